@@ -27,7 +27,7 @@ require (
 	github.com/issue9/identicon v1.0.1
 	github.com/jaytaylor/html2text v0.0.0-20190408195923-01ec452cbe43
 	github.com/json-iterator/go v1.1.7
-	github.com/klauspost/compress v1.8.6 // indirect
+	github.com/klauspost/compress v1.8.6
 	github.com/klauspost/cpuid v1.2.1 // indirect
 	github.com/lib/pq v1.2.0
 	github.com/mattn/go-isatty v0.0.12 // indirect
@@ -64,6 +64,7 @@ require (
 	gopkg.in/ini.v1 v1.52.0
 	gopkg.in/ldap.v2 v2.5.1
 	gopkg.in/macaron.v1 v1.3.4
+	gopkg.in/yaml.v2 v2.2.2
 	unknwon.dev/clog/v2 v2.1.2
 	xorm.io/builder v0.3.6
 	xorm.io/core v0.7.2
@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// ../../../conf/app.ini (18.755kB)
+// ../../../conf/app.ini (26.475kB)
 // ../../../conf/auth.d/github.conf.example (181B)
 // ../../../conf/auth.d/ldap_bind_dn.conf.example (719B)
 // ../../../conf/auth.d/ldap_simple_auth.conf.example (761B)
@@ -213,7 +213,7 @@
 // ../../../conf/locale/locale_cs-CZ.ini (72.893kB)
 // ../../../conf/locale/locale_de-DE.ini (73.666kB)
 // ../../../conf/locale/locale_en-GB.ini (66.468kB)
-// ../../../conf/locale/locale_en-US.ini (69.108kB)
+// ../../../conf/locale/locale_en-US.ini (114.236kB)
 // ../../../conf/locale/locale_es-ES.ini (74.188kB)
 // ../../../conf/locale/locale_fa-IR.ini (92.107kB)
 // ../../../conf/locale/locale_fi-FI.ini (70.349kB)
@@ -309,7 +309,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _confAppIni = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7c\xdb\x8f\xe4\xca\x79\xdf\x3b\xff\x8a\x3a\x2d\x2b\xda\x15\xd8\x3d\x97\xdd\xd9\xb3\x67\x47\x6d\x88\xdb\xcd\x99\xa1\xb7\x6f\x22\x39\x7b\x39\x83\x05\xb7\x86\xac\x66\x97\x9a\x64\x51\x55\xc5\x99\x6d\x21\x30\x74\xe0\x07\x27\x41\xfc\x94\xc4\x46\x00\x23\x80\x11\x24\x06\x9c\x38\x91\x91\x04\x90\x15\x19\x79\x38\xf6\xfb\xee\xff\x60\x48\x76\x90\xc0\xff\x42\xf0\x7d\x45\x76\xb3\x67\x7a\xe6\xac\x65\x04\x3e\x07\x98\xe6\xa5\xea\xab\xdb\x77\xf9\x7d\x17\xee\xb7\xc8\x67\x9f\x7d\x46\x26\xee\x4b\xd7\x27\xf8\x67\x3c\x1d\x7a\x27\x6f\x48\x78\xe6\x05\xe4\xc4\x1b\xb9\xf0\xde\x32\xad\x66\x23\xd7\x09\x5c\x32\x76\x5e\xb8\x64\x70\xe6\x4c\x4e\xdd\x80\x4c\x27\x64\x30\xf5\x7d\x37\x98\x4d\x27\x43\x6f\x72\x4a\x06\xe7\x41\x38\x1d\x93\xc1\x74\x72\xe2\x9d\xde\xa4\xe0\x9d\x90\x37\xd3\x73\xe2\xf8\x2e\x99\x39\x83\x17\xce\x29\xf4\x98\xf9\xd3\x97\xde\xd0\xf5\xed\xad\x01\xa6\xaf\x80\xf2\xec\x0d\x99\x9e\x10\x2f\x44\x1a\xd6\x31\x09\x17\x8c\x5c\x4a\x5a\x24\xa4\xa0\x39\x23\x62\x4e\xf4\x82\x11\x5a\x96\x19\x8f\xa9\xe6\xa2\xb0\x49\x4c\x0b\x72\xc9\xc8\x4a\x54\x92\xc4\x22\x2f\x69\xb1\x22\x42\x12\xcd\x68\x8e\x9d\x7a\xd6\x73\xdf\x99\x0c\xa3\x89\x33\x76\x49\x9f\x9c\x8a\x54\xd5\x84\xd5\x4a\x69\x96\x93\x4a\x31\x49\xae\x17\x82\xa8\x85\xa8\xb2\x04\x88\xc9\xaa\x28\x78\x91\xde\x1c\x4c\xf5\x88\xa7\xc9\x82\x2a\x52\x08\xc2\xe6\x73\x16\x6b\x22\x0a\xf2\x8a\x17\x89\xb8\x56\xb6\x75\x4c\x84\x5e\x30\x79\xcd\x15\xb3\x09\xd7\x0d\xc1\x9c\xea\x78\x81\xb4\xae\x68\x56\xe1\x2a\x7e\xe3\x3c\x70\x7d\xc2\x8a\x2b\x2e\x45\x91\xb3\x42\x93\x2b\x2a\x39\xbd\xcc\x58\xcf\xf2\xcf\x27\x11\xbe\xee\x93\x94\xeb\x7a\xae\xcd\x8c\x72\x91\xdc\xbb\x0d\x8c\xc3\x0c\x48\x27\x61\x57\x1d\x9b\x74\x4a\x29\x92\x0e\x6c\x47\x47\x33\xa5\x3b\x86\xf8\x78\x3a\x84\x9d\x48\xd8\x95\x65\x5d\x28\x26\xaf\x98\x7c\x5b\x0f\x53\x56\x97\x19\x8f\xbb\x73\x1a\xc3\x60\xe7\xfe\x88\xcc\x61\x2f\xb7\x07\xeb\x59\xee\xeb\xd0\xf5\x27\xce\x28\x82\x16\x7d\xf2\xed\x07\x33\x7f\x1a\x4e\x07\xd3\xd1\x43\xf5\x6c\x6f\xef\xdb\x0f\x86\xd3\xb1\xe3\x4d\x1e\xaa\x67\xdf\x7e\x70\x16\x86\xb3\x68\x36\xf5\xc3\x87\x6a\x6f\xe7\x20\x89\xc8\x29\x2f\xcc\xf9\xee\x1c\xcc\x10\x23\x7d\x92\x89\x98\x66\x0b\xa1\x9a\x3d\x29\xa5\xd0\x22\x16\x19\xd1\x0b\xaa\x09\x57\x70\x92\x09\xd1\x82\xe0\x9a\x48\xc2\x25\x1c\x90\x96\x74\x3e\xe7\x31\x3c\xbf\x45\xfa\x98\x0c\x2a\x29\x59\xa1\xb3\x15\x51\x55\x59\x0a\xa9\x15\xe9\x2c\xb4\x2e\x61\xf3\xe0\x57\xc1\xc5\x3c\x4e\x79\x87\x00\x17\x76\xaa\x82\xbf\xef\xf4\xac\x66\xbd\xa4\x4f\xa0\x55\x3d\x21\x9a\x24\x92\x29\x05\x43\x5d\x32\x92\x71\xa5\x59\xc1\x12\x72\xb9\xba\x3d\x32\x6e\x8b\x33\x1c\xc2\x29\xef\xf7\xf0\xff\x66\x55\x42\x6a\x52\x54\xf9\x25\x93\x9f\x4c\x08\xf6\x97\xf4\xc9\xa3\xfd\x7d\xa0\x72\xca\x0a\x26\xa9\x66\x44\x69\x56\xaa\x67\xd6\x31\xf9\x0d\xd2\xdb\x4b\x45\xaa\x48\xcc\xa4\x26\xdd\x98\xf6\xb5\xac\x18\xe9\x26\x95\x44\x32\xfd\xa7\x9f\x3f\xd9\x5f\xec\xe7\xfb\x8a\x74\x61\x83\xfb\xf9\x0a\x7e\x7a\xec\x3d\xcd\xcb\x8c\xf5\x62\x91\x5b\xc7\xd6\x31\x99\x4a\x32\x97\x22\x27\x94\xf4\xca\xf9\x7b\x32\xe7\x19\x23\xec\x3d\xcc\x98\x25\xe6\x0d\xcc\xaf\x96\x07\x1c\x8c\xcf\x61\xa6\x30\x15\x21\x19\x79\x90\x08\xeb\x98\x14\x42\xc3\x49\xa7\x4c\xc3\x02\x4d\x7f\xec\x58\x4a\x7e\x05\x8d\x97\x6c\xf5\xd0\x4c\x5b\x94\xac\x50\x2a\x23\xe5\x32\x56\x07\x87\xa4\xcb\x0b\xa4\x8a\xa3\x77\x45\xa5\xeb\x3b\x96\x93\x6e\x21\x96\x6c\xa5\x3e\xad\xd7\x92\xad\x9a\x4e\xf0\x42\xc1\x45\xc2\x94\x35\x70\xfd\x30\x42\x1d\xd6\x27\x71\xa5\xb4\xc8\xf7\x90\x09\xf6\x9a\x61\xac\x17\xee\x9b\x9d\x0d\x6a\x8a\xf5\x19\xe6\xbc\xe0\x79\x95\x13\x9a\x65\xe2\x9a\x25\x24\x1c\x05\xe4\x8a\x49\x65\x24\x75\x07\xcb\x85\xa3\xe0\x60\x1f\x58\x0d\x2e\x0e\x9a\x8b\xc3\x8e\x6d\xb8\x0e\x6e\x1e\x75\x7a\x56\x38\x0a\xa2\xb1\x37\x89\x5e\xba\x7e\xe0\x4d\x41\x26\xb0\x99\x75\x4c\x4e\xe0\x28\x4a\x26\x73\xae\x60\x14\x72\xbd\x60\x45\x2d\x07\x8d\x00\x5c\x71\x4a\xce\x0b\xfe\xbe\x91\x38\x25\xe2\x25\xd3\x3d\xeb\x7c\xe2\xbd\x8e\x82\xe9\xe0\x85\x1b\x46\x33\xd7\x1f\x7b\x41\x4d\xfb\xc9\x93\x27\xd6\x31\x19\x81\xd4\x91\x07\xc3\xf1\x97\x0f\xd7\x0a\xe1\x5a\xc8\x25\x93\x8a\x3c\x60\xbd\xb4\x47\x82\xe0\x8c\x54\x65\x42\x35\x7b\x48\x68\x1c\x33\xa5\x40\xae\xaf\xd9\x25\x4e\x80\xc7\x0c\x04\xcd\x2b\x48\x2e\x94\x26\x31\x55\x4c\x81\xb6\x26\x89\x40\x4e\x28\x98\x11\xda\x78\x41\x8b\x94\x21\x1f\x24\x6c\x4e\xab\x4c\x1b\x75\x09\x9d\x9d\x4c\x33\x09\x1a\x55\x14\xd9\x8a\xf0\xb9\xd1\xf6\x30\xae\x51\x5f\x04\x8e\x0f\x34\x00\x10\x04\x0a\x0a\xb4\x09\x55\x04\xa4\x03\x5f\xf6\xac\xd1\x74\xe0\x8c\x22\x7f\x3a\x0d\xef\xd2\x5a\x6b\x99\xbc\xad\xb8\xac\x63\xf2\x6a\xc1\x50\xb5\x6a\x41\x12\xae\x40\x55\x93\x0a\x17\x3a\x18\x4e\x70\x53\x94\xa6\x9a\xc7\x28\x14\x8a\x48\x96\x52\x99\x64\x4c\xa9\x9e\x35\x3d\x39\x19\x79\x13\xb7\xd1\xbb\x73\x9a\x29\xb6\x9b\x60\x26\xd2\x14\x48\xf2\x82\x48\x51\x69\x26\x7b\xd6\xd0\x0b\x9c\xe7\x23\x37\xf2\xa7\xe7\xa1\xeb\x47\xa3\xe9\x29\xe9\x13\x90\xde\x6d\x0a\xac\x40\x02\x2d\xd5\x40\x32\x76\xc5\x32\x72\xfa\xa5\x37\x43\xbb\x08\x9a\xc9\x28\xef\x09\x12\xc4\x17\xcd\x6c\x1a\xdd\x43\xf5\xa2\x5e\x8b\x90\x30\x91\x36\x3d\x55\xb2\x18\xc4\x99\x24\x54\xd3\x9e\xe5\xcc\x66\xd1\xd0\x09\x9d\x68\xe6\x84\x67\x60\x4e\xa8\xa6\x3b\xe7\xa4\x05\xc9\x04\x4d\x08\x55\x8a\x69\x45\x1e\xf0\x1e\xeb\x91\x4e\x2c\x8a\x39\xf0\xb9\x66\x79\x99\x51\xcd\x50\xd1\x1a\xcb\xd0\x79\x68\x74\x49\xc2\xd5\x92\xf0\x42\x69\x46\x13\xb0\x79\x2c\xbf\x64\x49\x02\x7a\x90\x17\x66\x0e\xa3\xa9\x33\x8c\x9c\x20\x70\xc3\x20\x3a\xf1\xa7\xe3\x68\xe8\x05\x2f\x6e\x2e\x2a\xa3\x45\x02\x6b\x29\x69\xca\xd6\x1c\x4c\x0b\x51\xac\x72\x51\xa1\xd1\x90\xca\x6e\x99\xe7\xda\x6a\x03\x2b\xf1\x22\xce\xaa\x04\xb6\x5a\x55\x97\xb8\x39\x8d\xa9\x59\xd0\x22\xc9\x36\x2a\x59\x32\x10\x6f\x34\x49\xef\x57\x3d\x6b\xe4\x20\x38\xaa\x19\xed\x2e\xf6\x01\xfe\x35\xf2\xb2\xc3\x38\x11\x56\x68\x2e\x59\xb6\xda\xb0\x00\xb4\xdf\xb0\x0f\x2c\xad\x6d\x3b\x8d\xad\x00\x6d\x0a\x56\x90\x17\x48\x3e\xce\x44\x81\x8b\xee\x59\x41\x70\x16\xad\x4d\xe9\xc6\x44\xdf\x69\x75\xee\xa7\x54\x5b\x9c\xc3\xc3\x36\xe7\x88\x39\x36\x95\x42\xe8\xda\xfa\x0a\xb9\xb2\xd7\xe2\xcc\x15\xe9\xfc\xc6\xd9\x74\xec\xee\xf5\x94\x5a\x74\x0c\x21\x14\x48\xc3\x42\x6d\x52\x60\xc5\xd5\xa2\xbb\x64\xab\x94\x15\xdb\x24\x36\xcf\x8d\x4d\xce\x18\x20\x2d\x96\x65\x64\xce\x8b\x84\x80\x7e\xbf\x5e\xf0\x78\x41\x60\xc2\xa0\x58\x68\x96\x99\xb1\x5e\xb8\x6f\x4e\xdd\x49\xc3\xb0\x1b\x3a\xcd\x6e\x36\x53\xc6\x5e\x92\x81\x29\x02\xf6\x14\x92\xca\x55\x2d\xd7\xa8\x57\x01\x4b\x11\x5a\xe3\x18\x30\x26\xb5\x26\x68\xcd\xd8\x3a\x6e\xcf\x59\x6f\xd0\xe6\x86\xe0\x7a\xb8\xf5\xe4\xa2\xd0\x0d\x5a\x9b\xd1\x62\x99\x78\xc1\xe2\xe5\xda\xac\xb4\x06\x56\xfc\xc7\x8c\x5c\x73\xbd\x20\xb1\x90\x92\xa9\x52\x18\x66\xd7\xab\x92\xf5\xac\xb1\x37\xf1\xc6\xe7\x63\xa4\x1d\x78\x5f\xba\xd1\xe0\xcc\x1d\xbc\xd8\xad\x83\x24\xbb\x96\x5c\x33\xd2\xf9\x6d\x3c\x9e\x3d\x5a\xe9\x85\x90\xfc\xc7\x2c\x89\xc0\xb0\x76\x8c\xb5\xa7\x1a\xf4\x9c\xd4\x36\xe1\x69\x21\x24\x4b\xcc\x8e\x54\x8a\x91\xcb\x8a\x67\xba\xe6\x16\xa3\x96\x7b\x96\xef\xbe\xf2\xbd\xd0\x8d\x9c\xf3\xf0\x6c\xea\x7b\x5f\xba\x43\x98\x4b\x10\x39\x61\x14\x84\x0e\xb2\xd0\x8e\xa9\xe0\x08\x84\xee\xa4\x88\xdd\x40\x14\xa2\xc0\xf5\x5f\x22\x4a\x6e\x4b\x44\xc1\x34\x18\x27\xc2\x0b\xcd\xe4\x9c\xc6\x06\x53\xde\x26\x84\x5a\x09\x71\x15\x01\x9d\x08\xf4\x46\x5e\x10\xba\x93\xe8\x6c\x1a\x84\xf7\x82\xb2\xbf\x2f\xc1\x5a\x54\xbe\xfd\xa0\x91\x9b\xb5\xd0\x41\x7b\x10\x1a\x50\x02\x25\x40\xa8\x98\x97\x0b\xb0\xab\x30\x44\x2c\x8a\x82\xc5\xe8\x76\xa0\x44\xee\xda\x8b\xf5\x2e\x44\x03\x6f\x76\xe6\xfa\x01\xe9\x13\xca\xd4\xc1\xe1\xd3\x6e\xac\xa5\x8d\xd7\x5f\x1c\xae\xaf\x0f\x8f\x9e\x6c\x9e\x1f\x3e\xed\xa6\x71\xfe\x7d\x83\x95\x16\x00\xf1\x6c\x42\x65\x3c\x17\x95\x3c\x3c\x7a\xb2\xbe\x3e\x38\x7c\x0a\xea\x6b\xc8\xe6\xbc\x60\x6b\x40\x43\xb3\x54\x48\xae\x17\xb9\x42\x11\xd4\x0b\xc6\xe5\x9a\x3d\x81\x2f\x33\x56\xa4\x7a\x41\x1e\x00\x63\x74\x0f\xda\x5a\x8f\x22\x6f\x3e\xec\x59\x17\x30\x6c\xdd\x07\x58\x2c\x02\x5e\x56\x6f\x2d\x77\x78\x78\x74\x74\xf0\x05\x68\x97\xa3\x27\x96\x3b\x18\x06\x0e\x21\xf5\x9d\x8f\xd7\x78\xb7\xff\xf8\xa9\x35\x5c\xdf\x1e\xec\x1f\x3e\xb6\xac\x0b\xc9\x4a\xa1\x38\x08\x55\xe3\xd1\xa0\x32\xba\x65\xd7\x72\x5a\xd0\x94\x25\x64\xdd\x9e\x33\xb5\xad\x65\x7e\x1b\x01\x73\xb7\xdd\xa0\x63\x81\xb2\x5a\xeb\x29\x15\x4b\x5e\x6a\x5c\x4d\xc3\x03\x0d\xa0\xb3\x89\x12\x39\xd3\x3c\x67\x8a\xc4\x8d\x53\xd9\x31\x3a\x6f\xe0\x7b\xb3\x30\x0a\xdf\xcc\x00\x0b\x5c\x52\xb5\x30\xbb\x8b\x03\x3b\x93\xc0\x03\x20\x24\x15\xd3\xb5\x99\x22\x55\x21\x59\x2c\xd2\x02\x24\xb1\x79\xd7\xb3\xa0\x65\x34\x38\x73\xfc\xc0\x0d\x6f\x2a\x8b\xb9\x90\x31\x23\x60\x91\x56\xa4\x60\xd7\x9b\x45\xae\x6a\xd5\x5e\xe3\xec\x9e\x75\x32\xf5\x07\x6e\x34\xf3\xbd\x97\x4e\xe8\xde\x90\xa4\x34\x13\x97\x34\x23\x19\xcf\x39\x32\x69\xcd\xfd\x62\xbe\xb5\x69\x84\x1a\xff\x19\xdc\x4f\xa3\x32\x6d\x38\xef\x9c\xd1\x02\xbd\x64\xec\xde\xb3\xc6\xce\xeb\x68\xe0\xbb\x4e\xe8\x4d\x27\xd1\xc8\x1b\x7b\x20\x11\xdd\x03\xeb\x98\xcc\x24\x9b\x33\x09\x8a\x64\xc4\x63\x56\x00\x38\xd4\x82\x94\x19\x88\x2e\x35\x60\x4e\x8b\xb2\x71\x79\x41\x62\x00\x10\x4e\xc0\xe2\xe5\x95\xd2\xb5\x73\x8d\xba\x09\xcd\x20\x2f\x0c\xb6\xd8\xcb\x0c\x39\xe3\xfd\xd6\x58\x7d\xeb\x05\x78\x71\xee\x89\xeb\xfb\xee\x30\x1a\x79\x03\x77\x12\xb8\x20\x3f\x4e\x49\xe3\x05\x6b\x66\x43\x0e\x7b\xfb\x36\x81\xf9\xd6\x0f\x76\x9b\xf2\x53\xae\x8d\xca\xa1\x28\xb1\x46\x23\x6f\xed\x13\xa0\x6f\x80\x94\x7b\xf0\x27\x58\xfb\xae\x1b\xeb\x8e\x78\xf3\xd4\xbb\x43\x25\x36\xf8\xee\x92\x67\x5c\xe3\x39\xe6\x3c\x45\x27\xaf\x75\xba\x97\xab\x86\x11\xd1\x55\x46\xb6\x5f\xe3\x3d\x83\x7f\xc1\xb8\x44\x63\xef\xd4\xc7\xa3\xb8\x77\x2c\xc9\x8a\x84\x49\x13\x71\x00\x5e\x94\xf4\x1a\xf7\xb9\x07\xec\x21\x19\xa1\x12\xf4\xa2\x06\x9c\x42\x33\xa2\x58\x5c\x49\x98\x9a\xe4\x6a\xa9\xd6\xa3\xfa\xce\x2b\xf4\x97\x22\xdf\x9d\x0c\x5d\xff\x26\x06\x46\x67\x89\xbe\x47\xb5\xb1\x61\xb0\x54\x00\xfa\xe5\x05\xf0\x02\xe0\xad\x3a\xb6\x21\xab\xa2\x61\x09\xc4\xf7\x20\x5f\x46\x4a\x08\x98\xdf\x0c\x08\xce\x19\xb0\x83\x64\x3f\xaa\x98\xd2\x3d\x72\xae\x2a\x9a\x65\xab\x36\xbc\x4b\x58\xc9\x0a\xc4\x93\x0b\x71\x0d\x8a\x60\x45\x06\xb3\x73\xf2\x20\x16\x92\xa9\x87\xe8\x99\x2c\xe8\x15\xeb\x11\x6f\x6e\x1d\xb7\xfa\xa1\x77\x51\x74\x71\xb3\xf9\x95\x09\xf0\x20\xf3\x19\xf3\xbe\x99\xfd\x60\x76\xae\x08\xbd\xa2\x3c\x6b\xe0\xef\x2d\xa7\x7d\x30\x1d\x8f\x3d\xc0\xac\x6e\x38\x38\x8b\x06\xd3\xc9\xe0\xdc\xf7\xdd\xc9\xe0\x0d\x18\x9e\x2d\x35\xd6\x63\x09\xfc\x82\x36\x1b\xd5\xd6\xa2\xf6\xba\x35\x2b\x94\x31\x0e\xb0\x45\x35\x68\x85\x99\x93\x0c\x34\xf5\xb5\xa4\xa5\x02\x69\x80\xc1\x07\x22\x61\x63\x2e\xa5\x90\xc4\xd0\x03\x19\x0a\x58\x49\x91\x83\x5a\xb4\x90\x6f\x29\xf8\x0b\x39\xc0\x6b\xf0\x5a\x5e\xf9\xce\x2c\x72\x5f\x87\xee\x04\xdc\x42\x90\x90\x9e\x7e\xaf\xed\x5e\x9e\xd8\xbd\x9c\xca\x65\x22\xae\x0b\xb8\x33\x3f\xcb\xc4\x3a\x26\x2f\x69\xc6\x13\x33\x4f\xe0\x9e\x7a\x8a\x38\x37\x4a\x4a\xc9\xae\x38\xbb\x26\xce\xcc\x03\x97\x40\xc4\x9c\x82\xe9\xc3\x91\xf5\x82\xe5\x36\x51\x55\xbc\x00\xe7\xad\xb3\x47\x4b\xbe\x77\x75\xb0\xd7\x0c\xd3\xd9\x9a\x36\x1e\x8b\x02\xa6\xc7\xe9\xaa\x1e\xe8\x12\x24\xad\xe9\x25\xac\x1c\x96\x6a\xd8\xf7\x5a\x14\xdf\xc1\x3d\xba\x06\xe7\x11\x76\x64\x7b\x13\x49\x22\x98\x82\x26\x78\xa0\xa8\x18\x5e\x7a\xee\x2b\xe4\x60\xe4\x5e\x60\x5b\x58\x7a\x33\x93\xed\x33\xaa\x4a\x70\x70\xde\xde\x21\x45\x6b\xf1\xc4\x31\x4d\xdb\xb5\x80\x0c\x37\xde\x5c\x1b\xfb\x36\x28\x91\x67\xab\x3a\x74\x52\xf7\x03\x3e\x2d\x40\xe6\x48\x85\xd2\xa9\x17\x5c\x99\x5e\x29\x38\x57\xd7\xbc\x64\x06\x02\x83\x07\x81\x16\x00\xc1\xd4\xc3\x9e\x15\xba\xe3\x59\xdb\x57\xdb\xd3\x79\xb9\x57\x53\x6d\x02\x08\x60\xcb\xea\xd3\x02\xe9\x6e\xac\xbd\xb1\x1a\xa6\x2d\x4b\x6c\x82\x5e\x7f\x87\xe7\x34\x65\x7b\x3f\x2c\x59\xfa\x4f\xcd\x65\x59\xa4\x9d\x1e\x19\x31\x38\x67\x96\x97\x46\x4d\x21\x0d\x02\x52\x36\x6f\x46\xe8\x59\xce\x68\x34\x7d\xe5\x0e\xd1\x0a\x06\x6b\x83\xda\x28\x02\xc4\xb4\xe0\xef\xd1\x46\xb3\xf3\x82\x8c\x9f\xf7\x2c\x73\x14\xce\x6b\xc4\xb2\xa4\x4f\x1e\xdd\xa9\x41\x0c\x58\x2f\x99\xac\x67\x6d\x2c\x10\xf4\x87\x53\x3c\xb2\xac\x0b\xd8\x82\x4b\xaa\x58\x83\x13\x9a\x7b\x72\x49\xe3\x25\x2b\x60\x95\x75\x28\xb5\x14\x4a\xa7\xd2\x38\xa8\xf9\x4a\xfd\x28\xeb\x90\x8e\xfa\x51\xc6\x35\x7b\x64\x8c\x4b\xae\xe0\x21\xf0\xe6\x1b\x51\x19\x4b\x68\xb0\x1b\xac\x3f\xe4\xc3\xe7\x86\xb9\xc7\xab\xe0\x07\xa3\x96\xe2\xaf\x21\x40\x43\xde\xaa\x81\xe7\xc1\xe1\xe7\x08\x3d\x0f\x9e\x1d\x3d\x7e\x74\x68\xd5\x61\x6b\x00\x23\x56\x13\x15\x86\xeb\x99\x13\x04\xaf\xa6\xfe\x10\x77\xef\x44\xb4\xe7\x89\x51\x92\xcd\xfc\x6b\x1b\x05\xd3\x07\xbd\xc8\x65\x6d\x13\xaf\x98\xe4\xf3\x55\x77\x5e\x65\x19\xfa\x62\xa3\x75\x60\xd8\x74\x68\xe8\x6e\xd6\x8a\x64\x73\xba\x64\x44\x55\x12\x35\x1b\xc0\x3b\x7a\xa9\x44\x56\x69\x56\x9b\x9b\x36\x8b\xc1\x4c\x7b\xc9\x25\x86\x99\x8d\x79\xb8\x21\x24\x28\x92\x20\x8f\xe0\xe6\xd3\x2c\x43\x27\xdd\x26\x00\x7f\x90\xb3\xb5\x20\x1d\x10\x8f\x0e\xf2\xe0\xaa\xa4\x4a\x11\xc0\x13\xde\x24\x08\x9d\xd1\x08\x8c\xda\x8b\x1b\xe6\x44\xb1\x58\xd6\x91\xc5\x22\x96\xab\x52\x93\x58\x88\x25\x6f\xf4\x85\x4d\x0e\x4f\x1c\x12\x8b\x84\xd9\x84\xe9\x18\x4e\xed\xb3\xcf\x4c\x76\xc3\x24\x41\xc2\x29\x79\xe1\xba\x33\xf2\x66\x7a\xee\x13\xdc\xf1\xa1\x13\x3a\x24\x70\x4e\xdc\xcf\x3e\xb3\x02\x77\xe0\xbb\x21\x38\x31\xa4\x4f\x3e\xfb\xd6\xf7\x4f\x86\xee\x2b\x70\x72\xfe\xc9\x77\x1f\xac\x19\x69\xa5\x88\x64\x39\x03\x5e\x64\x89\x31\x50\x95\x16\xdd\x4c\xa4\xbc\xe8\x59\xa3\xe9\xa9\x37\x89\x7c\x77\xec\x8e\x9f\xbb\x7e\x34\x74\xde\x00\x4b\x7e\x5e\xf7\xae\xe7\xda\x78\xf4\x4a\x0b\x40\xdb\xeb\xee\x84\x17\x73\x21\xf3\xb5\x19\x99\xbe\xf0\xdc\x0d\xad\x16\xaf\x44\xbc\x88\x25\x4b\xb8\x39\xc7\xdd\x94\x61\x76\x99\x48\x53\xe3\xe4\x03\x8c\x33\xf9\x92\x9a\x2c\xac\xbd\x4d\x91\x5e\x33\x40\xb5\x37\x0e\x10\x9c\x6f\x38\xdb\x66\x80\x75\xf7\xc0\x1d\x9c\xfb\x77\xc4\xbb\xa0\x57\x3d\x1f\x2d\x08\x2f\x12\x13\x24\x46\x24\x69\xd6\xa9\x34\xd5\x95\x6a\x81\x17\xd8\xb4\x20\x74\xc2\xf3\x20\x32\x03\xdc\x38\xf6\x5d\xcb\xdb\x45\x70\x07\xa5\x66\xdf\xb0\x61\x64\x1a\x5a\xd6\x05\xcb\x29\xcf\x76\x2b\x75\xe0\x58\x7c\xbd\x89\x70\x6e\xd4\x79\x7b\x56\xa5\x64\x73\xfe\x1e\x7e\x00\x74\x18\x55\x8a\xf8\xa5\xba\xfc\x21\x28\x08\x30\xd5\x3d\x2b\x38\x7f\xfe\x5b\xee\x20\x8c\x00\x8f\x7a\xaf\x49\x9f\xbc\xbb\xf8\xf6\x83\x4d\xd6\xea\xa1\x7a\x4b\xde\xd5\x04\x83\x71\x38\x6b\x40\x1e\x6a\x15\xae\x15\x7a\xa7\xb5\x56\x56\xb9\x2e\x7b\x30\xb3\xb4\x2a\x7a\x42\xa6\xcf\x8e\x9e\x7e\x6e\x9b\xa7\x29\x3c\x06\x3f\xaf\xf5\xec\x47\x3f\xc2\x07\x8f\x9f\x1c\x59\xc7\xc4\x33\xa6\x11\x7d\x5d\x56\x24\x0a\xfd\xa0\xc7\x4f\x8e\x3a\x36\x0e\x1b\x90\x6b\x9e\x65\x68\x09\x14\x4b\x00\x5b\x81\x27\x85\xfe\x78\x38\x0a\xc0\xe0\x60\xcf\xa3\xa7\x9f\x43\x47\x70\x5a\xf2\xdc\x2c\x1a\xf4\xb0\x7f\x32\x20\x4f\x1e\xef\x7f\xd1\xdb\x0c\x74\xc3\x69\xda\x90\xe2\xda\x0c\x45\xb3\x6b\x10\xa6\x66\xc4\x46\x43\xee\x5a\x63\xbd\x3d\xe6\x50\x4c\x8e\xa2\x4e\xc6\x3c\x80\x91\x8f\x1e\x1d\x1e\x3e\x04\xe0\xca\x55\x83\x26\x7f\x08\xde\x03\x2d\xea\x2e\x75\x6b\x9b\xd4\x19\xa8\x77\x1d\x70\x31\x3a\xe4\x7b\xf8\xfa\xfb\xad\x44\xc8\x6f\xbe\x23\x46\x04\x7b\xd6\x89\x3f\x1d\x93\x3e\x29\x84\x64\x65\xb6\xfa\x3e\x6a\xbb\x9b\x49\x2a\xc3\x7d\xc0\x88\xbd\x46\x7f\x7f\x42\x7b\x50\x74\xd7\x42\x26\xbd\xb6\x9e\xdf\xed\x7a\x9c\xb9\xa3\x29\x11\x25\x33\x99\x9c\x3a\x40\xb5\x60\x04\x68\x1a\x8f\x48\x91\x84\xcf\xe7\x4c\xb2\x42\xb7\xdc\x0d\xe8\xd6\x58\x5e\xe3\x1e\x6d\xba\x80\xce\xda\xa6\xbb\xe5\x1c\xe3\xfe\x9a\x78\x56\xcf\x82\x76\x18\x34\x31\x52\x74\x63\x96\x6a\xc9\x4b\x62\x2c\x4d\x93\x50\x6d\xa7\x85\x44\x9b\x13\x7a\x64\x5a\x64\x2b\xb4\x29\xa8\xfc\xd1\x61\x67\xd9\xbc\xab\x78\x5a\x80\xf3\xbb\xe9\xa8\x7a\x56\xf0\xc2\x9b\x45\x2f\x5d\xdf\x3b\x79\xb3\x5b\xc9\x00\x9d\x38\xe3\x80\x95\xb6\x7b\x9e\x07\x6e\x34\x70\xfd\xd0\x3b\xf1\x06\x6d\xbf\x77\x47\xf6\x07\x4f\xff\xbe\xec\x8f\x69\xd0\x64\x7f\x6e\x4f\xa0\xa3\xd9\x7b\xbd\x57\x66\x94\x17\x1d\xc0\xb4\x0d\x7a\x6b\x58\x08\xe6\x32\x1b\x39\xde\x24\x0a\xdd\xd7\x77\xf8\x7e\x54\x6b\x40\x42\x94\x20\x19\x20\x48\x68\xa6\x41\x5b\x83\x23\xd2\xa8\x94\xb1\x37\x76\x49\xce\x94\xa2\x29\x23\xd7\x0b\x80\x4d\x8a\x99\x60\xe0\x59\x38\x1e\x19\x3e\x57\x28\x7e\xdb\xc9\x52\x23\x7e\x44\x64\xe8\xed\x81\x30\x98\x5d\x33\xa1\x1d\x63\xee\x4b\x9a\x03\xa6\xd2\x4c\x2a\xb2\xa0\x65\xc9\x81\x9d\x9d\xe1\xb0\x35\xf7\xc8\x19\x6d\xe6\x6f\x5d\xd0\x4a\x2f\x1a\x6c\x75\x85\xfe\x40\x93\x6c\x34\x11\x2f\x6d\x52\x7d\x31\x26\x6e\x0a\x92\xf3\xa2\xc2\xc3\x71\x06\x21\x46\x23\xa2\xc1\x74\xe8\x46\x23\xef\x25\x22\xb6\x83\xa7\xfb\x77\xd2\x92\x0c\xcc\x49\x23\x31\xb7\x29\xfa\x6e\xe0\x86\x51\x23\x47\xbb\xe8\x6e\x45\x41\x11\x21\xd5\x5a\x21\x16\xc5\x9c\xd7\xe6\xd6\x18\xf2\x04\x37\xb4\x60\xd7\xdb\x7a\x83\xe1\xc6\xba\x8d\x75\xe0\x8a\x88\xb2\x0e\x04\xa0\x1e\x53\x1b\xca\x68\x93\xb4\x68\x68\xb7\x6c\x09\x3a\xd9\x2c\xe5\x4a\xcb\xda\xc0\xfb\xee\x0f\xce\x3d\xdf\x8d\xdc\xb1\xe3\x8d\x22\xac\xb1\xf0\xc7\xf7\x78\xee\xa0\x13\x6a\xbc\xbd\x95\xde\x20\x57\x1c\xbc\xd6\x5a\x00\x15\xd7\x6c\x43\x3b\xf0\x4e\x27\xde\x24\x02\x7f\xe7\xfe\xe4\x14\x8a\xe2\xd6\xfc\xa0\x55\xd1\xbc\x4f\x6c\x42\xe3\x58\x54\xc0\x38\xd7\x1b\x67\xd4\xf8\x0e\x26\x34\x84\xe9\x12\x9a\xe4\xbc\x50\xad\xc4\x96\x7b\xea\x05\xe1\x27\xc4\x23\x62\x5a\xea\x78\x41\x0d\x07\x6c\x8e\xa4\x3d\xa3\x75\xd4\xa1\x45\x33\x1a\x38\xb3\x70\x70\xe6\x34\x8e\xd6\x1d\x5e\x5a\x2b\x7f\x03\x78\x6b\xc1\x0a\xdd\x64\x62\x9a\xd0\x0d\x59\x30\x9a\x00\xe3\xaf\x47\x79\xe9\xfa\x20\xbf\xfe\xf4\xf5\x1b\x0c\x71\xbb\x93\x10\x54\xca\x9d\x2b\x01\x20\x07\xdc\x14\xd3\x2c\x5b\x35\x29\x06\x60\x26\x73\x4a\x66\x39\x77\xcf\xe4\xee\x91\xa7\x77\x6d\x23\x88\x4c\x6b\xee\x46\xea\xa9\x5a\xa3\xbd\x4f\x18\xf3\xbe\x65\x46\x67\xae\x33\x44\xa3\xf6\xba\xfb\xca\x7d\x0e\x2f\xbb\x60\xe5\x2c\xeb\x02\x46\xd8\x8d\x9e\x0c\xb7\x17\xa2\x56\xc9\x18\x78\x80\x69\xe0\x26\xac\xd7\x68\x78\x7e\x32\xad\xd5\x74\x7b\x59\xe0\x4e\x60\x32\xf3\xed\x1a\xf3\x9b\xac\x77\x29\xc5\x15\x4f\x98\xdc\x38\x3f\x39\xcb\x85\x5c\x61\x11\x07\x47\x1f\x08\x3c\x1a\x00\xc6\xca\x54\x71\x60\x25\x12\x38\xf6\xd8\x6e\x8d\x25\x8b\x39\x4f\x1b\x15\x63\x76\x48\x95\x2c\x46\x75\xdb\x8c\xf1\xcc\x3a\x26\xdd\xba\xdf\x33\x0c\x20\x6c\xd2\xd9\xe0\xee\x1a\x22\x64\xc5\x34\x36\x84\xe1\x9f\xad\x27\x8a\x5e\x2d\xf8\x4b\x35\x6c\x7b\x87\xee\x52\xfd\x56\xbd\xc3\x1e\x38\xcb\x67\x4d\x46\xa3\xaf\xe3\xd2\x06\x6d\xd3\x7f\xf6\xe4\xd1\xe7\x5f\xd8\x8d\xbe\xeb\xe7\x34\xa6\x52\x14\x76\x72\xd9\xdf\xb7\x4b\x21\x32\x8c\xa3\xf7\x0f\xf6\xf7\x6d\x9e\x64\x2c\xd2\x3c\x67\xa2\xd2\x7d\x50\x75\xcd\x82\xa3\xba\x5c\xab\x76\xd3\x9a\x71\xef\x83\xd2\xba\xb5\xcd\x3c\x01\xfe\x98\xa3\x11\xd8\x86\xd0\x3c\xca\xf8\x92\x45\xa9\x29\xb2\xda\x8d\xf8\x79\x41\x4c\x0c\x14\xfc\xc9\xbb\xdd\x05\x98\xc9\xe9\xc0\x44\x55\xaf\x68\x06\xdd\x14\x8b\x05\xe0\x52\x03\x0c\xcc\x5c\x4c\x22\xf8\x74\x10\x79\x93\xd0\xf5\x5f\x3a\x23\xd2\x27\x8f\x9e\xec\xef\xdf\x08\x0d\x64\x7c\x5e\x07\x0c\x6f\xd0\xa1\x0d\x25\x13\x22\x18\x79\x27\x6e\x14\x7a\xb8\x98\xa7\x4f\x1e\xaf\xe9\xb4\xf7\x04\xba\x0d\x02\xff\x84\x68\xb1\x64\xe0\x86\x05\xfe\xc9\x0d\x57\x22\x8a\x95\x9c\x5b\xd6\x45\x4c\xe3\xc5\x3a\xc4\x80\x37\x84\x26\xb4\xd4\xbb\x59\xd4\xf0\xa5\xe1\xd1\x9c\xe5\xd8\xbe\x03\x76\xd6\x99\x85\xdb\x5c\x7a\x22\x36\x1d\x6b\xbf\x7c\xf7\x5e\x81\xab\xbc\xde\x97\x27\xfb\x4d\x57\x33\x92\x29\x2e\x59\x8f\x64\xb7\x72\x4e\x88\x05\x1b\xeb\xf6\xec\xff\x17\x3f\xd6\x12\x84\xc3\x3f\x23\xef\x36\xa1\x8f\x83\x83\xc3\x83\x83\x77\x35\xe0\xb7\xac\x8b\x85\xd6\x65\x0b\x4d\x54\xe6\x10\x3a\x0e\x66\xcf\xbb\x03\x51\x68\x29\xb2\xae\x03\xb6\xaf\x3b\x95\x3c\x05\xb4\x65\x34\xde\x16\x70\xc5\xf2\x10\x01\xee\x98\x42\x30\xec\x0c\x06\x6e\x00\x6e\xe0\x24\xf4\xa7\xa3\x08\xc3\x52\xd1\xd4\xf7\x4e\xbd\x09\x0e\x6b\x90\x57\xce\x0a\xbd\x53\x93\x25\x75\x74\x89\x6c\xda\x61\xc8\x35\xc5\x02\xac\xec\x1b\x62\x7c\x46\xae\xda\x5d\x45\xb1\x89\x4d\x36\xf0\xba\x1d\x4e\x69\xb5\xfd\x47\x8e\xd8\x91\x5d\xa4\x3e\x35\x8c\xd7\x8a\xe0\x3d\xfe\x07\x44\xf0\x24\xcb\x18\x55\xac\xf7\xeb\x1c\x92\xd1\xe9\xd8\x7f\x57\x28\xf6\x1f\x75\x6b\xbf\xbb\xf7\xdd\x5f\x63\x27\x1f\x1d\xfe\x9a\x5b\x79\xb0\x6f\x59\x17\x20\x94\xb0\x7b\x81\xa9\xf1\x61\x26\xa7\x61\x9c\x14\xdc\xac\x79\x05\x88\x45\x54\xba\xac\x00\xc7\x25\xc6\x93\xc2\x50\x7f\xc5\x54\xab\x74\x56\x14\x6b\xaf\x6e\x2e\x60\xb9\xbc\x48\x41\x7f\x38\x93\xc0\x1b\xd8\x58\x80\x36\xc4\x2c\xa1\x5f\x5d\xae\xea\xab\x93\xc1\xd3\xc3\xc3\xe6\xf7\x4b\x73\x71\xb4\x8f\xbf\x07\x07\x87\x8f\xd6\x17\xe6\xd5\xa3\x47\x8f\xbe\x58\x5f\x4c\x68\x21\x6c\xf2\x82\xeb\x78\xc1\x0a\x9b\x04\x9a\xe6\x65\xfd\x33\xe6\x59\xc6\xd7\xd7\xb1\x14\xa8\xee\xf0\x16\x7a\xf5\x6a\x5d\x98\x83\x14\xb6\xc2\x6a\x84\x5e\x8a\x4a\xb7\xd7\xaf\x18\xc3\x2a\xcf\x67\x7b\x7b\xa9\xc8\x68\x91\xf6\x84\x4c\xf7\xca\x65\xba\x07\xdb\xb6\xf7\xad\x72\x99\x76\x63\x51\x28\x4d\x0b\xad\x30\xa9\x3a\x76\xc0\x15\xaa\x67\x6d\x59\x17\x25\x8f\x75\x25\xd7\x86\x60\x5b\x03\xa0\x43\x40\xaf\xa8\xa6\x72\xb7\x0a\x70\x5e\x3a\xa1\xe3\x47\xe7\x33\x2c\x77\xda\x52\x08\xa6\xd7\x4e\xb2\xad\xc4\xc3\x7d\xc4\x7d\x77\x36\x0d\xbc\x70\xea\xbf\x89\xee\x1e\x07\x68\x75\x37\x83\x0d\x16\xbc\x60\x8a\xd5\xa8\x35\xa6\xa6\xfe\x83\x36\x61\x04\xd3\x90\x28\x51\xc9\x98\x6d\xd2\x39\xf5\x16\xc6\x45\x2f\x95\xa6\x49\x2f\x16\x79\xbd\x86\xbd\x9e\x75\xea\xd7\x13\x08\xa6\xe7\xfe\x00\xc3\x8e\x75\xbb\x3b\x72\xae\xf5\x5b\xdb\x38\x5c\xc6\x2c\x34\x21\x2a\xcc\x81\x37\xc2\x8a\xc5\xae\x1c\x9c\xc5\x39\xe6\xc6\x72\x2c\x08\x6c\x1c\x90\x66\xdc\x7b\x9d\x8f\x39\x4b\xb0\xa6\x36\x69\x56\x97\x09\xb1\xac\x4a\x58\xb8\x22\xc3\x49\x50\x4f\x2c\x16\x57\xeb\xc3\x6c\x65\xb7\xac\x63\x13\xac\x33\x3e\xb8\xbd\xe6\x28\xf5\x6c\x6f\xef\xfa\xfa\xba\x97\xf1\xcb\x66\x4b\x84\x4c\x51\xe0\x12\xa6\x1b\x7f\x3d\xfc\x86\xe5\xe1\xac\x6f\xae\x0f\x40\x04\xc6\x82\x9a\x6d\x32\x71\x20\x75\x49\x33\x96\xac\x41\xf6\x89\x3b\x74\x7d\x27\x74\x87\xd1\x8d\x3d\xb0\x2e\x9a\x54\xd7\x6e\x0c\xbf\xa0\x32\x31\x89\xc6\x4b\xc9\xe8\x72\x93\x4a\x5b\x93\x3e\x73\xfc\x61\x84\x19\xc4\xe7\xbe\xeb\xdc\x8c\xd2\x37\xa5\x2f\x35\xcb\x9c\xfb\x23\xa2\xe2\x05\xcb\x77\x69\x5c\xaa\x60\xa4\x65\x5d\x7c\x65\xd2\xd2\xe0\xcb\x8e\xeb\x19\x36\x92\x5c\x07\xe9\x6c\xd2\x49\xb9\xee\x90\x07\x08\x11\x52\xae\x9f\xed\xed\x75\x1e\xd6\x58\x87\xa6\x05\x5b\xbf\x33\x77\xf8\xba\x67\x99\x0f\x19\xa2\x73\x7f\x14\x05\x83\x33\x77\xdc\x4a\x4c\x65\x9f\x90\x79\xbd\x6c\x12\xe6\x2c\xd9\x63\x09\xd7\x66\xde\xed\x29\x7e\x63\xbe\x95\x84\xa2\x49\xba\xd7\xc5\x66\xf0\xb6\x10\xad\x3c\x25\x55\xeb\x14\xa4\x6d\x22\x98\x65\xa5\x37\x09\x5b\x4c\x90\x6d\xe7\x6a\xef\x4c\xd3\x5a\x17\x2a\xa7\x52\xaf\x4a\xd0\x5a\x77\x87\xb9\x83\x4d\xa3\xdb\x87\xbc\x09\x77\x9f\xf8\xce\x20\xac\xc7\x44\x23\x3a\x74\x82\x33\x77\x7d\x37\x72\x42\xf7\x75\xb4\xfd\xcc\x99\x9c\x8e\xdc\x61\xf4\x83\xf3\x69\xb8\x79\x68\x5d\x60\x7c\xe0\xed\x6e\x91\x97\x2c\xad\x32\x2a\xc9\x83\x42\x14\x5d\x6c\xf8\xb0\x56\x42\x9b\x8a\x3d\x21\x53\x5a\xf0\x1f\xd7\x1f\x6c\xb4\xc3\x0c\xe7\x23\xc7\x8f\xa6\xfe\xe9\xba\x12\xa5\xc5\xed\xd7\xec\x72\x21\xc4\xf2\xed\x8d\x13\x6f\x20\x84\xc1\x02\x6b\x27\xb5\x8e\xee\xad\xbf\xba\xe8\x80\xc3\x03\x08\x5e\x65\x34\x5e\xc2\x05\xea\x02\x99\x98\xcb\x22\xd5\x34\x5b\x76\x4c\xca\x2f\xa8\xf3\x29\x36\xc1\xc6\x36\xa9\x9b\xc2\x85\x69\x88\x05\x41\x19\xc7\xa2\x32\x03\x96\xb7\x00\xfd\xd0\x1d\x79\x2f\x5d\x1f\xbd\x94\xe9\x39\xa6\x0c\x8f\x6e\x84\x19\x10\x66\xf0\xa2\x49\xcc\xac\xa3\x9f\x78\x74\x18\x38\x0d\x47\xc1\xed\xe0\x69\xb8\x55\xc7\xb0\xe0\x0a\xad\x47\xdb\x36\xf2\xc2\x80\x90\x92\xa6\x0c\xb0\xe9\xa9\x37\x39\x8d\x26\xe7\xe3\x1a\x47\x34\xdf\x1e\x64\xe0\xef\x69\x5e\xa4\x0a\x08\x61\x8e\x09\x3c\xfb\x8b\x4c\xa4\xbb\xeb\xb2\x68\x96\x41\x33\xc3\xf7\xdb\x85\x58\x99\x48\xf7\x3a\x44\x55\x97\xad\x7a\xc9\xed\xa2\xd1\x41\x7d\x08\x60\x83\x85\x49\x74\xd6\xce\x7e\x7d\x1e\x46\xf6\x9b\x23\x01\x59\x3c\x57\xcc\xc8\x8c\xf1\x4d\x6b\xc1\xcc\xab\x4c\xf3\xb2\xa9\x5a\x68\xa0\x5d\x4d\xd6\xc6\xc9\x75\xac\x3a\x49\x5a\x3f\xb5\x8e\xc9\xf3\x6a\x3e\x67\xb2\xa9\x78\x03\x9d\xb6\xa0\x45\xc1\x32\x9b\x2c\x19\x2b\x09\xd7\x20\xb5\x1c\x17\x63\x2a\xd7\x49\x82\xe5\x08\xcb\x42\x5c\x93\x6b\xac\x27\x86\x97\x3d\xeb\xf9\xf9\xc9\x89\xeb\x47\x23\x77\x82\xdb\x09\xee\x91\x5b\xfb\x88\xa1\xa4\x31\x2e\xc8\x2b\xe6\x02\x7e\x5f\x51\x59\xc0\xaf\x2b\xa5\x90\x70\x71\x42\x35\xcd\x3a\xdb\x5b\x67\x7a\x59\x23\xf7\xa5\x0b\xee\x1f\xde\x5a\x8d\x0b\xd8\xec\x56\x6d\x2d\x8a\x6c\x85\xe7\xd3\xab\x9f\xc3\x39\x0d\x30\x83\xa3\xb1\x9e\x00\xf3\x74\x0b\x26\xf1\x8b\xa4\x9a\xe2\x9a\x16\xee\xcc\x4d\x42\xf0\xf0\x93\xa8\xec\x2c\x74\x32\x91\x32\x93\xa1\x24\x52\x68\x38\x9f\x07\xea\x1a\x80\x1e\xaa\xe2\x06\x5b\xd6\x81\x56\xf5\x10\x53\x7b\x91\x3f\x0d\x4d\x48\xff\x76\x89\xbc\x62\x29\xce\x63\xcd\x67\x24\xa1\x1c\xeb\xab\x1d\x6f\xf4\xe6\x56\xcf\x5b\x00\x5c\x2d\xf8\x1c\x95\x82\x29\x36\x42\x1a\x5b\xfb\x7d\xf8\xb4\xae\x7b\x3b\x20\xdf\xfb\x1e\xdc\x61\xcd\x62\x1b\xa7\x47\xc1\x99\x77\x82\x75\xd3\x4f\xef\x44\xeb\x19\xd6\x3d\x6d\x0f\xd3\xc4\x26\x26\x35\x62\xc7\xff\x9a\x94\xd7\xfb\x92\x4b\x84\xe4\xab\x46\xda\x8c\xa1\x7a\x90\xb0\x8c\x69\x46\xe8\x5c\x63\x60\xff\x3d\x36\x79\x68\x68\xad\xd3\xce\xeb\x2c\xbf\x91\x94\x1b\x67\x88\x4f\x3f\xf5\x10\x8d\x0a\x05\x5b\x6e\x61\xe1\xbb\x65\x68\xd4\x72\xf7\x6b\x53\x31\xcb\x5c\x07\x2c\x0d\xe6\x49\xb8\x2a\x33\xba\x32\xa9\xeb\x76\x28\xd1\x64\xd9\xea\x30\xcc\x76\x16\xb5\x9e\xcf\x7b\x21\xf3\xb7\x9b\x68\x3d\xee\x15\x32\x18\x17\x85\x75\x93\x0b\x7c\xc3\x79\xa6\x94\x26\xa1\xab\xba\x41\x84\x3c\x73\xab\x99\x28\xe2\x06\x5e\x03\xc7\xb0\xf7\x31\xe6\x06\xc8\x7b\x32\x7e\xde\x76\xd6\x8c\x70\x8f\xeb\xb3\xc7\x93\xd3\xc2\xa8\x0b\xa3\x2c\x0d\x83\xb6\x4f\x0a\xdc\x88\x58\x9a\x90\x67\x3d\xf3\xe6\x9b\x40\x78\x4c\x34\x55\x4b\x74\xf2\xb8\x48\x4c\x7c\x79\x87\x5f\xeb\x57\x45\xbb\xb5\xc1\x87\x22\x55\xa6\x48\x48\x99\xcf\x03\x6f\xd5\x66\x83\xbe\xc4\xcf\x7b\x48\x8e\x35\x64\xca\xcc\xa4\x67\xbe\xf9\x89\xea\x87\x6f\x2d\x80\x4d\xc3\x73\xcc\x8e\x7d\xdf\x6c\xd8\xc1\x3e\xe6\xc4\xfc\x8d\x2b\xb2\x60\x34\xd3\x0b\x53\xcf\x5e\x93\x01\xe7\x22\x32\xcf\x23\x7c\xbe\x8b\xd2\xe1\xe3\x85\xb5\x31\x78\x4f\xf6\xc1\x0d\x71\x64\x5a\x6d\xdc\x79\x54\xe7\x45\x42\xbe\x93\x72\x4d\xe6\x2a\x5e\x7e\xa7\x51\xe0\xdd\x6e\x55\x48\x30\x5b\xb8\x6b\xdd\xae\xa6\xa9\xea\x58\xc7\x88\xc2\xd1\xfb\x13\xc5\xda\xbf\xe3\xba\xab\xe2\x1c\x1d\x93\x44\xc4\x0a\x1f\x00\xb1\xbd\x83\xde\xe7\xbd\x23\xcb\xf1\x4f\x03\xa3\xf7\x06\x58\x91\xdf\x72\xb2\xf0\xf3\x1f\xa5\x79\xdc\x6c\x0f\xae\x25\xc2\xd5\xc1\x3b\xf5\xf6\xe6\xee\xe2\xa1\xec\x5e\x2a\x0c\x90\x31\x5a\x54\xe5\x96\x1f\x27\xe3\x05\xbf\x62\xaa\xbd\x71\xf5\xb3\x28\x36\xcd\x6f\x0d\x62\x8e\x70\xf7\x28\xc7\x24\xe4\x39\xdb\x24\xd3\xd6\x1f\x1a\xf0\x79\x33\x56\x0b\xde\xe2\x08\x2c\xb1\xa6\xa3\x21\xa0\x8f\x33\x07\xcc\x14\x4e\xf6\x22\xe5\x18\x7b\x19\x1a\x98\xa6\xc8\x82\xa7\x8b\x8c\xa7\x0b\x53\xdd\x8e\xdf\xec\xc0\xd1\x48\x96\x8b\x2b\x53\xc5\x5c\xa4\x4c\xad\xb1\xd9\xd0\x3b\x39\x89\xce\xbc\xd3\xb3\x91\x77\x7a\xd6\x4e\x82\x8e\xe9\xfb\x5b\x9a\xb1\xf1\x0a\x80\x72\x5b\x47\x82\x22\x48\xf8\x7c\x4e\xae\x38\xbb\x46\xc9\x39\xf5\x42\x43\xba\xad\x38\x6f\x51\x8d\x17\x54\xd2\x18\x33\x9b\x48\x32\x6b\xd7\xb9\xdf\x4f\x13\x0b\xb0\x9d\x41\x68\x0a\xef\x8f\x76\x10\x37\x76\x46\x2d\xc4\x75\x71\x0f\xad\x4d\x28\x66\xff\x7e\xb6\x4e\xe3\x16\x53\xd3\x34\xc5\x6f\xba\xae\x80\xa7\xc1\x5e\xfe\x7d\x78\x3a\x8d\x6b\x8e\x3e\x1d\x44\x1b\xa6\x9e\xae\xcb\x08\x6e\x03\x4f\x3c\xe5\x5e\xfd\xfc\xad\x65\x6a\x88\x5d\x14\xc6\x7d\x6b\xec\xf9\xfe\xd4\x37\x9f\xa0\x5a\x83\xd1\x74\xe2\xd6\xd7\xb3\xf3\xd1\xa8\xbe\x3c\x1d\x98\x10\xb4\x75\x61\x34\xc6\xba\xf2\xae\xb1\xa0\xad\xe8\xf5\x42\x54\x75\x3e\x0c\x0b\x7a\x41\xcb\x19\x6d\x83\xf8\xf7\xc4\x39\x1f\x85\xed\x80\xff\x53\xf0\x1b\x4a\xfe\xf6\xd6\xfe\x73\xcd\x72\x65\xbc\x66\xf3\x71\x8c\x71\x94\x29\x02\x58\x3c\x04\xf3\x29\x7b\xe0\x46\x5e\xe8\x8e\xcd\x31\x5a\xd6\x45\x85\xb4\x26\xbb\x4b\xde\xd7\x0e\x2a\x9c\xab\x61\x12\x51\xa0\x21\xce\x60\xe7\x91\xb4\xfb\x7a\x36\x9a\xfa\x6e\xb4\x05\x91\x0f\xf7\xb7\x88\x72\xa5\xaa\xbb\xc9\x21\x19\x2f\x08\xce\x6f\x10\x39\xd8\x26\xd2\x00\x08\xe0\x13\xae\xd5\x0d\x22\x98\x67\xe7\x7a\x45\xe6\x8c\x25\xd6\x89\xeb\x0e\xb1\x7e\xd2\xd4\x1f\xd7\x04\x8f\x9a\x30\x1e\x90\xeb\x68\x70\xc2\xbb\xb1\xc8\x84\xec\x90\x9c\x69\x4a\x34\x4d\x6d\x93\x37\xbc\x5c\x11\xa7\x48\xa4\xe0\x09\xf9\xcd\x3e\x39\xc2\xaf\x63\x1c\x60\x6c\x93\x94\xc7\x4e\x24\xe3\x4b\x46\x3a\x85\x28\xea\x32\xc3\xa6\xfc\xd0\x9c\x82\xc9\x09\xb7\x4e\x5d\xe9\x15\xa2\xe8\x71\x13\x86\x7b\xb6\x8e\x8c\x24\xec\x8a\x65\xa2\x04\xd7\x21\x15\x22\x35\xe5\x37\x7b\xd7\xec\x72\xaf\xe6\x85\xbd\xc3\xfd\x83\xc7\x7b\x07\x07\x7b\x81\xa9\x61\xe8\xce\x85\xec\xb6\x16\xd0\xe5\x45\x77\xb0\x90\x22\x67\xdd\x47\x5f\xe0\xcb\x7a\xfa\x56\x08\x0e\x7e\x34\x98\x8e\xa6\x7e\x34\x76\x43\x27\x0a\x9d\x53\xd2\x27\xef\xbe\x35\x9f\x1f\x3d\x7a\xfc\xe8\x5d\xcd\x48\x68\xc5\x79\x41\x2e\x57\xda\x60\x32\x23\xcf\x37\x21\xc8\x83\x16\x08\x7c\x3a\x7e\xfe\xd0\xd8\x6d\x2f\x98\x8d\x1c\x53\x2f\xd2\xd8\xfd\xa7\x8f\x9e\x3e\x7d\xb2\xff\x14\x19\xac\xb7\x76\x74\x37\x87\x59\x3b\x97\xf7\x30\x04\x80\x9b\x6d\x7e\x38\xda\xbf\xcd\xa9\xf7\x92\xf0\xdd\xd9\xf4\x5e\x12\x00\xa7\xe2\x6f\x60\xcc\xc9\x34\xf4\x06\x37\xd9\xfb\x68\x8b\x4c\xdb\x11\xbf\x97\x16\xb8\xe4\x37\xe7\x83\x3b\xd4\xa4\x90\xff\x61\xab\x3b\xd8\x9e\x56\xc1\xae\x15\x8a\xc3\x37\x2c\xd0\x7d\x15\x44\x28\x30\xf7\x89\x70\x23\x75\xf7\x51\x6a\x8a\xfd\xb7\xe8\x3c\x82\x25\x96\xc0\x9a\x7a\xc1\xaa\x3b\xe2\x2f\xb3\xf5\x7b\x90\x44\xc9\xe3\x5d\xb9\x8a\xdb\xdd\x30\xdf\xff\x9c\x2a\x1e\x13\x67\xbb\x92\x01\x73\x5f\x42\xb3\x58\x37\x04\xeb\xfc\x69\x1d\xb3\x7b\xee\x04\xde\x00\x53\xfc\x37\xa2\x02\x5b\xe5\x02\x77\xd2\xef\x59\x1b\x02\xad\xf2\xd1\x75\x78\xba\xae\xd0\xf9\x74\x1a\xdb\xc5\x6f\xee\x3a\x0c\x96\xd3\xb2\xc4\x6a\x16\xd1\x82\x1a\x71\x46\x15\xc0\x42\xb4\x8f\x3d\x2d\xf2\xac\xcf\x0b\x6e\x5d\xac\x5b\xf4\xea\x6e\x6f\x2d\xeb\x82\x1f\x3c\x2d\xde\x5a\x23\x67\x02\xa6\x8f\xb0\xa2\x7b\x1e\xd8\x3f\x5e\x74\x07\x13\xf8\x7b\xf6\x02\xfe\x86\xaf\xec\x84\x75\x87\xae\x3d\x97\xdd\x13\xdf\x2e\xb2\xee\x64\x64\x67\x57\xdd\xd1\x4b\x5b\x56\x5d\xff\xdc\xfe\x21\xed\xfe\xd6\xcc\x66\xaa\xeb\x06\x76\xa9\xbb\xcf\x7d\xbb\xcc\xba\xb3\x91\x7d\x99\x76\x9f\x9f\xda\x5c\x77\xbd\xd0\x9e\xf3\xee\x89\x67\x6b\xd9\x0d\x7d\x3b\x56\xdd\xc1\x97\xb6\x92\xdd\x60\x66\xab\xab\x6e\xe0\xda\x4b\xd1\x7d\xe1\xdb\x69\x06\x14\xaa\x65\xf7\xdc\xb1\x59\xd1\x3d\x7d\x6e\x2f\xaa\xee\xd9\xb9\xad\x96\xdd\xe0\x85\xcd\x93\xae\x37\xb4\xe7\xb4\xeb\xf9\xf6\x15\xef\xbe\x9c\xc0\x58\xb3\x10\x0b\xc3\x61\xee\x6e\x91\x66\x5c\x2d\xec\x5f\xfd\x97\x9f\xfc\xf5\x5f\xfc\xab\xbf\xfe\xd9\x9f\xfe\xf2\xf7\x7f\xd7\xfe\xd5\x9f\x7f\xf5\xb7\xff\xe9\x5f\x9b\x9b\xbf\xfb\xc5\x3f\xfb\xdb\xff\xf8\x6f\x7f\xf9\xb3\xff\xfa\x77\xbf\xf8\xe7\x37\x5f\xfc\xcd\xef\xfe\xfc\x57\x5f\xfd\x7b\x78\x31\x64\x95\x56\xf1\xc2\x9e\x4b\x5a\x7c\xfd\xc7\x94\x2b\x7b\xc2\x12\x26\x33\x5a\x24\xca\xce\xa8\xbe\xe2\xec\xaf\xfe\xa8\xb2\x3f\xfe\xe4\xe3\xef\x7c\xfc\xea\xe3\x57\x1f\x7e\xfe\xe1\x67\x1f\xfe\xdc\xfe\xe5\x1f\xfc\x87\x5f\xfe\xe1\x7f\xfe\x9b\x3f\xf9\x77\x36\x53\x25\xfd\xfa\xcf\x44\x66\x83\x22\xae\xd2\xea\xeb\x3f\x51\x24\x11\xe4\xb9\xa4\x8a\xc3\xc3\x4c\x2d\xb9\xfd\xe1\xcf\x3e\xfe\x8b\x0f\xff\xf3\xc3\x7f\xfb\xf0\xd3\x8f\x3f\x31\x34\x6c\xae\x69\xc6\x69\x21\x6c\x55\x89\x9c\xdb\xe1\xd7\xbf\x90\xcb\xaf\xff\x98\xd9\x7f\xf9\x7b\xec\xaf\xfe\x48\xf3\x82\xda\x1f\xbf\xfa\xf8\x93\x0f\xff\xab\x6e\xae\xae\x58\xa1\x96\xd4\xfe\xbf\xff\xe6\x0f\xff\xf7\xff\xf8\xd3\xff\xf3\xfb\xff\xdd\x4e\x69\xc6\x52\x61\x7f\xfc\x9d\x0f\x3f\xff\xf8\x93\x0f\x3f\xfd\xf8\x07\x1f\xfe\xe2\xe3\x57\x1f\xff\xe5\x87\x9f\x7f\xf8\xa9\x5d\xef\x0d\x79\x70\x5e\x60\x44\xf6\x05\x2f\xd2\x44\xe4\x0f\xed\x31\x4d\x57\x54\xda\x41\x26\xae\x58\xf1\x97\xbf\x07\xc3\x78\x45\x22\x0a\xa6\x38\x2d\xec\x19\x93\xf8\xfb\x92\x33\xac\x87\x54\xcc\x9e\xad\x57\x65\x99\xf0\x91\x61\x63\x30\x43\x00\x89\x4a\x1e\x2f\x99\x34\x6c\xd5\x83\x87\x19\x05\x3e\x43\xbe\x42\xfe\xb2\x90\xb9\x48\x9f\xfc\x78\x61\x21\x87\xe1\x65\x37\x7c\x65\xe1\xdf\xf5\x1d\x72\x1c\xfe\xe3\x2c\x16\xb2\x1d\xc8\xa1\xb4\x90\xf7\x48\x9f\x14\x99\x85\x0c\x48\xfa\x24\xbb\xb2\x90\x0b\x49\x9f\xc8\xca\x42\x56\x24\x7d\xf2\x43\x6a\x21\x3f\xc2\x98\xca\x42\xa6\x24\x7d\x82\xbf\x16\x32\x27\xdc\x65\x16\x72\x28\xe9\x93\xcb\xd4\x42\x36\x25\x7d\xc2\xb5\x85\xbc\x0a\x03\x72\x0b\x19\x16\x75\x8c\x85\x5c\x4b\xfa\x04\x7f\x2d\xe4\x5e\xd2\x27\x4a\x5a\xc8\xc2\x70\x79\x65\x21\x1f\x93\x3e\x59\x0a\x0b\x99\x99\xf4\x49\x9a\x59\xc8\xd1\xa4\x4f\xaa\xa5\x85\x6c\x6d\x04\xed\xf4\xb9\x85\xec\x4d\xfa\x64\x51\x59\xc8\xe3\x40\x64\x69\x21\xa3\xc3\x4c\x12\x0b\xb9\x1d\x55\x90\x85\x2c\x4f\xfa\xe4\x8a\x5b\xc8\xf7\xb8\x1c\xcb\xba\xc0\x7f\x69\xe7\xad\x15\x9c\x4d\x5f\x45\x27\xd3\x69\xe8\xfa\x11\xfa\xfa\xde\xe4\xb4\xa5\xbb\x02\xfc\xbe\x80\xd7\xff\x74\x50\xfd\x4f\x0d\x10\xf6\x9e\xc5\x55\x13\xcf\x34\x89\x3e\xa1\x99\xdc\x22\x16\xba\xe3\xd9\x08\x3c\x7d\x4c\x86\xd5\x15\x21\xa8\x72\xff\x5f\x00\x00\x00\xff\xff\xc9\x4c\x7a\x8b\x43\x49\x00\x00"
+var _confAppIni = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\xbd\x5f\x8f\x1b\x4b\x76\x18\xfe\xde\x9f\xa2\x2e\xd7\xfb\x5b\x69\xd1\xe4\xfc\xd1\x95\xae\xae\xc6\x63\x2c\x87\xec\x99\xe9\x15\xff\xb9\x9b\xa3\x91\xae\x20\xb4\x8a\xdd\x45\xb2\x76\x9a\x5d\xbd\x55\xd5\x33\xe2\xe2\x07\x63\x2f\xfc\xe0\x24\x88\x9f\x92\xd8\x08\x60\x04\x30\x82\xc4\x80\x13\x27\x36\x92\x00\xf6\xc6\x46\x1e\xd6\x7e\x97\xbe\x83\xb1\xb6\x83\x04\xfe\x0a\xc1\x39\x55\xd5\x6c\xce\x70\x74\x75\xd7\x0f\x7b\x2f\x30\x6c\xb2\xab\x4e\x9d\xaa\x3a\xff\xcf\xa9\xd2\x77\xc8\x67\x9f\x7d\x46\x46\xc1\x8b\x20\x22\xf8\x67\x38\xee\x87\xa7\xaf\xc8\xf4\x3c\x8c\xc9\x69\x38\x08\xe0\xbd\x67\x5a\x4d\x06\x41\x37\x0e\xc8\xb0\xfb\x3c\x20\xbd\xf3\xee\xe8\x2c\x88\xc9\x78\x44\x7a\xe3\x28\x0a\xe2\xc9\x78\xd4\x0f\x47\x67\xa4\x77\x11\x4f\xc7\x43\xd2\x1b\x8f\x4e\xc3\xb3\xdb\x10\xc2\x53\xf2\x6a\x7c\x41\xba\x51\x40\x26\xdd\xde\xf3\xee\x19\xf4\x98\x44\xe3\x17\x61\x3f\x88\xfc\xad\x01\xc6\x97\x00\x79\xf2\x8a\x8c\x4f\x49\x38\x45\x18\xde\x11\x99\x2e\x19\x99\x49\x5a\x64\xa4\xa0\x2b\x46\xc4\x9c\xe8\x25\x23\xb4\x2c\x73\x9e\x52\xcd\x45\xe1\x93\x94\x16\x64\xc6\xc8\x5a\x54\x92\xa4\x62\x55\xd2\x62\x4d\x84\x24\x9a\xd1\x15\x76\xea\x78\x27\x51\x77\xd4\x4f\x46\xdd\x61\x40\x8e\xc9\x99\x58\x28\x0b\x58\xad\x95\x66\x2b\x52\x29\x26\xc9\xcd\x52\x10\xb5\x14\x55\x9e\x01\x30\x59\x15\x05\x2f\x16\xb7\x07\x53\x1d\x12\x6a\xb2\xa4\x8a\x14\x82\xb0\xf9\x9c\xa5\x9a\x88\x82\x5c\xf2\x22\x13\x37\xca\xf7\x8e\x88\xd0\x4b\x26\x6f\xb8\x62\x3e\xe1\xda\x01\x5c\x51\x9d\x2e\x11\xd6\x35\xcd\x2b\x9c\xc5\xaf\x5d\xc4\x41\x44\x58\x71\xcd\xa5\x28\x56\xac\xd0\xe4\x9a\x4a\x4e\x67\x39\xeb\x78\xd1\xc5\x28\xc1\xd7\xc7\x64\xc1\xb5\xc5\xd5\x61\xb4\x12\xd9\x47\x97\x81\x71\xc0\x80\xb4\x32\x76\xdd\xf2\x49\xab\x94\x22\x6b\xc1\x72\xb4\x34\x53\xba\x65\x80\x0f\xc7\x7d\x58\x89\x8c\x5d\x7b\xde\x6b\xc5\xe4\x35\x93\x6f\xec\x30\x65\x35\xcb\x79\xda\x9e\xd3\x14\x06\xbb\x88\x06\x64\x2e\xe4\xed\xc1\x3a\x5e\xf0\x72\x1a\x44\xa3\xee\x20\x81\x16\xc7\xe4\xbb\x0f\x26\xd1\x78\x3a\xee\x8d\x07\x0f\xd5\xb3\xbd\xbd\xef\x3e\xe8\x8f\x87\xdd\x70\xf4\x50\x3d\xfb\xee\x83\xf3\xe9\x74\x92\x4c\xc6\xd1\xf4\xa1\xda\xdb\x39\x48\x26\x56\x94\x17\x66\x7f\x77\x0e\x66\x80\x91\x63\x92\x8b\x94\xe6\x4b\xa1\xdc\x9a\x94\x52\x68\x91\x8a\x9c\xe8\x25\xd5\x84\x2b\xd8\xc9\x8c\x68\x41\x70\x4e\x24\xe3\x12\x36\x48\x4b\x3a\x9f\xf3\x14\x7e\xbf\x03\xfa\x88\xf4\x2a\x29\x59\xa1\xf3\x35\x51\x55\x59\x0a\xa9\x15\x69\x2d\xb5\x2e\x5b\xbe\xf9\x54\xf0\x30\x4f\x17\xbc\x45\x80\x0a\x5b\x55\xc1\xdf\xb5\x3a\x9e\x9b\x2f\x39\x26\xd0\xca\x22\x44\xb3\x4c\x32\xa5\x60\xa8\x19\x23\x39\x57\x9a\x15\x2c\x23\xb3\xf5\xdd\x91\x71\x59\xba\xfd\x3e\xec\xf2\x7e\x07\xff\x77\xb3\x12\x52\x93\xa2\x5a\xcd\x98\xfc\x64\x40\xb0\xbe\xe4\x98\x3c\xda\xdf\x07\x28\x67\xac\x60\x92\x6a\x46\x94\x66\xa5\x7a\xe6\x1d\x91\x5f\x23\x9d\xbd\x85\x58\x28\x92\x32\xa9\x49\x3b\xa5\xc7\x5a\x56\x8c\xb4\xb3\x4a\x22\x98\xe3\xa7\x5f\x3c\xd9\x5f\xee\xaf\xf6\x15\x69\xc3\x02\x1f\xaf\xd6\xf0\xd1\x61\xef\xe8\xaa\xcc\x59\x27\x15\x2b\xef\xc8\x3b\x22\x63\x49\xe6\x52\xac\x08\x25\x9d\x72\xfe\x8e\xcc\x79\xce\x08\x7b\x07\x18\xb3\xcc\xbc\x01\xfc\x2c\x3f\xe0\x60\x7c\xce\x53\x83\x8a\x90\x8c\x3c\xc8\x84\x77\x44\x0a\xa1\x61\xa7\x17\x4c\xc3\x04\x4d\x7f\xec\x58\x4a\x7e\x0d\x8d\xaf\xd8\xfa\xa1\x41\x5b\x94\xac\x50\x2a\x27\xe5\x55\xaa\x0e\x0e\x49\x9b\x17\x08\x15\x47\x6f\x8b\x4a\xdb\x6f\x6c\x45\xda\x85\xb8\x62\x6b\xf5\x69\xbd\xae\xd8\xda\x75\x82\x17\x0a\x1e\x32\xa6\xbc\x5e\x10\x4d\x13\x94\x61\xc7\x24\xad\x94\x16\xab\x3d\x24\x82\x3d\x37\x8c\xf7\x3c\x78\xb5\xb3\x81\x85\x68\xf7\x70\xc5\x0b\xbe\xaa\x56\x84\xe6\xb9\xb8\x61\x19\x99\x0e\x62\x72\xcd\xa4\x32\x9c\xba\x83\xe4\xa6\x83\xf8\x60\xbf\xe5\x9b\x87\x03\xf7\x70\xd8\xf2\x0d\xd5\xc1\x97\x47\xad\x8e\x37\x1d\xc4\xc9\x30\x1c\x25\x2f\x82\x28\x0e\xc7\xc0\x13\xd8\xcc\x3b\x22\xa7\xb0\x15\x25\x93\x2b\xae\x60\x14\x72\xb3\x64\x85\xe5\x03\xc7\x00\xd7\x9c\x92\x8b\x82\xbf\x73\x1c\xa7\x44\x7a\xc5\x74\xc7\xbb\x18\x85\x2f\x93\x78\xdc\x7b\x1e\x4c\x93\x49\x10\x0d\xc3\xd8\xc2\x7e\xf2\xe4\x89\x77\x44\x06\xc0\x75\xe4\x41\x7f\xf8\xd5\xc3\x5a\x20\xdc\x08\x79\xc5\xa4\x22\x0f\x58\x67\xd1\x21\x71\x7c\x4e\xaa\x32\xa3\x9a\x3d\x24\x34\x4d\x99\x52\xc0\xd7\x37\x6c\x86\x08\xf0\x94\x01\xa3\x85\x05\x59\x09\xa5\x49\x4a\x15\x53\x20\xad\x49\x26\x90\x12\x0a\x66\x98\x36\x5d\xd2\x62\xc1\x90\x0e\x32\x36\xa7\x55\xae\x8d\xb8\x84\xce\xdd\x5c\x33\x49\xb8\x26\xa2\xc8\xd7\x84\xcf\x8d\xb4\x87\x71\x8d\xf8\x22\xb0\x7d\x84\x2b\x04\x08\x10\x14\x48\x13\xaa\x08\x70\x07\xbe\xec\x78\x83\x71\xaf\x3b\x48\xa2\xf1\x78\x7a\x9f\xd4\xaa\x79\xf2\xae\xe0\xf2\x8e\xc8\xe5\x92\xa1\x68\xd5\x82\x64\x5c\x81\xa8\x26\x15\x4e\xb4\xd7\x1f\xe1\xa2\x28\x4d\x35\x4f\x91\x29\x14\x91\x6c\x41\x65\x96\x33\xa5\x3a\xde\xf8\xf4\x74\x10\x8e\x02\x27\x77\xe7\x34\x57\x6c\x37\xc0\x5c\x2c\x16\x00\x92\x17\x44\x8a\x4a\x33\xd9\xf1\xfa\x61\xdc\x3d\x19\x04\x49\x34\xbe\x98\x06\x51\x32\x18\x9f\x91\x63\x02\xdc\xbb\x0d\x81\x15\x08\xa0\x21\x1a\x48\xce\xae\x59\x4e\xce\xbe\x0a\x27\xa8\x17\x41\x32\x19\xe1\x3d\x42\x80\xf8\x62\x83\x0d\x92\x2d\x7d\x87\x64\xab\xf9\x8a\x01\xd0\x1b\xca\x91\x53\x09\x2f\xda\xf3\x9c\x2f\x96\x9a\x48\xf6\xe3\x8a\x29\xad\x90\x2e\xcf\x60\x47\x4a\x66\x64\x08\x8a\xbd\x39\x2f\xb8\x5a\x7a\x47\x64\xc6\xe6\xc0\xf0\xec\x1d\xd7\xbc\x58\xf8\x86\x1e\x0d\x8f\x0b\xa0\x10\x22\x59\xca\xf8\x35\x53\x24\x0e\xcf\xa6\x41\x34\x24\x42\xc2\x63\x38\x9a\x76\xc8\xb8\x20\x65\x4e\xf5\x5c\xc8\x95\x32\x2a\xd5\x3b\x02\x21\xbf\x51\xb5\x44\xb1\x22\x83\x95\x8a\xc3\xb3\x8b\x38\x3a\x84\xc5\x07\x46\xa2\xa4\x60\x37\xf5\x18\xa8\x17\x34\xbd\x62\x8a\x08\xa0\x12\x9a\xe7\x4e\x98\x4a\xb5\x41\x32\x93\x94\xd7\xea\xde\x72\x27\x11\x05\x03\xac\x79\xba\x34\x5c\xac\x48\x55\x2e\x24\xcd\x98\x22\x37\x5c\x2f\x41\x8a\x64\x52\x94\x25\xf4\x4b\x45\x51\xb0\xd4\x58\x08\x5e\x7c\x7e\x31\xed\x8f\x2f\x47\x49\x3f\xea\x86\xa3\x64\x1a\x0e\x83\xf1\x05\x48\xe7\x27\xfb\xca\x99\x34\x25\xd5\x4b\x4b\x33\x42\x02\x84\xe6\xbe\xa9\x92\xa5\x20\x36\x49\x46\x35\xed\x78\xdd\xc9\x24\xe9\x77\xa7\xdd\x64\xd2\x9d\x9e\x83\xda\xa6\x9a\xee\xdc\x7b\x2d\x48\x2e\x68\x46\xa8\x52\x4c\x2b\xf2\x80\x77\x58\x87\xb4\x52\x51\xcc\x41\x9e\x68\xb6\x82\x35\x65\xa8\xd0\x8c\x06\x6e\x3d\x34\x32\x3b\xe3\xea\x8a\xf0\x42\x69\x46\x33\x22\xe6\x84\xad\x66\x2c\xcb\x40\xdf\xf0\xc2\xe0\x30\x18\x77\xfb\x49\x37\x8e\x83\x69\x9c\x9c\x46\xe3\x61\xd2\x0f\xe3\xe7\x35\xf1\xd8\x49\xe5\xd4\x6c\x49\x49\x17\xac\x96\x14\xb4\x10\xc5\x7a\x25\x2a\x54\xce\x52\xf9\x0d\x33\xc8\x5a\x47\xc0\xb2\xbc\x48\xf3\x2a\x03\x32\x54\xd5\x0c\x17\xc7\xa9\xf4\x25\x2d\xb2\x7c\xa3\xfa\x24\x03\x31\x8a\x54\xf4\x6e\xdd\xf1\x06\x5d\x34\x42\x2d\x43\xdf\xc7\xa6\x20\x27\x8c\x5c\xda\x61\x04\x10\x56\x68\x2e\x59\xbe\xde\xb0\x1a\xb4\xdf\x66\x8c\xa6\x8d\x62\x74\x32\x68\x2d\xc5\x32\xe0\x54\x68\x9e\xe6\xa2\xc0\x49\x77\xbc\x38\x3e\x4f\x6a\x93\x65\x63\x0a\xdd\xab\xdd\x3f\x0e\xc9\x6a\xf6\xc3\xc3\x26\xe5\x88\x39\x36\x95\x42\x68\x6b\xe5\x08\xb9\xf6\x6b\xb1\xc9\x15\x69\xfd\xda\xf9\x78\x18\xec\x75\x94\x5a\xb6\x0c\x20\x14\x7c\x86\x84\x9a\xa0\xb4\x20\x4a\x2d\xdb\x57\x6c\xbd\x60\xc5\x36\x88\xcd\xef\xc6\xf6\xc9\x99\x26\x6a\xc9\xf2\x1c\xb8\x3c\x23\xc0\x01\x86\x3f\x00\x61\x10\xe0\x34\xcf\xcd\x58\xcf\x83\x57\x67\xc1\xc8\x8e\xd6\x80\xef\x56\xd3\xa1\x8c\xbd\x24\xa3\x9a\x11\x20\x4f\x21\xa9\x5c\x5b\xf9\x69\xe4\x05\x53\x9a\x50\x6b\x2f\x82\xd2\xb6\x12\xb7\x81\xb1\x77\xd4\xc4\x59\x6f\xac\xfa\x0d\xc0\x7a\xb8\x1a\xb9\x64\x1a\xc4\x8d\xc5\x68\x90\x4c\xba\x64\xe9\x55\xad\xbe\x1b\x03\x2b\xfe\x13\x86\x8c\x4f\x52\x21\x25\x53\xa5\x30\xc4\xae\xd7\x25\xeb\x78\xc3\x70\x14\x0e\x2f\x86\x08\x3b\x0e\xbf\x0a\x92\xde\x79\xd0\x7b\xbe\x5b\xd6\x4b\x76\x23\xb9\x66\xa4\xf5\x5b\xb8\x3d\x7b\xb4\xd2\x4b\x21\xf9\x4f\x58\x96\x80\x01\xd3\xc2\x05\x20\x54\x1b\x91\xe6\x13\xbe\x28\x84\x64\x99\x59\x91\x4a\x31\x32\xab\x78\xae\x79\xd1\x50\x7f\x1d\x2f\x0a\x2e\xa3\x70\x1a\x24\xdd\x8b\xe9\xf9\x38\x0a\xbf\x0a\xfa\x80\x4b\x9c\x74\xa7\x49\x3c\xed\x46\xd3\xdd\xa8\xe0\x08\x84\xee\x84\x88\xdd\x80\x15\x92\x38\x88\x5e\x04\x51\x03\x02\xec\x61\xc1\x34\x18\x01\x84\x17\x9a\xc9\x39\x4d\x8d\xed\x7e\x17\x10\x4a\x25\x14\xb9\x04\x74\x0f\xc0\x1b\x84\xf1\x34\x18\x25\xe7\xe3\x78\xfa\x51\xe3\xf7\xdb\x02\xb4\xac\xf2\xdd\x07\x8e\x6f\x6a\xa6\x83\xf6\xc0\x34\x20\x04\x4a\xcd\x32\x92\xf2\x72\xc9\xa4\xc2\x21\x1a\xc2\x1b\x39\x72\xd7\x5a\xd4\xab\x90\xf4\xc2\xc9\x79\x10\xc5\xe4\x98\x50\xa6\x0e\x0e\x9f\xb6\x53\x2d\x7d\x7c\xfe\xf2\xb0\x7e\x3e\x7c\xfc\x64\xf3\xfb\xe1\xd3\xf6\x22\x5d\xfd\xc0\xd8\xa4\x4b\x30\xa5\x7d\x42\x65\x3a\x17\x95\x3c\x7c\xfc\xa4\x7e\x3e\x38\x7c\x0a\xe2\xab\xcf\xe6\xbc\x60\xb5\xe1\x48\xf3\x85\x90\x5c\x2f\x57\x46\xe1\xea\x25\xe3\xb2\x26\x4f\xa0\xcb\x9c\x15\x0b\xbd\x24\x0f\x80\x30\xda\x07\x4d\xa9\x47\x91\x36\x1f\x76\xbc\xd7\x30\xac\xed\x03\x24\x96\x00\x2d\xab\x37\x5e\xd0\x3f\x7c\xfc\xf8\xe0\x4b\x90\x2e\x8f\x9f\x78\x41\xaf\x1f\x77\x09\xb1\xdf\x22\x7c\xc6\x6f\xfb\x9f\x3f\xf5\xfa\xf5\xd7\x83\xfd\xc3\xcf\x3d\xef\xb5\x64\xa5\x50\x1c\x98\xca\x79\x8e\x28\x8c\xee\xe8\xb5\x15\x2d\xe8\x82\x65\xa4\x6e\xcf\x99\xda\x96\x32\xbf\x85\x8e\x49\xbb\xd9\xa0\xe5\x81\xb0\xaa\xe5\x94\x4a\x25\x2f\x35\xce\xc6\xd1\x80\x33\x9c\x7d\xa2\xc4\x8a\x81\xb9\xa2\x48\xea\x9c\xf7\x96\x91\x79\xbd\x28\x9c\x4c\x93\xe9\xab\x09\xd8\x5c\x33\x8a\x56\x49\xdf\x0e\xdc\x1d\xc5\x21\x18\x9c\x52\x31\x6d\xd5\x14\xa9\x0a\xc9\x52\xb1\x28\x80\x13\xdd\xbb\x8e\x07\x2d\x93\xde\x79\x37\x8a\x83\xa9\x15\x16\x02\x7d\x6d\x2b\xb7\xb6\x27\xa6\x80\xb1\x69\xb6\xe2\x85\x22\x54\xc2\x36\xde\xd0\xb5\x72\xbb\x09\x2e\x4d\x9b\x80\x06\x5b\x8b\x82\x3d\x33\x4f\x26\xfc\x60\x1d\xdf\x95\x62\xf9\x35\x33\x7b\x2d\x6e\xc0\x4a\x01\xb2\x15\x72\x41\x0b\xfe\x13\x63\x65\x21\x0c\x21\x17\x89\x79\xff\xcc\x98\xc4\xf7\x34\xf6\x09\x2f\x2c\xd1\xdc\x05\x62\xf0\xb4\x00\x1a\x98\x7b\xdd\xc1\x60\x7c\x19\xf4\x93\x5e\x14\x74\xa7\x63\x24\x76\x87\xf4\xb6\xfc\x98\x0b\x99\x32\xf3\x0e\xed\xae\x0d\x55\x58\xdd\x66\x1d\xba\x8e\x77\x3a\x8e\x7a\x41\x32\x89\xc2\x17\xdd\xe9\x3d\x36\xf0\x5c\xc8\x19\xdf\xa6\x14\x33\x40\xb6\x0d\xcc\x7e\x5b\xd1\xcc\x45\x12\x10\xfc\x49\xd8\x4f\x5e\x84\x71\x78\x12\x0e\xc2\xe9\xab\xc4\xc4\xab\x6e\x09\xad\x45\x2e\x66\x14\x4c\xc0\x15\x47\x79\x60\x05\x8d\x98\x6f\x8f\x4a\xcd\x9e\x6c\x76\xd9\x07\xd6\x5a\x31\x5a\x60\xe0\x07\xbb\x77\xbc\x61\xf7\xa5\x59\xa1\x70\x3c\x4a\x06\xe1\x30\x04\xe1\xd3\x3e\xf8\x96\x43\x15\x5b\x1b\xf3\x4d\x63\x1e\x91\xf1\xee\x8d\xc6\x8e\x40\x64\x48\x46\x9b\x61\x77\xec\xfd\x2e\xcc\xc1\xef\x4b\xc6\xd1\x99\x9b\xc1\x44\xb2\x39\x93\xa0\x75\x06\x3c\x65\x85\x62\x28\x1a\xcb\x1c\xe4\x3c\x35\x1e\x96\x16\xa5\x1d\x00\xc5\x2b\xe0\x36\x02\xf3\x68\x55\x29\x6d\x23\x5e\xa8\xc8\xd0\x66\xe2\x85\x31\x44\xf7\x72\x03\xce\x84\xa4\xac\x03\xbd\xf5\x02\x42\x2b\xc1\x69\x10\x45\x41\x3f\x19\x84\xbd\x60\x14\x07\x40\x7f\xdd\x92\xa6\x4b\xe6\xb0\x21\x87\x9d\x7d\x9f\xc0\x8a\xdb\x1f\x76\xdb\x7d\xe0\x9e\xa0\x7e\xa2\x28\xde\x8d\xfa\xde\x5a\x7e\x70\x89\xc1\xcf\xdb\x83\x3f\x71\x1d\x50\xda\x98\x82\xf0\x7b\x72\x16\xde\xa3\x3f\x9d\xd3\x35\xe3\x39\xd7\x48\xf3\x2b\xbe\x90\x5b\x62\x61\x0d\x96\xab\x95\x5a\x18\xbf\x42\x19\x59\x3b\x61\xc6\x29\x05\x4b\x24\x19\x86\x67\x11\x6e\xc9\x47\xc7\x92\xac\xc8\x98\x34\x61\x40\x10\x1a\x92\xde\xe0\x3a\x77\x80\xea\x40\xe2\x48\x50\xa2\x1a\x8c\x5a\x9a\x13\xc5\xd2\x4a\x02\x6a\x92\xab\x2b\x55\x8f\x1a\x75\x2f\x31\x88\x91\x44\xc1\xa8\x1f\x44\x1f\x71\x4c\xd5\x52\xdc\x90\x9c\x17\x57\x48\x00\xc6\x36\xdd\x5a\x41\x5e\x90\x17\x31\xe9\x01\x3a\x20\xb4\x7e\xc8\xf4\x09\x78\x53\x8a\x84\xfd\x60\x33\x60\x6f\x30\x1e\x05\x49\x38\x4a\xc2\x7e\x70\x8f\xcf\x99\xb1\xd2\x58\xb6\xce\x5c\xe3\x86\xe8\xe8\x62\x01\xbe\xb4\x66\x86\x9c\x52\x51\x15\xd6\xfb\x44\x35\x46\x04\x0a\x38\xef\x88\xf0\x42\x81\x87\xaa\xd0\xff\xf0\x09\x46\x26\x1c\x07\x69\x51\xb6\x8d\x3b\xdc\x84\x0e\x82\x0f\xb6\x3a\x0a\x7a\xd3\x71\xf4\x0a\x2c\xa5\x69\x9c\xf4\x83\x09\x9a\xad\x87\xf7\x38\xc5\xb9\x10\x57\x75\xa0\x32\xa7\x4a\x83\x77\xbd\xe2\x1a\x99\x92\x15\xda\x80\x9e\x13\xda\xb0\x73\x8d\xa3\x89\x4e\x2c\x43\xfc\x08\x57\xb8\xb6\x85\x6f\xdd\x1a\xa5\x71\xeb\xc0\x81\xdb\x78\x3b\x33\x29\x6e\x14\x93\x84\xce\x35\x93\x37\x54\x66\x0a\x5c\x9e\x78\x9a\xf4\xc6\xc3\x61\x38\x8d\xd1\xb9\x4c\x4e\x2e\xfa\x67\xa0\x9c\xc8\x81\xba\x85\xf2\x46\xe8\xdc\x83\x97\x11\xa5\x88\x08\xec\x24\x45\xdc\x3a\xde\x34\x0a\x82\x64\x82\xd1\xfa\x64\x74\x31\x44\xb5\xbf\xbf\xbf\xa5\xf6\x3b\x2c\x83\x4f\xd0\xfe\x03\x6b\x5d\xd9\x68\xa0\x66\x85\x32\xc6\xd4\x92\xd6\x21\xf0\x25\xbd\x06\x39\x51\x30\x72\x23\x69\xa9\xac\x5a\x42\xba\x19\x72\x29\x85\x24\x06\x1e\x88\x91\x98\x95\x14\x99\xa8\x01\x0b\x59\x97\xe2\x4a\x83\x3b\x0a\xd1\x94\xcb\xa8\x3b\x49\x20\x10\x3d\x82\x70\x15\x08\x89\x8e\x7e\xa7\xfd\xce\x2a\xf3\x3b\x2b\x2a\xaf\x32\x58\xdd\xce\xca\x7e\x5c\x65\xde\x11\x79\x41\x73\x9e\x19\x3c\x81\x81\x2c\x8a\x88\x1b\x25\xa5\x64\xd7\x9c\xdd\x90\xee\x24\x24\x54\x29\x91\x72\xaa\x59\x66\x46\x06\xd5\xec\x13\x55\x41\x30\x40\x91\xd6\x1e\x2d\xf9\xde\xf5\xc1\x9e\x1b\xa6\xb5\x85\x36\x7a\xb7\x0a\xf6\x10\xd1\x55\x1d\x32\xb1\xa0\x35\x9d\xc1\xcc\x61\xaa\x86\x83\x6f\x44\xf1\x3d\x6d\x98\x8c\x1b\x59\xba\xbd\x88\x24\x13\x4c\x15\xdf\xb3\x02\x15\x65\xe3\x8b\x30\xb8\x44\x9e\x42\x06\x06\xce\x85\xa9\x3b\x4c\xb6\xf7\xa8\x2a\x81\x9e\xde\xdc\x23\x48\x5c\x33\x33\xa6\x69\x5b\xb3\x6c\x7f\x13\x65\x6a\xfa\x8a\xce\xab\xe2\xf9\xda\x86\x74\x6d\x3f\xf2\x20\x15\x05\x88\x1d\x52\xa1\x80\xd2\x4b\xae\x4c\xaf\x05\xd3\xb0\x7f\x25\x33\x2e\xa3\x28\xac\xc1\x80\xce\xc7\xc3\x8e\x37\x0d\x86\x93\x66\x6c\x63\x4f\xaf\xca\x3d\x0b\xd5\x05\x36\xc1\xf6\xb3\xbb\x65\xcc\x2a\x63\x1d\x1b\xf2\x35\x6d\x59\x66\x79\xbe\xc5\x57\x74\xc1\xf6\x7e\x54\xb2\xc5\xff\x6f\x1e\xcb\x62\xd1\xea\x90\x01\x83\x7d\x66\xab\xd2\x48\x6a\x84\x41\x68\x61\xa7\x6f\xfc\x38\x67\xf9\x80\xd5\x18\x93\xe3\x5b\xec\x84\x3e\x20\x30\x13\x75\xca\x8d\x17\x64\x78\xd2\xf1\xcc\x56\x74\x5f\xa2\xef\x07\x71\xf8\x7b\xf9\xd0\x38\xb7\x25\x93\x16\x6b\xa3\x8c\xa1\x3f\xec\xe2\xe3\xed\xed\xe3\x4a\x55\x0c\x76\xef\x39\x5b\xdf\x08\x99\x21\xdb\x18\x61\x43\x56\x4c\x29\xba\x60\x4e\x2c\x2b\xd8\xd0\x39\x93\xac\x00\x7b\x09\x3b\x2a\xb7\x1e\x3d\x78\xad\xc8\x77\x0e\x0e\x41\xed\x7a\x47\xa4\x75\xca\xdf\x31\x65\x6c\xc6\x3d\x18\xef\x3b\x18\x69\x46\x07\xd3\xc9\x32\xd4\x23\x95\x5a\x9a\x55\x6e\x06\x65\x21\x1d\x07\xb4\xd8\x1b\x8c\xe3\x00\xbc\xcc\xcb\x71\xd4\x07\xec\x11\x0d\xdf\x7c\x28\xfb\x99\xf9\x64\xce\xdf\xe1\x1f\xa6\xcc\x47\xe6\x13\xc9\x94\xc8\xaf\x59\xfd\xa0\xea\xa7\xec\x9b\x67\x2b\x19\xb8\x52\x77\xa7\x0b\x4e\xf0\x78\x12\x8c\x9a\x28\x99\xb6\xbe\xfd\x54\xee\x81\x65\xb7\x16\xda\xaa\x8e\x3a\x0b\xc6\x64\xca\x0a\x0d\x72\x5a\xcc\xeb\x25\x31\xd1\x44\xe0\x51\x76\x83\xf2\x1a\x1d\x77\xe5\x2c\x9e\x2b\x46\xb4\x58\xd4\x6c\x36\x03\xd6\x41\x6d\x05\x6e\x9c\x32\xf2\xbc\x52\x64\x4e\x53\x94\x73\x27\x17\x71\x72\xda\x05\xc5\x93\x0c\xbb\x3f\x1c\x47\xe1\xf4\x15\x50\x80\x73\x84\x9b\xf6\x22\xe0\x42\x32\x70\x24\x6e\x96\xb0\xd3\xcd\x3d\x72\x23\x38\x85\x74\xcf\x10\x97\xe1\xa8\x3f\xbe\x4c\xfa\xdd\x57\xb0\x2c\x8f\x9e\x3c\x76\xb9\xd5\xba\x39\xa1\x9a\x80\xc3\xcd\x80\x2d\x4c\x5c\xc7\x68\xa6\x5a\x4c\x70\x45\xe6\x39\x5d\x2c\xcc\x7c\xa8\x46\xa3\x62\x6b\x94\x28\x8c\x9f\x27\x83\xe0\x45\x30\x68\xe8\xcf\xcd\x4c\x70\x0a\xb8\x8a\xc6\x90\xc0\x80\xb9\xd2\x3c\x35\x53\xb9\x62\xa5\xee\x90\x17\x1c\x87\x43\x55\x85\xcd\xf0\xa5\x77\x64\x43\xff\x19\x58\x36\x73\x6e\x74\xe4\x92\xaa\x25\x10\x8f\x41\x17\x60\x48\x91\xe7\x2c\x23\x55\x49\x78\xa1\x05\xc9\x28\x08\x2a\x83\x81\x32\x6a\x94\xe8\x1b\xe1\x1d\x91\x1b\xc6\xc0\x20\x9a\x46\xdd\xd3\xd3\xb0\x97\x44\xc1\x34\x18\xa1\x3d\x6c\x97\xe8\xcb\x5b\xea\x0e\x7c\xc3\xd5\x8a\x41\x3c\x14\x14\x12\x50\x4a\x0c\x62\x7b\xcb\x18\xaa\x1b\xd9\x34\x24\x5f\x14\x26\xb0\x87\xb1\x4f\x6b\xaa\x40\xc0\x2f\x17\x92\x59\x3b\x05\x71\xf7\x8e\x0c\xf6\x2c\x47\x9d\xa3\xc5\x36\x5c\xbd\x64\x46\x5e\x82\x45\x2e\xe4\x86\x31\x3b\x24\xb2\x5d\x9a\xed\x2d\x34\xa5\x79\x9e\x5b\xe5\x2e\x8a\xe6\x4e\x2e\xc5\xca\x0c\x6f\xe3\x6c\xd6\x60\xce\x6a\x7b\x6d\x12\x44\xf1\x78\xd4\x1d\x84\x5f\x6d\x14\xc1\xd6\x72\x00\x06\x49\x2e\x16\x6f\x3e\xb2\xc9\xd0\x86\xe4\x62\xb1\xd9\x5d\xb7\x53\xb0\x4e\x32\x33\xe1\x76\xc9\x32\x6b\xac\xd2\x22\xb3\x16\x92\xcb\xa3\x8a\xb9\xd5\x15\x00\xaa\x43\x62\x93\x30\xdc\x87\x3f\x57\x8c\x95\xa8\x96\x81\xf2\x99\x8d\x81\xdd\xda\x43\x20\x73\xef\x35\xe8\x94\x19\x55\xcc\xa1\xea\xbe\x93\x19\x4d\xaf\x58\x91\xf9\x75\xce\xbc\x14\x4a\x2f\xa4\x89\x90\xaf\xd6\xea\xc7\x79\x8b\xb4\xd4\x8f\x73\xae\xd9\x23\xe3\xb0\xac\x14\xfc\x08\xca\xfe\x95\xa8\x8c\xaf\x66\x82\x47\x80\xd1\x94\xf7\x4f\x8c\xb5\x30\x5c\xc7\xbf\x39\x68\x38\x13\x36\x06\xe1\xc0\x7b\x36\xf2\x75\x70\xf8\x05\xc6\xbe\x0e\x9e\x3d\xfe\xfc\xd1\xa1\x67\xeb\x13\x20\x1a\xe2\xb9\xf4\x3f\x3c\x4f\xba\x71\x0c\xf2\x0c\xd5\xd1\xa9\x68\xe2\x89\x3c\xb1\xc1\xdf\x6e\x23\xa0\x0f\x69\x1a\x2e\xad\x9f\x75\xcd\x24\x9f\xaf\xdb\xf3\x2a\xcf\x31\x18\x3c\xa8\x2b\x00\x4c\x07\x07\x77\x33\x57\x04\x8b\x22\x4d\x55\x12\xad\xde\x4a\x81\x9f\xa3\x44\x5e\x69\x66\x5d\x98\xa6\xce\x06\x4c\x3b\xd9\x0c\xeb\x09\x8c\xcb\xf1\x66\x87\x23\x01\x7b\xcb\x0b\xa5\x69\x9e\x5b\xea\x57\x4c\x1b\x53\x41\x0b\xd2\x02\x32\x6b\xc1\xd3\x6c\x5d\x52\xa5\x08\x78\xbc\xe1\x28\x9e\x76\x07\x03\x70\x94\x9e\xdf\xf2\x1c\x14\x4b\xa5\x4d\x21\x17\xa9\x5c\x97\x9a\xa4\x42\x5c\x71\x67\x80\xf9\xe4\xf0\xb4\x4b\x52\x91\x81\x33\xa0\x53\xd8\xb5\xcf\x3e\xb3\x61\x01\xac\x76\x99\x8e\xc9\xf3\x20\x98\x40\x85\x4a\x44\x70\xc5\x21\xcd\x42\xe2\xee\x69\xf0\xd9\x67\x5e\x1c\xf4\xa2\x60\x0a\xca\x84\x1c\x93\xcf\xbe\xf3\x83\xd3\x7e\x70\x09\x51\xd6\xff\xef\xfb\x0f\x6a\x42\x5a\x03\xcb\xaf\x20\x5d\x22\xad\x08\xa6\x95\x16\xed\x5c\x2c\x78\x01\x49\x93\xb3\x70\x94\x44\xc1\x30\x18\x9e\x04\x91\x23\xca\x2f\x6c\x6f\x8b\xab\x4b\x29\x28\x2d\x58\xd6\xe8\x4e\x78\x01\xe9\x2f\x9b\xe4\xef\x8d\xc7\xcf\xc3\x60\x03\xab\x41\x2b\x09\x2f\x80\x87\xb8\xd9\xc7\xdd\x90\x01\x3b\x48\x2d\x6e\x84\x91\x29\x8c\xb1\x60\x61\xee\x4d\x88\xf4\x86\x29\xb1\xba\xed\x09\x32\x6d\xdc\x49\x37\x40\xdd\x3d\x0e\x7a\x17\xd1\x7d\xfe\x23\xab\x77\x45\x0b\xc2\x8b\xcc\x54\x03\x00\x0a\xc4\xcc\x13\x94\x40\xa5\x1a\x0e\x31\x2c\x1a\x78\x62\x17\x71\x62\x06\xb8\xb5\xed\xbb\xa6\xb7\x0b\xe0\x0e\x48\x6e\xdd\xb0\x61\x62\x1a\x42\x0d\x08\x98\xe9\x6d\x65\xed\xf7\xac\x0e\x17\x2f\x85\xd2\x30\x8c\x95\x67\x37\x6c\xb6\x14\xe2\x4a\xdd\x36\x41\x33\x96\x73\x1b\x98\x66\xd7\x98\xe4\x30\xb6\xfc\xda\x19\x35\xc6\x6b\x04\xdf\xdf\x45\xcd\xad\x80\x63\xaa\x43\xa6\x0d\xd3\xaa\xf5\xfd\x96\x4b\x36\xd2\x3c\x37\xdc\x81\x15\x40\x5a\x10\x5a\x58\xdb\xd5\xd6\x10\x49\x42\x77\x21\x2a\xc0\x30\x02\x66\xc5\xb4\xe9\xbd\xc3\xda\x6c\xf8\x28\x98\x5e\x8e\xa3\xe7\x09\xda\xbd\x10\x46\x27\xc7\xe4\xfb\xb7\x32\x4d\xc0\xb4\xdd\xb8\x17\x86\x6d\x2a\x57\x48\x4a\x57\x6c\x8d\xc1\x5d\x31\x27\x67\x93\xb3\x46\x96\x44\x81\xa6\x50\x7a\xa3\xfd\x88\xa6\x0b\xac\x8b\xb2\xaa\x10\xbe\x1a\xdd\x84\x5a\x89\x2a\x82\xb2\x89\xbb\xf4\x86\x6d\x36\x5b\xa3\x61\x6e\x06\x5f\x81\x96\xbe\x88\xa7\x41\x3f\x39\x9b\x9c\x01\x43\x46\x50\x45\x76\xec\x79\xaf\xd9\x8a\xf2\x7c\xb7\x7b\x83\x8a\x16\x5e\x6f\x6a\x10\x36\x8e\x4d\x93\x9c\x4a\xc9\xe6\xfc\x1d\x7c\x94\xb5\xe2\x86\xce\xaa\x9a\xfd\x08\x24\x3b\x38\xad\x1d\x2f\xbe\x38\xf9\x61\xd0\x9b\x26\x10\x9c\x0a\x5f\x92\x63\xf2\xf6\xf5\x77\x1f\x6c\xea\xca\x1e\xaa\x37\xe4\xad\x05\x18\x0f\xa7\x13\x17\xf1\x41\x75\xc0\xb5\xc2\xbc\x86\xb5\xc7\xd5\x4a\x97\x1d\xc0\x6c\x51\x15\x1d\x21\x17\xcf\x1e\x3f\xfd\xc2\x37\xbf\x2e\xe0\x67\xc8\x10\x34\x7e\xfb\xf1\x8f\xf1\x87\xcf\xd1\x64\x0b\xcd\x76\x00\x34\xc2\x0a\x30\x91\x15\x69\x7d\xfe\xe4\x71\xcb\xc7\x61\x63\x72\x03\x2a\x7f\x86\xfc\x90\x75\xc8\x05\x66\xcb\x30\x93\x03\x15\x28\xa2\x30\x3d\x1f\x3f\xfd\x02\x3a\x36\xad\x15\xf0\x48\xa2\xd3\x1e\x79\xf2\xf9\xfe\x97\x9d\xcd\x40\xb7\xc2\xed\x1b\x50\x5c\x9b\xa1\x6c\x80\xdb\x8d\xe8\x54\xdb\xae\x39\xda\xe5\x31\x9b\x62\xaa\x88\xac\x9a\x7f\x00\x23\x3f\x7e\x74\x78\xf8\x10\xd8\x81\x2b\x57\xcb\xf6\xa3\x4a\x69\x43\xf7\xd0\xc5\xb6\xf6\x89\xb5\x74\xdf\xb6\x20\xde\xd8\x22\xbf\x8e\xaf\x7f\xd0\x28\x55\xfa\x8d\xb7\xc4\xc8\xce\x8e\x07\xc9\x6a\x72\x4c\x0a\x21\x59\x99\xaf\x7f\x80\x6a\xea\x76\x19\x99\x11\x1b\x20\x41\x3a\x4e\xf1\x7e\x42\x7b\xd0\x50\xe0\xa6\x74\x9a\x0a\x7a\x77\x1c\xf2\x3c\x18\x8c\x37\x75\x12\x9b\x52\x08\xc7\xb6\xb0\x19\x19\x9f\xa3\x3f\xa3\x1b\xb1\x47\xe8\xe6\x7c\x50\x13\x2b\xdd\x74\x01\x65\xb3\x0d\x77\x2b\xad\x82\xeb\x6b\x32\xa1\x1d\x0f\xda\x61\xba\xcd\x88\xbf\x5b\x58\xaa\x2b\x5e\x1a\x36\x5c\xd7\x35\x10\x8d\xc2\x2d\xd1\xa4\x04\x28\xcd\xc8\x31\x65\x61\xb4\x36\x60\xa1\x58\x3e\x6f\x5b\xc6\x6d\x74\x84\x4a\x88\xe7\xe1\x04\x4a\x95\xa0\xbe\x74\xa7\x76\x00\x38\x69\xce\x59\xa1\x6f\xf5\xbc\x88\x83\x04\x6a\xb1\xc2\xd3\xb0\xd7\x4c\x18\xec\xa8\xcf\xc2\xdd\xff\x58\x7d\x96\x69\xe0\xea\xb3\xee\x22\xd0\xd2\xec\x9d\xde\x2b\x73\xca\x21\xcf\xad\x88\x8b\x63\x38\x12\x02\x5c\x26\x03\x2c\xe5\x08\x5e\xde\x13\x08\xa6\x5a\x43\x4c\x80\x12\x04\x03\x00\x09\xcd\x35\xa8\x59\xcd\x8d\xfc\x87\x35\x1c\x86\xc3\xc0\xb9\xb2\x60\x09\xe7\xac\x2e\x63\x39\x9f\x0e\x07\x86\xce\x15\xb2\xdf\x76\x39\xa3\x61\x3f\x22\x72\x0c\xfd\x02\x33\x98\x55\x33\x71\x50\x63\xa7\x95\x74\x05\xd1\x05\xcd\xa4\x22\x4b\x5a\x96\x1c\xc8\xb9\xdb\xef\x37\x70\x4f\xba\x83\x0d\xfe\xde\x6b\xf0\x5f\x9d\x51\x7c\x8d\x91\x31\x57\x0e\x68\x72\xa5\xda\xa4\x5b\x52\x2c\xad\x2a\x20\xeb\x58\xe1\xe6\x74\x7b\x53\x4c\xe3\x24\xbd\x71\x3f\x48\x06\xe1\x0b\x8c\x5d\x1c\x3c\xdd\xbf\x17\x96\x64\x8a\xe9\x9a\x63\xee\x42\x8c\x82\x18\x6a\xcf\x2c\x1f\xed\x82\xbb\x95\x3f\x47\xd3\xd6\x4a\x05\x48\x1e\x70\x6b\x27\x19\x0b\x2c\xc3\x05\x85\x74\xd4\x96\xdc\x60\xb8\xb0\x81\xd3\x0e\x5c\x11\x51\xda\xac\x00\xca\x31\xb5\x81\x8c\xc6\x84\x16\x0e\x76\x43\x97\xc0\x00\x92\x2d\xb8\xd2\xd2\x5a\x66\x51\xf0\x9b\x17\x61\x14\x24\xc1\xb0\x1b\x0e\x12\xac\x82\x8e\x86\x1f\x09\xe3\x83\x4c\xb0\x91\xa7\xad\xc2\x18\x72\x0d\x7e\xaf\x63\x40\xc5\x35\xdb\xc0\x8e\xc3\xb3\x11\x14\xfd\x85\xc1\xe5\xc7\xcb\xc7\x90\x15\xb7\xf0\x23\x97\x4d\xff\xce\x87\x0c\xb8\x09\xa0\xdf\x6c\xc2\xb2\x26\x8a\x66\xb2\x4e\x46\xf7\x62\x1a\xb0\x51\x7a\x16\x9c\x85\xf1\xf4\x13\x92\x13\x29\x2d\x75\xba\xa4\x86\x02\x36\x5b\xd2\xc4\xa8\x4e\x41\x34\x60\x26\xbd\xee\x64\xda\x3b\xef\xd6\x9e\xe6\xee\x78\x65\xa3\xf2\x07\x63\x2f\xe0\x36\xda\x1a\x1e\x97\xc7\x21\x4b\x46\x33\x20\xfc\x7a\x14\xa8\x94\x84\xc4\xe3\xf8\xe5\x2b\x2c\x8e\x00\x0f\xb1\xf7\x91\x99\x80\x05\x0e\xd4\x04\xc5\x2c\x6b\xbb\x28\x48\x4c\x66\x97\xcc\x74\xee\xc7\xe4\xfe\x91\xc7\xf7\x2d\x23\xb0\x4c\x03\x77\xc3\xf5\x54\xd5\x66\xfa\x27\x8c\xf9\xb1\x69\x26\xe7\x41\xb7\x8f\x4a\xed\x65\xfb\x32\x38\x81\x97\x6d\xd0\x72\x9e\xf7\x1a\x46\xd8\x6d\x3d\x19\x6a\x2f\x84\x15\xc9\x18\x82\x07\x34\x70\x11\xea\x39\x1a\x9a\x1f\x8d\xad\x98\x6e\x4e\x0b\xfc\x40\x2c\x37\x7c\x53\x3b\x6b\xf8\x15\x26\x70\xcd\x33\x26\x37\x5e\xeb\x8a\xad\x84\x5c\x63\x99\x35\x77\xce\x6b\xc6\x8d\x13\xce\x56\x29\xe4\xfd\x1a\x0e\xf9\x96\xff\x8b\x75\xd8\x78\x96\x00\x42\xe0\x08\xa7\x76\x12\x8a\x39\x5f\x38\x11\x64\x56\x10\xea\xea\x50\x1c\x3b\x1c\x4c\x3e\xde\xf4\x7b\x86\xa1\xf6\x4d\x41\x2a\xd8\x9f\x06\x08\x59\x33\x8d\x0d\x01\xbd\x67\xf5\x44\xe6\x58\x70\x4b\xf5\xd2\x9a\x75\x6f\xd1\x0f\xb6\x6f\xd5\x5b\xec\x81\x13\x79\xe6\xcc\xef\x63\x9d\x96\x3e\x48\xa3\xe3\x67\x4f\x1e\x7d\xf1\xa5\xef\xe4\xe1\xf1\x8a\xa6\x54\x8a\xc2\xcf\x66\xc7\xfb\x7e\x29\x44\x8e\x15\x1a\xc7\x07\xfb\xfb\x3e\xcf\x72\x96\x40\xe6\x49\x54\xfa\xd8\x88\xc2\x36\x71\xcb\xf2\x8c\xbc\xdd\xc4\x10\x0e\x0e\x0e\x0f\x0e\xcc\xb0\xb8\x54\xcf\x48\x3f\x1e\x39\xed\xed\x62\x1e\x0e\x57\xb0\x6b\x9e\xb9\xf1\x7f\xa0\xd3\xf2\xc1\x06\xd0\xa3\x47\xfb\x4f\x1e\xa2\x43\x6f\xa0\xb9\xd5\x7e\xd6\xa8\x94\x21\x4a\x3b\x0f\x60\x17\x78\x20\x93\x63\x80\x50\xcb\xfc\x63\xf7\x80\x16\xcc\x71\x3d\x1a\xc9\x66\x40\xe3\xa6\xb1\x52\x39\x64\x45\x8e\xad\xb8\x72\x06\xb5\xdb\x7a\xac\x84\xae\xf7\xbe\xde\x45\x65\x5d\x40\xb7\xf4\x2e\xb7\xd4\xb2\x3f\xb4\x20\xed\x92\x33\xf0\x42\x4c\xf4\x89\x2b\xcb\xd7\x99\x6b\xea\xf0\x47\x8f\x06\x4c\xbe\x9a\xae\x12\x7b\xae\xc5\x86\x39\xdc\x18\x1f\x73\x45\x75\x83\xda\xeb\x70\xa5\xac\xbd\x65\xeb\x82\xf2\x24\xe7\x57\x2c\x59\x98\xd3\x28\xbb\x3d\x66\x5e\x10\x93\x97\x36\x89\xca\xfb\xdc\x6d\xc0\xe4\xac\x67\x32\xdd\xd7\x34\x87\x6e\x8a\xa5\x02\xdc\x03\x63\x9f\x19\x5c\x4c\x25\xe7\x59\x2f\x09\x47\xd3\x20\x7a\xd1\x1d\x60\x84\x6c\x7f\xff\x56\xae\x22\xe7\x73\x66\x72\x9d\xb7\xe0\x50\x07\xc9\xe4\x2c\x06\xe1\x69\x80\xf9\x47\x72\x4c\x9e\x3e\xf9\xbc\x86\xd3\x5c\x13\xe8\xd6\x8b\xa3\x53\xa2\xc5\x15\x83\x30\x46\x1c\x9d\xde\x72\xc5\x93\x54\xc9\xb9\xe7\xbd\x46\x82\x76\xc2\x02\xbf\x10\x9a\xd1\x52\xef\x96\x14\x4e\x42\x08\xd9\x10\x12\x60\xee\x74\x27\xd3\x6d\x61\x70\x2a\x36\x1d\x6d\x5c\x6b\xf7\x5a\x75\xbc\xc6\xba\x3c\xd9\x77\x5d\xcd\x48\x86\xf6\x1a\xe2\xa8\xc1\x0a\x40\xd0\xce\xc8\x78\xf6\xab\x62\x7b\xe3\x77\xc1\x3a\xe6\xe0\x80\x6f\x89\xf5\x55\x95\x6b\x5e\xe6\x0c\xeb\xe0\x15\x91\x95\x21\x7a\x57\x9f\xcf\x20\xe8\xbf\xe4\x45\x46\xa8\xa9\x1f\x9e\xd1\x9c\x16\x29\x18\xfb\x23\xec\x00\xf9\x0d\xef\xc8\x1a\xfd\xb6\xb4\x7e\x4b\xbe\x9a\x43\x0a\x86\xfa\xb7\xe2\xd6\x0f\xde\x36\x0b\xc5\x08\x54\x75\xbd\x7d\x68\xe3\xbc\xcd\x12\x5c\x20\x4d\x68\x6c\xcf\x22\x91\xad\x92\xe7\xb7\x0f\x89\x28\x88\x5a\x52\xc9\xcc\x20\x26\x70\x28\x4c\x50\xe6\x0c\xe3\x23\x8d\x22\x74\x5b\x26\x87\x61\x20\x64\xe8\x15\x5a\xe4\x05\x4c\xc9\x24\x5d\x31\xfe\xc0\x58\x81\xa6\x4e\x9e\x9b\x65\xe9\x90\x58\x53\xa9\x2b\x38\xca\x33\x07\x33\xdc\x25\x64\x37\xb2\xed\xb6\x0a\x23\x42\x6e\x53\x2a\xd2\x17\x9e\x8d\x30\x09\x6b\x0e\xb1\x20\x4a\xc0\x09\xb7\xab\xbf\x2b\x08\x51\xf3\xfe\xd2\xb4\x81\x0d\x52\x44\xa5\x4b\x96\x55\x39\xc6\x4c\xd4\x95\xf2\x5d\x94\x49\x35\xc2\x50\x30\x53\xa6\xb4\x22\x65\x95\xe7\xde\xd1\xa6\x36\xdf\x64\xf2\x0c\x25\x98\x39\x73\x65\x55\x7b\x66\xc2\xe8\x5c\x63\xc9\x15\xa0\x00\xab\x5b\x2f\x03\x99\x55\xa6\xf4\xdd\x3b\xaa\x97\x18\x11\xc0\x55\x2b\x84\x46\xf2\x99\xad\x6d\xeb\x54\x14\x9b\xd3\x2c\x80\x8f\x09\xd7\x5b\x14\x09\x57\xde\x91\x43\x9c\x65\x44\x14\x29\xeb\x90\x31\xce\xd6\xd2\x62\x9d\x08\x50\xcc\x8e\x73\x4b\x24\xfb\xf5\x86\x42\x0f\xef\x88\x94\x3c\xbd\x52\x26\xf2\x5f\x95\x76\xbc\x83\xfd\x0d\x17\xc3\x01\xb7\xb8\x77\x1e\xf4\x2f\x06\x41\x54\x5b\x94\xaf\x97\x5a\x97\x0d\x67\xa7\x32\xc2\xa9\xd5\xc5\xb2\xf0\x76\x4f\x14\x5a\x8a\xbc\xdd\x05\xd3\xbc\x3d\x96\x7c\x01\xce\xa0\x31\xc8\xb6\xfc\x6a\x58\x01\x2d\x48\x29\x99\x42\x5f\xbd\xdb\xeb\x05\x31\x84\x17\x47\xd3\x68\x3c\x30\x71\xb4\x64\x1c\xc1\x39\x06\x64\x47\xe3\x18\xae\x58\xa1\x77\x1a\x5a\x99\x4d\x03\x93\x4d\x3b\x5c\x81\x05\x9e\xe0\xca\xbf\x21\x19\x6f\x38\xae\xd9\xd5\x66\x98\xd0\x36\x71\xde\x7f\x33\x4c\xdf\x68\xfb\x2b\x4e\xad\x93\x5d\xa0\x3e\x35\xdf\xde\x48\xb5\x7f\xfe\x4f\x4a\xb5\xe7\x8c\x2a\xd6\xf9\x65\x36\xc9\x98\x94\xd8\x7f\x57\xcd\xc4\xaf\x74\x69\xbf\xbf\xf7\xfd\x5f\x62\x25\x1f\x1d\xfe\x92\x4b\x79\x00\xea\xe9\x5c\xdc\x10\x31\xd7\xe0\x6c\x8a\x9b\x22\xc7\x92\x10\x31\x77\x67\x51\x60\xfa\x50\xf5\x0e\xef\xb5\xd8\x62\xf0\x0e\xe9\xd7\x1d\x1a\x09\x6d\x2c\xe8\xb2\x6a\xdc\xc9\x04\xa8\xe5\x02\xa5\x88\xd2\xdf\x0d\xd3\xcc\x22\xe7\x74\xe1\x74\xd9\x6c\x0d\xe2\x01\xc7\xe2\xaa\xd6\xf7\x70\x98\xf4\x72\x84\xa7\x59\x4c\xb1\xd7\xe9\xe0\x22\x3e\x6f\x5a\x44\x07\x2b\xcf\x7b\x0d\x83\x60\x86\xd7\x9c\xc4\x61\x26\x7b\x6f\x02\x42\xf0\x41\x20\x95\xb6\x26\xa2\xd2\x65\xa5\x59\x06\x73\x31\xe1\x85\x17\xa6\xf4\x67\x73\x90\x58\x14\x75\x04\x6d\x2e\x60\xef\x78\xb1\x00\x23\x01\xca\x8a\x7b\x3e\x1e\xc7\xeb\x63\xb1\x67\x54\xcd\xd6\xf6\xe9\xb4\xf7\xf4\xf0\xd0\x7d\x7e\x65\x1e\x1e\xef\xe3\xe7\xc1\xc1\xe1\xa3\xfa\xc1\xbc\x7a\xf4\xe8\xd1\x97\xf5\xc3\x88\x16\xc2\x27\xcf\xb9\x4e\x97\xac\xf0\x41\xa5\xad\x4a\xfb\x31\xe4\x79\xce\xeb\xe7\x54\x0a\x14\xac\xf8\x15\x7a\x75\xac\xc1\x03\x11\xfe\x66\xee\x89\xd0\x99\xa8\x74\x73\xfe\x8a\x31\x3c\xf3\xfa\x6c\x6f\x6f\x21\x72\x5a\x2c\x20\xc0\xbb\x57\x5e\x2d\xf6\x60\xd9\xf6\xbe\x53\x5e\x2d\xda\xa9\x28\x94\xa6\x85\x56\x58\x9a\x3b\xec\x42\xd8\xc9\x62\xed\x79\xaf\x4b\x9e\xea\x4a\xb2\x37\x3b\xc5\x19\x06\x5f\xe8\x35\xd5\x54\xee\x96\x67\xdd\x17\xdd\x69\x37\x4a\x2e\x26\xb8\x8d\x5b\xd2\xcd\xf4\xda\x09\xb6\x91\xfd\xfe\x18\xf0\x28\x98\x8c\xe3\x10\xab\x01\xef\x1f\x07\x60\xb5\x37\x83\xf5\x96\xbc\x60\x8a\xd9\x08\x01\xc4\xae\x31\x59\xea\x42\xb6\xa6\x21\x51\xa2\x92\x29\xdb\x14\x91\xd9\x25\x4c\x8b\xce\x42\x9a\x26\x10\xba\xb6\x73\xd8\xeb\x78\x67\x91\x45\x20\x1e\x5f\x44\x3d\xcc\xcd\xd9\x76\xf7\x14\xbb\xda\xb7\xbe\xa1\x78\xa3\xe3\x5c\x3a\x60\xab\x8e\x1a\x44\x14\xb0\x94\x98\xcf\xb1\x22\x6f\x85\x86\x89\x0b\xf6\xb8\x71\x3f\x1a\xe8\x99\xb3\x8c\x99\x5c\x99\x9d\x1d\x14\x45\x56\x25\x4c\x5c\x91\xfe\x28\xb6\x88\xa5\xe6\xd4\x9d\x69\xb2\xa9\xa9\xf3\x8e\x4c\x62\xc4\xc4\x3b\xfd\x9a\xa2\xe0\x14\xe6\xcd\xcd\x4d\x27\xe7\x33\xb7\x24\x42\x2e\x90\xe1\x32\xa6\x5d\x6c\x74\xfa\x0d\xd3\x43\xac\x6f\xcf\x8f\x08\x69\xac\x22\xb7\x4c\x26\xe6\xae\x66\xb4\x59\xf6\x70\x1a\xf4\x83\xa8\x0b\x99\xa6\x3b\x6b\x00\x14\x75\xc3\x33\xbd\x44\xb6\x59\x32\x3c\x0c\x09\x69\x00\xfe\x8e\xe5\x56\xc8\x3b\x91\x5e\x53\x98\xa9\xe9\x50\x78\xa2\x40\x8b\x9a\x74\xad\xc0\x3d\xfc\xf2\x56\x64\xd3\xd5\x34\x10\x5a\xf0\x55\x1d\x3c\xad\xa1\x9e\x85\xa7\x0e\xb2\x6f\x4c\x4d\x43\xbe\x52\x69\x32\x97\x36\x8f\x00\x65\x16\x9b\x5b\x08\xea\x99\x75\x47\xe1\x70\xf7\xc4\xb6\x8e\x03\x49\x5e\x92\xe0\x65\x78\x4a\x56\x4c\x53\x63\x95\xa3\x76\x3a\x9b\xc4\x98\x4a\x04\x9c\xec\xa1\xc1\xbb\x93\x2d\x32\xa3\xd4\x9b\x7a\x12\x83\xd9\x2b\xac\x34\xc1\xc5\x10\xda\x50\x4d\x0a\x76\x1f\x46\x3b\x85\xad\x51\xc7\x61\xc1\x6d\x28\xb4\x99\xba\x3d\x9c\x89\x48\xc1\x29\x4b\x38\x92\x14\x85\x50\xf2\x19\x9e\x6e\xdb\x43\x77\x15\x96\xdd\x95\x07\x66\xc7\xde\xd9\xfd\x7a\xb8\xb5\x9c\x7c\xe5\x2a\xca\x66\xf5\xa9\x54\xa0\x85\x23\xd2\xb5\x33\xc2\x4d\x65\xef\x52\x3c\xa0\x5c\x57\xd5\x9b\x4d\x85\xdc\x20\xcb\xac\xe7\x03\x2c\x7d\x67\xea\xd8\xd0\x26\xdf\xa9\x6a\x73\x5b\x78\x1f\x0e\xbb\x67\x41\x32\x09\x5f\x06\x03\x50\x9e\x9f\xef\x9b\xff\x6e\x4d\xe5\x23\xa4\x06\xd3\x33\xf5\xa4\xca\xda\x89\xae\xfe\xeb\x0e\x0a\x9b\x98\xc7\x26\xe7\xca\x0b\x64\x0a\x5e\xd8\x72\x7e\xab\x9d\x04\xda\xbc\x34\x37\x40\x20\xca\x3f\x9d\x76\x7b\xe7\xc3\x60\x84\x49\x4f\x88\x3d\x3b\xba\xb5\x47\x80\x5c\xc9\xe9\xee\x08\xe2\x92\xca\xcc\x14\xfc\xce\x24\xa3\x57\x9b\x92\xd6\x9a\x24\xcf\xbb\x11\x94\xf8\x8f\x82\xe4\x24\x0a\xba\xb7\x8b\x3b\x5c\x6a\xdb\x0a\x51\x38\xe0\x09\x2e\xd1\x6a\x97\x41\x45\x95\x2d\x51\x47\x0e\x37\x15\xf2\x40\x5b\x43\x8b\xa1\xd3\x6d\x36\x45\xe8\x93\xd6\x82\xeb\x16\x79\x80\x1e\xc0\x82\xeb\x67\x7b\x7b\xad\x87\xd6\xc5\xa7\x8b\x82\xd5\xef\xcc\x37\x7c\xdd\xf1\xcc\x45\x27\x70\xd4\x14\xfd\x8b\x61\xa3\x40\x34\xff\x84\x0a\xe8\x99\xab\xdd\x67\xd9\x1e\xcb\xb8\xad\x0a\x6c\xa2\xf8\x8d\x75\xcf\x64\x2a\x2c\x0c\x77\x48\x12\xde\x16\x62\xd3\x01\x40\xd6\xb5\xcf\x26\x7f\x5a\x56\xba\x06\x60\x0a\x55\xb7\x6b\xa6\xef\x2d\x97\xf6\x5e\xab\x15\x95\x7a\x5d\x82\x1e\xbf\x3f\xc9\x1e\x6f\x1a\xdd\xdd\xe4\x8d\x9f\x7b\x1a\x41\xda\xc8\x8c\x89\xac\xdb\xef\xc6\xe7\x41\xfd\x6d\xd0\x9d\x06\x2f\x93\xed\xdf\xba\xa3\xb3\x41\xd0\x4f\x7e\xf3\x62\x3c\xdd\xfc\xe8\xbd\xc6\xec\xc4\x9b\xdd\x4a\x50\xb2\x45\x95\x53\x49\x1e\x14\xa2\x68\x63\xc3\x87\x56\x2d\x6f\x4e\x9a\xde\x3a\x0c\xd3\x48\x72\x5c\x0c\xba\x78\x0a\xa6\x3e\x1c\xd3\x08\x67\x5b\x97\xf6\xcd\xad\x1d\x77\x1e\x82\x31\xf5\xeb\x10\xb9\xcd\x2d\xd6\xb7\xb2\xb4\x20\xce\x07\x81\x2b\x95\xd3\xf4\x0a\x1e\x50\x3b\xca\xcc\x3c\x16\x0b\x4d\xf3\xab\x96\xa9\x14\x8b\x6d\x19\x8e\x4f\xb0\xb1\x4f\x6c\x53\x9f\xb8\x86\x78\x90\xcd\xd6\x9c\x98\x18\xd1\x56\x1c\xab\x1f\x40\xee\x2c\x6a\x9c\x3c\x3f\x78\x7c\x2b\xc9\x81\x5e\x04\x2f\x5c\x3d\x4f\x9d\x7b\xc5\xad\xc3\xb4\x2d\xdc\x34\x71\x27\x75\xbb\x5d\xe9\xb7\xe4\xca\xd4\x85\x36\xac\x45\x5e\x18\x1f\xc3\x9c\x23\xb8\x75\x84\xe0\x5e\x71\x5d\x57\xd8\xa2\x2c\xb6\x87\xc1\x33\x7b\x22\xa1\x52\x4b\xac\xab\xd1\xa4\xa4\x6b\x90\xdd\xbe\xad\x12\xd4\x42\xd3\x7c\x07\x14\xae\x5c\x59\x82\x64\xe6\x6a\x92\xed\xd2\x41\x47\x2c\xb5\x48\x37\x82\x79\xd2\x7d\x85\x96\x9e\x3d\x5b\x81\x47\x1f\xbd\xfa\x36\x95\x9c\x28\xa6\x21\x3f\x87\x02\x18\x8b\xa9\x20\x13\xf2\xba\x51\x03\xb9\x7d\x02\x12\xef\x1a\x10\x0b\xc3\xa9\xdb\x47\x1e\x73\xb1\xd8\x6b\x41\x81\x49\xe3\x64\xf2\xf6\xf1\xec\x9e\x25\x1b\xb0\xa3\x85\x49\x8a\xb8\xe4\x88\xa1\x20\x23\xad\x1c\x11\x81\xf4\xb8\xb0\x85\xc1\xd4\x04\x91\xad\x28\xa9\x63\x7f\x78\xde\xc1\xf9\x9a\x16\xac\x8f\xc8\xb5\x3c\x5b\x0d\x68\x7f\xf5\x8e\xc8\x49\x05\xc5\x08\xee\x6c\x29\x2c\xed\x92\x16\x05\xcb\x7d\x63\xa2\x80\x12\x54\xf0\x97\x2b\x7b\x17\x07\xc9\xf0\x20\xc3\x55\x81\xb5\xc3\x54\x9b\x97\x50\x1b\x7c\x7a\x0a\x97\x56\x04\x23\x73\x86\x04\x32\xb0\x36\x98\x3b\x95\x34\xc5\x09\x85\xc5\x5c\xc0\xe7\x25\x95\x05\x7c\x06\x52\x0a\x09\x0f\xa7\x54\xd3\xbc\xb5\xbd\x74\xa6\x97\xe7\x6a\x8c\xf1\xab\xe7\x62\xb5\x6e\xb5\xac\xc5\x57\xe4\x6b\xdc\x9f\x8e\xfd\xfd\x8d\x2d\xf5\x02\x52\x42\x9f\x46\x10\x5e\x2c\x99\xc4\x08\xa2\x85\x58\xc3\x9a\xf3\x1d\x80\xe6\xfc\x13\xa1\xec\x3c\x25\x66\x32\x8b\xa6\x14\xcf\x5a\x42\xe4\x81\xba\x01\x67\x0d\x95\x87\xf3\x0f\x6d\x62\x5a\x3d\xc4\x1a\xb6\x24\x1a\x4f\x4d\x09\xc4\xdd\x4b\x3f\x14\x5b\x20\x1e\x35\x9d\x99\x82\xe7\x8e\xd7\xef\x86\x83\x57\x77\x7a\xde\x89\x08\xa8\x25\x9f\xa3\x18\xb3\x21\x4a\x80\xb1\xb5\xde\x87\x4f\xed\x01\xaa\x03\xf2\xeb\xbf\x0e\xdf\xf0\x74\x70\x33\x70\x90\xc4\xe7\xe1\x29\xde\x50\xf0\xf4\x5e\xf6\x06\x33\x40\xdd\x1a\xc6\x25\x11\x46\x36\x84\xd0\x34\x82\xd8\xbb\x92\x4b\x74\xab\xd7\x8e\xdb\xb0\x0f\x79\x90\xb1\x9c\x69\x66\x0b\xb9\x57\xf4\x1d\x36\x79\x68\x60\xd5\xf5\x95\x6e\x0b\x2d\xa7\xdc\xda\x43\xfc\xf5\x53\x37\xd1\xc6\x31\x2f\xa2\x81\x87\x57\x4c\x78\x06\x86\xe5\xbb\x5f\x1a\x8a\x99\x66\x9d\xe0\x35\x62\x2f\xe3\xaa\xcc\xe9\xda\xc8\xbd\x66\xea\xd5\x54\x25\xd9\x7c\xc9\x76\xd5\x99\xc5\xe7\x9d\x90\xab\x37\x9b\xea\x06\x5c\x2b\x24\x30\x48\xb8\xdf\xa6\x82\xc8\x50\x9e\x89\x9c\x66\x74\x6d\x1b\x24\x48\x33\x77\x9a\x41\xcc\xd6\x00\x44\x8a\x01\x6b\x58\x29\xa6\xc8\x3b\x32\x3c\x69\x46\x8f\x0c\x73\x0f\xdd\x61\x3e\xd8\x39\xe7\xd1\x18\x61\x69\x08\xb4\xb9\x53\x8f\x60\xa7\x62\x2d\x2b\x8c\x06\x64\xf5\xe5\x37\x75\xd5\xb7\x8d\x67\x6f\xaa\xc6\xd1\x62\x35\xc1\x18\x73\x3d\x0e\xf4\x31\x56\x9f\x8b\x6e\x9b\x05\xb1\x3d\xdf\xec\x08\xb7\x3b\xf9\x93\x8b\xc5\x7c\xa5\x4d\x42\xf9\x47\x4a\x14\xad\x46\xa8\xc2\xbc\x83\x45\x30\x70\x94\x8f\x67\x49\x51\xbc\x16\x99\x71\x75\xa0\xc4\xfb\xc7\x15\x33\xf5\xf9\x50\x81\x93\x8b\x62\x81\x61\x7c\x5a\x18\x17\xbc\xae\x80\x31\x07\xfb\x16\x0b\x17\xd5\x32\xce\x2c\xa1\xda\x0a\x3d\x73\x53\x8f\x2d\x86\xde\x56\x52\x1d\x2f\x86\x88\xf2\xf4\x3c\x0a\xe2\xf3\xf1\xa0\xef\x4e\xf9\x6d\x09\x81\x22\xb3\x51\x33\x73\x6a\xe2\xa3\xa8\xba\xe4\xe8\xcb\x36\x24\x3a\xdb\x56\x9e\x1e\x11\x73\xa5\x85\x62\xae\x0a\x41\x8b\xc6\x91\x70\x53\xbd\x21\x24\x1a\x17\x27\x17\x67\x9b\x9a\x02\x67\x1e\xa5\x52\x14\x0d\x0a\x74\xb7\xd5\xc1\xcf\x36\xae\x5f\x32\xc9\x45\x66\xea\x2a\x76\x04\x4c\xa3\xaa\x68\xb6\x36\xbe\x3a\x26\x85\xf1\x62\x1f\x13\xd7\xbf\x73\x9b\x05\xe8\x3d\xbc\x78\x8a\xac\xf0\x14\xa1\x32\x98\x74\xcc\x6d\x54\x89\xfd\xf1\x8d\xe7\x12\x02\xe4\x98\xfc\xc0\xa5\x0c\xb0\x16\x2c\x6a\x1c\x8a\x60\x34\xd7\x4b\x73\x03\x88\x05\x03\xf6\x43\x62\x7e\x4f\xf0\xf7\x5d\x90\x0e\x3f\x5f\x7a\xdb\x97\xfc\x1c\x91\xae\x5c\x54\x9b\x38\xb1\xdd\x0c\xf2\xbd\x05\xd7\x64\xae\xd2\xab\xef\x39\x45\xdc\x6e\x57\x85\x04\x83\x09\x57\xad\xdd\xd6\x74\xa1\x60\x37\x14\x63\x26\x12\x27\x8a\x3a\xd6\xc6\x75\x5b\xa5\x2b\x0c\x12\x65\x22\x55\xf8\x03\x00\xdb\x3b\xe8\x7c\xd1\x79\xec\x75\xa3\xb3\xd8\xe8\xaf\x1e\x60\xda\x0c\x78\x6d\x22\xa4\x76\x5e\x38\x97\x04\x67\x07\xef\xd4\x9b\xdb\xab\x8b\x9b\xb2\x7b\xaa\x30\x40\xce\x68\x51\x95\xcd\x21\xa8\x4c\x97\xfc\x9a\xa9\xe6\xc2\xd9\xdf\x92\xd4\x34\x7f\xb3\x7b\x0b\x77\x8f\x72\x44\xa6\x7c\xc5\x36\x2c\x54\x5f\xcd\xc2\xe7\x6e\xac\x86\x63\x85\x23\xb0\xcc\x1b\x0f\x20\x65\x3f\x3d\xef\x82\xb9\x61\x91\x8d\xd8\x0a\x73\x9b\x0a\x4b\x14\x8d\x1e\x82\x7c\xd9\x26\x5b\x56\xfb\x93\x70\xdd\x15\x50\xad\x2d\xb8\xe1\xec\xc6\x9d\xce\x05\x10\xe6\xca\x28\x2a\x37\xc9\x4f\x5b\x37\xdb\x58\x06\xb1\x7d\xd6\xbe\x53\x2f\x07\x00\x4b\x6a\x38\x9f\xbc\x14\x07\x38\x85\x6e\x59\xe6\x6b\x2c\x10\xb3\x07\xcd\xcd\x55\x69\xea\xce\x6d\x02\xf5\x4c\x36\xd9\xc3\xba\x9c\xcb\xb7\xc7\xa4\x5d\x5f\x68\x86\x39\x58\x70\x44\xb5\xb9\x9b\x4d\x14\xac\x0e\x95\x93\x9c\x6a\x27\xce\x6a\x70\x6e\x42\x1b\x5c\x12\xf7\xee\x5b\x4c\x0a\x39\x6f\x20\xd2\x2b\x7b\x72\x0f\x85\xd4\x8e\x3d\xc1\xea\xb4\x19\xc3\x5c\x26\x5e\x91\xe4\x0e\xb9\x6d\x9f\x36\xf2\x8e\xee\xdf\x11\x87\x30\x0e\x94\x80\x09\x96\xe4\x22\xbd\xfa\x64\x5c\x1d\x11\x89\x3c\x27\x55\x79\xf7\xdc\xda\xbd\x3b\x60\x6a\x35\x8d\x32\xb8\x11\xe6\xb8\x99\x6f\x53\xdf\xd6\x8a\x81\x99\xe0\xf9\xb6\x46\xdb\xd6\xce\x63\x89\x64\xf7\x31\xb5\x56\xa7\xc9\x6e\xf6\x9a\xbf\x44\x8a\x3c\xff\x96\xdc\x66\x1c\x4a\xc0\x69\x73\x66\x6b\xd7\x4c\x5a\x3b\x8f\x80\x91\x7b\xb0\x72\x0d\x7e\x29\x01\x80\x9c\x0b\xd7\xd6\x55\x7a\x73\x2e\x6e\x6b\xa9\xcd\x19\x79\x2e\x5d\x69\x40\x2a\x0a\x2d\xf9\xac\x02\x3d\xe5\xa3\xda\x58\xd0\x9f\x30\xa9\xea\x45\xc7\xfa\xfe\x22\xe5\x4c\x39\x0c\x71\xdd\x10\xb8\x39\xd3\xf7\xad\xa4\xe0\x08\x4c\x34\x93\xf5\xce\x58\x5d\x2d\xcb\x0b\x7c\x74\xd9\x00\x78\x5b\x98\x86\x9b\x2b\x5a\xea\x36\xb7\x0e\xed\xcd\xd6\x58\x7d\x93\xae\xd3\x9c\x91\x52\xe4\x3c\xe5\xdb\xa7\x1b\x1b\x64\x6e\xf5\x38\xf2\x36\x29\x69\xc1\x72\x37\xa9\x1a\x44\xe2\x40\x7c\xbb\x85\x7f\xbd\xe0\x98\x07\xed\x1b\x0b\x44\x91\x25\x5f\x2c\xcd\xe5\x7f\x62\x0e\x85\x2e\x58\x1d\x07\x9b\xb1\x12\xd7\x2c\x73\x02\xa5\x0e\xa4\xf4\xc3\xd3\xd3\xe4\x3c\x3c\x3b\x1f\x84\x67\xe7\xcd\x7a\xe9\x21\x7d\x77\xc7\x29\x70\x21\x3c\x80\xdc\x74\x0f\xd0\x4c\xe2\xf3\x39\x01\xc1\x89\x46\xe3\x59\x38\x35\xa0\x9b\x3e\xc3\x1d\xa8\x70\x6f\x0f\x4d\x9d\x25\x44\x71\x94\x7a\x90\x8f\xc3\xc4\x5b\x7e\xba\xbd\xa9\xb9\xdd\xe9\xf1\x0e\xe0\xc6\xc5\xaa\x6f\x4b\xb8\x07\xd6\x26\x2d\xba\xff\x71\x4b\x60\x91\x36\xec\x00\xbc\xe6\x42\x29\xa0\x8a\x76\x1b\xe4\xd4\xb7\x31\x03\x16\xa9\x35\x02\xce\x7a\x89\xb5\x03\x6e\xe3\xce\xde\x95\xac\x40\xf0\x8d\x5a\x99\x07\x30\x05\xbf\x56\xa8\x80\x59\x2e\x16\x0f\x6b\xf3\x8d\x6e\x2e\xd3\xf4\x8e\x4c\x51\x18\xcc\x02\x2a\x87\x64\x6d\x19\xef\xef\xbe\x19\x67\x3c\xea\x5d\x44\x11\x84\x8f\xc7\x93\xc0\x14\xbd\xe2\xaa\x3c\xf9\x34\xd4\x9a\xba\x18\xd3\x19\x8d\xeb\x25\xfd\x46\x43\xef\xc8\x5c\xed\xb8\x89\xcf\x2f\x98\x26\x94\x3c\xde\x7f\x54\xdb\xb4\x06\xa3\xcb\x6e\x38\x85\x68\xd4\x16\x3a\x8f\x0e\x81\x95\xc7\x0e\xdc\x8e\x78\x1a\xf2\x43\xc7\xfe\xfe\xc6\x33\xb7\xb4\x04\x68\xe9\xed\x7b\xc3\x30\x8a\xc6\x91\xb9\x79\xd7\xc3\x4b\x4e\xec\xf3\xe4\x62\x30\xb0\x8f\x67\x3d\x57\x50\x36\x35\x40\xd4\xbd\x93\xbe\xbb\xb8\x1b\xde\xc7\x0a\x75\xa5\x45\x59\x9a\x04\x9a\x3b\x44\x62\xdb\x12\x73\x6c\x26\x65\xa8\xa5\x81\x10\xf1\x84\xe9\xbe\xd7\x8d\x7a\xe7\xe1\x0b\x87\xb0\xb9\x3e\xf4\x09\x1c\x6e\x36\xc6\x71\x7d\x2a\xd6\x39\xfd\x8d\xca\xb8\xa5\xa8\x6c\xc9\xf3\x9c\xe9\x74\x09\xdb\x61\x0c\x6b\xf4\x03\x4e\xbb\x17\x83\x69\x33\x75\xfe\x14\x82\xb3\x25\x7f\x73\x67\x83\xb9\x66\x2b\x65\x92\x75\x6e\x4b\x36\xb7\x8d\xe0\xde\x98\xfb\xc4\xe3\x20\x09\xa7\xc1\x30\x76\xe7\xe0\xb7\xa1\xb8\x45\x31\x01\xc6\x99\xd0\xae\x98\x1d\xe6\x6d\x0e\x41\x80\xee\x37\x87\x0a\x7c\x68\x60\x8c\x1c\xa4\x0a\x5c\x33\x17\x15\xcb\xd7\x26\x85\x85\x74\x65\x6b\x9a\xbf\x29\x42\x78\x32\x9e\x26\xb0\xf1\xf5\x4d\x4f\x4f\xf0\x42\x94\x0a\xa7\x3b\xda\x7d\xb9\xd3\xc6\x1c\x5b\x3a\xf9\x23\x8a\xed\xf3\xdf\x5e\xf0\x72\x32\x18\x47\xb7\x6e\x5b\x39\xdc\xdf\x02\x6a\xad\xa4\x7b\xc0\x21\x98\x30\x8e\x2f\xee\x5c\xd9\xb2\x05\xc4\x85\x65\x5c\x94\x74\x1b\x08\x2a\x24\x30\x2d\xe7\x8c\x65\xde\x69\x10\xf4\x13\xc3\xc5\x10\x0b\xb5\x00\x1f\xbb\x02\x07\x00\xd7\xd2\x90\x8c\x69\xa7\x22\x17\xb2\x85\xe9\x42\xa2\xe9\xc2\x37\xd5\xeb\xb3\x35\xe9\x16\x99\x14\x3c\x23\xbf\x71\x4c\x1e\xe3\xed\x7e\x5d\x90\x99\xe6\x68\x08\x76\x22\x50\xff\x4a\x5a\x85\x28\xec\x29\x65\x77\x7a\xd9\x10\x8a\x39\x99\xd0\x20\x4c\xa5\xd7\x18\x9b\x1c\xba\x02\x85\x67\x75\xce\x38\x03\xf7\x19\xd8\x48\x75\x16\x42\x2c\xcc\x21\xb0\xbd\x1b\x36\xdb\xb3\xe4\xba\x77\xb8\x7f\xf0\xf9\xde\xc1\xc1\x5e\x6c\x4e\xd2\xb4\xe7\x42\xb6\x1b\x13\x68\xf3\xa2\xdd\x5b\x4a\xb1\x62\xed\x47\x5f\xe2\x4b\x8b\xbe\x37\x85\x44\x4f\xd2\x1b\x0f\xe0\x9a\x86\x60\xda\x4d\xa6\x5d\x60\xa0\xb7\xdf\x99\xcf\x1f\x3f\xfa\xfc\xd1\x5b\x4b\xa5\x18\x1b\xe1\x50\x18\xa7\x99\xda\xa8\x8a\xdb\x81\x9d\x07\x8d\xd0\xda\xd3\xe1\xc9\x43\x13\x0d\x09\xe3\xc9\xa0\x6b\x4e\x2d\xb9\x68\xca\xd3\x47\x4f\x9f\x3e\xd9\x7f\x8a\x04\xd6\xa9\x13\x1e\x9b\xcd\xb4\x49\x86\x8f\x10\x04\x84\x8c\xb6\xe9\xe1\xf1\xfe\x5d\x4a\xfd\x28\x08\xa8\x85\xf8\x28\x08\x30\x6c\xd2\x6f\x20\x4c\x38\x1d\xd0\xbb\x4d\xde\x8f\xb7\xc0\x34\x3d\xa6\x8f\xc2\x82\xd4\xcc\x6d\x7c\x70\x85\xdc\x41\x86\x7f\xda\xec\x0e\xb6\xd1\x2a\xd8\x8d\x42\x76\xf8\x86\x09\x06\x97\x71\x82\x0c\xf3\x31\x16\xde\xba\x43\xe4\x1e\x48\xee\x52\xa7\x2d\x38\x8f\x60\x8a\x25\x90\xa6\x5e\xb2\xea\x9e\x3c\xdc\xa4\x7e\x0f\x9c\x28\x79\xba\xab\x24\xed\x6e\x37\x3c\x75\x72\x42\x15\x4f\x49\x77\xfb\x3c\x0d\x96\x38\x0a\xcd\x52\xed\x00\xda\xf2\x71\x03\x35\x39\xe9\xc6\x61\x0f\x0f\x9a\xdc\xca\x0e\x6d\x1d\x5a\xb9\x17\x7e\xc7\xdb\x00\x68\x9c\x3e\xaf\x0b\x77\xec\x51\x81\x4f\x87\xb1\x7d\x04\x33\xa8\xd3\xa1\x2b\x6a\x2e\x68\xd6\xa2\x61\xc5\xa6\x39\x55\x60\x37\xa0\xe9\xd5\xd1\x62\x95\x1f\xf3\x82\x7b\xaf\xeb\x16\x1d\xdb\xed\x8d\xe7\xbd\xe6\x07\x4f\x8b\x37\x70\xcf\x30\x58\x55\x84\x15\xed\x8b\xd8\xff\xc9\xb2\xdd\x1b\xc1\xdf\xf3\xe7\xf0\x77\x7a\xe9\x67\xac\xdd\x0f\xfc\xb9\x6c\x9f\x46\x7e\x91\xb7\x47\x03\x3f\xbf\x6e\x0f\x5e\xf8\xb2\x6a\x47\x17\xfe\x8f\x68\xfb\x87\x13\x9f\xa9\x76\x10\xfb\xa5\x6e\x9f\x44\x7e\x99\xb7\x27\x03\x7f\xb6\x68\x9f\x9c\xf9\x5c\xb7\xc3\xa9\x3f\xe7\xed\xd3\xd0\xd7\xb2\x3d\x8d\xfc\x54\xb5\x7b\x5f\xf9\x4a\xb6\xe3\x89\xaf\xae\xdb\x71\xe0\x5f\x89\xf6\xf3\xc8\x5f\xe4\x00\xa1\xba\x6a\x5f\x74\x7d\x56\xb4\xcf\x4e\xfc\x65\xd5\x3e\xbf\xf0\xd5\x55\x3b\x7e\xee\xf3\xac\x1d\xf6\xfd\x39\x6d\x87\x91\x7f\xcd\xdb\x2f\x46\x30\xd6\x64\x8a\xf7\x4a\x00\xee\x41\xb1\xc8\xc1\x78\xfa\xbb\xff\xf2\xd3\xbf\xfd\xab\x7f\xf5\xb7\x7f\xfe\x27\xbf\xf8\xbd\xdf\xf1\xff\xee\x2f\xbe\xfe\x87\xff\xf4\xaf\xcd\x97\x7f\xfc\xcb\x7f\xf6\x0f\xff\xf1\xdf\xfe\xe2\xcf\xff\xeb\x3f\xfe\xe5\x3f\xbf\xfd\xe2\xef\x7f\xe7\x67\x7f\xf7\xf5\xbf\x87\x17\x7d\x56\x69\x95\x2e\xfd\xb9\xa4\xc5\xcf\xff\x88\x72\xe5\x8f\x58\xc6\x24\x5c\xfe\xac\xfc\x9c\xea\x6b\xce\xfe\xe6\x0f\x2b\xff\xc3\x4f\x3f\xfc\xf6\x87\xaf\x3f\x7c\xfd\xfe\x67\xef\xff\xfc\xfd\x5f\xf8\xbf\xf8\xfd\xff\xf0\x8b\x3f\xf8\xcf\x7f\xff\xc7\xff\xce\x67\xaa\xa4\x3f\xff\x33\x91\xfb\x20\x88\xab\x45\xf5\xf3\x3f\x56\x24\x13\xe4\x44\x52\xc5\xe1\xc7\x5c\x5d\x71\xff\xfd\x9f\x7d\xf8\x17\xef\xff\xe7\xfb\xff\xf6\xfe\x4f\x3f\xfc\xd4\xc0\xf0\xb9\xa6\x39\x87\xf2\x36\x55\x89\x15\xf7\xa7\x3f\xff\x4b\x79\xf5\xf3\x3f\x62\xfe\x5f\xff\x2e\xfb\x9b\x3f\xd4\xbc\xa0\xfe\x87\xaf\x3f\xfc\xf4\xfd\xff\xb2\xcd\xd5\x35\x2b\xd4\x15\xf5\xff\xef\xbf\xf9\x83\xff\xfd\x3f\xfe\xe4\xff\xfc\xde\x7f\xf7\x17\x34\x67\x0b\xe1\x7f\xf8\xed\xf7\x3f\xfb\xf0\xd3\xf7\x7f\xfa\xe1\xf7\xdf\xff\xd5\x87\xaf\x3f\xfc\xcb\xf7\x3f\x7b\xff\xa7\xbe\x5d\x1b\xf2\xe0\xa2\xc0\xcc\xfc\x73\x5e\x2c\x32\xb1\x7a\xe8\x0f\xe9\x62\x4d\xa5\x1f\xe7\xe2\x9a\x15\x7f\xfd\xbb\x30\x4c\x58\x64\xa2\x60\x8a\xd3\xc2\x9f\x30\x89\x9f\x2f\x38\x33\xe7\xef\x99\x3f\xa9\x67\xe5\x99\xa4\x9c\x21\x63\x50\x43\x60\x43\x42\xf5\x32\x93\x86\xac\x3a\xf0\x23\x14\xd0\xbd\xf1\x90\xae\x90\xbe\x3c\x24\x2e\x72\x4c\x7e\xb2\xf4\x90\xc2\xf0\xb1\x3d\xbd\xf4\xf0\x6f\xfd\x0d\x29\x0e\xff\x11\x0f\x0f\xc9\x0e\xf8\x50\x7a\x48\x7b\xe4\x98\x14\xb9\x87\x04\x48\x8e\x49\x7e\xed\x21\x15\x92\x63\x22\x2b\x0f\x49\x91\x1c\x93\x1f\x51\x0f\xe9\x11\xc6\x54\x1e\x12\x25\x39\x26\xf8\xe9\x21\x71\xc2\xb7\xdc\x43\x0a\x25\xc7\x64\xb6\xf0\x90\x4c\xc9\x31\xe1\xda\x43\x5a\x85\x01\xb9\x87\x04\x8b\x32\xc6\x43\xaa\x85\x34\x22\x7c\x7a\x48\xbd\xe4\x98\x28\xe9\x21\x09\xc3\xe3\xb5\x87\x74\x4c\x8e\xc9\x95\xf0\x90\x98\x21\xd5\x9d\x7b\x48\xd1\xe4\x98\x54\x57\x1e\x92\xb5\x61\xb4\xb3\x13\x0f\xc9\x9b\x1c\x93\x65\xe5\x21\x8d\x03\x90\x2b\x0f\x09\x1d\x30\xc9\x3c\xa4\x76\x14\x41\x1e\x92\x3c\x39\x26\xd7\xdc\x43\xba\xc7\xe9\x78\xde\x6b\x34\xf2\xde\x78\xf1\xf9\xf8\x32\x39\x1d\x8f\xe1\x0e\x7d\xcc\xa0\xe0\x1d\x02\xb5\xec\xc2\xbb\x7c\x60\x83\xb0\x1e\xc6\x5e\x95\x4e\xd8\x3b\x96\x56\x2e\xaf\x6d\x4a\x20\x85\x66\x72\x0b\x18\x5c\x5f\x36\x40\xc3\x10\x92\xc7\xf6\x40\x0c\x8a\xdc\xff\x37\x00\x9a\x85\x7b\x0d\x6b\x67\x00\x00"
 
 func confAppIniBytes() ([]byte, error) {
 	return bindataRead(
@@ -324,8 +324,8 @@ func confAppIni() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "conf/app.ini", size: 18755, mode: os.FileMode(0644), modTime: time.Unix(1583258558, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x57, 0x40, 0x0, 0x59, 0xcb, 0x43, 0xdb, 0x25, 0x52, 0x4f, 0xc, 0x1d, 0x24, 0x4, 0x52, 0xd, 0x7a, 0x2a, 0x9d, 0x22, 0xb5, 0x2d, 0x60, 0x0, 0x3f, 0xac, 0x9c, 0xe, 0xe7, 0x7f, 0x2d, 0x93}}
+	info := bindataFileInfo{name: "conf/app.ini", size: 26475, mode: os.FileMode(0644), modTime: time.Unix(1792289912, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf6, 0xec, 0x7d, 0x59, 0x56, 0xe5, 0x13, 0x4f, 0xe4, 0x80, 0x88, 0x87, 0xc9, 0x32, 0xd8, 0xd2, 0xd4, 0x81, 0x78, 0x6f, 0xba, 0xf3, 0x92, 0xf4, 0x27, 0x7f, 0x6d, 0xac, 0xc4, 0xa5, 0x57, 0x12}}
 	return a, nil
 }
 
@@ -4569,7 +4569,7 @@ func confLocaleLocale_enGbIni() (*asset, error) {
 	return a, nil
 }
 
-var _confLocaleLocale_enUsIni = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xbd\xeb\x72\xdc\xb8\x92\x30\xf8\x9f\x4f\x81\xee\x09\x85\xbb\x23\xe4\x72\xf4\x39\xdf\x7c\xbb\xd1\x61\xf9\xac\xda\x6e\x5f\x66\x64\x5b\x63\xd9\x73\xbe\x59\xaf\x83\x8d\x22\x51\x55\x18\xb1\x00\x1e\x02\x54\xb9\x7a\xe2\xbc\xc1\x3e\xc0\x3e\xdf\x3e\xc9\x06\xf2\x82\x0b\xc9\x92\xed\x3e\xb3\x7f\xa4\x22\x90\x48\xdc\x13\x99\x89\xcc\x84\xec\xfb\xba\x55\xae\x11\x17\xe2\x52\xf4\x52\x9b\x4e\x39\x27\x9c\xea\x36\x0f\x77\xd6\x79\xd5\x8a\x17\xda\x0b\xa7\x86\x3b\xdd\xa8\xaa\xda\xd9\xbd\x12\x17\xe2\xa5\xdd\xab\xaa\x95\x6e\xb7\xb6\x72\x68\xc5\x85\x78\xc6\xbf\x2b\xf5\xb9\xef\xec\x10\x80\x7e\xc5\x5f\xd5\x4e\x75\x7d\x28\xa3\xba\xbe\x72\x7a\x6b\x6a\x6d\xc4\x85\xb8\xd1\x5b\x23\x5e\x19\x4c\xb1\xa3\xe7\xa4\xb7\xa3\xc7\xb4\xb1\xe7\xa4\x0f\x7d\x35\xa8\xad\x76\x5e\x0d\xe2\x42\xbc\xa3\x9f\xd5\x41\xad\x9d\xf6\xa1\xa6\xbf\xe2\xaf\xaa\x97\xdb\xf0\x79\x2d\xb7\xaa\xf2\x6a\xdf\x77\x12\xb2\xdf\xd3\xcf\xaa\x93\x66\x3b\x22\xcc\x15\xfd\xac\x9a\x41\x49\xaf\x6a\xa3\x0e\xe2\x42\x3c\x85\x8f\xd5\x6a\x55\x8d\x4e\x0d\x75\x3f\xd8\x8d\xee\x54\x2d\x4d\x5b\xef\xb1\x53\x1f\x9c\x1a\x04\xa5\x0b\x69\x5a\x11\xd2\xa1\xc1\xaa\xad\xb5\xa9\xa5\xa3\x56\xab\x56\x68\x23\xa4\xab\x00\x95\x91\x7b\x2e\x1d\x7e\x56\x6a\x2f\x75\x17\xc6\x28\xfc\xaf\x7a\xe9\xdc\xc1\xc2\x40\x5e\xd3\xcf\x6a\x50\xb5\x3f\xf6\x0a\x3a\xfc\xf0\xfd\xb1\x57\x55\x23\x7b\xdf\xec\x64\x68\x26\xfe\xaa\xaa\x41\xf5\xd6\x69\x6f\x87\x23\xc0\xf1\x47\x65\x87\xad\x34\xfa\x77\xe9\xb5\x0d\x63\xfd\x36\xfb\xac\xf6\x7a\x18\x6c\x18\xc8\xd7\xf0\xa3\x32\xea\x50\x07\x3c\xe2\x42\xbc\x51\x87\x1c\x4b\xc8\xd9\xeb\xed\x80\xa3\x18\x32\x5f\xc3\x57\xc0\x82\x79\x84\x09\xb3\x22\xb6\x8d\x1d\x6e\x29\xf5\x79\xf8\x39\x41\x69\x87\x2d\xe5\x96\xed\x92\x46\x6e\x15\xe5\xbe\x86\x8f\x02\xc0\x55\xb2\xdd\x6b\x53\xf7\xd2\xa8\x30\x74\x97\xe1\x4b\x5c\x87\xaf\x4a\x36\x8d\x1d\x8d\xaf\x9d\xf2\x5e\x9b\x6d\x98\x83\x4b\x4c\x12\x37\x94\x54\x65\x79\x31\xed\x68\xc7\x38\xcb\xe2\x42\xfc\x87\x1d\x07\x71\x8d\x9f\x98\x97\x15\x82\xcc\x58\xb2\x92\x8d\xd7\x77\xda\x6b\x85\x95\xf1\x47\xd5\x8f\x5d\x57\x0f\xea\x6f\xa3\x72\x3e\x64\x5d\x8f\x5d\x27\xde\xd1\x77\xa5\x9d\x1b\xa1\xc4\x2b\xf8\x51\x55\x8d\x34\x0d\x74\xe7\x29\xfc\xa8\xaa\x8f\xda\x38\x2f\xbb\xee\x53\x45\x3f\x02\x30\xfe\xc2\x71\xf2\xda\x43\x63\x29\x51\xdc\x78\xd5\xbb\x30\xd0\xe2\xb9\x1e\x9c\x7f\xe8\xf5\x5e\x89\x77\xa3\xa9\x5a\xdb\xdc\xaa\xa1\x0e\xdb\x0f\x36\xce\xab\x8d\x38\xda\xf1\xc1\xa0\xc4\x30\x1a\xa3\xcd\x56\xbc\xb0\x5b\x27\xb4\x71\xba\x55\xe2\x19\x40\x9f\x8b\xbe\x53\xd2\x29\x31\x28\xd9\x8a\xc7\x52\x78\x39\x6c\x95\xbf\xf8\xbe\x5e\x77\xd2\xdc\x7e\x2f\x76\x83\xda\x5c\x7c\x7f\xe6\xbe\x7f\xf2\x62\xd4\xad\xea\xb4\x51\xee\xf1\x23\xf9\x44\x34\x72\x50\x9b\xb1\xeb\x8e\x62\xad\x36\x61\xaf\x1c\xed\x28\x9a\x9d\x34\xdb\xb0\x4f\x8e\x7e\x17\x2a\xd4\x46\xf8\x9d\x76\x22\x6c\xd4\xef\xaa\x30\x4a\xda\xab\xba\x5d\x33\x09\x82\x06\x41\xf2\xa0\x9c\x78\x7d\xbc\xf9\xb7\xab\x73\x71\x6d\x9d\xdf\x0e\x0a\x7e\xdf\xfc\xdb\x95\xf6\xea\xcf\xe7\xe2\xf5\xcd\xcd\xbf\x5d\x09\x3b\x88\xf7\xfa\xd9\x2f\xab\xaa\x5d\xd7\x3c\x2e\xcf\xa4\x97\xeb\xd0\x85\x38\x57\x21\x13\xb7\x52\xcc\x83\x0d\x15\x08\x1c\x10\x33\xe7\x61\x93\xd2\x06\x5d\xdc\x8e\xed\xba\xa6\x3d\x1c\x71\xbc\x09\x1b\xb9\x5d\xa7\x01\xbe\xc6\xa1\x1b\x9d\x12\xaf\xde\xbc\x79\xfb\xec\x17\xa1\xcc\x56\x1b\x25\x0e\xda\xef\xc4\xe8\x37\xff\x7b\xbd\x55\x46\x0d\xb2\xab\x1b\x1d\xc6\x66\x70\xca\x8b\x8d\x1d\xb0\xa7\xab\xca\xb9\xae\xde\xdb\x36\xd4\x72\x73\x73\x25\x5e\xdb\x36\xd0\x34\xbf\x83\x86\xf8\x5d\xe5\xfe\xd6\x85\xf1\x8a\x15\xbe\xdf\x29\x01\x4b\x17\x80\xec\x86\x87\x47\xb4\xd4\xc6\x95\x78\xbc\x1e\x9e\x64\xed\x92\x6b\x67\xbb\xd1\x53\x89\xc3\x4e\x19\x98\x27\xe7\xe5\xe0\x85\x74\x4c\xe8\x57\x95\x1a\x86\x5a\xed\x7b\x7f\x0c\xb3\x43\x6d\x98\x62\x47\x24\x8d\x34\xc6\x7a\xb1\x56\x02\xe0\x57\x95\xb1\x35\xee\xd4\x40\x36\x5b\xed\xe4\xba\x53\x35\x12\xf0\x81\x29\xd2\x7f\x84\xc5\x81\x05\x09\x42\x14\x10\x61\xc4\xc2\xa1\x00\xd4\x39\xac\x1c\x69\x04\x20\x15\xb4\xd5\xf3\x16\x32\x5d\x88\xb3\x86\xa4\x21\x26\xcc\x5a\x58\xf1\x34\xf0\x9a\xb9\xec\xfb\x4e\x37\x58\xf5\x0b\xcc\x4b\xcb\x27\x1c\x91\x34\xf7\x39\x1c\x4c\x3f\xe7\x65\x8b\x60\xf4\x61\x48\x07\x51\xd0\x60\x28\xbf\x53\x83\x12\xbb\x71\x8b\x07\x47\x67\xc7\xf6\x3b\xa0\xe0\x3c\xbe\x89\x4e\x8a\x77\xd6\x7a\x9c\xf3\x08\x90\xaa\xb8\xec\x3a\x38\x95\x07\xb5\xb7\x3e\x0c\x1c\x15\x0b\xb4\xe8\xa0\xbb\x2e\xf4\xd4\xc9\x3b\xd5\x0a\x6f\x71\xbf\xb5\x7a\x50\x4d\x40\xbc\xaa\x86\xd1\xd4\xb4\xd8\xdf\x8d\x06\x17\x3c\xa7\x95\x2b\x0b\xa0\xf6\xa3\xf3\x62\x27\xef\x54\x18\xf8\xc0\x1a\x78\xbb\xd8\x4e\xe8\xd2\x30\x1a\xd8\xc2\xab\xaa\xb5\x7b\x09\xc7\xfc\x33\xf8\x41\xdf\x39\x7e\xed\x84\xdc\x6c\x54\xe3\x9d\xb8\xb9\x79\x29\x9a\xce\x1a\x25\x3e\xbc\xbb\x72\x61\x1b\xec\xea\xde\x0e\xc0\x12\xdc\xbc\x14\xd7\x76\xf0\x31\x2d\x1b\xe8\x00\x61\xc6\xfd\x5a\x0d\xe2\xb0\xd3\xcd\x0e\x87\x3d\x94\x08\xab\x58\x0d\x42\x3b\x31\x3a\x6d\xb6\xe7\xa2\x53\xa1\x07\xda\xe3\x02\x08\x7d\xe0\x55\x17\xc0\x37\x4a\xfa\x71\x50\x70\xe8\xd7\xeb\x51\x77\x5e\x9b\x3a\x54\x48\x78\x80\x2c\x88\x5f\x30\x03\x4a\xdc\x40\xc6\x09\xf8\xba\xb7\x3d\x32\x2f\xb0\xab\xd6\x59\x39\x42\x18\xb6\x7c\x98\x40\xdb\x2b\x5c\xef\x8e\x9a\x14\x16\xdc\xa8\xdd\x4e\x6c\x06\xbb\x17\xee\xe8\xbc\xda\x43\xc1\x56\xaa\xbd\x35\xab\x6a\xe7\x7d\xcf\x63\xf3\xf2\xfd\xfb\x6b\x1c\x9c\x98\x7a\xdf\xe8\xc8\x6c\xed\xc2\x2a\xe9\x02\x1b\x65\x44\x40\x1b\x96\xf1\x38\x74\x93\x15\xfe\xe1\xdd\x15\xe7\x9c\x98\xb9\xd0\x84\x47\xe1\xcf\x4d\x9a\x40\x58\x09\xce\xee\xd5\x01\xd6\xbb\x36\x02\x98\x9d\x55\xd5\xd9\x6d\x3d\x58\xeb\x79\xb9\x5f\xd9\x2d\x2e\xf1\x22\x23\xd5\xf4\x8c\x17\x6d\x18\x9c\xc3\x10\x58\xbd\xce\x6e\x81\xe0\x85\xf1\x5a\x55\xca\x00\x69\x69\xac\x71\xb6\x53\x4c\x39\x7f\x85\x54\xf1\x14\x53\x91\x88\x2e\x40\xc6\x59\x7a\x15\x28\x4b\xab\xa1\xc7\xde\x22\x3d\x0d\x00\xe7\x42\x76\xce\x8a\x7e\xd0\xc6\x87\x8a\x61\x8e\x08\xc3\xaa\xaa\x6c\x1f\x4a\x64\x34\xe4\x2d\x25\x24\xc2\x01\xfd\x8e\xf9\xc0\xea\xc1\xca\xd1\x4d\x76\x38\xb9\xbd\xef\x6b\x3a\x89\x6e\x5e\xbf\xbf\xc6\xe3\x08\x52\x61\x11\x5c\x88\xe7\x83\xdd\xa7\x84\x34\x3e\xaf\x03\x3e\x80\x91\x6d\x3b\x28\xe7\xce\xc5\xbb\xe7\x4f\xc5\x3f\xff\xf9\x4f\x7f\x5a\x89\x57\x3e\x90\xbd\x40\x09\xfe\x33\xec\x60\x49\xb3\x90\x40\xed\x20\xfc\x4e\x89\xef\x03\x19\xfb\x5e\x3c\x86\xdc\xff\x43\x7d\x96\xfb\xbe\x53\xab\xc6\xee\x9f\x84\x55\xba\x97\x7e\x55\x85\x1c\x35\x30\xd1\xb8\x51\xa6\x55\x03\x31\xae\x94\x95\x91\x5e\xca\xce\xd8\x58\xe4\xd6\xc3\xd8\x6f\xf4\xb0\x4f\x13\xc4\x7c\x7c\x98\xa9\x90\xc3\x5c\xa0\xee\x6a\x63\xbd\xde\x1c\x13\x28\xf4\xf4\x4d\x48\xa4\xa5\x59\xd1\x4e\xa3\xe3\x2a\x8e\x31\xee\x4b\x58\x81\x6f\xfd\x4e\x0d\x3c\xdc\x2e\x8d\xb7\xdd\x6c\x02\xd3\x32\x59\x2d\x6f\x31\x15\x57\x4b\x0e\x12\x97\xc9\x33\x22\x18\x4f\x9f\xbd\x11\xea\x4e\x99\xb0\xb0\xfb\xc1\xb6\x63\x03\x2b\x87\x57\x4c\x27\x06\xe5\xec\x38\x34\x8a\x16\x6a\x24\xc8\xa1\x69\x81\xea\x37\xb2\xeb\x8e\xab\x8a\x0f\xc6\xed\x20\xef\xa4\x97\x43\x56\xc5\x0b\x4e\xa2\xd6\xcf\x60\x67\x8d\x8a\x25\x42\xcf\x9b\xd1\xf9\x40\x3d\xa0\x15\x0e\x1b\x85\xd9\x4e\xc8\x41\x89\xb1\xef\xac\x6c\x55\x2b\xd6\x47\xa0\xf1\x2e\xac\x85\x56\x6d\xe4\xd8\xf9\x55\xb5\x51\x6d\x20\x4a\xaa\xad\xa9\xae\xce\xda\x5b\xa8\x8c\x86\xea\x39\x03\x88\x4b\x42\x7a\x05\x10\xa7\x4a\xc6\xc6\x52\xf9\x08\x16\x1b\x45\x35\x78\x0b\x2c\x4a\xca\xb7\xbd\x32\xd4\x0d\x66\x4c\x44\xe0\x3b\x5a\x61\x8d\xe8\xf4\x9a\x3a\x9d\xc6\x72\xc2\x64\xf0\xe8\xdc\x04\x69\x36\xcf\x5b\x2c\x30\x1b\x54\x58\xf0\x6e\x5a\xf6\x5c\x58\xd3\x1d\x89\x19\x09\x5b\x0c\x05\x48\xe6\x4b\x5c\x22\x4b\x51\x5c\x63\x8a\x44\x52\x5b\x99\x1f\xab\x7d\x87\x6c\xaf\xb8\x93\x9d\x6e\x03\x46\x46\x10\x4e\x8b\xe5\xb6\xac\x2a\xe2\x95\x6b\x92\xab\xeb\x3b\x0d\x72\x6c\xdc\x62\x88\x92\x64\xed\x30\xc2\xff\x1e\x00\x82\x80\xec\x16\xcb\xc6\xd6\xbc\x0d\x9d\x74\x51\x8e\xc5\x75\x12\xba\x0b\x35\x04\xfe\xdd\x9d\x8b\x3b\x0d\x6c\x00\x2d\x72\x18\x97\x75\xe0\x31\x3b\x15\xaa\x72\x4a\x01\x06\xa1\xcd\xa3\xb1\xc7\x32\x2b\x12\xe2\x48\xae\x62\xbe\x3f\xb0\x83\xad\x35\x0f\xbc\x30\x0a\xd9\x16\x1e\xd5\x09\xdb\x27\x06\xbd\xdd\x79\x61\xec\x61\x45\xdc\xef\xe0\x3c\x8e\x0e\xc8\x16\x8a\x5a\xea\xa1\x11\xbc\xf7\xe4\xe8\x6d\xa0\x2f\xb0\xf5\xc4\x76\x90\x06\x96\x1f\x23\x56\x2e\xb6\x2b\x32\x84\x90\x37\x93\x21\x11\x68\x2a\xcc\xcf\xf8\xcf\x48\xfd\x88\xe8\xe5\x79\x44\xed\x12\x0c\x96\x66\x85\x00\x56\x8c\xd4\x95\x04\xc0\x7a\x6b\x41\x00\x65\x81\x2f\x70\x58\x95\x57\xce\xd7\x5b\xed\xeb\x4d\x20\xc1\x01\xf1\x73\xfc\x11\x58\x3e\xe5\xbc\x78\xb0\xd5\xfe\x81\x68\xec\x7e\x2f\x4d\xfb\xb3\x38\xbb\x23\xe9\xe1\xcf\x81\xba\x86\x1d\xaa\x3b\x18\x23\x12\x6c\x07\x85\x42\xc2\x9d\x1a\x5c\xd8\x3d\xad\x55\x4e\x04\xae\xd9\x8d\x3d\xf0\x1b\x51\xf2\x22\x01\xb1\xb5\x07\x13\xe8\x08\x0c\xba\xdd\x6c\x74\xa3\x65\x27\xd6\xda\xc8\xe1\x18\xb1\xc0\xe9\x74\xe6\xce\xc5\x9b\xb7\xef\x01\x70\x6b\x03\x3b\xd4\x32\xc0\xaa\xd2\x06\xd6\x7b\x90\x32\x68\x4d\xe4\x22\x16\x27\x69\x6c\x4b\x63\x87\xc0\x12\x40\x6f\xb8\xe0\x09\x06\x3a\xf0\x13\x28\x9f\xe8\x20\xe2\x02\x2c\x94\x8b\xbc\x6e\x18\x86\xbd\xf4\xcd\x8e\x38\x61\x5c\x44\x2e\x2c\xc2\xd0\xd2\x66\x1c\x06\x65\x70\x6d\xfd\x2c\xce\x9c\x78\xf8\x44\x9c\x65\xc7\x75\xbd\xd7\x2e\x30\x97\x91\x53\xe5\xb3\x5b\x40\x02\xe5\x16\xe7\x73\xea\x6d\x7e\xbc\x43\xc1\x70\xc6\x8b\x8d\x56\x5d\x3b\x6d\x6f\x60\xe4\xf1\xf0\xdc\x2e\xcd\x75\xc8\x16\x98\x3d\x22\x51\xa0\xd1\x59\x5e\x1a\x21\x5d\xcb\x4e\xff\xae\x72\x7e\xb0\x18\xd0\x62\x83\xc6\x15\xc9\xfb\x2f\x9b\x91\xbc\x95\xbc\x54\xdd\x88\x52\xc2\x85\xf8\xab\xea\x1a\xbb\x57\xdf\x89\xbf\xaa\x07\x83\x12\xdb\x0e\x96\x8a\xf4\xa4\x17\xb0\x4e\xc1\x42\x3e\x47\xe1\x62\x33\x1a\x38\xbb\xbc\xbc\x55\xa0\x4a\x48\x63\xb5\xc4\x36\x9e\x9c\xdd\xea\xe3\xce\xee\xd5\xa7\x6a\x44\xa1\xcc\x76\x6d\x14\xeb\x61\xd7\xda\x01\xf9\xa0\x28\xe3\x27\x98\xb8\x21\xdd\x41\xfb\x66\x57\x47\xf5\x66\x18\x7d\xaf\x3e\xc3\x24\x43\x56\xd2\x76\x86\xdd\x1c\xb2\xaa\xfd\x11\x16\x62\xe8\xf8\xeb\x63\x5a\x87\x5a\xb9\xca\xed\xec\x01\xb4\x87\x11\xe2\x66\x67\x0f\xa0\x37\x2c\x44\xb7\xd5\x6a\x55\x35\xb6\xeb\xe4\xda\x86\x89\xbc\x4b\xf0\x4f\xf3\xd4\x12\xf9\xfe\x58\xdb\x61\x4b\xd5\x96\xda\xb2\xfd\x91\x14\x74\x94\x8b\x0a\x3a\x57\x01\x99\x27\x3d\x2e\x9c\x06\x67\xae\x22\xbd\xd4\x4a\x9b\x1a\xd4\x5e\x5c\xf3\x2b\x83\x42\x55\xde\xce\xaa\xfa\x48\x3a\xde\x4f\x15\xc3\x15\x6d\x42\x0a\x8c\x83\xee\x0a\x55\xa4\x9b\xe8\x22\x5d\xe5\x94\x1c\x60\x07\xde\xc0\x8f\xaa\xfa\x28\x47\xbf\xfb\x94\x69\x65\x6b\x5e\x79\xac\x9d\x05\xcd\x21\x51\xe6\xc4\x5e\xee\x54\x1f\x38\xd1\xbd\x83\x25\xdb\x0d\x4a\xb6\x47\x92\x5b\xe3\xe2\xfd\x0b\x1e\x84\xda\x84\xf3\xe3\xbb\xca\xd9\x40\xb2\xea\x6f\x44\xf1\x8b\x36\x2d\x96\x2f\x99\x08\x54\x17\xef\x7b\x58\x26\x76\x18\x8e\xe7\xa5\x46\x63\x27\x9d\x58\x2b\x65\x58\xf2\x6c\x57\xac\x2f\x0a\xcb\x4b\x36\x48\x75\x40\xa1\x0d\x3b\x10\x4b\xda\x19\x77\x13\x5a\x88\x47\x05\xd5\x82\x27\x87\x63\x46\x37\x70\x78\xdf\x5c\x45\x18\xf4\x9a\x38\xad\x0b\x71\x39\xfa\x9d\x32\x9e\xc5\xc0\x1b\x48\xaf\x80\x73\x85\xfd\xd7\xc8\xae\x1a\xd4\x5e\x05\xe1\xb2\xde\xa3\x8a\x1a\xbf\xc4\x6b\x55\x6d\xec\xb0\x85\xdd\x8a\xdb\xe9\x42\x3c\x87\x84\xb4\xbf\x02\x80\xf2\xf9\x99\x48\x10\x9c\xf2\x17\xbe\x00\xa8\x8d\x3d\x80\xaa\x38\x9c\xd6\xd3\x69\x1c\x7b\x60\x03\xf8\x8c\x45\x1e\x0e\xc4\x07\xa7\x8c\x4f\x93\x71\x29\x8c\x3a\x88\x1c\x8a\x86\x2c\xce\x48\x80\x0f\xc4\xf1\xf1\xfa\xc9\x99\x7b\xfc\x68\xfd\x24\x1e\x72\xcd\x4e\x35\xb7\xb8\x05\xb4\x59\xdb\xcf\xa0\x97\x22\x46\xc3\x04\x92\x70\xd6\x8a\x9d\x1d\x07\x92\x0d\x83\xec\xe4\x15\xe4\x16\x73\xdf\x0f\x96\x98\x8c\x06\x36\x36\xec\xb1\xb4\xae\x41\x7b\x1c\x56\x36\x9c\xc4\xbc\xb4\xfb\xc1\xee\xf4\x5a\xfb\x40\x00\x41\x95\x72\x05\xff\xaf\x29\x59\xb5\x13\x88\x8c\x97\x1a\x22\xb9\xd6\x4e\xf4\xb1\x00\x1e\x46\x9d\xdd\x6e\x51\x17\xfb\x85\xe5\x11\xb8\x4b\x18\xca\x4e\xef\xb5\x9f\xad\xee\x40\xc7\x25\xed\x12\xd2\x77\xf3\x34\x41\x77\xd2\x40\x0f\xaa\x51\xc6\x77\xc7\x58\xdf\x41\x6a\x2f\xfe\x2c\xf6\xda\x8c\x3e\xc8\xf2\x3b\x65\x84\x1f\x8e\x42\x6e\x65\xa8\x76\x27\x5d\x3d\x1a\x9a\x31\xd5\xf2\x7a\x7f\xa9\x81\x95\x08\xf5\xf2\xae\xcc\xa0\x4a\xf9\x56\xfc\x10\x27\xf3\xc7\x15\x69\xbe\xa1\x54\x38\xde\x43\x7b\x74\x10\xc6\xe4\xd2\xb2\xb0\x43\x64\x42\x09\x50\x48\x58\x42\xd6\xa8\xb4\x30\x3a\xdd\xdc\xc2\x78\xad\x47\xef\x6d\x10\xb4\xbb\xb0\x18\x61\xc4\x62\x8b\x9f\x02\x14\xa8\x41\x00\x5b\xc8\xc3\xd5\x34\x1d\xa3\x0a\x8a\x05\x08\xbf\x5c\xf8\x87\x41\xfd\x98\x8a\xc7\xbd\x03\x25\x08\x05\x96\xce\xb6\xd5\x3b\xc8\xc4\x4b\x0d\xde\x7c\x7c\xaa\x36\xa4\x66\x8e\x73\x39\x94\x63\x01\xf9\x61\x87\xa8\xcf\xbd\x1e\x82\xc8\x35\x00\x0b\x06\xa5\x57\x93\xba\x92\x4e\x62\xde\x63\x5f\xb6\x38\x1d\xbc\xde\xda\xda\xed\x90\x79\xe2\xe6\x89\x4e\x99\xad\xdf\xa1\xd6\x31\x30\xee\x5e\x84\xf1\xf6\xe2\x7f\x82\xba\x5c\x36\x5e\x0d\x6e\x55\x19\x6b\x6a\x20\x47\xd9\x26\x7a\x63\xcd\x43\x24\x51\x2c\x89\xb1\xde\x97\x2e\x21\xb8\xe2\xb0\xde\x06\x3b\x6e\x77\xa4\xaa\xac\x70\xf7\xf8\x83\xad\x37\xb2\xf1\x70\xa1\xf5\xfe\x60\x1f\xd2\x47\x49\x0c\x67\xc0\x30\x06\x34\x98\x13\xba\x79\x4d\x39\xf3\x32\xca\x04\x32\x3e\xa8\xc6\xde\xa9\xe1\xc8\x73\xf1\x6b\x48\x15\x52\xf8\x54\x39\x83\x88\x65\x3c\x31\xbb\x68\xf1\x3b\x4a\x3d\x0d\xcf\x35\x32\xa4\x78\x7a\x4f\x33\xb3\x0e\x2e\xb4\xb0\x3f\xd9\xc9\xc4\xa0\x9f\xa8\x14\xbe\x99\x82\x8c\x0e\xd7\x18\x95\x5a\x55\xd5\xc7\xb0\xa8\x3f\x55\xb4\x53\x54\x36\xd5\x44\x45\x38\x87\x77\x14\x92\xcd\x08\xcf\x12\xd5\xbf\xab\x41\x6f\x8e\x08\x54\xd0\x88\x53\x1b\xa6\x5c\xaf\xf1\xd4\x4d\xac\xed\xbb\x9c\xb6\x53\xf2\x66\xec\xce\xc5\x01\x79\xde\x54\x26\x2a\xb2\x88\x1b\x16\x81\x52\xc0\x35\x79\xf5\x71\x6f\x5b\xd9\x7d\xaa\x8e\x70\x1d\xf8\x1f\xca\x55\x06\xae\x60\x6d\xb5\xb7\x2d\x16\x7a\x0d\x3f\xaa\xea\xe3\xc6\x0e\xfb\x4f\x55\xe0\xa7\xde\x4c\x44\xcf\xc0\x78\x51\x5a\x26\xfc\x40\xd6\xaf\xf9\x15\x73\xec\xf3\xf5\x82\x94\xfa\x4e\xa5\x9b\x66\xf8\x15\x3b\x7f\x73\xf3\xf2\x3d\xab\xd6\x6e\x5e\x8a\x5b\x45\xb8\x5f\x7a\xdf\xbb\x0f\xa0\x30\x46\xed\xef\x87\x77\x57\xd5\xb5\x3c\x06\x81\x10\x93\xe9\x03\x32\xde\x2b\xb9\xa7\x46\x86\x9f\x88\x22\x6c\x16\x4a\x0c\x3f\xed\x90\x5f\x95\x54\x20\x74\xfc\x5a\xc8\xc4\x48\xe4\xaa\x37\xea\xf0\xcb\x20\x4d\xc3\x85\x03\x37\xb8\x86\x04\x2c\xf9\xd4\xee\xf7\xda\xdf\x8c\xfb\xbd\x84\x8d\x81\xdf\xc2\x61\x02\x65\xbf\x56\xce\xa1\x1d\x00\x65\xef\x31\x81\xb2\x9f\xee\xac\x6e\xb2\xdc\x06\xbe\xab\xf7\x83\x52\x54\xeb\x73\xbe\x75\xab\x40\x02\x40\xf6\x14\x7f\x55\x51\xb1\xa2\xe8\x7a\xfc\xb7\xd9\x0d\xd4\x6f\x95\xec\xfa\x9d\x04\x19\x23\x03\x8b\x64\x2f\x64\x9a\x71\xaf\x06\xdd\x80\x72\x4e\xba\xdd\x0f\x0f\xeb\x1f\x73\x22\x58\xa0\x68\xad\xff\x16\x34\xe1\x37\x12\xc6\x93\xd8\x5c\xf7\xe5\xa6\x9d\x03\x46\x11\x50\x9e\x03\x42\x3b\x08\x28\x57\x62\x76\xfa\x77\x1e\x0b\x40\x15\xbe\x23\xbe\xb3\x00\x01\x02\x67\x82\x8a\xf5\x01\x5f\x12\x84\x4f\x3e\x06\xce\x5c\x89\x7a\x2f\x3f\x7f\xa9\xe0\xde\x2e\x94\x43\xcd\x7c\x2a\x44\xfa\x05\x89\xc7\x5b\x49\x26\x56\xbf\x55\xe3\x70\x0f\xf0\x87\x77\x57\xab\xdf\x2a\x6d\x9a\x6e\x6c\x4f\x36\xc4\x8d\x6b\xe7\x87\xc0\x76\x3d\x38\x73\x0f\x02\x4a\x73\x6b\xec\xc1\x44\xf8\x0f\xf8\x2d\xe0\xfb\x67\xb6\xf5\xa8\xb5\x21\x9d\x47\xb2\xfa\x10\xad\x6e\x03\x17\x03\xba\x8b\x55\x3a\x4f\x73\x7d\x46\xdc\xe5\xa0\x0f\x26\x8d\x53\x24\x74\x41\x44\x00\xd5\x8e\xdc\xab\x55\xb2\x4f\xa9\x03\x33\x5c\x07\x09\xdc\xe4\x22\x73\x60\x02\x98\x4a\x03\xbb\x0c\x10\x2b\xbc\x98\x9c\x97\x9b\x90\xa1\x93\xc5\xed\xb0\x5d\x28\xfd\x76\x7e\x69\x7a\xa2\xbc\x57\x72\xbf\x80\x20\x12\x98\x93\x05\x71\xee\xa1\x10\x1c\x3a\x13\x0a\x39\x2f\x17\xa0\x56\x69\x94\xe2\x80\xe7\x73\x93\x2b\x18\xe2\x38\x97\x5a\xab\x42\xca\xaa\xf7\xda\xf1\x64\xbd\xdf\x81\x86\x32\x67\x1d\xa2\xd2\xbb\x53\x4d\xe0\xaa\x79\xc9\x39\x90\x59\x43\x0a\x98\x14\xb0\xbe\x73\x55\xc1\x51\x3d\x80\x09\x52\xa6\x16\x23\x45\x25\x9d\x97\x7b\x79\xab\x84\x1b\x03\x6b\xb6\x93\x9e\xa4\x94\x72\xb2\x02\x97\x0c\xa8\xb0\xce\xd8\xf2\x19\x7a\x7b\x30\xe1\x78\xfb\x12\x7e\x00\xfb\x46\xd4\xb9\x1e\x75\x8e\x98\x90\x47\xa0\x53\x68\xa3\x8a\x4f\x7d\xd6\x70\xb7\xf6\x42\xdf\x29\x52\xf2\x45\xdd\x26\xe4\xad\xaa\x4e\x3a\x5f\x87\xf5\x88\xcd\x05\x71\xd6\xde\x85\xcd\x1a\xea\x0b\xb9\x62\x08\xab\x06\x6c\x66\x00\x03\x6a\xf5\x0c\xf5\x2f\x2c\xc5\x38\x45\x5d\x67\x0f\xaa\x3d\x17\x61\x15\x99\xd2\x08\x00\x28\x82\xec\x0e\xf2\xe8\x48\x82\x61\xba\x66\x0d\x8d\xd5\xaa\x4a\x3a\x42\xb7\xab\xc3\x81\x1b\x99\xf4\xbb\xc0\xc8\xf0\x0a\xb1\x9b\x74\xdd\x1d\xa0\x50\xd7\xf7\xb3\x38\x73\xd5\x88\xf7\x0b\x00\x7e\xcc\xd0\x80\x71\x0d\x9d\x44\x77\x19\x53\x44\x28\xce\x83\x28\x23\xb4\x7f\xe0\xc2\x3a\x1b\xf7\x28\x02\xad\xe9\x42\x22\xca\x6e\xad\x1d\xd7\x9d\x7a\x88\x92\xb1\xe6\x55\x1d\x55\x8d\x13\x1e\x38\x36\xeb\xae\xaa\x9c\xd7\x5d\x17\xc6\x98\xcd\xcd\x0a\x49\x15\x72\x61\xf3\xc1\x40\xb8\x9d\xee\x85\x85\xcb\xbc\x7c\x90\xd2\x82\xcd\x04\x41\x6f\x45\xab\x40\xf2\xb6\x83\xf0\x83\x34\x6e\xa3\xe0\x76\x73\x8f\xf7\x03\x2b\xaa\x3a\xc8\x95\x68\x5e\x76\xa2\x66\x54\x62\x40\xd5\xf9\xa9\x03\xb3\x93\x4d\x64\x59\x35\xda\x16\xc0\x15\x1a\xb4\x01\xc6\x34\x61\x72\xdc\x86\xb0\xc0\x66\x43\x00\xb7\xe9\xc5\x22\x59\x1c\x87\x4d\xa1\x81\xc3\xfa\x61\x35\x7d\xa1\xdf\x15\x9a\x6f\xd5\xc8\x20\x15\xfb\xe1\x3d\xe4\x30\xeb\x34\xdd\x12\xd5\xc7\xb0\xce\x3f\x55\x28\x3b\xd5\xf1\x8a\xf2\x29\xca\x52\xc8\x71\x43\x62\xf5\x9f\x56\x9b\x1a\xee\xdb\xfe\xc5\x6a\x03\x97\x73\x55\x61\x92\x32\x51\x0f\x92\xe1\xdc\x11\x6c\x65\xd6\x9d\x6e\xd8\x7a\xee\x58\x6d\x2c\xec\x1e\xd0\x1e\x3e\xe7\xdf\x95\xf3\x32\x10\x08\x32\xa8\x08\xbf\x0a\x75\x24\x16\x42\x5d\xf5\x73\xfe\x4d\xa9\x31\xa9\x1a\x4d\x4c\xf9\x40\x3f\xab\x2a\xf0\xd5\x2b\x20\xea\x41\x14\x80\xfb\xd9\x8c\x94\x87\x93\x3a\x6c\x6b\xce\x5b\x65\xf0\xbd\xf4\x5e\x0d\x06\xef\x52\x70\xcb\xe7\x45\x29\x3b\xa2\xc8\x28\x43\x18\x5b\xb6\x2a\xfc\x54\x25\xdb\x43\x36\x3b\x5c\xba\x46\x8a\xc3\x8f\x37\xae\x15\xed\x69\x47\x6c\xf9\xbf\xaa\xa3\xab\x9c\x6a\xc6\x01\x87\xf5\x86\x7e\x2e\xab\x67\x49\x5f\x3c\x31\xad\x4c\x97\x01\xae\xb4\x02\x71\x15\xad\xb1\x0b\xf1\x0c\x7f\xb0\x82\xaa\xea\x61\xfa\x32\xfb\x49\x9a\xcf\xd8\x15\x32\x9f\xcd\x15\x53\xa5\x96\x46\x3b\x81\x48\x80\x51\xe1\xeb\x3a\x38\x96\x37\x76\x10\xd2\x1c\xd3\xc5\x9f\xea\xe0\xe0\x33\x99\x19\x80\x3b\x87\x72\x01\xec\xa0\xd6\x7c\x37\x9c\x8c\x6a\xf6\xb2\x55\xe2\x4e\xcb\xa8\xd8\xca\xd8\xa5\x78\x9e\xb3\xb2\xb4\xd0\x21\x80\x18\x84\x8a\x6c\xe6\x96\x78\x9a\xbd\x65\x8d\x82\xdf\x29\x8d\x57\xb3\x06\x38\xa9\xcd\xd8\x75\x7c\x26\x3e\x1f\xbb\x0e\x4d\xc4\xe6\x66\xca\xa1\x0a\xba\xa2\xbe\xa2\x9f\xd5\xd8\xb7\x41\x68\x4d\x63\xf9\x01\x12\xe2\x58\x96\xf9\x99\x30\x0a\xa3\xca\xc5\xa2\x4a\x13\xc1\xdb\x4c\x3a\xed\x8e\x2b\xde\xcd\x0b\x06\xc9\xb4\xb1\xdb\x29\x48\xd2\xfa\x01\xa5\xa2\x8e\xc3\x44\xa1\x0d\x10\x0c\xed\x41\x1e\xc5\xce\x1e\x44\xa7\xcd\xad\xa3\x99\x0a\xe3\x94\x0b\xe6\xa0\xa8\xf5\xda\x8c\x8a\x44\xa5\xf0\x73\x6e\xfe\x4a\x36\x03\x64\x41\xb0\x3e\xb2\x36\x0c\x6d\x0c\x68\x03\x88\xf5\x51\x80\x34\x78\xda\x58\x61\x6a\xa5\xc0\x46\x0a\x7c\xf9\x0e\x36\x12\x89\xae\x7d\x70\x4a\x3c\x45\xbb\x09\xda\x63\xcd\xce\x5a\x47\x37\x10\x89\xfa\x85\x34\x50\x06\x12\xf1\xa3\x69\x49\x78\x70\xd6\x2e\xd9\x7e\x03\xf6\x39\xed\xa0\x9a\xae\x14\x13\x34\x6d\xa8\xa7\x74\xd5\x78\xc9\x38\xd1\x3e\x83\xfb\x04\x34\xa6\xd6\x7b\x14\x58\x3f\xb0\xf5\x06\x4c\x78\x94\x45\x20\x7b\x55\xb6\x67\xba\x4a\xa8\x5e\xbe\xc2\xfb\xc2\x62\xe1\xa5\x90\xdf\x5d\xe3\xf4\x47\xba\x64\xbb\x82\x5d\xe3\x7e\xc4\xfc\x30\x78\x59\xfe\x1b\x30\x3d\x88\x7a\x95\xb0\xc7\xea\x09\x08\xa9\x22\x0a\xc8\x45\x86\x9b\xeb\x3a\xc9\x6c\x4f\x5a\x3f\xdb\x31\x5c\xee\x20\x5d\xd1\x71\x5a\xe3\x24\x3a\x49\xb8\x2b\x2a\x88\x52\xa6\x3f\x4f\x4d\xa3\xda\xfe\x51\x5a\xc2\xf8\x56\x15\x8a\x29\x2e\x4a\x27\x97\x48\x31\x95\x63\x3b\xf9\x98\x4f\xa6\xf2\x05\x61\x55\x6c\x7c\x96\x93\xde\x7e\xd0\xa0\x13\x29\x49\xf0\x8c\xe8\x16\x04\x16\x46\xc1\x82\x29\x55\xa2\xab\xab\x8a\x51\x85\x63\x0b\x7e\x71\x4a\xd4\xba\xdd\x28\xb0\x27\xa6\x64\xde\x01\x9c\x8b\x0b\x3f\xb6\xb1\x53\x44\x0e\xb1\xaf\xcf\x28\x61\x92\xcf\x9d\xc1\x6c\xe0\xce\xb5\x5b\xea\xcd\x10\xd8\x77\x15\x4f\x0c\x6d\xd0\x92\x2d\x1a\x24\x14\x64\x49\x3c\x03\x3a\x25\x0e\x12\x2f\x81\x98\x4a\xfd\x65\x5a\x7b\x5a\x40\xbf\x96\xd7\x47\xd8\xb7\x72\xfb\x7c\x57\xc9\xb6\x85\xc5\x9d\x0c\x3b\x5a\x20\x1c\xa5\x0a\x32\x40\xe5\x10\x68\xf8\x11\x53\xeb\xe2\x72\xcb\xa1\x9e\xe9\xeb\x2f\xb4\x02\xfb\xf1\xdf\x70\x97\x55\x54\x95\xee\xb2\x62\x23\x27\x5b\x6b\xd6\xcb\xf9\x1e\x93\x6d\x0b\x9c\x10\xad\xe5\x8c\x9f\xa1\xd5\x1c\xd9\x9a\x50\x0b\x8a\x2f\x61\x78\xfe\x55\x1d\x81\xf9\xa1\x95\x00\x67\x92\x76\x42\x82\x2d\x2b\x18\xc0\xa3\x2c\xe3\x66\xa2\x72\x39\xe7\x97\x70\xe9\xe4\x14\xc1\x02\x63\x28\xcd\x31\x30\xfa\x60\x31\x8c\x4c\xb4\xb7\x62\x2b\xa3\x89\x50\x3c\xd0\x4a\x56\x5c\xc3\x25\xdb\x4e\x6f\x77\xdd\x51\xe8\x7d\x6f\x07\x0f\x2b\x89\x4d\x1d\x92\xf0\x1a\xbe\x06\xd5\xd8\xad\xd1\xbf\xc3\xc0\xee\xd1\xd4\x39\x5e\x9e\x3c\x76\x7e\xb0\x66\xfb\xe4\x19\x58\x42\xdd\x06\xc2\xb3\xb3\x87\xbf\x3c\x7e\x44\xe9\xe2\x29\x4c\xa1\x1d\xbd\x78\xa1\xfd\xcb\x71\xfd\xc0\x89\xed\xa8\x5b\x38\x6b\x1f\xcb\xcc\x37\x83\xac\xa7\xd0\x0e\xfd\x60\xe2\xb0\x80\xa7\x86\x1d\x84\xb3\xdd\x9d\x9a\x14\xb1\xfb\x3d\x4e\xef\xba\x53\x7b\x84\x84\xf6\x83\xc1\x95\x32\x30\x72\x6a\xa0\xf1\xb9\xb9\x79\xb9\x8a\x4b\x3c\xcd\x0f\x4d\x1b\x33\xa8\x85\x96\x85\x98\xc3\x00\xdc\x90\xce\x34\x9d\x40\xa0\x62\xe1\x52\xc0\x78\xcc\x4b\xc1\x3c\xba\xc0\xac\xcc\xf4\x3b\x20\xb5\x04\x14\x5c\x5c\x5c\x84\x76\x20\x03\x16\xd2\x9a\x99\x96\x96\x16\x56\xb6\x78\xc3\xa1\xc3\xe2\x34\x30\xee\xb1\x79\xb0\x5c\x27\xfb\x9b\x28\x1a\xf6\x9d\xe8\x19\x77\x20\xa3\x68\x34\x22\x89\xa6\x4d\x61\x0a\xaa\xa6\x90\xa6\x71\x2b\x72\x6a\x86\xa6\xa5\x48\xd1\x70\x41\x2a\x07\xf4\xfa\x2b\xa9\xd9\xac\xde\xd4\x71\xae\xee\x2b\x28\x1a\xf4\xe9\x12\x86\xc3\x1a\x54\x9c\xd0\x44\x5d\x49\x34\xc4\x83\x0c\x63\xeb\x4c\xcc\x7b\x63\xe9\x0a\x58\x70\x22\xcc\x89\xf3\x81\x55\xc9\xb7\x72\x68\x04\x18\xed\xa3\xd1\x21\x68\x5e\xfe\x37\xd1\xca\xa3\xab\xbc\xbd\x55\x66\xa1\x08\xa4\x9f\x2a\x54\x7d\xe5\xa5\x5e\x76\x6b\x15\x6a\x18\x1d\xca\x9a\x7e\x74\x3f\xe7\x79\xe8\x4b\x57\x80\xdb\xcd\x26\xa4\x6d\x36\x55\x71\x6f\x46\x96\x75\x68\x86\x99\x67\xb1\xdb\x41\xb4\x32\xcd\x33\xc1\x32\xa7\xb8\x2e\x73\x6c\xa3\x03\x36\xf5\xb2\xdc\xb3\x61\xd7\x12\x41\xca\x6e\xd4\x70\xe7\x06\xaa\x25\x9c\xdc\x28\xd1\x77\xb2\x51\x2b\x76\xb8\x01\x0b\x5b\x20\x6e\xe1\x70\xe6\x9b\x3d\x8d\xf7\xe3\x9d\x75\x6a\x4a\xec\x26\x8a\xc9\x4c\x4e\x5c\xe5\x4d\xdf\x79\xdf\xa3\x21\x47\xee\x13\x90\x58\x06\x32\x17\x00\xf6\x47\x74\xd6\x6c\xd5\x10\xed\x44\x43\x93\xfa\x4e\x92\x95\x29\xec\xde\xd0\xdd\xc8\x0b\x45\x2b\x05\x36\x09\x6d\xa1\x48\x1a\x89\x8f\x3f\x7d\x72\x67\x1f\xff\xf4\xc9\x7d\xff\xe4\x5a\x0d\x0e\x8c\xf0\x2f\xb1\x1b\xef\xc3\xf2\x80\x11\x91\x8e\x6e\xb9\x07\xd5\x86\x0e\xc9\xee\x5c\xa8\xd5\x76\x25\x1e\x87\x21\x78\x72\xf6\xf1\xcf\x9f\xdc\xe3\x47\xf0\x7b\x35\x9f\xcc\x64\xc5\x8f\x73\xfb\x75\x6b\xa9\x91\xa6\xfe\xdb\xc4\x33\xec\x0b\xa3\x0a\x36\x7d\x61\xa2\xc2\xc1\x0b\x4c\x7d\xb9\x04\xf9\x56\xd6\xa9\x66\x50\x1e\xe4\x78\xd4\x7f\xa2\x8c\x0b\xa9\x45\x89\x50\xd1\xfc\x26\xf7\xfd\x4e\x19\x2a\xc7\xa9\x45\x29\xd2\x0f\xf2\xed\x69\xb5\x70\xaf\x5b\x62\x4b\x8b\x69\xa2\x91\x8d\x46\x03\x91\x11\x89\x96\x1e\xdf\x55\xc5\xdd\x74\xd8\xc1\x5f\x85\x75\x51\x43\x5f\xa2\x37\xc4\xb3\x1a\xf5\xdd\xc2\x64\xf2\xa5\xcb\x7c\x32\xe5\x49\xf5\xe5\x1c\x4b\x22\xa0\xa7\x11\x80\x05\x85\x41\x99\x60\x4a\xac\x27\xe4\xf5\xd4\x3d\xbd\x8b\x6b\xef\xe4\xa2\x2b\x2f\xf2\xdd\x3d\xa8\x88\x74\x16\x77\xf0\xe4\x15\x10\xe8\x67\x74\x08\xf4\x2a\x70\x32\x72\xd0\xdd\xf1\x5b\xc9\x82\xf8\x55\x36\xbb\x92\x26\x01\xe5\x61\xf3\x70\x3a\x23\x1a\x75\x2e\x1e\xaf\x9f\xd0\xa4\xdd\x2a\xd5\x13\x4b\x86\x4d\x9a\x10\xb0\xc7\x8f\xd6\xe5\xb6\x1c\x14\xfa\xf0\x79\x35\xa7\x98\xef\x62\xde\xbd\x03\x73\x02\x41\x5c\x1d\x19\x9a\x92\xc2\x9e\x58\x16\xa7\x31\x96\x3c\xc6\x04\x59\x3c\x75\xb9\xf4\xf4\xdc\x9d\x1f\x1f\xc9\xd7\x95\x8e\x93\xaf\x22\x47\x5c\x78\xc9\x4e\x2c\x6a\x0f\x3b\x75\xa7\x3a\x64\x3c\xda\x40\x4c\xc0\xd0\x62\x13\xe8\x44\x94\x6d\xfd\xa9\xd5\x7e\x0f\xf7\xb1\xd0\x8c\xaf\xdd\x3e\xb1\xde\x72\x54\x58\x76\xc0\x85\x59\x23\x1f\x10\xe5\x87\xc5\x73\xc0\x55\x71\x82\x02\xdb\xca\x45\x5e\xf0\x2c\x87\xc9\x01\x40\xe4\x36\xe2\x6e\xc1\xc2\x49\xe9\x9f\x26\x0a\xb8\x7c\xf2\xb3\x82\x75\xed\x6d\xdc\x29\x3b\x34\x70\x16\x97\xd7\xaf\xdc\xaa\x8a\x15\x32\x52\xd8\x25\xd8\x84\x03\x6a\xfc\xc1\x0c\xba\xeb\x66\x5b\x8d\xf5\x67\x58\x9c\xb8\x5b\x68\x13\xf2\xb7\xb1\x53\xb3\x0e\x61\x67\xca\x7c\x1c\x77\xe5\xb2\x15\x80\xb5\x41\x4b\xa6\x82\x5a\xec\xea\x77\xe2\x75\xba\x85\x0b\x33\xdb\x1f\x83\xe8\x13\xdd\x31\xce\xe9\x80\x15\x07\x10\x5e\x26\x6e\x20\xda\x23\xc5\x17\x81\x7f\x1d\x22\xf3\xcc\x0d\x26\xf6\x39\x9f\xca\x9c\x87\x5e\x9c\xcc\xc4\x51\x2f\x16\x5b\x62\xab\x7b\xc6\x53\xf6\xf9\x4b\x4c\xb6\xdd\x94\xf4\xed\xe4\x22\xcf\x7b\x95\x2d\xef\xeb\xc5\x6a\xe3\xb6\xc7\xaa\x27\xcb\x5b\xa0\x0c\x88\xa6\xb2\xc0\x24\xa1\x62\x11\x57\x44\xc6\x2e\x48\x27\x0e\xaa\xeb\xf2\xd5\x81\x57\x3c\x2e\x2e\x92\x89\xdc\x54\xc8\x4c\x6e\x55\xc1\x85\xc0\xca\x04\xd9\x17\x1d\x71\xa2\x92\x8a\x6e\xb1\x60\x00\xcc\xb1\xb8\xa6\x72\x2b\x2c\x06\x97\x5f\x91\x1c\x5d\xd1\x55\x58\x16\xd5\x21\x83\xca\x7c\x7d\xd0\xff\xb4\x3c\x57\x70\xec\xb3\x7b\x23\xf0\x07\x50\x72\xef\x88\x00\x01\x8b\xaa\x36\x74\xb3\x9c\x55\x72\xcf\x94\xe0\x15\x08\x36\x80\x1b\x98\xa7\x4d\x9a\x9e\xae\x17\x0b\xa0\x2f\xb4\x7c\x72\x93\x5e\xb6\xf6\x9e\xc6\xe5\x55\x14\x3a\x14\x24\x06\xd0\xd7\x0c\x2f\xc8\xa4\x13\x22\x48\x4b\x2e\xd9\xc6\xd1\x7a\x2f\x2c\x89\x09\x28\x53\xe5\xab\xc4\x9a\x33\xad\x4f\x77\x97\x8c\xac\x57\xc3\x5e\x1a\xb0\xdc\xc5\x7b\x16\xd6\x4f\x3c\xbd\x7c\xf3\xe6\xed\xfb\xa4\x96\x08\xc4\xcf\xb4\xc0\x6b\xb1\xc3\xd3\xac\x5d\xec\xf6\x14\x77\x6d\x09\x91\x1c\xaf\xa8\xc4\x29\xb8\x5c\xf6\xcb\x8c\x9c\xb7\x16\xb4\x36\x70\x5f\xcd\xd2\x6b\xd1\xfe\xf6\xe4\x0a\xf9\x18\x86\xf8\x53\xc5\x77\xff\x6f\xc3\xff\x2a\x37\x9f\xc8\x2c\x5a\x80\xde\x26\xc3\x97\xe4\x91\x2f\xb6\xd6\xb6\x33\x73\x0a\x10\x4b\x47\x70\x3a\x6b\xec\xbe\xb7\xc0\xf9\x6c\x04\x58\xbd\x9e\x87\xdd\x65\x07\xa0\x92\x20\xd2\x18\xfd\xb7\x11\x14\x52\x60\xa4\xba\xaa\xee\xb4\xd3\x6b\xdd\xa1\x08\xfd\xef\xf1\x03\xd3\xc3\xaf\x89\x4f\x76\x56\xb9\x76\xe2\xb1\xeb\xa5\x11\x4d\x27\x9d\xbb\xf8\x7e\xd4\x22\xf0\xcd\x5e\x7d\xf6\xdf\x3f\xb9\x1e\xc0\x3e\xf2\xf1\xa3\x00\xf1\x64\x86\xae\xde\xd8\xa1\xc1\xdb\xd6\x68\x09\x0e\xc4\x8a\xd2\xc3\x36\x35\xc0\xc5\x64\x5b\x15\x07\xfe\x0f\xd4\xb9\xb1\xc3\x6d\xea\xc7\x0f\x74\xc1\x60\x37\x48\xb0\xef\x64\x37\x96\xb7\x4d\xa1\xf6\x50\xc6\xfd\x58\x81\xc3\x79\x2a\x0b\x4e\x02\x10\x6a\x28\x64\x68\xb3\xfd\x0b\x0c\x9a\xbf\x3f\x88\xc9\x4b\xd5\xf5\x41\x3c\xfc\xae\x82\x96\xd0\xad\xfc\x34\x6a\x0d\xe4\xb1\x37\x76\xc8\x03\x97\x6c\x48\x5d\x98\x8d\x2c\xb6\x85\xec\x58\x32\xcb\x66\x33\x90\x53\xe8\x44\x7e\x93\x7d\x24\x83\xaa\x78\x6c\xb9\x66\xd0\xe0\x51\x8e\xe9\x9d\x84\x0b\xee\x18\xb6\x08\x12\xb7\xda\xeb\xad\xb1\x43\x36\x0c\x37\x60\x32\x24\x56\x31\x4b\x70\x20\x24\x57\x75\xba\x51\xc6\x01\xb5\xc3\x5f\x9c\x32\x2b\x2e\x05\xc3\xc2\xe5\x63\x38\x30\x68\x2b\x84\x1f\xf4\xbd\x50\x8a\x00\xb9\xca\x4a\x8e\xde\xd6\xda\x68\x0f\xbe\x44\xd1\xf5\xcc\x4f\xd6\x2b\x9e\x50\x6c\xec\x84\x7e\xd3\x48\xfd\x09\x0f\xb9\x03\xd1\xf4\x90\x1f\x50\x36\x41\xe4\xbd\x4c\x76\x0e\x30\x7e\x90\x20\xd0\x54\x94\x62\x1e\xd5\xfd\x30\x1a\xbc\x6b\x1f\x8d\x2a\x12\x93\x60\x84\x7c\x80\x39\x52\x74\x8d\x87\x7e\x90\xcd\x6d\x20\x2e\x83\xda\xa8\x41\x99\x06\x1c\x16\xa4\xcf\x14\x19\x68\x52\x61\x0d\x1d\x04\xa1\x18\x23\xd7\x41\x64\xbd\x03\xbf\x19\xf4\xbf\x12\xaf\x38\xe5\x87\x9d\x1d\x87\x1f\x19\x90\x55\xe5\x11\x8e\x2e\x7c\x26\xf9\xdc\x4e\x52\x28\x90\xd5\xa1\x30\x2a\x1c\x0a\x72\x40\x87\xee\x4c\xc7\xe1\xd8\x2d\x36\xba\x20\x12\x3e\x50\xdd\xb9\xa3\x69\x92\xf2\xee\x06\xbe\xaa\x83\xf4\xcd\x0e\x6d\x30\xfe\x4a\x3f\xc1\x04\x63\x2b\x7f\xc7\xd4\x9b\xf8\x01\x5b\xc0\xd1\xa6\x70\x69\x01\xd3\xca\xcd\x42\x39\xa4\xc4\xc2\x98\xe5\xb8\x12\xaf\xe5\x67\xbd\x1f\xf7\xe2\x9f\x7f\xfa\x53\x66\xa3\x49\x8e\x00\xab\x39\x4e\xf2\x10\x00\x5b\x08\x72\x61\x4d\xc5\xc8\xa4\x63\x50\xb2\xd9\x91\xdb\x8a\xdd\xd4\x18\x44\x06\x58\xc9\xf7\xd1\x28\x2d\x90\x34\x80\x53\xad\xd8\x53\x1b\x22\x20\x14\x0d\x2d\x3d\x2b\x8d\x4d\x56\xcb\x26\x23\x53\x9b\xc7\x6f\xb7\x1c\x99\x62\xb8\xdf\x80\xc4\x28\xd5\xd6\x41\x54\x62\xba\x57\x58\x50\x57\x14\xb3\x8b\x83\x1e\xc5\xa0\x5d\x18\xf5\x28\xcf\x3d\x7d\x84\x44\xd7\xe9\x92\xaa\x83\x1f\xe4\xba\x1b\xd5\xf7\x4f\x70\x21\x31\x49\x67\xac\xb4\x45\x5f\x53\xd8\xb0\x6c\x8f\x12\xc4\x0a\xe9\x76\x5a\xef\x4f\x21\x70\x48\x5a\xee\x0b\x50\xc5\xa9\x4f\xe2\x96\xcc\x14\x8d\x8f\x5e\xbc\x7a\x0f\x76\xb8\xf7\x14\xaf\xf1\x6e\xa6\x66\x37\xb6\xff\xc0\x50\x58\x10\xe3\x23\xbb\x8e\xe5\x78\x67\x32\x1f\x8c\xf5\x11\xe3\x36\x70\xfc\x96\x5e\x86\xa5\xc9\x75\x05\x3e\x43\x3b\x87\x42\x87\xd1\x30\x9f\x05\x1f\x9d\xb0\x63\x1b\x08\x59\xb9\xb0\x18\x5b\x72\x7b\x6d\x64\xc7\x3e\xaf\xaf\x30\x91\x0a\x86\x44\xb8\x78\x2a\xad\xb6\xd8\x45\x47\xe6\xe1\x7e\x18\x6d\x34\xd0\x4b\xab\x21\xb7\xcd\x23\xaa\x40\x67\x1c\x05\x76\xb3\x9b\x0a\x8f\x29\x4e\xa7\x43\x2b\x7c\x55\x41\x02\xac\x3b\x6d\x6e\x81\xb9\xeb\x8f\x29\x21\xe3\x65\x9f\xda\x5e\xab\xf6\xbb\x2c\x8f\x95\x2b\xd7\x30\xfb\xff\xef\xff\xfd\xff\x3c\x7c\x1a\xda\xfd\xd4\x0f\xdd\xc3\xa7\x2c\x59\x06\x78\x1c\x47\x44\x20\xde\xfe\x6b\x35\x9a\x03\xd9\xcb\x7e\xc0\x5f\x15\x7f\x03\x95\xaa\x46\xe3\xc8\x04\x03\x7e\x54\xf4\x15\x88\x55\x45\x01\xe9\x02\x95\xaa\x2a\x13\x0f\xd9\x37\xb6\x38\x67\xff\x36\xea\xe6\xb6\xc6\x0b\xb5\x0b\xf1\x6f\xe1\x4b\x40\x90\x33\x62\x35\xc2\xa9\x15\x8f\x20\x58\xb4\x93\x73\x2c\xf7\x5a\x05\xba\x45\xde\xf7\xe9\xc8\x92\x25\xeb\x74\xe4\x43\x83\x01\x3b\x6d\x54\xd5\x8f\x6e\x87\x32\x1c\xd7\x76\x3d\xba\x1d\x84\x70\xf9\x8c\x21\x82\x72\x0c\x30\x35\x33\x1c\x6b\x39\xa8\x7a\x1f\xbd\x1c\xa6\xbb\x3b\x2e\x1c\x72\xa4\x4b\x57\x72\x47\xe5\x57\x55\x85\x47\x30\xba\x39\xb8\x2a\x9e\xaa\x74\x9a\xfa\x41\x01\xd2\x41\xa9\x00\xe9\xd5\xc0\x06\x86\xd2\xb4\xb5\x97\x5b\x2c\x19\x58\x1f\x2a\x6a\x07\xe1\xe5\x96\x10\x01\xe6\x5f\xe8\x67\xe5\x25\x98\xa3\xbd\x97\xdb\x79\x74\xbc\x7e\xec\xba\x79\x0c\xbd\x4e\xae\x15\x24\x5f\xc1\x8f\x6a\x1f\x1a\xe9\xad\x51\x78\x7a\xf2\x47\xd5\x80\xf3\x86\x8b\x6e\x1c\xae\xda\x6a\x66\x11\xca\x36\x50\xf0\x03\xd4\x1d\xe2\x4f\x18\x82\x7a\x90\x87\x90\x26\x0f\xf8\xb9\xd3\x8e\x62\x2d\xbe\xc4\x5f\x98\x8c\xf7\x36\x00\x0a\x97\x35\x11\x1e\x24\x10\xda\x23\xd7\xfc\x1b\xb3\xbc\x0d\x3c\xdd\x90\x66\x87\xcd\x79\xbc\xb5\x02\x33\x90\xa9\x76\x3b\x7b\x30\xd5\x9d\x6e\x95\x85\x33\x83\xe2\x31\x60\xb4\xc9\xf5\x60\x0f\x8e\x99\xce\x30\xda\xf8\x19\xa6\xd7\x3c\x48\xb1\x1b\x5e\xbe\x7f\x7d\xf5\xcf\x02\x70\x84\x79\x58\x55\x71\x26\x56\xf6\x4e\x0d\x14\x34\xe4\x2d\xfd\x4c\x99\xe4\xae\x9a\x0d\x19\x98\x6a\xaa\x34\x72\x11\xd4\x79\xd9\x15\x90\x37\x21\x61\x01\x10\x23\x1a\x5e\x76\xdd\x42\x1e\x19\x22\xd5\xeb\x63\x34\xa5\x6a\x05\x5c\xef\x04\x12\x0c\x57\x3c\x09\x98\x4d\x6e\xa6\xac\x1f\xc9\x10\x13\x0e\xb0\x52\x6d\x58\xfa\x2b\x88\x4f\x89\x16\x76\x6f\xd4\x01\xd9\x5b\xca\x42\xbb\xab\x3a\xda\xdf\x81\xff\x52\x0e\x10\xfe\x71\xf6\xaf\xad\xf6\x45\x66\x3f\x28\x58\x07\xd8\x2c\x87\x24\x0e\x46\x16\x1b\xe4\x18\x10\x45\x83\x1a\x90\x19\x6b\xea\x70\xa4\xd6\xbc\xe1\x9e\xa2\xdc\x10\x32\x85\xb1\xe6\x21\x9c\xb7\x90\x59\x34\x02\x48\x51\xde\x12\xcf\x4b\x88\xc1\xf6\xa3\xf3\xf5\x5a\xd5\xd6\xd4\x32\x8d\xcd\x7f\xb0\xdd\xf0\x1a\x5c\xd1\x24\xef\xcf\x70\xf0\xc9\x5b\xf4\x5e\x18\x6c\x10\x54\x05\xf7\x83\x43\xc8\xe5\xc8\x41\xf2\xc1\x30\x8f\xd0\x8f\x1c\x33\xd0\xda\x29\x83\x4f\x21\x21\x03\x2c\x9b\xd5\xe7\xf8\x58\x71\x96\xf5\x2a\xd7\xdb\xcd\xfa\x15\xa8\x56\x0d\x11\xc1\x48\xfd\x9b\x37\x00\x48\x1a\x86\x0b\x4b\x2a\x9a\x6f\xea\x1d\xda\xac\x42\x93\xd2\x51\x06\x1e\x5f\xa5\x59\xc0\xf2\x35\x39\x2f\xb4\xc0\xec\x81\xa3\x37\x2f\x37\xf2\x82\x18\xa0\xb2\xd5\x6a\x95\xd7\x17\xd5\x09\xa0\xb5\x0b\xdc\x7a\x3a\xc4\xcf\x31\x84\x17\x70\x73\xda\xe3\xdd\x28\x9c\x9e\x8f\x56\x01\x96\x55\x97\x79\x81\xad\x65\xbd\xd4\x5a\x6d\x35\x06\xfb\x04\xa1\x5a\x51\x90\x91\x84\x64\x2d\x9b\x5b\xd7\x4b\x88\xf9\x88\xed\x81\xf3\xd9\x0e\xd9\x7a\x6d\x54\x57\x83\x31\xb6\xb8\x10\xf8\x19\x33\x81\xb2\x66\x8b\x9e\x3c\xe6\x26\x6b\x5e\xb6\x6d\xed\xf7\x3d\x5b\x39\x3d\x38\x73\x8f\x1e\x73\xb7\x9f\x3c\xc8\xa0\x12\xc0\x83\xb4\x2d\x5b\x0c\x40\x4b\xb6\x95\x79\xde\xd4\x34\x39\xcf\xa3\xa6\xd1\x21\x18\x83\x1c\xb7\xe0\xa3\xce\xd1\xdb\x84\xfa\xec\x95\x69\x55\x2b\x32\x19\x23\x9b\x1b\x42\x82\x43\xdb\x1d\x6b\x6f\x71\x95\x26\x6a\x83\xfd\x65\x00\x1e\x76\x52\x95\x31\xdb\x8c\xe0\x0f\x43\x77\xbf\x07\xb7\xf4\xa8\x3a\x83\x8c\x54\x5d\x62\x20\x52\x0d\xcc\x3a\xb0\xfa\xcd\x44\x8f\xc7\x84\x67\x03\xe1\xdc\xc0\x01\x06\xda\x03\xf6\x02\x18\xd4\x53\x84\x53\x94\x3d\xf4\x57\x39\x1d\x64\xaf\x00\xb0\x82\x26\x96\xa8\xf4\xa6\xcc\x47\x62\x62\xa9\x3b\x5d\xbc\x44\xd6\xd6\x0a\x83\x72\xd2\x8e\x01\x61\x66\x16\x7f\x93\xca\x32\xd3\x80\x0a\xe9\xa4\xb6\x46\x92\x8d\x9b\xad\xd4\x56\xc7\x00\xb2\xb9\xde\x84\xd7\x02\x2f\xff\x5a\xbb\x5a\x46\xea\x68\x3c\xab\x4e\x49\x12\xee\x25\x19\x8e\x62\xf4\x18\x89\x27\xef\x84\x71\xbe\xaf\x22\xa0\x0f\x50\x87\x3b\xee\xe9\x74\x8f\x91\x58\x59\x60\x93\x82\x33\xf9\x8e\x88\x86\x00\xbc\x7b\x35\x07\x63\x00\xeb\x69\xb5\x16\x84\x7a\x36\xaa\x50\x4d\x6a\x55\xaa\xa8\x90\x33\x73\xd6\xf0\xeb\xbb\x40\xd4\xb8\x36\xb6\x46\x45\x46\x76\x71\x50\x74\x87\x4d\x37\x98\x7c\x4f\x34\x1f\x51\xc7\x70\xaa\x22\xb2\xa8\xad\x0f\xbb\xac\x5a\x26\xa9\x33\x5b\x30\xb6\xbf\x75\xda\x34\x2a\x45\xa7\x55\x2d\xd7\xbf\xba\x5f\xa5\x97\x42\x10\x80\xdd\x07\xdd\x40\x1d\xc2\x2c\xc0\xd1\x50\x54\x62\x87\xb8\xad\x90\x1c\xf2\xfe\xd9\x4a\x6d\xd2\xf6\xf2\x16\x7c\x91\xf0\x54\xf1\xbb\xec\x04\x29\x7b\x3a\x5b\xca\x97\x38\x8c\xa0\xe0\x4a\x53\xf6\xf5\x8b\xda\x58\xa6\xad\x81\xf4\x04\x5e\x10\x67\x27\x48\xae\x68\x4c\x93\x9d\x64\x21\x3b\xb5\x07\x62\x4f\xda\x9a\x2c\xc2\x69\x3b\xa4\x48\x50\x98\xfe\x88\x8c\x6a\xd2\x64\x43\x53\xd1\x0b\x35\x48\x86\x13\x6c\x74\x2c\xce\xb0\x11\x21\xfe\x12\x9a\x70\x0e\xb8\x71\xdd\xea\x81\x48\x31\x7e\x90\xb0\x9a\x88\x0d\xb9\xb0\x41\xf3\x23\x53\xe6\x26\xed\x8f\xfc\x99\x63\x5b\xd7\x13\xb5\xe6\x38\xa0\x13\x7a\x28\x19\xbc\x88\xa0\x62\xa1\x81\x09\x7f\xe2\xf8\x89\xd0\x33\xe3\x5f\xc2\xe5\x42\x06\xe7\x4c\x42\x1b\xd1\xca\x4a\xf9\x1b\x0d\x92\xe1\x73\x6d\xda\x98\x26\x41\x8f\x13\x5d\xe2\x63\x7a\x92\xe4\xc8\x73\x3d\xe6\xd0\xd9\xf8\x0c\xb4\xa4\x94\xc6\x11\xad\xde\x86\xff\x31\xd5\xa8\x03\x29\xca\x0f\x6a\x88\x11\x9f\x30\xee\x7d\x20\xfb\x20\x73\x65\xc9\xab\xa9\x9c\x95\x65\x05\x92\x11\x12\x51\x88\x86\xfc\x3c\xbb\xe9\x94\x1c\xea\x58\xfe\x69\xf8\x14\xdd\x0c\x4b\x14\xdc\x72\xb9\x6d\x52\x4d\x0e\xf3\xc6\x2e\x83\x61\x75\x39\x24\xd6\xb8\x5f\x02\xb6\xbd\x32\x05\xec\xdb\x5e\x99\x5c\x6c\x2c\x10\x5b\xa7\xda\x09\x66\xb8\xc5\x59\x86\x97\x0e\x22\x26\xc2\x3d\x16\xfd\x9c\xb7\x33\x03\xc2\x66\xca\x05\x50\x63\x73\xb8\x37\x76\x06\x44\xfb\x36\xb2\x07\xd3\xd9\x4b\xf3\xa3\x0e\xb3\x09\xc2\xcc\x1a\x2c\x6b\x62\xfc\x33\x00\x8a\xa7\x7e\x51\x4d\x44\x46\x95\x15\xf8\x10\x57\xbc\x65\x58\xc5\x1b\xd5\xb0\xbb\x64\xe0\x32\x5b\xb5\x01\xc7\x40\xa7\x40\xa7\x5a\x2e\x84\x69\x71\x6d\x36\x36\xa7\x71\x41\x8e\x95\xe6\x48\xa5\x40\x3f\x11\x8d\x19\x31\x0a\x0f\xe9\x50\xbe\x8f\x3d\xfd\x9e\x83\xf2\xc8\xb5\x45\x1f\x4d\x1a\x2d\x74\xe4\xc4\x40\xe8\xd3\x86\x51\x00\x9f\x13\xad\x5a\xb8\x20\x81\x21\x71\xca\x9f\x2a\x32\x3a\x72\xb0\x42\xe2\xfe\x45\x78\x26\xb1\xb9\x10\x9a\xc8\x1d\xd0\x2a\xc4\x11\x5f\xb6\x88\xd4\x16\x43\xf0\x21\x5a\x58\xdf\x5e\xae\xc5\x85\x38\x6b\x61\x71\xc7\xb9\x0c\x4b\x37\x65\xe1\x4a\xe6\x4c\xd2\xe3\xf0\x44\x17\x33\x9c\xe7\x05\x6e\x01\x6f\x6a\x70\x5d\xc6\x5b\x9b\x6e\xa1\xc4\xbd\x1b\x7c\x0a\x73\x12\xf3\x6c\x1b\x53\xc9\x7b\x76\x5b\x82\xd8\x6a\xa3\x4e\xa3\x3e\x51\x8e\x14\xe7\xa0\x2e\x9f\xe7\xac\x64\xd7\xd5\x51\x55\x75\xd9\x75\x02\x3f\x16\x41\x1d\x3d\x0d\xe2\x6d\x10\x06\x53\x53\x5b\xb2\xef\x59\x2a\x84\xab\xb5\xad\xd7\x47\x2a\x83\xdb\x0e\x02\xf6\x9e\x28\xb2\x57\x26\x48\x2e\x81\x9d\xc3\x22\xaf\x63\xc2\x42\x11\x47\x21\x2b\xed\xe0\x17\x72\x56\xb0\x1e\x3d\x1d\x15\x6e\x11\x24\x10\x0d\x00\x79\x0b\x3f\x96\x40\xd0\xe4\x3b\x4a\x6f\xef\x28\x08\x18\x7b\x9b\x2d\x56\xac\xa4\x4b\x25\xae\x14\x7a\xde\x7f\xb9\xdc\xde\x3a\x1f\x8e\x39\xb4\xf0\x7f\x6d\x21\xf0\x06\x7c\xde\x53\x4f\x2a\x80\x15\xcd\x4a\x84\x9d\xc4\xca\x28\xfc\x9d\x74\x51\x99\xf1\x31\xd8\x1d\x93\xf9\xb0\x7c\x32\x2b\x5c\x6f\xe4\xad\x5a\xc0\x80\xda\x2c\x82\x06\xe5\x91\x1d\xa3\xd6\xc8\x8e\xd9\xb9\xf2\x19\xa7\xe2\xb3\x2f\xb7\x78\x0c\x3b\x3e\xd9\xe1\x6d\xcc\x2a\x77\xb8\x19\xf7\x35\xf5\xd1\x21\x05\xe0\xaf\x58\x9c\x47\xa0\x96\xa1\xca\xdf\xe2\x77\xea\xee\x3f\x05\x0e\xfb\x0c\x7a\xfa\x1b\x17\x63\xef\x46\x84\xce\x02\x7d\x5f\x92\xd3\x4b\xf4\x7e\x61\xeb\x8b\x36\x53\xee\x50\xb1\xbf\xc4\x66\xda\xcc\x59\x03\x4f\x01\xb8\xff\x2a\x35\xd4\x05\x49\x83\x0f\xee\x6f\x99\xc5\x8d\x8a\x20\x34\xe9\xe0\xdc\x97\x83\x0f\x0a\x46\x95\xe1\xde\xc1\xe7\x24\xf3\x3e\x64\x43\x51\x80\x8e\xcd\xb4\xc4\x08\x74\x32\x51\x34\xcc\xc8\x52\x3c\x96\x42\xb7\x64\xcd\xfe\x7d\x1c\x6e\xf8\x7a\x02\x8b\xa5\x18\x74\xac\x2f\xe2\xe0\xcf\x6f\xc4\x42\x5c\xee\xa0\x36\x11\x0f\x5d\x72\xb7\x38\x3b\xd8\x55\x0c\x77\xc1\xb2\xd1\xb7\x55\xd1\x5b\x7a\xb7\xe9\x1a\x7e\xa4\x9a\x39\xa6\x29\xf0\xbb\x4f\xb3\xcf\xb8\xcc\x0b\x8b\x1c\x4a\xe4\x60\xd5\x1c\x63\x89\xf4\x16\x85\x2b\x13\x45\xf9\x64\xf1\xef\x3f\x2d\x0b\x58\x8d\x35\x77\x6a\x70\xe4\xbe\x40\x18\x49\x81\xf9\x6b\xab\xd3\xf4\x4c\x74\x1d\x5c\x37\x1a\x91\xdd\xc8\x3b\x35\x39\xc4\x99\xe5\x89\x2c\x54\x99\xdf\xd8\xce\x26\x16\x0b\xbe\xa6\x00\x68\x25\x75\xd6\x2e\x72\x47\x69\x69\xd2\xce\x85\xc8\xe8\xe5\xa9\x83\x90\x0b\x9d\xc1\x8c\x89\xa6\xac\xcc\x8c\x11\xc7\xb0\x81\x10\x77\x8c\x0d\x88\xe7\x58\xc8\x73\x1d\x40\xa3\x99\xd6\x22\xd8\xb2\xc7\x26\xf2\x18\xb9\xd9\xa5\x06\x21\x38\x79\x69\x6a\x53\x58\x62\x12\xee\xd3\x86\x74\xcb\x95\x27\xdd\x2d\xb6\xf5\x0b\x7a\xdb\x8c\x4c\xf6\x72\xf0\xba\xd1\xbd\x8c\xa4\xf2\x3a\x4b\x61\x48\xe9\xbd\x6c\x76\x61\x5b\xe7\x4c\xd7\x6f\xa8\x7f\x20\xb5\x43\x58\x8f\x68\xcb\x1e\x04\x2d\x2f\xd7\xbf\x2d\x94\x8e\xa1\xb4\xf3\xd2\x31\x31\xa0\xf8\xad\xc2\xbb\xb0\x4c\x5c\xcb\xef\xc4\x28\xb3\xb1\xfb\x5e\x0e\xaa\xd4\xc6\x86\x94\xa8\x8e\x5d\x84\xe3\x59\x62\x60\x7f\xb0\x22\x5e\xe4\xc0\x13\x67\xe1\x04\x2b\xf5\x88\xa0\x70\x8c\x2a\x90\x12\x2d\x44\xee\xbe\x80\x68\x0c\xd3\x0a\xa9\x86\x0b\x41\xbf\x28\xbf\xb8\x44\x9c\x5e\x1e\x72\xcf\x6d\x3d\x28\x37\x76\x30\x23\xe0\x51\x86\x1f\x1b\x3b\x9a\x76\x15\x81\xe0\x9d\xa9\xc0\x6d\xa5\xba\xb2\x43\x04\x5f\xa1\x22\xff\xd6\x90\xbb\x56\x8d\x0c\x8c\x3a\xb4\x39\xf4\x75\xa7\x64\x9b\xf5\x7e\x50\xf0\xd8\xc3\x14\xff\x5e\x0d\xdb\xd8\xd1\xaf\xc1\x5f\x8c\xe9\x0e\x63\x76\xa3\x87\x6d\x77\x14\xad\xde\x00\xd5\xf5\x82\xd4\x0d\x5c\xdd\x4e\xba\x3a\x7f\x4f\x2c\x2c\x90\x58\x1b\x2b\x91\x26\x13\xb3\x56\xfe\x00\xd1\xae\xc0\x99\x22\xd4\x8b\xaa\x32\xf7\xf3\xc4\x63\xea\x11\xd4\xf1\x28\x70\x2e\x2d\x11\xee\x7f\x82\x0f\x24\xdf\x34\x73\x13\x31\x73\x61\xd5\x01\xf1\xe3\x35\x74\x80\x2d\xe3\xad\x80\x11\x02\x6e\xa7\x65\xcd\x07\x1e\x23\xec\x6e\xf5\xa7\xe8\x6e\x25\xb4\xf1\x76\xc1\x0d\x8b\xf0\x03\x26\x62\x6a\xb8\x1a\x4c\xfb\xc7\xd0\x8b\xb3\x8f\xff\xe3\x13\x6f\x09\x2f\xd7\x75\x7e\x3a\xa0\xc5\x6a\xfc\x2c\xa0\xa6\x0a\x9f\x94\x57\x5c\x9b\xb3\x8e\x91\xf2\x89\x87\xf0\x16\x17\x4f\xb2\xe1\xc2\x0c\xb2\x50\xcf\x67\xd2\x5b\xd1\xab\x21\x50\x45\x1a\xcd\x68\xb3\xbb\x2a\x86\x06\xb8\xfd\x21\xd5\x14\x56\x4d\xcc\x79\x3f\x43\x1b\xc9\x20\xc1\x94\x54\x10\x51\xb4\xd2\xcb\x7a\x3d\xb0\x79\xbe\xf4\x32\xda\x64\x2e\xe3\x22\xd8\x76\x4c\xd1\x98\xc8\xd6\x0b\xee\x03\x33\xe2\xce\x6d\xd7\xae\x06\x8f\x74\x54\x05\xbf\x27\x37\xf3\x4e\x37\x5e\xc4\x74\xed\x28\x1c\x12\xbe\xb5\xb2\xc5\x97\x6b\xe2\x0b\x75\x9b\x41\xb9\x1d\xbc\x2b\x11\x00\x36\xea\x20\xf6\x16\x18\xda\x48\x91\xa4\xa9\xc1\x04\x11\xf7\x6b\x6e\x45\x54\x74\x83\x4c\x8a\x68\x40\x8a\xd7\x22\x32\x54\x60\xb1\xf5\x75\xd8\xd0\x03\x62\x09\x5f\xa2\x08\x51\x89\xcb\xfd\x76\xa7\xeb\x9a\x3e\x31\x87\xeb\x61\x2f\x0d\x1a\x17\x6b\x23\xec\xd0\xaa\x81\x62\xee\x82\x73\xb7\xdf\x2d\x61\x46\xbe\x14\x91\x12\x3b\x97\xdd\x30\x21\x5a\x4c\x8f\xcb\x36\x50\x39\xbe\xec\x0d\x00\x38\x61\xef\x20\x9d\x2f\x76\x29\x3d\x91\x7b\xb8\x34\xcb\x8c\xfe\xa2\xfe\x34\x37\xb8\xc9\x16\xf1\x94\xcc\xc1\x82\x5e\xa2\x36\xb0\x89\x46\x43\x44\x01\x4a\x45\x65\xfb\x6f\xa4\x17\x7a\xe0\xe3\xc6\xa1\xcd\x95\xac\xdd\xcb\xe1\xcf\xc9\xa8\x41\xae\xaa\x98\xca\x1f\xfe\xe9\xac\xfd\x91\x1e\xe3\x92\x7b\x35\xb7\x59\x0d\x89\x38\x6a\x39\xff\x12\x0e\x12\xed\x20\xcc\x35\xbc\x01\x61\x07\x1e\xa1\x15\x13\x56\x12\x9a\x32\x83\x55\xe0\xcf\x7e\xc9\x8f\xbc\x02\x06\xa2\x97\x19\x75\xc8\x08\x10\xdd\x93\xa5\xbb\x25\x66\x6c\xb8\x93\x1a\x77\x28\x86\x8b\xc0\x52\xe8\x9c\x00\x4d\x36\x8d\x5a\x55\x99\xf5\x4c\xc6\x5c\x24\x65\x4d\x96\xbd\xa0\x59\xca\x72\x97\xb5\x4b\x53\x80\x36\xa9\x50\xcf\x5c\x51\xb7\xad\xdb\x51\xd5\x24\xfa\xbf\xb1\x40\x4a\xc2\xd7\xb4\x05\x2c\xf2\x4e\x31\x47\xf9\xaf\xec\x50\xed\xc6\x75\x38\xd3\x31\x3a\x35\x2e\xf4\xcc\x60\xc8\x5b\xf6\x24\xa1\xbb\x79\xe2\xce\x0a\xf4\x93\x33\x70\x71\x70\xa2\x8f\x66\xf8\x9f\x67\x2c\x18\x74\xe7\xb9\xa9\xcf\xcf\x46\x05\x6a\x7c\xf1\x03\x5f\x4e\xff\x58\x76\x52\x61\x0c\xa2\xf0\x3f\xcf\x88\x2f\xa8\x10\xaa\x1a\xd7\x21\x61\x04\xe4\x94\x92\xde\xca\x38\x8f\x56\x20\x0f\x8e\xc7\xe3\xf1\xe1\x7e\xff\xb0\x6d\x1f\x2c\xf4\x3a\x63\xa2\x63\xb7\x27\x56\x10\xa4\xad\x9a\x9c\x23\x19\xa6\x4c\x26\x59\x1e\x3b\x30\x69\xc9\xe7\xe9\x03\x28\x68\xd7\xca\x83\xdf\x61\x46\x46\x60\x27\xa5\xd9\x73\xe1\x84\xb4\x7d\xa7\x92\xd7\x59\x20\x79\x18\x4d\x22\xef\xcb\x44\x9e\xcb\xb2\x26\xc1\x96\xef\x6d\x60\xb4\x6a\x24\xfe\xda\x6e\x52\x63\x26\x83\x82\xcf\x2f\x9e\x1c\x92\x4c\x8e\x4a\xc3\x1a\x65\xa9\x05\xc0\x65\x49\x2a\xd5\xfe\xdf\x29\x4d\x2d\x55\xbf\xb4\x0c\xbe\x20\x4f\x55\x07\x7d\xab\xc5\x85\xf8\xab\xbe\xd5\xf0\x7b\x45\xe1\xb1\xb3\x70\xd8\xde\x42\xf6\x77\x45\x3e\xf7\x35\xe4\x80\x45\xdc\x8e\x9c\x80\x05\xbe\x28\x88\x5e\x86\x63\xd7\x8a\x4e\xdf\x22\xbf\x61\x9b\x11\x14\x2d\x47\x0a\x86\xf6\x9f\x10\x99\xcc\x6e\x15\x78\x81\x47\x19\x46\x7b\x5a\x54\x2b\xac\x90\xd6\x38\x04\x4b\xac\xe9\xf1\x68\xda\xe4\x3e\x3e\x2e\x15\xd2\x11\x3c\x7f\x5e\x1a\x12\x48\x6e\xa1\x74\x92\x5a\x12\x3c\xc6\xb6\xca\xb1\xbe\xa1\xc7\xb7\x30\x9f\x4d\xd7\x4a\x4b\x95\xd0\x73\xb4\x5e\x0a\x02\x85\x12\x72\x6d\x47\x32\xf0\x22\xd5\x68\x22\x10\xd4\x0f\x78\x23\x88\x6a\xba\x09\xc2\x45\xaa\x03\xec\xfc\xa9\x02\xba\x5a\x39\x73\x70\x93\xce\x2a\x1e\x28\x77\xe6\x10\x1c\x56\x7a\x48\xa9\xe9\x0a\x85\x74\x09\x45\x7f\x52\xde\xb4\x3f\xe8\x67\x56\x80\xd0\xc1\xb6\x0c\x65\xac\xd7\x8d\xaa\x7f\x62\x3e\x2a\xf7\x45\x43\x5b\x8d\xad\x22\xd6\x3d\x88\xc1\x1c\x9f\x81\xd9\xa0\xb0\xdf\xd5\xe0\xe1\xd1\x88\x38\x43\xf3\x4b\x78\x58\x48\x80\xea\x0b\xae\x90\x11\x87\xa3\x69\x76\xd9\x20\x72\x94\x34\x0e\x75\xc2\xe6\x89\xae\x5a\x7c\x5a\x9a\xd3\x56\x38\x59\x2e\xbe\x10\x99\x65\x65\xcf\xfd\x10\x8f\x94\x7d\x9f\x00\x5b\xa1\x47\x16\x45\x45\x3f\x05\x84\x96\x0a\xb4\x92\x4e\x01\xc1\x1b\xcf\xe8\xd4\x73\x0a\x64\x34\x7c\x47\x76\x21\x3e\xf0\xef\x04\xbc\x64\x4c\x3b\xcb\xac\xd7\x28\x87\x67\x7e\x51\xe8\xbb\x9d\x24\xe2\x40\xd7\x01\x2a\xf7\x0c\xa1\x49\xee\x47\xb7\x83\xf7\x44\xa3\x06\x98\x83\xba\x72\x45\x5f\xf2\xfe\x39\x01\x98\x38\x78\xc5\xcf\x1f\xb2\xed\x13\xea\x0a\x9d\x6e\x21\xde\x04\xdc\x2e\x06\x76\xf7\x7b\xce\x07\xed\x07\x04\x36\x40\xb6\xea\xbc\x60\x1b\x29\x6a\x9a\x81\x17\x25\xd9\x68\x25\xb5\x62\x62\xd0\x36\xcd\x98\x58\xb4\xd6\xa3\x89\x26\xbf\xc9\xba\x75\xde\xde\xec\xad\x36\xbc\x29\x02\x07\x74\xed\xe3\x5b\x6c\xd6\x90\xfb\xc2\xac\x29\xd3\x1a\x13\xb1\x7f\x56\x56\xc3\x32\x60\xc6\x06\xdf\x1b\x44\xf0\xbb\x54\x53\x3f\x58\x0f\x77\x6e\xb9\x8d\xf0\x35\x27\x2e\xac\x9e\x79\x81\xe8\xfb\x84\x39\xd9\xea\x81\xc7\xd3\xec\xd0\xe0\x62\x81\x17\x7f\x65\xd3\xe8\x56\x19\x2f\xbb\x24\x8d\x42\x8c\xd1\x9d\xf6\x0a\xa2\x85\x65\xf3\x87\xaf\x8a\xa4\x2d\x80\xa1\x1f\x65\x6e\x53\x0c\x81\x1f\xd9\x5e\x76\xb5\x5a\x4d\x97\x79\x4d\xed\xc5\x8d\x4c\x9c\xf9\x75\x4c\xbb\x07\x7c\xe2\xd2\x85\x95\x0b\xca\x17\x4c\x3d\x60\x87\x20\xd6\xf8\xb2\xcd\x6a\x36\x5a\x13\xe3\x44\x1e\x29\x98\xb4\xf5\x64\x33\x2c\x14\x89\x5c\x06\x85\x95\x48\x63\x4a\x9a\xc0\x7e\x50\x77\xb0\x03\xc3\x88\xf3\xb8\x2e\x34\x83\xb5\xf3\x13\xa9\x8e\xdf\xa3\x2c\x64\x2c\x6d\x9c\x0f\x84\x08\xed\x80\x78\x06\xbf\x0e\x67\x0c\xa6\x80\x81\x5c\xa0\x9f\x38\x62\xf9\x1b\xcf\x25\xe6\x68\xf3\x4b\x73\xc9\x7a\x9c\x18\xd0\x79\x4d\x5d\xc6\x68\x0e\x14\x49\xc6\x58\xf3\x30\x2e\x49\x9e\x09\x60\x2c\x50\xc8\x2f\x91\xc6\x27\x66\x4a\xdb\xcb\x59\x9f\xe2\x6a\xac\xd3\x42\x0c\x54\x3b\x2e\xd2\xc3\xce\x82\x76\x02\x88\x60\x59\xc7\xd7\x61\xcb\xed\x5e\x89\x57\xb6\x03\xb9\xd5\x7b\x9b\x6d\x07\xbb\xc9\xc7\x69\x36\x48\xf0\x98\x5b\x60\x25\x53\x09\x74\x11\x3b\xf6\xd2\xc5\x37\xfb\x27\x8a\x90\x9d\x6a\x6e\xef\xed\x75\xf1\x54\xdc\x1f\xed\x2c\x1a\x5a\x45\x5c\x64\x6e\x05\x9f\xf7\x15\xc3\x31\xc0\x17\x03\x70\x7f\xe1\x3b\xd9\x14\xba\x9b\xec\xa5\xf7\xff\x40\x8b\xb8\x06\x6a\x11\x7c\xce\x68\x2f\x97\x9e\xd1\xde\xeb\x05\x0a\x90\x2f\xb1\xaf\xa5\xbc\x3b\x6b\x6f\xf1\x41\xc6\x35\xfc\x4c\x39\x5b\xed\x39\x33\x1c\x14\x2f\xcb\xdc\xb5\x74\xba\xa9\x33\xd6\xe6\x97\x90\xb0\xc0\xe0\x90\xef\x58\x06\x49\x2e\xac\x73\x50\x77\x34\x0d\xbd\x4a\x18\xc6\xe5\x68\x1a\xf1\xc6\x1e\xe6\xa8\x02\x98\x36\x35\xeb\xfc\x12\xca\x90\x13\x9f\x9f\xfc\xb2\x4e\x10\x79\x67\x49\x2f\x8d\x65\x4b\x91\x22\x32\xbf\xe5\x77\x4b\x6f\xf4\xc2\x41\x9c\xf5\x88\x6c\xcf\xe7\x3d\x22\x2f\x94\x70\x22\x7e\x5d\xbc\xe4\xa5\x38\xc9\x53\xe3\xd9\x88\x5d\xb6\x77\x41\x62\x6d\xf3\xa6\x5c\x52\xda\x42\x63\x02\xb3\x3a\x21\x89\x20\x84\xe1\x43\xf2\x59\xff\x9c\x42\xcf\x64\x23\xbb\x9a\xc4\xb4\x20\x73\xf3\x53\xf5\x21\x29\x6b\x44\xd7\xd9\x43\x4d\xc1\xbe\xf3\x2a\x2e\x21\x6e\x26\x07\xf0\x8e\xbe\x16\x80\x10\x22\x49\x95\x21\x09\x7a\x0c\x02\x50\x36\x43\x7d\x9e\x37\x83\xd3\x26\xed\x28\x40\xe9\xb9\xfa\x5f\x19\x14\x78\xfc\x0f\xef\xae\xee\x01\xe7\x66\xff\x7b\xf1\x6a\xf1\x3a\x0c\x3d\x52\x3e\x24\xe3\x1f\xde\x5d\x61\xeb\xfd\x4e\x1d\x4b\x13\x33\x2f\xd7\xd9\xe4\xa0\x20\x3d\x19\x6f\xbc\x30\x07\xa7\x71\x35\x9c\x18\x71\x80\xa9\x09\x66\x32\xf4\x9d\xde\xee\xfc\x41\x41\x58\x9d\x13\xb8\x8a\xf9\x28\x1b\x71\x62\x46\xe8\xea\xf8\x9b\xe7\x64\xa9\xa1\x71\x72\x4e\xb4\x2e\x16\xa6\x9c\xe9\x44\x81\xa1\xa2\x78\x4f\x38\x97\x67\x2c\x2b\xfa\xdf\x3d\x69\x39\xea\xa8\x28\x3b\xdd\x38\xf1\x1c\x60\xe6\xe5\x71\x68\x9c\x3f\xa2\x97\xc1\x32\x82\x37\x72\x0f\xc1\x52\x03\xd4\xcf\xf7\xe2\x58\xf1\x33\x4d\x17\xe2\x0d\xfe\xba\x1f\xbc\x78\xda\x29\xcc\x7b\xfa\xbc\xaf\xaf\x79\x24\x1b\x8e\x06\x99\x5b\x81\xa2\xa8\xfd\x5f\xe1\xec\xfc\xbb\xf8\xaf\xb0\x54\xfe\x2e\xfe\x4b\x9b\x56\x7d\xfe\x3b\xdf\x9a\xc5\xd7\xc8\x03\xb9\x3b\x9f\x85\x3c\x41\xd5\x77\x18\x04\x28\x96\x9f\xfe\x63\xd7\x4d\x77\x4b\x29\x35\x51\xf0\xac\x1e\x9f\x4d\x1a\xf4\x7a\xc4\x93\x8f\xaf\x34\x67\xd1\x81\xd6\x73\xa9\x01\xef\x96\x30\x28\x06\x1c\xc8\xe0\xdb\x24\x2e\xc4\x2b\x8c\x86\xc1\x77\xe3\xcc\xc9\x40\xf6\xb4\x3c\xee\x30\xba\xfa\xe0\xeb\x3a\xdc\x5b\x23\x9c\x32\x70\xf7\x11\x6f\x39\xd9\xb2\x3b\xc9\x99\x12\xdc\x29\x7e\x47\xcb\xc7\x67\xf0\x25\xfe\x4f\x6b\x72\x49\x1c\xef\x78\xc0\x93\xce\xdb\xda\x85\xb3\x83\x0d\x5e\x32\x41\x19\x6e\xcf\x0a\x5f\xf4\xb0\x9d\xbd\x13\x76\xd0\x5b\x1d\x56\x1c\x3d\x1b\x13\x11\x1b\x75\xa0\xd7\x79\x76\xd2\x21\xde\xf8\xd6\x08\x86\xae\xc7\x6a\x64\x7c\xe0\xd6\x95\x15\x94\x3a\x92\xd5\x44\x2e\x89\xfc\x30\xbc\x78\x90\x69\x0d\xcc\x9d\x1a\x7c\xbc\x36\xf5\xe2\xbd\x15\xef\xd4\x76\xec\xe4\x90\x07\x01\x98\x16\x98\x2e\x48\xc6\x43\xea\x4d\x38\xf3\xc3\xb2\x10\x03\xe1\xca\x15\x04\x1c\x0e\x80\x6e\x3f\x82\x6c\x32\x60\x20\xe1\x69\x2d\xa8\x67\x72\xa0\x68\x7a\x48\x8f\xae\x94\x01\x90\x8a\x8a\xb3\xd1\xa0\x36\xc0\x1d\xf2\x52\x2b\x38\x4c\x3c\xb5\x01\xe3\x20\x2d\xb4\x20\x59\xc5\x71\x24\x24\xba\x5f\x9e\x68\x7a\x10\x1a\x63\xb4\x4d\x02\x43\x24\x8d\x3b\x42\xf1\x1b\xa5\xd8\x24\xb0\x59\x2d\x23\xf8\xe7\x84\x00\xdf\x86\xb9\x08\xa4\x09\x7f\xbe\xe5\xd7\x65\xe6\x60\x51\x31\x92\x9e\x94\x29\x07\x25\x93\x8b\x80\x14\xd0\x24\x4d\x9e\x3b\xc2\x2d\xd6\xec\xb2\x17\x5b\x41\x75\x05\x31\xe3\xdc\x42\xf3\x26\xd3\xb4\x18\x6e\x4b\x6f\xb2\x35\x0c\x7e\x56\xda\xb4\xfa\x4e\xb7\xa3\xec\xe8\x2d\xac\xd3\x78\xff\x54\xe2\x6d\xac\x01\x8d\xc8\x49\xdc\x93\x0e\x01\x6d\x83\x50\xb9\x0f\x06\x32\x26\xdf\xa4\x67\xae\x16\x7b\x14\xc8\x6e\x34\x0f\xa3\x9d\x84\x61\x57\xd3\xb3\x35\xb9\xae\x1e\x15\xf1\xb0\x3e\x30\x78\x37\xaf\xd2\x9f\x67\x5c\x1e\xd9\x73\xfd\x3a\x04\x9c\xc0\xfe\x3c\x93\x5e\x2e\x82\xf1\x84\xbe\x65\x8f\x2a\x05\x85\x80\xe5\x6a\xa5\x97\xe9\x36\xd4\x58\x0a\xa5\xb5\x96\xcd\xed\xa2\x9e\x75\x11\xff\xc2\xfe\xca\x55\xb9\x61\xe0\x58\x18\x07\x8f\xb7\x50\x71\x38\x48\xce\xe6\xcc\xeb\xec\xc2\xe1\x5d\x4e\x9a\xb8\xc1\xc9\x93\x0b\xba\x32\x7d\xbb\x22\xd3\xf8\x95\x0e\xa2\xd0\xb4\x25\x7a\x74\x62\xa0\xb8\x03\xc5\xc3\x53\x7f\x64\xb4\x4e\x0f\x54\x22\x44\x5f\x8c\xaf\x76\x1a\xdf\x9f\x4e\x12\xb6\x2c\x0a\x1a\xf7\x26\xd0\xc9\x23\x9a\x2a\xcd\x5d\xcf\xce\x29\xa8\x50\xc8\x0d\x52\x61\x18\xee\x73\xe2\x20\xcf\xa3\xc9\x30\x3d\x2c\x98\xd9\x70\xe2\x1e\x3a\xdd\x42\x38\xe9\xb0\xdb\x97\x1c\xc4\x8b\x99\x39\xb8\x0b\x0a\xfc\x42\xaf\x4c\x0b\x16\xb5\x18\x71\x74\xae\x60\xba\x7f\x7d\x7c\xe1\x46\xea\x94\x7c\xb7\x8c\x8c\xe5\xee\x2f\x3c\x93\x32\xdf\xf3\x7c\x8c\xbf\x51\x07\xb2\x5d\x4d\xf2\xad\xbc\x05\x7e\x9a\xa9\x31\xc4\xd4\x64\x32\xbb\x80\x6a\xf1\x1c\x48\xef\x82\xc5\xa6\x71\x81\xe1\x74\xf3\xca\xc8\x7c\x4b\x11\xf9\x32\xa9\xb3\xad\x27\xf6\xb9\x97\x6d\x0b\xfd\x29\xec\x74\x4f\x16\x98\x04\xbc\x2d\x70\x95\x41\xf5\xe7\xeb\x65\x52\x31\x47\xd6\x9f\x5f\x4f\xd8\x21\x37\x47\xcd\x1b\xb6\xd0\xa5\xc5\x62\x85\x09\x0f\x1c\x64\xb0\x1e\x93\x7b\x2b\x19\xea\xe5\x97\x34\x79\xd4\xc7\xf2\x50\x9c\xac\xd9\x7b\x22\xf1\x73\xa3\xf0\xbe\xf6\xd4\xc8\x3d\x5d\x1c\x35\x8a\x16\x9a\xab\x32\x92\xfa\x6b\xe2\xd1\x95\x69\xc2\x0a\x8d\x35\x3c\xbd\x99\xc2\x4f\x05\xfe\x73\x3d\x1b\xf8\xe2\x25\xce\x32\x02\x15\x29\x49\xf1\x55\x04\x60\x1f\xf3\xb2\xab\x72\x5d\x1c\x50\xed\x44\x6b\x88\x94\x50\x13\xed\x54\xbc\xf1\x25\x15\x15\x98\x45\xed\xc7\x66\x87\x37\xbc\xa0\x89\x82\x70\x4f\xe2\xfa\xed\xcd\x7b\x81\x3a\x68\x3f\xe8\xed\x36\x1c\xbb\xe2\xaf\x3b\x65\x02\x4d\x83\x5b\x22\xa4\x6b\xb6\x69\x46\xd4\x57\xbe\xb0\x5b\x77\x2e\x0e\x8a\xa3\xec\x9a\x96\x0e\xa1\xfc\x9d\x1b\x56\xc2\xa0\xa9\xa4\xd8\x59\x87\x8f\x77\xb8\x5e\x35\x7a\x73\x5c\x89\x2b\x25\x07\x23\xf6\x41\x82\x60\x92\x79\xaf\x13\x72\xec\x09\x04\x10\x7a\xfc\x48\xe6\xca\x7a\x1a\x92\x7c\xf9\xd2\xf1\x34\x1b\x9e\x29\xe8\x52\x58\x5b\x1e\xe1\xfb\x6c\x00\xe0\x99\x34\x3c\x90\x35\xc4\x9e\x66\x4b\xd3\xaf\x58\xa6\xb3\x36\xa4\x35\x4a\xed\xfd\x6a\xc2\x4b\xa8\x56\x1e\x75\xf7\xd4\x96\x0b\xf1\x5e\x39\x08\xf9\x09\xdf\x5f\x00\xe7\x21\xb8\x51\xa1\x4f\x02\xdc\x6b\x40\x3f\x8b\xcb\x22\x62\x0d\x53\xaa\x1c\xd9\x04\xf0\x18\xb9\xb9\xce\x6c\xb1\x8e\x2c\x38\x75\xc0\x71\x98\xf6\x13\xd7\x3e\x9a\x3a\x62\x75\x7f\x1b\xd5\xa8\x56\xe2\x95\x17\x7b\x79\x84\xb7\x66\xc1\x22\xd1\xa9\xc6\x9a\xd6\xb1\xa1\x9c\xf6\xe0\xa5\xed\xc4\xd8\xb3\xd7\xfc\x6c\x4a\xe6\x6d\x1b\x54\x36\x56\xef\xe2\xc7\x7d\x80\x59\x0f\x5e\x86\x96\x7b\xe9\x6e\x27\x36\x2a\x41\xfe\xfb\xc6\x5e\xa4\x40\xc4\xb1\x04\x3d\x98\xa1\xcd\xbd\xed\xcf\x6f\x80\x94\xf3\x4b\x20\xae\xb7\x18\x9c\xf2\x1d\xfd\x9c\x03\xa1\x81\x10\xf4\x09\x7f\xcd\x41\x7a\x7a\x77\x3c\xbe\x40\x3e\x07\x59\xdb\x36\x8c\xe3\x2f\xb6\x3d\xce\x75\xe1\xbc\xba\xa2\x42\x1c\x68\x51\x6f\x0f\x70\x13\xbc\x3e\x42\x86\xf6\x4e\x75\x1b\x7c\xd3\x22\x48\xad\x8a\x83\x01\xc1\xad\x41\xba\x85\x45\x12\x40\xf3\x0c\x77\x26\xe0\x65\x9a\x5b\xf6\xe2\x43\x74\xc5\xe3\x5a\xd3\x36\x61\xa8\x20\x6a\xd7\x2b\x94\x38\x60\x35\x82\x12\x1c\x63\x34\x9d\x07\x89\xbd\xcf\xc2\x29\xb0\x9a\xac\x1f\x94\x03\x1f\x2e\xa0\x61\xf0\xa8\x2d\x83\xa0\xc8\x86\xd1\x3a\xb2\x48\xab\x89\x51\xd7\x0e\xea\x59\x68\x11\x45\xc6\x85\x95\x05\x31\x71\x67\x10\xc9\x87\x0b\x80\xf8\xd5\x9d\x29\x0b\x46\xe0\x49\xc3\xfe\xb2\x20\x7f\xd9\x01\x12\x27\xc6\x6e\x89\x6f\x74\x48\x00\x50\x67\x15\x0e\x06\x56\x51\x65\x06\xd4\x61\xac\x3e\xbc\xbb\xca\x89\xf9\xb9\x90\xe1\x78\x47\x3d\x47\xab\x3c\x3c\xa3\x36\xa8\xad\x1c\x5a\x8e\x4d\x44\x07\xcc\x4e\x7a\x3c\x48\x86\x30\x7c\xac\xa2\x80\x88\x81\x84\x0b\xc3\x4a\xdc\x6a\x03\x71\x7d\x41\x32\x21\xa5\x62\x10\x12\x93\x81\x52\x38\x54\xc6\x3e\x9c\x33\x78\x68\x71\x45\xd0\xf7\x1f\xfe\xe5\xe6\xed\x9b\x73\xf1\xf9\xe1\xe1\x70\x78\x18\x8a\x3f\x1c\x87\x4e\x99\xd0\x97\xf6\x5c\xfc\xaf\xd7\x57\xe7\x42\xf9\xe6\xc7\x95\x78\x8d\xc7\x4f\xa2\xea\x64\xb7\x0c\x2e\x10\x60\x04\x3c\x0e\xff\xc0\xb1\x44\x5b\x87\x14\xb6\xf9\x03\xfe\x39\x13\x19\xa6\x91\x1d\x64\xf9\xed\x7b\x70\x94\xcd\x18\x12\x7a\x9a\xe4\x06\x7e\x4c\x33\x12\xfd\x06\x30\x5e\xa8\xf0\x66\x99\x74\xe2\xe6\xe5\xe5\x9f\xfe\xf9\x7f\x8a\x97\xaf\x2f\x9f\x8a\x9d\xfa\x2c\x5a\xbd\x55\x78\x3d\xc9\x5b\xfb\x4e\xf3\xa4\xff\xaf\x87\x61\x35\x3c\xbc\xd1\x5b\x23\xfd\x38\x28\x5e\x00\x48\x27\x72\x1e\xa9\x93\xcd\xed\xd2\xbb\x97\x53\x10\xdd\x58\x43\x03\xf0\xaa\xb1\xa6\xec\x3d\x82\xb0\x33\xd7\x53\x70\xe3\x4a\xca\xeb\xb0\x66\x22\x23\xb3\x53\x26\x10\xfa\xb1\x6b\xcb\x33\x7a\xad\x78\x09\xa8\xf6\x2f\xd3\xc2\x10\xf8\x0f\x9e\xc9\xb8\x10\xff\x02\x21\x9f\x76\x6c\xfd\x14\xb2\xb8\x77\x00\x3c\x2d\x1b\x36\x43\x9d\x09\x76\x17\xe2\x95\x30\x41\x74\x60\xa1\x32\xe5\x45\xc1\x72\x8a\x83\x54\x7c\x17\xe2\x4a\x79\xb1\x8f\x2a\x3f\x58\xe3\x88\x6d\x56\xa2\x34\x8d\x5d\xce\xe6\x41\xf9\x25\x8f\x05\xc8\x66\xa3\xf3\x01\x2c\xfd\xd4\x16\xb3\x97\x31\x12\xef\x31\x2d\x92\x07\x7f\x5c\xc8\x4a\x91\x7f\x53\x48\x45\x08\x73\xb9\x34\x3b\x14\x8b\x71\x71\xe2\xb2\x83\x83\xaf\x8b\x73\xb5\xc1\xb4\xcc\x34\xd6\xe1\x62\x76\xa4\xfa\xa0\x51\x47\x17\xcf\x73\x74\x5c\x6d\xcf\x05\x3b\x7d\x9e\x93\x3d\xdf\x39\x47\x89\x68\xcf\xc5\x68\xd2\x6f\x74\xb8\x23\xf1\x95\x3f\xc1\x9e\x38\x7c\x46\x73\xcf\xf6\x1c\x5f\xb1\x4e\x09\xab\x79\x47\x0b\x7b\x8e\xc2\x3e\xff\x1e\xd0\x68\xe2\x92\x5b\x07\xfc\xff\xdf\x9b\xbc\x2b\xd0\x37\x77\x34\xcd\x6e\xb0\x46\xff\xbe\xd0\x37\xbc\x5e\x49\x2e\xbb\x38\xe6\xec\xb8\x7b\x1f\x70\x39\x4b\x8c\x81\x16\x78\xea\x4e\x7c\x22\x7c\x5e\x37\x05\xa0\x4c\xf1\x27\x4f\x00\xa4\xc5\xca\xb6\x71\xeb\x4e\x83\xa9\x0a\xf8\x18\x2e\xdf\x21\x63\xc8\x46\x8e\xdd\x38\xcd\xc8\x83\x34\x9f\x3e\x0b\x51\x91\x1b\x49\x57\x3a\xbc\x98\x7c\x13\x3f\x88\x02\x21\xbe\x48\x51\x9c\xe2\x70\x84\x97\x6a\x81\x65\xa6\x7a\x6e\x9d\x96\x24\x48\xe2\x11\x66\x52\x12\x01\x4e\xea\x98\x09\x27\xb4\x66\xe6\x3a\x87\x54\xc3\x29\x39\x0c\xa3\x10\xb0\x7c\xc0\xaf\x9a\xc3\x9b\x76\xcf\x62\x5a\x29\xd5\xf2\x21\x09\xfc\x4f\x79\x42\x42\x18\x24\x38\x4c\x72\xce\x26\xc8\xc7\xa5\x1f\x73\x00\xa1\xe7\xdc\xbc\xe2\xb0\xbd\xb3\x67\x28\x8f\x93\xa1\x6e\xb5\x6b\xec\xd0\xde\x8f\xfb\x19\x02\xfd\x11\xec\x66\xeb\x65\xf7\x85\xa6\x3f\x23\xa8\x6f\xc3\x8f\x63\xc2\xaf\xc2\xe0\xeb\x35\x93\xcc\xd6\xee\x25\x98\xbf\x3e\x83\x1f\xb3\xc3\x79\x27\x8d\x41\x53\x7f\xfc\x95\xcf\x75\xdf\xd9\x23\xbf\x33\xfa\x0c\xbe\xf8\xe9\xf4\x39\x48\xf6\x2a\xe7\xfa\xc9\x53\x7c\x1b\xf3\x85\xf5\xcd\x4e\x7e\xf7\xf8\xd1\xfa\x49\xe0\xc3\xe9\x1e\xa0\xb3\xf6\x96\xbd\x7c\x64\x0b\xfb\x26\x3e\x34\xd3\xc7\xd7\x2b\x93\x8d\x8a\x6c\x5b\x34\x2c\xd2\x06\x87\x62\xf2\xa4\x5f\x7a\x92\x09\x5b\x35\xe1\xd2\x60\x0e\x62\x3b\x69\xec\x53\x6f\x96\x3a\x93\x94\x06\x00\x05\x23\xb0\xc3\xe7\x4e\x64\xfb\x10\x18\x0e\xd2\xde\x8a\xf7\x3b\x75\x8c\x81\xab\xe1\x45\x39\xb8\xd4\x2d\xdf\xce\x81\xe6\xf1\x93\xa2\xf9\xd5\xa3\xad\xcb\x41\xe6\xa7\x4c\x20\x86\x0f\x6a\x8b\xcc\x51\xb4\xa9\x19\xb9\xfa\xb4\x70\xa0\x59\xea\xc5\xfc\x0d\xcf\x08\x35\x7d\x6b\x34\xf5\xf4\xe4\x5b\xa3\x79\xd1\xfc\xc1\xd1\xac\x28\x48\x08\x71\x10\x16\x2d\xc6\x8b\x69\x99\x3f\x27\x9a\xba\xfa\x15\x2f\x8a\x2e\xcf\xdc\x54\x45\xf4\xc5\xa9\xbe\xcf\x61\xa4\xcd\x3b\xf7\x15\x6f\x8b\x4e\xc3\xd2\x7d\x85\xb6\x68\xa9\x2d\xb9\x41\x71\x6c\xc0\xd7\xea\x8c\xf2\xe0\xff\x73\x67\xaa\x6f\x7c\x4e\x60\x11\xeb\x17\x9e\x14\x68\xf5\x66\xb3\xc2\xd0\xca\xb5\xb3\xe3\x00\xe6\x04\xbf\xc0\xb7\xb8\x81\x6f\x04\xa1\xc0\x92\x17\x14\x61\x12\x13\xa3\x93\x25\x79\x55\x42\x22\xb8\xd7\x82\x06\x36\x56\x78\x21\x9e\xe9\xcd\x06\x5d\x6d\xdf\x58\x9f\x9a\xb2\xc2\x22\x6e\x67\x0f\x75\xf8\x05\x2f\x94\x82\x55\xe1\xce\x1e\xb0\xd0\x4d\x48\xc9\xc0\x5c\xdf\x69\x5f\x53\x54\xe7\x9b\xf0\x01\x71\xa9\x33\x88\xd1\x40\x0c\x4a\x86\xf9\x80\x9f\x39\x54\x40\x19\x83\x6b\xf0\x45\xd4\x59\x1b\x03\x27\x82\xb6\x23\x5d\x51\xc1\x56\x61\xb8\xb3\x16\x08\x21\xa8\x33\x12\x48\xfe\x82\xd0\x59\x1b\x15\xe5\x09\x82\x06\x1a\xa8\xfb\x2f\xaf\xde\xe0\x27\xc4\x54\xa6\x68\x58\x10\x5c\xfb\xb9\xee\x68\xbc\x21\xe2\xa2\x1b\x7b\x08\xdc\xa8\x5a\x0e\x28\x19\xf2\x44\x96\x9c\x39\x43\xe6\xe1\xb5\x11\x87\xb7\xb6\xde\x4b\x73\x8c\xae\xdb\x37\x76\xaf\x48\x95\x73\x50\x44\x07\x21\x04\x77\xf2\x1c\xb5\x56\x84\x22\x04\xc5\x03\xc2\x6a\xe1\x80\xb6\xe2\x88\xe2\xab\xa5\xc8\xe2\x9c\x87\x61\xe2\x99\xf1\x0b\xe4\x82\x99\x3f\x86\x68\x07\xb9\x01\x47\xbe\xf0\x3f\xa6\xf6\x83\x4a\xc5\xae\x07\xf5\x70\x5a\x8c\x1c\xee\xc2\xbf\x98\x26\x77\xe8\xec\x91\x66\x20\xcd\x0c\xfb\x86\x7a\x2b\xce\x1c\xc5\xdd\xa4\x9d\x5f\x22\xc6\xd5\x5f\xd3\xfb\x9c\xb8\xf6\xe1\x65\xc5\xa2\x4f\xb9\x27\xdf\x35\x32\xa8\x22\x8e\x03\x98\xaf\xe0\xeb\x74\xfd\x60\xdb\xb1\xf1\xab\xa2\xdd\x45\x69\xe4\x48\x15\xaf\x3a\xd1\xd9\x2d\xe8\x3c\x20\x50\x32\xda\xfb\x8e\xa6\x55\x83\xf3\x68\xda\x2f\x33\x32\xaf\xf7\xfd\x80\x37\x25\x8c\xde\xcb\x6d\x7c\x3d\x4f\x6e\x31\x4c\x4b\xca\x03\xc5\x7f\xc8\x09\x3f\x8a\x32\x91\x13\x60\x2f\x80\x2c\xda\xaa\x97\x5b\x60\xec\x9b\x3c\xbe\x7f\x10\x46\xad\x61\xe6\x3c\x6b\x40\x71\xc4\x71\xea\xfc\x58\xe3\x9c\xd2\x89\x27\x9b\x7e\xda\xb6\x14\x60\x3c\xe6\x74\x56\xb6\x28\xfb\x5f\xe1\xaf\xd5\x6a\xb5\xb0\x6a\xe6\xaf\xa7\xf7\x83\x7a\x38\x9d\xeb\x0c\x3e\x0e\xc0\x5f\xd5\x83\xae\x13\xbd\xd5\xc6\x0b\x74\x4a\x93\xbe\x58\x29\x7c\x51\x44\x53\xab\xad\x79\x08\xe7\x65\x6a\xc6\xd4\x15\x33\x56\x47\x0b\x25\x2d\x99\xe9\xaa\x06\x27\x37\xde\x11\xe0\xe5\x56\x6e\x0b\x58\x3d\x69\x63\x80\xbb\xe9\x6c\x43\x21\xbf\x9f\xa0\x4a\xb3\x80\x05\x60\x3c\x7b\x59\xde\x8a\x17\x8b\x53\x98\xe5\xe3\x96\xeb\x99\xba\xb5\x35\x76\x40\x7d\x77\xbc\x65\xf7\x72\x7b\xef\x43\x71\x93\xda\xf2\x0b\x6b\xac\xe2\x0b\xa7\xe9\x74\x0f\x94\x4e\x72\x19\x1e\xe2\x79\x02\xa5\xa4\x3d\x32\xe3\x79\x66\xb8\xc8\xa9\x38\xdb\x57\xc5\xc3\xbf\xa9\x04\xc7\xb4\x01\x4e\x80\x7f\x57\xd5\x47\x3b\x6c\x3f\x55\x70\x2b\x09\x61\xd0\x63\xfc\xd2\xfc\x0a\x12\xd4\xcc\x01\x26\xf4\xe8\x3e\xc0\xe7\x63\xd7\x25\xe8\xf2\x9d\xb6\x17\x61\x9b\x96\x46\x3d\x01\x00\x95\xfc\xf0\x2c\x1b\xf9\x74\xd0\xcb\x6c\x2b\x7e\xd2\xc3\x0e\xdb\xe4\xc5\x99\x57\x87\x0f\x14\x25\xdf\x40\x7a\xb5\xa0\x22\x5f\x8b\x0b\x71\x0d\x3f\x2a\x6d\xee\xb4\x0f\xfc\xc3\x5e\xa1\x55\xe0\x2b\x48\x80\xf3\xc6\x1a\x55\x15\xde\x08\x15\x04\x5b\xaf\xd9\x13\xe1\x82\x7d\x12\x28\xbd\xb0\x83\xbc\x28\xcc\x22\xf3\x27\x48\x02\xca\xd2\xf5\x34\x20\x87\x51\x59\x70\x4a\x0f\xd0\x91\x3c\x86\x92\x30\x84\x90\x7a\x1f\x74\xf1\xec\x59\xa0\x0e\x23\x07\xbc\x04\x5c\x60\x23\x69\x50\xf2\x83\x45\x15\x30\x6b\x53\x44\xe2\x72\xab\x54\x4d\x46\x6b\x76\xe8\xb1\x9e\x8a\x05\x2e\x15\x0c\xfa\xff\x82\xf0\xc5\xbb\x3b\xa4\x76\x95\xf8\x6c\x21\x26\xd3\x63\xb6\xb9\x1e\x16\x10\x05\x91\xe4\x2f\xd5\xf2\x53\x4e\x6f\xa7\x6b\xe3\x0f\x3c\xe6\x34\xc7\x71\xef\x73\x4e\x80\x2e\x0d\x68\xd6\x18\x98\x87\x13\x8d\x88\xac\xec\xb7\x3a\x9d\xc6\xfd\x13\x18\xa6\xb8\x57\xf2\x5b\x32\x72\x8e\xf8\x2b\xfe\x4a\x59\x9d\x6d\xd8\x53\xf5\x8a\x7e\x9e\xb4\xa9\xb9\xcf\x67\xa2\x04\xcd\x88\x59\x31\x70\x11\xd3\xd7\x1a\xe0\x90\x2b\x86\x1d\xb6\xff\x98\x27\x46\xf1\xb4\xe8\xac\xd5\xf2\x4e\x7a\x39\x9c\x6a\x34\xe6\x72\xdb\xbf\xba\xe9\x53\x33\xb5\x82\xc2\x4c\xb5\x55\xb3\xc7\x3e\xa1\x83\xf7\x16\x29\x9f\xfe\xcc\x1b\x1c\xef\x09\x33\x33\x31\xb2\x31\xc1\x47\x3f\xd1\xd6\xe1\xcb\x2f\x7f\x9e\x30\x34\xba\xef\x09\xd0\x69\x2b\x03\x65\x8a\x61\x2d\xf3\x46\xde\x5b\x22\xe7\x66\xec\xc4\x68\xe5\x8f\x3f\x0b\xba\x6c\xa0\x72\xd9\xb6\xac\xb6\xa4\x57\x00\x79\xfc\x92\x6a\x74\x93\xc5\x94\x9f\xbe\x69\x9b\x46\x0e\xf8\x56\x72\x58\x2c\xd6\x5b\x45\xb4\x7e\x45\xff\x77\xba\xaf\x8b\xa7\x40\x5f\xc7\xf4\xec\x55\xd0\x9f\x63\x31\x52\x39\x11\x1f\xd5\x4c\xd2\x13\x7d\x85\x80\x08\xec\xfe\x11\x81\xf0\x1b\x78\xcb\xc5\x9c\x69\xf9\xb2\x0e\xfc\x5f\x0f\xb6\x53\xb1\xa1\xe2\x9d\xed\x54\x6a\x5e\x19\xd4\xb1\x2c\x18\xcb\xc4\x74\xd2\x4f\xf0\xbb\x8c\x31\xbd\x7c\xcf\x97\x53\xe9\x8c\xcd\x5f\xfa\x00\x7e\x9c\xb0\x83\x78\xf3\xf3\x14\xda\x40\x48\x7d\x3a\x8d\xdf\xd8\x43\x85\x47\xf1\x0a\xa2\x46\x5e\x88\x7f\xb1\xda\x50\x4a\x59\x29\xa6\x05\xce\x28\xbd\x41\xf3\x2e\xc8\x58\xf8\x48\xf5\x3c\x7f\xf2\xd6\x1e\x9c\x44\xf1\x95\x3d\x7a\x2b\x1b\x18\x7b\x8a\x4d\x6a\xd0\x8a\xa7\x7c\x25\x0e\xb1\x4e\x9e\xbe\xc1\x70\x11\x45\xbd\x39\xc4\xd7\x54\x0c\xa1\x00\xa6\xd5\x9d\xb3\x2e\x1f\x14\x80\xd1\x05\x52\xed\xb9\x1d\x60\xcf\x9d\xda\x01\x11\x09\xca\x76\xe4\x10\x5f\xd3\x8e\x50\x0b\x04\xa6\x63\x3f\x87\x93\xed\x91\x6d\x2b\xd0\x04\x3d\x37\x3e\x73\xd3\x26\xa6\xd7\xde\xde\x67\xe7\x3f\x98\xee\xb6\x13\x7e\xc6\xad\x96\x8e\x54\xcc\x41\x9b\xcb\x05\x96\x03\xcd\xe8\x17\x5f\x6f\xff\x32\x11\x80\x80\x83\xa1\x64\x04\xcd\x0c\xe4\x8b\xd7\x27\xe6\xe7\x12\xb6\x2b\xb1\x88\xc0\x2b\x10\x6d\xa0\xcc\x2f\x1f\xc9\x08\xc7\xcf\x2f\x21\xbf\x98\x1f\x2a\xc0\x30\xf2\x4c\xb6\x00\x51\xc7\xbd\x1a\x36\x58\x56\xeb\x1c\x59\x24\xe6\x00\x15\x89\xf8\x1c\x8e\x77\x6c\xce\xed\x65\x17\x4b\x0a\xae\xcf\x0a\xbf\x5c\x86\xda\xcb\xe3\xf4\x35\x6e\x08\x15\x51\xec\x9a\xd3\x82\xd5\xbc\x29\xe9\x5c\x7f\xa1\xef\x94\x49\x0b\xe6\xa4\x70\xb5\xca\xb7\xfa\x7c\x81\x64\xe4\x5a\xe7\x4c\xf0\x76\x80\x50\x89\x3c\xf3\x81\x74\x64\x0b\x03\xd0\xff\x1c\xfb\xdc\x48\x33\xa5\x0d\x60\xae\xa8\xe4\xfe\xc1\x7d\x24\xe2\x0f\x37\x07\x48\xca\xfd\xed\x01\x92\x81\x91\x81\x4d\x9b\x93\x87\xfb\x9a\x85\xf4\xe0\x0f\x37\x0b\x28\xcc\x57\x36\xeb\x9c\xdb\x84\x7c\x4c\xa0\x17\x4b\x94\xe2\xbe\xd6\x4e\x04\x2d\x58\xc6\xef\x72\x69\x8b\xc9\x06\x58\xe0\x82\x24\xb8\x68\x81\x9b\x29\xa8\x57\xab\xe9\x7e\xca\x4c\x88\xb3\x3d\x95\xf9\x28\x70\x5b\xc0\x58\x98\x7c\xb9\xe8\x3c\x4c\xa8\x8c\x35\x20\x9f\xe3\x65\x71\xf4\xf7\xca\x90\xd3\x75\x95\x1f\x8e\xc4\x13\xc1\x23\x63\xc5\x0b\xa2\xf1\x8e\x8a\xd4\x59\x3a\xc6\x5a\xa9\x3e\xc2\xcc\x7d\xaa\x5a\xe9\x76\x6b\x2b\x07\xb8\x2a\xe1\xdf\x55\xe1\xc7\x5f\xe5\x84\x6a\xca\x21\xe3\x2b\xc1\xd9\xa0\x16\xe3\x29\x47\xbf\x0b\xe2\x62\x94\x33\x2e\x8b\x04\x87\xef\xcb\x6f\x99\x99\xdc\x8e\x14\x2a\x87\x9c\x0c\xc0\xa7\xdc\x79\xb5\x17\x6f\x30\xa1\xda\x5b\xa3\xd1\x9e\xf9\x35\xfe\xd2\x66\x5b\x15\xf1\x9e\x9e\x87\x8f\x0a\x22\xfc\x50\xca\x95\x74\xbe\xf2\xd6\xc3\x33\xb1\xef\xc3\xff\x9f\xc5\x59\x5b\xa5\xae\xaf\xd6\xa3\xee\x5a\x0e\xa7\xf4\x4b\xf8\x10\xaf\x92\x59\x56\x06\x28\xfb\xbe\xbe\x43\x62\xd9\xf7\x1d\x77\x8b\xdd\xbe\x12\xdc\x56\xfb\x9a\xe3\xb4\x5c\xe4\x51\x5b\x72\x18\x9b\x83\xd8\x05\x08\x6c\x96\xd7\x20\xda\x61\xb3\xc2\xc7\x0c\x22\xde\x49\x20\x0c\xdf\x4c\x44\x28\xe7\xa5\xd7\xce\x03\x17\x79\xc3\xbf\x5d\x06\x90\xac\x15\x31\x1a\x1f\x7d\xe4\x28\x60\x1a\x6a\x32\x0e\x8d\xd3\x42\x93\x00\x58\x47\xb7\x54\x25\x8f\x2a\x98\xf9\xb5\xd2\xcb\x35\x6b\xb7\x1e\xaf\x41\x69\xbd\x7e\x82\x9a\xdf\xf3\x2c\xa1\x58\x70\x79\x46\x71\xff\x9a\x92\x4b\xa6\x22\xa5\xe3\xd3\xd7\x45\x92\xf3\xb2\xac\x4b\x36\xb3\x5a\xf8\xca\x2c\x4f\x63\x87\x99\x94\xc2\xae\x33\x05\x76\x0b\xd1\x07\x48\x46\x2a\xb2\xd0\x3f\xac\x48\x42\x5f\xc4\x49\x4f\x50\xaf\x9e\xa7\x75\x76\xab\x8d\x40\x5d\x7d\xd9\x3d\x92\x5c\x4a\x9c\x1c\xec\xad\x40\x01\x41\xc8\xf3\x94\x1d\x1b\x11\x17\xa9\x40\x7f\xf2\x04\xb2\x0e\x9e\x01\xa6\x68\xd7\x6e\xb5\xb4\x90\x58\x21\x11\x17\x13\x6a\x25\x96\x20\xdd\x41\xe3\xbb\xbf\x37\xf0\x63\x11\x66\x18\x41\x6b\x3b\xe6\xbb\xa3\xe9\x94\x34\xf5\x68\xd6\xda\xb4\xb5\xa5\xd7\xb3\x9f\x86\x44\x31\x9a\x35\x98\x50\xbe\x05\x72\xe3\xee\x2d\x94\x71\x08\x97\x5d\x27\x30\x8b\x4b\x66\x7e\x69\xcb\xac\x42\xc2\x4c\x4c\x07\x19\xf0\xca\x24\x29\xbb\xc4\x83\x49\x08\x7d\x4b\x06\x3c\x9c\xfd\x55\x38\x26\xad\x4c\x10\x11\xcd\xb7\x37\x15\xce\xb7\x70\x9e\xe9\x3b\x35\x69\x64\xe9\x99\x4d\x20\x5f\xc0\x30\x69\xe2\x22\x8a\x6f\x6f\x24\xf0\x15\x66\x8b\xa7\xea\x89\x46\x1e\xc5\xa0\x1a\x3b\xb4\x24\xc2\x77\xd6\x79\xa0\xb9\xf8\xce\xea\xfd\x28\x4f\xb5\xfa\x5e\x9c\xdf\xd0\x8d\x70\x12\x6c\x9b\xd4\x7c\x2b\xb6\x72\x58\xcb\x2d\xfa\x21\x51\x80\x29\x5b\xfa\xc2\x9f\x28\x7e\xdf\x00\x43\x83\xda\xc0\x47\x2e\xa0\x3f\xd5\xb6\x41\x41\x60\x16\xd9\x75\xb5\x73\x3b\xb2\xf1\x78\xa7\xf0\x9a\xea\xc1\xca\xb9\xdd\x23\x49\x0f\xd1\x2b\xb0\x86\x70\x0f\xf0\x8d\xa2\x1f\x1a\x09\xae\xfc\x3f\x43\x18\x25\x20\xed\x50\x9a\x79\xfc\x30\x5a\x3f\xde\x5b\xd1\xa4\x2f\x19\x5d\xcf\xc6\x76\x80\xa6\x78\xf5\x55\x3d\xe0\xc8\x37\xef\x20\x89\xae\xc0\x1a\x05\xb6\xf4\x44\xc5\x80\xaf\xb5\xce\x73\x06\xd9\xf3\xdb\xcd\x6c\xcd\xdf\x53\xc5\x3d\xb3\xf0\xe0\x5b\x6a\xcd\xbb\x19\x6a\xb8\x67\x0d\x0d\x4a\x1b\xed\x67\x5b\xe1\x1d\x24\x6b\xd9\xe9\xdf\xff\xe0\x86\x58\x42\xfc\x8f\x6e\x88\x21\x6b\xd5\xb4\x4b\x39\x83\x00\xa1\xeb\xea\xb1\x27\xf6\xe6\x06\x5f\xe2\xff\xd0\x4f\x38\x1c\xb0\xd6\x37\xbe\xde\xda\xc1\x8e\x5e\xe3\x9b\x6d\x98\x26\x5e\x70\x9a\x5b\x28\x00\x77\x3e\xc7\x7a\xa4\x10\x9c\x5c\xe6\x35\x24\x8b\x0f\xf0\xe8\x5e\x2a\x05\xec\x21\x97\x91\x1d\x68\xc6\x51\x65\x0f\x7c\x23\x95\xba\xe4\x8c\xac\x24\x95\xb1\x6b\x2f\x29\xae\x22\x01\xbf\xa5\x94\x0c\x16\x6e\x5a\xd5\x50\x77\xd6\xde\x8e\x3d\x70\x76\x10\x19\x0a\x93\xc5\x15\x24\x8b\xf7\x21\x79\x5e\x03\xb7\x2a\x16\x9b\x34\xea\x54\xb9\xcd\xa0\x66\x65\x9e\x0f\x6a\x0e\xcf\x23\xb7\x53\xb2\x9f\x8d\xdb\x4b\x25\xfb\xd9\xa8\x01\xe4\x7c\x00\x00\xf6\xf4\x28\xe4\xa5\x74\x0b\x0a\x85\xbc\xc4\xab\xb6\x3b\x55\x87\x06\x83\xb0\x29\xbc\x09\x62\xca\x89\x12\xc4\x4f\x4d\x5b\x45\xb7\xa3\xb3\x56\xd9\xf5\x7f\xaa\xc6\x3b\x86\x7e\x8b\x9f\x39\xc3\x6d\xad\x77\x7e\x90\x7d\x60\x85\xc1\x05\x01\x87\xe9\x17\x4e\x0f\xac\x70\x73\x3b\x1b\x29\x84\x9e\x0f\x15\x42\x9f\x1e\xab\xbd\xeb\xa5\xa9\x9d\x1f\xc6\xc6\x8f\x83\x72\xb1\xc2\xd7\x37\xbd\x34\xe2\x26\x66\xcc\x6a\x9c\x95\xcc\x57\xe8\xb4\xf0\x52\xcd\x8d\x6c\x76\x6a\xb1\xea\xa7\x21\xe7\xde\xba\x67\x65\xf3\xca\x67\xc5\x97\x76\xca\x60\x37\xba\x0b\x44\x69\x3d\x36\xb7\xca\xd7\x3b\xe9\x76\xb5\x87\x57\x44\x33\x5c\xd7\x0c\x26\x7e\x01\x30\xf1\x52\xba\x9d\x78\x0f\xda\xc7\x05\xac\xdb\xa6\xde\x2b\x2f\xc1\x5c\x2b\xc3\xf2\xe2\xa9\x78\x4d\xc9\x4b\xa5\x40\x2b\x59\x93\x04\x44\xbb\x30\x30\xa5\x19\x86\xb7\xa0\xb8\x24\xa1\xe8\x32\x82\x2c\x61\x33\xea\x33\x1d\xe9\xcd\xb1\xa1\x77\xeb\x3f\xfb\xd0\x86\x77\x98\x92\xc1\x82\x14\xbb\x6d\x58\x04\xbc\x01\x4b\x1e\x88\x56\xfb\xe2\x29\x6c\xdf\x19\x05\x4b\xc0\x48\xb8\x5e\x3c\x15\xd7\x72\x74\x8b\x80\xbd\xc4\xcd\x74\x12\x92\xab\x67\x40\xae\x79\x0a\x47\x95\x3a\x1c\x4a\x24\x2b\xa8\x42\x58\x81\x17\x33\x46\x87\xad\x7b\x89\x96\xbc\x1f\x9c\x1a\xc4\x6b\x8c\x18\x7b\x1d\xd2\x08\xd6\xa8\x43\x7e\xbb\x94\xae\xb9\x2f\x31\x91\xc1\x50\xb2\x00\x79\x02\x53\x98\x17\x6e\xd9\x28\x1e\x48\x34\xe5\x15\xd1\x75\x31\x2d\x1d\xa0\xbd\x75\x94\xc6\x51\xcf\xe3\xe3\x7c\x94\x0e\x7e\x38\x83\xda\x6a\xe7\x29\xf0\x08\x44\x17\x07\x67\xd5\x77\x90\xcc\xf2\x4d\xee\x7e\xfc\xde\x42\x2f\xb3\x8e\x95\x76\xa4\xdc\xcd\x2f\x47\x5e\x5f\x11\x8e\xfc\x21\x28\xea\x19\x08\x2f\x6c\xbf\x58\x2a\x56\xd8\x8e\x11\x21\xc3\x72\xec\xe8\x92\xb7\xcb\x4b\x83\x64\xc9\xa2\xda\x04\xc3\x15\x48\x9d\xd9\x28\xf7\xd2\xb9\x03\xd8\xa1\xb3\xda\x1f\x2e\x4e\x84\xf6\xe4\x73\x08\xd7\x0e\x60\xcd\x3d\x1a\xb2\xa2\xe3\xd6\xa7\xd8\x88\x64\xe4\x17\x59\x0c\x1a\x08\xca\xf9\xd2\x05\x6b\x1a\x8b\x6c\xa5\x80\x65\x50\xb9\x46\xf6\xf2\x33\x0a\x27\x30\xa4\x14\x98\x9d\x2c\x49\x33\xc7\xa0\xa7\x9c\x7b\xa5\xf7\xfa\x64\x59\xd6\x69\xfe\x70\xa3\xbc\x78\xf8\x13\x38\xcd\x3a\x25\xb6\x9d\x5d\x43\x34\x5a\x0c\xa9\xdb\x05\x14\x3f\x12\x0e\xed\xea\x7c\x51\x82\xee\x9d\x1b\x0c\x3f\xcb\x45\xda\x0f\x76\xa7\xd7\xda\xe3\x84\x2c\x14\x60\x00\x7e\x3b\x74\x1b\xd7\x72\xa8\x89\x96\x78\x51\x08\xc2\x40\x85\x0c\x5c\xa1\x76\xc8\xec\x28\x78\xcd\x63\x58\xac\x20\x62\x90\xb7\xc4\x0c\x43\x56\x26\x7b\x76\x35\xb0\x7d\x18\x7a\x32\xc7\xa3\xf7\xbd\x1d\x42\x17\x70\xb1\x7d\x09\x17\x82\x0b\x04\x2f\x78\xef\xa5\x25\x93\xee\x3a\x78\xc5\x20\xe9\xe7\xc5\x79\xef\x55\x7a\xb9\x36\xe0\x05\x9a\xda\x1e\x4c\xd2\xab\x66\x2d\xc5\xf7\x69\x42\x7b\x53\x58\x0e\x1b\x38\xd3\xc0\xf3\xc2\xbb\x92\x41\xc8\xca\xc3\xab\xc4\x68\x48\xe9\x21\x44\x3b\xc4\x08\x1e\xe8\x1a\x40\x5a\xd7\xbc\x01\x3b\xe9\xc8\x0a\xe9\x44\xfd\xfb\x42\x85\x5e\x54\x9f\xeb\xc7\xca\x06\xe0\x9d\x66\xf4\x4c\x9a\xdd\x33\xb9\xb2\x29\x0b\x06\x68\x97\xd9\x94\xdd\xf7\x1a\x80\x1d\x28\xf2\xc4\x84\xba\x17\x17\xfd\x05\x95\x87\x12\x39\xf5\x86\x84\xd2\x50\x0a\x92\xd2\x25\x18\xdf\x7f\xa1\x92\x19\x08\xf7\xb4\xbe\x6c\x3b\x17\xb5\x61\x89\xf2\x7a\x1a\xd3\xf2\x26\x60\xca\xfc\x9a\x1c\xd3\x49\x7f\x28\x2e\xc4\x5f\xf1\x17\xa5\x83\x12\x11\xb9\xb7\x81\xd3\xa6\xfe\x7f\x04\x19\x64\xb3\x70\x72\xff\xae\x2a\xd0\x86\x17\x74\xdb\x9d\x22\xdc\x8e\x60\xf1\x05\x17\x0e\xd6\x42\x44\x9d\xb2\xb2\x5e\x60\x4a\xfe\xca\x2d\xa6\x28\x88\xca\xd7\xc6\xf8\x7c\x2d\xa5\x33\xcd\x8a\x01\xc1\x29\x7d\x6e\xf6\x96\x35\x99\xd0\x4f\xda\x9b\xd5\x06\x50\xcb\x87\x49\xd6\x4a\xa7\x9a\x71\xd0\xfe\x08\x81\x72\x6d\x63\x3b\x74\x1d\x86\x34\x88\x91\x1b\xd2\xb8\x9d\x13\xe7\x20\x4c\x85\x68\x1e\x17\xe2\xa5\x75\xdc\xee\x1e\xdf\xbf\xbd\xb6\x03\xa7\x80\x7e\xaf\x05\xcb\x73\x6d\x5a\xf1\xec\x4d\x99\x5e\x58\xb9\xc5\xc8\x89\xf8\x96\xbf\x2b\x22\x28\x72\x78\x44\x8c\x8e\xa8\x56\xdb\x95\x78\xf6\xf6\xf5\xff\x75\xe6\x72\x84\x7c\x34\x72\x75\xd7\xf4\xbd\x04\x93\x59\xc4\xc9\xc1\x68\xb3\xfd\x99\x9e\x9c\x62\x1c\xf0\x48\x96\x1d\xd0\x04\xbd\xef\xc2\x00\x78\xf5\xd9\xc3\xad\xa7\xb1\x9e\x5e\xbb\xde\xe9\xed\x0e\xcc\x3d\x74\xa7\xb6\xe8\xe6\x11\xb6\xed\x8a\x67\x32\xf0\x5d\xf4\x9e\x1d\xf0\x5b\x74\x73\xf5\x8b\x74\x2a\x07\x81\x21\x02\x80\x38\x44\xd2\x63\xa8\x46\xb5\xe4\x5f\x2d\x2e\x39\xf7\x24\xf4\xf4\x19\x72\xa0\x48\x91\x43\x08\xad\x77\x7a\x6b\x1e\x6a\x78\xfd\x25\x90\x46\xd5\xb5\x14\xaf\xa0\x88\x45\xb9\x9a\xd5\xc0\x46\x6e\xf0\x8c\xc7\x9b\xfb\x5b\xe3\x46\x6e\xfa\xcd\xf8\xa5\x96\xef\xa5\x86\x90\xa6\xf0\x7f\x0a\x76\xa7\x06\xbd\x39\xd6\xdb\xc1\x8e\x7d\x9d\xd1\xe4\x0b\xf1\xef\x90\x23\x20\x27\xa3\xd6\x54\x0e\x0b\xd0\x55\x22\x04\x9e\x84\xb1\x7e\x01\xd0\xd9\x6c\xa4\x81\xc7\x12\xf8\x06\x49\x84\xc4\x47\x48\x0a\x88\xd4\xf0\xc6\x9a\x20\x5f\x60\xac\xa0\x0e\x0d\x7f\xb1\x58\xec\x05\x18\xa1\x4b\x0d\x0f\x47\x5f\x51\x5c\x70\xbc\xd5\xcb\x56\x41\xc2\x18\x90\xa8\x36\x08\xda\xd8\x2d\x5a\x1c\x09\xdd\x15\x00\x40\x20\x9e\x00\x30\x1d\x4b\x17\x8a\x82\x06\xfd\x42\x3c\x57\xbe\xd9\x89\x94\x15\x0a\xd1\x6e\x44\x2f\xae\xcf\xbc\x5b\x63\x9f\xa1\xb2\xa2\xcb\x78\xc1\x1c\x01\xd0\x24\xa5\x80\xd8\x07\x0e\xa8\x76\x32\x1c\x17\x4e\x5c\xb6\xe2\xe6\x92\x49\xcd\xde\xf7\x35\x5d\x0c\xdc\xbc\x7e\x7f\x7d\x0f\xed\x0a\xa0\x44\x57\x00\x32\x23\x2e\x21\x8b\x08\x0c\x64\x65\x54\x86\x03\x2e\x21\x9d\x72\x1c\x54\x54\xb5\x44\xb0\xdc\x32\xdc\x7d\x1c\x74\xd8\xe1\x83\x72\x7e\xd0\x0d\xbe\x93\x4f\x65\x56\xe2\xf5\xd8\x79\xdd\x77\x8a\x53\xd8\x0e\x16\x62\x2d\xf4\x72\xe0\x17\xc5\x1b\xbb\xdf\x4b\xf1\xe0\xfc\xc1\xaa\x38\x05\x6a\xdf\xb9\x14\xa8\xf5\xfd\xd5\x8d\xf8\xd5\x34\xc3\x11\xcd\x65\xa8\xa7\xb7\xba\x0f\x60\x35\xae\xf9\xd0\xe1\x5b\xdd\x03\x2c\xae\x75\x26\xb7\x72\x5f\x3b\x35\xdc\xe9\x26\xee\xc9\xeb\xcb\xd7\xa0\xc2\xd3\x8d\xca\x89\x3d\x55\x0d\x2f\xdf\xb1\x10\x95\x1a\x71\x39\x7a\x5b\x08\x51\x5c\x2a\x7b\x60\x6a\x7a\x3c\xa2\xa5\x0b\x8f\xeb\x8c\xc7\x2e\xa1\x0b\x56\xbb\x38\xfa\x78\x59\x9c\x2a\x16\xb9\xfa\xec\xee\x2d\x9d\xc9\x53\x69\xae\x2c\xfe\x25\xcf\xc0\x55\x71\xda\xe6\xac\x57\x89\xe7\x2b\x8d\x4e\x73\x64\x19\x9b\x7c\xdf\xb8\x2d\x46\x51\x2c\x4b\x14\x90\x35\x32\x00\x64\xfd\x33\x41\x1d\xed\x80\xe6\x25\x72\x4b\xad\xf9\x18\x2f\x18\x73\xde\x63\xc0\x49\x4b\x14\x78\x67\x1d\x1d\x43\x4f\xa0\x46\x2e\x1a\x60\xd6\x47\xb4\x20\xa2\x4b\x66\x32\x88\x48\x8c\x7a\x0a\x14\xab\x1c\x41\xe5\xf1\x50\x71\x01\x00\xef\x43\x9c\x73\xd6\xcd\x09\xe7\x5c\x36\xe3\x0b\x0c\x34\xa2\x41\x09\x9c\xfc\xb0\xd8\x77\xe3\x2a\x5b\x74\xc4\x94\x4c\x5c\x36\xe8\x38\xd0\x7e\x37\xae\x6b\xd9\xeb\x5a\x99\x16\xdd\x78\x2e\xc4\xe5\xf5\x2b\xf1\x2b\x7d\x56\x64\x60\xb1\x32\xd6\xd7\x0e\x1c\xa2\x7e\x00\x0f\x38\xe5\x7f\xe4\x2c\xd2\xc4\x47\x4b\x0c\xd2\xc4\x37\x85\x41\x06\xc1\xae\x07\x69\x5a\xde\xf3\xbf\x84\x0f\x74\xbb\xa2\xec\x61\xc4\xb3\x08\xef\x6a\x61\x30\xf3\xac\x3d\xfa\x99\x85\xac\xf0\xb3\x6c\x40\x8a\x16\x3e\x09\x30\xfe\xe1\xdd\xd5\x04\x72\xca\x16\x96\xb9\x19\x5f\x19\xd9\xc9\x12\x62\xe7\xc3\xb9\xd0\xb6\xa1\x9d\x10\x85\x2e\xfc\x56\xce\x2d\x81\x11\xe5\x07\xb0\xf0\x7b\x02\xd3\xa8\xc1\xb3\x3b\xe3\x53\x35\x90\x0a\x08\x3d\x0e\x27\xa0\xb7\xea\xc8\x90\xff\xaa\x8e\x4b\x10\x81\xf4\x86\xd3\x2e\x99\x85\xbc\xd6\x06\x74\x16\x81\x04\xb3\x7d\x48\x59\x66\x34\xfa\x73\xed\x2c\xe8\x48\x33\x4f\x09\xf0\x01\xfd\x2c\x30\x23\x13\xbd\x27\xa5\x41\xfa\xae\x07\x6b\x3d\x8d\xfa\x15\x8a\xe3\xd6\xfa\x85\x71\xb7\x9b\x4d\xa7\x8d\xe2\x79\x7c\x8b\x9f\x4b\x73\x49\x31\xa7\xea\xc1\x8e\x78\xdf\xb1\xcd\x5e\xa1\xc1\xc4\xb0\xb3\xa6\x2b\x00\x4f\x8b\xed\xef\xc0\x5e\xd1\x21\xf1\xe2\x77\xdd\x4f\xe0\x64\xdf\xd7\xa0\xc3\xed\x25\xba\xf9\x66\xb6\x38\xa0\xc4\x0d\xe9\xb3\x9e\xca\xb6\x96\xce\x29\xef\xea\xcd\x60\xf7\x75\xab\xdd\x2d\x79\xd6\x09\x4c\xa7\x57\x70\xb4\xbb\x9d\x96\x95\xe0\xd8\xc5\x43\x84\x5f\x30\x3e\x11\xd0\xed\xb2\x0d\x74\xf3\x72\x79\xf7\x38\xb7\x5b\x10\xc9\xb2\xcc\xb8\xb0\x7f\xfd\xdc\xc3\x9b\x9a\x6d\xb9\xc0\xdd\x8e\x45\x1d\x06\x28\x96\xa4\xdb\xad\x60\x2a\x69\x58\xde\x85\x59\x2c\x86\xc2\xed\xc2\x2a\xdc\x2a\xc3\x20\xff\x0a\x5f\x4b\x40\x35\xc4\xcf\x4b\x60\x18\x7b\x6f\x0a\xb8\xc7\xf5\x89\x9e\xf2\xfa\x77\x85\x4f\x00\x67\x0b\xf7\x56\x1d\x05\xc8\xc0\x90\x71\x5f\x51\xb7\x50\xca\x15\x5d\x53\x64\x04\x5d\x5e\x49\xd7\xd2\xd7\x41\x1e\xf7\xd9\xdd\xf5\xf7\x13\x98\xef\x85\xf4\x60\xf9\x53\x8c\x15\x24\xd4\xf4\x50\x43\x8d\x73\x4d\x42\xbd\x8f\xef\x37\x60\x72\x5e\x0c\x58\x64\x53\x13\xb7\x08\xfc\xb0\x81\x08\x93\x0b\x40\x34\x5b\x04\x34\x9d\x2c\xa6\xbc\xba\xdf\xf1\x53\x37\x48\x7a\x31\x21\xae\x2e\xd4\x46\xf2\xf2\xca\x14\x1e\x8b\xab\x2c\x40\xdf\xbf\x0e\x00\x02\x6d\xa5\x59\xaa\xbf\x81\x2f\x38\xe7\x0a\x28\x69\x9c\xae\x9b\x9d\xf4\x78\x78\x5c\xbe\xb9\x79\x05\xbe\xf4\x4e\xf9\x02\x0e\x5e\x96\xaa\x93\x1e\xe5\x39\xbe\x34\x45\xda\x94\x1c\x72\x2f\x3f\x27\xcd\x2a\x28\x4d\x51\x37\x2b\xa2\xaa\x16\x12\x8b\x32\xfd\xa0\x30\x18\x6e\xdd\xe9\x46\x19\x47\x8f\x8d\x51\xa2\xe0\xc4\xa2\x0c\x93\x20\xa0\xe2\x5b\xa8\x85\x09\x10\x10\xf3\x17\x93\x3a\x88\xf8\x20\x45\x0c\xa3\x55\xef\xf5\x36\xbe\xe4\x47\xc4\x08\xb5\x95\x30\xae\x31\x77\x09\xcb\x20\xd1\xc9\xbd\x1e\x94\x69\xd5\xc0\x14\x93\xb0\x0c\xf2\x80\x76\x14\x98\x5b\x10\x50\xc0\x42\x0e\xdc\xf5\x26\x48\x50\x61\xe6\xf1\x6a\xb6\x39\xa6\x57\xca\x05\xe4\x89\x2c\xaf\x6c\x47\x1b\x56\xc8\x0a\xc8\xf5\x61\x90\x3d\xbc\x01\x62\x1c\x99\xf8\xfd\x0a\xb9\x02\xa8\x77\xc8\x15\x29\x77\x09\x0b\x79\x28\x43\xcf\xa0\x57\xa1\xc1\x19\x9e\x2c\x1f\xfb\x05\xf9\x05\xa6\xb1\x0f\x04\x38\xa3\x7e\x1f\x20\x41\xa8\x92\x08\xe6\xb0\x5e\xed\x7b\x5e\xc2\x04\x1d\x92\xec\x20\x87\xe3\x7c\x39\x53\xa1\x18\x02\xf7\xd8\x2b\x97\x0a\xc6\x40\xb7\x21\x79\xa9\x1c\x76\x4b\x7e\xae\x49\x61\x47\xe5\xa0\x37\x90\x34\x5f\x94\x54\x32\x14\xe2\x60\x03\x59\x29\x47\x25\xb8\x48\xbb\x4e\x3b\xf8\x19\x9b\x41\x2e\xee\xdf\x76\x5d\x68\xf2\x52\x6a\xae\xf7\x4a\xa9\xb9\x1e\x30\xa5\x12\x17\xf6\x21\xe3\xc0\xda\xf5\xca\xb9\x8e\x97\xe2\xcd\xcd\x55\xb1\xee\xb2\xdc\x24\x9e\xfe\xb0\xb1\x83\xf8\xbe\xb7\xce\x6f\x07\xe5\xbe\x17\xd6\x74\xc7\x1f\xb3\x12\x34\x3b\xd7\xd9\x64\x50\xea\x14\x87\xfb\x5b\xa7\xbd\xfa\xf3\xf7\x88\x21\x9d\xaf\xa4\x0b\xcc\x98\x4f\xd2\x04\x2e\x1f\xa0\x94\x4b\x6c\xf3\xa0\xc8\x41\xa9\x95\x60\xba\x84\x7c\x33\xa7\x8a\x90\x3a\x2b\xd9\x58\x7b\xab\x55\x2a\x4a\xc3\xf7\x8e\x0b\x61\xfe\xa9\x62\x4b\x1a\xb1\xfb\x4b\xc0\x77\xb6\xf7\xe9\xfb\x44\x21\x7a\xf2\xa2\xee\x07\xfb\xf9\x88\x32\x14\xf3\xd3\x98\x23\x20\x67\x2a\xf1\x60\x80\x85\x19\xb6\x48\xd2\x40\xc6\x00\x13\xdd\x1a\x2b\xce\x29\x1a\xc8\x1a\x68\xd7\x7b\xa2\x55\x0b\x08\x78\xdc\xae\x16\x8a\x73\x79\xb5\x97\xba\x4b\x53\x8b\xea\xb5\xc5\x79\x05\xc8\xd3\xac\x11\x66\xbb\x11\xac\x31\xea\x70\x18\xe8\xcf\xa0\xd8\x83\x04\x81\x09\x25\xf0\xc2\x5e\xc1\x0c\xe0\xf1\x2e\xc4\xf3\xc1\xee\xcb\x8c\x85\x1d\x83\x19\xf1\x20\x51\x9d\xcd\x0f\x91\x5f\xaf\xde\x4e\xea\x54\x9d\x05\xb6\x80\xa3\xa9\xfe\x7a\xf5\x56\xf0\xf7\xa4\x2f\xb7\xba\x9f\x68\x59\x9a\x4c\x7a\xc0\x9c\x59\xfb\xea\x1c\x06\x5f\x6f\xa2\x70\xb3\x59\x46\x59\xea\x6b\xe4\x13\x84\xbc\x47\x3c\x49\x0d\x00\x75\x74\xed\xd5\x67\x4f\xf5\x27\xfd\x74\x09\x2c\xdb\x36\x03\xae\x25\x5c\x36\xc0\x9b\x85\xb1\x80\x90\x1d\x48\x78\x10\xc4\xae\x1c\x1d\x65\x5a\xe4\x3f\x49\x33\x0b\xb7\xed\xc0\x80\x02\x40\x09\x1d\x01\xeb\x0d\x06\x17\xb9\x10\xcf\xf1\x87\xb7\x18\x53\x36\x95\x0c\x49\x41\xa0\xfe\x59\x9c\xdd\x9d\xc2\xe2\x30\x78\xc7\xfb\x54\x28\xbd\x02\x4e\x61\x76\x03\x8a\x55\x5c\xe7\xb0\x4d\xe3\x32\x9f\x68\x01\x16\xd7\x7b\x28\x11\x95\x57\x10\x7e\xa5\xee\xc8\x08\x97\xed\x17\xf0\x39\x53\x48\x2d\x4a\x0d\xca\x05\x49\x8f\x2f\x13\x8a\xb2\xef\x42\x5e\xba\x48\x38\x89\x01\xdf\xdd\xcc\xb6\x27\xbc\x63\xc3\x8f\x79\xaa\xb4\x4f\xd9\x01\x62\xa9\xb8\xd3\x5b\x53\x07\x61\x15\x63\x97\x70\xe9\x90\x2c\x34\x7a\x37\x15\xe5\xa2\x48\x98\x1b\x4d\x64\x42\x61\xae\x06\xcc\xcb\x31\x47\x95\xe5\xd7\x8d\xec\x7d\xb3\x93\x19\x47\x95\x23\xa5\xdc\x65\x2c\x53\xfa\x5a\x78\xa7\x44\x6c\xa7\x69\xed\x57\x61\xb5\xd3\x5e\x9e\x42\x6c\x4f\xf7\xfb\xbe\xa6\xd6\x31\xa2\xce\xd7\x1c\x0b\x8c\x16\x54\xfd\x71\x9d\x82\xaa\x7d\x71\x75\xc2\xfb\x02\xd4\x35\x5c\x24\xd1\xec\x85\xfa\x81\x4b\x24\x0f\xb8\x9f\x1d\xe9\xe8\x07\x96\x9d\xe8\xa8\x91\x38\x71\xa0\x43\xe6\xaa\x1f\xec\x9d\xa6\x08\x43\xf4\xf3\x14\x48\xc2\xcc\x90\x84\x7a\x5a\xa0\x3c\xa8\x9e\x4e\x8e\x36\x84\x09\xc2\x81\xe3\x10\xbd\x41\x2c\xb8\x01\x1e\x67\x0a\xb6\x6d\xf0\xb1\xbf\x3b\xb0\x6c\x78\xf1\x54\xf0\xd7\x14\x30\x30\x83\x9d\xde\x28\x36\xc2\x0a\x72\x4d\xf8\x46\xbf\x9d\x69\x03\xdd\xb0\x99\x1c\xa7\x4f\x6f\xde\x3d\x9f\x1e\xa3\x68\x4b\x17\x7b\x8d\xd6\x73\x8b\xa3\x09\x90\x2b\xd9\xca\x9e\x2f\x4b\xe0\x57\x99\x7d\x7f\x47\x10\x26\x3f\x3d\x39\x07\xe4\xa8\xd8\x0a\x10\xa1\x16\x1b\x11\xe0\x56\xe4\x20\x0c\xcf\xbe\xd9\x0e\x43\x69\xd4\xf8\xc4\x19\x9a\x18\x28\xe7\x04\xe5\x22\x73\x4e\x0f\xa0\x25\xa2\x1a\x1d\x4c\x32\xd2\x1a\xd3\x4e\x90\xd5\x98\x7f\x9a\x97\xc8\x60\x16\xb8\xd7\x2c\x77\x2a\x49\x5c\x2e\x89\x10\x19\x7c\x26\x3c\xdc\xcc\x04\x86\x09\x1c\xcb\x0b\xcf\x17\x04\x05\x0e\xd2\x94\xc9\xfb\xe4\xc3\xb7\x2c\xec\x53\x38\xb0\xa5\xae\x67\xe3\x35\x93\xb3\x66\xc5\x66\xfd\x4d\x85\x4f\x48\x4f\x33\x14\xd9\x10\x64\xa5\x97\xc4\xa7\xc5\xa2\x3c\x2a\x59\xd9\x25\x49\xaa\xd7\x60\x36\x9a\xd1\x01\x4c\x58\x1e\x20\x82\x5e\x51\x98\x0f\x14\xda\xa2\x58\x19\x68\x20\x85\xf8\xc0\x9c\x42\xb0\xe4\xb2\xe8\xe6\xb2\x84\x20\xd3\xc5\x7c\x19\xcd\x76\xe0\x58\x23\x6c\xb4\xf7\x62\xe0\xf8\x22\x78\xc1\x34\x29\xc0\x47\x26\x17\xcc\x8e\x4b\x2e\x39\x2d\x42\x64\x7b\xa3\x5a\x88\xc9\xd6\xd6\xb1\x24\x91\xee\x98\x43\x0d\x4e\x5a\x26\x7a\xab\x37\x0e\x2b\xbd\xd0\xbb\x38\xaa\x08\x1b\x2f\xd3\x32\x9a\xc2\x2f\xcc\x47\xc2\xc2\x45\x38\xec\x7f\xc4\xcf\xe1\x7c\x17\x2b\x88\xaf\x78\xd0\x6a\x7c\x9f\x2f\x3d\xce\xa4\xe8\xbd\x40\x6c\xed\x48\x56\x5f\x21\x45\x50\xca\xb4\xc0\x3d\x17\x9c\xc4\x72\x73\x89\xad\xce\xe8\xce\x0b\x7d\x82\xe0\x6c\xb5\x8f\x93\x04\xb1\x1c\x77\x7a\xbb\x83\x17\x5f\xb3\xa9\xc2\xf8\x85\x47\xe3\xe5\x67\x11\xf3\x73\x0c\x61\xed\x43\xe9\x4e\x1b\x74\xc4\x0a\x25\xf0\x03\x15\x64\x20\x43\x4b\xe1\xb4\xd9\x92\x8a\xe5\xc7\x93\x08\xea\x2c\x4a\x26\xa1\xca\xe3\x66\x2e\xe0\x0b\xa5\x96\xf1\xf1\x8e\x04\x2c\xd9\x5e\x9c\x20\x08\xb0\x05\x82\x6d\x53\xcb\x61\x4b\xf6\xc0\x72\xd8\x8e\xe0\x28\x58\x54\x01\xda\x33\x95\x4d\xdd\xeb\xa8\x6d\x9b\x4c\x1e\x82\xc3\xda\xcc\xa1\x61\x71\xa2\x12\x6c\xa1\x00\xf8\xd4\x67\xf0\x4f\xd1\xc7\x7e\x0e\x08\x61\xcd\x13\x1c\x44\x34\x5f\x00\x23\x13\x67\x04\x7a\xf1\x34\x82\x30\x4c\x67\xb7\x69\xbd\x5c\xd9\xed\xf2\x7a\x09\x50\xa8\x16\xcc\xd4\xb3\x01\x1a\xb5\x81\x53\x3d\x6d\x00\x27\x75\xcd\xeb\x4c\x55\x13\x92\xe7\xf1\xa0\xd8\x35\x7a\xd5\x0c\xf8\x80\x56\xf8\xf7\x5e\xba\xdb\xe8\x34\x5d\xa8\x8a\x38\xcd\x35\x3b\xd5\x8e\x1d\xea\x80\xf1\x67\x82\x47\x39\x0f\xec\xd3\xc1\xda\x9c\x33\x40\xe1\x67\x47\xc7\x31\x0b\xc3\xcf\x02\x40\x7d\x56\xcd\x98\xb9\xaa\xfc\x8a\xdf\x64\x1b\x9e\xd0\x58\x0e\xb4\x32\x1a\xb0\x50\xb9\xc6\x94\x0c\x66\x21\x56\x59\x6c\x3a\x69\xfd\x51\x61\x7f\xb2\xfe\x58\x3d\x98\x7c\x04\x28\x76\x2f\x67\xaf\x66\xfc\x64\x03\x9a\x89\xc7\x39\xc3\x42\xe0\x52\x7c\x5e\x24\xb1\xdf\x10\xc1\x14\x21\x29\xb8\x65\x84\x27\xbf\x62\x12\xe9\xc2\x0c\xc5\x5a\x55\xa7\x1a\x88\x2f\x06\xec\x71\xf8\x08\xdc\x45\xcc\x6f\x55\x01\xf1\x8c\x3e\x0b\x18\x6d\x50\x3a\xc0\x2c\x14\x32\x5e\x61\x1a\xa1\xcc\xdc\xe8\xf9\x4e\x1e\x81\x29\x2a\x32\xdc\x7f\xdf\x50\xca\x14\x92\x6b\x06\xa0\xcb\xae\x9b\x8d\x46\xae\xa1\xcc\xd3\xe0\x45\xc0\x2c\xd6\x41\xd6\xa7\xe9\x34\x72\x96\xed\x61\x15\xaf\x66\xad\x8d\x17\xeb\x34\x23\x1c\x14\xe0\x4b\xce\x97\xd5\x47\x1c\xfb\x4f\x1c\x3c\x8f\xec\x7c\xd9\xbc\x3e\x73\x69\x2b\x62\x9c\x9f\x41\x68\xee\x6a\x50\x26\x7b\x64\x17\xbf\x8a\x42\xa0\x42\xc2\x67\x3c\xce\x3e\xfe\xf4\xc9\xf1\x3b\x1e\xde\x66\xf8\x3e\xfe\xe9\x53\x40\xf9\xf1\xcf\x9f\x10\x2b\xaa\xf4\x19\x6b\x3f\x42\x90\xff\xb2\xc4\x4f\x9f\xdc\x23\x37\x34\x8f\xa6\x65\x85\xf4\x13\xb0\x90\xf9\x3f\x12\xe2\x5e\x0e\xaa\xe6\xa0\xaf\xb4\x28\x31\x59\x3b\x6b\x28\xf0\xb3\x72\x0a\xe2\xfd\xd2\xdb\xc6\xf1\x49\x42\x6a\x11\x7f\x4f\xc6\x07\x7b\xb9\xdc\xc5\x34\x64\x34\xce\xf8\x4e\xf4\x85\xf8\x0d\x1f\x7e\xa0\x77\xa3\xb3\x02\x8f\xd0\x48\xf6\x11\x16\xfd\x27\xe8\x68\x40\xf0\x5b\x05\x8f\x46\x24\x04\xf8\x86\xc4\xb7\x20\xc0\xd7\x26\x12\x06\x7e\x7d\xe2\x9b\x1a\x41\xcf\x3f\xa4\x66\x60\x82\x6a\x05\x98\x95\x7c\x3d\x22\x1c\x8f\xc9\xeb\x1a\xbf\xf1\x02\xcc\x5f\xc9\x2e\x10\xc2\x6b\xd5\x27\x47\x67\x86\x0e\x07\xe9\x9b\xb1\xd1\x50\x4d\xd1\xc5\x11\xfb\x66\x84\xf0\x6a\xf6\x0c\x1f\xa4\xfe\x91\xce\xe2\xe0\xe1\x5b\xe0\xd9\xb6\x35\xea\x40\x0f\x84\xff\xe3\x9b\x86\x48\x4c\xac\x83\x09\x09\xe3\xa7\xcd\xfd\xa7\xb4\xb9\x17\xd1\xf1\xe6\x86\xf7\x62\xbc\xdc\x66\x3b\x5b\x6e\x8b\xce\x42\x13\xa1\x0c\xf5\x73\xbe\xf7\x73\x84\x1c\xc1\x08\x50\x72\xe3\x00\xe7\x37\xb6\x0c\x5e\xc4\xa1\x2d\x8e\xcf\xe0\xcc\x5e\x1f\x5f\xda\xd0\xc4\x6f\x81\xe7\x33\xbd\x93\x43\x3e\xca\x59\x6c\xe9\x7f\x74\x16\x90\x90\x62\x55\x45\x8d\xf1\x15\x22\xaa\x33\xcc\x3c\x5c\xf6\x2a\xd3\xa8\x7f\x60\x58\x4f\x56\x18\xed\xe1\xa8\x42\x78\xdf\x98\x46\x3d\xab\xf8\xdb\xc6\xbe\xa8\xad\xfa\xe8\xad\xed\x3e\x55\x72\x1b\x66\x42\x6e\x6d\x05\x46\x28\x18\xac\x0e\x00\x8d\x3d\x54\xf8\x19\x7e\xfd\x14\x08\xf9\x4f\xf4\x4e\x9f\x38\x73\xd5\x4f\x7b\x48\xd8\x6b\x13\xf8\xa8\x90\xb0\x83\x84\x9d\x1d\xe1\xd9\xe5\x9f\x5a\xf8\x6c\xe5\x11\xbe\x0e\xf0\x75\x50\xea\x16\x0b\x03\x83\xf0\x93\xd8\x5b\xe3\x77\x90\x72\x84\xef\xa3\x92\xf4\x68\x33\xbe\x07\x78\x11\x8e\x08\xfe\x38\x73\x15\x56\x47\xe9\xfc\x71\xe6\xaa\x50\x2b\xa5\xe2\xcf\x33\x57\xd1\x25\xdc\x59\x0b\x17\x6f\x21\x25\x54\x4f\x49\xf8\xf3\x0c\xf8\x3a\xbf\x63\x84\xf8\xfb\xcc\x55\xa1\x1d\x94\x88\x3f\xcf\x5c\x35\xc8\x43\x9d\xda\x45\xbf\x20\x35\xb5\x8a\x7e\x41\x2a\xb7\x09\xfe\x57\xd5\xc7\x76\xb0\xfd\xef\xd6\xa8\x4f\x15\x8b\xa9\x7b\xe5\xc8\x85\xf5\xd9\x60\x7b\xf6\x5c\x57\x03\xda\x01\x76\xba\xb9\xc5\x07\x11\xe1\x5e\xb7\xa2\x28\xc8\xb5\x36\xfd\x18\xed\x24\xc8\x5d\xe0\x81\x67\x41\x3f\xbe\x12\x88\x31\xae\x8e\xbd\x5a\x55\xc0\xe2\x7b\x6b\xeb\x35\x88\x03\xcf\xe3\x25\xf2\x0f\xff\xf5\x5f\x00\xaf\x7f\x57\x7f\xff\xbb\x78\xfd\xcb\x8f\x42\x7d\x6e\x94\x6a\x9d\xd8\x93\x73\x1a\x83\xed\xe5\xe7\xe7\x05\xe4\xaa\xa2\x80\x51\x74\x47\x83\x01\xa3\xf0\x9a\xe6\xff\x0b\x00\x00\xff\xff\x44\x2e\x85\x4c\xf4\x0d\x01\x00"
+var _confLocaleLocale_enUsIni = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\xfd\xeb\x96\x1b\xb9\x95\x30\x0a\xfe\xe7\x53\xc0\xf2\xe4\x57\x55\x5e\x29\xaa\x6d\x77\x7f\x33\xab\xa6\x54\x1e\x95\x54\xaa\x52\xb7\x6e\xad\x54\xd9\x9f\xbf\x9a\x5a\x61\x90\x01\x92\xe8\x0c\x06\x68\x00\x91\x14\xdd\xab\xdf\xe0\x3c\xc0\x79\xbe\xf3\x24\x67\xed\x1b\x2e\x11\x41\x66\xca\xee\xf3\x27\x93\x01\x6c\x6c\xdc\x81\x8d\x7d\xd5\x87\x43\xd3\x9a\xb0\x56\x4f\xd5\x33\x75\xd0\xb6\xef\x4c\x08\x2a\x98\x6e\xf3\x78\xe7\x42\x34\xad\xfa\xc1\x46\x15\x8c\xbf\xb3\x6b\xb3\x58\xec\xdc\xde\xa8\xa7\xea\x47\xb7\x37\x8b\x56\x87\xdd\xca\x69\xdf\xaa\xa7\xea\x85\xfc\x5e\x98\x4f\x87\xce\x79\x00\xfa\x9e\x7e\x2d\x76\xa6\x3b\x40\x19\xd3\x1d\x16\xc1\x6e\xfb\xc6\xf6\xea\xa9\xba\xb1\xdb\x5e\xbd\xea\x29\xc5\x0d\x51\x92\xde\x0d\x91\xd2\x86\x83\x24\xfd\x74\x58\x78\xb3\xb5\x21\x1a\xaf\x9e\xaa\x0f\xfc\x73\x71\x34\xab\x60\x23\xd4\xf4\x27\xfa\xb5\x38\xe8\x2d\x7c\xbe\xd7\x5b\xb3\x88\x66\x7f\xe8\x34\x66\x7f\xe4\x9f\x8b\x4e\xf7\xdb\x81\x60\x5e\xf3\xcf\xc5\xda\x1b\x1d\x4d\xd3\x9b\xa3\x7a\xaa\x9e\xe3\xc7\x72\xb9\x5c\x0c\xc1\xf8\xe6\xe0\xdd\xc6\x76\xa6\xd1\x7d\xdb\xec\xa9\x53\x3f\x05\xe3\x15\xa7\x2b\xdd\xb7\x0a\xd2\xb1\xc1\xa6\x6d\x6c\xdf\xe8\xc0\xad\x36\xad\xb2\xbd\xd2\x61\x81\xa8\x7a\xbd\x97\xd2\xf0\x73\x61\xf6\xda\x76\x30\x46\xf0\x7f\x71\xd0\x21\x1c\x1d\x0e\xe4\x7b\xfe\xb9\xf0\xa6\x89\xa7\x83\xc1\x0e\x3f\xfe\x78\x3a\x98\xc5\x5a\x1f\xe2\x7a\xa7\xa1\x99\xf4\x6b\xb1\xf0\xe6\xe0\x82\x8d\xce\x9f\x10\x4e\x3e\x16\xce\x6f\x75\x6f\xff\xa6\xa3\x75\x30\xd6\xef\x8a\xcf\xc5\xde\x7a\xef\x60\x20\xdf\xe0\x8f\x45\x6f\x8e\x0d\xe0\x51\x4f\xd5\x5b\x73\x2c\xb1\x40\xce\xde\x6e\x3d\x8d\x22\x64\xbe\xc1\x2f\xc0\x42\x79\x8c\x89\xb2\x12\xb6\x8d\xf3\xb7\x9c\xfa\x12\x7e\x8e\x50\x3a\xbf\xe5\xdc\xba\x5d\xba\xd7\x5b\xc3\xb9\x6f\xf0\xa3\x02\x08\x0b\xdd\xee\x6d\xdf\x1c\x74\x6f\x60\xe8\x9e\xc1\x97\x7a\x0f\x5f\x0b\xbd\x5e\xbb\xa1\x8f\x4d\x30\x31\xda\x7e\x0b\x73\xf0\x8c\x92\xd4\x0d\x27\x2d\x8a\xbc\x94\x76\x72\x43\x9a\x65\xf5\x54\xfd\xd9\x0d\x5e\xbd\xa7\x4f\xca\x2b\x0a\x61\x66\x2a\xb9\xd0\xeb\x68\xef\x6c\xb4\x86\x2a\x93\x8f\xc5\x61\xe8\xba\xc6\x9b\xbf\x0e\x26\x44\xc8\x7a\x3f\x74\x9d\xfa\xc0\xdf\x0b\x1b\xc2\x80\x25\x5e\xe1\x8f\xc5\x62\xad\xfb\x35\x76\xe7\x39\xfe\x58\x2c\xf6\xda\xf6\xd1\xf4\xf0\xd5\xec\x5d\x4b\x0b\x40\xb7\x8f\x5d\xdf\x9d\x54\x91\xa9\x20\xb3\x82\xa6\xe1\x59\x9d\x60\x35\x01\xc2\x9d\xee\xb7\x26\xa8\xbd\x6e\x8d\x5a\x9d\x14\xee\x15\x84\x09\x4a\x7b\xa3\x74\xd7\xb9\xa3\x69\x97\x8b\xad\x8d\x8d\x3b\x18\x9a\xd8\x66\xe8\xf5\x9d\xb6\x9d\x5e\xe1\x88\x7c\xdc\x19\xdc\xfc\xc6\x2b\x1b\x54\x74\x4e\xad\x86\x70\x52\xd1\xa9\x8d\xed\x6d\xd8\xa9\xb8\xb3\x41\x71\x77\x95\xb7\xdb\x5d\x54\xbd\x3b\x5e\xab\x43\x67\x74\x30\x2a\xfa\x93\xd2\x5b\x6d\x7b\x05\x1b\xd0\x2f\x17\x8b\x9f\x6d\x1f\xa2\xee\xba\x5f\x16\xfc\x03\x06\x83\x7e\xd1\x3a\x88\x36\x76\x26\x27\xaa\x9b\x68\x0e\x01\x16\x92\x7a\x69\x7d\x88\x8f\xa3\xdd\x1b\xf5\x61\xe8\x17\xad\x5b\xdf\x1a\xdf\xc0\xf1\x82\x07\xc3\xab\x8d\x3a\xb9\xe1\x0b\x6f\x94\x1f\xfa\xde\xf6\x5b\xf5\x83\xdb\x06\x65\xfb\x60\x5b\xa3\x5e\x20\x74\x6a\x98\x37\xba\x55\xdf\x68\x15\xb5\xdf\x9a\xf8\xf4\x51\xb3\xea\x74\x7f\xfb\x48\xed\xbc\xd9\x3c\x7d\x74\x15\x1e\x7d\xfb\xc3\x60\x5b\xd3\xd9\xde\x84\x6f\x9e\xe8\x6f\xd5\x5a\x7b\xb3\x19\xba\xee\xa4\x56\x66\xe3\xbc\x81\xba\xd4\x1a\xc7\x58\xe9\xfe\x14\x77\x50\xa1\xed\x69\x40\xe0\x20\xfa\xd5\x02\x86\xc5\x46\xd3\xb4\x2b\x39\x62\xb1\x41\x98\xec\x4d\x50\x6f\x4e\x37\xff\xfe\xfa\x5a\xbd\x77\x21\x6e\xbd\xc1\xdf\x37\xff\xfe\xda\x46\xf3\xfb\x6b\xf5\xe6\xe6\xe6\xdf\x5f\x2b\xe7\xd5\x47\xfb\xe2\xbb\xe5\xa2\x5d\x35\x32\x2e\x2f\x74\xd4\x2b\xe8\x42\x5a\x8b\xed\x4a\x8e\x8a\x94\x87\x07\x06\x1c\xe0\x78\x58\x87\x88\x87\x10\x1f\x40\xb3\xc7\x4d\xbb\x6a\xf8\x8c\x4a\x38\xde\xc2\x41\xd5\xae\xf2\x00\xbf\xa7\xa1\x1b\x82\x51\xaf\xde\xbe\x7d\xf7\xe2\x3b\x65\xfa\xad\xed\x8d\x3a\xda\xb8\x53\x43\xdc\xfc\x7f\x9a\xad\xe9\x8d\xd7\x5d\xb3\xb6\x30\x36\x3e\x98\xa8\x36\xce\x53\x4f\x97\x8b\x10\x3a\x59\xd3\x37\x37\xaf\xd5\x1b\x58\xc1\x07\x1d\x77\xd8\x90\xb8\x5b\x84\xbf\x76\x30\x5e\xa9\x42\x58\x7d\xb8\x35\x11\xc8\x6d\x64\x78\x54\xcb\x6d\x5c\xaa\x6f\x56\xfe\xdb\xa2\x5d\x7a\x15\x5c\x37\x44\x2e\x71\xdc\x99\x1e\xe7\x29\x44\xed\xa3\xd2\x41\x2e\xb2\xe5\xc2\x78\xdf\x98\xfd\x21\x9e\x60\x76\xb8\x0d\x63\xec\x84\x64\xad\xfb\xde\x45\xb5\x32\x0a\xe1\x97\x8b\xde\xf1\x56\x83\x6b\xa1\xb5\x01\xb6\x4a\x43\x17\x94\x97\x13\xf7\xcf\x6e\x90\x82\x0c\xa1\x2a\x08\x18\x31\xb8\xf4\xf0\xf6\x81\x95\xa3\x7b\xda\x9b\x8a\x8f\xb2\xb2\x85\x72\xee\xa5\x59\xa3\xa3\x2f\x25\x4c\x5a\xb8\x90\x69\x90\x35\xf3\xec\x70\xe8\xec\x9a\xaa\xfe\x81\xf2\xf2\xf2\x01\x12\x80\xe7\xbe\x84\xc3\xe9\x97\xbc\x62\x11\x0c\x11\x86\xd4\xab\xea\x8e\xc1\xf2\x3b\xe3\x8d\xda\x0d\x5b\xba\x18\x3b\x37\xb4\xbf\xc2\x1b\x4a\xc6\x37\xdf\x03\xea\x83\x73\x91\xe6\x3c\x01\xe4\x2a\x9e\x75\x1d\x52\x1d\xde\xec\x5d\x34\x2a\x5d\x72\xd6\x04\x75\xb4\x5d\x07\x3d\x0d\xfa\xce\xb4\x2a\x3a\xda\x6f\xad\xf5\x66\x0d\x88\x97\x0b\x3f\xf4\x0d\x2f\xf6\x0f\x43\x4f\x0b\x5e\xd2\xea\x95\x85\x50\xfb\x21\x44\xb5\xd3\x77\x06\x06\xde\x84\x00\x28\xe7\xda\x89\x5d\xf2\x43\x8f\x5b\x78\xb9\x68\x1d\x9c\xbc\xb0\x5b\xf0\x07\x7f\x97\xf8\x6d\x50\x7a\xb3\x31\xeb\x18\xd4\xcd\xcd\x8f\x6a\xdd\xb9\xde\xa8\x9f\x3e\xbc\x0e\xb0\x0d\x76\xcd\xc1\x79\x24\x79\x6e\x7e\x54\xef\x9d\x8f\x29\xad\x18\x68\x80\xe8\x87\xfd\xca\x78\x75\xdc\xd9\xf5\x8e\x86\x1d\x4a\xe4\x13\x79\x08\xb6\xdf\x5e\xab\xce\x40\x0f\x6c\xa4\x05\x00\x7d\x90\x55\x07\xe0\x1b\xa3\xe3\xe0\x0d\x12\x35\xcd\x6a\xb0\x5d\xb4\x7d\x03\x15\x32\x1e\x3c\x16\xd4\x77\x94\x81\x25\x6e\x30\xe3\x0c\x7c\x73\x70\x07\x22\xce\x70\x57\xad\x8a\x72\x8c\x10\xb6\x3c\x4c\x60\xba\x55\x02\x37\x09\x16\xdc\x00\xd7\xc6\xc6\xbb\xbd\x0a\xa7\x10\xcd\x1e\x0b\xb6\xda\xec\x5d\xbf\x5c\xec\x62\x3c\xc8\xd8\xfc\xf8\xf1\xe3\x7b\x1a\x9c\x94\x7a\x69\x74\x74\xb1\x76\x71\x95\x74\x36\x44\xd3\x2b\x40\x0b\xcb\x78\xf0\xdd\x68\x85\xff\xf4\xe1\xb5\xe4\x9c\x99\x39\x68\xc2\x13\xf8\x73\x93\x27\x10\x57\x42\x70\x7b\x73\xc4\xf5\x6e\x7b\x85\xc4\xdc\x72\xd1\xb9\x6d\xe3\x9d\x8b\xb2\xdc\x5f\xbb\x2d\x2d\xf1\x2a\x23\xd7\xf4\x42\x16\xad\x8a\x4e\x1d\xbd\x8d\x46\x75\x6e\x8b\x07\x1e\x8c\xd7\x72\x61\x7a\x3c\x5a\xd6\xae\x0f\xae\x4b\xd4\xc0\xf7\x98\xaa\x9e\x53\x2a\x1d\xa2\x33\x90\x69\x96\x5e\xc1\xc9\xd2\x5a\xec\x31\xde\xdb\x1d\xd1\x0e\xd7\x4a\x77\xc1\xa9\x83\xb7\x7d\x84\x8a\x71\x8e\x18\xc3\x72\xb1\x70\x07\x28\x51\x9c\x21\xef\x38\x21\x1f\x1c\xd8\xef\x94\x8f\xa4\x2c\xae\x1c\xbb\x2e\x2e\xa7\xb0\x8f\x87\x86\x6f\xa2\x9b\x37\x1f\xdf\xd3\x75\x84\xa9\xb8\x08\x9e\xaa\x97\xde\xed\x73\x42\x1e\x9f\x37\x80\x0f\x61\x74\xdb\x7a\x13\xc2\xb5\xfa\xf0\xf2\xb9\xfa\x97\xdf\xff\xee\x77\x4b\xf5\x2a\xc2\xb1\xa7\x56\x46\xfd\x07\xec\x60\xcd\xb3\x90\x41\x9d\x57\x71\x67\xd4\x23\x38\xc6\x1e\xa9\x6f\x30\xf7\xff\x67\x3e\xe9\xfd\xa1\x33\xcb\xb5\xdb\x7f\x0b\xab\x74\xaf\xe3\x12\x68\xa8\xce\x78\x39\x34\x6e\x4c\xdf\x1a\xcf\x84\x39\x67\x15\x47\x2f\x67\x17\x64\x3a\xbd\x46\x60\xec\x37\xd6\xef\xf3\x04\xc9\x3b\x45\x3d\xa7\x1c\xa1\x72\x6d\xd7\xf4\x2e\xda\xcd\x29\x83\x62\x4f\xdf\x42\x22\x2f\xcd\x05\xef\x34\xbe\xae\xd2\x18\xd3\xbe\xc4\x15\xf8\x2e\xee\x8c\x97\xe1\x0e\x79\xbc\xdd\x66\xd3\xd9\x7e\xbc\x5a\xde\x51\x2a\xad\x96\x12\x24\x2d\x93\x17\x7c\x60\x3c\x7f\xf1\x56\x99\x3b\xd3\x2b\xb8\x61\xbc\x6b\x87\x35\xae\x1c\x59\x31\x9d\xf2\x26\xb8\xc1\xaf\x0d\x2f\xd4\x74\x20\x43\xd3\xe0\xd4\x5f\xeb\xae\x3b\x2d\x17\x72\x31\x6e\xbd\xbe\xd3\x51\xfb\xa2\x8a\x1f\x24\x89\x5b\x3f\x81\x9d\x34\x2a\x95\x80\x9e\xaf\x87\x10\xe1\xf4\xc0\x56\x04\x6a\x14\x65\x13\x61\x3b\x1c\x3a\xa7\x5b\xd3\x02\xd1\x0b\x93\x1a\x94\xf3\xaa\x35\x1b\x3d\x74\x71\xb9\xd8\x98\xd6\x78\x1d\x4d\xdb\x70\x5d\x9d\x73\xb7\xc3\x21\x0f\xd5\x4b\x01\x50\xcf\x18\xe9\x6b\x84\x38\x57\x32\x35\x96\xcb\x27\xb0\xd4\x28\xae\x21\x3a\x68\x4e\x91\xef\x0e\xa6\xe7\x6e\x08\x61\xa2\x80\xee\x68\x95\xeb\x55\x67\x57\xdc\xe9\x3c\x96\x23\x22\x43\x46\xe7\x06\x5e\xeb\x65\xde\x6c\x81\xc9\xa0\xe2\x82\x0f\xe3\xb2\xd7\x0a\x5f\x1a\x44\x8c\xc0\x16\xa3\x07\xb2\xd0\x25\x21\x1f\x4b\xe9\x39\x2a\x27\x12\x25\x8c\xf2\x53\xb5\x1f\x88\xec\x55\x77\xba\xb3\x2d\x60\x14\x04\x70\x5b\xcc\xb7\x65\xb9\x60\x5a\xb9\x61\xbe\x41\x73\x67\xcd\x31\xd7\x28\x28\x99\x97\xa0\xa2\x53\x7f\x04\x00\x60\x00\x84\xd9\xb2\xa9\x35\xef\xa0\x93\x21\xbd\xd3\x69\x9d\x40\x77\xb1\x06\xa0\xdf\xc3\xb5\xba\xb3\x48\x06\xf0\x22\xc7\x71\x59\x19\x85\x55\x47\xa7\x82\x31\x88\x41\xd9\xfe\xc9\x70\xa0\x32\x4b\x7e\xa4\xf2\xbb\x51\xe8\x7e\x20\x07\x5b\xd7\x7f\x11\x55\x6f\x88\x6c\x91\x51\x1d\x91\x7d\xf9\x09\xb5\x64\xea\xd7\x87\x48\xa3\x83\x6f\x0b\xc3\x2d\x8d\xd8\x08\xd9\x7b\x7a\x88\x0e\xce\x17\xdc\x7a\x6a\xeb\x75\x8f\xcb\x4f\x10\x9b\x90\xda\x95\x08\x42\xcc\x9b\xbc\x91\x09\x68\xcc\xac\x98\xd0\x9f\xe9\xf4\xe3\x43\xaf\xcc\xe3\xd3\x2e\xc3\x50\x69\x61\x78\x50\xc5\x74\xba\xf2\x03\xb0\xd9\xba\x6d\x28\x1e\x7c\x40\x61\x2d\xa2\x09\xb1\x81\x97\xe9\x46\xdb\xce\x00\xe2\x97\xf4\x23\x3a\x05\x79\xea\x8b\xad\x8d\x5f\xa8\xb5\xdb\xef\x75\xdf\x7e\xad\xae\xee\xf8\xf5\xf0\x7b\x38\x5d\x9b\xf2\x0d\x8b\x0f\x77\x6f\xe8\x91\x70\x67\x7c\x80\xdd\xd3\x3a\x13\x54\xef\xa2\x0a\xc3\x01\xe9\x8d\xf4\xf2\xe2\x07\x62\xeb\x8e\x3d\x9c\x23\x38\xe8\x6e\xb3\xb1\x6b\xab\x3b\xb5\xb2\xbd\xf6\xa7\x84\x05\x6f\xa7\xab\x70\xad\xde\xbe\xfb\x88\x80\x5b\x07\xe4\x50\x2b\x00\xcb\x85\xed\x71\xbd\xc3\x2b\x83\xd7\x44\xf9\xc4\x92\x24\x4b\x6d\x59\x3b\xef\xcd\x3a\x62\x6f\xa4\xe0\x19\x02\xda\x3b\x17\xe9\x7d\x62\x83\x62\x58\x2c\x97\x68\x5d\x18\x86\xbd\x8e\xeb\x1d\x53\xc2\xb4\x88\x02\x2c\x42\x68\xe9\x7a\xf0\xde\xf4\xb4\xb6\xbe\x56\x57\x41\x3d\xfe\x56\x5d\x15\xd7\x75\xb3\xb7\x01\x88\xcb\x44\xa9\xca\xdd\xad\x30\x81\x73\xab\xfb\x39\xf7\xb6\xbc\xde\xb1\x20\xdc\xf1\x6a\x63\x4d\xd7\x8e\xdb\x0b\x84\x3c\x5d\x9e\xdb\xb9\xb9\x86\x6c\x45\xd9\x03\x1d\x0a\x3c\x3a\xf3\x4b\x03\xd2\xad\xee\xec\xdf\x4c\x49\x0f\x56\x03\x5a\x6d\xd0\xb4\x22\x65\xff\x15\x33\x52\xb6\x52\x96\x6a\x18\xe8\x95\x00\x3c\xc7\x6e\xed\xf6\xe6\x57\xea\x4f\x06\x58\x0e\xdb\x0e\x97\x8a\x8e\xcc\x17\x70\xc1\xe0\x42\xbe\xa6\xc7\xc5\x66\xe8\xf1\xee\x8a\xfa\xd6\x20\x2b\x21\x8f\xd5\x1c\xd9\x78\x76\x76\x17\x3f\x03\x07\xf6\x97\xc5\x40\x8f\x32\xd7\xb5\xe9\x59\x0f\x29\xca\x79\xa2\x83\xd2\x1b\x3f\xc3\xa4\x0d\x19\x8e\x36\xae\x77\x4d\x62\xdf\xc2\xe8\x47\xf3\x09\x27\x19\xb3\x32\x37\x57\x3d\xa7\xac\xc5\xfe\x84\x0b\x11\x3a\xfe\xe6\x94\xd7\xa1\x35\x61\x11\x76\xee\x88\xdc\xd1\x04\x71\xb3\x73\x47\xe4\x8b\x56\x4f\x37\xe0\xaa\xae\x5d\xd7\xe9\x95\x83\x89\xbc\xcb\xf0\xcf\xcb\xd4\x1a\xf9\xfe\x04\x0c\x41\xae\xb6\xe6\x06\xee\x4f\xcc\x80\xe4\x5c\x62\x40\x86\x05\x1e\xf3\xcc\xa7\xc6\xdb\xe0\x2a\x2c\x98\xef\xb6\xb4\x7d\x83\x6c\x3d\xa9\xf9\x55\x4f\x8f\xaa\xb2\x9d\x8b\xc5\xad\x31\x87\x06\xf9\x7b\x86\xb6\x52\x9e\x75\x39\x31\xaf\x82\xda\xe9\xa0\x56\xc6\xf4\xea\xd6\x1c\xa2\x22\xf0\x6b\x58\x02\xfd\x2d\x20\xfd\x55\x85\x06\x77\x71\x46\x53\x6c\xe4\x87\x60\x5a\xfc\xcc\x5c\xf5\x5f\x16\xd2\xf2\x6a\x94\xe8\x4e\xa0\x65\x10\x2a\xe6\x6f\x18\x71\x7f\xc3\x22\x18\xed\xf1\x4c\xb8\xc1\x1f\x0b\x6f\xe0\x0c\x35\x7d\x6b\xda\x26\x23\x4f\x69\x78\x3b\xff\xd9\x0d\x8b\xc5\xcf\x7a\x88\xbb\x5f\x0a\x7e\x79\x23\x7b\x46\xf8\xe6\xc8\xd3\xe5\x11\xca\x84\xf1\xce\x1c\x3a\xe3\x9b\x7d\xc0\xcd\xd6\x79\xa3\xdb\x13\xbf\xb8\xd3\xb6\xfb\x03\x5d\xe1\xb6\x87\x9b\xef\x57\x8b\xe0\xe0\xb0\x6d\x3e\x13\xc5\x77\xb6\x6f\xa9\x7c\x4d\xfe\x10\x23\x7f\x7f\xc0\x05\xee\xbc\x3f\x5d\xd7\xbc\x98\x34\xfc\x5c\xac\x5d\x0a\xa7\x0b\x36\x86\x5e\xd3\x79\x99\xd9\xa7\x54\xd2\x4d\xe8\x32\x68\x21\x5d\x72\x5c\x0b\xdd\x79\x41\x48\x74\xed\xcd\xe7\x57\x01\x83\xde\x30\x8d\xf8\x54\x3d\x1b\xe2\xce\xf4\x51\x1e\xb0\x37\x98\xbe\x40\x9a\x1b\x4f\x8e\xb5\xee\x16\xde\xec\x0d\x3c\x8b\x9b\x3d\xf1\x8e\xe9\x4b\xbd\x31\x8b\x8d\xf3\x5b\x3c\x67\xe8\x20\x78\x0a\x4c\xd5\x2d\xf2\x37\x28\x01\x01\x4c\x2c\x6f\x73\x86\x90\x94\x3f\x88\x68\xa6\xe9\xdd\x11\x99\xf8\xa6\x9d\x4e\xe3\x70\x40\x02\x46\xa8\x03\xa2\x3e\xf1\xe1\x13\x4c\x1f\xf3\x64\x3c\x53\xbd\x39\xaa\x12\x8a\x87\x2c\xcd\x08\xc0\xab\xe8\xd4\x37\xab\x6f\xaf\xc2\x37\x4f\x56\xdf\xa6\xeb\x79\xbd\x33\xeb\x5b\xda\xbc\xb6\x5f\xb9\x4f\xc8\x51\x63\x12\xa9\x87\xc3\xec\xaa\x55\x3b\x37\x78\x7e\xd5\xc2\xab\x2f\x1a\xcc\xad\xe6\xfe\xe0\x1d\x93\x47\xb4\x4f\xf1\x74\xc8\xeb\x1a\xf9\xfa\x3a\x1a\xa2\x21\x64\x69\x1f\xbc\xdb\xd9\x95\x8d\x70\x74\x23\x13\xe8\x35\xfe\x7f\xcf\xc9\xa6\x1d\x41\x14\x54\xa0\x4f\x17\x8d\x0d\xea\x90\x0a\xd0\x35\xda\xb9\xed\x96\xb8\xc8\xf7\x2c\x0f\xa0\x8b\x71\x28\x3b\xbb\xb7\x71\xb2\xba\xe1\x06\xd2\xbc\x4b\x98\x35\x2f\xd3\x84\xdd\xc9\x03\xed\xcd\xda\xf4\xb1\x3b\xa5\xfa\x8e\xda\x46\xf5\x7b\xb5\xb7\xfd\x10\x4d\x80\x6a\xfb\xcc\xc3\x5f\x2e\x76\x3a\x34\x43\xcf\x33\x66\x5a\x59\xef\x3f\x5a\x24\x82\xa0\x5e\xd9\x95\x05\x54\xfd\x32\x57\x5f\xa6\xc9\xfc\x6a\xc9\x3c\x7b\x2c\x05\x84\x09\xb4\xc7\xc2\x33\x52\xcf\x2d\x0b\xe7\x13\xf9\xcc\x80\x4a\xe3\x12\x72\xbd\xc9\x0b\xa3\xb3\xeb\x5b\x1c\xaf\xd5\x10\xa3\xeb\xd5\xca\x74\xee\xc8\x23\x96\x5a\xfc\x1c\xa1\x90\x81\x83\xd8\x20\x8f\x56\xd3\x78\x8c\x16\x58\x0c\x20\xe2\x7c\xe1\x2f\xbd\xf9\x2a\x17\x4f\x7b\x07\x4b\x30\x0a\x2a\x5d\x6c\xab\x0f\x98\x49\xe2\x26\xd9\x7c\x42\x0f\xac\x99\x41\x9e\xe6\xd2\xd7\x63\x81\xf9\xb0\x43\xcc\xa7\x83\xf5\xa6\xc5\x61\x71\x91\xde\x55\xcb\x51\x5d\x99\x9b\x32\xed\x71\xac\x5b\x9c\x49\x86\xe8\x5c\x13\x76\x44\xf6\x49\xf3\x54\x67\xfa\x6d\xdc\x11\xbf\x74\x65\x94\x8e\x0a\xc6\x3b\xaa\xff\x89\x8c\x7e\xbd\x8e\xc6\x07\xe0\x8d\xf7\x0d\x1e\x47\xc5\x26\x7a\xeb\xfa\xc7\x98\x96\xde\x90\xc2\xb1\x66\xf1\x89\x54\x0c\xeb\xcd\xbb\x61\xbb\x63\x26\xeb\x82\x76\x4f\x3c\xba\x66\xa3\xd7\x11\x45\x8d\x1f\x8f\xee\x31\x7f\xd4\x87\xe1\x04\x18\xc7\x80\x07\xb3\x06\x55\xef\x39\x67\x5a\xc6\xf4\xd1\xf8\xc6\x9b\xb5\xbb\x33\xfe\x24\x73\xf1\x3d\xa4\x2a\xad\x62\xae\x5c\x40\xd4\x3c\x9e\x94\x5d\xb5\xf8\x03\xa7\x9e\x87\x97\x1a\x05\x52\x3d\xbf\xd0\xcc\xa2\x83\x33\x2d\x3c\x9c\xed\x64\x7e\x5a\x9c\xa9\x14\xbf\xe5\x04\x19\x02\xad\x31\x2e\x05\x22\x3c\x58\xd4\xbf\x2c\x78\xa7\x98\x62\xaa\xf9\x14\x91\x1c\xd9\x51\x98\x9d\xe1\xe5\x2d\xf8\x47\xe3\x81\x0d\x86\x40\xd5\x19\x71\x6e\xc3\xd4\xeb\x35\xdd\xba\x25\x5d\x55\x9c\xed\x9c\xbc\x19\xba\x6b\x75\x24\x6a\x3d\x97\x49\x2c\x38\xa6\xe3\x15\x9c\x14\xa8\xc0\xb0\xf8\x79\xef\x5a\xdd\xfd\xb2\x38\xa1\xa0\xf6\xcf\x26\x2c\x7a\x14\x8e\xbb\xc5\xde\xb5\x54\xe8\x0d\xfe\x58\x2c\x7e\x06\x1e\xe2\x2f\x0b\xa0\xbb\xde\x8e\x1e\xcd\x40\xa0\x71\x5a\x41\xed\x61\xd6\xf7\xa5\xf0\x3f\xf5\xf9\xfd\xcc\xfb\xfa\x83\xc9\x3a\x00\xf8\x2b\x75\xfe\xe6\xe6\xc7\x8f\xc2\x14\xbc\xf9\x51\xdd\x1a\xc6\xfd\x63\x8c\x87\xf0\x13\xb2\xba\x89\x6f\x0d\x4c\xee\xf7\xfa\x04\x4f\x59\x4a\xe6\x0f\xcc\xf8\x68\xf4\x9e\x1b\x09\x3f\x09\x05\x6c\x16\x4e\x84\x9f\xce\x97\x42\x9e\x05\x3e\x97\xbe\xaf\x5e\xf3\x74\xc8\x2d\xde\x9a\xe3\x77\x5e\xf7\x6b\x29\x0c\xd4\xe0\x0a\x13\xa8\xe4\x73\xb7\xdf\xdb\x78\x33\xec\xf7\x1a\x37\x06\x7d\xab\x40\x09\x9c\xfd\xc6\x84\x40\x1a\x1a\x9c\xbd\xa7\x04\xce\x7e\xbe\x73\x76\x5d\xe4\xae\xf1\x7b\xf1\xd1\x1b\xc3\xb5\xbe\x14\x79\xe1\x02\xdf\x2e\x44\x9e\xd2\xaf\x45\x62\x09\x19\x56\x5c\xf8\xcb\x44\x76\xf6\x97\x85\xee\x0e\x3b\x8d\xaf\xa3\x02\x2c\x1d\x7b\x90\xd9\x0f\x7b\xe3\xed\x1a\xd9\x8a\x3a\xec\xbe\x7c\xdc\x7c\x55\x1e\x82\x15\x8a\xd6\xc5\xcf\x41\x03\xbf\x5d\xbc\x88\x2d\x74\xf7\x37\xed\x1a\x31\x2a\x40\x79\x8d\x08\x9d\x57\x58\xae\xc6\x1c\xec\xdf\x64\x2c\x10\x15\x7c\x27\x7c\x57\x00\x81\x4f\xe5\x0c\x95\xea\x43\xba\xc4\xf6\xf9\x1a\xb8\x0a\x35\xea\xbd\xfe\x74\x5f\xc1\xbd\x9b\x29\x47\x32\x85\x5c\x88\x39\x23\x9a\xae\xb7\xfa\x98\x58\xfe\x65\x31\xf8\x0b\xc0\x3f\x7d\x78\xbd\xfc\xcb\xc2\xf6\xeb\x6e\x68\xcf\x36\x24\x0c\xab\x10\x3d\x90\x5d\x5f\x5c\x85\x2f\x00\x65\x7f\xdb\xbb\x63\x9f\xe0\x7f\xa2\x6f\x85\xdf\x5f\x8b\x16\x4e\x63\x7b\xe6\xd6\x64\x7d\x1c\xd5\xda\x16\xa8\x18\xe4\xba\x2c\xf3\x7d\x5a\x72\x62\xd2\x2e\x47\x4e\x36\xf3\xca\x0e\x29\xd1\x1b\xec\x41\xd0\x7b\x90\xc1\x88\xe6\x50\x03\xc4\x70\x03\xbc\x83\xbe\x7c\xec\xef\x74\x48\xa7\x34\x40\x20\x77\x01\x89\xc3\x83\x6b\xa6\xe5\x46\xc7\xd0\xd9\xe2\xce\x6f\x67\x4a\xbf\x9b\x8a\x7b\xcf\x94\x8f\x46\xef\x67\x10\xa4\x03\xe6\x6c\x41\x9a\x7b\x2c\x84\x97\xce\xe8\x84\x9c\x96\x03\xa8\x65\x1e\xa5\x34\xe0\xe5\xdc\x94\xac\x11\x01\x18\xf1\xdb\xaa\x57\x16\xf0\xbd\x64\xb2\x80\x03\xab\x6b\xd2\x21\xb1\xeb\x3b\xb3\x8e\x26\x61\xd2\x01\xdf\xac\x90\x82\xca\x10\xc2\xa9\x05\x6e\x79\x34\xde\xa3\x72\x58\xc1\xd0\x63\x16\x2b\xdf\x97\x7b\x7d\x6b\x54\x18\xbc\x21\x0e\x12\xbd\x52\xea\xc9\x02\x2a\x19\x51\x51\x9d\xa9\xe5\x13\xf4\xee\xd8\xc3\xf5\x76\x1f\x7e\x04\xfb\x4c\xd4\x25\x07\x78\x8a\x98\x91\x27\xa0\x73\x68\x13\x73\xd2\x7c\xb2\x28\x15\xfc\xc1\xde\x19\x66\x4f\x26\xae\x2c\xe6\x2d\x17\x9d\x0e\x11\x18\x40\xd4\x2b\x7a\xce\xba\x3b\xd8\xac\x50\x1f\xe4\x2a\x0f\xab\x06\xb5\x7d\x10\x03\xf1\x23\x7b\xee\x1f\x2c\xc5\x34\x45\xa4\xef\x74\xad\x74\x40\x80\x72\x3d\xe3\x89\xa0\xbb\xa3\x3e\x05\x7e\xc1\xc8\xb9\xe6\x7a\x1e\xab\xe5\x22\x73\x37\xc3\xae\x81\x0b\x37\x11\xe9\x77\xc6\x27\xd1\x9d\x72\x9b\x2c\xa8\x07\x28\xe2\x52\x02\x8b\x15\xb8\x76\xc0\x2e\x40\xf0\x53\x81\x06\xd5\x82\xf8\x26\xba\x2b\x88\x22\x46\x71\x0d\x4f\x19\x65\xe3\x17\x41\xe9\x10\x86\x3d\x3d\x81\x56\x2c\x4a\x49\x6f\xb7\xd6\x0d\xab\xce\x3c\xa6\x97\xb1\x95\x55\x9d\x98\xa4\x23\x1a\x38\x35\xeb\x6e\xb1\x08\xd1\x76\x1d\x8c\xb1\x28\x02\x56\x2f\x55\xcc\xc5\xcd\x87\x03\x11\x76\xf6\xa0\x1c\x8a\x21\xcb\x41\xca\x0b\xb6\x78\x08\x46\xa7\x5a\x83\x2f\x6f\xe7\x55\xf4\xba\x0f\x1b\x83\x72\xd9\x3d\x49\x36\x96\x5c\x35\xbc\x2b\x49\xf1\xef\x4c\xcd\xc4\xc4\xc0\xaa\x6d\x5f\x57\x5c\x4e\x64\x5d\x35\x69\x45\x38\x2f\x6d\xc0\x31\xcd\x98\x82\xb4\x01\x16\xd8\x64\x08\x50\x0f\xa0\xc4\x3d\x3f\x0e\x9b\x8a\x77\x48\xf5\xe3\x6a\xba\xa7\xdf\x0b\x52\x3c\x6b\x88\x40\xaa\xf6\xc3\x47\xcc\x11\xd2\x69\xbc\x25\x16\x3f\xc3\x3a\xff\x65\x41\x6f\xa7\x26\x09\x57\x49\xdd\x8f\xfa\x48\x89\x8b\xff\x70\xb6\x6f\x50\x52\xf8\xaf\xce\xf6\x28\x56\x5c\x94\xad\x1d\xb3\x11\x59\xa5\xf1\x84\x5a\x3e\xab\xce\xae\x45\xaf\xf1\xb4\xd8\x38\xdc\x3d\xc8\x65\x7c\x29\xbf\x17\x21\x6a\xef\x4d\xcb\xaa\x20\xf0\xab\x44\xcf\x85\x88\xcb\xfe\x52\x7e\x73\x6a\x4a\x5a\x0c\x7d\x4a\xf9\x89\x7f\x2e\x80\x13\xb5\x5f\xe2\xa1\xee\x0d\x4b\x96\x8b\xa3\x1c\x6e\x6a\x65\x83\x92\xbc\x65\x01\x7f\xd0\x31\x1a\xdf\xe3\x88\xf2\x96\x2f\x8b\x72\x76\x42\x51\x9c\x0c\x30\xb6\xa2\xef\xf9\xcb\x22\x6b\x85\x8a\x42\xe8\x9c\x00\x2c\x0d\x3f\xc9\x8a\x17\xbc\xa7\x03\x93\xe5\xff\x66\x4e\x61\x11\xcc\x7a\xf0\x34\xac\x37\xfc\x73\x9e\x8d\xcb\x9c\xee\x91\xd2\x6b\x16\x63\x84\x5a\x7f\x25\x2c\x78\x8d\x3d\x55\x2f\xe8\x87\x30\xa8\x16\x07\x9c\xbe\x42\xb3\x95\xe7\x33\x75\x85\xfe\x57\x8c\xa9\x9a\x4b\x63\x83\x22\x24\x48\xa8\x88\xa0\x71\x08\xcc\x11\xd6\xfd\x29\x8b\x2c\x4d\x87\x17\x5f\x5f\x28\x30\x80\x58\xbe\x6f\x11\xec\x68\x56\x22\xd5\xce\xea\x40\xa8\x94\x7a\x67\x75\x62\x6c\x15\xe4\x52\xba\xcf\x85\x59\x5a\xf1\x10\xf0\x19\x04\x20\x21\x51\x4b\x32\xcd\xd1\x09\x47\x21\xee\x8c\xf5\x4a\x10\x2d\x17\xa0\xb8\x29\x77\xe2\xcb\xa1\xeb\x48\xb9\x6d\xaa\x40\x0e\x55\xb0\x70\xfd\x35\xff\x5c\x0c\x87\x56\x47\x53\x8c\xe5\x4f\x98\x90\xc6\xb2\xce\x2f\x1e\xa3\x38\xaa\x52\x2c\xb1\x34\x09\xbc\x2d\x5e\xa7\xa0\x2d\xc1\xbb\x79\x46\x55\x9c\x37\x76\x3b\x06\xc9\x5c\x3f\x3c\xa9\x28\x97\x26\x8a\xb4\x97\x70\x68\x8f\xfa\xa4\x40\x1a\xd3\xd9\xfe\x36\xf0\x4c\xa9\xe8\xaa\x87\x39\x32\x6a\xa3\xed\x07\xc3\x4f\x25\xf8\x39\x55\x4c\x66\x6d\x07\xd6\x7d\x58\x9d\x84\x1b\x46\xda\x11\xbc\x01\x40\xe7\x02\xd2\x2f\xa8\x59\x8c\xf5\x2b\x18\x41\x52\x1b\x40\xed\x8e\x7c\xae\x81\x6a\xda\x73\x4c\x93\x3d\xb6\xde\x39\x17\x58\x02\x91\x4f\x3f\x48\x43\x66\x20\xa5\xc9\xb4\x64\x3c\xf8\x2d\x75\xb2\xc4\x9b\x77\x50\xc3\xc2\xd0\x0c\xcd\x1b\xea\x39\xa5\x4b\xcd\xa2\x59\x22\x7d\xc2\x33\xa6\xb1\x7b\x7a\xb0\xfe\xc4\xb9\xa4\x62\x95\xde\x22\x98\xbd\x9c\x94\x8d\xce\x35\x9d\xf6\x75\x49\x42\xb5\xd3\xa4\x6a\xbd\x87\xed\x73\xb0\x9f\x4c\x17\xf8\xc2\x67\x76\x35\x52\xbd\x65\xff\xc6\xab\x8e\x52\x93\x30\xf3\x9e\xc5\x27\x4b\xab\x94\xe2\x63\x4a\x3e\xe7\x5c\x57\x91\x7f\x32\x2e\x29\x1f\x26\xa3\xc8\x7f\x8b\x4a\x18\x9c\xe7\x91\x89\xd1\x8c\x40\x98\xb5\x51\x41\xce\x12\xf0\x52\xd7\x59\xe2\x7d\xd4\xfa\xc9\x0e\x94\x72\x47\x1d\xaa\x8e\xf3\x9e\xe1\xa7\x98\x46\xd9\x53\x75\xc8\x15\xfc\xf8\xdc\x34\xae\xed\x1f\x3d\x9b\x04\xdf\x72\x41\xcf\x9e\x90\x5e\x3b\xcf\xe8\x04\x06\xe1\x27\x59\x44\xa4\x7c\x36\x8a\xa8\x0e\x6a\x23\x6a\x78\xe5\x51\x7e\xf0\x16\x79\x2c\x15\xe4\xf4\x10\xaf\x0e\x6c\x1c\x05\x87\x4a\x65\xf9\x9c\x5e\x2e\x04\x15\x5c\x83\xf8\x4b\x52\x12\x17\xef\xc6\x44\xa5\x83\xd4\x29\x3b\x4a\x72\x69\x23\xa5\x36\x76\x86\x8f\x57\xea\xeb\x0b\x4e\x18\xe5\x4b\x67\x28\x1b\xa9\x7d\x1b\xe6\x7a\xe3\xe1\x39\x60\xd2\x0d\x64\x7b\xd2\xe9\x4b\xaa\x19\xd5\x31\xa7\x5e\xe0\xb9\xa7\x8e\x9a\x84\x4a\x72\xea\xfd\x61\x5c\x7b\x5e\x40\xdf\xd7\xe2\x28\xea\x5b\xbd\x7d\x7e\xb5\xd0\x6d\x8b\x8b\x3b\xab\xb8\xb4\x78\x10\xd5\x2c\x4d\x80\x2a\x21\x10\x75\x4e\x6d\x2a\x61\x59\x20\xbe\xd5\xc3\x05\x64\x40\xce\xfc\x37\xc8\xc6\xaa\xaa\xb2\x6c\x2c\x35\x72\xb4\xb5\x26\xbd\x9c\xee\x31\xdd\xb6\x70\x5a\x99\xac\xf7\x68\xd3\x15\x5b\x6a\x3c\xd2\x78\x84\x19\xc0\x06\xef\xae\x06\xb5\xaf\x44\x6f\xcb\xf4\x2d\x5f\x69\xd1\x29\xb6\xa9\x81\xf5\x0b\xc6\x37\x2a\x19\xdf\x78\x8d\xcb\x19\x04\xe9\xbc\xeb\xd6\xcc\x08\xe4\xc3\x73\xb6\x55\x7c\x51\x3c\xb0\x71\xe3\x11\x29\x4b\x89\xfc\x97\x4d\x88\xf0\x59\x72\xe9\x18\xe6\xfd\x5e\xd0\x90\xbc\xe3\x13\x29\x09\x33\x41\x4f\x46\x58\x42\xff\x66\x4e\x48\x70\xf2\x6e\x41\x3a\xc0\x06\xa5\x51\xf3\x19\xcd\x25\xe8\xfd\x18\x26\xec\x89\x7a\x5f\x3c\x43\x41\x5f\x30\x0c\x8b\xc4\xb8\xee\x4f\xae\x37\xa4\x5f\x4e\x0f\x97\xe8\xd4\x56\x27\x85\xb2\x44\x44\xd4\xcf\x1f\x1b\xa1\x05\x3b\xbb\xdd\x75\x27\x65\xf7\x07\xe7\x23\xee\x36\x51\x8c\xc9\x0c\x03\xf8\xf2\x66\xed\xb6\x3d\x30\x1d\xa1\x06\x52\x8c\x4f\x02\xab\x6f\x42\xf4\xae\xdf\x7e\xfb\x02\xf5\xe6\x80\x07\x07\x94\xcc\x1f\xbe\x79\xc2\xe9\xea\x39\x2e\x73\x37\x44\xd0\x35\xff\x71\x58\x7d\x11\xd4\x76\xb0\xad\x41\x81\xb1\x2e\x2c\x79\x58\xd7\x0e\x9b\x0b\x9c\x3c\x19\x16\xb4\xeb\x71\x5e\x05\xd7\xdd\x99\x51\x11\xb7\xdf\xd3\x16\x58\x75\x66\x4f\x90\xd8\x7e\x54\xcf\x33\x3d\x8e\x9c\xf1\x3c\x3e\x37\x37\x3f\x2e\xd3\x31\x90\xe7\x87\xa7\x4d\x1e\x05\x15\x67\x8b\x09\x72\x00\xe6\x45\x59\xdc\xd2\x78\xc1\x4b\x29\x24\xf6\xa6\xa5\x70\x1e\x83\xde\x9b\x29\x4f\x0d\x5f\x8a\x80\x42\x8a\xab\xa7\xd0\x0e\x22\x7a\x21\x6d\x3d\xe1\x8c\xf3\xc2\x2a\x96\x33\x5c\xcc\x3c\x50\xf4\x58\x4a\xcd\xc3\x2d\x3d\x3a\x03\xf9\xd4\xa7\xbe\xf3\x99\x2f\x1d\x28\x4e\x7d\x1e\x91\x7c\xee\x8f\x61\xaa\x93\xdf\xd0\xb9\x2f\xad\x28\x4f\x7c\x52\x44\xa6\x53\x9f\x16\xa4\x09\x78\xa7\x3d\xf0\xc4\x9f\xd4\x9b\x3b\x2e\xd5\x3d\xe0\xd4\xc7\x3e\x3d\xc3\xe1\x70\x3d\x31\xab\x78\xa2\x5e\x6b\x52\xdb\xc4\x8c\xde\x35\xc5\xd3\xfa\xad\x63\xb1\xbb\x92\x44\x9c\x93\x10\x75\x34\xd5\x56\x86\x46\xa0\x89\x07\xde\x6c\xc4\xed\xfa\x7f\xab\x56\x9f\xc2\x22\xba\x5b\xd3\xcf\x14\xc1\xf4\x73\x85\x16\x0f\x14\xa4\x66\x30\xac\x61\x08\xf4\xbe\x8f\x43\xf8\xba\xcc\x23\xcb\xd2\x0a\xdc\x6d\x36\x90\xb6\xd9\x94\x89\x44\xd7\x27\xa5\xdd\x32\x4b\x8c\x54\x92\x4e\x72\x99\x89\x7a\x5c\x95\x88\x32\x88\x46\x17\x5a\x60\xe8\x7a\xcf\xc2\xae\xe5\x03\xa9\x90\x62\xd2\xce\xb5\xbd\xd2\x2a\xe8\x8d\x51\x87\x4e\xaf\xcd\x52\xcc\xb3\x60\x98\xe8\x70\xd3\x21\xc9\x4b\x95\x25\x9d\x84\xce\x05\x33\x3e\xec\x46\xcc\xe0\xe2\x6d\xbe\x2c\x9b\x0e\xf6\x2a\xa4\x3c\x53\x5a\x90\x64\xb2\x8a\x55\x34\x90\x44\x54\x9d\xeb\xb7\xc6\x27\xad\x62\x68\xd2\xa1\xd3\xac\x93\x8c\xbb\x17\x6f\x36\xa1\x17\x93\x66\x88\x28\x10\xb7\x58\x24\x8f\xc4\xcf\xbf\xfd\x25\x5c\xfd\xfc\xbb\x5f\xc2\xa3\x6f\xdf\x1b\x1f\xd0\x64\xe3\x19\x75\xe3\x23\x2c\x0f\x1c\x11\x1d\xa8\x43\x6b\x6f\x5a\xe8\x90\xee\xae\x95\x59\x6e\x97\xea\x1b\x18\x82\x6f\xaf\x7e\xfe\xfd\x2f\xe1\x9b\x27\xf8\x7b\x39\x9d\xcc\x6c\xf3\x81\x9f\x0f\x5c\x4b\x6b\xdd\x37\x7f\x1d\xd9\x11\xde\x33\xaa\x2a\x3a\x05\xe5\x60\x92\xe8\x71\x54\x2f\x41\x91\x84\x07\xb3\xf6\x26\x22\xef\x84\x78\xce\x58\x80\x52\xab\x12\x50\xd1\x54\x7a\xfe\x71\x67\x7a\x2e\x27\xa9\x55\x29\xe6\xc9\x8a\xc4\x7a\x31\x23\x4b\xaf\xb1\x25\x34\x63\x2e\x78\x52\xd4\x98\x5a\xc8\xfe\xaa\x44\xeb\x0d\xec\xe0\x07\x61\x9d\x95\x8a\xd4\xe8\x7b\xa6\xeb\x7b\xf3\xab\x99\xc9\x14\x41\xd7\x74\x32\xf5\x59\x96\xf1\x14\x4b\x3e\x40\xcf\x23\x80\xa6\x12\x78\x3b\x39\xac\x47\xc7\xeb\x39\xdd\x88\x90\xd6\xde\xd9\x45\x57\x2b\x4f\x84\x0b\xa8\xf8\xe8\xac\xf4\x1e\xd8\x86\x24\x00\xa9\x24\xe6\xa3\xd1\x00\x25\xa3\xbd\xed\x4e\x9f\x7b\x2c\xa8\xef\xf5\x7a\x57\x9f\x49\x78\xf2\x88\x31\x01\xdf\x11\x6b\x73\x0d\x4a\x6e\x3c\x69\xa0\x39\xca\x24\x19\x35\x69\x74\x80\x81\xf2\xd4\xb2\xee\x17\x59\x7c\x46\x33\x3d\x31\x3f\xa4\xbc\x8b\x03\x73\x06\x41\x5a\x1d\x05\x9a\xfa\x84\x3d\xb3\x2c\xce\x63\xac\x69\x8c\x11\xb2\x74\xeb\x4a\xe9\xf6\xfc\xc2\x10\xf5\xcb\x64\x19\x4d\xdf\x0f\x3b\x8e\xa4\xf0\x9c\x6e\x5e\xe2\xd8\x76\xe6\xce\x74\x44\x78\xb4\x66\xed\x71\x72\xf4\x26\x1a\x9f\x14\x39\x4b\x8d\x9b\x7a\x19\x5c\xa0\x3e\x66\x9a\xf1\xd0\xed\x93\xea\xad\x47\x45\xde\x0e\xb4\x30\x1b\xa2\x03\xd2\xfb\x61\xf6\x1e\x08\x8b\x34\x41\x40\xb6\x4a\x91\x1f\x38\x11\x27\x07\x01\x89\xda\x48\xbb\x85\x0a\x67\x41\x4b\x9e\x28\xa4\xf2\xd9\x2a\x0f\xd7\x75\x74\x69\xa7\xec\x48\x1d\x5e\x3d\x7b\xff\x0a\xd4\xc4\xa4\x42\x41\x8a\xbb\x04\x53\x68\xb4\x59\x69\xbe\xeb\x26\x5b\x4d\x78\x96\x54\x9c\xa9\x5b\x6c\x13\xd1\xb7\xa9\x53\x93\x0e\x51\x67\xea\x7c\x1a\x77\x13\x8a\x15\x40\xb5\x61\x4b\xc6\x8f\xd9\xd4\xd5\x5f\xa9\x37\x59\xf2\x09\x33\x7b\x38\x29\x5b\xf9\x3f\xa0\x0b\x56\x1d\xf1\xf1\x32\x32\x1a\xb2\xb1\xf4\x89\x90\x88\x67\x69\x30\x93\xcf\xe5\x54\x96\x34\xf4\xec\x64\x66\x8a\x7a\xb6\xd8\x1c\x59\x7d\x10\x3c\x75\x9f\xef\x23\xb2\xdd\xa6\x3e\xdf\xce\x2e\xf2\xb2\x57\xc5\xf2\x7e\x3f\x5b\x6d\xda\xf6\x54\xf5\x68\x79\x2b\x7a\x03\x92\x7a\x32\x12\x49\xf4\x46\xa7\x15\x91\x5b\xa3\x74\x50\x47\xd3\x75\xe5\xea\x20\xb1\x5a\x48\x8b\x64\xf4\x6e\xaa\xde\x4c\xa0\x73\xb8\x31\xa6\x4d\x53\xf1\xd2\x98\x96\xd7\x4d\x4e\x4f\xfe\x72\x0e\x07\xd3\xb7\x34\x98\x54\x20\x3a\x05\x60\x68\xe6\x5d\xd1\x53\x7f\xc0\xfc\xa7\xcb\xe5\x92\x89\xaa\x6b\x80\x05\xd5\x93\xb5\xb7\x2b\x23\x05\x71\x74\x0f\x9e\x74\xe7\x2a\x49\x5d\xda\x6d\x69\xd4\xd0\x04\x16\x4d\xbd\x90\xaa\x40\xf7\x13\x20\x26\x86\xee\xc0\x6f\xc4\xb7\x2c\x9b\xdd\xbb\x9e\xcd\xa1\x08\x55\xcf\xad\xc5\xdc\x0a\x32\x0d\x59\x82\xd5\x25\x28\xd4\x6c\x03\x5d\x61\x60\xfd\xd1\xe3\x05\x46\xd7\x65\xda\x22\xa8\x64\xcf\x1f\xca\x46\xa1\xaa\x11\x5b\xe7\x42\x44\x09\x75\x06\x69\xaa\x71\xcf\x97\xcd\xc2\x9b\x3b\x77\x3b\xc9\x86\xb4\xb2\xc5\x05\xa2\xbc\xd4\x5e\xa6\x26\x97\xeb\xeb\xcc\xc5\xa2\x9e\xf3\x26\xc6\xed\x6b\x23\xed\x04\xd6\x0d\xa3\x5e\x92\x12\xb4\x7a\x29\x53\x9c\x55\x3d\x5c\xd7\x96\xfb\x27\x53\xf5\x47\xe7\x6f\x97\x75\x43\xb1\x3b\xf7\x35\x12\x80\x26\x67\x3c\xc8\x07\x97\xc5\x2c\x26\x1e\x33\x0b\xb5\x71\x6f\xf6\xa7\x4a\x6a\x1d\x96\x54\x0c\x65\xe1\xe9\xa6\x7c\xcd\x92\xf1\x0c\x57\x42\xe5\x2b\x91\xba\x33\x22\x79\xe8\x58\x28\x16\x27\x1a\x36\x19\xbd\x0f\x7c\x37\xe2\xeb\xc9\x6c\x58\xd1\xa4\xa8\xe4\xc2\x69\x41\x12\x51\x6a\x80\x34\xb0\x4c\x1b\x35\x3d\x6b\x1b\x54\x40\xf7\xb4\x7c\xa4\x58\x53\xb7\xf6\x42\xe3\xca\x2a\x2a\x86\x1f\x2f\x67\xe8\x6b\x81\x17\xd9\x25\xa3\xb9\xe3\xd3\x30\xab\xca\xf2\x51\x5c\x19\x16\x30\x50\x21\xd9\x33\xf9\xd5\x28\x64\x48\x56\x65\x10\x64\x07\xe3\xf7\xba\x47\x45\x7e\x12\xbb\x0a\xeb\xec\xf9\xb3\xb7\x6f\xdf\x7d\xcc\x1c\x33\xb8\x97\xfb\x16\x9f\x01\x62\xb9\x39\x69\x97\xd8\x6f\xa6\x0b\xa5\x86\xc8\x16\xa4\x5c\xe2\x1c\x5c\xc9\x96\x28\x6c\x1e\xb6\x0e\x19\x8a\x28\xcd\x12\xc6\x4a\xd5\xfe\xf6\xec\x0a\xf9\x19\x86\xf8\x97\x85\xa8\x02\xbd\x83\xff\x8b\x52\x9b\xaa\x50\x70\x43\x52\x20\xe5\x15\xae\x45\xd4\xd6\xb9\x76\xa2\x5d\x85\x1c\x93\x01\xad\x67\x81\x1f\xee\x90\x28\xdf\x28\x54\x82\xbf\x86\xdd\xe5\x3c\x5e\xe0\xf8\xda\xee\xed\x5f\x07\xe4\x95\xa2\xce\xfa\x72\x71\x67\x83\x5d\xd9\x8e\xb8\x3b\x7f\x4c\x1f\x94\x0e\xbf\x46\xce\x25\x8a\xca\x6d\x50\xdf\x84\x83\xee\xd5\xba\xd3\x21\x3c\x7d\x34\x58\xe5\x4d\xab\xc0\xe4\xee\xd1\xb7\xef\xe9\x22\xf8\xe6\x09\x40\x7c\x3b\x41\xd7\x6c\x9c\x5f\x93\xf2\x45\x32\x0c\xc1\x23\x84\xd3\x61\x9b\xf6\xe6\x98\xab\xb3\x46\xc4\x88\x7f\x47\x9d\xe0\xd3\x2b\xf7\xe3\x4b\x96\x0f\xba\x0d\x5d\x7f\x77\xba\x1b\x6a\xe1\x33\xd4\x0e\x65\xc2\x57\xc5\xf8\x34\x61\xbd\x33\xed\xd0\x99\x86\x75\x0b\x66\x47\x44\x80\x58\xcb\x09\xb5\xb3\x19\x5e\x47\x50\x4b\x9d\xc7\x48\x2d\xff\x0c\x94\x5c\x80\x71\xa2\x7f\x8f\xdc\x43\xb4\x6c\x82\x2f\x74\xfc\x61\xfb\xed\x1f\x70\x6a\xe3\x65\x9f\x51\xe0\xe3\x0e\xf8\x2b\xbf\x5a\xe0\x78\xb1\x2a\xd1\xd8\x09\x1a\xe6\x89\xf3\x0b\xc8\x43\x0f\x18\x98\x3a\xb3\x66\x0a\x57\x42\xba\x13\xd6\x46\xb1\xe6\xe0\xd0\xc7\xa1\x2e\xd5\x6f\x4e\xac\x05\x9a\xe8\xbe\xb0\xf6\x16\x1d\x78\x50\x3a\x78\xc2\x2b\xbd\xe0\x61\xe2\xd6\x46\xbb\xed\x9d\x2f\x86\xe1\x06\xf5\x1c\xd5\x32\x65\x29\xf1\xab\x17\x16\x9d\x5d\x9b\x3e\xe0\x99\x4c\xbf\x24\x65\x52\x5c\x2b\x81\x45\x8d\x09\x6f\x74\xbb\x17\xc7\x66\x7b\xf9\x9e\x29\xc5\x80\x52\x25\x28\xb4\xb9\xc6\xf6\x36\xa2\xe9\x66\xb2\xf4\x8d\xa3\x09\x27\x12\x4f\x34\x34\xa1\x4a\xb9\xa3\x18\x0f\xdb\x30\xf2\xf4\xb0\xf1\x62\x31\x41\xec\x2c\x82\x95\xb3\x70\xfc\x30\x41\x91\x7e\x3b\xbb\xd0\x6b\x0e\x7e\xe8\x49\x41\x68\xe8\x4d\x95\x98\x39\x0b\x44\x48\xf7\x27\x76\x66\xf4\x38\x7a\xbd\xbe\x85\x23\xd0\x9b\x8d\xf1\xa6\x5f\xa3\x95\x95\x8e\x05\xcd\x40\x7a\x60\xae\xe7\xeb\x0a\x8a\x09\x72\xdb\x47\xe3\xef\xd0\xd8\x8f\xcc\x5d\xd5\x2b\x49\xf9\x12\x24\x7a\x5f\x09\xa0\xc8\xe3\x12\x1c\x4b\x95\x47\xf9\xd2\xce\xe7\x89\x0d\x88\x24\x28\xf0\x2b\xbf\xbc\xf9\x0a\x07\x0d\xd8\xe1\x48\xec\x68\x6f\x54\x30\x11\x34\x3c\x22\x7a\x26\x30\x5d\x1b\xc8\xba\x0a\x01\x7b\x73\x67\x3c\x51\x4a\x4b\xa9\x66\xce\xf5\xe1\x28\xab\x41\x8e\x46\xb2\x52\xa6\xeb\x3d\xb9\x31\x12\x4e\x48\x65\xae\x5e\xa2\x99\x53\xca\x1a\x65\x7d\x66\x0d\x87\x11\x9a\x75\x67\xb4\x6f\xd6\xc5\x08\xa5\x79\x85\x62\x21\x3a\x6f\xda\x79\x66\xad\xa0\x40\xc9\x40\x38\xf5\xeb\x2c\x1b\xb8\xc1\xaf\xc5\x51\xc7\xf5\x8e\xd4\xea\xfe\xc4\x3f\x51\xab\x6e\xab\xff\x46\xa9\x37\xe9\x03\x0f\x88\xc0\x47\x46\xc8\xdb\x9b\xf7\x75\xe1\x57\x28\x27\x56\xfa\x89\xa7\xa5\x7a\xa3\x3f\xd9\xfd\xb0\x57\xff\xf2\xdb\xdf\x15\x6a\xf7\x6c\xdb\xb5\x9c\xe2\xa4\x0c\x52\x6f\x63\x7f\x0a\xb9\x18\x6b\xe9\x79\xa3\xd7\x3b\xb6\x44\x74\x9b\x06\xf7\x16\xbd\x54\x3f\x26\x3d\x63\xb8\x96\x10\xce\xb4\x6a\xcf\x6d\x48\x80\x58\x14\x5a\x7a\x55\xeb\x0f\xb2\x56\x9f\xc0\xc1\x15\xb4\xb2\x6d\x6b\xfa\xac\x11\x1c\xea\xdb\x8f\x3c\x97\x80\xfc\x52\x74\x34\x01\x6d\x6d\x0f\x9d\x7d\x6f\x94\x75\xb1\x77\x0d\xeb\xc7\xc4\xf3\xb9\x26\x60\x85\x17\xaa\x2f\xbd\x2d\xce\x57\xb9\x9c\x57\x72\x1c\x6b\xe9\x7f\xbe\xae\xe3\x18\xc3\x65\x95\xc7\xde\x98\xb6\x01\x46\x93\x5c\x7a\x95\xcd\xcf\x82\xfd\x7f\x8a\x83\xc1\xe4\x00\x94\x3c\x0c\x96\xb9\xe7\xa9\x9c\xe4\xa6\xa4\x26\x3c\x80\xe2\x50\xab\x6e\x30\x8f\xbe\xa5\x7d\x22\x54\x87\x60\xe5\xf3\x99\xea\xac\x0e\x68\x86\x58\xd2\xa5\x9d\x0f\xbb\xe7\xf0\x5d\x9c\x75\x33\x50\x15\x61\xca\xcc\x2a\x5d\x88\x69\x9e\xfc\xf0\xea\x23\x5a\x8e\x5c\x28\xde\x90\x64\xbb\x11\xc3\xeb\x3f\x93\xdb\x49\xdd\x05\x57\x2a\xfc\x30\x02\xbc\xc8\xd2\x60\xac\x4e\xe4\x23\x49\x7c\xa5\x81\xa9\x52\xae\x0b\x48\x61\x1b\x02\xb1\x6c\x7a\x6b\x5a\x26\x00\x66\xd4\x89\xa8\x0d\x8c\xac\x5e\x58\x82\x2d\xbb\x98\x58\xeb\x4e\xfc\x4b\xbc\xa2\x44\x2e\x08\x89\xf8\xdc\xad\xf5\x8c\xc5\xa8\x54\x97\xae\xf5\x04\x6d\x52\x29\xcf\xab\xa1\xd4\x26\xe7\x43\x8f\x09\x1c\xfa\x52\x6e\xb3\x20\x1a\x45\xd2\xe9\x0b\xd5\x74\x30\x07\xcf\xc7\xa5\xde\x19\xdd\x36\x2b\xb3\xb3\x7d\x2b\x93\xc4\xb7\xb0\x0d\x70\x40\xac\xd1\xee\xeb\xcb\xf0\x95\x42\x50\x3c\x6e\xab\x64\x2a\x5b\xa0\x44\x67\x56\x13\x54\x98\x0a\x84\x42\x01\x09\x7f\x60\x47\xc3\x3f\x48\x2d\xb2\xdc\xc1\xf4\x0d\x6a\x97\x80\x23\x34\xd3\x57\x9a\x26\x25\x8a\xf4\x3a\x24\x52\x41\x01\x59\x88\xcf\x8b\x83\x8a\x4e\x21\xd7\x2a\x1b\x88\x1c\x42\xf4\x46\xef\x97\x05\x82\xd6\xde\x19\xbf\x35\xed\x08\x03\xb1\x7e\x39\x0b\x47\xb0\x44\x20\x1a\x6c\xcc\xad\xd8\xe8\x10\x1f\x6f\x9c\x3f\x6a\xdf\x02\x23\x66\xcd\x9e\x60\xdd\xa6\x2e\xc5\xab\x7f\x4f\x58\xc5\xf6\x56\x57\x7d\x9b\x6b\x1b\x0e\x44\x28\x55\xe0\xfe\xdb\x9a\xca\xc2\xe2\x5a\x8f\xc7\xf6\xe5\x06\x4a\xfa\x34\xb0\xc3\xa0\x96\xb3\xfd\xbb\xbf\x47\x07\xef\x22\x51\x89\x93\x09\x4b\x59\xb8\x3b\x72\x8b\xf9\x1a\xa7\x6d\xd1\x9d\x4a\x6c\x28\xb2\xd3\xa1\xc1\x85\x39\x59\x71\x90\xab\x74\x50\x98\xeb\x36\x45\x39\xbc\x2c\x5d\xbf\x00\x36\x32\x6a\x3b\xe1\x33\xfc\x70\xca\x09\x05\xd7\xe1\xb9\x3b\x58\xd3\xfe\xaa\xc8\x13\x09\xcd\x7b\x3c\x04\xff\xaf\xff\xe3\xff\x7c\xfc\x5c\x39\xaf\x9e\x47\xdf\x3d\x7e\x2e\xec\x69\x7e\xdf\xb0\xbf\xa4\xc0\x16\x94\xf0\x73\x41\xda\x08\xcd\x61\x08\xbb\x65\xc1\x40\x11\xdf\x00\x0a\x32\x4c\x2b\x3d\xc1\x5b\x04\x1e\x4c\x65\x31\x78\x34\x6b\xcf\x76\x99\xf8\xeb\x7f\xd4\x3b\xa4\xaa\xc3\x06\x38\xe3\x48\x60\x03\xbf\xd0\x07\x6c\xe3\x4d\x88\xde\xc2\x88\xa3\x86\xd5\xda\x4c\x78\xf2\x13\x42\xdf\x06\x22\x1e\xf0\x40\x04\x0b\x1b\xf1\x0b\xc8\x73\x85\x7d\x66\x95\xa7\x1f\x6c\x4c\x42\x51\x16\xfb\xa3\x6a\x3a\x92\xd4\x1b\xbd\x36\xe2\x31\xf0\xd9\xfb\x57\xca\xf6\x21\x1a\xdd\xca\xab\x10\x9c\x87\x89\x1c\x96\xae\x18\xdb\xab\x3f\xde\x90\x71\x76\x02\xf9\x0f\x13\x57\x5e\x13\x55\x90\xa0\xfe\xd5\xc4\xef\x28\xf1\xd5\x8b\xef\x19\x56\x94\x4f\x76\x96\xb4\xfc\x5a\x38\xc6\x92\xde\x09\x4c\x18\x96\x46\x1b\x9b\x9b\x9b\x1f\x61\xaa\xe9\x26\xa0\xb9\x57\xef\xfe\x6d\x31\xf4\x47\xb6\x51\xfb\x89\x7e\x2d\xe4\x1b\xc9\xc8\xc5\xd0\x07\x56\x7b\xc6\x1f\x0b\xfe\x02\x6a\x72\xc1\xee\xb9\x81\x8c\x5c\x80\x6e\x0a\xcf\xf9\x5b\x57\x3d\x13\xff\x3a\xd8\xf5\x6d\x43\x0a\x55\x4f\xd5\xbf\xc3\x97\x42\x97\xc8\xdc\x09\x98\x8b\xf4\x82\x82\x94\xf1\xec\x94\x9e\x62\x20\x55\xd6\x5e\x7e\x71\xe9\x9a\x3f\x71\x92\x37\x8f\x00\x76\xb6\x37\x0b\x58\x32\xc4\x90\x96\xda\xde\x0f\x61\x07\x03\x86\x89\xf4\x94\x4a\x18\xd2\x79\x53\xe1\x58\x69\x6f\x9a\x7d\xb2\x2c\x1e\xd3\x27\xe9\xea\x63\xe7\x15\x59\x25\xeb\x64\xc0\xe2\x86\x5e\x90\x64\x5a\x1c\x16\xe9\x51\xc8\x8f\xc1\xe8\x0d\x22\xf5\xc6\x00\x64\x34\x5e\x8c\x7a\x74\xdf\x36\x51\x6f\xa9\x64\x34\x5e\xb6\x90\xf3\x2a\xea\xed\xa2\x73\xba\x15\xc7\x49\x9b\x80\x9a\xf4\x9a\xfc\xc9\x83\xab\x24\x82\x35\xf9\x2a\x31\x61\x11\x35\x1a\x88\x7c\xd4\xdb\xa9\x27\x71\x3c\x93\x27\xfe\xc6\x3b\xbd\x32\x98\xfc\x1a\x7f\x2c\xf6\xd0\x85\xe8\x7a\x43\x4f\x43\xf9\x58\xd0\xfd\x19\x92\x61\x75\x40\xaf\xe0\x73\x6d\x60\x47\x6a\xf4\x0a\xa2\x9f\x68\x47\x8c\xa4\xf0\xdf\x0c\xfe\x6e\xdc\xea\x3f\xcc\x1a\xd1\x5d\xb5\xaa\x73\x2e\x18\xb9\xa8\x0f\x7a\x0d\xd7\x3e\x03\xe0\xd0\x36\x5e\x1f\x01\x9b\x3e\xd2\xe7\xce\x06\xf6\x68\xff\x23\xfd\xa2\x64\x20\xe8\xdb\x66\x75\x62\xd6\x1f\xb8\x77\xa4\x0c\x52\x14\xd2\x47\xd1\x0e\x4a\x88\x90\xaf\xc8\xe7\xe9\x7b\xf9\x4d\x59\xa5\xf6\x3d\x2e\x07\xd1\xd9\x8f\xce\x29\xca\x20\x56\x19\xbe\x67\x17\x77\xb6\x35\x0e\xc9\x6c\x76\x17\x47\xce\xfe\x57\xde\x1d\x83\x30\x69\xbc\x92\x4f\x58\x4f\x20\xaf\x62\x58\xf5\xe3\xc7\x37\xaf\xff\x45\x21\x0e\x98\xf8\xe5\x22\x4d\xee\x12\xf6\x38\xfb\x34\x7c\xc7\x3f\x73\x26\xfb\xa4\x29\x66\x01\xed\xb1\x4c\x9e\x8c\x04\x1a\xa2\xee\x2a\xc8\x1b\x48\x98\x01\x24\x87\xeb\xe0\x61\x79\x9a\xc7\x6a\xa9\x34\xc6\xa4\x06\xdb\x2a\xd4\x27\x02\xaa\x15\x75\x8a\x32\xb0\xe8\xc1\x8f\x59\x25\xcc\x19\x1c\x71\x4c\x72\x43\xe1\xdd\xc2\xdb\x8b\x83\x25\xe8\xbd\x49\x1b\x4e\x07\xb0\x93\x9c\x87\x2e\x09\x77\xae\x0e\x2d\x25\x76\x86\xe4\x79\xcc\xe8\xa1\x14\x6e\x17\x03\x12\x5b\xdc\x06\xd2\xa1\xc8\x56\x89\xe4\xe8\x74\xa3\x6c\x0c\x4a\x96\x5d\x49\xbf\x80\x76\x7e\x6b\xa3\xf3\x4b\x8c\x6b\x40\xf6\x3f\x20\x18\x87\x9f\x92\x45\x96\x1d\x4d\xb2\x0e\x82\xaf\x0a\x00\xfe\x49\xf6\xf7\xad\x8d\x55\xe6\xc1\x1b\x5c\xc0\x42\xc4\xe0\x3d\x0e\x29\x3c\x92\x41\x00\xe9\x5a\x6b\x10\x59\xef\xfa\x06\x9e\x4f\x8d\x1c\x4d\xcf\x31\x53\x41\xa6\xea\x5d\xff\x18\x32\x69\x40\xaa\x46\xe0\xa1\x5d\xb6\x24\xca\xda\x17\x30\xb0\x5d\x6c\x56\xa6\x71\x7d\xa3\xf3\xa4\xfe\x59\xac\x1a\x57\x46\xb9\x5e\x69\x19\xff\xe8\x48\xa3\xd7\xa1\xa1\xd3\xc1\x85\x4c\x8c\x45\x37\x45\x8e\x24\x0f\xb9\xcf\xc7\x7e\x94\x98\x21\x6f\x72\xc1\x13\x2c\x76\x4b\x8c\x7e\x4b\x7c\x22\x62\x2e\x7a\x55\x4a\xb8\x27\xfd\x82\xf3\xbd\x41\x4f\xcb\xac\x28\x51\x36\x00\x32\xd9\x0d\x73\xa6\x38\x3e\xab\x77\x64\x51\x87\x4d\x2a\xe4\x7b\xd0\xae\x5a\x81\x76\x5e\xa1\x54\x16\x1a\x2c\x79\x74\x43\x25\xcb\x8d\x6d\xb4\x3d\x56\x06\x57\x43\x51\x5f\x92\x6e\xa0\x7c\x5b\xe9\xb6\xcd\x0f\xb6\x6b\x72\x8d\x8c\x2f\x77\x1b\x89\x31\x85\x24\xe2\x93\x25\xc0\x8a\x90\xbf\x2c\xb0\x75\x22\x26\x5b\x99\xad\xa5\x20\x0a\x6e\x93\xf9\x7d\x05\x92\x95\x5e\xdf\x86\x83\x5e\x9b\xd4\x1e\x24\x42\x9d\x2f\xd6\xeb\xda\x74\x0d\x9a\x8a\xaa\xa7\x8a\x3e\x53\x26\xde\x32\xc5\xa2\xa7\x6b\x67\xbc\xe6\x75\xdb\x36\x71\x7f\x10\x9b\x89\x2f\xae\xc2\x93\x6f\xa4\xdb\xdf\x7e\x51\x40\x65\x80\x2f\xf2\xb6\x6c\x49\x1e\x40\x27\x59\x95\x37\x36\x9c\x2c\xf3\xb8\x69\x4c\x2e\x24\x61\x3f\x11\x69\xe2\x15\x5b\x99\x4f\x91\xfc\xe3\x15\xec\xb2\x62\x6e\x18\x89\xbc\x12\x9a\xe8\x68\x95\xe6\x63\x92\xfa\x2b\x00\x32\xec\x2c\xb9\x13\x16\x09\x81\x3f\x86\xee\x3e\x42\xa7\x59\x49\x92\x87\x19\xb9\xba\x4c\x6a\xe5\x1a\x84\xc8\x12\x69\x60\x9f\xfc\xb1\x64\x3c\x1b\x24\x7a\xd1\x3c\x1f\xdb\x03\xf3\xcb\xc1\x12\x46\x8f\xa6\xe2\x1c\x14\x9b\x65\xbd\x4f\xc3\x33\xf2\xf5\x52\x8e\xc4\xc8\x8e\x70\xbc\x78\xf9\x58\x5b\x19\x0a\x76\xc0\x3b\xa6\xc7\x4b\x61\x1c\xd7\x80\xcb\x72\xfd\xac\xba\x91\xb5\x15\xbe\x2b\x5e\x28\xb5\x5e\x47\x0a\xcc\x51\x0a\x48\x64\x2d\xc8\xf2\x6f\x6c\x68\x74\x3a\x1d\xfb\x28\x92\x5c\x2c\x6b\xd4\x41\x33\x7b\x98\xbc\x72\x6a\x22\x19\x46\x4c\x92\x4b\x15\x01\x3c\xd5\x11\x4e\x7b\x26\x4b\x52\x84\x0b\x61\xce\x69\x25\x99\xa2\x4d\xc5\x43\x80\xbe\x87\x6c\xf9\xa4\x86\xd7\x0b\xa3\x96\x2a\xba\x4d\x68\x50\xc2\x60\xda\xe6\xa8\x7d\x4f\xb6\xd7\x35\x81\x43\xd9\x70\xa3\x83\x8b\xfe\xd7\x2f\x6f\x50\x3c\xeb\x5b\x96\xcb\x82\xa8\x47\xc7\xe8\xed\x6a\x88\x26\x2c\x65\x47\xf2\x02\x89\xf3\x0d\xc8\x5e\xc0\x89\x1b\xae\xa1\x23\xde\x6c\x87\x4e\x73\x24\x00\xa4\xf7\xe4\x5d\x45\x8a\x0a\x58\x37\x65\x4c\xcf\x34\x1c\xa7\x3c\xac\x79\xa4\x2a\xa6\x68\xf9\x0a\x78\xf8\x1c\xf0\x75\xd2\xf4\xae\x21\x91\x4b\xa1\x23\x54\xcd\x87\x68\x69\x73\x81\xb1\x8c\x26\xc8\xd2\x38\x57\x11\x1b\x18\x36\xc7\x5d\x51\xad\xdc\x09\x13\xb3\x0f\x86\x56\xc1\xf6\x6b\x93\xc3\x96\x98\x56\xea\x5f\x5e\x16\x3e\x66\x0f\x6f\xa8\xe2\xcd\xca\x66\xc7\x9d\xe6\xbb\xad\xaa\xc4\xf9\x74\x2e\xd0\x79\x2e\x07\x00\xe8\xb8\xe4\xf3\x21\x3a\x7c\x86\xd2\xb5\x18\x77\xc5\x15\x58\xf7\x74\xb2\x17\x9f\xd1\x30\xe2\xd3\x3b\x4f\xd9\xc3\x77\x65\xef\xe4\x72\x80\xb3\x13\xa8\x70\x9a\x1d\x6f\x44\x6f\xbe\xb8\x8a\x21\x3b\xb7\x07\x83\x12\xb8\x86\x6d\xbc\x78\x3f\x67\x17\xc1\x94\xfe\x84\x39\xf2\x79\xb2\xb1\xa9\xe4\xe4\x07\xd8\x98\x23\x6c\x7c\xaf\x4f\xb0\x51\xfa\xbd\x68\xe0\x22\x0b\xc3\xaa\xb5\x9e\xef\x12\xfa\x60\xce\x6a\x3e\x2d\xd9\x43\x08\x36\x3f\x51\x95\x61\xd4\xfe\x44\x60\x06\x31\xfd\x3b\x53\x6b\x89\x03\x3b\x61\x7d\x4d\xa1\x26\x04\x0b\x79\x01\xca\xcd\x95\x1f\x61\x7c\x53\xc9\x5b\xac\x86\x2b\x5f\x8c\x92\x33\xf2\x30\xab\xd6\xa3\xfc\x0d\xf1\x76\x5f\x02\x9b\x56\xd2\x34\x0a\x1d\x92\xc7\xb1\x94\x9e\x1f\xed\xec\x18\x2c\xe5\xf0\xe5\xfe\x42\xc7\x9c\x26\x22\xbe\x77\xf0\x3f\xa5\xf6\xe6\xc8\x22\xfd\xa3\xf1\xc9\x15\x30\x05\x7c\x83\x7b\x0b\x1f\xd0\x45\xf2\x72\xfc\x68\x2e\xb2\xe0\xc8\x80\x44\x85\xfc\x12\xcc\x2f\xb3\x49\x56\x98\xca\x3f\x87\x4f\xd5\x4d\xb0\xa4\x57\x78\xf9\x08\x1f\x55\x53\xc2\xbc\x75\xf3\x60\x54\x5d\x09\x49\x35\xee\xe7\x80\x91\x85\x5d\xc2\x22\x1f\xbb\xe0\x01\x54\x88\x5d\x30\xed\x08\x33\x24\x9d\x81\xd7\x01\x5d\xe9\xa3\x5e\x10\xff\x9c\xb6\xb3\x00\xa2\x66\xea\x19\xd0\xde\x95\x70\x6f\xdd\x04\x88\xf7\x6d\xa2\x6f\xc6\xb3\x97\xe7\xc7\x1c\x27\x13\x44\x99\x63\x81\x30\x16\x10\xb2\xa5\xaa\x26\x21\xe3\xca\x2a\x7c\x84\x2b\xe9\x43\x2c\x93\x86\x1a\xec\x2e\xad\x0e\xde\xb4\x66\x63\x7b\xd3\xaa\x60\x50\xbe\x59\x2f\x84\x71\x71\xdb\x6f\x5c\x79\xc6\x01\x07\x01\x78\x51\x54\x0a\x59\x51\xc9\x6e\x89\x9c\x9c\x32\xbb\xec\x51\xea\xe9\x23\xf1\x79\xaa\x57\x8e\x5c\xe0\xf0\x68\x91\x9f\x1c\x8a\x90\x35\x6e\x18\xfb\x47\x3d\xd3\xaa\x19\x55\x0e\x80\x80\x92\xe7\x8a\x0c\x81\xe5\xfd\x74\xb8\xdf\x0b\x2f\x47\x6c\xf9\x8a\xce\xc7\x1d\xa4\x32\x0e\x29\x92\x4f\x5b\x3c\xec\x18\x2d\xae\xef\xa8\x57\xc4\x74\x82\xc5\x9d\xe6\x12\x96\x6e\xce\xa2\x95\x2c\x99\xcc\xb2\x93\x89\xae\x66\xb8\xcc\x03\x6a\x81\x74\x4a\x68\x5d\x26\xfd\x92\x6e\xa6\xc4\xc5\x0d\x3e\x86\x39\x8b\x79\x7f\xa6\xe4\x85\xdd\x96\x21\xb6\xb6\x37\xe7\x51\x9f\x29\x47\x23\xe5\x8d\x0e\x8e\xf4\x6c\xe1\xc7\x05\x98\xb3\xf8\xfd\x5c\x49\x96\x21\xa3\xe4\x78\x9a\x03\xac\xa9\x26\x71\x35\x81\x43\x45\x1f\xb3\xa0\x81\x23\x6e\x46\x07\x6f\xe5\x3c\x10\x2d\x1b\x0a\xcc\x15\xa2\xbd\x00\xec\x2d\x2e\x43\x9b\x1a\x49\xe1\x33\x45\xf6\xa6\x8f\x16\xd5\xbf\xb8\xc8\x9b\x94\x30\x53\x24\x70\xa4\x04\xe7\xe3\x4c\xce\x12\x57\x7b\xe4\x8b\x28\xcc\x82\xc0\x91\x14\x22\xdf\x60\xf3\x20\x24\x49\x49\x8f\xdb\x0f\x22\xa5\xa1\x94\x76\xb6\x62\xa3\x43\x2e\xf1\xda\x90\xdb\xb4\xfb\xcb\xed\x5d\x88\xe4\x08\x1e\x9a\xf4\xc6\x85\xa8\xf8\xf3\x42\x3d\xb9\x00\x55\x34\x29\x01\xfb\x54\x98\x8c\xf4\x3b\xf3\x18\x0b\x2b\x46\x34\x60\x64\x3b\x44\xfd\xed\xa4\x70\xb3\xd1\xb7\x66\x06\x03\x16\x14\x68\xe4\xad\xb9\x21\x31\xd5\xdc\x50\xdc\x5a\x9f\x68\x2a\x3e\xc5\xfa\x00\x49\xd1\xae\x46\xe7\x47\x9b\xb2\xea\xf3\x83\x33\x75\x18\xe5\x33\x2b\x53\xaa\x1b\xf6\x0d\x8f\x04\x73\xc5\xe5\x2b\xe1\x91\x71\x6a\x34\x34\xec\x2f\xe9\x3b\x0f\xca\xaf\xaf\x02\x71\x01\xf4\xb7\x7f\x91\x62\x2b\x07\xd0\x2b\x97\x7a\x21\x1e\x6d\xa8\x78\x11\x96\xea\x19\x1b\xdd\x27\xeb\x7b\x51\xb1\x6d\x0b\x96\x19\x17\xfb\x43\x6a\xb7\x2b\x8c\xc5\xe9\x6a\x42\x0d\x92\x5a\x42\x52\x9d\xb3\xf8\x21\xc3\x50\x67\x49\xa3\x12\x08\x7d\x93\x4c\x78\x0a\x3e\x3a\x87\x94\xdb\x20\x94\x70\x02\x6d\x79\xf3\x97\x25\xc4\x28\x93\x9a\xac\xfc\xf9\xd2\x95\x49\xe6\x72\x0e\x17\x7d\xb4\xf3\xd3\x9e\x60\xd8\x87\x46\xcb\x02\x50\xfc\x3d\x0b\xd9\xbb\x08\xd4\x47\xdf\xb3\xe7\x98\xa8\xf8\x6b\x16\xba\x1d\xc8\xb4\x05\x09\x5d\xf9\x2d\x90\xde\xe0\x82\x95\xb1\xfc\x80\x9f\xa3\xcc\x4b\x03\xee\xab\x02\x4c\xef\xe4\xdd\xcb\xa0\xe3\x65\x4e\x6b\x13\x3f\x60\x61\xda\x96\x2d\x8e\x1f\xa5\x35\x8a\x5f\xdf\xe2\x3e\xac\x56\x6a\xde\x28\x15\x12\x5c\x76\xb0\x57\xa0\x5c\x46\xf9\xbb\x0a\x65\x7d\x18\xfc\xa5\xee\x65\x6a\x96\x7c\x7e\x6e\xc3\xe8\xc5\xe3\xcd\x26\xe1\x61\xd5\x4c\x6e\x1d\xc2\xb1\x67\x49\x79\x27\x7f\x5e\x15\xcc\xe3\xf7\x66\x6f\xfb\xd6\x78\xae\xe7\xa8\x83\xe2\x24\x76\x6a\x7f\x87\xd6\xed\x68\x96\x70\xd4\xc4\x2d\xc0\x5e\xb3\xbb\x96\xcf\xab\x34\xad\x1e\x50\xcb\x9b\x1d\x73\xad\x12\x0c\x6c\x8f\xe2\xf4\xfd\xfd\x2f\xe1\x09\xa1\x79\x72\xf5\xf3\x3f\x03\xfe\x5f\xe3\x7f\xa8\xe0\xef\x6e\x46\x1e\xe1\xbd\x46\xe5\x9f\x87\x56\x38\x6d\x6a\x31\x2f\x9f\xd7\x9a\x60\xf7\xb6\xd3\x3e\x13\x1a\x37\x94\x30\x22\x36\x0e\x2e\x80\x22\xb6\x69\x52\xad\x78\x89\x70\x6a\x6e\xcb\xa5\x02\xc9\xb6\x51\x38\x54\x5c\x27\x78\x42\x53\x68\xf2\xca\x8d\x11\xe3\xc6\x90\x8d\xfb\xd1\xb5\x09\x7a\x26\x60\x11\x48\x18\x56\x7b\x4b\xde\xbc\x48\x6c\x8e\xc8\x96\xf9\x9c\x8e\x4d\x79\x5a\xbc\x39\xd5\x07\xdc\x68\xf8\xd0\x4a\x08\x46\xd1\xe4\x33\x97\x34\x1d\xc9\x8f\x5a\x72\xa8\xa6\x0b\xa2\x5b\x0c\xb6\xab\x73\x17\x4b\x6d\x4d\x6c\x84\x57\x05\x06\x97\x51\x38\x57\x15\x14\x32\xac\xe4\xdd\xa9\x15\x7d\x4e\x91\x61\xba\x8c\xdd\x9f\x48\x1e\xd3\x73\x6f\x24\x6c\x2e\xb4\x5f\x1a\xb6\xac\x4a\x27\x6a\x54\xde\x2b\xc2\xb3\xaf\xa0\xf2\x05\xf1\x8a\xf6\xb6\xf3\xfb\xa4\xf9\x23\x99\xc8\x0b\x27\xc3\xaf\xa0\xbc\x3e\xaa\x3f\x3f\x7b\xf3\xba\xae\x0d\x85\x22\x0d\x7b\x15\x27\xa6\x86\xe9\xda\x84\x49\x32\xe6\x0a\xe5\x26\xfc\x11\x8d\x27\xdc\x46\x6d\xaa\xc2\xd3\x7b\x09\x76\x4d\x33\x7b\x27\x28\xb7\x11\x20\xf1\x36\xda\x1c\xb4\x8f\x76\x6d\x0f\x9a\x48\x8e\x37\xee\xce\xa8\x2a\x8d\x45\x0e\x6b\xdd\xbb\xde\xae\x75\x37\x77\xa1\xea\x50\x55\x88\x77\xa0\xd2\x21\xaf\xa6\xe9\x2e\x87\xe3\xec\x53\x39\x26\xbc\xa6\xb3\x5f\x06\x0e\xdb\x4a\x0b\x90\x67\x16\x37\xb4\xeb\xcb\xad\xb5\x9c\xe2\x2e\xdd\xa4\xd2\xcc\xfd\xfa\xaa\x9d\xb8\x48\x9d\x69\x52\xa6\x07\xd2\xb9\x51\xb0\xd6\xe9\x34\x9a\x9e\x32\xbf\xbe\x6a\xaf\x39\xba\x6c\xf2\xb2\xce\xbe\x14\x08\x87\xdb\x8c\x19\x98\xa4\x0a\xef\xa6\xf2\x93\x49\xa3\xb2\x88\x90\x7a\x92\xf9\xc0\x89\x7e\x9c\x36\x27\xe1\xe9\xdc\xfa\x96\xbc\x54\xde\xaa\xb5\xeb\xef\x8c\x0f\xba\x5c\xe6\x43\xcf\x10\x3f\xf5\xdd\x39\x18\xc8\x38\xf7\xd8\x2b\xf2\xc4\x70\x14\x1d\xd7\x9c\x83\x71\x9b\x4d\x13\xdd\x01\x6d\x7a\xde\x6d\x36\x8f\xf1\xf7\x1c\x60\x74\xae\xd9\xd1\xbb\x0b\x44\x96\x4e\xd1\xc7\x1c\xa8\x37\xe8\x99\x89\xe3\x3f\xe0\xcf\x39\xb0\x70\xd0\x18\x67\xee\xa0\xf7\x55\x76\x76\xb8\xcd\x3d\xac\x89\x41\x80\x29\x62\xef\xd5\x5b\x0d\x11\x94\x4a\x77\x79\xf4\xf2\x44\x01\x50\xb1\xb9\x87\xfe\x41\xa5\x86\x7e\x54\x8e\x3e\x93\x4a\xf2\x85\xaa\x14\x69\x34\x89\xc6\x9b\xca\xe1\xd4\x9c\x0f\x23\x7c\xa4\x5c\xf1\x00\x94\x21\x09\x02\x4a\x01\xe3\xe7\xd4\xc4\x22\xbc\x4c\x3b\xde\x5b\x2b\x07\x19\xad\xb0\x12\x63\x8b\x70\x2c\x8b\x6b\x35\x72\xbc\x67\xf8\x91\x49\xb6\x5c\xb0\x0a\x20\xe7\x12\x48\x6d\x26\x98\x6e\x7f\x0a\x05\x2a\x71\x20\xf8\x96\xa9\x5c\x7f\x71\x24\x32\x91\xa1\x80\x13\x65\x15\xc7\x1d\x4a\x0d\x64\x35\x06\x50\xa6\x48\x8d\x1b\x49\x3c\xa5\x6e\xb2\x6c\xbd\x01\xc3\xd6\x9a\x13\x26\x7c\xc3\xc4\x87\xac\xf3\xd7\xae\x73\x99\x4f\x89\x5f\x63\x00\x32\xdd\xbc\x6a\x67\x59\x8c\xf9\x99\xc0\x4f\x4f\x48\x18\xd1\x3b\x04\x39\xd3\x19\xca\x18\xc9\xcb\xeb\xcc\x14\x15\x85\x1a\x88\xb1\x51\xc4\xe1\xc6\x14\x0b\x7b\xd7\x45\xd0\x64\x3b\x3a\x0b\x36\xef\x05\x12\x61\x2a\x37\x05\x16\x25\x49\xd9\xf3\xa3\xed\x2b\xcf\x05\x8c\xfb\xbc\x75\xef\x7c\xe5\x79\x1b\x53\x5b\xef\xd1\xde\x28\xde\xf9\xa3\x8b\xf7\xaa\x55\xef\x8b\x14\x81\xd4\x31\xea\xf5\x0e\x17\x7b\xc1\xb9\xfc\x0b\x09\xf1\x58\x76\x47\xa4\x40\xcf\x14\x5f\xd4\xab\xbf\xcc\x94\x4e\x81\x4a\xcb\xd2\x29\x11\x50\xfc\x65\x41\xda\x81\x85\xcc\xa3\xd4\x12\xe4\x4c\xd6\xe1\xad\x75\x32\x20\x25\x29\x65\xcc\xc2\x25\x13\x32\x06\x8e\x47\xa7\x92\x1e\x1a\x9c\x22\xa8\x42\x53\xdf\x86\x48\x4b\x26\x39\x62\x8d\x16\xe3\xa2\x3e\x45\x8f\xd1\xe3\x0a\x93\x92\x31\xff\xe2\xfc\x4a\xe9\x72\xac\x6c\xc9\x30\xf4\xb4\x22\x83\xa1\x0f\xf2\x7b\x9c\x97\xbb\xd2\x9a\xc2\x7e\x48\x44\xa6\xac\x5f\x56\x0a\xcb\x9f\xbf\x7b\xf1\xfd\xbb\x3f\xbd\xfd\xfe\xc3\x8d\x50\x33\xd8\x7c\xd1\xce\xe0\x51\x77\x8d\x37\x61\xe8\x62\x90\x4b\x94\x3e\x36\x6e\xe8\xdb\x0c\x84\x2e\x1c\x9a\xe8\x8a\x7e\x16\x0c\x97\xb8\xe3\x2a\x25\x77\x65\xd6\x7a\x08\x5c\x21\x8c\x33\xea\x95\xe7\x91\xf7\xa4\xdd\x36\xc6\x8f\x1a\xf3\x32\xc8\x0f\xc1\x5f\xcd\xe7\x8e\xa2\xb1\x12\x87\xa3\x3b\xa9\xd6\x6e\xf0\xa9\x1c\x45\x7b\x4e\xaa\xdb\xe9\x80\x56\x02\x8d\xcc\xf8\x53\xf5\x97\x54\x9b\x48\x81\x47\x8b\x62\x65\xe2\xd1\x98\x9e\x5e\x25\x58\x2f\xc9\xba\xc3\xd7\x23\xef\x66\x4f\xb0\x8e\x27\xf0\x68\x6c\xf9\x8d\xf7\x6b\xfc\xa0\x97\x1e\xaf\x9a\x91\x9c\x68\x66\xc5\xe3\xc1\x2b\x93\x7e\x14\x9a\x18\x47\x08\xd9\x11\x62\x56\x12\xe8\xed\x2f\xae\xd1\x7e\x97\x5c\xa3\x29\xdb\x47\x37\xe3\x32\x8d\xf1\xef\xc9\x5a\xa2\xaa\x86\xd2\xfe\x31\xf4\x0a\x5f\xc6\xd2\x09\xbd\x6a\xaa\xab\xb6\xa6\x3b\x2a\xa8\xb1\xc4\x36\xe7\x55\x2a\xce\xb2\xe2\xd3\xfe\xa0\x03\xca\xd1\xe2\xc9\x14\x3d\x65\xb0\x37\x99\x72\x26\xa3\x53\x07\xe3\xf1\x51\x45\x45\x92\x13\x83\x65\x35\x34\xc8\x50\xf7\xb9\x26\x58\x35\x29\xe7\xe3\x04\x6d\x3a\x82\x19\xa6\x3e\x81\x09\x45\xab\x23\xe8\x2d\x8a\x2b\x1d\x1d\x75\x22\xd2\xe7\x71\x31\x6c\x3b\x64\xbd\x50\x36\x9c\x44\x8d\xc4\xe2\x62\x91\xb6\xdb\xd0\xe0\xfb\x5c\x94\x6b\xc8\x6d\x6e\x67\xd7\x51\xa5\x74\x1b\x38\x5c\x04\x45\xd1\xdf\x7a\x13\x42\x32\x3f\xf0\x66\xe3\x4d\xd8\x61\xc4\x70\x00\xd8\x98\xa3\xda\x3b\xe4\x06\xa7\xd3\x50\xf7\x0d\x5a\x3b\xd3\x7e\x2d\x55\x5f\xab\x6e\xd4\x26\x3c\x55\x1c\xf0\x02\x15\xda\x07\x3e\x0c\x1b\x79\x2b\x9a\xc3\x97\x4f\x84\xa4\x85\x21\xfd\x0e\xe7\xeb\x4a\xf2\x43\x89\x18\x03\xa9\x6a\xaf\x7b\xf2\xb6\x60\x7b\xe5\x7c\x6b\x3c\xc7\x24\x04\x1a\x3f\x39\x22\xae\x30\x8b\x82\x09\x68\x5d\xd3\xe1\x25\x71\x60\x6d\xcf\x67\x20\xf2\xdb\x05\xba\xab\x20\xbb\xb4\xd5\x66\x17\x03\xf4\x45\x67\x74\x67\xcf\xf1\xd8\xd4\x88\xdf\x92\x45\xde\xfd\xc8\xcf\x23\x4d\xcf\x6c\x3e\x2c\x4c\x17\xcc\x11\x07\xb8\x78\x6d\x53\x9e\x4a\x79\x65\xd9\xf6\x4c\xe1\xe9\xec\x1e\x75\x90\xb7\xa5\x4c\x25\x34\x5a\x1a\x7f\x84\x72\xe3\x9a\xea\xfd\x9a\x2b\x28\x19\xc5\xcc\x54\x9f\x69\xe7\xf5\x3f\x32\xec\x5f\x57\x07\x39\x2f\x27\xc2\x57\x6a\x37\x62\x3a\xd7\x93\x0e\x2c\x40\x23\x8a\xc6\x00\x40\x5b\xf5\x83\x21\xf4\x98\xae\x38\x3d\x13\x19\xa8\xb0\x59\xd8\x4e\xcb\x39\x59\x99\xc5\x54\xd0\xfb\x71\xb0\x3a\x51\x52\x99\x83\x6a\xa2\xd7\xb6\x2b\xc8\x8c\x0f\xc2\x22\x91\x0c\x1a\x10\xf4\x2a\x25\xde\x07\xb9\x91\xd5\x34\x8c\x2f\x56\x3c\x42\xe7\xee\x37\x82\x06\x63\x69\x3f\xa0\x9d\x84\xeb\xe4\x8a\x78\x3f\xda\xf6\xc2\x09\xc1\x09\xfc\x3a\x6f\x24\x19\xf5\xd6\xb0\xc1\x75\xc5\xd8\xb9\x84\x44\x0d\x7d\xb4\x1d\x39\x3c\xa4\xa2\xd0\x35\xef\xee\x44\x51\x1f\x83\x6c\xd9\x7e\x5b\xd4\x56\xd6\x03\xa3\xc0\x01\xda\xb1\x98\xee\x90\x16\x2b\xd0\xa5\x33\xb6\x37\x46\x22\x55\xa3\x85\x49\xdc\x89\xcd\x33\x19\x84\xe2\x74\xc7\xcb\x67\xe7\x72\xa6\x09\x6c\x98\x81\x46\x25\x45\xc5\x53\x48\xa1\x19\xdf\x9d\xc9\x97\x19\x7f\xf7\x10\x9a\x12\xb6\x41\x41\x57\x02\xc4\x1c\x71\x59\x0d\x0b\xf2\x73\x01\x39\x7e\x48\xef\x15\x7a\x56\x44\x8f\xde\xb8\xd3\xb1\x32\x0e\xcc\xc2\x85\x53\x54\xea\xbd\x0d\x81\x44\xe1\xe4\x72\x9e\x54\xa7\x58\x1d\x2b\x05\xa7\x92\x19\x64\x53\xf9\x63\x3f\xa5\xe2\x73\xa7\x85\xa6\xb8\xa2\x6e\x7e\x19\xbe\x9a\x01\x62\x8c\x2c\x0f\x7e\xc6\x5f\x60\xdb\x33\x85\xe5\x79\x2e\x56\x04\xa5\x9c\x47\x9b\x51\x32\x65\x03\x0a\x34\xef\x8e\xfd\x4c\x09\x60\x2d\xb7\x64\x45\xf4\x27\xf9\x29\xf5\x5c\x68\x77\xe5\x24\x4d\xd2\xc4\x4e\x81\xfc\xb6\xa1\x43\x8c\xf3\x15\xf6\xc9\xce\x51\x7a\x95\x68\x93\x04\x32\x57\xde\x0d\xb1\x65\x36\xda\xdb\x3c\x65\x85\xaa\x27\xdb\x6d\x66\x75\x52\x0e\x32\x82\xf7\x2b\x4d\x5b\x41\x92\xe0\x62\x2f\x25\x0e\xd4\x9c\xc9\xe1\xc3\x87\x5a\xed\x72\xe0\x63\xbd\x38\x85\xf1\x1d\xd0\xfc\x4e\x7b\x79\x1d\x5c\x57\xa7\xf5\x24\x48\x86\x54\x44\x85\x44\x05\xb1\x3c\x71\x1e\x5c\x0f\xb2\x97\xf0\x1e\x60\x6a\xec\x41\x55\x84\xc6\x9b\xfc\x34\x7a\x48\x4d\xec\x72\x2f\xd7\x93\x8c\xa2\xe7\x8d\x96\xa5\x7e\xa4\xa8\x87\x7e\x9f\xed\xa9\xb3\xea\xec\x5f\x58\xcb\xeb\x8b\x98\xa8\x68\xa6\xb4\x13\x19\x3d\xa2\xc5\xca\x37\x55\x4f\xec\x9d\xea\xa4\xfb\xf2\xd7\x57\xed\x57\xf4\xca\x40\x4b\xa9\x89\xaf\x1c\x48\xa4\xa9\x29\x19\x29\x6c\x33\x25\x92\x48\x38\x5f\xe5\x3e\x92\x57\x16\x6b\x1b\x14\x8e\x72\xe0\x5b\xac\xbe\x66\x60\x30\xd4\x1b\x68\xe2\xe5\xd7\x08\x01\x17\x17\x88\x70\x58\x32\x95\x82\x5b\xa2\x38\x98\x58\x46\x8a\x4d\xee\xd7\x89\x4a\xa9\x6b\x9a\x31\xee\x16\xbd\xf1\x9c\x55\x9b\xa3\x73\xcd\xcb\xfa\x02\xef\x6c\x1f\x0b\x75\xfa\x1b\xb7\xcf\x4b\xb8\x75\xb8\x82\x39\x84\xd9\xba\x8a\x59\xab\xe0\xda\x0d\x33\x42\x82\x45\x61\x87\x59\x30\x65\xb2\xa6\x58\x91\x3d\xa3\xd6\x56\xe4\xce\xab\xb6\x8d\x01\xb2\xfa\x02\xdc\xb5\x65\xdd\xae\x69\x07\xd3\xb0\x66\xd0\x5b\x87\xcf\x20\xf8\x1a\xb7\x40\x34\x62\xc6\x98\x05\xf1\xa8\x43\xa0\xeb\xbc\x33\x9a\xf4\x2b\x99\x54\xcb\x10\x2a\x3a\x71\xb3\x62\x0a\x91\x22\x3a\xae\xc8\xe8\x47\xef\xf7\xd9\xc1\x49\xbe\xa0\xe1\x7f\x99\x31\xe3\xf7\xaa\xcc\xcd\x7d\x7e\x31\x18\xd4\x21\x56\x5f\x8a\x69\xcf\x57\x75\x27\x0d\xc5\x97\x82\xff\x65\x86\x08\x17\x04\x55\x43\xdb\x86\x31\xb6\x2c\x30\x85\x94\x2c\x51\xbc\x4e\x52\xa4\x2f\x4e\xa7\xd3\xe9\xf1\x7e\xff\xb8\x6d\xbf\x98\xe9\x75\xc1\x7c\x4c\xdd\x1e\xd9\x90\x11\xe0\xf8\x0d\x5c\x60\x2a\x78\xb9\xf3\x63\x07\x00\xd5\x3c\x81\xc6\xa6\x56\x2b\x13\xa3\xf1\xa5\x59\x13\x6d\xfc\x54\x50\x05\xa7\x0e\xc6\x1d\x3a\x93\xbd\xdb\xc2\x73\x8d\xa2\x56\x94\x7d\x19\xf1\xc1\x8b\xac\x51\x20\xed\x8b\x0d\x4c\xd2\x94\xec\xe5\x61\x7f\x66\x50\x82\xbe\xbb\x34\x24\x05\xff\x39\x0f\x6b\xe2\x41\xcf\x00\xce\x73\xa0\x73\xed\xff\x9d\x5c\xe8\xb9\xea\xe7\x96\xc1\x3d\x7c\xe8\xc5\xd1\xde\x5a\xa4\x61\x6e\x2d\xfe\x5e\x72\xe8\xf3\x22\xd4\x79\x74\x98\xfd\xab\x2a\x3f\x49\xdc\xa1\xbc\x25\x23\x5a\xd4\x93\x56\xf4\xa2\xc4\x56\xbb\xa1\x6b\x55\x67\x6f\x0d\xf1\x98\xd7\x03\xbe\x01\x4f\x1c\xe8\x0e\x4d\x7c\xa2\xdb\x1a\x94\x90\x26\xde\xaf\x8d\xbc\xa8\x96\x54\x21\xaf\x71\x0c\x84\xd9\x1c\xf8\xfd\x84\x69\x4c\x38\xf9\x10\x91\x37\x42\xe0\x0c\xf1\x3e\x25\x30\xbf\x97\xd3\x99\xdb\x9b\xe1\x29\xce\x58\x89\x95\x62\x8c\x49\xbe\x58\x2c\x4f\xde\x70\xac\x6b\xa0\x7a\x07\xff\x56\x6e\x60\xf3\x58\xd6\x9c\xcc\x07\x04\xf7\x03\x56\x9b\xd4\x04\x52\x9d\xa2\x0e\x74\xf8\xc5\x15\xb0\x5e\xf7\x55\x50\xb0\xa0\x45\xfb\x06\xcb\x5d\x05\x02\x87\x0c\xc4\xd4\xb0\xfe\x36\xcb\x60\xaa\xfe\xe4\xbc\x71\x7f\xc8\x69\x68\x05\xc2\xf7\xf0\x3c\x14\xb9\xd7\x68\x7e\x2b\x5c\x82\xd2\xb1\x28\x4e\x3b\xb4\x8d\xd8\x8e\x20\x3e\x90\x38\x10\xc2\xc2\x81\xfd\x6e\x7c\xd4\x10\x16\x2d\xcd\xd0\xd4\x02\x08\x17\x12\xa2\xba\xc7\xe5\x72\xc2\x11\x78\x9a\x43\x31\x88\x12\x2a\x49\x42\xaa\xf0\xe7\x55\x58\x2c\x78\xc5\x61\x29\xfe\xb9\xb0\x7d\x00\x1f\xdc\x28\x55\xe7\x9f\x29\x2d\x59\xfa\x51\xe8\xd3\xd7\xda\x6f\x4d\x88\xea\x45\x4e\x9d\x05\x4d\x87\x40\x51\x5a\x7b\xa3\xbc\xee\xd9\x8e\xae\xd6\x69\x60\xbb\x9a\x1d\x47\xf6\xd1\xb6\xbf\x56\x14\x83\x1c\x89\x28\xcc\xa5\xb8\xe3\x45\x25\xcb\x69\xd5\xa7\xa2\xce\x53\xce\xae\x9d\x51\xa4\xe4\xd2\x05\x43\x4a\xec\x5d\x53\xf7\xb9\xd4\xe0\x9c\xda\xcb\x45\x6f\x4c\xd1\x10\x68\x3d\x9a\x01\x72\xe8\xd1\xe7\xd5\xf7\xea\x24\xfe\x1f\xce\x94\x28\x04\x43\xc2\x02\x32\x14\x0b\x01\x4b\xe1\x18\x92\x45\x19\xbd\x50\x00\x26\x29\xec\x32\xd0\xb9\x07\x03\x85\x5f\x6a\xcf\x35\xb6\x41\x1d\x0d\x8c\xa5\x8a\x3f\xce\x81\x65\x1a\x34\xb9\x90\x0a\xd5\xe8\x8d\x87\xa0\x1c\x3f\xe9\x07\x69\xc0\x4a\xa1\xd5\x10\x72\x8c\x9d\xef\x86\xa0\xe8\x63\x0e\xa0\xf1\x36\x80\x86\x06\xfc\x9b\xcd\x4f\x7c\x5a\x03\x2c\x62\x13\xa2\xd2\xfc\x1c\x3f\xee\x1c\x85\x50\xbd\xba\xbb\xba\x22\xbe\x08\xf5\x84\xd9\x68\x1d\x86\xe4\x6f\x31\xee\xcf\xc3\xc7\x10\x05\x55\x6b\xd2\x1a\x96\x81\xa9\x75\x7e\x21\x35\x2d\x97\x83\xf1\xd6\xd5\x73\xd0\x1a\x7e\x9c\x26\xfe\xad\x7a\x5a\x32\x33\x9e\x4b\xf2\xe5\x42\xd2\xf3\x97\x36\xd1\xcf\x63\x8f\x0e\xc4\x3e\xa2\xfd\xb7\x1f\xba\x68\x0f\x5d\x45\x6f\x4f\x79\x28\x68\x4f\x4b\x86\x03\x85\xdc\x8a\xaa\x5e\x52\x5c\xb9\x34\x74\xc2\x9b\x42\x8c\x18\x9e\x3e\x28\x83\x8e\x6d\xe7\xbb\xcb\xae\xd5\xa0\xbd\xb3\xf9\xd4\x30\x88\xc7\x50\xe0\x9d\x1f\x84\xce\xf6\xe4\x52\xb5\x37\xea\xaa\x9d\x05\xe9\x5d\xe6\x3b\xf5\x4e\xb8\x52\xf5\xba\x9d\x9d\x89\x7a\x32\x29\x5d\x5a\x53\x74\x2c\x7a\xbd\xd9\x90\x77\x5e\xfa\x35\xc9\x4a\x7b\xbb\x43\x8a\x10\xae\xfa\x8d\x89\xeb\x5d\x1e\xfd\xd2\x4b\xd3\x74\x4d\xc2\xac\xfd\xf4\xf1\x39\xd2\xc9\x61\x49\x9e\x75\x1d\x33\x40\x79\x5d\x92\x4f\xf9\x83\xf1\x50\x80\x99\x56\xde\xd0\xc3\xde\xa2\x2b\xce\x8d\xcd\xc7\xef\x10\x8c\x17\x07\x4e\xbd\x89\xe0\x76\x5d\x5a\xc2\x8e\xfb\x96\x33\x9d\xd0\x27\xb1\x20\x9c\xe4\xad\x3b\x76\x92\x43\x5d\x9c\x02\xb0\x1b\x68\x50\xb0\xc2\x1f\x77\xdc\x87\x29\x64\x74\x11\xbd\x04\x7e\x84\xff\x8b\x05\x53\x84\x4d\x0e\x01\x8d\xdf\x75\x28\xe8\x45\x6b\x90\x0d\xdc\xaf\xe9\xe0\x7e\x51\x7c\x56\x79\xf2\xd2\x79\xaf\xd7\xb7\x78\x85\xb6\x66\xdd\x69\x4f\x0c\x88\xbd\xee\xed\x06\xe5\xed\xe5\x46\xba\x0a\xc9\x2b\x8a\x5d\x9a\xa5\xda\x3a\xa0\xc7\xaf\xd1\x35\x8f\xde\x9a\xe5\x7f\x04\x08\xa4\xce\x0c\x0f\x92\x4a\xc5\x4f\xf1\x5a\xfd\x60\xf6\x80\x07\xa7\xe2\xe0\xf6\xcb\x4f\xfb\x6e\xa9\xfe\x68\xfa\xd6\x79\x93\xdd\x4e\xc8\xf5\x18\x6e\xed\xe1\x00\xc7\x4b\xd5\xda\xb0\x16\xdd\xf7\x1b\xfe\x75\x15\xe6\x40\xe8\xa5\xfd\x66\xa6\xfd\x23\x6d\x3b\x94\x54\x03\x34\x63\x9e\x08\xd9\x12\x41\x63\x7b\xa5\x59\xcc\x36\xaa\x11\xac\x2d\xb9\xa6\xf1\x2e\xa9\x46\x70\xd6\x5e\xb9\xc2\x74\xd0\x3e\xe4\x80\x4a\xd9\xee\x7e\x8d\x84\x33\x33\x19\x10\xa8\xfd\x7a\x5c\x12\x07\x3f\xcf\x64\x9d\x7d\x67\x7c\xa0\x97\xcb\x1f\xe9\x57\x9d\xad\xfb\x53\x93\x41\x74\x7f\x9a\x74\x70\xb4\x9e\x72\xd3\x78\xbd\x10\x49\x20\x40\xe3\x7e\x81\xb3\x0f\xb8\xcf\x34\x69\x97\xc8\x12\x5f\x2c\xf6\x43\x34\xc9\x07\x37\xcc\xd8\x10\x8d\xba\xe1\xef\x3a\x57\x56\x2a\xc5\x54\xad\x63\xcf\x9e\x99\x59\x18\x30\x89\x70\x0a\xef\x0f\x3e\x49\x04\x63\xcb\x57\x90\xc4\xda\x68\x07\x4f\x0e\x4b\x36\x8f\x77\xa4\x4e\xfd\xb6\xaa\x05\x97\x65\xa4\x37\xdb\xda\xf9\x36\xb3\x7f\x60\x5a\x82\x31\x1c\x91\x65\x39\x6e\xb8\xc6\x60\x9c\x2f\x20\x88\xde\x24\xa7\xf9\x27\x58\xcc\x43\xdf\xea\xd3\x4c\xe6\x6f\xf1\x8d\x7b\x26\xf3\x77\x30\x11\x83\x09\xf3\xb9\xbf\xc7\x17\x5b\xdb\x9f\xcb\xff\x67\x9c\xc6\xc1\x9f\xc9\xfe\x17\xb8\x8f\xbc\x9d\xcf\xfc\x9f\xd0\x66\x1d\x07\x3f\xcd\x26\xef\x1f\x4f\xc9\x27\x79\x9d\x65\xd0\x6e\xfb\xa7\x3e\xda\x6e\x9a\x53\xba\x7f\x35\x3c\x31\xe9\x79\x9b\x94\x8d\xd1\xec\x0a\xce\x5d\x94\x6e\xd9\xa8\x4c\xdf\x06\xe1\x38\x5b\xd6\x1b\x0f\xe3\x09\x88\x76\x6f\xfe\x46\x4a\xa8\x1f\xf9\xe7\x19\x88\xc2\x81\xbb\xde\x27\xb9\x49\x2a\xcf\x0b\xe8\xd5\xb3\xb7\xcf\x10\x93\xfa\xdf\x90\xda\xea\xa8\x57\x48\x6d\xe2\x32\xfa\x7e\xf0\xee\x60\x9e\x7c\x67\x7c\x07\x4f\x9c\xd1\xf0\x64\x2d\xbe\xf3\xeb\x9c\x95\xe5\xd8\x4b\xf4\x19\x30\xf6\x4f\x50\xbc\xf1\x61\xef\x48\xf6\x88\xa9\xb1\x9c\xad\xe3\xfe\xc2\x1c\x4e\x67\x5c\x3c\x2b\x44\xd7\xe5\x4a\x5e\x55\x0a\x26\x4c\x86\x00\x51\xc1\x77\x24\x1d\x6d\xb8\x91\xe3\xd1\x15\x74\x14\x0c\x31\x11\x02\x9a\x54\x69\xd3\xa0\x2f\xc9\x35\x21\xd2\x48\xd9\xcf\x35\x28\xc5\xc5\x5d\x28\xf3\x4a\xe7\x93\xbc\xfb\xd3\x1d\x4f\xa1\x74\x71\xd1\xd4\xd2\x43\x3e\xdf\x51\xde\xc5\xf4\x03\x8b\xd6\x56\xec\xb9\x32\x5c\xab\x8e\x0d\x30\xb2\xe4\xa3\xcd\x57\x0a\x3e\x4a\x00\x52\xa1\x0b\x67\x8e\xaf\xbd\xe4\x27\x74\xcb\xb6\x52\xf0\x72\x95\x42\xa4\x6f\xa9\x43\x16\x7e\x95\xbd\xe0\xc6\xe0\x49\x8e\xbf\xe6\x72\x0b\xbd\x08\x69\x3b\xb3\x90\xa1\x8d\x89\x8e\x7d\x94\x1d\xef\x3f\xaa\x02\x0a\xb5\xe6\xd0\xb9\xd3\x93\x14\x4d\x88\x52\x5f\x80\x72\xad\x87\x46\x72\x06\xba\xf9\xc0\x5f\xbf\x59\x86\xbf\x76\x29\xa2\x63\x3d\xea\xa5\x07\xb7\x2a\xce\x32\x8d\x9f\xec\xdd\x9a\x00\xaf\x70\xe8\xae\xab\xdc\xd4\x75\x9d\x92\xcf\x1a\xae\x6d\x65\x05\xcc\xcc\x3f\x2d\xd6\xb1\x4f\x4b\xce\x9c\x89\x7b\x73\x94\x35\x80\x71\xf2\x01\x6a\xe6\x22\xa9\x3b\x4b\xbf\xd9\xc8\x9c\xda\x51\x79\x8f\x15\x97\x5e\xa3\x46\x8f\xa2\xbe\x50\x03\x8e\xb9\x01\x54\x79\x5a\x93\xc0\x35\x19\x0f\xf2\x68\xbf\xfe\x79\x12\x00\xf4\xe1\xc8\x2a\x56\x4b\x81\x8b\xd3\x3f\x03\x53\x8e\x5e\x39\x5a\xb3\x63\x63\x98\xba\x18\x39\x7b\x17\x87\xee\x29\x44\x05\x55\xac\x74\x54\x7b\x47\xb4\x7e\xda\x98\x28\x96\x2e\x27\x65\x91\xdc\xf8\x62\x04\x79\xfc\x99\xd2\x96\xc4\x6a\xc3\x07\x3c\xfd\xca\x59\x59\xf5\x5b\x74\x34\x8a\xef\x33\x60\x4b\xf2\xcf\x0e\x9e\x57\xf6\xf6\x2c\x10\x39\xb9\x61\x3e\xe0\x39\x20\x4f\xbe\x7d\x21\x72\xc5\x39\x90\xa1\x17\xf7\x0a\xb0\x92\xf9\x77\x06\x9e\x73\xaa\x39\xc9\x6c\x56\xa4\x01\x5a\x04\xff\xa0\x08\x7f\x69\x5f\x21\x57\x1e\xa1\x8a\x61\x15\x16\x1d\x1e\x67\xc1\x15\x8e\x15\x91\xab\xba\xcc\x15\xdd\x17\xe2\xe2\x0c\x60\xc9\x93\x18\x3d\xc8\x49\x43\x3e\xd8\xd6\x78\x16\x32\x3f\x82\x4b\xf5\x91\xe4\x43\x7b\x29\xfc\x25\x31\x17\xae\x47\x5e\x9e\xe1\x9c\x75\x3d\x3e\x7f\xc5\xdf\x51\x6e\xc5\xc8\x99\xdb\x38\x63\xe4\x86\xb2\x19\xfa\xe4\xa7\x33\xbb\xa4\x9c\xb6\x17\xe9\xcb\x04\xc8\x4f\x49\x70\x57\x25\xb4\xb4\xeb\xd9\x4d\xfb\xf2\xbe\x1a\xf3\xce\x7e\x51\x57\x33\x43\xda\x66\x53\x90\x99\x38\xf0\xbf\xca\x35\x25\x29\x65\x79\xb6\xbe\x97\xc4\x99\xd5\x33\x2d\x20\xf3\xc5\xa5\x8a\xd5\xe3\xdd\x9e\x22\x05\xe1\x62\xb1\xfd\xf6\x5a\xe9\xf5\x1a\x5f\xd6\xba\xcb\x7a\x90\x30\x2b\xc7\x9d\x8d\x06\xee\xd0\x72\xfe\xa2\x01\x43\x90\x99\xaa\xd9\x49\x6d\x6e\x27\xba\xab\x3d\x03\x98\xd6\x93\xde\x16\x07\x55\x69\x9e\xa8\xb6\x9d\x5b\xa5\x83\xa4\xba\x04\xef\x7e\x93\xee\xbf\xa9\xf0\xd8\xf9\xe4\xb9\x2a\x9a\x1e\x26\x97\x42\xda\x2f\x91\x7d\x9e\xaa\xc2\xd6\xc2\xd9\x45\x0f\x83\x95\x61\xb5\x89\xb3\x5d\x6b\xe8\x0e\x93\x01\xbd\xa7\x73\xb5\xd9\xc4\x39\xb8\x4c\x8e\xbd\x9f\x8b\xd5\xc0\x04\x55\x39\x10\x17\x9b\x47\xaa\x12\xd5\x88\x22\x3e\xb9\xb6\x52\x89\xe5\x3d\x2d\x17\x95\x8b\x94\x09\xc3\x25\x4d\x98\x48\xa2\x96\xe5\x81\x98\x04\xe7\x13\xe5\x38\xf5\x01\x79\x5f\x73\xb0\xb2\x1a\x18\x32\x94\x9c\x4d\x9a\x96\xb9\x28\x6e\x9e\xed\x65\x4d\x4b\xde\xcb\x42\xe4\x95\x83\x64\xd4\x92\x03\xbe\x68\xfc\xe4\xb8\x2f\xce\xab\xbf\x19\xef\x00\x9b\xc4\x20\xb7\x71\xc9\x9a\x74\x13\x46\x29\x21\x9f\xef\xde\x72\xaf\x3f\x81\x14\x15\xc4\x0f\x39\x5c\x8a\xc4\x59\xe1\x0c\x0e\xb0\x72\x1e\xc1\xca\xb5\xe8\xcc\xdd\x4c\x51\x40\x16\x2a\x9d\x5c\x44\x22\x2d\xc8\x17\xf9\x0d\x57\xcd\x29\x0f\x2a\x96\xa9\xd2\x0f\xec\xbb\xcf\x7c\x42\xef\x70\x96\x5d\x0d\x91\x6c\x0e\x5b\xe3\x36\x23\x6d\x87\x40\xd2\x6d\x5c\x72\xbc\x4d\x51\x2f\xbd\x67\x8f\x99\x0c\x7d\xf0\x66\x63\x3f\x99\x70\x66\x38\x4d\x8f\x87\x52\xe3\x7a\x74\x16\x8f\x9e\x18\x31\x45\x39\xd2\x68\x7a\x50\xb1\xac\x62\x49\x63\x40\x74\x2c\x8b\x63\x60\x3f\xc8\x1c\x23\x53\x98\x14\x23\x4d\x26\xbd\x97\x24\x37\x38\xda\x60\x54\xd2\x9a\x0c\x99\xff\x98\xac\x82\x59\x29\x24\x50\xe3\x8a\xdb\xec\x4c\xef\xce\xd3\x5b\x73\x1b\xde\x4f\x26\xe1\x0c\xda\x2c\x24\x7b\x3e\xa7\x80\x92\x55\xc2\x18\x70\xba\xef\x49\xda\xbc\xdf\x6b\x14\x3b\xd1\xcd\x22\x67\x5c\x45\x57\x4d\xe1\x97\x63\x87\xe8\x97\x60\xe5\x0e\x4e\xd7\xe4\x45\x68\x31\xd3\x1b\x29\xbc\xb2\xe2\x6c\x35\xe2\x0f\xc2\x53\x6b\x7d\x31\x97\x3d\x2b\x73\x3d\x08\xc7\x54\x09\x50\x77\xa2\xff\x59\xe9\xb9\xea\xee\x22\xbe\x7c\xa9\xb2\x47\xfb\x94\xf0\xb0\x62\x0d\x4b\xfc\xae\x28\xf6\x54\xb8\x56\x57\x1c\x85\xf3\x62\xf9\xde\x35\x65\xcd\x1c\x32\xbb\xbd\xa7\x8c\xd8\xff\x66\xd2\x69\xee\x0e\xf1\x86\x98\xc0\x1c\xa4\x6a\x6f\x3c\x72\x61\xc2\x0c\x44\xd2\x36\x30\x2b\xf1\xfe\xd2\x1b\x8a\x33\x46\x96\x17\x50\x18\xde\xdc\x67\x79\x83\x82\x8a\xee\x04\x38\xbd\x81\x70\x5b\x77\xd6\xf4\x7c\x76\xc3\xe6\x2c\x5d\x3e\xb2\xd6\x2a\x45\x54\xc3\xba\x96\xea\x59\xc2\x43\xf6\xfa\x48\x3e\xb3\xee\xf5\x24\x3c\x01\xc9\x81\x90\x8d\xa5\xf7\xe6\x5a\x39\x9f\xd8\x58\x36\xcc\xdc\x82\x82\xba\x11\x65\x9d\xa7\x89\x91\x70\x15\x4a\x0a\x1b\x0a\x36\xa9\x21\x49\x7a\xff\x81\x53\x66\x30\xd6\xf7\x73\x2a\x7a\xe9\x4a\x46\x3e\x4e\xe9\xd4\x3b\xb9\x78\xa0\x94\xe5\xb2\x80\xae\x55\xe8\xe8\xa5\x75\xe1\x54\x98\x80\x27\xba\xb3\x62\x22\x71\xbe\x92\xe7\x1d\x3e\x61\x08\xeb\x37\x2b\x34\x3f\x86\xb8\xf6\x67\xc9\xd9\xe9\xb1\x79\x3f\xec\x88\x5c\x7b\x78\x39\xee\xc0\x0f\x25\xf5\x09\x84\xfa\x0e\x2d\xa6\x91\xec\xe4\xe5\xf2\xe4\x37\x13\x6e\xcb\xdd\x6f\x1e\x87\x08\x1b\x4b\x72\xa4\xe7\x06\xfd\x7e\x24\xb2\x4c\xe4\x30\xa4\x62\x43\x65\x13\xb6\xe4\xd6\x00\xc1\x39\x57\x58\x3e\x4b\x79\x19\x0b\x8f\xd3\x7c\xd2\x89\xbe\xc7\xf5\x89\xcb\x55\x1d\xbc\x59\x9b\xd6\xf4\x6b\x8e\x27\x92\x09\x69\xdd\xd3\x2b\x0d\xd9\x0f\x21\x02\x9e\x7d\xc1\x38\xa0\x0e\x1f\x6d\x1f\x1e\x30\x23\x7f\x07\x05\x0b\xf5\x15\xad\xbd\x58\x4b\x6a\x57\x7e\x32\x4b\xb8\xbe\xee\x54\xb2\x02\x6d\x10\xf4\x5f\x3f\x00\x5d\x33\x39\xd8\x30\xc7\x84\x0a\x13\x09\xf8\xef\xc1\x66\xca\x3b\x7d\x14\xf8\x27\xb7\x34\x95\x45\xe6\x24\xc3\xb3\x7d\x5f\xc8\x6a\x36\x04\x95\x7a\xc4\x98\x5c\xaf\x6c\xc4\xb8\x76\xca\x1b\xd4\xcc\x0a\xca\xd5\x6b\x40\x50\xc2\xbe\xb2\x33\x8d\x1e\xf9\x20\xe7\x8a\xca\x5a\x2e\x16\xc9\x9a\x30\x44\x32\xe7\xe7\x23\xb3\x7e\x41\x7d\x03\x99\x0d\xde\xed\xd3\x13\x72\xa6\x19\x9f\x71\xaf\x27\x97\xd1\x7c\xc2\xf1\x63\xf5\x61\x38\x93\x07\x1e\x8a\x2a\x85\xfd\xa4\x21\x2b\x29\xff\x1a\x73\x72\xed\xcf\xa7\x62\xd2\x5f\x41\x2a\x8a\x82\x82\xe4\xb7\x88\xb8\x03\x81\x98\x0b\xc5\xec\x62\x51\xd2\x80\xe3\xe0\x5a\x15\xd2\x14\x99\xaa\x76\x11\x72\xb6\x4f\x13\xba\x45\x46\xaa\xbb\x4c\xbf\x9c\x41\x70\x79\x58\x3c\xd1\xcc\xb5\xd2\x88\xeb\xeb\x41\x79\x5f\xb1\x6e\x8a\xa1\xe1\xfe\xae\xb2\xf6\xfd\x85\x7e\x9d\xa1\xa5\xa4\x77\x7a\xde\xf0\xe7\xf3\xf0\x5d\xee\xac\xac\x58\x31\x12\xab\x59\x52\x6c\xc2\x54\xdb\xea\xf8\xc2\xd0\x63\x75\xca\xa6\x30\xc6\x27\xf5\x9b\x59\xf3\x9d\x62\xf4\x48\xaf\xe5\x88\xca\x4f\x5c\x92\xf5\xc8\x89\xa5\xdb\x1b\xd3\xaa\xde\xa5\x01\x98\x19\xc2\x4c\x07\x66\x76\x0c\xd0\x53\x92\x0c\xd8\x91\xaf\x81\xaf\xa8\x7a\xf9\x3d\x0c\x5b\x19\xf9\x80\xf5\x7d\x9d\x27\x0a\x13\xf0\xa5\x22\xd0\xbb\x62\x0b\x4d\xf6\xcf\x4f\x81\x47\x26\x97\x20\x6f\xf1\xa7\x83\x0e\xc9\x47\xd2\xc8\x34\x0b\x9e\xdc\x17\x7b\x8d\x34\x2f\x87\x95\x0d\x7f\x6f\x67\xc9\x53\x75\xc2\xc5\xfe\xaa\xf1\xf3\x52\x31\x1a\x83\xa7\xea\x23\xfe\xdf\x38\x2f\x3e\x82\x30\x0a\xbf\x9c\xc6\xfb\x7f\xa0\x45\x52\x03\xb7\x68\x44\xd6\x33\x07\x52\x4a\x4f\x38\x90\xef\x67\xc8\xac\x38\x8a\x9a\xf3\x10\xfe\x23\x5a\x0d\x06\x83\xf4\xfc\xbb\x22\x2c\x2a\x72\x70\xd4\x8d\x89\x61\x06\xb6\xe0\x10\x0b\x0b\x06\xf7\x0c\xb1\xaf\x71\xcb\xcc\x87\x66\x25\x91\x03\x1b\xa7\x91\x69\xb0\x6e\x5b\x9b\x77\x6a\x22\x45\x13\xe5\x60\xe6\x05\x3e\xd3\x46\x2d\x6d\xbf\x33\xde\x12\xc5\xfc\x2a\xfd\xc6\x7b\xaa\x6c\xc3\x5c\xd1\x8b\xfc\xfa\x0c\x66\x3e\x01\x6b\x09\x2b\xf8\x9e\x7f\x9e\x07\x03\x0a\x39\x1b\x38\x7e\x2f\x69\xb2\xfe\xef\x2b\x4a\x47\xb4\x69\x47\x65\x29\xf1\xbe\xa2\xb5\x4f\x4d\x58\x1b\x29\x77\xae\xa8\x5c\xa5\x09\x88\x0f\x68\x13\xa2\xfa\xfe\x52\xc1\xb2\x4e\xee\x55\xd5\xde\x42\x46\xcd\xd9\x18\x69\xb1\x54\xb6\x23\x1d\xb0\xf2\xb4\xcd\x4b\x27\xee\x4c\x35\x75\xc5\xb4\xef\x9c\xc3\x40\xc9\x7f\x32\x2b\xfc\x99\x73\xb6\x36\x4a\x26\xbc\x16\x7f\xac\x73\xe1\xbe\x5a\x37\x85\x60\xea\x3b\x48\x98\x11\x4f\x71\x84\xd3\x02\x92\xa3\x6c\x4f\x41\x21\xc0\x63\x43\xf0\x12\x5a\xf4\xad\x3b\x4e\x51\x01\x98\xed\x1b\xf1\x15\x90\x51\x42\x0e\xdb\x96\x3d\xc4\x97\x00\xe9\xad\x6b\xb5\xb7\xfd\x10\x4d\x31\x28\x81\x84\x5b\xef\x40\x25\xcd\xea\x0e\xe3\x16\x4f\x8e\x94\xa2\x47\x1c\x35\x67\xda\x23\x0e\xfc\x05\x1b\x8e\x82\x10\x7b\xb7\x3f\x64\xaf\x4f\x98\x4b\x27\xbc\xa6\x49\xdc\xb9\xa3\x82\x88\x26\x81\x8d\x21\xa6\x3c\xdc\xa2\x99\xba\xbd\xd3\xfd\xda\xb4\x65\x53\x9e\x71\xda\x4c\x63\x40\x51\x7c\x74\xc3\x43\x92\x0a\xa7\x10\xcd\xbe\xe8\x5f\x30\x14\x3c\xbd\xd7\x5d\xc3\x26\x12\x60\xef\xb2\x1a\x6c\x17\xe1\x6e\x02\x73\x89\xdc\x08\x90\xc4\x37\x87\x61\xd5\xd9\x75\x53\x56\xf1\x0c\x32\x14\x65\x14\x51\xa2\x10\x21\xb2\x02\xea\xe0\x94\x07\x6f\xef\x6a\x69\x55\x30\x8d\xf9\x34\x6d\x86\xa4\x8d\xda\x51\x81\x36\x83\xef\x70\x03\x31\x28\xea\xd7\xff\xf4\xe1\xf5\x05\x70\x69\x76\xd2\xaf\x94\x30\x2d\x35\xf3\xe4\xa7\x0f\xaf\xa9\xf5\xa4\xb4\x5e\xfa\x96\x8f\x7a\x55\x4c\x0e\x19\xb1\x8c\xc6\x1b\x13\x39\xac\x8c\x3f\x33\xe2\x08\xc3\x91\x69\xfc\x68\xe8\x3b\x50\x25\x3b\x1a\xf8\x7b\x0e\x57\x35\x1f\x75\x23\xce\xcc\x08\x01\x3d\x68\x4e\xd6\x43\x88\x6e\x5f\xb9\x35\x47\xbd\x4f\x4c\x26\x87\x09\xec\x35\x2e\x5c\x2e\x94\xac\xbc\x7b\x29\xa0\x0e\xc6\x23\x8d\x7e\xad\x1c\x2a\xca\xb4\x1c\xdd\x33\x18\x69\xe1\xe8\x96\x4b\x9e\x84\xaf\x55\x9f\x9d\x03\xe3\xad\x98\x5c\x01\x2e\xef\x69\x46\x7e\x8b\xcf\xf4\x61\x2c\xd8\xc7\x33\x37\x39\x37\x64\xc9\xfd\xbf\xfc\x53\x19\x1d\x1e\x2b\x47\x45\x1f\xba\x30\x71\xe2\x1e\xdb\x5e\x46\x65\x79\x66\xa2\x24\x52\xe4\x3d\x53\x25\x60\xc2\x90\xb0\x5e\x65\xef\x56\x97\xa6\xf0\x3a\xbb\x03\x4d\xc8\xd6\xae\x3d\xb7\xdd\xe6\xd6\xa0\x64\x9e\x5b\x78\xa9\x30\xe7\x8c\xf7\x20\x79\x6e\xfc\xc8\x38\xe7\x37\x63\x51\xf4\xbf\x7b\x3f\x96\xa8\x93\xfd\xe1\xf9\xc6\x21\x53\x56\xc7\x69\x79\x1a\x9a\x10\x4f\x9d\x39\x8f\xe0\xad\xde\xc3\x3d\x74\x03\x50\x5f\x5f\xc4\x01\x5e\xd0\x8c\x47\x05\xf2\xb7\xf4\xeb\x32\xb8\xee\x0e\x3b\x9d\xcb\x3c\x2b\x3e\x2f\x17\xf4\x66\x6b\x3e\x1d\x0a\x71\xd5\xf7\x49\x52\xf2\x90\x82\xc5\x4e\x99\x91\x77\xb9\xcd\x99\xe5\x51\x7a\x76\xa5\x37\x3e\x6f\x1f\x42\x06\x8b\x32\xed\x24\xd7\x1b\xb5\xd6\x87\x48\x9a\xa9\x5b\xef\x86\xc3\xf2\x5c\xd3\x52\x6f\x46\x13\xf0\x41\xdc\x51\x4f\xb9\x97\x73\x08\xb2\xec\x8b\x8b\xe1\x21\x33\x92\xd5\x5d\xcb\xf9\x83\x6c\x9b\xea\xd1\x8b\x22\x31\x62\x39\xd2\x2d\x3d\x19\x19\x4d\x9c\x87\xdb\x2c\x8e\x9d\x1f\xa8\xa5\xfa\x98\x64\x86\xa3\x41\xb8\x16\x65\xfd\xe3\xce\x75\x86\xab\xb3\x1b\xd5\x3b\x38\x2c\xd9\xfc\xf0\x3f\xd1\x95\xec\x7f\xc9\xab\x1a\x56\x30\xad\xf2\x8b\x5b\xa0\x29\xd4\x08\xd7\xba\x57\x43\x30\xaa\x0c\xf8\x42\xfc\xb6\xff\x1c\x82\xf1\xff\xa5\xfe\x13\x4e\x96\xff\x92\x9a\x84\xf3\xba\xa1\x58\x29\x9e\xf9\xfc\xc5\xe9\xd3\xa3\x01\x5b\xcf\xf6\x9c\x0a\x8b\x95\xef\x54\x0a\x8c\x5e\xdd\x8f\xa3\xd1\x75\x78\x5a\x1d\xa2\xaa\x6d\x8b\xc4\xf9\x59\x19\xc8\x1f\x2b\x5a\x4d\xb5\x3c\xc8\x0b\x15\x6a\x05\xd2\xd3\x11\xe3\x30\xc2\xcb\x06\xd3\x92\x46\x9b\xbc\xb9\x31\x7b\x5c\x9e\x8e\x6a\x76\x95\x23\x8e\xbd\xe8\x88\x1e\xf0\xb6\x80\x8c\x22\xc6\x3a\x5b\x47\x8d\xb0\x60\x66\xf6\x70\x23\xbe\xa2\xc5\x29\xcd\x48\xf2\xff\x91\xf3\x1f\x86\x25\xeb\xae\x98\xc7\x1b\xdb\x75\xb4\x28\x18\xaa\x72\x8f\x53\x8f\xf1\xb5\xea\x50\xe2\x6f\x23\x4b\xfb\xa3\x4b\x11\xbf\x45\xfe\xc8\x58\x96\xea\xd9\x9d\xb6\x1d\xce\xd3\x9d\xf6\x16\x7e\x84\xaf\x1f\xd8\xbc\x3a\xca\x80\x24\x8f\x3d\x48\xcf\xd4\x9a\xa2\xc1\x33\x6f\xf3\xeb\x4a\x1c\x34\x5b\xa7\x78\x08\x42\x7f\x26\xa6\x6f\xd5\x23\x34\xbb\x0f\x8f\xb2\xf3\x20\x64\xfa\xd2\xa6\xc6\x2d\xac\xbd\x49\xee\x9e\x4e\xd3\x55\x58\x4e\x6b\x3a\x16\x72\x2b\x5a\x8d\x81\xf1\x58\xeb\xfa\x05\x7e\xa9\xff\xed\xfa\x92\x70\x22\x67\x5f\x18\xd4\x35\xba\x06\x63\xf5\x8a\xd7\xd5\x42\x6f\x0d\xf2\x2b\xe5\x3f\x92\xd7\x05\xe5\xbc\xdd\x5a\x38\x34\xb0\x50\xb1\x8b\xc0\xe2\x15\xd3\xd0\x59\x04\xe2\xe5\xd0\xdf\x1f\x51\x85\xfc\x48\x25\x92\xbe\xe3\x4e\x87\xba\x82\xda\xe0\x74\x39\x92\x42\x25\x9e\x2d\xe4\x15\xdd\x41\xaf\x79\x31\xf9\xcf\x8b\xea\xa3\x4b\xf7\x42\x32\xce\x39\x4d\x0a\x8c\xcf\x1b\xc1\xc3\xb6\xe2\xf8\x88\x8b\xae\x88\x87\x58\xea\xeb\xd1\x9b\x29\x29\x2f\x79\x73\x67\x7c\x18\xe9\x9a\x50\x2d\x64\xb4\x1b\xd0\xa2\xe0\x31\x95\xcb\xee\x40\x90\x8e\xa8\x2a\xce\x95\x48\x1b\x6c\x7f\xa6\x15\x23\x1f\x18\x43\xdf\xa2\xd6\xf6\xa4\x05\x39\x94\xc8\x73\xfe\x45\x03\x15\x46\x8a\x97\x04\x8d\x3c\x25\xf6\xdd\x3c\xd5\x7c\x63\x28\x89\x7b\x40\x4d\xc2\xf8\x40\x25\x2f\xaa\x3e\xe7\xd1\xad\x39\x19\xa5\xd1\x4f\x72\xa7\xb4\xb3\x87\x29\x58\xe2\x42\x09\xec\x78\x50\x0a\xde\x7d\xb2\x1d\x83\xa4\x9a\x25\x45\x27\xe8\x7a\x87\xd6\x27\xe4\xe3\x07\x35\x49\x3d\x59\x78\xcd\xd4\x5b\x4f\x13\xac\x09\x9c\x1b\xf2\xe4\x86\x3d\xc3\x6b\x2e\xad\x61\x1b\x94\x86\x6b\xc4\xde\xd9\x76\xd0\x1d\x36\xe6\x12\xde\xdf\xd5\x78\xd7\xae\x47\x05\xc5\xb3\xb8\x47\x1d\xc2\xab\x6b\x03\xdd\xf9\xc2\x73\x58\x30\x66\xa5\x90\x1d\xe4\x4c\xcd\x70\xab\x26\x1f\xc5\x95\x2f\x77\xd2\x58\x47\xe6\x44\xe1\xf8\x80\xbc\x1a\xe0\xfa\x40\xc7\x00\x69\x95\x7e\x3d\x79\xb6\xb3\x76\xdc\xf7\x1e\x70\xe2\x7b\x16\x1c\x36\xce\x82\xe5\xc7\x15\x3b\x33\x32\x58\x08\x20\xd0\x7e\x23\x7b\xc2\xe9\x9d\xda\x3a\x14\xae\xea\xf5\xed\xac\xd1\xfa\x2c\xfe\x99\xfd\x55\xda\xc5\xd3\x23\x28\xb0\x0c\x81\x2b\x46\x8f\x63\x61\x0e\x5f\xed\xbd\xe1\x43\x79\x34\x49\x83\xb3\x56\x0e\x76\xa5\x3d\xb7\xf2\xc7\xb1\x8a\xb1\x69\x73\xe7\xd1\x99\x81\x92\x0e\x14\xab\xff\xfa\xef\x1a\xad\xf3\x03\x95\x0f\x22\x71\x25\xfe\xfc\xd9\xdb\xb7\xef\x3e\x16\xf1\x8a\x67\xce\x95\x11\xbe\xdf\x9d\x3d\xd8\x30\x8e\x7f\x4f\x22\xd4\x1c\xb8\xdd\x9f\xc8\x67\xed\xd4\x28\xaf\xb4\xb7\x07\x36\x1f\x0c\xb7\x10\xbc\xd7\x29\xf0\x12\x1d\x7b\xa5\x23\x71\xda\x43\xe7\x5b\x88\x37\x1d\x75\x1b\x2c\x1c\xe0\x33\x3d\xe1\xd0\xb1\x86\xed\xc5\x6a\x2e\xb2\x87\xa2\x19\x21\xe8\xe5\xf5\x71\x8f\x7b\x8f\x73\x0c\xbb\x79\x64\x22\x00\xb8\xc8\xf0\x2f\x9a\x76\x67\x83\x5d\xd9\xce\xc6\x53\x69\xd3\x27\xf6\x4b\xea\x8f\x29\x9b\x55\xaa\x2f\x96\x64\x36\x00\xaa\x32\x82\x22\x02\x7d\xe9\x78\x4f\x21\x7a\xdb\xa7\x52\xfc\x79\x5f\xb1\x42\x2d\x3d\xda\x3d\x13\x61\xf4\x13\x49\x98\xab\xc4\x33\x26\x7d\xee\x6b\xb2\x19\x61\x16\x7c\xc9\xb4\xc4\x5b\x18\xdf\x0b\xed\xe5\x91\x69\xc6\xfe\x6d\x2e\x02\xd7\xc4\xa2\x24\x93\x95\x54\x7a\x40\xf2\x43\x67\x33\xc4\xc1\x9b\x7b\x6a\xcf\x13\x5e\x4c\x0b\x77\x24\xcd\x77\xae\xe7\xe1\x33\xce\xfd\x62\x23\x5d\x29\x7f\x77\xbe\x12\x81\x9f\x47\xbb\x71\x7e\x65\xdb\xd6\xf4\xb8\x7d\xea\xe6\xa2\x55\x08\x24\x9b\xb6\xa2\x47\xba\xa3\x3e\x89\x41\x54\x40\x5a\x71\x45\xbc\xee\x19\x86\xf1\xb9\xaa\xd6\xe5\x5a\x2a\x6d\xab\x93\xc8\x88\x89\x48\xcf\x8a\x0c\xad\x08\xf7\xb9\x54\x4d\x15\xa1\xb7\x05\x5a\xc3\x73\x97\xa4\xd0\xbd\xa0\x5b\x4e\x11\x07\x12\x0c\xf8\xc0\x69\x0a\xf2\x05\xd7\xb5\xd0\x25\x33\xa8\x66\x09\x27\x27\xa4\x4e\x1e\x76\x29\xe0\xcf\xcf\x2e\xdf\xc3\xf9\xa6\x15\xc2\x2d\x39\x3d\x2a\xf8\xee\x6d\x33\x8a\xaa\x00\x82\x5f\xe8\x4f\x15\x5d\xe1\x6c\x81\x62\x41\xbe\x45\x47\x6d\x39\x2b\xb7\x19\x95\xd3\xa7\x07\xec\xa8\xe2\xb1\xb6\x7c\x55\x4d\xe1\xc4\xa9\x6c\xd8\x4c\x97\x66\x8b\x55\xce\x8f\x91\xf2\xc3\x5d\x9f\xcd\xb0\xd8\xc5\x79\x69\x64\x94\xd9\x8b\x13\x45\xc4\xfa\x90\x3f\xef\xdd\x29\x64\x0d\x3e\xb0\x71\x3b\x37\x72\xcf\x67\x47\x2d\x19\x71\xce\x1b\x1c\x25\xcb\xca\xd7\x76\x6f\x23\xdb\xd5\x5d\x02\x6d\x44\x07\x5b\x5e\xfc\x46\xbc\xa6\x72\xfa\xe5\xd2\x05\x9b\xbb\x28\x55\xf0\xba\xe9\xf9\x9d\xde\xde\xd4\x7c\xd2\x35\x83\x16\x2e\xd9\x67\x76\xd1\xd5\xb5\xee\xc5\x65\x63\x8a\x4c\x3d\xf4\x2d\xd2\xed\x26\x50\x3d\x49\xbb\xe2\x68\x56\x0a\x25\x0b\x1b\xbd\x36\xe9\x8d\xfd\xec\xfd\x2b\xd2\x69\x93\x53\x5c\xd4\x36\xb0\x05\xba\x7d\x0c\xf8\x97\x12\x00\x88\x94\x2d\x7a\xde\xf8\x14\x0b\xff\x5a\x05\x8e\x88\x34\x6a\x1a\xc0\x92\x82\xaa\xf3\xe4\xe7\x62\x62\x83\x80\x3e\x2c\x97\xea\x15\x7b\x2a\x74\x59\x80\x29\x17\x5f\xc9\xd8\xb9\x3c\x93\xe4\x57\xb3\x0a\x85\xea\x8e\x13\xd9\xc6\x65\x14\x12\xba\x85\x17\x05\x0e\x43\xcd\x66\xa8\x4b\xd9\x5e\xaf\xa3\xbd\x43\x37\x76\x28\x22\xa7\x4f\x38\x19\xef\x2d\xc2\xe6\xf1\xec\xda\xe3\x7e\x70\x5a\x3e\x57\x35\xf1\x05\x9e\x7a\x71\xeb\x61\x2c\x1e\x1b\x2c\xca\x40\x70\xd4\x5b\xe5\x7c\x65\xfe\x51\xef\xc1\x19\xf7\x22\xa0\xb4\x45\x96\x68\xb4\xf8\xd8\xf6\x77\xaf\xec\x86\x78\xea\x79\xbb\xf7\xc6\xb4\x53\xc2\x6f\xbe\xe9\xc5\xc0\x9c\x03\x85\x56\x34\x09\x1a\x5d\x56\xf1\xd7\xf9\xb1\xef\xcd\x9d\xf1\xb9\xd0\x5b\x47\x45\xe0\x82\x14\x3f\x05\x97\x27\x1b\xd8\x1d\x79\xb9\x7c\x09\x9f\xbc\x48\xbe\xba\x5c\xb0\xd2\xda\x4c\x1b\x79\x4e\x42\x94\x2f\x42\x5c\xf9\xce\xc5\xc2\x7f\x94\xf3\x62\x5a\x01\x9b\xaf\x5d\x6b\x0f\x2e\x19\xe0\x41\x11\x3a\x8d\xca\x81\x08\xd1\xd5\xb2\xa2\xb9\x06\x15\x3a\x2c\xd9\x9c\xd7\x8c\x2f\x13\x79\x37\xf1\x22\xbf\x07\x27\x9f\x9e\xd2\x49\x2c\x74\x06\xf1\xd9\xf3\x76\xe5\x2a\x8f\xb7\x4d\x74\xcd\x6a\x72\x6d\x7d\xe7\x62\xc9\xcb\x29\x73\xc3\xb5\xda\x7a\xdd\x23\x1b\x5c\x23\xb5\x62\xc9\xae\x7a\xe5\xc8\x69\x53\xf5\x48\x97\x6a\x85\x3f\x58\x32\x98\x89\x62\x85\x99\xb3\x1c\x93\x90\x52\xd4\x2b\x48\xd1\x23\x63\x59\x02\x6b\x82\xe9\x23\x45\x5e\xbc\x31\x7d\xac\x96\xa2\x37\xc1\xf4\x2d\xe3\xa3\x40\x5b\xa6\x6f\xcb\xfc\x3b\x77\x6b\xca\x7c\xf8\x9e\xd4\x70\xe6\x3e\xcb\x8d\xca\xa3\x2b\x0e\x3d\xae\xc2\xf2\x4c\x33\xee\x41\xe0\xcd\x19\x14\x45\x4b\xef\x45\x01\xb0\xe5\xc0\x12\x53\x7e\x5a\xba\x34\xf7\xd6\xf5\x7a\x71\x1b\x6c\x80\x4d\xf8\x93\x3b\xd2\x82\x70\xcb\xb5\x97\x80\x12\x77\x4a\x94\xde\x15\xd5\xdb\x22\xf5\x50\x85\xdb\x32\xca\xf5\x45\xf8\x93\x14\x00\x98\x78\x9c\x57\x21\x9d\x5d\x05\x76\xea\x0b\xdc\x1c\xf4\x63\xbe\x0d\xe8\xe6\x00\x57\xf5\x9d\xf1\xe4\x68\x41\xe2\xe3\x25\x20\x8c\x19\x20\xa3\x9d\x5a\x2b\x31\xf1\xb2\x76\xb7\x20\x40\x9c\xe2\x71\x09\x06\x08\x39\x40\x7a\x8d\x1e\x13\x96\x48\x51\xc2\x15\x8d\xf0\x27\x45\xbe\xc4\x10\xa4\xe8\xe1\x93\x21\x18\xff\x44\x26\xe6\x09\xa2\x7c\xf4\x2d\xfe\x4b\x3b\x43\x82\x73\x71\x4f\x61\x2b\x17\x3d\xcb\xb3\x5a\x28\xf8\x8d\x82\xfe\x17\xba\x7e\xca\x79\x66\x68\x61\x25\x95\x1d\x84\xf3\xdb\xfb\x37\x7e\xa5\x1b\x27\x83\x42\x05\xf8\x4d\x41\x46\x99\x3a\x8c\x96\xd0\xb2\x26\xa7\x8f\xa4\xaf\xc4\xa4\x37\x6b\x2f\x8d\xd4\x9a\x0a\xc3\x19\xfc\x26\xdd\x3a\x30\x4b\x40\xb7\x9c\xa8\xc2\xa4\x7e\xfc\xf8\xf1\xbd\x7a\xff\xee\xe6\xa3\x22\xed\xd6\xe8\xed\x76\x6b\x7c\x58\xaa\x3f\xed\x0c\x5e\x37\x68\x1c\x4e\xfc\x13\xb7\x5e\x0f\xa4\xa0\xf9\x83\xdb\x86\x6b\x75\xe4\x87\xf0\x4e\xf7\x2d\x33\xbb\x4a\x67\x3d\x22\xf2\xa3\xd8\x3c\x6a\xe7\x02\x3a\x00\x55\xe1\x60\xd6\x76\x73\x42\x73\x4b\xdf\xab\xbd\xf3\xd9\x5f\xd2\x37\x9a\xc1\x9f\x3e\x6a\x30\xbc\xe7\xa3\x62\x3d\xa7\x9e\xfc\x30\xd8\xd6\xc0\xcc\x96\x1c\x3a\xca\x2c\xa9\x7e\x66\x83\x4d\x86\x67\x0c\x5a\x39\x6f\x65\xc5\x5f\x86\xb9\xe8\xb8\x15\x16\xa8\x26\xc6\x9f\x45\x6b\x0d\x09\x6d\xf4\x00\xea\x7e\xd2\x86\x7c\x90\x70\x7b\x1f\xcc\xe0\x61\x54\xcb\x48\x7a\xec\xdc\x16\x50\x3a\x05\x9f\x95\xfc\x7d\x0f\xb8\x0c\xc1\x8d\x81\x3e\xa9\x03\x4c\x37\xad\x88\x84\x10\x66\xd3\x04\xf6\xe1\x2a\xc3\x13\xa6\x7a\x56\xb3\xe8\x73\xef\xb0\x55\x09\x29\x3f\x61\x0d\x0b\xc3\x43\xd4\x71\x08\x40\x9c\xd9\xbe\x3e\xb3\xe7\xd1\x0e\xbd\xf9\x74\x20\x1b\x0b\x2e\x3a\xae\xc0\x9b\x70\x70\xfd\xb9\x0a\xbe\x1e\xdb\x54\xdc\x57\xe1\x46\x5b\xe2\x77\xd4\xb5\x50\x72\x4d\x36\x57\x08\x86\x1e\x9d\xf3\x3c\x60\x98\xb1\x99\xc4\x7e\x22\x44\x4a\xaf\xdc\x5d\x8a\x45\xa2\xdb\x96\x69\x54\x99\x83\x69\x8d\xde\x14\xab\xe0\x43\xfa\xb8\x04\x58\x4c\x10\xa8\x3a\xaa\xa8\xc3\xed\xc8\x65\xb2\x37\x74\x36\x51\xec\x23\x42\xff\xd7\xc1\x0c\x06\x1f\x33\x7b\x4d\x56\x6f\x18\xa2\x28\x98\xb5\xeb\xdb\x50\x18\xd4\xe5\x09\xa7\x09\xb0\x7d\x8e\x03\x36\x69\xd6\x41\x9f\x3a\xa7\xdb\xc2\x4f\xd9\x7b\x4a\x49\x2e\xcd\xee\x2b\xd2\xb0\x25\x4d\xf2\x5b\x4b\x60\xca\x9b\xb5\xb1\x77\x26\x28\x86\xc7\x9b\x47\xaa\xb9\x6a\xef\x6f\x4b\xd3\xe9\x48\x26\x28\xaf\xf1\xc7\xfd\x05\xb6\x83\xf6\xba\x8f\x46\x42\x05\x84\x9d\x3e\xa0\x2c\xa5\x6c\x82\x4e\x8d\xa0\x33\x37\x79\x9a\xe9\xd7\x26\xd9\x1a\x92\x3f\x02\x0c\xfd\x1b\xd8\xd7\x50\x88\x7e\x58\xb3\xb6\x04\xbe\x8a\xb7\x8e\xa4\x77\xbd\x39\x0a\xca\x80\xaf\x55\xee\xb9\x0f\xea\xd6\x98\x83\x02\xb7\x87\x50\x88\xec\x16\x8a\xd5\x84\x12\xe7\xc3\xd6\xeb\x9a\xfd\x72\xae\x77\x0c\x2a\xeb\xfa\x27\xfc\x64\xdb\x98\x3c\xae\xe8\xdd\x99\x84\xf2\x59\x12\x24\x7d\x8c\x2e\x0d\xc5\xd7\x0f\xae\x30\xd5\x65\xa0\xfc\x1f\x53\x4d\x0f\x58\x19\x6e\x7f\xc8\xaa\x1f\xaf\xc4\x07\x25\x8d\x0e\xf4\x5e\xfc\x40\x88\x87\x00\xa2\x03\xa4\x89\xd7\xd5\x60\x89\x43\xb8\xa3\x25\x4f\x55\x2c\x18\xc4\x12\xd2\x7d\x52\xa9\x39\xe8\x28\xbc\xcc\xbd\x6b\xcd\x92\xe2\x3f\x07\xbe\xef\x57\x27\xf2\x27\x97\xe6\x2c\xf1\x26\x3a\xb3\x89\xca\x0d\xf1\x01\x73\x41\xad\x80\xd3\x85\x7e\x7c\xe6\xc0\x4c\x2f\x20\xa0\x64\xff\xfe\x1d\x43\xf3\x9b\xbc\x4c\xd4\xf1\xae\xd3\x51\x52\xa2\xbd\x0a\x74\x18\x86\xfb\xb1\xe6\xa7\x5a\x8d\x57\xd2\x51\xfd\xf0\xef\xc4\x4d\xff\xa6\xb8\x25\xdd\xf6\x0f\xc6\x5c\x5b\xac\xcd\x9e\x17\x74\x41\x05\x7e\x51\xe1\xcf\x29\x10\x79\xde\xc7\xd3\x99\x7e\x9d\xed\x44\x3e\x2c\xa7\x20\xe8\x20\x02\x5e\x9f\xed\x69\xaa\xe8\x9e\xcc\x58\x45\xdb\x5d\x69\x6f\xd4\xc1\x1d\x8d\x4f\x7c\x2f\x65\x63\x30\xdd\xe6\x1a\x89\x1b\x58\xfb\xa6\xb5\x85\xcb\xcd\xec\x20\x07\x31\x26\x7e\x8b\x45\x99\x05\xba\x2c\xcb\xe1\xfe\x48\x21\x35\x0b\xf0\xc2\x72\xae\x4d\xde\x6e\x22\xb9\xf7\x44\x93\x10\xc2\x9b\xdf\xf5\x18\x1d\xc0\x9a\xf6\x5c\x51\xe9\x14\x75\x08\x95\x5d\x5a\xd3\x99\x2d\xeb\x96\x03\x19\x5b\x29\xac\x1e\x01\x02\x53\xb7\xa6\x87\x86\x61\xe0\x1b\x0a\xd8\x7f\x9a\x78\x89\x51\xe4\x5b\x0d\x3d\xdb\x3b\xaf\xa2\xde\x1f\x70\xb0\xe0\x0a\x07\x5a\x60\x89\xe6\xfa\x26\xe0\xe5\xd8\xbb\x44\x5d\xcb\xf1\x11\xa0\x54\xb6\x38\x2f\x59\x55\xe9\x5c\x16\x13\xf6\x10\x9d\xaf\x4e\xe4\xd4\x51\xce\xa3\xf5\x13\x5d\x61\x40\x73\xc6\xa4\x41\x4a\x94\x3e\x01\xcf\x8c\xef\xf9\x7a\x31\x02\xf0\xe8\x14\x95\xfb\x43\xb8\x60\xd7\x2a\x68\x50\x52\x4e\xae\xa5\xe4\x50\x3b\xd0\xc3\xdc\xb4\xe4\x72\x05\x6f\x03\x06\xa1\x13\x94\x38\xc2\x77\x18\xee\x1e\x0b\x65\x81\xba\x0d\x58\xcf\x4c\x8b\x50\xe3\x8e\xe6\x1a\xbd\x09\x4c\x21\xa4\x12\x06\x7a\x4e\x9f\x13\x51\x29\x83\x67\xd3\x86\x1f\x2b\x32\xab\x78\x80\xa5\x4d\x03\x4b\x06\x1b\x1a\x88\xb2\x23\xb2\x12\x1e\x56\xa2\x29\x58\x44\xbc\x14\x15\xc5\xe2\x31\x74\xad\x34\xb0\x19\x89\xfa\x6b\x4d\xd4\xb6\x0b\xca\x9b\xad\xf6\x89\xdc\xe3\x07\x1a\xac\x50\x7c\x88\xc1\xb4\x24\x55\x22\xdd\x05\x27\xb8\x68\x15\xdf\xda\xbe\x45\x83\x3b\x1d\xb5\x04\x18\x01\x65\x8e\x1c\x95\x61\x6b\xa2\x1a\x0e\xae\x97\x65\x29\x15\x61\xdf\xbf\xfc\xd7\x9b\x77\x6f\xaf\xd5\xa7\xc7\xc7\xe3\xf1\x31\x14\x7f\x3c\xf8\xce\xf4\xd0\x97\xf6\x5a\xfd\xaf\x37\xaf\xaf\x95\x89\xeb\xaf\x96\xea\x0d\x3d\xdf\xf2\xab\x88\xef\x44\x8c\x59\x8b\x0c\xab\xc1\xff\x03\xcf\x3a\x39\x9b\x49\x9d\x5a\xe8\xc0\x4a\x7f\x9a\x67\xb5\x41\xa5\xa0\xa7\x32\xab\xea\xe3\xe9\x50\x2c\x81\x60\xd6\xde\x10\x5f\x0b\x7e\x8c\x33\x32\x61\x8e\x60\xb2\x50\x61\x95\x2a\x1d\xd4\xcd\x8f\xcf\x7e\xf7\x2f\xff\x53\xfd\xf8\xe6\xd9\x73\xb5\x33\x9f\x54\x6b\xc5\x10\x9e\xdb\xa7\xee\xac\x4c\xfa\xff\x7a\x0c\xab\xe1\x31\x04\x07\xd7\x71\xf0\xc9\xb0\x9f\xce\xf0\xa2\x6b\xa1\xd3\xeb\x5b\xe4\x38\xf0\xca\xfd\x89\x7f\x8e\x41\xec\xda\xf5\x3c\x00\xaf\xd6\xae\xaf\x7b\x4f\x20\x12\xf9\xfb\x39\xfc\xcf\x99\xb8\x66\x12\x23\x60\x67\x7a\x15\x76\xe8\x60\xb8\x7a\xe3\xae\x8c\x2c\x01\xd3\xfe\x61\x5c\x18\x9d\xe4\xb0\xab\xc7\x7f\x1d\xc8\x63\x12\xf7\x14\xb2\xa4\x77\x08\x3c\x2e\x8b\x9c\xba\x42\x01\xe3\xa9\x7a\x45\x6c\x74\x51\xfe\xc8\x79\x49\x01\x64\x8c\x83\x55\xf1\x9e\xaa\xd7\x26\xaa\x7d\x52\xcd\xc3\x35\x4e\xd8\x26\x25\xea\x78\x40\xf3\xd9\x32\x28\xec\xaa\x02\x8f\xef\xad\xc4\xca\x99\x0e\xe0\x19\xef\x5c\x65\xf6\x3c\x46\xca\x9b\x60\x64\x09\xf7\x4b\xe7\x6f\xe7\xb2\xb2\x66\x76\xba\x11\x36\x28\xfc\x9e\x9b\x1d\x76\x0b\x33\x3b\x71\xc5\xa5\x2e\xf6\xa5\xa5\x7a\xcf\xb8\x4c\x12\x0d\xbc\x1a\x49\x8e\xca\xec\x74\xea\xc3\x17\xc6\x10\x63\x01\x4c\x80\xff\x14\x80\x0c\x7e\x51\x10\x93\x6b\xf6\xa4\x0f\xbf\x86\x3e\xff\xa6\xe8\xec\xac\x66\x22\x9f\x18\x44\x09\x3e\x53\x8c\x9b\x16\xf5\xbf\x5b\x93\x13\x96\xd3\x8e\x56\xbe\x01\xaa\xf0\x96\x17\x40\x93\xd2\x70\x69\x4e\xfc\xff\x7c\x6f\x5a\x33\xea\x1b\x98\xed\xed\xbc\x83\x10\x57\xed\x72\x76\xc4\x8b\x10\xaa\x34\xe6\xcf\xe9\xfb\x22\x70\x3d\x4b\x82\x81\x17\x78\xee\x8e\xf3\x33\x1e\x60\x08\x1d\xbf\x3b\x71\x19\xe2\xaf\x33\x00\x79\xb1\x8a\x4b\xc9\x55\x67\x51\x06\x67\xfb\x91\x2f\xcf\x09\x02\xc9\xaa\xd6\xfa\x79\xb0\x99\x7d\x51\xa8\x5b\xa0\xf4\x8f\x74\x32\x2a\x7e\x3d\x8b\xcb\x9e\x8d\xe4\x71\x94\x91\x3d\x99\xbd\xb8\x70\xed\x12\x1b\x3b\x9d\x92\xf9\x9e\x94\x9b\x82\x19\x1c\xc4\xbb\x3d\x9a\xae\x1b\x71\x6c\x01\x78\xa4\xf8\x70\x1c\xf3\xf9\xc6\x3a\x0f\x4c\x8e\x64\x66\x2f\x93\x23\x13\x86\x26\x03\x8e\xea\x98\xf0\x11\xa7\x3e\xbd\xf8\xe4\xca\x35\x9c\x63\x99\x72\xb4\x0f\xee\x25\x79\x86\xff\x80\x69\xc2\x61\xb4\xe5\x71\x81\x2d\xe1\xfb\x18\x49\xad\xfa\x32\x86\x01\xa1\x7b\xab\x24\xa2\x80\x95\x5d\xc9\x2f\x6e\x00\x04\x19\xf8\xb6\x8f\x66\xeb\x13\x57\x19\x59\x90\x67\x8c\x42\xdb\xa6\xb5\x01\x64\xa1\x97\x71\xbf\x20\xa0\xbf\x07\x7b\xbf\x8d\xba\xbb\xbd\x0f\x3d\x41\x7d\x1e\x7e\x1a\x93\xc8\x21\xc9\x3f\xc2\xff\x71\x66\xeb\xf6\x1a\x1d\xd4\xbe\xc0\x1f\xe3\x6c\x78\xa3\xf6\xa4\x6a\x46\xbf\xca\xb9\x86\xc7\x4a\x73\x6b\x4e\x1c\x26\xa2\x73\x27\xf5\x6f\xe6\x14\x66\x41\xf2\xb6\xf8\x66\xf5\x2d\x9c\x37\x0e\xb8\xfe\x71\xbd\xd3\xbf\x42\x31\xd4\xab\xa4\x1a\xdc\x39\x77\x2b\x41\x1f\x99\x3b\x79\x30\x3e\xa0\x27\x3f\xde\x97\x80\x30\xd9\x21\xeb\xb6\x65\x11\xfb\x54\xa2\x03\x43\xc7\x32\xa0\x4a\x82\x33\x9e\x83\xd4\x4e\x1e\xfb\xdc\x9b\xb9\xce\x64\xfe\x3e\x42\xe1\x08\xe0\x1b\x27\x69\x7b\x88\x6c\x4c\x7d\x94\x17\x17\x3e\xd7\x76\x86\x6c\x03\x74\xc8\x5d\x92\xe6\xdd\xdc\xfc\x88\x98\x8a\xa6\x51\xf0\x84\x62\x90\x25\xde\x2b\xc4\xbe\x63\xc1\x4e\x7f\xe2\x57\xe3\xb8\x70\x1d\xa0\x70\xae\x17\xf9\xf5\x32\x79\xb8\x40\x36\x6c\xf1\x66\xa0\x10\x8e\xb9\xa7\xe9\x61\x95\x4f\x81\xda\x6a\x00\x8a\xa2\x75\xf6\xb4\x68\x66\x45\x9f\x8d\xc8\x55\x4d\x0b\xa0\xaa\x8f\xb8\xdc\xd5\x11\x33\x99\x46\xe3\x8c\x40\xa3\x9a\xb9\xb1\x34\xe7\xde\xa9\xbe\x14\x90\xaf\x2d\x3b\x97\x05\x3b\x65\xf8\x3d\x5a\x09\xa6\xf0\x85\x51\x6c\xd5\x07\x08\x76\xe6\xda\x52\xba\xfc\x4d\x0d\x78\xa8\x78\xc7\xee\x81\xa9\xd2\x98\x4f\xf0\x0f\x2e\x74\xfc\x56\xff\x03\x4c\x18\x9d\x2f\x89\x00\x81\xa0\x8c\x19\x1b\x78\x82\x48\x43\xe3\x8e\x3d\xbe\x5d\xb4\xfa\xf3\xb3\x37\xaf\x73\x94\x3e\xb7\x21\x02\x06\x45\x7c\xf4\x36\xbb\x16\x8f\x20\x85\x73\x8d\xb1\x41\x60\xda\xb1\x73\xae\x36\xf8\x65\x85\x79\xc7\x52\x22\x29\x2a\xb4\x68\x7a\x94\x05\xaf\x61\x39\x1e\x81\xdc\xf5\x69\xc7\xec\xbe\xec\xd8\x4f\x87\xd9\x6e\x51\xef\x85\x39\x28\x36\x1d\xb5\xa5\xc7\x81\xc3\x1b\x0b\x73\x3a\x47\x38\xee\x4e\xa2\x1b\x34\x69\x59\x23\xa5\xd0\x02\x0d\x7f\x71\x08\xca\x73\x90\x55\xc0\xae\x09\x47\x5c\x68\x8a\x3d\xb3\xb7\x27\x63\xf9\xfd\x27\x0e\x53\x90\x46\x3c\xd9\x71\xf5\x2e\x0a\xdf\x2d\x75\x5c\x7b\xe6\x23\x0f\x7d\x74\xc3\xba\xf6\x59\xcc\x0d\xa3\x67\x70\x18\x79\xe3\x03\x8e\x5e\xc7\x8c\x18\x9c\xba\x72\x86\xf0\x24\x11\x5f\xd8\x52\xd9\x14\x33\x8e\x1d\x99\xa3\x75\xa7\xb3\x03\x93\xfc\xa3\x72\xd4\xdc\x83\xf6\x31\x07\xf4\x2a\x7b\x42\xe6\x8c\xed\xd7\x13\x14\xc8\xf1\x78\xaa\xfe\xcd\xf6\xed\x24\x8f\x5f\xd8\x35\x5b\x48\x1a\x28\x6e\x0b\x9f\x8d\x7c\x15\x16\x78\x93\xdd\x23\x59\x2b\x86\x73\x2b\x10\x61\x89\xfa\x07\x11\xd1\xca\x74\xf3\x20\x99\xe2\x9a\x90\x5a\x25\xd8\xd8\x85\x5c\xe5\x2d\x7b\xec\x23\xa8\xea\xce\xf9\x57\x70\x0d\x76\xd6\x5d\x7a\x0d\x06\x31\x9a\x60\x6a\x6e\xed\x61\x02\x02\x1b\x89\x5e\xf7\x45\x3c\xa4\x28\x1e\xd2\x08\x68\xbc\x26\xa7\xcb\x84\x58\x7e\x63\x3f\x90\x3a\x95\xc8\xb8\xa6\x65\xb3\x76\xda\x0b\x81\xce\x5a\x69\xb5\x54\x96\x4b\xec\x6d\x08\xa0\x1d\xc5\xcb\xfe\x81\x2b\x7e\x16\x55\x3e\xdc\xe5\x5c\x2a\x18\xa8\x04\x23\xd2\x67\xd0\x64\x94\x40\x20\xa5\x36\x12\x9c\x08\xde\xec\x6d\xcf\x4c\xfe\x0f\x98\xa4\x3e\x48\xd2\x79\xe0\xfc\x20\x82\x04\x79\x9c\x52\xcb\x27\xd1\xbd\xf9\x98\xc8\xad\x2b\xe3\x75\x8b\xcb\x53\xc0\x3f\xf6\xda\x46\xfe\xc7\x90\x42\x09\x14\x78\x87\xfa\xf3\xe8\x4f\xaf\xde\x7f\xfd\x48\x39\xaf\x1e\xfd\xfc\xa7\x57\xef\x7f\x79\x54\xc6\xf3\xba\x66\x19\xa5\x74\x2b\x44\x77\x20\x81\x26\x2c\x04\x69\x69\x32\xb2\xb9\x30\x22\x4d\xe9\x93\xe9\x63\x7d\x18\x16\x3e\x13\x87\xc0\x32\x46\x2a\x5f\x54\xed\x36\x6c\x46\x5a\x38\x01\xca\xb3\x45\xb1\x80\x71\xe9\xce\x3a\xc2\x04\x94\xae\x37\xb3\xbe\x84\xc6\x8c\x7a\x09\xea\x7e\xa9\x37\x06\xad\xbd\xdb\x6c\xf7\x3d\x6e\xef\x85\xb2\xf4\xab\x21\x6d\xf2\x3c\xed\xf8\xf9\x25\x86\xb2\xfa\xea\x52\xcd\x61\xad\xd1\x20\x59\xca\x7f\xcf\x09\x82\x41\x1c\x8c\xe8\x4e\x7d\x26\xb2\x22\x9c\x92\x8b\xc0\x84\x2e\xa6\x08\x2d\x21\x43\x72\x54\x21\xfd\xb5\x81\x5d\xe9\x8b\x71\x0d\x35\x82\x20\xa8\x16\x9c\x25\x15\x9d\xfa\x27\x85\x42\x6d\x98\x0e\xa9\xf7\xd2\x10\xb3\x78\x5b\x14\x8e\x31\x44\x0d\x91\xed\x9c\xc3\xf1\x2e\xd1\x56\xcf\x49\xf4\xb0\x72\xb5\xba\x5e\x1d\x8d\xb9\x35\x7d\x7b\x69\x3a\x0e\x8e\x1d\xe1\x11\x5d\xfe\xde\x85\x58\xe0\xe0\x33\x2f\xda\xbd\x11\x47\xe8\xd5\x76\xbc\xb8\xe2\xe5\x44\xfb\x11\xe6\x81\x95\xe3\xf3\x5c\x17\x7e\x58\x28\xd8\xd2\x6f\xa9\x33\x3b\x81\x36\xf5\xcc\x66\x65\xd3\xde\x6c\x75\xb4\x77\x17\x87\xaf\x34\x11\x1b\x6d\xa5\x89\x87\xf0\x73\x16\x25\xd3\x93\x8a\xb7\xc6\x65\xac\x33\x1b\x28\x9f\xd1\x4c\x43\x4e\xb2\x6a\xee\x7d\x10\x11\x84\x17\xbf\x16\x6d\xf6\xc4\xfb\xff\xfa\x4f\x2e\xb3\x7c\xfb\xec\xcd\xf7\xc9\x8f\x03\x4f\xd5\x21\xcb\x17\x70\x38\x09\xb6\xa4\x59\x73\x7c\x49\x34\x2c\x38\x38\x7a\x35\xe0\xda\xe6\xd2\x44\xd7\x26\x86\xd0\x52\xfd\x91\x2e\x13\x48\x35\xfd\xda\x9f\x0e\x12\x2c\x8a\x16\x74\x6b\xc3\xa1\xd3\x27\x09\x4a\xc0\x33\xc6\xb1\x0a\x4d\x5c\x66\x0d\xbe\x51\x6c\x1c\x52\xf9\x18\x82\x51\x21\x13\xd7\xb5\x20\x91\x0d\x1d\x4a\x04\x23\x15\xe2\x73\x38\xce\x78\x4c\x93\x11\x67\x87\xb8\x74\xe3\x13\xe1\x99\x30\xd4\x6e\x70\xa5\x04\xaf\x97\xc6\xf5\x89\xda\x68\x95\xeb\x67\x00\x03\xd0\x3b\x40\x0b\xfc\xc4\x04\xa6\xb4\xff\xeb\x29\x70\x60\xa1\x4e\x3c\x23\xd8\x09\xf3\xa4\x5f\x99\x99\x8f\xae\xd7\x26\x46\x74\x90\xde\xda\xad\x65\xbb\x4f\x34\x2e\x09\x6b\xe7\x41\x14\x8c\x07\x05\xdc\x7f\x56\x64\x75\x9a\x60\x97\x88\x9f\xe6\x78\xad\x83\x51\xb6\x0f\xa6\x0f\x16\x76\x1a\xad\x24\x94\xa3\x2a\x0b\xfb\xe6\x80\x1b\x32\x98\x74\x07\xc1\xa4\x18\xa1\xe9\xb1\xbd\xc9\x6f\x2f\x34\x03\x89\x91\x99\x11\xc5\x74\xb0\x93\x83\xff\x67\xb2\xeb\x60\x7b\x54\x22\x1f\x06\xb0\x0d\x47\xeb\x8e\x5c\x94\x87\xd9\x29\x94\xa0\x04\xc5\x90\x66\x63\x49\x00\xc9\xe6\x39\xa2\xe6\xdf\xdd\x37\xa4\x85\xf1\x5c\x41\x59\xc8\xb0\xce\x4e\x78\x45\x72\x61\xb5\x57\xa1\xd4\x1a\x8f\xe7\xce\xa4\x7c\x5c\x9c\x91\xe7\x54\x00\x15\xdb\xe1\xdc\xea\x1a\xf3\x1b\xe4\x08\x4a\x0f\xb2\xe2\x10\x2a\x56\xf2\x4c\x18\x9a\xac\x8a\x95\x8a\xc8\x0b\x99\x8c\x0c\x1e\xc0\x7c\x98\xb4\xea\xe2\x48\xcd\x31\x1e\x96\x17\x90\x59\xe4\x2f\x55\xb8\xa6\xdd\x58\x99\xb5\x06\x28\x1b\xf3\x05\x5f\x8f\x41\x7e\xf0\xa6\x7d\xfd\xcd\xca\x7f\x5b\x91\xd6\x28\xc9\xd0\x43\x74\x4d\xe7\xd6\xb7\x49\x8c\xf1\x6c\x88\xee\xf1\x6b\xb7\xbe\x3d\x0b\x2a\xf3\x00\x40\xe7\x3d\x17\x01\xf8\x5e\x47\xbb\xd6\x5d\x77\xca\x74\xc7\xa9\xb8\x88\xd8\x21\x0a\x72\x35\x8b\x98\xed\x44\x37\x04\x27\xab\xbc\xb0\xdc\x60\x9f\x1e\x44\x0c\x90\x17\xae\xfd\x92\x1a\x5e\xac\x87\xa1\x87\x66\x9a\x56\xed\x75\x3f\x50\xf5\xfc\x6c\xe7\x74\xda\x8c\x13\x05\x10\x92\x43\x49\x08\x84\x20\x80\xcb\xf3\x23\x31\xa1\x33\x11\x00\xfb\xfe\xb8\xbb\x38\x86\x44\xd1\x31\xf1\x84\x23\x59\xde\x49\xc5\xc8\x7c\x09\x30\x5f\x5d\x68\x02\xfa\xf0\x4c\x0f\x62\x72\xe9\x49\xcc\xa5\x87\x15\xca\xc7\x17\x8f\x23\x9f\x0e\x84\x51\xf8\x4c\xc4\x9a\xf4\x86\x2f\x54\x19\xc8\x72\x92\x25\xb8\x4f\xb2\x35\xa4\x5a\x54\x5f\x7b\x1a\x18\xb7\x25\xd3\x76\x13\x99\xdb\x19\xd0\x22\x5e\xa3\x0b\x74\xc5\xa9\x95\xd9\xe9\x6e\xc3\x56\x0e\x29\x86\x29\x2f\x4e\x6f\xb8\xc1\x93\x26\x42\x72\xf2\x5c\xc7\xe8\x2f\xb4\xb5\x78\x05\xc3\xcc\x09\x82\x73\x04\xe3\x05\x4c\x85\x49\x4c\xbd\x64\x1e\xec\x1f\x60\xb2\x2b\x33\xf1\x77\x16\xe5\x1c\xe9\x27\x56\xe5\x0d\x28\x24\x87\xca\xce\xfc\x23\xa4\x9c\x05\x4d\xd2\x78\xbd\xbe\xdd\x7a\xd4\x45\x21\x14\x53\xde\x64\xde\x9b\xf2\x1e\x66\x6a\x10\xb5\x9b\x95\xf3\xca\x0f\x18\x67\x7a\x49\x75\x22\xe8\xc1\x3b\xe8\x37\xce\x2f\x8e\xaa\x26\x03\x7d\xbd\xf6\x2e\x04\xe6\x28\x67\x3b\x4e\xb1\x18\x3d\xa1\x2e\x18\xd4\xc3\x27\xcb\x71\x67\x3b\x93\x9f\x46\xc1\x92\x5f\xa8\xd5\x10\x4e\xe7\xc7\x61\x9e\xfa\x12\x18\xea\xe8\x85\xd2\x2c\x98\x83\xce\x9c\x07\x4a\xfa\xf4\x37\xf8\xe3\x02\xa0\xed\xd7\x78\x29\xc0\xff\xf3\x60\x85\xfb\xda\xda\x65\xed\xf9\x22\x85\x8d\x84\x68\xb3\x8b\x4c\xb3\x9d\x11\x3a\x8e\x8b\x57\xc2\x7e\xf4\x13\x2e\xfc\x3b\x9d\xfc\x13\x40\xe2\xe8\x59\xa6\x7e\x7d\xd5\x9e\x47\x8a\x6b\x02\x96\xf1\xbf\xe3\x8f\xf3\x80\xbc\x66\xe0\xb5\x43\xbf\xce\x83\x9e\x75\x0f\x31\x0f\x58\x1a\x53\xa0\x79\x4d\xb8\x9d\xba\x58\x40\x5b\xcc\xa4\xc6\x24\x78\xf8\x72\xc1\xe5\xd6\x1b\xd3\x5e\xda\x6c\x52\x5b\x36\x79\x90\xca\xd8\x70\xa9\xda\x25\xba\x3f\xa1\x21\x8f\x2b\xdf\x9a\x33\xfe\x1e\x50\x2b\xa5\x73\x5b\x89\x65\xf4\xda\x6d\xa7\x99\xc9\x2f\x1e\x9b\x70\x6c\xd2\x71\x83\x6a\x97\x95\xa7\xf4\xf2\xad\x93\x04\x56\x6b\x6f\x30\xf0\xbf\xee\x78\x73\x0f\x71\x07\xdf\x6b\x44\x02\x10\x88\x43\x2c\xc2\xc5\x0c\x0d\xcd\x57\xf7\x06\x05\x04\x33\x6d\x6e\xbc\x89\x14\xa4\x8c\x5b\xcf\xc7\xf7\xad\x39\xd0\x76\x16\xa3\xe2\x99\xa2\x73\x3b\xf6\x40\x28\x46\x51\x43\xa4\x04\x1e\x27\x14\xc6\x7a\x26\x17\xcb\x7a\x69\x87\x9f\x6d\xec\x86\x7c\x3b\xce\xe4\x49\xb0\x06\x09\x03\x16\xe6\x60\xf2\x18\x22\x13\x99\x3f\x66\x6b\xda\xbb\x88\x9a\xde\x78\x51\xbf\x97\xf1\x9c\x47\x2a\xf1\xc7\xc6\xaa\x56\xc5\xe4\x4b\xc4\xa2\x17\x63\xe5\xa9\x99\xc6\x35\x21\xec\x58\x12\xcc\x22\xd9\xcb\xe0\x95\xec\x38\x8b\xe3\x2e\x17\x12\x91\xfc\x33\x31\xad\xaf\x24\xf3\x73\x25\x0e\x3a\x84\xa3\xf3\xa4\x8f\x4d\x3f\x2f\x17\x38\x9a\x15\x9d\x6f\xea\xa7\x57\x19\x12\x36\x82\xb7\x87\x5a\xa2\x99\x92\xe0\xe8\x2a\xe5\x42\x12\x09\xf0\x5f\x7e\xfb\xbb\xd2\x2d\x2f\x85\x03\x5c\xce\x63\x4d\x61\x04\xb3\x7f\xc3\x5c\x74\xb1\x00\xed\xe8\xe5\xca\xbb\x63\x30\x4d\x70\x83\xc7\xa3\xfe\x3b\xfc\x56\x37\xf8\x4d\x20\x48\x14\x86\xac\x40\x46\x4e\x07\x2b\xef\xca\x36\xaa\x4e\xf7\x2d\x87\x08\x19\x47\x59\xfd\x9a\xf0\x1c\x34\xdb\xed\xd0\x0f\x46\x8e\x8b\x54\x3d\x65\x27\x84\x94\xd8\xea\xa8\xc9\xe2\x32\x35\x1c\x02\xce\x6c\x36\xe8\x1a\x0c\xd8\x90\xb9\x4b\x4b\x2a\x02\xd1\xf8\x1a\xf8\x85\xb7\x1b\x5e\x6e\x3b\x77\xa4\x42\x70\xcd\x85\x02\x2c\x1c\x3a\x1b\x1b\x16\xeb\xdd\xc0\x87\xfa\xa3\x35\xc7\x02\x62\xe8\x51\xf7\x5c\x60\x7e\xa2\xcf\x12\x0a\x50\xca\xb4\x89\xca\x63\x16\x02\xb0\xfb\x8a\xec\xfa\x0a\x8f\x2f\x81\xbb\x6a\x93\xbb\xe7\x02\x04\x4e\xac\x02\x42\x9e\x66\x19\x82\x27\x0c\x55\x44\xbe\x7b\xf5\x96\x3e\xa1\x85\x12\xca\x1a\x9a\x87\x61\x45\x28\x0b\x52\x1b\x10\x7a\x78\xa2\x66\x9e\x62\x1e\x2a\xc5\xab\x22\xb9\x7e\xd5\x45\xe7\x54\xa7\xfd\x96\x71\x44\xe7\x9a\xbd\xee\x4f\x58\x45\x92\xe0\xd1\xc7\xd1\xf0\xeb\x86\x22\x21\x0a\x1e\xc0\x00\x45\x18\xaa\x30\xad\x20\x39\xbd\xdd\x6c\x08\xb7\xe8\xe1\x43\xcb\x7e\x90\xdf\x94\x85\x7a\xc1\xf0\x0b\x1f\x29\xba\xc5\x79\xcc\x1d\x36\x2d\xf7\x56\xe0\x99\x23\x59\x38\xce\xcf\x23\x99\x47\xd8\x6d\x66\x93\xa9\x9d\x84\x77\xb1\x60\xcd\xb7\x25\xff\x0f\x59\xfb\x2d\xa4\xbc\x1e\xb9\x9f\xa2\x42\xf7\x16\xe5\x39\xf8\x95\x20\x5a\xaf\xd1\xba\xe1\x05\xfc\x4f\xa9\x07\x6f\x72\x31\xf0\x68\x3a\x2e\x56\x18\x61\xff\x91\x7f\x4e\xf2\x2a\xe7\x35\x51\x6f\xf1\x2d\x8e\xba\x8a\x70\x85\x5e\xf1\x3b\x0a\x0e\xc1\x57\x2f\xd0\xd0\x52\x10\x98\x16\x37\x1a\xfc\x4b\x69\x7a\x47\xa1\xac\xe7\xc6\x45\x6e\x12\xb4\xb1\x57\x44\x0b\xf2\xbd\x5c\xb7\x9a\x0e\x0f\x0c\xbc\x83\x2b\x04\xbe\x30\x16\x62\x35\x60\x61\x58\x91\x9e\x34\xde\x6c\xa8\x47\xa8\xd2\x20\x47\xa7\x6c\xc4\x15\x00\xe4\x77\x3b\xac\x63\xdd\xee\xaa\x34\x69\xf3\x19\xd9\x6c\x0a\x68\x8e\xb5\xee\x15\x3c\xcf\x28\x70\x0b\xf3\xa1\x22\x05\x2e\xd6\x85\x8a\x8c\xdd\x53\x1c\x89\x8c\x3e\xea\xad\x70\xbd\x3e\x6a\xe2\xd1\x15\x79\x68\xdf\x0c\x39\xf0\xa3\x2a\x93\x1e\x83\x12\x42\xaf\x60\xf4\x45\xbd\x45\xfd\x4b\x96\xd4\x52\x10\x41\x98\x2b\x71\x05\x13\x76\x45\x03\x2a\xf5\x20\x49\x9d\xaa\x04\xa5\x1c\xec\x35\x52\x0a\xde\x80\x4f\x5c\xd3\x66\x28\x45\xc1\xeb\x3b\xda\x71\xb8\x14\x08\x9e\xa3\x3d\x5f\xf1\x7c\x5e\x93\x0b\x66\x96\xf2\x9f\x0e\x12\x67\x77\x12\xab\xb5\x58\x40\x75\x6c\xf4\x62\x51\xd7\x1a\x12\x29\x07\x76\x31\xd1\xc9\xaf\xe9\x17\x58\xd5\x4f\xf7\x42\xb5\xa0\x6d\x50\x5a\x1d\xbc\x79\x3c\x5e\x64\x05\x7c\x1a\xf9\x3f\x99\x2f\x40\xc6\xe4\x6c\x8f\x86\x74\x44\x10\x96\x4b\x54\xe8\x59\x5e\x53\xd6\xf5\x8f\x51\xc9\x29\x37\x63\x4c\xa0\xa7\xea\x78\x85\xe6\xb5\x3a\xde\xab\x41\xdf\x99\x46\xf6\xf9\x8d\xbe\x33\xa3\xcd\x8e\xcb\x36\x6f\xf7\xef\x61\xf7\x4d\x8e\x09\x89\xfa\x28\x50\xb5\x7b\xc7\x19\x60\x89\xbd\x42\x05\x92\xbf\xab\x31\xcc\xbc\x8e\x94\xd4\x53\xfa\xb4\x24\x0f\x28\x9e\x8d\xa9\xc5\x5b\x62\xd4\xdb\x0b\x4c\xc9\x49\x6d\xa5\x54\x89\xaa\xb8\x47\x05\xaa\xdc\x48\x39\x64\x1a\xed\x40\x09\x1a\x98\xd2\xaf\x99\x75\x4a\x98\x27\x7c\xc9\xe5\x3c\xb6\xb9\x73\x32\x57\x55\x45\xa7\xc3\xbb\x17\x53\x88\x0d\x56\x58\x83\x4d\xcf\x89\x86\x15\xe5\x1a\xdc\xed\x45\x97\xf9\x89\xc2\xb5\xcd\xea\xd4\x4d\x70\x15\x1e\x01\xa5\x0c\x2f\x59\x4c\xcf\x25\x5a\xd6\xe8\x0a\x85\x76\x57\xbe\x90\x28\xb2\x4a\xe1\x34\x1e\x13\xaa\xec\x91\xea\x8a\x64\xe9\xb6\x6d\x20\x9b\xb5\x2d\xe1\x67\x8d\xb5\x10\xa1\xc0\xa7\x8a\x4e\xe9\x10\x4c\x0c\xe8\xf5\xc1\xb4\xca\x74\xc1\x60\xe8\x4a\x8e\xf7\xac\xd5\x41\xaf\x6f\x31\xf0\xb0\xd9\xda\x10\x81\x7a\xa5\xa2\xe4\x32\x1e\x0b\x15\x26\x51\x03\xaa\x74\x09\xc9\xb4\xac\x1b\x9d\xc7\x87\x1e\x8c\xa6\xec\x20\x71\xbe\xc8\x23\x2f\xb3\x0d\x61\x22\x75\xaf\xf4\x2a\xb8\x6e\x88\x46\xed\x62\x3c\x7c\xfd\xe4\x89\x72\x1e\x7f\x06\xf8\xfd\xd3\x87\xd7\xb9\x16\xa1\x16\xe1\xbf\x0d\xd1\xae\x43\x9d\x95\x9c\xde\xca\xa0\x17\x90\x64\x22\x3a\x82\x1f\x69\xe0\x21\x10\x0f\x98\xed\x55\x74\x51\x53\x2b\x67\x3d\x56\xb1\xde\x75\x9e\xec\x64\x7b\x2f\xec\xa4\xe8\x90\xdc\x52\x10\x42\xa0\xae\x98\x2b\x79\xaa\x9e\xe1\x8f\xb9\x5c\xc9\x1c\x37\xf9\xe2\xda\xe2\x61\xc0\x86\x83\xe6\x72\xd4\xdd\xb8\xbc\xb6\xa8\x06\xf6\x02\xff\xb7\x67\x30\xb4\x9a\x60\x4e\xa3\xf4\xde\xe5\xa6\x7f\x2c\x4f\xa9\x14\xfa\x94\x66\xb8\x3f\xe5\xa5\x42\x05\xc6\x23\x00\xce\xa6\x98\x2f\x58\xc6\x14\x29\xce\x7a\x52\xfd\x78\xaa\xf4\xdd\x56\x5d\x95\xfe\xf6\x09\xf2\xff\x9b\x09\x1d\x98\x97\x71\xc1\x66\x6f\x5a\xab\xe1\xfc\xe5\x1f\x33\x28\x26\x65\x10\x1d\xba\x04\x53\x4f\x33\x7a\x52\x3c\x41\x5f\x77\x74\xc8\x2f\x36\xc6\xb4\x61\x19\x86\x15\xbc\xdf\x56\x86\x82\xb7\xf3\xef\xe8\xd4\xb3\xe8\xf6\x0a\x60\x18\x70\xf2\xcc\xe7\xc5\x48\xb9\x73\xc4\x2b\xe5\xa4\x57\x1c\x50\xad\x6c\x0a\xb4\xf8\xd9\xf9\xed\x2f\x0b\xe7\xf9\x50\x4a\xfe\x71\x2a\x9f\x36\x28\x65\x05\x18\x38\xc2\x2f\x01\xbe\x1c\xba\x2e\x43\xd7\x62\xd8\x1f\xbc\xd1\xb1\x96\x52\xf7\x49\xba\x1a\x76\xce\x47\x0e\x98\xb9\x77\x9e\x5e\x77\x6c\xd3\xe5\xfc\x36\x71\x16\xaa\xea\x16\xf0\x4a\x6e\x72\xf0\x73\x16\x3f\x2f\x38\x5a\x21\xf8\xc6\x82\x1f\x0b\x71\xea\xe4\xf6\x86\xf8\x35\xe8\x10\xc9\xe0\x83\xc6\xf5\x66\x51\xc5\xf3\x5b\x74\xee\x68\x7c\x23\xb1\xfc\x9e\x4a\x54\x3f\x4e\xaf\xe2\x33\x3c\xad\xb8\xc1\xd2\x5e\xa0\x78\x01\x65\x6e\x34\x0c\x38\x20\xc7\x51\x99\x3e\xf8\xb1\x01\x89\x10\x85\x92\x38\x84\x98\x7a\x09\x3a\x8f\xed\x9f\xdd\x00\xe4\x10\x45\x39\x10\xd1\x49\x74\x6a\xcf\x8c\x2d\x4c\xc4\x36\x59\xf1\xbd\x1e\xc4\x3e\x3b\x55\x53\x10\x57\x3b\x4d\x2f\xc2\x54\x4c\x77\x1d\x85\x16\xfb\x03\xc1\x1f\x8c\x47\x65\xbb\x4c\x6e\x60\x99\x9c\xac\x3a\x73\x67\xba\xca\x30\x11\x11\xc1\x86\xfe\xc3\x62\x21\x7e\x92\xd7\x34\x8c\x2f\x8a\xcf\x2a\x4f\x3c\x65\xbd\xb4\x7d\x5b\x0d\x76\xf6\xe2\x5a\x2e\x28\xb6\x05\x87\xf2\xca\xf5\xf9\x3e\x5a\x92\xb2\x50\x85\x20\xbb\x74\x4f\x9e\x15\xf3\x2d\xb5\xac\x5b\xc1\x7e\xa4\x6a\x17\x52\xef\x09\x37\x07\x09\xc1\x0b\xd0\x1b\xbd\x86\x35\xae\xc0\xd8\x78\x58\xc1\x56\x7d\xb2\x75\xdb\xf0\x64\x6b\xe3\xe3\xbd\x6b\x87\xce\xd4\x88\xcf\xd9\x4a\x55\x40\xbd\x6b\xbc\x09\x43\x87\x7b\xfe\xad\x2b\x85\x1c\x04\x17\xaa\xce\xe6\x80\xc8\x60\xb4\x32\xea\x49\xf4\x43\xbf\xe6\xfd\x82\x63\x12\x53\x88\x16\xa9\x83\xf7\xe3\xb1\xbf\x56\xbd\xf6\xde\x1d\xf1\x5c\x47\xc0\xc0\xf1\x28\x9d\x0a\xc6\xa0\x8f\xa7\xe5\x62\x01\x46\xc2\xcb\x9e\xa2\x34\xa0\x77\xe5\x76\x7c\x2c\x40\x66\xa2\xed\x04\x68\x59\x14\x94\x30\xd2\x85\xab\xad\x59\x1c\x87\xb9\xe0\xd2\x04\xcf\xe8\xf2\xe6\x28\x1a\x83\x7b\xea\x4c\x23\x16\x45\xa0\xb9\xa9\x0a\xba\x78\xd0\x7e\x2a\xe1\xb6\x73\x56\x3a\x0b\xd5\xd3\xe2\xdc\x2b\xdd\x33\x70\xc8\xbd\x3f\xd1\xaf\x9c\xd5\x39\x76\xa5\x85\x62\xd8\x51\xe0\xc9\xcf\x88\xc4\x57\x83\x16\x94\xf8\xbb\x59\x6f\x82\x0f\x95\xf2\xb1\xc3\x0c\xe7\xb7\xff\x58\x7c\xbf\x33\xba\x46\xdc\x6a\x7d\xa7\xa3\xf6\xe7\x1a\x4d\xb9\xd2\xf6\x07\x37\x7d\xec\x2b\xff\xdd\x6c\x6c\x4f\x82\x6a\xc4\xe6\xa7\x7e\x7a\x5d\x2c\x52\x8c\x45\xdd\xbf\xec\x04\xa1\xf0\x55\xcf\x2a\x1e\x12\x6a\xdd\x86\xfb\xdd\xe3\xff\xea\x9c\xf3\xe6\xa2\xb5\xe7\x9d\x38\x33\x28\xdc\x32\x42\xbb\x56\xa3\x7a\xb1\x44\xf9\x66\x72\x23\x8f\x76\x14\x22\x80\x7c\xd9\xc9\xab\xae\xe8\xe9\xb5\x6a\xef\x55\x62\xa9\xdc\x91\xc0\x7b\x23\x29\xcd\xe0\xa1\x2d\xe3\x97\x8d\x31\x41\xf4\x22\xe3\x35\x16\xbc\xe6\x91\x13\x2f\xc1\xa3\x46\xd7\xbe\x3b\x03\x7b\xe7\xac\x13\x93\x30\xd9\xf1\xa1\xc7\x43\xcc\x46\x42\x7e\xc0\xe7\xca\xf3\x57\xa5\xa5\xdf\xfc\xbd\xc3\x16\x66\xa2\xf0\x64\xb7\x3d\x11\xfc\xc9\x5d\xf1\x4f\xaf\xae\x2b\x69\x15\x11\x80\xa4\xf5\x50\x08\x1f\x48\xa9\x05\xf3\x46\x37\x53\xd9\x79\x2a\x87\xfe\x44\xc3\xa8\x9f\x4b\x3e\x8f\xbe\x73\x51\xd5\x3e\x07\x30\x97\x5c\x3e\x66\xee\x15\x93\x8f\xd0\x32\x27\x2e\x4e\xa8\x77\xe6\x31\x80\xe2\xa8\x58\x94\x65\x20\x6b\x52\x33\x27\x09\xba\xb5\x72\x44\xaf\x99\x4f\xeb\x6e\x68\xe5\x59\x9d\x82\x5a\x39\x5f\xbc\x96\x26\xad\x34\xc7\x9a\x38\xfa\xce\xc5\x11\x08\xd3\x52\xf9\x78\x80\x2e\xd5\xd6\x66\x22\x75\x3a\x73\x16\x20\x9a\xb3\x3a\x61\x39\x97\x0e\x62\x40\x3f\xdd\x1e\x15\xd4\x3c\x63\x65\xe5\x78\xf1\x92\x4f\x51\xa4\x94\x58\x33\x7d\x3a\xb5\x3c\x69\xa2\x76\x42\x89\x2c\x07\x21\xd1\xbc\x09\xa9\x5f\x6c\x83\xb3\x72\x85\xc8\x17\xd9\xed\x32\x0d\x3a\x6b\x99\x05\xe3\x1f\xa2\x49\x56\x8c\xc9\x68\x68\x1f\xaa\x3e\x46\xf3\x37\xec\x1b\xea\x0a\xd2\xf5\xba\x67\x8d\x98\xab\x9a\x46\x1b\x95\xba\xd7\x2e\x9c\x26\x5e\x63\x90\xe2\x56\x78\xca\x1f\x8c\x6e\x47\x61\x1d\x13\x1c\x6a\x0d\x26\x8d\x06\x13\xc7\x43\x39\x53\x44\xf4\x79\x50\x37\x20\xe9\xff\x10\x73\x76\x56\x89\x1b\x4b\x8a\x0b\x19\xe8\xea\x8c\x55\xcd\x04\xa6\x18\x5c\x1c\x1e\xc0\x5f\x74\xbf\x5e\xc7\xf7\xdc\x69\x88\x9c\x46\x3b\xab\x23\x62\xde\x64\xe5\xc8\x6a\x82\x0d\xdb\x6f\x27\x9d\x22\x7f\x32\xd4\x09\x18\x62\x42\xd1\x9a\xa5\xfa\xa9\x27\xa5\x09\x58\xbc\x63\x17\xc5\xbc\xae\x61\x29\x62\xd1\x71\xd3\x68\x79\xa7\x93\xbd\x5e\xf3\xbe\x78\xef\x1e\xf5\x49\x6c\x52\x70\x51\x47\x57\x9d\x89\x85\xa0\xff\xd9\xfb\x57\xd8\x17\x60\x47\xa2\xb1\x06\x38\x34\xad\xb4\xd0\x0f\xae\x39\xb8\xce\xae\xeb\xa5\xa4\xde\x63\xda\x2c\x60\xb2\x81\x83\x2f\xcb\x96\xa0\x3d\xea\xab\xdf\xf3\xaa\x10\x77\xb7\x49\x0b\x16\x36\x62\xf4\x76\x4d\xfa\x85\xba\xcf\xb6\x24\xc1\x44\xd8\xb6\x48\xf8\x91\x7d\xc4\x7c\xab\x97\xe3\x00\x15\x2f\xe1\x9b\xa2\xda\xd4\x8d\x3a\xd5\x51\x29\xce\x62\x5b\xd9\xb6\x29\x42\x61\x30\x99\x86\x88\x57\x16\x5d\xf5\xc1\x6c\x57\x7d\x25\x33\xee\xcf\xc3\x98\x9d\xff\x77\x27\xb4\x9f\xb4\x22\x07\x9e\x0c\xe5\xb9\xc0\x1a\x67\x46\x44\x9c\xf1\x37\xab\x53\x83\x43\x57\x88\x05\x4a\x47\xfd\xf7\x8f\xed\x5e\x7f\x22\x8d\x04\x14\x84\x5b\x92\x31\xbf\xd1\x9f\xce\x9c\x4d\x97\x8b\x16\x5b\x0e\xba\xf1\xf8\xb7\x65\x34\x41\x42\xfe\xe0\x49\x9f\xc1\xbe\x42\x1d\xb6\xa7\xea\x26\x17\xa6\x07\x4d\x8a\x20\x3a\x3a\x53\xcf\xa0\x26\x6c\x6e\x88\x8d\xdb\x24\xa4\xe3\x3e\x0b\x81\x62\x3e\xad\x8d\x69\x01\xf3\x83\x9b\x2e\x4f\x8d\xb9\x28\x43\x04\xf2\xe0\xf3\x2c\x45\x88\x07\x2c\x43\x87\x0f\x9b\xb9\x88\xfa\xc9\x08\x0e\x60\x10\x9e\x59\xf8\x55\xf0\xff\xaa\x7f\xb6\x9f\xee\xde\x71\x84\x65\xb2\x88\x43\xa9\xf5\xd8\xf6\x79\x49\xae\x3c\x69\x6b\x87\xa8\xa0\x29\xb2\xc8\xe7\x3a\xc0\x74\x0c\x0a\x81\xb9\x23\xb3\x50\x73\xa6\x0a\x39\x9b\x86\x99\x1e\xb6\x61\x14\xab\xe6\x5e\x78\x19\x24\xb8\xc7\xf4\xe3\x60\x0e\x9a\xc4\xea\xdb\xce\xad\x54\x42\x5a\x29\x94\x10\xd7\x8d\x19\x16\x8f\xe0\x19\x6c\xd7\xe6\xf1\x6f\xae\x95\x3e\xd8\x47\x4b\x0c\x8b\xfc\xe8\x37\x8f\x48\x91\x71\x44\x76\xcf\x0e\x02\x0d\x63\xd9\x83\xef\x26\x21\x4b\xcf\x42\x3f\xb8\xfd\x54\xae\x6e\xfb\x5e\x87\x68\xfc\xb5\x30\x52\x9f\xfc\xe6\x11\x28\xcb\x30\xef\x23\x2d\x92\x2c\x34\xc2\x43\x44\x22\x49\xcc\xba\xc8\x29\x47\x19\x03\xfc\xce\xfa\x16\x7a\x9e\x7d\x9c\xee\x27\xd5\x89\x4a\x2e\x9a\x16\xc3\xb3\xcf\xbb\x61\xbb\x1b\x47\x28\xc5\xb8\xac\x9d\x0d\x71\xba\x88\xd9\x72\x6d\x75\x3a\xe8\xc0\x2c\x38\x68\xd6\xc3\x5b\x29\xd2\x9e\xca\x05\x12\x43\xb6\x73\x58\x60\x44\x1a\xf8\x0c\xa2\xbf\x07\xcb\x39\x5c\x06\x2d\xaa\x09\x3b\xe5\xcf\x15\x20\x12\x25\x05\x32\xe5\x29\x4d\x8f\x8f\x14\x12\xf5\x30\x0e\x6d\x7c\x1f\x8a\x74\x3c\x4c\xe3\x13\x53\x04\x63\x02\xc7\xf1\xa5\x7b\x95\x27\x5e\x42\x9e\xc2\x3c\xe1\xf4\xcd\xc5\x8b\xe5\x33\xb8\x3f\x09\x9e\xd9\xe1\x2f\x02\x4e\xd9\xce\x94\x5b\xe0\xa5\xe4\x90\x68\xeb\x33\x0a\x3f\x78\x47\x40\x29\x0e\x8c\xe3\x28\x98\x29\x3f\xa5\xab\x7e\xf2\x82\x44\x46\x59\x8b\xab\x1e\xbd\x6b\x9e\x64\x0f\xfd\x66\x79\x30\xfb\x6b\xb5\x34\xfd\xdd\xa3\xd9\x3e\x06\x7d\x67\x44\xca\x7d\xe9\x94\x2b\x62\x69\xf3\x81\x7d\xd6\x5a\xbb\x46\x5e\x5e\x2a\x52\x12\x9d\xb3\x66\xdb\x1f\x34\xe5\xbd\xef\x3a\x39\xff\xd6\x1b\x81\x54\x16\x40\x97\xba\x74\x8f\x44\x5d\x1a\x4b\x6c\x1e\x32\xea\x1a\x39\xd2\x20\x73\x04\xe4\x1b\xd0\x8b\xad\xeb\xf2\x91\x51\x9d\xac\x0f\x09\x1d\x35\xea\xe9\xdc\xc0\x3d\xf4\x19\x97\x71\x15\x4d\x14\x53\x8e\x9a\xcf\x99\x41\x7b\xd7\x54\xd0\x35\x2b\x1a\xaa\xe6\xd3\x06\x2d\x5a\x72\xe7\x97\xe7\xae\xb1\x8b\xaf\xc1\x02\x94\x7f\x15\x04\xc3\x3c\x46\x1d\x58\x45\x02\x7e\xcc\x81\x9c\x55\x7f\x9f\x19\x0f\x7e\x59\x36\x62\xc7\x9c\x42\xad\x3c\xa8\x94\x3e\x90\xb2\x11\xb9\xc8\xc0\x9f\x0f\x2a\xe7\xcd\x7f\x88\x42\xc4\x07\xfe\x39\x57\x8e\xd1\x67\xec\xf3\x03\x02\x08\x12\xa6\x79\x10\x7c\xdc\x4d\xe3\xba\xcc\x35\xb1\xe8\x53\x5a\x28\x79\xc5\x49\xee\xe5\x55\x56\x76\x70\x06\x89\xe4\xce\x22\xd1\x03\xa9\xb1\x3d\xc3\xff\x1f\xbd\xb6\xdd\x99\x65\x8a\x90\xbc\x44\x45\x07\xaa\xf0\x2f\xcc\x1e\x58\x64\x07\x87\xf3\xb5\x2d\x93\xc3\x0d\xfa\xd1\x2a\x5f\x9e\x51\xe7\xcb\x25\x0f\x1c\xf4\xe3\xc1\xe5\xd2\x21\x26\x1b\xf8\x81\xe5\xe4\xf2\x4f\x03\x8d\xc2\xc9\xb9\xdd\x48\x27\x51\x85\x16\x89\xbd\x62\x43\x5e\xae\x8a\x27\xba\xaa\x2a\x2d\x8d\xb8\x33\xff\x8d\x55\xd1\x72\x18\x75\x8a\xd7\xcf\xdf\x55\xd3\x82\xa5\xb9\x4b\xfe\xbf\xb3\x87\xe2\x91\x8b\x96\x24\x92\x5e\x04\xaa\xfc\x3a\x15\x4b\x31\x4d\x51\x35\x6c\x3d\x4a\xcf\xac\xd5\x7d\x11\xbc\x34\x03\xa5\xe7\xfe\xfb\xf9\x9c\x71\xf9\xba\x0e\xfa\xdf\x78\xd7\x99\xd4\x50\xf5\xc1\x75\x26\x37\x4f\xc2\x50\x52\x08\xca\xba\x60\x2a\x93\xd2\xe9\xe2\x22\x87\x13\x70\x84\x48\x3a\x91\x43\x4f\xc9\x70\x2d\xa5\xb2\x14\xbd\xe0\xe0\x93\x6e\x23\x63\x47\xd5\xc2\xaf\xc7\xd0\xbd\x3b\x66\x79\xfb\x5b\x77\x5c\x90\xb0\x7d\xf9\x1f\x0e\x1f\xf8\xff\xea\x6c\xcf\x29\x75\xa5\x94\x86\x7c\x40\x9d\x9f\x9c\xba\x65\xfe\xd2\x34\xbf\xb4\x88\x16\x59\xb3\x70\x4d\xc9\xc7\xb4\x43\x3d\x5f\x31\xb5\xec\x29\xf0\x4b\xfd\xba\x21\xac\xa8\xeb\x98\xab\x45\x95\xc7\xba\xde\x12\xe2\x21\x15\x43\x3b\x27\xd5\x5d\x8b\x4f\x49\xf8\x9f\x8d\x5f\xcc\x5e\xda\x81\x0f\xf2\xdc\x8e\x67\xf0\x59\xb7\xa3\x84\x78\x48\x3b\xa0\x96\x27\xf8\x12\x89\xee\x72\x7b\x90\x56\x44\x4f\x5a\xb5\x31\xec\xa8\x89\xbd\x93\xf5\xf0\xb1\x90\xf0\x07\xd5\x3b\xd5\x8e\x34\x16\xc2\x72\x4e\xd0\x4a\x39\xb8\x6c\xc3\x8c\x52\x01\xae\x63\x3e\xba\x81\x84\x29\x24\x22\xf7\x8b\x86\x60\xa6\xb1\x64\x02\x2d\x62\xb7\x67\xb0\x59\x69\x25\xb5\x2b\x2b\x81\xa0\x04\x99\xcf\x06\xce\xbc\x5f\x50\x4b\x70\x4c\xac\xb1\x46\x48\x29\x6a\x04\xa4\x69\x26\x5b\x84\x68\xd2\x5e\x85\x0d\x56\xd4\x3a\x45\x96\x44\x7c\x08\x95\x64\x17\x53\x38\xd9\xb1\xa5\x3e\x47\xe1\xe0\xd4\xa0\x9a\xa5\x74\xd5\x6d\x0a\x28\x50\x41\x2b\x23\xd7\x47\xa7\x82\xdb\x9b\x87\x52\xae\xd3\xa6\x14\x6c\x71\x7b\x67\xfa\xbc\x60\x2e\xd0\xac\xc5\x56\x9f\x2e\x90\xe2\xb8\xb6\xa5\x9a\x0b\x4b\x27\x64\xe6\xe1\xe8\x28\x16\x06\xa2\xff\x3a\xf5\x79\xad\xfb\xf1\xd9\x00\x2b\x02\x10\x7d\x71\xe9\x88\xf8\xbb\x9b\x83\x47\xca\xe5\xf6\x50\x20\x54\x72\x69\xd7\x96\xc7\xc3\xa5\x66\xd1\x79\xf0\x77\x37\x0b\x4f\x98\x07\x36\xeb\x5a\xda\xc4\xae\x53\xda\x76\xf6\xa4\xb8\xd4\xda\x91\x2a\x15\x2e\xe3\x8a\xf9\x25\xc7\x06\xea\xde\x10\xdb\x6b\x2e\x86\x5b\x61\x2b\xb5\x5c\x8e\xf7\x53\xf5\xcc\x48\x7b\xaa\x78\x6f\x48\x5b\x30\x2c\x2f\x40\xe7\xfb\x30\xa3\xea\x5d\x8f\x7a\xbc\xe2\xb7\x98\x35\x00\x0a\xe4\xec\x36\x35\xfa\x13\x4b\xca\x61\x44\x92\x1a\x23\x16\x4e\xbe\x52\x99\xa6\xc4\x20\x1b\x3e\x44\x69\xf3\x28\xc8\xe4\x34\xa2\x24\xed\x49\x9a\x8d\x7f\x30\xa0\xe4\xe2\x67\x5c\x2b\xbf\x2c\x5a\x1d\x76\x2b\xa7\x3d\x19\x98\xf3\xef\x05\x59\x52\x90\xef\xfa\xb0\x28\x71\x8d\x35\x35\xc2\xa2\x94\xaf\x8f\xa6\xb4\x9a\xcd\x42\xd6\xc3\x8e\xf9\xaa\x84\xb0\x40\x85\x87\xad\x28\x38\x6c\x07\x92\xb8\x2f\x38\xfa\x3e\xcc\xf7\x29\x44\xb3\x47\x57\x54\x6b\x13\x16\x7b\xd7\x5b\x0a\xc0\xf7\x86\x7e\xc1\xdb\x6c\xaf\x6d\x1f\x4d\xaf\x49\xd9\xf3\x4d\xfe\x5a\x74\x76\x63\xd6\xa7\x35\x1e\x99\xaf\xd3\x6f\x11\x07\x2d\x02\x85\xb1\x14\xcb\x0b\x64\xc8\x8b\xf9\x05\x4e\x53\x73\xd0\x24\x51\x81\x8f\x45\xa7\x73\x0a\x84\x79\x5d\x54\x5a\xb2\x5f\x43\x6c\x9e\x3c\xb6\xcb\xd5\x60\xbb\xb6\xb1\xfd\x06\x56\xce\x77\xf0\xa1\x5e\xe5\xe0\x0a\x05\xa0\x3e\x1c\x9a\x3b\x3a\xff\x81\xef\xcc\x63\xc5\x91\x79\x0a\xb8\xad\x8d\x45\x20\x2d\x90\x93\xcd\xc0\xb8\x12\xc4\xcd\x40\x50\xb3\xd8\x24\x95\x9a\x05\x1f\x13\x88\x64\xa9\x47\x30\x62\xaf\x97\xa0\x92\xa4\x9f\x5f\xd6\xf8\x3b\x14\x00\x39\x1e\x0c\x6a\x52\xc9\x47\x89\x02\xe7\xb6\x90\xe6\xe2\xb7\xcc\xac\xbc\xd7\x67\xaa\x94\x51\xa5\xa8\x2f\x3a\xea\x95\xd8\x20\x40\xbc\xca\x16\xdd\x1a\xe3\x72\xbe\x2e\x12\xaa\x15\x5d\x66\x54\xae\x8d\x73\x72\x4d\x27\xe5\xf4\x23\xf0\x72\xea\xa4\x10\x75\x5d\x17\xf9\x5a\x1c\x25\x91\x37\xda\x32\x8d\xb4\x73\xcb\x14\xf1\xa1\x57\x61\x77\x6b\x9b\xdd\x15\x57\x59\x1c\x2e\xbf\x4c\x22\xeb\xfc\x51\x4f\x48\x27\xb8\x4c\xeb\xdc\xd6\xf6\x8a\x4c\xb9\xea\xee\x25\xf7\x58\x25\x4e\xf6\x73\x5f\xa3\x60\xcf\xb2\x39\x65\x27\x01\xe7\xaa\x54\x3c\xe9\xca\x04\x09\x94\x33\x06\xd4\x31\xea\xf5\x8e\x7d\x08\xce\x2c\x24\xd1\xbc\x4b\x8b\x89\xe4\x2b\x73\x90\xa3\x08\x5a\xb3\x30\x7e\xe8\xc9\xa6\xbf\xc8\x5d\x77\x46\xf7\xcd\xd0\xaf\x6c\xdf\x36\x0e\x8e\x2f\xb4\x80\x35\xba\x57\x43\x4f\x32\xb6\x77\x78\x86\x85\x8b\x85\x0a\xa2\xe7\x59\xd7\x29\xca\x92\x92\xa5\x8f\xb4\x59\xea\x27\x63\xa6\x7c\x09\x46\xad\xb3\x4a\x58\xc8\x64\xa5\xee\xba\x14\xa7\x27\x2d\x92\x07\xe1\x18\xb5\x32\x43\x24\x34\x9f\xdf\x54\xbc\xb2\xe1\x8a\xc6\x50\x63\x55\x23\x47\x92\x62\x02\xb9\x07\xc3\xa8\x89\xb3\x28\x3e\xbf\x91\xe2\x33\x14\xd1\x9d\x69\xa4\x04\xe2\x66\x06\x7b\xe7\x42\xc4\x33\x97\x78\xfb\x97\x51\x9e\x6b\xf5\x45\x9c\x9f\xd1\x0d\xb8\x09\xb6\xeb\xdc\x7c\xa7\xb6\xda\xaf\xf4\x96\x02\x50\x33\x2f\xba\x54\x3f\xb1\x26\x9c\x29\x7e\x69\x80\xb1\x41\xad\xeb\xcd\x1c\xfa\x73\x6d\xf3\x06\x5c\x81\x00\x41\xd3\x84\xb0\x63\xf7\xe9\x1f\x0c\x19\x13\x7e\xb1\x0c\x61\xf7\x84\x74\xcf\x20\x4a\x06\xba\x57\xff\x02\xfb\xaf\xbe\x5c\xeb\x01\x30\x7f\xad\x7a\xd7\x63\xe4\x1d\x3c\x92\x73\xfc\x3c\x17\xe2\x57\x17\x2b\x1a\xf5\xa5\x38\xd7\xab\x40\x58\x12\xf8\xeb\x01\x3d\xc0\x93\x10\xdb\x0f\x49\x6c\xa8\x88\x61\xf4\xae\xe5\x14\x43\x52\xdd\x85\x28\x19\x2a\xfb\xe7\x1b\x8d\xe7\x85\x2a\x2e\xcc\xc2\x17\x9f\x53\x6b\xd9\x4d\xa8\xe1\xc2\x1a\xf2\xc6\xf6\x36\x4e\xb6\xc2\x07\x4c\xb6\xba\xb3\x7f\xfb\x3b\x37\xc4\x1c\xe2\x7f\x74\x43\xf8\xa2\x55\xe7\xbb\x84\xfa\x4c\x74\x7e\x88\x9d\xd7\x07\x03\x11\x1b\x87\x68\x46\x76\x5c\x53\xaf\x3e\x18\x65\xce\xb4\xec\x20\x0c\x81\xce\x3b\x1d\x23\x6f\xe7\xe4\x0f\x58\x07\x45\x84\x8c\x62\xaa\xf5\x42\x8b\x4a\x2f\x6e\x17\xdb\x53\xf5\x9d\xbb\x30\xee\x78\x51\x0f\x2a\xb6\xfb\x66\x38\x30\x5d\x77\x83\xdf\xea\xa7\xc3\x88\xb4\x63\x17\xbb\xcd\xd6\x79\x37\x44\x8b\x86\x31\xcf\x29\x4d\xfd\x20\x69\x61\xa6\x00\x5a\xe8\x9c\x9a\x21\xe8\x6d\x59\xe6\x0d\x26\xab\x9f\x20\xb9\x28\x85\x74\xb1\x94\x81\xa7\x8d\x58\x1f\x20\xa1\x2c\xa5\x9e\x49\x46\x51\x92\xcb\xb8\x55\xd4\xb6\xc7\x22\x0c\xfc\x8e\x53\x0a\x58\x34\x04\x36\xbe\x81\xb0\x15\xc3\x01\x49\x5a\x14\x66\x53\xb2\x7a\x8d\xc9\xe8\x75\x25\x4c\x6b\x90\x56\xa5\x62\xa3\x46\x9d\x2b\xb7\xf1\x66\x52\xe6\xa5\x37\x53\x78\x19\xb9\x9d\xd1\x87\xc9\xb8\xfd\x68\xf4\x61\x32\x6a\x08\x39\x1d\x00\x84\x3d\x3f\x0a\x65\x29\xdb\x76\x66\x54\xe2\x55\xdb\x9d\xab\x23\x39\x01\xac\xe0\x7b\x78\x00\x9e\x29\x21\xf1\x6b\x47\x65\xd8\xe6\x6c\xd2\x2a\xb7\x02\xb6\x7d\x10\xe8\x77\xf4\x59\x40\xad\x9c\x8b\x21\x7a\x7d\x80\xdd\xb1\xbe\x4d\xc3\xf4\x9d\xa4\xc3\x2e\x59\xdf\x4e\x46\x8a\xa0\xa7\x43\x45\xd0\xe7\xc7\x6a\x1f\x0e\xba\x6f\x24\xd4\xae\x09\xa9\xc2\x37\x37\x07\xdd\xab\x9b\x94\x31\xa9\x71\x52\xb2\x5c\xa1\xe3\xc2\x73\x35\xaf\xf5\x7a\x67\x66\xab\x7e\x0e\x39\x17\xeb\x9e\x94\x2d\x2b\x9f\x14\x9f\xdb\x29\xde\x6d\x6c\x07\xa7\xf1\x6a\x58\xdf\x9a\xd8\xec\x74\xd8\x35\x51\xaf\x3a\x53\xe2\x7a\x2f\x60\xea\x3b\x04\x53\x3f\xea\xb0\x53\x1f\x01\x6c\x0e\xeb\x76\xdd\xec\x4d\xd4\xe8\xbd\xa5\xc0\xf2\xc3\x73\xf5\x86\x93\xe7\x4a\x21\x87\xb9\xe1\xa7\x1f\xef\x42\xa0\xc6\x0b\x0c\xef\x00\x44\x5e\x83\xcf\x12\xc8\x1c\xb6\xde\x7c\x62\x5a\x46\x9e\xf9\x6f\xcd\xa7\x08\x6d\xf8\x40\x29\x05\x2c\x3e\xdf\xb7\x6b\x79\xfb\xa2\x97\x33\x7c\xc7\x03\xf8\x47\xbb\x9f\x9e\x60\x19\x98\x0e\xae\x1f\x9e\xab\xf7\x7a\x08\xb3\x80\x07\x3d\x84\x8b\x90\x52\xbd\x00\x4a\xcd\x63\x38\xae\x34\xa8\xa7\xd2\xae\xea\x76\x25\x17\x64\x0d\xd0\x70\xe0\x0b\x25\x64\xb7\x64\x78\x73\x82\xe7\x94\x73\xf0\x05\x59\x5e\x16\x79\x36\x25\xc5\xcb\x52\x9d\xdb\x8e\x4b\xbc\x76\xdb\x12\x9a\x9d\xed\x21\x74\xf5\xea\x07\xd8\xfc\xf2\x57\x7f\x22\xb8\x05\xb1\x9a\x96\xf0\xb7\xa1\x08\xa7\xcd\x41\x53\xac\x23\x60\x3e\x71\x0c\x53\xf5\x1e\xd2\x18\x16\xec\x2b\x0b\x6b\x98\x6c\x45\xf0\x8c\x12\x05\xac\x50\xbb\xa3\x14\x79\xd2\xb4\x12\x36\x0c\x7e\x4b\x1e\xab\x9c\x22\x0f\x94\xd3\x32\x1d\x74\x70\x81\xd3\xa6\xae\xae\x28\x1d\x83\x22\x92\xd5\xbb\xf1\x4d\x4f\xde\xd2\x39\x24\xfc\x07\x4c\x96\x67\x2a\x64\x09\x63\xe7\xa3\xc3\x5e\x16\x1d\xab\x23\xed\x48\x37\x1f\x62\xf8\x40\x38\xd8\xd3\x0b\xb8\x9c\x90\x9e\xe1\x1b\x54\x9c\x3c\xd5\x4c\x37\x71\xf6\x44\x90\xb0\xb9\x3a\x36\x4a\xeb\xca\xd2\xc8\x20\x90\x17\xf7\x08\xc3\x6b\xc8\x2b\x47\x59\x7c\x64\x95\x0e\x98\xc7\xea\x55\xde\xec\xc9\x1d\x2a\xbb\x08\x92\xd6\xb3\x4c\x85\xce\xa8\x52\xc1\x85\xa7\x56\x71\xce\x7d\xca\xa6\x79\x2c\x8a\x95\x02\x63\x32\x5a\x23\xa0\x91\x8b\x14\x99\xa8\xe5\xaa\xa7\xc9\xdd\x56\xa1\xe1\xfa\x5c\x72\x5f\xdb\xbd\x3d\x5b\x56\xb8\xed\x5f\xde\x98\x58\x28\x0a\x83\xe2\x96\xee\x92\x5f\x2c\xd4\xd4\xfd\x8a\x71\xd8\xd0\x94\x8b\x12\xa5\x42\xd2\x60\xfc\x59\x2f\xd2\x83\x77\x3b\xbb\xa2\x7d\x68\xfb\x99\x02\x02\x40\x3a\xd7\x08\x55\xd4\xd4\xee\xa7\x85\x76\x28\xec\xdb\xdb\x9e\x56\xa8\xf3\x85\x0d\xaf\xac\x79\x64\x48\xe3\x8e\xe6\xe8\x26\x13\x0c\x45\x19\xa8\x98\x16\x28\x6e\x79\x8a\x56\x5c\xe2\xe1\x00\x1b\xb2\xd8\xee\xc3\x45\xe0\x8a\xc0\xab\x27\xd4\xdc\x92\xc9\x52\x38\x59\x31\x74\x91\xc9\xe2\xbc\x68\xfa\x57\xaf\x0d\x74\x72\xdc\xb8\x63\x9f\x39\xfe\x45\x4b\x31\x17\xdb\x4b\xc2\x4b\x90\xad\xa0\xa9\x42\x72\xc5\xea\xfa\xda\x65\x7b\xb2\x37\x8e\x8e\x1f\xf5\x0a\xcd\xcc\x74\x1f\x36\xe4\xa8\x78\x2f\xf2\x80\xb2\x01\x3b\x1d\xd8\x02\xfe\x4c\xfd\xfb\x4a\xb8\x53\x55\x5f\x5b\x31\x94\x0d\x20\x69\x7b\xd2\x81\x9d\x48\x40\x43\xdd\x94\x19\x6f\x2f\xcf\x8a\x29\xbb\xe0\xed\x05\x84\x07\x61\x09\xf6\x85\xa3\xd3\xbd\x32\x4c\xac\x4e\x79\x2c\x51\x9e\xde\x98\x50\x1b\xe9\x63\x52\x16\xcf\x8a\x64\x16\x85\x11\x28\x48\x19\xd5\x06\x26\x4e\x55\x25\xa5\xb5\x1c\x56\x82\x09\x34\xd0\x65\xd3\x16\x85\xf9\x8d\x58\xf3\x04\x2e\xed\xb2\x11\xd4\x5b\xb6\xab\x09\x0b\x92\x81\x90\x96\xf6\xa8\x11\xc5\x89\x52\xb5\x85\x4a\xd4\xba\x1b\x94\x56\x36\x90\x52\xa6\x3a\x24\x94\xce\x9c\x68\xd0\x57\xa0\x5f\x9c\x8e\xec\x68\x22\x87\xbd\xa4\x8d\xe3\xc1\x32\xa4\xfd\x1b\x91\x42\x7f\x33\x0b\x14\xd6\x54\x57\x47\x38\x77\x77\x04\x86\x25\x5d\x78\x90\xb0\xc1\xc5\x45\x79\x92\x55\xf4\x82\x52\xc4\x57\xed\xe9\x20\x29\x63\xb7\xd6\x2d\xa7\xcb\xb1\x09\x3b\x15\x7f\x71\xfa\xd4\xeb\x43\xd1\x64\x46\x3f\x6a\x6f\x51\x1b\x42\xcd\xdf\x67\x45\x2b\x83\x59\x0f\x1e\x6c\x5f\x0e\xde\x45\xb7\x76\x1d\x39\x49\xc7\x34\xf5\x9e\xd3\xa4\x9d\xa3\x08\x8e\x94\xba\x73\x81\xe2\x92\x07\x69\x37\xc7\x3c\x7b\xef\xbc\xa4\x20\xa7\xb8\x45\x1b\x44\xdb\xb7\xea\xc5\xdb\x3a\xbd\x72\xf2\x80\x32\xd8\x21\xb0\x1d\x3b\x1c\x96\x85\x40\x74\xe3\xbc\x92\xb8\xd3\xac\xde\xfb\xe2\xdd\x9b\xff\xff\x55\x28\x11\x16\x1e\x2c\xb1\xba\xe4\xc6\x72\x06\xa6\x70\x08\x41\x71\xbc\xbe\xa6\x33\x28\xe1\xb0\xa1\x88\x80\x70\xe8\x60\x00\xa2\xf9\x14\x51\x25\xa0\x77\x11\x5b\xaa\xd5\xce\x82\xfe\xbb\xb7\x77\xb6\x33\x5b\x8a\xc5\x07\x27\xc7\x52\x66\x32\x18\xdf\xac\xc8\x07\x16\x92\x7c\x2c\xd6\xfd\x4e\x07\x53\x82\xb4\xbd\x00\xa4\x21\xd2\x91\x8c\x4d\xcd\x5c\xbc\x6d\xf5\x4c\x72\xcf\x42\x8f\xe4\xc9\x23\x47\xdd\xd0\xfa\x60\xb7\xfd\x63\xdb\xc3\xd8\xc2\xe9\x6c\xba\x96\xa3\x2d\xc8\x60\xa3\xe2\xf5\x72\x52\x83\xf8\x05\x80\x43\x54\xbd\xbd\xdc\x9a\x30\x48\xd3\x6f\x86\xfb\x5a\x8e\x56\xb9\x4f\xd5\xf7\xf8\x7f\x0c\x86\x6e\x02\x4f\x0d\x7a\x77\x6b\x8a\x6b\x81\x3d\x0a\x9e\xc8\xef\x5b\x71\x61\x70\x39\x2a\xc0\x72\xf6\x15\xba\x65\x43\x91\x21\x42\x17\xb3\x91\x07\x9e\x4a\x6c\x6c\x17\x8d\x4f\x90\x2f\xf1\xb3\x82\xc8\x0d\xe7\xc8\x11\x38\xf4\x4d\x47\xde\xb3\xa8\x58\xea\x05\x8a\x5b\xb5\xc5\x97\xc5\x6b\x4b\x81\xd8\x49\x00\x5d\xac\x82\x8c\x11\x90\x18\x10\xaa\x52\x87\x65\x71\x64\x74\xaf\x11\x40\xd9\x9e\x2a\x1a\x8f\x65\x80\xa2\x2b\x8a\x2e\xf7\xd2\x80\x84\x28\x67\x41\x21\xde\x8d\x14\x6a\xf3\x93\xec\xd6\xd4\x67\xac\xac\xea\x32\x69\x5f\x24\x00\xd2\xd7\xaa\x20\xf6\x40\x84\x35\x41\xc3\x8d\x15\xd4\xb3\x56\xdd\x3c\xe3\x9c\xb0\x8f\x87\x86\x45\x4c\x37\x6f\x3e\xbe\xbf\x70\x76\x01\x28\x9f\x2b\x08\x59\x1c\x2e\x90\xc5\x07\x0c\x66\x15\xa7\x0c\x2b\x15\x70\xa4\x59\x66\xbe\x42\x0a\x1f\x58\x61\x1e\xee\x12\x11\x0f\x3b\xdc\x1b\x32\x97\xa2\x10\xa8\x54\x66\xa9\xde\x0c\x5d\xb4\x87\xce\x48\x8a\xb8\x81\xc1\xd8\xfb\x62\xd0\xb0\x3a\xa1\x84\x53\xab\x2f\xae\xbf\x58\x56\xb7\x40\x13\xbb\x90\x2e\x02\xf5\xf1\xf5\x8d\xfa\x9e\x02\xec\xc0\x7d\xcc\x3d\xbd\xb5\x07\x00\x6b\x68\xcd\x73\x10\x3b\x84\xa5\xb5\x2e\xc7\xad\xde\x37\x6c\xe2\x24\x7b\xf2\xfd\xb3\x37\xc8\x13\xb5\x6b\x53\x1e\xf6\x5c\x35\x3a\xb7\x97\x77\x5c\x6e\x04\x84\xa8\xa8\xde\x71\x52\x2a\x3f\xb7\x26\xd7\x23\x29\x5d\xc8\xb8\x4e\xc8\xfc\x1a\xba\xa2\xf6\xab\xab\x4f\x96\xc5\xb9\x62\xe9\x61\x51\x48\x71\xf3\x9d\x3c\x7e\x50\xd6\xc5\xef\x0b\xdf\xba\xac\x6e\xdb\x92\xfa\xab\xf1\x3c\xd0\x4f\x47\x89\xac\xa0\xd4\x2f\x8d\xdb\xd8\xa3\x07\x11\xea\x55\x89\x0a\xb2\x21\x02\x80\x55\xe3\x46\xa8\x93\x92\xdc\xb4\x44\xa9\xc6\x38\x1d\xe3\x19\xff\x17\x17\x7c\x5e\xf0\x12\x45\xf2\xdd\xa6\xe8\xbd\x67\x50\x23\x58\x0a\xba\x89\xea\x75\xac\xae\xc0\xda\x42\xf9\xad\x40\x8e\x9b\x30\x82\x71\x60\x28\xa8\x58\x62\x9e\xd2\x02\x40\xda\x87\x89\xf7\xa2\x9b\x23\xe2\xbd\x6e\xc6\x3d\x34\x3c\xa1\x41\xf4\x4c\x0d\x26\x07\x88\xaf\x8b\x45\xc7\x44\xc9\xc8\xef\x21\x5f\x07\xe8\x0f\xa9\xd1\x07\xdb\x98\xbe\x25\xb7\x9d\x4f\xd1\xce\xfb\x7b\xfe\x5c\xb0\xfe\xcf\xb2\x77\x91\x8d\x3d\xbe\xa4\x08\x6e\xf1\x2b\xc9\x62\xd1\x46\x52\x14\x62\xd1\xc6\xba\xd2\x17\x62\x58\x30\xc8\x4b\x31\x8d\xc0\x22\xb1\x25\x8f\x18\x9c\xed\x07\xba\x8b\x88\x9b\x85\x83\x59\x66\xed\xd9\xf9\xc0\xd0\x2b\xf8\x59\x37\x60\x29\xae\x08\x9b\xc1\x77\xa5\xeb\xc5\x9f\x3e\xbc\x1e\x41\x8e\xc9\xc2\x3a\xb7\xa0\x2b\x13\x39\x59\x43\xec\x62\x3c\x88\x0b\x76\x30\x82\x4f\x4e\xd8\x67\xc0\xf8\xe4\x47\x30\xf8\x3d\x82\x59\x1b\x1f\xc5\x5d\xf4\x73\xe3\x99\x0b\x45\x1e\x9d\x47\xa0\x10\xe6\x98\x21\xff\xcd\x9c\xe6\x20\xe0\xe8\x85\xdb\x2e\x2b\x18\xbd\xb1\x3d\xb2\x4d\xe0\x08\xe6\xd4\x51\x99\xa1\xb7\x9f\x9a\xe0\x90\xe9\x9c\x1f\xf9\xe4\x63\xfb\x93\xa2\x8c\xe2\xf5\x3f\x2a\x8d\x0c\x80\xc6\x3b\x17\x79\xd4\x5f\x13\x47\xc0\xb9\x38\x33\xee\x6e\xb3\xe9\x6c\x6f\x64\x1e\xdf\xd1\xe7\xdc\x5c\x72\x7c\x92\x06\x04\x5e\x28\x40\x82\x85\xf5\x82\x12\x15\x25\xc2\xce\x1a\x95\xe2\xdb\x62\xfb\x37\x7b\xc8\x97\xc4\x0f\x7f\xb3\x87\x11\x1c\xe8\x73\x21\x53\x1c\x0c\xf5\x46\x5a\x5d\x90\x8e\x06\x7c\x93\x9e\xea\x96\xfd\x22\x36\xa0\x6a\xd9\xb4\x36\xdc\x8a\x37\x6c\x4a\x67\x1f\xa6\x36\xdc\x8e\xcb\x6a\x34\x51\x92\x21\xa2\x2f\x1c\x9f\x04\x18\x76\xc5\x06\xba\xf9\x71\x7e\xf7\x84\xb0\x9b\x79\x92\x15\x99\x69\x61\x43\x58\xea\x60\x5a\xbe\xea\x4b\x90\x22\x6e\x35\x00\x54\x4b\x32\xec\x96\x38\x95\x3c\x2c\x1f\x60\x16\xab\xa1\x08\x3b\x58\x85\x5b\xd3\x0b\xc8\xbf\xe1\xd7\x1c\x50\x13\x4d\x88\x05\x98\x82\xef\x09\xe0\x9e\xd6\x27\x85\x33\xb7\x7f\x33\x39\x76\x08\x65\xa0\xdb\x6c\xc8\xa0\xf8\x21\x97\x8a\x86\x99\x52\xa1\xea\x9a\x61\x0b\x81\x5a\xb9\xa1\xd1\xe8\xae\xc4\xc7\x42\x0b\xe2\xd1\x08\xe6\x91\xd2\x1c\x32\xad\x44\x88\x09\x0d\x28\xe3\x45\x38\x83\xe9\xd8\xa3\x47\x7d\x54\x9c\xac\x28\xb9\x2c\x86\x24\x72\xdf\x30\xb5\x88\xf4\x70\x8f\xbe\x5b\x67\x80\x78\xb6\x18\x68\x3c\x59\x72\xf2\xda\xc3\x8e\x38\x2e\x72\xf4\x52\x42\x5a\x5d\xc4\x10\x95\xe5\x55\x30\x3c\x66\x57\x19\x40\x5f\x5e\x07\x08\x41\x86\x04\xf2\xaa\xbf\xc1\x2f\xbc\xe7\x2a\x28\xdd\x07\xdb\xac\x77\x3a\xb2\xb7\xd3\xb7\x37\xaf\x30\xe6\x41\x30\xb1\x82\x9b\x77\xbd\xc1\xdf\x63\xc8\x7b\xdc\x6a\xe4\x2c\x36\x33\xab\xca\x9f\x75\x44\x21\x89\xc4\x0c\xae\x7b\xc1\xf4\x37\x82\x38\x5f\x12\xea\x92\x74\x4f\x1d\x70\xb6\x32\xc7\x70\x5a\x17\x9c\xaf\x15\xa3\xaf\x42\x76\xc0\x90\x6e\xde\xb4\x4d\x67\xd7\xa6\x27\x2f\xa2\xef\x25\x51\x49\x62\x55\x46\xce\x4f\xbc\x82\xb6\x36\x16\xa7\x27\xde\x44\x3f\x8c\x3a\xc8\x27\x27\x1d\xe7\x30\xd5\xcd\xde\xb2\x73\xad\x7c\x92\x62\x2e\x2e\x04\x95\x72\xe7\xb0\x78\x4d\x11\x10\x1a\x6f\xfa\xd6\x78\x39\xee\x19\x8b\xd7\x47\x52\x27\xa2\xdc\xea\xf4\x47\x2c\x28\x86\x63\x3b\x69\x14\xb4\x35\xab\xa1\x25\xaf\xef\x28\x91\xe3\x2c\x8a\x01\x45\x59\x55\xf9\xe8\x8d\x01\x25\x60\x38\x71\xfb\x01\xcc\x3b\x3e\x7a\x63\x14\xa5\xb0\xf6\x48\xdd\xec\x16\x76\xc3\x12\xaf\xa6\x23\xc8\xba\x81\x92\xe8\x83\x58\xdf\x62\xae\x82\x5c\x05\xb9\x2a\xe7\xce\x61\x61\xef\xeb\x38\x10\x38\x08\xd0\xbf\x02\x4f\x91\x4f\xc3\x80\xf9\x15\x26\xf2\xaa\x5b\x9c\xf4\x1c\x64\xdf\xd4\x07\x7e\x09\x1b\xcd\xfe\x20\xdb\x95\xa1\x21\xc9\x79\xed\x4f\xd3\xad\xcb\x85\x64\x55\x93\xb7\xf9\x54\x90\x93\x71\x2f\xcf\x36\x8c\xba\xa5\x3f\x35\xcc\x9c\xe4\x72\xd8\x1b\x4c\x9a\x6e\x20\x2e\x09\x85\x24\x70\x45\x51\x2a\x70\x09\x29\xd2\xae\xf2\x69\xf5\x42\x94\x87\x67\xcf\xaa\x76\x55\x71\x2d\x73\x6a\xc9\xe3\xcb\xa9\x25\xcf\x33\xa7\x0e\x21\xf1\x0e\x8a\xd4\x10\x3a\x59\xb9\x37\x37\xaf\xab\x65\x5a\xe4\xe6\xa7\xf8\x97\x1b\x88\xcd\x7d\x70\x21\x6e\xbd\x09\x8f\xd0\x29\xd1\x57\x45\x09\x9e\x9d\xf7\xc5\x64\x70\xea\x18\x47\xf8\x6b\x67\xa3\xf9\xfd\x23\xc2\x90\x69\x09\xe6\x7b\x16\x84\x36\xa5\x9c\x21\x16\x38\x97\x9f\x08\xde\xb0\xa5\x62\x0a\x35\x08\x6f\x04\x49\x45\x77\xd7\x93\x92\x6b\xe7\x6e\xad\xc9\x45\x79\xf8\x3e\x48\x21\xca\x3f\x57\x6c\x8e\xfb\x77\xb9\x04\x7e\x17\x47\x05\x7f\x9f\x29\xe4\x0d\x50\xb4\x28\x64\xfa\x74\xa2\xf7\xa2\xbc\x1d\x28\x47\x61\xce\xf8\x75\x47\x51\x2b\x26\xd8\xd2\x09\x88\xef\x29\x32\xfb\xa6\x8a\xcb\x03\x10\xdf\x55\x98\x79\xae\x55\x33\x08\x64\xdc\x5e\xcf\x14\x97\xf2\xe4\x04\x30\x4d\x2d\xb1\x12\x67\xe7\x15\x21\xcf\x93\x81\x94\x1d\x06\x54\xe5\xa1\x80\x14\x9f\xc8\x77\x35\x24\x28\x4a\xa8\x81\x67\xf6\x0a\x65\x20\x3d\xfb\x54\xbd\xf4\x6e\x5f\x67\xcc\xec\x18\xca\x48\xf7\x8e\xe9\x5c\x79\xe7\x7c\xff\xfa\xdd\xa8\x4e\xd3\x39\x24\x81\x78\x6c\x00\x42\xc9\xf7\xa8\x2f\xc0\x55\xaa\x39\x4a\xeb\xe2\xa5\x44\x39\x93\xf6\x35\x25\x0c\x36\x55\xad\x87\x10\xdd\xbe\x2c\x5c\x97\x7a\xc8\x5b\x8c\x20\x2f\x3c\xc5\x72\x03\x90\xf5\xde\x00\x97\x92\xeb\xcf\xbc\xf8\x1a\x58\xb7\x6d\x01\xdc\xe8\x2e\xb2\xcc\x26\x17\x50\xba\xc3\xd7\x6c\xb4\x77\xe3\xd1\x31\x7d\x4b\xb4\x36\x73\xa1\x51\xb9\x01\x12\x14\x02\xd4\xd0\x09\x30\x87\x9a\x7b\x49\x3f\xa2\xa3\xb0\xec\xb9\x24\x24\x01\xf3\xe0\x6b\x75\x75\x77\x0e\x4b\x20\xbb\x9c\x8f\xb9\x50\x11\x1b\xb8\x8f\x82\x62\x99\xd6\x39\x6e\xd3\xb4\xcc\x47\x1c\x8f\xd9\xf5\x0e\x25\x12\xa3\x0e\xfd\x0f\x36\x1d\xeb\xc8\x88\xba\x08\xfa\xcb\x53\x98\x5a\x95\xf2\x26\xc0\xab\x56\x04\x27\x55\xd9\x0f\x90\x97\x85\x26\x67\x31\x90\x2b\x9f\x62\x7b\xfa\x3d\x96\xc6\x74\xee\x33\xa7\x4f\x9b\x2d\xc5\x83\xdd\x62\x48\x5f\x8e\xcb\x22\xa5\xc5\x27\x29\x24\x57\xe5\xd2\xf3\xb7\xd4\x51\x29\x1e\xc0\x45\x72\x55\x4e\x08\xb0\x22\xbf\x59\xeb\x43\x5c\xef\x74\x41\x80\x95\x48\x39\x77\x1e\xcb\xf8\x7c\xad\x0c\xc5\x12\xb6\xf3\x67\xed\x83\xb0\xba\x71\x2f\xcf\x21\x76\xe7\xfb\x7d\xa9\xa9\x4d\x0a\x53\xf4\x90\x6b\x41\xd0\xa2\x58\x23\xad\x53\x14\x2b\xcc\xae\x4e\x80\x93\xae\xd1\x22\x49\x5a\x46\xdc\x0f\x4c\x45\xcd\x63\x51\x30\x2a\xae\x74\x32\x08\x2d\x6e\x74\x4c\x38\x77\xa1\x63\x26\xf0\xa7\xee\x2c\x3b\x3b\xe7\x9f\xe7\x40\x32\x66\x81\x64\xd4\xe3\x02\xf5\x45\xf5\x7c\x74\xb5\x11\x0c\xc6\xeb\x68\xd0\xd7\x22\xf1\xb3\x6e\x90\xc6\x19\x83\x6d\xd7\x0d\xaa\xf7\xde\xa1\x22\xc9\x0f\xcf\x95\x7c\x8d\x01\x81\x18\xec\xec\xc6\x88\x06\x1f\xbc\x8b\xe0\x9b\xac\xdd\xc6\x0d\x0c\x7e\x33\xba\x4e\x9f\xdf\x7c\x78\x39\xbe\x46\x49\x11\x33\xdb\x2c\xc2\xe7\xfc\x68\x22\xe4\x52\xb7\xfa\x20\x82\x21\xfc\x55\x67\x5f\xee\x08\xc1\xcc\xdc\x9e\xeb\x6e\x40\x85\xb3\xdc\x0e\x4a\x38\xd3\x12\xca\x3c\x7f\xa5\x0b\x00\xb0\x40\x25\x70\xa8\xb0\x48\x47\x91\x68\xd3\x40\xe0\xc3\x2f\xd5\x8f\x6f\xbe\xd9\xca\x01\x6e\xc9\xbe\x0a\xd0\x4d\xb0\xeb\xc8\xd4\xb5\x71\xde\x92\x3a\x13\x3b\x0f\xe5\x5c\x76\xc2\x45\xb9\xa9\xba\x6c\x18\x56\x1c\xee\x29\x6d\xbe\xea\x5c\xe6\x7c\xd7\x0b\x98\x19\xfa\xb9\xc8\x1d\xbf\x65\x9e\xcd\x3d\x62\x0a\xf8\xe2\xf9\x72\x33\x79\xb2\x8c\xe0\xe4\xc5\xf2\x72\xe6\xa9\x22\x21\xb0\x0a\xee\x0a\x26\x9c\x63\xad\x60\xe6\x7c\xd7\x8b\xf1\x9a\xbc\xf4\x26\xc5\x26\xfd\xcd\x85\xcf\xbc\xdf\x26\x28\x8a\x21\x28\x4a\xcf\x3d\xe0\x66\x8b\xca\xa8\x14\x65\xe7\xde\x72\x07\x8b\x5a\xcf\xc5\x49\x44\x09\xf3\x03\xc4\xd0\x4b\xf6\x43\x4f\xcf\xc6\xf4\xb0\x0d\xc6\x8b\x0f\x7a\xca\xa9\x9e\xb6\x52\x96\xcc\xd3\xe6\x10\x14\x9c\xaf\xfb\xd1\x6c\x3d\xe3\x48\x5a\x9a\x3f\x70\x8a\x88\xf3\x46\x05\xe4\xd2\x96\x82\xc5\x85\x2d\x25\xc7\x45\xf8\xe2\xd8\x98\x96\x82\x2d\x36\xa9\x24\x5f\x1e\x29\x87\x1b\x1c\xce\x0c\x94\xcc\x23\xb7\x0f\xb4\x85\xe6\xab\xd2\xbd\xdd\xcf\xd6\x24\x19\xe7\x2a\x0a\xd1\x5b\xe0\x8c\xd8\x0d\xb2\x38\xbd\x3d\xa8\xef\xff\xd7\xab\x97\x4a\x14\xcc\xc7\xf0\xb0\x44\xec\x1e\x15\xad\xec\x27\xd3\x05\x3e\xe0\x31\x49\x51\xd2\xa4\x2f\xf9\x10\x61\x2e\xc9\xcc\xfa\xe4\x1c\xea\xa3\x60\xe0\x80\xd7\x69\x8d\x71\xc8\xeb\xd9\x25\x46\xb0\x49\x8e\x5b\x1c\xf1\xac\xcb\x94\xcf\x79\x29\x22\xc1\xb1\x13\x7e\x09\x8d\x3d\x5b\x01\x43\x2f\x65\x6b\x7e\x2c\xf7\xa1\x64\x72\x50\x6d\xbc\xfb\xdc\xc0\x3a\x8f\x90\xa2\x38\x65\x5c\xe0\x82\x6c\x9d\x52\x52\x6b\xb7\xb6\x38\x84\x41\xdb\x73\xb6\x95\x5b\x1b\xd3\x8a\xc5\x30\xad\xa0\x10\xd4\xd9\xed\xae\xe4\x15\xb6\x18\x9a\xf4\xd4\x47\xfd\x49\xa5\xfc\x12\x03\xcc\x32\x96\xee\x6c\x4f\xd6\xa4\x50\x82\x3e\x88\xb5\xf9\x25\xc5\x5e\x0f\xb6\xdf\x32\xc7\xeb\xab\xb3\x08\x9a\x22\x90\x2e\xa3\x2a\x52\xe6\xf0\x41\xa9\x79\x7c\x72\x3c\x21\x96\xe2\x60\x1a\x21\x00\xd8\x0a\xc1\x76\xdd\x68\xbf\x65\xdd\x7e\xed\xb7\x03\xac\xba\x50\x55\x81\xbc\x4f\x53\x4c\xdd\x9b\xc4\x2b\x1d\x4d\x1e\x81\xe3\xda\x2c\xa1\x21\x41\x6d\x50\xbb\x65\xa6\x00\xfa\x3a\x29\xe0\x9f\xc3\xf7\x1c\x20\xfa\x17\xcd\x70\xe8\x59\x74\x06\x6c\xbb\x2e\x80\x7e\x78\x9e\x40\x04\x86\x82\x54\xf3\x7a\x79\xed\xb6\xf3\xeb\x05\xa0\x88\xa9\x5b\x48\x06\x00\x9a\x78\xb9\x63\x11\x01\x80\x33\xf7\xec\x4d\xc1\x39\x83\xe4\x69\xf4\x16\x71\x1a\xb1\x5c\x7b\x7c\x01\x3c\x87\x7f\x18\xff\x3f\xe5\x94\x9c\x3b\x49\x13\xda\x07\xc5\x0f\xf4\x33\xc3\xd3\xb3\x1b\x6d\x4d\xd0\x72\x44\x32\xf0\xfc\x70\x43\x90\xf0\x98\xf0\xb3\x02\x30\x9f\xcc\x7a\x28\xcc\xce\xbe\xa7\x6f\xb6\xf3\xc8\x68\x5c\xf2\xf2\x48\x66\x17\xef\x29\xa5\x80\x99\x89\x12\x95\x9a\xce\x02\x27\x92\x15\x9d\xad\x3f\x55\x8f\xda\x46\x00\x25\x8e\x37\xc4\x35\x03\x7d\x8a\xee\xd6\xc8\x17\x87\xc0\xe2\x51\xda\x9a\x48\x71\x26\xf8\x35\x84\xc1\x89\x09\x92\x03\xb8\x26\x78\x76\x8e\xc0\x2f\x6c\x98\xa1\x54\xab\xe9\xcc\x1a\x9d\xa1\x40\x6d\xf8\x01\xa4\x56\xca\x6f\x4d\x05\xf1\xc2\x84\x29\x8c\xed\xe9\xb1\x46\x59\xf4\xe6\x7b\x45\x69\x8c\xb2\x70\x30\x92\xbc\x6a\x62\x46\x11\xa9\x9c\x41\x4d\x3b\x86\x94\x9a\x11\x08\x6c\x59\xc7\xa3\x51\x32\x8c\xcb\xb4\xe6\xb7\xb5\xcf\xcb\x2a\xef\x77\x23\xbf\x25\x55\xe6\xef\x4b\xe7\x25\x75\xd6\x3f\x8f\xbd\x96\x14\x23\x35\x5e\x1c\x92\xe5\x0e\xb8\x37\x96\x93\x31\xc8\xa6\xa9\x95\x39\xeb\xbd\x76\xe9\xa5\xff\x15\xa9\xf8\x15\xb9\x0d\x7a\x8c\xef\xb9\x22\x1f\x19\xdd\xd7\x20\x2d\x01\x9b\x5b\x3c\x8b\xc2\x35\x1d\x56\x6c\x69\x0b\xfa\x21\x50\x34\xa8\x5b\x63\x0e\xea\xe8\x3c\x78\xb1\xbf\x56\xab\x21\x52\xa8\x02\x76\xed\x68\x7b\x88\x41\x22\x16\x49\x87\x1c\xc3\x3f\x39\x0b\x4c\xa1\x05\xc4\x9d\xaf\x7a\x15\x51\x99\x16\x43\x22\x60\x9c\xbd\xed\xb6\xcb\x41\x37\x50\x33\x0d\xa2\x72\xaf\x5d\x6b\xbe\xdd\xba\x2d\x5b\x49\x94\xed\xff\xe6\x09\x66\x62\x4b\x43\x04\x5e\xb7\xeb\x95\xde\x44\xe3\x51\x2d\x4e\xfb\x18\x96\xd5\x78\x64\x02\xfc\xcd\x68\x14\x94\x85\xc2\xb3\xd0\xcd\xea\x34\x57\xe0\xa8\x83\x8a\x83\xef\x29\xf0\x06\x45\x7f\xd6\x11\x83\x3e\x97\x48\xb8\xbb\x80\x81\x7e\x55\xb9\xf4\xfc\x62\xb7\xf3\xfc\x92\x29\x9d\xc1\x93\x87\xeb\x5b\x23\x03\x3d\xd3\x3e\x58\xde\x83\xef\xd5\xbb\xbe\x6a\x23\x9e\xc4\x25\xf4\x48\xe9\xab\xca\x63\xe2\x20\xa1\xda\x6c\x2e\xe3\xa2\x9a\x8b\x45\x3a\x19\x9d\xc4\x1a\x4c\x43\xb4\x9c\xab\xf1\xb3\x50\x6c\x36\xcb\x45\x76\x22\x24\x8b\x3b\x6d\x46\x72\x83\x2f\x81\x0f\xc8\x6b\x3c\x2e\x27\x3d\x7a\x39\xc3\xa0\x6e\x3a\xbd\xcd\x8e\x3a\x48\x93\x4b\x8f\x22\xdc\x2d\x39\xee\x69\xc9\xcf\xc1\x56\x69\xf5\x08\xb7\x03\x95\x7e\x44\xf1\x50\xd1\x66\x1e\xb9\x90\x81\x16\x70\xe7\xd6\xb7\xe4\x9b\x8b\xbc\x5f\xb7\x66\x6d\x03\xeb\x9c\x91\xa1\x3d\x34\xcf\x74\xee\xb8\x54\xaf\xa8\x25\x20\xe0\xb1\x41\xf5\x86\x94\xaa\x86\x3e\x45\xcb\x5d\x99\x8d\x83\x5e\x89\xaf\x7f\x1b\xe4\xe1\xb8\x2c\x86\x04\xfa\x91\xc3\x72\xbc\x92\xee\x91\x0e\xef\x2c\x98\xa8\xdd\x21\x88\x3a\xee\x1c\x1d\x30\x12\x45\x89\xb4\x77\x49\xd3\xba\x65\xcf\xfd\x75\x90\xa6\x9d\x61\x31\xab\x72\x1b\xb5\x77\x7d\xdc\xd1\xf8\xd3\xa0\xd1\x04\x20\x5b\x8c\x86\x82\x08\x02\xdd\x41\xac\x52\x93\x55\x32\xb1\x8f\x50\x21\x1c\x21\x56\x36\xb1\x56\x5b\xaf\xd7\x18\xd2\xcb\xba\x76\x59\xc7\x68\xf0\x86\xc7\x09\xa6\x72\x5b\x8f\x43\x1d\x9e\x24\x8d\x43\xe5\x0d\x6b\x16\x3a\x3b\xd7\xc4\x4a\xc6\x9e\xe1\x23\x85\x33\xbf\x83\xc5\xd9\xaa\xde\xc9\x49\xe7\x3c\xb9\x27\x50\x69\x16\x3f\x6f\x6c\x96\x55\xd3\x8a\xbe\x49\x00\xff\xec\x2b\x1f\xb9\xa3\x7b\x1d\xed\x1a\x06\x91\x06\x95\xbd\x0c\x5d\x1e\x93\x31\xdf\x85\xbc\xd0\x51\xb7\x0b\x30\xd9\x13\x0d\xb7\xb7\x18\x3e\x4a\x29\x60\x71\x76\x44\xc8\xf8\x02\xfe\xf1\x32\x4d\x53\x0b\x57\xdd\x1c\x7c\x16\x83\xbe\x4d\x03\x84\x78\x68\xe2\x29\x12\xb4\x0c\xd3\xb5\xfa\x27\xb5\x37\xba\x0f\xb2\x4f\x53\x37\x8b\x25\x54\x75\xf5\x8c\x47\xed\xd1\x0e\xc8\x60\xb9\x39\xef\x7a\xc3\x81\x0e\x0d\xe9\x01\x2c\xd5\xb3\x6c\x66\xc0\xee\x6e\x69\x83\x43\x2a\x8c\xff\x34\xa2\x6a\x02\x83\x2b\x12\x3d\xc0\xb3\x4f\xd6\xf1\xa2\x7b\x50\x0b\xae\x95\xb1\xa8\x5e\x4a\x57\x21\x5a\x29\xc9\xcd\x17\x1d\xd7\x35\xf5\x59\x81\x7e\x24\x0d\x19\xc7\x29\x57\x95\x7e\x02\x80\x33\x28\xe4\xa1\x54\xf8\xf3\x2b\xc7\x6c\xa4\x35\x9c\x1c\xb7\x8d\x41\x8a\x43\x7d\xe6\x70\xce\x04\x0c\x41\x57\x33\x27\x67\x24\x7b\x0b\x31\x7d\x54\x2f\x24\xa9\x00\x43\xaf\xa7\x19\xf2\xad\x53\xf9\x73\xe4\x65\xfa\x64\x62\x59\x01\x0b\x4b\xb3\x94\x74\xa6\x72\x24\xd5\xe8\xe7\x4c\x6e\x66\xfa\xbf\xe5\xf5\x39\x07\x94\x57\x26\x62\xcb\x9a\xe5\x33\xb0\x78\x69\x88\x88\xcb\x28\xbd\xd5\x76\xb6\x62\xbc\x73\x40\x0a\x09\x73\x85\xb0\x8b\xca\x69\xde\x32\x05\x2e\xc0\x4f\x59\x01\x00\x52\x1b\x0a\x41\x6a\x72\xd4\x06\x07\x48\xd4\xb7\x26\x28\x43\xd1\x24\x1d\x01\xe0\xab\xea\xa0\xb7\xa2\xbc\x03\xfb\x10\x88\x38\x78\x5b\x6a\xa1\xaf\x96\x75\x03\x76\x6e\x6f\xf8\x99\x82\x6c\xf2\x3d\x18\xd8\x6d\x0d\x93\xe5\x67\x61\xf3\xe2\xbf\xc1\xf0\x6a\x16\x48\x19\x7f\x8b\x41\x4f\x9d\x84\xea\x26\x45\x4a\x6c\x10\x34\x39\x19\xbc\x07\x89\x1e\x91\xcc\x22\x76\xb6\x35\xca\xc6\xa5\xfa\xf1\xe3\x9b\xd7\xd8\x73\xdd\xdb\x08\x0a\x7e\xa3\xe6\x6e\x9c\x43\x8d\x53\x0e\xe0\xfe\x12\x3f\x29\x5e\xfa\x79\xc0\x99\xc6\x42\x03\x09\xa4\x88\x75\x01\x0d\xc5\xbb\xb3\x35\x9e\x8d\xc1\x92\x47\x4e\xbf\xc7\xfd\xc9\x76\x11\x8a\xef\x0a\xbb\x3f\x78\xdb\xc7\x25\x68\x8b\xd2\x29\xc7\x1b\x3f\x45\x54\x07\xde\xfe\x97\x37\x5f\x11\x80\xf3\x12\x7c\x82\x3d\x8a\xe2\x44\xe3\xb4\x58\x89\xef\x4e\x9b\xfe\x09\xef\xf5\x51\xf7\x67\x22\xd3\x57\xf9\xa3\xf0\xf4\x30\x2e\x33\x08\x72\xd8\x09\x1e\x3f\x1a\xce\x1c\x1e\x1e\x6b\x20\xea\xea\x33\x3a\x32\x5e\x59\x46\xb7\x4d\xe8\xed\xe1\x80\x5b\xe5\x39\x89\xf7\x71\x82\x7f\x34\xba\x55\x37\x94\x75\xbe\x50\x73\x24\x0b\x3a\xb1\x03\x90\xb5\x61\x7b\x7e\xa0\xd8\x9e\xa7\x07\x4a\x8d\xe6\xf1\x4f\xaf\x3e\xfe\xf8\xee\xa7\x8f\xb2\x90\xd8\x9c\x37\x3f\x7a\x0e\x12\xe7\xa4\x24\x61\xfa\xb6\xa0\xe4\x93\x2c\x13\xee\x93\xbd\x86\xb3\xd0\x0d\xd8\xf5\x95\x77\xb7\xa6\x57\xd2\xb7\xb5\xee\x55\x88\x46\x77\x6a\xed\x4d\x6b\xfa\x68\x75\x57\x84\x50\xc1\xab\x08\x4b\x19\x7d\x4b\xd1\x2d\x77\xae\xa3\x4d\xce\x31\x96\x0f\x3a\x88\x98\x9c\xc8\x2a\x36\x1f\x05\x53\x09\x7c\x24\x52\x64\x4d\x5e\x93\x27\x37\x78\xa5\x7b\xdd\x9d\xd0\xfb\x91\x48\x13\x85\xf6\x65\x7d\xcb\x92\x64\xb5\xfd\xc8\xc5\xd2\x68\xaa\xc6\xf7\x44\xf5\x24\x9e\x81\x2c\xdf\xba\x16\x5b\x8e\xb9\x13\x53\x16\xf4\x99\x8a\x0c\x83\x5f\x24\xd0\x37\xdb\x85\xa7\xc8\x00\x99\xe7\xff\x8d\x56\x3b\x6f\x36\x4f\xc1\xe7\xfc\xb7\x57\xe1\x9b\x27\xfa\xdb\x85\x37\x1c\x96\x18\x0b\xd1\x57\x55\x08\x87\x8b\xb6\xcc\xd5\xcf\xbf\xfd\x25\x14\x57\x64\xc6\xf7\xf3\xef\x7e\x01\x94\x3f\xff\xfe\x17\xc2\xca\xc1\x71\x18\x2b\x87\x7e\xa9\x4b\xfc\xf6\x97\xf0\x24\xf8\xf5\x93\x71\x59\xa5\xe3\x08\x0c\x32\xff\x39\x23\x3e\x68\x94\xa6\x48\xb4\x79\x64\xe3\x50\xb2\x0d\xae\x17\x22\x33\x18\x75\x95\xa2\xcd\x2c\xc4\x9e\x5d\x5a\x24\xdf\xa3\xf1\xa1\x5e\xce\x77\x31\x0f\x19\x8f\x33\x11\xb7\x4f\xd5\x5f\xdc\xc1\xe0\x9b\x00\xbf\x8b\x02\x4f\x30\x25\x3c\xa1\xa2\xbf\xc6\x8e\x02\x82\xbf\x2c\xd6\x9d\x0b\x05\x02\xfc\xfc\x2c\x04\xde\x40\xa5\x19\x03\x7d\x7f\x66\x23\x38\xe6\x64\x6e\x06\x25\xd0\xdb\xfd\x73\x10\xd1\x78\x94\xd1\x9e\x10\x1d\x2f\xc0\xd2\x31\x59\x85\x10\x32\xce\x8f\xce\x04\x1d\x0d\xd2\x67\x63\xe3\xa1\x1a\xa3\x4b\x23\xf6\xd9\x08\x31\x84\xd6\x04\x1f\xa6\xfe\x3d\x9d\xa5\xc1\xe3\x40\x62\x79\xdb\xf6\xe6\x28\xd1\xc5\xfe\xe1\x4d\xc3\xec\xb3\x54\x87\xbc\x9a\x04\x3f\x6f\xee\xdf\xe5\xcd\x3d\x8b\x4e\x36\x37\x6c\xe7\x26\xea\x6d\xb1\xb3\xf5\xb6\xea\x2c\x36\x31\x3c\x12\x9c\xfa\xdb\xe9\xde\x2f\x11\x72\xfb\x08\xa5\x34\x0e\x71\x7e\x66\xcb\x36\xce\xdf\xca\x16\x87\xdf\xa6\x55\xba\xdc\xe0\x67\x36\x34\x4b\x28\xd0\xe1\x21\xf4\x48\x3d\x55\xec\x9a\x30\xa9\x5a\xff\xe3\x47\x17\x1d\xa4\x54\x55\x55\x63\x8a\xe5\xc2\x75\x52\xc8\xca\x8d\xf1\xa6\x5f\x9b\x7f\x60\x58\xcf\x56\x98\x8c\x17\xb9\x42\xa4\x1c\x79\xd4\x8b\x8a\x3f\x6f\xec\xab\xda\x44\xb6\x58\xac\x38\x4c\xe0\x15\xb7\x58\xfc\x1c\x9d\xeb\x7e\x59\xe8\x2d\xcc\x94\xde\xba\x05\x94\xe6\xb0\x1c\x88\xa8\x77\xc7\x05\x7d\xc2\xaf\xdf\xc2\x41\xff\x5b\x15\xcc\xda\xf5\x2d\x04\xed\xfa\xed\x1e\x13\xf6\xb6\x1f\xa2\xc1\x84\x1d\x26\xec\xe0\xe2\x86\xcf\x16\x3f\x5b\x7d\xc2\xaf\x23\x7e\x1d\x8d\xb9\xa5\xc2\xf8\x9c\xf9\x2d\xbd\xdd\x31\xe5\x84\xdf\x27\xa3\xb1\x34\xd5\x03\x75\x62\x30\x4a\xfa\xb8\x0a\x0b\xaa\x8e\xd3\xe5\xe3\x2a\x2c\xa0\x56\x4e\xa5\x9f\x57\x61\xc1\x0c\x80\xab\x96\x9e\xf0\x57\x61\x01\xd5\x73\x12\xfd\xbc\x42\x49\x49\xdc\x09\x42\xfa\x7d\x15\x16\xd0\x0e\x4e\xa4\x9f\x57\x61\x01\x36\x05\xb9\x5d\xfc\x0b\x53\x73\xab\xf8\x17\xa6\x4a\x9b\xf0\xff\x62\xf1\x73\xeb\xdd\xe1\x6f\xae\x37\xbf\xa4\xc9\xc9\x4c\xd8\x17\xde\x1d\xc4\xa1\xa5\xf1\x64\xd4\xd9\xd9\x35\xf2\x04\x59\x71\x7d\xc1\x14\x6d\x63\xfb\xc3\x90\x8c\x5e\xd8\xf7\xc3\x17\x91\xc1\x18\x49\x8a\xd6\x70\x3a\x98\xe5\x02\xd2\x9a\xe8\x5c\xb3\xb2\x5b\x56\x22\x21\x25\x8b\x2f\xff\xf3\x3f\x11\xde\xfe\xcd\xfc\xd7\x7f\xa9\x37\xdf\x7d\xc5\x21\x40\x83\xda\xb3\xb3\x23\x01\xdb\xeb\x4f\x2f\x2b\xc8\xe5\x82\x5d\xe3\xb3\x12\x2a\xb9\xc6\xc7\xea\x17\xff\xf7\x00\x07\xfc\x4a\x0f\x3c\xbe\x01\x00"
 
 func confLocaleLocale_enUsIniBytes() ([]byte, error) {
 	return bindataRead(
@@ -4584,8 +4584,8 @@ func confLocaleLocale_enUsIni() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "conf/locale/locale_en-US.ini", size: 69108, mode: os.FileMode(0644), modTime: time.Unix(1583003971, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0x87, 0x60, 0x3c, 0x8c, 0xf3, 0x5b, 0xe, 0xc8, 0x1a, 0x59, 0x31, 0xe4, 0xd4, 0xa0, 0x19, 0xec, 0xdf, 0x7d, 0x8f, 0x72, 0xb2, 0xc6, 0xa8, 0xc8, 0xf6, 0x33, 0xde, 0xd8, 0xf2, 0xee, 0x78}}
+	info := bindataFileInfo{name: "conf/locale/locale_en-US.ini", size: 114236, mode: os.FileMode(0644), modTime: time.Unix(1792292269, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x98, 0x1d, 0x2, 0x1, 0xf7, 0xa, 0xf5, 0x60, 0x1d, 0xfd, 0x8a, 0xa2, 0x81, 0x48, 0x87, 0x91, 0x23, 0x7f, 0x21, 0x5a, 0x4a, 0x6, 0xc4, 0x42, 0x70, 0x99, 0xfc, 0x3d, 0xe0, 0x16, 0x17, 0xaa}}
 	return a, nil
 }
 
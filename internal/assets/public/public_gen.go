@@ -926,7 +926,7 @@
 // ../../../public/img/gogs-hero.png (35.001kB)
 // ../../../public/img/slack.png (1.633kB)
 // ../../../public/js/.DS_Store (6.148kB)
-// ../../../public/js/gogs.js (51.365kB)
+// ../../../public/js/gogs.js (55.885kB)
 // ../../../public/js/jquery-3.4.1.min.js (88.145kB)
 // ../../../public/js/libs/clipboard-2.0.4.min.js (10.754kB)
 // ../../../public/js/libs/emojify-1.1.0.min.js (13.252kB)
@@ -19928,7 +19928,7 @@ func jsDs_store() (*asset, error) {
 	return a, nil
 }
 
-var _jsGogsJs = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6d\x7b\x1b\xb7\xb1\x30\xfc\xdd\xbf\x02\xa1\xfd\x78\xc9\x8a\x5c\x4a\x4e\xd3\xd3\x23\x5b\xee\xe5\xd7\x53\xb7\x76\x92\x13\xdb\x4d\xcf\xe3\xa8\xbe\xc0\x5d\x90\x84\xb5\x0b\x6c\x00\xac\x64\x25\xd1\x7f\xbf\x2f\xbc\xed\xe2\x6d\x97\x94\xec\xb4\xe9\xb9\x6f\x7e\x90\xc8\xdd\xc1\x00\x18\x0c\x66\x06\x83\xc1\x20\x6b\x39\x02\x5c\x30\x5c\x88\xec\xfe\xad\x5b\xe7\x90\x81\x82\xb3\xf5\x7d\xf5\x8d\xb7\xab\x96\x55\xf7\x6f\xdd\x5a\xb7\xa4\x10\x98\x12\x80\x09\x16\x4f\x68\x5d\x23\x22\xbe\x65\xe8\x1c\xa3\x8b\x37\x70\x35\xbd\xb3\xa6\xac\x9e\x81\x9f\x6f\x01\x00\x80\x2c\x78\x47\xc0\xd5\x2b\x44\x5a\x70\x02\xd4\xbb\x7c\x8d\x49\x39\xcd\x72\x01\x57\x6d\x05\x59\x5e\x23\xd2\x66\xb3\xfb\x0a\xde\xc2\x5a\x18\x2c\x50\x9d\xcd\x24\xe8\x74\x04\xe2\x5d\x09\x05\x5c\x08\xb8\x3a\x99\x64\xe0\xa0\x07\x91\x8f\xa7\x59\xa3\xdb\x96\xcd\xc0\x01\xc8\x26\xa7\xd9\x2c\x2f\x2a\x5c\x9c\x4d\xbb\x7e\x4c\x6d\x6b\xfb\x16\x6f\x31\x97\xcd\x9d\xca\x2f\xa6\x62\x55\x79\xde\x50\x2e\xa6\xea\xbd\xc1\xde\xb2\x2a\x9b\xcd\x1d\x04\xf2\x33\x79\x2f\xe9\x36\x39\x56\xe4\x9b\xfb\xaf\x6a\x5a\xa2\xc9\x31\x98\x6c\xd6\xf5\x24\x78\x55\x50\x22\xd0\x47\x31\x39\x06\x6e\x0d\xe6\x69\x36\x0b\xa0\x2d\x68\x40\xd3\x9c\xa3\x8d\x1c\x92\x71\xa2\x5c\x30\x2c\x90\x25\x09\x90\xa8\x20\x43\x30\x9b\xe5\xe7\xb0\x9a\xce\xba\x8a\xae\xfc\x3a\x7b\x92\x49\x2c\xb3\xa0\xd7\x1d\xf9\x0c\xc5\xbf\x85\x04\x55\x89\x51\xdf\xaf\x85\xd1\xb0\xdd\x8f\x2a\xf3\x2a\xca\xb7\xa2\xae\x74\xbb\x62\x48\x54\xd3\x0f\x78\x7d\x99\xb3\x96\x4c\xbd\x52\xef\x0e\x4f\x53\x88\x55\xf5\xa0\xa0\x25\xca\xe6\x20\x2a\x90\x23\x58\x6c\x1d\xfe\xc1\x73\xb0\xaa\x68\x71\x96\x22\x88\xfc\x6c\xab\x0f\x3c\xdf\xe2\xcd\xb6\xc2\x9b\xad\x78\x2c\x41\xa7\xba\x40\x5c\xf5\x55\xf0\xec\xaa\xfb\x65\x5e\x48\x00\xf5\x65\xd5\x0a\x41\x09\x7f\x22\x99\xf9\x1b\xf2\x8c\x08\xc4\xe4\x24\xb9\xd2\x13\xd7\xb4\xf9\x39\xae\xd0\x2b\x5a\x22\x1e\x4e\xdc\x67\x25\xfe\x27\xce\x5a\x97\x2d\xde\xc0\x95\x44\xfa\xe9\xf3\x58\xa3\xc6\x6b\x30\x75\x50\xe7\x15\x22\x1b\xb1\x75\x87\x22\x24\x85\xac\xdd\x29\xe0\x61\x5f\xac\x71\x85\x16\x72\x8e\xf2\x6c\x96\xf3\xa6\xc2\x62\x9a\xcd\x5d\xe6\x73\x8b\x8e\x09\x12\xb0\x4b\x98\x80\x6b\x08\x14\x30\x2e\x54\xc0\xb5\xa5\x07\xf8\x67\x49\x10\x10\x4b\x11\xb0\x97\x24\x09\xd9\xe6\x57\x97\x26\xe0\x5a\x12\x05\x5c\x5f\xaa\x80\xcf\x2f\x59\xc0\x35\xa5\x0b\x48\x48\x18\xe0\x49\x19\xd0\x4b\x1a\x17\xf8\x4a\xca\x95\x48\x80\x3c\xc5\xeb\xf5\x6f\x52\xe7\x97\x78\xbd\xfe\xad\x28\x7c\x35\x03\xc9\x6f\x54\x55\x4b\x42\x7d\xfb\x39\x26\x98\x4f\xf1\x84\x4a\x0d\x6b\xda\x5f\x5f\x87\x25\xe3\xd9\x95\x54\x92\x57\xb7\x12\x1c\xfb\x9c\xb2\xba\x1b\x7e\xa5\x3a\xa6\x59\x8e\x4a\x2c\x72\xd9\xeb\x6c\x66\xd4\x07\x38\x39\x01\x87\x2e\xd1\x18\x12\x2d\x23\x76\x2e\xe8\xd2\x09\x2d\xea\x23\xb3\x1a\x2a\x9c\x2d\x11\x54\xd4\x54\x63\x56\x27\x5b\x5b\xe8\x77\x7b\x35\x38\x6c\x6f\xc2\x5c\x8f\x30\x5a\xfb\x62\xb9\x04\x2f\xe1\x0a\x55\xbc\x9f\xd5\x15\xe6\x42\x4d\x93\x2c\x6f\x71\x5e\xa9\xb7\xb9\x7c\x98\xb9\x7a\x9e\xd0\xd7\xa8\x42\x85\x82\x94\x2f\x2d\x2f\x11\xba\xe0\xea\x85\x07\xad\xb0\x58\x59\x31\xcd\x72\x0d\xb2\x50\x8f\x81\x27\x28\x24\xf8\x16\x72\xd5\xa8\xb7\x4d\x09\x05\x7a\xa4\x29\x76\xe2\x60\x31\xec\x08\xd5\x9b\x6c\x26\xe9\x92\xb5\x0a\x38\x33\xfd\xea\x08\xad\x1f\xbf\xe0\xbc\x45\xaf\x90\x80\xd3\x96\x55\x73\xa0\x0b\xce\x01\x2e\x5d\x6a\x1a\x39\xa0\x20\xf6\x9f\xf9\x1a\xd7\xe4\xd8\x22\xf5\xdf\xe2\x72\x72\x0c\x70\x99\x10\xb5\x96\xfc\x8f\xca\x12\xdc\x25\x2b\xde\xdc\x07\x82\x02\xa9\x1a\x40\x4b\x34\x7d\x50\x09\x34\x85\x04\x05\x67\x08\x35\xe0\xed\x0b\x50\x51\x7a\xc6\xc1\x86\xd2\x32\xb7\x18\xde\x48\xb9\xc6\xb7\xb4\xad\x4a\xb0\x42\x00\x96\x25\x2a\x41\x89\x19\x2a\x44\x75\x29\xcb\xfe\xf9\xcd\xab\x97\xd2\x92\x04\x9c\xd6\x68\x4b\x2f\xc0\x87\x96\x0b\xb0\x41\x02\xa0\xba\x11\x97\xe0\x01\x6f\x20\x79\x08\x28\x01\x4a\x44\x36\x70\x83\x34\x72\x87\xe2\x8e\x54\x3e\x26\x54\x4c\xfb\x81\x9e\x81\x9c\x16\x02\x17\x94\xe8\x17\xe6\xc7\xa2\xd8\xa2\xe2\x6c\x96\x45\xea\xce\x23\xb9\x96\xc5\x5a\x4c\x64\x9a\x0c\x99\x33\xbd\xf7\x6f\xc4\x0e\x05\xa0\xe7\x95\xa9\x0c\xf2\x27\x15\xe4\x7c\x9a\xa9\x26\xa2\x32\x9b\x85\xa2\xd3\x82\x32\x54\xd3\x73\x14\x42\xdf\x4f\xc2\x9a\xc6\x99\xee\x67\x41\x61\x8f\x2a\x59\xba\xc7\x6e\x63\x53\x73\x20\x25\xe0\x43\xfe\x8e\x26\x89\x06\x58\x18\x8d\x36\x29\x91\x80\xc5\x76\x32\xef\x9a\xad\xa1\x70\xd9\x49\xb2\x8e\x55\x7b\xa6\x05\xa8\xe2\x68\x80\x44\xb0\x2c\x6f\x48\x9f\xbe\x64\x9a\x38\xff\x4c\xb2\x40\x71\x03\xb2\xdc\xf2\xec\x0b\x55\xc3\x8b\x52\x9a\x18\x93\xc9\xfd\x88\xc5\x1b\xc8\x10\x11\xd3\x59\x60\x01\x8d\x4c\x8e\x1b\xf0\xad\xfc\x74\xed\x38\x38\x49\xf4\x06\x1c\x80\xc9\x7c\x92\x5a\x0d\x87\xb0\x66\x66\x51\x96\xcd\x02\x5e\xde\xe2\x12\x85\x83\x93\xe4\x91\x3d\x10\xf7\x4c\x90\xc4\x1a\x09\x4e\x4b\x14\xdb\xcb\x21\xd5\xa8\xaa\xb6\x4a\x6a\xbc\xf9\x69\xf6\xee\xca\x0e\xb7\xf0\xea\x56\xdc\xc9\x6e\x98\x1d\x06\x52\x46\x9c\x6d\xb0\x53\x5e\xab\x6f\xb0\x86\x15\x47\xbb\x64\x5e\x27\xe9\x2c\xe3\xec\x92\x76\xfb\xcc\x94\xeb\xce\x92\xa2\x42\x90\x4d\xe6\x20\xf3\xa9\xf0\x99\x78\xfd\x5f\x27\x74\x3b\x77\x8b\xa6\xbd\x63\xce\x5c\xaf\xe5\x23\xac\xe2\xae\x37\xf6\x63\xcb\x3d\x78\x2a\xcb\x42\x7f\x51\xd7\x46\xcd\x2c\x2f\x04\xaa\xa7\xfa\xeb\x7b\x5c\xce\x01\x26\x4d\x2b\xbf\x45\x6b\xa3\xda\xda\x66\x1d\xb0\xb4\xf2\x7d\xd3\x0c\x24\x2d\x44\xb9\x4c\xf0\x0a\x79\xb6\x22\xe8\x4d\xba\xd0\x9a\xab\xf7\x32\xe4\x14\x25\xea\x4f\x53\xfe\x9f\xce\x9a\x83\xec\xd9\x19\x6a\xb2\x0b\xe7\x28\x0b\x7c\x22\x2e\x5f\xa5\x19\x25\x42\x90\xd4\x79\xd7\x56\x77\xf5\xd0\x1c\xce\xb2\x6b\xe9\x38\xf9\xe1\x17\x58\x14\x5b\x30\x4d\x31\x8f\xfd\x14\x90\x23\x90\xdd\xae\x71\x85\xb8\xa0\x04\xbd\xc7\x65\x76\x9c\x76\x8e\xb8\xd3\xcb\xf6\xbe\x9b\x9b\x0f\x20\x28\x24\x61\x4e\x26\x72\x60\x26\x60\xcb\xd0\xfa\x44\xad\x44\xbd\x36\xcb\xc7\x7a\x21\xfa\x30\x03\x07\x83\x5e\x13\x5b\x48\xae\xa7\xa7\x0a\xfc\xc1\x12\x3e\x1c\xf2\x0b\xad\x18\x82\x67\xf1\x2b\xd3\x35\xc8\x39\xde\x10\xf4\x1b\xe9\x59\xf6\x00\xd7\x1b\x8b\xb0\xc5\x00\x9e\x43\x01\x19\xc0\x35\xdc\xa0\x09\xe0\xac\x48\xa0\xd6\x30\x9f\x8d\x6c\x57\x01\x67\x0f\x0b\x03\xe0\xae\x0a\x77\x68\xfc\x3b\x3d\x9b\x29\x11\x37\xca\xab\x9e\x54\xad\x6f\xaa\x2d\xc1\x0e\xf1\x90\x90\x36\xff\x24\x71\xf1\xab\xcc\xfe\xc8\xae\xbe\x0a\x64\xd4\x28\x1b\x47\xe3\x35\xb0\xf8\xdf\x6d\x31\x86\x23\x9d\xa5\xf4\x65\xbf\x4e\x7e\x65\x45\x0b\x80\xa4\x04\x76\x36\xaa\xb7\x8e\xae\xeb\x5c\x0b\x9d\x24\x92\x3d\xf6\xe5\x92\x41\x9d\x2a\x65\xd1\xaa\x42\xee\x8c\xd7\xfe\x1b\xcf\x7d\xf3\x1d\x6a\x28\xc7\x82\xb2\xcb\xc8\x7b\xc3\xba\x57\xd7\x72\x36\x79\xe8\x9f\xe3\x4a\x20\xf6\x1a\x41\x56\x6c\x9f\x32\xda\x94\xf4\x82\x4c\xad\xe5\x1c\xe9\xef\xd2\x40\x38\x3a\x9c\x32\x77\x76\x58\x80\xdc\x7e\x99\xfe\x1c\xb8\x12\xab\xea\x0d\xfa\x28\x74\x85\xc7\x40\xb0\x16\xf9\xbe\x0c\x4a\x9e\x6c\x21\xd9\xa0\x63\xc7\xed\x28\x25\xc4\x1c\x9c\xc3\xaa\x45\x73\x70\xa7\xd8\x52\x5c\xa0\x14\x87\x5e\x60\x52\xd2\x8b\xbc\xa2\x05\x94\x05\x73\x29\xe5\x64\x53\x75\x09\xd7\xe9\x9a\x90\xbf\x94\x70\x5a\xa1\xbc\xa2\x9b\x69\xa2\xc0\xe8\x2e\x44\x8d\x38\x87\xb2\xc9\x3f\x03\x42\xbf\x43\xbc\xad\x04\x3f\x76\x89\xa1\xf0\x10\xba\x60\xfa\x5d\x36\x4b\x2c\x39\x7a\x1e\x7c\x8e\x2b\x04\x94\x44\x93\x3c\x58\xd0\xba\xc6\x82\x0f\x8c\x7c\xbe\xc6\xb2\xd1\xca\x16\xb2\x3c\xf0\x10\x1c\x82\x5f\x7e\xe9\x2a\xf0\xe1\x0d\x3a\x0f\xda\x33\xea\x87\x98\x22\xcb\x8b\x2d\xa5\x1c\xe5\x0c\xad\x11\x43\xa4\x40\xa0\x1b\xe5\xcc\xb3\x6c\x55\x85\x06\x26\x2f\x68\xd5\xd6\x64\x2f\xd9\x98\xaa\x81\x17\x8c\x56\x15\x26\x1b\x63\x23\xe6\x85\x9c\xe8\x25\xe6\x4d\x05\x2f\xe5\xfc\x21\x72\xf2\x45\x73\x3e\x85\x4a\xef\x5d\xf9\x12\x63\x55\x41\x69\xb2\x87\xc5\x7d\x75\x20\x20\xdb\x20\x21\xad\xe1\xb0\x72\xb5\x37\xb2\x63\xd1\x60\xaa\xed\xf5\x51\xb2\xce\x78\x91\x96\xe6\x8d\xef\xf1\x19\x1e\x62\x85\x0b\x7c\x86\x73\xbd\x35\x75\xe3\xc1\x6d\xe0\x26\x18\x57\xbf\xfe\x6f\x1a\x39\x7a\x83\xdc\xc8\x91\x10\x98\x6c\x78\x4e\x35\xdc\x60\x4b\xee\x4c\xb3\xdb\xb2\xdc\x7b\x02\x6b\x94\xcd\xf2\x33\x74\xd9\x36\xbb\xb6\x3f\x1b\x46\xeb\xc6\xac\x09\x54\xe9\x85\x2c\xbd\x28\x94\xc0\x58\xe8\xb7\x29\xd3\xd6\x8e\x88\xda\xe5\xc8\x05\x7d\x2d\x18\x26\x1b\xf5\xf5\x25\xbd\x40\xec\x09\xe4\x68\x3a\x03\x5f\x84\x9e\x8c\xae\x8a\x6c\xb8\x54\x52\x29\xeb\xa6\xe4\x7c\x4b\x2f\xa6\x7b\x7b\x30\x4c\x21\xa9\xc4\xa2\x42\x23\x1c\xf1\x98\x41\x52\x6c\xd1\xee\x21\x59\x19\xc0\x9b\x70\x47\xc3\xa8\x50\x1a\x7a\x61\xb1\xc4\x4c\x62\x67\x1e\x22\x70\x55\xa9\xe1\x90\x45\x94\x07\xdc\x3e\xbb\xd8\x62\x81\x8c\xb0\xd2\xa3\xb6\xc3\x29\xa5\x36\xcb\xcc\xe2\x3c\x6d\xfe\x0c\x4d\x55\x6f\x9a\x97\x98\xcb\xfa\xa3\xf5\xfd\xde\xee\xa4\x1e\x6f\x3f\x8f\x07\x91\x8e\x0c\x96\xb3\x0b\x92\x18\x2a\xbd\x0b\x32\x38\x3e\xcb\x25\x78\xc2\x10\x14\x48\x3b\xdf\x7c\xd5\x4c\xd0\x85\x42\xde\x6d\xbd\x49\x43\x09\x5d\xe8\xcd\x0f\xbb\xef\x16\x0e\x55\x0f\xa0\x23\x40\xf6\x11\xd3\x5e\x45\x11\x8b\x5f\x0d\xd6\x60\x9a\x00\xf2\x02\x92\x02\x55\xd7\xaf\x2a\x9c\x18\x57\xa1\xd2\x29\x68\x45\xd9\xa2\xc1\xc5\x19\x62\xfb\x7b\x53\x6a\x4c\xb0\x2a\xc9\xc7\xfa\xd1\x30\xa4\x81\x80\xae\x66\x8f\xe6\xab\x98\x37\x09\xfb\x7e\x8b\x3e\x82\x50\xb6\xe8\xc6\x6e\xd1\xc7\xa4\xf2\xf2\x7b\x22\xe5\x56\x87\x29\x01\xde\x77\x61\xc1\x2f\xa0\x28\xb6\x8b\xae\x8d\x9c\x4f\x27\x2b\x58\x9c\x6d\x18\x6d\x49\xa9\x9f\x4f\xe6\x20\x85\x2d\xec\x32\x2a\xb1\xd9\x3b\x5b\xec\xcf\x1d\xd3\xec\xb6\x2e\x52\xd3\x12\x56\x0b\x69\xd3\xee\x5e\x60\xc5\x35\x82\x9e\x71\x16\xca\x7e\x4f\xa2\x11\x58\x54\x68\x27\xa6\x04\x31\x13\x43\x91\x44\x73\x7d\xc2\xee\x8b\xb9\x6f\x60\xae\x28\x95\xcd\xf4\xff\x69\x2c\x8b\x28\x79\xd4\x34\x8c\x9e\x7b\x26\xf1\x50\x14\x47\x80\xdb\x6c\xec\xf2\x76\x55\x63\x11\x6a\x15\x10\x2d\xaf\xaf\x6c\x2b\x32\x39\xaf\x6f\x6a\xa3\x74\x6b\xa8\x41\x41\xd7\x2d\x94\x62\x61\x77\xab\x6f\x55\xa2\x20\x41\x17\x7d\xe1\x41\x41\xa9\xd7\x2a\x50\x20\x3d\xe8\x46\x1a\x76\xe5\xf2\xfe\x95\x27\x10\xfb\xc7\x0a\x42\xe0\xda\xfc\x0c\x06\xa5\x82\x64\x73\x1c\x82\xc3\x69\x26\x9f\x87\xc1\x51\x98\x54\x98\xa0\xd4\x42\xa7\x47\x7f\xac\x49\xea\xbf\xe6\x02\x32\xf1\x14\x0a\x94\xaa\x49\xbd\x5c\x28\x07\x66\x50\x9f\x1c\x70\x68\xca\x65\xff\xb3\xa8\x17\x65\x16\xae\xaf\xb4\x57\x58\x83\xf4\x0c\x55\x88\xb4\x7e\xcd\x6e\x97\x08\x96\xb2\x13\x56\x10\x09\x45\x9d\xe7\xaa\xa2\xa9\xa9\x63\x64\xfb\xca\x97\x2a\xb7\x95\x73\xdf\x34\x7d\x2f\x71\x12\x54\x1f\xb9\x06\xf6\xe5\x4a\xe5\xb8\x18\xe4\x48\x69\x36\xe7\x58\x82\x8c\xa9\xdf\x67\x25\x16\x40\x41\x01\x25\x7b\x7c\x8e\x53\x2f\xde\xc8\xe7\xc6\x44\x55\x0f\x16\x46\x4a\x05\xde\x6d\x39\x49\x5f\x48\xc9\x66\x60\xd5\xa4\x55\xa0\x9d\xc0\xd3\x0b\x08\xf3\xcb\x2f\x2e\xa1\x55\x4d\x6f\xe8\x66\xa3\xea\x1b\x21\x62\xdf\xae\x5c\x28\xf0\x50\x10\x28\x45\x4d\xc5\x02\x93\x85\x44\xac\xac\xdd\x01\xb8\x54\x3b\x87\x91\xee\x44\xd8\x51\x21\x5f\xd3\xa2\xe5\xe1\xfb\x81\xb1\xf5\x39\xaa\x6f\x51\xc7\x51\x01\x79\x42\x16\x54\x06\xc8\xe2\xda\xe5\x38\x3c\x47\xfb\x94\xda\xc9\xd6\x8a\xff\xfa\xae\xeb\x65\x89\xe3\xc0\x71\x57\xef\x09\x52\x29\x78\x09\xe8\x8d\xac\xf2\xa2\x26\xe7\xb0\x5f\x32\x51\x28\x56\x0b\x69\xc2\x83\x84\x1b\xcf\x44\xb5\x79\x6a\xcf\xf7\x06\x5e\x3f\xfc\x54\x91\x77\x72\x1c\xf5\x79\xcc\x07\x03\xf6\x8e\x04\x0d\xb0\x4a\xd0\x5c\xd5\x38\x14\x67\x19\x12\x6c\xb4\x44\x18\x12\x39\x2c\x9e\x06\x04\x0b\x65\xc0\x04\x51\x01\x13\xee\x17\x5b\x65\xe6\xc5\x9e\x46\xe8\x1d\x6b\x7a\x9f\xc4\x0e\xe8\xf8\x0b\x51\x3c\x71\x3f\xc6\x22\x6b\x7e\xa2\x2b\xfe\xff\x29\x51\x32\xce\x20\xb6\xae\x0e\xb7\x6d\x8b\x9f\x12\x8e\x19\x85\x87\x21\x52\x22\x66\x30\x25\xb0\xe8\xf7\x7d\x1f\x53\x28\xe0\xc5\x48\x79\x78\x31\x5a\xd8\x86\x3d\x06\x5e\xf0\xe5\x12\xbc\x46\xa2\x6d\x00\x41\x17\x4a\x8d\xa6\xa7\xac\x43\x01\xed\xab\x1e\x74\xbd\x76\xa3\x96\x2c\xd6\x49\x2e\x4b\x2f\x63\xaa\x69\x9c\xa9\xa8\x47\xdb\xee\xb8\xcb\x7d\x20\x67\xd0\x27\xd3\xaf\xff\xc2\xe7\x48\x75\x4b\xc5\x7f\x2e\x4d\xa4\x30\xb0\x41\x98\x80\xc0\x1a\x01\x41\x41\x89\xb9\xc0\x64\xd3\x62\xbe\x05\x6b\x46\x6b\x40\xc5\x16\x31\x1e\xa1\x0c\xd9\x41\xda\x02\xb2\x55\x61\x47\xcd\x80\xb4\x38\x08\x0b\x8c\x3b\x17\x06\xfa\x06\xc8\xc7\x62\x7e\x7d\x1a\x99\x40\x52\x28\x04\x9b\x66\xaa\x83\x3a\xe6\x75\x1e\xb7\xce\x0b\x89\xdd\x13\x99\x8d\xfd\x1e\x44\xd7\x05\x87\x8f\x21\x34\xbd\x51\x55\xdb\xad\x23\xa7\x12\x01\x57\x9f\xd4\x5e\x83\xde\x34\xe5\xba\x15\x8c\xf6\x60\x60\x5c\x74\x4f\x3a\x27\xc3\xe7\xe8\xcc\x40\x4d\xb6\x53\xd7\xaf\xcb\xed\x57\x54\xdd\x40\x40\x6b\xd0\x88\x54\xc9\x21\xa6\xd7\x46\xc6\xfe\x7e\x95\x0e\x9f\x27\x1e\x93\x2e\xc4\xc1\xaa\x53\xae\x43\x30\x10\xa6\x3f\xd4\x6e\x69\xe4\xfc\x6b\x5a\x9d\x86\x36\xf6\x45\x7a\x4c\x77\x19\x1a\xf6\xb3\xc3\xe0\xe8\xc0\x9c\xf8\x7a\x2b\x53\xb5\xe5\xb1\xab\x8c\x3e\xfc\x92\x6e\x64\x77\x6c\x26\x89\x23\x61\xc6\xd8\xcf\x7e\xe6\x8c\xfd\x48\x0d\xa5\x2c\x93\x1d\xea\x28\xfc\x04\x43\xd7\x29\x27\x42\x1d\x33\x63\x50\x2b\x79\x5d\x19\xf2\xa6\xee\x53\xa5\x6a\xba\xa9\x70\x47\x3d\x20\x8c\xea\xf7\xd0\x0d\x1f\x98\xf1\x9a\xe0\x1f\x9e\x89\x30\xdc\xe4\xf4\x8c\xfb\xb9\xee\x49\x1a\xf7\x93\x9a\xae\xde\xfb\xc1\xb7\xc9\xf3\x38\x7b\xfb\xbc\xf7\x33\x30\xfc\x96\x44\x26\xd4\x96\x86\x66\x86\xb4\x30\xf4\x46\x66\x73\x09\x18\xbc\xb0\x66\x2d\x80\x1c\x10\x84\x4a\x54\xc6\x6b\x42\x77\x16\xa5\x84\x49\xc8\x41\x09\xc1\xa7\x2c\x36\x7f\x16\xef\xb4\xd4\x3c\x70\xc7\xca\x4c\x2f\x94\x82\xd0\x94\xae\xf0\x75\x96\xb2\xc1\x3a\xe0\x29\xaa\x90\x40\x76\x01\xe0\x19\xfe\xa5\x7a\xb5\x30\xaf\xf6\x35\xfd\xc7\x4e\x0c\x4a\x0a\x15\x94\xac\x31\xab\xbd\x63\x49\x15\x2d\xa0\xf2\xab\x26\x69\x74\x8d\x63\x86\x20\x14\xbd\x09\xe6\xcc\x4b\x4a\xc6\x76\x81\x1c\x12\xdc\xd6\x67\x85\xdc\x03\x89\x8a\x16\x0b\x1d\xaa\xa8\x77\x7c\xf6\xd1\x7f\x57\x37\x18\x19\x1d\x95\x00\xb8\x80\xa2\xe5\xbe\x2b\x47\x3f\x7b\xac\x74\xa6\xf1\xe6\xe8\x47\x9d\xdf\x3c\xf0\x3f\x98\x66\x4b\x63\x18\xa8\x45\xd3\x7b\x73\x0c\x6b\xd7\x2e\x68\xbc\x99\xb9\x8b\xa3\xdd\xb6\x69\x2e\xf6\x1f\x75\xfe\x44\xd1\xf2\x28\xca\x61\x50\x52\x5c\x07\xeb\x02\x2a\xf7\xb8\xe1\xda\xd9\x3e\x6e\x42\x0f\xd7\x3e\x3e\x42\xdb\x7e\x3d\x6f\x87\x9b\x72\x0e\xab\x94\x37\xde\x1b\x91\xa4\xbb\x3c\x76\x27\x3e\xc5\xeb\xf5\x90\x33\x51\x1f\x60\x1b\x73\x4e\x17\xb4\x25\xa2\xf3\x4c\x4b\xf8\x85\x79\x94\x05\x11\xe8\x16\xb4\xc3\x76\x02\x8e\x22\x12\x58\x98\x58\x69\x49\xe3\x7f\xf0\xc4\x9e\x7c\xa9\x9a\xa0\xa0\xd2\xab\x32\x58\x96\x2f\xb1\x5e\xed\x4b\x28\xa3\x10\x78\x03\x89\x3e\xc4\x57\x61\x82\x4e\x73\x58\x96\x99\xf1\xfe\x4c\xe4\x93\xc9\x00\xb6\x12\x55\x7b\x60\x2b\xd5\xf6\xe0\x6e\x6c\xb0\x2c\xbf\x45\xac\xd0\x6e\x80\x06\x32\x8e\x9e\x57\x14\x8a\xa9\x69\xf3\x0c\x2c\xc1\x34\xf5\xf8\xc0\x05\x36\x4d\x9a\xcd\xc0\xef\xc0\xd1\xe1\x61\xc2\x52\xee\x5b\x3a\xc9\x57\x90\x01\xd9\xdb\x89\xd9\x02\xba\xc0\xa5\xd8\x4e\xe6\x6e\x53\x0e\xc0\xe4\xff\x0b\x5b\x7c\x35\x14\xc9\x6e\x06\x5f\x1d\x15\x5f\xd1\x8f\x20\x97\xfd\xe5\x0b\xd2\xee\x13\x4e\xe8\x8a\x03\xbd\x0c\xc2\x03\xa7\x35\x06\x22\xa3\x8c\x48\x8d\x50\x8c\x39\xf3\x9d\xc6\x6b\xac\xb3\x9c\x92\x69\xb6\x85\x7c\xab\xb7\xf4\xb3\xb9\x63\xb9\x46\x71\x5a\x83\x5d\x96\xa6\x58\x6e\x03\x16\xfd\xed\xfb\x74\xd0\xb2\xe4\x00\xc9\xbe\x51\xdf\x20\xdf\xe6\x35\x14\xc5\x76\xba\xfc\xc7\x6d\x55\x57\x7e\x70\x67\x99\x50\x7e\xc9\x99\x71\x67\x5a\x2b\x03\x90\xe3\x55\x85\xc9\x86\x4f\x33\xa7\x81\x5e\x20\x4f\xba\x59\x2e\xb5\x72\xc1\xf0\x66\x83\x98\x47\x9e\x48\x94\xfc\x77\x8b\x8b\x33\xbd\xe1\xa3\x0c\xa5\x5e\x92\x80\x2d\xad\xf5\x26\x43\x17\xf1\x52\x54\x94\xa0\x05\xe7\xdb\x1d\xfc\xa1\xb6\x91\x15\xac\x52\xcf\x46\x4a\x7b\x6e\xda\x0a\x93\x33\x4f\x1c\x06\x95\xe8\x82\xf1\x7e\x69\xa2\x5c\x18\x6f\xbe\xaa\x5a\x14\xaa\x3d\x07\xf3\x56\x88\x86\xc7\xb1\x58\x7e\x19\x65\x86\xbc\x16\x94\xc1\x0d\xca\x39\x32\x31\x94\x0e\x96\x86\x51\x41\x0b\x5a\x65\x73\x90\x29\x82\x18\xc2\xda\xd3\x2d\xe9\x3a\xff\x6d\x89\xa6\xc7\xfc\xf3\x91\xcc\x10\x64\x94\x68\xba\x37\xe3\x24\xd3\xdd\xd0\xd1\xa0\xd3\xf0\xa4\xc8\x72\x09\xbe\x6d\xab\x0a\x30\xf4\x63\x8b\xb8\x18\xd2\x98\x05\xad\x1b\xc8\x50\xde\xb4\x55\xf5\x09\xd1\x6b\x3a\x38\x29\x15\xbf\x36\x50\xaf\xf2\x25\x8d\x56\xea\x1e\x6a\xae\x11\xdb\xa0\x5c\xca\x2c\xb5\xf5\xf5\x8e\xc0\x1a\x9d\xa8\x87\xef\xb9\xb8\xac\xd0\xe9\xbe\x61\x4d\x9e\x0d\x07\x4e\x4e\x4e\x40\x56\xa8\xc8\x9e\xf7\x1a\x9b\x0e\xd2\xcc\x06\x76\x64\x4d\x0c\x67\x5e\x22\x5e\x30\xac\x02\xed\xf2\x35\x46\x95\x54\xc1\xd7\x0b\x39\x1b\xc7\xb5\x2b\x12\xcd\x90\x36\x8c\x58\xfe\x1e\x9f\x61\xef\xb4\x79\xe7\x2e\x7e\x64\x16\x97\x71\xd8\x62\x97\x8b\xe0\xb6\x63\x0e\xdf\xef\x47\xcd\x96\x1e\x18\x24\x82\x2e\xc0\x6b\x5c\x37\x15\x7a\xf5\xf4\x59\xb0\x5b\x0f\x5b\x41\x9f\xd2\x0b\x52\x51\x58\x3e\xa7\x44\x3c\xba\x40\x9c\xd6\x28\xb9\xe9\x8e\x2a\x24\x07\xfa\xb8\x6f\xee\xbb\xc3\xd3\x68\x83\xbd\x40\xaf\x2f\x49\x91\xda\xd3\x37\x4b\xde\xef\xd4\xe2\xd4\xdd\x5d\x6f\x2a\x88\xc9\x1b\x15\xc6\x6c\x60\x66\xe0\x67\x75\x2e\x9b\x5f\x92\x02\xd4\x48\x6c\x69\x19\x8d\x0f\x47\xe2\x0d\xae\x11\x6d\xc5\x1e\x0b\xa4\xe5\x12\x3c\x7f\xf1\xf7\x57\xcf\x8e\x01\x17\xb8\xaa\x00\x47\x4a\x95\xc8\x96\xd8\xd9\x07\x2e\xb6\x88\xd8\xf5\xce\x0a\x16\x67\xea\x2c\x78\x89\x05\xa8\x69\x89\x76\xfa\xdf\xd4\x00\xec\xb1\xe4\x03\xd7\xf0\xb8\x0d\xa7\xb0\xf2\xc0\x02\x27\x9b\xd3\x92\x91\xa4\x34\x5d\x69\x53\xb4\x1b\x84\x5f\xd7\x11\xd7\xf9\xdd\x09\x41\x4c\x1d\x8a\x3f\x01\xd9\x83\x12\x9f\xdb\x83\x34\x35\x64\x67\x52\x3a\x4d\x1e\x4a\xeb\x4b\xa2\xd4\x87\x5f\x4a\x7c\xfe\x30\x1b\x77\xfd\x78\x6e\x2f\xb3\xe3\x46\x59\xb7\x2d\x31\x1b\x77\x7e\xa5\x1d\x47\xa9\x05\xf3\x1c\x1c\xa6\x3c\xb2\x86\x75\x26\x2f\x29\x2c\x31\xd9\xe4\x79\x1e\x9c\xf1\x0d\x88\xa8\xd9\x0f\x93\xcd\x13\x4a\xd6\x78\x73\x9c\xa0\x1c\xc7\x64\x53\x21\x69\x81\x3f\x66\x08\x9e\x71\x33\x2f\xc7\xb0\x62\x52\x22\x22\xbe\xc7\x62\xfb\x06\xae\x78\x72\x22\x0b\xb8\x7a\x8d\x7f\x42\xc7\xe0\xf7\x41\x54\x4d\x83\xaa\xea\x89\x8a\x27\x4d\x87\xdd\x08\x4a\xab\x15\x64\xc7\xe0\xdd\x64\x45\xab\x72\x32\x07\x13\x2c\x60\x85\x0b\xf9\x8d\x0b\x86\xcf\x90\xd8\x32\xda\x6e\xa4\xd1\x3f\xf9\x25\xc1\xb3\x93\x2d\x52\xc4\x59\x1c\x49\x08\xfb\xe3\x9e\xfb\xe3\x4b\xf7\xc7\x4a\x59\x88\xee\x13\x5e\xc3\xaa\xd2\x8f\x92\x15\x48\x43\x54\xbe\xfc\xb1\xa5\x02\x0d\x42\xb5\x84\xb2\x12\x31\x54\x2e\x2a\xcc\x85\x04\x0b\x7f\x27\x8b\x49\x3b\x45\xf5\x5a\x9d\xf1\x9a\x83\x89\x80\xab\x4a\x7d\xd9\x52\x86\x7f\xa2\x44\xc0\x6a\xc1\xda\x6a\xb8\xe2\xa2\x42\x90\x2c\x94\xb3\x53\xc2\x18\xde\x94\x5f\xd7\x6d\x55\xf1\x82\x21\x44\x26\xa7\x49\x3d\xa2\x92\x03\x5a\x39\xae\x58\x5b\x27\x0c\x94\x3d\x7e\x85\x19\xa3\xcc\x3e\xbd\x25\xc5\x1d\x65\xe0\xc5\xb3\x5b\x3a\x2e\x5b\x85\x28\x53\x71\xd9\xa0\x1c\x91\x92\x4b\xee\xf0\xa2\x62\x1a\x28\x04\x62\xc4\xd5\x49\x25\x38\x51\xa9\x28\xac\x52\x59\x00\x03\x64\x1e\x68\xd6\x36\x3c\x5f\xca\x95\xf7\x21\xb8\x7b\xd7\x14\x81\x5c\xbc\x20\x25\xfa\xf8\xcd\xba\x47\x2d\x15\x79\x79\xff\xd6\x95\x6e\xde\xa3\x52\x8e\x66\xdf\x04\x41\x55\x32\x0c\xb1\x45\xa0\x68\x19\xa7\x0c\x28\x15\xa8\xd5\x26\x80\x4a\x05\x02\xa5\x7b\x25\xe8\x87\xff\x6e\x11\xbb\x04\x74\xf5\x01\x15\x22\xbf\xe5\x28\x80\x3b\x73\xd0\x92\x12\xad\x31\xe9\x63\xa2\xef\xe4\x6b\x92\x6f\x90\x78\xa2\x10\x7f\x6b\xf1\x0e\x85\x05\xa9\x10\xa2\xca\x89\x07\xd8\x20\x31\x3d\x0c\x62\x8c\x1a\xca\xc1\x09\x38\xf4\xdd\x11\xe6\x6c\x19\xa6\xe4\xb5\x5c\xaf\x64\xb2\xe9\xa8\x0a\x45\xa2\x2e\xaa\x82\x7e\x5d\x68\x67\x0d\xac\x4d\x12\x1f\xa3\x42\x56\xd2\xa2\x95\x3a\x38\x44\xa9\x82\x19\x13\x7e\x55\xd9\xd2\xd7\xaa\x2f\xb6\x64\x5f\x69\xae\x8d\xaa\xef\x94\x45\x96\x2e\xf7\xd2\xf8\xcf\x76\x96\x56\x6b\x01\x8f\x31\xec\xe7\xb5\x8a\xe1\x3c\x47\xaa\x8b\xd3\xac\xd8\x42\x06\x0b\x81\x58\x36\x07\x0b\x54\xe5\xea\xd8\x92\x4d\x25\x77\x3f\x41\x26\x59\xde\x41\x0e\x16\x7d\xbb\x5c\xa7\x81\xfd\x66\xf8\xb1\xa1\xdc\xda\xb6\x57\xb3\xa9\xe6\x16\x29\xb2\x6f\x39\x67\xb3\x45\x6f\x13\x75\x6a\xd3\x3d\x44\x16\x4e\x2b\x97\xe6\xe1\xbb\x5c\x50\xa9\x3a\x25\x0a\x97\x94\x21\x18\x38\x01\xa4\xad\x2a\x6f\x89\x2b\xab\xf2\x67\x75\x7c\x42\x4d\x19\x53\x5e\x29\xbf\x84\xc4\x3b\x60\xe4\xed\x6b\xe0\xed\x30\xee\x06\x0d\xbb\x1d\x4a\x6c\x97\x02\x73\x94\xd7\x0e\xc5\x95\x52\x5a\xa3\x0a\xeb\x33\x9a\x9b\x7b\x9b\x9a\x9f\xdf\xcc\xbc\x89\x89\xb9\x87\x79\xb9\x87\x69\x79\x73\xb3\x72\xb7\x49\x39\x60\x4e\xee\x6f\x4a\xfe\x5a\x66\xe4\xcd\x4d\xc8\xd8\x7c\x0c\x17\x87\x09\xb3\x71\xd4\x64\x74\x88\xf4\x69\x86\xd7\xaf\x6a\x74\xed\x36\xb8\x6e\x60\x6c\x7d\x9a\xa1\xe5\x19\x59\xa7\xbe\xcb\xc5\x13\xa8\x57\xbe\x42\x78\xd2\x89\xeb\xb4\x46\x18\x16\xd3\xfe\x9b\x01\x6d\x10\xcb\xec\x94\x2e\x18\x53\x3b\x43\xda\x20\xa1\x67\xfa\xbe\xe4\x6b\x46\xeb\xae\x3d\x9e\x78\x77\x50\x57\x98\xa0\xaf\xdb\x7a\x85\x18\xd7\x22\xde\x73\x7c\x45\xfa\x8e\x92\xe9\x44\x3b\x73\x26\xae\x43\xbb\xa8\xe7\x40\x3f\xf6\xbc\x44\x9d\xf8\x50\x21\xf2\xb5\x34\xa8\xfe\x26\x15\x7f\xb7\x69\xbc\x7b\x78\x6c\x6e\x3f\xca\x3a\xc1\x2b\xe7\xe7\x07\xbe\xf8\xb1\xc5\xc5\xd9\xa2\x69\xab\x6a\xa1\x4f\x26\x2f\xf4\xf1\xca\x5d\xee\xa6\x94\xab\x09\x64\xda\xe3\xb3\x10\x74\x41\xd0\x85\x39\xcf\x17\xb9\x9a\x64\xcd\x4e\xb5\x1a\xca\x9e\x85\x4c\xee\xc8\x0f\x16\x00\x36\x4e\xbc\x61\xb4\x99\x66\x52\x49\x60\x86\xca\x6c\xae\x68\x30\x8b\x8c\xc2\x6b\x34\x24\xe5\xa1\xba\x49\x43\x94\x5e\x9d\x85\xd6\x56\x37\x64\x9d\x03\xeb\x39\xae\x90\xc2\x24\x2d\xe7\xc9\x6d\xb5\x85\x21\x7f\xdb\xed\x1e\x0f\x28\xda\xcd\x45\xd1\x36\x20\x47\x5d\x9e\x9a\x69\x96\xaf\x18\x82\x65\xc1\xda\x7a\x05\x78\x03\x49\x6e\xde\x46\x47\x07\x4a\x7c\x8e\xcb\x6e\xf3\xd0\x29\x55\xe2\xf3\xdc\xbc\x0c\xb7\x11\x55\x6b\xe4\x8c\x91\x2c\xf0\xc7\x31\x9f\x64\xb4\x8a\xd0\x6c\x93\xdc\x5e\x56\xe5\x4c\x33\x07\x5c\x73\xee\x47\xb6\x5e\x99\xc3\x3a\xc2\xc4\x14\x83\xbc\xcf\x84\x01\xad\xd3\x7d\x28\x78\xcc\xe1\x66\x8d\xe9\xc0\x7b\x36\x14\xa2\x64\x60\xde\x1d\x9e\xe6\xd2\x2c\xb6\x26\xbe\xb6\xed\x5d\x0b\xdd\xa4\x19\x48\xdb\xeb\x1d\xb6\xa0\xed\xc3\x91\x07\x0a\xda\x0c\xc9\x6e\xe8\xe0\x30\x56\xc2\xf8\x8f\x86\xf2\xe8\x3f\xa3\xdd\x60\xb5\x76\x83\x4c\x38\x71\x1f\x26\x58\xc0\xe4\xa0\x5e\x86\x5b\x56\x6b\xca\xc0\x54\x16\xc3\x6a\xc1\x07\x30\x78\xa0\x31\xd8\xf5\x0e\x38\x38\xc0\x43\xbb\xc8\x76\x40\x55\x81\x77\xf8\x34\xee\x96\x6c\x74\x88\x12\x2c\xe2\x6d\x6c\x17\xde\x1b\x85\x11\x2b\xe9\xce\x34\x53\x79\x25\xad\x51\x64\x46\x66\xf2\xf0\x01\xd4\xb9\x6f\x26\xb7\xb5\x7d\x64\xb9\x45\x25\x99\x79\xb0\x54\xb9\x28\xb3\x59\x8e\x09\x47\x4c\x3c\x46\x6b\xca\x90\x9d\x02\x23\x1e\x34\x59\x9d\x63\x82\x99\x91\x9d\x3c\x04\x4b\x60\x0c\xaf\x6b\xa1\x8c\x2d\xaa\xf8\xc9\x48\x4c\x5d\x34\x1b\x76\xf2\x14\xd8\x35\x17\x0e\xb5\x09\x97\xc6\x70\xe5\xfb\x07\x0c\x8f\xbd\x3b\xf5\xb6\xac\x86\xc5\x58\x22\x3c\xc1\xac\xc9\xe2\xc5\x3e\xea\x0e\x2c\x58\x90\x78\xfb\xd6\xbc\x71\x44\xc7\x30\xbf\x68\xde\x6b\x5a\xbe\x4d\x14\x4b\x47\x77\x0d\x51\x3e\x81\x6a\x47\x78\x58\x98\x55\xd0\x97\x58\xa1\xef\xa4\x47\x3f\x24\xd9\x82\x94\x90\x82\x21\xf4\xbe\x81\xca\x8d\xa1\x4b\x7f\xa0\x98\x04\xf3\xfc\xce\x34\xbb\xdd\x01\x9a\x1d\xca\xee\x77\x00\x67\x13\xd8\x0b\xb8\xca\x66\xc1\x82\x68\x3e\x08\xc1\x28\x35\xa7\x19\xe4\xba\x09\x1c\xf4\xed\xca\x79\xbb\xe2\x3a\x7b\xc2\xe1\xdc\x79\xec\x3a\xd1\x26\xcb\x89\x2c\x73\xd4\xdb\x4b\xfd\xe6\xb9\x52\xa4\x59\xa4\x8d\xd3\xdb\x49\x7a\x3d\xb3\x63\x43\xe9\x8b\x70\x47\x69\x16\x58\xa0\x4e\x5d\x76\x9d\x25\xf5\xfa\xb3\x8f\x5a\xac\xfa\xab\xc5\x6e\x29\xa6\x83\x1a\xd0\x47\xc1\x27\x56\xda\x4e\xe6\xd6\x38\x50\xd9\x3b\x31\x41\xdf\x33\xd8\x3c\xfb\x28\x10\xe1\x98\x92\x14\x36\x09\xb4\xb8\x60\xb0\x91\x98\x0c\x58\x80\x2f\x61\x6d\x48\x7b\x55\x11\x6a\x32\x18\x7f\x61\xc4\xb5\xad\xb2\x2b\xaa\x43\x9c\x41\x3d\x57\x0b\xf4\x39\xe0\x0d\x2a\xe6\xa0\xab\x5c\x7d\xfd\x1e\x8b\xed\x53\xda\x3b\x14\x5e\x62\x72\x36\x57\xab\xce\xb7\x2a\xd7\x71\x83\x9f\x40\x6b\xeb\xab\x29\x6c\x4b\x83\x13\xa7\x78\x90\xb5\x54\x05\x61\x80\x13\xb0\xcc\x0f\x7e\xc8\xa7\xef\xfe\x91\x9f\x1e\xcc\xee\x2c\x73\xf4\x11\x15\x52\xa0\x45\x73\xc3\x45\x5a\xbf\x3b\x3a\xf5\x53\x71\xdc\x0f\x61\x9d\x4a\xf3\x09\x38\xe8\x8b\x0f\x4e\x25\x4c\xd6\x34\x58\x5a\x60\x52\xbe\xa2\x25\x7a\x7c\xd9\x8d\xd9\xb4\xc3\xe3\x54\xe9\x90\x45\xf3\x24\xec\x93\x9c\x9b\x77\xa7\xa1\x51\x26\x6b\x0b\xbb\x58\x2b\xc5\xae\x1a\x92\xcb\xef\x7e\xa7\xe4\xc8\x74\x6f\x71\x1d\xbc\x35\x83\x20\x89\xe3\x95\xbc\x72\x7c\x60\x91\x48\xeb\x0b\x75\xdd\x4a\x51\x47\xb6\xd7\xe9\xa3\x55\xe3\x77\xef\x76\x08\xee\xde\x8d\xaf\xc7\x48\x3c\x73\x4a\x46\xaf\xb0\x11\x06\x06\xe5\x4c\xf9\xdc\x43\x0a\x19\x9e\x93\xc2\xce\x69\xcf\x60\x72\xa7\x34\x50\xc7\xba\x52\x74\x54\xb0\x40\xd3\xe5\x34\xff\xdd\xec\x87\x65\xfe\xbb\x25\x9e\x83\xec\xce\xd1\x52\x5a\x0d\x92\x8e\xa1\x5c\x77\x11\x46\xe9\x2f\xc6\x28\xed\x16\x8c\x92\x59\x78\xc1\xa6\x2f\xd6\x3a\x47\xb6\x14\x27\x00\x73\x00\xc1\x2b\x23\x61\xfa\x41\xe2\x73\x70\x81\xc0\x05\xae\x2a\x50\x51\x58\x02\xb1\x85\x02\x18\xe1\xa7\xa3\x88\xba\xac\xed\x76\xfc\x42\x49\xd6\xd1\xbb\x9f\x2b\x69\x92\x2b\xd7\x40\xda\x9d\x9c\x52\xb6\x6e\xd2\xb1\x98\x32\x7e\x57\x9f\x49\x3a\x5d\x20\x00\x19\x02\x1b\x8a\xc9\x06\x08\x0a\x5a\x8e\x9c\x39\xe8\xf5\xe1\x8b\xc8\x13\x70\xf7\x2e\xf8\x62\xc8\xb5\x11\xb6\x2e\x6c\x59\xc0\xe0\x6a\xb8\x83\x22\x91\x63\x80\x23\xa1\xb3\x20\x4d\xb5\x8b\x51\xcb\xcb\x80\x49\x1c\x09\x02\x5b\x29\xa5\xa0\x92\x22\x91\xef\x43\x8b\xdc\x34\x23\xa8\x04\xc4\x91\xc2\xd8\x7b\xd0\xc6\x1a\x6e\xd1\x36\x98\x6c\x26\xf1\x42\x7c\x8c\x8b\xaf\x81\x36\x5e\x56\xbb\x23\x6f\x37\xc3\xd6\x76\x51\x7d\x81\xc5\x96\xb6\x02\x40\x72\x09\xd6\xb4\x2a\x11\x0b\x35\x97\x5e\x39\xc6\xba\xcb\x17\xab\xee\xda\x41\xed\xc9\x45\xa4\x89\xd9\xa0\xaf\x48\x57\xa2\x71\xa4\x16\x4c\x1e\xc4\x3b\xaf\xae\x05\x38\x3a\x75\x03\x24\xe5\x5a\xfa\x2f\xaf\xbf\xf9\x7a\xea\x37\x59\x4b\x21\x54\x2c\x5a\x56\x2d\x1a\x86\xd6\xf8\xa3\xb2\x97\x4c\x82\x3b\x47\x79\x2b\x02\xab\x10\xfd\x4d\x6a\x4a\xba\xef\x73\xbd\xb9\xa0\x03\x91\x74\x48\x91\x32\xce\x52\x59\x54\x47\x46\xd0\xdf\xa2\x88\x59\x63\x0f\x1c\x19\xfa\x28\x18\xfc\x2b\xba\xe4\xd9\x1c\xfc\xbc\xf7\xf1\x93\x6b\xb5\x2a\xe4\x2c\x87\xad\xac\x5b\x07\xac\x50\x01\x7d\x41\x02\x4a\x8a\x38\xc9\x04\xe0\x08\xd5\xdc\x0a\x1a\xde\xc0\x02\x71\x50\x50\x66\xee\x32\x90\xcb\xe1\x9f\xc3\x3a\xcd\xe6\xcb\x55\x9c\x94\x75\xb9\x04\x0b\xa0\x82\xe9\x8e\x97\xcb\x0d\x16\xdb\x76\x95\x17\xb4\x5e\xca\x0e\xd5\xaa\xde\x65\xdf\x84\xa5\x3a\xe4\xcd\x97\xff\xf9\xc7\x3f\x8e\x23\xea\x4b\xe7\x04\x89\x65\x49\x8b\x65\x0d\x49\x0b\xf5\x65\x27\xb7\xcf\xd0\x65\x0d\x9b\xf8\x68\xee\xde\xe3\x92\x5c\x50\xbe\x81\x2b\x2f\x27\x48\x32\x40\xd6\x7e\xd4\x66\xbd\xa6\xdd\x09\x78\xc4\x18\xbc\xd4\xc1\xd6\x2f\x88\x30\x1e\x50\x7f\xf8\xde\x12\x2c\x26\x33\x6d\xe4\xeb\x15\xca\x04\xa4\x22\xbb\xbb\xae\xd4\x56\x37\x77\x6b\xd4\xa9\xae\x6f\xff\xd5\xf4\xe8\xc1\x8f\xdd\x1c\xa7\x9a\x3c\x07\xc9\x79\x86\x7f\x42\xe0\x97\x5f\xc0\xef\x83\x2a\xc6\x90\x9a\x0d\xbe\x10\xa3\x80\xab\xf7\x2a\x8e\x3c\xc4\x77\x35\xb6\x16\x0a\x5d\xc7\xdf\xb0\x0d\x24\xf8\x27\x68\x5c\x76\x7e\xaa\x50\xea\xbc\xbc\x56\xb2\xd0\x81\x94\x7f\x2e\xbe\xeb\x25\xfd\xa3\x6c\x73\xf3\x9c\x7f\x94\x6d\x7e\xe5\x94\x7f\xb6\x86\xdf\x62\xc6\xbf\x70\xc4\x1f\x95\x35\x8e\x87\x1a\xca\xa7\xd7\x1d\xe3\xaf\xd1\x85\x94\x85\x2c\xc6\xa4\x52\x2e\xc9\x57\x83\xf9\x45\x7b\x50\x75\x13\x51\x04\x1b\x72\x40\x45\x37\x98\xbc\x17\x97\x0d\xba\x51\xb8\xac\xef\x4c\x38\xd2\x7b\x1a\x87\x43\xb1\xb2\xa6\x36\x33\xa4\xda\xf5\xfa\x48\x9d\x73\xe8\xdc\xff\xa9\xc3\xd1\x2a\x21\x0e\x59\xa8\x78\xea\x81\x7d\x06\x0b\x67\x61\x86\xce\x3c\xcb\x46\x48\x9a\xd8\x36\x74\x51\x2c\x11\xa4\xdb\x55\x73\x66\x1d\x72\x7e\x41\x59\xa9\x73\xd6\x4f\x6c\x93\x27\x63\x87\xe4\xfa\x32\xe6\x40\x87\xb3\xcf\x31\xda\xe9\xe0\x30\xe7\x58\xd4\xb0\x4f\xd5\xeb\x55\x13\xd2\x76\x84\x6e\xf9\x1e\xf4\xf7\x9b\x32\x4c\xdc\x80\x34\xfb\x70\xc2\x60\x22\xc7\x8e\x5b\x29\x79\x8d\x8a\x96\x61\x71\xf9\xad\x09\xac\xd7\xc7\x04\x13\xbb\x70\xd9\x6d\x6e\x40\xdf\x77\x41\xf8\x76\x57\x2e\xb1\x65\x22\x7b\xbf\x85\x7c\x21\x54\x3e\xc8\x68\x99\x39\xb4\x53\xd6\x17\x89\x17\x98\x20\x9a\xf1\xb0\x15\x5b\x44\x04\xd6\x07\x67\x06\xe6\xbe\x0f\x34\x3a\xb3\x25\xe8\xde\x13\x5b\x8d\x6f\x09\x9b\x91\x6d\xbc\x72\xc7\x7b\x5e\x8b\xb1\xd7\x0d\xac\x47\xde\x6a\x4b\x6d\x04\x20\xa6\x65\xa4\x9d\x64\x87\xdf\x5c\x36\x28\xdc\x69\x09\x9c\x36\xe6\x66\x03\x0b\x3d\x7c\xb3\xc1\xbd\xec\x18\x98\xf1\x79\xf9\xf4\xd1\xb7\x43\x73\xdc\xd2\x6d\x2c\xcd\xc2\xe8\x35\x03\x5f\xf6\xf5\xbc\x7e\xf5\x66\xb8\x1e\x43\xe0\xd1\x74\x0e\xa3\x8c\xba\x77\x8b\x7e\xdf\xb7\xe8\xdb\x47\xaf\x06\x6b\xd2\x43\x7a\xe3\x5a\xbe\xda\x93\xbe\xe5\xa7\x12\xf8\x0f\xd9\x31\x58\x2e\xff\xeb\xc5\x9b\x3f\xbf\x7d\x3c\x58\x4b\xc7\x81\x63\xd5\x0c\x54\x74\x15\xa7\xf0\xef\x99\xf1\x44\x72\x92\x34\x26\xbd\x47\x5f\x25\x75\xe4\xb0\x08\xdb\x21\x0b\x81\x3d\x7b\x9b\x10\x6b\x66\xf2\x0f\xe1\xb6\x11\xbf\xc0\x49\x7e\xb5\x53\x16\x29\xe3\x62\x4f\x61\x14\xcd\x4d\x5f\x36\x25\x7c\x06\xd7\x27\xde\x4d\xfb\x3e\x22\x92\xa9\xc0\x05\x4a\x89\x61\xf5\xc2\x3f\xd9\xa9\x23\x01\x90\x80\xb8\x7a\x45\x4b\x68\x52\xfe\xde\xd6\x4f\x16\x26\xa7\xa8\x7f\x84\xfd\x91\xba\x7d\x0c\xe8\xdc\x4f\x0a\x0e\x28\x38\xee\x99\x72\x6a\x5f\x47\xbf\xde\x27\x43\xa3\xd3\x84\x2e\xf5\x8d\xc9\xef\xd0\xa4\x4f\xd0\x75\x59\x45\x42\x99\xeb\xa2\xba\x51\x1a\x52\xb7\xb3\xe6\xde\x48\x7d\xe7\x4f\x70\x60\x5f\x25\x94\x5e\xd1\x8f\xfa\xfe\xe6\xee\x5e\x86\x5c\x45\x60\x01\x95\xaf\xca\x40\x84\x09\x93\x0d\xa0\xbd\x49\x68\x27\x79\xac\xe8\x0f\xae\x48\x31\xc5\x87\x15\x81\xbd\x28\xa2\xaa\x86\x6e\x81\xe9\xfb\xd0\x35\xd6\x5c\x63\x75\xa3\x8b\x67\x4a\x74\xc3\x2a\x5b\x72\xf3\x4a\x31\x39\x47\x8c\xa3\x6b\xd5\xa7\x13\x49\x5e\xa3\xba\x61\xc9\x65\x32\x6a\xf4\xf1\xe7\x9f\x21\xa7\x86\x4e\x4f\xd1\x9d\xe9\x9c\x54\x3a\xec\x11\xd8\x64\xe1\xa1\xc3\x43\x6d\x3a\x95\xe1\x36\x7a\xd8\xfd\x3d\x2e\x82\x71\x17\x0f\x3d\xb5\x30\x07\xa3\xb7\xf8\xa9\x92\x65\xb0\xdd\x3c\x98\xa3\x19\x24\x12\x06\x07\x8d\x8e\xb3\x83\xe8\x83\xaf\x37\x49\x03\x89\x4b\xae\xae\x35\xe5\x41\x95\xfb\xa4\x0c\x19\xba\x0a\xc4\x69\x18\x43\xfa\xf6\xd2\xc8\xec\x4f\x79\x60\xbc\xc5\x77\xf2\xba\x7c\x27\x64\xaf\xc5\x7d\x06\xad\x33\x74\xd9\x30\xc4\xf9\x50\x1c\x58\x1c\xd1\xf3\xa5\x54\x3e\xde\xa3\x2f\xef\xcd\xa4\x48\x43\x2a\x65\xc4\x19\xba\x04\xd4\x78\xde\xc0\x0a\xb2\x40\x29\x19\x0e\x50\x7c\xec\x84\x1e\xba\xcd\x97\xe6\xec\xf7\x5b\x44\x5e\x52\x2e\x9e\xab\x05\xd3\x8a\x96\x97\x73\xa0\x53\x40\xf6\x1d\xe9\x8e\x62\x44\xd3\x22\xda\x55\xd6\xf9\xf1\xc1\x09\x40\xb9\xfe\xea\x6b\xd7\x2f\xee\x4c\xf5\xe3\x59\x8e\x75\x6d\x33\xb5\x6d\xd3\x3f\xd6\x75\xf3\xa9\x02\x30\x0d\x89\x35\xae\x2a\x39\xb4\xb4\x99\x85\xd1\xae\x90\x15\xdb\xb7\x1c\x31\xee\xf9\x48\xbe\xd0\x8a\x5b\xbe\x5c\xc8\x75\xb9\xce\x41\xd0\xdd\xcc\x92\x88\x21\x49\xb8\x4c\x4c\xf4\x9e\xad\xe1\x31\xfd\x68\x13\xbf\xf8\x88\xbd\xeb\x89\x4d\x1d\x3a\xf8\xcd\x29\xda\x27\xbe\x34\x6d\x30\xc1\x84\x3e\xd0\x98\xb7\x6c\x54\x2c\xc9\x97\x67\xe8\x52\x2e\x7c\xbb\x19\xe0\xa5\x16\x4e\xd9\x43\xa6\x80\xb5\xaf\x1e\x80\x7b\xd1\x68\x98\xe6\x26\xd7\x4f\x23\xfb\x6c\x77\x72\xf8\x01\x7e\x0c\x0e\xe9\xb6\xac\x3a\x06\xbc\x5d\xb5\xac\x02\x07\x20\x5b\xc2\x06\x2f\xcf\x8f\x96\x92\x8c\x7c\xa9\xe9\xf0\xa7\x1f\xd5\x65\x63\xa6\x61\xbe\xb8\x90\x13\x5a\x9a\x6a\xc7\x60\xf2\x81\x53\x12\x04\x4b\xf3\xb6\x28\x10\xe7\xae\x67\x9b\x21\xde\x50\xc2\x93\x4b\x31\x49\x2f\x42\xc5\x33\x75\x69\xb1\x7b\x82\x8a\x0b\x36\x24\x43\x8d\x4d\xc2\x85\xda\x8e\xe4\x82\x39\x86\x69\x42\x86\xa6\xfc\x13\x1d\x39\xbb\x0b\xb0\x92\x32\xde\xb6\x3c\xa7\x67\xb2\xaa\xee\xa7\x93\xfa\x6d\x2c\xfc\x52\x62\x07\x27\x20\x1b\x38\x20\x70\x47\xab\x1a\x0f\xab\xbb\x23\x35\x92\x51\xc6\x7e\x54\x0d\x07\xc1\x59\x05\x75\x19\xdd\xc3\x9d\xb7\xc9\xa9\xdb\xe0\x55\xba\x15\xfd\xee\xbd\xe1\x87\xc9\x43\x2f\xc8\x4f\xb2\x85\x0a\xbb\x7d\xd8\xc1\xdb\x47\x3a\xc2\x4f\x47\xf7\x0d\x6f\x2d\x48\x42\xda\x21\x9e\xea\xf4\x2e\x6d\x55\x29\x27\xd2\xa0\x9a\x8c\xfa\x07\xa6\x5d\xed\x5d\x69\x59\xfd\x6c\xa4\xe2\xe1\xd4\x6d\x3d\xd9\xc6\x0e\x70\x0c\xa5\x86\xf3\xb9\x47\xfe\x19\x02\x74\xa6\xbf\x15\x38\x60\xdf\xeb\xeb\x86\x10\xa5\x6e\x68\xb0\xb9\x5f\xcd\xb8\x0c\x45\xd9\xa5\x3b\x31\xe0\xee\xdb\x8b\x02\x43\x8b\xe9\xd1\xec\x84\x3b\xab\x1e\x0c\xcd\x0d\xf3\x67\xa4\x24\x7b\x97\xd0\x5d\x29\xdb\x81\x3c\x1a\x29\x69\x1f\x20\x8f\x15\xf7\x88\x26\x9b\x83\xa4\x36\xba\xd2\x47\x67\xf5\x11\x2e\x95\x6d\x42\x65\x9b\xa3\xc4\xc4\x73\x62\x02\xc4\x05\xed\xa6\x3c\x0f\x15\x6a\x77\x1f\x1d\x46\xc3\x7a\x55\x65\x10\xf9\x44\xbd\x2a\x2b\x8a\xf4\xaa\x45\x3c\xae\x57\x4d\xd1\x71\xbd\x6a\x81\xfe\x6f\xd4\xab\x2a\x5c\x32\xa9\x57\xc1\x01\x98\xdc\x6d\x71\x79\x32\x01\x07\x21\xa5\x4c\xf4\x94\x5c\x18\xfc\x3f\xf5\xfb\x6f\xa9\x7e\xed\x6f\x73\x63\x35\xb0\x37\x57\x4b\x7e\x98\x3c\x7c\xb0\xc4\x0f\x81\xa7\x68\xa5\x5e\x0b\x14\xad\xa7\xea\x8c\xa6\xfd\xdf\xa5\xb2\x6c\xa7\x3b\x95\x65\x23\x6f\x97\x93\xd9\xbb\xa3\xb1\xfc\x1a\xff\x8b\x14\x98\x2f\x42\xaf\xa1\xc0\x7c\xb1\xba\xbf\x02\x8b\x55\x86\xa3\xc0\x5c\xb1\x1f\xee\x82\xcb\x45\xf2\xdf\x30\xba\x88\x36\xc2\x0b\x5a\xa2\x85\x72\x76\xaa\x04\x6e\xa4\xad\xc7\x22\x13\xfa\xc5\x2e\x25\xd3\x4c\xb1\x95\x6c\x41\x9f\x8f\x4f\x1d\x66\x18\xcf\x6d\x67\xb4\x97\x72\x3c\x0e\xf8\x87\xbc\x9b\xcd\x8d\x37\xb1\xbb\x7a\x61\x28\xe5\x9c\x1e\x04\x5a\x75\xfd\x00\x0f\x41\x85\x43\xbf\x85\xc6\xa6\x8f\x71\xa8\x2a\xe6\xdd\xb5\xb9\x95\x40\x6c\x9a\xbd\x63\xa8\xd2\x17\x36\x5b\x37\x66\x97\xf0\x4f\x4e\xe6\xd3\x6c\x36\x07\x53\x94\xf3\x2d\x5e\x8b\xbf\xa2\x4b\xf0\xa7\xa0\x7c\x9f\x9d\x0f\xfd\x38\x3d\x9c\x81\x63\x75\xbc\x33\xb4\xe8\x4a\x13\xae\x33\x72\x55\xdb\x4d\xf2\x06\xee\x97\xed\x6f\xfa\xf2\x87\xf2\x60\xf6\xc3\x42\xff\x8f\x92\xfe\x85\xf7\xca\xf7\x2c\xb2\x8b\xb8\xaa\xe4\x1a\x33\x2e\xe2\x58\x93\x74\x1a\x41\x05\x2c\xeb\xf1\x69\xa8\xa2\x8a\xd3\x62\x24\x35\x80\x0a\x4b\x38\x90\x06\xc9\xbd\xd3\x64\xe6\xfc\xe9\x44\x0a\xd3\x39\x58\xd1\xf2\x72\x32\x33\x37\xa8\xbe\xa1\xcd\x54\x23\xcb\xe9\x7a\xcd\x91\x50\x51\x2d\x0d\x58\x80\x7b\x87\xe1\x81\x1f\xb0\x33\x84\x57\x7e\xf6\x1d\x8c\x6b\x64\x5e\xfc\x8c\x24\xfb\x55\x08\xb3\x7f\x6a\xc7\x50\x48\x49\xb3\xfe\xb5\x09\x93\xea\x04\x95\x7b\xe5\x70\x16\x42\x65\x4e\x06\xbd\x54\x04\x96\xb4\xe9\xfb\xc8\xab\x86\xd1\x35\xae\x86\xef\xae\xb2\x41\x28\x37\x8e\xbc\xfa\x75\xa3\xae\xfe\x5d\x22\xae\xfa\xcb\xb8\x9f\xd0\xaa\x82\x2b\xca\xfc\x70\xbb\x68\x44\x07\x0a\xb8\x83\x6b\x12\x4b\x17\xdd\x7b\xca\x00\x54\x16\x73\x9f\x2e\x43\x6d\x05\xaa\x67\x0b\xf9\x4c\xdd\x7f\xb2\x97\x09\xa4\x06\xb2\x36\x97\xaa\x04\x77\xff\x38\x9b\x21\xc6\x6b\xef\x5e\xe8\x9e\xca\xbe\x31\xe2\xb0\x9f\xb4\xb8\x9c\x1c\x03\x0f\x45\xbc\x50\xb0\x39\x39\xfc\xd1\x57\x71\xcf\xce\xf5\x08\x36\x23\x54\x42\xd9\x7f\x8f\x56\x5b\x4a\xcf\xa2\xa9\xa4\x12\x59\x9c\x23\x22\xfa\xad\xa2\xee\xb4\xf7\xde\x47\xe4\x31\x9f\x66\xc7\x83\xdb\x25\x4e\x15\x2a\x49\x53\x32\x2e\xc7\x37\xa5\x4c\xa4\xd3\xbf\xa0\x61\xc3\x2e\x72\xcb\x76\x7f\xb6\x77\x13\x80\x06\x5e\xaa\x03\x24\x94\x00\x2d\x80\x15\x43\x75\x3d\x90\xf4\xce\xb7\x98\xeb\x1b\x6a\xa5\xee\x34\x97\xb9\xed\x77\x4b\xc8\xd0\x4d\xba\xd2\xe2\x00\x39\xa1\xdd\x1d\x09\xbb\x6f\x6c\xdd\x73\xf3\xcd\xcb\x90\xea\x55\x10\xda\x4b\xe9\x94\x37\xc9\xbb\x1b\x14\xea\x77\x87\xa7\x33\x70\x35\x07\x5f\x79\x9a\xa1\x63\xd7\x8e\xb6\x6f\xb4\x6a\x00\x25\xaa\xf0\x39\x62\x97\x1d\x2d\xed\x03\x43\xba\xb9\x34\x76\x83\x67\xfb\x4c\xe8\x21\x1a\x04\x9b\x8f\x59\xb8\xf9\x98\x25\xe6\xfc\xce\x9d\xba\x74\x02\x7f\xbb\x0b\xb7\x17\x45\x23\x39\xfc\x29\xbb\x73\x8a\xfc\x87\xbd\xb4\xf0\x37\xb9\x5c\x43\x9e\x21\x58\x5e\x26\x5a\x22\x3b\xa2\xd5\x5a\x8d\x04\xd4\x59\x5c\x55\x27\x4f\x6d\x30\x63\x77\x11\x8c\x41\x6e\x3c\x28\x61\x19\xfd\x38\x59\xca\xb2\xc2\x6b\x24\x6c\xb6\x37\x41\xd5\x71\x17\x44\x4a\x40\xd7\x2a\x6b\x95\x5a\x4e\x69\x71\x00\x74\x48\xab\xe5\x14\x9d\x5f\xb6\x83\x39\x1d\x9f\x1c\xae\xda\x4d\x9d\x19\x76\x58\x53\xdd\xcf\x81\x3e\xc2\x42\xa8\x0b\x48\x3b\xce\x94\x3f\x16\x1c\x93\x02\xed\x57\x55\xcf\x64\x0d\x6d\x24\x8f\xb5\x8d\x7f\x17\x93\x8d\xf2\x98\x07\xe9\xc5\xed\x9d\xbd\x2e\xec\x39\x64\x58\xeb\xc5\xb9\x09\x0a\x10\xa8\x04\x02\x93\xcb\xcc\x2f\x36\x07\x99\x9b\xb0\xb8\x27\x72\x0d\x89\xc0\x05\x78\xfb\x42\xea\xcc\xb6\x42\x3c\xef\x7a\xd6\x62\x27\x1d\x70\xf7\xd5\xf1\x93\xe9\xc4\x5f\x36\x08\xc0\x4d\xdf\xe5\x0a\xf2\x0f\x6d\xdd\xec\x40\x04\x0d\x02\x13\xbd\xe1\x5c\xbc\x4a\x89\xa4\xfb\xd8\xfd\xbd\x2a\xb8\x4d\x51\x32\x57\x94\x6c\x68\xd3\x36\xd3\x4c\xca\xf1\x6c\x54\xc1\xf0\x0a\x97\x28\x6f\x77\xb5\x4d\x30\x48\x74\x3a\x0f\xd9\x3e\x59\x46\x8e\x58\x84\xad\x6d\x2e\x20\x2b\x77\xe0\xd2\x13\x53\xa3\xd2\x05\x12\x88\xb0\x34\x58\x28\x2b\x75\x5c\x45\xf7\x7d\xea\xc3\xf4\x71\x36\x7d\xd0\x82\x0f\xd1\x30\xba\x61\x88\x73\x9d\xb6\x45\x7d\x75\x9a\x22\x15\xf0\x23\xb9\x2a\xc5\xe2\x72\x68\xe4\x62\xb2\x3a\x2f\x05\x6d\x8c\x35\x15\x83\xfd\x7c\x8d\xf1\xb3\x66\x79\x8f\x4f\x59\x42\x3d\xd9\xa5\x52\x86\xdc\xcc\x99\x73\x2c\x97\xfa\x28\x1d\x93\x31\x76\xf7\xe6\x30\x1f\xb8\x57\xe3\x75\xa6\xa1\x80\xab\xa9\x0f\x23\xf5\x40\x12\xc6\xa1\xc8\xa7\x2b\xf7\x99\x66\x4b\x7d\x1f\xea\xf4\xa8\x53\x97\xee\xa4\x7d\xca\x68\xf3\x13\x25\x7a\xbc\x74\x34\x9b\x79\x62\x43\xd9\xcc\x4f\x2f\x11\xb4\x7d\x38\x12\xf8\x67\xcf\x13\x3e\xc5\xca\x0d\xf3\xb3\x7b\x5d\x6c\x57\xdc\x7e\x49\xf9\xcb\x1d\x28\x73\xcb\x98\x54\xa4\xe6\x96\x31\x0f\x7c\x8b\x60\xa9\xd2\x49\xfd\x0c\x26\x7f\x5f\x3c\xe1\x6c\xbd\x78\x43\xcf\x10\x31\xda\x32\x4c\x02\x57\xc3\x8f\xcf\x71\x85\x78\x5c\x45\x0d\x3f\x2e\xf4\x02\x2e\x5d\x42\xa5\x05\x4c\x15\x92\x6f\xc2\x42\x72\xa5\xd0\x08\x54\x9a\xba\xa6\x61\x39\xfd\x9e\x67\x26\xf3\xf8\xef\x96\xbf\xcb\x66\xe0\x4f\xca\x99\x03\xe2\x5a\x3a\xe8\xa0\x92\xb2\xfc\x4e\x19\x5a\x2f\x31\x39\xe3\xa9\x64\xd8\x25\x2e\xc4\x53\xb4\x86\x6d\x25\x5e\x21\xce\xe1\x26\xd1\x85\x52\xbf\x5f\xd4\x1a\x20\xda\x58\xc0\x85\x78\x41\xce\x61\x85\x55\x67\xf4\x1e\x43\x88\x03\x6b\x00\x7d\x1d\xf1\x42\x87\x78\xc6\x68\x54\x79\x4a\x1f\xe3\x4d\x8c\x41\x65\x6d\x10\x94\x2e\x56\x38\xba\xc0\x5b\x96\xd5\x1d\x95\x18\xe2\xb2\xda\xda\x4c\x0e\x9e\x5c\xb0\xec\xba\xb3\x5d\x59\x3c\x94\x4c\x27\x66\x97\xc4\x4b\xde\x20\x91\xea\x83\xea\x43\x6e\x6d\x97\xd7\xdf\xc9\x1f\xb9\xfc\x75\x0a\x4e\x54\xa9\xbc\x6d\x71\x99\xf6\x36\xeb\x64\x07\xdd\xf5\xd3\x0f\xf4\x77\x5c\xea\x2d\xf8\xae\xb0\xda\x7c\x57\xf7\x83\x9e\x4c\x24\x7a\x3e\x01\x92\xc0\x27\x93\x2d\x2e\x4b\x44\x26\x0f\x8d\x0b\xbd\x2b\x30\x12\x25\xae\xca\x4b\x5d\xd0\x34\x88\x94\x53\x55\x63\xca\x51\x9d\x78\xd6\x51\x49\x53\xbb\x94\xa8\x22\x4a\x8d\xa5\x13\xea\x48\x03\x30\xf1\x88\xb6\x23\xb7\x90\xba\x1f\x65\x80\xc8\xbb\xf2\x3e\xa5\xf7\xfa\x7d\x29\xe6\xf1\x90\x12\x30\xe0\xee\xdd\x88\xc7\xa4\x2c\x19\x8e\xdf\x03\x8e\x35\x3f\x82\x78\x2c\x5b\x3b\x30\xac\x74\x3c\xd4\xd7\xe1\xdc\xe8\xf2\xa3\x8c\xe7\x78\x6a\xe8\x66\x0f\x96\x1c\xda\x08\x49\x1d\xe3\xdc\xe9\xa9\xb1\x5a\xe5\x99\xce\x6e\xa9\x7e\xda\x4c\x97\x2a\x39\x00\x59\xe3\x8d\x23\xec\x71\xbd\x79\x5f\x62\xe6\x6d\x8e\xe2\x7a\xb3\x54\x65\x1c\xcb\x0d\x6f\x08\x65\xe8\x3d\xaa\xa9\xda\x29\x4b\x65\x0e\x54\xfb\x7a\x90\xab\x9a\xdd\x44\xc2\x1b\x24\x9e\xe9\xfc\x40\xfc\xf1\xa5\x52\xfc\x5f\xc3\x1a\x29\x7f\xe1\x42\x57\x63\x10\xa4\xd2\x6f\x59\x84\x5d\x06\x2e\x7c\x70\xe0\x32\x80\x9b\xc5\xd3\xc2\xbe\xc3\xa7\x11\x3d\x9e\x54\xb8\x59\x51\xc8\x4a\xf0\x97\xd7\x5d\x6b\x8b\xee\xa1\xce\xaa\xdb\x01\xfd\xe5\xb5\xba\x00\xc5\xfc\xb2\xed\xeb\x1e\x28\x87\xbd\x11\x54\xc3\xde\x7a\x94\xab\x3b\xfe\xfb\x43\xbb\xbe\xf3\x5f\xcf\x29\x64\xfd\xa7\x92\x4c\x8f\x84\x60\x78\xd5\x0a\x64\xa2\x4e\xad\xf5\x5b\x22\x2e\x18\xbd\x74\x0d\xe0\xbe\x1c\xf7\xca\x05\x6b\x8e\x21\xf4\x0a\xcc\xf6\xc0\xb9\x8c\xec\x5a\xcd\x0a\x83\xd2\x3f\x47\x9b\x28\xc3\x1b\x4c\xd4\xf5\x64\xbe\xa5\xe4\x13\x1f\x31\x46\xd9\x30\xe9\x7f\x0b\xd4\xd5\x4d\xfc\xb7\xa0\xed\x72\x09\x1e\xb5\x82\x4a\x1b\xaa\xb7\x30\x55\x60\x78\x7f\x19\x0a\x34\x00\x83\xae\x75\x0b\x10\x96\xf4\xa2\xa7\x65\xb7\x8c\x11\xf4\x0a\x7e\xd4\xc9\xbd\xa7\x5f\x1d\xdd\x9b\xab\xc8\xfb\xae\x88\xf9\xa9\xdf\xc7\x17\x45\x3d\xfa\xcb\xa3\xbf\xeb\x64\x2f\x26\x02\xb9\x33\xda\xe1\x07\xf8\x71\xa1\xac\xd4\xcf\xef\x45\x72\xbd\x47\x8e\xfb\x68\x28\xda\x24\xbe\x19\x72\xc0\x64\x8e\xc4\x7c\xf6\xf7\x85\xec\x61\x76\x0c\x26\x52\xc8\x4e\x06\x85\x7e\x18\x00\xae\xc3\x24\xf4\x1d\x7f\x73\x9b\x85\x3a\x5e\x61\x2b\x43\x2a\x48\xcf\x67\xfa\xee\x41\x2e\x97\xe0\xad\xba\x58\x58\x09\xc7\xb7\xdf\xbd\x04\x94\x01\xad\x4a\x01\x47\xd5\x5a\xf2\x09\xa1\xa0\xa6\x0c\x81\x35\x42\x41\x7c\xba\xa4\xad\x76\x16\x99\x86\x48\x96\xfc\xce\x44\x74\xfc\x59\xf5\x7e\x6a\x7a\xba\x78\xfb\xdd\xcb\xd4\x36\x4a\xcb\xa2\x8c\xfa\xfd\xc0\xb8\x39\x8c\x24\x60\x62\xa3\x2b\x76\x83\xa6\x3c\x80\x60\x74\xa7\xc4\xc1\xb1\xc7\x4e\x89\xeb\x58\x46\x55\x63\x2f\x8d\x77\xee\x2d\xfd\x0c\x7c\xd9\x21\xcb\xcd\xf1\x26\x73\x4a\x28\xc8\x4f\x53\x51\xd5\xd7\xe4\xf5\x1e\x94\x3c\x6a\x1a\x46\xcf\xd1\x2e\xbb\x5c\xdf\x1e\xdb\xd3\x5b\x2f\x2b\xd4\x29\xec\x35\x65\xf5\xc8\x09\x6c\xb7\x94\xbe\x4f\x32\x75\xa1\xa4\xfb\x49\xed\xb5\x82\xf8\x8c\x1f\x48\x7b\x6d\x3f\x39\x4f\xba\xde\xb8\xe9\x31\xca\x07\xb1\xd5\x96\x9e\x75\x43\x64\x18\x70\xf0\xaa\x65\x82\xf5\xec\xee\x36\xfd\xbd\x39\x3f\x70\x22\x2c\xf6\x96\x78\xde\xb1\x2d\xbd\x58\x34\x90\xec\x7b\x13\x7b\xe8\xd0\x50\x45\xd5\x08\x3a\x3b\x3d\x51\x05\xaa\x69\x37\xab\xc0\x70\x72\xb2\x7b\x6e\x3d\x66\x16\xc9\xd1\xff\x1c\x1b\x05\x31\x1b\x19\x61\x95\x5e\x2b\x8c\xef\x01\x0c\xcf\xa4\x3d\xfc\xfc\x65\x77\xcd\xdc\xfd\xa4\x58\x01\x66\x4b\x85\x82\x1a\x9e\x21\x00\xd9\x0a\x0b\x06\x99\xca\xd2\x53\x77\x89\x42\x05\x05\x2b\xb4\x85\xe7\x08\x54\x58\x42\x01\x3d\xe3\x8c\x9e\xec\x47\x4b\x3d\xdd\x4f\x16\x85\x23\x95\x9e\xcb\x57\xde\x4e\x2e\x2a\xce\xd4\xd9\x1c\x1d\x02\x44\x09\xd0\x19\x51\xd4\xfd\x07\x44\x0a\xa5\xa5\x11\xc4\xf6\xc0\x0c\x43\x1b\xec\xb4\x4f\x03\x2d\xf8\x25\x37\x5b\xbb\xbb\x76\x08\x15\x21\xcd\xce\x60\xac\xf0\x86\x9c\x73\x3b\x55\xc3\x40\x7e\x81\x21\x7c\x69\x5b\xc1\x9f\xc5\x2e\x33\x7b\xdd\x5c\x30\x58\x62\xba\xab\xb3\x61\xe5\x1a\xc5\x5e\x9d\x09\x8b\x1a\x98\xf1\x86\x5f\x05\x7b\x38\x90\x14\x5b\xca\xfa\x5d\x05\x9b\x4a\x6f\x7c\xb7\x44\x2d\x0d\xb5\xc1\x13\x7a\x22\xbd\x98\xc3\xed\xd1\x1c\x6c\xef\xcd\xc1\xf6\xcb\x39\xd8\xfe\x7e\x0e\xb6\x5f\xcd\xc1\xf6\x0f\xfb\xed\x88\x12\x9d\x30\x72\x30\xda\x4c\x27\xfc\x44\xa4\xa0\x25\x7a\xfb\xdd\x8b\x27\xb4\x6e\x28\x41\x44\x4c\x65\x41\x1b\xe1\xe8\x85\x5b\xf4\x29\x11\xdf\xfd\xe3\x87\xf6\xf0\xf0\xc9\xe1\xe2\x87\xf6\xe8\xf9\xf3\xe7\x3f\xb4\xf7\x9e\x1c\xca\x1f\x4f\xff\xe3\xf9\xf3\x1f\x2e\x7e\x58\x80\xd3\xe5\x46\xed\xc9\x38\x45\xcc\xb3\x45\x74\x7a\x50\x35\x56\x27\x4f\x3f\x87\x55\x6c\xf5\x18\x42\xbd\x3b\x87\xd5\xe9\x50\x16\xf1\xbe\xbc\x5c\xb1\x2f\xe4\xb2\xc2\x2d\x36\x16\xaf\x14\x55\x71\x72\x12\xdf\xa3\xe4\x7e\x7c\x60\x70\xb4\xa7\xe5\xe4\x15\x3b\x88\xcb\x79\xbf\xcc\xe0\xa9\xa1\xb8\x60\xb0\x31\xe9\xa5\xad\x1f\xce\xc6\xd9\x4e\x6c\x40\xae\x66\x43\x95\x1c\x76\x02\x6c\xdc\x6d\x40\x67\x85\xcc\x38\xd9\xb2\x07\xd0\x2f\x3a\xb1\x59\xb1\x3d\xf4\xfe\xf1\x9a\x30\x3a\x58\xdd\x54\xf1\xb0\x8b\xf5\x85\x0f\x87\x64\xb5\xfa\x92\x3c\x1c\x69\xb6\x51\xdd\x23\x79\xee\x23\xff\x50\x81\x41\xa4\x83\x3b\xd5\xcd\x9b\xfa\x3a\xc9\xfb\xdd\xe3\x3e\xac\xc6\x7d\xda\x5f\x3c\xd9\x3f\x7b\x56\xe2\xa8\xb4\xbd\x64\xa1\x7f\xe2\xe7\xce\xea\x9f\x9b\x0c\x4b\xf7\x9d\xf6\xd8\x60\xd3\x5e\x3c\xc8\xc6\x00\x75\x5d\xaa\xb4\xc7\x73\x6f\x25\x19\x5d\xa5\x9a\x5e\x43\xda\x63\xe2\xde\xfd\xad\x9b\xb1\xfb\x5b\x23\x6f\xa0\x39\x37\xce\xb7\x89\xd3\xd4\x89\xd6\xb8\x17\x08\xcf\x46\x73\x25\xda\xcf\xe8\x75\xba\x3b\x03\x8e\x41\xfa\x70\xb6\xf1\xff\xc7\x4d\xbe\x6e\x6d\x01\x6e\x2f\xb5\x82\x14\x3c\x8c\xb6\x42\x1d\xf7\xef\x3b\x97\x95\xf8\xdc\x0f\xa3\xcb\x8e\xa3\x60\xbd\xb9\x0f\xee\xe4\x99\xee\x62\xef\x0a\x2f\xb2\xeb\x78\x2c\x50\x2c\xc0\x76\xa1\xa3\x99\xc2\xfa\x83\x20\x27\xdd\x15\x27\x21\xb5\x36\x2f\x28\x73\xbc\x87\xf6\x11\xc0\xc4\x74\x35\x8e\x25\xb2\x30\x43\x4c\x28\x3f\xba\xec\x3b\x0b\x7a\x1a\x92\x7a\x80\xcc\x6a\xf2\x77\x6a\x4a\x2b\xf2\x3f\x43\xbe\x9d\x6e\x21\xdf\xba\x71\xd9\x36\x8e\xa8\x69\xf9\xf6\xb5\x80\xc2\xf3\x5e\x45\x2f\xa7\xa4\xad\xaa\x39\xd0\x7f\x15\x2a\xeb\x11\x01\x71\x6e\x52\x2f\x08\x15\x9c\x28\xf8\x54\x1c\x5f\x1f\x9f\xec\xb4\xcb\x18\xaa\x1b\x27\x1f\xbe\xdb\xb2\xc4\xeb\xee\x22\x87\x47\x55\xa5\xe2\x4f\x3b\x91\x16\x69\x86\xc4\xd5\x72\x48\x9d\x3c\x4c\xc6\x19\xa6\x42\x5a\xf5\xb3\x39\xb8\xb3\x66\xb4\x0b\x9d\xd5\x51\xb2\x23\x17\xa5\xab\x61\x77\x4b\x58\x06\x82\xf6\xba\xfc\x17\x44\x4c\xfd\x60\x70\xa6\xae\xde\xd7\x29\xd6\xa6\x47\xb3\xe0\xb8\xd3\xca\x2b\x28\x51\xef\x55\xac\xb8\xef\xb1\x22\x04\x5f\x9c\x80\x55\x6a\x07\x1d\x82\x87\xf1\x0b\xf9\x29\xc0\x09\x80\xf1\xac\x97\x1d\x59\x25\x84\x01\x38\x71\xeb\x04\x91\x34\xd2\x4e\x70\xc8\x39\x4a\x65\x5e\xf0\xfc\xf1\x50\xf9\xe3\x65\x35\xa1\x0b\xbe\x6b\x9b\x46\xa4\x73\x28\x64\xf9\x4b\x75\x54\x66\x70\x25\xdb\x8f\x9c\x89\x6f\xb6\xc5\x75\xce\xfe\xb9\x6f\x96\xa6\xaf\x98\x77\x26\x58\x76\x5b\xd5\x07\x3b\x73\x28\x53\xbf\x57\xc9\x73\x63\xd1\xbc\x05\xfa\xdc\x86\x1e\xff\x81\x4a\xbd\xca\xe2\xd3\x03\x6a\xe4\x6d\xec\x55\x6c\xa9\xfa\xe1\xca\x78\x43\x70\x98\xb5\xc7\xf5\x7e\xdc\xb1\xcb\xac\x1c\x32\xf4\x3f\xb4\x7d\xdd\x32\xe5\x7d\x52\xf2\x45\xa7\x0c\x7e\x7c\x29\xd0\x4b\x44\x40\x41\x5b\x22\x38\x58\x5d\x4a\xb1\xae\xee\xcf\xd4\x11\x54\x19\x07\x6f\xdf\x3c\x5f\xfc\x11\x30\xd4\x30\xc4\x11\x11\x5a\x2a\xf4\x13\xac\x47\x32\x25\x94\xd5\xb0\xfa\x1b\xec\xdc\x6d\xfa\x56\xd1\x02\x19\x64\x6a\xb3\x53\xbd\xe8\x20\xc1\x09\x30\x41\xca\x7d\x61\x47\x36\xaf\x4c\xf3\xba\x8b\x33\x53\x9b\x3b\x5d\xc9\xa1\xdd\x1d\xc5\x9f\xca\x2e\xb4\x80\xc5\x16\x32\x69\x7c\x3c\x12\x53\x97\xb7\x6c\x75\x07\x27\xa0\x00\x0f\xc0\xf4\x08\x3c\x78\x00\xfe\x63\x06\xfe\x04\x8e\x80\xaf\x54\xfb\xd7\x47\x47\xf2\xfd\x3d\x10\x2b\x5d\x07\xe6\x0f\x12\xe6\xcb\x04\x8c\x0f\x77\x4f\xe1\xfa\xfd\x00\x5c\x00\xab\x70\x7e\x35\x02\xeb\xc3\x7f\xa9\x70\xff\x01\x1c\x03\x7d\x6b\x57\xfe\x35\xfc\xda\x95\xff\xf6\x82\x41\x4d\x82\x20\xf9\x43\xca\xdf\x5e\xab\x6f\x73\x75\x35\xc4\xb3\x0a\xd5\x2f\xca\xb9\x66\x23\xc4\x5e\x74\x2b\x00\x1d\x31\xcd\x37\x26\x08\x45\x32\x7c\x0f\xdf\xaf\x61\xd5\x8b\xbe\xb0\x3e\xc4\xa6\x2b\x00\x0b\x97\xc5\x24\x2e\x13\x8c\xe7\xf2\x09\x25\xa6\x79\x7f\x45\xfe\x29\xc7\xf8\x92\x28\xdb\x98\xc4\xf1\x53\x75\x65\xec\x09\xe8\xeb\xf0\x5f\x57\x8a\x11\x9d\xc6\x48\xf8\x00\x86\xa1\x1a\x62\xa2\xaf\x93\xea\xda\x5f\x21\x72\x3f\xc8\x43\x8e\x08\x78\x68\x21\x22\x4f\x83\xad\xbf\x6f\x88\xd5\x05\x87\x73\x5b\x26\x92\x48\x7d\xbd\x87\xae\x45\xe1\x2c\x91\xd3\x54\xee\x4a\x5a\xf5\x69\x83\x97\x64\xe5\xfa\x64\x83\x4b\x5d\x75\xda\x41\x45\xb0\x79\x4f\x25\xbf\xfc\x9f\x00\x00\x00\xff\xff\x1c\xb8\x42\xfa\xa5\xc8\x00\x00"
+var _jsGogsJs = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\xdf\x7a\xdc\x36\x92\x28\x7e\xaf\xa7\x40\xda\xde\x90\x3d\x6a\x52\x52\x32\x99\x9d\x95\x2c\xcf\xe7\x38\xf1\x8e\x67\xed\x24\x1b\xdb\x3b\xb3\xeb\x68\xfd\xa1\x49\x74\x37\x22\x36\xd1\x03\x80\x92\x95\xac\x9e\xeb\x77\xff\x7b\xb2\xf3\x15\xfe\x90\x00\x08\xb2\x29\xd9\x99\xc9\x9c\x3d\xbe\x48\xd4\x44\xa1\x50\x28\x00\x85\x42\x55\xa1\x90\x34\x82\x20\x21\x39\x2d\x64\x72\x76\x70\x70\x85\x39\x2a\x04\x5f\x9d\xa9\xbf\x44\xb3\x6c\x78\x75\x76\x70\xb0\x6a\xea\x42\x52\x56\x23\x5a\x53\xf9\x94\x6d\xb7\xa4\x96\xdf\x71\x72\x45\xc9\xf5\x6b\xbc\x4c\x1f\xae\x18\xdf\xce\xd1\xcf\x07\x08\x21\x04\x15\x1f\x4a\xbc\x7c\x49\xea\x06\x9d\x23\x55\x96\xaf\x68\x5d\xa6\x49\x2e\xf1\xb2\xa9\x30\xcf\xb7\xa4\x6e\x92\xf9\x99\x82\xb7\xb0\x16\x86\x4a\xb2\x4d\xe6\x00\x9a\x8e\x40\xbc\x2d\xb1\xc4\x99\xc4\xcb\xf3\x59\x82\x0e\x3b\x10\xf8\x9c\x26\x3b\x4d\x5b\x32\x47\x87\x28\x99\x5d\x24\xf3\xbc\xa8\x68\x71\x99\xb6\xfd\x48\x2d\xb5\x1d\xc5\x1b\x2a\x80\xdc\x14\xfe\x98\x9f\xb5\x85\x0f\xf3\x1d\x13\x32\x55\xe5\x06\x7b\xc3\xab\x64\xbe\x70\x10\xc0\xbf\xd9\x3b\xe0\xdb\xec\x54\xb1\x6f\xe1\x17\x6d\x59\x49\x66\xa7\x68\xb6\x5e\x6d\x67\x41\x51\xc1\x6a\x49\xde\xcb\xd9\x29\x72\x5b\x30\x5f\x93\x79\x00\x6d\x41\x03\x9e\xe6\x82\xac\x61\x48\xc6\x99\x72\xcd\xa9\x24\x96\x25\x08\x50\x61\x4e\x70\x32\xcf\xaf\x70\x95\xce\xdb\x86\x6e\xfd\x36\x3b\x96\x01\x96\x79\xd0\xeb\x96\x7d\x86\xe3\xdf\xe1\x9a\x54\xe8\xfc\x9e\x14\xf6\x86\xed\xac\xd7\x98\xd7\x50\xbe\x91\xdb\x4a\xd3\xd5\x87\x24\x5b\xf6\x23\x5d\xdd\xe4\xbc\xa9\x53\xaf\xd6\xdb\xe3\x8b\x18\x62\xd5\x3c\x2a\x58\x49\x92\x05\xea\x55\xc8\x09\x2e\x36\xce\xfc\xa1\x0b\xb4\xac\x58\x71\x19\x63\x08\xfc\xdb\x54\x3f\x8a\x7c\x43\xd7\x9b\x8a\xae\x37\xf2\x4b\x00\x4d\x75\x85\x7e\xd3\xb7\xc1\xb7\xdb\xf6\x97\x29\x00\x00\xf5\xc7\xb2\x91\x92\xd5\xe2\x29\x4c\xe6\x6f\xeb\xaf\x6b\x49\x38\x2c\x92\x5b\xbd\x70\x0d\xcd\xcf\x68\x45\x5e\xb2\x92\x88\x70\xe1\x7e\x5d\xd2\xbf\xe1\xaa\x75\xa7\xc5\x6b\xbc\x44\xe7\xd1\x3a\xf7\x9a\x10\x74\x85\x52\x07\x75\x5e\x91\x7a\x2d\x37\xee\x50\x84\xac\x80\xd6\x9d\x0a\x1e\xf6\x6c\x45\x2b\x92\xc1\x1a\x15\xc9\x3c\x17\xbb\x8a\xca\x34\x59\xb8\x93\xcf\xad\x3a\x26\x48\xf6\x0a\x93\xbb\x08\x94\x3d\x42\xe5\xee\xd2\xe3\x6f\x26\x41\x22\x52\x64\x9a\x24\xf9\x9b\x4b\x93\xbb\x49\x94\x7b\x48\x95\x5f\x40\xb2\xdc\x55\xba\xc4\x24\x8c\x2f\x65\x1c\x49\xe3\x02\xdf\x82\x5c\xe9\x09\x90\xaf\xe8\x6a\xf5\xab\xdc\xf3\x4b\xba\x5a\xfd\x5a\x36\x7c\xb5\x02\xeb\x5f\xe9\x56\x0d\x8c\xfa\xee\x63\x2c\x30\x9f\xe3\x91\x2d\x35\x6c\x69\xfa\x7e\x1d\xd6\xec\xaf\xae\xe8\x26\x79\x7b\x10\x99\xb1\xcf\x18\xdf\xb6\xc3\xaf\xb6\x8e\x34\xc9\x49\x49\x65\x0e\xbd\x4e\xe6\x66\xfb\x40\xe7\xe7\xe8\xd8\x65\x1a\x27\xb2\xe1\xb5\x5d\x0b\xba\x76\x64\x17\xf5\x91\xcd\xcf\x3c\xc0\x76\xb5\xf4\xa0\x6e\x0f\xe2\x6a\x75\x94\xda\x42\x97\x4d\x22\x38\xa4\x37\xa2\xae\xf7\x30\x5a\xfd\xe2\xe8\x08\xbd\xc0\x4b\x52\x89\x6e\x55\x57\x54\x48\xb5\x4c\x92\xbc\xa1\x79\xa5\x4a\x73\xf8\x98\xb8\xfb\x7c\xcd\x5e\x91\x8a\x14\x0a\x12\x0a\xed\x5c\xaa\x59\x26\x54\x81\x07\xad\xb0\x58\x59\x91\x26\xb9\x06\xc9\xd4\x67\xe4\x09\x0a\x00\xdf\x60\xa1\x88\x7a\xb3\x2b\xb1\x24\x4f\x34\xc7\xce\x1d\x2c\x66\x3a\x62\x55\x92\xcc\x81\x2f\x49\xa3\x80\x13\xd3\xaf\x96\xd1\xfa\xf3\x73\x21\x1a\xf2\x92\x48\x9c\x36\xbc\x5a\x20\x5d\x71\x81\x68\xe9\x72\xd3\xc8\x01\x05\x31\x7d\xe5\x6b\x5c\xb3\x53\x8b\xd4\x2f\xa5\xe5\xec\x14\xd1\x32\x22\x6a\x2d\xfb\x9f\x94\x25\xfa\xb4\x5e\x8a\xdd\x19\x92\x0c\xc1\xd6\x80\x9a\x5a\xf3\x87\x94\x48\x73\x48\x32\x74\x49\xc8\x0e\xbd\x79\x8e\x2a\xc6\x2e\x05\x5a\x33\x56\xe6\x16\xc3\x6b\x90\x6b\x62\xc3\x9a\xaa\x44\x4b\x82\x70\x59\x92\x12\x95\x94\x93\x42\x56\x37\x50\xf7\x8f\xaf\x5f\xbe\x00\x4d\x12\x09\xb6\x25\x1b\x76\x8d\x7e\x6c\x84\x44\x6b\x22\x11\xd9\xee\xe4\x0d\x7a\x24\x76\xb8\x7e\x8c\x58\x8d\x94\x88\xdc\xe1\x35\xd1\xc8\x1d\x8e\x3b\x52\xf9\xb4\x66\x32\xed\x06\x7a\x8e\x72\x56\x48\x5a\xb0\x5a\x17\x98\x1f\x59\xb1\x21\xc5\xe5\x3c\xe9\x6d\x77\x1e\xcb\xb5\x2c\xd6\x62\x22\xd1\x6c\x48\x9c\xe5\x3d\x9d\x88\x3d\x1b\x80\x5e\x57\xa6\x31\x2c\x9e\x56\x58\x88\x34\x51\x24\x92\x32\x99\x87\xa2\xd3\x82\x72\xb2\x65\x57\x24\x84\x3e\x8b\xc2\x1a\xe2\x4c\xf7\x93\xa0\xb2\xc7\x95\x24\xde\x63\x97\xd8\xd8\x1a\x88\x09\xf8\x70\x7e\xf7\x16\x89\x06\xc8\xcc\x8e\x36\x2b\x89\xc4\xc5\x66\xb6\x68\xc9\xd6\x50\xb4\x6c\x25\x59\x5f\xde\xde\x22\x52\x09\x32\xc0\x22\x5c\x96\xf7\xe4\x4f\x57\x33\xce\x9c\xbf\x25\x5b\xb0\xbc\x07\x5b\x0e\x3c\xfd\x42\xb5\xf0\xbc\x14\xe8\x1c\xcd\x66\x67\xbd\x29\xbe\xc3\x9c\xd4\x32\x9d\x07\x1a\xd0\xc8\xe2\xb8\xc7\xbc\x85\x7f\x2d\x1d\x87\xe7\x91\xde\xa0\x43\x34\x5b\xcc\x62\xa7\xe1\x10\xd6\xac\x2c\xc6\x93\x79\x30\x97\x37\xb4\x24\xe1\xe0\x44\xe7\xc8\x04\xc4\xdd\x24\x88\x62\x3d\x88\x29\xb4\xc0\x14\xdb\xcb\xa1\xad\x51\x35\x6d\x37\xa9\x71\xf2\xe3\xd3\xbb\xad\x3b\x4c\xe1\xed\x41\xbf\x93\xed\x30\x3b\x13\x48\x29\x71\x96\xe0\xf9\x59\xb0\x7d\xa3\x15\xae\x04\xd9\x27\xf3\x5a\x49\x67\x27\xce\x3e\x69\x37\x65\xa5\xdc\x75\x95\x14\x15\xc1\x7c\xb6\x40\x89\xcf\x85\x8f\x34\xd7\xff\x7e\x42\xb7\x35\xb7\x68\xde\x3b\xea\xcc\xdd\x28\x1f\x99\x2a\xce\xdf\x13\xa7\xe5\x84\x39\x95\x24\xa1\xbd\xa8\xa5\x51\x4f\x96\xe7\x92\x6c\x53\xfd\xe7\x3b\x5a\x2e\x10\xad\x77\x0d\xfc\xd5\x3b\x1b\x6d\xad\x6e\xd6\x02\x83\x96\xef\xab\x66\x71\x0d\x11\x8e\x09\x5e\x25\x4f\x57\x74\x54\xba\x50\x9b\xdb\x4e\x52\xe4\xe0\x9f\x06\xbd\xff\xe6\xff\xe1\x53\x73\x70\x7a\xb6\x8a\x1a\x74\xe1\x8a\x24\x81\x4d\xc4\x9d\x57\xf1\x89\xd2\x43\x10\xdd\xf3\xee\xbc\xdd\x6d\x87\xd6\x70\x92\xdc\x69\x8f\x83\x7f\xe2\x9a\xca\x62\x83\xd2\xd8\xe4\xb1\xff\x0a\x2c\x08\x4a\x1e\x6c\x69\x45\x84\x64\x35\x79\x47\xcb\xe4\x34\x6e\x1c\x71\x97\x97\xed\x7d\xbb\x36\x1f\x61\x54\x00\x63\xce\x67\x30\x30\x33\xb4\xe1\x64\x75\xae\x4e\xa2\x1e\xcd\xf0\x59\x1f\x44\x1f\x27\xe8\x70\xd0\x6a\x62\x2b\xc1\x79\x3a\x55\xe0\x8f\x8e\xf0\xe3\x21\xbb\xd0\x92\x13\x7c\x79\x36\xd4\x35\x2c\x04\x5d\xd7\xe4\x57\xd2\xb3\xe4\x11\xdd\xae\x2d\xc2\x86\x22\x7c\x85\x25\xe6\x88\x6e\xf1\x9a\xcc\x90\xe0\x45\x04\xb5\x86\xf9\x68\x6c\xbb\x0d\x66\xf6\xb0\x30\x40\xee\xa9\x70\xcf\x8e\xff\xb0\x9b\x66\x4a\xc4\x8d\xce\x55\x4f\xaa\x6e\xef\xbb\x5b\xee\x13\x0f\x11\x69\xf3\x37\x12\x17\xbf\xc8\xea\x4f\xfa\x03\x79\x30\x7d\x1a\xf7\xc6\x6b\xe0\xf0\xbf\x5f\x63\x0c\x47\x3a\x49\xa2\x26\x49\x7b\xca\x7d\x69\x45\x0b\xc2\x75\x89\xec\x6a\x54\xa5\xce\x5e\xd7\x9a\x16\x5a\x49\x94\x2c\x42\xb9\x64\x50\xc7\x6a\x59\xb4\xaa\x92\xbb\xe2\xb5\xfd\xc6\x33\xdf\x7c\x4f\x76\x4c\x50\xc9\xf8\x4d\xcf\x7a\xc3\xdb\xa2\x3b\x19\x9b\x3c\xf4\xcf\x68\x25\x09\x7f\x45\x30\x2f\x36\x5f\x71\xb6\x2b\xd9\x75\x9d\x5a\xcd\xb9\xb7\x7f\x97\x06\xc2\xd9\xc3\x19\x77\x57\x87\x05\xc8\xed\x1f\xe9\xcf\x81\x29\xb1\xaa\x5e\x93\xf7\x52\x37\x78\x8a\x24\x6f\x88\x6f\xcb\x60\xf5\xd3\x0d\xae\xd7\xe4\xb4\xa3\x33\x05\x09\xb1\x40\x57\xb8\x6a\xc8\x02\x3d\x2c\x36\x8c\x16\x24\x36\x43\xaf\x69\x5d\xb2\xeb\xbc\x62\x05\x86\x8a\x39\x48\x39\x74\x6e\x6b\xb8\x46\xd7\x88\xfc\x65\xb5\x60\x15\xc9\x2b\xb6\x4e\x23\x15\x46\xbd\x10\x5b\x22\x04\x06\x92\x7f\x46\x35\xfb\x9e\x88\xa6\x92\xe2\xd4\x65\x86\xc2\x53\xb3\x8c\xeb\xb2\x64\x1e\x39\x72\x74\x73\x10\xbc\x4b\x48\x49\x34\x98\x83\x60\x51\xa3\x52\x0c\x8c\x7c\x0e\x4e\x26\xa3\x0b\xd9\x39\xf0\x18\x1d\xa3\xff\xf9\x9f\xb6\x01\x1f\xde\xa0\xf3\xa0\x3d\xa5\x7e\x68\x52\x24\x79\xb1\x61\x4c\x90\x9c\x93\x15\xe1\xa4\x2e\x08\x6a\x47\x39\xf1\x34\xdb\x34\xe9\x60\xf2\x82\x55\xcd\xb6\x9e\x24\x1b\x63\x2d\x88\x82\xb3\xaa\xa2\xf5\xda\xe8\x88\x79\x01\x0b\xbd\xa4\x62\x57\xe1\x1b\x58\x3f\x35\x2c\xbe\xf9\xd9\x04\x54\xda\x77\xe5\x4b\x8c\x65\x85\x8b\xcb\x7e\x75\x7f\x3b\x90\x98\xaf\x89\x4c\xe6\xfd\xc6\x95\x6f\x64\xcf\xa1\xc1\x34\xdb\xed\x47\xd1\x36\xfb\x87\xb4\xf8\xdc\xf8\x33\xbd\xa4\x43\x53\xe1\x9a\x5e\xd2\x5c\xbb\xa6\xee\x3d\xb8\x60\x95\xf3\xc7\xd5\x6f\xff\xdb\x1d\x8c\xde\xe0\x6c\x14\x44\x4a\x5a\xaf\x45\xce\x34\xdc\x20\x25\x0f\xd3\xe4\x01\xd4\x7b\x57\xe3\x2d\x49\xe6\xf9\x25\xb9\x69\x76\xfb\xdc\x9f\x3b\xce\xb6\x3b\x73\x26\x50\xb5\x33\xa8\x9d\x15\x4a\x60\x64\xba\x34\xa6\xda\xda\x11\x51\x5e\x8e\x5c\xb2\x57\x92\xd3\x7a\xad\xfe\x7c\xc1\xae\x09\x7f\x8a\x05\x49\xe7\xe8\x93\xd0\x92\xd1\x36\x91\x0c\xd7\x8a\x6e\xca\x9a\x94\x5c\x6c\xd8\x75\x3a\xd9\x82\x61\x2a\xc1\x26\x96\xee\x35\x50\x74\x23\xf2\x25\xc7\x75\xb1\x21\xfb\x87\x64\x69\x00\xef\x33\x3b\x76\x9c\x49\xb5\x43\x67\x16\x4b\x7f\x92\xd8\x95\x47\x6a\xbc\xac\x48\x66\xaa\x28\x0b\xb8\xfd\x76\xbd\xa1\x92\x18\x61\xa5\x47\x6d\x8f\x51\x4a\x39\xcb\xcc\xe1\x7c\x3e\xc1\xec\xd3\x2d\x55\x6f\x99\x97\x54\x40\xfb\xe5\xbd\xcd\x49\x1d\xde\x6e\x1d\x0f\x22\x1d\x19\x2c\xc7\x0b\x12\x19\x2a\xed\x05\x19\x1c\x9f\xa3\x23\xf4\x94\x13\x2c\x89\x36\xbe\xf9\x5b\x73\x4d\xae\x15\xf2\xd6\xf5\x06\x8a\x12\xb9\xd6\xce\x0f\xeb\x77\x0b\x87\xaa\x03\xd0\x11\x20\x53\xc4\xb4\xd7\x50\x6f\x8a\xdf\x0e\xb6\x60\x48\x40\x79\x81\xeb\x82\x54\x77\x6f\x2a\x5c\x18\xb7\xe1\xa6\x53\xb0\x8a\xf1\x6c\x47\x8b\x4b\xc2\xa7\x5b\x53\xb6\xb4\xa6\xaa\xa6\x18\xeb\xc7\x8e\x13\x0d\x84\x74\x33\x13\xc8\x57\x31\x6f\x00\xfb\x6e\x43\xde\xa3\x50\xb6\x68\x62\x37\xe4\x7d\x74\xf3\xf2\x7b\x02\x72\xab\xc5\x14\x01\xef\xba\x90\x89\x6b\x2c\x8b\x4d\xd6\xd2\x28\x44\x3a\x5b\xe2\xe2\x72\xcd\x59\x53\x97\xfa\xfb\x6c\x81\x62\xd8\xc2\x2e\x83\x5f\x51\x8f\x5d\x36\x7d\x76\xa4\xc9\x03\x5d\x65\xcb\x4a\x5c\x65\xb4\x34\xe4\xef\x35\x06\xf8\x2d\xa2\x6e\xe2\x64\x4a\x7f\x8f\xa2\x91\x54\x56\x64\x2f\xa6\x08\x33\x23\x43\x91\xcc\x3f\x0a\x63\xa7\x62\xee\x08\xcc\x15\xa7\x92\xb9\xfe\x7f\xda\x97\x45\xac\x7e\xb2\xdb\x71\x76\xe5\xa9\xc4\x43\x51\x1c\x01\x6e\xe3\xd8\x15\xcd\x72\x4b\x65\xba\x37\x5a\xe3\xd6\x52\x91\xc0\xba\xbe\xaf\x8e\xd2\x9e\xa1\x06\x05\x5d\x7b\x50\xea\x0b\xbb\x83\x8e\xaa\x48\xc5\x9a\x5c\x77\x95\x07\x05\xa5\x3e\xab\x60\x49\xf4\xa0\x1b\x69\xd8\xd6\xcb\xbb\x22\x4f\x20\x76\x9f\x15\x84\xa4\x5b\xf3\x33\x18\x94\x0a\xd7\xeb\xd3\x10\x1c\xa7\x09\x7c\x0f\x83\xa3\x68\x5d\xd1\x9a\xc4\x0e\x3a\x1d\xfa\x53\xcd\x52\xbf\x58\x48\xcc\xe5\x57\x58\x92\x58\x4b\xaa\x30\x83\xcf\x61\x7b\x30\xe0\xd8\xd4\x4b\xfe\x33\xdb\x66\x65\x12\x9e\xaf\xb4\x55\x58\x83\x74\x13\xaa\x90\xf1\xfd\x35\x79\x50\x12\x5c\x42\x27\xac\x20\x92\x8a\x3b\xcf\x54\x43\xa9\x69\x63\x3e\xc9\xa3\x02\xd8\x94\x71\xdf\x90\x3e\x49\x9c\x04\xcd\x27\xf7\x9d\x95\xca\x70\x31\x38\x23\x41\x6d\xce\x29\x80\x8c\x6d\xbf\x10\x7a\x81\x14\x14\x52\xb2\xc7\x9f\x71\xaa\xe0\x35\x7c\x37\x2a\xaa\xfa\x90\x19\x29\x15\x58\xb7\x61\x91\x3e\x07\xc9\x66\x60\xd5\xa2\x55\xa0\xad\xc0\xd3\x07\x08\xf3\xcb\xaf\x0e\xd0\xaa\xa5\xd7\x6c\xbd\x56\xed\x8d\x30\xb1\xa3\x2b\x97\x0a\x3c\x8d\x08\xa4\x9a\xc9\x8c\xd6\x19\x20\x4e\xe6\xc3\x70\x31\x3a\x87\x91\xee\x45\xd8\x72\x21\x5f\xb1\xa2\x11\xe9\xb4\xb1\xf5\x67\x54\x47\x51\x3b\xa3\x02\xf6\x84\x53\x50\x29\x20\xd9\x9d\xeb\x09\x7c\x45\xa6\xd4\xda\x3b\xad\xd5\xfc\xeb\xba\xae\x8f\x25\x8e\x01\xc7\x3d\xbd\x47\x58\xa5\xe0\x01\xd0\x1b\x59\x65\x45\x8d\xae\x61\xbf\x66\xa4\x52\x7f\x5b\x88\x33\x3e\x66\xc6\x33\x51\x6d\xde\xb6\xe7\x5b\x03\xef\x1e\x7e\xaa\xd8\x3b\x3b\x0d\x29\xff\x48\x91\xa0\x01\x56\x00\xcd\x55\x8b\x43\x71\x96\x21\xc3\x46\x6b\xdc\x4e\x16\x4f\x03\x82\x85\x71\x64\x82\xa8\x90\x09\xf7\xeb\x6b\x65\xa6\x60\xa2\x12\xfa\xd0\xaa\xde\xe7\x7d\x03\x74\xff\x8f\x5a\xcd\x89\xb3\x3e\x16\x68\xf9\xa9\x6e\xf8\xbf\x58\xad\x64\x9c\x41\x6c\x4d\x1d\x2e\x6d\xd9\x4f\x11\xc3\x8c\xc2\xc3\x49\x5d\x12\x6e\x30\x45\xb0\xe8\xf2\xae\x8f\x31\x14\xf8\x7a\xa4\x3e\xbe\x1e\xad\x6c\xc3\x1e\x03\x2b\xf8\xd1\x11\x7a\x45\x64\xb3\x43\x35\xb9\x56\xdb\x68\x7c\xc9\x3a\x1c\xd0\xb6\xea\xf9\x58\x6c\x40\x3b\xdf\x7a\xd5\x5a\xc9\x65\xf9\x65\x54\x35\x8d\x33\x16\xf5\x68\xe9\xee\x77\xb9\x0b\xe4\x3c\x3b\xe8\xd5\x3b\x3a\x42\xff\x4a\xaf\x88\xea\x96\x8a\xff\x3c\x32\x91\xc2\xc8\x06\x61\x22\x30\x71\x20\xc9\x50\x49\x05\x98\x0a\x1a\x2a\x36\x68\xc5\xd9\x16\x31\xb9\x21\x5c\xc4\xc3\x3d\x9d\x5e\x81\x2e\x80\xce\xbd\x4f\xaa\xa3\x66\x40\x1a\x1a\x84\x05\x9e\xc5\x31\x3a\x81\xbe\x01\xf2\xb1\x98\x5f\x9f\x47\x1a\x45\x8e\xa5\xe4\x69\xa2\x3a\xa8\xfa\x9c\x2c\x3c\x9c\xff\x65\xb4\xc0\x2e\x24\x76\x22\x32\xc3\xba\x61\x74\x16\x60\x14\xa1\xe9\x8d\x6a\xda\xba\x8e\x9c\x46\x24\x5e\x7e\x10\xbd\x06\xbd\x21\xe5\xae\x0d\x8c\xf6\x60\x60\x5c\x74\x4f\x5a\x23\xc3\xc7\xe8\xcc\x40\x4b\xb6\x53\x77\x6f\xcb\xed\x57\xaf\xb9\x81\x80\xd6\x80\x88\x58\xcd\xa1\x49\xaf\x95\x8c\xe9\x76\x95\x16\x9f\x27\x1e\xa3\x26\xc4\x61\xc1\x12\x31\x1d\xa2\x81\x30\xfd\x21\xba\x41\xc9\xf9\xfb\x50\x1d\x87\x36\xfa\x45\x7c\x4c\xf7\x29\x1a\x13\x15\x8e\x16\xcc\x89\xaf\xb7\x32\x55\x6b\x1e\xfb\xea\xe8\xcb\x2f\x71\x22\xdb\x6b\x33\x51\x1c\xb7\xc3\xa8\xa7\xa9\x33\xee\x0e\x05\x70\xfb\xb6\xa3\x3d\x43\xd7\x6e\x4e\x35\x73\xd4\x8c\xc1\x5d\x69\x92\x35\x75\x4a\x93\x8a\x74\xd3\xe0\xfc\x6c\x2f\x0a\x2f\xaa\xdf\x43\x37\x7c\x61\x66\xe4\xf2\x4c\x0f\xc3\x7d\x6e\xcf\x7c\xc8\x4d\x9a\x7d\xcb\x35\x7e\xb8\x9e\x52\xf3\x76\xb2\xcd\x7b\x9a\x82\x31\x72\x18\x00\x15\x0a\x02\xbf\x7d\x35\x03\x34\x0c\xed\xc8\xdc\xdd\x20\x8e\xaf\xad\x5a\x8b\xb0\x40\x35\x21\x25\x29\x0f\x46\xe5\x43\x4c\x98\x84\x33\x28\x22\xf8\x94\xc6\xe6\xaf\xe2\xbd\x9a\x9a\x07\xee\x68\x99\xf1\x83\x52\x10\x9a\xd2\x56\xbe\xcb\x51\x36\x38\x07\x7c\x45\x2a\x22\x89\x3d\x00\x78\x8a\x7f\xa9\x8a\x32\x53\x34\x55\xf5\x1f\xbb\x31\x08\x1c\x2a\x58\xbd\xa2\x7c\xeb\x5d\x4b\x02\xff\xb9\xb2\xab\x46\x79\x74\x87\x6b\x86\x3d\xd1\x1b\x99\x9c\x79\xc9\x6a\x92\x4e\x32\x6c\x3e\xd0\x77\x85\xdc\x0b\x89\x8a\x17\x19\x2d\x3b\x8f\x4f\x7a\xa7\x8b\xb0\xd3\x47\x46\x47\x25\x20\x21\xb1\x6c\x84\x6f\xca\xd1\xdf\xbe\x54\x7b\xa6\xb1\xe6\xe8\x4f\xad\xdd\x3c\xb0\x3f\x18\xb2\x41\x19\x46\xea\xd0\xf4\xce\x5c\xc3\xda\xe7\x05\xed\x3b\x33\xf7\xcd\x68\x97\x36\x3d\x8b\xfd\x4f\xad\x3d\x51\x36\xa2\x17\xe5\x30\x28\x29\xee\x82\x35\xc3\xca\x3c\x6e\x66\xed\x7c\x8a\x99\xd0\xc3\x35\xc5\x46\x68\xe9\xd7\xeb\x76\x98\x94\x2b\x30\xb4\x47\x8c\x5a\xee\x88\x44\xcd\xe5\x7d\x73\x22\xdc\xc0\x1a\x32\x26\xea\x0b\x6c\x63\xc6\xe9\x82\x35\xb5\x6c\x2d\xd3\x00\x9f\x99\x4f\x49\x10\x81\x6e\x41\x5b\x6c\xe7\xe8\xa4\xc7\x02\x0b\xd3\xdf\xb4\x40\xf9\x1f\xbc\xb1\x07\x85\x8a\x04\x05\x15\x3f\x95\xe1\xb2\x7c\x41\xf5\x69\x1f\xa0\xcc\x86\x00\x57\x79\xf4\x25\x3e\x30\xcd\x5e\x80\x57\x34\x31\xd6\x9f\x19\x7c\x99\x0d\x60\x2b\x49\x35\x01\x5b\xa9\xdc\x83\xfb\xb1\xe1\xb2\xfc\x8e\xf0\x42\x9b\x01\x76\x98\x0b\xf2\xac\x62\x58\xa6\x86\xe6\x39\x3a\x42\x69\xec\xf3\xa1\x0b\x6c\x48\x9a\xcf\xd1\x6f\xd0\xc9\xf1\x71\xbf\x25\x87\xd2\x59\xbe\xc4\x1c\x41\x6f\x67\xc6\x05\x74\x4d\x4b\x09\x17\x3a\x1c\x52\x0e\xd1\xec\x9f\x42\x8a\x6f\x87\x22\xd9\xcd\xe0\xab\xab\xe2\x4b\xf6\x1e\xe5\xd0\x5f\x91\xd5\xcd\x94\x70\x42\x57\x1c\xe8\x63\x10\x1d\xb8\xad\x31\x10\x19\x65\x44\x6a\x0f\xc5\x98\x31\xdf\x21\x5e\x63\x9d\xe7\xac\x4e\x93\x0d\x16\x1b\xed\xd2\x4f\x16\x8e\xe6\x4a\x62\x61\x3e\xd1\x2e\x83\x2a\x96\xdb\x80\x45\xdf\x7d\x1f\x0f\x5a\x86\x19\x00\xd3\xb7\xd7\x37\x2c\x36\xf9\x16\xbc\x75\xe9\xd1\x7f\x3f\x50\x6d\xe5\x87\x0f\x8f\x22\x9b\xdf\x36\xee\xf2\xd8\x2a\x05\x50\xd0\x25\xc4\x1d\x89\x34\x71\x08\xf4\x02\x79\xe2\x64\xb9\xdc\xca\x25\xa7\xeb\x35\xe1\x1e\x7b\x5c\x1e\x1a\x83\x98\xba\x8f\xca\xd9\x9a\x13\x21\x8c\x4c\xd0\x1f\xb3\x9d\xf9\x1a\x7a\xee\x75\x31\x29\x15\x1b\x91\xb5\xc4\xef\x8d\xa9\xd0\xcb\xbe\x75\x3b\xc4\xd2\x08\xa8\x52\x08\xf8\xd8\x39\x21\x0e\x0b\xe5\xc0\x1a\xc8\x38\xe0\xd3\xff\xa1\xb9\x07\x76\x58\x6e\x66\xa7\x96\x0e\xd7\xf6\x82\xe5\x26\x9a\x7b\x40\x73\xa2\xab\x43\x45\x9a\x9c\xb6\x37\x3a\x0e\xc6\xf5\x8d\xa1\xa3\x55\xd8\x2b\x2d\xa9\xba\xe1\x30\x94\xa9\xa0\xc4\x64\xa1\x6c\x69\xb9\x26\xc4\x16\x6d\xf1\x7b\x5b\x20\x99\xc4\xd5\xfc\x6c\x62\x23\x42\x72\x06\x9e\xc4\x9c\xfc\x35\x3d\x9e\x3b\x36\x67\x83\xff\x3e\x78\x4e\x5c\x3c\x31\x72\x6e\xe7\xf9\x0a\xd3\x6a\x5f\xb8\xb1\x3b\x3b\x2c\x8b\x17\xe8\x93\x28\xe7\xfb\x2d\xe0\xea\x1a\xdf\x88\xbb\xb4\xe1\xcc\x40\xa5\xa0\x8d\xc9\xd5\xde\x1e\xfd\xef\x0d\x2d\x2e\xb5\x27\x55\x9d\x40\xba\x2d\x1a\x6d\xd8\x96\x1c\x20\x27\x10\x2d\x2b\x2a\x56\x93\x4c\x88\xcd\x02\xb9\x1f\x36\x52\xee\xc4\x1e\x59\xac\x23\x61\x9f\x02\xfc\x77\x9c\x49\x56\xb0\x36\xc4\xc0\x5b\x38\x96\xc2\xa0\x4d\xb5\x54\x46\x1b\xb0\x12\x5a\x37\x94\x86\xd7\x71\x8e\x8e\xd0\x77\x4d\x55\x21\x4e\xfe\xda\x10\x21\x87\xd4\x92\x82\x6d\xc1\xca\x9f\xef\x9a\xaa\xfa\x80\x10\x41\x1d\x01\x16\x0b\x12\x44\x23\xbe\xd5\xd1\x46\xdd\x9b\xe3\x5b\xc2\xd7\x24\x87\x8d\x41\xcd\x83\xb7\x60\x9c\x3e\x57\x1f\xdf\x09\x79\x53\x91\x8b\xa9\xb1\x63\x9e\xa2\x8c\xce\xe1\xd2\x4f\xa1\xc2\xa7\xde\x69\x6c\x3a\x12\x36\x19\x70\x7b\x9b\x40\xd9\xbc\x24\xa2\xe0\x54\x45\x33\xe6\x2b\x4a\x2a\xd0\x73\xee\x16\xd7\x37\x8e\x6b\x5f\xb8\x5f\x24\x65\x46\x74\xba\xe9\xa3\xc6\x02\x71\xb2\x25\xdb\x25\xe1\x6e\x0a\x8d\x9d\x81\x02\x99\xbf\x74\xd5\x62\x5b\xe0\x5e\x8b\xaf\x68\x7d\xd9\x03\x84\x8f\x49\x37\x7b\x73\x77\xe2\x2a\xb9\x02\x00\x63\xb3\x1b\x06\xc1\x87\x31\x38\x96\x58\x58\x98\x09\x37\xb8\xf5\x59\xc3\x75\x36\xda\x2e\xcc\xfb\x6b\xcc\xb4\x40\x4b\x92\x69\xfa\xa7\xb4\xa0\x65\xb7\xba\x1f\x13\x46\xf3\xec\x38\x59\xd1\xf7\xea\x66\x0b\xa9\x41\x1b\x78\xf3\xfd\xf3\xa7\x6c\xbb\x63\x35\xa9\x0d\x07\xc6\x17\xfa\xa0\x70\x09\x62\x92\x9b\x56\xa9\xb0\xa3\xe0\x46\x0f\x77\xa5\xea\xa0\xfe\x4a\x32\x0e\x57\xe9\x05\x31\xb7\x0c\x1c\xfc\xed\xf8\x2e\xda\x39\x60\x65\x06\x0c\xb5\x9d\x2b\x6f\xbe\x7f\x61\xce\xad\xa6\x1a\xae\x3b\x0d\x3c\xb1\x50\x99\x13\x39\x0f\xeb\xcb\x7e\x47\x9f\x7e\xea\x62\x8a\xe4\x3a\x70\x4a\xef\x92\xf3\xc0\x92\x3c\x3b\x6d\xa9\x9f\x90\x4c\x46\xc5\x79\xbc\x6a\xd6\x6b\x22\xe0\x9b\x48\xfd\x64\x32\x5d\x78\x86\x16\x2f\x4a\xb5\x10\x1d\x38\xf4\xf2\xc2\xcb\x2a\xe1\x14\x7a\x71\x1d\xce\x77\x97\x2d\xba\x89\xee\x60\xae\xfd\xf9\x1e\x1a\xaf\x70\xcf\x6d\x0d\xef\x2a\xa4\x8b\xc3\xd8\xeb\xc3\x94\x19\x92\x6e\xd5\xb9\xb2\x6e\xaa\xaa\xfb\x5a\x61\x21\x6d\x64\x4a\x92\x38\xdf\x19\xbb\x6c\x76\x83\xe1\x23\x1a\xa1\xe1\x18\x28\xb3\x5b\xdb\x3d\x25\x54\x83\xa3\xaa\x81\x3c\x3f\xef\x9a\x0b\x85\xab\xdb\x41\x5f\xd2\xb9\x14\x2a\x44\x11\xdc\x96\x6f\x8f\xd0\xe7\xbd\x63\x85\xcb\x9a\xf1\xbb\x5f\x7d\x1a\x9c\xf9\xba\x26\xf2\x4f\xaf\xbe\xfd\xc6\x76\xd3\x58\x0f\xfc\xe9\xa1\xf4\x5a\x55\x7e\xaa\x49\xbd\x5d\xec\xb1\xd5\x43\xf8\xd1\xba\x66\x5c\xd9\x8f\x2a\x82\x38\x11\x3b\x56\x0b\x22\x10\x5b\xe9\x9c\x62\xac\x11\x1a\x97\xc8\xfb\x41\xd0\xf0\x1d\x7d\x32\xc6\xd7\x58\xbf\xd0\xd0\xa5\x2b\x95\x8e\xa3\x67\x51\xd5\xc2\x11\xa8\x5f\xa0\x7b\x98\x10\x92\x47\x25\xbd\xf2\xee\x1e\x46\x6f\x40\xaa\x0a\x39\xde\xed\x48\x5d\x82\x9e\xa0\x93\x82\xc4\xd2\x34\x20\x38\x9a\x2a\xcb\x44\x2e\x24\x96\xc4\xec\xe0\x15\x13\xa4\x4c\xd0\x1f\x90\x85\xcb\xf4\x6a\xb4\x05\xa7\x61\x01\xdb\x91\x5a\x69\xa3\xfb\xa8\x49\xd0\x24\x82\x31\xd2\xc1\xe0\xe7\xb3\x77\xcb\x0a\xd7\x97\x33\xc4\x49\x75\x3e\xab\x99\x6a\x88\xcf\x1e\x27\xc1\x26\xa2\x10\x80\x8f\xe3\x5d\xc3\x2b\xb3\x85\xe9\x83\xb7\x2a\xa9\x1b\x25\x44\x0f\x51\x82\xda\x6f\x6a\xc4\xef\x49\x30\x0c\x8b\x61\x18\x1a\xe6\x9e\xb7\x5e\x8c\x35\x55\x17\xce\xd1\x69\xac\x14\x7a\x37\xd4\x9c\x51\xdd\x04\x3a\x8f\xd5\xac\x9b\xad\xb5\xff\xe9\xbd\x6e\x57\xe1\x82\xa4\xc9\x3f\x95\x96\x39\xb6\x74\xea\x7c\xb1\xd3\x0c\x58\x89\xd6\x9c\xdc\x28\xa6\xc3\xaf\x54\x77\x16\x98\xf9\xff\xff\x7f\x8a\x9f\x2d\xee\x08\x72\x58\x5c\x0a\x3f\x15\xef\x2a\x7a\x49\xaa\x9b\x41\xeb\xb3\x63\xad\xc2\xde\x6c\x55\x34\x2c\x59\x55\x26\x7b\xa3\x6b\xbb\x15\x68\xfb\x13\xb1\xbb\x85\xb6\x6a\x8f\xa1\x3a\x8a\xce\x95\x6d\xe6\x80\xe9\x6d\x28\x91\x23\x91\xd9\xf4\x8d\x54\x63\xb5\xd9\xfd\x3c\x53\x8d\xdb\x77\x15\xa5\xf9\x9a\x6e\x09\x6b\x64\xaa\xb6\x14\x07\xab\xdd\x62\x04\x91\x16\x44\xef\x23\x0b\xf4\xf9\xf1\x71\xa0\x02\xe9\x92\x34\x72\xb3\xf1\x05\x16\xca\xe7\x4e\x65\xb8\x47\xc3\x89\xcf\xbd\xe5\x03\x36\x0e\x91\xa9\xcf\xc6\x4c\x88\x85\xcc\xcc\x4d\x36\x6f\xc3\xd6\x1b\x30\x00\xde\x65\x8f\x75\xc5\xbe\xaa\x6b\xe3\x79\xfd\x56\x40\xee\x0f\xcb\x79\x53\xd5\x38\xcc\x96\xac\xbc\x41\x92\x6b\x72\x49\x2d\xf9\xcd\xc5\xfe\x2b\x09\xaa\xf7\x9c\x5d\x0f\xd9\x66\xec\x5a\xa3\x12\x9d\xab\x71\x7f\x0b\xd0\xae\x8d\x44\xb5\x94\xcc\x2f\xfa\xb6\xae\x4f\x4c\x45\x50\x45\xa0\x92\x51\x1f\xcc\xd5\x45\x94\x8b\x0d\x1e\x3c\x9e\xdd\x61\x87\x31\x69\x1e\x34\xd2\xf3\x58\x53\xc9\x7c\x60\x03\x32\xe5\xbe\xac\x35\xb2\x75\xc5\xaa\x8a\x5d\xcf\x9c\xab\xe8\x62\x83\xf5\x35\x98\xbe\xc0\x35\xa7\xad\x40\xe4\x9a\xaf\x62\x83\xc1\xbe\x2f\x24\x4f\x8f\x17\xe8\xe4\x78\x3e\xdf\x43\x07\x48\x59\xfb\xb7\xc1\x61\x20\xc2\x8a\x4e\x5f\x75\x3f\x81\x02\x5b\xc7\x87\xbf\xf5\x93\xcb\x79\xeb\x02\xae\xf8\x79\xd9\xda\xda\x70\xab\x27\xc6\x39\xdb\xbf\xf6\xd7\xe6\xf2\x7b\xe0\xb8\x93\x9c\x25\x61\x6b\x0f\x0c\x70\x4d\xae\xd1\x2b\xba\xdd\x55\xe4\xe5\x57\x5f\x07\xd1\xee\xb8\x91\xec\x2b\x76\x5d\x57\x0c\x97\xcf\x58\x2d\x9f\x5c\x13\xc1\xb6\x24\x1a\xb4\x4e\x2a\x02\xc2\xf6\xb4\x23\xf7\xed\xf1\x45\x2f\x40\xbd\x20\xaf\x6e\xea\x22\x16\x13\x6f\x5c\xc6\xdf\x2b\xe7\xae\x1b\x9d\xbe\xab\x30\xad\x5f\xab\x6b\xc0\x06\x66\x8e\x7e\x56\x79\xcd\xc4\x4d\x5d\xa0\x2d\x91\x1b\x56\xf6\xe6\xab\x23\xa0\xf6\x3b\x18\xe1\xe2\xed\xf3\xbf\xbc\xfc\xfa\x14\x09\x49\xab\x0a\x09\xa2\x2c\x46\x40\x89\x35\xac\xa0\xeb\x0d\xa9\xcd\x2a\x40\x70\xf9\x03\x49\xa6\x42\xb1\x11\x64\x79\xdd\x1b\xbf\xa2\x06\x60\x82\xcb\x14\xdd\x21\x62\x65\x38\x05\xb4\x07\x16\x04\xa9\x38\x94\x8c\x24\x75\x6d\x6b\x9b\xaa\xed\x20\xfc\xb2\x81\x2c\x6d\xdc\x5a\x5d\x13\xae\x92\xca\x9d\x23\x4f\xbb\xdc\x62\x7e\x09\x86\xa7\x19\xa4\x97\x50\x72\x50\x27\x8f\x28\xe9\xd5\xe3\xe4\xec\x60\x72\xd8\x88\x89\x58\x85\xcb\x41\x36\x36\x6c\x3c\x78\x24\x1e\x78\x11\xdb\xf7\x17\x6a\x1b\x1e\x10\xa0\x68\xf6\x82\xe1\x12\xee\x34\xe7\x79\x90\x23\x2b\x60\xa2\x9e\x7e\xb4\x5e\x3f\x05\x37\xfd\xfa\x34\xc2\x39\x41\xeb\x75\x45\xc0\x83\xf5\x25\x24\x16\x11\x66\x5d\x8e\x61\xa5\x75\x49\x6a\xf9\x67\x2a\x37\xaf\xf1\x52\x44\x17\xb2\xc4\xcb\x57\xf4\x27\x72\x8a\x7e\xeb\x7f\x17\x3b\x52\x55\x4f\x95\x81\x37\x7e\x6d\x45\x32\x56\x2d\x31\x3f\x45\x6f\x67\xa0\x14\xcd\x16\x68\x46\x25\xae\x68\x01\x7f\x09\xc9\xe9\x25\x91\x1b\xce\x9a\x35\x38\xcd\x66\xff\x13\x99\xb3\xb3\x0d\x51\xcc\xc9\x4e\x66\x8b\xee\xc7\x67\xee\x8f\xcf\xdd\x1f\x4b\xe5\x61\x71\xbf\x88\x2d\xae\x2a\xc2\x07\x1b\x00\xd3\x0d\x14\xfe\xb5\x61\x92\x0c\x42\x35\x35\xe3\x25\xe1\xa4\xcc\x40\x65\x03\xb0\xf0\x77\xb4\x1a\x98\x81\x54\xaf\x55\x8e\x94\x05\x9a\x29\xb5\x40\x91\xc7\x38\xfd\x89\xd5\x12\x57\x19\x6f\xaa\xe1\x86\x41\xf7\xaa\x33\x15\x2c\x04\x30\x66\x6e\xc2\x9f\x90\x3d\x41\x14\x9c\x90\x7a\x76\x11\x35\x11\x2a\xdd\xdf\xca\x71\x35\xb5\x75\xc2\x7d\xe8\xf1\x4b\xca\x39\xe3\xf6\xeb\x01\x88\x3b\xc6\xd1\xf3\xaf\x0f\xf4\xbd\x66\x75\xc5\x97\xc9\x9b\x1d\xc9\x49\x5d\x0a\x98\x1d\x9e\x59\x60\x87\xa5\x24\xbc\x76\xf7\xa4\x12\x9d\xab\x54\x8e\x76\x53\xc9\x90\x01\x32\x1f\xce\x0e\x9c\x39\x5f\x82\xe7\xfa\x18\x6c\x45\xba\x0a\x16\xf2\x79\x5d\x92\xf7\xdf\xae\x3a\xd4\xa0\xab\x95\x67\x07\xb7\x9a\xbc\x27\x25\x8c\x66\x47\x82\x64\x68\x4d\x24\x92\x1b\x82\x8a\x86\x0b\xc6\x91\xda\x02\xf5\xb6\x89\xb0\xda\x02\x91\x32\xab\x02\xe8\x8f\xff\xde\x10\x7e\x83\xd8\xf2\x47\x52\xc8\xfc\xc0\xd9\x00\x1e\x2e\x50\x53\x97\x64\x45\xeb\xee\x4e\xf1\xc3\x7c\x55\x83\x12\xf8\x54\x21\xfe\xce\xe2\x1d\xb3\x8b\x98\x5b\xb6\xda\x40\xb8\x26\x32\x3d\x0e\xee\xe8\xec\x98\x40\xe7\xe8\xd8\xb7\x63\x98\xdc\x2c\x94\xd5\xaf\x24\xe6\x32\x01\xd2\x49\x15\x8a\x44\x5d\x55\x5d\x9a\x75\xa1\x7b\xc9\xe4\x7c\x8c\x0a\x59\xc9\x8a\x06\xf6\xe0\x10\x25\xa9\xe2\x71\x49\x40\xe9\x2b\xd5\x17\x5b\xb3\x6b\x34\xd7\xf6\xf2\xef\x95\xb1\x3d\x5e\xef\x85\xd1\xb2\xf7\xd6\x56\x4a\x98\x37\x31\xec\xbf\x57\xea\x0e\xe4\x15\x51\x5d\x04\xaf\x12\xe6\xb8\x90\x84\x27\x0b\x94\x91\x2a\x57\x1e\x36\x53\x71\x7e\x16\x61\x13\xd4\x77\x90\xa3\xac\xa3\x2b\x66\x68\x32\xf3\x71\xc7\x44\x6b\x41\x9c\xa7\x7a\xb6\x80\xc8\x76\xcd\xec\xb2\xd3\x89\xda\x6d\xd3\x4d\xc2\x12\x2e\x2b\xef\x04\x15\x94\xe5\x92\xc1\xd6\x09\x28\x5c\x56\x86\x60\x9e\xe5\xce\x66\xdb\x5d\xa1\xd4\x5f\xd5\xfd\xf3\x8c\x52\xa6\xbc\x5a\x7e\x0d\x74\x3e\xa8\xe4\x4d\x55\xf0\xf6\x28\x77\x83\x8a\xdd\x9e\x4d\x6c\xdf\x06\xe6\x6c\x5e\x7b\x36\xae\xd8\xa6\x35\xba\x61\x7d\x44\x75\x73\xb2\xaa\xf9\xf1\xd5\xcc\xfb\xa8\x98\x13\xd4\xcb\x09\xaa\xe5\xfd\xd5\xca\xfd\x2a\xe5\x80\x3a\x39\x5d\x95\xfc\xa5\xd4\xc8\xfb\xab\x90\x7d\xf5\x71\x7e\x76\xb0\x4f\x6d\x1c\x55\x19\x1d\x26\x7d\x98\xe2\xf5\x8b\x2a\x5d\xfb\x15\xae\x7b\x28\x5b\x1f\xa6\x68\x79\x4a\xd6\x85\xef\x4d\xf7\x04\xaa\xef\x77\x95\x4f\x5b\x71\x1d\xdf\x11\x86\xc5\xb4\x5f\x32\xb0\x1b\xf4\x65\x76\x6c\x2f\x18\xdb\x76\x86\x76\x83\xc8\x3e\xd3\xf5\x25\x87\xcb\x5e\x2d\x3d\x9e\x78\x77\x50\x57\xb4\x26\xdf\x28\x63\xb5\xd0\x22\xde\xb3\xf3\xf5\xf6\x3b\x56\xa7\x33\xed\xa7\x9f\xb9\x66\xb3\x62\xbb\x40\xfa\xb3\x67\x3c\x6b\xc5\x87\xba\x62\xbe\x05\x85\xea\x3f\x60\xe3\x4f\xe7\x61\xb0\xc3\xe0\xf0\xd8\xdc\xf8\x8c\xb7\x82\x17\xd6\xe7\x8f\x22\xfb\x2b\xc4\x81\x64\x10\x81\x90\xe9\xcc\x5e\x99\x4e\x4f\xb4\x2f\x92\x20\x16\x45\x80\x12\x6d\xd0\xc9\x24\xcb\x20\x47\x85\x8e\x86\x48\x62\x61\x6e\x4e\xb3\x1a\xca\xe6\x12\x8a\x46\xb4\x0f\x56\x68\xa3\xbb\x74\x44\x0c\x6c\x12\x94\xc7\x62\xb2\x06\x12\x68\x8f\x10\x12\x0b\x3e\xb8\x0f\x21\x61\x68\xce\xad\x3f\x64\xad\x01\x0b\x32\x9b\x29\x4c\xa0\x39\xcf\x1e\xa8\x10\x40\xf8\x6d\xc3\x25\x3d\xa0\x5e\x34\x34\xe9\x85\xd1\x0a\xd2\xe6\x79\x4d\x93\x1c\x32\x6b\x96\x05\x6f\xb6\x4b\x04\x5e\x82\xdc\x94\xf6\xae\xde\x97\xf4\x8a\x96\x84\xf7\x6b\x95\xf4\x2a\x37\x85\x61\x18\xae\xa2\x06\x56\x0c\x4c\x81\xdf\x8f\x85\x9b\xf4\x4e\x11\x7a\xda\x44\x0d\xaa\xaa\x9e\x21\x73\x8f\xed\xd5\x52\xaf\xd4\x61\x7d\x43\xc3\x54\xc3\xa2\xcb\x24\x89\xad\x1f\x64\xf0\xcd\x98\x6e\x36\x6b\x4c\x87\xde\xb7\xf9\x78\xbd\xb7\xc7\x17\x39\xa8\xc5\x56\xc5\xd7\xba\xbd\xab\xa1\x2f\x90\xfb\x6b\x08\x5b\x40\xfb\x70\xe4\x3e\xd2\xcf\x7d\xa8\x21\xd9\x0f\x7d\x3b\x98\x42\x7d\x70\x28\x4f\xfe\xe5\x24\x66\x85\xdf\x61\x2e\x9d\x7b\x13\x9a\x37\xf6\x0d\xa7\xa3\xd0\xd5\xb3\x62\x1c\xa5\x50\x8d\xaa\x03\x1f\xa2\xe8\x91\xc6\x60\xcf\x3b\xe8\xf0\x90\x0e\xb9\x50\xed\x80\xaa\x0a\x6f\xe9\xc5\x80\x9b\x2a\x40\x89\xb2\x7e\x18\xb8\x0b\xef\x8d\xc2\x88\x96\x14\xba\xd4\xcc\xc8\xcc\x1e\x3f\xc2\x3a\x77\xec\xec\x81\xd6\x8f\xec\x6c\x51\x49\x5a\x1f\x1d\x59\xb7\x2d\xad\x05\xe1\xf2\x4b\xb2\x62\x9c\xd8\x25\x30\x3f\x1b\x6d\xce\x51\xc1\xcc\xc8\xce\x1e\xa3\x23\x64\x14\xaf\x3b\xa1\xbc\x9d\xe0\x71\x1b\xb9\x93\xd6\x5b\x0d\xd3\x5c\x78\x63\x6b\xe1\x78\xe1\x39\xe0\x86\xe6\xa1\x3b\xc7\xde\x5e\xf8\xa1\xbd\x83\x62\x2c\x12\xde\x6f\xce\x64\xfd\xc3\x3e\x69\x2f\xfc\x5b\x90\xbe\x4b\xc8\x94\x38\xa2\x63\x78\xbe\xe8\xb9\xb7\x6b\xc4\x26\x52\x2d\x7e\x3b\x6a\x88\xf3\x11\x54\x7b\xae\x57\x85\x59\xf9\x7d\x89\x15\xda\x4e\x3a\xf4\x43\x92\x2d\x78\x52\x41\x72\x42\xde\x41\x74\xb1\x5d\x85\xf9\x8f\x8c\xd6\xc1\x3a\x07\x67\x64\x0b\x68\x02\xda\xda\xdf\x01\x9c\x7d\x00\x0e\xee\x23\xcf\x83\x03\xd1\x62\x10\x82\x33\x26\xb3\xf6\xdc\x84\x0e\x3b\xba\x8c\xc3\x0a\xb2\x0f\x1e\x2f\x9c\xcf\xae\x11\x6d\x76\x34\x83\x3a\x27\x9d\xbe\xd4\x05\x9f\xab\x8d\x34\xe9\xed\xc6\x71\x77\x92\x3e\xcf\xec\x71\x28\x7d\x12\x7a\x94\xe6\x3d\xff\x6a\xdb\x96\x3d\x67\xc1\xbe\xfe\xf5\x7b\x2d\x56\xfd\xd3\x62\x7b\x14\xd3\x97\x02\xc8\x7b\x29\x66\x56\xda\xce\x16\x33\x3f\x38\x91\xfc\x99\xe3\xdd\xd7\xef\x25\xa9\x85\x0d\xc9\x0a\xb0\x01\x50\x76\xcd\xf1\x2e\x23\x2d\x58\x80\x2f\xa2\x6d\x80\xbe\xaa\x18\x35\x1b\xbc\xbf\x60\xc4\x35\x3a\x0f\xaa\xea\x2b\xc2\x68\xbb\x50\x07\xf4\x05\x12\x3b\x52\x2c\x50\xdb\xb8\xfa\x13\xac\x16\x5f\xb1\xce\xa0\xf0\x82\xd6\x97\xda\x79\xff\x46\xbd\x15\xb4\xa3\x4f\xb1\xd5\xf5\xe1\x5f\x5b\x1b\x9d\x3b\xd5\x83\x57\x3f\xd4\x25\x06\x74\x8e\x8e\xf2\xc3\x1f\xf2\xf4\xed\x7f\xe7\x17\x87\xf3\x87\x47\x39\x79\x4f\x0a\x10\x68\xbd\xb5\xe1\x22\xdd\xbe\x3d\xb9\xf0\x53\x59\x9e\x85\xb0\x4e\xa3\xf9\x0c\x1d\x76\xd5\x07\x97\x12\xad\x57\x2c\x38\x5a\xd0\xba\x84\xa7\x12\xbf\xbc\x69\xc7\x2c\x6d\xf1\x38\x4d\x3a\x6c\xd1\x73\x12\x77\x8f\x84\x99\xb2\x8b\x50\x29\x83\xd6\xc2\x2e\x6e\xd5\xc6\xae\x08\x81\x5c\x66\x41\xf6\x1a\x18\x99\xb6\x94\x6e\x83\x52\x33\x08\xc0\x1c\xaf\x66\x27\x89\x22\x22\xad\xab\xd4\x76\x2b\xc6\x1d\xa0\xd7\xe9\xa3\xdd\xc6\x3f\xfd\xb4\x45\xf0\xe9\xa7\xfd\xe7\x25\x23\xdf\x9c\x9a\xbd\x22\x6a\x84\x81\x41\x39\x57\x36\xf7\x90\x43\x66\xce\xa1\x73\x5b\x5f\xd1\x33\x98\x1c\x39\x0e\xd4\x4e\xdd\x36\x9e\xe7\x28\xcd\x7f\x33\xff\xe1\x28\xff\xcd\x11\x5d\xa0\xe4\xe1\xc9\x11\x68\x0d\xc0\xc7\xf9\x08\xc2\x5e\xfa\xc8\x31\x4e\xbb\x15\x7b\xc9\x20\xbd\xcb\x9a\xcf\x57\xca\xcb\x80\xf4\xe5\x18\x81\x30\x7a\x69\x24\x4c\x37\x48\x62\x81\xae\x09\xba\xa6\x55\x85\xc0\xf2\x89\xe4\x06\x4b\x64\x84\x9f\xbe\x2c\xd0\xbe\x7a\xd6\x2e\xb5\x40\x92\xb5\xfc\xee\xd6\x4a\x9c\xe5\x50\x79\xc0\x9c\x3c\x3d\xce\x22\xde\xd5\xaf\x81\x4f\xd7\x04\x61\x4e\xd0\x9a\x81\xc3\x44\x32\xd4\x08\xe2\xac\x41\xaf\x0f\x9f\xf4\x2c\x01\x9f\x7e\x8a\x3e\x19\x32\x6d\xec\x8f\xdf\xf4\x19\x04\xc3\x1d\x54\x09\xdb\x03\xa5\x49\x67\x11\x4e\xb5\x89\x51\xcb\xcb\x60\x92\x38\x12\x04\xec\xd3\x60\xfd\x82\x29\xde\xb3\x7d\x68\x91\x3b\x1f\xa4\xa8\xbf\x61\x4c\x1e\xb4\x31\xc2\x2d\xda\x1d\xad\xd7\xb3\xfe\x41\x7c\x6c\x16\xdf\x01\x6d\xff\x58\xed\x8e\xbc\x75\x86\xad\xec\xa1\xfa\x9a\xca\x0d\x6b\x24\xc2\xf5\x0d\x5a\xb1\xaa\x24\xfc\x20\x7a\x72\xec\xef\x5d\xbe\x58\x75\xcf\x0e\xfd\xf8\x29\x34\x1a\xc6\x6b\x1b\xd1\x38\x62\x07\x26\x0f\xe2\xad\xd7\x56\x86\x4e\x2e\xce\xa2\xd1\xb8\x1e\xc9\x5a\x0a\x91\x02\x62\xb2\x32\x27\x28\xdf\x24\x88\x77\x36\x6f\xc5\x60\x75\xc5\x7d\x1d\x5b\x92\x6e\x79\xae\x9d\x0b\xfa\x8e\x89\xea\x75\xa2\x94\xb3\xd8\x2b\x24\x23\x23\xe8\xbb\x28\x66\xd1\x7b\x73\x7b\x70\x24\xe4\xbd\xe4\xf8\xdf\xc8\x8d\x48\x16\xe8\xe7\xc9\xe9\x1b\xee\x44\x55\xec\x2e\x95\x99\x56\xd6\xac\x83\x96\xa4\xc0\xbe\x20\x41\x25\x23\xa2\x4e\x24\x12\x84\x6c\x85\x15\x34\x62\x87\x0b\x22\x50\xc1\xb8\x79\x0b\x10\x8e\xc3\x3f\x87\x6d\x1a\xe7\xcb\xed\x69\xac\xcd\x0c\xa9\x9b\x10\xa7\x47\x47\x6b\x2a\x37\xcd\x12\x22\x42\x8f\xa0\x43\x5b\xd5\xee\x51\x47\xc2\x91\x0a\xea\x15\x47\xff\xf2\xfb\xdf\x8f\x23\xea\x6a\xe7\x35\x91\x47\x25\x2b\x8e\xb6\xb8\x6e\xb0\x7e\x2c\xf4\xc1\x25\xb9\xd9\xe2\x9d\xb8\xff\xb8\x44\x0f\x94\xaf\xf1\xd2\xcb\xa9\xb9\x1d\x3b\x7a\x2b\x67\xbd\xe6\xdd\x39\x7a\xc2\x39\xbe\xd1\x97\x95\x9f\xd7\xd2\x58\x40\xfd\xe1\x7b\x53\x53\x39\x9b\x6b\x25\x5f\x9f\x50\x66\x68\x36\x72\xd6\x2e\xb6\x76\x6f\x6e\xcf\xa8\xa9\x6e\x6f\xfa\x69\x7a\x34\x71\xc2\xfe\x19\xa7\x48\x5e\xa0\xe8\x3a\xa3\x3f\x11\x08\x46\xfc\xed\xfc\x6c\x32\x52\xe3\xe0\x0b\x31\x4a\xbc\x7c\xa7\xee\x61\x87\xf8\x6e\xc7\xce\x42\xa1\xe9\xf8\x5b\xbe\xc6\x35\xfd\x09\x1b\x93\x9d\xff\xd4\x06\x73\x0a\xef\xf4\xd8\xc6\x40\xca\x7c\x17\xdf\xdd\x92\xe6\x33\xbe\xbe\x7f\xce\x7c\xc6\xd7\xbf\x70\xca\x7c\xdb\xc2\xaf\x31\x63\x7e\x38\xe2\x4f\xca\x2d\xed\x0f\x35\x86\xaf\x77\x1d\xe3\x6f\xc8\x35\xc8\x42\xde\xc7\xa4\x52\x16\x43\xd1\xe0\xfb\x1c\x1d\x28\xcc\xea\x3e\x6c\x38\x03\x2a\xb6\xa6\xf5\x3b\x88\xd4\xb9\xd7\x4d\x48\xdf\x98\x70\xa2\x7d\x1a\xc7\x43\xd7\x20\x4d\x6b\x66\x48\xb5\xe9\xf5\x89\x0a\xb6\x6d\xcd\xff\xb1\xa8\x7d\x95\x50\xb6\xce\xd4\x05\xb5\x01\x3f\x83\x85\xb3\x30\x43\x39\xc3\x80\x08\xe0\x89\xa5\xa1\x8d\x62\x89\x5b\xd3\xfd\x1b\x7b\x58\x88\x6b\xc6\x4b\xfd\xe6\xdb\xcc\x92\x3c\x1b\x4b\x32\xd3\xd5\x31\x51\xc5\x8e\x9f\x63\xb4\xd3\xb7\x07\x13\xa7\x6d\xc8\xd5\xbb\x35\x13\xf2\x76\x84\x6f\xf9\x04\xfe\xfb\xa4\x0c\x33\x37\x60\xcd\x94\x99\x30\xf8\x10\x42\x3b\x5b\x21\x19\x75\xd1\x70\x2a\x6f\xec\x45\x56\x9d\x66\x27\xe2\x85\x4b\x1e\x08\x03\xfa\xae\xbb\xb5\xaa\x67\x74\xcc\x65\x02\xbd\xdf\x60\x91\xc9\x4a\x44\x78\x34\xe8\x29\xeb\xaa\xf4\x0f\x98\xfd\x15\x8f\x1b\xb9\x21\xb5\xa4\x3a\xf1\xc4\xc0\xda\xf7\x81\x46\x57\x36\x80\x4e\x5e\xd8\x6a\x7c\x4b\xbc\x1b\x71\xe3\x95\x7b\xca\xc5\x56\x8e\x15\xef\xf0\x76\xa4\x54\x6b\x6a\x23\x00\x7d\x5e\xf6\x76\x27\xe8\xf0\xeb\x9b\x1d\x09\x3d\x2d\x67\xd1\x97\x01\x2d\xf4\xf0\xcb\x80\x9f\x25\xa7\x56\x13\x7c\xf1\xd5\x93\xef\x86\xd6\xb8\xe5\xdb\x58\x9a\xc2\xd1\x67\xfa\x3e\xef\xda\x79\xf5\xf2\xf5\x70\x3b\x86\xc1\x63\xed\x8c\x4f\xd4\xc9\x14\xfd\xb6\xa3\xe8\xbb\x27\x2f\x07\x5b\xd2\x43\x7a\xef\x56\xbe\x98\xc8\xdf\xf2\x43\x19\xfc\xbb\xe4\x14\x1d\x1d\xfd\xeb\xf3\xd7\x7f\x7c\xf3\xe5\x60\x2b\xed\x0c\x1c\x6b\x66\xa0\xa1\xdb\xfe\x13\x78\xdd\x64\x3c\x87\x99\x04\xca\xa4\xf7\xe9\x8b\xe8\x1e\x39\x2c\xc2\x26\x26\xc4\x8f\x89\x35\xb3\xf8\x87\x70\xcf\x9d\x7c\x0b\x36\x79\xf4\x5e\x59\xa4\x94\x8b\x89\xc2\xa8\xb7\x36\x7d\xd9\x14\xb1\x19\xdc\x9d\x79\xf7\xed\xfb\x88\x48\x66\x92\x16\x24\x26\x86\x55\x41\x32\x0f\xbb\xf8\xb0\x24\x12\xd3\xea\x25\x3c\x79\x61\xba\xa9\xbf\x64\xe6\x4d\x0e\x3f\x05\xdc\x13\xf5\x7a\x37\xd2\xb9\x93\x15\x1c\x52\x70\xc2\x53\xe5\xa0\x38\xd3\xc5\x53\x5e\x38\x70\x48\x68\x53\xc7\x9a\xfc\x88\xbb\x24\x96\xf0\x20\x31\xc5\xfd\x44\x66\x2e\xaa\x7b\x3d\xe3\xe1\x76\x56\x9f\x19\x91\x7e\x33\x37\x48\x78\xa7\x32\xbc\x2c\xd9\x7b\x62\x93\x15\x99\x57\x30\xf5\xc5\x3d\x95\xef\xd9\x40\x84\x69\x8b\x0c\xa0\x7d\x89\x77\x2f\x7b\xac\xe8\xf7\x39\x60\xab\x0f\x6f\x04\xf6\xa1\xc5\xaa\x1a\x7a\x45\xb5\xeb\x43\x4b\xac\xc9\x68\x73\xaf\x87\x5b\x4b\x72\xcf\x26\x9b\xfa\xfe\x8d\xd2\xfa\x8a\x70\x41\xee\xd4\x9e\xbe\x42\x7a\x87\xe6\x86\x25\x97\xc9\x48\xd9\xc5\x9f\x7f\x84\x9c\x94\xaa\xb0\xbb\x5e\x3b\xab\x74\xd8\x23\xb2\x79\x80\x66\x91\x30\x74\x5a\x86\x6e\xf4\xb0\xfb\x13\x1e\x52\x75\x0f\x0f\x1d\xb7\xa8\x40\xa3\xaf\xe0\xab\x9a\x65\xe0\x6e\x1e\x7c\xe3\x08\x45\x1e\xdc\x89\xa7\xd4\x72\xb3\x71\xaa\xd4\x25\xf7\xc9\xa4\x45\x4b\x30\xbb\xd1\x52\xec\x49\x81\x75\x97\xa7\x34\x1d\xc2\x38\x29\x29\x57\xaf\xb0\x4e\xc9\xc9\xe4\x1e\xbe\x75\x52\x13\xf1\x14\x26\xcb\xb7\xf5\xd7\xb5\x24\x7e\xc8\x5e\x43\xbb\x0c\xd4\x97\xe4\x66\xc7\x89\x10\x43\x71\x60\xfd\x88\x9e\xcf\x61\xf3\xf1\x3e\x7d\xfe\xd9\x1c\x44\x1a\x81\x86\xd0\x25\xb9\x41\xcc\x58\xde\xd0\x12\xf3\xe8\xa3\x60\x7a\x1e\x0f\x5c\xd2\x04\x75\xf6\xcf\x1b\x52\xbf\x60\x42\x3e\x53\x07\x26\xb8\xed\xbb\x40\xfa\x09\x85\xae\x23\xed\x55\x8c\xde\xb2\xe8\x79\x95\x75\x4a\x01\x74\x8e\x48\xae\xff\xf4\x77\xd7\x4f\x1e\xa6\xfa\xf3\x3c\xa7\xba\xb5\xb9\x72\xdb\x74\x9f\x75\xdb\x22\x55\x00\x86\x90\xfe\x8e\xab\x6a\x0e\x1d\x6d\xe6\x61\xb4\x2b\xa4\x7f\x7a\x23\x08\x17\x9e\x8d\xe4\x13\xbd\x71\x43\x61\x06\xe7\x72\x9d\xc3\xaf\x7d\xd9\x34\x12\x43\x32\x94\xd5\xa4\x6b\xe1\x4b\xf6\xde\x26\x4e\xf5\x11\x7b\x89\x58\x4c\x1b\x3a\xf8\xcd\xa9\xda\x3d\x1c\x61\x68\xd0\x75\x02\xa0\x31\x6b\xd9\xa8\x58\x82\xc2\x4b\x72\x03\x07\xdf\x76\x05\x78\x4f\xf3\xc4\xf4\x21\x53\xa1\xcb\x58\xf2\x59\x6f\x34\x0c\xb9\xd1\xf3\xd3\x68\x8e\x12\xfc\x23\x7e\x1f\x5c\xd2\x6d\x78\x75\x8a\x44\xb3\x6c\x78\x85\x0e\x51\x72\x84\x77\xf4\xe8\xea\xe4\x08\xd8\x28\x8e\x34\x1f\xfe\xf0\x57\xf5\x58\xb7\x21\x6c\xd1\x73\x09\x83\xaa\x76\x8a\x66\x3f\x0a\x56\x07\xc1\xd2\xa2\x29\x0a\x22\x84\x6b\xd9\xb6\x09\x4c\x86\x82\xda\x6a\x26\xbf\x86\x4b\xde\xde\x0d\x2a\x21\xf9\x90\x0c\xd5\xfd\x45\x42\x2a\x77\xa4\x90\xdc\x51\x4c\x23\x32\x34\x66\x9f\x68\xd9\xd9\x3e\x20\x1d\x95\xf1\x96\xf2\x9c\x5d\xea\xc4\x45\xe6\xa7\x93\x46\x61\x2c\xfc\x12\xb0\x3b\x39\x74\xc2\x7f\x26\x8b\x8a\x87\x75\x62\x3a\x15\xfb\x4f\xb5\x70\x18\xdc\x55\xd0\x09\x55\xf6\xbe\xc6\x3e\x6b\x33\x88\xe8\xb2\x77\x66\x3e\xcc\x1e\x7b\x41\x7e\x30\x2d\x54\xd8\xed\xe3\x16\xde\x7e\xd2\x11\x7e\x3a\xba\x6f\xd8\xb5\x00\x8c\xb4\x43\xac\x33\x68\xc0\xd5\x44\x65\x44\x9a\xef\xbb\xde\xdb\xf6\x0f\xa5\x6d\xeb\x6d\x6d\x68\x7e\x9e\xdc\xf5\x06\xae\xcf\xb6\xb1\x0b\x1c\x43\xa9\xd5\xfd\xd9\x03\xff\x19\x02\x74\x96\xbf\x15\x38\x68\xea\xf3\xef\x43\x88\x62\x2f\x1c\x9a\x26\xec\xb8\x0c\x45\xd9\x4d\x96\x28\x93\x39\x30\x74\x98\x1e\xcd\xee\xbf\xb7\xe9\xdb\x7d\x96\xc1\xb8\xd0\xf6\xf9\xa3\xad\x93\x71\xfe\x46\xa5\x7d\x80\xbc\xbf\x71\x8f\xec\x64\x0b\xd4\x2b\x34\x6e\xa3\xee\x0a\x97\x4a\x24\xa8\xf2\x4d\xb0\xda\xc4\x73\xd2\x1a\xc9\x6b\xd6\x2e\x79\x11\x6e\xa8\xed\x7b\xee\x94\x0c\xef\xab\x2a\xeb\xc9\x07\xee\xab\xd0\x50\x6f\x5f\xb5\x88\xc7\xf7\x55\x53\x75\x7c\x5f\xb5\x40\xff\x1b\xf7\x55\x60\x63\x7c\x5f\x45\x87\x68\xf6\x69\x43\xcb\x73\x88\x97\x0b\x38\x65\xa2\xa7\xe0\x60\xf0\xff\xb6\xdf\x7f\xc8\xed\xd7\xfe\xb6\x29\xca\xcc\xff\xd5\xb2\x9a\x3d\x7e\x74\x44\x1f\x23\x6f\xa3\x85\x7d\x2d\xd8\x68\xbd\xad\xce\xec\xb4\xff\x77\x6d\x59\xb6\xd3\xed\x96\x65\x23\x6f\x8f\x66\xf3\xb7\x27\x17\xff\x3b\x36\x30\x5f\x84\xde\x61\x03\xf3\xc5\xea\xf4\x0d\xac\xbf\x65\x2c\x50\xaf\x30\x16\xf7\x00\x87\xe4\xff\xa0\xe4\xba\xe7\x08\x87\x48\x8c\x4c\x19\x3b\x55\x02\xf4\xba\xd9\x8e\x45\x26\x74\x87\x5d\x08\x95\x51\xd3\x0a\x28\xe8\xf2\xd9\xab\xcb\x0c\xe3\xb9\xe1\xcd\xee\xa5\x0c\x8f\x23\x29\xb0\xba\x74\x98\x0a\xb4\x7b\xba\x70\x28\x65\xbb\x1e\x04\x56\xb5\xfd\x40\x8f\x51\x45\x43\xbb\x85\xc6\xa6\xaf\x71\xa8\x26\x16\xba\xa5\x7c\xa5\xd2\x21\xa7\xc9\x5b\x48\x46\x95\x68\xb9\xae\x1a\xee\x12\xe6\xc3\x62\xbe\x00\x2b\x4d\x0a\xef\xd5\xd0\x95\xfc\x37\x72\x83\xfe\x10\xd4\xef\xb2\xdb\xab\xc4\xde\xe8\x54\x5d\xef\x0c\x35\xba\xd2\x84\xeb\x8c\x3c\x75\x7e\x9f\xbc\xfb\xd3\xb2\xe5\xa7\x2f\x7e\x28\x0f\xe7\x3f\x64\xfa\xff\xbd\xa4\xf9\x1e\xf7\xbd\x29\xb2\x8f\xb9\xaa\xe6\x8a\x72\x21\xa7\xa6\xe1\x57\xc0\xe8\x5c\xb7\xd7\xf1\x50\x45\x15\xc7\xc5\x48\x6c\x00\x15\x96\x45\x1c\xc9\x67\x17\xd1\x14\x8b\xe9\x0c\x84\xe9\x02\x81\xb1\x04\xae\x0d\x14\x9c\x55\xd5\x6b\xb6\x4b\x35\xb2\x9c\xad\x56\x82\x48\x15\xd5\xb2\x43\x19\xfa\xec\xf8\x78\xf0\x01\xd5\xb1\xe8\xa9\xa9\x83\x71\x87\x97\x0b\x3e\x22\xcb\x7e\x11\xc6\x4c\x79\x1a\x01\x45\x43\x75\x40\xad\x7f\x65\xc2\xa4\x5a\x41\x55\xb0\x5a\xb0\x8a\xe4\x15\x5b\xa7\x49\x08\x95\xcc\xcf\x46\x23\xb0\x40\xa7\xef\x22\xaf\x76\x9c\x41\x18\xed\xa8\x77\xde\x39\x8a\xdd\x3d\xf2\xea\x97\x8d\xba\xfa\x47\x89\xb8\x6a\x0f\x3f\x37\x4f\x59\x55\xe1\x25\xe3\x7e\xb8\x5d\x6f\x44\x07\x2a\xb8\x83\xab\xdd\x82\xa8\x68\xcb\x19\x47\x58\x69\xcc\x5d\xba\x0c\xe5\x0a\x54\xdf\xc0\xb3\x47\xd4\xfb\xa1\x93\x54\x20\x93\xce\x50\x3f\x4a\x1a\xbc\x9d\x7b\x16\xa6\xb9\x56\x80\x63\xd9\x37\xc6\x92\x5d\x37\x54\xbd\x60\xe1\xa2\xe8\x1f\x14\x6c\x4e\x0e\x7f\xf4\xf5\x1b\x14\xf3\x5e\x46\xa8\x58\x6e\x41\xb2\xdc\x30\x76\xd9\x5b\x4a\xc0\x20\x72\x45\x6a\xd9\xb9\x8a\xa6\x3d\x2a\xe2\xce\xda\xe0\xf5\x87\x48\xbc\x8a\x69\x42\x25\x69\x8a\xc6\xe5\xf4\x52\xb8\x43\xa4\xd3\xdf\x81\xb0\x61\x13\xb9\x9d\x76\x7f\xb4\x6f\xfb\xa1\x1d\xbe\x51\x17\x48\x58\x8d\xb4\x00\x56\x13\xaa\xed\x01\xf0\x3b\xdf\x50\xa1\x2e\xd7\xa9\xbd\xd3\xa4\x71\x9d\xf6\xca\xe6\xc3\xc0\xad\xa4\x0d\xfe\x5a\xe3\x40\x79\xcd\xda\x37\x06\x27\xa6\x17\xdd\xef\x7c\xf3\x92\xd1\x7b\x0d\x9c\x4d\x4a\x79\x13\x7d\xfb\x50\xa1\x86\xd4\x28\xe8\x76\x81\xbe\x38\xf6\x93\xd4\x1a\xe6\xb6\xbc\x7d\xad\xb7\x06\x54\x92\x8a\x5e\x11\x7e\xd3\x7e\x27\x42\xb6\x1f\x11\x15\x08\xb2\xf1\x6c\x38\xab\x59\x23\x16\x88\x13\x35\x0c\x92\x21\x98\x56\x88\x4a\x81\xb4\x2a\x8c\x58\x5d\x10\xa4\x1e\xd8\x46\xe0\xfa\xca\xdb\xb1\xb1\xb8\xa6\x0d\xc5\x28\xff\x02\xc7\x65\x12\x3a\x2e\x93\x88\xbc\xd8\xeb\xe5\x8b\x3f\x9e\x37\xe5\x21\x95\x7b\xfa\xef\x6e\x23\xaf\x28\x70\xf2\x0f\xcb\x26\xe5\xe8\xbc\x5f\x9e\xa6\x0f\x71\x80\xaa\x19\x7e\xdc\x09\x64\xdf\x8f\xe8\x9e\x95\xe0\xaa\xf7\x4d\x84\x12\xe8\x88\xd6\x1c\xb6\x44\x62\xfd\x06\x8a\xea\xe4\x85\x8d\x17\x6d\xdf\xaa\x35\xc8\x8d\x91\x2a\xac\xa3\x3f\x47\x6b\xd9\x55\xf5\x8a\x48\x9b\x50\x4f\x32\x24\x37\x04\x91\xba\x44\x6c\xa5\x12\x83\xa9\x13\xab\x96\xb8\x48\x47\x0d\xdb\x79\xa1\xbe\xbd\x6d\x61\x2e\xa6\x3d\xaf\xe1\xda\x0b\xdc\x6b\xd9\xce\xea\x57\x4f\x88\x92\xf7\xb8\x90\x2a\xd3\x74\x3b\x0f\xe1\x47\x26\x68\x5d\x90\x69\x4d\x75\x93\x6c\xc7\xe0\x42\x15\x6a\x76\x96\x0d\x3a\x59\xb2\xe1\x85\xf3\xce\x87\x2e\x54\x49\xb2\x93\xb9\x07\x7b\x85\x39\xd5\xaa\xc7\xc2\xc4\x5d\x48\x52\x22\x49\xeb\x9b\xc4\xaf\xb6\x40\x49\x12\x79\x9f\xe7\x15\xd9\x62\x88\xbb\x42\x6f\x9e\x83\x5a\xd2\xb4\x89\xfe\x8d\xbf\xbb\x7b\x4c\xa7\xfd\x33\xfd\x39\xc8\xad\x66\xe3\x2c\xdc\x0c\x69\xee\x42\xfd\xb1\xd9\xee\xf6\x20\xc2\x06\x81\x09\x90\x49\x3a\xf5\x82\xd5\xc0\xf7\x53\x34\x1e\x70\xaa\x39\x99\x2b\x4e\xee\xd8\xae\xd9\xf5\x9f\x58\xe8\xef\xe1\xa2\xa2\x25\xc9\x9b\x7d\xb4\x49\x8e\x6b\x41\x2d\x7d\x50\x07\x46\xac\x87\xad\xd9\x5d\x63\x5e\xee\xc1\xa5\x17\xa6\x46\xa5\x2b\x44\x10\x51\xd0\x09\x19\x2f\x75\xe8\x4a\xfb\x77\xea\xc3\x74\xa1\x4c\xed\x9f\x01\x84\xf3\x28\x98\xfd\xd3\x21\x05\x36\xa3\x27\x70\xf0\xa7\xf2\x66\x68\xe4\xfa\x6c\x75\x0a\x25\xdb\x19\x85\xb5\x0f\xf6\xf3\x1d\xc6\xcf\x9e\x7c\x3a\x7c\xf0\xdf\xbc\x63\x3b\xe8\x3d\x58\x98\x35\x73\x45\xc1\x9a\x42\x92\x91\x0b\xaa\x61\x08\x19\x8a\x24\xbb\x70\x7b\xe9\xbe\xde\xdf\x6a\xdf\x12\x2f\x53\x1f\x46\xa5\x51\x8f\xc1\x38\x1c\xf9\x70\xfd\x69\xae\xa7\xe5\x6b\x85\x2a\x3d\xf1\xf2\xd6\xb7\x6f\x7c\x72\xb6\xfb\x89\xd5\xa4\x73\x95\x94\xe6\x8b\x8d\x16\x34\x3f\xbd\x5c\xdb\xf6\xe3\x48\x6c\xa5\xbd\xb2\xf9\x15\x55\x96\xae\x9f\x6f\x9d\x4d\xaf\xad\x6e\xff\x88\xb9\x24\x1c\x28\xf3\x10\x3a\x6c\xa4\x26\x49\xbd\x07\x0e\xf9\xd9\x54\xc6\xae\x9f\xd1\xec\x2f\xd9\x53\xc1\x57\xd9\x6b\x76\x49\x6a\xb3\x5b\x86\x79\xf6\xb6\xf8\x3d\xdc\xb3\x14\xfd\x26\xb6\xf8\x7d\xa6\xcf\xc8\xf1\x1a\x2a\xf3\x62\xac\x12\x94\x84\x95\xe0\x30\xb6\x93\xa4\x34\x6d\xa5\x61\x3d\x5d\x2e\x12\xf3\x6e\xd7\x6f\x8e\x7e\x93\xcc\xd1\x1f\x94\xbd\x0c\x9d\xa2\x41\xe8\xa0\x91\xb2\xfc\x5e\xe9\xb2\x70\x63\x5c\xc4\xf2\x8d\x97\xb4\x90\x5f\x91\x15\x6e\x2a\xf9\x52\xe7\x74\xef\x23\x2f\x75\x79\xd6\xa6\xaf\xef\xa3\x78\x5e\x5f\xe1\x8a\xaa\xce\x68\x37\x4e\x88\x83\x6a\x80\x4c\xed\x99\x99\x8e\xa2\xed\xa3\x51\xf5\x19\xfb\x92\xae\xfb\x18\x54\x62\x0c\xc9\x18\x64\xde\x8b\xd5\xd5\x1d\x05\x0c\xfd\xba\x5a\xa1\x8f\x0e\x1e\x9c\x09\xc7\x64\x06\xfc\x53\x1a\x0f\x5c\xbf\x33\x8e\x28\x2f\x3f\x06\x20\xd5\xb9\x00\x86\x3c\x07\xee\x5c\x7f\x0b\x3f\x72\xf8\x75\x61\x5e\x30\xc8\x9b\x86\x96\x67\x83\xfe\x9e\xee\xa9\xca\xe4\x91\xfe\x1b\x5c\x6b\x36\x5f\xa3\xaa\xac\xe2\x1b\x90\xd2\x76\x66\x80\x5e\xcc\x10\x30\xf8\x7c\xb6\xa1\x65\x49\x6a\xf5\x3c\x00\x68\x1a\x6d\x85\x91\x40\x7c\x55\xbf\x4b\xf7\xaf\x5a\x9c\xf0\x9e\xb4\xc7\x25\xcd\xed\x12\x50\xf5\x38\x35\x96\xb1\xa9\x65\x0d\xa2\xb5\xc7\xb4\x3d\xe9\x9b\xd4\x4b\x32\x03\x4c\xde\x97\x5a\xeb\x76\x90\x9a\x81\x39\xa4\x04\x0c\x38\xee\xc2\x72\x90\x25\xc9\x68\xec\x87\xd5\xe6\x47\x10\x8f\x25\xc4\xb7\x53\xe9\x74\xa8\xaf\x8b\xd1\xaa\x4a\x79\x3e\x1d\x20\xfb\x4e\xef\xe9\xa3\x81\x9b\xb2\xd3\xae\x3e\x41\xb8\xbe\x4e\x20\x7a\xe0\x26\x13\x55\xf9\x17\xe0\x32\xab\x23\xec\xe9\x76\xfd\xae\xa4\xdc\xf3\x3f\xd3\xed\xfa\x48\xd5\x71\x34\x37\xaa\x5e\x96\x7a\x47\xb6\x4c\x39\x23\x63\xc9\x19\x95\xeb\x14\x0b\xd5\xb2\x9b\xab\x79\x4d\xe4\xd7\x3a\x05\x93\xf8\xf2\x46\x6d\xfc\xdf\xe0\x2d\x51\x26\xd9\x4c\x37\x63\x10\xc4\x32\x9c\x59\x84\x6d\x92\x33\x7a\x78\xe8\x4e\x00\x37\x51\xaa\x85\x7d\x4b\x2f\x7a\xfc\x78\x5a\xd1\xdd\x92\x61\x5e\xa2\x3f\xbd\x6a\xa9\x2d\xda\x8f\x3a\x71\x71\x0b\xf4\xa7\x57\xea\x61\x3f\xf3\xcb\xd2\xd7\x7e\x50\x3e\x11\x23\xa8\x86\x1d\x22\x24\x57\x0f\xdc\x74\xf7\xa2\x7d\xff\x8a\x5e\x53\xc4\x9a\xa8\x81\x4d\x70\xd3\x8d\x2e\x1b\x49\x4c\x60\xaf\xd5\x7e\x4b\x22\x24\x67\x37\xae\x02\xdc\xd5\x13\x5e\xbd\xe0\xcc\x31\x84\xde\x3c\x48\xa7\x7b\x30\x9f\xdf\x8f\xac\x30\xee\xff\x63\xd0\xc4\x38\x5d\xd3\x5a\xbd\xa0\xee\x6b\x4a\x3e\xf3\x09\xdc\xdd\x1e\x66\xfd\xaf\x81\xbb\x9a\xc4\x7f\x08\xde\xc2\xb5\x93\x46\x32\xd0\xa1\x5c\xf7\xc5\x03\xf7\x29\x51\x6c\x00\x06\xbd\x17\x16\x20\xac\xe9\x05\xa8\x43\xb7\x8c\x12\xf4\x12\xbf\xd7\xf9\xd3\xd3\x2f\x4e\x3e\x5b\xa0\xc4\xad\x62\x7e\xea\xf2\xa4\xb7\x98\x9f\xfc\xe9\xc9\x5f\x74\x3e\x1d\x13\xe4\xdd\xd9\xdf\x7f\xc4\xef\x33\x28\xc9\x3e\xbe\x15\xc9\xb5\x1e\x4d\x08\xe8\x71\x8d\x38\xa3\x2a\x73\x4f\xcc\x27\x7f\xc9\xa0\x87\xc9\x29\x9a\x81\x90\x9d\x0d\xbb\xb7\x22\xcf\x4c\x2f\x90\x90\x58\x6a\x53\xa5\x4a\xf4\xdd\x3f\x61\x03\x58\x98\x01\xd1\xf4\x3d\x7c\x50\xf0\xcd\xae\xc4\x92\x28\xe1\x08\xef\x77\x32\xf5\x9c\x27\xbb\x22\x48\x90\x6a\x85\xe8\x0a\xd5\x0c\x6d\x19\x27\x68\x45\x48\x70\x05\x00\x78\xab\x8d\x45\x86\x10\x98\x92\xdf\x9b\xa0\x99\x3f\xaa\xde\xa7\xa6\xa7\xd9\x9b\xef\x5f\xc4\x3c\x55\xf0\xae\x52\xcc\x41\x14\x70\x76\x01\xed\xcc\xcf\x06\xe0\x3c\x4b\x73\xcc\x02\x88\x46\x9d\x51\x0e\x8e\x09\xce\x28\xd7\x76\x4f\xaa\x1d\xe1\xc2\x35\x03\x13\x49\x3e\xc6\xbc\x6c\x91\xe5\xe6\x06\x99\xfe\x7f\x30\x09\xe1\xa5\x3d\xe8\x6b\xf4\x05\x15\x56\x3f\xd9\xed\x38\xbb\x22\xfb\xf4\x72\x25\x0a\x1c\x7e\xeb\x63\x85\xba\xe8\xbe\x62\x7c\x3b\x72\xc9\xdd\xad\x05\xa0\xea\x34\xdc\x2c\xb7\x74\x30\x6f\x6c\xcc\x9d\x8d\xfa\xd7\x28\x07\xac\xb6\x1f\x9c\x8a\x5e\xfb\xc6\x3a\x8c\xf0\xa1\xaf\xb5\x4d\x7f\xdc\x7d\xc4\xc0\x0b\x55\x72\x6b\xd9\x3d\xbb\x43\x46\x94\xdb\xf9\xd0\xa5\xbb\xbe\xb5\xc4\xb3\x8e\x6d\xd8\xb5\x7e\x71\xf7\x7e\x06\x0d\xf3\x58\xaf\xef\x4c\xeb\x35\xa0\x48\xbb\x5f\x03\x66\x26\x47\xbb\xe7\xb6\x63\x56\x11\x8c\xfe\xc7\x70\x14\xf4\xa7\x91\x11\x56\xd9\x7e\xdf\xea\x88\x20\xbe\x87\xa3\xa4\x6c\x9f\xb1\x1e\x76\x94\x80\x77\x8a\xa1\x2d\xbe\x24\x08\xf3\x25\x95\x1c\x73\x95\x08\x69\xdb\xe6\x62\x95\x0c\x2d\xc9\x06\x5f\x11\x04\x4f\x4d\x22\x8c\xf4\x8a\x33\xfb\x64\x37\x5a\xea\x6b\x76\xaf\x91\x8a\xaf\xe5\x5b\xcf\x59\x4e\x8a\x4b\xc4\xb8\x09\xfc\x40\xac\x46\x3a\xe9\x0c\x92\x0c\x91\x1a\x84\xd2\x91\x11\xc4\xf6\x4e\x12\x27\x6b\xea\xd0\xa7\x81\x32\x71\x23\x8c\xf7\x7c\x9f\x13\x56\x31\xd2\x38\x5f\xfb\x1b\xde\x90\x71\x6e\xef\xd6\x30\x90\xc2\x61\x08\x5f\x5c\x57\x18\xb6\x55\x7a\xdd\xcc\x38\x2e\x29\xdb\xd7\xd9\xb0\x71\x8d\x62\x52\x67\xc2\xaa\x06\x66\x9c\xf0\xdb\xc0\x87\x03\x79\xda\x19\xef\xbc\x0a\x36\x5b\xe1\xb8\xb7\x44\x1d\x0d\xb5\xc2\x13\x5a\x22\xbd\xb0\xce\xcd\xc9\x02\x6d\x3e\x5b\xa0\xcd\xe7\x0b\xb4\xf9\xed\x02\x6d\xbe\x58\xa0\xcd\xef\xa6\x39\x9d\x6b\x9d\x93\x73\x30\xa0\x4f\xe7\x54\x8d\xbc\xa9\x0e\x15\x6d\x10\xa9\x17\xd1\xd2\x65\x9d\x7c\xfb\xdf\x3f\x34\xc7\xc7\x4f\x8f\xb3\x1f\x9a\x93\x67\xcf\x9e\xfd\xd0\x7c\xf6\xf4\x18\x7e\x7c\xf5\xcf\xcf\x9e\xfd\x70\xfd\x43\x86\x2e\x8e\xd6\xca\x27\xe3\x54\x31\xdf\xb2\x64\x1e\x21\xc6\xe4\xa7\xbf\xc2\x55\x5f\xeb\x31\x8c\x82\xac\x75\x17\x43\x89\xda\xbb\xfa\x70\x62\xcf\xe0\x58\xe1\x56\x1b\x0b\x09\xeb\x35\x71\x7e\xde\x7f\xaa\x2a\xa2\xa9\x1a\x60\x74\x32\x51\x73\xf2\xaa\x1d\xf6\xeb\x79\xbf\xcc\xe0\xc1\xff\x72\x48\xaa\x6b\x32\x78\x5b\x3b\x9c\x0d\x65\x6e\xdf\xe6\xd4\xd3\x50\xe5\xdf\x9d\x21\x1b\xda\x1c\xf0\x59\x21\xb3\xef\x6b\x3e\xc2\x7e\xd5\x99\x4d\x3c\xee\xa1\xf7\x6f\x30\x85\x01\xd8\xea\x31\x90\xc7\x6d\x38\x35\x7e\x3c\x24\xab\xd5\x1f\xd1\xfb\xa7\x1a\xc6\xbb\xf5\xe8\x7e\xf2\xef\x6d\x18\x44\x3a\x7e\x56\x3d\x30\xac\x5f\xec\x3c\x6b\x3f\x77\x91\x4b\xee\xd7\xfe\xe3\xf4\x5d\x99\xf7\x1e\x6e\xf7\xb9\x7b\x0e\xb4\xfb\x06\x19\x1f\x62\xdf\x18\x77\xbf\xf8\x19\xcd\xba\xef\x26\xef\xd5\x99\xd3\x05\x1b\x02\xdc\x49\x14\xa0\x1f\x14\xd5\x9a\x80\x0a\x9f\x7b\x87\x4f\x15\x4e\x5c\x54\xed\xb6\x38\x70\xec\x04\xb9\x44\xd7\x35\x01\xbb\xb7\xca\x8e\x25\x90\xda\xff\xe4\x86\x50\xfd\x24\x3d\x2a\x36\x4c\x90\xba\x7d\xde\x1f\x71\xb2\x25\xf0\xc2\x08\x29\xd1\xf2\x06\x00\x91\x20\xfc\x8a\xf0\xdc\xcf\x9c\x6e\xc1\xb5\x17\x46\x53\x62\xf4\xa0\xce\xac\xa8\x10\x69\x02\xd1\x1f\x06\x01\x8d\x79\x5f\x3d\x7b\xad\xd2\x25\xbd\x92\x8c\xc3\xf3\xaf\x6b\x22\x9f\x4b\xb2\x4d\x13\xa7\xb7\x5d\x9a\x8a\xe0\x49\x09\x3d\xa3\xdc\x37\x8b\x75\x05\x98\xc2\xb6\x52\x98\xc4\x5c\xd7\x19\xcd\xb3\x39\x88\x57\x65\x39\x4c\xa2\x29\x47\x3f\x18\xb7\x10\x9b\xc9\x98\x23\x32\x50\x6b\x16\x4f\x01\x95\xcd\xdc\x61\x6b\x0d\x3e\x14\xd2\x5d\x5e\xe2\xac\x91\x44\xed\x44\x2d\x4c\x02\xcf\x72\x78\xe1\x9a\xc9\x69\x2f\x28\x74\xe1\x83\x3b\xf9\xcc\x6d\xa5\xbc\xf0\x22\x08\x4f\xc7\x02\x12\x03\x6c\xd7\x3a\x6a\x2e\x6c\x3f\x08\xa6\xf3\x5e\xda\x86\xbe\x68\x4e\xc0\x6b\x8b\xad\x09\xd5\x7e\x42\xb4\x36\x5d\xed\xc7\xac\x59\x98\xb1\xd7\x98\x75\xdd\xb7\x16\xf4\x22\x3c\xb7\x05\x79\x1c\x6e\xdb\x97\xee\xce\x9c\x80\x40\xad\xcd\xfc\x11\x8b\x0d\xd8\x67\x37\x6e\xfc\xbf\x8d\x57\x83\xbc\x06\xaf\x24\x96\x9e\x09\xaf\x57\x98\x82\x5f\x6e\x81\xf4\x7f\x15\x2a\x6b\x16\x42\xfd\x1c\xb8\x5e\xb0\x33\x3a\x57\xf0\xb1\x78\xd1\x2e\x0e\xde\xa1\xcb\x68\xeb\x6b\xe7\xdd\x05\x97\xb2\x48\x71\xfb\x60\xc8\x93\xaa\x52\x71\xce\xad\x70\xed\x6d\x8f\x91\x27\x0c\xbd\x97\xaa\x6f\x83\xfb\xf1\xfd\xd0\x69\xfd\x0d\x62\xa8\x39\x6b\x43\xb4\x55\xa1\xaf\x03\xda\x8b\x00\x8e\xd7\xd8\xad\x61\x27\x10\xd6\x0f\x12\xe8\x94\xa0\xfe\xa5\x03\xae\x44\x98\x79\xc8\xfa\x64\x1e\xc8\xa3\xa5\x57\x11\x50\x4f\xaa\x56\x04\x39\x7d\x20\xd0\x78\x19\x0b\x23\xc0\xe8\x71\xbf\x00\xfe\x15\xe8\x1c\xe1\xfe\xa9\x19\x3a\xb2\xec\x7f\x06\x32\x8b\x31\xdd\x43\x11\x05\x2c\x23\xb1\x0c\x1f\x9e\x53\x02\x2b\xa7\x04\x34\x13\xfa\x21\xec\x3f\x83\x48\x4d\xdb\x34\xc9\x5f\xa8\x2b\x59\xa3\x09\x4e\xbd\x38\x7a\x5b\x5d\xbf\x0d\xb1\xf0\x75\x73\x7f\x40\xdb\x16\xbb\x05\x96\x3c\x50\xed\xe1\x56\x27\x4c\xd4\xef\x65\xf4\x7e\x62\x6f\xdd\x22\x7d\x3f\x48\x8f\xff\x40\xa3\x5e\x63\xfd\x5b\x2a\x6a\xe4\x6d\x00\x5a\x5f\x5d\xf7\xc3\xe2\xe9\xba\xa6\x61\x76\x28\xd7\x04\xf4\xd0\x9e\x35\x73\xcc\xc9\x7f\xb2\xe6\x55\xc3\x95\x09\x4e\xc9\x17\x9d\x9a\xfa\xcb\x1b\x49\x5e\x90\x1a\x15\xac\xa9\xa5\x40\xcb\x1b\x49\x84\x7e\xa7\x55\x87\x91\x25\x02\xbd\x79\xfd\x2c\xfb\x3d\xe2\x04\xf2\x7b\x90\x5a\x6a\xa9\xd0\x2d\xb0\x0e\x49\x5a\x33\xbe\xc5\xd5\x7f\xe0\xd6\xe6\xa8\x5f\xaf\x2d\x88\x41\xa6\x3c\xbe\x07\x5a\xb3\x34\x90\xf0\x30\xa8\x2a\x73\x2a\x3b\xb2\x79\x69\xc8\x6b\x1f\x68\x8d\x79\xb8\xda\x9a\x43\x2e\x2e\x35\x3f\xd1\xb9\x03\x08\xaf\x97\x82\x3a\xf5\x44\xa6\xee\xdc\xb2\xcd\x1d\x9e\xa3\x02\x3d\x42\xe9\x09\x7a\xf4\x08\xfd\x33\xe8\x25\x27\xc8\xcf\xde\xd3\x15\x9f\x9c\x40\xf9\x67\x41\x79\x00\xf3\x3b\x80\xf9\x3c\x02\xe3\xc3\x7d\xa6\x70\xfd\x76\x00\x2e\x80\x55\x38\xbf\x18\x81\xf5\xe1\x3f\x57\xb8\x7f\x87\x4e\x91\x7e\x1d\x2e\xff\x06\x7f\xe3\xca\x7f\xfb\x90\xa5\x66\x41\x90\x64\x24\xe6\x74\xd8\xaa\xbf\x16\xea\x09\x12\x70\x4f\x3e\x2f\x17\x7a\x1a\x11\xfe\xbc\xf4\x1e\xc5\xdf\x8a\xb5\xd1\x5f\x60\xc2\x77\xf0\xdd\x41\x5e\x15\x74\x95\xf5\x65\x49\xdd\x00\xca\xdc\x29\x06\xb8\x4c\x44\xa2\x3b\x4f\x58\x6d\xc8\xfb\x37\xe2\xdf\xa6\xed\x3f\x46\x66\x89\x89\x5c\x73\x06\xca\xa0\xac\x6d\xc3\x2f\xae\xd4\x44\x74\x88\x01\xf8\x00\x86\x93\x2d\xa6\xb5\x7e\xb6\xac\xa5\xbf\x22\xf5\x59\x90\xef\x9e\xd4\xe8\xb1\x85\xe8\xa9\x7c\xb6\xfd\x8e\x10\xbb\x17\x1c\x2f\x6c\x9d\x9e\x44\xea\xda\x3d\x8e\x5f\x9c\x1e\xe0\x72\x5b\xd3\x6e\x9f\x86\x54\xd5\xb8\xbe\x41\xe3\x72\x57\xdd\xaa\x51\x61\x7c\xde\x57\x98\x2f\xff\x67\x00\xeb\x74\xf4\xd8\x4d\xda\x00\x00"
 
 func jsGogsJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -19943,8 +19943,8 @@ func jsGogsJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "js/gogs.js", size: 51365, mode: os.FileMode(0644), modTime: time.Unix(1582991299, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4f, 0x67, 0xf8, 0x2b, 0xd1, 0x45, 0x41, 0xc9, 0xe6, 0x67, 0xb4, 0xa1, 0xcb, 0x99, 0x2f, 0x7c, 0x75, 0x38, 0x35, 0xf2, 0x18, 0x53, 0x9c, 0x3a, 0xf, 0xab, 0xaf, 0xf1, 0x1b, 0xac, 0x61, 0xf3}}
+	info := bindataFileInfo{name: "js/gogs.js", size: 55885, mode: os.FileMode(0644), modTime: time.Unix(1792280303, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x95, 0x57, 0xac, 0x5, 0xf9, 0x7, 0x64, 0x36, 0xa0, 0xc8, 0x61, 0xd6, 0x8c, 0xce, 0x96, 0x55, 0x24, 0x91, 0x15, 0x48, 0xce, 0x4, 0xc1, 0xd0, 0xc0, 0x2d, 0x72, 0x54, 0x5f, 0x7, 0x56, 0xef}}
 	return a, nil
 }
 
@@ -40,7 +40,9 @@ func (fs *fileSystem) Get(name string) (io.Reader, error) {
 // NewTemplateFileSystem returns a macaron.TemplateFileSystem instance for embedded assets.
 // The argument "dir" can be used to serve subset of embedded assets. Template file
 // found under the "customDir" on disk has higher precedence over embedded assets.
-func NewTemplateFileSystem(dir, customDir string) macaron.TemplateFileSystem {
+// Only files with the given extensions are served, all files are served when no
+// extension is given.
+func NewTemplateFileSystem(dir, customDir string, exts ...string) macaron.TemplateFileSystem {
 	if dir != "" && !strings.HasSuffix(dir, "/") {
 		dir += "/"
 	}
@@ -50,6 +52,8 @@ func NewTemplateFileSystem(dir, customDir string) macaron.TemplateFileSystem {
 	for _, name := range names {
 		if !strings.HasPrefix(name, dir) {
 			continue
+		} else if len(exts) > 0 && !hasExt(name, exts) {
+			continue
 		}
 
 		// Check if corresponding custom file exists
@@ -72,3 +76,13 @@ func NewTemplateFileSystem(dir, customDir string) macaron.TemplateFileSystem {
 	}
 	return &fileSystem{files: files}
 }
+
+func hasExt(name string, exts []string) bool {
+	ext := path.Ext(name)
+	for i := range exts {
+		if exts[i] == ext {
+			return true
+		}
+	}
+	return false
+}
@@ -5,28 +5,32 @@
 // ../../../templates/admin/auth/new.tmpl (10.045kB)
 // ../../../templates/admin/base/page.tmpl (1.227kB)
 // ../../../templates/admin/base/search.tmpl (247B)
-// ../../../templates/admin/config.tmpl (22.345kB)
-// ../../../templates/admin/dashboard.tmpl (7.122kB)
+// ../../../templates/admin/bot/list.tmpl (2.004kB)
+// ../../../templates/admin/config.tmpl (24.118kB)
+// ../../../templates/admin/dashboard.tmpl (7.865kB)
+// ../../../templates/admin/lifecycle.tmpl (4.188kB)
+// ../../../templates/admin/maintenance.tmpl (1.825kB)
 // ../../../templates/admin/monitor.tmpl (1.87kB)
-// ../../../templates/admin/navbar.tmpl (1.219kB)
+// ../../../templates/admin/navbar.tmpl (1.774kB)
 // ../../../templates/admin/notice.tmpl (4.063kB)
 // ../../../templates/admin/org/list.tmpl (1.524kB)
 // ../../../templates/admin/repo/list.tmpl (2.348kB)
+// ../../../templates/admin/site_content.tmpl (2.887kB)
 // ../../../templates/admin/user/edit.tmpl (5.451kB)
 // ../../../templates/admin/user/list.tmpl (1.879kB)
 // ../../../templates/admin/user/new.tmpl (2.811kB)
 // ../../../templates/base/alert.tmpl (457B)
 // ../../../templates/base/delete_modal_actions.tmpl (261B)
-// ../../../templates/base/footer.tmpl (2.819kB)
-// ../../../templates/base/head.tmpl (9.23kB)
+// ../../../templates/base/footer.tmpl (2.939kB)
+// ../../../templates/base/head.tmpl (9.588kB)
 // ../../../templates/explore/navbar.tmpl (710B)
 // ../../../templates/explore/organizations.tmpl (1.054kB)
 // ../../../templates/explore/page.tmpl (852B)
 // ../../../templates/explore/repo_list.tmpl (1.356kB)
-// ../../../templates/explore/repos.tmpl (365B)
+// ../../../templates/explore/repos.tmpl (1.117kB)
 // ../../../templates/explore/search.tmpl (278B)
 // ../../../templates/explore/users.tmpl (1.066kB)
-// ../../../templates/home.tmpl (17.611kB)
+// ../../../templates/home.tmpl (17.773kB)
 // ../../../templates/inject/footer.tmpl (0)
 // ../../../templates/inject/head.tmpl (0)
 // ../../../templates/install.tmpl (14.499kB)
@@ -34,71 +38,108 @@
 // ../../../templates/mail/auth/activate_email.tmpl (652B)
 // ../../../templates/mail/auth/register_notify.tmpl (500B)
 // ../../../templates/mail/auth/reset_passwd.tmpl (628B)
-// ../../../templates/mail/issue/comment.tmpl (258B)
-// ../../../templates/mail/issue/mention.tmpl (304B)
+// ../../../templates/mail/issue/comment.tmpl (350B)
+// ../../../templates/mail/issue/comment.txt (120B)
+// ../../../templates/mail/issue/mention.tmpl (391B)
+// ../../../templates/mail/issue/mention.txt (153B)
+// ../../../templates/mail/issue/merge.tmpl (320B)
+// ../../../templates/mail/issue/merge.txt (102B)
+// ../../../templates/mail/issue/review_reminder.tmpl (588B)
+// ../../../templates/mail/issue/review_reminder.txt (351B)
 // ../../../templates/mail/notify/collaborator.tmpl (317B)
+// ../../../templates/mail/notify/commit_mention.tmpl (485B)
+// ../../../templates/mail/notify/deactivated_user.tmpl (581B)
+// ../../../templates/mail/notify/inactive_repo.tmpl (616B)
+// ../../../templates/mail/notify/inactive_user.tmpl (836B)
+// ../../../templates/mail/notify/path_watch.tmpl (750B)
+// ../../../templates/mail/notify/repo_invite.tmpl (570B)
+// ../../../templates/mail/notify/visibility_failed.tmpl (765B)
 // ../../../templates/org/create.tmpl (981B)
-// ../../../templates/org/header.tmpl (938B)
+// ../../../templates/org/dependencies.tmpl (1.782kB)
+// ../../../templates/org/header.tmpl (1.138kB)
 // ../../../templates/org/home.tmpl (3.338kB)
 // ../../../templates/org/member/invite.tmpl (803B)
 // ../../../templates/org/member/members.tmpl (2.423kB)
+// ../../../templates/org/settings/bot.tmpl (4.119kB)
+// ../../../templates/org/settings/bots.tmpl (2.809kB)
 // ../../../templates/org/settings/delete.tmpl (1.502kB)
-// ../../../templates/org/settings/navbar.tmpl (558B)
+// ../../../templates/org/settings/navbar.tmpl (1.308kB)
 // ../../../templates/org/settings/options.tmpl (3.025kB)
-// ../../../templates/org/settings/webhook_new.tmpl (1.06kB)
+// ../../../templates/org/settings/reminders.tmpl (295B)
+// ../../../templates/org/settings/repo_policy.tmpl (2.547kB)
+// ../../../templates/org/settings/rule_set_edit.tmpl (3.221kB)
+// ../../../templates/org/settings/rule_sets.tmpl (4.781kB)
+// ../../../templates/org/settings/secrets.tmpl (291B)
+// ../../../templates/org/settings/webhook_new.tmpl (1.119kB)
 // ../../../templates/org/settings/webhooks.tmpl (293B)
 // ../../../templates/org/team/members.tmpl (1.652kB)
 // ../../../templates/org/team/new.tmpl (3.594kB)
 // ../../../templates/org/team/repositories.tmpl (1.852kB)
 // ../../../templates/org/team/sidebar.tmpl (1.895kB)
 // ../../../templates/org/team/teams.tmpl (1.576kB)
-// ../../../templates/repo/bare.tmpl (2.597kB)
-// ../../../templates/repo/branch_dropdown.tmpl (1.912kB)
-// ../../../templates/repo/branches/all.tmpl (1.418kB)
+// ../../../templates/repo/bare.tmpl (2.664kB)
+// ../../../templates/repo/branch_dropdown.tmpl (2.748kB)
+// ../../../templates/repo/branches/all.tmpl (1.624kB)
 // ../../../templates/repo/branches/navbar.tmpl (303B)
-// ../../../templates/repo/branches/overview.tmpl (3.195kB)
+// ../../../templates/repo/branches/overview.tmpl (3.609kB)
 // ../../../templates/repo/commits.tmpl (240B)
-// ../../../templates/repo/commits_table.tmpl (3.095kB)
+// ../../../templates/repo/commits_table.tmpl (3.593kB)
 // ../../../templates/repo/create.tmpl (4.626kB)
-// ../../../templates/repo/diff/box.tmpl (6.521kB)
-// ../../../templates/repo/diff/page.tmpl (1.714kB)
+// ../../../templates/repo/dependencies.tmpl (2.217kB)
+// ../../../templates/repo/diff/box.tmpl (7.966kB)
+// ../../../templates/repo/diff/page.tmpl (1.992kB)
 // ../../../templates/repo/diff/section_unified.tmpl (917B)
 // ../../../templates/repo/editor/commit_form.tmpl (2.557kB)
 // ../../../templates/repo/editor/delete.tmpl (317B)
 // ../../../templates/repo/editor/diff_preview.tmpl (291B)
-// ../../../templates/repo/editor/edit.tmpl (3.155kB)
+// ../../../templates/repo/editor/edit.tmpl (3.354kB)
 // ../../../templates/repo/editor/upload.tmpl (2.097kB)
 // ../../../templates/repo/forks.tmpl (575B)
-// ../../../templates/repo/header.tmpl (4.622kB)
-// ../../../templates/repo/home.tmpl (4.531kB)
+// ../../../templates/repo/header.tmpl (6.131kB)
+// ../../../templates/repo/home.tmpl (9.86kB)
+// ../../../templates/repo/insights.tmpl (5.619kB)
+// ../../../templates/repo/invitation.tmpl (931B)
+// ../../../templates/repo/issue/choose.tmpl (1.353kB)
 // ../../../templates/repo/issue/comment_tab.tmpl (1.397kB)
+// ../../../templates/repo/issue/form_fields.tmpl (1.826kB)
 // ../../../templates/repo/issue/label_precolors.tmpl (1.28kB)
 // ../../../templates/repo/issue/labels.tmpl (5.223kB)
-// ../../../templates/repo/issue/list.tmpl (9.811kB)
+// ../../../templates/repo/issue/list.tmpl (11.945kB)
 // ../../../templates/repo/issue/milestone_new.tmpl (2.353kB)
 // ../../../templates/repo/issue/milestones.tmpl (4.626kB)
 // ../../../templates/repo/issue/navbar.tmpl (275B)
 // ../../../templates/repo/issue/new.tmpl (306B)
-// ../../../templates/repo/issue/new_form.tmpl (4.937kB)
+// ../../../templates/repo/issue/new_form.tmpl (7.076kB)
 // ../../../templates/repo/issue/view.tmpl (985B)
-// ../../../templates/repo/issue/view_content.tmpl (17.083kB)
-// ../../../templates/repo/issue/view_title.tmpl (2.44kB)
+// ../../../templates/repo/issue/view_content.tmpl (23.701kB)
+// ../../../templates/repo/issue/view_title.tmpl (2.869kB)
 // ../../../templates/repo/migrate.tmpl (4.212kB)
-// ../../../templates/repo/pulls/commits.tmpl (695B)
-// ../../../templates/repo/pulls/compare.tmpl (2.636kB)
-// ../../../templates/repo/pulls/files.tmpl (693B)
+// ../../../templates/repo/mute_schedule.tmpl (2.083kB)
+// ../../../templates/repo/pulls/commits.tmpl (1.184kB)
+// ../../../templates/repo/pulls/compare.tmpl (3.118kB)
+// ../../../templates/repo/pulls/files.tmpl (2.648kB)
 // ../../../templates/repo/pulls/fork.tmpl (2.618kB)
 // ../../../templates/repo/pulls/tab_menu.tmpl (1.102kB)
-// ../../../templates/repo/release/list.tmpl (3.758kB)
-// ../../../templates/repo/release/new.tmpl (5.302kB)
-// ../../../templates/repo/settings/branches.tmpl (2.175kB)
-// ../../../templates/repo/settings/collaboration.tmpl (2.85kB)
+// ../../../templates/repo/recent_push.tmpl (606B)
+// ../../../templates/repo/release/list.tmpl (6.102kB)
+// ../../../templates/repo/release/new.tmpl (6.807kB)
+// ../../../templates/repo/release/stats.tmpl (2.151kB)
+// ../../../templates/repo/settings/auto_lock.tmpl (2.093kB)
+// ../../../templates/repo/settings/branches.tmpl (7.908kB)
+// ../../../templates/repo/settings/collaboration.tmpl (7.567kB)
 // ../../../templates/repo/settings/deploy_keys.tmpl (3.661kB)
 // ../../../templates/repo/settings/githook_edit.tmpl (1.371kB)
-// ../../../templates/repo/settings/githooks.tmpl (974B)
-// ../../../templates/repo/settings/navbar.tmpl (1.124kB)
-// ../../../templates/repo/settings/options.tmpl (18.431kB)
-// ../../../templates/repo/settings/protected_branch.tmpl (3.64kB)
+// ../../../templates/repo/settings/githooks.tmpl (1.453kB)
+// ../../../templates/repo/settings/import.tmpl (3.289kB)
+// ../../../templates/repo/settings/navbar.tmpl (2.107kB)
+// ../../../templates/repo/settings/options.tmpl (26.531kB)
+// ../../../templates/repo/settings/protected_branch.tmpl (5.032kB)
+// ../../../templates/repo/settings/push_log.tmpl (4.177kB)
+// ../../../templates/repo/settings/reminder_form.tmpl (2.015kB)
+// ../../../templates/repo/settings/reminders.tmpl (295B)
+// ../../../templates/repo/settings/secret_form.tmpl (2.867kB)
+// ../../../templates/repo/settings/secrets.tmpl (291B)
+// ../../../templates/repo/settings/tasks.tmpl (2.364kB)
 // ../../../templates/repo/settings/webhook/base.tmpl (293B)
 // ../../../templates/repo/settings/webhook/delete_modal.tmpl (526B)
 // ../../../templates/repo/settings/webhook/dingtalk.tmpl (699B)
@@ -106,19 +147,22 @@
 // ../../../templates/repo/settings/webhook/gogs.tmpl (1.512kB)
 // ../../../templates/repo/settings/webhook/history.tmpl (3.16kB)
 // ../../../templates/repo/settings/webhook/list.tmpl (2.182kB)
-// ../../../templates/repo/settings/webhook/new.tmpl (1.06kB)
-// ../../../templates/repo/settings/webhook/settings.tmpl (5.033kB)
+// ../../../templates/repo/settings/webhook/new.tmpl (1.119kB)
+// ../../../templates/repo/settings/webhook/payload_version.tmpl (1.789kB)
+// ../../../templates/repo/settings/webhook/settings.tmpl (5.71kB)
 // ../../../templates/repo/settings/webhook/slack.tmpl (1.515kB)
 // ../../../templates/repo/user_cards.tmpl (1.927kB)
-// ../../../templates/repo/view_file.tmpl (4.983kB)
-// ../../../templates/repo/view_list.tmpl (2.511kB)
+// ../../../templates/repo/view_file.tmpl (5.922kB)
+// ../../../templates/repo/view_list.tmpl (3.505kB)
+// ../../../templates/repo/watch_paths.tmpl (1.931kB)
 // ../../../templates/repo/watchers.tmpl (161B)
 // ../../../templates/repo/wiki/new.tmpl (1.265kB)
 // ../../../templates/repo/wiki/pages.tmpl (776B)
 // ../../../templates/repo/wiki/start.tmpl (533B)
-// ../../../templates/repo/wiki/view.tmpl (3.308kB)
+// ../../../templates/repo/wiki/view.tmpl (3.512kB)
 // ../../../templates/status/404.tmpl (343B)
 // ../../../templates/status/500.tmpl (349B)
+// ../../../templates/status/503.tmpl (316B)
 // ../../../templates/user/auth/activate.tmpl (1.355kB)
 // ../../../templates/user/auth/forgot_passwd.tmpl (1.234kB)
 // ../../../templates/user/auth/login.tmpl (2.382kB)
@@ -127,18 +171,18 @@
 // ../../../templates/user/auth/signup.tmpl (2.17kB)
 // ../../../templates/user/auth/two_factor.tmpl (940B)
 // ../../../templates/user/auth/two_factor_recovery_code.tmpl (950B)
-// ../../../templates/user/dashboard/dashboard.tmpl (5.518kB)
-// ../../../templates/user/dashboard/feeds.tmpl (5.244kB)
-// ../../../templates/user/dashboard/issues.tmpl (6.762kB)
+// ../../../templates/user/dashboard/dashboard.tmpl (5.644kB)
+// ../../../templates/user/dashboard/feeds.tmpl (5.458kB)
+// ../../../templates/user/dashboard/issues.tmpl (7.057kB)
 // ../../../templates/user/dashboard/navbar.tmpl (2.151kB)
 // ../../../templates/user/meta/followers.tmpl (161B)
 // ../../../templates/user/meta/header.tmpl (864B)
 // ../../../templates/user/meta/stars.tmpl (0)
 // ../../../templates/user/profile.tmpl (4.069kB)
-// ../../../templates/user/settings/applications.tmpl (3.134kB)
+// ../../../templates/user/settings/applications.tmpl (3.95kB)
 // ../../../templates/user/settings/avatar.tmpl (1.843kB)
 // ../../../templates/user/settings/delete.tmpl (1.447kB)
-// ../../../templates/user/settings/email.tmpl (2.326kB)
+// ../../../templates/user/settings/email.tmpl (2.978kB)
 // ../../../templates/user/settings/navbar.tmpl (1.622kB)
 // ../../../templates/user/settings/organizations.tmpl (1.5kB)
 // ../../../templates/user/settings/password.tmpl (1.557kB)
@@ -317,7 +361,27 @@ func adminBaseSearchTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _adminConfigTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5c\x4f\x73\xea\x38\x90\x3f\x67\x3e\x85\x2b\xb5\xa7\xa9\x8d\x53\x5b\x35\x87\x3d\xf0\xa6\x8a\x21\x2f\xc9\xec\x23\x33\x54\xe0\xcd\x1c\x55\xc2\x6a\x8c\x36\xb2\xe4\x91\x64\x42\x5e\x2a\xdf\x7d\x4b\x7f\x0c\x06\x6c\x63\x11\x33\xcb\x29\x01\xab\xfb\xf7\x53\xab\xd5\x92\x5a\x6d\xde\xdf\x35\x64\x39\xc3\x1a\xa2\xeb\x39\x56\x70\xbb\x04\x4c\xae\xa3\xf8\xe3\xe3\xa7\x01\xa1\xab\x28\x61\x58\xa9\x2f\xd7\x98\x64\x94\x47\x89\xe0\x0b\x9a\x5e\xff\xfa\xd3\x55\xf5\x59\x41\xcd\x03\x8d\x29\x07\x69\x9e\xed\x3f\x4c\x25\x25\xf6\xfb\xab\x2a\x98\xd5\x78\xcb\xf1\x6a\x8e\xa5\xc3\xbb\xda\x95\xd4\xaf\xc0\x56\x10\xbd\x52\x02\x51\x22\x58\x91\x59\x7c\x0d\x5c\x3b\x65\x57\x07\xd4\x31\x03\xa9\x9d\x2e\xdf\xe0\xf6\xe7\x68\x0a\x72\x05\x32\x52\xa0\x35\xe5\xa9\x8a\x7e\xbe\x75\x50\x57\x83\xe5\x2f\x15\x92\x5a\xe4\x11\xd6\x1a\x27\x4b\x20\x91\xb1\x81\xef\x8b\x55\x13\xd3\xff\xfa\x6f\x1e\xcf\xa4\x67\x1d\x3b\x3b\xc4\xca\xaa\x46\xde\x2a\xa5\xda\xdb\xe5\x2f\x4e\x70\xcf\x0c\x1b\xed\x1a\xcf\x19\x44\x0a\xd2\x6c\xdb\x97\xab\x01\x61\x65\x5b\xc2\x6e\x96\x42\xd2\x1f\xc6\xa6\x2c\xb2\x88\x37\x3b\xdf\x95\x32\x57\x03\xa2\x7f\x6d\x64\x37\x97\x98\x13\xc4\x71\x06\xd7\x1f\x1f\x83\x5b\xa2\xb7\x52\xc4\x48\x0d\xf3\x3c\xfe\xcd\xb4\xf9\x03\x67\x60\x5b\x90\x4e\x7a\x65\xc1\x51\xa1\x40\x36\x6b\x7d\x2e\xf8\x77\x05\x32\x50\x67\x26\x48\x0b\xd3\xe7\x82\x3f\x09\xb2\xe1\xb9\x69\xb0\x63\x62\x42\x57\xd4\x0e\xdc\xe0\x96\xd0\x55\xa5\x55\x0b\xb4\x1b\xc4\x18\xd6\x1a\x24\xc7\x0c\x15\x92\xd5\xb3\x70\x8e\x14\x7f\xf5\x0d\xbf\x3f\x8f\x03\x3a\xe8\x51\x88\xc8\x30\xe5\xad\xfa\xef\x6c\x93\x70\xd5\xb9\x14\x5a\x24\xa2\x9d\xfc\xc4\x37\x0a\x57\xbf\xd4\x3a\x47\x98\x90\x86\x61\xf7\xfa\x1f\x67\xb3\xc9\x90\x90\x90\xa1\xaf\xea\xcf\x85\xd4\x47\xf5\x4f\x84\xd4\xe1\xfa\x13\x90\x1a\x2d\x28\xab\x73\xb1\x41\x22\x08\x54\x50\x46\x20\xf5\x3d\x65\xd6\xd9\xec\xa3\x50\xb0\x17\x78\xeb\x8a\xf5\x0d\xde\x3e\x05\xa5\x99\x42\x19\xe5\x68\x05\x52\x51\xd1\xee\x5a\xb3\xf1\xf4\x89\xf2\xbf\x5c\xcb\x70\x13\x16\x9c\xae\x91\x12\xc9\x0b\x68\x94\x83\xcc\xa8\x6a\x40\xdc\xef\xe3\x77\x4e\xd7\x53\x2b\x37\xd9\x88\x9d\xdc\x61\x26\x12\xcc\x90\x14\x42\x1f\x9d\xaa\x63\xd3\xf4\x59\x08\x5d\x99\xab\xbd\x07\x0e\xb1\x58\x30\xca\xa1\x29\x7c\x0d\x68\x09\xb3\xc0\xd1\x02\xbf\xbf\xd3\x45\x54\xf2\xfb\xd3\x89\xba\xb8\x76\x93\x2c\x21\x79\x79\x7f\x07\x4e\x3e\x3e\x6e\xd4\x3f\x05\x96\x70\x23\x0c\x23\x1a\x6c\x23\x42\x95\x59\x63\x90\x14\x85\x06\x89\x98\x48\x03\x99\xdd\x39\x05\xcf\x56\x7e\x2c\xd2\x7e\xe9\x01\xb7\xec\xd2\x1f\x34\x0f\xe4\xf5\xd5\x4a\x3e\xfc\xa0\x79\x17\x46\xbd\x8f\x35\xce\x73\x44\xb0\xc6\x28\xc7\x7a\xd9\xc1\xf1\x87\x79\x7e\x87\x35\x9e\x60\xbd\xfc\x84\xbf\x63\x82\xb0\x52\xa0\x15\x5a\x48\x91\x21\x42\xd5\x4b\xa0\xd9\xc6\x02\x93\xa1\x55\x71\x2f\x45\x76\x47\xd5\xcb\xff\x8b\xf9\x18\xe6\x84\xf2\xb4\x61\xde\xee\x1b\x6f\xec\x5a\xfb\xb9\xbb\x6f\xbb\xc1\x2d\x61\x7e\x9b\x55\xe1\xe1\x76\x7c\xd3\xc7\xf3\x6c\xf7\xd4\xf2\x52\xf7\x7a\x4a\x2d\xfd\xac\x22\x1d\x5d\x83\x0b\x1d\xc5\xd3\xe9\x63\x39\xd5\x49\xf7\x29\xde\x24\xef\x1f\x1f\x67\x5a\xbb\x0b\xda\xc4\x6e\xa3\xb3\x66\x0f\x74\x5c\x6d\xcd\xee\xa1\xaa\xf4\x70\xdf\x70\x5c\xa5\x5d\x66\x6a\x26\xfb\x9e\xc3\x4e\x1f\x63\xb3\xcc\x34\xce\xf3\xe3\x40\x2f\xf0\x96\x02\xef\x06\xf5\xcd\xb6\xfd\x1c\x18\xd2\xa0\x3a\xf6\xec\x1b\xbc\xcd\x40\x7d\xa6\x73\x19\xe5\x34\x2b\x32\x64\x70\x15\xfd\x01\xc8\x3a\x5a\x2d\x70\x6d\x10\x9b\x3e\xc6\x4f\x4e\xc5\x37\x78\x9b\xd2\x1f\x30\x32\xf2\xdd\x1d\x36\x9c\xa1\x3a\x6a\x95\x5d\x42\xea\x44\xcb\x48\x78\x95\x54\x03\xc2\x85\xb6\x53\x1e\x88\xa1\xa0\x10\xd6\x48\x69\x5c\xef\xce\x8d\x36\x7a\x76\xca\x86\x1b\x5d\xdf\xe0\x4d\x0d\xf5\xd4\x28\x0a\x88\xf7\x1d\x03\xfe\xf1\xce\xd9\x1e\xa0\x79\x41\x99\xa6\x1c\xb9\x35\x20\xa8\x43\x96\xf9\x6f\x4e\xde\x2d\x0a\x7d\x8e\x39\xa3\x4a\x03\x47\x4b\xa1\xda\xa2\xc6\xd8\xb6\x7a\x14\x2a\x38\x76\x78\xfd\x47\xa2\x92\xd3\x7f\x4a\x6c\x2a\xb3\x0f\x34\x5f\x82\x3c\xee\xb0\xce\x80\x23\xd7\xba\xd6\x5d\xbd\x51\x8f\xae\xaf\xcf\x90\x0b\x45\xb5\x90\x6f\x67\x59\x66\x25\xe4\xe2\x52\xd7\x59\xc3\xad\x71\x51\xd8\x31\xf9\xd6\x48\x76\x69\x08\xdf\xfe\x59\x28\x95\x48\x9a\x6b\xa4\xdf\xf2\xd6\xb3\x64\x05\x6c\x6a\x25\x66\x6f\xf9\x09\x47\x4a\x0b\x89\xb9\xa2\x28\x59\x62\xad\xa0\xee\x2c\x5e\xfa\x97\x9b\xa5\x15\xe0\xe1\x1f\xd3\xdf\x47\x4b\x2c\x15\xe8\xed\x56\xe0\x6a\x97\xdc\x90\x2b\x7a\xd0\xe6\xfd\x1d\x98\x82\x8a\xcc\x80\x36\x53\xe4\x42\xa3\x92\x18\xad\x90\xa9\x78\xee\x55\x58\x7f\x17\x42\x26\x80\x72\x49\x57\x58\x77\x3e\xc0\x55\xfa\x74\x6f\xe4\x27\x4e\xbc\xa7\x63\x92\xe5\x95\xe1\x35\x4a\x24\x60\x4d\x05\x47\x8c\x66\xb4\x21\x33\x52\xa1\xf2\x84\xd7\x23\x2f\x31\x36\x02\x21\x09\x38\x03\x99\x4b\x58\x80\x94\x40\x10\xa3\x09\x70\x75\xb0\x0c\x3a\xc8\xff\x11\x94\xef\x98\x60\x52\xca\x8d\xbd\x58\x74\xfd\x9f\xd1\x75\x28\x7a\x79\x68\xb5\x99\xa0\xb4\xb6\xbb\xc7\xc6\xc2\xef\x45\x1f\x67\xb3\xc9\x03\xed\xb4\xe6\x75\x25\xe7\x8f\xac\x2e\xf9\x60\xe6\x3e\xca\x68\x2a\xad\xa9\x4f\xe0\xe9\x8e\xb1\x36\x3d\x61\xb6\x55\x4f\xa5\xaa\x33\x30\x96\xf8\xd5\xa6\xa2\x90\x04\x4e\x40\x06\xa5\x29\x0e\x18\x3f\xe3\xd7\x7b\xca\xe0\xd9\xaa\xea\x31\x6d\x61\x09\x27\x22\xcb\xa8\x39\xe4\x82\x4e\xec\x41\x2b\x29\xa4\x04\x9e\xbc\x1d\xf5\xfb\x91\x93\xbc\x37\x82\xa3\xad\x5c\xff\x29\x1f\x67\x58\x62\x50\x63\x9b\xf4\x79\x95\x38\x47\xb0\xd6\xc0\x15\x15\xbc\x6e\xba\xd4\xc5\xe8\xaf\x4e\xc3\x98\x72\xf8\x5b\xe2\xfc\xeb\x46\xfe\xc4\x90\xed\x19\xe5\x12\x56\x14\x5e\xed\xb0\xdb\x21\x37\x63\x1d\xc8\x69\xb2\xd5\x61\x46\xda\x8c\xf1\x3e\xab\x9e\xcd\x59\xe4\x4c\x60\x12\x78\x7a\xad\x32\xff\xee\x14\x38\x0f\x0d\x38\xc6\x76\xa6\xa6\x21\xcb\x43\xd6\x7b\x4f\x68\x06\x59\x7e\x5a\xe6\xa7\x0a\x8e\x19\x13\xaf\x40\xec\x16\xa0\xeb\x58\x7a\x02\x43\x27\x6a\xf6\x02\xa7\x7a\x96\x27\xe1\xbc\x09\xaf\xed\xd9\xe8\xe8\x74\xf4\xf0\xd6\x7f\xf0\xda\x1c\x8f\x3e\x3e\xa2\xa7\xdf\x4e\x42\x36\xa0\x06\xbd\x7e\x25\xaa\x41\x7d\xc2\x6b\x03\xac\x76\x56\x9f\x96\xcd\xec\x1d\xd6\x78\x8e\x15\x9c\x65\x2b\x4b\xe6\x97\xba\x91\x25\xf3\xb8\x61\x53\xf9\xfe\x1e\x97\x36\x89\xcb\x6d\x64\xc7\x81\x23\xf3\xb8\xe6\x34\xb5\xaf\xf4\xf0\x2c\x75\x4c\x69\xf3\x1d\xe6\x46\x69\xe0\x1d\x26\x99\xc7\xcd\x57\x98\x1b\xa5\x81\x97\x98\x64\x1e\x2b\xc5\x5a\xee\x31\x37\x8a\xa7\xd3\xb1\x5b\x3d\xa3\x2e\xca\xd0\x12\x58\x5e\x92\xed\x4c\xe5\x58\xc0\xda\x90\xd9\x89\x51\xad\x84\xec\xbe\xa7\x8e\x4c\x5b\x32\x16\x92\x42\x52\x7d\x9e\xa3\xa2\xf2\xca\x2f\x75\x96\x95\xfc\x62\x26\x52\xca\x91\x84\x0c\xb2\x39\x48\x44\xf0\x5b\x43\x40\x2b\xcd\x15\x8f\x8d\xc4\xb3\x17\xb8\xc3\x6f\x2a\xe8\xbe\xce\x2b\x49\x84\x78\xa1\xb0\xc5\x6d\x9e\x48\x1b\xdc\x91\x15\x29\x81\x03\xa7\xd5\x3e\xb0\x99\x63\x9d\x31\xbf\xfb\xc6\x9f\xc0\xb3\x9f\x03\x6e\xe1\x76\xf0\xed\xc7\xfe\x2e\xe2\xbc\xee\xcd\xc1\xc1\x38\x80\xd2\x58\x17\x0a\x39\xb6\xc1\x34\xcb\x53\x43\x4a\xf9\xd4\x2a\x72\xbc\xfb\x66\x5c\x43\xb5\x8b\xe3\x1c\xf0\x3a\xf0\x9d\x96\x28\xf1\x35\xc3\x94\x9d\x25\x44\x80\xd1\x7c\xa9\xf1\xc1\x92\x0b\xdd\xfa\x5a\x5b\x85\x6f\x77\x6b\x85\x3b\x25\x1b\x1d\x4b\x55\xcc\xff\x17\x12\x8d\x72\x09\x0b\xba\x6e\x4d\x37\x3a\x90\xa9\x13\x98\xd8\xf6\x27\xe4\xc7\x1d\x6c\x4b\x7a\xd6\xc1\x84\xa6\x66\x9d\xda\x85\x14\x59\xab\xda\x7b\x29\xb2\x70\xb5\x35\x9b\x8a\x5d\xb5\xd5\x2d\x45\x9f\xf9\x76\x07\xbf\x49\xa1\x00\x13\x9d\x33\xed\x8e\x58\x99\x3a\xf9\x3a\xfe\xb3\xaf\x24\xbb\x1f\x40\x60\xc2\x26\xd9\x6b\x62\xc8\x4e\x46\x71\xc7\x43\x0d\x8f\x47\x2f\x54\x49\x0d\x5e\x6d\x87\xbd\xbe\xc1\x41\x32\xf1\x84\x6c\xe2\x5e\x3a\xf1\xea\x5c\xa3\xa5\x5e\x68\x8e\x56\x20\xe9\x62\x3f\xc5\x71\x6c\xb0\xa6\x2f\x34\xff\xcb\x0a\xf6\x3b\x56\x85\x02\x94\x80\xd4\x74\x41\x93\xc3\x6c\xe8\x31\x56\xdf\x15\x8c\xb6\xc2\xfd\x32\x6b\x2a\xdf\xaa\x0b\x3c\xad\xe5\x5b\x5d\xc0\x1a\xca\xb7\xea\xb0\xea\xcb\xb7\xfa\xf7\x15\x33\x32\x39\xc3\x94\x23\x0d\xeb\xee\xd7\x82\x9b\x81\x99\x18\xd9\x19\xac\x03\x92\xa2\x5d\x68\x61\x42\x2a\xb4\x10\x66\xa1\xd4\x86\x84\x6c\xa8\x0d\xd9\x59\xae\x29\x17\x42\x66\x95\x76\xe6\xe3\x75\x84\x13\x4d\x05\xff\x72\xfd\xfe\x3e\xcc\xf3\x69\x31\xb7\x55\x26\xb7\xae\x26\xd9\xf5\xf0\xd6\xde\x90\x1b\x92\xd7\x51\x06\x7a\x29\xc8\x97\xeb\xdc\x2c\x46\xd5\x38\x11\x8f\xa6\xcf\xf7\x33\xf1\x02\xfc\x71\xf6\x34\xae\xde\x61\x54\xc8\x51\xce\x28\x87\x68\x41\x81\x91\xa8\xa0\x11\x83\x45\x55\xcb\x7e\x47\x28\xcf\x8b\x9d\xe7\x57\x03\xfb\x55\x64\x0e\xec\x5f\xae\xc1\x51\x32\x71\x6f\xf3\x41\xc2\x3f\x05\x95\x40\xaa\x4a\x9d\x15\x9a\x3e\xce\x0b\xad\x05\xdf\x29\xd2\x06\xe0\x91\xfb\xfa\x3a\xa2\xe4\xcb\xb5\xe9\xff\x8d\xd1\x7f\x33\xd7\xfc\xfa\x68\x30\x03\x4e\xd0\xd6\x64\xc6\x0b\x9c\xb2\xad\x27\xdc\x1a\xd3\x87\x5e\x36\x0e\x0b\xbd\x04\xae\x4d\x48\xa1\x82\x9f\x65\x8b\x88\x0b\x7d\xb1\x85\x3d\x86\x5b\x6c\x9c\x75\x85\x35\x20\x13\x62\x10\xa3\xab\xa6\x74\x98\x31\x56\x3c\xf4\xad\x47\x82\xc0\xd8\xb4\xdd\x4b\x33\x68\x21\x58\x2c\xf1\x2b\xca\x28\x2f\x74\xa9\xaa\xdb\x21\xc1\xd2\x91\xa0\x40\xa3\x1c\x2b\xf5\x2a\x24\xe9\x46\xea\xd9\xc8\x4c\xbc\xc8\x19\x99\xd9\x79\x80\x2a\x9b\x7e\xb9\xbf\xcd\x6b\x0c\x48\x9e\xa7\xd5\x60\x83\xd3\xc8\xc9\xf7\x79\x33\xb3\xc3\x52\xd1\x94\x23\xca\xd1\x8a\xc2\xeb\x29\x24\xa7\x34\xe5\x94\xff\x45\xe1\xb5\x4f\x76\x9b\xda\x51\x48\xa9\xd2\x61\x57\x5c\x96\x5d\x59\x3b\x5a\x91\xef\x93\x5f\x79\xaf\x55\x51\x8f\x12\x9c\xeb\x64\x89\x83\x68\xfa\x1b\xad\x8a\x9a\x91\xd3\xf2\xef\x96\x46\xee\xf6\x69\x05\xd2\x2c\xf4\x52\xac\xdf\x6c\x4d\xd0\x36\xf2\x9d\xd4\x37\xab\x6e\x62\xb4\xed\x86\xd1\xb3\x8c\xc7\x1e\x77\x71\xba\x07\xd5\xd2\x17\xe7\x72\xa8\x36\xab\x23\xbf\x84\xb4\xe4\x51\xfd\x8c\x6c\x32\xf5\xa3\x55\x10\x58\xc1\x6a\x4e\x89\x67\x59\xea\xcc\xf9\xf4\x52\x97\x3a\xc3\xad\xf4\x26\x17\xc0\xb9\xd0\x87\x67\xa3\x46\xd7\x31\x46\xf3\xae\x63\xc3\xf7\x1f\xc2\x1f\x45\x42\xfc\xa5\x35\x93\x6d\x5f\x5c\x38\x53\x22\xdb\xea\xbe\xd4\xa1\x29\xe9\xe5\x52\xb8\x08\xd7\x90\x0a\xb4\xad\xe2\x89\x6f\x15\x94\xc8\x75\xa2\x25\xc0\xd6\x10\x2d\x55\xe2\xbb\x68\x76\xb5\x4e\x4f\x29\xb3\x77\x7a\x3a\x64\x3a\x5d\xc3\x86\xec\x66\x37\x94\xa5\xd6\xb9\x42\x82\xb3\xce\x6e\xbd\x0b\xdb\x73\x92\xda\xa9\x4e\x13\x44\xb9\x06\xb9\xc2\x8d\xef\xd3\xb8\x86\x0f\xa3\xdf\x7d\xbb\xa6\x9d\x9b\x82\x44\x70\x12\xb4\x73\x2b\x59\x64\x78\x8d\x18\x5d\x00\xd2\xf4\xd8\x10\x3c\xe1\xf5\x98\x2e\x60\x46\xb3\xfd\x3b\xb4\x3e\x88\x24\x4a\x2e\xba\x24\xbe\xfd\xb8\x4c\x9f\xef\xc3\x13\xde\x23\x33\x7f\xcf\x12\x4a\x12\xd3\xfa\x52\x03\x89\x25\x17\x63\x82\x73\xdd\x14\x45\xac\x65\xe2\xa1\x6b\x12\x30\x7a\x4e\x75\xbb\x1f\x3b\xdd\x67\xf0\x61\x07\xde\x70\xf9\x5d\x1e\x80\xdd\x7c\xb6\x1c\x46\x82\xf3\x6a\xb6\xa0\x0c\x6a\x95\x87\x3e\x8e\x6d\x85\xcf\x57\x25\xd9\x76\xfc\xb6\x0e\x62\x9c\xe2\x3c\x47\xef\x8d\xfa\x4b\xf5\xd8\x2d\xc3\xd0\x7b\x9a\xad\xe9\x7a\xae\x4d\xaa\x50\x3a\x76\xc9\x5f\xe1\x70\x5a\x29\x52\x05\xab\x73\x25\x52\x05\xf4\x73\x25\x48\x15\xf0\xf6\xe2\xa3\x0a\xe2\x49\x15\x47\x7b\x40\x2d\x05\x47\xbb\x48\x41\x95\x46\xcf\xc0\xe0\x5c\x85\x46\xd2\xe9\xbe\xd4\x49\xe4\xe9\x7d\x62\x32\x79\xe3\x9d\x6f\x52\xd5\x50\x0c\x28\xbd\x3b\x20\xf7\xd9\xda\xbb\x03\x32\xc7\xaa\xef\x0e\x18\x9c\x58\x7a\x57\x0b\xdc\x5a\x81\x57\x87\x1c\x34\x31\x26\x34\xd1\x85\x3c\xcf\xc4\xc8\x9d\xee\x4b\x9d\x18\x9e\x5e\x8c\x57\x58\x63\x89\x5c\xd1\xe3\xd1\x5a\x53\x6f\xb0\x78\x68\xa5\x5c\xe1\xe3\x69\xe1\xbd\xc4\xb7\xef\xdc\x9c\x46\x62\x5b\x82\xd9\x1f\x9d\x54\x7a\x2e\x4a\x14\x32\x69\x7d\xf5\xa5\xa4\xf1\xe0\x45\xa6\x56\xe2\x74\xe8\x32\xfd\x59\x52\xe8\x1a\xa0\x4a\x1e\x3e\xfb\x59\xd2\xe9\x29\x3a\x95\xec\x7c\x7a\x64\x01\x04\x24\xd6\x40\xd0\x69\x24\x5d\xe8\xbc\x2f\xb5\x0c\xc3\xa8\xb6\x4c\xe5\xc7\xd9\x6c\x72\x96\x79\x6c\x5f\x0a\xb9\xd0\x49\x6c\xb8\xc5\x38\x49\x40\x29\x43\x51\x4b\xc1\x90\x5d\x3b\x90\x90\x34\xad\xfd\xb9\x97\xdd\x23\x81\xb1\x5a\x3c\xb4\x0a\x46\x4e\xde\x2e\x1f\x7f\x5a\xe9\xba\x63\xc2\x11\x81\xcb\x38\x3a\x3c\x51\x29\xc5\x79\xd2\x98\x99\x55\x7d\xa9\xfe\xe0\xd8\xc5\x04\x16\xb8\x60\xfa\x48\x6a\xc5\x59\x29\xbe\x73\x8d\x8f\x1d\x4d\x97\xa2\x90\xaa\x73\xed\xed\xdf\x30\x5f\x0a\xf1\x72\x96\x21\x78\x75\xba\x2f\x75\x0c\x3c\xbd\xf8\xe8\xde\xcd\xdb\x28\x3e\x71\xa7\x56\xe2\x10\x60\x74\x05\xd2\xe6\xae\x44\xd1\x50\x02\x5f\x62\xdd\xb9\xc6\x33\xd7\xb6\xc7\x34\x44\xc9\xc6\x56\x16\x69\xa6\xea\xab\x8b\x1a\x97\x87\x92\xdf\xf4\x85\xe6\xb3\xf1\x34\xb0\xc2\xa8\xc5\x11\x1f\xe8\x79\xd2\x07\x29\xbd\xd8\xbc\x41\x4a\xf5\x66\x2f\x41\xe8\x62\x81\x96\x34\x5d\x32\x9a\x2e\x3b\xbf\xd7\xf8\x40\x75\xb9\x9b\xb8\xa3\x8b\xc5\x63\x29\xdf\xd3\x96\xc2\x10\x34\x7b\x7b\x4b\x8e\x51\xde\xb4\xc1\x37\x34\x9e\xf0\xfa\x81\x6a\xc3\x62\x6c\x1a\x06\x78\xe4\x01\x0a\x4a\x96\x58\xe2\x44\x1f\xbe\xb2\xde\x88\x37\xda\x48\x9c\x0a\xdc\x72\x7e\xd9\x85\x3b\x3c\xb7\x1c\x47\x49\x13\x84\x65\xda\x1a\x64\x0c\xc8\xc3\x68\x28\xd3\xf3\xbd\x4b\x67\xfb\x6b\xdf\x23\x85\xf6\x20\x64\xb8\xf8\xc8\x13\xbb\x17\x4f\xfb\xcc\xa1\x3b\x1a\x76\x75\x0e\x60\x61\xda\xf7\x4c\x22\x61\x82\x07\x58\x62\x64\x9a\xf7\x4c\x21\x2f\x18\xeb\xce\x60\x52\xb0\x3e\x33\xd2\xde\x33\x3b\xc3\x3f\x8c\x82\xc0\xeb\x83\xfd\xe7\x23\x3a\x13\x69\x70\x44\x67\x22\xbd\x71\x32\xff\x56\x70\x37\x2c\xdd\x4b\xd5\x5d\x7e\x0a\x62\x2c\xd2\xb6\x5f\x07\x72\xa6\xfc\xa9\x7b\x20\x18\xb8\x5e\x6e\x9b\xad\x40\xbe\x45\x73\xac\x68\xe2\x0c\xb0\xe9\xc4\xfb\xbb\xc4\x3c\x85\xc8\x50\x48\x6d\xfc\x2c\x37\xf4\x03\x2d\x7f\xdd\x9e\x07\xb4\x71\x87\xff\x68\xe9\xec\xe6\x9d\x36\x4d\xf6\xc5\xe2\xf2\x67\x39\x2b\x8f\xaa\xda\x07\xb7\x61\x50\x22\xd7\x9b\x57\xa9\xf7\xd1\x06\xb9\x84\xad\x5d\x0f\x6e\x7c\xed\xd3\x1d\x1e\x15\xec\xbd\x82\x43\x6b\xa8\x1d\x0f\xae\xfe\x57\xfe\xe3\xff\xfa\x3f\x07\x3f\x38\xbb\x10\x42\x83\xff\xf5\xda\xff\x0b\x00\x00\xff\xff\x53\xa8\xaa\x78\x49\x57\x00\x00"
+var _adminBotListTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x55\x4d\x6f\xdb\x38\x10\x3d\x2b\xbf\x82\x10\xf6\xb0\x7b\x88\x88\x05\x72\x58\x2c\x18\x01\xad\x83\xb6\x01\x82\xb6\x88\xd3\xb3\x31\x12\xc7\x32\x11\x8a\x54\xc9\x91\xd3\x40\xd0\x7f\x2f\xf4\x65\xd1\xb2\x1b\x24\x40\x2e\x96\xc9\x99\x79\xef\x71\x38\xc3\x69\x1a\xc2\xb2\xd2\x40\xc8\xe2\x0c\x3c\xf2\x1d\x82\x8c\x59\xd2\xb6\x17\x42\xaa\x3d\xcb\x35\x78\x7f\x1d\x83\x2c\x95\x61\xb5\x47\x17\xa7\x17\x51\x68\xa9\x15\xcb\xad\x21\x50\x66\xb0\x2d\x8d\x85\x53\xb2\xdf\x8f\x42\xaa\x1e\x8f\x1b\xd8\x67\xe0\x06\xb6\xe8\x38\x92\x9e\x50\xef\x91\x3d\x29\x89\x2c\xb7\xba\x2e\x4d\x4f\x83\x86\x06\xb0\xe8\x44\x38\x68\x74\x74\xc0\x8a\xc4\xee\x2a\x50\x41\xb6\x62\x40\x04\xf9\x0e\x25\xeb\x8e\x38\x8a\xed\x81\x12\xf5\xef\x7f\x26\x79\x70\xa3\xac\x24\xb3\xe4\xbb\x9f\x4d\x09\x06\x0a\xdc\x54\x60\x50\xc7\x6d\xcb\xfe\x3e\xf5\x25\x4b\xa0\x63\x96\x3c\x74\xdf\xb6\xfd\x67\xe0\xe6\xbb\xab\x01\x7d\x91\x8c\xda\x78\x82\xfc\x11\x32\x8d\xb3\x1c\xea\x97\x1e\x8b\x72\x3e\x5d\x24\x86\xdd\xf3\xa1\x7b\x74\xcf\x2c\x03\xaf\x72\xe6\xc9\xa9\x6a\x02\x99\x82\x23\x41\xdd\x19\xa7\x55\x24\xc8\x1d\xfe\x77\xb6\xf4\xf6\x46\x70\xda\x1d\xef\xfd\x21\x0f\x06\x4a\x8c\xdb\xf6\xd5\xfe\xd6\x15\x6f\x71\x2f\x1c\x18\xf2\xaf\x8c\xe8\xea\xcf\x27\xb9\x43\x20\x94\x6f\x8a\x41\xa9\x68\x19\x20\xf8\x9c\x16\xc1\x8f\x32\x26\x28\xb3\xf2\xf9\xe0\xd9\x34\x0e\x4c\x81\x2c\xf9\x68\xc9\x8f\xf5\x75\x92\xd7\x48\x90\xec\xf8\x6f\x6f\x7a\x1e\x79\x6a\xf9\x0a\x25\x9e\xb5\x09\x60\x3b\x87\xdb\xeb\xb8\x69\x3a\x8a\x6f\xae\x48\xbe\xd8\x12\xef\x94\x79\x6c\xdb\x38\x9d\x77\x27\x04\x48\xcf\xa0\xcc\xab\x23\xc1\x9f\xfb\x0c\x07\xaa\xc7\xb2\x0c\xfd\xa3\x28\x94\x70\x8f\x95\x4d\x02\xf2\x7e\x3d\x53\xff\x7f\x14\xd8\x34\x6a\xcb\x92\x15\x98\x7b\x04\xb9\xb2\xb2\x73\xf2\x15\x98\xa0\x74\x4b\x65\xd4\x58\xae\x1a\x32\xd4\x1d\xe8\x5f\xf3\x3d\x59\x57\x24\x1e\x89\x94\x29\xfc\x50\x14\x39\x98\x8d\x43\x90\x9b\xdc\xca\xa1\xf8\x3a\xc4\xb4\x69\xd0\xc8\xb6\x3d\x4f\xbf\x46\x5a\x13\x50\xed\xdf\x89\xdf\x23\x6d\x7c\x0f\xf8\x4a\x01\x2b\x5b\x76\x0d\xfc\xbd\xd6\xfa\x9d\x24\xe4\x03\xe2\xa6\xaa\xb5\x7e\x51\x84\xe0\x8b\xfb\x6c\x1a\xd4\x1e\x17\x4e\xa1\x24\xc2\x5f\xc4\x0a\x87\xcf\x0b\x21\x61\xe3\xdb\x4d\xd0\x9c\x3d\xf5\x31\xc3\xb1\x8a\x73\x65\xdd\x33\x92\x22\x8d\x5d\x5d\xdd\x00\xe1\xa7\x92\xee\xac\x29\x58\xb2\x1a\x9a\x78\xa8\xb0\xd1\xb2\xde\x59\x47\x81\x69\x64\x7d\xb9\x61\x3e\x54\xd5\xba\xce\x7e\xdc\xdf\xb5\x2d\xb7\xae\xe0\xcb\x66\xe1\x53\x5e\x79\x77\x2a\x7e\x68\xc3\x38\x15\x6a\xca\xc6\x16\xd8\x16\x2e\x2b\x34\xb9\xd2\x97\xfe\x67\x0d\x0e\x2f\x6d\x9c\x0a\xae\xd2\xd3\x5e\x0b\x9f\x8d\x45\x1a\x04\x0f\xdf\x0d\xc1\xfb\x47\x39\xbd\x08\xae\xe8\x64\x74\x0d\x83\xb0\x1f\x60\x15\x14\x38\xcf\xc2\xe9\x4a\xa7\x3f\xe3\x77\xfc\x9c\x4c\xbf\xad\xb5\x84\xe3\x28\xfd\x3d\x00\xf1\x41\x90\x9d\xd4\x07\x00\x00"
+
+func adminBotListTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_adminBotListTmpl,
+		"admin/bot/list.tmpl",
+	)
+}
+
+func adminBotListTmpl() (*asset, error) {
+	bytes, err := adminBotListTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "admin/bot/list.tmpl", size: 2004, mode: os.FileMode(0644), modTime: time.Unix(1792258322, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xec, 0x99, 0x2a, 0xfc, 0xcf, 0x2e, 0x8f, 0x4a, 0xf, 0x16, 0xd6, 0xfe, 0x6d, 0x87, 0xa3, 0x53, 0xa8, 0x6f, 0xf2, 0xb9, 0xc, 0xf7, 0x49, 0x8a, 0xaa, 0x12, 0xd0, 0x2e, 0x1a, 0x16, 0x4a, 0x41}}
+	return a, nil
+}
+
+var _adminConfigTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x5c\x41\x6f\xdb\xba\xb2\x5e\x3b\xbf\x42\x30\xde\xea\xe0\x45\xc1\x03\xce\xe2\x2d\xd2\x02\xae\xd3\x26\x7d\x75\x5a\x23\x4e\x7b\xde\x8e\xa0\xc5\xb1\xcc\x1b\x8a\xd4\x21\xa9\xc4\x6e\x90\xff\x7e\x41\x52\xb2\x65\x5b\x92\x45\x45\x3e\xd7\xab\xd6\x31\xe7\x9b\x8f\xc3\xe1\x90\x1c\x0e\xfd\xfa\xaa\x21\x49\x19\xd6\x10\x0c\xe7\x58\xc1\xd5\x12\x30\x19\x06\xe1\xdb\xdb\xc5\x35\xa1\xcf\x41\xc4\xb0\x52\x1f\x86\x98\x24\x94\x07\x91\xe0\x0b\x1a\x0f\x3f\x5e\x0c\xca\xdf\x65\xd4\x7c\xa1\x31\xe5\x20\xcd\x77\xfb\x5f\xc6\x92\x12\xfb\xf7\x41\x59\x99\x45\xbc\xe2\xf8\x79\x8e\xa5\xd3\x37\xd8\x95\xd4\x2f\xc0\x9e\x21\x78\xa1\x04\x82\x48\xb0\x2c\xb1\xfa\x35\x70\xed\xc0\x06\x07\xd4\x31\x03\xa9\x1d\x56\xde\xe0\xea\x8f\x60\x06\xf2\x19\x64\xa0\x40\x6b\xca\x63\x15\xfc\x71\xe5\x54\x0d\xae\x97\x7f\x96\x48\x6a\x91\x06\x58\x6b\x1c\x2d\x81\x04\xc6\x06\x79\x5f\x2c\x4c\x48\xff\xe7\x7f\x79\xf8\x28\x73\xd6\xa1\xb3\x43\xa8\x2c\x34\x72\x9f\x86\x05\xec\xd5\xf2\x4f\x27\xb8\x67\x86\x0d\xba\xc6\x73\x06\x81\x82\x38\xd9\xf6\x65\x70\x4d\x58\xd1\x96\xb0\xcb\xa5\x90\xf4\xb7\xb1\x29\x0b\xac\xc6\xcb\x9d\xbf\x15\x32\x83\x6b\xa2\x3f\xd6\xb2\x9b\x4b\xcc\x09\xe2\x38\x81\xe1\xdb\xdb\xf5\x15\xd1\x5b\x29\x62\xa4\x46\x69\x1a\x7e\x32\x6d\xbe\xe3\x04\x6c\x0b\xd2\x0a\x57\x66\x1c\x65\x0a\x64\x3d\xea\x43\xc6\x7f\x2a\x90\x9e\x98\x89\x20\xd0\x88\x79\x2f\xc8\x86\xe7\xa6\xc1\x8e\x89\x09\x7d\xa6\x76\xe0\xae\xaf\x08\x7d\xfe\x78\xd1\x46\xb5\x1b\xc4\x10\x56\x1a\x24\xc7\x0c\x65\x92\x55\xb3\x70\x8e\x14\x7e\xce\x1b\xfe\x7c\x98\x78\x74\x30\xd7\x42\x44\x82\x29\x6f\xc4\xbf\xb1\x4d\xfc\xa1\x53\x29\xb4\x88\x44\x33\xf9\x69\xde\xc8\x1f\x7e\xa9\x75\x8a\x30\x21\xb2\x11\xff\xee\xf1\x71\x3a\x22\x44\x76\xc4\x4f\x85\xd4\x47\xf1\xa7\x42\x6a\x7f\xfc\x08\xa4\x46\x0b\xca\xaa\x5c\xec\x3a\x12\x04\x4a\x5a\xc6\x20\xf5\x17\xca\xac\xb3\xd9\xaf\x7c\x95\x3d\xc1\xba\xad\xae\x6f\xb0\x7e\x97\x2a\xcd\x14\x4a\x28\x47\xcf\x20\x15\x15\xcd\xae\xf5\x38\x99\xdd\x53\xfe\xcb\xb5\xf4\x37\x61\xc6\xe9\x0a\x29\x11\x3d\x81\x46\x29\xc8\x84\xaa\x1a\x8d\xfb\x7d\xfc\xc9\xe9\x6a\x66\xe5\xa6\x1b\xb1\xce\x1d\x66\x22\xc2\x0c\x49\x21\xf4\xd1\xa9\x3a\x31\x4d\x1f\x84\xd0\xa5\xb9\xda\x7b\xe0\x10\x8b\x05\xa3\x1c\xea\xc2\xd7\x35\x2d\xd4\x2c\x70\xb0\xc0\xaf\xaf\x74\x11\x14\xfc\x7e\x38\x51\x17\xd7\x2e\xa3\x25\x44\x4f\xaf\xaf\xc0\xc9\xdb\xdb\xa5\xfa\x3b\xc3\x12\x2e\x85\x61\x44\xbd\x6d\x44\xa8\x32\x6b\x0c\x92\x22\xd3\x20\x11\x13\xb1\x27\xb3\x1b\x07\xf0\x60\xe5\x27\x22\xee\x97\x1e\x70\xcb\x2e\xfe\x4d\x53\x4f\x5e\x9f\xad\xe4\xed\x6f\x9a\xb6\x61\xd4\xfb\x58\xe3\x34\x45\x04\x6b\x8c\x52\xac\x97\x2d\x1c\x7f\x94\xa6\x37\x58\xe3\x29\xd6\xcb\x77\xf8\x3b\x26\x08\x2b\x05\x5a\xa1\x85\x14\x09\x22\x54\x3d\x79\x9a\x6d\x22\x30\x19\x59\x88\x2f\x52\x24\x37\x54\x3d\xfd\x47\xcc\xc7\x30\x27\x94\xc7\x35\xf3\x76\xdf\x78\x13\xd7\x3a\x9f\xbb\xfb\xb6\xbb\xbe\x22\xec\x63\xbe\xe1\xda\xf2\x70\x3b\xbe\xd9\xdd\x69\xb6\x7b\x6a\x79\xae\x7b\x3d\xa5\x96\xf9\xac\x22\x2d\x5d\x83\x0b\x1d\x84\xb3\xd9\x5d\x31\xd5\x49\xfb\x29\x5e\x27\x7f\x31\x68\xc9\xb4\x72\x17\xb4\x89\xdd\x06\xb3\x62\x0f\x74\x1c\xb6\x62\xf7\x50\x06\x3d\xdc\x37\x1c\x87\xb4\xcb\x4c\xc5\x64\xdf\x73\xd8\xd9\x5d\x68\x96\x99\xda\x79\x7e\x5c\xd1\x13\xac\x63\xe0\xed\x54\x7d\xb3\x6d\xdf\xa7\x0c\x69\x50\xba\xb5\xba\x47\x50\xef\xe9\x5c\x42\x39\x4d\xb2\x04\x19\xbd\x8a\xfe\x06\x64\x1d\xad\x52\x71\x65\x10\x9b\xdd\x85\xf7\x0e\xe2\x1b\xac\x67\xf4\x37\x8c\x8d\x7c\x7b\x87\xf5\x67\xa8\x8e\x5a\x65\x97\x90\xea\x68\x19\x09\x2f\x92\x6a\x40\x38\xd3\x76\xca\x03\x31\x14\x14\xc2\x1a\x29\x8d\xa5\xf6\xb2\xd1\x83\x03\x1b\x6d\xb0\xbe\xc1\x5a\x8d\xf4\xcc\x00\x79\xc4\xfb\x96\x01\xff\x78\xe7\x6c\x0f\xd0\x3c\xa3\x4c\x53\x8e\xdc\x1a\xe0\xd5\x21\xcb\xfc\x93\x93\x77\x8b\x42\x9f\x63\xce\xa8\xd2\xc0\xd1\x52\xa8\xa6\xa8\x31\xb1\xad\xee\x84\xf2\x8e\x1d\x39\xfe\x91\xa8\xe4\xf0\xbb\xc4\xa6\x22\xfb\x40\xd3\x25\xc8\xe3\x0e\xeb\x0c\x38\x76\xad\x2b\xdd\x35\x37\xea\xd1\xf5\xf5\x01\x52\xa1\xa8\x16\x72\x7d\x92\x65\x56\x42\x2a\xce\x75\x9d\x35\xdc\x6a\x17\x85\x1d\x93\x6f\x8d\x64\x97\x06\xff\xed\x9f\x55\xa5\x22\x49\x53\x8d\xf4\x3a\x85\x96\xca\x66\x56\xe2\x71\x9d\x42\x47\x95\x98\x2b\x8a\xa2\x25\xd6\x0a\xaa\xce\xe2\x17\x83\xd2\x26\xa0\xac\x78\xf4\x7d\xf6\x75\xbc\xc4\x52\x81\xde\x6e\x05\x06\xbb\xe4\x46\x5c\xd1\x83\x36\xaf\xaf\xc0\x14\x94\x64\xae\x69\x3d\x45\x2e\x34\x2a\x88\xd1\x12\x99\x92\xe7\x0e\x3c\xfb\xcb\x98\x78\x01\x82\x22\x09\x58\x0b\xa9\x2a\xfa\x5c\x65\xe7\x91\x13\x1b\xe7\x52\x1d\x8d\xbd\x10\x32\x02\x94\x4a\xfa\x8c\x75\xeb\xd3\x63\x89\xc5\x17\x23\x3f\x75\xe2\x3d\x9d\xd1\x0a\x5e\x73\x4a\xd0\x33\x55\x74\x4e\x19\xd5\x6b\xe3\x11\x3c\xee\x48\x71\x4e\xc9\xaf\x0d\xd2\xd8\x02\xf5\x49\x36\xc1\x2b\x37\x7a\x54\x70\xc4\x68\x42\x6b\x72\x48\x25\x52\xf7\x78\x35\xce\x25\x26\x46\xe0\xed\xed\xbd\x2a\x4d\x46\x04\x09\x19\x7b\xab\x9e\x82\xfc\x21\x63\x5f\x02\xa9\x84\x05\x48\x09\x04\x31\x1a\x01\x57\xa0\x2a\x15\xff\x9f\xa0\x7c\x67\x34\xa6\x85\xdc\x24\x17\x0b\x86\xff\x1d\x0c\x7d\xb5\x17\xf9\x05\x9b\xb4\x8b\xa9\xee\xe0\x16\xf9\xb1\xc1\x24\xf4\x6e\xa9\xee\xd3\x1d\xf2\xec\x82\xcb\x13\x99\x30\x8d\x12\x1a\x4b\x6b\xf0\x0e\x3c\x5d\xc6\xc1\x66\x92\xcc\x0e\xf8\xbe\x80\x3a\x01\x63\x89\x5f\x6c\xd6\x10\x49\xe0\x04\xa4\x57\x46\xe9\x80\xf1\x03\x7e\x31\x79\xc5\x07\x0b\xd5\x63\x86\xc9\x12\x66\x58\x69\x14\x89\x24\xa1\x5a\x21\x4d\x13\x40\xf3\x8c\xc4\x70\x7c\xde\x4d\xb0\xd2\x63\x27\xf7\x48\x13\xf8\x64\xa5\x7c\xbd\x4f\x4b\x00\x94\xe2\xd8\xa4\x12\x78\x96\x1c\x55\xfa\x28\x01\xa6\xb6\xf9\xf7\x2c\xe9\x3f\x0f\xe8\x86\x90\x18\x5d\xa1\xcd\x04\xbe\x48\x9c\x22\x73\x9f\xc0\x4d\xa2\xb3\xed\x82\xf2\xd9\x21\x4c\x28\x87\xbf\x24\x4e\x3f\x6f\xe4\x3b\x2e\x2d\x39\xa3\x54\xc2\x33\x85\x17\xeb\x60\xd6\xb9\x8c\x57\x79\x72\x9a\x6e\x31\x8c\x4f\x19\x6f\xda\x67\xd5\xb3\x39\xb3\xd4\xa4\xbd\x3c\x53\x1a\x65\xe6\x3f\x1d\x80\x9b\x0b\xa4\x4f\xdf\xcf\xa9\x99\xab\x47\x9f\x4d\x60\x4e\xe8\x11\x92\xb4\x5b\x3a\xb0\xac\xbc\xd8\xb3\x98\x7d\xa1\xf2\x23\x90\xef\x5b\xcc\x06\x51\xbd\x8f\x84\xf3\x26\xbc\xb2\x07\xe6\xa3\x93\x30\x57\x6f\xfd\x07\xaf\xcc\x99\xf9\xed\x2d\xb8\xff\xd4\x49\xb3\x51\x6a\xb4\xab\xb6\x5a\xef\xf1\xca\x28\x56\x3b\x91\xa6\xe1\x84\x63\x92\xb6\xe6\x46\xf9\x24\xe7\x1b\x32\x3f\xd7\xd3\x0d\x99\x87\x35\x27\x8d\xd7\xd7\xb0\xb0\x49\x58\x9c\x2d\x5a\x0e\x1c\x99\x87\x15\x47\xec\x7d\xd0\xc3\x03\xf6\x31\xd0\xfa\x8b\xed\x0d\xa8\xe7\xc5\x36\x99\x87\xf5\xf7\xda\x1b\x50\xcf\x9b\x6d\x32\x0f\x95\x62\x0d\x97\xdb\x1b\xe0\xd9\x6c\xe2\xd6\xe9\xa0\x0d\x18\x5a\x02\x4b\x0b\xb2\xad\xa9\x1c\x0b\x58\x1b\x32\x3b\x31\x2a\x38\x06\x59\x49\xa6\x29\x43\x0f\x51\x26\xa9\x5e\x9f\xa8\x2a\xc3\x81\x9f\x6d\xae\x3e\xe7\x17\x32\x11\x53\x8e\x24\x24\x90\xcc\x41\x22\x82\xd7\xaa\xee\x3a\x33\x97\x98\x18\x89\x87\x5c\xe0\x06\xaf\x95\xd7\x25\x6e\x0e\x12\x09\xf1\x44\x61\xab\xb7\x7e\x22\x6d\xf4\x8e\xad\x48\xa1\xd8\x73\x5a\xed\x2b\x36\x73\xac\xb5\xce\x9f\x79\xe3\x77\xe8\xb3\x9f\x3d\xae\x66\x77\xf4\xdb\x8f\xfd\xdd\xce\xe6\xd8\x9b\x23\x8a\x71\x00\xa5\xb1\xce\x14\x72\x6c\xbd\x69\x16\xe7\x93\x98\xf2\x99\x05\x72\xbc\xfb\x66\x5c\x41\xb5\x8d\xe3\x1c\xf0\x3a\xf0\x9d\x86\x28\xf1\x39\xc1\x94\x9d\x24\x44\x80\x41\x3e\xd7\xf8\x60\xc9\xf9\x6e\x7d\xad\xad\xfc\xb7\xbb\x95\xc2\x17\x83\xd6\x2c\x55\x36\xff\x17\x44\x1a\x99\x74\x04\x5d\x35\xe6\xa0\x9d\x92\x99\x13\x98\xda\xf6\x1d\x2e\x4d\x9c\xda\x86\x9c\xbd\x53\xe3\x9b\xaf\x77\xb0\xe6\x7a\xbd\x11\xd6\xdc\x9e\xfb\xc3\x56\x6c\x2a\x76\x61\xcb\x5b\x8a\x3e\x2f\x61\x9c\xfa\x4d\xb2\x06\x98\x68\x7d\xfd\xe2\x88\x15\x49\x9a\xcf\x93\x1f\x7d\xdd\xbc\xe4\x03\x08\x4c\xd8\x9b\x97\x8a\x18\xb2\x93\x66\xde\xf1\x50\xc3\xe3\x2e\x17\xda\xba\xa9\x69\xd3\xdc\xe0\x20\xc3\xdc\x21\xc5\xbc\x97\x63\x1e\x9c\x6a\xb4\xd4\x13\x4d\x4d\x31\x17\x5d\xac\x3d\x07\x6b\xf6\x44\xd3\x5f\x56\xb0\xdf\xb1\xca\x14\xa0\x08\xa4\xa6\x0b\x1a\x1d\x66\xa9\x8f\xb1\xfa\xa9\x60\xbc\x15\xee\x97\x59\x5d\x4d\x5f\x55\xe0\x69\xac\xe9\x6b\xa3\xac\xa6\xa6\xaf\x4a\x57\x75\x4d\x5f\xff\xbe\x62\x46\x26\x65\x98\x72\xa4\x61\xa5\xfd\x07\x66\x6a\x64\x1f\x61\xa5\xfb\x1d\x16\x4c\x48\x89\x16\xc2\xcc\x97\xda\x88\x90\x0d\xb5\x11\x3b\xc9\xdd\xf5\x42\xc8\xa4\xd4\xce\x7c\x1c\x06\x38\x32\x99\xdd\x0f\xc3\xd7\xd7\x51\x9a\xce\xb2\xb9\x2d\x3d\xba\xb2\x3d\xbc\x72\x3d\xbc\xb2\x65\x13\x86\xe4\x30\x48\x40\x2f\x05\xf9\x30\x4c\xcd\x62\x54\x8e\x13\xe1\x78\xf6\xf0\xe5\x51\x3c\x01\xbf\x7b\xbc\x9f\x94\x2f\xb6\x4a\xe4\x28\x37\xb9\xc2\x60\x41\x81\x91\x20\xa3\x01\x83\x45\x19\x65\xbf\x23\x94\xa7\xd9\xce\xf7\x83\x6b\xfb\xa7\xc0\x1c\xd8\x3f\x0c\xc1\x51\x32\x71\x6f\xf3\x41\xc2\xdf\x19\x95\x40\xca\xa0\xce\x0a\x75\x1f\xe7\x99\xd6\x82\xef\x54\xee\x03\xf0\xc0\xfd\x79\x18\x50\xf2\x61\x68\xfa\x7f\x69\xf0\x2f\xe7\x9a\x0f\x8f\x06\x33\xe0\x04\x6d\x4d\x66\xbc\xc0\x81\x6d\x3d\xe1\xca\x98\xde\xf7\x06\xda\x94\x38\x00\xd7\x26\xa4\x50\xc1\x4f\xb2\x45\x34\x15\x19\xe7\xba\x43\x34\xdc\x42\xe3\xac\xe6\xda\x0f\x99\x10\x83\x18\x7d\xae\x4b\x87\x19\x63\x85\xa3\xbc\xf5\x58\x10\x98\x98\xb6\x7b\x69\x06\x2d\x04\x0b\xcd\x05\x44\x42\x79\xa6\x0b\x28\xd2\x9e\x8e\x04\x65\xca\x82\xb1\x52\x2f\x42\x92\x76\xa4\x1e\x8c\xcc\x34\x17\x39\x21\x33\x3b\x0f\x50\x69\xd3\x2f\x93\xb6\xfb\xea\x9c\xa7\x45\xb0\xc1\x69\xec\xe4\xfb\xbc\x03\xda\x61\xa9\x68\xcc\x91\xa9\xe7\xa6\xf0\xd2\x85\xe4\x8c\xc6\x9c\xf2\x5f\x14\x5e\xfa\x64\xb7\x29\x28\x86\x98\x2a\xed\x77\x99\x66\xd9\x15\x05\xc5\x25\xf9\x3e\xf9\x15\x37\x68\x25\x78\x14\xe1\x54\x47\x4b\xec\x45\x33\xbf\x3b\x2b\xc1\x8c\x1d\xca\x3f\x5b\x2f\xbb\xdb\x27\x53\xda\x6f\x8a\x04\xc4\x6a\x8d\xf0\x4e\xe4\xeb\xd4\x37\x0b\x37\x35\x68\xbb\x61\xf4\x24\xe3\xb1\xc7\x5d\x74\xf7\xa0\x4a\xfa\xe2\x54\x0e\xd5\x64\x75\x94\x2f\x21\x0d\x79\xd4\x7c\x46\xd6\x99\xfa\xce\x02\x78\x96\x35\x9b\x53\xe2\x49\x96\x3a\x73\x3e\x3d\xd7\xa5\xce\x70\x2b\xbc\xc9\x05\x70\x2e\xf4\xe1\xd9\xa8\xd6\x75\x8c\xd1\x72\xd7\xb1\xe1\xfb\xbb\xc8\x8f\x22\x3e\xfe\xd2\x98\xc9\xb6\xaf\x59\x4e\x94\xc8\xb6\xd8\xe7\x9b\xc7\x76\xf4\x52\x29\x5c\x84\xab\x49\x05\xda\x56\xe1\x34\x6f\xe5\x95\xc8\x75\xa2\x85\x82\xad\x21\x1a\x9e\x0e\xec\x6a\xb3\xab\x75\xdc\xe5\xed\x85\xc3\x69\x91\xe9\x74\x0d\x6b\xb2\x9b\xed\xb4\x98\x32\x1a\x85\x04\x67\xeb\xf6\xe9\xdf\xb2\xda\x9e\x93\xd4\x0e\x3a\x8e\x10\xe5\x1a\xe4\x33\x66\xcd\x5d\xbf\x1d\x7f\xcd\xdb\xd5\xed\xdc\x14\x44\x82\x13\xaf\x9d\x5b\xc1\xc2\x5c\xf5\x32\xba\x00\x5b\x65\xd2\xcc\xe3\x1e\xaf\x26\x74\x01\xa6\xae\xe4\x04\x44\x22\x25\x17\xa8\xbd\x3b\xcc\x1e\xbe\xf8\x27\xbc\xc7\x66\xfe\x9e\x24\x94\x44\xa6\xf5\xb9\x06\x12\x4b\x2e\xc4\x04\xa7\xba\x2e\x8a\x58\xcb\x84\x23\xd7\xc4\x63\xf4\x1c\x74\xb3\x1f\x3b\xec\x13\xf8\xb0\x53\x5e\x73\xf9\xbd\x5b\x47\x6b\x39\x8c\x05\xe7\xe5\x6c\x41\x11\xd4\x4a\x5f\xe6\x71\xec\x9f\x28\x9d\x6d\xf2\x53\x96\x29\x7d\xa2\xcd\x48\xe4\xb0\xcf\xd6\x57\x1d\x3d\xdf\xeb\x99\xdc\x62\x5d\x2f\x68\x0e\xc4\x2f\x06\x1e\x5c\xcd\xe3\x7a\x65\x8c\x94\x31\x8f\x07\x18\x85\xce\x87\x8c\xcf\x0a\x61\x1f\xde\x2d\x33\x39\x76\xfc\xcc\x98\x9d\x26\x8b\xb3\x81\x3f\xdb\x5c\xce\x86\xa1\xaf\x4f\x6d\x4d\xd7\x73\x99\x5b\x89\xd2\xb1\x7a\x91\x12\x87\x6e\x55\x6d\x25\x5d\xad\x8b\xda\x4a\x4a\xdf\x57\xcd\x56\x52\xde\x5c\xc7\x56\xd2\xd8\xa9\x78\x6d\x4f\x51\x43\xed\xda\xae\x26\xaf\xa2\xb5\x07\x60\x70\xaa\x9a\x35\xe9\xb0\xcf\xf7\x59\x8e\xa5\xf7\x8e\xc9\x94\x1b\xef\x74\x93\xaa\x82\xa2\x47\x15\xe7\x01\xb9\xf7\x96\x71\x1e\x90\x39\x56\xc8\x79\xc0\xa0\x63\x15\x67\xa5\xe2\xc6\x62\xce\x2a\xcd\x5e\x13\x63\x4a\x23\x9d\xc9\xd3\x4c\x8c\xd4\x61\x9f\xeb\xc4\xc8\xe9\x85\xf8\x19\x6b\x2c\x91\xab\x9f\x3d\x5a\xb6\x9c\x1b\x2c\x1c\x59\x29\x57\x43\xdb\x2d\xbc\x17\xfa\xed\x9b\xbe\x6e\x24\xb6\xd5\xbc\xfd\xd1\x89\x65\xce\x45\x89\x4c\x46\xd0\x86\xc6\x6d\x2e\x32\xb3\x12\xdd\x55\x17\x99\xf4\x82\x42\xdb\x00\x55\xf0\xc8\x13\xe9\x05\x9d\x9e\xa2\x53\xc1\x2e\xcf\xb4\x2d\x80\x80\xc4\x1a\x08\xea\x46\xd2\x85\xce\x2f\x05\xca\xe8\x14\x54\x8b\x11\xac\x8d\x59\xbb\x5e\xec\x62\x55\x57\x83\x60\x4e\x93\x77\xdb\x63\x94\x83\x9c\xc4\x1c\x4a\x4b\x6a\x5e\x9d\xd0\x85\x2f\xb9\x99\x91\xfc\xfc\xff\x5f\xbf\xf4\xcc\xc8\x04\x76\x9a\xe0\x18\x50\x4a\x57\xc0\x54\xf3\x28\xdd\xe3\xd5\x57\xd3\x78\x6a\xdb\x76\x18\xa9\xd2\x56\x3f\x7f\xf3\xd2\xc6\x37\x36\x42\xf9\x1b\x97\x03\x37\x69\x58\x57\xcc\xdb\xb5\x93\x2c\x2a\xf6\x59\xdd\x99\xae\x28\x86\x5b\x88\xa3\x08\x94\xa9\x03\xe5\x5a\x0a\x86\xec\x46\x06\x09\x49\xe3\xca\xdf\x36\xdb\x4d\x75\x18\xab\x85\x23\x0b\x30\x76\xf2\x76\x2f\xf3\xc3\x4a\x57\xa5\x3f\x8e\x08\x9c\x47\x4a\xe4\x9e\x4a\x29\x4e\x93\x11\x49\x2c\xf4\xb9\xfa\x83\x63\x17\x12\x58\xe0\x8c\xe9\x23\x29\x63\x67\xa5\xf0\xc6\x35\x3e\x96\x72\x5b\x8a\x4c\xaa\xd6\x6f\x0a\xfe\x82\xf9\x52\x88\xa7\x93\x0c\xc1\x8b\xc3\x3e\xd7\x31\xc8\xe9\x85\x47\x0f\x12\xb9\x8d\xc2\x8e\xc7\x86\x42\x0f\x01\x53\xd9\x21\x6d\x4e\x5e\x64\x35\x4f\x7b\x0a\x5d\x37\xae\xf1\xa3\x6b\xdb\x63\x7a\xb5\x60\x63\x2b\x26\xcd\xcf\xe0\x55\x56\x4d\xd6\x2e\x7f\x05\x3f\x53\x36\xf9\x38\x99\x79\x56\x4e\x36\x38\xe2\x2d\x3d\x4d\x2e\x2b\xa6\x67\x9b\xc4\x8a\xa9\xde\x6c\x6c\x09\x5d\x2c\xd0\x92\xc6\x4b\x46\xe3\x65\xeb\x97\xe1\xb7\x54\x17\x5b\xdb\x1b\xba\x58\xdc\x15\xf2\x3d\xed\x49\x0c\x41\xb3\x1f\xb1\xe4\x18\xe5\x75\xa7\x4d\x43\xe3\x1e\xaf\x6e\xa9\x36\x2c\xcc\x5b\x5c\x9f\xad\xc8\x81\x16\xf3\xab\x09\x12\x47\x1a\x64\x6b\x7d\xe3\x8d\x44\x57\xc5\x0d\x87\xe9\x5d\x75\x87\x87\xe8\xe3\x5a\xe2\x08\x61\x19\x37\x06\x19\xa3\xe4\x76\x3c\x92\xf1\xe9\xde\x08\xdb\xfe\xda\x97\xf8\xd0\x1c\x84\x0c\x97\x3c\xf2\x84\xee\xe9\x7e\x9f\x77\x83\x8e\x86\x5d\x9d\x3d\x58\x98\xf6\x3d\x93\x88\x98\xe0\x1e\x96\x18\x9b\xe6\x3d\x53\x48\x33\xc6\xda\x33\x98\x66\x8c\xf5\x4c\x20\x8e\xda\xab\xbf\x1d\x7b\x29\xaf\x0e\xf6\xef\x8f\xe8\x4c\xc4\xde\x11\x9d\x89\xf8\xd2\xc9\xfc\x53\xc1\xdd\xb0\x74\x3f\x4b\xd1\xe6\x77\x8f\x26\x22\x6e\xfa\x29\x3c\x67\xca\x8b\xf6\x81\xe0\xda\xf5\x72\xdb\xec\x19\xe4\x3a\x98\x63\x45\x23\x67\x80\xe1\xf6\xca\x49\x62\x1e\x43\x60\x28\xc4\x36\x7e\x5e\x14\x47\x00\x2d\x4b\x55\xce\xda\xb8\xc3\x7f\x35\x74\x76\xf3\x56\x57\x93\x7d\xb1\xb0\xf8\x0d\xea\xd2\x57\x65\xf4\xeb\x2b\x3f\x55\x22\xd5\x9b\x9f\x88\xd8\xd7\x76\x9d\x4a\xd8\xda\xf5\xa0\x92\xc5\x7e\xbb\xc3\xa3\xa4\x7b\xef\xfa\xcd\x1a\x6a\xc7\x83\xcb\xff\x2b\xfe\x93\xff\x9b\xff\x73\xf0\xeb\xea\x0b\x21\xcc\xfd\xbc\xfd\x79\xf5\x7f\x0f\x00\x0e\xac\x75\x1c\x36\x5e\x00\x00"
 
 func adminConfigTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -332,12 +396,12 @@ func adminConfigTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "admin/config.tmpl", size: 22345, mode: os.FileMode(0644), modTime: time.Unix(1583516842, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x22, 0x47, 0x55, 0xc9, 0x2e, 0xfc, 0x5, 0x18, 0x5d, 0xad, 0x4a, 0xd9, 0x87, 0xee, 0x8f, 0x45, 0x73, 0x21, 0xde, 0x56, 0xa2, 0xcc, 0x8c, 0x95, 0x93, 0x14, 0xac, 0x55, 0x93, 0x35, 0x56, 0x2a}}
+	info := bindataFileInfo{name: "admin/config.tmpl", size: 24118, mode: os.FileMode(0644), modTime: time.Unix(1792280139, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9a, 0xec, 0x4e, 0xd0, 0xe3, 0x32, 0x5d, 0x54, 0x49, 0xe4, 0x9b, 0xac, 0x43, 0x35, 0x18, 0xd9, 0x2c, 0x5, 0xc0, 0xd0, 0x8d, 0x5e, 0xa4, 0x4b, 0xa2, 0x64, 0xa, 0x5d, 0x2e, 0xa, 0x91, 0x98}}
 	return a, nil
 }
 
-var _adminDashboardTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x99\xdd\x72\xdb\xb6\x12\xc7\xaf\x95\xa7\xe0\xf0\x5e\xe2\x24\x27\xe7\x9c\x4e\x87\x56\xc7\xf1\x4c\xec\x4c\x6d\x27\x13\x39\xc9\x25\x67\x09\xac\x48\x54\x20\xc0\x02\x0b\x25\xaa\xeb\x77\xef\x10\x24\x6d\x89\x74\x23\x52\xb5\xe2\xde\xd8\x1a\x7c\xec\x6f\xff\xc0\x02\xbb\x90\x6e\x6f\x09\x8b\x52\x02\x61\x10\xa6\x60\x31\xca\x11\x78\x18\xcc\xee\xee\x5e\xc4\x5c\xac\x03\x26\xc1\xda\x93\x10\x78\x21\x54\xc0\xc1\xe6\xa9\x06\xc3\xc3\xf9\x8b\xc9\x76\xb7\x13\x01\xd3\x8a\x40\x28\x34\x55\x5f\xb7\x33\x33\xc2\xcf\x99\x4c\xb6\x79\xde\x68\xa4\x60\x9d\x82\xa9\x91\x93\xdd\x99\xf4\x15\xe5\x1a\x83\xaf\x82\x63\xc0\xb4\x74\x85\xf2\x18\x54\x54\x1b\x9b\xf4\xbc\x07\x89\x86\xee\x6d\x4d\xe2\xfc\xf5\x96\x17\xa4\xcb\x00\x88\x80\xe5\xc8\x83\x4a\x67\xe3\xac\x37\x34\x13\x2f\x7f\x52\xb3\x1b\xd3\xb8\x35\xbb\xd7\x3a\x4b\x9d\x90\x3c\x11\x6a\xa9\xc3\xd6\x6c\x94\xbf\xae\x27\x76\x74\xde\x5b\x27\x48\x25\x06\x16\xb3\xe2\xc1\xd9\x49\xcc\x65\x3b\x96\xcb\x69\xae\x8d\xf8\xa3\x5a\x34\x19\x78\xe2\x74\xa7\xad\x9d\x33\x89\x39\xcd\xbf\xe7\x1d\x94\x65\xb2\x46\x13\xde\xdd\xc5\x11\xa7\x87\x59\x7c\x7e\x7b\x7b\x5a\x96\x9f\xd1\xf8\x1e\x3e\xd4\x5e\x26\xa8\xb2\x67\x85\x56\x8f\xda\x9c\x9d\x0b\xfa\x5c\xf7\x8f\x34\xac\xf7\xd8\xd5\x07\x99\xad\x77\x87\x44\x81\x8f\x9b\x7d\x53\xf5\xdf\x88\x02\x0f\x31\xcb\x74\x51\x08\x7a\xc4\x70\xf3\x71\x72\x7b\x2b\x96\x41\xcd\x38\xf3\x63\x9b\x08\xf1\xe3\x20\x20\x30\x19\xd2\x49\x98\xa4\x12\xd4\x2a\x0c\x72\x83\xcb\x93\x30\x27\x2a\xed\xcf\x51\x94\x09\xca\x5d\x3a\x63\xba\x88\x32\x9d\xd9\xfa\x4f\x8d\x8c\x5a\xcf\x5b\xab\xe1\xbc\xdb\x12\xc4\xa2\x8d\xa6\x25\x04\x4b\x98\xe2\x37\x42\xa3\x40\x4e\xa5\xa8\x58\x60\x04\x4c\x73\xc1\x39\xaa\x93\x90\x8c\xc3\x70\x1e\x47\x62\x1e\x47\xb0\xe5\x3d\x4a\x8b\x5b\x2e\x5f\x47\xa7\x5b\x7d\x8a\xdf\x77\x6d\x2d\x5d\x1c\x71\xd9\x04\x7f\xc4\xc5\x7a\xfe\xe2\xe9\x4e\x9a\x25\x20\x61\x49\xb0\xe1\x07\xad\x7b\xc4\xca\x56\xdc\x20\x4e\x7d\xaa\x83\xd9\x82\x80\xec\xec\x4c\x3b\x45\x68\x66\x9f\x2c\x9a\x6e\xdb\x7b\x93\x75\x9b\x3e\xb8\x54\x0a\xf6\x2b\x6e\xba\x1d\x1f\xb1\xd4\xdd\xb6\x2f\x40\x2c\xef\x36\x2e\x08\x7a\xa0\x53\x46\x42\xab\x7e\x2b\x43\x6b\xbb\xad\xef\xac\x75\xd8\x6d\xac\xe2\x03\x15\xf5\x04\x80\xa3\x9e\x03\x6f\xb5\x94\xfa\x6b\xb7\xf5\x4a\x18\xa3\x7b\x8e\x7d\x44\x89\x60\x7b\xb8\x4b\x9d\x09\xb5\xd0\xce\xb0\x5e\xd7\x17\x4c\x73\xad\x57\x7d\xf3\x12\x2d\x69\xd5\x37\x05\x29\xca\x6e\xe3\x85\xd6\xab\x1b\xb0\x3d\x2b\x37\x08\x45\x6f\xe3\x4a\x0e\x84\x8f\x8d\x3e\xf5\xf1\xe2\xd7\xe5\xcf\x60\x41\xe6\xd5\xc5\xcd\xd5\x65\x1b\xdd\x71\x54\x1e\x29\xa0\x75\x89\x06\xaa\xfd\xb4\x7b\x23\xda\x29\x4b\xc0\x56\x3e\x6f\xec\x49\x23\x75\xeb\xe3\x53\xd7\x68\x36\x41\x0a\x56\xb0\x7a\xf2\x43\x1e\xa1\x54\xf3\xcd\xfd\xd1\x8f\xc9\xcc\x1f\x6e\x2a\xe2\xdf\xbd\x0c\x39\x4a\x24\x4c\x84\x02\x46\x62\x0d\x84\x09\x30\x56\xad\xac\xf5\x57\x23\xf1\x5d\x53\xdd\x8b\x89\x81\x41\x9a\xda\xdf\x1d\x18\x9c\xea\xa9\x11\x59\x4e\xf5\x65\x14\xc4\xd0\xdc\x89\x3e\x59\x2d\x5c\xfa\xe9\xe3\xe5\xdd\x5d\xe4\x1d\xf8\x45\x97\x27\x2f\xc3\xef\x3a\x76\xbf\xbe\x89\x71\x75\x5a\x81\xf9\x8e\x3f\x71\xb4\xa5\xf3\x10\xcd\x06\x4b\x9d\x80\x61\xb9\x58\xe3\xd1\xd5\xbe\x7a\x6e\xb5\x85\xb0\x56\xa8\xcc\xab\x3e\xba\xda\xff\x3c\x9b\xda\xaa\xc6\xc9\xd8\x8f\x51\xf9\xfa\xd9\x54\x1a\xb4\x1b\xc5\x12\x90\x32\xb1\x36\x5f\xe1\xe6\xe8\x5a\xff\xfb\x6f\xd0\x5a\xa5\x9c\xa3\x2b\xfd\xdf\x33\x2a\x15\x4a\xd0\x8f\x3d\xa9\xff\x7f\x42\xb5\x71\xb4\x9d\x88\xe2\xc8\x67\xa9\x63\xd5\x93\x1b\x4b\x58\x24\x55\xb9\xe7\xf6\x67\xe0\x67\x78\xbc\x59\x34\x6b\x34\x89\x2b\xff\xfe\xfd\xb2\xd8\xd8\x85\xf7\x7f\xf6\xc9\x8f\x1a\xf7\x8c\x61\xce\x18\x54\x94\x64\xda\x68\x47\x42\xed\x85\x5c\xbb\xe2\xbc\x1d\xdb\x45\xed\xac\x16\x17\x6b\xe1\xb7\xa1\xd9\xb5\x71\xfe\x14\x58\x68\xb3\x49\x9c\x85\x6c\xaf\x4b\x57\x58\x9c\x4a\xa9\x19\x10\xf2\x71\xea\x49\x13\xc8\x96\x05\xad\x8d\x01\xbc\x9b\x6a\xe2\x38\x56\x43\xd1\xa9\xff\x16\x64\x08\x64\xb1\xb1\xe3\x10\xa5\x16\x55\x31\x9b\x48\xad\x57\xae\xf4\x6f\x5e\xbb\x8f\x73\xe9\xc7\x8e\x04\x75\x56\x6c\x18\xe9\x0a\x8b\x2b\x3f\xe3\x30\xd8\xd2\xe0\x70\xd0\x5b\x83\xd8\xc3\x3c\x61\x78\xe6\x08\xe5\xb0\xe0\xbc\x40\x28\x7d\x74\x8e\x13\xed\x01\x23\x43\xa6\x42\x8d\x8e\x99\x6d\x90\xe0\x72\x90\x9e\x77\x5c\x8e\xbc\x66\x76\x28\x2a\x71\x76\x18\x47\x39\xfb\x0f\x40\xa6\x7e\x90\x0e\x5a\xb7\xe6\xf1\x3a\xf2\xfe\xf0\x34\x9d\xfe\x86\x8c\xf6\x46\x65\x45\x79\x5f\x0f\x3d\x42\x60\xa6\x5a\x93\x25\x03\x65\xe2\x9f\x7a\xc3\x62\x73\x51\x0d\x3d\x60\x91\x6b\xc4\xc8\xe8\xf4\xb0\xd1\xe1\x59\xd8\x12\x54\x62\xc9\x38\x46\xce\xa0\x1d\x98\x11\x16\x25\xa8\x03\x84\xf5\x68\x47\x3d\x7a\x05\xab\xca\x89\xf1\xe2\xce\xaa\x69\x87\xa8\xeb\xf1\x06\x27\x23\x8f\x1c\x9f\x8f\x8c\x5e\x0a\x59\x55\xa1\xa9\x63\x2b\xa4\x24\x07\x9b\x27\xbe\x7a\x1a\x8c\x7e\xe3\xd8\xea\x02\x6c\x3e\x1a\x9e\xb1\xa4\x40\x02\x0e\x04\x83\x61\xe7\x67\xa3\x31\x9a\x72\x34\x49\x53\x48\x36\x09\xb1\xaa\x74\x87\x22\xdf\x57\xf3\x1f\xa1\x3e\xc1\x9d\xa0\xf0\x5b\xf3\x8c\x65\x1b\xb6\xff\x66\xbf\xc6\x6f\x74\x7e\x36\x4e\xbd\x04\xeb\x11\x43\xca\xd3\x4b\xb0\xa3\xed\xd7\x05\x5a\xc6\x92\x12\x06\xe4\x8c\x0f\xd5\x20\x5f\x9a\x5d\x8f\xdc\xc5\x56\xc7\x70\xcc\x58\x42\xb3\x48\x7b\xd3\x44\x55\x5f\xef\x2e\x52\xef\xfb\xf4\xed\x4f\xed\x87\xe6\x7f\xf3\xaf\xf7\xb3\xd7\x52\x6b\xc2\xe6\x37\xb4\xbf\x02\x00\x00\xff\xff\xf9\x8e\x33\x0d\xd2\x1b\x00\x00"
+var _adminDashboardTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x99\x6f\x6f\xdb\xb6\x13\xc7\x1f\xbb\xaf\x42\xd0\x73\x5b\x68\x7f\xfd\x6d\xc5\xa0\x78\x48\x03\x34\x29\x96\x3f\x45\x9d\xb6\x0f\x85\x13\x79\x96\x38\x53\xa4\x46\x9e\x9c\x78\x9e\xdf\xfb\x20\x4a\x4e\x6c\xc9\x8b\x25\xa7\x6e\xfa\xa4\x71\x49\xde\x7d\xee\x4b\x1d\x79\xa4\xb4\x5c\x12\x66\xb9\x04\x42\xcf\x8f\xc1\x62\x90\x22\x70\xdf\x1b\xad\x56\xaf\x42\x2e\xe6\x1e\x93\x60\xed\x89\x0f\x3c\x13\xca\xe3\x60\xd3\x58\x83\xe1\xfe\xf8\xd5\x60\xb3\xbb\x10\x1e\xd3\x8a\x40\x28\x34\x65\x5f\xb3\x33\x31\xc2\xd9\x0c\x06\x9b\x3c\xe7\x34\x50\x30\x8f\xc1\x54\xc8\xc1\xb6\x25\xdd\xa1\x9c\xa3\x77\x27\x38\x7a\x4c\xcb\x22\x53\x0e\x83\x8a\x2a\x67\x83\x56\xf4\x20\xd1\xd0\x83\xaf\x41\x98\xbe\xdd\x88\x82\x74\xee\x01\x11\xb0\x14\xb9\x57\xea\xac\x83\x75\x8e\x46\xe2\xf5\x3b\x35\xba\x35\x75\x58\xa3\x07\xad\xa3\xb8\x10\x92\x47\x42\x4d\xb5\xbf\x76\x1b\xa4\x6f\x2b\xc3\x86\xce\x07\xef\x04\xb1\x44\xcf\x62\x92\x3d\x06\x3b\x08\xb9\x5c\x8f\xe5\x72\x98\x6a\x23\xfe\x2e\x27\x4d\x7a\x8e\x38\xdc\x6a\x5b\xdb\x0c\x42\x4e\xe3\xa7\xa2\x83\x3c\x8f\xe6\x68\xfc\xd5\x2a\x0c\x38\x3d\x5a\xf1\xf1\x72\x79\x9a\xe7\x5f\xd1\xb8\x1e\xde\xd5\x5f\x22\xa8\xf4\x67\x85\x56\x3b\x7d\x8e\xce\x05\x7d\xad\xfa\x7b\x3a\xd6\x7b\xfc\xea\x83\xdc\x56\x4f\x87\x44\x86\xbb\xdd\xbe\x2f\xfb\x6f\x45\x86\x87\xb8\x65\x3a\xcb\x04\xed\x70\x5c\xff\x1c\x2c\x97\x62\xea\x55\x8c\x33\x37\xb6\xce\x10\x37\x0e\x3c\x02\x93\x20\x9d\xf8\x51\x2c\x41\xcd\x7c\x2f\x35\x38\x3d\xf1\x53\xa2\xdc\xfe\x16\x04\x89\xa0\xb4\x88\x47\x4c\x67\x41\xa2\x13\x5b\xfd\x53\x21\x83\xe5\x72\xdb\xab\x3f\x6e\xb6\x78\xa1\x58\x67\xd3\x14\xbc\x29\x0c\xf1\x9e\xd0\x28\x90\x43\x29\x4a\x16\x18\x01\xc3\x54\x70\x8e\xea\xc4\x27\x53\xa0\x3f\x0e\x03\x31\x0e\x03\xd8\x88\x1e\xa5\xc5\x8d\x90\xaf\x83\xd3\x8d\x3e\xc5\x1f\xba\x36\xa6\x2e\x0c\xb8\x1c\xd7\xcb\x80\x8b\xf9\xf8\xd5\xf7\x5b\x69\x96\x80\x84\x25\xc1\xba\x2f\xb4\xe6\x12\xcb\xeb\x1f\xdd\x38\xd5\xaa\xf6\x46\x13\x02\xb2\xa3\x33\x5d\x28\x42\x33\xfa\x62\xd1\x34\xdb\x6e\x4c\xd2\x6c\xfa\x54\xc4\x52\xb0\x3f\x70\xd1\xec\xf8\x8c\xb9\x6e\xb6\x7d\x03\x62\x69\xb3\x71\x42\xd0\x02\x9d\x32\x12\x5a\xb5\x5b\x19\x5a\xdb\x6c\xfd\x68\x6d\x81\xcd\xc6\x32\x3f\x50\x51\x4b\x00\x14\xd4\x0a\xe0\x83\x96\x52\xdf\x35\x5b\xaf\x84\x31\xda\xb4\x55\x49\x04\xdb\xc2\x5d\xea\x44\xa8\x89\x2e\x0c\x6b\x75\x7d\xc3\x38\xd5\x7a\xd6\x76\x2f\xd1\x92\x56\x6d\x57\x10\xa3\x6c\x36\x5e\x68\x3d\xbb\x05\xdb\xf2\x72\x8b\x90\xb5\x1e\x5c\xce\x81\x70\xd7\xe8\x53\x97\x2f\x6e\x5e\xfe\xf1\x26\x64\xde\x5c\xdc\x5e\x5d\xae\xb3\x3b\x0c\xf2\x23\x25\xb4\xce\xd1\x40\xf9\x3c\xed\xde\x8c\x2e\x94\x25\x60\x33\x57\x37\xf6\x94\x91\xaa\x75\xb7\xe9\x1c\xcd\xc2\x8b\xc1\x0a\x56\x19\x3f\xd6\x11\x8a\x35\x5f\xac\xff\x37\x08\xc9\x8c\x1f\x77\x2a\xe2\x4f\x6e\x86\x1c\x25\x12\x46\x42\x01\x23\x31\x07\xc2\x08\x18\x2b\x67\xd6\xba\xad\x91\xf8\xb6\xab\xe6\xc6\xc4\xc0\x20\x0d\xed\x5f\x05\x18\x1c\xea\xa1\x11\x49\x4a\xd5\x66\xe4\x85\x50\xef\x89\xae\x58\x4d\x8a\xf8\xcb\xe7\xcb\xd5\x2a\x70\x01\xfc\xae\xf3\x93\xd7\xfe\xb8\xd3\xfc\x46\xa6\xa8\xca\x0a\x8c\xb7\xe2\x09\x03\x32\xcf\xd2\x6c\x30\xd7\x11\x18\x96\x8a\x39\x1e\x5d\xed\x9b\x97\x56\x9b\x09\x6b\x85\x4a\x9c\xea\xa3\xab\xfd\xdf\x8b\xa9\x2d\xcf\x38\x09\xfb\x31\x2a\xdf\xbe\x98\x4a\x83\x76\xa1\x58\x04\x52\x46\xd6\xa6\x33\x5c\x1c\x5d\xeb\xff\x7f\x06\xad\x65\xc9\x39\xba\xd2\x5f\x5e\x50\xa9\x50\x82\x7e\xec\x4a\xfd\xf5\xc5\xd4\xb2\x14\xd9\xac\xda\x84\x6d\x59\xd0\x8f\xad\xf4\xdd\x77\x54\x1a\x06\x9b\x25\x37\x0c\x5c\x3d\x3e\xd6\xc9\x79\x61\x09\x33\x37\x47\x85\xfd\x19\xaf\xa9\x16\xcd\x1c\x4d\x54\xe4\xff\x7d\x53\x9b\x2c\xec\xc4\xc5\x3f\xfa\xe2\x46\xf5\xbb\xb0\xb1\xc2\x18\x54\x14\x25\xda\xe8\x82\x84\xda\x0b\xb9\x2e\xb2\xf3\xf5\xd8\x26\x6a\x6b\xb6\xb8\x98\x0b\xf7\x18\xea\xa7\xd6\x2f\x9e\x0c\x33\x6d\x16\x51\x61\x21\xd9\x1b\xd2\x15\x66\xa7\x52\x6a\x06\x84\xbc\x9f\x7a\xd2\x04\x72\xcd\x82\xb5\x8f\x0e\xbc\xdb\xd2\xb0\x1f\xab\xa6\xe8\xd8\xbd\xef\xe9\x02\x99\x2c\x6c\x3f\x44\xae\x45\x79\x6c\x8f\xa4\xd6\xb3\x22\x77\xb7\x7b\xbb\x8f\x73\xe9\xc6\xda\x83\xb4\xac\x67\xac\x1b\xe9\x0a\xb3\x2b\x67\x71\x18\x6c\x6a\xb0\x3b\xe8\x83\x41\xb4\x47\x4c\xcf\x14\x21\xef\x96\x9c\x17\x08\xb9\xcb\xce\x7e\xa2\x1d\xa0\x67\xca\x94\xa8\xde\x39\xb3\x09\x12\x5c\x76\xd2\xf3\x91\x4b\x7c\x06\x45\x45\x85\xed\xc6\x51\x85\x7d\x06\xc8\x54\x57\xef\x4e\xf3\x56\x5f\xd3\xf9\x01\x34\x1d\xff\x89\x8c\x6c\x17\xca\x4d\x35\xf4\x08\x89\x19\x6b\x4d\x96\x0c\xe4\x91\xbb\xd4\x76\xcb\xcd\x49\x39\xf4\x80\x49\xae\x10\x3d\xb3\xd3\xc1\x7a\xa7\x67\x66\x73\x50\x91\x25\x53\x30\x2a\x0c\xda\x8e\x15\x61\x92\x83\x3a\x40\x58\x8b\x76\xd4\xa5\x97\xb1\xf2\x38\xd1\x5f\xdc\x59\x69\x76\x88\xba\x16\xaf\x73\x31\x72\xc8\xfe\xf5\xc8\xe8\xa9\x90\xe5\x79\x3b\x2e\xd8\x0c\x29\x4a\xc1\xa6\x91\x3b\x3d\x75\x46\xbf\x2f\xd8\xec\x02\x6c\xda\x1b\x9e\xb0\x28\x43\x02\x0e\x04\x9d\x61\xe7\x67\xbd\x31\x9a\x52\x34\x51\x7d\x90\xac\x0b\x62\x79\xd2\xed\x8a\xbc\x29\xed\x27\x8b\x63\xec\x09\x0a\xef\xeb\x0b\x3b\x5b\xb0\xfd\x3b\xfb\x35\xde\xd3\xf9\x59\x3f\xf5\x12\xac\x43\x74\x39\x9e\x5e\x82\xed\xed\xbf\x3a\xa0\x25\x2c\xca\xa1\x43\xcd\xf8\x54\x0e\x72\x47\xb3\x6b\x7b\x98\x8e\xee\x98\xeb\xfe\xe9\xd8\xe9\xf0\x52\x9e\xaf\xcf\x8e\x90\x0c\xa6\x50\xaa\x5c\x89\xe5\x4b\x1c\x2e\xa6\xd3\xdd\x81\x08\xc5\xf1\xde\x2b\x3f\x56\xdd\x3c\xbc\x83\x1d\x7d\xae\x4c\x3d\xbf\xb4\xf3\xfb\xc9\xde\xc4\x6e\xbd\x0f\xec\x47\xae\x4d\x9f\x01\x97\x3a\x39\x04\x2c\x75\xd2\x13\x7a\x07\x82\xd6\xd0\xad\x17\xd9\xbb\x3f\x0a\x6e\x40\xbf\x55\x96\xab\xd5\x53\x9f\x8d\x36\x7f\xad\x7f\xd4\x7f\xeb\x3f\xad\xaf\xbb\x53\xad\x09\xeb\x4f\xc5\xff\x0e\x00\xc7\x22\x12\xa2\xb9\x1e\x00\x00"
 
 func adminDashboardTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -352,8 +416,48 @@ func adminDashboardTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "admin/dashboard.tmpl", size: 7122, mode: os.FileMode(0644), modTime: time.Unix(1582104583, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x52, 0x41, 0xdf, 0x52, 0xc5, 0x59, 0xb8, 0x60, 0x95, 0x8b, 0x7d, 0x75, 0xb1, 0xc9, 0x27, 0x3f, 0xb6, 0xc9, 0xe2, 0xd3, 0x6, 0xee, 0xdc, 0xd6, 0x61, 0xf7, 0xc4, 0x83, 0xcb, 0xde, 0x9f, 0x9}}
+	info := bindataFileInfo{name: "admin/dashboard.tmpl", size: 7865, mode: os.FileMode(0644), modTime: time.Unix(1792278752, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x31, 0x2b, 0x3b, 0x87, 0x7f, 0xb2, 0x92, 0x3d, 0x6f, 0xa3, 0x20, 0x8c, 0xa9, 0xff, 0x19, 0x77, 0x42, 0x8, 0x22, 0x4d, 0x46, 0x77, 0x36, 0xe7, 0x4a, 0x61, 0xc2, 0xc9, 0x4c, 0xc5, 0x3b, 0x5b}}
+	return a, nil
+}
+
+var _adminLifecycleTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x57\x4d\x6f\xe3\x36\x10\x3d\xdb\xbf\x82\x20\x5a\xa0\x3d\x54\xea\xb6\xbb\x40\x0f\xb2\x81\x62\xb3\xdb\x2e\x90\x2d\x8a\x24\x7b\x36\x28\x71\x1c\xb1\x91\x48\x82\x1c\x29\x31\x54\xfd\xf7\x82\xfa\xa4\x64\xc5\x4e\xb2\x69\xd1\x93\x2d\x71\xe6\xcd\xbc\xc7\x99\x11\x59\x55\x08\xb9\xce\x18\x02\xa1\x31\xb3\x10\xa6\xc0\x38\x25\x41\x5d\xaf\x23\x2e\x4a\x92\x64\xcc\xda\x0d\x65\x3c\x17\x92\x64\x62\x0f\xc9\x21\xc9\x80\x6e\xd7\x2b\x7f\xb9\x10\x24\x51\x12\x99\x90\x60\xdc\xda\x7c\xf1\xd6\x08\xde\xbc\x5f\xf9\xf1\x1a\xd0\x50\xb2\x32\x66\xa6\x0d\xb9\x9a\x7a\xe2\x3d\x64\x25\x90\x7b\xc1\x81\x24\x2a\x2b\x72\xd9\x84\x01\x89\x2d\xd8\xea\x28\x7b\x96\x81\xc1\x01\x6b\x15\xa5\x6f\xbd\x2c\x50\x69\xc2\x10\x59\x92\x02\x27\x8e\x67\x97\x6c\x03\x14\x88\x37\xbf\xc8\xe0\xc6\x74\x69\x05\x23\xd7\x1e\x2b\x4c\xdf\xb6\xd6\x33\x72\x03\xa4\x85\xdb\x7c\xcc\x6d\x15\xe9\xed\x09\xd8\x80\x83\x4d\x68\x5d\x47\xa1\xee\xed\xf7\xca\xe4\x1e\xae\x7b\xa4\x84\x25\x28\x94\xdc\xd0\xaa\x0a\x2e\x85\xbc\xab\x6b\x4a\x72\xc0\x54\xf1\x0d\xd5\xca\x0e\xc1\x1c\x83\xf7\xd7\x57\x1f\x6f\xd4\x1d\xc8\xdf\x6f\x3e\x5f\x76\x59\x3b\x0d\xde\x79\xa0\x5c\x94\x82\x0b\x79\x3b\xf0\x3f\x95\x62\x61\xc1\xec\xb4\xca\x44\x72\x68\x32\x4d\xdf\xf5\xd1\x22\xdd\x63\xa6\x90\xe9\x27\xa3\xec\x8e\x48\x4f\xc4\x14\x32\x13\x12\xc8\x5e\x40\xc6\x07\x62\x47\x85\x96\x42\x72\x17\xab\x87\xd1\x60\x15\x09\xa9\x0b\x24\x92\xe5\xb0\xa1\x4d\x38\x90\x2c\xce\x80\x53\x82\x07\x0d\x1b\x3a\xf8\x90\xaa\x12\x7b\x12\x7c\xb1\x60\xfe\x6c\x32\x0a\x3e\xb4\x96\x75\xdd\xd8\x00\xaf\x2a\x90\xbc\xae\x3d\xf0\x8c\xc5\x90\x9d\x64\xd8\x47\x73\xc4\x5a\xeb\x21\xf7\x90\x8b\x72\xbb\x5e\x7a\x98\xd4\xb9\x6a\x49\xdb\x65\xd6\x33\x41\xba\x94\x5c\x81\x74\x74\x85\x74\x65\x52\xc2\x2e\x57\x12\x53\x7b\x7a\x3f\xe6\xc6\xc7\x59\xf7\x82\x0a\xfe\x08\xbe\x2f\xf5\xd1\x5a\x2b\xb9\x2c\xf2\x18\x0c\x25\xb9\x90\x1b\xfa\x86\x92\x92\x65\x05\xb8\x32\x56\x66\xa2\xff\xa7\xce\xfd\x73\xe3\x4d\xde\xfc\x54\xd7\x74\x59\xbe\xe7\x49\x72\x6b\x58\x02\x3b\xce\x0e\x67\xd4\xf0\xec\x9e\x20\x84\x67\xed\x6b\xe0\xbf\x5e\xa0\xff\xa3\x47\xdf\xe7\xfe\x9b\x73\xbb\x60\x07\xeb\x73\x7e\x56\x6f\x8d\x81\x77\xce\x18\xcc\xb4\xb9\x9e\x58\x7f\xf3\x86\x9b\x6b\x09\x0f\x90\x6b\x37\x86\xce\x68\xe9\xd9\x2d\xb4\x02\xc2\x03\x32\x03\x6c\x54\xd3\xb3\x9f\x34\xaf\xf7\xda\xa8\x7b\xbb\xa1\x3f\xd3\xed\x54\xb9\x0f\x83\x89\x0b\xd4\x23\x6f\xd7\x2f\x90\x70\x16\x72\x51\xc7\x4e\xb9\xaf\x9f\xa8\x06\xb4\xfa\xfa\x89\xea\xa1\xfc\x17\x13\xb5\x09\x77\x66\xa2\x5e\x81\x56\xff\xa3\x89\x7a\xa2\xa2\x1b\x36\xaf\x3c\x30\xbd\x31\xb1\x08\xef\x0b\xf9\x92\x79\xe9\xa9\x7b\x6a\x5e\xbe\x58\x8e\x7f\xa1\xc1\xe7\xb8\x93\x5a\x7a\xa4\xc1\xfd\x22\x7a\xbd\x06\x9f\x85\xec\x1b\x9c\xfc\x4d\xae\xd9\x1e\x96\xfa\xfc\xbc\x80\x71\x81\xa8\xe4\xe4\x84\x0b\x20\x49\xfb\xfa\xcc\xbc\xd1\x9c\x21\x34\xfa\xb5\xe6\x4b\xc1\xa3\xd0\x9d\xfe\xb6\xeb\xf9\xf0\x79\x8d\x03\x6d\xc0\x21\x11\xb6\xdb\xc4\xd3\x47\xdb\x42\x5a\x64\xc9\x9d\xeb\xc5\x31\x10\x36\x8f\xb3\xc3\x6e\x3b\x05\x2e\x7a\xe4\xf1\xf4\xd9\x5a\x2f\x43\x96\x60\x0e\x24\x66\x56\x24\xc4\xa2\x11\xba\x07\xf7\x84\x46\x47\x6b\x3b\x8e\x0e\x34\xe3\x83\x5b\x5d\x90\x5a\x2a\x14\x09\xd8\xc0\xf5\x55\x23\x33\xa6\x67\x7d\x46\x71\x6c\x11\xff\x05\x09\x3e\xdf\xb1\x57\xf5\xa9\x9e\xee\xbb\x63\x83\xc4\x00\x43\xe0\x47\x4e\x51\xe8\x31\x8d\xc2\xa9\x0c\x11\xc6\x8a\x1f\x46\xe3\xaa\x32\x4c\xde\xc2\x92\xfe\x47\x9a\xad\x22\xe4\xdb\x76\xb7\x3e\x59\xf7\x45\xad\xeb\xaa\xfa\x66\x31\x39\xea\x96\x20\xb3\xb0\x68\xe2\xda\xca\x0a\x54\x46\x40\x67\xe9\xa6\x7c\x14\x22\x9f\x47\x8b\x18\x49\x0d\xec\xdd\x30\xfb\x55\xeb\xeb\x22\xfe\x72\x75\x59\xd7\x61\x55\x05\xd7\xad\xd8\x7f\xb0\x1c\xdc\x18\x9b\xbf\x89\x42\xb6\x5d\x02\xf4\xb3\xf9\x4e\x1b\x21\x71\xff\xf8\x7e\xec\xbe\xb5\x74\x54\xe6\xfb\x47\x52\xb4\x9a\x0d\xed\xac\x95\x76\xdf\xf3\x42\x53\xc2\x19\xb2\x1f\xba\x3b\x67\x73\x7a\x7b\xdf\xee\x57\x5d\x77\x6b\x25\x33\x82\xb5\x37\x34\x21\x4b\x30\xe8\x6a\x58\xc8\x83\x23\x73\xc1\x10\x3e\xe6\x78\x9d\x2a\x83\x64\xf4\x8c\x42\x17\x6c\x4e\x6c\xb2\xe1\xab\x4e\x4d\xaf\x00\xfc\x1d\x8f\xc2\xa6\x4d\x86\xd6\x6b\xf7\x68\xbd\x7a\xca\xb5\x53\xaa\x9d\xdf\xff\xe3\xf0\xf3\x43\x8e\xa3\x68\xf8\xd7\xff\xe9\x7e\xbb\x9f\xa3\x0b\xf8\x5e\x29\x84\xee\x36\xff\xcf\x00\x6c\x15\x80\x92\x5c\x10\x00\x00"
+
+func adminLifecycleTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_adminLifecycleTmpl,
+		"admin/lifecycle.tmpl",
+	)
+}
+
+func adminLifecycleTmpl() (*asset, error) {
+	bytes, err := adminLifecycleTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "admin/lifecycle.tmpl", size: 4188, mode: os.FileMode(0644), modTime: time.Unix(1792274665, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x75, 0x44, 0xe1, 0x9f, 0x57, 0x8, 0x44, 0x41, 0x81, 0xbe, 0x19, 0xc9, 0x81, 0x17, 0xc8, 0x12, 0xc8, 0x50, 0x64, 0x7b, 0x93, 0x1d, 0x19, 0x8b, 0x65, 0x7d, 0x15, 0x73, 0x88, 0xe8, 0x67, 0xda}}
+	return a, nil
+}
+
+var _adminMaintenanceTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xcd\x6e\x9c\x30\x10\x3e\xb3\x4f\x61\xf9\xd4\x5e\x40\xad\x72\xe8\x01\x56\x4a\x9b\x44\x3d\x24\x97\x26\xf7\x68\xc0\xb3\x8b\x15\x33\x46\x66\xd8\x4d\xb4\xe2\xdd\x2b\x30\xbf\xd9\x84\xa4\x3d\x2d\xd8\x33\xdf\xdf\xd8\xec\xe9\xc4\x58\x94\x06\x18\x85\x4c\xa1\xc2\x28\x47\x50\x52\x84\x4d\xb3\x89\x95\x3e\x88\xcc\x40\x55\x25\x12\x54\xa1\x49\x14\xa0\x89\x91\x80\x32\x94\xdb\x4d\x30\x2f\xa8\xb5\xc8\x2c\x31\x68\x42\xd7\xee\xbd\xde\xdc\x3b\xad\xba\xf5\x60\xce\xd8\xc1\x46\x04\x87\x14\x9c\x27\x0d\x96\x9d\x7c\x44\x73\x40\x71\xd4\x0a\x45\x66\x4d\x5d\x50\x47\x83\xc4\x1e\x6c\x81\xd6\xe9\x07\x83\x8e\x47\xac\x20\xce\x2f\x66\x2a\xd8\x96\x02\x98\x21\xcb\x51\x89\xd6\x69\x2f\xb6\x03\x0a\xf5\xb7\x1f\x14\x3e\xb8\x5e\x56\x38\x77\x3b\xa0\x45\xf9\x85\xe7\x7d\x65\x6f\x04\xad\x70\x5f\x4c\xea\x82\xb8\xdc\xae\x02\x87\x0a\xab\x4c\x36\x4d\x1c\x95\xa3\x0e\xbd\x13\xe1\x3d\x03\x63\x78\x4d\x90\x1a\x54\x3d\xf9\x19\xe9\x11\x1c\x69\xda\x8b\x02\xab\x0a\xf6\x38\x70\x2e\x31\x7e\xbe\x8c\xed\x1f\x98\x0c\xd1\xd3\x3d\xa6\x2f\x72\xea\x16\x5f\xae\x80\xf1\xa6\xe0\x5b\x4b\xfb\x61\xf9\x5e\x53\x86\x5f\x27\xe0\xd3\x09\x4d\x85\xff\x4a\x34\xa4\xda\xd5\x23\xcd\x7c\x46\x4a\x1f\xb6\x9b\xf3\x9d\x78\x67\x5d\x31\x4b\xa0\x7d\x95\x02\x32\xd6\x96\x12\x79\x3a\x85\xb7\x9a\x9e\x9a\x46\x8a\x02\x39\xb7\x2a\x91\xa5\xad\xc6\x59\xb4\xa2\x7e\xdd\xff\xb9\x79\xb0\x4f\x48\xbf\x1f\xee\x6e\xdf\xcc\x75\xa7\xd1\x28\xe1\x13\xbc\x76\xee\xf1\xce\x87\xdb\x34\xe8\x9c\x75\xbd\x9a\x29\xea\xd8\x40\x8a\x46\xec\xac\x4b\xe4\x38\x87\x75\xf7\x43\x59\x3b\xf5\xae\x7b\x02\x63\x7c\x66\x70\x08\x42\xab\x09\x4e\x10\x14\x38\x7b\x75\xf6\x58\x25\xf2\xbb\x14\xa5\x81\x0c\x73\x6b\x14\xba\xce\xfc\x15\xee\xa0\x36\x3c\x2a\x96\xed\xd9\xf3\x03\x1b\xd7\xe2\x68\xe0\x18\x58\xe7\x61\x2f\x92\xd0\x64\x34\xa1\xe8\x02\x99\x19\x9e\x55\xb4\x97\x3e\xc7\xec\x29\xb5\xcf\x53\x41\x10\x6b\x2a\x6b\xee\x55\x83\x31\xf6\xf8\xd8\x85\x50\x49\xc1\x2f\x25\x26\x72\xec\xe9\x63\xf6\x12\x2f\xdb\xca\xcb\xae\xb0\x69\xba\x12\x54\x7d\xdc\x33\x6c\x1f\xd8\x7a\xc0\x0b\xce\x37\x52\x5e\x18\x7e\xcf\xfd\x2b\xdb\x6b\xd7\x32\x08\xe2\xb4\x66\xb6\x34\xcb\x65\xef\x10\x49\xf8\xe5\x61\x82\xfe\x9c\x4a\x71\x00\x53\x63\x22\xfd\x75\xfb\xe8\xb4\xd4\xa5\x02\xf6\x87\xc5\xa3\x6d\x57\x58\x1d\xaa\x75\x4e\xa5\xab\xcf\x90\x0e\x65\x6f\xb0\x9e\x5d\xf5\xff\x50\xf1\x39\xe7\x48\x2b\x1a\xde\xf9\x5a\xc4\x51\xfb\x49\xd8\x6e\x96\xeb\xe3\xd3\xf0\xd0\xff\xf6\x3f\x67\xff\x21\x3b\x6b\x19\x9d\x14\x61\xd3\x6c\xfe\x0e\x00\xae\x17\x09\x78\x21\x07\x00\x00"
+
+func adminMaintenanceTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_adminMaintenanceTmpl,
+		"admin/maintenance.tmpl",
+	)
+}
+
+func adminMaintenanceTmpl() (*asset, error) {
+	bytes, err := adminMaintenanceTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "admin/maintenance.tmpl", size: 1825, mode: os.FileMode(0644), modTime: time.Unix(1792250907, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf7, 0x9c, 0x85, 0x65, 0x78, 0x23, 0x10, 0xc7, 0xe7, 0xb8, 0x6d, 0xd6, 0xfa, 0x6a, 0xf9, 0x80, 0xd3, 0x79, 0xe2, 0xe6, 0x8a, 0x3c, 0x29, 0x3b, 0x29, 0x95, 0x2f, 0x16, 0xc8, 0x15, 0xb6, 0x88}}
 	return a, nil
 }
 
@@ -377,7 +481,7 @@ func adminMonitorTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _adminNavbarTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd4\x4d\x6b\xb4\x30\x10\x07\xf0\xf3\x3e\x9f\x22\xf8\x01\x94\xe7\xd6\xc3\x76\x61\x69\x2f\x85\xbe\xb1\xed\x9e\xcb\xac\x19\x75\x40\x13\x99\x24\x16\x2a\xf9\xee\x25\xbe\x80\x4b\x09\xd5\x9e\x3c\xfc\xf3\x9f\xf9\x61\xc4\xbd\xa4\x4e\xe4\x35\x18\x73\x9b\x14\xda\xb1\xf8\x24\x89\x22\xd7\xb5\x6b\x54\x72\xf8\xb7\x5b\xe6\x8e\x44\x87\x6c\x29\x87\x5a\x34\xa8\x5c\xc8\xaf\x0e\x54\x08\x12\x59\x90\xc5\x26\x39\xf4\x7d\x4a\xff\x6f\x54\xfa\xce\x22\x01\xd9\x90\xfa\x68\x41\x61\x9d\x78\xbf\xcf\x24\x75\x43\x17\xe6\x66\xdf\x53\x21\xd2\x57\x28\xf1\xc1\x1c\xc3\xe1\x7b\x30\xd5\x45\x03\x4b\xef\x21\xb7\xd4\x61\xdf\xa3\x92\xde\x8f\xc3\x45\xc5\x58\x84\xd6\xb1\x6d\xdf\xdc\xe5\x7c\x7a\xf4\x3e\x1b\x96\x0c\xa6\xdd\x8f\xdd\xa9\x9c\xe7\x25\xde\x87\xcd\x19\xfc\x02\x38\x1b\x64\xb3\x71\x79\xe6\x42\x29\x46\x18\xc3\x95\xeb\x5f\xb8\x04\x45\x5f\x60\x49\xab\xcd\x0c\xcd\x65\x54\xa1\x97\x83\xd7\x6a\x4e\xd8\x6a\x43\x56\x33\xe1\x66\x0c\x87\x6e\x4c\xc3\x8b\xc1\x6b\x31\x47\x67\x2b\x54\xe1\x33\xfc\xd3\xcb\x01\x67\xab\xa8\x07\xae\x66\xaf\x15\xdd\x69\x55\x50\xb9\x15\x92\x0f\xad\x98\x64\x4a\x57\x0a\x9e\xb5\xa5\x7c\xfb\xdd\xa8\xb1\x16\x33\xcc\xf1\x4a\xc4\x93\x56\xe1\x2a\xb7\x22\x9a\xb1\x16\x43\xcc\xf1\x02\x31\xfd\x40\xa6\xc7\x77\x00\x00\x00\xff\xff\xac\x13\x49\x38\xc3\x04\x00\x00"
+var _adminNavbarTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\xd5\xcb\xaa\xdb\x30\x10\x06\xe0\xf5\xe9\x53\x08\xef\x6b\xd3\x5d\x17\xa7\x81\x34\xdd\x14\xd2\x0b\x49\xb3\x0e\x13\x69\x6c\x0f\xd8\x92\xd1\xc5\xa5\x35\x7a\xf7\x22\x3b\x26\x0e\x45\x54\xea\x2a\x8b\x7f\xfe\x99\x2f\x88\x90\x57\x41\x23\xe3\x1d\x18\xf3\xa1\xa8\x95\xd3\xec\x27\x09\x64\x5c\x75\xae\x97\xc5\xee\xcd\xcb\x36\x77\xc4\x46\xd4\x96\x38\x74\xac\x47\xe9\x42\xfe\x34\xd0\x22\x08\xd4\x8c\x2c\xf6\xc5\x6e\x9a\x4a\x7a\xf7\x5e\x96\x3f\x34\x2b\x40\xf4\x24\xaf\x03\x48\xec\x0a\xef\x5f\x2b\x41\xe3\xdc\x85\xb5\x39\x4d\x54\xb3\xf2\x3b\x34\xf8\xd9\xec\xc3\xf0\x27\x30\xed\x4d\x81\x16\xde\x03\xb7\x34\xe2\x34\xa1\x14\xde\x2f\xcb\x59\xab\xb1\x0e\xad\xfd\x30\x9c\xdd\xed\x72\x3a\x7a\x5f\xcd\x47\x66\xd3\xcb\x5f\xb7\x4b\xb1\xee\x2b\xbc\x0f\x97\x2b\xf8\x07\xe0\x62\x50\x9b\xcc\xe3\x95\x0b\xa5\x18\x61\x09\x13\xcf\x7f\xd3\x0d\x48\xfa\x0d\x96\x94\xcc\x66\x28\xdd\x44\x15\x6a\xbb\x38\x55\xf3\x51\xd9\x6c\xc4\x4d\xd9\x28\x62\xce\x12\x6f\x9f\x70\x50\x86\xac\xd2\x84\xd9\x06\x1d\xba\x31\x84\xde\x2c\x4e\xc5\xec\x9d\x6d\x51\x86\x9f\xc0\x7f\x3d\x0c\x38\xdb\x46\x3d\xf0\xb4\x3b\x55\x74\x50\xb2\xa6\x26\x17\xc2\xe7\x56\x4c\x72\x4f\x13\x05\x5f\x95\x25\x9e\xff\x36\x72\xa9\xc5\x0c\x6b\x9c\x88\xf8\xa2\x64\x78\xca\x5c\x44\xbf\xd4\x62\x88\x35\x4e\x45\x00\x49\x8b\x12\x24\xc7\x6c\xc8\xa3\x1a\xc5\x6c\x46\x12\x41\x47\xaa\x91\xff\xe2\x5d\x36\xa7\x5b\x8b\x31\xcc\x63\x20\x91\x72\x26\x8b\x07\x15\xbe\x80\xcd\xc5\x18\xb2\xf8\x96\x2f\xdd\x98\x27\xcc\x5c\xd7\x99\x0d\xe9\xfe\x5f\x73\xff\xf8\x33\x00\xd1\xf6\x83\xd4\xee\x06\x00\x00"
 
 func adminNavbarTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -392,8 +496,8 @@ func adminNavbarTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "admin/navbar.tmpl", size: 1219, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb2, 0xed, 0xc9, 0x32, 0xf7, 0xd, 0x84, 0x89, 0x56, 0x68, 0x88, 0xbc, 0x4d, 0x34, 0xc5, 0x5c, 0x80, 0xc2, 0x8c, 0xbc, 0x43, 0x95, 0x83, 0x47, 0xfc, 0xb8, 0x7e, 0xbe, 0x8, 0xc7, 0x3, 0x98}}
+	info := bindataFileInfo{name: "admin/navbar.tmpl", size: 1774, mode: os.FileMode(0644), modTime: time.Unix(1792278081, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x13, 0xc5, 0x90, 0x46, 0xa2, 0x5d, 0x30, 0x1d, 0x10, 0x7b, 0xfe, 0x5a, 0xfd, 0xc8, 0x93, 0x7b, 0x92, 0x95, 0x26, 0xbe, 0xc3, 0x47, 0x39, 0x5a, 0xa6, 0xb0, 0xc1, 0xe7, 0x31, 0x95, 0xbb, 0x86}}
 	return a, nil
 }
 
@@ -457,6 +561,26 @@ func adminRepoListTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _adminSite_contentTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x56\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\x62\xc0\x0d\x60\xfb\x10\xa9\x8b\x06\xc1\x76\x2b\x07\x58\xf4\x03\x7b\x48\x7b\xd8\xa4\x67\x63\x2c\x8e\x2c\x22\x14\x29\x90\x94\xb3\x81\xab\xff\x5e\x90\xb2\x1c\x49\xf1\xda\xeb\xed\xc9\x32\x39\xf3\xe6\xcd\x23\x67\x86\xbb\x9d\xa3\xaa\x96\xe8\x08\xd8\x1a\x2d\xa5\x25\x21\x67\x90\xb4\x6d\x9c\x71\xb1\x85\x5c\xa2\xb5\x4b\x86\xbc\x12\x0a\xac\x70\x74\x9d\x6b\xe5\x48\x39\x76\x17\x47\x43\x8b\x46\x80\xdf\x41\xa1\xc8\xf8\xbd\xe9\xe6\xc6\x08\x1e\xd6\xa3\x61\xc8\x80\x9b\x2a\xdc\xae\xd1\x74\x51\xa3\xb1\xa7\x7b\x26\xb9\x25\x78\x16\x9c\x20\xd7\xb2\xa9\x14\x0c\x08\x4c\xd0\x42\x02\x28\xc9\xb8\x03\x56\x94\x95\x37\x03\x16\x4e\xd7\x80\xce\x61\x5e\x12\x07\x9f\xea\x9e\x6c\x00\x4a\xc4\xfb\x0f\x2a\x79\x34\x7b\x5a\x89\x4f\x77\xd5\x47\xeb\xe1\xd2\xf2\xa6\x73\x98\xe4\x77\x40\xb5\xb4\xa9\x5e\xe9\x45\x59\x7d\x77\x1a\x39\xe1\x64\x73\xd6\xb6\x59\x5a\xf7\x2e\x85\x36\xd5\x00\xda\xff\x65\x80\xb9\x13\x5a\x2d\xd9\x6e\x97\xdc\x0b\xf5\xd4\xb6\x0c\x2a\x72\xa5\xe6\x4b\x56\x6b\x7b\x88\xe7\xf3\xf8\xed\xe1\xcb\x9f\x8f\xfa\x89\xd4\xe7\xc7\xbf\xee\xf7\xc4\xc7\x84\x0b\x41\x92\xc3\x6e\x27\x0a\x48\xfe\x30\x66\xf5\x59\x57\xf4\xb7\x76\x22\xa7\xb6\x25\x63\xb4\xd9\xed\x48\xf1\xb6\x3d\xa0\x46\x99\xc4\x35\x49\xcf\x65\xc9\x4a\x5d\xd1\x4a\x05\x73\x76\x2e\xbb\xa1\xad\x4f\x32\xc0\xbc\xa2\x3a\xfa\xea\xd0\x10\x82\xe0\x63\x5c\x50\x58\xd1\x64\xc9\xe8\x67\xbb\x64\x37\x0c\x2a\xfc\x2a\x49\x6d\x5c\xb9\x64\x37\x3f\xfd\x72\x1b\x48\x0c\x2c\x7d\x9c\x1e\xf8\x35\x94\xad\x51\xf5\xf9\x97\x24\xeb\x4b\xa8\xaf\xbc\x03\x99\x90\x81\xc7\xe9\x51\xb3\x94\x8b\xed\x5d\x7c\x4c\x61\xad\x1d\x99\x6b\x29\xd4\x93\x1d\xa8\x38\x3d\x83\xd7\xad\xbd\xc2\xe7\x48\x75\xb8\xab\x0e\xf7\x88\xa0\x1d\xa1\xa8\xbf\x0b\x06\xd5\x86\x20\x09\xe6\x87\x9b\x30\x2d\x31\x0d\x81\x8b\x85\x01\xe9\x01\xb1\x93\xac\xa3\x28\x13\xaa\x6e\xdc\xfe\xbc\xbc\xeb\x2a\x50\x62\xb0\x45\xd9\x50\x77\x61\xfd\x82\xbf\xb1\xb5\xc4\x9c\x4a\x2d\x39\x19\xbf\x71\x75\x32\xd5\x01\xd6\xf0\x26\x4e\x93\xbc\x9c\x5f\x63\x46\xec\xfe\xf9\x72\xff\x86\x5b\xe9\x5c\x6d\x3f\xa6\xe9\x89\xb0\x53\xa9\x43\xc1\xc4\x3f\x22\xf0\x49\xfe\xdf\x94\x77\xa2\xe5\x0f\x49\x39\x4d\xe9\x32\x22\x8d\x99\xd2\x38\x22\xdb\x38\xc4\xe4\x1f\x0e\x7b\xb3\x50\x2f\xb0\x46\x2b\x72\x58\x37\xce\x69\xc5\x42\x53\x40\xce\xaf\x87\xb2\x41\x69\xa8\x58\xb2\x77\x67\xcb\x17\x39\x0f\x65\x12\xaa\xe4\xff\xf4\x81\x61\xc9\xf5\x8d\x00\xfe\x85\x07\x2c\xe8\xfb\xfb\x41\x23\x80\x8b\xad\x08\x03\x67\x24\xc2\xc4\x28\x74\x5f\xa8\xc8\x5a\xdc\x10\x03\xeb\x5e\x24\x2d\x19\x17\xb6\x96\xf8\xf2\x11\xd6\x52\xe7\x4f\xc7\xfb\x49\x3f\xce\xce\x75\x35\x42\xbe\xb2\x4a\xd4\x35\xb9\x20\xcd\xe8\x40\xea\x4b\xdc\x57\xcf\x68\x94\x50\x9b\xd1\xf4\x8a\xbe\x99\xdd\x9b\xa1\x43\xc8\x1f\x3a\xa4\xef\x9a\x3a\x43\xe2\x17\x67\x79\x72\xee\x0c\x8d\xfb\xc1\x33\x5a\xeb\x26\xcf\xed\x68\xf2\xbc\xbf\xfd\xf9\xc3\xcd\xe4\xfe\x67\x36\x37\xa2\x76\x77\x49\x92\x64\xe9\xfe\xbb\x9b\x4e\x03\xb8\x63\xe3\xe9\xc4\x20\x19\xd7\x61\xd6\xd5\xc6\xe8\x65\x45\xa4\xfa\x92\x39\xa7\x4b\x53\x73\x74\xdd\x24\xee\x3c\x8e\x9d\x5a\x96\xfa\x27\xc7\x5d\x3c\x5e\x3f\x7c\xf5\x1f\xfb\xdf\xfd\x4f\xdc\xe7\x1e\x47\x69\x0a\x9f\x38\x07\x54\x40\x55\xed\x5e\xbc\x7a\xa0\x8b\x7d\xf3\x03\x5f\x47\x71\x74\x35\x9f\xbd\x9b\x94\xf6\x6c\x91\xe4\x52\xe4\x4f\xf3\xa2\x51\xe1\xb1\x03\x73\x5a\xc0\x2e\x8e\xa2\x2d\x1a\xb8\x92\x68\x1d\x2c\xe1\x6a\x3e\x4b\xc6\x4e\x7e\x63\xbe\xf8\x35\x8e\xa2\x60\x93\xe4\x52\x2b\x9a\x2f\x12\xa1\x2c\x19\xf7\xa9\x70\x64\xe6\x61\x67\x91\x14\x42\xf1\xf9\x2c\x34\xb2\xd9\x22\xd9\xa2\x9c\xcf\x66\xc1\x93\x92\xda\xd0\x96\x94\xfb\x9d\x0a\x6c\x64\x87\xd7\x2e\xe2\xc3\x29\xc6\x6f\x1e\x9a\x1d\x89\xee\xa5\xf9\xdf\x00\xcb\xde\x50\x94\x47\x0b\x00\x00"
+
+func adminSite_contentTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_adminSite_contentTmpl,
+		"admin/site_content.tmpl",
+	)
+}
+
+func adminSite_contentTmpl() (*asset, error) {
+	bytes, err := adminSite_contentTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "admin/site_content.tmpl", size: 2887, mode: os.FileMode(0644), modTime: time.Unix(1792278081, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfd, 0x79, 0x3b, 0x4f, 0xe6, 0x21, 0xfe, 0xf0, 0x6d, 0xa5, 0x62, 0x1, 0x58, 0x5a, 0x30, 0x3a, 0x22, 0xd3, 0x57, 0xdb, 0x54, 0xa5, 0x5b, 0x1d, 0x7f, 0xfb, 0x9a, 0x88, 0x32, 0x6, 0x57, 0x9f}}
+	return a, nil
+}
+
 var _adminUserEditTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x4b\x6f\xe3\x36\x10\x3e\x3b\xbf\x82\x15\x7a\x68\x0f\x96\x53\x60\x0f\x45\x21\x07\x28\xd2\x6c\x37\x40\x52\x04\xbb\x29\x7a\x14\x68\x71\x6c\x11\xa6\x48\x2d\x49\xd9\x09\x04\xfd\xf7\x82\x0f\xbd\x25\x3f\x16\x2d\xb0\x27\x9b\xc3\x19\xce\x7c\x1f\x67\x48\x6a\xca\x52\x43\x96\x33\xac\x01\x05\x1b\xac\x60\x95\x02\x26\x01\x0a\xab\xea\x26\x22\xf4\x80\x12\x86\x95\x5a\x07\x98\x64\x94\x23\x20\x54\xa3\x42\x81\x0c\xee\x6e\x16\xdd\xe9\x82\xa2\x44\x70\x8d\x29\x77\x73\xc3\xc9\x9d\xa4\xc4\xca\x17\x5d\x7f\x76\xd1\x15\xc7\x87\x0d\x96\xce\xe5\xa2\x6f\xa9\x8f\xc0\x0e\x80\x8e\x94\x00\x4a\x04\x2b\x32\x6e\xdd\x00\xd7\x6e\xb1\xc5\x28\x7a\xcc\x40\xea\x66\xad\x45\x94\x7e\xe8\x44\xa1\x45\x8e\xb0\xd6\x38\x49\x81\x20\x83\xd3\x07\x6b\x17\x0a\xe9\x2f\xbf\xf2\xf0\x55\xfa\xb0\x42\x03\x53\x85\x06\x71\x8c\x93\x44\x14\x5c\x07\xf5\xa2\xab\xf4\x83\x33\x1b\xa0\x6c\xd6\x56\xb0\xcb\xda\x20\x17\xd1\x56\xc8\xac\xa3\x67\x86\x01\xc2\x89\xa6\x82\xaf\x83\xb2\x0c\x9f\x28\xdf\x57\x55\x80\x32\xd0\xa9\x20\xeb\x20\x17\xaa\x31\x36\xa1\xdd\x7f\xf9\xfc\xf1\x55\xec\x81\x7f\x7a\x7d\x7e\xf2\x51\xf4\xbd\x53\xce\x28\x07\xb4\xa5\xc0\x08\x2a\x4b\xba\x45\xe1\x83\x94\xf1\xdf\x0a\xe4\x5f\x38\x83\xaa\x02\x29\x85\x2c\x4b\xe0\xa4\xaa\x9a\xa5\x17\x11\xc3\x1b\x60\x26\xa0\x75\x60\x00\xc7\x1c\x67\x10\xdc\x75\xd9\x30\x62\x2b\xad\xaa\x68\x65\xd5\x5b\x6b\x95\x63\x6e\x94\x8d\x9b\xd0\xf9\x89\x56\x56\x58\x87\xb8\x22\xf4\xd0\x0c\x7e\x58\x2e\xd1\xeb\x7b\x0e\x0a\x61\x4e\x90\x59\x14\x2d\x97\x77\xf3\x68\x24\x7c\x2d\xa8\x04\x32\x82\xf5\x24\x76\x94\x9b\x95\x4e\xe3\xba\x9b\xdb\x55\x5c\xe8\x34\x56\xa2\x90\xc9\x24\xac\xfe\xae\x2a\x60\x60\xb7\x0a\xe9\xf7\x1c\x10\x91\x22\x27\xe2\xc8\x5b\x67\x8b\x88\xf2\xbc\xd0\x76\x7a\x1d\xa4\x94\x10\xe0\x01\xa2\x64\x1d\x30\x13\x67\x6c\xe4\x81\x85\xdb\x97\x1c\x30\x2b\xc0\xed\xbf\x91\x7e\xb1\xf1\x84\x0e\xd6\x72\x20\x7d\xfc\xc3\xe4\x47\x4d\x48\xc7\x75\xb7\x5a\xe0\x4d\x07\xb3\x98\x99\x48\x30\xb3\x68\x3b\x5b\x62\x62\xaf\xcd\x6b\x60\x88\x26\x82\x07\x77\xd1\x8a\x4e\xbb\xc9\x80\x17\x1d\xf0\xfd\x8d\xd3\x90\x05\x88\x60\x8d\x97\x1e\xdd\xed\xf2\xf6\xea\x98\x16\x65\x29\x31\xdf\x01\x0a\x1d\x7a\xd5\xa4\xfc\x79\x7f\x65\xd9\x61\xd0\xb2\x66\xbc\xd7\xb9\x39\x74\x63\xd3\xa6\x45\xd9\x9b\xef\xe7\x6e\x6f\xd0\x89\xa0\xc9\x51\x2e\xf8\xd2\xe2\x99\xce\xd6\x71\x15\x3a\x0d\xf8\x8a\x5c\xf9\x74\x76\x1b\xdd\x56\x55\x4a\x09\x9c\x2c\x57\x97\x49\xe3\x7a\x1d\xe5\x79\x47\x71\x22\xd7\x5d\xea\xb6\xc9\x6a\xf5\x7a\xc9\xea\x24\x2d\xbd\x6d\xb4\x0e\x53\x80\x70\xa1\xc5\x56\x24\x85\x3a\xcb\xd6\x90\x9a\x8f\x05\x63\x97\x9e\x4f\xdb\x82\xb1\x09\xbc\x0a\xb4\xa6\x7c\xa7\xc2\x76\xfe\x24\xcc\x56\xcd\xa3\xec\x08\x06\x20\xdb\xe8\x82\xcb\xf3\x60\x08\xf1\x21\xc3\x94\x5d\x82\x0f\x8c\x62\x1f\x9b\x13\x9d\x84\xe3\x54\x3c\x14\x3f\x70\x07\x91\x1f\x0c\x30\xf9\x70\x3a\x9b\x36\x3a\x55\xfa\x18\x9d\xab\x7a\xff\xf0\x1e\xea\xf5\x73\xac\xd4\x51\x48\x12\x4c\xd1\x31\x5d\x09\x2f\xde\x64\xaa\x10\xb8\xd0\xe8\xa7\xe9\x6a\xf8\xf9\x82\x72\x68\xa3\xe9\x12\xd8\x48\x4f\x72\xd8\x68\x79\x1a\xdb\xf1\x0c\xd2\x45\x94\xd7\x40\x53\x60\xf9\x7c\x01\xd6\x96\xb1\x51\x03\x69\xc3\xc8\xaf\xae\x92\x7f\x60\xa3\xa8\xbe\xa8\x48\x8e\x4e\x75\xa6\x44\xea\xd9\x93\x6c\xd4\x4a\x9e\x8c\x66\xe8\xb8\x28\xe4\x38\xa7\x9a\xf8\x02\x94\x33\x9c\x40\x2a\x18\x01\x93\xd1\xe1\x2e\x44\xa9\xd6\xf9\x6f\xab\x55\xf6\x4e\x44\x86\x29\x0f\x13\x91\x21\x21\xad\x58\x0d\xe4\xe7\xab\x6c\x7c\xb4\x26\xd8\x5c\xcd\x97\x50\xc3\xbc\xee\x0c\x37\xcd\xf4\x99\x43\xd2\x6b\x35\x47\x64\x3d\x1e\x1d\x90\x75\x64\x43\x54\x13\xb0\x0a\x8a\x08\x3d\x50\xfb\x1e\x9d\xd7\x9a\x79\xe3\x3d\xe3\xb7\xcf\x90\x8b\x7b\x09\x17\x53\x91\xe1\xb7\x58\x42\x2e\xe2\xc4\x1b\xcd\x67\xf0\x58\xf5\x24\x3f\x63\x75\x4f\xd4\xc4\x84\x4b\x28\x5e\x64\x1b\x90\x23\xfe\x46\xa8\xae\x2f\xbe\x91\xcb\x98\x80\x4a\x26\x6b\xf0\x3f\xd9\x93\x60\xee\x05\x99\xa4\x90\xec\x37\xe2\xad\xfb\x64\x74\x0c\x46\x4a\x4b\xc1\x77\xb3\x10\xa8\x8a\xcd\x67\xc2\x01\x6b\x70\x67\x98\xd7\x1f\xee\x40\xbd\x05\x8e\x6b\x6b\xd2\x54\x6c\xe3\xdd\xe7\x8c\xa5\xf7\x51\xfd\x6e\x95\xaa\xca\x4e\x03\xf1\xf9\x72\xe5\xeb\xe7\x7f\x86\x9f\x4b\x91\xd2\x0d\xd5\xee\x0d\x73\x39\x01\x03\xbb\x53\x44\xbc\x78\x55\x7b\xe1\x7c\xdf\x6c\x98\x64\x30\xc3\x2b\x12\xc1\xaa\x9f\xc9\x03\xa3\xf3\x7d\x03\xc7\x8c\x89\x63\xbc\xa3\x3a\x4e\x85\xd8\x5f\x01\xbf\x6f\x77\x8a\x87\x7b\xcc\x1f\x08\xd5\x7f\x52\xfd\x49\x88\xfd\x37\xd0\xe1\xcf\x63\x8e\x37\x0c\xcc\xd9\xcf\x5e\xb0\x4e\x9f\xe9\x4e\xfa\xe3\x6b\x8a\x94\x69\xda\x2e\xe0\xed\x3a\xe2\x68\x96\x0b\x69\x6a\xa1\xfe\xd8\x9a\x23\x6f\x8a\xbd\x9e\xf1\x19\x06\x1f\xad\xae\x45\x3f\xcb\xe0\xa9\x8f\xac\xfa\x7b\xec\x9b\x4f\xe3\x61\x02\x6e\x0a\xad\x05\xef\xf5\xa1\x00\x38\x72\xe2\xf9\x8b\xa3\xc8\x09\xd6\x10\xe7\x52\x6c\x29\x73\xaf\x25\x67\x32\x97\xda\xe6\xdd\xef\x7d\x11\x60\xa0\x61\xe9\x5d\xb8\x4f\xd3\x42\x32\x73\xb1\xfd\xe8\xfb\x3c\x2b\xa7\xe3\x27\xcd\xad\x59\x5f\x7a\xcd\xf7\xea\x64\x58\xce\xac\xd3\x8d\x9a\xcd\xc6\x68\xb5\x15\x32\xf3\x3d\xaa\x46\xde\xfc\xab\xff\xf8\xdf\x9a\xcf\x61\xdf\x23\xc3\x8c\xa1\x0d\x56\x34\xf1\xa8\x50\x26\x08\x66\x13\xbd\x3f\x9a\x08\xde\x6d\xa8\xb5\x4d\x05\x2d\xb1\x4a\xfb\x1d\x85\xc9\xd7\x57\x1f\x5b\xac\xa9\xb6\xc4\xdf\xb4\xb1\x76\x3c\x76\x7b\x80\x51\x3e\xfd\x9c\x1b\x2c\xd8\xbb\xf9\xeb\x35\x47\xdd\x43\x6f\x64\x61\xc6\xae\x3f\xa7\x7c\x2f\xd4\x99\x8c\x2c\xb6\x42\x68\xf0\xcd\xcb\x7f\x03\x00\x00\xff\xff\x7a\xd0\x63\x3d\x4b\x15\x00\x00"
 
 func adminUserEditTmplBytes() ([]byte, error) {
@@ -557,7 +681,7 @@ func baseDelete_modal_actionsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _baseFooterTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdd\x6e\xe3\x36\x13\xbd\xb6\x9f\x82\x1f\xbf\xbd\x68\x17\x10\x99\xa4\xbb\xc0\x36\x95\x55\xa4\x58\x74\x83\x45\xfa\xb7\x71\x51\xf4\xaa\x18\x4b\x63\x69\x1c\x8a\x54\x48\x2a\xae\x57\xd0\x03\xf5\x35\xfa\x64\x85\xa8\x1f\xe7\xaf\xd9\x64\xd3\x1b\xd9\x12\x67\x0e\xcf\x39\x1e\x1e\xab\x69\xe4\xcb\x79\x5c\xf8\x52\x25\xf3\x78\x65\xb2\x5d\x32\x9f\xc5\x19\x5d\x25\xf3\x97\xb2\x6d\xe7\xb3\x58\x86\x9b\x59\xbc\x36\xc6\xa3\x4d\xe6\xb3\xb0\xcc\x52\x05\xce\x2d\x78\x4d\x2c\x35\xda\x03\x69\xb4\xbc\x5b\xbc\xbd\xaa\x70\xed\xfb\x85\xd9\xdf\x7f\xb1\xa6\xf9\x1d\xc1\xb6\x2d\x6b\x9a\x93\xaa\xfa\x11\x4a\xec\xf6\x98\x4d\xbb\xdc\x69\xb7\x94\x17\x9e\x29\xd2\x17\x6e\x40\x69\x1a\x5a\xb3\xf3\xc2\x6c\xbf\x0f\x84\x96\x58\x56\x0a\x3c\x9e\x19\xc8\x96\x34\xe2\xcd\x66\xb1\xab\x40\xf7\x1d\x5d\x8f\xa0\xc3\x37\x5a\x2c\x2d\xe3\x15\xe4\xc8\xdb\xf6\x98\xc5\xce\x5b\xa3\xf3\xa4\x69\xc6\x5e\xc7\xc4\xcf\x90\xe3\xb9\x07\xeb\x7b\xac\x58\x0e\x45\xec\x3a\x84\x1f\xf6\xbc\x05\x93\x82\x52\x4c\x2c\xcb\x4a\x4d\x80\xd7\x10\x06\x5a\x72\xcf\xab\x69\x50\x67\x03\xdf\xa0\x4a\xec\x65\x7d\x67\x41\x67\xa4\xf3\x49\x0e\x30\x0f\x36\x47\xbf\xe0\x7f\xac\x14\xe8\x0b\xce\x2c\xaa\x05\xd7\xc6\x54\xa8\xd1\x32\x6d\x2c\xae\xd1\x5a\xb4\x9c\x15\x16\xd7\x0b\x5e\x78\x5f\xb9\x63\x29\x73\xf2\x45\xbd\x12\xa9\x29\x65\x6e\x72\x17\x2e\x3c\x89\x69\x74\x79\x0d\x6c\x0d\x51\x5f\x15\xb9\xcb\x1a\x2c\xf2\x24\x96\x94\x04\x0b\xc7\x2a\x67\x23\xa3\xd5\x8e\x27\xef\xc8\x9f\xd6\xab\x41\x47\x2c\x21\x79\x36\x41\xbf\x25\xef\xd1\x06\x86\xef\x4c\xee\x4e\x7f\xb9\x4b\x6f\xa8\x79\x88\xd8\xb2\x2f\xf9\xaf\x98\x1d\x4b\xb9\x45\x5a\x99\xc9\xb8\xb4\x20\x0d\x77\x99\x85\xa2\x87\x78\x9d\x93\x06\xf6\x5b\x57\x75\x9b\xda\xf5\x01\xb8\x7d\x6c\x40\xe7\x35\xe4\xc8\x56\xc6\x7b\x53\xb2\xb5\x32\xe0\x49\xe7\xcc\x29\xca\x90\xd5\x15\xcb\xac\xa9\x32\xb3\xd5\xe1\x70\x30\xf2\x58\xf2\x51\xf0\xc4\x70\x6b\xac\xca\x18\xa5\x46\xf7\x04\x87\xf5\x6b\x5b\x79\xfc\xd3\xf3\xa4\x69\xc4\x19\xe8\xbc\x3f\x8f\xfb\xb3\x78\xb3\xb4\x44\x5d\xf3\xfd\x89\xb2\xa0\x73\x64\xe2\x44\xa9\xae\xd5\x8d\x83\x1a\xfc\x1e\x3a\x3a\x52\x2c\x4c\x36\x5e\xb2\x17\x61\x0b\x16\xae\x6d\x0b\xa9\xa7\x2b\x64\x0e\x15\xa6\x1e\xb3\xc1\x89\xd1\xff\xfb\x7b\xfe\xdf\x34\xa8\x1c\xb6\x6d\xd3\xbc\x10\x67\xa4\x2f\xda\xf6\xdb\xce\xa7\xc5\x40\xbf\x5b\xe8\x61\x3a\x41\xa3\x18\xd8\x53\xde\xbb\x7d\x2d\x70\x6e\x7c\x85\x81\x80\x04\xe7\xd0\x3b\xa9\x68\x65\x71\x33\x7d\x8a\x2e\x27\x39\x73\x7e\xa7\x70\xc1\x33\x72\x95\x82\xdd\xb1\x36\x1a\x39\xcb\xc0\x43\xb4\x71\x8a\x52\xd4\x0e\x17\xfc\x90\x27\xef\xe1\x0a\x5c\x6a\xa9\xf2\xec\xac\x7f\xec\x26\x3e\xcf\x39\xd0\x26\x77\x82\x4c\x50\x39\x65\xd2\x16\x57\x8e\x42\x24\x8d\x5b\x4c\xba\xa6\x08\x97\x63\x86\xc7\xb2\x4f\xfa\x79\xfc\xbf\x28\x62\xcb\x82\x6c\x16\x55\x60\xfd\x8e\x75\x42\xc1\x12\x3a\x16\x45\xc9\xbc\x4f\xa5\x0f\x78\x59\x93\xc5\x53\xca\x0b\xd5\xc5\xf1\xfb\xf3\xf0\xc7\x10\xe6\x2e\x70\x0e\x7e\xb8\x02\xd1\xef\x7f\xc0\x93\xaa\x3a\xaf\x57\xbf\x7e\x38\x6b\x5b\x59\xa9\x3a\x27\xed\x64\x31\x22\x44\x5f\x8b\xc3\x37\xe2\x60\x8a\x26\x17\xc2\x3d\x1e\xbc\x72\x36\x7d\x34\xc2\xf4\x40\x54\x90\x5e\x88\x4d\x97\x6c\xb2\xc7\xd9\x23\x26\x85\xda\x38\x41\x9a\xfc\xa4\x81\x74\xfe\x93\xee\x62\xfa\x8b\x2f\xbf\xd9\x37\x8c\x23\x72\x43\xf7\x0f\xa4\x29\x35\xca\x58\xf7\x99\xb2\x37\x97\x35\xda\x9d\x28\x27\x9c\xe8\x48\x1c\x89\xaf\xee\x3e\x7f\x82\x11\x8f\xc7\x2c\x49\xdf\xb2\xe5\x5e\x95\x6f\xc1\xa3\xa7\x12\x2b\x4a\x2f\xd0\x3e\x4f\x69\x76\x03\x2b\x3a\x12\xaf\xc4\xeb\xfb\xd7\x9e\xae\xf8\x09\xd8\x8f\x51\x6d\x4d\xf5\xd1\x68\xfc\x4c\xbd\xd9\xd0\x1e\xbd\x16\xaf\xc5\xc1\x74\x1b\x3c\x7f\xbc\xb2\x87\x50\xfe\x65\xa0\x47\xde\x02\x6a\x6f\xde\x92\x4b\xcd\x15\x5a\xb6\x60\x6b\x50\x0e\x3f\xa1\xf9\xa4\xf6\xc6\xd1\xc7\x5e\xf3\xa7\xd9\xc1\x50\x1e\xbd\x12\x07\xe2\x68\xba\x7d\x60\xae\x1e\x00\xed\xa3\xd4\x49\x2c\xcd\x86\xd6\xbb\xe8\x50\x1c\x8a\x83\x7b\xa0\x1e\x01\x91\x2a\xaa\x56\x06\x6c\x16\x1d\x89\x03\xf1\xea\x1e\x90\x79\xd3\x8c\x6f\x6a\x8c\x93\xde\x60\xea\x87\x04\xe4\x4c\x74\x3c\x65\xff\xda\xfb\x4f\x00\x00\x00\xff\xff\x57\x7d\x5e\x69\x03\x0b\x00\x00"
+var _baseFooterTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x56\xdd\x6e\xe3\x36\x13\xbd\xb6\x9f\x82\x1f\xbf\xbd\x68\x17\x10\x95\xa4\x09\xb0\x4d\x65\x15\x29\x16\xdd\x60\xe1\xfe\x6d\x5c\x14\xbd\x2a\xc6\xd2\x58\x1a\x87\x22\x15\x92\x8a\xeb\x25\xf4\x40\x7d\x8d\x3e\x59\xa1\x5f\x3b\x4e\x9a\x4d\x36\xbd\x91\x25\x71\xe6\xcc\x39\xc7\x9c\xa1\xbc\x0f\x5f\x4f\xa3\xdc\x15\x32\x9e\x46\x4b\x9d\x6e\xe3\xe9\x24\x4a\xe9\x36\x9e\xbe\x0e\xeb\x7a\x3a\x89\xc2\xf6\x61\x12\xad\xb4\x76\x68\xe2\xe9\xa4\x5d\x66\x89\x04\x6b\x67\xbc\x22\x96\x68\xe5\x80\x14\x1a\xde\x2c\x1e\xae\x4a\x5c\xb9\x6e\x61\xf2\xf7\x5f\xcc\xfb\xdf\x11\x4c\x5d\x33\xef\x2f\xca\xf2\x47\x28\xb0\xa9\x31\x19\xab\xdc\x4b\x37\x94\xe5\x8e\x49\x52\xd7\xb6\x47\xf1\x9e\x56\xec\x2a\xd7\x9b\xef\x5b\x42\x0b\x2c\x4a\x09\x0e\xe7\x1a\xd2\x05\x0d\x78\x93\x49\x64\x4b\x50\x5d\x46\x93\x23\xe8\xf8\x8d\x12\x0b\xc3\x78\x09\x19\xf2\xba\x3e\x67\x91\x75\x46\xab\x2c\xf6\x7e\xc8\xb5\x4c\xfc\x0c\x19\x5e\x39\x30\xae\xc3\x8a\xc2\x3e\x88\xed\x43\xb8\xbe\xe6\x01\x4c\x02\x52\x32\xb1\x28\x4a\x39\x02\xee\x21\xf4\xb4\xc2\x1d\x2f\xef\x51\xa5\x75\xbd\x53\x25\x76\xb2\xbe\x33\xa0\x52\x52\xd9\x28\x07\x98\x03\x93\xa1\x9b\xf1\x3f\x96\x12\xd4\x35\x67\x06\xe5\x8c\x2b\xad\x4b\x54\x68\x98\xd2\x06\x57\x68\x0c\x1a\xce\x72\x83\xab\x19\xcf\x9d\x2b\xed\x79\x18\x66\xe4\xf2\x6a\x29\x12\x5d\x84\x99\xce\x6c\x7b\xe1\x71\x44\x83\xcb\x2b\x60\x2b\x08\xba\xa8\xc0\xde\x54\x60\x90\xc7\x51\x48\x71\x6b\xe1\x10\x65\x4d\xa0\x95\xdc\xf2\xf8\x1d\xb9\xcb\x6a\xd9\xeb\x88\x42\x88\x5f\x4c\xd0\x6d\xc8\x39\x34\x2d\xc3\x77\x3a\xb3\x97\xbf\xdc\xa7\xd7\xc7\x3c\x46\x6c\xd1\x85\xfc\x57\xcc\xce\xc3\x70\x83\xb4\xd4\xa3\x71\x49\x4e\x0a\xee\x33\x6b\x83\x1e\xe3\x75\x45\x0a\xd8\x6f\x4d\xd4\x21\xb5\xfd\x0d\x70\xd8\x36\xa0\xb2\x0a\x32\x64\x4b\xed\x9c\x2e\xd8\x4a\x6a\x70\xa4\x32\x66\x25\xa5\xc8\xaa\x92\xa5\x46\x97\xa9\xde\xa8\xb6\x39\x18\x39\x2c\xf8\x20\x78\x64\xb8\xd1\x46\xa6\x8c\x12\xad\x3a\x82\xd3\xc9\x61\x29\x87\x7f\x3a\x1e\x7b\x2f\xe6\xa0\xb2\xae\x1f\x77\xbd\x78\x37\xb4\x40\x55\xf1\x5d\x47\x19\x50\x19\x32\x71\x21\x65\x93\x6a\x87\x8d\xda\xfa\xdd\x67\x34\xa4\x58\xbb\xb3\xf1\x86\xbd\x6a\x4b\xb0\xf6\x5a\xd7\x90\x38\xba\x45\x66\x51\x62\xe2\x30\xed\x9d\x18\xfc\x7f\x38\xe7\xff\xde\xa3\xb4\x58\xd7\xde\xbf\x12\x73\x52\xd7\x75\xfd\x6d\xe3\xd3\xac\xa7\x5f\xd7\x03\x4c\x23\x68\x10\x03\x3b\xca\x3b\xb7\xf7\x06\xce\xfe\xed\xa8\xaa\x6b\xc2\xa6\x86\x7d\x41\x07\x7a\x2f\x7e\xfd\x30\xef\xf9\xcc\x61\x89\x72\x8f\xd0\x9d\x3f\x1f\xfa\x8c\x10\xac\x45\x67\x43\x49\x4b\x83\xeb\xf1\x57\x34\xf3\x99\x33\xeb\xb6\x12\x67\x3c\x25\x5b\x4a\xd8\x9e\x2b\xad\x90\xb3\x14\x1c\x04\x6b\x2b\x29\x41\x65\x71\xc6\x8f\x79\xfc\x1e\x6e\xc1\x26\x86\x4a\xc7\xe6\xdd\x6b\x3b\x96\x7d\xc9\x20\xd1\x99\x15\xa4\x79\xbc\x3f\x0b\x37\xb8\xb4\xd4\x8e\xc2\xa1\xc4\xe8\xe7\x70\x13\x85\xc3\xd9\x11\x85\xdd\x09\x33\x8d\xfe\x17\x04\x6c\x91\x93\x49\x83\x12\x8c\xdb\xb2\x46\x28\x18\x42\xcb\x82\x20\x9e\x76\xd3\xf0\x03\xde\x54\x64\xf0\x92\xb2\x5c\x36\xc7\xc0\xfb\xab\xf6\x40\x6a\xf7\x7b\xcb\xb9\xf5\xc3\xe6\x88\x6e\xe7\xf8\x45\x59\x5e\x55\xcb\xd6\xf6\xb0\x94\x55\x46\xca\x86\xf9\x80\x10\x7c\x2d\x8e\xdf\x88\xa3\x71\x24\xda\xf6\x50\x89\x7a\xaf\xac\x49\x9e\x8c\x30\xbe\x10\x25\x24\xd7\x62\xdd\x4c\xd4\xb0\xc3\xd9\x21\xc6\xb9\x5c\x5b\x41\x8a\xdc\xa8\x81\x54\xf6\x93\x6a\x8e\x87\x2f\xbe\xfc\x66\x97\x30\xec\x85\x3b\xba\x7f\x20\x45\x89\x96\xda\xd8\xcf\x94\xbd\xbe\xa9\xd0\x6c\x45\x31\xe2\x04\x27\xe2\x44\x7c\x75\xff\xfd\x33\x8c\x78\x3a\x66\x41\xea\xc0\x96\x07\x55\xbe\x05\x87\x8e\x0a\x2c\x29\xb9\x46\xf3\x32\xa5\xe9\x1d\xac\xe0\x44\x9c\x8a\xb3\x87\xd7\x9e\xaf\xf8\x19\xd8\x4f\x51\x6d\x74\xf9\x51\x2b\xfc\x4c\xbd\x69\x9f\x1e\x9c\x89\x33\x71\x34\x3e\xb6\x9e\x3f\x5d\xd9\x63\x28\xff\xb2\xa1\x07\xde\x02\x2a\xa7\xdf\x92\x4d\xf4\x2d\x1a\x36\x63\x2b\x90\x16\x3f\xa1\xf9\xa2\x72\xda\xd2\xc7\x4e\xf3\xa7\xd9\x41\x1f\x1e\x9c\x8a\x23\x71\x32\x3e\x3e\xb2\xaf\x1e\x01\xed\x46\xa9\x0d\xb1\xd0\x6b\x5a\x6d\x83\x63\x71\x2c\x8e\x1e\x80\x7a\x02\x44\x22\xa9\x5c\x6a\x30\x69\x70\x22\x8e\xc4\xe9\x03\x20\x53\xef\x87\x2f\x44\xc6\x49\xad\x31\x71\xfd\x04\xe4\x4c\x34\x3c\xc3\xee\x73\xfb\x9f\x01\x00\x98\x4b\x94\x9e\x7b\x0b\x00\x00"
 
 func baseFooterTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -572,12 +696,12 @@ func baseFooterTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "base/footer.tmpl", size: 2819, mode: os.FileMode(0644), modTime: time.Unix(1583557388, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdc, 0x3f, 0xee, 0x25, 0x21, 0x4e, 0xe8, 0xfb, 0x63, 0x8a, 0xaf, 0x27, 0x25, 0x1e, 0xbb, 0x51, 0x4e, 0x2b, 0x64, 0xa1, 0xa4, 0x93, 0xa3, 0x93, 0xd, 0x78, 0xdb, 0x4f, 0x18, 0x2, 0xb8, 0xd3}}
+	info := bindataFileInfo{name: "base/footer.tmpl", size: 2939, mode: os.FileMode(0644), modTime: time.Unix(1792278081, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0x3d, 0x2d, 0xe6, 0x10, 0xc4, 0x4a, 0xd5, 0x1f, 0x15, 0xb6, 0x76, 0x15, 0xf6, 0xa9, 0xba, 0xbb, 0x2d, 0xe5, 0xce, 0x69, 0x9f, 0x3c, 0xe1, 0x9e, 0x6b, 0x34, 0xdb, 0xa0, 0x5e, 0x88, 0xce}}
 	return a, nil
 }
 
-var _baseHeadTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x1a\x6d\x6f\xdb\xb8\xf9\x73\xfc\x2b\x78\x1a\x0e\xd8\x0e\x27\xa9\x69\x7b\xb7\x43\x6b\xfb\x90\xa5\x6f\x19\x92\x36\x48\x9c\x61\xfb\x64\xd0\xd2\x13\x89\x09\x45\xb2\x24\x65\xd7\xf3\xfc\xdf\x07\x92\x92\x4c\xc9\x72\x22\xf7\xb6\x7e\x89\x4d\x3e\xef\xef\x0f\xdd\xf1\x0f\xef\xbe\x9c\xcf\xfe\x75\xfd\x1e\xe5\xba\xa0\xd3\xd1\xb8\xfe\x03\x38\x45\x29\xd6\x38\x54\xe5\xa2\x94\x74\x12\x6c\x36\x67\x42\xdc\x96\x8b\xbb\x9b\xcb\xed\x36\x98\x8e\x4e\xc6\x05\x68\x8c\x72\xad\x45\x08\x5f\x4b\xb2\x9c\x04\xe7\x9c\x69\x60\x3a\x9c\xad\x05\x04\x28\x71\xdf\x26\x81\x86\x6f\x3a\x36\x74\xdf\xa2\x24\xc7\x52\x81\x9e\xdc\xcd\x3e\x84\xbf\x05\x28\xee\x25\xf3\xcf\xf0\xee\x2c\x3c\xe7\x85\xc0\x9a\x2c\xa8\x4f\xe9\xe2\xfd\x04\xd2\x0c\x02\x83\xb7\xd9\x90\x7b\xc4\xb8\x46\xd1\x35\xce\xe0\x42\x9d\xa5\x05\x61\xdb\xed\xe8\xa4\xa2\xc8\x70\x01\x93\x00\x97\x3a\xe7\xd2\x23\x61\xd1\xa2\x1b\x10\x5c\x11\xcd\xe5\x7a\xbb\xdd\x6c\xa2\x2f\x2b\x06\x32\xfa\x8c\x0b\x30\x5f\x81\x2a\xd8\x6e\x3f\xf2\x4c\x6d\x36\xc0\xd2\xed\xd6\x09\xda\xa2\x9b\x82\x4a\x24\x11\x9a\x70\xf6\x1c\xf1\xdd\xd7\x86\x43\x1b\x2a\x7a\xb7\x23\xb6\xdd\xa2\x10\xb5\x91\x5a\xb7\x95\x44\xbe\x90\x88\x28\x84\x91\xc0\x84\x51\x50\x0a\x29\xa0\xf7\x61\xce\x95\x86\x14\x7d\x24\x1a\x29\x90\x4b\x92\xc0\x61\x55\x1e\x61\xbd\xe2\x32\x55\x9e\x1e\x19\xff\x19\x65\x44\xff\xec\x13\xfb\x19\x65\x3c\x53\x81\xb5\xbc\x25\x35\x6a\x51\x91\x70\x0f\x52\x82\x6f\x6a\xc6\xc3\xdd\xe9\xce\xd5\x0e\x7e\x9e\x28\x79\xdf\x32\x5d\x74\x7e\x7b\xf3\x61\xc6\x1f\x81\xd5\x62\xb6\xe0\x5d\x1c\xb6\x30\xfc\x80\x34\x08\xa3\x93\xf1\x0f\x61\x88\xbe\x08\x60\xe8\xa3\xc4\x22\x47\x33\x9c\x29\x14\x86\x75\xb8\x74\x43\xc5\x99\x11\xed\x6e\xee\x14\xc8\x6b\xc9\xef\x09\x05\x2f\x94\x84\xe4\x02\xa4\x5e\x4f\x02\x9e\xbd\xe9\x0a\x51\x05\xcf\xa7\xd9\xd5\xe5\x4e\x92\x5e\x4c\xdd\x4e\x0b\xe1\xf8\x3c\x01\x4f\x74\x2b\xfa\xf7\x02\xd5\xc8\xed\x4e\x3e\x94\x94\xba\x53\xf4\xe7\x06\x6c\x77\xf8\x97\xda\xfb\x07\x38\x1d\x8a\xe6\x16\x3f\x94\x63\x85\x76\x67\x65\xf1\x81\x53\xca\x57\x20\xd5\x76\x8b\xee\xeb\xcf\x08\xb3\xd4\x44\xa4\x3b\x20\x2c\xeb\x41\x21\x2c\xdb\x6e\x91\x00\x2e\x28\x44\x87\x64\x22\x05\xce\x7a\xb5\x3f\x5b\x62\x8d\xe5\x25\x61\x8f\xb5\xb1\x3d\x37\xfa\xb9\x37\xd0\x7f\x5e\xaa\x1d\xef\x44\xbe\x78\x80\x44\x1f\xe5\x43\x8f\xdf\xce\x43\x47\x7b\xe6\x50\x81\x38\xc2\x9c\x1e\x89\xa7\x2d\x3b\xd4\x94\x67\x42\x1c\x67\xbd\x15\x2c\x14\xd1\x47\xa5\xc0\x99\x10\xdf\x63\xb1\xe1\x85\xf2\x88\x80\xac\xf5\x25\x45\x16\xdf\xe3\x25\x49\x38\x8b\x04\xcb\x0e\xab\x63\x94\x9d\x9b\x7a\xd6\x91\xcc\xaf\xaa\xa3\x93\x31\x25\xec\x11\x49\xa0\x93\x40\xe5\x5c\xea\xa4\xd4\xc8\xd0\x0e\x50\x2e\xe1\xbe\x53\xf8\xe2\x3e\xe6\xa3\x93\xb1\x33\x02\x52\x32\xe9\x22\x3c\xa8\xf8\xe1\x6b\x09\x72\x1d\xbe\x8a\x5e\x47\xa7\x51\x41\x58\xf4\xa0\x82\xe9\x38\x76\x38\xd3\xe7\xb0\x29\x59\xd4\x24\x22\x2c\x21\x5c\xf3\x32\x54\xa5\x84\x3d\x2a\x9e\x1e\x7a\x4d\x41\xe5\x00\xba\x5f\x09\xac\x14\x68\x15\xdf\x73\xa6\x43\xbc\x02\xc5\x0b\x08\x5f\x47\xbf\x46\xaf\xe2\x44\xb5\x8f\xad\xb8\x89\xb2\x26\x3b\x9e\x01\x4f\xb4\x31\x94\x0a\x5f\x47\xaf\xa2\x17\xcd\x57\x8f\x68\xd5\x43\x18\xd7\xb0\xe0\xfc\x31\x7a\x30\xa5\x4c\x22\x09\x2c\x05\x69\xca\x19\x11\x6b\x9d\x73\xd6\x40\xb8\x8a\x57\x60\xf9\x08\xe9\x3e\xb4\x39\x4f\xf9\x8a\x21\xe2\x63\x78\x1d\xe9\x42\x5d\x5c\x5b\x82\x9f\xab\x5b\x97\x6c\x87\x1d\x20\x68\x99\x11\xa6\xe2\x9a\xda\x83\x0a\x5f\x58\x65\x1a\x91\x7b\x1c\x3a\x84\xa0\x53\xc1\x12\xfb\xb5\xfa\xd2\x47\x6a\x17\xa7\xf5\x1c\xf3\xb5\x24\x12\x6e\x49\x21\x28\x5c\xbd\x7b\xef\xe4\x1f\xee\x99\x9a\xbd\xb2\x04\x8a\x14\xc2\xd3\xe8\xf4\x45\x74\xba\x3b\xf0\x5d\x3e\x44\x91\x67\x28\x7d\x87\x69\x12\x9e\x42\x41\xa4\xe4\x32\xfc\x25\x3a\xfd\x6b\xf4\x22\xc6\x69\xca\x59\x5c\xf0\x14\x62\xca\x71\x6a\x3e\xfc\x6f\x08\x5b\x92\xa6\x72\x1c\x22\x67\x3e\x9e\x9c\xf3\x14\xae\x2c\x5e\x64\x10\xee\x6e\x2e\xd1\x04\xa1\xe3\x98\xfc\xf8\x39\xfe\xf1\xb3\xe1\xf2\xd6\x10\xef\x73\xb0\xcd\x84\xdb\xc6\x7f\x2e\x6c\x8f\x70\xad\xc9\x5d\x05\x05\x66\x9a\x24\xe1\xcb\xe8\x75\xf4\xf2\x3b\xb3\xd7\x10\x32\xd3\xa7\x41\xfd\x7d\x39\xd9\x6c\xae\xde\xfd\x82\xce\x84\xf8\x07\xc8\x6a\x17\x61\xdc\x37\x94\x21\x67\xed\x14\xa5\x92\x0b\x93\x7f\x6f\x72\xbe\x04\x89\xa6\x28\x2a\x80\x95\x68\x83\x52\xa2\x04\xc5\xeb\x37\x68\x41\x79\xf2\xf8\x16\x6d\x2d\x78\x49\x22\x05\x09\x67\x29\x96\x6b\x07\xd9\x50\x88\x88\x86\xc2\x23\x50\x60\x99\x11\x16\x6a\x2e\xde\xa0\x17\x0e\x1f\x8d\xe3\x9a\xf5\x38\xde\x49\x54\x19\xf2\xef\x78\x89\x6f\x5d\x44\x38\x43\x3e\x59\x64\x7b\xec\x76\x54\x91\xb6\xe6\x7a\xe8\xb3\xd6\x8e\xc4\xe8\x64\x6c\x1b\xec\xd4\x65\xf2\xcc\x7c\xb6\x2b\x4b\xf5\xc9\xae\x22\xd5\xbe\xd1\xf4\xdd\x71\xec\x70\x46\xed\xf9\x5c\xe7\x50\x40\x98\x70\xda\xd9\xb6\x66\xe6\xfc\xdc\x1c\x5f\x81\xc6\x33\x9c\x59\x87\x99\x28\xd3\x50\x08\x8a\x35\xa0\x80\x30\x33\x44\xc5\x66\xe7\x0c\x50\xb4\xdd\x8e\xc6\xf6\xf3\x74\x34\x5e\xf0\x74\x6d\x54\x4d\xc9\x12\x25\x14\x2b\x35\x09\xee\x4b\x4a\x51\x0e\x24\xcb\xb5\x2b\x08\x8d\xa1\x67\x39\x51\xa8\x1a\x29\xd0\x8a\xcb\x47\x85\x16\xa0\x35\x48\xb4\x22\x3a\xf7\xec\xdf\x76\xce\xde\x26\x79\xc1\x94\xc6\x94\xda\x42\xd6\x66\xdd\xcc\xb4\x0b\x2c\x11\x6d\x44\x68\x43\x95\xc4\xea\x8f\x09\x03\x59\x5d\x77\xef\x33\x49\xd2\xfa\xaa\x75\x97\x70\x5a\x16\xac\xb9\xea\xe2\x69\x2e\x50\x13\x9f\xc8\x04\xe2\x0e\xf4\x64\x8c\x6b\x48\x1b\xa9\x0b\x89\x59\xda\x9f\x4e\x1e\xd2\xc9\x98\x14\x99\xc7\xa1\x20\x8c\xa0\x6a\xd2\xe9\x09\xac\xee\xb0\xe1\x71\x8f\xb1\x35\xa6\xfb\x57\xf7\xb6\x4b\x9e\x65\x90\x3a\x4b\xf6\x49\xe9\x6f\x65\xef\xb0\xca\x17\x1c\xcb\x74\xbb\x45\x38\xd1\x64\xb9\xdb\x59\xfb\x95\xd8\x6c\x22\x72\xfa\x1b\x8b\x66\x12\x05\x69\x8d\x1c\x98\x08\xc5\xd3\x21\x0c\x2f\x94\x2a\x41\x0d\xe3\x46\x2c\x6c\x9b\x67\x75\x36\x9c\xe1\x75\x49\xe9\x40\x7e\xc2\x80\xb6\xd9\x99\xa3\xb9\x84\xaf\x25\x28\xbd\xc7\xd5\x1b\xd4\x9f\x15\xe3\x13\xb7\xeb\xdc\xd1\x36\xce\x79\x01\x3d\x7c\xab\x66\xf1\x2c\xdb\xf7\xdf\x04\xe5\x72\x20\x67\x70\xc0\xb1\x34\x3b\x4a\x5b\x8c\xea\x6a\x5f\x92\xf8\x27\x3f\x5d\x0c\xfb\x56\xa0\xb7\x53\xc9\x84\x30\x22\x4c\x94\xba\x9d\x0d\xe6\xa4\x06\x53\x80\x65\x92\x2f\xf8\xb7\x00\x99\xc5\xc5\xbd\x64\x05\x48\x50\x9c\x40\xce\x69\x0a\xd2\xee\x51\x8d\x64\x0e\x7e\x2e\x24\xb7\x4b\x61\xb5\xa3\x34\xa4\xdb\x64\xdd\x48\x3f\x1d\xc7\xc4\x07\x8a\x53\xb2\xdc\xfb\xfe\x53\xec\x5b\xf8\x89\xdc\xf2\x54\x94\xa6\x3a\x75\x4b\xc4\x9e\x15\xea\xe6\x86\xec\x7b\x9f\x6d\xc8\x0f\x65\x21\x90\xad\x20\x82\x0b\x53\xeb\x4a\x11\xb8\x97\x40\x7f\x77\x6c\x74\x4e\x24\x60\xb3\xd4\xc0\xca\xe8\xeb\x00\x97\x58\x12\x6c\x16\xb0\x49\xa0\x09\x5b\x23\xc2\x96\x20\x35\xa4\x2d\x49\x4e\xc6\x4a\x60\x56\xcb\x62\x2d\xdb\xba\xf6\x0c\x56\x8d\xe9\xa8\xfa\x1b\x0a\x5a\x9a\x56\xe8\xe3\x2b\x19\x72\x46\xd7\xed\x48\x69\xc9\x36\x8e\x0d\x7c\xc7\xde\x4f\xb2\xd1\x92\x60\x96\x51\x08\x8d\x85\xba\x9e\xb2\x23\x93\x21\xd8\x3a\xf2\xac\xbb\x67\xfa\x6e\x6e\xf4\x07\xbe\x09\xf8\xd8\x09\xde\xc1\x7e\xde\x20\x31\x99\x22\x5f\x7f\x06\xab\xb9\xa1\x17\xf8\x41\x52\xd7\xea\xef\x12\xac\x20\x99\x3c\x4a\x32\x83\x15\x26\x94\x33\x38\x20\x5f\x4d\xf1\x39\x11\x5d\xd4\xbb\x98\xbf\x53\x20\xa3\x73\xcc\xce\xad\x99\xbe\xc8\x0c\x33\xf2\x6f\xec\xde\x3f\x8e\xd6\x8b\xcb\xec\x68\x7b\x73\x8f\xe5\x01\xbd\xb8\xcc\x06\xe8\x54\x3d\xa4\xfa\x30\x26\xe1\xed\xbc\x08\x2c\xad\x07\x29\xe4\x04\x44\x0c\x56\x36\xa5\xdd\x00\x79\x08\xa9\x49\x6a\x0b\xea\xa1\x1a\xac\x3f\x5e\x0b\x34\x5e\x10\x96\xc2\xb7\x49\x10\x9e\x3e\x55\x19\x4a\x05\x72\x5e\x3d\x72\xce\x31\x4b\xe7\x85\xab\xda\x7f\xac\x48\x20\x6c\x1f\xa9\xf6\x6a\x45\x6b\x8a\x51\x05\xa6\x14\x49\x5e\xb2\x14\xd2\xce\x38\xe3\xc7\xd0\x0d\xd0\xd6\x9b\x57\x87\xe8\xb3\x05\xe6\x90\x8a\x3d\xa5\x61\x70\x9d\x69\xdb\xf7\xbb\xaa\x4e\x87\x44\x5b\x8c\xb6\xd3\x8d\xaf\xa1\x6b\xcd\x93\x56\x53\x23\x19\x83\x74\x4e\xd8\x1c\x9b\xa9\x03\x8d\x95\x96\x9c\x65\xd3\xb6\x25\xeb\xdd\xa0\xba\xec\xc4\xbd\x6d\x6a\x07\xc5\x48\xc9\x92\x58\x21\xba\xdd\x6f\x60\x06\xf7\x49\x72\x44\xed\x04\xa9\x7a\xb3\x78\xcd\xcb\xc6\xb9\x47\x54\x50\x7f\xe4\x31\x02\xdd\x82\xd6\x84\x65\x6a\xbb\x6d\x8d\x3d\xe8\xb0\x3e\x26\xaa\x62\x55\xa1\x0d\x57\x64\x87\xd1\xaf\x4a\x73\x7f\x6c\x37\xd0\x58\x66\xa0\x27\xc1\x7c\x41\x31\x7b\x0c\xdc\xca\xce\x38\x17\xc0\x40\x22\xc6\x77\x3f\xf2\x38\x6d\x72\xad\x85\x7a\x13\xbb\x15\x94\xf0\x38\xe5\x89\x6a\xb0\x1a\xe0\xc1\x7a\xd9\x81\xb7\xbf\xd0\xe6\x40\xc5\xc0\xce\xe1\xff\x40\x38\x30\x0e\x3b\x90\xbd\x1e\xae\x88\x0e\x75\x2d\x36\xe0\x5d\xd5\x3d\xdd\xad\xe2\x4f\x38\xd2\xe2\xcf\x05\x66\x40\xbb\x7a\x1f\x6e\x2f\x83\x13\xaf\x0d\x78\xcf\x65\x81\x48\x3a\x09\x28\xcf\x78\xa9\x43\xf3\x3d\x68\x47\x86\x51\xdb\x14\xf0\x9e\xf8\x75\x48\x01\x2a\x40\xe7\x3c\x9d\x04\xd7\x5f\x6e\x67\x3d\x65\xa6\xf9\xcd\xef\xd3\xec\xea\xf2\x29\xe7\xa8\x72\x51\x10\x1d\x2e\x4a\xad\x39\xab\x5a\x88\x91\x68\x12\xfc\xc9\x97\xef\xb0\x69\xf7\xd2\x85\x64\x2c\x34\x22\xee\x5b\xd9\x5c\xcd\xcd\xd5\xbe\x89\xf7\x0b\x54\x6c\xf8\x4e\x07\xf4\x70\xd7\xb8\x8e\xe9\xdf\x07\x31\x3a\x08\xae\x44\x23\x63\x77\xb4\x9b\xfb\xdb\xed\xbe\x59\x10\x0f\x6d\x88\xff\xb7\x3c\xef\x49\xd8\xce\xc2\xfc\xfc\xda\xe2\x52\xee\x36\xe7\xab\x1b\xc8\x88\xd2\xd2\x4e\x0e\x7f\xb3\xc1\xd0\x19\xa0\x0e\xee\x9f\xb7\x24\x63\x77\x62\xd8\xfa\xe9\x4a\xb0\x09\x83\x52\x0c\x5f\x4b\x0e\x75\x12\x69\x65\x06\x19\x74\x67\x3d\xdc\xd6\xb1\x3b\x0d\x3e\xad\xcb\x05\x3b\x42\x17\xca\x33\xc2\x7e\x97\x90\x12\x09\x89\x9e\x6b\x3e\x31\x5d\xb3\x67\xea\x79\x26\x61\x48\x9f\x7e\xd6\x50\x84\xb5\xd5\xeb\xf8\xb8\x1d\xb1\x98\x71\xb6\x2e\x78\xa9\x9e\x8a\x57\xdf\x1a\x1d\x7c\xcd\x45\x37\x2d\xf6\xb2\x8e\x96\x05\xdb\xdd\x77\xae\x33\x49\xd2\xe6\xb2\x27\x61\xed\xeb\x5d\x0d\xd0\xb9\x5f\xe0\xfa\xc6\xab\xb0\x55\x84\x82\x5c\x82\xfc\xcc\x35\x49\x60\xff\xfd\xd0\x7f\x19\x74\x12\xac\xb0\x64\xf6\x67\x22\x50\xca\x0c\xa9\xfb\xaf\x89\x55\xf5\xa8\x9d\xb4\xd9\xb4\x78\xa0\xff\xa0\x5b\x2d\x5f\x7a\xc5\x73\x57\xa2\x9a\x4f\xb5\x94\x9b\x4d\xfc\xd3\xa8\x3e\x1e\xc7\xee\x6d\x75\x1c\xbb\xff\xf1\x63\xdf\x18\xfe\x1b\x00\x00\xff\xff\xfa\xb7\x7f\x2d\x0e\x24\x00\x00"
+var _baseHeadTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x1a\x6d\x73\xdb\xb6\xf9\xb3\xf4\x2b\x50\xae\xbd\xdb\x7a\x25\x19\xb7\x69\xd7\x4b\x25\xf5\x3c\x27\x69\xbd\x8b\x93\x5c\x6c\xef\xb6\x4f\x3a\x88\x7c\x4c\xc2\x06\x01\x04\x00\xa5\x68\x1a\xff\xfb\x0e\x00\x49\x81\x14\x69\x4b\xee\x6e\xfe\x22\x12\xc0\xf3\xfe\x0e\x7a\xf6\xd5\xeb\x0f\x17\x37\xff\xfa\xf8\x06\xe5\xba\xa0\x8b\xe9\xac\xf9\x01\x9c\xa2\x14\x6b\x1c\xaa\x72\x55\x4a\x3a\x0f\x76\xbb\x73\x21\xae\xcb\xd5\xed\xa7\x77\x55\x15\x2c\xa6\x93\x59\x01\x1a\xa3\x5c\x6b\x11\xc2\xe7\x92\xac\xe7\xc1\x05\x67\x1a\x98\x0e\x6f\xb6\x02\x02\x94\xb8\xb7\x79\xa0\xe1\x8b\x8e\x0d\xde\x5f\x50\x92\x63\xa9\x40\xcf\x6f\x6f\xde\x86\x3f\x07\x28\x1e\x44\xf3\xcf\xf0\xf6\x3c\xbc\xe0\x85\xc0\x9a\xac\xa8\x8f\xe9\xf2\xcd\x1c\xd2\x0c\x02\x03\xb7\xdb\x91\x3b\xc4\xb8\x46\xd1\x47\x9c\xc1\xa5\x3a\x4f\x0b\xc2\xaa\x6a\x3a\xa9\x31\x32\x5c\xc0\x3c\xc0\xa5\xce\xb9\xf4\x50\x58\xb0\xe8\x13\x08\xae\x88\xe6\x72\x5b\x55\xbb\x5d\xf4\x61\xc3\x40\x46\xef\x71\x01\xe6\x15\xa8\x82\xaa\xfa\x8d\x67\x6a\xb7\x03\x96\x56\x95\x63\xb4\x83\x37\x05\x95\x48\x22\x34\xe1\xec\x29\xe4\xfb\xd7\x96\x42\xf7\x54\xf4\x7a\x8f\xac\xaa\x50\x88\x76\xbb\xd1\xdd\x9a\x23\x9f\x49\x44\x14\xc2\x48\x60\xc2\x28\x28\x85\x14\xd0\xbb\x30\xe7\x4a\x43\x8a\x7e\x23\x1a\x29\x90\x6b\x92\xc0\xb8\x28\x0f\xb0\xdd\x70\x99\x2a\x4f\x8e\x8c\x7f\x87\x32\xa2\xbf\xf3\x91\x7d\x87\x32\x9e\xa9\xc0\x6a\xde\xa2\x9a\x76\xb0\x48\xb8\x03\x29\xc1\x57\x35\xe3\xe1\x7e\x35\x5e\x74\xcf\x2f\x13\x25\xef\x3a\xaa\x8b\x2e\xae\x3f\xbd\xbd\xe1\x0f\xc0\xaa\x6a\xe0\xbc\xf3\xc3\x0e\x84\xef\x90\x06\x60\x3a\x99\x7d\x15\x86\xe8\x83\x00\x86\x7e\x93\x58\xe4\xe8\x06\x67\x0a\x85\x61\xe3\x2e\x7d\x57\x71\x6a\x44\xfb\x9d\x5b\x05\xf2\xa3\xe4\x77\x84\x82\xe7\x4a\x42\x72\x01\x52\x6f\xe7\x01\xcf\x5e\xf5\x99\xa8\x9d\xe7\xf7\x9b\xab\x77\x7b\x4e\x06\x21\x75\x37\x2c\x84\xa3\xf3\xc8\x79\xa2\x29\x0c\xd1\xf2\xdd\xc8\xad\xbc\x2d\x29\x75\xab\xe8\xcf\xbb\xdd\xc1\xe2\x5f\x1a\xeb\x8f\x50\x1a\xf3\xe6\x0e\x3d\x94\x63\x85\xf6\x6b\x65\xf1\x96\x53\xca\x37\x20\x55\x55\xa1\xbb\xe6\x19\x61\x96\x1a\x8f\x74\x0b\x84\x65\x03\x20\x84\x65\x55\x85\x04\x70\x41\x21\x1a\xe3\x89\x14\x38\x1b\x94\xfe\x7c\x8d\x35\x96\xef\x08\x7b\x68\x94\xed\x99\xd1\x8f\xbd\x23\xed\xe7\x85\xda\xe9\x46\xe4\xab\x7b\x48\xf4\x49\x36\xf4\xe8\xed\x2d\x74\xb2\x65\xc6\x12\xc4\x09\xea\xf4\x50\x3c\xae\xd9\x63\x55\x79\x2e\xc4\x69\xda\xdb\xc0\x4a\x11\x7d\x52\x08\x9c\x0b\xf1\x1c\x8d\x1d\x9f\x28\x4f\x70\xc8\x46\x5e\x52\x64\xf1\x1d\x5e\x93\x84\xb3\x48\xb0\x6c\x5c\x1c\x23\xec\xd2\xe4\xb3\x1e\x67\x7e\x56\x9d\x4e\x66\x94\xb0\x07\x24\x81\xce\x03\x95\x73\xa9\x93\x52\x23\x83\x3b\x40\xb9\x84\xbb\x5e\xe2\x8b\x87\x88\x4f\x27\x33\xa7\x04\xa4\x64\xd2\x07\xb8\x57\xf1\xfd\xe7\x12\xe4\x36\xfc\x21\x7a\x19\x9d\x45\x05\x61\xd1\xbd\x0a\x16\xb3\xd8\xc1\x2c\x9e\x82\xa6\x64\xd5\xa0\x88\xb0\x84\x70\xcb\xcb\x50\x95\x12\x0e\xb0\x78\x72\xe8\x2d\x05\x95\x03\xe8\x61\x21\xb0\x52\xa0\x55\x7c\xc7\x99\x0e\xf1\x06\x14\x2f\x20\x7c\x19\xfd\x14\xfd\x10\x27\xaa\xbb\x6c\xd9\x4d\x94\x0a\x9e\x45\x80\x27\xda\x28\x4a\x85\x2f\xa3\x1f\xa2\x17\xed\xab\x87\xb4\xae\x21\x8c\x6b\x58\x71\xfe\x10\xdd\x9b\x54\x26\x91\x04\x96\x82\x34\xe9\x8c\x88\xad\xce\x39\x6b\x4f\xb8\x8c\x57\x60\xf9\x00\xe9\xe1\x69\xb3\x9e\xf2\x0d\x43\xc4\x87\xf0\x2a\xd2\xa5\xba\xfc\x68\x11\xbe\xaf\x77\x5d\xb0\x8d\x1b\x40\xd0\x32\x23\x4c\xc5\x0d\xb6\x7b\x15\xbe\xb0\xc2\xb4\x2c\x0f\x18\xf4\x18\x84\x4e\x04\x8b\xec\xa7\xfa\x65\x08\xd5\xde\x4f\x9b\x3e\xe6\x73\x49\x24\x5c\x93\x42\x50\xb8\x7a\xfd\xc6\xf1\x7f\xbc\x65\x1a\xf2\xca\x22\x28\x52\x08\xcf\xa2\xb3\x17\xd1\xd9\x7e\xc1\x37\xf9\x31\x82\x3c\x81\xe9\x19\xaa\x49\x78\x0a\x05\x91\x92\xcb\xf0\xc7\xe8\xec\xaf\xd1\x8b\x18\xa7\x29\x67\x71\xc1\x53\x88\x29\xc7\xa9\x79\xf8\xdf\x20\xb6\x28\x4d\xe6\x18\x43\x67\x1e\x27\x17\x3c\x85\x2b\x0b\x17\x19\x80\xdb\x4f\xef\xd0\x1c\xa1\xd3\x88\x7c\xf3\x3e\xfe\xe6\xbd\xa1\xf2\x8b\x41\x3e\x64\x60\x1b\x09\xd7\xad\xfd\x9c\xdb\x9e\x60\x5a\x13\xbb\x0a\x0a\xcc\x34\x49\xc2\xef\xa3\x97\xd1\xf7\xcf\x8c\x5e\x83\xc8\x74\x9f\x06\xf4\xd7\xf5\x7c\xb7\xbb\x7a\xfd\x23\x3a\x17\xe2\x1f\x20\xeb\x59\x84\x71\x5f\x51\x06\x9d\x79\x9a\x44\xa9\xe4\xc2\xc4\xdf\xab\x9c\xaf\x41\xa2\x05\x8a\x0a\x60\x25\xda\xa1\x94\x28\x41\xf1\xf6\x15\x5a\x51\x9e\x3c\xfc\x82\x2a\x7b\xbc\x24\x91\x82\x84\xb3\x14\xcb\xad\x3b\xd9\x62\x88\x88\x86\xc2\x43\x50\x60\x99\x11\x16\x6a\x2e\x5e\xa1\x17\x0e\x1e\xcd\xe2\x86\xf4\x2c\xde\x73\x54\x2b\xf2\xef\x78\x8d\xaf\xed\x52\xad\xc8\x47\x93\xec\x80\xde\x4e\x4a\xd2\x56\x5d\xf7\x43\xda\xda\xa3\x98\x4e\x66\xb6\xc0\x2e\x5c\x24\xdf\x98\x67\x3b\xb2\xd4\x4f\x76\x14\xa9\xe7\x8d\xb6\xee\xce\x62\x07\x33\xed\xf6\xe7\x3a\x87\x02\xc2\x84\xd3\xde\xb4\x75\x63\xd6\x2f\xcc\xf2\x15\x68\x7c\x83\x33\x6b\x30\xe3\x65\xd1\x45\xa9\x34\x2f\x7e\x07\x9c\x5e\x33\x22\x04\x68\xd7\x92\x6b\x28\x04\xc5\x1a\x50\x40\x98\x69\xae\x62\x33\x8b\x06\x28\xaa\xaa\xe9\xcc\x3e\x2f\xa6\xb3\x15\x4f\xb7\x46\x05\x29\x59\xa3\x84\x62\xa5\xe6\xc1\x5d\x49\x29\xca\x81\x64\xb9\x76\x89\xa2\x35\xc0\x4d\x4e\x14\xaa\x5b\x0d\xb4\xe1\xf2\x41\xa1\x15\x68\x0d\x12\x6d\x88\xce\x3d\xbb\x74\x8d\x76\x30\x61\x5e\x32\xa5\x31\xa5\x95\x75\x95\x0e\xe9\xb6\xd7\x5d\x61\x89\x68\xcb\x42\xf7\x54\x49\xac\x5e\x30\x61\x20\xeb\xed\xfe\x7e\x26\x49\xda\x6c\x75\xf6\x12\x4e\xcb\x82\xb5\x5b\x7d\x38\xcd\x05\x6a\xfd\x16\x19\x07\xdd\x1f\x9d\xcc\x70\x73\xd2\x7a\xf0\x4a\x62\x96\x0e\x87\x99\x07\x34\x99\x91\x22\xf3\x28\x14\x84\x11\x54\x77\x40\x03\x0e\xd7\x6f\x42\x3c\xea\x31\xb6\xca\x74\x7f\x4d\xcd\x7b\xc7\xb3\x0c\x52\xa7\xc9\x21\x2e\xfd\x69\xed\x35\x56\xf9\x8a\x63\x99\x56\x15\xc2\x89\x26\xeb\xfd\x2c\x3b\x2c\xc4\x6e\x17\x91\xb3\x9f\x59\x74\x23\x51\x90\x36\xc0\x81\xf1\x5c\xbc\x38\x86\xe0\xa5\x52\x25\xa8\xe3\xa8\x11\x7b\xb6\x4b\xb3\x5e\x3b\x9e\xe0\xc7\x92\xd2\x23\xe9\x09\x73\xb4\x4b\xce\x2c\x2d\x25\x7c\x2e\x41\xe9\x03\xaa\x5e\x03\xff\x24\x1b\xbf\xf3\x02\x8e\xe3\xa2\xcb\x40\xce\x0b\x18\xa0\x5b\x17\x91\x27\xc9\xbe\xf9\x22\x28\x97\x47\x52\x06\x77\x38\x96\x66\x76\xe9\xb2\x51\x6f\x1d\x72\x12\x7f\xeb\x87\x8b\x21\xdf\x71\xf4\x6e\x28\x19\x17\x46\x84\x89\x52\x77\x0e\xd9\x95\xe6\x98\x02\x2c\x93\x7c\xc5\xbf\x04\xc8\x0c\x34\xee\x86\x2b\x40\x82\xe2\x04\x72\x4e\x53\x90\xf3\xc0\xe7\xcc\x9d\x5f\x0a\xc9\xed\xb0\x58\xcf\x2e\x2d\xea\x2e\x5a\xd7\xea\x2f\x66\x31\xf1\x0f\xc5\x29\x59\x1f\xbc\x7f\x1b\xfb\x1a\x7e\x24\xb6\x3c\x11\xa5\xc9\x4e\xfd\x14\x71\xa0\x85\xa6\xe8\x21\x7b\x0f\x68\x0b\xf5\x7d\x59\x08\x64\x33\x88\xe0\xc2\xe4\xba\x52\x04\xee\x86\xd0\x9f\x29\x5b\x99\x13\x09\xd8\x0c\x3b\xb0\x31\xf2\xba\x83\x6b\x2c\x09\x36\x83\xd9\x3c\xd0\x84\x6d\x11\x61\x6b\x90\x1a\xd2\x0e\x27\x93\x99\x12\x98\x35\xbc\x58\xcd\x76\xb6\x3d\x85\xd5\xed\x3b\xaa\x7f\x43\x41\x4b\x53\x22\x7d\x78\x25\x43\xce\xe8\x36\x58\x8c\xf2\x36\x8b\xcd\xf9\x9e\xbe\x1f\x25\xa3\x25\xc1\x2c\xa3\x10\x1a\x0d\x05\x07\x90\x35\xc2\xe9\x64\x58\xbb\x07\xaa\xef\xc7\xc6\xb0\xe3\x1b\x87\x8f\x1d\xe3\x3d\xe8\xa7\x15\x12\x93\x05\xf2\xe5\x67\xb0\x59\x1a\x7c\x81\xef\x24\x4d\xae\x7e\x16\x63\x05\xc9\xe4\x49\x9c\x19\xa8\x30\xa1\x9c\xc1\x08\x7f\x0d\xc6\xa7\x58\x74\x5e\xef\x7c\xde\xdc\xde\x45\x17\x98\x5d\x58\x35\x7d\x90\x19\x66\xe4\xdf\xd8\xdd\x8b\x9c\x2c\x17\x97\xd9\xc9\xfa\xe6\x1e\xc9\x11\xb9\xb8\xcc\x8e\x90\xa9\xbe\x60\x9d\xf4\x02\xde\xf6\x91\xc0\xd2\xa6\xc1\x42\x8e\x41\xc4\x60\x63\x43\xda\x35\x96\x63\x40\x6d\x50\xdb\xa3\x1e\xa8\x81\xfa\xe3\xb9\x40\xe3\x15\x61\x29\x7c\x99\x07\xe1\xd9\x63\x99\xa1\x54\x20\x97\xf5\xe5\xe7\x12\xb3\x74\x59\xb8\xac\xfd\xc7\x92\x04\xc2\xf6\xf2\xea\x20\x57\x74\xba\x18\x55\x60\x4a\x91\xe4\x25\x4b\x21\xed\xb5\x33\xbe\x0f\x7d\x02\xda\xb9\x0b\xeb\x21\x7d\x32\xc1\x8c\x89\x38\x90\x1a\x8e\xce\x33\x5d\xfd\x3e\x2b\xeb\xf4\x50\x4c\x27\x23\x87\x4b\x62\x6d\x0d\x7d\x6d\x4e\x3a\x45\x8d\x64\x0c\xd2\x25\x61\x4b\x6c\xba\x0e\x34\x53\x5a\x72\x96\x2d\xba\x9a\x6c\x66\x86\x7a\xb3\xe7\xf7\xb6\xa8\x8d\xb2\x91\x92\x35\xb1\x4c\xf4\xab\xdf\x91\x11\x3c\xc4\xc9\x09\xb9\x13\xa4\x1a\x8c\xe2\x2d\x2f\x5b\xe3\x9e\x90\x41\xfd\x96\xc7\x30\x74\x0d\x5a\x13\x96\xa9\xaa\xea\xb4\x3d\x68\x5c\x1e\xe3\x55\xb1\xaa\xc1\x8e\x17\x64\x0f\x31\x2c\x4a\xbb\x7f\x6a\x35\xd0\x58\x66\xa0\xe7\xc1\x72\x45\x31\x7b\x08\xdc\x28\xcf\x38\x17\xc0\x40\x22\xc6\xf7\x1f\x7f\x9c\x34\xe6\x1b\x9f\x7a\x15\xbb\xd1\x94\xf0\x38\xe5\x89\x6a\xa1\xda\xc3\x47\xcb\x65\x1b\xde\xe1\x44\x9b\x03\x15\x47\x56\x0e\xff\xc3\xe1\x91\x7e\x38\x9d\x0c\x6b\x65\xe0\x13\xd3\xb1\xa6\xc5\xe6\x78\x5f\x74\x4f\x76\x2b\xf8\x23\x86\xb4\xf0\x4b\x81\x19\xd0\xe0\x40\x94\xb1\xf2\x32\x7d\x96\xc0\xb3\x3b\x2e\x0b\x44\xd2\x79\x40\x79\xc6\x4b\x1d\x9a\xf7\xa0\xeb\x19\x38\x71\x09\x7c\xc0\x7f\x1d\x50\x80\x0a\xd0\x39\x4f\xe7\xc1\xc7\x0f\xd7\x37\x03\x69\xa6\xfd\x16\x68\x3e\xc9\x3c\x66\x1c\x55\xae\x0a\xa2\xc3\x55\xa9\x35\x67\x75\x09\x31\x1c\xcd\x83\x3f\xf9\xfc\x8d\xab\xf6\x20\x5c\x48\xc6\x42\xc3\xe2\xa1\x96\xcd\xd6\xd2\x6c\x1d\xaa\xf8\x30\x41\xc5\x86\xee\xe2\x88\x1a\xee\x0a\xd7\x29\xf5\x7b\x14\xa2\x07\xe0\x52\x34\x32\x7a\x47\xfb\xbe\xbf\x5b\xee\xdb\x01\x71\xfa\xff\x8e\xf3\x81\x80\xed\x0d\xcc\x4f\x8f\x2d\x2e\xe4\xae\x73\xbe\xf9\x04\x19\x51\x5a\xda\xce\xe1\x6f\xd6\x19\x7a\x0d\xd4\xe8\xfc\x79\x4d\x32\x76\x2b\x8e\x1b\x3f\x5d\x0a\x36\x6e\x50\x8a\xe3\xc7\x92\xb1\x4a\x22\x2d\xcf\x20\x83\x7e\xaf\x87\xbb\x32\xf6\xbb\xc1\xc7\x65\xb9\x64\x27\xc8\x42\x79\x46\xd8\xaf\x12\x52\x22\x21\xd1\x4b\xcd\xe7\xa6\x6a\x0e\x74\x3d\x4f\x04\x0c\x61\x63\xf1\x42\x58\x57\xbc\x9e\x8d\xbb\x1e\x8b\x19\x67\xdb\x82\x97\xea\x31\x7f\xf5\xb5\xd1\x83\x37\x17\x61\xbd\xb0\x38\x88\x3a\x73\x93\xb6\xdf\xef\x6d\x9b\x1b\xb8\x76\x73\x20\x60\xed\xed\x5d\x73\xa0\xb7\xbf\xc2\xcd\x8e\x97\x61\x77\x3b\x7b\xc9\x18\x5d\x61\x62\xc2\x1d\xb3\x04\x0e\x2f\x10\xfd\xab\x41\xc7\xc2\x06\x4b\x66\xbf\x1f\x81\x52\x38\x03\x54\xec\xc1\x07\xae\x16\xeb\x54\xd2\xde\x2a\xb6\xb6\xda\x48\x60\x9d\x2b\x05\xaf\x4d\xfb\x7a\x6f\x28\x0f\xfb\xd2\x7c\x1b\x08\xbc\x96\xed\x95\x31\xe9\x6b\x77\x5b\x7e\xe5\xb8\x69\xd4\x6f\x3d\xcf\x7c\xfd\x8a\xce\xcd\x05\xa8\xad\x79\x0a\x7d\xbd\xaf\xa9\xa3\x44\x5c\xbd\x5a\x6d\x05\x56\xa6\xef\xf0\x8d\xba\xcf\xa5\xed\xd3\x7e\xbb\x8e\x77\x90\x6b\x90\xef\xb9\x26\xcf\x52\xe6\xd3\x0a\xdc\xed\x3a\x34\xd0\x7f\xd0\xb5\x96\xdf\x7b\xa5\xe8\x11\x26\xcd\x7d\xd3\xb4\x59\x9e\xc5\xee\xa6\x7a\x16\xbb\xff\xab\xb2\x37\x36\xff\x1d\x00\x39\x50\x44\xff\x74\x25\x00\x00"
 
 func baseHeadTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -592,8 +716,8 @@ func baseHeadTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "base/head.tmpl", size: 9230, mode: os.FileMode(0644), modTime: time.Unix(1582806009, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0x7, 0x54, 0xbd, 0xb9, 0x6c, 0x61, 0xd7, 0x90, 0x81, 0xd0, 0x8, 0x67, 0xc2, 0x5d, 0xec, 0x39, 0xe0, 0xc6, 0x95, 0x8d, 0x6e, 0x52, 0xed, 0xd4, 0x9d, 0x5b, 0xb9, 0x1e, 0xa1, 0xb6, 0xc6}}
+	info := bindataFileInfo{name: "base/head.tmpl", size: 9588, mode: os.FileMode(0644), modTime: time.Unix(1792278081, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0xc1, 0x18, 0xd7, 0xa7, 0x98, 0xbe, 0xf8, 0x2d, 0x1c, 0x7d, 0x90, 0xac, 0xa5, 0xb, 0x1b, 0x1c, 0xd, 0x81, 0xea, 0xf5, 0x97, 0xec, 0x3f, 0x8f, 0x28, 0x1a, 0xb7, 0x58, 0x13, 0x35, 0x90}}
 	return a, nil
 }
 
@@ -677,7 +801,7 @@ func exploreRepo_listTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _exploreReposTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x51\x6e\x86\x20\x10\x84\x9f\xf5\x14\x84\x03\xd4\x0b\xb4\xbd\x4a\xb3\xc2\x54\x37\x41\x20\xb0\x62\x13\xe3\xdd\x1b\x95\xb4\xb6\xbf\xf9\x9f\x96\x30\xc3\x37\xcc\xae\xab\x60\x8a\x8e\x04\x4a\xf7\x94\xd1\x8d\x20\xab\xd5\xcb\xb6\xb5\xaf\x96\x8b\x32\x8e\x72\x7e\xd3\xf8\x8a\x2e\x24\xa8\x84\x18\x32\x4b\x48\x8c\xac\xdf\xdb\xe6\xea\x99\x59\x99\xe0\x85\xd8\x23\xed\xda\x7f\x71\x48\x6c\x8f\xfb\xe6\x1a\x5a\xc9\x9d\xa7\xd2\x53\x3a\x93\x9b\xbf\x6f\x65\x81\x2b\x50\x0b\x5b\x28\x13\xdc\x3c\xf9\x23\x08\x5e\x4e\xdc\x2d\x2f\x83\x92\x19\x7f\x78\xb7\x9e\xbd\xcd\x87\xe3\x2c\xcf\x6d\x91\x06\xfc\x7e\xac\xb3\x5c\x8e\x76\xf5\x50\x67\x1d\x0f\xfb\xfc\x0c\x41\x50\x7b\x7d\x07\x00\x00\xff\xff\x19\xdb\x58\x1b\x6d\x01\x00\x00"
+var _exploreReposTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x94\xcd\x8e\xd3\x30\x10\xc7\xcf\xd9\xa7\x18\xe5\xde\x44\xa0\x3d\x70\xe8\x56\x42\x42\x08\xa4\x5d\x40\xdd\xe5\xbc\x72\xed\x69\x33\x34\xb1\xa3\xb1\xdb\xae\x64\xfc\xee\x28\xb1\x9b\xba\xa1\x70\xf2\xc7\x7f\xe6\x37\x1f\xce\xc4\x7b\x87\x5d\xdf\x0a\x87\x50\x6e\x84\xc5\xba\x41\xa1\x4a\xa8\x42\xb8\x5b\x2a\x3a\x82\x6c\x85\xb5\x0f\x25\xbe\xf5\xad\x61\x04\xc6\xde\x58\x72\x86\x09\x6d\xb9\xba\x2b\x72\x9b\x03\x81\x34\xda\x09\xd2\xc8\x83\x36\x17\x77\x4c\x6a\xbc\x2f\xf2\xa0\x89\x5c\x6b\x71\xdc\x08\x8e\x91\x8b\x6b\x5f\x77\xc2\xf6\x88\x70\x22\x85\x20\x4d\x7b\xe8\xf4\x18\x08\xb5\x8b\xb8\x9b\x3c\x8b\x82\x65\x33\xf1\x0a\xef\x69\x0b\xd5\x1a\xa5\xe9\x3a\xd4\x0a\xd5\x7a\x28\x25\x89\xc5\xb2\xb9\xcf\x32\x75\xa6\x07\xe1\x9c\x90\x0d\x2a\x18\x1a\x92\x0a\x8a\x9c\x8a\xde\x7d\xd0\xd5\x0b\x4f\xb1\x2a\xbe\x50\x5f\xc7\x0e\x95\x13\xb7\x6e\xee\x93\xe7\xac\x1b\x13\xdf\xe2\xae\x43\xed\x20\x83\x2c\x22\xe4\x1c\x72\xe6\xc9\xd8\x8a\x37\x54\xd0\x92\x75\x93\x4d\xe1\x3d\x0b\xbd\xc3\x7f\x97\x38\x03\x91\xc3\xee\xe2\x5d\x14\x4b\x3a\x2b\x46\x3a\x92\x46\x43\x5a\x17\xb1\x73\x5f\xed\x0f\xa6\xa3\x70\x18\x42\x6b\xe4\xde\x7b\x6c\x2d\x42\x54\x3e\x1b\xde\x87\x30\x24\xbd\xd8\x1a\xde\xa3\xba\x52\x9f\x88\xd9\x70\xd2\x65\x6b\x34\x46\x39\xde\x78\x8f\x5a\x85\x50\xae\x96\x35\xe5\xe9\x64\xa9\x5e\x3f\x76\xd2\xc5\x59\x4d\xef\x03\x0d\xe3\xf6\xa1\xf4\xfe\x63\xdf\x3f\x1f\x36\x3f\xd7\x8f\x21\xd4\xde\x57\xdf\x4f\x1a\xb9\xfa\x26\x3a\x8c\xe7\xb8\x2b\x57\x33\x09\x6a\x98\xc4\x65\x2d\xf2\x50\xb1\xfe\x4f\x68\x25\x53\xef\xc8\xe8\x10\xf2\xec\xd4\x45\x80\x46\xd8\x05\x76\xe6\x17\x8d\xfc\xcc\x05\x7e\xc3\xb3\xe3\xf7\x5f\x5e\x9e\x1e\x07\xbe\xa2\xe3\x2a\x15\x9e\x95\x3c\x5e\xdf\xdd\x3e\x5e\x5b\xe7\x5a\xb6\xcf\x8d\x6e\xcd\xc4\xd0\xef\xd7\xf1\xb3\x81\xea\x3f\x66\xbd\xd8\xe1\x65\x10\xcf\xf8\xf3\x26\xad\x69\xf9\xeb\xff\xb1\x35\xc6\x61\x9a\xe3\x3f\x03\x00\xc0\xd3\xed\xa5\x5d\x04\x00\x00"
 
 func exploreReposTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -692,8 +816,8 @@ func exploreReposTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "explore/repos.tmpl", size: 365, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xca, 0xb1, 0x58, 0x8, 0xeb, 0x89, 0x96, 0xa5, 0xd6, 0xec, 0x9f, 0xb5, 0x54, 0x1f, 0x8e, 0x6f, 0xf8, 0x92, 0x55, 0x2f, 0x11, 0x55, 0xc0, 0x9f, 0xe3, 0xfd, 0xf2, 0x5a, 0x3f, 0x9c, 0xd4, 0xad}}
+	info := bindataFileInfo{name: "explore/repos.tmpl", size: 1117, mode: os.FileMode(0644), modTime: time.Unix(1792273437, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5, 0xcf, 0xec, 0xda, 0x3d, 0x77, 0xd7, 0xa8, 0xc7, 0x84, 0xef, 0x3d, 0x47, 0x66, 0x90, 0x8a, 0x2d, 0xad, 0x5e, 0x65, 0x97, 0xa3, 0x90, 0x16, 0xf8, 0xc3, 0xcb, 0xad, 0x2a, 0xc1, 0x1e, 0xd6}}
 	return a, nil
 }
 
@@ -737,7 +861,7 @@ func exploreUsersTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _homeTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\x5b\x73\x13\xc7\xb6\xc7\x9f\x93\x4f\xb1\xd0\x0b\x2f\xb6\x5c\xd9\x4f\xa7\x76\x01\x55\x1c\xc2\xce\xc9\x29\x12\x72\x20\xd9\xfb\x31\xd5\x9a\x59\x1a\x35\x9e\xe9\x16\xdd\x3d\x02\xe3\x72\x95\x6c\x03\xe1\x22\x30\xe4\xe2\x93\x10\xf6\x0e\x3e\x21\x3b\x86\x13\x88\x0d\x16\x08\x49\x96\xab\xb6\xbe\x40\xcf\x67\x88\x24\x8b\x27\xbe\xc2\xa9\xee\x19\xf9\xc2\x25\xc8\x8e\x31\x86\x3a\x79\x88\x6e\x33\x3d\x3d\x6b\xfd\xd7\xfa\xff\x66\x7a\xcc\xe8\xa8\xc2\x20\xef\x13\x85\x90\xca\x10\x89\x43\x39\x24\x6e\x0a\xd2\x63\x63\xef\xee\x71\x69\x01\x1c\x9f\x48\xb9\x37\x95\xe3\x01\xa6\xf6\xbd\xfb\xce\xda\xef\x42\x0a\x52\x11\x67\x98\x64\x7c\x84\x80\xba\xae\x8f\x50\x40\x31\x02\x02\x7d\x72\x12\x5d\xc8\x13\x0f\xc1\x13\xd4\x35\x7b\xae\xdb\x55\xd2\x93\x0a\x91\xc1\x09\xea\x22\x38\xc8\x14\x0a\x20\x3e\xf5\x18\xba\xc9\x47\xf3\x86\xfb\x61\xc0\xec\xbe\xeb\x76\xf6\xb9\xc7\xe3\x6f\xdf\xd9\x43\x03\x0f\xa4\x70\xf6\xa6\x46\x47\xf7\xe7\xf3\x47\xc3\xcc\x67\x47\x0e\x8d\x8d\x0d\xd1\xc0\x1b\xf2\xb8\x27\x07\x73\x28\x78\x3a\xcf\xbc\x14\x0c\xc5\xe3\x0c\xb9\xb4\xf0\xcc\x88\x66\xab\xde\x88\xb9\x3f\xed\x1b\x1d\x4d\xd3\xf7\xfe\x8d\xa5\x3f\x15\x90\x22\xf9\xfc\xe7\x2e\x4a\x27\x35\x36\xb6\x67\x28\xf7\xa7\xf5\x83\xf4\xde\xf4\x5e\x47\x47\x69\x16\xf0\x38\xa4\x0f\x11\xe6\x41\xca\xc5\xc1\xf7\x0f\xa6\xc6\xc6\x9e\x3a\xf9\x0d\xc6\x6d\xdd\xbe\x48\xbd\x9c\x5a\x17\xb6\xb5\x51\x7a\x67\x4f\xee\xbd\xb5\xe7\x04\x21\x05\xea\x70\x06\x26\xa9\x28\x92\x8d\xde\xd9\x43\x7b\x1b\x71\x47\xd9\xdf\x93\xd7\xc1\xac\x4f\x4c\x9e\xf7\x0c\xd1\x7d\x70\x90\xb2\x2c\x71\x72\x70\x2a\x04\xca\xa4\x22\xbe\x4f\x51\x20\x8b\x8f\x33\x94\x7b\x2f\x39\x62\x7e\x25\x2d\x44\x78\xd8\x3b\xc4\x51\x45\x84\x42\xc0\x64\x8c\x3d\x04\x94\xf9\x59\xed\x4d\x7d\x9e\xf1\x09\x1b\x4e\x99\x93\xdd\x9b\x62\x9c\xe7\x91\xa1\x00\xc6\x05\x66\x51\x08\x14\x29\xc8\x09\xcc\xee\x4d\xe5\x94\xca\xcb\x3f\x0f\xd9\x34\xa6\x29\x1f\x72\xb9\x23\x87\x92\x89\x10\x45\x39\xeb\x7d\xf8\x3c\x2b\x78\xf0\x79\x86\x32\x22\x46\xd2\x39\x15\xf8\xa9\x7d\x2e\x45\xd8\xcf\x4e\x20\x73\x43\xe6\xed\x19\x22\xfb\x20\xdb\xac\x0b\x70\x91\x32\x84\x4f\x7c\xa2\x54\x96\x8b\x20\x0d\x1f\x70\x4f\x82\x47\x33\x0a\x50\x02\x09\x9d\x5c\xbc\xdd\x1f\x99\x2d\x55\xb9\x30\x93\x76\x78\x60\x26\x4e\x95\xb4\xf3\x1f\x52\x02\x71\x28\x20\x52\xa1\x30\x27\x32\x6c\x72\xf1\xbe\x7d\x35\xb3\x1b\xd8\xaa\x23\x22\x0a\x9f\x32\xcf\x0b\x47\x86\x08\x93\x34\xe3\xe3\x60\x81\x78\x82\x30\x35\x88\x27\x49\x90\xf7\x71\xfd\x54\xcc\xdc\x52\xfb\xfe\x1a\x6f\x62\xe3\xc4\x5d\x5b\x89\x72\x1b\x32\x96\x37\x25\xe0\xa1\x4c\x72\xf6\xe1\x9a\x0d\x65\x9e\x0c\xa3\x9d\x50\x3a\x91\x5b\xfe\x77\xaa\xf7\x15\xd7\x84\x8b\x05\xea\xe0\xa0\x8b\x72\x58\xf1\x7c\x52\x1c\x2b\x22\x6a\xd6\x33\x28\x3c\x81\x34\x8b\xcc\xed\xaf\x36\xac\xea\xfc\xe6\xcd\x30\xab\xc0\xee\x4e\x7c\x3f\xbd\xd9\x80\xdb\x78\xfb\x84\x79\x69\x2e\xbc\xa1\xd4\xbe\x0f\xb8\xcd\xe3\x30\x0f\xf2\xd4\x14\xac\xb2\x92\xfe\x33\xfc\x8d\x32\x97\x9f\x90\x03\x10\x10\xe7\xf0\xd1\x01\x38\x44\x59\x78\x72\x00\xf6\x1f\xf9\x68\x00\x50\x39\x69\xf8\x5b\xf3\x66\xce\x47\x70\x89\x3c\x86\x8c\x7a\x08\x47\x47\xa4\xc2\x60\x00\x4e\x10\x09\x2e\x15\x40\x02\x08\x90\x4a\x85\x0c\x3c\xcc\x36\x6f\xfa\xbe\xda\xf5\xfc\xec\xac\xbe\x79\x33\xba\x9e\x30\x95\xa8\x92\xcc\x1e\x42\xea\xe4\x94\x87\x27\xcc\xcb\x06\x12\x9a\x23\x0a\x02\xca\x68\x40\xfc\x5e\xec\x08\xcb\x72\xe1\xa2\x08\x99\x87\x0c\x42\xe6\xc2\x30\x61\x0c\x24\xfa\x19\xa9\x80\x84\x59\xd3\x1f\x31\x00\xaf\x59\x67\x52\xd1\xde\x46\x52\x09\x1e\xc8\x3c\x11\xc8\x5c\x64\x70\x84\xc8\x7c\x06\x85\x18\x81\x4f\x28\x64\x50\x09\x8a\x19\x63\x9d\x28\x5c\x64\x3b\xa0\x3e\x1c\xee\xf6\x2c\xe3\xbf\x42\xf4\x7d\x9e\xcd\xf6\xeb\x12\xef\xa3\xb0\x4a\xf5\x51\x29\x84\x03\xdc\x45\xc8\x60\x96\x32\x17\x15\x48\xea\xe4\x6c\x8c\xb6\xbe\x1b\xa7\xf6\x7d\x40\xd5\x7f\x84\x19\x53\x29\xbb\xe0\x33\x13\x12\xa9\x9a\x75\x75\x0a\x21\x64\x12\x32\x48\xc1\xb4\xc1\xbf\xa2\xc8\xa0\x94\x36\x7f\xe0\x52\x94\x28\xe1\x13\xc1\x8f\xe1\xb0\x92\x69\xf8\x54\x90\x10\x5c\xea\xe4\x5e\x52\x04\xa3\xa3\xe8\x4b\x84\x75\x60\x70\x2a\x37\x78\xe0\xe3\x37\x16\x0c\x3a\xdf\x7e\xd5\xbe\x7b\xbe\xfb\xe3\x99\xfe\x92\xdc\x99\x98\x7d\xfc\xdd\xcd\x56\xf5\x6c\x7b\xea\xd7\x56\xed\xa7\xce\x8d\x47\x9d\x4b\x77\x3b\x5f\x5d\x6a\x2d\x5e\x5f\xbe\x5f\x5b\xae\xfd\xd0\x7e\x74\xbf\x3d\x35\xf7\xb8\x78\xad\xbb\xf4\xc5\x76\x93\x42\xab\x5a\xea\x2e\x7d\xdf\x3e\xf7\xa0\xbb\x74\xa5\x3b\x53\x32\x8a\x78\x52\x2f\x75\x97\xbe\x8d\x27\xbb\x05\x93\xda\x0c\x10\x40\xe7\xdc\xf4\x8e\x61\x82\x27\xf5\x52\xab\xf6\x53\x7b\xea\xc2\xf6\x33\x41\xbb\x74\x66\xf9\xee\xcc\xf2\x95\xb3\x36\x28\xb1\xe8\x7e\x2b\x4e\xbc\xfe\x9e\xf7\x5c\x26\xe8\x3e\x9c\x8d\xa5\xdc\x5f\x5d\xb4\x6a\xb5\xd6\xe2\x37\x5b\xe7\xfb\xd0\xfd\xf5\x4e\x77\xb6\x18\xeb\xe7\xeb\x5f\x3b\xa5\xf1\xe5\x6b\xa7\x93\xda\x9a\x5c\x8c\xf5\x1c\xab\xdc\x72\xef\x93\x7a\xa9\x5d\x3a\xd3\xb9\xf8\x4b\x0f\x0d\x7e\x2b\x8e\x7f\x44\x9c\xdf\x8a\xe3\x16\x0d\x20\xc9\xf9\xfe\x23\x1f\xfd\x56\x9c\xe8\x94\xae\xb6\x2a\xc5\x56\xe5\x76\x67\x62\xb6\x3d\x7d\xbd\xf3\xcb\xff\x98\xb1\xe7\xe6\xbb\x33\xa5\x27\xf5\xf1\xb7\x90\x02\xba\x8b\xb5\xc7\x5f\x4c\x2d\x57\x7f\xee\x33\x97\x36\x38\xed\xda\xf9\x56\xed\xe1\xf2\xb5\xd3\x9d\x1b\x57\xbb\x97\xbf\xea\x2c\x34\x96\xaf\x9d\x7e\x7c\xe6\xd2\xf2\xe2\xdd\xee\x83\xfb\xa6\xf7\xd5\x66\xba\x0f\xee\xc7\x97\x1d\x66\xb3\xeb\xc5\xd6\xe2\xe5\xb8\x09\x2e\xcf\xfc\xd2\xaa\x3d\xe8\xfe\x73\xbc\x33\x3f\x61\x02\x7e\xbd\xd8\xbe\xf9\xf3\xf2\xec\xc5\x76\xf5\x9f\xad\xca\x85\xee\x85\x89\xe5\xeb\xe3\x9d\x89\x59\xbb\xd7\xa5\xf6\x85\x99\xf6\x77\xb3\xdd\xf2\xe9\x4e\xf5\xca\x0b\xe3\xff\x9a\x18\xa0\x5d\x2f\x76\xaa\x57\xda\xa5\xe9\x3e\xdd\xe1\x7c\xb1\x73\xfd\xfc\xf2\xb5\xd3\xad\xda\x8f\xcb\x37\xc6\x8d\x52\xe3\x01\xae\xcf\xbe\x62\xe7\x87\x56\xe5\x82\xe9\xf5\xe5\x07\xed\xa5\xff\x6d\x5f\xb8\xd1\x3e\xf3\x53\xe7\xdc\xd5\x56\xed\x97\xce\xdf\x7f\x6a\x9f\x99\x6f\x5f\x29\xb5\xa7\xae\xb6\xe7\xbf\xe9\x2e\x7d\xd7\xaa\xdc\x6e\xd5\x7f\x30\x09\xb9\x76\xfa\xf1\xcc\xa3\xe5\xef\xef\x3e\xa9\x8f\x77\x97\xbe\x5d\xbe\x73\xbe\x55\x2b\xb6\x1e\x9d\x7b\x52\xff\xa1\x73\xee\x4a\xab\x52\xed\x2e\xcc\x2c\x97\xee\x76\x8b\x67\xda\x57\x7e\x7e\x69\x65\x3c\x0f\x0d\xb2\x62\xf0\x2f\x47\xde\x58\x34\xf8\x0b\x71\xa8\x8f\xd0\xbc\xd1\xbb\x65\x80\xa2\x2f\x19\x00\x00\x7c\xe8\x83\x0c\xb3\x59\xaa\xc0\xc5\xed\xc6\x00\x9f\x30\x07\x05\xf8\xbb\xf1\x64\xf3\x96\x13\x2a\x13\x66\xab\x11\x87\x0b\x81\x32\xcf\x99\x4b\x98\x32\xe7\x55\xe0\x4a\x20\xc8\x11\xa9\x9a\xb3\x01\xa6\xdf\x85\xe7\xfc\x77\x38\x04\x77\x77\xa8\xa8\x4f\x25\x8a\xb8\xda\x49\x01\x9d\xed\xc7\x08\x1e\x3e\x77\x7e\x3b\x85\x2c\x9e\x3b\x39\x1e\x02\x32\xf0\x77\xf7\x32\xc7\x8c\x1e\xf2\x21\x95\x10\xb2\xed\x27\x90\xe4\xe3\x4e\xbe\x15\xf1\x51\xe8\x2b\x3a\x68\x6f\xe1\x66\xb9\x08\x70\x03\xd7\xac\x8a\x87\x82\x21\xe4\x89\x50\x3c\x54\xc0\x9b\x8f\xb6\xf8\x36\x44\x1e\x43\x05\xcd\xdb\xa6\x64\x1c\x7b\x47\xa2\x79\x0b\xfa\xb8\x17\x71\x20\xc7\xa9\xa4\x52\xe2\xa9\xa4\xde\xf2\xa2\x79\x2b\xdb\xbc\x25\x9a\xb7\xe0\xad\xbc\xe7\xd0\xbc\xe5\xf5\xd9\x29\xe3\xc4\x25\xcd\xc5\xc4\xd7\x74\x4b\x81\x52\xf2\x50\x38\x28\xd3\xa6\x8d\xda\xa8\x07\xcd\xdb\x01\x26\x19\x16\x20\x43\x61\xea\x67\xdd\xbd\x04\x25\x9a\xb3\x12\x32\x9c\x41\x40\x84\x93\x6b\xde\x4a\x43\xf3\xbc\xc3\x19\x0f\xa8\x89\xbc\xbf\xbb\x79\x8b\xa1\xf0\x28\x9a\x43\x14\xb8\x04\x89\xa2\x80\xa1\x90\x2f\xca\xc1\x6b\x22\x8e\xc3\x79\x64\x70\xd4\x9e\x7f\x7f\x21\xfc\x94\x87\x0a\x25\xf8\x28\x21\x09\x1b\x48\xce\x94\x0d\xd2\x2b\x66\x8e\x5d\x70\x04\x8f\x71\xea\x31\x3c\x35\xc8\x78\x28\x01\x15\x38\x9c\x29\x41\x33\x21\x9e\x32\x06\x23\x90\xb9\xa6\x5c\x8c\xe8\xf9\x31\x54\x80\xcc\xe1\x02\x21\x40\xea\xfb\x18\x8a\x17\xb4\xa1\xdf\x63\x0a\x94\x83\x07\x8f\xbe\xb9\x4c\xd1\x9c\x71\xa8\x6f\x34\x18\x37\x6a\xd2\x67\xa1\x1c\xa5\xc6\x82\x02\x33\xbb\xed\xc6\x09\x22\x04\x61\x0e\x01\xf4\xc1\x7e\x4f\x93\x6e\x48\x04\x01\x15\x82\xe9\xd5\xc4\xf4\x6a\x92\x86\xc3\x10\x4a\x12\x33\x82\x09\xc0\xf6\x23\xc2\x8e\xa1\x81\x01\xe0\x71\x5f\x73\xd0\x04\xee\x35\x58\xfd\xf1\x10\xd5\xce\xb7\x7a\xa3\x1e\x2b\x9e\x0d\xf8\x45\x36\x64\x0e\xe5\x8c\x18\xb4\x72\x42\xe2\x1f\x0f\x29\x0a\xeb\xfb\xb8\xe9\xc5\xa7\x17\x59\x7e\x88\x6e\xcf\xed\x89\x90\x08\xc8\xfa\x70\xfc\x5d\x07\x7d\xea\xa1\x29\x8e\x2c\x29\x70\x41\x15\x79\x2b\x7d\x9e\x7a\x28\xf8\x46\x00\x8d\xa2\xe1\x33\xee\x70\x09\x02\x8f\x87\x54\x52\xc5\x25\x8c\x24\x51\xee\xa5\x55\x80\x5d\x3a\x20\x4f\xad\x15\x10\x41\x14\x49\xc3\xae\xfd\x39\x2e\x84\xc9\x3d\x0a\xaf\x79\xe7\x45\x91\x7d\x53\xdc\xfb\x5f\x33\x07\xa5\x6a\xce\x80\xe2\x2e\x37\xe7\xfd\xea\xd7\x07\xa8\xe0\x72\xc5\xa5\x47\x90\xb9\x1c\x08\xe4\x88\xb9\x62\x44\xa9\xac\x51\x8f\xa0\xa3\xb8\x99\x12\x29\x34\xef\x10\x08\xf0\x18\x17\x69\xf8\xd7\xcc\xc7\x1c\x24\x12\x09\xaa\x79\x27\xa0\x2e\x87\x11\x13\x47\x92\xe1\xe2\x65\xfa\x7e\x9e\x8f\xe7\xd5\xe0\xbf\xbf\xc1\xf7\x06\xfe\x90\x8f\xcb\xd7\x62\xe4\x82\xbb\x08\x1c\xf0\x24\x3a\xa1\x6a\xce\x14\xd0\x5f\x35\x72\x93\xd9\x10\x24\xb5\x2b\x7d\xc0\xf3\x28\x88\x2d\x46\x3f\x0d\x87\x43\xe0\x19\x85\x2c\x67\xb6\x4a\xbc\x3d\xd8\x3a\xab\xdd\xc8\x0d\x80\x9d\x64\xef\x21\x64\x08\x3d\x89\x7f\x30\x10\x9b\xbd\x8e\xe7\x6f\x80\xb7\x0f\xae\xa2\xe1\x06\x5c\x42\x70\x97\x00\x06\x70\xdc\x3a\xbb\xb9\xda\x7b\x56\x94\xf1\xef\x9b\x2e\xa0\x17\x98\xbd\xc3\x99\x44\x2f\x5c\xf5\xfb\x3e\xac\xfe\xa0\x74\xb8\x9f\x23\x76\xb6\x50\xe0\x4e\xf3\x36\x78\x5c\x2a\x02\x01\xa1\xf2\xad\x74\x7d\x2c\x20\x20\x88\xe6\x4c\x9e\xba\x1b\x71\x7f\x4b\xc3\xa7\x08\xe4\x79\x18\x03\x80\x13\x0a\xc9\x25\xe0\x6a\xdc\x03\x94\x01\xb7\x02\x30\x99\x8b\x2d\x9f\xaf\xc3\x80\x34\x1c\x8c\xaf\xe9\x4f\x61\x4c\x00\xd4\x5c\x97\x34\x6f\x29\x41\x1d\x02\x2e\x01\x19\x12\x08\x9a\x33\xc7\x43\xca\x76\x18\x1a\x1c\x68\xde\x77\xa9\xc7\x81\x64\x50\xa8\x3e\x03\x97\xb0\x41\xe8\x72\x13\x8f\x57\xcd\x06\x07\x12\x28\x20\x80\xa0\xb8\x60\xb8\x02\x04\xc7\x50\x71\x20\x94\xb9\x06\x06\xfc\x9c\xa1\x81\x8f\x9b\x3f\x72\x88\x5d\xa1\x80\xc2\xe3\xe6\x8d\x45\xe5\x78\x0c\x2a\x36\x81\x04\x22\x1c\x3c\xf2\xd9\x1b\x8b\x04\xfa\x87\xa8\xa8\x1b\xd1\x78\x34\xa1\x1b\xfa\x11\xe8\x7b\x10\x4d\xda\x4f\x73\x7a\x51\x37\xf4\x3d\x5d\xd5\xe5\xfe\xd2\xbe\x76\xa4\xed\x86\x04\xfd\x50\xcf\xe9\xa5\x64\xe6\x95\x68\x42\x97\x41\x57\xa2\x71\xbd\xa4\x1b\xba\xa6\x17\xa3\x29\x5d\xd6\xf5\xe8\xa2\x7e\x04\xd1\x69\x3d\xa7\x1f\xe9\x9a\xed\x9d\x7a\x41\xd7\xa2\x29\xd0\xf7\xf4\x5c\x74\x4e\x97\xcd\xf9\x2f\xe9\x9a\x9e\x8b\x26\xa2\xd3\xba\x11\x15\xcd\x3e\x69\xd0\xdf\xf6\x46\x8a\x4a\xfa\x61\x34\xa9\x1f\xd9\x03\xd8\xfe\x10\x8d\x6f\x3f\x51\xe8\x8a\xae\xe9\xca\x0e\xa2\x8a\x64\x42\x36\x09\xf7\xa3\x62\x34\xa9\x1f\x26\x49\xd8\x76\xc8\xd0\x4b\x7a\xce\x48\x36\x9a\xd8\xc9\xa0\xa1\xaf\x25\xa5\x32\xfe\xb4\xde\x74\x59\x2f\xea\x45\xa3\xd4\x0d\x98\x54\x54\xd4\x73\x7a\x5e\x37\x6c\xd5\x96\xa3\x09\xd0\x8b\x7a\x0e\x74\x2d\xba\x6c\xbe\x35\xaa\x6e\xe8\x25\x5d\x36\x9b\x45\x67\x75\x45\x37\xcc\x31\xec\x0f\xd1\xb8\x29\x13\x93\x2a\x73\xe8\x01\xd0\x55\x3b\x4a\xc3\x6e\x3a\x05\xba\xae\x1b\xfa\x41\x3c\x64\x55\x37\x74\x5d\x2f\xc5\xb9\x36\xd3\xb7\x55\x33\x11\x95\xb6\x96\x67\x7e\x8f\x5e\x40\x57\x20\x9a\x48\x83\x5e\x48\x83\xfe\x32\xba\xa8\xe7\xed\x4c\xe6\xe2\x82\x1c\x80\xe8\x0b\xdb\x7b\xcc\xc4\x74\x1d\xec\xb9\xd7\xa2\x92\xa9\x6c\xd0\x8b\x76\xc3\x7b\x46\x97\xd1\x78\x34\xf5\x36\x42\x8e\xfe\x5e\x97\xf5\x7d\x9b\xa8\x7b\xba\x1c\x8d\x6f\x58\x47\xba\x62\x05\x98\x28\xa8\xa2\x1f\xea\xaa\xae\xe8\xf2\x7a\x95\x98\x41\xcb\x10\x4d\x44\x45\x5d\xb6\x21\xbe\x67\xad\xa2\x62\xe4\x52\x59\xab\x98\xb5\xaa\xb4\x3a\x89\x55\xb9\xa8\xcb\x7a\xc1\x2a\xac\xa1\xef\x1b\x4d\x3d\x85\x4a\xfa\x8e\x3d\x03\xa3\xcf\x7a\x54\xb2\x5d\x24\xba\x64\xf6\x8a\x8a\xfa\xbe\xae\x44\x97\xd7\xb5\xeb\xba\x7d\x5b\x31\xb3\xda\x59\xe0\xa4\xff\x11\x4d\xe8\x6a\x54\x8c\x2e\x46\x13\xd6\x78\x6c\x08\xcf\xe8\x86\x5e\x88\xf3\x12\x57\xd4\x42\x9f\xde\xfa\x65\x34\x1e\x5d\x85\xe8\x52\x2c\x70\x13\xc8\x57\xcd\x55\xd6\xce\x8d\x87\x36\x6c\xc2\x2a\xd6\x45\x6d\xa1\x45\xe3\x26\x99\x55\x3b\x0d\x5d\x1f\x30\xf9\x58\xb4\xdd\xcc\x3a\x69\xd5\x34\x34\xbd\xd0\xab\x46\x3d\x1f\x5d\x34\x02\x5a\xd0\xe5\xa4\xd3\x95\x92\xd3\x30\x2a\x5b\xb2\x32\x28\xeb\xaa\xf9\x50\x8e\xce\x9b\x4a\xad\x45\x93\xd1\x17\x26\xbf\x69\xd0\x7f\x37\x5f\xd8\x1e\xb6\x7a\xe0\x25\xdb\x88\x8c\x78\xec\x68\x9b\x20\xb6\x70\x78\xf0\xb3\xfd\x6f\x03\xb1\x55\x4c\x0b\x9f\x04\x7d\x6f\x0d\xaf\xd5\x12\x0b\xe9\xf3\xe1\x9f\x9d\x42\x6d\xd1\x74\xdc\x23\xee\xe9\x4a\x5c\xff\xd1\x64\xaf\xb3\xfc\x3e\xb5\x35\xa2\xff\x7e\x86\xda\x74\xc5\x18\x44\x3c\x52\xac\x62\x2b\xc5\x7b\x3b\x80\xdd\x6c\x4b\xdc\x49\xec\x16\x4f\xc8\xa6\xc2\xc6\xdb\x4a\xe9\xc1\xff\xd3\xdb\xcb\xe9\xed\x19\xd5\x2d\x46\xd3\x56\xca\xa5\x8d\x78\xee\x52\x8c\x65\xd1\xe5\xe8\x6b\x5b\xcb\xf3\xd1\xa4\x5e\x88\x4a\x83\xd1\x94\xae\x46\xd3\x4f\xc1\x9b\xf9\xc2\x1c\xe6\x29\x74\x8b\xa6\x07\x20\x3a\x6f\xd2\x98\xd8\xef\x0a\xae\x45\xd3\x16\x03\x2d\xaa\x6d\xfe\x9a\x61\x13\xa8\x16\x4d\xaf\xa2\xda\x3f\xd6\x83\x5a\x32\xd5\x1e\xa5\xd9\x29\x26\x94\xb6\x10\xff\xb0\x64\x6d\xb2\x61\x76\x7b\xdb\x41\xcd\x48\x26\x6e\x72\x1b\x11\x8d\xc1\x9e\xaf\x13\x48\x8b\x4a\x46\x28\x4f\x43\xda\x74\xdc\x4a\x63\xa3\x34\xd8\x6c\xd8\x6b\x45\x1d\x2b\xa2\x5b\x51\xc6\x0b\xe0\x2c\x9a\x7c\x1a\xcf\xbe\x59\x83\x67\xf6\x3a\xbb\xdc\x83\xb3\x68\x7a\x15\xce\x6c\x57\x5e\x81\xb3\x17\xa6\xf1\x75\xc1\xd9\x97\xd1\xb4\x5e\x30\x78\x66\xaf\x53\x2b\xbd\x72\x2a\x46\x67\x75\xd9\xc6\x6d\xe3\x78\x66\x74\x7d\xd6\xfc\x6f\x72\xbb\xd0\xec\x6b\x8b\x92\x93\x2b\x6c\x34\x95\xd4\xcf\xa2\x9e\x8b\xc6\x07\xc0\x26\x71\x7e\xd5\x57\x17\xed\x35\x41\x43\x57\xe3\x5e\xa1\xe7\x41\x3f\x8c\xb7\x89\x83\x60\xa7\x6f\x6f\x81\xac\x81\xb2\x18\xc9\xaa\x56\x2e\xe7\xd7\x20\x99\x69\x41\xeb\x0e\x9b\x60\xd9\x5c\x8c\x65\x2f\xad\xdb\x18\xcb\xde\x54\x04\x3b\x48\xe4\x08\x28\xde\x7b\xc2\x76\x03\x8b\x68\x23\xdb\xbe\x7c\x16\x32\x50\x39\x8c\x1f\x82\x19\x89\xff\x10\x97\x0b\x18\xe1\xa1\x80\xde\x63\x0c\x69\x38\x2c\x40\xe6\x68\x3e\xe6\xa2\x13\x54\xfd\xb1\x3f\x17\xde\xdc\x3a\xd9\x96\x3d\xf8\xb5\x15\xeb\x64\x02\x3c\x54\x40\xd5\x6b\x7b\xdc\xd5\xdd\xc9\x04\x74\x40\x70\x29\x07\x7b\xf2\xd9\xc8\x32\x59\xc8\x24\x10\x36\x72\x22\x87\x62\xcb\x17\xc2\x08\x4b\xd6\xc0\xd0\x48\xbc\xbf\x67\x5c\xb9\x44\x5b\x1e\x9c\xa1\xa9\x09\xf0\x79\x01\xdf\x46\xe4\x38\x64\x8e\x75\xc2\x1e\x71\x03\xe9\xca\x11\x09\x3e\x3f\xd1\xfb\xa3\xda\xf8\xf9\x17\x61\x1f\xe8\x33\x69\x74\x6d\xcc\x4d\x87\xe1\x0c\x08\x03\xca\xf0\x64\x1e\x99\xa4\x05\x7c\x8a\x1a\x8e\x92\x02\xc6\x3d\x27\x20\x4e\x8e\xb2\x64\x1d\x6c\x64\x67\x61\xc1\x86\x9f\x83\xf9\x50\xed\x96\x40\x7c\x1f\x5e\xc9\x63\x83\xeb\x4d\xff\x3f\x39\x65\x10\x4a\xc8\x8c\xac\x2c\x57\x29\xca\x3c\x63\x43\x01\x19\x36\x2a\xa6\x32\x5e\xf5\x72\x14\x60\x01\x19\x64\x50\x29\x14\x69\x78\x9f\xb3\xdd\x0a\x32\x08\x32\x67\x5d\x2b\x83\x40\x56\xc7\xe0\x7d\xac\x79\x31\x77\x6c\xec\xdd\xe4\xf3\x33\xff\x5a\x49\x96\x73\x65\xce\x29\x3d\x36\xf6\xee\xff\x05\x00\x00\xff\xff\x9c\x5e\xaf\x54\xcb\x44\x00\x00"
+var _homeTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x9c\xdf\x73\x13\xc7\x96\xc7\x9f\x93\xbf\xe2\xe0\x17\x5e\x6c\xb9\x92\xa7\xad\x5b\x40\x15\x4b\xb8\x49\xb6\x20\x64\x21\xb9\xf7\x31\xd5\x9a\x39\x1a\x35\x9e\xe9\x16\xdd\x3d\x32\xc6\xeb\x2a\xd9\x06\xc2\x0f\x81\x21\x3f\xbc\x09\xe1\xde\xe0\x0d\xb9\x31\x6c\x20\x36\x58\x20\x2c\xd9\xae\xba\xfa\x07\xba\xff\x86\x48\xb2\x78\xe2\x5f\xd8\xea\x9e\x91\xb1\x0d\x49\x6c\x16\x8c\xcd\x2e\x0f\xe8\xd7\x4c\xf7\x99\x73\xbe\xe7\x9c\xcf\x4c\xcf\x78\x78\x58\x61\x54\x08\x89\x42\xe8\xc9\x12\x89\xfd\x79\x24\x7e\x0f\x64\x46\x46\xde\xde\xe3\xd3\x22\x78\x21\x91\x72\x6f\x4f\x9e\x47\xd8\xb3\xef\xed\xb7\x56\x7f\x17\x53\x90\x8a\x78\x03\x24\x1b\x22\x44\xd4\xf7\x43\x84\x22\x8a\x21\x10\x18\x92\x93\xe8\x43\x81\x04\x08\x81\xa0\xbe\xdd\x73\xcd\xae\x92\x9e\x54\x88\x0c\x06\xa9\x8f\xe0\x21\x53\x28\x80\x84\x34\x60\xe8\xa7\x1f\xed\x1b\x1e\xc6\x11\x73\xfb\xae\xd9\x39\xe4\x01\x4f\xbe\x7d\x6b\x0f\x8d\x02\x90\xc2\xdb\xdb\x33\x3c\xbc\xbf\x50\x38\x16\x67\x3f\x3d\x7a\x68\x64\xa4\x9f\x46\x41\x7f\xc0\x03\xd9\x97\x47\xc1\x33\x05\x16\xf4\x40\x7f\x32\x4e\xbf\x4f\x8b\xcf\x8c\x68\xb7\xea\x8e\x98\x7f\x77\xdf\xf0\x70\x86\xbe\xf3\x2f\x2c\xf3\x89\x80\x1e\x52\x28\x7c\xe6\xa3\xf4\x7a\x46\x46\xf6\xf4\xe7\xdf\x5d\x3b\x48\xf7\x4d\xf7\x75\x78\x98\xe6\x20\xf3\x01\x8f\xf0\x23\xae\xa8\x87\x23\x23\xeb\x8e\x3b\xa6\xe0\x71\xa6\x08\x65\x28\x9e\x3d\xb0\x98\x02\x65\x39\x0e\x11\x4a\x69\x5d\x67\x9d\xde\xc7\xdc\x48\x10\x11\x31\xe0\xf3\xc1\xd4\x1d\x6f\x0d\x0f\xaf\x9a\x06\xfe\x03\x8e\x29\xf1\xee\x07\x9f\x1c\x3e\xe4\x66\x7c\xd6\xc0\xe1\x61\x64\xfe\xc8\x48\x6a\x21\x9e\x80\xcc\x21\xc2\x02\xe8\xf1\xb1\xef\xbd\x83\x3d\xcf\x33\x73\x33\x91\x5d\xb3\x2f\xd2\x20\xaf\xd6\x04\x76\x75\x1c\xdf\xda\x93\x7f\x67\xb5\xd7\xc1\x1e\xb3\xc7\x19\x58\xd9\x75\x7d\x62\xe3\xda\xdd\x88\x7b\xca\xfd\x9e\xbe\xf6\xe5\x42\x62\x95\xb8\xa7\x9f\xee\x83\x83\x94\xe5\x88\x97\x87\x53\x31\x50\x26\x15\x09\x43\x8a\x02\x59\x32\x4f\x7f\xfe\x9d\x74\xc6\xc2\x8a\x70\x88\x08\xb0\x3b\xc5\x31\x45\x84\x42\xc0\x74\x8c\x3d\x04\x94\xfd\x59\xed\xed\xf9\x2c\x1b\x12\x36\xd0\x63\x0f\x76\x6f\x0f\xe3\xbc\x80\x0c\x05\x30\x2e\x30\x87\x42\xa0\xe8\x81\xbc\xc0\xdc\xde\x9e\xbc\x52\x05\xf9\xa7\x7e\x27\xb4\x0c\xe5\xfd\x3e\xf7\x64\x7f\x6a\x08\x51\x94\xb3\xee\x87\xcf\x72\x82\x47\x9f\x65\x29\x23\x62\x28\x93\x57\x51\xd8\xb3\xcf\xa7\x08\xfb\xd9\x20\x32\x3f\x66\xc1\x9e\x7e\xb2\x0f\x72\x8d\xba\x00\x1f\x29\x43\xf8\x38\x24\x4a\xe5\xb8\x88\x32\xf0\x3e\x0f\x24\x04\x34\xab\x00\x25\x90\xd8\xcb\x27\xdb\xfd\x6f\xac\xa5\x2a\x1f\x67\x33\x1e\x8f\xac\xe1\x54\x49\xfb\x22\xfb\x95\x40\xec\x8f\x88\x54\x28\xec\x81\x0c\xd8\x58\xbc\xe7\x5e\xad\x75\xbd\x2f\x6b\x46\x44\x11\x52\x16\x04\xf1\x50\x3f\x61\x92\x66\x43\xec\x2b\x92\x40\x10\xa6\xfa\xf0\x24\x89\x0a\x21\xae\x35\xc5\xda\xd6\xb3\xef\x2f\xc9\x26\xce\x4f\xdc\x77\xb5\x42\x6e\x41\xc4\x0a\x36\x05\x02\x94\x69\xcc\x3e\x5c\xb5\xa1\x2c\x90\x01\x74\x06\x65\x52\xb9\x15\x7e\xa7\xbe\xbc\xe2\x9c\xf0\xb1\x48\x3d\xec\xf3\x51\x0e\x28\x5e\x48\x93\x63\x45\x44\x8d\x7a\x16\x45\x20\x90\xe6\x90\xf9\x1b\xcb\x0d\xa7\xba\xb0\x71\x33\xce\x29\x70\xbb\x93\x30\xcc\xbc\xa8\xc3\x9d\xbf\x43\xc2\x82\x0c\x17\x41\x7f\xcf\xbe\xf7\xb9\x8b\xe3\x00\x8f\x0a\xd4\x26\xac\x72\x92\xfe\x13\xfc\x95\x32\x9f\x0f\xca\x5e\x88\x88\x77\xe4\x58\x2f\x1c\xa2\x2c\x3e\xd9\x0b\xfb\x8f\x1e\xee\x05\x54\x5e\x06\xfe\xda\xb8\x99\x0f\x11\x7c\x22\x8f\x23\xa3\x01\xc2\xb1\x21\xa9\x30\xea\x85\x41\x22\xc1\xa7\x02\x48\x04\x11\x52\xa9\x90\x41\x80\xb9\xc6\xcd\x30\x54\xbb\x9e\x1f\x9d\xa7\x6f\x76\x46\xd5\x13\x36\x13\x55\x1a\xd9\x43\x48\xbd\xbc\x0a\x70\xd0\xbe\x6c\x22\xa0\x79\xa2\x20\xa2\x8c\x46\x24\xec\xfa\x8e\xb0\x1c\x17\x3e\x8a\x98\x05\xc8\x20\x66\x3e\x0c\x10\xc6\x40\x62\x98\x95\x0a\x48\x9c\xb3\xf5\x11\x23\x08\x1a\x75\x26\x15\xed\x6e\x24\x95\xe0\x91\x2c\x10\x81\xcc\x47\x06\x47\x89\x2c\x64\x51\x88\x21\xf8\x98\x42\x16\x95\xa0\x98\xb5\xcd\x1d\x85\x8f\x6c\x1b\xe4\x87\xc7\xfd\x6e\xcb\xf8\xf7\x18\xc3\x90\xe7\x72\x1b\xed\x12\xef\xa1\x70\x4a\x0d\x51\x29\x84\x03\xdc\x47\xc8\x62\x8e\x32\x1f\x15\x48\xea\xe5\x9d\x8f\x5e\x7e\x35\xee\xd9\xf7\x3e\x55\x1f\xc4\x59\x9b\x29\xbb\xe0\x53\xeb\x12\xa9\x1a\x75\x75\x0a\x21\x66\x12\xb2\x48\xc1\x96\xc1\xbf\xa0\xc8\xa2\x94\x2e\x7e\xe0\x53\x94\x28\xe1\x63\xc1\x8f\xe3\x80\x92\x19\xf8\x44\x90\x18\x7c\xea\xe5\xff\x20\x09\x86\x87\x31\x94\x08\x6b\xc0\xe0\x54\xbe\xef\xc0\x47\x3b\x16\x0c\xda\xdf\x7c\xd9\xba\x7b\xbe\xf3\xc3\x99\x8d\x05\xb9\x3d\x36\xfd\xf8\xdb\x9b\xcd\xf9\xb3\xad\x89\x5f\x9a\xb5\x1f\xdb\x37\x1e\xb5\x2f\xdd\x6d\x7f\x79\xa9\xb9\x70\x7d\xf9\x7e\x6d\xb9\xf6\x7d\xeb\xd1\xfd\xd6\xc4\xcc\xe3\xd2\xb5\xce\xd2\xe7\x5b\x4d\x0a\xcd\xf9\x72\x67\xe9\xbb\xd6\xb9\x07\x9d\xa5\x2b\x9d\xa9\xb2\x55\xc4\x93\x7a\xb9\xb3\xf4\x4d\x62\xec\x4b\x30\xea\x45\x80\x00\xda\xe7\x26\xb7\x0d\x13\x3c\xa9\x97\x9b\xb5\x1f\x5b\x13\x17\xb6\x9e\x09\x5a\xe5\x33\xcb\x77\xa7\x96\xaf\x9c\x75\x4e\x49\x44\xf7\x6b\x69\x6c\x9b\x32\x41\xe7\xe1\x74\x22\xe5\x8d\xe5\x45\xb3\x56\x6b\x2e\x7c\xfd\xf2\xfa\x3e\x74\x7e\xb9\xd3\x99\x2e\x25\xfa\xf9\xea\x97\x76\x79\x74\xf9\xda\xe9\x34\xb7\xc6\x17\x12\x3d\x27\x2a\x77\xdc\xfb\xa4\x5e\x6e\x95\xcf\xb4\x2f\xfe\xdc\x45\x83\x5f\x4b\xa3\x87\x89\xf7\x6b\x69\xd4\xa1\x01\xa4\x31\xdf\x7f\xf4\xf0\xaf\xa5\xb1\x76\xf9\x6a\xb3\x5a\x6a\x56\x6f\xb7\xc7\xa6\x5b\x93\xd7\xdb\x3f\xff\x97\x1d\x7b\x66\xb6\x33\x55\x7e\x52\x1f\x7d\x03\x29\xa0\xb3\x50\x7b\xfc\xf9\xc4\xf2\xfc\x4f\x1b\x8c\xa5\x73\x4e\xab\x76\xbe\x59\x7b\xb8\x7c\xed\x74\xfb\xc6\xd5\xce\xe5\x2f\xdb\x73\x8b\xcb\xd7\x4e\x3f\x3e\x73\x69\x79\xe1\x6e\xe7\xc1\x7d\x5b\xfb\x6a\x53\x9d\x07\xf7\x93\xd3\x0e\xbb\xd9\xf5\x52\x73\xe1\x72\x52\x04\x97\xa7\x7e\x6e\xd6\x1e\x74\xfe\x31\xda\x9e\x1d\xb3\x0e\xbf\x5e\x6a\xdd\xfc\x69\x79\xfa\x62\x6b\xfe\x1f\xcd\xea\x85\xce\x85\xb1\xe5\xeb\xa3\xed\xb1\x69\xb7\xd7\xa5\xd6\x85\xa9\xd6\xb7\xd3\x9d\xca\xe9\xf6\xfc\x95\xdf\xf4\xff\x6b\x62\x80\x56\xbd\xd4\x9e\xbf\xd2\x2a\x4f\x6e\xb0\x3b\x9c\x2f\xb5\xaf\x9f\x5f\xbe\x76\xba\x59\xfb\x61\xf9\xc6\xa8\x55\x6a\x32\xc0\xf5\xe9\x57\xdc\xf9\xa1\x59\xbd\x60\x6b\x7d\xe5\x41\x6b\xe9\xbf\x5b\x17\x6e\xb4\xce\xfc\xd8\x3e\x77\xb5\x59\xfb\xb9\xfd\xb7\x1f\x5b\x67\x66\x5b\x57\xca\xad\x89\xab\xad\xd9\xaf\x3b\x4b\xdf\x36\xab\xb7\x9b\xf5\xef\x6d\x40\xae\x9d\x7e\x3c\xf5\x68\xf9\xbb\xbb\x4f\xea\xa3\x9d\xa5\x6f\x96\xef\x9c\x6f\xd6\x4a\xcd\x47\xe7\x9e\xd4\xbf\x6f\x9f\xbb\xd2\xac\xce\x77\xe6\xa6\x96\xcb\x77\x3b\xa5\x33\xad\x2b\x3f\xfd\x61\x66\x3c\x0f\x0d\x72\xa2\xef\xcf\x47\x77\x2c\x1a\xfc\x99\x78\x34\x44\x68\xdc\xe8\x5e\x32\x40\xb1\x21\x19\x00\x00\x7c\x18\x82\x8c\x73\x39\xaa\xc0\xc7\xad\xc6\x80\x90\x30\x0f\x05\x84\xbb\xf1\x64\xe3\x96\x17\x2b\xeb\x66\xa7\x11\x8f\x0b\x81\xb2\xc0\x99\x4f\x98\xb2\xc7\x55\xe4\x4a\x20\xc8\x21\xa9\x1a\xd3\x11\x66\xde\x86\xe7\xfc\x3b\x12\x83\xbf\x3b\x56\x34\xa4\x12\x45\x92\xed\xa4\x88\xde\xd6\x63\x04\x8f\x9f\x6b\xdf\x76\x21\x8b\xe7\x1a\xc7\x63\x40\x06\xe1\xee\x6e\xe4\x98\xd5\x43\x21\xa6\x12\x62\xb6\xf5\x04\x92\x7e\xdc\xce\x97\x22\x0e\xc7\xa1\xa2\x7d\xee\x22\xb3\xbd\x20\x81\x9b\x38\x67\x55\x3c\x16\x0c\xa1\x40\x84\xe2\xb1\x02\xde\x78\xf4\x92\x2f\x43\x14\x30\x56\xd0\xb8\x6d\x53\xc6\x73\x57\x24\x1a\xb7\x60\x03\xd7\x22\x0e\xe4\x39\x95\x54\x4a\x3c\x95\xe6\x5b\x41\x34\x6e\xe5\x1a\xb7\x44\xe3\x16\xbc\x91\xd7\x1c\x1a\xb7\x02\x14\x9b\x08\x5c\x5a\x5c\xac\x7f\x6d\xb5\x14\x28\x25\x8f\x85\x87\x32\x63\xcb\xa8\xf3\x7a\xd4\xb8\x1d\x61\x1a\x61\x01\x32\x16\x10\xaf\xbb\x96\xa0\x44\x63\x5a\x42\x96\x33\x88\x88\xf0\xf2\x8d\x5b\x19\x68\x9c\xf7\x38\xe3\x11\xb5\x9e\x0f\x77\x37\x6e\x31\x14\x01\x45\x3b\x45\x91\x4b\x90\x28\x8a\x18\x0b\xf9\x5b\x31\x78\x4d\xc4\x71\xa4\x80\x0c\x8e\xb9\xe3\xdf\x98\x0b\x3f\xe1\xb1\x42\x09\x21\x4a\x48\xdd\x06\x92\x33\xe5\x9c\xf4\x8a\x99\x63\x17\x1c\xc5\xe3\xdc\x2e\xc5\x9c\xea\x63\x3c\x96\x80\xca\xad\x59\x08\x9a\x8d\xf1\x94\x6d\x30\xf6\xaa\x8f\x4d\x17\x2b\x7a\x7e\x1c\x15\x20\xf3\xb8\x40\x7b\xed\x2d\x0c\x31\x16\x99\xcd\x33\x05\xca\xbe\x83\xc7\x76\x2e\x53\x34\xa6\x3c\x1a\x5a\x0d\x26\x85\x9a\x6c\x30\x51\x8e\x51\xdb\x82\x22\x6b\xdd\x56\xe3\x04\x11\x82\x30\x8f\x00\x86\xe0\xbe\xa7\x69\x35\x24\x82\x80\x8a\xc1\xd6\x6a\x62\x6b\x35\xc9\xc0\x11\x88\x25\x49\x18\xc1\x3a\x60\xeb\x11\x61\xdb\xd0\x40\x2f\xf0\xa4\xae\x79\x68\x1d\xf7\x1a\x5a\xfd\x89\x18\xd5\xf6\x6f\xf5\x56\x3d\x4e\x3c\x9b\xe8\x17\xb9\x98\x79\x94\x33\x02\xc8\xc0\x8b\x49\x78\x22\xa6\x28\x5c\xdf\xc7\xde\x97\xdd\xf2\x63\xf4\xbb\xdd\x9e\x08\x89\x80\x6c\x03\x1d\x7f\xd7\xc1\x90\x06\x68\x93\x23\x47\x8a\x5c\x50\x45\xde\xc8\x3e\x4f\x03\x14\x7c\x33\x80\x46\xd1\xf2\x19\xf7\xb8\x04\x81\x27\x62\x2a\xa9\xe2\x12\x86\x52\x2f\x77\xc3\x2a\xc0\x2d\x1d\x90\x75\x6b\x05\x44\x10\x45\x32\xb0\x6b\x7f\x9e\x0b\x61\x63\x8f\x22\x68\xdc\x21\x3b\xbc\x7b\xff\x73\xea\xa0\x54\x8d\x29\x50\xdc\xe7\xf6\xb8\x5f\xfd\xfa\x00\x15\x5c\xae\x74\xe9\x21\x64\x3e\x07\x02\x79\xe2\xa1\x00\x94\xca\x35\xea\x21\xf4\x14\xb7\x26\x91\x62\xe3\x0e\x81\x08\x8f\x73\x91\x81\x7f\x4e\x7d\xc4\x41\x22\x91\xa0\x1a\x77\x22\xea\x73\x18\xb2\x7e\x24\x59\x2e\xc8\x0b\x2c\x1b\x14\x54\xdf\xbf\x1e\xfd\x3f\xda\xc7\xe5\x6b\x69\xe4\xc2\x2e\x49\x71\xc0\x93\xe8\xc5\xaa\x31\x55\xc4\xf0\x69\x23\xb7\x91\x8d\x41\x52\xb7\xd2\x07\xbc\x80\x82\xb8\x64\x0c\x33\xf6\x02\x00\xcf\x2a\x64\x79\xbb\x55\xda\xdb\x23\xe0\xaf\x40\xaa\x7f\x78\x01\x60\x3b\xb5\xf7\x18\xb2\x84\x9e\x44\xe0\x5b\x10\xc6\x67\xcf\xe3\xb9\xda\x21\xa7\xf1\x64\xb3\xdd\x5d\x70\x9f\x00\x46\x70\xc2\x75\x76\x14\xcf\x13\x65\xf2\x3b\xbe\xe4\x66\xef\x71\x26\x31\x88\x9f\xf6\xfb\x0d\xb4\xfa\x83\xd2\xe3\x61\x9e\x38\x6b\xa1\xc8\xbd\xc6\x6d\x08\xb8\x54\x04\x22\x42\xe5\x9b\x79\x47\x41\x11\x01\x41\x34\xa6\x0a\xd4\xe7\x9b\x3d\xcb\x3f\x45\xa0\xc0\xe3\x04\x00\xbc\x58\x48\x2e\x01\x9f\xfa\x3d\x42\x19\x71\x27\x00\x1b\xb9\xa4\xe5\xf3\x35\x18\x90\x81\x83\xc9\x39\xfd\x29\x4c\x08\x80\x12\xc0\xb0\x71\x4b\x09\xea\x11\xf0\x09\xc8\x98\x40\xd4\x98\x3a\x11\x53\xb6\xcd\xd0\xe0\x40\xe3\xbe\x4f\x03\x0e\x24\x8b\x42\x6d\xd0\x71\x29\x1b\xc4\x3e\xb7\xfe\x78\xd5\x6c\x70\x20\x85\x02\x02\x08\x8a\x0b\x86\x2b\x40\x70\x1c\x15\x07\x42\x99\x6f\x61\x20\xcc\x5b\x1a\xf8\xa8\xf1\x03\x87\xa4\x2b\x14\x51\x04\xdc\xbe\x71\xa8\x9c\x8c\x41\xc5\x0b\x20\x81\x88\xfb\x8e\x7e\xba\x63\x91\x40\x7f\x6f\x4a\x7a\xd1\x8c\x9a\x31\xbd\xa8\x1f\x81\xbe\x07\x66\xdc\x7d\x9a\xd1\x0b\x7a\x51\xdf\xd3\xf3\xba\xb2\xb1\xb0\xaf\x1e\x69\xab\x21\x41\x3f\xd4\x33\x7a\x29\xb5\xbc\x6a\xc6\x74\x05\x74\xd5\x8c\xea\x25\xbd\xa8\x6b\x7a\xc1\x4c\xe8\x8a\xae\x9b\x8b\xfa\x11\x98\xd3\x7a\x46\x3f\xd2\x35\x57\x3b\xf5\x9c\xae\x99\x09\xd0\xf7\xf4\x8c\x39\xa7\x2b\xf6\xf8\x97\x74\x4d\xcf\x98\x31\x73\x5a\x2f\x9a\x92\xdd\x27\x03\xfa\x9b\xee\x48\xa6\xac\x1f\x9a\x71\xfd\xc8\x4d\xe0\xea\x83\x19\xdd\x7a\xa2\xd0\x55\x5d\xd3\xd5\x6d\x44\x15\xa9\x41\x2e\x08\xf7\x4d\xc9\x8c\xeb\x87\x69\x10\xb6\x1c\x32\xf4\x92\x9e\xb1\x92\x35\x63\xdb\x19\x34\xf4\xb5\x34\x55\x46\xd7\xeb\x4d\x57\xf4\x82\x5e\xb0\x4a\xdd\x44\x93\x32\x25\x3d\xa3\x67\xf5\xa2\xcb\xda\x8a\x19\x03\xbd\xa0\x67\x40\xd7\xcc\x65\xfb\xad\x55\xf5\xa2\x5e\xd2\x15\xbb\x99\x39\xab\xab\x7a\xd1\xce\xe1\x7e\x30\xa3\x36\x4d\x6c\xa8\xec\xd4\xbd\xa0\xe7\xdd\x28\x8b\x6e\xd3\x09\xd0\x75\xbd\xa8\x1f\x24\x43\xce\xeb\x45\x5d\xd7\x4b\x49\xac\xad\xf9\x2e\x6b\xc6\x4c\xf9\xe5\xf2\xcc\xef\xd1\x0b\xe8\x2a\x98\xb1\x0c\xe8\xb9\x0c\xe8\x2f\xcc\x45\x3d\xeb\x2c\x99\x49\x12\xb2\x17\xcc\xe7\xae\xf6\x58\xc3\x74\x1d\xdc\xb1\xd7\x4c\xd9\x66\x36\xe8\x05\xb7\xe1\x3d\xab\x4b\x33\x6a\x26\xde\x44\xc8\xd1\xdf\xe9\x8a\xbe\xef\x02\x75\x4f\x57\xcc\xe8\xa6\x75\xa4\xab\x4e\x80\xa9\x82\xaa\xfa\xa1\x9e\xd7\x55\x5d\x59\xab\x12\x3b\x68\x05\xcc\x98\x29\xe9\x8a\x73\xf1\x3d\xd7\x2a\xaa\x56\x2e\xd5\xd5\x8a\x59\xad\x4a\xa7\x93\x44\x95\x0b\xba\xa2\xe7\x9c\xc2\x16\xf5\x7d\xab\xa9\x75\xa8\xa4\xef\xb8\x23\xb0\xfa\xac\x9b\xb2\x9d\x14\xcc\x25\xbb\x97\x29\xe9\xfb\xba\x6a\x2e\xaf\x29\xd7\x75\xf7\xb6\x6a\xad\xda\x5e\xe0\xa4\xff\x6e\xc6\xf4\xbc\x29\x99\x8b\x66\xcc\x35\x1e\xe7\xc2\x33\x7a\x51\xcf\x25\x71\x49\x32\x6a\x6e\x83\xbd\xf5\x0b\x33\x6a\xae\x82\xb9\x94\x08\xdc\x3a\xf2\x55\x73\x95\x6b\xe7\xb6\x87\x2e\xba\x80\x55\x5d\x17\x75\x89\x66\x46\x6d\x30\xe7\x9d\x19\xba\xde\x6b\xe3\xb1\xe0\xaa\x99\xeb\xa4\xf3\xb6\xa0\xe9\xb9\x6e\x36\xea\x59\x73\xd1\x0a\x68\x4e\x57\xd2\x4a\x57\x4e\x0f\xc3\xaa\x6c\xc9\xc9\xa0\xa2\xe7\xed\x87\x8a\x39\x6f\x33\xb5\x66\xc6\xcd\xe7\x36\xbe\x19\xd0\x7f\xb3\x5f\xb8\x1a\xf6\x74\xe2\x25\x57\x88\xac\x78\xdc\x68\x2f\x40\x6c\xf1\x40\xdf\xa7\xfb\xdf\x04\x62\xab\xda\x12\x3e\x0e\xfa\xde\x2a\x5e\xab\xa5\x2d\x64\x72\x67\x51\x9b\x99\x4c\x6a\xc4\x3d\x5d\x4d\xf2\xdf\x8c\x77\x2b\xcb\xef\x53\xdb\xa2\xf9\xcf\x67\xa8\x4d\x57\x6d\x83\x48\x46\x4a\x54\xec\xa4\x78\x6f\x1b\xb0\x9b\x2b\x89\xdb\x89\xdd\x12\x83\x5c\x28\x9c\xbf\x9d\x94\x1e\xfc\x3f\xbd\xfd\x31\xbd\x3d\xa3\xba\x05\x33\xe9\xa4\x5c\xde\x4c\xcf\x5d\x4a\xb0\xcc\x5c\x36\x5f\xb9\x5c\x9e\x35\xe3\x7a\xce\x94\xfb\xcc\x84\x9e\x37\x93\xeb\xe0\xcd\x7e\x61\xa7\x59\x87\x6e\x66\xb2\x17\xcc\x79\x1b\xc6\xb4\xfd\xae\xe0\x9a\x99\x74\x18\xe8\x50\xed\xc5\xcf\x19\x5e\x00\xd5\xcc\xe4\x53\x54\xfb\xfb\x5a\x50\x4b\x4d\xed\x52\x9a\x99\x7c\x4a\x69\x73\xc9\x0f\x4b\xae\x4d\x2e\xda\xdd\xde\x74\x50\xb3\x92\x49\x8a\xdc\x66\x44\x63\xb1\xe7\xab\x14\xd2\x4c\xd9\x0a\x65\x3d\xa4\x4d\x26\xa5\x34\x69\x94\x16\x9b\x2d\x7b\xad\xa8\x63\x45\x74\x2b\xca\xf8\x0d\x38\x33\xe3\xeb\xf1\xec\xeb\x55\x78\xe6\xce\xb3\x2b\x5d\x38\x33\x93\xe6\xf2\x9a\xaa\xbc\x02\x67\xbf\x19\xc6\xd7\x05\x67\x5f\x98\x49\x3d\x67\xf1\xcc\x9d\xa7\x56\xbb\xe9\x54\x32\x67\x75\xc5\xf9\x6d\xf3\x78\x66\x75\x7d\xd6\xfe\x37\xbe\x55\x68\xf6\x95\x43\xc9\xf1\x15\x36\x9a\x48\xf3\x67\x41\xcf\x98\xd1\x5e\x70\x41\x9c\x7d\xda\x57\x6d\x94\x2c\xcd\xcd\x27\xb5\x42\xcf\x82\x7e\x98\x6c\x93\x38\xc1\x99\xef\x2e\x81\xac\x82\xb2\x04\xc9\xe6\x9d\x5c\xce\xaf\x42\x32\x33\xb9\x6e\xda\x14\xcb\x66\x12\x2c\xd3\xd5\x0d\x61\xd9\x8e\x7d\x2e\x97\xc8\x21\x50\xbc\x7b\x87\xed\x26\x16\xd1\x86\xb6\x7c\xf9\x2c\x66\xa0\xf2\x98\xdc\x04\x33\x94\x3c\x88\xcb\x05\x0c\xf1\x58\x40\xf7\x36\x86\x0c\x1c\x11\x20\xf3\xb4\x90\x70\xd1\x20\x55\xf9\xd7\xb0\x4e\x26\xb6\xd3\x3a\x99\x80\x00\x15\x50\xf5\xda\x6e\x77\xf5\xb7\x33\x01\x1d\x10\x5c\xca\xbe\xae\x7c\x36\xb3\x4c\x66\x1f\xee\x23\x6c\x68\x30\x8f\xe2\xa5\x2f\x84\x11\x96\xae\x81\xa1\x95\xf8\xc6\xee\x71\xe5\x12\x5d\x7a\x70\x86\x36\x27\x20\xe4\x45\x7c\x43\x6f\x7b\xc9\xab\x41\x37\xe3\xa6\x1e\xa8\x95\x10\xf2\xc1\xee\x43\xb5\xc9\xfd\x2f\xc2\xdd\xd0\x67\xc3\xe8\x3b\x9f\xdb\x0a\xc3\x19\x10\x06\x94\xe1\xc9\x02\x32\x49\x8b\xb8\x8e\x1a\x8e\x91\x22\x26\x35\x27\x22\x5e\x9e\xb2\x74\x1d\x6c\x68\x87\xdf\x07\xf3\xa1\xda\x2d\x81\x84\x21\xf0\x57\x7f\x0f\xcc\xbf\x71\xca\x20\x96\x90\x1d\x5a\x59\xae\x52\x94\x05\xb6\x0d\x45\x64\xc0\xaa\x98\xca\x64\xd5\xcb\x53\x80\x45\x64\xf6\x41\x65\x85\x22\x03\xef\x71\xb6\x5b\x41\x16\x41\xe6\x5d\xd7\xca\x22\x90\xa7\x63\xf0\x0d\xac\x79\xb9\x3f\xad\x91\x7e\x7e\xe6\xef\xa9\xe4\x38\x57\x28\xdc\x5f\x54\x79\xfb\x7f\x06\x00\x2a\xab\xb8\x6f\x6d\x45\x00\x00"
 
 func homeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -752,8 +876,8 @@ func homeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "home.tmpl", size: 17611, mode: os.FileMode(0644), modTime: time.Unix(1582806009, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9b, 0x32, 0xfc, 0x18, 0x69, 0x6e, 0x11, 0xac, 0xda, 0x42, 0xf4, 0x54, 0x38, 0x49, 0x8f, 0x87, 0x6d, 0xe9, 0x24, 0x6c, 0x85, 0x8f, 0xf1, 0x7b, 0xaf, 0x75, 0x5, 0xac, 0x4, 0xc9, 0x82, 0xa4}}
+	info := bindataFileInfo{name: "home.tmpl", size: 17773, mode: os.FileMode(0644), modTime: time.Unix(1792278081, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3, 0xea, 0x96, 0xe5, 0x87, 0x24, 0xb1, 0x65, 0x63, 0x3f, 0x6a, 0xc8, 0x8f, 0xfd, 0x50, 0x25, 0x8c, 0xb3, 0xf2, 0xfd, 0xfb, 0xc, 0xb5, 0x83, 0x7d, 0x4, 0x68, 0x79, 0x92, 0xba, 0x60, 0x65}}
 	return a, nil
 }
 
@@ -897,7 +1021,7 @@ func mailAuthReset_passwdTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _mailIssueCommentTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8f\x41\x4b\xc4\x30\x10\x85\xcf\xc9\xaf\x18\x73\x4f\x03\x9e\x04\xa7\x39\xb8\x8a\x1e\x56\x14\xb6\x08\x1e\xd3\x76\xd6\x46\x77\x9b\xda\x9d\xaa\x25\xe6\xbf\x4b\x0c\x7b\x1a\xde\xcc\x7b\xef\x63\xf0\xe2\xf6\x69\xd3\xbc\x3e\xdf\xc1\xc0\xc7\x83\x95\x78\x1e\xe4\x7a\x2b\x05\x1e\x89\x1d\x0c\xcc\x93\xa6\xcf\xc5\x7f\xd5\x6a\x13\x46\xa6\x91\x75\xb3\x4e\xa4\xa0\x2b\xaa\x56\x4c\x3f\x6c\x72\xf6\x1a\xba\xc1\xcd\x27\xe2\x7a\xe1\xbd\xbe\x52\x60\x72\x0d\x7b\x3e\x90\x8d\xb1\xda\x2d\xed\x3b\x75\x9c\x12\x9a\xb2\x93\x68\x0a\x4b\x62\x1b\xfa\x35\x9b\xa7\x6c\xbc\x09\xfd\x0a\xbf\xb0\xe3\xf9\xf2\xa1\x79\xdc\xe6\xc0\x54\x8e\x52\x08\xad\xb5\x14\x02\xdb\x39\x0b\x74\x30\xcc\xb4\xaf\x55\x8c\xd5\xd6\x8f\x1f\x29\x29\xfb\xe2\xe9\x1b\x3c\x43\x18\xe1\x3e\xbc\x9d\xd0\x38\x5b\x49\xf1\x5f\x81\xa6\x70\xd0\x94\x57\xff\x02\x00\x00\xff\xff\x0c\xd8\x55\xc2\x02\x01\x00\x00"
+var _mailIssueCommentTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x3c\x90\x4f\x4f\xc3\x30\x0c\xc5\xcf\xcd\xa7\x30\xbd\x37\x91\x38\x21\xe1\x46\x88\x0d\xc1\x61\x30\xa4\x4d\x48\x1c\xb3\xd5\x5b\x03\x6d\x52\x1a\x8f\x51\x85\x7c\x77\xd4\x56\xe3\x64\x3d\xeb\xbd\x9f\xff\xe0\xd5\x72\xbd\xd8\xbe\xbf\x3e\x40\xcd\x6d\xa3\x05\x5e\x0a\x99\x4a\x8b\x0c\x5b\x62\x03\x35\x73\x57\xd0\xd7\xc9\x7e\x97\xf9\xc2\x3b\x26\xc7\xc5\x76\xe8\x28\x87\xfd\xac\xca\x9c\xe9\x87\xd5\x98\xbd\x85\x7d\x6d\xfa\x40\x5c\x9e\xf8\x50\xdc\xe4\xa0\x46\x0c\x5b\x6e\x48\xc7\x28\x37\xa7\xdd\x07\xed\x39\x25\x54\x73\x4f\xa0\x9a\x67\x09\xdc\xf9\x6a\xd0\x22\x8b\xd1\x1e\x40\xae\xac\xfb\x0c\x6b\xd7\x0c\x29\x89\x2c\xc3\x4e\xdf\xc5\x28\x97\x9e\x7a\xb9\xb4\xa1\x6b\xcc\xf0\x62\x5a\x4a\x09\xce\xbd\x67\x02\x03\x8e\xce\xd0\x52\x08\xe6\x48\x12\x55\x37\x71\xa8\x09\x74\x89\xc7\x28\xef\x7d\x35\xc0\x2f\x6c\xb8\xbf\x7e\xda\x3e\xaf\x52\xfa\xf7\xb9\x6a\xb4\xe1\xa8\xb2\xa2\x28\xc6\xc4\xae\x1f\x05\x1a\xa8\x7b\x3a\x94\x79\x8c\xd3\x46\x29\xe5\xfa\xcd\xd2\x19\x2c\x83\x77\xf0\xe8\x8f\x01\x95\xd1\x52\x64\x13\x0b\xd5\x7c\x03\xaa\xf9\x8d\x7f\x03\x00\x88\xd9\x54\xcc\x5e\x01\x00\x00"
 
 func mailIssueCommentTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -912,12 +1036,32 @@ func mailIssueCommentTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mail/issue/comment.tmpl", size: 258, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd6, 0x76, 0x52, 0xd8, 0x4b, 0xe5, 0x78, 0x7a, 0xe4, 0xfb, 0x5a, 0xab, 0x81, 0x3a, 0x5a, 0x83, 0xeb, 0x6d, 0x58, 0x48, 0x13, 0xfd, 0xb0, 0x55, 0x5d, 0x2, 0xc3, 0x42, 0x19, 0xa9, 0x1, 0x50}}
+	info := bindataFileInfo{name: "mail/issue/comment.tmpl", size: 350, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc4, 0xc5, 0x7d, 0x9f, 0xb, 0xe4, 0xb4, 0x60, 0x39, 0xa6, 0x93, 0x48, 0x71, 0x1, 0xda, 0xd2, 0x70, 0x11, 0x67, 0x64, 0x7e, 0xe1, 0x3c, 0xa9, 0xc6, 0x45, 0x31, 0xa1, 0xb5, 0xfa, 0x90, 0xc9}}
 	return a, nil
 }
 
-var _mailIssueMentionTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x90\x4f\x4f\xc3\x30\x0c\xc5\xcf\xcd\xa7\x30\xb9\x27\x91\x38\x21\x70\x23\xc4\x86\xe0\x30\xfe\x48\x9b\x90\x38\x66\xab\x47\x03\x6b\x52\x3a\x17\xa8\x42\xbe\x3b\x2a\x81\x93\xf5\xec\xe7\x9f\xad\x87\x27\xcb\x87\xc5\xe6\xf9\xf1\x1a\x5a\xee\x0e\x56\xe0\x7f\x21\xd7\x58\x51\x61\x47\xec\xa0\x65\xee\x15\xbd\x8f\xfe\xa3\x96\x8b\x18\x98\x02\xab\xcd\xd4\x93\x84\x5d\x51\xb5\x64\xfa\x62\x33\xef\x5e\xc0\xae\x75\xc3\x91\xb8\x1e\x79\xaf\xce\x24\x98\x19\xc3\x9e\x0f\x64\x53\xd2\xeb\x71\xfb\x4a\x3b\xce\x19\x4d\xe9\x09\x34\xe5\x96\xc0\x6d\x6c\xa6\xd9\xdc\xdb\xcb\x94\xf4\x32\xd2\xa0\x97\xfe\xd8\x1f\xdc\x74\xef\x3a\xca\x19\x3a\x0a\xec\x63\xa0\x06\xa6\x38\x9e\xa3\xe9\x8b\x3b\x25\x7d\x15\x9b\x09\xbe\x61\xcd\xc3\xe9\xed\xe6\x6e\x35\xe3\xff\x86\xa2\xaa\x94\x52\xa2\xaa\x70\x3b\xcc\x02\x1d\xb4\x03\xed\x6b\x99\x92\x5e\xf9\xf0\x96\xb3\xb4\x4f\x9e\x3e\xc1\x33\xc4\x00\x37\xf1\xe5\x88\xc6\x59\x2d\xaa\x5f\x04\x9a\xf2\x15\x9a\x12\xcc\x4f\x00\x00\x00\xff\xff\x39\x3d\x0f\x78\x30\x01\x00\x00"
+var _mailIssueCommentTxt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x1c\xcd\x31\xaa\x02\x31\x10\x06\xe0\x3e\xa7\x98\x0b\x24\x07\x78\xd5\x03\x17\x6c\x44\x3b\xfb\x80\xbf\x4b\x30\x3b\x23\x3b\x81\xb0\x0c\xff\xdd\x45\xbb\xaf\xfb\x22\xda\x53\xca\xa5\xe9\xcb\x6f\xda\x0f\xf2\x3f\xa2\x2c\x86\xbd\x2c\xcd\xdf\xbd\x1e\xd7\xba\x81\x94\xb9\xdb\x80\x54\x51\x4c\xd9\xe0\x5e\x57\x94\x08\x74\x07\x19\x51\x4e\xa6\x03\x3a\xbe\x86\x3e\xc8\x94\x72\xce\xe9\xde\x30\xa5\x0d\x31\x95\xb3\xad\xfe\x27\x11\xbf\x8a\x4c\x9f\x01\x00\xc8\x41\x52\x9e\x78\x00\x00\x00"
+
+func mailIssueCommentTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_mailIssueCommentTxt,
+		"mail/issue/comment.txt",
+	)
+}
+
+func mailIssueCommentTxt() (*asset, error) {
+	bytes, err := mailIssueCommentTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/issue/comment.txt", size: 120, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x58, 0x53, 0x46, 0xb3, 0xf3, 0x80, 0x1d, 0xe2, 0x4f, 0x31, 0x44, 0x27, 0xd, 0x28, 0x39, 0x8e, 0xaf, 0x4, 0x66, 0xc, 0xba, 0xf6, 0x4c, 0x88, 0x1c, 0x7b, 0x9a, 0xe1, 0x4b, 0xe9, 0xcf, 0xf2}}
+	return a, nil
+}
+
+var _mailIssueMentionTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x90\x4d\x4e\xc3\x30\x10\x46\xd7\xf1\x29\x86\xec\x63\x4b\xac\x10\x38\x16\xa2\x45\xb0\x28\x14\xa9\x15\x12\x4b\xb7\x99\x12\x43\x62\x87\x64\x02\x44\x83\xef\x8e\x12\x8b\x03\xb0\xb2\x6c\x7f\xef\xcd\x8f\x3e\x5b\x6f\x57\xfb\x97\xa7\x5b\xa8\xa9\x6d\x8c\xd0\x7f\x07\xda\xca\x88\x4c\xb7\x48\x16\x6a\xa2\xae\xc0\x8f\xd1\x7d\x96\xf9\x2a\x78\x42\x4f\xc5\x7e\xea\x30\x87\x63\xba\x95\x39\xe1\x37\xa9\x99\xbd\x82\x63\x6d\xfb\x01\xa9\x1c\xe9\x54\x5c\xe4\xa0\x66\x0d\x39\x6a\xd0\x30\xcb\xdd\x78\x78\xc3\x23\xc5\xa8\x55\x7a\x13\x5a\xa5\x5a\x42\x1f\x42\x35\x19\x91\x31\xbb\x13\xc8\x8d\xf3\xef\xc3\xd6\x37\x53\x8c\x22\xcb\x74\x67\xae\x99\xe5\x3a\x60\x2f\xd7\x6e\xe8\x1a\x3b\x3d\xda\x16\x63\x84\x16\x3d\xb9\xe0\xb1\x82\x29\x8c\x52\xab\x6e\x31\x60\x33\xe0\x7f\xc0\xcb\x04\xce\x71\x66\x79\x13\xaa\x09\x7e\x60\x47\xfd\xf9\xfd\xfe\x61\x13\x63\xfa\x65\x46\x5f\xcd\x56\xbd\x64\x8b\xa2\x98\x89\x43\xbf\x80\x16\xea\x1e\x4f\x65\xce\xbc\xb4\x1e\x63\x6e\x9e\x1d\x7e\x81\x23\x08\x1e\xee\xc2\xeb\xa0\x95\x35\x52\x64\x8b\x4b\xab\x34\xac\x56\x69\xdf\xbf\x03\x00\x85\xd3\x43\x2f\x87\x01\x00\x00"
 
 func mailIssueMentionTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -932,8 +1076,108 @@ func mailIssueMentionTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mail/issue/mention.tmpl", size: 304, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1d, 0xb6, 0x29, 0x8, 0x3b, 0x27, 0x61, 0x7e, 0xd9, 0x4, 0xa6, 0x60, 0xec, 0x79, 0x4b, 0x96, 0x16, 0x4a, 0x75, 0x7c, 0xe2, 0x5e, 0xc7, 0xce, 0x27, 0x6d, 0x68, 0x1c, 0xf3, 0x2a, 0x1c, 0x39}}
+	info := bindataFileInfo{name: "mail/issue/mention.tmpl", size: 391, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x74, 0x17, 0x9a, 0xb9, 0x8, 0xce, 0x1, 0xc8, 0x18, 0xca, 0x17, 0x44, 0xe, 0x7a, 0x1e, 0x87, 0xe, 0xbc, 0x7e, 0x47, 0x8b, 0x6a, 0x17, 0xe6, 0x2c, 0xd8, 0xa9, 0x9d, 0x14, 0x1a, 0x1f, 0x81}}
+	return a, nil
+}
+
+var _mailIssueMentionTxt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\xce\x31\x0a\x02\x41\x0c\x05\xd0\x3e\xa7\xc8\x05\x26\x07\xd8\x4a\x70\xc1\x46\xb4\xb3\x5f\xd8\x28\xc1\xd9\x44\x9c\x11\x19\x3e\xb9\xbb\xe0\x09\xb6\x7f\xc5\x03\xec\xce\x72\x36\x7f\xb6\xab\xd7\x91\x79\x00\x64\x0e\x7d\xcb\x6c\xed\x55\x97\x71\x59\x36\xcd\xe4\x4d\xbd\x5b\xb8\xae\x3c\xe2\x23\x80\xd6\xa6\xfb\xf0\x44\x04\xc8\x31\xbc\xab\xf7\x4c\x40\x7d\xcd\x24\x2a\xa5\xd0\xcd\xf4\xcb\xd6\x39\x9c\x4f\xf1\x68\x13\x03\xff\x4b\x26\xfd\x06\x00\x23\xc2\xa6\x6b\x99\x00\x00\x00"
+
+func mailIssueMentionTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_mailIssueMentionTxt,
+		"mail/issue/mention.txt",
+	)
+}
+
+func mailIssueMentionTxt() (*asset, error) {
+	bytes, err := mailIssueMentionTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/issue/mention.txt", size: 153, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0xa1, 0xcd, 0x89, 0x78, 0x4c, 0xd4, 0x5e, 0x9a, 0x1a, 0xdc, 0x1f, 0xf4, 0x67, 0x6a, 0x7a, 0xc2, 0xe9, 0x0, 0xfa, 0xd6, 0x7b, 0x72, 0x2f, 0xfa, 0x7b, 0x44, 0xfd, 0x60, 0x89, 0x6a, 0x33}}
+	return a, nil
+}
+
+var _mailIssueMergeTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x34\x90\xc1\x4a\xc4\x30\x10\x86\xcf\xcd\x53\x8c\xbd\xb7\xb9\x0a\x4e\x8b\xec\xae\x78\x11\x15\x5c\x04\x8f\xd9\x76\x76\x13\x6d\x93\x6c\x32\x55\x97\x90\x77\x97\x6e\xf0\x14\xfe\x9f\xcc\xf7\x0d\x83\x37\xbb\x97\xed\xfe\xe3\xf5\x01\x34\xcf\x53\x2f\xf0\xff\x21\x35\xf6\xa2\xc2\x99\x58\x81\x66\xf6\x0d\x9d\x17\xf3\xdd\xd5\x5b\x67\x99\x2c\x37\xfb\x8b\xa7\x1a\x86\x92\xba\x9a\xe9\x97\xe5\x3a\x7b\x07\x83\x56\x21\x12\x77\x0b\x1f\x9b\xdb\x1a\xe4\x8a\x61\xc3\x13\xf5\x29\xb5\x6f\xcb\xe1\x93\x06\xce\x19\x65\xe9\x04\xca\xe2\x12\x78\x70\xe3\x65\xfd\xec\xfb\xfb\x94\xda\x9d\xa3\xd0\xee\x4c\xf4\x93\xba\x3c\xab\x99\x72\x86\x99\xc2\x89\x46\x60\x6d\x22\xf8\x65\x9a\x20\xd0\x79\xa1\xc8\x60\x2c\x3b\xc0\xc1\x8d\x57\xc7\x46\x45\xda\x04\x65\x07\xbd\x6a\xae\x6d\x8b\xd2\x17\xb4\xa8\xaa\xa6\x69\x44\x55\xe1\x21\xac\x01\x15\xe8\x40\xc7\xae\x4e\xa9\x7d\x32\xf6\x2b\xe7\xba\x7f\x37\xf4\x03\x86\xc1\x59\x78\x74\xa7\x88\x52\xf5\xad\xa8\xae\x08\x94\x65\x4b\x94\xe5\x50\x7f\x03\x00\x72\x91\xae\x16\x40\x01\x00\x00"
+
+func mailIssueMergeTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailIssueMergeTmpl,
+		"mail/issue/merge.tmpl",
+	)
+}
+
+func mailIssueMergeTmpl() (*asset, error) {
+	bytes, err := mailIssueMergeTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/issue/merge.tmpl", size: 320, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe7, 0x85, 0xd8, 0xab, 0x32, 0x94, 0xf, 0x10, 0xcc, 0x8f, 0x17, 0xbf, 0xf3, 0x99, 0xf3, 0x75, 0x56, 0x83, 0xb3, 0x43, 0x95, 0xad, 0xd4, 0xe6, 0x53, 0x11, 0x96, 0x1f, 0x8a, 0xc4, 0x36, 0x4f}}
+	return a, nil
+}
+
+var _mailIssueMergeTxt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x14\xca\x41\x0a\xc2\x30\x10\x05\xd0\x7d\x4e\xf1\x2f\x90\x1c\xc0\x95\x94\x82\x1b\x71\xe9\x3e\xe8\xa7\x1d\x4c\x93\x9a\x49\x11\x19\xe6\xee\xe2\xfe\x9d\xcd\xd2\xdc\xd8\xd3\x2c\xba\x97\xfc\xbd\xe5\x8d\xee\xd8\xd8\x17\x3e\x31\x56\x51\xec\x47\x29\xe8\x7c\x1f\xd4\x01\xa9\xa3\xc1\x2c\x4d\x59\x39\xf5\x5c\x1f\xab\x7b\x0a\x21\xc6\x18\xee\xc2\x0f\x64\xa0\x55\x5c\xda\xa2\xa7\x3f\xbb\x4a\x7d\xb9\x87\xdf\x00\xf4\xc7\xde\x6d\x66\x00\x00\x00"
+
+func mailIssueMergeTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_mailIssueMergeTxt,
+		"mail/issue/merge.txt",
+	)
+}
+
+func mailIssueMergeTxt() (*asset, error) {
+	bytes, err := mailIssueMergeTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/issue/merge.txt", size: 102, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x15, 0x1e, 0x89, 0x63, 0x1, 0x8, 0x6b, 0xe3, 0xec, 0x80, 0x40, 0x4b, 0xd8, 0x79, 0x6d, 0x3f, 0xc2, 0xbe, 0x52, 0x26, 0x34, 0x13, 0xc5, 0xba, 0xb3, 0xe6, 0xc4, 0xec, 0x2b, 0x9a, 0x5c, 0xbb}}
+	return a, nil
+}
+
+var _mailIssueReview_reminderTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\xaf\x95\x30\x10\x85\xd7\xf0\x2b\x46\xd6\x0f\xd8\x9a\xd8\xdb\xc4\x3c\x5f\x74\x61\xa2\xd1\x1b\x13\x97\xc3\x65\xb8\xad\x96\x0e\xaf\x9d\x3e\x24\x0d\xff\xdd\x00\xf7\xea\xc6\x8d\xab\xc9\x21\x9c\xf3\xcd\x99\xaa\x57\xef\x3e\x3d\x9e\xbf\x7f\x7e\x02\x23\xa3\xd3\xa5\xba\x0f\xc2\x5e\x97\x85\x1a\x49\x10\x8c\xc8\x54\xd3\x73\xb2\x2f\xa7\xea\x91\xbd\x90\x97\xfa\xbc\x4c\x54\xc1\xe5\x50\xa7\x4a\xe8\x97\xb4\x9b\xf7\x0d\x5c\x0c\x86\x48\x72\x4a\x32\xd4\xaf\x2b\x68\xb7\x18\xb1\xe2\x48\xe7\xdc\x7c\x4d\xdd\x0f\xba\xc8\xba\xaa\xf6\xf8\x56\xaa\xf6\x60\x95\xaa\xe3\x7e\xd1\x65\x91\xb3\x1d\xa0\x79\x8a\x17\x74\x28\xd4\xaf\x6b\x59\x14\x6a\xd2\x67\x63\x23\x4c\xc9\x39\x08\xf4\x9c\x28\x0a\x18\x8c\xd0\x11\x79\x98\xd1\x8a\xf5\x57\x18\x38\x40\xa0\x17\x4b\x33\x74\x0b\xa8\x6e\x03\x7e\xd9\x35\x85\x8d\xd8\xe9\xfd\x97\x9c\x9b\x0f\x9c\x42\x5c\x57\x30\xdb\x7c\x00\xf4\x3d\x88\xa1\x9b\x99\xc2\x1e\x8d\x2e\x10\xf6\xcb\x81\x08\x34\x5a\xdf\x53\xdf\xa8\x76\xd2\xc7\x42\x6f\x23\xa0\x07\xec\x47\xeb\x81\x87\x9b\x7f\xe2\x68\x85\xc3\xf2\x00\x0b\x27\x18\x71\x81\x19\xbd\x80\x30\x0c\xec\x1c\xcf\x90\x26\xe0\x00\x18\xa3\xbd\x7a\x40\xcf\x62\xe8\xbe\x34\x85\x5b\x7a\xce\xe4\x22\xfd\x67\xf1\x85\xd3\x9f\xf6\xff\x6a\xf9\x37\xdb\xef\x37\x55\x7b\x8f\xba\xae\x37\x4a\x17\x36\xa1\x10\x4c\xa0\xe1\x54\xe5\xdc\x7c\xb4\xfe\xe7\xba\x56\xfa\xdb\x76\x4d\x2b\xc0\x1e\xde\xf3\x35\xaa\x16\x75\x53\x16\x7b\x96\x6a\x8f\x17\x53\xad\x91\xd1\xe9\xf2\xf7\x00\x79\x6b\x46\x98\x4c\x02\x00\x00"
+
+func mailIssueReview_reminderTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailIssueReview_reminderTmpl,
+		"mail/issue/review_reminder.tmpl",
+	)
+}
+
+func mailIssueReview_reminderTmpl() (*asset, error) {
+	bytes, err := mailIssueReview_reminderTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/issue/review_reminder.tmpl", size: 588, mode: os.FileMode(0644), modTime: time.Unix(1792246553, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5d, 0x76, 0xf0, 0xda, 0x19, 0x45, 0x48, 0x37, 0x5e, 0xaa, 0xfd, 0x76, 0xf7, 0xec, 0xa9, 0xb, 0x33, 0x83, 0xd4, 0x97, 0x8d, 0xf9, 0xf2, 0x94, 0xfd, 0x81, 0x44, 0x7a, 0xff, 0xe0, 0xf0, 0x7a}}
+	return a, nil
+}
+
+var _mailIssueReview_reminderTxt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x8f\xb1\x4e\xf5\x30\x0c\x85\xf7\x3c\xc5\x79\x80\xdb\x3e\xc0\xbf\xfd\x03\x82\x81\x09\x21\x76\x5f\xe2\xb6\x11\xa9\x5d\xe2\x84\x2a\x8a\xf2\xee\xa8\xe5\xea\x4e\x0c\x4c\x96\xe5\x73\x3e\xeb\x6b\x2d\x4c\x18\x1f\xec\x9d\x22\x65\xf6\xbd\xbf\x2e\xc1\xb0\x95\x18\x91\xf8\xb3\xb0\x65\x2c\x64\xb8\x32\x0b\x76\x0a\x39\xc8\x8c\x49\x13\x12\x7f\x05\xde\x71\xad\x68\x6d\x7c\x39\x17\x4e\xbd\x9f\xb7\xd6\xc6\x27\x2d\xc9\x7a\xc7\x72\xcc\x0b\x48\x3c\xf2\xc2\xb7\x16\xa7\x93\x49\x31\x31\xf9\xfa\xc3\x4e\xbc\x06\xf1\xec\x47\xe7\xfe\x1b\x48\x40\x7e\x0d\x02\x9d\x6e\xbd\x4d\x2d\x64\x4d\xf5\x82\xaa\x05\x2b\x55\xec\x24\x19\x59\x31\x69\x8c\xba\xa3\x6c\xd0\x04\x32\x0b\xb3\x80\x44\xf3\xc2\xe9\xfe\x6f\x6c\x8d\xa3\xf1\x9f\xed\xaa\x96\xbb\xe2\x6f\x46\x07\x4f\x7c\xef\xce\x0d\xc3\xe0\xde\x8e\x58\xc8\x50\xc1\xa3\xce\xf6\xef\x88\x3f\x07\xf9\xe8\xdd\x7d\x0f\x00\xbd\x45\xb9\x7c\x5f\x01\x00\x00"
+
+func mailIssueReview_reminderTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_mailIssueReview_reminderTxt,
+		"mail/issue/review_reminder.txt",
+	)
+}
+
+func mailIssueReview_reminderTxt() (*asset, error) {
+	bytes, err := mailIssueReview_reminderTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/issue/review_reminder.txt", size: 351, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6c, 0x68, 0xb9, 0x3d, 0x3, 0xc7, 0x81, 0x2d, 0xbb, 0xfa, 0x46, 0xa2, 0xc3, 0x85, 0x6e, 0x84, 0x6, 0x8b, 0xef, 0x64, 0xd7, 0x47, 0x78, 0x80, 0x1b, 0x7a, 0xf8, 0xea, 0xbd, 0x47, 0x15, 0xb5}}
 	return a, nil
 }
 
@@ -957,6 +1201,146 @@ func mailNotifyCollaboratorTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _mailNotifyCommit_mentionTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x3c\x91\xcd\x6e\xd4\x30\x14\x85\xd7\xc9\x53\x5c\xbc\x82\xc5\x8c\xc5\x0e\x81\x63\x51\x4d\xd9\x55\x80\x4a\x59\x74\x85\x3c\xc9\x9d\x89\xa9\xff\x70\x6e\x10\xd1\x95\x1f\x88\xd7\xe0\xc9\x90\x63\xb5\x2b\xdb\x47\xf6\xf9\xce\xb9\x56\xaf\x6e\xbf\x9c\x1e\x1e\xbf\x7e\x82\x99\xbc\xd3\xbd\x7a\x5e\xd0\x4c\xba\xef\x94\x47\x32\x30\x13\xa5\x03\xfe\x5a\xed\xef\x41\x9c\x62\x20\x0c\x74\x78\xd8\x12\x0a\x18\xdb\x69\x10\x84\x7f\x48\xd6\xb7\x1f\x60\x9c\x4d\x5e\x90\x86\x95\x2e\x87\x77\x02\x64\xb5\x21\x4b\x0e\x35\xf3\xf1\xdb\x7a\xfe\x89\x23\x95\xa2\x64\xd3\x7a\x25\x1b\xab\x57\xe7\x38\x6d\xf5\x72\xd2\x1f\x99\x8f\xb7\x11\x73\x29\xe0\x31\x90\x8d\x01\x27\xd8\xe2\x0a\x36\x00\xb3\xbd\xc0\x95\xe0\xb5\xc3\x00\xc7\x53\xf4\xde\xd2\xf2\x06\xde\x96\x32\xb6\x3d\x33\xba\x05\x4b\x31\xd0\x04\x66\x0c\x53\x29\x90\xd6\x65\xc6\x09\x28\x82\x1a\xe3\xb4\xa7\xb9\xc7\x14\x3f\x1b\x8f\x35\xce\xae\xbd\x57\x32\xd5\x08\xab\xd3\x7d\xd7\x31\x67\x13\xae\xf8\x42\x29\xa5\xef\xba\x4e\x39\xab\x95\x81\x39\xe3\x65\x10\xcc\xc7\x3b\x1b\x9e\x4a\x11\xad\x9d\xf7\x26\x6f\xd5\xce\x68\x25\x9d\x6d\x2e\x3b\xbf\xef\x94\xdc\x6d\x55\xd2\xff\xfe\x02\xf3\x23\x9a\x5a\x50\x19\x20\x93\xaf\x48\x83\xf8\x71\x76\x26\x3c\x09\xc8\xe8\x06\x11\x62\x4c\x18\x30\x43\x88\x19\x2f\x98\x33\x66\xf1\x02\xbd\x49\xe9\xfb\xfd\x5d\xa3\xde\xa4\xf4\xdc\xa1\x42\x53\x1d\x69\x1b\xa5\x92\xed\x37\xff\x0f\x00\x6b\x80\xa8\xc9\xe5\x01\x00\x00"
+
+func mailNotifyCommit_mentionTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailNotifyCommit_mentionTmpl,
+		"mail/notify/commit_mention.tmpl",
+	)
+}
+
+func mailNotifyCommit_mentionTmpl() (*asset, error) {
+	bytes, err := mailNotifyCommit_mentionTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/notify/commit_mention.tmpl", size: 485, mode: os.FileMode(0644), modTime: time.Unix(1792263943, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6b, 0x8a, 0xe1, 0x75, 0x74, 0x2a, 0x4e, 0x19, 0xc8, 0x14, 0x70, 0xa, 0x39, 0x1f, 0xc1, 0xc8, 0x2c, 0xc2, 0x87, 0x8a, 0x81, 0xb5, 0x5e, 0x48, 0x3e, 0xe7, 0x30, 0x15, 0x1d, 0x6d, 0xda, 0x51}}
+	return a, nil
+}
+
+var _mailNotifyDeactivated_userTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x54\x92\xcf\x8e\xd3\x3e\x10\xc7\xcf\xbf\x3e\xc5\xf7\xe7\x13\x48\x6d\x73\x45\xe0\x44\x5a\x2d\x48\x48\x2c\x7f\x04\xdd\x43\x4f\x68\x92\x4c\x1b\x53\x67\x6c\xec\xc9\x2e\x55\x94\x07\xe2\x35\x78\x32\x14\xa5\x94\xe5\x64\xcf\xf8\x33\xf3\x19\x69\x6c\xff\x7f\xfd\xf1\x76\xb7\xff\xf4\x06\x9d\xf6\xbe\x5a\xd9\x3f\x07\x53\x5b\xad\xfe\xb3\x3d\x2b\xa1\x53\x8d\x1b\xfe\x3e\xb8\x87\xd2\xdc\x06\x51\x16\xdd\xec\xce\x91\x0d\x9a\x25\x2a\x8d\xf2\x0f\x2d\xe6\xda\x57\x68\x3a\x4a\x99\xb5\x1c\xf4\xb0\x79\x61\x50\xcc\x6d\xd4\xa9\xe7\x6a\x1c\xb7\x5f\x86\xfa\x1b\x37\x3a\x4d\xb6\x58\x72\x2b\x5b\x2c\xae\x95\xad\x43\x7b\x9e\xe1\x58\xbd\x75\xb0\xf5\x8c\xdf\x67\x4e\x42\x3d\xcf\x7c\x5d\xad\x6d\x11\x17\x60\x1f\x86\x04\x6a\x9a\x30\x88\xa2\xa3\x8c\x9a\x59\xd0\x32\x35\xea\x1e\x48\xb9\x45\xcd\x0d\x0d\x99\xe1\x96\x77\x09\xba\x30\x43\xe6\x16\x87\x90\xd0\x87\xc4\xd0\x8e\x04\xe3\xb8\x7d\x1f\x44\xbb\x3c\x4d\xe8\xe7\xcb\xb3\xfc\x7c\x0d\x92\x16\xe7\x30\xe0\xd1\x79\x7f\x29\x07\xd5\x9e\xa1\x01\xd9\x1d\x05\x4e\x30\x88\x3a\x3f\x2b\x5c\x46\xfa\x2b\xdf\x5e\xe7\xdc\x85\x27\x79\x9c\x9f\x4c\xbd\x46\xf4\x4c\x99\xd1\x78\xd7\x9c\xa0\x1d\xe3\x10\xbc\x0f\x8f\x4e\x8e\xf0\x4e\x4e\x2f\xaf\x4d\x2c\xa1\x4b\x7c\x28\xcd\x38\x6e\xdf\x31\xc7\x3b\x27\xa7\x69\x32\xd5\x3f\xa1\x2d\xa8\xba\x56\xfc\xfa\x89\x71\xdc\x33\xa5\x69\x82\x25\x28\xa5\x23\x6b\x69\xbe\xd6\x9e\xe4\x64\x90\xd8\x97\x46\x42\x88\x2c\x9c\x20\x21\xf1\x81\x53\xe2\x64\xae\x9e\x9b\x18\xef\x3f\xdf\x2d\x92\x9b\x18\x3f\x5c\x56\x70\x51\xd8\x62\x59\x95\x2d\x96\xdf\xf2\x7b\x00\xbb\x61\x3a\xb5\x45\x02\x00\x00"
+
+func mailNotifyDeactivated_userTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailNotifyDeactivated_userTmpl,
+		"mail/notify/deactivated_user.tmpl",
+	)
+}
+
+func mailNotifyDeactivated_userTmpl() (*asset, error) {
+	bytes, err := mailNotifyDeactivated_userTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/notify/deactivated_user.tmpl", size: 581, mode: os.FileMode(0644), modTime: time.Unix(1792289045, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0xa7, 0x6b, 0x76, 0x75, 0x14, 0x44, 0x50, 0x74, 0x30, 0x80, 0xed, 0x4d, 0xc2, 0xdf, 0x3c, 0x93, 0xe3, 0x6e, 0x35, 0x1, 0xc, 0x9e, 0xeb, 0x5d, 0x9d, 0x43, 0x9a, 0xd7, 0x5a, 0xf7, 0xf9}}
+	return a, nil
+}
+
+var _mailNotifyInactive_repoTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x54\x92\x4d\x8e\xd4\x30\x10\x85\xd7\xf4\x29\x0a\xaf\x40\x62\x3a\x5b\x04\x4e\xa4\xd1\xc0\x02\x31\xfc\x68\x68\x16\xb3\x42\xd5\xc9\x4b\xc7\xc4\xb1\x8d\x5d\xe9\x21\x8a\x72\x20\xae\xc1\xc9\x90\x3b\x74\xc3\xac\x92\x2a\x55\xbd\x4f\xef\x95\xf5\xd3\x37\x9f\x6e\x76\xf7\x9f\xdf\x52\x27\x83\xad\x36\xfa\xfc\x01\x37\xd5\xe6\x89\x1e\x20\x4c\x9d\x48\xb8\xc2\x8f\xd1\x1c\x4b\x75\xe3\x9d\xc0\xc9\xd5\x6e\x0a\x50\x54\xaf\x55\xa9\x04\x3f\xa5\xc8\xbb\xaf\xa9\xee\x38\x26\x48\x39\x4a\x7b\xf5\x52\x51\x91\x65\xc4\x88\x45\x35\xcf\xdb\x2f\xe3\xfe\x3b\x6a\x59\x16\x5d\xac\xbd\x8d\x2e\x56\xd6\x46\xef\x7d\x33\xe5\xe1\x50\xdd\x21\xf8\x64\xc4\xc7\x89\x34\x53\x17\xd1\x96\x6a\x9e\xb7\xb7\xc6\xf5\xcb\xa2\x2a\x5d\xfb\xe6\xa4\x96\xe7\x3e\xf2\x80\x2c\x77\xea\xe9\x82\x2b\xea\x38\x51\xc7\x0d\x39\x4f\x61\x4c\x1d\x12\xf9\x48\x26\xa5\x11\xc4\xb5\x98\xa3\x91\x89\x5a\x1f\x69\x9e\xb7\x1f\xbc\x93\x2e\x2d\x0b\x0d\xf9\xe7\x59\x7a\xbe\xa5\x5d\x07\x8a\xff\xf8\x0f\xc6\x5a\x72\x5e\x68\x8f\xec\xcc\x1d\xd0\xbc\x20\x76\x0d\x19\x21\x93\xc8\xe1\x88\x48\x0d\x2c\x04\x0d\xf1\x28\x7e\x60\x31\x35\x5b\x3b\x6d\x75\x11\x56\x3b\xef\x5a\x92\xc7\xaa\x26\x51\x92\xac\x3c\xb0\x71\xc2\xc6\x65\xd5\x60\xc1\x09\x54\x5b\x53\xf7\xa7\x85\xd6\x5b\xeb\x1f\x8c\x3b\x90\x35\xae\x27\xf1\xd4\x03\x21\x93\x4f\x3e\xf0\xea\x42\xf8\x3f\xa5\xf7\x40\x38\x27\xf5\xa8\xcc\xe1\x5c\x36\x7e\xff\xa2\x79\xbe\x07\xc7\x65\xc9\x19\x0b\xc7\x03\xa4\x54\xdf\xf6\x96\x5d\xaf\x28\xc2\x96\xca\x79\x1f\xe0\x10\xc9\xf9\x88\x16\x31\x22\xaa\x0b\xe7\x3a\x84\xaf\x77\xb7\x2b\xe4\x3a\x84\xf3\x19\xfe\x22\x74\xb1\x5e\x53\x17\xeb\x83\xfa\x33\x00\x7e\xae\xc4\x9c\x68\x02\x00\x00"
+
+func mailNotifyInactive_repoTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailNotifyInactive_repoTmpl,
+		"mail/notify/inactive_repo.tmpl",
+	)
+}
+
+func mailNotifyInactive_repoTmpl() (*asset, error) {
+	bytes, err := mailNotifyInactive_repoTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/notify/inactive_repo.tmpl", size: 616, mode: os.FileMode(0644), modTime: time.Unix(1792274665, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x49, 0x5d, 0xff, 0x40, 0x97, 0xa2, 0x58, 0xb1, 0xf3, 0x63, 0x81, 0x46, 0x82, 0xa2, 0xb0, 0x44, 0x3, 0x9a, 0xf6, 0xef, 0x6b, 0x1c, 0xd6, 0x71, 0x11, 0x88, 0x30, 0xb, 0x41, 0xd9, 0xb4, 0x62}}
+	return a, nil
+}
+
+var _mailNotifyInactive_userTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x93\xc1\x6e\xd4\x30\x10\x86\xcf\xdd\xa7\x18\x72\x6a\xa5\xdd\xcd\x15\x81\x13\xa9\x6a\x11\x54\x14\x8a\x60\x7b\xd8\x13\x72\x92\xc9\xc6\xc4\x3b\x36\xf6\xb8\x4b\x14\xe5\x81\x78\x0d\x9e\x0c\x39\x6e\xb7\xdb\x9e\x12\x3b\xff\xcc\x37\xff\x6f\x47\xbc\xb9\xbe\xbb\xda\x6c\xbf\x7d\x80\x8e\xf7\xba\x5c\x88\xa7\x07\xca\xa6\x5c\x9c\x89\x3d\xb2\x84\x8e\xd9\xae\xf0\x77\x50\x0f\x45\x76\x65\x88\x91\x78\xb5\x19\x2c\x66\x50\xa7\x55\x91\x31\xfe\xe1\x3c\xd6\xbe\x87\xba\x93\xce\x23\x17\x81\xdb\xd5\xdb\x0c\xf2\xd8\x86\x15\x6b\x2c\xc7\x71\xfd\x23\x54\xbf\xb0\xe6\x69\x12\x79\xda\x5b\x88\x3c\xb1\x16\xa2\x32\xcd\x10\xc5\xb6\xfc\xa4\x40\x54\x51\x7e\xef\xd1\x91\xdc\x63\xd4\x57\xe5\x52\xe4\x36\x09\xb6\x26\x38\x90\x75\x6d\x02\x31\x74\xd2\x03\x19\x86\x0a\x91\x20\x78\x6c\xa0\x35\x0e\xc6\x71\xfd\xc5\x10\x77\x7e\x9a\x60\x1f\x5f\xce\xfd\xc5\x3a\x35\x18\x47\xd5\xc2\xfa\xa3\x93\x35\x5e\xcb\xc1\x4f\xd3\xe2\x2c\x36\xbd\x61\x38\x28\xad\xa1\x42\x68\x50\xd6\xac\x1e\x24\x63\x03\x8a\x62\xaf\x13\x35\x34\x72\x38\xf7\x17\x10\x48\xa3\xf7\x30\x98\x00\x5e\xed\x28\x0a\x25\x83\x90\xd0\x39\x6c\x8b\x2c\xba\x55\x3b\xba\xa1\x5b\x45\xfd\x34\x65\xe5\xab\x0d\x91\xcb\x12\x8c\x83\x1e\xd1\x82\x62\x98\x89\xb8\x86\x3b\xaa\x5f\x0c\xb0\x9c\x09\xf3\x64\xc9\x25\xc8\x4a\x23\xb0\x39\x52\x03\xb1\xd2\xc0\x1d\x82\x56\xd4\x43\x85\xda\x1c\x40\x79\x68\x8d\xd6\xe6\x80\xcd\xd1\x36\x6a\x8f\x4f\x6e\x5f\x44\x78\xda\xbd\xee\x24\xed\x22\xb6\x0a\x3c\xa3\xf7\x72\x00\x8d\x0c\xc1\x43\x4f\xe6\x00\xdc\xc9\xf4\xc1\xf3\x5c\x86\x31\x24\x7e\x86\x50\x13\x19\xc2\x96\x1b\x93\xcc\x0d\xa7\xa8\x64\x73\x09\x56\xa3\xf4\x08\xb5\x56\x75\x3f\xcf\x9e\xa6\x55\xb4\x9b\x5d\xbc\x3b\x9e\xf5\x69\xa0\x9f\x11\xed\x49\x9c\xcf\xcb\x18\xe6\xb1\xe2\xdf\x5f\x18\xc7\x2d\x4a\x37\x4d\xf1\x38\x58\xba\x1d\x72\x91\xfd\xac\xb4\xa4\x3e\x03\x87\xba\xc8\xc8\x18\x8b\x84\x0e\xc8\x38\x6c\xd1\x39\x74\xd9\x91\x73\x69\xed\xfd\xf7\xdb\x04\xb9\xb4\xf6\xeb\xe3\x0d\x7c\x44\x88\x3c\xdd\x54\x91\xa7\x9f\xe5\xff\x00\x52\x0c\xeb\xfb\x44\x03\x00\x00"
+
+func mailNotifyInactive_userTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailNotifyInactive_userTmpl,
+		"mail/notify/inactive_user.tmpl",
+	)
+}
+
+func mailNotifyInactive_userTmpl() (*asset, error) {
+	bytes, err := mailNotifyInactive_userTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/notify/inactive_user.tmpl", size: 836, mode: os.FileMode(0644), modTime: time.Unix(1792274665, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x63, 0xe9, 0xa0, 0x68, 0x61, 0x2, 0x8e, 0x2b, 0x3a, 0x87, 0xa5, 0x73, 0xb2, 0x6b, 0xf, 0x99, 0xfc, 0x61, 0x40, 0xa7, 0xd5, 0xa8, 0x9, 0x98, 0xd7, 0xd3, 0x5d, 0xbe, 0x19, 0x1f, 0xd8, 0x86}}
+	return a, nil
+}
+
+var _mailNotifyPath_watchTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x92\xcf\x6e\xd4\x30\x10\xc6\xcf\xc9\x53\x0c\x39\x20\x38\x34\xe1\x88\xa8\x63\xb1\x6c\xe1\xd4\xd2\xaa\x2d\x87\x9e\x90\x37\x99\x6c\x42\x13\xdb\x38\x93\x96\xd5\xc8\x0f\xc4\x6b\xf0\x64\xc8\xf6\xfe\x01\xc4\x29\xf1\x7c\xf6\xf7\xfd\xc6\x63\xf1\xe2\xe2\x7a\x7d\xff\x70\xf3\x11\x7a\x9a\x46\x99\x8b\xc3\x07\x55\x2b\xf3\x4c\x4c\x48\x0a\x7a\x22\x7b\x86\xdf\x97\xe1\xa9\x2e\xd6\x46\x13\x6a\x3a\xbb\xdf\x59\x2c\xa0\x49\xab\xba\x20\xfc\x41\x55\x38\x7b\x0e\x4d\xaf\xdc\x8c\x54\x2f\xd4\x9d\xbd\x2d\xa0\x0a\x36\x34\xd0\x88\x92\xb9\xbc\x5b\x36\xdf\xb0\x21\xef\x45\x95\x6a\xb9\xa8\x52\x56\x2e\x36\xa6\xdd\x85\xcd\x56\xbe\x67\x2e\x2f\x0c\x3a\xef\xc1\x2e\x73\x8f\x6d\x30\xd5\x5b\x9c\x81\x0c\x3c\x2b\x6a\x42\xc9\x2a\xea\x67\x30\x1a\x36\x4e\xe9\xa6\x07\xd1\x98\x36\x66\x7c\x88\xeb\x10\x11\x2b\x60\xba\x93\x76\x8b\xd6\x7c\x56\x13\x1e\x55\xe6\xa1\x83\x72\x6d\x26\xab\x1c\x5e\x0e\xfa\xd1\x7b\x78\x25\x14\xf4\x0e\xbb\xba\x60\xfe\x5b\x2a\xe4\xd3\x80\xcf\xd0\x0e\x5d\x27\x2a\x25\x5f\x33\xa3\x6e\xbd\x7f\x27\x2a\x1b\xc8\x97\x51\xe6\x59\xc6\xec\x02\x2c\x94\x9f\x86\x11\x67\xef\xf3\x2c\xcb\xc4\x38\x04\x29\xcb\x8e\x24\x77\xa4\x68\x99\x8f\x1c\x51\x4c\x30\xd7\x63\x7b\xa3\xa8\xf7\x9e\xf9\xf4\x0f\x2f\x9d\x72\xee\x1c\xf6\x89\x7f\x12\x1e\xd0\x98\xcb\xb4\x37\xa0\xc5\xd0\x2a\xa5\xee\xcf\xe4\x99\xa8\x22\x61\x8c\xd9\x12\x94\x57\xc6\x21\xbc\x89\x84\xc2\xca\x95\x6e\x81\x39\x16\xbd\x87\x29\x68\xe9\xda\x5b\xe8\x42\x27\x65\xea\xf2\xe4\x66\xe5\x95\xd2\x6a\x8b\xb0\x33\x8b\xfb\x67\x2e\xff\x03\xec\xd1\x61\x60\xdb\x1b\x09\x2b\x7f\xfd\x04\xe6\x07\x54\x61\xd2\x42\x01\x29\xb7\x45\xaa\x8b\xaf\x9b\x51\xe9\xc7\x02\x1c\x8e\x75\xa1\x8d\xb1\xa8\xd1\x81\x36\x0e\x3b\x74\x0e\x5d\x71\xf4\x5e\x59\xfb\xe5\xf6\x32\x75\xbf\xb2\xf6\x30\x5a\x25\x63\x84\xa8\xd2\x9b\x12\x55\x7a\xd6\xbf\x07\x00\x44\x25\x8b\x2d\xee\x02\x00\x00"
+
+func mailNotifyPath_watchTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailNotifyPath_watchTmpl,
+		"mail/notify/path_watch.tmpl",
+	)
+}
+
+func mailNotifyPath_watchTmpl() (*asset, error) {
+	bytes, err := mailNotifyPath_watchTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/notify/path_watch.tmpl", size: 750, mode: os.FileMode(0644), modTime: time.Unix(1792277264, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xde, 0x75, 0x53, 0x53, 0xea, 0x90, 0xc2, 0x2a, 0x7c, 0x29, 0xa8, 0x6a, 0xb8, 0x44, 0x38, 0xd6, 0x34, 0xf7, 0x69, 0x34, 0x54, 0x63, 0x60, 0xc0, 0x65, 0xc4, 0xf, 0x6, 0x2e, 0x80, 0x50, 0xce}}
+	return a, nil
+}
+
+var _mailNotifyRepo_inviteTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x91\xc1\x6e\xd4\x30\x10\x86\xcf\xec\x53\x0c\x3e\xb3\x9b\x2b\x2a\x4e\x50\x55\x38\x20\x55\xa5\x2a\xcb\xa1\x27\x34\x71\x66\x37\x26\x5e\x8f\x99\x4c\xba\x44\x51\x1e\x88\xd7\xe0\xc9\x90\x13\x9a\x93\xc7\xbf\x7f\xff\xdf\x2f\xdb\xbe\xfd\xf4\xf5\xee\xf8\xfc\xf8\x19\x5a\xbd\x84\x6a\x67\x5f\x17\xc2\xa6\xda\xbd\xb1\x17\x52\x84\x56\x35\xed\xe9\xd7\xe0\x5f\x4a\x73\xc7\x51\x29\xea\xfe\x38\x26\x32\xe0\xd6\x5d\x69\x94\x7e\x6b\x91\xef\x7e\x00\xd7\xa2\xf4\xa4\xe5\xa0\xa7\xfd\x7b\x03\x45\x8e\x51\xaf\x81\xaa\x69\x3a\x7c\x1b\xea\x9f\xe4\x74\x9e\x6d\xb1\x6a\x3b\x5b\xac\xac\x9d\xad\xb9\x19\xb3\x39\x55\xb6\xce\xde\x2f\xf1\xc5\x2b\x49\xf6\xd6\x15\xb4\xd8\x83\x5f\x94\x06\x46\x1e\x40\x19\x1c\x87\x80\x35\x0b\x2a\x01\x47\x10\x4a\xdc\x7b\x65\x19\x6f\xc0\x3a\x6e\x16\xe0\x13\x25\x7e\xc0\x0b\xe5\x94\x45\xb3\x45\x5a\x21\x8f\x81\xb0\x27\xe8\xfd\x39\x82\x8f\xc0\x02\x4e\x28\x47\x61\x04\x74\x8e\x87\xa8\xef\x40\x5b\x8a\xe0\x82\x77\x5d\x1e\xe1\xc4\x21\xf0\xd5\xc7\x33\x04\x1f\xbb\xdc\x01\x9d\xa3\xa4\xcb\xe1\xd2\x0e\xd5\x73\xbc\xd9\x20\x16\xa1\x15\x3a\x95\x66\x9a\x0e\xf7\x3e\x76\xf3\x6c\xaa\x6d\xb4\x05\x56\x9b\xf3\x81\x15\xae\x2c\x9d\x8f\xe7\x8f\x70\x94\x11\x1c\xa7\x31\x93\x30\x36\x90\xb0\xd7\x3c\x7b\xcd\xcc\x91\x07\x81\x5a\xf8\xda\x93\x1c\xb6\x80\xbf\x7f\x60\x9a\x9e\x09\x65\x9e\xc1\x22\x28\xca\x99\xb4\x34\x3f\xea\x80\xb1\x33\x20\x14\x4a\x13\x99\x13\x45\x12\x88\x2c\x74\x22\x11\x12\xb3\x15\xbc\x4d\xe9\xfb\xd3\xfd\xda\xf0\x36\xa5\xd7\x57\xfb\xdf\xd1\x16\xeb\xff\xd8\xa2\xd5\x4b\xa8\x76\xff\x06\x00\x8d\x25\x50\x66\x3a\x02\x00\x00"
+
+func mailNotifyRepo_inviteTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailNotifyRepo_inviteTmpl,
+		"mail/notify/repo_invite.tmpl",
+	)
+}
+
+func mailNotifyRepo_inviteTmpl() (*asset, error) {
+	bytes, err := mailNotifyRepo_inviteTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/notify/repo_invite.tmpl", size: 570, mode: os.FileMode(0644), modTime: time.Unix(1792240301, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x70, 0xdb, 0x6b, 0xa6, 0xa5, 0x33, 0xe7, 0x76, 0xbc, 0xaf, 0xff, 0x9e, 0xd6, 0xdf, 0x44, 0x1a, 0xa0, 0x7a, 0x52, 0xd5, 0x97, 0x5c, 0xd6, 0x57, 0xfa, 0xa1, 0x1e, 0x28, 0xfb, 0xc3, 0xa, 0x8}}
+	return a, nil
+}
+
+var _mailNotifyVisibility_failedTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x92\xc1\x6e\xd4\x30\x10\x86\xcf\xdd\xa7\x18\x7c\xa6\x9b\x2b\x12\x4e\xa4\xaa\x70\x40\xaa\xa0\x5a\x0a\x52\x4f\xd5\x24\x9e\x34\x66\x1d\xdb\xb5\x27\xbb\x44\x96\x1f\x88\xd7\xe0\xc9\x90\x93\xee\xb6\xc0\x29\xf6\x24\xff\xff\xcd\xfc\x19\xf9\xe6\xc3\x97\xeb\xbb\xfb\xdb\x8f\x30\xf0\x68\x9a\x8d\x3c\x3d\x08\x55\xb3\xb9\x90\x23\x31\xc2\xc0\xec\x2f\xe9\x69\xd2\x87\x5a\x5c\x3b\xcb\x64\xf9\xf2\x6e\xf6\x24\xa0\x5b\x6f\xb5\x60\xfa\xc9\x55\xd1\xbe\x87\x6e\xc0\x10\x89\xeb\x89\xfb\xcb\x77\x02\xaa\x62\xc3\x9a\x0d\x35\x29\x6d\xbf\x4e\xed\x0f\xea\x38\x67\x59\xad\xb5\x8d\xac\x56\xd6\x46\xb6\x4e\xcd\xe5\x63\xdf\xdc\x0d\x04\xb1\x1b\x48\x4d\x86\x54\x31\xb4\x8f\x04\xec\x60\xc4\x3d\x41\x20\xef\xa2\x66\x17\x66\x90\x9d\x53\x8b\xed\x8e\xbc\xfb\x8c\x23\x15\xdf\xa5\x06\x29\xe9\x1e\xb6\x9f\xe2\x6d\xd0\x07\x64\xca\xd9\xaf\x87\x94\xc8\xc4\x72\x9d\x5a\xa3\xbb\x94\xc8\xaa\x9c\xe1\x88\x11\xac\x63\x40\xef\x8d\x26\xf5\x16\x78\xf8\x0b\x74\xd0\x51\xb7\xda\x68\x9e\x41\x47\x98\xec\xda\x92\xda\xca\xca\x37\x9b\x8b\x85\x45\x4f\xb0\xdd\x11\x46\x67\x41\xf4\x2e\xec\x45\xce\x9b\x8b\x32\xcc\xf7\x17\xad\xeb\x01\xa1\xbc\x24\xf5\xda\x1d\xcd\x11\xe7\x08\xbd\x33\xc6\x1d\x23\x68\x8e\xd0\x62\x7c\xdd\xc0\x19\x54\x9a\x87\xff\x69\x1d\x3d\x3c\xcf\x77\xc6\xee\x4e\x62\x4d\xb1\x80\xcb\x44\xee\x68\x29\x00\x06\x82\x45\xa3\x4a\xa6\x2d\xc1\xb3\x14\xd0\x2a\xe8\xd0\x96\x20\x5a\x82\x11\x15\xc1\x1a\xd3\x0b\xbe\xa4\xb5\xfc\xa2\x7b\x37\xc1\x88\x33\x04\x3a\x68\x3a\xfe\x9b\x57\x24\x66\x6d\x1f\x23\x20\x83\x44\x18\x02\xf5\xb5\x48\x69\x7b\xa3\xed\x3e\x67\xd1\x9c\x8f\xb2\xc2\xe6\xd9\x5d\xfa\xe6\xf7\x2f\x48\xe9\x9e\x30\xe4\x5c\x64\x8c\xe1\x91\xb8\x16\x0f\xad\x41\xbb\x17\x10\xc8\xd4\xc2\x3a\xe7\xa9\x8c\x61\x5d\xa0\x9e\x42\xa0\x20\xce\x80\x2b\xef\xbf\xed\x6e\x56\xc2\x95\xf7\xa7\x95\xc0\x66\x41\xc8\x6a\x5d\x31\x59\xad\x5b\xfe\x67\x00\x29\xc0\xbd\x94\xfd\x02\x00\x00"
+
+func mailNotifyVisibility_failedTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_mailNotifyVisibility_failedTmpl,
+		"mail/notify/visibility_failed.tmpl",
+	)
+}
+
+func mailNotifyVisibility_failedTmpl() (*asset, error) {
+	bytes, err := mailNotifyVisibility_failedTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mail/notify/visibility_failed.tmpl", size: 765, mode: os.FileMode(0644), modTime: time.Unix(1792262163, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0x6, 0xd7, 0x3d, 0x18, 0x37, 0x8c, 0x7, 0x5b, 0xd, 0x3b, 0xbf, 0x96, 0x8d, 0x3a, 0xdf, 0xb7, 0xf7, 0xd2, 0xb, 0xdb, 0xa6, 0x25, 0xb6, 0x8b, 0x60, 0x6d, 0xe4, 0x43, 0xcd, 0xf9, 0xd5}}
+	return a, nil
+}
+
 var _orgCreateTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\xcf\x8e\xd3\x30\x10\xc6\xcf\xd9\xa7\x18\xf9\x01\x12\xa1\xbd\x70\x48\x2b\x21\x04\xe2\x50\x40\xda\x2d\xe7\x6a\x1a\x4f\x12\x6b\x1d\xdb\x4c\x9c\x2e\x60\xf9\xdd\x91\xf3\x8f\x34\x08\x2e\x4d\x33\xf1\xf7\xf3\x7c\xdf\xd8\x21\x78\xea\x9c\x46\x4f\x20\xae\xd8\x53\xd1\x12\x4a\x01\x79\x8c\x0f\xa5\x54\x37\xa8\x34\xf6\xfd\x41\x58\x6e\xd0\xa8\x5f\xe8\x95\x35\x60\xe8\x15\x2c\x37\xe2\xf8\x90\x6d\xd7\x0c\x0a\x3a\x25\xa5\x26\xb8\x11\xff\x04\x26\x8d\x3f\x48\x82\xc3\x86\xa0\x61\x25\xd3\xfa\x3b\x41\x65\xf5\xd0\x99\xb1\x9c\x95\xb5\xe5\x6e\x43\x4a\xaf\x02\xb0\x4a\x1b\x1e\x44\x08\xf9\x49\x99\x97\x18\x05\x74\xe4\x5b\x2b\x0f\xc2\xd9\xde\x4f\xd2\x2c\x84\xfc\xfd\xf3\xd3\xc7\xb3\x7d\x21\xf3\xe9\xfc\xf9\x14\xe3\x58\x2e\xdb\xc7\x0d\xd0\x5b\x07\xe8\x3d\x56\x2d\x49\x48\x26\x89\x67\x79\xd2\xab\x37\x6f\x4d\x7e\x66\x10\x86\x5e\x2f\xc9\xdb\xc2\x28\xda\xc7\x69\xd5\xce\xe9\x8a\xea\xa9\xe9\xc8\xf8\x3f\xac\x5d\x9e\xa8\x89\xfd\x14\x68\xb6\xe7\x28\xa3\x95\x21\x60\xfa\x3e\x28\x26\x09\xb5\x22\x2d\x21\x04\x55\x43\xfe\x81\xf9\xf2\x95\x9b\x2f\xd8\x51\x8c\xc4\x6c\x39\x04\x32\x32\xc6\x65\xa7\xac\xd4\x78\x25\x9d\x92\x1a\xe7\x73\x31\xd8\x91\x38\x6e\xcd\x58\x6e\xf2\xe5\xcb\xa5\xb5\x3a\x79\x8e\xb1\x2c\x46\xe1\x8a\x51\xc6\x0d\x1e\x94\xdc\x50\x20\xfd\x6e\xdf\x6f\xa8\x07\x1a\xe7\xb0\xd4\xd2\x2c\x70\xf0\xb6\xb6\xd5\xd0\xaf\x16\x56\x68\xef\xd0\x2c\x36\x5b\xd2\xee\x7f\x8d\x91\x76\x73\x63\x49\x35\x23\xca\x42\xaa\xdb\xf1\xe1\x9f\xa1\x8d\x59\xed\xb2\x38\xee\xad\x5d\x07\xef\xad\xd9\x8c\xad\x61\x22\x03\x53\x79\x15\x67\xfb\xd6\x2a\x26\xf4\xb4\x3d\x07\xa9\x9f\x49\xb5\xb2\x71\x83\x9d\x81\xd0\x32\xd5\x29\xa5\x77\xce\x3d\x0f\xd7\x6f\x4f\xa7\x18\x8b\x7b\xe7\x15\x9a\x8a\xf4\x68\x16\xef\x9d\xde\xff\x2d\x8b\x74\x03\xc6\x1b\x33\xd7\xe6\xe7\xfc\xf8\xeb\x9c\xd5\xd6\x7a\xe2\xe9\xa0\xfd\x0e\x00\x00\xff\xff\xc3\x63\x63\x1a\xd5\x03\x00\x00"
 
 func orgCreateTmplBytes() ([]byte, error) {
@@ -977,7 +1361,27 @@ func orgCreateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _orgHeaderTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\xcb\x6e\xd5\x30\x10\x86\xd7\x39\x4f\x61\x59\x15\x3b\x92\x76\x87\x44\x4e\x10\x12\x0b\x90\xa0\x45\xa8\x2f\x30\x71\xe6\x38\x43\x7d\x89\x6c\x27\x70\xb0\xfc\xee\x28\xd7\x73\x41\x95\x4a\x57\x1e\xe5\x9f\xf9\xf2\xff\x9e\x24\xc6\x5f\x14\x5a\x96\x3f\x38\x99\xd2\x2e\x2b\x1b\x1a\x98\x50\xe0\xfd\x9e\xf7\xc4\x84\x35\x01\xc8\xa0\xe3\xd5\x2e\xbb\x16\x07\x74\x81\x04\x28\x75\x64\xd2\x51\xc3\x5a\x84\x66\xea\xbb\x68\x14\x56\xf5\xda\xcc\xcf\xaf\x09\xe3\xc4\xc2\x1e\x45\xd2\xf2\x4c\x24\x0d\x12\x39\xf3\x4e\xec\x79\x8c\xf9\x0f\x54\x1f\x07\x08\xe0\xbe\x92\x79\x4a\xe9\x83\xdf\xdf\xdd\xde\x6e\xa3\xbe\x03\xb3\xce\x06\xfc\x1d\x58\x68\xc9\x30\xe9\xf0\xc8\xab\x12\x58\xeb\xf0\x30\x51\x3e\x5b\x8d\x33\x80\x57\x31\xe6\x9f\xc8\x77\x0a\x8e\xf7\xa0\x31\xa5\xb2\x80\xaa\x2c\x46\x52\xb5\x5b\xb0\x97\x76\x1d\xc9\x36\xac\xaf\xbc\x16\x35\x9a\x7e\xd3\xb2\x12\x56\x29\x46\x3a\xb0\x9b\xfc\x3b\x48\xfc\xe2\x1f\x9c\xfc\x86\xba\x46\xe7\x53\x02\x11\x68\xc0\x18\xd1\x34\x29\x31\x0a\xa8\xf9\xe6\xf3\x66\xdc\xc7\xec\xb3\xd0\xf3\xc0\x89\x9d\x95\xb4\xc2\xad\x08\x24\xac\x61\xcb\xf9\xd6\x3a\x09\x86\xfe\x40\x20\x6b\x78\x55\x16\x54\xbd\x31\xb5\xef\xde\x8f\x44\xba\x7b\x67\xf2\x47\xc7\xb8\x75\x32\xef\xd0\x76\x0a\xf9\xb8\xf2\xec\xdf\x38\x07\x65\x21\x90\x91\xac\x27\x56\x2b\x10\x4f\x4c\x41\x8d\x6a\xba\xb2\xfb\x5e\x6f\x11\xca\xa2\xa1\xe1\x94\xb9\x80\x17\xe4\x7f\x44\xd0\xff\x91\x3e\x8c\xed\x2f\xca\xfe\x13\x9d\x9f\xd6\xfd\x7c\xea\x19\xf6\xba\xd0\x8b\xef\x67\x23\x9f\x0b\x67\xf5\xa9\xdc\xaa\xb5\xd8\xce\xcb\x0f\xa9\xa1\x81\xa6\xbf\x62\xd1\x97\x3b\xda\xfd\x0d\x00\x00\xff\xff\x22\xee\xb0\x84\xaa\x03\x00\x00"
+var _orgDependenciesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x55\xc1\x8e\xdb\x38\x0c\x3d\x7b\xbe\x42\x10\xf6\xba\x36\xf6\xb6\x07\x25\xa7\xdd\x43\xd1\x41\x0f\x83\x69\xaf\x81\x2c\xd1\x8e\x30\xb2\xe8\x4a\x72\x66\x5c\xc3\xff\x5e\x48\xb6\x63\x29\x33\x4d\xd1\x53\x44\x86\x7c\x7c\x7c\xa4\xe4\x69\xf2\xd0\xf5\x9a\x7b\x20\xb4\xe6\x0e\xaa\x33\x70\x49\x49\x39\xcf\x0f\x4c\xaa\x0b\x11\x9a\x3b\x77\xa0\x68\x5b\x6e\xd4\x0f\xee\x15\x1a\x22\xa1\x07\x23\xc1\x08\x05\x8e\x1e\x1f\x8a\x14\x03\x6d\x1b\x21\xc0\x2e\x20\x45\x8a\x32\x28\x22\xd0\x78\xae\x0c\xd8\x90\x58\xb0\xfe\x38\x4d\xa5\xfa\xe7\x5f\x53\x3e\xdb\x98\x5c\xa6\xe0\xa5\x04\x27\xe8\x3c\xb3\xaa\x8f\xd1\x0d\xda\x2e\xc1\x0a\x66\x84\xb9\x2d\xd2\xe8\x41\x49\xc2\x45\x64\xab\x4c\x3f\xf8\x25\xac\x60\xd1\x20\x86\x77\x70\xa0\xdf\x29\xb9\x70\x3d\xc0\x81\x4e\x53\xf9\x19\xc6\x57\xb4\x72\x9e\x29\xe9\x35\x17\x70\x46\x2d\xc1\x1e\xe8\x5d\x7a\x0e\xb8\x15\xe7\x53\x92\x40\x03\x00\x1f\x3c\x36\x28\x06\xb7\x16\xad\x07\xef\xd1\x24\xfc\x6a\x3d\x00\x59\xbc\x34\x13\x00\xde\x7a\x8d\x16\x56\xe0\xd8\xf9\x12\xb6\x74\x59\x49\x75\x09\x27\x56\x85\xd6\xc3\x69\x9a\x54\x43\x76\xf2\x0f\xc5\xe6\xfa\x2f\xe1\xb9\xf8\x0b\xe6\x79\xad\x21\xa1\x11\xed\x55\x99\x82\xf9\x30\xb6\xd5\x28\x98\xb7\xdb\x31\xfc\x93\x91\xb4\xd0\x63\xae\x43\xcf\xc5\x0b\x6f\x21\xf2\xf5\xe7\x34\x71\xab\xd6\xe0\x60\xc9\xab\x92\x40\x7f\x03\x75\x01\xeb\x14\x9a\x7b\x50\xea\x02\x1f\x40\xbd\x1b\x4e\xc0\x76\xca\xa3\x1d\x6f\xc0\x58\x75\x6d\x8e\x55\x69\xd7\xcc\xd7\x28\xc7\x2d\x6c\x9a\x2c\x37\x2d\x7c\x28\xe5\x8d\x42\x05\xf3\x72\x37\xd6\x09\x3c\x41\xab\x9c\xb7\xe3\xd7\xa7\xc7\x3d\x2b\xc4\x72\x72\xb6\xd0\xc4\xd5\xca\x62\x28\xf1\xdc\xb6\xe0\x0f\xf4\x54\x6b\x6e\x5e\x28\xb1\xa0\x0f\xd4\x20\xf6\x60\xc0\x12\x83\x16\x1a\xb0\x36\x1e\x1b\xd4\x1a\x5f\xe9\x91\xa9\xeb\x15\x15\x5e\x09\x34\x64\xfd\xfd\x7b\x1b\xca\x91\x55\xea\x48\xa6\xa9\xfc\xc2\x3b\x08\x4a\xf0\x8c\x2a\x68\x07\x39\xbf\x3f\x45\xcc\xd0\x8c\x4c\x1d\x37\xf7\xb2\x53\x46\x91\x9a\x3b\x25\x88\xe6\x35\xe8\x38\xc1\xff\x05\xba\xd1\x79\xe8\xe6\xf9\xba\xe0\xd7\x41\xc9\x5c\xe3\x45\xd9\x6f\xcb\x8e\xcc\x33\x13\x28\x21\x60\xec\x9e\x6a\x75\x2d\x6d\x31\xd7\xf3\xeb\xc5\xf3\xf0\xe6\x49\x6b\x61\x0c\x65\xff\xba\xb7\x84\xdc\x8c\xa7\x74\x11\x03\xca\x71\x6d\xee\x3d\xa9\x7c\xa0\x3d\x96\x8f\xca\xbc\xcc\x73\x95\x3f\x93\xbf\x96\x35\xd4\xdf\x35\x8d\x08\xfb\xa8\xb2\x72\xc9\xe6\xe6\x5a\xb3\x2a\x59\x5d\x56\xc5\x8b\xbd\x18\x8b\x62\x9f\xdc\xb3\x1d\x8c\xe0\x1e\xf6\x94\xfe\x43\x5d\xee\x5c\x28\xbf\x41\xec\x2f\x72\x46\x23\xdb\xa5\x9b\xc9\x3b\x68\x3b\x30\xdb\x33\x5c\xdc\xad\x63\xf0\x64\xc1\x0d\xda\x3b\x7a\xf3\xb4\xed\x2f\x60\x52\xf7\x7a\x5a\xff\x5c\x7f\xde\x7d\xd8\x1a\x44\xbf\x7d\x95\x7e\x0e\x00\x6e\x2a\xfd\x78\xf6\x06\x00\x00"
+
+func orgDependenciesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgDependenciesTmpl,
+		"org/dependencies.tmpl",
+	)
+}
+
+func orgDependenciesTmpl() (*asset, error) {
+	bytes, err := orgDependenciesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/dependencies.tmpl", size: 1782, mode: os.FileMode(0644), modTime: time.Unix(1792270780, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x91, 0x6e, 0xca, 0xf9, 0xb, 0x3b, 0x1e, 0xca, 0xa2, 0x1d, 0x21, 0x1d, 0x48, 0x3f, 0xa9, 0x4a, 0xd2, 0x1a, 0x8a, 0x2c, 0xd5, 0x87, 0x63, 0x4, 0x81, 0xd, 0x31, 0xc4, 0xf7, 0xcf, 0xf5, 0x5e}}
+	return a, nil
+}
+
+var _orgHeaderTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x93\xdb\x6a\xdc\x30\x10\x86\xaf\xbd\x4f\x21\x44\xe8\x5d\xed\xe4\xae\x50\xaf\x4b\x21\x17\x2d\xb4\x49\x29\x79\x81\x59\x79\x56\x9e\xae\x0e\x46\x92\xdd\x6e\x85\xde\xbd\x78\x7d\xd8\x43\x43\xd8\xe4\x4a\x83\x7f\xfd\xbf\xe7\x1b\x31\x31\xfe\xa6\xd0\xb0\xfc\xd1\xc9\x94\x56\x59\x59\x53\xcf\x84\x02\xef\xd7\xbc\x23\x26\xac\x09\x40\x06\x1d\xaf\x56\xd9\xa5\xd8\xa3\x0b\x24\x40\xa9\x3d\x93\x8e\x6a\xd6\x20\xd4\x87\x7b\x67\x17\x85\x55\x9d\x36\xe3\xf7\xcb\x84\xc1\x31\x65\x0f\x22\x69\x79\x22\x92\x06\x89\x9c\x79\x27\xd6\x3c\xc6\xfc\x27\xaa\xcf\x3d\x04\x70\xdf\xc8\xec\x52\xfa\xe4\xd7\x77\xb7\xb7\x8b\xd5\xb7\x60\x66\x6f\xc0\x3f\x81\x85\x86\x0c\x93\x0e\xf7\xbc\x2a\x81\x35\x0e\xb7\x87\x94\x2f\x56\xe3\x18\xc0\xab\x18\xf3\x7b\xf2\xad\x82\xfd\x03\x68\x4c\xa9\x2c\xa0\x2a\x8b\x21\xa9\x5a\xad\xb2\x67\xda\x75\x24\x9b\x30\xff\xf2\x52\xd4\x68\xba\x45\xcb\x4a\x98\xa5\x18\x69\xcb\x6e\xf2\x1f\x20\xf1\xab\x7f\x74\xf2\x3b\xea\x0d\x3a\x9f\x12\x88\x40\x3d\xc6\x88\xa6\x4e\x89\x51\x40\xcd\x97\x3e\x6f\x86\xf7\x18\xfb\x2c\xf4\x68\x38\x66\x67\x25\xcd\xe1\x56\x04\x12\xd6\xb0\xe9\x7c\x6f\x9d\x04\x43\x7f\x21\x90\x35\xbc\x2a\x0b\xaa\xde\x99\x8d\x6f\x3f\x0e\x89\x74\xf7\xc1\xe4\x4f\x8e\x71\xeb\x64\xde\xa2\x6d\x15\xf2\xe1\xc9\xb3\xff\x71\xb6\xca\x42\x20\x23\x59\x47\x6c\xa3\x40\xec\x98\x82\x0d\xaa\xc3\xc8\x1e\x3a\xbd\x20\x94\x45\x4d\xfd\x91\xb9\x80\x2b\xf8\x9f\x10\xf4\x2b\xe8\xc3\x70\xfd\x2a\xf6\x5f\xe8\x3c\xee\x5f\xa4\x1e\xc3\xde\x06\x3d\xf5\xfd\x16\xe4\x7b\x6c\xd1\xd4\x68\x04\xe1\x2b\xc8\xeb\x13\xd7\x55\x03\x68\x41\xec\x86\x95\x79\x69\x02\x67\xa1\x29\x3d\xc3\x71\x0a\x78\x52\x1f\xcb\xa5\x9a\x8b\xe5\x3c\x5f\x88\x9a\x7a\x3a\x6c\xf7\xa4\x4f\xc4\xab\x7f\x03\x00\x27\x1f\x91\x33\x72\x04\x00\x00"
 
 func orgHeaderTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -992,8 +1396,8 @@ func orgHeaderTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "org/header.tmpl", size: 938, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa8, 0x16, 0x82, 0x4a, 0xa1, 0xb3, 0xa6, 0x4c, 0x6f, 0xf2, 0xb7, 0x7e, 0xb5, 0x51, 0x50, 0x10, 0x8a, 0x26, 0x45, 0x88, 0xa9, 0x73, 0x6, 0x9e, 0xed, 0xbb, 0xfa, 0x4a, 0xc2, 0xae, 0xf1, 0x3b}}
+	info := bindataFileInfo{name: "org/header.tmpl", size: 1138, mode: os.FileMode(0644), modTime: time.Unix(1792270780, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa4, 0xb0, 0xac, 0x9f, 0x1d, 0xb4, 0x41, 0x7e, 0x6f, 0x11, 0xa3, 0x4f, 0x86, 0xa7, 0x2, 0x82, 0xe0, 0x6e, 0x4, 0x2f, 0x9a, 0x39, 0x11, 0xe1, 0x70, 0xa9, 0xc0, 0x67, 0xae, 0x46, 0x28, 0xc}}
 	return a, nil
 }
 
@@ -1041,83 +1445,223 @@ var _orgMemberMembersTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x4
 
 func orgMemberMembersTmplBytes() ([]byte, error) {
 	return bindataRead(
-		_orgMemberMembersTmpl,
-		"org/member/members.tmpl",
+		_orgMemberMembersTmpl,
+		"org/member/members.tmpl",
+	)
+}
+
+func orgMemberMembersTmpl() (*asset, error) {
+	bytes, err := orgMemberMembersTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/member/members.tmpl", size: 2423, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc2, 0x10, 0xf8, 0xb0, 0x47, 0xa4, 0x61, 0x14, 0x9d, 0x9, 0x3, 0x9c, 0xb2, 0x34, 0x2a, 0x60, 0x0, 0x8, 0xf1, 0x66, 0x3a, 0x6a, 0xd6, 0xe0, 0x3e, 0x57, 0xce, 0x66, 0x6e, 0x11, 0x19, 0x5b}}
+	return a, nil
+}
+
+var _orgSettingsBotTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x57\xcd\x8e\xdb\x36\x10\x3e\x7b\x9f\x82\x20\x72\xb5\x8c\x16\x39\xf4\x20\x1b\x48\x37\x48\x53\x20\x2d\x8a\xdd\xe4\x2c\x8c\xc5\xb1\x4c\xac\x44\xaa\xe4\xc8\x89\x2b\x08\xe8\x43\xf4\x09\xfb\x24\x05\x7f\x24\x53\xfe\x49\x37\xdb\x9e\x24\x91\x33\xdf\xfc\x7d\x9c\xa1\xfa\x9e\xb0\x69\x6b\x20\x64\x7c\x0b\x16\x57\x7b\x04\xc1\x59\x36\x0c\x77\xb9\x90\x07\x56\xd6\x60\xed\x9a\x6b\x53\x81\x92\x7f\x00\x49\xad\x98\x45\x22\xa9\x2a\xcb\xb6\x9a\xf8\xe6\x6e\x91\x62\x68\x53\x79\x08\x34\x01\x64\x91\xa2\x74\x92\x95\x5a\x11\x48\x85\xc6\x29\x9e\x6f\x56\x46\x0a\xbf\x7e\x01\x39\x9a\x5c\x29\x38\x6c\x61\xc4\x9e\x03\xd0\x67\xac\x0f\xc8\x3e\x4b\x81\xac\xd4\x75\xd7\x28\x6f\x0d\x15\x05\xcc\xc5\x45\xac\x50\xa3\xa1\x09\x6b\x91\xef\x5f\x27\xce\x90\x6e\x19\x10\x41\xb9\x47\xc1\x62\x48\x01\x67\x91\xcb\xa6\x4a\x24\xe1\x00\x04\x86\xc9\x06\x2a\xe4\xcc\x9a\x72\xcd\xfb\x3e\xfb\x51\x53\xf6\x80\xf5\x1b\xbf\xf9\x41\xaa\xa7\x61\xe0\x1b\x16\x37\x7e\x85\x06\x87\x81\xe5\xb6\x05\x95\x20\x35\x52\x49\xb6\x05\x2b\x4b\x56\xc3\x16\x6b\xbe\xe9\xfb\x4c\x7e\xf7\x83\xca\x3e\x1a\xc6\x0d\xb6\x3a\x93\xd6\x76\x68\x33\x97\xfa\x61\xc8\x57\x0e\x20\x78\x95\xaf\xf6\xaf\xe3\xdb\x3c\xab\x53\x10\x04\xdb\x1a\x99\xc5\xaa\x39\xe5\x64\x91\x87\xd5\x93\xf8\x01\xcd\x31\xfa\x60\xc9\xc8\x76\x54\x1c\x15\x16\x39\xb9\x6c\x8c\x5f\x8b\x9c\xcc\xf4\xee\xf6\x66\x2e\x6b\x53\x65\x63\xed\x9c\xcf\x36\x73\x41\x58\x49\xda\x1c\xbd\xff\xb4\xff\x16\xe5\x12\x54\x61\x10\x44\x51\x6a\x81\x2f\xd4\xb7\x48\x85\x25\xa0\xce\xbe\x10\xa0\xd4\x8d\x4b\x60\xd1\x76\x75\x7d\x15\x62\xb6\x92\xaf\x4e\xf9\xc9\x57\xb3\xd4\xe5\xb4\xd5\xe2\x38\x49\xf6\xbd\x01\x55\x21\xcb\x7e\x32\xa0\xc8\x46\x52\x5e\xa4\x78\x91\x93\xd8\xe4\xc0\xf6\x06\x77\x9e\x68\x0f\x8e\x16\x23\xc1\xc6\xef\x40\xb0\x7c\x05\xce\x1b\x71\xae\x2d\x55\xdb\x11\xdb\x69\xd3\xac\x79\xe5\xac\x2d\xa3\xde\xcf\x6f\x87\x81\x33\x05\x0d\xae\xf9\x3c\xd9\x8c\x8e\xad\x5b\xdc\x63\xf9\xb4\xd5\x5f\x38\xeb\x7b\xb9\x63\xd9\x3d\xa8\x07\x04\x71\xaf\x05\x0e\x83\xdf\x44\xd1\xf7\xa8\xc4\x30\xfc\x37\xcb\x49\x99\x6e\x9b\x7e\x44\x7a\xf4\x32\xff\xaf\xed\x59\x85\x6f\x5b\xbf\x0f\x62\xbf\x75\x75\xfd\x2c\xfb\xdb\x8e\x48\xab\xdb\x0e\x24\xbd\x47\xaa\x23\x0b\xe2\xae\xa4\xaf\xbe\x46\xc9\xae\x15\x40\x58\x78\x3c\x4f\xc7\xa0\x77\xe6\x41\x4a\xc3\x45\x74\xf2\xc4\xca\x84\x87\xf9\xca\x9f\xf6\xcd\xdd\x57\x39\x99\xbb\x20\x98\x14\x57\xe3\x80\xd2\x8d\x09\xc7\xcd\x57\x91\x97\x2b\x2f\x65\x39\x6b\x90\xf6\x5a\xac\x79\xab\xed\xd4\x81\x9c\x95\x57\xd9\xfd\xe3\xc3\xbb\x8f\xfa\x09\xd5\xfb\x8f\xbf\x7c\x98\xec\x2c\x62\xd1\x42\x0d\xf6\x52\x08\x54\x63\xa5\x5c\x27\x29\xa4\xe0\xec\x00\x75\x87\x6b\x9e\xfa\x70\x3a\x71\xce\xd1\xcd\xdd\x45\xd8\xf9\x4a\xc8\xc3\xd5\x76\xb9\xd5\x44\xba\x39\x75\xcd\xf3\x7e\xd9\x8e\xb2\x84\x5f\x88\x55\x06\x8f\xfc\x5f\x9a\x46\x08\xbe\x10\x68\x4b\x5f\xa0\x76\x93\xba\xf0\x6d\xa3\x27\xb5\x34\x59\x69\x40\x41\x85\x05\x94\x25\x5a\x5b\x90\xcb\x22\x1f\x86\x67\x4e\x85\xf3\xf8\xe6\x62\x4f\x78\x64\xb5\x4c\x8a\x95\xee\x4b\xc2\x26\xad\xe2\xd7\x92\xe0\xbd\x9a\x92\x30\x95\x67\xaa\x42\xc2\x35\x4f\x83\xa4\xff\x9d\x9b\x64\xb3\xab\xc2\x85\x88\x56\xb3\x4b\x00\x4f\x8f\xa1\x1c\x85\xac\xdd\x2f\x9f\xf0\xb8\x74\x2d\x06\x97\x52\x09\x59\x02\x69\xc3\x76\xc0\x76\xb0\x2c\xa5\x29\x6b\x0c\x07\xfd\x3d\xd8\x07\x2c\x51\xd1\x9b\x92\xe4\x41\xd2\x71\x18\x3c\xc3\x0f\xc8\xa4\x3a\xa0\x21\xd6\xea\x56\xaa\x8a\x75\x6d\xdf\x63\x6d\x71\x18\x96\x3a\x52\x8d\xb3\x9b\x18\x02\x08\x96\xf1\x7e\x12\x8e\xca\x65\x5d\x7d\xce\x7c\x17\xc4\x31\x71\x9c\x79\xc5\x03\x18\x09\xe1\x94\x05\x27\xdc\x94\x96\xea\xc8\x4f\xfd\x47\xa6\x87\x3f\x49\xf3\x0b\x92\x15\x92\x62\x51\x09\xf7\xfc\xfe\x0b\xab\x71\x47\xfc\xd9\x36\x08\xd5\x4d\x1b\x96\x8c\x56\x95\x3b\x40\xe3\xc4\x8a\x2b\x77\x8b\x6b\x50\x10\xf3\xe7\x1a\x09\xa4\x40\x8b\x5c\x6e\xae\x27\x11\x84\x28\xb4\xe2\xe3\x6d\x6b\xd3\xf7\x6f\x81\xf0\x5d\x43\x8f\x7b\x6d\x88\x65\xf7\x06\x81\x50\x4c\x97\x29\xf6\xf7\x9f\x7f\x31\x76\x0a\x5e\x97\x24\x4b\xad\x58\x7c\x2e\xa5\xda\xe9\x10\xfb\xa9\xb8\x9f\xac\x03\xb8\x6e\xbf\x06\x4b\x45\x67\x51\xdc\x76\xe1\x53\x2b\x66\x2e\x8c\x3c\xba\x0e\xa8\x74\x31\xa6\x81\x0f\x43\x2c\xf8\xac\x16\x17\xc5\xb8\x5d\x1b\x23\xab\x3d\xb1\x5d\xad\x9d\x03\xd3\xc8\x49\x90\xc2\x52\xd2\x11\x4c\xa4\x5a\xbc\x23\xc6\x7d\x81\x35\x12\x2e\x23\x40\x20\x69\x67\xea\xd9\x10\x08\x2d\x60\x15\x44\xa3\x8c\x1b\x21\x7d\x9f\xcd\x9a\xf6\x38\x12\x2e\x43\x0f\xaa\xf3\x0e\x17\x23\x8c\x53\xef\x46\xcc\x67\x8d\x26\x19\x7f\xc9\xce\xed\x99\x70\xb3\x59\xfa\x31\x78\x92\x73\x9f\xe9\xfc\x9b\x47\x7e\x63\xfc\xf5\xfd\x8d\xe1\x97\x3a\xb1\x93\x58\x27\x0d\x2f\xf7\xbf\x07\xce\xde\x9a\xbb\x69\x38\x1f\x41\x67\x0d\xc4\x0b\x38\x8a\x78\xa5\xcd\xd9\x68\x75\x15\xf0\x12\x71\xac\x86\x77\x83\xbf\x77\xd2\xe0\xe9\xae\x9a\x26\xf0\x92\x14\x95\x41\x54\x17\xf4\xb9\xea\x53\x85\x0a\x0d\x5c\x56\xf1\xac\x84\xe9\xe4\x3e\x19\x9f\xde\xc6\x97\xf8\x8c\x8f\xbb\xb3\xba\xd9\x06\xea\x3a\x12\x35\x70\x87\x35\x5a\x40\xed\x5c\x3c\x13\xf5\x67\x3c\x99\xb5\xa7\x06\x40\x06\xec\xde\xef\x4f\x2d\xef\x6a\x60\xe9\xf8\x2d\xbc\x35\xa9\x43\x7c\x93\xb3\x89\xc9\xf4\xd7\x34\x6f\x37\xcf\x47\x9c\xdf\x23\x46\xe8\x8b\x7f\xdb\x78\x54\x7c\xb8\x45\x60\xa4\x8d\xff\xf5\x41\xe5\x42\x63\xa7\x35\x8d\xbf\xed\xff\x0c\x00\xc9\xf2\x86\x08\x17\x10\x00\x00"
+
+func orgSettingsBotTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgSettingsBotTmpl,
+		"org/settings/bot.tmpl",
+	)
+}
+
+func orgSettingsBotTmpl() (*asset, error) {
+	bytes, err := orgSettingsBotTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/settings/bot.tmpl", size: 4119, mode: os.FileMode(0644), modTime: time.Unix(1792258322, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc9, 0xfe, 0x2e, 0x38, 0x5d, 0x6b, 0x32, 0xe9, 0xdf, 0xd4, 0x8f, 0xe8, 0xa3, 0x53, 0xee, 0x8, 0x8, 0xc4, 0xf4, 0x53, 0x83, 0x4c, 0xf4, 0x13, 0xb6, 0x8c, 0xaf, 0x95, 0x42, 0x7a, 0xc1, 0xf7}}
+	return a, nil
+}
+
+var _orgSettingsBotsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x56\xcd\x6e\xe3\x36\x10\x3e\x3b\x4f\x31\x10\x7a\x68\x0f\x2b\xa3\xc0\x1e\x7a\x50\x0c\x74\x7f\xd2\x16\xd8\xb6\xc0\x6e\xee\xc6\xd8\x1c\x4b\x44\x28\x52\x25\x47\x4e\x5d\xc1\x40\x1f\xa2\x4f\xd8\x27\x29\x28\x52\x12\x65\x25\x71\x50\xec\x25\x96\x66\x86\xdf\x7c\xdf\xcc\x70\xa2\xae\x63\xaa\x1b\x85\x4c\x90\xed\xd0\xd1\xba\x22\x14\x19\xe4\xe7\xf3\x4d\x21\xe4\x11\xf6\x0a\x9d\xbb\xcd\x8c\x2d\x51\xcb\xbf\x90\xa5\xd1\xe0\x88\x59\xea\xd2\xc1\xce\xb0\xcb\x36\x37\xab\x14\xc4\xd8\xb2\xc7\x20\x1b\x50\x56\x29\x4c\x2b\x61\x6f\x34\xa3\xd4\x64\xfd\xc1\x4b\x67\x69\xa5\xe8\xed\x0b\xc8\x21\xe7\x5a\xe3\x71\x87\x03\xf6\x1c\x80\x1f\x49\x1d\x09\x1e\xa5\x20\xd8\x1b\xd5\xd6\xba\xcf\x46\x9a\x03\xe6\x6a\x21\x16\x15\x59\x1e\xb1\x56\x45\xf5\x36\x21\xc3\xa6\x01\x64\xc6\x7d\x45\x02\xa2\xa4\x80\xb3\xea\xba\x5c\x7e\xff\x83\xce\xef\x6d\xcf\x2e\x1f\xd8\xe5\x7d\x45\x06\xb4\x75\xf5\x36\xc4\x5f\xa8\x1c\x41\x1d\x95\xf5\xc4\xee\x32\xec\x81\x4e\xa0\xa4\x1b\xdd\x33\xbf\x64\xaa\x47\xc7\xcb\x7c\xb6\x82\xdc\x7e\x20\xe5\x69\x09\x79\x1c\x4e\x76\x9d\x45\x5d\x12\xe4\xef\x0c\xbb\x31\x64\x91\x09\x66\xbd\x59\x84\x18\x3d\xab\x7a\x12\xb5\x2a\x64\x5d\xa6\xd2\x8f\xc8\x68\x41\xd6\x58\x52\x06\xce\xee\x6f\xb3\xae\xcb\x3f\x93\xfa\xb1\x77\x7c\x92\xfa\xe1\x7c\x4e\xb3\xa4\x64\xe7\x49\x49\xd1\x91\xf4\xb3\x79\x11\x2a\x4b\x07\x0f\xff\x4d\xfe\xbb\x2d\x03\xf2\x34\x46\xbe\x30\xeb\xae\xcb\x7f\xc3\x9a\x7c\xc6\xc2\xb1\x35\xba\xdc\x8c\xa6\x62\x1d\x2d\xc5\x1a\x13\xdc\xae\x93\x07\xc8\xef\x5a\xa5\x62\x98\x6b\x50\x8f\x03\x48\x7f\x32\x94\x96\x4e\x99\xc7\x49\x82\xd6\x3e\x6a\xd3\x75\xa4\xc5\x54\xe4\xb9\x1c\xdc\xb3\x3c\x4a\x3e\x41\x4d\x8c\xa9\x92\x55\x21\x37\x5e\xc4\xd8\xe0\xb1\xb9\x28\xc4\xd6\xe8\xec\x7c\x86\x22\xe2\x7f\x40\xa6\xbb\x9a\xbf\x54\xc6\x32\xe4\xef\x2d\x21\x93\x18\xf3\xc3\xbf\x7f\xff\x03\x33\xa8\xc5\xac\xe4\xba\xad\xb7\xa5\x45\xcd\x2e\x83\x6f\x15\xe9\x7e\x32\x7e\xea\x0d\xdf\x79\x20\x99\xd6\xf8\xa2\x39\xcf\xf7\xca\xca\xb2\x62\x38\x28\xe3\xf9\xc0\xae\x65\x36\xf3\x6e\x05\x53\x32\x28\x96\x04\xb0\xd4\x27\xd8\xa1\x93\xfb\x78\x04\x04\x29\x62\x7a\x13\x01\x40\x20\xe3\x9b\xd6\xaa\xd0\xe5\xd8\xe2\x10\x13\x9d\x52\x78\x5f\xfe\xcb\x87\xd9\x54\xad\x56\x57\xaa\x10\x31\x66\xbd\x5a\x87\xac\xcf\xe9\xbd\xb8\x56\x49\xa7\x13\x4f\x7c\xfc\x8a\xcb\x26\xd7\xf4\xf8\xff\x17\xce\xc1\xd8\x3a\x89\xf3\xaf\x19\xf8\x49\x34\xba\xaf\x5b\xbc\x8f\x7e\x26\x2b\x23\x6e\xb3\xc6\x24\xeb\xa8\xeb\xf2\xf7\x5f\x3e\xdf\xdd\x9b\x07\xd2\x3f\xdf\xff\xfa\x69\xda\x30\x69\xe7\xe9\x8f\x56\xfa\x66\x1e\x24\x29\x01\xe1\xfa\x7c\xb4\x76\xfb\xce\x70\xb8\x1c\x64\xad\xb1\xb1\x60\x53\x8f\x0a\x85\x3b\x52\x9e\xd1\x6d\xb6\x33\xbc\xd5\x58\x53\xb6\xb9\x52\x0a\x1f\xe3\x87\xb4\x3f\x3b\x41\x49\xdd\xb4\x0c\x52\x24\x48\xe0\xff\xa6\xef\x47\x54\x2d\xf5\x9a\x07\x9b\xd7\x3d\xb0\xdf\x3c\xb5\x3b\x53\x99\x97\xea\xa6\xbb\x7f\x5d\xde\xa1\x55\xea\x9a\xbe\x29\xe6\x45\x7d\x53\x58\x14\x98\x18\x26\x85\xa3\x31\x61\xf4\x6a\x65\x1f\x6b\x94\xea\x35\xb2\xc8\x07\xce\x25\x05\xd3\x8b\x0a\x42\x48\x64\x1f\x5f\xf8\xd4\x4c\x2f\x93\x0c\x0a\x4c\x92\xec\xcd\x40\xba\x22\xd5\x5c\x9b\x96\xfe\xf8\xd6\x47\x92\xed\x39\x35\x4f\xd7\x62\xb1\x9a\x4a\x4b\xa4\x17\x4b\xec\xd5\xb7\x74\xb1\x4a\x8a\xb5\xbf\x77\xb3\x05\x91\x3e\x0d\x0f\xf1\x37\xfe\xdc\x5c\x5c\x71\x57\xa3\x52\x71\x59\x86\xed\x05\xb5\x11\xa8\x3c\xc1\x8b\x50\xb9\x37\x3a\x5d\x31\x85\x1c\x9c\x6c\xd1\x55\xbd\x3f\xdb\xc4\x55\xff\xb2\xac\x3e\x93\xec\xff\x07\xdd\x4c\x44\x93\x74\xe9\xf7\x57\xd1\x6c\x5e\x87\x36\x7c\xb0\x84\x96\x0c\xb0\x8b\x8f\xb7\x20\x73\xdb\xcb\xdc\x86\xa5\xe5\xe2\x97\x6b\x38\xb2\x38\x71\x30\x86\x87\xef\xd2\xff\x06\x00\x4f\x3f\x62\x63\xf9\x0a\x00\x00"
+
+func orgSettingsBotsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgSettingsBotsTmpl,
+		"org/settings/bots.tmpl",
+	)
+}
+
+func orgSettingsBotsTmpl() (*asset, error) {
+	bytes, err := orgSettingsBotsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/settings/bots.tmpl", size: 2809, mode: os.FileMode(0644), modTime: time.Unix(1792292269, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcd, 0xdf, 0x7e, 0x1c, 0x8c, 0xf8, 0x4f, 0xa4, 0x7b, 0x55, 0xa2, 0x2d, 0x97, 0x87, 0x4e, 0x4e, 0x18, 0x91, 0x36, 0x6e, 0x1f, 0xb, 0xe6, 0x20, 0x5a, 0x87, 0x72, 0x5f, 0xf5, 0xb3, 0x8f, 0x81}}
+	return a, nil
+}
+
+var _orgSettingsDeleteTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x8e\xd3\x30\x10\x3d\xa7\x5f\x31\x32\xe7\x26\x02\xed\x81\x43\xda\x0b\x02\x71\x58\x24\xc4\xee\xbd\x9a\xc6\x93\xd4\xaa\x63\x07\x7b\xd2\x02\x21\xff\x8e\x12\x27\xa9\x49\x17\x2d\x27\x3b\xce\xcc\x9b\xf7\x9e\x67\xdc\x75\x4c\x75\xa3\x91\x09\xc4\x11\x3d\x65\x27\x42\x29\x20\xed\xfb\x4d\x2e\xd5\x05\x0a\x8d\xde\xef\x84\x75\x15\x1a\xf5\x0b\x59\x59\x03\x9e\x98\x95\xa9\x3c\x48\xd2\xc4\x24\xf6\x9b\x24\x86\xb1\xae\x1a\x51\xc8\x05\x9c\x24\x06\x6a\x15\x14\xd6\x30\x2a\x43\x6e\x48\x5c\xff\xac\x9c\x92\xe3\xf9\x1d\xe4\x5c\x35\x33\x78\x39\xe2\x8c\xfd\x37\x00\x5f\x49\x5f\x08\xae\x4a\x12\x14\x56\xb7\xb5\x19\xab\x91\xe1\x80\x99\xdc\xc9\x45\x4d\x8e\x17\xac\x24\x3f\x3d\x44\x64\xd8\x36\x80\xcc\x58\x9c\x48\xc2\x15\x9d\x51\xa6\x82\x49\x5a\xc0\x4b\xba\x2e\x55\x6f\xdf\x9b\xf4\xd9\x8d\x2c\xd3\x99\x65\x1a\xbc\x39\x60\x51\xd8\xd6\xb0\x98\xf1\xb3\xd3\x43\xc8\x5c\xe9\xbe\x2b\xe3\xa9\xaa\x6f\xbc\xd7\xe1\x8e\x24\xd4\xe4\x3d\x56\x34\x47\x24\x79\xb3\xd8\x40\x3f\x18\x34\x95\x2c\xf6\xb9\x5a\xae\xb0\x60\x55\x58\x03\xd3\xba\x0d\xca\xf7\x79\xa6\xf6\xf0\x9a\x8a\xc6\xd9\xba\x61\x01\xbf\xe1\x89\xdd\xbb\xcf\xcf\x5f\x1e\xfb\x3e\xcf\x9a\x99\x5b\x26\xd5\x65\xde\x97\xd6\xd5\x11\xd1\xe1\x53\x80\x92\x3b\x11\xa0\xb6\xe1\x00\x8b\xa1\x93\x76\xa2\xeb\xd2\x47\x65\xce\x7d\x2f\xa0\x26\x3e\x59\xb9\x13\x8d\xf5\x8b\xea\xc1\xde\x0f\x4f\xdf\x3e\x3d\xdb\x33\x99\x50\x76\x16\xab\x4c\xd3\xf2\x5c\xa8\xc4\x33\x09\xe0\x9f\x0d\xed\x44\x83\xde\x5f\xad\x93\x37\x5f\x22\xeb\x94\xd1\xca\x10\x38\xfa\xde\xaa\xc1\xc3\x52\x91\x96\xd0\x75\xaa\x84\xf4\xa3\x73\x87\xaf\x53\x72\xdf\x93\x73\xd6\x75\x1d\x19\xd9\xf7\x0b\x54\x92\x6b\x3c\x92\x1e\x54\xc5\x85\x62\xfb\x96\xd3\xc1\xa1\x31\xfa\x96\x1c\x38\x0f\x66\x2c\x51\x60\xb0\x8e\x49\xaf\x45\x00\xb6\x6c\x4b\x5b\xb4\x7e\x21\xbd\xe8\x8a\x6c\x7f\xa9\x3f\x8e\x2d\xb3\x35\xd3\x90\x6e\xc3\x97\x00\x89\x8c\xdb\x50\x24\x5c\xc5\x78\x30\x6c\x77\xe2\x4d\x7c\x47\x0b\xed\x7f\x36\x47\x61\x4d\xa9\x5c\x7d\x78\xb9\xd5\x57\x7d\x91\x0d\xa0\x53\xeb\x2f\xe7\xcb\x6e\xde\x4c\xeb\xb4\x6c\x56\xaa\x7c\x8d\x5a\xc3\x11\xbd\x2a\x26\x59\x50\x5b\x89\x7a\xe0\xba\x0a\x1d\x5b\x3d\x1a\xd5\xdb\x1c\xb0\x43\x7f\x1a\xff\x87\xe6\xdf\xbc\x3e\xc4\xd6\x55\x07\x56\xac\x69\xd4\xb6\x70\x8d\x2a\xc6\xaf\x4c\xde\xec\xff\x07\x50\x92\x2f\xc4\x3c\x46\x33\xe6\xdd\xfb\x34\x25\x8c\x32\x0f\x61\x6a\xfc\xf4\x3c\x87\x94\xbb\x8c\xd2\x5a\x9e\x9f\xde\x3f\x01\x00\x00\xff\xff\x87\x15\x1a\x53\xde\x05\x00\x00"
+
+func orgSettingsDeleteTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgSettingsDeleteTmpl,
+		"org/settings/delete.tmpl",
+	)
+}
+
+func orgSettingsDeleteTmpl() (*asset, error) {
+	bytes, err := orgSettingsDeleteTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/settings/delete.tmpl", size: 1502, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0x91, 0x36, 0xa1, 0x14, 0x91, 0xd7, 0x17, 0x8d, 0x3f, 0x2d, 0x4e, 0x86, 0xc9, 0x4b, 0x18, 0x27, 0x8a, 0xea, 0x9, 0xd2, 0x41, 0xb, 0xcc, 0x2f, 0x3, 0xd9, 0x9d, 0xec, 0x12, 0xf8, 0xee}}
+	return a, nil
+}
+
+var _orgSettingsNavbarTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\xd4\xbf\x6a\xc3\x30\x10\x06\xf0\x39\x7d\x0a\xe1\x07\xb0\xe9\xd6\x21\xcd\x50\x3a\xb4\x50\x48\x48\xba\x07\xc5\xba\x38\x47\x64\x9d\x39\x49\x0e\xc5\xe8\xdd\x8b\xff\x35\x29\xa5\x28\xf6\x94\x41\xdf\x77\xf7\x43\x11\x5e\x2a\xac\x45\xae\xa5\xb5\xcf\xc9\x91\x3c\x8b\x0b\x2a\x10\x39\x69\x5f\x9a\x64\xf5\xb0\xb8\x3d\xf7\x28\x6a\x60\x87\xb9\xd4\xa2\x04\xe3\xdb\xf3\x5f\x81\x13\x48\x05\x2c\xd0\x41\x99\xac\x9a\x26\xc5\xc7\x27\x93\x7e\xb2\x48\x88\x8b\xd4\x82\x73\x68\x0a\x9b\x84\xb0\xcc\x14\xd6\x5d\x59\x8e\xd5\xa6\xc1\xa3\x48\x37\xb2\x80\x77\xbb\x1b\x92\xeb\xca\x21\x19\x1b\x82\xcc\x1d\xd6\xd0\x34\x60\x54\x08\xfd\x78\x71\x62\x38\xb6\xb5\x74\xcd\xc5\x07\x9a\x73\x08\xd9\xcf\x86\x76\xf4\xe2\xbf\xfd\x29\xf5\x63\x93\x10\x5a\x41\x26\xe3\x90\x37\xa2\xf3\x74\x46\x76\x6a\x6b\x7f\x31\x0c\x15\x5d\x35\x7d\xe8\x7e\xcb\x0e\x72\x06\x37\x43\x63\xfb\x62\xcc\x33\xc6\xee\x17\xbd\xd0\x1c\xce\x81\x5c\xec\x8f\x3a\xd0\x24\xc7\x16\x6a\x84\xcb\x16\x4a\x34\x0a\x78\x06\x89\xc7\x6a\xec\x8e\xb8\xdb\xb4\xbf\xe6\xa7\x20\x2b\xda\x90\xc6\xfc\x6b\x8e\xaf\x22\x8b\x8e\x18\x21\x76\x75\x6d\x74\x5f\x75\x7b\xa6\xe0\xbc\x86\xdd\xac\xc7\xc5\x5e\xc7\x4d\x5e\xc3\xde\x4e\x7b\x5b\xaf\xa0\xc1\xc1\x74\x8f\xea\x7a\x11\xd0\x10\xba\xd1\x0c\x1f\xa6\xe1\xe7\x7b\x00\x06\x21\x40\xbd\x1c\x05\x00\x00"
+
+func orgSettingsNavbarTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgSettingsNavbarTmpl,
+		"org/settings/navbar.tmpl",
+	)
+}
+
+func orgSettingsNavbarTmpl() (*asset, error) {
+	bytes, err := orgSettingsNavbarTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/settings/navbar.tmpl", size: 1308, mode: os.FileMode(0644), modTime: time.Unix(1792268704, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3b, 0xed, 0x7d, 0x9a, 0x13, 0x7f, 0x21, 0x1, 0x49, 0xa9, 0xb8, 0x50, 0xe3, 0xb6, 0xa8, 0x74, 0xe3, 0x41, 0xd, 0xc0, 0x1f, 0x2f, 0x4d, 0x9e, 0x18, 0xb7, 0xb7, 0x2b, 0x40, 0x45, 0xb1, 0x6b}}
+	return a, nil
+}
+
+var _orgSettingsOptionsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\xab\xe3\x36\x14\x5d\x67\x7e\x85\x30\xdd\xc6\xa6\x65\x16\x5d\x38\x81\x32\xed\xd0\xc2\x9b\x16\xa6\xaf\x74\x69\x6e\xac\x1b\x5b\x3c\x59\x72\xf5\x91\xbc\xd6\xf8\xbf\x17\x59\x92\xe3\xaf\x3c\xf2\x60\x36\x89\x2d\x5d\x1d\x9d\x73\xee\xb5\xae\xba\xce\x60\xd3\x72\x30\x48\x92\x13\x68\xcc\x6a\x04\x9a\x90\xb4\xef\x3f\xe4\x94\x5d\x48\xc9\x41\xeb\x43\x22\x55\x05\x82\xfd\x07\x86\x49\x41\x34\x1a\xc3\x44\xa5\x89\x6c\xdd\xbb\x4e\x8e\x1f\x76\x53\x1c\xa9\xaa\x01\x06\x95\x07\xda\x4d\x91\x2c\x23\xa5\x14\x06\x98\x40\xe5\x16\x2e\x27\x2b\xc5\xe8\x30\xbe\x82\x8c\xdb\x66\x02\x2e\x27\x88\xd8\x73\x00\x73\x45\x7e\x41\x72\x65\x14\x49\x29\xb9\x6d\xc4\xb0\x1b\x0a\xe3\x31\x77\x2b\xbd\xc0\x51\x99\x11\x6b\x97\xd7\x1f\x27\x64\x8c\x6c\x09\x18\x03\x65\x8d\x94\x04\x49\x1e\x67\xd7\x75\x29\xfb\xfe\x47\x91\x3e\xab\x81\x5d\x1a\xd9\xa5\xd1\x94\x08\x98\xd5\x1f\xfd\x92\x85\xd0\x11\x57\x63\xd5\xdc\x08\xee\xf2\xb3\x54\xcd\x24\xce\xbd\x26\x04\x4a\x87\x7a\x48\xba\x2e\x7d\x62\xe2\xa5\xef\x13\xd2\xa0\xa9\x25\x3d\x24\xad\xd4\xe3\x62\x47\xeb\xd3\x9f\x5f\x3f\x3f\xcb\x17\x14\xbf\x3e\x7f\x79\x0a\x2c\xe6\xbb\x2b\xfc\xc7\x32\x85\x94\x9c\x19\x72\x4a\xba\x8e\x9d\x49\xfa\x8b\x52\xc5\xef\xd0\x60\xdf\xa3\x52\x52\x75\x1d\x0a\xda\xf7\x23\xf0\x2e\xe7\x70\x42\xee\xe8\x0c\xe5\x50\x08\x68\x30\x39\x2e\x6d\x88\x33\x45\x2d\xb9\x73\xab\xef\x73\xdd\x82\x18\xf3\x83\xaf\x86\xb8\x9d\x6b\x46\x31\x21\x8c\x0e\x58\x7b\xb7\x62\x5f\xd6\x20\x2a\xdc\xb7\x4a\x36\xad\x49\x8e\xe4\xae\xc3\x3e\xb0\x90\xaa\x1a\x76\x0a\x0b\xfa\x3e\xcf\xdc\x56\xc7\x3c\x1b\x98\xde\x88\x33\xd1\x5a\x13\xf7\xf2\xbc\x89\xfb\x3d\x24\xfe\xf9\x02\xdc\xe2\x60\xed\x1f\xaa\x4a\xbd\x07\x09\xa1\x60\x60\x1f\xc9\xad\x66\xc1\x1a\x79\x96\xa5\xd5\x24\x9a\x19\xf7\xcb\x33\xca\x2e\xc7\x0d\xd7\x97\x66\x7f\xb6\x9c\x3f\x6a\xf8\xd9\x72\xfe\x86\xe3\xe3\xf4\xc4\xf6\xfb\x2e\xdc\xc0\x82\x0d\x93\x81\xb9\x17\x37\x8a\xc9\xbb\xe5\xfd\x8c\xba\x54\x6c\xf8\x18\x1e\x51\x48\x6f\xe1\x4e\xe3\x77\x6b\x91\x2e\x62\x4b\x98\xab\x29\x50\x08\x83\xb6\x29\x4c\x50\x37\x1b\x52\xf2\xaa\x0f\xc9\x0f\x83\x8d\x4e\xe1\x8c\x65\x9e\x45\xac\x77\xab\xfd\x1b\x4f\x9a\x99\x87\x72\x79\xf5\xa1\xeb\x4c\x8e\x05\x1e\x23\xde\xcc\x62\x0c\x0a\x2a\xc7\x57\xf3\x6f\x8b\x87\xc4\x2a\xbe\x4c\xe6\x48\xf1\xc1\x5c\x6e\xb3\xe7\xb2\x84\x98\xa4\x3b\xf4\xc7\x90\x37\xf9\x8f\x51\x41\xc0\xed\x7d\xc1\xfb\x29\x4c\xac\x89\x8f\x67\x9e\xcb\xc2\x93\xac\x2a\xa4\x7f\x69\x54\xe9\x6f\xfa\x27\xda\x30\xb1\x79\xf6\x59\x46\x28\xbb\xb0\xe1\x24\x9f\xc3\x4c\xa3\x98\xe0\x4c\xe0\xea\x7c\xfc\x02\xaf\x5f\xb1\x95\x9f\x14\xc2\xa3\x75\xdd\xc0\x6b\xa1\xb0\x95\x45\x19\x16\xcd\x8d\x03\x47\x34\xb5\x1a\x95\x4e\xd7\xa1\x6f\x1a\xb8\x0e\x0f\x4e\x6e\x4c\xf8\xa2\x10\xb6\x39\xb9\xa6\x3c\xf7\x77\x25\x6a\x22\xa4\x8d\x86\xd4\xc8\xdb\x77\x30\xbf\x7d\xac\xed\x66\xb1\x05\xcb\xb6\xac\x5f\xd6\xde\xc9\x1a\x23\xc5\xec\x86\x80\x28\x88\x1f\xde\x38\x2a\xc6\x32\xb4\x2d\x05\x83\x45\x7c\x1f\xd8\xf8\x55\x5b\x94\xf2\xcc\x35\xda\x58\x0c\x0f\x55\xcc\x83\x9d\x3a\x83\x0b\x18\x77\x5f\x99\x35\x6c\x82\xa2\xf4\x59\x69\x2c\x37\xac\x05\x65\x06\x06\x7b\xd7\x77\xde\xd7\xce\xa7\xc5\xba\x5d\x84\x81\xc1\x2c\x7f\x93\x76\x2a\xa5\xc6\x42\xe0\xb5\x08\x71\x77\xcb\xce\xd7\x57\xd4\xe3\xe9\x9f\x19\xc7\x84\x6c\x7f\x99\xdf\x2a\xaf\xcb\x9c\x4e\x78\xce\x33\xba\xcb\x61\x82\xe8\x2e\x1a\x61\x1b\x8a\x1c\x0d\xee\xbd\xf5\x43\x6b\x77\x7d\x1b\xb5\xd9\x5b\xc5\xd7\xc9\xca\x7c\x7c\x08\xa5\x52\xe0\x22\xee\x1e\x41\xbf\xae\x28\xad\x52\x28\xcc\x94\xe8\x66\x4f\x89\x55\x37\x1f\x1f\x9f\xe2\x43\xf8\x0f\x7f\xab\x3b\xec\x59\x4a\x13\x2f\xdb\xff\x07\x00\x00\xff\xff\xf6\xb1\x55\xe3\xd1\x0b\x00\x00"
+
+func orgSettingsOptionsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgSettingsOptionsTmpl,
+		"org/settings/options.tmpl",
+	)
+}
+
+func orgSettingsOptionsTmpl() (*asset, error) {
+	bytes, err := orgSettingsOptionsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/settings/options.tmpl", size: 3025, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x87, 0xaf, 0x13, 0x8, 0xaf, 0xb7, 0x28, 0x1d, 0xf6, 0xaf, 0x5c, 0x76, 0x14, 0x4f, 0xd7, 0xe2, 0xdd, 0x30, 0x74, 0x9c, 0x30, 0xae, 0xdb, 0x9e, 0xd0, 0x9f, 0x12, 0x41, 0x77, 0xbc, 0xa9, 0xaf}}
+	return a, nil
+}
+
+var _orgSettingsRemindersTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xd0\x51\x6e\x83\x30\x0c\x06\xe0\x67\x72\x8a\x28\x07\x80\x0b\x30\xae\x32\x19\x62\x32\x4b\x60\x23\xc7\xe3\x61\x28\x77\x9f\x4a\x1b\x95\xb6\x4f\xbf\x94\xfc\xf9\x62\xf9\x38\x0c\xd7\x6d\x01\x43\x1f\x46\xc8\xd8\xfd\x20\xc4\xe0\xdb\x52\x5c\x1f\x69\xf7\xd3\x02\x39\x7f\x05\xd1\x04\x4c\x7f\x60\x24\xec\x33\x9a\x11\xa7\xec\x15\x57\xe2\x88\x9a\xc3\xe0\x9a\xab\x24\x9a\x4e\x08\xf5\x4e\x35\x57\xeb\x97\xfc\x24\x6c\x40\x8c\x7a\x7b\xf8\x7e\x99\x94\xe2\x79\xfe\x41\xd6\x8f\x3b\x86\x7d\x84\x6a\xbf\xf6\x14\x37\x79\x16\xeb\x84\xdf\xb3\xe8\x5a\xfb\x7d\x17\x69\x1f\x5c\xcd\x47\x5c\x91\x73\x13\xb3\x88\xa1\x06\xdf\x96\xe2\xfe\x07\x00\x60\xe8\xf7\x5c\x27\x01\x00\x00"
+
+func orgSettingsRemindersTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgSettingsRemindersTmpl,
+		"org/settings/reminders.tmpl",
+	)
+}
+
+func orgSettingsRemindersTmpl() (*asset, error) {
+	bytes, err := orgSettingsRemindersTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "org/settings/reminders.tmpl", size: 295, mode: os.FileMode(0644), modTime: time.Unix(1792247875, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x31, 0xac, 0x22, 0xcb, 0x5b, 0x3f, 0x3a, 0x22, 0xbe, 0x7, 0x68, 0x7c, 0xd4, 0xc0, 0x71, 0x4c, 0xb4, 0xee, 0x82, 0xe3, 0xf6, 0xb2, 0x55, 0x46, 0xaf, 0xe3, 0x24, 0x3d, 0x96, 0xae, 0xc3, 0xc3}}
+	return a, nil
+}
+
+var _orgSettingsRepo_policyTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x56\x4d\x6f\xa4\x38\x10\x3d\x93\x5f\x61\x59\x7b\x0d\xbd\x91\x72\xd8\x03\xe4\xb0\xd1\x46\x7b\x48\xa4\x28\x89\xe6\x8a\x0c\xae\x86\x52\x8c\x6d\x19\x43\xba\x07\xf9\xbf\x8f\xcc\x57\xa0\x21\x4a\x2b\x33\x39\x75\xdb\x50\xef\xbd\x7a\xf5\x30\xb4\xad\x85\x52\x0b\x66\x81\xd0\x94\x55\xb0\x2b\x80\x71\x4a\x42\xe7\x2e\x22\x8e\x0d\xc9\x04\xab\xaa\x98\x2a\x93\x33\x89\x3f\x99\x45\x25\x49\x05\xd6\xa2\xcc\x2b\x62\x40\xab\x4b\xad\x04\x66\x47\x7a\x73\x11\xcc\xb1\x94\xc9\x3b\x28\x30\x3d\x58\x30\x47\xab\x91\x64\x4a\x5a\x86\x12\x8c\x2f\x3c\xbd\x98\x1b\xe4\xdd\xfe\x0a\x72\xa4\xde\x49\xd6\xa4\x6c\xc4\x5e\x02\xd8\x37\x10\x0d\x90\x37\xe4\x40\x32\x25\xea\x52\x76\x6c\x20\x6d\x8f\x19\xac\x7a\x66\x02\x8c\x9d\xb0\x82\xa8\xb8\x9e\x89\xb1\x4a\x13\x66\x2d\xcb\x0a\xe0\x64\x68\xa9\xc7\x09\xda\x36\xc4\xab\x7f\x64\xf8\x62\x3a\x75\xe1\xa8\x2e\xf4\xc6\x24\x83\x31\x23\xe8\xae\xb8\xee\xcb\x4e\x9a\x9d\xb0\x2b\xc8\xcb\x77\x91\x41\xa4\x6f\xce\xc1\x4f\x38\x54\x19\x75\x2e\xda\xe9\xb1\x70\xaf\x4c\x39\x23\xf0\x4b\x4a\x58\xe6\x67\x17\xd3\xb6\x0d\xef\x51\xbe\x3a\x47\x49\x09\xb6\x50\x3c\xa6\x5a\x55\x13\xab\xef\xe9\xf6\xf9\xe9\xee\x45\xbd\x82\xfc\xff\xe5\xe1\x7e\x90\xbf\x94\x8d\x52\xa0\x04\xb2\x47\x10\x7c\xaa\x5c\x8d\xb8\x80\xec\x35\x55\x87\xf7\x1b\x82\xb6\xc5\x3d\x09\x9f\xd1\xc2\x9d\x32\x19\x3c\x1a\x6c\x98\x85\x89\xc2\x63\xa0\xd4\xb5\x25\xf6\xa8\x21\xa6\x13\x42\x8f\x05\x9c\x70\xac\x58\x2a\x80\xcf\x21\x41\x54\x5b\x10\x92\x95\x10\xd3\xbd\xe7\x49\x74\x4f\x44\x57\xb8\xbd\xa0\x27\xd0\xea\xb1\x73\x33\x5c\xea\x1a\x68\xdb\x16\x24\x77\x6e\x41\xea\x37\xa6\x75\x24\x58\x0a\xe2\xac\x79\x85\x4b\x45\x7e\x70\x7d\xf1\xe4\xe2\x8e\x63\x33\xad\x3e\x75\x2c\xd2\xa3\xe5\x05\x08\x4d\xcf\xd3\x00\xb2\x53\xc1\x93\xf4\x98\x30\x5e\xa2\x5c\x04\xe8\xb4\xbf\x85\xa2\x3f\x1e\x83\x14\xf9\x0f\xac\x30\x45\x81\xf6\x78\x5b\x30\x99\x7f\x77\x20\x52\xe4\x49\x33\x51\x26\x59\xc7\x79\x5e\x36\x36\xc5\x7e\x53\x4a\xb6\x65\x7e\x16\x98\x75\x1e\x3e\xf3\xfa\xcb\x89\x19\x9d\xfe\xad\x86\xc6\xf3\x6b\x30\x6b\x96\xc2\xb3\x62\x37\x4c\xe9\x3f\x63\x92\x07\x76\xb8\x35\xd0\xbd\xa2\xee\xb1\x44\xeb\x1c\x18\xa3\xcc\x00\x3c\xcb\x67\x67\x9f\x3f\x16\x63\x5a\xb2\x43\x92\x0d\x45\x89\xf0\x55\x67\x3e\x41\x1b\x85\x1b\x93\xe9\x83\x87\x7c\x93\x68\x08\xe4\xd6\x95\x3e\x8a\xb2\x2e\x53\xff\xfe\x2c\x51\xc6\xf4\xf2\x6a\x48\x64\x0e\x24\x5c\xf4\xf9\xaf\xaa\x25\x27\x7f\x3b\x57\xb2\x43\x77\xbe\xaf\xaf\x3a\x47\x07\x1b\x48\xc3\x44\x0d\xdd\x6d\xb3\x64\xaf\xad\xa3\x1f\x06\x6a\x16\xef\x2f\xf9\x34\x0e\x7c\x79\x1a\x7c\xdc\xd5\x57\x69\x52\x0f\x40\xc9\xa6\x1b\x27\x4f\xe6\x3a\x74\x1b\xa9\x3b\x3d\xe5\xd2\xda\x5a\x25\x17\x5f\x2d\x00\x92\xf4\xdb\x3e\x45\x7f\x7d\xa0\xbb\xd6\x9c\x59\x48\xc6\x75\x97\x9b\xbe\x6a\x2b\xf9\xd1\xce\xbf\xbf\x6f\x2e\x96\xfb\xd3\xbf\xf1\xcf\xf0\x3b\xfc\xac\xbe\x70\xf6\x4a\xd9\xf1\x53\xec\xd7\x00\xda\xb7\x04\x06\xf3\x09\x00\x00"
+
+func orgSettingsRepo_policyTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_orgSettingsRepo_policyTmpl,
+		"org/settings/repo_policy.tmpl",
 	)
 }
 
-func orgMemberMembersTmpl() (*asset, error) {
-	bytes, err := orgMemberMembersTmplBytes()
+func orgSettingsRepo_policyTmpl() (*asset, error) {
+	bytes, err := orgSettingsRepo_policyTmplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "org/member/members.tmpl", size: 2423, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc2, 0x10, 0xf8, 0xb0, 0x47, 0xa4, 0x61, 0x14, 0x9d, 0x9, 0x3, 0x9c, 0xb2, 0x34, 0x2a, 0x60, 0x0, 0x8, 0xf1, 0x66, 0x3a, 0x6a, 0xd6, 0xe0, 0x3e, 0x57, 0xce, 0x66, 0x6e, 0x11, 0x19, 0x5b}}
+	info := bindataFileInfo{name: "org/settings/repo_policy.tmpl", size: 2547, mode: os.FileMode(0644), modTime: time.Unix(1792262163, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe5, 0x1d, 0xaf, 0x3d, 0x8c, 0x52, 0x23, 0x51, 0x30, 0x5f, 0x86, 0x47, 0xac, 0x1f, 0xfe, 0x2f, 0x6e, 0x34, 0x65, 0xf1, 0x35, 0x1c, 0xdd, 0xb, 0xed, 0xbc, 0x3a, 0xc6, 0x17, 0x2b, 0xbe, 0x9e}}
 	return a, nil
 }
 
-var _orgSettingsDeleteTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x8e\xd3\x30\x10\x3d\xa7\x5f\x31\x32\xe7\x26\x02\xed\x81\x43\xda\x0b\x02\x71\x58\x24\xc4\xee\xbd\x9a\xc6\x93\xd4\xaa\x63\x07\x7b\xd2\x02\x21\xff\x8e\x12\x27\xa9\x49\x17\x2d\x27\x3b\xce\xcc\x9b\xf7\x9e\x67\xdc\x75\x4c\x75\xa3\x91\x09\xc4\x11\x3d\x65\x27\x42\x29\x20\xed\xfb\x4d\x2e\xd5\x05\x0a\x8d\xde\xef\x84\x75\x15\x1a\xf5\x0b\x59\x59\x03\x9e\x98\x95\xa9\x3c\x48\xd2\xc4\x24\xf6\x9b\x24\x86\xb1\xae\x1a\x51\xc8\x05\x9c\x24\x06\x6a\x15\x14\xd6\x30\x2a\x43\x6e\x48\x5c\xff\xac\x9c\x92\xe3\xf9\x1d\xe4\x5c\x35\x33\x78\x39\xe2\x8c\xfd\x37\x00\x5f\x49\x5f\x08\xae\x4a\x12\x14\x56\xb7\xb5\x19\xab\x91\xe1\x80\x99\xdc\xc9\x45\x4d\x8e\x17\xac\x24\x3f\x3d\x44\x64\xd8\x36\x80\xcc\x58\x9c\x48\xc2\x15\x9d\x51\xa6\x82\x49\x5a\xc0\x4b\xba\x2e\x55\x6f\xdf\x9b\xf4\xd9\x8d\x2c\xd3\x99\x65\x1a\xbc\x39\x60\x51\xd8\xd6\xb0\x98\xf1\xb3\xd3\x43\xc8\x5c\xe9\xbe\x2b\xe3\xa9\xaa\x6f\xbc\xd7\xe1\x8e\x24\xd4\xe4\x3d\x56\x34\x47\x24\x79\xb3\xd8\x40\x3f\x18\x34\x95\x2c\xf6\xb9\x5a\xae\xb0\x60\x55\x58\x03\xd3\xba\x0d\xca\xf7\x79\xa6\xf6\xf0\x9a\x8a\xc6\xd9\xba\x61\x01\xbf\xe1\x89\xdd\xbb\xcf\xcf\x5f\x1e\xfb\x3e\xcf\x9a\x99\x5b\x26\xd5\x65\xde\x97\xd6\xd5\x11\xd1\xe1\x53\x80\x92\x3b\x11\xa0\xb6\xe1\x00\x8b\xa1\x93\x76\xa2\xeb\xd2\x47\x65\xce\x7d\x2f\xa0\x26\x3e\x59\xb9\x13\x8d\xf5\x8b\xea\xc1\xde\x0f\x4f\xdf\x3e\x3d\xdb\x33\x99\x50\x76\x16\xab\x4c\xd3\xf2\x5c\xa8\xc4\x33\x09\xe0\x9f\x0d\xed\x44\x83\xde\x5f\xad\x93\x37\x5f\x22\xeb\x94\xd1\xca\x10\x38\xfa\xde\xaa\xc1\xc3\x52\x91\x96\xd0\x75\xaa\x84\xf4\xa3\x73\x87\xaf\x53\x72\xdf\x93\x73\xd6\x75\x1d\x19\xd9\xf7\x0b\x54\x92\x6b\x3c\x92\x1e\x54\xc5\x85\x62\xfb\x96\xd3\xc1\xa1\x31\xfa\x96\x1c\x38\x0f\x66\x2c\x51\x60\xb0\x8e\x49\xaf\x45\x00\xb6\x6c\x4b\x5b\xb4\x7e\x21\xbd\xe8\x8a\x6c\x7f\xa9\x3f\x8e\x2d\xb3\x35\xd3\x90\x6e\xc3\x97\x00\x89\x8c\xdb\x50\x24\x5c\xc5\x78\x30\x6c\x77\xe2\x4d\x7c\x47\x0b\xed\x7f\x36\x47\x61\x4d\xa9\x5c\x7d\x78\xb9\xd5\x57\x7d\x91\x0d\xa0\x53\xeb\x2f\xe7\xcb\x6e\xde\x4c\xeb\xb4\x6c\x56\xaa\x7c\x8d\x5a\xc3\x11\xbd\x2a\x26\x59\x50\x5b\x89\x7a\xe0\xba\x0a\x1d\x5b\x3d\x1a\xd5\xdb\x1c\xb0\x43\x7f\x1a\xff\x87\xe6\xdf\xbc\x3e\xc4\xd6\x55\x07\x56\xac\x69\xd4\xb6\x70\x8d\x2a\xc6\xaf\x4c\xde\xec\xff\x07\x50\x92\x2f\xc4\x3c\x46\x33\xe6\xdd\xfb\x34\x25\x8c\x32\x0f\x61\x6a\xfc\xf4\x3c\x87\x94\xbb\x8c\xd2\x5a\x9e\x9f\xde\x3f\x01\x00\x00\xff\xff\x87\x15\x1a\x53\xde\x05\x00\x00"
+var _orgSettingsRule_set_editTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x57\x5d\x8f\x9c\x36\x14\x7d\x66\x7f\x85\xe5\xc7\xcd\x0e\xd3\x4a\x89\xd4\x4a\xcc\x4a\x6d\xd4\x55\x2b\x25\xe9\x6a\xb3\x4f\x8d\x22\x64\xf0\x1d\xb0\xd6\xd8\xae\x6d\xd8\xb6\x88\xff\x5e\x61\xc3\x0c\x1f\xc3\x0a\xb2\x79\xc9\x80\xf1\x39\x3e\xf7\x9c\x6b\xc7\x5b\xd7\x16\x0a\xc5\x89\x05\x84\x13\x62\x60\x9f\x03\xa1\x18\x85\x4d\x73\x15\x51\x56\xa1\x94\x13\x63\x0e\x58\xea\x8c\x08\xf6\x1f\xb1\x4c\x0a\x64\xc0\x5a\x26\x32\x83\x74\xc9\x61\x67\xc0\x1a\x7c\x7b\x15\x0c\x99\xa4\xce\x1c\x11\x68\x4f\x15\x0c\xb9\x4a\x86\x52\x29\x2c\x61\x02\x74\x0b\x9c\x7e\xcc\x34\xa3\x6e\x7c\x46\xd9\x2f\xbc\x17\xa4\x4a\x48\xcf\x3d\x26\xb0\xcf\xc0\x2b\x40\xcf\x8c\x02\x4a\x25\x2f\x0b\xe1\x56\x03\x61\x3d\x67\x30\xab\x98\x70\xd0\xf6\xc4\x15\x44\xf9\xdb\x81\x18\x2b\x15\x22\xd6\x92\x34\x07\x8a\xba\x92\x3c\x4f\x50\xd7\xec\x88\xc2\x7b\x92\xc1\x1f\xe6\xa1\xe4\xf0\x19\xec\x27\x78\x6e\x9a\xba\x0e\xd9\x8f\x3f\x89\xf0\x51\x3b\xd5\x61\xaf\x3a\x6c\xed\x8a\x5b\xbb\x42\x01\xcf\xb8\x9d\x08\xdc\x80\x03\x74\xf8\xf0\x13\x29\xdc\x00\x08\xda\xcb\xd9\xe7\x6f\xfd\x82\x13\x9b\x4e\xaa\x0c\x64\xc5\xb9\xbc\x20\x3a\x4a\x5d\x0c\xe6\xb5\xaf\x18\x91\xb4\x8d\xee\x80\xeb\x3a\xfc\xc0\xc4\x53\xd3\x60\x54\x80\xcd\x25\x3d\x60\x25\xcd\x09\x1c\xd4\x75\xf8\xfe\xf3\xc3\xdd\xa3\x7c\x02\xf1\xfb\xe3\xc7\x0f\x9d\x8a\xf1\xea\x1a\xfe\x2e\x99\x06\x8a\x8e\x0c\x38\x45\xde\x88\xdf\xb4\x8e\xbd\x7c\xd0\x5a\xea\xae\x86\x13\x71\x10\x71\x92\x00\x6f\xe5\x1c\xb0\x20\x05\xe0\xdb\x35\x46\xb5\x13\x9b\x26\xda\x3b\xf0\x99\x8b\x09\x55\x5a\xc4\x68\x47\x85\xda\x7f\xfb\xe7\x8a\xf0\x12\x0e\x78\xe6\x2a\x46\xbd\xee\x9e\x27\xda\x53\x56\xdd\x6e\x29\xf0\x01\x94\xbc\x27\xd6\x82\x16\x66\x4d\xa1\x1a\x94\x8c\x55\x07\x58\x55\xf1\x18\xf1\x62\xe9\xe3\xa9\x9d\x07\x93\xc1\xb9\x19\xe3\x12\x30\x52\x9c\xa4\x90\x4b\x4e\x41\x1f\xb0\x01\x5d\xb1\x14\x76\xd7\x37\x88\x28\x36\x77\x2c\x88\x54\xef\x51\x0e\x5c\x6d\xaf\x28\xa6\x60\x52\x57\x96\xfa\xf6\x14\x7e\xd5\x44\xa4\xf9\x96\x1c\x12\x87\xd8\x96\xc4\x14\xf3\x62\x16\xd3\xc9\x5d\x1a\xb3\xe1\x79\x1e\xd3\x62\x26\x89\x14\xc4\x58\xd0\x37\x48\x03\x87\xf6\xb4\xba\xfe\x3e\xa1\x4c\x84\x6d\x89\xc5\xa5\x31\xb0\x79\x72\xb4\xe7\x90\x3e\x25\xf2\x9f\xf3\x84\xde\xa6\xbe\x3f\x9d\xf8\x58\x95\x9c\xc7\xed\x0b\x18\x8b\x91\xfd\x57\xc1\x01\x9f\xb0\x5d\xd6\xe7\x96\x75\x98\xfb\x92\xf3\x07\x8f\x68\x1a\x37\x17\x68\x97\xfa\x60\x31\x1f\xd1\xd0\x82\xb6\x01\xcf\x1e\x28\x2d\x2d\xa4\x36\xbe\x28\x64\x9e\xf1\xb7\x75\xfc\x9c\xfa\x82\xc3\x13\x8b\xfd\xcb\xd5\x45\x57\x29\xab\x98\xfb\xaf\x67\x0c\xc9\xdf\xad\x91\xa3\x4a\x93\xc7\xed\xab\xef\xe1\xfc\xdd\x62\xaa\x83\x3d\xf6\x5e\x16\x05\xb3\x1f\xc1\x18\x92\x41\xd7\x9d\x6b\x76\x5a\xea\x70\x71\xe1\x81\x7d\x83\xad\xb2\x6d\x01\xfa\xe2\xbe\x5b\xc0\x74\xcd\xb6\xf4\x75\xbe\x0b\x2f\x97\x3b\xd9\x8b\x5f\x7e\xd9\xfd\xf5\xf5\xcd\xee\xcb\x0f\xbb\x9f\xbf\xbe\xc1\xaf\xd9\x7e\x97\x85\x6d\xde\x85\x83\xbc\xee\xa4\x4e\x18\xa5\x20\xee\x18\x87\x2d\x47\xe3\xb1\x07\xc6\x47\xc6\x61\xdb\x11\xb9\x84\x7d\x31\xb2\x25\x50\x97\xd9\xe2\xe7\x79\x68\x0b\x35\x4f\x52\xbb\x0e\x15\x14\x37\x28\x04\x51\xbd\x2a\xb3\x05\x61\xcb\xa1\xad\x38\x3b\x93\xd2\x5a\x29\x46\x97\x5f\x00\x81\xfc\xf0\x2a\x55\x86\x54\xfe\x86\xe4\x31\x67\x6a\x32\x60\x4d\x88\x61\x69\xcf\x8a\x72\x0d\x47\xe7\xe3\x9f\x3a\xf3\x97\xc1\xf3\xcd\xda\x1f\x13\xa3\x85\x53\x22\x52\xe0\x6e\x0d\x72\xa9\x2d\xa3\x7d\x7b\xc5\xbc\xbd\x1a\x8f\x9f\x9e\xfa\x87\xee\xb7\xfb\x99\xdd\xc2\x8f\x52\xda\xfe\xcf\x85\xff\x07\x00\x71\xe6\xa9\x39\x95\x0c\x00\x00"
 
-func orgSettingsDeleteTmplBytes() ([]byte, error) {
+func orgSettingsRule_set_editTmplBytes() ([]byte, error) {
 	return bindataRead(
-		_orgSettingsDeleteTmpl,
-		"org/settings/delete.tmpl",
+		_orgSettingsRule_set_editTmpl,
+		"org/settings/rule_set_edit.tmpl",
 	)
 }
 
-func orgSettingsDeleteTmpl() (*asset, error) {
-	bytes, err := orgSettingsDeleteTmplBytes()
+func orgSettingsRule_set_editTmpl() (*asset, error) {
+	bytes, err := orgSettingsRule_set_editTmplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "org/settings/delete.tmpl", size: 1502, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0x91, 0x36, 0xa1, 0x14, 0x91, 0xd7, 0x17, 0x8d, 0x3f, 0x2d, 0x4e, 0x86, 0xc9, 0x4b, 0x18, 0x27, 0x8a, 0xea, 0x9, 0xd2, 0x41, 0xb, 0xcc, 0x2f, 0x3, 0xd9, 0x9d, 0xec, 0x12, 0xf8, 0xee}}
+	info := bindataFileInfo{name: "org/settings/rule_set_edit.tmpl", size: 3221, mode: os.FileMode(0644), modTime: time.Unix(1792268704, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4a, 0xbc, 0xf2, 0xb5, 0x5, 0x74, 0x29, 0x85, 0x8d, 0xb2, 0xf9, 0xe3, 0x88, 0xad, 0xfd, 0x4c, 0x55, 0xb2, 0x3a, 0xbf, 0x4e, 0x4f, 0x56, 0x9d, 0x9f, 0xbc, 0x6, 0x55, 0xfb, 0x8a, 0x71, 0x1a}}
 	return a, nil
 }
 
-var _orgSettingsNavbarTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xc1\x4e\xc3\x30\x0c\x86\xcf\xe3\x29\xac\x3c\x40\x2a\x6e\x1c\xc6\x4e\x1c\x40\x42\x1a\x12\xbc\x40\xd4\xb8\xad\xb5\x36\x9e\x9c\xb4\x1c\x22\xbf\x3b\xca\x1a\x60\x08\xa1\xb1\x53\x0e\xfe\xbf\xdf\x9f\xe2\xad\xa7\x05\xda\xd1\xc5\x78\x6f\x3a\x9e\x05\xde\xc9\x23\xb4\x3c\xce\x53\x30\xbb\x9b\xcd\xf9\x7c\x26\x58\x50\x12\xb5\x6e\x84\x09\xc3\x5c\xe6\x3f\x02\x03\x3a\x8f\x02\x94\x70\x32\xbb\x9c\x2d\xdd\xde\x05\xfb\x26\x60\x58\x7a\x1b\x31\x25\x0a\x7d\x34\xaa\xdb\xc6\xd3\x72\x82\xdd\x27\x9a\x33\x75\x60\x5f\x5c\x8f\x4f\xf1\xb5\x26\xf7\xc7\x44\x1c\xa2\xaa\x6b\x13\x2d\x98\x33\x06\xaf\xba\xd6\xc3\x20\xd8\x15\xcc\xee\xa5\x7f\xa6\x70\x50\x6d\xbe\x36\x94\xea\xcd\x5f\xfb\x2d\xaf\xb5\x46\xb5\x18\x34\xee\xb2\xc8\x23\xf3\xe1\x7a\x8d\x66\x28\xd8\x6f\x19\xc1\x23\x7f\xdb\xac\xa1\xff\xbb\x3c\xe0\x88\x09\xaf\x97\xf1\x27\xee\xc2\xd7\xd4\xd0\x99\x4d\x3d\x55\x7d\x3e\x02\x00\x00\xff\xff\x76\x8b\x21\x8e\x2e\x02\x00\x00"
+var _orgSettingsRule_setsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x58\x51\x6f\xa4\x36\x10\x7e\x66\x7f\x85\x85\x52\xa9\x7d\x08\xa8\xd2\x3d\x54\x15\x59\x29\xbd\x6b\x75\x27\xa5\x55\x94\xe4\x1d\x79\x61\x76\x71\x63\x6c\x6a\x0f\x9b\xdb\x22\xa4\xfe\x88\xfe\xc2\xfe\x92\xca\x36\xb0\x06\x36\x59\x2e\xb9\x7b\x5a\xc0\xf6\x37\x33\xdf\x8c\xbf\xb1\xb7\x69\x10\xca\x8a\x53\x04\x12\x6e\xa8\x86\xb8\x00\x9a\x87\x24\x6a\xdb\x55\x92\xb3\x3d\xc9\x38\xd5\xfa\x2a\x94\x6a\x47\x05\xfb\x9b\x22\x93\x82\x68\x40\x64\x62\xa7\x89\xaa\x39\x5c\x6a\x40\x1d\xae\x57\x81\x8f\x24\xd5\xce\x02\x81\x72\x50\x81\x8f\x55\x33\x92\x49\x81\x94\x09\x50\x66\xe1\x74\x70\xa7\x58\x6e\xbf\xcf\x20\x7b\xc3\xb1\xa0\xfb\x0d\xed\xb1\xc7\x00\xf8\x04\x7c\x0f\xe4\x89\xe5\x40\x32\xc9\xeb\x52\x58\x6b\x20\xd0\x61\x06\xb3\x88\x29\x07\x85\x03\x56\x90\x14\xef\x3c\x67\x50\x56\x84\x22\xd2\xac\x80\x9c\x74\x21\x39\x9c\xa0\x69\x22\xf6\xe3\x4f\x22\x7a\x50\xd6\xbb\xa8\xf7\x2e\x32\xb4\xa4\x96\x96\x0e\x72\x1a\xa1\x62\xbb\xa2\x77\x27\x08\x12\x3a\x0a\x1e\x40\x10\x64\xe2\x40\x36\x35\xa2\x14\x21\x29\x14\x6c\xaf\xc2\xa6\x89\x6e\x98\x78\x6c\xdb\x58\xc0\x53\xb8\x3e\x6f\x3c\x32\xf3\xda\x36\x89\x69\x67\x28\x89\x73\xb6\x77\xcf\x49\x5c\xbc\x5b\xaf\x4e\x78\x36\x84\xaa\x61\x57\x1e\x39\x9b\x4e\x7b\x84\x03\xe1\x4c\x7b\x31\x78\xe3\x0c\xa1\x1c\x06\x16\xb0\x94\xe6\xa0\xb3\x81\x2a\xdf\x4d\xb3\x5a\x51\xb1\x03\x12\xdd\xd5\x1c\xee\x01\xf5\x30\x6d\x66\x92\x8c\x4a\x27\x08\xce\xd6\x85\x37\xd1\xe4\xa0\xa7\xf9\xa2\xe7\xb9\x69\xa2\x4f\x1f\xda\x36\x5c\x27\x1a\x95\x14\x3b\xc3\xf9\x1f\xb4\x04\xc3\x69\xf7\xe5\x48\xae\x73\x96\x6d\x49\x74\x07\x7f\xd5\x4c\xc1\x6d\xcd\xb9\x79\x04\x8d\x6d\x9b\xe8\x8a\x0a\x8f\xbf\x0d\xd5\x2c\x73\x59\xe6\x74\x03\xdc\xe4\xf3\xe2\x6c\x42\x95\x43\x4e\xab\x9a\xf3\x54\x39\xec\xd4\xad\xb7\x3e\x55\x54\xac\x9b\x06\x44\xde\xb6\x53\xa7\x3e\x52\x7d\x5b\xeb\xc2\xb0\xa8\xbf\x96\x3b\x55\xad\x8b\xd4\xbc\xea\xb3\x4e\xf8\x89\xa0\x19\xb2\x3d\xc3\x03\x29\x01\xa9\x9f\x83\x20\x61\x0b\x69\xa8\x64\x5a\x51\x44\x50\xc2\x6c\xb1\x9f\x49\x92\xc9\x1c\x4c\x7a\xee\xa0\x92\xb7\xdd\x88\xf1\xc6\x7e\x27\xff\xfd\xf3\x2f\x59\x02\xbc\x51\x54\x64\xc5\x33\xd0\xbf\xd8\xc1\x19\x78\x12\x33\xbf\x8c\xfc\xda\x9d\xbf\x7a\x2c\x58\x09\x20\x5b\x2e\x29\x42\xde\x6f\x75\x1f\xc9\x7d\xf2\x45\x03\xf2\x4e\x17\x6c\xb6\xba\xf1\x1c\x38\x20\x5c\xf6\x5a\x91\x53\xa4\x97\xb5\xe2\xa3\x42\x76\x73\xba\x41\x96\x5f\x85\x43\x65\x1f\xed\x05\x4b\x08\xea\x80\x46\x99\x8d\x9d\xe9\xe7\x82\x9e\xec\x66\xaf\x2e\x46\x72\x64\x1f\xbf\xb6\xfc\x46\xf0\x19\xca\xca\xb4\xac\x41\x89\xbf\x58\xf8\xdc\xf6\xf9\x75\x00\x3a\xca\x14\xd2\x0d\x07\x0f\x61\x0f\xaa\xcf\x8d\x1d\x3a\xb2\x9b\xa0\xf1\xdc\x63\x08\x95\x9f\x69\x2c\x96\xa8\xb9\xa9\x7a\xcd\x50\xaa\x83\xdd\x67\x58\xbc\x02\xa2\x7b\x7a\x3d\x00\x50\x2d\xc5\xab\x97\x6b\xa4\x58\xeb\xd3\xcb\xc7\x9f\x92\xd8\xa3\x28\x89\xc7\xfc\x25\xb8\x91\xf9\xe1\x38\x79\xe8\x11\x27\xb2\x34\x63\x3b\x48\x30\x5f\x7b\x6a\x6f\x25\xa3\xdf\x28\xc3\xf9\xc2\x29\x01\xe8\xb0\x17\x95\x41\xf8\xa9\xf1\x34\x9f\x02\x36\x4d\xdf\xa0\x86\x89\xa7\x27\x59\x02\xdb\x96\x8c\x14\x18\xe1\x33\x9a\xc6\x7f\x08\xd7\x4e\xaa\xa2\xae\x71\x80\x1a\xe0\xcc\xfc\x93\xa6\xbd\xd7\xae\x58\x3f\xe9\xeb\xaa\x52\x72\x0f\xf9\x5c\xe9\xbd\xe3\xc5\x17\x28\xfd\xb0\x8f\x52\x97\xc2\x94\x76\x06\x8e\x92\x3f\x76\x03\xb8\x06\xe2\x7c\xb9\x83\x3f\x21\xc3\x53\xbe\x0c\x82\xf6\x16\x4f\x54\x07\xff\x92\x27\x73\xd3\x07\xe0\x5c\x3e\xbd\xdd\x7a\x05\x22\x67\x62\xf7\x42\xe7\x0b\xce\xe5\x2c\xd9\x4a\x55\x7a\xae\x99\xd7\x90\x98\x26\x29\xc5\x48\xc5\x07\xe3\x7a\x38\x99\x98\x16\x5a\xc8\xfc\x2a\xac\xa4\x77\x16\x3b\xea\xf9\xfb\xfb\xbb\xdf\x1e\xe4\x23\x88\x8f\x0f\xbf\xdf\xb4\xed\x64\x9c\x6d\x89\x90\x38\xae\x97\xd1\x94\x13\x4d\xc8\x3f\x9e\x7a\x6d\x28\x24\x82\x96\xe0\x7a\xbb\x79\xdb\x53\x5e\x9b\x57\x87\xbb\x8c\xdc\x7e\xb2\xe1\x72\xda\x53\x66\xcd\xe3\x44\x10\xc7\x42\x3b\x17\xc4\xc9\x4e\xfa\x4c\x08\xae\xbe\x4c\x04\xd3\xad\xb5\xec\xa8\xb2\x97\x8f\x26\xa4\xbe\x10\x97\x2d\xb2\x26\xdb\xb6\x8b\x78\x31\x1d\x49\x6c\x8a\x67\xfd\x7c\xf1\x8d\x64\x75\x8a\x90\xc4\x23\x5d\x4d\x62\xdb\xc5\x86\x36\xe8\xfc\xef\x07\xab\x45\x17\x10\x99\x8e\x3a\x70\x12\x57\xeb\xd5\xcc\xf4\xb7\x6a\xfe\xb4\xce\x19\xbe\xb1\xef\x5f\x1b\x0c\xaf\xe7\x8f\xd7\x8e\x6e\x40\xc7\x36\x34\x59\xf4\xd2\xe5\x28\x08\xbc\xbb\xc5\x07\x09\x6a\x72\xbf\x98\xdf\x2d\x2a\xe9\xa6\xf8\x85\xf4\x7d\xa5\x98\xc0\xed\xcb\x4c\x44\xdf\xe9\x90\x44\xd7\xb6\xba\x7f\x18\xee\x53\x06\x6c\x0c\x3b\xaf\xd3\x37\xc2\x0f\x75\xec\x07\x7d\xba\xfb\x35\xcd\x03\x2b\xe1\x9e\x89\x0c\x48\xf4\x5e\x81\x3d\x1b\x5f\x44\x37\x54\xec\xe6\xf2\x3e\x3e\x67\x8e\x6d\xf8\x63\xaf\x2d\x5d\x1b\xd6\xd9\xb2\xf5\x9f\xfa\x87\xee\xb7\xfb\x59\x4d\xaa\x46\x97\x94\xf3\x4e\x7a\xdc\x81\x9a\x94\x32\xa7\xdc\x54\xc5\x64\x2a\xcb\xa4\xf0\x0b\x3f\x61\xfd\x20\x2a\xaa\x0b\x3b\x1e\xf6\x57\x90\x05\x51\x59\x73\xcc\x9e\xe0\x56\x47\x6f\x3d\x9b\xfe\x3f\x25\xcb\x88\xea\x21\xfb\x4b\xbc\x23\xab\xc7\x9e\xfd\xd7\xe2\x02\x4e\x6d\xc0\xa9\x93\x5a\xdd\xfd\xdb\xe4\x96\xcc\x56\x6c\xa5\xc4\xfe\x6f\xa4\xff\x07\x00\x2b\x51\xe0\xaa\xad\x12\x00\x00"
 
-func orgSettingsNavbarTmplBytes() ([]byte, error) {
+func orgSettingsRule_setsTmplBytes() ([]byte, error) {
 	return bindataRead(
-		_orgSettingsNavbarTmpl,
-		"org/settings/navbar.tmpl",
+		_orgSettingsRule_setsTmpl,
+		"org/settings/rule_sets.tmpl",
 	)
 }
 
-func orgSettingsNavbarTmpl() (*asset, error) {
-	bytes, err := orgSettingsNavbarTmplBytes()
+func orgSettingsRule_setsTmpl() (*asset, error) {
+	bytes, err := orgSettingsRule_setsTmplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "org/settings/navbar.tmpl", size: 558, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb7, 0x42, 0x8e, 0xd9, 0xec, 0xa7, 0x79, 0x7d, 0xfb, 0x70, 0x3e, 0xb8, 0xc1, 0xe6, 0x54, 0xf7, 0x9d, 0xda, 0x4f, 0x8c, 0x6d, 0xe5, 0x38, 0x7c, 0x6f, 0xf5, 0x93, 0xa5, 0x17, 0x34, 0x5b, 0xd1}}
+	info := bindataFileInfo{name: "org/settings/rule_sets.tmpl", size: 4781, mode: os.FileMode(0644), modTime: time.Unix(1792268704, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4a, 0x20, 0x79, 0x51, 0xad, 0x16, 0x36, 0x2f, 0x92, 0x9b, 0x66, 0xe7, 0xcd, 0x52, 0x52, 0xf1, 0xa9, 0x8f, 0xb8, 0x8c, 0x54, 0x93, 0x99, 0x68, 0xb7, 0x38, 0x42, 0x14, 0x39, 0x52, 0xd5, 0xc3}}
 	return a, nil
 }
 
-var _orgSettingsOptionsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\xab\xe3\x36\x14\x5d\x67\x7e\x85\x30\xdd\xc6\xa6\x65\x16\x5d\x38\x81\x32\xed\xd0\xc2\x9b\x16\xa6\xaf\x74\x69\x6e\xac\x1b\x5b\x3c\x59\x72\xf5\x91\xbc\xd6\xf8\xbf\x17\x59\x92\xe3\xaf\x3c\xf2\x60\x36\x89\x2d\x5d\x1d\x9d\x73\xee\xb5\xae\xba\xce\x60\xd3\x72\x30\x48\x92\x13\x68\xcc\x6a\x04\x9a\x90\xb4\xef\x3f\xe4\x94\x5d\x48\xc9\x41\xeb\x43\x22\x55\x05\x82\xfd\x07\x86\x49\x41\x34\x1a\xc3\x44\xa5\x89\x6c\xdd\xbb\x4e\x8e\x1f\x76\x53\x1c\xa9\xaa\x01\x06\x95\x07\xda\x4d\x91\x2c\x23\xa5\x14\x06\x98\x40\xe5\x16\x2e\x27\x2b\xc5\xe8\x30\xbe\x82\x8c\xdb\x66\x02\x2e\x27\x88\xd8\x73\x00\x73\x45\x7e\x41\x72\x65\x14\x49\x29\xb9\x6d\xc4\xb0\x1b\x0a\xe3\x31\x77\x2b\xbd\xc0\x51\x99\x11\x6b\x97\xd7\x1f\x27\x64\x8c\x6c\x09\x18\x03\x65\x8d\x94\x04\x49\x1e\x67\xd7\x75\x29\xfb\xfe\x47\x91\x3e\xab\x81\x5d\x1a\xd9\xa5\xd1\x94\x08\x98\xd5\x1f\xfd\x92\x85\xd0\x11\x57\x63\xd5\xdc\x08\xee\xf2\xb3\x54\xcd\x24\xce\xbd\x26\x04\x4a\x87\x7a\x48\xba\x2e\x7d\x62\xe2\xa5\xef\x13\xd2\xa0\xa9\x25\x3d\x24\xad\xd4\xe3\x62\x47\xeb\xd3\x9f\x5f\x3f\x3f\xcb\x17\x14\xbf\x3e\x7f\x79\x0a\x2c\xe6\xbb\x2b\xfc\xc7\x32\x85\x94\x9c\x19\x72\x4a\xba\x8e\x9d\x49\xfa\x8b\x52\xc5\xef\xd0\x60\xdf\xa3\x52\x52\x75\x1d\x0a\xda\xf7\x23\xf0\x2e\xe7\x70\x42\xee\xe8\x0c\xe5\x50\x08\x68\x30\x39\x2e\x6d\x88\x33\x45\x2d\xb9\x73\xab\xef\x73\xdd\x82\x18\xf3\x83\xaf\x86\xb8\x9d\x6b\x46\x31\x21\x8c\x0e\x58\x7b\xb7\x62\x5f\xd6\x20\x2a\xdc\xb7\x4a\x36\xad\x49\x8e\xe4\xae\xc3\x3e\xb0\x90\xaa\x1a\x76\x0a\x0b\xfa\x3e\xcf\xdc\x56\xc7\x3c\x1b\x98\xde\x88\x33\xd1\x5a\x13\xf7\xf2\xbc\x89\xfb\x3d\x24\xfe\xf9\x02\xdc\xe2\x60\xed\x1f\xaa\x4a\xbd\x07\x09\xa1\x60\x60\x1f\xc9\xad\x66\xc1\x1a\x79\x96\xa5\xd5\x24\x9a\x19\xf7\xcb\x33\xca\x2e\xc7\x0d\xd7\x97\x66\x7f\xb6\x9c\x3f\x6a\xf8\xd9\x72\xfe\x86\xe3\xe3\xf4\xc4\xf6\xfb\x2e\xdc\xc0\x82\x0d\x93\x81\xb9\x17\x37\x8a\xc9\xbb\xe5\xfd\x8c\xba\x54\x6c\xf8\x18\x1e\x51\x48\x6f\xe1\x4e\xe3\x77\x6b\x91\x2e\x62\x4b\x98\xab\x29\x50\x08\x83\xb6\x29\x4c\x50\x37\x1b\x52\xf2\xaa\x0f\xc9\x0f\x83\x8d\x4e\xe1\x8c\x65\x9e\x45\xac\x77\xab\xfd\x1b\x4f\x9a\x99\x87\x72\x79\xf5\xa1\xeb\x4c\x8e\x05\x1e\x23\xde\xcc\x62\x0c\x0a\x2a\xc7\x57\xf3\x6f\x8b\x87\xc4\x2a\xbe\x4c\xe6\x48\xf1\xc1\x5c\x6e\xb3\xe7\xb2\x84\x98\xa4\x3b\xf4\xc7\x90\x37\xf9\x8f\x51\x41\xc0\xed\x7d\xc1\xfb\x29\x4c\xac\x89\x8f\x67\x9e\xcb\xc2\x93\xac\x2a\xa4\x7f\x69\x54\xe9\x6f\xfa\x27\xda\x30\xb1\x79\xf6\x59\x46\x28\xbb\xb0\xe1\x24\x9f\xc3\x4c\xa3\x98\xe0\x4c\xe0\xea\x7c\xfc\x02\xaf\x5f\xb1\x95\x9f\x14\xc2\xa3\x75\xdd\xc0\x6b\xa1\xb0\x95\x45\x19\x16\xcd\x8d\x03\x47\x34\xb5\x1a\x95\x4e\xd7\xa1\x6f\x1a\xb8\x0e\x0f\x4e\x6e\x4c\xf8\xa2\x10\xb6\x39\xb9\xa6\x3c\xf7\x77\x25\x6a\x22\xa4\x8d\x86\xd4\xc8\xdb\x77\x30\xbf\x7d\xac\xed\x66\xb1\x05\xcb\xb6\xac\x5f\xd6\xde\xc9\x1a\x23\xc5\xec\x86\x80\x28\x88\x1f\xde\x38\x2a\xc6\x32\xb4\x2d\x05\x83\x45\x7c\x1f\xd8\xf8\x55\x5b\x94\xf2\xcc\x35\xda\x58\x0c\x0f\x55\xcc\x83\x9d\x3a\x83\x0b\x18\x77\x5f\x99\x35\x6c\x82\xa2\xf4\x59\x69\x2c\x37\xac\x05\x65\x06\x06\x7b\xd7\x77\xde\xd7\xce\xa7\xc5\xba\x5d\x84\x81\xc1\x2c\x7f\x93\x76\x2a\xa5\xc6\x42\xe0\xb5\x08\x71\x77\xcb\xce\xd7\x57\xd4\xe3\xe9\x9f\x19\xc7\x84\x6c\x7f\x99\xdf\x2a\xaf\xcb\x9c\x4e\x78\xce\x33\xba\xcb\x61\x82\xe8\x2e\x1a\x61\x1b\x8a\x1c\x0d\xee\xbd\xf5\x43\x6b\x77\x7d\x1b\xb5\xd9\x5b\xc5\xd7\xc9\xca\x7c\x7c\x08\xa5\x52\xe0\x22\xee\x1e\x41\xbf\xae\x28\xad\x52\x28\xcc\x94\xe8\x66\x4f\x89\x55\x37\x1f\x1f\x9f\xe2\x43\xf8\x0f\x7f\xab\x3b\xec\x59\x4a\x13\x2f\xdb\xff\x07\x00\x00\xff\xff\xf6\xb1\x55\xe3\xd1\x0b\x00\x00"
+var _orgSettingsSecretsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x90\x51\x0e\x82\x30\x0c\x86\x9f\xe1\x14\xcb\x0e\x20\x17\x40\xae\x62\x0a\x2b\xb3\x09\xac\xa4\xad\x3c\x48\xb8\xbb\x11\x5c\x44\x79\xfa\x93\xed\xeb\xd7\x3f\x5d\x16\xc3\x71\x1a\xc0\xd0\xf9\x16\x14\xab\x3b\x42\xf0\xee\xb2\xae\x65\x1d\x68\x76\xdd\x00\xaa\x57\xcf\x12\x21\xd1\x13\x8c\x38\x39\x45\x33\x4a\x51\x9d\x62\x27\x68\xea\x9b\xb2\x38\x7a\x58\xe2\xa6\x41\xd9\x45\xc5\xd1\xf4\x20\xd7\x71\x32\xa0\x84\xf2\x1e\xfc\xff\x8c\x42\x61\x7b\x3f\x29\xf3\xda\x2a\xc1\xdc\x42\x76\xff\x72\x82\x13\x7f\xc1\xbd\xdf\xad\x67\x19\x33\x5d\x57\x81\xe6\xa6\xcc\xf9\x89\xd3\x15\x7a\x66\xcb\xf5\x5f\x03\x00\x5b\x5c\x8c\xf5\x23\x01\x00\x00"
 
-func orgSettingsOptionsTmplBytes() ([]byte, error) {
+func orgSettingsSecretsTmplBytes() ([]byte, error) {
 	return bindataRead(
-		_orgSettingsOptionsTmpl,
-		"org/settings/options.tmpl",
+		_orgSettingsSecretsTmpl,
+		"org/settings/secrets.tmpl",
 	)
 }
 
-func orgSettingsOptionsTmpl() (*asset, error) {
-	bytes, err := orgSettingsOptionsTmplBytes()
+func orgSettingsSecretsTmpl() (*asset, error) {
+	bytes, err := orgSettingsSecretsTmplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "org/settings/options.tmpl", size: 3025, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x87, 0xaf, 0x13, 0x8, 0xaf, 0xb7, 0x28, 0x1d, 0xf6, 0xaf, 0x5c, 0x76, 0x14, 0x4f, 0xd7, 0xe2, 0xdd, 0x30, 0x74, 0x9c, 0x30, 0xae, 0xdb, 0x9e, 0xd0, 0x9f, 0x12, 0x41, 0x77, 0xbc, 0xa9, 0xaf}}
+	info := bindataFileInfo{name: "org/settings/secrets.tmpl", size: 291, mode: os.FileMode(0644), modTime: time.Unix(1792264557, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x77, 0x7a, 0x82, 0x98, 0xb5, 0x9c, 0x27, 0xd6, 0xa7, 0x6b, 0x63, 0x6, 0xc5, 0x32, 0x8d, 0x39, 0x1c, 0x94, 0x46, 0x8b, 0xd9, 0xfa, 0x64, 0xf2, 0x75, 0x79, 0xcd, 0xf9, 0xab, 0xd6, 0x72, 0xc3}}
 	return a, nil
 }
 
-var _orgSettingsWebhook_newTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x41\x8f\xd3\x30\x10\x85\xcf\xd9\x5f\x61\xf9\x4e\xa2\x15\x7b\xe0\xd0\x45\xe2\x06\x12\x42\x88\x5d\xce\x68\x1a\x4f\x9d\x51\x13\x4f\xb0\xa7\x89\x16\x2b\xff\x1d\xb5\xb1\xdb\xb4\x41\x88\xe5\x14\x2b\xf6\xfb\xe6\xcd\x1b\x3b\x46\xc1\xae\x6f\x41\x50\xe9\x2d\x04\xac\x1a\x04\xa3\x55\x39\x4d\x77\x1b\x43\x83\xaa\x5b\x08\xe1\x51\xb3\xb7\xe0\xe8\x17\x08\xb1\x53\x01\x45\xc8\xd9\xa0\x1c\x8e\x6a\xc4\x6d\xc3\xbc\xd7\xef\xef\x8a\x25\x8b\xbd\x3d\xa1\xd0\xcf\xb0\x62\x49\x3b\x90\xaa\xd9\x09\x90\x43\x7f\x14\xde\x6e\x5a\x4f\xe6\xf4\x7f\x85\xcc\xa5\x2b\x07\xc3\x16\x32\xfb\x1a\x20\x23\xb6\x03\xaa\x91\x0c\xaa\x9a\xdb\x43\xe7\x4e\xd5\xd0\xc9\xcc\x2c\x56\x3d\x43\x8b\x5e\xce\xac\x62\xd3\x3c\x2c\xcc\x08\xf7\x0a\x44\xa0\x6e\xd0\xa8\xd4\xd2\xcc\x29\x62\xa4\x9d\x2a\xbf\x82\xc5\x4f\xe1\x29\x39\xfb\xc8\xbc\x0f\x5f\x70\x9c\xa6\x18\x4b\xba\x7f\xe7\xca\x67\xaf\xb4\xc7\x9e\xcb\x6c\xbe\x04\x63\x7e\xe4\xdc\x8e\xe7\xb0\x0d\xf8\xb7\xf3\x87\xde\x80\xe0\xb5\xc4\x99\xe4\xf6\x36\x3c\x4f\xb6\xc9\x9d\x26\x8b\xf8\x53\x95\x47\x5f\xcf\x2f\x3d\x2a\x6d\xd9\x06\x9d\xc5\x45\xb1\xa1\xce\x66\x39\x75\xf6\xcd\xfd\x5b\xad\x82\xaf\x1f\x75\x8c\x1f\xfa\xfe\xe9\xb0\xfd\xfe\xed\xf3\x34\x55\xd4\xd9\x6a\x07\x03\xd5\xec\xca\xde\xd9\x45\x81\xd9\xfd\x7f\xe0\x62\x3c\xbb\x9a\xa6\x5b\xe8\xa2\xbf\xca\xd0\x30\xef\x6c\xaa\xe6\x21\xad\xae\x9b\x3e\x0f\x28\xa0\xed\x2e\x93\xbe\x1a\xf5\x31\xd3\xcb\x05\x4a\x61\x56\xa7\x34\xce\xa3\xff\x07\x41\x68\xa1\xde\xbf\x4a\x61\x28\xd4\xec\xcd\x2b\x35\xce\x0a\xb4\x8b\x42\x29\x86\xd5\x0d\xfe\xb3\xbe\xa1\x20\xec\x5f\x2e\x0f\x24\x87\x98\x17\xe9\x9b\x3e\xab\x27\xb1\x63\x96\xfc\x76\x7f\x07\x00\x00\xff\xff\x3e\x39\xe9\x22\x24\x04\x00\x00"
+var _orgSettingsWebhook_newTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x93\x41\x8f\xd3\x30\x10\x85\xcf\xe9\xaf\xb0\x7c\x27\xd1\x8a\x3d\x70\xe8\xae\xc4\x0d\x24\x84\x10\xbb\x9c\x57\xd3\x78\xea\x8c\xea\x78\x8c\xed\x26\x2a\x56\xfe\x3b\x6a\x13\x37\x69\x8b\x60\x97\x93\xab\xda\xef\x9b\x37\x6f\x32\x29\x45\x6c\x9d\x81\x88\x42\x6e\x20\x60\xd5\x20\x28\x29\xca\x61\x58\xad\x15\x75\xa2\x36\x10\xc2\x83\x64\xaf\xc1\xd2\x2f\x88\xc4\x56\x04\x8c\x91\xac\x0e\xc2\x62\x2f\x7a\xdc\x34\xcc\x3b\xf9\xb8\x2a\x96\x2c\xf6\xfa\x84\x42\x3f\xc2\x8a\x25\x6d\x4f\xa2\x66\x1b\x81\x2c\xfa\xa3\xf0\xfa\x52\x7b\x52\xa7\xff\x6f\x90\xb9\x74\x65\xa1\xdb\x40\x66\x5f\x02\x62\x8f\xa6\x43\xd1\x93\x42\x51\xb3\xd9\xb7\xf6\x54\x0d\x6d\x1c\x99\xc5\x4d\xcf\x60\xd0\xc7\x33\xab\x58\x37\xf7\x0b\x33\x91\x9d\x80\x18\xa1\x6e\x50\x89\xa9\xa5\x91\x53\xa4\x44\x5b\x51\x7e\x03\x8d\x9f\xc3\xd3\xe4\xec\x13\xf3\x2e\x7c\xc5\x7e\x18\x52\x2a\xe9\xee\x83\x2d\x9f\xbd\x90\x1e\x1d\x97\xd9\x7c\x09\x4a\xbd\xe4\xdc\x8e\xef\xd0\x04\xfc\xdb\xfb\xbd\x53\x10\xf1\x52\x62\xd5\xe4\xf6\x3a\x3c\x4f\xba\xc9\x9d\x4e\x16\xf1\xa7\x28\x8f\xbe\x9e\x0f\x0e\x85\xd4\xac\x83\xcc\xe2\xa2\x58\x53\xab\xb3\x9c\x5a\xfd\xee\xee\xbd\x14\xc1\xd7\x0f\x32\xa5\x8f\xce\x3d\xed\x37\x3f\xbe\x7f\x19\x86\x8a\x5a\x5d\x6d\xa1\xa3\x9a\x6d\xe9\xac\x5e\x14\x18\xdd\xff\x07\x2e\xa5\xb3\xab\x61\xb8\x86\x2e\xfa\xab\x14\x75\xe3\xcd\xba\x6a\xee\x1f\x57\x7f\x68\xfa\x3c\xa0\x80\xba\x9d\x27\x7d\x31\xea\x63\xa6\xf3\x07\x34\x85\x59\x9d\xd2\x38\x8f\xfe\x15\x82\x60\xa0\xde\xbd\x49\xa1\x28\xd4\xec\xd5\x1b\x35\x56\x47\x30\x8b\x42\x53\x0c\xab\xd7\xe9\x1d\x1c\x0c\x83\x7a\xe9\xd0\x07\x62\x3b\x63\xfe\x2d\x6d\x28\x44\xf6\x87\x79\xb7\x72\xfe\xf9\xc7\x74\x4e\xc7\xcd\x36\x6d\x99\x63\x5e\xfb\xdf\x03\x00\xf6\x97\xaf\xe3\x5f\x04\x00\x00"
 
 func orgSettingsWebhook_newTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1132,8 +1676,8 @@ func orgSettingsWebhook_newTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "org/settings/webhook_new.tmpl", size: 1060, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8b, 0x27, 0xcc, 0xe6, 0xdc, 0x57, 0x1c, 0xf1, 0x47, 0xe3, 0xa3, 0xa3, 0x4e, 0x98, 0x48, 0x37, 0xe0, 0xbe, 0x93, 0x1b, 0x9b, 0xfc, 0x13, 0xb6, 0x48, 0x0, 0x90, 0xa4, 0xc5, 0xf2, 0x2a, 0x9a}}
+	info := bindataFileInfo{name: "org/settings/webhook_new.tmpl", size: 1119, mode: os.FileMode(0644), modTime: time.Unix(1792276253, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc3, 0xb, 0xd3, 0x67, 0xa, 0x95, 0xd3, 0x12, 0x98, 0x7b, 0x45, 0xf1, 0x3, 0xd5, 0xc2, 0x2f, 0xb0, 0x93, 0xd0, 0x61, 0x6c, 0x45, 0xef, 0x66, 0x94, 0xb4, 0xc6, 0xc7, 0x5f, 0x47, 0x8, 0x52}}
 	return a, nil
 }
 
@@ -1257,7 +1801,7 @@ func orgTeamTeamsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoBareTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x56\x4d\x8f\xdb\x36\x10\x3d\x7b\x7f\xc5\x80\xcd\x55\x12\xda\xe4\x50\x14\x5a\x03\x6d\x13\x64\x0b\x24\x40\x11\x6f\xd1\xa3\x41\x93\xb3\xd2\xc0\x12\xa9\x92\x23\x6f\x0c\xd5\xff\xbd\x20\xf5\x61\x59\x8e\x8b\x76\x7b\x28\x90\x93\x4c\xce\xc7\x9b\xf7\x86\x1c\xb3\xeb\x18\xeb\xa6\x92\x8c\x20\x76\xd2\x63\x56\xa2\xd4\x02\xd2\xd3\xe9\x2e\xd7\x74\x00\x55\x49\xef\xef\x85\xc3\xc6\x7a\x62\xeb\x8e\xf0\x47\x4b\x6a\xef\x59\x3a\x16\xeb\xbb\xd5\x3c\x3e\x38\xc5\x78\x74\x7d\x86\xd5\x3c\x45\x4b\xa0\xac\x61\x49\x06\x5d\x88\x5c\x1a\x0b\x47\x3a\xee\x5f\x18\x3c\x7d\x66\x44\x03\xcf\xa4\x11\x94\xad\xda\xda\xc4\x34\x68\xb8\x77\x5e\x5d\x31\x90\x15\x3a\x1e\x0a\x88\x76\x7a\x82\xf4\x17\xff\x69\xa2\xf0\xbb\x23\x46\x37\x98\x57\x79\xf9\x66\x56\x05\xdb\x06\x24\xb3\x54\x25\x6a\x18\xb8\xf4\x38\x21\x53\x4a\xdf\x7e\x6f\xd2\x47\xd7\x73\x4d\xa3\x14\xdb\xa2\x25\x8d\x62\x4a\x97\x95\x6f\x86\x80\x05\xc1\x29\x6d\x0c\x00\x96\xbb\x0a\xc1\x63\x51\x9f\xb9\x5c\xc6\x10\x63\x3d\x19\x56\x79\xf9\x7a\x7d\x55\x81\xaa\xac\xc1\x2d\x97\xe4\xb7\x61\x2d\x4e\x27\xc8\x7d\x2d\xab\xea\x96\x6b\x89\x55\x13\xda\x23\x4a\xe6\xe6\x87\x2c\x2b\x88\x13\xaf\xea\x54\xd9\x3a\xdb\x59\xbb\xcf\xd0\x64\xef\x89\x93\x9f\xa4\x27\xe5\x93\xf7\xc8\x4c\xa6\x48\x64\x12\x36\xcf\x12\x0a\xf8\x13\x36\xec\xbe\x7b\x78\xfc\xf8\xe1\x74\xca\xb3\x1e\x33\xcf\xca\xd7\xe7\x7a\x17\xec\x15\x93\x35\x10\x1d\x81\x4c\xd3\x9e\x39\x0f\x3d\x32\x96\xe1\x55\xfa\x96\x7c\x10\xe6\xe1\xf1\xf1\xd7\x51\xd2\x98\x6d\xd7\x32\x5b\x33\x4b\xb8\x0b\x15\x42\x64\x05\xbd\x51\x00\xe9\xfe\xa8\x26\x71\x3b\x09\x1c\xbd\x00\x2d\x59\x26\x15\x99\xfd\xbd\xe8\xba\xf4\xe7\x60\xfa\x40\x66\x9f\x06\x8c\xcd\xe9\x34\xab\x63\xa8\xe4\x37\x8f\x83\x2d\x7e\xba\x0e\x2b\x8f\xfd\xa2\xeb\xd0\xe8\x8b\xc2\xb2\x1e\x7c\x4e\xe6\xd2\xe5\x9a\xdc\x66\xf3\xf0\x9f\xb9\x79\x5f\xde\x66\x16\x01\x2e\x78\x6d\x36\x0f\xff\xa6\xe6\x3c\x76\x68\x89\xd9\xba\x4a\xc0\x41\x56\x2d\x06\xbc\x2f\xb7\xac\xeb\x5e\x5d\x4b\x3c\x2a\x78\x69\x8c\x55\x0e\xc8\x02\x1c\x4a\x6d\x4d\x75\x3c\x17\x75\x4b\x97\x61\xbb\xb1\x0d\x99\x02\xda\x06\x54\x45\xcd\xce\x4a\xa7\x7b\x95\xa6\x65\xb2\x63\x33\x88\x64\x1d\x15\x64\x64\x15\x85\x5a\x5c\x0c\xdb\x1c\xb7\x41\x44\x11\xca\x88\xde\xbe\x55\x0a\x03\xe6\x6d\xe7\xed\xe0\x73\x0e\x42\xe7\xac\xfb\xdb\x90\xe8\x71\x0e\x18\xe6\xd8\x3f\x2b\xe9\x20\x1d\xc9\x70\x85\xee\x05\x99\x03\x3a\x46\x0d\x4c\xe6\x38\xe6\x9a\x38\xb3\x74\x05\xf2\xbd\xf8\x66\xd1\xb8\xd9\x69\xc8\x69\xd4\xd4\x2a\x26\x65\x0d\x0c\xdf\x98\xa6\x39\x8a\x75\x9e\xd1\xac\x0f\xcb\xe3\x92\x67\x9a\x0e\xd3\xc4\xba\x58\x5c\x5e\x7a\x4d\x07\x8a\x13\x74\x70\x7a\xe1\x90\x73\x28\x19\xb7\x06\x9f\xe3\x90\xdb\x2a\x5b\xd7\xd2\x68\x11\x06\xcf\x8d\x81\x53\x4b\xb7\xd7\xf6\xd9\xcc\x58\xe7\x8d\xc3\x75\xae\xac\xc6\x35\xdb\x56\x95\xf0\xe9\xdd\x8f\x6f\x3f\xbe\x4b\x6b\x7d\x57\x10\x03\x19\xe2\xf8\x43\x6a\xbd\x30\x05\x3c\x62\x48\x6a\x10\x4f\xe4\xfc\xb8\x21\xa2\xd1\x61\x6d\x19\x63\x54\x7f\xc6\x20\xf7\x8d\x9c\x0e\xed\x4c\xff\x78\x63\x2e\x67\xc0\x0b\x2f\x4b\x9e\x05\x88\x75\xc4\x6f\x5a\x5f\x42\xd2\x8e\xe0\xb5\xf4\x8c\x2e\xcf\x22\xcf\x3c\x0b\x9c\xff\xa7\xae\x85\xc2\xb6\xf8\x99\x3c\x8f\x7f\x4d\x2f\xec\xd6\x57\xa2\xf2\x08\xf8\x65\xcd\x87\x77\x00\x28\x34\x3c\x7b\x72\x5c\xbf\x39\x76\xd2\xe1\xb6\x46\xef\x65\x71\x7e\x74\x2c\x81\xa6\x59\x7e\xde\x9f\x7e\x8d\x3f\x86\xef\xf0\xb9\x7a\x45\x3d\x59\xcb\xe3\x3b\xee\xaf\x00\x00\x00\xff\xff\xbb\x8b\x32\x8f\x25\x0a\x00\x00"
+var _repoBareTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x56\x4d\x8f\xdb\x36\x13\x3e\xdb\xbf\x62\xc0\x37\x57\x59\x78\x9b\x1c\x8a\x42\x36\xd0\x76\x83\x6c\x81\x04\x08\xe2\x2d\x7a\x34\x68\x72\x2c\x0d\x2c\x91\x0a\x39\xf2\xc6\x50\xf5\xdf\x0b\x51\x94\x2d\x7f\x6c\xda\xa6\x87\x02\x3d\x51\x9c\xcf\xe7\x99\x21\x47\x6c\x5b\xc6\xaa\x2e\x25\x23\x88\xad\xf4\x98\x16\x28\xb5\x80\x45\xd7\xcd\x33\x4d\x07\x50\xa5\xf4\x7e\x29\x1c\xd6\xd6\x13\x5b\x77\x84\xcf\x0d\xa9\xbd\x67\xe9\x58\xac\xe6\xb3\xa9\x7f\x6f\x14\xfc\xd1\x0d\x11\x66\xd3\x10\x0d\x81\xb2\x86\x25\x19\x74\xbd\xe7\xb5\x32\x77\xa4\x83\xfc\x42\xe1\xe9\x0b\x23\x1a\x78\x26\x8d\xa0\x6c\xd9\x54\x26\x84\x41\xc3\x83\xf1\xec\x86\x81\x2c\xd1\x71\x04\x10\xf4\xb4\x83\xc5\x2f\xfe\xd3\x89\xc2\x6f\x8e\x18\x5d\x54\xcf\xb2\xe2\xcd\x04\x05\xdb\x1a\x24\xb3\x54\x05\x6a\x88\x5c\x86\x3c\x7d\xa4\x05\xfd\xff\x7b\xb3\x78\x72\x03\xd7\x45\x28\xc5\x26\x6f\x48\xa3\x38\x85\x4b\x8b\x37\xd1\xe1\x8a\xe0\x29\x6c\x70\x00\x96\xdb\x12\xc1\x63\x5e\x9d\xb9\x5c\xfa\x10\x63\x75\x52\xcc\xb2\xe2\xf5\xea\x06\x81\x2a\xad\xc1\x0d\x17\xe4\x37\xfd\x5e\x74\x1d\x64\xbe\x92\x65\xf9\x92\x69\x81\x65\xdd\xb7\x47\x14\xcc\xf5\x0f\x69\x9a\x13\x27\x5e\x55\x0b\x65\xab\x74\x6b\xed\x3e\x45\x93\xbe\x23\x4e\x7e\x92\x9e\x94\x4f\xde\x21\x33\x99\x3c\x91\x49\x2f\x3c\x97\x50\xc0\xef\xb0\x66\xf7\xdd\xe3\xd3\x87\xf7\x5d\x97\xa5\x43\xce\x2c\x2d\x5e\x9f\xf1\x5e\xb1\x57\x4c\xd6\x40\x30\x04\x32\x75\x73\xe6\x1c\x7b\xf4\x6a\xf1\x73\x0f\xf1\xa3\xb3\x6c\x95\x2d\xfd\xe2\xf1\xe9\xe9\xe3\x7a\xac\x6b\x08\xb9\x6d\x98\xad\x99\x44\xdd\xf6\x30\x21\x50\x83\x41\x29\x80\xf4\x70\x5e\x93\x20\x4e\x7a\xa2\x5e\x80\x96\x2c\x93\x3a\xc6\x5e\x8a\xa9\xb4\x24\xb3\x5f\x8a\xb6\x1d\xf2\xbf\x27\xb3\x1f\x53\x4f\x20\x46\x90\xbf\x7a\x8c\xba\xb0\xb4\x2d\x96\x1e\x87\x4d\xdb\xa2\xd1\x17\x70\xd3\x01\xd2\x94\xe7\xa5\xc9\x7d\xde\xeb\xf5\xe3\x3f\x66\xed\x7d\x71\xc3\xf9\x2c\xbb\xc3\x38\x24\xbd\xe0\xbb\x5e\x3f\xfe\x1d\x2e\x59\x68\xea\x35\x8e\xc6\x95\x02\x0e\xb2\x6c\xb0\xcf\x47\x3b\xc0\xcf\xb7\x84\x1f\x70\x27\x9b\x92\x21\x20\xec\xba\xb6\x7d\x75\x0d\x6c\xac\xf3\xa5\x2a\x76\x22\x22\x11\xe0\x50\x6a\x6b\xca\xe3\x6a\xfe\x67\xb5\x8b\xe2\xda\xd6\x64\x72\x68\x6a\x50\x25\xd5\x5b\x2b\x9d\x1e\x2a\x79\xda\x26\x5b\x36\xb1\x68\xd6\x51\x4e\x46\x96\x4b\x71\x7b\xb7\x6c\x7d\xdc\xf4\x45\x15\x5d\x17\xad\x7d\xa3\x14\x7a\xff\x35\xe3\x4d\xb4\x39\x3b\xa1\x73\xd6\x7d\xd5\x25\x58\x9c\x1d\xe2\x28\xfc\x6b\x90\x0e\xd2\x91\xec\x6f\xe1\x52\x90\x39\xa0\x63\xd4\xc0\x64\x8e\x63\xac\x13\x67\x96\x2e\x47\x5e\x8a\xff\x5d\x35\x72\x72\x3a\x32\x1a\x6b\x6a\x15\x93\xb2\x06\xe2\x1a\xc2\xd4\x47\xb1\xca\x52\x5a\xcd\x5f\x3c\x3e\x59\xaa\xe9\xb0\x9a\xdf\xdb\x5c\xce\x0d\x4d\x07\x0a\x43\x38\x1a\x7d\xe3\x9c\x74\x28\x19\x37\x06\x9f\xc3\x9c\xdc\x28\x5b\x55\xd2\x68\xd1\x75\x2f\xce\xac\x4a\xba\xbd\xb6\xcf\x66\xc2\x3a\xab\x1d\xae\x32\x65\x35\xae\xd8\x36\xaa\x80\x4f\x6f\x7f\x7c\xf8\xf0\x76\x51\xe9\x79\x4e\x0c\x64\x88\xc3\x87\xd4\xfa\x4a\xd5\xe7\x23\x86\xa4\x02\xb1\x23\xe7\x47\x81\x08\x4a\x87\x95\x65\x0c\x5e\xc3\x19\x83\xcc\xd7\xf2\x74\x68\x27\xf5\x8f\xf3\xe2\x81\x7c\xff\xf3\x88\x37\xe3\xfe\x8d\xb8\x73\x5d\xa2\x7d\xb8\x2c\x59\xda\xa7\x58\x85\xfc\x75\xe3\x0b\x48\x9a\x31\x79\x25\x3d\xa3\xcb\xd2\xc0\x33\x4b\x7b\xce\xff\x52\xd7\x7a\x60\x1b\xfc\x42\x9e\xc7\xbf\xdb\x37\x76\xeb\x3f\x52\xe5\x31\xe1\xfd\x9a\xc7\xa7\x04\x28\x34\x3c\x79\xb5\xdc\x3e\x5b\xb6\xd2\xe1\xa6\x42\xef\x65\x7e\x7e\xb7\x5c\x27\x3a\xcd\xf6\xb3\xfc\xf4\x35\x7e\xc4\x35\x2e\x37\x0f\xb1\x9d\xb5\x3c\x3e\x05\xff\x18\x00\x6d\x0e\xbf\xfc\x68\x0a\x00\x00"
 
 func repoBareTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1272,12 +1816,12 @@ func repoBareTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/bare.tmpl", size: 2597, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcd, 0x75, 0xd9, 0x57, 0x37, 0xb4, 0xc6, 0xab, 0x65, 0x7a, 0xa1, 0xbc, 0x9d, 0xbc, 0x79, 0xe, 0x23, 0x5b, 0x1e, 0x40, 0x87, 0x46, 0x9b, 0x85, 0xc7, 0x4c, 0xe1, 0xc1, 0xd4, 0x50, 0xee, 0xbf}}
+	info := bindataFileInfo{name: "repo/bare.tmpl", size: 2664, mode: os.FileMode(0644), modTime: time.Unix(1792262907, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x64, 0xc8, 0xa7, 0x6c, 0x92, 0xab, 0xb3, 0x12, 0x3f, 0xc9, 0xaa, 0xfc, 0x2a, 0x48, 0x48, 0xc3, 0xa9, 0x5b, 0xf2, 0xe4, 0xb3, 0x4e, 0x36, 0x5e, 0x12, 0xb4, 0x21, 0xc0, 0x52, 0xc0, 0xca, 0x67}}
 	return a, nil
 }
 
-var _repoBranch_dropdownTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x54\x41\x6b\xdc\x3c\x10\x3d\xef\xf7\x2b\x84\x92\xeb\x7a\xc9\xed\x23\xac\x03\x6d\x29\x34\x50\x4a\x68\x96\x5e\x97\x59\x69\xd6\x16\x91\x25\x57\x1a\x67\x1b\x8c\xfe\x7b\xb1\x25\x39\xde\x6c\xb6\xa4\xed\xb1\x27\xc9\x9e\xa7\x99\xa7\x37\x6f\xb4\x96\xea\x91\x09\x0d\xde\x97\x7c\xaf\x88\x50\x32\x45\xd8\x30\x51\x5b\xeb\x91\x39\xdc\xa3\x43\x23\x90\xdf\xfc\xb7\x98\x63\x3b\xc5\xf6\xda\x02\x29\x53\xb1\xbd\xd2\x84\x8e\x49\x67\x5b\x69\x0f\x86\x33\x09\x04\x4b\x63\x97\x0e\x7d\xa7\xc9\x97\xbc\xef\x0b\x75\xf5\xbf\x29\x36\x8e\x71\x87\xad\x2d\xda\x4e\x6b\x5f\x18\xbb\x4d\x10\x1e\xc2\x50\x61\xf1\xb2\xc6\x0e\xbc\x12\xcc\x37\xa0\x35\xdb\x75\x44\xd6\x24\x98\x6f\xc1\x64\x1c\xe1\x0f\x8a\xbf\x17\x6b\x95\x7f\x5a\x41\x4a\x58\xc3\xd2\xba\xac\x14\x2d\x77\x0e\x8c\xa8\xf9\xcd\x7a\xa5\x22\xbc\xef\xd5\x9e\x15\xb7\xfe\x9b\xc2\xc3\xfb\x31\x18\xc2\x09\xd7\x74\x6a\x88\xa0\xf6\xf8\x0a\x82\x1c\x62\x8c\x1b\x19\xc2\x75\x64\xe2\xc9\x59\x53\xdd\x9c\x29\x11\xb7\x5f\xa0\xc1\x79\xde\xfb\xda\x3a\xba\xff\xf4\xee\x8a\xbd\x04\x0c\x89\xd7\xab\x94\x73\x54\x60\x35\x48\x10\xb7\xd3\xa5\x73\x0b\xd8\x70\xe5\xe9\x9e\xeb\x95\x54\x8f\xe3\x66\x26\x6e\x83\xa6\x7b\x55\xf2\x51\x35\x8f\xe0\x44\xcd\x94\x69\xbb\x53\x69\x53\xbf\x8f\x6a\x0c\xf1\x01\xcc\x0c\x34\x58\xf2\x78\x9e\xb3\x56\x83\xc0\xda\x6a\x89\xee\x15\x17\xc4\x44\xdb\x28\xf0\x16\x8c\xdc\x12\x54\x3c\x84\xa2\x28\x12\xb5\xcc\xfc\x88\x64\x8d\x20\xd1\x65\x5a\xc7\xec\x2b\xa7\x64\x8a\x1c\x85\xe8\x60\x99\xb0\xba\x6b\x0c\x73\xf6\x90\x11\x8b\x35\x64\xc0\xe4\xf4\x04\xe3\xac\x76\xb8\x2f\xf9\x45\xb2\x33\x81\xab\x90\x4a\x7e\x11\xd9\x2e\xb5\xf2\x34\xa5\x39\xf5\x23\x1b\xfb\x6e\x2c\xe5\xde\x6f\xa0\x0a\x61\xa7\x41\x3c\xa4\x6e\x3e\x1f\x5e\x9c\xb1\x1c\x0e\x63\x31\x55\x78\xee\xf7\xf8\x05\x7f\x71\x05\x82\xea\x6d\xfc\xff\x84\x3b\x41\xf5\x16\xde\xcf\xad\x9d\x77\xf9\xb8\xdf\x4a\x96\x7c\x2e\x77\x26\xe8\x85\xb3\x5a\x0f\x4f\xcf\xe8\xe2\x53\xae\x9e\x9e\x34\x96\x5c\x2a\xdf\x6a\x78\xba\x66\xc6\x1a\xe4\x89\x7b\x9e\x7c\x07\xa6\xc2\x3c\x67\xe8\x33\xe3\xb9\x69\xc6\x97\x70\x4c\x8e\xdf\xd9\xe5\x6c\x24\x59\x11\x82\x47\x8d\x82\x50\x66\x49\xa2\xc6\x9d\xd3\x83\xd1\x2f\x8b\xaf\xd8\xda\xcf\xca\x3c\x84\xb0\x1a\x33\x5c\x16\x77\x50\xe1\xad\xff\x60\x9b\x46\x91\x0f\x41\xc4\x4d\x1e\x7e\xef\x44\xca\xb4\xea\xfb\x8f\x5e\x40\x8b\x77\xb6\x33\x72\x28\x95\x12\x6c\x1c\xe2\x1d\x50\x7d\x02\x99\x87\xa6\x0e\xf5\x7d\x31\x3c\x19\x93\xca\x29\x70\x46\xe6\xc9\x12\xbf\xd4\xf8\xa5\x9f\x7f\x43\xe7\x0d\x54\xff\xa6\xc6\x79\x93\xd6\xb4\xfc\x0c\x00\x00\xff\xff\x14\xb8\xa6\x37\x78\x07\x00\x00"
+var _repoBranch_dropdownTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x55\xc1\x6e\xdc\x36\x10\x3d\xdb\x5f\x41\x30\x46\x6e\x2b\xc1\x3d\x15\xc1\x6a\x8b\xb4\x28\x10\x03\x69\x6b\xc4\x6e\xaf\x8b\x59\x71\x24\x0d\x4c\x91\x2a\x39\xf2\xc6\x5d\xf0\xdf\x0b\x4a\xd4\x5a\xf2\xda\x46\x9a\x02\x41\x0f\xf5\x45\xb4\xe6\xed\xcc\xe3\x9b\x37\xa3\xb5\xa2\x7b\x51\x6a\xf0\xbe\x90\x15\x31\xa3\x12\xc4\xd8\x8a\xb2\xb1\xd6\xa3\x70\x58\xa1\x43\x53\xa2\xdc\x9c\x9f\xcd\xb1\x3d\x89\x4a\x5b\x60\x32\xb5\xa8\x48\x33\x3a\xa1\x9c\xed\x94\xdd\x1b\x29\x14\x30\xac\x8c\x5d\x39\xf4\xbd\x66\x5f\xc8\xc3\x21\xa3\xcb\xef\x4d\x76\xeb\x84\x74\xd8\xd9\xac\xeb\xb5\xf6\x99\xb1\xdb\x04\x91\x21\xc4\x0a\x67\x4f\x6b\xec\xc0\x53\x29\x7c\x0b\x5a\x8b\x5d\xcf\x6c\x4d\x82\xf9\x0e\xcc\x84\x63\xfc\xcc\xe3\xeb\xb3\x35\x4d\x2f\x6d\xc9\x54\x5a\x23\xd2\x73\x55\x13\xaf\x76\x0e\x4c\xd9\xc8\xcd\x3a\xa7\x11\x7e\x38\x50\x25\xb2\x2b\xff\x07\xe1\xfe\xc7\x21\x18\xc2\x09\xd7\xf4\xab\x18\x41\xed\xf1\x19\x04\x3b\xc4\x31\x6e\x54\x08\xef\x46\x26\x9e\x9d\x35\xf5\xe6\x85\x12\xe3\xf1\x57\x68\x71\x9e\xf7\xa6\xb1\x8e\x6f\x3e\xbc\xbf\x14\x4f\x01\x31\xf1\x3a\x4f\x39\x07\x05\xf2\x28\xc1\xe6\x7c\x71\xe9\xa9\x05\x22\x5e\xf9\x78\xcf\x75\xae\xe8\x7e\x73\xbe\x14\xb7\x45\xd3\xa7\x4e\x69\x32\x77\xab\xce\x61\x45\x9f\x63\xab\x2e\xb2\x4f\xd8\xd9\x8f\x64\xee\x42\xc8\x07\xfa\x17\xd9\x35\xd4\x78\xe5\x7f\xb2\x6d\x4b\xec\x43\x28\xc7\xc3\x48\x5c\xcc\x10\x57\xc6\x53\xdd\x44\x08\xa5\xd3\x74\x39\xef\xca\x74\x8d\x7c\x5e\xd6\xf7\x55\x2a\x3b\x64\xb9\x75\x88\xd7\xc0\xcd\x50\xf9\x67\x5f\x42\x87\xd7\xb6\x37\x6a\x11\x4a\x79\x9e\x35\xcc\xd0\x73\x8f\xe0\xca\x46\x90\xe9\xfa\x53\x63\x24\xb7\x2e\x14\x8a\xf1\x08\x16\x06\x5a\x2c\xe4\xf8\x7b\x29\x3a\x0d\x25\x36\x56\x2b\x74\xcf\x78\x78\x4c\xb4\x1d\xed\xb1\x05\xa3\xb6\x0c\xb5\x0c\x21\xcb\xb2\x44\x6d\xd2\x7d\x41\xb2\x41\x50\xe8\x26\x5a\x4b\xf6\xb5\x23\x95\x22\x8b\x10\xef\xad\x28\xad\xee\x5b\x23\x9c\xdd\x4f\x88\xb3\x35\x4c\x80\xe3\x9c\x26\x98\x14\x8d\xc3\xaa\x90\x6f\x92\xd6\x0c\xae\x46\x2e\xe4\x9b\x91\xed\x4a\x93\xe7\x63\x9a\xd3\x69\x12\x43\x3b\x8c\xe5\xc9\xb9\xb7\x50\x87\xb0\xd3\x50\xde\x2d\xc4\x1f\xfe\x5e\x18\x18\x8c\x43\x7d\xac\xf0\xe8\xd6\xe1\x3f\xf8\x17\x57\x60\xa8\xbf\x8c\xff\xd7\x70\x67\xa8\xbf\x84\xf7\x63\x6b\xe7\x5d\x5e\xf6\x9b\x54\x21\xe7\x72\x4f\x04\x7d\xe9\xac\xd6\x71\x71\x8e\x33\x78\xc2\xd5\xf3\x83\xc6\x42\x2a\xf2\x9d\x86\x87\x77\xc2\x58\x83\x32\x71\x9f\xf6\x96\x03\x53\xe3\xb4\x25\xd0\x4f\x8c\xe7\xa6\x19\xf6\xf8\x90\x1c\xff\x14\x17\xb3\x85\x22\xb2\x10\x3c\x6a\x2c\x19\xd5\x24\xc9\xa8\x71\xef\xf4\x37\xd9\x00\xcb\xd9\xce\xe2\x48\x7f\xc5\xf8\x1f\x0e\x59\x08\x8f\xa2\x9f\xa5\xc0\x6c\xb3\x7f\x00\xff\x8b\x75\xf8\x9a\x4a\xc7\x8f\x4c\xa5\x7b\x52\x82\xc9\x3c\xa4\x2f\x8d\xd0\x16\xd4\xaa\xb5\x0e\x57\x0e\x2b\xbf\x94\xe8\x7d\xd7\xdd\xf4\xbb\xdf\x3f\x7d\x0c\x21\x87\x8e\xf2\xfb\xcb\x3c\xfa\xc7\xe7\x87\xc3\x20\x9e\x27\xb6\xee\x21\xfb\x6d\x6f\xd0\x65\xe3\x16\x7f\x12\x4a\x2f\x63\xea\x1f\xee\xc8\xa8\x62\xf4\xca\x5b\x4d\x2d\x71\x31\x60\xab\x28\xe9\x0d\xfd\x85\xc7\x06\x75\x50\x63\x21\xbf\x93\x9b\x13\xe3\x46\xb2\xdb\x48\x76\x3b\x90\x7d\x49\x98\x67\x3c\x7a\x9c\xa7\x57\x0d\xfa\x74\x19\xfc\x03\x93\xde\x42\xfd\xbf\x41\x5f\x35\xe8\x4b\x0a\xfd\x77\xcc\xc9\x50\xbf\xf5\xd6\x71\x31\xea\xca\xe8\x14\x30\x7e\x2b\xb3\x4e\x87\xf4\x4c\x8f\xbf\x07\x00\xdf\x12\xce\x6e\xbc\x0a\x00\x00"
 
 func repoBranch_dropdownTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1292,12 +1836,12 @@ func repoBranch_dropdownTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/branch_dropdown.tmpl", size: 1912, mode: os.FileMode(0644), modTime: time.Unix(1583510288, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x81, 0x1f, 0x2d, 0xeb, 0xc9, 0xbc, 0x95, 0xf6, 0xe6, 0xe0, 0xa3, 0x19, 0x53, 0xad, 0x5a, 0xac, 0x78, 0xc7, 0xa, 0x8b, 0xa9, 0xc7, 0xf4, 0x6a, 0x4c, 0x37, 0xa9, 0x39, 0xa1, 0x9b, 0x67, 0xa5}}
+	info := bindataFileInfo{name: "repo/branch_dropdown.tmpl", size: 2748, mode: os.FileMode(0644), modTime: time.Unix(1792291259, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc2, 0x3c, 0x13, 0xf0, 0x9a, 0x7d, 0x40, 0x39, 0x91, 0xee, 0x27, 0x83, 0xc0, 0xf8, 0x87, 0x8, 0xee, 0x5, 0xd0, 0x14, 0xa2, 0xa7, 0xd0, 0x7c, 0x63, 0xa4, 0x9b, 0xf2, 0xac, 0xe, 0x90, 0xec}}
 	return a, nil
 }
 
-var _repoBranchesAllTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\x4f\x6f\xdb\x3e\x0c\x3d\xbb\x9f\x82\x30\x72\x68\x0f\x95\xf1\xbb\xfd\x30\xb8\x01\xba\x61\x87\x02\xdd\x50\xb4\x05\x7a\x2c\x64\x89\xb1\x89\xca\x92\x2b\x51\xc9\x0a\xcf\xdf\x7d\xf0\xbf\x24\xad\x91\x5c\xc2\x88\xa6\x1e\xc9\xf7\x48\xb5\x2d\x63\xdd\x18\xc9\x08\x69\x21\x03\x66\x15\x4a\x9d\x82\xe8\xba\x8b\x5c\xd3\x16\x94\x91\x21\xdc\xa4\x1e\x1b\x17\x88\x9d\xff\x80\xc2\x4b\xab\x2a\x0c\x20\x8d\x49\xd7\x17\xc9\x31\x42\x1f\x36\x20\xa0\x1f\x31\x92\x63\x90\x48\xa0\x9c\x65\x49\x16\x7d\x7f\xf3\xd3\x47\x2b\xb7\x85\x1c\xdd\x4b\xc8\x39\x67\x36\x45\x8d\xd8\x49\x9e\x69\xda\x7e\x05\x8a\x04\xec\x1a\x90\xcc\x52\x55\xa8\x61\x2a\x67\x02\x16\xf4\xdf\xff\x56\x3c\xfb\x11\x58\xcc\xc0\xa2\x6f\xe6\x2c\xe6\x1e\x2f\x60\x59\xa3\x65\x30\x14\x78\x46\xf5\xd2\x96\x08\xe2\xfb\x84\x36\x00\x7d\x46\x20\xc6\x1a\x22\x41\xe9\x49\x8f\xb7\x16\x19\xd0\xe0\x16\x2d\xec\x48\x23\x28\x67\x62\x6d\xe7\xc0\xa4\x6d\x69\x03\xe2\x2e\x3c\x78\xc7\xa8\x18\x75\xd7\xe5\x34\x5f\x75\x8a\x49\x39\x0b\x93\xbd\x0e\x15\xa1\xd1\xe9\x3a\xcf\x68\x0d\x6d\x8b\xb6\x8f\x96\x73\x74\x2d\xfd\x9b\x76\x3b\x9b\x42\xe5\x71\x73\x93\xb6\xed\x4a\x3c\x62\xe3\xee\xc9\xbe\x75\x5d\x16\xbc\xca\xda\xf6\x67\x50\xb2\xc1\x07\x17\xad\x06\xf1\x5b\xd6\xd8\x75\xe9\x3a\x57\x4e\xe3\xba\x6d\x27\x47\x9e\x0d\xe7\x3c\x93\x87\x2a\x57\x4c\x35\x3e\x91\x55\x08\xdf\x6e\xe0\x79\x7f\x10\x3f\x5c\x5d\x13\x4f\x86\xd1\x8b\x97\x0a\x2d\xac\xc4\xbd\xb4\xe5\xc4\x56\x92\xe4\xa1\x91\xf6\x58\x46\xfc\xd3\xd3\x5c\x56\x0c\xa5\xc7\x8f\x74\xdd\xd7\x7a\x42\xbf\xd8\x68\xc9\xa8\x5f\x8b\x8f\x14\x8e\xaa\x58\x26\xee\x8b\x87\xbf\xf0\x24\x37\x43\x0f\x7d\xca\x59\x8e\x59\xf9\xa5\x34\x1b\x17\xfd\x69\x61\xa4\xd5\x70\x39\xfc\xe0\x3b\xac\xa6\x29\x18\xf2\x0c\xd9\xae\x60\x25\xee\xc2\xe3\x7e\x83\x6e\x75\x4d\xf6\x0a\x2e\xad\x63\x18\xb9\x1f\xfd\xe2\x2e\xfc\x22\xef\x9d\xbf\xda\x33\x92\x1c\x74\x8b\x04\x85\x0c\xa4\xa0\x30\x11\xa1\x88\xcc\xee\xa4\x86\xc8\x4c\xb6\x0c\xfb\xb5\x39\xcb\x9c\xaa\xfa\xe1\x7d\xd5\xb8\x91\xd1\xf0\xeb\xe8\x4f\x7b\x6e\x8e\x84\x45\x13\x10\xa6\x56\x3f\x77\xf3\xe2\x89\xd1\xc3\x4a\xdc\x1a\xe3\x76\x0f\xd1\x98\x47\x7c\x8f\x18\xf8\x7c\x13\xe7\xea\x57\xae\x6e\xa4\xc7\x2f\x73\x78\x4c\x6c\xd7\x09\x21\x4e\x8d\xe9\xc9\xc5\x28\x89\xaf\x9b\x68\xcc\xb5\x1f\x2b\xdc\xaf\xc8\x57\x6e\xfa\xa0\x20\x2c\xee\x16\x34\xf4\xbb\xb4\x98\x96\xc3\xdf\x43\xc4\xec\x9b\xec\x64\x16\xaf\xed\xc6\x39\x9e\xde\xca\x7f\x01\x00\x00\xff\xff\x47\x6b\xfb\x4c\x8a\x05\x00\x00"
+var _repoBranchesAllTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xcb\x6e\xe3\x3a\x0c\x5d\xa7\x5f\x41\x18\x59\xb4\x8b\xca\xb8\xbb\x8b\x81\x1b\xa0\xf3\x58\x14\xe8\x0c\x8a\xb6\x40\x97\x81\x2c\x31\x36\x51\x59\x72\x25\x3a\x99\xc0\xa3\x7f\x1f\x38\x7e\x34\x0f\x24\x98\x4d\x14\x31\xe4\xe1\x21\x79\xa8\xb4\x2d\x63\x55\x1b\xc9\x08\x49\x2e\x03\xa6\x25\x4a\x9d\x80\x88\xf1\x2a\xd3\xb4\x06\x65\x64\x08\x77\x89\xc7\xda\x05\x62\xe7\xb7\x90\x7b\x69\x55\x89\x01\xa4\x31\xc9\xe2\x6a\xb6\x8f\xd0\xb9\xed\x10\xd0\xf7\x18\xb3\x7d\x90\x86\x40\x39\xcb\x92\x2c\xfa\x2e\xf2\xe0\x47\x2b\xd7\xb9\xec\xcd\xa7\x90\x63\xce\x74\xf0\xea\xb1\x67\x59\xaa\x69\x7d\x0c\xd4\x10\xb0\xab\x41\x32\x4b\x55\xa2\x86\x81\xce\x00\x2c\xe8\xbf\xff\xad\x78\xf5\x3d\x57\x31\x02\x8b\xae\x98\x8b\x98\x13\x5e\xc0\xa2\x42\xcb\x60\x28\xf0\x48\xd7\x4b\x5b\x20\x88\xaf\x03\xda\x0e\xe8\x10\x81\x18\x2b\x68\x08\x0a\x4f\xba\x8f\x3a\xc9\x80\x06\xd7\x68\x61\x43\x1a\x41\x39\xd3\x54\x76\x74\x9c\xb5\x2d\xad\x40\x3c\x84\x27\xef\x18\x15\xa3\x8e\x31\xa3\x31\xd4\x29\x26\xe5\x2c\x0c\xe7\x6d\x28\x09\x8d\x4e\x16\x59\x4a\x0b\x68\x5b\xb4\x9d\xb7\x1c\xbd\x2b\xe9\xdf\xb5\xdb\xd8\x04\x4a\x8f\xab\xbb\xa4\x6d\xe7\xe2\x19\x6b\xf7\x48\xf6\x3d\xc6\x34\x78\x95\xb6\xed\x8f\xa0\x64\x8d\x4f\xae\xb1\x1a\xc4\x2f\x59\x61\x8c\xc9\x22\x53\x4e\xe3\xa2\x6d\x07\x43\x96\xee\xee\x59\x2a\x8f\x59\xbe\xc8\x0a\xef\xc3\x77\x5c\xc9\xc6\x70\x8c\x59\xa8\xa5\x1d\xd3\x37\x04\xb9\x0c\xa4\x80\xc9\x6e\xc1\xc8\x1c\x4d\x02\x4c\x6c\xb0\xa7\x72\x66\x3c\x41\x56\xb8\xec\xc4\x83\x96\x97\x1a\x83\x4a\x3a\x46\xff\x1a\x20\x43\x02\x7d\x95\xbd\x86\xc5\xc0\xad\x9f\x57\x8c\x59\xda\x51\x5c\x0c\xbd\x9a\xaa\x99\x33\x55\xf8\x42\x56\x21\x7c\xb9\x83\xd7\xe9\x22\xbe\xb9\xaa\x22\x1e\x0e\x46\x2f\xde\x4a\xb4\x30\x17\x8f\xd2\x16\x53\xfc\x71\xd9\x8c\xbf\x3b\xd1\x14\x25\x43\xe1\x71\x7b\x91\x7d\x53\x6b\xc9\xa8\x97\xf9\x36\x81\x39\x5f\x48\xdc\x8d\x02\xfe\xc0\x8b\x5c\xe1\x54\xc6\x20\xae\x51\xc7\xa7\x42\x5b\xb9\xc6\x9f\x97\x99\xb4\x1a\xae\x77\x1f\xf8\x01\xf3\x41\xd3\xbb\x3c\xbb\xc1\xdf\xc0\x5c\x3c\x84\xcf\x5e\xde\xeb\x8a\xec\x0d\x5c\x5b\xc7\x87\x3d\x7e\x08\x3f\xc9\x7b\xe7\x6f\xa6\x8e\xcc\x32\x79\x22\x83\xdc\x34\x08\x79\xc3\xec\xce\x2a\x12\x99\xc9\x16\x61\x7a\x04\x2e\x76\x4e\x95\xdd\x2a\x2e\x75\x3f\xe0\x65\x6f\x4f\x62\x3c\x90\x29\x9a\x80\x30\x94\x7a\x58\xcd\x9b\x27\x46\x0f\x73\x71\x6f\x8c\xdb\x3c\x35\xc6\x3c\xe3\x47\x83\x81\x2f\x17\x71\x89\xbf\x72\x55\x2d\x3d\x1e\x6d\xd5\x7e\x63\x63\x14\x42\x9c\x5b\xba\xb3\x6b\x5e\x10\xdf\xd6\x8d\x31\xb7\xbe\x67\x38\x2d\xfc\x71\x6f\x3a\xa7\x20\x2c\x6e\x4e\xda\xf0\xa9\xf6\xe9\xd5\xdb\xff\xfa\xe9\x31\xda\x86\x73\x38\xf6\x9f\xe9\xdd\x7f\xc7\xca\x39\x46\x9f\x80\x88\xf1\xef\x00\xb2\x75\x5a\xaf\x58\x06\x00\x00"
 
 func repoBranchesAllTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1312,8 +1856,8 @@ func repoBranchesAllTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/branches/all.tmpl", size: 1418, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd8, 0x74, 0xc0, 0xce, 0x6b, 0x2, 0x7d, 0xc0, 0xeb, 0xd3, 0x41, 0x41, 0x98, 0x5, 0x7b, 0xb0, 0x78, 0xcf, 0x38, 0x1, 0x66, 0x83, 0x38, 0xca, 0xf1, 0x29, 0xd, 0x1e, 0xa7, 0x66, 0xfb, 0x1d}}
+	info := bindataFileInfo{name: "repo/branches/all.tmpl", size: 1624, mode: os.FileMode(0644), modTime: time.Unix(1792248414, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x66, 0xfb, 0x7e, 0x2c, 0x3c, 0xc1, 0xc0, 0x31, 0x4d, 0x60, 0x0, 0xc3, 0x9c, 0xad, 0x59, 0x54, 0xb2, 0x32, 0x2a, 0x4a, 0xbe, 0xaa, 0x6, 0x7e, 0xc3, 0x6e, 0x43, 0xe, 0x6, 0x77, 0xd9, 0x8c}}
 	return a, nil
 }
 
@@ -1337,7 +1881,7 @@ func repoBranchesNavbarTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoBranchesOverviewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x97\xcf\x6f\xdb\x3a\x0c\xc7\xcf\xc9\x5f\x41\x18\x39\xbc\x77\xa8\x8c\x77\x7b\x18\xd2\x00\xed\xb6\x43\x81\x6e\x28\xda\x02\x3d\x06\x8a\xc4\xd8\x44\x65\xc9\x93\xe8\x64\x85\xe7\xff\x7d\x70\xfc\xa3\x89\x93\xb4\x29\xd0\x61\x97\x9e\x9c\x50\xf4\x57\x24\xbf\xfc\x1c\x5c\x96\x8c\x59\x6e\x24\x23\x44\x0b\x19\x30\x4e\x51\xea\x08\x44\x55\x8d\xa7\x9a\x56\xa0\x8c\x0c\xe1\x3c\xf2\x98\xbb\x40\xec\xfc\x13\x2c\xbc\xb4\x2a\xc5\x00\x6e\x85\x7e\x45\xb8\x8e\x66\xe3\xd1\xb6\x4c\x9d\xbb\x91\x41\xdf\x08\x8d\xb6\x95\x0a\x02\xe5\x2c\x4b\xb2\xe8\xeb\x37\x77\x0e\xad\x5c\x2d\x64\x13\xde\x97\xec\x2e\x8e\xdb\xac\x46\x7b\x34\x8d\x35\xad\x86\x42\x05\x01\xbb\x1c\x24\xb3\x54\x29\x6a\x68\xcb\x69\x85\x05\xfd\xf7\xbf\x15\xf7\xbe\x11\x16\x01\x99\xc9\x26\x41\x68\x5c\xca\xc2\xf0\xbc\xb9\x29\x7a\x51\xbe\x97\x0e\x98\x64\x68\x19\x0c\x05\x6e\x2e\xd8\xce\x24\xc6\x0c\x0a\x82\xc4\x93\x6e\x4e\x87\x42\x68\x70\x85\x16\xd6\xa4\x11\x94\x33\x45\x66\xdb\xbc\x51\x59\xd2\x12\xc4\x97\xa6\xa8\xcb\x4d\x4d\xe2\x2a\xdc\x78\xc7\xa8\x18\x75\x55\x4d\xa9\xd3\x71\x8a\x49\x39\x0b\xed\xf3\x2c\xa4\x84\x46\x47\xb3\x69\x4c\x33\x28\x4b\xb4\x75\xb6\xec\xb2\x33\xe9\x1f\xb5\x5b\xdb\x08\x52\x8f\xcb\xf3\xa8\x2c\x27\xe2\x16\x73\x77\x4d\xf6\xb1\xaa\xe2\xe0\x55\x5c\x96\x5f\x83\x92\x39\xde\xb8\xc2\xea\x61\x11\xdf\x65\x86\x55\x15\xcd\xa6\xca\x69\x9c\x95\xe5\xc1\xe3\x69\xbc\x39\x9d\xc6\xb2\x6f\x67\xc2\x94\xe1\x1d\x59\x85\xf0\xe9\x1c\xee\xfb\x3f\x83\xf7\x3f\xbb\x2c\x23\x6e\x1f\x8c\x5e\x3c\xa4\x68\x61\x22\xae\xa5\x4d\x36\x9e\xd4\x43\x0c\xb9\xb4\xdb\x6e\xe3\xcf\xda\x82\x24\x65\x48\x3c\x3e\x45\xb3\xba\xa7\x5d\x9b\xbb\xfd\x11\x45\xae\x25\xa3\x9e\x2f\x9e\x22\xd8\x2a\xe9\xb5\x2a\xea\xb6\xe0\x17\xdc\xc9\xe5\xa6\xbb\xba\x80\xd6\xd0\x6e\x45\x5a\xcb\xa4\xd5\x30\x11\x57\xe1\xb6\x87\xe6\x42\x67\x64\xe1\x1f\xeb\x18\x9a\x49\x37\x61\x71\x15\xbe\x91\xf7\xce\xff\xdb\xb7\xb5\xbb\x1b\x4b\x57\xf8\x43\x9b\x31\x7a\xf6\xb2\x20\x58\xc8\x40\x0a\x16\xa6\x40\x58\x14\xcc\xee\xa8\xaf\xed\x9e\xf7\x24\xd5\x53\x3a\x36\x24\x95\x4a\x9b\xe0\x7c\x0f\x89\x67\x3f\x77\xda\xde\x6c\xd8\x78\x3b\xda\xfe\x18\x77\x7b\x7c\xa1\x98\x56\x78\xd9\xea\xb7\xc9\x27\x22\xbb\xcf\x6c\x5f\xa7\xdc\xc8\xce\xfb\x96\x06\x55\xbc\x01\xdb\x51\x59\xfa\xba\xe7\xc3\xa5\xbe\x8a\xf5\x1b\xc0\xee\x46\xf2\x97\x60\xde\xc3\xf7\x08\xb0\x2f\x22\x7b\x0a\xa4\x7f\x06\xd3\xd3\xc1\xdc\xd9\xd1\xa3\x70\x3e\x78\x62\xf4\x30\x11\x17\xc6\xb8\xf5\x4d\x61\xcc\x2d\xfe\x28\x30\xf0\x56\x1b\x27\x62\x79\x18\xcc\x97\x98\x54\x2e\xcb\xa5\xc7\x81\x45\x13\xd1\x2c\x5f\x63\x8c\x10\xe2\x98\x83\x47\x77\x26\x21\x3e\xcb\x0b\x63\xce\x7c\xd3\x4b\xbf\x3d\xc3\x71\xd7\x49\x41\x58\x5c\xef\xb0\xbd\x37\xb9\x9e\xef\x57\xb9\xef\x62\x3d\xf8\x77\x2c\xcd\xfb\x73\x1f\x6a\xd5\xf7\xc5\xfe\x40\xa1\x1f\xd4\x7f\x50\xff\x41\xfd\x1b\xa8\xef\x22\xed\x63\xef\xf3\x62\xe9\x1c\xb7\xdf\x05\xbf\x03\x00\x00\xff\xff\x83\x30\xf4\xb6\x7b\x0c\x00\x00"
+var _repoBranchesOverviewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x6f\xe3\x36\x10\x3d\x3b\xbf\x62\x40\xf8\xd0\x1e\x42\xa3\xb7\xa2\x70\x0c\x64\xdb\x1e\x02\x6c\x8b\x20\x59\x60\x8f\x06\x4d\x8e\xed\xc1\x52\xa4\x4a\x8e\xec\x1a\xaa\xfe\x7b\x21\x89\x52\x64\xd9\xce\x7a\x81\x14\x3d\x6c\x4e\x4a\x86\xc3\xa7\x37\x1f\xef\x19\x2a\x4b\xc6\x2c\xb7\x8a\x11\xc4\x4a\x45\x9c\x6d\x51\x19\x01\xb2\xaa\x6e\xe6\x86\x76\xa0\xad\x8a\xf1\x4e\x04\xcc\x7d\x24\xf6\xe1\x00\xab\xa0\x9c\xde\x62\x04\xbf\xc3\xb0\x23\xdc\x8b\xc5\xcd\x64\x08\x53\xe7\x36\x30\x18\x5a\xa0\xc9\x10\xa9\x20\xd0\xde\xb1\x22\x87\xa1\xbe\x79\x74\xe8\xd4\x6e\xa5\xda\xf0\x29\x64\xf7\xe2\x59\xca\x6a\xb1\x27\xf3\x99\xa1\xdd\x18\xa8\x20\x60\x9f\x83\x62\x56\x7a\x8b\x06\x12\x9d\x04\x2c\xe9\xa7\x9f\x9d\xfc\x14\x5a\xae\x32\x22\x33\xb9\x4d\x94\x06\xd7\xaa\xb0\xbc\x6c\xdf\x24\x5e\x85\xef\xa1\x23\x6e\x32\x74\x0c\x96\x22\xb7\xcc\x87\x99\xc4\x98\x41\x41\xb0\x09\x64\xda\xd3\x31\x10\x5a\xdc\xa1\x83\x3d\x19\x04\xed\x6d\x91\xb9\x94\x37\x29\x4b\x5a\x83\xfc\xad\x25\xf5\xa1\xe1\x24\x1f\xe2\x63\xf0\x8c\x9a\xd1\x54\xd5\x9c\x3a\x1c\xaf\x99\xb4\x77\x90\x9e\xb7\x71\x4b\x68\x8d\x58\xcc\x67\xb4\x80\xb2\x44\x57\x67\xab\x2e\x3b\x53\xe1\x8b\xf1\x7b\x27\x60\x1b\x70\x7d\x27\xca\x72\x2a\x9f\x30\xf7\x1f\xc9\x7d\xa9\xaa\x59\x0c\x7a\x56\x96\xbf\x47\xad\x72\x7c\xf4\x85\x33\x63\x12\x7f\xaa\x0c\xab\x4a\x2c\xe6\xda\x1b\x5c\x94\xe5\xd9\xe3\xf9\xac\x39\x9d\xcf\x54\x5f\xce\x94\x29\xc3\x67\x72\x1a\xe1\x97\x3b\xf8\xd4\xff\x33\xba\xff\xab\xcf\x32\xe2\xf4\x60\x0c\xf2\xf3\x16\x1d\x4c\xe5\x47\xe5\x36\xcd\x4c\xea\x26\xc6\x5c\xb9\xae\x9e\x7a\xda\xf8\x77\x3d\x82\xcd\x96\x61\x13\xf0\x20\x16\x75\x4d\xc7\x63\xee\xf6\x47\x16\xb9\x51\x8c\x66\xb9\x3a\x08\x98\xf2\xd5\x2c\xea\xaa\xe1\x1f\x78\x56\xeb\xa6\xba\x9a\x40\x1a\x68\xb7\x22\x69\x64\xca\x19\x98\xca\x87\xf8\xd4\x8b\xe6\xde\x64\xe4\xe0\x07\xe7\x19\xda\x4e\xb7\x61\xf9\x10\xff\xa0\x10\x7c\xf8\xb1\x2f\xeb\x78\x37\xd6\xbe\x08\xe7\x36\x63\xf2\x32\xcb\x82\x60\xa5\x22\x69\x58\xd9\x02\x61\x55\x30\xfb\x8b\x73\x4d\x7b\xde\x2b\x49\x2c\x4e\xb4\xd0\x37\x49\x6f\x95\xdb\xe0\xf2\x44\x12\x2f\xf3\xec\x95\x51\x97\xdd\x6c\xd8\xcd\x30\x9a\xfe\xb8\x49\x4d\x91\xf7\x9a\x69\x87\x1f\x12\x7e\x4a\xbe\x52\xb2\x93\xcb\x3c\x55\x03\x9b\xf8\x61\x14\x23\x16\xdf\x20\xdb\x49\x59\x86\xba\xe6\xf3\x54\xbf\x2a\xeb\x6f\x10\x76\xd7\x92\xff\x49\xcc\x27\xf2\xbd\x20\xd8\x17\x9a\xcf\x2a\xc3\xfb\x98\xf4\x51\x55\x63\xf1\xb5\x0b\xc8\xe4\x0e\x60\xd5\x0a\xad\x00\x26\xb6\xd8\x1a\xcb\x85\xb9\x45\x95\xe1\xb2\xfe\x25\x40\xc7\x4b\x83\x51\x8b\xaa\x7a\x55\xb5\x47\x17\x54\x14\xc7\x4a\x3a\xd2\x6e\x2f\xcf\xc1\x5e\x7e\xc5\x81\xae\xf1\x9c\xff\xc6\x75\xae\xf7\x99\xe1\x5a\x5f\xf6\x9a\xcf\x81\x18\x03\x4c\xe5\xbd\xb5\x7e\xff\x58\x58\xfb\x84\x7f\x15\x18\x79\x50\xc6\x95\x2e\x73\xde\x67\x5e\xb3\x18\xed\xb3\x5c\x05\x1c\x6d\xdc\x54\xb6\xb2\x6f\xf7\x4c\x4a\x79\x69\x21\x2f\x4a\x60\x43\x7c\x9b\x17\xd6\xde\x86\xb6\x96\x5e\x0c\xe3\x76\xd7\x49\x51\x3a\xdc\x1f\x59\xd5\x49\xe7\x06\x6b\x31\x38\x19\xc4\xfb\x68\x17\xeb\x7d\xec\x99\x95\x7d\x7b\x1b\x8b\x35\xea\xdb\xba\xd8\x19\xa2\xef\x26\xf6\x6e\x62\xef\x26\xf6\xfd\x9a\x58\x17\x49\x8f\xe1\x27\x56\xf3\xf1\xb7\xf6\x9e\x31\x08\x90\x55\xf5\xef\x00\xf1\xed\xce\x63\x19\x0e\x00\x00"
 
 func repoBranchesOverviewTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1352,8 +1896,8 @@ func repoBranchesOverviewTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/branches/overview.tmpl", size: 3195, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x17, 0xc0, 0xfd, 0x3c, 0x16, 0x4f, 0x9c, 0x97, 0x34, 0x97, 0xce, 0xdc, 0xc4, 0x74, 0xb4, 0x4e, 0x8, 0x81, 0x45, 0xbe, 0x3d, 0x6b, 0xcc, 0xd, 0xbd, 0x4e, 0xdf, 0xca, 0x15, 0x9f, 0xbb, 0x3b}}
+	info := bindataFileInfo{name: "repo/branches/overview.tmpl", size: 3609, mode: os.FileMode(0644), modTime: time.Unix(1792248414, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd3, 0x8d, 0x77, 0xbb, 0xb6, 0xb0, 0xa6, 0xef, 0x18, 0x36, 0x1a, 0x2a, 0x79, 0x1e, 0x35, 0x4a, 0xf8, 0x2b, 0xe6, 0x4b, 0xe5, 0xa, 0x89, 0x29, 0x85, 0x47, 0x1c, 0xc8, 0xcf, 0xdb, 0x13, 0x88}}
 	return a, nil
 }
 
@@ -1377,7 +1921,7 @@ func repoCommitsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoCommits_tableTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x5d\x8f\xe3\x34\x14\x7d\x6e\x7f\xc5\x55\xa8\x16\x58\x69\x12\x2d\xda\x07\x04\x69\xd1\xb0\x0b\xda\x11\xb3\xab\xd5\x74\x57\x3c\xa2\xdb\xf8\x26\x31\xe3\xd8\xc1\x76\x3a\x53\x42\xfe\x3b\xf2\x47\xda\xb4\x9d\x2e\x2b\x04\x2f\xad\xe3\x8f\x7b\x8f\xcf\x3d\x3e\x76\x5e\xbf\x84\x42\xa0\x31\xcb\xa4\xe3\x60\x55\x0b\x68\x2d\x16\x35\x31\xa8\x09\x19\xe9\x64\x35\x9f\xf5\x3d\x2f\x21\x7d\x8f\x15\xdd\x98\x57\xaa\x69\xb8\x35\xc3\x30\x9f\xcd\xfa\x3e\xe5\x2f\xbe\x95\xe9\x07\x0d\x89\xa6\x56\xa5\x45\x18\x8c\xff\xbf\xd5\xdc\x58\xa5\x77\x89\x9b\xdc\xf7\x24\x0c\x8d\xcb\x62\x94\x57\xaa\x93\x76\x18\xe0\x1f\x02\x99\x31\x82\x64\xa1\xf1\x34\x9c\x9c\xf1\xed\x64\x33\x9a\x57\xb5\x75\xf0\x67\xb3\xbc\x54\xba\x01\x2c\x2c\x57\x72\x99\xf4\x7d\x7a\x47\xad\xba\xe5\xf2\x7e\x18\xb2\x98\x22\xeb\xfb\xf4\x47\x8d\xb2\xa8\xdf\x61\x43\xc3\x90\x19\x42\x5d\xd4\x61\xfd\x69\x68\xcb\xe5\x0e\xc2\x04\xe0\xb2\xed\x62\x9a\xd9\x2c\xf7\x5f\x20\xb1\xa1\x65\xf2\x47\x02\xad\xc0\x82\x6a\x25\x18\x69\x9f\xf7\xe9\x5d\xc6\x54\xc3\x90\xc0\x16\x45\x47\x7e\xea\x2f\xb4\x7b\x50\x9a\xb9\x4e\xec\xac\x2a\x55\xd1\x99\x08\x26\x63\x7c\x1b\x9b\x9b\xce\x5a\x25\x27\xd0\x36\x02\x8b\xfb\x00\x30\x8c\x25\xc0\xd0\xe2\x55\x8b\x92\xc4\x32\xf9\x02\x19\xbb\x62\xd4\x0a\xb5\xbb\xba\xa7\x5d\xe8\x4e\x56\x17\xa1\x95\x5c\xb2\x64\x18\xf2\x2c\x04\x0b\x6c\x66\x8e\x4e\xd7\x1c\x91\x84\xe2\x82\x2b\xcb\x8d\x79\xcd\xcb\xf2\x95\x6a\x5a\xd4\xa1\xdc\x39\x42\xad\xa9\x74\x9b\x5a\xc4\xc2\x9f\xd1\xef\xd9\xa7\x52\x69\x0a\x13\x6e\x5e\xbb\x7d\x1f\x76\x55\x69\x22\x09\xa6\x46\x10\xb8\x09\x80\xd7\xb5\xd2\x76\xfd\xe6\xfa\x05\x9c\xad\xcc\x33\x5c\x41\x9a\xa6\xf0\xb9\xa9\xaf\x4b\x4b\xfa\xdf\x64\x3e\x59\xe8\x12\x1f\x84\x9a\x67\xf5\xcb\xd5\x7c\x1e\xe4\x7a\x10\xea\x89\x96\x3a\x69\x2c\x16\xf7\xb8\x11\x74\x38\x7b\xd6\x7f\x1a\xaa\x1a\x92\x41\x5c\x79\xe8\xe2\x6c\x99\xc4\xda\x5c\xf9\x9e\xe4\x42\xa8\x2d\xe9\x1d\x6c\xd0\xf0\x02\x8c\xd5\xbc\x25\x06\x25\x7f\x3c\x84\xe6\xb2\x12\x04\x82\x4b\x8a\x47\xc4\xba\xf3\x1e\x55\x65\xf5\xa8\x67\x5b\x8f\xf1\x4b\xd5\x69\x78\xe0\x8c\x3e\xa1\x16\xec\x6c\xad\xb4\xd7\x8b\xad\xcf\x43\x48\x2e\xc9\x87\x80\x86\x8c\xc1\x8a\x92\x55\x6e\x5a\xdc\xcb\xd7\xd4\x98\xac\x1c\xb1\x79\xe6\xba\x57\x97\x7d\x61\x5c\x7f\x29\x93\xad\x35\xc5\x54\xde\x06\x00\x05\xaf\x24\xb1\x4f\x60\x67\x68\x8f\xe3\xe5\x59\xa4\xc1\x75\x8d\xdc\xe4\x76\xa3\xd8\x2e\x4c\xe8\x7b\x58\xe8\xef\x96\x70\xcb\x8d\x9d\x16\x38\x8c\x69\x94\x15\xc1\x42\xc7\x8e\x09\xa9\xb3\xdc\xb2\x11\x68\x64\x6c\x1c\x89\xde\xf6\xd1\xd0\x7e\x9d\x77\x95\xa6\x9a\x94\x19\xb7\x68\x51\x03\x6f\x1c\x03\x60\x74\xe1\xfd\xc2\xad\x49\xef\x48\x5c\xfb\xd1\x20\xf1\x04\x50\xd8\x65\x92\x64\xab\x67\x72\x63\xda\xef\xc3\xef\xe4\x54\x5c\xb7\xed\xba\xdb\x7c\xbc\xbb\x1d\x86\x6c\x8c\x11\xfc\xcf\x33\x75\xed\xd1\xc5\x9e\x20\xee\x11\xe6\xde\xcd\x3f\x1f\xe2\x01\x19\x8c\x91\x7f\x6a\x90\x8b\x4b\x38\x4f\x01\x4c\x92\x87\x8b\x60\x16\xcb\xc4\x56\xf3\x73\x66\xa3\x44\xa0\x50\x42\x60\xeb\xf4\x3e\x65\x39\x7b\x0e\x6e\xb3\xce\xa8\x41\x69\x70\xae\xe0\xdb\x4c\x91\x91\x5f\x5a\x68\x35\x19\x92\x16\x1e\x08\xd0\x98\xae\x21\xb0\x35\x81\x51\x9d\x2e\x08\x9c\x6e\x0c\x77\x57\x1b\x48\x05\x42\xc9\x8a\x34\xd0\x23\x37\xd6\xc0\xf3\x6c\x0a\x94\x97\x20\x95\x85\xaf\x50\x32\x58\xa4\xfb\x8c\x8b\x74\x4c\xf8\xf5\x94\xc3\xe9\x59\xe8\xf8\x45\xd3\xb9\x79\x9d\xae\xad\xe6\xb2\x72\x35\xf1\x07\xe5\x72\x59\x10\xb4\xb3\x7d\xa9\x4a\x25\x84\x7a\x48\x9e\x0c\x7f\x41\x0e\x07\xc0\xf1\x73\x04\x7d\xe4\x9d\xde\x30\x3f\x01\xf0\x48\x34\x93\xba\x1d\x6f\xd7\x53\x55\x59\x77\xad\x6b\x92\xd6\x3f\x0c\xe0\xc5\x30\x54\x9a\x76\x60\xe9\xd1\x42\x5c\x0d\x35\x9a\x2b\x6a\xd4\xef\xdc\x25\xbd\x23\xc9\x46\x03\x7e\x1b\x0b\x5e\xa2\xbb\x8b\xd2\x75\xd7\x34\xa8\x77\x91\x6b\xaf\xba\xd0\x0c\x95\x73\xe7\xb5\x55\x86\xde\x92\x45\x03\x7f\xc1\xda\xea\x6f\xde\x7c\x78\x7b\x7b\xca\x69\x90\xd7\x99\xba\x0e\xb8\xce\xfc\xe5\x03\x6f\x68\xcd\x65\x41\x7b\x95\xff\x5a\x93\x84\x45\x7a\x8b\x81\x91\x7d\xc0\xbd\xc3\x4c\xa9\xc9\xb3\xbd\xc5\xe4\x99\x37\xeb\xd5\x7c\xbc\x68\xc7\x69\xe1\x4e\x51\x1a\xd2\x37\x68\xde\x6b\xda\x72\xd5\x19\xff\xf1\x8e\x1e\xad\x8b\x93\x6f\x5c\xe4\xe9\x55\x53\x90\xb4\xe1\x39\xe7\x54\x31\x91\x41\x83\x42\xc4\xb7\x02\xec\x15\x3b\x0d\x3c\x0c\x8c\x1b\x07\x84\xc5\xfc\x49\x98\x77\x3c\xe7\x70\xd1\x3e\xf9\xb8\x5a\x1c\xbd\xae\xfc\xfa\x45\xfa\x33\x17\xf4\xee\xa0\xaf\xc3\x67\x4c\xf4\x43\x8b\x15\x2d\xfb\x3e\x1d\xd3\xb8\x47\xdf\x30\x3c\x73\xdd\x6b\xfe\x67\x18\x8a\xed\x61\x48\xe2\x2a\x4f\xa9\x8b\xf7\xb4\xd1\x4b\x7a\x20\x9d\x84\xa7\x49\x50\xe7\x67\xf2\x11\xb8\xbd\xcc\x45\x18\xff\x5f\x79\x70\x29\xfe\x0b\x0e\xfc\x8b\x74\xca\xc1\x89\xc0\xfe\x0e\x00\x00\xff\xff\xbe\xae\x97\xce\x17\x0c\x00\x00"
+var _repoCommits_tableTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x57\xdf\x6f\xdb\x38\x12\x7e\xb6\xff\x8a\x01\xcf\xe8\xb5\x05\x22\xa1\x87\x3e\x1c\xee\x64\x1d\xd2\xb4\x87\x06\x9b\x16\x41\xdc\xee\x3e\x16\x63\x71\x24\x71\x23\x91\x5a\x92\x72\xe2\xd5\xea\x7f\x5f\x50\xa4\x6c\xd9\x8e\xb3\xc5\x62\xf7\x25\x16\x7f\xcd\x0c\xbf\xef\x9b\x19\x26\x29\xdf\x42\x56\xa1\x31\x4b\xd6\x0a\xb0\xaa\x01\xb4\x16\xb3\x92\x38\x94\x84\x9c\x34\x4b\xe7\xb3\xae\x13\x39\x44\xb7\x58\xd0\xb5\xb9\x52\x75\x2d\xac\xe9\xfb\xf9\x6c\xd6\x75\x91\x78\xf3\x6f\x19\x7d\xd1\xc0\x34\x35\x2a\xca\xfc\x62\xf8\xfd\x56\x0a\x63\x95\xde\xb2\xb0\xd9\x19\xb9\x36\x3f\x0a\x7a\x78\xa7\x51\x66\xe5\x30\x3d\x4b\x70\x0c\xa0\x51\x8d\x90\x05\xb4\x0d\x83\x52\x53\xbe\x64\x5d\x17\xdd\x51\xa3\x6e\x84\xbc\xef\xfb\x38\x18\x8f\xbb\xee\x83\xc9\xb0\xa1\x5b\xd5\x4a\x0e\x91\xb7\xf5\x19\x6b\xea\xfb\x08\xad\xaa\x19\x70\xb4\x78\x91\x29\x69\x49\xda\x25\x3b\x89\x32\x27\xe2\x26\x32\xed\xda\x64\x5a\xac\x89\xf5\x7d\x38\xb2\x41\x2d\xd0\x0a\x25\x97\x4c\xc8\x0d\x69\x4b\x1c\xac\x90\x5b\x96\x26\x62\x0c\x52\x65\x56\x64\x4a\x42\xf8\xbd\xd0\xc6\xb0\x34\x89\x45\x9a\xc4\x98\x0e\xd7\x24\xc9\xdd\xcd\xba\x8e\x2a\x43\x23\x4e\x01\xb6\x2b\xd5\x4a\xdb\xf7\xf0\x07\xc8\x19\x16\x2c\x8c\xa6\x9e\xc6\x3f\xe1\x62\x33\x61\x4f\x8b\xa2\xb4\x8e\xaf\xd9\x2c\xc9\x95\xae\x01\x33\x7f\x99\x73\x38\x1e\x60\x17\x1b\x42\x9d\x95\xfe\xfc\xb1\x69\x87\x02\xf8\x0d\x20\x64\xd3\x06\x37\xb3\x59\x32\x8c\x40\x62\x4d\x4b\xf6\x0b\x83\xa6\xc2\x8c\x4a\x55\x71\xd2\x4f\x20\x3f\xde\x32\xb8\x72\xc0\x6f\xb0\x6a\x69\xd8\xfa\x03\x6d\x1f\x94\xe6\x6e\x12\x5b\xab\x72\x95\xb5\x26\x04\x13\x73\xb1\x09\x9f\xeb\xd6\x5a\x25\x27\xa1\xad\x2b\xcc\xee\x7d\x80\x7e\x2d\x90\xd9\xa0\xa4\x6a\xc9\xfe\x81\x9c\x5f\x70\x6a\x2a\xb5\xbd\xb8\xa7\xad\x9f\x66\xe9\xd9\xd0\x72\x21\x39\xeb\xfb\x24\xf6\xc6\x3c\x9a\xb1\x83\x33\x9d\xef\x23\xf1\xe4\x82\x57\xf4\x7b\x91\xe7\x57\xaa\x6e\x50\x7b\xba\x13\xdc\xe9\x77\x11\x88\x3f\x81\x7f\x40\x9f\x72\xa5\xc9\x6f\xb8\x7e\xef\xee\xbd\xbf\x55\xa1\x89\x24\x98\x12\xa1\xc2\xb5\x0f\x78\x55\x2a\x6d\x57\x1f\x2f\xdf\xc0\xc9\x49\xa7\x3d\x88\xa2\x08\xbe\xd7\xf5\x65\x6e\x49\xff\x19\xcf\x47\x07\xbd\xe8\x47\xa1\x26\x71\xf9\x36\x9d\xcf\xbd\x5c\xf7\x42\x3d\xd2\x52\x2b\x8d\xc5\xec\x1e\xd7\x15\xed\x8b\x8d\x1d\x86\x86\x8a\x9a\xa4\x17\x57\xe2\xa7\x04\x5f\xb2\xc0\xcd\xc5\x30\xc3\xce\x98\xda\x90\xde\xc2\x1a\x8d\xc8\xc0\x58\x2d\x1a\xe2\x90\x8b\xc7\xbd\x69\x21\x8b\x8a\xa0\x12\x92\x42\x8a\x58\x57\xe0\x82\xaa\xac\x1e\xf5\x6c\xcb\xd1\x7e\xae\x5a\x0d\x0f\x82\xd3\x33\x6a\xc1\xd6\x96\x4a\x0f\x7a\xb1\xe5\xa9\x09\x29\x24\x0d\x26\xa0\x26\x63\xb0\x20\x96\x26\xa6\xc1\x9d\x7c\x4d\x89\x2c\x75\xc0\x26\xb1\x9b\x4e\xcf\xd7\x85\xf1\xfc\x39\x4f\xb6\xd4\x14\x5c\x0d\x65\x00\xb0\x12\x85\x24\xfe\x4c\xec\x1c\xed\xa1\xbd\x24\x0e\x30\x24\xf1\x1e\x9b\xc4\xae\x15\xdf\xfa\x0d\x5d\x07\x0b\xfd\x9f\x25\xdc\x08\x63\xa7\x04\xfb\x35\x8d\xb2\x20\x58\xe8\x30\x31\x01\x75\x96\x58\x3e\x06\x1a\x10\x1b\x57\x42\x6d\xfb\x6a\x68\x77\xce\xed\x17\x75\x31\xa1\x19\x37\x68\x51\x83\xa8\x1d\x02\x60\x74\x36\xd4\x0b\x77\x26\xba\xa3\xea\x72\x58\xf5\x12\x67\x80\x95\x5d\x32\x16\xa7\x2f\xe4\xda\x34\xff\xf5\x7f\x27\x59\x71\xd9\x34\xab\x76\xfd\xf5\xee\xa6\xef\xe3\xd1\x86\xaf\x7f\x03\x52\x97\x43\x74\x61\x26\x54\xf4\x10\xe6\xae\x9a\x7f\x7f\x88\xfb\xc8\x60\xb4\xfc\xa1\x46\x51\x9d\x8b\xf3\x38\x80\x89\x73\xdf\x08\x66\x81\x26\x9e\xce\x4f\x91\x0d\x12\x81\x4c\x55\x15\x36\x4e\xef\x53\x94\xe3\xd7\xe0\x2e\xeb\x0a\x35\x28\x0d\xae\x2a\x0c\xdf\x5c\x91\x91\xff\xb4\xd0\x68\x32\x24\x2d\x3c\x10\xa0\x31\x6d\x4d\x60\x4b\x02\xa3\x5a\x9d\x11\x38\xdd\x18\xe1\x7a\x39\x48\x05\x95\x92\x05\x69\xa0\x47\x61\xac\x81\xd7\xf1\x34\x50\x91\x83\x54\x16\x5e\xa2\xe4\xb0\x88\x76\x1e\x17\xd1\xe8\xf0\xd5\x14\xc3\x69\x2e\xb4\xe2\x6c\xd1\xb9\x7e\x1f\xad\xac\x16\xb2\x70\x9c\x0c\x89\x72\x9e\x16\x04\xed\xca\xbe\x54\xb9\xaa\x2a\xf5\xc0\x9e\x34\x7f\x46\x0e\xfb\x80\xc3\x70\x0c\xfa\xa0\x76\x0e\x05\xf3\x99\x00\x31\x7d\x9a\xb7\xc3\xeb\x0e\x50\x15\xd6\xb5\x75\x4d\xd2\x0e\x0f\x03\x78\xd3\xf7\x85\xa6\x2d\x58\x7a\xb4\x10\x4e\x43\x89\xe6\x82\x6a\xf5\xb3\x70\x4e\xef\x48\xf2\xb1\x00\x7f\x0a\x84\xe7\xe8\x7a\x51\xb4\x6a\xeb\x1a\xf5\x36\x60\x3d\xa8\xce\x7f\x7a\xe6\x5c\xbe\x36\xca\xd0\x27\xb2\x68\xe0\x37\x58\x59\xfd\xaf\x8f\x5f\x3e\xdd\x3c\x81\xa9\xc8\x61\x11\x5d\x95\xa4\xf5\xf6\x56\x64\xf7\x66\x82\x6f\xd7\x3d\x08\xeb\xde\x00\x9c\x1e\x0f\x37\x45\xef\xd0\xec\x5a\x92\x39\xc0\x64\x3e\x9b\xd0\x33\xe9\xdc\x43\xb1\x1e\x3a\xf7\x11\x2d\x8b\xe8\xc9\xae\xe5\x71\x5f\x1c\xd5\xb3\xa6\xad\x2a\x13\x61\xa5\x09\xf9\xf6\x9b\x90\xdf\xd6\x68\x88\xc1\xcb\x09\x3f\xaf\x0e\x79\x39\x26\xe6\xa9\xfc\x3a\x49\xaf\x3d\x31\x27\x05\xf6\x8b\xa8\x69\x25\x64\x46\xbb\x34\xff\xa9\x24\x09\x8b\xe8\x06\xbd\x24\x76\x06\x77\x25\x76\xea\x33\x89\x77\x35\x36\x89\x87\x6e\x95\xce\xc7\x97\xc6\xb8\xcd\x37\x55\xa5\x21\xfa\x88\xe6\x56\xd3\x46\xa8\xd6\x0c\x83\xcf\xf4\x68\x9d\x9d\x64\xed\x2c\x4f\x7b\x6d\x46\xd2\xfa\x07\xfc\x21\xee\xa6\xc6\xaa\x0a\x8f\x25\xd8\xa5\xec\xd4\x70\xdf\x73\x61\x5c\x20\x3c\xf8\x67\x7e\xdf\xe1\x9e\x67\xd8\x32\x43\xfa\x4c\x9f\x97\x41\x55\xff\x17\x15\x7d\xde\x27\xd8\x7e\x18\x1c\xfd\xaf\xc1\x82\x96\x5d\x17\x8d\x6e\xdc\xab\xb7\xef\x5f\xb8\xe9\x95\xf8\xd5\x2f\x85\xef\xbe\x67\xe1\xd4\x00\xe9\xa9\x32\xc6\x4e\x27\xe9\x81\xb4\xff\x2f\x24\xc8\xe0\x3b\xf1\xf0\xd8\x9e\xc7\xc2\xaf\xff\xad\x38\x38\x17\x7f\x05\x06\xc3\x93\x7c\x8a\xc1\x91\xc0\x7e\x1f\x00\x8b\xe9\xe4\xde\x09\x0e\x00\x00"
 
 func repoCommits_tableTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1392,8 +1936,8 @@ func repoCommits_tableTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/commits_table.tmpl", size: 3095, mode: os.FileMode(0644), modTime: time.Unix(1583516842, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0xe7, 0x15, 0x5b, 0x8c, 0x14, 0x6c, 0xbe, 0x42, 0xdc, 0x9c, 0x5, 0x2e, 0x7e, 0x4b, 0x3, 0x35, 0xde, 0xf8, 0xe7, 0x16, 0xe3, 0x36, 0x6f, 0xe5, 0x7a, 0xcc, 0x3b, 0xb7, 0xe4, 0xec, 0x6f}}
+	info := bindataFileInfo{name: "repo/commits_table.tmpl", size: 3593, mode: os.FileMode(0644), modTime: time.Unix(1792260323, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc1, 0x8d, 0x1e, 0xa4, 0x7f, 0xfe, 0x2b, 0x95, 0xe8, 0x84, 0x67, 0xf1, 0xb2, 0xba, 0x77, 0xc2, 0xad, 0x8b, 0x16, 0x6e, 0xc4, 0x91, 0x7d, 0xf0, 0x2e, 0x3c, 0xe5, 0x62, 0x8c, 0xf7, 0x4a, 0x33}}
 	return a, nil
 }
 
@@ -1417,7 +1961,27 @@ func repoCreateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoDiffBoxTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4b\x93\xdb\xb8\x11\x3e\x73\x7e\x45\x87\x51\x79\xa4\xcd\x88\xaa\xb5\x7d\x48\xad\x29\xa5\xf6\x99\x9d\x2a\xc7\xd9\xf2\xcc\x5d\x05\x11\x4d\x11\x31\x08\x70\x01\x70\x34\x13\x86\xff\x3d\x05\x90\xe0\x4b\x0f\xcf\xb8\xe2\xcb\x56\x2e\x12\xf1\xe8\x46\xf7\xd7\x5f\x37\x9a\xac\x2a\x96\x42\xf4\x13\x4b\xd3\x0f\xd2\x7c\xff\x40\x18\x27\x3b\x8e\x75\x7d\x15\xc4\xd9\xdb\x4d\x55\x45\xec\xdb\xbf\x8a\xe8\x5e\x41\xa8\xb0\x90\x11\x65\x69\x1a\x51\x62\xc8\x56\x48\xb3\x25\x7e\x7f\x58\xd7\xf1\x2a\x7b\xbb\xb9\xaa\x2a\xe4\xba\x11\xa7\xec\x01\x12\x4e\xb4\x5e\x87\x56\x6a\x49\xd1\x10\xc6\x97\x3b\xf9\x08\x6e\xbc\x93\x8f\xe1\xe6\x2a\x70\x1b\xed\x7f\x10\x33\xbf\x3f\x25\x90\x92\xa5\x42\x73\x40\x34\xe1\x26\x5e\x31\xb7\xe1\xb4\x35\xda\x10\xa3\xb7\x14\x75\x12\x36\x8e\x44\x1f\xca\xfc\x17\xc6\x51\xb7\xc3\x7b\x69\x08\xff\x9e\x52\x66\x98\x14\xc3\xb9\x9f\x90\xa3\x9b\xfb\x0f\xdc\x19\xf5\xfa\xd7\xfb\x7f\xbc\xb7\x96\x07\x23\xdb\x4b\x06\x8a\xed\x33\xe3\x6c\x0d\x82\x98\x0c\x16\x0c\x13\x4f\xb0\x23\x9a\x25\x60\xe4\x7e\xcf\x11\x76\xa5\x31\x52\x84\x90\x29\x4c\xd7\xe1\xdf\xb4\x79\xe2\xb8\x6e\x30\xbe\xd5\x77\x05\x67\xe6\xce\x4e\xd5\x75\x29\x58\xca\x90\x7a\xbc\xb4\x5d\xaa\x2a\x14\xb4\xae\xc3\x4d\x55\xc1\x54\x02\xea\xfa\x8c\xfb\x99\x3c\x6c\x5b\x6d\xdb\x07\x86\x87\xd0\xee\x6c\xb4\x5e\x90\x70\x07\x0e\xf6\xdb\x83\xe3\x15\x79\x91\x93\x96\x07\x4b\x43\xd4\x1e\xcd\x3a\xfc\xb3\x0b\x6a\x6a\x71\x0f\xcf\xf0\xc6\x1d\x6c\x9f\xb6\x2e\x66\x61\x7f\x62\xbc\x6a\x49\xd0\x3f\x48\xde\xb1\xa7\x21\x8e\x53\x0d\x19\xa3\x18\x02\xa3\x2d\xa9\xda\xf3\x1a\x76\x28\x22\xf6\xd8\x06\xd8\x11\xa0\x89\x66\x10\xf3\x86\x3f\xc1\x31\x29\x13\x59\x0a\x83\x0a\xdc\x3f\x14\x25\xe7\xcb\x61\xb4\xad\x56\x96\x82\x90\xc6\x46\xe3\x07\x26\x5a\x8d\x56\x95\x2e\x88\xf0\xba\x08\xa5\x2d\x1c\x9c\x09\x5c\x87\x55\x15\x79\xc2\x35\xf1\x1c\x0c\xe3\x95\x95\xdc\x9c\xd4\xb3\x23\xaa\x3b\x79\xb2\xe4\x6c\xe3\x98\x1a\xb0\x87\x6d\x26\x5a\xce\xed\xa5\xc8\x8f\xf6\x5e\x32\xc0\xee\x9f\x38\xe2\xb3\xa4\x75\xa4\x1f\x8e\xf5\xf4\x99\x3f\x50\xbb\xa9\xaa\xd9\x29\x26\xec\x98\x08\x4f\x28\xb0\x24\x6c\x03\xe5\x79\x60\x9f\xff\xb4\x5c\x82\x91\x54\x42\xca\x04\xd3\x19\x10\xce\xc1\x06\x1e\x2c\x8d\x4a\x7d\x03\x42\x1e\x20\x97\x94\xa5\x4f\x37\x16\x9d\x1b\xeb\x36\x1a\x04\x22\x28\x28\x14\x24\x47\x58\x2e\xbd\xb6\xa1\xbb\x8d\x02\xa8\x2a\xcb\x99\xfb\xa7\x02\xef\xe5\x9d\x51\x10\xfd\x1d\x8d\x1d\xd5\x35\x14\xb2\x60\x62\x0f\x65\xd1\xc2\x92\x48\x61\x50\x18\x8b\xcc\x39\x99\x76\xe7\x03\x51\x8c\x58\xa4\xd6\x21\x13\x0f\xa8\x0c\x52\x97\x4b\xed\x72\x21\x35\x6b\x56\x1d\xe1\x20\x41\x4b\xc4\x70\xf3\x4a\xec\x74\xf1\x6e\x04\x4d\x9f\x8e\xd6\x6b\x5f\x5c\x9a\x84\xab\xaa\xe8\x56\x50\x7c\x6c\xa3\xf3\x81\xe4\x38\x4c\xe4\x15\xf7\xa5\xd3\x83\x1b\xaf\x24\xdf\x5c\x79\x80\xaf\xba\xb4\x99\xb1\x1b\x98\x39\x54\xbf\x5b\x1f\xe5\x90\xcb\x02\xb7\x1a\xdd\xea\x5b\x91\xc8\xbc\xb0\x00\x1f\x57\xcb\x2e\x29\x47\x75\xde\x45\xcb\x43\xe7\x0b\x69\xf6\x76\x58\x64\x64\x01\xc4\x18\x92\x64\x48\x41\x48\x95\x13\x0e\x19\x12\x8a\x5d\x3e\x9c\x61\x92\xd5\xbc\xd5\x65\x51\x28\xd4\x1a\x69\xd8\x11\xe8\x72\xa6\x97\x0c\x6c\x7e\x1c\xa7\xb9\x77\xf2\xa3\xa3\x0d\xfd\xb2\x7c\xff\x0b\xfc\x61\x32\x7e\x09\x97\x73\xfe\x4c\xca\x0e\x4f\x70\xa4\xb5\x95\xc0\x41\xeb\x19\xda\xeb\x69\x3a\x87\xa1\x86\x61\x2d\x79\x21\xbb\xa0\xaa\xee\xc9\xee\x8e\xfd\x1b\x7f\xb4\x32\x30\x8b\x7e\xa6\xcc\x48\x95\x48\x91\xb2\x3d\x0c\x6d\x18\x5c\x23\xc3\x2c\xfa\x22\x76\x7e\x09\xdd\x3c\xd5\x46\xd7\xca\xc5\x82\x39\x2a\xb5\xf0\x7f\xc6\x7e\x6d\xc6\x0e\x82\xd4\xa1\xeb\x79\xfc\x4f\x4e\x1b\x1a\xc1\x2b\x45\x94\x7a\x07\xad\xea\xf3\x3c\x3f\x2e\x32\x77\xe5\x2e\x97\xb4\xe4\xfd\xb5\x79\xa1\xf3\x9c\xd2\xc6\xb9\x38\x8c\xf7\xb8\x6d\x6b\x3a\xb6\xbd\xc2\xa7\xb6\x83\x6b\x9b\x36\x85\x7c\x1d\x0a\x99\x4a\xce\xe5\xc1\xdf\x25\x55\xf5\xb3\x4e\x48\x81\xbf\xc9\x52\x50\x98\x45\x3f\x60\x2a\x15\xde\xc9\x52\x25\xf8\x1b\x31\x59\x5d\xaf\xc6\x5b\x7c\x0a\x9d\xbb\xe0\x6d\x63\xb9\x75\x30\x0e\x6e\xa3\x11\x75\x67\xd1\x50\x3d\x54\xd5\xea\x1b\xf8\x20\xa1\x9f\x84\x03\x02\xd1\xba\xcc\x11\x4c\x86\xa0\xdd\x02\xd8\x23\xb4\x4d\xe8\x27\x10\x12\xb8\x14\x7b\x54\x80\x8f\x4c\x1b\x0d\xdf\xac\xbe\x0a\x18\x5f\x07\x86\x9e\x86\x23\x1e\x0e\x57\xba\xc2\x38\xa5\x45\x29\xb4\x21\xc9\x27\xfb\xe6\xd5\xd7\x25\xe3\x86\x1a\xf7\x79\x7f\xc9\x7e\xfe\x5e\xab\xaa\x19\xd3\xb7\x39\xd9\xbb\x7b\x7f\x9e\xd8\xde\x6a\x66\x2f\x79\x3b\x65\x1b\x80\x41\xc9\x5c\x0c\xa4\x58\xea\xba\x2b\x2f\x3c\xa8\x3a\x03\x53\x7d\x4f\xd3\x07\x85\xe5\x7b\xd0\x2a\xb1\x20\xcf\xa2\x8f\xe4\x70\x19\xd5\xbe\x1a\xf4\x00\x1d\x37\x9a\x83\x03\xdb\xbb\x81\x3e\xf9\x3b\x81\x22\xd8\x9f\xa5\x0d\x44\xf3\x64\xe3\x32\xb4\xc8\xc1\xd6\x8f\x83\xd8\x58\xf9\xc1\x44\x9b\x75\x93\xf7\xb7\xc1\xba\xc5\x30\x63\xfb\x8c\xdb\x64\x6d\x6e\x9d\xef\xd6\x2d\x6c\xbf\x8e\xe6\xa7\x62\x6d\xe7\xf5\xaf\x1b\x98\x69\x4c\xdc\xab\x68\x27\x79\xd7\x4c\x4c\x64\x7a\xa1\x4f\x37\x30\xb3\xf5\xcf\x49\xb4\xd2\xd1\x7b\x26\x70\x2a\x11\xc4\x46\x79\x7c\x9a\xa6\xd5\xee\x3a\xd5\xb8\x36\x78\x09\xbe\xac\xaa\xd9\xa7\xba\x06\xe9\x9f\xc2\xcd\x58\x63\x03\x09\xfe\xde\xc9\xc2\xdb\xe9\xa1\xf6\x58\xea\x8f\xb5\x76\xea\xa5\x28\x73\x5b\xe7\x0d\x9d\x6a\x6b\xb6\x4a\x6e\x4b\xe5\x3a\x7c\x13\xc2\x58\xce\x5a\x75\x64\x41\x10\xc4\x85\xc2\x4d\xdc\x84\xd8\x7b\x67\x03\x95\x4d\x20\xe7\x44\xec\x4b\xb2\xc7\xe5\x51\x98\xfa\x17\x65\x21\xbb\x95\xc1\x4b\x78\x07\xeb\x8f\x32\x2f\x4a\x83\xf4\x56\x58\x8b\x2c\x84\xbf\x48\xd5\xc0\x6f\xf3\xda\xda\xb0\x89\x57\xd6\x9e\x23\xcf\x4e\xb8\x3b\x25\xf0\x25\xc0\xa0\x7b\x5a\x4a\x4e\x43\x68\x5c\xb4\x93\xd1\x7b\x4c\xcd\x2d\x7d\xac\xeb\x61\x1b\x73\x97\x91\x6f\x7d\xae\x37\x0d\xcd\xfb\xaa\x9a\xec\x1f\x5c\x9e\x56\xf1\x0e\x95\x4b\xc7\xc9\xa6\x16\x87\x67\x79\x74\x6c\xba\x0b\x4b\x46\x78\x7a\x60\xd4\x64\xcf\x8a\xde\x41\x91\x02\xfe\xd7\x21\x3c\x46\xeb\xb9\x61\xed\xbe\x89\xbc\x30\xba\x9f\x0b\xa3\xc0\xc3\x28\x8c\x1f\xad\xc5\xcf\x88\xe3\x47\x1f\xa2\x5e\xe0\x52\x20\x07\xbb\xfe\x60\x91\xec\x3d\xfb\xda\xa1\x1c\xdf\xd2\xfd\x46\xb5\xb9\xfa\xcc\xb6\xe3\xa9\x53\x49\x5f\x55\x06\xf3\x82\x13\x83\x4d\xcf\xb0\xb2\xc1\x5f\xb5\x2e\xf9\x6f\x7a\x21\x44\x53\x45\x63\xd5\xf1\x6a\x72\x63\xc5\xab\xf1\x9d\x36\xbd\x3d\x7b\xf1\x71\xb3\xd1\x7d\x87\x1d\xbc\x86\x35\xcb\xf1\xce\xba\xec\x87\x57\x41\xff\xd1\xf8\xf8\x73\xc0\x17\x7d\x0d\x78\xe1\xeb\xd6\x99\x6e\xcb\x48\xb9\xcd\x89\x78\xda\x36\x5f\x05\xdb\xf7\xc7\xb6\x89\xf2\x5e\x4d\xbd\x38\xba\xd6\x63\x9d\x28\x56\x18\x77\xd2\x3c\x2d\x85\x8b\xc6\x7c\x01\x95\x3b\x7a\x36\xbf\x8e\x08\xa5\x2e\x2d\xae\x17\x11\x92\x24\x3b\xde\x14\x3c\x10\x05\x85\xc2\x07\x58\xc3\x6c\x6e\x32\xa6\x17\x91\x1d\xce\x17\xef\x9a\x75\x96\xce\xed\x38\x62\x7a\x7e\x1d\x51\xe4\xad\x3a\x78\xf5\xca\x89\x45\x49\xc6\x38\x55\x28\xe6\x8b\x08\x7f\x9f\xbf\x59\x44\x06\x1f\xcd\x7c\x11\x19\xc5\xf2\xf9\x02\xd6\xeb\x35\x5c\x5f\x77\xa7\x05\x87\x8c\x71\x6c\x34\x36\xc7\x9c\x55\xdc\x2e\xbf\x50\x7f\xd0\xfa\x32\x50\xd1\x3a\x12\x78\x22\x9e\x36\x3b\x33\x39\x9f\x7b\x04\x4e\xaf\x2e\x3a\x55\x27\x54\xbc\xbe\xa8\xe2\xf5\x73\x54\xbc\x59\xd8\x78\xb9\x12\x33\xbf\xee\x23\x77\xf9\xd0\x4b\x12\xde\x16\x85\xb9\x7c\xc0\x0e\x89\x06\x88\xba\x19\xd6\xad\x4d\xf1\xaa\x23\x93\xe7\x9d\xff\xff\x6f\x00\x00\x00\xff\xff\x62\x40\xfd\xc4\x79\x19\x00\x00"
+var _repoDependenciesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\x4d\x8f\xdb\x36\x10\x3d\x6b\x7f\x05\x41\xe4\xd0\x1e\x22\xa1\x41\x0e\x3d\x70\x75\x28\xb2\xb7\x6d\x11\xec\x6e\x7b\x35\x28\x72\x24\x0d\x56\x1e\x0a\x24\xed\xd4\x60\xf9\xdf\x0b\x7d\x50\x96\x1c\xc3\x4e\x2e\xb6\x24\x72\xde\x7b\x33\xf3\x38\x52\x08\x1e\xf6\x7d\x27\x3d\x30\x5e\x49\x07\x45\x0b\x52\x73\x96\xc7\xf8\x20\x34\x1e\x99\xea\xa4\x73\x8f\xdc\x42\x6f\x1c\x7a\x63\x4f\x0c\xc9\x61\xd3\x7a\xc7\x34\xf4\x40\x1a\x48\x21\x38\x5e\x3e\x64\x6b\xa8\x61\xff\x08\x05\x76\x02\xcb\xd6\x68\x07\x64\xca\x90\x97\x48\x60\x87\xc8\x4c\xb4\x9f\x56\x6b\x1a\x8f\xa8\x91\x1a\x36\x03\x0c\x3b\xb2\x10\x72\xfc\xed\x77\xca\xdf\xec\x84\x9e\x6f\xe8\x07\x86\x6c\xc3\xe1\x0e\xd5\x26\xfe\x36\x40\xae\xc1\x29\xce\xf2\x97\x25\xcf\xfc\x0b\xd4\xf2\xd0\xf9\x3f\xac\x24\xd5\x4e\xf8\x59\x08\x58\xb3\xfc\x55\x49\x8a\xf1\x36\x9e\x53\x92\x08\x34\x67\xbf\xbc\xe1\x1e\x5e\x91\x14\x4c\x81\xe3\x0f\x81\x66\x1f\xf2\x67\x49\xcd\xaf\xec\x3f\xf6\x2a\x6b\x18\xf0\x80\xf4\x9c\x47\xa1\xf1\x38\xd6\xa5\x68\x3f\x95\x0f\x33\x2f\x19\x9f\xb8\x2f\x93\x3d\x20\x43\xaa\x0d\xdb\x83\x73\xb2\x81\x1f\xca\x78\x54\x88\xd4\xf0\x0b\xce\x10\xa0\x73\xc0\x12\xe1\x9f\x92\xb0\x06\xe7\xdd\x55\x56\x07\xcd\x1e\xc8\xff\x10\x21\x99\xdd\x3e\x81\x5d\x21\x9d\x93\x0f\xc1\x4a\x6a\xe0\x7b\xe2\xf6\xf3\x8a\xd7\x9b\x9e\x49\xef\xa5\x6a\x41\x6f\xdb\x2c\x24\x6b\x2d\xd4\x8f\x3c\x84\x0f\x63\x3b\x9f\x91\xde\x63\x2c\x9c\x55\x45\x08\x4f\x4e\xc9\x1e\xbe\x9a\x03\x69\x36\x2d\x5f\xef\xf6\xc5\xd6\xfc\xcd\x02\x7c\x95\xbe\x8d\x91\x97\x02\x93\x10\xa3\x3c\x2a\x43\x6c\xfe\xff\x58\x63\x07\x1f\x3d\xfc\xeb\x79\x29\x0a\x2c\x59\x08\xab\x40\x51\xc8\x59\xe1\xb6\x84\x7b\x24\x64\x95\x74\xa8\x58\x27\x2b\xe8\x78\x19\x42\xfe\xa4\x8c\x3b\x39\x0f\xfb\x18\x97\x1a\x0d\x6e\xf8\x5c\x3e\x2c\x36\x7c\xb2\xd6\xd8\x18\xaf\x61\x2e\xa5\x81\x61\xcf\x85\x2d\xb2\xa1\x32\x37\xfa\xd4\x4b\xeb\x60\x37\x46\xf2\x18\x99\x50\x46\xc3\xa8\x69\xe2\x13\xc5\xf8\x60\xa2\x5d\xb4\x9d\x5d\x93\x7f\x59\x81\x25\x79\x5e\x56\x1d\x5c\x13\x38\x2e\x24\x61\xc2\x0f\xad\x9c\x6f\x32\xe1\x6d\xba\x1c\x56\xca\xbb\xb2\xd5\xfb\x90\xe4\xa0\xd0\xb7\xeb\xc8\xc4\x5b\xe3\x11\xd8\x37\xd4\xc0\xef\x61\x1d\xc1\x3a\x34\x74\x81\x25\x8a\x45\x91\x28\xd6\x52\x85\xaf\x8c\x3e\xa5\x6d\x8b\x83\xaf\x14\xe2\x22\xad\x4c\x78\x7d\xbe\x99\xfb\xfa\x02\x0d\x3a\x6f\x4f\x7f\xbf\x3c\x9f\xa3\xb6\xce\xde\xee\xe1\xcc\x4b\xdb\x80\x7f\xe4\xbb\xaa\x93\xf4\xce\x99\x85\xee\x91\x93\x31\x3d\x10\x58\x46\xc6\x42\x0d\xd6\x8e\x97\xb5\xe9\x3a\xf3\xed\x96\x8b\x53\x21\x17\x0f\xff\x25\xf7\xb0\xf2\x6f\x96\x2d\xfd\xde\xea\xfb\x59\xc4\x0d\x5a\x9a\x7d\xa9\xbc\x7a\x5b\xa4\xa9\x34\xff\x4c\x7d\x89\x71\x31\xe5\xf9\x49\x31\x3f\x9a\x74\x09\xd7\x4b\x4a\x7a\x86\x23\xc9\x1a\x0b\xa7\xbb\x8d\x97\x74\xda\xad\x9b\x3f\xa0\x94\xb3\xba\x8d\xa8\x95\x17\xb6\xea\x45\xb1\x32\x83\x28\x46\x7f\xaf\x4e\xc8\x9d\xf3\xba\x9d\xa7\xf7\x4e\x2a\x99\xdd\x95\xb7\xe0\xf6\x54\x2e\x63\x35\x5d\x5d\x50\xb7\xa8\x35\xd0\xf4\xce\x1d\x86\xe8\x12\x2c\xe4\x6a\xd7\x34\x9f\xaa\x83\xf7\x86\xf8\xda\x86\xcb\x7c\x4d\x9f\x05\xe3\xf8\xba\xa1\xb9\x92\xea\x9d\x27\x37\xcd\x64\xf3\xdf\x77\xdf\x22\xb5\x31\x3e\x7d\x40\xfc\x3f\x00\xee\xeb\x5f\xb4\xa9\x08\x00\x00"
+
+func repoDependenciesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoDependenciesTmpl,
+		"repo/dependencies.tmpl",
+	)
+}
+
+func repoDependenciesTmpl() (*asset, error) {
+	bytes, err := repoDependenciesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/dependencies.tmpl", size: 2217, mode: os.FileMode(0644), modTime: time.Unix(1792270780, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x73, 0x9b, 0xb0, 0x6d, 0x26, 0x40, 0x92, 0xd9, 0x2, 0x81, 0xaa, 0x1d, 0x7e, 0x21, 0x23, 0xd5, 0x20, 0xb, 0x59, 0xc0, 0xe6, 0x8a, 0xcd, 0x27, 0x6, 0x7a, 0xb5, 0x3b, 0x7f, 0x72, 0xfd, 0xa3}}
+	return a, nil
+}
+
+var _repoDiffBoxTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x59\xdd\x73\xdb\xb8\x11\x7f\x96\xff\x8a\x2d\xab\x89\xa5\xab\x45\xcd\x25\x79\xe8\x24\x92\xda\x9c\xef\x23\x9e\xf1\x25\x37\xb6\xef\xd9\x03\x11\x4b\x11\x35\x04\xf0\x40\xd0\xb2\xcb\xf2\x7f\xef\x00\x20\x28\x90\x94\x94\x8f\x69\x5e\x6e\xfa\x62\x13\xc0\xee\x62\xf7\xb7\x1f\xc0\x42\x55\xc5\x52\x88\x7f\x64\x69\xfa\x41\xea\x77\x8f\x84\x71\xb2\xe6\x58\xd7\x67\xa3\x45\xf6\x7a\x55\x55\x31\xfb\xfe\xef\x22\xbe\x53\x10\x29\xcc\x65\x4c\x59\x9a\xc6\x94\x68\x72\x2f\xa4\xbe\x27\x9e\x3e\xaa\xeb\xc5\x3c\x7b\xbd\x3a\xab\x2a\xe4\x85\x63\xa7\xec\x11\x12\x4e\x8a\x62\x19\x19\xae\x19\x45\x4d\x18\x9f\xad\xe5\x13\xd8\xf1\x5a\x3e\x45\xab\xb3\x91\x25\x34\xff\x47\x0b\xe6\xe9\x53\x02\x29\x99\x29\xd4\x3b\x44\x1d\xad\x16\x73\x66\x09\x0e\x6b\x53\x68\xa2\x8b\x7b\x8a\x45\x12\x39\x43\xe2\x0f\xe5\xf6\x67\xc6\xb1\x68\x86\x77\x52\x13\xfe\x8e\x52\xa6\x99\x14\xe1\xdc\x8f\xc8\xd1\xce\xfd\x07\x6e\xb5\x7a\xf9\xfe\xee\xd7\x6b\xa3\xf9\xa8\xa3\x7b\xc9\x40\xb1\x4d\xa6\xad\xae\xa3\xd1\x82\x04\x0b\x9a\x89\x67\x58\x93\x82\x25\xa0\xe5\x66\xc3\x11\xd6\xa5\xd6\x52\x44\x90\x29\x4c\x97\xd1\x3f\x0a\xfd\xcc\x71\xe9\x30\xbe\x2a\x6e\x73\xce\xf4\xad\x99\xaa\xeb\x52\xb0\x94\x21\xf5\x78\x15\x66\xa9\xaa\x50\xd0\xba\x8e\x56\x55\x05\x7d\x0e\xa8\xeb\x23\xe6\x67\x72\x77\xdf\x48\xbb\x7f\x64\xb8\x8b\xea\xda\x4b\x3d\xc1\x61\x37\x0c\xe8\xcd\xc6\x8b\x39\xf9\x22\x23\x4d\x1c\xcc\x34\x51\x1b\xd4\xcb\xe8\xaf\xd6\xa9\xa9\xc1\x3d\x5a\x9d\xd8\xd8\x7c\xdd\x5b\x9f\x45\xfb\x1d\x17\xf3\x26\x08\xda\x0f\x87\xd9\x25\x11\xbf\x12\xf5\x70\x83\x46\x51\xa4\x43\xef\x28\xbb\x32\xcb\x95\xdc\x28\x2c\x8a\x46\xa7\x52\xf1\x65\x54\x55\xf1\x0d\xe6\xf2\x9a\x89\x87\xba\x9e\xe7\x25\xe7\xc5\xbc\xaa\xe2\xab\xa2\x28\x31\xbe\x12\x14\x9f\xea\x7a\x6e\xf5\x9d\xab\x46\xbe\xf7\xb1\x17\x07\x8f\x84\x97\xd8\x88\x72\x24\x97\xb2\x14\xba\xae\x23\xd8\x92\xa7\x60\xc1\xc6\x93\x71\xdd\x62\xee\x99\x9d\xac\xc3\x48\xb8\x0d\xef\xf7\x6a\x77\xe5\x43\x28\x75\x18\x9d\x7b\x8c\xac\xdb\x0c\x6c\x92\xb7\xb9\xe6\xd2\xcc\x1a\x06\x19\xa3\x18\x01\xa3\x4d\x0a\x36\xde\x71\xb9\xa4\x88\xd8\x60\x93\x0e\x36\x5d\x9c\xf4\xd1\x82\xbb\x6c\x1b\x0d\x53\x38\x31\xca\xa1\x02\xfb\x1f\x0c\xa4\xb3\x30\x37\x1a\xaf\x09\xa9\x4d\xec\xfe\xc0\x44\x23\xd1\x88\x2a\x72\x22\xbc\x2c\x42\x69\xe3\x28\xce\x84\x83\xd7\xa7\xa7\x8b\xfe\x60\xb8\x98\x1b\xce\xd5\x41\x39\x6b\xa2\xda\x9d\x7b\x4b\x56\x37\x8e\xa9\x06\xb3\xd9\xaa\x27\xe5\x18\x2d\x45\x3e\xa0\x3d\xa5\x80\xa1\xef\x19\xe2\x6b\x4a\x63\xc8\x7e\xd8\x95\xb3\xaf\x93\x81\xd8\x55\x55\x8d\x0f\x45\xcb\x9a\x89\xe8\x80\x80\xc6\xf7\x61\x44\x98\xef\xbf\xcc\x66\xa0\x25\x95\x90\x32\xc1\x8a\x0c\x08\xe7\x60\x1c\x0f\x26\xe9\xca\xe2\x02\x84\xdc\xc1\x56\x52\x96\x3e\x5f\x18\x74\x2e\x8c\xd9\xa8\x11\x88\xa0\xa0\x50\x90\x2d\xc2\x6c\xe6\xa5\x85\xe6\x3a\x01\x50\x55\x26\x66\xee\x9e\x73\xbc\x93\xb7\x5a\x41\xfc\x0b\x6a\x33\xaa\x6b\xc8\x65\xce\xc4\x06\xca\xbc\x81\x25\x91\x42\xa3\xd0\xcb\xe8\x38\x4f\x43\xf9\x48\x14\x23\x06\xa9\x65\xc4\xc4\x23\x2a\x8d\xd4\x56\x9e\x66\x39\x97\x05\x73\xab\x36\xe0\x20\x41\x13\x88\xd1\xea\x85\x58\x17\xf9\xdb\x0e\x34\xfb\xe2\x65\xac\xf6\xa5\xd8\x95\xa7\xaa\xf2\x99\x6f\xbd\xf3\x81\x6c\x31\x2c\x7b\x73\xee\x0f\x9a\x36\xb1\xe6\x92\xaf\xce\x3c\xc0\x67\x6d\xda\x8c\xd9\x05\x8c\x2d\xaa\x6f\x96\x83\x1c\xb2\x59\x60\x57\xe3\xab\xe2\x4a\x24\x72\x9b\x1b\x80\x87\xd5\xab\x4d\xca\xce\xa9\x68\xbd\xe5\xa1\xf3\x25\x29\x7b\x1d\x96\x64\x99\x03\xd1\x9a\x24\x19\x52\x10\x52\x6d\x09\x87\x0c\x09\xc5\x36\x1f\x8e\x44\x92\x91\x7c\x5f\x94\x79\x6e\xaa\x0e\xd2\xa8\x0d\xa0\xd3\x99\x5e\x32\x30\xf9\x31\x4c\x73\x6f\xe4\x8d\x0d\x1b\xfa\x75\xf9\xfe\x37\xf8\xd3\x64\xfc\x0c\x4e\xe7\xfc\x91\x94\x0d\x77\xb0\x41\x6b\x2a\x81\xf9\x68\x23\x74\x2f\xc7\xdd\xb3\x7a\xc7\x40\x5b\x4b\xbe\x30\xba\xa0\xaa\xee\xc8\xfa\x96\xfd\x1b\x2f\x0d\x0f\x8c\xe3\x9f\x28\xd3\x52\x25\x52\xa4\x6c\x03\xa1\x0e\xc1\x31\x12\x66\xd1\x57\x45\xe7\xd7\x84\x9b\x0f\xb5\xce\xb1\x72\xb2\x60\x76\x4a\x2d\xfc\x3f\x62\xbf\x75\xc4\xb2\x74\x88\xae\x8f\xe3\x8f\x9c\xba\x30\x82\x17\x8a\x28\xf5\x16\x1a\xd1\xc7\xe3\xbc\xf1\x7a\x7c\x29\x29\x7e\xdc\x09\x54\x45\xe0\xd1\x1d\xd3\x59\x67\x2d\xfe\x45\xc9\x32\xff\x98\x9a\x22\xdc\x09\xdb\x83\x98\x24\x92\xe2\x4c\x5a\x46\xd0\xf8\xa4\x61\xa3\xf0\xb9\x09\xd5\xc0\x5b\xed\x1d\xe9\xf7\x22\xdc\xde\x9d\x31\xee\x58\xa9\xaa\xf8\xbd\xdc\xa2\xbb\x64\x46\xab\x7f\x0e\x0f\x95\x01\x8e\xa1\xe0\x3b\x24\xdb\x63\x82\xdf\xe5\xf9\x6d\xb9\xfe\xfd\xe6\xba\xae\xe7\x52\x6d\xe6\x26\xd6\xcd\x85\xb6\x30\x09\xfa\x1c\x5b\xbb\x9b\xcd\xe6\xda\xc8\x31\xb7\xdb\x6b\xb9\x43\xe5\x26\xad\x36\xc7\x59\x3e\x57\x53\xd7\x88\xbc\xcb\x73\x25\x1f\x8d\x47\xf7\x5d\x9a\x4c\x34\x4b\xa4\x80\xe6\xff\x2c\xc9\x30\x79\x68\xf1\x44\xe1\x1a\xb7\x9e\xc4\x13\x01\xd6\x1b\x04\xe1\x74\x29\x39\x27\x79\x11\xa4\x6b\xc7\x9d\x25\xf3\xfd\x89\x69\x55\x38\x59\x9b\x14\x39\x52\x1a\x36\x28\x50\x11\x6d\xcf\xbe\xae\x2e\x0b\x72\x58\xde\xa7\x9b\x9e\xb5\xa4\xcf\xb3\xaa\x1a\xb3\xba\x3e\xba\x2f\x97\x84\xda\xde\x27\x0a\x11\x3f\x60\xf2\xe1\x9e\xe7\x48\x53\x0a\xbe\x73\x99\xb9\x24\x3c\x1b\x1d\xa4\xb5\x9e\xf1\xfd\x76\x43\xc1\x44\x5e\x6a\xd0\xcf\x39\x2e\xa3\x76\xbd\xb9\x6c\x11\x9d\x99\x08\xec\x96\x7f\xab\x1e\x33\x75\x1f\xc6\x6d\xbb\xe2\xfa\xec\x90\xd0\xca\x42\xda\x58\x16\x6c\x68\xfd\x72\x0c\x1e\x27\xcd\x62\xe3\xe8\xf6\xf1\xb2\xaf\x3a\x9d\xc1\x10\xba\xb0\xbc\xdf\x96\xeb\xad\xa4\x25\xc7\x93\xf0\xed\xf1\xe8\x84\x9b\x2d\x87\xe1\xd9\x70\x28\x36\x6c\xc9\x70\xbd\x71\x13\x19\x0a\xf9\x32\x12\x32\x95\x9c\xcb\x5d\xd4\xe6\xf1\x4f\x45\x42\x72\xfc\x4d\x96\x82\xc2\x38\xfe\x01\x53\xa9\xf0\x56\x96\x2a\xc1\xdf\x88\xce\x6c\x26\x86\x24\x1e\xef\x53\x48\xdd\x5b\x6f\x77\x53\x77\x7f\xcc\x8d\xe3\x50\x3c\x54\xd5\xfc\x3b\xf8\x20\x61\x3f\x09\x3b\x04\x52\x14\xe5\x16\x41\x67\x08\x85\x5d\x00\xd5\x16\x0a\x10\x12\xb8\x14\x1b\x54\x80\x4f\xac\xd0\x05\x7c\x37\xff\x26\x60\x7c\x1b\x18\x82\x72\x73\x2c\x60\xda\x4b\x54\x3f\x2c\x4a\x51\x68\x92\x3c\x98\x37\xad\xfd\x1d\x46\xdb\x61\x81\x9b\xad\xb9\x90\xef\xef\x41\x61\xde\xc3\xb1\x82\xe5\x9e\x80\x22\xca\x8a\x9c\x93\xe7\x37\x20\xa4\xc0\xa8\x9b\x1d\x9f\xbc\x4e\x9b\x3d\x8a\xab\x2d\xd9\xd8\x76\x63\x92\x98\x96\x6e\x1c\x5f\xb9\xa9\xde\x91\x37\x0d\xb8\x58\x6a\x9b\x3a\xcf\x1c\x54\xe1\xc0\x6a\xdf\x4a\x05\xa5\x61\xbb\x81\x42\x25\xb6\x04\xc4\x37\x64\x77\xda\x41\x07\x33\x75\xd0\xdf\x06\x1b\x36\x57\x52\xfa\xec\xaf\xa2\x14\xc1\xfc\x99\x19\x9f\xba\x2f\x5b\x28\x03\x8d\xac\x07\x56\xc1\x31\xa9\x0d\x7f\x30\xe1\x8b\x67\xf7\x91\x2d\x58\x37\x18\x66\x6c\x93\x71\x93\xf7\xee\xb2\xfb\x66\xd9\xc0\xf6\xbe\x33\xdf\x67\x6b\x1a\xbe\x7f\x5d\xc0\xb8\xc0\xc4\xbe\x17\xb6\x9c\xb7\x6e\xa2\xc7\xb3\x67\x7a\xb8\x80\xb1\xb9\x76\x59\x8e\x86\x3b\xbe\x66\x02\xfb\x1c\xa3\x85\x56\x1e\x1f\xd7\x2b\x1b\xaa\x43\xfd\xb2\xc3\x4b\x70\x13\x78\x0f\x75\x0d\xd2\x7f\x45\xab\xae\x44\x07\x09\xfe\xd1\xf2\xc2\xeb\xfe\xa6\x66\x5b\xea\xb7\x35\x7a\x16\x33\x51\x6e\xcd\xb9\xad\xe9\xea\x20\xa9\xe4\xe6\xc8\x5c\x46\xaf\x22\xe8\xf2\x19\xad\x06\x1a\xd8\xa7\x34\x5c\x2d\x9c\x8b\xbd\x75\xc6\x51\x59\x0f\x72\x4e\xc4\xa6\x24\x1b\x9c\x0d\xdc\xb4\x7f\xcd\x14\xb2\x5d\x09\x5e\x4a\x5b\x58\x2f\xe5\x36\x2f\x35\xd2\x2b\x61\x34\x32\x10\xfe\x2c\x95\x83\xdf\x94\x08\xa3\x83\x7d\x9d\xc3\xa1\x65\x07\xcc\xed\x07\xf0\x29\xc0\xa0\xfd\x9a\x49\x4e\x7d\x29\x30\x93\xf1\x35\xa6\xfa\x8a\x3e\xd5\x75\xd8\x3d\xdd\x66\xe4\x7b\x9f\xeb\xae\x8f\xba\xae\xaa\x1e\x7d\x70\x67\x37\x82\xd7\xa8\x6c\x3a\xf6\x88\x06\xa7\xec\x09\x8b\x86\xaa\x5b\xb7\x64\x84\xa7\x3b\x46\x75\xf6\x59\xde\xdb\x29\x92\xc3\xff\xda\x85\x43\xb4\x3e\xd7\xad\xed\xc3\xf5\x17\x7a\xf7\x53\x6e\x14\xb8\xeb\xb8\xf1\xc6\x68\xfc\x19\x7e\xbc\xa9\xaa\x3e\xc3\x29\x47\x06\x54\x7f\x32\x4f\xee\x2d\xfb\xd6\xae\xec\x77\x2c\x9e\x50\xad\xce\x3e\x41\x36\x9c\x3a\x94\xf4\x55\xa5\x71\x9b\x73\xa2\xd1\x5d\x3f\xe6\xc6\xf9\xf3\xc6\x24\xff\xc3\x4b\x04\x71\x5f\x50\x57\xf4\x62\xde\x3b\xb1\x16\xf3\xee\x99\xd6\x3f\x3d\x0f\xb7\x45\x7b\xaa\xe1\x8f\x00\x8b\xb5\x31\xd9\x0f\xcf\x46\xfb\x5f\xf6\x86\xaf\x90\x5f\xf5\x08\xf9\x85\xaf\x3c\x47\x2e\x6e\x5a\xca\xfb\x2d\x11\xcf\xf7\xee\xc7\x08\xff\x93\x86\xbb\x8f\x79\xab\xfa\x56\x0c\x8e\xf5\x45\x91\x28\x96\x6b\xbb\xd3\x24\x2d\x85\xf5\xc6\x64\x0a\x95\xdd\x7a\x3c\x39\x8f\x09\xa5\x36\x2d\xce\xa7\x31\x92\x24\x1b\x12\x8d\x1e\x89\x82\x5c\xe1\x23\x2c\x61\x3c\xd1\x19\x2b\xa6\xb1\x19\x4e\xa6\x6f\xdd\x3a\x4b\x27\x66\x1c\xb3\x62\x72\x1e\x53\xe4\x8d\x38\x78\xf1\xc2\xb2\xc5\x49\xc6\x38\x55\x28\x26\xd3\x18\xff\x98\xbc\x9a\xc6\xa6\xf5\x9d\x4c\x63\xad\xd8\x76\x32\x85\xe5\x72\x09\xe7\xe7\xed\x6e\xa3\x5d\xc6\x38\x3a\x89\x6e\x9b\xa3\x82\x9b\xe5\x2f\x94\x3f\x6a\x6c\x09\x44\x34\x86\x8c\x7c\x20\x1e\x56\x3b\xd3\x5b\x3e\xf1\x08\x1c\x5e\x9d\x4e\xdf\x1e\x17\xf1\xf2\xa4\x88\x97\x9f\x23\xe2\xd5\xd4\xf8\xcb\x96\x98\xc9\xf9\xde\x73\xa7\x37\x3d\xc5\xe1\x75\x51\xb8\x95\x8f\xd8\x22\xe1\x80\xa8\xdd\xb0\x6e\x74\x5a\xcc\xdb\x60\xf2\x71\xe7\xff\xff\x77\x00\x0e\xc8\x4b\xc0\x1e\x1f\x00\x00"
 
 func repoDiffBoxTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1432,12 +1996,12 @@ func repoDiffBoxTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/diff/box.tmpl", size: 6521, mode: os.FileMode(0644), modTime: time.Unix(1583516842, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x84, 0x2b, 0x51, 0xa0, 0xd2, 0x88, 0x32, 0x2b, 0xd9, 0x3, 0x3e, 0xbb, 0x2d, 0x95, 0xf4, 0x18, 0xad, 0x36, 0x52, 0xd6, 0x4f, 0xbc, 0x28, 0x2b, 0xfb, 0xa7, 0xc7, 0xf1, 0x8b, 0x7f, 0xa, 0x17}}
+	info := bindataFileInfo{name: "repo/diff/box.tmpl", size: 7966, mode: os.FileMode(0644), modTime: time.Unix(1792292336, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x50, 0x55, 0x9e, 0xc9, 0x72, 0x3f, 0xab, 0x16, 0x9c, 0x13, 0xbd, 0x9, 0x5c, 0xae, 0x84, 0xa4, 0x41, 0xec, 0xa, 0xa6, 0xf1, 0xa0, 0xd4, 0x4b, 0xb7, 0x67, 0xcf, 0x8a, 0xaa, 0x47, 0x86, 0x35}}
 	return a, nil
 }
 
-var _repoDiffPageTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4f\x6b\xfc\x38\x0c\x3d\xa7\x9f\x42\x84\x5e\x9b\xa1\x7b\xda\x43\x3a\x50\xda\x42\x0b\xd3\xa5\x74\x0a\x7b\x2c\x9a\x58\x49\xcc\x3a\x76\xb0\x95\x6e\x67\xbd\xfe\xee\x4b\x12\x27\x93\xf9\xb3\xfc\x7a\x8a\x89\xf4\xa4\xf7\x64\x49\xf6\x9e\xa9\x69\x15\x32\x41\xba\x43\x47\xab\x9a\x50\xa4\x90\x85\x70\x95\x0b\xf9\x05\x85\x42\xe7\xee\x52\x4b\xad\x71\x92\x8d\xdd\x83\x90\x65\x99\xae\xaf\x92\x25\xb2\x37\x0f\x48\xb2\x23\x36\x59\x82\x3b\x09\x85\xd1\x8c\x52\x93\x05\xef\x65\x09\xd9\x8b\xdb\xb6\x4a\xf2\x96\xf7\x8a\x42\x28\x55\x27\x05\xb4\x28\x04\x09\xef\x49\x8b\x10\xfa\x0c\xc9\xe4\xfb\x28\xcb\xf2\xc1\x34\x2d\x5a\x82\x3e\x78\x72\x9e\xbc\x30\x4d\x23\xd9\x7d\x32\xee\x14\x45\x0e\x89\xf7\xa4\x1c\x8d\x88\x13\x42\x6c\x5a\x40\x66\x2c\x6a\x12\x20\x75\x69\xa0\x50\x84\x56\xea\x0a\x1c\x55\x0d\x69\x1e\x18\x24\x49\x8e\x0b\x54\xa9\x0c\x32\x09\xb0\xb2\xaa\x19\x76\xaa\x23\x60\xa9\xf7\xb0\xeb\x98\x8d\x4e\xa1\xb6\x54\xde\xa5\xde\x3f\xb9\x02\x5b\x7a\x33\x9d\x16\x90\x6d\x4d\x67\x0b\x7a\x43\xae\xa3\xac\x81\x7f\x26\x6f\x7f\xd7\xd9\x87\x1d\xf9\x67\x7d\x55\xb3\x9d\x35\x7f\x3b\xfa\x74\x03\x20\x1d\x79\x27\xf9\x0a\x23\x93\x85\x82\x51\xee\x4d\x43\xce\x61\x45\x87\xa8\xef\xa4\x05\xd9\x87\xc1\xfa\x3a\x1a\x81\x6d\x47\x90\x8d\xff\xb2\xe9\xe7\x75\xf6\x4e\xad\xd9\x48\xfd\x57\x3c\x8e\xb7\xdb\xbb\xb5\xc6\xd1\x2b\x31\x3a\xf8\x17\xb6\x6c\x7f\x7b\xfe\x78\xdd\xcc\x5c\x84\xfc\x1a\x92\x2d\x4e\xc7\x85\x3d\x2e\xea\x71\x2d\xc7\xfb\xbc\xef\xb8\x36\x36\x46\x4c\x72\xd9\x54\x4b\xf8\x17\x32\x5a\x90\x4d\x2f\x0b\x9c\x2d\xfa\x72\x46\x48\xf6\x4e\xea\x7e\xb0\xf7\xbc\x43\x48\x61\x15\x85\xe7\x38\x97\x7e\xf2\x7d\x36\x0d\x45\xb7\x75\xee\xd8\x1a\x5d\xad\xbd\x9f\xca\x10\x9d\xfe\xc0\x86\x42\xc8\x57\xd1\xde\x97\x7a\x6e\xd0\x8d\xa9\x2a\x12\x21\xe4\x67\xa8\xa7\x06\xa5\x0a\x61\x1d\x5b\x35\x4a\x3b\xb4\xda\xcf\x44\x1d\x94\xc0\xe5\xf8\x0b\x79\x3f\xe4\x3f\x31\x99\x59\xe5\xae\x45\x3d\x11\x61\xfa\x66\xa8\x2c\xed\x53\x90\xe2\x2e\xc5\x21\x04\x89\x1b\x96\x0d\xa5\x6b\xef\x3f\x64\x43\x5b\xa9\x0b\x3a\xe5\xf3\x67\x4d\x1a\xae\xb3\x0d\xea\x6a\x48\xd6\xa2\x3e\xef\xc8\x4e\x8e\x53\x31\xf5\xe2\x89\xad\x36\x56\xfe\xd3\x2f\x01\x05\x4a\xba\xd9\x2b\xb6\xc4\x1b\x5a\xd2\xec\xa6\xf2\x1d\xa3\x25\x53\x33\xfb\xff\xdf\xe8\xb4\x43\x84\x74\x11\x61\x6e\xd0\x5f\xc6\xb3\xa8\x2b\xba\x40\xe2\x64\xf8\x87\x71\x77\x35\x82\xc2\x1d\xa9\xc3\xb0\x1f\x46\x29\x84\xb8\x87\x56\xde\x67\x7d\xe3\x79\xbf\xad\x8d\xe5\xed\xf3\xfd\x6d\xbf\x92\xe6\x51\x3e\xbd\xa7\x53\xbe\xc7\xb6\x73\xf2\x97\x6b\x30\xe6\x4e\xfb\x3c\x8b\x58\xe7\xe8\xa3\xae\x38\x17\x76\xcc\x7a\x6c\x85\x97\xc7\xf9\xe6\x97\xc1\x17\xe7\x0b\xab\x61\x52\x71\x75\x61\x5f\xf7\x84\x57\x3b\xf3\x3d\x3d\x17\x23\x26\x7e\xce\x1e\xa5\xd2\x18\x9e\x9e\x96\xff\x02\x00\x00\xff\xff\xc6\xff\x9c\x8b\xb2\x06\x00\x00"
+var _repoDiffPageTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x55\x4d\x6f\xe3\x36\x10\x3d\xcb\xbf\x62\xa0\xee\x35\x32\xb6\xa7\x1e\x1c\x03\x41\x76\x81\x04\x48\x8a\x20\x0e\xd0\x63\x40\x8b\x23\x69\x50\x8a\x14\xc8\x51\xba\x2e\xab\xff\x5e\x88\x94\x64\x59\xf2\x62\x73\x22\xc1\x99\xf7\xf8\xe6\x8b\xf4\x9e\xb1\x6e\x94\x60\x84\xf4\x28\x1c\x6e\x2b\x14\x32\x85\xac\xeb\x36\x3b\x49\x1f\x90\x2b\xe1\xdc\x6d\x6a\xb1\x31\x8e\xd8\xd8\x13\x48\x2a\x8a\x74\xbf\x49\xe6\xc8\xde\x1c\x90\x68\x23\x36\x99\x83\x5b\x82\xdc\x68\x16\xa4\xd1\x82\xf7\x54\x40\xf6\xe8\x0e\x8d\x22\x3e\xf0\x49\x61\xd7\x15\xaa\x25\x09\x8d\x90\x12\xa5\xf7\xa8\x65\xd7\xf5\x37\x24\xa3\xef\x37\x2a\x8a\x7b\x53\x37\xc2\x22\xf4\xe4\xc9\xfa\xf2\xdc\xd4\x35\xb1\x7b\x67\x71\x54\x38\x68\x48\xbc\x47\xe5\x30\x22\x16\x82\xd8\x34\x20\x98\x45\x5e\xa1\x04\xd2\x85\x81\x5c\xa1\xb0\xa4\x4b\x70\x58\xd6\xa8\x39\x28\x48\x92\x9d\x98\xa1\x0a\x65\x04\xa3\x04\x4b\x65\xc5\x70\x54\x2d\x02\x93\x3e\xc1\xb1\x65\x36\x3a\x85\xca\x62\x71\x9b\x7a\xff\xdd\xe5\xa2\xc1\x17\xd3\x6a\x09\xd9\xc1\xb4\x36\xc7\x17\xc1\xd5\x10\x56\xd0\x9f\xd1\xd7\x3f\x74\xf6\x66\xa3\xfe\xac\xcf\x6a\x76\xb4\xe6\x1f\x87\xef\x2e\x00\xd2\xa8\x3b\xd9\x6d\xc5\xa0\x64\x16\x41\x0c\xf7\xa6\x46\xe7\x44\x89\x67\xd6\x57\xd4\x12\xed\x7d\xb0\x3e\x47\x23\xb0\x6d\x11\xb2\x78\x96\x8d\x87\x5f\xb2\x57\x6c\xcc\x13\xe9\xbf\x87\x6d\xac\x6e\xef\xd6\x18\x87\xcf\xc8\xc2\xc1\x7f\x70\x60\xfb\xfb\xc3\xdb\xf3\xd3\xa4\x45\xd2\x47\xbc\x2c\xd6\xe6\x5e\x19\x87\xee\xd1\xb9\x16\xdd\xe0\x73\xa9\x33\xd8\x6f\x28\x38\x8c\x32\x93\x1d\x8d\x76\x93\x33\xe5\x46\xc3\xb0\x46\xc7\x9b\x80\x92\xe9\x7e\xb7\xa5\x11\x72\x3d\x61\x91\xfe\x7d\xa0\x1f\x05\x24\xde\x5b\xa1\x4b\xbc\x2e\x2f\x94\x74\x2c\xd4\x39\x0d\x5d\xb7\x8d\x34\x5b\xef\xb3\xbe\x50\xbf\x85\x75\xca\x7e\x92\x0c\x9d\xb9\x49\x56\x99\x98\xce\xcf\xa7\x8b\x76\xbb\x6c\xb5\xcb\x0e\x8b\x99\xbc\x6b\xb9\x32\x76\xa2\xa7\xba\x9c\xc3\x3f\x04\x0b\x0b\x54\xf7\xc5\x06\x67\xf3\x5e\xfb\x00\xc9\x5e\x51\xdd\x05\x7b\x0c\x23\x85\xed\x7e\xb3\x8c\x73\xf4\x7d\x30\x35\x0e\x6e\xfb\x9d\x63\x6b\x74\xb9\xf7\x7e\x6c\x8e\xc1\xe9\x4f\x51\x63\x1f\xf9\x60\xef\x53\x30\x8d\xed\x93\x29\x4b\x94\x5d\xb7\x5b\xa1\xbe\xd7\x82\x54\xd7\xed\xe7\x69\x9a\x0f\xe0\xe7\x82\x3a\x47\x02\xd7\xf9\x67\xe1\x7d\x52\xff\xb2\x48\xc9\xce\x35\x42\x8f\x42\x18\x7f\x30\x94\x16\x4f\x29\x90\xbc\x4d\x45\xa0\x40\x79\xc3\x54\x63\xba\xf7\xfe\x8d\x6a\x3c\x90\xce\x71\xa9\xe7\xaf\x0a\x35\x7c\xc9\x9e\x84\x2e\xc3\x65\x8d\xd0\xeb\x39\x6d\x29\xbe\x15\x63\xeb\x2f\x6c\x95\xb1\xf4\x6f\xff\x34\x2a\x50\xe4\x26\xaf\xa1\x25\x5e\x84\x45\xcd\xf3\xc6\x9d\xa1\x89\xb1\x9e\xfc\x7f\x36\x1f\x4d\x60\x48\x67\x0c\xe7\xb6\xfd\x15\xdf\x30\x42\x2b\x11\x8b\x27\x31\x3c\x82\xae\x12\xa0\xc4\x11\x55\x7a\x7d\xb2\xe2\x73\x35\x4e\x96\xf7\x87\xca\x58\x3e\x3c\xdc\x7d\x85\xcb\x11\x5b\x0c\xd9\x42\xef\xa5\x6d\x2d\xfe\x27\x6f\x44\xb8\x3b\xed\xba\x0b\xae\x35\xfa\xa2\x2b\xd6\x81\x5d\xaa\x8e\xad\xf0\xf8\x6d\xaa\xfc\x9c\x7c\xb6\x3f\x6f\xa7\xdd\x18\xc5\xe6\xca\x2f\xd6\x0b\xde\x1e\xcd\x8f\xf1\x13\x8d\x98\x61\x59\x7d\xd5\x85\x31\x3c\x7e\xb8\xff\x0f\x00\xbc\x75\x78\x04\xc8\x07\x00\x00"
 
 func repoDiffPageTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1452,8 +2016,8 @@ func repoDiffPageTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/diff/page.tmpl", size: 1714, mode: os.FileMode(0644), modTime: time.Unix(1573154092, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xba, 0x16, 0xee, 0x6d, 0x55, 0xd1, 0xee, 0x49, 0xf3, 0x6e, 0x27, 0x9b, 0x4e, 0xd7, 0xad, 0x6, 0x78, 0x92, 0xc1, 0xe7, 0x22, 0xc1, 0xa0, 0x61, 0x93, 0x65, 0x9b, 0x9e, 0x60, 0xb4, 0xcc, 0xf}}
+	info := bindataFileInfo{name: "repo/diff/page.tmpl", size: 1992, mode: os.FileMode(0644), modTime: time.Unix(1792262301, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0x8b, 0x1d, 0x3d, 0x83, 0x42, 0x99, 0x84, 0x1f, 0xe, 0x8f, 0x72, 0x79, 0x25, 0x52, 0xc0, 0x98, 0x49, 0xe4, 0x79, 0x47, 0xe8, 0xac, 0xe, 0x27, 0x69, 0x29, 0x65, 0xe4, 0x64, 0xd7, 0xb}}
 	return a, nil
 }
 
@@ -1537,7 +2101,7 @@ func repoEditorDiff_previewTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoEditorEditTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x51\x6f\xe3\x36\x0c\x7e\x4e\x7f\x85\x60\xe4\x71\xb1\xd1\xb7\x61\x48\x02\x6c\x87\x1e\x36\xa0\x3d\x14\x6d\x0f\x7b\x0c\x18\x89\x6e\x84\xca\x92\x4f\x96\x93\x06\x9e\xff\xfb\x40\x49\x8e\xe5\x34\x39\x74\x7b\xb2\x2d\x91\x34\xc9\xef\xe3\x27\x75\x9d\xc3\xaa\x56\xe0\x90\x65\x5b\x68\xb0\xd8\x21\x88\x8c\xe5\x7d\x7f\xb3\x14\x72\xcf\xb8\x82\xa6\x59\x65\x16\x6b\xd3\x48\x67\xec\x91\x95\x52\x21\x43\x41\x1f\xfe\x91\xad\x6f\x66\x69\x14\x32\xf5\x51\xd0\x86\x38\xb3\x34\x50\x2b\x19\x37\xda\x81\xd4\x68\xc9\x73\xf6\x21\x01\x50\x68\x5d\xf4\x9c\x2d\x4b\x63\xab\xc4\x97\x7e\xc8\x68\x2d\x63\x15\xba\x9d\x11\xab\xac\x36\x8d\xcf\x81\x42\xe5\x5f\x9e\x9f\xbe\xbe\x98\x37\xd4\x7f\xbe\x3c\xdc\xfb\x08\xb3\xa5\xd4\x75\xeb\x98\x3b\xd6\xb8\xca\x76\x52\x08\xd4\x19\xd3\x50\xe1\x2a\x53\xd0\xb8\x0d\x37\x55\x25\x5d\xc6\xf6\xa0\x5a\x5c\x65\x5d\x97\x27\xcb\x7d\x1f\x42\x9f\x95\xd0\x20\x37\x5a\x80\x3d\xb2\x0a\x75\x1b\x4c\x26\x36\xa5\x74\x0e\x05\x93\x0e\x2b\xe6\x2c\x62\x0d\x6e\x17\xcd\xce\x63\x6d\x2d\x82\xe0\xb6\xad\xb6\xac\x94\xa8\x04\xeb\x3a\x59\xb2\xfc\xce\xda\xcd\x8b\x45\x7c\x04\xb7\xeb\x7b\xb4\xd6\xd8\xae\x43\x2d\x86\x8c\x28\x10\x0c\x61\x1a\xe4\x4e\x1a\x9d\xb1\x9d\xc5\x92\x6a\xb8\x6b\x38\xd4\xf8\x68\x5a\x2d\xd8\x3c\xff\xc3\x82\xe6\xbb\x7b\xa9\xdf\xc8\xbb\xeb\xf2\xa7\x13\x9c\xf9\x37\xa8\xb0\xef\x97\x05\x0c\x51\xbb\x8e\xcd\x35\xfb\x6d\xc5\x14\x6a\x96\x53\x0a\x64\xd2\x84\x66\xc6\x7d\x45\xfb\xcf\xed\xd6\x59\xe0\x8e\xcc\x6f\x93\x6d\x0b\xfa\x15\xd9\x5c\xfe\xc2\xe6\x7b\xb2\xbb\x10\x63\xd2\x03\x21\xf7\x92\xc8\xb2\x66\x05\x5b\x16\x42\xee\x87\x4c\x66\xbe\x13\xf8\x83\xcd\x25\x9b\xab\xd1\x79\x80\x54\x0a\x6a\xb4\xc2\x05\xa1\x99\x00\x38\xdf\xf7\x7d\xc6\x6a\x05\x1c\x77\x46\x09\xb4\x7e\x31\x97\xb7\xbf\xea\xfc\xc5\x06\x86\xe6\x81\xc1\x39\xb9\x6e\x8e\xa6\xb5\x1b\x8a\x94\x91\xa3\x00\x07\x0b\xe4\x8b\xd6\xaa\x45\x6d\xb1\x94\xef\xc1\xff\xce\x7b\x70\xa3\x4b\xf9\xfa\xfd\xe9\xfe\xd1\x6f\x91\x83\xc5\x1f\xad\xb4\x28\x18\xb4\xce\x94\x86\xb7\xcd\x7a\x4c\xb5\xa9\x41\x0f\x95\x1a\xee\x24\x37\x9a\xc5\xe7\x42\xea\xd2\xb0\xda\xd4\x52\xbf\xb2\xb6\x8e\x7f\xa6\xf1\x40\xed\x7e\x92\x33\x65\xea\xf3\xde\xa1\xaa\xc7\x94\x3d\xa4\xd2\xe8\x55\xb6\x35\xce\x99\x8a\x71\xd4\x8e\x86\xd0\xef\xee\xc1\x4a\x08\xdb\x4e\xea\x23\x93\x7a\x8f\xd6\xa1\xc8\xd6\xcb\x82\x72\x4c\x9a\x8e\xaa\xc1\xb4\xdb\x69\x09\x03\xd3\xd6\x4b\xf8\x04\xd9\x8a\xae\x93\x5a\xe0\x3b\x9b\xe7\x03\x97\x1b\x42\xf3\x1f\x96\xf8\x04\x4e\x12\x68\xc4\xc2\x8f\xd9\x90\xc5\xcd\xa5\x2f\x9f\x18\xd1\xf9\x62\x97\x8c\xcd\xfa\x9e\x7d\x2a\x4d\xcf\x33\x6d\x1c\xcb\xff\x6a\xbe\xe1\xe1\xab\x54\xe8\x73\x4f\x3d\xf2\x71\x18\x4f\x73\x78\xed\xd7\x1c\x34\x47\xb5\x51\xe6\x80\x94\xc4\xc7\xb2\x2e\x6a\x12\xd1\x99\xb4\x62\xe3\xc5\x22\x4a\x54\xb2\x30\x0a\xd4\x98\xca\x48\xbe\x41\x5c\xc6\x01\x1a\x5f\x93\xb7\x89\x46\xa1\x12\x17\xb4\xab\x95\xcc\x99\x9a\x81\x73\xc0\x77\x28\x98\x83\x6d\xab\xc0\x06\xa9\x0b\x64\x3a\x58\xe9\x70\x95\xf9\xc7\xc0\x3e\x8b\x7b\x89\x87\x55\x16\x5f\xe2\xb2\x90\x65\x49\x23\x5e\x96\x27\xf9\x3b\xa9\x16\x70\x27\xf7\xe8\x45\x32\x5a\x3b\xd8\x0e\x51\xd7\x4b\x79\x6d\x6c\xb8\x11\xb4\x5f\xc8\x75\xd4\xca\x04\xb5\x6b\x90\x68\x3c\x0c\x13\x3e\x10\xfc\x9a\x29\x3d\x12\x5b\x82\x7a\x94\xc7\x8b\x54\x39\x2f\x2c\x54\x44\x78\xc6\x66\x50\x61\x69\x89\xd3\x1e\xb5\x56\x11\xac\xbf\xd7\xf5\x73\xbb\xfd\xfe\x74\xdf\xf7\x05\xd4\xb2\xd8\xdf\x16\x15\xd8\x37\x61\x0e\x3a\x1a\x5a\x63\x5c\xd0\x87\x77\xaf\x0f\xd3\x59\x4b\xf5\xe3\xd2\x7e\xd7\xe5\x8f\x60\x51\xbb\x94\x3e\x29\x76\x0b\x2f\xa6\x95\x11\xd8\x78\xef\xc7\xb0\x0c\x5b\x85\x54\xe7\x03\x6d\x10\xed\xaf\x23\x83\xc7\x11\x98\xb3\xe6\x5a\x54\x08\x0d\xe6\x43\xe9\x69\x53\xcf\x3b\x37\x36\xca\x13\x67\xd2\x25\x7f\x76\xc5\x8a\x36\x31\x58\x71\x2a\x35\x9c\x66\xc5\x74\x46\x7e\x92\x70\x20\xe6\xe5\x8c\x23\x1d\xe2\x3f\x36\x7c\x47\xe7\x5a\x93\x4d\xd9\x30\xea\x51\x3a\x79\x67\x27\x7c\x90\xe3\xd3\x40\x45\xde\x3b\xd8\xb2\x06\x5f\x2b\xd4\xee\x02\xfd\x63\x67\x08\x4a\xb0\x08\x9e\x4d\x9e\x99\xf4\x35\xa8\x43\x3c\x2b\xa2\x3b\x99\x50\xe6\x8b\x4b\x27\xfc\x62\xda\x93\x28\x44\x9f\xe5\x5f\x6a\x9e\x12\x6c\x04\x63\x62\x32\xf8\x05\x46\xe1\xbb\x0b\x84\x7a\x88\xcb\xc4\xa6\xbb\x77\xd7\x9c\x79\x29\xa9\x71\x71\xb0\x50\x93\x07\xea\x46\x1a\x1d\xfc\xee\xa5\xc6\xbf\x2d\xd4\x77\xa7\x65\x7f\x0b\xea\xba\x9c\x22\x7d\x09\x4d\x20\x5c\x4e\xdd\x9a\xaa\xe2\xe7\xa1\x49\x30\x61\x67\xc3\x37\x19\xdc\x13\xfe\x57\x58\xae\x0c\x08\xa9\x5f\xb3\xff\xcc\x8d\x34\x81\x84\xfc\xe3\x30\xfc\xef\x3f\x8f\x6f\x1f\x6e\xeb\x81\xe9\x45\xb8\xf2\x6e\xc2\xf5\x3a\xde\xbf\x0b\xfa\x5a\xdf\x0c\xde\xf1\xf1\xe1\xd2\x5e\x1a\xe3\x86\xfb\xfe\xbf\x01\x00\x00\xff\xff\x22\xa5\x84\x0b\x53\x0c\x00\x00"
+var _repoEditorEditTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\x41\x6f\xab\x38\x10\x3e\xa7\xbf\xc2\x42\x39\x6e\x40\xbd\xad\x56\x49\xa4\xdd\xa7\x56\xbb\x52\xfa\x54\xb5\x7d\xda\x63\x34\xc1\x43\x33\xaa\xb1\x79\xb6\x21\x8d\x58\xfe\xfb\xca\xd8\x04\x93\x26\x55\xf5\x4e\x80\x3d\x33\xcc\xcc\xf7\xcd\x87\x69\x5b\x8b\x65\x25\xc0\x22\x4b\x76\x60\x30\xdb\x23\xf0\x84\xa5\x5d\x77\xb3\xe4\xd4\xb0\x5c\x80\x31\xab\x44\x63\xa5\x0c\x59\xa5\x8f\xac\x20\x81\x0c\xb9\x7b\xe8\x2f\xc9\xfa\x66\x16\x47\x71\xa6\x7d\x14\xd4\x3e\xce\x2c\x0e\x54\x13\xcb\x95\xb4\x40\x12\xb5\xf3\x9c\x7d\x48\x00\x04\x6a\x1b\x3c\x67\x6d\x4b\x05\x4b\xff\x31\x9b\xfb\xe7\x17\x0d\xf9\x1b\xf2\x7e\xf9\x3c\xe4\x01\xb4\x24\xf9\xca\x4a\x34\x06\x5e\xb1\x0f\x3c\x9b\x2d\x69\x30\x51\xb9\xa5\x5c\x49\x16\xae\x0b\xff\x8e\xf5\x32\xa3\x35\x6b\xdb\x94\x6e\x7f\x97\xe9\x8b\xf6\xb9\xa7\xbe\xb6\x54\x14\x66\x6b\xfd\x3b\xb7\x21\x7e\x12\x5e\x9e\x71\x6a\x7c\xee\x28\x7d\x42\xcb\x42\xe9\x32\x4a\xc8\xc5\x60\x6e\x2d\x61\x25\xda\xbd\xe2\xab\xa4\x52\xc6\xfa\xc4\xda\x36\xfd\xf6\xfc\x74\xff\xa2\xde\x50\xfe\xfd\xf2\xb0\x09\x51\x49\x56\xb5\x65\xf6\x58\xe1\x2a\xd9\x13\xe7\x28\x13\x26\xa1\xc4\x55\x22\xc0\xd8\x6d\xae\xca\x92\x6c\xc2\x1a\x10\x35\xae\x92\xb6\x4d\xa3\xe5\xae\x4b\xd6\x17\xfa\x62\x30\x57\x92\x83\x3e\xb2\x12\x65\x3d\xb4\x25\xb2\x29\xc8\x5a\xe4\x8c\x2c\x96\xcc\x6a\xc4\x0a\xec\x3e\x98\x9d\xc7\xda\x69\x04\x9e\xeb\xba\xdc\xb1\x82\x50\x70\xe6\xb1\xb9\xd3\x7a\xfb\xa2\x11\x1f\xc1\xee\xbb\x0e\xb5\x56\x3a\xb4\x65\x88\x33\x5b\xc2\x10\xc6\x60\x6e\x49\xc9\x84\xed\x35\x16\xae\x86\x3b\x93\x43\x85\x8f\xaa\x96\x9c\xcd\xd3\xbf\x34\xc8\x7c\xbf\x21\xf9\xe6\xbc\xdb\x36\x7d\x3a\xd1\x2e\xfd\x0e\x25\x76\xdd\x32\x83\x21\x6a\xdb\xb2\xb9\x64\x7f\xac\x98\x40\xc9\x52\x97\x82\x33\x31\x5d\x17\xed\x0b\xb7\xff\x5c\xef\x1c\x8e\xd6\x99\xdf\x46\xdb\x1a\xe4\x2b\xb2\x39\xfd\xc6\xe6\x8d\xb3\xbb\x10\x63\xd2\x03\x4e\x0d\x39\x52\xaf\x59\xc6\x4e\x14\x08\xb1\xa8\x60\xf8\x93\xcd\x89\xcd\xc5\xe8\x3c\x40\x4a\xdc\x35\x5a\xe0\xc2\xa1\x19\x01\x38\x6f\xba\x2e\x61\x95\x80\x1c\xf7\x4a\x70\xd4\xfd\xe2\x65\x36\x3a\xd7\xed\x51\xd5\x7a\xeb\x22\x25\xce\x91\x83\x85\x05\xe6\x8b\x5a\x8b\x45\xa5\xb1\xa0\x77\xef\x7f\xd7\x7b\xe4\x4a\x16\xf4\xfa\xe3\x69\xf3\xd8\x6f\xc5\x0e\xa1\xf7\x43\xbf\x7d\x67\x13\xa6\xf1\x67\x4d\x1a\x39\x83\xda\xaa\x42\xe5\xb5\x59\x8f\x95\x98\x0a\xe4\xb5\x69\x22\x59\x28\x56\xa9\xca\x4d\x60\x5d\x85\xf7\xb8\x29\x47\x69\x3f\x29\xc9\x15\xd2\x97\xb5\x47\x51\x8d\x15\xf5\x88\x93\x92\xab\x64\xa7\xac\x55\x25\xcb\x51\x5a\xd4\x61\xb7\x01\x4d\xe0\xb7\x2d\xc9\x23\x23\xd9\xa0\xb6\xc8\xdd\x30\xbb\x1c\x23\x4c\x50\x18\x8c\xc1\x88\x4b\x18\x88\xb8\x5e\xc2\x17\xb8\x98\xb5\x2d\x49\x8e\xef\x6c\x9e\x0e\x54\x37\x0e\xec\xff\x58\xe4\xe3\x29\x3b\x6f\x3c\x49\x3f\x66\x13\x94\xe2\xe3\x53\x9f\xd8\xfa\x9a\x0c\x29\x9d\x74\x1d\xfb\x52\x9a\x3d\x0d\xa5\xb2\x4e\x30\xbf\xe3\xe1\x9e\x04\xf6\xb9\xc7\x1e\xe9\x38\xab\xa7\x31\xbd\xf6\xea\x1c\x64\x8e\x62\x2b\xd4\x01\x75\x72\xa9\xac\x8b\x92\xe5\xd8\xee\xa4\x64\xdb\x6b\x49\x50\xb0\x68\x61\xd4\xaf\x31\x95\x91\x7c\x83\xf6\x8c\xf3\x35\xde\x46\x77\x13\x09\x43\xc1\x2f\x48\x5b\x4d\xcc\xaa\x8a\x81\xb5\x90\xef\x91\x33\x0b\xbb\x5a\x80\xf6\x4a\xe8\xc9\x74\xd0\x64\x71\x95\xf4\x97\x81\x7d\x1a\x1b\xc2\xc3\x2a\x09\x37\x61\x99\x53\x51\x38\x05\x28\x8a\x93\x3a\x9e\x44\x0d\x72\x4b\x0d\xf6\x1a\x1a\xac\x2d\xec\x86\xa8\xeb\xeb\x1f\xa1\x5c\x71\x3c\x7d\x83\xa8\x98\xa0\x76\x0d\x12\x89\x87\x41\x00\x06\x82\x5f\x33\x75\x97\xc8\x56\x72\x8f\xe0\xcd\xa8\x58\xe7\x54\x39\x2f\xcc\x57\x44\xfc\xd4\x0c\x57\x58\x5c\xe2\xb4\x47\xb5\x16\x0e\xd6\x3f\xab\xea\xb9\xde\xfd\x78\xda\x74\x5d\x06\x15\x65\xcd\x6d\x56\x82\x7e\xe3\xea\x20\x83\xa1\x56\xca\x7a\x7d\x78\xef\xf5\x61\x3a\x6b\xb1\x7e\x5c\xda\x6f\xdb\xf4\x11\x34\x4a\x1b\xd3\x27\xc6\x6e\xd1\x6b\x6d\xa9\x38\x9a\xde\xfb\xd1\x2f\xc3\x4e\xa0\xab\xf3\xc1\x6d\x74\xdd\x67\xc8\xe0\x11\xaf\x1d\x0e\x34\x0a\x04\x83\xe9\x50\x7a\xdc\xd4\xf3\xce\x8d\x8d\xea\x89\x33\xe9\x52\xff\x69\x0b\x15\x6d\x43\xb0\xac\x6d\x27\x92\x9c\x4d\x67\xe4\x93\x84\x3d\x31\x3f\x3d\xce\x84\x77\x6c\xf3\xbd\xfb\xec\x99\x64\xca\x86\x51\x8f\xe2\xc9\x3b\x3b\x00\x78\x39\x3e\x0d\x54\xe0\xbd\x85\x1d\x33\xf8\x5a\xa2\xb4\x17\xe8\x1f\x3a\xe3\xa0\x04\x8d\xd0\xb3\xa9\x67\xa6\x7b\x1a\xd4\x21\x7c\x2b\x82\xbb\x33\x71\x99\x2f\x2e\x1d\x00\x16\xd3\x9e\xf8\xf0\xb3\xaf\xf2\x2f\x36\x8f\x09\x36\x82\x31\x31\x19\xfc\x3c\xa3\xf0\xdd\x7a\x42\x3d\x84\x65\xc7\xa6\xbb\x77\x6b\xce\xbc\x04\x49\x5c\x1c\x34\x54\xce\x03\xa5\x21\x25\xbd\xdf\x86\x24\xfe\xab\xa1\xba\x3b\x2d\xf7\x87\xa4\xb6\x4d\x5d\xa4\x6f\xbe\x09\x0e\x97\x53\xb7\xa6\xaa\xf8\x75\x68\x22\x4c\xd8\xd9\xf0\x4d\x06\xf7\x84\xff\x15\x96\x0b\x05\x7c\x3c\xf7\xfe\x62\x02\x11\xf9\xc7\x61\xf8\xe5\x37\x8f\x77\x1f\x7e\x3a\x3c\xd3\x33\x7f\x22\xde\xfa\xd3\xb7\xff\x8d\x58\x66\xee\x69\x7d\x33\x78\x87\xcb\x87\x7f\x8f\x42\x29\x3b\xfc\xb6\xfc\x3f\x00\x37\xb1\xf9\xae\x1a\x0d\x00\x00"
 
 func repoEditorEditTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1552,8 +2116,8 @@ func repoEditorEditTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/editor/edit.tmpl", size: 3155, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8a, 0x1c, 0x4b, 0xf1, 0xca, 0xc9, 0x75, 0x16, 0xb7, 0x1d, 0x34, 0x31, 0x1e, 0x6e, 0xb8, 0x51, 0x3d, 0x11, 0x22, 0xe9, 0x7b, 0x15, 0xf3, 0x9f, 0xa, 0xa8, 0x7e, 0x1d, 0xcd, 0x22, 0xff, 0xba}}
+	info := bindataFileInfo{name: "repo/editor/edit.tmpl", size: 3354, mode: os.FileMode(0644), modTime: time.Unix(1792286671, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf3, 0xff, 0x72, 0xd, 0xb3, 0x55, 0x86, 0xe6, 0x63, 0x94, 0x56, 0x90, 0x31, 0x52, 0xab, 0x7f, 0xbd, 0x3b, 0xc3, 0xf5, 0x3b, 0x8, 0x6a, 0xf1, 0xfe, 0x14, 0xf2, 0x53, 0x7a, 0xd7, 0xe, 0xc4}}
 	return a, nil
 }
 
@@ -1597,7 +2161,7 @@ func repoForksTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoHeaderTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x4d\x73\xdb\x36\x10\x3d\xcb\xbf\x02\xe5\xf8\xd0\x1e\x48\x35\xb7\x1e\x24\x75\x1c\x27\x6e\x35\xe3\x24\x1e\xcb\x69\x8e\x99\x25\xb9\xa2\x30\x02\x01\x06\x00\xa5\x78\x38\xfc\xef\x9d\x25\x48\x91\x14\x29\x59\x6e\x72\x69\x2e\x8e\x80\xfd\x78\xef\xed\x02\x58\xce\x62\xbe\x63\x91\x00\x63\xe6\xde\x06\x21\x46\xed\xef\x35\x64\x19\x6a\x6f\x71\x55\x14\x7b\x6e\x37\x2c\x78\xc4\x4c\x19\x6e\x95\x7e\x2e\xcb\xab\x49\xd7\x25\xe7\x2c\x52\xd2\x02\x97\xe4\x30\xfb\xc5\xf7\x99\xb1\xa0\x6d\xbb\xca\x7c\x7f\x71\x35\x39\x76\xda\xa1\xb6\x3c\x02\x21\x9e\x59\x06\x71\x8c\x31\x4b\x34\x8f\x19\x21\xe8\x85\xa9\x56\x5d\x84\x5e\x88\x48\x89\x3c\x95\x47\x19\x69\xa9\x31\x3e\x4e\xe8\xb8\x79\x6e\x6f\xb0\x99\x27\xc8\x42\x8d\x10\x47\x3a\x4f\xc3\xc6\x6a\x52\x14\x7c\xcd\x82\xcf\x06\x6f\x73\x63\x55\x7a\xb3\x03\x0b\x9a\x24\x70\xff\x66\x3c\x4d\x3a\x51\x52\x2e\x39\x33\x19\x44\x18\x33\x9e\x42\x82\x1e\x33\x3a\x9a\x7b\x45\x11\x3c\xa2\x70\xce\xf7\x5c\x6e\xcb\xf2\x90\x60\x32\xe3\x4d\x00\x97\x6b\x69\x1e\x34\xdf\x81\xc5\xb2\x4c\x31\x01\x5f\x45\x96\x47\x4a\xb2\xfa\xaf\x2f\x54\xb4\x2d\x0a\x14\x06\x99\x33\xff\xc0\xb5\x56\xfa\x84\xb5\xc6\x4c\xf9\x91\x50\x12\x7b\x3e\x77\x4a\x6f\xcf\x79\xac\x95\xde\x62\x5c\x14\x28\x63\xc2\x3a\x9b\xf2\x56\x10\x0a\xd3\x55\xa0\x81\x3f\x1a\x6d\xc0\xe9\x24\xfc\xb3\x48\xfb\xa0\x2a\x00\xb4\x74\x02\x20\xad\xd5\xbf\x66\xc0\x36\x1a\xd7\x24\xee\x4d\x96\xad\xf2\xf0\xf3\xe3\x7d\x59\x4e\x8b\x22\xf8\xb4\x97\xa8\x83\x8f\x90\x22\x05\x38\x5a\x98\x4d\xa1\x89\xd7\x6d\x94\x98\xef\x78\xd5\x43\x6c\xca\x66\xd3\x98\xef\x16\xc3\x34\xd7\xd5\x59\x69\xaa\x5c\x14\xc3\x88\x8d\x26\x0d\xf3\x6e\x06\xe2\xe8\xaf\x05\x24\xe4\x7a\x1d\xf0\x37\x7f\xc8\xe0\x49\x33\x8f\xd8\x06\x69\xe5\xf0\x75\xad\x55\xea\x95\x25\x9b\x01\xb3\xa0\x13\xb4\x73\xef\x6b\x28\x40\x6e\x3d\xa6\x51\xcc\x3d\xa9\x54\x86\x74\xee\xa4\xd2\xb8\x46\xad\x51\x7b\x1d\x78\x2e\x6d\x70\x13\xc7\x1a\x8d\x71\x20\x87\xab\x84\xd7\x51\xec\x0b\xda\x80\x77\x75\xb9\x18\xba\xab\x5d\x17\x7a\x03\x28\x78\x0b\x06\x49\xb2\xa0\xd5\x6c\x95\x87\x2b\xab\x59\xbb\xf5\x88\x82\x76\xd9\x1b\xe6\xbf\x39\x89\xad\xae\xc8\x55\x8b\x53\x2a\xcb\xae\x83\xa5\xf9\x2b\x47\x63\xdb\x9e\xe8\x1f\x7d\xcd\x93\x8d\xed\x9c\xc7\xb5\xd2\x69\x5b\x70\x93\x09\x78\x66\x5c\x0a\x2e\xd1\x63\x10\x59\xae\xe4\x71\x99\xa7\x6e\x79\x5a\xe5\xa4\x7c\x5f\xc0\x46\x1b\x2e\x13\x32\x29\xcb\x5c\xd6\x30\xf7\xb4\xfc\xa7\xc6\x98\x6b\x8c\xec\x57\xab\xe6\x14\xa7\xa6\xcd\x52\xb4\x1b\x15\xcf\xbd\x87\x4f\xab\xa7\x16\xce\x84\x4c\x6e\x57\x8f\x77\x4f\x6a\x8b\xf2\xef\xa7\x0f\xf7\xed\xd9\x3b\x66\x22\x20\x44\x81\x31\x0b\x73\x6b\x95\xf4\x98\x85\x90\xcb\x18\xbf\xcf\xbd\xdf\x3b\x01\x27\x33\xb7\xdf\x71\x0c\xc1\xf0\xa8\x71\xeb\x58\x76\x4e\x37\x3e\x63\x4f\xd2\x3e\x45\x66\x04\x98\x0d\x53\xb9\x25\x9d\x6a\xba\x8c\x6e\x00\x77\x36\x4f\x28\x33\xec\x93\x5c\x56\x22\x79\xb4\xe7\xce\xf9\xd0\xa6\x63\xd1\x69\xcc\xaa\x01\x1c\x85\x2e\x57\x18\xd0\xac\x54\x6a\x0f\x44\xad\xff\xb4\x8a\x8a\xda\xf4\xf8\xd3\xf1\xcd\xd3\x0a\x34\x9a\x7e\x2a\x68\xed\x7a\x57\xc1\x64\x36\xa5\x0e\xfa\xa9\xfd\xb4\xb2\xa0\x87\xed\x44\x8f\xde\xff\xb4\x9b\x08\x7a\xaf\x9d\x7a\x0c\x2f\xe8\xa3\x9e\xfd\x58\x1b\x51\x86\xb3\x5d\xd4\x1a\xfc\xc4\x26\xa2\xa0\x63\x1d\x44\x70\xff\x63\xff\xb8\x0b\xf7\x16\xe4\x5b\xbc\xab\x2e\xd1\x1f\xaf\xd8\x90\x4e\x5d\xc3\x2a\x17\x7e\x63\xee\x2d\x5c\xbe\x63\xd7\xc1\xbd\x4a\x12\x8c\x3f\x1b\xfa\x59\x96\x99\xca\xb8\x4c\x58\x9e\x35\x2f\xef\xe8\xeb\x4a\xfa\x12\x89\x2d\xbd\xb3\xe4\x76\xa2\x0b\xce\x8c\x1e\x4d\xb9\xc7\xde\x11\xef\xa4\x90\xaf\xa8\x14\x05\x1a\xab\x14\x69\x7c\x69\xa5\x8e\x46\x8d\xce\x5e\x77\xa7\x5d\x77\xff\xab\x06\x56\x94\x71\x7f\x5c\x3d\xda\x6b\xa7\xde\x81\x53\x77\xaa\x6e\xf2\x1c\xce\x52\xb0\x34\xef\xf8\x7a\x7d\xab\xd2\x0c\x34\x8e\xcc\xea\x16\x42\xd3\x1d\xd8\x87\x83\xb9\x85\x30\x17\xa0\x59\x8a\x32\x67\x12\x76\x21\xd4\x43\xf3\xc9\x27\xb5\x55\xdd\x35\xeb\x03\x24\xb8\x34\xff\x70\xdc\xdf\x71\x41\xf7\x26\xdd\x67\xbb\xf6\x38\x5b\x4c\x3b\x05\xe9\x8e\x4b\x57\x2f\x74\xc8\x9a\x0b\xf4\x2d\x7e\xb7\xae\x3f\x58\x51\x1c\xf7\x07\x25\xf4\x0e\xca\x43\x0d\xfc\x50\x0d\x07\xb0\xfd\x9a\x09\xde\x4b\x08\x05\x2e\x8d\xc9\x0f\x17\xfc\x38\x9d\xca\xe4\x9e\x13\xe9\x0b\xe9\x4c\x79\x15\xf5\x65\x56\x95\x9d\x5f\x0d\x6d\xf1\x29\x62\x75\xac\xb2\x64\x6d\xad\x07\x34\xde\x7f\xb7\xa8\x25\x88\x27\x0d\xd1\x16\x69\xb4\x34\x19\x74\xaf\xe6\x51\xdf\x8f\x79\xfa\x29\x43\xd9\x68\x90\x68\x78\x6e\xae\xcd\x50\xe4\x07\x9e\x26\x05\x21\xea\xe3\xb4\xa8\x89\x8e\x47\xa8\x3d\x66\x53\xca\xbe\x38\x5b\x0a\x90\x71\x0f\xcb\x8d\x10\x6a\x6f\x1e\x72\x21\x0c\xfb\xb5\x6e\xe8\xaa\xd5\x7e\x3b\x5b\x1c\x72\x78\x5d\x6d\x32\x4a\xf1\x72\x69\x12\x6e\x7d\x32\xf5\x35\x7e\x23\x18\xa7\xca\xe3\xc2\xd1\x64\xfb\x0a\xc5\x2b\x9a\x3f\x22\x78\x1d\xe0\x12\xa1\x87\xcd\xf2\x85\x6f\xf9\x59\x51\x9d\xc1\xa5\x82\xee\xf9\x96\xbf\xac\x67\xa8\xd4\xf6\x94\x86\x55\x84\x97\x8e\xee\xd2\xb4\x44\x6e\xe2\x94\xcb\xc6\xa1\x73\x89\x55\x13\x7d\x75\x7f\x1d\x00\x8d\x12\x5c\xa1\xb5\x5c\x26\x97\x5f\x50\x53\x53\x7b\x1c\x1e\x8e\xd3\x4c\xad\x52\xd4\x60\xe3\x54\x0f\x71\xda\x6f\x17\x58\x1c\xbd\x16\x2d\xf5\x66\xed\xf0\x77\xe4\x4a\x3f\x7c\x9a\xd6\x46\xed\x77\xfa\x91\xf5\x88\x61\x95\xa6\xfe\xf9\x6f\x00\x00\x00\xff\xff\x28\x04\x24\x9d\x0e\x12\x00\x00"
+var _repoHeaderTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x58\x4b\x6f\xdb\x3e\x12\x3f\x27\x9f\x82\x2b\xe4\xf0\xff\x1f\x24\x6f\x6f\x7b\xb0\xb3\x48\xd3\x66\xd7\x40\xda\x06\x71\xda\x1e\x83\x91\x34\x96\x09\x53\xa4\x4a\x52\x4e\x03\x41\xdf\x7d\x41\x52\x0f\xca\x96\x6c\xa7\xed\x65\x7b\x49\x4d\xce\xe3\x37\x4f\xce\x68\x9e\xd2\x1d\x49\x18\x28\xb5\x08\x36\x08\x29\xca\xf0\x45\x42\x51\xa0\x0c\xae\x2f\xab\xea\x85\xea\x0d\x89\x1e\xb1\x10\x8a\x6a\x21\x5f\xeb\xfa\xf2\xc2\x67\x29\x29\x49\x04\xd7\x40\xb9\x61\x98\xff\x23\x0c\x89\xd2\x20\x75\x7f\x4a\xc2\xf0\xfa\xf2\x62\x9f\x69\x87\x52\xd3\x04\x18\x7b\x25\x05\xa4\x29\xa6\x24\x93\x34\x25\x06\xc1\x40\x8c\x3d\x75\x12\x06\x22\x12\xc1\xca\x9c\xef\x69\x34\x47\x2d\xf1\xbe\x42\x67\x5b\xe0\xee\x0e\x2e\xcb\x0c\x49\x2c\x11\xd2\x44\x96\x79\xdc\x52\x5d\x54\x15\x5d\x93\xe8\xab\xc2\xdb\x52\x69\x91\xdf\xec\x40\x83\x34\x2e\x70\xff\xe6\x34\xcf\x3c\x29\x39\xe5\x94\xa8\x02\x12\x4c\x09\xcd\x21\xc3\x80\x28\x99\x2c\x82\xaa\x8a\x1e\x91\x39\xe6\x7b\xca\xb7\x75\xdd\x29\xb8\x98\xd3\x56\x80\xd3\xb5\x54\x0f\x92\xee\x40\x63\x5d\xe7\x98\x41\x28\x12\x4d\x13\xc1\x49\xf3\x37\x64\x22\xd9\x56\x15\x32\x85\xc4\x91\x7f\xa2\x52\x0a\x39\x41\x2d\xb1\x10\x61\xc2\x04\xc7\x01\xcf\x9d\x90\xdb\x63\x1c\x6b\x21\xb7\x98\x56\x15\xf2\xd4\x60\x9d\xcf\x68\xef\x10\x23\xa6\xae\x0f\xe1\x8f\x4a\x3b\xb0\x69\x12\xfe\x51\xa4\x43\x50\x16\x80\x39\x9a\x00\x68\xce\x9a\x5f\x73\x20\x1b\x89\x6b\xe3\xdc\x9b\xa2\x58\x95\xf1\xd7\xc7\xfb\xba\x9e\x55\x55\xf4\xe5\x85\xa3\x8c\x3e\x43\x8e\x46\xc0\xde\xc1\x7c\x06\xad\x3c\x3f\x51\x52\xba\xa3\x36\x87\xc8\x8c\xcc\x67\x29\xdd\x5d\x1f\xaa\xb9\xb2\xb5\xd2\x46\xb9\xaa\x0e\x25\x56\xd5\xd0\x72\x5f\x83\xb1\x31\x5c\x33\xc8\x0c\xeb\x55\x44\xdf\xfd\x8b\x47\x4f\x92\x04\xc6\xda\x28\xb7\x0c\xcf\x6b\x29\xf2\xa0\xae\xc9\x1c\x88\x06\x99\xa1\x5e\x04\xcf\x31\x03\xbe\x0d\x88\x44\xb6\x08\xb8\x10\x05\x9a\xba\xe3\x42\xe2\x1a\xa5\x44\x19\x78\xf0\x9c\xda\x15\xac\xf1\x26\x4d\x25\x2a\xe5\x70\x8e\x5e\x18\xd4\xce\xd0\xa1\x5b\xab\xca\x8f\xce\xd9\x06\xb8\x08\xfa\x06\xb4\xb0\xa2\xf7\xa0\xd0\x38\x2e\xea\x3d\xb7\x2a\xe3\x95\x96\xa4\xbf\x7a\x44\x66\x6e\xc9\x3b\x12\xbe\x9b\xc4\xd6\xc4\xe5\xb2\xc7\xc9\x85\x26\x57\xd1\x52\xfd\xa7\x44\xa5\xfb\xcc\x18\x36\x00\x49\xb3\x8d\xf6\xaa\x72\x2d\x64\xde\x87\x5d\x15\x0c\x5e\x09\xe5\x8c\x72\x0c\x08\x24\x9a\x0a\xbe\x1f\xec\x99\x3b\x9e\x59\x9d\x46\xdf\x77\xd0\xc9\x86\xf2\xcc\x90\xd4\x75\xc9\x1b\x98\x2f\xe6\xf8\xdf\x12\x53\x2a\x31\xd1\xcf\x5a\x2c\x8c\x9c\xc6\x6c\x92\xa3\xde\x88\x74\x11\x3c\x7c\x59\x3d\xf5\x70\x2e\x0c\xc9\xed\xea\xf1\xee\x49\x6c\x91\xff\xf7\xe9\xd3\x7d\x5f\x81\xfb\x96\x30\x88\x91\x61\x4a\xe2\x52\x6b\xc1\x03\xa2\x21\xa6\x3c\xc5\x9f\x8b\xe0\x9f\x9e\xc0\x8b\xb9\xbb\xf7\x18\x63\x50\x34\x69\xd9\x3c\x4a\xaf\xc6\xf1\x15\x07\x2e\x1d\x9a\x48\x14\x03\xb5\x21\xa2\xd4\xc6\x4f\x8d\xb9\xc4\xf4\x01\x57\xa1\x13\x9e\x39\xcc\x93\x92\x5b\x27\x05\x75\xdd\x56\xfb\x21\x8d\x47\xe1\x25\xa6\x4d\x00\x67\x82\x6f\x2b\x1c\x98\x69\xbd\xd4\x97\x45\xe3\xff\x99\x95\x8a\x52\x0d\xec\x37\x45\x5c\xe6\x16\x34\xaa\xa1\x2a\xe8\xe9\x06\x0d\xe1\x62\x3e\x33\x19\x74\x7d\x39\x8d\xc0\xf6\xc9\x36\x48\x63\xdd\x63\x96\x97\x1a\x43\x95\x6c\x30\x2d\x19\x06\x44\x53\xcd\x70\x11\x1c\xba\xc2\xd0\x3d\x77\x74\xb6\x1f\x76\x11\x8b\x91\xb1\x61\x5c\xbc\x80\xf8\xf8\x7f\x09\xa1\x75\x57\x58\x80\xde\xa8\x23\xf8\x2c\xd5\xb3\xa3\x1a\xa2\x5b\x53\x86\x04\x98\x46\xc9\x41\xe3\x29\x84\x7f\xa0\x26\x57\x1a\xe4\x61\x49\x9a\xf1\xe1\xff\xb4\x22\x0d\xf4\x41\x49\x0e\x2c\x3c\xa3\x16\x07\xf4\x63\xa5\x68\x34\x1c\xad\xc4\x9e\xe0\x0f\x16\xa2\x11\x3a\x56\x85\x06\xee\x2f\xd6\xa0\x7b\xb4\x6e\x81\xbf\xc7\x3b\xfb\x10\xfd\x7e\xc4\x60\x22\x58\xc4\xea\xc2\x1f\xc4\x4d\x15\xcb\x0f\xe4\x2a\xba\x17\x59\x86\xe9\x57\x65\x7e\xd6\x75\x21\x0a\xca\x33\x52\x16\xed\x0c\x33\x3a\xa7\x18\xff\x1a\x23\xb6\x66\x62\x31\x6c\x13\x59\x70\x64\x88\x6b\xc3\x3d\xf6\x16\x07\x93\x8e\x7c\x43\xa4\x8c\xa0\xb1\x48\x19\x1f\x9f\x1b\xa9\xbd\xa1\xcd\xbb\xf3\x6f\xfa\x73\xf7\x3f\x3b\xfa\x23\x4f\x87\x83\xff\xde\x9d\xb7\x3f\x34\xcb\xcc\x55\xf4\x8d\x2a\x1a\x53\x46\xf5\xeb\xaa\xe9\x9a\x4e\xc3\x5e\x16\xa8\x1c\x18\x23\x94\xaf\x05\xc9\x51\x29\xc8\x90\xec\x3a\xce\xbe\x31\x37\xdb\x86\xdf\x9d\xda\x81\x82\xac\x99\x00\x8d\xe9\x64\x7f\x52\xa8\x35\xe5\x99\x1a\x6f\x33\x93\x3d\x66\x4e\x79\x51\x6a\xa2\x5f\x0b\x5c\x04\x1b\x9a\xa6\xc8\x03\xc2\x21\xc7\x45\xe0\x14\x05\x64\x07\xac\xc4\x45\x90\x00\x4f\x90\x85\x3d\xee\x6e\x01\x3a\xe8\x36\x76\x7b\x19\xb6\x9c\x91\x62\x6f\x00\x47\xbd\xc4\xee\xe9\x79\x76\xca\x02\x33\x9b\xf9\xa5\xef\xd7\x61\x9f\xb3\x89\x59\x05\xbc\x8e\x74\xe9\x0f\x96\xdd\xbe\xd0\x3b\x62\x88\x63\x4c\x7d\xe1\x98\x02\xf2\xd7\x07\xd0\x78\x97\xeb\x7b\xc1\x33\x12\xb5\x31\x4e\xff\x6e\xc4\x0d\x77\x98\x33\x65\x97\x31\xa3\xc9\x39\xa2\xdb\x74\xed\xb2\xb5\x3b\x3b\xc8\x5a\x7f\x41\x6e\xa9\xba\x66\x1e\x2d\xd5\x07\xba\x5e\xdf\x8a\xbc\x00\x89\x23\x6b\xb7\x86\x58\xf9\xbb\xf7\xe1\x8e\xad\x21\x2e\x19\x48\x92\x23\x2f\x09\x87\x5d\x0c\x8e\x6c\x7a\x2e\xee\xcb\xde\x45\xe2\x01\x32\x5c\xaa\x6f\x14\x5f\xee\x28\x33\xc3\x8f\x49\xaf\x5d\xff\x9e\x68\xcc\xbd\x8e\xe0\x6f\x3e\x97\x27\x5a\x94\x79\xfa\x43\x8d\x3f\xb5\x8b\x3e\xa9\xaa\xfd\x06\x65\x14\x06\x5d\xe9\x43\x03\xbc\xf3\xaf\x03\xd8\x7f\x98\x88\x3e\x72\x88\x19\x2e\x95\x2a\x51\x1d\x35\xc7\x92\xdc\x53\xa5\x8f\x9b\x33\x2a\xfe\xe3\x4f\x3b\xac\xb0\x27\x09\xc9\x16\xa5\x79\xf6\x06\x44\xc3\x6b\xdb\xc5\xfb\x97\xd3\xaf\x7c\x6a\x71\x76\x1b\xec\x29\x77\x59\xf2\xd0\x2e\x76\xe9\x94\xc7\x9c\x48\xb3\x59\xf5\x49\x74\xda\x80\xb9\x2a\xc0\x6f\x03\xa3\xbc\x9f\xcb\xfc\x4b\x81\xbc\x75\x6e\x26\xe1\xb5\x35\x2b\x66\x65\xe7\x40\xd7\x31\xdd\x43\x71\x5d\x55\x47\x24\x34\x1c\xf3\x99\xd1\x7e\x7d\x34\xc6\xc0\xd3\x01\x96\x1b\xc6\xc4\x8b\x7a\x28\x19\x53\xe4\xaf\xa6\x52\x6c\x0e\xff\x7d\x34\xea\x86\xe1\x74\xd0\xfd\x10\x15\x46\xc5\xe9\xd0\x64\x54\x87\x86\x34\x94\xf8\xc3\xc0\x98\x0a\x8f\x13\x67\xf6\xde\x37\x78\xdc\x9a\xf9\x3b\x0e\x6f\x04\x9c\xeb\xe8\xae\x21\x0c\xc0\xdc\x02\xb7\x87\xa6\x11\x3c\x22\x43\x50\x27\x4a\xac\x21\x7a\x9b\xbf\x65\x23\xf9\xb4\xcb\x35\x64\x53\x5e\xee\x84\xbc\xb9\x73\x7c\xa7\x5b\x7a\xd4\x28\x47\x70\xae\x35\x2f\x74\x4b\x4f\x5b\x12\x0b\xb1\x9d\x32\xc5\x4a\x38\x61\xc6\x7e\xda\x2c\xd5\xfb\xe6\xb5\x98\x6e\x7f\x5c\x99\xd9\xe4\xfc\x66\x3e\xa3\x0d\xc7\x19\xb5\x20\xa1\xd8\x4c\xf6\xa7\x56\xcc\xa9\xd0\x2c\x55\x6f\xd1\x4d\x9a\x53\xde\x32\x78\xcf\x9b\x9b\xaf\xcc\xcb\xd6\xa1\x1a\xb5\x76\xd5\x0c\x2c\xe7\x5b\xdb\xcd\x64\xdd\xd7\xbd\xe9\x3c\x14\x82\xa9\x29\x73\x3b\x39\xfd\xa7\x29\xb8\xde\x1b\x64\x7b\xd3\xdb\xb3\xee\xef\xc8\x63\xdf\x7d\x7f\x6c\x88\xfa\x41\x66\x8f\x7a\x84\xd0\xaa\x69\x7e\xfe\x6f\x00\xac\x9e\x1c\x28\xf3\x17\x00\x00"
 
 func repoHeaderTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1612,12 +2176,12 @@ func repoHeaderTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/header.tmpl", size: 4622, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7e, 0xe3, 0x78, 0xed, 0x4f, 0xfb, 0xe8, 0xdc, 0xb9, 0x59, 0x5a, 0xfd, 0x9f, 0xae, 0xf, 0xb5, 0x30, 0x47, 0x3, 0x9d, 0x77, 0x9c, 0xc5, 0x54, 0x77, 0x55, 0xff, 0x40, 0xf2, 0x77, 0x9f, 0x78}}
+	info := bindataFileInfo{name: "repo/header.tmpl", size: 6131, mode: os.FileMode(0644), modTime: time.Unix(1792281940, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x77, 0x57, 0xe9, 0x8f, 0x6a, 0x11, 0x29, 0xb2, 0x9e, 0xb7, 0xe8, 0x7b, 0x39, 0x1a, 0x7c, 0x1c, 0x47, 0x1c, 0x7d, 0x84, 0x2d, 0x86, 0x46, 0x2b, 0xec, 0xb3, 0xeb, 0x55, 0x23, 0x69, 0xfa, 0xcd}}
 	return a, nil
 }
 
-var _repoHomeTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xdf\x6f\xdb\xb6\x13\x7f\x76\xff\x8a\xfb\xea\xeb\xc7\x59\x5e\x0b\x0c\x18\x06\xc7\x40\x9b\x66\x4b\x80\xae\x0b\xe2\x74\x03\xfa\x12\x50\xd2\xc5\xbe\x96\x22\x55\x92\xb2\x93\xaa\xfa\xdf\x07\x92\xfa\x41\x49\x71\x9a\x75\xc5\x9e\x6c\xeb\x8e\x77\xf7\xf9\xdc\xf1\xee\xe4\xaa\x32\x98\x17\x9c\x19\x84\x28\x61\x1a\x97\x3b\x64\x59\x04\x71\x5d\x3f\x5b\x65\xb4\x87\x94\x33\xad\x4f\x22\x85\x85\xd4\x64\xa4\xba\x87\x5b\xe2\x08\x9c\xb4\x89\xd6\xcf\x66\xe1\x71\xab\xe3\x8e\xa3\xf2\x06\x66\xa1\x85\x92\x20\x95\xc2\x30\x12\xa8\xec\xc9\xd9\xc4\x33\xe3\xa8\x4c\x73\x72\x56\x55\x74\x0b\xf1\x25\xdb\xe2\x85\xbe\xc2\x42\x9e\xcb\x1c\x9d\x60\xb6\x2a\x80\x32\x1f\xd1\x22\x43\x9d\x3a\x63\xed\x81\xab\x2e\xce\xf8\x35\xea\x54\x51\x61\x48\x8a\xba\x5e\xe9\x82\x89\x36\x94\xac\x97\xc0\x8e\xe9\x05\xe6\xf2\x03\x45\xeb\xaa\x3a\x72\x1c\xbe\xc0\x5b\x3c\xbc\x21\x81\x2f\x12\x05\x5f\x60\x63\xd4\x8b\xf3\xeb\xdf\xdf\xd4\xf5\x6a\x69\xed\xae\xab\x0a\xb9\xc6\x91\x17\xe1\xc3\x6b\x6d\x18\xbc\x33\x0b\x32\x8c\x53\xea\x5c\xd1\xf3\x9f\x45\x7c\xad\x3c\x6d\xb1\x90\x37\x0e\x4b\x68\x52\x64\x1e\xf0\x6c\xc5\x5a\x9b\x9c\xc4\xc7\x08\x76\x0a\x6f\x4f\xa2\x61\xb8\x7f\x61\xa2\xc9\x60\x5d\x8f\x71\x74\x82\xd5\x92\x39\xa6\x56\xcb\xc2\x7f\x0e\x93\xa3\x71\x9b\xa3\x30\x91\x23\x77\x4b\x66\xa1\x0d\x33\xba\x21\x77\xa4\x6b\x0e\x12\x76\x52\xd1\x67\x9b\x50\x0e\x29\x0a\x83\x0a\x6c\x70\x5d\x61\x8c\x4f\x91\xc1\xbc\x79\x0e\x60\x11\x0d\x40\xbc\x21\xf1\xb1\xae\x97\xa9\xcc\x73\x32\x7a\x59\x55\x67\x3a\x65\x05\x5e\xca\x52\x64\x10\xbf\x52\x4c\xa4\xbb\xb7\x2c\x77\xf0\x06\x24\xdb\x60\xf0\xce\x40\xc2\x59\xfa\x31\x5a\xaf\xa8\x15\xc8\xd4\x50\x2a\x05\x34\x9f\x8b\x1d\x69\x4b\x47\xb4\x5e\x2d\x69\x0d\xab\xc4\xb2\x74\xea\xdd\x9d\xca\x52\x18\xcb\x4f\xb2\x86\x49\x62\x9a\x90\xfa\xc4\x40\xcb\xa3\x65\x32\xa3\xfd\xb7\x62\x4d\x1c\x28\xd4\xdf\x0a\xc8\xa6\xc8\xdb\xf0\x98\x3c\x24\x4f\x15\x3e\x0a\x69\x4b\xe6\xa6\xf3\xfe\xdd\x71\x29\xe4\xc8\xf4\xb7\xe3\x32\x6c\x3b\x48\x52\x50\xca\x6f\xcb\xfc\x9a\x6d\xf5\x31\x5c\x9d\xe7\xaf\x60\xea\xbf\x76\xdf\xfa\xdb\x36\xb9\x15\xa9\x14\x19\x53\xf7\x90\xa3\x28\x3d\xf8\xa6\x35\x95\x9c\x5f\xe1\xa7\x12\xb5\x39\x35\x77\xf1\x4b\xce\xe5\x01\xbb\x1b\x1b\x58\xb9\x25\x63\x30\x83\x80\xbc\x01\x75\xaf\x98\x46\x8b\x31\xee\xef\x40\xc1\x14\x4e\xee\x40\xab\xf6\x1a\x6f\x59\xc9\x8d\x4f\x74\x5d\xc7\x71\x3c\xd2\x1c\x05\x76\x8e\x2c\xbb\x10\xb7\xd2\x5e\x1d\xef\x7d\xb6\x4a\x4a\x63\x64\x98\x9a\xad\x42\x14\xa0\x73\xc6\x39\x78\xe1\xd7\x4a\xaf\x09\xb3\xa9\xbd\xa5\x3f\xd4\x71\xcd\xc6\x4c\x07\xed\x6c\x32\x32\x7c\x2d\xde\x64\x4a\x16\x99\x3c\x88\x76\x02\x7c\x85\xc4\x51\xa2\x12\x85\x2c\x4b\x55\x99\x27\x01\xc9\x8d\x58\x63\x6a\x5b\x70\xf4\x60\xbd\x6a\x95\x3e\xde\x6f\xaa\xea\x8c\x73\x2a\x34\xe9\x8d\x51\x24\xb6\x83\x21\x63\x95\xe0\xf9\x4f\x7d\x77\xb5\xf8\x60\x2e\xe0\x97\x13\xe0\x28\x20\xbe\x56\x88\x56\x49\x37\x95\xe1\xc4\xdc\x8a\x37\x65\x62\x14\x4b\x8d\xd5\x7e\xde\x4b\x15\x13\x5b\x84\x39\xfd\x00\xf3\xbd\x55\x9b\x5a\x18\x40\xcf\x68\x4f\x76\xe0\xae\x61\x09\xe1\xbd\xf5\x55\x8a\x9f\x60\x4e\x30\xe7\xdd\xd1\xd9\xe0\x52\xb2\xd4\xd0\x1e\xa1\xe5\x67\x5d\x55\xf3\x7d\x77\x79\x3a\x3b\x7e\xb6\xb5\x06\x6c\xfc\x85\x0d\x8c\x44\x86\x77\x30\x8f\x2f\x99\xd9\x69\x98\xd3\x11\x1f\x9d\xf1\xa0\xe8\x43\xb6\xe7\x0d\xdd\x4d\x3a\x46\xc2\xc2\x67\xc0\x87\xc5\xd6\x93\xd0\xba\x19\x39\xf8\xf1\xc0\x0d\x1f\x90\xa6\x68\xbb\x33\x30\xad\xa9\xc9\x0e\x71\xca\xc4\x99\x60\x09\xc7\xb3\xcc\x3e\x68\x7d\x39\x5b\x76\x4e\xda\x5d\x68\xe1\x6b\x5f\x47\x61\xab\x23\x71\x0f\x09\x2f\x11\x5a\xe1\x20\x2d\xd6\xee\xcb\x2c\xfb\x95\x78\x40\xec\x91\x7e\x7a\x23\xf0\xf0\x58\x81\x8e\x65\xb6\x5c\x6c\x4a\xea\x3a\x0c\xa8\xbd\xd4\xad\xb3\xd9\xa4\x7b\xa2\x43\x18\x0b\x3c\xdc\x58\x54\x51\x10\x58\x57\xda\x43\xc6\x7b\x2c\xef\x0a\x2e\xd9\x13\xe1\x94\x4e\xf7\x3f\x44\xe4\x1d\x3e\x15\x54\x70\x87\xda\xe7\xbe\xa4\xfe\xb7\x58\xc0\x1f\x82\xdf\x83\xde\xc9\x03\xa4\x5c\x0a\x84\x82\x09\xe4\x40\x02\x82\xf5\x78\x27\x73\x2b\xd8\x22\x2c\x16\x41\x55\xd9\x9b\x28\xe0\xc7\x41\x05\xf5\x60\x98\xbb\x23\x4d\x03\x26\x51\x94\xcd\x1e\xe6\xdc\x2c\x9c\x9b\x61\x05\x09\x69\x60\x1e\xbf\x26\x6d\x8b\xf3\xfc\xfa\xfa\x32\x40\x36\xe9\xee\x09\xd3\x94\x36\x21\x37\xb4\xf5\x2b\xb4\x77\xb1\x33\xa6\xd0\x11\x64\xcc\xb0\x85\xdd\xe3\x5c\xda\x4e\xad\xc8\xe6\x2d\xb6\x1e\x36\xc1\x04\x69\xa2\x78\xa7\xb1\x91\xb8\x8f\xb6\x55\xd8\x1f\xc3\x4a\x99\x8d\x26\xc4\x43\x85\x34\x80\xb4\xd9\x9c\xff\x4b\x44\x5a\xef\x8e\xe3\x71\xe6\x03\x34\x9b\xcd\xf9\xd3\x22\x5d\xb9\xe4\x8c\x7d\x95\x8a\x47\xb0\x67\xbc\x44\xeb\xe7\xe1\xf4\x54\xd5\x7c\x4a\x68\xcb\xd8\x50\xe8\xa2\x6b\xfc\x46\x60\x07\x9b\x14\xfc\xfe\xf8\xf4\xf6\x6c\xb8\x11\xdd\xc8\x0a\x59\xd8\x49\x55\x16\x90\x72\x2a\x12\xc9\x54\xd6\x16\x54\xf3\x73\x91\x18\xd1\xf0\x23\x15\x6d\x49\x30\xee\x38\x1a\x6f\xbf\xc5\xfd\x8d\x7b\xe9\xb0\x91\x38\x6d\x5d\xa6\x29\x5a\xc7\xc7\x95\x6f\x1a\x9d\xfe\x10\x2a\x25\xd5\xa3\x47\x9c\x46\x7f\xc0\xbe\x2a\xa2\x30\x4f\x0b\x69\xcf\x14\x31\x7b\x81\x4e\x22\x12\x7b\x54\xb6\xab\xdb\x0e\xdc\xda\xea\x30\x1b\xa6\xb6\x68\x4e\xa2\xff\x8f\x72\xd7\x15\xc2\xf1\x9d\xc7\x1a\x29\x9a\xd7\x87\x67\x0f\x57\xca\x78\x23\x71\x59\xf9\x50\xe6\x05\xb4\xab\x4d\x98\xa3\x87\x9c\x5a\x1d\xdb\xac\x9c\xde\xc0\xd7\xc0\x78\xbf\x8a\xb6\x9d\x36\x5c\xd0\xbb\xb6\x3b\x0f\xfb\x2e\x53\xe9\x8e\xf6\xe3\xb5\x72\x3e\x68\xbc\xf1\x67\x2a\x1e\xdb\xfb\xdc\xb4\xf3\x3a\x76\x3f\x7f\x7f\x71\x19\x74\xd1\xef\x19\x87\x61\x2a\xde\x7e\xfe\x07\xa1\x5c\xbf\xbc\x8a\x7f\x7b\x1f\x46\x33\xd8\x85\x06\x2f\x34\xd3\x06\x1f\x3e\x0d\x5e\x09\xec\x70\xbb\xd0\x7f\x12\x1e\xfa\xc9\x36\x59\x5e\xf7\xd4\x0e\xcc\xee\x8f\x8b\x7e\x5f\x7a\x58\xdb\xbd\x22\xf7\xda\x3e\x84\xc6\x6f\xf3\x31\xf9\x6f\xe4\x56\x4a\xd3\xfe\xad\xf2\x77\x00\x00\x00\xff\xff\x8d\xa2\xe7\x80\xb3\x11\x00\x00"
+var _repoHomeTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x3a\x5d\x8f\xdb\x36\xb6\xcf\xce\xaf\xe0\x55\x8d\x8b\x16\xa8\xa5\x9b\xa2\x17\x58\x04\x1e\x17\xe9\x24\xe9\xcc\x6e\x9a\x0c\xc6\x93\x2d\xd0\x17\x83\x16\x8f\x2d\x66\x64\x52\x25\x29\x4f\x1c\x57\xff\x7d\x71\x48\x4a\xa2\x3e\xec\x4c\xd2\x74\x9f\x6c\x89\x87\xe7\x9b\xe7\x4b\x3c\x1e\x0d\xec\x8a\x9c\x1a\x20\xd1\x9a\x6a\x48\x32\xa0\x2c\x22\x71\x55\x3d\x99\x33\xbe\x27\x69\x4e\xb5\xbe\x88\x14\x14\x52\x73\x23\xd5\x81\x6c\x78\x0e\x24\xe7\xda\x44\x8b\x27\x93\x70\x3b\xc2\xd8\xed\xa0\x1c\x82\x49\x88\xa1\xe4\x24\x95\xc2\x50\x2e\x40\xe1\xce\xc9\x80\x32\xcd\x41\x19\xbf\x73\x72\x3c\x3e\x70\x93\x91\xf8\x86\x9a\xec\x16\xb4\x51\x3c\x35\x5c\x0a\xbb\xd6\xc7\xcb\xc5\x46\x92\x1d\x68\x4d\xb7\x10\x2d\x8e\xc7\x69\xcc\x9f\xfe\x43\xc4\x77\xca\xf1\x14\x17\xd4\x64\x2b\xe5\x91\x00\x5b\x09\x69\x78\x0a\x11\xf9\xf6\x9f\x92\x0b\x12\xdf\x28\xd8\xf0\x0f\xa0\x49\xf4\x3d\x89\xbe\xab\xaa\x79\xc2\xf8\xde\x71\x08\x82\x55\x55\x8f\x57\x2b\xa6\x82\x14\x84\x59\x15\xa5\xce\x5a\x8e\xf9\x06\xf9\xdd\xc2\xb5\xbe\x85\x42\x5e\xc9\x1d\x78\x76\x0b\xc2\x99\xd3\xe1\x8c\x81\x4e\xad\xf8\xf5\x86\xdb\x46\xb3\xf1\x0b\xd0\xa9\xe2\x85\x93\x73\xae\x0b\x2a\x6a\x21\x59\xbb\x42\x32\xaa\x67\xb0\x93\xef\x39\x8a\x7a\x62\x3b\xf9\x93\xbc\x81\x87\xd7\x5c\xc0\x0f\x6b\x45\xfe\x24\x4b\xa3\x7e\xb8\xba\xfb\xf5\x35\x0a\x87\x78\x17\xc7\x23\xe4\x1a\x7a\x54\x84\x63\xaf\xc6\x61\xe0\x83\x99\x71\x43\x73\x9e\x5a\x52\x5d\xa5\x0a\xb9\xb2\xb2\x84\x28\xbd\xb6\x26\x93\x39\xad\x71\xe6\x5c\xdc\x47\x24\x53\xb0\xb9\x88\xba\xec\xfe\x06\x6b\xcd\x0d\x54\x55\xb4\x38\xb1\x30\x4f\xa8\xd5\xd4\x3c\x29\x16\x23\x66\xd7\xb0\xdd\x81\x30\x91\x55\xee\x96\x9b\x99\x36\xd4\x68\xaf\xdc\x1e\xac\x79\x90\x24\x93\x8a\x7f\x44\x17\xcc\x09\x1a\x0f\x14\x41\xe6\x1a\x57\xee\xef\xe2\x06\x76\xfe\x3d\x21\x28\x51\x47\x88\xd7\x5c\xdc\x57\x55\x92\xca\xdd\x8e\x1b\x9d\x1c\x8f\x2f\x75\x4a\x0b\xb8\x91\xa5\x60\x24\xfe\x59\x51\x91\x66\x6f\xe8\xce\x8a\xd7\x51\x32\x32\x03\x1f\x0c\x59\xe7\x34\xbd\x8f\x16\x73\x5e\x2f\xc8\xd4\xf0\x54\x0a\xe2\x7f\x67\x19\xd7\xa8\x8e\x68\x31\x4f\xf8\x82\xcc\xd7\x8b\xe3\x31\xa5\x79\x4e\xe2\x4b\x47\xf3\x52\x96\xc2\xa0\x92\xd6\x0b\x32\xb0\x8e\xe7\xab\xb5\x0e\xa9\x95\x39\x99\x34\xfe\xfd\x25\x02\xaf\xad\x64\xa0\xbf\x54\x2a\xb4\x93\xc3\xe1\x04\xb3\x72\x79\x7d\xc1\x59\x91\xb6\xdc\xac\x1a\xea\x5f\x5d\x2e\x05\x39\x50\xfd\xe5\x72\x19\xba\x0d\x2d\x15\xfa\xf3\x9b\x72\x77\x47\xb7\xfa\x94\x5c\x0d\xe5\x2f\x94\x69\x32\x39\xc3\x31\x31\xdc\xe4\x70\x11\x0d\xa8\x6a\xfe\x11\xa2\xaa\x7a\x46\x46\x57\x56\x72\xfd\x1e\x52\xa3\x23\x82\xdc\xbf\x96\x52\xc3\x5b\xf7\xc6\xbe\xb8\xa1\xe9\x3d\x30\xff\xa6\xaa\xce\xe9\x85\x51\x43\x31\xba\x87\xca\x79\xc5\x73\x58\xf2\x8f\xe0\x82\x1f\xfe\x73\xba\xf1\xe2\x0f\x24\x6f\xff\xb6\xff\xea\xfc\xf0\x4a\xaa\xfb\xe5\x41\xa4\x4b\x43\x4d\xa9\xeb\xf0\xd3\x0b\x14\x3b\x3c\x37\x75\x82\xb0\xe1\x62\x23\xd5\xfd\x4c\x1f\x44\x1d\x8b\x5d\x30\x9e\xc6\xd7\xba\x35\xdc\x6f\x8a\x1b\x50\x1e\xa5\x87\x88\xaf\xf5\x0b\xbe\x07\xb5\x05\xd6\x2c\xd4\x7b\x03\x93\x3f\xcf\x73\xf9\xa0\x6f\xca\x3c\xd7\x2d\x58\x10\x15\x4b\x4e\x14\xdf\x66\x86\x6c\x72\x49\x0d\x30\x62\xb8\x38\x90\x35\xd5\x3c\x25\xeb\xd2\x18\x29\xda\x98\x39\xed\xc5\x9b\x82\x2a\x18\x8f\x37\x55\x15\xc7\xf1\xf1\xd8\xe1\xe4\x67\xaa\x01\x1f\xe3\x5f\x4b\x6d\xde\x3e\x08\x50\xb1\x0b\x4b\xcf\x7a\x28\xde\x15\xda\x28\xa0\xbb\x1a\xd5\x58\x22\x45\xad\xad\x50\x6b\xb1\x2c\x40\xac\x8a\x32\xcf\xa3\x36\x4c\x3b\x55\xb4\x49\xc0\x3e\xe5\x1a\x88\xd3\xdb\xcf\x90\xf1\x60\x6d\x32\xdf\x48\xb5\x6b\xea\x8b\x50\x1b\x11\xa1\x36\xd9\x0f\xa4\x47\xd2\x33\x64\x22\x22\x3b\x30\x99\x64\x17\x51\x21\xdb\xe8\x6d\x29\x4e\xe3\xcb\xe5\xed\xab\x3b\x79\x0f\xc2\x65\xbc\x56\xfd\x5c\x14\xa5\x21\xe6\x50\xc0\x45\x94\x71\xc6\x40\x44\x44\xd0\x1d\x5c\x44\x3e\x22\x91\x3d\xcd\x4b\x77\x5a\x5a\x35\xb4\xfb\x9d\x65\xce\xdb\x70\xab\x00\x44\x6d\xc3\x33\xe7\xc2\xf9\x9e\x3d\x13\xe7\xf4\x6c\xc1\xec\xe9\xb0\x18\x1b\x66\xe6\x09\x6a\x6f\xf1\x64\x44\xeb\x9d\x87\xd3\x0c\xd8\x6a\x04\x09\x01\x73\x7c\x04\xe7\x40\x2a\x12\x3f\xc7\x4a\x8e\xc4\x3d\xab\x9d\xe3\x95\xe2\x8e\xd5\xda\x6e\x88\x4e\x20\xa8\xeb\x8e\x47\xa0\x83\x3d\x88\x68\x5c\x28\xda\x3b\x1c\x7d\x5f\xaf\xdd\x45\xa5\xc9\xa3\xbc\x7c\x0c\xc7\xab\x32\xcf\x9b\x93\x32\xd8\xd7\xf8\x7c\x18\x91\x1a\x16\x8f\x47\x45\xc5\x16\x48\x7c\x05\x94\xbd\xdd\x60\x1c\xb8\x85\x3f\x4a\xd0\xe6\x6c\x84\xea\xd6\xb1\x9f\x32\x20\x66\x52\x3c\x81\x33\xe5\x50\x77\xad\x78\x5a\xaf\xa5\x06\xb6\xa2\x7a\x65\x0b\xfd\x11\x95\x0e\xd4\x88\x44\xb0\xc2\x89\xaf\x05\x83\x0f\xa8\xb3\x6f\xda\x87\xf6\xf0\x9f\x0d\x17\xe2\x31\x94\xa2\x45\xf8\xae\x35\xc0\x59\x65\xb7\xff\x06\xe5\x61\x2a\x05\xa3\xea\x40\x76\x20\xca\xc8\x6f\xe3\x1b\x42\xd1\x11\x02\x9b\x5c\x9a\x0f\x2e\x60\x03\x23\xf1\x25\x15\x97\x2e\xc6\x06\x10\x23\x46\xdb\x70\x83\x67\x3e\xcc\xc3\xe7\x74\x78\x2a\x6e\xd7\x60\x2f\x60\x43\xcb\xdc\x74\xe2\x78\x07\xb2\xc7\x2f\x3a\xd6\xb5\xd8\xc8\x20\x40\x0d\xc3\x93\x8b\x45\xce\xb7\x3e\x1d\x91\xd0\x9f\x3c\x9b\xbe\x34\xeb\x06\x9d\x4f\xb9\x7c\xaf\x43\x72\x21\x75\xc5\x94\x2c\x98\x7c\x10\x75\x97\xf4\x09\x25\xf6\x6c\xb8\x56\x40\x59\xaa\xca\xdd\x3a\x50\xb2\x5f\xd6\x60\x93\x44\x34\x5a\xce\x8d\x1c\xfd\x6e\x4d\x7e\x3c\xbe\xcc\x73\x5e\x68\xae\x97\x46\x71\xb1\xed\x34\x62\x08\x44\x9e\xfe\x7f\xd7\xbb\xc9\x54\x90\x67\x17\x24\x07\x41\xe2\x3b\x05\x80\x40\xba\x8d\x4f\x64\x9a\xe3\xf2\xb2\x5c\x1b\x45\x53\x83\xd0\x4f\xdb\x55\x17\x0f\xa6\xfc\x7b\x32\xdd\x23\xd8\x10\x43\x47\x74\xc6\xf7\x1c\xdb\xe8\x05\x49\x48\x58\x02\x3a\x07\x86\x3f\xc8\x94\x93\x69\x1e\xe4\xd2\xb0\x02\xc4\xe4\xb9\x07\x52\xeb\x07\x23\xdc\xbe\xa9\x2d\x3b\x99\x39\x2c\x61\xc8\xb4\x40\xc6\x38\x1e\x6b\x32\xb5\x7d\xb7\x26\x53\x7e\x82\x46\x83\x3c\x70\xfa\x50\xdb\x53\xaf\x6e\x6f\x8e\xde\x62\x51\x55\x2d\x5b\x74\x31\x60\x6d\x3c\x99\x8d\x95\x81\x9d\x19\x85\xcb\xc6\x03\x9f\x1a\xf4\xd9\x97\x54\xbc\x14\x74\x9d\xc3\x4b\x86\x2f\x9a\xc8\x84\xb8\x6c\x71\xc8\x73\x98\x39\xdf\xd7\x51\x58\x57\xdb\x22\x2d\x2f\x81\xd4\x8b\x1d\xb3\x20\xde\xe7\x8c\x61\x75\x1b\x68\x6d\xbc\xdd\x58\x09\x78\x38\xe7\xa0\xfd\x35\x74\x17\x34\x49\x55\x85\x0c\xd5\x87\x3a\x28\x7f\x7a\x21\x18\xac\x84\xb1\x80\x87\x15\x4a\x15\x05\x8c\xb5\x55\x5b\xbf\x68\xf3\xb2\xbc\x2b\x72\x49\x1f\x29\x4e\x69\x61\xff\x8b\x12\x39\x82\x8f\x15\x2a\x38\x43\xf5\x7b\xfb\x30\xff\x9f\xd9\x8c\xbc\x15\xf9\x81\xe8\x4c\x3e\x90\x34\x97\x02\x48\x41\x05\x60\x32\x26\xc1\xd0\x2b\x93\x3b\x5c\xd8\x02\x99\xcd\x02\xaf\xc2\x54\xf2\x2d\x1e\x47\x41\xfe\xef\x3b\xf2\xad\x90\x66\x30\xb1\xfa\xae\xe3\x5e\xad\xa4\xae\xc4\x6d\x32\x7f\x51\xfa\x41\x86\xe5\x61\x66\x79\x88\x08\x76\x4f\x33\xe6\x72\x83\xd5\xf9\x25\xae\xde\x28\x69\x64\x2a\x73\x5d\xa7\x0d\xd4\x61\xdd\x9e\xbc\x96\x5b\xdb\x9c\xd8\xad\x0a\x76\xb0\x5b\x83\x9a\x95\x2a\xc7\xfd\xcf\x8b\x62\x59\xae\xdf\xdd\xbe\xae\xaa\xa4\xd4\xa0\x12\x0d\xc6\x70\xb1\xd5\x89\x25\xbb\x2a\x3c\xe6\xc8\x6b\xa9\xe7\xdf\x5d\xe2\x57\x77\x77\x37\xcb\x40\xf7\x83\xfc\xe3\xfa\x19\xa7\xd4\xba\xab\x69\x06\x61\x4e\xce\xcc\x98\x42\x7b\x39\x6b\xe2\x17\x51\xf8\x16\x67\x34\xad\xe8\xe8\x6f\x35\xe1\x8e\x97\xf0\x0d\x79\xa7\xc1\xaf\xd8\x9f\x3a\xc4\xe1\x43\xd7\xc3\x27\xfd\x72\x7a\xf4\x00\x74\x85\x5d\x2e\xaf\xfe\xa2\xa8\x5a\x67\x5e\xa4\x56\xd0\xf6\xdd\x88\x98\x96\x64\x20\xe4\x72\x79\xf5\x38\x01\x7c\xa3\xd3\xa3\x5f\xaa\x3c\x68\x70\x5c\x1a\x39\xe1\x4f\xc4\x32\x56\x55\xb6\x9b\xea\xf2\x53\x6b\xb5\xbb\xe4\xf5\xee\xd9\x88\x08\xa6\x6d\x29\xf2\xc3\xe2\xc9\x79\x85\xd9\x02\xc4\xaf\x15\xb2\xc0\x3c\x5c\x16\x24\xcd\x79\xb1\x96\x54\xb1\xfa\x44\xf8\xc7\xd9\xda\x08\xaf\x2e\xa9\xf8\x96\x0b\x9a\x8f\x4c\x36\x52\x59\x1c\x56\x76\xec\x58\x55\x1e\x5a\x97\x69\x0a\x5a\x9f\x03\x5e\x79\x98\x76\x13\x28\x25\xd5\xd9\x2d\x16\xa2\xdd\x80\xe3\x6d\x10\xe6\x71\x2c\xed\xa9\xe2\xd4\x35\xb9\x5c\xec\x41\xd5\x0d\x64\x8d\xab\x91\xd9\x50\xb5\x05\x73\x11\x7d\xd3\x33\x65\xdb\x0a\x9e\xac\xe8\x10\x49\x71\x08\xfb\x82\x81\xe3\xf4\xeb\x2d\x6b\x95\xf7\xe5\xae\x20\x75\xe1\x16\xda\x28\x8c\x50\x38\x61\xa4\x82\xe9\xc7\x30\x62\x40\xed\xd0\x5a\x1d\x56\x3a\xb4\xdb\x12\x7d\xb0\xe4\xbf\x28\x0c\x27\xd0\x2e\x64\x35\x7c\x04\x83\xfb\xc9\xe8\xbc\x2c\xf4\xab\x53\x4a\xee\xc8\x16\x30\x34\x99\xa7\x92\xc1\x50\xfc\x68\xb1\xe5\xc6\x9f\xfb\x4e\x81\x14\x18\x6a\xf4\xa8\xf8\x9a\x67\x9e\x20\xde\x80\xe7\xaf\x2c\xc2\x4c\x67\x14\x5b\x9b\x47\x88\xd2\x82\xb6\x22\xcd\x66\x0c\x0a\x93\x91\xa7\x7f\x87\x70\x2e\xce\x62\x0b\xd4\x20\x68\x43\xec\xd7\x90\xbd\xf4\x4d\x7b\x28\xfc\x49\xe9\x5b\x60\x14\x5f\xc1\x4e\x1a\x20\x94\x31\x52\x2f\x8c\xa9\x00\x27\x9b\x2e\xb0\x5a\x8e\x6c\xde\x6a\xfa\xbf\x61\xc2\x72\x50\x5a\x67\x23\x30\x3e\xda\x9f\xda\x7b\x42\xa5\x43\x9d\x76\x12\x9d\xd7\xb1\xab\x76\x2d\xce\x6b\x71\xfd\xe2\xe5\x29\x35\x37\x6d\x47\x0f\x6b\xd0\x75\x79\x4b\xa0\xf0\x9c\xc1\xcc\x7d\xde\xf1\x59\x0d\x3f\xa3\x5d\x44\x7b\x8d\x2c\x3e\x4b\x12\xf7\x07\xe7\xf6\xae\xba\xf8\xc9\x16\x21\xbe\x7e\xfc\xe6\xe4\x81\xe6\x62\xe5\x76\xf6\x46\x8b\x9f\xc7\xc5\x7b\x30\x6b\x45\xb9\xd0\xcf\x92\x84\x33\xa0\x49\x9a\x41\x7a\x2f\x4b\x93\x6c\xb9\xf9\x09\xdf\xc4\x38\x37\xe1\x0a\x58\x5c\xe4\xe5\x96\x0b\x1d\x73\x76\xf1\x0b\x37\x3f\x5e\x33\xa0\xff\x5b\x83\xc7\xc8\xd9\xe3\xb8\x6e\x48\xf6\x19\x1f\x37\x4a\x2f\xf5\xbe\x01\x60\xcb\xe5\xd5\xbf\xe0\xf0\x17\xad\xd3\x36\xc4\xa7\xab\x3d\x4c\xef\xa7\x24\xd1\x3a\x5b\xdd\xc3\x61\x95\x71\x61\x3e\x21\x49\x87\x91\xee\xc3\xe7\xe5\x95\x91\x1c\x82\x30\x58\xe2\x5b\xb8\xc7\x27\x8e\x13\xaa\xe8\x8c\x91\xa9\x4a\x33\xbe\x87\x64\xbc\x65\x75\xed\x4a\xfc\x91\x17\xe7\xa6\x25\xb6\x47\x74\x30\x38\xc3\xfd\xfd\xfa\xa6\xa3\xa8\xaf\xc7\x87\xa1\x2a\xde\x7e\xfc\x0c\x56\xee\x9e\xdf\xc6\xbf\xfc\xfe\x37\x72\xf3\x51\x9b\xcf\x64\xe7\xf7\xe5\x5d\xc8\xcf\x49\xb7\x19\x69\xd3\xc2\xb7\xf3\xf6\xc3\xbc\xfd\xd4\xae\x24\x7e\x80\xba\x95\xb2\x1e\xab\xce\xb3\x1f\xc3\x76\x5d\x16\x84\x1a\x43\xd3\x0c\x18\xa9\xab\x88\x27\xe7\x2b\x95\x82\xa6\xf7\x76\xfa\xea\x27\xf3\xfd\x9b\x04\x8e\xa4\x8e\x6a\xce\xb2\x1f\x2d\xc6\xb9\xc1\x00\x6b\xb3\x8a\x07\x99\x29\x64\x2b\x6c\x6d\x1b\x56\x2c\x6c\xcd\x89\x59\x4b\x76\x58\x74\xc7\x44\x23\x92\x59\x50\xd5\x6a\xd0\xb0\xc5\x9c\x8e\xdb\x71\x38\xfa\x9a\x9e\x6d\xc4\x7d\x13\x8e\xe1\xa0\x1d\xb7\xce\x13\xc3\xba\xd4\xce\xdb\x9b\x71\x05\x69\xf0\xb9\xda\x5b\xc8\xa2\x3e\x1e\x9b\x3f\xae\x77\x48\xbc\x71\xfb\x44\xba\x83\x1c\x9a\xf3\xad\xc0\x8f\x13\x3e\x87\xa5\x52\x1f\xb4\x81\x5d\xef\xd2\x42\x13\x5d\x72\xba\x06\x5b\x90\x74\x40\x3b\x77\x13\x42\x82\xf3\xa4\xd1\x67\x77\xc8\xd4\x5a\x64\x9e\x58\x53\xf5\xaf\x82\xb8\x3e\xfb\xdf\x1c\x1e\xda\xd9\xc8\x60\xfc\xb9\xe7\xf5\xc8\xa5\xb9\x1e\xd2\x4e\xdc\xc6\xa1\xed\x45\x84\x16\xba\x43\xef\x16\x10\x9a\xd9\x21\xd6\xe7\xf9\xfa\xd8\xf7\x66\x44\xb5\xc2\x87\xa1\x23\xf7\x86\x14\x35\x4a\x7f\xd7\x82\xf8\xcd\x33\xb7\x79\x74\x66\x8b\x20\x1f\x80\x75\xee\x55\x34\x9e\x3d\x94\xe3\xdc\x87\xed\x33\x07\xb5\x36\xc3\x8d\xe2\x7b\x6a\xa0\xaa\x72\x99\xde\x77\xbe\x37\xe2\x97\xe1\xaa\x0a\x3e\x73\x75\x56\x7f\xe5\xd8\xbc\xf9\x75\x9b\xf8\x6a\x03\xe1\x9b\xba\x91\x3d\x99\x76\x7c\xaf\x37\x9a\x79\xbc\xfa\x47\xd3\xf0\xf1\x18\x87\x1f\x61\x93\xe6\xd0\x45\x8b\xde\x12\x49\x48\xe7\x44\xf6\xaa\x87\xee\x5d\xa1\xb0\x4a\x38\x79\x55\xa8\x7b\x3f\x28\xbc\x12\x84\x61\xf5\xb1\xa9\xfd\x13\x13\xd9\x66\xd9\xbf\xf1\x3f\x83\xcb\x5e\x1b\x29\x4d\x7d\x4f\xec\x3f\x03\x00\x8a\x1b\xb2\x3c\x84\x26\x00\x00"
 
 func repoHomeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1632,8 +2196,68 @@ func repoHomeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/home.tmpl", size: 4531, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1e, 0x55, 0xb3, 0xd3, 0x84, 0x7, 0x2c, 0x62, 0x66, 0xf9, 0xab, 0x73, 0x89, 0x89, 0xe, 0x46, 0xf1, 0x79, 0xc9, 0xd, 0xe8, 0xcd, 0x2e, 0x30, 0x33, 0x6b, 0x1e, 0x1b, 0xb0, 0xb8, 0xd1, 0x9c}}
+	info := bindataFileInfo{name: "repo/home.tmpl", size: 9860, mode: os.FileMode(0644), modTime: time.Unix(1792291920, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0xea, 0xa4, 0x8f, 0xc0, 0x4a, 0x1e, 0xf3, 0x92, 0x5c, 0xce, 0xdb, 0xd6, 0x94, 0xc0, 0xfa, 0x19, 0xcc, 0x83, 0x79, 0xae, 0x12, 0xf7, 0xcb, 0xe7, 0x84, 0x37, 0xe6, 0xaf, 0xe2, 0xdc, 0x9f}}
+	return a, nil
+}
+
+var _repoInsightsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x58\xdf\x6f\xdb\xb6\x13\x7f\x76\xfe\x0a\x42\xdf\x7c\xdf\x16\x19\x1d\xfa\x30\x24\x8a\x80\x36\x59\x81\x01\xdd\x56\x24\x19\xf6\x68\xd0\xe2\xc9\xe2\x22\x93\x2a\x79\x4a\xe2\x0a\xfc\xdf\x07\x92\xfa\x6d\xd9\xb5\x5b\x07\x28\x96\x87\x98\x16\x79\xc7\xbb\xcf\xf1\xf3\xe1\xc9\x55\x85\xb0\x2e\x72\x8a\x40\x82\x25\xd5\x30\xcf\x80\xb2\x80\x84\xc6\x9c\x45\x8c\x3f\x91\x24\xa7\x5a\x5f\x07\x0a\x0a\xa9\x39\x4a\xb5\x21\x5c\x68\xbe\xca\x50\x07\xf1\xd9\xac\x6f\x6d\x97\x38\x6b\x50\xde\x7e\xd6\x77\x50\x72\x92\x48\x81\x94\x0b\x50\xd6\x72\x3c\xa9\x21\x91\x82\x51\xb5\x21\x6b\x10\xa5\x5b\xb1\xed\x7d\xa9\xa8\x48\xb2\x05\x53\xb2\x60\xf2\x59\xd4\xdb\x0c\x7d\x29\x1b\x5c\xcf\xc9\x2c\xa2\xcd\x14\x47\x58\x07\x24\x53\x90\x5e\x07\x55\x15\xde\x41\x21\x3f\x72\xf1\x68\xcc\x9c\x41\x01\x82\x81\x48\x38\xe8\x20\x8e\x78\x63\x21\x13\xe4\x89\x14\xa4\xfe\xbc\x28\x68\xf2\x48\x57\x10\xc4\xd1\x9c\xc7\xa4\xaa\x42\xfe\xe6\x17\x11\x3e\x28\x1f\x5f\x38\x70\x63\x4c\x34\xa7\x2e\x84\x68\xce\xf8\x53\x7c\xd6\x1f\x64\x6f\x7b\xb9\xa3\x2c\x08\x45\xa4\x49\x06\x8c\xd4\x08\xd6\x00\x8c\x36\x68\xb0\x0f\x19\x57\x90\xa0\x54\x7e\x23\xe7\x3a\x7b\x3b\x01\x6b\xeb\x56\xc3\x6a\x0d\x02\xbd\xdf\xa8\x88\x0f\x72\xbd\x60\xa0\x13\x97\x48\x31\x08\xbf\xaa\x78\x4a\xc2\x5b\xae\xee\x91\xa2\xae\x6b\x80\x74\x99\xc3\xd4\xd6\x6e\xa2\x29\x06\xda\xfc\xfc\x78\x16\xa1\xaa\x47\xf6\x79\x8b\xb9\x00\xf2\xcc\x19\x04\xf1\xff\xa2\x39\x66\xbd\x15\x07\x04\xbd\x71\xd1\x62\x36\xe1\x17\x33\x05\xde\x33\xf1\x67\x84\xe6\x7c\x25\x80\x05\x7b\xdc\xa6\x3c\x07\x7d\x5a\x97\x9a\x7f\x81\xa1\xc7\x68\xde\xe0\x10\xcd\x7b\xf0\x44\xb8\x94\x6c\x53\xaf\xa9\x2a\x45\xc5\x0a\xc8\x39\xff\x89\x9c\x3f\x91\xcb\xeb\x31\xfc\x43\x38\x67\x11\xb2\xb8\xaa\xde\x31\x46\xce\x39\x79\xe3\xb6\x63\x83\xc9\x88\xb6\x44\x38\xef\x33\x41\xab\x64\x5e\x55\xbf\xea\x84\x16\xf0\x49\x96\x82\x91\xf3\xf0\xbd\xa3\xdd\x1f\x74\x0d\xc6\x8c\x26\xc3\x4f\x14\x33\x63\xf6\x71\xc6\x62\x78\xd1\xd5\xa7\xa5\x8e\xb7\xb4\x1c\x19\x07\x37\xa4\x72\x1f\xd3\x0f\xb6\x1e\xc6\x1c\x6c\x60\xd7\xdf\xf3\x2f\x40\x42\xfb\x7f\x68\xd8\xc1\x3e\xab\x2a\x10\xac\xc6\x31\x9a\x77\xb8\x47\x73\x77\x76\xfd\x91\x87\x5c\xc3\xb6\xdc\xec\xe4\xd8\x1e\xf2\x0a\xb9\xd8\xe2\x6f\x9f\x5c\x3e\x98\x53\xc8\xc4\xb2\xd4\x8b\x94\xda\x8d\x02\x63\x2e\x2d\xea\xef\x4b\xfd\xc1\x3d\x08\xfd\x87\xdf\xdc\x13\xfa\x37\xdd\xce\xde\x71\xfd\xb8\x31\x26\xd2\x05\x15\xbd\x10\x14\x30\xb2\xa4\x9a\x27\x24\xa7\x4b\xc8\x83\xf8\x90\xad\x17\x8a\xeb\x47\x77\xe4\xad\xb7\xb8\x03\xfb\x74\x9a\xd5\xdb\xcc\x49\x16\xe9\xf2\xfc\x9d\xfe\x23\x15\xc7\x4d\xef\xd1\xdf\x5c\x30\xf9\x7c\x4b\x37\x7a\x97\xb4\x75\x28\xbd\x2b\x31\x93\xea\x48\x8d\x9b\x20\xee\x2e\x97\x93\xbc\x0d\x3d\xd7\x76\x1d\xf3\x7d\xb2\x73\xbe\x0b\x22\x7b\xfb\x2a\xbe\x2c\x91\x4b\xa1\x17\x89\x5c\xaf\x39\xea\x80\x84\x37\x7e\xf4\x03\x71\x43\x41\x02\x02\xdb\x10\x5f\x91\x1e\x03\x4c\x4e\x7d\x8f\x0e\x01\xdf\x7b\x93\xde\xf4\x97\x1e\x87\x63\x7d\xba\x06\x1e\x3e\xc2\xaa\xab\xd9\x6c\xcc\xe1\x01\x7f\x3b\xd9\x4e\x29\x49\xe9\x85\xfe\x5c\x52\x05\x01\xd1\xb8\xc9\xe1\x3a\x48\x64\x2e\x95\xd3\x8d\x1b\x3b\x32\xa6\x93\xef\xe6\x88\x3a\x4e\x9f\x8d\x4e\x4a\x9b\xdf\x77\x51\x66\x02\x96\x6d\xba\xb4\xa4\x78\x96\x75\xdb\xb0\xff\x6a\x0b\x1f\xe8\xca\xc7\x1e\xc4\xfd\x6f\x53\x17\x51\x3b\xf6\xe5\xa8\x41\x61\x5c\x17\x39\xdd\x5c\x92\x34\x87\x97\x2b\x92\x81\xad\xf7\x25\x79\x13\xfe\x0c\xeb\xab\xa0\xb3\xe9\x12\xb9\xf7\x45\xeb\x72\x98\x8d\x2a\x5c\xc8\x82\x8b\x15\x29\x8b\x16\xf9\x67\xce\x30\x73\xc8\x7f\x02\x65\xf9\x60\xcc\xff\xaf\xc8\x92\x26\x8f\x2b\x65\x6f\xde\x8b\xad\xd2\x5c\x05\x84\x51\xa4\x17\xf6\xdc\x81\x40\xd7\xe2\xfa\xd4\xea\x55\x35\xcf\xeb\x65\x4f\x54\x71\x6a\x91\xbd\x0e\xb8\x78\x02\x85\xb6\x24\x5c\xb8\x0b\xba\x29\xde\x6c\x4b\x01\x66\x83\xe2\xfa\x6f\xc8\xf6\xd5\xe3\x3f\x29\x51\x13\xc2\xf1\x5a\x0a\xc5\x40\x3e\x0b\x50\x16\x0f\x91\xe6\x3c\xc1\x57\x10\xaa\xed\x3d\xbe\xa6\x57\x0c\xfe\x74\x16\x37\x8d\xc1\x89\x5f\x02\x34\x7f\xa9\xd9\x7c\x50\xdc\x05\xc5\x6c\xbb\x4d\x3f\xcc\x56\x95\x5b\x3d\xfe\x31\x1d\xf9\x6e\x30\x26\xae\xf6\x63\xba\xee\xbb\xf6\x95\x3b\xbc\x85\x94\x96\x39\xfa\x3e\x7c\x77\x0f\xbe\xb7\xa9\x6e\xc7\x5d\xe4\x77\xa5\xeb\xa6\xcf\x06\x92\xd4\xad\x1b\x5d\x1d\x08\x2f\x48\x56\x0a\x36\x5f\xe1\x70\x0b\x6c\xce\x05\x04\x24\xfc\xc8\xc5\xe8\xa2\xf0\x7f\x91\x5d\x5a\xc7\x8c\xa0\x84\x5d\xe3\x1e\xf5\xd6\xb4\xa1\x7a\x80\x8d\xa9\xaa\xd0\x18\xd2\xb0\x39\xe2\x07\x86\x22\xe4\xc2\x8f\x5c\x9d\x79\x3c\x96\xb4\x91\xa6\x8d\x25\xef\x07\xd2\x9d\x5d\x72\x30\x29\x3f\x35\x5b\x73\x29\xa0\xff\xa6\x7e\x98\x28\xed\x89\x04\x15\x4d\x53\x9e\xb4\x3b\x7b\x25\x3a\x30\xc1\xbd\x5a\x54\x7b\x6e\xba\xf8\x07\xff\x75\xd0\xaa\x7f\x43\x73\xb1\x53\x6e\x0e\x89\x84\x9e\xf8\x37\x85\xc6\x71\x62\xcb\xa2\x5f\xc7\x77\x29\xf8\xe7\x12\xbe\x47\xd4\x46\x67\x66\xf2\x35\xe5\x96\x22\x7c\x58\xe3\x7d\x26\x15\x92\xf0\x96\x6e\x8e\x78\x33\x0f\x1f\x24\xd2\xfc\x18\x83\xbf\x5c\x4e\x47\x37\x01\xb3\x08\x53\x29\xf1\x5b\xcb\x8f\x36\xcc\xdd\x45\xda\x0e\xd3\x21\xd7\x25\x77\x84\x51\x2f\xc1\xc9\x9a\xb5\x69\x0c\xf5\xc5\x67\x5d\x93\xa2\xfe\xd8\xfa\x55\xd7\xda\x36\xbf\xcb\xfe\x3b\x00\x11\xbc\x6e\x18\xf3\x15\x00\x00"
+
+func repoInsightsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoInsightsTmpl,
+		"repo/insights.tmpl",
+	)
+}
+
+func repoInsightsTmpl() (*asset, error) {
+	bytes, err := repoInsightsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/insights.tmpl", size: 5619, mode: os.FileMode(0644), modTime: time.Unix(1792270780, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x43, 0x6e, 0x1d, 0xc3, 0x96, 0xf1, 0x5d, 0x81, 0x3, 0xbd, 0x66, 0x14, 0x90, 0x6d, 0x21, 0x31, 0x6e, 0x20, 0x45, 0xa4, 0x11, 0x4c, 0xcd, 0xc3, 0xb, 0xe9, 0x73, 0x36, 0x67, 0x2a, 0x41, 0x31}}
+	return a, nil
+}
+
+var _repoInvitationTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x92\x4f\x8f\xda\x30\x10\xc5\xcf\xe1\x53\x8c\x7c\x6a\x2f\x41\xcb\x69\x0f\x01\xa9\x6a\xbb\x2a\x2a\xdb\x03\xd0\x5e\x57\x26\x1e\xc8\x68\x1d\xdb\xb2\x87\x68\x51\x9a\xef\x5e\x39\x21\x7f\x96\xb6\x9c\xac\x4c\x66\xde\xbc\xf7\xd3\xd4\x35\x63\xe9\xb4\x64\x04\x71\x90\x01\xe7\x05\x4a\x25\x20\x6d\x9a\x59\xa6\xa8\x82\x5c\xcb\x10\x96\xc2\xa3\xb3\x81\xd8\xfa\x0b\x90\xa9\x88\x25\x93\x35\x62\x35\x4b\xa6\x4d\x67\x82\x92\x94\xd2\x08\x15\xfa\x0b\x78\xd4\xf2\x0d\x15\x38\x79\x42\x38\x79\x52\xb1\xff\xdd\x40\x6e\xf5\xb9\x6c\x65\x92\x24\x3b\x5a\x5f\x4e\x94\xe2\xa7\x00\x99\xc7\x45\x4b\x51\xd7\xe9\x86\xcc\x6b\xd3\x08\x28\x91\x0b\xab\x96\xc2\xd9\xc0\xdd\x68\x52\xd7\xe9\xe7\xdd\xf6\x69\x6f\x5f\xd1\x7c\xdb\x3f\x6f\x9a\xa6\x2d\x67\xc5\x62\x22\xc8\xd6\x81\x64\x96\x79\x81\x0a\x62\x4a\xf4\xd7\xf1\x38\x4f\x0f\x8f\x26\xdd\x7b\x68\x93\xa6\x63\xc6\x94\x89\x35\x8a\x5e\x71\x5e\x2c\xba\x99\x9b\xdc\x83\x70\xc0\x53\x89\x86\x47\xe5\x1b\xbc\x52\xa3\xe7\x8e\x6f\xdb\x90\xb9\xd5\xbd\xed\x0a\x43\x2e\x20\x5d\xc7\x0a\x5e\x1f\x9f\x7e\xa1\xe0\xb4\xbc\xfc\x90\x25\xf6\xff\xb6\x71\x30\x22\x7a\x57\x78\x3a\x6b\xdd\x76\x7d\x18\x56\xf4\x5a\xcf\x56\xe1\xfa\xe1\xd1\x7c\xc7\xcb\x47\xf8\x0d\x3b\xf6\x8b\x0e\x5d\x36\x77\x83\x79\x3a\x42\xba\x0e\x5f\x4b\x49\xfa\x17\x7a\x3a\x12\xaa\xde\xf8\x2d\x01\x45\x15\xb5\x48\xb3\xb9\xa2\x6a\xf5\x8f\x26\xc6\x37\x06\x4f\xa7\x62\xa0\x93\x24\xd9\xe1\xcc\x6c\xcd\x44\xe7\xe4\x11\x0d\x74\x65\x71\x97\x8d\xcc\x73\x74\x2c\xa2\xe3\xae\x7d\x58\x3a\x71\x50\xd7\xa8\x03\x8e\xa6\xef\xe3\xc6\x98\xf4\xc5\x58\x7e\xa9\xae\x71\x47\xf8\x2d\x05\xf8\xe4\xdc\xee\x7c\xf8\xb9\xdd\xfc\x97\x19\x1a\x35\x5c\x4b\xef\x23\x9b\xc7\x73\x5e\xcd\xc6\xda\xf5\xbd\x3e\x7f\x9d\xc9\xd1\x5a\x46\xdf\xdd\xc9\x9f\x01\x00\x46\xb9\x06\x5f\xa3\x03\x00\x00"
+
+func repoInvitationTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoInvitationTmpl,
+		"repo/invitation.tmpl",
+	)
+}
+
+func repoInvitationTmpl() (*asset, error) {
+	bytes, err := repoInvitationTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/invitation.tmpl", size: 931, mode: os.FileMode(0644), modTime: time.Unix(1792288754, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x82, 0x7e, 0x8d, 0xb6, 0x92, 0xd0, 0x1d, 0x70, 0x70, 0x3d, 0xa0, 0xaf, 0xa2, 0xbc, 0xa0, 0xa7, 0x32, 0x70, 0xc8, 0x96, 0xc9, 0x9d, 0xfa, 0x44, 0xcd, 0x56, 0x50, 0x75, 0x23, 0xa4, 0xbf, 0x2f}}
+	return a, nil
+}
+
+var _repoIssueChooseTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x54\xcd\x6a\xdd\x3c\x10\x5d\x3b\x4f\x21\xc4\xb7\x96\x09\x64\xf1\x2d\x7c\x6f\x37\x25\x50\x28\x5d\x94\xd2\x6d\xd0\xb5\xc6\xf6\x10\x5b\x32\xd2\x5c\xa7\x45\xe8\xdd\x8b\xfc\xab\xf8\xfe\x34\x74\xe5\xb1\x66\xce\x39\x33\x73\x84\xbc\x27\xe8\xfa\x56\x12\x30\x7e\x92\x0e\xf2\x06\xa4\xe2\x4c\x84\xf0\x50\x28\x1c\x58\xd9\x4a\xe7\x0e\xdc\x42\x6f\x1c\x92\xb1\xbf\x99\x86\x37\x86\xce\x9d\x81\x1f\x1f\xb2\x14\x1e\x6b\x46\x38\xd8\x89\x20\x4b\x19\xce\xc8\x4a\xa3\x49\xa2\x06\x1b\x91\xef\x92\x5a\x0e\x27\x39\x1d\x5f\x52\x8e\x62\xf9\x5c\x32\x11\x67\x45\xae\x70\xd8\xb3\x9c\x91\x29\x1c\x30\xea\x1f\xb7\x82\xe6\x29\xc9\x93\xe9\x99\x24\x92\x65\x03\x8a\xcd\xbd\xce\xaa\x02\x1f\xff\xd7\xe2\x87\x9d\x54\xc5\xa8\xea\x44\x65\x6c\x27\xca\xc6\x18\x07\x7c\x56\x6e\x9e\xae\x08\xaf\xa4\x0e\xea\x0e\x34\x4d\xac\x57\xbb\x53\x0c\x09\x3a\x37\x55\x64\xde\x5b\xa9\x6b\x60\xe2\x4b\x14\x7c\x36\xb6\x73\xa3\xce\x0e\x1d\x21\x33\xe2\x7d\x22\x2e\x75\xd5\x1b\x93\x32\x51\xb4\x58\x37\xc4\xaa\xd6\x48\x02\xc5\xbc\xc7\x2a\x0a\xfd\x94\x2d\xaa\x10\x6a\x0b\xa0\xbd\x07\xad\x42\x60\xa7\x33\x91\xd1\x9c\x35\x16\xaa\x03\xf7\xfe\x3f\xf1\x1d\x7a\xf3\x15\xf5\x6b\x08\x93\x05\x2e\xd7\xf0\xf6\x69\x31\xe7\xe0\xbd\x78\xc6\x16\xb4\xec\x20\x04\x7e\x8c\x88\xdb\x1b\xac\x81\x5e\x1c\x49\x4b\xa0\x78\x08\x45\x2e\xb7\x76\x93\x59\x16\x43\xbc\x17\xdf\x46\xda\xd5\xc5\x8b\x52\x05\xae\xb4\xd8\x13\x1a\xbd\x8d\x9e\xed\x06\x5c\xcf\xa3\xbd\x9f\x37\x44\x92\xf1\x1e\x5a\x07\x69\x69\xe1\x7a\xa9\x17\x19\x82\x5f\xc4\x2c\xa8\xbf\xcd\x87\x7a\x88\x92\x9c\x25\x3b\x29\xf2\xc8\x94\x36\x37\x6e\x7a\x9d\x26\x9d\x2d\xfd\x49\xe2\x14\x72\xeb\x36\xdc\xbb\x0c\x77\xee\xc2\x47\xfd\x3e\xb5\x52\xbf\x1e\x1e\xff\xd9\xe0\xeb\xfe\xde\xa3\x1a\x15\xf9\xce\xfc\x9b\xde\x7f\x80\xeb\x25\x02\x76\x84\x49\xbc\x85\x6b\xb4\x04\xf3\x77\xfe\x5c\xbc\x95\x95\x31\xb4\x3c\x76\x7f\x06\x00\x26\xf1\x7b\xb8\x49\x05\x00\x00"
+
+func repoIssueChooseTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoIssueChooseTmpl,
+		"repo/issue/choose.tmpl",
+	)
+}
+
+func repoIssueChooseTmpl() (*asset, error) {
+	bytes, err := repoIssueChooseTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/issue/choose.tmpl", size: 1353, mode: os.FileMode(0644), modTime: time.Unix(1792260099, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x37, 0x39, 0x2b, 0x49, 0xaa, 0x12, 0x62, 0x4f, 0x22, 0x20, 0x75, 0xb2, 0xac, 0xeb, 0x55, 0xe4, 0x9b, 0x40, 0xaa, 0x6, 0x60, 0x2b, 0x1e, 0x4a, 0x87, 0x2, 0xcc, 0x19, 0xc6, 0x48, 0xe0, 0x7f}}
 	return a, nil
 }
 
@@ -1657,6 +2281,26 @@ func repoIssueComment_tabTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _repoIssueForm_fieldsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x55\x4f\x6f\x1b\x2f\x10\x3d\xaf\x3f\x05\x42\x3e\xfc\x7e\x17\xac\xf6\x54\x55\xbb\x96\x5a\x59\x69\x22\x25\x6a\x95\x46\xb9\x56\x64\x19\xd7\x28\x2c\x10\x60\xd3\x44\x88\xef\x5e\xc1\x2e\xfb\xc7\xd1\xb6\xf6\xa1\x27\x63\xe6\x31\xf3\x66\xde\xcc\x6c\xc9\xa5\x6e\x1d\x72\xaf\x1a\x2a\x7c\xe0\x8c\x81\xc4\x48\xd2\x06\x2a\xec\xa0\xd1\x82\x3a\xc0\xe8\x99\x8a\x16\x2a\xec\x3d\xb9\xb2\xb6\x85\x0b\x65\x1a\x72\xc1\x05\x44\x5c\x08\x78\xbb\xf2\x7e\x9d\x30\x16\x7d\xac\xd0\x08\xba\x4f\x77\x21\xac\xbc\x37\x54\xfe\x84\x89\x89\x7c\x56\xec\x35\x84\x55\xe1\x3d\xdf\x23\x78\x42\xe4\xee\x55\x03\xc2\x0d\x35\x8f\x4c\xfd\x92\x38\xda\x8a\x92\xf1\x67\x54\x0b\x6a\x6d\x85\xf7\x1c\x04\x43\x83\x7d\xeb\xfd\x77\x67\xde\x5f\xde\xdd\x5c\x23\x72\x0b\x92\x81\x01\x96\x02\x86\x50\x6e\x18\x7f\xde\x46\xdf\x20\x2c\xbc\xf1\x94\x42\x92\x7b\x2a\x38\xa3\x8e\x2b\x69\xc9\x2d\x3c\xb5\xdc\x00\x0b\xc1\xf4\x27\xef\x41\xb2\x10\x50\x8a\x8a\xb7\xab\xa2\x28\x4a\x41\x1f\x40\xa0\xbd\x32\x15\xde\x2b\xd3\xfc\x88\xf5\xd8\xc5\xfc\xbd\x27\x9f\x9c\x33\xfc\xa1\x75\x60\xc9\x75\x84\x45\x12\x09\x9f\x9e\x76\x11\x27\x98\x1d\xd8\xda\x70\x1d\xa3\x27\x7a\x45\x51\xea\x4c\xef\x00\x42\x1f\xfb\x9c\xe1\xcb\x8d\xee\xbd\x26\x8e\x43\x80\xb1\x8a\x49\x54\x9c\x3d\xa7\x7f\x88\xb3\x23\xda\xbd\xcc\xf3\xbb\x41\x6a\x65\xd0\x7f\xbd\xa8\xe4\x0b\x38\x44\xae\x76\xff\xcf\x52\xe8\x6b\x8d\x91\x16\xb4\x86\x83\x12\x0c\x4c\xea\x91\x09\xe6\xdb\x68\x8a\xc8\x33\x0a\x9f\x13\x14\x16\xd0\x2c\x35\x07\x2f\x8e\x1a\xa0\x43\x76\xf9\xe2\xc4\x04\xff\x0d\xdb\xd3\xeb\x55\x6e\x32\xe1\xc5\x14\x99\x51\x7a\x9c\x81\x88\x59\x73\xd6\x4d\xd6\x2e\x67\x6d\x41\x40\x7d\xaa\xa8\x7d\x63\xb5\x1c\x0d\xae\xd1\x9b\x96\xbc\x69\x85\xe3\x5a\x40\x08\x4d\x7f\xca\x33\x70\xae\x6c\x7d\x3b\x4a\xe5\x16\x02\x74\xa0\xa2\x54\xa9\xa1\x73\xcf\xc5\x9e\x5f\x13\xfe\xee\x83\x24\x77\x06\x61\x03\x5a\x11\x1e\xf7\x85\x25\x31\x1d\xd2\xa5\x8c\x63\x09\xbb\x87\x43\xb0\x61\x0c\x8a\x62\xd8\x34\x93\xc0\x5f\x13\xda\x2e\xc5\xf5\x3e\x8f\x6c\x5f\x95\x2c\xe3\x25\xb5\x28\x56\x3e\x9b\x3b\x02\x53\xd1\xc7\x59\x5f\x64\x54\x6e\xba\x67\x8b\x6a\xd7\x07\xa8\x1f\x1f\xd4\x0b\xd8\x3f\xe8\x7d\x42\x5a\x6f\x37\xdc\xf2\x56\xe3\x52\x70\x09\xd3\xe5\x76\xe4\xa0\xe5\x28\xf3\x1a\xec\x79\x91\xcc\x3a\x6c\xcd\x59\xac\x5b\xf7\xfd\x18\x9e\x9c\x5d\xda\xf4\x72\xe4\xf7\x17\xfe\x23\xa3\x6e\xc5\x4e\x85\x18\x97\x6e\x2a\x7e\xf7\x21\x38\x3a\xcf\x16\x67\x3e\x4e\x3e\x1a\xe9\x26\xff\xfe\x1e\x00\xc3\x8b\xd9\xd7\x22\x07\x00\x00"
+
+func repoIssueForm_fieldsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoIssueForm_fieldsTmpl,
+		"repo/issue/form_fields.tmpl",
+	)
+}
+
+func repoIssueForm_fieldsTmpl() (*asset, error) {
+	bytes, err := repoIssueForm_fieldsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/issue/form_fields.tmpl", size: 1826, mode: os.FileMode(0644), modTime: time.Unix(1792260099, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x62, 0x1e, 0x45, 0xa1, 0x8, 0xd3, 0xf1, 0xfa, 0x6b, 0x9e, 0xff, 0xdd, 0x1a, 0xce, 0xed, 0xb5, 0xd8, 0x6c, 0x4c, 0x2, 0x9f, 0x33, 0x72, 0xb9, 0x6, 0xf, 0x43, 0x37, 0xaf, 0x68, 0xab, 0xfc}}
+	return a, nil
+}
+
 var _repoIssueLabel_precolorsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd2\xcf\x4a\xc4\x30\x10\xc7\xf1\xbb\x4f\x51\xe2\x79\x31\x8d\xf9\xd3\xca\x76\xdf\x65\x32\x33\x51\xb0\x58\xd8\x56\xd0\xb7\x17\x9b\xf1\x36\x97\xf1\x9a\x2f\x7c\xf8\xc1\xe4\x0a\x03\xae\xb0\xef\x8b\xc3\x6d\xdd\xee\x6e\xd8\x8f\xef\x95\x17\x57\x01\xdf\x5f\xef\xdb\xe7\x07\x5d\xce\xf0\xf2\xc8\xe3\x48\x61\x74\x03\xc1\x01\xfd\xed\xf2\xc6\x5f\x8b\xfb\x0b\xb7\xeb\x13\xdc\x1e\x0c\x5e\xcd\x31\x78\xcd\xeb\xc1\xea\xb5\x8a\xe0\xa3\xe2\x49\xb0\x7a\xde\xcf\x93\xd7\xf6\x49\xb0\x7b\xb9\x96\xa4\x7a\x67\xb0\x7a\xc1\x17\x62\xcd\x93\x60\xdf\x97\x02\xa2\xba\xef\x0c\x56\x2f\x3d\xf3\x3c\x16\xc5\x93\x60\xbe\x6f\xc6\x8c\x9a\x27\xc1\xec\x01\x4d\xba\xd7\x83\xd9\xe3\x16\x50\xfb\x2f\x12\xac\x5e\x6d\x9c\x6a\x53\x3c\x09\x76\x8f\x80\xb4\xfb\x4a\xb0\x7a\x58\x88\xdb\xa4\x78\x12\xfe\xb1\x2f\xb6\xa0\xef\xfb\x0d\x56\x8f\x22\xa6\x36\x2b\x9e\x84\xee\xfd\x04\x00\x00\xff\xff\x88\x5f\xc2\xeb\x00\x05\x00\x00"
 
 func repoIssueLabel_precolorsTmplBytes() ([]byte, error) {
@@ -1697,7 +2341,7 @@ func repoIssueLabelsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoIssueListTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5a\x5f\x6f\xe3\xb8\x11\x7f\xf6\x7d\x0a\x56\x0d\x82\xbb\x07\xd3\xd8\xb7\xe2\x2e\x4e\xb1\xe7\x45\xb1\x01\xd2\x34\xd8\x04\x7d\x35\x68\x69\x6c\xf3\x96\x22\x75\x24\xe5\xc4\x55\xf5\xdd\x0b\xfe\x15\xe5\xff\xd9\x6c\x76\xbd\x7d\xb2\x2c\x92\xc3\x99\xdf\xfc\x66\x48\x0e\xd5\x34\x1a\xca\x8a\x11\x0d\x28\x9b\x11\x05\xa3\x25\x90\x22\x43\xb8\x6d\x7f\xba\x2a\xe8\x0a\xe5\x8c\x28\x35\xce\x24\x54\x42\x51\x2d\xe4\x3a\xbb\xfe\x69\x90\x0e\x32\x2d\x76\x10\x48\x37\x6c\x90\x8e\xab\x29\xca\x05\xd7\x84\x72\x90\x66\x64\xaf\x91\x93\xd5\x8c\xb8\xd7\xdb\x22\xa9\x52\x35\x8c\x7c\x17\x27\x78\xb0\x29\x5a\xd2\xc5\x52\xbb\xf1\x83\xa6\xa1\x73\x84\xef\xc9\x02\x6e\xd4\x8d\x19\x7b\x4b\x95\x76\xa3\x06\x83\x2b\x92\x8c\x5a\x48\x00\x8e\x66\xb5\xd6\x82\x67\x68\x29\x61\x3e\xce\x9a\x06\x7f\x82\x4a\xdc\x52\xfe\xb9\x6d\xdd\xdc\x6a\xc4\xe1\x29\xbb\x6e\x1a\x4c\xdf\xfd\x8d\xe3\x47\xe9\x14\xc3\xae\x11\x9b\xc6\xb6\xbd\x1a\x91\x30\x3d\x30\x05\x47\xe7\x43\x56\x4d\x2e\x34\xc2\xf7\x35\x63\x9f\xe0\xcf\x1a\x94\x9e\xe8\x67\xfc\x9e\x31\xf1\x04\x45\xdb\x16\x54\x91\x19\x83\xa2\x69\x80\x17\x6d\xdb\x69\x68\xed\xdb\x33\xa8\x69\x36\x9b\x7e\x27\x0a\x8c\x49\xd8\xdb\x94\x8b\xb2\x22\x12\x46\xde\x52\xe7\x4c\xfc\x01\xe6\xa4\x66\xfa\x77\x49\x78\xbe\x6c\x5b\x8c\xf1\xb6\xa4\x8f\x40\x8a\x1b\x3e\x17\x66\x16\xa7\xd2\x36\x28\x55\xcd\xd8\x2e\x4c\x4c\x77\xeb\xb8\x51\x41\x57\xd6\xff\xf1\xa1\xef\xca\x82\xae\xa8\xa1\xd0\xf5\xbe\x0e\x9a\xf2\x35\x9a\x11\x45\x73\xa4\x34\xd1\xb5\xf2\x88\x2a\xe7\xff\x1e\xe2\x1d\xc8\x37\xea\x61\x29\x9e\x26\x4c\x28\x83\x92\xf3\x04\xc9\x35\x5d\x81\x57\xce\x8b\xdc\x64\xc3\x85\x87\xed\xef\x7a\x5d\xc1\xd8\xfc\xff\x37\x85\xa7\xc7\x75\x05\x6d\x7b\xa9\x84\xd4\xf6\xdd\x83\x90\x3a\xbc\xd3\x44\xc3\x58\x54\xc0\x2f\x19\x99\x01\x53\xe3\xa6\xc1\x0f\xc0\x20\xd7\xb7\xf6\x7f\xdb\x5e\x96\x94\x81\xd2\x82\x1b\x81\xf8\x9f\xe1\xcf\xcd\x87\xb6\xbd\x24\x4a\xd1\x05\x07\xdb\xf2\xde\x3f\x9b\x06\x4f\xee\x2b\x1a\xac\x13\xb9\xa6\xb9\xe0\xc8\xff\x0e\x2d\x1b\x87\x66\x5e\x28\x0c\x78\x34\x40\xbf\x9b\xb5\xa6\xe3\x54\x93\x59\x66\xa0\x51\x35\x3c\x68\xa2\x15\xfe\x57\x05\x7c\x22\x6a\xae\x83\xb3\xc8\x1e\x4c\x37\xf0\x94\x50\x7c\x11\x9a\xa7\x81\x99\xdb\x59\xbe\x07\x9c\x6e\xe6\x13\xe0\xb4\x1d\xb7\xf1\x74\xf8\x6c\x23\xba\x8f\xdb\x36\x8f\xa1\x39\x13\x44\x43\x81\x14\xe4\x82\x17\x44\xae\xd1\x9c\x32\x0d\x12\x95\xc0\x6b\xcf\xf2\xbf\x0c\x87\xc8\x02\x80\x86\xc3\xeb\x1d\x19\xb1\x63\x7e\x80\x69\x23\x9d\xa0\x42\x8a\xaa\x10\x4f\x1c\xfd\x51\x97\x15\xa2\x1a\xca\x00\x8a\xaa\x08\x0f\xa2\x34\x3c\x87\xc4\xba\xd7\x78\xa7\xdd\xd4\xfa\x27\x8b\x99\x2f\x42\x1b\x27\x32\xc0\x26\x58\x5e\x8d\xcc\x44\xfe\x39\xd1\xbe\xb3\x32\x65\x9e\x55\xf0\xb5\x61\x69\x5f\x9a\xa7\x0d\xd6\x5c\xec\xa7\xcd\xc5\x06\x6f\x4e\x01\x61\xca\xc5\x54\x59\x8e\xa6\x59\x70\xd0\x34\x92\xf0\x05\x74\x2e\x71\xaf\xdf\xd2\xc8\x2e\x66\xac\x5d\x5f\x68\x73\x8f\x11\x21\x52\x2c\xc3\xe0\x4f\x74\xd1\x0b\x47\x64\x27\x0a\x51\x94\x2f\x21\xff\x9c\x2c\x16\x5d\x36\x9e\x98\x16\x93\x3a\x2e\xf9\x4c\x55\xbf\xf9\x3e\x9e\x12\xbd\xf9\xac\x09\x28\x17\x4c\xc8\x0c\x29\xbd\x66\x30\xce\x66\x24\xff\xbc\x90\xa2\xe6\xc5\xd0\x36\xfc\x8a\x9a\x06\x4f\xcc\x93\x55\xd7\x49\x31\xef\xee\x48\x09\xe8\xbf\xe8\x81\x70\xaa\xe9\x7f\xa0\xef\x8d\xb8\x28\x75\xf1\x18\x9e\x62\x8c\x45\x8c\x8e\xc6\x59\xec\xf9\x2d\x62\x2d\x3a\xf2\xc7\x89\xb7\xbd\xe9\xfb\xcb\x83\x2d\xa2\x70\x2c\xe0\x52\xdf\x6c\x05\x5d\x47\xe4\x24\x20\x1c\x8f\xdd\x9a\x86\x9c\xe8\xce\x9d\x6f\x87\xcd\xc5\xc1\xb5\xed\x84\xdc\xf4\x6a\xc2\x07\x89\x47\xf9\x1e\x3a\x7e\x0b\xba\x07\x9b\xff\x0f\xd8\x7e\x20\x05\x1f\xe5\x3b\x51\x6a\x41\x39\x1c\xa5\x7b\xe2\x9a\x03\x6c\xef\xb8\x73\x8e\x64\x3f\xb0\x3c\x61\xbf\x2a\xd1\x72\x81\x94\xcc\xfd\xa1\x8d\xbd\x5f\x11\x4d\xa4\x53\x2f\xb3\xd9\xff\x03\x55\x15\x23\x6b\x13\x13\x2f\x0f\x04\xa3\xff\x9e\x20\x88\x9c\x33\x20\x7c\x35\x92\x1b\x61\x6f\x46\xf0\xe0\xf6\xe8\x2e\x94\x11\x66\x76\x6b\xfd\x6d\xfb\x21\x67\x13\xc6\xde\x90\xfc\xaf\x58\x06\x8c\x76\x98\x30\x36\x75\xaf\x7b\x31\x71\x18\x01\x37\x4b\xf1\x32\x18\xfc\xa0\x73\xc6\xc2\xab\x38\xd5\x62\xba\x16\xf5\xe9\x80\xe4\x12\xcc\xc9\x63\x3a\x5b\xbf\x08\x92\x6e\xd8\x19\x83\xd2\x29\xf9\x32\x4c\x4a\xe0\x9a\x8a\x97\xb2\x24\x8e\x3a\x63\x44\xbc\x8e\x94\x2f\x36\x11\xd9\x9f\x15\x8d\x11\xdf\x34\x2b\x1a\xf8\xde\x36\x2b\x0a\x89\x7e\x36\x2e\x0f\xfe\x41\x19\x23\x1a\x94\xce\x7e\x41\x3f\xdb\x9d\x4e\x68\xf8\xe5\x25\x04\xd8\xb5\x34\x3a\xb9\xdf\xdf\xfb\x46\x19\xec\x8d\x3c\x12\x07\x1d\x28\x82\x15\xae\xff\xeb\x30\x70\x62\xce\x04\x83\x68\xd3\x89\x18\x48\xc8\x81\xeb\xba\x2a\x88\x86\x57\x23\x91\x0a\x3b\x13\x3c\x36\xec\x3b\x11\x15\x06\x44\x7d\x2d\x50\x12\x59\x67\x82\x49\xdf\xba\x13\x21\x29\x85\xd2\xb9\x28\x4d\x86\x7d\x35\x24\x89\xac\x33\x81\xa4\x6f\xdd\x4b\x58\xf2\xb5\x30\x49\x85\x9d\x09\x28\x1b\xf6\xed\x5f\x4b\xd3\x45\x35\x5d\xa2\xac\x4c\xc4\xa8\xd2\xe1\x26\xcc\x1f\xe7\x6c\x41\x39\x9c\xe5\x9a\x06\x5d\x68\x5a\xc2\x83\x96\xbf\x8e\xd1\xa3\x79\xa2\x3c\x07\x84\x27\x6e\x7f\x83\x2e\xf0\x2d\xe1\x0b\x14\xce\x37\x8c\xf6\xce\xb8\xc1\x53\x3b\x4e\xf5\xf8\x46\x7d\x02\x52\xb4\xed\x8c\x11\x5b\xb5\xb3\xf7\x58\xf6\xaa\x24\xb8\xc9\x95\x78\xaf\xff\x6a\xce\x60\xbc\x80\x67\x63\x66\xb0\x2e\x75\xbf\xa6\x9a\x01\x5a\x12\x35\x84\x52\xfc\x41\xb7\x3d\x3b\xea\x24\x58\x74\x1f\xcd\x00\x0f\xda\xa9\xc5\xd2\x9a\x7a\x7d\x4e\xe4\xcd\xdb\x14\x47\x43\x49\xb2\xab\x43\xfe\x43\x48\x70\xc5\x49\x5f\x91\xfc\x0d\x1d\xae\x57\x9e\x56\xb6\x09\xff\x8c\xa7\xee\xea\x72\xe2\x98\x96\x00\x93\x6e\xb0\x3c\x0f\x51\x77\x37\xba\xff\xaa\x23\x70\xd6\x6e\xa2\x6c\xd1\x34\x95\x9e\x6e\xa8\x36\x74\xb9\xaa\xe2\x6e\x0c\x54\x1e\xa8\x35\x30\x10\xed\xbd\x73\x72\x87\x8b\x48\x61\x84\xef\x85\xd2\x20\xf1\x47\x51\x82\x71\x5e\x7c\x91\x9c\xdd\x2d\x30\xf3\x78\xa7\xea\x31\x88\x0e\x8a\xef\x13\x6e\x74\xf5\xd1\x57\x93\xe3\x70\x49\x2e\x6a\x71\xb4\x38\x17\x94\x1c\xec\xac\xa6\x07\x67\x74\x8a\xa7\x65\xec\x6e\x96\x2d\xa2\x44\xdb\x23\x61\x7a\xf5\x8d\x80\x56\xd0\x65\x17\x58\xf1\xe2\x29\x68\x8f\x2a\x51\x51\xbe\x40\x75\x95\x5c\x8b\x07\x09\xd1\x55\x86\xfc\x05\xd1\x64\x98\x0b\xae\x81\xeb\x7e\xaf\x5e\xed\xc5\x77\x5c\x11\x49\x89\x39\x72\x8c\x33\xca\x57\x20\x35\x14\xbe\xc5\xde\x43\xdb\x06\x06\x73\x8d\xcc\x2e\xc4\x7f\x27\xe0\x95\xa5\xe5\x22\x51\x97\xd8\x52\x0f\xa2\x25\x59\x40\x16\x6b\x40\x71\xee\xad\x62\xd0\x31\x90\xae\x46\x55\x48\xd6\x8c\xfa\xe4\xdb\x31\xbd\x69\x9e\xa8\x5e\xba\x6f\x0a\x62\x16\xa6\x73\xb4\xd0\x08\x3f\x0a\x4d\x98\x69\x50\xe8\x5d\x94\x96\x24\x57\x67\x09\xaa\xc8\x02\xfa\xf7\xd4\xdb\x49\x78\x26\x64\x01\x92\x81\x52\xa6\x3b\xe5\x16\x29\x94\x1e\x5c\xb6\x56\x58\x7b\x3c\xf9\x48\xd4\xbd\x84\x15\x15\xf5\x8e\x52\xac\x5b\x58\x1d\x07\x7a\x1d\xcf\xa9\xa0\xd7\x0f\x95\x4b\x03\x96\x89\xad\x4e\xd9\xcc\x9b\x93\x12\x22\xde\x0f\x19\xc2\x10\x29\xc5\x53\x72\x16\x44\x7b\x93\x50\xe5\x85\x66\x3b\x43\xa7\x5b\x76\xac\x4f\xbb\x3e\x83\xb8\xa3\xb9\xab\x4b\x34\x7c\x97\xb4\x24\x4e\x09\xe8\xfb\x83\x2f\xc6\x38\x95\xbd\xf1\x6d\xc8\x0e\x7f\xe2\x1b\x35\xa9\xa5\x04\xae\x77\x6f\x90\x7a\x37\x68\xa1\xe3\x0f\xe0\xc9\xbb\xba\x4c\x9c\x18\x5e\x6c\x62\x93\x24\xad\xcd\xbf\x7b\x78\x7f\x07\xcf\xfa\x28\xe7\x5d\xa7\x1f\x01\x25\xab\xe8\x36\xd7\xf7\x72\x99\xc3\xb3\xd9\x6a\xba\x2b\xd4\x2e\x22\xec\x82\xe2\x33\xba\x89\x8b\xa4\x3c\xd2\x67\x7b\x6f\xf3\xd6\x3d\x27\xd8\x77\x8f\xa1\xdd\xff\xfa\x9f\xad\xaf\xc6\xe6\x42\xe8\xf0\x01\xd8\xff\x02\x00\x00\xff\xff\x27\xc8\xd3\x4b\x53\x26\x00\x00"
+var _repoIssueListTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x5a\x5f\x6f\xe3\xb8\x11\x7f\x76\x3e\x05\xab\x06\xc1\xdd\x83\x65\xec\x5b\x71\xe7\xb8\xd8\xcb\xa2\x58\xa3\x69\xba\xd8\x04\x7d\x0d\x68\x69\xec\xf0\x96\x22\x75\x24\xe5\xac\xab\xf2\xbb\x17\xfc\x27\x51\x96\x14\x3b\x9b\xec\x26\xb9\xbc\x44\x16\xff\xcd\xfc\x66\x7e\x1c\x71\x86\x75\xad\xa0\x28\x29\x56\x80\x92\x15\x96\x30\xbb\x03\x9c\x27\x28\xd5\xfa\x64\x9e\x93\x2d\xca\x28\x96\xf2\x3c\x11\x50\x72\x49\x14\x17\xbb\x64\x71\x32\x89\x07\x99\x16\x3b\x08\x84\x1b\x36\x89\xc7\x55\x04\x65\x9c\x29\x4c\x18\x08\x33\xb2\x3f\x54\x40\x06\x4c\xdd\x96\x95\xbc\xf3\xe3\x3b\x13\x30\xbc\x5d\x61\x37\xb4\x3f\x96\x48\x59\xc1\xcc\x77\xf1\x83\xf7\x97\x17\x64\x73\xa7\xdc\xf8\x49\x5d\x93\x35\x4a\x3f\xe1\x0d\x2c\xe5\xd2\x8c\xbd\x24\x52\xb9\x51\x93\xc9\x1c\x47\xa3\x56\x58\x92\x0c\xad\x2a\xa5\x38\x43\x25\x2f\x09\xdb\xa0\xaa\x4c\xd0\x9d\x80\xf5\x79\x52\xd7\xe9\x67\x28\xf9\x25\x61\x5f\xb4\x76\x52\xc8\x14\x2b\x5e\x24\x28\xc7\x0a\x4f\x8d\xca\xc0\x94\xed\x48\xde\xfd\x8d\xa5\x37\xc2\x49\x9c\xae\x01\x72\x99\xca\x6a\x25\x33\x41\x56\x90\x68\xed\x87\x6c\xb1\x20\x58\x11\xce\xce\x13\xc2\xb6\x20\x14\xe4\x48\x11\xb6\x4b\x16\x73\x12\xe4\xe2\x99\x22\x19\x67\xc8\xff\x9f\x0a\x29\x93\xc5\x7c\x46\x16\xf3\x19\x5e\x0c\x28\xb1\x11\x00\xcc\x2b\xf1\x90\xe8\x33\x06\xf7\xc9\xa2\x27\xab\xd7\xcb\x34\x6a\xdd\x2c\x51\xd7\x40\x25\x68\x7d\x60\x3d\x64\xb1\x66\x5c\xa1\xf4\x53\x45\xe9\x67\xf8\xa3\x02\xa9\x2e\xd4\xd7\xf4\x3d\xa5\xfc\x1e\x72\xad\x73\x22\xf1\x8a\x42\x5e\xd7\xc0\x72\xad\x5b\x09\xc9\x7a\x7c\x50\x5d\xef\x37\xfd\x86\x25\x18\x95\x52\xaf\x53\xc6\x8b\x12\x0b\x98\x79\x4d\x9d\xd7\xa6\x1f\x60\x8d\x2b\xaa\x7e\x13\x98\x65\x77\x5a\xa7\x69\xda\x9f\xe9\x23\xe0\x7c\xc9\xd6\x5c\xeb\x20\x52\x1f\x94\xb2\xa2\x74\x08\x13\xd3\xdd\x3c\xcf\x67\x39\xd9\x2e\x4e\xe2\x87\xae\x3f\xe6\x64\x4b\x0c\x57\x16\x63\x1d\x8c\xd5\xbd\xff\x49\x85\x55\x25\x3d\xa2\xd2\x39\x71\x07\xf1\x16\xe4\xa5\xbc\xbe\xe3\xf7\x17\x94\x4b\x83\x92\xb3\x04\xce\x14\xd9\x82\x17\xae\xe3\xd2\x2d\xd6\xa7\x1e\xb6\xbf\xab\x5d\x09\xe7\xe6\xf7\x7f\x08\xdc\xdf\xec\x4a\xd0\xfa\x4c\x72\xa1\xec\xbb\x6b\x2e\x54\x78\xa7\xb0\x82\x73\x5e\x02\x3b\xa3\x78\x05\x54\x9e\xd7\x75\x7a\x0d\x14\x32\x75\x69\x7f\x6b\x7d\x56\x10\x0a\x52\x71\x66\x26\x4c\xff\x15\x7e\x2c\x3f\x68\x7d\x86\xa5\x24\x1b\x06\xb6\xe5\xbd\x7f\x36\x0d\x9e\xa1\xe3\xce\x6e\xbd\x71\x6a\xd6\x85\xdc\x79\xbd\x87\x7e\xd8\x6b\x4d\xc7\x5b\x85\x57\x89\x81\x46\x56\x70\xad\xb0\x92\xe9\xbf\x4b\x60\x17\xbc\x62\x2a\x18\x0b\x8f\x60\xba\x87\xa7\x80\xfc\x9b\xd0\x3c\x0e\xcc\xcc\xae\xf2\x12\x70\xba\x95\x8f\x80\xd3\x76\xec\xe3\xe9\xf0\xe9\x23\x3a\xe6\xdb\x76\x33\x46\x6b\xca\xb1\xd9\xdf\x24\x64\x9c\xe5\x58\xec\xd0\x9a\x50\x05\x02\x15\xc0\x2a\xef\xe5\x7f\x99\x4e\x91\x05\x00\x4d\xa7\x8b\x81\x6d\xbd\xf5\xfc\x00\xd3\xde\x76\x82\x72\xc1\xcb\x9c\xdf\x33\xf4\x7b\x55\x94\x88\x28\x28\x02\x28\xb2\xc4\x2c\x4c\xa5\xe0\x6b\x88\x0e\xa3\xca\x3b\xe9\x6e\xad\x7d\x92\x66\xe7\x6b\xa0\x6d\x16\x32\xc0\x46\x58\xce\x67\x66\x21\xff\x1c\x49\xdf\x6a\x19\x7b\x9e\x15\xf0\xa9\xb4\xb4\x2f\xcd\x93\xd6\x67\x02\xb0\xe4\xcc\xbe\xb2\x66\xfa\x6c\x7f\xef\xb9\xd3\xe9\xb8\x3f\x9d\xee\x39\xd4\x31\xe8\xdc\x32\x7e\x2b\xad\xf3\xc6\xdb\xe3\xa4\xae\x05\x66\x1b\x68\x6d\x75\x32\x79\x31\xed\x5b\x96\x2d\x3f\x7c\x3b\x18\x1d\x1f\x0a\xdc\xb2\x3e\x09\x7f\xa0\xd3\x0e\x81\x91\x5d\x28\xf0\x2e\xbb\x83\xec\x4b\x14\x5e\xda\xfd\xfb\xc2\xb4\x98\xcd\xe6\x8c\xad\x64\xf9\xab\xef\xe3\x9d\xa8\xb3\x9e\x55\x01\x65\x9c\x72\x91\x20\xa9\x76\x14\xce\x93\x15\xce\xbe\x6c\x04\xaf\x58\x3e\xb5\x0d\xbf\xa0\xba\x4e\x2f\xcc\x93\x15\xd7\xcd\x62\xde\x5d\xe1\x02\xd0\xff\xd0\x35\x66\x44\x91\xff\x42\xd7\x4c\x4d\x18\x6b\x19\x1c\x9e\x1a\x56\x36\x18\x1d\x64\x66\xd3\xf3\x47\xb0\xb3\x31\xe4\x9f\x80\xa1\xa3\x91\xe0\xdb\xe9\xd9\xc0\x73\x88\xa2\xb1\xd1\x7a\x34\x6d\x3d\x3c\x62\x8a\x73\x70\x17\x1e\x91\x9b\xba\xb5\xf3\x0b\x80\x76\xfa\x60\xfc\x3c\x62\x9b\x7b\x32\x45\xc2\x8c\x07\x19\x12\x3a\xfe\x08\x82\x04\x9d\xff\xcc\xfc\x78\x60\x37\x3f\xc8\x10\x2c\xe5\x86\x30\x38\x48\x90\xc8\x66\x0f\xf0\xa3\x75\xaa\x37\x45\x8f\x07\x42\x60\xea\x23\x1f\x29\x36\x48\x8a\xcc\x1f\x25\xe9\xfb\x2d\x56\x58\x38\xb9\x13\x1b\x61\x3e\x10\x59\x52\xbc\x33\x2c\x7a\x14\x75\x2c\x78\x98\xe5\xbd\x13\xfa\xfe\xd7\xf8\xc9\x24\x10\xcd\xbe\x41\x4e\xe7\x40\xb6\xde\x81\x6b\x8c\x48\xe3\x4c\x3a\x44\x25\xf7\x35\xec\x96\x4d\x5a\x37\x38\xc8\xa7\x0e\xa1\x46\x19\xf5\x3d\x29\xf5\x2d\xcc\x79\x42\xd4\x89\x81\x1a\xe1\x55\x4b\xac\xc8\x5f\x5b\x6e\x0d\x92\x2b\xea\x89\xd2\x17\xe0\x56\xfa\x1c\x7c\xea\x7d\x52\x92\xfe\xf7\x64\xba\xcc\x0c\x79\x9d\x0b\x35\x27\xd3\x8b\x4a\x2a\x5e\xd8\x5c\x88\xd6\x21\x1f\x63\x26\x0c\xa6\x48\x2f\x79\x86\x29\xfc\x13\x76\x4d\x2a\xa3\x03\x78\x4b\xc4\x88\x89\xd1\x63\xe8\xd1\x04\x34\x03\xc9\x48\x30\x6b\x7c\xdd\xe0\xfa\x6c\xc1\xca\x4c\xf6\xdd\x02\x55\x70\xa4\xc6\x03\x50\x82\x29\x4d\x82\x23\x1d\xe3\x3f\x98\xd2\x97\x08\x62\x4f\xa0\xa2\x11\x3b\xc5\x94\xde\xba\xd7\x1d\x0e\x3e\x0c\x8d\x5b\x25\x7f\x1c\x3e\x7e\xd0\x9b\x04\xc9\xcb\x7e\xab\xf8\xed\x8e\x57\xc7\x23\x95\x09\x30\x79\x8d\xdb\xd5\xee\x51\x58\xb5\xc3\xde\x22\x5a\xad\xf4\x8f\x03\xab\x00\xa6\x08\x7f\xac\x5f\x35\xa3\xde\x22\x54\x5e\x78\xc2\x36\xfb\x50\x8d\x1f\x25\x8c\x76\x3f\x74\xe7\x35\xb8\x7e\xdf\x9d\x97\x0b\xf4\x93\xf1\x85\x60\x38\x94\x50\xac\x40\xaa\xe4\x67\xf4\x93\x3d\x15\x85\x86\x9f\x1f\xe3\x19\x43\x11\xdd\xcd\xfb\x8a\xdd\xc2\x48\x99\x7a\xed\x0f\x30\xa7\x45\x8b\xd3\xdc\xf5\x7f\x1a\x38\x6e\x9a\xd7\x0e\x4e\xa3\xec\x91\xe0\xb8\x72\x62\x55\xe6\x58\xc1\x93\x21\x8a\x27\x7b\xed\x40\xed\x29\x7e\x24\x5c\x14\xb0\x7c\x2e\xb4\xa2\xb9\x5e\x3d\xe5\x3a\x6a\x1f\x89\x55\xc1\xa5\xca\x78\x61\x76\xf1\x27\x63\x15\xcd\xf5\xda\xb1\xea\xaa\xfd\x18\xbf\x7a\x2e\xb0\xe2\xc9\xde\x84\x67\x0d\xc0\xd5\x8b\xf0\x71\xa8\x8f\x03\xa7\x9d\x13\x51\x22\x55\xb8\xf4\xe0\x8f\xc7\x36\x1d\x12\x0e\xc6\x75\x8d\x4e\x15\x29\xe0\x5a\x89\x5f\xce\xd1\x8d\x79\x22\x2c\x33\x87\x68\xf7\x39\x86\x4e\xd3\x4b\xcc\x36\xc8\x77\x9f\x53\xd2\x49\x29\x0c\x64\x20\xa2\xca\xe7\x67\xc0\xb9\xd6\x2b\x8a\xb3\x2f\xe1\x74\x69\x0b\xca\xc1\x7e\xae\x10\xb6\xf8\xab\x39\x9f\xb2\x1c\xbe\x6a\xed\x55\xd9\xf3\x0b\x45\x14\x05\x74\x87\xe5\x14\x0a\xfe\x3b\xe9\x9b\x7c\xd6\xce\x60\xd1\xbd\x31\x03\xba\x39\xa3\x90\x12\x5a\x4a\x97\xfd\xf1\x9f\x08\x4b\x69\x6a\xf7\xe1\x83\x21\x32\x7b\xba\x94\x57\x50\x29\x81\xe9\xcf\x03\xf9\x39\xab\x64\xa7\xbb\x2f\x91\xf8\x7a\xae\xd3\xec\xa9\x39\x03\x5f\xcf\x6d\xd3\x05\xcf\x9c\x89\x3b\x2a\x73\xd0\xc1\xa4\x9f\x45\xe8\x34\xc7\x19\x85\x8e\xac\x83\xc9\x85\x78\xe8\x43\x89\x86\x38\x8b\x70\x4c\x0d\xb0\x22\x8f\xc3\xff\x07\xd7\xfc\x42\xa5\xad\x2d\xaf\xfd\x83\x0b\x70\x35\x37\xef\x45\xbf\xa2\x87\xcb\x70\xc7\xd5\x16\x22\xd7\x4f\xaf\xaa\xe2\xc2\x6d\x26\x11\x62\xf1\x97\xbd\xdf\x6a\x50\x7b\xd3\x69\xbc\xe6\xef\xfb\x36\x7e\xd0\x9d\xbd\x93\x9b\xec\xca\x32\x2f\x9b\x63\x00\xc8\x2c\xca\x92\x9e\x8e\x5f\xbe\x70\xe7\xe0\x66\x97\x42\xe9\x27\x2e\x15\x88\xf4\x23\x2f\xc0\x58\xb5\x79\x11\xa5\x8b\x2d\x30\xeb\xe6\x72\x91\xc7\xa0\x31\xd0\x50\x52\xb0\x2d\xfb\x7d\x3f\xaf\x39\x3d\xee\x42\xc6\xc1\xd2\x52\x90\x7e\x32\x58\x3d\x0e\x56\x6a\x35\x8a\xcb\xb6\xed\x2a\x3d\x0f\x6a\x40\x19\x49\xf1\x79\x18\x83\x2c\x43\x28\x36\x57\x33\x82\xf4\x83\x77\xde\xc2\x0c\x8d\x0d\xb5\x1e\xb8\xf0\xd6\xf4\xea\xd4\x01\xc6\xaf\xb9\xf9\x16\x7b\x53\xcb\x36\x50\x58\x2b\x64\x3e\x66\xfd\x95\x41\xf7\x67\xeb\x0e\xad\xb8\xd8\x96\x1d\x10\x29\xf0\x06\x92\xa6\x1e\xd1\xac\xdd\x2b\x4c\x1c\x02\x69\x3e\x2b\x43\xa0\xa6\xa4\x97\x04\xad\xeb\x7b\xa2\xee\x5c\x61\xa2\x89\xc0\x64\x8d\x36\x0a\xa5\x37\x5c\x61\x6a\x1a\x24\x7a\xa7\x75\x3f\xb0\x3a\x4d\x50\x89\x37\xd0\xbd\xc9\xd5\x0f\xc0\x2b\x2e\x72\x10\x14\xa4\x34\xdd\x09\xb3\x48\xa1\x4e\x6d\x60\xff\xb3\xcb\xc6\xbf\x8f\x58\x7e\x12\xb0\x25\xbc\x1a\x28\x24\xba\xaf\x2d\xe7\x03\x9d\x8e\x6f\xa2\xea\xd4\xe5\xd0\x99\x41\xd1\x90\xae\xd5\x22\xf1\x7a\xc6\x9e\x12\x10\xb2\x9e\x84\x85\xe0\xf7\x51\xda\x02\x8d\x6e\x5b\xa5\x9f\x34\x19\xe4\x54\x1b\xc1\xac\xb1\xdb\x3e\x93\xe6\xfb\xf7\xaa\x2a\xd0\xf4\x5d\xd4\x12\x59\x2b\x98\xc5\xe7\x68\xd2\x34\x8d\xe7\xde\xbb\x56\x39\x60\x68\x1b\xa5\x85\x00\xa6\x86\x3f\xa7\x3b\x57\x49\x42\xc7\xb7\x6c\xe2\xab\xaa\x88\xac\x1b\x5e\xec\x83\x16\x6d\x73\xfb\x3f\x47\x98\x72\x05\x5f\xd5\x41\x96\xb8\x4e\x6f\x1a\x3e\xab\x41\x9f\x1d\xa3\xde\xcf\x4c\x8e\x30\xdc\x3e\x6a\x39\x64\x63\x93\x0f\x0e\x86\x49\x71\x39\xb3\xc3\x8f\xce\x19\xa0\x7d\x8e\x8c\xd2\x3e\x86\x76\xff\xdf\xff\xeb\xdd\x45\x5f\x73\xae\xc2\xb5\xf2\xff\x0f\x00\x5b\x6e\xf0\x0d\xa9\x2e\x00\x00"
 
 func repoIssueListTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1712,8 +2356,8 @@ func repoIssueListTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/issue/list.tmpl", size: 9811, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe, 0x57, 0x4f, 0x7e, 0xc6, 0x24, 0x2d, 0x8a, 0x92, 0xb3, 0x5e, 0x1e, 0x82, 0xd9, 0xa4, 0x8, 0x96, 0x52, 0xc5, 0xbc, 0x3f, 0x4e, 0xc5, 0xd8, 0xb2, 0x4f, 0x76, 0x56, 0x44, 0xe4, 0x73, 0x96}}
+	info := bindataFileInfo{name: "repo/issue/list.tmpl", size: 11945, mode: os.FileMode(0644), modTime: time.Unix(1792286051, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x19, 0xa4, 0x73, 0x25, 0x16, 0xc3, 0xe9, 0x27, 0x97, 0x9e, 0x97, 0x5d, 0xc9, 0xa0, 0x93, 0x14, 0xbd, 0x4b, 0x8, 0x63, 0xa2, 0xf6, 0x7f, 0x85, 0x24, 0xc9, 0x96, 0xd6, 0x67, 0xa, 0x8}}
 	return a, nil
 }
 
@@ -1797,7 +2441,7 @@ func repoIssueNewTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoIssueNew_formTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x5f\x6f\xdb\x36\x10\x7f\x76\x3f\x05\xc1\xee\x61\x7b\x88\x8c\x61\xc0\x30\x0c\xb2\x87\x22\x43\xd1\x02\xe9\x56\xa4\xd9\x73\x40\x8b\x67\x99\x0b\x45\x6a\x24\xe5\xa4\x30\xf4\xdd\x07\xfe\x15\x25\xcb\x49\x1c\xa4\xc0\xf6\x64\xf9\x74\xbc\x3f\xbf\xbb\xfb\x1d\xed\x72\x2b\x55\x83\x2a\x4e\xb4\x5e\xe1\x8e\xa1\x4a\x36\x0d\x08\x83\x9c\xb8\x56\x8c\x62\x44\x2a\xc3\xa4\x58\xe1\xc3\xa1\xb8\x62\xe2\xae\xef\x31\x6a\xc0\xec\x24\x5d\xe1\x56\x6a\x83\xd7\x6f\x16\x87\x43\x71\xf9\xe5\xfa\xfd\x8d\xbc\x03\xf1\xe1\xe6\xd3\x55\xdf\x5b\x19\xdb\xa2\xe2\x3d\x27\x7a\x67\xbf\x2e\x4a\xca\xf6\xd1\x91\x66\x0f\x06\x40\xa0\x7b\x46\x01\x55\x92\x77\x8d\xb0\x66\x16\x8b\xc3\xc1\x40\xd3\x72\x62\x00\xe1\x0d\xd1\xb0\x24\x1c\x94\xc1\xa8\xf0\x26\x96\x94\xed\x9d\x3b\x10\xd4\x4a\x72\x9b\xe6\x1e\xf8\x1e\x8e\x4c\xe6\x2a\x43\x7e\xda\xbb\xcb\x5f\x86\x37\xfe\xc5\xa2\x24\x51\x4e\xf6\xc4\x10\x85\xd1\x4e\xc1\xd6\x83\x20\xeb\x1a\xe8\x5f\x1a\x54\xf1\x41\x36\x10\x30\xf1\xc7\x16\x25\x6b\x6a\xa4\x55\x35\xd5\xbc\x06\xfe\xce\x19\x1a\xa9\x97\x4b\x12\x1e\xc6\x51\x6a\xa8\x5d\x15\x2a\x29\xcc\x10\xd3\x48\x6b\xcb\x80\xd3\xf8\x62\x51\x32\xd1\x76\x06\x09\xd2\xc0\x0a\x1b\x66\x38\x60\xd4\x72\x52\xc1\x4e\x72\x0a\xca\x45\xc3\x7e\xfc\x45\x14\x37\x0a\x61\x05\xad\x2c\x1a\xc6\x41\x1b\x29\x40\x17\x5e\xdf\xd6\x75\x4f\x78\x07\x4e\xd9\xc9\xac\xc8\x90\x0d\x13\x14\x1e\x56\xf8\x27\x8c\x48\x67\xe4\x56\x56\x9d\x46\x0a\xfe\xe9\x98\x02\x1a\x23\x0b\x95\x59\x4c\x6a\x68\x5d\x2d\x99\xd6\x1d\x2c\x03\xbe\xb7\x86\x6c\x62\x3d\x27\x29\x19\x78\x30\x48\xb1\x7a\x67\x86\xbc\x36\x9d\x31\x52\x64\xd0\xd4\xca\x36\x8e\x17\xe7\xd1\xfd\x9c\xce\x84\xce\xfb\x4c\x6a\xf8\xa8\x2f\x65\xd3\x12\x05\x9f\x3b\xce\xa3\x4b\xa7\x31\x41\xa3\xed\x38\xd7\x45\xa5\x80\x18\x8b\xc4\x60\x09\xb8\x86\xc7\x0e\xba\xdc\xe6\x4f\xfa\x16\x0d\xf0\xf8\x80\x8f\xd1\x1a\x1e\xd3\x53\x7c\x08\x9f\xe3\x2e\xdf\xca\x4e\x3d\xd5\xe3\xb1\x7b\x1a\x30\x24\x36\xba\xef\x0f\x46\x57\x98\x93\x0d\xf0\x5b\x46\x35\x0e\xed\x92\x09\xcc\xd7\x16\x56\x78\xc7\x28\x05\x91\x77\x43\x52\x89\x9d\x3b\xf1\xe8\x10\x17\xd2\xa0\xe2\xca\x6a\xea\xbe\xa7\x4c\x93\x0d\x07\x1a\x70\x40\x5b\x2e\x89\x61\xa2\x46\x7f\x77\x4d\x8b\x34\x70\xa8\xcc\x85\x33\x8b\xa8\x92\x2d\x95\xf7\x22\xce\x84\x6e\x89\xc8\x5b\x22\x75\xbf\x36\x4a\x8a\x7a\x7d\xaa\x06\x02\xee\x7d\xa0\x1a\xf7\x7d\xb9\x0c\xda\xf1\x6c\x66\x54\x56\x86\x55\x52\xa0\xf0\x79\x51\x03\x51\x78\x5d\x2e\xad\x4e\xac\x4a\xf6\x3c\x1a\x3a\x6e\x40\xa1\x06\x44\x87\x11\x25\x86\x5c\x58\x44\xdf\x0e\x08\xce\x0c\xaa\x90\x17\x3e\x5d\xc4\x0c\x34\xf8\xd1\xf0\x2b\x0e\x44\xdd\x66\x49\xe4\x53\xa5\x88\xa8\x61\x40\x38\xb6\x56\x22\x2a\xdf\xf6\x1f\xf5\xe5\x0e\xaa\x3b\xa0\x7d\x5f\xf9\x87\x58\x02\xe7\x3e\xd0\xd8\xdb\x2c\xfc\xc3\xa1\xf8\xf8\xbb\x1d\xf5\x20\x09\xe1\x4a\x95\x32\x8b\x1a\xeb\x59\x18\x8f\xfc\x46\x5c\x9d\xff\xe0\x3d\xe1\x3b\x32\xe1\x1b\xa0\x92\x5c\x2a\x8c\xb4\xf9\xca\x61\x85\x37\xa4\xba\xab\x95\xec\x04\xbd\x70\x2f\x7e\x45\x76\xbb\xd8\xa7\xcc\x8a\x95\xfd\x41\x1a\xb0\x18\x91\x84\xd0\x30\x72\x33\x63\x35\xed\x59\x8f\x32\xe2\x4c\x9b\xb9\xce\x1b\x97\x2d\x24\xf9\x81\xe8\x2f\x4e\x08\xd4\x95\xa1\xef\x77\x8c\x42\x4a\xf1\xb1\xd2\x0a\xe9\xeb\xea\x7b\x33\x75\xd7\x89\xb2\x4e\xaa\xea\x46\x2b\x43\x38\xf3\x1a\xca\x3a\x4c\x76\x2a\x67\x5c\x58\xdf\x15\xd7\xd0\x4a\xbf\x77\x3c\x17\xeb\xdf\x7c\xf2\xab\xf9\xc2\xbe\xbc\x2a\x33\xb3\x9b\x15\xca\xd7\x3f\xd6\x2b\x2b\x57\x62\xba\xa3\x22\x51\xb6\x67\x14\xdc\x74\x0e\x2a\x03\x97\xa5\x25\x76\x6b\x2f\x2a\x9e\xce\xc6\xb2\x53\x8c\x96\x6b\x3d\x45\x6a\xdf\x4b\x85\x8a\x3f\x5b\x10\x9f\xd2\xce\x44\xc5\x25\x97\x1a\xe8\x20\xf9\xe1\x99\x9c\x97\x1c\xbf\x2a\xef\x25\xab\xdf\x8c\xfa\x1c\xe7\xbd\x0a\xbd\x8d\x63\xcd\x19\xce\x8e\xd8\x18\xe7\x81\xe6\x32\x97\xd3\xa6\x38\xd6\xd8\x01\x71\x0a\x71\x1b\x97\xec\x14\x00\x43\x30\xeb\x72\xc9\xb2\x2b\xc4\xe9\x2c\x64\x0b\x62\x94\x44\x5a\xf2\x59\x34\x69\xae\x4f\xe4\x33\x0a\xd7\x8f\xf0\x09\x42\x7e\x6c\x8c\x53\x14\xc3\x24\xe7\xcc\x38\x8a\x27\xbb\x8f\x4c\xbe\x58\xd8\xa7\xed\xfc\x5f\x04\xbe\x72\x31\x3e\x1f\xfa\x93\x39\x65\xf4\xfa\xad\xa0\x27\x4f\x02\xff\x8c\x15\x75\x44\x19\x67\x2d\xab\x94\xf8\x79\x5b\x6a\x42\x25\xb3\x64\xa0\xc3\x12\xc4\xa3\xd1\xcd\x1c\x1e\x5d\x4e\xf2\xcb\xc7\xe1\xf0\x14\x9e\xc9\x52\x86\xec\x20\x3b\x6b\xf1\x9f\xbf\x54\x88\xd6\xac\x16\x90\xef\x94\x91\xe8\xd4\x4a\xc9\x94\x9e\xbc\x26\xbf\x0b\xba\xcf\xbd\x29\x47\xdb\xaf\xba\x34\xa2\xd1\xff\xc1\xce\x18\x85\x3a\x73\x29\xce\xf0\x9c\x61\xa5\x17\x0f\x79\x74\xbb\x1a\x1f\xf1\x7f\x09\x84\x46\x9e\xfc\xae\x1f\xfd\x01\x70\xf4\x76\x9e\x9d\x5f\x46\x0b\xa9\x27\xce\x62\x85\x88\xd4\x79\xa4\x30\x6e\x95\x33\x38\x61\x70\x77\x3e\x25\xe4\xe8\x47\x3b\xf1\xbe\x6a\x51\x1e\x10\xf1\x05\x41\xac\x21\x35\xe0\x84\x7e\x3a\x33\x5b\x86\xf4\xf6\xcc\xdf\x11\x93\x9f\xe7\xe5\x72\x2b\x55\xb3\x7e\xf3\x6f\x00\x00\x00\xff\xff\x46\x40\x13\x3d\x49\x13\x00\x00"
+var _repoIssueNew_formTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x59\xcd\x6e\xe3\xba\x15\x5e\xdb\x4f\x41\x70\xba\x68\x17\x96\x51\x14\x28\x8a\xc2\x76\x31\xc8\x6d\x30\x01\xe6\xde\x5e\x64\xd2\x6e\x0d\x5a\x3c\x96\xd9\x50\xa4\x4a\x52\xf9\x81\xe1\xe7\xea\xbe\x4f\x56\x90\x14\x29\x52\x96\x9c\x78\x90\x01\xda\x55\x14\xf2\xf0\x1c\x9e\xbf\xef\x7c\x92\x57\x7b\xa9\x6a\x54\x72\xa2\xf5\x1a\xb7\x0c\x95\xb2\xae\x41\x18\xe4\x96\x2b\xc5\x28\x46\xa4\x34\x4c\x8a\x35\x3e\x1e\x8b\xaf\x4c\x3c\x9e\x4e\x18\xd5\x60\x0e\x92\xae\x71\x23\xb5\xc1\x9b\xf9\xec\x78\x2c\x6e\xbe\xdd\xdf\x3e\xc8\x47\x10\x5f\x1e\x7e\xfe\x7a\x3a\xd9\x35\xb6\x47\xc5\x2d\x27\xfa\x60\xff\x9d\xad\x28\x7b\x0a\x86\x34\x7b\x31\x00\x02\x3d\x33\x0a\xa8\x94\xbc\xad\x85\x55\x33\x9b\x1d\x8f\x06\xea\x86\x13\x03\x08\xef\x88\x86\x25\xe1\xa0\x0c\x46\x85\x57\xb1\xa4\xec\xc9\x99\x03\x41\xed\x4a\xaa\xd3\x3c\x03\x7f\x82\x33\x95\xa9\x48\xef\x9f\xf6\xe6\xd2\xcd\x6e\xc7\x6f\xcc\x56\x24\xac\x93\x27\x62\x88\xc2\xe8\xa0\x60\xef\x83\x20\xab\x0a\xe8\xdf\x35\xa8\xe2\x8b\xac\xa1\x8b\x89\x3f\x36\x5b\xb1\xba\x42\x5a\x95\x43\xc9\x7b\xe0\x9f\x9d\xa2\x4c\x7c\xb5\x24\xdd\x43\x7e\x4b\x0d\x95\xcb\x42\x29\x85\xe9\xef\x94\x49\xed\x19\x70\x1a\x36\x66\x2b\x26\x9a\xd6\x20\x41\x6a\x58\x63\xc3\x0c\x07\x8c\x1a\x4e\x4a\x38\x48\x4e\x41\xb9\xdb\xb0\xdf\xff\x49\x14\x0f\x0a\x61\x05\x8d\x2c\x6a\xc6\x41\x1b\x29\x40\x17\x5e\xde\xe6\xf5\x89\xf0\x16\x9c\xb0\x5b\xb3\x4b\x86\xec\x98\xa0\xf0\xb2\xc6\x7f\xc0\x88\xb4\x46\xee\x65\xd9\x6a\xa4\xe0\x5f\x2d\x53\x40\x91\x4b\xb3\x90\x06\x15\xbf\x92\x0a\xee\xf4\x8d\xac\x1b\xa2\xe0\xd7\x96\xf3\xd3\x89\x12\x43\x16\xba\xad\x2a\xd0\xb6\x86\xf4\xa2\x55\xdc\xa9\xbf\x87\x46\xfa\x48\x2c\x99\xd6\x2d\xe8\x65\x22\x85\xbb\xfc\x06\xaf\xbb\xac\xcf\x5c\x7d\x5c\xb0\x36\x9f\xf5\x51\x62\x74\x8d\x9d\xe6\xd4\x3c\x4e\x43\x5c\x13\xce\x51\x0d\x5a\x93\x0a\xd0\x81\x51\xc0\xc8\x5d\x57\x36\x20\x46\xe2\xe5\xaf\x59\xd8\xdd\x6d\x1f\x30\x77\xa2\xe4\x52\x03\x9d\x3e\xe3\xf7\x87\xa7\x44\x5b\x2f\x42\x35\x4e\x9f\x15\x6d\xbd\x8d\x35\xdb\x97\x59\x5e\x0b\x07\x20\x14\x14\xde\x4c\x29\xd1\xac\x66\x9c\xa8\xad\xff\x17\x9f\x4e\x69\x48\xcf\x8a\x8f\x33\xdb\xd4\x99\x48\x9e\x82\xae\xf9\x62\x3e\x8a\x9f\xda\x86\xb3\x92\x18\xd0\x79\x12\x7a\x9d\xcf\x44\x09\x26\xaa\x10\xef\xef\x73\xa3\x91\x5a\xb3\x1d\x87\x2d\x8d\xf6\xce\x7d\x69\xae\x39\xbe\xa5\xa0\x4b\xa7\xa3\xb9\x1c\x8d\xb0\x39\x3b\x1e\x15\x11\x15\x8c\xfa\x3c\x38\xcc\x0c\xd4\xc9\xc9\xd9\x6c\xa5\x1b\x22\xc2\xae\x2c\x0d\x2b\xa5\xf0\xfd\x53\xdc\xe9\x1b\x57\x24\xa7\x53\xb7\xbe\xf0\xc5\xeb\x4b\xe7\x78\x04\xae\x61\xb8\x67\x4b\x11\x68\x97\x0e\x9b\x30\xab\x3e\xb3\x47\x22\x68\xfd\x66\xa4\xe3\x8e\xc7\xe2\xce\x76\xb6\xef\x72\x55\x81\x59\xe3\xed\x8e\x13\xf1\x88\x91\x02\xbe\xc6\x42\x3a\x13\x0a\x6f\x3e\xf5\xb2\xe8\x78\x2c\x1e\x3c\x38\x44\xf4\x1a\x71\xcf\xc0\x8b\x41\x95\x82\x57\xbc\x19\x7a\x68\x6f\xf3\x9e\x3e\x09\x5e\x4f\xca\x67\xbd\xd8\xc5\x01\xfd\xe7\xdf\x68\xf2\x40\xd6\x4c\xa8\xf8\xa5\xad\x6f\xba\xff\xac\x33\x83\xf0\xe5\x75\x95\x97\xfd\x70\x37\x56\xc5\xde\xa2\x6a\x2f\x15\x70\xd9\xbc\x36\xb0\xc6\x07\x46\x29\x08\xdc\xa1\xb4\x13\x4d\x21\x37\xed\xee\xa1\xb5\xa4\xac\x22\xf2\x32\xc1\x99\x00\x94\x0f\x82\xf3\x81\x77\x80\xf2\x71\x27\x5f\x70\xea\x5a\x3a\x2d\x84\x34\x7d\x47\xe0\xee\xaa\xf1\x54\x04\xfa\xf4\x38\x27\x3b\xe0\x93\x7d\x96\x2b\xb4\x91\xf5\xf2\xf3\xf1\xc8\x5e\x01\x34\x44\x50\x5b\x48\xba\x85\x5b\x4b\x4f\xfa\xc7\xe2\x4e\xff\x83\x70\x16\xa5\x33\x1e\x61\xef\xe6\x6b\x7e\x69\x59\xcd\xd6\x05\x4c\x07\x4e\xe1\x0d\xb9\x42\x8b\x67\xd9\x3e\xd1\x3d\x9e\x85\x4b\x98\x76\x15\xa8\xd9\x2b\x15\x4c\x3c\xd9\xeb\xe3\xd4\xa5\x5b\xc6\xc1\x66\x68\x88\x70\xf1\x7e\xb6\xa3\x35\x33\x52\xbd\x7e\xa6\x35\x13\x19\x0c\xb5\x3c\xd8\x1f\xe0\x57\x52\xab\xbd\xa9\xbf\x2a\x25\x55\x86\x63\x36\xc9\x6c\xe3\x8a\x72\xb5\xe4\x2c\x3f\x9f\x95\xa6\xcd\x59\xcb\x27\xfb\x64\xb6\x6a\x14\x58\x45\xbd\xb5\x7b\xf2\xec\x10\x57\xc1\x78\x0d\xe4\x1a\x26\x32\xd9\xb5\xf1\xd6\x90\x5d\x9e\xc9\xfe\x68\x46\x0e\x2d\x1c\x29\x56\x1d\xfa\x58\xac\x76\xad\x31\x52\x24\x09\xad\x94\xe5\xa4\x7e\x39\x25\x3e\x7f\x4c\xfb\x92\xed\x2f\x71\x8f\xd9\xec\x2c\xcf\x4d\xcb\xb9\x2e\x4a\x05\xbe\x1b\x7a\x4d\x59\xd1\x8d\x1c\x0c\xa8\x38\x72\x32\x89\xcf\x6a\xe9\x2f\x7c\x4e\x96\xfa\xc7\xf8\x14\x1e\xba\xbf\x39\x81\xde\xcb\x56\xbd\x45\x9f\x03\x31\xad\xc1\x90\xc0\xa1\x3d\x98\x58\xc2\xe5\xfa\x7c\xcb\x6c\x73\x79\x6c\x49\x16\x72\x08\xec\x51\x2f\x8a\x04\xf8\x1b\x58\xec\x19\xdf\x57\x2b\xa9\x4f\x27\xca\x34\xd9\xf1\x38\xf9\xd0\x9e\x4b\x62\x6c\x23\xfe\xb3\xad\x1b\xa4\x81\x43\x69\x16\x4e\x2d\xa2\x4a\x36\x54\x3e\x8b\x40\xb7\x87\x13\x2a\x12\x6b\x6d\x94\x14\xd5\x34\xa4\xc1\xb3\xbf\xa8\x27\x1c\x9d\xf4\x7c\x7a\xaa\x77\x7f\x17\x15\x10\x95\x8f\xe6\xec\x39\xe3\xf3\xdc\x80\x42\x35\x88\xb6\x23\x88\x36\xa2\x9f\xfa\x08\x8e\xbc\x03\x08\xb9\xf0\xee\x22\xcf\x35\x2e\x5d\xbf\xe4\x40\xd4\x36\x71\x22\x05\xda\x0e\x11\x42\x84\xe7\x91\x3f\x74\x86\xe2\x08\xb7\x63\xc1\xce\xf0\xd2\x3f\x84\x14\x38\xf3\x1d\xd9\xf8\x94\x5c\xdf\xf6\xfd\x4f\x91\xf1\x32\xda\x5d\x57\xaa\xe8\x59\x90\xd8\x5c\x26\x47\xc1\x6e\x88\xab\xb3\x3f\xa4\x3e\x99\x0a\x5f\x00\xa5\xe4\x52\x61\xa4\xcd\x2b\x87\x35\xde\x91\xf2\xb1\x52\xb2\x15\x74\xe1\x36\xfe\x6c\xc9\xcc\x8d\x7d\x4a\xb4\xd8\xb5\x5f\x48\x9d\xf1\x9b\xb4\xe5\x46\xda\xea\x8c\x33\xba\x38\xa6\xd4\x31\xbb\x5a\x9e\xb6\xce\xc9\x2f\x44\x7f\x73\x8b\x40\x5d\x1a\x4e\x27\xfb\x4e\x12\x5d\xbc\x94\x5a\x21\x7d\x5e\x71\xce\x62\x26\xd2\x3a\xc8\xaa\x6b\xad\x24\xc2\x89\xd5\x2e\xad\x7d\x67\xc7\x74\x5e\xa0\x95\x7f\xf1\xce\xaf\xc7\x13\xfb\xfd\x59\x19\xe9\xdd\x24\x51\x3e\xff\x21\x5f\x49\xba\x22\xd2\x9d\x25\x89\xb2\x27\xe6\x26\x73\x2a\xd2\x63\x59\x7c\x3f\xde\x32\x1a\xe0\x2c\x5f\x9b\x42\xb4\x54\xea\x2d\x50\xfb\xad\x54\xa8\xf8\x5b\x03\xe2\xe7\x70\x48\xa3\xc2\x13\xe5\x7e\xe5\x77\xef\xc4\xbc\x68\xf8\x43\x71\x2f\x6a\xfd\x61\xd0\xe7\x30\xef\x43\xe0\x2d\xbf\xeb\xe0\xb3\xc1\x20\xce\xa3\xaf\xaa\xc3\xa2\x98\xe6\x73\x91\xba\xb0\xa9\x00\xf4\x97\xd9\xac\x96\x2c\xa1\x10\xd3\x5e\xb8\x37\x9a\xd4\x89\xf9\x28\x3f\xea\xfa\x7a\xc2\x9f\xf3\x97\xd0\x29\x40\xbe\xd4\xc6\xf1\x16\x7d\x27\xa7\xc8\x38\xc5\xd7\x46\xbe\x0e\x0c\xcb\xf9\x7f\x31\xf0\xdd\xab\xe7\xbb\x43\x3f\xe9\x53\x02\xaf\x3f\x2a\xf4\xe4\xcd\xc0\xbf\x63\x44\x9d\x41\xc6\x55\xc3\x2a\x3a\x7e\xdd\x94\x1a\x40\xc9\x28\x18\xe8\x6e\x08\xe2\xac\x75\x13\x83\xf3\xf1\x38\xc7\xcf\xb3\x6f\xc4\x33\x6a\x4a\x22\xdb\xaf\x5d\x35\xf8\xaf\x1f\x2a\x44\x6b\x56\x09\x48\x67\x4a\xb6\x34\x35\x52\x12\xa1\x37\x69\xf2\xe7\x4e\xf6\xbd\x4c\x39\xe8\xfe\xd0\xa1\x11\x94\xfe\x1f\xcc\x8c\xec\xaa\x23\xa4\x38\x89\xe7\xfc\x03\xf1\x35\x98\x5d\xe7\x47\xfc\xaf\x0d\x5d\x21\x0f\x7e\x32\xc8\x7e\x5b\x38\xdb\x1d\x47\xe7\xef\x83\x85\x58\x13\x57\xa1\x42\x88\xd4\x75\xa0\x90\x97\xca\x15\x98\xd0\x9b\xbb\x1e\x12\xd2\xe8\x07\x3d\x81\xaf\xda\x28\xf7\x11\xf1\x09\x41\xac\xb6\x9f\x78\x62\xf4\xe3\x99\xd1\x34\xc4\xdd\x2b\xdf\x23\x06\xaf\xe7\x2b\xf7\xbd\x6a\x33\xff\xef\x00\x7f\x87\x9a\xb9\xa4\x1b\x00\x00"
 
 func repoIssueNew_formTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1812,8 +2456,8 @@ func repoIssueNew_formTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/issue/new_form.tmpl", size: 4937, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x59, 0x6a, 0x8b, 0xc9, 0x12, 0x9c, 0x3f, 0x6e, 0x11, 0x55, 0x6, 0xd3, 0x83, 0x10, 0xc9, 0xac, 0xbe, 0xba, 0x22, 0xb3, 0xe0, 0x98, 0xf2, 0xc8, 0x5, 0xef, 0x9f, 0x93, 0x83, 0x2c, 0x85, 0xd1}}
+	info := bindataFileInfo{name: "repo/issue/new_form.tmpl", size: 7076, mode: os.FileMode(0644), modTime: time.Unix(1792260099, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf2, 0xb1, 0x4d, 0x3, 0xb0, 0x39, 0xc4, 0xdd, 0xee, 0x7b, 0x99, 0xb6, 0x8b, 0x16, 0xf9, 0xaf, 0x1e, 0xfc, 0xd2, 0xb9, 0xa3, 0x62, 0x5, 0xd1, 0xe3, 0xda, 0xac, 0x41, 0xa0, 0x25, 0xf3, 0x6e}}
 	return a, nil
 }
 
@@ -1837,7 +2481,7 @@ func repoIssueViewTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoIssueView_contentTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5c\xeb\x6f\x1b\xb7\xb2\xff\x2c\xff\x15\xbc\xdb\x00\x6d\x81\x48\x6e\x92\xb6\xb8\xe8\xb5\x7c\x91\x3a\x09\x62\xc0\x79\xc0\x76\x70\x3e\x0a\xd4\xee\x58\x62\xbd\x22\xb7\x24\x57\xb6\xab\xee\xff\x7e\xc0\xd7\x2e\xc9\x7d\x68\x95\x38\x3d\x3d\xe7\xe4\x8b\xa5\x5d\x72\x38\x2f\xce\xfc\x86\x1c\xe5\x24\x23\x5b\x94\xe6\x58\x88\x79\x52\x12\xb4\xe2\x24\x4b\x4e\x8f\x26\xbb\x1d\xb9\x41\xb3\x37\x39\x16\xeb\xaa\x3a\x9a\x4c\xfc\x61\x82\xdc\x4b\x00\x8a\xee\x48\x06\x28\x65\x79\xb9\xa1\x6a\xca\x64\xb2\xdb\x49\xd8\x14\x39\x96\x80\x92\x25\x16\x70\x8c\x73\xe0\x32\x41\x33\x43\xe2\x38\x23\x5b\x4d\x1a\x68\xa6\x9e\xe8\x35\x28\x93\x68\x76\x2e\x44\x09\xb3\x73\xf1\xb1\xcc\x73\x3d\xd6\xa7\xc4\xa1\x60\xc7\x44\x8d\x38\xde\x12\xb8\x5b\x48\x22\x73\xb0\x44\x1d\x2d\xf5\x09\x3d\x49\x39\x60\x09\xd9\x95\xe4\xbf\xcc\xd1\x35\xd9\xc0\x15\xa1\x29\x38\xf2\x67\xe6\x2d\x7a\x32\xbb\xc0\x74\x85\xd4\x74\x5f\x2a\x79\x07\xf9\x16\x7c\xa1\x50\xca\x36\x1b\xa0\x72\x9a\x13\x21\xb5\x84\x27\x25\xf1\x74\x65\x5f\x0b\x23\xbc\x4f\xcb\xbe\x31\x2f\x26\x27\xd8\x3d\xc7\x5b\x2c\x31\x4f\x90\x96\x7c\x55\x0b\xfe\x91\x09\x09\x7c\x76\xfe\x0a\xfd\x50\x55\x6b\x0e\x37\xf3\x64\xb7\x0b\xdf\xbd\x65\x1b\xb8\x20\xf4\xb6\xaa\x12\x2b\xb3\xa1\x3d\x39\x21\x9b\x15\x12\x3c\x6d\x4f\xb9\x84\xfc\xa5\x5e\xcf\xce\xb3\xcc\x1c\x63\xfb\x21\xe0\x97\xca\x86\xdf\x49\xe4\x13\x92\x15\x08\x4b\x89\xd3\x35\x64\x68\x0d\x38\x03\xee\x46\x4e\x4e\x44\x81\x69\xad\x42\xb8\x97\x68\xc5\xe1\x21\x39\x3d\xc1\x8f\x23\x64\x3c\xe4\x15\x11\x45\x8e\x1f\xde\xe3\x0d\x54\x95\x92\x05\xed\x76\x33\xf2\xec\x7f\xe9\xec\x9a\x1b\x57\x99\x69\x57\x11\x33\x6b\x03\xc8\x16\x58\xb9\xa0\xa1\xf2\x16\x8b\xf5\x35\x5e\xf9\xae\x82\xfe\x44\x57\xf8\x46\x53\x53\xb2\xd4\x82\x85\x3a\xe0\x64\xb5\x96\x08\xa7\x92\x30\x2a\x6a\xe9\xed\x36\x39\x17\x9a\xfa\x87\x3b\x0a\x5c\xbb\x6f\x9b\x04\x91\xb0\xb1\xd3\x9b\xd9\xbe\x6b\x40\x46\xe4\xd4\xd9\x01\x19\xfd\x7c\x93\x9c\x9e\xd4\x0e\xc7\x52\x49\x52\x46\x91\xfd\x3b\x2d\x80\xa6\x24\x4f\x4e\x4f\x8e\xc9\x69\x6d\x54\x63\x61\xb3\xcf\x1c\x87\x76\xb7\xb5\xde\x05\x9f\x43\x69\x6b\x6b\x0b\x58\x79\x8e\x1c\x8e\xe3\x40\x33\xe0\x8e\x67\xb4\xc1\xfc\x36\x63\x77\x14\xad\xb1\x98\xc2\x86\xfd\x46\xda\x6a\x52\x26\xb8\xd4\xd3\x20\x3b\x33\xf3\x3c\x7d\xd5\xb6\x8e\x86\xfc\x79\x25\xf9\xf3\xb7\xd7\xef\x2e\x9a\xb1\xbb\x1d\xe4\x02\x7c\x5d\xfb\x7e\x48\x59\xad\xc9\xd3\x3e\xf7\xa0\x6c\xe1\xc6\xc4\xa6\x1f\xd0\x59\xa8\x00\x7c\x57\x4b\xbf\x26\x19\x24\x8d\xb7\xd6\xc2\xf5\x4e\xf6\xed\x3d\xfd\x83\x51\x30\x24\x50\x86\x25\x9e\xde\x71\x22\x61\x9e\x68\x56\xa7\x35\xd1\xf3\x57\x55\x65\x5e\xd9\x61\x05\x07\x15\x11\xbb\x07\xda\x97\x76\x68\x59\x64\x58\xc2\xb4\xe4\xb9\xda\x76\x4f\x66\x97\x50\x30\xb3\xd5\x4c\x68\x15\xc7\xcd\x6c\x9a\xc1\x7d\x55\x1d\xd7\xce\xa8\x09\xe8\x6f\xf7\x52\x6f\xda\x66\xb2\x72\xbf\x6e\x8f\xf2\x4d\xfe\x52\xbb\x93\x8e\x95\x8d\x56\x43\x8f\x5b\x32\x29\xd9\xa6\xdf\xf1\xe2\xf1\x62\x83\xf3\x1c\x91\x0d\x5e\x81\xb7\x1d\x27\xbb\x1d\xc7\x74\x05\x43\x0b\x9b\x5d\x27\x31\x5f\x81\x9c\x27\x8b\x65\x8e\xe9\x6d\x82\x38\xe4\xca\x6f\x58\x01\x14\x38\xa2\x8c\xc3\x0d\x70\x0e\xdc\x6d\xc5\xdd\xee\x65\x51\x5c\x95\xcb\x4f\x97\x17\x55\x75\x8c\x1b\xba\x4a\x6f\x9f\x3e\x29\x8d\xfb\xfb\xda\x88\xff\x86\xe4\x40\xf1\x06\xce\xc5\xb9\x62\x14\xcd\x4c\xd8\xf2\x86\x99\xf0\xdd\x88\xa5\x05\x4a\x5c\x3c\xdf\xbf\x24\xd2\xc9\x70\xfe\xad\x32\x69\xa7\x9b\x37\xd3\x66\x4a\xb6\x85\xc4\xcb\xc4\xf1\xf1\x6d\xc8\x70\xb4\xa3\xe2\x4d\xe5\xb8\x43\x71\x20\xca\x40\xdc\x4a\x56\x4c\xd5\xe6\xcf\x19\xce\x0e\x62\xaa\x99\x54\x33\x15\x6d\xc6\xd6\x7e\xf4\x93\x58\xc7\xdb\x70\xc7\x05\x1e\xd9\x8c\x6b\x9e\xdb\x4f\x47\x6d\xe7\x39\xb3\xe9\xdd\x4e\x19\x02\x18\x6d\x68\x61\x56\xf9\x9f\xe9\x14\xfd\x80\xe6\xe8\xec\xc3\xbb\x77\xaf\xdf\x5f\x3f\x45\xcf\xd0\x1c\x5d\xbe\xfe\xf0\xf1\xf5\xfb\xa7\xe8\xb9\x7a\x71\xf1\xe1\xea\xf5\x53\xf4\x02\xcd\xd1\xf9\xd5\xd5\xa7\xd7\x8b\xcb\xd7\x6f\x9e\xa2\x1f\xed\x94\xf3\x6b\xf3\xfd\xa7\x86\x84\x79\xf0\x33\x9a\xa3\x8f\x9f\x2e\x2e\xd4\x37\x34\x9d\x9e\x1e\xd5\x2e\x07\xbf\xa3\xd9\xf5\x43\x01\x2a\xc9\xb6\x43\xbb\xc3\x25\x88\x64\x7a\x23\xdb\x74\xe8\xf9\xee\x00\x56\xe9\x4e\xe0\xfb\xf0\x49\x84\x50\x06\xb1\x49\x60\xd8\x01\x7c\x72\x10\x42\x19\x87\x51\x0e\x15\xae\x79\xd9\x85\x48\x7a\xfc\x3e\x82\x24\xe3\xc1\xc8\x58\x38\x62\x9d\x40\x09\x74\xb5\x66\x77\x8a\xf8\x0f\xc1\x96\x6e\x41\x12\x89\x57\x41\xdc\x6a\xbc\xc8\x11\x78\x16\xc6\x84\x49\xaf\x74\x85\xd6\x47\x12\x0e\x37\x61\x05\x45\x34\x9f\x8f\xa5\x99\xb2\x3c\xc7\x4b\xc6\xb1\x64\xe3\x28\xbf\x18\x4b\x99\x29\xb0\xd6\x26\x19\xc6\x99\x08\x4b\x75\x8c\xd0\xea\x62\x1c\x3d\x99\x9d\x0b\x95\x16\x05\x91\x8c\x3f\xbc\xcc\x36\x84\xa2\xef\x14\x63\x8d\x63\x3d\x99\x5d\xb0\xd5\x0a\xb2\x4f\x02\xf8\xf9\xab\xef\x87\xed\xd2\x86\x8a\x5f\x07\x2c\x06\x64\x33\xc8\x41\xc2\xb4\x8e\x12\x8e\xb0\x43\x00\xa6\x1a\x22\xd9\xdc\x0f\x1d\x16\x5f\x74\x00\x0b\x57\x1f\x69\x68\xf1\xaa\xaa\x8e\x0d\x7d\x4b\x2e\x67\x29\xce\xc1\x4c\xea\xb4\x90\x19\xbd\xb0\x54\x14\x5a\xbb\x21\x7c\x93\x68\xd0\xd1\x2b\xe8\x7d\xa7\x8c\xfb\xcc\x18\xbe\x8f\xbe\x8d\x84\xc6\x9f\x0d\x8e\x1d\x56\xea\x07\xc6\x1a\x1a\x47\xaf\x55\xa4\x68\xc1\xe2\xce\x34\x3e\x00\x8d\x7b\x34\x3f\x84\x8d\xf7\x29\x6f\x3f\x42\xee\xc6\xc6\x9f\x89\x8e\x9d\x53\x5a\x17\x1b\x80\xc7\xf1\xc8\xd1\xf8\x38\x76\xe3\x36\x20\x7e\xd2\x8b\x88\xdb\xd5\x98\xb2\x74\x37\x2c\x3d\x14\x11\x8f\xc5\xc4\x1e\xb0\xe9\xc1\xc3\x7f\x09\x22\x1e\x8f\x89\xff\x46\xa8\xb8\x1b\x17\xff\x7d\x90\x71\x57\xce\x9a\x44\xc1\xaf\x03\x3d\x87\xfb\xee\xf0\xf3\x82\x30\xf7\x6a\xbc\xf9\xac\x0b\x6f\xc2\x36\x38\x3c\xf0\x95\xd6\xca\x50\x9c\x6c\x88\x24\x5b\x98\x66\x4c\x26\x91\xa8\x4d\x8a\x52\x11\x58\x03\x47\xe7\x14\x03\x58\xed\x8b\x11\x68\x3f\x6e\x1c\x5a\xb5\x79\x3a\x06\x13\x72\xd0\x3b\xcc\x42\xc2\xd7\x4a\x61\xa3\x30\xe1\xb0\x35\x9e\x7f\xa1\x35\x52\xc2\xd3\x1c\xa6\x22\xc7\x62\xfd\x5f\x64\x8c\x34\x67\xe2\xb1\x4d\xf1\xe3\x17\x9a\x62\xc9\xd8\xad\x82\x0f\xff\x21\x66\x38\xb0\x5c\x22\x72\xc1\xe1\xe6\x50\x8b\x04\xba\xce\x40\x62\x85\x7d\x8f\xba\x62\x77\xac\xed\x95\xc6\x1d\x6a\xdd\x58\xdf\xbd\xa2\x37\x90\x26\xc0\x64\x11\x43\xbd\x81\xd4\xde\x5f\xc4\x9f\x9b\xd3\x33\xef\x5a\xa4\xb3\x9a\x47\x1b\xe0\x2b\x40\x4b\x76\x5f\x9f\xdf\x47\x45\x3c\x52\xdc\xb6\x8f\xe5\x14\xd9\x4b\xf8\xbd\x04\x21\x15\x9c\x7f\xa7\xc8\x64\x55\x55\x94\xbc\xc8\xa1\x3e\x33\xb1\xfe\x5c\x33\x73\xa6\xf7\x48\x55\x29\xe1\x3b\x06\x19\xa2\xe5\xef\x20\xe4\xaf\x9c\xdd\x02\xad\x2a\x0e\x59\x0f\x35\x9f\x83\x73\x71\xb6\x86\xf4\x96\xd0\x55\x55\x3d\x40\x9e\xb3\xbb\x11\x93\xce\x30\x7d\x59\x4a\xa6\x59\xd7\x2c\x01\xf5\x67\xe9\xb5\xad\x5e\x93\xd3\xc0\x80\x1b\x58\xe1\x69\x97\xf5\xb5\x36\x6b\xe3\x37\xae\x3f\x74\x1e\x11\x01\x31\x63\x90\x16\x60\x1b\xa1\xfb\x4e\x30\x68\x0a\x75\xe5\x70\xc6\x34\x61\xe9\x10\x6f\x9e\xa2\xcc\x73\x31\x5b\x63\xb1\xd0\x6c\x64\x49\x3f\x54\x77\x0c\x29\x66\x7e\xe5\x98\xa6\xeb\x57\xaa\xe6\xc2\xcb\x3c\x00\x3c\x91\x74\x19\xd9\x12\x7d\xc0\x12\x57\x55\x27\xe1\xd7\xc9\xc9\x0d\xe3\x1b\x6f\xa2\xfa\x9a\xd8\xca\x56\x07\x0a\xbd\x1a\x98\x95\x6d\xa4\x40\x1b\x90\x6b\x96\xcd\x93\x82\x09\x19\x9f\x4c\xcc\xce\xae\x2e\xdf\x5c\x2b\xa7\x8a\xcb\x9e\xc9\xe4\x64\x59\x4a\xc9\x7c\x4c\xc6\x21\x43\xe6\x61\x57\xa9\x63\xd4\x64\x6b\xcc\xa5\x66\x41\x17\x3b\x66\x46\x58\x3c\x2a\xc6\x4f\x7b\xf1\x53\x84\xaf\x06\xb6\xcc\x1e\xeb\x9a\x70\xb2\xdf\xb6\x06\x32\x2c\x24\x33\x16\xee\x37\xf0\xbe\x8d\xb9\x87\x1f\x0e\x59\x50\x6d\x0c\xc5\xcd\xfb\x56\xb8\xec\xe7\x5f\x95\x4f\x8b\xa5\xe6\x61\x24\xef\x43\xb1\x62\x8f\x10\x26\x94\x8c\x96\x43\x3c\xd0\xf4\x00\x51\x88\x58\xa4\x96\x93\xcf\x14\x25\x8c\x60\xfb\x3d\x04\xe8\x68\x59\x34\x67\x07\x08\x93\x62\xba\xc0\xa5\x73\xab\x45\x06\x22\xed\x10\xaa\x15\x3d\x9a\x63\xaf\x7f\xa8\xe2\x9b\x7f\x56\xe8\xd8\x17\x2a\x6c\x2d\x6e\x1c\xbe\x3f\x48\x0c\xc6\x08\x9f\x99\x1b\x02\x79\x16\x1d\xaf\x45\x47\xac\x38\x23\x0c\x69\x1d\x7a\xa9\xd5\xfc\x43\xe8\x84\xd0\xa2\x94\x48\x3e\x14\x30\x4f\xf4\xd0\x04\xa9\xb2\x56\x25\x16\xa5\x3c\x21\x1f\x72\x48\xd0\x16\xe7\x25\xcc\x13\x83\x57\xac\x5e\x2d\xbc\x30\xa4\x21\x9b\x27\xf6\x43\x6b\x89\x1c\x2f\x21\xef\x0d\x5d\x5d\x34\x55\x00\x33\xb3\xc2\x7a\x30\x0e\xcc\xf1\x83\xf0\x7e\xb6\x60\xda\x43\xc5\x4b\xb5\x73\x2e\x61\x89\x5b\xf7\x42\xc3\x8a\x3c\x44\x93\x07\xab\x92\x6b\x7e\x16\x4b\xb8\x61\xdc\x48\xaf\xf6\x5e\x8b\xe6\xb0\xee\xba\x89\x74\x6b\xaf\xad\xad\x2e\xfd\xb5\x0e\x8e\x23\x9c\x46\x24\x52\xfb\x89\x93\x42\xb9\x34\xda\xef\x7e\x92\x15\xb1\x56\x8b\x7e\x5f\x30\x48\xd9\x5b\x21\xa9\xaa\x5f\x4e\x8e\x8b\x88\x82\x0a\x22\x98\x03\xd6\xf7\x3f\x1d\x93\xac\xde\xbb\xde\x48\xbc\x24\x34\x83\xfb\x79\xf2\x63\x82\x38\xbb\x13\xf3\xe4\x85\xda\xc7\x8e\xe4\x81\x1e\xd7\x4e\xd7\x3a\xb6\xd5\x09\x3b\xa0\xb6\x0f\xb0\x87\x90\xad\xa3\xa0\x30\x5a\x32\xee\xa4\x3e\x2f\xb8\x89\xbe\x49\x74\xd8\xdf\x4a\xfe\x71\xee\xef\xcc\xf5\xff\xb2\x44\x9a\x62\x4a\x99\x1c\x11\xb3\x87\xd9\x8b\x71\xc7\x20\x7f\x84\xde\xb0\x2f\x62\x71\x0d\x79\x11\xde\xbc\x7c\xc6\x01\x54\xf3\xb1\xab\x6a\x32\xf7\x2c\x03\x15\x93\x4e\x2f\x3d\xc5\x92\x57\x3e\x37\xf7\x35\x5d\x25\x74\x50\x41\x7b\x43\x7b\xaa\xe8\x91\x95\x44\x94\x08\x6d\x11\x61\x13\xa2\xdb\xb6\x40\xe5\x34\x4e\x91\x23\x7b\x3a\x6c\xcb\x5a\x4f\x0a\xed\xe9\xbb\x73\x77\x30\xe6\x9c\xd4\xf7\xff\xbe\x74\x6b\x83\xba\x62\x58\x48\x2c\x4b\xe1\x62\x8b\xfb\x66\xc2\xfd\x9a\x64\x99\x8f\x68\x82\x86\x3c\xbd\x75\xc8\x6a\x2d\x93\xa8\x64\xc1\x34\x0b\x9b\xae\xd0\x77\xba\x8d\xf0\x15\x11\xaa\x76\xb9\xd2\x4b\x9c\xad\x31\x5d\xc1\xf7\xad\xcb\xba\x7e\x54\x6e\x97\x6f\x78\x9e\xda\x68\xd4\x8e\x52\x58\x90\xd4\xc5\x2a\x2f\x34\xfe\x6c\xef\x06\xcc\x74\xed\x18\x03\x87\x7e\x0b\xfd\x2d\xa9\x6f\x14\xec\xa2\x98\x66\xee\xae\x6d\x2f\x05\x67\x98\x6e\x4a\x5b\x9c\xab\x84\xa9\x46\xb6\x4a\xa9\x31\x7c\x0d\x04\xf0\xae\x7b\xa6\xbd\xca\xd3\x15\xd9\x17\xaa\x4e\x1f\xd1\x7d\x89\xe6\x0c\x81\x11\x8a\xd3\x03\xc7\xe9\x2d\x64\x6a\x58\x6d\x01\x50\x68\xdd\xa5\x0d\xa7\x45\x4f\x5f\x3f\x85\x95\x62\x0f\x5f\x06\x23\xba\x0b\xdd\x20\xe6\x46\xb9\x2e\x6a\x9b\xf1\xd2\x5e\x5f\xd8\x6d\xec\x1f\x41\x97\x3b\xcc\x29\xa1\x2b\xb4\x01\x21\xf0\xaa\x56\x61\x1f\x93\x82\xac\xe8\x82\x50\x9d\x93\x09\xb7\x39\x0c\xcd\xae\xc8\x8a\x9e\x53\x15\xcf\xea\x33\xbe\x9e\xd0\xaf\x9e\x95\xe4\xf4\xa8\xa9\x50\x02\x80\xca\x4a\xde\x6a\x55\x8e\x6f\xce\x6c\x94\xdd\x80\xc4\x1d\xed\xbc\x25\x41\x7e\xaf\x72\xbb\xe0\xc9\x4c\xe0\x71\x47\x4d\xe8\x26\x67\x58\x2a\x15\xfc\x56\x6e\x0a\x24\x20\x87\x54\x4e\x35\xb4\x44\x19\x67\x45\xc6\xee\xdc\x86\x6c\x1d\x2a\xd6\xe9\x42\x48\xce\xe8\xaa\xbf\x7f\x11\xee\x66\x9a\xa2\x30\x77\xb4\x66\xb4\x9b\x3b\x04\x98\x00\xf3\x30\x81\x07\x9f\x03\x68\x9f\x4b\xe0\x68\x03\xb4\xb4\xdb\xc3\x65\x1b\x73\x5b\x7a\x40\x6b\xe1\x93\x28\x0f\x69\xce\xbb\xda\x8d\x29\x9b\x1a\x75\x21\x85\x4f\x06\xda\x37\xe1\x6e\x96\xe6\x80\xf9\xc2\x53\x82\xdf\xeb\x65\x6f\x3c\x2f\xf4\xdb\x06\x4e\xd4\xc9\xde\xe5\x82\x33\x53\x7c\x55\x95\xad\xc2\x9c\x09\xf5\xf2\x51\x07\x84\xed\x9a\xf2\x2e\x81\x49\x66\xd9\x65\x7c\x9e\x7c\xa3\x59\x59\xb8\x11\xa7\x9d\x66\x68\xad\x1b\x54\xeb\xcd\x59\xa5\x3d\x7e\xf4\x49\x18\x07\x4a\x59\xce\x78\x82\x74\x55\x34\x4f\x96\x38\xbd\x5d\x71\x56\xea\xc8\x97\x33\xfe\x0b\xd2\xc7\xd1\x39\xe3\x1e\x15\xf5\xac\x39\x70\x3f\x6a\x45\x9e\x56\x37\x5c\xdb\xff\x8d\x96\x51\xdd\x09\xdf\x6e\x2b\xf0\xcc\x66\x85\x7c\x8b\xc5\x95\x7e\x08\x99\x36\x43\x55\xad\x49\x06\xb5\x88\x43\xa6\xa5\xcc\xd8\x35\xea\x3f\xe8\x31\x6b\x0c\x6b\x3b\xba\xd9\x9c\x08\xc1\x46\xae\x6d\xe0\xf3\xa5\x53\x58\x68\xc9\x1a\x11\x76\x38\xf7\xff\x1b\xcd\xcc\xeb\xb1\xd6\x30\x8d\x35\xde\x30\x0e\xc6\x44\xd6\x2e\xff\x87\x86\xad\x66\xad\xa5\xc3\x1e\x25\x92\xfc\x11\x18\xce\x3f\x86\xf1\x8c\xe8\x3d\xde\x73\xe8\xf2\x75\xa2\xdb\x86\xe4\x20\x24\xa3\xf0\xa8\x11\xae\xa6\xfa\xd5\x82\xdc\xd7\x88\x6e\x0d\xd7\x8f\x12\xe1\x42\x25\xc4\x2d\xd6\x1f\x0a\xa0\xef\xdc\x88\xee\xfe\xea\xee\x63\x37\x7f\x44\xdc\x33\xd9\xdf\x60\xe5\xc9\x76\x72\x4c\xbc\xc2\xa1\x5f\x0a\x8d\x29\x7d\x21\x3a\x6a\xbb\x66\x6b\xf7\xc8\xd3\xde\xe4\x7d\x31\x79\x68\xb3\xd6\x5c\xd4\xfb\xb5\x0e\x8e\xd1\x76\x0b\x58\xf3\x40\x5a\xf4\x45\x59\xc0\x14\x12\x7f\x6f\x1b\xd8\x9b\xed\xd1\x56\xe8\x95\xc9\x8b\xa9\x7f\x81\x15\xf0\x5e\x1b\x8c\xc8\x5d\xad\x08\x75\x50\x16\x33\x5b\xbb\xd6\xc4\x61\x49\x2c\x8a\x5f\x9d\x11\x48\xd8\x1c\x99\x04\xdb\xba\xb5\x6c\x2b\xa5\xf9\x08\x25\xf8\x51\x46\xb7\x9a\x43\x7a\x9e\xda\xe3\x37\x03\x66\xd8\xdb\x42\x3f\x26\xf5\x34\x27\x03\x58\x28\xec\x0f\xb0\x20\x99\x3b\x1e\x08\x1e\x05\x67\x04\xee\x00\x78\xb7\x9b\x79\x83\xdc\xf9\xca\xe3\x27\x34\xb7\xc8\xa3\xe6\x33\x47\xf4\xdf\x2a\x9d\xd5\x4c\x3f\x4a\x36\x0b\x54\xd0\x81\xd8\x5f\xda\xf7\xdd\x61\xf4\xb3\x63\x8e\x5b\x76\x1e\x4e\x31\x67\x7e\x76\x03\x45\xe7\x76\xc1\x01\x5f\xeb\xad\xda\x3a\x51\x33\xcb\xc8\xdf\x9a\xf4\x46\xa8\xda\xe5\x3e\x23\x40\x39\xb5\x1d\x16\x9f\x42\x7f\x3c\x38\x3c\x35\x8b\xee\x89\x4e\x7b\x2d\x12\x90\x73\xf5\x53\xd8\x05\x1a\xf6\x38\x85\x3f\x79\xad\x67\x76\x9a\x29\x1a\xd3\x6a\x41\x7a\xc4\xe0\x16\x0e\x29\x30\x97\x24\x25\x05\xae\x7f\x25\xdc\x15\x3e\xf6\x06\x8e\x72\xb3\x08\x28\xa1\xd9\xfb\x72\xf3\xd1\x7b\xe2\x45\x93\xd8\x8c\xf1\xf6\x0a\xa7\x35\x66\xab\xf3\x48\x6f\xb3\x58\xb7\x25\x50\xc1\x0a\x15\x3a\xcb\x22\xe9\xdd\x2b\x5e\xc3\xb4\x3d\x99\x0b\x4c\xe0\xda\xb5\x55\x94\xd6\x11\x4b\xb2\x02\xa5\x40\x25\x70\xfb\x6a\x8b\x39\xc1\xe6\x9d\x6d\x72\xa6\x5b\xe0\x9e\x53\x4e\x46\x17\xb6\xee\x83\xfd\xeb\x6c\x17\x00\x31\xdd\x6a\xae\xc2\x4b\xd0\x86\xee\x2e\x0d\x22\x1b\xb7\xee\x14\x86\x7e\x9b\x24\xf1\xb2\xcc\xb1\x3d\x53\x31\x8c\x35\xd7\x0f\xa9\x24\x5b\x40\xba\x83\xbd\x8e\xa6\xf1\xad\x0a\x87\x1c\xb0\x80\x99\xe9\x73\x6f\xdc\xb7\x21\x63\xfb\xda\x91\x17\x29\x6d\xb4\x0f\x1a\xa7\x0b\x72\xbc\x7d\x76\xec\x7e\x97\xb0\xaf\xa7\xbd\x9f\x11\xd7\x46\x5f\xb3\xd2\x68\x7a\xb8\x9f\x3d\x10\x57\xe2\x65\xd8\x2f\xd5\x5c\x55\x36\x47\x9e\xcf\xdc\x05\x88\xfd\xdd\x8b\xbb\xac\xb4\x37\x28\xe1\x5d\xe4\x58\x36\xd4\xca\x4e\x65\xf5\x31\xa0\xd3\x8a\x3d\x66\xec\x93\x3d\x67\x38\xab\x3b\x3f\x3a\x17\x6c\xee\x30\x90\xf2\x25\x7b\x8e\xdb\x7d\xc4\x68\x4f\xc6\xf3\x12\x50\x8a\x69\x0a\x79\xc7\xa9\xef\x8b\xfe\x88\x6e\xe6\x04\x59\xb5\xf5\x7f\x3b\x00\x50\x24\xf0\x16\x3a\x28\x3f\xef\xa7\xac\x66\xf8\x74\x0f\xda\x42\xfe\x0f\x4e\x8e\x1e\xe9\x67\xda\x76\xc5\x7f\x06\x00\x00\xff\xff\x24\x2b\x99\x82\xbb\x42\x00\x00"
+var _repoIssueView_contentTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x5c\x5f\x73\xdb\x38\x92\x7f\x96\x3f\x05\x8e\x93\xab\x4d\xaa\x46\xf2\x24\x33\xbb\xb7\x97\xb3\x74\x95\x71\x92\x1a\xd7\x39\x93\x94\x9d\xd4\x3d\xaa\x20\xb2\x2d\x61\x4d\x11\x0c\x00\xda\xf1\x6a\xf8\xdd\xaf\xf0\x87\x24\x00\x02\x14\x65\x3b\xb9\xdc\xde\xfa\xc5\x22\x09\x34\x1b\xfd\x0f\x3f\x34\x1a\x3c\xc9\xc8\x0d\x4a\x73\xcc\xf9\x3c\xa9\x08\x5a\x33\x92\x25\x8b\xa3\xc9\x6e\x47\xae\xd0\xec\x6d\x8e\xf9\xa6\xae\x8f\x26\x13\xbb\x19\x27\x5f\x04\x40\x81\x6e\x49\x06\x28\xa5\x79\xb5\x2d\x64\x97\xc9\x64\xb7\x13\xb0\x2d\x73\x2c\x00\x25\x2b\xcc\xe1\x18\xe7\xc0\x44\x82\x66\x9a\xc4\x71\x46\x6e\x14\x69\x28\xb2\xba\x36\xef\x28\xa8\x40\xb3\x33\xce\x2b\x98\x9d\xf1\x0f\x55\x9e\xab\xb6\x36\x25\x06\x25\x3d\x26\xb2\xc5\xf1\x0d\x81\xdb\xa5\x20\x22\x07\x43\xb4\xa1\x25\x7f\xa1\x27\x29\x03\x2c\x20\xbb\x14\xec\xe5\x1c\x7d\x24\x5b\xb8\x24\x45\x0a\x0d\xf9\x53\xfd\x14\x3d\x99\x9d\xe3\x62\x8d\x64\x77\x7b\x54\xe2\x16\xf2\x1b\xb0\x07\x85\x52\xba\xdd\x42\x21\xa6\x39\xe1\x42\x8d\xf0\xa4\x22\x96\xac\xcc\x63\xae\x1e\x39\xb4\xcc\x13\xfd\x60\x72\x82\x9b\xfb\xf8\x06\x0b\xcc\x12\xa4\x46\xbe\x6e\x07\xfe\x81\x72\x01\x6c\x76\xf6\x1a\xfd\x54\xd7\x1b\x06\x57\xf3\x64\xb7\x73\x9f\xfd\x46\xb7\x70\x4e\x8a\xeb\xba\x4e\xcc\x98\x35\xed\xc9\x09\xd9\xae\x11\x67\x69\xbf\xcb\x05\xe4\xaf\xd4\xfb\x4c\x3f\xc3\xcc\x31\x36\x3f\x1c\x7e\x0b\xd1\xf1\x3b\xf1\x6c\x42\xd0\x12\x61\x21\x70\xba\x81\x0c\x6d\x00\x67\xc0\x9a\x96\x93\x13\x5e\xe2\xa2\x15\x21\x7c\x11\x68\xcd\xe0\x2e\x59\x9c\xe0\xc7\x19\xa4\xdf\xe4\x35\xe1\x65\x8e\xef\x7e\xc7\x5b\xa8\x6b\x39\x16\xb4\xdb\xcd\xc8\xf3\xbf\x16\xb3\x8f\x4c\x9b\xca\x4c\x99\x0a\x9f\x19\x1d\x40\xb6\xc4\xd2\x04\x35\x95\xdf\x30\xdf\x7c\xc4\x6b\xdb\x54\xd0\x1f\xe8\x12\x5f\x29\x6a\x72\x2c\xed\xc0\x5c\x19\x30\xb2\xde\x08\x84\x53\x41\x68\xc1\xdb\xd1\x1b\x37\x39\xe3\x8a\xfa\xfb\xdb\x02\x98\x32\xdf\x3e\x09\x22\x60\x6b\xba\x77\xbd\x6d\xd3\x80\x8c\x88\x69\xa3\x07\xa4\xe5\xf3\x43\xb2\x38\x69\x0d\x8e\xa6\x82\xa4\xb4\x40\xe6\xff\xb4\x84\x22\x25\x79\xb2\x38\x39\x26\x8b\x56\xa9\x93\x49\xe7\x67\x0d\x87\xc6\xdb\x7a\xcf\x9c\xdf\xee\x68\x5b\x6d\x73\x58\x5b\x86\xec\xb6\x63\x50\x64\xc0\x1a\x9e\xd1\x16\xb3\xeb\x8c\xde\x16\x68\x83\xf9\x14\xb6\xf4\x6f\xa4\x2f\x26\xa9\x82\x0b\xd5\x0d\xb2\x53\xdd\xcf\x92\xd7\x6e\x17\x6e\xf2\xc7\xa5\x60\x2f\x7e\xfb\xf8\xee\xbc\x6b\xbb\xdb\x41\xce\xc1\x96\xb5\x6d\x87\x05\x6d\x25\xb9\x88\x99\x47\x41\x97\x4d\x1b\x5f\xf5\x03\x32\x73\x05\x80\x6f\xdb\xd1\x6f\x48\x06\x49\x67\xad\xed\xe0\xa2\x9d\x6d\x7d\x4f\xff\x4e\x0b\xd0\x24\x50\x86\x05\x9e\xde\x32\x22\x60\x9e\x28\x56\xa7\x2d\xd1\xb3\xd7\x75\xad\x1f\x99\x66\x25\x03\x19\x11\xc3\x0d\xcd\x43\xd3\xb4\x2a\x33\x2c\x60\x5a\xb1\x5c\xba\xdd\x93\xd9\x05\x94\x54\xbb\x9a\x0e\xad\xfc\xb8\xeb\x5d\x64\xf0\xa5\xae\x8f\x5b\x63\x54\x04\xd4\xd5\x17\xa1\x9c\xb6\xeb\x9c\x2c\xec\x01\xda\xbf\x6d\x95\xbf\x52\xe6\xa4\x62\x65\x5d\x07\x64\x51\x11\xb4\xa2\x42\xd0\x6d\xdc\xf0\xfc\xf6\x7c\x8b\xf3\x1c\x91\x2d\x5e\x83\xe5\x8e\x93\xdd\x8e\xe1\x62\x0d\x43\x2f\xd6\x5e\x27\x30\x5b\x83\x98\x27\xcb\x55\x8e\x8b\xeb\x04\x31\xc8\xa5\xdd\xd0\x12\x0a\x60\xa8\xa0\x0c\xae\x80\x31\x60\x8d\x2b\xee\x76\xaf\xca\xf2\xb2\x5a\x7d\xba\x38\xaf\xeb\x63\xdc\xd1\x95\x72\xfb\xf4\x49\x4a\xdc\xf6\x6b\x3d\xfc\xb7\x24\x87\x02\x6f\xe1\x8c\x9f\x49\x46\xd1\x4c\x87\x2d\xab\x99\x0e\xdf\xdd\xb0\xd4\x80\x92\x26\x9e\xef\x7d\xe5\x71\xab\x64\x35\x29\xce\xff\x24\x55\x1b\x34\xf7\xae\xfb\x4c\x8e\x71\x29\xf0\x2a\x69\xf8\xf9\x93\xcb\xb8\xe7\x59\xbe\x73\x35\x5c\x22\x3f\x20\x65\xc0\xaf\x05\x2d\xa7\x32\x08\xe4\x14\x67\x07\x31\xd5\x75\x6a\x99\xf2\x9c\xb2\xe7\x97\xf6\x64\x16\x78\xea\x7a\x9e\x63\x99\x5d\xbb\xee\xbe\xf9\x75\xd4\x37\xa2\x53\x33\xcd\x9b\x2e\x43\x40\xa3\x0f\x31\xf4\x5b\xfe\x65\x3a\x45\x3f\xa1\x39\x3a\x7d\xff\xee\xdd\x9b\xdf\x3f\xfe\x88\x9e\xa3\x39\xba\x78\xf3\xfe\xc3\x9b\xdf\x7f\x44\x2f\xe4\x83\xf3\xf7\x97\x6f\x7e\x44\x3f\xa3\x39\x3a\xbb\xbc\xfc\xf4\x66\x79\xf1\xe6\xed\x8f\xe8\x17\xd3\xe5\xec\xa3\xbe\xfe\x73\x47\x42\xdf\xf8\x0b\x9a\xa3\x0f\x9f\xce\xcf\xe5\x15\x9a\x4e\x17\x47\xad\xe9\xc1\x67\x34\xfb\x78\x57\x82\x9c\x6c\xfb\x21\xbe\xc1\x27\x88\x64\xca\xa1\xcd\xb4\x68\xd9\xf0\x00\x66\x09\x4f\xe4\xfb\x70\x8a\x87\x54\x06\x31\x8a\xa3\xd8\x01\x9c\x72\x10\x52\x19\x87\x55\x0e\x1d\xdc\x6e\x17\x45\x26\x3a\x02\x36\x04\xf9\xaf\x54\xd4\x35\xf2\x5d\x69\x4b\x0a\x82\x56\x98\x93\x14\xe5\x78\x05\x79\xb2\x88\x7a\xcb\x8a\x5a\x53\x95\x79\x3f\x8a\xb6\xf6\xe0\xcf\x78\xe0\x33\x16\xfa\x4c\x26\xad\xd0\x2e\x37\xf4\x56\x12\xff\xc9\x09\x1b\x3d\xf8\x23\xf0\xda\xee\x6e\x59\x6a\x43\xe0\xb9\x43\x60\x32\x89\x8e\xae\x54\x52\x4d\xdc\xe6\x3a\x74\x21\x8f\xe6\x8b\xb1\x34\x53\x9a\xe7\x78\x45\x19\x16\x74\x1c\xe5\x9f\xc7\x52\xa6\x12\x18\xf6\x49\xba\xb1\xcc\xc3\x6d\x81\x16\x4a\x5c\x94\xa1\x27\xb3\x33\x2e\xa7\x60\x4e\x04\x65\x77\xaf\xb2\x2d\x29\xd0\x53\xf8\xdc\xd9\xda\x6b\x19\x80\xe8\x7a\x0d\xd9\x27\x0e\xec\xec\xf5\xb3\x61\xbd\xf4\x61\xe9\xd7\x01\xa6\x0e\xd9\x0c\x72\x10\x30\x6d\x23\x51\x43\xb8\x41\x1b\xea\xf6\x94\x64\x73\x3b\x3c\xe9\x87\x21\x10\x63\x3a\x68\x18\x23\x67\x46\x4d\xdf\x90\xcb\x69\x8a\x73\x98\x27\x51\x0d\xe9\xd6\x4b\x43\x45\x22\xc3\x2b\xc2\xb6\x49\x5d\x0f\x0d\xf4\x4b\x70\x8c\xfb\xd4\xe8\x3e\xf7\xae\x46\xc2\xf0\x7b\x03\xf1\x06\x97\xc5\x41\xb8\x6c\xe1\x3f\x96\x91\xa2\x07\xc1\x83\x50\x61\x00\x86\x3f\x39\x1c\x87\xef\x13\xde\x7e\x34\x1e\xc6\xe1\xf7\x44\xe2\x8d\x51\x1a\x13\x1b\x80\xe2\x7e\xcb\xd1\x58\xdc\x37\xe3\x3e\xf8\x7e\x12\x45\xdf\xfd\x95\x9f\xd4\x74\x18\x02\x1f\x8a\xbe\xc7\xe2\x6f\x0b\x3c\x85\x5f\xfc\x6d\xd0\xf7\x78\xfc\xfd\x1d\x22\xf0\x30\x06\xff\x7e\x50\x78\x68\xee\x9a\x78\x41\x30\x80\xd4\x5d\xff\x3b\x3c\x47\xe1\xce\xc1\x0a\xdb\x3e\x0f\x61\x5b\xb8\x71\x12\x16\xb6\xd0\x7a\x33\x15\x23\x5b\x22\xc8\x0d\x4c\x33\x2a\x12\x6f\xa8\x27\xd8\x8e\xc4\x0a\xa4\x36\xc6\x31\x80\x0b\x1f\x8c\x76\xe3\x18\x75\xe8\xad\xdd\x5d\x27\x33\x16\xd1\x38\x03\xe5\x69\x06\x1a\xbe\x91\x02\x1b\x85\x0d\x87\xb5\xf1\xe2\x81\xda\x48\x09\x4b\x73\x98\x72\x99\x73\xfe\x3e\x94\xa1\x23\xe8\x69\x4e\x39\x5c\x00\xe6\xb4\xb0\x13\x50\x4f\x98\xba\x25\xe5\x8d\x5e\xce\x51\x27\x6a\xbb\xc3\xec\x5c\x21\x8f\xff\x82\x3b\xbb\xab\x47\x75\x76\xc6\x4f\x2b\x2e\xe8\xb6\xae\x5d\xb2\x73\x74\x89\x0b\x22\xc8\xdf\x01\x3d\x2d\x19\x29\xc4\x15\x4a\xfe\x95\x27\x4e\xe7\x67\x75\xed\xba\xcf\xb7\x31\xa1\x54\x72\x90\x2d\x31\x57\x36\x64\x33\x7d\x80\x3d\xf5\x02\xdd\x37\x65\x5d\x24\x87\xf2\xda\x49\x79\xd8\x13\x7e\x79\xa0\x27\xac\x28\xbd\x96\x28\xee\x1f\x24\x24\x85\x72\xf6\x03\xab\x56\x22\x96\x0c\xae\x0e\xd6\x8f\x2d\xeb\x0c\x04\x96\x4b\x90\xa0\x5d\xf9\xd2\x5e\x2b\xf8\x27\xdf\xeb\xcb\x3b\x3a\xf4\x0e\x59\x3a\xd0\xd8\x63\x68\xf4\x3c\xf6\x6f\x0f\x8d\x9c\x39\x4d\xff\x1f\x1a\x8b\x46\x5e\x4b\x06\x5b\x22\x97\x2b\x03\x16\x63\x6b\xeb\xd0\x69\xed\xaf\xff\x70\xd3\xda\xb7\xd4\x51\x56\x95\x39\x49\xb1\x80\x25\x1d\x72\xe9\x6e\x55\xf3\x20\x5d\xfd\xfb\x3f\x03\xef\x83\xf5\xc4\xe0\x2b\x28\xca\x6c\xd2\xfb\xbf\xc9\x55\x60\xef\x3f\x98\xaa\x46\x5b\x60\x6b\x40\x2b\xfa\xa5\xdd\xa4\xf6\x32\xd4\x48\x4a\xa8\xbf\xf7\x24\xc9\x5e\xc0\xe7\x0a\xb8\x90\x79\xa4\x77\x92\x4c\x56\xd7\x65\xc5\xca\x1c\x8e\xbc\xec\x5e\xcb\x8c\x02\x58\x59\x5d\x4b\x81\x07\x1a\x69\xa2\xd5\x67\xe0\xe2\x57\x46\xaf\xa1\xa8\x6b\x06\x59\x84\x9a\xcd\xc1\x19\x3f\xdd\x40\x7a\x4d\x8a\x75\x5d\xdf\x41\x9e\xd3\xdb\x11\x9d\x4e\x71\xf1\xaa\x12\x54\xb1\xae\x58\x82\xe2\xc8\x81\x4f\x0c\x32\x23\xd7\x64\xe1\x18\xcd\x16\xd6\x78\x1a\x9a\xef\x94\x34\x5b\x2b\xef\x6c\x6e\x28\xd9\xee\x65\x00\xb4\x42\x7a\x99\x82\x11\xb2\x8f\x6f\x90\x2b\x23\xd7\xaa\x71\x73\x56\xbe\xd5\x96\x55\x9e\xf3\xd9\x06\xf3\xa5\x62\x23\x4b\xe2\x39\xa2\x86\x21\xc9\xcc\xaf\x0c\x17\xe9\xe6\x35\xe4\x20\xf0\x2a\x77\x56\xd8\xde\xe8\x32\x72\x43\xd4\xee\x81\x47\x6d\x72\xe2\x5e\x4e\x4e\xae\x28\xdb\x5a\x1d\xe5\x65\x62\x52\xaa\xca\x43\xd5\xdb\x40\xbf\xd9\xb8\x28\xda\x82\xd8\xd0\x6c\x9e\xc8\x34\xb6\x9f\x12\x9f\x9d\x5e\x5e\xbc\xfd\x28\x8d\xca\xcf\xb7\x4d\x26\x27\xab\x4a\x08\x6a\x27\x01\x18\x64\x48\xdf\x4c\x16\x31\x31\x99\xe4\xe6\x4a\xb1\xa0\xb2\x6c\xba\x87\x9b\xb5\x94\x8c\x2f\xa2\x0b\x76\x6f\x8d\x31\xe0\x32\x3d\xc1\x07\x7c\x40\x35\x7d\x65\x0c\xe2\x4d\xce\xe1\x76\x03\x2c\xaa\x8e\xce\x34\x74\xfc\x8b\xe5\x1b\x0f\x00\x76\x03\x46\xd5\xad\x6c\xb4\x69\x2d\xa1\xe1\x2f\xa9\xeb\xb1\xf2\x19\xc9\x7f\x8c\x07\xbd\x40\x5f\x0a\xaa\x79\x88\x5b\xf7\xbe\xa8\xb4\x87\x1f\x06\x99\x93\xe3\x1b\x92\xe6\x97\x80\x10\xa3\x16\x87\x05\x5e\xae\x14\x0f\x23\x79\x1f\x0a\x94\x7b\x06\xa1\xe3\xe8\xe8\x71\xf0\xbb\x22\x3d\x60\x28\x84\x2f\x53\xc3\xc9\x3d\x87\xe2\x86\xef\xfd\x16\x02\xc5\xe8\xb1\x28\xce\x0e\x18\x4c\x8a\x8b\x25\xae\x1a\xb3\x5a\x66\xc0\xd3\xc0\xa0\x5c\x0f\xc6\x45\x86\x9c\x0d\xa7\xff\x66\x44\x00\x43\x4f\x55\xe5\xde\x2b\x29\x7b\xed\xc8\xa7\xca\xd5\x9e\x99\x07\x4d\x25\x4f\x49\x95\x30\xb8\x6a\x78\x01\xb2\x2c\xf0\xd9\x7d\xe3\xee\x43\x03\x02\x29\xae\xe8\x21\xa1\x20\x27\x05\x60\xb6\xdc\x10\x2e\xc7\xbd\x64\xf0\xb9\x22\x0c\xf8\x92\xa9\x61\xec\x09\x07\xc6\x20\x62\xe2\x9b\x9d\xd2\x4c\x57\x8c\xf1\x0f\x50\x64\xae\xa1\x3f\x50\x2a\x7d\x8f\xb8\xc7\xfa\x71\xd0\x8e\x68\x06\x4b\xb5\xab\xc9\x97\xa5\xe6\x3e\x41\x4f\x73\x28\x02\xc3\xf2\x36\x1f\x2d\xec\x6a\xf6\x3c\xae\x48\x2e\xf7\x10\xc6\xbc\x4b\x65\xda\x35\x94\x1d\x21\xfa\x80\xd8\xef\x25\xe3\x7d\x33\xbc\x19\x87\x0e\xd5\xf1\xb9\x7d\x70\x6a\x37\x9b\x35\xbe\x37\x79\xd3\xbf\xc5\xf1\x15\x81\xdc\x09\xde\xfd\x11\x31\x9c\x11\x8a\x54\x8c\xb0\x70\xb3\xf9\x43\xe8\x84\x14\x65\x25\x90\xb8\x2b\x41\xee\x9e\x65\x84\x26\x48\xee\x96\xcc\x13\x1d\x1d\xb8\xb8\xcb\x21\x41\x37\x38\xaf\x60\x9e\xe8\x35\x80\x09\x1c\x66\x56\xd5\xb4\x21\x9b\x27\xe6\x47\xff\x1d\xaa\xa8\x21\xae\xdb\x00\x51\xa9\x5e\xdd\xcb\x1d\x9b\xa7\x95\xc0\x9d\xfe\xb6\x84\x5b\x77\x18\x08\x45\xdf\x8f\x7c\x75\x4c\x59\xae\xe0\x8a\x32\x2d\x11\xe5\x54\x5d\x8d\x74\xc0\x36\x7a\xe2\xf7\x4b\x6e\xc6\x69\x21\xfc\xea\x47\xd7\x43\x6c\x14\x0e\xad\xb2\x91\xef\x06\xf2\x32\x59\x1c\x16\x99\x33\xc5\x74\x39\xcc\x8b\xbf\xb8\x24\x02\xc9\x79\x90\x91\x52\x3a\x34\xd2\x7a\x1f\x96\xbb\xd2\xdc\x3c\xc9\xf4\xf2\xfa\x25\x2a\x68\x01\x41\xd9\xf7\xcb\x93\x7c\x8b\x2a\x07\xe2\x9e\x4a\x85\x6e\x81\x73\xac\x20\xe0\x4b\x6f\x68\x93\xc9\x89\x8c\xf6\x98\x01\x56\x75\x5c\x5e\x07\x63\x6b\xfe\x5d\x81\x57\x44\x16\x78\xce\x93\x5f\x12\xc4\xe8\x2d\x9f\x27\x3f\xab\x9c\x81\x1a\xe1\x3b\xdd\x4c\x8a\xb1\xa1\x1d\xd8\x57\xb5\x3d\xca\xee\xf6\x91\x61\x92\x03\xe3\xbd\x9d\xcb\x91\x5a\x75\x79\x5d\x0a\x43\xae\xc1\x29\xbd\xf1\x07\xf7\x21\xfd\xf5\x59\xef\x46\x6f\x0d\xa5\x30\x57\xbb\x8a\x8a\x16\x3e\xee\x5f\x47\xa3\xd8\xc0\xb4\xc3\xcb\xdf\xca\x54\x81\x8b\xc4\x2b\xfd\xe9\xad\xc8\xfc\x05\x59\x70\x01\xf6\xbf\x06\xf0\x53\x5c\x14\x54\x8c\xc0\x92\x87\xc1\xb7\xfb\xa0\xb7\xf1\x2c\x4a\xe3\x03\x16\x67\xb2\x27\xe2\x06\xbe\x9d\x6e\x80\xb1\xbb\x0f\x24\xbd\xe6\xce\xc5\xec\x4c\xce\x25\xf1\xb2\x8a\x18\xa4\x78\x04\x61\x0c\xae\x6d\x63\x22\xc1\x79\xbe\x24\xc5\xd2\x07\xaf\x91\x84\xc9\x20\x76\x1a\x89\x89\xd4\x62\x5a\x7b\x49\x36\xed\x16\xd2\xf7\x04\x49\x7d\xd7\xd5\x95\x93\xfb\x12\x20\x8a\x8b\xc8\x8a\xfe\x5e\xae\xb7\xbf\x5a\xa1\xfb\x19\xcf\x78\x9e\x53\x39\x69\x07\x72\x9e\x15\x41\xd2\xd8\x51\x13\xb4\x1b\xf2\xd1\x4a\xb4\x06\xbf\x93\x40\xf1\xbd\x7c\x4b\xb3\x7b\x1d\x3b\x0c\x91\x2b\x4e\x96\x98\x27\xfd\x5e\x6d\x7a\xb7\x09\x39\xc3\x44\x12\x77\x3f\xba\x2f\x07\x6f\x5d\xa9\xeb\x14\xd1\x53\xca\x9c\x85\x63\x23\x9d\x67\x21\x4b\x7c\x36\x90\x27\x56\x96\x18\x49\x11\x5b\xd9\xfa\xae\x3c\x32\x94\xb1\x77\x12\xf6\x56\xd3\x48\xd2\x7e\x64\xfe\xd4\xf3\x19\x93\x3a\x35\xbe\xd3\xcc\xe0\xb2\x6c\xcc\xf7\xa6\x91\xc7\x35\xcc\x69\xb4\x88\x73\x45\x8e\xd4\x99\x6e\xa6\x1c\xc9\xb6\xf2\x98\x23\x1a\x5c\x2b\x19\xe6\x02\x8b\x8a\x37\x50\xa3\xb9\xd2\x88\x77\x43\xb2\xcc\x4e\x65\xd8\xa2\xd1\x73\x93\xac\x2b\x4e\x16\xc1\x6c\x43\x77\x9e\xca\x58\xc5\x6b\xc2\x65\xc6\xf6\x52\xbd\xe2\x74\x23\x4b\xcc\x9e\xf5\x6a\x63\xe3\xb9\x48\xf3\xfa\x8e\xe7\xa9\x89\x19\x7d\x18\x60\x47\x14\x0b\x2d\xfd\xc5\x94\xe2\xe9\xee\xf3\x24\xe6\x05\x26\x75\xa7\xae\x92\xba\x76\x7a\x4d\x71\x91\x35\xa5\xad\x7b\x29\x34\x8a\x09\x53\xba\xc1\xf9\x3c\xd1\x2d\x7b\x09\xe4\x31\x7c\x0d\x17\xac\xfa\xd5\x67\x03\x27\x35\x3b\x32\x1c\x72\x48\x45\x03\x3b\x55\xc8\xd5\x65\x21\x89\x7b\x66\xb2\xc4\xa9\x40\x19\xa3\xa5\xac\x36\x6b\xca\xd3\xa2\xd2\x70\x08\x05\xca\xfc\x4c\xb5\x61\x17\x22\x34\x8b\x56\x85\x4c\x1f\x8e\x52\x0d\xf5\xcd\xca\x6b\xb7\x9b\xe9\x6d\x33\x6d\x41\x5d\x29\xce\xcc\x8e\x79\x56\x7d\x8f\x55\xd3\x63\x82\xda\xc9\xb1\x26\x39\x02\x9e\x6a\x29\x8d\x58\xa2\x0c\xda\xaa\x4a\xfb\x3f\xd0\x52\xb5\x64\x1f\x60\xa8\x9a\xc0\x08\x3b\x55\x0d\xc7\x99\xa9\xcb\xd4\x01\x65\xd5\xfe\xf5\x1e\x98\x6f\xc9\xeb\xcf\x6e\x46\x3e\xc2\x97\xce\x54\x98\xc1\xba\xe8\xc9\x03\x10\xde\xc1\x23\x0b\x4b\xc4\xf0\x81\xc9\x57\x19\x07\xd3\xf3\x4d\x18\x16\xdc\x62\x56\x90\x62\xed\x23\x83\x18\xd7\x9c\xac\x0b\x89\xf8\xcc\xfa\x58\x83\x74\x34\xbb\x24\xeb\xe2\xac\x50\x9b\xb8\xcd\xe4\x1e\x9d\xac\x4f\x8e\x2b\x89\x2a\xda\xd4\xb0\x93\x23\xa1\x15\xeb\x9d\x02\xf7\x38\x6e\x66\xb9\x2d\x08\x1c\x38\x29\x5d\x11\x64\x07\x97\x3e\xe6\xcc\x74\xe0\x6f\x36\x38\xd1\x55\x4e\xb1\x90\x22\xf8\x5b\xb5\x2d\x91\xf6\xa6\xa9\xca\x53\x74\x31\xc5\x88\xd8\xdf\x3e\x6f\xa7\x6b\x2e\x18\x2d\xd6\xf1\xa3\xa1\x70\x3b\x53\x14\xb9\x2e\x49\xd7\xad\x8f\x46\x80\x72\xc0\xcc\x85\xe3\xce\x6f\x5b\x72\x24\x17\xc0\xd0\x16\x8a\xca\xf8\x4b\x33\xdb\xeb\xe2\xf0\x03\x4e\x6d\x3e\xf1\x70\x80\x39\x46\xd4\xc7\x23\x05\x9d\x9a\x10\x2d\xd7\x1c\xc9\xe0\xf0\xd3\x5c\xa6\x57\x2c\x21\xd8\xc7\xe7\x4c\xc8\x3d\x57\x4f\x3b\x20\xdc\x82\xad\x36\x92\xea\xac\x54\x9b\xa7\x6a\x54\xa8\x5e\xef\x1d\xf8\x30\x07\xd1\xac\x9a\x77\x92\x19\x76\x29\x9b\x27\x3f\x28\x56\x96\x4d\x8b\x45\x50\x0d\xbd\xf7\x3a\xdb\x24\xdd\x0e\xb9\xd9\xf4\xb6\x49\x68\x03\x4a\x69\x4e\x59\x82\x4c\x7a\x67\x85\xd3\xeb\x35\xa3\x95\x0a\x85\x39\x65\x2f\x91\x2a\xfb\xca\x29\xb3\xa8\xc8\x7b\x5d\x7d\xc5\x88\x03\x86\x7d\xfb\xd7\x52\x46\xed\x47\x06\xfa\xa7\x28\x2c\xb5\x99\x41\xfe\x86\xf9\xa5\xba\x09\x99\x52\x43\x5d\xcb\x33\x0b\xed\x10\x87\x54\x5b\x50\xad\x57\xef\xb8\x45\x44\xad\xfe\x52\x35\x09\x96\xc4\x68\xf1\xd9\x8e\xdc\xea\xc0\xe6\x4b\xcd\x69\xae\x26\x5b\x44\x1e\x30\xee\xff\xd4\x92\x99\xb7\x6d\x8d\x62\x3a\x6d\xbc\xa5\x0c\xb4\x8a\x8c\x5e\xfe\x03\x0d\x6b\xcd\x68\x0b\xfd\xd1\x56\xd6\xda\x8a\xb3\xf7\xbf\x2c\x25\x5a\xb7\xf7\x2c\xf0\xbf\x4e\x74\xdb\x92\x1c\xb8\xa0\x05\x3c\x6a\x84\x6b\xa9\x7e\xb5\x20\xf7\x35\xa2\x5b\xc7\xf5\xa3\x44\x38\x57\x08\xfe\xe9\xf5\xf7\x25\x14\xef\x9a\x16\xe1\xa3\xeb\xe1\x14\x8f\xdd\xc2\x3f\x86\x1a\x5f\xc5\x5b\x63\xeb\x96\xf2\x93\xc9\xd0\x28\x14\xa6\xb7\x07\x11\xfa\x66\x41\xeb\xda\x91\xf1\xf4\x9d\x3c\x16\x93\x87\x9c\xb5\xe5\xa2\xf5\xd7\x05\x0a\xbb\x9b\xc3\x9a\x85\xda\xbc\x8b\xb6\x4a\x3e\xfb\xbe\x75\x60\x0a\x46\x46\x6b\x21\x3a\x26\x2b\xa6\x7e\x03\x2d\xe0\xbd\x3a\x18\x31\x77\xf5\x22\xd4\x41\xb3\x98\x76\xed\x56\x12\x87\x4d\x62\x5e\xfc\x0a\x46\x20\x6e\xe6\xc8\x24\x90\x17\xb3\x5e\x7b\x14\x16\x7f\x9b\x2c\xda\x27\x66\x97\x9e\x25\x76\xff\xc9\x80\x1a\xf6\x7e\x95\x60\xcc\xd4\xd3\x65\x66\x30\x97\xd8\x1f\x60\x49\xb2\x26\x3d\xe3\xdc\x72\x72\x34\xd6\x4a\xd8\x6a\xd4\x2c\xb6\x1f\x7f\x42\x6b\x5e\xf2\xa8\xf3\x59\x43\xf4\xff\xd4\x74\xd6\x32\xfd\x28\xb3\x99\x23\x82\x00\x62\x7f\x65\x9e\x87\xc3\xe8\xbd\x63\x4e\xf3\xda\xb9\xdb\x45\xe7\x5c\x8d\x03\x79\x79\x53\x27\xc1\xda\x7b\x2a\x5d\xc7\xab\x5d\x1e\xf9\xf9\x8e\x68\x84\x6a\x4d\xee\x1e\x01\xaa\x11\xdb\x61\xf1\xc9\xb5\xc7\x83\xc3\x53\xf7\xd2\x3d\xd1\x69\xaf\x46\x1c\x72\xcd\xfa\xc9\x3d\xf4\xea\x96\xb4\xbb\x5f\x13\x6b\x7b\x06\xd5\xe4\xb5\xe9\x55\x9c\x3f\x5e\x70\x1b\x4c\x0f\xdb\x69\xc9\x67\xde\x3d\x3d\xdf\xb6\x7b\x06\xc1\x9d\x2b\x69\xf0\xb2\xf0\x7f\xda\xd6\xc1\xef\xcf\xbf\xfb\xbe\x6c\x75\x0d\x65\xe0\x63\x09\xf5\x78\xd1\x89\x2e\xdb\x90\x1c\xce\x93\x96\xf8\x52\x65\xad\xe2\xd6\x27\x47\xb1\xec\x58\xe9\x55\x71\x04\xcf\x71\xab\x16\x90\x21\x35\x7f\x44\xbf\xae\x62\x32\x0b\x3f\x78\x87\xce\xbb\x49\xc7\x67\xd2\x4c\x3c\xe6\x42\x4f\x39\x45\xb5\x5d\x01\x4b\xd0\x96\x14\xf3\xe4\x79\x82\x9a\xd2\x8d\xc5\x01\x5f\x28\xf3\xc4\xe4\x72\xd9\xaf\xc7\x31\x1c\x6a\x66\x04\xc3\x05\xbf\x02\xb6\x2c\x31\x13\x24\x25\x25\x56\x7b\x26\x9a\xb9\xb6\x6f\x53\xd9\xd4\xd1\x68\x4a\x68\xc2\x52\x0f\x53\xed\x0b\x3f\x36\xb8\x5e\xb2\x52\xeb\x45\xa7\x78\xdd\x1a\xef\xa1\xbc\x29\xe6\x9e\xea\xed\xd4\x64\x93\x8a\x3c\x1a\xb7\x53\x1d\xda\xb7\x8c\x6c\x09\x0f\x6c\x6b\xee\xdd\x29\x1e\xe7\x5b\x55\xa1\xb6\x38\x83\x8e\x15\xf5\xac\x3d\x62\x6d\x44\x1a\x5f\x0c\x5c\xab\xd3\x36\xc7\x24\xfe\x91\x41\xc3\x97\x2f\x6a\x27\xed\xeb\xee\xa7\x3c\x8e\x3c\xee\x25\x8d\xa8\x07\x39\x91\x46\x92\x6e\xf6\x5b\x16\x43\x7b\xbd\xdd\xa6\x8c\x67\xe5\xed\x56\x90\x4a\xf7\x58\x2d\x8d\x0b\xf6\x77\x85\x3c\x1c\x18\xd8\xa4\x19\xc5\xca\xb2\x68\x96\x03\xfe\x76\x8c\x47\x8d\x5e\x5d\x4d\x05\x2d\x49\x3a\x8e\x2c\xbd\xba\x5a\xea\xe6\x23\x68\x0b\x4a\xe5\xa7\xa7\xd4\xb4\x3e\x86\xb8\xa0\x74\x69\xda\x8f\xa0\xce\x80\xd3\xfc\x66\x2c\xed\xb6\xf5\x08\xca\xbc\xc4\xdb\x71\x54\x55\xcb\x00\x45\x7f\x73\xcb\x5d\x8e\x3f\xd0\x17\xbb\x12\x07\x34\xc4\xe3\x5e\x57\x6c\x63\xda\x81\x21\xd0\x6b\xed\xc4\xf9\xe8\xea\x65\xef\xba\xa5\xda\x7a\xf3\xd0\xec\xf7\x6a\xfb\xc1\xba\x63\x2d\x66\x7c\x14\xe9\xa3\x7b\xb7\xdb\x51\xbf\xde\x3a\x7a\x34\x31\x0c\x04\x51\x49\x4b\xb9\x72\xab\xca\x24\x0a\xd5\xad\xcf\xd3\x98\x9d\x42\x07\x01\x9a\xe7\x6a\xd2\x50\xf1\x4d\xd0\x12\xa5\x50\x08\x60\xe6\xd1\x0d\x66\x04\xeb\x67\xda\x1e\x48\x71\x03\xcc\xc2\xc4\x93\xd1\x79\xf5\xe6\x87\xf9\x6f\xfe\x1d\x39\x79\x20\xf5\x61\x1f\x92\x79\x1f\xfd\x69\x6a\x46\x7c\x24\xe1\x97\x94\x0c\x7d\x6d\x4e\xe0\x55\x95\x63\xb3\xa5\xb3\x38\x72\x00\xbb\x8c\xee\xf2\xd3\xc2\x72\xce\x6c\x17\x73\x7e\xa9\x12\x83\x1c\x30\x87\x99\x6a\x65\x15\xb9\x77\x64\xcc\xa7\x66\x90\xb5\x50\x33\x8b\x4d\xe7\x33\x35\x25\x39\xbe\x79\x7e\xdc\x7c\x05\x6a\xdf\x17\x84\xe2\x8c\x98\xd7\x75\xac\x74\x92\x1e\xfe\x7a\x90\x33\x5c\x81\x57\xee\x21\xc1\xae\x68\xb5\xdb\x82\x7d\xde\xd4\xbf\x98\xaf\x8c\x35\xa5\xab\xfa\x72\xe1\x16\xa3\x8e\x65\x43\xbe\xb9\x11\x59\xbb\x0b\xd9\x48\xc5\xf8\x77\x6c\xec\x39\xc5\x59\x7b\xe2\x27\xf8\xc2\xae\x84\x05\x49\x5b\x32\x11\x2c\xbc\xc3\x69\x62\x5c\x5e\x01\x4a\x71\x91\x42\x1e\xd8\x85\xfe\x79\x00\xdb\xa9\x3e\xce\xa2\xbe\xf7\xd5\x6e\x80\x02\x71\x7c\x03\x01\xca\x2f\xe2\x94\x65\x0f\x9b\xee\x41\x2e\x64\x7f\xde\xeb\xe8\x91\x3e\xc0\x6b\xde\xf8\x3f\x03\x00\xa9\xe7\x69\xc1\x95\x5c\x00\x00"
 
 func repoIssueView_contentTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1852,12 +2496,12 @@ func repoIssueView_contentTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/issue/view_content.tmpl", size: 17083, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x53, 0xd8, 0xf8, 0x2c, 0x9f, 0x23, 0xc8, 0x61, 0xf1, 0x32, 0xf7, 0x58, 0xd4, 0xd8, 0x55, 0xf5, 0x6b, 0x63, 0x65, 0x5b, 0x5d, 0x83, 0x4e, 0x9, 0x74, 0x3b, 0x35, 0xb1, 0x4c, 0xf1, 0x10, 0xa0}}
+	info := bindataFileInfo{name: "repo/issue/view_content.tmpl", size: 23701, mode: os.FileMode(0644), modTime: time.Unix(1792282551, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8, 0x7a, 0xe, 0x81, 0x1d, 0x35, 0x89, 0x1f, 0xcd, 0xbf, 0x5, 0x63, 0xff, 0xb8, 0xa1, 0x1b, 0x40, 0x94, 0x97, 0x34, 0x28, 0x74, 0x7b, 0x13, 0x9b, 0xc3, 0x8a, 0x4f, 0xc9, 0xf4, 0xdd, 0xf2}}
 	return a, nil
 }
 
-var _repoIssueView_titleTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x73\xda\x3a\x14\x5d\x93\x5f\x71\xc7\x8f\xad\xcd\xcb\x5b\xbd\xc9\x80\x17\x2f\x6f\x01\x33\x49\xda\x49\xd8\x33\xc2\xba\x18\x35\xb2\xe4\xea\x83\x24\x75\xfd\xbb\xba\xef\x2f\xeb\x48\xb2\xc1\x80\x4d\x93\xe9\x06\x3c\x57\x47\xba\xe7\x9c\xfb\x31\xa5\x6c\x07\x19\x27\x5a\xcf\x22\xcd\x5e\x0d\xa2\x80\x17\x46\x11\x32\xc9\x6d\x21\xc0\x30\xc3\x31\x4a\xaf\x46\x5d\xa0\x65\x90\x2b\x46\x5d\x78\x34\xdd\x5e\xb7\x61\xf3\x82\x7c\x87\xdd\xeb\x1e\x31\x9a\xea\x92\x88\x16\xc4\x04\xc5\xd7\x28\xfd\xab\xaa\x92\x85\xd6\x16\x93\x85\x0b\xd4\xf5\x74\xe2\x50\x29\x04\x30\xa3\xb3\x88\xb9\xe3\x38\x10\x68\x6f\x6f\x89\x8e\xb1\x90\x5f\x58\x94\xee\x1f\x58\x3a\xc4\xfe\x01\x9f\xd0\x71\x75\x4f\x20\x65\x26\xbc\x10\x33\x51\x5a\x13\x75\x14\x34\x01\x6d\xde\x38\xce\x22\xca\x74\xc9\xc9\xdb\x0d\x08\x29\x30\xd0\x1e\x4d\x3d\x04\x76\x84\x5b\x9c\x45\xa7\xf9\x1a\x6d\x13\xca\x76\xde\x87\xc9\xf6\xda\xfd\x57\x15\xdb\x40\xb2\xd0\x1e\xfb\xe9\x45\xa0\xaa\xeb\x3d\xa7\x26\xfb\x46\x5a\x75\x6e\xd3\x11\xc4\x33\xff\x26\x05\x82\xc1\x57\x03\x8a\xe5\x5b\xd3\xc0\xfa\xe4\x75\x85\xad\x89\x66\x19\xe4\xca\x95\x52\x48\x13\x33\x11\x3b\x20\xac\xad\x31\x52\x78\xe3\xd8\xf5\xbf\x22\x59\x2a\x88\x14\x96\x32\xf1\x46\xeb\xc4\x81\x22\xe7\x63\xa3\xa8\x9b\x29\x23\x22\x43\x1e\x5f\x4a\xb8\xe6\x16\xe1\x24\xd7\x80\xbb\x43\x0c\x42\x96\x21\x0e\x9a\xec\x70\x80\x41\x10\xfb\xae\xe4\x40\x89\x21\xb1\x2d\x29\x31\x18\x5b\xc5\x5d\x61\xc7\xc9\x23\x96\xf2\x8e\x89\xe7\xba\x9e\x04\x2e\x93\xd3\xfe\x9c\x34\x93\x30\xc4\xdd\xb1\x3b\x66\x7e\xf8\xdc\x7f\x55\x15\x0a\xea\xfa\xa1\x8d\x84\x6e\x99\x13\x7d\x8f\x2a\x47\x7f\x74\x3a\x69\xa5\x55\x25\x47\xe0\x44\xe5\xee\x77\x8d\x3c\x4a\xa7\xac\x05\xc8\xcc\xb0\x4c\x0a\x68\xfe\xe3\x9c\x99\xb8\xb4\x9c\xc7\x0a\xbf\x5a\xd4\x26\x4a\xa7\x13\x96\xc2\x19\x6b\x87\xd1\x49\xe1\xb3\x76\x68\x57\x15\x72\x8d\x10\x5a\xd8\xab\xd7\xb7\x5c\xea\x7e\x66\x0a\xe9\x7b\x69\x85\x59\xce\xfc\x53\x43\x94\xda\x26\xf0\xa0\x55\xb0\xfb\x94\x59\x1f\x8d\x50\xfc\x0f\x11\x91\x25\x8a\xdf\x12\x71\xa0\x3e\x1a\xbe\x82\x57\xfb\x41\x0f\x2e\x7d\xb6\x9c\x7b\x72\xdd\xb0\x0b\x3e\x86\x3a\x9c\xd4\x78\x54\x55\x30\x0e\xee\x3f\x19\x75\x33\x83\x25\x2b\xf0\x89\x89\x0c\xfb\xee\x86\x8b\x30\x4e\xee\x88\xc8\xa1\xd9\x27\x04\x7c\xaa\xdc\x0c\xde\x50\xc9\xe2\x7f\xf8\xbb\xae\xb7\x0a\x37\x9d\xfd\xd5\x83\x9b\xcb\x02\x43\xfb\x47\x8d\xc0\xf4\x12\xfc\x81\x14\x7e\xe1\x92\xf3\xf5\xee\x7b\x8f\xa2\xce\xdc\xa4\x8c\x2f\x34\x5d\x70\x76\xe5\xa1\x90\x3c\xd8\xe2\x56\x16\x05\x33\x1a\x92\x39\x12\xba\x74\xd5\x34\x90\xfc\x47\x34\x36\xdf\x07\xbb\xe0\x3b\x3c\x19\xf5\xcf\x7c\x79\x7f\xd7\x5d\xfb\x9d\x0e\xe9\x73\x47\x6a\x33\xe8\x48\x38\xbb\xe8\x42\x80\xfc\x99\xf2\x8f\x4a\x1e\xd4\x19\x96\x48\x47\xb0\x6b\xa7\x4c\x21\x31\x83\xfd\x74\x1b\x4e\x8f\x9a\xe8\x88\xbf\x61\x05\x36\xfc\x43\x83\x0e\xdb\xe7\xd7\xdb\xb9\xc8\xce\xdc\x20\x5d\xad\xdf\xa2\x2e\x27\xe8\xf7\x1a\xce\xfd\x75\xb2\xc9\x06\xdb\x39\x39\x14\xf5\x1d\x29\x57\x1b\xf2\x8c\x97\xf2\xf6\x25\x10\xcd\x4c\xfe\xfc\x71\x75\x29\x89\xb0\xc5\x2a\x93\x45\x81\xc2\xe8\xa8\x7d\xb6\xa9\xa2\x8b\x05\x4b\xdb\x32\x1d\x56\xfd\xf1\xb6\xa2\x6c\xc7\x28\x2a\xb7\x7b\xfc\x46\x69\xfe\x7e\x05\x00\x00\xff\xff\xfa\xa4\x71\x5d\x88\x09\x00\x00"
+var _repoIssueView_titleTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x55\xcd\x72\xea\x36\x14\x5e\x93\xa7\x38\xe3\xd2\x99\x9b\x85\x4d\xd3\x55\x27\x03\x5e\x94\x2e\xc2\x34\x37\xed\x24\xec\x19\x61\x1d\x40\xbd\xb2\xe4\xea\x87\x24\xd7\xd5\x73\x75\xdf\x27\xeb\x48\x32\x60\xc0\xe6\x92\xb9\x1b\xf0\x1c\x7d\x47\xe7\x3b\xdf\xf9\xd1\x98\xb2\x2d\x14\x9c\x68\x3d\x49\x34\x7b\x33\x88\x02\x5e\x19\x45\x28\x24\xb7\xa5\x00\xc3\x0c\xc7\x24\xbf\x19\xb4\x81\x96\xc1\x5a\x31\xea\xcd\x83\xf1\xe6\x6e\x67\x36\xaf\xc8\xb7\xd8\x76\x0f\x88\xc1\x58\x57\x44\xec\x40\x4c\x50\x7c\x4b\xf2\x1f\xea\x3a\x9b\x69\x6d\x31\x9b\x79\x83\x73\xe3\x91\x47\xe5\x10\xc1\x8c\x4e\x12\xe6\x8f\xd3\x48\x60\xe7\xbd\x21\x3a\xc5\x52\xfe\xc5\x92\x7c\x7f\xc1\xdc\x23\xf6\x17\x84\x80\x9e\xab\xbf\x02\x29\x33\xf1\x86\x94\x89\xca\x9a\xa4\x95\x41\x63\xd0\xe6\x9d\xe3\x24\xa1\x4c\x57\x9c\xbc\xdf\x83\x90\x02\x23\xed\xc1\x38\x40\x60\x4b\xb8\xc5\x49\x72\x1a\xaf\xc9\x6d\x44\xd9\x36\xe8\x30\xda\xdc\xf9\xff\xba\x66\x2b\xc8\x66\x3a\x60\xff\x78\x15\xa8\x9c\xdb\x73\x6a\xa2\xaf\xa4\x55\xe7\x32\x1d\x41\x02\xf3\xaf\x52\x20\x18\x7c\x33\xa0\xd8\x7a\x63\x1a\x58\x57\x7a\xed\xc4\x96\x44\xb3\x02\xd6\xca\x97\x52\x48\x93\x32\x91\x7a\x20\x2c\xad\x31\x52\x04\xe1\xd8\xdd\x2f\x22\x9b\x2b\x48\x14\x56\x32\x0b\x42\xeb\xcc\x83\x12\xe7\xf6\x19\xb5\x23\x15\x44\x14\xc8\xd3\x4b\x01\x97\xdc\x22\x9c\xc4\xea\x51\xb7\x8f\x41\x8c\xd2\xc7\x41\x93\x2d\xf6\x30\x88\xc9\x5e\x15\x1c\x28\x31\x24\xb5\x15\x25\x06\x53\xab\xb8\x2f\xec\x30\x7b\xc6\x4a\x3e\x32\xf1\xc5\xb9\x51\xe4\x32\x3a\xed\xcf\x51\x33\x09\x7d\xdc\x3d\xbb\x63\xe6\x87\xcf\xfd\x57\x5d\xa3\xa0\xbe\x1f\x76\x96\xd8\x2d\x0f\x44\x7f\x46\xb5\xc6\x70\x74\x3a\x69\x95\x55\x15\x47\xe0\x44\xad\xfd\xef\x12\x79\x92\x8f\xd9\x0e\x20\x0b\xc3\x0a\x29\xa0\xf9\x4f\xd7\xcc\xa4\x95\xe5\x3c\x55\xf8\xb7\x45\x6d\x92\x7c\x3c\x62\x39\x9c\xb1\xf6\x18\x9d\x95\x21\x6a\x8b\x76\x5d\x23\xd7\x08\x6c\x05\x44\x50\xd8\x29\xa0\xa7\x5c\x6a\xa4\xf0\x49\x48\x73\x30\xfe\x69\x39\xbf\x3d\xb2\x05\xd8\x33\x12\x2d\x45\x36\xd3\x4f\x68\x8d\x22\xfc\x36\x24\x55\xd7\x43\x15\x0e\x9e\x48\x89\x70\x3f\x81\x3d\x9f\x0e\xdf\x47\x59\x10\x8e\xbf\xe3\x7b\xe3\xca\x56\x5d\xa8\x99\x9e\x5a\x6d\x64\xe9\xdc\xf1\xe5\x13\x78\x21\x82\x19\xf6\x15\xe1\x53\xa5\x98\x30\x2b\x48\x7e\xd4\x49\xc7\x15\xb7\xce\xed\x2b\x72\x2a\x7b\x5d\x9f\xe3\xb3\xa9\xe4\x52\x39\xf7\xad\x62\x74\xfa\xce\x0a\x29\x9c\xeb\xab\xc7\x6e\x02\x82\xd0\x0b\xa2\x17\x4d\x8f\xb7\x13\xfb\x07\x5e\xc8\x0a\xbb\x8a\x75\x52\xa8\xae\x7c\x14\xd2\x6b\x7b\x28\x2e\xde\x48\xe5\x3a\xbe\x91\xec\x29\xb3\x2e\x1a\x71\x52\x3f\x44\x44\x56\x28\xbe\x49\xc4\x83\xba\x68\x84\xe2\xde\x1c\xf5\x50\xec\xdc\xb3\xd6\xf2\xc6\xe7\x38\x34\x27\x03\x39\xa8\x6b\x18\xc6\x51\x79\x31\xea\x7e\x02\x73\x56\xe2\x0b\x13\x05\x76\xf9\x46\x47\x18\x66\x8f\x44\xac\xa1\x59\xfe\x04\x42\xa8\xb5\xe9\xf5\x50\xd9\xec\x37\xf8\xc9\xb9\x8d\xc2\x55\xeb\xb1\xe9\xc0\x3d\xc8\x12\xe3\xae\x4a\x9a\x04\xf3\x4b\x70\xdf\x3a\x5e\x12\x72\xfe\x16\x87\x45\x41\x51\x17\x7e\xad\x0d\x2f\x6c\x88\xa8\xec\x22\x40\x21\x7b\xb2\xe5\x54\x96\x25\x33\x1a\xb2\x07\x24\x74\xee\xab\x69\x20\xfb\x95\x68\x6c\xbe\x0f\x72\xf9\xae\x35\xea\xe7\x87\xf9\xe7\xc7\xf6\x1b\xdd\xea\x90\x2e\x75\xa4\x36\xbd\x8a\xc4\xb3\x8b\x2a\x44\xc8\xf7\x65\xfe\xd1\x94\x7b\xf3\x8c\xfb\xa5\x95\xb0\x6f\xa7\x42\x21\x31\xbd\xfd\x34\x8d\xa7\x47\x4d\x74\xc4\xdf\xb0\x12\x1b\xfe\xb1\x41\xfb\xe5\xf3\xe7\x83\xf3\x24\x5b\x73\x83\x74\xb1\x7c\x4f\xda\x9c\xa0\x5b\x6b\x38\xd7\x77\xbf\x94\x22\x8d\x43\x51\xaf\x08\xb9\x58\x91\x2f\x78\x29\x6e\x57\x80\x66\x5b\x0f\xfe\xfb\xf7\xe6\x52\x10\x61\xcb\x45\x21\xcb\x12\x85\x39\xac\xfe\xa6\x8a\xde\x16\x25\xdd\x95\xe9\xf0\x2e\x1f\x6f\x2b\xca\xb6\x8c\xa2\x4a\xf2\x66\xa3\x34\x7f\xff\x0f\x00\x1f\xf5\xee\x94\x35\x0b\x00\x00"
 
 func repoIssueView_titleTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1872,8 +2516,8 @@ func repoIssueView_titleTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/issue/view_title.tmpl", size: 2440, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x36, 0x88, 0x60, 0x11, 0x7b, 0x90, 0x67, 0xf6, 0x78, 0xc, 0xa1, 0xcb, 0x85, 0x98, 0xfb, 0x9c, 0xe1, 0x79, 0xbc, 0xb4, 0x49, 0x1c, 0x65, 0x27, 0x7a, 0x8f, 0xeb, 0xb9, 0xc1, 0x4b, 0x5b, 0xbd}}
+	info := bindataFileInfo{name: "repo/issue/view_title.tmpl", size: 2869, mode: os.FileMode(0644), modTime: time.Unix(1792282551, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2f, 0x83, 0xaf, 0xc6, 0x9, 0xed, 0x30, 0x39, 0xd4, 0x3c, 0x74, 0xa3, 0x69, 0xcf, 0xb6, 0x28, 0x24, 0x52, 0xa9, 0x55, 0xf2, 0x85, 0x72, 0xdd, 0xb3, 0x5e, 0xef, 0x3, 0xa9, 0xa0, 0xbe, 0x8c}}
 	return a, nil
 }
 
@@ -1897,7 +2541,27 @@ func repoMigrateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoPullsCommitsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcd\xaa\xdb\x30\x10\x85\xd7\xbe\x4f\x31\xe8\x01\x64\xba\xeb\xc2\x37\xd0\x76\xd3\x42\x29\xe5\xd2\x7d\x18\x5b\xe3\x78\xa8\x7e\x5c\x69\xec\xb4\x08\xbd\x7b\xf1\x4f\x42\x6e\x42\x56\x32\xd6\x99\x6f\x46\x67\x4e\xce\x42\x6e\xb4\x28\x04\xaa\xc5\x44\xf5\x40\x68\x14\xe8\x52\x5e\x1a\xc3\x33\x74\x16\x53\x7a\x55\x91\xc6\x90\x58\x42\xfc\x07\x33\xd3\x19\x38\xa5\x89\x60\x9c\xac\x85\x2e\x38\xc7\x92\xd4\xe1\xa5\xba\x85\x2d\x15\x2b\x8c\xe2\x86\xab\x6e\x79\x13\x43\x17\xbc\x20\x7b\x8a\x4b\xe5\xbb\x4b\x8f\x73\x8b\xdb\xef\x47\xe4\xda\xb9\xde\x25\x1b\xb8\xba\x47\x47\x3e\x0d\xb2\xd5\x57\x0d\xde\x5c\x9c\x22\x91\x87\x76\x12\x09\x1e\x72\xe6\x1e\x7c\x10\xd0\x3f\x27\x6b\xdf\xe8\xcf\x44\x49\xbe\xc8\x5f\xfd\xc9\xda\x70\x26\x53\x8a\xe1\x84\xad\x25\x93\x33\x79\x53\x8a\x82\x21\x52\xff\xaa\x72\xd6\x6f\x34\x86\xef\xec\x7f\x97\x52\x77\xc1\x8d\x18\xa9\xce\x59\x7f\x8e\xe8\xbb\xe1\x07\x3a\x2a\x45\x6b\x9d\xf3\x3d\xf9\x2b\xa1\xf9\xe6\xfb\x50\x8a\x3a\xe4\xac\xf9\xc3\x47\xaf\x7f\xc5\xed\x65\x7a\x71\x33\x69\x4f\x67\x55\x4a\x53\xe3\x3a\x7e\x53\x1b\x9e\x57\x7f\xae\x1f\xef\x9f\x6a\x78\xe6\xc5\xe2\xc3\x55\xf0\xc4\xb1\x65\x6d\x47\x61\xb1\x74\x71\xed\x41\xb8\x0e\x50\x0b\xb6\x47\x47\x7e\xba\xc8\xee\x1a\xb6\x41\x24\x38\x40\x11\xec\x06\x32\x20\xd8\x6e\x39\x48\x74\x72\xe4\x05\xb0\x13\x9e\xe9\xc9\xf6\xf6\xb0\x1c\x65\xf1\xf5\xda\x61\x1f\x7d\x3f\xf7\xe3\x21\x99\x7d\x08\x72\x09\xd3\xff\x00\x00\x00\xff\xff\x17\x91\x92\x06\xb7\x02\x00\x00"
+var _repoMute_scheduleTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x5d\x6f\x9b\x30\x14\x7d\x26\xbf\xc2\xb2\x3a\x69\x7b\x18\xd9\xa4\x4e\xda\x03\xe4\x65\x1f\xea\x43\xab\x4d\x6b\xa4\x3d\x56\x0e\xbe\x04\x2b\x60\x33\xfb\x92\x35\x43\xfc\xf7\xc9\xd8\xa4\x06\xd2\x26\x7d\x0a\xc6\xe7\x9e\x73\xee\xb9\xc4\x6e\x5b\x84\xaa\x2e\x19\x02\xa1\x1b\x66\x60\x59\x00\xe3\x94\xc4\x5d\xb7\x48\xb8\xd8\x93\xac\x64\xc6\xa4\x54\x43\xad\x8c\x40\xa5\x0f\xa4\x6a\x10\xde\x9b\xac\x00\xde\x94\x40\x57\x8b\x28\xa4\xb0\xb8\x9e\x02\xb4\x23\x89\x42\x96\x46\x90\x4c\x49\x64\x42\x82\xb6\x95\xa3\xd2\x5e\x9d\x95\xa0\xd1\x57\x46\x49\x71\x1d\x54\xa2\xaa\x09\x43\x64\x56\x99\x78\x09\xcb\x11\xb5\x6d\x2c\x3e\x7e\x96\xf1\x5a\x3b\xfd\xd8\x3a\x7c\x38\x3a\x74\x54\xcb\xe2\xda\x82\x27\x6e\x8e\x7c\x06\xb6\x15\x48\xec\x4d\x45\x49\xbd\x7a\x99\x33\xe6\x60\x32\xda\x75\xc9\xb2\x76\x05\xb9\xd2\x55\x40\x6b\x97\x94\xb0\x0c\x85\x92\x29\x6d\xdb\xf8\x56\xc8\x5d\xd7\x51\x52\x01\x16\x8a\xa7\xf4\xe7\x8f\xfb\xb5\xd3\xb2\xee\xbf\xdc\xff\xfa\xbe\x56\x3b\x90\x37\xeb\xbb\xdb\xde\xee\xd8\xa8\x86\x3f\x8d\xd0\xc0\x89\x90\xa5\x90\x40\x72\x01\x25\x37\xbe\x3e\x4a\x4a\xb6\x81\xf2\xac\x63\x76\x30\xbd\x63\x87\x76\xa5\x6d\xab\x99\xdc\x02\x89\x7f\x03\xec\x38\x3b\x18\x2f\x3e\x96\xef\xe5\x06\xb5\xf1\x96\x1d\x68\x01\xd9\x6e\xa3\x1e\x9f\x00\x51\x22\x64\xdd\x20\x91\xac\x82\x94\x5a\x5a\x4a\xf0\x50\x43\x4a\x8f\x58\xb2\x67\x65\x03\x36\x9a\x5a\x0b\x89\x39\xa1\x6f\xdc\x47\x47\x49\xdb\x8a\x9c\x5c\xc5\x77\x0d\xc2\xfd\x60\xfe\x86\x99\xaf\xec\x60\xf7\x7b\x06\xe0\x6d\x0b\x92\x77\x5d\x20\x39\x84\x70\x75\x4c\xe1\xed\x40\x7d\x3a\x8d\x87\x5e\xf1\xdd\x34\x12\xfb\xa9\x70\xb1\x5f\x2d\xe6\x0b\x2f\xba\x98\xbc\x0f\xf3\xc0\xbf\x6a\x3a\x9d\x53\x73\x1c\x27\xea\x06\x48\x72\xa5\x53\x6a\x90\x69\xa4\xe7\x86\xe9\x50\x33\xeb\x3e\x77\xc1\x07\x1e\x3f\x02\xbf\x70\x33\x40\x51\x41\x90\xbf\x0b\xda\x02\x6c\xf8\x83\xc3\xd5\x62\xd6\xfd\x2b\x1b\x01\xc9\xcf\xb6\x61\x31\x2f\x35\x61\xf7\x7d\x0b\xfd\xe3\xf3\x0d\x7c\x93\xfc\x84\xfd\x28\xa9\x07\xc7\x05\x94\xf5\x25\x7e\x1e\x2c\x10\x74\xf0\xdf\x1e\xcf\x3a\x78\x3c\x9b\x47\x18\x87\x35\xfd\x4f\x49\x38\xeb\xe1\x08\x9c\x06\x13\xe4\x72\xc4\xf8\x70\x9e\xd6\x93\xb1\x0e\xa4\x6b\x0f\xb0\x19\x55\xec\xb1\x04\xb9\xc5\x22\xa5\x9f\x3e\xcc\x27\xfe\xba\xc4\x06\xe5\x13\xb1\x3d\x13\xd5\x38\xa1\x4d\x83\xa8\xe4\xb0\xd7\x08\xb2\xd5\x00\x92\xb8\xd7\x67\xd5\x0d\xdb\x43\x3f\x29\x87\x9f\xea\x26\x4b\x7b\x0e\xfb\x2b\x42\xe4\x24\xbe\x61\x26\x8c\xa5\xeb\x66\xee\x1a\x41\xb8\xd8\x8b\xfe\x6a\x09\x1b\xb8\xec\x7c\x5f\x72\x28\x01\xe1\xe4\x31\xff\xdc\x39\x7f\x22\x03\xfb\x21\x6d\x98\x11\xd9\xa5\x39\x78\xd9\x79\x12\x41\xff\xc3\xd1\x35\x74\xe5\x7f\xfd\xcf\xec\x1e\xce\x95\x42\xd0\x94\xc4\x5d\xb7\xf8\x3f\x00\x12\xed\xbb\x44\x23\x08\x00\x00"
+
+func repoMute_scheduleTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoMute_scheduleTmpl,
+		"repo/mute_schedule.tmpl",
+	)
+}
+
+func repoMute_scheduleTmpl() (*asset, error) {
+	bytes, err := repoMute_scheduleTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/mute_schedule.tmpl", size: 2083, mode: os.FileMode(0644), modTime: time.Unix(1792245874, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbb, 0x84, 0x54, 0xda, 0xe4, 0x1c, 0x80, 0xe3, 0xb2, 0x63, 0x16, 0x71, 0x71, 0xa, 0x8c, 0xd9, 0xbc, 0xc5, 0xfa, 0x8c, 0x28, 0xb4, 0x4e, 0x9f, 0xde, 0x3b, 0xc8, 0xdf, 0x44, 0x9c, 0x24, 0xec}}
+	return a, nil
+}
+
+var _repoPullsCommitsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x54\x4d\x6f\xdb\x3a\x10\x3c\x3b\xbf\x62\x21\xf8\xf8\x1e\x85\xdc\x1e\xf2\x1c\x03\x69\x7a\x48\x80\xa0\x28\xe2\xa2\x57\x63\x25\xad\xa5\x45\x29\xd2\x25\x57\x72\x03\x82\xff\xbd\x90\x44\xf9\x13\x39\xc9\xd6\xce\x0c\x77\x67\x47\x0c\x41\xa8\xdd\x6b\x14\x82\xac\x40\x4f\x79\x43\x58\x65\xa0\x62\xbc\x5b\x55\xdc\x43\xa9\xd1\xfb\xc7\xcc\xd1\xde\x7a\x16\xeb\x3e\xa0\x67\x3a\x00\x7b\xdf\x11\xec\x3b\xad\xa1\xb4\x6d\xcb\xe2\xb3\xf5\xdd\xe2\x5c\x6c\x60\x8c\x62\xe4\x26\xb9\xc5\xb9\x5e\xc7\x50\x5a\x23\xc8\x86\xdc\xc0\xbc\x28\x1a\xec\x0b\x9c\x5e\xdf\x4a\x8e\x27\xe7\x09\x32\x09\x2f\xae\xa5\x1d\xd7\x8d\x4c\xfc\xc5\x0a\xcf\x0a\xb5\x23\x32\x50\x74\x22\xd6\x40\x08\xbc\x03\x63\x05\xd4\xf7\x4e\xeb\x77\xfa\xdd\x91\x97\x67\xf9\xa3\x9e\xb4\xb6\x07\xaa\x62\xac\xd8\x63\xa1\xa9\x0a\x81\x4c\x15\x63\x06\x8d\xa3\xdd\x63\x16\x82\x7a\xa7\xbd\x7d\x63\xf3\x2b\xc6\xbc\xb4\xed\x1e\x1d\xe5\x21\xa8\x2f\x0e\x4d\xd9\x7c\xc3\x96\x62\x54\x4a\x85\x70\xad\xfc\x42\x58\xbd\x9a\x9d\x8d\x31\x5b\x87\xa0\xf8\xfe\x3f\xa3\x7e\xb8\x69\x32\x35\xb8\xe9\x95\xa1\x43\x16\xe3\x2a\xc7\xb1\xfd\x55\x5e\x71\xbf\xbe\x3b\xff\x71\x39\x6a\xc5\x3d\x0f\x16\xaf\x8f\x80\x4f\x1c\x1b\xd6\xb6\x15\x16\x4d\xb3\x6b\x37\xc0\xb1\x81\x5c\xb0\xd8\xb6\x64\xba\x19\x76\x75\x60\x61\x45\x6c\x0b\x28\x82\x65\x43\x15\x08\x16\x53\x0e\x3c\xd5\x2d\x19\x01\x2c\x85\x7b\x9a\xb7\xc7\x3b\x50\xcf\x63\x42\xde\xd8\xc8\x3b\xf9\x4e\x8b\x9f\x96\x76\xad\x7c\x40\x67\xd8\xd4\xd0\x92\xf7\x58\x53\x0a\xd6\xbf\x9a\xcd\xbc\xcb\x0b\x46\xca\xd6\x67\x3e\x4e\xec\xed\xc0\xde\x26\xe5\xd1\xd7\xe4\xd2\x20\xd6\xe9\x59\x4b\xb3\x3f\x9e\xb1\x08\xc1\xa1\xa9\xe9\xf3\xbe\x07\xae\xe6\x19\x7e\x15\x31\xdf\x20\x68\x2c\x48\x9f\xd2\xb2\xbc\x8a\x4b\xcb\x32\xa4\x65\x92\x57\xaf\x5f\xa7\x34\x6c\x1a\xeb\x64\xf3\xf2\x74\x0f\xa7\x8a\xda\x88\x63\x53\x9f\xf2\x90\x1a\x9c\x11\x9b\xae\x6d\xd1\x7d\xc4\xf8\x00\x73\xd7\x4b\xfe\x07\x96\x3d\x3c\x3c\x82\xfa\xc9\x56\xa3\xb0\x35\x3e\xc6\x71\x13\x4b\x8e\xf1\x7f\x48\x69\x0e\x61\xd9\xc7\x98\xfe\x1c\xe7\xca\x4f\x83\x5d\x94\x56\x79\xa7\xd3\x07\x75\xb4\xf0\x0c\x70\x93\xa5\x74\x2b\x6c\x65\xf8\x80\x8e\x51\x4a\xd4\xf4\x4c\x8f\x9b\x2b\x68\x67\xad\xcc\xb7\xc6\xdf\x01\x00\xe7\xc6\x11\x86\xa0\x04\x00\x00"
 
 func repoPullsCommitsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1912,12 +2576,12 @@ func repoPullsCommitsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/pulls/commits.tmpl", size: 695, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x61, 0xf0, 0xcc, 0x8c, 0xe6, 0x3b, 0xb5, 0x7f, 0x5a, 0x75, 0xba, 0xed, 0x79, 0xe8, 0x3f, 0xbc, 0x60, 0x16, 0xd8, 0xa2, 0xea, 0xe7, 0xac, 0x3, 0xbb, 0xdc, 0xb, 0x30, 0xe5, 0xbe, 0x7c, 0xac}}
+	info := bindataFileInfo{name: "repo/pulls/commits.tmpl", size: 1184, mode: os.FileMode(0644), modTime: time.Unix(1792272794, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb4, 0xd9, 0x5, 0xae, 0x39, 0x65, 0x70, 0x5d, 0x85, 0x3e, 0xe1, 0x6f, 0x17, 0x90, 0xe7, 0xec, 0x0, 0x8b, 0xed, 0xf8, 0xcf, 0xf4, 0xc6, 0xcb, 0x61, 0x5b, 0x2e, 0x37, 0x34, 0xf, 0x7f, 0x87}}
 	return a, nil
 }
 
-var _repoPullsCompareTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x41\x4f\xf4\x36\x10\x3d\x87\x5f\x31\x8a\xb8\xae\xa3\x7e\xa7\xea\xd3\xc2\x01\x54\x09\xa4\x16\x21\xa0\xe7\xc8\x6b\x4f\x12\xab\x8e\x1d\x6c\x07\xb6\x72\xfd\xdf\x2b\x27\xce\x26\x59\x76\xdb\xa2\xa2\x9e\x7a\x8a\xb5\x19\xbf\x79\xf3\xf2\xde\xda\xde\x3b\x6c\x3b\x49\x1d\x42\xbe\xa3\x16\x8b\x06\x29\xcf\x81\x84\x70\xb1\xe5\xe2\x0d\x98\xa4\xd6\x5e\xe5\x06\x3b\x6d\x85\xd3\xe6\x77\x60\xba\xed\xa8\x41\xe8\x7a\x29\x81\x8b\xaa\xca\xaf\x2f\xb2\x25\x4c\xac\x1d\x60\xd0\x8c\x40\xd9\x12\xa9\x17\xc0\xb4\x72\x54\x28\x34\x71\xe7\xea\xa5\x15\x7b\x87\xa8\xe0\x5d\x70\x04\xa6\x65\xdf\x2a\xe8\x68\x8d\x50\x1b\xc1\x87\xea\x6c\xdb\x7c\x5b\x40\xa5\x36\xc3\x9b\xcc\x7b\x22\x7e\xf8\x51\x91\x17\x33\x92\x20\x91\xa2\x25\x89\x70\xc9\x1a\xaa\x6a\xb4\x79\x64\x94\x1d\xf5\xed\x77\x07\xa8\x7f\x86\x52\x72\xb4\x2c\x0f\x61\x5b\x70\xf1\x36\x12\x2b\x9a\x6f\xe3\x62\x3d\xad\xc5\xba\x45\xe5\x80\x35\x5a\x5b\x84\x9d\xa1\x8a\x35\x89\xf1\xd6\x76\x54\x4d\xb5\x9a\x39\xc1\xb4\x82\xf4\xdc\xd4\xc2\x6d\x52\xd7\xfc\x7a\x5b\xc4\xd2\xeb\x0f\xcc\x7b\x01\x95\xd4\xd4\x09\x55\x43\x25\xa4\x43\x03\xdc\xe8\x8e\xeb\x77\x95\x03\xa7\x8e\x6e\x94\xde\x18\xb4\xbd\x74\xf6\x2a\x3f\x33\x9b\xd2\x65\x2a\xc9\x43\x48\xd4\x8e\xbb\xec\xa8\x15\x0c\x6c\x4b\xa5\x84\x5d\xef\x9c\x56\x53\xe1\x7a\x0a\x87\x7b\xf7\xb7\x22\x46\xa7\xe5\x21\x7c\x07\xef\x2f\xc9\x0d\xb5\x78\x33\xa8\x12\xe5\x9c\xc7\xcc\xb2\xad\x98\x50\xa7\x99\x20\x2a\x13\xd5\x10\x13\xcb\x83\xfe\x6b\xc6\x2d\xaa\x7e\x26\xb8\x1e\x65\x50\xd9\x22\x35\xac\x01\xa1\xba\xde\x1d\x0a\x17\x1d\x93\x98\xc7\xfd\x62\x49\xdc\x02\x8a\xb6\x78\x95\x8f\x28\x39\x74\x92\x32\x6c\xb4\xe4\x68\xce\xaa\x3c\x22\x96\xc9\x00\x21\x10\x42\x66\x86\x8b\x31\xd6\xce\x64\x46\x4b\x19\xbf\xee\x6a\xa2\xcc\x7b\x13\x7d\x08\x64\x54\x0e\x6d\x72\xf5\xf1\x7e\xe1\xb0\x05\xef\x45\x05\xf8\x0a\x4b\xad\x63\x32\x2d\x4a\x64\x0e\xb9\xf7\xa8\x78\x08\xc9\x30\xbd\x91\x71\x86\x4b\xf2\x84\x9d\xfe\x59\xa8\xdf\x42\x28\xd2\x87\x2b\xbc\xff\xc9\x32\xda\xe1\xa3\xee\x15\x8f\x10\x84\x90\x01\x5d\x69\x07\x97\xe4\xb1\x97\xf2\x09\x5f\x7b\xb4\xee\xd6\xed\xc9\x33\x6d\x31\x82\x84\x10\xe1\xee\x90\xf2\x5f\x2d\x1a\xf2\x40\x5b\x0c\xe1\x7b\xea\xba\x86\x1c\xcb\x26\x3b\x0c\x46\x5a\x86\x6c\x1c\x7d\xd8\x77\x42\xb9\xc5\x7a\xb1\x24\x84\x7c\x2a\x36\xff\x81\xff\xa7\x58\x4f\x11\x58\xce\xfc\x7f\x04\x3e\x1b\x81\x59\xbd\xb3\x31\x98\x13\x30\x17\x9f\x48\x00\xc4\xb8\x7c\x3a\x06\xeb\xbf\xb0\xaf\x4e\x04\xf9\x9a\x18\xa4\xd5\xc5\xb0\x53\x54\x40\xee\xed\x83\x76\x8d\x50\xf5\x8b\xbe\x1d\xc7\x3a\x71\x30\xce\xe7\xd7\xa4\xfb\xd9\x23\x64\xc0\x2a\x9d\x5e\x98\xfb\x88\x82\xf7\x28\x2d\xc2\xd4\xfc\x17\x34\x35\x46\xe5\xbe\xa2\x6f\x1b\xc1\xa6\x73\xe5\x7c\xdf\x3b\x6a\x17\x5f\xe4\xdf\x34\x6e\xa8\x2d\xe3\xaa\x34\x23\x56\x0e\xb3\x53\xd2\xf2\x09\xe5\x23\x75\x0d\x2c\x4d\x40\xee\x15\xc7\x3d\xfc\x01\xcf\xb4\xc2\x33\x4c\xd3\xcf\x1f\xee\x54\xc2\xda\x1e\x0b\x85\xef\x65\xa5\x4d\x9b\xee\x56\xa7\x0a\x99\x6e\x5b\xe1\x6c\xe9\xe8\x4e\xe2\x5f\xd4\xc5\xdb\x5b\xb1\xd3\xfb\x43\xc9\xec\xa9\x83\x5d\xd2\x22\x3d\x3e\x5c\x17\x2b\xad\xdd\x74\xcf\xfb\x33\x00\x00\xff\xff\x3f\x9f\xfa\x6f\x4c\x0a\x00\x00"
+var _repoPullsCompareTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x57\x4d\x6f\xe3\x36\x10\x3d\xdb\xbf\x62\x20\xe4\x6a\x0a\xdd\x53\x11\x38\x46\xbb\x8b\x02\x59\x20\xdd\x06\x4e\xf6\x2c\xd0\xd4\x48\x22\x4a\x91\x5a\x92\x8a\xbd\x60\xf9\xdf\x0b\x4a\x94\x25\xf9\x63\xd3\xa0\x41\x4f\x3d\x89\x96\x86\x6f\x66\x1e\xdf\x23\x69\xe7\x2c\xd6\x8d\xa0\x16\x21\xd9\x51\x83\x69\x85\x34\x4f\x80\x78\xbf\x5c\xe7\xfc\x05\x98\xa0\xc6\xdc\x25\x1a\x1b\x65\xb8\x55\xfa\x3b\x30\x55\x37\x54\x23\x34\xad\x10\x90\xf3\xa2\x48\x36\xcb\xc5\x14\x26\xc4\x76\x30\xa8\x7b\xa0\xc5\x14\xa9\xe5\xc0\x94\xb4\x94\x4b\xd4\x61\xe6\xec\xa3\xe1\x07\x8b\x28\x61\xcf\x73\x04\xa6\x44\x5b\x4b\x68\x68\x89\x50\x6a\x9e\x77\xd1\x8b\x75\xf5\x61\x02\x15\xd3\x74\x5f\x16\xce\x11\xfe\xd3\xcf\x92\x3c\xeb\xbe\x08\x12\x4a\x34\x24\x16\x9c\xb1\x8a\xca\x12\x4d\x12\x2a\x5a\x9c\xe4\x6d\x77\x47\xa8\x7f\x86\x92\xe5\x68\x58\xe2\xfd\x3a\xcd\xf9\x4b\x5f\x58\x5a\x7d\xd8\x2c\x4f\x80\x5b\x0e\x06\xcb\x1a\xa5\x05\x56\x29\x65\x10\x76\x9a\x4a\x56\xc5\x8a\xd7\xa6\xa1\x72\x88\x55\xcc\x72\xa6\x24\xc4\xe7\xaa\xe4\x76\x15\xb3\x26\x9b\x75\x1a\x42\x37\x67\x95\xb7\x1c\x0a\xa1\xa8\xe5\xb2\x84\x82\x0b\x8b\x1a\x72\xad\x9a\x5c\xed\x65\x02\x39\xb5\x74\x25\xd5\x4a\xa3\x69\x85\x35\x77\xc9\x95\xde\xa4\xca\x62\x48\xe2\x7d\x2c\xed\x34\xcb\x8e\x1a\xce\xc0\xd4\x54\x08\xd8\xb5\xd6\x2a\x39\x04\xce\xbb\xb0\x78\xb0\xaf\x92\x18\x94\x96\x78\x7f\x0b\xce\xdd\x90\x8f\xd4\xe0\xc7\x8e\x95\x40\xe7\xd8\xe6\x62\xb1\xe6\x03\xea\xd0\x13\x04\x66\x02\x1b\x7c\xa8\xf2\xc8\xff\xbc\xe2\x1a\x65\x3b\x16\x38\x6f\xa5\x63\xd9\x20\xd5\xac\x02\x2e\x9b\xd6\x1e\x03\x27\x19\x23\x99\xa7\xf9\x42\x48\x98\x02\x92\xd6\x78\x97\xf4\x28\x09\x34\x82\x32\xac\x94\xc8\x51\x5f\x65\xb9\x47\xcc\xa2\x00\xbc\x27\x84\x8c\x15\x4e\xda\x98\x2b\x93\x69\x25\x44\x58\xdd\x59\x47\x0b\xe7\x74\xd0\x21\x90\x9e\x39\x34\x51\xd5\xa7\xf3\xb9\xc5\x1a\x9c\xe3\x05\xe0\x37\x98\x72\x1d\x9c\x69\x50\x20\xb3\x98\x3b\x87\x32\xf7\x3e\x0a\xa6\xd5\x22\xf4\x70\x43\xb6\xd8\xa8\x07\x2e\xff\xf4\x3e\x8d\x0b\x97\x3a\xf7\x9b\x61\xb4\xc1\x47\xd5\xca\x3c\x40\x10\x42\x3a\x74\xa9\x2c\xdc\x90\xc7\x56\x88\x2d\x7e\x6b\xd1\xd8\x4f\xf6\x40\x9e\x68\x8d\x01\xc4\xfb\x00\x77\x8f\x34\xff\x6a\x50\x93\x2f\xb4\x46\xef\x6f\x63\xd6\x39\x64\x1f\x36\xc8\xa1\x13\xd2\xd4\x64\x7d\xeb\xdd\xbc\x0b\xcc\x4d\xc6\x93\x21\x21\xe4\x4d\xb6\xf9\x0f\xf4\x1f\x9f\x47\x0b\x4c\x7b\xfe\xdf\x02\x6f\xb5\xc0\xc8\xde\x55\x1b\x8c\x0e\x18\x83\x2f\x38\x00\x82\x5d\xde\x6c\x83\xf9\x16\xf6\xde\x8e\x20\xef\x63\x83\x38\x5a\x76\x33\x79\x01\xe4\xb3\xf9\xa2\x6c\xc5\x65\xf9\xac\x3e\xf5\x6d\x5d\x38\x18\xc7\xf3\x6b\xe0\xfd\xea\x11\xd2\x61\x65\x56\x4d\xc4\x7d\x52\x82\x73\x28\x0c\xc2\x90\xfc\x77\xd4\x25\x06\xe6\xde\x23\x6f\x1d\xc0\x86\x73\xe5\x7a\xde\x7b\x6a\x26\x2b\xf2\x6f\x12\x57\xd4\x64\x61\x94\xe9\x1e\x2b\x81\x51\x29\x71\xb8\x45\xf1\x48\x6d\x05\x53\x11\x90\xcf\x32\xc7\x03\xfc\x05\x4f\xb4\xc0\x2b\x95\xc6\xd7\xdd\x32\x29\x0d\x64\x8b\x2f\x1c\xf7\xa8\x83\x54\xcc\xf8\xf3\x19\x69\x7d\xd4\xfb\x8f\x7b\xb8\xd6\x84\x8e\x50\xe1\xdc\xbf\x5d\x9e\xd8\x6a\x96\x76\xf4\xd5\x9a\x42\xa5\xb1\xe8\x3c\x7e\xaf\x6a\xec\xad\x91\x6c\x7e\x71\x2e\xea\x78\x9d\xd2\xcd\xf2\x92\x48\xcf\xa0\x67\x2d\xcc\xa0\x7f\x6d\x9a\xa7\x76\xf7\x75\xfb\xe0\x7d\xaa\x74\x99\x0e\x46\xec\x2f\xa1\xe4\x8f\xbd\x3c\xda\x26\xb5\x01\x25\x75\x8e\x3c\xa8\x3d\xea\xfe\x65\x57\xcf\xf5\x29\xaf\xd7\x7a\xb6\xa9\x43\xa9\xf1\x7b\xb2\x79\x8d\xc9\xf1\x62\x38\xd9\xc6\x27\x8e\x9c\x66\x39\xbb\x35\x73\x63\x5a\x4c\x25\xee\xb3\x42\xe9\x3a\xde\x9e\x2f\x05\x32\x55\xd7\xdc\x9a\xcc\xd2\x9d\xc0\x1f\xc4\x85\xfb\x79\xba\x53\x87\x63\xc8\x98\xfe\xb8\x21\xc4\x41\x7c\x9c\xfd\x21\x28\x94\xb2\xc3\x4d\xfe\xef\x01\x00\xf0\xd8\x09\xf1\x2e\x0c\x00\x00"
 
 func repoPullsCompareTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1932,12 +2596,12 @@ func repoPullsCompareTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/pulls/compare.tmpl", size: 2636, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x90, 0xd8, 0xbb, 0xcf, 0x2e, 0xe0, 0xd7, 0x8c, 0x9e, 0x5f, 0x24, 0x21, 0x4, 0x41, 0x5a, 0x95, 0x87, 0x34, 0xfe, 0xdf, 0x55, 0xe7, 0x48, 0xef, 0xe6, 0xff, 0xfd, 0x47, 0x39, 0x5d, 0x5a, 0x24}}
+	info := bindataFileInfo{name: "repo/pulls/compare.tmpl", size: 3118, mode: os.FileMode(0644), modTime: time.Unix(1792264163, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x95, 0xc9, 0x68, 0x9a, 0xfe, 0x24, 0x5c, 0x90, 0xda, 0xf, 0x88, 0xaf, 0x8b, 0x90, 0x42, 0x5, 0xa3, 0xea, 0x7d, 0xe8, 0xb0, 0xb4, 0xb4, 0x67, 0xa2, 0x9c, 0x48, 0x2d, 0x25, 0x49, 0x32, 0x10}}
 	return a, nil
 }
 
-var _repoPullsFilesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcf\x8a\xdb\x30\x10\xc6\xcf\xd9\xa7\x18\xf4\x00\x12\xbd\xf5\x90\x0d\xb4\xbd\xb4\x50\x4a\x59\x7a\x5f\x46\xd6\x38\x1e\x2a\x4b\xae\x34\x76\xb6\x08\xbd\xfb\xe2\x3f\x09\xd9\x84\x9c\x64\xac\x6f\x7e\x9a\xf9\xe6\x2b\x45\xa8\x1f\x3c\x0a\x81\xb2\x98\xc9\x74\x84\x4e\x81\xae\xf5\x69\xef\x78\x82\xc6\x63\xce\xcf\x2a\xd1\x10\x33\x4b\x4c\xff\x61\x62\x3a\x01\xe7\x3c\x12\x0c\xa3\xf7\xd0\xb2\xa7\x0c\x8e\xdb\x56\x1d\x9e\x76\xd7\xbc\xb9\x68\xe1\x51\x5a\x89\xbb\x6b\xe4\xc8\xd0\xc4\x20\xc8\x81\xd2\x5c\xf9\xe1\x32\xe0\x64\x71\xfd\x7d\x8f\x5c\x1e\x37\x9b\x64\x05\xef\x6e\xd1\x89\x8f\x9d\xac\xf5\xbb\x3d\x5e\x5d\x1c\x13\x51\x00\x3b\x8a\xc4\x00\xa5\x70\x0b\x21\x0a\xe8\xdf\xa3\xf7\x2f\xf4\x6f\xa4\x2c\xdf\xe4\x4d\x7f\xf1\x3e\x9e\xc8\xd5\xea\x38\xa3\xf5\xe4\x4a\xa1\xe0\x6a\x55\xd0\x25\x6a\x9f\x55\x29\xfa\x85\x86\xf8\x93\xc3\xdf\x5a\x4d\x13\xfb\x01\x13\x99\x52\xf4\xd7\x84\xa1\xe9\x7e\x61\x4f\xb5\x6a\xad\x4b\xb9\x25\x7f\x27\x74\x3f\x42\x1b\x6b\x55\x87\x52\x34\x7f\xfa\x1c\xf4\x9f\xb4\x4e\xa6\x67\x43\xb3\x0e\x74\x52\xb5\xee\x0d\x2e\xed\xef\x8d\xe3\x69\xf1\xe7\xf2\xf1\x71\x54\xc7\x13\xcf\x16\x1f\x2e\x82\x07\x8e\xcd\x9b\x7b\x15\x16\x4f\x67\xd7\xee\x84\x4b\x03\x46\xd0\xbe\xf6\x14\xc6\xb3\xec\xe6\x41\x1b\x45\x62\x0f\x28\x82\x4d\x47\x0e\x04\xed\x1a\x85\x4c\xc7\x9e\x82\x00\x36\xc2\x13\x3d\xd8\xde\x9c\x14\x63\xe3\xdb\x05\xbe\x75\xbd\x9d\xdb\x71\x97\xcb\x36\x46\x39\xe7\xe8\x3d\x00\x00\xff\xff\x81\x56\x1c\x92\xb5\x02\x00\x00"
+var _repoPullsFilesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x4b\x6f\xe3\x36\x10\x3e\xcb\xbf\x82\x20\x7a\x68\x0f\xa1\x50\x60\x0f\x3d\x28\x42\xd3\x14\xdb\x04\x48\xdb\x85\x37\x7b\x36\x28\x71\x6c\x11\x91\x48\x95\x1c\xd9\x31\x08\xfd\xf7\x82\xd4\xc3\xf4\x6b\xe3\xd6\x17\x99\xe4\xcc\xc7\x6f\xde\x74\x0e\xa1\x69\x6b\x8e\x40\x68\xc1\x2d\xa4\x15\x70\x41\x09\xeb\xfb\x45\x26\xe4\x96\x94\x35\xb7\xf6\x9e\x1a\x68\xb5\x95\xa8\xcd\x9e\x6c\x25\xec\x88\xb4\xb6\x03\xd2\x76\x75\x4d\xd6\xb2\x06\x4b\x84\x5c\xaf\x69\xbe\x48\x62\x3c\xaf\x14\xf0\xc0\x0c\x88\x49\x0c\xd9\x49\x52\x6a\x85\x5c\x2a\x30\x5e\xf3\xe8\x50\xf1\x6d\xc1\x87\xed\x73\xc8\x70\x79\x3a\x8a\x0c\xc0\xc9\x29\xb4\x91\x9b\x0a\x07\xfd\x24\xe3\xd1\xc1\xc6\x00\x28\x52\x74\x88\x5a\x11\xe7\xe4\x9a\x28\x8d\x84\x7d\xe9\xea\x7a\x09\xff\x74\x60\xf1\x11\xdf\xd9\x43\x5d\xeb\x1d\x88\xbe\x17\xd2\xf2\xa2\x06\xe1\x1c\x28\xd1\xf7\x94\x54\x06\xd6\xf7\xd4\x39\xb6\x84\x56\xbf\x48\xf5\xd6\xf7\x69\xa9\x9b\x96\x1b\x48\x9d\x63\xbf\x19\xae\xca\xea\x2f\xde\x40\xdf\x33\xc6\x9c\x3b\x45\x7e\x02\x2e\x9e\xd5\x5a\xf7\x3d\xcd\x9d\x63\xf2\xe7\x5f\x14\x7b\x35\x83\x65\xcc\x3b\xd4\x32\x05\x3b\xda\xf7\x59\xca\x03\xfd\x2c\x15\x72\x9b\x2f\xe2\x3f\xc7\xa6\x0a\xb9\x95\xde\xc5\xf9\x2c\x70\xc5\x63\x3e\x72\x2b\x94\x58\xc3\xe4\xb5\x33\xc1\x40\x20\x45\x5e\xac\x1a\x50\xdd\x24\x76\x72\x61\xa1\x11\x75\x43\x38\x22\x2f\x2b\x10\x04\x79\x31\xa4\x82\x85\x4d\x03\x0a\x09\x2f\x51\x6e\xe1\x3c\x7a\x21\xc1\x78\x0d\x06\xe7\xb0\x85\x08\xb0\x47\x2d\xe0\xef\x9d\x02\x63\x87\xdd\xd3\x1b\x27\xe0\x52\x0b\xb8\xd3\x41\xf0\xce\x76\x4d\xc3\xcd\x7e\x8c\x71\x92\x55\x9f\x22\x85\x31\xeb\xae\x79\xd8\xe3\xac\x06\x9c\xe0\xe9\xea\xd3\x84\xd2\x4e\x20\x15\xd4\xed\x2d\xfa\x2b\x01\xb6\x0c\x20\xed\x84\x81\x3e\x63\x22\x32\x5b\x30\x7b\x52\x70\x2b\x4b\x12\x8e\x26\xca\x49\x86\x85\x16\xfb\x69\x95\x38\x67\xb8\xda\x40\xec\x0e\xf6\x87\xd1\x5d\x3b\x79\x65\xd0\x31\xb3\x82\x5f\x89\x68\x95\x64\x9e\x98\x27\xfd\x85\x23\x82\x51\x9e\x56\xd8\x8a\x65\x6c\xcb\xd5\xc4\x0e\xe1\x1d\x7d\x49\xec\xbd\xa9\x3f\x7c\x68\x6b\xa8\x75\x4a\x7e\xac\x41\x11\xf6\xd9\x2f\x7e\xf2\x57\x78\xc4\x98\x54\x8a\xe2\x3a\xc7\xd9\xca\x6f\xf6\x10\xef\x49\x94\x1f\xea\xeb\x49\x37\x30\xd4\x17\xcd\x7f\x75\x8e\x0d\x35\x35\x55\xc5\x0c\x16\xca\xf2\x12\xfc\x2b\xf0\xe6\x3a\xfc\x43\xdb\x7e\xed\x8a\x6f\xcb\x97\xbe\x4f\xb5\xd9\xa4\xde\xfa\xe5\xdc\xe4\x58\xf0\xfe\x78\x65\x8a\x1e\xc9\x17\xf7\x8b\xde\x81\x19\x36\x03\xa7\xeb\x2a\x37\xf3\xfd\xc8\x57\xbe\x3a\x9e\xed\x43\xdb\x1a\xbd\x05\x71\x62\xce\xa5\x48\x82\xa2\x31\x42\x92\x64\x72\x12\xd1\x25\xca\x52\x2b\x32\x7e\xef\xca\x0a\xca\x37\xdf\x36\x64\x4e\x6e\x89\x3e\x1f\x59\xac\x8a\x3d\x3d\x66\x72\xf0\xfa\xc8\xf4\x34\xb0\xff\x23\xb4\x97\x82\x9b\x9c\xe5\x9a\x17\xaa\x2d\x7c\xe4\x98\x3d\xf8\x86\x4e\xf3\xef\x38\xa3\xd6\xff\xc9\x19\x0a\x40\x80\xa0\x7d\x7f\x91\xd2\xf7\x82\x9c\xa5\x51\x09\x1f\xcb\x66\x69\xdc\x11\xb2\x34\xb4\x8b\x7c\x71\x94\x0b\x73\x73\x38\x68\xad\xb5\x69\xa2\x7e\xe3\x97\x34\x34\x61\xad\x4e\x47\xd5\xd0\xe0\x9d\x63\xcf\x7e\x24\xb0\x67\x25\xe0\x3d\x4c\xb0\x43\x43\x24\x0d\x60\xa5\xc5\x3d\x6d\xb5\x45\x1a\x31\x65\x8f\x5f\x97\x9f\x5f\xf5\x1b\xa8\xa7\xd7\x3f\x5f\x0e\x06\x66\x52\xb5\x1d\x12\xdc\xb7\x70\x4f\x2b\x29\x04\x28\x4a\x14\x6f\xe0\x9e\x96\xba\x69\x24\xae\xa4\xa0\x64\xcb\xeb\x0e\x02\x9d\xa8\xbd\xf9\x69\xf8\x18\x64\x9e\x7f\xf7\xd9\xb0\x38\x4a\xfc\x27\x3e\x67\xfe\xd9\x84\xf0\xbf\x6c\x1c\xe3\xd1\x68\x0a\x5d\x76\xd8\x9e\x38\x0c\x8e\x98\x09\xec\x24\x56\xc2\xf0\xdd\x4d\xcd\x7d\x16\xf6\x71\x1e\x60\xf3\xc5\xb5\xcc\x3b\xa7\x13\x3f\x36\xae\xd0\x19\x8b\xea\x26\x36\x93\xec\x65\x32\x47\x79\xe4\x73\x20\x5f\x9c\x1d\xcd\x4f\x84\x78\xf7\xec\x15\xe0\x1f\x73\x69\xa1\xdf\xe7\xf9\x3f\x6a\x8d\xdf\xf1\x73\x36\xd9\xd7\x5a\xe3\xf4\xd4\xfb\x77\x00\x4e\x5f\x3b\x07\x58\x0a\x00\x00"
 
 func repoPullsFilesTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1952,8 +2616,8 @@ func repoPullsFilesTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/pulls/files.tmpl", size: 693, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3e, 0x11, 0xe7, 0x25, 0xc, 0xa5, 0x24, 0x9b, 0xe2, 0xed, 0xea, 0x38, 0x4f, 0x41, 0x3b, 0xb2, 0x30, 0x9d, 0xc2, 0x5b, 0x95, 0x4c, 0xb2, 0x25, 0xa8, 0x73, 0x90, 0xc4, 0xb, 0xa1, 0x63, 0xd7}}
+	info := bindataFileInfo{name: "repo/pulls/files.tmpl", size: 2648, mode: os.FileMode(0644), modTime: time.Unix(1792288872, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x48, 0xbe, 0x21, 0xed, 0x75, 0x42, 0xa4, 0x4c, 0x6a, 0x66, 0xb3, 0x40, 0x2d, 0xbe, 0xc5, 0xd2, 0xd7, 0x5e, 0xbb, 0xa7, 0x6, 0x4c, 0xf6, 0xe4, 0x7f, 0xfc, 0xe6, 0xe7, 0x7a, 0x72, 0xb1, 0x2a}}
 	return a, nil
 }
 
@@ -1997,7 +2661,27 @@ func repoPullsTab_menuTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoReleaseListTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xe3\x36\x10\x3d\x6b\x7f\x05\x21\x04\x45\x72\x88\xd4\xa4\x3d\x14\xa8\xe2\xc2\xdd\x14\x8d\x81\x6c\x10\xc4\x0e\x0a\xe4\xb2\x18\x4b\x63\x89\x08\x45\x0a\x24\x65\x27\xeb\xea\xbf\x17\x14\x29\x8b\xf2\x57\x92\x2d\xd0\x9e\x9c\x48\x9c\x99\x37\x6f\xde\xcc\x50\xeb\xb5\xc6\xb2\x62\xa0\x91\x84\x73\x50\x18\x17\x08\x59\x48\xa2\xa6\xf9\x94\x64\x74\x49\x52\x06\x4a\x5d\x85\x12\x2b\xa1\xa8\x16\xf2\x95\x48\x64\x08\x0a\xc3\xd1\xa7\xc0\x37\x36\x27\x5a\x63\x94\xd6\x3c\xf0\xed\x6b\x4a\x52\xc1\x35\x50\x8e\xd2\x58\x06\x3b\x71\x81\xa1\xd4\xce\x32\x48\x8a\x4b\xcf\xd2\x79\x35\x66\xc1\x7a\x1d\xd1\x8b\x5f\x78\x34\x93\x36\x64\xe4\xe0\x74\xbf\x2a\x6c\x1d\x04\xeb\x35\x5d\x10\xe0\x19\x89\x26\xea\x61\x03\xfe\x2f\x49\x35\x4a\x72\xca\x85\x26\x51\xff\x38\x9a\xa8\x2f\x54\x4a\x21\xcf\xac\xf1\x36\x74\x49\xf3\x42\xdb\xf8\x41\x90\x80\xf7\x46\x95\xc0\x18\xc9\x25\x22\x27\xf3\x5a\x6b\xc1\x43\x52\x48\x5c\x5c\x85\xeb\xf5\x49\x1b\xe1\x96\xf2\xe7\xa6\x89\x3b\x78\x31\xc7\x55\xe7\xe9\x70\x32\x1c\x57\x5f\x3b\x9e\x1d\xa4\x20\x89\xc1\xda\x25\x71\x46\x97\x8e\x0c\xe4\x99\x25\x2c\x2e\x2e\xcd\xa3\xa4\x66\x84\x66\xa6\x5e\xad\xf1\x39\xa3\x4a\x77\xc4\x49\xe0\x39\x9a\xb4\x2d\x92\x2e\x55\x46\xbd\x7c\x72\x49\xb3\x4d\xa2\x43\x12\x16\xa2\x96\x64\x45\x33\x24\xa9\x60\x75\xc9\x49\x89\x1a\xbc\x54\xe8\x82\x44\xf7\xf5\x9c\x51\x55\xa0\x9c\x5c\x77\xb0\xbb\x57\x13\x75\x2d\x61\xa1\xfb\xc7\x41\xa2\x2a\xe0\x5e\x80\x57\x64\x4c\xac\xc8\x1c\x14\x4d\x09\x83\x39\xb2\x70\x64\x58\xdc\x4f\x51\x66\xbc\x85\x4d\x93\xc4\xc6\xcd\xa8\x0f\x86\x4c\x21\xb1\x11\xef\x25\xba\xe3\x47\xc2\x0a\xcb\xcb\x3b\xc3\x56\x1b\x97\x7b\x63\xbb\x72\xec\x06\xd2\x90\x13\x8d\x2f\x9a\xcc\x59\x8d\x1b\xd6\x5a\x35\xed\xd5\x8b\x92\x69\xbc\x5e\x47\x33\xc8\xef\xa0\xc4\xa6\x09\x4d\xdf\x5d\x85\x5c\x2c\x84\x61\x29\x1c\x25\xd4\x77\x4d\x53\xc1\xc3\x51\x12\xd3\x11\xf1\xad\x36\x9a\x69\x65\xe3\x83\x1d\x62\x1d\x40\x4d\x45\x59\x52\xdd\x63\x7c\x03\xe2\xb4\x80\x8b\xa3\xf8\x52\x91\xe1\x10\xe0\xb4\x10\x52\x4f\x6f\xc6\x17\xc4\x59\x7b\x38\x07\x30\x7b\xa9\xef\xc8\x51\xaf\x90\x2d\x71\x20\xc8\x0c\x35\x50\xf6\x1e\x49\x26\xc5\x4f\xdf\x51\x82\x91\xf9\x8f\x6a\xb6\x45\xac\x0d\x74\xb2\x67\xd0\x34\x4d\xd2\x0e\x88\xd1\xe9\xa1\x18\x9b\xb1\x80\x19\xd5\xc7\x0b\x7e\x58\x93\xc6\x36\xb4\x98\xce\x92\xd8\x46\xdc\xd6\x62\xec\x65\x9c\x54\x1b\xe9\x18\x45\xe6\x12\x5f\x7d\x45\xfa\x5a\x80\x5a\x17\x42\x7a\x6f\x83\x84\x96\x79\xf7\x96\x96\xf9\xf9\xc5\x8f\x21\x51\x32\x35\x99\xf5\x5c\x9b\x21\x33\x5e\x82\x06\x69\xf3\x1c\x38\xe8\xa9\x18\x57\xd5\xb4\x9e\x3f\x3e\xdc\x36\x4d\x3c\x30\xf7\x08\xef\x1f\x5e\x53\x55\x31\x78\xdd\x55\xf6\x96\xb4\xbb\xca\x7f\x96\x08\x1a\x33\x53\x04\xbf\x11\x69\x89\xc6\xf1\x8c\x96\x38\xa5\x3c\xc5\xcd\x41\x72\x12\xdd\x02\xcf\x37\x6d\xbd\x45\xe1\x16\x31\xed\x9e\x3c\x52\x14\x70\x8b\xf4\xae\x2e\x3f\xb7\x0d\xa5\x7e\xc7\x82\x9a\x85\x34\x03\x99\xa3\x26\x7f\x93\xa9\x96\x97\x37\xb3\x2f\xb7\x3b\x83\x24\x89\xab\xfe\x6f\x4f\xf6\x25\xc8\xe7\x4c\xac\x8c\xd2\x55\x1a\xfa\xf9\x76\xae\x48\x74\x27\x34\xfa\x75\xef\x1b\x68\xe8\xcb\xf8\x61\x02\x32\xbf\xf2\xc5\xe5\xb1\x81\xeb\x0c\x54\x2b\x35\xbb\x6e\x9c\x5d\xcd\x3a\xaf\xfd\xbe\xe9\x90\xb9\xad\x33\xd6\x1a\xd2\xa2\x44\xae\x95\x47\x69\xbb\x7e\xbc\xe3\x46\x5e\x9d\x2b\x91\x6a\x33\x34\x88\xfb\x3d\xaf\x20\x7d\x86\x1c\xdd\x10\x39\xa0\x22\xe8\xc3\x18\x45\x3d\x3e\x9a\xae\xdf\xd3\x4a\xd1\x1e\x15\x19\xb6\x06\x68\xb6\x05\x60\x75\xd5\xde\x1d\x76\xb7\xd9\x47\x52\x59\x50\x86\xe7\xdf\x68\xb5\x9b\xcb\x60\x38\x80\x4c\x0b\xba\xc4\xc1\x58\x88\x8c\xd9\xfb\x47\x83\x12\xb5\x4c\xf1\xab\x19\xc1\x61\xd3\x90\xd3\xa7\xc9\xfd\xd9\xf1\xa4\xff\xa3\x34\x34\xc8\x28\xff\xf6\x11\xe8\xb3\xf1\x43\xf4\xe7\xd3\x1b\xe8\x77\x7a\x36\xae\xd9\x68\x6f\x33\xd8\x8b\x82\xbf\x12\x7e\xfe\x7f\xb6\xb2\x17\xf7\xad\xfe\xfc\xb7\x3a\x79\x6f\x29\x9f\x26\xf7\xc3\xf9\xfa\x3d\x95\x7d\x6f\x30\x5b\xd7\x21\x27\xc3\x3a\x1d\xba\xa8\x64\x42\x87\xa3\x1f\xf8\x5c\x55\xbf\x1e\xba\x35\x6c\xe4\xe1\x5f\x95\xad\x24\x7c\xb2\x53\xe4\xba\xfb\xc2\xd8\x73\xbf\xb7\x37\x7b\xd2\xb7\xff\x0d\x98\xbb\xe5\x92\x8a\x5a\x35\x4d\x46\x15\xcc\x19\x66\x2e\x44\x68\xcf\x0d\xcf\xf4\xf4\x59\xea\x7e\x83\x85\x46\x79\x65\xf6\x9b\x3b\x33\x5e\xb4\x17\x86\xd0\x79\xb1\xf0\x77\xbb\x83\x2a\x55\xa3\x32\xd7\xd0\xd6\xca\x7d\x1d\x74\xec\xbd\x81\x3d\x7a\x40\x48\x8b\x3f\x8c\xff\xfd\xa0\xdd\x77\x51\x77\xe8\x30\xea\x3b\x7c\xd1\x1f\x43\xcc\xf1\x45\x0f\xd1\x76\x65\x72\xbf\xee\x67\xe7\xc3\x70\x21\x84\xee\xbe\x29\xff\x09\x00\x00\xff\xff\x6e\x73\x7f\x28\xae\x0e\x00\x00"
+var _repoRecent_pushTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x91\x31\x6f\x9d\x40\x0c\xc7\x67\xf8\x14\x96\x95\xa1\x1d\x02\xea\xd6\xe1\x60\x68\xa5\xaa\xc3\xab\x14\x91\xb7\x47\x97\x3b\xbf\x87\x15\xf0\xd1\x3b\xf3\xd2\x08\xf1\xdd\x2b\x20\xa8\x4c\xcd\x74\x37\xd8\x3f\xff\xfc\xf7\x34\xbd\xb2\xb6\x50\x34\xe4\x48\xf4\x61\x4c\xed\x3c\xe7\x99\xf1\x7c\x03\xd7\xd9\x94\x2a\x1c\x19\x58\x2e\x01\x7a\x4a\xc9\x5e\x09\x81\x7d\x85\x71\x2d\xbf\x1f\xc6\xd4\x62\x9d\x67\x99\xb9\x84\xd8\x1f\x3a\x22\x5f\x5b\x85\x4b\x17\xac\x92\x47\xb0\x4e\x39\x48\x85\xd3\x74\x57\x34\x34\x84\x13\xcb\xcb\x3c\x97\x07\x4a\xe9\x39\xf5\x9c\x12\x42\x4f\xda\x06\x5f\xe1\x10\x92\xae\xec\x6c\xe9\xfa\xfe\xd8\xfc\x38\x87\x17\x92\x9f\xe7\x5f\xa7\x45\x31\xcb\x0c\xcb\x30\x2a\xe8\xdb\x40\x15\xb6\xec\x3d\x09\x82\xd8\x9e\x16\x3d\xcf\x91\x9c\x3e\x69\x40\xb8\xd9\x6e\xa4\x6d\xf6\x36\x77\xa3\x9a\xe7\x51\x35\xc8\x41\xba\x67\x61\x78\xb6\x89\x1d\xb0\x0b\x02\x5b\x01\x82\xb2\x76\xef\x00\xfe\xf2\x55\x8a\x73\x04\x8c\x34\x84\x62\xf3\x7f\x5a\xfc\x8b\xdd\x7f\xc1\x1b\xde\xa9\xc1\xe9\x8a\x7a\x7f\xef\xff\x60\x6d\x4a\xae\x4d\xb9\xb1\xd7\xe8\xca\x25\xbb\xe5\xf7\xc1\x00\x4a\x0e\xa1\xf8\x16\xad\xb8\x16\x3e\x35\xf6\xf5\xcc\x3d\x3d\xb2\x38\x82\x62\x39\x1c\x79\xb8\x2b\x4e\x56\xae\x9f\xd7\x7c\x8c\x3d\xac\xa6\x2c\x6f\x70\x8d\x44\xff\xb6\x6a\x23\x5d\xf6\x8b\xec\xb7\x7f\x18\xbb\xae\xa1\xdf\x23\x25\xdd\xa3\xfa\xbf\x94\x0b\xfd\x60\x23\xe1\x3c\x9b\xd2\xd6\x79\x66\x4a\xcf\xb7\x3a\x9f\x26\x12\x3f\xcf\xf9\xdf\x01\x00\xc5\xaa\x0c\x94\x5e\x02\x00\x00"
+
+func repoRecent_pushTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoRecent_pushTmpl,
+		"repo/recent_push.tmpl",
+	)
+}
+
+func repoRecent_pushTmpl() (*asset, error) {
+	bytes, err := repoRecent_pushTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/recent_push.tmpl", size: 606, mode: os.FileMode(0644), modTime: time.Unix(1792286051, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe9, 0x7f, 0xdd, 0xc9, 0x51, 0x2e, 0x3c, 0x5e, 0x16, 0xdf, 0xc3, 0x21, 0x43, 0xe5, 0x6a, 0xcf, 0xb8, 0xbc, 0x1c, 0xad, 0xb0, 0x3c, 0x7c, 0xe2, 0x53, 0xf6, 0xc9, 0xff, 0xcd, 0xe1, 0xc0, 0xc3}}
+	return a, nil
+}
+
+var _repoReleaseListTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x58\xcd\x6e\xe3\x36\x10\x3e\x3b\x4f\x41\x10\x41\x91\x1c\x22\x35\x69\x0f\x05\x2a\xbb\x48\x37\xc5\xc6\x68\x36\x08\x62\x6f\x0b\xe4\x12\x8c\xa5\xb1\x44\x84\x22\x05\x92\x72\x92\x75\xf5\x5c\xbd\xf7\xc9\x0a\x4a\xd4\x9f\xff\xe2\x78\x81\x45\x0f\x3d\xc9\x96\x38\xbf\xdf\x37\xc3\x21\x97\x4b\x83\x69\xc6\xc1\x20\xa1\x33\xd0\xe8\x27\x08\x11\x25\x5e\x51\x1c\x05\x11\x5b\x90\x90\x83\xd6\x43\xaa\x30\x93\x9a\x19\xa9\x5e\x89\x42\x8e\xa0\x91\x8e\x8e\x06\x5d\x61\xbb\xa2\x14\x46\x55\x89\x0f\xba\xf2\x39\x23\xa1\x14\x06\x98\x40\x65\x25\x07\x6b\x76\x81\xa3\x32\x4e\x72\x10\x24\x17\x1d\x49\xa7\xd5\x8a\x0d\x96\x4b\x8f\x9d\xff\x24\xbc\xa9\xaa\x4c\x7a\xce\x9d\xfa\xa9\x69\xa9\x60\x10\x40\xad\x20\x93\x19\x13\x31\xc9\x33\x4a\x12\x85\xf3\x21\x5d\x2e\x8f\xbd\x7b\xcc\xe4\x0d\x13\x4f\x45\xe1\xd7\x82\x1e\x18\x99\x52\x12\x81\x81\x33\xeb\x2b\x0a\x33\xa4\x6b\xe6\xe6\x88\x91\xf6\x74\x3e\xd3\xa1\x62\x33\xa4\x45\xe1\x44\x16\xa0\x18\x18\x26\xc5\x90\x32\xb1\x40\x65\x30\x22\x86\x89\x57\x3a\x0a\x58\xed\x8a\x0c\x0d\x0b\xa5\x20\xee\x79\xa6\xb4\xa6\xa3\xc0\x67\xa3\xc0\x07\x17\x1d\x9b\x13\x10\x11\xf1\xc6\xfa\xbe\x49\xf9\x9f\x8a\x19\x54\xe4\x44\x48\x43\xbc\xf6\xb5\x37\xd6\x9f\x98\x52\x52\x9d\x56\x21\xaf\x26\x5c\xb1\x38\x31\x55\xd6\xba\xf9\xc8\x19\xd1\x29\x70\x4e\x62\x85\x28\xc8\x2c\x37\x46\x8a\x37\x72\xe3\x0b\x7c\xae\x35\x6d\x87\x40\xe0\xf3\xa3\xfb\xed\x50\x18\x0c\xea\xc8\x06\x81\x1f\xb1\x85\x0b\x12\x45\x54\xc1\xec\x27\x17\x15\x19\x9e\x99\x49\x88\xf7\x01\x22\x14\x21\x3a\x04\xb3\xda\x63\x83\x2f\xc6\x7a\xfb\x4a\xc2\x6a\x81\x73\xa5\xcc\x96\x77\x0d\x7a\x2c\x0c\xaa\x05\x70\xdd\x58\xd5\x19\x88\x0d\x04\x58\x05\xf7\x78\x4b\x24\xce\xce\x63\x8a\x11\x03\x41\xc9\xc9\x75\x9e\x82\xb8\xca\x55\x89\x30\xf1\x3e\x95\xef\xc9\xb1\x77\x03\x22\x3e\xdd\x83\x03\x6f\x9a\x5a\xb7\x71\xb9\x40\x05\x31\xd6\x46\xd6\xbe\x4f\x98\x08\xf1\x06\xb4\x69\xdd\x08\x7c\x1b\x77\x9d\x1c\xe4\x1a\xeb\x84\xbc\x1d\xaa\xb6\xea\x1e\x39\x68\x43\xf7\x31\x75\x34\xe8\x21\x39\x08\xfc\x6c\x74\xd4\x7d\x13\xe4\x9c\xb0\x68\x48\x9d\x9d\x33\xce\xb4\xa9\x4b\x58\x81\x88\xd1\x52\xb9\xfc\x54\xa3\x16\x70\xd6\xe1\x68\xac\x58\xd4\x90\xb7\x4f\xec\xb9\xcc\x15\x79\x66\x11\x92\x50\xf2\x3c\x15\x24\x45\x03\x1d\x7a\x5a\x56\xdc\xe5\x33\xce\x74\x82\x6a\x7c\x55\xe7\xa0\xfe\x34\xd6\x57\x0a\xe6\xa6\x7d\xdd\xa7\x4b\xce\xc8\x2b\x72\x2e\x9f\xc9\x0c\x34\x0b\x09\x87\x19\xf2\x5d\x08\x46\x56\x1b\xed\x67\xbf\x01\x80\x54\x16\xef\x14\xba\xe5\x3b\xcc\xca\x2a\x2f\x7b\x9a\xcd\x1a\x95\x1b\x6d\xd7\xc0\xac\x19\x32\x10\x93\xb2\xa2\x66\x3c\xc7\x26\x6b\x2e\x39\xc7\xde\x58\x7f\xcc\x51\x9b\xa2\x08\x58\x57\xc2\xf6\xab\xaa\x57\x91\xe5\xd2\x9b\x42\x7c\x0b\x29\x16\x45\xcd\xb2\x00\x36\x37\x10\xad\x42\xbf\xbb\x9e\xda\xed\x63\x48\x85\x9c\x4b\x9b\x62\x3a\xda\xcb\x8a\x6d\x22\x2b\x21\x35\x58\x4e\x21\xbe\x53\xd2\x60\x68\x30\xea\x3a\xbd\xda\x6b\xb9\x0c\x9f\xc8\x01\xad\xc0\x40\xfc\x98\xd5\x06\x1e\x23\xd4\xe1\x5e\x3d\xdf\x67\xab\x1e\xf7\x21\xea\x7f\xec\x01\x14\xca\x34\x65\x86\x8e\x8e\xde\x04\x26\x94\x11\xf6\x73\x36\x49\xa4\x32\x93\xeb\xcb\x73\xe2\x4d\x12\x38\xdf\x1b\xa0\x6a\xf1\x0e\x74\xf6\x30\xb5\x8e\xd2\x6a\xc8\x16\xb1\x3f\x50\xb1\x39\x0b\xcb\xb4\xad\x56\x66\xf7\x9b\x37\xd6\xd5\x5f\x8c\x76\x94\x8c\xdb\xc3\xda\x8a\x39\x04\xe2\x85\xb3\x53\xa1\xbb\xe2\xc6\x84\xc5\x02\xd5\xca\xcb\xdf\xf1\xd5\xf6\x95\x37\x69\xd0\xf8\x3d\xd8\x4e\xcc\xda\x7a\x93\xd8\xb7\xdc\xa4\xdd\x7c\xec\xaa\xfb\xde\xbf\x76\xff\x5d\xeb\xa7\xe6\x19\xf9\x02\x7b\x1d\x35\x42\x03\x8c\xef\xd3\x53\x83\xe4\x87\xed\x3d\xc4\x16\x31\x33\xfc\x90\x46\x31\x6a\x65\xb7\x96\xff\xf1\x86\x31\xa9\x28\x82\x72\xbc\x19\x9d\x6c\x33\xd5\x0c\x35\x18\x31\xb3\xbb\x3b\x6d\x07\xc3\xca\xd2\xca\x35\xf2\xcf\xdf\xe4\x4d\x5b\xda\x80\xd1\x87\x1a\x2b\x85\x9d\xb5\xd3\xc0\xaf\xe2\x5b\x6b\x2f\x1d\x20\x36\xcc\x4e\x74\xb4\xb9\x88\x20\x37\x89\x54\x7d\xaa\xa6\x71\xfd\x95\xa5\xf1\xd9\xf9\xf7\x94\x68\x15\x96\xc3\x70\x43\x01\xbb\x79\x5f\x2e\xc0\x80\xaa\x22\xed\x29\x68\x93\x71\x99\x65\x93\x7c\xf6\xf9\xfe\xa6\x28\xfc\x9e\x78\x07\xe5\xf6\xe5\x15\xd3\x19\x87\xd7\xb6\xe9\x6f\xe3\xb9\x23\xe4\x07\x85\x50\xb5\xfd\xde\x06\xc7\x52\xb4\x8a\xa7\x2c\xc5\x72\x72\x69\x16\xba\xf1\xa5\xd9\x2e\x57\x49\xd5\x4f\x4c\x79\x12\xda\x81\x0a\xb8\xa3\xd2\x6d\x9e\x7e\x28\x5b\xb6\xfe\x15\x13\x66\x87\xf7\x29\xa8\x18\x0d\xf9\x8b\x4c\x8c\xba\xb8\x9e\x7e\xba\x59\xdb\xa0\xdd\xb8\xb4\x56\x8d\x29\xa8\xa7\x48\x3e\xdb\x02\xd4\x61\x6f\x73\xae\x55\x11\xef\x56\x1a\xec\xe2\xde\xd6\x75\x5f\x97\xd5\xc3\x25\x44\x5d\xe4\x93\x8b\x5d\x83\x8c\x13\xa8\xa8\x56\x8d\xe6\x4e\x2e\xe7\xb5\xd6\x76\x8e\xab\x3d\x73\xd3\xdc\xa5\x31\x10\x26\x29\x0a\xa3\x3b\x29\x2d\xc7\xba\xce\xf2\x5d\x9d\x30\x83\xf0\x09\x62\x74\x8d\x70\x0b\x8b\xa0\x35\x63\x19\xf5\xf9\xf3\xf8\x6a\x63\x2d\x79\x1b\x58\x64\xb3\xd5\xf3\x66\x95\x00\x6d\x34\x96\xd5\x87\xc6\xc1\x99\x78\x3a\xc3\x17\x83\x4a\x00\x5f\x8f\xc6\x2b\x03\xa1\xc4\x94\x2c\x19\xd2\xc7\x19\x07\xf1\xb4\x12\x02\x11\x52\x66\x68\x77\x1e\x21\x15\xce\x51\x29\x7b\x02\x5e\x2e\xbd\x1b\xbb\xcb\x1d\x10\x97\x3b\x58\x56\x87\x48\x37\x02\x9f\x56\x7f\x9b\x86\x7d\x7a\x60\xc0\x73\xc6\xf1\xec\x0b\xcb\xd6\x63\xed\x35\x43\x50\x61\xc2\x16\xd8\xeb\x82\x9e\x15\x7b\x47\x27\x94\xb9\x0a\xf1\xd1\x4e\x23\xb4\x28\xc8\xc9\xc3\xf8\xee\x74\x77\x2a\xbe\x51\x18\x06\x94\x17\x7f\x79\x8f\xeb\xd3\xcb\x7b\xef\xe3\xc3\x7f\xc7\xfb\x2f\xb6\xae\xdf\xe7\xfe\xc3\x64\x7a\xfa\x3e\x22\x06\x7e\xce\x47\x1b\x5b\x57\xff\xbc\x6a\x5b\xd5\x8f\xff\x9f\x4d\xbe\xdd\xd9\xa4\x93\xed\xd2\xfb\x5e\x5f\xe8\x02\xb8\x7b\x83\x19\x7c\x75\xed\xef\x4b\xf0\x87\xf1\x5d\x9f\x7a\x07\x95\xeb\xbe\xd6\xaa\x62\xfd\x4a\x83\x65\x85\xbd\xc7\xe2\xc3\x64\xba\x32\x05\x75\xf7\xfa\x1d\xc3\x7e\x7f\x92\x89\xa4\xa1\xa3\xef\xc4\x4c\x67\x3f\xf7\xc6\x90\x8e\xba\xa6\x6c\xbb\x97\x73\x55\xa9\x76\x11\x0f\xd1\xde\xb4\x55\x78\x6f\xb8\x51\xac\xee\x12\x49\x43\x20\x7b\x37\x77\xa7\x70\xc1\x64\xae\x8b\x22\x62\x1a\x66\x1c\x23\x67\x82\x92\xe6\xfe\xae\x5d\xd3\x26\xb4\x4a\xe6\x2f\x30\x37\xa8\x86\x76\x4a\x74\x6b\x2e\xe7\xe5\x90\x4f\x9d\x96\xfa\xb2\x6b\xb5\x44\x98\xd6\x39\x6a\x2f\x73\x52\xf5\xad\xb0\x0f\xfb\xf8\xee\xdd\x23\x84\xc9\x6f\x22\xda\xe6\xb4\xbb\x89\xad\x17\x6d\xf7\xfa\x16\x5f\xcc\xfb\x3c\x16\xf8\x62\xfa\xde\xd6\x30\xb9\xa7\x7b\xac\x5d\xa0\xcf\xa5\x34\xf5\xdd\xfb\xbf\x03\x00\x46\x16\x05\x76\xd6\x17\x00\x00"
 
 func repoReleaseListTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2012,12 +2696,12 @@ func repoReleaseListTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/release/list.tmpl", size: 3758, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0xeb, 0x5f, 0xa3, 0xfb, 0x4f, 0x80, 0x46, 0xdf, 0x43, 0x5d, 0x51, 0x4b, 0x77, 0x3f, 0xff, 0xb5, 0xa5, 0x87, 0x6f, 0x8c, 0xfc, 0x4a, 0xe, 0x1, 0x3, 0x91, 0x37, 0xe2, 0x6b, 0xc7, 0xab}}
+	info := bindataFileInfo{name: "repo/release/list.tmpl", size: 6102, mode: os.FileMode(0644), modTime: time.Unix(1792283285, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x68, 0x2a, 0x62, 0x86, 0x87, 0x6f, 0xa0, 0xf0, 0x8b, 0x4e, 0xd9, 0xc, 0x91, 0x1c, 0xf7, 0x64, 0x6c, 0xd6, 0xc, 0xea, 0x14, 0x2f, 0x8e, 0x6a, 0x98, 0xbf, 0x2, 0x5f, 0xe9, 0x4b, 0x93, 0x20}}
 	return a, nil
 }
 
-var _repoReleaseNewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\xdd\x6e\xe3\xb8\x15\xbe\x96\x9f\xe2\x40\x1d\x20\xce\x02\x96\xda\xbd\x2a\x5a\xd9\xe8\xb4\x33\xdb\x0e\x30\xbb\x28\x32\xc9\xb5\x41\x89\xc7\x12\x11\x9a\x54\x49\xca\x4e\xc6\xf0\xbb\x17\xfc\x91\x44\xc9\x76\x36\x83\xbd\x49\x4c\xe9\xe3\xf9\x3f\x1f\x0f\x75\x3a\x19\xdc\xb7\x9c\x18\x84\xb4\x24\x1a\xf3\x06\x09\x4d\x21\x3b\x9f\x17\x05\x65\x07\xa8\x38\xd1\x7a\x9d\x2a\x6c\xa5\x66\x46\xaa\x57\x10\x78\x04\x85\x1c\x89\xc6\x74\xb3\x48\x62\x01\x16\xe5\x04\xa0\xf2\x22\x92\x58\x46\xc7\xa0\x92\xc2\x10\x26\x50\xd9\x9d\x49\xd1\xfc\x1c\xbd\xa3\xec\xc0\x28\x13\x35\x04\x01\x16\x91\x9c\x4e\x6c\x07\xd9\x7f\x49\x8d\x5f\xf4\x67\xca\xcc\x83\x57\x6c\x45\xbb\xb7\x19\xfb\xcb\x5f\x45\xf6\xa8\xbc\xee\x2c\xd8\x95\x21\x65\x66\xdb\x1b\x19\xc0\xb1\x29\xba\x2b\x07\x35\x6f\x0b\xd1\x5d\x19\x80\xe7\x73\x91\x53\x76\x08\x66\x21\xff\x7d\x2b\x04\x1e\xff\xb0\x11\x56\xc6\x4d\x1b\x04\x75\x62\x8b\xbc\xf9\xd9\x3e\xba\xc8\x25\xe1\xa8\x4c\xc8\x44\x52\xec\xa4\xda\x47\xf1\x76\xcb\x5a\x31\x9a\x02\xa9\x0c\x93\x62\x9d\x9e\x4e\xd9\x57\x26\x9e\xcf\xe7\x14\xf6\x68\x1a\x49\xd7\x69\x2b\xb5\xe9\x53\x91\xfd\xeb\xdb\xc3\x2f\x8f\xf2\x19\xc5\x7f\x1e\x7f\xfd\xea\x3d\x9a\x25\x58\xe3\x01\x05\x1c\x19\x45\xa8\x24\xef\xf6\x02\x0c\x51\x35\x06\x11\x13\x34\x13\x9c\x09\x84\x1d\x43\x4e\xc1\xe7\xf9\xb3\x52\xdb\x47\x52\xff\x46\xf6\x78\x3e\xa3\x52\x52\x05\x27\xc3\xf6\xdf\x29\x87\x24\x29\x4a\x1b\x49\x43\xea\xad\x70\x32\x8a\xbc\xdc\x14\xba\x25\xa2\x57\x4a\x4c\xba\xf9\x47\x91\xdb\x47\x9b\x42\x1b\x25\x45\xdd\xef\xf0\x86\xda\x3d\xe1\x79\xaf\x32\x4a\x75\x92\x14\x4c\xb4\x9d\x01\x46\xd7\xa9\x21\xf5\xca\xaa\x49\xc1\xfe\x75\xeb\xad\x5f\x1f\x08\xef\xd0\x85\x73\x34\x25\x85\x96\x93\x0a\x1b\xc9\x29\x2a\xf7\xee\x7a\xc2\x07\x29\x76\x0b\xe9\x8c\xdc\xc9\xaa\xd3\xa0\xf0\x7f\x1d\x53\x48\x37\xbd\x1d\xb7\xbc\xea\xdf\xcf\xf3\xc2\xd1\x25\x19\xa8\x92\x2d\x95\x47\xd1\x87\x74\x70\xc9\xbc\xb6\xb8\x4e\x1b\x46\x29\x8a\xd8\xa5\x90\xc0\x99\x53\x7d\xb4\xd2\x3c\x92\xd3\x2b\x94\x95\x61\x95\x14\x10\xfe\xaf\x6a\x66\x56\xa5\x22\xa2\x6a\xd2\x4d\x91\xb3\x71\x47\x64\xa4\xc1\x17\x33\xda\x74\xbb\xa9\x82\x39\xe7\x33\xfc\x6d\x00\x87\x4c\xba\xac\x58\x70\x50\xb6\xaa\x3a\xa5\x50\x18\xd7\x5e\x0f\x03\x83\x65\x9f\x70\x47\x3a\x6e\xfe\xe9\x40\x17\x09\xb7\xfd\xd4\xf7\xd8\xd4\xad\x3e\x74\x60\x9d\xba\xed\xc9\x1e\x45\x37\xf1\x44\x11\x51\x23\x64\x5e\x1f\xea\xa1\x96\xe6\x0d\x61\x70\x9f\x02\x25\x86\xac\xc6\x58\xdb\xda\x77\xff\xa6\x46\x45\xdd\x7f\x69\xf2\x74\x11\x17\x4a\x83\xbc\x7d\x83\x6c\x6c\x62\x2d\x24\x30\x4d\x54\x4f\xb1\xba\x51\x7c\xf4\x2b\xf2\x03\xf9\x9c\x04\xae\x74\xff\x45\xdb\x33\xc3\x6f\x34\x7d\xc1\x49\x89\xfc\x0d\xab\xed\x56\x67\xb0\x07\x2e\xe2\xaa\x0e\x75\xec\x20\xef\xed\xc0\x20\x6f\x52\xf2\xde\xbc\xdb\x1d\x19\xc5\xfc\xc2\xcd\xf7\x3a\x62\x4f\x47\x5b\xae\x17\xae\xd8\xde\x20\x0a\x49\xf0\xa6\xc7\x59\x49\xe1\xb7\xdd\xd2\xa3\xde\x6f\x90\x0f\x3f\x31\x86\x54\xcd\x1e\x85\x19\x4b\xb3\x30\xa4\xe4\x18\x11\x88\x5b\x47\x9c\x61\x4a\x49\x5f\xaf\x14\xf9\x35\x61\x0e\xaf\x46\xb0\x5d\xd2\x78\x99\x14\x24\x9c\x13\xeb\x74\x5b\x72\x22\x9e\x53\x3b\x62\xac\x53\x21\x65\x8b\x02\x15\x08\xa9\x70\x87\x4a\xd9\xc1\xa2\x51\xb8\xb3\x49\xf9\xd8\xb6\xdf\xba\xf2\xe9\xe1\xeb\xf9\x9c\x47\x6a\xf3\xd3\x29\x7b\x7a\xfa\xf2\xc9\x66\x2b\x08\xd9\x49\xce\xe5\xd1\xc5\xeb\xb7\x70\x2e\x90\xb9\xfe\xc8\x55\x7c\x31\xa0\x90\x82\x62\x75\x63\x80\x22\x47\x83\xab\x51\xc3\xaa\xec\x8c\x91\xa2\x37\xe4\x4f\xe9\xe6\x36\xf3\xbd\x84\x86\xee\x3a\xcb\x4d\xa3\x65\x8e\x3c\x2e\xac\x88\x4b\x76\xc7\x38\xea\x74\xc6\xca\x63\x41\xf6\x72\xe2\xa0\xe6\x93\xa8\x16\x79\x1c\xf3\x0b\xbe\x98\x24\xb0\xc8\x5d\x7e\xdf\x6c\xf6\x50\x2d\x5f\xf4\xc7\x21\x12\x9f\x85\xdd\x35\x88\x9d\xd6\x99\x35\x7f\x13\x53\xd1\xec\x48\x2a\x89\x66\x15\xf8\x58\xba\x53\xe9\xbb\x14\x98\x3a\x0e\x1f\x57\x3e\x78\x2d\x97\x84\xae\x3a\xc5\xe7\x69\x0f\x9d\xa3\xe3\xfc\x87\x4d\xa4\xaa\xb0\x35\xda\x05\x6b\xb4\xf8\xa3\x2d\x04\xa4\x8f\xaf\xad\xe5\xe1\x00\xdd\x93\x97\x95\xb5\x77\x86\xfd\x95\xbc\xfc\x62\xbd\x98\xe0\x34\xfb\x7e\x05\xf7\x8d\x7d\xc7\x01\x46\xfd\xf1\xb2\xda\xa3\xd6\xa4\xc6\x29\xd3\xf4\xae\x65\x01\xb5\x0d\xa8\x74\xd8\xce\xc4\x81\x70\x46\x57\xae\x18\x56\x3e\xff\x57\x25\x04\xe0\xd6\x01\xb7\x16\x38\x0a\xb1\xee\xac\x8c\x94\xab\x92\xd5\x37\xb6\x5b\xc8\xd6\x48\xb9\x2d\x59\x3d\x6e\x54\xb8\x97\x07\x1c\xc3\x71\xb9\xcf\x23\xb6\x16\x91\xfa\x4a\x8e\x2a\x64\x28\x9c\xeb\x47\xc3\xc5\x15\x60\xfa\xba\x55\x18\x32\x0a\x53\xe2\x9c\x89\x68\xb0\x7a\x2e\xe5\xcb\x50\xfd\x93\x09\x66\x78\x1b\x1a\x69\x14\x9a\x86\xf3\x66\x7c\x72\x3e\x3b\x34\xd2\x60\xf9\x20\xd0\xd3\x6f\x34\x21\x5e\x67\xec\x51\xd2\x96\xa2\xae\xd2\x68\x98\x98\x51\x78\xc4\xc6\xd1\xcf\x1f\x38\x9b\x23\x55\x57\x8f\xe8\x59\x8c\xdc\x6d\xca\x06\xf9\xfd\xe7\xc0\x5b\x63\xb5\xef\xd2\x51\x7c\xad\x10\x45\x68\xde\x91\x84\x7a\x86\xf8\xa4\xc8\xce\x44\xf4\x7f\xdb\xa9\xae\xe4\x4c\x37\xe9\x88\x9d\x8d\xdb\x3f\x78\xc7\xbb\x20\xba\x22\xf7\x36\x0e\x89\x8d\x89\xde\x72\x7c\xcf\x3f\x9e\xe4\x7b\x66\xf7\xb4\xe3\xf9\xe6\x83\x1b\x1d\xfd\xbd\x68\x24\x1c\xbf\xa1\x6f\x59\xcf\xed\x53\x46\xb6\x3b\xaf\x5b\xee\xf7\x5e\xda\x3e\x9e\x07\xf3\x4b\xc7\xbb\xe3\xff\xde\x38\xcf\xe2\x32\x09\xda\xe9\x94\xff\x04\xa1\x04\x80\x32\x2a\xee\x0c\x68\x72\x40\x20\x1a\xa8\xcd\x2c\x10\x03\x3b\xa6\xb4\x01\xc3\xf6\x08\x4c\x83\x90\x06\x5a\xa9\x35\xb3\x43\x83\x91\x50\x62\x8f\xac\x09\x13\x50\x62\x45\x3a\x8d\x60\x1a\x84\x7f\x33\x03\x86\xd4\xd0\x10\x0a\xa5\x75\xa2\x52\x48\x0c\xd2\x0c\x7e\xca\x47\x0b\xd8\x0e\xa4\x82\xa5\x95\x7b\x59\x97\xf7\x97\x35\x16\x18\x60\x12\xa0\xd7\x3e\x3e\x81\x18\x74\x57\xee\x99\xe9\x69\xc1\xd9\x17\x9f\xa8\xd7\x23\x67\x1d\xdf\x7a\x6c\x74\xd9\x99\xc4\x6b\x52\x53\xf1\xa9\x36\x8e\x2a\xd7\x4a\x68\x48\xdb\xed\x79\x90\x88\x0a\xf9\x90\xb4\xa1\x3c\xae\x0c\xe0\x45\x6e\xaf\xf3\x9b\x45\xff\x24\xfc\x5b\xbc\xd1\xd9\xf3\x1b\xe2\x9e\x70\x1e\xcc\xf7\x05\x0a\x7b\x49\x09\xf7\x5f\x6a\xa6\x58\x37\xe2\xc4\x9f\x69\xc6\x01\xc8\x28\xa2\x9b\xd9\x05\xe9\xa6\x83\x4e\x0f\x93\x22\x0d\x9f\x31\x7a\x67\x22\x6d\xc3\xac\xeb\xd4\xb4\xb7\xf9\xb1\x97\x35\x12\x71\xbb\x89\x85\x5e\x7c\x1c\x09\x5d\xe8\x9c\xdc\xfa\x2f\x20\xba\xff\x6a\xe5\x37\xf5\x59\x5e\x14\xba\x52\xac\x35\x9b\x45\x92\xe7\xf0\xc9\x07\x67\x9c\x38\x40\xc9\xe3\x22\xf9\xb0\xbc\xcb\x6e\x8d\x8a\x77\xf7\x59\xc5\x59\xf5\xbc\xdc\x75\xc2\xdf\xc2\x97\x78\x0f\xa7\x45\x92\x1c\x88\x82\x0f\x4a\x1e\x61\x0d\x1f\x96\xa6\x61\xfa\x3e\x6b\x89\xbd\xb3\xea\x27\x61\x18\x5f\xde\xb9\x31\xed\xee\xfe\xef\x3d\xd6\xad\x2d\x5a\xc9\xe3\x1c\x6a\x87\x31\x0b\x5d\x24\x89\x7b\xed\x4f\xea\xa5\x7f\x92\xe7\xf0\xe0\xd6\xae\x0b\x51\x18\xa6\xd0\x4f\xf5\xc0\x76\x60\x55\xc3\x91\x68\xf7\x92\x13\x1d\x9c\x4a\xd8\x0e\x96\x5e\x67\x56\x35\x8c\x53\x85\x62\x79\x9f\x71\x14\xb5\x69\x60\xbd\x86\x3f\x7b\x37\x92\x80\x99\x1a\x94\xb9\x43\xe6\xee\x3e\x32\x24\x49\xce\xd6\x18\x77\x9c\x1d\x50\x98\x70\x15\x77\xaf\xce\xf7\x8b\x22\xef\x23\xbd\xb8\xc8\xd7\x4e\x4a\xd3\x7f\x57\xfc\x7f\x00\x00\x00\xff\xff\x1d\xd1\x98\xc5\xb6\x14\x00\x00"
+var _repoReleaseNewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x59\xdd\x6e\xdb\x38\x16\xbe\x96\x9f\x82\xd0\x14\xb0\x3d\x80\xa5\xdd\xb9\x5a\xcc\xca\xc6\x66\xa7\xed\x6e\x81\xce\x60\x90\xa6\xd7\x06\x25\x1e\x49\x44\x68\x52\x4b\x52\x76\xd2\x40\xef\xbe\xe0\x8f\x2c\x4a\xb6\x9c\x04\x73\xd3\x58\xd2\xc7\x73\xbe\xf3\xcb\x43\xf6\xe5\x45\xc3\xa1\x61\x58\x03\x8a\x73\xac\x20\xad\x01\x93\x18\x25\x5d\xb7\xc8\x08\x3d\xa2\x82\x61\xa5\xb6\xb1\x84\x46\x28\xaa\x85\x7c\x46\x1c\x4e\x48\x02\x03\xac\x20\xde\x2d\xa2\x50\x80\x41\x59\x01\x20\x9d\x88\x28\x94\xd1\x52\x54\x08\xae\x31\xe5\x20\xcd\xca\x28\xab\x7f\x09\xbe\x11\x7a\xa4\x84\xf2\x0a\x79\x01\x06\x11\xbd\xbc\xd0\x12\x25\x7f\xe2\x0a\xbe\xa8\x4f\x84\xea\x7b\xa7\xd8\x88\xb6\x5f\x13\xfa\xf7\x7f\xf0\xe4\x41\x3a\xdd\x89\xe7\x95\x00\xa1\x7a\xef\x1f\x62\x0f\x0e\xa9\xa8\x36\x3f\xab\xb9\x2d\x44\xb5\xb9\x07\x76\x5d\x96\x12\x7a\xf4\xb4\x80\xbd\xce\x82\xc3\xe9\x2f\x93\x30\x32\x66\x39\x70\x62\xc5\x66\x69\xfd\x8b\x79\x75\x11\x4b\xcc\x40\x6a\x1f\x89\x28\x2b\x85\x3c\x04\xfe\xb6\x8f\x95\xa4\x24\x46\xb8\xd0\x54\xf0\x6d\xfc\xf2\x92\x7c\xa5\xfc\xb1\xeb\x62\x74\x00\x5d\x0b\xb2\x8d\x1b\xa1\x74\x1f\x8a\xe4\xb7\x6f\xf7\x9f\x1f\xc4\x23\xf0\xff\x3e\xfc\xfe\xd5\x59\x34\x09\xb0\x82\x23\x70\x74\xa2\x04\x50\x21\x58\x7b\xe0\x48\x63\x59\x81\x17\x31\x42\x53\xce\x28\x07\x54\x52\x60\x04\xb9\x38\x7f\x92\x72\xff\x80\xab\x3f\xf0\x01\xba\x0e\xa4\x14\xd2\x1b\xe9\x97\xbf\x92\x0e\x51\x94\xe5\xc6\x93\x1a\x57\x7b\x6e\x65\x64\x69\xbe\xcb\x54\x83\x79\xaf\x14\xeb\x78\xf7\xaf\x2c\x35\xaf\x76\x99\xd2\x52\xf0\xaa\x5f\xe1\x88\x9a\x35\xfe\x7d\xaf\x32\x08\x75\x14\x65\x94\x37\xad\x46\x94\x6c\x63\x8d\xab\x8d\x51\x13\x23\xf3\xef\x36\xee\xd5\xc6\xe8\x88\x59\x0b\xd6\x9d\x03\x95\x18\x35\x0c\x17\x50\x0b\x46\x40\x6e\xe3\xd9\x80\x9f\xa5\x98\x25\xb8\xd5\xa2\x14\x45\xab\x90\x84\xff\xb5\x54\x02\xd9\xf5\x3c\xe6\xac\xea\xbf\x4f\xe3\xc2\xc0\x06\x19\x11\x29\x1a\x22\x4e\xbc\x77\xe9\xd9\x24\xfd\xdc\xc0\x36\xae\x29\x21\xc0\x43\x93\x7c\x00\x27\x46\xf5\xde\x8a\xd3\x40\x4e\xaf\x50\x14\x9a\x16\x82\x23\xff\x77\x53\x51\xbd\xc9\x25\xe6\x45\x1d\xef\xb2\x94\x0e\x2b\x02\x92\x1a\x9e\xf4\xc0\x29\xba\xe1\x1f\x4b\xa7\xeb\xd0\xaf\x67\xb0\x8f\xa4\x8d\x8a\x01\x7b\x65\x9b\xa2\x95\x12\xb8\xb6\xe5\x75\x7f\xee\x60\xc9\x47\x28\x71\xcb\xf4\xbf\x2d\xe8\x22\xe0\x51\x34\xd4\xd8\xd8\xac\xde\x75\xc8\x18\x35\x6f\xc9\x01\x78\x3b\xb2\x44\x62\x5e\x01\x4a\x9c\x3e\x50\xe7\x5c\x9a\x16\x84\x86\x43\x8c\x08\xd6\x78\x33\xf8\xda\xe4\xbe\xfd\x33\x26\x15\x54\xff\x25\xe5\xf1\x43\x98\x28\x35\xb0\xe6\x46\xb3\x31\x81\x35\x10\xdf\x69\x82\x7c\x0a\xd5\x0d\xe2\x83\x5f\x81\x1d\xc0\xa6\x4d\xe0\x4a\xf5\x5f\x94\x3d\xd5\x6c\xa6\xe8\x33\x86\x73\x60\x37\x58\x9b\xa5\x96\xb0\x03\x2e\xc2\xac\xf6\x79\x6c\x21\x6f\xad\x40\x2f\x6f\x94\xf2\x8e\xde\x7c\x45\x06\x3e\xbf\x30\xf3\xad\x86\x98\xdd\xd1\xa4\xeb\x85\x29\xa6\x36\xb0\x04\xec\xad\xe9\x71\x46\x92\xff\x6d\x96\xf4\xa8\x51\xaf\xfc\xad\x36\xc9\xc7\x44\xf5\x59\x8a\xc3\xd0\xc5\xde\x91\x14\x45\x2f\x61\xdf\x48\x28\x29\x63\x40\xe2\x89\xdc\xe0\xf1\x41\xbc\x29\x73\xe6\x9d\xe4\x68\x63\xad\x71\x51\x1f\x80\xeb\xa1\x5c\x32\x8d\x73\x06\x41\x53\xb3\xcf\x41\x1f\xd3\xb9\x20\xcf\x57\x0a\xef\x9a\x30\x8b\x97\x03\xd8\x3c\x92\xf0\x31\xca\xb0\xdf\xbb\xb6\xf1\x3e\x67\x98\x3f\xc6\x66\xec\xd9\xc6\x5c\x88\x06\x38\x48\xc4\x85\x84\x12\xa4\x04\x19\xa3\x5a\x42\x69\x12\xe5\xae\x69\xbe\xb5\xf9\xf7\xfb\xaf\x5d\x97\x06\x6a\xd3\x97\x97\xe4\xfb\xf7\x2f\x1f\xbb\xee\x2c\xa4\x14\x8c\x89\x93\xf5\xfb\x1f\x7e\xaf\xc2\x53\xfd\x81\xa9\xf0\xa4\x91\x04\x82\x24\xad\x6a\x8d\x08\x30\xd0\xb0\x19\x34\x6c\xf2\x56\x6b\xc1\x7b\x22\x3f\xc5\xbb\xf9\x6e\xfc\xe4\x9b\x4c\xdb\x9a\x7e\x39\x30\xb3\x0d\xed\x82\x45\x58\x46\x25\x65\xa0\xe2\xc9\x4e\x31\x14\x49\x2f\x27\x74\x6a\x3a\xf2\x6a\x96\x86\x3e\xbf\xe8\x61\xa3\x00\x66\xa9\x8d\xef\xed\x34\x72\xd9\xf2\x45\xdd\x9d\x3d\xf1\x89\x9b\x55\x67\xb1\xe3\x3c\x33\xf4\x77\xc1\xf2\xe9\x36\x99\x63\x45\x0b\xe4\x7c\x69\x77\xca\x1f\x82\x43\x6c\xf7\x95\xe1\xc9\x39\xaf\x61\x02\x93\x4d\x2b\xd9\x34\xec\xbe\x6e\x54\x18\x7f\xbf\x08\x17\x05\x34\x5a\x59\x67\x0d\x8c\xef\x4c\x22\x00\x79\x78\x6e\xcc\xde\xe0\xa1\x07\xfc\xb4\x31\x7c\x27\xd8\xdf\xf1\xd3\x67\xca\x26\x38\x45\x7f\x5c\xc1\x7d\xa3\x3f\xe0\x0c\x23\x6e\xcb\xdb\x1c\x40\x29\x5c\xc1\xb8\xfb\xf5\xa6\x25\x1e\xb5\xf7\xa8\xf8\xbc\x9c\xf2\x23\x66\x94\x6c\x6c\x32\x6c\x5c\xfc\xaf\x4a\xf0\xc0\xbd\x05\xee\x0d\x70\x10\x62\xcc\xd9\x68\x21\x36\x39\xad\x66\x96\x1b\xc8\x5e\x0b\xb1\xcf\x69\x35\x2c\x94\x70\x10\x47\x18\xdc\x71\xb9\xce\x21\xf6\x06\x11\x77\xdd\x28\xc4\xa3\xc4\x19\x9d\x68\x6c\x98\x36\x8c\xf2\x47\x15\xef\x16\xd1\xcd\xae\xf4\x6a\xef\x76\x62\x2e\x3a\xf7\xc0\x63\x68\x47\x16\x3a\x74\xb5\x40\xa5\x3e\x09\x37\x0f\x2b\x14\xd2\x8b\xaf\x8e\x19\x63\x7e\x93\x4a\x35\xcb\xf6\x96\x49\x58\xa0\x5f\xcd\x8b\x2b\xa3\xe8\x87\x1b\x46\x79\x29\x61\x5d\x4f\xe6\xa3\x77\x71\x6a\x25\xeb\x1b\x48\x2b\x47\xe4\x6c\xf5\x4c\xa8\xd5\x5a\x37\xea\xd7\x34\x9d\xd1\x3d\x76\xef\x10\xe7\x77\x39\xf5\x06\xff\x59\x97\xbe\x6d\x90\xb8\xee\xbe\xb1\x05\xef\x51\x3e\xf5\xdd\x6d\x57\x8d\xda\x5c\xf8\x7b\xb4\xaf\x50\xfe\x3c\xea\x7a\xae\xd9\x61\x42\x36\x23\x67\x0d\xfb\xca\xac\xb5\x98\x90\xbd\xc5\x86\x5b\xd9\x7b\x06\x0d\x5b\x17\xd7\xe7\xcf\xd7\x46\xce\x8b\xab\x85\xf1\xe7\x46\x82\x57\x82\xc6\x03\xd9\x44\x44\x0d\xc5\x63\x2e\x9e\x06\x17\x86\x27\xa3\xf3\x57\x1f\x92\x41\x68\xec\xe7\xd8\xe1\x4d\xd7\x59\x34\x10\x9f\x95\x93\x2e\x12\x9c\x3c\xaf\xbb\x62\x90\xb4\x27\xa0\x8a\x38\x38\xa4\xcc\x36\x98\xf0\xe7\x3b\xbc\x1e\xa8\xba\xee\xfa\xb1\x8f\xec\x2d\x8d\x71\xf2\xdb\x67\xb9\x5b\xc7\x75\xb7\xd3\x0e\xe2\x2b\x09\xc0\xfb\x54\x3c\x97\x42\xbf\xcb\x7f\x94\xb8\xd4\xe7\xd5\x37\x0e\x89\x4d\x9b\x33\xaa\xea\x78\xc0\x4e\x8e\xf1\xef\xbc\x3b\xba\x18\x56\xb2\xd4\x71\xdc\x2d\xc6\x0c\x1f\x70\xf5\xa7\x14\x1a\x0a\x0d\xe1\x64\x13\xc6\xc3\x8e\x72\x95\x84\xe7\x5b\x33\x1a\x13\xc5\xa3\x1b\xc8\xd0\x7c\x7b\x36\xc7\xb5\xa6\xd7\x16\x24\x4a\x70\x0d\x30\x35\x7b\x54\xfc\x66\x9e\xec\x67\x1d\x37\x50\xf6\x3d\xc0\x8d\x38\x6e\xb6\xf9\x60\x8f\xce\xee\x5e\x68\x18\x6e\xdc\x02\x8f\xf4\x73\xe4\x64\xfa\x9b\x27\xee\x16\x5f\x71\x72\x30\x7d\x8e\xfc\x3d\xbd\x84\x79\x73\xde\xbc\x35\x3f\x26\xf1\x9c\x28\x4f\x7f\x46\x3e\x75\x11\xa1\x84\x2f\x35\x52\xf8\x08\x08\x2b\x44\x4c\x46\x22\xac\x51\x49\xa5\xd2\x48\xd3\x03\x20\xaa\x10\x17\x1a\x35\x42\x29\x6a\x0e\x2c\x5a\xa0\x1c\x7a\x64\x85\x29\x47\x39\x14\xb8\x55\x80\x74\x0d\xe8\x3f\x54\x23\x8d\x2b\x54\x63\x82\x72\x63\x44\x21\x01\x6b\x20\x09\xfa\x39\x1d\x18\xd0\x12\x09\x89\x56\x46\xee\x65\x3d\xad\x2f\x6b\xc3\x77\xae\x91\x83\x9e\xcf\x2d\xde\x35\x34\xd5\xe6\x07\xaa\xfb\x76\x66\xf9\x85\xfb\xf1\x75\xcf\x19\xc3\xf7\x0e\x1b\x5c\xfe\x8c\x37\x5f\x3c\x33\x51\x0f\xc7\xa4\x6b\x29\x15\x0f\x91\x9f\x3b\x89\x62\x5e\xd8\xbd\x74\x31\x4e\x96\x2b\xbb\x43\x96\x9a\xeb\xcd\xdd\xa2\x7f\xe3\xff\x2c\x6e\x74\xa4\xe9\x8d\xd9\x01\x33\xe6\xe9\xbb\x7c\x45\x07\x41\x30\x73\x37\xd7\x63\xac\x2d\xdd\xf0\xda\x7a\x28\x6c\x2d\xb1\xaa\x27\x17\x46\xb3\x06\x5a\x3d\x54\xf0\xd8\x5f\xeb\xf6\xc6\x04\xda\xce\x67\x7f\xab\xa6\xd9\xbd\x2a\x6b\xe8\x0b\xcd\x2e\x14\x7a\x71\x59\xec\x8b\xd2\x1a\xb9\x77\x37\xc2\xaa\xbf\xc5\x77\x8b\xfa\x28\x2f\x32\x55\x48\xda\xe8\xdd\x22\x4a\x53\xf4\xd1\x39\x67\x38\xed\x20\x29\x4e\x8b\xe8\xc3\x6a\x99\xcc\x1d\x53\x97\xeb\xa4\x60\xb4\x78\x5c\x95\x2d\xb7\x8a\xd0\x0a\xd6\xe8\x65\x11\x45\x47\x2c\xd1\x07\x29\x4e\x68\x8b\x3e\xac\x74\x4d\xd5\x3a\x69\xb0\xb9\xc3\x53\xdf\xb9\xa6\x6c\xb5\xb4\x47\xc4\xe5\xfa\x9f\x3d\xd6\x3e\x1b\xb4\x14\xa7\x29\xd4\x1c\x04\x0d\x74\x11\x45\xf6\xb3\x3b\x25\xac\xdc\x9b\x34\x45\xf7\xf6\xd9\x56\x21\x70\x4d\x25\xb8\x1b\x05\x44\x4b\x64\x54\xa3\x13\x56\xf6\x23\xc3\xca\x1b\x15\xd1\x12\xad\x9c\xce\xa4\xa8\x29\x23\x12\xf8\x6a\x9d\x30\xe0\x95\xae\xd1\x76\x8b\xfe\xe6\xcc\x88\x3c\x66\x4c\x28\xb1\x9b\xe3\x72\x1d\x10\x89\xa2\xce\x90\xb1\xdb\xf0\x11\xb8\xf6\x57\x93\xf6\x53\xb7\x5e\x58\x07\xdf\x11\x82\x30\x47\x70\x68\xf4\xb3\xa1\x81\x44\x89\xe0\x49\x83\xe4\x98\x21\x33\x34\x59\x67\xff\x34\x9d\xd9\x5e\x71\xb2\x35\xca\x78\x79\x99\x4c\x56\x99\x2f\x8e\x9b\x05\x25\x05\x13\x1c\x56\xeb\x84\x72\x05\x52\xdf\x95\x1a\xe4\xca\x7e\x59\x27\x25\xe5\x64\xb5\xb4\xbd\x66\xb9\x4e\x8e\x98\xad\x96\x2e\x36\x73\x06\x65\x69\x9f\x3a\x8b\x8b\x04\x2c\x85\xd0\xfd\x7f\x1c\xfd\x7f\x00\x43\xe2\x63\xec\x97\x1a\x00\x00"
 
 func repoReleaseNewTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2032,12 +2716,52 @@ func repoReleaseNewTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/release/new.tmpl", size: 5302, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7c, 0xb1, 0x83, 0xe4, 0x57, 0x63, 0x5d, 0xba, 0xe7, 0xf9, 0x45, 0x7a, 0x54, 0xce, 0x2b, 0xa2, 0x13, 0xa3, 0xc7, 0x7c, 0x92, 0xb3, 0xee, 0x9c, 0x49, 0x12, 0xa4, 0xed, 0xdd, 0xb4, 0x6, 0x15}}
+	info := bindataFileInfo{name: "repo/release/new.tmpl", size: 6807, mode: os.FileMode(0644), modTime: time.Unix(1792271618, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0xe3, 0xd2, 0x6b, 0xd9, 0x21, 0xd6, 0x3d, 0x68, 0xed, 0x12, 0x2e, 0xa0, 0xee, 0xfc, 0x46, 0x8e, 0xa1, 0xf9, 0x42, 0x90, 0x10, 0xe4, 0x93, 0xef, 0xfc, 0x3a, 0x39, 0xec, 0xdd, 0xd, 0xc8}}
+	return a, nil
+}
+
+var _repoReleaseStatsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x56\x5d\x6b\xe3\x3a\x10\x7d\x76\x7e\x85\x10\x7d\xb8\x17\xee\xb5\xd8\xd2\x87\x65\x51\x0c\x85\xb2\xb0\x50\xf6\xa1\x1f\xcf\xcb\xd8\x9a\xd8\xa2\x8e\x64\xac\x49\xb3\x41\xf8\xbf\x2f\xb2\x1d\x47\x6e\xd3\xa4\x2d\xec\x93\xbf\x66\xce\x39\x9a\x99\x23\xd9\x7b\xc2\x75\x53\x03\x21\xe3\x39\x38\x14\x15\x82\xe2\x2c\xed\xba\x85\x54\xfa\x99\x15\x35\x38\xb7\xe4\x2d\x36\xd6\x69\xb2\xed\x8e\xb5\x58\x23\x38\x64\x8e\x80\x1c\xcf\x16\x49\x0c\x11\xe2\x7a\x08\x6c\x07\x90\x24\x46\xd9\x68\x56\x58\x43\xa0\x0d\xb6\x21\x33\x91\xd5\x65\xf4\x4d\xe9\x67\xad\xb4\x29\xd9\x08\x10\x22\x12\xef\x53\xfd\xe5\xab\x49\x1f\xda\x01\x3d\x1d\xf9\xd3\x9e\xff\x17\x69\xaa\x91\xb3\xf4\x6e\x7c\xfb\x00\xe5\x4f\x58\x63\x60\x4e\x66\xdc\x6e\x93\x4f\xb8\x67\x30\x15\xba\x82\xb3\xf4\x3e\x3c\xdc\xc0\xce\x75\x9d\x14\x4a\x3f\xf7\x82\x45\x75\x39\x08\xbf\x8a\x84\x93\x6d\x18\x10\x41\x51\xa1\xfa\x88\x78\x70\x0e\xc9\xf1\x5e\xac\x14\xd5\x55\xc8\xf1\x5e\xaf\x46\xee\xf4\xba\xff\x3e\xae\x85\x20\xaf\x31\x22\x9d\x08\xfb\x0f\x03\x5f\x22\x29\xd0\x0f\xf7\x89\xa4\x76\xbc\x0b\xef\xb3\xf7\x88\xe1\x61\xad\x54\x45\x69\x7b\x46\xaa\x5a\x44\xb6\xd5\x0a\x59\xab\xcb\x8a\x18\xd4\xba\x34\xa8\xce\x57\xd3\x6e\x4d\x6d\x41\xb9\x39\xb6\x14\x7b\x75\x52\x44\xa2\x25\xe5\x56\xed\xc6\x18\xef\x5b\x30\x25\x1e\x2b\xc7\x7c\x79\x89\x24\x95\x49\xbd\xd7\x6a\x0b\xd2\x85\x35\x6c\xbc\xfe\xdf\x40\xf1\x04\x25\xf2\x4c\x0a\x9d\x31\x09\xac\x6a\x71\xb5\xe4\xde\x5f\x37\xcd\xfd\x26\x7f\xbc\xbb\xed\x3a\x31\xd4\x73\x8d\x86\x9c\xf0\x3e\xbd\x9e\x1e\xd3\xc7\xc7\x1f\x37\x5d\xc7\xc3\xe4\x2f\xb9\xb1\x2b\x5b\xd7\x76\xcb\xb3\x79\xd0\x30\x75\x52\x40\x26\x05\xa9\x58\xd8\xe4\xa1\x57\x65\x7b\xb0\x04\x75\xd7\xc5\x09\x87\xb2\x24\xde\xa3\x51\xe3\x6a\xa5\x88\xea\x22\x69\x65\x2d\x7d\xaa\xc9\x14\x18\xdf\x6e\xf2\x6b\x89\x43\xe5\x0f\x42\x8f\xb6\x6f\x92\x23\x45\x3f\x8c\xd9\xa2\x7f\x78\x9f\x47\xce\x99\x44\x81\xae\x77\x7c\x34\xc1\x68\x92\xbf\xef\x06\x05\xbb\x17\x65\x3a\x3d\x8c\xa7\xab\x78\x64\x4e\x62\xe0\x43\x9f\xe7\x96\xdb\xda\xcf\x18\xee\x48\x8f\x3f\x62\xb6\x0b\xfd\x1f\xbb\x50\xb0\x63\xdf\x96\xfb\xb5\x0e\x9b\xe0\x1b\xb6\xf3\xfe\x06\x08\xbf\xaf\xe9\xbe\xb2\x2d\xf5\xa9\x21\x61\x3e\xd6\x07\xf4\x37\xca\x77\xd2\x28\xff\x68\xa3\xf0\x37\xeb\x75\xb0\x0b\xfd\xef\x31\xe3\xbc\x28\xe3\x49\xbc\x5e\xe3\xe7\xcc\x37\x4d\x78\x1f\x52\xbb\x23\x27\x4d\x3c\x8d\x0e\xcb\xd0\xf5\xf7\x4d\xba\xb1\xb3\x13\x21\x99\x4e\x9d\x83\x98\x17\x3c\x95\x56\x0a\xcd\x70\x70\x06\x3f\x4d\x19\x12\xa2\xa8\x1c\x9c\x2e\x58\xbe\x21\xb2\x86\x4f\x7b\x5f\x7a\x87\x8d\xbd\xd5\xe6\xa9\xeb\xc4\xa8\xc3\x9d\x9d\xad\x1c\x8a\x27\x3e\x6c\x73\x8b\xbd\xbe\xf1\xf2\xea\x4f\x22\xec\x0a\xfb\xdf\x80\x3f\x03\x00\xf0\x1c\x14\x76\x67\x08\x00\x00"
+
+func repoReleaseStatsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoReleaseStatsTmpl,
+		"repo/release/stats.tmpl",
+	)
+}
+
+func repoReleaseStatsTmpl() (*asset, error) {
+	bytes, err := repoReleaseStatsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/release/stats.tmpl", size: 2151, mode: os.FileMode(0644), modTime: time.Unix(1792269961, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x56, 0x80, 0x97, 0x76, 0x40, 0x12, 0x2f, 0x58, 0xa9, 0xc4, 0xee, 0x67, 0xae, 0x6a, 0x64, 0x2e, 0xab, 0x84, 0x5c, 0x8, 0x97, 0xeb, 0x5f, 0xb4, 0xd8, 0xf8, 0x81, 0xc8, 0xca, 0xc1, 0x51, 0x78}}
+	return a, nil
+}
+
+var _repoSettingsAuto_lockTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x55\xcd\x6e\xdb\x3c\x10\x3c\xdb\x4f\x41\xf0\x1e\xf9\x0b\xbe\x14\xe8\xc1\x32\x10\xa4\x29\x5a\xc0\x45\x8b\xc4\x77\x81\x12\xd7\x16\x61\x8a\x64\xc9\x95\x63\x43\xd0\xbb\x17\x12\x45\x59\xb2\x85\xc0\x69\x4f\x12\x97\xbb\xb3\x33\xc3\xbf\xaa\x42\x28\x8c\x64\x08\x84\xa6\xcc\xc1\x22\x07\xc6\x29\x89\xea\x7a\xbe\xe4\xe2\x40\x32\xc9\x9c\x8b\xa9\x05\xa3\x9d\x40\x6d\x4f\xc4\x01\xa2\x50\x3b\x47\x58\x89\xfa\x4e\xea\x6c\x4f\x57\xf3\xd9\x10\xa7\x49\x6e\x71\xc0\x7a\xa4\xd9\x10\xaa\x14\x24\xd3\x0a\x99\x50\x60\x9b\xca\xcb\xc9\x9d\x15\xbc\x8d\x5f\x63\x86\xd6\x0b\xc5\x0e\x29\x0b\xe0\x63\x04\x7c\x03\x79\x00\xf2\x26\x38\x90\x4c\xcb\xb2\x50\x6d\x3b\x50\xe8\x41\x67\x57\x8a\x99\x04\x8b\x3d\xd6\x6c\x99\x3f\x0c\xd8\xa0\x36\x84\x21\xb2\x2c\x07\x4e\x3a\x4d\x1e\x67\x56\x55\x91\xb8\xff\xac\xa2\x8d\xf5\xf4\xa2\x40\x2f\x12\xce\x95\x90\x34\xfe\x24\xad\x3f\x01\x79\x91\x3f\xf8\xda\x0b\xc9\x7d\x03\x07\xbb\xe2\xcc\x74\xb6\x34\xab\x9b\x9b\x24\x1c\x5c\x46\xeb\x7a\xb9\x30\xa1\x7a\xab\x6d\x31\xe8\xd2\x0c\x29\x61\x19\x0a\xad\x62\x5a\x55\xd1\x0b\x18\xbd\x16\x6a\x5f\xd7\x67\x67\xcf\x8b\x4a\x0a\xc0\x5c\xf3\x98\xfe\xfa\xf9\xba\x09\x8c\x1a\xd1\x4f\xaf\x2f\x5f\x37\x7a\x0f\xea\xdb\xe6\xc7\xba\x93\x36\x96\x24\x94\x14\x0a\xc8\x56\x80\xe4\x7d\xe5\xd5\x26\xc8\x21\xdb\xa7\xfa\x78\x4e\x98\x2d\x85\x32\x25\x12\xc5\x0a\x88\x29\x28\x96\x4a\xe0\x94\xe0\xc9\x40\x4c\xfb\x74\x52\x55\x62\x4b\xa2\xef\xee\xd9\x27\xd4\x75\x3b\x05\xbc\xaa\x40\xf1\xba\x1e\xc0\x49\x96\x82\xfc\x80\x85\xa1\x65\xe3\xa2\xaf\xed\xb9\x2f\xb8\x38\xac\xe6\x53\x83\xd1\xde\xd3\x5e\xb4\x9b\x56\x6d\xe1\x77\x29\x2c\x70\x9f\xd4\x09\x79\xb6\x36\x79\xdc\x22\xd8\x2f\xec\xe4\xea\x1a\xac\xd5\xb6\x93\x42\x2f\xb5\x34\x6b\x18\x53\xd6\x64\x27\x9c\x9d\x1c\xfd\x80\xb8\x41\xd5\xb5\xbe\x60\xbd\xe0\x23\xf8\x6e\x29\x86\x11\xbf\x1a\xaa\x2c\xd2\xe6\x74\x17\x42\xc5\xf4\x9e\x92\x03\x93\x25\xb4\x9b\xea\xb1\x44\xbd\xd6\xd9\x3e\x1a\x68\xa2\x24\x28\x9f\x36\x74\x64\xd2\xa5\x37\xcf\x47\x28\x0c\xae\x1b\xba\x37\xb9\x03\x6d\x7e\xd2\x46\x3e\xe2\xcf\xa8\xee\x7d\x87\x46\xa9\x61\xbb\x8e\x62\x13\x7e\x8c\x74\x50\x52\xb0\xa3\x04\xb5\xc3\x3c\xa6\x9f\xfe\xa3\xc4\x48\x96\x41\xae\x25\x07\x1b\xd3\x3d\x80\xb9\xd3\x06\xd4\x1d\x17\x2e\x2b\x9d\x13\x5a\x0d\xd5\x9a\xe0\x55\x0e\xd2\xfc\xad\xc6\xa4\x29\x06\x3b\xba\x32\x6e\xdd\xe8\x97\x27\x7b\x60\x7f\xa6\x0b\x7f\x85\xdd\xce\x2a\x94\x4c\x1c\x3b\x84\x23\x32\x0b\xac\xb5\x3d\xe4\x75\x8e\xf7\x43\xab\xdf\x5c\x4c\xff\xa7\xab\xa1\xdd\x4f\x7e\xb6\xc1\x0c\x20\xab\xf9\x3f\x1b\xd8\xf5\x9c\xf4\xee\x66\xb7\xd2\x12\x51\xab\xd1\x93\x07\xa0\x88\x0f\xbf\xc7\xa6\x34\x9c\x21\x24\x61\xdc\xb6\xf7\x55\x53\x1c\x96\x8b\xe6\xc6\x5f\xcd\xc7\xf1\xfe\x2f\xfc\x74\xdf\xee\x73\xf5\x3a\x6e\xb5\xc6\xf0\x8e\xff\x19\x00\x55\x55\xa2\x18\x2d\x08\x00\x00"
+
+func repoSettingsAuto_lockTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsAuto_lockTmpl,
+		"repo/settings/auto_lock.tmpl",
+	)
+}
+
+func repoSettingsAuto_lockTmpl() (*asset, error) {
+	bytes, err := repoSettingsAuto_lockTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/auto_lock.tmpl", size: 2093, mode: os.FileMode(0644), modTime: time.Unix(1792259442, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x55, 0xac, 0x48, 0x9a, 0x12, 0x44, 0xc5, 0xb6, 0x2f, 0xe8, 0xfd, 0x5, 0x3d, 0x42, 0x26, 0x4e, 0xc2, 0xb2, 0x8b, 0xbb, 0x2c, 0xc9, 0xfb, 0x4a, 0xf6, 0xf2, 0x5d, 0xc0, 0x9c, 0xd2, 0x51, 0x2a}}
 	return a, nil
 }
 
-var _repoSettingsBranchesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4f\x6f\x9b\x30\x14\x3f\xd3\x4f\xf1\x64\xf5\x5a\xd0\xa4\x1e\x76\x20\x39\x74\x7f\xb4\x49\x9d\x54\x75\xbd\x47\x0e\x7e\x09\x56\x8d\xcd\xec\x47\xba\xca\xe2\xbb\x4f\x01\x4c\x0c\xa4\xeb\x26\xf5\xd0\x13\x06\xfb\xfd\xfe\x3c\xbd\x9f\x13\xef\x09\xab\x5a\x71\x42\x60\x5b\xee\x30\x2b\x91\x0b\x06\x69\xdb\x5e\xe4\x42\x1e\xa0\x50\xdc\xb9\x15\xb3\x58\x1b\x27\xc9\xd8\x67\x70\x48\x24\xf5\xde\xc1\xd6\x72\x5d\x94\xe8\xd8\xfa\x22\x89\x61\x8e\x67\x3b\x18\xb4\x3d\x50\x12\x23\x35\x12\x0a\xa3\x89\x4b\x8d\xf6\x58\x39\xdf\xdc\x5b\x29\xba\xef\x4b\xcc\xc0\x9c\x69\x7e\xd8\xf2\x00\x3e\x45\xa0\x27\x54\x07\x84\x27\x29\x10\x0a\xa3\x9a\x4a\x77\x74\xa8\xa9\x07\x4d\x16\x86\xb9\x42\x4b\x23\x56\x92\x97\xd7\x91\x1a\x32\x35\x70\x22\x5e\x94\x28\x60\xf0\xd4\xe3\x24\xde\xa7\xf2\xc3\x47\x9d\x3e\xd8\x5e\x5e\x1a\xe4\xa5\x02\x77\xbc\x51\xb4\xe9\x1b\xc4\x02\x70\x56\x5e\xf7\xa5\x33\xc7\x23\xbe\xc3\x7d\x85\x9a\x60\xa8\xbf\x1a\xea\x07\xbe\xbc\x5e\xff\x2b\xe5\x46\xa0\x2b\x58\xdb\xe6\x59\x1d\x8a\x77\xc6\x56\x11\xe7\xf1\x95\x01\x2f\x48\x1a\xbd\x62\xde\xa7\xb7\x52\x3f\xb6\x6d\x36\x93\x0e\x15\x52\x69\xc4\x8a\xd5\xc6\x85\x06\x76\xce\x3f\xfd\xbc\xff\xfa\x60\x1e\x51\x7f\x7b\xf8\x71\x3b\x18\x9c\x1a\xb3\xf8\xab\x91\x16\x05\x48\xad\xa4\x46\xd8\x49\x54\x02\xbc\x97\x3b\x48\xef\xc7\x69\x4a\xbf\xbb\x1b\x6e\xb1\x6d\x85\x74\x7c\xab\x50\x78\x8f\x5a\xb4\xed\xc8\x35\x6f\x96\x43\x85\x9d\x6a\x10\xd6\xd4\xc2\x3c\xe9\xd3\xd1\x24\x97\xba\x6e\x08\xe8\xb9\xc6\x15\x2b\xa5\x10\xa8\x19\x48\xb1\x62\xc1\x8e\xe6\x15\x9e\xde\x0e\x5c\x35\xd8\xb9\x8f\x04\x7d\xee\x3b\x70\xd3\x9d\x89\x85\x4c\xc7\x0c\x7f\x13\x5b\xff\xb5\x32\xcf\x84\x3c\xc4\xda\x42\x6d\x10\x0e\xb2\x30\x9a\xad\xf3\x4c\x9e\xe7\xa8\x50\x37\x11\x7d\xe2\xbd\xe5\x7a\x8f\x90\xde\x0c\xc1\x1b\xfb\x3e\xaf\x94\x84\x15\x03\xc1\x89\x5f\x9d\x3c\x1e\xbd\x74\x8f\x99\xb0\x64\x68\xf9\x49\xc3\x64\x7f\xf6\xb6\x6d\x88\x8c\x9e\xe4\x15\x51\x43\xff\xf9\x48\x70\xf9\xd2\x88\x36\xb5\xe0\x84\xdd\x54\xf6\xa7\x03\x68\xcc\x90\x67\xc7\xc9\x1c\x62\xd2\x7f\x7f\xab\x54\xd6\xd6\x10\x16\x84\x62\x33\x5e\x5c\xff\x9d\xcc\x11\xe3\x2a\xbe\xfc\x5e\x4d\xe7\x92\xfa\x4c\x42\xa7\xd4\x5d\x40\xd7\xef\x25\x57\xe7\x26\xff\x05\xaf\x45\x69\x8c\xc3\x0d\x3f\xdd\x7e\xef\x23\x08\x8d\x55\xc7\x18\x5c\x86\x9b\xce\xfb\x2f\xae\xe0\x35\xde\x99\x46\x0b\x78\x93\x74\x4c\x07\x39\x5a\x4f\x5b\xbd\x1c\x22\x50\x72\x72\xbd\x0e\xf6\xee\xfa\x83\x4b\x97\x0b\x8b\x51\xdf\x38\x94\x16\x77\xaf\x59\xcd\x0b\x23\x30\x18\xee\xd6\x79\xc6\xcf\xbb\x9a\x34\x20\xda\x39\x2d\xc7\x55\x58\x0c\xcf\xe1\xb1\xf8\xc5\xdd\x19\x43\xc3\x7f\x83\x3f\x01\x00\x00\xff\xff\x6c\x86\x4a\x0b\x7f\x08\x00\x00"
+var _repoSettingsBranchesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x59\x49\x6f\xdb\xb8\x17\x3f\x3b\x9f\x82\x10\x72\x48\xfe\x7f\x44\x4e\x81\x1e\x06\x85\xed\x62\xd2\x05\x13\x20\x9d\x06\x49\x8e\xc5\x08\xb4\xf8\x6c\xb1\xa1\x48\x0d\x49\x39\x31\x14\x7d\xf7\x81\x48\x49\xa6\x36\x6f\x49\x81\x5e\x62\x6d\x6f\xfb\xbd\x9d\xc9\x32\x0d\x71\xc2\xb0\x06\xe4\xcd\xb1\x82\x71\x04\x98\x78\xc8\xcf\xf3\x93\x09\xa1\x2b\x14\x32\xac\xd4\xd4\x93\x90\x08\x45\xb5\x90\x6b\xa4\x40\x6b\xca\x97\x0a\xcd\x25\xe6\x61\x04\xca\x9b\x9d\x8c\x5c\x36\xc5\xb7\x86\x0d\x48\xcb\x68\xe4\x72\x4a\x29\x0a\x05\xd7\x98\x72\x90\x05\x65\xfb\xe5\x52\x52\x62\x9e\x77\x79\x56\x92\xc7\x1c\xaf\xe6\xb8\x62\xde\xe4\xa0\x9f\x80\xad\x00\x3d\x51\x02\x28\x14\x2c\x8d\xb9\x11\x07\x5c\x5b\xa6\xa3\x8e\xc1\x98\x81\xd4\x35\xaf\xd1\x24\x7a\xef\x68\xa3\x45\x82\xb0\xd6\x38\x8c\x80\xa0\xd2\x26\xcb\x67\x94\x65\x3e\x7d\xf7\x07\xf7\x1f\xa4\x55\xcf\xaf\xd4\xf3\x09\x2c\x70\xca\x74\x60\x01\xf2\x2a\xc6\xe3\xe8\xbd\x25\x6d\x59\x5c\xf3\x57\xb0\x8c\x81\x6b\x54\xd2\x5f\x94\xf4\xa5\xbc\x49\x32\xdb\x57\x64\x40\x40\x85\x5e\x9e\x4f\xc6\x49\x45\xbc\x10\x32\x76\x64\x16\xb7\x1e\xc2\xa1\xa6\x82\x4f\xbd\x2c\xf3\x6f\x28\x7f\xcc\xf3\x71\x4b\x75\x14\x83\x8e\x04\x99\x7a\x89\x50\x15\x80\xc6\xf2\x4f\xf7\x77\x5f\x1f\xc4\x23\xf0\xbf\x1e\xbe\xdd\x94\x06\x36\x0d\x93\xf0\x6f\x4a\x25\x10\x44\x39\xa3\x1c\xd0\x82\x02\x23\x28\xcb\xe8\x02\xf9\x77\x75\x34\xf9\xd7\xea\x0a\x4b\xc8\x73\x42\x15\x9e\x33\x20\x59\x06\x9c\xe4\x79\x2d\xab\x0d\x96\x02\x06\x46\x6b\x44\xa4\x48\x88\x78\xe2\x9b\x4f\x47\x13\xca\x93\x54\x23\xbd\x4e\x60\xea\x45\x94\x10\xe0\x1e\xa2\x64\xea\x55\xe6\x70\x1c\xc3\xe6\x6e\x85\x59\x0a\xc6\x7a\x47\xa1\xcf\x16\x81\x2b\xf3\x8d\xab\x48\x33\xcc\xe0\x59\x7b\xb3\xad\x94\x93\x31\xa1\x2b\x57\xb7\x8a\xb6\x52\x1c\xd1\x50\x70\x6f\x36\x19\xd3\x7e\x19\x31\xf0\xd4\x11\x3f\xca\x32\x89\xf9\x12\x90\x7f\x55\x26\x5e\x8d\x7b\x9b\x92\x6a\x88\x3d\x44\xb0\xc6\x17\x1b\x1b\x0b\x5b\xcc\x4f\x4b\xb1\x51\x09\xf9\x46\x87\xc6\xfb\xd6\xdd\x3c\xd5\x5a\xf0\x46\xbe\x02\x70\x64\x1f\x17\x02\x4e\x87\x42\x34\x4d\x08\xd6\x60\xa2\xd2\x7e\x3d\x3b\xe9\x4a\x98\x8c\x8b\xc8\x9c\x9d\x38\xcf\xdf\x2a\x2b\x13\x29\x34\x84\x1a\x48\x50\x17\xae\x83\x33\xb3\xe6\x71\xe1\x16\xbf\x9d\xd9\xd9\x15\xdd\x93\xa1\x4d\xd1\x26\x41\x67\xbf\x4b\x5e\xf5\x45\xfe\x80\xad\x61\x24\x84\x82\x00\x6f\xaa\xdf\xef\x91\x08\xa9\x64\x45\x1a\x9c\x56\x95\x2e\xcb\xbe\xa8\x10\x27\x70\x2b\x52\x4e\xd0\x9b\x64\x47\x33\x90\x9d\xeb\x3d\x8b\x6f\x82\xb5\x06\xc9\x37\x55\x77\x09\xba\x37\x06\x5e\xeb\x7a\x5b\x26\x6d\x31\x2c\xfe\x7a\x28\x61\x38\x84\x48\x30\x02\xb2\x08\x31\x06\x45\x67\xfc\x9f\x87\xaa\x68\x3b\xa0\x00\xec\x9b\x04\x41\x69\x6e\x80\x09\xe9\xa9\x0a\xa3\x49\x52\x09\x89\x80\x25\x47\x71\x36\x49\x86\x5e\xd0\x3d\x5e\x80\x9b\x6b\x83\x25\xa7\x9d\x15\xdd\x7c\x47\x8c\x36\x3a\x61\x19\x89\xb7\xf6\xc3\x6e\x40\x76\xa2\x71\x13\x5a\xd6\x71\xd7\xea\xd6\x6a\xeb\x06\xf1\x04\xa3\x48\xc2\xa2\x11\xb0\xa5\x51\x1f\x8d\xdb\xb2\xcc\xff\x1b\xc7\x50\x78\x75\x12\x0a\x02\xb3\xfa\xc1\x64\x6c\xee\x27\x63\x3c\x73\xf8\xa9\x04\xbb\x3e\x9b\x63\x45\x43\xa4\x29\x5f\x23\x86\xe7\xc0\xb6\x16\xee\x21\x78\x8d\xd3\x0a\xc6\xae\x49\xc0\x14\xec\xb2\xa4\x95\x7a\x07\x18\xd2\x4a\xc6\x46\xf6\x35\xde\x39\x6f\x7e\x59\x1b\xd1\x78\xf9\xaa\x16\x62\xe8\x0f\x6b\x1f\x05\xc9\x60\x54\xef\x5b\x66\x8c\xdc\xb7\x9e\xec\x06\xaa\x4b\x5d\xd2\x1a\x05\x66\xf5\xab\x2a\x8b\xc1\xa7\xbf\x9e\x0c\xa6\xbc\x4d\xc3\x32\x81\x1f\xf0\x52\xf5\x1a\x9d\x52\x44\xe8\x8a\x12\x20\xcd\x0a\xd0\x29\x01\x0d\x0e\x5b\xf3\xbf\xe9\x30\x49\x97\x91\x46\x0b\x26\xb0\x06\xe2\xba\xed\xd4\xf5\xdb\x98\x00\x03\x0d\x03\xee\xb3\xfa\x9c\x0e\xf8\x70\x70\x42\xb6\xae\xa2\xc4\x9d\x88\xaf\x3f\x37\x66\xdf\x3e\xf7\x14\x21\x60\x4a\xc8\x1e\xc3\x5f\x27\x84\x8d\x15\x5d\x2f\xb5\x9d\xd3\x1c\x18\x44\xa8\x8b\x51\x01\x95\xbf\x17\x4c\x84\x8f\x76\x6e\x40\x75\xf1\xa8\xab\x69\x59\x3f\x06\x1a\x77\xb3\x92\xb8\xef\xdc\x37\x6f\x5c\x3b\x42\x11\xc7\x54\x07\x8c\x72\x7d\x78\xe1\xb0\xc4\x17\x86\x78\x9f\xaa\xe1\x08\x3b\x7e\x1f\x74\x35\x7e\x45\xc9\xd0\x4f\xc2\x96\x09\xd5\x3f\x80\xb6\x4a\xc8\x68\x62\xba\x52\xa1\xd5\xd4\x8b\xf1\x73\xa0\xd2\xf9\x4f\x08\x75\xc0\x80\x2f\x75\xe4\xed\x67\xb5\xdf\x43\x59\x60\x60\x78\x77\x96\xc6\x62\x4d\xec\x21\x28\x93\xa3\xef\x8d\x4d\x22\x9e\xc6\xf3\xe2\xa0\x23\xa6\x7c\xea\x5d\xba\x29\xf4\xc9\xa8\x72\x43\xb9\xbe\x4b\x19\x28\xff\x1b\x7e\xbe\xb7\x2c\x6e\x0c\x87\xc6\x48\xd6\x9c\x2a\x0f\x80\x66\x2e\xc8\xba\x30\x17\x8e\x00\xa7\x43\xbb\x1b\x9e\x0e\x89\x03\x50\xf7\xdd\x11\x10\x5d\x09\xb2\xbe\xa1\x1c\x76\x60\xd4\xbc\x19\xc6\xcb\x85\xab\x72\x60\xd5\x94\xf6\x44\xaa\x4d\xd6\x05\xc9\xc1\xa8\xfd\x71\x09\x4f\xe7\xf1\x30\x06\x65\x8c\xd4\x75\xac\xd5\x3b\xff\x39\x5b\x00\xd6\x2f\x0b\xfa\xfc\x42\x44\xa8\x5e\x24\x2c\x70\xa8\x85\x7c\xd1\xa0\xf4\x4b\x18\x09\x09\xe7\x67\x3f\xce\xfc\xff\xff\x38\x3f\xff\xf8\x01\x79\x47\xcc\xd3\x5b\x8c\x0f\x0a\x5a\x90\xde\xe0\x34\xbd\xd5\x17\xad\x63\xc0\x08\xc2\xc7\xb9\x78\xee\x1e\xe0\x58\xcc\x80\x2f\x84\x0c\x21\x10\x3c\x48\x52\x55\x47\x53\x4d\x56\x6e\x3e\x6d\xfc\xbe\x58\xb2\xef\xfc\x36\x55\x51\x9e\x9b\xcf\xeb\x25\xa8\x9d\x47\x7b\xa2\xd0\x56\xa5\x2f\x4f\x8e\x82\xb7\xc5\xb8\x5b\xa8\xb7\x86\xfd\xd1\x13\x93\x3d\x8b\x09\xaa\xfb\x6e\x23\x1e\x3a\x87\x29\x77\x16\x1e\x81\xa4\x1a\x48\x81\xf8\x3d\xe8\x7a\xe0\xd9\xbb\x47\x6e\x69\x92\x32\x65\x46\xb3\x7a\xb6\x76\x7a\xe4\xce\x26\x49\x2b\xcd\x2e\x0a\x36\x17\x86\x4d\x0d\xd7\xb6\x6e\x59\x4b\x2d\xc7\x6b\xff\xfb\x13\x07\xe9\x7f\xa6\x2a\x61\x78\x5d\x6d\x24\xc9\xec\xa8\xd1\x70\x10\xaf\x5d\x03\xa2\xd2\x52\xf0\xa5\xbb\x13\x95\x4f\xde\x68\xb5\xab\xad\xf6\x6b\xe0\x7a\xb6\xba\xd2\xeb\x5f\x9e\x21\x4e\x8a\x01\xa1\x31\x51\xb6\xde\xf9\xd7\xea\xcf\x24\x91\x62\x05\x24\xcf\xdb\xba\xd9\xf0\x3c\x58\x37\x30\xcc\xfb\x55\x2b\x57\x4e\xd4\x56\xe2\x0e\x7e\x9a\x79\xb3\xab\x44\x3d\xb6\x1e\xae\x02\x15\x3c\x90\x25\xe3\x2d\xca\x74\x65\xae\x81\x31\xf1\xf4\x1a\xb1\x09\x70\x42\xf9\x72\x23\xb5\x7d\x2e\xd5\x39\xa8\x6a\x44\x56\x31\xd9\xad\xa8\x5e\x17\x33\x1c\x6e\xce\xf5\x74\x3f\x55\x9c\x43\xd3\x0f\x9b\x69\xdb\x1e\x78\x94\xbd\x4a\x39\x4b\x3b\x9d\x75\x62\xe4\xce\x6e\x7c\xb7\x29\x63\xc5\x25\x28\x9d\xe7\x85\x8e\x7b\xec\x0d\x05\x02\x2a\x8d\x63\x2c\xd7\x7e\xb9\x38\x06\x49\xca\x58\x20\x2d\xa3\xfa\x94\xb1\x03\xc2\xc8\x6d\x12\xdf\x40\x29\xbc\x84\xcd\x8a\xd0\x91\x2e\xe4\xb2\xcf\xf8\xb2\x64\xc7\x96\xde\x99\x04\x1c\x28\x06\x44\x54\x80\x6c\xd3\xee\xab\x90\x73\xb3\x8b\x7d\xa5\x0c\x1c\x30\xf7\x55\x6f\x51\xd1\x07\x0b\xca\x6a\xf5\x5a\xae\x1a\x12\xb2\x55\xc1\xee\x69\x28\x5d\x20\x21\xd1\x19\x17\xda\x49\xb8\xf3\xa1\xe4\x73\x03\x6d\xc7\xca\x51\xef\xb9\x75\xd0\xab\x2d\x6b\xee\x8e\x3d\x77\xdb\xa2\x5b\xe1\x16\xec\xd8\x78\x07\x8f\x5c\x9b\x5f\x35\x87\x16\x09\x58\x89\xf6\x79\xc7\xc1\x25\xc6\xf0\x28\x46\xbf\x18\x3f\xdb\x59\x7a\xea\xbd\xbb\xbc\xbc\xec\x39\x36\x19\x98\x04\xec\x62\x6e\x7a\xc1\x1e\xeb\xf9\x46\x8b\x32\xa1\x82\x5a\x9b\xfe\x25\xbd\x1b\x19\xdd\xbd\x7d\xfb\xc9\xf9\xf0\x02\xee\x5c\x3b\x1f\xd5\x4f\xab\x8b\xf2\xb7\xfc\xe9\xfc\x5b\x77\x21\x84\x2e\xff\x01\xfd\xdf\x00\x71\x71\x0f\x2b\xe4\x1e\x00\x00"
 
 func repoSettingsBranchesTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2052,12 +2776,12 @@ func repoSettingsBranchesTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/branches.tmpl", size: 2175, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3b, 0x24, 0x5c, 0x2, 0x75, 0x3d, 0x4b, 0xbb, 0x3e, 0xef, 0x58, 0x77, 0x25, 0x5f, 0xc, 0x18, 0x8c, 0xb5, 0xab, 0x5c, 0xc8, 0x3c, 0x51, 0x11, 0x90, 0x26, 0x46, 0x90, 0xa5, 0xab, 0xb5, 0x17}}
+	info := bindataFileInfo{name: "repo/settings/branches.tmpl", size: 7908, mode: os.FileMode(0644), modTime: time.Unix(1792275797, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd4, 0x6a, 0x32, 0x5d, 0x61, 0x59, 0xc2, 0x5d, 0xb2, 0x9f, 0xfb, 0x9d, 0x42, 0x1f, 0xf0, 0xf1, 0x2b, 0x80, 0x8f, 0xc4, 0xfe, 0xc6, 0xf7, 0x5a, 0x96, 0xea, 0x84, 0xea, 0xbd, 0xd7, 0xa4, 0x83}}
 	return a, nil
 }
 
-var _repoSettingsCollaborationTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x8f\xdb\x36\x10\x3d\x7b\x7f\x05\x21\xe4\x6a\x09\xdb\xe6\x90\x83\xbd\x40\x90\xa0\x68\xd0\x4d\x0f\x9b\xed\xd9\x18\x8b\x23\x8b\x08\x3f\x54\x72\x64\x67\x21\xf8\xbf\x17\x14\x25\x9b\x94\xac\xc5\x06\x68\x4e\xb2\xc9\x99\x37\xef\x0d\x67\x86\xec\x3a\x42\xd5\x48\x20\x64\xd9\x1e\x1c\x16\x35\x02\xcf\x58\x7e\x3e\xdf\x6d\xb8\x38\xb2\x52\x82\x73\xdb\xcc\x62\x63\x9c\x20\x63\x5f\x98\x43\x22\xa1\x0f\x8e\x95\x46\x4a\xd8\x1b\x0b\x24\x8c\xce\x1e\xee\x56\x31\x96\x77\xe8\xb1\xd0\x06\xb4\x55\x0c\xd7\x0a\x56\x1a\x4d\x20\x34\x5a\xef\x39\xdd\x3c\x58\xc1\xfb\xf5\x39\xe6\x18\xbe\xd0\x70\xdc\xc3\x08\x9e\x22\xd0\x09\xe5\x11\xd9\x49\x70\xf4\x2c\x5b\xa5\xfb\x70\xa8\x29\x80\xae\x66\xaa\x41\xa2\xa5\x0b\xd6\x6a\x53\xbf\x8f\xd8\x90\x69\x18\x10\x41\x59\x23\x67\x83\xa6\x80\xb3\xea\xba\x5c\xdc\x7f\xd0\xf9\xb3\x0d\xf4\xf2\x91\x5e\x9e\x66\x67\xc4\x2d\xea\xf7\xc1\x73\x22\xf8\x02\xef\xf0\xa0\x50\x53\x94\x5c\x63\x99\x14\x8e\xae\x11\x2d\xe8\x03\xb2\xfc\x53\x64\xe1\x06\xfc\x14\x57\x10\x2a\x96\x64\xf3\x46\xe4\x4a\xa4\x99\xba\x1a\xae\x36\xc0\x6a\x8b\xd5\x36\xeb\xba\x8f\x4d\xf3\xad\xdd\xff\xf3\xf4\x78\x3e\x17\x5d\x97\xff\x0d\x0a\xcf\xe7\xc8\x74\xb5\x11\xea\x10\xeb\x39\x02\x81\x65\x42\xc1\x01\x33\xe6\x6c\xe9\x41\xf2\x27\x94\x1f\xfb\x8d\x47\xa1\xbf\xa7\xfe\x5d\x97\x7f\x16\xae\x91\xf0\x12\xb0\xaf\x24\x0a\xb8\x52\x2f\xb8\x38\x2e\x09\x41\x71\xa8\x69\x49\x89\x6b\x40\x8f\xb6\xa6\x24\x51\x1a\xcd\x86\xef\xda\xd5\x02\x25\xcf\x1e\x36\x85\xb7\x8a\x9c\x52\x7c\xa1\xa5\xd0\xc8\xb8\x35\x0d\x37\xa7\x18\x9d\xb1\xa4\xf8\xf0\x07\x65\x0f\x5d\xf7\xee\x52\x19\xd1\x51\x09\xa3\xf3\xaf\x86\xe3\x97\xfb\x0f\xfa\x2f\x7c\x39\x9f\x53\x49\x1e\x4a\x8c\x40\x63\x24\xe6\x59\x7a\x7a\x62\x29\x24\x94\x25\x3a\xb7\x56\x86\x23\x53\xa8\xdb\x8c\x71\x20\x58\xb7\x56\xfa\xb4\xbf\xcb\x43\xba\x8b\x60\xb6\xf3\x66\xa3\x85\xe0\xfd\xc1\x7c\xf9\x9c\x9c\x06\x63\x29\xbe\x2f\xa4\xc1\xc3\xab\x0b\xa0\x6f\xa9\xfb\x1c\xb8\x12\xbe\xfa\x07\xef\x23\xc8\x16\xb7\xd9\xef\x69\x7e\xde\x84\x30\x4b\xd4\xff\x45\xf1\x64\x05\xe1\x8c\xe2\x6f\x3f\x41\x71\x44\xf8\x65\x14\xad\x9f\xc9\x53\x86\xf7\x3f\xc1\x70\x00\x98\x17\x5b\xba\x30\xe9\xaf\xd7\xba\x8d\x4e\x66\xa9\xd7\xf6\x2d\x91\xd1\x91\xad\x45\xce\x48\xe8\x17\x36\xec\x8c\x9d\x84\x12\x09\xd7\x61\x71\xa1\x64\x83\xcd\xb0\x79\xbb\x58\x57\xaf\x64\x21\xb8\xef\xe2\x61\x9a\x25\xb3\x25\x04\xbf\x2d\x39\xfe\xd3\x75\xa8\xf9\x65\x88\x5f\xd6\x27\x49\xd9\x1b\x22\xa3\x66\xc3\x7c\x24\xbb\xa9\x8c\x55\xf1\xe8\x35\x56\x65\xcc\xab\xf2\xac\xd7\x81\xe5\x3a\xac\x42\xe9\x0f\xae\xd7\x3b\x0c\x4b\xa6\x90\x6a\xc3\xb7\x59\x63\xae\xb7\x81\x9f\x9b\x9f\xbe\x3d\xfd\xf1\x6c\xbe\xa3\xfe\xf3\xf9\xeb\xe3\xed\x8b\x20\x24\xbc\xf2\x73\xce\x5f\x08\x12\x2b\x9a\x5c\x08\x9e\x85\x43\xb0\x65\xbd\x6e\x1d\xda\xf5\xde\xfc\xc8\x5e\x19\x85\x4d\x4b\xe9\xf4\xf7\x2b\xa3\x45\x63\x8d\x6a\x28\x63\x1a\x14\x6e\xb3\x24\xf9\xac\x91\x50\x62\x6d\x24\x47\xdb\x8b\x5b\x38\xb8\x40\x65\xe7\xa9\xec\x22\x97\xbe\x09\xa0\x25\x53\x1a\xd5\xf8\xa3\xdd\x66\xa6\xaa\xc2\x52\x65\xca\xd6\x31\x8b\xff\xb6\xc2\x22\x5f\x2a\xea\xa9\x94\xf1\xbe\xb5\xe8\x5a\x49\x8e\xd5\x82\xa3\x9f\xb5\x8b\x8d\x90\xfe\x99\xd5\xfa\xc1\x22\xea\xa1\xd0\x7d\x83\x2e\x09\x04\xce\xa7\x65\x39\xa9\xc6\x4d\xe1\x2b\xe1\x61\x52\x72\x97\x5f\xe3\x8f\xe1\x3b\x7c\xee\xa6\xf2\x14\x48\xc9\xf6\xe0\x44\x39\xf4\x1b\x53\x86\x83\xf4\x87\x37\x3d\x54\x7f\x27\x46\xaf\x9b\xeb\x45\x44\x16\x5c\x9d\xde\x42\x6f\x79\xf7\x18\xbb\xeb\x43\x8e\xef\x9f\x0b\xe3\x28\x6e\xfc\x2c\xdb\x34\xaf\xe4\xeb\x26\xec\x8e\xa3\x2b\xfb\xcc\x35\xd7\x4c\xcc\x1f\x77\xc3\x14\xe8\x85\xef\x42\x63\xb9\xe1\x89\x1b\x5c\x66\x1e\x95\x31\x34\x3e\x5c\xff\x0b\x00\x00\xff\xff\xda\xd9\x89\x4a\x22\x0b\x00\x00"
+var _repoSettingsCollaborationTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x59\x5f\x8f\xdb\xb8\x11\x7f\xf6\x7e\x0a\x82\xdd\x87\x04\xa8\x65\xa4\xcd\x43\x50\xd8\x2e\x16\x49\x8b\x6e\xbb\xe9\x05\xbb\x9b\x97\xc3\x01\x02\x2d\x8e\x2d\x62\x29\x52\x21\x29\x7b\x0d\x9d\xbe\xfb\x81\xfa\x63\x91\x94\xe5\xb5\xf7\xee\x80\xe4\xc9\x96\x44\xce\xfc\xe6\x1f\xe7\x37\x52\x59\x1a\xc8\x72\x4e\x0c\x20\xbc\x22\x1a\x66\x29\x10\x8a\x51\x54\x55\x57\x73\xca\xb6\x28\xe1\x44\xeb\x05\x56\x90\x4b\xcd\x8c\x54\x7b\xa4\xc1\x18\x26\x36\x1a\x25\x92\x73\xb2\x92\x8a\x18\x26\x05\x5e\x5e\x4d\x5c\x59\x76\x43\x2d\x0b\x54\x23\x6d\xe2\x8a\x2b\x18\x4a\xa4\x30\x84\x09\x50\x76\x67\xf8\x70\xa3\x18\xad\xef\x0f\x65\x76\xea\x67\x82\x6c\x57\xa4\x13\xee\x4b\x30\x3b\xe0\x5b\x40\x3b\x46\xc1\xa2\x2c\x32\x51\xab\x03\x61\x1a\xa1\x93\x81\xd5\x84\x83\x32\x07\x59\x93\x79\xfa\xde\x41\x63\x64\x8e\x88\x31\x24\x49\x81\xa2\xd6\xa6\x46\xce\xa4\x2c\x23\xf6\xee\x83\x88\x1e\x55\x03\x2f\xea\xe0\x45\xbe\x77\x5a\xb9\x93\xb2\x64\x6b\x14\x7d\x3c\x3c\x93\x4a\x77\x8f\x42\x1f\x28\xb6\x49\x3b\xbc\xf6\xa9\xce\x89\x38\x18\x08\xcf\x06\x6d\x14\xec\xf1\xf2\x4c\x04\x31\x13\x24\x31\x6c\x0b\xf1\x5a\x2a\x5c\x55\xf3\x99\x95\xd7\x4b\xf7\x75\x1b\x26\xf6\x68\x45\x34\x4b\xd0\xaa\x30\x46\x0a\xdd\x03\x99\x94\xa5\x22\x62\x03\x28\xba\x6d\x45\x7e\x22\x7b\xfd\x53\x6e\xb5\xf4\xc6\x58\x99\xc4\x91\x58\x1b\x0e\xdf\x50\x84\xae\xbd\x7d\x55\xd5\xfc\x2f\x4b\x10\xb4\xaa\x5a\x75\x18\xa5\x0a\xd6\x0b\x5c\x96\xd7\xd1\x1d\x13\x4f\x55\xf5\xcf\x03\x7e\x4a\xf6\x7a\x51\x96\x51\x55\x59\xe3\xaf\x2f\xb4\xde\xee\xae\xe3\x3c\x9f\x11\xd7\xa4\x5a\xfb\xc1\x1b\x33\xca\xb6\xcb\xab\xe1\x85\xbb\x6e\x3e\x4b\xdf\x2f\xaf\xfa\xa0\x76\x56\x1d\x0d\x6e\xe0\xdf\x43\x36\xed\x88\x12\x4c\x6c\x50\x06\x5a\x93\x0d\x1c\xbc\x7c\x71\x54\x29\xe8\x04\xa3\x37\x1c\xc4\x08\x92\xb7\x28\xf0\xfb\x55\x68\x9d\x67\xdc\x08\x5e\x0d\x9b\x0c\x84\x71\x6a\x5f\x2a\xc4\x99\x36\x7d\x41\xb4\xc9\xf1\x72\x8e\x33\x03\x19\xf2\x8a\xfd\x88\xe6\x35\xf3\x0b\xb9\x5f\x68\xf3\xab\xcb\x92\x9b\x3c\x7f\x28\x56\x5f\xef\xef\xaa\x6a\x56\x96\xd1\xff\x49\x06\x55\xe5\x2c\x9d\xcc\x59\xb6\x71\xed\xd9\x12\x43\x14\x62\x99\xf5\x39\xd2\x2a\xb1\x42\xa2\x7b\xe0\x37\xf5\x83\x26\xe5\xdc\xfd\x65\x19\x7d\x62\x3a\xe7\x64\xdf\xc8\xee\x41\xf8\x69\xb4\x63\x26\x45\x4c\x50\x78\x76\xf2\xdc\x73\x05\x8a\x6e\x3f\x79\x55\xe2\xd6\x75\x57\x7a\xb2\x71\x22\x27\x2b\xe0\xaf\xc8\xf2\x61\x7d\x07\xe7\x63\x7f\x7c\xf4\x0b\xda\x34\xbe\x23\xda\xdc\xd4\x52\xa2\x5b\xfd\x33\x28\xe9\x82\x9d\x4c\xce\xc6\x22\x60\x0b\x2a\x3e\xe0\xf1\xf4\x00\xd7\xf0\x4a\xb1\x9c\x68\xd3\x49\x45\x6f\x1e\x59\x06\x0f\x4c\x24\xe0\xe2\x46\xd7\xd1\x1d\x11\x9b\xb7\xe8\x57\xf4\x40\xd6\x10\xea\x76\x0b\x3d\x28\xf5\xe1\xf3\x36\xa2\x4e\x36\x33\x29\xa2\x2f\xc4\xa4\xf7\xa0\x8d\x62\x89\xbd\xae\xaa\xcb\xdc\x7c\xa6\xa9\x39\x31\xa9\x8e\x39\xcb\x98\x01\x8a\xd1\x9b\xff\x4a\x26\x50\xf4\x45\xc1\x9a\x3d\x83\x46\xf8\xaf\x08\xbf\xad\xaa\x41\x00\x6f\x38\x97\xbb\x5b\xad\x0b\xd0\x55\x75\xa1\x32\x6b\x6c\xcc\xea\xbd\xb8\xaa\x42\x67\xbc\xe0\x2c\xff\x69\x50\xc7\x60\xbb\xd9\x58\x21\xbb\x25\x20\x13\xc3\x12\x29\x50\xfb\x3b\xd5\x29\x03\x4e\xf1\x32\x4c\xe8\x40\x3e\x13\x9c\x09\x40\x54\xc9\x9c\xca\x9d\x2b\x1d\xa1\x30\x26\x7e\x45\x05\xb1\xfd\x2c\x29\xdc\xbe\xfb\x20\xfe\x07\xfb\xaa\xf2\x4d\xb2\xa2\x58\x27\xa8\xd3\x84\x2c\x4a\x0b\x8f\x8d\xa9\x24\x49\x02\x5a\x4f\x33\x49\x01\x65\x20\x0a\x8c\x28\x31\x64\x5a\x28\xee\x36\xb8\x59\xb3\x2c\xb6\xcb\xba\x15\x8c\xda\x15\xf5\x81\xe1\x19\x84\x50\x78\x8e\xb6\x3b\xac\x75\x0b\xec\x5a\x77\x2a\xe8\x11\xa1\x19\xb3\xdc\xa4\xdd\xbd\x25\xbc\x80\x05\xfe\x3b\x5e\x5e\x2c\x61\xe0\xa8\x3f\x0a\xe2\x4e\x31\x03\x03\x88\x7f\xc3\xcb\x8b\x25\xfc\x69\x10\x95\x65\xcc\x21\xc2\x77\x78\x79\xa9\x80\x61\xb2\xf9\x37\xfc\xcb\x93\xd5\x66\x52\x05\xa3\x6d\xf3\x18\xd5\xab\x59\x17\xd2\xa9\xdc\x4d\x73\x22\x80\xb7\xc6\xd4\xff\x17\xf8\x2f\x1e\xde\x69\x7d\x56\x4c\x0f\x89\x79\xd9\x19\x33\x34\x74\xde\x6a\xef\x31\x29\xa0\x1e\xae\xae\xb8\x81\x83\x81\x69\x47\x11\x8f\x56\x51\xb3\xa6\x7d\x78\xbc\x7e\x4e\x9d\xc0\xcd\xf6\xd8\xa5\x37\xd8\xeb\xf6\x8d\xf2\x33\xa2\xa0\xd9\xb3\x01\x10\xde\x10\x92\x32\x5b\xdb\x16\xd5\x49\x87\xf6\xea\xd6\x52\x65\x2e\x19\x92\x2a\xc3\x88\xd4\x4d\xc7\xb3\xba\xf1\x2c\xca\xc0\xa4\x92\x2e\x70\x2e\xb5\x09\x0d\xfe\xf8\x70\xff\xef\x47\xf9\x04\xe2\x3f\x8f\x9f\xef\xbc\x43\x9d\x89\xbc\x30\xc8\xec\x73\x58\xe0\x94\x51\x0a\x02\x23\x41\x32\xb0\x2a\x29\x46\x6d\x42\x1f\xf7\xa3\xea\xbb\x20\xfa\xc7\xe2\x95\x7d\x72\xdd\x9c\xf0\xfd\xc3\xc9\xbc\x66\x3e\x17\x66\x56\x9c\xb7\xad\xb1\x4e\xb1\x46\x82\x2b\xd3\x96\x35\x51\x40\x5a\xe3\x5a\x9f\x29\xb9\xd3\xf6\xcc\x43\x39\x27\x09\xa4\x92\x53\x50\x0b\x4c\x65\xa2\x67\x36\xb5\xd9\x1a\xb9\x46\xda\xa6\x58\xf7\x61\xf7\xa6\xd3\x93\x7f\x11\x7d\xdf\x9c\xcf\x3a\x8d\x1e\x8c\xbc\x33\x3b\x05\x9e\x5f\x5a\x3d\x0d\xcf\xb7\xb2\xf3\xe5\x68\x5f\xf6\x59\x76\x53\x3b\x47\x7c\x1c\xcc\xe3\x29\x24\x4f\x2b\xf9\xec\xad\xe9\x92\xa3\xf1\x18\xb1\xcc\xa2\xa3\x07\x6d\xc2\x1c\xb6\xa1\x70\xb4\xb5\x8e\xf1\xb8\x48\xbd\x14\x68\xeb\x1d\x5f\xcd\xab\xe2\xed\xe1\x39\x1e\xf3\xd0\x31\xe1\xf5\xe0\xe8\xd9\x28\x5b\xb4\xce\xe1\x73\x32\x42\x45\x4e\x89\x81\xb8\xbb\xae\x41\x04\x27\xc4\x64\x3e\xb3\x65\xbb\xbc\x70\xb6\x74\xa6\x32\xeb\xd6\x2f\x20\x28\x13\x9b\x5b\xb1\x65\x06\xfa\xe1\x2d\x7d\x7f\x6c\x48\xf3\x5f\x51\x9c\x98\x25\xf3\x46\x6a\xcc\x1a\xb1\xb8\x1f\x0a\xbb\xd1\xf6\xf5\x83\x60\x3f\x09\x1e\xc7\x7e\xce\x2c\x78\xc1\x30\x38\xe9\x99\x59\xc8\x20\x33\xc2\x78\x40\xd0\xac\x4f\xfe\x65\xef\x9f\x41\xdd\x4f\x84\xbf\xf1\x5b\xac\x41\x98\x98\x18\x7f\x1e\xf9\x5a\xa7\x06\x1d\x0c\x23\x27\x9b\x7a\x68\xb0\x66\xcf\xa3\xf6\xbe\x86\x34\x9f\xcf\x9a\x5f\xa6\xcd\xa7\x88\xf2\xd9\x4c\xf9\x75\x54\xb9\xcd\xd7\x23\x94\x79\xa4\xe3\xff\x00\x94\xf9\x47\xe0\xcc\xdf\x3f\x69\x1e\xb2\xe6\xc9\x45\x05\x77\xf2\x84\x09\xd8\x58\xd7\x59\xc7\x48\x59\x97\xa5\x0a\x34\x08\x3a\xce\xce\x4e\xd3\xb3\x53\xfc\xec\x34\x3d\x3b\xd2\xdd\xce\xed\x6b\x0d\xe4\xb6\x2f\x1c\xed\x6a\x61\x5b\xfb\x3d\xee\xd9\xca\x27\xf8\x2e\xdc\x13\xcc\x1d\x2f\xb8\xc8\xc2\xbe\xc8\x45\x27\x06\x38\xef\x55\xca\x79\xef\x64\x57\xd2\x18\x99\x0d\x3a\x72\x67\xe2\xf1\xd9\xc1\x9e\x8f\xd6\x8e\x69\x53\x4c\xd3\x30\x3a\x6d\x70\x46\xa2\x51\x96\x23\x81\x18\x23\x9c\xb6\xa5\x73\x58\x9b\xe0\xf5\xae\x45\xa1\x81\xa8\x24\x9d\x16\x1a\xd4\xd4\xa7\x9d\x83\x1e\x95\x17\xc6\xef\xf4\xf6\x4e\xb7\x22\x57\x32\xcb\x4d\x17\x72\x6f\x70\xf3\x29\xfd\x38\x0d\x6a\xa0\xc4\x16\x4a\xec\x6c\xa9\x8f\x27\x52\x18\x99\xc8\x2c\xe7\x60\x60\x81\xe5\x7a\xdd\xdc\x5a\xcb\xa4\xd0\x48\xc1\xb7\x82\x29\xa0\x67\xb7\xf3\x96\x34\x29\xd0\x05\x37\xba\x99\x07\x97\x27\xd2\xc2\xbf\x18\x21\xab\x7d\xb2\x8e\x19\x48\x28\x0d\x47\xda\x20\x5d\xdd\x54\xed\x95\x1e\xfe\x75\x7f\xda\xdf\xf6\xe7\x2a\x34\x2f\x23\x9c\xb7\x9f\x8d\x9a\x41\x1a\x65\x92\x12\x6e\x83\x17\x06\xd5\xb2\x15\x87\xa7\xf6\x6c\xc1\x28\xa2\x53\x9f\x2a\x9c\xf3\x31\x44\xaa\xb8\x56\xd9\x7d\x6c\x3b\x20\x76\xf4\xba\xdf\x00\xe7\xf9\xf2\x42\xb1\xfe\xe8\xd5\xc9\x1f\x7c\x49\x6c\xdf\x20\xd4\x86\xc7\x4d\x61\xe9\xf6\x7b\x6a\xb3\x65\xb0\x63\x2d\xa5\xe9\xbe\x92\xfe\x36\x00\x06\x5e\x22\x8e\x8f\x1d\x00\x00"
 
 func repoSettingsCollaborationTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2072,8 +2796,8 @@ func repoSettingsCollaborationTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/collaboration.tmpl", size: 2850, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0x94, 0xf1, 0x14, 0x69, 0x35, 0xd3, 0x93, 0xd0, 0xab, 0xf1, 0xf7, 0xb4, 0xa8, 0x81, 0xf6, 0x65, 0x9d, 0xb9, 0x1a, 0xdc, 0x35, 0x42, 0xb7, 0x14, 0x8a, 0xaf, 0x23, 0x86, 0xb5, 0x3d, 0xbf}}
+	info := bindataFileInfo{name: "repo/settings/collaboration.tmpl", size: 7567, mode: os.FileMode(0644), modTime: time.Unix(1792288754, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x63, 0xfd, 0x14, 0x57, 0xa0, 0x13, 0x3f, 0x56, 0x42, 0x4, 0x36, 0x42, 0x35, 0xa9, 0x7d, 0xc5, 0x3d, 0x8d, 0x14, 0x56, 0xa8, 0xf, 0x77, 0x81, 0xf, 0x3d, 0x37, 0x5d, 0x27, 0xb7, 0x1a, 0xc5}}
 	return a, nil
 }
 
@@ -2117,7 +2841,7 @@ func repoSettingsGithook_editTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoSettingsGithooksTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xcf\x8e\x9c\x30\x0c\xc6\xcf\xcc\x53\x58\xa8\xd7\x01\xb5\xda\x43\x0f\x2c\x52\x6f\x5b\x69\xdb\x43\xbb\xf7\xca\x03\x06\xac\x0d\x09\x4a\x3c\x6c\x57\x69\xde\xbd\xe2\x5f\x66\x86\x91\xaa\x9e\x62\xb0\xfd\xfb\x3e\x9b\xe0\xbd\x50\x3f\x28\x14\x82\xf4\x84\x8e\xf2\x8e\xb0\x4e\x21\x0b\xe1\x50\xd4\x3c\x42\xa5\xd0\xb9\xc7\xd4\xd2\x60\x1c\x8b\xb1\xef\xe0\x48\x84\x75\xeb\xa0\x65\xe9\x8c\x79\x75\x69\x79\x48\xae\x31\x53\xed\x8c\x21\xbb\x80\x92\x6b\xd2\x99\xa1\x32\x5a\x90\x35\xd9\xa9\x73\x9f\x6c\x2d\xd7\xf3\xfb\x7b\xe6\xa6\x9c\x6b\x1c\x4f\xb8\xc1\x6f\x09\xf2\x46\x6a\x24\x78\xe3\x9a\xa0\x32\xea\xdc\xeb\x59\x8e\xb4\x2c\xd0\xe4\x6e\x60\x54\x64\x25\xb2\x92\xa2\x7b\xb8\x72\x23\x66\x00\x14\xc1\xaa\xa3\x1a\xd6\x99\x16\x4e\xe2\x7d\xc6\x1f\x3f\xeb\xec\xc5\x2e\xf6\xb2\xcd\x5e\x16\x17\xb3\x21\xf3\xee\x61\x69\xda\xcd\x1a\xc9\x82\x27\x45\xe0\xa8\xed\x2f\x46\xf7\xc5\x13\x12\x14\xbb\x98\xbf\x29\x60\xa1\x3e\x26\xfe\xc3\xdb\xaf\x9a\x5c\x95\xc2\x1f\xf8\x29\xf6\xd3\xd3\xcb\xb7\xe7\xd5\xeb\xe4\xb6\xe6\x71\x23\x79\x6f\x51\xb7\x04\xd9\xd3\xd4\x14\x6b\xfe\x21\x9d\x14\x6e\x40\x1d\x3f\x07\xfd\x16\xf0\x9e\x1b\xc8\xbe\xba\x2f\x95\xf0\x48\x21\xb4\x96\x48\x7b\x4f\xca\x2d\x0f\xef\xde\x93\xae\x43\x48\xcb\x82\xb7\x46\x53\x09\x57\x46\xc3\x7a\x1e\x07\xcb\x3d\x4f\xed\xc7\xda\x48\x5a\x16\x39\x97\x45\x3e\x29\xed\x84\x4b\xef\xb3\xef\xd8\x53\x08\x77\x69\xbc\x31\x75\x52\x67\x82\x33\x83\xe5\xb6\x93\x14\x3a\x4b\xcd\x63\xea\xfd\x87\xec\x07\x0d\xe6\x99\xf5\x6b\x08\x97\x0b\x37\xaf\x2c\x6f\x59\xf2\x88\xbf\x36\xdb\x20\x34\x78\x1c\x48\x57\xac\x36\x6f\x18\x95\x77\x0b\x9d\x47\x3d\xec\x33\x97\x30\x46\x5b\xb0\x9e\xeb\x71\x77\x7f\x1b\x63\x64\xfb\xd3\xfe\x06\x00\x00\xff\xff\x78\x2e\xcb\x77\xce\x03\x00\x00"
+var _repoSettingsGithooksTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x54\x41\x6f\x9c\x3c\x10\x3d\xb3\xbf\x62\x64\x7d\x87\xaf\x52\x03\x6a\x95\x43\x0f\x64\xa5\xaa\x55\x95\x56\x69\x0f\x49\xee\x91\x17\x0f\x30\x0a\xd8\xc8\x1e\x36\x8d\x5c\xfe\x7b\xe5\x05\xb3\x40\xa4\x26\x27\xdb\x6b\xcf\x7b\xf3\xde\xbc\xc5\x7b\xc6\xb6\x6b\x24\x23\x88\x83\x74\x98\xd5\x28\x95\x80\x74\x18\x76\xb9\xa2\x23\x14\x8d\x74\xee\x4a\x58\xec\x8c\x23\x36\xf6\x19\x1c\x32\x93\xae\x1c\x54\xc4\xb5\x31\x8f\x4e\xec\x77\xc9\x12\x26\xbc\x3d\xc1\xa0\x1d\x81\x92\x25\x52\x4f\x50\x18\xcd\x92\x34\xda\x50\xb9\xbd\xac\x2c\xa9\xd3\xef\x2f\x31\x23\x73\xa6\xe5\xf1\x20\x23\xf8\x1a\x81\x9f\xb0\x39\x22\x3c\x91\x42\x28\x4c\xd3\xb7\xfa\x44\x87\x9a\x47\xd0\xe4\x85\x60\xd9\xa0\xe5\x19\x2b\xf1\x9e\x4a\x48\xbf\x5a\x2a\x19\xd5\x75\xd0\x37\x5d\x6c\x1b\x7d\x92\x56\x93\xae\xa0\x45\xe7\x64\x85\x13\xfc\xfa\xd9\xe4\xc2\xde\xfb\x94\x3e\x7c\xd2\xe9\xbd\x1d\xa5\xa4\x51\x4a\x1a\x4d\x7c\x50\x81\x51\x0c\x43\x9e\x29\x3a\xce\x58\xdd\x9b\x4b\x1f\x14\xba\x42\xc0\xff\x3f\x0c\xe9\x75\xff\x20\xde\x83\x78\x17\x90\xbb\x19\xb7\x34\xb6\x5d\x68\x09\x47\x01\xb2\x60\x32\xfa\x4a\x78\x9f\xde\x62\x67\x6e\x48\x3f\x0e\xc3\xd9\xf5\x13\x59\x56\x11\x67\x16\x1d\x1b\x8b\x02\x5a\xe4\xda\xa8\x2b\xd1\x19\xc7\xb3\x01\x89\xf7\xe9\x97\xbb\xdb\x6f\xf7\xe6\x11\xf5\xf5\xfd\xcf\x9b\x68\x60\x92\xe4\x87\x9e\xd9\xe8\x05\xb3\xb1\x52\x57\x08\xe3\xef\x6f\x32\x2a\x92\x07\x41\x63\xd9\xac\x2a\x0b\x3a\xa6\xd3\xc2\x47\xef\x51\xab\xa9\x89\xbc\xbe\x5c\xb0\xb3\xe9\x40\x32\xcb\xa2\x46\x05\x71\x58\xbb\x28\xe2\x95\x4e\x44\x84\xcc\xea\xcb\xfd\x6e\x3b\xfa\x9e\xce\xc8\x2c\x0f\x0d\x82\xc3\xaa\x3d\xe7\x70\xfb\x38\x40\x42\x43\x0b\x1f\x97\x0f\x88\xb1\x5d\x19\xfc\x6a\x26\x4e\x69\xf8\x03\x77\x6c\x3f\xae\x66\xb0\xca\x97\xf7\xa3\xfd\xe9\x2a\xe8\xff\xa2\x4e\x72\xd7\xc9\x79\x80\x8c\xbf\x19\xc6\x7f\xcc\x77\xf7\xb9\x60\x3a\xe2\x30\x54\x16\x51\x7b\x8f\x8d\x1b\x0f\xcf\x93\xff\x62\x9f\x53\x2c\x34\x05\x53\x61\x34\x4c\xeb\x45\x67\xa9\xa5\x50\x7e\xa1\x0c\x8b\x7d\x9e\xd1\x3e\xcf\x02\xd3\x86\x38\x04\xe4\x97\x6c\x31\x0c\x7f\x73\x2d\x57\x4d\x1d\x9a\x1e\xa1\x27\xb0\x54\xd5\x2c\xa0\xb6\x58\x86\x60\xff\xf7\x4a\xb2\x67\xf8\x65\xb3\xa5\x84\x52\x5e\x74\xa8\x0b\x6a\x62\x6f\x72\x66\xde\x18\x7a\x8e\xda\xf2\xe6\xbc\x9d\x77\x71\x33\xad\xd3\xf2\xe2\xf3\x54\x1a\xc3\xf1\x43\xfa\x77\x00\x55\x9a\x2a\xf9\xad\x05\x00\x00"
 
 func repoSettingsGithooksTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2132,12 +2856,32 @@ func repoSettingsGithooksTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/githooks.tmpl", size: 974, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0x7e, 0xb3, 0x3a, 0x9, 0x7, 0x6b, 0x9f, 0x39, 0xe9, 0xa8, 0x94, 0x66, 0x51, 0x89, 0xfd, 0xb3, 0x53, 0x43, 0xbf, 0x13, 0x29, 0x43, 0xf4, 0x0, 0xb8, 0x37, 0xf5, 0x48, 0x1d, 0xbd, 0x1d}}
+	info := bindataFileInfo{name: "repo/settings/githooks.tmpl", size: 1453, mode: os.FileMode(0644), modTime: time.Unix(1792260391, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9f, 0xfa, 0x10, 0x31, 0xb1, 0x11, 0xb2, 0xed, 0xda, 0x79, 0x38, 0x32, 0x36, 0x82, 0x22, 0x2f, 0xd7, 0xb6, 0x65, 0xe, 0x46, 0x3, 0xdc, 0x46, 0xc, 0x7d, 0xe0, 0x9, 0xa7, 0x90, 0x86, 0xee}}
+	return a, nil
+}
+
+var _repoSettingsImportTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x5d\x8b\xeb\x36\x10\x7d\x4e\x7e\x85\x10\x2d\xb4\xd0\xda\x94\xde\x87\x3e\xd8\x81\x72\xa1\x14\x7a\xdb\x2e\x7b\x17\xfa\xb8\x28\xd6\x38\x16\x2b\x4b\xbe\xd2\x38\xbb\xc1\xf8\xbf\x17\x7d\xd8\x91\x9d\x2c\xd9\xfb\xc1\x2e\xc4\x1f\x9a\x33\x67\xce\x9c\x91\x35\x0c\x08\x6d\x27\x19\x02\xa1\x7b\x66\x21\x6f\x80\x71\x4a\xb2\x71\xdc\x16\x5c\x1c\x49\x25\x99\xb5\x25\x35\xd0\x69\x2b\x50\x9b\x13\xb1\x80\x28\xd4\xc1\x12\xd1\x76\xda\x20\xdd\x6d\x37\x29\x88\x5b\xe9\x41\xc0\x04\x98\x4d\x8a\xd3\x0b\x52\x69\x85\x4c\x28\x30\x2e\x72\xfd\xf2\x60\x04\xf7\xcf\x2f\x31\xa7\xbc\xb9\x62\xc7\x3d\x9b\xc0\x97\x08\xf8\x0c\xf2\x08\xe4\x59\x70\x20\x95\x96\x7d\xab\x7c\x3a\x50\x9e\xe6\x0a\xd5\x97\xcb\x24\x18\x9c\xb1\x36\x45\xf3\x2e\x61\x83\xba\x23\x0c\x91\x55\x0d\x70\x12\x6b\x0a\x38\x9b\x61\xc8\xc4\x2f\xbf\xa9\xec\xc1\x84\x92\xb3\x89\x5e\x06\x2f\x5e\x96\x09\x30\x6f\xde\x85\x90\x55\xa5\x33\xae\x85\x43\x7b\x26\xb8\x29\xba\xdd\x2d\xec\x47\x0e\xb6\xa2\xe3\x58\xe4\xdd\x14\xc4\x12\xe4\xbd\xec\x81\xec\x7b\x44\xad\x28\x69\x0c\xd4\x25\x1d\x86\xec\x1e\x3a\xfd\x41\xa8\xa7\x71\x3c\x2b\x19\xa9\xde\x4c\xe8\x73\xb1\x58\x46\xce\xc5\x71\xb7\xfd\x56\x6a\x89\xf6\xab\xd4\x1a\x06\x51\x93\xec\x4e\x32\x15\x11\x6e\x08\x18\xd2\x3d\x76\x06\x8e\x02\x9e\x2f\x85\x4c\x00\xb3\xff\x98\x51\x2e\x68\x46\x5e\x93\x7a\x0e\x0b\x48\x0b\xd6\xb2\x03\x4c\x9c\x56\x0b\x27\x25\x6e\x92\x8a\x70\xd6\xab\xed\x45\x9e\xe1\x7a\x39\xa1\x49\x61\xe7\xe2\xdd\xff\x30\x18\xa6\x0e\xf0\x2a\x65\x47\x46\x0a\xa7\x88\x43\x95\x62\x11\x0a\x8a\x27\x2b\x8b\xbc\x97\xf3\xeb\x05\x83\xe5\xca\x02\xd9\x5e\xc2\x44\xa8\x17\xe4\x08\xe6\x44\xf6\xcc\x8a\x8a\xf8\x57\x67\x7e\x05\xba\xea\xe7\xdb\x4d\x81\xe6\x7c\xb3\x29\xb0\xb9\xad\xca\x93\x50\xdc\x2b\x82\xcd\xe7\x86\x2a\xd6\xc2\x17\x86\xb2\x0a\x85\x56\x17\xc1\x45\x9e\x14\x50\xe4\xcb\xea\x0a\xdc\x6b\x7e\xda\x6d\xaf\xb7\xe6\x7d\xe3\xfa\xb4\xe8\xcc\x52\x8d\x4d\x81\x7c\x37\x0c\xdf\xcd\xcc\x7e\xe8\x8c\x50\x58\xbf\xc2\xd0\xe9\xf2\xf8\xbd\xa5\x24\xfb\x4b\x28\xfe\xa3\x67\xca\x2f\xe1\xb2\x7f\x58\x0b\xd7\x5f\x26\xb7\x9b\xc2\x76\x4c\x25\x2d\xf5\x63\x00\x9f\x48\xf6\xbb\x17\x82\xd0\xca\x00\x43\xa7\xe6\xc1\x00\xa8\x61\x00\x69\x81\xac\xd6\xf4\x1d\x0f\x6b\xdc\x0e\x14\x3d\x13\x7d\x21\xd9\x1e\x24\xfd\x8c\xf2\x42\x03\x42\x81\x81\x83\x2f\xd1\xd1\x5c\x10\xf7\x44\xb3\x7b\x60\x56\xab\x71\x5c\x94\x81\xf0\x82\xe4\x60\xe0\xe4\xf2\x9e\x97\xe4\x6e\xcd\x6e\x69\xe9\xd8\xce\x54\x93\x45\xab\xdd\xac\x48\x0b\xeb\xe6\x15\xc8\xdd\x67\xc6\x01\x96\xf4\xd7\x37\x8c\xb8\xd2\xd8\x08\x75\xa0\xb1\x21\x17\x39\x52\x4a\x45\xbe\xf0\x53\x91\xfb\xd9\x9a\x6f\x6b\x6d\xda\xa4\x61\xee\x96\x92\xa0\x9a\xdf\xef\xc3\x5e\x4f\x49\x0b\xd8\x68\x5e\xd2\xbb\x7f\x3f\x3e\x9c\x07\x73\x18\xb2\xf7\x1f\xef\xff\x78\xd0\x4f\xa0\xfe\x7c\xf8\xfb\x43\x92\x56\xa8\xae\x47\x82\xa7\x0e\x4a\xda\x08\xce\x41\x51\xe2\x26\xa9\xa4\x71\x28\xc8\x91\xc9\x1e\x4a\xca\xba\x4e\x9e\xd2\x59\x87\x17\x64\x06\xe6\x4f\x51\x23\x38\x4c\xa1\xf3\x57\xd8\x25\x0e\xd7\x5e\x83\x18\x92\xf0\x9a\xb7\xdf\x3b\xc9\x2a\x68\xb4\xe4\x60\xd2\xa9\x79\xcb\xf6\x6e\xa1\x32\x80\xf6\xca\xf6\xbe\x9e\xca\x57\x92\x2c\x36\x70\x03\x9f\x7a\x61\x80\x13\xa1\xa4\x50\x40\x6a\x01\x32\x1e\x52\xe2\x5f\xe1\xed\x4d\x6a\x6d\x4a\x1a\x72\x3f\xfa\x0d\x97\xce\xfb\xae\x7b\xbf\x88\x08\x2a\x0b\xbe\x0a\x88\x72\x2d\x9f\x85\x5e\x74\xcc\xda\x67\x6d\x38\x25\xac\x47\x5d\xe9\xb6\x93\x80\x50\x52\x5d\xd7\x94\x4c\x1c\x93\x1c\xab\x6f\xc8\xca\x5d\x6b\xb3\x85\xf3\xc2\x54\xb2\x3f\x89\x01\xa8\xe9\x18\x71\x5b\xf2\x60\x06\xa7\x75\x08\x99\x13\x2f\x8f\x26\xeb\x53\x49\x74\xe9\x02\xbf\x62\xaa\x02\x99\x9e\x39\xdc\x30\x38\x83\xef\xb6\xd7\x86\xf1\x2d\x8e\xb8\x74\xc2\x97\x0d\x10\x01\x55\x85\x76\xb4\xbd\x44\xd1\x31\x83\xb9\x03\xfa\x99\x33\x64\xdf\x76\xbc\xe2\x19\xe5\x0c\x9a\x7a\xf2\x15\x2b\x46\xf4\x60\xa2\x5a\x48\x98\xcc\x13\xae\x59\x55\x41\x87\x25\xcd\x4e\xad\xfc\x29\x3b\xb1\x56\x5e\x71\xce\xd2\x37\x97\xc6\x48\x8f\x97\x6f\x3e\x69\x5d\x71\xc6\xba\xa5\xb3\x1d\xcf\x04\xe6\xab\xe9\x22\xfe\xc6\x9f\x8b\xc3\x7c\xad\x35\x82\xa1\x24\x1b\xc7\xed\xff\x03\x00\xf7\xae\x79\xdc\xd9\x0c\x00\x00"
+
+func repoSettingsImportTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsImportTmpl,
+		"repo/settings/import.tmpl",
+	)
+}
+
+func repoSettingsImportTmpl() (*asset, error) {
+	bytes, err := repoSettingsImportTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/import.tmpl", size: 3289, mode: os.FileMode(0644), modTime: time.Unix(1792246171, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb0, 0x15, 0x37, 0x53, 0xb4, 0x96, 0x6d, 0x13, 0x49, 0x84, 0x4b, 0x36, 0x70, 0x65, 0x3e, 0x41, 0xd2, 0x8f, 0x71, 0x1a, 0xf4, 0xf9, 0x7e, 0xe9, 0x3, 0xa2, 0xb4, 0xd0, 0xf, 0x60, 0x54, 0xd8}}
 	return a, nil
 }
 
-var _repoSettingsNavbarTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd3\xcf\x6a\xe3\x30\x10\x06\xf0\x73\xf6\x29\x84\x1f\x40\x66\x6f\x7b\xc8\xe6\xd0\x50\xda\xd0\x94\x96\xfe\x39\x17\xc5\x9a\xd8\x43\x1c\x8d\x19\xc9\x2e\xc6\xe8\xdd\x8b\x6c\x37\x38\x94\x46\xc4\xa7\x1c\xf4\x7d\x33\xbf\x0c\x78\xa9\xb1\x11\x59\xa9\xac\xfd\x9f\xec\xa9\x66\xf1\x89\x1a\x44\x46\x65\x7d\x34\xc9\xea\xcf\x62\xfa\x5e\xa3\x68\x80\x1d\x66\xaa\x14\x47\x30\x75\x78\x3f\x0b\x14\xa0\x34\xb0\x40\x07\xc7\x64\xd5\x75\x12\xff\xfe\x33\xf2\x8d\x45\xc2\x50\x91\xb4\xe0\x1c\x9a\xdc\x26\xde\x2f\x53\x8d\x4d\xdf\x56\xdf\xdd\xae\xc3\xbd\x90\xcf\x2a\x87\x8d\x7d\x1d\x93\x4f\x95\x43\x32\xd6\x7b\x95\x39\x6c\xa0\xeb\xc0\x68\xef\x87\xf9\xa2\x60\xd8\x87\x9a\x7c\x81\x8a\xb6\x68\x0e\xde\xa7\xa7\x15\x61\xf6\xe2\x57\x81\xa4\x61\x70\xe2\x7d\x30\xa4\x2a\x4e\x59\x53\x59\xaa\x1d\xb1\x0a\xc5\xeb\x41\x69\x36\xed\xc7\x78\xe7\xe1\x29\xb2\xa7\x19\x72\xa2\x5f\x62\xd1\x11\xb7\x72\x63\x1f\x91\x99\x78\x08\x5e\xfc\x1b\x37\xac\x4c\x56\xc0\x8c\x93\xa6\xbb\xb1\x1a\xc3\x9f\x72\xe7\xee\x7e\x4f\xd4\x77\x4f\x74\x98\x83\x2b\x42\x2f\x26\x1b\x42\x3f\xce\x29\xb7\x94\xe7\xa0\xdf\x2d\xb0\x5c\x2b\x73\xab\xd1\xdd\xa1\x0b\x92\x3e\x1a\x11\x8f\xd1\xd9\xe8\x34\x47\x37\xc0\x2f\xc8\x73\x74\x13\xfc\xb5\x47\x7d\x80\x76\x0e\xef\x00\x6d\xf4\xa4\x1a\xaa\x92\xda\x8f\x3e\x3a\x39\xec\xf8\x7d\x8f\x3f\x5f\x01\x00\x00\xff\xff\xa3\x4e\xbe\xb4\x64\x04\x00\x00"
+var _repoSettingsNavbarTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x95\xcd\x6e\xdb\x3a\x10\x46\xd7\xb9\x4f\x41\x68\x1f\x09\x77\xd7\x45\x1a\xa0\x0d\x82\xd6\xa8\x8b\x06\x76\xba\x16\x68\x72\x2c\x0d\x4c\x73\x04\xfe\x38\x35\x04\xbe\x7b\x41\xca\x4e\x65\x04\x2d\x1d\xae\xb4\xe0\xf9\x38\x87\x83\x19\xe8\x4e\xe2\x81\x09\xc5\xad\xfd\x58\x6d\xc9\x1b\xf6\x82\x12\x98\x20\xe5\xf7\xba\xba\xff\xef\x66\x7e\xee\x91\x1d\xc0\x38\x14\x5c\xb1\x3d\x68\x1f\xcf\x2f\x80\x1e\xb8\x04\xc3\xd0\xc1\xbe\xba\x1f\xc7\x1a\xff\xff\xa0\xeb\x67\xc3\x2a\x03\x03\xd5\x16\x9c\x43\xdd\xd9\x2a\x84\xbb\x46\xe2\x21\xa5\xf9\x39\x3b\x8e\xb8\x65\xf5\x13\xef\x60\x61\xd7\x27\xf2\xc7\xe0\x90\xb4\x0d\x81\x0b\x87\x07\x18\x47\xd0\x32\x84\xe9\x7e\xd6\x1b\xd8\xc6\x58\xbd\x82\x81\x96\xa8\x77\x21\x34\xaf\x25\xe2\xdd\x37\x7f\x35\xa8\x69\xba\xb8\x0a\x21\x3a\x34\x3c\xaf\xf2\x40\x4a\xf1\x0d\x19\x1e\x83\xef\x17\x6a\xc4\x3c\x9f\xd3\xbb\x84\xe7\x92\x49\x4d\x93\x63\xa9\x88\x45\x47\xe6\x58\x2f\xec\x77\x34\x86\x4c\x08\xd9\x67\x7c\x36\x5c\x8b\x1e\x0a\x5a\xda\x6c\x4e\xd1\x9c\xfc\x2b\x77\xe9\x9d\xea\x64\xfd\xbe\x12\xed\x4a\xe4\xfa\x98\xcb\x99\x4d\xd0\x9b\x76\xd6\x4b\xea\x3a\x90\x3f\x2d\x98\xfa\x81\xeb\x47\x89\xee\x0b\xba\x68\x92\xd0\x8c\xf1\x09\x2d\x96\x6e\x3a\x74\x93\xf8\x3f\xcc\x3b\x74\x33\xf9\xf7\x36\xf5\x1b\x1c\x4b\xf4\x76\x70\xcc\xb6\x54\xc2\xa0\xe8\xd8\x26\xf4\xfa\x65\x5a\x83\x30\xe0\x4a\x9c\xec\x94\xcc\x69\x9d\xb1\xeb\x95\x56\x70\x40\x78\x59\xc1\x1e\xb5\x04\x53\xa2\x66\xce\xd9\x9c\x9c\x49\xa5\xda\x3f\xfc\xdb\x89\x9c\x2d\xf7\xa3\xe6\x1b\x05\x0b\x6b\x7d\xdc\xda\xfc\x3c\x26\xf2\x93\x77\xb4\x24\xb1\x2b\x78\x06\xf7\x8e\x6e\x15\x89\x5d\x76\x28\x31\x56\x6a\x23\xdf\x26\xbe\x68\x36\xd7\xa2\x07\xe9\x15\xc8\x67\x6e\x8b\x96\xc8\xc5\x5c\x76\x1e\xce\x55\xda\x09\xbf\x7e\x2e\x9e\xbc\xed\x97\xd4\x15\x88\x0d\xde\xf6\xb7\x8a\xba\x9c\x5b\xe4\xda\xc8\x5d\x2f\xb5\xd8\x0f\x64\x5c\x81\x13\xa6\x60\xce\x68\xa2\x5a\xf8\x95\xe0\x99\xd6\xe9\xb7\x7d\xfa\xfc\x1e\x00\xdf\x31\x14\x45\x3b\x08\x00\x00"
 
 func repoSettingsNavbarTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2152,12 +2896,12 @@ func repoSettingsNavbarTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/navbar.tmpl", size: 1124, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfd, 0xbf, 0x8a, 0xa3, 0xbf, 0xf2, 0x89, 0x50, 0xd, 0x20, 0xd1, 0xae, 0xd1, 0xc9, 0xa6, 0xa6, 0x99, 0xf5, 0xe7, 0xe0, 0xb7, 0xa1, 0x12, 0x5e, 0xf1, 0x7c, 0x77, 0xea, 0xf3, 0x49, 0x32, 0x31}}
+	info := bindataFileInfo{name: "repo/settings/navbar.tmpl", size: 2107, mode: os.FileMode(0644), modTime: time.Unix(1792281004, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x43, 0xdc, 0x9, 0x44, 0xc0, 0x79, 0x3c, 0xe, 0x95, 0xf2, 0xd4, 0xef, 0xd0, 0xd5, 0xc0, 0x9e, 0x1, 0xb3, 0xe0, 0xa6, 0x11, 0x17, 0xd7, 0x32, 0x6d, 0x39, 0xe4, 0xd2, 0x49, 0xc6, 0x8f, 0x1c}}
 	return a, nil
 }
 
-var _repoSettingsOptionsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x5d\x8f\xdb\x36\xd6\xbe\x76\x7e\x05\x5f\xe5\xbd\xd8\x05\xd6\xf6\x26\xdd\x02\x8b\xc2\x1e\xa0\x9b\x26\xbb\x01\xd2\x36\x98\xc9\x22\x97\x06\x2d\x1d\xdb\xc4\x48\x94\x42\x52\xf6\x4c\x5d\xff\xf7\x05\x3f\x24\x91\x12\x25\xd3\xf6\xcc\x24\x03\xa4\x37\x8d\x25\xf2\xf0\x9c\xf3\x9c\x8f\x87\x12\x35\xfb\xbd\x80\xac\x48\xb1\x00\x14\x2d\x31\x87\xe9\x06\x70\x12\xa1\xc9\xe1\xf0\x62\x96\x90\x2d\x8a\x53\xcc\xf9\x3c\x62\x50\xe4\x9c\x88\x9c\xdd\x23\x0e\x42\x10\xba\xe6\x28\x2f\x04\xc9\x29\x8f\xae\x5e\x8c\x6c\x29\x72\xa8\x92\x02\x4c\xcb\x19\xd9\x82\x4a\x82\xe2\x9c\x0a\x4c\x28\x30\x39\xb3\x7d\x73\xcd\x48\xa2\xae\x77\x65\x56\x0b\x4f\x29\xde\x2e\x71\x25\xdc\x95\x20\x76\x90\x6e\x01\xed\x48\x02\x28\xce\xd3\x32\xa3\x6a\x39\xa0\x42\x0b\x1d\x75\xec\xc5\x29\x30\x51\xcb\x6a\xab\x23\xf2\x02\x61\x21\x70\xbc\x81\x04\x19\xa3\xb4\xa0\xd1\x7e\x3f\x21\xaf\xfe\x49\x27\x9f\x98\xd6\x6f\x52\xe9\x37\x59\x62\x4e\xe2\x45\xf5\x33\xaa\x24\x4f\x13\xb2\xbd\xf2\x2d\x52\x2f\xc0\x61\x9d\x35\xaa\x8e\x66\xab\x9c\x65\xd6\x38\xf9\x33\x42\x38\x96\x6e\x9f\x47\xfb\xfd\xe4\x03\xa1\xb7\x87\x43\x84\x32\x10\x9b\x3c\x99\x47\x1f\x7f\xbf\xf9\x54\x4d\x96\xfa\xbd\xb9\xb9\x7e\xf7\x29\xbf\x05\xfa\x9f\x4f\xbf\x7e\x30\x6a\x8c\x46\x33\x42\x8b\x52\x20\x71\x5f\xc0\x3c\xda\x90\x24\x01\x1a\x21\x8a\x33\x98\x47\x5a\x76\x84\xb6\x38\x2d\x61\x1e\x95\x45\x82\x05\xd4\x12\xdd\x80\xf8\x52\x12\x06\x09\x5a\x11\x48\x13\xb4\xdf\x93\x15\x9a\xbc\x65\x6c\x71\x0d\x45\xfe\x1b\xce\xe0\x70\x00\xc6\x72\xb6\xdf\x03\x4d\x0e\x87\x5a\xc6\x68\x96\xe2\x25\xa4\xd2\x16\x1d\x55\x0b\xb9\x72\x74\xd5\xf1\x66\x73\xef\x70\x98\xf1\x02\xd3\x1a\x62\xb8\x13\x48\xae\xbc\x21\x09\x44\x88\x24\x5a\xce\x58\x8e\x1d\xc7\x1b\x4c\xd7\x30\x2e\x58\x9e\x15\x22\xba\x42\xfd\x20\xe9\x91\x0b\x79\x51\xce\x5c\x98\x29\x87\xc3\x6c\x2a\x57\xbb\x9a\x4d\x95\xa2\x8d\xde\xda\x6b\xd5\x72\x5a\x35\xe3\x36\xeb\x82\xf1\xdc\x7e\x3f\xb9\xae\x53\x66\xa2\xfd\x11\xa1\x04\x0b\x3c\xae\x95\xed\x1b\x55\xb9\xb6\x76\x7b\x13\x38\x2e\x06\x6d\xd7\xff\x02\x3c\x66\x44\x65\x65\x88\xf7\x93\x66\xb8\xf4\xff\xff\xfb\x00\x90\x63\x94\x4f\x5a\xce\x90\x20\x60\x06\xb8\x52\x05\x97\x22\xe7\xe4\x0f\x83\x87\x2d\xd9\xb8\xc8\xb9\xc4\xf2\x1d\x9f\x47\x3f\x28\xd4\x2d\x07\x38\xfa\xcf\xa6\xd5\x1a\xcd\xaa\x45\xb5\xdc\x06\xd2\xc2\x13\x33\x35\xb8\xd6\x6a\x8d\x09\xc5\x85\x82\x52\xa0\x6b\xb1\x89\x0e\x87\x9f\x90\x0e\xc8\xca\xd4\x0f\xfa\xc6\x55\x1d\x39\xc5\xc9\xd0\x7d\x86\x25\x27\x22\x28\x69\x76\x7a\xe8\x90\xd6\xea\xbe\x07\xb5\x26\x84\x2b\x21\x06\x9d\xfa\xa7\x2e\x0a\x25\x4b\x7b\x22\xb9\xd6\x33\x6a\x99\x58\x17\x1d\xb2\x42\x34\x17\xc8\x9e\xf4\x9e\xbf\xcb\xd9\x6d\x5d\x7f\x1c\x47\x10\x9a\x12\x0a\xba\x8a\x34\xf6\x1a\x83\xbb\x26\x6e\x09\x27\x4b\x92\x12\x71\xef\xb1\xaf\xd3\x68\x36\x10\xdf\x2e\xf3\x3b\x4b\x6e\xe5\x03\x6d\x76\xc1\xc8\x16\x37\x66\xd7\xe3\x0d\x30\x8e\x09\x1f\xf5\xd8\xc3\x41\x8d\x82\xc4\x60\x64\x8b\x1e\xd2\x59\xaa\xbc\x90\xd1\x26\xbb\xe2\x9f\xe8\x06\xaf\xe0\x70\xe8\xae\xf3\x5b\x99\x49\x5f\xf1\x9e\x9a\xe7\x01\xbd\x91\xbe\xca\xd9\x6d\xb5\x44\x5d\xc6\x8c\x9a\x5d\x57\xd9\x91\xe9\xfe\x32\x53\x5e\xf4\x85\xad\x15\x96\xcb\x52\x88\x9c\x3a\xed\x1b\x80\x22\x7d\xd9\x57\x54\xea\x10\xd5\xad\xc5\x6e\x92\xb3\xa9\x9e\xe6\xcb\x9d\xd9\x54\xf6\xbe\x2a\xca\x5a\x30\x27\x64\x4b\x54\x5f\x76\x42\x31\xb0\x79\x4e\xf1\x16\x0b\x49\x26\xaa\x1e\x5a\xe4\x5c\x44\x08\x68\xac\x63\x22\x2b\x53\x41\x0a\xcc\x84\xd2\x60\x2c\x2b\x78\x40\x87\x3d\x1a\xe0\x76\x42\x1b\x0d\x1c\x68\xad\x36\x95\xe7\x1c\x16\x14\x76\x0b\x33\xae\x37\xaf\x4d\x0b\x37\xf6\x68\xf5\x57\x24\x85\x08\x85\x95\xa3\x33\x71\x6d\x43\x6a\xa9\xe9\x02\x3a\x9a\x61\x4b\xa2\xec\xe0\x66\x99\x04\x52\x10\x30\xd6\x9e\x37\x3d\xf2\x4b\x09\x5c\x8c\x4b\x96\x76\xb1\x9a\xea\xf1\x66\x68\x92\x53\x68\x8d\xeb\x53\x50\xcf\x5b\xc4\x25\x63\x40\x85\xad\x28\x1e\x0a\xba\x76\x95\xf3\x14\x87\x5f\x89\x2c\xdb\x15\xfe\xe1\x1c\x72\x80\x44\x66\x4a\x64\x87\x45\xba\x1a\x86\xf1\xc8\x9e\x5c\xf0\xb2\xc6\xfe\xa0\x3e\x81\x37\x6a\xdd\xad\x78\xea\xc9\x07\xab\x01\xbe\xa5\x78\x99\xc2\x47\x56\xd2\xde\x26\x88\x50\x5f\x85\x35\xbe\x2a\xe4\xec\x33\xfa\x02\x32\xff\x59\xfd\x11\x94\x3a\x46\xa2\x31\xd2\xbd\xe6\x6f\x19\x3a\x10\x1c\x63\xbc\xfd\xa2\x5e\x32\xc0\xa2\x3e\x12\x36\x54\xc3\x43\x5c\xfe\x9e\x0a\x60\x5b\x9c\xf6\xf9\xdb\x29\x52\xc4\x0c\xf6\x74\x20\xa3\x6b\x3d\xc2\xa7\x69\xe3\xd9\x7a\x98\xf1\x6a\xf3\x5b\x7b\x94\x96\xd9\x52\xf6\xc8\x86\x7e\x68\x9f\x36\xda\x46\x01\x26\xfb\xf9\x84\x36\xc5\xe8\x8b\x93\x84\x01\xe7\xfd\x06\x55\x03\x86\xed\x69\x0d\x36\x56\xb5\xaf\xb6\xad\x99\xbc\x2b\xd3\xf4\x67\x7d\xd7\xc7\xf9\x03\x38\xaa\xbb\x84\x97\xe6\x3a\xd4\x6c\xd8\x3f\x8f\xd9\xc9\x5b\x7d\xc7\xe9\xe5\x61\xcd\xfc\x2b\x55\xb0\x31\xbf\xa7\xf1\xf1\x32\x16\xc0\x5b\x0d\x58\x29\xe6\x62\x21\x85\x42\xe2\x0d\x2b\x43\xd9\xaa\x20\xf9\x80\xb9\xb8\xb9\xa7\x71\xcd\xe6\xce\x08\xfc\x0e\xb0\xcb\xb4\x84\x10\x5c\xa5\x9a\x0b\x53\xc9\x43\x31\x6d\xdf\x71\xa8\xe4\x43\x3c\x5a\xc1\xc9\x16\x4b\xef\x3d\xda\xd3\x95\xc7\x7a\x94\x52\x29\x1e\x35\x21\xfd\x7f\xe3\x31\xfa\x4c\x6e\x09\x1a\x8f\x7d\x9c\x6c\x88\x38\x76\x03\x6c\x47\x6e\x89\x8f\x1a\x1e\xdd\x11\x19\x13\xcc\x18\xdd\xe3\xc6\xfc\x9e\x0b\xc8\xba\x4d\xce\x69\x84\x6a\x49\x4d\xc2\x04\x66\x6b\x10\xf3\xe8\xa5\xbc\xb6\xf0\x6f\xa1\x74\x53\x94\x06\xf7\xef\xa1\xfa\xac\xab\xf1\x57\xf2\xfb\x1e\x4a\xb8\x11\xd9\x43\x75\x4b\x52\x05\x82\xdd\x10\xdb\x5b\x56\x5b\xd9\x84\x70\xf9\xa3\xd2\x56\x3f\xe2\xa8\x0d\x0d\xcb\x42\x57\x01\x86\x13\x92\x0f\x6d\x4f\xab\xca\xaf\x22\x0a\x39\xa0\x8c\xd5\xe4\x08\x09\xbc\x24\x34\x81\xbb\x79\xf4\xf7\x16\x2e\x70\x27\x80\x51\x9c\x1a\x80\x34\x86\x66\x96\x89\xc6\x15\x4e\x79\x45\xa0\xf5\xac\x79\xf4\x52\x75\xe2\x6a\x9e\x06\x51\x33\x6c\x6d\xff\x3c\x7a\xe9\x48\xb6\x60\xf6\x7b\xef\xad\x19\xed\x83\x7c\x1a\xb0\x6f\x6e\x3a\x0c\x87\x85\xa3\xdc\x25\x64\x68\x99\xdf\x39\x4c\xe8\x88\xda\x5e\xf0\xbb\x9e\x3a\xff\x19\x04\x4e\xd3\x7c\xb7\x28\xca\x65\x4a\x62\x07\xb1\x81\xa7\x11\x3f\xcb\x39\x1f\xd5\x94\xe1\x7c\x3a\xee\xdb\xce\xf2\xa7\xb1\xcd\xe7\x18\xfc\x82\x95\x9d\xd8\xf7\x44\x76\x2b\xf6\x3d\xd9\x11\x14\x40\x17\xc6\xbd\x6b\xcf\xc3\xc5\x7d\x48\xca\x7a\x63\xbf\xeb\x29\x3f\xcb\x76\xc7\x95\xcc\xb7\x73\xa8\x2d\xed\x0e\x1e\x26\xdd\xdd\xf1\x55\x14\x74\x6f\x1c\x7b\xa4\x69\xdb\xfc\xdf\xeb\x0f\xee\x0e\x28\xf8\x41\x71\x67\xe1\x01\x42\xee\xcb\x20\xc5\x06\xde\x73\x5e\x02\x7f\x10\x3e\x40\x94\xa8\x87\x67\x04\x4e\xb2\x99\x45\x3a\x05\xcb\xe5\x04\x6a\xd4\x60\xc6\x68\xbb\x2f\xa0\x05\x5a\x91\xa7\x23\x06\x95\xc2\xfe\xee\x50\xdb\xfb\x0d\x73\x03\xc1\x70\x7c\x0b\xec\x1c\x7a\x60\xe1\xd9\xc7\x0f\xda\x90\x0f\x57\x9b\x4f\x5a\x97\x87\xe3\x08\x7a\xf9\xca\xc4\xa7\x20\x0b\xb5\x09\xc3\x7c\xc1\x13\x1a\x97\x11\x86\xbe\x0c\x1c\xa2\x0c\xc7\xb2\xed\x44\xd2\x30\x9c\x7b\xcf\x8d\x36\x0c\xe6\xc5\x20\x73\xe8\x4f\x0b\x5f\xe6\x84\xc6\xd3\x43\xd1\x87\xc7\x4a\x89\xc0\xcc\x1e\xa6\x12\xc7\xd2\xa2\x1d\x1a\x7e\xa2\x61\x8c\x0b\xe6\x1a\xf6\x78\x8f\x43\xbc\x7c\xc3\x9e\xd3\xa6\x1c\xce\xbd\x50\xd6\x61\x3c\xd4\x26\x1e\xe7\x30\x0f\x6b\x7d\x1f\xf9\x68\x61\x1a\xee\x60\x5b\xee\x2a\x67\x19\x16\x43\xea\x78\x46\x1f\x71\xae\x67\x86\x71\xad\xef\xce\x89\x8e\x7d\xa7\xa6\xc9\x88\x2b\x52\x1c\xc3\x26\x4f\x13\x90\x41\x33\x59\x4f\xd0\x46\x88\x82\xff\x34\x9d\xae\x89\xd8\x94\xcb\x49\x9c\x67\xd3\x7d\xc9\x81\x1d\xa6\x7b\x69\xd3\x61\xaa\x4b\xdb\x74\xaf\x8a\xc6\x99\xf0\x74\x6d\xd0\xe0\xa0\x3f\xd1\x8d\x60\xaf\xf5\xe3\xa4\x50\x9c\x6c\x0e\xc8\xfb\xf0\xd2\xd9\xc4\xc5\x7d\x3a\x78\x48\xa0\x52\xcc\x1e\xee\x45\x6a\x28\x4e\xc2\xab\xb4\xbf\x4e\x7b\x6b\xb2\x4f\x33\x6f\x3d\xa6\x65\x06\x8c\xc4\x11\xd2\xb5\x08\xbe\xa0\x81\x48\xb8\x91\x72\x50\x3d\xa7\x2e\xad\xf3\x28\xf2\x54\xd7\x80\xfa\xea\x51\x73\xd2\x48\x47\xce\x0b\xfc\x92\xa0\x94\xac\x37\x02\xad\x19\xdc\x23\x01\x77\x22\xba\xfa\xcb\xcb\x57\xaf\x7f\xf8\xc7\x5f\x7b\xce\x1b\x75\x03\xa1\xfb\xfb\xdb\x45\x06\xa7\xc5\x06\x9f\x03\x8f\x33\xf1\x71\x30\x6a\x2d\x11\x00\xd4\xcf\xff\x7a\x33\x7e\xf5\xfa\x87\xbf\xa1\x5f\xde\xbe\xfb\xf7\xf8\x22\xd0\x06\xda\xac\x67\x43\xf8\xb1\x4c\x53\x74\xad\x5f\x86\xdb\xfb\xc2\x0e\x7b\x78\x83\xa9\x79\xe9\x58\xa6\x29\x7f\x90\xa3\x36\x85\x94\x74\xd9\x29\x9b\x80\x2d\xa4\x5e\xe5\xc8\x0e\x52\x0e\x1a\xa4\x4d\xc6\xea\xf3\x19\xad\x52\xe3\xe2\x17\xad\xd6\x16\x72\x80\x1c\x19\x65\xbd\x94\xa8\xb6\x34\xb4\x4f\x1f\xc7\xa2\x75\xe4\x49\x19\x4a\xd6\x34\x67\xb0\xd8\x6d\x88\x00\x5e\xe0\x38\xe4\x08\x94\x52\xfb\xbd\x9a\xf8\xb9\x9e\x37\xe0\xf3\x40\xa7\x4f\xba\xba\x78\xbb\xd0\x50\x1a\x3d\x8a\x8f\xf4\x26\x87\xc1\x12\xf3\x60\xf7\xa8\x5d\xd6\xb5\x9a\xf2\x00\x9e\xb1\x35\x58\x64\xc0\xd6\x67\xb8\xe6\xb9\x9c\xec\x1a\x8d\x7c\x87\x6c\xde\xf3\xc6\xc3\xbf\xef\x28\xb0\x80\x53\xda\x3b\xcc\x28\xa1\xeb\xe0\x57\x8a\x09\xa6\x6b\x60\x8b\x3f\x72\x75\x76\x24\xf4\x65\x62\xb5\x8a\x90\x39\x8c\xb4\x8c\xf6\x1b\xc6\x93\x0e\x0a\x11\x59\x1b\x7b\x9e\x4b\x31\xd9\x91\x86\xc0\x51\x27\xce\xd5\x91\x2a\xbe\xc9\x77\xe3\x2c\x4f\x70\x5a\x61\xa5\x6b\xa9\xba\x34\x8f\x5e\xc6\x39\xdd\x02\x13\xfa\x14\xb2\xba\x38\xc4\x11\xcd\xe8\x23\x18\x2a\x75\x1b\xed\x36\x3f\x06\x4a\xdc\xfc\xd8\x4c\x2a\x8e\xcf\xf1\x6c\x6a\xdc\x48\xb2\x4f\xff\x0d\x9f\x2a\x70\xb2\xe1\x6b\x41\x21\x18\xa6\x7c\x05\x2c\x10\x8b\x6a\xf8\x03\x82\xe1\x88\x0c\x45\xa3\x9a\x74\x0a\x1c\x83\x2f\x83\xfd\x8e\xee\x3b\x08\xd2\x87\xd3\x11\xa0\x2e\x42\xca\x1c\x50\xdc\x91\x5b\x72\x1c\x27\xf3\x12\x4d\x1d\x51\x3c\x76\x6e\xc2\x05\xeb\x08\x5a\x6d\xc1\x16\x60\xc3\x88\x59\x13\x43\xdf\x4a\x78\xda\x45\xd8\xa9\xdb\xa7\x4f\x23\x03\x4e\x58\x12\xf5\xe2\x72\x6e\x0a\xf9\xf1\x18\x84\xa3\x17\x09\x4f\xfa\xb4\xfe\x69\x95\xac\xfa\x6a\xf5\x0f\xf3\xff\x0a\x8e\x81\x06\x3a\xd8\x97\xda\x7c\x36\xc3\x69\x8a\xb4\x5f\x15\x43\xf5\x35\x90\xd6\x67\x5c\x76\xe7\x0d\xe9\x04\x2f\x6c\x6b\x2c\x39\xce\x97\x5b\x2d\xbd\xaa\xfe\x9b\x01\xe7\x78\x0d\x6a\xa7\x86\x52\x58\x35\xdf\x79\x1d\xeb\x26\x34\x17\x24\x06\xbe\x78\xd5\x9c\xc7\x77\xfc\x7a\xd1\xe7\x57\x3d\x27\x86\xc2\xcf\x0b\x55\xde\xe9\xb2\x10\x87\xa9\xcd\x1c\x32\x18\x50\xb4\xa5\x11\x0b\x41\x44\x5a\x93\x1d\x73\xec\xcc\xfb\xa9\x41\xe7\xeb\x24\xe7\x1c\x9a\x43\x45\x7b\x38\x93\xfb\x9d\x98\xab\xf6\x89\x1f\x81\x39\xc4\x37\xf0\x73\x2c\xf7\x5c\xa5\x5d\xaa\x9c\xaf\x06\x95\xcd\x6a\xdb\xaf\x61\xa8\x9f\xad\xb5\xf7\x0e\x98\xc6\x90\x5a\x2c\xd8\x77\x62\x5f\x8d\x51\x0a\x5b\xe9\xdc\x29\x70\xcd\xf9\xf7\x00\x02\xb6\x88\x73\xba\x22\x2c\xeb\x14\x2e\x2b\x5a\x6b\x22\xdd\x2a\x08\x56\x19\x3f\x96\xdb\x5e\x46\x72\x56\x72\x5b\xcc\xe2\x69\xb3\xbb\x0e\x74\x4f\x7a\xa3\xd9\x92\x9d\x3c\xff\xf5\x37\x59\x1e\x6a\xff\x7e\xaf\x0f\x17\xd5\x07\x5c\x8a\x3c\xce\xb3\x42\x76\xe4\x79\x94\xaf\x56\x3d\x25\xe3\x74\xb5\x29\xec\x16\xb9\xec\xb8\x7d\xba\x77\x7d\xaf\x86\x0f\x59\xd2\x92\x69\xcc\x69\x5f\x0d\xb5\xe9\x19\x95\xc1\x0c\xdf\xaa\x97\x97\xfe\x0d\x50\x40\x11\x3c\x5e\xfb\x3c\x34\xf2\xac\xca\x57\x13\xc2\xa7\xad\x7b\x86\x54\x7a\xaa\x5e\x40\xd1\x6b\x4d\x6e\x95\xbc\x2e\x5f\xb4\xbe\x52\x3c\x59\xb8\xfa\x4a\xf1\x55\x67\x85\x2e\xaf\xfd\xba\x05\xd6\xc0\xf8\xbd\xbc\x3e\x49\x79\x7d\x46\xa5\xc8\x30\xb1\xbe\x1d\x7e\x50\x2d\x3a\xf2\x38\x24\xb0\x54\xd9\x8f\x23\xce\x2a\x55\x4a\xc0\x73\xac\x57\xf6\x03\x0d\x4b\x42\x3b\x5f\xbe\x45\xe2\x66\x61\xf7\xbd\xb8\x7c\x2f\x2e\x0f\x5e\x5c\xea\x56\x5a\xef\xfa\x3a\x7f\xf1\x66\x95\xe7\xa2\xfa\xdb\x3c\xff\x0b\x00\x00\xff\xff\x8d\x2b\x10\x3f\xff\x47\x00\x00"
+var _repoSettingsOptionsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x5d\xeb\x73\xdb\xb8\x76\xff\x6c\xff\x15\x28\xe3\x0f\xc9\x74\x25\x25\xd9\xbb\x9d\x36\x23\xa9\x93\x9b\x47\xaf\x3b\xce\x5d\x8f\xed\xbb\x99\x69\xb7\xc3\x81\x48\x48\xc2\x98\x22\xb9\x00\x28\xd9\xab\xf2\x7f\xef\xe0\x41\x12\x20\x41\x12\x94\xe4\x3c\x3a\xd9\x2f\x8e\xc8\x83\x83\xf3\xfc\xe1\xe0\xc5\xdd\xef\x19\xda\xa4\x11\x64\x08\x78\x0b\x48\xd1\x64\x8d\x60\xe8\x81\x71\x9e\x9f\x4f\x43\xbc\x05\x41\x04\x29\x9d\x79\x04\xa5\x09\xc5\x2c\x21\x8f\x80\x22\xc6\x70\xbc\xa2\x20\x49\x19\x4e\x62\xea\xcd\xcf\xcf\x74\x2e\x9c\x54\x70\x41\x44\xf2\x39\xd3\x19\x65\x18\x04\x49\xcc\x20\x8e\x11\xe1\x2d\xeb\x2f\x57\x04\x87\xe2\x79\x93\x67\xd1\xf1\x24\x86\xdb\x05\x2c\x98\x9b\x1c\xd8\x0e\x45\x5b\x04\x76\x38\x44\x20\x48\xa2\x6c\x13\x8b\xee\x50\xcc\x24\xd3\xb3\x86\xbe\x30\x42\x84\x95\xbc\xea\xe2\xb0\x24\x05\x90\x31\x18\xac\x51\x08\x94\x52\x92\xd1\xd9\x7e\x3f\xc6\xaf\xfe\x35\x1e\xdf\x11\x29\xdf\xb8\x90\x6f\xbc\x80\x14\x07\x7e\xf1\xd3\x2b\x38\x4f\x42\xbc\x9d\xdb\x3a\x29\x3b\xa0\x68\xb5\xa9\x44\x3d\x9b\x2e\x13\xb2\xd1\xe8\xf8\x4f\x0f\xc0\x80\x9b\x7d\xe6\xed\xf7\xe3\x2b\x1c\xdf\xe7\xb9\x07\x36\x88\xad\x93\x70\xe6\x5d\xff\x7a\x7b\x57\x34\xe6\xf2\xbd\xbb\xbd\xf9\x78\x97\xdc\xa3\xf8\x6f\x77\x9f\xae\x94\x18\x67\x67\x53\x1c\xa7\x19\x03\xec\x31\x45\x33\x6f\x8d\xc3\x10\xc5\x1e\x88\xe1\x06\xcd\x3c\xc9\xdb\x03\x5b\x18\x65\x68\xe6\x65\x69\x08\x19\x2a\x39\x9a\x01\xf1\x47\x86\x09\x0a\xc1\x12\xa3\x28\x04\xfb\x3d\x5e\x82\xf1\x07\x42\xfc\x1b\x94\x26\x7f\x87\x1b\x94\xe7\x88\x90\x84\xec\xf7\x28\x0e\xf3\xbc\xe4\x71\x36\x8d\xe0\x02\x45\x5c\x17\x19\x55\x3e\xef\xd9\x9b\x37\xac\x59\xbd\xcb\xf3\x29\x4d\x61\x5c\xba\x18\x3d\x30\xc0\x7b\x5e\xe3\x10\x79\x00\x87\x92\xcf\x88\xd3\x8e\x82\x35\x8c\x57\x68\x94\x92\x64\x93\x32\x6f\x0e\xda\x9d\x24\x29\x7d\xfe\x90\xb7\xf4\x55\x93\x3c\x9f\x4e\x78\x6f\xf3\xe9\x44\x08\x5a\xc9\x2d\xad\x56\x74\x27\x45\x53\x66\xd3\x1e\x28\xcb\xed\xf7\xe3\x9b\x32\x65\xc6\xd2\x1e\x1e\x08\x21\x83\xa3\x52\xd8\x36\xaa\xc2\xb4\xa5\xd9\xab\xc0\x31\x7d\x50\x37\xfd\x7b\x44\x03\x82\x45\x56\xba\x58\x3f\xac\xc8\xb9\xfd\x2f\x6c\x0e\xe0\x34\xc2\x26\x35\x63\x70\x27\x40\x82\x60\x21\x0a\xcc\x58\x42\xf1\x9f\xca\x1f\x3a\x67\x65\x22\xe3\x11\x49\x76\x74\xe6\xfd\xec\xcd\x4d\x03\x18\xf2\x4f\x27\x45\x1f\x55\xaf\x69\xd1\xdd\x1a\x45\xa9\x37\x6f\x77\xae\xd6\x5b\xa5\x42\x7a\x24\xa3\x08\xc5\x2b\xb6\xf6\xf2\xfc\x0d\x90\x01\x59\xa8\x7a\x25\x5f\xcc\xcb\xc8\x49\x07\xbb\xee\x33\x5a\x50\xcc\x9c\x92\x66\x27\x49\xbb\xa4\x16\xef\xf3\xbc\x23\x84\x0b\x26\xca\x3b\xe5\x4f\x09\x0a\x19\x89\x5a\x22\xb9\x94\xd3\xab\xa9\x58\x82\x0e\x5e\x82\x38\x61\x40\x6f\x74\x49\x3f\x26\xe4\xbe\xc4\x1f\xc3\x10\x38\x8e\x70\x8c\x24\x8a\x54\xfa\x2a\x85\x9b\x2a\x6e\x31\xc5\x0b\x1c\x61\xf6\x68\xd1\xaf\x31\xd0\xac\x51\x70\xbf\x48\x1e\x34\xbe\x52\xc0\xf1\x25\xfd\xad\x64\x74\x95\x04\xf7\x28\xac\xa4\xab\x0c\x25\x6d\x93\x12\xbc\x85\x95\x6d\x0a\xc0\x54\xe6\xe1\xb9\xd3\x6c\x29\x49\xcb\xfe\xa5\x24\x28\x04\x21\xa6\x70\x11\xa1\xd0\x10\x08\x45\x14\x39\x77\x5f\xf1\x94\x9a\x18\x66\xbe\x96\xb4\x79\xae\xfa\x53\x71\x64\xf6\x16\x1b\xba\x76\x1a\x9a\x9b\xc7\xe7\x29\xc2\x87\xf2\xff\x05\xb7\x70\x89\xf2\xbc\xd9\xf1\xdf\xb3\x0d\x77\x30\x6d\x01\x6a\xaf\x8b\xfb\x32\x21\xf7\x45\x17\x25\xf6\x2a\x29\x9b\xfe\xd5\xd3\xc9\xcd\x97\xee\xa9\x5e\x85\x16\x17\x6a\x21\xdc\xcc\xff\x15\xf0\xb1\x41\xfa\xc0\x44\x91\xba\x31\x0d\xe9\x8a\x77\x6d\xb9\xaf\xe5\xf6\x22\x63\x2c\x89\x8d\x1a\x08\xa1\x18\xc8\xc7\x36\x64\x2e\x45\x96\xe3\xb3\x5e\x69\x4c\x27\xb2\x99\x0d\x80\xa6\x13\x5e\x40\x14\xa9\xea\x92\xa9\xb5\x7c\x0a\xf1\x16\x8b\x02\xc8\xcc\xf9\xe3\xca\x94\xf6\x3a\x65\x40\xa1\x42\x79\xf9\x94\x45\x68\xa4\xe1\xc3\x7c\x30\xdc\x48\x7c\xd5\xe2\x00\x32\x6f\x2e\x83\xcc\x9e\x66\x4e\xc1\x54\xc8\xe6\xa7\xd9\x22\xc2\x7c\x28\x2a\x52\x7e\x60\xf3\x32\x08\x5b\xd3\xa3\xc2\x77\x53\x09\x65\xb4\xda\x43\x69\x57\x1e\x41\x0c\x6f\xd0\x28\x4a\x02\x18\x79\x60\x83\xa5\xd3\xaa\xa4\xfa\x84\xe3\x3b\x2c\x4b\x93\x72\x4c\xd8\x61\xb6\x06\x1a\xcd\xad\x92\x52\x28\x55\xfc\x08\xc7\x1f\x13\xb2\x81\x0c\x78\xaf\x5f\xbe\xfc\x97\xd1\xcb\x57\xa3\x97\xaf\xef\x5e\xfd\xf2\xe6\xe5\x5f\x2a\x25\x9a\xe5\xce\xd9\x99\x81\x22\x03\xd2\xb6\x34\x95\x18\xf2\xc1\x98\x4b\xfd\x5f\x49\x8c\x4a\x5c\xb1\x66\x6a\x57\x6e\x1e\x93\x9c\x16\xc1\x2c\x09\x5a\x2b\x11\x54\x8a\x5a\x20\xc4\x29\x17\x1d\x53\x71\x02\xb7\x90\x41\x52\x65\x64\x9a\x50\xe6\x01\x14\x07\x32\x26\x36\x59\xc4\x70\x0a\x09\x13\xe2\x8c\x78\xd9\xea\x30\xad\xe8\x4d\x33\x3d\xcb\x94\x04\x86\x63\xb5\xda\x3c\x49\x28\xf2\x63\xb4\xf3\x15\x5d\x6b\x31\xa3\xe0\x40\xe9\x23\xc5\x5f\xe2\x08\x79\xc0\xad\x06\x3b\x10\x87\xeb\x10\xac\x89\x59\xf7\x2f\xd4\x38\xf2\x69\x8b\xea\x26\x44\x11\x62\x68\x24\x2d\xaf\x26\x06\x7f\x64\x88\xb2\x51\x46\xa2\xa6\xaf\x26\x92\x5e\x91\x86\x49\x8c\x6a\x74\x6d\x02\xca\x76\x7e\x90\x11\x82\x62\xa6\x0b\x0a\xbb\x06\x89\x7a\x69\x67\x81\xc1\x4f\x98\xd7\xaa\x85\xff\xdd\x27\xce\x1d\x33\xe7\x8d\x60\xd9\x98\x3a\x9b\x12\xba\x4d\x9e\x5b\x72\xe1\xe9\xc6\x20\x29\x7b\xff\xb0\xa3\x55\xfd\x1f\x62\x5e\x0d\x5e\x93\x2c\x6e\xad\xfc\x01\x68\xab\xd0\x94\xad\x52\xde\xfa\x80\x62\x18\xa8\xff\xb4\x41\x03\x09\x71\x14\x47\xa5\xa4\xf9\xcc\x5e\x83\xca\x40\x30\x94\xb1\x16\xa0\x65\x97\x0e\x1a\xb5\xcd\x3c\xeb\xd8\xdd\x8a\xe4\x2d\x26\xbf\x8c\x19\x22\x5b\x18\xb5\xd9\xdb\x00\x29\xac\x88\xbd\x56\x59\x4b\x8a\xee\xe1\xb8\x24\x53\x56\xad\x7e\x4b\x8b\xc6\xd9\x66\x81\x48\x19\x4a\xfb\xbd\xb2\x69\x25\xad\x77\xc8\xe0\xa5\xa9\xa2\xe4\x85\x61\x48\x10\xa5\xed\x0a\x15\x04\xdd\xfa\xd4\x88\x95\x56\xf5\xa7\x75\x6d\xf8\xfc\xe1\xad\x7c\xd9\x32\xf0\xf7\x15\xeb\x66\x0f\xd6\xa9\x7d\xab\x75\xd8\x2e\x91\xd1\x40\x3d\x7b\x92\xd4\xcd\x67\xb3\x5f\x46\x11\x69\x59\xb0\xaa\x53\x58\x2c\x68\x31\x61\x49\x6e\xda\xb0\x7a\x9c\x46\x30\x40\xeb\x24\x0a\x11\x99\x79\x7d\x9d\xfa\x1a\xb5\xc7\x6d\xcc\xd7\x65\x82\x64\x93\xf2\x31\x60\xe6\x25\xcb\xa5\xd7\x3a\x9b\x1a\x6a\x8a\x14\x52\xba\x4b\x48\xd8\x6e\x8a\x92\xc2\xcd\x14\x25\xb9\x69\x8a\xea\xb1\xcc\x95\xea\xb7\x93\x69\x0a\xf2\x1e\xd3\xc4\x68\x37\xaa\x34\x72\x42\x1b\x1d\xfc\xc6\xff\x50\x1e\xc8\x73\xab\x41\x5b\xe6\x1d\x2e\x2b\x16\x16\x4b\x05\x11\x82\xc4\x0f\x08\x0a\x51\xcc\x30\x8c\xea\x19\x68\x79\x5f\x43\x6e\x83\x7f\x37\x1c\x37\x99\x59\xdd\x59\x0b\xa6\x9a\xa5\x8c\xc9\xf0\x53\x55\xdc\xfd\xd3\x61\x7b\xb5\x7d\x82\xd9\xee\x53\x97\x15\x23\xfa\x18\x07\x07\x4c\x69\x5b\xbd\x1a\x41\xca\x7c\xce\x14\x59\xd3\x73\xaa\xd6\x61\x8a\xf0\xbe\x82\x94\xdd\x3e\xc6\xc1\x49\xa7\x52\x8b\x28\x43\x2e\x7e\xe5\x62\xfa\xaa\xbc\x1a\x34\x83\xd2\xde\x18\x21\x78\x8a\x4d\x9e\x94\x24\x0c\x09\x57\xf9\x34\xdb\x6c\x20\x79\xf4\x5a\x4a\x61\x82\x57\x6b\xad\x2c\x85\x86\x05\x60\x70\x0f\x18\x8e\x1f\x0b\x3b\x80\x35\x41\xcb\x72\xd5\x55\x4d\x01\x8a\x4e\x27\x0b\x02\xe3\x60\x8d\x68\xd7\x84\xb8\xa4\xd1\x6b\x7c\x7d\xf3\xa9\x7f\x1f\x8a\xf1\x4a\xae\x5e\x50\x4b\xb8\xbb\x2e\xd5\xbe\x15\x5a\x63\x44\xab\x19\xa0\x6c\x57\x31\xdc\x22\xf2\x08\xc4\x6e\x18\xa0\x8c\xe0\xb4\x60\xad\x05\x32\xe3\xf6\xd6\x82\x84\x91\xea\x07\x7f\x3b\x1f\xe2\x01\xa5\xbb\xd0\x9c\xad\x8f\x61\xa4\x6a\x13\x3f\xcd\xa2\xc8\x57\x13\xb3\x13\xb2\xe5\x19\x0b\x89\xbf\xc6\x94\xcf\xa5\x4e\xc8\x38\x48\x42\xe4\x27\xbb\x18\x11\xea\xc3\x34\x25\x49\x51\x9b\x1e\xc7\x7e\xb7\xc6\x0c\x45\xd8\x62\x83\xe9\x44\xf3\xd8\x74\x62\xba\x73\xca\x16\x49\xf8\xa8\x2f\x98\x12\xbe\xe9\xd6\x1d\x45\x8d\x28\x38\x9b\xb2\x50\xff\x59\xae\xfb\x5e\x43\xc6\x10\x89\xf5\x96\x32\xc1\x8a\x1c\xba\xe8\x4c\xa2\x49\x2a\xdb\xff\xbb\xc0\xdd\xfd\x7e\xfc\x57\xf1\x42\x4e\xa5\xcb\x1f\x55\x0e\xd9\x56\xa7\x32\xac\xe2\x5b\xe4\xb0\x00\xd1\x4e\x28\x53\xb6\x45\xa1\x2f\xa5\xf0\x95\x0c\x5e\x03\x58\x5b\xf6\x06\x86\xe8\xb7\xdf\x7f\xa0\x01\x4c\xd1\x75\x92\xc5\x21\x70\x53\xaf\xb1\x41\x50\x99\xfb\x3d\x5a\xc2\x2c\x62\x79\x7e\x0a\x0b\xe8\xd1\x15\x4a\xc6\x8d\xf5\x7f\x3d\x04\x26\x2c\xac\x87\x44\xb1\x24\x21\x22\xff\x3a\x8b\xa2\x1b\x99\xa7\x79\x3e\xc5\x85\x74\x49\xc0\x70\x90\xc4\x40\xfd\x1d\x89\x02\x88\x0f\xe9\x78\x5e\x18\xb7\x9d\xf8\xa1\x24\x94\xcb\xad\x3d\x22\x5c\x89\xa4\xfe\x9b\xcc\xe9\xaf\x25\xc4\xbb\x24\x44\xbf\x8a\xfc\x7f\xab\xd2\xff\x0b\x4a\xd2\x8c\x1a\xb9\x28\xf0\xb9\x80\x8f\x5a\x28\x0f\x0b\x93\x12\x84\x7c\xb9\xd3\xcc\xf7\x9d\x4a\xce\xbc\xfe\xa6\xe6\xa3\x3b\x04\x37\xb4\x1e\xca\x96\x84\x1a\x26\x45\x9c\xf8\x3a\x1a\x76\xe7\x4e\xcd\x48\x06\x58\x36\xb7\x8f\x0c\xb4\x9c\x4e\xc4\x50\x39\x3f\xb7\x09\x5e\x1b\xb8\xcb\x91\x7a\x10\xa8\xc7\x89\x5a\x38\xaa\xca\x27\x5d\x24\xfb\xea\xdf\x0d\x0a\x31\x41\x01\xa3\x27\x5d\xf7\x23\x05\xd7\xe3\x56\xfc\xd2\xb9\x43\x17\x96\x55\x83\x83\x0a\x97\x96\xa1\xcd\x6a\xa4\x96\x41\x6d\xca\x87\x6b\x01\x96\x22\x65\xd5\x09\x90\x09\x5f\xd3\x4d\x76\x88\xc8\x9f\xd3\x89\xa0\xb2\xe4\x9b\xb1\xd1\xba\x22\xe8\xb1\x13\x78\x0b\x03\xf0\xa9\x1c\x64\x28\xf4\xc0\x73\xbe\x41\x72\x8b\xe3\x00\x81\xf1\x3b\xf9\x10\x5c\x8c\xaf\x60\xbc\x7a\x51\xee\xf6\x76\x74\x2b\xea\x5a\x00\x23\xbc\x8a\x91\x31\xa9\xed\xdf\x87\xb8\xe8\xd9\x13\x2c\xf3\xb2\x65\xfa\x34\x70\x0e\xa5\xd6\xda\x0b\x0b\x78\x73\x57\x3e\x38\xd4\x57\xb1\x2e\xdf\xe7\x79\xbd\x6d\x63\x52\xc3\x97\xf8\xf5\x82\xbe\xcb\x25\x6a\x6d\xbe\x94\xcb\x32\xb9\x69\xcc\x69\x4e\x8b\x2b\x4f\x39\x43\x82\xe1\x16\xf2\xf9\xe5\x93\x9d\x84\x7b\xaa\x63\x6f\x85\xe0\x5e\x35\xe9\xff\xa7\xd1\x08\x7c\xc6\xf7\x18\x8c\x46\xf3\x81\xfb\x5d\x4d\x4c\xda\xe1\x7b\x6c\xdb\xd1\xea\x5d\x0b\x52\x2a\x28\x1a\xb9\x34\x3f\xa2\x8f\x94\xa1\x4d\x73\x6d\xde\x58\xbf\x17\x5d\xca\xbd\x23\x06\xc9\x0a\xb1\x99\xf7\x8c\x3f\xf3\xed\x47\x49\xd4\xb0\x8d\xef\x71\xfb\x59\x92\x36\xed\x4a\xff\x0b\xfe\x6d\x07\xc8\xcc\x39\x7b\xcb\x0e\x5d\x35\xb4\xe9\xeb\xf8\xf5\x43\x0b\xba\xb0\xc5\x01\x9b\x72\x77\x59\x1c\x76\x2a\x14\x75\x5b\xa7\xa8\xcd\xde\x61\x88\x13\x9b\x33\x6a\xde\x90\x11\x05\x0c\xa7\x8c\x44\x63\x8f\x8f\x1d\x38\x0e\xd1\xc3\xcc\x7b\x59\xf3\x0b\x7a\xe0\xd5\x3f\x8c\x94\x83\xa4\x0f\x55\x2b\x15\x8d\x4b\x18\xd1\x62\xdf\x4f\xb6\x9a\x79\xcf\x70\xac\xb5\x93\x4e\x14\x04\x4a\xff\x99\xf7\x0c\x3d\x34\x28\x3a\xac\xf7\x41\x51\xdb\x5c\x3e\x99\x9f\x9f\x39\x3b\x3d\xa3\xc8\x37\x84\x3b\x66\x0f\x67\x91\x3c\x18\x1b\x38\x3d\x62\x5b\x9d\xdf\xb4\xd4\xa0\xf3\x62\xe6\x36\x73\x14\x25\x3b\x75\x8e\xc3\xf0\x58\xc7\xa9\xac\xb7\xbc\xcd\xb5\x68\xd2\x9d\x4f\xfd\xb6\x6d\x74\x3f\x6c\x93\xec\x7b\x0c\x7e\x46\xb2\x46\xec\x5b\x22\xbb\x16\xfb\x96\xec\x70\x0a\xa0\x23\xe3\xde\xd4\xe7\x74\x71\xef\x92\xb2\xd6\xd8\x6f\x5a\xca\xbe\x39\x68\xd2\xf1\xd3\x9f\x1d\x9a\x36\x89\xbb\xf7\x0a\x9b\xf4\x45\x14\x34\x5f\xf4\x1d\x3f\xd5\x75\xfe\xc7\xcd\x95\xb9\x71\xeb\x7c\xd2\xaf\xd1\x71\xef\x46\x62\x6d\x0b\x80\x57\x03\x97\x94\x66\x88\x9e\xa4\x1e\xc0\x82\xd5\xe9\x2b\x02\x23\xd9\x54\x27\x0d\xc0\x32\x6b\x02\x41\xd5\x99\x31\x52\xef\x23\xca\x02\x29\xc8\x97\x2b\x0c\x0a\x81\xed\xa3\x43\xa9\xef\x37\x5c\x1b\x30\x02\x83\x7b\x44\x0e\x29\x0f\x34\x7f\xb6\xd5\x07\x75\x97\x77\xa3\xcd\x9d\x94\xe5\x74\x35\x82\xec\xbe\x50\xf1\x4b\x14\x0b\xa5\x0a\xdd\xf5\x82\x25\x34\x8e\x2b\x18\xda\x32\xb0\xab\x64\xe8\xcb\xb6\x81\x45\x43\x77\xee\x1d\x7c\x22\x20\xc8\x28\x4b\x36\x7e\x10\xf1\x53\x7b\x04\x41\x2a\xae\x85\xb5\xcb\x64\xa5\xb7\xee\x2a\x77\xdf\x33\xb1\xf2\x51\xa6\xb7\xbf\x93\x37\x4f\x5e\xd7\x6f\x9e\xbc\x13\xc4\xef\x38\xed\x8d\x24\xb5\x5e\x40\x19\x32\xc8\xd8\xfa\xb7\x8d\x33\xdf\x5b\xad\xd6\x09\x46\x9d\xe5\x5a\x3b\x16\xd9\xe0\xca\x35\x89\x4f\x55\xb3\x3d\x15\x0e\x39\xc2\x69\x77\xfd\xd6\x87\x45\x9d\xd9\x59\x77\x9d\x73\x81\xa7\xd3\xf7\x1c\xe1\xb1\xb6\xa9\xd7\x79\xc6\x3b\xd7\x52\x4f\x59\xa8\x5e\xed\x1d\x52\xee\x69\xfd\x3b\x64\xa2\xbb\x81\x75\xbe\x4b\x71\xe2\xbd\x4b\x1c\x0b\x75\x8f\x71\x2d\x2d\x94\x69\x6d\x6f\x06\x1a\x56\x1e\xd1\xe7\x11\x67\x1c\xa7\x42\xe3\xd5\x18\xac\x19\x4b\xe9\x9b\xc9\x64\x85\xd9\x3a\x5b\x8c\x83\x64\x33\xd9\xf3\xa3\x66\xf9\x64\xcf\x75\xca\x27\x72\x3c\x99\xec\x05\x68\x1c\xe8\x9e\xa6\x0e\xd2\x39\x7c\x09\x9a\x91\xd7\x72\x0d\xcf\xd5\x4f\x7a\xe1\x4d\xdb\xfc\x25\xb3\x89\xb2\xc7\x08\xb9\x08\xa6\x93\x5b\x3d\xd5\x15\x27\xee\x28\x6d\xc7\x69\x2b\x26\xdb\x24\xb3\xe2\x71\x9c\x6d\x10\xc1\x81\x07\x24\x16\xa1\x3f\x40\x47\x24\xdc\x72\x3e\xa0\x6c\x53\x42\xeb\xcc\xf3\x2c\xe8\xea\x80\xaf\x16\x31\xc7\x15\x77\x50\xdf\x46\x8e\xc4\x7e\x02\xdf\xc7\x00\x7c\xe4\xf5\xe6\xcf\x9f\xbd\x7a\xfd\xf3\x5f\x5e\xb4\x5c\xc8\x6d\x06\x42\xf3\xf7\xb7\xeb\x19\x18\xa5\x6b\x78\x88\x7b\x8c\x86\x4f\xe3\xa3\x5a\x17\x0e\x8e\x7a\xfb\xd7\x77\xa3\x57\xaf\x7f\xfe\x09\xbc\xff\xf0\xf1\x3f\x46\xff\x6f\x9d\x46\xd0\x0a\x3d\xa4\x83\xdc\xa5\x9a\x3c\x8d\xa3\x4a\xe6\xc3\xcd\x7c\xec\x58\x27\xc5\x50\x02\x38\x8b\xdd\x21\xb0\x65\xbc\x33\xda\x58\x7d\x55\xbc\x73\x19\xe8\x6e\x04\xad\x7d\xa0\xfb\x7d\xf1\xfc\xfa\xe6\xd7\xff\x1c\xfd\xf7\xcb\xd1\xbf\xfd\xcf\x3f\xbf\xf8\x7d\x71\xdc\x50\xa6\x0b\xe7\x5e\xf3\xb7\x2e\x35\xdd\xa0\x08\x41\x7a\xa2\xc5\x26\xa2\x98\x1d\xb3\xdc\x64\x99\xdc\x96\x6c\x07\x4d\x6f\x0b\xcd\x8e\x58\x4e\xb2\x4a\x31\x64\x75\x49\xb7\x34\x3f\x45\x04\xd4\x31\x22\xdd\xdc\x0d\x1d\xde\xc1\x58\xdd\x77\xc9\xa2\x88\x9e\xe4\x6a\x3b\x3f\x6a\x48\x0f\xbc\xd5\xee\xbe\x0c\x28\x7b\xe9\x59\x05\xe4\x44\x9d\xb3\x30\xa5\xf5\xe1\xab\x12\x42\x8c\xa3\xef\xf8\x68\xcb\x80\x1d\x73\x2d\x25\xac\x75\x86\x55\x6a\xea\x0a\x85\xee\xe7\xf5\xd5\xf5\x7d\xa1\x28\x5e\xc5\x09\x41\xf2\xe0\x10\x4d\x61\xe0\x72\x9d\x5f\x88\x7d\x29\x1a\x7e\x2e\xdb\x75\xd8\xdc\xd1\xe8\xe3\xa6\x2c\x83\xcf\xf3\x3f\x89\x8d\x64\x22\x13\xb4\x80\xd4\xd9\x3c\x02\x4a\x6e\x44\x93\x13\x58\x46\x97\xc0\xdf\x20\xb2\x3a\xbd\x69\xf4\x6b\x34\xbc\x03\x7f\x83\x28\x85\x2b\xe4\x17\x5f\x25\xf2\x7a\xa5\x6c\x69\x67\x93\x54\xdd\x50\xe1\x0d\x3e\x49\xfa\x3b\x45\xfe\x41\xbf\xba\x69\xf3\xda\x0e\x92\x18\xc7\x2b\xa0\xfa\xa9\x55\x56\xe9\x81\x52\xfa\x38\xde\xc2\x08\x87\x5e\xa7\x54\xc6\x50\xd9\x30\xb0\xe5\x1b\x16\xdd\xab\x84\x2d\x06\x2b\xae\xcc\xb4\xbc\x2d\xbe\x51\x63\x56\x0b\x17\x7b\xed\xa0\xe9\x1d\x66\x11\xca\xc1\xf3\x67\xc6\xd3\x4b\x31\x17\x7e\x51\x5f\x62\xb4\xe9\x7b\xf4\x22\x63\xa7\xad\x15\xba\x82\xe2\x68\xda\x05\xfe\x09\x5c\x6c\xc1\x9b\x99\x69\xfc\xdf\x20\xc1\x1c\x18\x29\xbf\x93\x8f\x97\xe0\x02\xe7\xf9\x4f\x40\x19\x59\x9e\x50\xbb\xd8\xef\xbd\xbd\xb8\xb3\x7f\xb1\xcd\xcb\x13\x69\x05\xc9\xc1\x4b\x28\x43\x71\xa2\xa6\x27\x81\x38\x42\xc4\xa5\xe2\x30\x6c\xaf\x9a\x9d\x00\x2f\x5a\xe4\x19\x8c\x19\xdf\xcb\x87\x43\xec\xa7\x42\x2f\x69\x65\x69\x71\x8c\xd1\xe1\x4b\x6a\x05\xba\xb8\x1e\x25\x0b\x79\x00\x13\xff\x4f\x79\x62\xd5\xf5\x10\x59\xd1\x8b\x3c\xdd\x29\x79\xd8\x6f\xb5\x38\xde\x6b\xc7\xbc\x9e\x6a\xd9\x8f\x34\x6f\xf9\xd8\x6e\x3b\x89\xe3\xa4\x04\x85\x80\xae\x93\xdd\x68\x93\x84\x30\x2a\x7c\x25\xeb\x2f\xf1\x68\xe6\x3d\x0b\x92\x78\x8b\x08\x93\x5f\x0a\x13\x0f\x3b\x37\x1a\x24\x75\x8f\x0f\xcf\xa6\xda\xbf\xcf\xa6\xeb\x5f\x1c\x39\xae\x7f\x99\x9f\xbb\xa0\xbe\x6a\x63\x3b\x5c\x6b\x44\x92\xfe\xb1\x8a\xee\xfb\x76\x4d\xac\xff\x0a\xae\x60\x04\xc6\x74\x89\x88\xa3\x2f\x0a\xf2\x13\x3a\xc3\x60\xe9\xea\x8d\xa2\xd1\x10\x77\x74\x1e\x02\x1c\x76\x45\xb2\xcd\x4f\x3d\x8e\x3a\xca\x53\xea\x8c\x2f\x3f\xd2\xd1\xef\x27\x75\x78\x8a\xb7\xe8\xbd\x51\x68\x3a\xab\xc7\x5b\x75\xc6\x9a\xc3\xba\x3d\xa6\x35\x74\x3d\x8d\x62\x19\x2e\x9c\xfc\xf3\x15\xd2\xa8\x3c\x80\xed\x92\x44\xad\x7e\x39\x34\x85\xec\xfe\x98\xa6\xbd\x4d\xdc\xd2\xa7\xe5\x1c\xb5\xfe\xb4\xf8\x87\xfa\xab\xfe\x9c\x77\x0c\xa0\x9d\xe3\x52\x7d\x0e\xbc\x81\x51\x04\xa4\x5d\xe5\xbe\xb8\x65\x00\xa9\x7d\x6a\x55\x1f\x79\x5d\x46\x82\x73\x5d\x1b\x8d\x8f\xf1\x75\xd5\xee\x39\x84\x58\x2c\x06\x11\x5a\x56\xdf\x62\xed\x1b\x4d\xe2\x84\xe1\x00\x51\xff\x55\xf5\xf9\x39\xc3\xae\x47\x7d\x7b\xac\xe5\xa4\xb8\xfb\x39\xf1\xc2\x3a\xf3\xf3\xce\x4a\x6d\x6a\x14\x83\x0e\xa0\xcd\x95\xf0\x19\x9f\x5c\x54\x17\x8c\xda\xbf\xac\xd7\xf8\x82\xa8\x71\x91\xd0\x28\x45\x5b\x6a\x26\xf3\x5b\xae\xa6\xd8\x03\x3f\xd4\xaa\xf7\xe6\xfa\xc9\x54\xf3\x3b\x20\x3a\x54\xe9\x42\x4a\x9d\xe5\x95\x93\xa0\xfc\xf0\xb0\x6d\x1e\x01\xe3\x00\x45\x5a\x15\x6c\xfb\xc0\x94\xa0\xa9\x5d\x7e\xb2\xdf\xe5\xb0\xf1\xb1\x07\x6b\x90\xc4\x4b\x4c\x36\x0d\xe0\xd2\xa2\xb5\x2c\xa4\x6b\x80\xa0\xc1\x78\x5f\x6e\x5b\x2b\x92\x83\x92\x5b\xab\x2c\xbe\x6c\x76\x97\x81\x6e\x49\x6f\x30\x5d\x90\xc1\xed\x5f\x7f\x93\xf0\x50\xda\xf7\x07\x3e\x1c\x85\x0f\xcd\x6f\xd8\xd8\x21\x63\xb8\xd8\xfc\x03\x73\xe2\xb2\x7c\x9b\xec\x4d\xdb\x0b\xf2\x2e\x4d\x6a\x3c\x95\x3a\xf5\xa7\xae\x3a\x7d\x47\x30\xb8\x81\xf7\xc8\x2f\xec\x74\x08\x08\xf6\x63\x9f\xa5\x8c\x3c\x08\xf9\xca\x82\xf0\xcb\xe2\x9e\x2a\x2a\x2d\xa8\xe7\x00\x7a\xb5\xc6\x35\xc8\x3b\xeb\xfa\x28\xef\x60\xe6\xe2\xa3\xbc\xaf\x1a\x3d\x34\xeb\xda\xaf\x0b\xb0\xca\x8d\x3f\xe0\xf5\x8b\xc0\xeb\xf7\x55\x91\xf1\x4a\xac\x6d\x86\xef\x84\x45\x3d\xcb\x21\x8e\x50\xa5\x2f\x47\x1c\x04\x55\x82\xc1\xf7\x88\x57\xfa\x82\x86\xc6\xa1\x9e\x2f\xdf\x62\xe1\xa6\xf9\xee\x07\xb8\xfc\x00\x97\x93\x83\x4b\x39\x94\x16\x7f\xcf\x1b\xff\x57\x9a\x65\x92\xb0\xe2\xff\x9f\xf3\x7f\x03\x00\x97\x61\x0b\xd1\xa3\x67\x00\x00"
 
 func repoSettingsOptionsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2172,12 +2916,12 @@ func repoSettingsOptionsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/options.tmpl", size: 18431, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x82, 0x9c, 0x2c, 0x1f, 0xde, 0x19, 0xf7, 0xaa, 0x85, 0x5d, 0xbb, 0x98, 0xc2, 0xf3, 0x92, 0xfb, 0x30, 0xad, 0x2c, 0x84, 0x3, 0xbc, 0x21, 0x60, 0xa7, 0xb8, 0x2a, 0x39, 0x2f, 0x66, 0xc1, 0xa8}}
+	info := bindataFileInfo{name: "repo/settings/options.tmpl", size: 26531, mode: os.FileMode(0644), modTime: time.Unix(1792290405, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb2, 0xd, 0x84, 0x92, 0xc7, 0x1f, 0xe4, 0x56, 0x87, 0x32, 0x1b, 0x54, 0xbc, 0x3f, 0xc4, 0x40, 0x7c, 0xb2, 0xd1, 0xe4, 0x3c, 0xe9, 0x20, 0x33, 0x10, 0x17, 0x62, 0xe7, 0xc5, 0xe9, 0x85, 0x19}}
 	return a, nil
 }
 
-var _repoSettingsProtected_branchTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4b\x6f\xe3\x36\x10\x3e\x3b\xbf\x82\x60\x7b\x8d\x8c\x16\x7b\xe8\xc1\x0e\xd0\x27\xba\xc0\xb6\xbb\xc8\xa6\xe8\xd1\xa0\xc5\xb1\x34\x0d\x45\xaa\xe4\xc8\xde\x54\xd5\x7f\x2f\x44\x8a\xb2\x5e\x36\x92\xa0\xed\x9e\x24\x3e\xe6\x9b\x99\xef\x9b\x91\xc8\xba\x26\x28\x4a\x25\x08\x18\xdf\x0b\x07\xeb\x1c\x84\xe4\x2c\x69\x9a\x9b\x8d\xc4\x23\x4b\x95\x70\x6e\xcb\x2d\x94\xc6\x21\x19\xfb\xc4\x1c\x10\xa1\xce\x1c\xdb\x5b\xa1\xd3\x1c\x1c\xbf\xbb\x59\x0d\x61\xda\xbd\x1e\x06\x6c\x00\x5a\x0d\x91\x2a\x64\xa9\xd1\x24\x50\x83\x6d\x2d\xa7\x8b\x99\x45\xe9\xe7\xe7\x98\xd1\xf3\x5a\x8b\xe3\x5e\x44\xf0\x31\x02\x9d\x40\x1d\x81\x9d\x50\x02\x4b\x8d\xaa\x0a\xed\xdd\x81\xa6\x00\xba\x9a\x25\x2c\x14\x58\xea\xb1\x56\x9b\xfc\xcd\x20\x1a\x32\x25\x13\x44\x22\xcd\x41\xb2\x2e\xa7\x80\xb3\xaa\xeb\x04\xbf\xfa\x46\x27\x0f\x36\x84\x97\xc4\xf0\x92\x40\xcc\xae\xb4\x86\x20\x25\x34\x9a\x47\xec\x75\xfe\x26\x58\x4f\x92\xee\x5d\x38\xc8\x0a\xd0\xd4\x71\x7b\x3b\x80\xe8\xbc\x6e\xca\xbb\x17\x38\xde\x49\x70\x29\x67\xc9\x77\x7e\x21\xf9\x55\x14\xc0\xfe\x66\x1f\xc9\x7e\xfd\xf3\xc3\x2f\xef\x9a\x66\xb3\x2e\x23\xee\xc1\xd8\x62\x10\x51\x3b\xe4\x4c\x78\x94\x2d\xaf\xeb\xe4\x1d\xea\xc7\xa6\xe1\xac\x00\xca\x8d\xdc\xf2\xd2\xb8\x48\xa9\xe7\xe2\xfb\x8f\xf7\x3f\x3d\x98\x47\xd0\x01\xb9\x5b\x18\xe6\x89\x5a\xa1\x06\x76\x40\x50\xb2\xb7\x9c\xd5\x46\x0e\xe9\xe3\xde\x7c\x3a\x6f\x58\x6d\x50\x97\x15\xc5\x3d\xa0\xc5\x5e\xc1\x90\x19\xa6\x45\x01\x5b\xde\xcd\x80\xe4\x8c\x9e\x4a\xd8\xf2\x1e\x8a\x49\x41\xe2\x96\x84\xcd\x80\xb6\xfc\x8b\x01\x3d\x7e\xb5\xae\xf1\xd0\x53\xf4\x21\xa2\x34\x8d\xb7\x07\x59\xd7\xa0\x65\xd3\x0c\xe2\x51\x62\x0f\xea\x8a\x0c\x9d\x83\x1d\xe5\xe8\x76\x41\x13\xde\x72\x1d\xec\xce\x38\x65\xcc\x29\x07\x55\xf2\x97\x01\x06\x65\x87\x0a\xb6\xe5\x25\xf1\x18\x47\xe3\x41\x4b\x32\xca\x9e\xa4\x3e\xf7\x2e\x00\xaf\x89\x0b\x4c\x68\x43\x4b\x6c\x48\x74\x2d\xf1\x91\x8e\x65\x01\x27\xda\x3e\x43\xdc\xa8\x6e\xd0\xd0\xc2\x9f\x15\x5a\xd8\x95\x95\x52\xbb\x76\x00\x8e\xe6\x72\x8e\x04\xbb\x0f\x26\x1f\x2a\xa5\xee\x83\xc1\x65\xe5\x9e\x2f\xdd\x62\x20\x0b\x1a\xbe\x42\xc4\x25\xe8\x25\x35\xc7\x0a\x4e\x46\x81\x82\xf7\x27\x0d\x36\x79\xeb\xde\xdb\x4c\x68\xfc\x4b\xb4\xba\xf6\xad\x77\x5d\x99\xe7\x48\xb3\xdc\x79\xa7\x1c\x09\x14\xb6\xc2\x04\xd1\xc2\xfc\x6e\x30\x7f\xbd\xff\xfa\x8d\x0b\xed\xf7\xa3\xc7\xfa\x3d\xee\xb8\x22\xe5\xf3\xb5\x3c\xfb\x4b\x4d\x51\x20\x11\x58\xb7\xa8\xe5\x2b\xc4\x5c\xc2\x5e\x14\x73\xa2\xdf\x6c\x18\x1b\x74\x42\xce\x50\xbd\x79\x7b\xce\xd8\xba\xd8\xa4\x63\xbd\x7b\x27\xd3\x8f\xf1\xeb\x68\xad\xdc\x65\x46\xc7\x55\x56\x54\x8a\xb0\x54\xc0\x1c\x08\x9b\xe6\xcc\x81\x0a\x5f\x23\x26\xad\x29\xa5\x39\xe9\x51\x2c\xb1\x00\x43\x3d\xe5\x28\x25\xf4\x9f\xfb\xa9\x7b\x76\x14\xaa\x02\xff\xab\x9a\x2c\x8d\x79\x18\xc7\x24\xe1\x20\x2a\x45\x8c\xe0\x13\xbd\x4c\xef\x90\xc1\x20\xf7\x91\x9e\x13\x37\x05\xe8\x6a\x1c\xc4\xaa\xae\xad\xd0\x19\xb0\xe4\xb7\x10\xe3\x68\x71\xfc\xdf\x24\x28\xba\x1e\x3a\xe7\xf8\xf6\x87\x69\x5a\x9e\xae\x22\x1b\xb2\x8d\x1a\x19\x16\x22\x03\xce\x9c\x4d\xbd\xdd\x3d\xa8\x6f\x8f\x82\x84\xed\xfe\xe7\x53\x88\xba\xf6\xa7\x84\x59\x3c\xb3\xf4\x56\x5d\x8d\xdd\x5c\xdb\x35\x9d\x98\x8d\xf7\xf6\xff\x29\x51\x02\x51\x7c\xc6\x12\x0d\xee\x17\x4b\xd4\x2f\xfd\xa7\x25\x7a\xce\xfd\xb5\x25\xfa\x10\x62\xfc\x77\x4a\x34\xda\x98\x94\x30\x35\x9a\x75\xcf\xdb\x3f\xc0\x3a\x78\xe2\x77\x9b\x35\x7e\xd6\xa2\x9c\xfe\x68\x87\x80\xdd\xda\xc2\xe1\xb6\x42\x26\xf1\x88\xfe\x7e\x70\x79\xd7\xf4\xec\xbb\xaf\x88\x8c\x1e\xdd\x7e\x00\x34\x0b\xd3\xad\xd8\x5f\x5e\x52\xbb\x2a\xa5\x20\xd8\xc5\xb1\x97\x37\x98\x2d\x1d\x00\x37\xeb\xf6\x3c\xdf\x5d\x3d\xfa\xf9\xfe\x2d\xbe\x74\xcf\xee\x31\xbb\x29\x1d\x8c\xa1\x78\xa7\xfb\x27\x00\x00\xff\xff\x4a\x75\x61\xca\x38\x0e\x00\x00"
+var _repoSettingsProtected_branchTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\xdf\x6f\xdb\x36\x10\x7e\x76\xfe\x0a\x82\xdb\x6b\x65\x6c\xe8\xc3\x1e\x6c\x03\x6d\xb7\xa1\x01\xd2\x35\x48\x33\xec\x51\xa0\xc5\xb3\xc5\x85\x22\x35\xf2\xe4\x34\xd3\xf4\xbf\x0f\x12\x29\x59\xa4\xe4\xcc\xc9\xd2\x75\x2f\xb6\xf8\xe3\xee\xbe\xfb\xbe\xa3\x48\xaa\xae\x11\x8a\x52\x32\x04\x42\xb7\xcc\xc2\x32\x07\xc6\x29\x49\x9a\xe6\x62\xc5\xc5\x81\x64\x92\x59\xbb\xa6\x06\x4a\x6d\x05\x6a\xf3\x40\x2c\x20\x0a\xb5\xb7\x64\x6b\x98\xca\x72\xb0\x74\x73\xb1\x18\xbb\x69\xe7\x76\x6e\xc0\x38\x47\x8b\xb1\xa7\x4a\x90\x4c\x2b\x64\x42\x81\x69\x2d\xe3\xc1\xbd\x11\xbc\xeb\x9f\xfa\xec\x23\x2f\x15\x3b\x6c\x59\xef\x3c\xf4\x80\xf7\x20\x0f\x40\xee\x05\x07\x92\x69\x59\x15\xaa\x0b\x07\x0a\x9d\xd3\xc5\x24\x61\x26\xc1\xe0\xe0\x6b\xb1\xca\x5f\x8f\xd0\xa0\x2e\x09\x43\x64\x59\x0e\x9c\xf8\x9c\x9c\x9f\x45\x5d\x27\xe2\xbb\x1f\x54\x72\x6b\x1c\xbc\xa4\x87\x97\x38\x62\xd2\xd2\x68\x84\x0c\x85\x56\xb4\xf7\xbd\xcc\x5f\x3b\xeb\x28\xe9\x21\x84\x85\x7d\x01\x0a\x3d\xb7\xaf\x46\x2e\x7c\xd4\x55\xb9\x79\x42\xe0\x94\x83\xcd\x28\x49\xde\x76\x03\xc9\x2f\xac\x00\xf2\x17\xf9\x84\xe6\xfb\xf7\xb7\x1f\xae\x9a\x66\xb5\x2c\x87\x6c\xc4\x6e\x98\x77\x69\xaf\x19\x22\x18\xe5\x71\xb7\x61\x7b\xb4\x39\xc8\xb2\x07\xd3\x9b\x7d\x60\x98\xe5\x42\xed\xdf\xfa\x8a\x18\xcc\x1e\x63\xc9\xa3\x04\x9e\x7a\xd8\x85\xf7\x42\x03\x73\xc3\xd4\x1e\xe6\x42\xac\x32\xcd\xa1\x25\xa3\x4d\xa3\x7b\x26\x75\x0d\x8a\x1f\xad\xeb\x1a\xa4\x85\x7f\x07\x26\x55\x5a\x01\x0d\x7c\x8e\x42\x8c\xf9\x6b\x63\x91\x81\x0d\xe0\x13\x0a\x43\xc9\x85\xda\x69\x52\x80\xb5\x6c\x0f\x63\x3e\x9f\x86\x10\x78\x5a\xba\x38\x34\x0e\x1c\x08\x3d\xe0\xe5\xe2\xb0\xb9\x98\x66\xb2\xda\x69\x53\x8c\xd0\xb5\x4d\x4a\x58\x57\x44\x6b\x5a\xd7\xc9\x95\x50\x77\x4d\x43\x49\x01\x98\x6b\xbe\xa6\xa5\xb6\x38\xc0\xae\xeb\xe4\xdd\xa7\x9b\x9f\x6f\xf5\x1d\xa8\x20\xde\xa9\xaa\x5a\x09\x55\x56\x48\xf0\xa1\x84\x35\xcd\x05\xe7\xa0\x28\x51\xac\x80\x35\x6d\x7f\x29\x39\x30\x59\x41\x17\x78\x54\xba\x4d\x43\x37\x11\xff\x23\x4e\x85\x92\x42\x01\xd9\x09\x90\xfc\x48\x68\xfc\xea\xc9\x21\xbb\xdb\xea\xcf\xc7\x09\x0b\x8f\xc5\xcf\x01\xc5\xb6\x12\xc6\x0b\xcf\xe3\x1a\xf8\xa7\x1e\xf6\xe0\x8a\x70\x86\xec\x15\x32\xb3\x07\x5c\xd3\x6f\x46\xab\xaf\x1b\x0d\x38\xb8\xee\xbd\x34\x4d\x67\x0f\xdc\x27\x34\xc2\x23\xd9\x16\xe4\xe6\x1f\x2b\x21\xc5\x5c\x58\x5f\x0c\xb4\x5d\x03\xce\xee\xe8\x27\x5a\xb2\x4f\x72\xe8\x5e\x1c\xe3\x17\x44\x58\x3c\x51\xa3\x25\x59\xf0\x35\x8d\x73\xf7\x00\x3a\x4d\xac\x63\x42\x69\x9c\x63\x83\x0b\xdb\x12\xdf\xd3\x31\x2f\x60\xa4\xed\x19\xe2\xf6\xea\x3a\x0d\x0d\xfc\x51\x09\x03\x69\x59\x49\x99\xb6\x0d\xb0\x38\x95\x33\x10\xec\xc6\x99\x5c\x57\x52\xde\x38\x83\xd3\xca\x9d\x2f\xdd\x2c\x90\x19\x0d\x9f\x21\xe2\x9c\xeb\x39\x35\x43\x05\xe3\xd6\x17\x20\xbd\x5d\x9d\xcc\xa4\xb9\xb0\xed\x31\xe2\x3c\xda\xaf\x3a\x9b\xf7\xce\xe4\x25\x89\x8f\xc0\xbc\x2c\xf5\xa1\xf3\xff\x05\xf9\xed\xfe\x98\xea\x7b\x05\xc6\xa6\xac\x2c\x8d\x3e\x30\x79\x9e\x04\xef\x34\x87\x8f\x9d\xe1\x1b\x6f\xf7\x92\x3a\xcc\xe2\x7a\x59\x35\xe6\x42\x3c\x59\x13\xc7\x4c\xc7\x43\x72\x69\x3f\x9a\x3d\x53\xe2\x4f\xd6\xbe\xe8\x9a\x66\x56\x9c\x58\xb8\x73\x94\x9b\xdf\x8a\xee\x73\x81\x20\x45\xfb\xa6\x72\x9a\xba\xfe\x74\xd4\xff\xf8\x86\x34\x4c\x9c\xd9\x8f\x7e\xea\x7c\xfd\xd6\xcf\x78\x44\xda\xf3\xb5\x3d\xc6\xcb\x74\x51\x88\x76\xcb\xb7\xb3\x9a\x3e\x43\xd4\x39\xdf\xb3\x62\x46\xfa\x4d\x9a\xfd\x8e\x15\x91\x33\x56\x6f\xba\x5f\x4d\xd8\x3a\xb9\x6b\x85\x7a\x0f\x41\xc8\xa4\x2c\x9e\x43\x6b\x65\x4f\x33\x1a\x56\x59\x51\x49\x14\xa5\x04\x62\x81\x99\x2c\x27\x16\xa4\xdb\x9e\x09\x37\xba\xe4\xfa\x5e\x05\x58\x16\x8f\x9c\xcb\xe2\xf0\xa3\x23\x5a\x34\x14\xf2\x10\x62\xe2\xb0\x63\x95\x44\x82\xf0\x19\x9f\xa6\xb7\xcb\x60\x94\x7b\xa0\x67\x14\xa6\x00\x55\x85\x20\x8e\x37\x89\x5f\x1d\xc6\x60\x30\x30\x16\x08\x85\x5f\x43\xc7\x1c\x2f\x7f\x8c\xd3\xea\xe8\x2a\xf6\x63\xb6\x85\x12\x44\x14\xed\x81\x9e\x58\x93\x75\x76\x37\x20\xdf\x1c\x18\x32\xe3\x0f\xd0\xb1\x8b\xba\xf6\x47\xdb\x08\xcf\x24\xbd\xe8\xe6\x31\x3f\x2b\xee\x98\xb4\xb7\xe6\xbf\x29\x51\x04\x56\x7c\xc5\x12\x75\xe1\x67\x4b\xb4\x1b\xfa\xa2\x25\x7a\xcc\xfd\xb9\x25\x7a\xeb\x30\xbe\x4c\x89\xf6\x36\x3a\x43\x91\x69\x45\xfc\xff\xab\xdf\xc1\x58\x78\xa0\x9b\xd5\x52\x7c\xd5\xa2\x8c\x37\xda\xf0\x7e\xdd\x8d\xcd\xdf\xa0\xb9\x38\x88\xee\x7b\xcc\xe9\x59\xf1\x65\x70\x5b\x21\x6a\x15\x7c\x6d\x02\x50\xc4\x75\xb7\x62\x7f\x7b\x4a\xed\xaa\xe4\x0c\x21\xed\xdb\x9d\xbc\xce\x6c\xee\x46\xb4\x5a\xb6\x17\xe8\xcd\x45\xd8\x3f\x3c\xf5\x0f\xfe\xdf\xff\x4d\xbe\x4c\xed\xb4\xc6\xfe\x1b\xda\xdf\x03\x00\x0a\x38\x1d\x79\xa8\x13\x00\x00"
 
 func repoSettingsProtected_branchTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2192,8 +2936,128 @@ func repoSettingsProtected_branchTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/protected_branch.tmpl", size: 3640, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x49, 0xcc, 0x3c, 0x61, 0x8d, 0x4c, 0x86, 0xd1, 0x83, 0x2e, 0xd3, 0xa4, 0x7f, 0x80, 0xf8, 0x3a, 0x62, 0xf2, 0x6b, 0x3a, 0x2, 0xca, 0x1a, 0x89, 0xad, 0x15, 0xd8, 0xcc, 0xf4, 0x60, 0xeb, 0x88}}
+	info := bindataFileInfo{name: "repo/settings/protected_branch.tmpl", size: 5032, mode: os.FileMode(0644), modTime: time.Unix(1792275797, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb2, 0x5a, 0x38, 0x33, 0x61, 0x22, 0xd8, 0x8d, 0x48, 0x7a, 0x11, 0xe9, 0x57, 0xdf, 0xb8, 0xf1, 0x7a, 0x16, 0x15, 0xc9, 0xf2, 0xd4, 0x24, 0x28, 0x6a, 0x95, 0x6f, 0xa5, 0x61, 0x39, 0xac, 0x3e}}
+	return a, nil
+}
+
+var _repoSettingsPush_logTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x57\x5b\x6f\xa4\x36\x14\x7e\x26\xbf\xc2\x42\xe9\xaa\x7d\x08\x34\xd2\x3e\x54\x5a\x42\x15\x25\x5a\xed\x4a\xa3\x34\x4a\x52\xf5\x31\x32\xf8\x0c\x58\x01\x9b\xda\x87\x99\x8c\x10\xff\xbd\x32\xe6\x0e\xb3\x33\x9b\x3e\x0d\xc6\xe7\xf2\x9d\xef\x5c\x38\x53\x55\x08\x79\x91\x51\x04\xe2\x46\x54\x83\x9f\x02\x65\x2e\xf1\xea\xfa\x22\x60\x7c\x47\xe2\x8c\x6a\x7d\xe3\x2a\x28\xa4\xe6\x28\xd5\x81\x68\x40\xe4\x22\xd1\xa4\x28\x75\x7a\x95\xc9\xc4\x0d\x2f\x9c\xb1\x19\x23\xdb\x98\x01\x65\x0d\x39\x63\x4b\x25\x27\xb1\x14\x48\xb9\x00\x65\x34\xe7\x97\x89\xe2\xac\x79\xbf\xb4\xd9\x79\xf6\x05\xdd\x45\xb4\x33\x3e\xb5\x80\x7b\xc8\x76\x40\xf6\x9c\x01\x89\x65\x56\xe6\xa2\x71\x07\x02\xad\x51\x67\x11\x30\xcd\x40\x61\x6f\xcb\x09\xd2\xcf\x23\x34\x28\x0b\x42\x11\x69\x9c\x02\x23\x6d\x4c\xd6\x8e\x53\x55\x1e\xbf\xfe\x43\x78\x2f\xca\xc2\xf3\x3a\x78\x9e\x21\xe6\xd5\x10\xd3\x99\xf4\xd3\xcf\x56\x69\x16\x6b\x6f\x59\x43\x92\x0f\x10\x9d\xa0\x68\x1f\xce\x70\xf2\xca\x40\xc7\x9d\x27\xa3\xc0\xb7\x24\x41\xe2\x3d\x81\x09\x9a\x4b\x71\x4f\x0f\x9a\xfc\x5e\xd7\x67\x98\x52\x9d\x8e\x3b\xd3\x37\xda\x20\x58\xe7\x25\xf0\x8b\x9e\x04\xbe\x25\xde\x63\xa9\xd3\x8d\x4c\x74\x77\xef\x04\x48\xa3\x0c\x46\x91\xee\x40\x1d\x48\x44\x35\x8f\x49\x73\xd5\x45\x6a\x44\x0d\xad\xfd\xd1\x09\x50\x0d\x07\x73\x1b\x9e\x01\x1c\x79\x0e\x6e\x5d\x07\x3e\xa6\x3f\xaf\x6c\x1e\x40\x7d\x58\x5d\xc1\xf6\xc3\xba\xb1\xcc\x73\x8e\xfa\xe3\xfa\x0a\x98\xc9\x12\xcd\xfe\x07\xfc\x5c\x22\xbc\x52\xc6\x96\x14\x04\xfe\x28\x19\x81\x3f\xcd\x54\x80\x91\x64\x87\xfe\xe8\x54\x95\xa2\x22\x81\x95\x6a\x58\x64\xd5\x09\x90\x85\x81\x2e\xa8\x20\xc8\x31\x83\x1b\xb7\xaa\xee\x29\xc2\xd7\x1c\x37\x52\x24\xc4\xbb\x53\x40\x11\x58\x5d\xbb\x61\x55\xbd\xf0\x1c\x9e\xb9\x88\xa1\x7f\x4f\x2e\xbd\x0d\x15\x89\x81\x6b\x8c\x84\x81\x8f\x6c\x6e\x7e\x74\x1c\x55\x29\xa8\xef\xf7\x63\x5c\x46\x98\x92\x54\xc1\xd6\x60\xb8\x2d\x8a\xe7\x32\xfa\xfb\x69\x53\xd7\x7e\x55\xb5\x0a\x0f\x34\x07\x0b\x64\xf2\x22\xf0\xe9\xcc\x07\x64\x1a\xe6\xb6\x9b\x18\xbb\xd1\x04\xef\x48\x12\x05\x07\x37\xbc\x6a\x81\xcf\x0c\x0c\x1d\xd6\x31\x7e\x46\x58\xdf\xf5\x3f\xfc\x8d\xd7\xf5\xc4\x57\xc9\xbb\x6e\xe3\xe2\x40\x32\x1a\x41\x66\x22\xb8\x9c\x95\xc3\x9e\xbf\x71\xb7\xa7\x71\x89\xa0\x77\xf1\x42\x0d\xdb\xbc\x33\x2f\x63\xe4\xb1\x14\xa4\xfd\xbd\x42\x9a\xb8\x61\xe0\xf3\xb0\x63\xe1\xb8\x68\xc2\xf1\x2a\x52\x54\xc4\x69\xaf\xb1\x74\xea\x3d\xa7\x52\xe1\x13\x6c\x2d\xd7\x1f\xe0\xe4\x1e\x32\xc0\x1f\xa6\xa3\xe4\x44\x01\x3b\x41\xd0\xda\xb8\x35\x86\xd9\xc0\xda\xc9\x22\xe8\x20\xd9\xe2\x9d\x5d\x2e\x30\x25\x0a\x40\xfc\x34\xaa\xd8\x36\xc6\x3a\xaa\x75\x58\x4e\x10\x4b\x06\x61\x55\x35\x5c\x3f\x7f\xbb\xbd\x26\xde\x5f\x19\xbb\x6b\x46\x92\xe9\x92\xc0\x6f\x04\xc8\x27\x45\x95\xfa\x32\x37\x28\xd8\x91\x30\x6d\x35\x9e\x74\xf5\x00\xfb\x85\xab\x73\x40\xd3\x11\x57\x3a\xa5\x2d\x49\x7d\x0b\x5f\x7a\x4f\x50\xc8\x0d\x17\x6f\x75\xed\xdb\xf1\x6a\x5a\x79\xe2\xcd\xfd\x21\x12\x1a\x9e\x0a\xf5\x23\x9d\x3a\xa4\xf0\xd7\x42\x71\x81\xdb\x33\x66\xfa\xeb\x2f\xda\x6d\x66\x5e\x7b\x7e\x39\x14\xf0\xdb\x5a\x7f\x0e\x22\xed\x6c\x1a\x2f\x44\xc3\xd4\xe9\xb2\xb0\x94\xb7\xf4\x07\x3e\xe3\xbb\xf0\xbc\xe8\xac\xe3\xa7\xe6\xe3\x71\xcb\x98\x6a\xf6\x8b\xe9\xb1\x9d\x04\x27\x46\x60\xeb\x6e\xe6\x63\xf2\xe5\x99\x13\x1e\xf8\x93\x4f\x4f\xe0\x37\x2b\x45\x78\xd1\xaf\x40\x7b\x8e\x29\xf1\x1e\x69\x32\x2a\x9f\x7e\x31\x7a\x91\x48\x33\x73\xa7\xc9\xf5\x70\x3d\x59\xcd\x62\x10\x08\x8a\x14\x34\x01\x12\x95\x88\x52\x68\x77\x0c\x6e\xba\xc5\x45\x52\x31\x50\x19\x68\x6d\x34\xb8\xa0\x66\x6b\x22\x39\x88\xd2\x0d\x2f\xd6\x2a\xb7\xeb\x94\xaf\x5c\x69\xac\x6b\xc6\xb5\xc1\xcf\xda\x28\x09\x47\xc8\xc7\x05\x6d\x8b\xd9\x0d\x87\x99\x4a\x45\x92\x01\x61\xb2\x34\x4b\x56\x06\x5b\x24\x66\xb4\xda\x79\x4a\x26\xe3\x82\xb2\x9c\x0b\x6f\x6b\x1c\xbd\x9a\x70\xdc\x45\x85\xcf\x60\x09\x89\xc4\xfb\x46\xf5\xa3\x82\x1d\x97\xa5\x3e\x06\xcf\xc6\x30\x11\x9c\x23\xfe\xd3\x38\xbc\x31\x9f\xcd\x5e\xc4\x6d\x8d\x8c\x01\x38\x43\x5c\x4d\x28\x54\x29\xb9\x3f\x1a\x50\xd3\x34\x5c\xeb\x12\xb4\x57\xb4\x76\xdd\x59\xb5\x4e\xe2\x1b\xd6\x12\x93\xf2\x95\xee\x81\x7f\x89\xf7\x50\xe6\xe4\xea\x7a\xb9\x18\xb4\xb8\x3a\x0a\x6c\xec\xa1\xe7\x79\xe7\x7d\xfd\x17\x19\xbf\x2b\x95\x02\x81\x75\x4d\x63\xe4\x3b\x58\xa1\xb4\xe1\x7f\x24\x78\x94\xd4\x87\x32\x1f\xf1\xd9\xbd\x58\xc1\x35\x6b\xe6\xe5\x9b\x23\x15\xf0\x00\xef\x78\x32\xfb\x56\xe8\x38\xc8\xe6\x7a\x35\xeb\x47\xb3\x2a\xe0\x1d\xdd\xba\xfe\x24\x22\x5d\x7c\x19\x6a\xa3\x59\x22\x14\x4f\xd2\xb6\x42\x6c\x71\x1c\xcf\xfb\x92\xfc\x0d\x3d\xbf\xdb\xfa\x08\x86\x69\x61\xbf\x1b\x8b\xde\xca\xe8\xd0\x5a\x73\xcc\x93\x3e\xb5\xd8\x87\xba\x9e\xe2\xb5\xb3\xf7\x62\xfd\x38\xcd\xd9\xe4\x34\x2b\xbc\x33\x16\x7e\x21\x05\xb8\x23\xf5\xde\xd6\xe0\xb3\x7f\xea\x1e\xda\xdf\xf6\x67\xf1\x17\x7a\x2b\x25\x76\x7f\xf6\xff\x1b\x00\x2c\xed\x91\xe2\x51\x10\x00\x00"
+
+func repoSettingsPush_logTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsPush_logTmpl,
+		"repo/settings/push_log.tmpl",
+	)
+}
+
+func repoSettingsPush_logTmpl() (*asset, error) {
+	bytes, err := repoSettingsPush_logTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/push_log.tmpl", size: 4177, mode: os.FileMode(0644), modTime: time.Unix(1792281004, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x69, 0x58, 0xfb, 0xb3, 0xd8, 0x74, 0xc3, 0x45, 0xaf, 0x18, 0xd5, 0x84, 0x5a, 0x91, 0x34, 0xb8, 0xc6, 0xfb, 0x5f, 0x8e, 0xfc, 0x22, 0x1e, 0x91, 0xa, 0xdc, 0x3c, 0x73, 0x75, 0x53, 0x80, 0xbd}}
+	return a, nil
+}
+
+var _repoSettingsReminder_formTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x95\xdf\x6e\x9b\x30\x14\xc6\xaf\xc9\x53\x58\x7e\x00\x58\xa5\x5e\xec\x02\x22\x6d\x5d\xab\x55\xea\xd4\xaa\x8d\xb4\xcb\xc8\xc1\x27\xe1\x28\xf8\xcf\x6c\x93\xac\x42\xbc\xfb\x64\x8c\xb3\xc0\xda\xae\x59\xbb\x3b\x6c\xce\x39\xdf\xf9\x7d\x1c\xe3\x9c\xe3\x8e\x94\x35\xb3\xb6\xa0\x6e\x0f\xf5\x0e\xc8\x1e\x39\x90\x52\xd5\x8d\x90\xa4\x54\xd2\x81\x74\x74\x3e\x4b\xda\xd6\x81\xd0\x35\x73\x40\xe8\x8a\x59\xc8\x58\x0d\xc6\x51\x92\x76\xdd\x2c\xc9\xab\xf3\x58\xa5\x41\xe2\x94\x26\xcc\x39\x56\x56\xc0\x49\x05\x8c\x83\xf1\x15\x92\xb6\x4d\xf1\xec\xa3\x4c\x17\x86\x50\x03\x5a\xa5\x16\x9c\x43\xb9\xb1\xa9\x81\x1d\xc2\x7e\x69\x40\xa0\xe4\x60\x2c\xed\x8b\x66\xd5\xf9\x7c\x96\x1c\xb7\xd8\xe0\xef\xc2\x16\x36\x62\xe8\x2d\xc9\xf5\xfc\xf5\xc5\x97\x1c\x6c\x49\xbb\x2e\xcf\xb4\xcf\x6d\x5b\x5c\x93\xf4\xda\x5e\xcb\x0a\x0c\x3a\xe0\x57\x46\x89\x5b\xb3\xf1\x2d\x24\x53\x75\x94\x6b\x45\x04\x58\xcb\x36\x40\x4f\xd1\xc4\x58\xbd\x17\xe6\xb8\x0b\xd2\x20\x79\xaf\x93\xaf\x95\x11\x47\x3a\x7e\x49\x09\x2b\x1d\x2a\x59\xd0\xb6\x4d\x3f\x33\x0b\x37\x28\xb7\x5d\x97\x45\x91\xec\x50\x9d\x12\x01\xae\x52\xbc\xa0\x77\xb7\x0f\x8b\xde\x6b\x6f\xf6\xc5\xc3\xfd\xd5\x42\x6d\x41\x7e\x5d\x7c\xbb\xf9\x13\x07\x65\x8d\x12\xc8\x1a\xa1\xe6\x21\x67\x4a\x5b\x56\x50\x6e\x57\xea\xe7\xf0\x36\xc9\x51\xea\xc6\x11\xc9\x04\x14\x14\x24\x5b\xd5\xc0\x29\x71\x8f\x1a\x0a\x7a\x88\x25\xd1\xd0\xcb\x10\xd0\x75\xfd\x2b\xe0\x03\x6d\xac\x55\xb3\x15\xd4\xa7\x58\x18\x05\xbd\x81\x21\xb9\xaf\x74\x70\xf3\xf8\xe9\x88\xc3\xed\x55\x60\xb4\x4f\x40\x1a\xf8\xd1\xa0\x01\x3e\x72\x61\xe8\x8d\xac\x95\x29\x68\xd0\x5f\xb2\xb5\x03\x73\xd2\x17\x1f\x25\x4e\x7a\x8e\x4e\x22\x9f\x08\x0c\xde\x8e\xf7\x82\xc1\xb2\x11\x2b\xbf\x12\x28\x0b\x7a\x46\xc9\x8e\xd5\x0d\xf4\xb3\x71\x3f\x68\x0e\x0f\x9f\x7c\x56\xd7\x51\x12\xe1\xa6\x3e\x8d\x1c\x78\x16\x1c\x6c\xc9\xfc\x71\xff\x07\xf4\x49\xea\x0b\xf0\x93\xc8\x38\x5a\x93\xdd\x27\x0c\xf8\xf0\xa4\x01\x97\x43\x62\xb4\x20\x0a\xea\x48\x5b\x41\xad\xdf\x80\xb2\xf4\xf9\x03\x91\x7e\xdd\xf8\xbd\xf1\x94\xed\x95\xd9\xa2\xdc\x2c\x39\x7b\xb4\xcf\x1d\xb5\x03\xfe\xf7\x10\xfc\x85\x3d\xda\x77\x3b\x75\xa3\x06\x26\x5f\xf2\x3f\xb3\x6b\x65\xdd\xb2\x54\xa2\xff\xcd\xff\x8d\xfd\x4e\x59\x77\x11\x62\xdf\x8d\x7d\xd4\xc0\x89\xec\x23\xe8\x55\xe3\x9c\x92\x47\xdc\x1b\x03\x20\x49\xd8\x7e\x69\x1e\x1b\xcd\xfd\xf0\xc5\x75\x3f\x78\x21\x6b\x2c\x9d\x67\xfe\xc6\xf0\xb7\x65\xd8\xc9\x33\x8e\xbb\xf9\xec\xd7\x00\x20\xa1\xa4\x6d\xdf\x07\x00\x00"
+
+func repoSettingsReminder_formTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsReminder_formTmpl,
+		"repo/settings/reminder_form.tmpl",
+	)
+}
+
+func repoSettingsReminder_formTmpl() (*asset, error) {
+	bytes, err := repoSettingsReminder_formTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/reminder_form.tmpl", size: 2015, mode: os.FileMode(0644), modTime: time.Unix(1792247875, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x9, 0xc8, 0x29, 0xf4, 0x3d, 0xb1, 0x66, 0xe6, 0x39, 0xd0, 0xc3, 0xd6, 0xe0, 0x0, 0x8c, 0xfb, 0xc1, 0xb6, 0x1c, 0xf9, 0x9f, 0x47, 0x3e, 0xf9, 0x27, 0x18, 0xc9, 0xc5, 0x49, 0x12, 0x4e}}
+	return a, nil
+}
+
+var _repoSettingsRemindersTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd0\x61\xca\x83\x30\x0c\x06\xe0\xdf\xf6\x14\xa1\x07\xd0\x0b\xf8\x79\x95\x8f\x68\xa3\x0b\x68\x2b\x49\x26\x0c\xe9\xdd\x87\x6e\x05\xd9\x60\xbf\x5e\x68\xdf\x3e\x0d\xd9\x77\xa3\x65\x9d\xd1\x08\x7c\x8f\x4a\xcd\x8d\x30\x78\xa8\x73\x76\x6d\xe0\x0d\x86\x19\x55\xff\xbc\xd0\x9a\x94\x2d\xc9\x03\x94\xcc\x38\x4e\x0a\x42\x0b\xc7\x40\xa2\xbe\x73\xd5\xd5\x39\xca\xa7\x43\xf2\x92\xaa\x2b\x75\x67\x18\x52\x34\xe4\x48\x72\xbc\xfc\xbc\x9c\x84\xc3\x79\xfe\x6d\x96\xaf\x9b\x88\x5b\x8f\x05\xff\x55\x2c\x33\xfe\x8f\x49\x96\xd2\x6f\x9b\xc0\x5b\xe7\x4a\xbe\xe3\x8a\x9c\x9b\x18\x53\x32\x12\x0f\x75\xce\xee\x39\x00\x93\xe5\x46\x94\x27\x01\x00\x00"
+
+func repoSettingsRemindersTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsRemindersTmpl,
+		"repo/settings/reminders.tmpl",
+	)
+}
+
+func repoSettingsRemindersTmpl() (*asset, error) {
+	bytes, err := repoSettingsRemindersTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/reminders.tmpl", size: 295, mode: os.FileMode(0644), modTime: time.Unix(1792247875, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa0, 0xdd, 0x70, 0xac, 0xe7, 0xc4, 0x2a, 0xc4, 0x10, 0x45, 0x63, 0xca, 0xf8, 0xbf, 0xc2, 0xb2, 0x37, 0x41, 0x92, 0x9d, 0xf4, 0x8f, 0x20, 0xfb, 0xb3, 0xb8, 0xa6, 0x5d, 0x3c, 0x11, 0xa8, 0x60}}
+	return a, nil
+}
+
+var _repoSettingsSecret_formTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x56\xcb\x6e\xeb\x36\x10\x5d\xcb\x5f\x31\x20\xb2\x8d\x84\x02\x41\x51\x14\xb2\x17\xbd\xb7\x17\x2d\x90\x76\x91\xe4\x76\x6b\xd0\xe2\xd8\x22\x4c\x91\x2a\x39\x72\x62\xa8\x06\xfa\x11\xfd\xc2\x7e\x49\x41\x91\x96\x25\x39\x0f\x15\xed\xc6\x96\xc4\xe1\x39\x33\x67\x1e\x64\x2e\xe4\x01\x0a\xc5\x9d\x5b\x32\x7a\x46\x75\x40\x78\x96\x02\xa1\x30\xaa\xa9\x34\x14\x46\x13\x6a\x62\xab\x45\xd2\xb6\x84\x55\xad\x38\x21\xb0\x0d\x77\x98\x71\x85\x96\x18\xa4\xa7\xd3\x22\xc9\xcb\xbb\x33\x4a\x23\x81\x4c\x0d\x9c\x88\x17\x25\x0a\x28\x91\x0b\xb4\x1e\x21\x69\xdb\x54\x7e\xf3\x9d\x4e\x9f\x2c\x30\x8b\xb5\x49\x1d\x12\x49\xbd\x73\xa9\xc3\xc2\x22\x39\xd6\x61\x65\xe5\xdd\x6a\x91\x0c\x3d\x6b\xe4\x05\xcf\xe1\xae\x8a\x2e\x25\x79\xbd\xfa\x10\x73\x2d\xd0\x15\x0c\xfe\x80\x47\xbe\xc5\xd3\x29\xcf\xea\xe0\x8a\xdc\x42\xfa\x18\x4c\x3c\x6b\x32\x25\xdc\xe3\x11\x94\x74\x81\xc8\x6f\xb0\x5c\xef\x70\xb2\xc7\x7f\xbf\xb1\xb8\x75\xf0\xfd\x12\xa4\x16\xf8\x02\x37\xd1\xe2\x01\xb7\x68\x51\x17\xe8\x20\xfd\xf9\xf3\xd9\x7c\x48\x22\x09\x2b\x68\x24\xec\xac\x14\x91\x65\x6c\x60\xf4\x28\x1b\xbd\x4d\x92\xcb\xde\xa4\x20\x59\x18\x0d\xf1\xff\x56\x99\x62\xcf\x56\x79\x26\x7b\xbc\x4c\xc8\xc3\x6b\xe0\xd7\xd9\x1e\xe0\x3b\xb2\x46\xef\xbc\xb8\xbf\xf2\xaa\x53\x2d\x7e\x59\x24\xd7\x48\xbc\x20\x79\x90\x74\x84\x0a\x89\x5f\x40\x92\x5c\xae\xda\xf6\xe6\x92\x9e\x3e\x33\x5c\x88\xb5\xd1\xec\x74\x82\xdc\xd5\x5c\xaf\xda\xf6\x33\x27\xfc\x52\xd1\x63\x69\x2c\x41\xfa\xc9\x22\x27\x14\x1d\xad\x5f\x87\xbf\xff\xfc\x0b\x46\x50\xaf\x67\xba\xa9\x85\xdf\xf7\x2e\xf6\xd7\x5a\x8c\xb0\x07\x52\x8d\xb5\x0a\x25\xd2\x25\xf7\x9c\xbc\x49\xdc\x93\x70\x93\x39\x1e\x3a\x14\xeb\xcd\x91\x0d\x10\xfb\xca\x9a\x52\x25\x49\xce\xa1\xb4\xb8\x5d\xb2\xb6\x4d\xef\xa5\xde\x9f\x4e\xcc\xa7\xe4\xeb\xc3\xbd\x77\x9f\x8f\xa8\x51\x8b\xa1\x9b\x93\x48\x86\xab\x6f\x57\x44\x69\xf1\xad\x82\xd8\x34\x44\x46\x0f\xda\xc3\xa2\x00\x92\xfa\x08\x71\x45\xa0\x42\xc2\xdb\xf0\xc6\x40\x70\xe2\xb7\x8d\x55\xde\xf7\x9b\xf4\x07\xee\x30\x04\x90\x9d\x25\xc9\xa2\x24\x59\xd8\x18\x77\x48\xd1\x05\xeb\xfb\xe5\x42\x3e\x47\xd8\x88\x72\x91\x20\xcf\x82\x2b\xaf\xf5\xc1\xe0\x79\x20\x4d\xff\xb5\x6d\x51\x39\x0c\x1f\x3f\x1e\x2f\xda\xe8\xc8\xdb\x63\x9d\x91\xf2\x8d\x5d\xfd\x6f\xb3\x71\xed\x90\xfe\xf5\x7c\xdc\x1a\x5b\x0d\x8c\xfc\x2b\x03\xdf\xaf\x46\x77\x42\xbf\x93\x18\xe6\xdb\xb9\x34\x62\xc9\x6a\xe3\x88\x01\x6f\xc8\x14\xa6\xaa\xbd\xce\x4b\x66\xb6\xdb\x90\xa0\xb6\x4d\x3f\x3d\x3e\x7c\x79\x32\x7b\xd4\x3f\x3d\xfd\x72\x7f\x3d\x4b\x2d\xfe\xde\x48\x5f\x2e\x5b\x89\x4a\x40\x98\xbc\x3f\x5a\xbb\x0e\xa3\x05\xad\x35\x36\x4a\x17\x73\x9e\x2b\xbe\x41\xe5\xbd\x5d\x32\xcd\x2b\x64\x33\xa6\x7c\x67\xe7\xdb\xa2\xdb\x1b\x71\xa4\xae\x1b\x02\x5f\x54\xdd\x32\xf8\xdf\xf3\xf3\x81\xab\x06\x3b\x11\x74\xe7\x07\x83\x8a\xbf\x28\xd4\x3b\x2a\x97\xec\xdb\x3b\x06\x67\xbf\x23\x56\x7d\x8e\xa7\x44\x55\xcf\xf5\x68\xed\x8d\xd1\xb2\xfe\xdc\x19\xd4\xde\x2c\x8d\x7e\xf3\x5e\x7e\x28\x52\x17\xcb\x1c\x9f\x82\xe1\xdb\x32\x85\xf5\xa8\x53\x7c\xa1\x63\x8d\x4b\x56\x73\xe7\x9e\x8d\x15\xd3\x42\xd0\xf8\x7c\x7b\x59\xfb\xaf\x9a\x75\x94\xef\x8a\x76\x35\x88\x76\x16\x51\xc7\x29\xd4\x9f\xd5\x73\x7b\x69\x3c\x27\xf2\xcc\x37\xc8\xaa\x6f\xe0\xf8\xb7\x98\xb4\x9a\xab\xb8\x52\xb0\xe1\x4e\x16\x71\xec\x41\x65\x04\x57\xec\xba\x2b\xbb\xb3\x79\xd0\xe5\x97\x83\x9b\x2c\x77\x65\xb7\xde\x1f\xd7\x73\xae\x32\x0a\x7d\xef\xb2\xd1\x94\x19\x50\x0e\xee\x6b\x73\x2f\x47\x01\x31\xdc\x92\xce\x8a\xbf\x06\x1d\xa6\x86\x0b\xd0\xe3\x28\x7d\xe1\x06\x39\xa4\x3e\xa0\x25\x14\x50\x70\x5d\xa0\x1a\xa5\xe5\x12\xbb\xc5\xca\x1c\x70\x1c\xfc\x28\xfa\x4e\xce\x54\x9b\x90\xa3\x3e\xfb\x13\xda\x98\xf9\x31\xb1\xd9\xbf\x41\x5a\x94\x58\xec\x2b\x6e\xf7\x1f\xf2\x1e\xd1\x8d\x89\xc7\xe5\xf0\xcf\x00\x7a\x8c\x1c\x4e\x33\x0b\x00\x00"
+
+func repoSettingsSecret_formTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsSecret_formTmpl,
+		"repo/settings/secret_form.tmpl",
+	)
+}
+
+func repoSettingsSecret_formTmpl() (*asset, error) {
+	bytes, err := repoSettingsSecret_formTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/secret_form.tmpl", size: 2867, mode: os.FileMode(0644), modTime: time.Unix(1792264557, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf4, 0x86, 0xc0, 0x8a, 0x92, 0x8e, 0xb8, 0xb1, 0x2, 0xa5, 0x4b, 0xd4, 0xf4, 0x51, 0x8d, 0xa9, 0x73, 0x41, 0xe0, 0x5, 0x7, 0x23, 0xa3, 0xdd, 0xfa, 0xe4, 0xdb, 0x2a, 0x9e, 0xcb, 0xf2, 0x9a}}
+	return a, nil
+}
+
+var _repoSettingsSecretsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x90\x51\x0a\x83\x30\x0c\x86\x9f\xf5\x14\xa5\x07\x98\x17\x70\x5e\x65\x44\x1b\x5d\x40\x1b\x49\xb2\xc2\x10\xef\x3e\xd4\x15\x64\xc2\x9e\x7e\x68\xbf\x7c\xf9\xc9\xb2\x18\x4e\xf3\x08\x86\xce\xb7\xa0\x58\x3d\x11\x82\x77\xb7\x75\x2d\xeb\x40\xc9\x75\x23\xa8\xde\xbd\xe0\xcc\x4a\xc6\xf2\x76\x8a\x66\x14\x07\x75\x8a\x9d\xa0\xa9\x6f\xca\xe2\x6c\xd9\xd0\xdd\x82\x72\x78\x8a\xb3\xe8\x45\xae\xe3\x68\x40\x11\x65\x9b\xfc\xfd\x1c\x84\xc2\xfe\x7e\x75\xe6\xc5\x55\x84\xd4\x42\x96\xff\x03\x8f\x86\x8f\x9e\x65\xca\x74\x5d\x05\x4a\x4d\x99\xf3\x1b\x97\x2b\xf4\xcc\x96\xfb\x7f\x06\x00\xf1\x63\x2e\x53\x23\x01\x00\x00"
+
+func repoSettingsSecretsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsSecretsTmpl,
+		"repo/settings/secrets.tmpl",
+	)
+}
+
+func repoSettingsSecretsTmpl() (*asset, error) {
+	bytes, err := repoSettingsSecretsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/secrets.tmpl", size: 291, mode: os.FileMode(0644), modTime: time.Unix(1792264557, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9, 0x1a, 0xaa, 0xf4, 0x3b, 0x63, 0x58, 0xb2, 0x72, 0xd5, 0xc9, 0xc1, 0xfe, 0x23, 0x78, 0xa7, 0xfc, 0x33, 0xda, 0x34, 0x9c, 0x59, 0xac, 0x13, 0xbc, 0x1b, 0xda, 0x5f, 0x1a, 0x4, 0x89, 0x99}}
+	return a, nil
+}
+
+var _repoSettingsTasksTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x56\xcd\x6e\xa3\x3a\x14\x5e\x93\xa7\xb0\xac\xae\xb1\xae\xd4\xc5\x5d\x38\xd9\x54\xba\xba\x23\x75\x36\x6d\xf6\x91\xc1\x27\xc1\x8a\x39\xa6\xf6\x21\x9d\x08\xf1\xee\x23\x43\x48\x81\x24\x6a\x32\xb3\x02\xff\x7d\x7f\xb6\x0f\x34\x0d\x41\x59\x59\x45\xc0\x78\xa6\x02\x88\x02\x94\xe6\x2c\x6d\xdb\x85\xd4\xe6\xc0\x72\xab\x42\x58\x72\x0f\x95\x0b\x86\x9c\x3f\xb2\x00\x44\x06\x77\x81\x91\x0a\xfb\xc0\x57\x8b\x64\x8c\x11\x27\x76\x18\xe0\x7b\x94\x64\x0c\x53\x1b\x96\x3b\x24\x65\x10\x7c\x5c\x39\x1f\xdc\x79\xa3\xbb\xfe\x4b\xcc\x81\x56\xa0\x3a\x64\x6a\x00\x9f\x22\xd0\x27\xd8\x03\xb0\x4f\xa3\x81\xe5\xce\xd6\x25\x76\x74\x80\xd4\x83\x26\x17\x6e\x95\x05\x4f\x67\xac\x44\x16\xcf\x23\x35\xe4\x2a\xa6\x88\x54\x5e\x80\x66\x27\x4f\x3d\x4e\xd2\x34\xa9\xf9\xe7\x5f\x4c\xd7\xbe\x97\x97\x0e\xf2\xd2\x10\x67\xd7\x16\xf4\xa6\xcf\x67\x40\x16\xc5\x73\xbf\x76\x66\xf9\x4c\x10\x60\x57\x7e\x29\x4d\x64\xb5\xba\x9b\x64\xa3\x21\xe4\xbc\x6d\xa5\xa8\xce\xfa\xcc\x96\xa5\xeb\x38\x78\x12\x90\x24\x92\x54\x66\x61\x44\x7d\x00\x7f\x64\x99\x0a\x26\x67\xdd\xd0\x40\x1d\xa7\x46\xbb\xe7\x66\x22\xc9\x7f\x35\xe2\xe8\x03\xda\xe8\x58\x41\xa7\x8d\x8a\x3f\xc6\x08\xa4\xa8\x0e\x7f\x8d\x62\x30\xbf\x21\x65\xda\x25\xc5\xc8\xaf\x14\xd3\x30\x24\x65\x4e\x1f\xcf\xcd\xa4\x69\xbc\xc2\x1d\xcc\xd3\xbe\x48\x2d\x91\xa4\xc7\xcd\x7e\x8f\xe0\x83\xa5\xeb\x63\x05\x8c\x97\xc6\x7b\xe7\x37\xe1\x88\x39\x1f\xa3\x74\x33\x9f\xee\xf5\x78\x1b\xa5\x69\xc0\x06\x60\x13\xce\x4f\xc8\x0a\xe7\xf6\x1b\x0d\xd6\xc4\xd3\x30\x27\x96\x8a\x15\x1e\xb6\x4b\x1e\x15\xbc\x41\xe5\x5e\x0d\xee\xdb\xf6\xeb\x32\xc6\xd5\x81\xaf\x1e\x10\x78\x85\x52\x0a\xb5\xfa\x4e\x69\x55\x5b\xbb\xf1\xf0\x51\x43\xa0\x4d\x5e\x40\xbe\xbf\x57\x6b\x5c\x19\x44\xd3\xa4\x3f\x50\xc3\xaf\xb6\x7d\x48\xed\x15\x5a\x36\x00\x5d\x91\x8d\x7a\x22\x4a\x0a\xd2\xdf\x9e\x80\xf4\xad\x46\x34\xb8\x9b\xdb\x09\x95\xc2\x49\x69\x04\x40\x66\x55\x06\xf6\x21\x07\xbe\x47\xef\x62\x8e\x90\x57\x92\xfe\x86\xf9\x71\xce\x8f\x1a\x6a\xd0\x37\x29\xef\x48\xa9\x69\xd6\xa6\x84\xf7\x78\x61\x59\xda\x3f\x9e\xd2\x57\x15\x53\xba\x32\xfd\xfc\x95\x32\xbb\x82\x98\xb2\x66\x87\xa0\xf9\x95\xa8\x5f\x14\xe6\x60\x63\xad\x9b\x7b\xde\x3a\x5f\x8e\x3c\xc7\x26\x67\x2a\x27\xe3\xf0\xf6\xe9\xef\xdc\x8a\xbc\x03\xe5\xac\x04\x2a\x9c\x5e\xf2\xca\x05\x9a\x92\xf7\x37\xf8\xe5\xfd\xed\xbf\xb5\xdb\x03\xfe\xbf\xfe\xf9\x3a\xe3\x4f\xa4\xc1\xaa\x26\x16\x8b\xe5\x92\x17\x46\x6b\x40\xce\x50\x95\xb0\xe4\xb1\x8f\xb3\x83\xb2\x35\x44\x29\xdd\x85\x88\xc7\x78\x06\x90\xd5\x44\x6e\xbc\x6f\x1e\x34\x23\x83\x47\xd6\x8f\x3c\xb4\x83\x27\x4f\x31\xed\x7e\xf5\x94\x4d\x8a\x18\xd0\x63\xfb\x3a\x29\xaa\xf3\xe9\x52\x4c\xaa\xaa\x14\xdd\x07\x69\xb5\xb8\x76\x4a\xef\x2f\xf8\xe8\x10\xce\x65\x62\xcc\x28\x85\x36\x87\xd5\x62\xfc\x36\xbc\x9c\x9e\xa7\xc7\xc5\x0f\xc3\xd6\x39\x1a\x7e\x6d\x7e\x0f\x00\xf8\x40\x8b\xbb\x3c\x09\x00\x00"
+
+func repoSettingsTasksTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsTasksTmpl,
+		"repo/settings/tasks.tmpl",
+	)
+}
+
+func repoSettingsTasksTmpl() (*asset, error) {
+	bytes, err := repoSettingsTasksTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/tasks.tmpl", size: 2364, mode: os.FileMode(0644), modTime: time.Unix(1792266788, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa6, 0xa2, 0x20, 0xe, 0x80, 0x50, 0x9b, 0xec, 0x5, 0x3d, 0x85, 0x4c, 0x87, 0x8e, 0x43, 0xbb, 0xf6, 0xeb, 0x67, 0x88, 0xf0, 0xa2, 0xb7, 0xb3, 0x12, 0x2e, 0x93, 0x50, 0xe5, 0x45, 0x77, 0x79}}
 	return a, nil
 }
 
@@ -2337,7 +3201,7 @@ func repoSettingsWebhookListTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoSettingsWebhookNewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x41\x8f\xdb\x20\x10\x85\xcf\xde\x5f\x81\x7c\x2f\xd6\xaa\x7b\xe8\x21\x5b\xa9\xb7\x56\xaa\xaa\xaa\xbb\x3d\x57\x13\x98\xe0\x51\x30\x50\x20\xb6\x56\x88\xff\x5e\x39\x86\xac\x93\x54\xab\xa6\x27\x23\xc3\xfb\xe6\xcd\x83\x49\x29\xe2\xe0\x34\x44\x64\xed\x16\x02\x76\x3d\x82\x6c\x19\xcf\xf9\x6e\x23\x69\x64\x42\x43\x08\x8f\xad\x47\x67\x03\x45\xeb\x5f\x58\xc0\x18\xc9\xa8\xc0\x0c\x4e\x6c\xc2\x6d\x6f\xed\xbe\xfd\x78\xd7\xac\x49\xf3\xf1\x23\x09\xfd\xc2\x6a\xd6\xb0\x03\x31\x61\x4d\x04\x32\xe8\x67\xe5\xe5\xa6\xf2\x24\x8f\xff\xaf\x99\xb5\x78\x67\x60\xdc\x42\x85\x9f\x13\xe2\x84\x7a\x44\x36\x91\x44\x26\xac\x3e\x0c\xe6\x58\x0e\x4d\x5c\xa0\xcd\x55\xcf\xa0\xd1\xc7\x13\xab\xd9\xf4\x0f\x2b\x37\xd1\x3a\x06\x31\x82\xe8\x51\xb2\xd2\xd3\xc2\x69\x52\xa2\x1d\xe3\xdf\x41\xe1\x97\xf0\x54\x9c\x7d\xb6\x76\x1f\xbe\xe1\x94\x73\x4a\x9c\xee\x3f\x18\xfe\xec\x17\xf3\xbc\x9a\xe7\x20\xe5\xaf\x9a\xdc\x7c\x0e\x75\xc0\xb7\xce\x1f\x9c\x84\x88\xe7\x12\x23\x8b\xdb\xcb\xf4\x3c\xa9\xbe\x76\x5a\x2c\xe2\x6f\xc6\x67\x5f\xcf\x2f\x0e\x59\xab\xac\x0a\x6d\x15\x37\xcd\x86\x06\x55\xe5\x34\xa8\x77\xf7\xef\x5b\x16\xbc\x78\x6c\x53\xfa\xe4\xdc\xd3\x61\xfb\xf3\xc7\xd7\x9c\x3b\x1a\x54\xb7\x83\x91\x84\x35\xdc\x19\xb5\x2a\xb0\xb8\xff\x0f\x5c\x4a\x27\x57\x39\x5f\x42\x57\xfd\x75\x92\xc6\x65\x67\xd3\xf5\x0f\x65\x75\xde\xf4\xe9\x82\x02\xaa\xe1\xf5\xa6\xdf\x78\x40\x25\xcc\xee\x98\xc6\xe9\xea\xff\x41\x10\x34\x88\xfd\x4d\x0a\x49\x41\x58\x2f\x6f\xd4\x18\x15\x41\xaf\x0a\x95\x18\xae\x5e\xf0\xdf\xf5\x3d\x85\x79\x5a\x5f\x07\xa4\x86\x58\x17\xe5\x5b\x3e\x57\x23\xb1\xb3\x36\xd6\xe1\xfd\x13\x00\x00\xff\xff\xce\x51\x8a\xc3\x24\x04\x00\x00"
+var _repoSettingsWebhookNewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x93\x41\x8b\xdb\x30\x10\x85\xcf\xce\xaf\x10\xbe\xd7\x66\xe9\x1e\x7a\xc8\x2e\xf4\xd6\x42\x29\xa5\xbb\x3d\x2f\x13\x6b\x22\x0f\x91\x25\x55\xa3\xd8\x04\xe1\xff\x5e\x1c\x4b\x89\x37\x29\xdb\x4d\x4f\x0a\x91\xde\x37\x6f\xde\x78\x62\x0c\xd8\x39\x0d\x01\x45\xb9\x01\xc6\xba\x45\x90\xa5\xa8\xc6\x71\xb5\x96\xd4\x8b\x46\x03\xf3\x43\xe9\xd1\x59\xa6\x60\xfd\x41\x30\x86\x40\x46\xb1\x30\x38\x88\x01\x37\xad\xb5\xbb\xf2\x71\x55\x2c\x49\xd3\xf3\x23\x09\xfd\xcc\x2a\x96\xb0\x3d\x89\xc6\x9a\x00\x64\xd0\x4f\xca\xcb\x4b\xe5\x49\x1e\xff\xbf\x66\xe6\xe2\xb5\x81\x7e\x03\x19\xfe\x9a\x10\x06\xd4\x3d\x8a\x81\x24\x8a\xc6\xea\x7d\x67\x8e\xe5\xd0\x84\x19\x5a\x5c\xf5\x0c\x1a\x7d\x38\xb1\x8a\x75\x7b\xbf\x70\x13\xac\x13\x10\x02\x34\x2d\x4a\x91\x7a\x9a\x39\x45\x8c\xb4\x15\xd5\x0f\x50\xf8\x95\x9f\x92\xb3\x2f\xd6\xee\xf8\x3b\x0e\xe3\x18\x63\x45\x77\x9f\x4c\xf5\xec\x67\xf3\x55\x36\x5f\x81\x94\x2f\x39\xb9\xe9\x1d\x6a\xc6\xb7\xde\xef\x9d\x84\x80\xaf\x25\x46\x26\xb7\x97\xe9\x79\x52\x6d\xee\x34\x59\xc4\xdf\xa2\x9a\x7c\x3d\x1f\x1c\x8a\x52\x59\xc5\x65\x16\x17\xc5\x9a\x3a\x95\xe5\xd4\xa9\x0f\x77\x1f\x4b\xc1\xbe\x79\x28\x63\xfc\xec\xdc\xd3\x7e\xf3\xeb\xe7\xb7\x71\xac\xa9\x53\xf5\x16\x7a\x6a\xac\xa9\x9c\x51\x8b\x02\xb3\xfb\xff\xc0\xc5\x78\x72\x35\x8e\x97\xd0\x45\x7f\xb5\xa4\x7e\xbe\x59\xd7\xed\xfd\xe3\xea\x2f\x4d\x9f\x06\xc4\xa8\xba\xf3\xa4\xdf\xf8\x80\x52\x98\xf5\x31\x8d\xd3\xe8\xdf\x21\x60\x0d\xcd\xee\x26\x85\x24\x6e\xac\x97\x37\x6a\x8c\x0a\xa0\x17\x85\x52\x0c\xab\xf7\xe9\x1d\x1c\xb4\x05\xf9\xd2\xa3\x67\xb2\xe6\x8c\xf9\xb7\xb4\x25\x9e\x16\xfd\xbc\x5b\x39\xff\xfc\x23\x9d\xe9\xb8\xda\xa6\xad\xb5\x21\xef\xfd\x9f\x01\x00\x08\xa9\x00\x01\x5f\x04\x00\x00"
 
 func repoSettingsWebhookNewTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2352,12 +3216,32 @@ func repoSettingsWebhookNewTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/webhook/new.tmpl", size: 1060, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7a, 0x78, 0x35, 0x8, 0x73, 0x75, 0x19, 0x27, 0xdb, 0x5d, 0xa, 0x1, 0x5c, 0x26, 0x67, 0xdc, 0xe0, 0x27, 0x56, 0x68, 0xdb, 0x48, 0xff, 0x2a, 0x5c, 0x68, 0xf8, 0x46, 0x6e, 0x1e, 0x78, 0xdd}}
+	info := bindataFileInfo{name: "repo/settings/webhook/new.tmpl", size: 1119, mode: os.FileMode(0644), modTime: time.Unix(1792276253, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc2, 0x37, 0xc1, 0x87, 0x44, 0x9, 0xa, 0x16, 0x58, 0x6e, 0x65, 0x97, 0xe7, 0xfc, 0x67, 0x4c, 0x24, 0xf9, 0x12, 0x96, 0xe7, 0x70, 0xa2, 0x90, 0xca, 0x98, 0x5a, 0x4, 0xfa, 0x65, 0xbf, 0x92}}
+	return a, nil
+}
+
+var _repoSettingsWebhookPayload_versionTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x94\x41\x6f\xdb\x38\x10\x85\xcf\xf2\xaf\x18\x10\x5e\x20\xb9\x50\x58\x20\x87\x3d\xc8\x3e\x6c\x36\x41\x82\x4d\xd1\xa0\x71\xdb\xa3\x41\x8b\x63\x69\x60\x9a\x64\x45\xca\x81\x41\xe8\xbf\x17\x12\x25\xc5\x31\x9c\xa4\xce\x45\x10\x89\xe1\xbc\xa7\x79\x1f\x15\x02\xad\x41\x68\x09\xfc\x51\x14\x78\xef\x9e\xd0\x7b\xd2\x85\xbb\x33\x66\xe3\x6e\x24\x79\xb8\xc0\x5f\xc0\xdb\xe5\x62\x6f\x11\x58\x61\x0a\xc7\x2e\x9b\x66\x92\x64\xe5\x15\xe4\x4a\x38\x37\x63\x35\x81\x37\x16\x84\xf7\x22\x2f\x51\x42\x89\x42\x62\xc5\xe6\x93\x24\x09\x81\xd3\xdf\xff\x68\xbe\xa8\x80\x55\x68\x0d\x77\xbd\x02\x7f\xc6\x55\x69\xcc\x86\x5b\xb1\x57\x46\xc8\xe5\x0e\x2b\x47\x46\xb3\xae\x77\x5a\x5e\xcd\x27\x49\x26\x69\x77\xa0\x31\xf6\x77\x58\x6c\x51\xfb\x4e\x20\xb3\xed\xf3\x7c\x9d\x65\x5e\x57\x55\xdb\x04\xf8\xcf\xbe\xe2\x31\x56\xfc\x88\x05\x4d\x13\xfb\xd2\xfa\xad\x0a\x7e\xef\x1e\x84\x47\xe7\x9b\x26\x73\x56\xe8\x03\xab\x45\x85\xa8\x61\x25\x1c\xe5\xe0\x49\xef\x41\x89\x15\x2a\x36\x3f\xdf\xa6\xea\x14\x58\xd3\x64\x69\xab\x31\x0f\x01\xb5\xec\xbc\x65\xa9\x8d\x03\x18\x74\x4b\x54\xf6\x33\x12\x45\x2d\x2a\xa1\x3d\x62\xa7\x62\x63\x6c\xb4\x06\x6d\xfc\x1f\x7c\x7a\x3b\xa5\xa3\xa0\x48\xaf\x0d\x6c\xd1\x39\x51\x60\x17\x52\x17\xd3\xf9\xc6\x6a\x5b\x54\x42\xe2\x52\xa2\xcb\x19\xf0\xa8\x78\x9c\x52\xef\x38\x49\xb2\x5a\x0d\x1e\x14\x39\xdf\x0b\x27\x21\x54\x42\x17\x08\xc3\x17\x7c\x8f\x4d\xaf\xcb\x76\xd7\x45\xff\xed\x69\x45\xf3\x10\xa6\xa3\xc5\x0b\x5b\x91\xf6\xeb\x8f\xac\xe6\x5d\x9b\xe5\x5f\x8e\x01\xff\x9f\xb4\xbc\x04\x7e\x4b\xa8\x24\xf0\x9b\x1d\xea\x96\x8c\x54\xd1\xe8\x64\x88\xae\x0d\xaf\x56\xdd\x76\x96\x4a\xda\xc5\xb7\xb5\xa9\xb6\x07\x53\x6c\x97\x0c\x44\xee\xc9\xe8\x19\x0b\x81\xff\x2b\x1c\x3e\x90\xde\x34\x4d\x3a\xf8\x49\x5b\x33\x2e\x0d\x61\xcc\xe9\xfe\xbf\xa6\x49\x8f\xef\x14\x6c\xd1\x97\x46\xce\x98\x35\xe3\x60\x42\xe0\xd7\x4f\xdf\x6e\x17\x66\x83\xfa\x6e\xf1\xe5\x61\x30\x46\xda\xd6\x1e\xfc\xde\xe2\x8c\x95\x24\x25\x6a\x06\x5a\x6c\x71\xc6\xc6\x6e\x3b\xa1\x6a\xec\x2c\x9d\x8e\x64\xc8\x7c\x55\x7b\x6f\x4e\x5c\x8b\x6e\x9b\x7d\x1e\x88\x77\x58\x88\xbd\xfb\xc9\xb6\x13\x9c\x4f\x0e\x07\x1f\xaf\xf3\x57\x25\xb1\x7a\x7d\xd6\x9d\x04\x59\xd2\x8e\xba\x3f\xd9\x41\x4a\x9f\x01\x39\x37\x5b\x2b\x7c\xe4\xf8\x85\xd8\x91\xcc\xb7\xfd\x7c\x08\xc5\xf4\x5d\x2a\xa6\xe7\x63\x91\xb4\xa7\x4e\x81\x71\x26\x19\x23\x05\x27\x30\x88\xff\xc5\x17\x0c\xa6\xe7\xce\xd3\x3d\x93\xcf\x4b\x06\xfc\x28\xf2\x83\xcc\x5f\x85\xde\xbf\xf5\x29\x0e\xeb\xdf\x03\x00\xb1\xd7\xdd\xad\xfd\x06\x00\x00"
+
+func repoSettingsWebhookPayload_versionTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoSettingsWebhookPayload_versionTmpl,
+		"repo/settings/webhook/payload_version.tmpl",
+	)
+}
+
+func repoSettingsWebhookPayload_versionTmpl() (*asset, error) {
+	bytes, err := repoSettingsWebhookPayload_versionTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/settings/webhook/payload_version.tmpl", size: 1789, mode: os.FileMode(0644), modTime: time.Unix(1792276253, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x56, 0xb, 0x53, 0x9c, 0x18, 0x32, 0x2b, 0x47, 0x5c, 0x88, 0x83, 0x97, 0x51, 0x1e, 0xaf, 0xbf, 0x69, 0x3b, 0x2f, 0x9f, 0x11, 0xe, 0x2b, 0x79, 0xcb, 0xb7, 0x3e, 0x5f, 0xc3, 0x91, 0xea, 0xc3}}
 	return a, nil
 }
 
-var _repoSettingsWebhookSettingsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x98\x5f\x4f\xf3\x36\x14\xc6\xaf\xcb\xa7\xf0\x7c\xdf\x74\x9b\xb8\x98\xa6\x16\x69\x03\x26\x90\xd8\x86\x00\x69\x97\x91\x1b\x1f\x1a\xab\xae\x9d\xd9\x4e\xa1\xca\xf2\xdd\x27\xff\x49\x48\x4b\xde\xd2\x34\x79\x95\xab\xa2\xda\x7e\xce\xe3\xdf\xe3\x43\xe3\xcc\x29\xdb\xa2\x84\x13\xad\x17\xf8\x95\x01\xa7\xf8\xea\x62\x32\x4f\x2f\xaf\x8a\x22\x62\x3f\xfd\x22\xa2\x17\x85\xb0\x82\x4c\x46\x1a\x8c\x61\x62\xa5\x23\xd8\x82\x30\x31\x05\x9d\xe0\xb2\x9c\xcf\xd2\x4b\xbb\xa2\x21\xb3\x52\x32\xcf\x80\x22\x37\x0f\x99\x5d\x06\xc8\x29\x6b\x2b\x3d\x69\x2d\xb8\xff\x75\xce\x90\x22\x94\x49\x24\xa4\x98\x3a\x15\x8d\x92\x14\x92\xf5\x52\xbe\xfb\xd9\x93\x39\x13\x59\x6e\xaa\x05\x29\xa3\x14\x04\x46\x82\x6c\x60\x81\xfd\x0a\xec\x2a\x2f\xb0\x53\xc2\x68\x4b\x78\x0e\x0b\x9c\xe5\x3a\x8d\xa5\xe0\x3b\x8c\x8a\x82\xbd\x22\xa9\x50\xf4\x48\x56\x70\xaf\x9f\xc3\xf6\xee\xa4\x5c\xeb\xbf\xe0\x0d\x45\xff\xc0\x32\x95\x72\x1d\x3d\xe6\x3a\xfd\x5b\xf0\x5d\x59\x3a\x13\x40\x8b\x02\x04\x2d\xcb\xe0\x84\x93\x25\xf0\x2f\x71\x35\x0a\xff\x87\x9e\x8d\xfa\xf9\xee\xe5\xcf\x07\x8b\xcf\x2f\x77\x08\x66\x94\x6d\x1d\xa2\xfa\x8f\x51\x59\x69\x10\x34\x86\x2d\xa8\x9d\x49\x99\x58\x05\x62\x35\x96\x67\x10\xf4\xb6\x1e\xed\x05\xe7\x53\xa5\xef\x83\x68\x58\x3c\x49\x2a\xa5\x86\xb8\x9a\xb2\x0f\xe7\xda\x0d\xde\xba\xb1\x5e\x68\x7c\x95\x0e\x44\xc2\xe7\x7e\x4b\x86\x9d\xfb\x36\x44\x39\x43\x2b\xc5\x68\xf0\x2c\xa4\xf9\x96\x6f\x6d\x76\x1c\x16\x98\x32\x9d\x71\xb2\xfb\x55\x48\x01\xb8\xb1\x89\xf9\x0f\xd3\x29\xba\x56\x40\x0c\xa0\xe9\xf4\x30\x0d\x6d\x8b\xa2\x37\x46\x01\x25\x92\xe7\x1b\xf1\x39\x99\x46\x60\x87\x89\x1d\x84\x74\x34\xa5\xc4\x59\xa8\x52\xaa\x57\x22\x43\x96\x4c\x50\x78\x5f\xe0\x1f\x3f\xe5\xe3\x96\xb4\x27\x73\x72\x34\xbe\xec\x7e\x1e\x93\xc9\x5c\x67\x44\xd4\x46\x81\x67\xf8\x44\xa5\x8f\x7f\xa9\x56\x21\x50\xa9\xe2\x6d\x3b\xfa\x16\xff\x0d\x70\x18\x19\x3f\x75\x16\x3a\xe1\xf7\xae\xfb\xe1\x0f\x65\x07\xc0\xef\x95\xce\xc2\xff\x87\x54\xeb\x51\xe1\xbf\x4a\xb5\xee\x84\xde\x3a\xee\x07\xde\x95\x1c\x00\xbb\xd5\x39\x0b\xba\xfd\x41\x1e\x15\xba\xfd\x31\xef\x04\xdd\x3a\xee\x07\xdd\x95\x1c\x00\xba\x7b\x0e\x39\x07\xfa\xbd\xd6\x39\xe8\x51\xb1\x33\x67\xa1\x13\x78\xef\xba\x1f\xfa\x50\x76\x00\xf8\x5e\xe9\xcc\x33\xcf\x39\x7a\x82\x7f\x73\xd0\x66\xe4\xb3\xcf\x79\xac\xbc\x91\x8e\x3d\xc0\x79\xd8\x40\xdf\x56\x68\x38\x18\xa4\x25\x3e\xf4\xce\x6f\x0d\x74\x2d\x37\x1b\x7b\xe1\x19\xbd\x43\xe2\xc4\x3b\xe9\xde\x28\x61\x0b\x03\xb4\x4b\xed\x61\xa8\xae\xa9\x04\xcf\x0a\xe8\x09\x38\x10\x3d\xee\x53\x92\xf2\x1e\x3a\x85\x12\x7c\xf7\xcb\xa3\x2a\x3c\x40\x12\x41\xaa\x63\x06\xe1\xb3\xba\x98\x1c\x30\xa4\x6c\xcb\x28\x28\x7c\xd5\x36\xce\x04\x67\x22\xbc\x3e\xc0\x07\xaf\x19\x0e\xf9\x1f\x83\x4f\x12\xc3\xb6\x27\xb1\x3f\xe5\x85\xc0\xbd\xfe\xcd\xe9\xb5\xe5\xf2\x65\x28\xc1\xca\x5e\x1a\x9d\xa2\xf0\x02\xb1\x9d\x06\xaa\x99\xc2\x3e\xe7\xd6\x23\xec\x8f\x57\xfb\xfe\xca\xd2\x3a\x59\xe6\xc6\x48\xd1\x60\xbc\x52\x00\x02\xf9\xaf\x8f\xda\xa2\x34\x7e\xf3\x80\x9c\x29\xbf\xc2\xd5\x04\x6e\xcf\x70\x2f\xf5\x3c\xa3\xf6\x86\xd4\x5a\x60\x32\x27\xcd\xab\x3e\x50\xe4\x1f\xe8\xa7\xa1\x5a\x50\x47\x94\x18\x32\xcd\x15\x5f\xe0\xa2\x88\x7e\x27\x1a\x1e\x98\x58\x97\xe5\xac\x2a\x32\xb3\xd2\x7a\x56\x5d\x67\xdc\x74\x46\xdd\xec\x3a\xf9\x9b\xb2\x3c\x66\x33\xdc\x24\x9a\x36\x89\x47\x60\xcf\x47\xdd\x02\x45\x61\x60\x93\x71\x7b\x6f\x76\x02\x1f\x16\xc2\xca\x60\x22\xde\x48\x4a\x38\x46\x51\x59\x5e\xfc\x1f\x00\x00\xff\xff\xc7\x60\x64\x7b\xa9\x13\x00\x00"
+var _repoSettingsWebhookSettingsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x98\x5f\x8f\xe2\x36\x14\xc5\x9f\xe1\x53\xb8\x7e\x27\xb4\xd5\x3e\x54\x15\x20\xb5\xb3\x5b\xed\x48\xdb\x76\xb5\xb3\x52\x1f\x23\x13\xdf\x21\x16\xc6\x4e\xfd\x27\x33\x88\xe6\xbb\x57\xfe\x13\x26\x0c\x59\x86\x90\xac\xf2\x94\x11\xb1\xcf\x3d\xfe\x9d\x7b\x07\x92\x05\x65\x25\xca\x38\xd1\x7a\x89\x1f\x19\x70\x8a\x57\xd3\xc9\x22\x7f\xb7\x3a\x1c\x12\xf6\xd3\x2f\x22\xf9\xaa\x10\x56\x50\xc8\x44\x83\x31\x4c\x6c\x74\x02\x25\x08\x93\x52\xd0\x19\xae\xaa\xc5\x3c\x7f\xe7\x76\x34\x64\x36\x4a\xda\x02\x28\xf2\xeb\x90\xd9\x17\x80\xbc\xb2\x76\xd2\x93\xd6\x82\xa7\x1f\x5b\x86\x14\xa1\x4c\x22\x21\xc5\xcc\xab\x68\x94\xe5\x90\x6d\xd7\xf2\x39\xac\x9e\x2c\x98\x28\xac\xa9\x37\xe4\x8c\x52\x10\x18\x09\xb2\x83\x25\x0e\x3b\xb0\xaf\xbc\xc4\x5e\x09\xa3\x92\x70\x0b\x4b\x5c\x58\x9d\xa7\x52\xf0\x3d\x46\x87\x03\x7b\x44\x52\xa1\xe4\x33\xd9\xc0\xbd\x7e\x88\xc7\xfb\x28\xe5\x56\xff\x05\x4f\x28\xf9\x07\xd6\xb9\x94\xdb\xe4\xb3\xd5\xf9\xdf\x82\xef\xab\xca\x9b\x00\x7a\x38\x80\xa0\x55\x15\x9d\x70\xb2\x06\xfe\x26\xae\x46\xe1\xff\xd0\x83\x51\x3f\x7f\xfc\xfa\xe7\x27\x87\x2f\x6c\xf7\x08\xe6\x94\x95\xab\x69\xf3\x8f\x51\x59\x69\x10\x34\x85\x12\xd4\xde\xe4\x4c\x6c\x22\xb1\x23\x96\x07\x10\xf4\xc3\xf1\x6e\x2f\x38\x67\x95\xbe\x0f\xa2\x61\xf1\x64\xb9\x94\x1a\xd2\x7a\xc9\x29\x9c\x3b\x7f\xf3\x83\xbf\xd7\x0b\x4d\xa8\xd2\x81\x48\xbc\x9e\x8e\x64\x3c\x79\x18\x43\x64\x19\xda\x28\x46\xa3\x67\x21\xcd\xb7\x7c\x6b\xb3\xe7\xb0\xc4\x94\xe9\x82\x93\xfd\xaf\x42\x0a\xc0\x8d\x43\x2c\x7e\x98\xcd\xd0\x9d\x02\x62\x00\xcd\x66\xaf\xd3\xd0\xae\x28\x7a\x62\x14\x50\x26\xb9\xdd\x89\xf3\x64\x1a\x81\xbd\x4e\xec\x55\x48\x17\x53\xca\xbc\x85\x3a\xa5\xe3\x4e\x64\xc8\x9a\x09\x0a\xcf\x4b\xfc\xe3\x59\x3e\x7e\x4b\x7b\x32\x57\x47\x13\xca\x9e\xe6\x31\x99\x2c\x74\x41\xc4\xd1\x28\xf0\x02\x5f\xa9\xf4\xf2\x2f\xd5\x29\x44\x2a\x75\xbc\x6d\xad\xef\xf0\xbf\x07\x0e\x23\xe3\xa7\xde\x42\x27\xfc\xc1\x75\x3f\xfc\xb1\xec\x00\xf8\x83\xd2\x4d\xf8\xff\x90\x6a\x3b\x2a\xfc\x47\xa9\xb6\x9d\xd0\x3b\xc7\xfd\xc0\xfb\x92\x03\x60\x77\x3a\x37\x41\x77\x5f\xc8\xa3\x42\x77\x5f\xe6\x9d\xa0\x3b\xc7\xfd\xa0\xfb\x92\x03\x40\xf7\xbf\x43\x6e\x81\x7e\xaf\xb5\x05\x3d\x2a\x76\xe6\x2d\x74\x02\x1f\x5c\xf7\x43\x1f\xcb\x0e\x00\x3f\x28\xdd\xd8\xf3\x9c\xa3\x2f\xf0\xaf\x05\x6d\x46\xee\x7d\xce\x53\x15\x8c\x74\x9c\x01\xce\xe3\x01\xfa\x8e\x42\xc3\xc1\x20\x23\xf1\xa2\x77\xfb\x68\xa0\x3b\xb9\xdb\x81\x18\x37\x1c\xdf\x60\x69\x16\x9c\x74\x1f\x94\x78\x84\x01\xc6\xe5\xe8\x61\xa8\xa9\xa9\x05\x6f\x0a\xe8\x0b\x70\x20\x7a\xdc\x5f\x49\x2a\x78\xe8\x14\x4a\xf4\xdd\x2f\x8f\xba\xf0\x00\x49\x44\xa9\x1b\x33\x28\xa4\x66\x46\xaa\xfd\xc8\x31\xd4\x36\x3a\x26\x51\x6f\xeb\x1b\xc6\xb1\xfc\x20\x79\xd4\x6a\x1d\x23\x89\xd7\x78\x99\xbe\xe2\x49\x59\xc9\x28\x28\xbc\x6a\xbb\xcf\x04\x67\x22\xbe\xd1\xc1\xab\xe9\xc5\x2c\x2e\x05\x41\x32\xc3\xca\xab\xc6\xe1\x9a\x77\x34\xf7\xfa\x37\xaf\xd7\x96\xce\x9b\xd1\x44\x2b\x27\x81\x74\x4a\x23\x08\xa4\x6e\x19\xa8\x66\x0a\xa7\x9c\x5b\xdb\x39\xf4\x59\xfb\xf9\xaa\xca\x39\x59\x5b\x63\xa4\x68\x30\xde\x28\x00\x81\xc2\xc7\x17\x6d\x51\x9a\x3e\x05\x40\xde\x54\xd8\xe1\x4e\xd7\x2c\xfa\xd2\xda\x77\x52\x18\x78\x36\xbe\x6a\x4b\x59\x03\x84\xc7\xaa\xa8\x90\x05\x13\x1b\x64\x8b\x3a\x4e\xe3\x7f\x0e\xc4\x57\x23\x46\x59\xc0\x88\x12\x43\x66\x99\xd3\x14\x66\x89\xbf\x6d\x33\x5a\x4c\x9c\x44\x6a\x85\x26\x25\xd0\xba\x9f\xa3\x4a\x49\x14\x23\x86\x49\xe1\xfa\xaf\x04\x65\x80\x22\xc3\xc4\x1e\xaf\xae\x94\xa5\xc0\x99\x7b\xbf\x74\xc6\xc1\x77\x89\x4b\x01\xb8\x86\x9e\xbc\x6d\x41\xdd\x63\x7c\x3b\xf2\x05\x69\x28\x2a\xa0\x28\x3c\x75\xce\x62\xb5\x70\x89\xc7\xb5\x8a\x7b\x60\xbf\x13\x0d\x9f\x98\xd8\x56\xd5\xbc\x2e\x32\x77\xd2\x7a\x5e\x3f\x73\xfb\xe5\x8c\xfa\xd5\xc7\x59\x78\x5f\x55\x97\x6c\xc6\xc7\xdd\xa6\x4d\xb2\x9a\x1e\x59\xd4\x43\x7f\x38\x18\xd8\x15\x9c\x18\x08\x02\x2f\x16\xe2\xce\x68\x22\xdd\x49\x4a\x38\x46\x49\x55\x4d\xff\x1f\x00\xfb\xf8\x9a\xfc\x4e\x16\x00\x00"
 
 func repoSettingsWebhookSettingsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2372,8 +3256,8 @@ func repoSettingsWebhookSettingsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/settings/webhook/settings.tmpl", size: 5033, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x56, 0x51, 0x47, 0xf3, 0xb5, 0xca, 0xf7, 0x12, 0x55, 0xf1, 0x6b, 0xaa, 0x1d, 0x98, 0x43, 0x11, 0x63, 0x5f, 0xf4, 0x89, 0x3e, 0x5c, 0x19, 0x2c, 0x36, 0x9b, 0x9f, 0x4c, 0x7a, 0x7b, 0x3e, 0xd5}}
+	info := bindataFileInfo{name: "repo/settings/webhook/settings.tmpl", size: 5710, mode: os.FileMode(0644), modTime: time.Unix(1792289153, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa1, 0x46, 0xef, 0xc5, 0xd6, 0xc8, 0x2d, 0x92, 0x64, 0xe0, 0x69, 0xc1, 0xcd, 0xd4, 0x9f, 0xf, 0xff, 0x83, 0x27, 0xa, 0x1a, 0xfd, 0xa7, 0x6a, 0x8d, 0xa7, 0x4a, 0xf1, 0x99, 0x95, 0xe8, 0xa6}}
 	return a, nil
 }
 
@@ -2417,7 +3301,7 @@ func repoUser_cardsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoView_fileTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x6d\x6f\xe4\xb6\x11\xfe\xbc\xfe\x15\x2c\xeb\xe2\x76\x01\xaf\xe4\x0b\x0e\x45\xd1\x68\xb7\x68\xee\x2e\x88\x0b\xe7\xee\xe0\x73\xf2\x29\xc0\x86\x12\x67\x57\xb4\x25\x52\x20\xa9\x5d\xbb\xaa\xfe\x7b\x31\xa4\xa8\x97\xf5\x4b\x7c\x45\xae\xf1\x97\xa5\xc8\x99\xe1\x33\xf3\x90\x33\x43\x27\x5c\xec\x89\xe0\x2b\xba\x15\x05\x2c\x33\x25\x2d\x48\x4b\x49\x56\x30\x63\x56\xb4\x69\xae\x59\xfa\x59\xfc\x1b\xde\xe2\x37\x89\xde\x73\x61\x95\xce\x94\xdc\x8a\x1d\x89\xbe\x17\x05\x7c\x60\x25\xb4\x2d\x5d\x9f\xcc\x92\xfc\x4d\x50\xab\x05\xb1\xaa\x22\xcc\x5a\x96\xe5\xc0\x49\x0e\x8c\x83\xa6\x6e\x9f\xa6\x11\x5b\x12\x5d\x01\xe3\x25\xbc\xbf\x13\xc6\xb6\xad\x86\x4a\x2d\xb5\x9b\x69\x1a\x28\x0c\x8c\xa6\x96\x88\xab\x69\x40\x72\xbf\xcb\xec\x11\xfd\x93\xd9\x6c\x96\x88\xb0\xb9\xca\xac\xc8\x94\x24\xdd\xef\x32\x55\xea\x96\xae\x93\x58\xa0\xf6\x44\xfd\x42\x5e\xf6\xfa\xb3\xc4\x58\xad\xe4\x6e\xdd\x34\x23\xbf\x92\xb8\x9b\xf5\xaa\x1e\xdb\x0b\xc4\x49\x62\x2a\x26\x03\x22\x0b\x77\x96\xec\x34\xdc\x13\xa9\x74\xc9\x0a\xba\x6e\x1a\x54\xc2\xc0\xfa\x28\xe2\xc8\xa9\x57\x4c\x86\xbd\xd0\xe3\x93\xe9\xae\x4f\x3b\xe9\xd8\x73\xfb\xd4\x82\x14\xb0\xb5\x83\xc7\x5f\x17\xe9\x18\xa8\xd8\x12\xa9\xec\x63\xd1\x75\xa7\x6c\x38\x1c\x5a\xec\x72\x4b\x1c\x66\x96\x59\xa1\xa4\x71\xd4\x3e\x90\x4b\x6b\x6b\x87\xc5\xd1\x0e\x17\xe6\x67\x01\x87\xb7\xaa\x2c\x45\xe0\x6f\x36\x4b\xd8\x03\x55\x4a\x72\x0d\x5b\x3c\x74\xd1\x15\x54\xea\x52\xc8\xdb\xb6\x8d\x8d\xce\xe2\xa6\x89\xbc\xfa\xc5\xbb\xb6\x8d\x9b\xe6\xbd\xc9\x58\x05\x9f\x54\x2d\x39\x89\xae\x35\xc0\x27\x66\x73\x3c\x72\x4d\x13\x89\xd7\x7f\x93\xd1\xb5\x26\x14\x4f\x65\x84\xb0\x37\x15\x60\x78\x84\xbc\xa5\x18\x0c\xd6\x03\x0c\xc1\xf8\x12\x38\x99\xc3\x61\x8e\x41\x7c\xa7\x99\xcc\x72\x4f\xd7\x97\x03\xcc\x85\xb1\x4a\xdf\x4f\xe0\x3d\x8b\x68\x6c\xff\x34\xba\x62\x07\x64\xdb\x23\x7c\x6a\x0f\xcd\x0e\x63\xfb\x49\xcc\xc5\xde\x0f\xc3\x2d\xab\x94\xc1\x84\x71\x1f\xbd\x65\xf2\xbd\x64\x69\x01\x3e\x83\x84\x18\x79\x39\x5c\xe4\xc2\xe2\x86\x63\x32\x1f\x8b\xd5\x06\xb8\xb0\xff\x6b\xa4\x9e\xbe\x3e\x15\xc8\x4c\x14\x24\xb5\x72\x19\x96\x2a\x55\x09\xb9\x23\x75\x45\x09\xe1\xcc\xb2\x90\x1b\x1d\xa2\x00\xf7\x5a\xa9\xc2\x8a\xaa\x6d\xa9\x97\x71\xfe\x0a\x25\x57\x34\x55\xd6\xaa\x92\x64\x20\x2d\x66\x3e\xb7\xba\x67\x5a\x30\xbf\x6c\x85\xbc\x27\x42\xee\x41\x5b\xe0\xfe\xae\x4e\x8e\xd1\x90\x68\x9e\xbb\xf5\xcf\xc1\x26\x5c\x18\x0c\x38\xa7\xff\x1f\xf8\x8f\xdc\x80\x9e\xdd\x77\x50\x80\x85\x17\xf1\xcb\x9d\xe8\xef\xcf\xb0\xd5\xcc\xe4\x19\x93\x93\x60\x8d\xc6\x4b\xce\xe4\x0e\xf4\xf3\xb4\x0f\x7e\xfc\xc1\xc4\x3f\xea\xcd\x8b\xa8\xff\x3a\x2e\x3c\x24\x7f\x34\xee\xf3\x42\x3f\x97\xc4\xf9\x1b\xec\x18\xa6\xd9\xbe\x96\xc6\xb2\xec\x16\x91\x0f\xad\x83\x75\x9f\x06\x76\x25\xf6\x25\x68\xa5\xef\x58\xfc\xf1\xba\x30\x17\x9f\xee\x6d\xae\xe4\x07\x65\x01\x6b\x7d\xdb\x8a\xca\x4d\x2c\x65\x37\x13\xda\x87\xb0\x97\x2b\x3c\x7b\x01\x07\x12\x4c\xfc\xc8\xf4\x2d\x57\x07\xd9\xb6\x65\x37\xf2\x54\x90\x97\xef\x10\xa4\xa7\xc5\xaf\x2a\x98\x90\xae\x30\x0f\x22\x0c\x4f\xed\x85\xb9\x86\xbb\x2e\xe5\x65\x8a\x7b\x3c\x1d\x50\x92\x33\xb3\x84\x52\xdd\x08\x3a\xea\x59\xc6\x28\x47\x49\x16\x4d\xbc\xf5\x04\xb7\x6d\x57\xe3\xbb\x6f\xf2\x1f\xf2\xd9\xea\x6f\x7e\xb8\xfe\xf1\x12\x97\x7a\x42\x9e\xf7\xad\x6b\x6f\x32\x2d\x2a\xdb\x11\xbb\x67\x9a\x68\x90\x1c\x34\x70\xb2\x22\xb2\x2e\x8a\x6f\xfd\xca\x69\xb4\x03\xfb\xaf\xcf\x1f\x3f\xcc\xdd\x45\x9e\x54\x8d\x33\x27\x78\x46\xb6\xb5\x74\x45\x7e\x1e\xa2\xb5\xb9\x31\x4a\x2e\x48\xd3\x1d\x73\xb4\x1e\x96\xd0\x7a\x1a\x55\x4c\x1b\x38\x12\xef\x36\x9c\x8d\x71\x74\x02\x91\x9f\x9b\xf7\x32\xa7\x73\xfa\xe7\x63\x8a\xe8\x22\x62\x55\x05\x92\xcf\x83\x85\x67\xc5\x09\x92\x42\x17\x11\xb0\x2c\x9f\xf7\x1e\x88\x33\x92\x16\x2a\xbb\x1d\xc0\xcf\x4e\xe7\x7e\x26\x62\x9c\xbb\x2e\x79\x4e\xab\x7b\x3a\xfd\x44\xcb\xb4\xdf\x6e\x96\x17\x37\x26\xca\xc5\x2e\x2f\xb0\x0f\xfa\x0e\xd5\x3b\x23\x41\xa4\x5d\x7c\x7b\xd2\x0d\xe3\x98\x7c\xdc\x83\x3e\x68\x61\x81\x88\x92\xed\x80\x94\x60\x73\xc5\x89\x55\xc4\x7b\x44\x2a\xad\x2a\xcc\x5d\x1a\xb6\xe2\x0e\xe7\x6d\x0e\xc4\xa8\x5a\x67\x40\x7e\xba\xba\x1c\xc5\xb9\xf3\x5d\x63\xf4\xe0\x40\xf0\xb4\x03\x8f\xae\xba\xd9\x21\x82\x28\xeb\xd2\xc6\x9d\x25\x2b\xf2\xea\x98\xdb\x57\x41\x6e\x90\xe9\x46\x91\xa9\x53\x63\xb5\x90\xbb\xf9\xf9\x59\x3f\x59\x30\x63\x2f\x24\x87\xbb\x8f\xdb\x39\x8d\xe9\xe2\x98\x4d\x1d\x79\xcf\x56\xfd\x61\x21\x73\x2c\x0f\x67\xc4\x0a\x5b\xc0\x19\x41\x2b\xa3\xa0\x6b\xb0\xb5\x96\xe4\xd7\x44\x94\x3b\x62\x74\xb6\xa2\xa7\x4d\xb7\x57\x1b\x9f\x36\xa8\xda\xd2\x5f\x43\x30\x9f\xa3\x39\x92\xe9\x32\xdc\xf9\x65\x06\x45\xf1\x18\xe7\x41\x60\x42\x7b\x3f\x19\xe5\xb6\x2c\xe6\x3e\x94\xf3\x07\xf3\x8b\x33\xd2\x04\x2f\xff\xde\xc7\xbf\x5d\x4c\xb8\x9e\x0c\x92\x78\x74\xf9\x9e\xcc\x2c\xbf\x57\x0a\xe8\xfa\xe9\x21\x1b\x3d\x68\xc4\x31\x33\x2d\x35\x3b\xe0\xd3\xa2\xab\x09\xe3\x86\x1c\x73\x08\x92\x37\x2d\xef\x3d\x31\xbf\xd1\x59\x8e\x0b\x5f\x97\x91\x7e\x16\x1c\xd4\xd4\xda\x1e\xa7\xdc\x69\xd2\xaa\x30\x5f\x60\x78\xf2\xfc\x99\xb6\xb0\xce\xe6\x46\x2a\xbb\x31\x75\x55\x29\x2c\x66\x1b\x21\x37\xa9\x56\x07\x03\x9a\x1e\x3d\xfe\x1c\x2f\x4e\xe5\x31\xc8\x9f\xde\x7d\x7f\xe4\xfe\x56\xb3\x12\xc8\x41\x70\x9b\xaf\xe8\xeb\xf3\xf3\xbf\x50\x92\x03\x5e\xf7\x15\xfd\xeb\xf9\x79\x75\x47\x83\x13\xff\xac\xaa\xcf\x75\xfa\xd3\xd5\x65\xdb\xc6\x55\x51\xef\x84\x34\x71\xc5\xb7\x37\x66\xf9\x3a\x7a\x13\x7d\x73\x1e\x1f\x20\x8d\x91\x02\xd0\xee\x40\xfd\x03\xab\xd7\xea\x37\x9c\x4f\x62\x0f\xe0\x89\xbe\x82\xbd\xa8\xeb\x27\x1a\x8a\x15\x95\x6a\xab\x8a\x42\x1d\xfa\xea\x99\x5a\xdf\x76\xec\x34\xbb\x77\x03\xcd\xb8\xa8\xcd\x53\x8f\x04\x84\x7e\xfc\x52\x98\x74\x0a\xc3\xb3\x61\x14\xd2\xe1\xa9\xe9\x65\x5c\x1b\x10\x9e\x31\x36\x55\xfc\xbe\x67\xc5\xea\x30\x0c\xc7\xb1\xeb\x6e\x2e\x99\xde\x0d\x4e\xcf\x12\xcb\xd7\x4f\x1e\x06\x07\xd5\x2a\xb5\x29\x50\x69\x4c\x7e\x12\x5b\x3e\x6c\x30\x89\x23\x9a\x0c\x51\x29\x84\x04\xb3\x94\x75\xe9\xe2\x70\x29\x24\x7c\xa8\x4b\x83\x76\x06\xf5\x87\xf2\xae\xc8\xac\x93\x4a\xc3\x3a\xc1\xf1\xf0\x7f\x97\xe8\x87\x50\x1f\x5c\x15\x71\xa4\xaa\x62\xac\x2d\xeb\xd2\x07\x7d\x72\xfd\x93\x58\x15\xeb\x24\x46\x63\xeb\x24\x76\x86\xa7\x0e\x0c\xed\xf9\x2c\x89\xfb\xd8\x25\xf1\x28\xa8\x49\x3c\x84\x7b\xd0\x08\x3c\x75\xbf\xdd\xcf\x49\xdf\x29\xf4\x99\xdb\xd4\x69\x29\x6c\xd7\x68\x2a\x5d\xce\x31\x6d\x12\x42\x08\x16\x95\x12\x8c\xf1\x99\xbe\xd2\xaa\xac\xac\xeb\x1b\xa6\x5c\xf8\x27\xc0\xc6\xfd\x97\x49\x97\x9b\x4e\x83\xb6\xed\x2f\xf2\x17\xf9\xb4\x34\x3e\xa2\x37\xa6\x2e\x4b\xe6\x9e\xbd\xf4\x8c\x50\x8f\xc1\x95\x2f\x7c\x25\xf8\xf7\xc3\x2b\xac\xc4\x08\x47\x6c\xc9\x3c\xc0\xf9\x93\xef\x6a\x02\x52\xfc\xc3\x72\xe1\x8d\x2f\x03\x84\x45\xb4\x67\x45\xd0\xe9\xac\x1c\x89\xba\xde\x72\xab\x74\x49\x17\x91\x0f\xc4\x7c\xe1\xe4\xda\x93\xf6\x64\xc8\xec\xff\x0d\x00\x00\xff\xff\x9c\x73\xf7\xb0\x77\x13\x00\x00"
+var _repoView_fileTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x58\x5b\x73\xdb\xb8\x15\x7e\x96\x7f\x05\x8a\xba\x13\x69\xc6\x22\x9d\x9d\x4c\xa7\xd3\x50\x6a\xbb\x4e\xd2\xb8\xe3\x8d\x3d\xb6\xb3\x4f\x3b\xa3\x85\x88\x23\x11\x31\x09\x70\x00\x50\xb2\xca\xf2\xbf\x77\x70\xe3\x45\x96\x1c\xef\x76\xd3\xf5\x8b\x29\xf0\xdc\xbf\x83\x73\x61\x42\xd9\x06\x31\x3a\xc3\x2b\x96\xc3\x34\x15\x5c\x03\xd7\x18\xa5\x39\x51\x6a\x86\xeb\xfa\x9e\x2c\xef\xd8\xbf\xe1\xc2\xfc\x46\xd1\x7b\xca\xb4\x90\xa9\xe0\x2b\xb6\x46\xd1\xbd\x04\xb8\x21\x3a\x6b\x1a\x3c\x3f\x19\x25\xd9\x9b\xc0\x56\x31\xa4\x45\x89\x88\xd6\x24\xcd\x80\xa2\x0c\x08\x05\x89\xad\x9e\xba\x66\x2b\x14\xdd\x02\xa1\x05\xbc\x7f\x64\x4a\x37\x8d\x84\x52\x4c\xa5\x3d\xa9\x6b\xc8\x15\xf4\x8e\xa6\xc6\xae\xba\x06\x4e\x9d\x96\xd1\x01\xfe\x93\xd1\x68\x94\xb0\xa0\x5c\xa4\x9a\xa5\x82\x23\xff\x7f\xba\x14\xe2\x01\xcf\x93\x98\x19\xee\x01\xfb\x25\xbf\x6a\xf9\x47\x89\xd2\x52\xf0\xf5\xbc\xae\xa3\x0f\x2c\x87\x4f\xa4\x80\xa6\x49\x62\x7f\xea\x58\x9d\x6d\x2f\x20\x47\x89\x2a\x09\x0f\x16\x69\x78\xd4\x68\x2d\x61\x87\xb8\x90\x05\xc9\xf1\xbc\xae\x0d\x93\x09\x2c\x8a\xc2\x93\x65\x2f\x09\x0f\xba\x8c\xc7\x27\x43\xad\xc7\x9d\xb4\xe8\x59\x3d\x15\x43\x39\xac\x74\xe7\xf1\xb7\xb5\xb4\x6f\x28\x5b\x21\xc2\x29\x1a\x73\xa1\x87\x21\x9e\xa0\xb1\x90\x28\xba\x10\x14\xae\xb7\x1c\xe4\x67\x05\x52\xf5\x7e\xdf\x03\x29\xd4\xc4\xfb\xf8\xbc\x3d\x36\xf8\x75\x1d\xb1\xd7\x7f\xe1\xd1\xbd\x44\xd8\x64\x4a\x64\xdc\x5f\x88\x2d\x07\xba\x58\xee\xb0\x87\xa8\xae\x25\xe1\x6b\xd8\xd7\xeb\xdf\x8e\x12\x82\x32\x09\x2b\x93\x91\xd1\x47\x51\xc0\x15\xe3\x0f\x26\xc7\xfe\x5e\xd7\x51\x08\x12\x09\xfa\x82\x93\x87\xa4\x5a\xeb\x0f\x48\xfd\x47\x59\xde\x55\xcb\xcf\xb7\x57\x4d\x13\x0b\xb9\x8e\xeb\xfa\x34\xba\x85\x52\x28\x73\x87\x76\x91\xe5\xf5\x9a\x62\x6d\x64\xc4\x75\x1d\x5d\x89\x2d\x48\x77\x68\x4d\x39\xce\xf2\xac\x99\x47\x01\x7a\x82\x8d\xa7\x37\x65\xa0\xbb\xbd\x92\xad\x33\x8d\x6c\x52\x91\x54\x33\xc1\x95\x8f\xfc\x1e\xdd\xb2\xd2\xba\x7b\xd9\xd3\x70\xa9\x7e\x64\xb0\xbd\x10\x45\xc1\xc2\x05\xb3\xb1\xd9\x67\xc5\x1d\x06\xc6\x4f\x87\x41\xac\x64\x6a\xdc\x73\xec\x97\xef\xac\xb3\xef\x55\x4a\x4a\xb8\x11\x15\xa7\xc3\xca\x73\x38\x19\x4a\x30\xf9\xc2\xf8\x03\xee\x05\x68\x00\xe4\xcb\xcd\x49\xad\x1d\x6a\xdf\x88\xef\x25\xe1\x69\xd6\xa2\xf1\x0b\x0d\xcc\x98\x32\x98\x0e\xcc\x7b\xd6\xa2\xbe\xfc\xd3\xe8\x96\x6c\xcd\x75\x0c\x49\x7b\x58\x87\x24\xdb\xbe\xfc\x24\xa6\x6c\x13\x52\xc5\x5f\xd6\xe8\x52\x5d\x89\xf5\x1a\xc2\xb5\xbd\x54\xff\xac\x40\xe9\x49\x1b\xa4\x95\x90\x45\xb0\x8a\x32\x55\xe6\x64\x87\x18\xcf\x19\x07\x8c\x5c\x6e\xec\x87\x6b\x4b\x74\x9a\x4d\x4b\xa2\x33\x85\x51\x01\x3a\x13\x74\x86\x6f\xae\xef\xee\x43\x9a\x98\xfb\x7b\x71\x77\xfb\xe1\x5e\x3c\x00\xff\x78\xff\xc3\x55\x97\x21\x8c\x97\x95\x46\x7a\x57\xc2\x0c\x67\x8c\x52\xe0\x18\x71\x52\xc0\x0c\x97\x44\x6b\x90\x1c\xa3\x0d\xc9\x2b\x98\x61\x93\x20\xc3\x06\xf4\x35\x09\x12\x28\x93\x90\xea\x85\x16\xad\x14\x73\xe3\x7c\x08\x83\x00\x17\xf7\x3e\x10\x44\xb1\x14\x69\xc6\x77\x1e\x13\x54\x8a\x92\xf1\x35\xaa\x4a\x8c\x28\xd1\x24\xb4\xcd\x19\x7e\x02\x83\x8d\xc5\xc2\xc6\xc2\x3f\x1b\x60\x70\xd3\x78\x4e\x7b\xb5\x6d\x0c\x97\x42\x6b\x51\xa0\x14\xb8\x06\xe9\xdf\x6e\x88\x64\xc4\xbd\xb6\xea\x19\xdf\x80\xd4\x40\xf1\xfc\x78\x27\x80\x1d\xb8\xda\x9f\xc4\xce\xda\x90\x5b\xb1\x41\xf2\x50\x3d\x73\x0d\xb1\x2d\x32\x17\x84\xbf\xe7\x64\x99\x83\x6b\xf6\x01\x1b\x47\x67\x5e\x52\xa6\x4d\xea\xf5\xaf\xf5\xa1\x5b\xb3\x00\xca\xf4\xaf\xbd\x33\xc7\xfd\x2b\x81\xa7\x2c\x47\x4b\xcd\xa7\xe1\x55\x0f\x8e\xa7\x78\x04\x73\xef\x85\xc8\x35\x2b\xff\xf7\xc8\xdb\xd0\xf6\x0a\x4a\x37\x13\x8c\x46\xbf\xce\x6c\x44\x99\x32\x01\xa7\xf8\xff\x63\xfe\x81\x5a\xd8\xa2\xfb\x0e\x72\xd0\xf0\x22\x7c\xa9\x25\xfd\xed\x11\xd6\x92\xa8\x2c\x25\x7c\x10\xac\xde\xf3\x94\x9a\x06\x2c\x9f\x87\xbd\xf3\xe3\x77\x06\xfe\xa0\x37\x2f\x82\xfe\xdb\xb8\xf0\x14\xfc\xc1\xd8\xe0\x3b\x44\x7b\x96\xc4\xd9\x9b\xf9\xc9\x7e\xdf\xaf\xb8\xd2\x24\x7d\x30\x96\x77\x53\xbe\xb6\x3f\x15\xac\x0b\xe0\xda\xd6\xd3\x76\xb9\x70\xe9\x75\xa9\x2e\x6f\x76\x3a\x13\xfc\x93\xd0\x60\xc6\xf2\xa6\x61\xa5\x3d\x98\x72\x7f\xe2\xf5\xb6\x0b\x88\x1d\x41\x36\x0c\xb6\x28\x88\xf8\x81\xc8\x07\x2a\xb6\xbc\x69\x0a\xff\xe4\xa0\x40\x2f\xd7\x10\xa8\x87\x63\x50\x99\x13\xc6\xed\x0c\xdd\x91\xf8\x0e\x79\x0f\x8f\xbe\xe4\xa5\x82\x3a\x7b\xbc\xa1\x28\x23\x6a\x0a\x85\xf8\xc2\x70\x6f\xbd\xe8\x5b\xd9\x2b\xb2\x46\xc4\x85\x03\xb8\x69\xea\xba\xff\x1b\xfd\x07\xdd\x69\xf9\x9d\x6b\x86\x3d\x40\x9e\xf7\xcd\x6f\x22\xa9\x64\xa5\xf6\xc0\x6e\x88\x44\x12\x38\x05\x09\x14\xcd\x10\xaf\xf2\xfc\xad\x7b\x73\x1a\xad\x41\xff\xeb\xee\xfa\xd3\xd8\x5e\xe4\xc1\xfc\x70\x66\x09\xcf\xd0\xaa\xe2\xb6\xa5\x8f\x43\xb4\x16\x5f\x94\xe0\x13\x54\x9f\x8c\x5a\xe9\xe1\x95\x91\xbe\x8c\x4a\x22\x15\xec\x91\x7b\x85\xa3\xbe\x1d\x9e\x20\x72\x67\xe3\x96\xe6\x74\x8c\xff\xb8\x0f\x11\x9e\x44\xa4\x2c\x81\xd3\x71\x90\xf0\x2c\x39\x32\xa0\xe0\x49\x04\x24\xcd\xc6\xad\x07\xec\x0c\x2d\x73\x91\x3e\x74\xc6\x8f\x4e\xc7\xee\x24\x22\x94\xda\x85\x76\x8c\xcb\x1d\x1e\xfe\x34\x92\x71\xab\x6e\x94\xe5\x5f\x54\x94\xb1\x75\x96\x9b\x89\xf8\x7b\xc3\xee\x85\x04\x92\x66\xf2\xf6\xc4\x3f\xc6\x31\xba\xde\x80\xdc\x4a\xa6\x01\xb1\x82\xac\xc1\x8f\x3f\x48\x0b\xe4\x3c\x42\xa5\x14\xa5\xa9\x5d\x12\x56\xec\xd1\x9c\xeb\x0c\x90\x12\x95\x4c\x01\x7d\xbe\xbd\xea\xc5\xd9\xfb\x2e\x4d\xf4\x60\x8b\x4c\xb6\x03\x8d\x6e\xfd\x69\x17\x41\x43\x6b\xcb\xc6\xa3\x46\x33\xf4\x6a\x1f\xdb\x57\x81\xae\xa3\xf1\x4f\x91\xaa\x96\x4a\x4b\xc6\xd7\xe3\xf3\xb3\xf6\x30\x27\x4a\x5f\x72\x0a\x8f\xd7\xab\x31\x8e\xf1\x64\x1f\x4d\x19\x39\xcf\x66\x6d\xb2\xa0\xb1\x69\x0f\x67\x48\x33\x9d\xc3\x19\x32\x52\x7a\x41\x97\xa0\x2b\xc9\xd1\xcf\x09\x2b\xd6\x48\xc9\x74\x86\x4f\x6b\xaf\xab\x89\x4f\x6b\xc3\xda\xe0\x9f\x43\x30\x9f\x83\x39\xe2\xcb\x69\xb8\xf3\xd3\x14\xf2\xfc\x10\xe6\x81\x60\x00\x7b\x7b\x18\x65\xba\xc8\xc7\x2e\x94\xe3\x27\xe7\x93\x33\x54\x07\x2f\xff\xda\xc6\xbf\x99\x0c\xb0\x1e\x3c\x24\x71\xef\xf2\x1d\xad\x2c\xbf\x55\x09\xf0\x03\x7a\x57\x8d\x9e\xac\x64\xa6\x32\x4d\x25\xd9\x9a\xaf\x00\xbe\x27\xf4\x57\x33\x53\x43\x0c\x78\xc3\xf6\xde\x02\xf3\x95\x1d\xa3\xdf\xf8\x7c\x45\xfa\x91\x51\x10\x43\x69\x1b\x73\x64\xb3\x49\x8a\x5c\xfd\x02\xc1\x83\x2f\x15\xc3\x29\xda\xca\x5c\x70\xa1\x17\xaa\x2a\x4b\x61\x9a\xd9\x82\xf1\xc5\x52\x8a\xad\x02\x89\xf7\xbe\xd3\x58\x5c\x2c\xcb\x21\x93\x6f\xde\x7d\xd8\x73\x7f\x25\x49\x01\x68\xcb\xa8\xce\x66\xf8\xf5\xf9\xf9\x9f\x30\xca\xc0\x5c\xf7\x19\xfe\xf3\xf9\x79\xf9\x88\x83\x13\xfd\x85\xbe\xcc\xab\x35\xe3\x2a\x2e\xe9\xea\x8b\x9a\xbe\x8e\xde\x44\xdf\x9d\xc7\x5b\x58\xc6\x06\x02\x90\x36\xa1\xfe\x66\xba\xd7\xec\x2b\xce\x27\xb1\x33\xe0\xc8\x5c\x41\x5e\xb4\xff\x21\x09\xf9\x0c\x73\xb1\x12\x79\x2e\xb6\x6d\xf7\x5c\x6a\x37\x76\xac\x25\xd9\xd9\x07\x49\x28\xab\xd4\xb1\x75\xd1\x98\xbe\xbf\x33\x0e\x26\x85\x6e\x81\xec\x85\xb4\xfb\x2a\xe4\x68\xec\x18\xe0\x99\x13\xbd\x14\x74\xd7\xa2\xa2\x65\xb7\x01\x3a\x34\xfc\x74\x73\x45\xe4\xba\x73\x7a\x94\x68\x3a\x3f\x9a\x0c\xd6\x54\x2d\xc4\x22\x37\x4c\x7d\xf0\x93\x58\xd3\x4e\xc1\x20\x8e\x46\x64\x88\x4a\xce\x38\xa8\x29\xaf\x0a\x1b\x87\x2b\xc6\xe1\x53\x65\x3e\xe0\xf4\xd9\x9f\xd2\xdb\x26\x33\x4f\x4a\x09\xf3\xc4\x3c\x77\x9f\x48\xa3\x8f\xa1\x3f\xd8\x2e\x62\x41\x15\x79\x9f\x9b\x57\x85\x0b\xfa\xe0\xfa\x27\xb1\xc8\xe7\x49\x6c\x84\xcd\x93\xd8\x0a\x1e\x3a\xd0\x8d\xe7\xa3\x24\x6e\x63\x97\xc4\xbd\xa0\x26\x71\x17\xee\x8e\x23\xe0\xe4\xff\xfb\x7f\x27\xed\xa4\xd0\x56\x6e\x55\x2d\x0b\xa6\xfd\xa0\x29\x64\x31\x36\x65\x13\x21\x84\x4c\x53\x29\x40\x29\x57\xe9\x4b\x29\x8a\x52\x8f\x9f\xae\xb7\x6e\x05\x58\xd8\x0f\xc2\xb2\x58\x78\x0e\xdc\x34\x3f\xf1\x9f\xf8\x71\x6a\xf3\x39\x65\xa1\xaa\xa2\x20\xf6\x03\x08\x3e\x43\xd8\xd9\x60\xdb\x97\xd9\x12\xdc\xfe\xf0\xca\x74\x62\x63\x0e\x5b\xa1\x71\x30\xe7\x0f\x6e\xaa\x09\x96\x9a\x3f\xd3\x2e\x9c\xf0\x69\x30\x61\x12\x6d\x48\x1e\x78\x26\x6f\x0f\x91\xda\xd9\xd2\x2c\xc5\x78\x12\xb9\x40\x8c\x27\x96\xae\x39\x69\x4e\xba\xca\xfe\xdf\x01\x00\x98\x02\x6d\xae\x22\x17\x00\x00"
 
 func repoView_fileTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2432,12 +3316,12 @@ func repoView_fileTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/view_file.tmpl", size: 4983, mode: os.FileMode(0644), modTime: time.Unix(1582133193, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa2, 0x6f, 0xd7, 0xaa, 0xde, 0x42, 0x19, 0xb3, 0x6e, 0xe6, 0x56, 0xa5, 0x78, 0x70, 0xda, 0x65, 0x6, 0xf7, 0x52, 0xa7, 0x53, 0x56, 0xec, 0xcb, 0x8e, 0x59, 0x94, 0x0, 0x44, 0x4b, 0xb5, 0x23}}
+	info := bindataFileInfo{name: "repo/view_file.tmpl", size: 5922, mode: os.FileMode(0644), modTime: time.Unix(1792286671, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x33, 0x7b, 0x23, 0x29, 0x26, 0xbe, 0xc3, 0x77, 0x75, 0x90, 0xa1, 0x56, 0x6, 0x98, 0x44, 0xb5, 0x6c, 0x82, 0x6e, 0xd5, 0xee, 0xf4, 0x85, 0x8, 0xae, 0x45, 0x60, 0x23, 0x8e, 0x2a, 0x9a, 0x1}}
 	return a, nil
 }
 
-var _repoView_listTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4d\x6f\xe3\x36\x10\x3d\xcb\xbf\x62\x20\xf8\x6a\xa9\xd9\xde\x0a\x59\x58\x77\x37\x45\x02\x38\x45\x60\x27\xe8\xb1\xa0\xa5\xb1\xc4\x86\x1f\x02\x49\x6d\x6c\xa8\xfa\xef\x05\x49\x7d\x59\x71\x76\x9b\x3d\x29\x21\x87\xc3\x37\x6f\xde\x3c\x3a\x31\xe4\xc0\x10\x68\xbe\x0e\x15\x56\x72\x75\xa4\x0c\xf5\xca\x2d\x86\x90\x31\xa2\xf5\x3a\xac\x29\xd4\x42\x1b\x92\xbd\xb8\xd8\x23\x3d\x61\x0e\x9a\x8a\x82\x21\x30\x2a\x10\x7c\x78\xba\x08\x12\x53\x22\xc9\xd3\x45\x10\x24\x46\xd9\x8f\x5d\xe9\xd3\x1c\x65\xad\xe0\x95\xe6\x2e\x32\x08\x82\xa6\xa1\x47\x88\xb6\xc4\xa0\x36\x5f\x24\xe7\xd4\x3c\x6b\x54\x6d\xeb\x36\x83\x84\xf2\x62\x02\x80\x7c\x23\x86\x28\xa0\x9c\x14\x08\x94\x17\xab\x9b\x4f\x21\x68\x95\xad\xc3\xa6\x79\x93\x23\xda\x21\xdb\xb8\x03\x5b\x2a\x5e\xda\x36\x84\x38\xed\xb2\x12\x28\x15\x1e\xed\xa9\x4d\x55\xed\xeb\xc3\xf3\x6e\xdb\xb6\xf1\xb5\x1c\x7f\x12\x8e\x6d\x1b\xa6\x89\x36\x4a\x8a\x22\x9d\xc5\x44\x9b\xda\x94\xb2\x0f\x4b\xe2\x2e\x2a\x89\x49\x5f\x1d\x32\x8d\x3f\x51\xcd\x08\x1c\xae\x5e\x78\xcb\x09\x65\x17\x35\x7d\x08\x60\x0f\x4e\xe4\x1d\xb6\x84\x80\x42\xb6\x0e\x85\x3c\x4a\xc6\xe4\xeb\xb4\xed\xba\x24\xc0\xc8\x01\x59\x38\xd0\x16\xed\xb0\x92\x9e\xd6\x38\x73\x17\xcd\xd9\x8b\xee\xbf\x5a\x78\x97\x49\xd3\xa6\xd9\x97\x52\x99\xfd\xdd\xe6\x06\xe6\xe1\xd1\xde\x28\x2a\x0a\x8b\xb2\x63\x2f\xd1\x15\x11\x3d\x90\x42\xe1\x19\x4a\xa2\x57\xc8\xe5\x3f\xd4\xa6\xda\xa1\xc8\x51\xf9\xf3\x0f\xa8\xb5\xa5\xf1\x48\x98\xc6\x59\xea\x7d\xcd\x39\x51\x67\x18\x40\xc3\xd2\xfd\xa9\xa9\x91\xea\x1c\x7d\x91\xbc\x92\x1a\x1f\xd0\x10\x0d\xff\xc2\xde\xa8\x4f\x77\x4f\x0f\x5b\xc7\x56\x45\x84\x17\x70\x6c\xca\xb9\x92\x85\x15\xfd\xa8\xe4\x6b\x21\xa6\x54\xe8\x63\xc0\xe0\xc9\x80\xab\x41\xd1\xa2\x34\x40\x0a\xb4\x35\x3c\x51\x8e\x7b\x2a\xb2\x39\xe6\xae\x6d\x7f\x95\x28\x60\x19\x6d\x89\xe7\xc5\xdf\x90\xc4\x6e\xac\xec\xbf\x7e\xce\x12\x73\x90\xf9\xd9\x6e\xf9\x69\xba\x23\xfa\x91\x28\x14\xe6\x91\x98\xd2\x37\x38\x31\xaa\x07\x65\x39\xac\xdc\x76\x37\x82\x89\xc9\x21\x93\xcc\x16\xbb\x0e\x7f\x0d\xd3\x84\xf6\xa1\x32\x33\x34\x93\x02\xba\xef\xca\x8a\x6e\xa5\xb0\x62\xe7\x30\x4d\x62\x9a\x4e\x06\xe9\x56\x67\xa4\xc2\x47\x59\x8b\x1c\xa2\xdf\x15\x11\x59\xe9\xf5\xd1\x34\xd1\x14\x4c\x98\x46\x91\x6d\x70\x12\x9b\xbc\xe7\x4d\x79\xe8\xbd\x1a\x9b\x46\x11\x51\x20\x2c\xa9\x41\x0e\xbf\xad\x21\xfa\xc3\xfa\x91\x2f\xa4\x69\x96\x28\x8c\x3a\xdb\x75\x2a\x72\x3c\x75\x61\xbf\x0c\xdb\x5e\x8f\xf3\xfd\x9b\x81\x87\x89\xef\xf8\x54\xd1\xbd\xde\xd7\x87\x07\x99\xd7\x6c\x1c\xd5\x0e\xdd\x5c\x85\x73\x46\xac\x53\xae\x74\x7d\xe0\xee\xb4\x65\x65\xd0\x8c\x47\xa3\xf0\xf8\xbc\xdb\x5a\x34\x1d\xb0\x68\xe7\x57\x36\x55\x65\x3f\xcb\x0b\xb2\x86\x73\x16\x9c\x3f\x3a\x2c\x4e\x6d\x6b\xd8\xb3\x1a\xea\xaa\xe8\x47\x9c\xa4\xf0\x19\xae\xc5\x8e\x93\x3a\x81\xe2\xc6\xf4\x62\x2e\x67\x9b\xc3\x34\xce\xdd\x6c\xec\x45\x77\x35\x7c\x86\xef\xe4\x19\x73\x0c\xa6\x13\x0c\x22\x98\x1b\xa5\x55\x64\x37\x65\x84\xf7\x4f\xc5\xbc\x69\x17\x94\xfd\x9f\x36\x9d\x39\xa3\xe2\xc5\xfd\xf3\xb6\x53\x97\xa5\xfd\x38\x9b\xc3\x22\xd5\x08\xe7\x2b\x55\x6d\x9b\x53\x85\x99\xb5\x95\x3e\xa1\x9d\xfb\xae\xe6\x2b\x77\x8e\x54\x04\xef\xcc\xd2\x32\x7a\x52\x88\x9d\xd5\xce\xb6\xa6\xe4\x5f\x15\xc2\x15\x96\x47\xc7\x1f\x39\xe6\x9d\x79\x66\x92\x31\x52\xd9\x37\x7d\x6a\xb4\xc3\x9b\xf9\x91\x07\x62\x79\xf5\x85\xe8\x25\xf1\xbe\xe8\xae\x3d\x03\xc1\xf7\xac\xbe\x3f\xd8\x9b\xfc\xf2\xa3\x2e\xbf\xb8\xa4\x68\x42\xcb\x0f\x2d\xbb\xbf\xdb\xe3\x32\xf8\xd6\xaf\xdf\xb1\xb8\x24\xee\x1c\x3b\x89\xdd\xaf\xa6\x74\xe1\xd4\x44\xac\x77\xee\x90\xe4\x1c\x6f\x4f\x54\x1b\x88\xee\xf5\x13\x9e\x8c\xb5\x3f\x7b\xac\x69\x0c\xf2\x8a\x11\x83\xe0\x7e\xa7\xc5\xdf\x28\xbe\xfe\xed\xe4\x0c\x51\xdb\x2e\xfa\xfc\xff\x05\x00\x00\xff\xff\x1c\x64\x18\xf2\xcf\x09\x00\x00"
+var _repoView_listTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x4f\x8f\xdb\xb6\x13\x3d\xdb\x9f\x62\x20\xf8\x6a\x0a\x9b\xdf\xe5\x87\x42\x56\xb3\x4d\x52\x24\xc0\xee\x62\x61\x6f\xd0\x63\x41\x4b\x63\x8b\x0d\x45\xaa\x24\xb5\xbb\x86\xaa\xef\x5e\x90\x14\xf5\x2f\x32\xb2\xe9\x49\xb6\x66\x34\x7c\xf3\xe6\xcd\x0c\x13\x43\x8f\x1c\x81\xe5\xbb\x48\x61\x25\xb7\x27\xc6\x51\x6f\xdd\xcb\x08\x32\x4e\xb5\xde\x45\x35\x83\x5a\x68\x43\xb3\x6f\xce\xf7\xc4\x5e\x31\x07\xcd\xc4\x99\x23\x70\x26\x10\xbc\x7b\xd3\xb0\x13\x90\x3b\xaa\xcd\x07\x59\x96\xcc\xe8\x3b\x26\xbe\xb5\x2d\xe4\xd4\xd0\x2d\xa7\xda\x6c\x33\xff\x7e\x5b\x2b\xbe\x8b\x9a\xe6\x7b\xdf\xa8\x69\x50\xe4\x6d\x9b\xae\x57\x89\x29\x90\xe6\xe9\x7a\xb5\x4a\x8c\xb2\x0f\xfb\x26\x20\x3a\xc9\x5a\xc1\x0b\xcb\x31\x72\x96\x55\x38\xda\x60\x08\xf8\x55\xa3\x6a\x5b\x67\x5c\x25\xac\x3c\x8f\x72\xa1\xcf\xd4\x50\x05\xac\xa4\x67\x04\x56\x9e\xb7\x37\xef\x22\xd0\x2a\xeb\x20\x4d\x63\x90\x3d\xf2\x5b\xf7\x41\x87\x10\xe2\xb4\x8b\x4a\xa1\x50\x78\xb2\x5f\xdd\x56\xd5\xa1\x3e\x7e\xdd\xdf\xb5\x6d\xbc\x14\xe3\x81\x96\xd8\xb6\x51\x9a\x68\xa3\xa4\x38\xa7\x33\x1f\x72\x5b\x9b\x42\x06\xb7\x24\xee\xbc\x92\x98\x86\xec\x90\x6b\xfc\x0f\xd9\x0c\xc0\x61\xf1\xc0\x4f\x25\x65\x7c\x92\xd3\x4f\x01\x0c\xe0\x6c\xc5\xd6\x1d\x25\x0a\xf9\x2e\x12\xf2\x24\x39\x97\x2f\x63\x05\xe9\x82\x02\xa7\x47\xe4\x51\x4f\x1b\xd9\x63\x25\x3d\xad\xb1\x97\xc6\x9c\x3d\xf2\xe5\xa3\x85\x37\x0d\x9a\x36\xcd\xa1\x90\xca\x1c\x3e\xdf\xde\xc0\xdc\x9d\x1c\x8c\x62\xe2\xdc\xb6\x3d\x7b\x89\xae\xa8\x08\x40\xce\x0a\x2f\x50\x50\xbd\xc5\x52\xfe\xc5\x6c\xa8\x3d\x8a\x1c\x95\xff\xfe\x1e\xb5\xb6\x34\x9e\x28\xd7\x38\x0b\x7d\xa8\xcb\x92\xaa\x0b\xf4\xa0\x61\xe3\x7e\x6a\x66\xa4\xba\x90\x0f\xb2\xac\xa4\xc6\x7b\x34\x54\xc3\x3f\x70\x30\xea\xdd\xe7\xa7\xfb\x3b\xc7\x56\x45\x85\x17\x70\x6c\x8a\xb9\x92\x85\xed\x9f\x41\xc9\x4b\x2e\xa6\x50\xe8\x7d\xc0\xe0\xab\x01\x97\x83\x62\xe7\xc2\x00\x3d\xa3\xcd\xe1\x89\x95\x78\x60\x22\xc3\xe5\x32\xff\x51\xa0\x80\x0d\xb9\xa3\x9e\x17\x7f\x42\x12\xbb\xb6\x4a\xe2\xd0\x67\x89\x39\xca\xfc\x92\xae\x43\x37\x7d\xa6\xfa\x91\x2a\x14\xe6\x91\x9a\xc2\x17\x38\x31\x2a\x80\xb2\x1c\x56\xce\xdc\xb5\x60\x62\x72\xc8\x24\xb7\xc9\xee\xa2\xff\x45\x69\xc2\x82\xab\xcc\x0c\xcb\xa4\x80\xee\xb9\xb5\xa2\xdb\x2a\xac\xf8\x25\x4a\x93\x98\xa5\xa3\x46\xfa\xa4\x33\x5a\xe1\xa3\xac\x45\x0e\xe4\x37\x45\x45\x56\x78\x7d\x34\x0d\x19\x83\x89\x52\x42\x6c\x81\x93\xd8\xe4\x81\x37\x95\xae\xc7\x6a\x6c\x1a\x45\xc5\x19\x61\x83\xc2\xa8\x0b\xfc\xb2\x03\xf2\xbb\x9d\x6d\x3e\x93\xa6\xd9\x78\xc5\x59\x83\x77\x21\x9e\xb4\x21\x53\x37\xb7\x9c\xc9\x42\xeb\x9c\x42\x2b\x0f\x63\xa7\x33\x7c\xd1\x87\xfa\x78\x2f\xf3\x9a\x0f\x9d\xda\x81\x9b\x8b\x70\x4e\x88\x9d\xb9\x5b\x5d\x1f\x4b\xf7\xb5\x25\xa5\x97\x8c\x87\xaa\xf0\xf4\x75\x7f\x37\x82\xda\x1f\x45\xf6\xde\x74\x5b\x55\xf6\xb1\x99\x90\xd6\x07\xb0\x28\x7d\x8c\xfe\xe5\x78\x7c\xf5\x36\xab\xa5\x49\x9e\x96\x63\x78\x0f\x4b\xbe\x43\xc7\x2e\x61\x72\x7d\x3b\x69\xd4\x6b\x5e\x7d\x9f\xce\xe7\x9c\xcb\x7c\x0c\x06\xde\xc3\x5b\x02\x0e\xc1\xfa\xb9\xb4\xea\x75\x32\x9f\xa5\x26\x0f\x45\x11\xb4\x0c\xdb\x64\x5e\xd8\x09\x9b\x6f\x29\xe5\xa5\xe4\x4c\x7c\x73\x7f\xbe\xaf\xe6\x34\xc7\x1f\x47\x73\x58\xa4\x1a\xe0\x7c\x64\xaa\x6d\x73\xa6\x30\xb3\x93\x27\x04\xb4\xa3\xa1\xcb\x79\xe1\xcc\x81\x8a\xd5\x95\x76\xdb\x90\x27\x85\xd8\x4d\xe3\x99\x69\x2a\xfd\x05\x8d\x2c\xb0\x3c\x2c\x85\x81\xe3\xb2\x9b\xaf\x99\xe4\x9c\x56\xf6\x06\x31\x9e\xc5\xeb\x11\xf5\xd9\xa8\x17\x7f\x7e\xb1\x6c\x16\x37\xcb\x26\x1b\xed\x94\xa9\x36\x47\x96\x89\x1e\xaf\x2f\x87\x4d\x36\x5d\x0b\x9b\x9f\xdd\x0b\xeb\xef\x4a\x33\xd0\x37\xa2\xec\xca\xc4\x1f\x73\x34\x9e\xff\x01\x96\x87\x6c\x30\xef\xe7\x7e\x77\xd2\xf5\x89\x99\xc4\xdd\x02\x48\x62\x77\x9f\x4b\xd7\x4d\xf3\xc2\x4c\x01\xe4\x91\x9e\x9d\x62\xdd\xb1\x67\x03\xe4\x49\x1a\xca\xed\x5b\x0d\x37\x0e\x7c\x92\xb3\xe7\x80\x38\x43\x61\x50\x41\x65\xb9\x3a\xd6\xc6\x48\xa1\xbb\xdd\x36\x72\xaa\x19\x1c\xa5\xca\x51\x71\xd4\xda\xfa\x32\x41\x0d\x93\x02\x4a\x14\x75\xd8\x28\x34\x78\xbb\x83\x85\x34\x7e\x29\x29\x7c\x66\xb2\xd6\xb6\x07\xb4\x05\x9a\x77\x29\x00\x33\x58\x46\x30\x6c\xaf\xde\x71\x59\xef\x5e\x1f\xbf\x5a\xa0\x3b\xbb\x5f\x7a\xf7\xd1\x2d\xd4\x5f\xb8\x02\x0e\x8e\x27\x03\x54\x29\xf9\x02\xb6\x3f\xfd\xfa\x02\xab\x37\x76\xf3\x7f\x41\x9e\x14\xb8\x4b\x34\x61\x5a\xd7\xa8\x49\xd5\x45\x8c\xfa\x02\xf7\x17\x3a\xbf\x9b\x1c\xb3\x7a\xd0\x02\x3b\x01\xfe\x0d\xe4\xa1\x2e\x61\x7b\x33\x16\x7f\x77\x7e\x48\xd8\x67\x9a\x12\xbf\x04\xd7\x4b\x83\x65\x46\x1e\xf9\xa2\x3f\xd4\xca\x2e\xd0\xb6\xa5\x99\x61\xcf\xb8\x40\x9a\x63\x78\xe4\xf8\x26\xda\x1e\xea\x72\xc4\x58\x78\x31\xc1\xd5\x2b\x7c\x76\x57\x5c\xaa\xee\x03\xbe\x9a\x1f\x56\xd6\x3b\xbd\x0d\x9e\x73\x9d\x55\xf4\x6a\xc5\x04\xbe\x9a\xa8\x6d\x61\x28\xb9\x9b\xc7\x5d\xeb\xd9\xc2\xfb\x9a\x4f\xca\x99\xc4\x39\x7b\x4e\xd7\xc3\x8f\x90\xe6\xf0\x64\x27\xa0\xf6\x4a\xb3\x47\x9a\x97\xf8\xe9\x95\x69\xc7\xf4\x13\xbe\x1a\x7b\x29\xf1\xcd\x65\xb0\xac\x38\x35\xe8\x21\xc5\xcf\x0c\x5f\xfe\x74\x2b\x04\xc8\x28\xd8\xbf\x03\x00\xd4\x26\xbd\x4d\xb1\x0d\x00\x00"
 
 func repoView_listTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2452,8 +3336,28 @@ func repoView_listTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/view_list.tmpl", size: 2511, mode: os.FileMode(0644), modTime: time.Unix(1583516842, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbf, 0xc4, 0x5a, 0x8, 0x9f, 0xea, 0x86, 0x82, 0xfb, 0x4c, 0x1d, 0xc4, 0x26, 0xe3, 0x7e, 0xf1, 0xe, 0x25, 0xb1, 0xf1, 0xd7, 0x7c, 0xc6, 0x3c, 0xbe, 0xa6, 0x31, 0x6f, 0x97, 0x51, 0x82, 0x2f}}
+	info := bindataFileInfo{name: "repo/view_list.tmpl", size: 3505, mode: os.FileMode(0644), modTime: time.Unix(1792280139, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4f, 0xa9, 0x10, 0xa4, 0x5c, 0xe8, 0x5e, 0xc, 0x38, 0x16, 0xb6, 0x22, 0x58, 0x2b, 0x28, 0xb6, 0xec, 0xc4, 0xa5, 0x71, 0x9e, 0x37, 0xba, 0xda, 0x70, 0x62, 0x92, 0x1, 0x11, 0x86, 0x7c, 0x45}}
+	return a, nil
+}
+
+var _repoWatch_pathsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x55\x4d\x6f\xdb\x38\x10\x3d\xcb\xbf\x82\x20\x72\x5d\x09\xbb\x48\x80\x3d\xc8\x3e\x6c\x82\x45\x0b\xa4\x48\x50\x1b\xe8\xd1\x18\x89\x63\x91\x08\x45\xaa\xd4\xd8\x8d\xa1\xea\xbf\x17\x14\x25\x4b\xb6\x0c\x27\x27\x81\xe4\xbc\x37\x5f\x6f\x46\x4d\x43\x58\x56\x1a\x08\x19\xcf\xa0\xc6\x44\x22\x08\xce\xe2\xb6\x5d\xa4\x42\x1d\x58\xae\xa1\xae\x97\xdc\x61\x65\x6b\x45\xd6\x1d\xd9\x2f\xa0\x5c\xfe\x55\x01\xc9\x9a\xaf\x16\xd1\x94\xc0\x5b\x75\x04\xe8\x02\x45\x34\xe5\xd8\x2b\x96\x5b\x43\xa0\x0c\x3a\x8f\x8c\x66\xbe\x41\xa3\xa3\x1e\x19\xa5\xf2\x7e\x82\x24\x5b\x31\x20\x82\x5c\xa2\x60\xbd\x0b\xcf\x11\x35\x4d\xac\xfe\xfe\xd7\xc4\x1b\x17\xfc\xc7\x5d\x7c\xdb\x10\x5f\x20\x4a\xe4\xbd\x37\xbd\x88\xe5\xc4\x56\x63\x51\xa2\xa1\x40\x97\x56\xab\x5b\x8c\xb1\xc0\x3a\xe7\x6d\x9b\x26\x55\xef\x5d\xed\x58\xfc\x0a\x24\x7f\x78\x23\xac\x3b\x8f\x51\x94\x12\x64\x1a\x27\xde\x0e\xe8\x8e\x2c\x83\x5a\xe5\xac\x7b\x0a\xde\xbc\x61\x66\xc5\xb1\x3f\x44\x4d\xe3\xc0\x14\x78\x8d\xb1\xb3\x75\x83\xa1\x3f\x88\x55\x9a\x5b\x81\x3e\xde\x57\x20\x42\x67\x7c\x5c\xdd\x55\x9a\x90\x38\x37\x0d\x81\x3e\xe1\x0e\xf6\x9a\xfe\x73\x60\x72\xf9\x62\xf4\xb1\x6d\x9b\xe6\xee\x66\xba\x1d\x60\x9b\x75\x88\xad\x35\xfa\xc8\x3d\x06\x75\x8d\x1f\x61\x41\xeb\x1e\x87\x75\x00\x19\xd1\xb6\xf3\xd0\xd2\xba\x02\x33\x94\x8a\xf0\x9d\x58\xe1\xf0\xc8\x57\x4d\xb3\x51\x25\xae\x95\xc9\x91\xc5\x8f\x0e\x81\x50\xb0\xbb\xf8\x19\x4c\xe1\x69\x3c\x6a\x9e\xe8\x49\xb0\xaa\x90\xc4\x40\xab\xc2\xa0\xe0\xa3\x49\x94\xee\xac\x2b\x19\xe4\xa4\xac\x59\x72\x9f\xc1\xb3\x32\x6f\x6d\x9b\x08\xd4\x48\xc8\x59\x89\x24\xad\x58\xf2\xd7\x97\xf5\x66\x0a\x8c\xbc\xed\xe3\xfa\xfb\xff\x1b\xfb\x86\xe6\xcb\xe6\xdb\xf3\xd8\x19\xcf\xab\x4c\xb5\x27\x46\xc7\x0a\x97\x5c\x2a\x21\xd0\x70\x66\xa0\xc4\x25\x57\x82\xb3\x03\xe8\x3d\x7a\x7f\xf1\xd7\xa7\xb6\x3d\xe3\x4d\xb3\x3d\x91\x35\x13\xb1\x38\x14\x8c\x94\x19\x04\x13\xde\xf9\xea\x76\xb9\x1d\x96\xf6\x80\x9d\x36\x03\x60\x9a\x74\xe2\xb3\x1e\x2f\xce\xca\x96\x26\xa3\xae\xfa\x26\x2d\x86\x87\x51\x9c\x69\xd2\xe9\xb6\x57\x7d\xe8\x7f\x78\xa8\xae\xf6\xee\x46\xa4\xc6\x1a\x3c\x9b\xa1\x93\xcf\x8b\x11\x15\xea\xa0\xba\x41\x4f\x13\xa1\x0e\x61\x3e\xbb\xf6\x8d\x26\xfe\xc8\x27\xed\xec\xbb\x79\xb5\x8d\x4d\x73\xb5\x7f\xe7\x7b\xee\xe7\x5e\xf9\xf2\xef\x14\xea\x93\x6e\x52\x0d\x19\x6a\xef\x6b\xc9\xab\x30\x6a\x1f\xa4\x38\x58\xf9\x2c\x3b\xf0\x6a\x31\x55\x89\x12\x23\x51\x2f\x92\xd3\xb1\x84\x77\x8d\xa6\x20\xb9\xe4\xff\x3c\x3c\x70\x36\x84\x34\x30\x9c\xca\x2d\x51\x57\x9f\x0b\x63\xeb\x4d\xfd\x4e\xfe\xcd\xd6\xb0\xc3\xb1\xf4\xd1\x58\xd8\xb3\x32\x9c\x67\x7f\xb1\xc3\x25\xe6\x6f\x99\x7d\x1f\x9e\x87\x9c\x42\x1a\xd7\x16\x46\x3f\x14\x73\x60\xa8\xcc\x07\xfb\xf6\xda\x02\xba\x28\xea\x24\x89\xcf\xe4\x33\x9b\xb7\xc2\x21\x9a\xc9\x98\xdd\x5a\x6a\x42\xcc\x46\x6c\xa2\xce\xd3\x9c\x0d\x77\xfd\xb7\xff\xcc\xfe\x78\x3b\x6b\x69\xf8\x59\xfe\x19\x00\x51\xd8\xc6\xbd\x8b\x07\x00\x00"
+
+func repoWatch_pathsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_repoWatch_pathsTmpl,
+		"repo/watch_paths.tmpl",
+	)
+}
+
+func repoWatch_pathsTmpl() (*asset, error) {
+	bytes, err := repoWatch_pathsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "repo/watch_paths.tmpl", size: 1931, mode: os.FileMode(0644), modTime: time.Unix(1792277264, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x94, 0x96, 0x4, 0x78, 0x3d, 0x8c, 0xf1, 0xd5, 0x3d, 0xfd, 0xe9, 0x7d, 0x93, 0x88, 0x41, 0x9, 0x69, 0x30, 0x81, 0x7f, 0xb3, 0x78, 0xe8, 0xad, 0xf4, 0xfe, 0xdc, 0xd5, 0xbb, 0xd3, 0x58, 0x17}}
 	return a, nil
 }
 
@@ -2537,7 +3441,7 @@ func repoWikiStartTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _repoWikiViewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x4b\x8f\xdb\x36\x10\x3e\x7b\x7f\x05\xa1\xee\xa1\x3d\x98\x46\x7a\x2a\x02\x3b\x40\xd1\x16\xd8\x00\x9b\x22\x88\x1d\xe4\x28\xd0\xe4\x58\x9a\x2e\x45\xaa\x24\xe5\xcd\x56\xd5\x7f\x2f\xf8\xd0\xcb\xd6\xa6\x2e\x7a\x92\x28\x0e\x67\xe6\xfb\xe6\x45\xb5\xad\x83\xaa\x96\xcc\x01\xc9\x8e\xcc\xc2\xa6\x04\x26\x32\x42\xbb\xee\x6e\x2b\xf0\x4c\xb8\x64\xd6\xee\x32\x03\xb5\xb6\xe8\xb4\x79\x21\xcf\xf8\x84\xe4\x8c\xf0\x9c\xbd\xbb\x5b\x4d\x8f\x7b\x99\x70\x1c\x4c\x54\xb0\x6a\x5b\x72\xef\xd0\x49\x20\x6f\x77\x84\xc6\xb7\xbf\xc9\x9e\x29\x74\xf8\x17\x78\x89\xa9\x8d\x06\x09\xd7\xca\x31\x54\x60\xbc\xee\xcb\xcd\xc2\xa0\x08\xdf\x2f\x37\x1c\x28\xf2\x8c\x02\x08\xd7\xb2\xa9\x54\x94\x99\x09\xf1\x52\x6b\x0b\xa4\x66\x05\xa4\xdd\x4b\x1d\x27\xa9\x99\x43\x55\x90\x13\x4a\x07\x86\x08\xa3\x6b\xa1\x9f\x55\x46\x04\x73\x6c\xad\xf4\xda\x80\x6d\xa4\xb3\xbb\xac\x6d\x29\xbe\xf9\x49\xd1\x83\x89\x98\x69\xdd\x48\x69\xa9\xd2\x79\x12\xc9\xba\xae\xb7\x72\x69\xe6\xc8\x2c\x72\x62\x2b\x26\x25\x39\x36\xce\x69\x35\x48\xae\xb6\xb6\x66\xaa\x97\x75\xf0\xd5\x8d\x5b\xab\x2b\x9b\x3e\x0a\x34\xe0\xe9\xba\xb7\x83\xd8\xd6\x3a\xa3\x55\xf1\xae\x6d\x23\xef\x5d\xb7\xdd\xa4\x4f\x83\x95\x8d\x37\x33\x2e\xb1\xb7\xd8\x23\x26\xc8\xbd\x57\xdb\x0d\x0e\x18\x36\x02\xcf\x4b\x80\x2a\x50\xcd\xc4\xff\x39\x54\xaf\x86\x58\x60\x86\x97\x04\x55\xdd\x4c\xe1\x8c\x56\x13\xdd\x57\x36\xbd\x8c\x3f\x44\x14\xab\x60\x97\x45\x3d\x19\xa9\x25\xe3\x50\x6a\x29\xc0\x2c\x04\x22\x90\x12\x35\xe6\x89\x1b\x4a\xe9\xc4\xc3\x29\x90\x99\xbf\x96\x1b\x2d\xa5\x8f\xff\x1c\xd3\xaa\x6d\x0d\x53\x05\x10\xfa\x91\x15\x60\x7d\xca\xae\x16\x8e\xa3\x83\x8a\xb4\x2d\x9e\x08\xfc\x49\xee\xe9\x21\x64\x3a\xfd\x9d\x55\xd0\x75\x16\x24\x70\x07\xa2\x6d\x41\x89\xae\x4b\x09\xd5\x18\xe9\x01\xdc\xd3\x4f\x50\xeb\x47\x54\x4f\x5d\xb7\xf1\xee\x6f\xda\x96\x7e\xfe\xf4\xe8\x33\xa8\x6d\x83\x86\x59\xc5\xcc\x11\xac\x92\xce\x45\x7c\xd3\xc5\xe4\x7d\x7c\x9d\xbc\xcd\x23\x67\xf1\xeb\xbf\xd4\x53\x83\x84\x71\x87\x3e\xc0\x21\x95\x63\x7c\x09\x8a\x5d\xc6\xa5\x56\xb0\xae\x99\x02\xd9\xd3\x18\x88\x51\xda\x91\x7b\xfa\x2b\x5a\x76\x94\xf0\x70\x38\x7c\x1c\xdc\xde\xc6\x42\xb8\xaa\x92\xa0\xa9\xaf\x92\xa0\xdb\x07\x79\x1d\x0d\x94\xce\xd5\x36\x71\x29\x51\x3d\x85\x6c\xf8\x82\x4f\xf8\x8b\xdf\xf6\x84\x52\x6f\x64\x3f\x29\xc5\xe8\xc7\x67\x0b\x69\x23\x3c\xda\x16\xa4\x85\xb8\x98\xb3\xb9\xdd\x44\xd3\x03\x88\xc9\xe6\x35\xa2\xfd\xfe\xe1\xff\x01\xb2\xb6\xfc\x36\x9c\x60\x61\x04\xb3\xdf\x3f\xdc\xe0\x68\x2a\xa2\x0b\x5b\x8d\x91\x19\x39\x33\xd9\x80\xb7\xb3\x1c\x1c\x9f\x9b\x57\x5c\xf6\x6c\xcd\x37\x83\x67\x43\x7a\x1b\x60\x42\x2b\xf9\xd2\xa7\xde\x2b\x64\x84\xf6\x90\xf6\x6a\x5d\xfb\xd2\x6b\x6a\xc2\x25\xd6\x47\xcd\x8c\xe8\x93\x29\x2d\xd7\x47\xd7\xb7\x62\x6d\xb0\x40\xc5\xe4\x42\xfd\x73\x5d\xbf\xe4\x9e\xbe\x6c\xa8\x33\xdb\x70\x0e\x76\xa9\x6b\x0f\xc2\x79\x92\x19\x0f\x81\x31\x7a\xa9\xbf\x8c\x47\x82\xc4\x78\xc0\xcf\x2d\x50\xee\x36\x97\xce\xcc\x20\xf3\xc5\xb3\xcb\x50\x9d\xc1\x38\x10\xc4\xa1\x7a\xe9\x75\x0d\x98\x1d\x33\x05\xb8\x5d\xf6\xdd\x45\xe8\x86\x0a\x1f\xba\xa8\xe6\x2e\xf0\x99\x9e\x41\x47\xfd\x32\x6d\xa8\xf3\x24\x59\x68\x02\xe3\xcb\xbc\xce\x05\x9e\x51\xf8\xe8\xa4\xc9\x1e\x4f\x4d\x47\x55\xc9\xec\x1a\x2a\xfd\x07\x66\xf3\xb9\xd3\x8f\x99\x90\x61\x4c\x09\x42\xdf\xdb\x4f\xc3\x3d\xe2\x8b\x41\xdf\xf8\xbf\xf7\xa9\x47\xc7\xcf\xf4\xbd\xfd\x80\x9e\xdc\x1f\x52\x0a\x5f\xb8\x63\xb0\x28\x87\x49\xb2\x65\xd3\xae\x35\x1d\xaa\xa4\x34\x70\x0a\xe1\xb8\xee\xaf\xbf\x59\xce\x6a\xf8\xa8\x1b\xef\x92\x6f\xeb\xa1\xdf\x6e\x72\x10\xe8\x42\xd7\x5d\x18\x2a\x7e\x2f\x8c\x94\x3c\x19\xf0\x08\xd9\x92\x1b\x85\x01\x50\xb7\x3a\x93\x2b\x7f\x8d\x5a\x36\xa9\xe0\xf9\x36\x8b\x06\xc4\xcc\x1e\x11\x20\xc1\xc1\x7a\x6e\x7d\x3e\x77\x6e\xa7\x25\x2a\x4b\xa7\x7d\x4d\xbe\x26\xf9\x1a\x8e\xa8\xe0\x35\x28\x63\x26\x4e\xfa\xd6\xe5\x3c\x6a\x8e\xb3\xec\x5b\x85\x34\xab\x60\x8f\x8a\x87\xab\xe5\x61\x58\xd0\x9f\x1b\x57\x6a\x43\xbf\x94\xa0\xc8\x3d\x7d\x64\xaa\x48\x79\xb4\xec\x9c\x64\xd6\xe5\x5c\x57\x15\xba\x1c\xd5\x49\x67\x83\x86\x30\x7a\x27\x66\xfc\x14\x3e\x41\xf2\xef\x9b\x45\x53\x31\xf3\x14\x2e\x52\x93\xc2\x88\x00\x69\xea\x13\x5e\x99\x33\x3f\x3e\x1c\x3e\x3c\x06\x85\xbd\x9a\xf4\x4c\x8f\xbb\x4b\x1a\x62\x8c\x43\xf7\x8c\xa4\x92\x4a\x0b\x16\xfa\xc1\xd2\xdd\x6b\x42\xd9\xd8\x29\x9c\x61\xb6\x9c\x5f\xb7\xfe\x43\xd8\xee\x46\x57\xa7\xb7\xeb\x88\x2a\x5a\xaa\x6f\x48\x03\xa5\x1d\x72\xc8\xdf\x64\xfd\xef\x41\x4f\xee\x76\x53\x8f\x34\xac\xae\xfe\x4d\x92\x8e\x80\x3a\x8f\x17\x10\x9b\xfe\x55\x12\x65\x57\x47\x4e\x5a\xbb\xfe\x7f\xe4\x9f\x00\x00\x00\xff\xff\xce\x4e\x2a\xae\xec\x0c\x00\x00"
+var _repoWikiViewTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x57\x49\x6f\xe3\xb6\x17\x3f\x3b\x9f\x82\xd0\x3f\x87\x7f\x0f\xa6\x30\x3d\x15\x03\x3b\xc0\xa0\x2d\x90\x01\x32\x45\x30\xf6\x20\x47\x81\x16\x9f\xa5\xd7\x50\xa4\x4a\x52\xf6\xa4\xaa\xbe\x7b\xc1\x45\x9b\x97\xd4\x45\x4f\x92\xf8\xd6\xdf\x5b\xa9\xb6\xb5\x50\xd5\x82\x59\x20\xc9\x8e\x19\x48\x4b\x60\x3c\x21\xb4\xeb\xee\x56\x1c\x0f\x24\x17\xcc\x98\x75\xa2\xa1\x56\x06\xad\xd2\x6f\xe4\x88\xaf\x48\x0e\x08\xc7\xe4\xe1\x6e\x31\x15\x77\x3c\x5e\x1c\x74\x50\xb0\x68\x5b\x72\x6f\xd1\x0a\x20\x1f\xd7\x84\x86\xb7\xbf\xc8\x86\x49\xb4\xf8\x27\x38\x8e\xa9\x8d\x06\x49\xae\xa4\x65\x28\x41\x3b\xdd\xa7\xc4\x42\x23\xf7\xe7\xa7\x04\x0b\x92\x1c\x91\x03\xc9\x95\x68\x2a\x19\x78\x66\x4c\x79\xa9\x94\x01\x52\xb3\x02\x22\xf5\x54\xc7\x5e\x28\x66\x51\x16\x64\x8f\xc2\x82\x26\x5c\xab\x9a\xab\xa3\x4c\x08\x67\x96\x2d\xa5\x5a\x6a\x30\x8d\xb0\x66\x9d\xb4\x2d\xc5\x0f\x3f\x49\xba\xd5\x01\x33\xad\x1b\x21\x0c\x95\x2a\x8b\x2c\x49\xd7\xf5\x56\x4e\xcd\xec\x98\xc1\x9c\x98\x8a\x09\x41\x76\x8d\xb5\x4a\x0e\x9c\x8b\x95\xa9\x99\xec\x79\x2d\x7c\xb7\x23\x69\x71\x66\xd3\x65\x81\x7a\x3c\x5d\xf7\x71\x60\x5b\x19\xab\x95\x2c\x1e\xda\x36\xc4\xbd\xeb\x56\x69\x3c\x1a\xac\xa4\xce\xcc\xf8\x89\xbd\xc5\x1e\x31\xc1\xdc\x79\xb5\x4a\x71\xc0\x90\x72\x3c\x5c\x02\x54\x81\x6c\x26\xfe\xcf\xa1\x3a\x35\xc4\x00\xd3\x79\x49\x50\xd6\xcd\x14\xce\x68\x35\x86\xfb\xcc\xa6\xe3\x71\x42\x44\xb2\x0a\xd6\x49\xd0\x93\x90\x5a\xb0\x1c\x4a\x25\x38\xe8\x75\x72\x39\x28\x41\x63\x16\x63\x43\x29\x9d\x78\x38\x05\x32\xf3\xd7\xe4\x5a\x09\xe1\xf2\x3f\xc7\xb4\x68\x5b\xcd\x64\x01\x84\x3e\xb3\x02\x8c\x2b\xd9\xc5\x05\x71\xb4\x50\x91\xb6\xc5\x3d\x81\x3f\xc8\x3d\xdd\xfa\x4a\xa7\xbf\xb1\x0a\xba\xce\x80\x80\xdc\x02\x6f\x5b\x90\xbc\xeb\x62\x41\x35\x5a\x38\x00\xf7\xf4\x2b\xd4\xea\x09\xe5\x6b\xd7\xa5\xce\xfd\xb4\x6d\xe9\xb7\xaf\x4f\xae\x82\xda\xd6\x6b\x98\x75\xcc\x1c\xc1\x22\xea\xbc\x88\x6f\xfa\x31\x79\x1f\x5f\x27\x6f\xf3\xcc\x19\xfc\xfe\x0f\xfd\xd4\x20\x61\xb9\x45\x25\x63\x29\x87\xfc\x12\xe4\xeb\x24\x17\x4a\xc2\xb2\x66\x12\x44\x84\xca\x61\xcf\x1a\x61\x7d\xbe\x7e\x76\xd4\x67\xad\xac\xca\x95\x30\xf4\x97\x40\x72\x51\xf1\xd1\xa3\x9f\xcd\x93\x2a\x0a\xe0\x5d\xe7\x45\x35\x54\x50\xed\x40\xf7\xe1\xfa\x54\xd7\x9b\x66\xe7\xe3\x93\x36\x06\x74\x6a\xc0\xba\xae\x35\xa9\x37\x9b\xd5\x51\x73\x12\x03\x13\xe1\x7b\xdd\xf7\xa7\xc6\x1f\xb7\xdb\xe7\xcd\x10\xbc\x55\x68\xc7\xb3\x5e\xf5\x8a\xfb\x5e\xf5\x08\x5d\xa9\x2d\x03\xcc\xd2\xda\xda\x44\x98\xbd\xed\x75\x32\x3d\x15\x28\x5f\x3d\xf2\x17\x7c\x45\xef\x80\x4b\x76\x6f\x7b\x2c\x34\xef\xe2\x37\x03\x91\xe0\x1f\x6d\x0b\xc2\x40\xf8\x98\x67\x7a\x95\x06\x87\x06\x7c\x13\xe2\x65\xb0\x9b\xcd\xe3\x7f\x83\x6a\x4c\x79\x06\x74\x3c\xbb\x02\xd3\x5b\x1d\x41\x6e\x36\x8f\x37\x00\x88\x8d\x7f\x62\xbf\xd1\x22\x21\x07\x26\x1a\x58\x27\x63\xa7\x5d\xae\x27\xe2\x3d\xeb\x3a\xd7\x5f\x17\x1c\xea\xe3\x7a\x4e\x8e\xd1\x1f\x5a\x55\x03\xe3\x4a\x8a\xb7\xbe\x8d\xae\x04\xce\x8f\xba\x48\xab\x55\xed\xc6\x48\x53\x93\x5c\x60\xbd\x53\x4c\xf3\xbe\x31\xe2\xe7\x72\x67\xfb\xb5\xa2\x34\x16\x28\x99\xb8\x30\xcb\x72\x55\xbf\x65\x2e\xac\xc9\x30\x33\x4c\x93\xe7\x60\xcc\x7b\xcc\x59\xe4\x19\x85\x40\x6b\xa5\xdf\x15\xf1\x1c\xa3\x80\xdb\xc1\x20\xed\x6d\x2e\x1d\x98\x46\xe6\x06\xc1\x3a\x41\x79\x00\x6d\x81\x13\x8b\xf2\xad\xd7\x35\x60\xb6\x4c\x17\x60\xd7\xc9\xff\x4e\x52\xfa\x70\x77\xba\x11\x54\x6e\x7d\x3c\xe3\xd3\xeb\xa8\xdf\xa6\xcb\x61\x5e\x3c\x17\x06\xda\xf8\x32\x9f\x59\x1c\x0f\xc8\x5d\x76\xe2\x2d\x25\x48\x4d\xd7\x6e\xc9\xcc\x12\x2a\xf5\x3b\x26\xf3\x1d\xda\xaf\x4c\x5f\x79\x4c\x72\x37\xa9\xbe\x0e\x77\xa2\x17\x8d\x6e\x89\xfd\x5f\x2a\x4b\xe8\x78\x4c\x3f\x9b\x2f\xe8\x82\xfb\x43\x2c\xed\x13\x77\x34\x16\xe5\xb0\x15\x57\x6c\x42\x99\x5d\x10\x48\xa9\x61\xef\xd3\x71\xbe\x2b\x7e\x35\x39\xab\xe1\x59\x35\x92\x87\x15\x15\x66\x63\x06\x1c\x6d\xf2\x70\x96\x41\x27\x45\x1d\xcd\xaf\xc7\x2c\x1a\x70\x08\xd9\x25\x37\x0a\x0d\x20\x6f\x75\x26\x93\x70\xbc\x66\x52\xc2\xf1\x36\x8b\x1a\xf8\xcc\x1e\xe1\x20\xc0\xc2\x72\x6e\x7d\xbe\x43\x6f\x0f\x4b\x50\x16\xa5\x5d\x4f\x5e\xe3\xbc\x86\x23\x28\xb8\x06\x65\xac\xc4\xc9\x3c\x3b\xdd\xad\xcd\x6e\x56\x7d\x0b\x5f\x66\x15\x6c\x50\xe6\xfe\x9a\xbc\x1d\x3e\xe8\xa7\xc6\x96\x4a\xd3\x97\x12\x24\xb9\xa7\x4f\x4c\x16\x41\xe5\x95\xdb\xa0\x60\xc6\x66\xb9\xaa\x2a\xb4\x19\xca\xbd\x4a\x06\x0d\xfe\x1a\x31\x31\xe3\x6e\x14\x7b\x88\xfe\xbd\xdb\x34\x15\xd3\xaf\xfe\x52\x38\x69\x8c\x00\x90\xc6\x39\xe1\x94\x59\xfd\xe3\xe3\xf6\xcb\x53\xd7\x4d\xd4\xc4\x67\x7c\xdc\x9d\x86\x21\xe4\xd8\x4f\xcf\x10\x54\x52\x29\xce\xfc\x3c\xb8\x74\x8f\x9c\x84\x6c\x9c\x14\x56\x33\x53\xce\xaf\x8e\xff\x22\x6d\x77\xa3\xab\x13\x83\x11\x55\xb0\x54\xdf\x50\x06\x52\x59\xcc\x21\xfb\x90\xf4\xbf\x3a\x7d\x70\x57\x69\x3d\x86\x61\x71\xf6\x9f\x15\x75\x78\xd4\x59\xb8\x4c\x99\xf8\xdf\x15\x43\x76\x26\xb2\x57\xca\xf6\xff\x56\x7f\x0f\x00\xdd\x69\x66\x93\xb8\x0d\x00\x00"
 
 func repoWikiViewTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2552,8 +3456,8 @@ func repoWikiViewTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "repo/wiki/view.tmpl", size: 3308, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x14, 0x86, 0x42, 0x75, 0xd9, 0xbd, 0xed, 0x6c, 0x88, 0x1b, 0x13, 0xff, 0x4, 0x67, 0x57, 0x94, 0x2b, 0x10, 0x9a, 0xf7, 0xe6, 0x25, 0xf4, 0x9c, 0x46, 0x1a, 0xa6, 0x63, 0x80, 0x64, 0x79, 0xa3}}
+	info := bindataFileInfo{name: "repo/wiki/view.tmpl", size: 3512, mode: os.FileMode(0644), modTime: time.Unix(1792262907, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4f, 0xca, 0x71, 0xfc, 0xb0, 0xc0, 0xfb, 0xeb, 0x6d, 0x8e, 0x4c, 0xc3, 0x3c, 0x45, 0x45, 0x57, 0x25, 0x1e, 0x71, 0x3f, 0xdb, 0x59, 0xd0, 0xc1, 0xe3, 0x1f, 0x6e, 0x1f, 0xaa, 0xe6, 0x4a, 0xab}}
 	return a, nil
 }
 
@@ -2597,6 +3501,26 @@ func status500Tmpl() (*asset, error) {
 	return a, nil
 }
 
+var _status503Tmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x90\xc1\x6e\xf3\x20\x10\x84\xcf\xf1\x53\xac\xb8\xff\x76\xfe\x6b\x45\x38\xf5\x9a\x63\x1f\x60\x0d\xdb\x78\x25\xbc\x46\x5e\x4a\x1a\x21\xde\xbd\x22\x4a\x5a\x55\x3d\x21\x0d\xf3\xcd\x68\xa7\xd6\x4c\x6b\x8a\x98\x09\xcc\x8c\x4a\xd3\x42\x18\x0c\x8c\xad\x0d\x36\x70\x01\x1f\x51\xf5\x64\x3e\x18\xfc\x26\x19\x59\x68\x07\x4f\x92\x69\x37\x6e\x38\xd8\x04\x9a\x6f\x91\x4e\x66\xc5\xfd\xc2\xf2\x2f\x6f\xe9\x05\xfe\x1f\x8f\xe9\xd3\x38\xcb\x4f\x7a\x45\x55\x2e\x04\xd7\x9d\xc4\x2f\xc0\x7e\x13\xe3\xec\xc4\xce\x4e\xa9\xa7\xfc\x2e\x0a\x5c\x38\xf4\x7c\x3b\x05\x2e\xfd\x7f\xde\xdd\x70\xa8\xf5\xca\x79\x81\xf1\x8c\x2c\x99\x04\xc5\x53\x6b\x36\xb9\x5a\xc7\x57\xd6\x14\xf1\x76\x26\x55\xbc\x74\x75\xea\x32\x45\xa5\xd6\x9e\xd8\x9b\x60\x41\x8e\x38\x47\xfa\xf1\xdd\xe9\x6f\xbf\x84\xd6\x1e\xcf\xf0\xe8\xfe\x33\xcf\xfb\xb6\xf5\xdb\xef\x03\x7d\x0d\x00\x03\x10\x5c\xfd\x3c\x01\x00\x00"
+
+func status503TmplBytes() ([]byte, error) {
+	return bindataRead(
+		_status503Tmpl,
+		"status/503.tmpl",
+	)
+}
+
+func status503Tmpl() (*asset, error) {
+	bytes, err := status503TmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "status/503.tmpl", size: 316, mode: os.FileMode(0644), modTime: time.Unix(1792263739, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x68, 0xe9, 0x10, 0xfe, 0x21, 0x18, 0x45, 0xd7, 0xb, 0xa9, 0x47, 0x57, 0x92, 0x25, 0x2b, 0x54, 0xff, 0x2e, 0xfc, 0x90, 0xbc, 0x6b, 0xb6, 0x78, 0xd2, 0xda, 0x4, 0xf8, 0xa3, 0x26, 0xd4, 0xde}}
+	return a, nil
+}
+
 var _userAuthActivateTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xdb\x6a\xdb\x40\x10\x7d\x76\xbe\x62\xd1\x07\x48\xd4\x4f\x7d\x90\x03\xa1\x24\xa4\xe0\x84\x62\x3b\xcf\x62\xac\x1d\x4b\x43\xf7\x22\x76\x47\x22\x41\xd5\xbf\x97\x95\x64\xc9\xb7\x40\x43\x9f\x96\x9d\xcb\x39\x33\x67\x67\xb6\x6d\x19\x75\xa5\x80\x51\x44\x7b\xf0\x98\x94\x08\x32\x12\x71\xd7\xdd\xa5\x92\x1a\x91\x2b\xf0\x7e\x15\xd5\x1e\x9d\x80\x9c\xa9\x01\xc6\xe8\xfe\x6e\x71\xe6\x24\xa1\x49\x4a\x85\xa2\x41\xf7\x21\x1c\x2a\x78\x47\x29\x2a\x28\x50\x14\x8e\x64\x88\x3f\x4b\xc8\xad\xaa\xb5\xe9\xcd\x8b\xf4\x60\x9d\x3e\x41\x0a\xd7\xa8\xa7\xb2\x66\x15\xb5\xed\x43\x55\x6d\xeb\xfd\xdb\x66\xdd\x75\x49\xa8\x22\x99\xaa\x10\x1a\xb9\xb4\x72\x15\x55\xd6\xf3\x00\xb6\x68\xdb\xf8\xc7\x76\xf3\xb4\xb3\xbf\xd1\x3c\xef\x5e\xd6\x5d\xd7\x9b\xd3\x72\x79\x42\xc1\xb6\x12\xc0\x0c\x79\x89\x52\x84\x7e\xd1\x8d\xe9\x21\x9f\xbe\x7d\x37\xf1\xce\x89\x08\x6a\x2e\xe3\x9e\x0d\xb3\x0f\x5b\xbb\x0c\xf2\xdc\xd6\x86\xa3\x23\x68\x52\x2e\x87\xb4\x0b\x31\x26\x6c\x8f\x85\x46\xc3\x33\xf8\x85\xd6\xa0\xd0\xf1\x20\xf6\x18\x40\x07\x11\xff\xf4\x0f\x63\x8b\xbf\xa0\xc0\xa3\x6f\x74\x6e\xd1\x35\x94\xe3\xab\xe5\x47\x03\x7b\x85\x72\xf2\x2f\xd2\x6a\xd2\x17\x0d\x87\x9e\xae\xba\x91\xe4\x43\x52\xe6\xb0\x20\xcf\xe8\x32\x0d\xa4\xa2\xae\x4b\x93\xea\x7e\xa2\x41\xe5\x51\x04\xae\x0d\x7a\x34\x72\x4d\x9a\xf8\x8b\x3c\x2e\x64\x72\xa6\x42\x6a\x56\x39\xab\x2b\xbe\xc5\x72\x8a\x79\x0d\x92\x5b\x73\x20\xa7\x21\x4c\x42\x5f\x68\xd6\x83\x8e\x70\x22\x5e\xdb\xa2\x40\xf9\xe6\xd1\xc5\x8f\xc1\x2d\xe2\x67\x5b\x3b\x2f\xfe\x88\x2d\xbb\xe5\xf0\xfa\xe7\x94\x46\xce\x4a\x9f\xf1\x1f\x85\xdf\xa2\x91\x9b\x51\x9b\x17\x20\xf5\x7f\x05\xfe\x63\x55\x47\xb9\xe7\x77\x7f\x02\xba\x78\xd9\x6b\x72\x32\x0d\x28\x92\x59\x6e\x25\x7e\x5d\xdb\x12\x7c\x56\x9b\xb1\x01\x94\xc3\x1c\x9c\x49\xfa\x0a\x1a\x6f\x68\xfc\x49\x1b\x97\x2b\x20\xa9\xa1\x7e\xad\xd2\x44\x52\x73\x33\x8a\xf1\x9d\x85\xa3\xa2\x9c\xf6\x23\x04\xec\x6b\x66\x6b\x4e\x90\xf6\xaa\x46\x31\x58\x3f\x1b\x34\x39\x8f\xf1\x10\x38\xf3\x9d\xb2\x5f\x0e\xc0\x74\x99\xa3\xd2\x24\x7c\x3f\xfd\x77\x35\xda\xc6\x73\x3c\xae\x36\xf8\x60\x6d\xd8\x80\x7e\x85\xff\x06\x00\x00\xff\xff\xdc\x01\x04\xea\x4b\x05\x00\x00"
 
 func userAuthActivateTmplBytes() ([]byte, error) {
@@ -2757,7 +3681,7 @@ func userAuthTwo_factor_recovery_codeTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _userDashboardDashboardTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x4b\x6f\xe3\x36\x10\x3e\x7b\x7f\x05\x21\x14\xbd\xd9\xc2\x02\x7b\xe8\x41\xce\x62\x91\x45\xbb\x01\xf2\x28\x36\xdd\x73\x30\x92\xc6\x32\x1b\x8a\x34\x28\xca\x8e\x2b\xf0\xbf\x17\x7c\x58\x22\x65\xc7\x76\xb2\x69\x91\x93\x29\x3e\x66\x86\x33\xf3\x7d\x9c\x71\xd7\x29\xac\x57\x0c\x14\x92\x24\x87\x06\xd3\x25\x42\x99\x90\x99\xd6\x1f\xb2\x92\xae\x49\xc1\xa0\x69\xe6\x49\x09\xcd\x32\x17\x20\x4b\xb2\x40\x2c\x9b\xe4\xe2\xc3\x24\x3c\xd9\x36\x28\xd3\x7e\x4f\xca\x61\x9d\x83\x74\x52\x26\xa1\x98\x96\x92\x42\x70\x05\x94\xa3\x34\x32\xc6\x8b\x95\xa4\xa5\x9d\x8f\x16\x14\x72\xb2\xa1\x25\x92\x42\xb0\xb6\xe6\x6e\xc3\x31\xfd\xce\x46\xa7\xde\xee\xa4\x0b\x32\xfb\xb2\x50\x28\xaf\xbe\xfa\xb9\x49\x96\xb7\x4a\x09\x1e\x28\x5f\xb0\x96\x96\x24\x87\x86\x16\x64\xb7\x88\x5c\xa1\x24\xf0\x37\x3c\x4d\x99\x80\x72\xea\xe6\x13\x52\x82\x82\x69\x2b\xd9\x3c\xe9\xba\xd9\x35\xe5\x8f\x5a\x7f\x06\xa3\xe0\x81\x96\xf3\xae\x1b\x94\x25\x17\x37\x42\x62\x96\xba\x83\x3b\xcb\x91\x97\xce\x8e\x2c\x2d\xe9\x7a\xef\xc2\x0d\x7d\x3a\x70\xe1\x91\xaf\xec\xad\xb8\x50\x64\x76\x29\xb8\xc2\x27\xf5\xa3\x41\x39\xbb\x6a\xee\x64\x05\x9c\xfe\x03\x8a\x0a\xae\xb5\x5a\x4a\xc4\xae\x43\xd6\xa0\xd6\x6a\x23\xbc\x6e\x42\x15\xd6\x44\x41\x0e\x39\x43\x52\x23\x6f\xbd\x92\x49\x06\x3b\x1d\x76\x0b\x14\x8a\xae\xd1\x5f\x58\x41\x3e\x4f\x24\xae\x44\x93\x5c\x74\xdd\x8c\x7e\xfc\x8d\xcf\xfe\x92\xc4\x4d\x51\x25\xe4\x36\xd1\x3a\x4b\xc1\x8b\x3a\xcf\x42\xb7\x77\xa4\x37\x54\x28\x64\x35\xd2\x27\x02\x01\x23\x8d\xbd\x67\x8f\x49\xac\xa9\x94\x42\x8e\x84\xba\xc9\x50\xdc\x10\x9c\xb1\xef\x15\xe4\xde\x33\x84\xd1\x46\x1d\x70\x8f\x37\x61\x74\x4c\xac\x08\x28\x05\xc5\x12\x4b\x62\x80\xe6\x51\xe0\x4c\x1f\x4c\x59\x8a\x1a\x67\xf5\xf6\xc1\xc9\xd2\x9a\x64\xcd\x0a\x78\x84\x13\xdc\x12\x06\x39\x32\x7b\x87\xef\xb8\x12\x97\xa2\xe5\xca\x18\x6f\xb6\xee\x84\x8e\xf4\x4b\x5a\x2d\x55\xaf\x31\x70\xd0\x4a\xac\x28\xaf\x48\xbb\x4a\xc8\x52\xe2\xc2\x64\xf5\x97\xd5\xea\xbe\xcd\x7f\x7c\xbf\xd6\x3a\x35\x76\xa4\x85\x44\x50\xe8\xb0\x74\x2c\x9e\x9f\x85\xac\x0c\x04\xa2\x3d\x5f\xb5\xf6\xb1\xf1\xbe\x32\x34\x80\x5c\x59\xfc\xf4\xf7\xe6\xb8\xb1\x77\x4e\xfa\x6d\x6b\x90\xd4\xca\x9d\x27\x8a\xf2\x2d\xa1\x7c\x8d\x52\x61\xe9\x97\x6d\xda\xd9\x55\x86\x0b\xe5\xe1\x3a\x5c\x70\x92\xd1\xfe\x86\xac\x6d\x08\x2d\x04\x4f\x2e\xb2\x94\x06\x3b\x42\xcf\x36\x72\x2a\x38\xdb\xc6\x79\x11\x18\x15\x3b\x77\x32\xe4\x5d\x98\x2a\xf1\x38\x0e\x40\x1f\x7c\x65\x71\xd7\x60\x55\x23\x1f\x22\x92\xb5\x6c\xb7\xd9\x68\x9c\x8a\x0d\x47\x39\xe5\x50\xe3\xd4\xa6\x59\xaf\xb9\xeb\x24\xf0\x0a\x89\x8d\x7c\xd3\x63\x68\x32\xc9\x98\x27\x86\xd9\x55\xf3\xa7\xa4\x6b\x50\xa8\xf5\xce\x05\xee\x3b\xf1\x81\x18\x7c\x60\x12\xe1\x50\xd8\xbb\xee\x97\x28\x8a\xb7\x50\xa3\x9d\xf6\xa3\xc0\xd1\xa1\xab\x45\xa1\x8c\xa3\x89\xff\x9d\xee\xec\xf9\x5d\xc8\x47\xad\xed\xc5\x16\x42\x3e\x62\xe9\x68\x89\x8c\xac\x65\xa2\x78\x8c\x56\x6e\x2c\x30\xfd\xc9\x82\x09\xde\xf3\x99\x99\xd9\xa5\x55\x1c\x56\x13\x58\x25\x05\xaf\x86\x37\xe4\x49\x11\x25\x5b\x5e\x98\x07\xc3\x10\x82\xf5\xab\x0d\xb5\xbb\x4d\x96\xba\x13\xb1\x90\x18\x77\x16\x40\xc4\xca\x62\x76\x68\x80\x18\xb9\xc1\xe0\xf8\xb6\xad\xef\x15\xc8\xc6\x00\xf7\x59\xaf\x34\x0a\x24\x91\x08\x72\xdf\xf2\x38\xcb\xa2\x3c\x33\x1f\x8c\x06\x79\x10\xf0\x9d\x27\xdc\x6a\xc4\xb7\xb7\x6d\x6d\xb3\x84\xcc\x6e\xe0\xe9\x7e\x29\x36\xe6\xeb\xb6\xad\x87\x63\x19\x0b\x01\x31\xe4\x42\x24\xe6\x9b\xa8\xd1\x3d\x74\x31\x3c\x2c\x57\x35\x4b\xb1\x79\xa8\x85\xc4\x9e\xb2\x42\x9b\x8f\x98\x9c\xa5\x2d\x8b\x61\xf3\x9a\xa7\x63\xf9\xe9\x4c\x92\xdd\x67\xd9\x42\x30\x06\xb9\x90\x60\xc8\xbc\xb7\xbe\xb7\x6e\xf9\xe9\x19\x2a\x3d\x81\x64\x0b\x65\x5a\xce\x93\x48\xfe\xd4\xa6\x70\x0c\xe5\x01\xcb\x97\xe1\xd6\x31\xb0\x5f\x89\xec\xe7\xa1\x3d\xbb\x33\xe4\x72\x1c\xd3\x67\x80\xfa\x79\xd8\x1e\x87\xfb\xeb\x40\x1d\x03\x32\xc6\xb4\x23\x4b\xe0\xa5\xf5\x72\x7c\x8f\xd3\xe7\x7a\x32\x08\xdd\xe2\x91\x48\x52\xe2\xd9\xe4\x38\x5b\xec\x23\xf7\x55\x0c\xf2\x06\x14\x72\xc0\x92\x88\x44\x62\x48\x8e\x69\x24\x00\x65\xf4\x98\x85\xdb\x42\xb4\xbe\x04\xac\x07\x0a\xa9\x71\x05\xe5\xea\xbd\xc3\xb0\x7b\x19\xb8\xeb\xed\x83\x15\x76\xba\x82\x0a\x0d\xff\x03\x55\x68\xf9\xc1\xc2\xca\xf7\x14\xd7\xa2\xaa\xb0\xb4\xa7\x2e\x81\x5f\xda\x0a\xe9\x20\x45\x9d\xa8\xc4\xce\x2f\xc5\x84\xac\x7c\x25\x76\xaa\x90\x12\xb2\x7a\xb3\x3a\xea\x8c\x42\xea\xcc\x4a\xca\x59\x35\x4e\xcf\xf8\xb5\x18\x32\x6e\xff\xb9\x08\xd6\x5e\xc1\xc8\x67\x15\x57\x21\x23\x0f\x59\x71\x27\xab\x31\x1f\x9f\xc7\xb3\x2f\xe3\xd5\xa8\xb5\x39\x40\x7f\x6f\x50\xd4\xfc\x04\x27\xf9\x57\xe9\x08\x27\x19\xd7\xfe\x9f\x9c\x14\x8c\x77\x27\x9e\x6b\xd8\xc6\x3c\xd3\x77\x81\x6f\xd2\xab\xed\xa4\x9d\xe6\x1a\xf7\xf4\xfd\xe7\xfd\x5a\x4d\x2b\x09\x0a\x3f\x3b\xc3\xe6\x1f\x4f\x77\x6e\xbf\xfe\x5c\xe7\xd6\x37\xd0\xef\xab\x77\x0b\xfa\xfa\xf7\xd6\xbd\xb9\x4c\x78\xef\xfd\xdb\x50\xa2\xbd\xa7\x26\xeb\xca\xa4\xcb\x1a\x98\xd6\xdf\x8e\x95\x48\x5b\x5e\xbc\x71\x97\xb5\xdf\xb2\xc4\xc3\x7e\xb4\x1b\xf8\x5f\xff\xb3\xf7\x87\xeb\x42\x08\x93\xf8\xf6\xdf\xca\x7f\x03\x00\x00\xff\xff\x2d\x49\xea\x2d\x8e\x15\x00\x00"
+var _userDashboardDashboardTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x4d\x6f\xdb\x38\x13\x3e\x2b\xbf\x82\x10\x5e\xbc\x37\xdb\xe8\xa2\x87\x3d\xc8\x2e\x8a\x14\xbb\x0d\x90\xa4\x8b\xa6\x3d\x07\x23\x69\x2c\x73\x43\x91\x06\x49\xd9\xf1\x6a\xf5\xdf\x17\xa4\x28\x89\x94\x3f\x9b\x66\x17\x39\x99\xe2\xc7\x7c\x71\x9e\x87\x33\xae\x6b\x8d\xe5\x9a\x81\x46\x12\xa7\xa0\x70\xb6\x42\xc8\x63\x32\x6d\x9a\xab\x24\xa7\x1b\x92\x31\x50\x6a\x1e\xe7\xa0\x56\xa9\x00\x99\x93\x25\x62\xae\xe2\xc5\x55\xe4\x9f\xac\x14\xca\x59\xbf\x67\xc6\x61\x93\x82\x6c\xa5\x44\xbe\x98\x8a\x92\x4c\x70\x0d\x94\xa3\x34\x32\xa2\xba\xa6\x4b\x32\xfd\x2c\x4a\xbc\x17\x9a\x66\x68\x0e\x44\xe3\x23\x94\x2f\x05\x29\x51\x29\x28\x90\xac\x44\x89\x13\x6e\x37\x93\x12\xe4\x53\x2e\xb6\xdc\x8a\x32\xc2\x3c\x49\xe4\x6f\xf2\xa0\xe5\x2f\x9f\xbf\xdd\xdd\x3a\xa1\xb3\x9c\x6e\x5a\x9d\xc8\x73\x3b\x37\xd2\x53\x48\x9a\xb7\xa2\xfc\x05\x8d\x9c\x6c\x69\x8e\x24\x13\xac\x2a\x07\x5d\x47\xbd\x6f\x23\xd4\x3a\x1f\x75\x2e\x7e\x5c\x6a\x94\x37\x9f\xdc\x5c\x94\xa4\x95\xd6\x82\x7b\xca\x97\xac\xa2\x39\x49\x41\xd1\x8c\x74\x8b\xc8\x35\x4a\x02\x7f\xc2\xf3\x84\x09\xc8\x27\xed\x7c\x4c\x72\xd0\x30\xa9\x24\x9b\xc7\x75\x3d\xbd\xa5\xfc\xa9\x69\x3e\x80\x51\xf0\x48\xf3\x79\x5d\x0f\xca\xe2\xc5\x9d\x90\x98\xcc\xda\x83\x9d\xe5\x9d\xfb\x43\x48\x02\x87\x15\x7d\x3e\xe0\xf0\x28\x56\xd6\x2b\x2e\x34\x99\x5e\x0b\xae\xf1\x59\x7f\x57\x28\xa7\x37\xea\x8b\x2c\x80\xd3\xbf\x40\x53\xc1\x9b\x46\xaf\x24\x62\x5d\x23\x53\xd8\x34\x7a\x2b\x9c\x6e\x42\x35\x96\x44\x43\x0a\x29\x43\x52\x22\xaf\x9c\x92\x28\x81\x4e\x87\xdd\x02\x99\xa6\x1b\x74\x0e\x6b\x48\xe7\xb1\xc4\xb5\x50\xf1\xa2\xae\xa7\xf4\xdd\xaf\x7c\xfa\x4d\x92\x76\x8a\x6a\x21\x77\x71\xd3\x24\x33\x70\xa2\x2e\xb3\xf0\x2a\x3a\xa0\xd7\x57\x28\x64\x31\xd2\x27\x3c\x01\x23\x8d\x7d\x64\x4f\x49\x2c\xa9\x94\x42\x8e\x84\xb6\x93\xbe\xb8\xe1\x72\xc6\xb1\xd7\x90\xba\xc8\x10\x46\x95\x3e\x10\x9e\xab\xe8\xd0\x31\xb1\x26\xa0\x35\x64\x2b\xcc\x89\x81\xb9\xc3\xa0\x83\x4e\x6f\x8a\x41\xd8\xb4\xdc\x3d\xb6\xb2\x9a\x86\x24\x6a\x0d\x3c\xc0\x09\xee\x08\x83\x14\x99\xf5\xe1\x2b\xae\xc5\xb5\xa8\xb8\x36\xc6\x9b\xad\x9d\xd0\x91\x7e\x49\x8b\x95\xee\x35\x7a\x01\x5a\x8b\x35\xe5\x05\xa9\xd6\x31\x59\x49\x5c\x9a\xac\xfe\xb8\x5e\x3f\x54\xe9\xf7\xaf\xb7\x4d\x33\x33\x76\xcc\x32\x89\xa0\xb1\xc5\xd2\xa9\xfb\xfc\x20\x64\x61\x20\x10\xec\xf9\xd4\x34\xee\x6e\x5c\xac\x0c\x09\x21\xd7\xf3\xd8\xf7\x9b\xe3\xd6\xfa\x1c\xf7\xdb\x36\x20\xa9\x95\x3b\x8f\x35\xe5\x3b\x42\xf9\x06\xa5\xc6\xdc\x2d\xdb\xb4\xb3\xab\x0c\x97\xda\xc1\x75\x70\x30\x4a\x68\xef\x21\xab\x14\xa1\x99\xe0\xf1\x22\x99\x51\x6f\x87\x1f\x59\x25\x27\x82\xb3\x5d\xbc\x38\x62\x54\x18\xdc\x68\xc8\x3b\x3f\x55\xc2\x71\x78\x01\xfd\xe5\x6b\x8b\x3b\x85\x45\x89\x7c\xb8\x91\xa4\x62\xdd\x66\xa3\x71\x22\xb6\x1c\xe5\x84\x43\x89\x13\x9b\x66\xbd\xe6\xba\x96\xc0\x0b\x24\xf6\xe6\x55\x8f\xa1\x28\x4a\x98\x23\x86\xe9\x8d\xfa\x43\xd2\x0d\x68\x6c\x9a\x2e\x04\xed\x77\xec\x2e\x62\x88\x81\x49\x84\x43\xd7\x5e\xd7\xff\x0b\x6e\xf1\x1e\x4a\xb4\xd3\x6e\xe4\x05\xda\x0f\xb5\xc8\xb4\x09\x34\x71\xbf\x93\xce\x9e\xdf\x84\x7c\x6a\x1a\xeb\xd8\x52\xc8\x27\xcc\x5b\x5a\x22\x23\x6b\x99\xc8\x9e\x82\x95\x3b\x0b\x4c\x77\x32\x63\x82\xf7\x7c\x66\x66\xba\xb4\x0a\xaf\xd5\x5c\xac\x96\x82\x17\xc3\x1b\xf2\xac\x89\x96\x15\xcf\xcc\x83\x61\x08\xc1\xc6\x35\x5e\xf4\xde\x24\xb3\xf6\x44\x28\x24\xc4\x9d\x05\x10\xb1\xb2\x98\x1d\x1a\x20\x06\x61\x30\x38\xbe\xaf\xca\x07\x0d\x52\x19\xe0\x1e\x8d\x8a\xd2\x20\x89\x44\x90\xfb\x96\x87\x59\x16\xe4\x99\xf9\x60\xd4\xcb\x03\x8f\xef\x1c\xe1\x16\x23\xbe\xbd\xaf\x4a\x9b\x25\x64\x7a\x07\xcf\x0f\x2b\xb1\x35\x5f\xf7\x55\x39\x1c\x4b\x98\x0f\x88\x21\x17\x02\x31\xe6\x5d\x6f\x1f\xba\x78\xb1\xc7\x55\x6a\x25\xb6\x8f\xa5\x90\xd8\x53\x96\x6f\xf3\x09\x93\x93\x59\xc5\x42\xd8\xbc\xe4\xe9\x58\xbd\xbf\x90\x64\xf7\x59\x36\x13\x8c\x41\x2a\x24\x18\x32\xef\xad\xef\xad\x5b\xbd\x3f\x42\xa5\x67\x90\x6c\xa1\x4c\xf3\x79\x1c\xc8\x9f\xd8\x14\x0e\xa1\x3c\x60\xf9\xda\xdf\x3a\x06\xf6\x0b\x91\x7d\x1c\xda\xd3\x2f\x86\x5c\x4e\x63\xfa\x02\x50\x1f\x87\xed\x69\xb8\xbf\x0c\xd4\x21\x20\x43\x4c\xb7\x64\x09\x3c\xb7\x51\x0e\xfd\x38\x7f\xae\x27\x03\x3f\x2c\x0e\x89\x64\x46\x1c\x9b\x9c\x66\x8b\x7d\xe4\xbe\x88\x41\x5e\x81\x42\x0e\x58\x12\x90\x48\x08\xc9\x31\x8d\x78\xa0\x0c\x1e\x33\x7f\x9b\x8f\xd6\x1f\x01\xeb\x81\x42\x6a\x5c\x41\xb5\xf5\xde\x61\xd8\xfd\x18\xb8\xcb\xdd\xa3\x15\x76\xbe\x82\xf2\x0d\xff\x1d\xb5\x6f\xf9\xc1\xc2\xca\xf5\x14\xb7\xa2\x28\x30\xb7\xa7\xae\x81\x5f\xdb\x0a\xe9\x90\xd7\xe7\x2a\xb1\xcb\x4b\x31\x21\x0b\x57\x89\x9d\x2b\xa4\x84\x2c\x5e\xad\x8e\xba\xa0\x90\xba\xb0\x92\x6a\xad\x1a\xa7\x67\xf8\x5a\x0c\x19\xb7\xff\x5c\x78\x6b\x2f\x60\xe4\x8b\x8a\x2b\x9f\x91\x87\xac\xf8\x22\x8b\x31\x1f\x5f\xc6\xb3\x3f\xc6\xab\x41\x6b\x73\x80\xfe\x5e\xa1\xa8\xf9\x09\x4e\x72\xaf\xd2\x09\x4e\x32\xa1\xfd\x2f\x39\xc9\x1b\x77\x27\xae\x2e\xe4\x99\xbe\x0b\x7c\x95\x5e\xad\x93\x76\x9e\x6b\xda\xa7\xef\x5f\xef\xd7\x4a\x5a\x48\xd0\xf8\xa1\x35\x6c\xfe\xee\x7c\xe7\xf6\xff\x9f\xeb\xdc\xfa\x06\xfa\x6d\xf5\x6e\x5e\x5f\xff\xd6\xba\xb7\x36\x13\xde\x7a\xff\x36\x94\x68\x6f\xa9\xc9\xba\x31\xe9\xb2\x01\xd6\x34\x9f\x4f\x95\x48\x3b\x9e\xbd\x72\x97\xb5\xdf\xb2\x84\xc3\x7e\xd4\x0d\xdc\xaf\xfb\xd9\xfb\xbb\x77\x29\x84\x49\x7c\xfb\x6f\xe5\x3f\x03\x00\x17\x06\xbb\xc0\x0c\x16\x00\x00"
 
 func userDashboardDashboardTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2772,12 +3696,12 @@ func userDashboardDashboardTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/dashboard/dashboard.tmpl", size: 5518, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0xf5, 0xb4, 0xc, 0x63, 0xdf, 0x5f, 0xf0, 0x4f, 0x59, 0x92, 0xe6, 0x0, 0xe7, 0x22, 0x5e, 0xca, 0xb7, 0xe0, 0x43, 0xce, 0xab, 0x6a, 0xac, 0xb3, 0xcc, 0xe7, 0xea, 0xfb, 0x22, 0xaa, 0x95}}
+	info := bindataFileInfo{name: "user/dashboard/dashboard.tmpl", size: 5644, mode: os.FileMode(0644), modTime: time.Unix(1792278081, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0x89, 0xd2, 0x80, 0xa6, 0x83, 0xef, 0x2a, 0xb9, 0x3b, 0x67, 0xab, 0x4e, 0xa4, 0xa0, 0x83, 0x56, 0xf1, 0x8f, 0x71, 0x81, 0xc5, 0xd5, 0xc, 0x29, 0x43, 0xde, 0x0, 0xb9, 0x2b, 0xbd, 0x33}}
 	return a, nil
 }
 
-var _userDashboardFeedsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5d\x93\x9b\x36\x14\x7d\x26\xbf\xe2\x96\xc9\xc3\xfa\xc1\x66\x71\xb2\xd9\x6d\x06\xef\x8c\x9b\x49\x9b\x9d\x71\xda\xcc\xda\x79\xf6\x28\x70\xc1\x6a\x40\x22\x92\xd8\x64\x87\xf0\xcb\xfa\xd6\x5f\xd6\x91\x84\x6d\xf0\x67\xba\x85\xe9\x93\xb1\x74\x75\xee\xd1\xd1\x45\xd2\xa1\x2c\x05\x61\x09\xc2\xe8\x57\xc4\x48\x56\xd5\x33\x27\x88\xe8\x03\x84\x29\x91\x72\xe2\x32\xfc\x2a\xdd\xdb\x67\x4e\xab\xb1\xa0\x90\x62\xac\x4c\xbb\x13\xd0\x2c\x69\x74\x90\x07\xa2\x88\x00\x9a\x91\x04\x5d\x90\x22\x9c\xb8\x65\x39\x9a\x86\x6a\x6a\x3a\xaa\xca\x05\x92\xaa\x89\x6b\x41\xbd\x88\x3e\x1c\x40\x4f\x04\x8d\x6a\xf4\x76\x47\x4c\x63\x85\xc8\xe0\x2b\x8d\x10\x42\x9e\x16\x19\xb3\x71\xad\xc0\xb2\xa4\x31\x70\x01\x17\xf8\x05\x46\xbf\xa1\xfa\x23\x5f\x3c\xe6\x08\x57\x83\xdd\x96\xf1\xe5\xa0\xaa\xf2\x42\xae\x40\xcf\xb3\x2c\x91\x45\x55\x55\x03\x3a\x41\x5e\x3f\x38\x01\x81\x95\xc0\x58\x03\x4f\xf3\x7c\x5e\x7c\xfa\x78\x3f\xab\x2a\xaf\x2c\x35\xd4\x34\x54\x1f\x25\x8a\xdf\x49\x86\x7a\x6c\x59\x8e\xe6\x2b\x2e\xda\xcd\x81\x47\x36\x58\x3f\x0d\x87\x70\x8f\x31\x0a\x64\x21\x82\x7a\xcc\x51\x82\xe2\x90\xf1\x08\x53\xe9\x91\x50\x51\xce\x46\x09\x87\xe1\x70\x3d\xc4\x4c\xa7\x4d\xdc\xaf\x2a\xa8\x7b\x9d\xb2\x7c\x3e\xa2\xfe\x0d\x1b\x2d\x04\xb8\xf5\xf8\x50\x20\x51\xb8\x14\x98\x73\xd7\x8c\xbb\xc7\x9c\xcf\x28\xfb\x0c\x96\x9d\xfe\xfb\x81\xa8\x15\x7c\x87\xb9\x12\xe3\x77\x8b\xf7\x33\xbd\xf2\x35\x1e\xa6\x12\x61\x2f\xe7\x78\x13\x71\x30\xa5\x40\x46\xb2\x66\xca\x37\x9c\x29\x64\xaa\xab\xf4\x57\xcd\xf4\xf0\xfc\x93\x20\x2c\x5c\x19\xcc\xd7\x13\x13\xf7\x8b\x69\x81\xef\xf0\x56\x86\x24\xc7\x0f\xbc\xd0\xab\x79\x52\x25\x9e\x65\x54\x1d\x52\xa9\x09\xdf\xc0\x7e\x3a\xfb\x57\x6d\xf6\x94\x45\xf8\x4d\x13\xb7\x0f\x3a\xf0\x4e\xca\x02\xef\x58\xcc\x25\x5c\x9e\xa1\x6d\x17\x97\xea\x01\xbb\xbc\x6b\xbc\x27\xf3\xbc\xee\x9e\x67\x5e\xa4\xe9\x52\xe0\x97\x02\xa5\xea\x9a\xee\xcd\x69\x06\x4a\x10\x26\x63\x14\xfd\x55\xe5\xcf\xa7\x09\xe8\xbd\x65\xa9\x48\xb2\xfb\x12\x76\x51\x52\xfe\x65\x97\x6b\xc5\xb3\x0c\x99\xea\xa7\xa8\x7c\xbf\x43\xa6\x19\x8a\xa4\xdf\xa2\xf2\xc7\x5d\x0a\x9b\x72\xd9\xd3\xbb\xea\xbf\xe8\x90\xa7\x40\x9e\x23\xeb\x89\xe8\xcb\xce\x05\xed\x75\xfd\xaf\xba\xd7\xb5\x57\xbe\xaf\x7a\x38\x1a\xed\xde\x6d\xa1\xfa\x3e\x1c\xfd\xeb\xd3\x64\x22\x4c\xf1\x18\x99\x6e\x08\x9c\x39\x47\x6a\x02\x7d\x6d\xe4\x67\x0e\x91\x98\x8b\xcf\x1d\x5f\xe5\x2e\xbb\xaf\x98\x8c\x0a\xc1\xc5\x52\x3e\xb2\x70\xa9\x8f\xbd\xbe\x8b\x66\xec\xff\x38\x1f\x5b\xcd\x7d\xac\xdd\xf8\xcc\xad\xb8\xc9\xc2\x56\xd1\x7f\x64\xb1\x5d\x86\xc0\x5b\x1b\x94\x7f\xe7\x77\xd6\x46\xa4\x61\x98\x42\x7b\x29\x5a\x3b\x1f\xc7\x09\x8a\x74\xf3\x6c\x2a\xc4\x98\xa4\xd7\x13\x98\x9a\x69\xd5\x97\xa8\xf1\x1b\x73\x81\x96\x30\xda\x8a\x60\xa2\xc5\x7a\x76\x75\x35\xad\x67\xdb\x0a\xa3\xb1\x85\x1d\xd5\x28\x8d\x4e\x67\x6d\x4a\x8f\x06\x38\x41\x4a\x6f\x9b\xd6\x93\x66\xc9\xf0\x66\x63\x38\xed\x38\x6b\x39\xad\xcc\xd3\x42\xad\xb8\x78\x9b\x11\x9a\x6a\x9b\x06\x01\xd9\x4e\x5e\xa3\x0f\x69\xe4\x6e\x5c\xde\x66\x02\x55\xe5\xd9\x6e\xcf\xf8\x3a\xe2\x5b\x8b\x67\x16\x6a\xfe\x6e\xea\x43\xdd\xa8\x0d\x1e\x04\x32\x27\x6c\x8d\xaa\xf0\x9b\x02\x25\x0a\x16\x12\x85\x90\xd2\x64\xa5\x20\x11\xf8\x08\x2b\x22\x87\x98\xf1\x3f\xa9\xf1\x8a\xef\x51\x4a\x92\x18\x8b\xa8\x47\xdf\x06\x5e\x4a\x6f\x9b\x3a\x60\xf3\xbd\x3b\xf0\x9f\xc6\x40\x58\x04\x17\x89\xaa\xc5\x9a\x21\x03\x7f\xb0\x55\x2e\x27\x02\x8d\x5f\x35\x8a\x1d\x73\xb2\xfb\xe1\x9a\xde\xc1\x7b\xa2\x8e\x58\x5a\x55\xa4\xbb\x4d\x5a\x55\xf0\xf7\x5f\x5a\x07\x33\x85\x1d\xa2\x81\xb7\x2d\xa8\x8d\xe9\xff\x21\xbb\x74\x42\x54\x73\x57\x01\x45\x55\x8a\x6d\x55\x0f\x9e\xd3\xfe\x46\xe3\xd3\xa9\xaf\xff\xbf\xd4\x8d\x1b\xfd\x53\x72\x6f\xb2\x2e\x74\xdf\x4e\x4e\x27\xc8\x5b\x70\xc7\x2a\xf2\xf8\x04\xf2\x73\xec\xb7\x1b\x72\x0f\xb9\x2e\xf4\xfe\xb6\xbf\xc7\xf9\xe3\xbd\x4d\xce\x7f\x31\x18\x1c\x0c\x7d\xb9\x1f\x7a\x35\x18\x0c\xfa\xd3\xbc\xbd\x5d\xb7\x35\xa1\x8a\xa4\x34\x6c\x48\xa3\xd1\x16\x34\xc3\x39\x65\x21\x5a\xab\x6a\x4e\x2d\xa8\x5f\xc2\x19\x61\x49\x43\x9a\xed\x5b\xd4\x78\x6a\x7f\x2c\xe3\x0c\x0f\x7d\x28\xa3\x2d\x1e\x66\x55\x32\x4c\xc8\x90\x87\x8a\x86\x9c\x41\xfd\x3b\x2c\x4b\xbb\xd9\xdf\xe9\xc6\xad\x68\x7a\x67\x08\x3c\xda\x4e\x7d\xec\x43\x5e\x44\x1f\x68\x84\x42\x8f\xb0\x01\xf5\x6f\xad\xcc\x3f\x01\x00\x00\xff\xff\xa2\x57\x09\xeb\x7c\x14\x00\x00"
+var _userDashboardFeedsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x58\xd1\x6e\xdb\x36\x14\x7d\x56\xbf\xe2\x4e\xe8\x43\xfc\x60\x39\x72\x9b\x36\x2b\x14\x03\x5e\xd7\xad\x01\x92\xad\x48\xd2\x67\x83\x91\xae\x64\xae\x12\xa9\x92\x54\xda\x40\xe5\x97\xed\x6d\x5f\x36\x90\x94\x6d\xc9\xb1\x13\xaf\x95\xf6\x10\x44\x26\x2f\xcf\x3d\x3c\xbc\x14\x79\x54\xd7\x82\xb0\x0c\x21\xf8\x0d\x31\x91\x5a\x3f\xf3\xa2\x84\xde\x41\x9c\x13\x29\xcf\x7c\x86\x5f\xa4\x3f\x7b\xe6\x75\x1a\x2b\x0a\x39\xa6\xca\xb6\x7b\x11\x2d\xb2\x56\x07\xb9\x23\x8a\x08\xa0\x05\xc9\xd0\x07\x29\xe2\x33\xbf\xae\x83\x79\xac\xe6\xb6\x43\x6b\x1f\x48\xae\xce\x7c\x07\x3a\x49\xe8\xdd\x0e\xf4\x4c\xd0\xa4\x41\xef\x76\xa4\x34\x55\x88\x0c\xbe\xd0\x04\x21\xe6\x79\x55\x30\x17\xd7\x09\xac\x6b\x9a\x02\x17\x70\x84\x9f\x21\xf8\x1d\xd5\x9f\xe5\xcd\x7d\x89\x70\x32\xda\x6e\x99\x1e\x8f\xb4\x2e\x2b\xb9\x04\x33\xcf\xba\x46\x96\x68\xdd\x00\x7a\x51\xd9\x3c\x78\x11\x81\xa5\xc0\xd4\x00\xcf\xcb\xf2\xba\xba\xfd\x78\x75\xa1\xf5\xa4\xae\x0d\xd4\x3c\x56\x1f\x25\x8a\x3f\x48\x81\x66\x6c\x5d\x07\xd7\x4b\x2e\xba\xcd\xd1\x84\xac\xb1\x7e\x1a\x8f\xe1\x0a\x53\x14\xc8\x62\x04\x75\x5f\xa2\x04\xc5\xa1\xe0\x09\xe6\x72\x42\x62\x45\x39\x0b\x32\x0e\xe3\xf1\x6a\x88\x9d\x4e\x97\x78\xa8\x35\x34\xbd\x5e\x5d\x3f\x0f\x68\x78\xca\x82\x1b\x01\x7e\x33\x3e\x16\x48\x14\x2e\x04\x96\xdc\xb7\xe3\xae\xb0\xe4\x17\x94\x7d\x02\xc7\xce\xfc\xfc\x40\xd4\x12\xbe\xc1\xb5\x12\xd3\xf7\x37\x97\x17\x66\xe5\x1b\x3c\xcc\x25\xc2\x83\x9c\x53\xad\x1f\x4b\x29\x90\x91\xa2\x9d\xf2\x2d\x67\x0a\x99\xea\x2b\xfd\x49\x3b\x3d\x3c\xbf\x15\x84\xc5\x4b\x8b\xf9\xe6\xcc\xc6\xfd\x62\x5b\xe0\x1b\xbc\x93\x31\x29\xf1\x03\xaf\x58\xd2\x1e\xb3\x43\x25\x5e\x14\x54\xed\x52\xa9\x0d\xdf\xc2\xfe\x7e\xf6\xaf\xba\xec\x29\x4b\xf0\xab\x21\xee\x1e\x4c\xe0\xb9\x94\x15\x9e\xb3\x94\x4b\x38\xd6\xfa\x80\xc5\xa5\x66\xc0\x36\xef\x06\xef\xbb\x79\xbe\xee\x9f\x67\x59\xe5\xf9\x42\xe0\xe7\x0a\xa5\xea\x9b\xee\xe9\xe3\x0c\x94\x20\x4c\xa6\x28\x86\xab\xca\x9f\x1f\x27\x60\xde\x2d\x0b\x45\xb2\xed\x4d\xd8\x47\x49\x85\xc7\x7d\xae\x15\x2f\x0a\x64\x6a\x98\xa2\x0a\xc3\x1e\x99\x16\x28\xb2\x61\x8b\x2a\x9c\xf6\x29\x6c\xce\xe5\x40\x7b\x35\x7c\xd1\x23\x4f\x81\xbc\x44\x36\x10\xd1\x97\xbd\x0b\x3a\xe8\xfa\x9f\xf4\xaf\xeb\xa0\x7c\x5f\x0d\x70\x34\xba\x77\xb7\x83\x1a\xfa\x70\x0c\x5f\x3f\x4e\x26\xc1\x1c\xf7\x91\xe9\x87\xc0\xe9\x41\x04\x86\x7a\x91\x3f\x71\x88\xa4\x5c\x7c\xea\xf9\x2a\x77\xdc\x7f\xc5\x14\x54\x08\x2e\x16\xf2\x9e\xc5\x0b\x73\xec\x0d\x5d\x34\xd3\xf0\x70\x3e\xae\x9a\x87\x58\xbb\xe9\xf4\x70\x16\xae\x8a\x7e\x90\x45\x6b\x19\xac\x2f\x08\xce\xe5\xaf\x98\x92\x2a\x6f\x50\xd6\xbd\x5e\x24\x4b\xc2\x5a\xde\xa9\xa0\x8c\xc2\x2d\x91\x34\x86\x9c\xdc\x62\xee\xcf\x76\x11\x4e\x1c\xd8\x6a\xb3\x19\xef\x62\x70\x66\xbb\x18\x44\x93\x95\x45\xfa\x6f\x8e\x6b\x65\x85\x5a\x96\x2d\x76\xd7\xb2\x95\xf7\xf2\xbc\xa8\xca\xd7\xcf\xb6\x46\xad\x4d\x7b\x73\x06\x73\xcb\xb3\xb9\xc6\x4d\xdf\xda\x2b\xbc\x84\x40\xeb\x4e\xb4\x58\xe9\xdb\xd4\xf3\x4a\xef\xad\xb0\x02\x15\x91\x36\xe6\xd2\x3c\x75\x7a\x69\xea\x92\x06\x4d\x8e\x56\xa7\xb7\x32\xcd\x7b\x03\xbc\x28\xa7\xb3\xb6\x35\xa6\x45\x36\x3e\x5d\x1b\x62\x37\xce\x59\x62\x57\x06\xf3\x4a\x2d\xb9\x78\x57\x10\x9a\x1b\x1b\x09\x11\xd9\x48\x63\xd0\xc7\x34\xf1\xd7\x2e\x74\x3d\x3d\xad\x27\xae\x7b\x62\x7d\x27\x09\x9d\x05\xb5\x85\x74\xfd\x7e\x1e\x42\xd3\x68\x0c\x28\x74\x0a\x42\xe1\x57\x05\x4a\x54\x2c\x26\x0a\x21\xa7\xd9\x52\x41\x26\xf0\x1e\x96\x44\x8e\xb1\xe0\x7f\x51\x03\x74\x85\x2c\x41\xe1\xe6\x77\x89\x52\x92\x0c\x21\x25\x66\x27\x04\xab\x9f\x1b\xa9\x1b\x35\xdb\x75\xdb\x14\x4f\x34\xc9\xe9\xac\xad\x5e\xbb\x8c\x77\xfd\xa6\x29\x10\x96\xc0\x51\xa6\x1a\x89\x2f\x90\x41\x38\xda\xe8\x5d\x12\x81\xd6\x85\x5b\x9d\xf7\xf9\xf3\x87\xe1\xbb\x8b\x3e\x76\x11\x0b\xa7\xa5\xf4\x37\x49\xb5\x86\x7f\xfe\x36\xea\xd9\x29\x6c\x11\x8d\x26\x9b\x22\x5d\x7f\xca\x38\xc8\x04\x3e\xb2\x14\xf6\x06\x06\x8a\xaa\x1c\xbb\x6b\xb1\xf3\xf6\x11\x6e\x6d\xd0\xa7\x7d\xdd\xff\x9e\x3a\x3c\xfe\xa1\xdc\xeb\xac\x37\xa6\x6f\x2b\xa7\x17\x95\x1d\xb8\x7d\x75\xbc\x7f\x02\xe5\x53\xec\x37\xc7\xcc\x00\xb9\x8e\xb8\x70\x7f\xdb\x0e\xe4\xc1\x8b\x33\x7c\x31\x1a\xed\x0c\x7d\xf9\x30\xf4\x64\x34\x1a\x0d\xa7\x79\xf7\x08\xe8\x6a\x42\x15\xc9\x69\xdc\x92\xc6\xa0\xdd\xd0\x02\xaf\x29\x8b\xd1\x19\x70\x7b\x16\x43\xb3\x09\x2f\x08\xcb\x5a\xd2\x6c\x76\x51\xeb\xa9\xfb\x09\x90\x33\xdc\xf5\xf9\x8f\x76\x78\xd8\x55\x29\x30\x23\x63\x1e\x2b\x1a\x73\x06\xcd\xff\x71\x5d\xbb\x03\xe4\xdc\x34\x6e\x44\x33\x6f\x86\x68\x42\xbb\xa9\xf7\x7d\x9e\x4c\xe8\x1d\x4d\x50\xf8\xb3\x55\x40\xf3\xbf\x51\xe6\xdf\x01\x00\x13\x8f\xee\xc0\x52\x15\x00\x00"
 
 func userDashboardFeedsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2792,12 +3716,12 @@ func userDashboardFeedsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/dashboard/feeds.tmpl", size: 5244, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x59, 0xea, 0xec, 0x34, 0x3b, 0xd7, 0x11, 0xbf, 0x1a, 0xd3, 0x56, 0x56, 0xb7, 0xbc, 0x4c, 0xbc, 0xa7, 0x1f, 0xad, 0x29, 0x3a, 0xcb, 0x6f, 0x6, 0x59, 0x14, 0x4b, 0x7f, 0xf8, 0xf6, 0x71, 0x5e}}
+	info := bindataFileInfo{name: "user/dashboard/feeds.tmpl", size: 5458, mode: os.FileMode(0644), modTime: time.Unix(1792293692, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc3, 0x50, 0x1f, 0xd4, 0xc2, 0x8b, 0x75, 0x81, 0x6, 0xc7, 0xfb, 0x3e, 0x55, 0x35, 0xac, 0x89, 0x6f, 0xf5, 0x1e, 0xae, 0x2a, 0xec, 0x4b, 0x60, 0xf1, 0x33, 0x15, 0x80, 0x49, 0x3f, 0xf7, 0xe}}
 	return a, nil
 }
 
-var _userDashboardIssuesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\xdd\x6f\xdb\x36\x10\x7f\x4e\xff\x8a\x9b\x66\x04\xed\x83\x65\xf4\x6d\xe8\x92\x0c\x45\x86\xa2\x01\xb2\x34\x68\xd2\x01\x7b\x0a\x68\xe9\x2c\xb3\xa5\x48\x8d\xa4\xec\xa6\x1a\xff\xf7\x81\x14\x25\x91\x92\x9d\xba\xe9\x07\xd0\x27\x4b\xe4\xf1\x3e\x7e\x77\xbc\x0f\xb9\x69\x34\x96\x15\x23\x1a\x21\x59\x12\x85\x8b\x35\x92\x3c\x81\xd4\x98\x27\x27\x39\xdd\x40\xc6\x88\x52\xa7\x49\x4e\xd4\x7a\x29\x88\xcc\x81\x2a\x55\xa3\x4a\xce\x9e\x1c\x85\x47\x6b\x85\x72\xd1\x13\x2d\x38\xd9\x2c\x89\x6c\xd9\x1c\x85\x7c\x6a\x0a\x99\xe0\x9a\x50\x8e\xd2\xf2\x18\x6f\x16\x92\xe6\x6e\x3d\xda\x58\x89\x5a\xc2\x96\xe6\x08\x99\x60\x75\xc9\x5b\x8a\xf1\x59\x85\x99\xe0\x39\x91\xf7\xb0\x41\xa9\x69\x46\x18\xac\x28\xd3\x28\xa1\x44\x5e\xfb\x33\x47\x27\xa4\x3b\xd2\x34\x74\x05\xf8\x2f\xa4\x7f\x53\xdc\xde\xde\x57\x08\xc9\xbd\xa8\xe5\x9d\xc4\x4a\x28\xaa\x85\xa4\xa8\x12\x63\x6a\x0a\x4b\xa2\x68\x06\x4b\x56\x23\x2c\x6b\xad\x05\x6f\x1a\xe4\xb9\x31\x40\x35\x96\x09\xac\x25\xae\x2c\xbb\xf4\x92\xf2\x0f\xc6\xfc\xa1\xef\x2b\x3c\x9d\xb0\x3a\xb6\x2f\xa7\x4d\x93\xbe\xc5\x4a\x5c\xfc\x69\xcc\xb1\x12\x52\x9f\x36\xcd\x2c\xbd\x11\x52\x5b\x05\xec\x9a\x26\x1a\x2d\xd5\x8d\x7d\x30\xa6\x53\xfb\xa8\x69\x52\xfa\xfc\x37\x9e\xde\x4a\x48\xd6\xa2\xc4\xb4\x75\x44\x4a\xf9\xdd\x20\x2a\xb1\x78\xb7\x66\x2a\x2d\x05\x2f\x02\x78\x24\x2d\xd6\x3a\x39\x6b\x9a\xf4\xc2\x9e\xb4\xfc\x55\xfa\x8f\xa8\xa5\xd5\x47\x9d\x8b\x9a\x6b\x63\x4e\x16\xed\xb9\x0e\xac\x05\xf1\x4f\x0e\x2c\x2e\x34\xa4\xe7\x82\x6b\xfc\xa8\xdf\x29\x94\xe9\x85\x7a\x23\x0b\xc2\xe9\x27\xa2\xa9\xe0\x83\xf0\x87\x30\x26\x4a\xd1\x82\x63\xfe\x78\x68\x3b\x0e\x5f\x89\x68\x04\xa9\x65\xd5\x41\xda\x46\xcd\x9d\x95\x95\x76\xb2\xee\xb4\xb0\x38\x0f\x00\x1f\x8a\xf0\x4b\xc7\x60\x37\xbc\x01\xbe\x0f\x63\x96\x49\x24\x1a\xf3\xbb\xe5\xfd\xe3\x51\x1b\x78\xfc\x08\xdc\x06\x69\x8f\x83\xed\xdc\x9d\xff\x2c\x6c\xde\x6e\x1f\xad\x71\x3e\xc8\xe9\x86\xe6\x36\xcd\x9c\x2c\x72\xba\xe9\x0f\x48\xc2\x0b\x04\x67\xbb\x7a\x20\x62\x67\x1e\x1d\x48\x2d\x42\x0f\x61\x6e\x21\x00\x4e\x4a\x1c\xa1\x3f\x8b\xe0\xb7\xef\x9d\x43\x8d\xe9\xaf\xd3\xd3\x91\xa8\x67\xc6\xf4\xee\xb1\x82\xbd\x90\x07\x3d\x34\x9b\xba\xe8\x44\x55\x84\x77\x26\xd9\xeb\x0a\x5a\xd6\x3c\x23\x1a\x1d\xd0\xaf\x6a\xc6\xae\x48\x89\x0e\xda\x8a\xf0\xe1\x5c\x98\x75\x99\x20\x9a\xf2\x02\x6a\x0a\x4e\xdf\x59\x7a\xa1\x6e\xd6\x62\x7b\xce\x84\xc2\xdc\x18\x89\x79\xd3\x20\x53\x68\x4c\x21\x11\x7b\x40\x18\x59\x22\x0b\xc3\xc5\x9d\xbd\x26\x05\x5e\x28\xe7\xe2\x01\xf7\x7e\x37\xe6\xdc\x34\xe9\x55\x5d\xb6\x6f\xdd\x89\x4e\x54\xbb\xf7\xa6\x42\x1e\xec\x0c\x41\xe0\x62\xc2\x11\x1e\x2e\xe2\xba\x66\x6c\xa7\x84\x61\x63\x24\x20\x7c\x0d\xc3\x6b\x4f\x68\x0e\x24\xc1\x53\x00\xb5\xde\x22\xdb\x60\x58\xe2\x5c\x99\x44\xae\x77\x97\x3a\x4d\xf9\xbd\x0f\x48\x1b\x04\xb5\xf2\x21\xa9\xa6\x55\xae\x73\x9e\xcb\xdd\x31\x04\xce\x69\x40\x32\x4d\x37\xd8\xf9\xce\x47\xb9\xe3\xb6\x27\x91\xc4\x91\xfc\x25\xc9\x44\x54\xc8\xfb\xb8\x38\xa1\x9d\x8a\x22\xd3\x34\x13\x1c\xfc\xef\xdc\xa5\x93\xb9\x25\xc6\xdc\xde\x5e\xba\xab\x06\x86\x89\xc7\x92\xde\x69\xb2\x4c\x20\x4c\x21\xd6\x81\x3e\x81\x8c\xab\xd9\x14\x9e\x69\x68\xff\x48\x60\x32\x27\xf6\x60\x68\x3a\xf2\x03\xa0\x71\xa4\x53\x6c\x5a\x3b\x77\xa3\x13\xc4\xf3\x28\xec\x5c\xa6\x06\x97\x17\x30\x0f\xfa\xad\x5d\x6d\xd6\x2f\xf3\x39\x58\x3b\x61\x3e\x3f\xdb\x9d\x9e\xa5\xa8\x72\xb1\xe5\x60\xb1\x83\xf7\x75\x59\xb5\xf9\xb3\x07\x61\x9c\xc2\x0e\x2e\x40\x16\xe7\xb0\xe2\xf4\x68\xf6\x22\x2d\x96\x11\x7c\x71\x1a\x0c\x35\x0d\x8d\x9a\xd4\x09\x21\x5d\x02\xef\x1d\x0a\x89\x6d\x87\x95\x4e\x9e\xc1\x53\x77\xe1\xba\x8d\x67\xc6\xc4\xe1\x74\x78\xa9\xd8\x13\x47\xad\xa0\x5d\x35\xe0\x00\x74\x52\xaf\xa6\xcd\xff\x64\x9f\x71\xb1\x61\x82\xe5\xed\x89\x6f\x6c\x47\xcb\xf7\xb1\x76\xf4\x5a\x1d\x6c\x87\xc4\x0c\xb9\xae\xab\xdc\xd6\xc2\x6f\x6e\x4d\xc8\xfd\xb1\x36\x8d\x34\x3c\xd8\x32\x86\x44\x7d\x37\xc3\x02\xe6\x8f\x8e\xb9\x48\xbf\x83\xcd\x2a\x85\xd2\x99\x28\x4b\x5b\x0c\xbf\xb9\x59\x01\xf3\xc7\x9a\x15\xeb\xf7\x65\xde\xfa\x6e\x76\x85\xdc\xbf\xca\x5f\x3b\x2d\x0b\x3b\x9e\xb0\x5a\xb4\x8f\x93\xca\xe1\x78\x03\xa3\xaa\x4f\xe1\x7d\x17\x3e\x6a\x07\x9b\x06\x66\x9a\x96\x78\xa3\xe5\x8b\x53\xb8\xb5\x4f\x94\x67\x08\x7e\x1c\xc8\x61\x96\x5e\x12\x5e\xc0\xd0\xb7\xb3\x3e\xb9\x47\xc5\x63\x5c\x6c\x7c\x4b\xda\x77\x42\xb3\x1e\x2f\x0f\x5d\xd0\x12\x7b\x27\xfc\x6a\x3b\x70\x9e\xe3\x47\x6b\x7a\xd0\xe1\x05\x6e\xd5\x54\x33\x84\x35\x51\x73\x2c\xc5\x7b\x3a\x78\xec\x65\x55\xdd\xd4\xcb\x77\x6f\x2f\x8d\x59\x74\x02\xde\x6c\x39\xca\xb4\x15\xd1\x2f\xfa\xd7\x16\xfd\xc5\x20\xd1\x79\xe8\xd6\xb2\xf7\xc0\x47\xcd\xb4\xeb\x5c\x5b\xc7\x84\xad\x74\x54\x31\xbd\xe3\x60\x18\xb0\xf6\x77\x15\x9d\x93\x5d\x45\x04\xdf\x19\xf7\xfc\x47\x23\xc2\xa8\xfb\x0d\x01\x3f\xdb\xad\xca\xa0\x42\xbf\x3f\x44\xc0\xa5\xf5\x4c\x68\xc5\xa8\x3b\x6b\x3d\x07\x4a\xdf\x33\xb4\x56\x31\x21\x5f\x58\x15\x5f\x09\x89\x85\x14\x35\xcf\xcf\xed\x9a\x31\xbf\xc3\x92\x64\x1f\xda\xa5\xf9\x40\xe7\x77\x1d\xa0\x16\x6d\xf8\x0f\x6e\x08\xa7\x9a\x7e\xc2\xf8\xb6\x4e\x2c\x3b\x1a\xcf\x46\x41\x78\xdb\xd7\xaa\x6f\x2b\x50\x65\x81\x6d\xf6\xa2\xed\x6d\x53\xdb\x21\xbe\x8f\x72\x48\xaf\x85\xd2\x28\xd3\xd7\xa2\x44\x7b\xcb\xfb\x85\x5e\xd7\xd5\x64\x94\xf1\xdf\x14\x30\xdc\x88\x41\x6b\x1b\x35\xff\xed\x02\xa1\x12\x95\x9b\xe3\xaa\xa0\x71\xed\x78\xf4\x92\x8d\x49\x20\x27\x9a\xcc\xfd\xe4\x11\x53\xb5\x81\xea\x29\x36\x44\x52\xf7\xc5\xe7\x34\xa1\x7c\x83\x52\x63\xee\x77\xdc\xe7\x2e\xb7\xc1\x70\xa5\xc1\x16\x32\xff\xa1\xaf\xd7\x93\x96\xe1\xf0\x4f\x36\x44\x13\x09\xb4\x24\x05\x26\xa0\x64\x16\x8b\x7d\x8b\xec\xa5\xa3\xf0\x1a\x06\x9c\x22\xdf\x4d\x46\xb2\x6a\xc8\x55\x8c\x8e\x26\xb2\xee\x6d\x4b\xf5\x1a\xdc\x5c\x1a\x24\x20\xba\x82\x42\x43\x7a\x2b\x34\x61\x76\x4b\xc1\xf3\x80\x6f\x90\x55\x5a\xdb\xa0\x22\x05\x8e\xa7\xaf\x69\xfe\x59\x0a\x99\xa3\x64\xa8\x94\x3d\x40\xb9\x43\x0f\xe2\xde\x72\x52\x30\x5c\x07\xf9\x9a\xa8\x6b\x89\x1b\x2a\x6a\x65\x4c\x4e\x15\x59\x32\x37\x76\x07\x75\xa2\x0d\x8a\x88\xf0\xa0\xd2\x71\xd0\x37\x85\x63\x77\x01\x55\xbb\x84\x0c\x33\xdd\xdd\xd8\xe3\x92\x32\x54\x5a\xf0\x96\xfe\xaf\xee\xcd\x55\xa1\x2e\xf6\xdc\x56\xe7\x4e\xb7\x63\x01\xb3\x05\x6b\x50\x36\xf1\xe6\xc4\x61\xd2\x41\xe1\x02\x89\x48\x29\xb6\x41\xdb\x0e\x7b\x2f\x59\xe5\xd9\x26\xd1\xe5\x18\xdd\x73\x9f\x7b\x9c\x7f\xa3\xd4\xd3\x17\xea\xab\xba\x84\xf9\xf3\x68\x2f\x70\x4f\xe7\x07\x3f\xb1\xa4\x69\x1a\x4b\x98\x7e\x83\x98\x7a\x37\xbd\x50\xe7\xb5\x94\x68\x27\xb0\x5d\xd5\x3f\x9c\xda\x7b\xc2\x9f\xc0\xaf\x57\x75\x19\xb8\xb4\x5b\x98\xe2\x13\xa5\xda\xe9\xc2\x9e\xbb\x70\x85\x1f\xf5\x67\xef\x41\x4b\xf4\x33\x60\xe5\x14\xdd\x15\xff\x7b\xe3\x9b\xdb\x49\xd8\x18\x18\x2e\x88\x2b\xe5\x3e\xe3\xdb\x6b\x12\x0d\xb6\xe3\xe0\x1f\xb5\x32\xe1\x5b\xe4\x82\xcf\x7c\xbd\xea\x1e\xfc\xaf\xff\x99\xfc\x99\xb4\x12\xc2\xe6\x7f\xf7\x3f\xd0\xff\x01\x00\x00\xff\xff\x64\xad\x5b\x0a\x6a\x1a\x00\x00"
+var _userDashboardIssuesTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x59\x5f\x6f\xdb\x36\x10\x7f\x76\x3e\xc5\x4d\x33\x82\xe6\xc1\x32\xfa\x36\x74\x4e\x86\x22\x45\x51\x63\x59\x1a\x24\xe9\x80\x3d\x05\xb4\x74\xb6\xd9\x52\xa4\x46\x52\x76\x5d\x8d\xdf\x7d\x20\x45\x49\x94\x64\xa7\x6e\xfa\x07\x68\x5e\x2c\x92\xc7\xfb\xf3\xbb\xe3\xdd\x91\x29\x4b\x8d\x59\xce\x88\x46\x88\x16\x44\xe1\x74\x8d\x24\x8d\x20\x36\xe6\x64\x96\xd2\x0d\x24\x8c\x28\x75\x1e\xa5\x44\xad\x17\x82\xc8\x14\xa8\x52\x05\xaa\xe8\xe2\x64\x14\x6e\x2d\x14\xca\x69\x43\x34\xe5\x64\xb3\x20\xb2\x62\x33\x0a\xf9\x14\x14\x12\xc1\x35\xa1\x1c\xa5\xe5\xd1\x5f\x5c\x49\x9a\xba\xf9\xce\xc2\x52\x14\x12\xb6\x34\x45\x48\x04\x2b\x32\x5e\x51\xf4\xf7\x2a\x4c\x04\x4f\x89\xdc\xc1\x06\xa5\xa6\x09\x61\xb0\xa4\x4c\xa3\x84\x0c\x79\xe1\xf7\x8c\x66\xa4\xde\x52\x96\x74\x09\xf8\x2f\xc4\x7f\x53\xdc\xde\xef\x72\x84\x68\x27\x0a\xf9\x20\x31\x17\x8a\x6a\x21\x29\xaa\xc8\x98\x82\xc2\x82\x28\x9a\xc0\x82\x15\x08\x8b\x42\x6b\xc1\xcb\x12\x79\x6a\x0c\x50\x8d\x59\x04\x6b\x89\x4b\xcb\x2e\xbe\xa2\xfc\x83\x31\x7f\xe8\x5d\x8e\xe7\x03\x56\xa7\x76\x70\x5e\x96\xf1\x2d\xe6\x62\xfe\xca\x98\x53\x25\xa4\x3e\x2f\xcb\x71\x7c\x27\xa4\xb6\x0a\xd8\x39\x4d\x34\x5a\xaa\x3b\xfb\x61\x4c\xad\xf6\xa8\x2c\x63\xfa\xfc\x37\x1e\xdf\x4b\x88\xd6\x22\xc3\xb8\x72\x44\x4c\xf9\x43\x2b\x2a\xb2\x78\xbb\xbf\x99\xd2\x52\xf0\x55\x00\x8f\xa4\xab\xb5\x8e\x2e\xca\x32\x9e\xdb\x9d\x96\xbf\x8a\xff\x11\x85\xb4\xfa\xa8\x4b\x51\x70\x6d\xcc\x6c\x5a\xed\xab\xc1\x9a\x12\xff\xe5\xc0\xe2\x42\x43\x7c\x29\xb8\xc6\x8f\xfa\x9d\x42\x19\xcf\xd5\x5b\xb9\x22\x9c\x7e\x22\x9a\x0a\xde\x0a\x7f\x0c\x63\xa2\x14\x5d\x71\x4c\x9f\x0e\x6d\xcd\xe1\x2b\x11\xed\x40\x6a\x59\xd5\x90\x56\x51\xf3\x60\x65\xc5\xb5\xac\x07\x2d\x2c\xce\x2d\xc0\xc7\x22\xfc\xd2\x31\xd8\x0f\x6f\x80\xef\xe3\x98\x25\x12\x89\xc6\xf4\x61\xb1\x7b\x3a\x6a\x2d\x8f\x1f\x81\x5b\x2b\xed\x69\xb0\x5d\xba\xfd\x9f\x85\xcd\xdb\x7d\x32\xda\x93\x0f\x52\xba\xa1\xa9\x4d\x33\xb3\x69\x4a\x37\xcd\x06\x49\xf8\x0a\xc1\xd9\xae\x1e\x89\xd8\xb1\x47\x07\xe2\xf9\xab\xc7\x31\xb7\x10\x00\x27\x19\xf6\xd0\x1f\x77\xe0\xb7\xe3\xda\xa1\xc6\x34\xc7\xe9\x59\x4f\xd4\x99\x31\x8d\x7b\xac\x60\x2f\xe4\x51\x0f\x8d\x87\x2e\x9a\xa9\x9c\xf0\xda\x24\x7b\x5c\x41\xcb\x82\x27\x44\xa3\x03\xfa\x75\xc1\xd8\x35\xc9\xd0\x41\x9b\x13\xde\xee\x0b\xb3\x2e\x13\x44\x53\xbe\x82\x82\x82\xd3\x77\x1c\xcf\xd5\xdd\x5a\x6c\x2f\x99\x50\x98\x1a\x23\x31\x2d\x4b\x64\x0a\x8d\x59\x49\xc4\x06\x10\x46\x16\xc8\xc2\x70\x71\x7b\x6f\xc8\x0a\xe7\xca\xb9\xb8\xc5\x7d\x34\xda\xcb\xb9\x2c\xe3\xeb\x22\xab\x46\xf5\x8e\x5a\x54\xb5\xf6\x36\x47\x1e\xac\xb4\x41\x30\x1a\xd5\x84\xc7\x8b\xb8\x29\x18\xdb\x2b\xa1\x5d\xe8\x09\x08\x87\x61\x78\x1d\x08\xcd\x96\x24\xf8\x0a\xa0\xd6\x5b\x64\x1b\x0c\x4b\x9c\x2b\x93\xc8\xf5\xfe\x52\xa7\x29\xdf\xf9\x80\xb4\x41\x50\x28\x1f\x92\x6a\x58\xe5\x6a\xe7\xb9\xdc\xdd\x85\xc0\x39\x0d\x48\xa2\xe9\x06\x6b\xdf\xf9\x28\x77\xdc\x0e\x24\x92\x6e\x24\x7f\x49\x32\x11\x39\xf2\x26\x2e\x66\xb4\x56\x51\x24\x9a\x26\x82\x83\xff\x9d\xb8\x74\x32\xb1\xc4\x98\xda\xd3\x4b\xf7\xd5\xc0\x30\xf1\x58\xd2\x07\x4d\x16\x11\x84\x29\xc4\x3a\xd0\x27\x90\x7e\x35\x1b\xc2\x33\x0c\xed\x1f\x09\x4c\xe2\xc4\x1e\x0d\x4d\x4d\x7e\x04\x34\x8e\x74\x88\x4d\x65\xe7\x7e\x74\x82\x78\xee\x85\x9d\xcb\xd4\xe0\xf2\x02\xa6\x41\xbf\xb5\xaf\xcd\xfa\x65\x32\x01\x6b\x27\x4c\x26\x17\xfb\xd3\xb3\x14\x79\x2a\xb6\x1c\x2c\x76\xf0\xbe\xc8\xf2\x2a\x7f\x36\x20\xf4\x53\xd8\xd1\x05\xc8\xe2\x1c\x56\x9c\x06\xcd\x46\xa4\xc5\xb2\x03\x5f\x37\x0d\x86\x9a\x86\x46\x0d\xea\x84\x90\x2e\x81\x37\x0e\x85\xc8\xb6\xc3\x4a\x47\x67\xf0\xcc\x1d\xb8\x7a\xe1\xcc\x98\x6e\x38\x1d\x5f\x2a\x0e\xc4\x51\x25\x68\x5f\x0d\x38\x02\x9d\xd8\xab\x69\x4c\xe3\xf5\xfd\x2d\x48\x6b\x98\x60\x69\xb5\xe3\x1b\xdb\x51\xf1\x7d\xaa\x1d\x8d\x56\x47\xdb\x21\x31\x41\xae\x8b\x3c\xb5\xb5\xf0\x9b\x5b\x13\x72\x7f\xaa\x4d\x3d\x0d\x8f\xb6\x8c\x21\x51\xdf\xcd\xb0\x80\xf9\x93\x63\xae\xa3\xdf\xd1\x66\x65\x42\xe9\x44\x64\x99\x2d\x86\xdf\xdc\xac\x80\xf9\x53\xcd\xea\xea\xf7\x65\xde\xfa\x6e\x76\x85\xdc\xbf\xca\x5f\x7b\x2d\x0b\x3b\x9e\xe0\xdb\x7f\x0e\x2a\x87\xe3\x0d\x8c\x2a\x1d\xf5\xbb\xf0\x5e\x3b\x58\x96\x30\xd6\x34\xc3\x3b\x2d\x5f\x9c\xc3\xbd\xfd\xa2\x3c\x41\xf0\xd7\x81\x14\xc6\xf1\x15\xe1\x2b\x68\x36\xcc\x58\x93\xdc\x3b\xc5\xa3\x5f\x6c\x7c\x4b\xda\x74\x42\xe3\x06\x2f\x0f\x5d\xd0\x12\x7b\x27\xfc\x6a\x3b\x70\x9e\xe2\x47\x63\xbc\x61\x03\xb7\x6a\xaa\x19\xc2\x9a\xa8\x09\x66\xe2\x3d\x6d\x3d\xf6\x32\xcf\xef\x8a\xc5\xbb\xdb\x2b\x63\xa6\xb5\x80\xb7\x5b\x8e\x32\xae\x44\x34\x93\x7e\x58\xa1\x3f\x6d\x25\x3a\x0f\xdd\x5b\xf6\xdd\x90\x72\x06\x10\x9e\x5a\xe4\xaa\x1a\xee\x0b\xcd\x5c\xd9\x5e\xb5\x2e\x3b\x6e\xe9\x16\x89\x12\x3c\x9e\xab\x6b\x2c\xb4\x24\xec\x2c\x68\x89\x3b\xc5\xd5\xf5\x40\x9d\x3d\x97\x82\x09\xd9\x34\x3d\x1e\xbc\x61\x57\xd2\xdb\x35\x4f\x04\x37\xa6\xaa\xab\xbe\xab\xea\x2a\x72\x59\x28\x2d\x32\x87\x79\xb0\x10\x36\xde\xe3\x26\x2c\x3b\x5b\xaf\x44\x42\x18\xfe\x89\xbb\xc6\x3b\xbd\x7b\x8b\x9f\x3d\xe9\xe0\xe4\x1a\xfc\x2a\x7e\xd5\x21\xdb\x7d\x7c\x43\x7b\x0f\x3d\xdc\x7c\xd5\x67\xa1\x36\xb0\xcb\xff\x80\x46\x7e\x18\xc6\xe5\xc5\x41\x37\x78\x15\x9a\xf5\xf6\xa0\x5c\x59\x1f\x84\x56\xf4\x9a\xd8\xca\x47\xa0\xf4\x8e\xa1\xb5\x8a\x09\xf9\xc2\xaa\xf8\x5a\x48\x5c\x49\x51\xf0\xd4\xfb\xf4\x77\x58\x90\xe4\x43\x35\x35\x69\xe9\xfc\xaa\x8b\x3b\x1b\x94\xf0\x1f\xdc\x11\x4e\x35\xfd\xd4\x8b\xc0\x81\x65\xa3\xfe\x15\x32\xc8\x02\x76\x98\x37\xdd\x17\xaa\x24\xb0\x2d\x74\xf6\xa0\x9b\xaf\xde\x3a\x9a\x64\x00\xf1\x8d\x50\x1a\x65\xfc\x46\x64\x68\x93\x61\x33\xd1\xe8\xba\x1c\xdc\xf8\xfc\xd3\x0b\x86\x0b\x5d\xd0\xaa\x7e\xd6\x3f\xf1\x20\xe4\x22\x77\xd7\xdd\x3c\xe8\xef\x6b\x1e\x8d\x64\x63\x22\x48\x89\x26\x13\x7f\x41\xeb\x52\x55\xe7\xd9\x53\x6c\x88\xa4\xee\x61\xec\x3c\xa2\xdc\xbe\x49\x62\xea\x57\xdc\xab\xa0\x5b\x60\xb8\xd4\x60\xeb\xbd\x7f\x0f\x1d\x35\x4d\x6b\x16\xbe\x91\x90\x0d\xd1\x44\x02\xcd\xc8\x0a\x23\x50\x32\xe9\x8a\xbd\x45\xf6\xd2\x51\x78\x0d\x03\x4e\x1d\xdf\x0d\x6e\xae\x79\x9b\xd2\x19\xed\x5d\x5c\xeb\xd1\x96\xea\x35\xb8\xeb\x7b\x90\xa7\xe9\x12\x56\x1a\xe2\x7b\xa1\x09\xb3\x4b\x0a\x9e\x1b\xb3\x2f\xf9\x56\xb6\x41\x4e\x56\xd8\xbf\xa4\x0e\xd3\xf4\x42\xc8\x14\x25\x43\xa5\xec\x06\xca\x1d\x7a\xd0\x6d\xc1\x07\x75\xd5\x65\xbc\x37\x44\xdd\x48\xdc\x50\x51\x28\x63\x52\xaa\xc8\x82\x61\xea\x2d\xf1\xe5\xb4\x0a\x8a\x0e\xe1\x51\x15\xf6\xa8\xa7\x97\x53\x77\x00\x55\x35\x85\x0c\x13\x5d\x9f\xd8\xd3\x8c\x32\x54\x5a\xf0\x8a\xfe\xaf\x7a\xe4\x8a\x75\x1d\x7b\x6e\xa9\x76\xa7\x5b\xb1\x80\xd9\xba\xde\x2a\x1b\x79\x73\xba\x61\x52\x43\xe1\x02\x89\x48\x29\xb6\xc1\xed\x06\x0e\x1e\xb2\xdc\xb3\x8d\x8c\x39\x10\x2b\x6d\xee\x71\xfe\x0d\xe9\x46\x4d\x3f\x73\x5d\x64\x30\x79\xde\x59\x0b\xdc\x53\xfb\xc1\x5f\xec\xe2\x38\xee\x4a\x18\x3e\xd5\x0c\xbd\xeb\xca\x86\x94\xc8\xf5\xfe\x26\x29\x7c\xdc\x68\x08\x7f\x02\xbf\x5e\x17\x59\xe0\xd2\x7a\x62\x88\x4f\x27\xd5\x0e\x27\x0e\x9c\x85\x6b\xfc\xa8\x3f\x7b\x0e\x2a\xa2\x9f\x01\x2b\xa7\xe8\xbe\xf8\x3f\x18\xdf\xdc\x3e\x18\x18\x03\xed\x01\x71\xa5\xdc\x67\x7c\x7b\x4c\x3a\xf7\xff\x7e\xf0\xf7\x3a\xbe\x70\xd4\x71\xc1\x67\x1e\xf9\xea\x0f\xff\xeb\x7f\x06\xff\x73\x5b\x0a\xa1\xb1\xfa\x77\xd9\xc9\xff\x03\x00\x50\xbb\xa3\x73\x91\x1b\x00\x00"
 
 func userDashboardIssuesTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2812,8 +3736,8 @@ func userDashboardIssuesTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/dashboard/issues.tmpl", size: 6762, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb8, 0x11, 0x32, 0x34, 0xf8, 0xaa, 0xaf, 0x1f, 0x1c, 0xf6, 0x54, 0x46, 0xd6, 0xa6, 0xff, 0x63, 0xe4, 0x65, 0x1e, 0x1, 0xaa, 0x9, 0x40, 0x0, 0x89, 0x44, 0x62, 0xac, 0x64, 0x3e, 0xc0, 0x96}}
+	info := bindataFileInfo{name: "user/dashboard/issues.tmpl", size: 7057, mode: os.FileMode(0644), modTime: time.Unix(1792282551, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0x7a, 0xb9, 0xa5, 0x17, 0xf9, 0xea, 0xef, 0x45, 0x19, 0x73, 0x9, 0x80, 0x37, 0xc1, 0xbf, 0x6e, 0x5a, 0x97, 0xee, 0xe2, 0x25, 0x7d, 0x75, 0x57, 0x47, 0x5, 0x27, 0xf9, 0x33, 0xfd, 0x43}}
 	return a, nil
 }
 
@@ -2917,7 +3841,7 @@ func userProfileTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _userSettingsApplicationsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\xcb\xae\xe3\x36\x0c\x5d\x67\xbe\x82\x70\xbb\x4d\x82\x16\xb3\xe8\x22\x09\x50\xcc\x03\x53\x60\xda\xc5\xcc\x9d\x75\xc0\x58\x4c\x2c\x44\x96\x5c\x89\xce\xed\x85\x61\xa0\x1f\xd1\x2f\xec\x97\x14\x7a\xd8\x91\x13\xa7\xbd\xe8\x26\x56\x2c\x9a\x3c\x24\x8f\x8e\xd8\x75\x4c\x75\xa3\x90\x09\x8a\x03\x3a\x5a\x57\x84\xa2\x80\x55\xdf\xbf\xd9\x08\x79\x81\x52\xa1\x73\xdb\xa2\x75\x64\xc1\x11\xb3\xd4\x27\x07\xd8\x34\x4a\x96\xc8\xd2\x68\x57\xec\xde\x2c\x26\x96\x12\x4a\xa3\x19\xa5\x26\xeb\xf7\x6e\x37\x4f\x56\x8a\xf0\x7e\x91\x87\xf6\xfe\xd7\x83\xff\xb5\xc6\xcb\x01\x6d\x44\xb1\x98\x7a\xe0\x67\x52\x17\x82\x67\x29\x08\x4a\xa3\xda\x5a\x87\x70\xa4\x39\x3a\x5d\xdc\x25\x84\x8a\x2c\x8f\xbe\x16\x9b\xea\x6d\x86\x86\x4d\x03\xc8\x8c\x65\x45\x02\x7c\xea\x09\x74\x70\xb4\x92\x3f\xfc\xa4\x57\x4f\x16\x8a\x01\xd9\xaa\x46\x8d\x27\xda\x63\x59\x92\x73\x7b\x36\x67\xd2\x45\x72\x7c\x9b\xa8\x95\xa7\x6a\x00\x75\xb7\x79\x50\x2d\x01\x4b\xfd\x02\xae\x32\xcf\xcb\x06\x35\x29\x38\xb4\xcc\x46\x17\x20\x90\x31\xbe\xda\x16\xdf\xa1\x10\xcb\x18\x6e\x19\xc2\xc5\x8d\x62\x37\x0b\xef\x44\x9a\x2c\x32\xed\x35\x3d\x8f\xe8\x36\x6b\x21\x2f\x09\x47\xb6\xde\xac\xab\xb7\x69\x35\xc5\x36\xd6\xc3\xd1\xa9\xbe\x16\xf6\xd6\xec\x4c\x2f\xa0\xa4\x9b\x4f\x51\x32\xd5\xe3\xc6\x7c\x29\x03\x3c\xb7\x17\xe4\xca\xb1\x84\x39\x3e\xff\x99\x45\x7d\x22\x58\x3d\x05\xd3\xd1\xe8\x2e\x12\x4c\x78\x75\x67\x62\xf4\x84\x30\x99\xd5\x62\x23\x07\x23\xe7\xaa\xe5\x99\x5e\x96\x8e\x91\x69\x29\xb5\xf0\x04\x37\x16\x8e\x08\x47\x5c\x96\xd2\x96\x8a\xba\x4e\x1e\x61\xf5\x09\xdd\x17\x2a\x49\xf3\xcf\x25\xcb\x8b\xe4\x97\xbe\x07\xf4\x4b\x02\xa9\x2f\x64\x19\x1a\xd3\x48\x7d\x82\xb6\xe9\x3a\x52\x8e\xfa\x7e\x69\xba\x8e\xb4\xe8\xfb\x02\x1e\xfa\x08\x5d\x4f\x5c\xde\x16\x5d\xf7\xfd\xa3\x9a\xed\x03\xc4\xa1\x70\x89\x2e\x17\xb4\x32\x1c\xc8\x6d\x11\x41\x90\x08\xfc\x2a\x52\xe0\xdd\x66\x2d\xb3\xea\xe4\x65\xfe\x1f\xc5\x8a\x45\x71\xa4\x85\x7f\xfe\xf8\x07\x28\x3a\x72\xf1\xea\x18\x4c\xfa\x61\x0c\xc7\xd6\xe8\x93\xa7\xf7\x6f\x58\x93\x67\x6f\x7a\x93\xd9\x64\xae\x30\xd5\x0f\x6a\x62\xcc\x1d\x2d\x36\x72\x37\x5f\x44\x14\x62\x6f\xfc\xc1\x80\x8d\x6b\x50\xef\xba\xee\x3d\x32\x7d\xac\xf9\x6b\x65\x2c\xc3\xea\x9d\x25\x64\x12\x21\xb4\xdf\x87\xbf\xff\xfc\x0b\xe0\x9a\xbc\x29\x59\x96\x46\x43\x7a\x2e\xa5\x3e\x9a\x98\xfb\xb5\xb9\xdf\x9c\x77\x30\x1f\x5f\xa1\xe3\x7d\xeb\x48\x3c\x86\xf0\xad\x11\x13\x08\x03\x8f\xe6\x1d\x6a\xb3\x1f\xca\x50\x78\x9b\xd0\xf0\x49\x2f\xee\x9a\xf1\xb8\x37\x41\xb6\xe0\xa8\x8c\x07\x30\x48\x52\xee\x29\xbe\xca\x85\x2e\x51\x0d\x0e\xe8\x64\x99\x3e\x01\x41\x8a\x98\x96\x13\x4d\x6b\xad\x8a\xcc\xfe\x2c\xf5\xb9\xef\xd7\xd1\x26\x6d\x4a\xe1\xf7\x56\xbf\xbc\xef\xfb\x49\x1f\xe7\x73\x8e\x9f\xde\x28\x70\x4c\x2d\x86\x7c\x94\xec\x8d\xc2\x84\x5a\xcd\x68\xe3\x75\x79\xb0\x99\x4a\x86\x06\x6b\xc3\xa1\xc9\x1f\xac\x35\xb6\xef\x53\x25\x2a\x29\x68\x38\x6d\xe0\x93\x79\xa4\xdc\x29\xda\x6b\xef\xa1\x79\xf5\x9c\x55\xfa\x21\x8f\x41\xd8\x5f\xab\xec\x8b\xcd\xd1\xd8\x3a\x33\xf4\x7f\x8b\x20\x6a\x5e\x50\xba\x2e\x35\xac\xf0\xa7\xac\x32\x62\x5b\x34\x26\x13\x7e\x8f\xf0\xdd\xd7\x2f\x1f\x83\x4c\x7f\x7a\xfa\xf5\x73\xa6\xd4\xcd\xfc\x45\x35\xa2\x1e\x64\x6c\xb3\x6e\x76\x73\xf2\x7e\x94\xa4\x44\x3a\x57\x1f\xac\xdd\x47\x51\x20\x5f\xf9\x41\x52\xb3\x4e\x2b\x3c\x90\xf2\xe8\xb7\x85\xc6\x9a\x1e\xdc\x92\x31\x70\x30\xf0\x81\xc3\x47\x99\x13\xa9\x9b\x96\x43\x07\x83\x09\xf8\xdf\x61\x7d\x41\xd5\x52\xa8\x88\x0e\x40\x0a\xc0\x96\xcd\xd1\x94\xad\x03\x4b\xbf\xb7\xd2\x92\x98\xa7\xda\xcc\xc1\x39\x59\x22\x7d\x7f\xc4\xfe\xbd\xdf\xb7\x8c\xbf\xe5\xfb\x66\xed\x9b\x37\x77\xdd\x0f\xcb\x71\x35\x2c\xd2\x33\x3d\xde\xdc\x90\xc6\xd5\xa8\x54\x3a\xdb\xf1\xd4\x41\x6d\x04\xaa\x99\xc1\x2f\xc8\x62\xc6\xde\xab\x66\xb2\x45\x57\x85\xfd\xf1\x96\x98\x4d\x33\x1f\xac\xf6\x21\x9a\x34\x31\xdb\x11\x6c\x16\x32\x9f\xfc\x1e\x31\x6d\xd6\xe3\x94\x75\x73\xae\x23\xf7\xdd\xdc\x04\xeb\xf5\x2e\x96\x63\xbc\x69\x4b\xd4\xe5\x75\x80\x8b\x55\x1e\x73\xb7\x54\x1b\x3f\x1b\xe4\xc9\x4f\xb2\x0f\xe5\x5c\x69\x13\xbb\x7a\x6d\xcf\xed\xe0\x1c\xd8\x32\x0d\x6c\xce\x0f\x82\x96\x15\x95\xe7\x1a\xed\xf9\x3f\xe3\xbe\x90\x9b\x06\x9e\xd2\xe1\x6e\x9e\x3e\x1a\xc3\x94\x86\xf3\x7f\x02\x00\x00\xff\xff\x5d\x25\xe7\xba\x3e\x0c\x00\x00"
+var _userSettingsApplicationsTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x57\xcd\x6e\xe3\x36\x10\x3e\xcb\x4f\x31\x50\x7b\xb5\x8d\x16\x7b\xe8\xc1\x36\x50\xec\x0f\xb6\x40\xda\xc3\x26\x39\x0b\x63\x71\x24\x11\xa6\x48\x95\xa4\x9c\x0d\x54\x01\x7d\x88\x3e\x61\x9f\xa4\x20\xf5\x63\xca\x96\x36\x49\xb1\x17\x8b\x16\xa9\x99\x6f\xbe\xf9\x66\x48\x36\x8d\xa5\xb2\x12\x68\x09\xe2\x23\x1a\xda\x16\x84\x2c\x86\x4d\xdb\xae\x76\x8c\x9f\x21\x15\x68\xcc\x3e\xae\x0d\x69\x30\x64\x2d\x97\xb9\x01\xac\x2a\xc1\x53\xb4\x5c\x49\x13\x1f\x56\xd1\x64\x25\x87\x54\x49\x8b\x5c\x92\x76\x73\xd7\x93\xb9\xe6\xcc\xbf\x8f\x42\xd7\xce\xfe\x76\xb0\xbf\x95\x78\x3e\xa2\xee\x50\x44\x53\x0b\xf6\x89\xc4\x99\xe0\x89\x33\x82\x54\x89\xba\x94\xde\x1d\x49\xdb\x19\x8d\x6e\x02\x42\x41\xda\x8e\xb6\xa2\x5d\xf1\x2e\x40\x63\x55\x05\x68\x2d\xa6\x05\x31\x70\xa1\xf7\xa0\xbd\xa1\x0d\xff\xe9\x17\xb9\x79\xd0\x10\x0f\xc8\x36\x25\x4a\xcc\x29\xc1\x34\x25\x63\x12\xab\x4e\x24\xe3\xde\xf0\x75\xa0\x9a\xe7\xc5\x00\xea\x66\xf2\x28\x6a\x02\xcb\xe5\x33\x98\x42\x3d\xad\x2b\x94\x24\xe0\x58\x5b\xab\x64\x0c\x0c\x2d\x76\xaf\xf6\xf1\x0f\xc8\xd8\xba\x73\xb7\xf6\xee\xba\x89\xf8\x30\x0b\x2f\x27\x49\x1a\x2d\x25\x92\x9e\x46\x74\xbb\x2d\xe3\xe7\x1e\x47\x30\xde\x6d\x8b\x77\x87\xd5\x0c\xb6\x91\x0f\x43\x79\x79\x21\xf6\x7a\xd9\x89\x9e\x41\x70\x33\x1f\x22\xb7\x54\x8e\x13\xf3\x54\x7a\x78\x26\x61\x64\xd2\x91\xc2\x10\x9f\xfb\x4c\xa3\xcc\x09\x36\x0f\x7e\xe9\xb8\xe8\xc6\x13\x4c\x74\x75\xb3\x44\xc9\x89\x60\x82\x55\xd1\x8e\x0f\x8b\x8c\x29\xd6\x27\x7a\x5e\x1b\x8b\x96\xd6\x5c\x32\x27\x70\xa5\x21\x43\xc8\x70\x9d\x72\x9d\x0a\x6a\x1a\x9e\xc1\xe6\x33\x9a\x2f\x94\x92\xb4\xbf\xa6\x96\x9f\xb9\x7d\x6e\x5b\x40\x37\x24\xe0\xf2\x4c\xda\x42\xa5\x2a\x2e\x73\xa8\xab\xa6\x21\x61\xa8\x6d\xd7\xaa\x69\x48\xb2\xb6\x8d\x61\xd1\x86\xcf\x7a\xaf\xe5\x7d\xdc\x34\x3f\x2e\x71\x96\x78\x88\x03\x71\xbd\x5c\xce\xa8\xb9\x2f\xc8\x7d\xdc\x81\x20\xe6\xf5\x15\xf7\x8e\x0f\xbb\x2d\x0f\xd8\x09\x69\xfe\x1f\x64\x75\xa4\x18\x92\xcc\x3d\x7f\xfe\x0a\x82\x32\x1b\xbf\xda\x87\x25\xb9\xe8\xc3\x58\xad\x64\xee\xe4\xfd\x07\x96\xe4\xd4\xdb\xbf\x59\x45\x73\xa6\xb0\xe7\x0f\x4a\xb2\x18\x1a\x8a\x76\xfc\x30\x4f\x22\x32\x96\x28\x57\x18\xb0\x33\x15\xca\x43\xd3\x7c\x40\x4b\x9f\x4a\x7b\x5f\x28\x6d\x61\xf3\x5e\x13\x5a\x62\xde\xb5\x9b\x87\x7f\xff\xfe\x07\xe0\x12\xbc\x4a\x2d\x4f\x95\x84\xfe\xb9\xe6\x32\x53\x5d\xec\x97\xe4\x3e\x1a\x67\x60\xde\xbf\x40\x63\x93\xda\x10\x5b\x86\xf0\x58\xb1\x09\x84\x41\x47\xf3\x06\xa5\x4a\x06\x1a\xe2\xb6\xed\x13\x3e\xc9\xc5\x4d\x32\x96\x73\xe3\xdb\x16\x64\x42\x39\x00\x43\x4b\x0a\x2d\x75\xaf\xc2\x46\xd7\x4b\x0d\x8e\x68\x78\xda\x7f\x02\x8c\x04\x59\x5a\x4f\x7a\x5a\xad\x45\xa7\xec\x3b\x2e\x4f\x6d\xbb\xed\xd6\xf4\x93\x9c\xb9\xb9\xcd\x6f\x1f\xda\x76\x92\xc7\xf9\x98\xbb\x4f\xaf\x3a\x70\x17\x5a\xe7\x72\x29\xd8\xab\x0e\xe3\xb9\x9a\xe9\x8d\x97\xe1\x51\x1f\xbe\xc3\xc6\x91\x11\xb1\x29\xda\xb7\xf7\xdf\xea\xf0\x82\xe9\xae\x27\xc0\x5f\x70\x8f\x99\x2f\x9d\x6a\x84\xe4\x74\x79\xa7\xf2\x9c\xd8\xa3\x21\xbd\xf9\x44\xc4\x7c\x57\xfd\x8c\xa6\xb8\x74\xdf\x4c\xe9\x32\x40\xe2\xfe\xc6\xbe\xb7\xb9\xbe\xd2\x34\x43\xde\x2e\x1e\xc7\x14\x96\x64\x0b\xc5\xf6\x71\xa5\x82\x1d\xc1\x51\xf1\xfe\xfe\xcb\xa7\xce\xd3\xc3\xef\x77\x41\x0b\x7f\x39\x18\xfa\xca\x8d\x35\x71\x18\xc7\x92\xfc\x42\xe5\x2d\x6c\x8d\x9a\xce\xea\x44\xc9\x24\x0f\xd7\x5a\xd9\x6d\x5d\xc4\xe3\xbf\x21\xf3\xd1\x50\x7e\xab\x11\xfb\x8e\xcf\x7b\x09\xe0\x4b\x25\x29\xee\xea\x30\x4c\x44\x28\xb8\x37\xd3\xbd\xc0\xf3\x22\xcd\xb7\x6c\xe5\x9a\x48\xbe\xc4\xd4\x78\x8c\xf8\x16\x5b\x21\x59\xb3\xf5\xe2\x54\xed\x85\x27\x95\xf5\x4d\xf1\xa3\xd6\x4a\xb7\x6d\x0f\xa6\xe0\x8c\x86\xdd\x09\x5c\xf1\x2f\x9d\x74\x56\xd1\x9b\xca\x2f\x7a\xfd\xc9\x68\x15\x4d\x0b\xf1\xb5\x95\xf8\xda\xcc\x7d\xbf\xb2\x18\x51\x0f\xdb\xfe\xa4\x26\x02\xd4\x19\x27\xc1\xfa\x7d\xe8\xa3\xd6\x49\xb7\x89\x92\x63\x7e\x38\x82\x04\x9d\x51\xe0\x91\x84\x43\xbf\x8f\x25\x96\xb4\x20\x88\x5e\xcf\x6e\x81\x73\xec\x3f\x0a\x8c\x70\x59\xd5\xd6\x67\xd0\x2f\x01\xf7\x3b\x8c\xcf\x28\x6a\xf2\x8c\x48\x0f\x24\x06\xac\xad\xca\x54\x5a\x1b\xd0\xf4\x67\xcd\x35\xb1\xf9\xd6\xfc\xa2\x76\x57\x51\xf4\x9a\x7c\x5f\xef\x10\xdf\xac\xf9\xd9\x2d\x60\x1c\x0d\x83\xfe\xd9\x3f\x56\x57\xa2\x31\x25\x0a\xd1\x77\xa4\xae\x3b\x42\xa9\x18\x8a\x99\x8b\x92\x3f\x46\x04\xea\xbd\x9c\x31\xac\x46\x53\xf8\xf9\xf1\x54\x35\x1b\x66\x78\x11\x49\xbc\x37\xae\xba\x68\x47\xb0\x81\xcb\xf0\xa6\xb4\xa4\xb4\x59\x8b\x53\xd5\xcd\x99\xc6\x74\xbc\x0d\xde\x5c\x84\xc6\x06\x3d\x9e\x4c\x53\x94\xe9\xe5\xc2\xd3\xb1\x3c\xc6\xae\xa9\x54\x67\x9a\x06\x3f\x89\xde\xd3\xb9\x91\xaa\xcb\xea\x25\x3d\xd7\x17\x4d\xaf\x96\xa9\x63\x75\x5a\x70\x9a\x16\x94\x9e\x4a\xd4\xa7\x17\xfd\x3e\x93\x99\x3a\x9e\xca\xe1\xe6\xfe\x99\x29\x65\xa9\xbf\xcc\xfe\x37\x00\x69\x99\x02\x72\x6e\x0f\x00\x00"
 
 func userSettingsApplicationsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2932,8 +3856,8 @@ func userSettingsApplicationsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/settings/applications.tmpl", size: 3134, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf0, 0x47, 0xdd, 0xaa, 0x61, 0xff, 0x81, 0x46, 0xa6, 0x6c, 0xc3, 0x6a, 0x62, 0x4d, 0xc7, 0xde, 0x39, 0x1a, 0xe3, 0x34, 0xf1, 0x8d, 0xb2, 0x57, 0x50, 0x44, 0x45, 0x5a, 0xfe, 0x3e, 0xbc, 0x39}}
+	info := bindataFileInfo{name: "user/settings/applications.tmpl", size: 3950, mode: os.FileMode(0644), modTime: time.Unix(1792289397, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5b, 0xf3, 0xfd, 0xc6, 0x23, 0xd4, 0x9a, 0x51, 0x8, 0x80, 0x30, 0xd4, 0x1d, 0xe3, 0x56, 0x92, 0x63, 0x54, 0xc8, 0xfc, 0x94, 0xaa, 0x80, 0xaf, 0x1c, 0xae, 0x33, 0x74, 0xb1, 0x69, 0xbe, 0xc}}
 	return a, nil
 }
 
@@ -2977,7 +3901,7 @@ func userSettingsDeleteTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _userSettingsEmailTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x56\xc1\x6e\x84\x36\x10\x3d\x93\xaf\xb0\xac\x5e\xb3\xa8\x52\x0e\x3d\xb0\x2b\x45\x6d\xaa\x46\x4a\xa4\x28\xcd\xa5\xa7\x95\x17\xcf\x2e\x56\x8c\x4d\xed\x61\xa3\x08\xf1\xef\x95\xb1\x01\x1b\xd8\xf4\xb4\xc0\x8c\xdf\xbc\xf1\x7b\x1e\x6f\xd7\x21\xd4\x8d\x64\x08\x84\x9e\x98\x85\xbc\x02\xc6\x29\xd9\xf5\xfd\x5d\xc1\xc5\x95\x94\x92\x59\xbb\xa7\xad\x05\x43\x2c\x20\x0a\x75\xb1\x04\x6a\x26\xa4\xa5\x87\xbb\x2c\xc9\x11\xa4\xd4\x0a\x99\x50\x60\x5c\x6c\x19\xbc\x18\xc1\x87\xef\x59\x5c\xd4\x21\xe7\x23\x72\xae\xd8\xf5\xc4\x8c\xaf\x9f\xa5\x08\xf8\x05\xf2\x0a\xe4\x4b\x70\x20\xa5\x96\x6d\xad\x86\x72\xa0\xd0\x83\x66\xab\x56\x98\x04\x83\x13\x56\x56\x54\x0f\x11\x1b\xd4\x0d\x61\x88\xac\xac\x80\x13\xd7\x74\x20\x3d\x00\xed\xc4\xaf\xbf\xa9\xdd\x87\x21\x74\x64\xb6\xab\x99\x62\x17\x38\x86\xd6\x47\xc8\xbc\x7a\xf0\x8b\x16\xbd\x4e\xc8\x16\x2e\xf5\x4c\x71\x99\x36\xa0\x11\x29\xec\x94\x90\x64\x08\x84\x7a\x0a\x6c\xd3\x1a\x10\x8e\x1c\x6c\x39\x72\x72\xac\xb8\xb8\x8e\xcb\xba\xce\x30\x75\x01\xb2\x7b\x1a\x98\x4f\x49\xab\x42\x24\x91\x68\x95\xe2\xb7\x3c\x0a\x66\x85\x45\xa3\xd5\xe5\xd0\x75\x1e\xbb\xef\x8b\x3c\x7c\x9a\x93\xba\x4e\x9c\xc9\xee\xd9\xbe\x19\x51\x33\xf3\xdd\xf7\x85\x6d\x98\x4a\x5c\x01\xa0\x08\x0a\xf5\x4d\x1a\x9f\x43\x24\x3b\x81\xa4\x87\xae\xfb\x65\xa3\xe1\x90\x44\x87\x6a\x0d\x53\x87\xae\x03\xc5\xe7\xb6\x42\x49\xa5\x31\x29\x3b\x87\x97\x1a\x18\x71\xa9\x30\xee\x2b\xcb\x8a\x53\x8b\xa8\x63\x96\x06\xb8\xe7\x78\x62\x56\x94\x24\xc4\x39\x48\x40\xb8\xf7\x6f\x94\x70\x86\xec\xbe\x35\x72\x4f\x1d\xf5\x17\xa1\x3e\xfb\x3e\xf7\x39\x21\x28\xb8\x8b\xed\x9e\xff\xe8\xfb\xb4\x62\xb6\xdd\xac\x5f\xec\x4d\x47\x93\x26\xb2\x22\xf7\x65\x63\x98\x44\xf8\x78\xf7\x1f\x4b\x14\x57\x86\xc0\x17\x18\xd1\x4e\x0c\xdb\x40\xce\x52\xbb\xb4\x05\xb9\xe2\xac\x4d\x4d\x58\x89\x42\xab\xb8\x39\x4a\x6a\xc0\x4a\xf3\x3d\x6d\xb4\x5d\xec\xa1\x6f\xe9\xf7\xbf\xdf\xff\xfc\xd0\x9f\xa0\xfe\xfa\x78\x7d\x49\x8b\x67\x59\x21\x54\xd3\x22\x51\xac\x86\x3d\x3d\x7a\x28\x4a\xf0\xbb\x81\x3d\xad\x04\xe7\xa0\x28\xb9\x32\xd9\xc2\x9e\xbe\xbd\x3f\xbf\x3e\xbe\xff\xb3\x2c\x91\x20\x88\x5b\x8b\xb7\x77\x7c\x43\xe5\xc8\x8b\xb1\xce\xff\x63\xc5\x49\x9e\x2d\x49\x9c\x28\x6e\xf7\x52\x7f\xad\x75\x5a\x79\x38\xfd\x90\xae\x58\x1c\xf0\x28\x35\x8a\xc4\x8f\x37\x86\xd3\x49\x23\xea\x7a\x35\xa3\x06\xb5\xe7\x74\xf7\x4a\x23\xf5\x7f\x16\xbf\xeb\x6e\x88\x9e\x98\x0d\xfe\x6d\x85\x3b\x53\x67\x01\x92\x13\x6f\xd3\x27\x63\x8e\x61\x90\x80\x31\xda\x84\xc6\x66\xd9\x8a\x61\x30\x38\x3e\x7b\xea\xf7\xfc\xb0\x39\x13\x19\xe7\x47\x05\x5f\x91\x2e\xc3\xc2\x19\xc7\xbb\xc6\x1d\x46\x9f\x12\x0c\x14\x5e\xbc\x87\xc2\x0b\x6b\x51\x9f\x75\xd9\x5a\x32\x72\x3e\x6c\x4d\xd9\x5b\x5e\x1a\x0d\xf4\xe3\x0c\x77\x7c\x17\x47\x7c\x61\xa5\xd8\x43\x73\xdd\xe9\x69\x7c\x08\xbf\xe1\xe7\x6e\x21\xbb\xad\x99\x94\xc1\xd8\x7e\xb0\x90\x5a\x73\x26\x37\xee\x71\x51\x6a\x15\x5f\x8a\x85\x98\x6e\x61\xc3\x6c\x35\xc4\xe9\xa1\xc8\x85\x0b\xfe\x78\x2f\x49\x70\xb6\x19\x1a\x9b\x58\x26\xb7\xca\x7c\x83\x17\xcd\xb6\x9c\x29\xd4\x78\xd7\x15\x79\x33\x77\xbc\xbe\xfb\xc3\xe4\x1c\x1a\x3c\x7a\xef\xda\xf0\xb7\xc6\x2f\x59\xad\x38\x6b\x8d\x10\xfe\x7a\xfc\x17\x00\x00\xff\xff\xed\xa0\x2b\xd6\x16\x09\x00\x00"
+var _userSettingsEmailTmpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x56\xcd\x6e\xe3\x36\x10\x3e\xcb\x4f\x41\x10\xbd\xae\x85\x02\x7b\xe8\x41\x16\xb0\x68\xb7\x68\x80\x04\x5d\xa4\xe9\xa1\x27\x83\x16\xc7\xd2\x20\x14\xa9\x92\x94\x53\x43\xd0\xbb\x17\x12\x29\x99\x94\xe5\xd4\xe8\xe6\xa4\x9f\xf9\x9f\xef\xe3\x70\xba\xce\x42\xdd\x08\x66\x81\xd0\x03\x33\x90\x56\xc0\x38\x25\xdb\xbe\xdf\x64\x1c\x4f\xa4\x10\xcc\x98\x1d\x6d\x0d\x68\x62\xc0\x5a\x94\xa5\x21\x50\x33\x14\x86\xe6\x9b\x24\xd2\x41\x52\x28\x69\x19\x4a\xd0\x83\x6c\x29\x2c\x35\xf2\xf1\x7f\x12\x06\x1d\x3c\xa7\x93\xe7\x54\xb2\xd3\x81\x69\x17\x3f\x89\x3d\xd8\x37\x10\x27\x20\x6f\xc8\x81\x14\x4a\xb4\xb5\x1c\xc3\x81\xb4\xce\x69\x72\x55\x0a\x13\xa0\xed\xec\x2b\xc9\xaa\xcf\x41\x36\x56\x35\x84\x59\xcb\x8a\x0a\x38\x19\x8a\xf6\x49\x8f\x8e\xb6\xf8\xe3\x4f\x72\xfb\xa2\x09\x9d\x32\xdb\xd6\x4c\xb2\x12\xf6\xbe\xf4\xc9\x65\x5a\x7d\x76\x46\x8b\x5a\x67\xcf\x06\xca\xfa\x92\xe2\x52\x6d\xf4\x46\x04\x9a\x59\x21\xd2\x40\x0b\xf5\x2c\x58\x4f\x6b\xf4\xb0\xe7\x60\x8a\x29\xa7\x21\x2b\x8e\xa7\xc9\xac\xeb\x34\x93\x25\x90\xed\xd7\x31\xf3\x59\xe9\x2a\x10\x89\x20\xba\x52\x71\x2d\x0f\x84\x49\x66\xac\x56\xb2\xcc\xbb\xce\xf9\xee\xfb\x2c\xf5\xbf\x2e\x4a\x5d\x87\x47\xb2\x7d\x30\xdf\x34\xd6\x4c\x9f\xfb\x3e\x33\x0d\x93\x11\x2b\x00\x24\xb1\x28\xcf\xa4\x71\x3a\x44\xb0\x03\x08\x9a\x77\xdd\x0f\x2b\x05\x7b\x25\x3a\x46\x6b\x98\xcc\xbb\x0e\x24\xef\xfb\x45\x48\xa9\x6c\x14\xf6\x22\x5e\x62\xa0\xb1\xac\x6c\x58\x57\x92\x64\x87\xd6\x5a\x15\x66\xa9\x81\xbb\x1c\x0f\xcc\x60\x41\xbc\x9c\x83\x00\x0b\x9f\xdc\x17\x25\x9c\x59\xf6\xa9\xd5\x62\x47\x87\xd4\x1f\x51\xbe\xf6\x7d\xea\x74\xbc\x10\xf9\x20\xdb\x3e\xfc\xd2\xf7\x71\xc4\x64\xbd\x58\x67\xec\x48\x47\xa3\x22\x92\x2c\x75\x61\x43\x37\x11\xf0\x61\xf7\xbf\x14\x16\x4f\xcc\x02\x5f\xf8\x08\x3a\x31\xb6\x81\x1c\x85\x1a\xd4\x16\xc9\x65\x47\xa5\x6b\xc2\x0a\x8b\x4a\x86\xc5\x51\x52\x83\xad\x14\xdf\xd1\x46\x99\x45\x0f\x5d\x49\x3f\xff\xf1\xfc\xeb\x8b\x7a\x05\xf9\xdb\xcb\xd3\x63\x1c\x3c\x49\x32\x94\x4d\x6b\x89\x64\x35\xec\xe8\xde\xb9\xa2\xc4\x9e\x1b\xd8\xd1\x0a\x39\x07\x49\xc9\x89\x89\x16\x76\xf4\xdb\xf3\xc3\xd3\x97\xe7\xbf\x68\xfe\x8e\x07\xbc\x65\xbc\xde\xf1\x15\x94\x03\x2e\x86\x38\xff\x07\x15\x67\x78\xd6\x20\x19\x40\x19\xba\x97\x6f\x92\x77\x71\xba\xe2\x70\xfc\x23\xb6\x58\x1c\xf0\x40\x35\x90\x84\xaf\x37\x86\xd3\x41\x59\xab\xea\xab\x19\x35\xa2\x7d\x51\x1f\x3e\x69\x80\xfe\xfb\xe0\x77\xdd\x0d\xd0\x23\xb2\xc1\xdf\x2d\x0e\x67\xea\x88\x20\x38\x71\x34\xfd\xaa\xf5\xde\x0f\x12\xd0\x5a\x69\x5f\xd8\x05\xb6\x6c\x1c\x0c\x43\x3e\x3b\xea\x7a\x9e\xaf\xce\x44\xc6\xf9\x5e\xc2\x5b\x80\xcb\x68\x98\x6f\x62\xd6\x0c\x87\xd1\xa9\x78\x02\xf9\x0f\xc7\x21\xff\xc1\x5a\xab\x8e\xaa\x68\x0d\x99\x72\xce\xd7\xa6\xec\x2d\x2e\x4d\x04\x7a\x77\x86\x0f\xf9\x2e\x8e\xf8\x82\x4a\x21\x87\x7c\xdc\x0f\xb8\xd2\xdc\xdd\x21\x95\xc5\x23\x16\x6c\x80\xf7\xff\xdf\x6b\xf7\x71\x26\x8d\x82\x7d\x07\x81\x50\x0a\x94\xe0\xe8\x13\x10\x64\xb1\x8d\x54\x50\xbc\x1e\xd4\x3f\xe1\x8d\x16\x0e\x0c\x81\xf2\xd5\xec\x95\x14\xe7\x09\xf4\xd9\x62\x1a\x1d\x56\xb7\x40\x3d\x3f\x1f\x55\x59\x02\xff\xd3\x80\xde\x3e\x31\x14\x43\x45\xe6\x77\x29\xce\x7d\x3f\x9a\x01\xf7\x84\x0d\xa2\x39\xde\xdd\xd9\xfc\x7d\x90\xcf\x0a\x69\x23\xba\x7d\x18\xf7\xda\x86\xb3\xe9\x86\x59\xa5\xc2\x3d\x5c\x0c\xdf\xa6\x17\xff\xf4\x8f\xcd\x02\x1a\x53\x33\x21\xfc\x90\x75\x97\x1c\xa9\x15\x67\x62\x65\xa7\xc4\x42\xc9\x90\xcd\x19\x4e\x42\xab\x99\xa9\x46\x39\xcd\xb3\x14\xf3\xcd\x8d\x1a\xa7\x1d\x49\xc0\x5c\xd8\x9c\x65\xb4\xe1\x5c\xb6\xc9\xac\xc9\xef\x70\x35\xed\x5d\x59\xda\x5c\x2a\xbe\xde\x43\xfd\x2d\x3e\x16\xb8\x77\x67\xc2\xf8\x15\xdb\x99\x5c\x59\x1c\x95\xb2\xe0\xd7\xe0\x7f\x07\x00\xf9\x23\xd3\x25\xa2\x0b\x00\x00"
 
 func userSettingsEmailTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -2992,8 +3916,8 @@ func userSettingsEmailTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "user/settings/email.tmpl", size: 2326, mode: os.FileMode(0644), modTime: time.Unix(1571173927, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe7, 0x95, 0x7a, 0x56, 0x1, 0x2e, 0x2c, 0x90, 0xd, 0xd3, 0x40, 0xb8, 0xaf, 0xb0, 0x12, 0x1e, 0x2a, 0x4f, 0xa0, 0xae, 0x2c, 0x90, 0x75, 0xdd, 0xd6, 0xc1, 0xf9, 0x93, 0x89, 0xca, 0x20, 0x44}}
+	info := bindataFileInfo{name: "user/settings/email.tmpl", size: 2978, mode: os.FileMode(0644), modTime: time.Unix(1792285439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x66, 0x3a, 0x3b, 0x4f, 0x3a, 0x80, 0xae, 0x53, 0x14, 0xb9, 0xc1, 0xa0, 0x9f, 0x65, 0xf1, 0x50, 0xc4, 0x1b, 0x9a, 0xd2, 0x76, 0xe, 0xa1, 0x69, 0x99, 0x58, 0xb0, 0xb4, 0x89, 0x13, 0x1a, 0xcd}}
 	return a, nil
 }
 
@@ -3273,13 +4197,17 @@ var _bindata = map[string]func() (*asset, error){
 	"admin/auth/new.tmpl":                          adminAuthNewTmpl,
 	"admin/base/page.tmpl":                         adminBasePageTmpl,
 	"admin/base/search.tmpl":                       adminBaseSearchTmpl,
+	"admin/bot/list.tmpl":                          adminBotListTmpl,
 	"admin/config.tmpl":                            adminConfigTmpl,
 	"admin/dashboard.tmpl":                         adminDashboardTmpl,
+	"admin/lifecycle.tmpl":                         adminLifecycleTmpl,
+	"admin/maintenance.tmpl":                       adminMaintenanceTmpl,
 	"admin/monitor.tmpl":                           adminMonitorTmpl,
 	"admin/navbar.tmpl":                            adminNavbarTmpl,
 	"admin/notice.tmpl":                            adminNoticeTmpl,
 	"admin/org/list.tmpl":                          adminOrgListTmpl,
 	"admin/repo/list.tmpl":                         adminRepoListTmpl,
+	"admin/site_content.tmpl":                      adminSite_contentTmpl,
 	"admin/user/edit.tmpl":                         adminUserEditTmpl,
 	"admin/user/list.tmpl":                         adminUserListTmpl,
 	"admin/user/new.tmpl":                          adminUserNewTmpl,
@@ -3303,16 +4231,37 @@ var _bindata = map[string]func() (*asset, error){
 	"mail/auth/register_notify.tmpl":               mailAuthRegister_notifyTmpl,
 	"mail/auth/reset_passwd.tmpl":                  mailAuthReset_passwdTmpl,
 	"mail/issue/comment.tmpl":                      mailIssueCommentTmpl,
+	"mail/issue/comment.txt":                       mailIssueCommentTxt,
 	"mail/issue/mention.tmpl":                      mailIssueMentionTmpl,
+	"mail/issue/mention.txt":                       mailIssueMentionTxt,
+	"mail/issue/merge.tmpl":                        mailIssueMergeTmpl,
+	"mail/issue/merge.txt":                         mailIssueMergeTxt,
+	"mail/issue/review_reminder.tmpl":              mailIssueReview_reminderTmpl,
+	"mail/issue/review_reminder.txt":               mailIssueReview_reminderTxt,
 	"mail/notify/collaborator.tmpl":                mailNotifyCollaboratorTmpl,
+	"mail/notify/commit_mention.tmpl":              mailNotifyCommit_mentionTmpl,
+	"mail/notify/deactivated_user.tmpl":            mailNotifyDeactivated_userTmpl,
+	"mail/notify/inactive_repo.tmpl":               mailNotifyInactive_repoTmpl,
+	"mail/notify/inactive_user.tmpl":               mailNotifyInactive_userTmpl,
+	"mail/notify/path_watch.tmpl":                  mailNotifyPath_watchTmpl,
+	"mail/notify/repo_invite.tmpl":                 mailNotifyRepo_inviteTmpl,
+	"mail/notify/visibility_failed.tmpl":           mailNotifyVisibility_failedTmpl,
 	"org/create.tmpl":                              orgCreateTmpl,
+	"org/dependencies.tmpl":                        orgDependenciesTmpl,
 	"org/header.tmpl":                              orgHeaderTmpl,
 	"org/home.tmpl":                                orgHomeTmpl,
 	"org/member/invite.tmpl":                       orgMemberInviteTmpl,
 	"org/member/members.tmpl":                      orgMemberMembersTmpl,
+	"org/settings/bot.tmpl":                        orgSettingsBotTmpl,
+	"org/settings/bots.tmpl":                       orgSettingsBotsTmpl,
 	"org/settings/delete.tmpl":                     orgSettingsDeleteTmpl,
 	"org/settings/navbar.tmpl":                     orgSettingsNavbarTmpl,
 	"org/settings/options.tmpl":                    orgSettingsOptionsTmpl,
+	"org/settings/reminders.tmpl":                  orgSettingsRemindersTmpl,
+	"org/settings/repo_policy.tmpl":                orgSettingsRepo_policyTmpl,
+	"org/settings/rule_set_edit.tmpl":              orgSettingsRule_set_editTmpl,
+	"org/settings/rule_sets.tmpl":                  orgSettingsRule_setsTmpl,
+	"org/settings/secrets.tmpl":                    orgSettingsSecretsTmpl,
 	"org/settings/webhook_new.tmpl":                orgSettingsWebhook_newTmpl,
 	"org/settings/webhooks.tmpl":                   orgSettingsWebhooksTmpl,
 	"org/team/members.tmpl":                        orgTeamMembersTmpl,
@@ -3328,6 +4277,7 @@ var _bindata = map[string]func() (*asset, error){
 	"repo/commits.tmpl":                            repoCommitsTmpl,
 	"repo/commits_table.tmpl":                      repoCommits_tableTmpl,
 	"repo/create.tmpl":                             repoCreateTmpl,
+	"repo/dependencies.tmpl":                       repoDependenciesTmpl,
 	"repo/diff/box.tmpl":                           repoDiffBoxTmpl,
 	"repo/diff/page.tmpl":                          repoDiffPageTmpl,
 	"repo/diff/section_unified.tmpl":               repoDiffSection_unifiedTmpl,
@@ -3339,7 +4289,11 @@ var _bindata = map[string]func() (*asset, error){
 	"repo/forks.tmpl":                              repoForksTmpl,
 	"repo/header.tmpl":                             repoHeaderTmpl,
 	"repo/home.tmpl":                               repoHomeTmpl,
+	"repo/insights.tmpl":                           repoInsightsTmpl,
+	"repo/invitation.tmpl":                         repoInvitationTmpl,
+	"repo/issue/choose.tmpl":                       repoIssueChooseTmpl,
 	"repo/issue/comment_tab.tmpl":                  repoIssueComment_tabTmpl,
+	"repo/issue/form_fields.tmpl":                  repoIssueForm_fieldsTmpl,
 	"repo/issue/label_precolors.tmpl":              repoIssueLabel_precolorsTmpl,
 	"repo/issue/labels.tmpl":                       repoIssueLabelsTmpl,
 	"repo/issue/list.tmpl":                         repoIssueListTmpl,
@@ -3352,21 +4306,32 @@ var _bindata = map[string]func() (*asset, error){
 	"repo/issue/view_content.tmpl":                 repoIssueView_contentTmpl,
 	"repo/issue/view_title.tmpl":                   repoIssueView_titleTmpl,
 	"repo/migrate.tmpl":                            repoMigrateTmpl,
+	"repo/mute_schedule.tmpl":                      repoMute_scheduleTmpl,
 	"repo/pulls/commits.tmpl":                      repoPullsCommitsTmpl,
 	"repo/pulls/compare.tmpl":                      repoPullsCompareTmpl,
 	"repo/pulls/files.tmpl":                        repoPullsFilesTmpl,
 	"repo/pulls/fork.tmpl":                         repoPullsForkTmpl,
 	"repo/pulls/tab_menu.tmpl":                     repoPullsTab_menuTmpl,
+	"repo/recent_push.tmpl":                        repoRecent_pushTmpl,
 	"repo/release/list.tmpl":                       repoReleaseListTmpl,
 	"repo/release/new.tmpl":                        repoReleaseNewTmpl,
+	"repo/release/stats.tmpl":                      repoReleaseStatsTmpl,
+	"repo/settings/auto_lock.tmpl":                 repoSettingsAuto_lockTmpl,
 	"repo/settings/branches.tmpl":                  repoSettingsBranchesTmpl,
 	"repo/settings/collaboration.tmpl":             repoSettingsCollaborationTmpl,
 	"repo/settings/deploy_keys.tmpl":               repoSettingsDeploy_keysTmpl,
 	"repo/settings/githook_edit.tmpl":              repoSettingsGithook_editTmpl,
 	"repo/settings/githooks.tmpl":                  repoSettingsGithooksTmpl,
+	"repo/settings/import.tmpl":                    repoSettingsImportTmpl,
 	"repo/settings/navbar.tmpl":                    repoSettingsNavbarTmpl,
 	"repo/settings/options.tmpl":                   repoSettingsOptionsTmpl,
 	"repo/settings/protected_branch.tmpl":          repoSettingsProtected_branchTmpl,
+	"repo/settings/push_log.tmpl":                  repoSettingsPush_logTmpl,
+	"repo/settings/reminder_form.tmpl":             repoSettingsReminder_formTmpl,
+	"repo/settings/reminders.tmpl":                 repoSettingsRemindersTmpl,
+	"repo/settings/secret_form.tmpl":               repoSettingsSecret_formTmpl,
+	"repo/settings/secrets.tmpl":                   repoSettingsSecretsTmpl,
+	"repo/settings/tasks.tmpl":                     repoSettingsTasksTmpl,
 	"repo/settings/webhook/base.tmpl":              repoSettingsWebhookBaseTmpl,
 	"repo/settings/webhook/delete_modal.tmpl":      repoSettingsWebhookDelete_modalTmpl,
 	"repo/settings/webhook/dingtalk.tmpl":          repoSettingsWebhookDingtalkTmpl,
@@ -3375,11 +4340,13 @@ var _bindata = map[string]func() (*asset, error){
 	"repo/settings/webhook/history.tmpl":           repoSettingsWebhookHistoryTmpl,
 	"repo/settings/webhook/list.tmpl":              repoSettingsWebhookListTmpl,
 	"repo/settings/webhook/new.tmpl":               repoSettingsWebhookNewTmpl,
+	"repo/settings/webhook/payload_version.tmpl":   repoSettingsWebhookPayload_versionTmpl,
 	"repo/settings/webhook/settings.tmpl":          repoSettingsWebhookSettingsTmpl,
 	"repo/settings/webhook/slack.tmpl":             repoSettingsWebhookSlackTmpl,
 	"repo/user_cards.tmpl":                         repoUser_cardsTmpl,
 	"repo/view_file.tmpl":                          repoView_fileTmpl,
 	"repo/view_list.tmpl":                          repoView_listTmpl,
+	"repo/watch_paths.tmpl":                        repoWatch_pathsTmpl,
 	"repo/watchers.tmpl":                           repoWatchersTmpl,
 	"repo/wiki/new.tmpl":                           repoWikiNewTmpl,
 	"repo/wiki/pages.tmpl":                         repoWikiPagesTmpl,
@@ -3387,6 +4354,7 @@ var _bindata = map[string]func() (*asset, error){
 	"repo/wiki/view.tmpl":                          repoWikiViewTmpl,
 	"status/404.tmpl":                              status404Tmpl,
 	"status/500.tmpl":                              status500Tmpl,
+	"status/503.tmpl":                              status503Tmpl,
 	"user/auth/activate.tmpl":                      userAuthActivateTmpl,
 	"user/auth/forgot_passwd.tmpl":                 userAuthForgot_passwdTmpl,
 	"user/auth/login.tmpl":                         userAuthLoginTmpl,
@@ -3469,17 +4437,23 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"page.tmpl":   {adminBasePageTmpl, map[string]*bintree{}},
 			"search.tmpl": {adminBaseSearchTmpl, map[string]*bintree{}},
 		}},
-		"config.tmpl":    {adminConfigTmpl, map[string]*bintree{}},
-		"dashboard.tmpl": {adminDashboardTmpl, map[string]*bintree{}},
-		"monitor.tmpl":   {adminMonitorTmpl, map[string]*bintree{}},
-		"navbar.tmpl":    {adminNavbarTmpl, map[string]*bintree{}},
-		"notice.tmpl":    {adminNoticeTmpl, map[string]*bintree{}},
+		"bot": {nil, map[string]*bintree{
+			"list.tmpl": {adminBotListTmpl, map[string]*bintree{}},
+		}},
+		"config.tmpl":      {adminConfigTmpl, map[string]*bintree{}},
+		"dashboard.tmpl":   {adminDashboardTmpl, map[string]*bintree{}},
+		"lifecycle.tmpl":   {adminLifecycleTmpl, map[string]*bintree{}},
+		"maintenance.tmpl": {adminMaintenanceTmpl, map[string]*bintree{}},
+		"monitor.tmpl":     {adminMonitorTmpl, map[string]*bintree{}},
+		"navbar.tmpl":      {adminNavbarTmpl, map[string]*bintree{}},
+		"notice.tmpl":      {adminNoticeTmpl, map[string]*bintree{}},
 		"org": {nil, map[string]*bintree{
 			"list.tmpl": {adminOrgListTmpl, map[string]*bintree{}},
 		}},
 		"repo": {nil, map[string]*bintree{
 			"list.tmpl": {adminRepoListTmpl, map[string]*bintree{}},
 		}},
+		"site_content.tmpl": {adminSite_contentTmpl, map[string]*bintree{}},
 		"user": {nil, map[string]*bintree{
 			"edit.tmpl": {adminUserEditTmpl, map[string]*bintree{}},
 			"list.tmpl": {adminUserListTmpl, map[string]*bintree{}},
@@ -3515,27 +4489,48 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"reset_passwd.tmpl":    {mailAuthReset_passwdTmpl, map[string]*bintree{}},
 		}},
 		"issue": {nil, map[string]*bintree{
-			"comment.tmpl": {mailIssueCommentTmpl, map[string]*bintree{}},
-			"mention.tmpl": {mailIssueMentionTmpl, map[string]*bintree{}},
+			"comment.tmpl":         {mailIssueCommentTmpl, map[string]*bintree{}},
+			"comment.txt":          {mailIssueCommentTxt, map[string]*bintree{}},
+			"mention.tmpl":         {mailIssueMentionTmpl, map[string]*bintree{}},
+			"mention.txt":          {mailIssueMentionTxt, map[string]*bintree{}},
+			"merge.tmpl":           {mailIssueMergeTmpl, map[string]*bintree{}},
+			"merge.txt":            {mailIssueMergeTxt, map[string]*bintree{}},
+			"review_reminder.tmpl": {mailIssueReview_reminderTmpl, map[string]*bintree{}},
+			"review_reminder.txt":  {mailIssueReview_reminderTxt, map[string]*bintree{}},
 		}},
 		"notify": {nil, map[string]*bintree{
-			"collaborator.tmpl": {mailNotifyCollaboratorTmpl, map[string]*bintree{}},
+			"collaborator.tmpl":      {mailNotifyCollaboratorTmpl, map[string]*bintree{}},
+			"commit_mention.tmpl":    {mailNotifyCommit_mentionTmpl, map[string]*bintree{}},
+			"deactivated_user.tmpl":  {mailNotifyDeactivated_userTmpl, map[string]*bintree{}},
+			"inactive_repo.tmpl":     {mailNotifyInactive_repoTmpl, map[string]*bintree{}},
+			"inactive_user.tmpl":     {mailNotifyInactive_userTmpl, map[string]*bintree{}},
+			"path_watch.tmpl":        {mailNotifyPath_watchTmpl, map[string]*bintree{}},
+			"repo_invite.tmpl":       {mailNotifyRepo_inviteTmpl, map[string]*bintree{}},
+			"visibility_failed.tmpl": {mailNotifyVisibility_failedTmpl, map[string]*bintree{}},
 		}},
 	}},
 	"org": {nil, map[string]*bintree{
-		"create.tmpl": {orgCreateTmpl, map[string]*bintree{}},
-		"header.tmpl": {orgHeaderTmpl, map[string]*bintree{}},
-		"home.tmpl":   {orgHomeTmpl, map[string]*bintree{}},
+		"create.tmpl":       {orgCreateTmpl, map[string]*bintree{}},
+		"dependencies.tmpl": {orgDependenciesTmpl, map[string]*bintree{}},
+		"header.tmpl":       {orgHeaderTmpl, map[string]*bintree{}},
+		"home.tmpl":         {orgHomeTmpl, map[string]*bintree{}},
 		"member": {nil, map[string]*bintree{
 			"invite.tmpl":  {orgMemberInviteTmpl, map[string]*bintree{}},
 			"members.tmpl": {orgMemberMembersTmpl, map[string]*bintree{}},
 		}},
 		"settings": {nil, map[string]*bintree{
-			"delete.tmpl":      {orgSettingsDeleteTmpl, map[string]*bintree{}},
-			"navbar.tmpl":      {orgSettingsNavbarTmpl, map[string]*bintree{}},
-			"options.tmpl":     {orgSettingsOptionsTmpl, map[string]*bintree{}},
-			"webhook_new.tmpl": {orgSettingsWebhook_newTmpl, map[string]*bintree{}},
-			"webhooks.tmpl":    {orgSettingsWebhooksTmpl, map[string]*bintree{}},
+			"bot.tmpl":           {orgSettingsBotTmpl, map[string]*bintree{}},
+			"bots.tmpl":          {orgSettingsBotsTmpl, map[string]*bintree{}},
+			"delete.tmpl":        {orgSettingsDeleteTmpl, map[string]*bintree{}},
+			"navbar.tmpl":        {orgSettingsNavbarTmpl, map[string]*bintree{}},
+			"options.tmpl":       {orgSettingsOptionsTmpl, map[string]*bintree{}},
+			"reminders.tmpl":     {orgSettingsRemindersTmpl, map[string]*bintree{}},
+			"repo_policy.tmpl":   {orgSettingsRepo_policyTmpl, map[string]*bintree{}},
+			"rule_set_edit.tmpl": {orgSettingsRule_set_editTmpl, map[string]*bintree{}},
+			"rule_sets.tmpl":     {orgSettingsRule_setsTmpl, map[string]*bintree{}},
+			"secrets.tmpl":       {orgSettingsSecretsTmpl, map[string]*bintree{}},
+			"webhook_new.tmpl":   {orgSettingsWebhook_newTmpl, map[string]*bintree{}},
+			"webhooks.tmpl":      {orgSettingsWebhooksTmpl, map[string]*bintree{}},
 		}},
 		"team": {nil, map[string]*bintree{
 			"members.tmpl":      {orgTeamMembersTmpl, map[string]*bintree{}},
@@ -3556,6 +4551,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"commits.tmpl":       {repoCommitsTmpl, map[string]*bintree{}},
 		"commits_table.tmpl": {repoCommits_tableTmpl, map[string]*bintree{}},
 		"create.tmpl":        {repoCreateTmpl, map[string]*bintree{}},
+		"dependencies.tmpl":  {repoDependenciesTmpl, map[string]*bintree{}},
 		"diff": {nil, map[string]*bintree{
 			"box.tmpl":             {repoDiffBoxTmpl, map[string]*bintree{}},
 			"page.tmpl":            {repoDiffPageTmpl, map[string]*bintree{}},
@@ -3568,11 +4564,15 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"edit.tmpl":         {repoEditorEditTmpl, map[string]*bintree{}},
 			"upload.tmpl":       {repoEditorUploadTmpl, map[string]*bintree{}},
 		}},
-		"forks.tmpl":  {repoForksTmpl, map[string]*bintree{}},
-		"header.tmpl": {repoHeaderTmpl, map[string]*bintree{}},
-		"home.tmpl":   {repoHomeTmpl, map[string]*bintree{}},
+		"forks.tmpl":      {repoForksTmpl, map[string]*bintree{}},
+		"header.tmpl":     {repoHeaderTmpl, map[string]*bintree{}},
+		"home.tmpl":       {repoHomeTmpl, map[string]*bintree{}},
+		"insights.tmpl":   {repoInsightsTmpl, map[string]*bintree{}},
+		"invitation.tmpl": {repoInvitationTmpl, map[string]*bintree{}},
 		"issue": {nil, map[string]*bintree{
+			"choose.tmpl":          {repoIssueChooseTmpl, map[string]*bintree{}},
 			"comment_tab.tmpl":     {repoIssueComment_tabTmpl, map[string]*bintree{}},
+			"form_fields.tmpl":     {repoIssueForm_fieldsTmpl, map[string]*bintree{}},
 			"label_precolors.tmpl": {repoIssueLabel_precolorsTmpl, map[string]*bintree{}},
 			"labels.tmpl":          {repoIssueLabelsTmpl, map[string]*bintree{}},
 			"list.tmpl":            {repoIssueListTmpl, map[string]*bintree{}},
@@ -3585,7 +4585,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"view_content.tmpl":    {repoIssueView_contentTmpl, map[string]*bintree{}},
 			"view_title.tmpl":      {repoIssueView_titleTmpl, map[string]*bintree{}},
 		}},
-		"migrate.tmpl": {repoMigrateTmpl, map[string]*bintree{}},
+		"migrate.tmpl":       {repoMigrateTmpl, map[string]*bintree{}},
+		"mute_schedule.tmpl": {repoMute_scheduleTmpl, map[string]*bintree{}},
 		"pulls": {nil, map[string]*bintree{
 			"commits.tmpl":  {repoPullsCommitsTmpl, map[string]*bintree{}},
 			"compare.tmpl":  {repoPullsCompareTmpl, map[string]*bintree{}},
@@ -3593,36 +4594,48 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"fork.tmpl":     {repoPullsForkTmpl, map[string]*bintree{}},
 			"tab_menu.tmpl": {repoPullsTab_menuTmpl, map[string]*bintree{}},
 		}},
+		"recent_push.tmpl": {repoRecent_pushTmpl, map[string]*bintree{}},
 		"release": {nil, map[string]*bintree{
-			"list.tmpl": {repoReleaseListTmpl, map[string]*bintree{}},
-			"new.tmpl":  {repoReleaseNewTmpl, map[string]*bintree{}},
+			"list.tmpl":  {repoReleaseListTmpl, map[string]*bintree{}},
+			"new.tmpl":   {repoReleaseNewTmpl, map[string]*bintree{}},
+			"stats.tmpl": {repoReleaseStatsTmpl, map[string]*bintree{}},
 		}},
 		"settings": {nil, map[string]*bintree{
+			"auto_lock.tmpl":        {repoSettingsAuto_lockTmpl, map[string]*bintree{}},
 			"branches.tmpl":         {repoSettingsBranchesTmpl, map[string]*bintree{}},
 			"collaboration.tmpl":    {repoSettingsCollaborationTmpl, map[string]*bintree{}},
 			"deploy_keys.tmpl":      {repoSettingsDeploy_keysTmpl, map[string]*bintree{}},
 			"githook_edit.tmpl":     {repoSettingsGithook_editTmpl, map[string]*bintree{}},
 			"githooks.tmpl":         {repoSettingsGithooksTmpl, map[string]*bintree{}},
+			"import.tmpl":           {repoSettingsImportTmpl, map[string]*bintree{}},
 			"navbar.tmpl":           {repoSettingsNavbarTmpl, map[string]*bintree{}},
 			"options.tmpl":          {repoSettingsOptionsTmpl, map[string]*bintree{}},
 			"protected_branch.tmpl": {repoSettingsProtected_branchTmpl, map[string]*bintree{}},
+			"push_log.tmpl":         {repoSettingsPush_logTmpl, map[string]*bintree{}},
+			"reminder_form.tmpl":    {repoSettingsReminder_formTmpl, map[string]*bintree{}},
+			"reminders.tmpl":        {repoSettingsRemindersTmpl, map[string]*bintree{}},
+			"secret_form.tmpl":      {repoSettingsSecret_formTmpl, map[string]*bintree{}},
+			"secrets.tmpl":          {repoSettingsSecretsTmpl, map[string]*bintree{}},
+			"tasks.tmpl":            {repoSettingsTasksTmpl, map[string]*bintree{}},
 			"webhook": {nil, map[string]*bintree{
-				"base.tmpl":         {repoSettingsWebhookBaseTmpl, map[string]*bintree{}},
-				"delete_modal.tmpl": {repoSettingsWebhookDelete_modalTmpl, map[string]*bintree{}},
-				"dingtalk.tmpl":     {repoSettingsWebhookDingtalkTmpl, map[string]*bintree{}},
-				"discord.tmpl":      {repoSettingsWebhookDiscordTmpl, map[string]*bintree{}},
-				"gogs.tmpl":         {repoSettingsWebhookGogsTmpl, map[string]*bintree{}},
-				"history.tmpl":      {repoSettingsWebhookHistoryTmpl, map[string]*bintree{}},
-				"list.tmpl":         {repoSettingsWebhookListTmpl, map[string]*bintree{}},
-				"new.tmpl":          {repoSettingsWebhookNewTmpl, map[string]*bintree{}},
-				"settings.tmpl":     {repoSettingsWebhookSettingsTmpl, map[string]*bintree{}},
-				"slack.tmpl":        {repoSettingsWebhookSlackTmpl, map[string]*bintree{}},
+				"base.tmpl":            {repoSettingsWebhookBaseTmpl, map[string]*bintree{}},
+				"delete_modal.tmpl":    {repoSettingsWebhookDelete_modalTmpl, map[string]*bintree{}},
+				"dingtalk.tmpl":        {repoSettingsWebhookDingtalkTmpl, map[string]*bintree{}},
+				"discord.tmpl":         {repoSettingsWebhookDiscordTmpl, map[string]*bintree{}},
+				"gogs.tmpl":            {repoSettingsWebhookGogsTmpl, map[string]*bintree{}},
+				"history.tmpl":         {repoSettingsWebhookHistoryTmpl, map[string]*bintree{}},
+				"list.tmpl":            {repoSettingsWebhookListTmpl, map[string]*bintree{}},
+				"new.tmpl":             {repoSettingsWebhookNewTmpl, map[string]*bintree{}},
+				"payload_version.tmpl": {repoSettingsWebhookPayload_versionTmpl, map[string]*bintree{}},
+				"settings.tmpl":        {repoSettingsWebhookSettingsTmpl, map[string]*bintree{}},
+				"slack.tmpl":           {repoSettingsWebhookSlackTmpl, map[string]*bintree{}},
 			}},
 		}},
-		"user_cards.tmpl": {repoUser_cardsTmpl, map[string]*bintree{}},
-		"view_file.tmpl":  {repoView_fileTmpl, map[string]*bintree{}},
-		"view_list.tmpl":  {repoView_listTmpl, map[string]*bintree{}},
-		"watchers.tmpl":   {repoWatchersTmpl, map[string]*bintree{}},
+		"user_cards.tmpl":  {repoUser_cardsTmpl, map[string]*bintree{}},
+		"view_file.tmpl":   {repoView_fileTmpl, map[string]*bintree{}},
+		"view_list.tmpl":   {repoView_listTmpl, map[string]*bintree{}},
+		"watch_paths.tmpl": {repoWatch_pathsTmpl, map[string]*bintree{}},
+		"watchers.tmpl":    {repoWatchersTmpl, map[string]*bintree{}},
 		"wiki": {nil, map[string]*bintree{
 			"new.tmpl":   {repoWikiNewTmpl, map[string]*bintree{}},
 			"pages.tmpl": {repoWikiPagesTmpl, map[string]*bintree{}},
@@ -3633,6 +4646,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"status": {nil, map[string]*bintree{
 		"404.tmpl": {status404Tmpl, map[string]*bintree{}},
 		"500.tmpl": {status500Tmpl, map[string]*bintree{}},
+		"503.tmpl": {status503Tmpl, map[string]*bintree{}},
 	}},
 	"user": {nil, map[string]*bintree{
 		"auth": {nil, map[string]*bintree{
@@ -16,6 +16,7 @@ import (
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/lazyregexp"
 	"gogs.io/gogs/internal/tool"
 )
 
@@ -23,6 +24,38 @@ func IsAPIPath(url string) bool {
 	return strings.HasPrefix(url, "/api/")
 }
 
+var badgePathPattern = lazyregexp.New(`^/[^/]+/[^/]+/badges/`)
+
+// IsBadgePath returns true if the URL is for repository badges, which accept
+// access tokens so badges of private repositories can be embedded.
+func IsBadgePath(url string) bool {
+	return badgePathPattern.MatchString(url)
+}
+
+var feedPathPattern = lazyregexp.New(`^/[^/]+/[^/]+/(commits/.+|releases|issues)\.atom$`)
+
+// IsFeedPath returns true if the URL is for repository feeds, which accept
+// feed tokens so feeds of private repositories can be subscribed to.
+func IsFeedPath(url string) bool {
+	return feedPathPattern.MatchString(url)
+}
+
+// getUserByFeedToken is replaced in tests.
+var getUserByFeedToken = db.GetUserByFeedToken
+
+// feedTokenUserID returns the ID of the user who owns the feed token, or 0 if
+// the token does not exist.
+func feedTokenUserID(token string) int64 {
+	u, err := getUserByFeedToken(token)
+	if err != nil {
+		if !errors.IsUserNotExist(err) {
+			log.Error("GetUserByFeedToken: %v", err)
+		}
+		return 0
+	}
+	return u.ID
+}
+
 // SignedInID returns the id of signed in user, along with one bool value which indicates whether user uses token
 // authentication.
 func SignedInID(c *macaron.Context, sess session.Store) (_ int64, isTokenAuth bool) {
@@ -31,7 +64,7 @@ func SignedInID(c *macaron.Context, sess session.Store) (_ int64, isTokenAuth bo
 	}
 
 	// Check access token.
-	if IsAPIPath(c.Req.URL.Path) {
+	if IsAPIPath(c.Req.URL.Path) || IsBadgePath(c.Req.URL.Path) {
 		tokenSHA := c.Query("token")
 		if len(tokenSHA) <= 0 {
 			tokenSHA = c.Query("access_token")
@@ -64,6 +97,14 @@ func SignedInID(c *macaron.Context, sess session.Store) (_ int64, isTokenAuth bo
 		}
 	}
 
+	// Check feed token, which grants nothing but reading feeds.
+	if IsFeedPath(c.Req.URL.Path) {
+		if token := c.Query("token"); token != "" {
+			uid := feedTokenUserID(token)
+			return uid, uid > 0
+		}
+	}
+
 	uid := sess.Get("uid")
 	if uid == nil {
 		return 0, false
@@ -0,0 +1,93 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-macaron/session"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/macaron.v1"
+
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// anonymousSession is a session without a signed in user.
+type anonymousSession struct {
+	session.Store
+}
+
+func (anonymousSession) Get(interface{}) interface{} {
+	return nil
+}
+
+func TestSignedInID_FeedToken(t *testing.T) {
+	beforeHasEngine, beforeGetUser := db.HasEngine, getUserByFeedToken
+	defer func() {
+		db.HasEngine, getUserByFeedToken = beforeHasEngine, beforeGetUser
+	}()
+
+	db.HasEngine = true
+	getUserByFeedToken = func(token string) (*db.User, error) {
+		switch token {
+		case "alice-token":
+			return &db.User{ID: 1}, nil
+		case "bob-token":
+			return &db.User{ID: 2}, nil
+		}
+		// Revoked tokens do not exist anymore.
+		return nil, errors.UserNotExist{}
+	}
+
+	tests := []struct {
+		name         string
+		url          string
+		expID        int64
+		expTokenAuth bool
+	}{
+		{
+			name:         "valid token",
+			url:          "/alice/proj/releases.atom?token=alice-token",
+			expID:        1,
+			expTokenAuth: true,
+		},
+		{
+			name:         "token of another user",
+			url:          "/alice/proj/issues.atom?token=bob-token",
+			expID:        2,
+			expTokenAuth: true,
+		},
+		{
+			name: "revoked token",
+			url:  "/alice/proj/releases.atom?token=revoked-token",
+		},
+		{
+			name: "not a feed",
+			url:  "/alice/proj/releases?token=alice-token",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var id int64
+			var isTokenAuth bool
+			m := macaron.New()
+			m.Get("/*", func(c *macaron.Context) {
+				id, isTokenAuth = SignedInID(c, anonymousSession{})
+			})
+
+			req, err := http.NewRequest("GET", test.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, test.expID, id)
+			assert.Equal(t, test.expTokenAuth, isTokenAuth)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badge
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// palette is the set of colors that are allowed to be used by badges.
+var palette = map[string]string{
+	"brightgreen": "#4c1",
+	"green":       "#97ca00",
+	"yellowgreen": "#a4a61d",
+	"yellow":      "#dfb317",
+	"orange":      "#fe7d37",
+	"red":         "#e05d44",
+	"blue":        "#007ec6",
+	"lightgrey":   "#9f9f9f",
+	"grey":        "#555",
+}
+
+// Color returns the hex code of the named color in the palette, and false if
+// the name is not in the palette.
+func Color(name string) (string, bool) {
+	hex, ok := palette[strings.ToLower(name)]
+	return hex, ok
+}
+
+// Badge is a badge with a label on the left and a message on the right. Colors
+// must be names in the palette.
+type Badge struct {
+	Label      string
+	Message    string
+	Color      string
+	LabelColor string
+}
+
+// textWidth returns the approximate width in pixels of the text rendered in
+// 11px Verdana.
+func textWidth(s string) int {
+	width := 0.0
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune("ijlI.,:;|!'` ", r):
+			width += 3.5
+		case strings.ContainsRune("frt()[]{}/\\-", r):
+			width += 4.7
+		case strings.ContainsRune("mwMW@%", r):
+			width += 10.5
+		case r >= 'A' && r <= 'Z':
+			width += 7.5
+		case r < utf8.RuneSelf:
+			width += 6.8
+		default:
+			width += 11
+		}
+	}
+	return int(width + 0.5)
+}
+
+func hexColor(name, fallback string) string {
+	if hex, ok := Color(name); ok {
+		return hex
+	}
+	hex, _ := Color(fallback)
+	return hex
+}
+
+// SVG renders the badge in flat style.
+func (b Badge) SVG() []byte {
+	labelWidth := textWidth(b.Label) + 10
+	messageWidth := textWidth(b.Message) + 10
+	width := labelWidth + messageWidth
+	label := html.EscapeString(b.Label)
+	message := html.EscapeString(b.Message)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="%s: %s">`, width, label, message)
+	fmt.Fprintf(&buf, `<title>%s: %s</title>`, label, message)
+	buf.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	fmt.Fprintf(&buf, `<clipPath id="r"><rect width="%d" height="20" rx="3" fill="#fff"/></clipPath>`, width)
+	fmt.Fprintf(&buf, `<g clip-path="url(#r)"><rect width="%d" height="20" fill="%s"/><rect x="%d" width="%d" height="20" fill="%s"/><rect width="%d" height="20" fill="url(#s)"/></g>`,
+		labelWidth, hexColor(b.LabelColor, "grey"), labelWidth, messageWidth, hexColor(b.Color, "lightgrey"), width)
+	buf.WriteString(`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`)
+	for _, t := range []struct {
+		x    float64
+		text string
+	}{
+		{x: float64(labelWidth) / 2, text: label},
+		{x: float64(labelWidth) + float64(messageWidth)/2, text: message},
+	} {
+		fmt.Fprintf(&buf, `<text x="%.1f" y="15" fill="#010101" fill-opacity=".3">%s</text><text x="%.1f" y="14">%s</text>`, t.x, t.text, t.x, t.text)
+	}
+	buf.WriteString(`</g></svg>`)
+	return buf.Bytes()
+}
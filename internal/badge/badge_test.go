@@ -0,0 +1,51 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badge
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColor(t *testing.T) {
+	tests := []struct {
+		name   string
+		expHex string
+		expOK  bool
+	}{
+		{name: "brightgreen", expHex: "#4c1", expOK: true},
+		{name: "Red", expHex: "#e05d44", expOK: true},
+		{name: "#fff", expHex: "", expOK: false},
+		{name: "url(#x)", expHex: "", expOK: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hex, ok := Color(test.name)
+			assert.Equal(t, test.expHex, hex)
+			assert.Equal(t, test.expOK, ok)
+		})
+	}
+}
+
+func TestBadge_SVG(t *testing.T) {
+	svg := string(Badge{
+		Label:   `<script>"build"`,
+		Message: "passing",
+		Color:   "javascript:alert(1)",
+	}.SVG())
+
+	// Must be well-formed XML with untrusted text escaped.
+	assert.Nil(t, xml.Unmarshal([]byte(svg), new(struct{})))
+	assert.False(t, strings.Contains(svg, "<script>"))
+	assert.True(t, strings.Contains(svg, "&lt;script&gt;&#34;build&#34;"))
+
+	// Unknown colors fall back to defaults.
+	assert.True(t, strings.Contains(svg, `fill="#555"`))
+	assert.True(t, strings.Contains(svg, `fill="#9f9f9f"`))
+	assert.False(t, strings.Contains(svg, "javascript"))
+}
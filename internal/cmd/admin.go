@@ -14,6 +14,7 @@ import (
 
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/maintenance"
 )
 
 var (
@@ -31,6 +32,10 @@ to make automatic initialization process more smoothly`,
 			subcmdRewriteAuthorizedKeys,
 			subcmdSyncRepositoryHooks,
 			subcmdReinitMissingRepositories,
+			subcmdMaintenance,
+			subcmdRegenerate,
+			subcmdRepair,
+			subcmdUser,
 		},
 	}
 
@@ -119,6 +124,19 @@ to make automatic initialization process more smoothly`,
 		},
 	}
 
+	subcmdMaintenance = cli.Command{
+		Name:   "maintenance",
+		Usage:  "Turn on or off read-only maintenance mode",
+		Action: runMaintenance,
+		Flags: []cli.Flag{
+			boolFlag("on", "Turn on maintenance mode, or update the message if it is already on"),
+			boolFlag("off", "Turn off maintenance mode"),
+			stringFlag("message", "", "Message shown to users whose changes are rejected"),
+			boolFlag("allow-admins", "Allow site admins to make changes"),
+			stringFlag("config, c", "", "Custom configuration file path"),
+		},
+	}
+
 	subcmdReinitMissingRepositories = cli.Command{
 		Name:  "reinit-missing-repositories",
 		Usage: "Reinitialize all repository records that lost Git files",
@@ -145,6 +163,7 @@ func runCreateUser(c *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "init configuration")
 	}
+	conf.InitLogging(true)
 
 	db.SetEngine()
 
@@ -168,6 +187,7 @@ func adminDashboardOperation(operation func() error, successMessage string) func
 		if err != nil {
 			return errors.Wrap(err, "init configuration")
 		}
+		conf.InitLogging(true)
 
 		db.SetEngine()
 
@@ -180,3 +200,39 @@ func adminDashboardOperation(operation func() error, successMessage string) func
 		return nil
 	}
 }
+
+func runMaintenance(c *cli.Context) error {
+	if c.Bool("on") == c.Bool("off") {
+		return errors.New("Exactly one of --on and --off must be specified")
+	}
+
+	err := conf.Init(c.String("config"))
+	if err != nil {
+		return errors.Wrap(err, "init configuration")
+	}
+	conf.InitLogging(true)
+
+	var desc string
+	if c.Bool("on") {
+		if err = maintenance.Enable("command line", c.String("message"), c.Bool("allow-admins")); err != nil {
+			return errors.Wrap(err, "enable maintenance mode")
+		}
+		desc = fmt.Sprintf("Maintenance mode is enabled by command line [allow_admins: %v]: %s", c.Bool("allow-admins"), c.String("message"))
+	} else {
+		if err = maintenance.Disable("command line"); err != nil {
+			return errors.Wrap(err, "disable maintenance mode")
+		}
+		desc = "Maintenance mode is disabled by command line"
+	}
+	fmt.Println(desc)
+
+	// The database may be unavailable during maintenance, e.g. being migrated, so
+	// failing to record the notice does not fail the command.
+	if err = db.SetEngine(); err == nil {
+		err = db.CreateMaintenanceNotice(desc)
+	}
+	if err != nil {
+		fmt.Printf("Failed to create system notice: %v\n", err)
+	}
+	return nil
+}
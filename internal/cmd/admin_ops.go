@@ -0,0 +1,311 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/tool"
+)
+
+// Exit codes of admin commands that print summaries, so scripts can tell
+// failures from problems that are found in data.
+const (
+	exitCodeFailure        = 1
+	exitCodeProblems       = 2
+	exitCodeSchemaMismatch = 3
+)
+
+const adminOpsDescription = `The command works without the web server, and refuses to run when the
+database has not been migrated to the version of this binary.
+
+Exit codes: 0 on success, 1 on failure, 2 when problems are found, and 3 when
+the version of the database schema does not match.`
+
+var (
+	subcmdRegenerate = cli.Command{
+		Name:  "regenerate",
+		Usage: "Regenerate files that are derived from the database",
+		Subcommands: []cli.Command{
+			{
+				Name:        "hooks",
+				Usage:       "Rewrite Git hooks of all repositories, e.g. after the path of the binary is changed",
+				Description: adminOpsDescription,
+				Action:      adminOperation("regenerate hooks", runRegenerateHooks),
+				Flags:       adminOpsFlags(),
+			},
+			{
+				Name:        "keys",
+				Usage:       "Rebuild '.ssh/authorized_keys' file from the database (caution: non-Gogs keys will be lost)",
+				Description: adminOpsDescription,
+				Action:      adminOperation("regenerate keys", runRegenerateKeys),
+				Flags:       adminOpsFlags(),
+			},
+		},
+	}
+
+	subcmdRepair = cli.Command{
+		Name:  "repair",
+		Usage: "Find and repair inconsistencies between the database and the disk",
+		Subcommands: []cli.Command{
+			{
+				Name:        "repo-paths",
+				Usage:       "Reconcile repository records with Git directories on disk",
+				Description: adminOpsDescription,
+				Action:      adminOperation("repair repo-paths", runRepairRepoPaths),
+				Flags: append(adminOpsFlags(),
+					boolFlag("reinit-missing", "Initialize empty Git repositories for records that lost Git directories"),
+				),
+			},
+		},
+	}
+
+	subcmdUser = cli.Command{
+		Name:  "user",
+		Usage: "Manage users",
+		Subcommands: []cli.Command{
+			{
+				Name:        "reset-password",
+				Usage:       "Reset the password of a user, a random one is generated if not read from standard input",
+				Description: adminOpsDescription,
+				Action:      adminOperation("user reset-password", runResetPassword),
+				Flags: append(adminOpsFlags(),
+					stringFlag("username", "", "Username"),
+					boolFlag("password-stdin", "Read the new password from the first line of standard input"),
+				),
+			},
+		},
+	}
+)
+
+func adminOpsFlags() []cli.Flag {
+	return []cli.Flag{
+		boolFlag("json", "Print the summary in JSON"),
+		stringFlag("config, c", "", "Custom configuration file path"),
+	}
+}
+
+// adminSummary is the result of an admin operation.
+type adminSummary struct {
+	Command string `json:"command"`
+	OK      bool   `json:"ok"`
+	Message string `json:"message"`
+	// Problems are found in data, which do not stop the operation.
+	Problems []string    `json:"problems"`
+	Details  interface{} `json:"details,omitempty"`
+}
+
+func (s *adminSummary) print(asJSON bool) {
+	if asJSON {
+		data, _ := jsoniter.MarshalIndent(s, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+
+	fmt.Println(s.Message)
+	for _, p := range s.Problems {
+		fmt.Printf("- %s\n", p)
+	}
+}
+
+// finish completes the summary with the error of the operation, and returns
+// the exit code of the command.
+func (s *adminSummary) finish(err error) int {
+	code := 0
+	switch {
+	case db.IsErrSchemaVersionMismatch(err):
+		code = exitCodeSchemaMismatch
+		if err.(db.ErrSchemaVersionMismatch).Current < err.(db.ErrSchemaVersionMismatch).Expected {
+			s.Message = fmt.Sprintf("%v, please start the web server of this version to migrate the database first", err)
+		} else {
+			s.Message = fmt.Sprintf("%v, the database has been migrated by a newer version", err)
+		}
+	case err != nil:
+		code = exitCodeFailure
+		s.Message = err.Error()
+	case len(s.Problems) > 0:
+		code = exitCodeProblems
+	}
+	s.OK = code == 0
+	return code
+}
+
+// adminOperation returns the action of an admin command that works offline and
+// prints a summary, which exits with the code of the result.
+func adminOperation(command string, operation func(c *cli.Context, s *adminSummary) error) func(*cli.Context) error {
+	return func(c *cli.Context) error {
+		s := &adminSummary{
+			Command:  command,
+			Problems: []string{},
+		}
+
+		err := conf.Init(c.String("config"))
+		if err != nil {
+			err = errors.Wrap(err, "init configuration")
+		} else {
+			// Logs are written to a file so the summary is the only output for
+			// scripts to parse.
+			conf.InitLogging(true)
+			err = log.NewFile(log.FileConfig{
+				Level:    log.LevelInfo,
+				Filename: filepath.Join(conf.Log.RootPath, "admin.log"),
+				FileRotationConfig: log.FileRotationConfig{
+					Rotate:  true,
+					Daily:   true,
+					MaxDays: 3,
+				},
+			})
+			if err != nil {
+				err = errors.Wrap(err, "init file logger")
+			} else {
+				log.Remove(log.DefaultConsoleName)
+				if err = db.SetEngine(); err == nil {
+					err = db.CheckSchemaVersion()
+				}
+			}
+		}
+		if err == nil {
+			err = operation(c, s)
+		}
+
+		code := s.finish(err)
+		s.print(c.Bool("json"))
+
+		if code != 0 {
+			return cli.NewExitError("", code)
+		}
+		return nil
+	}
+}
+
+func runRegenerateHooks(_ *cli.Context, s *adminSummary) error {
+	total, failures, err := db.RegenerateRepositoryHooks()
+	if err != nil {
+		return errors.Wrap(err, "regenerate repository hooks")
+	}
+
+	s.Message = fmt.Sprintf("Hooks of %d repositories have been regenerated, %d failed", total-len(failures), len(failures))
+	s.Problems = append(s.Problems, failures...)
+	s.Details = struct {
+		Repositories int `json:"repositories"`
+		Failed       int `json:"failed"`
+	}{total, len(failures)}
+	return nil
+}
+
+func runRegenerateKeys(_ *cli.Context, s *adminSummary) error {
+	if err := db.RewriteAuthorizedKeys(); err != nil {
+		return errors.Wrap(err, "rewrite authorized keys")
+	}
+	count, err := db.CountPublicKeys()
+	if err != nil {
+		return errors.Wrap(err, "count public keys")
+	}
+
+	fpath := filepath.Join(conf.SSH.RootPath, "authorized_keys")
+	s.Message = fmt.Sprintf("%q has been rebuilt with %d public keys", fpath, count)
+	s.Details = struct {
+		Path string `json:"path"`
+		Keys int64  `json:"keys"`
+	}{fpath, count}
+	return nil
+}
+
+func runRepairRepoPaths(c *cli.Context, s *adminSummary) error {
+	report, err := db.CheckRepositoryPaths(c.Bool("reinit-missing"))
+	if err != nil {
+		return errors.Wrap(err, "check repository paths")
+	}
+
+	reinitialized := make(map[string]bool, len(report.Reinitialized))
+	for _, name := range report.Reinitialized {
+		reinitialized[name] = true
+	}
+	for _, name := range report.Missing {
+		if !reinitialized[name] {
+			s.Problems = append(s.Problems, "missing Git directory: "+name)
+		}
+	}
+	for _, dir := range report.Orphaned {
+		s.Problems = append(s.Problems, "orphaned Git directory: "+dir)
+	}
+
+	s.Message = fmt.Sprintf("Checked %d repositories: %d missing, %d reinitialized, %d orphaned directories",
+		report.Total, len(report.Missing), len(report.Reinitialized), len(report.Orphaned))
+	s.Details = report
+	return nil
+}
+
+func runResetPassword(c *cli.Context, s *adminSummary) error {
+	if c.String("username") == "" {
+		return errors.New("Username is not specified")
+	}
+
+	u, err := db.GetUserByName(c.String("username"))
+	if err != nil {
+		return errors.Wrap(err, "get user")
+	}
+
+	var password string
+	generated := !c.Bool("password-stdin")
+	if generated {
+		if password, err = tool.RandomString(16); err != nil {
+			return errors.Wrap(err, "generate password")
+		}
+	} else {
+		// The password is not accepted as a flag, which would be visible to
+		// other users in the list of processes.
+		if password, err = readPassword(os.Stdin); err != nil {
+			return errors.Wrap(err, "read password")
+		} else if len(password) < 6 {
+			return errors.New("Password must be at least 6 characters")
+		}
+	}
+
+	u.Passwd = password
+	if u.Rands, err = db.GetUserSalt(); err != nil {
+		return errors.Wrap(err, "get user salt")
+	}
+	if u.Salt, err = db.GetUserSalt(); err != nil {
+		return errors.Wrap(err, "get user salt")
+	}
+	u.EncodePasswd()
+	if err = db.UpdateUser(u); err != nil {
+		return errors.Wrap(err, "update user")
+	}
+
+	details := struct {
+		Username string `json:"username"`
+		Password string `json:"password,omitempty"`
+	}{Username: u.Name}
+	s.Message = fmt.Sprintf("Password of user '%s' has been reset", u.Name)
+	if generated {
+		s.Message += fmt.Sprintf(", the new password is: %s", password)
+		details.Password = password
+	}
+	s.Details = details
+	return nil
+}
+
+// readPassword returns the first line of the reader without the line ending.
+func readPassword(r io.Reader) (string, error) {
+	password, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(password, "\r\n"), nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gogs.io/gogs/internal/db"
+)
+
+func Test_readPassword(t *testing.T) {
+	tests := []struct {
+		input  string
+		expVal string
+	}{
+		{input: "", expVal: ""},
+		{input: "secret", expVal: "secret"},
+		{input: "secret\n", expVal: "secret"},
+		{input: "secret\r\nignored\n", expVal: "secret"},
+		{input: " secret with spaces \n", expVal: " secret with spaces "},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			password, err := readPassword(strings.NewReader(test.input))
+			assert.Nil(t, err)
+			assert.Equal(t, test.expVal, password)
+		})
+	}
+}
+
+func Test_adminSummary_finish(t *testing.T) {
+	tests := []struct {
+		name       string
+		problems   []string
+		err        error
+		expCode    int
+		expMessage string
+	}{
+		{
+			name:       "success",
+			expCode:    0,
+			expMessage: "done",
+		},
+		{
+			name:       "problems",
+			problems:   []string{"missing Git directory: alice/proj"},
+			expCode:    exitCodeProblems,
+			expMessage: "done",
+		},
+		{
+			name:       "failure",
+			problems:   []string{"missing Git directory: alice/proj"},
+			err:        errors.New("boom"),
+			expCode:    exitCodeFailure,
+			expMessage: "boom",
+		},
+		{
+			name:       "older schema",
+			err:        db.ErrSchemaVersionMismatch{Current: 19, Expected: 20},
+			expCode:    exitCodeSchemaMismatch,
+			expMessage: "database schema version mismatch [current: 19, expected: 20], please start the web server of this version to migrate the database first",
+		},
+		{
+			name:       "newer schema",
+			err:        db.ErrSchemaVersionMismatch{Current: 21, Expected: 20},
+			expCode:    exitCodeSchemaMismatch,
+			expMessage: "database schema version mismatch [current: 21, expected: 20], the database has been migrated by a newer version",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &adminSummary{
+				Message:  "done",
+				Problems: test.problems,
+			}
+			assert.Equal(t, test.expCode, s.finish(test.err))
+			assert.Equal(t, test.expMessage, s.Message)
+			assert.Equal(t, test.expCode == 0, s.OK)
+		})
+	}
+}
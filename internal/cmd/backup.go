@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -21,6 +22,8 @@ import (
 
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/maintenance"
+	"gogs.io/gogs/internal/osutil"
 )
 
 var Backup = cli.Command{
@@ -28,7 +31,10 @@ var Backup = cli.Command{
 	Usage: "Backup files and database",
 	Description: `Backup dumps and compresses all related files and database into zip file,
 which can be used for migrating Gogs to another server. The output format is meant to be
-portable among all supported database engines.`,
+portable among all supported database engines.
+
+The database is dumped in a single transaction. Use '--pause-pushes' to turn on read-only
+maintenance mode while backing up, so that repositories match the database dump.`,
 	Action: runBackup,
 	Flags: []cli.Flag{
 		stringFlag("config, c", "", "Custom configuration file path"),
@@ -38,12 +44,108 @@ portable among all supported database engines.`,
 		stringFlag("archive-name", fmt.Sprintf("gogs-backup-%s.zip", time.Now().Format("20060102150405")), "Name of backup archive"),
 		boolFlag("database-only", "Only dump database"),
 		boolFlag("exclude-repos", "Exclude repositories"),
+		boolFlag("exclude-secrets", "Exclude passwords and secret keys from the configuration file"),
+		boolFlag("portable", "Save paths relative to the work directory, so the archive can be restored to other locations"),
+		boolFlag("pause-pushes", "Turn on read-only maintenance mode while backing up"),
 	},
 }
 
-const _CURRENT_BACKUP_FORMAT_VERSION = 1
+// Format version 2 adds the schema version of the database and portable
+// archives, whose repositories are packed relative to the repository root.
+const _CURRENT_BACKUP_FORMAT_VERSION = 2
 const _ARCHIVE_ROOT_DIR = "gogs-backup"
 
+// backupSecretKeys are keys of secrets in the configuration file by sections,
+// which are cleared when backing up with '--exclude-secrets'.
+var backupSecretKeys = map[string][]string{
+	"database":   {"PASSWORD", "PASSWD"},
+	"security":   {"SECRET_KEY"},
+	"email":      {"PASSWORD", "PASSWD"},
+	"mailer":     {"PASSWD"},
+	"prometheus": {"BASIC_AUTH_PASSWORD"},
+}
+
+// backupPathKeys are keys of paths in the configuration file by sections,
+// which are made relative to the work directory in portable archives.
+var backupPathKeys = map[string][]string{
+	"server":            {"APP_DATA_PATH"},
+	"repository":        {"ROOT"},
+	"repository.upload": {"TEMP_PATH"},
+	"database":          {"PATH"},
+	"attachment":        {"PATH"},
+	"picture":           {"AVATAR_UPLOAD_PATH", "REPOSITORY_AVATAR_UPLOAD_PATH"},
+	"log":               {"ROOT_PATH"},
+}
+
+// backupDataDirs returns names of data directories in backup archives and
+// paths of them that are configured.
+func backupDataDirs() []struct{ Name, Path string } {
+	return []struct{ Name, Path string }{
+		{"attachments", conf.Attachment.Path},
+		{"avatars", conf.Picture.AvatarUploadPath},
+		{"repo-avatars", conf.Picture.RepositoryAvatarUploadPath},
+	}
+}
+
+// backupProgress prints steps of backing up and restoring.
+type backupProgress struct {
+	step, total int
+}
+
+func (p *backupProgress) next(format string, args ...interface{}) {
+	p.step++
+	log.Info("[%d/%d] %s", p.step, p.total, fmt.Sprintf(format, args...))
+}
+
+// archiveConfig saves the configuration file to be archived, with secrets
+// cleared and/or paths inside the work directory made relative to it.
+func archiveConfig(src, dst string, excludeSecrets, portable bool) error {
+	cfg, err := ini.LoadSources(ini.LoadOptions{
+		IgnoreInlineComment: true,
+	}, src)
+	if err != nil {
+		return errors.Wrap(err, "load")
+	}
+
+	if excludeSecrets {
+		for section, keys := range backupSecretKeys {
+			sec, err := cfg.GetSection(section)
+			if err != nil {
+				continue
+			}
+			for _, key := range keys {
+				if sec.HasKey(key) {
+					sec.Key(key).SetValue("")
+				}
+			}
+		}
+	}
+
+	if portable {
+		for section, keys := range backupPathKeys {
+			sec, err := cfg.GetSection(section)
+			if err != nil {
+				continue
+			}
+			for _, key := range keys {
+				if !sec.HasKey(key) {
+					continue
+				}
+				k := sec.Key(key)
+				rel, err := filepath.Rel(conf.WorkDir(), k.String())
+				if err == nil && filepath.IsAbs(k.String()) && !strings.HasPrefix(rel, "..") {
+					k.SetValue(filepath.ToSlash(rel))
+				}
+			}
+		}
+	}
+
+	if err = os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+	return cfg.SaveTo(dst)
+}
+
 func runBackup(c *cli.Context) error {
 	zip.Verbose = c.Bool("verbose")
 
@@ -51,8 +153,11 @@ func runBackup(c *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "init configuration")
 	}
+	conf.InitLogging(true)
 
-	db.SetEngine()
+	if err = db.SetEngine(); err != nil {
+		return errors.Wrap(err, "set engine")
+	}
 
 	tmpDir := c.String("tempdir")
 	if !com.IsExist(tmpDir) {
@@ -64,77 +169,151 @@ func runBackup(c *cli.Context) error {
 	}
 	log.Info("Backup root directory: %s", rootDir)
 
+	// Changes are paused by maintenance mode, unless it has been turned on by
+	// someone else who will turn it off.
+	paused := false
+	if c.Bool("pause-pushes") && !maintenance.Current().Enabled {
+		if err = maintenance.Enable("backup", "A backup is in progress, changes are paused for a few minutes.", false); err != nil {
+			log.Fatal("Failed to turn on maintenance mode: %v", err)
+		}
+		paused = true
+	}
+
+	archiveName := filepath.Join(c.String("target"), c.String("archive-name"))
+	err = createBackup(c, rootDir, archiveName)
+	os.RemoveAll(rootDir)
+	if paused {
+		if err := maintenance.Disable("backup"); err != nil {
+			log.Error("Failed to turn off maintenance mode: %v", err)
+		}
+	}
+	if err != nil {
+		log.Fatal("Failed to backup: %v", err)
+	}
+
+	log.Info("Backup succeed! Archive is located at: %s", archiveName)
+	log.Stop()
+	return nil
+}
+
+func createBackup(c *cli.Context, rootDir, archiveName string) error {
+	databaseOnly := c.Bool("database-only")
+	withRepos := !c.Bool("exclude-repos") && !databaseOnly
+	portable := c.Bool("portable")
+
+	progress := &backupProgress{total: 2}
+	if !databaseOnly {
+		progress.total += 2
+	}
+	if withRepos {
+		progress.total++
+	}
+
 	// Metadata
+	schemaVersion, err := db.SchemaVersion()
+	if err != nil {
+		return errors.Wrap(err, "get database schema version")
+	}
 	metaFile := path.Join(rootDir, "metadata.ini")
 	metadata := ini.Empty()
 	metadata.Section("").Key("VERSION").SetValue(com.ToStr(_CURRENT_BACKUP_FORMAT_VERSION))
 	metadata.Section("").Key("DATE_TIME").SetValue(time.Now().String())
 	metadata.Section("").Key("GOGS_VERSION").SetValue(conf.App.Version)
+	metadata.Section("").Key("DB_VERSION").SetValue(com.ToStr(schemaVersion))
+	metadata.Section("").Key("DB_TYPE").SetValue(conf.Database.Type)
+	metadata.Section("").Key("PORTABLE").SetValue(com.ToStr(portable))
+	metadata.Section("").Key("EXCLUDE_SECRETS").SetValue(com.ToStr(c.Bool("exclude-secrets")))
 	if err = metadata.SaveTo(metaFile); err != nil {
-		log.Fatal("Failed to save metadata '%s': %v", metaFile, err)
+		return errors.Wrapf(err, "save metadata '%s'", metaFile)
 	}
 
-	archiveName := filepath.Join(c.String("target"), c.String("archive-name"))
 	log.Info("Packing backup files to: %s", archiveName)
-
 	z, err := zip.Create(archiveName)
 	if err != nil {
-		log.Fatal("Failed to create backup archive '%s': %v", archiveName, err)
+		return errors.Wrapf(err, "create backup archive '%s'", archiveName)
 	}
 	if err = z.AddFile(_ARCHIVE_ROOT_DIR+"/metadata.ini", metaFile); err != nil {
-		log.Fatal("Failed to include 'metadata.ini': %v", err)
+		return errors.Wrap(err, "include 'metadata.ini'")
 	}
 
 	// Database
+	progress.next("Dumping database")
 	dbDir := filepath.Join(rootDir, "db")
-	if err = db.DumpDatabase(dbDir); err != nil {
-		log.Fatal("Failed to dump database: %v", err)
+	if err = db.DumpDatabase(dbDir, c.Bool("verbose")); err != nil {
+		return errors.Wrap(err, "dump database")
 	}
 	if err = z.AddDir(_ARCHIVE_ROOT_DIR+"/db", dbDir); err != nil {
-		log.Fatal("Failed to include 'db': %v", err)
+		return errors.Wrap(err, "include 'db'")
 	}
 
-	// Custom files
-	if !c.Bool("database-only") {
-		if err = z.AddDir(_ARCHIVE_ROOT_DIR+"/custom", conf.CustomDir()); err != nil {
-			log.Fatal("Failed to include 'custom': %v", err)
+	// Custom files, the configuration file in use is always saved as the one in
+	// the custom directory.
+	if !databaseOnly {
+		progress.next("Copying custom files")
+		customDir := filepath.Join(rootDir, "custom")
+		if com.IsDir(conf.CustomDir()) {
+			if err = com.CopyDir(conf.CustomDir(), customDir); err != nil {
+				return errors.Wrap(err, "copy 'custom'")
+			}
+		}
+		if osutil.IsFile(conf.CustomConf) {
+			err = archiveConfig(conf.CustomConf, filepath.Join(customDir, "conf", "app.ini"), c.Bool("exclude-secrets"), portable)
+			if err != nil {
+				return errors.Wrap(err, "save configuration file")
+			}
+		}
+		if com.IsDir(customDir) {
+			if err = z.AddDir(_ARCHIVE_ROOT_DIR+"/custom", customDir); err != nil {
+				return errors.Wrap(err, "include 'custom'")
+			}
 		}
 	}
 
 	// Data files
-	if !c.Bool("database-only") {
-		for _, dir := range []string{"attachments", "avatars", "repo-avatars"} {
-			dirPath := filepath.Join(conf.Server.AppDataPath, dir)
-			if !com.IsDir(dirPath) {
+	if !databaseOnly {
+		progress.next("Copying data files")
+		for _, dir := range backupDataDirs() {
+			if !com.IsDir(dir.Path) {
 				continue
 			}
 
-			if err = z.AddDir(path.Join(_ARCHIVE_ROOT_DIR+"/data", dir), dirPath); err != nil {
-				log.Fatal("Failed to include 'data': %v", err)
+			if err = z.AddDir(path.Join(_ARCHIVE_ROOT_DIR+"/data", dir.Name), dir.Path); err != nil {
+				return errors.Wrap(err, "include 'data'")
 			}
 		}
 	}
 
-	// Repositories
-	if !c.Bool("exclude-repos") && !c.Bool("database-only") {
+	// Repositories, which are packed relative to the root in portable archives,
+	// and with the directory of the root otherwise.
+	if withRepos {
+		progress.next("Dumping repositories in %q", conf.Repository.Root)
 		reposDump := filepath.Join(rootDir, "repositories.zip")
-		log.Info("Dumping repositories in %q", conf.Repository.Root)
-		if err = zip.PackTo(conf.Repository.Root, reposDump, true); err != nil {
-			log.Fatal("Failed to dump repositories: %v", err)
+		files := 0
+		err = zip.PackToFunc(conf.Repository.Root, reposDump, func(fullName string, fi os.FileInfo) error {
+			if fi.IsDir() {
+				return nil
+			}
+			files++
+			if zip.Verbose {
+				fmt.Printf("Adding file...%s\n", fullName)
+			} else if files%1000 == 0 {
+				log.Info("%d files of repositories have been dumped", files)
+			}
+			return nil
+		}, !portable)
+		if err != nil {
+			return errors.Wrap(err, "dump repositories")
 		}
-		log.Info("Repositories dumped to: %s", reposDump)
+		log.Info("Repositories dumped to: %s (%d files)", reposDump, files)
 
 		if err = z.AddFile(_ARCHIVE_ROOT_DIR+"/repositories.zip", reposDump); err != nil {
-			log.Fatal("Failed to include 'repositories.zip': %v", err)
+			return errors.Wrap(err, "include 'repositories.zip'")
 		}
 	}
 
+	progress.next("Saving backup archive")
 	if err = z.Close(); err != nil {
-		log.Fatal("Failed to save backup archive '%s': %v", archiveName, err)
+		return errors.Wrapf(err, "save backup archive '%s'", archiveName)
 	}
-
-	os.RemoveAll(rootDir)
-	log.Info("Backup succeed! Archive is located at: %s", archiveName)
-	log.Stop()
 	return nil
 }
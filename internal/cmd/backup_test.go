@@ -0,0 +1,178 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	stdzip "archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/ini.v1"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "app.ini")
+	require.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func Test_archiveConfig(t *testing.T) {
+	dataPath := filepath.Join(conf.WorkDir(), "data")
+	src := writeConfig(t, `
+[database]
+PASSWORD = db-secret
+PATH = `+filepath.Join(dataPath, "gogs.db")+`
+
+[security]
+SECRET_KEY = key ; not a comment
+
+[repository]
+ROOT = /srv/git
+
+[server]
+APP_DATA_PATH = relative/data
+`)
+
+	load := func(t *testing.T, excludeSecrets, portable bool) *ini.File {
+		dst := filepath.Join(t.TempDir(), "custom", "conf", "app.ini")
+		require.Nil(t, archiveConfig(src, dst, excludeSecrets, portable))
+		cfg, err := ini.LoadSources(ini.LoadOptions{IgnoreInlineComment: true}, dst)
+		require.Nil(t, err)
+		return cfg
+	}
+
+	t.Run("keep secrets and paths", func(t *testing.T) {
+		cfg := load(t, false, false)
+		assert.Equal(t, "db-secret", cfg.Section("database").Key("PASSWORD").String())
+		assert.Equal(t, "key ; not a comment", cfg.Section("security").Key("SECRET_KEY").String())
+		assert.Equal(t, filepath.Join(dataPath, "gogs.db"), cfg.Section("database").Key("PATH").String())
+	})
+
+	t.Run("exclude secrets", func(t *testing.T) {
+		cfg := load(t, true, false)
+		assert.Empty(t, cfg.Section("database").Key("PASSWORD").String())
+		assert.Empty(t, cfg.Section("security").Key("SECRET_KEY").String())
+		assert.False(t, cfg.Section("mailer").HasKey("PASSWD"))
+	})
+
+	t.Run("portable paths", func(t *testing.T) {
+		cfg := load(t, false, true)
+		assert.Equal(t, "data/gogs.db", cfg.Section("database").Key("PATH").String())
+		assert.Equal(t, "/srv/git", cfg.Section("repository").Key("ROOT").String())
+		assert.Equal(t, "relative/data", cfg.Section("server").Key("APP_DATA_PATH").String())
+	})
+}
+
+func Test_fillSecrets(t *testing.T) {
+	archived := writeConfig(t, `
+[database]
+PASSWORD =
+USER = gogs
+
+[security]
+SECRET_KEY = archived-key
+
+[mailer]
+PASSWD =
+`)
+	specified := writeConfig(t, `
+[database]
+PASSWORD = db-secret
+USER = root
+
+[security]
+SECRET_KEY = specified-key
+`)
+
+	require.Nil(t, fillSecrets(archived, specified))
+	cfg, err := ini.Load(archived)
+	require.Nil(t, err)
+
+	// Only secrets that are cleared are filled.
+	assert.Equal(t, "db-secret", cfg.Section("database").Key("PASSWORD").String())
+	assert.Equal(t, "gogs", cfg.Section("database").Key("USER").String())
+	assert.Equal(t, "archived-key", cfg.Section("security").Key("SECRET_KEY").String())
+	assert.Empty(t, cfg.Section("mailer").Key("PASSWD").String())
+}
+
+// newZip returns the zip archive with the files of given names and contents.
+func newZip(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := stdzip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.CreateHeader(&stdzip.FileHeader{Name: name, Method: stdzip.Store})
+		require.Nil(t, err)
+		_, err = f.Write(content)
+		require.Nil(t, err)
+	}
+	require.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+func Test_verifyZip(t *testing.T) {
+	repos := newZip(t, map[string][]byte{
+		"alice/proj.git/HEAD":   []byte("ref: refs/heads/master\n"),
+		"alice/proj.git/config": []byte("[core]\n"),
+	})
+	archive := newZip(t, map[string][]byte{
+		"gogs-backup/metadata.ini":       []byte("VERSION = 2\n"),
+		"gogs-backup/db/Version.json":    []byte(`{"ID":1}` + "\n"),
+		"gogs-backup/repositories.zip":   repos,
+		"gogs-backup/data/avatars/1.png": []byte("image"),
+	})
+
+	verify := func(data []byte, check func(name string, r io.Reader) error) (int, error) {
+		r, err := stdzip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.Nil(t, err)
+		return verifyZip(r, t.TempDir(), check)
+	}
+
+	t.Run("count files in nested archives", func(t *testing.T) {
+		var checked []string
+		files, err := verify(archive, func(name string, _ io.Reader) error {
+			checked = append(checked, name)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 6, files)
+		// Files in nested archives are not checked by the function.
+		assert.Len(t, checked, 3)
+	})
+
+	t.Run("fail on checks", func(t *testing.T) {
+		_, err := verify(archive, func(name string, _ io.Reader) error {
+			if strings.HasSuffix(name, ".json") {
+				return errors.New("invalid JSON")
+			}
+			return nil
+		})
+		assert.EqualError(t, err, `read "gogs-backup/db/Version.json": invalid JSON`)
+	})
+
+	t.Run("fail on checksums", func(t *testing.T) {
+		corrupted := bytes.Replace(archive, []byte("image"), []byte("IMAGE"), 1)
+		_, err := verify(corrupted, nil)
+		assert.EqualError(t, err, `read "gogs-backup/data/avatars/1.png": zip: checksum error`)
+
+		corrupted = newZip(t, map[string][]byte{
+			"gogs-backup/repositories.zip": bytes.Replace(repos, []byte("[core]"), []byte("[CORE]"), 1),
+		})
+		_, err = verify(corrupted, nil)
+		assert.EqualError(t, err, `verify "gogs-backup/repositories.zip": read "alice/proj.git/config": zip: checksum error`)
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/email"
 	"gogs.io/gogs/internal/httplib"
+	"gogs.io/gogs/internal/maintenance"
 	"gogs.io/gogs/internal/template"
 )
 
@@ -63,14 +64,36 @@ var (
 	}
 )
 
+// isPusherAdmin returns true if the user who pushes is a site administrator.
+func isPusherAdmin() bool {
+	userID := com.StrTo(os.Getenv(db.ENV_AUTH_USER_ID)).MustInt64()
+	user, err := db.GetUserByID(userID)
+	if err != nil && !errors.IsUserNotExist(err) {
+		fail("Internal error", "GetUserByID [%d]: %v", userID, err)
+	}
+	return user != nil && user.IsAdmin
+}
+
 func runHookPreReceive(c *cli.Context) error {
 	if len(os.Getenv("SSH_ORIGINAL_COMMAND")) == 0 {
 		return nil
 	}
 	setup(c, "hooks/pre-receive.log", true)
 
+	// Maintenance mode
+	if state := maintenance.Current(); state.Enabled {
+		if !state.Bypass(state.AllowAdmins && isPusherAdmin()) {
+			fail(state.DisplayMessage(), "")
+		}
+	}
+
 	isWiki := strings.Contains(os.Getenv(db.ENV_REPO_CUSTOM_HOOKS_PATH), ".wiki.git/")
 
+	var (
+		repo *db.Repository
+		err  error
+	)
+
 	buf := bytes.NewBuffer(nil)
 	scanner := bufio.NewScanner(os.Stdin)
 	for scanner.Scan() {
@@ -89,49 +112,84 @@ func runHookPreReceive(c *cli.Context) error {
 		newCommitID := string(fields[1])
 		branchName := strings.TrimPrefix(string(fields[2]), git.BRANCH_PREFIX)
 
-		// Branch protection
+		// Rule sets of the organization, which are enforced in addition to
+		// protection of the branch.
 		repoID := com.StrTo(os.Getenv(db.ENV_REPO_ID)).MustInt64()
-		protectBranch, err := db.GetProtectBranchOfRepoByName(repoID, branchName)
-		if err != nil {
-			if errors.IsErrBranchNotExist(err) {
-				continue
+		if strings.HasPrefix(string(fields[2]), git.BRANCH_PREFIX) {
+			if repo == nil {
+				repo, err = db.GetRepositoryByID(repoID)
+				if err != nil {
+					fail("Internal error", "GetRepositoryByID [%d]: %v", repoID, err)
+				}
 			}
-			fail("Internal error", "GetProtectBranchOfRepoByName [repo_id: %d, branch: %s]: %v", repoID, branchName, err)
-		}
-		if !protectBranch.Protected {
-			continue
-		}
-
-		// Whitelist users can bypass require pull request check
-		bypassRequirePullRequest := false
-
-		// Check if user is in whitelist when enabled
-		userID := com.StrTo(os.Getenv(db.ENV_AUTH_USER_ID)).MustInt64()
-		if protectBranch.EnableWhitelist {
-			if !db.IsUserInProtectBranchWhitelist(repoID, userID, branchName) {
-				fail(fmt.Sprintf("Branch '%s' is protected and you are not in the push whitelist", branchName), "")
+			ruleSets, err := repo.OrgRuleSetsOfBranch(branchName)
+			if err != nil {
+				fail("Internal error", "OrgRuleSetsOfBranch [repo_id: %d, branch: %s]: %v", repoID, branchName, err)
+			}
+			err = db.CheckOrgRuleSetsForPush(repo.RepoPath(), ruleSets, branchName, oldCommitID, newCommitID)
+			if db.IsErrOrgRuleViolation(err) {
+				fail(err.Error(), "")
+			} else if err != nil {
+				fail("Internal error", "CheckOrgRuleSetsForPush [repo_id: %d, branch: %s]: %v", repoID, branchName, err)
 			}
 
-			bypassRequirePullRequest = true
-		}
-
-		// Check if branch allows direct push
-		if !bypassRequirePullRequest && protectBranch.RequirePullRequest {
-			fail(fmt.Sprintf("Branch '%s' is protected and commits must be merged through pull request", branchName), "")
+			// Commit lint rules of the repository
+			lintRules, err := db.GetCommitLintRules(repoID)
+			if err != nil {
+				fail("Internal error", "GetCommitLintRules [repo_id: %d]: %v", repoID, err)
+			}
+			if lintRules.EnforceOnPush {
+				results, err := repo.LintPushCommits(*lintRules, oldCommitID, newCommitID)
+				if err != nil {
+					fail("Internal error", "LintPushCommits [repo_id: %d, branch: %s]: %v", repoID, branchName, err)
+				} else if len(results) > 0 {
+					msg := fmt.Sprintf("Commit messages pushed to branch '%s' violate commit lint rules of the repository:", branchName)
+					for _, r := range results {
+						msg += fmt.Sprintf("\n  %s %s: %s", r.Commit.ID.String()[:10], r.Commit.Summary(), strings.Join(r.Violations, "; "))
+					}
+					fail(msg, "")
+				}
+			}
 		}
 
-		// check and deletion
-		if newCommitID == git.EMPTY_SHA {
-			fail(fmt.Sprintf("Branch '%s' is protected from deletion", branchName), "")
+		// Tag protection, new tags can be pushed but protected ones cannot be
+		// deleted or moved.
+		if strings.HasPrefix(string(fields[2]), git.TAG_PREFIX) {
+			if oldCommitID == git.EMPTY_SHA {
+				continue
+			}
+			tagName := strings.TrimPrefix(string(fields[2]), git.TAG_PREFIX)
+			protected, err := db.IsTagProtected(repoID, tagName)
+			if err != nil {
+				fail("Internal error", "IsTagProtected [repo_id: %d, tag: %s]: %v", repoID, tagName, err)
+			} else if !protected {
+				continue
+			}
+			if newCommitID == git.EMPTY_SHA {
+				fail(fmt.Sprintf("Tag '%s' is protected from deletion", tagName), "")
+			}
+			fail(fmt.Sprintf("Tag '%s' is protected from being overwritten", tagName), "")
 		}
 
-		// Check force push
-		output, err := git.NewCommand("rev-list", "--max-count=1", oldCommitID, "^"+newCommitID).
-			RunInDir(db.RepoPath(os.Getenv(db.ENV_REPO_OWNER_NAME), os.Getenv(db.ENV_REPO_NAME)))
-		if err != nil {
-			fail("Internal error", "Failed to detect force push: %v", err)
-		} else if len(output) > 0 {
-			fail(fmt.Sprintf("Branch '%s' is protected from force push", branchName), "")
+		// Branch protection. Whitelist users can bypass require pull request
+		// check, and so can site administrators for commits made from the web,
+		// which are allowed by Repository.CanDirectPush. Their Git pushes are
+		// checked as anyone's.
+		isWebPush := db.PushCredentialType(os.Getenv(db.ENV_AUTH_CREDENTIAL_TYPE)) == db.PushCredentialWeb
+		userID := com.StrTo(os.Getenv(db.ENV_AUTH_USER_ID)).MustInt64()
+		err = db.CheckProtectBranchForPush(db.ProtectBranchPush{
+			RepoID:                   repoID,
+			RepoPath:                 db.RepoPath(os.Getenv(db.ENV_REPO_OWNER_NAME), os.Getenv(db.ENV_REPO_NAME)),
+			Branch:                   branchName,
+			OldCommitID:              oldCommitID,
+			NewCommitID:              newCommitID,
+			UserID:                   userID,
+			BypassRequirePullRequest: isWebPush && isPusherAdmin(),
+		})
+		if db.IsErrProtectBranchViolation(err) {
+			fail(err.Error(), "")
+		} else if err != nil {
+			fail("Internal error", "CheckProtectBranchForPush [repo_id: %d, branch: %s]: %v", repoID, branchName, err)
 		}
 	}
 
@@ -190,6 +248,26 @@ func runHookUpdate(c *cli.Context) error {
 	return nil
 }
 
+// printPullRequestURL prints the URL to compose a pull request from the new
+// branch of the repository to the Git client, if pull requests are allowed and
+// the branch has none yet.
+func printPullRequestURL(repoID int64, branch string) {
+	repo, err := db.GetRepositoryByID(repoID)
+	if err != nil {
+		log.Error("Failed to get repository [id: %d]: %v", repoID, err)
+		return
+	}
+	link, err := repo.ComposePullRequestURL(branch)
+	if err != nil {
+		log.Error("Failed to compose pull request URL [repo_id: %d, branch: %s]: %v", repoID, branch, err)
+		return
+	} else if link == "" {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "\nCreate a pull request for '%s' by visiting:\n     %s\n\n", branch, conf.Server.ExternalURL+link)
+}
+
 func runHookPostReceive(c *cli.Context) error {
 	if len(os.Getenv("SSH_ORIGINAL_COMMAND")) == 0 {
 		return nil
@@ -202,19 +280,43 @@ func runHookPostReceive(c *cli.Context) error {
 
 	isWiki := strings.Contains(os.Getenv(db.ENV_REPO_CUSTOM_HOOKS_PATH), ".wiki.git/")
 
+	credential := db.PushCredential{
+		Type: db.PushCredentialType(os.Getenv(db.ENV_AUTH_CREDENTIAL_TYPE)),
+		ID:   com.StrTo(os.Getenv(db.ENV_AUTH_CREDENTIAL_ID)).MustInt64(),
+		Name: os.Getenv(db.ENV_AUTH_CREDENTIAL_NAME),
+	}
+	var pushLogs []*db.PushLog
+
 	buf := bytes.NewBuffer(nil)
 	scanner := bufio.NewScanner(os.Stdin)
 	for scanner.Scan() {
 		buf.Write(scanner.Bytes())
 		buf.WriteByte('\n')
 
-		// TODO: support news feeds for wiki
-		if isWiki {
+		fields := bytes.Fields(scanner.Bytes())
+		if len(fields) != 3 {
 			continue
 		}
 
-		fields := bytes.Fields(scanner.Bytes())
-		if len(fields) != 3 {
+		pushLogs = append(pushLogs, &db.PushLog{
+			RepoID:         com.StrTo(os.Getenv(db.ENV_REPO_ID)).MustInt64(),
+			PusherID:       com.StrTo(os.Getenv(db.ENV_AUTH_USER_ID)).MustInt64(),
+			PusherName:     os.Getenv(db.ENV_AUTH_USER_NAME),
+			CredentialType: credential.Type,
+			CredentialID:   credential.ID,
+			CredentialName: credential.Name,
+			RemoteAddr:     os.Getenv(db.ENV_REMOTE_ADDR),
+			IsWiki:         isWiki,
+			RefName:        string(fields[2]),
+			OldCommitID:    string(fields[0]),
+			NewCommitID:    string(fields[1]),
+		})
+		log.Info("Push to %s/%s [ref: %s, pusher: %q, credential: %s (%d), remote_addr: %s]",
+			os.Getenv(db.ENV_REPO_OWNER_NAME), os.Getenv(db.ENV_REPO_NAME), fields[2],
+			os.Getenv(db.ENV_AUTH_USER_NAME), credential.Type, credential.ID, os.Getenv(db.ENV_REMOTE_ADDR))
+
+		// TODO: support news feeds for wiki
+		if isWiki {
 			continue
 		}
 
@@ -231,6 +333,10 @@ func runHookPostReceive(c *cli.Context) error {
 			log.Error("PushUpdate: %v", err)
 		}
 
+		if options.OldCommitID == git.EMPTY_SHA && strings.HasPrefix(options.RefFullName, git.BRANCH_PREFIX) {
+			printPullRequestURL(com.StrTo(os.Getenv(db.ENV_REPO_ID)).MustInt64(), strings.TrimPrefix(options.RefFullName, git.BRANCH_PREFIX))
+		}
+
 		// Ask for running deliver hook and test pull request tasks
 		reqURL := conf.Server.LocalRootURL + options.RepoUserName + "/" + options.RepoName + "/tasks/trigger?branch=" +
 			template.EscapePound(strings.TrimPrefix(options.RefFullName, git.BRANCH_PREFIX)) +
@@ -251,6 +357,10 @@ func runHookPostReceive(c *cli.Context) error {
 		}
 	}
 
+	if err := db.AddPushLogs(pushLogs); err != nil {
+		log.Error("Failed to add push logs: %v", err)
+	}
+
 	customHooksPath := filepath.Join(os.Getenv(db.ENV_REPO_CUSTOM_HOOKS_PATH), "post-receive")
 	if !com.IsFile(customHooksPath) {
 		return nil
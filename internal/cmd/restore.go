@@ -5,10 +5,17 @@
 package cmd
 
 import (
+	stdzip "archive/zip"
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
+	"github.com/json-iterator/go"
 	"github.com/mcuadros/go-version"
 	"github.com/pkg/errors"
 	"github.com/unknwon/cae/zip"
@@ -19,6 +26,7 @@ import (
 
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/migrations"
 )
 
 var Restore = cli.Command{
@@ -29,7 +37,10 @@ The backup version must lower or equal to current Gogs version. You can also imp
 backup from other database engines, which is useful for database migrating.
 
 If corresponding files or database tables are not presented in the archive, they will
-be skipped and remain unchanged.`,
+be skipped and remain unchanged. Pending migrations are run after the database is imported,
+and Git hooks and the '.ssh/authorized_keys' file are regenerated for the new location.
+
+Use '--verify' to check integrity of the archive without restoring anything.`,
 	Action: runRestore,
 	Flags: []cli.Flag{
 		stringFlag("config, c", "", "Custom configuration file path"),
@@ -38,6 +49,8 @@ be skipped and remain unchanged.`,
 		stringFlag("from", "", "Path to backup archive"),
 		boolFlag("database-only", "Only import database"),
 		boolFlag("exclude-repos", "Exclude repositories"),
+		stringFlag("secrets", "", "Path to the config file that has secrets excluded from backup, default is '--config'"),
+		boolFlag("verify", "Only check integrity of the backup archive"),
 	},
 }
 
@@ -45,6 +58,170 @@ be skipped and remain unchanged.`,
 // format that is able to import.
 var lastSupportedVersionOfFormat = map[int]string{}
 
+// checkBackupMetadata returns an error if the backup archive described by the
+// metadata cannot be restored by this binary.
+func checkBackupMetadata(metadata *ini.File) error {
+	backupVersion := metadata.Section("").Key("GOGS_VERSION").MustString("999.0")
+	if version.Compare(conf.App.Version, backupVersion, "<") {
+		return fmt.Errorf("Current Gogs version is lower than backup version: %s < %s", conf.App.Version, backupVersion)
+	}
+	formatVersion := metadata.Section("").Key("VERSION").MustInt()
+	if formatVersion == 0 {
+		return errors.New("Failed to determine the backup format version from metadata: VERSION is not presented")
+	}
+	if formatVersion > _CURRENT_BACKUP_FORMAT_VERSION || lastSupportedVersionOfFormat[formatVersion] != "" {
+		return fmt.Errorf("Backup format version found is %d but this binary only supports %d\nThe last known version that is able to import your backup is %s",
+			formatVersion, _CURRENT_BACKUP_FORMAT_VERSION, lastSupportedVersionOfFormat[formatVersion])
+	}
+	if dbVersion := metadata.Section("").Key("DB_VERSION").MustInt64(); dbVersion > migrations.ExpectedVersion() {
+		return fmt.Errorf("Database schema version of backup is newer than this binary supports: %d > %d", dbVersion, migrations.ExpectedVersion())
+	}
+	return nil
+}
+
+// verifyZip reads all files in the zip archive to check their checksums, and
+// calls the function for every file to check its content. Files in nested
+// archives with name "repositories.zip" are checked as well.
+func verifyZip(r *stdzip.Reader, tmpDir string, check func(name string, r io.Reader) error) (files int, err error) {
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		files++
+
+		if path.Base(f.Name) == "repositories.zip" {
+			n, err := verifyNestedZip(f, tmpDir)
+			if err != nil {
+				return files, errors.Wrapf(err, "verify %q", f.Name)
+			}
+			files += n
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return files, errors.Wrapf(err, "open %q", f.Name)
+		}
+		if check != nil {
+			err = check(f.Name, rc)
+		}
+		if err == nil {
+			// Reading to the end is what verifies the checksum.
+			_, err = io.Copy(ioutil.Discard, rc)
+		}
+		rc.Close()
+		if err != nil {
+			return files, errors.Wrapf(err, "read %q", f.Name)
+		}
+	}
+	return files, nil
+}
+
+func verifyNestedZip(f *stdzip.File, tmpDir string) (int, error) {
+	tmp, err := ioutil.TempFile(tmpDir, "gogs-verify-")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	rc, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	_, err = io.Copy(tmp, rc)
+	rc.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	r, err := stdzip.NewReader(tmp, int64(f.UncompressedSize64))
+	if err != nil {
+		return 0, err
+	}
+	return verifyZip(r, tmpDir, nil)
+}
+
+// verifyBackup checks integrity of the backup archive, i.e. checksums of all
+// files, the metadata and the format of database dump.
+func verifyBackup(archive, tmpDir string) error {
+	r, err := stdzip.OpenReader(archive)
+	if err != nil {
+		return errors.Wrap(err, "open archive")
+	}
+	defer r.Close()
+
+	var metadata *ini.File
+	hasVersion := false
+	files, err := verifyZip(&r.Reader, tmpDir, func(name string, rd io.Reader) error {
+		switch {
+		case name == _ARCHIVE_ROOT_DIR+"/metadata.ini":
+			data, err := ioutil.ReadAll(rd)
+			if err != nil {
+				return err
+			}
+			metadata, err = ini.Load(data)
+			return err
+
+		case strings.HasPrefix(name, _ARCHIVE_ROOT_DIR+"/db/") && strings.HasSuffix(name, ".json"):
+			if path.Base(name) == "Version.json" {
+				hasVersion = true
+			}
+			scanner := bufio.NewScanner(rd)
+			scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+			for line := 1; scanner.Scan(); line++ {
+				if !jsoniter.Valid(scanner.Bytes()) {
+					return fmt.Errorf("invalid JSON at line %d", line)
+				}
+			}
+			return scanner.Err()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if metadata == nil {
+		return errors.New("File 'metadata.ini' is missing")
+	} else if !hasVersion {
+		return errors.New("Database dump is missing")
+	}
+	if err = checkBackupMetadata(metadata); err != nil {
+		return err
+	}
+	log.Info("Verified %d files, backup archive is created at %s by Gogs %s",
+		files, metadata.Section("").Key("DATE_TIME").String(), metadata.Section("").Key("GOGS_VERSION").String())
+	return nil
+}
+
+// fillSecrets sets secrets that are excluded from the archived configuration
+// file with the ones in the configuration file that is specified.
+func fillSecrets(archived, specified string) error {
+	opts := ini.LoadOptions{IgnoreInlineComment: true}
+	dst, err := ini.LoadSources(opts, archived)
+	if err != nil {
+		return errors.Wrapf(err, "load %q", archived)
+	}
+	src, err := ini.LoadSources(opts, specified)
+	if err != nil {
+		return errors.Wrapf(err, "load %q", specified)
+	}
+
+	for section, keys := range backupSecretKeys {
+		sec, err := dst.GetSection(section)
+		if err != nil {
+			continue
+		}
+		for _, key := range keys {
+			if sec.HasKey(key) && sec.Key(key).String() == "" {
+				sec.Key(key).SetValue(src.Section(section).Key(key).String())
+			}
+		}
+	}
+	return dst.SaveTo(archived)
+}
+
 func runRestore(c *cli.Context) error {
 	zip.Verbose = c.Bool("verbose")
 
@@ -53,6 +230,16 @@ func runRestore(c *cli.Context) error {
 		log.Fatal("'--tempdir' does not exist: %s", tmpDir)
 	}
 
+	if c.Bool("verify") {
+		log.Info("Verify backup: %s", c.String("from"))
+		if err := verifyBackup(c.String("from"), tmpDir); err != nil {
+			log.Fatal("Failed to verify backup archive: %v", err)
+		}
+		log.Info("Backup archive is valid!")
+		log.Stop()
+		return nil
+	}
+
 	log.Info("Restore backup from: %s", c.String("from"))
 	if err := zip.ExtractTo(c.String("from"), tmpDir); err != nil {
 		log.Fatal("Failed to extract backup archive: %v", err)
@@ -69,17 +256,8 @@ func runRestore(c *cli.Context) error {
 	if err != nil {
 		log.Fatal("Failed to load metadata '%s': %v", metaFile, err)
 	}
-	backupVersion := metadata.Section("").Key("GOGS_VERSION").MustString("999.0")
-	if version.Compare(conf.App.Version, backupVersion, "<") {
-		log.Fatal("Current Gogs version is lower than backup version: %s < %s", conf.App.Version, backupVersion)
-	}
-	formatVersion := metadata.Section("").Key("VERSION").MustInt()
-	if formatVersion == 0 {
-		log.Fatal("Failed to determine the backup format version from metadata '%s': %s", metaFile, "VERSION is not presented")
-	}
-	if formatVersion != _CURRENT_BACKUP_FORMAT_VERSION {
-		log.Fatal("Backup format version found is %d but this binary only supports %d\nThe last known version that is able to import your backup is %s",
-			formatVersion, _CURRENT_BACKUP_FORMAT_VERSION, lastSupportedVersionOfFormat[formatVersion])
+	if err = checkBackupMetadata(metadata); err != nil {
+		log.Fatal("%v", err)
 	}
 
 	// If config file is not present in backup, user must set this file via flag.
@@ -94,14 +272,42 @@ func runRestore(c *cli.Context) error {
 		customConf = configFile
 	}
 
+	// Secrets that are excluded from the config file in backup must be provided
+	// by another config file.
+	if metadata.Section("").Key("EXCLUDE_SECRETS").MustBool() && com.IsExist(configFile) {
+		secretsConf := c.String("secrets")
+		if secretsConf == "" {
+			secretsConf = c.String("config")
+		}
+		if secretsConf == "" {
+			log.Fatal("Secrets are excluded from backup, '--secrets' or '--config' must be specified")
+		}
+		if err = fillSecrets(configFile, secretsConf); err != nil {
+			log.Fatal("Failed to fill secrets of config file in backup: %v", err)
+		}
+	}
+
 	err = conf.Init(customConf)
 	if err != nil {
 		return errors.Wrap(err, "init configuration")
 	}
+	conf.InitLogging(true)
+
+	if err = db.SetEngine(); err != nil {
+		return errors.Wrap(err, "set engine")
+	}
 
-	db.SetEngine()
+	withRepos := !c.Bool("exclude-repos") && !c.Bool("database-only")
+	progress := &backupProgress{total: 2}
+	if !c.Bool("database-only") {
+		progress.total += 2
+	}
+	if withRepos {
+		progress.total++
+	}
 
 	// Database
+	progress.next("Importing database")
 	dbDir := path.Join(archivePath, "db")
 	if err = db.ImportDatabase(dbDir, c.Bool("verbose")); err != nil {
 		log.Fatal("Failed to import database: %v", err)
@@ -109,6 +315,7 @@ func runRestore(c *cli.Context) error {
 
 	// Custom files
 	if !c.Bool("database-only") {
+		progress.next("Restoring custom files")
 		if com.IsExist(conf.CustomDir()) {
 			if err = os.Rename(conf.CustomDir(), conf.CustomDir()+".bak"); err != nil {
 				log.Fatal("Failed to backup current 'custom': %v", err)
@@ -117,35 +324,69 @@ func runRestore(c *cli.Context) error {
 		if err = os.Rename(filepath.Join(archivePath, "custom"), conf.CustomDir()); err != nil {
 			log.Fatal("Failed to import 'custom': %v", err)
 		}
+
+		// Git hooks refer to the config file, which has been moved from the
+		// archive to the custom directory.
+		if !c.IsSet("config") {
+			conf.CustomConf = filepath.Join(conf.CustomDir(), "conf", "app.ini")
+		}
 	}
 
 	// Data files
 	if !c.Bool("database-only") {
-		_ = os.MkdirAll(conf.Server.AppDataPath, os.ModePerm)
-		for _, dir := range []string{"attachments", "avatars", "repo-avatars"} {
+		progress.next("Restoring data files")
+		for _, dir := range backupDataDirs() {
 			// Skip if backup archive does not have corresponding data
-			srcPath := filepath.Join(archivePath, "data", dir)
+			srcPath := filepath.Join(archivePath, "data", dir.Name)
 			if !com.IsDir(srcPath) {
 				continue
 			}
 
-			dirPath := filepath.Join(conf.Server.AppDataPath, dir)
-			if com.IsExist(dirPath) {
-				if err = os.Rename(dirPath, dirPath+".bak"); err != nil {
+			if com.IsExist(dir.Path) {
+				if err = os.Rename(dir.Path, dir.Path+".bak"); err != nil {
 					log.Fatal("Failed to backup current 'data': %v", err)
 				}
 			}
-			if err = os.Rename(srcPath, dirPath); err != nil {
+			_ = os.MkdirAll(filepath.Dir(dir.Path), os.ModePerm)
+			if err = os.Rename(srcPath, dir.Path); err != nil {
 				log.Fatal("Failed to import 'data': %v", err)
 			}
 		}
 	}
 
-	// Repositories
+	// Repositories, which are packed relative to the root in portable archives,
+	// and with the directory of the root otherwise.
 	reposPath := filepath.Join(archivePath, "repositories.zip")
-	if !c.Bool("exclude-repos") && !c.Bool("database-only") && com.IsExist(reposPath) {
-		if err := zip.ExtractTo(reposPath, filepath.Dir(conf.Repository.Root)); err != nil {
-			log.Fatal("Failed to extract 'repositories.zip': %v", err)
+	if withRepos {
+		progress.next("Restoring repositories to %q", conf.Repository.Root)
+		if com.IsExist(reposPath) {
+			reposDir := filepath.Dir(conf.Repository.Root)
+			if metadata.Section("").Key("PORTABLE").MustBool() {
+				reposDir = conf.Repository.Root
+			}
+			_ = os.MkdirAll(reposDir, os.ModePerm)
+			if err := zip.ExtractTo(reposPath, reposDir); err != nil {
+				log.Fatal("Failed to extract 'repositories.zip': %v", err)
+			}
+		}
+	}
+
+	progress.next("Running migrations and regenerating files")
+	if err = db.NewEngine(); err != nil {
+		log.Fatal("Failed to migrate database: %v", err)
+	}
+	if withRepos && com.IsExist(reposPath) {
+		_, failures, err := db.RegenerateRepositoryHooks()
+		if err != nil {
+			log.Fatal("Failed to regenerate Git hooks: %v", err)
+		}
+		for _, failure := range failures {
+			log.Warn("Failed to regenerate Git hooks of %s", failure)
+		}
+	}
+	if !conf.SSH.Disabled && !conf.SSH.StartBuiltinServer {
+		if err = db.RewriteAuthorizedKeys(); err != nil {
+			log.Fatal("Failed to rewrite '.ssh/authorized_keys': %v", err)
 		}
 	}
 
@@ -107,7 +107,7 @@ func parseSSHCmd(cmd string) (string, string) {
 	return ss[0], strings.Replace(ss[1], "'/", "'", 1)
 }
 
-func checkDeployKey(key *db.PublicKey, repo *db.Repository) {
+func checkDeployKey(key *db.PublicKey, repo *db.Repository) *db.DeployKey {
 	// Check if this deploy key belongs to current repository.
 	if !db.HasDeployKey(key.ID, repo.ID) {
 		fail("Key access denied", "Deploy key access denied: [key_id: %d, repo_id: %d]", key.ID, repo.ID)
@@ -123,6 +123,13 @@ func checkDeployKey(key *db.PublicKey, repo *db.Repository) {
 	if err = db.UpdateDeployKey(deployKey); err != nil {
 		fail("Internal error", "UpdateDeployKey: %v", err)
 	}
+	return deployKey
+}
+
+// sshRemoteAddr returns the IP address of the SSH client, which is set by both
+// OpenSSH and the builtin SSH server.
+func sshRemoteAddr() string {
+	return strings.SplitN(os.Getenv("SSH_CONNECTION"), " ", 2)[0]
 }
 
 var (
@@ -170,7 +177,14 @@ func runServ(c *cli.Context) error {
 		fail("Internal error", "Failed to get repository owner '%s': %v", ownerName, err)
 	}
 
+	// Clone URLs of renamed repositories keep working, users are warned once
+	// they are allowed to access the repository.
+	isRenamed := false
 	repo, err := db.GetRepositoryByName(owner.ID, repoName)
+	if errors.IsRepoNotExist(err) {
+		repo, err = db.GetRepositoryByOldName(owner.ID, repoName)
+		isRenamed = err == nil
+	}
 	if err != nil {
 		if errors.IsRepoNotExist(err) {
 			fail(_ACCESS_DENIED_MESSAGE, "Repository does not exist: %s/%s", owner.Name, repoName)
@@ -197,13 +211,19 @@ func runServ(c *cli.Context) error {
 		fail("Invalid key ID", "Invalid key ID '%s': %v", c.Args()[0], err)
 	}
 
+	credential := db.PushCredential{
+		Type: db.PushCredentialSSHKey,
+		ID:   key.ID,
+		Name: key.Fingerprint,
+	}
 	if requestMode == db.ACCESS_MODE_WRITE || repo.IsPrivate {
 		// Check deploy key or user key.
 		if key.IsDeployKey() {
 			if key.Mode < requestMode {
 				fail("Key permission denied", "Cannot push with deployment key: %d", key.ID)
 			}
-			checkDeployKey(key, repo)
+			credential.Type = db.PushCredentialDeployKey
+			credential.ID = checkDeployKey(key, repo).ID
 		} else {
 			user, err = db.GetUserByKeyID(key.ID)
 			if err != nil {
@@ -224,6 +244,14 @@ func runServ(c *cli.Context) error {
 					"User '%s' does not have level '%v' access to repository '%s'",
 					user.Name, requestMode, repoFullName)
 			}
+
+			restriction, err := db.GetPathRestriction(repo, user)
+			if err != nil {
+				fail("Internal error", "Failed to get path restriction: %v", err)
+			} else if restriction != nil {
+				fail("Your access to this repository is limited to some paths, which Git cannot enforce. Please use the web interface or the API instead.",
+					"User '%s' is limited to some paths of repository '%s'", user.Name, repoFullName)
+			}
 		}
 	} else {
 		// Check if the key can access to the repository in case of it is a deploy key (a deploy keys != user key).
@@ -248,11 +276,26 @@ func runServ(c *cli.Context) error {
 		}
 	}
 
+	if user != nil {
+		if err = db.UpdateCollaboratorAccess(repo.ID, user.ID); err != nil {
+			log.Error("Failed to update collaborator access [repo_id: %d, user_id: %d]: %v", repo.ID, user.ID, err)
+		}
+		if err = db.UpdateUserLastSeen(user.ID); err != nil {
+			log.Error("Failed to update last seen of user [%d]: %v", user.ID, err)
+		}
+	}
+
 	// Special handle for Windows.
 	if conf.IsWindowsRuntime() {
 		verb = strings.Replace(verb, "-", " ", 1)
 	}
 
+	if isRenamed {
+		// Keep suffixes of the old name, e.g. ".wiki.git".
+		repoFullName = owner.LowerName + "/" + repo.LowerName + repoFields[1][len(repoName):]
+		fmt.Fprintf(os.Stderr, "Gogs: Repository has been renamed to %s/%s, please update the remote URL.\n", owner.Name, repo.Name)
+	}
+
 	var gitCmd *exec.Cmd
 	verbs := strings.Split(verb, " ")
 	if len(verbs) == 2 {
@@ -262,12 +305,14 @@ func runServ(c *cli.Context) error {
 	}
 	if requestMode == db.ACCESS_MODE_WRITE {
 		gitCmd.Env = append(os.Environ(), db.ComposeHookEnvs(db.ComposeHookEnvsOptions{
-			AuthUser:  user,
-			OwnerName: owner.Name,
-			OwnerSalt: owner.Salt,
-			RepoID:    repo.ID,
-			RepoName:  repo.Name,
-			RepoPath:  repo.RepoPath(),
+			AuthUser:   user,
+			Credential: credential,
+			RemoteAddr: sshRemoteAddr(),
+			OwnerName:  owner.Name,
+			OwnerSalt:  owner.Salt,
+			RepoID:     repo.ID,
+			RepoName:   repo.Name,
+			RepoPath:   repo.RepoPath(),
 		})...)
 	}
 	gitCmd.Dir = conf.Repository.Root
@@ -278,5 +323,16 @@ func runServ(c *cli.Context) error {
 		fail("Internal error", "Failed to execute git command: %v", err)
 	}
 
+	// Count clones and fetches, but not of wikis.
+	if strings.HasSuffix(verb, "upload-pack") && !strings.HasSuffix(strings.TrimSuffix(repoFields[1], ".git"), ".wiki") {
+		visitor := db.TrafficVisitorByKey(key.ID)
+		if user != nil {
+			visitor = db.TrafficVisitor(user.ID, "")
+		}
+		if err = db.RecordRepoClone(repo.ID, visitor); err != nil {
+			log.Error("Failed to record clone [repo_id: %d]: %v", repo.ID, err)
+		}
+	}
+
 	return nil
 }
@@ -5,9 +5,9 @@
 package cmd
 
 import (
+	gocontext "context"
 	"crypto/tls"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
 	"net/http/fcgi"
@@ -23,6 +23,7 @@ import (
 	"github.com/go-macaron/i18n"
 	"github.com/go-macaron/session"
 	"github.com/go-macaron/toolbox"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/unknwon/com"
 	"github.com/urfave/cli"
@@ -35,6 +36,8 @@ import (
 	"gogs.io/gogs/internal/context"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/form"
+	"gogs.io/gogs/internal/graceful"
+	"gogs.io/gogs/internal/maintenance"
 	"gogs.io/gogs/internal/osutil"
 	"gogs.io/gogs/internal/route"
 	"gogs.io/gogs/internal/route/admin"
@@ -61,7 +64,9 @@ and it takes care of all the other things for you`,
 // newMacaron initializes Macaron instance.
 func newMacaron() *macaron.Macaron {
 	m := macaron.New()
-	if !conf.Server.DisableRouterLog {
+	if conf.RequestLog.Enabled {
+		m.Use(context.RequestLogger())
+	} else if !conf.Server.DisableRouterLog {
 		m.Use(macaron.Logger())
 	}
 	m.Use(macaron.Recovery())
@@ -109,11 +114,13 @@ func newMacaron() *macaron.Macaron {
 	renderOpt := macaron.RenderOptions{
 		Directory:         filepath.Join(conf.WorkDir(), "templates"),
 		AppendDirectories: []string{filepath.Join(conf.CustomDir(), "templates")},
+		Extensions:        []string{".tmpl", ".html"},
 		Funcs:             template.FuncMap(),
 		IndentJSON:        macaron.Env != macaron.PROD,
 	}
 	if !conf.Server.LoadAssetsFromDisk {
-		renderOpt.TemplateFileSystem = templates.NewTemplateFileSystem("", renderOpt.AppendDirectories[0])
+		// Plain text templates of mails are not HTML templates.
+		renderOpt.TemplateFileSystem = templates.NewTemplateFileSystem("", renderOpt.AppendDirectories[0], renderOpt.Extensions...)
 	}
 	m.Use(macaron.Renderer(renderOpt))
 
@@ -167,6 +174,7 @@ func newMacaron() *macaron.Macaron {
 		},
 	}))
 	m.Use(context.Contexter())
+	m.Use(context.Maintenance())
 	return m
 }
 
@@ -202,6 +210,9 @@ func runWeb(c *cli.Context) error {
 	m.Combo("/install", route.InstallInit).Get(route.Install).
 		Post(bindIgnErr(form.Install{}), route.InstallPost)
 	m.Get("/^:type(issues|pulls)$", reqSignIn, user.Issues)
+	// Users deactivated by lifecycle policies cannot sign in, so the link works
+	// without signing in.
+	m.Get("/lifecycle/keep/:token", route.KeepActive)
 
 	// ***** START: User *****
 	m.Group("/user", func() {
@@ -227,11 +238,13 @@ func runWeb(c *cli.Context) error {
 		m.Combo("/email").Get(user.SettingsEmails).
 			Post(bindIgnErr(form.AddEmail{}), user.SettingsEmailPost)
 		m.Post("/email/delete", user.DeleteEmail)
+		m.Post("/email/notification", user.SettingsEmailNotificationPost)
 		m.Get("/password", user.SettingsPassword)
 		m.Post("/password", bindIgnErr(form.ChangePassword{}), user.SettingsPasswordPost)
 		m.Combo("/ssh").Get(user.SettingsSSHKeys).
 			Post(bindIgnErr(form.AddSSHKey{}), user.SettingsSSHKeysPost)
 		m.Post("/ssh/delete", user.DeleteSSHKey)
+		m.Post("/clone_protocol", user.SettingsCloneProtocolPost)
 		m.Group("/security", func() {
 			m.Get("", user.SettingsSecurity)
 			m.Combo("/two_factor_enable").Get(user.SettingsTwoFactorEnable).
@@ -251,6 +264,8 @@ func runWeb(c *cli.Context) error {
 		m.Combo("/applications").Get(user.SettingsApplications).
 			Post(bindIgnErr(form.NewAccessToken{}), user.SettingsApplicationsPost)
 		m.Post("/applications/delete", user.SettingsDeleteApplication)
+		m.Post("/applications/feed_token", user.SettingsRegenerateFeedToken)
+		m.Post("/applications/feed_token/delete", user.SettingsRevokeFeedToken)
 		m.Route("/delete", "GET,POST", user.SettingsDelete)
 	}, reqSignIn, func(c *context.Context) {
 		c.Data["PageIsUserSettings"] = true
@@ -274,6 +289,9 @@ func runWeb(c *cli.Context) error {
 		m.Get("/config", admin.Config)
 		m.Post("/config/test_mail", admin.SendTestMail)
 		m.Get("/monitor", admin.Monitor)
+		m.Combo("/maintenance").Get(admin.Maintenance).Post(bindIgnErr(form.AdminMaintenance{}), admin.MaintenancePost)
+		m.Combo("/lifecycle").Get(admin.Lifecycle).Post(bindIgnErr(form.AdminLifecyclePolicies{}), admin.LifecyclePost)
+		m.Combo("/site-content").Get(admin.SiteContent).Post(bindIgnErr(form.AdminSiteContent{}), admin.SiteContentPost)
 
 		m.Group("/users", func() {
 			m.Get("", admin.Users)
@@ -286,6 +304,8 @@ func runWeb(c *cli.Context) error {
 			m.Get("", admin.Organizations)
 		})
 
+		m.Get("/bots", admin.Bots)
+
 		m.Group("/repos", func() {
 			m.Get("", admin.Repos)
 			m.Post("/delete", admin.DeleteRepo)
@@ -315,7 +335,7 @@ func runWeb(c *cli.Context) error {
 			m.Get("/stars", user.Stars)
 		}, context.InjectParamsUser())
 
-		m.Get("/attachments/:uuid", func(c *context.Context) {
+		serveAttachment := func(c *context.Context, preview bool) {
 			attach, err := db.GetAttachmentByUUID(c.Params(":uuid"))
 			if err != nil {
 				c.NotFoundOrServerError("GetAttachmentByUUID", db.IsErrAttachmentNotExist, err)
@@ -325,20 +345,51 @@ func runWeb(c *cli.Context) error {
 				return
 			}
 
-			fr, err := os.Open(attach.LocalPath())
+			canView, err := attach.CanBeViewedBy(c.User)
+			if err != nil {
+				c.ServerError("check attachment visibility", err)
+				return
+			} else if !canView {
+				c.NotFound()
+				return
+			}
+
+			// Fall back to the original when no preview is generated for the attachment.
+			localPath := attach.LocalPath()
+			if preview && com.IsFile(attach.PreviewPath()) {
+				localPath = attach.PreviewPath()
+			}
+
+			fr, err := os.Open(localPath)
 			if err != nil {
 				c.ServerError("open attachment file", err)
 				return
 			}
 			defer fr.Close()
 
+			fi, err := fr.Stat()
+			if err != nil {
+				c.ServerError("stat attachment file", err)
+				return
+			}
+
 			c.Header().Set("Cache-Control", "public,max-age=86400")
 			c.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, attach.Name))
 
-			if _, err = io.Copy(c.Resp, fr); err != nil {
-				c.ServerError("copy from file to response", err)
-				return
+			// Serve with support of range requests so that interrupted downloads
+			// of large assets can be resumed.
+			http.ServeContent(c.Resp, c.Req.Request, attach.Name, fi.ModTime(), fr)
+
+			// HEAD requests and responses without content are not downloads.
+			if !preview && c.Req.Method == http.MethodGet {
+				db.CountAttachmentDownload(attach, c.Resp.Status(), c.Req.Header.Get("Range"))
 			}
+		}
+		m.Get("/attachments/:uuid", func(c *context.Context) {
+			serveAttachment(c, false)
+		})
+		m.Get("/attachments/:uuid/preview", func(c *context.Context) {
+			serveAttachment(c, true)
 		})
 		m.Post("/issues/attachments", repo.UploadIssueAttachment)
 		m.Post("/releases/attachments", repo.UploadReleaseAttachment)
@@ -373,6 +424,7 @@ func runWeb(c *cli.Context) error {
 			m.Get("/members/action/:action", org.MembersAction)
 
 			m.Get("/teams", org.Teams)
+			m.Get("/dependencies", org.Dependencies)
 		}, context.OrgAssignment(true))
 
 		m.Group("/:org", func() {
@@ -408,6 +460,38 @@ func runWeb(c *cli.Context) error {
 					m.Post("/slack/:id", bindIgnErr(form.NewSlackHook{}), repo.SlackHooksEditPost)
 					m.Post("/discord/:id", bindIgnErr(form.NewDiscordHook{}), repo.DiscordHooksEditPost)
 					m.Post("/dingtalk/:id", bindIgnErr(form.NewDingtalkHook{}), repo.DingtalkHooksEditPost)
+					m.Post("/:id/payload_version", repo.WebhookPayloadVersionPost)
+				})
+
+				m.Combo("/reminders").Get(repo.ReviewReminders).
+					Post(bindIgnErr(form.ReviewReminder{}), repo.ReviewRemindersPost)
+				m.Group("/secrets", func() {
+					m.Combo("").Get(repo.SettingsSecrets).
+						Post(bindIgnErr(form.Secret{}), repo.SettingsSecretsPost)
+					m.Post("/delete", repo.DeleteSecret)
+				})
+				m.Combo("/repositories").Get(org.RepoPolicy).
+					Post(bindIgnErr(form.OrgRepoPolicy{}), org.RepoPolicyPost)
+				m.Group("/rules", func() {
+					m.Get("", org.RuleSets)
+					m.Combo("/new").Get(org.NewRuleSet).
+						Post(bindIgnErr(form.OrgRuleSet{}), org.NewRuleSetPost)
+					m.Post("/delete", org.DeleteRuleSet)
+					m.Post("/exemptions/:id", org.ReviewRuleSetExemption)
+					m.Combo("/:id").Get(org.EditRuleSet).
+						Post(bindIgnErr(form.OrgRuleSet{}), org.EditRuleSetPost)
+				})
+
+				m.Group("/bots", func() {
+					m.Combo("").Get(org.Bots).
+						Post(bindIgnErr(form.NewBot{}), org.BotsPost)
+					m.Post("/delete", org.DeleteBot)
+					m.Group("/:botname", func() {
+						m.Get("", org.Bot)
+						m.Post("/grants", bindIgnErr(form.BotGrant{}), org.BotGrantPost)
+						m.Post("/tokens", bindIgnErr(form.NewAccessToken{}), org.BotTokenPost)
+						m.Post("/tokens/delete", org.DeleteBotToken)
+					})
 				})
 
 				m.Route("/delete", "GET,POST", org.SettingsDelete)
@@ -426,6 +510,8 @@ func runWeb(c *cli.Context) error {
 		m.Post("/migrate", bindIgnErr(form.MigrateRepo{}), repo.MigratePost)
 		m.Combo("/fork/:repoid").Get(repo.Fork).
 			Post(bindIgnErr(form.CreateRepo{}), repo.ForkPost)
+		m.Combo("/invitations/:token").Get(repo.AcceptInvite).
+			Post(repo.AcceptInvitePost)
 	}, reqSignIn)
 
 	m.Group("/:username/:reponame", func() {
@@ -438,11 +524,21 @@ func runWeb(c *cli.Context) error {
 			m.Group("/collaboration", func() {
 				m.Combo("").Get(repo.SettingsCollaboration).Post(repo.SettingsCollaborationPost)
 				m.Post("/access_mode", repo.ChangeCollaborationAccessMode)
+				m.Post("/paths", bindIgnErr(form.CollaborationPaths{}), repo.ChangeCollaborationPaths)
 				m.Post("/delete", repo.DeleteCollaboration)
+				m.Post("/invites/access_mode", repo.ChangeInviteAccessMode)
+				m.Post("/invites/resend", repo.ResendInvite)
+				m.Post("/invites/revoke", repo.RevokeInvite)
 			})
 			m.Group("/branches", func() {
 				m.Get("", repo.SettingsBranches)
 				m.Post("/default_branch", repo.UpdateDefaultBranch)
+				m.Post("/exemptions", bindIgnErr(form.OrgRuleSetExemption{}), repo.RequestRuleSetExemption)
+				m.Post("/tags", repo.AddProtectedTag)
+				m.Post("/tags/delete", repo.DeleteProtectedTag)
+				m.Post("/commit_lint", bindIgnErr(form.CommitLintRules{}), repo.UpdateCommitLintRules)
+				m.Combo("/pattern").Get(repo.SettingsProtectedBranch).
+					Post(bindIgnErr(form.ProtectBranch{}), repo.SettingsProtectedBranchPost)
 				m.Combo("/*").Get(repo.SettingsProtectedBranch).
 					Post(bindIgnErr(form.ProtectBranch{}), repo.SettingsProtectedBranchPost)
 			}, func(c *context.Context) {
@@ -469,10 +565,12 @@ func runWeb(c *cli.Context) error {
 					m.Get("", repo.WebHooksEdit)
 					m.Post("/test", repo.TestWebhook)
 					m.Post("/redelivery", repo.RedeliveryWebhook)
+					m.Post("/payload_version", repo.WebhookPayloadVersionPost)
 				})
 
 				m.Group("/git", func() {
 					m.Get("", repo.SettingsGitHooks)
+					m.Post("/restore", repo.SettingsGitHooksRestorePost)
 					m.Combo("/:name").Get(repo.SettingsGitHooksEdit).
 						Post(repo.SettingsGitHooksEditPost)
 				}, context.GitHookService())
@@ -484,12 +582,40 @@ func runWeb(c *cli.Context) error {
 				m.Post("/delete", repo.DeleteDeployKey)
 			})
 
+			m.Get("/export", repo.SettingsExport)
+			m.Combo("/import").Get(repo.SettingsImport).
+				Post(bindIgnErr(form.ImportRepoSettings{}), repo.SettingsImportPost)
+			m.Combo("/reminders").Get(repo.ReviewReminders).
+				Post(bindIgnErr(form.ReviewReminder{}), repo.ReviewRemindersPost)
+			m.Group("/secrets", func() {
+				m.Combo("").Get(repo.SettingsSecrets).
+					Post(bindIgnErr(form.Secret{}), repo.SettingsSecretsPost)
+				m.Post("/delete", repo.DeleteSecret)
+			})
+			m.Combo("/auto-lock").Get(repo.IssueAutoLock).
+				Post(bindIgnErr(form.IssueAutoLock{}), repo.IssueAutoLockPost)
+			m.Group("/tasks", func() {
+				m.Get("", repo.SettingsScheduledTasks)
+				m.Post("/cancel", repo.CancelScheduledTask)
+			})
+			m.Get("/push-log", repo.SettingsPushLog)
+
 		}, func(c *context.Context) {
 			c.Data["PageIsSettings"] = true
 		})
 	}, reqSignIn, context.RepoAssignment(), reqRepoAdmin, context.RepoRef())
 
 	m.Post("/:username/:reponame/action/:action", reqSignIn, context.RepoAssignment(), repo.Action)
+	m.Group("/:username/:reponame/mute-schedule", func() {
+		m.Combo("").Get(repo.MuteSchedule).
+			Post(bindIgnErr(form.MuteSchedule{}), repo.MuteSchedulePost)
+		m.Post("/delete", repo.DeleteMuteSchedulePost)
+	}, reqSignIn, context.RepoAssignment())
+	m.Group("/:username/:reponame/watch-paths", func() {
+		m.Combo("").Get(repo.WatchPaths).
+			Post(bindIgnErr(form.PathWatch{}), repo.WatchPathsPost)
+		m.Post("/delete", repo.DeleteWatchPathPost)
+	}, reqSignIn, context.RepoAssignment())
 	m.Group("/:username/:reponame", func() {
 		m.Get("/issues", repo.RetrieveLabels, repo.Issues)
 		m.Get("/issues/:index", repo.ViewIssue)
@@ -502,11 +628,13 @@ func runWeb(c *cli.Context) error {
 		m.Group("/issues", func() {
 			m.Combo("/new", repo.MustEnableIssues).Get(context.RepoRef(), repo.NewIssue).
 				Post(bindIgnErr(form.NewIssue{}), repo.NewIssuePost)
+			m.Get("/suggestions", repo.MustEnableIssues, repo.IssueSuggestions)
 
 			m.Group("/:index", func() {
 				m.Post("/title", repo.UpdateIssueTitle)
 				m.Post("/content", repo.UpdateIssueContent)
 				m.Combo("/comments").Post(bindIgnErr(form.CreateComment{}), repo.NewComment)
+				m.Post("/duplicate", bindIgnErr(form.MarkDuplicate{}), repo.MarkIssueDuplicate)
 			})
 		})
 		m.Group("/comments/:id", func() {
@@ -520,6 +648,10 @@ func runWeb(c *cli.Context) error {
 			m.Get("/_pages", repo.WikiPages)
 		}, repo.MustEnableWiki, context.RepoRef())
 	}, ignSignIn, context.RepoAssignment(false, true))
+	m.Group("/:username/:reponame", func() {
+		m.Get("/releases", repo.MustBeNotBare, context.RepoRef(), repo.Releases)
+		m.Get("/releases.atom", repo.ReleasesFeed)
+	}, ignSignIn, context.RepoAssignment(false, false, true))
 
 	m.Group("/:username/:reponame", func() {
 		// FIXME: should use different URLs but mostly same logic for comments of issue and pull reuqest.
@@ -529,6 +661,8 @@ func runWeb(c *cli.Context) error {
 				m.Post("/label", repo.UpdateIssueLabel)
 				m.Post("/milestone", repo.UpdateIssueMilestone)
 				m.Post("/assignee", repo.UpdateIssueAssignee)
+				m.Post("/lock", bindIgnErr(form.LockIssue{}), repo.LockIssue)
+				m.Post("/unlock", repo.UnlockIssue)
 			}, reqRepoWriter)
 		})
 		m.Group("/labels", func() {
@@ -552,6 +686,7 @@ func runWeb(c *cli.Context) error {
 			m.Post("/delete", repo.DeleteRelease)
 			m.Get("/edit/*", repo.EditRelease)
 			m.Post("/edit/*", bindIgnErr(form.EditRelease{}), repo.EditReleasePost)
+			m.Get("/stats/*", repo.ReleaseStats)
 		}, repo.MustBeNotBare, reqRepoWriter, func(c *context.Context) {
 			c.Data["PageIsViewFiles"] = true
 		})
@@ -562,6 +697,8 @@ func runWeb(c *cli.Context) error {
 		// which should be /org1/test-repo/compare/master...develop
 		m.Combo("/compare/*", repo.MustAllowPulls).Get(repo.CompareAndPullRequest).
 			Post(bindIgnErr(form.NewIssue{}), repo.CompareAndPullRequestPost)
+		m.Post("/sync-fork", repo.MustBeNotBare, reqRepoWriter, repo.SyncForkPost)
+		m.Post("/recent-push/dismiss", repo.DismissRecentPushPost)
 
 		m.Group("", func() {
 			m.Combo("/_edit/*").Get(repo.EditFile).
@@ -595,7 +732,6 @@ func runWeb(c *cli.Context) error {
 
 	m.Group("/:username/:reponame", func() {
 		m.Group("", func() {
-			m.Get("/releases", repo.MustBeNotBare, repo.Releases)
 			m.Get("/pulls", repo.RetrieveLabels, repo.Pulls)
 			m.Get("/pulls/:index", repo.ViewPull)
 		}, context.RepoRef())
@@ -623,19 +759,34 @@ func runWeb(c *cli.Context) error {
 		m.Group("/pulls/:index", func() {
 			m.Get("/commits", context.RepoRef(), repo.ViewPullCommits)
 			m.Get("/files", context.RepoRef(), repo.ViewPullFiles)
+			m.Post("/files/reviewed", reqSignIn, repo.MarkPullFileReviewed)
+			m.Post("/code_owners", reqSignIn, repo.ReviewPullCodeOwners)
 			m.Post("/merge", reqRepoWriter, repo.MergePullRequest)
+			m.Post("/close-merged-elsewhere", reqRepoWriter, repo.ClosePullAsMergedElsewhere)
 		}, repo.MustAllowPulls)
 
 		m.Group("", func() {
 			m.Get("/src/*", repo.Home)
+			m.Get("/last-commits/*", repo.LastCommits)
 			m.Get("/raw/*", repo.SingleDownload)
-			m.Get("/commits/*", repo.RefCommits)
 			m.Get("/commit/:sha([a-f0-9]{7,40})$", repo.Diff)
 			m.Get("/forks", repo.Forks)
+			m.Get("/insights", repo.Insights)
+			m.Get("/insights/*", repo.Insights)
+			m.Get("/dependencies", repo.Dependencies)
 		}, repo.MustBeNotBare, context.RepoRef())
+		m.Get("/commits/*", repo.MustBeNotBare, repo.CommitsFeed, context.RepoRef(), repo.RefCommits)
 		m.Get("/commit/:sha([a-f0-9]{7,40})\\.:ext(patch|diff)", repo.MustBeNotBare, repo.RawDiff)
 
 		m.Get("/compare/:before([a-z0-9]{40})\\.\\.\\.:after([a-z0-9]{40})", repo.MustBeNotBare, context.RepoRef(), repo.CompareDiff)
+
+		m.Get("/issues.atom", repo.IssuesFeed)
+
+		m.Group("/badges", func() {
+			m.Get("/status/*", repo.MustBeNotBare, repo.StatusBadge)
+			m.Get("/release.svg", repo.ReleaseBadge)
+			m.Get("/issues.svg", repo.IssuesBadge)
+		})
 	}, ignSignIn, context.RepoAssignment())
 	m.Group("/:username/:reponame", func() {
 		m.Get("/stars", repo.Stars)
@@ -666,7 +817,21 @@ func runWeb(c *cli.Context) error {
 	}, ignSignIn)
 
 	m.Group("/-", func() {
+		m.Get("/healthz", func(c *context.Context) {
+			if graceful.IsDraining() {
+				c.PlainText(http.StatusServiceUnavailable, []byte("draining"))
+				return
+			}
+			// The instance is still serving reads in maintenance mode.
+			if maintenance.Current().Enabled {
+				c.PlainText(http.StatusOK, []byte("maintenance"))
+				return
+			}
+			c.PlainText(http.StatusOK, []byte("ok"))
+		})
+
 		if conf.Prometheus.Enabled {
+			registerGitOperationMetrics()
 			m.Get("/metrics", func(c *context.Context) {
 				if !conf.Prometheus.EnableBasicAuth {
 					return
@@ -705,9 +870,30 @@ func runWeb(c *cli.Context) error {
 		log.Info("Listen on %v://%s%s", conf.Server.Protocol, listenAddr, conf.Server.Subpath)
 	}
 
+	// FastCGI is served over standard input and cannot be drained.
+	if conf.Server.Protocol == "fcgi" {
+		if err = fcgi.Serve(nil, m); err != nil {
+			log.Fatal("Failed to start server: %v", err)
+		}
+		return nil
+	}
+
+	server := &http.Server{Handler: m}
+	graceful.OnShutdown(func(ctx gocontext.Context) {
+		if err := server.Shutdown(ctx); err != nil {
+			log.Error("Failed to shut down server: %v", err)
+		}
+	})
+	go graceful.HandleSignals(conf.Server.ShutdownDrainTimeout)
+
+	var listener net.Listener
 	switch conf.Server.Protocol {
 	case "http":
-		err = http.ListenAndServe(listenAddr, m)
+		listener, err = graceful.Listen("tcp", listenAddr)
+		if err != nil {
+			log.Fatal("Failed to listen on %q: %v", listenAddr, err)
+		}
+		err = server.Serve(listener)
 
 	case "https":
 		tlsMinVersion := tls.VersionTLS12
@@ -721,54 +907,76 @@ func runWeb(c *cli.Context) error {
 		case "TLS10":
 			tlsMinVersion = tls.VersionTLS10
 		}
-		server := &http.Server{
-			Addr: listenAddr,
-			TLSConfig: &tls.Config{
-				MinVersion:               uint16(tlsMinVersion),
-				CurvePreferences:         []tls.CurveID{tls.X25519, tls.CurveP256, tls.CurveP384, tls.CurveP521},
-				PreferServerCipherSuites: true,
-				CipherSuites: []uint16{
-					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
-					tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
-				},
-			}, Handler: m}
-		err = server.ListenAndServeTLS(conf.Server.CertFile, conf.Server.KeyFile)
-
-	case "fcgi":
-		err = fcgi.Serve(nil, m)
+		server.TLSConfig = &tls.Config{
+			MinVersion:               uint16(tlsMinVersion),
+			CurvePreferences:         []tls.CurveID{tls.X25519, tls.CurveP256, tls.CurveP384, tls.CurveP521},
+			PreferServerCipherSuites: true,
+			CipherSuites: []uint16{
+				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+				tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+			},
+		}
+		listener, err = graceful.Listen("tcp", listenAddr)
+		if err != nil {
+			log.Fatal("Failed to listen on %q: %v", listenAddr, err)
+		}
+		err = server.ServeTLS(listener, conf.Server.CertFile, conf.Server.KeyFile)
 
 	case "unix":
-		if osutil.IsExist(listenAddr) {
+		if !graceful.IsInherited("unix", listenAddr) && osutil.IsExist(listenAddr) {
 			err = os.Remove(listenAddr)
 			if err != nil {
 				log.Fatal("Failed to remove existing Unix domain socket: %v", err)
 			}
 		}
 
-		var listener *net.UnixListener
-		listener, err = net.ListenUnix("unix", &net.UnixAddr{Name: listenAddr, Net: "unix"})
+		listener, err = graceful.Listen("unix", listenAddr)
 		if err != nil {
 			log.Fatal("Failed to listen on Unix networks: %v", err)
 		}
 
-		// FIXME: add proper implementation of signal capture on all protocols
-		// execute this on SIGTERM or SIGINT: listener.Close()
 		if err = os.Chmod(listenAddr, conf.Server.UnixSocketMode); err != nil {
 			log.Fatal("Failed to change permission of Unix domain socket: %v", err)
 		}
-		err = http.Serve(listener, m)
+		err = server.Serve(listener)
 
 	default:
 		log.Fatal("Unexpected server protocol: %s", conf.Server.Protocol)
 	}
 
-	if err != nil {
+	if err != nil && err != http.ErrServerClosed {
 		log.Fatal("Failed to start server: %v", err)
 	}
 
+	// Serving returns as soon as the shutdown begins, wait for it to finish.
+	<-graceful.Done()
+	log.Info("Server is stopped")
 	return nil
 }
+
+// registerGitOperationMetrics registers gauges of expensive Git operations
+// currently running by class and waiting for others.
+func registerGitOperationMetrics() {
+	for _, class := range []string{db.GIT_OPERATION_DIFF, db.GIT_OPERATION_ARCHIVE, db.GIT_OPERATION_LOG} {
+		class := class
+		prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   "gogs",
+			Name:        "git_operations_running",
+			Help:        "Number of expensive Git operations currently running.",
+			ConstLabels: prometheus.Labels{"class": class},
+		}, func() float64 {
+			return float64(db.GitOperationStats().Running[class])
+		}))
+	}
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "gogs",
+		Name:      "git_operations_waiting",
+		Help:      "Number of expensive Git operations waiting for others to finish.",
+	}, func() float64 {
+		return float64(db.GitOperationStats().Waiting)
+	}))
+}
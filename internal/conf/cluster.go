@@ -0,0 +1,44 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package conf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CheckCluster returns an error that lists configured components which only
+// work within a single node when cluster mode is enabled, so that nodes do not
+// silently serve inconsistent state behind a load balancer.
+//
+// Locations of repositories and application data cannot be verified to be on
+// shared storage, and are up to administrators.
+func CheckCluster() error {
+	if !Cluster.Enabled {
+		return nil
+	}
+
+	var problems []string
+	if Database.Type == "sqlite3" {
+		problems = append(problems, `[database] TYPE "sqlite3" is a file that cannot be shared safely`)
+	}
+	switch Session.Provider {
+	case "redis", "memcache", "mysql", "postgres":
+	default:
+		problems = append(problems, fmt.Sprintf("[session] PROVIDER %q keeps sessions in the node", Session.Provider))
+	}
+	switch Cache.Adapter {
+	case "redis", "memcache":
+	default:
+		problems = append(problems, fmt.Sprintf("[cache] ADAPTER %q keeps cached data in the node", Cache.Adapter))
+	}
+
+	if len(problems) > 0 {
+		return errors.Errorf("node-local components are configured: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package conf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCluster(t *testing.T) {
+	enabled, dbType, sessionProvider, cacheAdapter := Cluster.Enabled, Database.Type, Session.Provider, Cache.Adapter
+	defer func() {
+		Cluster.Enabled, Database.Type, Session.Provider, Cache.Adapter = enabled, dbType, sessionProvider, cacheAdapter
+	}()
+
+	tests := []struct {
+		name            string
+		enabled         bool
+		dbType          string
+		sessionProvider string
+		cacheAdapter    string
+		wantErr         string
+	}{
+		{
+			name:            "disabled",
+			dbType:          "sqlite3",
+			sessionProvider: "memory",
+			cacheAdapter:    "memory",
+		},
+		{
+			name:            "shared components",
+			enabled:         true,
+			dbType:          "postgres",
+			sessionProvider: "redis",
+			cacheAdapter:    "redis",
+		},
+		{
+			name:            "node-local components",
+			enabled:         true,
+			dbType:          "sqlite3",
+			sessionProvider: "file",
+			cacheAdapter:    "memory",
+			wantErr:         `node-local components are configured: [database] TYPE "sqlite3" is a file that cannot be shared safely; [session] PROVIDER "file" keeps sessions in the node; [cache] ADAPTER "memory" keeps cached data in the node`,
+		},
+		{
+			name:            "node-local cache",
+			enabled:         true,
+			dbType:          "mysql",
+			sessionProvider: "mysql",
+			cacheAdapter:    "memory",
+			wantErr:         `node-local components are configured: [cache] ADAPTER "memory" keeps cached data in the node`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Cluster.Enabled = test.enabled
+			Database.Type = test.dbType
+			Session.Provider = test.sessionProvider
+			Cache.Adapter = test.cacheAdapter
+
+			err := CheckCluster()
+			if test.wantErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
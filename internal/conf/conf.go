@@ -16,6 +16,9 @@ import (
 
 	_ "github.com/go-macaron/cache/memcache"
 	_ "github.com/go-macaron/cache/redis"
+	_ "github.com/go-macaron/session/memcache"
+	_ "github.com/go-macaron/session/mysql"
+	_ "github.com/go-macaron/session/postgres"
 	_ "github.com/go-macaron/session/redis"
 	"github.com/gogs/go-libravatar"
 	"github.com/mcuadros/go-version"
@@ -180,6 +183,11 @@ func Init(customConf string) error {
 		return errors.Wrap(err, "mapping [repository] section")
 	}
 	Repository.Root = ensureAbs(Repository.Root)
+	switch Repository.AllowedCreators {
+	case "everyone", "org_owners", "admins":
+	default:
+		return errors.Errorf("invalid ALLOWED_CREATORS %q in [repository] section", Repository.AllowedCreators)
+	}
 	Repository.Upload.TempPath = ensureAbs(Repository.Upload.TempPath)
 
 	// *****************************
@@ -198,6 +206,9 @@ func Init(customConf string) error {
 	if err = File.Section("security").MapTo(&Security); err != nil {
 		return errors.Wrap(err, "mapping [security] section")
 	}
+	if Security.TrustedGPGKeyring != "" {
+		Security.TrustedGPGKeyring = ensureAbs(Security.TrustedGPGKeyring)
+	}
 
 	// Check run user when the install is locked.
 	if Security.InstallLock {
@@ -363,6 +374,8 @@ func Init(customConf string) error {
 
 	if err = File.Section("cache").MapTo(&Cache); err != nil {
 		return errors.Wrap(err, "mapping [cache] section")
+	} else if err = File.Section("cluster").MapTo(&Cluster); err != nil {
+		return errors.Wrap(err, "mapping [cluster] section")
 	} else if err = File.Section("http").MapTo(&HTTP); err != nil {
 		return errors.Wrap(err, "mapping [http] section")
 	} else if err = File.Section("release").MapTo(&Release); err != nil {
@@ -385,6 +398,8 @@ func Init(customConf string) error {
 		return errors.Wrap(err, "mapping [ui] section")
 	} else if err = File.Section("prometheus").MapTo(&Prometheus); err != nil {
 		return errors.Wrap(err, "mapping [prometheus] section")
+	} else if err = File.Section("log.request").MapTo(&RequestLog); err != nil {
+		return errors.Wrap(err, "mapping [log.request] section")
 	} else if err = File.Section("other").MapTo(&Other); err != nil {
 		return errors.Wrap(err, "mapping [other] section")
 	}
@@ -63,6 +63,8 @@ var (
 		DisableRouterLog bool
 		EnableGzip       bool
 
+		ShutdownDrainTimeout time.Duration
+
 		AppDataPath        string
 		LoadAssetsFromDisk bool
 
@@ -103,13 +105,19 @@ var (
 		Root                     string
 		ScriptType               string
 		ANSICharset              string `ini:"ANSI_CHARSET"`
+		AllowedCreators          string
 		ForcePrivate             bool
+		ForbidVisibilityChange   bool
 		MaxCreationLimit         int
+		MaxCreationLimitPerOrg   int
 		PreferredLicenses        []string
 		DisableHTTPGit           bool `ini:"DISABLE_HTTP_GIT"`
 		EnableLocalPathMigration bool
 		EnableRawFileRenderMode  bool
-		CommitsFetchConcurrency  int
+		EnableCloneInIDE         bool `ini:"ENABLE_CLONE_IN_IDE"`
+		DirectoryStatsDepth      int
+		LastCommitsTimeBudget    time.Duration
+		TreePagingNum            int
 
 		// Repository editor settings
 		Editor struct {
@@ -125,6 +133,30 @@ var (
 			FileMaxSize  int64
 			MaxFiles     int
 		} `ini:"repository.upload"`
+
+		// Repository issue settings
+		Issue struct {
+			CloseKeywords  []string
+			ReopenKeywords []string
+		} `ini:"repository.issue"`
+
+		// Repository insights settings
+		Insights struct {
+			BusFactorMajority    float64
+			BusFactorWindowDays  int
+			BusFactorRiskLevel   int
+			TrafficRetentionDays int
+		} `ini:"repository.insights"`
+
+		// Repository recommendations settings
+		Recommendations struct {
+			EnablePersonalized bool
+		} `ini:"repository.recommendations"`
+
+		// Repository push log settings
+		PushLog struct {
+			RetentionDays int
+		} `ini:"repository.push_log"`
 	}
 
 	// Database settings
@@ -153,6 +185,8 @@ var (
 		CookieSecure            bool
 		EnableLoginStatusCookie bool
 		LoginStatusCookieName   string
+		LocalNetworkAllowlist   []string `delim:","`
+		TrustedGPGKeyring       string   `ini:"TRUSTED_GPG_KEYRING"`
 
 		// Deprecated: Use Auth.ReverseProxyAuthenticationHeader instead, will be removed in 0.13.
 		ReverseProxyAuthenticationUser string
@@ -238,6 +272,12 @@ var (
 		Host     string
 	}
 
+	// Cluster settings
+	Cluster struct {
+		Enabled      bool
+		RunScheduler bool
+	}
+
 	// HTTP settings
 	HTTP struct {
 		AccessControlAllowOrigin string
@@ -259,6 +299,8 @@ var (
 			AllowedTypes []string `delim:"|"`
 			MaxSize      int64
 			MaxFiles     int
+
+			DownloadStatsFlushInterval time.Duration
 		} `ini:"release.attachment"`
 	}
 
@@ -277,6 +319,11 @@ var (
 		GravatarSource             string
 		DisableGravatar            bool
 		EnableFederatedAvatar      bool
+		AvatarSize                 uint
+		EnableAnimatedAvatar       bool
+		StripEXIF                  bool `ini:"STRIP_EXIF"`
+		MaxImagePixels             int64
+		AttachmentPreviewSize      uint
 
 		// Derived from other static values
 		LibravatarService *libravatar.Libravatar `ini:"-"` // Initialized client for federated avatar.
@@ -292,10 +339,11 @@ var (
 
 	// Webhook settings
 	Webhook struct {
-		Types          []string
-		DeliverTimeout int
-		SkipTLSVerify  bool `ini:"SKIP_TLS_VERIFY"`
-		PagingNum      int
+		Types             []string
+		DeliverTimeout    int
+		SkipTLSVerify     bool `ini:"SKIP_TLS_VERIFY"`
+		PagingNum         int
+		MaxPayloadCommits int
 	}
 
 	// Markdown sttings
@@ -344,6 +392,41 @@ var (
 			Schedule   string
 			OlderThan  time.Duration
 		} `ini:"cron.repo_archive_cleanup"`
+		ReviewReminders struct {
+			Enabled    bool
+			RunAtStart bool
+			Schedule   string
+		} `ini:"cron.review_reminders"`
+		VisibilitySchedules struct {
+			Enabled    bool
+			RunAtStart bool
+			Schedule   string
+		} `ini:"cron.visibility_schedules"`
+		IssueAutoLock struct {
+			Enabled    bool
+			RunAtStart bool
+			Schedule   string
+		} `ini:"cron.issue_auto_lock"`
+		RepoTrafficRollup struct {
+			Enabled    bool
+			RunAtStart bool
+			Schedule   string
+		} `ini:"cron.repo_traffic_rollup"`
+		PushLogCleanup struct {
+			Enabled    bool
+			RunAtStart bool
+			Schedule   string
+		} `ini:"cron.push_log_cleanup"`
+		RepoRelations struct {
+			Enabled    bool
+			RunAtStart bool
+			Schedule   string
+		} `ini:"cron.repo_relations"`
+		LifecyclePolicies struct {
+			Enabled    bool
+			RunAtStart bool
+			Schedule   string
+		} `ini:"cron.lifecycle_policies"`
 	}
 
 	// Git settings
@@ -356,18 +439,24 @@ var (
 		MaxGitDiffLineCharacters int
 		MaxGitDiffFiles          int
 		GCArgs                   []string `ini:"GC_ARGS" delim:" "`
+		MaxConcurrentOperations  int
+		MaxWaitingOperations     int
 		Timeout                  struct {
 			Migrate int
 			Mirror  int
 			Clone   int
 			Pull    int
 			GC      int `ini:"GC"`
+			Diff    int
+			Archive int
+			Log     int
 		} `ini:"git.timeout"`
 	}
 
 	// API settings
 	API struct {
 		MaxResponseItems int
+		BotRateLimit     int
 	}
 
 	// UI settings
@@ -399,6 +488,14 @@ var (
 		BasicAuthPassword string
 	}
 
+	// Request log settings
+	RequestLog struct {
+		Enabled       bool
+		Format        string
+		SlowThreshold time.Duration
+		DebugHeader   bool
+	}
+
 	// Other settings
 	Other struct {
 		ShowFooterBranding         bool
@@ -93,6 +93,19 @@ func (c *Context) UserID() int64 {
 	return c.User.ID
 }
 
+// PushCredential returns the credential of the signed in user for the push log
+// of changes pushed on behalf of the user.
+func (c *Context) PushCredential() db.PushCredential {
+	switch {
+	case c.IsTokenAuth:
+		return db.PushCredential{Type: db.PushCredentialToken}
+	case c.IsBasicAuth:
+		return db.PushCredential{Type: db.PushCredentialPassword}
+	default:
+		return db.PushCredential{Type: db.PushCredentialWeb}
+	}
+}
+
 // HasError returns true if error occurs in form validation.
 func (c *Context) HasApiError() bool {
 	hasErr, ok := c.Data["HasError"]
@@ -177,6 +190,8 @@ func (c *Context) Handle(status int, msg string, err error) {
 		if !conf.IsProdMode() || (c.IsLogged && c.User.IsAdmin) {
 			c.Data["ErrorMsg"] = err
 		}
+	case http.StatusServiceUnavailable:
+		c.Data["Title"] = "Service Unavailable"
 	}
 	c.HTML(status, fmt.Sprintf("status/%d", status))
 }
@@ -191,6 +206,28 @@ func (c *Context) ServerError(msg string, err error) {
 	c.Handle(http.StatusInternalServerError, msg, err)
 }
 
+// UnavailableOrServerError renders the 503 page if the error is caused by an
+// expensive Git operation that timed out, was canceled or had to wait for too
+// many others, or the 500 page for any other error.
+func (c *Context) UnavailableOrServerError(msg string, err error) {
+	if !db.IsErrGitOperationUnavailable(err) {
+		c.ServerError(msg, err)
+		return
+	}
+
+	log.Warn("%s: %v", msg, err)
+	c.Resp.Header().Set("Retry-After", "60")
+	if auth.IsAPIPath(c.Req.URL.Path) {
+		c.JSON(http.StatusServiceUnavailable, map[string]string{
+			"message": c.Tr("git_operation_unavailable"),
+		})
+		return
+	}
+
+	c.Data["UnavailableMessage"] = c.Tr("git_operation_unavailable")
+	c.Handle(http.StatusServiceUnavailable, msg, err)
+}
+
 // NotFoundOrServerError use error check function to determine if the error
 // is about not found. It responses with 404 status code for not found error,
 // or error context description for logging purpose of 500 server error.
@@ -305,6 +342,10 @@ func Contexter() macaron.Handler {
 			c.Data["LoggedUserID"] = c.User.ID
 			c.Data["LoggedUserName"] = c.User.Name
 			c.Data["IsAdmin"] = c.User.IsAdmin
+
+			if err := db.UpdateUserLastSeen(c.User.ID); err != nil {
+				log.Error("Failed to update last seen of user [%d]: %v", c.User.ID, err)
+			}
 		} else {
 			c.Data["LoggedUserID"] = 0
 			c.Data["LoggedUserName"] = ""
@@ -327,6 +368,7 @@ func Contexter() macaron.Handler {
 		c.Data["ShowFooterBranding"] = conf.Other.ShowFooterBranding
 
 		c.renderNoticeBanner()
+		c.renderSiteContent()
 
 		ctx.Map(c)
 	}
@@ -0,0 +1,42 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gogs.io/gogs/internal/db"
+)
+
+func TestContext_PushCredential(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           *Context
+		expCredential db.PushCredentialType
+	}{
+		{
+			name:          "session",
+			ctx:           &Context{},
+			expCredential: db.PushCredentialWeb,
+		},
+		{
+			name:          "access token",
+			ctx:           &Context{IsTokenAuth: true},
+			expCredential: db.PushCredentialToken,
+		},
+		{
+			name:          "basic authentication",
+			ctx:           &Context{IsBasicAuth: true},
+			expCredential: db.PushCredentialPassword,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expCredential, test.ctx.PushCredential().Type)
+		})
+	}
+}
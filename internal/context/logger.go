@@ -0,0 +1,141 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package context
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/macaron.v1"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/trace"
+)
+
+// routeParams returns all route parameters of the request. Macaron does not
+// export them nor the matched route pattern, thus read via reflection.
+func routeParams(ctx *macaron.Context) map[string]string {
+	v := reflect.ValueOf(ctx).Elem().FieldByName("params")
+	if !v.IsValid() || v.Kind() != reflect.Map || v.Len() == 0 {
+		return nil
+	}
+
+	params := make(map[string]string, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		params[iter.Key().String()] = iter.Value().String()
+	}
+	return params
+}
+
+// routePattern reconstructs the route pattern by replacing values of route
+// parameters in the path with their names, e.g. "/unknwon/gogs/issues/1" becomes
+// "/:username/:reponame/issues/:index". When multiple parameters have the same
+// value, the result is the best effort.
+func routePattern(path string, params map[string]string) string {
+	if len(params) == 0 {
+		return path
+	}
+
+	// The glob matches the rest of the path, which may contain slashes.
+	if glob := params["*"]; glob != "" && strings.HasSuffix(path, glob) {
+		path = strings.TrimSuffix(path, glob) + "*"
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		if strings.HasPrefix(name, ":") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	segments := strings.Split(path, "/")
+	replaced := make([]bool, len(segments))
+	for _, name := range names {
+		for i := range segments {
+			if !replaced[i] && segments[i] == params[name] {
+				segments[i] = name
+				replaced[i] = true
+				break
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// requestContext returns the context of the request if it has been created.
+func requestContext(ctx *macaron.Context) *Context {
+	v := ctx.GetVal(reflect.TypeOf(&Context{}))
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface().(*Context)
+}
+
+// RequestLogger returns a middleware that logs every request in structured
+// format, along with counters of Git commands and SQL queries executed when
+// serving the request.
+func RequestLogger() macaron.Handler {
+	return func(ctx *macaron.Context) {
+		start := time.Now()
+		t := trace.New(conf.RequestLog.SlowThreshold)
+		unbind := trace.Bind(t)
+		defer unbind()
+
+		if conf.RequestLog.DebugHeader {
+			ctx.Resp.Before(func(w macaron.ResponseWriter) {
+				c := requestContext(ctx)
+				if c != nil && c.IsLogged && c.User.IsAdmin {
+					w.Header().Set("X-Gogs-Trace", trace.Encode(trace.FormatLogfmt, t.Counters().Fields(false)))
+				}
+			})
+		}
+
+		ctx.Next()
+
+		duration := time.Since(start)
+		status := ctx.Resp.Status()
+		if status == 0 {
+			status = 200
+		}
+
+		params := routeParams(ctx)
+		route := routePattern(ctx.Req.URL.Path, params)
+		if len(params) == 0 && status == 404 {
+			route = "-" // Do not log raw paths of unmatched requests as route patterns.
+		}
+
+		user := "-"
+		if c := requestContext(ctx); c != nil && c.IsLogged {
+			user = c.User.Name
+		}
+
+		slow := conf.RequestLog.SlowThreshold > 0 && duration >= conf.RequestLog.SlowThreshold
+		counters := t.Counters()
+		detailed := slow || len(counters.SlowOps) > 0
+		fields := append([]trace.Field{
+			{Key: "method", Value: ctx.Req.Method},
+			{Key: "route", Value: route},
+			{Key: "status", Value: status},
+			{Key: "duration_ms", Value: trace.Milliseconds(duration)},
+			{Key: "user", Value: user},
+			{Key: "bytes", Value: ctx.Resp.Size()},
+		}, counters.Fields(detailed)...)
+		if detailed {
+			fields = append(fields, trace.Field{Key: "path", Value: ctx.Req.URL.Path})
+		}
+
+		line := trace.Encode(conf.RequestLog.Format, fields)
+		if detailed {
+			log.Warn("%s", line)
+		} else {
+			log.Info("%s", line)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_routePattern(t *testing.T) {
+	tests := []struct {
+		path   string
+		params map[string]string
+		expVal string
+	}{
+		{
+			path:   "/explore/repos",
+			expVal: "/explore/repos",
+		},
+		{
+			path: "/unknwon/gogs/issues/1",
+			params: map[string]string{
+				":username": "unknwon",
+				":reponame": "gogs",
+				":index":    "1",
+			},
+			expVal: "/:username/:reponame/issues/:index",
+		},
+		{
+			path: "/unknwon/gogs/src/master/internal/db",
+			params: map[string]string{
+				":username": "unknwon",
+				":reponame": "gogs",
+				"*":         "master/internal/db",
+				"*0":        "master/internal/db",
+			},
+			expVal: "/:username/:reponame/src/*",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expVal, routePattern(test.path, test.params))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package context
+
+import (
+	"net/http"
+	"strings"
+
+	"gopkg.in/macaron.v1"
+
+	"gogs.io/gogs/internal/auth"
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/maintenance"
+)
+
+// maintenanceAllowedPaths are paths that accept changes in maintenance mode,
+// so that admins are able to sign in and turn it off.
+var maintenanceAllowedPaths = []string{
+	"/user/login",
+	"/user/logout",
+	"/admin/maintenance",
+}
+
+// isReadOnlyMethod returns true if requests of the method do not make changes.
+func isReadOnlyMethod(method string) bool {
+	return method == "GET" || method == "HEAD" || method == "OPTIONS"
+}
+
+// isGitPath returns true if the path is of Git smart HTTP requests, whose push
+// requests are checked by the Git HTTP handler to respond messages that Git
+// clients understand.
+func isGitPath(path string) bool {
+	return strings.HasSuffix(path, "/git-upload-pack") || strings.HasSuffix(path, "/git-receive-pack")
+}
+
+// Maintenance shows a banner on all pages in maintenance mode, and rejects
+// requests that make changes unless the user is allowed to bypass it.
+func Maintenance() macaron.Handler {
+	return func(c *Context) {
+		state := maintenance.Current()
+		if !state.Enabled {
+			return
+		}
+		c.Data["Maintenance"] = state
+
+		path := strings.TrimPrefix(c.Req.URL.Path, conf.Server.Subpath)
+		if isReadOnlyMethod(c.Req.Method) || isGitPath(path) ||
+			state.Bypass(c.IsLogged && c.User.IsAdmin) {
+			return
+		}
+		for _, p := range maintenanceAllowedPaths {
+			if strings.HasPrefix(path, p) {
+				return
+			}
+		}
+
+		c.Resp.Header().Set("Retry-After", "120")
+		if auth.IsAPIPath(path) {
+			c.JSON(http.StatusServiceUnavailable, map[string]string{
+				"message": state.DisplayMessage(),
+			})
+			return
+		}
+
+		c.Data["Title"] = "Under Maintenance"
+		c.HTML(http.StatusServiceUnavailable, "status/503")
+	}
+}
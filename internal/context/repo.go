@@ -6,15 +6,18 @@ package context
 
 import (
 	"fmt"
-	"io/ioutil"
+	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/editorconfig/editorconfig-core-go/v2"
 	"gopkg.in/macaron.v1"
+	log "unknwon.dev/clog/v2"
 
 	"github.com/gogs/git-module"
 
+	"gogs.io/gogs/internal/auth"
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/db/errors"
@@ -44,10 +47,44 @@ type Repository struct {
 	CommitID     string
 	RepoLink     string
 	CloneLink    db.CloneLink
-	CommitsCount int64
-	Mirror       *db.Mirror
+	// RepoSize is the size of objects of the repository on disk in bytes, see
+	// Size.
+	RepoSize int64
+	// NumLooseObjects and NumPackedObjects are the numbers of loose and packed
+	// objects of the repository, see Size.
+	NumLooseObjects  int64
+	NumPackedObjects int64
+	Mirror           *db.Mirror
+	BotGrant         *db.BotGrant // Only set for bots with grants on the repository
+	// PathRestriction is only set for collaborators limited to some paths.
+	PathRestriction *db.PathRestriction
 
 	PullRequest *PullRequest
+
+	// commitAuthors caches users resolved by author e-mails of commits.
+	commitAuthors map[string]commitAuthor
+	// protectTags caches protected tag patterns of the repository.
+	protectTags []*db.ProtectTag
+	// branches and tags are names of references of the repository loaded by
+	// the request, nil if not loaded yet.
+	branches []string
+	tags     []string
+	// pullReviewState is the review of files of the pull request being viewed
+	// by the current user, nil if not loaded yet.
+	pullReviewState *db.PullReviewState
+	// sizeLoaded is true if RepoSize is loaded by the request.
+	sizeLoaded bool
+	// commitsCount is the number of commits of the reference being viewed,
+	// see CommitsCount.
+	commitsCount       int64
+	commitsCountLoaded bool
+	// doer is the signed in user, nil for anonymous visitors.
+	doer *db.User
+}
+
+type commitAuthor struct {
+	user     *db.User
+	verified bool
 }
 
 // IsOwner returns true if current user is the owner of repository.
@@ -70,34 +107,244 @@ func (r *Repository) HasAccess() bool {
 	return r.AccessMode >= db.ACCESS_MODE_READ
 }
 
+// CanDirectPush returns true if the current user can write to the branch
+// directly rather than through pull requests, according to protection of the
+// branch and rule sets of the organization. Site administrators and users in
+// the push whitelist of a protected branch are allowed.
+func (r *Repository) CanDirectPush(branch string) bool {
+	if !r.IsWriter() || r.doer == nil {
+		return false
+	}
+	canPush, err := db.CanUserPushToBranch(r.Repository, r.doer, branch)
+	if err != nil {
+		log.Error("Failed to check push permission [repo_id: %d, user_id: %d, branch: %s]: %v", r.Repository.ID, r.doer.ID, branch, err)
+		return false
+	}
+	return canPush
+}
+
 // CanEnableEditor returns true if repository is editable and user has proper access level.
 func (r *Repository) CanEnableEditor() bool {
-	return r.Repository.CanEnableEditor() && r.IsViewBranch && r.IsWriter() && !r.Repository.IsBranchRequirePullRequest(r.BranchName)
+	return r.Repository.CanEnableEditor() && r.IsViewBranch && r.CanDirectPush(r.BranchName)
 }
 
-// GetEditorconfig returns the .editorconfig definition if found in the
-// HEAD of the default repo branch.
-func (r *Repository) GetEditorconfig() (*editorconfig.Editorconfig, error) {
-	commit, err := r.GitRepo.GetBranchCommit(r.Repository.DefaultBranch)
+// GetEditorconfig returns the .editorconfig definitions that apply to files in
+// the directory of the tree of the viewed commit. It falls back to the HEAD of
+// the default branch when no .editorconfig file is found in the viewed commit,
+// or no commit is viewed.
+func (r *Repository) GetEditorconfig(dir string) (*editorconfig.Editorconfig, error) {
+	if r.Commit != nil {
+		ec, err := r.Repository.GetEditorconfig(r.GitRepo, r.Commit.ID.String(), dir)
+		if err == nil || !git.IsErrNotExist(err) {
+			return ec, err
+		}
+	}
+
+	commitID, err := r.GitRepo.GetBranchCommitID(r.Repository.DefaultBranch)
 	if err != nil {
 		return nil, err
 	}
-	treeEntry, err := commit.GetTreeEntryByPath(".editorconfig")
+	return r.Repository.GetEditorconfig(r.GitRepo, commitID, dir)
+}
+
+// Branches returns names of all branches of the repository, which are only
+// loaded once by the request.
+func (r *Repository) Branches() ([]string, error) {
+	if r.branches == nil {
+		branches, err := r.Repository.GetBranchNames(r.GitRepo)
+		if err != nil {
+			return nil, err
+		}
+		r.branches = append(make([]string, 0, len(branches)), branches...)
+	}
+	return r.branches, nil
+}
+
+// Tags returns names of all tags of the repository, latest created first,
+// which are only loaded once by the request.
+func (r *Repository) Tags() ([]string, error) {
+	if r.tags == nil {
+		tags, err := r.Repository.GetTagNames(r.GitRepo)
+		if err != nil {
+			return nil, err
+		}
+		r.tags = append(make([]string, 0, len(tags)), tags...)
+	}
+	return r.tags, nil
+}
+
+// Size returns the size of loose and packed objects of the repository on disk
+// in bytes, which is only counted once by the request along with the numbers of
+// objects. Bare repositories have no objects to count.
+func (r *Repository) Size() (int64, error) {
+	if !r.sizeLoaded && !r.Repository.IsBare {
+		countObject, err := git.GetRepoSize(r.GitRepo.Path)
+		if err != nil {
+			return 0, err
+		}
+		r.RepoSize = countObject.Size + countObject.SizePack
+		r.NumLooseObjects = countObject.Count
+		r.NumPackedObjects = countObject.InPack
+	}
+	r.sizeLoaded = true
+	return r.RepoSize, nil
+}
+
+// CommitsCount returns the number of commits of the branch, tag or commit
+// being viewed, which is only counted once by the request. Counts of branches
+// are cached across requests until branches move.
+func (r *Repository) CommitsCount() (int64, error) {
+	if !r.commitsCountLoaded {
+		var err error
+		if r.IsViewBranch {
+			r.commitsCount, err = r.Repository.CommitsCount(r.BranchName)
+		} else {
+			r.commitsCount, err = r.Commit.CommitsCount()
+		}
+		if err != nil {
+			return 0, err
+		}
+		r.commitsCountLoaded = true
+	}
+	return r.commitsCount, nil
+}
+
+// OwnerOfPath returns users and teams that own the file at given path,
+// according to the CODEOWNERS file at the current commit.
+func (r *Repository) OwnerOfPath(treePath string) ([]*db.User, []*db.Team, error) {
+	if r.Commit == nil {
+		return nil, nil, nil
+	}
+	return r.Repository.CodeOwnersOfPath(r.Commit, treePath)
+}
+
+// IsLFSTracked returns true if the file at given path is tracked by Git LFS,
+// according to the .gitattributes files at the current commit.
+func (r *Repository) IsLFSTracked(treePath string) (bool, error) {
+	if r.Commit == nil {
+		return false, nil
+	}
+	return db.IsLFSTracked(r.Commit, treePath)
+}
+
+// IsTagProtected returns true if the tag is protected from deletion and from
+// being overwritten. Tags are considered protected when patterns cannot be
+// loaded, so that actions on them are not offered.
+func (r *Repository) IsTagProtected(tagName string) bool {
+	if r.protectTags == nil {
+		tags, err := db.GetProtectTags(r.Repository.ID)
+		if err != nil {
+			log.Error("Failed to get protected tags of repository [%d]: %v", r.Repository.ID, err)
+			return true
+		}
+		r.protectTags = tags
+	}
+	return db.MatchProtectTags(r.protectTags, tagName)
+}
+
+// ResolveCommitAuthor returns the user who has the author e-mail of the commit
+// and whether the e-mail is verified by the user, the user is nil if no one has
+// the e-mail. The commit should only be attributed to the user when the e-mail
+// is verified.
+func (r *Repository) ResolveCommitAuthor(commit *git.Commit) (*db.User, bool, error) {
+	email := strings.ToLower(commit.Author.Email)
+	if author, ok := r.commitAuthors[email]; ok {
+		return author.user, author.verified, nil
+	}
+
+	u, verified, err := db.GetUserByCommitEmail(email)
 	if err != nil {
-		return nil, err
+		if !errors.IsUserNotExist(err) {
+			return nil, false, err
+		}
+		u = nil
+	}
+
+	if r.commitAuthors == nil {
+		r.commitAuthors = make(map[string]commitAuthor)
+	}
+	r.commitAuthors[email] = commitAuthor{
+		user:     u,
+		verified: verified,
+	}
+	return u, verified, nil
+}
+
+// OpenPRsUsingBranchAsHead returns open pull requests of other repositories
+// that use the branch of this repository as head, with their base repositories
+// loaded.
+func (r *Repository) OpenPRsUsingBranchAsHead(branch string) ([]*db.PullRequest, error) {
+	prs, err := db.GetUnmergedPullRequestsByHeadInfo(r.Repository.ID, branch)
+	if err != nil {
+		return nil, fmt.Errorf("GetUnmergedPullRequestsByHeadInfo: %v", err)
+	}
+
+	others := make([]*db.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if pr.BaseRepoID == r.Repository.ID {
+			continue
+		}
+		if err = pr.LoadAttributes(); err != nil {
+			return nil, fmt.Errorf("LoadAttributes [%d]: %v", pr.ID, err)
+		}
+		others = append(others, pr)
+	}
+	return others, nil
+}
+
+// AllowsMergeCommit returns true if pull requests can be merged to the branch
+// with merge commits, i.e. the branch does not require linear history.
+func (r *Repository) AllowsMergeCommit(branch string) bool {
+	return !db.IsBranchOfRepoRequireLinearHistory(r.Repository.ID, branch)
+}
+
+// pullHead returns the path of the head repository and the head commit of the
+// pull request, which must have not been merged.
+func pullHead(pr *db.PullRequest) (string, string, error) {
+	if pr.HeadRepo == nil {
+		return "", "", fmt.Errorf("head repository [%d] does not exist", pr.HeadRepoID)
 	}
-	reader, err := treeEntry.Blob().Data()
+
+	repoPath := pr.HeadRepo.RepoPath()
+	gitRepo, err := git.OpenRepository(repoPath)
 	if err != nil {
-		return nil, err
+		return "", "", fmt.Errorf("OpenRepository: %v", err)
 	}
-	data, err := ioutil.ReadAll(reader)
+	commitID, err := gitRepo.GetBranchCommitID(pr.HeadBranch)
+	if err != nil {
+		return "", "", fmt.Errorf("GetBranchCommitID: %v", err)
+	}
+	return repoPath, commitID, nil
+}
+
+// PullCodeOwners returns ownership of changed files of the pull request at
+// the current head commit.
+func (r *Repository) PullCodeOwners(pr *db.PullRequest) (*db.PullCodeOwners, error) {
+	repoPath, commitID, err := pullHead(pr)
 	if err != nil {
 		return nil, err
 	}
-	return editorconfig.ParseBytes(data)
+	return pr.CodeOwners(repoPath, commitID)
 }
 
-// MakeURL accepts a string or url.URL as argument and returns escaped URL prepended with repository URL.
+// PullReviewState returns the review of files of the pull request by the user
+// at the current head commit, which is only loaded once by the request.
+func (r *Repository) PullReviewState(pr *db.PullRequest, u *db.User) (*db.PullReviewState, error) {
+	if r.pullReviewState == nil {
+		repoPath, commitID, err := pullHead(pr)
+		if err != nil {
+			return nil, err
+		}
+		if r.pullReviewState, err = pr.ReviewStateAt(u.ID, repoPath, commitID); err != nil {
+			return nil, err
+		}
+	}
+	return r.pullReviewState, nil
+}
+
+// MakeURL accepts a string, url.URL or *url.URL as argument and returns
+// escaped URL prepended with repository URL. The query string and fragment of
+// a url.URL are preserved.
 func (r *Repository) MakeURL(location interface{}) string {
 	switch location := location.(type) {
 	case string:
@@ -107,12 +354,24 @@ func (r *Repository) MakeURL(location interface{}) string {
 		return tempURL.String()
 	case url.URL:
 		location.Path = r.RepoLink + "/" + location.Path
+		location.RawPath = ""
 		return location.String()
+	case *url.URL:
+		return r.MakeURL(*location)
 	default:
-		panic("location type must be either string or url.URL")
+		panic("location type must be either string, url.URL or *url.URL")
 	}
 }
 
+// MakeURLWithQuery is like MakeURL with a string but also appends the encoded
+// query, e.g. for links to pages of a list.
+func (r *Repository) MakeURLWithQuery(path string, query url.Values) string {
+	return r.MakeURL(url.URL{
+		Path:     path,
+		RawQuery: query.Encode(),
+	})
+}
+
 // PullRequestURL returns URL for composing a pull request.
 // This function does not check if the repository can actually compose a pull request.
 func (r *Repository) PullRequestURL(baseBranch, headBranch string) string {
@@ -123,14 +382,142 @@ func (r *Repository) PullRequestURL(baseBranch, headBranch string) string {
 	return fmt.Sprintf("%s/compare/%s...%s:%s", repoLink, baseBranch, r.Owner.Name, headBranch)
 }
 
-// [0]: issues, [1]: wiki
+// CanComparePullRequest returns true if a pull request can be composed from
+// the head branch of the repository to the base branch of the base repository
+// of pull requests, i.e. both branches exist and point to different commits.
+// It is the check PullRequestURL does not do, e.g. after a branch is deleted.
+func (r *Repository) CanComparePullRequest(baseBranch, headBranch string) (bool, error) {
+	headCommitID, err := r.GitRepo.GetBranchCommitID(headBranch)
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get commit of head branch %q: %v", headBranch, err)
+	}
+
+	baseGitRepo := r.GitRepo
+	if baseRepo := r.PullRequest.BaseRepo; baseRepo != nil && baseRepo.ID != r.Repository.ID {
+		baseGitRepo, err = git.OpenRepository(baseRepo.RepoPath())
+		if err != nil {
+			return false, fmt.Errorf("open base repository: %v", err)
+		}
+	}
+	baseCommitID, err := baseGitRepo.GetBranchCommitID(baseBranch)
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get commit of base branch %q: %v", baseBranch, err)
+	}
+	return baseCommitID != headCommitID, nil
+}
+
+// checkRepoPageAccess returns whether the viewer with given access mode can
+// view the page of the repository at the path. Viewers without access to a
+// partially public repository can only view its issues, wiki or releases, and are given
+// a link to redirect to from other pages. The link is empty when the viewer
+// should get 404 as if the repository does not exist, including when the
+// path is already within the section to redirect to, which means that page is
+// inaccessible as well and redirecting again would bounce between pages.
+func checkRepoPageAccess(mode db.AccessMode, repo *db.Repository, isIssuesPage, isWikiPage, isReleasesPage bool, method, path string) (allowed bool, redirect string) {
+	if mode > db.ACCESS_MODE_NONE {
+		return true, ""
+	} else if !repo.IsPartialPublic() {
+		return false, ""
+	}
+
+	if (isIssuesPage && repo.CanGuestViewIssues()) ||
+		(isWikiPage && repo.CanGuestViewWiki()) ||
+		(isReleasesPage && repo.CanGuestViewReleases()) {
+		return true, ""
+	}
+
+	// Only redirect requests to view pages, e.g. never redirect form submissions.
+	if method != "GET" && method != "HEAD" {
+		return false, ""
+	}
+
+	var targets []string
+	if repo.CanGuestViewIssues() {
+		targets = append(targets, repo.Link()+"/issues")
+	}
+	if repo.CanGuestViewWiki() {
+		targets = append(targets, repo.Link()+"/wiki")
+	}
+	if repo.CanGuestViewReleases() {
+		targets = append(targets, repo.Link()+"/releases")
+	}
+	for _, target := range targets {
+		if path != target && !strings.HasPrefix(path, target+"/") {
+			return false, target
+		}
+	}
+	return false, ""
+}
+
+// Pages that collaborators limited to some paths can visit, besides the home
+// page. Issue pages are only allowed when the collaborator can access issues.
+var (
+	restrictedRepoPages      = []string{"/src", "/raw", "/archive", "/last-commits"}
+	restrictedRepoIssuePages = []string{"/issues", "/labels", "/milestones", "/comments"}
+)
+
+// redirectRenamedRepo permanently redirects the request to the repository that
+// was renamed from the name, keeping the rest of the path and the query. It
+// responds with 404 if there is no such repository or the user cannot read it.
+func redirectRenamedRepo(c *Context, owner *db.User, repoName string) {
+	repo, err := db.GetRepositoryByOldName(owner.ID, repoName)
+	if err != nil {
+		c.NotFoundOrServerError("GetRepositoryByOldName", errors.IsRepoNotExist, err)
+		return
+	}
+
+	if !c.IsLogged || !c.User.IsAdmin {
+		has, err := db.HasAccess(c.UserID(), repo, db.ACCESS_MODE_READ)
+		if err != nil {
+			c.ServerError("HasAccess", err)
+			return
+		} else if !has {
+			c.NotFound()
+			return
+		}
+	}
+
+	link := renamedRepoLink(c.Req.URL, c.Params(":username"), c.Params(":reponame"), repoName, repo.Link())
+	c.Context.Redirect(link, http.StatusMovedPermanently)
+}
+
+// renamedRepoLink returns the link of the request to the renamed repository.
+// The old path segments are the ones requested, i.e. the owner name and the
+// repository name with suffixes like ".git" that are kept in the link.
+func renamedRepoLink(reqURL *url.URL, oldOwnerName, oldRepoSegment, oldRepoName, newRepoLink string) string {
+	oldPrefix := "/" + oldOwnerName + "/" + oldRepoSegment
+	rest := reqURL.Path
+	if i := strings.Index(rest, oldPrefix); i >= 0 {
+		rest = rest[i+len(oldPrefix):]
+	} else {
+		rest = ""
+	}
+	suffix := oldRepoSegment[len(oldRepoName):]
+
+	link := url.URL{
+		Path:     newRepoLink + suffix + rest,
+		RawQuery: reqURL.RawQuery,
+	}
+	return link.String()
+}
+
+// RepoAssignment assigns the repository of the request to the context. The
+// pages indicate whether the request is for issues, wiki or releases
+// respectively, which may be viewed by guests of private repositories.
 func RepoAssignment(pages ...bool) macaron.Handler {
 	return func(c *Context) {
 		var (
-			owner        *db.User
-			err          error
-			isIssuesPage bool
-			isWikiPage   bool
+			owner          *db.User
+			err            error
+			isIssuesPage   bool
+			isWikiPage     bool
+			isReleasesPage bool
 		)
 
 		if len(pages) > 0 {
@@ -139,6 +526,9 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 		if len(pages) > 1 {
 			isWikiPage = pages[1]
 		}
+		if len(pages) > 2 {
+			isReleasesPage = pages[2]
+		}
 
 		ownerName := c.Params(":username")
 		repoName := strings.TrimSuffix(c.Params(":reponame"), ".git")
@@ -157,8 +547,11 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 		c.Data["Username"] = c.Repo.Owner.Name
 
 		repo, err := db.GetRepositoryByName(owner.ID, repoName)
-		if err != nil {
-			c.NotFoundOrServerError("GetRepositoryByName", errors.IsRepoNotExist, err)
+		if errors.IsRepoNotExist(err) {
+			redirectRenamedRepo(c, owner, repoName)
+			return
+		} else if err != nil {
+			c.ServerError("GetRepositoryByName", err)
 			return
 		}
 
@@ -169,46 +562,54 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 		c.Data["RepoLink"] = c.Repo.RepoLink
 		c.Data["RepoRelPath"] = c.Repo.Owner.Name + "/" + c.Repo.Repository.Name
 
-		// Admin has super access.
-		if c.IsLogged && c.User.IsAdmin {
-			c.Repo.AccessMode = db.ACCESS_MODE_OWNER
-		} else {
-			mode, err := db.UserAccessMode(c.UserID(), repo)
-			if err != nil {
-				c.ServerError("UserAccessMode", err)
-				return
-			}
-			c.Repo.AccessMode = mode
+		var u *db.User
+		if c.IsLogged {
+			u = c.User
+		}
+		c.Repo.doer = u
+		c.Repo.AccessMode, err = db.EffectiveAccessMode(u, repo)
+		if err != nil {
+			c.ServerError("EffectiveAccessMode", err)
+			return
 		}
 
 		// Check access
+		allowed, redirect := checkRepoPageAccess(c.Repo.AccessMode, repo, isIssuesPage, isWikiPage, isReleasesPage, c.Req.Method, c.Req.URL.Path)
+		if !allowed {
+			if redirect != "" {
+				c.Redirect(redirect)
+			} else {
+				c.NotFound()
+			}
+			return
+		}
 		if c.Repo.AccessMode == db.ACCESS_MODE_NONE {
-			// Redirect to any accessible page if not yet on it
-			if repo.IsPartialPublic() &&
-				(!(isIssuesPage || isWikiPage) ||
-					(isIssuesPage && !repo.CanGuestViewIssues()) ||
-					(isWikiPage && !repo.CanGuestViewWiki())) {
-				switch {
-				case repo.CanGuestViewIssues():
-					c.Redirect(repo.Link() + "/issues")
-				case repo.CanGuestViewWiki():
-					c.Redirect(repo.Link() + "/wiki")
-				default:
-					c.NotFound()
-				}
+			c.Repo.Repository.EnableIssues = repo.CanGuestViewIssues()
+			c.Repo.Repository.EnableWiki = repo.CanGuestViewWiki()
+		}
+
+		if c.IsLogged && c.Repo.AccessMode == db.ACCESS_MODE_READ {
+			c.Repo.PathRestriction, err = db.GetPathRestriction(repo, c.User)
+			if err != nil {
+				c.ServerError("GetPathRestriction", err)
 				return
 			}
-
-			// Response 404 if user is on completely private repository or possible accessible page but owner doesn't enabled
-			if !repo.IsPartialPublic() ||
-				(isIssuesPage && !repo.CanGuestViewIssues()) ||
-				(isWikiPage && !repo.CanGuestViewWiki()) {
+		}
+		if r := c.Repo.PathRestriction; r != nil {
+			if !r.AllowsPage(c.Repo.RepoLink, c.Req.URL.Path, restrictedRepoPages, restrictedRepoIssuePages) {
 				c.NotFound()
 				return
 			}
+			c.Repo.Repository.EnableIssues = c.Repo.Repository.EnableIssues && r.AllowIssues
+			c.Repo.Repository.EnableWiki = false
+			c.Repo.Repository.EnablePulls = false
+			c.Data["PathRestriction"] = r
+		}
 
-			c.Repo.Repository.EnableIssues = repo.CanGuestViewIssues()
-			c.Repo.Repository.EnableWiki = repo.CanGuestViewWiki()
+		if c.IsLogged && c.Repo.AccessMode > db.ACCESS_MODE_NONE {
+			if err = db.UpdateCollaboratorAccess(repo.ID, c.User.ID); err != nil {
+				log.Error("Failed to update collaborator access [repo_id: %d, user_id: %d]: %v", repo.ID, c.User.ID, err)
+			}
 		}
 
 		if repo.IsMirror {
@@ -220,6 +621,7 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 			c.Data["MirrorEnablePrune"] = c.Repo.Mirror.EnablePrune
 			c.Data["MirrorInterval"] = c.Repo.Mirror.Interval
 			c.Data["Mirror"] = c.Repo.Mirror
+			c.Data["MirrorSafeAddress"] = c.Repo.Mirror.SafeAddress()
 		}
 
 		gitRepo, err := git.OpenRepository(db.RepoPath(ownerName, repoName))
@@ -229,14 +631,6 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 		}
 		c.Repo.GitRepo = gitRepo
 
-		tags, err := c.Repo.GitRepo.GetTags()
-		if err != nil {
-			c.ServerError(fmt.Sprintf("GetTags '%s'", c.Repo.Repository.RepoPath()), err)
-			return
-		}
-		c.Data["Tags"] = tags
-		c.Repo.Repository.NumTags = len(tags)
-
 		c.Data["Title"] = owner.Name + "/" + repo.Name
 		c.Data["Repository"] = repo
 		c.Data["Owner"] = c.Repo.Repository.Owner
@@ -244,10 +638,30 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 		c.Data["IsRepositoryAdmin"] = c.Repo.IsAdmin()
 		c.Data["IsRepositoryWriter"] = c.Repo.IsWriter()
 
+		if c.Repo.IsAdmin() {
+			schedule, err := db.GetVisibilitySchedule(repo.ID)
+			if err != nil && !db.IsErrVisibilityScheduleNotExist(err) {
+				c.ServerError("GetVisibilitySchedule", err)
+				return
+			}
+			c.Data["VisibilitySchedule"] = schedule
+		}
+
 		c.Data["DisableSSH"] = conf.SSH.Disabled
 		c.Data["DisableHTTP"] = conf.Repository.DisableHTTPGit
 		c.Data["CloneLink"] = repo.CloneLink()
 		c.Data["WikiCloneLink"] = repo.WikiCloneLink()
+		c.Data["EnableCloneInIDE"] = conf.Repository.EnableCloneInIDE
+		if repo.IsFork {
+			c.Data["BaseCloneLink"] = repo.BaseRepo.CloneLink()
+		}
+
+		cloneProtocols, err := db.ResolveCloneProtocols(c.User)
+		if err != nil {
+			c.ServerError("ResolveCloneProtocols", err)
+			return
+		}
+		c.Data["CloneProtocols"] = cloneProtocols
 
 		if c.IsLogged {
 			c.Data["IsWatchingRepo"] = db.IsWatching(c.User.ID, repo.ID)
@@ -260,22 +674,24 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 		}
 
 		c.Data["TagName"] = c.Repo.TagName
-		brs, err := c.Repo.GitRepo.GetBranches()
+
+		brs, err := c.Repo.Branches()
 		if err != nil {
-			c.ServerError("GetBranches", err)
+			c.ServerError("Branches", err)
+			return
+		}
+
+		// If default branch doesn't exist, fall back to some other branch, which is
+		// saved so that later requests can trust the default branch.
+		defaultBranch, err := c.Repo.Repository.ResolveDefaultBranch(gitRepo, brs)
+		if err != nil {
+			c.ServerError("ResolveDefaultBranch", err)
 			return
 		}
-		c.Data["Branches"] = brs
-		c.Data["BrancheCount"] = len(brs)
 
 		// If not branch selected, try default one.
-		// If default branch doesn't exists, fall back to some other branch.
 		if len(c.Repo.BranchName) == 0 {
-			if len(c.Repo.Repository.DefaultBranch) > 0 && gitRepo.IsBranchExist(c.Repo.Repository.DefaultBranch) {
-				c.Repo.BranchName = c.Repo.Repository.DefaultBranch
-			} else if len(brs) > 0 {
-				c.Repo.BranchName = brs[0]
-			}
+			c.Repo.BranchName = defaultBranch
 		}
 		c.Data["BranchName"] = c.Repo.BranchName
 		c.Data["CommitID"] = c.Repo.CommitID
@@ -284,6 +700,248 @@ func RepoAssignment(pages ...bool) macaron.Handler {
 	}
 }
 
+// SetBranches puts names of branches of the repository into the template data
+// for pages that list them. It renders a server error and returns false if
+// they cannot be loaded.
+func (c *Context) SetBranches() bool {
+	branches, err := c.Repo.Branches()
+	if err != nil {
+		c.ServerError("Branches", err)
+		return false
+	}
+	c.Data["Branches"] = branches
+	c.Data["BrancheCount"] = len(branches)
+	return true
+}
+
+// RefPageSize is the number of branches or tags listed by the reference
+// switcher at once, more are loaded from the API on demand, which does not
+// return more than conf.API.MaxResponseItems items at once.
+const RefPageSize = 50
+
+// SetRefPages puts names of the first page of branches and tags of the
+// repository into the template data for the reference switcher, along with
+// the total numbers of them. Branches are sorted by name, and tags are latest
+// created first. It renders a server error and returns false if they cannot
+// be loaded.
+func (c *Context) SetRefPages() bool {
+	pageSize := RefPageSize
+	if pageSize > conf.API.MaxResponseItems {
+		pageSize = conf.API.MaxResponseItems
+	}
+
+	branches, numBranches, err := c.Repo.Repository.ListRefs(db.REF_KIND_BRANCH, db.REF_SORT_NAME, 1, pageSize)
+	if err != nil {
+		c.ServerError("ListRefs", err)
+		return false
+	}
+	tags, numTags, err := c.Repo.Repository.ListRefs(db.REF_KIND_TAG, db.REF_SORT_COMMITTERDATE, 1, pageSize)
+	if err != nil {
+		c.ServerError("ListRefs", err)
+		return false
+	}
+
+	refNames := func(refs []*db.Reference) []string {
+		names := make([]string, len(refs))
+		for i := range refs {
+			names[i] = refs[i].Name
+		}
+		return names
+	}
+	c.Data["Branches"] = refNames(branches)
+	c.Data["BrancheCount"] = numBranches
+	c.Data["HasMoreBranches"] = numBranches > len(branches)
+	c.Data["Tags"] = refNames(tags)
+	c.Data["HasMoreTags"] = numTags > len(tags)
+	c.Data["RefPageSize"] = pageSize
+	c.Repo.Repository.NumTags = numTags
+	return true
+}
+
+// RefKind is the kind of reference that a path of repository pages starts
+// with.
+type RefKind int
+
+const (
+	RefKindBranch RefKind = iota + 1
+	RefKindTag
+	RefKindCommit
+)
+
+func (k RefKind) String() string {
+	switch k {
+	case RefKindBranch:
+		return "branch"
+	case RefKindTag:
+		return "tag"
+	case RefKindCommit:
+		return "commit"
+	}
+	return ""
+}
+
+// ResolvedRef is the branch, tag or commit that a path of repository pages
+// starts with.
+type ResolvedRef struct {
+	Kind RefKind
+	// Name is the name of the branch or tag, or the full commit ID.
+	Name     string
+	TreePath string
+	Commit   *git.Commit
+}
+
+var (
+	// ErrRefNotFound is returned when a path starts with no branch, tag or
+	// commit.
+	ErrRefNotFound = errors.New("branch, tag or commit does not exist")
+	// ErrRefAmbiguous is returned when a path starts with an abbreviated commit
+	// ID that matches more than one commit.
+	ErrRefAmbiguous = errors.New("abbreviated commit ID is ambiguous")
+)
+
+var commitIDPrefixPattern = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+// ResolveRef returns the branch, tag or commit that the path starts with, and
+// the default branch if the path is empty. The longest branch or tag name wins,
+// and branches take precedence over tags of the same name and tags over
+// commits, whose IDs can be abbreviated to no less than 7 characters.
+// ErrRefNotFound or ErrRefAmbiguous is returned if nothing or more than one
+// commit matches, and any other error means the repository cannot be read.
+func ResolveRef(gitRepo *git.Repository, path, defaultBranch string) (*ResolvedRef, error) {
+	if path == "" {
+		if !gitRepo.IsBranchExist(defaultBranch) {
+			return nil, ErrRefNotFound
+		}
+		commit, err := gitRepo.GetBranchCommit(defaultBranch)
+		if err != nil {
+			return nil, fmt.Errorf("get branch commit: %v", err)
+		}
+		return &ResolvedRef{Kind: RefKindBranch, Name: defaultBranch, Commit: commit}, nil
+	}
+
+	parts := strings.Split(path, "/")
+	kinds, err := matchRefNames(gitRepo, parts)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(parts); i > 0; i-- {
+		ref := &ResolvedRef{
+			Name:     strings.Join(parts[:i], "/"),
+			TreePath: strings.Join(parts[i:], "/"),
+		}
+		switch kinds[ref.Name] {
+		case RefKindBranch:
+			ref.Kind = RefKindBranch
+			ref.Commit, err = gitRepo.GetBranchCommit(ref.Name)
+			if err != nil {
+				return nil, fmt.Errorf("get branch commit: %v", err)
+			}
+			return ref, nil
+		case RefKindTag:
+			ref.Kind = RefKindTag
+			ref.Commit, err = gitRepo.GetTagCommit(ref.Name)
+			if err != nil {
+				return nil, fmt.Errorf("get tag commit: %v", err)
+			}
+			return ref, nil
+		}
+	}
+
+	if !commitIDPrefixPattern.MatchString(parts[0]) {
+		return nil, ErrRefNotFound
+	}
+	commit, err := getCommitByPrefix(gitRepo, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	return &ResolvedRef{
+		Kind:     RefKindCommit,
+		Name:     commit.ID.String(),
+		TreePath: strings.Join(parts[1:], "/"),
+		Commit:   commit,
+	}, nil
+}
+
+// matchRefNames returns kinds of branches and tags whose names are made of
+// leading parts of the path, keyed by names. Branches take precedence over tags
+// of the same name. All names are looked up in a single Git command.
+func matchRefNames(gitRepo *git.Repository, parts []string) (map[string]RefKind, error) {
+	args := []string{"for-each-ref", "--format=%(refname)"}
+	for i := range parts {
+		if parts[i] == "" {
+			break
+		}
+		name := strings.Join(parts[:i+1], "/")
+		args = append(args, git.BRANCH_PREFIX+name, git.TAG_PREFIX+name)
+	}
+	if len(args) == 2 {
+		return nil, nil
+	}
+
+	// Patterns also match references under the names, which are skipped.
+	stdout, err := git.NewCommand(args...).RunInDir(gitRepo.Path)
+	if err != nil {
+		return nil, fmt.Errorf("list references: %v", err)
+	}
+	candidates := make(map[string]bool, len(args)-2)
+	for _, arg := range args[2:] {
+		candidates[arg] = true
+	}
+	kinds := make(map[string]RefKind)
+	for _, refName := range strings.Fields(stdout) {
+		if !candidates[refName] {
+			continue
+		}
+		if strings.HasPrefix(refName, git.BRANCH_PREFIX) {
+			kinds[strings.TrimPrefix(refName, git.BRANCH_PREFIX)] = RefKindBranch
+		} else if name := strings.TrimPrefix(refName, git.TAG_PREFIX); kinds[name] == 0 {
+			kinds[name] = RefKindTag
+		}
+	}
+	return kinds, nil
+}
+
+// getCommitByPrefix returns the only commit whose ID starts with the prefix.
+// Objects of other types that share the prefix are ignored.
+func getCommitByPrefix(gitRepo *git.Repository, prefix string) (*git.Commit, error) {
+	stdout, err := git.NewCommand("rev-parse", "--disambiguate="+prefix).RunInDir(gitRepo.Path)
+	if err != nil {
+		return nil, fmt.Errorf("disambiguate: %v", err)
+	}
+
+	var commit *git.Commit
+	for _, id := range strings.Fields(stdout) {
+		c, err := gitRepo.GetCommit(id)
+		if err != nil {
+			if git.IsErrNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("get commit: %v", err)
+		} else if commit != nil {
+			return nil, ErrRefAmbiguous
+		}
+		commit = c
+	}
+	if commit == nil {
+		return nil, ErrRefNotFound
+	}
+	return commit, nil
+}
+
+// fullCommitIDPath returns the URL path with the abbreviated commit ID that
+// the reference path starts with replaced by the full one, or an empty string
+// if the resolved reference is not a commit or its ID is not abbreviated.
+func fullCommitIDPath(urlPath, refPath string, ref *ResolvedRef) string {
+	if ref.Kind != RefKindCommit || !strings.HasSuffix(urlPath, refPath) {
+		return ""
+	}
+	commitID := strings.SplitN(refPath, "/", 2)[0]
+	if commitID == ref.Name {
+		return ""
+	}
+	return strings.TrimSuffix(urlPath, refPath) + ref.Name + strings.TrimPrefix(refPath, commitID)
+}
+
 // RepoRef handles repository reference name including those contain `/`.
 func RepoRef() macaron.Handler {
 	return func(c *Context) {
@@ -292,14 +950,10 @@ func RepoRef() macaron.Handler {
 			return
 		}
 
-		var (
-			refName string
-			err     error
-		)
-
 		// For API calls.
 		if c.Repo.GitRepo == nil {
 			repoPath := db.RepoPath(c.Repo.Owner.Name, c.Repo.Repository.Name)
+			var err error
 			c.Repo.GitRepo, err = git.OpenRepository(repoPath)
 			if err != nil {
 				c.Handle(500, "RepoRef Invalid repo "+repoPath, err)
@@ -307,79 +961,55 @@ func RepoRef() macaron.Handler {
 			}
 		}
 
-		// Get default branch.
-		if len(c.Params("*")) == 0 {
-			refName = c.Repo.Repository.DefaultBranch
-			if !c.Repo.GitRepo.IsBranchExist(refName) {
-				brs, err := c.Repo.GitRepo.GetBranches()
-				if err != nil {
-					c.Handle(500, "GetBranches", err)
-					return
-				}
-				refName = brs[0]
-			}
-			c.Repo.Commit, err = c.Repo.GitRepo.GetBranchCommit(refName)
+		ref, err := ResolveRef(c.Repo.GitRepo, c.Params("*"), c.Repo.Repository.DefaultBranch)
+		if err == ErrRefNotFound && c.Params("*") == "" {
+			// The default branch has been resolved by RepoAssignment for web pages,
+			// but API calls may still see a stale one.
+			var brs []string
+			brs, err = c.Repo.Branches()
 			if err != nil {
-				c.Handle(500, "GetBranchCommit", err)
+				c.Handle(500, "Branches", err)
 				return
 			}
-			c.Repo.CommitID = c.Repo.Commit.ID.String()
-			c.Repo.IsViewBranch = true
-
-		} else {
-			hasMatched := false
-			parts := strings.Split(c.Params("*"), "/")
-			for i, part := range parts {
-				refName = strings.TrimPrefix(refName+"/"+part, "/")
-
-				if c.Repo.GitRepo.IsBranchExist(refName) ||
-					c.Repo.GitRepo.IsTagExist(refName) {
-					if i < len(parts)-1 {
-						c.Repo.TreePath = strings.Join(parts[i+1:], "/")
-					}
-					hasMatched = true
-					break
-				}
+			var defaultBranch string
+			defaultBranch, err = c.Repo.Repository.ResolveDefaultBranch(c.Repo.GitRepo, brs)
+			if err != nil {
+				c.Handle(500, "ResolveDefaultBranch", err)
+				return
 			}
-			if !hasMatched && len(parts[0]) == 40 {
-				refName = parts[0]
-				c.Repo.TreePath = strings.Join(parts[1:], "/")
+			ref, err = ResolveRef(c.Repo.GitRepo, "", defaultBranch)
+		}
+		if err != nil {
+			if err == ErrRefNotFound || err == ErrRefAmbiguous {
+				c.Handle(404, "RepoRef", fmt.Errorf("%v: %s", err, c.Params("*")))
+			} else {
+				c.Handle(500, "ResolveRef", err)
 			}
+			return
+		}
 
-			if c.Repo.GitRepo.IsBranchExist(refName) {
-				c.Repo.IsViewBranch = true
-
-				c.Repo.Commit, err = c.Repo.GitRepo.GetBranchCommit(refName)
-				if err != nil {
-					c.Handle(500, "GetBranchCommit", err)
-					return
-				}
-				c.Repo.CommitID = c.Repo.Commit.ID.String()
-
-			} else if c.Repo.GitRepo.IsTagExist(refName) {
-				c.Repo.IsViewTag = true
-				c.Repo.Commit, err = c.Repo.GitRepo.GetTagCommit(refName)
-				if err != nil {
-					c.Handle(500, "GetTagCommit", err)
-					return
-				}
-				c.Repo.CommitID = c.Repo.Commit.ID.String()
-			} else if len(refName) == 40 {
-				c.Repo.IsViewCommit = true
-				c.Repo.CommitID = refName
-
-				c.Repo.Commit, err = c.Repo.GitRepo.GetCommit(refName)
-				if err != nil {
-					c.NotFound()
-					return
-				}
-			} else {
-				c.Handle(404, "RepoRef invalid repo", fmt.Errorf("branch or tag not exist: %s", refName))
+		// Abbreviated commit IDs in web pages are redirected to the full one, so
+		// that links stay stable.
+		if (c.Req.Method == "GET" || c.Req.Method == "HEAD") && !auth.IsAPIPath(c.Req.URL.Path) {
+			if fullPath := fullCommitIDPath(c.Req.URL.Path, c.Params("*"), ref); fullPath != "" {
+				c.RawRedirect(conf.Server.Subpath + (&url.URL{Path: fullPath, RawQuery: c.Req.URL.RawQuery}).String())
 				return
 			}
 		}
 
-		c.Repo.BranchName = refName
+		c.Repo.TreePath = ref.TreePath
+		c.Repo.Commit = ref.Commit
+		c.Repo.CommitID = ref.Commit.ID.String()
+		switch ref.Kind {
+		case RefKindBranch:
+			c.Repo.IsViewBranch = true
+		case RefKindTag:
+			c.Repo.IsViewTag = true
+		case RefKindCommit:
+			c.Repo.IsViewCommit = true
+		}
+
+		c.Repo.BranchName = ref.Name
 		c.Data["BranchName"] = c.Repo.BranchName
 		c.Data["CommitID"] = c.Repo.CommitID
 		c.Data["TreePath"] = c.Repo.TreePath
@@ -421,24 +1051,70 @@ func RepoRef() macaron.Handler {
 	}
 }
 
-func RequireRepoAdmin() macaron.Handler {
-	return func(c *Context) {
-		if !c.IsLogged || (!c.Repo.IsAdmin() && !c.User.IsAdmin) {
-			c.NotFound()
-			return
-		}
+// RepoAccessOptions contains options of middlewares requiring access to the
+// repository.
+type RepoAccessOptions struct {
+	// Whether to respond 403 with a JSON error to API requests of users who are
+	// denied, instead of 404. Users who cannot read the repository always get
+	// 404 to not leak existence of private repositories.
+	ForbiddenForAPI bool
+}
+
+// checkRepoAccess returns the status code to respond to the request to the path
+// requiring the access mode to the repository, or http.StatusOK if the request
+// is allowed. Site admins are always allowed.
+func checkRepoAccess(required, mode db.AccessMode, isLogged, isSiteAdmin bool, path string, opt RepoAccessOptions) int {
+	if mode >= required || (isLogged && isSiteAdmin) {
+		return http.StatusOK
+	}
+
+	if opt.ForbiddenForAPI && auth.IsAPIPath(path) && mode >= db.ACCESS_MODE_READ {
+		return http.StatusForbidden
 	}
+	return http.StatusNotFound
 }
 
-func RequireRepoWriter() macaron.Handler {
+func requireRepoAccess(required db.AccessMode, opts []RepoAccessOptions) macaron.Handler {
+	var opt RepoAccessOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
 	return func(c *Context) {
-		if !c.IsLogged || (!c.Repo.IsWriter() && !c.User.IsAdmin) {
-			c.NotFound()
+		status := checkRepoAccess(required, c.Repo.AccessMode, c.IsLogged, c.IsLogged && c.User.IsAdmin, c.Req.URL.Path, opt)
+		switch {
+		case status == http.StatusOK:
 			return
+		case status == http.StatusForbidden:
+			c.JSON(status, map[string]string{
+				"message": fmt.Sprintf("%s access to the repository is required", required),
+				"url":     DocURL,
+			})
+		case auth.IsAPIPath(c.Req.URL.Path):
+			c.Status(status)
+		default:
+			c.NotFound()
 		}
 	}
 }
 
+// RequireRepoReader makes sure the context user has at least read access to the
+// repository.
+func RequireRepoReader(opts ...RepoAccessOptions) macaron.Handler {
+	return requireRepoAccess(db.ACCESS_MODE_READ, opts)
+}
+
+// RequireRepoWriter makes sure the context user has at least write access to the
+// repository.
+func RequireRepoWriter(opts ...RepoAccessOptions) macaron.Handler {
+	return requireRepoAccess(db.ACCESS_MODE_WRITE, opts)
+}
+
+// RequireRepoAdmin makes sure the context user has at least admin access to the
+// repository.
+func RequireRepoAdmin(opts ...RepoAccessOptions) macaron.Handler {
+	return requireRepoAccess(db.ACCESS_MODE_ADMIN, opts)
+}
+
 // GitHookService checks if repository Git hooks service has been enabled.
 func GitHookService() macaron.Handler {
 	return func(c *Context) {
@@ -0,0 +1,1082 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package context
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gogs/git-module"
+	"github.com/stretchr/testify/assert"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_checkRepoPageAccess(t *testing.T) {
+	newRepo := func(allowPublicIssues, allowPublicWiki, allowPublicReleases bool) *db.Repository {
+		return &db.Repository{
+			Name:              "repo",
+			Owner:             &db.User{Name: "owner"},
+			IsPrivate:         true,
+			EnableIssues:      true,
+			EnableWiki:        true,
+			AllowPublicIssues: allowPublicIssues,
+			AllowPublicWiki:   allowPublicWiki,
+
+			AllowPublicReleases: allowPublicReleases,
+		}
+	}
+	const (
+		issues = iota + 1
+		wiki
+		releases
+	)
+
+	tests := []struct {
+		name        string
+		mode        db.AccessMode // Anonymous and unrelated users have no access
+		repo        *db.Repository
+		page        int
+		method      string
+		path        string
+		expAllowed  bool
+		expRedirect string
+	}{
+		{
+			name:       "read-only user views raw file",
+			mode:       db.ACCESS_MODE_READ,
+			repo:       newRepo(false, false, false),
+			path:       "/owner/repo/raw/master/README.md",
+			expAllowed: true,
+		},
+		{
+			name: "no access to private repository",
+			repo: newRepo(false, false, false),
+			path: "/owner/repo",
+		},
+		{
+			name: "no access to issues of private repository",
+			repo: newRepo(false, false, false),
+			page: issues,
+			path: "/owner/repo/issues",
+		},
+		{
+			name: "no access to wiki of private repository",
+			repo: newRepo(false, false, false),
+			page: wiki,
+			path: "/owner/repo/wiki",
+		},
+		{
+			name: "no access to archive of private repository",
+			repo: newRepo(false, false, false),
+			path: "/owner/repo/archive/master.zip",
+		},
+		{
+			name:       "view public issues",
+			repo:       newRepo(true, false, false),
+			page:       issues,
+			path:       "/owner/repo/issues",
+			expAllowed: true,
+		},
+		{
+			name:       "view public wiki",
+			repo:       newRepo(false, true, false),
+			page:       wiki,
+			path:       "/owner/repo/wiki",
+			expAllowed: true,
+		},
+		{
+			name:       "view public releases",
+			repo:       newRepo(false, false, true),
+			page:       releases,
+			path:       "/owner/repo/releases",
+			expAllowed: true,
+		},
+		{
+			name:        "no access to releases of private repository",
+			repo:        newRepo(true, true, false),
+			page:        releases,
+			path:        "/owner/repo/releases",
+			expRedirect: "/owner/repo/issues",
+		},
+		{
+			name:        "redirect from raw file to public releases",
+			repo:        newRepo(false, false, true),
+			path:        "/owner/repo/raw/master/README.md",
+			expRedirect: "/owner/repo/releases",
+		},
+		{
+			name:        "redirect from private wiki to public releases",
+			repo:        newRepo(false, false, true),
+			page:        wiki,
+			path:        "/owner/repo/wiki",
+			expRedirect: "/owner/repo/releases",
+		},
+		{
+			name:        "redirect from raw file to public issues",
+			repo:        newRepo(true, true, false),
+			path:        "/owner/repo/raw/master/README.md",
+			expRedirect: "/owner/repo/issues",
+		},
+		{
+			name:        "redirect from private issues to public wiki",
+			repo:        newRepo(false, true, false),
+			page:        issues,
+			path:        "/owner/repo/issues",
+			expRedirect: "/owner/repo/wiki",
+		},
+		{
+			name:        "redirect from private wiki to public issues",
+			repo:        newRepo(true, false, false),
+			page:        wiki,
+			path:        "/owner/repo/wiki",
+			expRedirect: "/owner/repo/issues",
+		},
+		{
+			name: "no redirect to the section of current page",
+			repo: newRepo(true, false, false),
+			path: "/owner/repo/issues/new",
+		},
+		{
+			name:   "no redirect for form submissions",
+			repo:   newRepo(true, false, false),
+			method: "POST",
+			path:   "/owner/repo/settings",
+		},
+		{
+			name: "no redirect to disabled issues",
+			repo: func() *db.Repository {
+				repo := newRepo(true, false, false)
+				repo.EnableIssues = false
+				return repo
+			}(),
+			path: "/owner/repo/archive/master.zip",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := test.method
+			if method == "" {
+				method = "GET"
+			}
+			allowed, redirect := checkRepoPageAccess(test.mode, test.repo, test.page == issues, test.page == wiki, test.page == releases, method, test.path)
+			assert.Equal(t, test.expAllowed, allowed)
+			assert.Equal(t, test.expRedirect, redirect)
+		})
+	}
+}
+
+func Test_restrictedRepoPages(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowIssues bool
+		path        string
+		expAllowed  bool
+	}{
+		{name: "home page", path: "/owner/repo", expAllowed: true},
+		{name: "tree view", path: "/owner/repo/src/master/docs", expAllowed: true},
+		{name: "raw file", path: "/owner/repo/raw/master/docs/guide.md", expAllowed: true},
+		{name: "archive", path: "/owner/repo/archive/master.zip", expAllowed: true},
+		{name: "last commits of tree view", path: "/owner/repo/last-commits/0123456789abcdef/docs", expAllowed: true},
+		{name: "commits", path: "/owner/repo/commits/master"},
+		{name: "commit diff", path: "/owner/repo/commit/0123456789abcdef"},
+		{name: "raw commit patch", path: "/owner/repo/commit/0123456789abcdef.patch"},
+		{name: "compare", path: "/owner/repo/compare/master...feature"},
+		{name: "pull request files", path: "/owner/repo/pulls/1/files"},
+		{name: "wiki", path: "/owner/repo/wiki"},
+		{name: "insights", path: "/owner/repo/insights"},
+		{name: "issues without issue access", path: "/owner/repo/issues"},
+		{name: "issues with issue access", allowIssues: true, path: "/owner/repo/issues/1", expAllowed: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &db.PathRestriction{
+				Prefixes:    []string{"docs"},
+				AllowIssues: test.allowIssues,
+			}
+			assert.Equal(t, test.expAllowed, r.AllowsPage("/owner/repo", test.path, restrictedRepoPages, restrictedRepoIssuePages))
+		})
+	}
+}
+
+func Test_checkRepoAccess(t *testing.T) {
+	const (
+		webPath = "/owner/repo/settings"
+		apiPath = "/api/v1/repos/owner/repo/hooks"
+	)
+	apiOpt := RepoAccessOptions{ForbiddenForAPI: true}
+
+	tests := []struct {
+		name        string
+		required    db.AccessMode
+		mode        db.AccessMode
+		isLogged    bool
+		isSiteAdmin bool
+		path        string
+		opt         RepoAccessOptions
+		expStatus   int
+	}{
+		{
+			name:      "anonymous user reads private repository on web",
+			required:  db.ACCESS_MODE_READ,
+			path:      webPath,
+			opt:       apiOpt,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:      "anonymous user reads private repository via API",
+			required:  db.ACCESS_MODE_READ,
+			path:      apiPath,
+			opt:       apiOpt,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:      "anonymous user writes public repository on web",
+			required:  db.ACCESS_MODE_WRITE,
+			mode:      db.ACCESS_MODE_READ,
+			path:      webPath,
+			opt:       apiOpt,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:      "anonymous user writes public repository via API",
+			required:  db.ACCESS_MODE_WRITE,
+			mode:      db.ACCESS_MODE_READ,
+			path:      apiPath,
+			opt:       apiOpt,
+			expStatus: http.StatusForbidden,
+		},
+		{
+			name:      "read-only user reads via API",
+			required:  db.ACCESS_MODE_READ,
+			mode:      db.ACCESS_MODE_READ,
+			isLogged:  true,
+			path:      apiPath,
+			opt:       apiOpt,
+			expStatus: http.StatusOK,
+		},
+		{
+			name:      "read-only user writes on web",
+			required:  db.ACCESS_MODE_WRITE,
+			mode:      db.ACCESS_MODE_READ,
+			isLogged:  true,
+			path:      webPath,
+			opt:       apiOpt,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:      "read-only user writes via API",
+			required:  db.ACCESS_MODE_WRITE,
+			mode:      db.ACCESS_MODE_READ,
+			isLogged:  true,
+			path:      apiPath,
+			opt:       apiOpt,
+			expStatus: http.StatusForbidden,
+		},
+		{
+			name:      "writer writes on web",
+			required:  db.ACCESS_MODE_WRITE,
+			mode:      db.ACCESS_MODE_WRITE,
+			isLogged:  true,
+			path:      webPath,
+			expStatus: http.StatusOK,
+		},
+		{
+			name:      "writer administers via API",
+			required:  db.ACCESS_MODE_ADMIN,
+			mode:      db.ACCESS_MODE_WRITE,
+			isLogged:  true,
+			path:      apiPath,
+			opt:       apiOpt,
+			expStatus: http.StatusForbidden,
+		},
+		{
+			name:      "member of admin team of organization administers via API",
+			required:  db.ACCESS_MODE_ADMIN,
+			mode:      db.ACCESS_MODE_ADMIN,
+			isLogged:  true,
+			path:      apiPath,
+			opt:       apiOpt,
+			expStatus: http.StatusOK,
+		},
+		{
+			name:      "user removed from team of organization reads on web",
+			required:  db.ACCESS_MODE_READ,
+			isLogged:  true,
+			path:      webPath,
+			opt:       apiOpt,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:      "user removed from team of organization reads via API",
+			required:  db.ACCESS_MODE_READ,
+			isLogged:  true,
+			path:      apiPath,
+			opt:       apiOpt,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:      "user removed from team of organization reads via API without option",
+			required:  db.ACCESS_MODE_READ,
+			isLogged:  true,
+			path:      apiPath,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:        "site admin administers on web",
+			required:    db.ACCESS_MODE_ADMIN,
+			isLogged:    true,
+			isSiteAdmin: true,
+			path:        webPath,
+			expStatus:   http.StatusOK,
+		},
+		{
+			name:        "site admin administers via API",
+			required:    db.ACCESS_MODE_ADMIN,
+			isLogged:    true,
+			isSiteAdmin: true,
+			path:        apiPath,
+			opt:         apiOpt,
+			expStatus:   http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := checkRepoAccess(test.required, test.mode, test.isLogged, test.isSiteAdmin, test.path, test.opt)
+			assert.Equal(t, test.expStatus, status)
+		})
+	}
+}
+
+func Test_renamedRepoLink(t *testing.T) {
+	tests := []struct {
+		name           string
+		reqURL         string
+		oldOwnerName   string
+		oldRepoSegment string
+		expLink        string
+	}{
+		{
+			name:           "home page",
+			reqURL:         "/alice/old",
+			oldOwnerName:   "alice",
+			oldRepoSegment: "old",
+			expLink:        "/alice/new",
+		},
+		{
+			name:           "sub page with query",
+			reqURL:         "/alice/old/issues?state=closed&page=2",
+			oldOwnerName:   "alice",
+			oldRepoSegment: "old",
+			expLink:        "/alice/new/issues?state=closed&page=2",
+		},
+		{
+			name:           "clone URL",
+			reqURL:         "/alice/old.git/info/refs?service=git-upload-pack",
+			oldOwnerName:   "alice",
+			oldRepoSegment: "old.git",
+			expLink:        "/alice/new.git/info/refs?service=git-upload-pack",
+		},
+		{
+			name:           "owner name in different case",
+			reqURL:         "/Alice/Old/src/master",
+			oldOwnerName:   "Alice",
+			oldRepoSegment: "Old",
+			expLink:        "/alice/new/src/master",
+		},
+		{
+			name:           "served under a sub-path",
+			reqURL:         "/gogs/alice/old/wiki",
+			oldOwnerName:   "alice",
+			oldRepoSegment: "old",
+			expLink:        "/alice/new/wiki",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqURL, err := url.Parse(test.reqURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			oldRepoName := strings.TrimSuffix(test.oldRepoSegment, ".git")
+			assert.Equal(t, test.expLink, renamedRepoLink(reqURL, test.oldOwnerName, test.oldRepoSegment, oldRepoName, "/alice/new"))
+		})
+	}
+}
+
+func TestRepository_ResolveCommitAuthor(t *testing.T) {
+	alice := &db.User{Name: "alice"}
+	r := &Repository{
+		commitAuthors: map[string]commitAuthor{
+			"alice@example.com": {user: alice, verified: true},
+			"bob@example.com":   {user: &db.User{Name: "bob"}},
+		},
+	}
+
+	tests := []struct {
+		email       string
+		expName     string
+		expVerified bool
+	}{
+		{email: "Alice@Example.com", expName: "alice", expVerified: true},
+		{email: "bob@example.com", expName: "bob", expVerified: false},
+	}
+	for _, test := range tests {
+		t.Run(test.email, func(t *testing.T) {
+			commit := &git.Commit{Author: &git.Signature{Email: test.email}}
+			u, verified, err := r.ResolveCommitAuthor(commit)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expName, u.Name)
+			assert.Equal(t, test.expVerified, verified)
+		})
+	}
+}
+
+func TestRepository_Size(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	repo.SetAuthor("alice", "alice@example.com")
+	run := repo.Git
+
+	// countObjects returns the size of loose and packed objects in bytes as
+	// reported by "git count-objects", along with the numbers of objects.
+	var numLoose, numPacked int64
+	countObjects := func() (loose, packed int64) {
+		for _, line := range strings.Split(run("count-objects", "-v"), "\n") {
+			var kib int64
+			switch {
+			case strings.HasPrefix(line, "count: "):
+				_, _ = fmt.Sscanf(line, "count: %d", &numLoose)
+			case strings.HasPrefix(line, "in-pack: "):
+				_, _ = fmt.Sscanf(line, "in-pack: %d", &numPacked)
+			case strings.HasPrefix(line, "size: "):
+				_, _ = fmt.Sscanf(line, "size: %d", &kib)
+				loose = kib * 1024
+			case strings.HasPrefix(line, "size-pack: "):
+				_, _ = fmt.Sscanf(line, "size-pack: %d", &kib)
+				packed = kib * 1024
+			}
+		}
+		return loose, packed
+	}
+
+	for i := 0; i < 10; i++ {
+		repo.WriteFile(fmt.Sprintf("file-%d.txt", i), strings.Repeat(fmt.Sprintf("line %d\n", i), 1000))
+	}
+	repo.Commit("initial")
+
+	gitRepo, err := git.OpenRepository(repo.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRepo := func() *Repository {
+		return &Repository{Repository: &db.Repository{}, GitRepo: gitRepo}
+	}
+
+	t.Run("loose objects", func(t *testing.T) {
+		loose, packed := countObjects()
+		assert.NotZero(t, loose)
+		assert.Zero(t, packed)
+
+		r := newRepo()
+		size, err := r.Size()
+		assert.Nil(t, err)
+		assert.Equal(t, loose, size)
+		assert.Equal(t, numLoose, r.NumLooseObjects)
+		assert.Zero(t, r.NumPackedObjects)
+	})
+
+	t.Run("packed objects", func(t *testing.T) {
+		r := newRepo()
+		_, err := r.Size()
+		assert.Nil(t, err)
+
+		run("gc", "--quiet")
+		loose, packed := countObjects()
+		assert.NotZero(t, packed)
+
+		packedRepo := newRepo()
+		size, err := packedRepo.Size()
+		assert.Nil(t, err)
+		assert.Equal(t, loose+packed, size)
+		assert.Equal(t, numPacked, packedRepo.NumPackedObjects)
+		assert.NotZero(t, packedRepo.NumPackedObjects)
+
+		// The size is only counted once by the request.
+		cached, err := r.Size()
+		assert.Nil(t, err)
+		assert.NotEqual(t, size, cached)
+		assert.Equal(t, cached, r.RepoSize)
+	})
+
+	t.Run("bare repository", func(t *testing.T) {
+		r := &Repository{Repository: &db.Repository{IsBare: true}}
+		size, err := r.Size()
+		assert.Nil(t, err)
+		assert.Zero(t, size)
+	})
+}
+
+func TestRepository_GetEditorconfig(t *testing.T) {
+	gitDir := testutil.InitGitRepo(t, "")
+	gitDir.SetAuthor("alice", "alice@example.com")
+	run := gitDir.Git
+	writeFile := gitDir.WriteFile
+
+	writeFile(".editorconfig", "root = true\n\n[*]\nindent_style = tab\n")
+	run("add", ".")
+	run("commit", "--quiet", "-m", "initial")
+
+	// The branch removes the root .editorconfig and adds a nested one.
+	run("checkout", "--quiet", "-b", "nested")
+	run("rm", "--quiet", ".editorconfig")
+	writeFile("web/.editorconfig", "[*.js]\nindent_style = space\n")
+	run("add", ".")
+	run("commit", "--quiet", "-m", "nested")
+
+	// The branch has no .editorconfig at all.
+	run("checkout", "--quiet", "-b", "none", "master")
+	run("rm", "--quiet", ".editorconfig")
+	run("commit", "--quiet", "-m", "none")
+
+	gitRepo, err := git.OpenRepository(gitDir.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &db.Repository{ID: -1, DefaultBranch: "master"}
+	defer db.ClearEditorconfigCache(repo.ID)
+
+	tests := []struct {
+		name           string
+		branch         string
+		treePath       string
+		expIndentStyle string
+	}{
+		{
+			name:           "no commit viewed",
+			treePath:       "web/app.js",
+			expIndentStyle: "tab",
+		},
+		{
+			name:           "default branch",
+			branch:         "master",
+			treePath:       "web/app.js",
+			expIndentStyle: "tab",
+		},
+		{
+			name:           "nested file on the branch",
+			branch:         "nested",
+			treePath:       "web/app.js",
+			expIndentStyle: "space",
+		},
+		{
+			name:           "root file removed by the branch",
+			branch:         "nested",
+			treePath:       "main.go",
+			expIndentStyle: "tab",
+		},
+		{
+			name:           "nested file not applied outside its directory",
+			branch:         "nested",
+			treePath:       "lib/app.js",
+			expIndentStyle: "tab",
+		},
+		{
+			name:           "no file on the branch",
+			branch:         "none",
+			treePath:       "web/app.js",
+			expIndentStyle: "tab",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &Repository{Repository: repo, GitRepo: gitRepo}
+			if test.branch != "" {
+				r.Commit, err = gitRepo.GetBranchCommit(test.branch)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ec, err := r.GetEditorconfig(path.Dir(test.treePath))
+			if err != nil {
+				t.Fatal(err)
+			}
+			def, err := ec.GetDefinitionForFilename(test.treePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, test.expIndentStyle, def.IndentStyle)
+		})
+	}
+}
+
+func TestRepository_CanDirectPush(t *testing.T) {
+	tests := []struct {
+		name       string
+		accessMode db.AccessMode
+		doer       *db.User
+	}{
+		{name: "anonymous", accessMode: db.ACCESS_MODE_READ},
+		{name: "reader", accessMode: db.ACCESS_MODE_READ, doer: &db.User{ID: 1}},
+		{name: "site administrator with read access", accessMode: db.ACCESS_MODE_READ, doer: &db.User{ID: 1, IsAdmin: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &Repository{
+				AccessMode: test.accessMode,
+				Repository: &db.Repository{ID: 1},
+				doer:       test.doer,
+			}
+			assert.False(t, r.CanDirectPush("master"))
+		})
+	}
+}
+
+func TestRepository_CanComparePullRequest(t *testing.T) {
+	root, err := ioutil.TempDir("", "gogs-compare-pull")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	oldRoot := conf.Repository.Root
+	conf.Repository.Root = root
+	defer func() { conf.Repository.Root = oldRoot }()
+
+	// The base repository only has "master", and the fork has "feature" one
+	// commit ahead of it, and "stale" which has been deleted.
+	baseRepo := &db.Repository{ID: 1, Name: "proj", Owner: &db.User{Name: "alice"}}
+	forkRepo := &db.Repository{ID: 2, Name: "proj", Owner: &db.User{Name: "bob"}}
+	work := testutil.InitGitRepo(t, filepath.Join(root, "work"))
+	work.Commit("init")
+	work.Git("clone", "--quiet", "--bare", work.Dir, baseRepo.RepoPath())
+	work.Git("checkout", "--quiet", "-b", "feature")
+	work.Commit("feature")
+	work.Git("clone", "--quiet", "--bare", work.Dir, forkRepo.RepoPath())
+	fork := testutil.OpenGitRepo(t, forkRepo.RepoPath())
+	fork.Git("branch", "stale", "feature")
+	fork.Git("branch", "-D", "stale")
+
+	gitRepo, err := git.OpenRepository(forkRepo.RepoPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		baseRepo   *db.Repository
+		baseBranch string
+		headBranch string
+		expVal     bool
+	}{
+		{
+			name:       "cross-repository",
+			baseRepo:   baseRepo,
+			baseBranch: "master",
+			headBranch: "feature",
+			expVal:     true,
+		},
+		{
+			name:       "identical branches",
+			baseRepo:   baseRepo,
+			baseBranch: "master",
+			headBranch: "master",
+			expVal:     false,
+		},
+		{
+			name:       "deleted head branch",
+			baseRepo:   baseRepo,
+			baseBranch: "master",
+			headBranch: "stale",
+			expVal:     false,
+		},
+		{
+			name:       "base branch only exists in the fork",
+			baseRepo:   baseRepo,
+			baseBranch: "feature",
+			headBranch: "feature",
+			expVal:     false,
+		},
+		{
+			name:       "same repository",
+			baseRepo:   forkRepo,
+			baseBranch: "master",
+			headBranch: "feature",
+			expVal:     true,
+		},
+		{
+			name:       "no base repository",
+			baseBranch: "master",
+			headBranch: "feature",
+			expVal:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &Repository{
+				Repository:  forkRepo,
+				GitRepo:     gitRepo,
+				PullRequest: &PullRequest{BaseRepo: test.baseRepo},
+			}
+			canCompare, err := r.CanComparePullRequest(test.baseBranch, test.headBranch)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expVal, canCompare)
+		})
+	}
+}
+
+// newResolveRefRepo creates a repository with two commits whose IDs share the
+// same 7-character prefix and another commit, then returns the path with a
+// function to run git commands in it.
+func newResolveRefRepo(t *testing.T) (repoPath string, ambiguousIDs [2]string, commitID string, run func(stdin string, args ...string) string) {
+	repo := testutil.InitGitRepo(t, "", "--bare")
+	run = repo.GitWithStdin
+	tree := run("", "hash-object", "-t", "tree", "-w", "--stdin")
+
+	// Commits only differ in messages until two of them collide on the prefix,
+	// which takes about 2^14 attempts on average.
+	seen := make(map[string]string)
+	for i := 0; ; i++ {
+		data := fmt.Sprintf("tree %s\nauthor gogs <gogs@localhost> 1577836800 +0000\ncommitter gogs <gogs@localhost> 1577836800 +0000\n\ncommit %d\n", tree, i)
+		id := fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("commit %d\x00%s", len(data), data))))
+		other, ok := seen[id[:7]]
+		if !ok {
+			seen[id[:7]] = data
+			continue
+		}
+
+		ambiguousIDs[0] = run(other, "hash-object", "-t", "commit", "-w", "--stdin")
+		ambiguousIDs[1] = run(data, "hash-object", "-t", "commit", "-w", "--stdin")
+		break
+	}
+
+	commitID = run("unique", "commit-tree", tree)
+	return repo.Dir, ambiguousIDs, commitID, run
+}
+
+func TestResolveRef(t *testing.T) {
+	repoPath, ambiguousIDs, commitID, run := newResolveRefRepo(t)
+	run("", "update-ref", "refs/heads/master", commitID)
+	run("", "update-ref", "refs/tags/v1.0", ambiguousIDs[0])
+
+	gitRepo, err := git.OpenRepository(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		defaultBranch string
+		expKind       RefKind
+		expName       string
+		expTreePath   string
+		expCommitID   string
+		expErr        error
+	}{
+		{name: "branch", path: "master/docs/README.md", expKind: RefKindBranch, expName: "master", expTreePath: "docs/README.md", expCommitID: commitID},
+		{name: "tag", path: "v1.0", expKind: RefKindTag, expName: "v1.0", expCommitID: ambiguousIDs[0]},
+		{name: "full commit ID", path: ambiguousIDs[1] + "/docs", expKind: RefKindCommit, expName: ambiguousIDs[1], expTreePath: "docs", expCommitID: ambiguousIDs[1]},
+		{name: "7-character prefix", path: commitID[:7] + "/docs", expKind: RefKindCommit, expName: commitID, expTreePath: "docs", expCommitID: commitID},
+		{name: "default branch", defaultBranch: "master", expKind: RefKindBranch, expName: "master", expCommitID: commitID},
+		{name: "default branch is not a tag", defaultBranch: "v1.0", expErr: ErrRefNotFound},
+		{name: "ambiguous prefix", path: ambiguousIDs[0][:7], expErr: ErrRefAmbiguous},
+		{name: "prefix shorter than 7 characters", path: commitID[:6], expErr: ErrRefNotFound},
+		{name: "nonexistent commit", path: "ffffff0000", expErr: ErrRefNotFound},
+		{name: "nonexistent branch", path: "develop", defaultBranch: "master", expErr: ErrRefNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ref, err := ResolveRef(gitRepo, test.path, test.defaultBranch)
+			assert.Equal(t, test.expErr, err)
+			if test.expErr != nil {
+				assert.Nil(t, ref)
+				return
+			}
+
+			assert.Equal(t, test.expKind, ref.Kind)
+			assert.Equal(t, test.expName, ref.Name)
+			assert.Equal(t, test.expTreePath, ref.TreePath)
+			assert.Equal(t, test.expCommitID, ref.Commit.ID.String())
+		})
+	}
+
+	t.Run("broken repository", func(t *testing.T) {
+		_, err := ResolveRef(&git.Repository{Path: filepath.Join(repoPath, "missing")}, commitID[:7], "")
+		assert.NotNil(t, err)
+		assert.NotEqual(t, ErrRefNotFound, err)
+		assert.NotEqual(t, ErrRefAmbiguous, err)
+	})
+
+	t.Run("prefix shared with a blob", func(t *testing.T) {
+		// Commits and blobs only differ in contents until one of each collides on
+		// the prefix.
+		commits := make(map[string]string)
+		blobs := make(map[string]string)
+		var prefix string
+		for i := 0; prefix == ""; i++ {
+			data := fmt.Sprintf("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor gogs <gogs@localhost> 1577836800 +0000\ncommitter gogs <gogs@localhost> 1577836800 +0000\n\nblob %d\n", i)
+			id := fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("commit %d\x00%s", len(data), data))))
+			commits[id[:7]] = data
+			if _, ok := blobs[id[:7]]; ok {
+				prefix = id[:7]
+				break
+			}
+
+			data = fmt.Sprintf("blob %d\n", i)
+			id = fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("blob %d\x00%s", len(data), data))))
+			blobs[id[:7]] = data
+			if _, ok := commits[id[:7]]; ok {
+				prefix = id[:7]
+			}
+		}
+		run("", "hash-object", "-t", "tree", "-w", "--stdin")
+		commitID := run(commits[prefix], "hash-object", "-t", "commit", "-w", "--stdin")
+		run(blobs[prefix], "hash-object", "-w", "--stdin")
+
+		ref, err := ResolveRef(gitRepo, prefix, "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindCommit, ref.Kind)
+		assert.Equal(t, commitID, ref.Name)
+	})
+
+	t.Run("branch with slashes", func(t *testing.T) {
+		run("", "update-ref", "refs/heads/feature/foo", commitID)
+		run("", "update-ref", "refs/heads/feature/foo-bar", ambiguousIDs[1])
+
+		ref, err := ResolveRef(gitRepo, "feature/foo-bar/cmd/main.go", "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindBranch, ref.Kind)
+		assert.Equal(t, "feature/foo-bar", ref.Name)
+		assert.Equal(t, "cmd/main.go", ref.TreePath)
+		assert.Equal(t, ambiguousIDs[1], ref.Commit.ID.String())
+
+		ref, err = ResolveRef(gitRepo, "feature/foo", "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindBranch, ref.Kind)
+		assert.Equal(t, "feature/foo", ref.Name)
+		assert.Equal(t, "", ref.TreePath)
+	})
+
+	t.Run("tag with slashes", func(t *testing.T) {
+		run("", "update-ref", "refs/tags/release/v1.1", commitID)
+
+		ref, err := ResolveRef(gitRepo, "release/v1.1/docs/README.md", "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindTag, ref.Kind)
+		assert.Equal(t, "release/v1.1", ref.Name)
+		assert.Equal(t, "docs/README.md", ref.TreePath)
+	})
+
+	t.Run("longer tag takes precedence over shorter branch", func(t *testing.T) {
+		run("", "update-ref", "refs/heads/release", ambiguousIDs[1])
+
+		ref, err := ResolveRef(gitRepo, "release/v1.1/docs", "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindTag, ref.Kind)
+		assert.Equal(t, "release/v1.1", ref.Name)
+		assert.Equal(t, "docs", ref.TreePath)
+
+		ref, err = ResolveRef(gitRepo, "release/v1.2/docs", "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindBranch, ref.Kind)
+		assert.Equal(t, "release", ref.Name)
+		assert.Equal(t, "v1.2/docs", ref.TreePath)
+	})
+
+	// Git does not allow branches "feature" and "feature/foo" at the same
+	// time, but a tag and a branch can be nested.
+	t.Run("longest nested name wins", func(t *testing.T) {
+		run("", "update-ref", "refs/tags/feature", ambiguousIDs[0])
+
+		tests := []struct {
+			name        string
+			path        string
+			expKind     RefKind
+			expName     string
+			expTreePath string
+		}{
+			{name: "file under longer branch", path: "feature/foo/file.go", expKind: RefKindBranch, expName: "feature/foo", expTreePath: "file.go"},
+			{name: "longer branch", path: "feature/foo", expKind: RefKindBranch, expName: "feature/foo"},
+			{name: "file sharing the prefix", path: "feature/foo.go", expKind: RefKindTag, expName: "feature", expTreePath: "foo.go"},
+			{name: "directory sharing the prefix", path: "feature/fo/file.go", expKind: RefKindTag, expName: "feature", expTreePath: "fo/file.go"},
+			{name: "shorter tag", path: "feature", expKind: RefKindTag, expName: "feature"},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				ref, err := ResolveRef(gitRepo, test.path, "")
+				assert.Nil(t, err)
+				assert.Equal(t, test.expKind, ref.Kind)
+				assert.Equal(t, test.expName, ref.Name)
+				assert.Equal(t, test.expTreePath, ref.TreePath)
+			})
+		}
+	})
+
+	t.Run("branch takes precedence over tag of the same name", func(t *testing.T) {
+		run("", "update-ref", "refs/tags/master", ambiguousIDs[0])
+
+		ref, err := ResolveRef(gitRepo, "master/docs", "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindBranch, ref.Kind)
+		assert.Equal(t, commitID, ref.Commit.ID.String())
+	})
+
+	t.Run("hex-looking branch name", func(t *testing.T) {
+		run("", "update-ref", "refs/heads/deadbeef", commitID)
+
+		ref, err := ResolveRef(gitRepo, "deadbeef/docs", "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindBranch, ref.Kind)
+		assert.Equal(t, "deadbeef", ref.Name)
+		assert.Equal(t, "docs", ref.TreePath)
+	})
+
+	t.Run("branch takes precedence over prefix", func(t *testing.T) {
+		run("", "update-ref", "refs/heads/"+commitID[:7], ambiguousIDs[1])
+
+		ref, err := ResolveRef(gitRepo, commitID[:7], "")
+		assert.Nil(t, err)
+		assert.Equal(t, RefKindBranch, ref.Kind)
+		assert.Equal(t, commitID[:7], ref.Name)
+		assert.Equal(t, ambiguousIDs[1], ref.Commit.ID.String())
+	})
+}
+
+func Test_fullCommitIDPath(t *testing.T) {
+	const commitID = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+	commitRef := &ResolvedRef{Kind: RefKindCommit, Name: commitID}
+	tests := []struct {
+		name    string
+		urlPath string
+		refPath string
+		ref     *ResolvedRef
+		expPath string
+	}{
+		{
+			name:    "abbreviated commit ID with tree path",
+			urlPath: "/alice/proj/src/4b825dc/docs/README.md",
+			refPath: "4b825dc/docs/README.md",
+			ref:     commitRef,
+			expPath: "/alice/proj/src/" + commitID + "/docs/README.md",
+		},
+		{
+			name:    "abbreviated commit ID",
+			urlPath: "/alice/proj/raw/4b825dc642",
+			refPath: "4b825dc642",
+			ref:     commitRef,
+			expPath: "/alice/proj/raw/" + commitID,
+		},
+		{
+			name:    "full commit ID",
+			urlPath: "/alice/proj/src/" + commitID + "/docs",
+			refPath: commitID + "/docs",
+			ref:     commitRef,
+		},
+		{
+			name:    "branch",
+			urlPath: "/alice/proj/src/deadbeef/docs",
+			refPath: "deadbeef/docs",
+			ref:     &ResolvedRef{Kind: RefKindBranch, Name: "deadbeef"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expPath, fullCommitIDPath(test.urlPath, test.refPath, test.ref))
+		})
+	}
+}
+
+func TestRepository_MakeURL(t *testing.T) {
+	r := &Repository{RepoLink: "/alice/proj"}
+	tests := []struct {
+		name     string
+		location interface{}
+		expURL   string
+	}{
+		{
+			name:     "string",
+			location: "src/master/docs/read me.md",
+			expURL:   "/alice/proj/src/master/docs/read%20me.md",
+		},
+		{
+			name: "url.URL with query and fragment",
+			location: url.URL{
+				Path:     "issues",
+				RawQuery: "state=closed&page=2",
+				Fragment: "issue-1",
+			},
+			expURL: "/alice/proj/issues?state=closed&page=2#issue-1",
+		},
+		{
+			name: "pointer to url.URL",
+			location: &url.URL{
+				Path:     "pulls",
+				RawQuery: "type=your_repositories",
+			},
+			expURL: "/alice/proj/pulls?type=your_repositories",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expURL, r.MakeURL(test.location))
+		})
+	}
+
+	t.Run("pointer to url.URL is not modified", func(t *testing.T) {
+		location := &url.URL{Path: "wiki"}
+		r.MakeURL(location)
+		assert.Equal(t, "wiki", location.Path)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		assert.Panics(t, func() { r.MakeURL(1) })
+	})
+}
+
+func TestRepository_MakeURLWithQuery(t *testing.T) {
+	r := &Repository{RepoLink: "/alice/proj"}
+	tests := []struct {
+		name   string
+		path   string
+		query  url.Values
+		expURL string
+	}{
+		{
+			name:   "no query",
+			path:   "releases",
+			expURL: "/alice/proj/releases",
+		},
+		{
+			name: "values are escaped",
+			path: "branches/delete/feature/x",
+			query: url.Values{
+				"commit":      {"abc123"},
+				"redirect_to": {"/alice/proj/pulls/1?a=b&c=d"},
+			},
+			expURL: "/alice/proj/branches/delete/feature/x?commit=abc123&redirect_to=%2Falice%2Fproj%2Fpulls%2F1%3Fa%3Db%26c%3Dd",
+		},
+		{
+			name:   "multiple values of a key",
+			path:   "issues",
+			query:  url.Values{"labels": {"1", "2"}},
+			expURL: "/alice/proj/issues?labels=1&labels=2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expURL, r.MakeURLWithQuery(test.path, test.query))
+		})
+	}
+}
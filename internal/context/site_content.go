@@ -0,0 +1,46 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package context
+
+import (
+	"html/template"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/markup"
+)
+
+// renderSiteContent loads footer links and the custom head snippet managed by
+// admins to display on all pages.
+func (c *Context) renderSiteContent() {
+	content, err := db.GetSiteContent()
+	if err != nil {
+		log.Error("Failed to get site content: %v", err)
+		return
+	}
+	links, err := db.GetFooterLinks()
+	if err != nil {
+		log.Error("Failed to get footer links: %v", err)
+		return
+	}
+
+	c.Data["FooterLinks"] = links
+	// The snippet is trusted as is since only site admins can change it.
+	c.Data["CustomHeadSnippet"] = template.HTML(content.HeadSnippet)
+}
+
+// SetHomeNotice renders the notice of the site managed by admins for the
+// landing page and dashboards.
+func (c *Context) SetHomeNotice() {
+	content, err := db.GetSiteContent()
+	if err != nil {
+		log.Error("Failed to get site content: %v", err)
+		return
+	}
+	if content.HomeNotice != "" {
+		c.Data["HomeNotice"] = string(markup.RawMarkdown([]byte(content.HomeNotice), ""))
+	}
+}
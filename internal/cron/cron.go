@@ -66,6 +66,83 @@ func NewContext() {
 			go db.DeleteOldRepositoryArchives()
 		}
 	}
+	if conf.Cron.ReviewReminders.Enabled {
+		entry, err = c.AddFunc("Review reminders", conf.Cron.ReviewReminders.Schedule, db.RemindPendingReviews)
+		if err != nil {
+			log.Fatal("Cron.(review reminders): %v", err)
+		}
+		if conf.Cron.ReviewReminders.RunAtStart {
+			entry.Prev = time.Now()
+			entry.ExecTimes++
+			go db.RemindPendingReviews()
+		}
+	}
+	if conf.Cron.VisibilitySchedules.Enabled {
+		entry, err = c.AddFunc("Visibility schedules", conf.Cron.VisibilitySchedules.Schedule, db.ApplyVisibilitySchedules)
+		if err != nil {
+			log.Fatal("Cron.(visibility schedules): %v", err)
+		}
+		if conf.Cron.VisibilitySchedules.RunAtStart {
+			entry.Prev = time.Now()
+			entry.ExecTimes++
+			go db.ApplyVisibilitySchedules()
+		}
+	}
+	if conf.Cron.IssueAutoLock.Enabled {
+		entry, err = c.AddFunc("Lock resolved issues", conf.Cron.IssueAutoLock.Schedule, db.AutoLockIssues)
+		if err != nil {
+			log.Fatal("Cron.(lock resolved issues): %v", err)
+		}
+		if conf.Cron.IssueAutoLock.RunAtStart {
+			entry.Prev = time.Now()
+			entry.ExecTimes++
+			go db.AutoLockIssues()
+		}
+	}
+	if conf.Cron.RepoTrafficRollup.Enabled {
+		entry, err = c.AddFunc("Roll up repository traffic", conf.Cron.RepoTrafficRollup.Schedule, db.RollupRepoTraffic)
+		if err != nil {
+			log.Fatal("Cron.(roll up repository traffic): %v", err)
+		}
+		if conf.Cron.RepoTrafficRollup.RunAtStart {
+			entry.Prev = time.Now()
+			entry.ExecTimes++
+			go db.RollupRepoTraffic()
+		}
+	}
+	if conf.Cron.PushLogCleanup.Enabled {
+		entry, err = c.AddFunc("Delete expired push logs", conf.Cron.PushLogCleanup.Schedule, db.DeleteExpiredPushLogs)
+		if err != nil {
+			log.Fatal("Cron.(delete expired push logs): %v", err)
+		}
+		if conf.Cron.PushLogCleanup.RunAtStart {
+			entry.Prev = time.Now()
+			entry.ExecTimes++
+			go db.DeleteExpiredPushLogs()
+		}
+	}
+	if conf.Cron.RepoRelations.Enabled {
+		entry, err = c.AddFunc("Update related repositories", conf.Cron.RepoRelations.Schedule, db.UpdateRepoRelations)
+		if err != nil {
+			log.Fatal("Cron.(update related repositories): %v", err)
+		}
+		if conf.Cron.RepoRelations.RunAtStart {
+			entry.Prev = time.Now()
+			entry.ExecTimes++
+			go db.UpdateRepoRelations()
+		}
+	}
+	if conf.Cron.LifecyclePolicies.Enabled {
+		entry, err = c.AddFunc("Apply lifecycle policies", conf.Cron.LifecyclePolicies.Schedule, db.ApplyLifecyclePolicies)
+		if err != nil {
+			log.Fatal("Cron.(apply lifecycle policies): %v", err)
+		}
+		if conf.Cron.LifecyclePolicies.RunAtStart {
+			entry.Prev = time.Now()
+			entry.ExecTimes++
+			go db.ApplyLifecyclePolicies()
+		}
+	}
 	c.Start()
 }
 
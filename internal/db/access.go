@@ -78,10 +78,23 @@ func userAccessMode(e Engine, userID int64, repo *Repository) (AccessMode, error
 		UserID: userID,
 		RepoID: repo.ID,
 	}
-	if has, err := e.Get(access); !has || err != nil {
+	if has, err := e.Get(access); err != nil {
 		return mode, err
+	} else if has {
+		return access.Mode, nil
 	}
-	return access.Mode, nil
+
+	// Bots are not in the access table, they read code with grants.
+	grant, err := getBotGrant(e, userID, repo.ID)
+	if err != nil {
+		if IsErrBotGrantNotExist(err) {
+			return mode, nil
+		}
+		return mode, err
+	} else if grant.CanReadCode {
+		mode = ACCESS_MODE_READ
+	}
+	return mode, nil
 }
 
 // UserAccessMode returns the access mode of given user to the repository.
@@ -89,6 +102,55 @@ func UserAccessMode(userID int64, repo *Repository) (AccessMode, error) {
 	return userAccessMode(x, userID, repo)
 }
 
+// EffectiveAccessMode returns the access mode of the user to the repository
+// that is enforced by the web UI, which includes access granted by teams and
+// ownership of the organization. Site administrators have owner access to all
+// repositories. The user can be nil for anonymous visitors.
+func EffectiveAccessMode(u *User, repo *Repository) (AccessMode, error) {
+	if u == nil {
+		return UserAccessMode(0, repo)
+	} else if u.IsAdmin {
+		return ACCESS_MODE_OWNER, nil
+	}
+	return UserAccessMode(u.ID, repo)
+}
+
+// UnitAccess indicates which units of a repository a user can access.
+type UnitAccess struct {
+	Code         bool
+	Issues       bool
+	PullRequests bool
+	Wiki         bool
+}
+
+// RepoUnitAccess returns units of the repository that are accessible with the
+// access mode. The grant is only given for bots, which use pull requests only
+// when granted to comment on them and never use issues or wiki. The path
+// restriction is nil unless the user is a collaborator limited to some paths.
+func RepoUnitAccess(repo *Repository, mode AccessMode, grant *BotGrant, r *PathRestriction) UnitAccess {
+	if mode < ACCESS_MODE_READ {
+		return UnitAccess{}
+	}
+
+	units := UnitAccess{
+		Code:         true,
+		Issues:       repo.EnableIssues && !repo.EnableExternalTracker,
+		PullRequests: repo.AllowsPulls(),
+		Wiki:         repo.EnableWiki && !repo.EnableExternalWiki,
+	}
+	if grant != nil {
+		units.Issues = false
+		units.PullRequests = units.PullRequests && grant.CanCommentPull
+		units.Wiki = false
+	}
+	if r != nil {
+		units.Issues = units.Issues && r.AllowIssues
+		units.PullRequests = false
+		units.Wiki = false
+	}
+	return units
+}
+
 func hasAccess(e Engine, userID int64, repo *Repository, testMode AccessMode) (bool, error) {
 	mode, err := userAccessMode(e, userID, repo)
 	return mode >= testMode, err
@@ -0,0 +1,88 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_EffectiveAccessMode(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "owner", LowerName: "owner"}
+	admin := &User{Name: "admin", LowerName: "admin", IsAdmin: true}
+	writer := &User{Name: "writer", LowerName: "writer"}
+	stranger := &User{Name: "stranger", LowerName: "stranger"}
+	reader := &User{Name: "reader", LowerName: "reader", Type: USER_TYPE_BOT}
+	commenter := &User{Name: "commenter", LowerName: "commenter", Type: USER_TYPE_BOT}
+	insertTestBeans(t, owner, admin, writer, stranger, reader, commenter)
+	private := &Repository{OwnerID: owner.ID, Name: "private", LowerName: "private", IsPrivate: true}
+	public := &Repository{OwnerID: owner.ID, Name: "public", LowerName: "public"}
+	insertTestBeans(t, private, public)
+	insertTestBeans(t,
+		&Access{UserID: writer.ID, RepoID: private.ID, Mode: ACCESS_MODE_WRITE},
+		&BotGrant{BotID: reader.ID, RepoID: private.ID, CanReadCode: true},
+		&BotGrant{BotID: commenter.ID, RepoID: private.ID, CanCommentPull: true},
+	)
+
+	Convey("Resolve effective access mode of users to repositories", t, func() {
+		for _, c := range []struct {
+			user    *User
+			repo    *Repository
+			expMode AccessMode
+		}{
+			{nil, private, ACCESS_MODE_NONE},
+			{nil, public, ACCESS_MODE_READ},
+			{owner, private, ACCESS_MODE_OWNER},
+			{admin, private, ACCESS_MODE_OWNER},
+			{writer, private, ACCESS_MODE_WRITE},
+			{stranger, private, ACCESS_MODE_NONE},
+			{stranger, public, ACCESS_MODE_READ},
+			{reader, private, ACCESS_MODE_READ},
+			{commenter, private, ACCESS_MODE_NONE},
+			{commenter, public, ACCESS_MODE_READ},
+		} {
+			mode, err := EffectiveAccessMode(c.user, c.repo)
+			So(err, ShouldBeNil)
+			So(mode, ShouldEqual, c.expMode)
+		}
+	})
+}
+
+func Test_RepoUnitAccess(t *testing.T) {
+	Convey("Resolve access to units of a repository", t, func() {
+		repo := &Repository{EnableIssues: true, EnablePulls: true, EnableWiki: true}
+
+		Convey("No unit is accessible without access to the repository", func() {
+			So(RepoUnitAccess(repo, ACCESS_MODE_NONE, nil, nil), ShouldResemble, UnitAccess{})
+			So(RepoUnitAccess(repo, ACCESS_MODE_NONE, &BotGrant{CanCommentPull: true}, nil), ShouldResemble, UnitAccess{})
+		})
+
+		Convey("Readers access enabled units", func() {
+			So(RepoUnitAccess(repo, ACCESS_MODE_READ, nil, nil), ShouldResemble, UnitAccess{
+				Code: true, Issues: true, PullRequests: true, Wiki: true,
+			})
+
+			external := &Repository{EnableIssues: true, EnableExternalTracker: true, EnablePulls: true, IsMirror: true}
+			So(RepoUnitAccess(external, ACCESS_MODE_WRITE, nil, nil), ShouldResemble, UnitAccess{Code: true})
+		})
+
+		Convey("Bots access pull requests only when granted to comment", func() {
+			So(RepoUnitAccess(repo, ACCESS_MODE_READ, &BotGrant{CanReadCode: true}, nil), ShouldResemble, UnitAccess{Code: true})
+			So(RepoUnitAccess(repo, ACCESS_MODE_READ, &BotGrant{CanCommentPull: true}, nil), ShouldResemble, UnitAccess{
+				Code: true, PullRequests: true,
+			})
+		})
+
+		Convey("Collaborators limited to paths access issues only when allowed", func() {
+			So(RepoUnitAccess(repo, ACCESS_MODE_READ, nil, &PathRestriction{AllowIssues: true}), ShouldResemble, UnitAccess{
+				Code: true, Issues: true,
+			})
+			So(RepoUnitAccess(repo, ACCESS_MODE_READ, nil, &PathRestriction{}), ShouldResemble, UnitAccess{Code: true})
+		})
+	})
+}
@@ -6,6 +6,7 @@ package db
 
 import (
 	"fmt"
+	"html"
 	"path"
 	"strings"
 	"time"
@@ -21,7 +22,9 @@ import (
 
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/email"
 	"gogs.io/gogs/internal/lazyregexp"
+	"gogs.io/gogs/internal/markup"
 	"gogs.io/gogs/internal/tool"
 )
 
@@ -53,19 +56,8 @@ const (
 	ACTION_MIRROR_SYNC_DELETE                        // 22
 )
 
-var (
-	// Same as Github. See https://help.github.com/articles/closing-issues-via-commit-messages
-	IssueCloseKeywords  = []string{"close", "closes", "closed", "fix", "fixes", "fixed", "resolve", "resolves", "resolved"}
-	IssueReopenKeywords = []string{"reopen", "reopens", "reopened"}
-
-	IssueCloseKeywordsPat     = lazyregexp.New(assembleKeywordsPattern(IssueCloseKeywords))
-	IssueReopenKeywordsPat    = lazyregexp.New(assembleKeywordsPattern(IssueReopenKeywords))
-	IssueReferenceKeywordsPat = lazyregexp.New(`(?i)(?:)(^| )\S+`)
-)
-
-func assembleKeywordsPattern(words []string) string {
-	return fmt.Sprintf(`(?i)(?:%s) \S+`, strings.Join(words, "|"))
-}
+// IssueReferenceKeywordsPat matches references of issues in commit messages.
+var IssueReferenceKeywordsPat = lazyregexp.New(`(?i)(?:)(^| )\S+`)
 
 // Action represents user operation type and other information to repository,
 // it implemented interface base.Actioner so that can be used in template render.
@@ -84,6 +76,14 @@ type Action struct {
 	Content      string    `xorm:"TEXT"`
 	Created      time.Time `xorm:"-" json:"-"`
 	CreatedUnix  int64
+
+	// IsDefaultBranch indicates whether a push updates the default branch.
+	IsDefaultBranch bool `xorm:"NOT NULL DEFAULT false"`
+
+	// Metas are metas of the repository to render references of issues in
+	// commit messages of pushes, which are set for all feeds of the repository
+	// at once.
+	Metas map[string]string `xorm:"-" json:"-"`
 }
 
 func (a *Action) BeforeInsert() {
@@ -249,6 +249,16 @@ func NewPushCommits() *PushCommits {
 	}
 }
 
+// limit returns a shallow copy of push commits which contains at most n commits.
+// The total number of commits is kept untouched. It does not limit when n <= 0.
+func (pc *PushCommits) limit(n int) *PushCommits {
+	limited := *pc
+	if n > 0 && len(limited.Commits) > n {
+		limited.Commits = limited.Commits[:n]
+	}
+	return &limited
+}
+
 func (pc *PushCommits) ToApiPayloadCommits(repoPath, repoURL string) ([]*api.PayloadCommit, error) {
 	commits := make([]*api.PayloadCommit, len(pc.Commits))
 	for i, commit := range pc.Commits {
@@ -315,14 +325,17 @@ func (push *PushCommits) AvatarLink(email string) string {
 	return push.avatars[email]
 }
 
-// UpdateIssuesCommit checks if issues are manipulated by commit message.
-func UpdateIssuesCommit(doer *User, repo *Repository, commits []*PushCommit) error {
+// UpdateIssuesCommit checks if issues are manipulated by commit message. Issues
+// are only closed or reopened by commits pushed to the default branch.
+func UpdateIssuesCommit(doer *User, repo *Repository, commits []*PushCommit, isDefaultBranch bool) error {
 	// Commits are appended in the reverse order.
 	for i := len(commits) - 1; i >= 0; i-- {
 		c := commits[i]
 
+		// References in code spans are not rendered as links, do not create
+		// comments for them either.
 		refMarked := make(map[int64]bool)
-		for _, ref := range IssueReferenceKeywordsPat.FindAllString(c.Message, -1) {
+		for _, ref := range IssueReferenceKeywordsPat.FindAllString(markup.StripCodeSpans(c.Message), -1) {
 			ref = ref[strings.IndexByte(ref, byte(' '))+1:]
 			ref = strings.TrimRightFunc(ref, issueIndexTrimRight)
 
@@ -345,6 +358,8 @@ func UpdateIssuesCommit(doer *User, repo *Repository, commits []*PushCommit) err
 					continue
 				}
 				return err
+			} else if !issue.Repo.HasInternalIssueTracker() {
+				continue
 			}
 
 			if refMarked[issue.ID] {
@@ -357,91 +372,84 @@ func UpdateIssuesCommit(doer *User, repo *Repository, commits []*PushCommit) err
 			if len(msgLines) > 2 {
 				shortMsg += "..."
 			}
-			message := fmt.Sprintf(`<a href="%s/commit/%s">%s</a>`, repo.Link(), c.Sha1, shortMsg)
+			message := fmt.Sprintf(`<a href="%s/commit/%s">%s</a>`, repo.Link(), c.Sha1, html.EscapeString(shortMsg))
 			if err = CreateRefComment(doer, repo, issue, message, c.Sha1); err != nil {
 				return err
 			}
 		}
 
-		refMarked = make(map[int64]bool)
-		// FIXME: can merge this one and next one to a common function.
-		for _, ref := range IssueCloseKeywordsPat.FindAllString(c.Message, -1) {
-			ref = ref[strings.IndexByte(ref, byte(' '))+1:]
-			ref = strings.TrimRightFunc(ref, issueIndexTrimRight)
-
-			if len(ref) == 0 {
-				continue
-			}
-
-			// Add repo name if missing
-			if ref[0] == '#' {
-				ref = fmt.Sprintf("%s%s", repo.FullName(), ref)
-			} else if !strings.Contains(ref, "/") {
-				// FIXME: We don't support User#ID syntax yet
-				continue
-			}
-
-			issue, err := GetIssueByRef(ref)
-			if err != nil {
-				if errors.IsIssueNotExist(err) {
-					continue
-				}
-				return err
-			}
-
-			if refMarked[issue.ID] {
-				continue
-			}
-			refMarked[issue.ID] = true
-
-			if issue.RepoID != repo.ID || issue.IsClosed {
-				continue
-			}
-
-			if err = issue.ChangeStatus(doer, repo, true); err != nil {
-				return err
-			}
+		// Issues are only closed or reopened when commits land on the default
+		// branch.
+		if !isDefaultBranch {
+			continue
 		}
 
-		// It is conflict to have close and reopen at same time, so refsMarkd doesn't need to reinit here.
-		for _, ref := range IssueReopenKeywordsPat.FindAllString(c.Message, -1) {
-			ref = ref[strings.IndexByte(ref, byte(' '))+1:]
-			ref = strings.TrimRightFunc(ref, issueIndexTrimRight)
-
-			if len(ref) == 0 {
-				continue
-			}
-
-			// Add repo name if missing
-			if ref[0] == '#' {
-				ref = fmt.Sprintf("%s%s", repo.FullName(), ref)
-			} else if !strings.Contains(ref, "/") {
-				// We don't support User#ID syntax yet
-				// return ErrNotImplemented
-				continue
-			}
+		// It is conflict to have close and reopen at same time, so marked issues
+		// of closing are skipped by reopening.
+		refMarked = make(map[int64]bool)
+		if err := changeIssuesStatusByKeywords(doer, repo, c, conf.Repository.Issue.CloseKeywords, true, refMarked); err != nil {
+			return err
+		}
+		if err := changeIssuesStatusByKeywords(doer, repo, c, conf.Repository.Issue.ReopenKeywords, false, refMarked); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			issue, err := GetIssueByRef(ref)
-			if err != nil {
-				if errors.IsIssueNotExist(err) {
-					continue
-				}
-				return err
-			}
+// mailCommitMentions sends one mail to each user mentioned in messages of the
+// pushed commits, listing commits that mention the user. Mentions in code spans,
+// the pusher and users who cannot read the repository are skipped.
+func mailCommitMentions(doer *User, repo *Repository, commits []*PushCommit) error {
+	if !conf.User.EnableEmailNotification {
+		return nil
+	}
 
-			if refMarked[issue.ID] {
+	names := make([]string, 0, 5)
+	mentioned := make(map[string][]email.MentionedCommit)
+	// Commits are appended in the reverse order.
+	for i := len(commits) - 1; i >= 0; i-- {
+		c := commits[i]
+		commit := email.MentionedCommit{
+			Summary: strings.Split(c.Message, "\n")[0],
+			Link:    repo.HTMLURL() + "/commit/" + c.Sha1,
+		}
+		for _, name := range markup.FindAllMentions(markup.StripCodeSpans(c.Message)) {
+			name = strings.ToLower(name)
+			if n := len(mentioned[name]); n > 0 && mentioned[name][n-1] == commit {
 				continue
+			} else if n == 0 {
+				names = append(names, name)
 			}
-			refMarked[issue.ID] = true
+			mentioned[name] = append(mentioned[name], commit)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
 
-			if issue.RepoID != repo.ID || !issue.IsClosed {
+	muted, err := getMutedUserIDs(x, repo.ID, time.Now())
+	if err != nil {
+		return fmt.Errorf("getMutedUserIDs [repo_id: %d]: %v", repo.ID, err)
+	}
+	for _, name := range names {
+		u, err := GetUserByName(name)
+		if err != nil {
+			if errors.IsUserNotExist(err) {
 				continue
 			}
+			return fmt.Errorf("GetUserByName [%s]: %v", name, err)
+		} else if u.ID == doer.ID || u.IsOrganization() || !u.IsMailable() || muted[u.ID] {
+			continue
+		}
 
-			if err = issue.ChangeStatus(doer, repo, false); err != nil {
-				return err
-			}
+		has, err := HasAccess(u.ID, repo, ACCESS_MODE_READ)
+		if err != nil {
+			return fmt.Errorf("HasAccess [user_id: %d, repo_id: %d]: %v", u.ID, repo.ID, err)
+		} else if !has {
+			continue
 		}
+		email.SendCommitMentionMail(NewMailerRepo(repo), NewMailerUser(doer), u.Email, mentioned[name])
 	}
 	return nil
 }
@@ -469,6 +477,7 @@ func CommitRepoAction(opts CommitRepoActionOptions) error {
 	}
 
 	// Change repository bare status and update last updated time.
+	wasBare := repo.IsBare
 	repo.IsBare = false
 	if err = UpdateRepository(repo, false); err != nil {
 		return fmt.Errorf("UpdateRepository: %v", err)
@@ -476,6 +485,7 @@ func CommitRepoAction(opts CommitRepoActionOptions) error {
 
 	isNewRef := opts.OldCommitID == git.EMPTY_SHA
 	isDelRef := opts.NewCommitID == git.EMPTY_SHA
+	isDefaultBranch := repo.IsDefaultBranchRef(opts.RefFullName)
 
 	opType := ACTION_COMMIT_REPO
 	// Check if it's tag push or branch.
@@ -487,14 +497,24 @@ func CommitRepoAction(opts CommitRepoActionOptions) error {
 			opts.Commits.CompareURL = repo.ComposeCompareURL(opts.OldCommitID, opts.NewCommitID)
 		}
 
-		// Only update issues via commits when internal issue tracker is enabled
-		if repo.EnableIssues && !repo.EnableExternalTracker {
-			if err = UpdateIssuesCommit(pusher, repo, opts.Commits.Commits); err != nil {
-				log.Error("UpdateIssuesCommit: %v", err)
+		// Only issues of repositories with internal issue tracker are updated,
+		// references to external issue trackers are skipped.
+		if err = UpdateIssuesCommit(pusher, repo, opts.Commits.Commits, isDefaultBranch); err != nil {
+			log.Error("UpdateIssuesCommit: %v", err)
+		}
+		if err = mailCommitMentions(pusher, repo, opts.Commits.Commits); err != nil {
+			log.Error("mailCommitMentions: %v", err)
+		}
+		if !isDelRef {
+			if err = mailPathWatches(pusher, repo, git.RefEndName(opts.RefFullName), isDefaultBranch, isNewRef, wasBare, opts.OldCommitID, opts.NewCommitID); err != nil {
+				log.Error("mailPathWatches: %v", err)
 			}
 		}
 	}
 
+	// Webhook payloads have their own limit of commits, take them before commits
+	// are capped for the feed.
+	payloadCommits := opts.Commits.limit(conf.Webhook.MaxPayloadCommits)
 	if len(opts.Commits.Commits) > conf.UI.FeedMaxCommitNum {
 		opts.Commits.Commits = opts.Commits.Commits[:conf.UI.FeedMaxCommitNum]
 	}
@@ -542,7 +562,11 @@ func CommitRepoAction(opts CommitRepoActionOptions) error {
 
 		compareURL := conf.Server.ExternalURL + opts.Commits.CompareURL
 		if isNewRef {
+			// A new branch is compared against the default branch when there is one.
 			compareURL = ""
+			if !wasBare && refName != repo.DefaultBranch {
+				compareURL = conf.Server.ExternalURL + repo.ComposeCompareURL(repo.DefaultBranch, refName)
+			}
 			if err = PrepareWebhooks(repo, HOOK_EVENT_CREATE, &api.CreatePayload{
 				Ref:           refName,
 				RefType:       "branch",
@@ -559,12 +583,13 @@ func CommitRepoAction(opts CommitRepoActionOptions) error {
 			}
 		}
 
-		commits, err := opts.Commits.ToApiPayloadCommits(repo.RepoPath(), repo.HTMLURL())
+		commits, err := payloadCommits.ToApiPayloadCommits(repo.RepoPath(), repo.HTMLURL())
 		if err != nil {
 			return fmt.Errorf("ToApiPayloadCommits: %v", err)
 		}
 
-		if err = PrepareWebhooks(repo, HOOK_EVENT_PUSH, &api.PushPayload{
+		action.IsDefaultBranch = isDefaultBranch
+		p := NewPushPayload(&api.PushPayload{
 			Ref:        opts.RefFullName,
 			Before:     opts.OldCommitID,
 			After:      opts.NewCommitID,
@@ -573,7 +598,9 @@ func CommitRepoAction(opts CommitRepoActionOptions) error {
 			Repo:       apiRepo,
 			Pusher:     apiPusher,
 			Sender:     apiPusher,
-		}); err != nil {
+		}, payloadCommits.Len)
+		p.IsDefault = isDefaultBranch
+		if err = PrepareWebhooks(repo, HOOK_EVENT_PUSH, p); err != nil {
 			return fmt.Errorf("PrepareWebhooks.(new commit): %v", err)
 		}
 
@@ -669,17 +696,29 @@ func MergePullRequestAction(actUser *User, repo *Repository, pull *Issue) error
 	return mergePullRequestAction(x, actUser, repo, pull)
 }
 
-func mirrorSyncAction(opType ActionType, repo *Repository, refName string, data []byte) error {
+// NewMirrorPusher returns a synthetic user to be attributed as the pusher of
+// changes fetched by mirror synchronization of the repository.
+func NewMirrorPusher(repo *Repository) *api.User {
+	return &api.User{
+		ID:       -1,
+		UserName: "mirror",
+		Login:    "mirror",
+		FullName: "Mirror of " + repo.FullName(),
+	}
+}
+
+func mirrorSyncAction(opType ActionType, repo *Repository, refName string, isDefaultBranch bool, data []byte) error {
 	return NotifyWatchers(&Action{
-		ActUserID:    repo.OwnerID,
-		ActUserName:  repo.MustOwner().Name,
-		OpType:       opType,
-		Content:      string(data),
-		RepoID:       repo.ID,
-		RepoUserName: repo.MustOwner().Name,
-		RepoName:     repo.Name,
-		RefName:      refName,
-		IsPrivate:    repo.IsPrivate,
+		ActUserID:       repo.OwnerID,
+		ActUserName:     repo.MustOwner().Name,
+		OpType:          opType,
+		Content:         string(data),
+		RepoID:          repo.ID,
+		RepoUserName:    repo.MustOwner().Name,
+		RepoName:        repo.Name,
+		RefName:         refName,
+		IsPrivate:       repo.IsPrivate,
+		IsDefaultBranch: isDefaultBranch,
 	})
 }
 
@@ -692,18 +731,21 @@ type MirrorSyncPushActionOptions struct {
 
 // MirrorSyncPushAction adds new action for mirror synchronization of pushed commits.
 func MirrorSyncPushAction(repo *Repository, opts MirrorSyncPushActionOptions) error {
+	payloadCommits := opts.Commits.limit(conf.Webhook.MaxPayloadCommits)
 	if len(opts.Commits.Commits) > conf.UI.FeedMaxCommitNum {
 		opts.Commits.Commits = opts.Commits.Commits[:conf.UI.FeedMaxCommitNum]
 	}
 
-	apiCommits, err := opts.Commits.ToApiPayloadCommits(repo.RepoPath(), repo.HTMLURL())
+	apiCommits, err := payloadCommits.ToApiPayloadCommits(repo.RepoPath(), repo.HTMLURL())
 	if err != nil {
 		return fmt.Errorf("ToApiPayloadCommits: %v", err)
 	}
 
 	opts.Commits.CompareURL = repo.ComposeCompareURL(opts.OldCommitID, opts.NewCommitID)
-	apiPusher := repo.MustOwner().APIFormat()
-	if err := PrepareWebhooks(repo, HOOK_EVENT_PUSH, &api.PushPayload{
+	apiPusher := NewMirrorPusher(repo)
+	// Mirror synchronization reports short names of branches.
+	isDefaultBranch := repo.IsDefaultBranchRef(git.BRANCH_PREFIX + opts.RefName)
+	p := NewPushPayload(&api.PushPayload{
 		Ref:        opts.RefName,
 		Before:     opts.OldCommitID,
 		After:      opts.NewCommitID,
@@ -712,26 +754,32 @@ func MirrorSyncPushAction(repo *Repository, opts MirrorSyncPushActionOptions) er
 		Repo:       repo.APIFormat(nil),
 		Pusher:     apiPusher,
 		Sender:     apiPusher,
-	}); err != nil {
+	}, payloadCommits.Len)
+	p.IsDefault = isDefaultBranch
+	if err := PrepareWebhooks(repo, HOOK_EVENT_PUSH, p); err != nil {
 		return fmt.Errorf("PrepareWebhooks: %v", err)
 	}
+	if isDefaultBranch {
+		AddDependencyScanTask(repo.ID)
+		AddCommitStatsTask(repo.ID)
+	}
 
 	data, err := jsoniter.Marshal(opts.Commits)
 	if err != nil {
 		return err
 	}
 
-	return mirrorSyncAction(ACTION_MIRROR_SYNC_PUSH, repo, opts.RefName, data)
+	return mirrorSyncAction(ACTION_MIRROR_SYNC_PUSH, repo, opts.RefName, isDefaultBranch, data)
 }
 
 // MirrorSyncCreateAction adds new action for mirror synchronization of new reference.
 func MirrorSyncCreateAction(repo *Repository, refName string) error {
-	return mirrorSyncAction(ACTION_MIRROR_SYNC_CREATE, repo, refName, nil)
+	return mirrorSyncAction(ACTION_MIRROR_SYNC_CREATE, repo, refName, false, nil)
 }
 
 // MirrorSyncCreateAction adds new action for mirror synchronization of delete reference.
 func MirrorSyncDeleteAction(repo *Repository, refName string) error {
-	return mirrorSyncAction(ACTION_MIRROR_SYNC_DELETE, repo, refName, nil)
+	return mirrorSyncAction(ACTION_MIRROR_SYNC_DELETE, repo, refName, false, nil)
 }
 
 // GetFeeds returns action list of given user in given context.
@@ -21,6 +21,9 @@ type NoticeType int
 
 const (
 	NOTICE_REPOSITORY NoticeType = iota + 1
+	NOTICE_MAINTENANCE
+	NOTICE_LIFECYCLE
+	NOTICE_SITE_CONTENT
 )
 
 // Notice represents a system notice for admin.
@@ -68,6 +71,16 @@ func CreateRepositoryNotice(desc string) error {
 	return CreateNotice(NOTICE_REPOSITORY, desc)
 }
 
+// CreateMaintenanceNotice creates new system notice with type NOTICE_MAINTENANCE.
+func CreateMaintenanceNotice(desc string) error {
+	return CreateNotice(NOTICE_MAINTENANCE, desc)
+}
+
+// CreateLifecycleNotice creates new system notice with type NOTICE_LIFECYCLE.
+func CreateLifecycleNotice(desc string) error {
+	return CreateNotice(NOTICE_LIFECYCLE, desc)
+}
+
 // RemoveAllWithNotice removes all directories in given path and
 // creates a system notice when error occurs.
 func RemoveAllWithNotice(title, path string) {
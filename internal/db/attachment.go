@@ -5,8 +5,10 @@
 package db
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"mime/multipart"
 	"os"
 	"path"
@@ -16,6 +18,8 @@ import (
 	"xorm.io/xorm"
 
 	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/tool"
 )
 
 // Attachment represent a attachment of issue/comment/release.
@@ -52,6 +56,53 @@ func (attach *Attachment) LocalPath() string {
 	return AttachmentLocalPath(attach.UUID)
 }
 
+// PreviewPath returns where the preview of an image attachment is stored in
+// local file system. The preview only exists for images that are larger than
+// the preview size.
+func (attach *Attachment) PreviewPath() string {
+	return attach.LocalPath() + ".preview"
+}
+
+// canViewReleaseAttachments returns true if the viewer with given access mode
+// can download attachments of the release of the repository. Attachments of
+// drafts are only visible to writers, like the drafts themselves.
+func canViewReleaseAttachments(mode AccessMode, repo *Repository, release *Release) bool {
+	if release.IsDraft {
+		return mode >= ACCESS_MODE_WRITE
+	}
+	return mode >= ACCESS_MODE_READ || repo.CanGuestViewReleases()
+}
+
+// CanBeViewedBy returns true if the user can download the attachment, the user
+// is nil for anonymous viewers. Attachments of releases require access to the
+// repository unless its releases are public, other attachments can be
+// downloaded by anyone with the link.
+func (attach *Attachment) CanBeViewedBy(u *User) (bool, error) {
+	if attach.ReleaseID == 0 {
+		return true, nil
+	}
+
+	release, err := GetReleaseByID(attach.ReleaseID)
+	if err != nil {
+		if IsErrReleaseNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get release: %v", err)
+	}
+	repo, err := GetRepositoryByID(release.RepoID)
+	if err != nil {
+		if errors.IsRepoNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get repository: %v", err)
+	}
+	mode, err := EffectiveAccessMode(u, repo)
+	if err != nil {
+		return false, fmt.Errorf("get access mode: %v", err)
+	}
+	return canViewReleaseAttachments(mode, repo, release), nil
+}
+
 // NewAttachment creates a new attachment object.
 func NewAttachment(name string, buf []byte, file multipart.File) (_ *Attachment, err error) {
 	attach := &Attachment{
@@ -64,16 +115,33 @@ func NewAttachment(name string, buf []byte, file multipart.File) (_ *Attachment,
 		return nil, fmt.Errorf("MkdirAll: %v", err)
 	}
 
-	fw, err := os.Create(localPath)
-	if err != nil {
-		return nil, fmt.Errorf("Create: %v", err)
-	}
-	defer fw.Close()
+	if tool.IsImageFile(buf) {
+		data, err := ioutil.ReadAll(io.MultiReader(bytes.NewReader(buf), file))
+		if err != nil {
+			return nil, fmt.Errorf("ReadAll: %v", err)
+		}
+
+		data, preview := prepareAttachmentImage(data)
+		if preview != nil {
+			if err = ioutil.WriteFile(attach.PreviewPath(), preview, 0644); err != nil {
+				return nil, fmt.Errorf("WriteFile: %v", err)
+			}
+		}
+		if err = ioutil.WriteFile(localPath, data, 0644); err != nil {
+			return nil, fmt.Errorf("WriteFile: %v", err)
+		}
+	} else {
+		fw, err := os.Create(localPath)
+		if err != nil {
+			return nil, fmt.Errorf("Create: %v", err)
+		}
+		defer fw.Close()
 
-	if _, err = fw.Write(buf); err != nil {
-		return nil, fmt.Errorf("Write: %v", err)
-	} else if _, err = io.Copy(fw, file); err != nil {
-		return nil, fmt.Errorf("Copy: %v", err)
+		if _, err = fw.Write(buf); err != nil {
+			return nil, fmt.Errorf("Write: %v", err)
+		} else if _, err = io.Copy(fw, file); err != nil {
+			return nil, fmt.Errorf("Copy: %v", err)
+		}
 	}
 
 	if _, err := x.Insert(attach); err != nil {
@@ -152,6 +220,9 @@ func DeleteAttachments(attachments []*Attachment, remove bool) (int, error) {
 			if err := os.Remove(a.LocalPath()); err != nil {
 				return i, err
 			}
+			if err := os.Remove(a.PreviewPath()); err != nil && !os.IsNotExist(err) {
+				return i, err
+			}
 		}
 
 		if _, err := x.Delete(a); err != nil {
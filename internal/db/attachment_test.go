@@ -0,0 +1,40 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_canViewReleaseAttachments(t *testing.T) {
+	Convey("Check access to attachments of releases", t, func() {
+		privateRepo := &Repository{IsPrivate: true}
+		publicReleasesRepo := &Repository{IsPrivate: true, AllowPublicReleases: true}
+		release := &Release{}
+		draft := &Release{IsDraft: true}
+
+		Convey("Guests need public releases", func() {
+			So(canViewReleaseAttachments(ACCESS_MODE_NONE, privateRepo, release), ShouldBeFalse)
+			So(canViewReleaseAttachments(ACCESS_MODE_NONE, publicReleasesRepo, release), ShouldBeTrue)
+		})
+
+		Convey("Readers can view published releases", func() {
+			So(canViewReleaseAttachments(ACCESS_MODE_READ, privateRepo, release), ShouldBeTrue)
+		})
+
+		Convey("Bare repositories have no public releases", func() {
+			repo := &Repository{IsPrivate: true, IsBare: true, AllowPublicReleases: true}
+			So(canViewReleaseAttachments(ACCESS_MODE_NONE, repo, release), ShouldBeFalse)
+		})
+
+		Convey("Drafts are only visible to writers", func() {
+			So(canViewReleaseAttachments(ACCESS_MODE_NONE, publicReleasesRepo, draft), ShouldBeFalse)
+			So(canViewReleaseAttachments(ACCESS_MODE_READ, privateRepo, draft), ShouldBeFalse)
+			So(canViewReleaseAttachments(ACCESS_MODE_WRITE, privateRepo, draft), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/tool"
+)
+
+type BotCapability int
+
+const (
+	BOT_CAPABILITY_READ_CODE BotCapability = iota
+	BOT_CAPABILITY_SET_STATUS
+	BOT_CAPABILITY_COMMENT_PULL
+)
+
+// BotGrant represents capabilities granted to a bot account on a repository
+// of its organization. Bots are not members of any team, they only have access
+// to repositories with grants.
+type BotGrant struct {
+	ID     int64
+	BotID  int64       `xorm:"UNIQUE(s)"`
+	RepoID int64       `xorm:"UNIQUE(s) INDEX"`
+	Repo   *Repository `xorm:"-" json:"-"`
+
+	CanReadCode    bool
+	CanSetStatus   bool
+	CanCommentPull bool
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+	Updated     time.Time `xorm:"-" json:"-"`
+	UpdatedUnix int64
+}
+
+func (g *BotGrant) BeforeInsert() {
+	g.CreatedUnix = time.Now().Unix()
+	g.UpdatedUnix = g.CreatedUnix
+}
+
+func (g *BotGrant) BeforeUpdate() {
+	g.UpdatedUnix = time.Now().Unix()
+}
+
+func (g *BotGrant) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		g.Created = time.Unix(g.CreatedUnix, 0).Local()
+	case "updated_unix":
+		g.Updated = time.Unix(g.UpdatedUnix, 0).Local()
+	}
+}
+
+// Allows returns true if the capability is granted.
+func (g *BotGrant) Allows(capability BotCapability) bool {
+	switch capability {
+	case BOT_CAPABILITY_READ_CODE:
+		return g.CanReadCode
+	case BOT_CAPABILITY_SET_STATUS:
+		return g.CanSetStatus
+	case BOT_CAPABILITY_COMMENT_PULL:
+		return g.CanCommentPull
+	}
+	return false
+}
+
+// IsEmpty returns true if no capability is granted.
+func (g *BotGrant) IsEmpty() bool {
+	return !g.CanReadCode && !g.CanSetStatus && !g.CanCommentPull
+}
+
+// CreateBot creates a new bot account within the organization.
+func CreateBot(org, bot *User) (err error) {
+	if err = IsUsableUsername(bot.Name); err != nil {
+		return err
+	}
+
+	isExist, err := IsUserExist(0, bot.Name)
+	if err != nil {
+		return err
+	} else if isExist {
+		return ErrUserAlreadyExist{bot.Name}
+	}
+
+	// The e-mail is optional and only identifies commits authored by the bot.
+	if bot.Email != "" {
+		bot.Email = strings.ToLower(bot.Email)
+		isExist, err = IsEmailUsed(bot.Email)
+		if err != nil {
+			return err
+		} else if isExist {
+			return ErrEmailAlreadyUsed{bot.Email}
+		}
+	}
+
+	bot.LowerName = strings.ToLower(bot.Name)
+	bot.Type = USER_TYPE_BOT
+	bot.BotOrgID = org.ID
+	bot.IsActive = true
+	bot.Avatar = tool.HashEmail(bot.LowerName)
+	if bot.Rands, err = GetUserSalt(); err != nil {
+		return err
+	}
+	if bot.Salt, err = GetUserSalt(); err != nil {
+		return err
+	}
+	bot.MaxRepoCreation = 0
+
+	_, err = x.Insert(bot)
+	return err
+}
+
+// GetBotsByOrgID returns all bot accounts of the organization.
+func GetBotsByOrgID(orgID int64) ([]*User, error) {
+	bots := make([]*User, 0, 5)
+	return bots, x.Where("type = ?", USER_TYPE_BOT).And("bot_org_id = ?", orgID).Asc("lower_name").Find(&bots)
+}
+
+// CountBots returns number of bot accounts of all organizations.
+func CountBots() int64 {
+	count, _ := x.Where("type = ?", USER_TYPE_BOT).Count(new(User))
+	return count
+}
+
+// Bots returns bot accounts of all organizations in given page.
+func Bots(page, pageSize int) ([]*User, error) {
+	bots := make([]*User, 0, pageSize)
+	return bots, x.Limit(pageSize, (page-1)*pageSize).Where("type = ?", USER_TYPE_BOT).Asc("id").Find(&bots)
+}
+
+// LoadBotAttributes loads the organization and grants of the bot account.
+func (u *User) LoadBotAttributes() (err error) {
+	if u.BotOrg == nil {
+		u.BotOrg, err = GetUserByID(u.BotOrgID)
+		if err != nil {
+			return fmt.Errorf("GetUserByID [org_id: %d]: %v", u.BotOrgID, err)
+		}
+	}
+
+	if u.BotGrants == nil {
+		u.BotGrants, err = GetBotGrants(u.ID)
+		if err != nil {
+			return fmt.Errorf("GetBotGrants: %v", err)
+		}
+	}
+	return nil
+}
+
+// GetBotGrants returns all grants of the bot account with their repositories.
+func GetBotGrants(botID int64) ([]*BotGrant, error) {
+	grants := make([]*BotGrant, 0, 5)
+	if err := x.Where("bot_id = ?", botID).Asc("id").Find(&grants); err != nil {
+		return nil, err
+	}
+
+	for _, g := range grants {
+		repo, err := GetRepositoryByID(g.RepoID)
+		if err != nil {
+			return nil, fmt.Errorf("GetRepositoryByID [repo_id: %d]: %v", g.RepoID, err)
+		}
+		g.Repo = repo
+	}
+	return grants, nil
+}
+
+func getBotGrant(e Engine, botID, repoID int64) (*BotGrant, error) {
+	g := &BotGrant{
+		BotID:  botID,
+		RepoID: repoID,
+	}
+	has, err := e.Get(g)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrBotGrantNotExist{botID, repoID}
+	}
+	return g, nil
+}
+
+// GetBotGrant returns the grant of the bot account on the repository.
+func GetBotGrant(botID, repoID int64) (*BotGrant, error) {
+	return getBotGrant(x, botID, repoID)
+}
+
+// UpdateBotGrant creates or updates capabilities of the bot account on the
+// repository, and revokes the grant when no capability is granted. Setting
+// statuses and commenting on pull requests require reading code, which is
+// granted implicitly.
+func UpdateBotGrant(g *BotGrant) error {
+	if g.IsEmpty() {
+		return DeleteBotGrant(g.BotID, g.RepoID)
+	}
+	if g.CanSetStatus || g.CanCommentPull {
+		g.CanReadCode = true
+	}
+
+	existing, err := GetBotGrant(g.BotID, g.RepoID)
+	if err != nil {
+		if !IsErrBotGrantNotExist(err) {
+			return err
+		}
+		_, err = x.Insert(g)
+		return err
+	}
+
+	g.ID = existing.ID
+	_, err = x.ID(g.ID).Cols("can_read_code", "can_set_status", "can_comment_pull").Update(g)
+	return err
+}
+
+// DeleteBotGrant revokes all capabilities of the bot account on the repository.
+func DeleteBotGrant(botID, repoID int64) error {
+	_, err := x.Delete(&BotGrant{
+		BotID:  botID,
+		RepoID: repoID,
+	})
+	return err
+}
+
+func deleteBot(e *xorm.Session, bot *User) (err error) {
+	// Keep content created by the bot, but show it as from someone has been deleted.
+	ghostID := NewGhostUser().ID
+	if _, err = e.Exec("UPDATE `issue` SET poster_id = ? WHERE poster_id = ?", ghostID, bot.ID); err != nil {
+		return fmt.Errorf("reattribute issues: %v", err)
+	} else if _, err = e.Exec("UPDATE `comment` SET poster_id = ? WHERE poster_id = ?", ghostID, bot.ID); err != nil {
+		return fmt.Errorf("reattribute comments: %v", err)
+	} else if _, err = e.Exec("UPDATE `commit_status` SET creator_id = ? WHERE creator_id = ?", ghostID, bot.ID); err != nil {
+		return fmt.Errorf("reattribute commit statuses: %v", err)
+	}
+
+	if _, err = e.Delete(&BotGrant{BotID: bot.ID}); err != nil {
+		return fmt.Errorf("delete grants: %v", err)
+	}
+
+	// Access tokens are revoked along with the user.
+	if err = deleteUser(e, bot); err != nil {
+		return fmt.Errorf("deleteUser: %v", err)
+	}
+	return nil
+}
+
+// DeleteBot deletes the bot account and revokes all of its access tokens and
+// grants. Issues, comments and commit statuses created by the bot are kept and
+// attributed to the ghost user.
+func DeleteBot(bot *User) (err error) {
+	if !bot.IsBot() {
+		return errors.UserNotExist{UserID: bot.ID, Name: bot.Name}
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if err = deleteBot(sess, bot); err != nil {
+		return err
+	}
+
+	return sess.Commit()
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_BotGrant_Allows(t *testing.T) {
+	Convey("Check capabilities of bot grant", t, func() {
+		g := &BotGrant{
+			CanReadCode:  true,
+			CanSetStatus: true,
+		}
+		So(g.Allows(BOT_CAPABILITY_READ_CODE), ShouldBeTrue)
+		So(g.Allows(BOT_CAPABILITY_SET_STATUS), ShouldBeTrue)
+		So(g.Allows(BOT_CAPABILITY_COMMENT_PULL), ShouldBeFalse)
+		So(g.IsEmpty(), ShouldBeFalse)
+
+		So(new(BotGrant).IsEmpty(), ShouldBeTrue)
+	})
+}
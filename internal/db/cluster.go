@@ -0,0 +1,75 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/graceful"
+	"gogs.io/gogs/internal/sync"
+)
+
+// clusterPollInterval is how often the node that runs scheduled tasks looks for
+// hook tasks and pull request tests that are recorded by other nodes.
+const clusterPollInterval = 10 * time.Second
+
+// queueClusterTasks queues repositories that have undelivered hook tasks and
+// pull requests that are waiting to be tested, which include those recorded
+// by other nodes of the cluster.
+func queueClusterTasks() error {
+	repoIDs := make([]int64, 0, 10)
+	if err := x.Table("hook_task").Distinct("repo_id").Where("is_delivered = ?", false).Find(&repoIDs); err != nil {
+		return fmt.Errorf("find repositories with undelivered hook tasks: %v", err)
+	}
+	for _, id := range repoIDs {
+		HookQueue.Add(id)
+	}
+
+	prIDs := make([]int64, 0, 10)
+	if err := x.Table("pull_request").Cols("id").Where("status = ?", PULL_REQUEST_STATUS_CHECKING).Find(&prIDs); err != nil {
+		return fmt.Errorf("find pull requests waiting to be tested: %v", err)
+	}
+	for _, id := range prIDs {
+		PullRequestQueue.Add(id)
+	}
+	return nil
+}
+
+// InitPollClusterTasks starts queueing hook deliveries and pull request tests
+// that are recorded by other nodes of the cluster. It must only be called on
+// the node that runs scheduled tasks.
+func InitPollClusterTasks() {
+	go func() {
+		ticker := time.NewTicker(clusterPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := queueClusterTasks(); err != nil {
+					log.Error("Failed to queue tasks of the cluster: %v", err)
+				}
+			case <-graceful.Done():
+				return
+			}
+		}
+	}()
+}
+
+// InitDiscardQueues drains in-process queues of hook deliveries and pull request
+// tests on nodes that do not run scheduled tasks. The tasks stay recorded in
+// the database, and are delivered and tested by the node that does, so that
+// every hook is delivered only once.
+func InitDiscardQueues() {
+	for _, q := range []*sync.UniqueQueue{HookQueue, PullRequestQueue} {
+		go func(q *sync.UniqueQueue) {
+			for id := range q.Queue() {
+				q.Process(id, func() {})
+			}
+		}(q)
+	}
+}
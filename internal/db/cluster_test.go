@@ -0,0 +1,42 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_queueClusterTasks(t *testing.T) {
+	setupTestDB(t)
+
+	insertTestBeans(t,
+		&HookTask{RepoID: 1, IsDelivered: true},
+		&HookTask{RepoID: 2},
+		&HookTask{RepoID: 2},
+		&PullRequest{Status: PULL_REQUEST_STATUS_MERGEABLE},
+		&PullRequest{Status: PULL_REQUEST_STATUS_CHECKING},
+	)
+	defer func() {
+		HookQueue.Process(<-HookQueue.Queue(), func() {})
+		PullRequestQueue.Process(<-PullRequestQueue.Queue(), func() {})
+	}()
+
+	Convey("Queue tasks recorded by other nodes of the cluster", t, func() {
+		So(queueClusterTasks(), ShouldBeNil)
+
+		So(HookQueue.Exist(1), ShouldBeFalse)
+		So(HookQueue.Exist(2), ShouldBeTrue)
+		So(PullRequestQueue.Exist(1), ShouldBeFalse)
+		So(PullRequestQueue.Exist(2), ShouldBeTrue)
+
+		Convey("Do not queue the same task twice", func() {
+			So(queueClusterTasks(), ShouldBeNil)
+			So(len(HookQueue.Queue()), ShouldEqual, 1)
+			So(len(PullRequestQueue.Queue()), ShouldEqual, 1)
+		})
+	})
+}
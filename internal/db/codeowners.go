@@ -0,0 +1,343 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gogs/git-module"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/process"
+)
+
+// codeOwnersPaths are paths of the CODEOWNERS file in the tree, the first one
+// that exists is used.
+var codeOwnersPaths = []string{".gogs/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeOwnersRule is a rule of the CODEOWNERS file that assigns owners to paths
+// matching the pattern. Owners are "@user", "@org/team" or email addresses.
+type CodeOwnersRule struct {
+	// Line is the line number of the rule in the CODEOWNERS file.
+	Line    int
+	Pattern string
+	Owners  []string
+
+	matcher *regexp.Regexp
+}
+
+// Match returns true if the file at given path matches the pattern of the rule.
+func (r *CodeOwnersRule) Match(treePath string) bool {
+	return r.matcher.MatchString(strings.TrimPrefix(treePath, "/"))
+}
+
+// compilePathPattern converts a pattern to the regular expression that matches
+// paths of files, following rules of ".gitignore":
+//   - A pattern without a slash, except a trailing one, matches at any depth.
+//   - A pattern with a leading or middle slash is relative to the root.
+//   - A pattern with a trailing slash only matches directories.
+//   - "*" and "?" do not match slashes, "**" matches across directories.
+//
+// When matchDescendants is true, a pattern that matches a directory matches all
+// files under it like in ".gitignore" and CODEOWNERS, otherwise it only matches
+// files themselves like in ".gitattributes".
+func compilePathPattern(pattern string, matchDescendants bool) (*regexp.Regexp, error) {
+	p := strings.TrimPrefix(pattern, "/")
+	anchored := p != pattern
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	if p == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+	if strings.Contains(p, "/") {
+		anchored = true
+	}
+
+	var buf strings.Builder
+	buf.WriteString("^")
+	if !anchored {
+		buf.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/") && (i == 0 || p[i-1] == '/'):
+			buf.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			buf.WriteString(".*")
+			i++
+		case p[i] == '*':
+			buf.WriteString("[^/]*")
+		case p[i] == '?':
+			buf.WriteString("[^/]")
+		default:
+			buf.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	switch {
+	case dirOnly:
+		if !matchDescendants {
+			return nil, fmt.Errorf("directory pattern")
+		}
+		buf.WriteString("/.+$")
+	case matchDescendants:
+		buf.WriteString("(?:/.+)?$")
+	default:
+		buf.WriteString("$")
+	}
+	return regexp.Compile(buf.String())
+}
+
+// ParseCodeOwners parses rules of a CODEOWNERS file in the order of lines.
+// Blank lines, comments and rules with invalid patterns are skipped. A rule
+// without owners is kept, so that it unassigns owners of matching paths.
+func ParseCodeOwners(data []byte) []*CodeOwnersRule {
+	rules := make([]*CodeOwnersRule, 0, 10)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		matcher, err := compilePathPattern(fields[0], true)
+		if err != nil {
+			continue
+		}
+		rules = append(rules, &CodeOwnersRule{
+			Line:    lineNum,
+			Pattern: fields[0],
+			Owners:  fields[1:],
+			matcher: matcher,
+		})
+	}
+	return rules
+}
+
+// MatchCodeOwners returns the rule that applies to the file at given path, or
+// nil if there is none. The last matching rule takes precedence.
+func MatchCodeOwners(rules []*CodeOwnersRule, treePath string) *CodeOwnersRule {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].Match(treePath) {
+			return rules[i]
+		}
+	}
+	return nil
+}
+
+// resolveCodeOwners returns users and teams of owners in the rule. Owners that
+// do not exist, and teams of organizations other than the owner of the
+// repository, are ignored.
+func (repo *Repository) resolveCodeOwners(owners []string) ([]*User, []*Team, error) {
+	if err := repo.GetOwner(); err != nil {
+		return nil, nil, fmt.Errorf("GetOwner: %v", err)
+	}
+
+	users := make([]*User, 0, len(owners))
+	teams := make([]*Team, 0, len(owners))
+	seenUsers := make(map[int64]bool)
+	seenTeams := make(map[int64]bool)
+	for _, owner := range owners {
+		if !strings.HasPrefix(owner, "@") {
+			u, err := GetUserByEmail(owner)
+			if err != nil {
+				if errors.IsUserNotExist(err) {
+					continue
+				}
+				return nil, nil, fmt.Errorf("GetUserByEmail [%s]: %v", owner, err)
+			}
+			if !seenUsers[u.ID] {
+				seenUsers[u.ID] = true
+				users = append(users, u)
+			}
+			continue
+		}
+
+		owner = owner[1:]
+		if i := strings.Index(owner, "/"); i >= 0 {
+			if !repo.Owner.IsOrganization() || !strings.EqualFold(owner[:i], repo.Owner.Name) {
+				continue
+			}
+			t, err := GetTeamOfOrgByName(repo.OwnerID, owner[i+1:])
+			if err != nil {
+				if errors.IsTeamNotExist(err) {
+					continue
+				}
+				return nil, nil, fmt.Errorf("GetTeamOfOrgByName [%s]: %v", owner, err)
+			}
+			if !seenTeams[t.ID] {
+				seenTeams[t.ID] = true
+				teams = append(teams, t)
+			}
+			continue
+		}
+
+		u, err := GetUserByName(owner)
+		if err != nil {
+			if errors.IsUserNotExist(err) {
+				continue
+			}
+			return nil, nil, fmt.Errorf("GetUserByName [%s]: %v", owner, err)
+		}
+		if u.IsOrganization() || seenUsers[u.ID] {
+			continue
+		}
+		seenUsers[u.ID] = true
+		users = append(users, u)
+	}
+	return users, teams, nil
+}
+
+// CodeOwnersOfPath returns users and teams that own the file at given path,
+// according to the CODEOWNERS file in the tree of given commit.
+func (repo *Repository) CodeOwnersOfPath(commit *git.Commit, treePath string) ([]*User, []*Team, error) {
+	fpath, data, err := readCodeOwners(commit)
+	if err != nil || fpath == "" {
+		return nil, nil, err
+	}
+
+	rule := MatchCodeOwners(ParseCodeOwners(data), treePath)
+	if rule == nil {
+		return nil, nil, nil
+	}
+	users, teams, err := repo.resolveCodeOwners(rule.Owners)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Trace("Code owners of %q [repo_id: %d] are defined by rule %q in %q", treePath, repo.ID, rule.Pattern, fpath)
+	return users, teams, nil
+}
+
+// readCodeOwners returns the path and content of the CODEOWNERS file in the
+// tree of given commit, the path is empty if there is none.
+func readCodeOwners(commit *git.Commit) (string, []byte, error) {
+	for _, fpath := range codeOwnersPaths {
+		data, err := readBlob(commit, fpath)
+		if err != nil {
+			if git.IsErrNotExist(err) {
+				continue
+			}
+			return "", nil, fmt.Errorf("read %q: %v", fpath, err)
+		}
+		return fpath, data, nil
+	}
+	return "", nil, nil
+}
+
+// maxOwnershipConflicts is the maximum number of conflicts reported for a
+// CODEOWNERS file.
+const maxOwnershipConflicts = 100
+
+// OwnershipConflict is a path matched by multiple rules of the CODEOWNERS file
+// that assign different owners, where only the last rule takes effect.
+type OwnershipConflict struct {
+	Path string
+	// Rules are all rules that match the path in the order of lines.
+	Rules []*CodeOwnersRule
+}
+
+// sameCodeOwners returns true if both lists have the same owners regardless
+// of order and case.
+func sameCodeOwners(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, owner := range a {
+		set[strings.ToLower(owner)] = true
+	}
+	other := make(map[string]bool, len(b))
+	for _, owner := range b {
+		owner = strings.ToLower(owner)
+		if !set[owner] {
+			return false
+		}
+		other[owner] = true
+	}
+	return len(set) == len(other)
+}
+
+// findOwnershipConflicts returns paths matched by an earlier rule whose owners
+// are different from the last matching rule, at most maxOwnershipConflicts
+// ones are returned.
+func findOwnershipConflicts(rules []*CodeOwnersRule, treePaths []string) []OwnershipConflict {
+	conflicts := make([]OwnershipConflict, 0, 10)
+	for _, treePath := range treePaths {
+		var matched []*CodeOwnersRule
+		for _, rule := range rules {
+			if rule.Match(treePath) {
+				matched = append(matched, rule)
+			}
+		}
+		if len(matched) < 2 {
+			continue
+		}
+
+		last := matched[len(matched)-1]
+		for _, rule := range matched[:len(matched)-1] {
+			if !sameCodeOwners(rule.Owners, last.Owners) {
+				conflicts = append(conflicts, OwnershipConflict{
+					Path:  treePath,
+					Rules: matched,
+				})
+				break
+			}
+		}
+		if len(conflicts) >= maxOwnershipConflicts {
+			break
+		}
+	}
+	return conflicts
+}
+
+// CodeOwnersConflicts returns paths in the tree of the default branch that are
+// matched by multiple rules of the CODEOWNERS file assigning different owners,
+// which are worth auditing because only the last rule takes effect.
+func (repo *Repository) CodeOwnersConflicts() ([]OwnershipConflict, error) {
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("open repository: %v", err)
+	}
+	commit, err := gitRepo.GetBranchCommit(repo.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("get branch commit: %v", err)
+	}
+
+	fpath, data, err := readCodeOwners(commit)
+	if err != nil {
+		return nil, err
+	} else if fpath == "" {
+		return nil, nil
+	}
+	rules := ParseCodeOwners(data)
+	if len(rules) < 2 {
+		return nil, nil
+	}
+
+	repoPath := repo.RepoPath()
+	stdout, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("CodeOwnersConflicts 'git ls-tree': %s", repoPath),
+		"git", "ls-tree", "-r", "--name-only", "-z", commit.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("git ls-tree: %v - %s", err, stderr)
+	}
+	var treePaths []string
+	if stdout != "" {
+		treePaths = strings.Split(strings.TrimSuffix(stdout, "\x00"), "\x00")
+	}
+	return findOwnershipConflicts(rules, treePaths), nil
+}
+
+// CodeOwnersConflictsCacheKey returns key used for cache conflicts of the
+// CODEOWNERS file in the tree of given commit.
+func (repo *Repository) CodeOwnersConflictsCacheKey(commitID string) string {
+	return fmt.Sprintf("CodeOwnersConflicts_%d_%s", repo.ID, commitID)
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_compilePathPattern(t *testing.T) {
+	Convey("Match paths of files with patterns", t, func() {
+		testCases := []struct {
+			pattern string
+			path    string
+			expect  bool
+		}{
+			{"*", "README.md", true},
+			{"*", "docs/README.md", true},
+			{"*.go", "main.go", true},
+			{"*.go", "internal/db/repo.go", true},
+			{"*.go", "main.gox", false},
+			{"docs", "docs/README.md", true},
+			{"docs", "api/docs/README.md", true},
+			{"docs/", "docs/README.md", true},
+			{"docs/", "docs", false},
+			{"/docs", "api/docs/README.md", false},
+			{"/docs/", "docs/guide/intro.md", true},
+			{"internal/db", "internal/db/repo.go", true},
+			{"internal/db", "cmd/internal/db/repo.go", false},
+			{"internal/*.go", "internal/main.go", true},
+			{"internal/*.go", "internal/db/repo.go", false},
+			{"**/db", "internal/db/repo.go", true},
+			{"internal/**", "internal/db/repo.go", true},
+			{"internal/**/*.go", "internal/repo.go", true},
+			{"internal/**/*.go", "internal/db/errors/repo.go", true},
+			{"?.go", "a.go", true},
+			{"?.go", "ab.go", false},
+			{"a.go", "a-go", false},
+		}
+		for _, tc := range testCases {
+			re, err := compilePathPattern(tc.pattern, true)
+			So(err, ShouldBeNil)
+			So(re.MatchString(tc.path), ShouldEqual, tc.expect)
+		}
+	})
+
+	Convey("Match paths of files without descendants", t, func() {
+		testCases := []struct {
+			pattern string
+			path    string
+			expect  bool
+		}{
+			{"*.psd", "assets/logo.psd", true},
+			{"assets", "assets/logo.psd", false},
+			{"assets/**", "assets/logo.psd", true},
+			{"/logo.psd", "assets/logo.psd", false},
+		}
+		for _, tc := range testCases {
+			re, err := compilePathPattern(tc.pattern, false)
+			So(err, ShouldBeNil)
+			So(re.MatchString(tc.path), ShouldEqual, tc.expect)
+		}
+	})
+
+	Convey("Reject invalid patterns", t, func() {
+		_, err := compilePathPattern("/", true)
+		So(err, ShouldNotBeNil)
+
+		_, err = compilePathPattern("assets/", false)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func Test_MatchCodeOwners(t *testing.T) {
+	rules := ParseCodeOwners([]byte(`# Default owners
+*             @alice
+
+/internal/db/ @org/team-backend bob@example.com  # Backend
+*.tmpl        @org/team-frontend
+/docs/
+`))
+
+	Convey("Parse rules and skip comments", t, func() {
+		So(rules, ShouldHaveLength, 4)
+		So(rules[1].Pattern, ShouldEqual, "/internal/db/")
+		So(rules[1].Owners, ShouldResemble, []string{"@org/team-backend", "bob@example.com"})
+		So(rules[3].Owners, ShouldBeEmpty)
+	})
+
+	Convey("The last matching rule takes precedence", t, func() {
+		testCases := []struct {
+			path   string
+			expect string
+		}{
+			{"main.go", "*"},
+			{"internal/db/repo.go", "/internal/db/"},
+			{"internal/db/mail.tmpl", "*.tmpl"},
+			{"/templates/base/head.tmpl", "*.tmpl"},
+			{"docs/README.md", "/docs/"},
+		}
+		for _, tc := range testCases {
+			rule := MatchCodeOwners(rules, tc.path)
+			So(rule, ShouldNotBeNil)
+			So(rule.Pattern, ShouldEqual, tc.expect)
+		}
+	})
+
+	Convey("No rule matches", t, func() {
+		So(MatchCodeOwners(rules[1:], "main.go"), ShouldBeNil)
+	})
+}
+
+func Test_findOwnershipConflicts(t *testing.T) {
+	rules := ParseCodeOwners([]byte(`*             @alice
+/internal/    @bob
+*.go          @Bob
+/docs/        @alice
+*.tmpl        @carol @alice
+`))
+
+	Convey("Report paths whose earlier matching rules assign different owners", t, func() {
+		conflicts := findOwnershipConflicts(rules, []string{
+			"README.md",
+			"internal/db/repo.go",
+			"internal/db/mail.tmpl",
+			"docs/index.md",
+			"main.go",
+		})
+		So(conflicts, ShouldHaveLength, 3)
+
+		So(conflicts[0].Path, ShouldEqual, "internal/db/repo.go")
+		So(conflicts[0].Rules, ShouldHaveLength, 3)
+		So(conflicts[0].Rules[2].Line, ShouldEqual, 3)
+
+		So(conflicts[1].Path, ShouldEqual, "internal/db/mail.tmpl")
+		So(conflicts[2].Path, ShouldEqual, "main.go")
+	})
+
+	Convey("Owners are compared regardless of order and case", t, func() {
+		So(sameCodeOwners([]string{"@alice", "@Bob"}, []string{"@bob", "@alice"}), ShouldBeTrue)
+		So(sameCodeOwners([]string{"@alice"}, []string{"@alice", "@bob"}), ShouldBeFalse)
+		So(sameCodeOwners(nil, nil), ShouldBeTrue)
+	})
+}
@@ -36,6 +36,14 @@ const (
 	COMMENT_TYPE_COMMENT_REF
 	// Reference from a pull request
 	COMMENT_TYPE_PULL_REF
+
+	// Reminder to the assignee of a pull request to review
+	COMMENT_TYPE_REVIEW_REMINDER
+
+	// Issue is closed as a duplicate of another issue
+	COMMENT_TYPE_DUPLICATE
+	// Reference from an issue that is closed as a duplicate
+	COMMENT_TYPE_DUPLICATE_REF
 )
 
 type CommentTag int
@@ -163,6 +171,13 @@ func (c *Comment) EventTag() string {
 	return "event-" + com.ToStr(c.ID)
 }
 
+// CloseReason returns the reason of closing the issue of a close comment, which
+// is empty for pull requests and issues closed before close reasons were
+// introduced.
+func (c *Comment) CloseReason() IssueCloseReason {
+	return IssueCloseReason(c.Content)
+}
+
 // mailParticipants sends new comment emails to repository watchers
 // and mentioned people.
 func (cmt *Comment) mailParticipants(e Engine, opType ActionType, issue *Issue) (err error) {
@@ -174,12 +189,17 @@ func (cmt *Comment) mailParticipants(e Engine, opType ActionType, issue *Issue)
 	switch opType {
 	case ACTION_COMMENT_ISSUE:
 		issue.Content = cmt.Content
-	case ACTION_CLOSE_ISSUE:
+	case ACTION_CLOSE_ISSUE, ACTION_CLOSE_PULL_REQUEST:
+		// Merging a pull request closes it, participants get the mail of the
+		// merge instead.
+		if issue.PullRequest != nil && issue.PullRequest.merging {
+			return nil
+		}
 		issue.Content = fmt.Sprintf("Closed #%d", issue.Index)
-	case ACTION_REOPEN_ISSUE:
+	case ACTION_REOPEN_ISSUE, ACTION_REOPEN_PULL_REQUEST:
 		issue.Content = fmt.Sprintf("Reopened #%d", issue.Index)
 	}
-	if err = mailIssueCommentToParticipants(issue, cmt.Poster, mentions); err != nil {
+	if err = mailIssueCommentToParticipants(issue, cmt.Poster, mentions, cmt.ID, opType == ACTION_COMMENT_ISSUE); err != nil {
 		log.Error("mailIssueCommentToParticipants: %v", err)
 	}
 
@@ -298,10 +318,11 @@ func createStatusComment(e *xorm.Session, doer *User, repo *Repository, issue *I
 		cmtType = COMMENT_TYPE_REOPEN
 	}
 	return createComment(e, &CreateCommentOptions{
-		Type:  cmtType,
-		Doer:  doer,
-		Repo:  repo,
-		Issue: issue,
+		Type:    cmtType,
+		Doer:    doer,
+		Repo:    repo,
+		Issue:   issue,
+		Content: string(issue.CloseReason),
 	})
 }
 
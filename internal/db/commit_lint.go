@@ -0,0 +1,160 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gogs/git-module"
+)
+
+// CommitLintRules are rules of commit messages of a repository. Rules with
+// zero value are not checked.
+type CommitLintRules struct {
+	ID                int64
+	RepoID            int64 `xorm:"UNIQUE"`
+	MaxSubjectLength  int
+	SubjectPattern    string // Regular expression the subject must match, e.g. conventional commit prefixes.
+	MaxBodyLineLength int
+	// Whether pushes with violating commits are rejected, otherwise violations
+	// are only reported as warnings on pull requests.
+	EnforceOnPush bool
+}
+
+// IsEnabled returns true if any rule is set.
+func (r *CommitLintRules) IsEnabled() bool {
+	return r.MaxSubjectLength > 0 || r.SubjectPattern != "" || r.MaxBodyLineLength > 0
+}
+
+// GetCommitLintRules returns commit lint rules of the repository, rules that
+// have never been saved are all disabled.
+func GetCommitLintRules(repoID int64) (*CommitLintRules, error) {
+	rules := &CommitLintRules{RepoID: repoID}
+	if _, err := x.Get(rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// UpdateCommitLintRules saves commit lint rules of the repository.
+func UpdateCommitLintRules(rules *CommitLintRules) error {
+	if _, err := regexp.Compile(rules.SubjectPattern); err != nil {
+		return ErrInvalidCommitLintPattern{Pattern: rules.SubjectPattern}
+	}
+	if rules.MaxSubjectLength < 0 {
+		rules.MaxSubjectLength = 0
+	}
+	if rules.MaxBodyLineLength < 0 {
+		rules.MaxBodyLineLength = 0
+	}
+
+	has, err := x.Get(&CommitLintRules{RepoID: rules.RepoID})
+	if err != nil {
+		return err
+	} else if !has {
+		_, err = x.Insert(rules)
+		return err
+	}
+	_, err = x.Where("repo_id = ?", rules.RepoID).AllCols().Omit("id").Update(rules)
+	return err
+}
+
+// CommitLintResult is a commit that violates commit lint rules.
+type CommitLintResult struct {
+	Commit     *git.Commit
+	Violations []string
+}
+
+// lintCommitMessage returns violations of the commit message against the
+// rules, the subject pattern must have been compiled from the rules.
+func lintCommitMessage(message string, rules CommitLintRules, subjectPattern *regexp.Regexp) []string {
+	lines := strings.Split(strings.TrimRight(message, "\n"), "\n")
+	subject := strings.TrimSpace(lines[0])
+
+	var violations []string
+	if rules.MaxSubjectLength > 0 && utf8.RuneCountInString(subject) > rules.MaxSubjectLength {
+		violations = append(violations, fmt.Sprintf("subject is longer than %d characters", rules.MaxSubjectLength))
+	}
+	if subjectPattern != nil && !subjectPattern.MatchString(subject) {
+		violations = append(violations, fmt.Sprintf("subject does not match pattern '%s'", rules.SubjectPattern))
+	}
+	if rules.MaxBodyLineLength > 0 {
+		for i, line := range lines[1:] {
+			if utf8.RuneCountInString(line) > rules.MaxBodyLineLength {
+				violations = append(violations, fmt.Sprintf("line %d of body is longer than %d characters", i+1, rules.MaxBodyLineLength))
+				break
+			}
+		}
+	}
+	return violations
+}
+
+// LintCommitMessages checks messages of commits against the rules and returns
+// the commits that violate any of them. Merge commits are skipped because
+// their messages are usually generated.
+func (repo *Repository) LintCommitMessages(commits []*git.Commit, rules CommitLintRules) ([]CommitLintResult, error) {
+	if !rules.IsEnabled() {
+		return nil, nil
+	}
+
+	var subjectPattern *regexp.Regexp
+	if rules.SubjectPattern != "" {
+		var err error
+		subjectPattern, err = regexp.Compile(rules.SubjectPattern)
+		if err != nil {
+			return nil, fmt.Errorf("compile subject pattern of repository [%d]: %v", repo.ID, err)
+		}
+	}
+
+	var results []CommitLintResult
+	for _, commit := range commits {
+		if commit.ParentCount() > 1 {
+			continue
+		}
+		violations := lintCommitMessage(commit.Message(), rules, subjectPattern)
+		if len(violations) > 0 {
+			results = append(results, CommitLintResult{
+				Commit:     commit,
+				Violations: violations,
+			})
+		}
+	}
+	return results, nil
+}
+
+// LintPushCommits checks messages of commits that are pushed to a branch of
+// the repository, i.e. commits between the old and the new commit, or commits
+// that are not in any branch yet when the branch is new.
+func (repo *Repository) LintPushCommits(rules CommitLintRules, oldCommitID, newCommitID string) ([]CommitLintResult, error) {
+	if !rules.IsEnabled() || newCommitID == git.EMPTY_SHA {
+		return nil, nil
+	}
+
+	revs := []string{newCommitID, "--not", "--branches"}
+	if oldCommitID != git.EMPTY_SHA {
+		revs = []string{oldCommitID + ".." + newCommitID}
+	}
+	output, err := git.NewCommand(append([]string{"rev-list"}, revs...)...).RunInDir(repo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("list commits: %v", err)
+	}
+
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("open repository: %v", err)
+	}
+	var commits []*git.Commit
+	for _, commitID := range strings.Fields(output) {
+		commit, err := gitRepo.GetCommit(commitID)
+		if err != nil {
+			return nil, fmt.Errorf("get commit '%s': %v", commitID, err)
+		}
+		commits = append(commits, commit)
+	}
+	return repo.LintCommitMessages(commits, rules)
+}
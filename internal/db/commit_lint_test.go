@@ -0,0 +1,38 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"regexp"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_lintCommitMessage(t *testing.T) {
+	Convey("Lint commit messages against rules", t, func() {
+		rules := CommitLintRules{
+			MaxSubjectLength:  20,
+			SubjectPattern:    `^(feat|fix): `,
+			MaxBodyLineLength: 10,
+		}
+		pattern := regexp.MustCompile(rules.SubjectPattern)
+
+		So(lintCommitMessage("fix: typo\n\nshort\n", rules, pattern), ShouldBeEmpty)
+		So(lintCommitMessage("fix: a very long subject line", rules, pattern), ShouldResemble, []string{
+			"subject is longer than 20 characters",
+		})
+		So(lintCommitMessage("Update README", rules, pattern), ShouldResemble, []string{
+			"subject does not match pattern '^(feat|fix): '",
+		})
+		So(lintCommitMessage("feat: x\n\nok\nthis line is too long", rules, pattern), ShouldResemble, []string{
+			"line 3 of body is longer than 10 characters",
+		})
+
+		Convey("Disabled rules are not checked", func() {
+			So(lintCommitMessage("Update README with a very long subject\n\nand a very long body line", CommitLintRules{}, nil), ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+type CommitStatusState string
+
+const (
+	COMMIT_STATUS_PENDING CommitStatusState = "pending"
+	COMMIT_STATUS_SUCCESS CommitStatusState = "success"
+	COMMIT_STATUS_ERROR   CommitStatusState = "error"
+	COMMIT_STATUS_FAILURE CommitStatusState = "failure"
+)
+
+// IsValid returns true if the state is one of the known states.
+func (s CommitStatusState) IsValid() bool {
+	switch s {
+	case COMMIT_STATUS_PENDING, COMMIT_STATUS_SUCCESS, COMMIT_STATUS_ERROR, COMMIT_STATUS_FAILURE:
+		return true
+	}
+	return false
+}
+
+// CommitStatus represents a status of a commit reported by an external
+// service (e.g. CI) under a context (e.g. "ci/build").
+type CommitStatus struct {
+	ID          int64
+	RepoID      int64             `xorm:"INDEX(s)"`
+	SHA         string            `xorm:"VARCHAR(40) INDEX(s)"`
+	State       CommitStatusState `xorm:"VARCHAR(7) NOT NULL"`
+	TargetURL   string            `xorm:"TEXT"`
+	Description string            `xorm:"TEXT"`
+	Context     string
+	CreatorID   int64
+	Creator     *User `xorm:"-" json:"-"`
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+}
+
+func (s *CommitStatus) BeforeInsert() {
+	s.CreatedUnix = time.Now().Unix()
+}
+
+func (s *CommitStatus) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		s.Created = time.Unix(s.CreatedUnix, 0).Local()
+	}
+}
+
+// LoadAttributes loads the creator of the status.
+func (s *CommitStatus) LoadAttributes() (err error) {
+	if s.Creator == nil {
+		s.Creator, err = GetUserByID(s.CreatorID)
+		if err != nil {
+			if errors.IsUserNotExist(err) {
+				s.CreatorID = -1
+				s.Creator = NewGhostUser()
+			} else {
+				return fmt.Errorf("GetUserByID [creator_id: %d]: %v", s.CreatorID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateCommitStatus creates a new status for the commit of the repository.
+// The context defaults to "default" when empty.
+func CreateCommitStatus(repoID, creatorID int64, sha string, s *CommitStatus) error {
+	if !s.State.IsValid() {
+		return ErrInvalidCommitStatusState{State: string(s.State)}
+	}
+
+	s.RepoID = repoID
+	s.CreatorID = creatorID
+	s.SHA = sha
+	if s.Context == "" {
+		s.Context = "default"
+	}
+	if _, err := x.Insert(s); err != nil {
+		return err
+	}
+	s.Created = time.Unix(s.CreatedUnix, 0).Local()
+	return nil
+}
+
+// GetLatestCommitStatuses returns the latest status of every context for the
+// commit of the repository, the most recent first.
+func GetLatestCommitStatuses(repoID int64, sha string) ([]*CommitStatus, error) {
+	statuses := make([]*CommitStatus, 0, 5)
+	if err := x.Where("repo_id = ? AND sha = ?", repoID, sha).Desc("id").Find(&statuses); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(statuses))
+	latest := make([]*CommitStatus, 0, len(statuses))
+	for _, s := range statuses {
+		if seen[s.Context] {
+			continue
+		}
+		seen[s.Context] = true
+		latest = append(latest, s)
+	}
+	return latest, nil
+}
+
+// CombinedCommitStatusState returns the combined state of the statuses: it is
+// failure if any of them is error or failure, pending if any of them is
+// pending, and success if all of them are success. An empty state is returned
+// when there is no status.
+func CombinedCommitStatusState(statuses []*CommitStatus) CommitStatusState {
+	if len(statuses) == 0 {
+		return ""
+	}
+
+	state := COMMIT_STATUS_SUCCESS
+	for _, s := range statuses {
+		switch s.State {
+		case COMMIT_STATUS_ERROR, COMMIT_STATUS_FAILURE:
+			return COMMIT_STATUS_FAILURE
+		case COMMIT_STATUS_PENDING:
+			state = COMMIT_STATUS_PENDING
+		}
+	}
+	return state
+}
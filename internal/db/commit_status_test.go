@@ -0,0 +1,29 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_CombinedCommitStatusState(t *testing.T) {
+	Convey("Combine states of commit statuses", t, func() {
+		statuses := func(states ...CommitStatusState) []*CommitStatus {
+			list := make([]*CommitStatus, len(states))
+			for i := range states {
+				list[i] = &CommitStatus{State: states[i]}
+			}
+			return list
+		}
+
+		So(CombinedCommitStatusState(nil), ShouldBeEmpty)
+		So(CombinedCommitStatusState(statuses(COMMIT_STATUS_SUCCESS, COMMIT_STATUS_SUCCESS)), ShouldEqual, COMMIT_STATUS_SUCCESS)
+		So(CombinedCommitStatusState(statuses(COMMIT_STATUS_SUCCESS, COMMIT_STATUS_PENDING)), ShouldEqual, COMMIT_STATUS_PENDING)
+		So(CombinedCommitStatusState(statuses(COMMIT_STATUS_PENDING, COMMIT_STATUS_ERROR)), ShouldEqual, COMMIT_STATUS_FAILURE)
+		So(CombinedCommitStatusState(statuses(COMMIT_STATUS_FAILURE, COMMIT_STATUS_SUCCESS)), ShouldEqual, COMMIT_STATUS_FAILURE)
+	})
+}
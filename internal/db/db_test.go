@@ -0,0 +1,53 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"xorm.io/core"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+var testDBCount int64
+
+// setupTestDB replaces the database engine with an empty in-memory SQLite
+// database that has all tables, and restores the engine when the test
+// finishes.
+func setupTestDB(tb testing.TB) {
+	dsn := fmt.Sprintf("file:gogs-test-%d?mode=memory&cache=shared", atomic.AddInt64(&testDBCount, 1))
+	engine, err := xorm.NewEngine("sqlite3", dsn)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	// The in-memory database only lives as long as its connection.
+	engine.SetMaxOpenConns(1)
+	engine.SetMapper(core.GonicMapper{})
+	if err = engine.Sync2(tables...); err != nil {
+		tb.Fatal(err)
+	}
+
+	before, beforeSQLite := x, conf.UseSQLite3
+	x, conf.UseSQLite3 = engine, true
+	tb.Cleanup(func() {
+		x, conf.UseSQLite3 = before, beforeSQLite
+		_ = engine.Close()
+	})
+}
+
+// insertTestBeans inserts the beans into the test database, and fails the
+// test on error.
+func insertTestBeans(tb testing.TB, beans ...interface{}) {
+	for _, bean := range beans {
+		if _, err := x.Insert(bean); err != nil {
+			tb.Fatalf("insert %T: %v", bean, err)
+		}
+	}
+}
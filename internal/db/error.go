@@ -6,6 +6,7 @@ package db
 
 import (
 	"fmt"
+	"strings"
 )
 
 type ErrNameReserved struct {
@@ -21,6 +22,20 @@ func (err ErrNameReserved) Error() string {
 	return fmt.Sprintf("name is reserved [name: %s]", err.Name)
 }
 
+type ErrSchemaVersionMismatch struct {
+	Current  int64
+	Expected int64
+}
+
+func IsErrSchemaVersionMismatch(err error) bool {
+	_, ok := err.(ErrSchemaVersionMismatch)
+	return ok
+}
+
+func (err ErrSchemaVersionMismatch) Error() string {
+	return fmt.Sprintf("database schema version mismatch [current: %d, expected: %d]", err.Current, err.Expected)
+}
+
 type ErrNamePatternNotAllowed struct {
 	Pattern string
 }
@@ -285,6 +300,20 @@ func (err ErrLastOrgOwner) Error() string {
 	return fmt.Sprintf("user is the last member of owner team [uid: %d]", err.UID)
 }
 
+type ErrBotGrantNotExist struct {
+	BotID  int64
+	RepoID int64
+}
+
+func IsErrBotGrantNotExist(err error) bool {
+	_, ok := err.(ErrBotGrantNotExist)
+	return ok
+}
+
+func (err ErrBotGrantNotExist) Error() string {
+	return fmt.Sprintf("bot grant does not exist [bot_id: %d, repo_id: %d]", err.BotID, err.RepoID)
+}
+
 // __________                           .__  __
 // \______   \ ____ ______   ____  _____|__|/  |_  ___________ ___.__.
 //  |       _// __ \\____ \ /  _ \/  ___/  \   __\/  _ \_  __ <   |  |
@@ -362,6 +391,34 @@ func (err ErrReleaseNotExist) Error() string {
 	return fmt.Sprintf("release tag does not exist [id: %d, tag_name: %s]", err.ID, err.TagName)
 }
 
+type ErrInvalidReleaseLink struct {
+	Label string
+	URL   string
+}
+
+func IsErrInvalidReleaseLink(err error) bool {
+	_, ok := err.(ErrInvalidReleaseLink)
+	return ok
+}
+
+func (err ErrInvalidReleaseLink) Error() string {
+	return fmt.Sprintf("release link is not valid [label: %s, url: %s]", err.Label, err.URL)
+}
+
+type ErrInvalidFooterLink struct {
+	Label string
+	URL   string
+}
+
+func IsErrInvalidFooterLink(err error) bool {
+	_, ok := err.(ErrInvalidFooterLink)
+	return ok
+}
+
+func (err ErrInvalidFooterLink) Error() string {
+	return fmt.Sprintf("footer link is not valid [label: %s, url: %s]", err.Label, err.URL)
+}
+
 type ErrInvalidTagName struct {
 	TagName string
 }
@@ -375,6 +432,58 @@ func (err ErrInvalidTagName) Error() string {
 	return fmt.Sprintf("release tag name is not valid [tag_name: %s]", err.TagName)
 }
 
+type ErrInvalidTagPattern struct {
+	Pattern string
+}
+
+func IsErrInvalidTagPattern(err error) bool {
+	_, ok := err.(ErrInvalidTagPattern)
+	return ok
+}
+
+func (err ErrInvalidTagPattern) Error() string {
+	return fmt.Sprintf("protected tag pattern is not valid [pattern: %s]", err.Pattern)
+}
+
+type ErrInvalidCommitLintPattern struct {
+	Pattern string
+}
+
+func IsErrInvalidCommitLintPattern(err error) bool {
+	_, ok := err.(ErrInvalidCommitLintPattern)
+	return ok
+}
+
+func (err ErrInvalidCommitLintPattern) Error() string {
+	return fmt.Sprintf("commit subject pattern is not valid [pattern: %s]", err.Pattern)
+}
+
+type ErrLifecycleKeepTokenNotExist struct {
+	Token string
+}
+
+func IsErrLifecycleKeepTokenNotExist(err error) bool {
+	_, ok := err.(ErrLifecycleKeepTokenNotExist)
+	return ok
+}
+
+func (err ErrLifecycleKeepTokenNotExist) Error() string {
+	return fmt.Sprintf("lifecycle keep token does not exist [token: %s]", err.Token)
+}
+
+type ErrTagProtected struct {
+	TagName string
+}
+
+func IsErrTagProtected(err error) bool {
+	_, ok := err.(ErrTagProtected)
+	return ok
+}
+
+func (err ErrTagProtected) Error() string {
+	return fmt.Sprintf("tag is protected [tag_name: %s]", err.TagName)
+}
+
 type ErrRepoFileAlreadyExist struct {
 	FileName string
 }
@@ -388,6 +497,518 @@ func (err ErrRepoFileAlreadyExist) Error() string {
 	return fmt.Sprintf("repository file already exists [file_name: %s]", err.FileName)
 }
 
+type ErrUnrelatedHistories struct {
+	CommitID    string
+	AncestorRef string
+}
+
+func IsErrUnrelatedHistories(err error) bool {
+	_, ok := err.(ErrUnrelatedHistories)
+	return ok
+}
+
+func (err ErrUnrelatedHistories) Error() string {
+	return fmt.Sprintf("commit and ancestor have unrelated histories [commit_id: %s, ancestor_ref: %s]", err.CommitID, err.AncestorRef)
+}
+
+type ErrMuteScheduleNotExist struct {
+	UserID int64
+	RepoID int64
+}
+
+func IsErrMuteScheduleNotExist(err error) bool {
+	_, ok := err.(ErrMuteScheduleNotExist)
+	return ok
+}
+
+func (err ErrMuteScheduleNotExist) Error() string {
+	return fmt.Sprintf("mute schedule does not exist [user_id: %d, repo_id: %d]", err.UserID, err.RepoID)
+}
+
+type ErrInvalidMuteSchedule struct {
+	Reason string
+}
+
+func IsErrInvalidMuteSchedule(err error) bool {
+	_, ok := err.(ErrInvalidMuteSchedule)
+	return ok
+}
+
+func (err ErrInvalidMuteSchedule) Error() string {
+	return fmt.Sprintf("invalid mute schedule: %s", err.Reason)
+}
+
+type ErrPathWatchNotExist struct {
+	ID int64
+}
+
+func IsErrPathWatchNotExist(err error) bool {
+	_, ok := err.(ErrPathWatchNotExist)
+	return ok
+}
+
+func (err ErrPathWatchNotExist) Error() string {
+	return fmt.Sprintf("path watch does not exist [id: %d]", err.ID)
+}
+
+type ErrPathWatchAlreadyExist struct {
+	Pattern string
+}
+
+func IsErrPathWatchAlreadyExist(err error) bool {
+	_, ok := err.(ErrPathWatchAlreadyExist)
+	return ok
+}
+
+func (err ErrPathWatchAlreadyExist) Error() string {
+	return fmt.Sprintf("path watch already exists [pattern: %s]", err.Pattern)
+}
+
+type ErrInvalidPathWatchPattern struct {
+	Pattern string
+}
+
+func IsErrInvalidPathWatchPattern(err error) bool {
+	_, ok := err.(ErrInvalidPathWatchPattern)
+	return ok
+}
+
+func (err ErrInvalidPathWatchPattern) Error() string {
+	return fmt.Sprintf("invalid path watch pattern [pattern: %s]", err.Pattern)
+}
+
+type ErrPathWatchLimitReached struct {
+	Limit int
+}
+
+func IsErrPathWatchLimitReached(err error) bool {
+	_, ok := err.(ErrPathWatchLimitReached)
+	return ok
+}
+
+func (err ErrPathWatchLimitReached) Error() string {
+	return fmt.Sprintf("path watch limit reached [limit: %d]", err.Limit)
+}
+
+type ErrInvalidDuplicateIssue struct {
+	IssueID     int64
+	CanonicalID int64
+}
+
+func IsErrInvalidDuplicateIssue(err error) bool {
+	_, ok := err.(ErrInvalidDuplicateIssue)
+	return ok
+}
+
+func (err ErrInvalidDuplicateIssue) Error() string {
+	return fmt.Sprintf("issue cannot be marked as duplicate [issue_id: %d, canonical_id: %d]", err.IssueID, err.CanonicalID)
+}
+
+type ErrInvalidIssueCloseReason struct {
+	Reason string
+}
+
+func IsErrInvalidIssueCloseReason(err error) bool {
+	_, ok := err.(ErrInvalidIssueCloseReason)
+	return ok
+}
+
+func (err ErrInvalidIssueCloseReason) Error() string {
+	return fmt.Sprintf("invalid issue close reason [reason: %s]", err.Reason)
+}
+
+type ErrReviewReminderNotExist struct {
+	RepoID int64
+	OrgID  int64
+}
+
+func IsErrReviewReminderNotExist(err error) bool {
+	_, ok := err.(ErrReviewReminderNotExist)
+	return ok
+}
+
+func (err ErrReviewReminderNotExist) Error() string {
+	return fmt.Sprintf("review reminder does not exist [repo_id: %d, org_id: %d]", err.RepoID, err.OrgID)
+}
+
+type ErrInvalidReviewReminder struct {
+	RemindAfter   int
+	EscalateAfter int
+}
+
+func IsErrInvalidReviewReminder(err error) bool {
+	_, ok := err.(ErrInvalidReviewReminder)
+	return ok
+}
+
+func (err ErrInvalidReviewReminder) Error() string {
+	return fmt.Sprintf("invalid review reminder [remind_after: %d, escalate_after: %d]", err.RemindAfter, err.EscalateAfter)
+}
+
+type ErrInvalidPathPrefix struct {
+	Prefix string
+}
+
+func IsErrInvalidPathPrefix(err error) bool {
+	_, ok := err.(ErrInvalidPathPrefix)
+	return ok
+}
+
+func (err ErrInvalidPathPrefix) Error() string {
+	return fmt.Sprintf("invalid path prefix [prefix: %s]", err.Prefix)
+}
+
+type ErrSecretNotExist struct {
+	RepoID int64
+	OrgID  int64
+	Name   string
+}
+
+func IsErrSecretNotExist(err error) bool {
+	_, ok := err.(ErrSecretNotExist)
+	return ok
+}
+
+func (err ErrSecretNotExist) Error() string {
+	return fmt.Sprintf("secret does not exist [repo_id: %d, org_id: %d, name: %s]", err.RepoID, err.OrgID, err.Name)
+}
+
+type ErrSecretInUse struct {
+	RepoID     int64
+	OrgID      int64
+	Name       string
+	References []*SecretReference
+}
+
+func IsErrSecretInUse(err error) bool {
+	_, ok := err.(ErrSecretInUse)
+	return ok
+}
+
+func (err ErrSecretInUse) Error() string {
+	return fmt.Sprintf("secret is referenced by webhooks [repo_id: %d, org_id: %d, name: %s, count: %d]", err.RepoID, err.OrgID, err.Name, len(err.References))
+}
+
+type ErrInvalidSecretName struct {
+	Name string
+}
+
+func IsErrInvalidSecretName(err error) bool {
+	_, ok := err.(ErrInvalidSecretName)
+	return ok
+}
+
+func (err ErrInvalidSecretName) Error() string {
+	return fmt.Sprintf("invalid secret name [name: %s]", err.Name)
+}
+
+type ErrIssueFormNotExist struct {
+	Filename string
+}
+
+func IsErrIssueFormNotExist(err error) bool {
+	_, ok := err.(ErrIssueFormNotExist)
+	return ok
+}
+
+func (err ErrIssueFormNotExist) Error() string {
+	return fmt.Sprintf("issue form does not exist [filename: %s]", err.Filename)
+}
+
+type ErrIssueAutoLockNotExist struct {
+	RepoID int64
+}
+
+func IsErrIssueAutoLockNotExist(err error) bool {
+	_, ok := err.(ErrIssueAutoLockNotExist)
+	return ok
+}
+
+func (err ErrIssueAutoLockNotExist) Error() string {
+	return fmt.Sprintf("issue auto-lock does not exist [repo_id: %d]", err.RepoID)
+}
+
+type ErrInvalidIssueAutoLock struct {
+	AfterDays int
+}
+
+func IsErrInvalidIssueAutoLock(err error) bool {
+	_, ok := err.(ErrInvalidIssueAutoLock)
+	return ok
+}
+
+func (err ErrInvalidIssueAutoLock) Error() string {
+	return fmt.Sprintf("invalid issue auto-lock [after_days: %d]", err.AfterDays)
+}
+
+type ErrVisibilityScheduleNotExist struct {
+	RepoID int64
+}
+
+func IsErrVisibilityScheduleNotExist(err error) bool {
+	_, ok := err.(ErrVisibilityScheduleNotExist)
+	return ok
+}
+
+func (err ErrVisibilityScheduleNotExist) Error() string {
+	return fmt.Sprintf("visibility schedule does not exist [repo_id: %d]", err.RepoID)
+}
+
+type ErrInvalidVisibilitySchedule struct {
+	RepoID        int64
+	ScheduledUnix int64
+}
+
+func IsErrInvalidVisibilitySchedule(err error) bool {
+	_, ok := err.(ErrInvalidVisibilitySchedule)
+	return ok
+}
+
+func (err ErrInvalidVisibilitySchedule) Error() string {
+	return fmt.Sprintf("invalid visibility schedule [repo_id: %d, scheduled_unix: %d]", err.RepoID, err.ScheduledUnix)
+}
+
+// ErrVisibilityChangeForbidden is returned when the repository is not allowed
+// to change to the visibility, e.g. forks follow their base repositories.
+type ErrVisibilityChangeForbidden struct {
+	RepoID    int64
+	IsPrivate bool
+	Reason    string
+}
+
+func IsErrVisibilityChangeForbidden(err error) bool {
+	_, ok := err.(ErrVisibilityChangeForbidden)
+	return ok
+}
+
+func (err ErrVisibilityChangeForbidden) Error() string {
+	return fmt.Sprintf("visibility change forbidden [repo_id: %d, is_private: %v, reason: %s]", err.RepoID, err.IsPrivate, err.Reason)
+}
+
+type ErrNotFork struct {
+	RepoID int64
+}
+
+func IsErrNotFork(err error) bool {
+	_, ok := err.(ErrNotFork)
+	return ok
+}
+
+func (err ErrNotFork) Error() string {
+	return fmt.Sprintf("repository is not a fork [repo_id: %d]", err.RepoID)
+}
+
+// ErrRepoCreationForbidden is returned when the user is not allowed to create
+// repositories for the owner by "[repository] ALLOWED_CREATORS".
+type ErrRepoCreationForbidden struct {
+	UserID  int64
+	OwnerID int64
+	Reason  string
+}
+
+func IsErrRepoCreationForbidden(err error) bool {
+	_, ok := err.(ErrRepoCreationForbidden)
+	return ok
+}
+
+func (err ErrRepoCreationForbidden) Error() string {
+	return fmt.Sprintf("repository creation forbidden [user_id: %d, owner_id: %d, reason: %s]", err.UserID, err.OwnerID, err.Reason)
+}
+
+type ErrRepoCreationLimitOutOfBound struct {
+	OrgID int64
+	Limit int
+	Bound int
+}
+
+func IsErrRepoCreationLimitOutOfBound(err error) bool {
+	_, ok := err.(ErrRepoCreationLimitOutOfBound)
+	return ok
+}
+
+func (err ErrRepoCreationLimitOutOfBound) Error() string {
+	return fmt.Sprintf("repository creation limit exceeds the bound set by admins [org_id: %d, limit: %d, bound: %d]", err.OrgID, err.Limit, err.Bound)
+}
+
+// ErrForkUpstreamNotFetched is returned when commits of the upstream branch
+// are not in the fork yet, and are being fetched in the background.
+type ErrForkUpstreamNotFetched struct {
+	RepoID int64
+	Branch string
+}
+
+func IsErrForkUpstreamNotFetched(err error) bool {
+	_, ok := err.(ErrForkUpstreamNotFetched)
+	return ok
+}
+
+func (err ErrForkUpstreamNotFetched) Error() string {
+	return fmt.Sprintf("upstream branch not fetched into fork [repo_id: %d, branch: %s]", err.RepoID, err.Branch)
+}
+
+// ErrForkDiverged is returned when the branch of the fork has commits that are
+// not in its upstream branch, thus cannot be fast-forwarded.
+type ErrForkDiverged struct {
+	RepoID int64
+	Branch string
+}
+
+func IsErrForkDiverged(err error) bool {
+	_, ok := err.(ErrForkDiverged)
+	return ok
+}
+
+func (err ErrForkDiverged) Error() string {
+	return fmt.Sprintf("fork has diverged from upstream [repo_id: %d, branch: %s]", err.RepoID, err.Branch)
+}
+
+type ErrBranchPushForbidden struct {
+	RepoID int64
+	Branch string
+}
+
+func IsErrBranchPushForbidden(err error) bool {
+	_, ok := err.(ErrBranchPushForbidden)
+	return ok
+}
+
+func (err ErrBranchPushForbidden) Error() string {
+	return fmt.Sprintf("push to protected branch forbidden [repo_id: %d, branch: %s]", err.RepoID, err.Branch)
+}
+
+// ErrOrgRuleViolation is returned when changes to a branch violate a rule set
+// of the organization.
+type ErrOrgRuleViolation struct {
+	RuleSet string
+	Branch  string
+	Reason  string
+}
+
+func IsErrOrgRuleViolation(err error) bool {
+	_, ok := err.(ErrOrgRuleViolation)
+	return ok
+}
+
+func (err ErrOrgRuleViolation) Error() string {
+	return fmt.Sprintf("branch '%s' is protected by rule set '%s' of the organization: %s", err.Branch, err.RuleSet, err.Reason)
+}
+
+// ErrProtectBranchViolation is returned when a push to a branch is not allowed
+// by protection of the branch.
+type ErrProtectBranchViolation struct {
+	Branch string
+	Reason string
+}
+
+func IsErrProtectBranchViolation(err error) bool {
+	_, ok := err.(ErrProtectBranchViolation)
+	return ok
+}
+
+func (err ErrProtectBranchViolation) Error() string {
+	return fmt.Sprintf("Branch '%s' %s", err.Branch, err.Reason)
+}
+
+// ErrPullFileNotChanged is returned when a file that is not changed by a pull
+// request is marked as viewed.
+type ErrPullFileNotChanged struct {
+	PullID   int64
+	TreePath string
+}
+
+func IsErrPullFileNotChanged(err error) bool {
+	_, ok := err.(ErrPullFileNotChanged)
+	return ok
+}
+
+func (err ErrPullFileNotChanged) Error() string {
+	return fmt.Sprintf("file is not changed by pull request [pull_id: %d, tree_path: %s]", err.PullID, err.TreePath)
+}
+
+// ErrMergeCommitNotAllowed is returned when a pull request is merged with a
+// merge commit to a branch that requires linear history.
+type ErrMergeCommitNotAllowed struct {
+	Branch string
+}
+
+func IsErrMergeCommitNotAllowed(err error) bool {
+	_, ok := err.(ErrMergeCommitNotAllowed)
+	return ok
+}
+
+func (err ErrMergeCommitNotAllowed) Error() string {
+	return fmt.Sprintf("branch '%s' requires linear history and does not allow merge commits", err.Branch)
+}
+
+// ErrCodeOwnersApprovalRequired is returned when a pull request is merged to a
+// branch that requires approval of code owners, and files matching the
+// patterns are not approved yet.
+type ErrCodeOwnersApprovalRequired struct {
+	Patterns []string
+}
+
+func IsErrCodeOwnersApprovalRequired(err error) bool {
+	_, ok := err.(ErrCodeOwnersApprovalRequired)
+	return ok
+}
+
+func (err ErrCodeOwnersApprovalRequired) Error() string {
+	return fmt.Sprintf("approval of code owners is required [patterns: %s]", strings.Join(err.Patterns, ", "))
+}
+
+type ErrOrgRuleSetInvalid struct {
+	Field  string
+	Reason string
+}
+
+func IsErrOrgRuleSetInvalid(err error) bool {
+	_, ok := err.(ErrOrgRuleSetInvalid)
+	return ok
+}
+
+func (err ErrOrgRuleSetInvalid) Error() string {
+	return fmt.Sprintf("invalid rule set [field: %s]: %s", err.Field, err.Reason)
+}
+
+type ErrInvalidRepoSettings struct {
+	Reason string
+}
+
+func IsErrInvalidRepoSettings(err error) bool {
+	_, ok := err.(ErrInvalidRepoSettings)
+	return ok
+}
+
+func (err ErrInvalidRepoSettings) Error() string {
+	return fmt.Sprintf("invalid repository settings: %s", err.Reason)
+}
+
+type ErrRepoSettingsMissingSecrets struct {
+	Names []string
+}
+
+func IsErrRepoSettingsMissingSecrets(err error) bool {
+	_, ok := err.(ErrRepoSettingsMissingSecrets)
+	return ok
+}
+
+func (err ErrRepoSettingsMissingSecrets) Error() string {
+	return fmt.Sprintf("repository settings missing secrets [names: %s]", strings.Join(err.Names, ", "))
+}
+
+type ErrInvalidCommitStatusState struct {
+	State string
+}
+
+func IsErrInvalidCommitStatusState(err error) bool {
+	_, ok := err.(ErrInvalidCommitStatusState)
+	return ok
+}
+
+func (err ErrInvalidCommitStatusState) Error() string {
+	return fmt.Sprintf("invalid commit status state [state: %s]", err.State)
+}
+
 // __________      .__  .__ __________                                     __
 // \______   \__ __|  | |  |\______   \ ____  ________ __   ____   _______/  |_
 //  |     ___/  |  \  | |  | |       _// __ \/ ____/  |  \_/ __ \ /  ___/\   __\
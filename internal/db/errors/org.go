@@ -19,3 +19,29 @@ func IsTeamNotExist(err error) bool {
 func (err TeamNotExist) Error() string {
 	return fmt.Sprintf("team does not exist [team_id: %d, name: %s]", err.TeamID, err.Name)
 }
+
+type OrgRuleSetNotExist struct {
+	ID int64
+}
+
+func IsOrgRuleSetNotExist(err error) bool {
+	_, ok := err.(OrgRuleSetNotExist)
+	return ok
+}
+
+func (err OrgRuleSetNotExist) Error() string {
+	return fmt.Sprintf("rule set does not exist [id: %d]", err.ID)
+}
+
+type OrgRuleSetExemptionNotExist struct {
+	ID int64
+}
+
+func IsOrgRuleSetExemptionNotExist(err error) bool {
+	_, ok := err.(OrgRuleSetExemptionNotExist)
+	return ok
+}
+
+func (err OrgRuleSetExemptionNotExist) Error() string {
+	return fmt.Sprintf("rule set exemption does not exist [id: %d]", err.ID)
+}
@@ -31,7 +31,7 @@ func IsReachLimitOfRepo(err error) bool {
 }
 
 func (err ReachLimitOfRepo) Error() string {
-	return fmt.Sprintf("user has reached maximum limit of repositories [limit: %d]", err.Limit)
+	return fmt.Sprintf("owner has reached maximum limit of repositories [limit: %d]", err.Limit)
 }
 
 type InvalidRepoReference struct {
@@ -47,6 +47,34 @@ func (err InvalidRepoReference) Error() string {
 	return fmt.Sprintf("invalid repository reference [ref: %s]", err.Ref)
 }
 
+type RepoInviteNotExist struct {
+	ID    int64
+	Token string
+}
+
+func IsRepoInviteNotExist(err error) bool {
+	_, ok := err.(RepoInviteNotExist)
+	return ok
+}
+
+func (err RepoInviteNotExist) Error() string {
+	return fmt.Sprintf("repository invitation does not exist [id: %d, token: %s]", err.ID, err.Token)
+}
+
+type RepoInviteEmailMismatch struct {
+	Email  string
+	UserID int64
+}
+
+func IsRepoInviteEmailMismatch(err error) bool {
+	_, ok := err.(RepoInviteEmailMismatch)
+	return ok
+}
+
+func (err RepoInviteEmailMismatch) Error() string {
+	return fmt.Sprintf("repository invitation is sent to an email not verified by the user [email: %s, user_id: %d]", err.Email, err.UserID)
+}
+
 type MirrorNotExist struct {
 	RepoID int64
 }
@@ -85,3 +113,31 @@ func IsErrBranchNotExist(err error) bool {
 func (err ErrBranchNotExist) Error() string {
 	return fmt.Sprintf("branch does not exist [name: %s]", err.Name)
 }
+
+type ProtectTagNotExist struct {
+	ID     int64
+	RepoID int64
+}
+
+func IsProtectTagNotExist(err error) bool {
+	_, ok := err.(ProtectTagNotExist)
+	return ok
+}
+
+func (err ProtectTagNotExist) Error() string {
+	return fmt.Sprintf("protected tag does not exist [id: %d, repo_id: %d]", err.ID, err.RepoID)
+}
+
+type RepoRedirectNotExist struct {
+	ID     int64
+	RepoID int64
+}
+
+func IsRepoRedirectNotExist(err error) bool {
+	_, ok := err.(RepoRedirectNotExist)
+	return ok
+}
+
+func (err RepoRedirectNotExist) Error() string {
+	return fmt.Sprintf("repository redirect does not exist [id: %d, repo_id: %d]", err.ID, err.RepoID)
+}
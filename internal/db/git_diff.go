@@ -6,10 +6,12 @@ package db
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"html"
 	"html/template"
 	"io"
+	"strings"
 
 	"github.com/sergi/go-diff/diffmatchpatch"
 	"golang.org/x/net/html/charset"
@@ -107,6 +109,9 @@ func (diffSection *DiffSection) ComputedInlineDiffFor(diffLine *git.DiffLine) te
 type DiffFile struct {
 	*git.DiffFile
 	Sections []*DiffSection
+	// IsCollapsed indicates whether changes of the file are collapsed by
+	// default, see CollapseGeneratedFiles.
+	IsCollapsed bool
 }
 
 func (diffFile *DiffFile) HighlightClass() string {
@@ -178,17 +183,108 @@ func ParsePatch(maxLines, maxLineCharacteres, maxFiles int, reader io.Reader) (*
 }
 
 func GetDiffRange(repoPath, beforeCommitID, afterCommitID string, maxLines, maxLineCharacteres, maxFiles int) (*Diff, error) {
-	gitDiff, err := git.GetDiffRange(repoPath, beforeCommitID, afterCommitID, maxLines, maxLineCharacteres, maxFiles)
+	return GetDiffRangeContext(context.Background(), repoPath, beforeCommitID, afterCommitID, maxLines, maxLineCharacteres, maxFiles)
+}
+
+// GetDiffRangeContext returns the diff between two commits. The Git command is
+// killed once the context is done or "[git.timeout] DIFF" is exceeded.
+func GetDiffRangeContext(ctx context.Context, repoPath, beforeCommitID, afterCommitID string, maxLines, maxLineCharacteres, maxFiles int) (*Diff, error) {
+	args, err := diffArgs(repoPath, beforeCommitID, afterCommitID)
 	if err != nil {
 		return nil, fmt.Errorf("GetDiffRange: %v", err)
 	}
-	return NewDiff(gitDiff), nil
+
+	r, w := io.Pipe()
+	done := make(chan error, 1)
+	result := make(chan *git.Diff, 1)
+	go func() {
+		result <- git.ParsePatch(done, maxLines, maxLineCharacteres, maxFiles, r)
+	}()
+
+	err = runGitOperation(ctx, GIT_OPERATION_DIFF, repoPath, w, args...)
+	w.CloseWithError(err) // Close writer to exit parsing goroutine
+	if err != nil {
+		return nil, fmt.Errorf("GetDiffRange: %w", err)
+	}
+
+	if err = <-done; err != nil {
+		return nil, fmt.Errorf("ParsePatch: %v", err)
+	}
+	return NewDiff(<-result), nil
 }
 
 func GetDiffCommit(repoPath, commitID string, maxLines, maxLineCharacteres, maxFiles int) (*Diff, error) {
-	gitDiff, err := git.GetDiffCommit(repoPath, commitID, maxLines, maxLineCharacteres, maxFiles)
+	return GetDiffRangeContext(context.Background(), repoPath, "", commitID, maxLines, maxLineCharacteres, maxFiles)
+}
+
+// GetDiffCommitContext returns the diff of the commit against its parent, see
+// GetDiffRangeContext for how the Git command is stopped.
+func GetDiffCommitContext(ctx context.Context, repoPath, commitID string, maxLines, maxLineCharacteres, maxFiles int) (*Diff, error) {
+	return GetDiffRangeContext(ctx, repoPath, "", commitID, maxLines, maxLineCharacteres, maxFiles)
+}
+
+// resolveCommit returns the commit of the ref, which can be any commit ID,
+// branch or tag. Annotated tags are peeled to commits.
+func resolveCommit(gitRepo *git.Repository, ref string) (*git.Commit, error) {
+	if strings.HasPrefix(ref, "-") {
+		return nil, git.ErrNotExist{ID: ref}
+	}
+
+	commit, err := gitRepo.GetCommit(ref + "^{commit}")
 	if err != nil {
-		return nil, fmt.Errorf("GetDiffCommit: %v", err)
+		if git.IsErrNotExist(err) {
+			return nil, git.ErrNotExist{ID: ref}
+		}
+		return nil, err
 	}
-	return NewDiff(gitDiff), nil
+	return commit, nil
+}
+
+// DiffAgainst returns the diff of the commit against the ancestor, which can be
+// any commit ID, branch or tag. When the commit is not a descendant of the
+// ancestor, the diff is computed against their merge base, i.e. only changes
+// introduced on the side of the commit are included. It returns
+// ErrUnrelatedHistories when they have no common history.
+func (repo *Repository) DiffAgainst(commitID, ancestorRef string) (*Diff, error) {
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("OpenRepository: %v", err)
+	}
+	commit, err := resolveCommit(gitRepo, commitID)
+	if err != nil {
+		return nil, err
+	}
+	ancestor, err := resolveCommit(gitRepo, ancestorRef)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeBase, err := gitRepo.GetMergeBase(ancestor.ID.String(), commit.ID.String())
+	if err != nil {
+		if git.IsErrNoMergeBase(err) {
+			return nil, ErrUnrelatedHistories{CommitID: commitID, AncestorRef: ancestorRef}
+		}
+		return nil, fmt.Errorf("GetMergeBase: %v", err)
+	}
+
+	return GetDiffRange(repo.RepoPath(), mergeBase, commit.ID.String(),
+		conf.Git.MaxGitDiffLines, conf.Git.MaxGitDiffLineCharacters, conf.Git.MaxGitDiffFiles)
+}
+
+// TreesEqual returns true if tip commits of both refs, which can be any commit
+// IDs, branches or tags, have identical trees, regardless of their histories.
+func (repo *Repository) TreesEqual(refA, refB string) (bool, error) {
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return false, fmt.Errorf("OpenRepository: %v", err)
+	}
+	commitA, err := resolveCommit(gitRepo, refA)
+	if err != nil {
+		return false, err
+	}
+	commitB, err := resolveCommit(gitRepo, refB)
+	if err != nil {
+		return false, err
+	}
+	return commitA.Tree.ID.Equal(commitB.Tree.ID), nil
 }
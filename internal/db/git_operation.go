@@ -0,0 +1,201 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogs/git-module"
+	"github.com/klauspost/compress/zstd"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/process"
+)
+
+// Classes of expensive Git operations, each has its own timeout set by
+// "[git.timeout]".
+const (
+	GIT_OPERATION_DIFF    = "diff"
+	GIT_OPERATION_ARCHIVE = "archive"
+	GIT_OPERATION_LOG     = "log"
+)
+
+var (
+	gitOperationLimiterOnce sync.Once
+	gitOperationLimiter     *process.Limiter
+)
+
+// gitOperations returns the limiter of expensive Git operations, which is
+// created by "[git] MAX_CONCURRENT_OPERATIONS" and "MAX_WAITING_OPERATIONS"
+// on first use.
+func gitOperations() *process.Limiter {
+	gitOperationLimiterOnce.Do(func() {
+		gitOperationLimiter = process.NewLimiter(conf.Git.MaxConcurrentOperations, conf.Git.MaxWaitingOperations)
+	})
+	return gitOperationLimiter
+}
+
+// GitOperationStats returns numbers of expensive Git operations currently
+// running and waiting.
+func GitOperationStats() process.LimiterStats {
+	return gitOperations().Stats()
+}
+
+func gitOperationTimeout(class string) time.Duration {
+	var seconds int
+	switch class {
+	case GIT_OPERATION_DIFF:
+		seconds = conf.Git.Timeout.Diff
+	case GIT_OPERATION_ARCHIVE:
+		seconds = conf.Git.Timeout.Archive
+	case GIT_OPERATION_LOG:
+		seconds = conf.Git.Timeout.Log
+	}
+	if seconds <= 0 {
+		return process.DEFAULT_TIMEOUT
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// IsErrGitOperationUnavailable returns true if the error is caused by an
+// expensive Git operation that timed out, was canceled or was rejected because
+// too many operations are in progress.
+func IsErrGitOperationUnavailable(err error) bool {
+	return errors.Is(err, process.ErrExecTimeout) ||
+		errors.Is(err, process.ErrTooManyOperations) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, context.Canceled)
+}
+
+// runGitOperation runs the Git command of the class in the directory, and
+// writes its standard output to given writer. The time it waits for other
+// operations counts towards the timeout of the class, and the command is
+// killed once the context is done.
+func runGitOperation(ctx context.Context, class, dir string, stdout io.Writer, args ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, gitOperationTimeout(class))
+	defer cancel()
+
+	release, err := gitOperations().Acquire(ctx, class)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	stderr, err := process.ExecDirContext(ctx, dir, fmt.Sprintf("runGitOperation [%s]: %s", class, dir), stdout, "git", args...)
+	if err != nil {
+		return fmt.Errorf("%w - %s", err, stderr)
+	}
+	return nil
+}
+
+// Formats of repository archives, which are also their file extensions.
+const (
+	ARCHIVE_ZIP     = "zip"
+	ARCHIVE_TAR_GZ  = "tar.gz"
+	ARCHIVE_TAR_ZST = "tar.zst"
+)
+
+// ArchiveFormats are formats of repository archives that can be downloaded.
+var ArchiveFormats = []string{ARCHIVE_ZIP, ARCHIVE_TAR_GZ, ARCHIVE_TAR_ZST}
+
+// CreateArchive creates the archive of the commit at the target path, which
+// only contains given paths if any. Files are excluded or substituted as
+// "export-ignore" and "export-subst" attributes of the commit tell. The archive
+// is written to a temporary file first, so an archive stopped halfway is never
+// left behind.
+func CreateArchive(ctx context.Context, repoPath, commitID, target, format string, paths ...string) error {
+	tmpPath := fmt.Sprintf("%s.%d.tmp", target, time.Now().UnixNano())
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	prefix := filepath.Base(strings.TrimSuffix(repoPath, ".git")) + "/"
+	err = WriteArchive(ctx, f, repoPath, commitID, prefix, format, paths...)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, target)
+}
+
+// WriteArchive streams the archive of the commit to the writer, where all files
+// are under the prefix, and only given paths are included if any. Pass the path
+// of a directory for its archive rather than "<commit>:<path>", because the
+// attributes of the root directory are not applied to the archive of a tree.
+func WriteArchive(ctx context.Context, w io.Writer, repoPath, commitID, prefix, format string, paths ...string) error {
+	args := []string{"archive", "--prefix=" + prefix}
+	tail := []string{commitID}
+	if len(paths) > 0 {
+		tail = append(tail, "--")
+		// Paths are not patterns, whatever characters they contain.
+		for _, p := range paths {
+			tail = append(tail, ":(literal)"+p)
+		}
+	}
+
+	var err error
+	switch format {
+	case ARCHIVE_ZIP, ARCHIVE_TAR_GZ:
+		args = append(args, "--format="+format)
+		err = runGitOperation(ctx, GIT_OPERATION_ARCHIVE, repoPath, w, append(args, tail...)...)
+	case ARCHIVE_TAR_ZST:
+		// Git only compresses tarballs with gzip by itself.
+		var zw *zstd.Encoder
+		zw, err = zstd.NewWriter(w)
+		if err != nil {
+			return err
+		}
+		args = append(args, "--format=tar")
+		err = runGitOperation(ctx, GIT_OPERATION_ARCHIVE, repoPath, zw, append(args, tail...)...)
+		if closeErr := zw.Close(); err == nil {
+			err = closeErr
+		}
+	default:
+		return fmt.Errorf("unknown format: %q", format)
+	}
+	if err != nil {
+		return fmt.Errorf("git archive: %w", err)
+	}
+	return nil
+}
+
+// diffArgs returns arguments of the Git command to show changes between two
+// commits, the parent of the commit is used when before commit is empty.
+func diffArgs(repoPath, beforeCommitID, afterCommitID string) ([]string, error) {
+	if beforeCommitID != "" {
+		return []string{"diff", "--full-index", "-M", beforeCommitID, afterCommitID}, nil
+	}
+
+	gitRepo, err := git.OpenRepository(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("OpenRepository: %v", err)
+	}
+	commit, err := gitRepo.GetCommit(afterCommitID)
+	if err != nil {
+		return nil, fmt.Errorf("GetCommit: %v", err)
+	}
+
+	// First commit of repository
+	if commit.ParentCount() == 0 {
+		return []string{"show", "--full-index", afterCommitID}, nil
+	}
+	parent, err := commit.ParentID(0)
+	if err != nil {
+		return nil, fmt.Errorf("ParentID: %v", err)
+	}
+	return []string{"diff", "--full-index", "-M", parent.String(), afterCommitID}, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+// readTarArchive returns contents of regular files in the compressed tarball by
+// their names without the prefix.
+func readTarArchive(t *testing.T, target, format string) map[string]string {
+	f, err := os.Open(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var r io.Reader
+	switch format {
+	case ARCHIVE_TAR_GZ:
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer gr.Close()
+		r = gr
+	case ARCHIVE_TAR_ZST:
+		zr, err := zstd.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	files := make(map[string]string)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[hdr.Name[strings.Index(hdr.Name, "/")+1:]] = string(data)
+	}
+	return files
+}
+
+func TestCreateArchive(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	repo.WriteFile("README.md", "readme")
+	repo.WriteFile("secret.txt", "secret")
+	repo.WriteFile("version.txt", "$Format:%H$")
+	repo.WriteFile("docs/guide.md", "guide")
+	repo.WriteFile("docs/api/index.md", "api")
+	plainCommitID := repo.Commit("init")
+
+	repo.WriteFile(".gitattributes", "secret.txt export-ignore\nversion.txt export-subst\n")
+	commitID := repo.Commit("add attributes")
+
+	repo.WriteFile("docs/draft.md", "draft")
+	repo.WriteFile(".gitattributes", "docs/draft.md export-ignore\n")
+	draftCommitID := repo.Commit("add draft")
+
+	for _, format := range []string{ARCHIVE_TAR_GZ, ARCHIVE_TAR_ZST} {
+		Convey("Create archive in "+format, t, func() {
+			target := filepath.Join(dir, "plain."+format)
+			So(CreateArchive(context.Background(), dir, plainCommitID, target, format), ShouldBeNil)
+			So(readTarArchive(t, target, format), ShouldResemble, map[string]string{
+				"README.md":         "readme",
+				"secret.txt":        "secret",
+				"version.txt":       "$Format:%H$",
+				"docs/guide.md":     "guide",
+				"docs/api/index.md": "api",
+			})
+
+			Convey("Export attributes are respected", func() {
+				target := filepath.Join(dir, "export."+format)
+				So(CreateArchive(context.Background(), dir, commitID, target, format), ShouldBeNil)
+				So(readTarArchive(t, target, format), ShouldResemble, map[string]string{
+					".gitattributes":    "secret.txt export-ignore\nversion.txt export-subst\n",
+					"README.md":         "readme",
+					"version.txt":       commitID,
+					"docs/guide.md":     "guide",
+					"docs/api/index.md": "api",
+				})
+			})
+
+			Convey("Archive of a directory respects attributes of the root directory", func() {
+				target := filepath.Join(dir, "docs."+format)
+				f, err := os.Create(target)
+				So(err, ShouldBeNil)
+				So(WriteArchive(context.Background(), f, dir, draftCommitID, "repo/", format, "docs"), ShouldBeNil)
+				So(f.Close(), ShouldBeNil)
+				So(readTarArchive(t, target, format), ShouldResemble, map[string]string{
+					"docs/guide.md":     "guide",
+					"docs/api/index.md": "api",
+				})
+
+				f, err = os.Create(target)
+				So(err, ShouldBeNil)
+				So(WriteArchive(context.Background(), f, dir, draftCommitID, "repo/", format, "docs/api"), ShouldBeNil)
+				So(f.Close(), ShouldBeNil)
+				So(readTarArchive(t, target, format), ShouldResemble, map[string]string{
+					"docs/api/index.md": "api",
+				})
+			})
+		})
+	}
+
+	Convey("Unknown formats are rejected", t, func() {
+		So(CreateArchive(context.Background(), dir, commitID, filepath.Join(dir, "archive.rar"), "rar"), ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/gogs/git-module"
+)
+
+// Special values of attributes in ".gitattributes".
+const (
+	GIT_ATTR_SET         = "true"  // e.g. "text"
+	GIT_ATTR_UNSET       = "false" // e.g. "-text"
+	GIT_ATTR_UNSPECIFIED = ""      // e.g. "!text"
+)
+
+// GitAttributesRule is a line of the ".gitattributes" file that assigns
+// attributes to paths matching the pattern.
+type GitAttributesRule struct {
+	Pattern string
+	Attrs   map[string]string
+
+	matcher *regexp.Regexp
+}
+
+// ParseGitAttributes parses rules of a ".gitattributes" file in the order of
+// lines. Blank lines, comments, macro definitions and rules with patterns that
+// are not supported, e.g. quoted or directory ones, are skipped.
+func ParseGitAttributes(data []byte) []*GitAttributesRule {
+	rules := make([]*GitAttributesRule, 0, 10)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 ||
+			strings.HasPrefix(fields[0], "#") ||
+			strings.HasPrefix(fields[0], "[attr]") ||
+			strings.HasPrefix(fields[0], `"`) {
+			continue
+		}
+
+		matcher, err := compilePathPattern(fields[0], false)
+		if err != nil {
+			continue
+		}
+
+		attrs := make(map[string]string, len(fields)-1)
+		for _, field := range fields[1:] {
+			switch {
+			case strings.HasPrefix(field, "-"):
+				attrs[field[1:]] = GIT_ATTR_UNSET
+			case strings.HasPrefix(field, "!"):
+				attrs[field[1:]] = GIT_ATTR_UNSPECIFIED
+			default:
+				if i := strings.Index(field, "="); i > 0 {
+					attrs[field[:i]] = field[i+1:]
+				} else {
+					attrs[field] = GIT_ATTR_SET
+				}
+			}
+		}
+		rules = append(rules, &GitAttributesRule{
+			Pattern: fields[0],
+			Attrs:   attrs,
+			matcher: matcher,
+		})
+	}
+	return rules
+}
+
+// GitAttribute returns the value of the attribute for the file at given path,
+// where the last matching rule that specifies the attribute takes precedence.
+// It returns GIT_ATTR_UNSPECIFIED if no rule specifies the attribute.
+func GitAttribute(rules []*GitAttributesRule, treePath, name string) string {
+	treePath = strings.TrimPrefix(treePath, "/")
+	for i := len(rules) - 1; i >= 0; i-- {
+		if v, ok := rules[i].Attrs[name]; ok && rules[i].matcher.MatchString(treePath) {
+			return v
+		}
+	}
+	return GIT_ATTR_UNSPECIFIED
+}
+
+// gitAttributesReader reads attributes of files according to ".gitattributes"
+// files in the tree of a commit, where parsed files are cached by directories
+// so attributes of many files can be read at once.
+type gitAttributesReader struct {
+	commit *git.Commit
+	rules  map[string][]*GitAttributesRule
+}
+
+func newGitAttributesReader(commit *git.Commit) *gitAttributesReader {
+	return &gitAttributesReader{
+		commit: commit,
+		rules:  make(map[string][]*GitAttributesRule),
+	}
+}
+
+// rulesOf returns rules of the ".gitattributes" file in the directory, which
+// are empty when the file does not exist.
+func (r *gitAttributesReader) rulesOf(dir string) ([]*GitAttributesRule, error) {
+	if rules, ok := r.rules[dir]; ok {
+		return rules, nil
+	}
+
+	fpath := path.Join(dir, ".gitattributes")
+	data, err := readBlob(r.commit, fpath)
+	if err != nil && !git.IsErrNotExist(err) {
+		return nil, fmt.Errorf("read %q: %v", fpath, err)
+	}
+	rules := ParseGitAttributes(data)
+	r.rules[dir] = rules
+	return rules, nil
+}
+
+// attribute returns the value of the attribute for the file at given path.
+// Like Git, files in deeper directories take precedence, and their patterns
+// are relative to the directories they are in.
+func (r *gitAttributesReader) attribute(treePath, name string) (string, error) {
+	treePath = strings.TrimPrefix(treePath, "/")
+	dirs := []string{""}
+	for dir := path.Dir(treePath); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		dirs = append(dirs, dir)
+	}
+
+	// Go from the deepest directory, the first one that specifies the attribute
+	// takes precedence.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		rules, err := r.rulesOf(dirs[i])
+		if err != nil {
+			return "", err
+		}
+
+		relPath := treePath
+		if dirs[i] != "" {
+			relPath = strings.TrimPrefix(treePath, dirs[i]+"/")
+		}
+		if v := GitAttribute(rules, relPath, name); v != GIT_ATTR_UNSPECIFIED {
+			return v, nil
+		}
+	}
+	return GIT_ATTR_UNSPECIFIED, nil
+}
+
+// isDiffCollapsed returns true if the file at given path is marked as not to
+// be diffed, i.e. with "-diff" or "binary", or as generated, i.e. with
+// "linguist-generated".
+func (r *gitAttributesReader) isDiffCollapsed(treePath string) (bool, error) {
+	for _, name := range []string{"diff", "binary", "linguist-generated"} {
+		v, err := r.attribute(treePath, name)
+		if err != nil {
+			return false, err
+		}
+		if (name == "diff" && v == GIT_ATTR_UNSET) ||
+			(name != "diff" && v == GIT_ATTR_SET) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GitAttributeAt returns the value of the attribute for the file at given path
+// according to ".gitattributes" files in the tree of given commit. Like Git,
+// files in deeper directories take precedence, and their patterns are relative
+// to the directories they are in.
+func GitAttributeAt(commit *git.Commit, treePath, name string) (string, error) {
+	return newGitAttributesReader(commit).attribute(treePath, name)
+}
+
+// IsDiffCollapsed returns true if changes of the file at given path should be
+// collapsed in diffs by default, according to ".gitattributes" files in the
+// tree of given commit. These are files marked as not to be diffed or as
+// generated, e.g. lockfiles and minified scripts.
+func IsDiffCollapsed(commit *git.Commit, treePath string) (bool, error) {
+	return newGitAttributesReader(commit).isDiffCollapsed(treePath)
+}
+
+// CollapseGeneratedFiles marks files of the diff whose changes should be
+// collapsed by default according to ".gitattributes" files in the tree of
+// given commit, which is usually the newer side of the diff.
+func (diff *Diff) CollapseGeneratedFiles(commit *git.Commit) error {
+	r := newGitAttributesReader(commit)
+	for _, f := range diff.Files {
+		if f.IsDeleted {
+			continue
+		}
+		collapsed, err := r.isDiffCollapsed(f.Name)
+		if err != nil {
+			return fmt.Errorf("isDiffCollapsed %q: %v", f.Name, err)
+		}
+		f.IsCollapsed = collapsed
+	}
+	return nil
+}
+
+// IsLFSTracked returns true if the file at given path is supposed to be tracked
+// by Git LFS, i.e. with "filter=lfs" attribute, according to ".gitattributes"
+// files in the tree of given commit. It does not check whether the current blob
+// is actually an LFS pointer.
+func IsLFSTracked(commit *git.Commit, treePath string) (bool, error) {
+	filter, err := GitAttributeAt(commit, treePath, "filter")
+	if err != nil {
+		return false, err
+	}
+	return filter == "lfs", nil
+}
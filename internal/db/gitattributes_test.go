@@ -0,0 +1,107 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_GitAttribute(t *testing.T) {
+	rules := ParseGitAttributes([]byte(`# Binary files
+[attr]binary -diff -merge -text
+*.psd   filter=lfs diff=lfs merge=lfs -text
+assets/ filter=lfs
+*.go    text eol=lf
+vendor/**/*.psd !filter
+"a b.psd" filter=lfs
+`))
+
+	Convey("Parse rules and skip unsupported lines", t, func() {
+		So(rules, ShouldHaveLength, 3)
+		So(rules[0].Pattern, ShouldEqual, "*.psd")
+		So(rules[0].Attrs, ShouldResemble, map[string]string{
+			"filter": "lfs",
+			"diff":   "lfs",
+			"merge":  "lfs",
+			"text":   GIT_ATTR_UNSET,
+		})
+		So(rules[1].Attrs, ShouldResemble, map[string]string{
+			"text": GIT_ATTR_SET,
+			"eol":  "lf",
+		})
+		So(rules[2].Attrs, ShouldResemble, map[string]string{
+			"filter": GIT_ATTR_UNSPECIFIED,
+		})
+	})
+
+	Convey("The last matching rule takes precedence", t, func() {
+		testCases := []struct {
+			path   string
+			name   string
+			expect string
+		}{
+			{"logo.psd", "filter", "lfs"},
+			{"/assets/logo.psd", "filter", "lfs"},
+			{"assets/logo.psd", "text", GIT_ATTR_UNSET},
+			{"vendor/images/logo.psd", "filter", GIT_ATTR_UNSPECIFIED},
+			{"vendor/images/logo.psd", "diff", "lfs"},
+			{"main.go", "filter", GIT_ATTR_UNSPECIFIED},
+			{"main.go", "eol", "lf"},
+		}
+		for _, tc := range testCases {
+			So(GitAttribute(rules, tc.path, tc.name), ShouldEqual, tc.expect)
+		}
+	})
+}
+
+func Test_IsDiffCollapsed(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	for filename, content := range map[string]string{
+		".gitattributes":        "package-lock.json -diff\n*.min.js linguist-generated\n*.pb.go linguist-generated=true\n",
+		"web/.gitattributes":    "vendor/** binary\napp.min.js -linguist-generated\n",
+		"package-lock.json":     "{}",
+		"web/app.min.js":        "",
+		"web/lib.min.js":        "",
+		"web/vendor/jquery.js":  "",
+		"api/service.pb.go":     "",
+		"api/service.go":        "",
+		"docs/package-lock.txt": "",
+	} {
+		repo.WriteFile(filename, content)
+	}
+	repo.Commit("init")
+
+	gitRepo, err := git.OpenRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit, err := gitRepo.GetCommit("HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Collapse files that are not diffed or generated", t, func() {
+		for treePath, expect := range map[string]bool{
+			"package-lock.json":     true,
+			"web/lib.min.js":        true,
+			"web/app.min.js":        false,
+			"web/vendor/jquery.js":  true,
+			"api/service.pb.go":     true,
+			"api/service.go":        false,
+			"docs/package-lock.txt": false,
+		} {
+			collapsed, err := IsDiffCollapsed(commit, treePath)
+			So(err, ShouldBeNil)
+			So(collapsed, ShouldEqual, expect)
+		}
+	})
+}
@@ -46,6 +46,15 @@ type Issue struct {
 	IsPull          bool         // Indicates whether is a pull request or not.
 	PullRequest     *PullRequest `xorm:"-" json:"-"`
 	NumComments     int
+	// CloseReason is set when the issue is closed and cleared when reopened,
+	// it is not used by pull requests.
+	CloseReason IssueCloseReason `xorm:"VARCHAR(50)"`
+
+	// Only repository writers can comment on locked issues.
+	IsLocked     bool
+	LockReason   string
+	LockedUnix   int64
+	UnlockedUnix int64
 
 	Deadline     time.Time `xorm:"-" json:"-"`
 	DeadlineUnix int64
@@ -242,13 +251,13 @@ func (issue *Issue) sendLabelUpdatedWebhook(doer *User) {
 			Sender:      doer.APIFormat(),
 		})
 	} else {
-		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, &api.IssuesPayload{
+		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, NewIssuesPayload(&api.IssuesPayload{
 			Action:     api.HOOK_ISSUE_LABEL_UPDATED,
 			Index:      issue.Index,
 			Issue:      issue.APIFormat(),
 			Repository: issue.Repo.APIFormat(nil),
 			Sender:     doer.APIFormat(),
-		})
+		}, issue))
 	}
 	if err != nil {
 		log.Error("PrepareWebhooks [is_pull: %v]: %v", issue.IsPull, err)
@@ -357,13 +366,13 @@ func (issue *Issue) ClearLabels(doer *User) (err error) {
 			Sender:      doer.APIFormat(),
 		})
 	} else {
-		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, &api.IssuesPayload{
+		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, NewIssuesPayload(&api.IssuesPayload{
 			Action:     api.HOOK_ISSUE_LABEL_CLEARED,
 			Index:      issue.Index,
 			Issue:      issue.APIFormat(),
 			Repository: issue.Repo.APIFormat(nil),
 			Sender:     doer.APIFormat(),
-		})
+		}, issue))
 	}
 	if err != nil {
 		log.Error("PrepareWebhooks [is_pull: %v]: %v", issue.IsPull, err)
@@ -416,19 +425,38 @@ func UpdateIssueCols(issue *Issue, cols ...string) error {
 	return updateIssueCols(x, issue, cols...)
 }
 
-func (issue *Issue) changeStatus(e *xorm.Session, doer *User, repo *Repository, isClosed bool) (err error) {
+func (issue *Issue) changeStatus(e *xorm.Session, doer *User, repo *Repository, isClosed bool, reason IssueCloseReason) (err error) {
 	// Nothing should be performed if current status is same as target status
 	if issue.IsClosed == isClosed {
 		return nil
 	}
 	issue.IsClosed = isClosed
 
-	if err = updateIssueCols(e, issue, "is_closed"); err != nil {
+	issue.CloseReason = ""
+	if isClosed && !issue.IsPull {
+		issue.CloseReason = reason
+		if issue.CloseReason == "" {
+			issue.CloseReason = ISSUE_CLOSE_REASON_COMPLETED
+		}
+	}
+
+	if err = updateIssueCols(e, issue, "is_closed", "close_reason"); err != nil {
 		return err
 	} else if err = updateIssueUsersByStatus(e, issue.ID, isClosed); err != nil {
 		return err
 	}
 
+	// Close reason does not apply to pull requests that are reopened.
+	if issue.IsPull && !isClosed {
+		if _, err = e.Table("pull_request").Where("issue_id = ?", issue.ID).
+			Update(map[string]interface{}{"close_reason": ""}); err != nil {
+			return fmt.Errorf("clear close reason: %v", err)
+		}
+		if issue.PullRequest != nil {
+			issue.PullRequest.CloseReason = ""
+		}
+	}
+
 	// Update issue count of labels
 	if err = issue.getLabels(e); err != nil {
 		return err
@@ -457,15 +485,17 @@ func (issue *Issue) changeStatus(e *xorm.Session, doer *User, repo *Repository,
 	return nil
 }
 
-// ChangeStatus changes issue status to open or closed.
-func (issue *Issue) ChangeStatus(doer *User, repo *Repository, isClosed bool) (err error) {
+// ChangeStatus changes issue status to open or closed. Issues are closed for
+// the reason, or as completed when it is empty. The reason is ignored by pull
+// requests.
+func (issue *Issue) ChangeStatus(doer *User, repo *Repository, isClosed bool, reason IssueCloseReason) (err error) {
 	sess := x.NewSession()
 	defer sess.Close()
 	if err = sess.Begin(); err != nil {
 		return err
 	}
 
-	if err = issue.changeStatus(sess, doer, repo, isClosed); err != nil {
+	if err = issue.changeStatus(sess, doer, repo, isClosed, reason); err != nil {
 		return err
 	}
 
@@ -473,6 +503,16 @@ func (issue *Issue) ChangeStatus(doer *User, repo *Repository, isClosed bool) (e
 		return fmt.Errorf("Commit: %v", err)
 	}
 
+	if err = issue.prepareStatusWebhooks(doer, repo, isClosed); err != nil {
+		log.Error("PrepareWebhooks [is_pull: %v, is_closed: %v]: %v", issue.IsPull, isClosed, err)
+	}
+
+	return nil
+}
+
+// prepareStatusWebhooks prepares webhooks of the issue or pull request being
+// closed or reopened.
+func (issue *Issue) prepareStatusWebhooks(doer *User, repo *Repository, isClosed bool) (err error) {
 	if issue.IsPull {
 		// Merge pull request calls issue.changeStatus so we need to handle separately.
 		issue.PullRequest.Issue = issue
@@ -500,13 +540,9 @@ func (issue *Issue) ChangeStatus(doer *User, repo *Repository, isClosed bool) (e
 		} else {
 			apiIssues.Action = api.HOOK_ISSUE_REOPENED
 		}
-		err = PrepareWebhooks(repo, HOOK_EVENT_ISSUES, apiIssues)
+		err = PrepareWebhooks(repo, HOOK_EVENT_ISSUES, NewIssuesPayload(apiIssues, issue))
 	}
-	if err != nil {
-		log.Error("PrepareWebhooks [is_pull: %v, is_closed: %v]: %v", issue.IsPull, isClosed, err)
-	}
-
-	return nil
+	return err
 }
 
 func (issue *Issue) ChangeTitle(doer *User, title string) (err error) {
@@ -531,7 +567,7 @@ func (issue *Issue) ChangeTitle(doer *User, title string) (err error) {
 			Sender:     doer.APIFormat(),
 		})
 	} else {
-		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, &api.IssuesPayload{
+		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, NewIssuesPayload(&api.IssuesPayload{
 			Action: api.HOOK_ISSUE_EDITED,
 			Index:  issue.Index,
 			Issue:  issue.APIFormat(),
@@ -542,7 +578,7 @@ func (issue *Issue) ChangeTitle(doer *User, title string) (err error) {
 			},
 			Repository: issue.Repo.APIFormat(nil),
 			Sender:     doer.APIFormat(),
-		})
+		}, issue))
 	}
 	if err != nil {
 		log.Error("PrepareWebhooks [is_pull: %v]: %v", issue.IsPull, err)
@@ -573,7 +609,7 @@ func (issue *Issue) ChangeContent(doer *User, content string) (err error) {
 			Sender:     doer.APIFormat(),
 		})
 	} else {
-		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, &api.IssuesPayload{
+		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, NewIssuesPayload(&api.IssuesPayload{
 			Action: api.HOOK_ISSUE_EDITED,
 			Index:  issue.Index,
 			Issue:  issue.APIFormat(),
@@ -584,7 +620,7 @@ func (issue *Issue) ChangeContent(doer *User, content string) (err error) {
 			},
 			Repository: issue.Repo.APIFormat(nil),
 			Sender:     doer.APIFormat(),
-		})
+		}, issue))
 	}
 	if err != nil {
 		log.Error("PrepareWebhooks [is_pull: %v]: %v", issue.IsPull, err)
@@ -633,7 +669,7 @@ func (issue *Issue) ChangeAssignee(doer *User, assigneeID int64) (err error) {
 		} else {
 			apiIssues.Action = api.HOOK_ISSUE_ASSIGNED
 		}
-		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, apiIssues)
+		err = PrepareWebhooks(issue.Repo, HOOK_EVENT_ISSUES, NewIssuesPayload(apiIssues, issue))
 	}
 	if err != nil {
 		log.Error("PrepareWebhooks [is_pull: %v, remove_assignee: %v]: %v", issue.IsPull, isRemoveAssignee, err)
@@ -783,13 +819,13 @@ func NewIssue(repo *Repository, issue *Issue, labelIDs []int64, uuids []string)
 		log.Error("MailParticipants: %v", err)
 	}
 
-	if err = PrepareWebhooks(repo, HOOK_EVENT_ISSUES, &api.IssuesPayload{
+	if err = PrepareWebhooks(repo, HOOK_EVENT_ISSUES, NewIssuesPayload(&api.IssuesPayload{
 		Action:     api.HOOK_ISSUE_OPENED,
 		Index:      issue.Index,
 		Issue:      issue.APIFormat(),
 		Repository: repo.APIFormat(nil),
 		Sender:     issue.Poster.APIFormat(),
-	}); err != nil {
+	}, issue)); err != nil {
 		log.Error("PrepareWebhooks: %v", err)
 	}
 
@@ -883,6 +919,8 @@ type IssuesOptions struct {
 	IsPull      bool
 	Labels      string
 	SortType    string
+	// CloseReason only lists closed issues of the reason if it is not empty.
+	CloseReason IssueCloseReason
 }
 
 // buildIssuesQuery returns nil if it foresees there won't be any value returned.
@@ -915,6 +953,10 @@ func buildIssuesQuery(opts *IssuesOptions) *xorm.Session {
 		sess.And("issue.milestone_id=?", opts.MilestoneID)
 	}
 
+	if opts.IsClosed && opts.CloseReason != "" {
+		sess.And("issue.close_reason=?", opts.CloseReason)
+	}
+
 	sess.And("issue.is_pull=?", opts.IsPull)
 
 	switch opts.SortType {
@@ -986,7 +1028,26 @@ func Issues(opts *IssuesOptions) ([]*Issue, error) {
 	return issues, nil
 }
 
-// GetParticipantsByIssueID returns all users who are participated in comments of an issue.
+// GetRecentIssuesByRepoID returns at most limit issues of the repository, both
+// open and closed, in the descending order of creation. Pull requests are not
+// included.
+func GetRecentIssuesByRepoID(repoID int64, limit int) ([]*Issue, error) {
+	issues := make([]*Issue, 0, limit)
+	if err := x.Where("repo_id = ?", repoID).And("is_pull = ?", false).
+		Desc("created_unix").Limit(limit).Find(&issues); err != nil {
+		return nil, fmt.Errorf("Find: %v", err)
+	}
+
+	for i := range issues {
+		if err := issues[i].LoadAttributes(); err != nil {
+			return nil, fmt.Errorf("LoadAttributes [%d]: %v", issues[i].ID, err)
+		}
+	}
+	return issues, nil
+}
+
+// GetParticipantsByIssueID returns all users who are participated in comments of an issue,
+// or subscribed to the issue.
 func GetParticipantsByIssueID(issueID int64) ([]*User, error) {
 	userIDs := make([]int64, 0, 5)
 	if err := x.Table("comment").Cols("poster_id").
@@ -995,6 +1056,13 @@ func GetParticipantsByIssueID(issueID int64) ([]*User, error) {
 		Find(&userIDs); err != nil {
 		return nil, fmt.Errorf("get poster IDs: %v", err)
 	}
+	subscriberIDs := make([]int64, 0, 5)
+	if err := x.Table("issue_user").Cols("uid").
+		Where("issue_id = ? AND is_subscribed = ?", issueID, true).
+		Find(&subscriberIDs); err != nil {
+		return nil, fmt.Errorf("get subscriber IDs: %v", err)
+	}
+	userIDs = append(userIDs, subscriberIDs...)
 	if len(userIDs) == 0 {
 		return nil, nil
 	}
@@ -1022,6 +1090,9 @@ type IssueUser struct {
 	IsMentioned bool
 	IsPoster    bool
 	IsClosed    bool
+	// IsSubscribed indicates whether the user receives notifications of the
+	// issue without participating, e.g. moved from a duplicate issue.
+	IsSubscribed bool
 }
 
 func newIssueUsers(e *xorm.Session, repo *Repository, issue *Issue) error {
@@ -1201,18 +1272,37 @@ func parseCountResult(results []map[string][]byte) int64 {
 }
 
 type IssueStatsOptions struct {
-	RepoID      int64
+	RepoID int64
+	// Repo is the repository of RepoID if loaded, whose stored numbers are used
+	// when issues are not filtered.
+	Repo        *Repository
 	UserID      int64
 	Labels      string
 	MilestoneID int64
 	AssigneeID  int64
 	FilterMode  FilterMode
 	IsPull      bool
+	// CloseReason only counts closed issues of the reason if it is not empty.
+	CloseReason IssueCloseReason
+}
+
+// isUnfiltered returns true if all issues or pull requests of the repository
+// are counted.
+func (opts *IssueStatsOptions) isUnfiltered() bool {
+	return opts.FilterMode == FILTER_MODE_YOUR_REPOS &&
+		(opts.Labels == "" || opts.Labels == "0") &&
+		opts.MilestoneID <= 0 &&
+		opts.AssigneeID <= 0 &&
+		opts.CloseReason == ""
 }
 
 // GetIssueStats returns issue statistic information by given conditions.
 func GetIssueStats(opts *IssueStatsOptions) *IssueStats {
 	stats := &IssueStats{}
+	if opts.Repo != nil && opts.isUnfiltered() {
+		stats.OpenCount, stats.ClosedCount = opts.Repo.IssueCounts(opts.IsPull)
+		return stats
+	}
 
 	countSession := func(opts *IssueStatsOptions) *xorm.Session {
 		sess := x.Where("issue.repo_id = ?", opts.RepoID).And("is_pull = ?", opts.IsPull)
@@ -1234,6 +1324,13 @@ func GetIssueStats(opts *IssueStatsOptions) *IssueStats {
 
 		return sess
 	}
+	closedSession := func(opts *IssueStatsOptions) *xorm.Session {
+		sess := countSession(opts).And("issue.is_closed = ?", true)
+		if opts.CloseReason != "" {
+			sess.And("issue.close_reason = ?", opts.CloseReason)
+		}
+		return sess
+	}
 
 	switch opts.FilterMode {
 	case FILTER_MODE_YOUR_REPOS, FILTER_MODE_ASSIGN:
@@ -1241,8 +1338,7 @@ func GetIssueStats(opts *IssueStatsOptions) *IssueStats {
 			And("is_closed = ?", false).
 			Count(new(Issue))
 
-		stats.ClosedCount, _ = countSession(opts).
-			And("is_closed = ?", true).
+		stats.ClosedCount, _ = closedSession(opts).
 			Count(new(Issue))
 	case FILTER_MODE_CREATE:
 		stats.OpenCount, _ = countSession(opts).
@@ -1250,9 +1346,8 @@ func GetIssueStats(opts *IssueStatsOptions) *IssueStats {
 			And("is_closed = ?", false).
 			Count(new(Issue))
 
-		stats.ClosedCount, _ = countSession(opts).
+		stats.ClosedCount, _ = closedSession(opts).
 			And("poster_id = ?", opts.UserID).
-			And("is_closed = ?", true).
 			Count(new(Issue))
 	case FILTER_MODE_MENTION:
 		stats.OpenCount, _ = countSession(opts).
@@ -1262,67 +1357,63 @@ func GetIssueStats(opts *IssueStatsOptions) *IssueStats {
 			And("issue.is_closed = ?", false).
 			Count(new(Issue))
 
-		stats.ClosedCount, _ = countSession(opts).
+		stats.ClosedCount, _ = closedSession(opts).
 			Join("INNER", "issue_user", "issue.id = issue_user.issue_id").
 			And("issue_user.uid = ?", opts.UserID).
 			And("issue_user.is_mentioned = ?", true).
-			And("issue.is_closed = ?", true).
 			Count(new(Issue))
 	}
 	return stats
 }
 
-// GetUserIssueStats returns issue statistic information for dashboard by given conditions.
-func GetUserIssueStats(repoID, userID int64, repoIDs []int64, filterMode FilterMode, isPull bool) *IssueStats {
+// GetUserIssueStats returns issue statistic information for dashboard by given
+// conditions. Numbers of issues of repositories of the user are summed from
+// stored numbers of the repos, which are the repositories whose issues are
+// listed.
+func GetUserIssueStats(repoID, userID int64, repos []*Repository, filterMode FilterMode, isPull bool) *IssueStats {
 	stats := &IssueStats{}
-	hasAnyRepo := repoID > 0 || len(repoIDs) > 0
-	countSession := func(isClosed, isPull bool, repoID int64, repoIDs []int64) *xorm.Session {
+	countSession := func(isClosed, isPull bool, repoID int64) *xorm.Session {
 		sess := x.Where("issue.is_closed = ?", isClosed).And("issue.is_pull = ?", isPull)
 
 		if repoID > 0 {
 			sess.And("repo_id = ?", repoID)
-		} else if len(repoIDs) > 0 {
-			sess.In("repo_id", repoIDs)
 		}
 
 		return sess
 	}
 
-	stats.AssignCount, _ = countSession(false, isPull, repoID, nil).
+	stats.AssignCount, _ = countSession(false, isPull, repoID).
 		And("assignee_id = ?", userID).
 		Count(new(Issue))
 
-	stats.CreateCount, _ = countSession(false, isPull, repoID, nil).
+	stats.CreateCount, _ = countSession(false, isPull, repoID).
 		And("poster_id = ?", userID).
 		Count(new(Issue))
 
-	if hasAnyRepo {
-		stats.YourReposCount, _ = countSession(false, isPull, repoID, repoIDs).
-			Count(new(Issue))
+	var numOpen, numClosed int64
+	for _, repo := range repos {
+		open, closed := repo.IssueCounts(isPull)
+		numOpen += open
+		numClosed += closed
 	}
+	stats.YourReposCount = numOpen
 
 	switch filterMode {
 	case FILTER_MODE_YOUR_REPOS:
-		if !hasAnyRepo {
-			break
-		}
-
-		stats.OpenCount, _ = countSession(false, isPull, repoID, repoIDs).
-			Count(new(Issue))
-		stats.ClosedCount, _ = countSession(true, isPull, repoID, repoIDs).
-			Count(new(Issue))
+		stats.OpenCount = numOpen
+		stats.ClosedCount = numClosed
 	case FILTER_MODE_ASSIGN:
-		stats.OpenCount, _ = countSession(false, isPull, repoID, nil).
+		stats.OpenCount, _ = countSession(false, isPull, repoID).
 			And("assignee_id = ?", userID).
 			Count(new(Issue))
-		stats.ClosedCount, _ = countSession(true, isPull, repoID, nil).
+		stats.ClosedCount, _ = countSession(true, isPull, repoID).
 			And("assignee_id = ?", userID).
 			Count(new(Issue))
 	case FILTER_MODE_CREATE:
-		stats.OpenCount, _ = countSession(false, isPull, repoID, nil).
+		stats.OpenCount, _ = countSession(false, isPull, repoID).
 			And("poster_id = ?", userID).
 			Count(new(Issue))
-		stats.ClosedCount, _ = countSession(true, isPull, repoID, nil).
+		stats.ClosedCount, _ = countSession(true, isPull, repoID).
 			And("poster_id = ?", userID).
 			Count(new(Issue))
 	}
@@ -0,0 +1,125 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"strings"
+)
+
+// IssueCloseReason is the reason an issue is closed for.
+type IssueCloseReason string
+
+// Built-in reasons of closing issues.
+const (
+	// ISSUE_CLOSE_REASON_CLOSED is the neutral reason of issues closed before
+	// close reasons were introduced, which cannot be chosen.
+	ISSUE_CLOSE_REASON_CLOSED      IssueCloseReason = "closed"
+	ISSUE_CLOSE_REASON_COMPLETED   IssueCloseReason = "completed"
+	ISSUE_CLOSE_REASON_NOT_PLANNED IssueCloseReason = "not-planned"
+	ISSUE_CLOSE_REASON_DUPLICATE   IssueCloseReason = "duplicate"
+)
+
+// MAX_ISSUE_CLOSE_REASON_LENGTH is the maximum length of a custom reason.
+const MAX_ISSUE_CLOSE_REASON_LENGTH = 50
+
+// issueCloseReasonStyles are the octicon and the color of built-in reasons.
+var issueCloseReasonStyles = map[IssueCloseReason][2]string{
+	ISSUE_CLOSE_REASON_CLOSED:      {"octicon-issue-closed", "red"},
+	ISSUE_CLOSE_REASON_COMPLETED:   {"octicon-check", "purple"},
+	ISSUE_CLOSE_REASON_NOT_PLANNED: {"octicon-circle-slash", "grey"},
+	ISSUE_CLOSE_REASON_DUPLICATE:   {"octicon-versions", "orange"},
+}
+
+// orDefault returns the neutral reason if the reason is empty.
+func (r IssueCloseReason) orDefault() IssueCloseReason {
+	if r == "" {
+		return ISSUE_CLOSE_REASON_CLOSED
+	}
+	return r
+}
+
+// IsNeutral returns true if the reason does not tell why the issue is closed.
+func (r IssueCloseReason) IsNeutral() bool {
+	return r.orDefault() == ISSUE_CLOSE_REASON_CLOSED
+}
+
+// IsCustom returns true if the reason is added by the repository.
+func (r IssueCloseReason) IsCustom() bool {
+	_, ok := issueCloseReasonStyles[r.orDefault()]
+	return !ok
+}
+
+// Icon returns the octicon class of the reason.
+func (r IssueCloseReason) Icon() string {
+	if r.IsCustom() {
+		return "octicon-issue-closed"
+	}
+	return issueCloseReasonStyles[r.orDefault()][0]
+}
+
+// Color returns the color class of the reason.
+func (r IssueCloseReason) Color() string {
+	if r.IsCustom() {
+		return "brown"
+	}
+	return issueCloseReasonStyles[r.orDefault()][1]
+}
+
+// LocaleKey returns the locale key of the name of a built-in reason.
+func (r IssueCloseReason) LocaleKey() string {
+	return "repo.issues.close_reason." + strings.Replace(string(r.orDefault()), "-", "_", -1)
+}
+
+// ParseCustomIssueCloseReasons parses custom reasons of closing issues, one per
+// line. Blank lines and duplicates are ignored.
+func ParseCustomIssueCloseReasons(s string) ([]IssueCloseReason, error) {
+	var reasons []IssueCloseReason
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		// Built-in reasons cannot be added in another case.
+		key := strings.ToLower(line)
+		if !IssueCloseReason(key).IsCustom() || len(line) > MAX_ISSUE_CLOSE_REASON_LENGTH {
+			return nil, ErrInvalidIssueCloseReason{Reason: line}
+		} else if seen[key] {
+			continue
+		}
+		seen[key] = true
+		reasons = append(reasons, IssueCloseReason(line))
+	}
+	return reasons, nil
+}
+
+// CustomIssueCloseReasons returns reasons of closing issues added by the
+// repository.
+func (repo *Repository) CustomIssueCloseReasons() []IssueCloseReason {
+	reasons, _ := ParseCustomIssueCloseReasons(repo.CustomCloseReasons)
+	return reasons
+}
+
+// IssueCloseReasons returns reasons that can be chosen to close issues of the
+// repository, built-in ones first.
+func (repo *Repository) IssueCloseReasons() []IssueCloseReason {
+	return append([]IssueCloseReason{
+		ISSUE_CLOSE_REASON_COMPLETED,
+		ISSUE_CLOSE_REASON_NOT_PLANNED,
+		ISSUE_CLOSE_REASON_DUPLICATE,
+	}, repo.CustomIssueCloseReasons()...)
+}
+
+// IsValidIssueCloseReason returns true if the reason can be chosen to close
+// issues of the repository.
+func (repo *Repository) IsValidIssueCloseReason(reason IssueCloseReason) bool {
+	for _, r := range repo.IssueCloseReasons() {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_IssueCloseReason(t *testing.T) {
+	Convey("Style reasons of closing issues", t, func() {
+		So(ISSUE_CLOSE_REASON_NOT_PLANNED.Icon(), ShouldEqual, "octicon-circle-slash")
+		So(ISSUE_CLOSE_REASON_NOT_PLANNED.Color(), ShouldEqual, "grey")
+		So(ISSUE_CLOSE_REASON_NOT_PLANNED.LocaleKey(), ShouldEqual, "repo.issues.close_reason.not_planned")
+		So(ISSUE_CLOSE_REASON_NOT_PLANNED.IsCustom(), ShouldBeFalse)
+
+		Convey("Empty reason is neutral", func() {
+			var reason IssueCloseReason
+			So(reason.IsNeutral(), ShouldBeTrue)
+			So(reason.IsCustom(), ShouldBeFalse)
+			So(reason.Icon(), ShouldEqual, ISSUE_CLOSE_REASON_CLOSED.Icon())
+			So(reason.LocaleKey(), ShouldEqual, "repo.issues.close_reason.closed")
+		})
+
+		Convey("Custom reasons share a style", func() {
+			reason := IssueCloseReason("won't fix")
+			So(reason.IsCustom(), ShouldBeTrue)
+			So(reason.IsNeutral(), ShouldBeFalse)
+			So(reason.Icon(), ShouldEqual, "octicon-issue-closed")
+			So(reason.Color(), ShouldEqual, "brown")
+		})
+	})
+}
+
+func Test_ParseCustomIssueCloseReasons(t *testing.T) {
+	Convey("Parse custom reasons of closing issues", t, func() {
+		reasons, err := ParseCustomIssueCloseReasons("won't fix\r\n\n  works as intended \nWon't fix\n")
+		So(err, ShouldBeNil)
+		So(reasons, ShouldResemble, []IssueCloseReason{"won't fix", "works as intended"})
+
+		reasons, err = ParseCustomIssueCloseReasons("")
+		So(err, ShouldBeNil)
+		So(reasons, ShouldBeEmpty)
+
+		Convey("Built-in reasons cannot be added", func() {
+			_, err := ParseCustomIssueCloseReasons("stale\nDuplicate")
+			So(IsErrInvalidIssueCloseReason(err), ShouldBeTrue)
+			So(err.(ErrInvalidIssueCloseReason).Reason, ShouldEqual, "Duplicate")
+
+			_, err = ParseCustomIssueCloseReasons("closed")
+			So(IsErrInvalidIssueCloseReason(err), ShouldBeTrue)
+		})
+
+		Convey("Reasons cannot be too long", func() {
+			_, err := ParseCustomIssueCloseReasons("this reason is much longer than fifty characters in total")
+			So(IsErrInvalidIssueCloseReason(err), ShouldBeTrue)
+		})
+	})
+}
+
+func TestRepository_IsValidIssueCloseReason(t *testing.T) {
+	Convey("Validate reasons of closing issues of a repository", t, func() {
+		repo := &Repository{CustomCloseReasons: "won't fix"}
+		So(repo.IssueCloseReasons(), ShouldResemble, []IssueCloseReason{
+			ISSUE_CLOSE_REASON_COMPLETED,
+			ISSUE_CLOSE_REASON_NOT_PLANNED,
+			ISSUE_CLOSE_REASON_DUPLICATE,
+			"won't fix",
+		})
+		So(repo.IsValidIssueCloseReason(ISSUE_CLOSE_REASON_DUPLICATE), ShouldBeTrue)
+		So(repo.IsValidIssueCloseReason("won't fix"), ShouldBeTrue)
+		So(repo.IsValidIssueCloseReason("stale"), ShouldBeFalse)
+		So(repo.IsValidIssueCloseReason(ISSUE_CLOSE_REASON_CLOSED), ShouldBeFalse)
+	})
+}
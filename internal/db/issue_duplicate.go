@@ -0,0 +1,285 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	log "unknwon.dev/clog/v2"
+)
+
+const (
+	// duplicateClosedWithin is how long closed issues are still considered as
+	// duplicate candidates after they are last updated.
+	duplicateClosedWithin = 90 * 24 * time.Hour
+	// maxDuplicateCandidates is the maximum number of issues to be scored for
+	// one lookup.
+	maxDuplicateCandidates = 200
+	// maxDuplicateTerms is the maximum number of terms of the title to look up.
+	maxDuplicateTerms = 8
+	// LIKELY_DUPLICATE_SCORE is the minimum score of a suggestion for the poster
+	// to confirm the new issue is not a duplicate before submission.
+	LIKELY_DUPLICATE_SCORE = 0.6
+)
+
+// duplicateStopWords are common words that do not tell issues apart.
+var duplicateStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "but": true, "by": true, "can": true, "cannot": true, "do": true,
+	"does": true, "for": true, "from": true, "has": true, "have": true, "how": true,
+	"in": true, "is": true, "it": true, "not": true, "of": true, "on": true,
+	"or": true, "the": true, "this": true, "to": true, "when": true, "with": true,
+}
+
+// stemTerm strips common English suffixes so that simple variants of a word,
+// e.g. "crash", "crashes" and "crashing", are considered as the same term.
+func stemTerm(term string) string {
+	for _, suffix := range []string{"ing", "es", "ed", "s"} {
+		if len(term) > len(suffix)+3 && strings.HasSuffix(term, suffix) {
+			return term[:len(term)-len(suffix)]
+		}
+	}
+	return term
+}
+
+// titleTerms returns distinct significant terms of the title in lower case.
+func titleTerms(title string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool, len(fields))
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if len(field) < 2 || duplicateStopWords[field] {
+			continue
+		}
+		term := stemTerm(field)
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// titleSimilarity returns the Dice coefficient of terms of two titles, from 0
+// for nothing in common to 1 for the same terms.
+func titleSimilarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	set := make(map[string]bool, len(a))
+	for _, term := range a {
+		set[term] = true
+	}
+	common := 0
+	for _, term := range b {
+		if set[term] {
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
+
+// DuplicateSuggestion is an existing issue that might be a duplicate of a new
+// issue.
+type DuplicateSuggestion struct {
+	*Issue
+	Score float64
+}
+
+// IsLikely returns true if the suggestion is similar enough to ask for
+// confirmation before submitting the new issue.
+func (s *DuplicateSuggestion) IsLikely() bool {
+	return s.Score >= LIKELY_DUPLICATE_SCORE
+}
+
+// SuggestDuplicateIssues returns up to limit open or recently closed issues of
+// the repository whose titles are similar to given title, most similar first.
+func SuggestDuplicateIssues(repoID int64, title string, limit int) ([]*DuplicateSuggestion, error) {
+	terms := titleTerms(title)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	// Longer terms are more specific, look up them in titles first.
+	lookups := make([]string, len(terms))
+	copy(lookups, terms)
+	sort.SliceStable(lookups, func(i, j int) bool {
+		return len(lookups[i]) > len(lookups[j])
+	})
+	if len(lookups) > maxDuplicateTerms {
+		lookups = lookups[:maxDuplicateTerms]
+	}
+	conds := make([]string, len(lookups))
+	args := make([]interface{}, len(lookups))
+	for i, term := range lookups {
+		conds[i] = "LOWER(name) LIKE ?"
+		args[i] = "%" + term + "%"
+	}
+
+	issues := make([]*Issue, 0, 10)
+	if err := x.Cols("id", "repo_id", "index", "name", "is_closed", "is_pull", "num_comments", "created_unix", "updated_unix").
+		Where("repo_id = ? AND is_pull = ?", repoID, false).
+		And("is_closed = ? OR updated_unix >= ?", false, time.Now().Add(-duplicateClosedWithin).Unix()).
+		And(strings.Join(conds, " OR "), args...).
+		Desc("updated_unix").
+		Limit(maxDuplicateCandidates).
+		Find(&issues); err != nil {
+		return nil, fmt.Errorf("find candidates: %v", err)
+	}
+
+	suggestions := make([]*DuplicateSuggestion, 0, len(issues))
+	for _, issue := range issues {
+		score := titleSimilarity(terms, titleTerms(issue.Title))
+		if score == 0 {
+			continue
+		}
+		suggestions = append(suggestions, &DuplicateSuggestion{
+			Issue: issue,
+			Score: score,
+		})
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		// Prefer open issues over closed ones.
+		return !suggestions[i].IsClosed && suggestions[j].IsClosed
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// issueParticipantIDs returns IDs of the poster, commenters and subscribers of
+// the issue.
+func issueParticipantIDs(e Engine, issue *Issue) ([]int64, error) {
+	posterIDs := make([]int64, 0, 5)
+	if err := e.Table("comment").Cols("poster_id").
+		Where("issue_id = ? AND type = ?", issue.ID, COMMENT_TYPE_COMMENT).
+		Distinct("poster_id").
+		Find(&posterIDs); err != nil {
+		return nil, fmt.Errorf("get poster IDs: %v", err)
+	}
+	subscriberIDs := make([]int64, 0, 5)
+	if err := e.Table("issue_user").Cols("uid").
+		Where("issue_id = ? AND is_subscribed = ?", issue.ID, true).
+		Find(&subscriberIDs); err != nil {
+		return nil, fmt.Errorf("get subscriber IDs: %v", err)
+	}
+
+	seen := make(map[int64]bool)
+	userIDs := make([]int64, 0, len(posterIDs)+len(subscriberIDs)+1)
+	for _, id := range append(append([]int64{issue.PosterID}, posterIDs...), subscriberIDs...) {
+		if id <= 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, nil
+}
+
+// subscribeIssue subscribes users to notifications of the issue.
+func subscribeIssue(e Engine, issue *Issue, userIDs []int64) error {
+	for _, userID := range userIDs {
+		issueUser := &IssueUser{
+			UID:     userID,
+			IssueID: issue.ID,
+		}
+		has, err := e.Get(issueUser)
+		if err != nil {
+			return fmt.Errorf("get issue user: %v", err)
+		} else if has {
+			if issueUser.IsSubscribed {
+				continue
+			}
+			issueUser.IsSubscribed = true
+			if _, err = e.ID(issueUser.ID).Cols("is_subscribed").Update(issueUser); err != nil {
+				return fmt.Errorf("update issue user: %v", err)
+			}
+			continue
+		}
+
+		issueUser.RepoID = issue.RepoID
+		issueUser.MilestoneID = issue.MilestoneID
+		issueUser.IsClosed = issue.IsClosed
+		issueUser.IsSubscribed = true
+		if _, err = e.Insert(issueUser); err != nil {
+			return fmt.Errorf("insert issue user: %v", err)
+		}
+	}
+	return nil
+}
+
+// MarkAsDuplicate closes the issue as a duplicate of the canonical issue in the
+// same repository, and cross-links both issues in their timelines. Participants
+// of the duplicate are subscribed to the canonical issue when transferParticipants
+// is true.
+func (issue *Issue) MarkAsDuplicate(doer *User, repo *Repository, canonical *Issue, transferParticipants bool) (err error) {
+	if issue.ID == canonical.ID || issue.RepoID != canonical.RepoID || issue.IsPull || canonical.IsPull {
+		return ErrInvalidDuplicateIssue{IssueID: issue.ID, CanonicalID: canonical.ID}
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err = createComment(sess, &CreateCommentOptions{
+		Type:    COMMENT_TYPE_DUPLICATE,
+		Doer:    doer,
+		Repo:    repo,
+		Issue:   issue,
+		Content: strconv.FormatInt(canonical.Index, 10),
+	}); err != nil {
+		return fmt.Errorf("create duplicate comment: %v", err)
+	}
+	if _, err = createComment(sess, &CreateCommentOptions{
+		Type:    COMMENT_TYPE_DUPLICATE_REF,
+		Doer:    doer,
+		Repo:    repo,
+		Issue:   canonical,
+		Content: strconv.FormatInt(issue.Index, 10),
+	}); err != nil {
+		return fmt.Errorf("create duplicate reference comment: %v", err)
+	}
+
+	if transferParticipants {
+		userIDs, err := issueParticipantIDs(sess, issue)
+		if err != nil {
+			return fmt.Errorf("issueParticipantIDs: %v", err)
+		} else if err = subscribeIssue(sess, canonical, userIDs); err != nil {
+			return fmt.Errorf("subscribeIssue: %v", err)
+		}
+	}
+
+	wasClosed := issue.IsClosed
+	if err = issue.changeStatus(sess, doer, repo, true, ISSUE_CLOSE_REASON_DUPLICATE); err != nil {
+		return fmt.Errorf("changeStatus: %v", err)
+	}
+
+	if err = sess.Commit(); err != nil {
+		return fmt.Errorf("Commit: %v", err)
+	}
+
+	if !wasClosed {
+		if err = issue.prepareStatusWebhooks(doer, repo, true); err != nil {
+			log.Error("prepareStatusWebhooks [issue_id: %d]: %v", issue.ID, err)
+		}
+	}
+	return nil
+}
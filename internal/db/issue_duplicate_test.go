@@ -0,0 +1,29 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_titleTerms(t *testing.T) {
+	Convey("Extract significant terms of titles", t, func() {
+		So(titleTerms("The editor crashes when saving files!"), ShouldResemble, []string{"editor", "crash", "saving", "file"})
+		So(titleTerms("Crash: crashing, crashed"), ShouldResemble, []string{"crash"})
+		So(titleTerms("a to is"), ShouldBeEmpty)
+	})
+}
+
+func Test_titleSimilarity(t *testing.T) {
+	Convey("Score similarity of titles", t, func() {
+		a := titleTerms("Editor crashes when saving a file")
+		So(titleSimilarity(a, titleTerms("Editor crashed while saving files")), ShouldBeGreaterThanOrEqualTo, LIKELY_DUPLICATE_SCORE)
+		So(titleSimilarity(a, titleTerms("Add dark theme")), ShouldEqual, 0)
+		So(titleSimilarity(a, a), ShouldEqual, 1)
+		So(titleSimilarity(a, nil), ShouldEqual, 0)
+	})
+}
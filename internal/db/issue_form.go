@@ -0,0 +1,501 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gogs/git-module"
+	"gopkg.in/yaml.v2"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// ISSUE_FORM_DIR is the directory of YAML files that define issue forms.
+const ISSUE_FORM_DIR = ".gogs/ISSUE_TEMPLATE"
+
+// Types of fields of issue forms.
+const (
+	ISSUE_FORM_FIELD_MARKDOWN   = "markdown"
+	ISSUE_FORM_FIELD_INPUT      = "input"
+	ISSUE_FORM_FIELD_TEXTAREA   = "textarea"
+	ISSUE_FORM_FIELD_DROPDOWN   = "dropdown"
+	ISSUE_FORM_FIELD_CHECKBOXES = "checkboxes"
+)
+
+// IssueFormOption is an option of a dropdown or checkboxes field. Options of
+// dropdowns are written as plain strings in YAML.
+type IssueFormOption struct {
+	Label    string `yaml:"label"`
+	Required bool   `yaml:"required"`
+}
+
+func (o *IssueFormOption) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var label string
+	if err := unmarshal(&label); err == nil {
+		o.Label = label
+		return nil
+	}
+	type plain IssueFormOption
+	return unmarshal((*plain)(o))
+}
+
+// IssueFormField is a field of an issue form.
+type IssueFormField struct {
+	Type       string `yaml:"type"`
+	ID         string `yaml:"id"`
+	Attributes struct {
+		Label       string            `yaml:"label"`
+		Description string            `yaml:"description"`
+		Placeholder string            `yaml:"placeholder"`
+		Value       string            `yaml:"value"`
+		Options     []IssueFormOption `yaml:"options"`
+		Multiple    bool              `yaml:"multiple"`
+		// Render is the language of the code block the value of textarea is
+		// wrapped in, e.g. "shell" for logs.
+		Render string `yaml:"render"`
+	} `yaml:"attributes"`
+	Validations struct {
+		Required bool   `yaml:"required"`
+		Regex    string `yaml:"regex"`
+	} `yaml:"validations"`
+
+	RenderedValue string `yaml:"-"`
+}
+
+// IsInput returns true if the field accepts values, i.e. not a markdown text.
+func (f *IssueFormField) IsInput() bool {
+	return f.Type != ISSUE_FORM_FIELD_MARKDOWN
+}
+
+// IssueForm is an issue template defined in YAML, which is rendered as a form
+// with typed fields and serialized into Markdown on submission.
+type IssueForm struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	Title       string            `yaml:"title"` // Prefix of titles
+	Labels      []string          `yaml:"labels"`
+	Assignees   []string          `yaml:"assignees"`
+	Body        []*IssueFormField `yaml:"body"`
+
+	Filename string   `yaml:"-"`
+	Raw      string   `yaml:"-"`
+	Errors   []string `yaml:"-"` // The form cannot be used unless empty
+}
+
+// IsValid returns true if the form has no schema error.
+func (f *IssueForm) IsValid() bool {
+	return len(f.Errors) == 0
+}
+
+var issueFormFieldIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// validate checks the form against the schema and records errors, fields
+// without IDs are given ones by their positions.
+func (f *IssueForm) validate() {
+	addErr := func(format string, args ...interface{}) {
+		f.Errors = append(f.Errors, fmt.Sprintf(format, args...))
+	}
+
+	if strings.TrimSpace(f.Name) == "" {
+		addErr("name is required")
+	}
+	if len(f.Body) == 0 {
+		addErr("body must have at least one field")
+	}
+
+	ids := make(map[string]bool, len(f.Body))
+	hasInput := false
+	for i, field := range f.Body {
+		if field == nil {
+			addErr("body[%d]: field is empty", i)
+			continue
+		}
+		if field.ID == "" {
+			field.ID = fmt.Sprintf("field-%d", i)
+		} else if !issueFormFieldIDPattern.MatchString(field.ID) {
+			addErr("body[%d]: id %q can only contain alphanumeric characters, '-' and '_'", i, field.ID)
+		}
+		if ids[field.ID] {
+			addErr("body[%d]: id %q is used by another field", i, field.ID)
+		}
+		ids[field.ID] = true
+
+		switch field.Type {
+		case ISSUE_FORM_FIELD_MARKDOWN:
+			if strings.TrimSpace(field.Attributes.Value) == "" {
+				addErr("body[%d]: markdown must have attributes.value", i)
+			}
+			continue
+		case ISSUE_FORM_FIELD_INPUT, ISSUE_FORM_FIELD_TEXTAREA:
+			if field.Validations.Regex != "" {
+				if _, err := regexp.Compile(field.Validations.Regex); err != nil {
+					addErr("body[%d]: validations.regex is invalid: %v", i, err)
+				}
+			}
+		case ISSUE_FORM_FIELD_DROPDOWN, ISSUE_FORM_FIELD_CHECKBOXES:
+			if len(field.Attributes.Options) == 0 {
+				addErr("body[%d]: %s must have attributes.options", i, field.Type)
+			}
+			labels := make(map[string]bool, len(field.Attributes.Options))
+			for j, o := range field.Attributes.Options {
+				if strings.TrimSpace(o.Label) == "" {
+					addErr("body[%d]: attributes.options[%d] must have a label", i, j)
+				} else if labels[o.Label] {
+					addErr("body[%d]: attributes.options[%d] duplicates %q", i, j, o.Label)
+				}
+				labels[o.Label] = true
+			}
+		default:
+			addErr("body[%d]: type %q is not one of markdown, input, textarea, dropdown and checkboxes", i, field.Type)
+			continue
+		}
+
+		hasInput = true
+		if strings.TrimSpace(field.Attributes.Label) == "" {
+			addErr("body[%d]: %s must have attributes.label", i, field.Type)
+		}
+	}
+	if len(f.Body) > 0 && !hasInput {
+		addErr("body must have at least one field other than markdown")
+	}
+}
+
+// ParseIssueForm parses and validates the issue form. Errors are recorded in
+// the returned form instead of being returned, so the raw YAML can be shown.
+func ParseIssueForm(filename string, data []byte) *IssueForm {
+	f := new(IssueForm)
+	if err := yaml.UnmarshalStrict(data, f); err != nil {
+		f = &IssueForm{
+			Errors: []string{err.Error()},
+		}
+	} else {
+		f.validate()
+	}
+	f.Filename = filename
+	f.Raw = string(data)
+	if f.Name == "" {
+		f.Name = filename
+	}
+	return f
+}
+
+// IssueForms returns issue forms in ISSUE_FORM_DIR of the commit in the order
+// of file names, including invalid ones.
+func IssueForms(commit *git.Commit) ([]*IssueForm, error) {
+	tree, err := commit.SubTree(ISSUE_FORM_DIR)
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("SubTree: %v", err)
+	}
+	entries, err := tree.ListEntries()
+	if err != nil {
+		return nil, fmt.Errorf("ListEntries: %v", err)
+	}
+
+	forms := make([]*IssueForm, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch path.Ext(e.Name()) {
+		case ".yml", ".yaml":
+		default:
+			continue
+		}
+
+		data, err := readBlob(commit, path.Join(ISSUE_FORM_DIR, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("readBlob [%s]: %v", e.Name(), err)
+		}
+		forms = append(forms, ParseIssueForm(e.Name(), data))
+	}
+	sort.Slice(forms, func(i, j int) bool {
+		return forms[i].Filename < forms[j].Filename
+	})
+	return forms, nil
+}
+
+// GetIssueForm returns the issue form with given file name in the commit.
+func GetIssueForm(commit *git.Commit, filename string) (*IssueForm, error) {
+	forms, err := IssueForms(commit)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range forms {
+		if f.Filename == filename {
+			return f, nil
+		}
+	}
+	return nil, ErrIssueFormNotExist{Filename: filename}
+}
+
+// PrefixTitle prepends the title prefix of the form to the title unless it is
+// already there.
+func (f *IssueForm) PrefixTitle(title string) string {
+	if f.Title == "" || strings.HasPrefix(title, f.Title) {
+		return title
+	}
+	return f.Title + title
+}
+
+// LabelIDs returns IDs of labels of the repository named by the form, names
+// are case-insensitive and unknown ones are ignored.
+func (f *IssueForm) LabelIDs(repoID int64) ([]int64, error) {
+	if len(f.Labels) == 0 {
+		return nil, nil
+	}
+
+	labels, err := GetLabelsByRepoID(repoID)
+	if err != nil {
+		return nil, fmt.Errorf("GetLabelsByRepoID: %v", err)
+	}
+	ids := make([]int64, 0, len(f.Labels))
+	for _, name := range f.Labels {
+		for _, l := range labels {
+			if strings.EqualFold(l.Name, name) {
+				ids = append(ids, l.ID)
+				break
+			}
+		}
+	}
+	return ids, nil
+}
+
+// AssigneeID returns the ID of the first user named by the form who can be
+// assigned to issues of the repository, or 0 if there is none.
+func (f *IssueForm) AssigneeID(repo *Repository) (int64, error) {
+	for _, name := range f.Assignees {
+		u, err := GetUserByName(name)
+		if err != nil {
+			if errors.IsUserNotExist(err) {
+				continue
+			}
+			return 0, fmt.Errorf("GetUserByName [%s]: %v", name, err)
+		}
+
+		if _, err = repo.GetAssigneeByID(u.ID); err != nil {
+			if errors.IsUserNotExist(err) {
+				continue
+			}
+			return 0, fmt.Errorf("GetAssigneeByID [%d]: %v", u.ID, err)
+		}
+		return u.ID, nil
+	}
+	return 0, nil
+}
+
+// Apply sets the title and content of the issue by the form and values, as
+// well as the assignee if the issue has none. It returns label IDs with labels
+// of the form added.
+func (f *IssueForm) Apply(repo *Repository, issue *Issue, labelIDs []int64, values IssueFormValues) ([]int64, error) {
+	issue.Title = f.PrefixTitle(issue.Title)
+	issue.Content = f.RenderBody(values)
+
+	if issue.AssigneeID == 0 {
+		assigneeID, err := f.AssigneeID(repo)
+		if err != nil {
+			return nil, err
+		}
+		issue.AssigneeID = assigneeID
+	}
+
+	formLabelIDs, err := f.LabelIDs(repo.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range formLabelIDs {
+		found := false
+		for _, labelID := range labelIDs {
+			if labelID == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			labelIDs = append(labelIDs, id)
+		}
+	}
+	return labelIDs, nil
+}
+
+// IssueFormValues are values submitted for fields of an issue form, keyed by
+// field IDs. Values of checkboxes and dropdowns are labels of selected options.
+type IssueFormValues map[string][]string
+
+// UnmarshalJSON accepts either a string or an array of strings for each field.
+func (v *IssueFormValues) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	values := make(IssueFormValues, len(raw))
+	for id, msg := range raw {
+		var s string
+		if err := json.Unmarshal(msg, &s); err == nil {
+			values[id] = []string{s}
+			continue
+		}
+		var ss []string
+		if err := json.Unmarshal(msg, &ss); err != nil {
+			return fmt.Errorf("value of field %q must be a string or an array of strings", id)
+		}
+		values[id] = ss
+	}
+	*v = values
+	return nil
+}
+
+// Get returns the first value of the field.
+func (v IssueFormValues) Get(id string) string {
+	if len(v[id]) == 0 {
+		return ""
+	}
+	return v[id][0]
+}
+
+// Has returns true if the option is selected for the field.
+func (v IssueFormValues) Has(id, label string) bool {
+	for _, value := range v[id] {
+		if value == label {
+			return true
+		}
+	}
+	return false
+}
+
+// IssueFormValueError is a field whose value is rejected.
+type IssueFormValueError struct {
+	Label string
+	// IsMissing indicates the value is required but missing, otherwise the
+	// value is not valid.
+	IsMissing bool
+}
+
+func (err IssueFormValueError) Error() string {
+	if err.IsMissing {
+		return fmt.Sprintf("%q is required", err.Label)
+	}
+	return fmt.Sprintf("%q is not valid", err.Label)
+}
+
+// ValidateValues returns errors of values that do not satisfy validations of
+// the fields.
+func (f *IssueForm) ValidateValues(values IssueFormValues) []IssueFormValueError {
+	var errs []IssueFormValueError
+	for _, field := range f.Body {
+		if !field.IsInput() {
+			continue
+		}
+
+		label := field.Attributes.Label
+		switch field.Type {
+		case ISSUE_FORM_FIELD_INPUT, ISSUE_FORM_FIELD_TEXTAREA:
+			value := strings.TrimSpace(values.Get(field.ID))
+			if value == "" {
+				if field.Validations.Required {
+					errs = append(errs, IssueFormValueError{Label: label, IsMissing: true})
+				}
+				continue
+			}
+			if field.Validations.Regex != "" {
+				if matched, _ := regexp.MatchString(field.Validations.Regex, value); !matched {
+					errs = append(errs, IssueFormValueError{Label: label})
+				}
+			}
+
+		case ISSUE_FORM_FIELD_DROPDOWN, ISSUE_FORM_FIELD_CHECKBOXES:
+			selected := values[field.ID]
+			valid := field.Type == ISSUE_FORM_FIELD_CHECKBOXES || field.Attributes.Multiple || len(selected) <= 1
+			for _, value := range selected {
+				found := false
+				for _, o := range field.Attributes.Options {
+					if o.Label == value {
+						found = true
+						break
+					}
+				}
+				valid = valid && found
+			}
+			if !valid {
+				errs = append(errs, IssueFormValueError{Label: label})
+				continue
+			}
+
+			missing := field.Type == ISSUE_FORM_FIELD_DROPDOWN && field.Validations.Required && len(selected) == 0
+			for _, o := range field.Attributes.Options {
+				if field.Type == ISSUE_FORM_FIELD_CHECKBOXES && o.Required && !values.Has(field.ID, o.Label) {
+					missing = true
+				}
+			}
+			if missing {
+				errs = append(errs, IssueFormValueError{Label: label, IsMissing: true})
+			}
+		}
+	}
+	return errs
+}
+
+// RenderBody serializes values into Markdown, with a heading for each field.
+// Values are assumed to be validated.
+func (f *IssueForm) RenderBody(values IssueFormValues) string {
+	const noResponse = "_No response_"
+
+	var buf strings.Builder
+	for _, field := range f.Body {
+		if !field.IsInput() {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "### %s\n\n", field.Attributes.Label)
+		switch field.Type {
+		case ISSUE_FORM_FIELD_INPUT:
+			value := strings.TrimSpace(values.Get(field.ID))
+			if value == "" {
+				value = noResponse
+			}
+			buf.WriteString(value)
+
+		case ISSUE_FORM_FIELD_TEXTAREA:
+			value := strings.TrimSpace(strings.Replace(values.Get(field.ID), "\r\n", "\n", -1))
+			if value == "" {
+				buf.WriteString(noResponse)
+			} else if field.Attributes.Render != "" {
+				fmt.Fprintf(&buf, "```%s\n%s\n```", field.Attributes.Render, value)
+			} else {
+				buf.WriteString(value)
+			}
+
+		case ISSUE_FORM_FIELD_DROPDOWN:
+			if len(values[field.ID]) == 0 {
+				buf.WriteString(noResponse)
+			} else {
+				buf.WriteString(strings.Join(values[field.ID], ", "))
+			}
+
+		case ISSUE_FORM_FIELD_CHECKBOXES:
+			for i, o := range field.Attributes.Options {
+				if i > 0 {
+					buf.WriteString("\n")
+				}
+				mark := " "
+				if values.Has(field.ID, o.Label) {
+					mark = "x"
+				}
+				fmt.Fprintf(&buf, "- [%s] %s", mark, o.Label)
+			}
+		}
+		buf.WriteString("\n\n")
+	}
+	return strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,164 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testIssueForm = `name: Bug report
+description: File a bug report
+title: "[Bug]: "
+labels: [bug]
+assignees: [alice]
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time!
+  - type: input
+    id: version
+    attributes:
+      label: Version
+      placeholder: v1.0.0
+    validations:
+      required: true
+      regex: ^v\d+
+  - type: textarea
+    id: logs
+    attributes:
+      label: Logs
+      render: shell
+  - type: dropdown
+    id: os
+    attributes:
+      label: Operating system
+      options: [Linux, macOS, Windows]
+  - type: checkboxes
+    attributes:
+      label: Code of conduct
+      options:
+        - label: I agree to follow the code of conduct
+          required: true
+        - label: I have searched existing issues
+`
+
+func Test_ParseIssueForm(t *testing.T) {
+	Convey("Parse a valid issue form", t, func() {
+		f := ParseIssueForm("bug.yml", []byte(testIssueForm))
+		So(f.Errors, ShouldBeEmpty)
+		So(f.IsValid(), ShouldBeTrue)
+		So(f.Name, ShouldEqual, "Bug report")
+		So(f.Title, ShouldEqual, "[Bug]: ")
+		So(f.Labels, ShouldResemble, []string{"bug"})
+		So(f.Body, ShouldHaveLength, 5)
+		So(f.Body[3].Attributes.Options[1].Label, ShouldEqual, "macOS")
+		So(f.Body[4].ID, ShouldEqual, "field-4")
+		So(f.Body[4].Attributes.Options[0].Required, ShouldBeTrue)
+	})
+
+	Convey("Report schema errors", t, func() {
+		f := ParseIssueForm("bad.yml", []byte(`description: no name
+body:
+  - type: select
+    id: a
+  - type: input
+    id: a
+    validations:
+      regex: "("
+  - type: dropdown
+    id: "b c"
+    attributes:
+      label: B
+`))
+		So(f.IsValid(), ShouldBeFalse)
+		So(f.Name, ShouldEqual, "bad.yml")
+		So(f.Errors, ShouldResemble, []string{
+			"name is required",
+			`body[0]: type "select" is not one of markdown, input, textarea, dropdown and checkboxes`,
+			`body[1]: id "a" is used by another field`,
+			"body[1]: validations.regex is invalid: error parsing regexp: missing closing ): `(`",
+			"body[1]: input must have attributes.label",
+			`body[2]: id "b c" can only contain alphanumeric characters, '-' and '_'`,
+			"body[2]: dropdown must have attributes.options",
+		})
+	})
+
+	Convey("Keep raw YAML when parsing fails", t, func() {
+		f := ParseIssueForm("broken.yml", []byte("name: [broken"))
+		So(f.IsValid(), ShouldBeFalse)
+		So(f.Name, ShouldEqual, "broken.yml")
+		So(f.Raw, ShouldEqual, "name: [broken")
+
+		f = ParseIssueForm("unknown.yml", []byte("name: A\nfoo: bar\nbody: []"))
+		So(f.IsValid(), ShouldBeFalse)
+	})
+}
+
+func TestIssueForm_ValidateValues(t *testing.T) {
+	f := ParseIssueForm("bug.yml", []byte(testIssueForm))
+
+	Convey("Validate values of fields", t, func() {
+		So(f.ValidateValues(IssueFormValues{
+			"version": {"v1.2"},
+			"os":      {"Linux"},
+			"field-4": {"I agree to follow the code of conduct"},
+		}), ShouldBeEmpty)
+
+		So(f.ValidateValues(IssueFormValues{}), ShouldResemble, []IssueFormValueError{
+			{Label: "Version", IsMissing: true},
+			{Label: "Code of conduct", IsMissing: true},
+		})
+
+		So(f.ValidateValues(IssueFormValues{
+			"version": {"1.2"},
+			"os":      {"Linux", "macOS"},
+			"field-4": {"I agree to follow the code of conduct", "Unknown"},
+		}), ShouldResemble, []IssueFormValueError{
+			{Label: "Version"},
+			{Label: "Operating system"},
+			{Label: "Code of conduct"},
+		})
+	})
+}
+
+func TestIssueForm_RenderBody(t *testing.T) {
+	f := ParseIssueForm("bug.yml", []byte(testIssueForm))
+
+	Convey("Render values into Markdown", t, func() {
+		So(f.RenderBody(IssueFormValues{
+			"version": {"v1.2"},
+			"logs":    {"line 1\r\nline 2\r\n"},
+			"field-4": {"I agree to follow the code of conduct"},
+		}), ShouldEqual, "### Version\n\nv1.2\n\n"+
+			"### Logs\n\n```shell\nline 1\nline 2\n```\n\n"+
+			"### Operating system\n\n_No response_\n\n"+
+			"### Code of conduct\n\n- [x] I agree to follow the code of conduct\n- [ ] I have searched existing issues")
+	})
+}
+
+func TestIssueForm_PrefixTitle(t *testing.T) {
+	f := ParseIssueForm("bug.yml", []byte(testIssueForm))
+
+	Convey("Prepend the title prefix once", t, func() {
+		So(f.PrefixTitle("crash on start"), ShouldEqual, "[Bug]: crash on start")
+		So(f.PrefixTitle("[Bug]: crash on start"), ShouldEqual, "[Bug]: crash on start")
+	})
+}
+
+func TestIssueFormValues_UnmarshalJSON(t *testing.T) {
+	Convey("Unmarshal strings and arrays of strings", t, func() {
+		var values IssueFormValues
+		So(json.Unmarshal([]byte(`{"version":"v1.2","os":["Linux","macOS"]}`), &values), ShouldBeNil)
+		So(values, ShouldResemble, IssueFormValues{
+			"version": {"v1.2"},
+			"os":      {"Linux", "macOS"},
+		})
+
+		So(json.Unmarshal([]byte(`{"version":1}`), &values), ShouldNotBeNil)
+	})
+}
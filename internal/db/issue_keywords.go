@@ -0,0 +1,133 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// issueKeywordsPatterns caches compiled patterns by their keywords.
+var issueKeywordsPatterns sync.Map
+
+// issueKeywordsPattern returns the pattern that matches issue references after
+// any of the keywords, e.g. "Closes #12" and "fixes owner/repo#12". The first
+// capturing group is the reference.
+func issueKeywordsPattern(keywords []string) *regexp.Regexp {
+	key := strings.Join(keywords, ",")
+	if pat, ok := issueKeywordsPatterns.Load(key); ok {
+		return pat.(*regexp.Regexp)
+	}
+
+	words := make([]string, 0, len(keywords))
+	for _, word := range keywords {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, regexp.QuoteMeta(word))
+		}
+	}
+	if len(words) == 0 {
+		// Matches nothing.
+		words = append(words, `[^\s\S]`)
+	}
+	pat := regexp.MustCompile(fmt.Sprintf(`(?i)(?:^|[^\w/])(?:%s):?[ \t]+((?:[\w.-]+/[\w.-]+)?#\d+)\b`, strings.Join(words, "|")))
+	issueKeywordsPatterns.Store(key, pat)
+	return pat
+}
+
+// issueKeywordRefs returns unique references of issues after any of the
+// keywords in the message. References to issues of the repository itself are
+// prefixed with its full name, e.g. "#12" becomes "owner/repo#12".
+func issueKeywordRefs(repoFullName, message string, keywords []string) []string {
+	var refs []string
+	seen := make(map[string]bool)
+	for _, match := range issueKeywordsPattern(keywords).FindAllStringSubmatch(message, -1) {
+		ref := match[1]
+		if ref[0] == '#' {
+			ref = repoFullName + ref
+		}
+		if key := strings.ToLower(ref); !seen[key] {
+			seen[key] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// ClosesIssues returns indexes of existing issues of the repository that the
+// commit closes by keywords of "[repository.issue] CLOSE_KEYWORDS" in its
+// message, e.g. "Closes #12" or "Fixes owner/repo#12" with the full name of
+// the repository.
+func (repo *Repository) ClosesIssues(commit *git.Commit) ([]int64, error) {
+	if !repo.HasInternalIssueTracker() {
+		return nil, nil
+	}
+
+	fullName := repo.FullName()
+	var indexes []int64
+	for _, ref := range issueKeywordRefs(fullName, commit.Message(), conf.Repository.Issue.CloseKeywords) {
+		n := strings.IndexByte(ref, '#')
+		if !strings.EqualFold(ref[:n], fullName) {
+			continue
+		}
+
+		index, err := strconv.ParseInt(ref[n+1:], 10, 64)
+		if err != nil {
+			continue
+		}
+		if _, err = GetRawIssueByIndex(repo.ID, index); err != nil {
+			if errors.IsIssueNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("GetRawIssueByIndex [%d]: %v", index, err)
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// changeIssuesStatusByKeywords changes status of issues referenced after any
+// of the keywords in the commit message. Issues of other repositories are only
+// changed when the doer has write access to them. Issues that have been marked
+// are skipped. Issues are closed as completed.
+func changeIssuesStatusByKeywords(doer *User, repo *Repository, c *PushCommit, keywords []string, isClosed bool, marked map[int64]bool) error {
+	for _, ref := range issueKeywordRefs(repo.FullName(), c.Message, keywords) {
+		issue, err := GetIssueByRef(ref)
+		if err != nil {
+			if errors.IsIssueNotExist(err) || errors.IsRepoNotExist(err) ||
+				errors.IsUserNotExist(err) || errors.IsInvalidRepoReference(err) {
+				continue
+			}
+			return err
+		} else if !issue.Repo.HasInternalIssueTracker() || marked[issue.ID] {
+			continue
+		}
+		marked[issue.ID] = true
+
+		if issue.IsClosed == isClosed {
+			continue
+		}
+		if issue.RepoID != repo.ID {
+			canWrite, err := HasAccess(doer.ID, issue.Repo, ACCESS_MODE_WRITE)
+			if err != nil {
+				return fmt.Errorf("HasAccess: %v", err)
+			} else if !canWrite {
+				continue
+			}
+		}
+
+		if err = issue.ChangeStatus(doer, issue.Repo, isClosed, ISSUE_CLOSE_REASON_COMPLETED); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_issueKeywordRefs(t *testing.T) {
+	keywords := []string{"close", "closes", "fixes", "resolves"}
+
+	Convey("Find references after keywords", t, func() {
+		So(issueKeywordRefs("owner/repo", "Closes #12", keywords), ShouldResemble, []string{"owner/repo#12"})
+		So(issueKeywordRefs("owner/repo", "Fix crash\n\nfixes: #1, resolves other/lib#3.\nCloses #1", keywords), ShouldResemble,
+			[]string{"owner/repo#1", "other/lib#3"})
+		So(issueKeywordRefs("owner/repo", "FIXES #7 and close Owner/Repo#8", keywords), ShouldResemble,
+			[]string{"owner/repo#7", "Owner/Repo#8"})
+	})
+
+	Convey("Skip references without keywords", t, func() {
+		So(issueKeywordRefs("owner/repo", "See #12", keywords), ShouldBeEmpty)
+		So(issueKeywordRefs("owner/repo", "Discloses #12", keywords), ShouldBeEmpty)
+		So(issueKeywordRefs("owner/repo", "Closes #12abc", keywords), ShouldBeEmpty)
+		So(issueKeywordRefs("owner/repo", "Closes #12", nil), ShouldBeEmpty)
+	})
+
+	Convey("Use custom keywords", t, func() {
+		So(issueKeywordRefs("owner/repo", "Implements #5 and closes #6", []string{"implements"}), ShouldResemble, []string{"owner/repo#5"})
+	})
+}
@@ -0,0 +1,239 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// Reasons of locking issues.
+const (
+	ISSUE_LOCK_REASON_OFF_TOPIC  = "off-topic"
+	ISSUE_LOCK_REASON_TOO_HEATED = "too heated"
+	ISSUE_LOCK_REASON_RESOLVED   = "resolved"
+	ISSUE_LOCK_REASON_SPAM       = "spam"
+)
+
+// IsValidIssueLockReason returns true if the reason is empty or known.
+func IsValidIssueLockReason(reason string) bool {
+	switch reason {
+	case "", ISSUE_LOCK_REASON_OFF_TOPIC, ISSUE_LOCK_REASON_TOO_HEATED, ISSUE_LOCK_REASON_RESOLVED, ISSUE_LOCK_REASON_SPAM:
+		return true
+	}
+	return false
+}
+
+// Lock locks the issue so only repository writers can comment on it.
+func (issue *Issue) Lock(reason string) error {
+	issue.IsLocked = true
+	issue.LockReason = reason
+	issue.LockedUnix = time.Now().Unix()
+	_, err := x.ID(issue.ID).Cols("is_locked", "lock_reason", "locked_unix").Update(issue)
+	return err
+}
+
+// Unlock unlocks the issue. The time is recorded so the issue is not locked
+// again automatically until it is closed again.
+func (issue *Issue) Unlock() error {
+	issue.IsLocked = false
+	issue.LockReason = ""
+	issue.UnlockedUnix = time.Now().Unix()
+	_, err := x.ID(issue.ID).Cols("is_locked", "lock_reason", "unlocked_unix").Update(issue)
+	return err
+}
+
+// IssueAutoLock is the setting of a repository to lock issues and pull
+// requests automatically after they have been closed for a number of days.
+type IssueAutoLock struct {
+	ID     int64
+	RepoID int64 `xorm:"UNIQUE"`
+	// AfterDays is the number of days after an issue is closed to lock it.
+	AfterDays int
+	// ExemptLabel is the name of the label that exempts issues from locking,
+	// empty to exempt none.
+	ExemptLabel string
+	// Comment is posted to issues before they are locked, empty to post none.
+	Comment string `xorm:"TEXT"`
+	// DoerID is the user who last updated the setting, who posts the comment.
+	DoerID int64
+	// CursorID is the ID of the last close event that has been processed, so
+	// every run only looks at issues closed since the previous run.
+	CursorID int64
+}
+
+// ValidateIssueAutoLock returns an error if the setting is invalid.
+func ValidateIssueAutoLock(l *IssueAutoLock) error {
+	if l.AfterDays < 1 {
+		return ErrInvalidIssueAutoLock{AfterDays: l.AfterDays}
+	}
+	return nil
+}
+
+// GetIssueAutoLock returns the issue auto-lock setting of the repository.
+func GetIssueAutoLock(repoID int64) (*IssueAutoLock, error) {
+	l := &IssueAutoLock{RepoID: repoID}
+	has, err := x.Get(l)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueAutoLockNotExist{RepoID: repoID}
+	}
+	return l, nil
+}
+
+// UpdateIssueAutoLock creates or updates the issue auto-lock setting. The
+// cursor is kept, so issues that have been processed are not processed again.
+func UpdateIssueAutoLock(l *IssueAutoLock) error {
+	if err := ValidateIssueAutoLock(l); err != nil {
+		return err
+	}
+
+	existing, err := GetIssueAutoLock(l.RepoID)
+	if err != nil {
+		if !IsErrIssueAutoLockNotExist(err) {
+			return err
+		}
+		_, err = x.Insert(l)
+		return err
+	}
+
+	l.ID = existing.ID
+	l.CursorID = existing.CursorID
+	_, err = x.ID(l.ID).AllCols().Update(l)
+	return err
+}
+
+// DeleteIssueAutoLock deletes the issue auto-lock setting of the repository.
+func DeleteIssueAutoLock(repoID int64) error {
+	_, err := x.Delete(&IssueAutoLock{RepoID: repoID})
+	return err
+}
+
+// issueAutoLockBatchSize is the number of close events processed at a time.
+const issueAutoLockBatchSize = 100
+
+// shouldAutoLock returns true if the issue closed by the close event should be
+// locked according to the setting.
+func shouldAutoLock(issue *Issue, closed *Comment, setting *IssueAutoLock) (bool, error) {
+	// Issues that are unlocked after being closed stay unlocked.
+	if !issue.IsClosed || issue.IsLocked || issue.UnlockedUnix >= closed.CreatedUnix {
+		return false, nil
+	}
+
+	// The issue has been reopened since, a later close event locks it if any.
+	later, err := x.Where("issue_id = ? AND id > ?", issue.ID, closed.ID).
+		In("type", COMMENT_TYPE_CLOSE, COMMENT_TYPE_REOPEN).Count(new(Comment))
+	if err != nil {
+		return false, fmt.Errorf("count status comments: %v", err)
+	} else if later > 0 {
+		return false, nil
+	}
+
+	if setting.ExemptLabel != "" {
+		labels, err := getLabelsByIssueID(x, issue.ID)
+		if err != nil {
+			return false, fmt.Errorf("getLabelsByIssueID: %v", err)
+		}
+		for _, l := range labels {
+			if strings.EqualFold(l.Name, setting.ExemptLabel) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// autoLockIssue posts the comment of the setting, if any, and locks the issue.
+func autoLockIssue(repo *Repository, issue *Issue, setting *IssueAutoLock) error {
+	if setting.Comment != "" {
+		doer, err := GetUserByID(setting.DoerID)
+		if err != nil {
+			if !errors.IsUserNotExist(err) {
+				return fmt.Errorf("GetUserByID [%d]: %v", setting.DoerID, err)
+			}
+		} else if _, err = CreateIssueComment(doer, repo, issue, setting.Comment, nil); err != nil {
+			return fmt.Errorf("CreateIssueComment: %v", err)
+		}
+	}
+	return issue.Lock(ISSUE_LOCK_REASON_RESOLVED)
+}
+
+// autoLockIssuesOfRepo locks issues of the repository that have been closed
+// long enough, starting from the cursor of the setting.
+func autoLockIssuesOfRepo(setting *IssueAutoLock, now time.Time) error {
+	repo, err := GetRepositoryByID(setting.RepoID)
+	if err != nil {
+		return fmt.Errorf("GetRepositoryByID: %v", err)
+	}
+
+	deadline := now.Add(-time.Duration(setting.AfterDays) * 24 * time.Hour).Unix()
+	for {
+		events := make([]*Comment, 0, issueAutoLockBatchSize)
+		if err = x.Join("INNER", "issue", "issue.id = comment.issue_id").
+			Where("issue.repo_id = ? AND comment.type = ? AND comment.id > ? AND comment.created_unix <= ?",
+				repo.ID, COMMENT_TYPE_CLOSE, setting.CursorID, deadline).
+			Asc("comment.id").Limit(issueAutoLockBatchSize).Find(&events); err != nil {
+			return fmt.Errorf("find close events: %v", err)
+		} else if len(events) == 0 {
+			return nil
+		}
+
+		for _, e := range events {
+			issue, err := getRawIssueByID(x, e.IssueID)
+			if err != nil {
+				log.Error("Failed to get issue [%d]: %v", e.IssueID, err)
+				continue
+			}
+			lock, err := shouldAutoLock(issue, e, setting)
+			if err != nil {
+				log.Error("Failed to check issue to lock [%d]: %v", issue.ID, err)
+				continue
+			} else if lock {
+				if err = autoLockIssue(repo, issue, setting); err != nil {
+					log.Error("Failed to lock issue [%d]: %v", issue.ID, err)
+				}
+			}
+		}
+
+		setting.CursorID = events[len(events)-1].ID
+		if _, err = x.ID(setting.ID).Cols("cursor_id").Update(setting); err != nil {
+			return fmt.Errorf("update cursor: %v", err)
+		}
+		if len(events) < issueAutoLockBatchSize {
+			return nil
+		}
+	}
+}
+
+// AutoLockIssues locks issues and pull requests that have been closed for the
+// number of days configured by their repositories.
+func AutoLockIssues() {
+	if taskStatusTable.IsRunning(_ISSUE_AUTO_LOCK) {
+		return
+	}
+	taskStatusTable.Start(_ISSUE_AUTO_LOCK)
+	defer taskStatusTable.Stop(_ISSUE_AUTO_LOCK)
+
+	log.Trace("Doing: AutoLockIssues")
+
+	settings := make([]*IssueAutoLock, 0, 10)
+	if err := x.Find(&settings); err != nil {
+		log.Error("Failed to find issue auto-locks: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, s := range settings {
+		if err := autoLockIssuesOfRepo(s, now); err != nil {
+			log.Error("Failed to lock issues [repo_id: %d]: %v", s.RepoID, err)
+		}
+	}
+}
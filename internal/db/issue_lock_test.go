@@ -0,0 +1,102 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_IsValidIssueLockReason(t *testing.T) {
+	Convey("Validate reasons of locking issues", t, func() {
+		So(IsValidIssueLockReason(""), ShouldBeTrue)
+		So(IsValidIssueLockReason(ISSUE_LOCK_REASON_RESOLVED), ShouldBeTrue)
+		So(IsValidIssueLockReason(ISSUE_LOCK_REASON_TOO_HEATED), ShouldBeTrue)
+		So(IsValidIssueLockReason("Resolved"), ShouldBeFalse)
+		So(IsValidIssueLockReason("<b>spam</b>"), ShouldBeFalse)
+	})
+}
+
+func Test_ValidateIssueAutoLock(t *testing.T) {
+	Convey("Validate issue auto-lock settings", t, func() {
+		So(ValidateIssueAutoLock(&IssueAutoLock{AfterDays: 30}), ShouldBeNil)
+		So(IsErrInvalidIssueAutoLock(ValidateIssueAutoLock(&IssueAutoLock{AfterDays: 0})), ShouldBeTrue)
+		So(IsErrInvalidIssueAutoLock(ValidateIssueAutoLock(&IssueAutoLock{AfterDays: -1})), ShouldBeTrue)
+	})
+}
+
+func Test_autoLockIssuesOfRepo(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "alice", LowerName: "alice"}
+	insertTestBeans(t, owner)
+	repo := &Repository{OwnerID: owner.ID, Name: "proj", LowerName: "proj"}
+	insertTestBeans(t, repo)
+	label := &Label{RepoID: repo.ID, Name: "Keep-Open"}
+	insertTestBeans(t, label)
+
+	now := time.Now()
+	daysAgo := func(days int) int64 {
+		return now.Add(-time.Duration(days) * 24 * time.Hour).Unix()
+	}
+	// newIssue creates a closed issue with status events at given times.
+	var index int64
+	newIssue := func(title string, events ...CommentType) *Issue {
+		index++
+		issue := &Issue{RepoID: repo.ID, Index: index, Title: title, IsClosed: events[len(events)-1] == COMMENT_TYPE_CLOSE}
+		insertTestBeans(t, issue)
+		for i, typ := range events {
+			c := &Comment{Type: typ, IssueID: issue.ID}
+			insertTestBeans(t, c)
+			if _, err := x.Exec("UPDATE comment SET created_unix = ? WHERE id = ?", daysAgo(40-i), c.ID); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return issue
+	}
+
+	stale := newIssue("stale", COMMENT_TYPE_CLOSE)
+	exempted := newIssue("exempted", COMMENT_TYPE_CLOSE)
+	insertTestBeans(t, &IssueLabel{IssueID: exempted.ID, LabelID: label.ID})
+	reopened := newIssue("reopened", COMMENT_TYPE_CLOSE, COMMENT_TYPE_REOPEN)
+	unlocked := newIssue("unlocked", COMMENT_TYPE_CLOSE)
+	if _, err := x.Exec("UPDATE issue SET unlocked_unix = ? WHERE id = ?", daysAgo(1), unlocked.ID); err != nil {
+		t.Fatal(err)
+	}
+	recent := newIssue("recent", COMMENT_TYPE_CLOSE)
+	if _, err := x.Exec("UPDATE comment SET created_unix = ? WHERE issue_id = ?", daysAgo(5), recent.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	setting := &IssueAutoLock{RepoID: repo.ID, AfterDays: 30, ExemptLabel: "keep-open"}
+	insertTestBeans(t, setting)
+
+	isLocked := func(issue *Issue) bool {
+		issue, err := getRawIssueByID(x, issue.ID)
+		So(err, ShouldBeNil)
+		return issue.IsLocked
+	}
+
+	Convey("Lock issues closed for the number of days of the setting", t, func() {
+		So(autoLockIssuesOfRepo(setting, now), ShouldBeNil)
+		So(isLocked(stale), ShouldBeTrue)
+		So(isLocked(exempted), ShouldBeFalse)
+		So(isLocked(reopened), ShouldBeFalse)
+		So(isLocked(unlocked), ShouldBeFalse)
+		So(isLocked(recent), ShouldBeFalse)
+
+		Convey("Only look at issues closed since the previous run", func() {
+			cursorID := setting.CursorID
+			So(cursorID, ShouldBeGreaterThan, 0)
+
+			So(autoLockIssuesOfRepo(setting, now.Add(30*24*time.Hour)), ShouldBeNil)
+			So(isLocked(recent), ShouldBeTrue)
+			So(isLocked(unlocked), ShouldBeFalse)
+			So(setting.CursorID, ShouldBeGreaterThan, cursorID)
+		})
+	})
+}
@@ -6,6 +6,7 @@ package db
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/unknwon/com"
 	log "unknwon.dev/clog/v2"
@@ -74,6 +75,10 @@ type mailerIssue struct {
 	issue *Issue
 }
 
+func (this mailerIssue) ID() int64 {
+	return this.issue.ID
+}
+
 func (this mailerIssue) MailSubject() string {
 	return this.issue.MailSubject()
 }
@@ -90,77 +95,119 @@ func NewMailerIssue(issue *Issue) email.Issue {
 	return mailerIssue{issue}
 }
 
-// mailIssueCommentToParticipants can be used for both new issue creation and comment.
-// This functions sends two list of emails:
-// 1. Repository watchers, users who participated in comments and the assignee.
-// 2. Users who are not in 1. but get mentioned in current issue/comment.
-func mailIssueCommentToParticipants(issue *Issue, doer *User, mentions []string) error {
-	if !conf.User.EnableEmailNotification {
-		return nil
-	}
-
+// issueMailRecipients returns users to be notified about the issue:
+// repository watchers, users who participated in comments, the poster and the
+// assignee, excluding the doer. It also returns IDs of users who muted the
+// repository.
+func issueMailRecipients(issue *Issue, doer *User) (_ []*User, muted map[int64]bool, err error) {
 	watchers, err := GetWatchers(issue.RepoID)
 	if err != nil {
-		return fmt.Errorf("GetWatchers [repo_id: %d]: %v", issue.RepoID, err)
+		return nil, nil, fmt.Errorf("GetWatchers [repo_id: %d]: %v", issue.RepoID, err)
 	}
 	participants, err := GetParticipantsByIssueID(issue.ID)
 	if err != nil {
-		return fmt.Errorf("GetParticipantsByIssueID [issue_id: %d]: %v", issue.ID, err)
+		return nil, nil, fmt.Errorf("GetParticipantsByIssueID [issue_id: %d]: %v", issue.ID, err)
+	}
+	muted, err = getMutedUserIDs(x, issue.RepoID, time.Now())
+	if err != nil {
+		return nil, nil, fmt.Errorf("getMutedUserIDs [repo_id: %d]: %v", issue.RepoID, err)
 	}
 
 	// In case the issue poster is not watching the repository,
 	// even if we have duplicated in watchers, can be safely filtered out.
-	if issue.PosterID != doer.ID {
+	if issue.Poster != nil && issue.PosterID != doer.ID {
 		participants = append(participants, issue.Poster)
 	}
+	if issue.Assignee != nil {
+		participants = append(participants, issue.Assignee)
+	}
 
-	tos := make([]string, 0, len(watchers)) // List of email addresses
-	names := make([]string, 0, len(watchers))
+	seen := map[int64]bool{doer.ID: true}
+	users := make([]*User, 0, len(watchers)+len(participants))
 	for i := range watchers {
-		if watchers[i].UserID == doer.ID {
+		if seen[watchers[i].UserID] {
 			continue
 		}
 
 		to, err := GetUserByID(watchers[i].UserID)
 		if err != nil {
-			return fmt.Errorf("GetUserByID [%d]: %v", watchers[i].UserID, err)
+			return nil, nil, fmt.Errorf("GetUserByID [%d]: %v", watchers[i].UserID, err)
 		}
-		if to.IsOrganization() || !to.IsActive {
+		if to.IsOrganization() || !to.IsActive || muted[to.ID] {
 			continue
 		}
 
-		tos = append(tos, to.Email)
-		names = append(names, to.Name)
+		seen[to.ID] = true
+		users = append(users, to)
 	}
-	for i := range participants {
-		if participants[i].ID == doer.ID {
-			continue
-		} else if com.IsSliceContainsStr(names, participants[i].Name) {
+	for _, u := range participants {
+		if seen[u.ID] || muted[u.ID] {
 			continue
 		}
 
-		tos = append(tos, participants[i].Email)
-		names = append(names, participants[i].Name)
+		seen[u.ID] = true
+		users = append(users, u)
 	}
-	if issue.Assignee != nil && issue.Assignee.ID != doer.ID {
-		if !com.IsSliceContainsStr(names, issue.Assignee.Name) {
-			tos = append(tos, issue.Assignee.Email)
-			names = append(names, issue.Assignee.Name)
+	return users, muted, nil
+}
+
+// splitMailRecipients splits email addresses of users by whether they want full
+// content or only links in notification emails. Everyone gets full content
+// when it is generated rather than written by users, e.g. "Closed #1".
+func splitMailRecipients(users []*User, userContent bool) (full, linksOnly []string) {
+	for _, u := range users {
+		if userContent && u.MailLinksOnly {
+			linksOnly = append(linksOnly, u.Email)
+		} else {
+			full = append(full, u.Email)
 		}
 	}
-	email.SendIssueCommentMail(NewMailerIssue(issue), NewMailerRepo(issue.Repo), NewMailerUser(doer), tos)
+	return full, linksOnly
+}
+
+// mailIssueCommentToParticipants can be used for both new issue creation and comment.
+// This functions sends two list of emails:
+// 1. Repository watchers, users who participated in comments and the assignee.
+// 2. Users who are not in 1. but get mentioned in current issue/comment.
+// The comment ID is zero for new issue creation. Users who want only links
+// still get content generated for the event, e.g. for closing the issue.
+func mailIssueCommentToParticipants(issue *Issue, doer *User, mentions []string, commentID int64, userContent bool) error {
+	if !conf.User.EnableEmailNotification {
+		return nil
+	}
+
+	users, muted, err := issueMailRecipients(issue, doer)
+	if err != nil {
+		return err
+	}
+	mailIssue := NewMailerIssue(issue)
+	mailRepo := NewMailerRepo(issue.Repo)
+	mailDoer := NewMailerUser(doer)
+	full, linksOnly := splitMailRecipients(users, userContent)
+	email.SendIssueCommentMail(mailIssue, mailRepo, mailDoer, full, commentID, false)
+	email.SendIssueCommentMail(mailIssue, mailRepo, mailDoer, linksOnly, commentID, true)
 
 	// Mail mentioned people and exclude watchers.
+	names := make([]string, 0, len(users)+1)
+	for _, u := range users {
+		names = append(names, u.Name)
+	}
 	names = append(names, doer.Name)
-	tos = make([]string, 0, len(mentions)) // list of user names.
+	mentioned := make([]*User, 0, len(mentions))
 	for i := range mentions {
 		if com.IsSliceContainsStr(names, mentions[i]) {
 			continue
 		}
 
-		tos = append(tos, mentions[i])
+		u, err := GetUserByName(mentions[i])
+		if err != nil || muted[u.ID] || !u.IsMailable() {
+			continue
+		}
+		mentioned = append(mentioned, u)
 	}
-	email.SendIssueMentionMail(NewMailerIssue(issue), NewMailerRepo(issue.Repo), NewMailerUser(doer), GetUserEmailsByNames(tos))
+	full, linksOnly = splitMailRecipients(mentioned, userContent)
+	email.SendIssueMentionMail(mailIssue, mailRepo, mailDoer, full, commentID, false)
+	email.SendIssueMentionMail(mailIssue, mailRepo, mailDoer, linksOnly, commentID, true)
 	return nil
 }
 
@@ -172,9 +219,36 @@ func (issue *Issue) MailParticipants() (err error) {
 		return fmt.Errorf("UpdateIssueMentions [%d]: %v", issue.ID, err)
 	}
 
-	if err = mailIssueCommentToParticipants(issue, issue.Poster, mentions); err != nil {
+	if err = mailIssueCommentToParticipants(issue, issue.Poster, mentions, 0, true); err != nil {
 		log.Error("mailIssueCommentToParticipants: %v", err)
 	}
 
 	return nil
 }
+
+// mailMerged sends emails to participants of the pull request that it has been
+// merged by the doer.
+func (pr *PullRequest) mailMerged(doer *User) error {
+	if !conf.User.EnableEmailNotification {
+		return nil
+	}
+
+	users, _, err := issueMailRecipients(pr.Issue, doer)
+	if err != nil {
+		return err
+	}
+	tos := make([]string, 0, len(users))
+	for _, u := range users {
+		tos = append(tos, u.Email)
+	}
+	email.SendPullRequestMergeMail(NewMailerIssue(pr.Issue), NewMailerUser(doer), tos, pr.BaseBranch)
+	return nil
+}
+
+// UpdateMailLinksOnly updates whether the user only receives links rather than
+// full content in notification emails of issues and pull requests.
+func (u *User) UpdateMailLinksOnly(linksOnly bool) error {
+	u.MailLinksOnly = linksOnly
+	_, err := x.ID(u.ID).Cols("mail_links_only").Update(u)
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_Repository_IssueCounts(t *testing.T) {
+	Convey("Get stored numbers of issues and pull requests", t, func() {
+		repo := &Repository{
+			NumIssues:       10,
+			NumClosedIssues: 3,
+			NumPulls:        5,
+			NumClosedPulls:  5,
+		}
+
+		numOpen, numClosed := repo.IssueCounts(false)
+		So(numOpen, ShouldEqual, 7)
+		So(numClosed, ShouldEqual, 3)
+
+		numOpen, numClosed = repo.IssueCounts(true)
+		So(numOpen, ShouldEqual, 0)
+		So(numClosed, ShouldEqual, 5)
+	})
+}
+
+func Test_IssueStatsOptions_isUnfiltered(t *testing.T) {
+	Convey("Check if issue stats are filtered", t, func() {
+		So((&IssueStatsOptions{FilterMode: FILTER_MODE_YOUR_REPOS}).isUnfiltered(), ShouldBeTrue)
+		So((&IssueStatsOptions{FilterMode: FILTER_MODE_YOUR_REPOS, Labels: "0"}).isUnfiltered(), ShouldBeTrue)
+		So((&IssueStatsOptions{FilterMode: FILTER_MODE_YOUR_REPOS, Labels: "1,2"}).isUnfiltered(), ShouldBeFalse)
+		So((&IssueStatsOptions{FilterMode: FILTER_MODE_YOUR_REPOS, MilestoneID: 1}).isUnfiltered(), ShouldBeFalse)
+		So((&IssueStatsOptions{FilterMode: FILTER_MODE_YOUR_REPOS, AssigneeID: 1}).isUnfiltered(), ShouldBeFalse)
+		So((&IssueStatsOptions{FilterMode: FILTER_MODE_CREATE}).isUnfiltered(), ShouldBeFalse)
+		So((&IssueStatsOptions{FilterMode: FILTER_MODE_MENTION}).isUnfiltered(), ShouldBeFalse)
+	})
+}
+
+func Test_GetIssueStats_storedNumbers(t *testing.T) {
+	Convey("Unfiltered issue stats use stored numbers of the repository", t, func() {
+		repo := &Repository{
+			ID:              1,
+			NumIssues:       4,
+			NumClosedIssues: 1,
+			NumPulls:        2,
+			NumClosedPulls:  1,
+		}
+		opts := &IssueStatsOptions{
+			RepoID:     repo.ID,
+			Repo:       repo,
+			FilterMode: FILTER_MODE_YOUR_REPOS,
+		}
+		So(GetIssueStats(opts), ShouldResemble, &IssueStats{OpenCount: 3, ClosedCount: 1})
+
+		// Pull requests are counted separately from issues.
+		opts.IsPull = true
+		So(GetIssueStats(opts), ShouldResemble, &IssueStats{OpenCount: 1, ClosedCount: 1})
+	})
+}
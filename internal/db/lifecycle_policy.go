@@ -0,0 +1,595 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	gouuid "github.com/satori/go.uuid"
+	log "unknwon.dev/clog/v2"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/email"
+	"gogs.io/gogs/internal/tool"
+)
+
+type LifecycleType int
+
+const (
+	LIFECYCLE_USER LifecycleType = iota + 1
+	LIFECYCLE_REPO
+)
+
+// Decisions made by lifecycle policies.
+const (
+	LIFECYCLE_NOTIFY     = "notify"     // The owner is notified of inactivity.
+	LIFECYCLE_DEACTIVATE = "deactivate" // The user is prohibited to login.
+	LIFECYCLE_RESET      = "reset"      // Activity is seen after being notified.
+	LIFECYCLE_KEEP       = "keep"       // The "keep active" link is followed.
+)
+
+// lifecycleMonth is the length of a month in inactive periods of policies.
+const lifecycleMonth = 30 * 24 * time.Hour
+
+// LifecyclePolicy is a policy of flagging inactive users or repositories,
+// there is at most one policy of each type.
+type LifecyclePolicy struct {
+	ID             int64
+	Type           LifecycleType `xorm:"UNIQUE"`
+	Enabled        bool
+	InactiveMonths int
+	// GraceDays is the number of days after being notified that inactive users
+	// are deactivated, zero means never. Repositories are only notified.
+	GraceDays int
+	// Exemptions are names exempted from the policy, one per line. User names
+	// and organization names for members of organizations of the user policy,
+	// owner names and full names of repositories of the repository policy.
+	Exemptions string `xorm:"TEXT"`
+	// EnabledUnix is when the policy was enabled, inactivity is not counted
+	// from an earlier time since activity of users is not tracked before.
+	EnabledUnix int64
+}
+
+// GetLifecyclePolicy returns the lifecycle policy of the type, policies that
+// have never been saved are disabled.
+func GetLifecyclePolicy(tp LifecycleType) (*LifecyclePolicy, error) {
+	p := &LifecyclePolicy{Type: tp}
+	if _, err := x.Get(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UpdateLifecyclePolicy saves the lifecycle policy.
+func UpdateLifecyclePolicy(p *LifecyclePolicy) error {
+	if p.InactiveMonths < 1 {
+		p.InactiveMonths = 1
+	}
+	if p.GraceDays < 0 || p.Type != LIFECYCLE_USER {
+		p.GraceDays = 0
+	}
+
+	old, err := GetLifecyclePolicy(p.Type)
+	if err != nil {
+		return err
+	}
+	p.EnabledUnix = old.EnabledUnix
+	if p.Enabled && !old.Enabled {
+		p.EnabledUnix = time.Now().Unix()
+	}
+
+	if old.ID == 0 {
+		_, err = x.Insert(p)
+		return err
+	}
+	_, err = x.ID(old.ID).AllCols().Omit("id").Update(p)
+	return err
+}
+
+// exemptionSet returns lower-cased names of exemptions of the policy.
+func (p *LifecyclePolicy) exemptionSet() map[string]bool {
+	set := make(map[string]bool)
+	for _, line := range strings.Split(p.Exemptions, "\n") {
+		name := strings.ToLower(strings.TrimSpace(line))
+		if name != "" {
+			set[name] = true
+		}
+	}
+	return set
+}
+
+// LifecycleState is the state of an inactive user or repository by the
+// lifecycle policy of its type.
+type LifecycleState struct {
+	ID           int64
+	Type         LifecycleType `xorm:"UNIQUE(s)"`
+	SubjectID    int64         `xorm:"UNIQUE(s)"`
+	NotifiedUnix int64
+	// ResetUnix is when the "keep active" link was followed or the user was
+	// reactivated by admins last time, which counts as activity.
+	ResetUnix int64
+	KeepToken string `xorm:"VARCHAR(40) INDEX"`
+	// Deactivated is true when the user is prohibited to login by the policy.
+	Deactivated bool
+}
+
+// LifecycleDecision is a decision made by a lifecycle policy, which is kept
+// as a record of all automated changes.
+type LifecycleDecision struct {
+	ID          int64
+	Type        LifecycleType
+	SubjectID   int64
+	SubjectName string
+	Decision    string
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64     `xorm:"INDEX"`
+}
+
+func (d *LifecycleDecision) BeforeInsert() {
+	d.CreatedUnix = time.Now().Unix()
+}
+
+func (d *LifecycleDecision) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		d.Created = time.Unix(d.CreatedUnix, 0).Local()
+	}
+}
+
+// IsUser returns true if the decision is made on a user.
+func (d *LifecycleDecision) IsUser() bool {
+	return d.Type == LIFECYCLE_USER
+}
+
+// RecentLifecycleDecisions returns at most limit latest decisions made by
+// lifecycle policies.
+func RecentLifecycleDecisions(limit int) ([]*LifecycleDecision, error) {
+	decisions := make([]*LifecycleDecision, 0, limit)
+	return decisions, x.Desc("id").Limit(limit).Find(&decisions)
+}
+
+// decideLifecycle returns the decision on a subject that was last active at
+// the time and is in the state, or empty string when nothing is to be done.
+// The deactivated is whether the subject is currently deactivated, which is
+// not the case when admins have reactivated the user.
+func decideLifecycle(lastActive int64, state *LifecycleState, deactivated bool, inactive, grace time.Duration, now time.Time) string {
+	switch {
+	case state.Deactivated && !deactivated:
+		return LIFECYCLE_RESET
+	case state.Deactivated:
+		return ""
+	case state.NotifiedUnix > 0 && lastActive > state.NotifiedUnix:
+		return LIFECYCLE_RESET
+	case state.NotifiedUnix == 0 && lastActive <= now.Add(-inactive).Unix():
+		return LIFECYCLE_NOTIFY
+	case state.NotifiedUnix > 0 && grace > 0 && state.NotifiedUnix <= now.Add(-grace).Unix():
+		return LIFECYCLE_DEACTIVATE
+	}
+	return ""
+}
+
+// maxUnix returns the latest of the times.
+func maxUnix(times ...int64) int64 {
+	var last int64
+	for _, t := range times {
+		if t > last {
+			last = t
+		}
+	}
+	return last
+}
+
+// lifecycleSubject is a user or repository that a lifecycle policy applies to.
+type lifecycleSubject struct {
+	id          int64
+	name        string
+	lastActive  int64
+	deactivated bool
+	// notify returns true if a notice with the "keep active" link is sent.
+	notify     func(keepLink string) (bool, error)
+	deactivate func(keepLink string) error
+}
+
+// applyLifecyclePolicy makes decisions on the subjects by the policy, and
+// records every decision that changes anything.
+func applyLifecyclePolicy(p *LifecyclePolicy, subjects []*lifecycleSubject) error {
+	states := make([]*LifecycleState, 0, 10)
+	if err := x.Where("type = ?", p.Type).Find(&states); err != nil {
+		return fmt.Errorf("find states: %v", err)
+	}
+	stateByID := make(map[int64]*LifecycleState, len(states))
+	for _, s := range states {
+		stateByID[s.SubjectID] = s
+	}
+
+	now := time.Now()
+	inactive := time.Duration(p.InactiveMonths) * lifecycleMonth
+	grace := time.Duration(p.GraceDays) * 24 * time.Hour
+	for _, subject := range subjects {
+		state := stateByID[subject.id]
+		if state == nil {
+			state = &LifecycleState{Type: p.Type, SubjectID: subject.id}
+		}
+		lastActive := maxUnix(subject.lastActive, state.ResetUnix, p.EnabledUnix)
+
+		decision := decideLifecycle(lastActive, state, subject.deactivated, inactive, grace, now)
+		switch decision {
+		case "":
+			continue
+		case LIFECYCLE_RESET:
+			if state.Deactivated {
+				state.Deactivated = false
+				state.ResetUnix = now.Unix()
+			}
+			state.NotifiedUnix = 0
+			state.KeepToken = ""
+		case LIFECYCLE_NOTIFY:
+			// The grace period only starts when the notice is actually sent,
+			// nobody should be deactivated without being told.
+			keepToken := tool.SHA1(gouuid.NewV4().String())
+			sent, err := subject.notify(conf.Server.ExternalURL + "lifecycle/keep/" + keepToken)
+			if err != nil {
+				log.Error("Failed to notify inactive %q: %v", subject.name, err)
+				continue
+			} else if !sent {
+				continue
+			}
+			state.NotifiedUnix = now.Unix()
+			state.KeepToken = keepToken
+		case LIFECYCLE_DEACTIVATE:
+			if subject.deactivate == nil {
+				continue
+			}
+			if err := subject.deactivate(conf.Server.ExternalURL + "lifecycle/keep/" + state.KeepToken); err != nil {
+				log.Error("Failed to deactivate inactive %q: %v", subject.name, err)
+				continue
+			}
+			state.Deactivated = true
+		}
+
+		var err error
+		if state.ID == 0 {
+			_, err = x.Insert(state)
+		} else {
+			_, err = x.ID(state.ID).AllCols().Update(state)
+		}
+		if err != nil {
+			return fmt.Errorf("save state of %q: %v", subject.name, err)
+		}
+		if _, err = recordLifecycleDecision(p.Type, subject.id, subject.name, decision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func recordLifecycleDecision(tp LifecycleType, subjectID int64, subjectName, decision string) (*LifecycleDecision, error) {
+	d := &LifecycleDecision{
+		Type:        tp,
+		SubjectID:   subjectID,
+		SubjectName: subjectName,
+		Decision:    decision,
+	}
+	if _, err := x.Insert(d); err != nil {
+		return nil, fmt.Errorf("insert decision: %v", err)
+	}
+	return d, nil
+}
+
+// isUserExempted returns true if the user or any organization of the user is
+// in the exemptions.
+func isUserExempted(u *User, orgs []*User, exemptions map[string]bool) bool {
+	if exemptions[u.LowerName] {
+		return true
+	}
+	for _, org := range orgs {
+		if exemptions[org.LowerName] {
+			return true
+		}
+	}
+	return false
+}
+
+// isRepoExempted returns true if the repository or its owner is in the
+// exemptions.
+func isRepoExempted(ownerName, repoName string, exemptions map[string]bool) bool {
+	ownerName = strings.ToLower(ownerName)
+	return exemptions[ownerName] || exemptions[ownerName+"/"+strings.ToLower(repoName)]
+}
+
+// orgsOfUsers returns organizations that every user is a member of, keyed by
+// user ID.
+func orgsOfUsers() (map[int64][]*User, error) {
+	orgs := make([]*User, 0, 10)
+	if err := x.Where("type = ?", USER_TYPE_ORGANIZATION).Find(&orgs); err != nil {
+		return nil, fmt.Errorf("find organizations: %v", err)
+	}
+	orgByID := make(map[int64]*User, len(orgs))
+	for _, org := range orgs {
+		orgByID[org.ID] = org
+	}
+
+	orgUsers := make([]*OrgUser, 0, 10)
+	if err := x.Find(&orgUsers); err != nil {
+		return nil, fmt.Errorf("find organization members: %v", err)
+	}
+	orgsOfUser := make(map[int64][]*User)
+	for _, ou := range orgUsers {
+		if org := orgByID[ou.OrgID]; org != nil {
+			orgsOfUser[ou.Uid] = append(orgsOfUser[ou.Uid], org)
+		}
+	}
+	return orgsOfUser, nil
+}
+
+// inactiveUserSubjects returns users that the policy applies to. Site admins,
+// organizations, bots and users that are prohibited to login by admins are
+// never flagged.
+func inactiveUserSubjects(p *LifecyclePolicy) ([]*lifecycleSubject, error) {
+	users := make([]*User, 0, 10)
+	if err := x.Where("type = ? AND is_admin = ?", USER_TYPE_INDIVIDUAL, false).
+		And("prohibit_login = ? OR id IN (SELECT subject_id FROM lifecycle_state WHERE type = ? AND deactivated = ?)",
+			false, LIFECYCLE_USER, true).
+		Find(&users); err != nil {
+		return nil, fmt.Errorf("find users: %v", err)
+	}
+
+	orgsOfUser, err := orgsOfUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	exemptions := p.exemptionSet()
+	subjects := make([]*lifecycleSubject, 0, len(users))
+	for _, u := range users {
+		if isUserExempted(u, orgsOfUser[u.ID], exemptions) {
+			continue
+		}
+
+		u := u
+		subjects = append(subjects, &lifecycleSubject{
+			id:          u.ID,
+			name:        u.Name,
+			lastActive:  maxUnix(u.LastSeenUnix, u.CreatedUnix),
+			deactivated: u.ProhibitLogin,
+			notify: func(keepLink string) (bool, error) {
+				if !conf.User.EnableEmailNotification || !u.IsMailable() {
+					return false, nil
+				}
+				email.SendInactiveUserMail(NewMailerUser(u), p.InactiveMonths, p.GraceDays, keepLink)
+				return true, nil
+			},
+			deactivate: func(keepLink string) error {
+				u.ProhibitLogin = true
+				if _, err := x.ID(u.ID).Cols("prohibit_login").Update(u); err != nil {
+					return err
+				}
+				if conf.User.EnableEmailNotification && u.IsMailable() {
+					email.SendDeactivatedUserMail(NewMailerUser(u), p.InactiveMonths, keepLink)
+				}
+				return CreateLifecycleNotice(fmt.Sprintf("User %q is deactivated by the inactive user policy", u.Name))
+			},
+		})
+	}
+	return subjects, nil
+}
+
+// lifecycleRepoActions are actions that count as activity of repositories.
+var lifecycleRepoActions = []ActionType{
+	ACTION_COMMIT_REPO,
+	ACTION_CREATE_ISSUE,
+	ACTION_CREATE_PULL_REQUEST,
+	ACTION_PUSH_TAG,
+	ACTION_COMMENT_ISSUE,
+	ACTION_MERGE_PULL_REQUEST,
+	ACTION_CLOSE_ISSUE,
+	ACTION_REOPEN_ISSUE,
+	ACTION_CLOSE_PULL_REQUEST,
+	ACTION_REOPEN_PULL_REQUEST,
+	ACTION_CREATE_BRANCH,
+	ACTION_DELETE_BRANCH,
+	ACTION_DELETE_TAG,
+}
+
+// inactiveRepoSubjects returns repositories that the policy applies to,
+// mirrors are never flagged since they are not changed by pushes.
+func inactiveRepoSubjects(p *LifecyclePolicy) ([]*lifecycleSubject, error) {
+	repos := make([]*Repository, 0, 10)
+	if err := x.Where("is_mirror = ?", false).Find(&repos); err != nil {
+		return nil, fmt.Errorf("find repositories: %v", err)
+	}
+
+	var activities []*struct {
+		RepoID         int64
+		LastActiveUnix int64
+	}
+	if err := x.Table("action").Select("repo_id, MAX(created_unix) AS last_active_unix").
+		In("op_type", lifecycleRepoActions).
+		GroupBy("repo_id").
+		Find(&activities); err != nil {
+		return nil, fmt.Errorf("find activities: %v", err)
+	}
+	lastActives := make(map[int64]int64, len(activities))
+	for _, a := range activities {
+		lastActives[a.RepoID] = a.LastActiveUnix
+	}
+
+	exemptions := p.exemptionSet()
+	subjects := make([]*lifecycleSubject, 0, len(repos))
+	for _, repo := range repos {
+		if err := repo.GetOwner(); err != nil {
+			return nil, fmt.Errorf("GetOwner [repo_id: %d]: %v", repo.ID, err)
+		}
+		if isRepoExempted(repo.Owner.Name, repo.Name, exemptions) {
+			continue
+		}
+
+		repo := repo
+		subjects = append(subjects, &lifecycleSubject{
+			id:         repo.ID,
+			name:       repo.FullName(),
+			lastActive: maxUnix(lastActives[repo.ID], repo.CreatedUnix),
+			notify: func(keepLink string) (bool, error) {
+				return notifyInactiveRepo(repo, p.InactiveMonths, keepLink)
+			},
+		})
+	}
+	return subjects, nil
+}
+
+// notifyInactiveRepo sends mail to admins of the repository that the
+// repository is inactive. It returns false if nobody can receive the mail.
+func notifyInactiveRepo(repo *Repository, months int, keepLink string) (bool, error) {
+	if !conf.User.EnableEmailNotification {
+		return false, nil
+	}
+
+	admins, err := repoAdmins(repo)
+	if err != nil {
+		return false, fmt.Errorf("repoAdmins: %v", err)
+	}
+
+	tos := make([]string, 0, len(admins))
+	seen := make(map[int64]bool)
+	for _, u := range admins {
+		if seen[u.ID] || !u.IsMailable() {
+			continue
+		}
+		seen[u.ID] = true
+		tos = append(tos, u.Email)
+	}
+	if len(tos) == 0 {
+		return false, nil
+	}
+	email.SendInactiveRepoMail(NewMailerRepo(repo), tos, months, keepLink)
+	return true, nil
+}
+
+// ApplyLifecyclePolicies notifies and deactivates inactive users and notifies
+// owners of inactive repositories by enabled lifecycle policies.
+func ApplyLifecyclePolicies() {
+	if taskStatusTable.IsRunning(_LIFECYCLE_POLICIES) {
+		return
+	}
+	taskStatusTable.Start(_LIFECYCLE_POLICIES)
+	defer taskStatusTable.Stop(_LIFECYCLE_POLICIES)
+
+	log.Trace("Doing: ApplyLifecyclePolicies")
+
+	for tp, subjectsOf := range map[LifecycleType]func(*LifecyclePolicy) ([]*lifecycleSubject, error){
+		LIFECYCLE_USER: inactiveUserSubjects,
+		LIFECYCLE_REPO: inactiveRepoSubjects,
+	} {
+		p, err := GetLifecyclePolicy(tp)
+		if err != nil {
+			log.Error("Failed to get lifecycle policy [type: %d]: %v", tp, err)
+			continue
+		} else if !p.Enabled {
+			continue
+		}
+
+		subjects, err := subjectsOf(p)
+		if err != nil {
+			log.Error("Failed to get subjects of lifecycle policy [type: %d]: %v", tp, err)
+			continue
+		}
+		if err = applyLifecyclePolicy(p, subjects); err != nil {
+			log.Error("Failed to apply lifecycle policy [type: %d]: %v", tp, err)
+		}
+	}
+}
+
+// KeepActive resets the inactivity clock of the subject that the "keep
+// active" link with the token is sent for, users deactivated by the policy
+// are allowed to login again. Users whose login has been changed by admins
+// since the deactivation are left as they are. It returns the recorded
+// decision.
+func KeepActive(token string) (*LifecycleDecision, error) {
+	if token == "" {
+		return nil, ErrLifecycleKeepTokenNotExist{Token: token}
+	}
+	state := &LifecycleState{KeepToken: token}
+	has, err := x.Get(state)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrLifecycleKeepTokenNotExist{Token: token}
+	}
+
+	var name string
+	switch state.Type {
+	case LIFECYCLE_USER:
+		u, err := GetUserByID(state.SubjectID)
+		if err != nil {
+			return nil, fmt.Errorf("GetUserByID: %v", err)
+		}
+		name = u.Name
+		if state.Deactivated {
+			u.ProhibitLogin = false
+			if _, err = x.ID(u.ID).Cols("prohibit_login").Update(u); err != nil {
+				return nil, fmt.Errorf("allow login: %v", err)
+			}
+			if err = CreateLifecycleNotice(fmt.Sprintf("User %q is reactivated by the \"keep active\" link", u.Name)); err != nil {
+				log.Error("CreateLifecycleNotice: %v", err)
+			}
+		}
+	case LIFECYCLE_REPO:
+		repo, err := GetRepositoryByID(state.SubjectID)
+		if err != nil {
+			return nil, fmt.Errorf("GetRepositoryByID: %v", err)
+		}
+		name = repo.FullName()
+	}
+
+	state.NotifiedUnix = 0
+	state.ResetUnix = time.Now().Unix()
+	state.KeepToken = ""
+	state.Deactivated = false
+	if _, err = x.ID(state.ID).AllCols().Update(state); err != nil {
+		return nil, fmt.Errorf("update state: %v", err)
+	}
+	return recordLifecycleDecision(state.Type, state.SubjectID, name, LIFECYCLE_KEEP)
+}
+
+// ResetUserLifecycle forgets the state of the user by the inactive user policy
+// when admins change whether the user is prohibited to login, so that the
+// "keep active" link sent before can not override the decision of admins.
+// Reactivation by admins counts as activity.
+func ResetUserLifecycle(userID int64) error {
+	_, err := x.Where("type = ? AND subject_id = ?", LIFECYCLE_USER, userID).
+		Cols("notified_unix", "reset_unix", "keep_token", "deactivated").
+		Update(&LifecycleState{ResetUnix: time.Now().Unix()})
+	return err
+}
+
+// userSeenInterval is the minimum interval of updating when a user is last
+// seen, which keeps requests from writing to the database every time.
+const userSeenInterval = time.Hour
+
+var userSeens = struct {
+	sync.Mutex
+	updated map[int64]time.Time
+}{updated: make(map[int64]time.Time)}
+
+// UpdateUserLastSeen records that the user signed in or made an authenticated
+// request now.
+func UpdateUserLastSeen(userID int64) error {
+	now := time.Now()
+
+	userSeens.Lock()
+	if now.Sub(userSeens.updated[userID]) < userSeenInterval {
+		userSeens.Unlock()
+		return nil
+	}
+	userSeens.updated[userID] = now
+	userSeens.Unlock()
+
+	_, err := x.ID(userID).Cols("last_seen_unix").Update(&User{LastSeenUnix: now.Unix()})
+	return err
+}
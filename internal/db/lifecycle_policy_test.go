@@ -0,0 +1,152 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_decideLifecycle(t *testing.T) {
+	Convey("Decide on inactive subjects", t, func() {
+		now := time.Unix(1600000000, 0)
+		day := int64(24 * time.Hour / time.Second)
+		inactive := 30 * 24 * time.Hour
+		grace := 7 * 24 * time.Hour
+
+		So(decideLifecycle(now.Unix()-10*day, &LifecycleState{}, false, inactive, grace, now), ShouldBeEmpty)
+		So(decideLifecycle(now.Unix()-31*day, &LifecycleState{}, false, inactive, grace, now), ShouldEqual, LIFECYCLE_NOTIFY)
+
+		notified := &LifecycleState{NotifiedUnix: now.Unix() - 3*day}
+		So(decideLifecycle(now.Unix()-40*day, notified, false, inactive, grace, now), ShouldBeEmpty)
+		So(decideLifecycle(now.Unix()-day, notified, false, inactive, grace, now), ShouldEqual, LIFECYCLE_RESET)
+
+		overdue := &LifecycleState{NotifiedUnix: now.Unix() - 8*day}
+		So(decideLifecycle(now.Unix()-40*day, overdue, false, inactive, grace, now), ShouldEqual, LIFECYCLE_DEACTIVATE)
+
+		Convey("Subjects are never deactivated without grace period", func() {
+			So(decideLifecycle(now.Unix()-40*day, overdue, false, inactive, 0, now), ShouldBeEmpty)
+		})
+
+		Convey("Deactivated subjects are only reset when reactivated", func() {
+			deactivated := &LifecycleState{NotifiedUnix: now.Unix() - 8*day, Deactivated: true}
+			So(decideLifecycle(now.Unix()-40*day, deactivated, true, inactive, grace, now), ShouldBeEmpty)
+			So(decideLifecycle(now.Unix()-40*day, deactivated, false, inactive, grace, now), ShouldEqual, LIFECYCLE_RESET)
+		})
+	})
+}
+
+func Test_lifecycleExemptions(t *testing.T) {
+	Convey("Match exemptions of lifecycle policies", t, func() {
+		p := &LifecyclePolicy{Exemptions: " Alice \n\nacme\r\nbob/Proj\n"}
+		exemptions := p.exemptionSet()
+		So(exemptions, ShouldResemble, map[string]bool{"alice": true, "acme": true, "bob/proj": true})
+
+		So(isUserExempted(&User{LowerName: "alice"}, nil, exemptions), ShouldBeTrue)
+		So(isUserExempted(&User{LowerName: "carol"}, []*User{{LowerName: "acme"}}, exemptions), ShouldBeTrue)
+		So(isUserExempted(&User{LowerName: "carol"}, []*User{{LowerName: "other"}}, exemptions), ShouldBeFalse)
+
+		So(isRepoExempted("Acme", "service", exemptions), ShouldBeTrue)
+		So(isRepoExempted("bob", "proj", exemptions), ShouldBeTrue)
+		So(isRepoExempted("bob", "other", exemptions), ShouldBeFalse)
+	})
+}
+
+func Test_applyLifecyclePolicy(t *testing.T) {
+	setupTestDB(t)
+
+	p := &LifecyclePolicy{Type: LIFECYCLE_USER, Enabled: true, InactiveMonths: 1, GraceDays: 7}
+	lastActive := time.Now().Add(-60 * 24 * time.Hour).Unix()
+
+	Convey("Grace period only starts when a notice is sent", t, func() {
+		var deactivated bool
+		subject := &lifecycleSubject{
+			id:         1,
+			name:       "alice",
+			lastActive: lastActive,
+			notify:     func(string) (bool, error) { return false, nil },
+			deactivate: func(string) error {
+				deactivated = true
+				return nil
+			},
+		}
+		So(applyLifecyclePolicy(p, []*lifecycleSubject{subject}), ShouldBeNil)
+
+		has, err := x.Get(&LifecycleState{Type: LIFECYCLE_USER, SubjectID: 1})
+		So(err, ShouldBeNil)
+		So(has, ShouldBeFalse)
+		So(deactivated, ShouldBeFalse)
+
+		var keepLink string
+		subject.notify = func(link string) (bool, error) {
+			keepLink = link
+			return true, nil
+		}
+		So(applyLifecyclePolicy(p, []*lifecycleSubject{subject}), ShouldBeNil)
+
+		state := &LifecycleState{Type: LIFECYCLE_USER, SubjectID: 1}
+		has, err = x.Get(state)
+		So(err, ShouldBeNil)
+		So(has, ShouldBeTrue)
+		So(state.NotifiedUnix, ShouldBeGreaterThan, 0)
+		So(keepLink, ShouldEndWith, "lifecycle/keep/"+state.KeepToken)
+	})
+}
+
+func Test_KeepActive(t *testing.T) {
+	setupTestDB(t)
+
+	alice := &User{Name: "alice", LowerName: "alice", ProhibitLogin: true}
+	insertTestBeans(t, alice)
+	state := &LifecycleState{Type: LIFECYCLE_USER, SubjectID: alice.ID, NotifiedUnix: 1, KeepToken: "token", Deactivated: true}
+	insertTestBeans(t, state)
+
+	prohibited := func() bool {
+		u, err := GetUserByID(alice.ID)
+		So(err, ShouldBeNil)
+		return u.ProhibitLogin
+	}
+
+	Convey("Follow the keep active link", t, func() {
+		Convey("Links do not override changes of admins", func() {
+			// Admins reactivate and then ban the user again.
+			So(ResetUserLifecycle(alice.ID), ShouldBeNil)
+
+			_, err := KeepActive("token")
+			So(IsErrLifecycleKeepTokenNotExist(err), ShouldBeTrue)
+			So(prohibited(), ShouldBeTrue)
+		})
+
+		Convey("Users deactivated by the policy are reactivated", func() {
+			if _, err := x.ID(state.ID).AllCols().Update(state); err != nil {
+				t.Fatal(err)
+			}
+
+			d, err := KeepActive("token")
+			So(err, ShouldBeNil)
+			So(d.Decision, ShouldEqual, LIFECYCLE_KEEP)
+			So(prohibited(), ShouldBeFalse)
+		})
+	})
+}
+
+func Test_orgsOfUsers(t *testing.T) {
+	setupTestDB(t)
+
+	alice := &User{Name: "alice", LowerName: "alice"}
+	acme := &User{Name: "acme", LowerName: "acme", Type: USER_TYPE_ORGANIZATION}
+	insertTestBeans(t, alice, acme)
+	insertTestBeans(t, &OrgUser{Uid: alice.ID, OrgID: acme.ID})
+
+	Convey("Find organizations of all users at once", t, func() {
+		orgsOfUser, err := orgsOfUsers()
+		So(err, ShouldBeNil)
+		So(orgsOfUser, ShouldHaveLength, 1)
+		So(orgsOfUser[alice.ID], ShouldHaveLength, 1)
+		So(orgsOfUser[alice.ID][0].LowerName, ShouldEqual, "acme")
+	})
+}
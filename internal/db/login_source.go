@@ -827,6 +827,11 @@ func UserLogin(username, password string, loginSourceID int64) (*User, error) {
 	}
 
 	if hasUser {
+		// Bots can only be authenticated with access tokens.
+		if user.IsBot() {
+			return nil, errors.UserNotExist{UserID: user.ID, Name: user.Name}
+		}
+
 		// Note: This check is unnecessary but to reduce user confusion at login page
 		// and make it more consistent at user's perspective.
 		if loginSourceID >= 0 && user.LoginSource != loginSourceID {
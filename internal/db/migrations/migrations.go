@@ -68,6 +68,20 @@ var migrations = []Migration{
 	NewMigration("store long text in repository description field", updateRepositoryDescriptionField),
 	// v18 -> v19:v0.11.55
 	NewMigration("clean unlinked webhook and hook_tasks", cleanUnlinkedWebhookAndHookTasks),
+	// v19 -> v20:v0.12.0
+	NewMigration("mark closed issues with close reason", markClosedIssuesWithReason),
+	// v20 -> v21:v0.12.0
+	NewMigration("create table of cached commits counts", createRepoCommitsCountTable),
+	// v21 -> v22:v0.12.0
+	NewMigration("create table of recent pushes", createRecentPushTable),
+	// v22 -> v23:v0.12.0
+	NewMigration("create table of repository redirects", createRepoRedirectTable),
+}
+
+// ExpectedVersion returns the version of the database schema after all
+// migrations, which is the one that the binary works with.
+func ExpectedVersion() int64 {
+	return int64(_MIN_DB_VER + len(migrations))
 }
 
 // Migrate database to current version
@@ -84,7 +98,7 @@ func Migrate(x *xorm.Engine) error {
 		// If the version record does not exist we think
 		// it is a fresh installation and we can skip all migrations.
 		currentVersion.ID = 0
-		currentVersion.Version = int64(_MIN_DB_VER + len(migrations))
+		currentVersion.Version = ExpectedVersion()
 
 		if _, err = x.InsertOne(currentVersion); err != nil {
 			return fmt.Errorf("insert: %v", err)
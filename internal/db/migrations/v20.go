@@ -0,0 +1,32 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package migrations
+
+import (
+	"fmt"
+
+	"xorm.io/xorm"
+)
+
+// markClosedIssuesWithReason marks all closed issues with the neutral close
+// reason, so filtering issues by reasons does not miss them.
+func markClosedIssuesWithReason(x *xorm.Engine) error {
+	exist, err := x.IsTableExist("issue")
+	if err != nil {
+		return fmt.Errorf("IsTableExist: %v", err)
+	} else if !exist {
+		return nil
+	}
+
+	type Issue struct {
+		CloseReason string `xorm:"VARCHAR(50)"`
+	}
+	if err = x.Sync2(new(Issue)); err != nil {
+		return fmt.Errorf("Sync2: %v", err)
+	}
+
+	_, err = x.Exec("UPDATE `issue` SET close_reason = ? WHERE is_closed = ? AND is_pull = ?", "closed", true, false)
+	return err
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package migrations
+
+import (
+	"fmt"
+
+	"xorm.io/xorm"
+)
+
+// createRepoCommitsCountTable creates the table of commits counts of branches,
+// which starts empty and is filled as branches are viewed.
+func createRepoCommitsCountTable(x *xorm.Engine) error {
+	type RepoCommitsCount struct {
+		ID          int64
+		RepoID      int64  `xorm:"UNIQUE(s)"`
+		Branch      string `xorm:"UNIQUE(s)"`
+		CommitID    string `xorm:"VARCHAR(40)"`
+		Count       int64
+		UpdatedUnix int64
+	}
+	if err := x.Sync2(new(RepoCommitsCount)); err != nil {
+		return fmt.Errorf("Sync2: %v", err)
+	}
+	return nil
+}
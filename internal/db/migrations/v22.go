@@ -0,0 +1,26 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package migrations
+
+import (
+	"fmt"
+
+	"xorm.io/xorm"
+)
+
+// createRecentPushTable creates the table of branches recently pushed by users.
+func createRecentPushTable(x *xorm.Engine) error {
+	type RecentPush struct {
+		ID         int64
+		UserID     int64 `xorm:"UNIQUE(s)"`
+		RepoID     int64 `xorm:"UNIQUE(s)"`
+		Branch     string
+		PushedUnix int64
+	}
+	if err := x.Sync2(new(RecentPush)); err != nil {
+		return fmt.Errorf("Sync2: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package migrations
+
+import (
+	"fmt"
+
+	"xorm.io/xorm"
+)
+
+// createRepoRedirectTable creates the table of former names of repositories.
+func createRepoRedirectTable(x *xorm.Engine) error {
+	type RepoRedirect struct {
+		ID          int64
+		OwnerID     int64  `xorm:"UNIQUE(s)"`
+		LowerName   string `xorm:"UNIQUE(s)"`
+		RepoID      int64  `xorm:"INDEX"`
+		CreatedUnix int64
+	}
+	if err := x.Sync2(new(RepoRedirect)); err != nil {
+		return fmt.Errorf("Sync2: %v", err)
+	}
+	return nil
+}
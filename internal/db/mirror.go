@@ -167,6 +167,107 @@ func (m *Mirror) FullAddress() string {
 	return unescapeMirrorCredentials(m.address)
 }
 
+// parseMirrorURL parses the mirror address with a scheme, e.g. HTTP(S) and SSH
+// URLs. It returns nil for other addresses, e.g. SCP-like SSH addresses and
+// local paths.
+func parseMirrorURL(addr string) *url.URL {
+	if !strings.Contains(addr, "://") {
+		return nil
+	}
+	u, err := url.Parse(addr)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+	return u
+}
+
+// redactMirrorAddress returns the mirror address without user information.
+// SCP-like SSH addresses (e.g. "git@example.com:owner/repo.git") cannot carry
+// passwords, so they are returned as is.
+func redactMirrorAddress(addr string) string {
+	u := parseMirrorURL(addr)
+	if u != nil {
+		u.User = nil
+		return u.String()
+	}
+
+	// Unescaped credentials may fail the parsing, and may contain "@" as well.
+	start := strings.Index(addr, "://")
+	if end := strings.LastIndex(addr, "@"); start > -1 && end > start {
+		return addr[:start+3] + addr[end+1:]
+	}
+	return addr
+}
+
+// SafeAddress returns mirror address from Git repository config with user
+// information stripped, which is safe to be shown.
+func (m *Mirror) SafeAddress() string {
+	m.readAddress()
+	return redactMirrorAddress(m.address)
+}
+
+// Username returns the username in mirror address from Git repository config,
+// if any.
+func (m *Mirror) Username() string {
+	m.readAddress()
+	u := parseMirrorURL(m.address)
+	if u == nil || u.User == nil {
+		return ""
+	}
+	return u.User.Username()
+}
+
+// composeMirrorAddress returns the new mirror address with given credentials.
+// The username and password of the old address are kept when they are empty
+// but the scheme and host are unchanged, so stored credentials never have to
+// be shown. The old password is only kept for the same username. Credentials
+// already in the new address take precedence.
+func composeMirrorAddress(oldAddr, newAddr, username, password string) string {
+	u := parseMirrorURL(newAddr)
+	if u == nil || u.User != nil {
+		return newAddr
+	}
+
+	old := parseMirrorURL(oldAddr)
+	if old == nil || old.User == nil || old.Scheme != u.Scheme || old.Host != u.Host {
+		old = nil
+	}
+	if username == "" {
+		if old == nil {
+			return u.String()
+		}
+		username = old.User.Username()
+	}
+
+	u.User = url.User(username)
+	if password != "" {
+		u.User = url.UserPassword(username, password)
+	} else if old != nil && old.User.Username() == username {
+		if oldPassword, ok := old.User.Password(); ok {
+			u.User = url.UserPassword(username, oldPassword)
+		}
+	}
+	return u.String()
+}
+
+// UpdateAddress writes new address with given credentials to Git repository
+// config. The old credentials are kept when the username or password is empty,
+// see composeMirrorAddress for details, unless clearCredentials is true.
+func (m *Mirror) UpdateAddress(addr, username, password string, clearCredentials bool) error {
+	m.readAddress()
+	oldAddr := m.address
+	if clearCredentials {
+		oldAddr = ""
+		username, password = "", ""
+	}
+	addr = composeMirrorAddress(oldAddr, addr, username, password)
+	if err := m.SaveAddress(addr); err != nil {
+		return err
+	}
+	m.address = addr
+	return nil
+}
+
 // escapeCredentials returns mirror address with escaped credentials.
 func escapeMirrorCredentials(addr string) string {
 	start, end, found := findPasswordInMirrorAddress(addr)
@@ -370,133 +471,138 @@ func SyncMirrors() {
 	// Start listening on new sync requests.
 	for repoID := range MirrorQueue.Queue() {
 		log.Trace("SyncMirrors [repo_id: %s]", repoID)
-		MirrorQueue.Remove(repoID)
+		MirrorQueue.Process(repoID, func() {
+			syncMirror(repoID)
+		})
+	}
+}
+
+// syncMirror syncs the mirror of the repository.
+func syncMirror(repoID string) {
+	m, err := GetMirrorByRepoID(com.StrTo(repoID).MustInt64())
+	if err != nil {
+		log.Error("GetMirrorByRepoID [%s]: %v", repoID, err)
+		return
+	}
+
+	results, ok := m.runSync()
+	if !ok {
+		return
+	}
+
+	m.ScheduleNextSync()
+	if err = UpdateMirror(m); err != nil {
+		log.Error("UpdateMirror [%d]: %v", m.RepoID, err)
+		return
+	}
 
-		m, err := GetMirrorByRepoID(com.StrTo(repoID).MustInt64())
+	// TODO:
+	// - Create "Mirror Sync" webhook event
+	// - Create mirror sync (create, push and delete) events and trigger the "mirror sync" webhooks
+
+	var gitRepo *git.Repository
+	if len(results) == 0 {
+		log.Trace("SyncMirrors [repo_id: %d]: no commits fetched", m.RepoID)
+	} else {
+		gitRepo, err = git.OpenRepository(m.Repo.RepoPath())
 		if err != nil {
-			log.Error("GetMirrorByRepoID [%d]: %v", m.RepoID, err)
-			continue
+			log.Error("OpenRepository [%d]: %v", m.RepoID, err)
+			return
 		}
+	}
 
-		results, ok := m.runSync()
-		if !ok {
+	for _, result := range results {
+		// Discard GitHub pull requests, i.e. refs/pull/*
+		if strings.HasPrefix(result.refName, "refs/pull/") {
 			continue
 		}
 
-		m.ScheduleNextSync()
-		if err = UpdateMirror(m); err != nil {
-			log.Error("UpdateMirror [%d]: %v", m.RepoID, err)
+		// Delete reference
+		if result.newCommitID == GIT_SHORT_EMPTY_SHA {
+			if err = MirrorSyncDeleteAction(m.Repo, result.refName); err != nil {
+				log.Error("MirrorSyncDeleteAction [repo_id: %d]: %v", m.RepoID, err)
+			}
 			continue
 		}
 
-		// TODO:
-		// - Create "Mirror Sync" webhook event
-		// - Create mirror sync (create, push and delete) events and trigger the "mirror sync" webhooks
-
-		var gitRepo *git.Repository
-		if len(results) == 0 {
-			log.Trace("SyncMirrors [repo_id: %d]: no commits fetched", m.RepoID)
-		} else {
-			gitRepo, err = git.OpenRepository(m.Repo.RepoPath())
-			if err != nil {
-				log.Error("OpenRepository [%d]: %v", m.RepoID, err)
+		// New reference
+		isNewRef := false
+		if result.oldCommitID == GIT_SHORT_EMPTY_SHA {
+			if err = MirrorSyncCreateAction(m.Repo, result.refName); err != nil {
+				log.Error("MirrorSyncCreateAction [repo_id: %d]: %v", m.RepoID, err)
 				continue
 			}
+			isNewRef = true
 		}
 
-		for _, result := range results {
-			// Discard GitHub pull requests, i.e. refs/pull/*
-			if strings.HasPrefix(result.refName, "refs/pull/") {
+		// Push commits
+		var commits *list.List
+		var oldCommitID string
+		var newCommitID string
+		if !isNewRef {
+			oldCommitID, err = git.GetFullCommitID(gitRepo.Path, result.oldCommitID)
+			if err != nil {
+				log.Error("GetFullCommitID [%d]: %v", m.RepoID, err)
 				continue
 			}
-
-			// Delete reference
-			if result.newCommitID == GIT_SHORT_EMPTY_SHA {
-				if err = MirrorSyncDeleteAction(m.Repo, result.refName); err != nil {
-					log.Error("MirrorSyncDeleteAction [repo_id: %d]: %v", m.RepoID, err)
-				}
+			newCommitID, err = git.GetFullCommitID(gitRepo.Path, result.newCommitID)
+			if err != nil {
+				log.Error("GetFullCommitID [%d]: %v", m.RepoID, err)
 				continue
 			}
-
-			// New reference
-			isNewRef := false
-			if result.oldCommitID == GIT_SHORT_EMPTY_SHA {
-				if err = MirrorSyncCreateAction(m.Repo, result.refName); err != nil {
-					log.Error("MirrorSyncCreateAction [repo_id: %d]: %v", m.RepoID, err)
-					continue
-				}
-				isNewRef = true
+			commits, err = gitRepo.CommitsBetweenIDs(newCommitID, oldCommitID)
+			if err != nil {
+				log.Error("CommitsBetweenIDs [repo_id: %d, new_commit_id: %s, old_commit_id: %s]: %v", m.RepoID, newCommitID, oldCommitID, err)
+				continue
 			}
-
-			// Push commits
-			var commits *list.List
-			var oldCommitID string
-			var newCommitID string
-			if !isNewRef {
-				oldCommitID, err = git.GetFullCommitID(gitRepo.Path, result.oldCommitID)
-				if err != nil {
-					log.Error("GetFullCommitID [%d]: %v", m.RepoID, err)
-					continue
-				}
-				newCommitID, err = git.GetFullCommitID(gitRepo.Path, result.newCommitID)
-				if err != nil {
-					log.Error("GetFullCommitID [%d]: %v", m.RepoID, err)
-					continue
-				}
-				commits, err = gitRepo.CommitsBetweenIDs(newCommitID, oldCommitID)
-				if err != nil {
-					log.Error("CommitsBetweenIDs [repo_id: %d, new_commit_id: %s, old_commit_id: %s]: %v", m.RepoID, newCommitID, oldCommitID, err)
-					continue
-				}
+		} else {
+			refNewCommitID, err := gitRepo.GetBranchCommitID(result.refName)
+			if err != nil {
+				log.Error("GetFullCommitID [%d]: %v", m.RepoID, err)
+				continue
+			}
+			if newCommit, err := gitRepo.GetCommit(refNewCommitID); err != nil {
+				log.Error("GetCommit [repo_id: %d, commit_id: %s]: %v", m.RepoID, refNewCommitID, err)
+				continue
 			} else {
-				refNewCommitID, err := gitRepo.GetBranchCommitID(result.refName)
+				// TODO: Get the commits for the new ref until the closest ancestor branch like Github does
+				commits, err = newCommit.CommitsBeforeLimit(10)
 				if err != nil {
-					log.Error("GetFullCommitID [%d]: %v", m.RepoID, err)
-					continue
-				}
-				if newCommit, err := gitRepo.GetCommit(refNewCommitID); err != nil {
-					log.Error("GetCommit [repo_id: %d, commit_id: %s]: %v", m.RepoID, refNewCommitID, err)
-					continue
-				} else {
-					// TODO: Get the commits for the new ref until the closest ancestor branch like Github does
-					commits, err = newCommit.CommitsBeforeLimit(10)
-					if err != nil {
-						log.Error("CommitsBeforeLimit [repo_id: %d, commit_id: %s]: %v", m.RepoID, refNewCommitID, err)
-					}
-					oldCommitID = git.EMPTY_SHA
-					newCommitID = refNewCommitID
+					log.Error("CommitsBeforeLimit [repo_id: %d, commit_id: %s]: %v", m.RepoID, refNewCommitID, err)
 				}
-			}
-			if err = MirrorSyncPushAction(m.Repo, MirrorSyncPushActionOptions{
-				RefName:     result.refName,
-				OldCommitID: oldCommitID,
-				NewCommitID: newCommitID,
-				Commits:     ListToPushCommits(commits),
-			}); err != nil {
-				log.Error("MirrorSyncPushAction [repo_id: %d]: %v", m.RepoID, err)
-				continue
+				oldCommitID = git.EMPTY_SHA
+				newCommitID = refNewCommitID
 			}
 		}
-
-		if _, err = x.Exec("UPDATE mirror SET updated_unix = ? WHERE repo_id = ?", time.Now().Unix(), m.RepoID); err != nil {
-			log.Error("Update 'mirror.updated_unix' [%d]: %v", m.RepoID, err)
+		if err = MirrorSyncPushAction(m.Repo, MirrorSyncPushActionOptions{
+			RefName:     result.refName,
+			OldCommitID: oldCommitID,
+			NewCommitID: newCommitID,
+			Commits:     ListToPushCommits(commits),
+		}); err != nil {
+			log.Error("MirrorSyncPushAction [repo_id: %d]: %v", m.RepoID, err)
 			continue
 		}
+	}
 
-		// Get latest commit date and compare to current repository updated time,
-		// update if latest commit date is newer.
-		commitDate, err := git.GetLatestCommitDate(m.Repo.RepoPath(), "")
-		if err != nil {
-			log.Error("GetLatestCommitDate [%d]: %v", m.RepoID, err)
-			continue
-		} else if commitDate.Before(m.Repo.Updated) {
-			continue
-		}
+	if _, err = x.Exec("UPDATE mirror SET updated_unix = ? WHERE repo_id = ?", time.Now().Unix(), m.RepoID); err != nil {
+		log.Error("Update 'mirror.updated_unix' [%d]: %v", m.RepoID, err)
+		return
+	}
 
-		if _, err = x.Exec("UPDATE repository SET updated_unix = ? WHERE id = ?", commitDate.Unix(), m.RepoID); err != nil {
-			log.Error("Update 'repository.updated_unix' [%d]: %v", m.RepoID, err)
-			continue
-		}
+	// Get latest commit date and compare to current repository updated time,
+	// update if latest commit date is newer.
+	commitDate, err := git.GetLatestCommitDate(m.Repo.RepoPath(), "")
+	if err != nil {
+		log.Error("GetLatestCommitDate [%d]: %v", m.RepoID, err)
+		return
+	} else if commitDate.Before(m.Repo.Updated) {
+		return
+	}
+
+	if _, err = x.Exec("UPDATE repository SET updated_unix = ? WHERE id = ?", commitDate.Unix(), m.RepoID); err != nil {
+		log.Error("Update 'repository.updated_unix' [%d]: %v", m.RepoID, err)
+		return
 	}
 }
 
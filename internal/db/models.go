@@ -26,6 +26,7 @@ import (
 
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db/migrations"
+	"gogs.io/gogs/internal/trace"
 )
 
 // Engine represents a XORM engine or session.
@@ -55,14 +56,20 @@ var (
 func init() {
 	tables = append(tables,
 		new(User), new(PublicKey), new(AccessToken), new(TwoFactor), new(TwoFactorRecoveryCode),
-		new(Repository), new(DeployKey), new(Collaboration), new(Access), new(Upload),
-		new(Watch), new(Star), new(Follow), new(Action),
+		new(Repository), new(DeployKey), new(Collaboration), new(RepoInvite), new(Access), new(Upload),
+		new(Watch), new(MuteSchedule), new(PathWatch), new(Star), new(Follow), new(Action),
 		new(Issue), new(PullRequest), new(Comment), new(Attachment), new(IssueUser),
+		new(ReviewReminder), new(ReviewReminderState), new(Secret), new(VisibilitySchedule), new(IssueAutoLock), new(RepoTraffic), new(PushLog),
 		new(Label), new(IssueLabel), new(Milestone),
-		new(Mirror), new(Release), new(LoginSource), new(Webhook), new(HookTask),
+		new(Mirror), new(Release), new(CommitStatus), new(LoginSource), new(Webhook), new(HookTask),
 		new(ProtectBranch), new(ProtectBranchWhitelist),
-		new(Team), new(OrgUser), new(TeamUser), new(TeamRepo),
-		new(Notice), new(EmailAddress))
+		new(Team), new(OrgUser), new(TeamUser), new(TeamRepo), new(BotGrant), new(OrgRepoPolicy),
+		new(OrgRuleSet), new(OrgRuleSetExemption), new(OrgRuleSetAudit), new(ReviewedFile), new(CodeOwnerApproval),
+		new(ReleaseLink), new(ReleaseDownload),
+		new(RepoManifest), new(RepoDependency), new(RepoDependencyScan), new(RepoCommitStats), new(RepoCommitsCount), new(RecentPush),
+		new(RepoRedirect), new(ProtectTag), new(CommitLintRules),
+		new(RepoRelation), new(LifecyclePolicy), new(LifecycleState), new(LifecycleDecision),
+		new(SiteContent), new(FooterLink), new(Notice), new(EmailAddress))
 
 	gonicNames := []string{"SSL"}
 	for _, name := range gonicNames {
@@ -188,15 +195,37 @@ func SetEngine() (err error) {
 	x.SetMaxIdleConns(0)
 	x.SetConnMaxLifetime(time.Second)
 
+	var xlogger core.ILogger
 	if conf.IsProdMode() {
-		x.SetLogger(xorm.NewSimpleLogger3(logger, xorm.DEFAULT_LOG_PREFIX, xorm.DEFAULT_LOG_FLAG, core.LOG_WARNING))
+		xlogger = xorm.NewSimpleLogger3(logger, xorm.DEFAULT_LOG_PREFIX, xorm.DEFAULT_LOG_FLAG, core.LOG_WARNING)
 	} else {
-		x.SetLogger(xorm.NewSimpleLogger(logger))
+		xlogger = xorm.NewSimpleLogger(logger)
 	}
+	if conf.RequestLog.Enabled {
+		xlogger = &traceLogger{ILogger: xlogger}
+		x.ShowExecTime(true)
+	}
+	x.SetLogger(xlogger)
 	x.ShowSQL(true)
 	return nil
 }
 
+// traceLogger records SQL queries to the trace of current request, by reading
+// the duration of queries that XORM logs when ShowExecTime is enabled.
+type traceLogger struct {
+	core.ILogger
+}
+
+func (l *traceLogger) Infof(format string, v ...interface{}) {
+	if t := trace.Current(); t != nil && len(v) > 1 && strings.HasSuffix(format, " - took: %v") {
+		if d, ok := v[len(v)-1].(time.Duration); ok {
+			query, _ := v[0].(string)
+			t.AddSQL(query, d)
+		}
+	}
+	l.ILogger.Infof(format, v...)
+}
+
 func NewEngine() (err error) {
 	if err = SetEngine(); err != nil {
 		return err
@@ -210,6 +239,14 @@ func NewEngine() (err error) {
 		return fmt.Errorf("sync structs to database tables: %v\n", err)
 	}
 
+	// The session table is only used by the database session providers.
+	switch conf.Session.Provider {
+	case "mysql", "postgres":
+		if err = x.StoreEngine("InnoDB").Sync2(new(Session)); err != nil {
+			return fmt.Errorf("sync session table: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -259,20 +296,67 @@ type Version struct {
 	Version int64
 }
 
+// CheckSchemaVersion returns ErrSchemaVersionMismatch if the database is not
+// at the schema version that the binary works with, e.g. not yet migrated by
+// the web server after an upgrade, or migrated by a newer binary.
+func CheckSchemaVersion() error {
+	current, err := SchemaVersion()
+	if err != nil {
+		return err
+	}
+	if expected := migrations.ExpectedVersion(); current != expected {
+		return ErrSchemaVersionMismatch{Current: current, Expected: expected}
+	}
+	return nil
+}
+
+// SchemaVersion returns the version of the database schema.
+func SchemaVersion() (int64, error) {
+	current := &Version{ID: 1}
+	if _, err := x.Get(current); err != nil {
+		return 0, fmt.Errorf("get version: %v", err)
+	}
+	return current.Version, nil
+}
+
 // DumpDatabase dumps all data from database to file system in JSON format.
-func DumpDatabase(dirPath string) (err error) {
+// All tables are read in a single transaction, so the dump is consistent
+// even if changes are made at the same time.
+func DumpDatabase(dirPath string, verbose bool) (err error) {
 	os.MkdirAll(dirPath, os.ModePerm)
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+	// MySQL and SQLite read from a snapshot in a transaction by default, while
+	// PostgreSQL and MSSQL see changes committed by others in the middle of it.
+	switch {
+	case conf.UsePostgreSQL:
+		_, err = sess.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ")
+	case conf.UseMSSQL:
+		_, err = sess.Exec("SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
+	}
+	if err != nil {
+		return fmt.Errorf("set transaction isolation level: %v", err)
+	}
+
 	// Purposely create a local variable to not modify global variable
 	tables := append(tables, new(Version))
 	for _, table := range tables {
 		tableName := strings.TrimPrefix(fmt.Sprintf("%T", table), "*db.")
+		if verbose {
+			log.Trace("Dumping table '%s'...", tableName)
+		}
+
 		tableFile := path.Join(dirPath, tableName+".json")
 		f, err := os.Create(tableFile)
 		if err != nil {
 			return fmt.Errorf("create JSON file: %v", err)
 		}
 
-		if err = x.Asc("id").Iterate(table, func(idx int, bean interface{}) (err error) {
+		if err = sess.Asc("id").Iterate(table, func(idx int, bean interface{}) (err error) {
 			return jsoniter.NewEncoder(f).Encode(bean)
 		}); err != nil {
 			f.Close()
@@ -280,9 +364,12 @@ func DumpDatabase(dirPath string) (err error) {
 		}
 		f.Close()
 	}
-	return nil
+	return sess.Commit()
 }
 
+// maxImportLineSize is the maximum size of a row in JSON files to be imported.
+const maxImportLineSize = 64 * 1024 * 1024
+
 // ImportDatabase imports data from backup archive.
 func ImportDatabase(dirPath string, verbose bool) (err error) {
 	snakeMapper := core.SnakeMapper{}
@@ -318,6 +405,9 @@ func ImportDatabase(dirPath string, verbose bool) (err error) {
 		rawTableName := x.TableName(table)
 		_, isInsertProcessor := table.(xorm.BeforeInsertProcessor)
 		scanner := bufio.NewScanner(f)
+		// Rows such as issues with long content can be larger than the default
+		// token size of the scanner.
+		scanner.Buffer(make([]byte, 64*1024), maxImportLineSize)
 		for scanner.Scan() {
 			switch bean := table.(type) {
 			case *LoginSource:
@@ -369,6 +459,10 @@ func ImportDatabase(dirPath string, verbose bool) (err error) {
 				}
 			}
 		}
+		f.Close()
+		if err = scanner.Err(); err != nil {
+			return fmt.Errorf("read JSON file of table '%s': %v", tableName, err)
+		}
 
 		// PostgreSQL needs manually reset table sequence for auto increment keys
 		if conf.UsePostgreSQL {
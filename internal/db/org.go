@@ -216,10 +216,26 @@ func DeleteOrganization(org *User) (err error) {
 		&Team{OrgID: org.ID},
 		&OrgUser{OrgID: org.ID},
 		&TeamUser{OrgID: org.ID},
+		&ReviewReminder{OrgID: org.ID},
+		&Secret{OrgID: org.ID},
+		&OrgRepoPolicy{OrgID: org.ID},
+		&OrgRuleSet{OrgID: org.ID},
+		&OrgRuleSetExemption{OrgID: org.ID},
+		&OrgRuleSetAudit{OrgID: org.ID},
 	); err != nil {
 		return fmt.Errorf("deleteBeans: %v", err)
 	}
 
+	bots := make([]*User, 0, 5)
+	if err = sess.Where("type = ?", USER_TYPE_BOT).And("bot_org_id = ?", org.ID).Find(&bots); err != nil {
+		return fmt.Errorf("get bots: %v", err)
+	}
+	for _, bot := range bots {
+		if err = deleteBot(sess, bot); err != nil {
+			return fmt.Errorf("deleteBot [bot_id: %d]: %v", bot.ID, err)
+		}
+	}
+
 	if err = deleteUser(sess, org); err != nil {
 		return fmt.Errorf("deleteUser: %v", err)
 	}
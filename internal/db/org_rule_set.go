@@ -0,0 +1,631 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogs/git-module"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// OrgRuleSet is a set of branch protection and push rules of an organization,
+// which is enforced in repositories of the organization whose names match, in
+// addition to rules of the repositories themselves. Branches that match are
+// protected from deletion and force pushes.
+type OrgRuleSet struct {
+	ID    int64
+	OrgID int64 `xorm:"INDEX"`
+	Name  string
+	// RepoPatterns are glob patterns of repository names separated by commas,
+	// e.g. "service-*, api".
+	RepoPatterns string `xorm:"TEXT"`
+	// BranchPatterns are glob patterns of branch names separated by commas,
+	// e.g. "master, release/*".
+	BranchPatterns     string `xorm:"TEXT"`
+	RequirePullRequest bool
+	// CommitMessagePattern is the regular expression that messages of pushed
+	// commits must match, e.g. a reference to a ticket.
+	CommitMessagePattern string `xorm:"TEXT"`
+	// ForbiddenFilePatterns are glob patterns of files that cannot be added or
+	// modified by pushed commits separated by commas, which match either paths
+	// or base names of files, e.g. "*.pem, vendor/*".
+	ForbiddenFilePatterns string `xorm:"TEXT"`
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+	Updated     time.Time `xorm:"-" json:"-"`
+	UpdatedUnix int64
+}
+
+func (rs *OrgRuleSet) BeforeInsert() {
+	rs.CreatedUnix = time.Now().Unix()
+	rs.UpdatedUnix = rs.CreatedUnix
+}
+
+func (rs *OrgRuleSet) BeforeUpdate() {
+	rs.UpdatedUnix = time.Now().Unix()
+}
+
+func (rs *OrgRuleSet) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		rs.Created = time.Unix(rs.CreatedUnix, 0).Local()
+	case "updated_unix":
+		rs.Updated = time.Unix(rs.UpdatedUnix, 0).Local()
+	}
+}
+
+// splitPatterns returns non-empty patterns separated by commas.
+func splitPatterns(patterns string) []string {
+	var list []string
+	for _, p := range strings.Split(patterns, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// matchAnyPattern returns true if the name matches any of the glob patterns.
+func matchAnyPattern(patterns, name string) bool {
+	for _, p := range splitPatterns(patterns) {
+		if matched, _ := path.Match(p, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesRepo returns true if the repository name matches the rule set, names
+// are case-insensitive.
+func (rs *OrgRuleSet) MatchesRepo(name string) bool {
+	return matchAnyPattern(strings.ToLower(rs.RepoPatterns), strings.ToLower(name))
+}
+
+// MatchesBranch returns true if the branch is protected by the rule set.
+func (rs *OrgRuleSet) MatchesBranch(name string) bool {
+	return matchAnyPattern(rs.BranchPatterns, name)
+}
+
+// IsForbiddenFile returns true if the file cannot be added or modified by
+// pushed commits.
+func (rs *OrgRuleSet) IsForbiddenFile(treePath string) bool {
+	return matchAnyPattern(rs.ForbiddenFilePatterns, treePath) ||
+		matchAnyPattern(rs.ForbiddenFilePatterns, path.Base(treePath))
+}
+
+// HasPushRules returns true if pushed commits are checked by the rule set.
+func (rs *OrgRuleSet) HasPushRules() bool {
+	return rs.CommitMessagePattern != "" || len(splitPatterns(rs.ForbiddenFilePatterns)) > 0
+}
+
+// Validate returns ErrOrgRuleSetInvalid if any pattern cannot be parsed.
+func (rs *OrgRuleSet) Validate() error {
+	if strings.TrimSpace(rs.Name) == "" {
+		return ErrOrgRuleSetInvalid{Field: "name", Reason: "name is empty"}
+	}
+	for _, f := range []struct {
+		name     string
+		patterns string
+		optional bool
+	}{
+		{"repo_patterns", rs.RepoPatterns, false},
+		{"branch_patterns", rs.BranchPatterns, false},
+		{"forbidden_file_patterns", rs.ForbiddenFilePatterns, true},
+	} {
+		list := splitPatterns(f.patterns)
+		if len(list) == 0 && !f.optional {
+			return ErrOrgRuleSetInvalid{Field: f.name, Reason: "no pattern is specified"}
+		}
+		for _, p := range list {
+			if _, err := path.Match(p, ""); err != nil {
+				return ErrOrgRuleSetInvalid{Field: f.name, Reason: fmt.Sprintf("bad pattern %q", p)}
+			}
+		}
+	}
+	if _, err := regexp.Compile(rs.CommitMessagePattern); err != nil {
+		return ErrOrgRuleSetInvalid{Field: "commit_message_pattern", Reason: err.Error()}
+	}
+	return nil
+}
+
+// GetOrgRuleSets returns all rule sets of the organization.
+func GetOrgRuleSets(orgID int64) ([]*OrgRuleSet, error) {
+	ruleSets := make([]*OrgRuleSet, 0, 5)
+	return ruleSets, x.Where("org_id = ?", orgID).Asc("name").Find(&ruleSets)
+}
+
+// GetOrgRuleSetByID returns the rule set of the organization with given ID.
+func GetOrgRuleSetByID(orgID, id int64) (*OrgRuleSet, error) {
+	rs := new(OrgRuleSet)
+	has, err := x.Where("id = ? AND org_id = ?", id, orgID).Get(rs)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, errors.OrgRuleSetNotExist{ID: id}
+	}
+	return rs, nil
+}
+
+// CreateOrgRuleSet creates a rule set of the organization.
+func CreateOrgRuleSet(doer *User, rs *OrgRuleSet) error {
+	if err := rs.Validate(); err != nil {
+		return err
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err := sess.Insert(rs); err != nil {
+		return fmt.Errorf("insert: %v", err)
+	} else if err = addOrgRuleSetAudit(sess, doer, rs.OrgID, ORG_RULE_AUDIT_CREATE, rs.Name, ""); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+// UpdateOrgRuleSet updates the rule set of the organization.
+func UpdateOrgRuleSet(doer *User, rs *OrgRuleSet) error {
+	if err := rs.Validate(); err != nil {
+		return err
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err := sess.ID(rs.ID).AllCols().Update(rs); err != nil {
+		return fmt.Errorf("update: %v", err)
+	} else if err = addOrgRuleSetAudit(sess, doer, rs.OrgID, ORG_RULE_AUDIT_UPDATE, rs.Name, ""); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+// DeleteOrgRuleSet deletes the rule set and its exemptions.
+func DeleteOrgRuleSet(doer *User, rs *OrgRuleSet) error {
+	sess := x.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err := sess.ID(rs.ID).Delete(new(OrgRuleSet)); err != nil {
+		return fmt.Errorf("delete rule set: %v", err)
+	} else if _, err = sess.Delete(&OrgRuleSetExemption{RuleSetID: rs.ID}); err != nil {
+		return fmt.Errorf("delete exemptions: %v", err)
+	} else if err = addOrgRuleSetAudit(sess, doer, rs.OrgID, ORG_RULE_AUDIT_DELETE, rs.Name, ""); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+// OrgRuleExemptionStatus is the status of an exemption from a rule set.
+type OrgRuleExemptionStatus int
+
+const (
+	ORG_RULE_EXEMPTION_PENDING OrgRuleExemptionStatus = iota
+	ORG_RULE_EXEMPTION_APPROVED
+	ORG_RULE_EXEMPTION_REJECTED
+)
+
+// OrgRuleSetExemption is an exemption of a repository from a rule set, which
+// is requested by admins of the repository and granted by owners of the
+// organization.
+type OrgRuleSetExemption struct {
+	ID          int64
+	OrgID       int64       `xorm:"INDEX"`
+	RuleSetID   int64       `xorm:"UNIQUE(s)"`
+	RuleSet     *OrgRuleSet `xorm:"-" json:"-"`
+	RepoID      int64       `xorm:"UNIQUE(s)"`
+	Repo        *Repository `xorm:"-" json:"-"`
+	RequesterID int64
+	Requester   *User  `xorm:"-" json:"-"`
+	Reason      string `xorm:"TEXT"`
+	Status      OrgRuleExemptionStatus
+	ReviewerID  int64
+
+	Updated     time.Time `xorm:"-" json:"-"`
+	UpdatedUnix int64
+}
+
+func (e *OrgRuleSetExemption) BeforeInsert() {
+	e.UpdatedUnix = time.Now().Unix()
+}
+
+func (e *OrgRuleSetExemption) BeforeUpdate() {
+	e.UpdatedUnix = time.Now().Unix()
+}
+
+func (e *OrgRuleSetExemption) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "updated_unix":
+		e.Updated = time.Unix(e.UpdatedUnix, 0).Local()
+	}
+}
+
+func (e *OrgRuleSetExemption) IsPending() bool {
+	return e.Status == ORG_RULE_EXEMPTION_PENDING
+}
+
+func (e *OrgRuleSetExemption) IsApproved() bool {
+	return e.Status == ORG_RULE_EXEMPTION_APPROVED
+}
+
+func (e *OrgRuleSetExemption) IsRejected() bool {
+	return e.Status == ORG_RULE_EXEMPTION_REJECTED
+}
+
+// GetOrgRuleSetExemptions returns exemptions of rule sets of the organization
+// with the rule set, the repository and the requester loaded, pending ones
+// first.
+func GetOrgRuleSetExemptions(orgID int64) ([]*OrgRuleSetExemption, error) {
+	exemptions := make([]*OrgRuleSetExemption, 0, 5)
+	if err := x.Where("org_id = ?", orgID).Asc("status").Desc("updated_unix").Find(&exemptions); err != nil {
+		return nil, err
+	}
+
+	ruleSets := make(map[int64]*OrgRuleSet)
+	for _, e := range exemptions {
+		if ruleSets[e.RuleSetID] == nil {
+			rs, err := GetOrgRuleSetByID(orgID, e.RuleSetID)
+			if err != nil {
+				return nil, fmt.Errorf("GetOrgRuleSetByID [%d]: %v", e.RuleSetID, err)
+			}
+			ruleSets[e.RuleSetID] = rs
+		}
+		e.RuleSet = ruleSets[e.RuleSetID]
+
+		var err error
+		if e.Repo, err = GetRepositoryByID(e.RepoID); err != nil {
+			return nil, fmt.Errorf("GetRepositoryByID [%d]: %v", e.RepoID, err)
+		}
+		if e.Requester, err = GetUserByID(e.RequesterID); err != nil {
+			if !errors.IsUserNotExist(err) {
+				return nil, fmt.Errorf("GetUserByID [%d]: %v", e.RequesterID, err)
+			}
+			e.Requester = NewGhostUser()
+		}
+	}
+	return exemptions, nil
+}
+
+// RequestOrgRuleSetExemption requests an exemption of the repository from the
+// rule set of its organization, or requests again after it has been rejected.
+func RequestOrgRuleSetExemption(doer *User, repo *Repository, ruleSetID int64, reason string) error {
+	rs, err := GetOrgRuleSetByID(repo.OwnerID, ruleSetID)
+	if err != nil {
+		return err
+	} else if !rs.MatchesRepo(repo.Name) {
+		return errors.OrgRuleSetNotExist{ID: ruleSetID}
+	}
+
+	e := new(OrgRuleSetExemption)
+	has, err := x.Where("rule_set_id = ? AND repo_id = ?", rs.ID, repo.ID).Get(e)
+	if err != nil {
+		return err
+	} else if has && e.Status != ORG_RULE_EXEMPTION_REJECTED {
+		return nil
+	}
+	e.OrgID = repo.OwnerID
+	e.RuleSetID = rs.ID
+	e.RepoID = repo.ID
+	e.RequesterID = doer.ID
+	e.Reason = reason
+	e.Status = ORG_RULE_EXEMPTION_PENDING
+	e.ReviewerID = 0
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if has {
+		_, err = sess.ID(e.ID).AllCols().Update(e)
+	} else {
+		_, err = sess.Insert(e)
+	}
+	if err != nil {
+		return fmt.Errorf("save exemption: %v", err)
+	} else if err = addOrgRuleSetAudit(sess, doer, repo.OwnerID, ORG_RULE_AUDIT_REQUEST_EXEMPTION, rs.Name, repo.Name); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+// ReviewOrgRuleSetExemption approves or rejects the exemption from a rule set
+// of the organization. Approved exemptions can be revoked by rejecting them.
+func ReviewOrgRuleSetExemption(doer *User, orgID, id int64, approve bool) error {
+	e := new(OrgRuleSetExemption)
+	has, err := x.Where("id = ? AND org_id = ?", id, orgID).Get(e)
+	if err != nil {
+		return err
+	} else if !has {
+		return errors.OrgRuleSetExemptionNotExist{ID: id}
+	}
+
+	rs, err := GetOrgRuleSetByID(orgID, e.RuleSetID)
+	if err != nil {
+		return fmt.Errorf("GetOrgRuleSetByID [%d]: %v", e.RuleSetID, err)
+	}
+	repo, err := GetRepositoryByID(e.RepoID)
+	if err != nil {
+		return fmt.Errorf("GetRepositoryByID [%d]: %v", e.RepoID, err)
+	}
+
+	action := ORG_RULE_AUDIT_REJECT_EXEMPTION
+	e.Status = ORG_RULE_EXEMPTION_REJECTED
+	if approve {
+		action = ORG_RULE_AUDIT_APPROVE_EXEMPTION
+		e.Status = ORG_RULE_EXEMPTION_APPROVED
+	}
+	e.ReviewerID = doer.ID
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err = sess.ID(e.ID).Cols("status", "reviewer_id", "updated_unix").Update(e); err != nil {
+		return fmt.Errorf("update exemption: %v", err)
+	} else if err = addOrgRuleSetAudit(sess, doer, orgID, action, rs.Name, repo.Name); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+// Actions of audit entries of rule sets.
+const (
+	ORG_RULE_AUDIT_CREATE            = "create"
+	ORG_RULE_AUDIT_UPDATE            = "update"
+	ORG_RULE_AUDIT_DELETE            = "delete"
+	ORG_RULE_AUDIT_REQUEST_EXEMPTION = "request_exemption"
+	ORG_RULE_AUDIT_APPROVE_EXEMPTION = "approve_exemption"
+	ORG_RULE_AUDIT_REJECT_EXEMPTION  = "reject_exemption"
+)
+
+// OrgRuleSetAudit is an entry of the audit trail of rule sets of an
+// organization. Names are saved as they were, so entries are kept after rule
+// sets, repositories or users are deleted or renamed.
+type OrgRuleSetAudit struct {
+	ID          int64
+	OrgID       int64 `xorm:"INDEX"`
+	DoerID      int64
+	DoerName    string
+	Action      string
+	RuleSetName string
+	RepoName    string
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+}
+
+func (a *OrgRuleSetAudit) BeforeInsert() {
+	a.CreatedUnix = time.Now().Unix()
+}
+
+func (a *OrgRuleSetAudit) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		a.Created = time.Unix(a.CreatedUnix, 0).Local()
+	}
+}
+
+func addOrgRuleSetAudit(e Engine, doer *User, orgID int64, action, ruleSetName, repoName string) error {
+	if _, err := e.Insert(&OrgRuleSetAudit{
+		OrgID:       orgID,
+		DoerID:      doer.ID,
+		DoerName:    doer.Name,
+		Action:      action,
+		RuleSetName: ruleSetName,
+		RepoName:    repoName,
+	}); err != nil {
+		return fmt.Errorf("insert audit entry: %v", err)
+	}
+	return nil
+}
+
+// GetOrgRuleSetAudits returns latest entries of the audit trail of rule sets
+// of the organization.
+func GetOrgRuleSetAudits(orgID int64, limit int) ([]*OrgRuleSetAudit, error) {
+	audits := make([]*OrgRuleSetAudit, 0, limit)
+	return audits, x.Where("org_id = ?", orgID).Desc("id").Limit(limit).Find(&audits)
+}
+
+// InheritedRuleSet is a rule set of the organization that applies to the
+// repository, with the exemption of the repository if ever requested.
+type InheritedRuleSet struct {
+	*OrgRuleSet
+	Exemption *OrgRuleSetExemption
+}
+
+// IsExempted returns true if the repository is exempted from the rule set.
+func (rs *InheritedRuleSet) IsExempted() bool {
+	return rs.Exemption != nil && rs.Exemption.IsApproved()
+}
+
+// InheritedRuleSets returns rule sets of the organization that match the
+// repository, including those the repository is exempted from.
+func (repo *Repository) InheritedRuleSets() ([]*InheritedRuleSet, error) {
+	all, err := GetOrgRuleSets(repo.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("GetOrgRuleSets: %v", err)
+	}
+
+	ruleSets := make([]*InheritedRuleSet, 0, len(all))
+	for _, rs := range all {
+		if !rs.MatchesRepo(repo.Name) {
+			continue
+		}
+
+		e := new(OrgRuleSetExemption)
+		has, err := x.Where("rule_set_id = ? AND repo_id = ?", rs.ID, repo.ID).Get(e)
+		if err != nil {
+			return nil, fmt.Errorf("get exemption: %v", err)
+		} else if !has {
+			e = nil
+		}
+		ruleSets = append(ruleSets, &InheritedRuleSet{
+			OrgRuleSet: rs,
+			Exemption:  e,
+		})
+	}
+	return ruleSets, nil
+}
+
+// OrgRuleSetsOfBranch returns rule sets of the organization that are enforced
+// on the branch of the repository.
+func (repo *Repository) OrgRuleSetsOfBranch(branch string) ([]*OrgRuleSet, error) {
+	inherited, err := repo.InheritedRuleSets()
+	if err != nil {
+		return nil, err
+	}
+
+	ruleSets := make([]*OrgRuleSet, 0, len(inherited))
+	for _, rs := range inherited {
+		if !rs.IsExempted() && rs.MatchesBranch(branch) {
+			ruleSets = append(ruleSets, rs.OrgRuleSet)
+		}
+	}
+	return ruleSets, nil
+}
+
+// orgRequirePullRequest returns true if any rule set of the organization
+// requires pull requests to change the branch.
+func (repo *Repository) orgRequirePullRequest(branch string) (bool, error) {
+	ruleSets, err := repo.OrgRuleSetsOfBranch(branch)
+	if err != nil {
+		return false, err
+	}
+	for _, rs := range ruleSets {
+		if rs.RequirePullRequest {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CheckOrgRuleSetsForPush checks the push that changes the branch from the old
+// commit to the new one against the rule sets, in the repository at the path.
+// The old commit is EMPTY_SHA for new branches, and the new commit is
+// EMPTY_SHA for deletions. It returns ErrOrgRuleViolation of the first
+// violation.
+func CheckOrgRuleSetsForPush(repoPath string, ruleSets []*OrgRuleSet, branch, oldCommitID, newCommitID string) error {
+	if len(ruleSets) == 0 {
+		return nil
+	}
+
+	for _, rs := range ruleSets {
+		switch {
+		case rs.RequirePullRequest:
+			return ErrOrgRuleViolation{RuleSet: rs.Name, Branch: branch, Reason: "commits must be merged through pull request"}
+		case newCommitID == git.EMPTY_SHA:
+			return ErrOrgRuleViolation{RuleSet: rs.Name, Branch: branch, Reason: "the branch cannot be deleted"}
+		}
+	}
+
+	revs := []string{newCommitID, "--not", "--branches"}
+	if oldCommitID != git.EMPTY_SHA {
+		output, err := git.NewCommand("rev-list", "--max-count=1", oldCommitID, "^"+newCommitID).RunInDir(repoPath)
+		if err != nil {
+			return fmt.Errorf("detect force push: %v", err)
+		} else if len(output) > 0 {
+			return ErrOrgRuleViolation{RuleSet: ruleSets[0].Name, Branch: branch, Reason: "force push is not allowed"}
+		}
+		revs = []string{oldCommitID + ".." + newCommitID}
+	}
+	return checkOrgRuleSetCommits(repoPath, ruleSets, branch, revs)
+}
+
+// checkOrgRuleSetCommits checks messages and changed files of commits in the
+// revision range against push rules of the rule sets.
+func checkOrgRuleSetCommits(repoPath string, ruleSets []*OrgRuleSet, branch string, revs []string) error {
+	hasPushRules := false
+	for _, rs := range ruleSets {
+		hasPushRules = hasPushRules || rs.HasPushRules()
+	}
+	if !hasPushRules {
+		return nil
+	}
+
+	// Each commit is the ID followed by the message, terminated by NUL.
+	output, err := git.NewCommand(append([]string{"log", "-z", "--format=%H%n%B"}, revs...)...).RunInDirBytes(repoPath)
+	if err != nil {
+		return fmt.Errorf("list commits: %v", err)
+	}
+	for _, entry := range bytes.Split(output, []byte{0}) {
+		entry = bytes.TrimLeft(entry, "\n")
+		if len(entry) == 0 {
+			continue
+		}
+		commitID, message := string(entry), ""
+		if i := bytes.IndexByte(entry, '\n'); i > -1 {
+			commitID, message = string(entry[:i]), string(entry[i+1:])
+		}
+
+		var files []string
+		for _, rs := range ruleSets {
+			if rs.CommitMessagePattern != "" {
+				pattern, err := regexp.Compile(rs.CommitMessagePattern)
+				if err != nil {
+					return fmt.Errorf("compile commit message pattern of rule set [%d]: %v", rs.ID, err)
+				} else if !pattern.MatchString(message) {
+					return ErrOrgRuleViolation{RuleSet: rs.Name, Branch: branch,
+						Reason: fmt.Sprintf("message of commit %s does not match %q", commitID, rs.CommitMessagePattern)}
+				}
+			}
+
+			if len(splitPatterns(rs.ForbiddenFilePatterns)) == 0 {
+				continue
+			}
+			if files == nil {
+				output, err := git.NewCommand("diff-tree", "-z", "--no-commit-id", "--name-only", "-r", "--root", "--diff-filter=d", commitID).RunInDirBytes(repoPath)
+				if err != nil {
+					return fmt.Errorf("list changed files of commit %s: %v", commitID, err)
+				}
+				files = []string{}
+				for _, name := range bytes.Split(output, []byte{0}) {
+					if len(name) > 0 {
+						files = append(files, string(name))
+					}
+				}
+			}
+			for _, name := range files {
+				if rs.IsForbiddenFile(name) {
+					return ErrOrgRuleViolation{RuleSet: rs.Name, Branch: branch,
+						Reason: fmt.Sprintf("commit %s changes forbidden file %q", commitID, name)}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// CheckOrgRuleSets checks commits of the pull request against push rules of
+// rule sets of the organization that are enforced on the base branch. This
+// method assumes fields of HeadRepo and BaseRepo have been loaded.
+func (pr *PullRequest) CheckOrgRuleSets() error {
+	ruleSets, err := pr.BaseRepo.OrgRuleSetsOfBranch(pr.BaseBranch)
+	if err != nil {
+		return fmt.Errorf("OrgRuleSetsOfBranch: %v", err)
+	}
+	return checkOrgRuleSetCommits(pr.HeadRepo.RepoPath(), ruleSets, pr.BaseBranch,
+		[]string{git.BRANCH_PREFIX + pr.HeadBranch, "^" + pr.MergeBase})
+}
@@ -0,0 +1,210 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/testutil"
+)
+
+func TestOrgRuleSet_Matches(t *testing.T) {
+	rs := &OrgRuleSet{
+		RepoPatterns:          "service-*, API",
+		BranchPatterns:        "master,release/*",
+		ForbiddenFilePatterns: "*.pem, vendor/*",
+	}
+
+	Convey("Match repositories by case-insensitive names", t, func() {
+		So(rs.MatchesRepo("service-auth"), ShouldBeTrue)
+		So(rs.MatchesRepo("Service-Auth"), ShouldBeTrue)
+		So(rs.MatchesRepo("api"), ShouldBeTrue)
+		So(rs.MatchesRepo("web"), ShouldBeFalse)
+	})
+
+	Convey("Match branches", t, func() {
+		So(rs.MatchesBranch("master"), ShouldBeTrue)
+		So(rs.MatchesBranch("release/1.0"), ShouldBeTrue)
+		So(rs.MatchesBranch("release/1.0/fix"), ShouldBeFalse)
+		So(rs.MatchesBranch("Master"), ShouldBeFalse)
+	})
+
+	Convey("Match forbidden files by paths or base names", t, func() {
+		So(rs.IsForbiddenFile("key.pem"), ShouldBeTrue)
+		So(rs.IsForbiddenFile("conf/tls/key.pem"), ShouldBeTrue)
+		So(rs.IsForbiddenFile("vendor/lib.go"), ShouldBeTrue)
+		So(rs.IsForbiddenFile("internal/vendor/lib.go"), ShouldBeFalse)
+		So(rs.HasPushRules(), ShouldBeTrue)
+		So((&OrgRuleSet{ForbiddenFilePatterns: " , "}).HasPushRules(), ShouldBeFalse)
+	})
+}
+
+func TestOrgRuleSet_Validate(t *testing.T) {
+	Convey("Validate rule sets", t, func() {
+		field := func(rs *OrgRuleSet) string {
+			err := rs.Validate()
+			if err == nil {
+				return ""
+			}
+			return err.(ErrOrgRuleSetInvalid).Field
+		}
+
+		So(field(&OrgRuleSet{Name: "default", RepoPatterns: "*", BranchPatterns: "master"}), ShouldBeEmpty)
+		So(field(&OrgRuleSet{Name: " ", RepoPatterns: "*", BranchPatterns: "master"}), ShouldEqual, "name")
+		So(field(&OrgRuleSet{Name: "default", RepoPatterns: ",", BranchPatterns: "master"}), ShouldEqual, "repo_patterns")
+		So(field(&OrgRuleSet{Name: "default", RepoPatterns: "*", BranchPatterns: "[master"}), ShouldEqual, "branch_patterns")
+		So(field(&OrgRuleSet{Name: "default", RepoPatterns: "*", BranchPatterns: "master", ForbiddenFilePatterns: "[x"}), ShouldEqual, "forbidden_file_patterns")
+		So(field(&OrgRuleSet{Name: "default", RepoPatterns: "*", BranchPatterns: "master", CommitMessagePattern: "("}), ShouldEqual, "commit_message_pattern")
+	})
+}
+
+func TestCheckOrgRuleSetsForPush(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	repo.SetAuthor("alice", "alice@example.com")
+	base := repo.CommitFile("README.md", "base")
+	next := repo.Commit("GOGS-1: change nothing")
+	key := repo.CommitFile("conf/key.pem", "secret")
+
+	// Commits of new branches are not yet in any branch when pushed.
+	repo.Git("checkout", "--quiet", "--detach", base)
+	repo.WriteFile("main.go", "package main")
+	detached := repo.Commit("GOGS-2: add main")
+	unticketed := repo.Commit("add nothing")
+	repo.Git("checkout", "--quiet", "master")
+
+	check := func(ruleSets []*OrgRuleSet, oldCommitID, newCommitID string) string {
+		err := CheckOrgRuleSetsForPush(repo.Dir, ruleSets, "master", oldCommitID, newCommitID)
+		if err == nil {
+			return ""
+		}
+		So(IsErrOrgRuleViolation(err), ShouldBeTrue)
+		return err.(ErrOrgRuleViolation).Reason
+	}
+	protected := []*OrgRuleSet{{Name: "protected"}}
+	ticketed := []*OrgRuleSet{{Name: "protected"}, {Name: "ticketed", CommitMessagePattern: `^GOGS-\d+:`}}
+
+	Convey("Allow pushes without rule sets", t, func() {
+		So(check(nil, next, base), ShouldBeEmpty)
+	})
+
+	Convey("Reject pushes to branches requiring pull requests", t, func() {
+		So(check([]*OrgRuleSet{{Name: "reviewed", RequirePullRequest: true}}, base, next), ShouldEqual, "commits must be merged through pull request")
+	})
+
+	Convey("Reject deletion and force pushes", t, func() {
+		So(check(protected, next, git.EMPTY_SHA), ShouldEqual, "the branch cannot be deleted")
+		So(check(protected, next, detached), ShouldEqual, "force push is not allowed")
+		So(check(protected, base, next), ShouldBeEmpty)
+	})
+
+	Convey("Check commit messages", t, func() {
+		So(check(ticketed, base, next), ShouldBeEmpty)
+		So(check(ticketed, next, key), ShouldEqual, `message of commit `+key+` does not match "^GOGS-\\d+:"`)
+
+		Convey("Only check commits that are new to the repository for new branches", func() {
+			So(check(ticketed, git.EMPTY_SHA, detached), ShouldBeEmpty)
+			So(check(ticketed, git.EMPTY_SHA, unticketed), ShouldEqual, `message of commit `+unticketed+` does not match "^GOGS-\\d+:"`)
+		})
+	})
+
+	Convey("Check changed files", t, func() {
+		ruleSets := []*OrgRuleSet{{Name: "secrets", ForbiddenFilePatterns: "*.pem"}}
+		So(check(ruleSets, base, next), ShouldBeEmpty)
+		So(check(ruleSets, next, key), ShouldEqual, `commit `+key+` changes forbidden file "conf/key.pem"`)
+	})
+}
+
+func TestRepository_InheritedRuleSets(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "alice", LowerName: "alice"}
+	org := &User{Name: "acme", LowerName: "acme", Type: USER_TYPE_ORGANIZATION}
+	insertTestBeans(t, owner, org)
+	repo := &Repository{OwnerID: org.ID, Name: "service-auth", LowerName: "service-auth"}
+	insertTestBeans(t, repo)
+
+	services := &OrgRuleSet{OrgID: org.ID, Name: "services", RepoPatterns: "service-*", BranchPatterns: "master"}
+	releases := &OrgRuleSet{OrgID: org.ID, Name: "releases", RepoPatterns: "*", BranchPatterns: "release/*", RequirePullRequest: true}
+	web := &OrgRuleSet{OrgID: org.ID, Name: "web", RepoPatterns: "web", BranchPatterns: "*"}
+	for _, rs := range []*OrgRuleSet{services, releases, web} {
+		if err := CreateOrgRuleSet(owner, rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := func(ruleSets []*OrgRuleSet) []string {
+		names := make([]string, len(ruleSets))
+		for i := range ruleSets {
+			names[i] = ruleSets[i].Name
+		}
+		return names
+	}
+
+	Convey("Inherit rule sets matching the repository", t, func() {
+		inherited, err := repo.InheritedRuleSets()
+		So(err, ShouldBeNil)
+		So(inherited, ShouldHaveLength, 2)
+		So(inherited[0].Name, ShouldEqual, "releases")
+		So(inherited[1].Name, ShouldEqual, "services")
+		So(inherited[1].Exemption, ShouldBeNil)
+
+		ruleSets, err := repo.OrgRuleSetsOfBranch("master")
+		So(err, ShouldBeNil)
+		So(names(ruleSets), ShouldResemble, []string{"services"})
+
+		required, err := repo.orgRequirePullRequest("release/1.0")
+		So(err, ShouldBeNil)
+		So(required, ShouldBeTrue)
+	})
+
+	var exemption *OrgRuleSetExemption
+	Convey("Exempt repositories from rule sets", t, func() {
+		So(errors.IsOrgRuleSetNotExist(RequestOrgRuleSetExemption(owner, repo, web.ID, "not a web app")), ShouldBeTrue)
+		So(RequestOrgRuleSetExemption(owner, repo, services.ID, "legacy"), ShouldBeNil)
+
+		inherited, err := repo.InheritedRuleSets()
+		So(err, ShouldBeNil)
+		exemption = inherited[1].Exemption
+		So(exemption.IsPending(), ShouldBeTrue)
+		So(inherited[1].IsExempted(), ShouldBeFalse)
+
+		So(ReviewOrgRuleSetExemption(owner, org.ID, exemption.ID, true), ShouldBeNil)
+		ruleSets, err := repo.OrgRuleSetsOfBranch("master")
+		So(err, ShouldBeNil)
+		So(ruleSets, ShouldBeEmpty)
+	})
+
+	Convey("Keep approved exemptions when requested again", t, func() {
+		So(RequestOrgRuleSetExemption(owner, repo, services.ID, "again"), ShouldBeNil)
+		ruleSets, err := repo.OrgRuleSetsOfBranch("master")
+		So(err, ShouldBeNil)
+		So(ruleSets, ShouldBeEmpty)
+	})
+
+	Convey("Record exemptions in the audit trail", t, func() {
+		audits, err := GetOrgRuleSetAudits(org.ID, 10)
+		So(err, ShouldBeNil)
+		So(audits[0].Action, ShouldEqual, ORG_RULE_AUDIT_APPROVE_EXEMPTION)
+		So(audits[1].Action, ShouldEqual, ORG_RULE_AUDIT_REQUEST_EXEMPTION)
+		So(audits[1].RepoName, ShouldEqual, "service-auth")
+	})
+
+	Convey("Revoke exemptions by rejecting them", t, func() {
+		So(ReviewOrgRuleSetExemption(owner, org.ID, exemption.ID, false), ShouldBeNil)
+		ruleSets, err := repo.OrgRuleSetsOfBranch("master")
+		So(err, ShouldBeNil)
+		So(names(ruleSets), ShouldResemble, []string{"services"})
+
+		So(RequestOrgRuleSetExemption(owner, repo, services.ID, "still legacy"), ShouldBeNil)
+		inherited, err := repo.InheritedRuleSets()
+		So(err, ShouldBeNil)
+		So(inherited[1].Exemption.IsPending(), ShouldBeTrue)
+		So(inherited[1].Exemption.Reason, ShouldEqual, "still legacy")
+	})
+}
@@ -657,8 +657,12 @@ func RemoveTeamRepo(teamID, repoID int64) error {
 
 // GetTeamsHaveAccessToRepo returns all teams in an organization that have given access level to the repository.
 func GetTeamsHaveAccessToRepo(orgID, repoID int64, mode AccessMode) ([]*Team, error) {
+	return getTeamsHaveAccessToRepo(x, orgID, repoID, mode)
+}
+
+func getTeamsHaveAccessToRepo(e Engine, orgID, repoID int64, mode AccessMode) ([]*Team, error) {
 	teams := make([]*Team, 0, 5)
-	return teams, x.Where("team.authorize >= ?", mode).
+	return teams, e.Where("team.authorize >= ?", mode).
 		Join("INNER", "team_repo", "team_repo.team_id = team.id").
 		And("team_repo.org_id = ?", orgID).
 		And("team_repo.repo_id = ?", repoID).
@@ -0,0 +1,148 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/nfnt/resize"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/imageutil"
+)
+
+// prepareAvatar returns the content to be saved for the uploaded avatar. The
+// avatar is oriented upright and resized to the configured size, which also
+// drops any metadata. Animated GIF avatars keep their animation when enabled.
+// It falls back to the original content when the image cannot be processed,
+// except for images with too many pixels, which are rejected.
+func prepareAvatar(data []byte) ([]byte, error) {
+	_, format, err := imageutil.DecodeConfig(data, conf.Picture.MaxImagePixels)
+	if err != nil {
+		if imageutil.IsErrTooManyPixels(err) {
+			return nil, err
+		}
+		log.Warn("Failed to decode avatar config, storing the original: %v", err)
+		return stripMetadata(data), nil
+	}
+
+	size := conf.Picture.AvatarSize
+	if format == "gif" && conf.Picture.EnableAnimatedAvatar {
+		g, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			log.Warn("Failed to decode GIF avatar, storing the original: %v", err)
+			return stripMetadata(data), nil
+		}
+
+		if len(g.Image) > 1 {
+			var buf bytes.Buffer
+			if err = gif.EncodeAll(&buf, imageutil.ResizeGIF(g, size, size)); err != nil {
+				log.Warn("Failed to encode GIF avatar, storing the original: %v", err)
+				return stripMetadata(data), nil
+			}
+			return buf.Bytes(), nil
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		log.Warn("Failed to decode avatar, storing the original: %v", err)
+		return stripMetadata(data), nil
+	}
+	img = imageutil.Orient(img, imageutil.Orientation(data))
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, resize.Resize(size, size, img, resize.Bilinear)); err != nil {
+		log.Warn("Failed to encode avatar, storing the original: %v", err)
+		return stripMetadata(data), nil
+	}
+	return buf.Bytes(), nil
+}
+
+// stripMetadata strips metadata of the image when it is enabled. It falls
+// back to the original content when failed.
+func stripMetadata(data []byte) []byte {
+	if !conf.Picture.StripEXIF {
+		return data
+	}
+
+	stripped, err := imageutil.StripMetadata(data)
+	if err != nil {
+		log.Warn("Failed to strip image metadata, storing the original: %v", err)
+		return data
+	}
+	return stripped
+}
+
+// prepareAttachmentImage returns the content to be saved for the uploaded
+// image attachment, and a downscaled preview when the image is larger than the
+// configured preview size. The preview is nil when not needed or the image
+// cannot be processed, and the returned content falls back to the original in
+// the latter case.
+func prepareAttachmentImage(data []byte) (content, preview []byte) {
+	cfg, format, err := imageutil.DecodeConfig(data, conf.Picture.MaxImagePixels)
+	if err != nil {
+		log.Warn("Skipped processing attachment image, storing the original: %v", err)
+		return data, nil
+	}
+
+	orientation := 1
+	if conf.Picture.StripEXIF {
+		orientation = imageutil.Orientation(data)
+	}
+	maxSize := int(conf.Picture.AttachmentPreviewSize)
+	needsPreview := cfg.Width > maxSize || cfg.Height > maxSize
+	// GIFs are rendered as-is to keep the possible animation.
+	if format == "gif" {
+		needsPreview = false
+	}
+
+	content = data
+	if orientation == 1 {
+		content = stripMetadata(data)
+		if !needsPreview {
+			return content, nil
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		log.Warn("Failed to decode attachment, storing the original: %v", err)
+		return data, nil
+	}
+
+	if orientation > 1 {
+		// The image has to be re-encoded after orienting, which drops all
+		// metadata.
+		img = imageutil.Orient(img, orientation)
+		var buf bytes.Buffer
+		if err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+			log.Warn("Failed to encode attachment, storing the original: %v", err)
+			return data, nil
+		}
+		content = buf.Bytes()
+	}
+	if !needsPreview {
+		return content, nil
+	}
+
+	thumb := imageutil.Thumbnail(conf.Picture.AttachmentPreviewSize, conf.Picture.AttachmentPreviewSize, img)
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85})
+	} else {
+		err = png.Encode(&buf, thumb)
+	}
+	if err != nil {
+		log.Warn("Failed to encode attachment preview: %v", err)
+		return content, nil
+	}
+	return content, buf.Bytes()
+}
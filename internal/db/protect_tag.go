@@ -0,0 +1,112 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// ProtectTag is a glob pattern of tag names in a repository, e.g. "v*". Tags
+// that match are protected from deletion and from being moved to other
+// commits, but new tags can still be pushed.
+type ProtectTag struct {
+	ID      int64
+	RepoID  int64  `xorm:"UNIQUE(s)"`
+	Pattern string `xorm:"UNIQUE(s)"`
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+}
+
+func (t *ProtectTag) BeforeInsert() {
+	t.CreatedUnix = time.Now().Unix()
+}
+
+func (t *ProtectTag) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		t.Created = time.Unix(t.CreatedUnix, 0).Local()
+	}
+}
+
+// Match returns true if the tag is protected by the pattern.
+func (t *ProtectTag) Match(tagName string) bool {
+	matched, _ := path.Match(t.Pattern, tagName)
+	return matched
+}
+
+// GetProtectTags returns protected tag patterns of the repository.
+func GetProtectTags(repoID int64) ([]*ProtectTag, error) {
+	tags := make([]*ProtectTag, 0, 3)
+	return tags, x.Where("repo_id = ?", repoID).Asc("pattern").Find(&tags)
+}
+
+// MatchProtectTags returns true if the tag is protected by any of the
+// patterns.
+func MatchProtectTags(tags []*ProtectTag, tagName string) bool {
+	for _, t := range tags {
+		if t.Match(tagName) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTagProtected returns true if the tag of the repository is protected.
+func IsTagProtected(repoID int64, tagName string) (bool, error) {
+	tags, err := GetProtectTags(repoID)
+	if err != nil {
+		return false, err
+	}
+	return MatchProtectTags(tags, tagName), nil
+}
+
+// AddProtectTag protects tags of the repository that match the pattern, an
+// existing pattern is not added again.
+func AddProtectTag(repoID int64, pattern string) error {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return ErrInvalidTagPattern{Pattern: pattern}
+	} else if _, err := path.Match(pattern, ""); err != nil {
+		return ErrInvalidTagPattern{Pattern: pattern}
+	}
+
+	t := &ProtectTag{
+		RepoID:  repoID,
+		Pattern: pattern,
+	}
+	has, err := x.Get(t)
+	if err != nil {
+		return err
+	} else if has {
+		return nil
+	}
+	if _, err = x.Insert(t); err != nil {
+		return fmt.Errorf("insert: %v", err)
+	}
+	return nil
+}
+
+// DeleteProtectTag deletes the protected tag pattern of the repository.
+func DeleteProtectTag(repoID, id int64) error {
+	if id <= 0 {
+		return errors.ProtectTagNotExist{ID: id, RepoID: repoID}
+	}
+
+	affected, err := x.Where("id = ? AND repo_id = ?", id, repoID).Delete(new(ProtectTag))
+	if err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ProtectTagNotExist{ID: id, RepoID: repoID}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+func Test_MatchProtectTags(t *testing.T) {
+	Convey("Match tags with protected tag patterns", t, func() {
+		tags := []*ProtectTag{
+			{Pattern: "v*"},
+			{Pattern: "release-[0-9]*"},
+		}
+		So(MatchProtectTags(tags, "v1.0.0"), ShouldBeTrue)
+		So(MatchProtectTags(tags, "release-2020"), ShouldBeTrue)
+		So(MatchProtectTags(tags, "release-next"), ShouldBeFalse)
+		So(MatchProtectTags(tags, "nightly"), ShouldBeFalse)
+		So(MatchProtectTags(nil, "v1.0.0"), ShouldBeFalse)
+	})
+}
+
+func Test_DeleteProtectTag(t *testing.T) {
+	setupTestDB(t)
+
+	tags := []*ProtectTag{
+		{RepoID: 10, Pattern: "v*"},
+		{RepoID: 10, Pattern: "release-*"},
+		{RepoID: 11, Pattern: "v*"},
+	}
+	for _, tag := range tags {
+		insertTestBeans(t, tag)
+	}
+
+	Convey("Delete a protected tag pattern of the repository", t, func() {
+		Convey("Reject missing and foreign patterns", func() {
+			for _, id := range []int64{0, -1, tags[2].ID, 100} {
+				err := DeleteProtectTag(10, id)
+				So(errors.IsProtectTagNotExist(err), ShouldBeTrue)
+			}
+
+			count, err := x.Count(new(ProtectTag))
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 3)
+		})
+
+		So(DeleteProtectTag(10, tags[0].ID), ShouldBeNil)
+		count, err := x.Where("repo_id = ?", 10).Count(new(ProtectTag))
+		So(err, ShouldBeNil)
+		So(count, ShouldEqual, 1)
+	})
+}
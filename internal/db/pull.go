@@ -53,12 +53,12 @@ type PullRequest struct {
 	Issue   *Issue `xorm:"-" json:"-"`
 	Index   int64
 
-	HeadRepoID   int64
+	HeadRepoID   int64       `xorm:"INDEX(head)"`
 	HeadRepo     *Repository `xorm:"-" json:"-"`
 	BaseRepoID   int64
 	BaseRepo     *Repository `xorm:"-" json:"-"`
 	HeadUserName string
-	HeadBranch   string
+	HeadBranch   string `xorm:"INDEX(head)"`
 	BaseBranch   string
 	MergeBase    string `xorm:"VARCHAR(40)"`
 
@@ -68,6 +68,14 @@ type PullRequest struct {
 	Merger         *User     `xorm:"-" json:"-"`
 	Merged         time.Time `xorm:"-" json:"-"`
 	MergedUnix     int64
+
+	// CloseReason is set when the pull request is closed for a special reason,
+	// e.g. PULL_CLOSE_REASON_MERGED_ELSEWHERE.
+	CloseReason string
+
+	// Whether the pull request is being merged, mails of closing the pull
+	// request are not sent meanwhile.
+	merging bool `xorm:"-" json:"-"`
 }
 
 func (pr *PullRequest) BeforeUpdate() {
@@ -193,9 +201,30 @@ const (
 	MERGE_STYLE_REBASE  MergeStyle = "rebase_before_merging"
 )
 
-// Merge merges pull request to base repository.
+// Merge merges pull request to base repository. The message of the merge
+// commit is rendered by the template of the base repository if not specified,
+// and required trailers are always appended. It returns ErrOrgRuleViolation if
+// commits violate rule sets of the organization on the base branch, and
+// ErrMergeCommitNotAllowed if the base branch requires linear history but the
+// merge style creates a merge commit. The push of the merge is recorded in the
+// push log with the credential and the address of the request.
 // FIXME: add repoWorkingPull make sure two merges does not happen at same time.
-func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle MergeStyle, commitDescription string) (err error) {
+func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle MergeStyle, message string, credential PushCredential, remoteAddr string) (err error) {
+	// Check if merge style is allowed, reset to default style if not
+	if mergeStyle == MERGE_STYLE_REBASE && !pr.BaseRepo.PullsAllowRebase {
+		mergeStyle = MERGE_STYLE_REGULAR
+	}
+	if mergeStyle == MERGE_STYLE_REGULAR && IsBranchOfRepoRequireLinearHistory(pr.BaseRepoID, pr.BaseBranch) {
+		return ErrMergeCommitNotAllowed{Branch: pr.BaseBranch}
+	}
+
+	if err = pr.CheckOrgRuleSets(); err != nil {
+		return err
+	}
+	if err = pr.checkCodeOwnersApproval(); err != nil {
+		return err
+	}
+
 	defer func() {
 		go HookQueue.Add(pr.BaseRepo.ID)
 		go AddTestPullRequestTask(doer, pr.BaseRepo.ID, pr.BaseBranch, false)
@@ -207,7 +236,10 @@ func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle
 		return err
 	}
 
-	if err = pr.Issue.changeStatus(sess, doer, pr.Issue.Repo, true); err != nil {
+	pr.Issue.PullRequest = pr
+	pr.merging = true
+	defer func() { pr.merging = false }()
+	if err = pr.Issue.changeStatus(sess, doer, pr.Issue.Repo, true, ""); err != nil {
 		return fmt.Errorf("Issue.changeStatus: %v", err)
 	}
 
@@ -248,14 +280,20 @@ func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle
 
 	remoteHeadBranch := "head_repo/" + pr.HeadBranch
 
-	// Check if merge style is allowed, reset to default style if not
-	if mergeStyle == MERGE_STYLE_REBASE && !pr.BaseRepo.PullsAllowRebase {
-		mergeStyle = MERGE_STYLE_REGULAR
-	}
-
 	switch mergeStyle {
 	case MERGE_STYLE_REGULAR: // Create merge commit
 
+		if strings.TrimSpace(message) == "" {
+			prInfo, err := headGitRepo.GetPullRequestInfo(baseGitRepo.Path, pr.BaseBranch, pr.HeadBranch)
+			if err != nil {
+				return fmt.Errorf("GetPullRequestInfo: %v", err)
+			}
+			if message, err = pr.MergeMessage(doer, prInfo.Commits); err != nil {
+				log.Warn("Failed to render merge message [repo_id: %d]: %v", pr.BaseRepoID, err)
+			}
+		}
+		message = appendTrailers(message, pr.mergeTrailers())
+
 		// Merge changes from head branch.
 		if _, stderr, err = process.ExecDir(-1, tmpBasePath,
 			fmt.Sprintf("PullRequest.Merge (git merge --no-ff --no-commit): %s", tmpBasePath),
@@ -268,8 +306,7 @@ func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle
 		if _, stderr, err = process.ExecDir(-1, tmpBasePath,
 			fmt.Sprintf("PullRequest.Merge (git merge): %s", tmpBasePath),
 			"git", "commit", fmt.Sprintf("--author='%s <%s>'", sig.Name, sig.Email),
-			"-m", fmt.Sprintf("Merge branch '%s' of %s/%s into %s", pr.HeadBranch, pr.HeadUserName, pr.HeadRepo.Name, pr.BaseBranch),
-			"-m", commitDescription); err != nil {
+			"-m", message); err != nil {
 			return fmt.Errorf("git commit [%s]: %v - %s", tmpBasePath, err, stderr)
 		}
 
@@ -308,6 +345,28 @@ func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle
 		return fmt.Errorf("unknown merge style: %s", mergeStyle)
 	}
 
+	// The merge is pushed without Git hooks, so the push log is written here.
+	pushLog := &PushLog{
+		RepoID:         pr.BaseRepoID,
+		PusherID:       doer.ID,
+		PusherName:     doer.Name,
+		CredentialType: credential.Type,
+		CredentialID:   credential.ID,
+		CredentialName: credential.Name,
+		RemoteAddr:     remoteAddr,
+		RefName:        git.BRANCH_PREFIX + pr.BaseBranch,
+	}
+	if pushLog.OldCommitID, err = baseGitRepo.GetBranchCommitID(pr.BaseBranch); err != nil {
+		return fmt.Errorf("get commit of base branch: %v", err)
+	}
+	stdout, stderr, err := process.ExecDir(-1, tmpBasePath,
+		fmt.Sprintf("PullRequest.Merge (git rev-parse): %s", tmpBasePath),
+		"git", "rev-parse", "HEAD")
+	if err != nil {
+		return fmt.Errorf("git rev-parse: %s", stderr)
+	}
+	pushLog.NewCommitID = strings.TrimSpace(stdout)
+
 	// Push changes on base branch to upstream.
 	if _, stderr, err = process.ExecDir(-1, tmpBasePath,
 		fmt.Sprintf("PullRequest.Merge (git push): %s", tmpBasePath),
@@ -331,9 +390,15 @@ func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle
 		return fmt.Errorf("Commit: %v", err)
 	}
 
+	if err = AddPushLogs([]*PushLog{pushLog}); err != nil {
+		log.Error("Failed to add push log of merging pull request [%d]: %v", pr.ID, err)
+	}
 	if err = MergePullRequestAction(doer, pr.Issue.Repo, pr.Issue); err != nil {
 		log.Error("MergePullRequestAction [%d]: %v", pr.ID, err)
 	}
+	if err = pr.mailMerged(doer); err != nil {
+		log.Error("mailMerged [%d]: %v", pr.ID, err)
+	}
 
 	// Reload pull request information.
 	if err = pr.LoadAttributes(); err != nil {
@@ -369,22 +434,25 @@ func (pr *PullRequest) Merge(doer *User, baseGitRepo *git.Repository, mergeStyle
 		l.PushFront(mergeCommit)
 	}
 
-	commits, err := ListToPushCommits(l).ToApiPayloadCommits(pr.BaseRepo.RepoPath(), pr.BaseRepo.HTMLURL())
+	commits, err := ListToPushCommits(l).limit(conf.Webhook.MaxPayloadCommits).ToApiPayloadCommits(pr.BaseRepo.RepoPath(), pr.BaseRepo.HTMLURL())
 	if err != nil {
 		log.Error("ToApiPayloadCommits: %v", err)
 		return nil
 	}
 
-	p := &api.PushPayload{
+	// The merge is pushed by the doer, not by the owner of the head repository.
+	apiPusher := doer.APIFormat()
+	p := NewPushPayload(&api.PushPayload{
 		Ref:        git.BRANCH_PREFIX + pr.BaseBranch,
 		Before:     pr.MergeBase,
 		After:      mergeCommit.ID.String(),
 		CompareURL: conf.Server.ExternalURL + pr.BaseRepo.ComposeCompareURL(pr.MergeBase, pr.MergedCommitID),
 		Commits:    commits,
 		Repo:       pr.BaseRepo.APIFormat(nil),
-		Pusher:     pr.HeadRepo.MustOwner().APIFormat(),
-		Sender:     doer.APIFormat(),
-	}
+		Pusher:     apiPusher,
+		Sender:     apiPusher,
+	}, l.Len())
+	p.IsDefault = pr.BaseRepo.IsDefaultBranchRef(git.BRANCH_PREFIX + pr.BaseBranch)
 	if err = PrepareWebhooks(pr.BaseRepo, HOOK_EVENT_PUSH, p); err != nil {
 		log.Error("PrepareWebhooks: %v", err)
 		return nil
@@ -546,6 +614,26 @@ func GetUnmergedPullRequestsByBaseInfo(repoID int64, branch string) ([]*PullRequ
 		Join("INNER", "issue", "issue.id=pull_request.issue_id").Find(&prs)
 }
 
+// GetMergedPullRequestsByCommitIDs returns merged pull requests of the base
+// repository whose head commits are in given list, keyed by commit ID. Pull
+// requests merged by rebasing are not found because their commits are rewritten.
+func GetMergedPullRequestsByCommitIDs(baseRepoID int64, commitIDs []string) (map[string]*PullRequest, error) {
+	prs := make(map[string]*PullRequest, len(commitIDs))
+	if len(commitIDs) == 0 {
+		return prs, nil
+	}
+
+	list := make([]*PullRequest, 0, len(commitIDs))
+	if err := x.Where("base_repo_id = ? AND has_merged = ?", baseRepoID, true).
+		In("merged_commit_id", commitIDs).Find(&list); err != nil {
+		return nil, err
+	}
+	for _, pr := range list {
+		prs[pr.MergedCommitID] = pr
+	}
+	return prs, nil
+}
+
 func getPullRequestByID(e Engine, id int64) (*PullRequest, error) {
 	pr := new(PullRequest)
 	has, err := e.ID(id).Get(pr)
@@ -833,18 +921,18 @@ func TestPullRequests() {
 	// Start listening on new test requests.
 	for prID := range PullRequestQueue.Queue() {
 		log.Trace("TestPullRequests[%v]: processing test task", prID)
-		PullRequestQueue.Remove(prID)
-
-		pr, err := GetPullRequestByID(com.StrTo(prID).MustInt64())
-		if err != nil {
-			log.Error("GetPullRequestByID[%s]: %v", prID, err)
-			continue
-		} else if err = pr.testPatch(); err != nil {
-			log.Error("testPatch[%d]: %v", pr.ID, err)
-			continue
-		}
+		PullRequestQueue.Process(prID, func() {
+			pr, err := GetPullRequestByID(com.StrTo(prID).MustInt64())
+			if err != nil {
+				log.Error("GetPullRequestByID[%s]: %v", prID, err)
+				return
+			} else if err = pr.testPatch(); err != nil {
+				log.Error("testPatch[%d]: %v", pr.ID, err)
+				return
+			}
 
-		pr.checkAndUpdateStatus()
+			pr.checkAndUpdateStatus()
+		})
 	}
 }
 
@@ -0,0 +1,225 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"gogs.io/gogs/internal/process"
+)
+
+// PULL_CLOSE_REASON_MERGED_ELSEWHERE is the close reason of pull requests whose
+// commits are already in the base branch, e.g. by cherry-picking.
+const PULL_CLOSE_REASON_MERGED_ELSEWHERE = "merged elsewhere"
+
+// IsClosedAsMergedElsewhere returns true if the pull request is closed because
+// its commits are already in the base branch.
+func (pr *PullRequest) IsClosedAsMergedElsewhere() bool {
+	return pr.CloseReason == PULL_CLOSE_REASON_MERGED_ELSEWHERE
+}
+
+// cherryPickMaxCommits is the max number of commits checked on each side of the
+// merge base, so enormous pull requests do not stall the page.
+const cherryPickMaxCommits = 250
+
+// patchIDCacheSize is the max number of patch IDs kept in patchIDCache.
+const patchIDCacheSize = 10000
+
+// patchIDCache caches patch IDs by commit IDs, which never change. Commits
+// without changes have empty patch IDs.
+var patchIDCache = struct {
+	sync.RWMutex
+	ids map[string]string
+}{
+	ids: make(map[string]string),
+}
+
+// revList returns IDs of non-merge commits in the range, newest first.
+func revList(repoPath, revRange string, limit int) ([]string, error) {
+	stdout, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("revList 'git rev-list': %s", repoPath),
+		"git", "rev-list", "--no-merges", fmt.Sprintf("--max-count=%d", limit), revRange)
+	if err != nil {
+		return nil, fmt.Errorf("git rev-list: %v - %s", err, stderr)
+	}
+	return strings.Fields(stdout), nil
+}
+
+// patchIDs returns stable patch IDs of the commits, keyed by commit IDs.
+func patchIDs(repoPath string, commitIDs []string) (map[string]string, error) {
+	ids := make(map[string]string, len(commitIDs))
+	missing := make([]string, 0, len(commitIDs))
+	patchIDCache.RLock()
+	for _, commitID := range commitIDs {
+		if id, ok := patchIDCache.ids[commitID]; ok {
+			ids[commitID] = id
+		} else {
+			missing = append(missing, commitID)
+		}
+	}
+	patchIDCache.RUnlock()
+	if len(missing) == 0 {
+		return ids, nil
+	}
+
+	args := append([]string{"show", "--no-color", "--no-ext-diff", "--format=commit %H", "--patch"}, missing...)
+	patches, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("patchIDs 'git show': %s", repoPath), "git", args...)
+	if err != nil {
+		return nil, fmt.Errorf("git show: %v - %s", err, stderr)
+	}
+	stdout, stderr, err := process.ExecDirStdin(-1, repoPath,
+		fmt.Sprintf("patchIDs 'git patch-id': %s", repoPath), strings.NewReader(patches),
+		"git", "patch-id", "--stable")
+	if err != nil {
+		return nil, fmt.Errorf("git patch-id: %v - %s", err, stderr)
+	}
+
+	for _, commitID := range missing {
+		ids[commitID] = ""
+	}
+	scanner := bufio.NewScanner(strings.NewReader(stdout))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 {
+			ids[fields[1]] = fields[0]
+		}
+	}
+
+	patchIDCache.Lock()
+	if len(patchIDCache.ids)+len(missing) > patchIDCacheSize {
+		patchIDCache.ids = make(map[string]string)
+	}
+	for _, commitID := range missing {
+		patchIDCache.ids[commitID] = ids[commitID]
+	}
+	patchIDCache.Unlock()
+	return ids, nil
+}
+
+// CherryPicks are commits of a pull request whose changes are already in the
+// base branch, detected by patch IDs.
+type CherryPicks struct {
+	// BaseCommitIDs maps IDs of commits in the head branch to IDs of commits
+	// with the same changes in the base branch.
+	BaseCommitIDs map[string]string
+	// NumCommits is the number of non-merge commits in the head branch since
+	// the merge base.
+	NumCommits int
+	// Truncated is true if either branch has too many commits since the merge
+	// base to be checked entirely.
+	Truncated bool
+}
+
+// IsAll returns true if all commits of the pull request are in the base branch.
+func (p *CherryPicks) IsAll() bool {
+	return !p.Truncated && p.NumCommits > 0 && len(p.BaseCommitIDs) == p.NumCommits
+}
+
+// MarkdownList returns the Markdown list of commits and their equivalents in
+// the base branch.
+func (p *CherryPicks) MarkdownList() string {
+	headIDs := make([]string, 0, len(p.BaseCommitIDs))
+	for headID := range p.BaseCommitIDs {
+		headIDs = append(headIDs, headID)
+	}
+	sort.Strings(headIDs)
+
+	var buf strings.Builder
+	for _, headID := range headIDs {
+		fmt.Fprintf(&buf, "- %s → %s\n", headID, p.BaseCommitIDs[headID])
+	}
+	return buf.String()
+}
+
+// detectCherryPicks detects commits of the head reference that are in the base
+// reference since their merge base.
+func detectCherryPicks(repoPath, baseRef, headRef string) (*CherryPicks, error) {
+	stdout, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("detectCherryPicks 'git merge-base': %s", repoPath),
+		"git", "merge-base", baseRef, headRef)
+	if err != nil {
+		return nil, fmt.Errorf("git merge-base: %v - %s", err, stderr)
+	}
+	mergeBase := strings.TrimSpace(stdout)
+
+	picks := &CherryPicks{
+		BaseCommitIDs: make(map[string]string),
+	}
+	headIDs, err := revList(repoPath, mergeBase+".."+headRef, cherryPickMaxCommits+1)
+	if err != nil {
+		return nil, err
+	}
+	picks.NumCommits = len(headIDs)
+	if len(headIDs) > cherryPickMaxCommits {
+		picks.Truncated = true
+		return picks, nil
+	} else if len(headIDs) == 0 {
+		return picks, nil
+	}
+
+	// Only the most recent commits of the base branch are checked.
+	baseIDs, err := revList(repoPath, mergeBase+".."+baseRef, cherryPickMaxCommits+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(baseIDs) > cherryPickMaxCommits {
+		picks.Truncated = true
+		baseIDs = baseIDs[:cherryPickMaxCommits]
+	} else if len(baseIDs) == 0 {
+		return picks, nil
+	}
+
+	ids, err := patchIDs(repoPath, append(headIDs, baseIDs...))
+	if err != nil {
+		return nil, err
+	}
+	basePatchIDs := make(map[string]string, len(baseIDs))
+	for _, baseID := range baseIDs {
+		if id := ids[baseID]; id != "" {
+			basePatchIDs[id] = baseID
+		}
+	}
+	for _, headID := range headIDs {
+		if baseID, ok := basePatchIDs[ids[headID]]; ok && ids[headID] != "" {
+			picks.BaseCommitIDs[headID] = baseID
+		}
+	}
+	return picks, nil
+}
+
+// DetectCherryPicks detects commits of the pull request that are already in
+// the base branch, e.g. by cherry-picking.
+func (pr *PullRequest) DetectCherryPicks() (*CherryPicks, error) {
+	if err := pr.LoadAttributes(); err != nil {
+		return nil, fmt.Errorf("LoadAttributes: %v", err)
+	}
+	return detectCherryPicks(pr.BaseRepo.RepoPath(), "refs/heads/"+pr.BaseBranch, fmt.Sprintf("refs/pull/%d/head", pr.Index))
+}
+
+// CloseAsMergedElsewhere closes the pull request with the reason that its
+// commits are already in the base branch, after posting the comment.
+func (pr *PullRequest) CloseAsMergedElsewhere(doer *User, content string) error {
+	if err := pr.LoadIssue(); err != nil {
+		return fmt.Errorf("LoadIssue: %v", err)
+	}
+	if err := pr.LoadAttributes(); err != nil {
+		return fmt.Errorf("LoadAttributes: %v", err)
+	}
+
+	if _, err := CreateIssueComment(doer, pr.BaseRepo, pr.Issue, content, nil); err != nil {
+		return fmt.Errorf("CreateIssueComment: %v", err)
+	}
+
+	pr.CloseReason = PULL_CLOSE_REASON_MERGED_ELSEWHERE
+	if err := pr.UpdateCols("close_reason"); err != nil {
+		return fmt.Errorf("update close reason: %v", err)
+	}
+	return pr.Issue.ChangeStatus(doer, pr.BaseRepo, true, "")
+}
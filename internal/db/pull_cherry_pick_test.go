@@ -0,0 +1,49 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_detectCherryPicks(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	repo.CommitFile("README", "init")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	fix := repo.CommitFile("fix.txt", "fix")
+	feature := repo.CommitFile("feature.txt", "feature")
+	repo.Git("checkout", "--quiet", "master")
+	repo.CommitFile("other.txt", "other")
+	repo.Git("cherry-pick", fix)
+	picked := repo.Git("rev-parse", "HEAD")
+
+	Convey("Detect commits cherry-picked to the base branch", t, func() {
+		picks, err := detectCherryPicks(dir, "master", "feature")
+		So(err, ShouldBeNil)
+		So(picks.NumCommits, ShouldEqual, 2)
+		So(picks.BaseCommitIDs, ShouldResemble, map[string]string{fix: picked})
+		So(picks.IsAll(), ShouldBeFalse)
+
+		repo.Git("cherry-pick", feature)
+		picks, err = detectCherryPicks(dir, "master", "feature")
+		So(err, ShouldBeNil)
+		So(picks.BaseCommitIDs, ShouldHaveLength, 2)
+		So(picks.IsAll(), ShouldBeTrue)
+		So(picks.MarkdownList(), ShouldContainSubstring, "- "+fix+" → "+picked+"\n")
+	})
+
+	Convey("Cache patch IDs by commits", t, func() {
+		patchIDCache.RLock()
+		_, ok := patchIDCache.ids[fix]
+		patchIDCache.RUnlock()
+		So(ok, ShouldBeTrue)
+	})
+}
@@ -0,0 +1,378 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogs/git-module"
+)
+
+// CodeOwnerApproval is an approval of a code owner on the files of a pull
+// request that the owner owns, which only counts at the head commit it was
+// given for.
+type CodeOwnerApproval struct {
+	ID          int64
+	PullID      int64  `xorm:"UNIQUE(s)"`
+	UserID      int64  `xorm:"UNIQUE(s)"`
+	CommitID    string `xorm:"VARCHAR(40)"`
+	CreatedUnix int64
+}
+
+func (a *CodeOwnerApproval) BeforeInsert() {
+	a.CreatedUnix = time.Now().Unix()
+}
+
+// ownedFiles is changed files of a pull request that are owned by a rule of
+// the CODEOWNERS file.
+type ownedFiles struct {
+	Pattern string
+	Line    int
+	Owners  []string
+	Files   []string
+}
+
+// assignCodeOwners groups the files by the rules that apply to them, in the
+// order of lines. Rules that match no files are left out, so are files whose
+// rule has no owners since nobody has to approve them.
+func assignCodeOwners(rules []*CodeOwnersRule, files []string) []*ownedFiles {
+	groups := make(map[*CodeOwnersRule]*ownedFiles)
+	for _, name := range files {
+		rule := MatchCodeOwners(rules, name)
+		if rule == nil || len(rule.Owners) == 0 {
+			continue
+		}
+		g := groups[rule]
+		if g == nil {
+			g = &ownedFiles{
+				Pattern: rule.Pattern,
+				Line:    rule.Line,
+				Owners:  rule.Owners,
+			}
+			groups[rule] = g
+		}
+		g.Files = append(g.Files, name)
+	}
+
+	assigned := make([]*ownedFiles, 0, len(groups))
+	for _, rule := range rules {
+		if g := groups[rule]; g != nil {
+			assigned = append(assigned, g)
+		}
+	}
+	return assigned
+}
+
+// pullCodeOwnersCacheSize is the maximum number of pull requests whose code
+// owners are kept in memory.
+const pullCodeOwnersCacheSize = 1000
+
+type pullCodeOwnersCacheKey struct {
+	pullID       int64
+	headCommitID string
+	mergeBase    string
+}
+
+type pullCodeOwnersCacheEntry struct {
+	key      pullCodeOwnersCacheKey
+	assigned []*ownedFiles
+}
+
+// pullCodeOwnersCache keeps the least recently used files assigned to rules of
+// the CODEOWNERS file of pull requests at their head commits and merge bases,
+// since neither changes until new commits are pushed to either branch.
+var pullCodeOwnersCache = struct {
+	sync.Mutex
+	ll      *list.List
+	entries map[pullCodeOwnersCacheKey]*list.Element
+}{
+	ll:      list.New(),
+	entries: make(map[pullCodeOwnersCacheKey]*list.Element),
+}
+
+func getPullCodeOwnersCache(key pullCodeOwnersCacheKey) ([]*ownedFiles, bool) {
+	pullCodeOwnersCache.Lock()
+	defer pullCodeOwnersCache.Unlock()
+
+	elem, ok := pullCodeOwnersCache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	pullCodeOwnersCache.ll.MoveToFront(elem)
+	return elem.Value.(*pullCodeOwnersCacheEntry).assigned, true
+}
+
+func putPullCodeOwnersCache(entry *pullCodeOwnersCacheEntry) {
+	pullCodeOwnersCache.Lock()
+	defer pullCodeOwnersCache.Unlock()
+
+	if elem, ok := pullCodeOwnersCache.entries[entry.key]; ok {
+		elem.Value = entry
+		pullCodeOwnersCache.ll.MoveToFront(elem)
+		return
+	}
+	pullCodeOwnersCache.entries[entry.key] = pullCodeOwnersCache.ll.PushFront(entry)
+	for pullCodeOwnersCache.ll.Len() > pullCodeOwnersCacheSize {
+		oldest := pullCodeOwnersCache.ll.Back()
+		pullCodeOwnersCache.ll.Remove(oldest)
+		delete(pullCodeOwnersCache.entries, oldest.Value.(*pullCodeOwnersCacheEntry).key)
+	}
+}
+
+// assignedCodeOwners returns files of the pull request at the head commit in
+// the repository at the path assigned to rules of the CODEOWNERS file in the
+// tree of the merge base.
+func (pr *PullRequest) assignedCodeOwners(repoPath, headCommitID string) ([]*ownedFiles, error) {
+	key := pullCodeOwnersCacheKey{
+		pullID:       pr.ID,
+		headCommitID: headCommitID,
+		mergeBase:    pr.MergeBase,
+	}
+	if assigned, ok := getPullCodeOwnersCache(key); ok {
+		return assigned, nil
+	}
+
+	gitRepo, err := git.OpenRepository(pr.BaseRepo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("open repository: %v", err)
+	}
+	baseCommit, err := gitRepo.GetCommit(pr.MergeBase)
+	if err != nil {
+		return nil, fmt.Errorf("get merge base commit: %v", err)
+	}
+	fpath, data, err := readCodeOwners(baseCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	var assigned []*ownedFiles
+	if fpath != "" {
+		files, err := pr.ChangedFilesAt(repoPath, headCommitID)
+		if err != nil {
+			return nil, err
+		}
+		assigned = assignCodeOwners(ParseCodeOwners(data), files)
+	}
+
+	putPullCodeOwnersCache(&pullCodeOwnersCacheEntry{
+		key:      key,
+		assigned: assigned,
+	})
+	return assigned, nil
+}
+
+// CodeOwnersGroup is changed files of a pull request that are owned by the
+// same rule of the CODEOWNERS file.
+type CodeOwnersGroup struct {
+	Pattern string
+	Line    int
+	Files   []string
+	Users   []*User
+	Teams   []*Team
+	// Approvers are owners who have approved at the head commit.
+	Approvers []*User
+	// posterID is the author of the pull request, who cannot approve their own
+	// changes as a code owner.
+	posterID int64
+}
+
+// IsApproved returns true if any owner has approved the files.
+func (g *CodeOwnersGroup) IsApproved() bool {
+	return len(g.Approvers) > 0
+}
+
+// IsOwner returns true if the user is an owner of the files, either directly
+// or as a member of an owner team. The author of the pull request is never
+// considered an owner.
+func (g *CodeOwnersGroup) IsOwner(userID int64) bool {
+	if userID == g.posterID {
+		return false
+	}
+	for _, u := range g.Users {
+		if u.ID == userID {
+			return true
+		}
+	}
+	for _, t := range g.Teams {
+		if t.IsMember(userID) {
+			return true
+		}
+	}
+	return false
+}
+
+// PullCodeOwners is ownership of changed files of a pull request at its head
+// commit.
+type PullCodeOwners struct {
+	HeadCommitID string
+	// Groups are files grouped by the rules that own them in the order of lines.
+	// Files whose owners do not exist are not in any group.
+	Groups      []*CodeOwnersGroup
+	groupOfFile map[string]*CodeOwnersGroup
+}
+
+// GroupOfFile returns the group that the file belongs to, or nil if the file
+// has no owners.
+func (o *PullCodeOwners) GroupOfFile(name string) *CodeOwnersGroup {
+	return o.groupOfFile[name]
+}
+
+// PendingGroups returns groups that no owner has approved yet.
+func (o *PullCodeOwners) PendingGroups() []*CodeOwnersGroup {
+	var pending []*CodeOwnersGroup
+	for _, g := range o.Groups {
+		if !g.IsApproved() {
+			pending = append(pending, g)
+		}
+	}
+	return pending
+}
+
+// IsOwner returns true if the user owns any changed file.
+func (o *PullCodeOwners) IsOwner(userID int64) bool {
+	for _, g := range o.Groups {
+		if g.IsOwner(userID) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApprovedBy returns true if the user has approved at the head commit.
+func (o *PullCodeOwners) IsApprovedBy(userID int64) bool {
+	for _, g := range o.Groups {
+		for _, u := range g.Approvers {
+			if u.ID == userID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CodeOwners returns ownership of changed files of the pull request at the
+// head commit in the repository at the path, according to the CODEOWNERS file
+// in the tree of the merge base. The pull request must have not been merged.
+func (pr *PullRequest) CodeOwners(repoPath, headCommitID string) (*PullCodeOwners, error) {
+	if err := pr.LoadAttributes(); err != nil {
+		return nil, fmt.Errorf("LoadAttributes: %v", err)
+	} else if err = pr.LoadIssue(); err != nil {
+		return nil, fmt.Errorf("LoadIssue: %v", err)
+	}
+	assigned, err := pr.assignedCodeOwners(repoPath, headCommitID)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*CodeOwnerApproval, 0, 5)
+	if err = x.Where("pull_id = ? AND commit_id = ?", pr.ID, headCommitID).Asc("id").Find(&approvals); err != nil {
+		return nil, fmt.Errorf("find approvals: %v", err)
+	}
+	approvers := make([]*User, 0, len(approvals))
+	for _, a := range approvals {
+		u, err := GetUserByID(a.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("GetUserByID [%d]: %v", a.UserID, err)
+		}
+		approvers = append(approvers, u)
+	}
+
+	owners := &PullCodeOwners{
+		HeadCommitID: headCommitID,
+		Groups:       make([]*CodeOwnersGroup, 0, len(assigned)),
+		groupOfFile:  make(map[string]*CodeOwnersGroup),
+	}
+	for _, a := range assigned {
+		users, teams, err := pr.BaseRepo.resolveCodeOwners(a.Owners)
+		if err != nil {
+			return nil, fmt.Errorf("resolveCodeOwners: %v", err)
+		} else if len(users) == 0 && len(teams) == 0 {
+			continue
+		}
+
+		g := &CodeOwnersGroup{
+			Pattern:  a.Pattern,
+			Line:     a.Line,
+			Files:    a.Files,
+			Users:    users,
+			Teams:    teams,
+			posterID: pr.Issue.PosterID,
+		}
+		for _, u := range approvers {
+			if g.IsOwner(u.ID) {
+				g.Approvers = append(g.Approvers, u)
+			}
+		}
+		owners.Groups = append(owners.Groups, g)
+		for _, name := range a.Files {
+			owners.groupOfFile[name] = g
+		}
+	}
+	return owners, nil
+}
+
+// ApproveCodeOwners approves files of the pull request that the user owns at
+// the head commit, replacing any previous approval of the user.
+func (pr *PullRequest) ApproveCodeOwners(userID int64, headCommitID string) (err error) {
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err = sess.Delete(&CodeOwnerApproval{PullID: pr.ID, UserID: userID}); err != nil {
+		return fmt.Errorf("delete previous approval: %v", err)
+	} else if _, err = sess.Insert(&CodeOwnerApproval{
+		PullID:   pr.ID,
+		UserID:   userID,
+		CommitID: headCommitID,
+	}); err != nil {
+		return fmt.Errorf("insert approval: %v", err)
+	}
+	return sess.Commit()
+}
+
+// WithdrawCodeOwnersApproval removes the approval of the user on the pull
+// request.
+func (pr *PullRequest) WithdrawCodeOwnersApproval(userID int64) error {
+	_, err := x.Delete(&CodeOwnerApproval{PullID: pr.ID, UserID: userID})
+	return err
+}
+
+// checkCodeOwnersApproval returns ErrCodeOwnersApprovalRequired if the base
+// branch of the pull request requires approval of code owners and some files
+// at the head commit are not approved by their owners yet.
+func (pr *PullRequest) checkCodeOwnersApproval() error {
+	if !IsBranchOfRepoRequireCodeOwnersApproval(pr.BaseRepoID, pr.BaseBranch) {
+		return nil
+	}
+
+	headRepoPath := pr.HeadRepo.RepoPath()
+	headGitRepo, err := git.OpenRepository(headRepoPath)
+	if err != nil {
+		return fmt.Errorf("open repository: %v", err)
+	}
+	headCommitID, err := headGitRepo.GetBranchCommitID(pr.HeadBranch)
+	if err != nil {
+		return fmt.Errorf("get head commit: %v", err)
+	}
+
+	owners, err := pr.CodeOwners(headRepoPath, headCommitID)
+	if err != nil {
+		return fmt.Errorf("CodeOwners: %v", err)
+	}
+	pending := owners.PendingGroups()
+	if len(pending) == 0 {
+		return nil
+	}
+	patterns := make([]string, len(pending))
+	for i := range pending {
+		patterns[i] = pending[i].Pattern
+	}
+	return ErrCodeOwnersApprovalRequired{Patterns: patterns}
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_assignCodeOwners(t *testing.T) {
+	Convey("Assign changed files to rules of CODEOWNERS file", t, func() {
+		rules := ParseCodeOwners([]byte(`*.go @alice
+/docs/ @acme/writers
+docs/internal/
+/vendor/ @bob
+`))
+		files := []string{"main.go", "docs/README.md", "docs/internal/notes.md", "internal/db/repo.go", "Makefile"}
+
+		assigned := assignCodeOwners(rules, files)
+		So(assigned, ShouldHaveLength, 2)
+
+		So(assigned[0].Pattern, ShouldEqual, "*.go")
+		So(assigned[0].Line, ShouldEqual, 1)
+		So(assigned[0].Owners, ShouldResemble, []string{"@alice"})
+		So(assigned[0].Files, ShouldResemble, []string{"main.go", "internal/db/repo.go"})
+
+		So(assigned[1].Pattern, ShouldEqual, "/docs/")
+		So(assigned[1].Files, ShouldResemble, []string{"docs/README.md"})
+
+		Convey("Later rules take precedence", func() {
+			assigned := assignCodeOwners(ParseCodeOwners([]byte("* @alice\n*.md @bob\n")), files)
+			So(assigned, ShouldHaveLength, 2)
+			So(assigned[0].Files, ShouldResemble, []string{"main.go", "internal/db/repo.go", "Makefile"})
+			So(assigned[1].Files, ShouldResemble, []string{"docs/README.md", "docs/internal/notes.md"})
+		})
+	})
+}
+
+func Test_PullRequest_CodeOwners(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "owner", LowerName: "owner"}
+	alice := &User{Name: "alice", LowerName: "alice"}
+	bob := &User{Name: "bob", LowerName: "bob"}
+	insertTestBeans(t, owner, alice, bob)
+	repo := &Repository{OwnerID: owner.ID, Name: "repo", LowerName: "repo"}
+	insertTestBeans(t, repo)
+	issue := &Issue{RepoID: repo.ID, Index: 1, PosterID: alice.ID, IsPull: true}
+	insertTestBeans(t, issue)
+	pr := &PullRequest{IssueID: issue.ID, Index: 1, BaseRepoID: repo.ID, HeadRepoID: repo.ID, MergeBase: "base"}
+	insertTestBeans(t, pr)
+
+	// Skip reading the CODEOWNERS file from Git.
+	putPullCodeOwnersCache(&pullCodeOwnersCacheEntry{
+		key: pullCodeOwnersCacheKey{pullID: pr.ID, headCommitID: "head", mergeBase: "base"},
+		assigned: []*ownedFiles{
+			{Pattern: "*.go", Line: 1, Owners: []string{"@alice", "@bob"}, Files: []string{"main.go"}},
+		},
+	})
+
+	Convey("Resolve code owners of a pull request", t, func() {
+		Convey("The author cannot approve their own changes", func() {
+			So(pr.ApproveCodeOwners(alice.ID, "head"), ShouldBeNil)
+
+			owners, err := pr.CodeOwners("", "head")
+			So(err, ShouldBeNil)
+			So(owners.Groups, ShouldHaveLength, 1)
+			So(owners.IsOwner(alice.ID), ShouldBeFalse)
+			So(owners.IsOwner(bob.ID), ShouldBeTrue)
+			So(owners.PendingGroups(), ShouldHaveLength, 1)
+		})
+
+		Convey("Approvals only count at the approved commit", func() {
+			So(pr.ApproveCodeOwners(bob.ID, "head"), ShouldBeNil)
+
+			owners, err := pr.CodeOwners("", "head")
+			So(err, ShouldBeNil)
+			So(owners.IsApprovedBy(bob.ID), ShouldBeTrue)
+			So(owners.PendingGroups(), ShouldBeEmpty)
+
+			So(pr.ApproveCodeOwners(bob.ID, "previous"), ShouldBeNil)
+			owners, err = pr.CodeOwners("", "head")
+			So(err, ShouldBeNil)
+			So(owners.PendingGroups(), ShouldHaveLength, 1)
+		})
+	})
+}
+
+func Test_pullCodeOwnersCache(t *testing.T) {
+	Convey("Keep the least recently used code owners of pull requests", t, func() {
+		for i := 0; i <= pullCodeOwnersCacheSize; i++ {
+			putPullCodeOwnersCache(&pullCodeOwnersCacheEntry{
+				key: pullCodeOwnersCacheKey{pullID: -1, headCommitID: strconv.Itoa(i)},
+			})
+		}
+		So(pullCodeOwnersCache.ll.Len(), ShouldEqual, pullCodeOwnersCacheSize)
+
+		_, ok := getPullCodeOwnersCache(pullCodeOwnersCacheKey{pullID: -1, headCommitID: "0"})
+		So(ok, ShouldBeFalse)
+		_, ok = getPullCodeOwnersCache(pullCodeOwnersCacheKey{pullID: -1, headCommitID: strconv.Itoa(pullCodeOwnersCacheSize)})
+		So(ok, ShouldBeTrue)
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"container/list"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+// MergeMessageVariables are names of variables that can be used in templates
+// of merge commit messages, e.g. "${PullRequestTitle}".
+var MergeMessageVariables = []string{
+	"PullRequestTitle",
+	"PullRequestIndex",
+	"PullRequestDescription",
+	"BaseBranch",
+	"HeadBranch",
+	"HeadRepo",
+	"CoAuthoredBy",
+}
+
+// expandMergeMessageTemplate replaces variables in the template with values
+// from the lookup. It returns an error for unknown variables and unterminated
+// references, while a "$" that is not followed by "{" is kept as it is.
+func expandMergeMessageTemplate(tmpl string, lookup func(name string) (string, bool)) (string, error) {
+	var buf strings.Builder
+	for i := 0; i < len(tmpl); i++ {
+		if tmpl[i] != '$' || i+1 >= len(tmpl) || tmpl[i+1] != '{' {
+			buf.WriteByte(tmpl[i])
+			continue
+		}
+
+		end := strings.IndexByte(tmpl[i+2:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated variable reference at offset %d", i)
+		}
+		name := tmpl[i+2 : i+2+end]
+		value, ok := lookup(name)
+		if !ok {
+			return "", fmt.Errorf("unknown variable %q", name)
+		}
+		buf.WriteString(value)
+		i += end + 2
+	}
+	return buf.String(), nil
+}
+
+// ValidateMergeMessageTemplate returns an error if the template references
+// unknown variables or cannot be parsed.
+func ValidateMergeMessageTemplate(tmpl string) error {
+	_, err := expandMergeMessageTemplate(tmpl, func(name string) (string, bool) {
+		for _, v := range MergeMessageVariables {
+			if v == name {
+				return "", true
+			}
+		}
+		return "", false
+	})
+	return err
+}
+
+// DefaultMergeMessage returns the message of merge commits when the base
+// repository has no template.
+func (pr *PullRequest) DefaultMergeMessage() string {
+	return fmt.Sprintf("Merge branch '%s' of %s/%s into %s", pr.HeadBranch, pr.HeadUserName, pr.HeadRepo.Name, pr.BaseBranch)
+}
+
+// coAuthorTrailers returns "Co-authored-by" trailers of unique authors of the
+// commits, excluding the doer who becomes the author of the merge commit.
+func coAuthorTrailers(doer *User, commits *list.List) []string {
+	var trailers []string
+	seen := map[string]bool{
+		strings.ToLower(doer.Email): true,
+	}
+	for e := commits.Front(); e != nil; e = e.Next() {
+		author := e.Value.(*git.Commit).Author
+		if author == nil || seen[strings.ToLower(author.Email)] {
+			continue
+		}
+		seen[strings.ToLower(author.Email)] = true
+		trailers = append(trailers, fmt.Sprintf("Co-authored-by: %s <%s>", author.Name, author.Email))
+	}
+	return trailers
+}
+
+// MergeMessage returns the message of the merge commit rendered by the
+// template of the base repository with the commits of the pull request. The
+// default message is returned along with the error when the template cannot be
+// rendered, so that merges are never blocked by a broken template.
+//
+// This method assumes fields of Issue, HeadRepo and BaseRepo have been loaded.
+func (pr *PullRequest) MergeMessage(doer *User, commits *list.List) (string, error) {
+	tmpl := pr.BaseRepo.MergeMessageTemplate
+	if strings.TrimSpace(tmpl) == "" {
+		return pr.DefaultMergeMessage(), nil
+	}
+
+	msg, err := expandMergeMessageTemplate(tmpl, func(name string) (string, bool) {
+		switch name {
+		case "PullRequestTitle":
+			return pr.Issue.Title, true
+		case "PullRequestIndex":
+			return fmt.Sprintf("%d", pr.Index), true
+		case "PullRequestDescription":
+			return strings.TrimSpace(pr.Issue.Content), true
+		case "BaseBranch":
+			return pr.BaseBranch, true
+		case "HeadBranch":
+			return pr.HeadBranch, true
+		case "HeadRepo":
+			return pr.HeadUserName + "/" + pr.HeadRepo.Name, true
+		case "CoAuthoredBy":
+			return strings.Join(coAuthorTrailers(doer, commits), "\n"), true
+		}
+		return "", false
+	})
+	if err != nil {
+		return pr.DefaultMergeMessage(), fmt.Errorf("render merge message template: %v", err)
+	}
+	return strings.TrimSpace(msg), nil
+}
+
+// mergeTrailers returns trailers that are required in merge commits of the
+// pull request, i.e. references of issues that the pull request closes by
+// keywords of "[repository.issue] CLOSE_KEYWORDS" in its title or description.
+func (pr *PullRequest) mergeTrailers() []string {
+	if !pr.BaseRepo.MergeMessageTrailers {
+		return nil
+	}
+
+	fullName := pr.BaseRepo.FullName()
+	refs := issueKeywordRefs(fullName, pr.Issue.Title+"\n"+pr.Issue.Content, conf.Repository.Issue.CloseKeywords)
+	trailers := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if n := strings.IndexByte(ref, '#'); strings.EqualFold(ref[:n], fullName) {
+			ref = ref[n:]
+		}
+		trailers = append(trailers, "Closes: "+ref)
+	}
+	return trailers
+}
+
+var trailerPattern = regexp.MustCompile(`^[\w-]+: \S`)
+
+// appendTrailers appends trailers that are not yet in the message, to the
+// trailer block of the message if it ends with one.
+func appendTrailers(msg string, trailers []string) string {
+	msg = strings.TrimRight(msg, " \t\r\n")
+	lines := strings.Split(msg, "\n")
+	existing := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		existing[strings.ToLower(strings.TrimSpace(line))] = true
+	}
+
+	added := make([]string, 0, len(trailers))
+	for _, trailer := range trailers {
+		if key := strings.ToLower(trailer); !existing[key] {
+			existing[key] = true
+			added = append(added, trailer)
+		}
+	}
+	if len(added) == 0 {
+		return msg
+	}
+
+	// The last paragraph is a trailer block if all of its lines are trailers,
+	// but the subject line is never a trailer block.
+	inBlock := len(lines) > 1
+	for i := len(lines) - 1; i >= 0 && inBlock; i-- {
+		if lines[i] == "" {
+			break
+		} else if i == 0 || !trailerPattern.MatchString(lines[i]) {
+			inBlock = false
+		}
+	}
+	if inBlock {
+		return msg + "\n" + strings.Join(added, "\n")
+	}
+	return msg + "\n\n" + strings.Join(added, "\n")
+}
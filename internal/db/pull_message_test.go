@@ -0,0 +1,88 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"container/list"
+	"testing"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_expandMergeMessageTemplate(t *testing.T) {
+	lookup := func(name string) (string, bool) {
+		switch name {
+		case "PullRequestTitle":
+			return "Add feature", true
+		case "PullRequestIndex":
+			return "12", true
+		}
+		return "", false
+	}
+
+	Convey("Expand variables", t, func() {
+		msg, err := expandMergeMessageTemplate("${PullRequestTitle} (#${PullRequestIndex})", lookup)
+		So(err, ShouldBeNil)
+		So(msg, ShouldEqual, "Add feature (#12)")
+
+		msg, err = expandMergeMessageTemplate("Costs $5 and ${PullRequestIndex}$", lookup)
+		So(err, ShouldBeNil)
+		So(msg, ShouldEqual, "Costs $5 and 12$")
+	})
+
+	Convey("Report invalid templates", t, func() {
+		_, err := expandMergeMessageTemplate("${PullRequestTitle", lookup)
+		So(err, ShouldNotBeNil)
+
+		_, err = expandMergeMessageTemplate("${Unknown}", lookup)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestValidateMergeMessageTemplate(t *testing.T) {
+	Convey("Validate templates of merge messages", t, func() {
+		So(ValidateMergeMessageTemplate(""), ShouldBeNil)
+		So(ValidateMergeMessageTemplate("${PullRequestTitle}\n\n${CoAuthoredBy}"), ShouldBeNil)
+		So(ValidateMergeMessageTemplate("${ReviewedBy}"), ShouldNotBeNil)
+	})
+}
+
+func Test_coAuthorTrailers(t *testing.T) {
+	Convey("Collect unique authors except the doer", t, func() {
+		commits := list.New()
+		for _, author := range []*git.Signature{
+			{Name: "Alice", Email: "alice@example.com"},
+			{Name: "Bob", Email: "bob@example.com"},
+			{Name: "Alice", Email: "ALICE@example.com"},
+			{Name: "Doer", Email: "doer@example.com"},
+		} {
+			commits.PushBack(&git.Commit{Author: author})
+		}
+
+		So(coAuthorTrailers(&User{Email: "doer@example.com"}, commits), ShouldResemble, []string{
+			"Co-authored-by: Alice <alice@example.com>",
+			"Co-authored-by: Bob <bob@example.com>",
+		})
+	})
+}
+
+func Test_appendTrailers(t *testing.T) {
+	Convey("Append trailers to messages", t, func() {
+		So(appendTrailers("Merge branch", []string{"Closes: #1"}), ShouldEqual, "Merge branch\n\nCloses: #1")
+		So(appendTrailers("Merge branch\n\nSome details.\n", []string{"Closes: #1"}), ShouldEqual,
+			"Merge branch\n\nSome details.\n\nCloses: #1")
+	})
+
+	Convey("Append trailers to the trailer block", t, func() {
+		So(appendTrailers("Merge branch\n\nCo-authored-by: Bob <bob@example.com>", []string{"Closes: #1"}), ShouldEqual,
+			"Merge branch\n\nCo-authored-by: Bob <bob@example.com>\nCloses: #1")
+	})
+
+	Convey("Skip trailers that exist", t, func() {
+		So(appendTrailers("Merge branch\n\nCloses: #1", []string{"closes: #1"}), ShouldEqual, "Merge branch\n\nCloses: #1")
+		So(appendTrailers("Fix: crash", nil), ShouldEqual, "Fix: crash")
+	})
+}
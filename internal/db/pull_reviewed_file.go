@@ -0,0 +1,188 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/gogs/git-module"
+)
+
+// ReviewedFile is a file of a pull request that a user has marked as viewed,
+// along with the head commit of the pull request at the time. The mark is
+// stale once the file is changed by commits after the head commit.
+type ReviewedFile struct {
+	ID          int64
+	PullID      int64  `xorm:"INDEX(s)"`
+	UserID      int64  `xorm:"INDEX(s)"`
+	TreePath    string `xorm:"TEXT"`
+	CommitID    string `xorm:"VARCHAR(40)"`
+	CreatedUnix int64
+}
+
+func (f *ReviewedFile) BeforeInsert() {
+	f.CreatedUnix = time.Now().Unix()
+}
+
+// MarkFileReviewed marks the file of the pull request as viewed by the user
+// at the commit, replacing any previous mark of the file.
+func MarkFileReviewed(pullID, userID int64, treePath, commitID string) (err error) {
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err = sess.Where("pull_id = ? AND user_id = ? AND tree_path = ?", pullID, userID, treePath).Delete(new(ReviewedFile)); err != nil {
+		return fmt.Errorf("delete previous mark: %v", err)
+	} else if _, err = sess.Insert(&ReviewedFile{
+		PullID:   pullID,
+		UserID:   userID,
+		TreePath: treePath,
+		CommitID: commitID,
+	}); err != nil {
+		return fmt.Errorf("insert mark: %v", err)
+	}
+	return sess.Commit()
+}
+
+// UnmarkFileReviewed removes the mark of the file of the pull request by the
+// user.
+func UnmarkFileReviewed(pullID, userID int64, treePath string) error {
+	_, err := x.Where("pull_id = ? AND user_id = ? AND tree_path = ?", pullID, userID, treePath).Delete(new(ReviewedFile))
+	return err
+}
+
+// GetReviewedFiles returns all marks of files of the pull request by the user,
+// including stale ones.
+func GetReviewedFiles(pullID, userID int64) ([]*ReviewedFile, error) {
+	files := make([]*ReviewedFile, 0, 10)
+	return files, x.Where("pull_id = ? AND user_id = ?", pullID, userID).Find(&files)
+}
+
+// PullReviewState is the review of files changed by a pull request by a user
+// at a head commit of the pull request.
+type PullReviewState struct {
+	PullID       int64
+	UserID       int64
+	HeadCommitID string
+	// ChangedFiles are paths of files changed by the pull request.
+	ChangedFiles []string
+	// ReviewedFiles are paths of files that the user has marked as viewed and
+	// are not changed since then.
+	ReviewedFiles map[string]bool
+}
+
+// ReviewStateAt returns the review of files of the pull request by the user up
+// to the head commit in the repository at the path.
+func (pr *PullRequest) ReviewStateAt(userID int64, repoPath, headCommitID string) (*PullReviewState, error) {
+	changed, err := pr.ChangedFilesAt(repoPath, headCommitID)
+	if err != nil {
+		return nil, err
+	}
+	files, err := GetReviewedFiles(pr.ID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("GetReviewedFiles: %v", err)
+	}
+	return &PullReviewState{
+		PullID:        pr.ID,
+		UserID:        userID,
+		HeadCommitID:  headCommitID,
+		ChangedFiles:  changed,
+		ReviewedFiles: unchangedReviewedFiles(repoPath, headCommitID, files),
+	}, nil
+}
+
+// IsChanged returns true if the file is changed by the pull request.
+func (s *PullReviewState) IsChanged(treePath string) bool {
+	for _, name := range s.ChangedFiles {
+		if name == treePath {
+			return true
+		}
+	}
+	return false
+}
+
+// Progress returns the number of changed files that the user has viewed, and
+// the total number of changed files.
+func (s *PullReviewState) Progress() (viewed, total int) {
+	for _, name := range s.ChangedFiles {
+		if s.ReviewedFiles[name] {
+			viewed++
+		}
+	}
+	return viewed, len(s.ChangedFiles)
+}
+
+// ToggleFile marks the file as viewed by the user at the head commit, or
+// removes the mark if viewed is false. It returns ErrPullFileNotChanged if the
+// file is not changed by the pull request.
+func (s *PullReviewState) ToggleFile(treePath string, viewed bool) error {
+	if !s.IsChanged(treePath) {
+		return ErrPullFileNotChanged{PullID: s.PullID, TreePath: treePath}
+	}
+
+	if !viewed {
+		if err := UnmarkFileReviewed(s.PullID, s.UserID, treePath); err != nil {
+			return err
+		}
+		delete(s.ReviewedFiles, treePath)
+		return nil
+	}
+
+	if err := MarkFileReviewed(s.PullID, s.UserID, treePath, s.HeadCommitID); err != nil {
+		return err
+	}
+	s.ReviewedFiles[treePath] = true
+	return nil
+}
+
+// unchangedReviewedFiles returns paths of reviewed files that are not changed
+// from the commits they were marked at to the head commit.
+func unchangedReviewedFiles(repoPath, headCommitID string, files []*ReviewedFile) map[string]bool {
+	// Files changed since each commit that marks were made at, which is nil
+	// when the commit no longer exists, e.g. after a force push.
+	changed := map[string]map[string]bool{
+		headCommitID: {},
+	}
+	reviewed := make(map[string]bool, len(files))
+	for _, f := range files {
+		if _, ok := changed[f.CommitID]; !ok {
+			output, err := git.NewCommand("diff", "-z", "--name-only", f.CommitID, headCommitID).RunInDirBytes(repoPath)
+			if err == nil {
+				changed[f.CommitID] = make(map[string]bool)
+				for _, name := range bytes.Split(output, []byte{0}) {
+					changed[f.CommitID][string(name)] = true
+				}
+			} else {
+				changed[f.CommitID] = nil
+			}
+		}
+
+		if paths := changed[f.CommitID]; paths != nil && !paths[f.TreePath] {
+			reviewed[f.TreePath] = true
+		}
+	}
+	return reviewed
+}
+
+// ChangedFilesAt returns paths of files that are changed by the pull request
+// from its merge base to the head commit in the repository at the path.
+func (pr *PullRequest) ChangedFilesAt(repoPath, headCommitID string) ([]string, error) {
+	output, err := git.NewCommand("diff", "-z", "--name-only", pr.MergeBase, headCommitID).RunInDirBytes(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("list changed files: %v", err)
+	}
+
+	var files []string
+	for _, name := range bytes.Split(output, []byte{0}) {
+		if len(name) > 0 {
+			files = append(files, string(name))
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_unchangedReviewedFiles(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	base := repo.CommitFile("README", "init")
+	repo.CommitFile("a.txt", "a")
+	first := repo.CommitFile("b.txt", "b")
+	head := repo.CommitFile("a.txt", "a2")
+
+	Convey("Keep marks of files that are not changed since", t, func() {
+		reviewed := unchangedReviewedFiles(dir, head, []*ReviewedFile{
+			{TreePath: "a.txt", CommitID: first},
+			{TreePath: "b.txt", CommitID: first},
+			{TreePath: "README", CommitID: head},
+			{TreePath: "c.txt", CommitID: strings.Repeat("0", 39) + "1"},
+		})
+		So(reviewed, ShouldResemble, map[string]bool{
+			"b.txt":  true,
+			"README": true,
+		})
+	})
+
+	Convey("List files changed by the pull request", t, func() {
+		files, err := (&PullRequest{MergeBase: base}).ChangedFilesAt(dir, head)
+		So(err, ShouldBeNil)
+		So(files, ShouldResemble, []string{"a.txt", "b.txt"})
+	})
+}
+
+func TestPullReviewState(t *testing.T) {
+	setupTestDB(t)
+
+	repo := testutil.InitGitRepo(t, "")
+	base := repo.CommitFile("README", "init")
+	repo.CommitFile("a.txt", "a")
+	first := repo.CommitFile("b.txt", "b")
+
+	pr := &PullRequest{ID: 1, MergeBase: base}
+	const userID = 1
+
+	Convey("Only mark files changed by the pull request", t, func() {
+		state, err := pr.ReviewStateAt(userID, repo.Dir, first)
+		So(err, ShouldBeNil)
+		So(state.ChangedFiles, ShouldResemble, []string{"a.txt", "b.txt"})
+
+		err = state.ToggleFile("README", true)
+		So(IsErrPullFileNotChanged(err), ShouldBeTrue)
+		So(state.ToggleFile("../b.txt", true), ShouldNotBeNil)
+		So(state.ToggleFile("a.txt", true), ShouldBeNil)
+		So(state.ToggleFile("b.txt", true), ShouldBeNil)
+		So(state.ToggleFile("b.txt", false), ShouldBeNil)
+
+		viewed, total := state.Progress()
+		So(viewed, ShouldEqual, 1)
+		So(total, ShouldEqual, 2)
+
+		files, err := GetReviewedFiles(pr.ID, userID)
+		So(err, ShouldBeNil)
+		So(files, ShouldHaveLength, 1)
+		So(files[0].TreePath, ShouldEqual, "a.txt")
+		So(files[0].CommitID, ShouldEqual, first)
+	})
+
+	Convey("Unmark files changed by new commits", t, func() {
+		head := repo.CommitFile("a.txt", "a2")
+		state, err := pr.ReviewStateAt(userID, repo.Dir, head)
+		So(err, ShouldBeNil)
+		So(state.ReviewedFiles, ShouldBeEmpty)
+		viewed, total := state.Progress()
+		So(viewed, ShouldEqual, 0)
+		So(total, ShouldEqual, 2)
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/process"
+)
+
+// changedPaths returns paths of files changed from the merge base of two
+// revisions to the head revision in the repository at given path.
+func changedPaths(repoPath, baseRev, headRev string) ([]string, error) {
+	if strings.HasPrefix(baseRev, "-") || strings.HasPrefix(headRev, "-") {
+		return nil, fmt.Errorf("invalid revisions %q and %q", baseRev, headRev)
+	}
+
+	stdout, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("changedPaths 'git diff': %s", repoPath),
+		"git", "diff", "--name-only", "-z", baseRev+"..."+headRev, "--")
+	if err != nil {
+		return nil, fmt.Errorf("git diff: %v - %s", err, stderr)
+	}
+
+	paths := strings.Split(strings.TrimSuffix(stdout, "\x00"), "\x00")
+	if len(paths) == 1 && paths[0] == "" {
+		return nil, nil
+	}
+	return paths, nil
+}
+
+// reviewersOfPaths returns code owners of given paths according to the
+// CODEOWNERS file in the tree of the base commit.
+func (repo *Repository) reviewersOfPaths(baseCommit *git.Commit, paths []string) ([]*User, []*Team, error) {
+	fpath, data, err := readCodeOwners(baseCommit)
+	if err != nil || fpath == "" {
+		return nil, nil, err
+	}
+
+	rules := ParseCodeOwners(data)
+	owners := make([]string, 0, 5)
+	matched := make(map[*CodeOwnersRule]bool)
+	for _, p := range paths {
+		rule := MatchCodeOwners(rules, p)
+		if rule == nil || matched[rule] {
+			continue
+		}
+		matched[rule] = true
+		owners = append(owners, rule.Owners...)
+	}
+	return repo.resolveCodeOwners(owners)
+}
+
+// PreviewReviewers returns users and teams who own files changed by a pull
+// request from the head branch to the base branch of the repository, according
+// to the CODEOWNERS file of the base branch.
+func (repo *Repository) PreviewReviewers(headBranch, baseBranch string) ([]*User, []*Team, error) {
+	return repo.PreviewReviewersOfCompare(repo.RepoPath(), baseBranch, baseBranch, headBranch)
+}
+
+// PreviewReviewersOfCompare is like PreviewReviewers but the changes are made
+// in the head repository at given path, which may be a fork. The base revision
+// must exist in the head repository, e.g. the merge base of the branches.
+func (repo *Repository) PreviewReviewersOfCompare(headRepoPath, baseBranch, baseRev, headRev string) ([]*User, []*Team, error) {
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return nil, nil, fmt.Errorf("open repository: %v", err)
+	}
+	baseCommit, err := gitRepo.GetBranchCommit(baseBranch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get branch commit: %v", err)
+	}
+
+	paths, err := changedPaths(headRepoPath, baseRev, headRev)
+	if err != nil {
+		return nil, nil, err
+	}
+	return repo.reviewersOfPaths(baseCommit, paths)
+}
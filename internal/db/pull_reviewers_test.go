@@ -0,0 +1,38 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_changedPaths(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	repo.CommitFile("README", "init")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	repo.CommitFile("internal/db/repo.go", "package db")
+	repo.CommitFile("templates/home.tmpl", "home")
+	repo.Git("checkout", "--quiet", "master")
+	repo.CommitFile("other.txt", "other")
+
+	Convey("Only list files changed by the head branch", t, func() {
+		paths, err := changedPaths(dir, "master", "feature")
+		So(err, ShouldBeNil)
+		So(paths, ShouldResemble, []string{"internal/db/repo.go", "templates/home.tmpl"})
+
+		paths, err = changedPaths(dir, "feature", "feature")
+		So(err, ShouldBeNil)
+		So(paths, ShouldBeEmpty)
+
+		_, err = changedPaths(dir, "--output=x", "feature")
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogs/git-module"
+	log "unknwon.dev/clog/v2"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+// PushCredentialType is the type of the credential that authenticated a push.
+type PushCredentialType string
+
+const (
+	PushCredentialSSHKey    PushCredentialType = "ssh_key"
+	PushCredentialDeployKey PushCredentialType = "deploy_key"
+	PushCredentialToken     PushCredentialType = "token"
+	PushCredentialPassword  PushCredentialType = "password"
+	// PushCredentialWeb is for changes made in the web UI, which are pushed by
+	// Gogs on behalf of the signed in user. Changes made through the API are
+	// recorded with the token or the password of the request.
+	PushCredentialWeb PushCredentialType = "web"
+)
+
+// PushCredential is the credential that authenticated a push.
+type PushCredential struct {
+	Type PushCredentialType
+	// ID is the ID of the public key, the deploy key or the access token.
+	ID int64
+	// Name is the fingerprint of the key or the name of the access token.
+	Name string
+}
+
+// PushLog is a ref updated by a push to a repository, with the credential that
+// authenticated the push and the address it came from.
+type PushLog struct {
+	ID             int64
+	RepoID         int64 `xorm:"INDEX"`
+	PusherID       int64
+	PusherName     string
+	CredentialType PushCredentialType `xorm:"VARCHAR(20)"`
+	CredentialID   int64
+	CredentialName string
+	RemoteAddr     string
+	IsWiki         bool `xorm:"NOT NULL DEFAULT false"`
+	RefName        string
+	OldCommitID    string `xorm:"VARCHAR(40)"`
+	NewCommitID    string `xorm:"VARCHAR(40)"`
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64     `xorm:"INDEX"`
+}
+
+func (l *PushLog) BeforeInsert() {
+	l.CreatedUnix = time.Now().Unix()
+}
+
+func (l *PushLog) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		l.Created = time.Unix(l.CreatedUnix, 0).Local()
+	}
+}
+
+// ShortRefName returns the name of the ref without the prefix of branches or
+// tags.
+func (l *PushLog) ShortRefName() string {
+	if strings.HasPrefix(l.RefName, git.BRANCH_PREFIX) {
+		return strings.TrimPrefix(l.RefName, git.BRANCH_PREFIX)
+	}
+	return strings.TrimPrefix(l.RefName, git.TAG_PREFIX)
+}
+
+// IsTag returns true if the ref is a tag.
+func (l *PushLog) IsTag() bool {
+	return strings.HasPrefix(l.RefName, git.TAG_PREFIX)
+}
+
+// IsCreate returns true if the ref was created by the push.
+func (l *PushLog) IsCreate() bool {
+	return l.OldCommitID == git.EMPTY_SHA
+}
+
+// IsDelete returns true if the ref was deleted by the push.
+func (l *PushLog) IsDelete() bool {
+	return l.NewCommitID == git.EMPTY_SHA
+}
+
+// AddPushLogs records refs updated by a single push.
+func AddPushLogs(logs []*PushLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	_, err := x.Insert(&logs)
+	return err
+}
+
+// PushLogsOptions contains options to list push logs of a repository.
+type PushLogsOptions struct {
+	// Since excludes pushes before the time if it is not zero.
+	Since    time.Time
+	Page     int
+	PageSize int
+}
+
+// PushLogs returns a page of push logs of the repository, newest first, and
+// the total number of them.
+func (repo *Repository) PushLogs(opts PushLogsOptions) ([]*PushLog, int64, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+
+	cond := func() *xorm.Session {
+		sess := x.Where("repo_id = ?", repo.ID)
+		if !opts.Since.IsZero() {
+			sess.And("created_unix >= ?", opts.Since.Unix())
+		}
+		return sess
+	}
+	total, err := cond().Count(new(PushLog))
+	if err != nil {
+		return nil, 0, fmt.Errorf("count: %v", err)
+	}
+
+	logs := make([]*PushLog, 0, opts.PageSize)
+	if err = cond().Limit(opts.PageSize, (opts.Page-1)*opts.PageSize).Desc("id").Find(&logs); err != nil {
+		return nil, 0, fmt.Errorf("find: %v", err)
+	}
+	return logs, total, nil
+}
+
+// pushLogRetentionCutoff returns the time before which push logs are deleted,
+// or zero time if they are kept forever.
+func pushLogRetentionCutoff(now time.Time, retentionDays int) time.Time {
+	if retentionDays <= 0 {
+		return time.Time{}
+	}
+	return now.AddDate(0, 0, -retentionDays)
+}
+
+// DeleteExpiredPushLogs deletes push logs that are older than
+// "[repository.push_log] RETENTION_DAYS".
+func DeleteExpiredPushLogs() {
+	if taskStatusTable.IsRunning(_PUSH_LOG_CLEANUP) {
+		return
+	}
+	taskStatusTable.Start(_PUSH_LOG_CLEANUP)
+	defer taskStatusTable.Stop(_PUSH_LOG_CLEANUP)
+
+	log.Trace("Doing: DeleteExpiredPushLogs")
+
+	cutoff := pushLogRetentionCutoff(time.Now(), conf.Repository.PushLog.RetentionDays)
+	if cutoff.IsZero() {
+		return
+	}
+	if _, err := x.Where("created_unix < ?", cutoff.Unix()).Delete(new(PushLog)); err != nil {
+		log.Error("Failed to delete expired push logs: %v", err)
+	}
+}
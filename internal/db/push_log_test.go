@@ -0,0 +1,73 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_ComposeHookEnvs(t *testing.T) {
+	Convey("Compose credential of the push into hook environment", t, func() {
+		envs := ComposeHookEnvs(ComposeHookEnvsOptions{
+			AuthUser: &User{ID: 2, Name: "alice"},
+			Credential: PushCredential{
+				Type: PushCredentialSSHKey,
+				ID:   3,
+				Name: "SHA256:abc",
+			},
+			RemoteAddr: "192.0.2.1",
+		})
+		So(envs, ShouldContain, ENV_AUTH_USER_ID+"=2")
+		So(envs, ShouldContain, ENV_AUTH_CREDENTIAL_TYPE+"=ssh_key")
+		So(envs, ShouldContain, ENV_AUTH_CREDENTIAL_ID+"=3")
+		So(envs, ShouldContain, ENV_AUTH_CREDENTIAL_NAME+"=SHA256:abc")
+		So(envs, ShouldContain, ENV_REMOTE_ADDR+"=192.0.2.1")
+
+		Convey("Deploy keys do not have users", func() {
+			envs := ComposeHookEnvs(ComposeHookEnvsOptions{
+				Credential: PushCredential{Type: PushCredentialDeployKey, ID: 4},
+			})
+			So(envs, ShouldContain, ENV_AUTH_USER_ID+"=0")
+			So(envs, ShouldContain, ENV_AUTH_CREDENTIAL_TYPE+"=deploy_key")
+		})
+	})
+}
+
+func TestPushLog(t *testing.T) {
+	Convey("Describe the ref updated by a push", t, func() {
+		l := &PushLog{
+			RefName:     git.TAG_PREFIX + "v1.0",
+			OldCommitID: git.EMPTY_SHA,
+			NewCommitID: "2c3f0da8eb3a6e4a8b7d0e5d4e4f2bd6e0b0f1a9",
+		}
+		So(l.ShortRefName(), ShouldEqual, "v1.0")
+		So(l.IsTag(), ShouldBeTrue)
+		So(l.IsCreate(), ShouldBeTrue)
+		So(l.IsDelete(), ShouldBeFalse)
+
+		l = &PushLog{
+			RefName:     git.BRANCH_PREFIX + "feature/v1.0",
+			OldCommitID: "2c3f0da8eb3a6e4a8b7d0e5d4e4f2bd6e0b0f1a9",
+			NewCommitID: git.EMPTY_SHA,
+		}
+		So(l.ShortRefName(), ShouldEqual, "feature/v1.0")
+		So(l.IsTag(), ShouldBeFalse)
+		So(l.IsCreate(), ShouldBeFalse)
+		So(l.IsDelete(), ShouldBeTrue)
+	})
+}
+
+func Test_pushLogRetentionCutoff(t *testing.T) {
+	Convey("Compute the time before which push logs are deleted", t, func() {
+		now := time.Date(2020, 3, 10, 12, 0, 0, 0, time.UTC)
+		So(pushLogRetentionCutoff(now, 30), ShouldResemble, time.Date(2020, 2, 9, 12, 0, 0, 0, time.UTC))
+		So(pushLogRetentionCutoff(now, 0).IsZero(), ShouldBeTrue)
+		So(pushLogRetentionCutoff(now, -1).IsZero(), ShouldBeTrue)
+	})
+}
@@ -41,7 +41,12 @@ type Release struct {
 	Created     time.Time `xorm:"-" json:"-"`
 	CreatedUnix int64
 
-	Attachments []*Attachment `xorm:"-" json:"-"`
+	Attachments []*Attachment  `xorm:"-" json:"-"`
+	Links       []*ReleaseLink `xorm:"-" json:"-"`
+	// Verification is the result of verifying the signature of the tag.
+	Verification *TagVerification `xorm:"-" json:"-"`
+	// IsTagProtected is true if the tag cannot be deleted or overwritten.
+	IsTagProtected bool `xorm:"-" json:"-"`
 }
 
 func (r *Release) BeforeInsert() {
@@ -84,6 +89,13 @@ func (r *Release) loadAttributes(e Engine) (err error) {
 		}
 	}
 
+	if r.Links == nil {
+		r.Links, err = getReleaseLinks(e, r.ID)
+		if err != nil {
+			return fmt.Errorf("getReleaseLinks [%d]: %v", r.ID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -150,18 +162,23 @@ func createTag(gitRepo *git.Repository, r *Release) error {
 }
 
 func (r *Release) preparePublishWebhooks() {
-	if err := PrepareWebhooks(r.Repo, HOOK_EVENT_RELEASE, &api.ReleasePayload{
+	if err := PrepareWebhooks(r.Repo, HOOK_EVENT_RELEASE, NewReleasePayload(&api.ReleasePayload{
 		Action:     api.HOOK_RELEASE_PUBLISHED,
 		Release:    r.APIFormat(),
 		Repository: r.Repo.APIFormat(nil),
 		Sender:     r.Publisher.APIFormat(),
-	}); err != nil {
+	}, r.Links)); err != nil {
 		log.Error("PrepareWebhooks: %v", err)
 	}
 }
 
-// NewRelease creates a new release with attachments for repository.
-func NewRelease(gitRepo *git.Repository, r *Release, uuids []string) error {
+// NewRelease creates a new release with attachments and external links for
+// repository.
+func NewRelease(gitRepo *git.Repository, r *Release, uuids []string, links []*ReleaseLink) error {
+	if err := validateReleaseLinks(links); err != nil {
+		return err
+	}
+
 	isExist, err := IsReleaseExist(r.RepoID, r.TagName)
 	if err != nil {
 		return err
@@ -190,6 +207,10 @@ func NewRelease(gitRepo *git.Repository, r *Release, uuids []string) error {
 		}
 	}
 
+	if err = replaceReleaseLinks(sess, r.ID, links); err != nil {
+		return err
+	}
+
 	if err = sess.Commit(); err != nil {
 		return fmt.Errorf("Commit: %v", err)
 	}
@@ -236,6 +257,20 @@ func GetReleaseByID(id int64) (*Release, error) {
 	return r, r.LoadAttributes()
 }
 
+// GetLatestReleaseByRepoID returns the latest published release of repository,
+// pre-releases are excluded.
+func GetLatestReleaseByRepoID(repoID int64) (*Release, error) {
+	r := new(Release)
+	has, err := x.Where("repo_id = ?", repoID).And("is_draft = ?", false).And("is_prerelease = ?", false).
+		Desc("created_unix").Get(r)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrReleaseNotExist{0, ""}
+	}
+	return r, nil
+}
+
 // GetPublishedReleasesByRepoID returns a list of published releases of repository.
 // If matches is not empty, only published releases in matches will be returned.
 // In any case, drafts won't be returned by this function.
@@ -280,8 +315,13 @@ func SortReleases(rels []*Release) {
 	sort.Sort(sorter)
 }
 
-// UpdateRelease updates information of a release.
-func UpdateRelease(doer *User, gitRepo *git.Repository, r *Release, isPublish bool, uuids []string) (err error) {
+// UpdateRelease updates information of a release, and replaces its attachments
+// and external links.
+func UpdateRelease(doer *User, gitRepo *git.Repository, r *Release, isPublish bool, uuids []string, links []*ReleaseLink) (err error) {
+	if err = validateReleaseLinks(links); err != nil {
+		return err
+	}
+
 	if err = createTag(gitRepo, r); err != nil {
 		return fmt.Errorf("createTag: %v", err)
 	}
@@ -308,6 +348,11 @@ func UpdateRelease(doer *User, gitRepo *git.Repository, r *Release, isPublish bo
 		}
 	}
 
+	if err = replaceReleaseLinks(sess, r.ID, links); err != nil {
+		return err
+	}
+	r.Links = links
+
 	if err = sess.Commit(); err != nil {
 		return fmt.Errorf("Commit: %v", err)
 	}
@@ -337,6 +382,14 @@ func DeleteReleaseOfRepoByID(repoID, id int64) error {
 		return fmt.Errorf("GetRepositoryByID: %v", err)
 	}
 
+	// Deleting a release deletes its tag as well.
+	protected, err := IsTagProtected(repo.ID, rel.TagName)
+	if err != nil {
+		return fmt.Errorf("IsTagProtected: %v", err)
+	} else if protected {
+		return ErrTagProtected{TagName: rel.TagName}
+	}
+
 	_, stderr, err := process.ExecDir(-1, repo.RepoPath(),
 		fmt.Sprintf("DeleteReleaseByID (git tag -d): %d", rel.ID),
 		"git", "tag", "-d", rel.TagName)
@@ -346,6 +399,10 @@ func DeleteReleaseOfRepoByID(repoID, id int64) error {
 
 	if _, err = x.Id(rel.ID).Delete(new(Release)); err != nil {
 		return fmt.Errorf("Delete: %v", err)
+	} else if _, err = x.Where("release_id = ?", rel.ID).Delete(new(ReleaseLink)); err != nil {
+		return fmt.Errorf("delete links: %v", err)
+	} else if _, err = x.Where("release_id = ?", rel.ID).Delete(new(ReleaseDownload)); err != nil {
+		return fmt.Errorf("delete download stats: %v", err)
 	}
 
 	return nil
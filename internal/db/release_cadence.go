@@ -0,0 +1,79 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// CadenceStats is the rhythm of releases of a repository.
+type CadenceStats struct {
+	NumReleases int
+	// Average and Median are the time between consecutive releases, which are
+	// zero when there are fewer than two releases.
+	Average time.Duration
+	Median  time.Duration
+	// LastRelease is the time of the latest release, and SinceLast is the time
+	// elapsed since then.
+	LastRelease time.Time
+	SinceLast   time.Duration
+}
+
+// HasIntervals returns true if there are enough releases to tell the time
+// between releases.
+func (s *CadenceStats) HasIntervals() bool {
+	return s.NumReleases >= 2
+}
+
+// computeCadence returns the cadence of releases that are created at given
+// Unix times, as of the time now.
+func computeCadence(createdUnixes []int64, now time.Time) *CadenceStats {
+	stats := &CadenceStats{
+		NumReleases: len(createdUnixes),
+	}
+	if len(createdUnixes) == 0 {
+		return stats
+	}
+
+	times := make([]int64, len(createdUnixes))
+	copy(times, createdUnixes)
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	stats.LastRelease = time.Unix(times[len(times)-1], 0)
+	if stats.SinceLast = now.Sub(stats.LastRelease); stats.SinceLast < 0 {
+		stats.SinceLast = 0
+	}
+	if len(times) < 2 {
+		return stats
+	}
+
+	intervals := make([]int64, len(times)-1)
+	for i := range intervals {
+		intervals[i] = times[i+1] - times[i]
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+
+	stats.Average = time.Duration((times[len(times)-1]-times[0])/int64(len(intervals))) * time.Second
+	median := intervals[len(intervals)/2]
+	if len(intervals)%2 == 0 {
+		median = (intervals[len(intervals)/2-1] + median) / 2
+	}
+	stats.Median = time.Duration(median) * time.Second
+	return stats
+}
+
+// ReleaseCadence returns the rhythm of published releases of the repository,
+// pre-releases are excluded.
+func (repo *Repository) ReleaseCadence() (*CadenceStats, error) {
+	createdUnixes := make([]int64, 0, 10)
+	if err := x.Table("release").Cols("created_unix").
+		Where("repo_id = ? AND is_draft = ? AND is_prerelease = ?", repo.ID, false, false).
+		Find(&createdUnixes); err != nil {
+		return nil, fmt.Errorf("find releases: %v", err)
+	}
+	return computeCadence(createdUnixes, time.Now()), nil
+}
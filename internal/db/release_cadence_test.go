@@ -0,0 +1,51 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_computeCadence(t *testing.T) {
+	day := int64(24 * time.Hour / time.Second)
+	start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(days int64) int64 {
+		return start.Unix() + days*day
+	}
+
+	Convey("Compute cadence of releases", t, func() {
+		stats := computeCadence([]int64{at(30), at(0), at(10), at(20), at(70)}, start.AddDate(0, 0, 75))
+		So(stats.NumReleases, ShouldEqual, 5)
+		So(stats.HasIntervals(), ShouldBeTrue)
+		So(stats.Average, ShouldEqual, 70*24*time.Hour/4)
+		So(stats.Median, ShouldEqual, 10*24*time.Hour)
+		So(stats.LastRelease.Unix(), ShouldEqual, at(70))
+		So(stats.SinceLast, ShouldEqual, 5*24*time.Hour)
+	})
+
+	Convey("Compute median of an odd number of intervals", t, func() {
+		stats := computeCadence([]int64{at(0), at(1), at(10), at(40)}, start.AddDate(0, 0, 40))
+		So(stats.Median, ShouldEqual, 9*24*time.Hour)
+		So(stats.SinceLast, ShouldEqual, time.Duration(0))
+	})
+
+	Convey("Tell only time since the only release", t, func() {
+		stats := computeCadence([]int64{at(0)}, start.AddDate(0, 0, 3))
+		So(stats.HasIntervals(), ShouldBeFalse)
+		So(stats.Average, ShouldEqual, time.Duration(0))
+		So(stats.Median, ShouldEqual, time.Duration(0))
+		So(stats.SinceLast, ShouldEqual, 3*24*time.Hour)
+	})
+
+	Convey("No releases", t, func() {
+		stats := computeCadence(nil, start)
+		So(stats.NumReleases, ShouldEqual, 0)
+		So(stats.HasIntervals(), ShouldBeFalse)
+		So(stats.LastRelease.IsZero(), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/graceful"
+)
+
+// ReleaseDownload is the number of downloads of an asset of a release in a day.
+type ReleaseDownload struct {
+	ID           int64
+	ReleaseID    int64 `xorm:"INDEX"`
+	AttachmentID int64 `xorm:"UNIQUE(s)"`
+	DayUnix      int64 `xorm:"UNIQUE(s)"`
+	Count        int64 `xorm:"NOT NULL DEFAULT 0"`
+}
+
+type releaseDownloadKey struct {
+	ReleaseID    int64
+	AttachmentID int64
+	DayUnix      int64
+}
+
+// releaseDownloads buffers downloads of release assets in memory, which are
+// flushed to the database every "[release.attachment]
+// DOWNLOAD_STATS_FLUSH_INTERVAL" so that downloads do not write to the
+// database synchronously.
+var releaseDownloads = struct {
+	sync.Mutex
+	counts map[releaseDownloadKey]int64
+}{
+	counts: make(map[releaseDownloadKey]int64),
+}
+
+// isRangeContinuation returns true if the value of the Range header of a
+// request asks for a part other than the beginning of the file, i.e. the
+// request continues a download that has been counted already.
+func isRangeContinuation(rangeHeader string) bool {
+	const prefix = "bytes="
+	if rangeHeader == "" || !strings.HasPrefix(rangeHeader, prefix) {
+		return false
+	}
+
+	first := strings.TrimSpace(strings.SplitN(rangeHeader[len(prefix):], ",", 2)[0])
+	return !strings.HasPrefix(first, "0-")
+}
+
+// CountAttachmentDownload counts a download of the attachment if it is an
+// asset of a release, with the status code of the response to a GET request
+// and the value of the Range header of the request. Only complete responses
+// and partial ones that start from the beginning of the file are counted, so
+// that cached and resumed downloads are not counted again. The count is
+// buffered and written to the database later.
+func CountAttachmentDownload(attach *Attachment, status int, rangeHeader string) {
+	if attach.ReleaseID == 0 {
+		return
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusPartialContent:
+		if isRangeContinuation(rangeHeader) {
+			return
+		}
+	default:
+		return
+	}
+
+	key := releaseDownloadKey{
+		ReleaseID:    attach.ReleaseID,
+		AttachmentID: attach.ID,
+		DayUnix:      trafficDay(time.Now()).Unix(),
+	}
+	releaseDownloads.Lock()
+	releaseDownloads.counts[key]++
+	releaseDownloads.Unlock()
+}
+
+// addReleaseDownloads adds the number of downloads to the row of the asset
+// and the day.
+func addReleaseDownloads(key releaseDownloadKey, count int64) error {
+	increase := func() (int64, error) {
+		return x.Where("attachment_id = ? AND day_unix = ?", key.AttachmentID, key.DayUnix).Incr("count", count).Update(new(ReleaseDownload))
+	}
+
+	affected, err := increase()
+	if err != nil {
+		return fmt.Errorf("increase count: %v", err)
+	} else if affected > 0 {
+		return nil
+	}
+
+	_, err = x.Insert(&ReleaseDownload{
+		ReleaseID:    key.ReleaseID,
+		AttachmentID: key.AttachmentID,
+		DayUnix:      key.DayUnix,
+		Count:        count,
+	})
+	if err != nil {
+		// The row may have been inserted by another node of the cluster.
+		if affected, _ = increase(); affected > 0 {
+			return nil
+		}
+		return fmt.Errorf("insert: %v", err)
+	}
+	return nil
+}
+
+// FlushReleaseDownloads writes buffered downloads of release assets to the
+// database. Downloads that fail to be written are kept in the buffer for the
+// next flush.
+func FlushReleaseDownloads() {
+	releaseDownloads.Lock()
+	counts := releaseDownloads.counts
+	releaseDownloads.counts = make(map[releaseDownloadKey]int64, len(counts))
+	releaseDownloads.Unlock()
+
+	for key, count := range counts {
+		if err := addReleaseDownloads(key, count); err != nil {
+			log.Error("Failed to flush release downloads [attachment_id: %d]: %v", key.AttachmentID, err)
+
+			releaseDownloads.Lock()
+			releaseDownloads.counts[key] += count
+			releaseDownloads.Unlock()
+		}
+	}
+}
+
+// InitFlushReleaseDownloads starts flushing buffered downloads of release
+// assets periodically, and once more when the process shuts down. Every node
+// of a cluster flushes its own buffer.
+func InitFlushReleaseDownloads() {
+	interval := conf.Release.Attachment.DownloadStatsFlushInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				FlushReleaseDownloads()
+			case <-graceful.Done():
+				return
+			}
+		}
+	}()
+	graceful.OnShutdown(func(context.Context) {
+		FlushReleaseDownloads()
+	})
+}
+
+// AssetDownloadStats is the number of downloads of an asset of a release.
+type AssetDownloadStats struct {
+	Attachment *Attachment
+	Total      int64
+	// Days are downloads in each of the recent days, in the order of time.
+	Days []DayStat
+}
+
+// ReleaseDownloadStats is the number of downloads of assets of a release.
+type ReleaseDownloadStats struct {
+	Assets []*AssetDownloadStats
+	Total  int64
+	// Days are downloads of all assets in each of the recent days, in the order
+	// of time.
+	Days []DayStat
+}
+
+// DownloadStats returns numbers of downloads of assets of the release in
+// total and in each of the recent days, including today. Downloads that are
+// not flushed yet are not included.
+func (r *Release) DownloadStats(days int) (*ReleaseDownloadStats, error) {
+	if days < 1 {
+		days = 1
+	}
+	end := trafficDay(time.Now())
+	start := end.AddDate(0, 0, -days+1)
+
+	attachments, err := GetAttachmentsByReleaseID(r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get attachments: %v", err)
+	}
+
+	downloads := make([]*ReleaseDownload, 0, 10)
+	if err = x.Where("release_id = ?", r.ID).Find(&downloads); err != nil {
+		return nil, fmt.Errorf("find downloads: %v", err)
+	}
+
+	newDays := func() []DayStat {
+		stats := make([]DayStat, 0, days)
+		for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+			stats = append(stats, DayStat{Day: day})
+		}
+		return stats
+	}
+
+	stats := &ReleaseDownloadStats{
+		Assets: make([]*AssetDownloadStats, len(attachments)),
+		Days:   newDays(),
+	}
+	assets := make(map[int64]*AssetDownloadStats, len(attachments))
+	for i, attach := range attachments {
+		stats.Assets[i] = &AssetDownloadStats{
+			Attachment: attach,
+			Days:       newDays(),
+		}
+		assets[attach.ID] = stats.Assets[i]
+	}
+
+	for _, d := range downloads {
+		// Downloads of attachments that have been removed from the release
+		// are not counted.
+		asset, ok := assets[d.AttachmentID]
+		if !ok {
+			continue
+		}
+		asset.Total += d.Count
+		stats.Total += d.Count
+
+		if d.DayUnix < start.Unix() {
+			continue
+		}
+		i := int(time.Unix(d.DayUnix, 0).UTC().Sub(start).Hours() / 24)
+		if i < len(stats.Days) {
+			asset.Days[i].Total += d.Count
+			stats.Days[i].Total += d.Count
+		}
+	}
+	return stats, nil
+}
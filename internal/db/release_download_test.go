@@ -0,0 +1,50 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_isRangeContinuation(t *testing.T) {
+	Convey("Tell continuations of downloads by Range headers", t, func() {
+		So(isRangeContinuation(""), ShouldBeFalse)
+		So(isRangeContinuation("bytes=0-"), ShouldBeFalse)
+		So(isRangeContinuation("bytes=0-1023"), ShouldBeFalse)
+		So(isRangeContinuation("bytes=0-99, 200-299"), ShouldBeFalse)
+		So(isRangeContinuation("items=5-10"), ShouldBeFalse)
+
+		So(isRangeContinuation("bytes=1024-"), ShouldBeTrue)
+		So(isRangeContinuation("bytes=100-199"), ShouldBeTrue)
+		So(isRangeContinuation("bytes=-500"), ShouldBeTrue)
+		So(isRangeContinuation("bytes=200-299, 0-99"), ShouldBeTrue)
+	})
+}
+
+func Test_CountAttachmentDownload(t *testing.T) {
+	defer func() {
+		releaseDownloads.counts = make(map[releaseDownloadKey]int64)
+	}()
+
+	Convey("Buffer downloads of release assets", t, func() {
+		releaseDownloads.counts = make(map[releaseDownloadKey]int64)
+		asset := &Attachment{ID: 1, ReleaseID: 2}
+
+		CountAttachmentDownload(asset, http.StatusOK, "")
+		CountAttachmentDownload(asset, http.StatusPartialContent, "bytes=0-")
+		CountAttachmentDownload(asset, http.StatusPartialContent, "bytes=4096-")
+		CountAttachmentDownload(asset, http.StatusNotModified, "")
+		CountAttachmentDownload(asset, http.StatusRequestedRangeNotSatisfiable, "bytes=0-")
+		CountAttachmentDownload(&Attachment{ID: 3, IssueID: 4}, http.StatusOK, "")
+
+		So(releaseDownloads.counts, ShouldResemble, map[releaseDownloadKey]int64{
+			{ReleaseID: 2, AttachmentID: 1, DayUnix: trafficDay(time.Now()).Unix()}: 2,
+		})
+	})
+}
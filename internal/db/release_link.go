@@ -0,0 +1,70 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ReleaseLink is an external link of a release, e.g. to a package registry or
+// a mirror of large assets, which is listed along with uploaded assets.
+type ReleaseLink struct {
+	ID        int64
+	ReleaseID int64 `xorm:"INDEX"`
+	Label     string
+	URL       string `xorm:"TEXT"`
+}
+
+// ValidateReleaseLink returns an error if the link has no label or its URL is
+// not an absolute HTTP(S) URL, so that links cannot run scripts when clicked.
+func ValidateReleaseLink(label, rawURL string) error {
+	if strings.TrimSpace(label) == "" {
+		return ErrInvalidReleaseLink{label, rawURL}
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidReleaseLink{label, rawURL}
+	}
+	return nil
+}
+
+func getReleaseLinks(e Engine, releaseID int64) ([]*ReleaseLink, error) {
+	links := make([]*ReleaseLink, 0, 2)
+	return links, e.Where("release_id = ?", releaseID).Asc("id").Find(&links)
+}
+
+// GetReleaseLinks returns external links of the release in the order they were
+// added.
+func GetReleaseLinks(releaseID int64) ([]*ReleaseLink, error) {
+	return getReleaseLinks(x, releaseID)
+}
+
+// replaceReleaseLinks replaces all external links of the release with given
+// links.
+func replaceReleaseLinks(e Engine, releaseID int64, links []*ReleaseLink) error {
+	if _, err := e.Where("release_id = ?", releaseID).Delete(new(ReleaseLink)); err != nil {
+		return fmt.Errorf("delete links: %v", err)
+	}
+	for _, link := range links {
+		link.ID = 0
+		link.ReleaseID = releaseID
+		if _, err := e.Insert(link); err != nil {
+			return fmt.Errorf("insert link: %v", err)
+		}
+	}
+	return nil
+}
+
+func validateReleaseLinks(links []*ReleaseLink) error {
+	for _, link := range links {
+		if err := ValidateReleaseLink(link.Label, link.URL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
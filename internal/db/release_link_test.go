@@ -0,0 +1,26 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_ValidateReleaseLink(t *testing.T) {
+	Convey("Validate external links of releases", t, func() {
+		So(ValidateReleaseLink("Docker image", "https://hub.docker.com/r/gogs/gogs"), ShouldBeNil)
+		So(ValidateReleaseLink("Mirror", "http://mirror.example.com/gogs.zip"), ShouldBeNil)
+
+		So(IsErrInvalidReleaseLink(ValidateReleaseLink("", "https://example.com")), ShouldBeTrue)
+		So(IsErrInvalidReleaseLink(ValidateReleaseLink(" ", "https://example.com")), ShouldBeTrue)
+		So(IsErrInvalidReleaseLink(ValidateReleaseLink("Script", "javascript:alert(1)")), ShouldBeTrue)
+		So(IsErrInvalidReleaseLink(ValidateReleaseLink("Data", "data:text/html,<script>")), ShouldBeTrue)
+		So(IsErrInvalidReleaseLink(ValidateReleaseLink("Relative", "/releases/download")), ShouldBeTrue)
+		So(IsErrInvalidReleaseLink(ValidateReleaseLink("No host", "https:///path")), ShouldBeTrue)
+		So(IsErrInvalidReleaseLink(ValidateReleaseLink("Empty", "")), ShouldBeTrue)
+	})
+}
@@ -7,9 +7,7 @@ package db
 import (
 	"bytes"
 	"fmt"
-	"image"
 	_ "image/jpeg"
-	"image/png"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -20,7 +18,6 @@ import (
 	"time"
 
 	"github.com/mcuadros/go-version"
-	"github.com/nfnt/resize"
 	"github.com/unknwon/cae/zip"
 	"github.com/unknwon/com"
 	"gopkg.in/ini.v1"
@@ -30,7 +27,6 @@ import (
 	"github.com/gogs/git-module"
 	api "github.com/gogs/go-gogs-client"
 
-	"gogs.io/gogs/internal/avatar"
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/markup"
@@ -192,10 +188,17 @@ type Repository struct {
 	ExternalTrackerURL    string
 	ExternalTrackerFormat string
 	ExternalTrackerStyle  string
+	ExternalTrackerRegexp string
+	// CustomCloseReasons are reasons of closing issues added to built-in ones,
+	// one per line.
+	CustomCloseReasons    string            `xorm:"TEXT"`
 	ExternalMetas         map[string]string `xorm:"-" json:"-"`
 	EnablePulls           bool              `xorm:"NOT NULL DEFAULT true"`
 	PullsIgnoreWhitespace bool              `xorm:"NOT NULL DEFAULT false"`
 	PullsAllowRebase      bool              `xorm:"NOT NULL DEFAULT false"`
+	MergeMessageTemplate  string            `xorm:"TEXT"`
+	MergeMessageTrailers  bool              `xorm:"NOT NULL DEFAULT false"`
+	AllowPublicReleases   bool              `xorm:"NOT NULL DEFAULT false"`
 
 	IsFork   bool `xorm:"NOT NULL DEFAULT false"`
 	ForkID   int64
@@ -267,19 +270,31 @@ func (repo *Repository) LoadAttributes() error {
 	return repo.loadAttributes(x)
 }
 
-// IsPartialPublic returns true if repository is public or allow public access to wiki or issues.
+// IsPartialPublic returns true if repository is public or allow public access to wiki, issues or releases.
 func (repo *Repository) IsPartialPublic() bool {
-	return !repo.IsPrivate || repo.AllowPublicWiki || repo.AllowPublicIssues
+	return !repo.IsPrivate || repo.AllowPublicWiki || repo.AllowPublicIssues || repo.AllowPublicReleases
 }
 
 func (repo *Repository) CanGuestViewWiki() bool {
 	return repo.EnableWiki && !repo.EnableExternalWiki && repo.AllowPublicWiki
 }
 
+// HasInternalIssueTracker returns true if issues of the repository are hosted
+// by Gogs. Issues are kept but not used while an external tracker is enabled.
+func (repo *Repository) HasInternalIssueTracker() bool {
+	return repo.EnableIssues && !repo.EnableExternalTracker
+}
+
 func (repo *Repository) CanGuestViewIssues() bool {
 	return repo.EnableIssues && !repo.EnableExternalTracker && repo.AllowPublicIssues
 }
 
+// CanGuestViewReleases returns true if releases and their attachments of the
+// repository are public while its code is not.
+func (repo *Repository) CanGuestViewReleases() bool {
+	return !repo.IsBare && repo.AllowPublicReleases
+}
+
 // MustOwner always returns a valid *User object to avoid conceptually impossible error handling.
 // It creates a fake object that contains error deftail when error occurs.
 func (repo *Repository) MustOwner() *User {
@@ -322,23 +337,15 @@ func (repo *Repository) AvatarLink() string {
 // UploadAvatar saves custom avatar for repository.
 // FIXME: split uploads to different subdirs in case we have massive number of repositories.
 func (repo *Repository) UploadAvatar(data []byte) error {
-	img, _, err := image.Decode(bytes.NewReader(data))
+	data, err := prepareAvatar(data)
 	if err != nil {
-		return fmt.Errorf("decode image: %v", err)
+		return err
 	}
 
 	_ = os.MkdirAll(conf.Picture.RepositoryAvatarUploadPath, os.ModePerm)
-	fw, err := os.Create(repo.CustomAvatarPath())
-	if err != nil {
-		return fmt.Errorf("create custom avatar directory: %v", err)
+	if err = ioutil.WriteFile(repo.CustomAvatarPath(), data, 0644); err != nil {
+		return fmt.Errorf("write custom avatar: %v", err)
 	}
-	defer fw.Close()
-
-	m := resize.Resize(avatar.AVATAR_SIZE, avatar.AVATAR_SIZE, img, resize.NearestNeighbor)
-	if err = png.Encode(fw, m); err != nil {
-		return fmt.Errorf("encode image: %v", err)
-	}
-
 	return nil
 }
 
@@ -445,6 +452,9 @@ func (repo *Repository) ComposeMetas() map[string]string {
 		switch repo.ExternalTrackerStyle {
 		case markup.ISSUE_NAME_STYLE_ALPHANUMERIC:
 			repo.ExternalMetas["style"] = markup.ISSUE_NAME_STYLE_ALPHANUMERIC
+		case markup.ISSUE_NAME_STYLE_REGEXP:
+			repo.ExternalMetas["style"] = markup.ISSUE_NAME_STYLE_REGEXP
+			repo.ExternalMetas["regexp"] = repo.ExternalTrackerRegexp
 		default:
 			repo.ExternalMetas["style"] = markup.ISSUE_NAME_STYLE_NUMERIC
 		}
@@ -518,6 +528,15 @@ func (repo *Repository) GetMilestoneByID(milestoneID int64) (*Milestone, error)
 	return GetMilestoneByRepoID(repo.ID, milestoneID)
 }
 
+// IssueCounts returns the stored numbers of open and closed issues, or pull
+// requests if isPull is true, of the repository.
+func (repo *Repository) IssueCounts(isPull bool) (numOpen, numClosed int64) {
+	if isPull {
+		return int64(repo.NumPulls - repo.NumClosedPulls), int64(repo.NumClosedPulls)
+	}
+	return int64(repo.NumIssues - repo.NumClosedIssues), int64(repo.NumClosedIssues)
+}
+
 // IssueStats returns number of open and closed repository issues by given filter mode.
 func (repo *Repository) IssueStats(userID int64, filterMode FilterMode, isPull bool) (int64, int64) {
 	return GetRepoIssueStats(repo.ID, userID, filterMode, isPull)
@@ -576,8 +595,18 @@ func (repo *Repository) AllowsPulls() bool {
 	return repo.CanEnablePulls() && repo.EnablePulls
 }
 
+// IsBranchRequirePullRequest returns true if the branch requires pull requests
+// by its protection or any rule set of the organization.
 func (repo *Repository) IsBranchRequirePullRequest(name string) bool {
-	return IsBranchOfRepoRequirePullRequest(repo.ID, name)
+	if IsBranchOfRepoRequirePullRequest(repo.ID, name) {
+		return true
+	}
+	required, err := repo.orgRequirePullRequest(name)
+	if err != nil {
+		log.Error("Failed to check rule sets of organization [repo_id: %d, branch: %s]: %v", repo.ID, name, err)
+		return true
+	}
+	return required
 }
 
 // CanEnableEditor returns true if repository meets the requirements of web editor.
@@ -687,6 +716,22 @@ func ComposeHTTPSCloneURL(owner, repo string) string {
 	return fmt.Sprintf("%s%s/%s.git", conf.Server.ExternalURL, owner, repo)
 }
 
+// composeSSHCloneURL returns SSH clone URL based on given owner and repository
+// name, which is in the short scp-like form when the SSH port is standard, and
+// in the "ssh://" form with the port otherwise. IPv6 hosts are enclosed in
+// square brackets in both forms.
+func composeSSHCloneURL(owner, repo string) string {
+	host := conf.SSH.Domain
+	if strings.Contains(host, ":") && !strings.HasPrefix(host, "[") {
+		host = "[" + host + "]"
+	}
+
+	if conf.SSH.Port != 22 {
+		return fmt.Sprintf("ssh://%s@%s:%d/%s/%s.git", conf.App.RunUser, host, conf.SSH.Port, owner, repo)
+	}
+	return fmt.Sprintf("%s@%s:%s/%s.git", conf.App.RunUser, host, owner, repo)
+}
+
 func (repo *Repository) cloneLink(isWiki bool) *CloneLink {
 	repoName := repo.Name
 	if isWiki {
@@ -694,14 +739,10 @@ func (repo *Repository) cloneLink(isWiki bool) *CloneLink {
 	}
 
 	repo.Owner = repo.MustOwner()
-	cl := new(CloneLink)
-	if conf.SSH.Port != 22 {
-		cl.SSH = fmt.Sprintf("ssh://%s@%s:%d/%s/%s.git", conf.App.RunUser, conf.SSH.Domain, conf.SSH.Port, repo.Owner.Name, repoName)
-	} else {
-		cl.SSH = fmt.Sprintf("%s@%s:%s/%s.git", conf.App.RunUser, conf.SSH.Domain, repo.Owner.Name, repoName)
+	return &CloneLink{
+		SSH:   composeSSHCloneURL(repo.Owner.Name, repoName),
+		HTTPS: ComposeHTTPSCloneURL(repo.Owner.Name, repoName),
 	}
-	cl.HTTPS = ComposeHTTPSCloneURL(repo.Owner.Name, repoName)
-	return cl
 }
 
 // CloneLink returns clone URLs of repository.
@@ -853,12 +894,16 @@ var hooksTpls = map[string]string{
 	"post-receive": "#!/usr/bin/env %s\n\"%s\" hook --config='%s' post-receive\n",
 }
 
+// delegateHookContent returns the content of the managed hook that delegates
+// to the server.
+func delegateHookContent(name string) string {
+	return fmt.Sprintf(hooksTpls[name], conf.Repository.ScriptType, conf.AppPath(), conf.CustomConf)
+}
+
 func createDelegateHooks(repoPath string) (err error) {
 	for _, name := range git.HookNames {
 		hookPath := filepath.Join(repoPath, "hooks", name)
-		if err = ioutil.WriteFile(hookPath,
-			[]byte(fmt.Sprintf(hooksTpls[name], conf.Repository.ScriptType, conf.AppPath(), conf.CustomConf)),
-			os.ModePerm); err != nil {
+		if err = ioutil.WriteFile(hookPath, []byte(delegateHookContent(name)), os.ModePerm); err != nil {
 			return fmt.Errorf("create delegate hook '%s': %v", hookPath, err)
 		}
 	}
@@ -1070,6 +1115,8 @@ func createRepository(e *xorm.Session, doer, owner *User, repo *Repository) (err
 
 	if _, err = e.Insert(repo); err != nil {
 		return err
+	} else if err = releaseRepoRedirect(e, owner.ID, repo.Name); err != nil {
+		return fmt.Errorf("releaseRepoRedirect: %v", err)
 	}
 
 	owner.NumRepos++
@@ -1105,8 +1152,9 @@ func createRepository(e *xorm.Session, doer, owner *User, repo *Repository) (err
 
 // CreateRepository creates a repository for given user or organization.
 func CreateRepository(doer, owner *User, opts CreateRepoOptions) (_ *Repository, err error) {
-	if !owner.CanCreateRepo() {
-		return nil, errors.ReachLimitOfRepo{Limit: owner.RepoCreationNum()}
+	policy, err := CheckRepoCreation(doer, owner)
+	if err != nil {
+		return nil, err
 	}
 
 	repo := &Repository{
@@ -1115,7 +1163,7 @@ func CreateRepository(doer, owner *User, opts CreateRepoOptions) (_ *Repository,
 		Name:         opts.Name,
 		LowerName:    strings.ToLower(opts.Name),
 		Description:  opts.Description,
-		IsPrivate:    opts.IsPrivate,
+		IsPrivate:    opts.IsPrivate || policy.ForcePrivate,
 		EnableWiki:   true,
 		EnableIssues: true,
 		EnablePulls:  true,
@@ -1268,6 +1316,13 @@ func TransferOwnership(doer *User, newOwnerName string, repo *Repository) error
 		return fmt.Errorf("update owner: %v", err)
 	}
 
+	// Former names are only redirected under the owner they were used by.
+	if _, err = sess.Delete(&RepoRedirect{RepoID: repo.ID}); err != nil {
+		return fmt.Errorf("delete redirects: %v", err)
+	} else if err = releaseRepoRedirect(sess, newOwner.ID, repo.Name); err != nil {
+		return fmt.Errorf("releaseRepoRedirect: %v", err)
+	}
+
 	// Remove redundant collaborators.
 	collaborators, err := repo.getCollaborators(sess)
 	if err != nil {
@@ -1304,6 +1359,11 @@ func TransferOwnership(doer *User, newOwnerName string, repo *Repository) error
 		if err = owner.removeOrgRepo(sess, repo.ID); err != nil {
 			return fmt.Errorf("removeOrgRepo: %v", err)
 		}
+
+		// Bots only exist within the organization.
+		if _, err = sess.Delete(&BotGrant{RepoID: repo.ID}); err != nil {
+			return fmt.Errorf("delete bot grants: %v", err)
+		}
 	}
 
 	if newOwner.IsOrganization() {
@@ -1360,38 +1420,82 @@ func deleteRepoLocalCopy(repo *Repository) {
 }
 
 // ChangeRepositoryName changes all corresponding setting from old repository name to new one.
+// The old name is redirected to the repository unless only its case is changed, which
+// leaves the lowercase paths on disk as they are.
 func ChangeRepositoryName(u *User, oldRepoName, newRepoName string) (err error) {
-	oldRepoName = strings.ToLower(oldRepoName)
-	newRepoName = strings.ToLower(newRepoName)
 	if err = IsUsableRepoName(newRepoName); err != nil {
 		return err
 	}
 
-	has, err := IsRepositoryExist(u, newRepoName)
-	if err != nil {
-		return fmt.Errorf("IsRepositoryExist: %v", err)
-	} else if has {
-		return ErrRepoAlreadyExist{u.Name, newRepoName}
-	}
-
 	repo, err := GetRepositoryByName(u.ID, oldRepoName)
 	if err != nil {
 		return fmt.Errorf("GetRepositoryByName: %v", err)
 	}
 
-	// Change repository directory name
-	if err = os.Rename(repo.RepoPath(), RepoPath(u.Name, newRepoName)); err != nil {
+	isCaseOnly := repo.LowerName == strings.ToLower(newRepoName)
+	if !isCaseOnly {
+		has, err := IsRepositoryExist(u, newRepoName)
+		if err != nil {
+			return fmt.Errorf("IsRepositoryExist: %v", err)
+		} else if has {
+			return ErrRepoAlreadyExist{u.Name, newRepoName}
+		}
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	oldLowerName := repo.LowerName
+	repo.Name = newRepoName
+	repo.LowerName = strings.ToLower(newRepoName)
+	if _, err = sess.ID(repo.ID).Cols("name", "lower_name").Update(repo); err != nil {
+		return fmt.Errorf("update name: %v", err)
+	}
+	if isCaseOnly {
+		return sess.Commit()
+	}
+
+	if err = releaseRepoRedirect(sess, u.ID, newRepoName); err != nil {
+		return fmt.Errorf("releaseRepoRedirect: %v", err)
+	} else if err = newRepoRedirect(sess, u.ID, oldLowerName, repo.ID); err != nil {
+		return fmt.Errorf("newRepoRedirect: %v", err)
+	}
+
+	// Change repository directory name, the directories are moved back if the
+	// transaction fails so they stay consistent with the database.
+	oldRepoPath := RepoPath(u.Name, oldLowerName)
+	newRepoPath := RepoPath(u.Name, newRepoName)
+	if err = os.Rename(oldRepoPath, newRepoPath); err != nil {
 		return fmt.Errorf("rename repository directory: %v", err)
 	}
+	renamed := [][2]string{{oldRepoPath, newRepoPath}}
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, paths := range renamed {
+			if err2 := os.Rename(paths[1], paths[0]); err2 != nil {
+				log.Error("Failed to restore directory %q: %v", paths[0], err2)
+			}
+		}
+	}()
 
-	wikiPath := repo.WikiPath()
-	if com.IsExist(wikiPath) {
-		if err = os.Rename(wikiPath, WikiPath(u.Name, newRepoName)); err != nil {
+	oldWikiPath := WikiPath(u.Name, oldLowerName)
+	if com.IsExist(oldWikiPath) {
+		newWikiPath := WikiPath(u.Name, newRepoName)
+		if err = os.Rename(oldWikiPath, newWikiPath); err != nil {
 			return fmt.Errorf("rename repository wiki: %v", err)
 		}
-		RemoveAllWithNotice("Delete repository wiki local copy", repo.LocalWikiPath())
+		renamed = append(renamed, [2]string{oldWikiPath, newWikiPath})
 	}
 
+	if err = sess.Commit(); err != nil {
+		return fmt.Errorf("commit: %v", err)
+	}
+	RemoveAllWithNotice("Delete repository wiki local copy", repo.LocalWikiPath())
 	deleteRepoLocalCopy(repo)
 	return nil
 }
@@ -1450,6 +1554,20 @@ func updateRepository(e Engine, repo *Repository, visibilityChanged bool) (err e
 			return fmt.Errorf("getRepositoriesByForkID: %v", err)
 		}
 		for i := range forkRepos {
+			// Forks which cannot be made public by the policy of their owners
+			// stay private.
+			if !repo.IsPrivate {
+				if err = forkRepos[i].getOwner(e); err != nil {
+					return fmt.Errorf("getOwner[%d]: %v", forkRepos[i].ID, err)
+				}
+				policy, err := forkRepos[i].Owner.getRepoPolicy(e)
+				if err != nil {
+					return fmt.Errorf("getRepoPolicy[%d]: %v", forkRepos[i].ID, err)
+				} else if policy.ForbidVisibilityChange {
+					continue
+				}
+			}
+
 			forkRepos[i].IsPrivate = repo.IsPrivate
 			if err = updateRepository(e, forkRepos[i], true); err != nil {
 				return fmt.Errorf("updateRepository[%d]: %v", forkRepos[i].ID, err)
@@ -1472,7 +1590,7 @@ func UpdateRepository(repo *Repository, visibilityChanged bool) (err error) {
 		return err
 	}
 
-	if err = updateRepository(x, repo, visibilityChanged); err != nil {
+	if err = updateRepository(sess, repo, visibilityChanged); err != nil {
 		return fmt.Errorf("updateRepository: %v", err)
 	}
 
@@ -1516,22 +1634,67 @@ func DeleteRepository(uid, repoID int64) error {
 		}
 	}
 
+	if _, err = sess.Where("pull_id IN (SELECT id FROM pull_request WHERE base_repo_id = ?)", repoID).Delete(new(ReviewedFile)); err != nil {
+		return fmt.Errorf("delete reviewed files: %v", err)
+	}
+	if _, err = sess.Where("pull_id IN (SELECT id FROM pull_request WHERE base_repo_id = ?)", repoID).Delete(new(CodeOwnerApproval)); err != nil {
+		return fmt.Errorf("delete code owner approvals: %v", err)
+	}
+
+	// "release" is a reserved word in some databases, so IDs are queried first
+	// instead of using a subquery.
+	releaseIDs := make([]int64, 0, 10)
+	if err = sess.Table("release").Cols("id").Where("repo_id = ?", repoID).Find(&releaseIDs); err != nil {
+		return fmt.Errorf("find releases: %v", err)
+	}
+	if len(releaseIDs) > 0 {
+		if _, err = sess.In("release_id", releaseIDs).Delete(new(ReleaseLink)); err != nil {
+			return fmt.Errorf("delete release links: %v", err)
+		} else if _, err = sess.In("release_id", releaseIDs).Delete(new(ReleaseDownload)); err != nil {
+			return fmt.Errorf("delete release download stats: %v", err)
+		}
+	}
+
 	if err = deleteBeans(sess,
 		&Repository{ID: repoID},
 		&Access{RepoID: repo.ID},
 		&Action{RepoID: repo.ID},
 		&Watch{RepoID: repoID},
+		&MuteSchedule{RepoID: repoID},
+		&PathWatch{RepoID: repoID},
 		&Star{RepoID: repoID},
 		&Mirror{RepoID: repoID},
 		&IssueUser{RepoID: repoID},
 		&Milestone{RepoID: repoID},
 		&Release{RepoID: repoID},
+		&CommitStatus{RepoID: repoID},
 		&Collaboration{RepoID: repoID},
+		&BotGrant{RepoID: repoID},
+		&RepoInvite{RepoID: repoID},
 		&PullRequest{BaseRepoID: repoID},
+		&ReviewReminder{RepoID: repoID},
+		&Secret{RepoID: repoID},
+		&IssueAutoLock{RepoID: repoID},
+		&VisibilitySchedule{RepoID: repoID},
+		&RepoTraffic{RepoID: repoID},
+		&PushLog{RepoID: repoID},
 		&ProtectBranch{RepoID: repoID},
 		&ProtectBranchWhitelist{RepoID: repoID},
+		&ProtectTag{RepoID: repoID},
+		&CommitLintRules{RepoID: repoID},
+		&RepoRelation{RepoID: repoID},
+		&RepoRelation{RelatedRepoID: repoID},
+		&LifecycleState{Type: LIFECYCLE_REPO, SubjectID: repoID},
+		&OrgRuleSetExemption{RepoID: repoID},
 		&Webhook{RepoID: repoID},
 		&HookTask{RepoID: repoID},
+		&RepoManifest{RepoID: repoID},
+		&RepoDependency{RepoID: repoID},
+		&RepoDependencyScan{RepoID: repoID},
+		&RepoCommitStats{RepoID: repoID},
+		&RepoCommitsCount{RepoID: repoID},
+		&RecentPush{RepoID: repoID},
+		&RepoRedirect{RepoID: repoID},
 	); err != nil {
 		return fmt.Errorf("deleteBeans: %v", err)
 	}
@@ -1545,6 +1708,8 @@ func DeleteRepository(uid, repoID int64) error {
 	for i := range issues {
 		if _, err = sess.Delete(&Comment{IssueID: issues[i].ID}); err != nil {
 			return err
+		} else if _, err = sess.Delete(&ReviewReminderState{IssueID: issues[i].ID}); err != nil {
+			return err
 		}
 
 		attachments := make([]*Attachment, 0, 5)
@@ -1731,11 +1896,11 @@ func SearchRepositoryByName(opts *SearchRepoOptions) (repos []*Repository, count
 	// this does not include other people's private repositories even if opts.UserID is an admin.
 	if !opts.Private && opts.UserID > 0 {
 		sess.Join("LEFT", "access", "access.repo_id = repo.id").
-			Where("repo.owner_id = ? OR access.user_id = ? OR repo.is_private = ? OR (repo.is_private = ? AND (repo.allow_public_wiki = ? OR repo.allow_public_issues = ?))", opts.UserID, opts.UserID, false, true, true, true)
+			Where("repo.owner_id = ? OR access.user_id = ? OR repo.is_private = ? OR (repo.is_private = ? AND (repo.allow_public_wiki = ? OR repo.allow_public_issues = ? OR repo.allow_public_releases = ?))", opts.UserID, opts.UserID, false, true, true, true, true)
 	} else {
 		// Only return public repositories if opts.Private is not set
 		if !opts.Private {
-			sess.And("repo.is_private = ? OR (repo.is_private = ? AND (repo.allow_public_wiki = ? OR repo.allow_public_issues = ?))", false, true, true, true)
+			sess.And("repo.is_private = ? OR (repo.is_private = ? AND (repo.allow_public_wiki = ? OR repo.allow_public_issues = ? OR repo.allow_public_releases = ?))", false, true, true, true, true)
 		}
 	}
 	if len(opts.Keyword) > 0 {
@@ -1910,10 +2075,17 @@ func SyncRepositoryHooks() error {
 var taskStatusTable = sync.NewStatusTable()
 
 const (
-	_MIRROR_UPDATE      = "mirror_update"
-	_GIT_FSCK           = "git_fsck"
-	_CHECK_REPO_STATS   = "check_repos_stats"
-	_CLEAN_OLD_ARCHIVES = "clean_old_archives"
+	_MIRROR_UPDATE        = "mirror_update"
+	_GIT_FSCK             = "git_fsck"
+	_CHECK_REPO_STATS     = "check_repos_stats"
+	_CLEAN_OLD_ARCHIVES   = "clean_old_archives"
+	_REVIEW_REMINDERS     = "review_reminders"
+	_VISIBILITY_SCHEDULES = "visibility_schedules"
+	_ISSUE_AUTO_LOCK      = "issue_auto_lock"
+	_REPO_TRAFFIC_ROLLUP  = "repo_traffic_rollup"
+	_REPO_RELATIONS       = "repo_relations"
+	_LIFECYCLE_POLICIES   = "lifecycle_policies"
+	_PUSH_LOG_CLEANUP     = "push_log_cleanup"
 )
 
 // GitFsck calls 'git fsck' to check repository health.
@@ -1983,6 +2155,46 @@ func repoStatsCheck(checker *repoChecker) {
 	}
 }
 
+// checkRepoIssueCounter recomputes the counter column of issues or pull
+// requests of repositories whose stored number has drifted, and reports the
+// drift as a repository notice.
+func checkRepoIssueCounter(column string, isPull, isClosed bool) {
+	desc := fmt.Sprintf("repository count '%s'", column)
+	cond := "is_pull=?"
+	args := []interface{}{isPull}
+	if isClosed {
+		cond += " AND is_closed=?"
+		args = append(args, true)
+	}
+	querySQL := "SELECT repo.id FROM `repository` repo WHERE repo." + column + "!=(SELECT COUNT(*) FROM `issue` WHERE repo_id=repo.id AND " + cond + ")"
+	correctSQL := "UPDATE `repository` SET " + column + "=(SELECT COUNT(*) FROM `issue` WHERE repo_id=? AND " + cond + ") WHERE id=?"
+
+	results, err := x.Query(append([]interface{}{querySQL}, args...)...)
+	if err != nil {
+		log.Error("Select %s: %v", desc, err)
+		return
+	}
+
+	corrected := 0
+	for _, result := range results {
+		id := com.StrTo(result["id"]).MustInt64()
+		log.Trace("Updating %s: %d", desc, id)
+		correctArgs := append(append([]interface{}{correctSQL, id}, args...), id)
+		if _, err = x.Exec(correctArgs...); err != nil {
+			log.Error("Update %s[%d]: %v", desc, id, err)
+			continue
+		}
+		corrected++
+	}
+	if corrected > 0 {
+		desc = fmt.Sprintf("Corrected drifted %s of %d repositories", desc, corrected)
+		log.Warn(desc)
+		if err = CreateRepositoryNotice(desc); err != nil {
+			log.Error("CreateRepositoryNotice: %v", err)
+		}
+	}
+}
+
 func CheckRepoStats() {
 	if taskStatusTable.IsRunning(_CHECK_REPO_STATS) {
 		return
@@ -2028,26 +2240,25 @@ func CheckRepoStats() {
 		repoStatsCheck(checkers[i])
 	}
 
-	// ***** START: Repository.NumClosedIssues *****
-	desc := "repository count 'num_closed_issues'"
-	results, err := x.Query("SELECT repo.id FROM `repository` repo WHERE repo.num_closed_issues!=(SELECT COUNT(*) FROM `issue` WHERE repo_id=repo.id AND is_closed=? AND is_pull=?)", true, false)
-	if err != nil {
-		log.Error("Select %s: %v", desc, err)
-	} else {
-		for _, result := range results {
-			id := com.StrTo(result["id"]).MustInt64()
-			log.Trace("Updating %s: %d", desc, id)
-			_, err = x.Exec("UPDATE `repository` SET num_closed_issues=(SELECT COUNT(*) FROM `issue` WHERE repo_id=? AND is_closed=? AND is_pull=?) WHERE id=?", id, true, false, id)
-			if err != nil {
-				log.Error("Update %s[%d]: %v", desc, id, err)
-			}
-		}
+	// Repository.NumIssues, NumClosedIssues, NumPulls and NumClosedPulls. Issues
+	// are never deleted on their own, so numbers of all issues and pull requests
+	// stay in line with indexes of new ones after the repair.
+	for _, counter := range []struct {
+		column   string
+		isPull   bool
+		isClosed bool // Only closed ones are counted if true.
+	}{
+		{"num_issues", false, false},
+		{"num_closed_issues", false, true},
+		{"num_pulls", true, false},
+		{"num_closed_pulls", true, true},
+	} {
+		checkRepoIssueCounter(counter.column, counter.isPull, counter.isClosed)
 	}
-	// ***** END: Repository.NumClosedIssues *****
 
 	// FIXME: use checker when stop supporting old fork repo format.
 	// ***** START: Repository.NumForks *****
-	results, err = x.Query("SELECT repo.id FROM `repository` repo WHERE repo.num_forks!=(SELECT COUNT(*) FROM `repository` WHERE fork_id=repo.id)")
+	results, err := x.Query("SELECT repo.id FROM `repository` repo WHERE repo.num_forks!=(SELECT COUNT(*) FROM `repository` WHERE fork_id=repo.id)")
 	if err != nil {
 		log.Error("Select repository count 'num_forks': %v", err)
 	} else {
@@ -2355,8 +2566,9 @@ func HasForkedRepo(ownerID, repoID int64) (*Repository, bool, error) {
 
 // ForkRepository creates a fork of target repository under another user domain.
 func ForkRepository(doer, owner *User, baseRepo *Repository, name, desc string) (_ *Repository, err error) {
-	if !owner.CanCreateRepo() {
-		return nil, errors.ReachLimitOfRepo{Limit: owner.RepoCreationNum()}
+	policy, err := CheckRepoCreation(doer, owner)
+	if err != nil {
+		return nil, err
 	}
 
 	repo := &Repository{
@@ -2366,7 +2578,7 @@ func ForkRepository(doer, owner *User, baseRepo *Repository, name, desc string)
 		LowerName:     strings.ToLower(name),
 		Description:   desc,
 		DefaultBranch: baseRepo.DefaultBranch,
-		IsPrivate:     baseRepo.IsPrivate,
+		IsPrivate:     baseRepo.IsPrivate || policy.ForcePrivate,
 		IsFork:        true,
 		ForkID:        baseRepo.ID,
 	}
@@ -0,0 +1,178 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+// AuthorStat represents the number of commits made by an author.
+type AuthorStat struct {
+	Name    string
+	Email   string
+	Commits int64
+}
+
+// aggregateAuthorStats aggregates output of "git log --format=%aN%x00%aE%x00" by
+// authors, who are identified by case-insensitive emails, and returns them in
+// descending order of commit count. The latest name of each author is used.
+func aggregateAuthorStats(log string) []AuthorStat {
+	stats := make(map[string]*AuthorStat)
+	fields := strings.Split(log, "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		name := strings.TrimSpace(fields[i])
+		email := strings.TrimSpace(fields[i+1])
+		if name == "" && email == "" {
+			continue
+		}
+
+		key := strings.ToLower(email)
+		stat := stats[key]
+		if stat == nil {
+			stat = &AuthorStat{
+				Name:  name,
+				Email: email,
+			}
+			stats[key] = stat
+		}
+		stat.Commits++
+	}
+
+	authorStats := make([]AuthorStat, 0, len(stats))
+	for _, stat := range stats {
+		authorStats = append(authorStats, *stat)
+	}
+	sort.Slice(authorStats, func(i, j int) bool {
+		if authorStats[i].Commits != authorStats[j].Commits {
+			return authorStats[i].Commits > authorStats[j].Commits
+		}
+		return authorStats[i].Email < authorStats[j].Email
+	})
+	return authorStats
+}
+
+// AuthorStats returns commit counts of authors of commits in given revision
+// range, e.g. "v1.0..v1.1". Merge commits are not counted.
+func (repo *Repository) AuthorStats(revRange string) ([]AuthorStat, error) {
+	return repo.authorStatsSince(revRange, time.Time{})
+}
+
+// authorStatsSince returns commit counts of authors of commits in given
+// revision range that are authored after the time, which is ignored when zero.
+func (repo *Repository) authorStatsSince(revRange string, since time.Time) ([]AuthorStat, error) {
+	if revRange == "" || strings.HasPrefix(revRange, "-") {
+		return nil, fmt.Errorf("invalid revision range %q", revRange)
+	}
+
+	args := []string{"log", "--no-merges", "--format=%aN%x00%aE%x00"}
+	if !since.IsZero() {
+		args = append(args, "--since="+since.Format(time.RFC3339))
+	}
+	args = append(args, revRange, "--")
+
+	var stdout bytes.Buffer
+	err := runGitOperation(context.Background(), GIT_OPERATION_LOG, repo.RepoPath(), &stdout, args...)
+	if err != nil {
+		return nil, fmt.Errorf("git log: %w", err)
+	}
+	return excludeBotAuthors(aggregateAuthorStats(stdout.String()))
+}
+
+// excludeBotAuthors returns stats of authors who are not identified as bot
+// accounts by their emails.
+func excludeBotAuthors(stats []AuthorStat) ([]AuthorStat, error) {
+	bots := make([]*User, 0, 5)
+	if err := x.Cols("email").Where("type = ?", USER_TYPE_BOT).And("email != ?", "").Find(&bots); err != nil {
+		return nil, fmt.Errorf("find bots: %v", err)
+	} else if len(bots) == 0 {
+		return stats, nil
+	}
+
+	botEmails := make(map[string]bool, len(bots))
+	for _, bot := range bots {
+		botEmails[strings.ToLower(bot.Email)] = true
+	}
+	authorStats := make([]AuthorStat, 0, len(stats))
+	for _, stat := range stats {
+		if !botEmails[strings.ToLower(stat.Email)] {
+			authorStats = append(authorStats, stat)
+		}
+	}
+	return authorStats, nil
+}
+
+// busFactor returns the fewest authors who made at least given percentage of
+// commits together, whose number is the bus factor. The stats must be in
+// descending order of commit count.
+func busFactor(stats []AuthorStat, majority float64) []AuthorStat {
+	var total int64
+	for _, stat := range stats {
+		total += stat.Commits
+	}
+	if total == 0 {
+		return nil
+	}
+
+	var sum int64
+	for i, stat := range stats {
+		sum += stat.Commits
+		if float64(sum)*100 >= float64(total)*majority {
+			return stats[:i+1]
+		}
+	}
+	return stats
+}
+
+// BusFactor returns the bus factor of the repository at given revision, that
+// is the minimum number of authors who made a majority of recent commits, and
+// those authors. Both the majority and the period are set by
+// "[repository.insights]". It returns 0 if there is no recent commit.
+func (repo *Repository) BusFactor(ref string) (int, []AuthorStat, error) {
+	since := time.Now().AddDate(0, 0, -conf.Repository.Insights.BusFactorWindowDays)
+	stats, err := repo.authorStatsSince(ref, since)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	authors := busFactor(stats, conf.Repository.Insights.BusFactorMajority)
+	return len(authors), authors, nil
+}
+
+// ReleasesInOrder returns published releases of the repository in the order
+// of creation, i.e. every release follows its previous release.
+func (repo *Repository) ReleasesInOrder() ([]*Release, error) {
+	releases := make([]*Release, 0, 10)
+	return releases, x.Where("repo_id = ?", repo.ID).And("is_draft = ?", false).Asc("created_unix", "id").Find(&releases)
+}
+
+// ReleaseRevRanges returns revision ranges of commits of the releases in the
+// order of creation, keyed by tag name. Commits of a release are those
+// reachable from its tag but not from the tag of its previous release, and all
+// commits reachable from the tag for the first release. Since tags are given
+// by commit IDs, a range never changes and its statistics can be cached.
+func ReleaseRevRanges(releases []*Release) map[string]string {
+	ranges := make(map[string]string, len(releases))
+	var prev *Release
+	for _, r := range releases {
+		if r.Sha1 == "" {
+			continue
+		}
+
+		revRange := r.Sha1
+		if prev != nil {
+			revRange = prev.Sha1 + ".." + r.Sha1
+		}
+		ranges[r.TagName] = revRange
+		prev = r
+	}
+	return ranges
+}
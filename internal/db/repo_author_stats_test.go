@@ -0,0 +1,82 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_aggregateAuthorStats(t *testing.T) {
+	Convey("Aggregate commit counts by authors", t, func() {
+		log := "Alice\x00alice@example.com\x00\n" +
+			"Bob\x00bob@example.com\x00\n" +
+			"Alice Liddell\x00Alice@Example.com\x00\n" +
+			"Carol\x00carol@example.com\x00\n" +
+			"Bob\x00bob@example.com\x00\n" +
+			"Alice\x00alice@example.com\x00\n"
+
+		So(aggregateAuthorStats(log), ShouldResemble, []AuthorStat{
+			{Name: "Alice", Email: "alice@example.com", Commits: 3},
+			{Name: "Bob", Email: "bob@example.com", Commits: 2},
+			{Name: "Carol", Email: "carol@example.com", Commits: 1},
+		})
+		So(aggregateAuthorStats(""), ShouldBeEmpty)
+	})
+}
+
+func Test_excludeBotAuthors(t *testing.T) {
+	setupTestDB(t)
+
+	insertTestBeans(t,
+		&User{Name: "alice", LowerName: "alice", Email: "alice@example.com"},
+		&User{Name: "ci", LowerName: "ci", Email: "ci@example.com", Type: USER_TYPE_BOT},
+		&User{Name: "deploy", LowerName: "deploy", Type: USER_TYPE_BOT},
+	)
+
+	Convey("Exclude authors with emails of bots", t, func() {
+		stats := []AuthorStat{
+			{Name: "CI", Email: "CI@example.com", Commits: 5},
+			{Name: "Alice", Email: "alice@example.com", Commits: 3},
+			{Name: "Bob", Email: "", Commits: 1},
+		}
+
+		authors, err := excludeBotAuthors(stats)
+		So(err, ShouldBeNil)
+		So(authors, ShouldResemble, stats[1:])
+	})
+}
+
+func Test_busFactor(t *testing.T) {
+	Convey("Find the fewest authors who made a majority of commits", t, func() {
+		stats := []AuthorStat{
+			{Name: "Alice", Email: "alice@example.com", Commits: 6},
+			{Name: "Bob", Email: "bob@example.com", Commits: 3},
+			{Name: "Carol", Email: "carol@example.com", Commits: 1},
+		}
+
+		So(busFactor(stats, 50), ShouldResemble, stats[:1])
+		So(busFactor(stats, 80), ShouldResemble, stats[:2])
+		So(busFactor(stats, 100), ShouldResemble, stats)
+		So(busFactor(nil, 50), ShouldBeEmpty)
+	})
+}
+
+func Test_ReleaseRevRanges(t *testing.T) {
+	Convey("Get revision ranges of releases", t, func() {
+		ranges := ReleaseRevRanges([]*Release{
+			{TagName: "v1.0", Sha1: "a"},
+			{TagName: "v1.1"},
+			{TagName: "v1.2", Sha1: "b"},
+			{TagName: "v2.0", Sha1: "c"},
+		})
+		So(ranges, ShouldResemble, map[string]string{
+			"v1.0": "a",
+			"v1.2": "a..b",
+			"v2.0": "b..c",
+		})
+	})
+}
@@ -6,10 +6,12 @@ package db
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/gogs/git-module"
 	"github.com/unknwon/com"
+	log "unknwon.dev/clog/v2"
 
 	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/tool"
@@ -66,6 +68,109 @@ func (br *Branch) GetCommit() (*git.Commit, error) {
 	return gitRepo.GetBranchCommit(br.Name)
 }
 
+// resolveDefaultBranch returns the stored default branch if it exists, otherwise
+// the branch of symbolic HEAD if it exists, otherwise the first branch. It
+// returns an empty string if there is no branch.
+func resolveDefaultBranch(stored, head string, branches []string) string {
+	exists := make(map[string]bool, len(branches))
+	for _, name := range branches {
+		exists[name] = true
+	}
+
+	switch {
+	case stored != "" && exists[stored]:
+		return stored
+	case head != "" && exists[head]:
+		return head
+	case len(branches) > 0:
+		return branches[0]
+	}
+	return ""
+}
+
+// EffectiveDefaultBranch returns the default branch that exists in Git, which
+// is the stored default branch unless it is stale, e.g. the branch has been
+// deleted by a push. Then the branch of symbolic HEAD or the first branch is
+// used, and saved as the new default branch.
+func (repo *Repository) EffectiveDefaultBranch() (string, error) {
+	if repo.IsBare {
+		return repo.DefaultBranch, nil
+	}
+
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return "", fmt.Errorf("OpenRepository: %v", err)
+	}
+	branches, err := repo.GetBranchNames(gitRepo)
+	if err != nil {
+		return "", fmt.Errorf("GetBranchNames: %v", err)
+	}
+	return repo.ResolveDefaultBranch(gitRepo, branches)
+}
+
+// ResolveDefaultBranch is like EffectiveDefaultBranch but with branches that
+// have been listed from the Git repository by the caller. The stored default
+// branch is kept as is when there is no branch at all, e.g. all branches have
+// been deleted by pushes, so it is used again once pushed.
+func (repo *Repository) ResolveDefaultBranch(gitRepo *git.Repository, branches []string) (string, error) {
+	for _, name := range branches {
+		if name == repo.DefaultBranch {
+			return name, nil
+		}
+	}
+
+	var head string
+	if headBranch, err := gitRepo.GetHEADBranch(); err == nil {
+		head = headBranch.Name
+	}
+
+	name := resolveDefaultBranch(repo.DefaultBranch, head, branches)
+	if name == "" || name == repo.DefaultBranch {
+		return repo.DefaultBranch, nil
+	}
+
+	log.Trace("Stale default branch %q of repository [%d] is replaced by %q", repo.DefaultBranch, repo.ID, name)
+	if err := repo.UpdateDefaultBranch(gitRepo, name); err != nil {
+		return "", fmt.Errorf("UpdateDefaultBranch: %v", err)
+	}
+	return name, nil
+}
+
+// UpdateDefaultBranch sets the branch as the default branch of the repository,
+// both as symbolic HEAD of the Git repository and in database. It returns
+// errors.ErrBranchNotExist if the branch does not exist in the Git repository.
+func (repo *Repository) UpdateDefaultBranch(gitRepo *git.Repository, name string) error {
+	if !gitRepo.IsBranchExist(name) {
+		return errors.ErrBranchNotExist{Name: name}
+	}
+	if err := gitRepo.SetDefaultBranch(name); err != nil {
+		return err
+	}
+
+	repo.DefaultBranch = name
+	if _, err := x.ID(repo.ID).Cols("default_branch").Update(repo); err != nil {
+		return fmt.Errorf("update default branch: %v", err)
+	}
+	return nil
+}
+
+// IsDefaultBranchRef returns true if the full reference, e.g.
+// "refs/heads/master", is the reference of the effective default branch.
+// The stored default branch is used when the effective one cannot be
+// determined.
+func (repo *Repository) IsDefaultBranchRef(ref string) bool {
+	if !strings.HasPrefix(ref, git.BRANCH_PREFIX) {
+		return false
+	}
+
+	branch, err := repo.EffectiveDefaultBranch()
+	if err != nil {
+		log.Error("Failed to get effective default branch [repo_id: %d]: %v", repo.ID, err)
+		branch = repo.DefaultBranch
+	}
+	return ref == git.BRANCH_PREFIX+branch
+}
+
 type ProtectBranchWhitelist struct {
 	ID              int64
 	ProtectBranchID int64
@@ -75,21 +180,75 @@ type ProtectBranchWhitelist struct {
 }
 
 // IsUserInProtectBranchWhitelist returns true if given user is in the whitelist of a branch in a repository.
-func IsUserInProtectBranchWhitelist(repoID, userID int64, branch string) bool {
-	has, err := x.Where("repo_id = ?", repoID).And("user_id = ?", userID).And("name = ?", branch).Get(new(ProtectBranchWhitelist))
+// The name is the name of the protected branch, which can also be a pattern.
+func IsUserInProtectBranchWhitelist(repoID, userID int64, name string) bool {
+	has, err := x.Where("repo_id = ?", repoID).And("user_id = ?", userID).And("name = ?", name).Get(new(ProtectBranchWhitelist))
 	return has && err == nil
 }
 
 // ProtectBranch contains options of a protected branch.
 type ProtectBranch struct {
-	ID                 int64
-	RepoID             int64  `xorm:"UNIQUE(protect_branch)"`
+	ID     int64
+	RepoID int64 `xorm:"UNIQUE(protect_branch)"`
+	// Name is either the exact name of a branch or a glob pattern of branch
+	// names, e.g. "release/*", which protects all branches that match.
 	Name               string `xorm:"UNIQUE(protect_branch)"`
 	Protected          bool
 	RequirePullRequest bool
-	EnableWhitelist    bool
-	WhitelistUserIDs   string `xorm:"TEXT"`
-	WhitelistTeamIDs   string `xorm:"TEXT"`
+	// RequireLinearHistory forbids merge commits on the branch.
+	RequireLinearHistory bool
+	// RequireCodeOwnersApproval forbids merging pull requests until files are
+	// approved by their owners in the CODEOWNERS file.
+	RequireCodeOwnersApproval bool
+	EnableWhitelist           bool
+	WhitelistUserIDs          string `xorm:"TEXT"`
+	WhitelistTeamIDs          string `xorm:"TEXT"`
+}
+
+// IsProtectBranchPattern returns true if the name is a valid glob pattern of
+// branch names rather than the name of a single branch.
+func IsProtectBranchPattern(name string) bool {
+	if !strings.ContainsAny(name, "*?[") {
+		return false
+	}
+	_, err := path.Match(name, "")
+	return err == nil
+}
+
+// IsPattern returns true if the protection applies to all branches that match
+// its name as a glob pattern.
+func (pb *ProtectBranch) IsPattern() bool {
+	return IsProtectBranchPattern(pb.Name)
+}
+
+// Match returns true if the protection applies to the branch.
+func (pb *ProtectBranch) Match(branch string) bool {
+	if !pb.IsPattern() {
+		return pb.Name == branch
+	}
+	matched, _ := path.Match(pb.Name, branch)
+	return matched
+}
+
+// MatchProtectBranch returns the protection that applies to the branch, or nil
+// if none does. The protection of the exact branch name takes precedence over
+// patterns, and among matching patterns the longest one wins as the most
+// specific, then the first in order of names.
+func MatchProtectBranch(protectBranches []*ProtectBranch, branch string) *ProtectBranch {
+	var matched *ProtectBranch
+	for _, pb := range protectBranches {
+		if !pb.IsPattern() {
+			if pb.Name == branch {
+				return pb
+			}
+			continue
+		}
+
+		if pb.Match(branch) && (matched == nil || len(pb.Name) > len(matched.Name)) {
+			matched = pb
+		}
+	}
+	return matched
 }
 
 // GetProtectBranchOfRepoByName returns *ProtectBranch by branch name in given repostiory.
@@ -107,15 +266,178 @@ func GetProtectBranchOfRepoByName(repoID int64, name string) (*ProtectBranch, er
 	return protectBranch, nil
 }
 
+// GetProtectBranchOfRepoByBranch returns the enabled protection that applies to
+// the branch in given repository, either of its exact name or of a matching
+// pattern. Disabled protections of the exact name do not override patterns.
+func GetProtectBranchOfRepoByBranch(repoID int64, branch string) (*ProtectBranch, error) {
+	protectBranches, err := GetProtectBranchesByRepoID(repoID)
+	if err != nil {
+		return nil, err
+	}
+	protectBranch := MatchProtectBranch(protectBranches, branch)
+	if protectBranch == nil {
+		return nil, errors.ErrBranchNotExist{Name: branch}
+	}
+	return protectBranch, nil
+}
+
 // IsBranchOfRepoRequirePullRequest returns true if branch requires pull request in given repository.
 func IsBranchOfRepoRequirePullRequest(repoID int64, name string) bool {
-	protectBranch, err := GetProtectBranchOfRepoByName(repoID, name)
+	protectBranch, err := GetProtectBranchOfRepoByBranch(repoID, name)
 	if err != nil {
 		return false
 	}
 	return protectBranch.Protected && protectBranch.RequirePullRequest
 }
 
+// IsBranchOfRepoRequireLinearHistory returns true if the branch is protected
+// and does not allow merge commits.
+func IsBranchOfRepoRequireLinearHistory(repoID int64, name string) bool {
+	protectBranch, err := GetProtectBranchOfRepoByBranch(repoID, name)
+	if err != nil {
+		return false
+	}
+	return protectBranch.Protected && protectBranch.RequireLinearHistory
+}
+
+// IsBranchOfRepoRequireCodeOwnersApproval returns true if the branch is
+// protected and requires approval of code owners to merge pull requests.
+func IsBranchOfRepoRequireCodeOwnersApproval(repoID int64, name string) bool {
+	protectBranch, err := GetProtectBranchOfRepoByBranch(repoID, name)
+	if err != nil {
+		return false
+	}
+	return protectBranch.Protected && protectBranch.RequireCodeOwnersApproval
+}
+
+// ProtectBranchPush is a push of a branch to be checked against protection of
+// the branch.
+type ProtectBranchPush struct {
+	RepoID      int64
+	RepoPath    string
+	Branch      string
+	OldCommitID string
+	NewCommitID string
+	// UserID is the ID of the pusher, whitelisted users can bypass requiring
+	// pull requests.
+	UserID int64
+	// BypassRequirePullRequest indicates whether the pusher can push directly
+	// regardless of the whitelist.
+	BypassRequirePullRequest bool
+}
+
+// CheckProtectBranchForPush returns ErrProtectBranchViolation if the push is
+// not allowed by the protection that applies to the branch, either of its exact
+// name or of a matching pattern.
+func CheckProtectBranchForPush(push ProtectBranchPush) error {
+	protectBranch, err := GetProtectBranchOfRepoByBranch(push.RepoID, push.Branch)
+	if err != nil {
+		if errors.IsErrBranchNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("GetProtectBranchOfRepoByBranch: %v", err)
+	} else if !protectBranch.Protected {
+		return nil
+	}
+
+	// Check if user is in whitelist when enabled
+	bypassRequirePullRequest := push.BypassRequirePullRequest
+	if !bypassRequirePullRequest && protectBranch.EnableWhitelist {
+		if !IsUserInProtectBranchWhitelist(push.RepoID, push.UserID, protectBranch.Name) {
+			return ErrProtectBranchViolation{Branch: push.Branch, Reason: "is protected and you are not in the push whitelist"}
+		}
+		bypassRequirePullRequest = true
+	}
+
+	// Check if branch allows direct push
+	if !bypassRequirePullRequest && protectBranch.RequirePullRequest {
+		return ErrProtectBranchViolation{Branch: push.Branch, Reason: "is protected and commits must be merged through pull request"}
+	}
+
+	// Check deletion
+	if push.NewCommitID == git.EMPTY_SHA {
+		return ErrProtectBranchViolation{Branch: push.Branch, Reason: "is protected from deletion"}
+	}
+
+	// Check force push, which is impossible for new branches, e.g. ones that
+	// match a pattern of protected branches.
+	if push.OldCommitID != git.EMPTY_SHA {
+		output, err := git.NewCommand("rev-list", "--max-count=1", push.OldCommitID, "^"+push.NewCommitID).RunInDir(push.RepoPath)
+		if err != nil {
+			return fmt.Errorf("detect force push: %v", err)
+		} else if len(output) > 0 {
+			return ErrProtectBranchViolation{Branch: push.Branch, Reason: "is protected from force push"}
+		}
+	}
+
+	// Check merge commits
+	if protectBranch.RequireLinearHistory {
+		hasMerges, err := HasMergeCommits(push.RepoPath, push.OldCommitID, push.NewCommitID)
+		if err != nil {
+			return fmt.Errorf("detect merge commits: %v", err)
+		} else if hasMerges {
+			return ErrProtectBranchViolation{Branch: push.Branch, Reason: "requires linear history and cannot contain merge commits"}
+		}
+	}
+	return nil
+}
+
+// HasMergeCommits returns true if any commit that the push from the old commit
+// to the new one introduces to the branch is a merge commit, in the repository
+// at the path. The old commit is EMPTY_SHA for new branches, in which case
+// commits that are not yet in any branch are checked.
+func HasMergeCommits(repoPath, oldCommitID, newCommitID string) (bool, error) {
+	if newCommitID == git.EMPTY_SHA {
+		return false, nil
+	}
+
+	revs := []string{newCommitID, "--not", "--branches"}
+	if oldCommitID != git.EMPTY_SHA {
+		revs = []string{oldCommitID + ".." + newCommitID}
+	}
+	output, err := git.NewCommand(append([]string{"rev-list", "--min-parents=2", "--max-count=1"}, revs...)...).RunInDir(repoPath)
+	if err != nil {
+		return false, fmt.Errorf("list merge commits: %v", err)
+	}
+	return len(output) > 0, nil
+}
+
+// allowsDirectPush returns true if commits can be pushed directly to the
+// protected branch by the user, who is whitelisted if the function returns
+// true. Site administrators are not restricted by the protection.
+func (pb *ProtectBranch) allowsDirectPush(isAdmin bool, isWhitelisted func() bool) bool {
+	switch {
+	case !pb.Protected, isAdmin:
+		return true
+	case pb.EnableWhitelist:
+		// Whitelist users can bypass require pull request check
+		return isWhitelisted()
+	}
+	return !pb.RequirePullRequest
+}
+
+// CanUserPushToBranch returns true if given user is allowed to push commits
+// directly to the branch according to its protection and rule sets of the
+// organization, which are also enforced by the pre-receive hook.
+func CanUserPushToBranch(repo *Repository, doer *User, branch string) (bool, error) {
+	if required, err := repo.orgRequirePullRequest(branch); err != nil {
+		return false, fmt.Errorf("orgRequirePullRequest: %v", err)
+	} else if required {
+		return false, nil
+	}
+
+	protectBranch, err := GetProtectBranchOfRepoByBranch(repo.ID, branch)
+	if err != nil {
+		if errors.IsErrBranchNotExist(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return protectBranch.allowsDirectPush(doer.IsAdmin, func() bool {
+		return IsUserInProtectBranchWhitelist(repo.ID, doer.ID, protectBranch.Name)
+	}), nil
+}
+
 // UpdateProtectBranch saves branch protection options.
 // If ID is 0, it creates a new record. Otherwise, updates existing record.
 func UpdateProtectBranch(protectBranch *ProtectBranch) (err error) {
@@ -125,17 +447,23 @@ func UpdateProtectBranch(protectBranch *ProtectBranch) (err error) {
 		return err
 	}
 
+	if err = updateProtectBranch(sess, protectBranch); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+func updateProtectBranch(e Engine, protectBranch *ProtectBranch) (err error) {
 	if protectBranch.ID == 0 {
-		if _, err = sess.Insert(protectBranch); err != nil {
+		if _, err = e.Insert(protectBranch); err != nil {
 			return fmt.Errorf("Insert: %v", err)
 		}
 	}
 
-	if _, err = sess.ID(protectBranch.ID).AllCols().Update(protectBranch); err != nil {
+	if _, err = e.ID(protectBranch.ID).AllCols().Update(protectBranch); err != nil {
 		return fmt.Errorf("Update: %v", err)
 	}
-
-	return sess.Commit()
+	return nil
 }
 
 // UpdateOrgProtectBranch saves branch protection options of organizational repository.
@@ -143,7 +471,20 @@ func UpdateProtectBranch(protectBranch *ProtectBranch) (err error) {
 // This function also performs check if whitelist user and team's IDs have been changed
 // to avoid unnecessary whitelist delete and regenerate.
 func UpdateOrgProtectBranch(repo *Repository, protectBranch *ProtectBranch, whitelistUserIDs, whitelistTeamIDs string) (err error) {
-	if err = repo.GetOwner(); err != nil {
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if err = updateOrgProtectBranch(sess, repo, protectBranch, whitelistUserIDs, whitelistTeamIDs); err != nil {
+		return err
+	}
+	return sess.Commit()
+}
+
+func updateOrgProtectBranch(e Engine, repo *Repository, protectBranch *ProtectBranch, whitelistUserIDs, whitelistTeamIDs string) (err error) {
+	if err = repo.getOwner(e); err != nil {
 		return fmt.Errorf("GetOwner: %v", err)
 	} else if !repo.Owner.IsOrganization() {
 		return fmt.Errorf("expect repository owner to be an organization")
@@ -156,7 +497,7 @@ func UpdateOrgProtectBranch(repo *Repository, protectBranch *ProtectBranch, whit
 		userIDs := tool.StringsToInt64s(strings.Split(whitelistUserIDs, ","))
 		validUserIDs = make([]int64, 0, len(userIDs))
 		for _, userID := range userIDs {
-			has, err := HasAccess(userID, repo, ACCESS_MODE_WRITE)
+			has, err := hasAccess(e, userID, repo, ACCESS_MODE_WRITE)
 			if err != nil {
 				return fmt.Errorf("HasAccess [user_id: %d, repo_id: %d]: %v", userID, protectBranch.RepoID, err)
 			} else if !has {
@@ -174,7 +515,7 @@ func UpdateOrgProtectBranch(repo *Repository, protectBranch *ProtectBranch, whit
 	if protectBranch.WhitelistTeamIDs != whitelistTeamIDs {
 		hasTeamsChanged = true
 		teamIDs := tool.StringsToInt64s(strings.Split(whitelistTeamIDs, ","))
-		teams, err := GetTeamsHaveAccessToRepo(repo.OwnerID, repo.ID, ACCESS_MODE_WRITE)
+		teams, err := getTeamsHaveAccessToRepo(e, repo.OwnerID, repo.ID, ACCESS_MODE_WRITE)
 		if err != nil {
 			return fmt.Errorf("GetTeamsHaveAccessToRepo [org_id: %d, repo_id: %d]: %v", repo.OwnerID, repo.ID, err)
 		}
@@ -190,7 +531,7 @@ func UpdateOrgProtectBranch(repo *Repository, protectBranch *ProtectBranch, whit
 
 	// Make sure protectBranch.ID is not 0 for whitelists
 	if protectBranch.ID == 0 {
-		if _, err = x.Insert(protectBranch); err != nil {
+		if _, err = e.Insert(protectBranch); err != nil {
 			return fmt.Errorf("Insert: %v", err)
 		}
 	}
@@ -207,7 +548,7 @@ func UpdateOrgProtectBranch(repo *Repository, protectBranch *ProtectBranch, whit
 		}
 
 		for _, teamID := range validTeamIDs {
-			members, err := GetTeamMembers(teamID)
+			members, err := getTeamMembers(e, teamID)
 			if err != nil {
 				return fmt.Errorf("GetTeamMembers [team_id: %d]: %v", teamID, err)
 			}
@@ -228,26 +569,19 @@ func UpdateOrgProtectBranch(repo *Repository, protectBranch *ProtectBranch, whit
 		}
 	}
 
-	sess := x.NewSession()
-	defer sess.Close()
-	if err = sess.Begin(); err != nil {
-		return err
-	}
-
-	if _, err = sess.ID(protectBranch.ID).AllCols().Update(protectBranch); err != nil {
+	if _, err = e.ID(protectBranch.ID).AllCols().Update(protectBranch); err != nil {
 		return fmt.Errorf("Update: %v", err)
 	}
 
 	// Refresh whitelists
 	if hasUsersChanged || hasTeamsChanged {
-		if _, err = sess.Delete(&ProtectBranchWhitelist{ProtectBranchID: protectBranch.ID}); err != nil {
+		if _, err = e.Delete(&ProtectBranchWhitelist{ProtectBranchID: protectBranch.ID}); err != nil {
 			return fmt.Errorf("delete old protect branch whitelists: %v", err)
-		} else if _, err = sess.Insert(whitelists); err != nil {
+		} else if _, err = e.Insert(whitelists); err != nil {
 			return fmt.Errorf("insert new protect branch whitelists: %v", err)
 		}
 	}
-
-	return sess.Commit()
+	return nil
 }
 
 // GetProtectBranchesByRepoID returns a list of *ProtectBranch in given repostiory.
@@ -255,3 +589,61 @@ func GetProtectBranchesByRepoID(repoID int64) ([]*ProtectBranch, error) {
 	protectBranches := make([]*ProtectBranch, 0, 2)
 	return protectBranches, x.Where("repo_id = ? and protected = ?", repoID, true).Asc("name").Find(&protectBranches)
 }
+
+// BranchProtectionSummary contains key rules of a protected branch.
+type BranchProtectionSummary struct {
+	Branch string
+	// IsPattern is whether the branch is a glob pattern of branch names.
+	IsPattern            bool
+	IsDefault            bool
+	RequirePullRequest   bool
+	RequireLinearHistory bool
+	// RequireCodeOwnersApproval is whether pull requests need approval of code
+	// owners to be merged.
+	RequireCodeOwnersApproval bool
+	EnableWhitelist           bool
+	// NumWhitelistUsers is the number of users who can push to the branch,
+	// including members of whitelisted teams.
+	NumWhitelistUsers int64
+	NumWhitelistTeams int
+}
+
+// ProtectionSummary returns key rules of protected branches of the repository,
+// with the default branch first.
+func (repo *Repository) ProtectionSummary() ([]BranchProtectionSummary, error) {
+	protectBranches, err := GetProtectBranchesByRepoID(repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetProtectBranchesByRepoID: %v", err)
+	}
+
+	summaries := make([]BranchProtectionSummary, 0, len(protectBranches))
+	for _, pb := range protectBranches {
+		s := BranchProtectionSummary{
+			Branch:                    pb.Name,
+			IsPattern:                 pb.IsPattern(),
+			IsDefault:                 pb.Name == repo.DefaultBranch,
+			RequirePullRequest:        pb.RequirePullRequest,
+			RequireLinearHistory:      pb.RequireLinearHistory,
+			RequireCodeOwnersApproval: pb.RequireCodeOwnersApproval,
+			EnableWhitelist:           pb.EnableWhitelist,
+		}
+		if pb.EnableWhitelist {
+			s.NumWhitelistUsers, err = x.Where("protect_branch_id = ?", pb.ID).Count(new(ProtectBranchWhitelist))
+			if err != nil {
+				return nil, fmt.Errorf("count whitelist users: %v", err)
+			}
+			for _, teamID := range tool.StringsToInt64s(strings.Split(pb.WhitelistTeamIDs, ",")) {
+				if teamID > 0 {
+					s.NumWhitelistTeams++
+				}
+			}
+		}
+
+		if s.IsDefault {
+			summaries = append([]BranchProtectionSummary{s}, summaries...)
+		} else {
+			summaries = append(summaries, s)
+		}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_resolveDefaultBranch(t *testing.T) {
+	Convey("Use the stored default branch that exists", t, func() {
+		So(resolveDefaultBranch("develop", "master", []string{"develop", "master"}), ShouldEqual, "develop")
+	})
+
+	Convey("Fall back to the branch of symbolic HEAD", t, func() {
+		So(resolveDefaultBranch("deleted", "master", []string{"develop", "master"}), ShouldEqual, "master")
+		So(resolveDefaultBranch("", "master", []string{"develop", "master"}), ShouldEqual, "master")
+	})
+
+	Convey("Fall back to the first branch", t, func() {
+		So(resolveDefaultBranch("deleted", "gone", []string{"develop", "master"}), ShouldEqual, "develop")
+		So(resolveDefaultBranch("deleted", "", []string{"develop", "master"}), ShouldEqual, "develop")
+	})
+
+	Convey("No branch at all", t, func() {
+		So(resolveDefaultBranch("master", "master", nil), ShouldBeEmpty)
+	})
+}
+
+func Test_ResolveDefaultBranch(t *testing.T) {
+	gitRepo, err := git.OpenRepository(newRefsRepo(t, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Keep the stored default branch that exists", t, func() {
+		repo := &Repository{DefaultBranch: "branch-00001"}
+		name, err := repo.ResolveDefaultBranch(gitRepo, []string{"branch-00000", "branch-00001"})
+		So(err, ShouldBeNil)
+		So(name, ShouldEqual, "branch-00001")
+	})
+
+	Convey("Keep the stored default branch without any branch", t, func() {
+		repo := &Repository{DefaultBranch: "master"}
+		name, err := repo.ResolveDefaultBranch(gitRepo, nil)
+		So(err, ShouldBeNil)
+		So(name, ShouldEqual, "master")
+	})
+
+	Convey("Reject default branches that do not exist", t, func() {
+		repo := &Repository{DefaultBranch: "branch-00000"}
+		err := repo.UpdateDefaultBranch(gitRepo, "deleted")
+		So(errors.IsErrBranchNotExist(err), ShouldBeTrue)
+		So(repo.DefaultBranch, ShouldEqual, "branch-00000")
+	})
+}
+
+func TestIsProtectBranchPattern(t *testing.T) {
+	Convey("Tell patterns from branch names", t, func() {
+		So(IsProtectBranchPattern("master"), ShouldBeFalse)
+		So(IsProtectBranchPattern("release/1.0"), ShouldBeFalse)
+		So(IsProtectBranchPattern("release/*"), ShouldBeTrue)
+		So(IsProtectBranchPattern("v?-stable"), ShouldBeTrue)
+		So(IsProtectBranchPattern("release/[0-9]*"), ShouldBeTrue)
+		So(IsProtectBranchPattern("release/[0-9"), ShouldBeFalse)
+	})
+}
+
+func TestMatchProtectBranch(t *testing.T) {
+	Convey("Match protection of branches", t, func() {
+		exact := &ProtectBranch{Name: "release/1.0/hotfix"}
+		release := &ProtectBranch{Name: "release/*"}
+		hotfix := &ProtectBranch{Name: "release/*/hotfix"}
+		stable := &ProtectBranch{Name: "v*-stable"}
+		protectBranches := []*ProtectBranch{hotfix, release, exact, stable}
+
+		So(MatchProtectBranch(protectBranches, "release/1.0"), ShouldEqual, release)
+		So(MatchProtectBranch(protectBranches, "release/2.0/hotfix"), ShouldEqual, hotfix)
+		So(MatchProtectBranch(protectBranches, "v1.2-stable"), ShouldEqual, stable)
+
+		Convey("Patterns do not match across slashes", func() {
+			So(MatchProtectBranch(protectBranches, "release/2.0/feature"), ShouldBeNil)
+			So(MatchProtectBranch(protectBranches, "release"), ShouldBeNil)
+			So(MatchProtectBranch(protectBranches, "master"), ShouldBeNil)
+		})
+
+		Convey("Exact name takes precedence over patterns", func() {
+			So(MatchProtectBranch(protectBranches, "release/1.0/hotfix"), ShouldEqual, exact)
+		})
+
+		Convey("Longest pattern wins among patterns", func() {
+			So(MatchProtectBranch([]*ProtectBranch{{Name: "*"}, {Name: "feature-*"}}, "feature-x").Name, ShouldEqual, "feature-*")
+		})
+	})
+}
+
+func Test_ProtectBranch_allowsDirectPush(t *testing.T) {
+	whitelisted := func() bool { return true }
+	notWhitelisted := func() bool { return false }
+
+	Convey("Unprotected branches allow direct pushes", t, func() {
+		pb := &ProtectBranch{RequirePullRequest: true, EnableWhitelist: true}
+		So(pb.allowsDirectPush(false, notWhitelisted), ShouldBeTrue)
+	})
+
+	Convey("Protected branches that require pull requests", t, func() {
+		pb := &ProtectBranch{Protected: true, RequirePullRequest: true}
+
+		Convey("Site administrators bypass the protection", func() {
+			So(pb.allowsDirectPush(true, notWhitelisted), ShouldBeTrue)
+		})
+		Convey("Writers cannot push directly", func() {
+			So(pb.allowsDirectPush(false, notWhitelisted), ShouldBeFalse)
+		})
+	})
+
+	Convey("Protected branches with push whitelist", t, func() {
+		pb := &ProtectBranch{Protected: true, RequirePullRequest: true, EnableWhitelist: true}
+
+		Convey("Site administrators bypass the whitelist", func() {
+			So(pb.allowsDirectPush(true, notWhitelisted), ShouldBeTrue)
+		})
+		Convey("Whitelisted users can push directly", func() {
+			So(pb.allowsDirectPush(false, whitelisted), ShouldBeTrue)
+		})
+		Convey("Writers not in the whitelist cannot push", func() {
+			So(pb.allowsDirectPush(false, notWhitelisted), ShouldBeFalse)
+
+			pb.RequirePullRequest = false
+			So(pb.allowsDirectPush(false, notWhitelisted), ShouldBeFalse)
+		})
+	})
+
+	Convey("Protected branches that only forbid force pushes and deletion", t, func() {
+		pb := &ProtectBranch{Protected: true}
+		So(pb.allowsDirectPush(false, notWhitelisted), ShouldBeTrue)
+	})
+}
+
+func TestHasMergeCommits(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	base := repo.CommitFile("README", "init")
+	repo.Git("checkout", "--quiet", "-b", "feature")
+	linear := repo.CommitFile("feature.txt", "feature")
+	repo.Git("checkout", "--quiet", "master")
+	repo.CommitFile("other.txt", "other")
+	repo.Git("merge", "--quiet", "--no-ff", "-m", "merge", "feature")
+	merged := repo.Git("rev-parse", "HEAD")
+	repo.Git("checkout", "--quiet", "--detach")
+	repo.Git("branch", "--quiet", "-D", "master", "feature")
+
+	Convey("Detect merge commits in pushed range", t, func() {
+		hasMerges, err := HasMergeCommits(dir, base, linear)
+		So(err, ShouldBeNil)
+		So(hasMerges, ShouldBeFalse)
+
+		hasMerges, err = HasMergeCommits(dir, base, merged)
+		So(err, ShouldBeNil)
+		So(hasMerges, ShouldBeTrue)
+
+		hasMerges, err = HasMergeCommits(dir, git.EMPTY_SHA, merged)
+		So(err, ShouldBeNil)
+		So(hasMerges, ShouldBeTrue)
+
+		hasMerges, err = HasMergeCommits(dir, base, git.EMPTY_SHA)
+		So(err, ShouldBeNil)
+		So(hasMerges, ShouldBeFalse)
+	})
+}
+
+func Test_CheckProtectBranchForPush(t *testing.T) {
+	setupTestDB(t)
+
+	repo := testutil.InitGitRepo(t, "")
+	repo.SetAuthor("alice", "alice@example.com")
+	base := repo.CommitFile("README.md", "base")
+	next := repo.CommitFile("README.md", "next")
+	repo.Git("checkout", "--quiet", "-b", "topic", base)
+	topic := repo.CommitFile("topic.txt", "topic")
+	repo.Git("checkout", "--quiet", "master")
+	repo.Git("merge", "--quiet", "--no-ff", "-m", "merge topic", "topic")
+	merge := repo.Git("rev-parse", "HEAD")
+
+	const repoID = 1
+	insertTestBeans(t,
+		&ProtectBranch{RepoID: repoID, Name: "release/*", Protected: true, RequirePullRequest: true},
+		&ProtectBranch{RepoID: repoID, Name: "release/*/hotfix", Protected: true, RequirePullRequest: true, EnableWhitelist: true},
+		&ProtectBranch{RepoID: repoID, Name: "release/1.0/hotfix", Protected: true},
+		&ProtectBranch{RepoID: repoID, Name: "v*-stable", Protected: true, RequireLinearHistory: true},
+		&ProtectBranchWhitelist{RepoID: repoID, Name: "release/*/hotfix", UserID: 1},
+	)
+
+	push := func(branch, oldCommitID, newCommitID string, userID int64, bypass bool) error {
+		return CheckProtectBranchForPush(ProtectBranchPush{
+			RepoID:                   repoID,
+			RepoPath:                 repo.Dir,
+			Branch:                   branch,
+			OldCommitID:              oldCommitID,
+			NewCommitID:              newCommitID,
+			UserID:                   userID,
+			BypassRequirePullRequest: bypass,
+		})
+	}
+	reasonOf := func(err error) string {
+		So(IsErrProtectBranchViolation(err), ShouldBeTrue)
+		return err.(ErrProtectBranchViolation).Reason
+	}
+
+	Convey("Allow pushes to branches that are not protected", t, func() {
+		So(push("master", base, next, 2, false), ShouldBeNil)
+		So(push("feature/release/1.0", base, next, 2, false), ShouldBeNil)
+	})
+
+	Convey("Reject pushes to branches matching a pattern", t, func() {
+		err := push("release/1.0", base, next, 2, false)
+		So(reasonOf(err), ShouldEqual, "is protected and commits must be merged through pull request")
+		So(err.Error(), ShouldEqual, "Branch 'release/1.0' is protected and commits must be merged through pull request")
+
+		Convey("Allow new branches to be pushed by those who can bypass", func() {
+			So(push("release/2.0", git.EMPTY_SHA, next, 2, true), ShouldBeNil)
+		})
+
+		Convey("Reject deletion and force push even by those who can bypass", func() {
+			So(reasonOf(push("release/1.0", next, git.EMPTY_SHA, 2, true)), ShouldEqual, "is protected from deletion")
+			So(reasonOf(push("release/1.0", next, topic, 2, true)), ShouldEqual, "is protected from force push")
+		})
+	})
+
+	Convey("Apply the whitelist of nested patterns", t, func() {
+		So(push("release/2.0/hotfix", base, next, 1, false), ShouldBeNil)
+		So(reasonOf(push("release/2.0/hotfix", base, next, 2, false)), ShouldEqual, "is protected and you are not in the push whitelist")
+	})
+
+	Convey("Exact rules win over patterns", t, func() {
+		So(push("release/1.0/hotfix", base, next, 2, false), ShouldBeNil)
+		So(reasonOf(push("release/1.0/hotfix", next, git.EMPTY_SHA, 2, false)), ShouldEqual, "is protected from deletion")
+	})
+
+	Convey("Reject merge commits to branches requiring linear history", t, func() {
+		So(push("v1-stable", base, next, 2, false), ShouldBeNil)
+		So(reasonOf(push("v1-stable", next, merge, 2, false)), ShouldEqual, "requires linear history and cannot contain merge commits")
+	})
+}
@@ -0,0 +1,231 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/tool"
+)
+
+// ChangelogMaxCommits is the maximum number of commits listed in a changelog.
+const ChangelogMaxCommits = 500
+
+// changelogTypeTitles are titles of groups of known commit types, in the order
+// of appearance in changelogs. Groups of other types follow in the order of
+// first appearance, and the group of unparseable commits is the last.
+var changelogTypeTitles = []struct {
+	Type  string
+	Title string
+}{
+	{"feat", "Features"},
+	{"fix", "Bug Fixes"},
+	{"chore", "Chores"},
+}
+
+// conventionalCommitPattern matches subjects in the form of
+// "type(scope)!: description", where scope and "!" are optional.
+var conventionalCommitPattern = regexp.MustCompile(`^([a-zA-Z]+)(?:\(([^()]+)\))?(!)?:\s+(\S.*)$`)
+
+// ChangelogEntry is a commit in the changelog.
+type ChangelogEntry struct {
+	CommitID string
+	Scope    string
+	Subject  string
+	// IsBreaking indicates whether the commit is marked as a breaking change.
+	IsBreaking bool
+	// PullRequest is the merged pull request that contains the commit, if any.
+	PullRequest *PullRequest
+}
+
+// ChangelogGroup is a group of commits of the same type.
+type ChangelogGroup struct {
+	// Type is the conventional commit type, it is empty for unparseable commits.
+	Type    string
+	Title   string
+	Entries []*ChangelogEntry
+}
+
+// Changelog is the list of changes between two revisions.
+type Changelog struct {
+	From   string
+	To     string
+	Groups []*ChangelogGroup
+	// IsTruncated indicates whether commits exceed ChangelogMaxCommits.
+	IsTruncated bool
+
+	NumFiles       int
+	TotalAdditions int
+	TotalDeletions int
+}
+
+// parseConventionalCommit returns the type, scope, description and whether it is
+// a breaking change of the commit subject. The type is empty if the subject is
+// not a conventional commit.
+func parseConventionalCommit(subject string) (typ, scope, description string, isBreaking bool) {
+	m := conventionalCommitPattern.FindStringSubmatch(subject)
+	if m == nil {
+		return "", "", subject, false
+	}
+	return strings.ToLower(m[1]), m[2], m[4], m[3] == "!"
+}
+
+// groupChangelogEntries groups entries by type of their commits.
+func groupChangelogEntries(entries []*ChangelogEntry, types []string) []*ChangelogGroup {
+	byType := make(map[string]*ChangelogGroup)
+	order := make([]string, 0, len(changelogTypeTitles))
+	for _, t := range changelogTypeTitles {
+		byType[t.Type] = &ChangelogGroup{Type: t.Type, Title: t.Title}
+		order = append(order, t.Type)
+	}
+	for i, entry := range entries {
+		typ := types[i]
+		if byType[typ] == nil {
+			title := typ
+			if typ == "" {
+				title = "Other"
+			} else {
+				order = append(order, typ)
+			}
+			byType[typ] = &ChangelogGroup{Type: typ, Title: title}
+		}
+		byType[typ].Entries = append(byType[typ].Entries, entry)
+	}
+	order = append(order, "")
+
+	groups := make([]*ChangelogGroup, 0, len(order))
+	for _, typ := range order {
+		if g := byType[typ]; g != nil && len(g.Entries) > 0 {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+// GenerateChangelog returns the changelog of commits reachable from toTag but
+// not from fromTag, grouped by conventional commit types parsed from subjects.
+// Both can be any commit IDs, branches or tags, so that the changelog of a
+// release is generated before its tag is created. Merge commits of merged pull
+// requests are listed by titles of the pull requests, other merge commits are
+// skipped. Commits are linked to merged pull requests that contain them.
+func (repo *Repository) GenerateChangelog(fromTag, toTag string) (*Changelog, error) {
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("OpenRepository: %v", err)
+	}
+	from, err := resolveCommit(gitRepo, fromTag)
+	if err != nil {
+		return nil, err
+	}
+	to, err := resolveCommit(gitRepo, toTag)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeBase, err := gitRepo.GetMergeBase(from.ID.String(), to.ID.String())
+	if err != nil {
+		if git.IsErrNoMergeBase(err) {
+			return nil, ErrUnrelatedHistories{CommitID: toTag, AncestorRef: fromTag}
+		}
+		return nil, fmt.Errorf("GetMergeBase: %v", err)
+	}
+
+	l, err := gitRepo.CommitsBetweenIDs(to.ID.String(), mergeBase)
+	if err != nil {
+		return nil, fmt.Errorf("CommitsBetweenIDs: %v", err)
+	}
+
+	changelog := &Changelog{
+		From: fromTag,
+		To:   toTag,
+	}
+	commits := make([]*git.Commit, 0, l.Len())
+	commitIDs := make([]string, 0, l.Len())
+	for e := l.Front(); e != nil; e = e.Next() {
+		commit := e.Value.(*git.Commit)
+		commits = append(commits, commit)
+		commitIDs = append(commitIDs, commit.ID.String())
+	}
+	prs, err := GetMergedPullRequestsByCommitIDs(repo.ID, commitIDs)
+	if err != nil {
+		return nil, fmt.Errorf("GetMergedPullRequestsByCommitIDs: %v", err)
+	}
+
+	entries := make([]*ChangelogEntry, 0, len(commits))
+	types := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		summary := commit.Summary()
+		pr := prs[commit.ID.String()]
+		if commit.ParentCount() > 1 {
+			if pr == nil {
+				continue
+			} else if err = pr.LoadIssue(); err != nil {
+				return nil, fmt.Errorf("LoadIssue [pull_id: %d]: %v", pr.ID, err)
+			}
+			summary = pr.Issue.Title
+		}
+		if len(entries) >= ChangelogMaxCommits {
+			changelog.IsTruncated = true
+			break
+		}
+
+		typ, scope, subject, isBreaking := parseConventionalCommit(summary)
+		entries = append(entries, &ChangelogEntry{
+			CommitID:    commit.ID.String(),
+			Scope:       scope,
+			Subject:     subject,
+			IsBreaking:  isBreaking,
+			PullRequest: pr,
+		})
+		types = append(types, typ)
+	}
+	changelog.Groups = groupChangelogEntries(entries, types)
+
+	diff, err := GetDiffRange(repo.RepoPath(), mergeBase, to.ID.String(),
+		conf.Git.MaxGitDiffLines, conf.Git.MaxGitDiffLineCharacters, conf.Git.MaxGitDiffFiles)
+	if err != nil {
+		return nil, fmt.Errorf("GetDiffRange: %v", err)
+	}
+	changelog.NumFiles = diff.NumFiles()
+	changelog.TotalAdditions = diff.TotalAddition
+	changelog.TotalDeletions = diff.TotalDeletion
+	return changelog, nil
+}
+
+// Markdown returns the changelog in Markdown with links to commits and pull
+// requests of the repository.
+func (c *Changelog) Markdown(repo *Repository) string {
+	var buf bytes.Buffer
+	for _, g := range c.Groups {
+		fmt.Fprintf(&buf, "### %s\n\n", g.Title)
+		for _, entry := range g.Entries {
+			buf.WriteString("- ")
+			if entry.IsBreaking {
+				buf.WriteString("**BREAKING** ")
+			}
+			if entry.Scope != "" {
+				fmt.Fprintf(&buf, "**%s:** ", entry.Scope)
+			}
+			buf.WriteString(entry.Subject)
+			if entry.PullRequest != nil {
+				fmt.Fprintf(&buf, " ([#%d](%s/pulls/%d))", entry.PullRequest.Index, repo.HTMLURL(), entry.PullRequest.Index)
+			}
+			fmt.Fprintf(&buf, " [%s](%s/commit/%s)\n", tool.ShortSHA1(entry.CommitID), repo.HTMLURL(), entry.CommitID)
+		}
+		buf.WriteString("\n")
+	}
+	if c.IsTruncated {
+		fmt.Fprintf(&buf, "Only the latest %d commits are listed.\n\n", ChangelogMaxCommits)
+	}
+	fmt.Fprintf(&buf, "%d files changed, %d additions, %d deletions ([compare](%s))\n",
+		c.NumFiles, c.TotalAdditions, c.TotalDeletions, conf.Server.ExternalURL+repo.ComposeCompareURL(c.From, c.To))
+	return buf.String()
+}
@@ -0,0 +1,114 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_parseConventionalCommit(t *testing.T) {
+	Convey("Parse subjects of commits", t, func() {
+		testCases := []struct {
+			subject     string
+			typ         string
+			scope       string
+			description string
+			isBreaking  bool
+		}{
+			{"feat: add changelog", "feat", "", "add changelog", false},
+			{"Fix(db): handle empty list", "fix", "db", "handle empty list", false},
+			{"chore!: drop Go 1.11", "chore", "", "drop Go 1.11", true},
+			{"refactor(api)!: rename fields", "refactor", "api", "rename fields", true},
+			{"Update README.md", "", "", "Update README.md", false},
+			{"feat:missing space", "", "", "feat:missing space", false},
+			{"fix(: broken scope", "", "", "fix(: broken scope", false},
+		}
+		for _, tc := range testCases {
+			typ, scope, description, isBreaking := parseConventionalCommit(tc.subject)
+			So(typ, ShouldEqual, tc.typ)
+			So(scope, ShouldEqual, tc.scope)
+			So(description, ShouldEqual, tc.description)
+			So(isBreaking, ShouldEqual, tc.isBreaking)
+		}
+	})
+}
+
+func Test_groupChangelogEntries(t *testing.T) {
+	Convey("Group entries by types", t, func() {
+		entries := []*ChangelogEntry{
+			{CommitID: "1"}, {CommitID: "2"}, {CommitID: "3"}, {CommitID: "4"}, {CommitID: "5"}, {CommitID: "6"},
+		}
+		types := []string{"", "docs", "fix", "feat", "fix", "perf"}
+
+		groups := groupChangelogEntries(entries, types)
+		So(groups, ShouldHaveLength, 5)
+
+		titles := make([]string, len(groups))
+		for i := range groups {
+			titles[i] = groups[i].Title
+		}
+		So(titles, ShouldResemble, []string{"Features", "Bug Fixes", "docs", "perf", "Other"})
+		So(groups[1].Entries, ShouldResemble, []*ChangelogEntry{entries[2], entries[4]})
+		So(groups[4].Entries, ShouldResemble, []*ChangelogEntry{entries[0]})
+	})
+}
+
+func Test_Repository_GenerateChangelog(t *testing.T) {
+	setupTestDB(t)
+	oldRoot := conf.Repository.Root
+	conf.Repository.Root = t.TempDir()
+	defer func() { conf.Repository.Root = oldRoot }()
+
+	owner := &User{Name: "alice", LowerName: "alice"}
+	insertTestBeans(t, owner)
+	repo := &Repository{OwnerID: owner.ID, Owner: owner, Name: "proj", LowerName: "proj"}
+	insertTestBeans(t, repo)
+
+	// A pull request and a branch without one are merged into master.
+	gitRepo := testutil.InitGitRepo(t, repo.RepoPath())
+	gitRepo.CommitFile("README", "init")
+	gitRepo.Git("tag", "v1.0")
+	gitRepo.Git("checkout", "--quiet", "-b", "login")
+	gitRepo.CommitFile("login.go", "login")
+	gitRepo.Git("checkout", "--quiet", "-b", "typo", "master")
+	typo := gitRepo.CommitFile("README", "fixed")
+	gitRepo.Git("checkout", "--quiet", "master")
+	gitRepo.Git("merge", "--quiet", "--no-ff", "-m", "Merge branch 'login'", "login")
+	merged := gitRepo.Git("rev-parse", "HEAD")
+	gitRepo.Git("merge", "--quiet", "--no-ff", "-m", "Merge branch 'typo'", "typo")
+
+	issue := &Issue{RepoID: repo.ID, Index: 1, Title: "feat(auth): add login", IsPull: true}
+	insertTestBeans(t, issue)
+	pr := &PullRequest{IssueID: issue.ID, Index: 1, BaseRepoID: repo.ID, HasMerged: true, MergedCommitID: merged}
+	insertTestBeans(t, pr)
+
+	Convey("List merged pull requests by their titles and skip other merges", t, func() {
+		changelog, err := repo.GenerateChangelog("v1.0", "master")
+		So(err, ShouldBeNil)
+		So(changelog.Groups, ShouldHaveLength, 2)
+
+		So(changelog.Groups[0].Title, ShouldEqual, "Features")
+		So(changelog.Groups[0].Entries, ShouldHaveLength, 1)
+		entry := changelog.Groups[0].Entries[0]
+		So(entry.CommitID, ShouldEqual, merged)
+		So(entry.Scope, ShouldEqual, "auth")
+		So(entry.Subject, ShouldEqual, "add login")
+		So(entry.PullRequest, ShouldNotBeNil)
+		So(entry.PullRequest.ID, ShouldEqual, pr.ID)
+
+		So(changelog.Groups[1].Title, ShouldEqual, "Other")
+		subjects := make(map[string]string)
+		for _, entry := range changelog.Groups[1].Entries {
+			subjects[entry.CommitID] = entry.Subject
+		}
+		So(subjects, ShouldHaveLength, 2)
+		So(subjects[typo], ShouldEqual, "change README")
+	})
+}
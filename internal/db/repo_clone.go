@@ -0,0 +1,85 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+// Protocols to clone repositories.
+const (
+	CLONE_PROTOCOL_HTTPS = "https"
+	CLONE_PROTOCOL_SSH   = "ssh"
+)
+
+// IsValidCloneProtocol returns true if the protocol is a known clone protocol.
+func IsValidCloneProtocol(protocol string) bool {
+	return protocol == CLONE_PROTOCOL_HTTPS || protocol == CLONE_PROTOCOL_SSH
+}
+
+// CloneProtocols describes protocols to clone repositories available to a
+// viewer.
+type CloneProtocols struct {
+	// HTTPS indicates whether cloning over HTTP(S) is enabled.
+	HTTPS bool
+	// SSH indicates whether cloning over SSH is available to the viewer. It is
+	// hidden from viewers without SSH keys unless HTTP(S) is disabled.
+	SSH bool
+	// NeedSSHKey indicates whether SSH is enabled but the signed-in viewer has
+	// not uploaded any SSH key.
+	NeedSSHKey bool
+	// Default is the protocol to show by default, which is empty when neither
+	// protocol is available.
+	Default string
+}
+
+// resolveCloneProtocols returns clone protocols for a viewer with given
+// preferred protocol and number of SSH keys.
+func resolveCloneProtocols(preferred string, isLogged bool, numKeys int64) *CloneProtocols {
+	p := &CloneProtocols{
+		HTTPS: !conf.Repository.DisableHTTPGit,
+	}
+	if !conf.SSH.Disabled {
+		p.SSH = numKeys > 0 || !p.HTTPS
+		p.NeedSSHKey = isLogged && numKeys == 0
+	}
+
+	switch {
+	case preferred == CLONE_PROTOCOL_SSH && p.SSH:
+		p.Default = CLONE_PROTOCOL_SSH
+	case p.HTTPS:
+		p.Default = CLONE_PROTOCOL_HTTPS
+	case p.SSH:
+		p.Default = CLONE_PROTOCOL_SSH
+	}
+	return p
+}
+
+// ResolveCloneProtocols returns clone protocols available to the viewer, the
+// viewer is nil when not signed in.
+func ResolveCloneProtocols(viewer *User) (*CloneProtocols, error) {
+	if viewer == nil {
+		return resolveCloneProtocols("", false, 0), nil
+	}
+
+	numKeys, err := x.Where("owner_id = ? AND type = ?", viewer.ID, KEY_TYPE_USER).Count(new(PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("count public keys: %v", err)
+	}
+	return resolveCloneProtocols(viewer.CloneProtocol, true, numKeys), nil
+}
+
+// UpdateCloneProtocol updates the preferred protocol of the user to clone
+// repositories.
+func (u *User) UpdateCloneProtocol(protocol string) error {
+	if !IsValidCloneProtocol(protocol) {
+		return fmt.Errorf("invalid clone protocol %q", protocol)
+	}
+	u.CloneProtocol = protocol
+	_, err := x.ID(u.ID).Cols("clone_protocol").Update(u)
+	return err
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+func Test_resolveCloneProtocols(t *testing.T) {
+	oldSSHDisabled, oldHTTPDisabled := conf.SSH.Disabled, conf.Repository.DisableHTTPGit
+	defer func() {
+		conf.SSH.Disabled, conf.Repository.DisableHTTPGit = oldSSHDisabled, oldHTTPDisabled
+	}()
+
+	Convey("Resolve clone protocols with both protocols enabled", t, func() {
+		conf.SSH.Disabled, conf.Repository.DisableHTTPGit = false, false
+
+		So(resolveCloneProtocols("", false, 0), ShouldResemble, &CloneProtocols{HTTPS: true, Default: CLONE_PROTOCOL_HTTPS})
+		So(resolveCloneProtocols(CLONE_PROTOCOL_SSH, true, 0), ShouldResemble, &CloneProtocols{HTTPS: true, NeedSSHKey: true, Default: CLONE_PROTOCOL_HTTPS})
+		So(resolveCloneProtocols(CLONE_PROTOCOL_SSH, true, 1), ShouldResemble, &CloneProtocols{HTTPS: true, SSH: true, Default: CLONE_PROTOCOL_SSH})
+		So(resolveCloneProtocols(CLONE_PROTOCOL_HTTPS, true, 1), ShouldResemble, &CloneProtocols{HTTPS: true, SSH: true, Default: CLONE_PROTOCOL_HTTPS})
+	})
+
+	Convey("Resolve clone protocols with only one protocol enabled", t, func() {
+		conf.SSH.Disabled, conf.Repository.DisableHTTPGit = true, false
+		So(resolveCloneProtocols(CLONE_PROTOCOL_SSH, true, 1), ShouldResemble, &CloneProtocols{HTTPS: true, Default: CLONE_PROTOCOL_HTTPS})
+
+		conf.SSH.Disabled, conf.Repository.DisableHTTPGit = false, true
+		So(resolveCloneProtocols("", false, 0), ShouldResemble, &CloneProtocols{SSH: true, Default: CLONE_PROTOCOL_SSH})
+		So(resolveCloneProtocols("", true, 0), ShouldResemble, &CloneProtocols{SSH: true, NeedSSHKey: true, Default: CLONE_PROTOCOL_SSH})
+
+		conf.SSH.Disabled, conf.Repository.DisableHTTPGit = true, true
+		So(resolveCloneProtocols(CLONE_PROTOCOL_SSH, true, 1), ShouldResemble, &CloneProtocols{})
+	})
+}
@@ -6,8 +6,10 @@ package db
 
 import (
 	"fmt"
+	"time"
 
 	log "unknwon.dev/clog/v2"
+	"xorm.io/xorm"
 
 	api "github.com/gogs/go-gogs-client"
 )
@@ -18,6 +20,24 @@ type Collaboration struct {
 	RepoID int64      `xorm:"UNIQUE(s) INDEX NOT NULL"`
 	UserID int64      `xorm:"UNIQUE(s) INDEX NOT NULL"`
 	Mode   AccessMode `xorm:"DEFAULT 2 NOT NULL"`
+	// AllowedPaths is the list of path prefixes separated by new lines that a
+	// read-only collaborator is limited to, empty for no restriction.
+	AllowedPaths string `xorm:"TEXT"`
+	// AllowIssues indicates whether a collaborator limited to some paths can
+	// access issues.
+	AllowIssues bool
+
+	// LastAccessUnix is when the collaborator last visited, cloned or pushed to
+	// the repository, zero if never since access is tracked.
+	LastAccessUnix int64
+	CreatedUnix    int64
+	// LastActive is the latest of the last access, the last push and when the
+	// collaborator was added, which is only set by InactiveCollaborators.
+	LastActive time.Time `xorm:"-" json:"-"`
+}
+
+func (c *Collaboration) BeforeInsert() {
+	c.CreatedUnix = time.Now().Unix()
 }
 
 func (c *Collaboration) ModeI18nKey() string {
@@ -51,33 +71,39 @@ func (repo *Repository) IsCollaborator(userID int64) bool {
 	return IsCollaborator(repo.ID, userID)
 }
 
-// AddCollaborator adds new collaboration to a repository with default access mode.
-func (repo *Repository) AddCollaborator(u *User) error {
+func (repo *Repository) addCollaborator(sess *xorm.Session, u *User, mode AccessMode) error {
 	collaboration := &Collaboration{
 		RepoID: repo.ID,
 		UserID: u.ID,
 	}
 
-	has, err := x.Get(collaboration)
+	has, err := sess.Get(collaboration)
 	if err != nil {
 		return err
 	} else if has {
 		return nil
 	}
-	collaboration.Mode = ACCESS_MODE_WRITE
+	collaboration.Mode = mode
 
+	if _, err = sess.Insert(collaboration); err != nil {
+		return err
+	} else if err = repo.recalculateAccesses(sess); err != nil {
+		return fmt.Errorf("recalculateAccesses [repo_id: %v]: %v", repo.ID, err)
+	}
+	return nil
+}
+
+// AddCollaborator adds new collaboration to a repository with default access mode.
+func (repo *Repository) AddCollaborator(u *User) error {
 	sess := x.NewSession()
 	defer sess.Close()
-	if err = sess.Begin(); err != nil {
+	if err := sess.Begin(); err != nil {
 		return err
 	}
 
-	if _, err = sess.Insert(collaboration); err != nil {
+	if err := repo.addCollaborator(sess, u, ACCESS_MODE_WRITE); err != nil {
 		return err
-	} else if err = repo.recalculateAccesses(sess); err != nil {
-		return fmt.Errorf("recalculateAccesses [repo_id: %v]: %v", repo.ID, err)
 	}
-
 	return sess.Commit()
 }
 
@@ -150,6 +176,10 @@ func (repo *Repository) ChangeCollaborationAccessMode(userID int64, mode AccessM
 		return nil
 	}
 	collaboration.Mode = mode
+	// Only read-only collaborators can be limited to some paths.
+	if mode > ACCESS_MODE_READ {
+		collaboration.AllowedPaths = ""
+	}
 
 	// If it's an organizational repository, merge with team access level for highest permission
 	if repo.Owner.IsOrganization() {
@@ -0,0 +1,139 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// collaboratorAccessInterval is the minimum interval of updating the last
+// access of a collaborator, which keeps requests of pages from writing to the
+// database every time.
+const collaboratorAccessInterval = time.Hour
+
+// maxCollaboratorAccesses is the max number of accesses kept in memory to
+// throttle updates.
+const maxCollaboratorAccesses = 10000
+
+// accessThrottle allows an access of a user to a repository at most once per
+// interval, and keeps at most max accesses in memory.
+type accessThrottle struct {
+	sync.Mutex
+	interval time.Duration
+	max      int
+	updated  map[[2]int64]time.Time
+}
+
+// allow returns true and records the access if no access of the key has been
+// allowed within the interval before now.
+func (t *accessThrottle) allow(key [2]int64, now time.Time) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	if now.Sub(t.updated[key]) < t.interval {
+		return false
+	}
+
+	if len(t.updated) >= t.max {
+		for k, updated := range t.updated {
+			if now.Sub(updated) >= t.interval {
+				delete(t.updated, k)
+			}
+		}
+		// Accesses are only updated more often than needed when too many are
+		// recent.
+		if len(t.updated) >= t.max {
+			t.updated = make(map[[2]int64]time.Time)
+		}
+	}
+	t.updated[key] = now
+	return true
+}
+
+var collaboratorAccesses = &accessThrottle{
+	interval: collaboratorAccessInterval,
+	max:      maxCollaboratorAccesses,
+	updated:  make(map[[2]int64]time.Time),
+}
+
+// UpdateCollaboratorAccess records that the user accessed the repository now,
+// which only takes effect when the user is a collaborator of the repository.
+func UpdateCollaboratorAccess(repoID, userID int64) error {
+	now := time.Now()
+	if !collaboratorAccesses.allow([2]int64{repoID, userID}, now) {
+		return nil
+	}
+
+	collaboration := new(Collaboration)
+	has, err := x.Where("repo_id = ? AND user_id = ?", repoID, userID).Cols("id").Get(collaboration)
+	if err != nil {
+		return err
+	} else if !has {
+		return nil
+	}
+	_, err = x.ID(collaboration.ID).Cols("last_access_unix").Update(&Collaboration{LastAccessUnix: now.Unix()})
+	return err
+}
+
+// lastActiveUnix returns the latest of the last access, the last push and when
+// the collaboration was created.
+func lastActiveUnix(c *Collaboration, lastPushUnix int64) int64 {
+	last := c.LastAccessUnix
+	if lastPushUnix > last {
+		last = lastPushUnix
+	}
+	if c.CreatedUnix > last {
+		last = c.CreatedUnix
+	}
+	return last
+}
+
+// InactiveCollaborators returns collaborations of the repository whose users
+// have neither accessed nor pushed to the repository since the time, with
+// LastActive set. Collaborators who were added before access is tracked and
+// have never been active since are returned with zero LastActive.
+func (repo *Repository) InactiveCollaborators(since time.Time) ([]*Collaboration, error) {
+	collaborations, err := repo.getCollaborations(x)
+	if err != nil {
+		return nil, fmt.Errorf("getCollaborations: %v", err)
+	} else if len(collaborations) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]int64, len(collaborations))
+	for i := range collaborations {
+		userIDs[i] = collaborations[i].UserID
+	}
+	var pushes []*struct {
+		ActUserID    int64
+		LastPushUnix int64
+	}
+	if err = x.Table("action").Select("act_user_id, MAX(created_unix) AS last_push_unix").
+		Where("repo_id = ? AND op_type = ?", repo.ID, ACTION_COMMIT_REPO).
+		In("act_user_id", userIDs).
+		GroupBy("act_user_id").
+		Find(&pushes); err != nil {
+		return nil, fmt.Errorf("find last pushes: %v", err)
+	}
+	lastPushes := make(map[int64]int64, len(pushes))
+	for _, p := range pushes {
+		lastPushes[p.ActUserID] = p.LastPushUnix
+	}
+
+	inactive := make([]*Collaboration, 0, len(collaborations))
+	for _, c := range collaborations {
+		last := lastActiveUnix(c, lastPushes[c.UserID])
+		if last >= since.Unix() {
+			continue
+		}
+		if last > 0 {
+			c.LastActive = time.Unix(last, 0).Local()
+		}
+		inactive = append(inactive, c)
+	}
+	return inactive, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_lastActiveUnix(t *testing.T) {
+	Convey("Get last activity of collaborators", t, func() {
+		So(lastActiveUnix(&Collaboration{LastAccessUnix: 300, CreatedUnix: 100}, 200), ShouldEqual, 300)
+		So(lastActiveUnix(&Collaboration{LastAccessUnix: 150, CreatedUnix: 100}, 200), ShouldEqual, 200)
+		So(lastActiveUnix(&Collaboration{CreatedUnix: 100}, 0), ShouldEqual, 100)
+		So(lastActiveUnix(&Collaboration{}, 0), ShouldEqual, 0)
+	})
+}
+
+func Test_accessThrottle(t *testing.T) {
+	now := time.Now()
+	newThrottle := func() *accessThrottle {
+		return &accessThrottle{
+			interval: time.Hour,
+			max:      2,
+			updated:  make(map[[2]int64]time.Time),
+		}
+	}
+
+	Convey("Allow an access at most once per interval", t, func() {
+		throttle := newThrottle()
+		So(throttle.allow([2]int64{1, 1}, now), ShouldBeTrue)
+		So(throttle.allow([2]int64{1, 1}, now.Add(time.Minute)), ShouldBeFalse)
+		So(throttle.allow([2]int64{1, 2}, now.Add(time.Minute)), ShouldBeTrue)
+		So(throttle.allow([2]int64{1, 1}, now.Add(time.Hour)), ShouldBeTrue)
+	})
+
+	Convey("Keep at most max accesses", t, func() {
+		throttle := newThrottle()
+		So(throttle.allow([2]int64{1, 1}, now), ShouldBeTrue)
+		So(throttle.allow([2]int64{1, 2}, now.Add(30*time.Minute)), ShouldBeTrue)
+
+		// Accesses before the interval are pruned.
+		So(throttle.allow([2]int64{1, 3}, now.Add(time.Hour)), ShouldBeTrue)
+		So(throttle.updated, ShouldHaveLength, 2)
+		So(throttle.allow([2]int64{1, 2}, now.Add(time.Hour)), ShouldBeFalse)
+
+		// All accesses are dropped when none is before the interval.
+		So(throttle.allow([2]int64{1, 4}, now.Add(time.Hour)), ShouldBeTrue)
+		So(throttle.updated, ShouldHaveLength, 1)
+	})
+}
+
+func Test_UpdateCollaboratorAccess(t *testing.T) {
+	setupTestDB(t)
+
+	before := collaboratorAccesses
+	defer func() {
+		collaboratorAccesses = before
+	}()
+	collaboratorAccesses = &accessThrottle{
+		interval: collaboratorAccessInterval,
+		max:      maxCollaboratorAccesses,
+		updated:  make(map[[2]int64]time.Time),
+	}
+
+	insertTestBeans(t, &Collaboration{RepoID: 1, UserID: 1})
+
+	Convey("Only update accesses of collaborators", t, func() {
+		So(UpdateCollaboratorAccess(1, 1), ShouldBeNil)
+		So(UpdateCollaboratorAccess(1, 2), ShouldBeNil)
+
+		collaborations := make([]*Collaboration, 0, 1)
+		So(x.Find(&collaborations), ShouldBeNil)
+		So(collaborations, ShouldHaveLength, 1)
+		So(collaborations[0].LastAccessUnix, ShouldBeGreaterThan, 0)
+	})
+}
@@ -0,0 +1,317 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogs/git-module"
+	"github.com/unknwon/com"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/sync"
+)
+
+// CommitWeek is the activity of the default branch of a repository in a week,
+// which starts on Sunday in UTC.
+type CommitWeek struct {
+	Week         int64 `json:"w"` // Unix time of the start of the week
+	Additions    int64 `json:"a"`
+	Deletions    int64 `json:"d"`
+	Commits      int64 `json:"c"`
+	OwnerCommits int64 `json:"o"`
+}
+
+// PunchCard is the number of commits by day of week (Sunday first) and hour of
+// day in time zones of authors.
+type PunchCard [7][24]int64
+
+// RepoCommitStats is the weekly activity and punch card of the default branch
+// of a repository, aggregated up to the commit. Merge commits are not counted.
+type RepoCommitStats struct {
+	ID       int64
+	RepoID   int64  `xorm:"UNIQUE"`
+	CommitID string `xorm:"VARCHAR(40)"`
+	// OwnerID is the owner whose commits are counted separately, stats are
+	// aggregated again from scratch once the repository is transferred.
+	OwnerID     int64
+	WeeksJSON   string `xorm:"TEXT"`
+	PunchJSON   string `xorm:"TEXT"`
+	UpdatedUnix int64
+
+	Weeks     []*CommitWeek `xorm:"-" json:"-"` // In the order of time
+	PunchCard PunchCard     `xorm:"-" json:"-"`
+}
+
+// decode unmarshals weeks and the punch card from their JSON columns.
+func (s *RepoCommitStats) decode() error {
+	if s.WeeksJSON != "" {
+		if err := json.Unmarshal([]byte(s.WeeksJSON), &s.Weeks); err != nil {
+			return fmt.Errorf("unmarshal weeks: %v", err)
+		}
+	}
+	if s.PunchJSON != "" {
+		if err := json.Unmarshal([]byte(s.PunchJSON), &s.PunchCard); err != nil {
+			return fmt.Errorf("unmarshal punch card: %v", err)
+		}
+	}
+	return nil
+}
+
+// encode marshals weeks and the punch card to their JSON columns.
+func (s *RepoCommitStats) encode() error {
+	data, err := json.Marshal(s.Weeks)
+	if err != nil {
+		return fmt.Errorf("marshal weeks: %v", err)
+	}
+	s.WeeksJSON = string(data)
+
+	data, err = json.Marshal(s.PunchCard)
+	if err != nil {
+		return fmt.Errorf("marshal punch card: %v", err)
+	}
+	s.PunchJSON = string(data)
+	return nil
+}
+
+// startOfWeek returns the start of the week of the time, i.e. Sunday midnight
+// in UTC.
+func startOfWeek(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day()-int(t.Weekday()), 0, 0, 0, 0, time.UTC)
+}
+
+// ContinuousWeeks returns weeks from the first week with commits to the last
+// one, where weeks without commits are filled in with zeros.
+func (s *RepoCommitStats) ContinuousWeeks() []*CommitWeek {
+	if len(s.Weeks) == 0 {
+		return []*CommitWeek{}
+	}
+
+	index := make(map[int64]*CommitWeek, len(s.Weeks))
+	for _, w := range s.Weeks {
+		index[w.Week] = w
+	}
+	first := time.Unix(s.Weeks[0].Week, 0).UTC()
+	last := s.Weeks[len(s.Weeks)-1].Week
+	var weeks []*CommitWeek
+	for t := first; t.Unix() <= last; t = t.AddDate(0, 0, 7) {
+		w := index[t.Unix()]
+		if w == nil {
+			w = &CommitWeek{Week: t.Unix()}
+		}
+		weeks = append(weeks, w)
+	}
+	return weeks
+}
+
+// Participation returns numbers of commits of all authors and of the owner in
+// each of the last 52 weeks up to the week of given time, the oldest first.
+func (s *RepoCommitStats) Participation(now time.Time) (all, owner [52]int64) {
+	current := startOfWeek(now)
+	for _, w := range s.Weeks {
+		// Weeks are always whole, so the difference is a multiple of a week.
+		i := 51 - int(current.Sub(time.Unix(w.Week, 0))/(7*24*time.Hour))
+		if i < 0 || i > 51 {
+			continue
+		}
+		all[i] = w.Commits
+		owner[i] = w.OwnerCommits
+	}
+	return all, owner
+}
+
+// addCommitStatsLog adds commits in output of
+// "git log --numstat --format=%x00%aI%x00%aE" to the stats. Commits whose
+// authors have any of given lower-cased emails are counted as commits of the
+// owner.
+func (s *RepoCommitStats) addCommitStatsLog(log string, ownerEmails map[string]bool) {
+	weeks := make(map[int64]*CommitWeek, len(s.Weeks))
+	for _, w := range s.Weeks {
+		weeks[w.Week] = w
+	}
+
+	fields := strings.Split(log, "\x00")
+	for i := 1; i+1 < len(fields); i += 2 {
+		authored, err := time.Parse(time.RFC3339, strings.TrimSpace(fields[i]))
+		if err != nil {
+			continue
+		}
+		lines := strings.Split(fields[i+1], "\n")
+
+		week := startOfWeek(authored).Unix()
+		w := weeks[week]
+		if w == nil {
+			w = &CommitWeek{Week: week}
+			weeks[week] = w
+		}
+		w.Commits++
+		if ownerEmails[strings.ToLower(strings.TrimSpace(lines[0]))] {
+			w.OwnerCommits++
+		}
+		for _, line := range lines[1:] {
+			// Format: "<added> TAB <deleted> TAB <path>", numbers of binary files
+			// are "-".
+			numbers := strings.SplitN(line, "\t", 3)
+			if len(numbers) < 3 {
+				continue
+			}
+			added, _ := strconv.ParseInt(numbers[0], 10, 64)
+			deleted, _ := strconv.ParseInt(numbers[1], 10, 64)
+			w.Additions += added
+			w.Deletions += deleted
+		}
+
+		// The punch card is in the time zone of the author.
+		s.PunchCard[authored.Weekday()][authored.Hour()]++
+	}
+
+	s.Weeks = make([]*CommitWeek, 0, len(weeks))
+	for _, w := range weeks {
+		s.Weeks = append(s.Weeks, w)
+	}
+	sort.Slice(s.Weeks, func(i, j int) bool { return s.Weeks[i].Week < s.Weeks[j].Week })
+}
+
+// GetRepoCommitStats returns commit stats of the repository, or nil if they
+// have never been aggregated.
+func GetRepoCommitStats(repoID int64) (*RepoCommitStats, error) {
+	stats := new(RepoCommitStats)
+	has, err := x.Where("repo_id = ?", repoID).Get(stats)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, nil
+	}
+	return stats, stats.decode()
+}
+
+// ownerEmails returns lower-cased emails of the owner of the repository.
+func (repo *Repository) ownerEmails() (map[string]bool, error) {
+	if err := repo.GetOwner(); err != nil {
+		return nil, fmt.Errorf("GetOwner: %v", err)
+	}
+
+	emails := map[string]bool{strings.ToLower(repo.Owner.Email): true}
+	if repo.Owner.IsOrganization() {
+		return emails, nil
+	}
+	addresses, err := GetEmailAddresses(repo.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("GetEmailAddresses: %v", err)
+	}
+	for _, a := range addresses {
+		if a.IsActivated {
+			emails[strings.ToLower(a.Email)] = true
+		}
+	}
+	return emails, nil
+}
+
+// AggregateRepoCommitStats aggregates commit stats of the default branch of
+// the repository. Only commits added since the last aggregation are read, unless
+// the history has been rewritten or the owner has changed.
+func AggregateRepoCommitStats(repo *Repository) error {
+	if repo.IsBare {
+		return nil
+	}
+
+	branch, err := repo.EffectiveDefaultBranch()
+	if err != nil {
+		return fmt.Errorf("EffectiveDefaultBranch: %v", err)
+	}
+	repoPath := repo.RepoPath()
+	commitID, err := git.NewCommand("rev-parse", "--verify", git.BRANCH_PREFIX+branch).RunInDir(repoPath)
+	if err != nil {
+		return fmt.Errorf("get commit of default branch %q: %v", branch, err)
+	}
+	commitID = strings.TrimSpace(commitID)
+
+	existing, err := GetRepoCommitStats(repo.ID)
+	if err != nil {
+		return fmt.Errorf("GetRepoCommitStats: %v", err)
+	}
+	stats := &RepoCommitStats{
+		RepoID:  repo.ID,
+		OwnerID: repo.OwnerID,
+	}
+	revRange := commitID
+	if existing != nil {
+		if existing.CommitID == commitID && existing.OwnerID == repo.OwnerID {
+			return nil
+		}
+		stats.ID = existing.ID
+
+		// Merge base fails when the old commit is not an ancestor any more or
+		// has been garbage collected.
+		_, err = git.NewCommand("merge-base", "--is-ancestor", existing.CommitID, commitID).RunInDir(repoPath)
+		if err == nil && existing.OwnerID == repo.OwnerID {
+			stats.Weeks = existing.Weeks
+			stats.PunchCard = existing.PunchCard
+			revRange = existing.CommitID + ".." + commitID
+		}
+	}
+
+	ownerEmails, err := repo.ownerEmails()
+	if err != nil {
+		return err
+	}
+	var stdout bytes.Buffer
+	err = runGitOperation(context.Background(), GIT_OPERATION_LOG, repoPath, &stdout,
+		"log", "--no-merges", "--numstat", "--format=%x00%aI%x00%aE", revRange, "--")
+	if err != nil {
+		return fmt.Errorf("git log: %w", err)
+	}
+	stats.addCommitStatsLog(stdout.String(), ownerEmails)
+
+	stats.CommitID = commitID
+	stats.UpdatedUnix = time.Now().Unix()
+	if err = stats.encode(); err != nil {
+		return err
+	}
+	if stats.ID == 0 {
+		_, err = x.Insert(stats)
+	} else {
+		_, err = x.ID(stats.ID).AllCols().Update(stats)
+	}
+	return err
+}
+
+// CommitStatsQueue is the queue of IDs of repositories whose commit stats need
+// to be aggregated.
+var CommitStatsQueue = sync.NewUniqueQueue(1000)
+
+// AddCommitStatsTask adds the repository to the queue of aggregating commit
+// stats, e.g. after a push to the default branch.
+func AddCommitStatsTask(repoID int64) {
+	go CommitStatsQueue.Add(repoID)
+}
+
+// AggregateCommitStats aggregates commit stats of repositories in the queue.
+func AggregateCommitStats() {
+	for repoID := range CommitStatsQueue.Queue() {
+		log.Trace("AggregateCommitStats [repo_id: %v]", repoID)
+		CommitStatsQueue.Process(repoID, func() {
+			repo, err := GetRepositoryByID(com.StrTo(repoID).MustInt64())
+			if err != nil {
+				log.Error("GetRepositoryByID [%s]: %v", repoID, err)
+				return
+			} else if err = AggregateRepoCommitStats(repo); err != nil {
+				log.Error("Failed to aggregate commit stats [repo_id: %d]: %v", repo.ID, err)
+			}
+		})
+	}
+}
+
+func InitAggregateCommitStats() {
+	go AggregateCommitStats()
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRepoCommitStats(t *testing.T) {
+	Convey("Aggregate commit stats", t, func() {
+		// Weeks start on 2020-01-05 and 2020-01-19, both are Sundays.
+		const week1, week3 = 1578182400, 1579392000
+		logs := []string{
+			"\x002020-01-05T23:30:00+08:00\x00Alice@example.com\n\n3\t1\ta.go\n-\t-\tlogo.png\n" +
+				"\x002020-01-07T10:00:00-05:00\x00bob@example.com\n\n10\t0\tb.go\n",
+			"\x002020-01-20T00:00:00Z\x00alice@example.com\n\n0\t2\ta.go\n",
+		}
+		ownerEmails := map[string]bool{"alice@example.com": true}
+
+		expWeeks := []*CommitWeek{
+			{Week: week1, Additions: 13, Deletions: 1, Commits: 2, OwnerCommits: 1},
+			{Week: week3, Additions: 0, Deletions: 2, Commits: 1, OwnerCommits: 1},
+		}
+		var expPunchCard PunchCard
+		expPunchCard[time.Sunday][23] = 1
+		expPunchCard[time.Tuesday][10] = 1
+		expPunchCard[time.Monday][0] = 1
+
+		stats := new(RepoCommitStats)
+		stats.addCommitStatsLog(logs[0]+logs[1], ownerEmails)
+		So(stats.Weeks, ShouldResemble, expWeeks)
+		So(stats.PunchCard, ShouldResemble, expPunchCard)
+
+		Convey("Commits can be added incrementally", func() {
+			stats := new(RepoCommitStats)
+			stats.addCommitStatsLog(logs[0], ownerEmails)
+			stats.addCommitStatsLog(logs[1], ownerEmails)
+			So(stats.Weeks, ShouldResemble, expWeeks)
+			So(stats.PunchCard, ShouldResemble, expPunchCard)
+		})
+
+		Convey("Stats survive a round trip to the database", func() {
+			So(stats.encode(), ShouldBeNil)
+			decoded := &RepoCommitStats{WeeksJSON: stats.WeeksJSON, PunchJSON: stats.PunchJSON}
+			So(decoded.decode(), ShouldBeNil)
+			So(decoded.Weeks, ShouldResemble, expWeeks)
+			So(decoded.PunchCard, ShouldResemble, expPunchCard)
+		})
+
+		Convey("Weeks without commits are filled in", func() {
+			So(stats.ContinuousWeeks(), ShouldResemble, []*CommitWeek{
+				expWeeks[0],
+				{Week: week1 + 7*24*3600},
+				expWeeks[1],
+			})
+			So(new(RepoCommitStats).ContinuousWeeks(), ShouldBeEmpty)
+		})
+
+		Convey("Participation covers the last 52 weeks", func() {
+			all, owner := stats.Participation(time.Date(2020, 1, 22, 12, 0, 0, 0, time.UTC))
+			So(all[51], ShouldEqual, 1)
+			So(all[50], ShouldEqual, 0)
+			So(all[49], ShouldEqual, 2)
+			So(owner[49], ShouldEqual, 1)
+
+			// The first week drops out after 52 weeks.
+			all, _ = stats.Participation(time.Unix(week1, 0).AddDate(0, 0, 52*7))
+			So(all[0], ShouldEqual, 0)
+			So(all[1], ShouldEqual, 1)
+		})
+	})
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogs/git-module"
+	log "unknwon.dev/clog/v2"
+)
+
+// RepoCommitsCount is the cached number of commits of a branch of a
+// repository, counted up to the commit. The cache is only valid while the
+// branch points to the commit.
+type RepoCommitsCount struct {
+	ID          int64
+	RepoID      int64  `xorm:"UNIQUE(s)"`
+	Branch      string `xorm:"UNIQUE(s)"`
+	CommitID    string `xorm:"VARCHAR(40)"`
+	Count       int64
+	UpdatedUnix int64
+}
+
+// countCommits returns the number of commits reachable from the commit. When
+// the cached count is of an ancestor of the commit, only commits added since
+// then are counted, otherwise, e.g. after a force push, all commits are
+// counted again.
+func countCommits(repoPath, commitID string, cached *RepoCommitsCount) (int64, error) {
+	if cached != nil && cached.CommitID == commitID {
+		return cached.Count, nil
+	}
+
+	var base int64
+	revRange := commitID
+	if cached != nil {
+		_, err := git.NewCommand("merge-base", "--is-ancestor", cached.CommitID, commitID).RunInDir(repoPath)
+		if err == nil {
+			base = cached.Count
+			revRange = cached.CommitID + ".." + commitID
+		}
+	}
+
+	var stdout bytes.Buffer
+	err := runGitOperation(context.Background(), GIT_OPERATION_LOG, repoPath, &stdout, "rev-list", "--count", revRange, "--")
+	if err != nil {
+		return 0, fmt.Errorf("git rev-list: %w", err)
+	}
+	count, err := strconv.ParseInt(strings.TrimSpace(stdout.String()), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse count %q: %v", stdout.String(), err)
+	}
+	return base + count, nil
+}
+
+func (repo *Repository) getCommitsCountCache(branch string) (*RepoCommitsCount, error) {
+	cached := new(RepoCommitsCount)
+	has, err := x.Where("repo_id = ? AND branch = ?", repo.ID, branch).Get(cached)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, nil
+	}
+	return cached, nil
+}
+
+// saveCommitsCount counts commits of the branch up to the commit, and saves
+// the count to the cache.
+func (repo *Repository) saveCommitsCount(cached *RepoCommitsCount, branch, commitID string) (int64, error) {
+	count, err := countCommits(repo.RepoPath(), commitID, cached)
+	if err != nil {
+		return 0, err
+	} else if cached != nil && cached.CommitID == commitID {
+		return count, nil
+	}
+
+	if cached == nil {
+		cached = &RepoCommitsCount{
+			RepoID: repo.ID,
+			Branch: branch,
+		}
+	}
+	cached.CommitID = commitID
+	cached.Count = count
+	cached.UpdatedUnix = time.Now().Unix()
+	if cached.ID == 0 {
+		_, err = x.Insert(cached)
+	} else {
+		_, err = x.ID(cached.ID).AllCols().Update(cached)
+	}
+	if err != nil {
+		// Another request may have cached the branch in the meantime, the count
+		// is still right.
+		log.Error("Failed to cache commits count [repo_id: %d, branch: %s]: %v", repo.ID, branch, err)
+	}
+	return count, nil
+}
+
+// CommitsCount returns the number of commits of the branch. The count is
+// cached until the branch moves, so it is only counted on cache miss.
+func (repo *Repository) CommitsCount(branch string) (int64, error) {
+	commitID, err := git.NewCommand("rev-parse", "--verify", git.BRANCH_PREFIX+branch).RunInDir(repo.RepoPath())
+	if err != nil {
+		return 0, fmt.Errorf("get commit of branch %q: %v", branch, err)
+	}
+
+	cached, err := repo.getCommitsCountCache(branch)
+	if err != nil {
+		return 0, fmt.Errorf("getCommitsCountCache: %v", err)
+	}
+	return repo.saveCommitsCount(cached, branch, strings.TrimSpace(commitID))
+}
+
+// updateCommitsCount updates the cached count of the branch after it has been
+// pushed to the commit, or removes the cache when the branch is deleted.
+// Branches never counted are left to be counted on first use.
+func (repo *Repository) updateCommitsCount(branch, newCommitID string) error {
+	if newCommitID == git.EMPTY_SHA {
+		_, err := x.Delete(&RepoCommitsCount{RepoID: repo.ID, Branch: branch})
+		return err
+	}
+
+	cached, err := repo.getCommitsCountCache(branch)
+	if err != nil {
+		return fmt.Errorf("getCommitsCountCache: %v", err)
+	} else if cached == nil {
+		return nil
+	}
+	_, err = repo.saveCommitsCount(cached, branch, newCommitID)
+	return err
+}
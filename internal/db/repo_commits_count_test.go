@@ -0,0 +1,66 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func TestCountCommits(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	// push adds commits on top of the branch and returns the new head, like a
+	// push received by the post-receive hook.
+	push := func(n int) string {
+		for i := 0; i < n; i++ {
+			repo.Commit("commit")
+		}
+		return repo.Git("rev-parse", "HEAD")
+	}
+	// cache returns the cache entry as updated after counting the commit.
+	cache := func(commitID string, cached *RepoCommitsCount) *RepoCommitsCount {
+		count, err := countCommits(dir, commitID, cached)
+		So(err, ShouldBeNil)
+		return &RepoCommitsCount{CommitID: commitID, Count: count}
+	}
+
+	first := push(3)
+
+	Convey("Count commits of a branch", t, func() {
+		cached := cache(first, nil)
+		So(cached.Count, ShouldEqual, 3)
+
+		Convey("Cached count is used while the branch does not move", func() {
+			count, err := countCommits(dir, first, &RepoCommitsCount{CommitID: first, Count: 100})
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 100)
+		})
+
+		Convey("Pushes only count new commits", func() {
+			second := push(2)
+			// A stale cached number shows that old commits are not counted again.
+			cached := cache(second, &RepoCommitsCount{CommitID: first, Count: 100})
+			So(cached.Count, ShouldEqual, 102)
+
+			cached = cache(push(1), cache(second, cache(first, nil)))
+			So(cached.Count, ShouldEqual, 6)
+		})
+
+		Convey("Force pushes count all commits again", func() {
+			// The branch has 6 commits, and is rewritten to have 5.
+			head := repo.Git("rev-parse", "HEAD")
+			repo.Git("reset", "--quiet", "--hard", "HEAD~2")
+			forced := push(1)
+
+			cached := cache(forced, &RepoCommitsCount{CommitID: head, Count: 100})
+			So(cached.Count, ShouldEqual, 5)
+		})
+	})
+}
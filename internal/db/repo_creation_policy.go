@@ -0,0 +1,173 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// Values of "[repository] ALLOWED_CREATORS", which are also reasons of
+// ErrRepoCreationForbidden.
+const (
+	REPO_CREATORS_EVERYONE   = "everyone"
+	REPO_CREATORS_ORG_OWNERS = "org_owners"
+	REPO_CREATORS_ADMINS     = "admins"
+)
+
+// OrgRepoPolicy is the repository policy of an organization set by its owners,
+// which can only be stricter than the policy of the site.
+type OrgRepoPolicy struct {
+	ID    int64
+	OrgID int64 `xorm:"UNIQUE"`
+	// ForcePrivate indicates whether to force every new repository of the
+	// organization to be private.
+	ForcePrivate bool
+	// ForbidVisibilityChange indicates whether to forbid repositories forced to
+	// be private to be made public.
+	ForbidVisibilityChange bool
+	// MaxCreationLimit is the max number of repositories of the organization,
+	// -1 means to use the limit set by admins.
+	MaxCreationLimit int `xorm:"NOT NULL DEFAULT -1"`
+}
+
+// GetOrgRepoPolicy returns the repository policy of the organization, or the
+// default one if its owners have not set any.
+func GetOrgRepoPolicy(orgID int64) (*OrgRepoPolicy, error) {
+	return getOrgRepoPolicy(x, orgID)
+}
+
+func getOrgRepoPolicy(e Engine, orgID int64) (*OrgRepoPolicy, error) {
+	p := &OrgRepoPolicy{OrgID: orgID}
+	has, err := e.Get(p)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		p.MaxCreationLimit = -1
+	}
+	return p, nil
+}
+
+// UpdateOrgRepoPolicy creates or updates the repository policy of the
+// organization. The limit must not exceed the one set by admins.
+func UpdateOrgRepoPolicy(org *User, p *OrgRepoPolicy) error {
+	if p.MaxCreationLimit < -1 {
+		p.MaxCreationLimit = -1
+	}
+	if bound := org.AdminRepoCreationLimit(); bound > -1 && p.MaxCreationLimit > bound {
+		return ErrRepoCreationLimitOutOfBound{OrgID: org.ID, Limit: p.MaxCreationLimit, Bound: bound}
+	}
+
+	p.OrgID = org.ID
+	existing, err := getOrgRepoPolicy(x, org.ID)
+	if err != nil {
+		return err
+	} else if existing.ID == 0 {
+		_, err = x.Insert(p)
+		return err
+	}
+
+	p.ID = existing.ID
+	_, err = x.ID(p.ID).AllCols().Update(p)
+	return err
+}
+
+// RepoPolicy is the effective repository policy of a user or an organization.
+type RepoPolicy struct {
+	// ForcePrivate indicates whether every new repository is forced to be
+	// private.
+	ForcePrivate bool
+	// ForbidVisibilityChange indicates whether private repositories cannot be
+	// made public, which only applies when ForcePrivate is true.
+	ForbidVisibilityChange bool
+	// MaxCreationLimit is the max number of repositories, -1 means no limit.
+	MaxCreationLimit int
+}
+
+// AdminRepoCreationLimit returns the max number of repositories the user or
+// organization can have by admins, -1 means no limit.
+func (u *User) AdminRepoCreationLimit() int {
+	if u.MaxRepoCreation > -1 {
+		return u.MaxRepoCreation
+	} else if u.IsOrganization() {
+		return conf.Repository.MaxCreationLimitPerOrg
+	}
+	return conf.Repository.MaxCreationLimit
+}
+
+// newRepoPolicy returns the effective repository policy of the owner with the
+// policy of the organization, which is nil for users.
+func newRepoPolicy(owner *User, orgPolicy *OrgRepoPolicy) *RepoPolicy {
+	p := &RepoPolicy{
+		ForcePrivate:           conf.Repository.ForcePrivate,
+		ForbidVisibilityChange: conf.Repository.ForbidVisibilityChange,
+		MaxCreationLimit:       owner.AdminRepoCreationLimit(),
+	}
+	if orgPolicy != nil {
+		p.ForcePrivate = p.ForcePrivate || orgPolicy.ForcePrivate
+		p.ForbidVisibilityChange = p.ForbidVisibilityChange || orgPolicy.ForbidVisibilityChange
+		if orgPolicy.MaxCreationLimit > -1 &&
+			(p.MaxCreationLimit == -1 || orgPolicy.MaxCreationLimit < p.MaxCreationLimit) {
+			p.MaxCreationLimit = orgPolicy.MaxCreationLimit
+		}
+	}
+	p.ForbidVisibilityChange = p.ForcePrivate && p.ForbidVisibilityChange
+	return p
+}
+
+// RepoPolicy returns the effective repository policy of the user or
+// organization.
+func (u *User) RepoPolicy() (*RepoPolicy, error) {
+	return u.getRepoPolicy(x)
+}
+
+func (u *User) getRepoPolicy(e Engine) (*RepoPolicy, error) {
+	if !u.IsOrganization() {
+		return newRepoPolicy(u, nil), nil
+	}
+
+	orgPolicy, err := getOrgRepoPolicy(e, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getOrgRepoPolicy: %v", err)
+	}
+	return newRepoPolicy(u, orgPolicy), nil
+}
+
+// checkRepoCreator returns an error if the doer is not allowed to create
+// repositories for the owner by "[repository] ALLOWED_CREATORS".
+func checkRepoCreator(doer, owner *User) error {
+	if doer.IsAdmin {
+		return nil
+	}
+
+	switch conf.Repository.AllowedCreators {
+	case REPO_CREATORS_ADMINS:
+		return ErrRepoCreationForbidden{UserID: doer.ID, OwnerID: owner.ID, Reason: REPO_CREATORS_ADMINS}
+	case REPO_CREATORS_ORG_OWNERS:
+		if !owner.IsOrganization() {
+			return ErrRepoCreationForbidden{UserID: doer.ID, OwnerID: owner.ID, Reason: REPO_CREATORS_ORG_OWNERS}
+		}
+	}
+	return nil
+}
+
+// CheckRepoCreation returns the effective repository policy of the owner, or
+// an error if the doer is not allowed to create a repository for the owner.
+// It does not check whether the doer owns the organization.
+func CheckRepoCreation(doer, owner *User) (*RepoPolicy, error) {
+	if err := checkRepoCreator(doer, owner); err != nil {
+		return nil, err
+	}
+
+	p, err := owner.RepoPolicy()
+	if err != nil {
+		return nil, err
+	} else if p.MaxCreationLimit > -1 && owner.NumRepos >= p.MaxCreationLimit {
+		return nil, errors.ReachLimitOfRepo{Limit: p.MaxCreationLimit}
+	}
+	return p, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+func Test_newRepoPolicy(t *testing.T) {
+	before := conf.Repository
+	defer func() {
+		conf.Repository = before
+	}()
+
+	conf.Repository.ForcePrivate = false
+	conf.Repository.ForbidVisibilityChange = true
+	conf.Repository.MaxCreationLimit = 10
+	conf.Repository.MaxCreationLimitPerOrg = 20
+
+	Convey("Use limits of users and organizations", t, func() {
+		So(newRepoPolicy(&User{MaxRepoCreation: -1}, nil).MaxCreationLimit, ShouldEqual, 10)
+		So(newRepoPolicy(&User{MaxRepoCreation: 3}, nil).MaxCreationLimit, ShouldEqual, 3)
+		So(newRepoPolicy(&User{Type: USER_TYPE_ORGANIZATION, MaxRepoCreation: -1}, nil).MaxCreationLimit, ShouldEqual, 20)
+	})
+
+	Convey("Only forbid visibility change of forced private repositories", t, func() {
+		p := newRepoPolicy(&User{MaxRepoCreation: -1}, nil)
+		So(p.ForcePrivate, ShouldBeFalse)
+		So(p.ForbidVisibilityChange, ShouldBeFalse)
+	})
+
+	Convey("Apply stricter policies of organizations", t, func() {
+		org := &User{Type: USER_TYPE_ORGANIZATION, MaxRepoCreation: -1}
+		p := newRepoPolicy(org, &OrgRepoPolicy{ForcePrivate: true, MaxCreationLimit: 5})
+		So(p.ForcePrivate, ShouldBeTrue)
+		So(p.ForbidVisibilityChange, ShouldBeTrue)
+		So(p.MaxCreationLimit, ShouldEqual, 5)
+
+		p = newRepoPolicy(org, &OrgRepoPolicy{MaxCreationLimit: 50})
+		So(p.MaxCreationLimit, ShouldEqual, 20)
+
+		conf.Repository.MaxCreationLimitPerOrg = -1
+		p = newRepoPolicy(org, &OrgRepoPolicy{MaxCreationLimit: 50})
+		So(p.MaxCreationLimit, ShouldEqual, 50)
+	})
+}
+
+func Test_checkRepoCreator(t *testing.T) {
+	allowedCreators := conf.Repository.AllowedCreators
+	defer func() {
+		conf.Repository.AllowedCreators = allowedCreators
+	}()
+
+	user := &User{ID: 1}
+	admin := &User{ID: 2, IsAdmin: true}
+	org := &User{ID: 3, Type: USER_TYPE_ORGANIZATION}
+
+	Convey("Allow everyone", t, func() {
+		conf.Repository.AllowedCreators = REPO_CREATORS_EVERYONE
+		So(checkRepoCreator(user, user), ShouldBeNil)
+		So(checkRepoCreator(user, org), ShouldBeNil)
+	})
+
+	Convey("Only allow owners of organizations", t, func() {
+		conf.Repository.AllowedCreators = REPO_CREATORS_ORG_OWNERS
+		So(IsErrRepoCreationForbidden(checkRepoCreator(user, user)), ShouldBeTrue)
+		So(checkRepoCreator(user, org), ShouldBeNil)
+		So(checkRepoCreator(admin, admin), ShouldBeNil)
+	})
+
+	Convey("Only allow admins", t, func() {
+		conf.Repository.AllowedCreators = REPO_CREATORS_ADMINS
+		So(IsErrRepoCreationForbidden(checkRepoCreator(user, org)), ShouldBeTrue)
+		So(checkRepoCreator(admin, user), ShouldBeNil)
+	})
+}
+
+func Test_UpdateRepository_forkVisibility(t *testing.T) {
+	setupTestDB(t)
+
+	root := conf.Repository.Root
+	defer func() {
+		conf.Repository.Root = root
+	}()
+	conf.Repository.Root = t.TempDir()
+
+	alice := &User{Name: "alice", LowerName: "alice"}
+	bob := &User{Name: "bob", LowerName: "bob"}
+	org := &User{Name: "acme", LowerName: "acme", Type: USER_TYPE_ORGANIZATION}
+	insertTestBeans(t, alice, bob, org)
+	insertTestBeans(t, &OrgRepoPolicy{OrgID: org.ID, ForcePrivate: true, ForbidVisibilityChange: true, MaxCreationLimit: -1})
+
+	base := &Repository{OwnerID: alice.ID, Owner: alice, Name: "proj", LowerName: "proj", IsPrivate: true}
+	insertTestBeans(t, base)
+	userFork := &Repository{OwnerID: bob.ID, Name: "proj", LowerName: "proj", IsPrivate: true, IsFork: true, ForkID: base.ID}
+	orgFork := &Repository{OwnerID: org.ID, Name: "proj", LowerName: "proj", IsPrivate: true, IsFork: true, ForkID: base.ID}
+	insertTestBeans(t, userFork, orgFork)
+
+	Convey("Keep forks private when their owners forbid to make them public", t, func() {
+		base.IsPrivate = false
+		So(UpdateRepository(base, true), ShouldBeNil)
+
+		repo, err := GetRepositoryByID(userFork.ID)
+		So(err, ShouldBeNil)
+		So(repo.IsPrivate, ShouldBeFalse)
+
+		repo, err = GetRepositoryByID(orgFork.ID)
+		So(err, ShouldBeNil)
+		So(repo.IsPrivate, ShouldBeTrue)
+	})
+}
@@ -0,0 +1,398 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogs/git-module"
+	"github.com/unknwon/com"
+	log "unknwon.dev/clog/v2"
+	"xorm.io/builder"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/dependency"
+	"gogs.io/gogs/internal/process"
+	"gogs.io/gogs/internal/sync"
+)
+
+const (
+	// maxManifestSize is the maximum size in bytes of a manifest file that is
+	// parsed.
+	maxManifestSize = 1 << 20
+	// maxManifests is the maximum number of manifest files of a repository
+	// that are parsed.
+	maxManifests = 100
+)
+
+// RepoManifest is a manifest file on the default branch of a repository that
+// dependencies are parsed from. The error is not empty when the file cannot be
+// parsed.
+type RepoManifest struct {
+	ID              int64
+	RepoID          int64  `xorm:"INDEX"`
+	TreePath        string `xorm:"TEXT"`
+	Ecosystem       dependency.Ecosystem
+	BlobID          string `xorm:"VARCHAR(40)"`
+	Error           string `xorm:"TEXT"`
+	NumDependencies int
+	UpdatedUnix     int64
+
+	Dependencies []*RepoDependency `xorm:"-" json:"-"`
+}
+
+// RepoDependency is a package that a manifest file of a repository depends on.
+type RepoDependency struct {
+	ID         int64
+	RepoID     int64 `xorm:"INDEX"`
+	ManifestID int64 `xorm:"INDEX"`
+	Ecosystem  dependency.Ecosystem
+	Name       string
+	LowerName  string `xorm:"INDEX"`
+	Version    string
+
+	Repo *Repository `xorm:"-" json:"-"`
+}
+
+// RegistryURL returns the URL of the package on the upstream registry.
+func (d *RepoDependency) RegistryURL() string {
+	return dependency.RegistryURL(d.Ecosystem, d.Name)
+}
+
+// RepoDependencyScan is the commit of the default branch of a repository that
+// manifest files were last scanned at.
+type RepoDependencyScan struct {
+	ID          int64
+	RepoID      int64     `xorm:"UNIQUE"`
+	CommitID    string    `xorm:"VARCHAR(40)"`
+	Scanned     time.Time `xorm:"-" json:"-"`
+	ScannedUnix int64
+}
+
+func (s *RepoDependencyScan) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "scanned_unix":
+		s.Scanned = time.Unix(s.ScannedUnix, 0).Local()
+	}
+}
+
+// GetRepoDependencyScan returns the last scan of manifest files of the
+// repository, or nil if the repository has never been scanned.
+func GetRepoDependencyScan(repoID int64) (*RepoDependencyScan, error) {
+	scan := new(RepoDependencyScan)
+	has, err := x.Where("repo_id = ?", repoID).Get(scan)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, nil
+	}
+	return scan, nil
+}
+
+// GetRepoManifests returns manifest files of the repository with their
+// dependencies, in the order of paths.
+func GetRepoManifests(repoID int64) ([]*RepoManifest, error) {
+	manifests := make([]*RepoManifest, 0, 5)
+	if err := x.Where("repo_id = ?", repoID).Find(&manifests); err != nil {
+		return nil, fmt.Errorf("find manifests: %v", err)
+	}
+	sort.Slice(manifests, func(i, j int) bool { return manifests[i].TreePath < manifests[j].TreePath })
+
+	deps := make([]*RepoDependency, 0, 20)
+	if err := x.Where("repo_id = ?", repoID).Asc("lower_name").Find(&deps); err != nil {
+		return nil, fmt.Errorf("find dependencies: %v", err)
+	}
+	index := make(map[int64]*RepoManifest, len(manifests))
+	for _, m := range manifests {
+		index[m.ID] = m
+	}
+	for _, d := range deps {
+		if m, ok := index[d.ManifestID]; ok {
+			m.Dependencies = append(m.Dependencies, d)
+		}
+	}
+	return manifests, nil
+}
+
+// manifestEntry is a manifest file in a tree.
+type manifestEntry struct {
+	TreePath  string
+	Ecosystem dependency.Ecosystem
+	BlobID    string
+	Size      int64
+}
+
+// parseManifestEntries returns manifest files in output of
+// "git ls-tree -r -l -z", in the order of paths. Files in directories of
+// vendored dependencies are skipped.
+func parseManifestEntries(lsTree string) []*manifestEntry {
+	var entries []*manifestEntry
+	for _, entry := range strings.Split(lsTree, "\x00") {
+		// Format: "<mode> SP <type> SP <object> SP+ <size> TAB <path>"
+		i := strings.IndexByte(entry, '\t')
+		if i < 0 {
+			continue
+		}
+		fields := strings.Fields(entry[:i])
+		if len(fields) != 4 || fields[1] != "blob" {
+			continue
+		}
+
+		treePath := entry[i+1:]
+		ecosystem, ok := dependency.ManifestEcosystem(treePath)
+		if !ok || isVendoredPath(treePath) {
+			continue
+		}
+		size, _ := strconv.ParseInt(fields[3], 10, 64)
+		entries = append(entries, &manifestEntry{
+			TreePath:  treePath,
+			Ecosystem: ecosystem,
+			BlobID:    fields[2],
+			Size:      size,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].TreePath < entries[j].TreePath })
+	return entries
+}
+
+// isVendoredPath returns true if the path is in a directory of vendored
+// dependencies, whose manifests are not dependencies of the repository.
+func isVendoredPath(treePath string) bool {
+	for _, dir := range strings.Split(treePath, "/") {
+		switch dir {
+		case "vendor", "node_modules", "third_party":
+			return true
+		}
+	}
+	return false
+}
+
+// parseManifest returns the manifest with dependencies parsed from the blob.
+// Errors of reading or parsing the file are reported by the manifest.
+func parseManifest(repoPath string, entry *manifestEntry) *RepoManifest {
+	m := &RepoManifest{
+		TreePath:  entry.TreePath,
+		Ecosystem: entry.Ecosystem,
+		BlobID:    entry.BlobID,
+	}
+	if entry.Size > maxManifestSize {
+		m.Error = fmt.Sprintf("file is too large to be parsed (%d bytes, maximum is %d bytes)", entry.Size, maxManifestSize)
+		return m
+	}
+
+	data, err := git.NewCommand("cat-file", "blob", entry.BlobID).RunInDirBytes(repoPath)
+	if err != nil {
+		m.Error = fmt.Sprintf("read file: %v", err)
+		return m
+	}
+	deps, err := dependency.Parse(entry.Ecosystem, data)
+	if err != nil {
+		m.Error = err.Error()
+		return m
+	}
+
+	m.NumDependencies = len(deps)
+	m.Dependencies = make([]*RepoDependency, len(deps))
+	for i, d := range deps {
+		m.Dependencies[i] = &RepoDependency{
+			Ecosystem: entry.Ecosystem,
+			Name:      d.Name,
+			LowerName: strings.ToLower(d.Name),
+			Version:   d.Version,
+		}
+	}
+	return m
+}
+
+// ScanRepoDependencies parses manifest files on the default branch of the
+// repository. Only manifest files that are added or changed since the last
+// scan are parsed again.
+func ScanRepoDependencies(repo *Repository) error {
+	if repo.IsBare {
+		return nil
+	}
+
+	branch, err := repo.EffectiveDefaultBranch()
+	if err != nil {
+		return fmt.Errorf("EffectiveDefaultBranch: %v", err)
+	}
+	repoPath := repo.RepoPath()
+	commitID, err := git.NewCommand("rev-parse", "--verify", git.BRANCH_PREFIX+branch).RunInDir(repoPath)
+	if err != nil {
+		return fmt.Errorf("get commit of default branch %q: %v", branch, err)
+	}
+	commitID = strings.TrimSpace(commitID)
+
+	scan, err := GetRepoDependencyScan(repo.ID)
+	if err != nil {
+		return fmt.Errorf("GetRepoDependencyScan: %v", err)
+	} else if scan != nil && scan.CommitID == commitID {
+		return nil
+	}
+
+	stdout, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("ScanRepoDependencies 'git ls-tree': %s", repoPath),
+		"git", "ls-tree", "-r", "-l", "-z", commitID)
+	if err != nil {
+		return fmt.Errorf("git ls-tree: %v - %s", err, stderr)
+	}
+	entries := parseManifestEntries(stdout)
+	if len(entries) > maxManifests {
+		log.Trace("Only first %d of %d manifest files of repository [%d] are scanned", maxManifests, len(entries), repo.ID)
+		entries = entries[:maxManifests]
+	}
+
+	existing := make([]*RepoManifest, 0, len(entries))
+	if err = x.Where("repo_id = ?", repo.ID).Find(&existing); err != nil {
+		return fmt.Errorf("find manifests: %v", err)
+	}
+	unchanged := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		unchanged[m.TreePath+"\x00"+m.BlobID] = true
+	}
+
+	// Files are read and parsed before the transaction to keep it short.
+	keep := make(map[string]bool, len(entries))
+	var changed []*RepoManifest
+	for _, entry := range entries {
+		key := entry.TreePath + "\x00" + entry.BlobID
+		keep[key] = true
+		if !unchanged[key] {
+			changed = append(changed, parseManifest(repoPath, entry))
+		}
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	for _, m := range existing {
+		if keep[m.TreePath+"\x00"+m.BlobID] {
+			continue
+		}
+		if _, err = sess.ID(m.ID).Delete(new(RepoManifest)); err != nil {
+			return fmt.Errorf("delete manifest: %v", err)
+		} else if _, err = sess.Where("manifest_id = ?", m.ID).Delete(new(RepoDependency)); err != nil {
+			return fmt.Errorf("delete dependencies: %v", err)
+		}
+	}
+
+	now := time.Now().Unix()
+	for _, m := range changed {
+		m.RepoID = repo.ID
+		m.UpdatedUnix = now
+		if _, err = sess.Insert(m); err != nil {
+			return fmt.Errorf("insert manifest: %v", err)
+		}
+		for _, d := range m.Dependencies {
+			d.RepoID = repo.ID
+			d.ManifestID = m.ID
+			if _, err = sess.Insert(d); err != nil {
+				return fmt.Errorf("insert dependency: %v", err)
+			}
+		}
+	}
+
+	if scan == nil {
+		_, err = sess.Insert(&RepoDependencyScan{
+			RepoID:      repo.ID,
+			CommitID:    commitID,
+			ScannedUnix: now,
+		})
+	} else {
+		scan.CommitID = commitID
+		scan.ScannedUnix = now
+		_, err = sess.ID(scan.ID).Cols("commit_id", "scanned_unix").Update(scan)
+	}
+	if err != nil {
+		return fmt.Errorf("save scan: %v", err)
+	}
+	return sess.Commit()
+}
+
+// DependencyScanQueue is the queue of IDs of repositories whose manifest files
+// need to be scanned.
+var DependencyScanQueue = sync.NewUniqueQueue(1000)
+
+// AddDependencyScanTask adds the repository to the queue of scanning manifest
+// files, e.g. after a push to the default branch.
+func AddDependencyScanTask(repoID int64) {
+	go DependencyScanQueue.Add(repoID)
+}
+
+// ScanDependencies scans manifest files of repositories in the queue.
+func ScanDependencies() {
+	for repoID := range DependencyScanQueue.Queue() {
+		log.Trace("ScanDependencies [repo_id: %v]", repoID)
+		DependencyScanQueue.Process(repoID, func() {
+			repo, err := GetRepositoryByID(com.StrTo(repoID).MustInt64())
+			if err != nil {
+				log.Error("GetRepositoryByID [%s]: %v", repoID, err)
+				return
+			} else if err = ScanRepoDependencies(repo); err != nil {
+				log.Error("Failed to scan dependencies [repo_id: %d]: %v", repo.ID, err)
+			}
+		})
+	}
+}
+
+func InitScanDependencies() {
+	go ScanDependencies()
+}
+
+// escapeLike escapes the wildcards of the LIKE pattern in the keyword with "!",
+// which works as the escape character across databases.
+func escapeLike(keyword string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(keyword)
+}
+
+// SearchOrgDependencies returns dependencies of repositories of the
+// organization whose names contain the keyword, with repositories loaded. Only
+// dependencies of repositories the user can read are returned, and at most limit
+// dependencies are returned.
+func SearchOrgDependencies(orgID, userID int64, keyword string, limit int) ([]*RepoDependency, error) {
+	keyword = strings.ToLower(strings.TrimSpace(keyword))
+	if keyword == "" {
+		return nil, nil
+	}
+
+	// Same as userAccessMode, private repositories are readable through the
+	// access table or the grant of a bot.
+	readable := builder.Eq{"repository.is_private": false}.
+		Or(builder.In("repository.id", builder.Select("repo_id").From("access").
+			Where(builder.Eq{"user_id": userID}.And(builder.Gte{"mode": ACCESS_MODE_READ})))).
+		Or(builder.In("repository.id", builder.Select("repo_id").From("bot_grant").
+			Where(builder.Eq{"bot_id": userID, "can_read_code": true})))
+
+	deps := make([]*RepoDependency, 0, 10)
+	if err := x.Join("INNER", "repository", "repository.id = repo_dependency.repo_id").
+		Where("repository.owner_id = ?", orgID).
+		And(readable).
+		And("repo_dependency.lower_name LIKE ? ESCAPE '!'", "%"+escapeLike(keyword)+"%").
+		Asc("repo_dependency.lower_name", "repository.lower_name").
+		Limit(limit).
+		Find(&deps); err != nil {
+		return nil, fmt.Errorf("find dependencies: %v", err)
+	}
+
+	repos := make(map[int64]*Repository)
+	for _, d := range deps {
+		if repos[d.RepoID] == nil {
+			repo, err := GetRepositoryByID(d.RepoID)
+			if err != nil {
+				return nil, fmt.Errorf("GetRepositoryByID [%d]: %v", d.RepoID, err)
+			}
+			repos[d.RepoID] = repo
+		}
+		d.Repo = repos[d.RepoID]
+	}
+	return deps, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/dependency"
+)
+
+func Test_parseManifestEntries(t *testing.T) {
+	Convey("Parse manifest files in output of ls-tree", t, func() {
+		lsTree := strings.Join([]string{
+			"100644 blob 1111111111111111111111111111111111111111     120\tgo.mod",
+			"100644 blob 2222222222222222222222222222222222222222     300\tgo.sum",
+			"100644 blob 3333333333333333333333333333333333333333 2000000\tweb/package.json",
+			"100644 blob 4444444444444444444444444444444444444444      50\tweb/node_modules/react/package.json",
+			"100644 blob 5555555555555555555555555555555555555555      60\tvendor/github.com/pkg/go.mod",
+			"160000 commit 6666666666666666666666666666666666666666       -\tlib/pom.xml",
+			"100644 blob 7777777777777777777777777777777777777777      80\tapi/requirements.txt",
+			"",
+		}, "\x00")
+
+		entries := parseManifestEntries(lsTree)
+		So(entries, ShouldResemble, []*manifestEntry{
+			{TreePath: "api/requirements.txt", Ecosystem: dependency.PYPI, BlobID: "7777777777777777777777777777777777777777", Size: 80},
+			{TreePath: "go.mod", Ecosystem: dependency.GO, BlobID: "1111111111111111111111111111111111111111", Size: 120},
+			{TreePath: "web/package.json", Ecosystem: dependency.NPM, BlobID: "3333333333333333333333333333333333333333", Size: 2000000},
+		})
+	})
+}
+
+func Test_parseManifest(t *testing.T) {
+	Convey("Report oversized manifest files without reading them", t, func() {
+		m := parseManifest("/nonexistent", &manifestEntry{
+			TreePath:  "package.json",
+			Ecosystem: dependency.NPM,
+			BlobID:    "3333333333333333333333333333333333333333",
+			Size:      maxManifestSize + 1,
+		})
+		So(m.TreePath, ShouldEqual, "package.json")
+		So(m.Error, ShouldContainSubstring, "too large")
+		So(m.Dependencies, ShouldBeEmpty)
+	})
+}
+
+func TestSearchOrgDependencies(t *testing.T) {
+	Convey("Search dependencies of repositories the user can read", t, func() {
+		setupTestDB(t)
+
+		const orgID, userID, botID = 1, 2, 3
+		insertTestBeans(t,
+			&User{ID: orgID, Name: "org", LowerName: "org", Email: "org@example.com", Type: USER_TYPE_ORGANIZATION},
+			&User{ID: 99, Name: "other", LowerName: "other", Email: "other@example.com"},
+			&Repository{ID: 1, OwnerID: orgID, LowerName: "public"},
+			&Repository{ID: 2, OwnerID: orgID, LowerName: "private", IsPrivate: true},
+			&Repository{ID: 3, OwnerID: orgID, LowerName: "archive", IsPrivate: true},
+			&Repository{ID: 4, OwnerID: 99, LowerName: "other"},
+			&Access{UserID: userID, RepoID: 2, Mode: ACCESS_MODE_READ},
+			&BotGrant{BotID: botID, RepoID: 3, CanReadCode: true},
+		)
+		// Dependencies of the unreadable repository sort first so that they
+		// would use up the limit if the access were filtered afterwards.
+		for _, repoID := range []int64{3, 3, 3, 1, 2, 4} {
+			insertTestBeans(t, &RepoDependency{RepoID: repoID, Name: "left_pad", LowerName: "left_pad"})
+		}
+		insertTestBeans(t, &RepoDependency{RepoID: 1, Name: "leftxpad", LowerName: "leftxpad"})
+
+		repoIDs := func(userID int64, keyword string, limit int) []int64 {
+			deps, err := SearchOrgDependencies(orgID, userID, keyword, limit)
+			So(err, ShouldBeNil)
+			ids := make([]int64, len(deps))
+			for i := range deps {
+				So(deps[i].Repo, ShouldNotBeNil)
+				ids[i] = deps[i].Repo.ID
+			}
+			return ids
+		}
+
+		So(repoIDs(userID, "left_pad", 2), ShouldResemble, []int64{2, 1})
+		So(repoIDs(0, "left_pad", 2), ShouldResemble, []int64{1})
+		So(repoIDs(botID, "left_pad", 3), ShouldResemble, []int64{3, 3, 3})
+
+		// Wildcards in the keyword are matched literally.
+		So(repoIDs(0, "left_", 10), ShouldResemble, []int64{1})
+		So(repoIDs(0, "left%pad", 10), ShouldBeEmpty)
+		So(repoIDs(0, "  ", 10), ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/process"
+)
+
+// DirStat represents the number of files and their total size in bytes under a
+// directory, including all of its subdirectories.
+type DirStat struct {
+	Path  string
+	Files int64
+	Size  int64
+}
+
+// aggregateDirStats aggregates output of "git ls-tree -r -l -z" by directories
+// up to given depth, and returns them in descending order of file count. Files
+// at the root of the tree are not counted for any directory.
+func aggregateDirStats(lsTree string, depth int) []DirStat {
+	if depth < 1 {
+		depth = 1
+	}
+
+	stats := make(map[string]*DirStat)
+	for _, entry := range strings.Split(lsTree, "\x00") {
+		// Format: "<mode> SP <type> SP <object> SP+ <size> TAB <path>"
+		i := strings.IndexByte(entry, '\t')
+		if i < 0 {
+			continue
+		}
+		fields := strings.Fields(entry[:i])
+		if len(fields) != 4 || fields[1] != "blob" {
+			continue
+		}
+		size, _ := strconv.ParseInt(fields[3], 10, 64)
+
+		dirs := strings.Split(entry[i+1:], "/")
+		dirs = dirs[:len(dirs)-1]
+		if len(dirs) > depth {
+			dirs = dirs[:depth]
+		}
+		for j := range dirs {
+			dir := strings.Join(dirs[:j+1], "/")
+			stat := stats[dir]
+			if stat == nil {
+				stat = &DirStat{Path: dir}
+				stats[dir] = stat
+			}
+			stat.Files++
+			stat.Size += size
+		}
+	}
+
+	dirStats := make([]DirStat, 0, len(stats))
+	for _, stat := range stats {
+		dirStats = append(dirStats, *stat)
+	}
+	sort.Slice(dirStats, func(i, j int) bool {
+		if dirStats[i].Files != dirStats[j].Files {
+			return dirStats[i].Files > dirStats[j].Files
+		} else if dirStats[i].Size != dirStats[j].Size {
+			return dirStats[i].Size > dirStats[j].Size
+		}
+		return dirStats[i].Path < dirStats[j].Path
+	})
+	return dirStats
+}
+
+// DirectorySizes returns file counts and sizes of directories in the tree of
+// given reference, up to the depth of "[repository] DIRECTORY_STATS_DEPTH", and
+// in descending order of file count.
+func (repo *Repository) DirectorySizes(ref string) ([]DirStat, error) {
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return nil, fmt.Errorf("invalid reference %q", ref)
+	}
+
+	repoPath := repo.RepoPath()
+	stdout, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("DirectorySizes 'git ls-tree': %s", repoPath),
+		"git", "ls-tree", "-r", "-l", "-z", ref)
+	if err != nil {
+		return nil, fmt.Errorf("git ls-tree: %v - %s", err, stderr)
+	}
+	return aggregateDirStats(stdout, conf.Repository.DirectoryStatsDepth), nil
+}
+
+// DirectorySizesCacheKey returns key used for cache directory sizes of the
+// tree of given commit.
+func (repo *Repository) DirectorySizesCacheKey(commitID string) string {
+	return fmt.Sprintf("DirectorySizes_%d_%s", repo.ID, commitID)
+}
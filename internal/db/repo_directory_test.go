@@ -0,0 +1,39 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_aggregateDirStats(t *testing.T) {
+	Convey("Aggregate file counts and sizes by directories", t, func() {
+		lsTree := strings.Join([]string{
+			"100644 blob 8c8b2ee2cb1ad8be9ca79be5c7bd4c9e9d2a5c34     120\tREADME.md",
+			"100644 blob 2d0b87fa0b1a9a82e3b25c6a2a2e5b3e6dbae4e6      30\tcmd/main.go",
+			"100644 blob 6f5e8e0dbd9c0e4b0ab7a01ebc1b5d7f4fba6c5e     100\tinternal/db/repo.go",
+			"100644 blob 5bd5b4fb1d1e62e4c5e4b2d5c2d9a5a3e7d1f2b9     200\tinternal/db/user.go",
+			"100644 blob 0a4e5b0e1d2c3b4a5968778695a4b3c2d1e0f9a8      50\tinternal/db/migrations/v1.go",
+			"100644 blob 1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c      10\tinternal/conf/conf.go",
+			"160000 commit 9f8e7d6c5b4a39281706f5e4d3c2b1a0f9e8d7c6       -\tvendor/lib",
+			"",
+		}, "\x00")
+
+		So(aggregateDirStats(lsTree, 1), ShouldResemble, []DirStat{
+			{Path: "internal", Files: 4, Size: 360},
+			{Path: "cmd", Files: 1, Size: 30},
+		})
+		So(aggregateDirStats(lsTree, 2), ShouldResemble, []DirStat{
+			{Path: "internal", Files: 4, Size: 360},
+			{Path: "internal/db", Files: 3, Size: 350},
+			{Path: "cmd", Files: 1, Size: 30},
+			{Path: "internal/conf", Files: 1, Size: 10},
+		})
+		So(aggregateDirStats("", 2), ShouldBeEmpty)
+	})
+}
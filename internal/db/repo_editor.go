@@ -37,23 +37,38 @@ const (
 	ENV_REPO_ID                = "GOGS_REPO_ID"
 	ENV_REPO_NAME              = "GOGS_REPO_NAME"
 	ENV_REPO_CUSTOM_HOOKS_PATH = "GOGS_REPO_CUSTOM_HOOKS_PATH"
+	ENV_AUTH_CREDENTIAL_TYPE   = "GOGS_AUTH_CREDENTIAL_TYPE"
+	ENV_AUTH_CREDENTIAL_ID     = "GOGS_AUTH_CREDENTIAL_ID"
+	ENV_AUTH_CREDENTIAL_NAME   = "GOGS_AUTH_CREDENTIAL_NAME"
+	ENV_REMOTE_ADDR            = "GOGS_REMOTE_ADDR"
 )
 
 type ComposeHookEnvsOptions struct {
-	AuthUser  *User
-	OwnerName string
-	OwnerSalt string
-	RepoID    int64
-	RepoName  string
-	RepoPath  string
+	// AuthUser is nil for pushes with deploy keys.
+	AuthUser   *User
+	Credential PushCredential
+	RemoteAddr string
+	OwnerName  string
+	OwnerSalt  string
+	RepoID     int64
+	RepoName   string
+	RepoPath   string
 }
 
 func ComposeHookEnvs(opts ComposeHookEnvsOptions) []string {
+	authUser := opts.AuthUser
+	if authUser == nil {
+		authUser = &User{}
+	}
 	envs := []string{
 		"SSH_ORIGINAL_COMMAND=1",
-		ENV_AUTH_USER_ID + "=" + com.ToStr(opts.AuthUser.ID),
-		ENV_AUTH_USER_NAME + "=" + opts.AuthUser.Name,
-		ENV_AUTH_USER_EMAIL + "=" + opts.AuthUser.Email,
+		ENV_AUTH_USER_ID + "=" + com.ToStr(authUser.ID),
+		ENV_AUTH_USER_NAME + "=" + authUser.Name,
+		ENV_AUTH_USER_EMAIL + "=" + authUser.Email,
+		ENV_AUTH_CREDENTIAL_TYPE + "=" + string(opts.Credential.Type),
+		ENV_AUTH_CREDENTIAL_ID + "=" + com.ToStr(opts.Credential.ID),
+		ENV_AUTH_CREDENTIAL_NAME + "=" + opts.Credential.Name,
+		ENV_REMOTE_ADDR + "=" + opts.RemoteAddr,
 		ENV_REPO_OWNER_NAME + "=" + opts.OwnerName,
 		ENV_REPO_OWNER_SALT_MD5 + "=" + tool.MD5(opts.OwnerSalt),
 		ENV_REPO_ID + "=" + com.ToStr(opts.RepoID),
@@ -117,6 +132,8 @@ type UpdateRepoFileOptions struct {
 	Message      string
 	Content      string
 	IsNewFile    bool
+	// RemoteAddr is the address of the request that made the change.
+	RemoteAddr string
 }
 
 // UpdateRepoFile adds or updates a file in repository.
@@ -185,12 +202,14 @@ func (repo *Repository) UpdateRepoFile(doer *User, opts UpdateRepoFileOptions) (
 		return fmt.Errorf("commit changes on %q: %v", localPath, err)
 	} else if err = git.PushWithEnvs(localPath, "origin", opts.NewBranch,
 		ComposeHookEnvs(ComposeHookEnvsOptions{
-			AuthUser:  doer,
-			OwnerName: repo.MustOwner().Name,
-			OwnerSalt: repo.MustOwner().Salt,
-			RepoID:    repo.ID,
-			RepoName:  repo.Name,
-			RepoPath:  repo.RepoPath(),
+			AuthUser:   doer,
+			Credential: PushCredential{Type: PushCredentialWeb},
+			RemoteAddr: opts.RemoteAddr,
+			OwnerName:  repo.MustOwner().Name,
+			OwnerSalt:  repo.MustOwner().Salt,
+			RepoID:     repo.ID,
+			RepoName:   repo.Name,
+			RepoPath:   repo.RepoPath(),
 		})); err != nil {
 		return fmt.Errorf("git push origin %s: %v", opts.NewBranch, err)
 	}
@@ -257,6 +276,8 @@ type DeleteRepoFileOptions struct {
 	NewBranch    string
 	TreePath     string
 	Message      string
+	// RemoteAddr is the address of the request that made the change.
+	RemoteAddr string
 }
 
 func (repo *Repository) DeleteRepoFile(doer *User, opts DeleteRepoFileOptions) (err error) {
@@ -289,12 +310,14 @@ func (repo *Repository) DeleteRepoFile(doer *User, opts DeleteRepoFileOptions) (
 		return fmt.Errorf("commit changes to %q: %v", localPath, err)
 	} else if err = git.PushWithEnvs(localPath, "origin", opts.NewBranch,
 		ComposeHookEnvs(ComposeHookEnvsOptions{
-			AuthUser:  doer,
-			OwnerName: repo.MustOwner().Name,
-			OwnerSalt: repo.MustOwner().Salt,
-			RepoID:    repo.ID,
-			RepoName:  repo.Name,
-			RepoPath:  repo.RepoPath(),
+			AuthUser:   doer,
+			Credential: PushCredential{Type: PushCredentialWeb},
+			RemoteAddr: opts.RemoteAddr,
+			OwnerName:  repo.MustOwner().Name,
+			OwnerSalt:  repo.MustOwner().Salt,
+			RepoID:     repo.ID,
+			RepoName:   repo.Name,
+			RepoPath:   repo.RepoPath(),
 		})); err != nil {
 		return fmt.Errorf("git push origin %s: %v", opts.NewBranch, err)
 	}
@@ -442,6 +465,8 @@ type UploadRepoFileOptions struct {
 	TreePath     string
 	Message      string
 	Files        []string // In UUID format
+	// RemoteAddr is the address of the request that made the change.
+	RemoteAddr string
 }
 
 // isRepositoryGitPath returns true if given path is or resides inside ".git" path of the repository.
@@ -505,12 +530,14 @@ func (repo *Repository) UploadRepoFiles(doer *User, opts UploadRepoFileOptions)
 		return fmt.Errorf("commit changes on %q: %v", localPath, err)
 	} else if err = git.PushWithEnvs(localPath, "origin", opts.NewBranch,
 		ComposeHookEnvs(ComposeHookEnvsOptions{
-			AuthUser:  doer,
-			OwnerName: repo.MustOwner().Name,
-			OwnerSalt: repo.MustOwner().Salt,
-			RepoID:    repo.ID,
-			RepoName:  repo.Name,
-			RepoPath:  repo.RepoPath(),
+			AuthUser:   doer,
+			Credential: PushCredential{Type: PushCredentialWeb},
+			RemoteAddr: opts.RemoteAddr,
+			OwnerName:  repo.MustOwner().Name,
+			OwnerSalt:  repo.MustOwner().Salt,
+			RepoID:     repo.ID,
+			RepoName:   repo.Name,
+			RepoPath:   repo.RepoPath(),
 		})); err != nil {
 		return fmt.Errorf("git push origin %s: %v", opts.NewBranch, err)
 	}
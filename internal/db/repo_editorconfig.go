@@ -0,0 +1,198 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"container/list"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/editorconfig/editorconfig-core-go/v2"
+	"github.com/gogs/git-module"
+)
+
+// editorconfigCacheSize is the maximum number of parsed .editorconfig files
+// kept in memory.
+const editorconfigCacheSize = 1000
+
+type editorconfigCacheKey struct {
+	repoID   int64
+	commitID string
+	dir      string
+}
+
+type editorconfigCacheEntry struct {
+	key editorconfigCacheKey
+	ec  *editorconfig.Editorconfig
+	err error
+}
+
+// editorconfigCache keeps the least recently used .editorconfig files parsed
+// from trees of commits. Since the tree of a commit never changes, an entry
+// only goes stale when the branch moves to another commit, which is then
+// looked up by its own key.
+var editorconfigCache = struct {
+	sync.Mutex
+	ll      *list.List
+	entries map[editorconfigCacheKey]*list.Element
+}{
+	ll:      list.New(),
+	entries: make(map[editorconfigCacheKey]*list.Element),
+}
+
+func getEditorconfigCache(key editorconfigCacheKey) (*editorconfigCacheEntry, bool) {
+	editorconfigCache.Lock()
+	defer editorconfigCache.Unlock()
+
+	elem, ok := editorconfigCache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	editorconfigCache.ll.MoveToFront(elem)
+	return elem.Value.(*editorconfigCacheEntry), true
+}
+
+func putEditorconfigCache(entry *editorconfigCacheEntry) {
+	editorconfigCache.Lock()
+	defer editorconfigCache.Unlock()
+
+	if elem, ok := editorconfigCache.entries[entry.key]; ok {
+		elem.Value = entry
+		editorconfigCache.ll.MoveToFront(elem)
+		return
+	}
+	editorconfigCache.entries[entry.key] = editorconfigCache.ll.PushFront(entry)
+	for editorconfigCache.ll.Len() > editorconfigCacheSize {
+		oldest := editorconfigCache.ll.Back()
+		editorconfigCache.ll.Remove(oldest)
+		delete(editorconfigCache.entries, oldest.Value.(*editorconfigCacheEntry).key)
+	}
+}
+
+// ClearEditorconfigCache removes parsed .editorconfig files of the repository
+// with given ID from the cache.
+func ClearEditorconfigCache(repoID int64) {
+	editorconfigCache.Lock()
+	defer editorconfigCache.Unlock()
+
+	for key, elem := range editorconfigCache.entries {
+		if key.repoID == repoID {
+			editorconfigCache.ll.Remove(elem)
+			delete(editorconfigCache.entries, key)
+		}
+	}
+}
+
+// parseEditorconfig parses the .editorconfig file in the directory of the tree
+// of the commit.
+func parseEditorconfig(gitRepo *git.Repository, commitID, dir string) (*editorconfig.Editorconfig, error) {
+	commit, err := gitRepo.GetCommit(commitID)
+	if err != nil {
+		return nil, err
+	}
+	treeEntry, err := commit.GetTreeEntryByPath(path.Join(dir, ".editorconfig"))
+	if err != nil {
+		return nil, err
+	}
+	reader, err := treeEntry.Blob().Data()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return editorconfig.ParseBytes(data)
+}
+
+// getEditorconfigFile returns the parsed .editorconfig file in the directory of
+// the tree of given commit. Parsed files are cached by the commit ID and the
+// directory, so is the absence of the file.
+func (repo *Repository) getEditorconfigFile(gitRepo *git.Repository, commitID, dir string) (*editorconfig.Editorconfig, error) {
+	key := editorconfigCacheKey{repoID: repo.ID, commitID: commitID, dir: dir}
+	if entry, ok := getEditorconfigCache(key); ok {
+		return entry.ec, entry.err
+	}
+
+	ec, err := parseEditorconfig(gitRepo, commitID, dir)
+	if err != nil && !git.IsErrNotExist(err) {
+		return nil, err
+	}
+	putEditorconfigCache(&editorconfigCacheEntry{
+		key: key,
+		ec:  ec,
+		err: err,
+	})
+	return ec, err
+}
+
+// editorconfigSelector returns the selector of the section of the .editorconfig
+// file in the directory, made relative to the root of the tree. Special
+// characters in names of directories are taken as part of the pattern, as the
+// matcher has no way to escape them.
+func editorconfigSelector(dir, selector string) string {
+	prefix := "/"
+	if dir != "" {
+		prefix += dir + "/"
+	}
+	switch {
+	case strings.HasPrefix(selector, "/"):
+		return prefix + selector[1:]
+	case strings.ContainsRune(selector, '/'):
+		return prefix + selector
+	default:
+		return prefix + "**/" + selector
+	}
+}
+
+// GetEditorconfig returns the .editorconfig definitions that apply to files in
+// the directory of the tree of given commit. As editors do, .editorconfig files
+// are looked up from the directory to the root of the tree until one declares
+// "root = true", and closer files take precedence. Sections of all files found
+// are combined with selectors relative to the root of the tree, so definitions
+// must be looked up by full paths of files. It returns git.ErrNotExist if no
+// .editorconfig file is found.
+func (repo *Repository) GetEditorconfig(gitRepo *git.Repository, commitID, dir string) (*editorconfig.Editorconfig, error) {
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+
+	var files []*editorconfig.Editorconfig
+	var dirs []string
+	for {
+		ec, err := repo.getEditorconfigFile(gitRepo, commitID, dir)
+		if err == nil {
+			files = append(files, ec)
+			dirs = append(dirs, dir)
+			if ec.Root {
+				break
+			}
+		} else if !git.IsErrNotExist(err) {
+			return nil, err
+		}
+
+		if dir == "" {
+			break
+		}
+		dir = path.Dir(dir)
+		if dir == "." {
+			dir = ""
+		}
+	}
+	if len(files) == 0 {
+		return nil, git.ErrNotExist{ID: commitID, RelPath: ".editorconfig"}
+	}
+
+	// Later sections take precedence, so files closer to the directory go last.
+	combined := &editorconfig.Editorconfig{Root: true}
+	for i := len(files) - 1; i >= 0; i-- {
+		for _, def := range files[i].Definitions {
+			d := *def
+			d.Selector = editorconfigSelector(dirs[i], def.Selector)
+			combined.Definitions = append(combined.Definitions, &d)
+		}
+	}
+	return combined, nil
+}
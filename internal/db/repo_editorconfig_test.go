@@ -0,0 +1,220 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/editorconfig/editorconfig-core-go/v2"
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+// newEditorconfigRepo creates a repository with a commit for each given set of
+// .editorconfig files, keyed by their directories, and returns IDs of the
+// commits. Files not in the set are removed by the commit.
+func newEditorconfigRepo(tb testing.TB, files ...map[string]string) (*git.Repository, []string) {
+	repo := testutil.InitGitRepo(tb, "")
+	dir := repo.Dir
+
+	commits := make([]string, len(files))
+	for i := range files {
+		if i > 0 {
+			repo.Git("rm", "-r", "--quiet", "--ignore-unmatch", ".")
+		}
+		for treeDir, content := range files[i] {
+			repo.WriteFile(path.Join(treeDir, ".editorconfig"), content)
+		}
+		commits[i] = repo.Commit(fmt.Sprintf("commit %d", i))
+	}
+
+	gitRepo, err := git.OpenRepository(dir)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return gitRepo, commits
+}
+
+func Test_GetEditorconfig(t *testing.T) {
+	gitRepo, commits := newEditorconfigRepo(t,
+		map[string]string{"": "[*.go]\nindent_style = tab\n"},
+		map[string]string{"": "[*.go]\nindent_style = space\n"},
+		nil,
+	)
+	repo := &Repository{ID: 1001}
+	defer ClearEditorconfigCache(repo.ID)
+
+	indentStyle := func(commitID string) string {
+		ec, err := repo.GetEditorconfig(gitRepo, commitID, "")
+		So(err, ShouldBeNil)
+		def, err := ec.GetDefinitionForFilename("main.go")
+		So(err, ShouldBeNil)
+		return def.IndentStyle
+	}
+
+	Convey("Parse .editorconfig by commits", t, func() {
+		So(indentStyle(commits[0]), ShouldEqual, "tab")
+
+		ec, err := repo.getEditorconfigFile(gitRepo, commits[0], "")
+		So(err, ShouldBeNil)
+		cached, err := repo.getEditorconfigFile(gitRepo, commits[0], "")
+		So(err, ShouldBeNil)
+		So(cached, ShouldEqual, ec)
+
+		Convey("A new commit busts the cache", func() {
+			So(indentStyle(commits[1]), ShouldEqual, "space")
+		})
+
+		Convey("The absence of the file is cached", func() {
+			_, err := repo.GetEditorconfig(gitRepo, commits[2], "")
+			So(git.IsErrNotExist(err), ShouldBeTrue)
+			_, ok := getEditorconfigCache(editorconfigCacheKey{repoID: repo.ID, commitID: commits[2]})
+			So(ok, ShouldBeTrue)
+		})
+	})
+
+	Convey("Clear cache of a repository", t, func() {
+		_, err := repo.GetEditorconfig(gitRepo, commits[0], "")
+		So(err, ShouldBeNil)
+		ClearEditorconfigCache(repo.ID)
+		_, ok := getEditorconfigCache(editorconfigCacheKey{repoID: repo.ID, commitID: commits[0]})
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func Test_GetEditorconfig_nested(t *testing.T) {
+	gitRepo, commits := newEditorconfigRepo(t,
+		map[string]string{
+			"":        "root = true\n\n[*]\nindent_style = tab\ntab_width = 8\n\n[*.md]\nindent_style = space\nindent_size = 2\n",
+			"web":     "[*.js]\nindent_style = space\nindent_size = 4\n\n[lib/*.js]\nindent_size = 2\n",
+			"web/lib": "[*]\ntab_width = 4\n\n[vendor.js]\nindent_style = tab\n",
+			"vendor":  "root = true\n\n[*]\nindent_style = space\n",
+		},
+		// The root .editorconfig is removed by the branch.
+		map[string]string{
+			"web": "[*.js]\nindent_style = space\nindent_size = 4\n",
+		},
+	)
+	repo := &Repository{ID: 1003}
+	defer ClearEditorconfigCache(repo.ID)
+
+	definition := func(commitID, treePath string) *editorconfig.Definition {
+		ec, err := repo.GetEditorconfig(gitRepo, commitID, path.Dir(treePath))
+		So(err, ShouldBeNil)
+		def, err := ec.GetDefinitionForFilename(treePath)
+		So(err, ShouldBeNil)
+		return def
+	}
+
+	Convey("Resolve .editorconfig files from the directory to the root", t, func() {
+		def := definition(commits[0], "main.go")
+		So(def.IndentStyle, ShouldEqual, "tab")
+		So(def.TabWidth, ShouldEqual, 8)
+
+		So(definition(commits[0], "docs/README.md").IndentSize, ShouldEqual, "2")
+
+		Convey("Closer files take precedence", func() {
+			def := definition(commits[0], "web/app.js")
+			So(def.IndentStyle, ShouldEqual, "space")
+			So(def.IndentSize, ShouldEqual, "4")
+			// Tab width defaults to the indent size of the same section.
+			So(def.TabWidth, ShouldEqual, 4)
+
+			def = definition(commits[0], "web/README.md")
+			So(def.IndentSize, ShouldEqual, "2")
+			So(def.TabWidth, ShouldEqual, 2)
+
+			def = definition(commits[0], "web/lib/util.js")
+			So(def.IndentStyle, ShouldEqual, "space")
+			So(def.IndentSize, ShouldEqual, "2")
+			So(def.TabWidth, ShouldEqual, 4)
+
+			def = definition(commits[0], "web/lib/vendor.js")
+			So(def.IndentStyle, ShouldEqual, "tab")
+		})
+
+		Convey("Sections only apply to files under their directories", func() {
+			So(definition(commits[0], "app.js").IndentStyle, ShouldEqual, "tab")
+			So(definition(commits[0], "lib/util.js").IndentSize, ShouldBeEmpty)
+		})
+
+		Convey("Lookup stops at the root file", func() {
+			def := definition(commits[0], "vendor/lib.go")
+			So(def.IndentStyle, ShouldEqual, "space")
+			So(def.TabWidth, ShouldEqual, 0)
+		})
+	})
+
+	Convey("Resolve .editorconfig files on a branch without the root one", t, func() {
+		def := definition(commits[1], "web/app.js")
+		So(def.IndentStyle, ShouldEqual, "space")
+		So(def.TabWidth, ShouldEqual, 4)
+
+		_, err := repo.GetEditorconfig(gitRepo, commits[1], "")
+		So(git.IsErrNotExist(err), ShouldBeTrue)
+	})
+}
+
+func Test_editorconfigSelector(t *testing.T) {
+	Convey("Make selectors relative to the root of the tree", t, func() {
+		So(editorconfigSelector("", "*.go"), ShouldEqual, "/**/*.go")
+		So(editorconfigSelector("", "lib/*.go"), ShouldEqual, "/lib/*.go")
+		So(editorconfigSelector("", "/main.go"), ShouldEqual, "/main.go")
+		So(editorconfigSelector("web/lib", "*.js"), ShouldEqual, "/web/lib/**/*.js")
+		So(editorconfigSelector("web/lib", "vendor/*.js"), ShouldEqual, "/web/lib/vendor/*.js")
+		So(editorconfigSelector("web/lib", "/index.js"), ShouldEqual, "/web/lib/index.js")
+	})
+}
+
+func Test_editorconfigCacheEviction(t *testing.T) {
+	Convey("Evict the least recently used entries", t, func() {
+		defer func() {
+			for i := 0; i <= editorconfigCacheSize; i++ {
+				ClearEditorconfigCache(int64(-i))
+			}
+		}()
+
+		for i := 0; i <= editorconfigCacheSize; i++ {
+			putEditorconfigCache(&editorconfigCacheEntry{key: editorconfigCacheKey{repoID: int64(-i)}})
+			if i == 0 {
+				continue
+			}
+			// Keep the first entry recently used.
+			_, ok := getEditorconfigCache(editorconfigCacheKey{repoID: 0})
+			So(ok, ShouldBeTrue)
+		}
+
+		_, ok := getEditorconfigCache(editorconfigCacheKey{repoID: -1})
+		So(ok, ShouldBeFalse)
+		_, ok = getEditorconfigCache(editorconfigCacheKey{repoID: int64(-editorconfigCacheSize)})
+		So(ok, ShouldBeTrue)
+	})
+}
+
+func Benchmark_GetEditorconfig(b *testing.B) {
+	gitRepo, commits := newEditorconfigRepo(b, map[string]string{"": "root = true\n\n[*]\nindent_style = tab\n\n[*.md]\nindent_style = space\nindent_size = 2\n"})
+	repo := &Repository{ID: 1002}
+	defer ClearEditorconfigCache(repo.ID)
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := parseEditorconfig(gitRepo, commits[0], ""); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetEditorconfig(gitRepo, commits[0], ""); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
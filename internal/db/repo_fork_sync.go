@@ -0,0 +1,256 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogs/git-module"
+	"github.com/unknwon/com"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/sync"
+)
+
+// ForkSyncStatus is the status of a branch of a fork relative to the branch it
+// follows in the upstream, i.e. the base repository.
+type ForkSyncStatus struct {
+	Branch           string
+	UpstreamBranch   string
+	UpstreamCommitID string
+	// Ahead is the number of commits in the branch but not in the upstream.
+	Ahead int
+	// Behind is the number of commits in the upstream but not in the branch.
+	Behind int
+}
+
+// IsBehind returns true if the upstream has commits that are not in the branch.
+func (s *ForkSyncStatus) IsBehind() bool {
+	return s.Behind > 0
+}
+
+// IsDiverged returns true if the branch cannot be fast-forwarded to the
+// upstream because both have commits that are not in the other.
+func (s *ForkSyncStatus) IsDiverged() bool {
+	return s.Ahead > 0 && s.Behind > 0
+}
+
+// UpstreamBranchOf returns the branch of the upstream that the branch of the
+// fork follows. The default branch follows the default branch of the upstream,
+// and other branches follow upstream branches with the same names.
+//
+// Required - BaseRepo
+func (repo *Repository) UpstreamBranchOf(branch string) string {
+	if branch == repo.DefaultBranch {
+		return repo.BaseRepo.DefaultBranch
+	}
+	return branch
+}
+
+// parseLeftRightCount parses the output of "git rev-list --left-right --count".
+func parseLeftRightCount(stdout string) (left, right int, err error) {
+	fields := strings.Fields(stdout)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("unexpected output: %q", stdout)
+	}
+	if left, err = strconv.Atoi(fields[0]); err != nil {
+		return 0, 0, err
+	}
+	if right, err = strconv.Atoi(fields[1]); err != nil {
+		return 0, 0, err
+	}
+	return left, right, nil
+}
+
+// ForkFetchQueue is the queue of forks whose upstream branches need to be
+// fetched to count commits that they are behind, in the form of
+// "<repo_id>:<upstream branch>".
+var ForkFetchQueue = sync.NewUniqueQueue(1000)
+
+// forkFetchInterval is the minimum interval between two fetches of the same
+// upstream branch into the same fork by ForkFetchQueue.
+const forkFetchInterval = 5 * time.Minute
+
+// forkFetchedAt records when upstream branches were last fetched into forks by
+// ForkFetchQueue. Records older than forkFetchInterval are pruned as new ones
+// are added. It is only accessed by FetchForkUpstreams.
+var forkFetchedAt = make(map[string]time.Time)
+
+// shouldFetchFork returns true and records the attempt if the fetch of the key
+// has not been attempted within forkFetchInterval.
+func shouldFetchFork(key string, now time.Time) bool {
+	for k, t := range forkFetchedAt {
+		if now.Sub(t) >= forkFetchInterval {
+			delete(forkFetchedAt, k)
+		}
+	}
+	if _, ok := forkFetchedAt[key]; ok {
+		return false
+	}
+	forkFetchedAt[key] = now
+	return true
+}
+
+// fetchUpstreamBranch fetches the upstream branch into the fork.
+//
+// Required - BaseRepo
+func (repo *Repository) fetchUpstreamBranch(upstreamBranch string) error {
+	_, err := git.NewCommand("fetch", "--no-tags", "--quiet", repo.BaseRepo.RepoPath(), git.BRANCH_PREFIX+upstreamBranch).
+		RunInDir(repo.RepoPath())
+	if err != nil {
+		return fmt.Errorf("fetch upstream branch %q: %v", upstreamBranch, err)
+	}
+	return nil
+}
+
+// FetchForkUpstreams fetches upstream branches of forks in the queue.
+func FetchForkUpstreams() {
+	for key := range ForkFetchQueue.Queue() {
+		log.Trace("FetchForkUpstreams: %s", key)
+		ForkFetchQueue.Process(key, func() {
+			if !shouldFetchFork(key, time.Now()) {
+				return
+			}
+
+			fields := strings.SplitN(key, ":", 2)
+			if len(fields) != 2 {
+				log.Error("FetchForkUpstreams: malformed key %q", key)
+				return
+			}
+			repo, err := GetRepositoryByID(com.StrTo(fields[0]).MustInt64())
+			if err != nil {
+				log.Error("GetRepositoryByID [%s]: %v", fields[0], err)
+				return
+			} else if err = repo.LoadAttributes(); err != nil {
+				log.Error("LoadAttributes [repo_id: %d]: %v", repo.ID, err)
+				return
+			} else if repo.BaseRepo == nil {
+				return
+			}
+
+			if err = repo.fetchUpstreamBranch(fields[1]); err != nil {
+				log.Error("Failed to fetch upstream of fork [repo_id: %d]: %v", repo.ID, err)
+			}
+		})
+	}
+}
+
+func InitFetchForkUpstreams() {
+	go FetchForkUpstreams()
+}
+
+// GetForkSyncStatus returns the status of the branch of the fork relative to
+// its upstream branch. When commits of the upstream branch are not in the fork
+// yet, they are fetched by ForkFetchQueue in the background, and it returns
+// ErrForkUpstreamNotFetched until they are.
+func (repo *Repository) GetForkSyncStatus(branch string) (*ForkSyncStatus, error) {
+	return repo.getForkSyncStatus(branch, false)
+}
+
+// getForkSyncStatus returns the status of the branch of the fork relative to
+// its upstream branch. Missing commits of the upstream branch are fetched
+// right away if fetch is true.
+func (repo *Repository) getForkSyncStatus(branch string, fetch bool) (*ForkSyncStatus, error) {
+	if err := repo.LoadAttributes(); err != nil {
+		return nil, fmt.Errorf("LoadAttributes: %v", err)
+	} else if !repo.IsFork || repo.BaseRepo == nil {
+		return nil, ErrNotFork{RepoID: repo.ID}
+	}
+
+	repoPath := repo.RepoPath()
+	if !git.IsBranchExist(repoPath, branch) {
+		return nil, errors.ErrBranchNotExist{Name: branch}
+	}
+
+	upstreamBranch := repo.UpstreamBranchOf(branch)
+	baseGitRepo, err := git.OpenRepository(repo.BaseRepo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("OpenRepository: %v", err)
+	}
+	upstreamCommitID, err := baseGitRepo.GetBranchCommitID(upstreamBranch)
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			return nil, errors.ErrBranchNotExist{Name: upstreamBranch}
+		}
+		return nil, fmt.Errorf("GetBranchCommitID [%s]: %v", upstreamBranch, err)
+	}
+
+	if _, err = git.NewCommand("cat-file", "-e", upstreamCommitID+"^{commit}").RunInDir(repoPath); err != nil {
+		if !fetch {
+			go ForkFetchQueue.Add(fmt.Sprintf("%d:%s", repo.ID, upstreamBranch))
+			return nil, ErrForkUpstreamNotFetched{RepoID: repo.ID, Branch: upstreamBranch}
+		} else if err = repo.fetchUpstreamBranch(upstreamBranch); err != nil {
+			return nil, err
+		}
+	}
+
+	stdout, err := git.NewCommand("rev-list", "--left-right", "--count", upstreamCommitID+"..."+git.BRANCH_PREFIX+branch).
+		RunInDir(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("count commits: %v", err)
+	}
+	behind, ahead, err := parseLeftRightCount(stdout)
+	if err != nil {
+		return nil, fmt.Errorf("parse commit counts: %v", err)
+	}
+
+	return &ForkSyncStatus{
+		Branch:           branch,
+		UpstreamBranch:   upstreamBranch,
+		UpstreamCommitID: upstreamCommitID,
+		Ahead:            ahead,
+		Behind:           behind,
+	}, nil
+}
+
+// SyncFork fast-forwards the branch of the fork to its upstream branch. The
+// update is pushed through Git hooks on behalf of the doer like any other push,
+// so that branch protection is respected and push webhooks are sent. It returns
+// ErrForkDiverged when the branch has commits that are not in the upstream. The
+// credential and the remote address are of the request that asked for the sync.
+func SyncFork(doer *User, repo *Repository, branch string, credential PushCredential, remoteAddr string) (*ForkSyncStatus, error) {
+	repoWorkingPool.CheckIn(com.ToStr(repo.ID))
+	defer repoWorkingPool.CheckOut(com.ToStr(repo.ID))
+
+	status, err := repo.getForkSyncStatus(branch, true)
+	if err != nil {
+		return nil, err
+	} else if !status.IsBehind() {
+		return status, nil
+	} else if status.Ahead > 0 {
+		return nil, ErrForkDiverged{RepoID: repo.ID, Branch: branch}
+	}
+
+	canPush, err := CanUserPushToBranch(repo, doer, branch)
+	if err != nil {
+		return nil, fmt.Errorf("CanUserPushToBranch: %v", err)
+	} else if !canPush {
+		return nil, ErrBranchPushForbidden{RepoID: repo.ID, Branch: branch}
+	}
+
+	// Commits are already fetched into the fork, push them to itself so that
+	// hooks are run.
+	repoPath := repo.RepoPath()
+	if err = git.PushWithEnvs(repoPath, repoPath, status.UpstreamCommitID+":"+git.BRANCH_PREFIX+branch,
+		ComposeHookEnvs(ComposeHookEnvsOptions{
+			AuthUser:   doer,
+			Credential: credential,
+			RemoteAddr: remoteAddr,
+			OwnerName:  repo.Owner.Name,
+			OwnerSalt:  repo.Owner.Salt,
+			RepoID:     repo.ID,
+			RepoName:   repo.Name,
+			RepoPath:   repoPath,
+		})); err != nil {
+		return nil, fmt.Errorf("push to branch %q: %v", branch, err)
+	}
+
+	status.Behind = 0
+	return status, nil
+}
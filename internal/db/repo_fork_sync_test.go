@@ -0,0 +1,109 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_parseLeftRightCount(t *testing.T) {
+	Convey("Parse counts of commits", t, func() {
+		left, right, err := parseLeftRightCount("3\t12\n")
+		So(err, ShouldBeNil)
+		So(left, ShouldEqual, 3)
+		So(right, ShouldEqual, 12)
+	})
+
+	Convey("Reject unexpected output", t, func() {
+		for _, stdout := range []string{"", "3", "3\tx", "1 2 3"} {
+			_, _, err := parseLeftRightCount(stdout)
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
+func Test_ForkSyncStatus(t *testing.T) {
+	Convey("Tell whether the branch is behind or diverged", t, func() {
+		s := &ForkSyncStatus{}
+		So(s.IsBehind(), ShouldBeFalse)
+		So(s.IsDiverged(), ShouldBeFalse)
+
+		s = &ForkSyncStatus{Behind: 2}
+		So(s.IsBehind(), ShouldBeTrue)
+		So(s.IsDiverged(), ShouldBeFalse)
+
+		s = &ForkSyncStatus{Ahead: 1, Behind: 2}
+		So(s.IsBehind(), ShouldBeTrue)
+		So(s.IsDiverged(), ShouldBeTrue)
+	})
+}
+
+func Test_shouldFetchFork(t *testing.T) {
+	defer func() { forkFetchedAt = make(map[string]time.Time) }()
+
+	Convey("Fetch the same upstream branch at most once per interval", t, func() {
+		now := time.Now()
+		So(shouldFetchFork("1:master", now), ShouldBeTrue)
+		So(shouldFetchFork("1:master", now.Add(time.Minute)), ShouldBeFalse)
+		So(shouldFetchFork("2:master", now.Add(time.Minute)), ShouldBeTrue)
+
+		So(shouldFetchFork("1:master", now.Add(forkFetchInterval+time.Minute)), ShouldBeTrue)
+		So(forkFetchedAt, ShouldHaveLength, 1)
+	})
+}
+
+func Test_Repository_GetForkSyncStatus(t *testing.T) {
+	setupTestDB(t)
+	oldRoot := conf.Repository.Root
+	conf.Repository.Root = t.TempDir()
+	defer func() { conf.Repository.Root = oldRoot }()
+
+	alice := &User{Name: "alice", LowerName: "alice"}
+	bob := &User{Name: "bob", LowerName: "bob"}
+	insertTestBeans(t, alice, bob)
+	base := &Repository{OwnerID: alice.ID, Name: "proj", LowerName: "proj", DefaultBranch: "master"}
+	insertTestBeans(t, base)
+	fork := &Repository{OwnerID: bob.ID, Name: "proj", LowerName: "proj", DefaultBranch: "master", IsFork: true, ForkID: base.ID}
+	insertTestBeans(t, fork)
+
+	// The base repository has a commit that is not in the fork.
+	baseGitRepo := testutil.InitGitRepo(t, base.RepoPath())
+	baseGitRepo.CommitFile("README", "init")
+	baseGitRepo.Git("clone", "--quiet", "--bare", base.RepoPath(), fork.RepoPath())
+	baseGitRepo.CommitFile("README", "update")
+
+	key := fmt.Sprintf("%d:master", fork.ID)
+	defer func() { ForkFetchQueue.Remove(key) }()
+
+	Convey("Fetch missing commits of the upstream in the background", t, func() {
+		_, err := fork.GetForkSyncStatus("master")
+		So(IsErrForkUpstreamNotFetched(err), ShouldBeTrue)
+
+		var queued string
+		select {
+		case queued = <-ForkFetchQueue.Queue():
+		case <-time.After(5 * time.Second):
+		}
+		So(queued, ShouldEqual, key)
+	})
+
+	Convey("Fetch missing commits of the upstream right away when syncing", t, func() {
+		status, err := fork.getForkSyncStatus("master", true)
+		So(err, ShouldBeNil)
+		So(status.Behind, ShouldEqual, 1)
+		So(status.Ahead, ShouldEqual, 0)
+
+		status, err = fork.GetForkSyncStatus("master")
+		So(err, ShouldBeNil)
+		So(status.Behind, ShouldEqual, 1)
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/gogs/git-module"
+)
+
+// hooksDrift returns whether each managed hook of the repository at the path
+// differs from the content the server generates, including missing ones.
+func hooksDrift(repoPath string) (map[string]bool, error) {
+	drift := make(map[string]bool, len(git.HookNames))
+	for _, name := range git.HookNames {
+		data, err := ioutil.ReadFile(filepath.Join(repoPath, "hooks", name))
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("read hook %q: %v", name, err)
+			}
+			drift[name] = true
+			continue
+		}
+		drift[name] = string(data) != delegateHookContent(name)
+	}
+	return drift, nil
+}
+
+// HooksDrift returns whether each managed hook in "hooks" directory of the
+// repository differs from the content the server generates, e.g. overwritten
+// by deployments. Custom hooks edited via the web UI are not managed.
+func (repo *Repository) HooksDrift() (map[string]bool, error) {
+	return hooksDrift(repo.RepoPath())
+}
+
+// RestoreHooks rewrites managed hooks of the repository and its wiki.
+func (repo *Repository) RestoreHooks() error {
+	if err := createDelegateHooks(repo.RepoPath()); err != nil {
+		return err
+	}
+	if repo.HasWiki() {
+		return createDelegateHooks(repo.WikiPath())
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_hooksDrift(t *testing.T) {
+	repoPath, err := ioutil.TempDir("", "gogs-hooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoPath)
+	if err = os.MkdirAll(filepath.Join(repoPath, "hooks"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Detect drift of managed hooks", t, func() {
+		So(createDelegateHooks(repoPath), ShouldBeNil)
+		drift, err := hooksDrift(repoPath)
+		So(err, ShouldBeNil)
+		So(drift, ShouldResemble, map[string]bool{
+			"pre-receive":  false,
+			"update":       false,
+			"post-receive": false,
+		})
+
+		So(ioutil.WriteFile(filepath.Join(repoPath, "hooks", "update"), []byte("#!/bin/sh\nexit 0\n"), os.ModePerm), ShouldBeNil)
+		So(os.Remove(filepath.Join(repoPath, "hooks", "post-receive")), ShouldBeNil)
+		drift, err = hooksDrift(repoPath)
+		So(err, ShouldBeNil)
+		So(drift, ShouldResemble, map[string]bool{
+			"pre-receive":  false,
+			"update":       true,
+			"post-receive": true,
+		})
+	})
+}
@@ -0,0 +1,214 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	gouuid "github.com/satori/go.uuid"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/tool"
+)
+
+// RepoInvite represents a pending invitation to collaborate on a repository,
+// which is sent to an email address that has not been registered yet.
+type RepoInvite struct {
+	ID        int64
+	RepoID    int64      `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	Email     string     `xorm:"UNIQUE(s) NOT NULL"`
+	Token     string     `xorm:"UNIQUE VARCHAR(40) NOT NULL"`
+	Mode      AccessMode `xorm:"DEFAULT 2 NOT NULL"`
+	InviterID int64
+	Inviter   *User `xorm:"-" json:"-"`
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+	Updated     time.Time `xorm:"-" json:"-"` // The last time the invitation was sent.
+	UpdatedUnix int64
+}
+
+func (inv *RepoInvite) BeforeInsert() {
+	inv.CreatedUnix = time.Now().Unix()
+	inv.UpdatedUnix = inv.CreatedUnix
+}
+
+func (inv *RepoInvite) BeforeUpdate() {
+	inv.UpdatedUnix = time.Now().Unix()
+}
+
+func (inv *RepoInvite) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		inv.Created = time.Unix(inv.CreatedUnix, 0).Local()
+	case "updated_unix":
+		inv.Updated = time.Unix(inv.UpdatedUnix, 0).Local()
+	}
+}
+
+func (inv *RepoInvite) ModeI18nKey() string {
+	return (&Collaboration{Mode: inv.Mode}).ModeI18nKey()
+}
+
+func (inv *RepoInvite) loadAttributes(e Engine) (err error) {
+	if inv.Inviter == nil {
+		inv.Inviter, err = getUserByID(e, inv.InviterID)
+		if err != nil {
+			if !errors.IsUserNotExist(err) {
+				return fmt.Errorf("getUserByID.(Inviter) [%d]: %v", inv.InviterID, err)
+			}
+			inv.Inviter = NewGhostUser()
+		}
+	}
+	return nil
+}
+
+// InviteCollaborator creates an invitation for the email address to collaborate
+// on the repository with given access mode. It returns the existing invitation
+// with access mode updated if the email address has already been invited.
+func (repo *Repository) InviteCollaborator(doer *User, email string, mode AccessMode) (*RepoInvite, error) {
+	if mode <= ACCESS_MODE_NONE || mode >= ACCESS_MODE_OWNER {
+		mode = ACCESS_MODE_WRITE
+	}
+
+	inv := &RepoInvite{
+		RepoID: repo.ID,
+		Email:  strings.ToLower(strings.TrimSpace(email)),
+	}
+	has, err := x.Get(inv)
+	if err != nil {
+		return nil, fmt.Errorf("get invitation: %v", err)
+	}
+
+	inv.Mode = mode
+	inv.InviterID = doer.ID
+	inv.Inviter = doer
+	if has {
+		if _, err = x.ID(inv.ID).Cols("mode", "inviter_id", "updated_unix").Update(inv); err != nil {
+			return nil, fmt.Errorf("update invitation: %v", err)
+		}
+		return inv, nil
+	}
+
+	inv.Token = tool.SHA1(gouuid.NewV4().String())
+	if _, err = x.Insert(inv); err != nil {
+		return nil, fmt.Errorf("insert invitation: %v", err)
+	}
+	return inv, nil
+}
+
+// PendingInvites returns invitations to collaborate on the repository that
+// have not been accepted yet.
+func (repo *Repository) PendingInvites() ([]*RepoInvite, error) {
+	invites := make([]*RepoInvite, 0, 5)
+	if err := x.Where("repo_id = ?", repo.ID).Asc("id").Find(&invites); err != nil {
+		return nil, err
+	}
+
+	for i := range invites {
+		if err := invites[i].loadAttributes(x); err != nil {
+			return nil, err
+		}
+	}
+	return invites, nil
+}
+
+// GetInvite returns the invitation to collaborate on the repository by given ID.
+func (repo *Repository) GetInvite(id int64) (*RepoInvite, error) {
+	inv := &RepoInvite{
+		ID:     id,
+		RepoID: repo.ID,
+	}
+	has, err := x.Get(inv)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, errors.RepoInviteNotExist{ID: id}
+	}
+	return inv, inv.loadAttributes(x)
+}
+
+// GetRepoInviteByToken returns the invitation to collaborate on a repository by
+// given token.
+func GetRepoInviteByToken(token string) (*RepoInvite, error) {
+	if token == "" {
+		return nil, errors.RepoInviteNotExist{Token: token}
+	}
+
+	inv := &RepoInvite{Token: token}
+	has, err := x.Get(inv)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, errors.RepoInviteNotExist{Token: token}
+	}
+	return inv, inv.loadAttributes(x)
+}
+
+// ChangeInviteAccessMode sets new access mode for the invitation.
+func (repo *Repository) ChangeInviteAccessMode(id int64, mode AccessMode) error {
+	// Discard invalid input
+	if mode <= ACCESS_MODE_NONE || mode >= ACCESS_MODE_OWNER {
+		return nil
+	}
+
+	_, err := x.Where("id = ? AND repo_id = ?", id, repo.ID).Cols("mode").Update(&RepoInvite{Mode: mode})
+	return err
+}
+
+// MarkInviteSent updates the last time the invitation was sent.
+func MarkInviteSent(inv *RepoInvite) error {
+	_, err := x.ID(inv.ID).Cols("updated_unix").Update(inv)
+	return err
+}
+
+// RevokeInvite deletes the invitation to collaborate on the repository.
+func (repo *Repository) RevokeInvite(id int64) error {
+	if id <= 0 {
+		return errors.RepoInviteNotExist{ID: id}
+	}
+
+	_, err := x.Where("id = ? AND repo_id = ?", id, repo.ID).Delete(new(RepoInvite))
+	return err
+}
+
+// AcceptRepoInvite converts the invitation to a collaboration of the user on the
+// repository at the access mode stored in the invitation. The invited email must
+// be verified by the user, otherwise anyone holding the link could take the seat.
+func AcceptRepoInvite(inv *RepoInvite, u *User) (err error) {
+	verified, err := u.HasVerifiedEmail(inv.Email)
+	if err != nil {
+		return fmt.Errorf("HasVerifiedEmail: %v", err)
+	} else if !verified {
+		return errors.RepoInviteEmailMismatch{Email: inv.Email, UserID: u.ID}
+	}
+
+	repo, err := GetRepositoryByID(inv.RepoID)
+	if err != nil {
+		return fmt.Errorf("GetRepositoryByID [%d]: %v", inv.RepoID, err)
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err = sess.ID(inv.ID).Delete(new(RepoInvite)); err != nil {
+		return fmt.Errorf("delete invitation: %v", err)
+	}
+
+	// The owner of the repository does not need to be a collaborator.
+	if repo.OwnerID != u.ID {
+		if err = repo.addCollaborator(sess, u, inv.Mode); err != nil {
+			return fmt.Errorf("addCollaborator: %v", err)
+		}
+	}
+
+	return sess.Commit()
+}
@@ -0,0 +1,98 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+func Test_AcceptRepoInvite(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "owner", LowerName: "owner", Email: "owner@example.com", IsActive: true}
+	alice := &User{Name: "alice", LowerName: "alice", Email: "alice@example.com", IsActive: true}
+	bob := &User{Name: "bob", LowerName: "bob", Email: "bob@example.com", IsActive: false}
+	insertTestBeans(t, owner, alice, bob)
+	repo := &Repository{OwnerID: owner.ID, Owner: owner, Name: "repo", LowerName: "repo"}
+	insertTestBeans(t, repo)
+	insertTestBeans(t,
+		&EmailAddress{UID: alice.ID, Email: "alice@work.example.com", IsActivated: true},
+		&EmailAddress{UID: alice.ID, Email: "alice@old.example.com", IsActivated: false},
+	)
+
+	invite := func(email string) *RepoInvite {
+		inv, err := repo.InviteCollaborator(owner, email, ACCESS_MODE_READ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return inv
+	}
+
+	Convey("Accept invitations to collaborate on a repository", t, func() {
+		Convey("Refuse users who have not verified the invited email", func() {
+			for _, c := range []struct {
+				email string
+				user  *User
+			}{
+				{"alice@example.com", bob},
+				{"alice@old.example.com", alice},
+				{"bob@example.com", bob},
+			} {
+				inv := invite(c.email)
+				err := AcceptRepoInvite(inv, c.user)
+				So(errors.IsRepoInviteEmailMismatch(err), ShouldBeTrue)
+
+				_, err = GetRepoInviteByToken(inv.Token)
+				So(err, ShouldBeNil)
+				So(IsCollaborator(repo.ID, c.user.ID), ShouldBeFalse)
+			}
+		})
+
+		Convey("Accept verified primary and alternative emails", func() {
+			for _, email := range []string{"Alice@Example.com", "alice@work.example.com"} {
+				inv := invite(email)
+				So(AcceptRepoInvite(inv, alice), ShouldBeNil)
+
+				_, err := GetRepoInviteByToken(inv.Token)
+				So(errors.IsRepoInviteNotExist(err), ShouldBeTrue)
+			}
+			So(IsCollaborator(repo.ID, alice.ID), ShouldBeTrue)
+		})
+	})
+}
+
+func Test_Repository_ChangeInviteAccessMode(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "owner", LowerName: "owner"}
+	insertTestBeans(t, owner)
+	repo := &Repository{OwnerID: owner.ID, Name: "repo", LowerName: "repo"}
+	other := &Repository{OwnerID: owner.ID, Name: "other", LowerName: "other"}
+	insertTestBeans(t, repo, other)
+
+	inv, err := other.InviteCollaborator(owner, "alice@example.com", ACCESS_MODE_READ)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Invitations can only be changed through their own repository", t, func() {
+		So(repo.ChangeInviteAccessMode(inv.ID, ACCESS_MODE_ADMIN), ShouldBeNil)
+		So(repo.RevokeInvite(inv.ID), ShouldBeNil)
+		So(repo.RevokeInvite(0), ShouldNotBeNil)
+
+		inv, err := other.GetInvite(inv.ID)
+		So(err, ShouldBeNil)
+		So(inv.Mode, ShouldEqual, ACCESS_MODE_READ)
+
+		So(other.ChangeInviteAccessMode(inv.ID, ACCESS_MODE_ADMIN), ShouldBeNil)
+		inv, err = other.GetInvite(inv.ID)
+		So(err, ShouldBeNil)
+		So(inv.Mode, ShouldEqual, ACCESS_MODE_ADMIN)
+	})
+}
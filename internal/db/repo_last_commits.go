@@ -0,0 +1,157 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LastCommit is the last commit that changed an entry of a directory.
+type LastCommit struct {
+	ID        string
+	Summary   string
+	Committed time.Time
+}
+
+// lastCommitsWalker attributes entries of a directory to commits of the output
+// of "git log --name-only -z" as it is written, newest commits first. Each
+// commit starts with a record separator followed by fields of its header,
+// which are followed by names of changed files.
+type lastCommitsWalker struct {
+	prefix  string // The tree path with a trailing slash, if any
+	pending map[string]bool
+	commits map[string]*LastCommit
+	onDone  func()
+
+	buf    []byte
+	header []string // Fields of the header of the current commit
+	commit *LastCommit
+}
+
+func newLastCommitsWalker(treePath string, names []string, onDone func()) *lastCommitsWalker {
+	w := &lastCommitsWalker{
+		pending: make(map[string]bool, len(names)),
+		commits: make(map[string]*LastCommit, len(names)),
+		onDone:  onDone,
+	}
+	if treePath != "" {
+		w.prefix = strings.TrimSuffix(treePath, "/") + "/"
+	}
+	for _, name := range names {
+		w.pending[name] = true
+	}
+	return w
+}
+
+const lastCommitsLogFormat = "--format=%x1e%H%x00%ct%x00%s"
+
+func (w *lastCommitsWalker) done() bool {
+	return len(w.pending) == 0
+}
+
+func (w *lastCommitsWalker) Write(p []byte) (int, error) {
+	if w.done() {
+		return len(p), nil
+	}
+
+	w.buf = append(w.buf, p...)
+	for !w.done() {
+		i := bytes.IndexByte(w.buf, 0)
+		if i < 0 {
+			break
+		}
+		w.field(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+	if w.done() && w.onDone != nil {
+		w.onDone()
+	}
+	return len(p), nil
+}
+
+func (w *lastCommitsWalker) field(s string) {
+	// Names of changed files and headers of commits without changed files are
+	// separated from the previous header by a newline.
+	if w.header == nil {
+		s = strings.TrimPrefix(s, "\n")
+	}
+
+	if strings.HasPrefix(s, "\x1e") {
+		w.header = append(w.header[:0], s[1:])
+		w.commit = nil
+		return
+	} else if w.header != nil {
+		w.header = append(w.header, s)
+		if len(w.header) < 3 {
+			return
+		}
+
+		committed, _ := strconv.ParseInt(w.header[1], 10, 64)
+		w.commit = &LastCommit{
+			ID:        w.header[0],
+			Summary:   w.header[2],
+			Committed: time.Unix(committed, 0),
+		}
+		w.header = nil
+		return
+	}
+
+	if w.commit == nil || !strings.HasPrefix(s, w.prefix) {
+		return
+	}
+	name := strings.SplitN(s[len(w.prefix):], "/", 2)[0]
+	if w.pending[name] {
+		delete(w.pending, name)
+		w.commits[name] = w.commit
+	}
+}
+
+// LastCommitsOfEntries returns the last commits that changed each of the named
+// entries of the directory at the tree path of given commit, by walking the
+// history once. The walk stops early once all entries are found, or when the
+// context is done, in which case entries not found yet are missing from the
+// result. Entries not found after the whole history is walked are mapped to
+// nil.
+func LastCommitsOfEntries(ctx context.Context, repoPath, commitID, treePath string, names []string) (map[string]*LastCommit, error) {
+	if commitID == "" || strings.HasPrefix(commitID, "-") {
+		return nil, fmt.Errorf("invalid commit ID %q", commitID)
+	}
+
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := newLastCommitsWalker(treePath, names, cancel)
+	if w.done() {
+		return w.commits, nil
+	}
+
+	args := []string{"--literal-pathspecs", "log", "--name-only", "--no-renames", "-z", lastCommitsLogFormat, commitID, "--"}
+	if treePath != "" {
+		args = append(args, treePath)
+	}
+	err := runGitOperation(walkCtx, GIT_OPERATION_LOG, repoPath, w, args...)
+	switch {
+	case w.done():
+		return w.commits, nil
+	case err == nil:
+		for name := range w.pending {
+			w.commits[name] = nil
+		}
+		return w.commits, nil
+	case ctx.Err() != nil:
+		return w.commits, nil
+	}
+	return nil, fmt.Errorf("git log: %w", err)
+}
+
+// LastCommitsCacheKey returns key used for cache last commits of entries of
+// the directory at the tree path of given commit, which never change.
+func LastCommitsCacheKey(commitID, treePath string) string {
+	return fmt.Sprintf("LastCommits_%s_%s", commitID, treePath)
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+// newLastCommitsRepo creates a bare repository whose first commit adds given
+// number of files to the "files" directory and a "README" file, and each of
+// the following commits changes every n-th file of the directory, starting
+// from the index of the commit.
+func newLastCommitsRepo(tb testing.TB, numFiles, numCommits int) string {
+	var stream strings.Builder
+	data := func(s string) {
+		fmt.Fprintf(&stream, "data %d\n%s\n", len(s), s)
+	}
+	for i := 0; i < numCommits; i++ {
+		fmt.Fprintf(&stream, "commit refs/heads/master\nmark :%d\n", i+1)
+		fmt.Fprintf(&stream, "committer gogs <gogs@localhost> %d +0000\n", 1500000000+i)
+		data(fmt.Sprintf("commit %d", i))
+		if i == 0 {
+			stream.WriteString("M 100644 inline README\n")
+			data("readme")
+		} else {
+			fmt.Fprintf(&stream, "from :%d\n", i)
+		}
+		for j := 0; j < numFiles; j++ {
+			if i == 0 || (numCommits > 1 && j%(numCommits-1) == i-1) {
+				fmt.Fprintf(&stream, "M 100644 inline files/file-%05d\n", j)
+				data(fmt.Sprintf("%d-%d", i, j))
+			}
+		}
+	}
+
+	repo := testutil.InitGitRepo(tb, "", "--bare")
+	repo.GitWithStdin(stream.String(), "fast-import", "--quiet")
+	return repo.Dir
+}
+
+func Test_lastCommitsWalker(t *testing.T) {
+	Convey("Attribute entries to commits of the log", t, func() {
+		output := "\x1ec3\x001500000003\x00Third\x00\nsrc/a/b.go\x00src/c.go\x00" +
+			"\x1ec2\x001500000002\x00Empty\x00" +
+			"\x1ec1\x001500000001\x00First\x00\nsrc/a/d.go\x00src/c.go\x00src/e.go\x00"
+
+		w := newLastCommitsWalker("src", []string{"a", "c.go", "e.go", "f.go"}, nil)
+		// Write byte by byte to split fields in all possible ways.
+		for i := range output {
+			_, err := w.Write([]byte{output[i]})
+			So(err, ShouldBeNil)
+		}
+		So(w.done(), ShouldBeFalse)
+		So(w.commits, ShouldHaveLength, 3)
+		So(w.commits["a"], ShouldResemble, &LastCommit{ID: "c3", Summary: "Third", Committed: time.Unix(1500000003, 0)})
+		So(w.commits["c.go"].ID, ShouldEqual, "c3")
+		So(w.commits["e.go"].ID, ShouldEqual, "c1")
+
+		Convey("Stop once all entries are found", func() {
+			done := false
+			w = newLastCommitsWalker("", []string{"src"}, func() { done = true })
+			_, err := w.Write([]byte(output))
+			So(err, ShouldBeNil)
+			So(done, ShouldBeTrue)
+			So(w.commits["src"].ID, ShouldEqual, "c3")
+		})
+	})
+}
+
+func Test_LastCommitsOfEntries(t *testing.T) {
+	repoPath := newLastCommitsRepo(t, 20, 5)
+	gitRepo, err := git.OpenRepository(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitID, err := gitRepo.GetBranchCommitID("master")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Get last commits of entries", t, func() {
+		commits, err := LastCommitsOfEntries(context.Background(), repoPath, commitID, "", []string{"README", "files"})
+		So(err, ShouldBeNil)
+		So(commits["files"].ID, ShouldEqual, commitID)
+		So(commits["files"].Summary, ShouldEqual, "commit 4")
+		So(commits["README"].Summary, ShouldEqual, "commit 0")
+
+		commits, err = LastCommitsOfEntries(context.Background(), repoPath, commitID, "files", []string{"file-00000", "file-00005", "file-00007"})
+		So(err, ShouldBeNil)
+		So(commits["file-00000"].Summary, ShouldEqual, "commit 1")
+		So(commits["file-00005"].Summary, ShouldEqual, "commit 2")
+		So(commits["file-00007"].Summary, ShouldEqual, "commit 4")
+
+		Convey("Results are the same as of looking up entries one by one", func() {
+			commit, err := gitRepo.GetCommit(commitID)
+			So(err, ShouldBeNil)
+			for name, c := range commits {
+				expected, err := commit.GetCommitByPath("files/" + name)
+				So(err, ShouldBeNil)
+				So(c.ID, ShouldEqual, expected.ID.String())
+			}
+		})
+
+		Convey("Entries not found are mapped to nil", func() {
+			commits, err := LastCommitsOfEntries(context.Background(), repoPath, commitID, "files", []string{"file-00001", "missing"})
+			So(err, ShouldBeNil)
+			So(commits, ShouldContainKey, "missing")
+			So(commits["missing"], ShouldBeNil)
+			So(commits["file-00001"].Summary, ShouldEqual, "commit 2")
+		})
+
+		Convey("Return entries found so far when the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			commits, err := LastCommitsOfEntries(ctx, repoPath, commitID, "files", []string{"file-00001"})
+			So(err, ShouldBeNil)
+			So(commits, ShouldBeEmpty)
+		})
+
+		Convey("Tree paths are not pathspec patterns", func() {
+			commits, err := LastCommitsOfEntries(context.Background(), repoPath, commitID, "file*", []string{"file-00001"})
+			So(err, ShouldBeNil)
+			So(commits["file-00001"], ShouldBeNil)
+		})
+	})
+
+	Convey("Reject invalid commit IDs", t, func() {
+		_, err := LastCommitsOfEntries(context.Background(), repoPath, "--all", "", []string{"README"})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+// benchmarkLastCommitsRepo returns path of a repository with a directory of
+// 2,000 files changed over 200 commits, its latest commit and entries of the
+// directory.
+func benchmarkLastCommitsRepo(b *testing.B) (string, *git.Commit, git.Entries) {
+	repoPath := newLastCommitsRepo(b, 2000, 200)
+	gitRepo, err := git.OpenRepository(repoPath)
+	if err != nil {
+		b.Fatal(err)
+	}
+	commit, err := gitRepo.GetBranchCommit("master")
+	if err != nil {
+		b.Fatal(err)
+	}
+	tree, err := commit.SubTree("files")
+	if err != nil {
+		b.Fatal(err)
+	}
+	entries, err := tree.ListEntries()
+	if err != nil {
+		b.Fatal(err)
+	}
+	return repoPath, commit, entries
+}
+
+func Benchmark_LastCommitsOfEntries(b *testing.B) {
+	repoPath, commit, entries := benchmarkLastCommitsRepo(b)
+	names := make([]string, len(entries))
+	for i := range entries {
+		names[i] = entries[i].Name()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		commits, err := LastCommitsOfEntries(context.Background(), repoPath, commit.ID.String(), "files", names)
+		if err != nil {
+			b.Fatal(err)
+		} else if len(commits) != len(names) {
+			b.Fatalf("got %d commits", len(commits))
+		}
+	}
+}
+
+// Benchmark_GetCommitsInfo measures looking up the last commit of each entry
+// with a Git command, which is replaced by LastCommitsOfEntries.
+func Benchmark_GetCommitsInfo(b *testing.B) {
+	_, commit, entries := benchmarkLastCommitsRepo(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := entries.GetCommitsInfo(commit, "files"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+)
+
+// MuteSchedule is a weekly schedule of a user to mute email notifications of a
+// repository, e.g. during off-hours. Notifications are still recorded in the
+// muted periods.
+type MuteSchedule struct {
+	ID     int64
+	UserID int64 `xorm:"UNIQUE(s)"`
+	RepoID int64 `xorm:"UNIQUE(s) INDEX"`
+	// Days is the bitmask of weekdays that the schedule applies to, where
+	// Sunday is the lowest bit.
+	Days int
+	// Start and End are minutes since midnight. The period spans midnight when
+	// End is before Start, e.g. from 22:00 to 07:00.
+	Start    int
+	End      int
+	Timezone string
+}
+
+// HasDay returns true if the schedule applies to the weekday.
+func (s *MuteSchedule) HasDay(day time.Weekday) bool {
+	return s.Days&(1<<uint(day)) != 0
+}
+
+// IsMuted returns true if the given time falls in a muted period.
+func (s *MuteSchedule) IsMuted(at time.Time) bool {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		log.Error("Failed to load location %q of mute schedule [id: %d]: %v", s.Timezone, s.ID, err)
+		return false
+	}
+	at = at.In(loc)
+	minute := at.Hour()*60 + at.Minute()
+	day := at.Weekday()
+
+	if s.Start < s.End {
+		return s.HasDay(day) && minute >= s.Start && minute < s.End
+	}
+	// The period spans midnight, so the early hours belong to the period
+	// started on the previous day.
+	return (s.HasDay(day) && minute >= s.Start) ||
+		(s.HasDay((day+6)%7) && minute < s.End)
+}
+
+// ValidateMuteSchedule returns an error if the schedule is invalid.
+func ValidateMuteSchedule(s *MuteSchedule) error {
+	if s.Days <= 0 || s.Days >= 1<<7 ||
+		s.Start < 0 || s.Start >= 24*60 ||
+		s.End < 0 || s.End >= 24*60 ||
+		s.Start == s.End {
+		return ErrInvalidMuteSchedule{Reason: "invalid days or times"}
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return ErrInvalidMuteSchedule{Reason: fmt.Sprintf("unknown timezone %q", s.Timezone)}
+	}
+	return nil
+}
+
+// GetMuteSchedule returns the mute schedule of the user for the repository.
+func GetMuteSchedule(userID, repoID int64) (*MuteSchedule, error) {
+	s := &MuteSchedule{
+		UserID: userID,
+		RepoID: repoID,
+	}
+	has, err := x.Get(s)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrMuteScheduleNotExist{UserID: userID, RepoID: repoID}
+	}
+	return s, nil
+}
+
+// UpdateMuteSchedule creates or updates the mute schedule of the user for the
+// repository.
+func UpdateMuteSchedule(s *MuteSchedule) error {
+	if err := ValidateMuteSchedule(s); err != nil {
+		return err
+	}
+
+	existing, err := GetMuteSchedule(s.UserID, s.RepoID)
+	if err != nil {
+		if !IsErrMuteScheduleNotExist(err) {
+			return err
+		}
+		_, err = x.Insert(s)
+		return err
+	}
+
+	s.ID = existing.ID
+	_, err = x.ID(s.ID).AllCols().Update(s)
+	return err
+}
+
+// DeleteMuteSchedule deletes the mute schedule of the user for the repository.
+func DeleteMuteSchedule(userID, repoID int64) error {
+	_, err := x.Delete(&MuteSchedule{UserID: userID, RepoID: repoID})
+	return err
+}
+
+// IsMutedFor returns true if the user has muted email notifications of the
+// repository at the given time.
+func (repo *Repository) IsMutedFor(u *User, at time.Time) bool {
+	s, err := GetMuteSchedule(u.ID, repo.ID)
+	if err != nil {
+		if !IsErrMuteScheduleNotExist(err) {
+			log.Error("GetMuteSchedule [user_id: %d, repo_id: %d]: %v", u.ID, repo.ID, err)
+		}
+		return false
+	}
+	return s.IsMuted(at)
+}
+
+// getMutedUserIDs returns the set of IDs of users who have muted email
+// notifications of the repository at the given time.
+func getMutedUserIDs(e Engine, repoID int64, at time.Time) (map[int64]bool, error) {
+	schedules := make([]*MuteSchedule, 0, 5)
+	if err := e.Where("repo_id = ?", repoID).Find(&schedules); err != nil {
+		return nil, err
+	}
+
+	muted := make(map[int64]bool, len(schedules))
+	for _, s := range schedules {
+		if s.IsMuted(at) {
+			muted[s.UserID] = true
+		}
+	}
+	return muted, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_MuteSchedule_IsMuted(t *testing.T) {
+	// 2020-01-06 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2020, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	Convey("Mute in the same day", t, func() {
+		s := &MuteSchedule{
+			Days:     1 << uint(time.Monday),
+			Start:    9 * 60,
+			End:      17 * 60,
+			Timezone: "UTC",
+		}
+		So(s.IsMuted(at(6, 9, 0)), ShouldBeTrue)
+		So(s.IsMuted(at(6, 16, 59)), ShouldBeTrue)
+		So(s.IsMuted(at(6, 17, 0)), ShouldBeFalse)
+		So(s.IsMuted(at(6, 8, 59)), ShouldBeFalse)
+		So(s.IsMuted(at(7, 12, 0)), ShouldBeFalse)
+	})
+
+	Convey("Mute over midnight", t, func() {
+		s := &MuteSchedule{
+			Days:     0x3e, // Monday to Friday
+			Start:    18 * 60,
+			End:      9 * 60,
+			Timezone: "UTC",
+		}
+		So(s.IsMuted(at(6, 20, 0)), ShouldBeTrue)
+		So(s.IsMuted(at(7, 8, 0)), ShouldBeTrue)
+		So(s.IsMuted(at(6, 12, 0)), ShouldBeFalse)
+		// Sunday is not in the schedule, so Monday early hours are not muted.
+		So(s.IsMuted(at(6, 8, 0)), ShouldBeFalse)
+		// Period started on Friday continues into Saturday.
+		So(s.IsMuted(at(11, 8, 0)), ShouldBeTrue)
+		So(s.IsMuted(at(11, 20, 0)), ShouldBeFalse)
+	})
+
+	Convey("Mute in the timezone of the schedule", t, func() {
+		s := &MuteSchedule{
+			Days:     1 << uint(time.Monday),
+			Start:    9 * 60,
+			End:      17 * 60,
+			Timezone: "Asia/Tokyo",
+		}
+		So(s.IsMuted(at(6, 1, 0)), ShouldBeTrue)   // 10:00 on Monday in Tokyo
+		So(s.IsMuted(at(5, 23, 0)), ShouldBeFalse) // 08:00 on Monday in Tokyo
+		So(s.IsMuted(at(6, 9, 0)), ShouldBeFalse)  // 18:00 on Monday in Tokyo
+	})
+
+	Convey("Unknown timezone never mutes", t, func() {
+		s := &MuteSchedule{
+			Days:     0x7f,
+			Start:    0,
+			End:      23 * 60,
+			Timezone: "Mars/Olympus",
+		}
+		So(s.IsMuted(at(6, 12, 0)), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/tool"
+)
+
+// PathRestriction limits a read-only collaborator of a private repository to
+// files under some path prefixes. It is only enforced by the web UI and the
+// API: Git cannot enforce access by paths, so restricted collaborators cannot
+// clone or fetch the repository at all.
+type PathRestriction struct {
+	Prefixes []string
+	// AllowIssues indicates whether the collaborator can access issues.
+	AllowIssues bool
+}
+
+// cleanTreePath returns the tree path cleaned as if it was rooted, so it never
+// goes above the root directory.
+func cleanTreePath(treePath string) string {
+	return strings.TrimPrefix(path.Clean("/"+treePath), "/")
+}
+
+// Allows returns true if the tree path is one of or under any of the prefixes.
+func (r *PathRestriction) Allows(treePath string) bool {
+	treePath = cleanTreePath(treePath)
+	for _, prefix := range r.Prefixes {
+		if treePath == prefix || strings.HasPrefix(treePath, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Visible returns true if the tree path is allowed or is a parent directory of
+// any of the prefixes, which is listed with only entries that lead to allowed
+// paths.
+func (r *PathRestriction) Visible(treePath string) bool {
+	treePath = cleanTreePath(treePath)
+	if treePath == "" || r.Allows(treePath) {
+		return true
+	}
+	for _, prefix := range r.Prefixes {
+		if strings.HasPrefix(prefix, treePath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterEntries returns entries of the directory at the tree path that are
+// visible.
+func (r *PathRestriction) FilterEntries(treePath string, entries git.Entries) git.Entries {
+	filtered := make(git.Entries, 0, len(entries))
+	for _, entry := range entries {
+		if r.Visible(path.Join(treePath, entry.Name())) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// ExistingPrefixes returns prefixes that exist in the commit.
+func (r *PathRestriction) ExistingPrefixes(commit *git.Commit) []string {
+	prefixes := make([]string, 0, len(r.Prefixes))
+	for _, prefix := range r.Prefixes {
+		if _, err := commit.GetTreeEntryByPath(prefix); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// Key returns a short string that identifies the set of prefixes, e.g. to name
+// archives that only contain allowed paths.
+func (r *PathRestriction) Key() string {
+	return tool.ShortSHA1(tool.SHA1(strings.Join(r.Prefixes, "\n")))
+}
+
+// AllowsPage returns true if the page at the URL path under the base link can
+// be visited by the restricted collaborator. The base link itself is always
+// allowed, other pages must be one of or under any of pages, or issue pages
+// when the collaborator can access issues.
+func (r *PathRestriction) AllowsPage(baseLink, urlPath string, pages, issuePages []string) bool {
+	if len(urlPath) < len(baseLink) || !strings.EqualFold(urlPath[:len(baseLink)], baseLink) {
+		return false
+	}
+	subpath := strings.TrimSuffix(urlPath[len(baseLink):], "/")
+	if subpath == "" {
+		return true
+	}
+
+	allowed := pages
+	if r.AllowIssues {
+		allowed = append(append([]string{}, pages...), issuePages...)
+	}
+	for _, page := range allowed {
+		if subpath == page || strings.HasPrefix(subpath, page+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePathPrefixes returns sorted and distinct path prefixes separated by new
+// lines or commas in the text. Prefixes cannot be the root directory or
+// contain wildcards, which have special meanings to Git.
+func ParsePathPrefixes(text string) ([]string, error) {
+	seen := make(map[string]bool)
+	prefixes := make([]string, 0, 5)
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool {
+		return r == '\n' || r == '\r' || r == ','
+	}) {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		prefix := cleanTreePath(field)
+		if prefix == "" || strings.ContainsAny(prefix, `*?[]\:`) {
+			return nil, ErrInvalidPathPrefix{Prefix: field}
+		}
+		if !seen[prefix] {
+			seen[prefix] = true
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Strings(prefixes)
+	return prefixes, nil
+}
+
+// PathRestriction returns the path restriction of the collaboration, or nil
+// if the collaborator is not restricted.
+func (c *Collaboration) PathRestriction() *PathRestriction {
+	if c.AllowedPaths == "" {
+		return nil
+	}
+	return &PathRestriction{
+		Prefixes:    strings.Split(c.AllowedPaths, "\n"),
+		AllowIssues: c.AllowIssues,
+	}
+}
+
+// SetCollaborationPaths limits the collaborator to read files under given path
+// prefixes, or removes the restriction when prefixes are empty. Collaborators
+// with write access or higher are changed to read-only when restricted.
+func (repo *Repository) SetCollaborationPaths(userID int64, prefixes []string, allowIssues bool) error {
+	collaboration := &Collaboration{
+		RepoID: repo.ID,
+		UserID: userID,
+	}
+	has, err := x.Get(collaboration)
+	if err != nil {
+		return fmt.Errorf("get collaboration: %v", err)
+	} else if !has {
+		return nil
+	}
+
+	if len(prefixes) > 0 && collaboration.Mode > ACCESS_MODE_READ {
+		if err = repo.ChangeCollaborationAccessMode(userID, ACCESS_MODE_READ); err != nil {
+			return fmt.Errorf("ChangeCollaborationAccessMode: %v", err)
+		}
+	}
+
+	collaboration.AllowedPaths = strings.Join(prefixes, "\n")
+	collaboration.AllowIssues = allowIssues
+	if _, err = x.ID(collaboration.ID).Cols("allowed_paths", "allow_issues").Update(collaboration); err != nil {
+		return fmt.Errorf("update collaboration: %v", err)
+	}
+	return nil
+}
+
+// GetPathRestriction returns the path restriction of the user on the
+// repository, or nil if the user is not restricted. Site admins, the owner
+// and members of teams that have the repository are never restricted, neither
+// for public repositories that everyone can read.
+func GetPathRestriction(repo *Repository, u *User) (*PathRestriction, error) {
+	if u == nil || u.IsAdmin || !repo.IsPrivate || u.ID == repo.OwnerID {
+		return nil, nil
+	}
+
+	collaboration := &Collaboration{
+		RepoID: repo.ID,
+		UserID: u.ID,
+	}
+	has, err := x.Get(collaboration)
+	if err != nil {
+		return nil, fmt.Errorf("get collaboration: %v", err)
+	} else if !has || collaboration.PathRestriction() == nil {
+		return nil, nil
+	}
+
+	teams, err := GetUserTeams(repo.OwnerID, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetUserTeams: %v", err)
+	}
+	for _, t := range teams {
+		if t.IsOwnerTeam() || t.HasRepository(repo.ID) {
+			return nil, nil
+		}
+	}
+	return collaboration.PathRestriction(), nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"archive/zip"
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_PathRestriction(t *testing.T) {
+	r := &PathRestriction{Prefixes: []string{"docs", "src/api"}}
+
+	Convey("Allow paths under prefixes", t, func() {
+		for _, p := range []string{"docs", "docs/guide.md", "/docs/a/b.md", "src/api", "src/api/v1.go", "docs/../docs/guide.md"} {
+			So(r.Allows(p), ShouldBeTrue)
+		}
+		for _, p := range []string{"", "README.md", "docs2/guide.md", "DOCS/guide.md", "src", "src/main.go", "src/api2", "docs/../secret.txt", "../docs/../secret.txt"} {
+			So(r.Allows(p), ShouldBeFalse)
+		}
+	})
+
+	Convey("Parent directories of prefixes are visible", t, func() {
+		for _, p := range []string{"", "src", "docs/guide.md"} {
+			So(r.Visible(p), ShouldBeTrue)
+		}
+		for _, p := range []string{"README.md", "src/main.go", "sr", "internal"} {
+			So(r.Visible(p), ShouldBeFalse)
+		}
+	})
+
+	Convey("Only allow pages in the list", t, func() {
+		pages := []string{"/src", "/raw", "/archive"}
+		issuePages := []string{"/issues"}
+		for _, p := range []string{"/owner/repo", "/owner/repo/", "/Owner/Repo/src/master/docs", "/owner/repo/raw/master/docs/guide.md", "/owner/repo/archive/master.zip"} {
+			So(r.AllowsPage("/owner/repo", p, pages, issuePages), ShouldBeTrue)
+		}
+		for _, p := range []string{"/owner/repo/issues", "/owner/repo/commits/master", "/owner/repo/commit/abc1234", "/owner/repo/rawfile", "/owner/repo2/src/master", "/owner"} {
+			So(r.AllowsPage("/owner/repo", p, pages, issuePages), ShouldBeFalse)
+		}
+
+		withIssues := &PathRestriction{Prefixes: r.Prefixes, AllowIssues: true}
+		So(withIssues.AllowsPage("/owner/repo", "/owner/repo/issues/1", pages, issuePages), ShouldBeTrue)
+		So(withIssues.AllowsPage("/owner/repo", "/owner/repo/pulls", pages, issuePages), ShouldBeFalse)
+	})
+}
+
+func Test_ParsePathPrefixes(t *testing.T) {
+	Convey("Parse path prefixes", t, func() {
+		prefixes, err := ParsePathPrefixes("docs/\r\n /src/api , docs\n\n")
+		So(err, ShouldBeNil)
+		So(prefixes, ShouldResemble, []string{"docs", "src/api"})
+
+		prefixes, err = ParsePathPrefixes("")
+		So(err, ShouldBeNil)
+		So(prefixes, ShouldBeEmpty)
+
+		for _, text := range []string{"/", "..", "docs/..", "docs/*", "docs/[a]", ":(glob)docs"} {
+			_, err = ParsePathPrefixes(text)
+			So(IsErrInvalidPathPrefix(err), ShouldBeTrue)
+		}
+	})
+}
+
+func Test_CreateArchive_restricted(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	for filename, content := range map[string]string{
+		"README.md":      "readme",
+		"secret.txt":     "secret",
+		"docs/guide.md":  "guide",
+		"docs2/notes.md": "notes",
+		"src/main.go":    "package main",
+	} {
+		repo.WriteFile(filename, content)
+	}
+	repo.Commit("init")
+
+	gitRepo, err := git.OpenRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit, err := gitRepo.GetBranchCommit("master")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Archive only contains allowed paths", t, func() {
+		r := &PathRestriction{Prefixes: []string{"docs", "missing"}}
+		paths := r.ExistingPrefixes(commit)
+		So(paths, ShouldResemble, []string{"docs"})
+
+		target := filepath.Join(dir, "archive.zip")
+		So(CreateArchive(context.Background(), dir, commit.ID.String(), target, ARCHIVE_ZIP, paths...), ShouldBeNil)
+
+		zr, err := zip.OpenReader(target)
+		So(err, ShouldBeNil)
+		defer zr.Close()
+
+		var files []string
+		for _, f := range zr.File {
+			if !strings.HasSuffix(f.Name, "/") {
+				files = append(files, f.Name)
+			}
+		}
+		sort.Strings(files)
+		prefix := filepath.Base(dir) + "/"
+		So(files, ShouldResemble, []string{prefix + "docs/guide.md"})
+	})
+}
@@ -0,0 +1,345 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogs/git-module"
+	log "unknwon.dev/clog/v2"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/email"
+)
+
+// maxPathWatchesPerUser is the maximum number of path watches of a user on a
+// repository.
+const maxPathWatchesPerUser = 50
+
+// maxWatchedPathsPerMail is the maximum number of changed files listed in the
+// mail of a push, the rest are only counted.
+const maxWatchedPathsPerMail = 50
+
+// PathWatch is a subscription of a user to changes of files in a repository
+// whose paths match the pattern, which follows rules of ".gitignore".
+type PathWatch struct {
+	ID      int64
+	UserID  int64  `xorm:"UNIQUE(s) INDEX"`
+	RepoID  int64  `xorm:"UNIQUE(s) INDEX"`
+	Pattern string `xorm:"UNIQUE(s)"`
+	// DefaultBranchOnly is true if only pushes to the default branch are
+	// watched.
+	DefaultBranchOnly bool
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+}
+
+func (w *PathWatch) BeforeInsert() {
+	w.CreatedUnix = time.Now().Unix()
+}
+
+func (w *PathWatch) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		w.Created = time.Unix(w.CreatedUnix, 0).Local()
+	}
+}
+
+// CreatePathWatch creates a new path watch of the user on the repository.
+func CreatePathWatch(w *PathWatch) error {
+	w.Pattern = strings.TrimSpace(w.Pattern)
+	if _, err := compilePathPattern(w.Pattern, true); err != nil {
+		return ErrInvalidPathWatchPattern{Pattern: w.Pattern}
+	}
+
+	watches, err := GetPathWatches(w.UserID, w.RepoID)
+	if err != nil {
+		return err
+	} else if len(watches) >= maxPathWatchesPerUser {
+		return ErrPathWatchLimitReached{Limit: maxPathWatchesPerUser}
+	}
+	for _, existing := range watches {
+		if existing.Pattern == w.Pattern {
+			return ErrPathWatchAlreadyExist{Pattern: w.Pattern}
+		}
+	}
+
+	_, err = x.Insert(w)
+	return err
+}
+
+// GetPathWatches returns path watches of the user on the repository.
+func GetPathWatches(userID, repoID int64) ([]*PathWatch, error) {
+	watches := make([]*PathWatch, 0, 5)
+	return watches, x.Where("user_id = ? AND repo_id = ?", userID, repoID).Asc("id").Find(&watches)
+}
+
+// GetPathWatchesOfRepo returns path watches of all users on the repository.
+func GetPathWatchesOfRepo(repoID int64) ([]*PathWatch, error) {
+	watches := make([]*PathWatch, 0, 10)
+	return watches, x.Where("repo_id = ?", repoID).Asc("id").Find(&watches)
+}
+
+// GetPathWatchByID returns the path watch with given ID on the repository.
+func GetPathWatchByID(repoID, id int64) (*PathWatch, error) {
+	w := new(PathWatch)
+	has, err := x.Where("id = ? AND repo_id = ?", id, repoID).Get(w)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrPathWatchNotExist{ID: id}
+	}
+	return w, nil
+}
+
+// DeletePathWatch deletes the path watch with given ID on the repository.
+func DeletePathWatch(repoID, id int64) error {
+	_, err := x.Delete(&PathWatch{ID: id, RepoID: repoID})
+	return err
+}
+
+// ChangedPath is a file changed by a push. Renamed files are reported with
+// both paths, so that watches of either path are notified.
+type ChangedPath struct {
+	Status  string // "A", "M", "D", "R", etc.
+	Path    string
+	OldPath string // Only set for renamed and copied files.
+}
+
+// parseRawDiff parses the output of "git diff --raw -z --no-abbrev".
+func parseRawDiff(output []byte) []*ChangedPath {
+	fields := bytes.Split(output, []byte{0})
+	changes := make([]*ChangedPath, 0, len(fields)/2)
+	for i := 0; i < len(fields); i++ {
+		meta := string(fields[i])
+		if !strings.HasPrefix(meta, ":") {
+			continue
+		}
+		parts := strings.Fields(meta)
+		if len(parts) < 5 || i+1 >= len(fields) {
+			break
+		}
+
+		change := &ChangedPath{
+			Status: parts[4][:1],
+			Path:   string(fields[i+1]),
+		}
+		i++
+		if (change.Status == "R" || change.Status == "C") && i+1 < len(fields) {
+			change.OldPath = change.Path
+			change.Path = string(fields[i+1])
+			i++
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// emptyTreeID is the ID of the empty tree, which every repository has.
+const emptyTreeID = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+
+// changedPathsOfPush returns files changed in the aggregated diff of given
+// revisions, which are either the old and new commits of the push, or a range
+// "<default>...<new>" of changes since the branch diverged from the default
+// branch.
+func changedPathsOfPush(repoPath string, revs ...string) ([]*ChangedPath, error) {
+	args := append([]string{"diff", "--raw", "-z", "--no-abbrev", "-M"}, revs...)
+	output, err := git.NewCommand(append(args, "--")...).RunInDirBytes(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("list changed files: %v", err)
+	}
+	return parseRawDiff(output), nil
+}
+
+// matchPathWatches returns the changed files that match each of the patterns.
+// Every distinct pattern is only matched once against the changed files, no
+// matter how many users watch it.
+func matchPathWatches(watches []*PathWatch, changes []*ChangedPath) map[string][]*ChangedPath {
+	matched := make(map[string][]*ChangedPath)
+	for _, w := range watches {
+		if _, ok := matched[w.Pattern]; ok {
+			continue
+		}
+
+		var files []*ChangedPath
+		matcher, err := compilePathPattern(w.Pattern, true)
+		if err == nil {
+			files = matchChangedPaths(matcher, changes)
+		}
+		matched[w.Pattern] = files
+	}
+	return matched
+}
+
+func matchChangedPaths(matcher *regexp.Regexp, changes []*ChangedPath) []*ChangedPath {
+	var files []*ChangedPath
+	for _, c := range changes {
+		if matcher.MatchString(c.Path) || (c.OldPath != "" && matcher.MatchString(c.OldPath)) {
+			files = append(files, c)
+		}
+	}
+	return files
+}
+
+// restrictChangedPath returns the changed file as seen by the user with the
+// path restriction, or nil if the file is hidden from the user. A renamed or
+// copied file is shown as added when only its old path is hidden, and is hidden
+// when its new path is hidden.
+func restrictChangedPath(restriction *PathRestriction, c *ChangedPath) *ChangedPath {
+	if restriction == nil {
+		return c
+	} else if !restriction.Visible(c.Path) {
+		return nil
+	} else if c.OldPath == "" || restriction.Visible(c.OldPath) {
+		return c
+	}
+	return &ChangedPath{
+		Status: "A",
+		Path:   c.Path,
+	}
+}
+
+// mailPathWatches sends one mail to each user who watches paths of the
+// repository that are changed by the push to the branch, listing the changed
+// files in the order of the diff. The pusher, muted users and users who cannot
+// read the repository are skipped, files hidden by path restrictions are left
+// out.
+func mailPathWatches(doer *User, repo *Repository, branch string, isDefaultBranch, isNewRef, wasBare bool, oldCommitID, newCommitID string) error {
+	if !conf.User.EnableEmailNotification {
+		return nil
+	}
+
+	watches, err := GetPathWatchesOfRepo(repo.ID)
+	if err != nil {
+		return fmt.Errorf("GetPathWatchesOfRepo: %v", err)
+	}
+	if !isDefaultBranch {
+		filtered := watches[:0]
+		for _, w := range watches {
+			if !w.DefaultBranchOnly {
+				filtered = append(filtered, w)
+			}
+		}
+		watches = filtered
+	}
+	if len(watches) == 0 {
+		return nil
+	}
+
+	// A new branch is compared with the default branch, or the empty tree if it
+	// is the first branch of the repository.
+	revs := []string{oldCommitID, newCommitID}
+	baseCommitID := oldCommitID
+	if isNewRef {
+		switch {
+		case wasBare:
+			revs = []string{emptyTreeID, newCommitID}
+			baseCommitID = ""
+		case branch == repo.DefaultBranch:
+			return nil
+		default:
+			revs = []string{repo.DefaultBranch + "..." + newCommitID}
+			baseCommitID = repo.DefaultBranch
+		}
+	}
+	changes, err := changedPathsOfPush(repo.RepoPath(), revs...)
+	if err != nil {
+		return err
+	} else if len(changes) == 0 {
+		return nil
+	}
+
+	matched := matchPathWatches(watches, changes)
+	userFiles := make(map[int64]map[*ChangedPath]bool)
+	userIDs := make([]int64, 0, 5)
+	for _, w := range watches {
+		files := matched[w.Pattern]
+		if len(files) == 0 {
+			continue
+		}
+		if userFiles[w.UserID] == nil {
+			userFiles[w.UserID] = make(map[*ChangedPath]bool)
+			userIDs = append(userIDs, w.UserID)
+		}
+		for _, f := range files {
+			userFiles[w.UserID][f] = true
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	muted, err := getMutedUserIDs(x, repo.ID, time.Now())
+	if err != nil {
+		return fmt.Errorf("getMutedUserIDs [repo_id: %d]: %v", repo.ID, err)
+	}
+
+	var compareLink string
+	if baseCommitID != "" {
+		compareLink = conf.Server.ExternalURL + repo.ComposeCompareURL(baseCommitID, newCommitID)
+	}
+	for _, userID := range userIDs {
+		u, err := GetUserByID(userID)
+		if err != nil {
+			if errors.IsUserNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("GetUserByID [%d]: %v", userID, err)
+		} else if u.ID == doer.ID || !u.IsMailable() || muted[u.ID] {
+			continue
+		}
+
+		has, err := HasAccess(u.ID, repo, ACCESS_MODE_READ)
+		if err != nil {
+			return fmt.Errorf("HasAccess [user_id: %d, repo_id: %d]: %v", u.ID, repo.ID, err)
+		} else if !has {
+			continue
+		}
+		restriction, err := GetPathRestriction(repo, u)
+		if err != nil {
+			return fmt.Errorf("GetPathRestriction [user_id: %d, repo_id: %d]: %v", u.ID, repo.ID, err)
+		}
+
+		var files []email.WatchedPath
+		total := 0
+		for _, c := range changes {
+			if !userFiles[u.ID][c] {
+				continue
+			}
+			c = restrictChangedPath(restriction, c)
+			if c == nil {
+				continue
+			}
+			total++
+			if len(files) >= maxWatchedPathsPerMail {
+				continue
+			}
+
+			commitID := newCommitID
+			if c.Status == "D" {
+				commitID = baseCommitID
+			}
+			files = append(files, email.WatchedPath{
+				Status:  c.Status,
+				Path:    c.Path,
+				OldPath: c.OldPath,
+				Link:    repo.HTMLURL() + "/src/" + commitID + "/" + c.Path,
+			})
+		}
+		if total == 0 {
+			continue
+		}
+		email.SendPathWatchMail(NewMailerRepo(repo), NewMailerUser(doer), u.Email, branch, compareLink, files, total)
+	}
+	log.Trace("Path watches of repository [%d] are notified of push to %q", repo.ID, branch)
+	return nil
+}
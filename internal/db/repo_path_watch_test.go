@@ -0,0 +1,108 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_parseRawDiff(t *testing.T) {
+	Convey("Parse raw diff output", t, func() {
+		output := ":100644 100644 1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 M\x00README.md\x00" +
+			":000000 100644 0000000000000000000000000000000000000000 3333333333333333333333333333333333333333 A\x00deploy/prod.yml\x00" +
+			":100644 100644 4444444444444444444444444444444444444444 4444444444444444444444444444444444444444 R100\x00old/a.sql\x00new/a.sql\x00" +
+			":100644 000000 5555555555555555555555555555555555555555 0000000000000000000000000000000000000000 D\x00Dockerfile\x00"
+
+		changes := parseRawDiff([]byte(output))
+		So(changes, ShouldResemble, []*ChangedPath{
+			{Status: "M", Path: "README.md"},
+			{Status: "A", Path: "deploy/prod.yml"},
+			{Status: "R", Path: "new/a.sql", OldPath: "old/a.sql"},
+			{Status: "D", Path: "Dockerfile"},
+		})
+
+		So(parseRawDiff(nil), ShouldBeEmpty)
+	})
+}
+
+func Test_matchPathWatches(t *testing.T) {
+	Convey("Match changed files against watched patterns", t, func() {
+		changes := []*ChangedPath{
+			{Status: "M", Path: "README.md"},
+			{Status: "R", Path: "new/a.sql", OldPath: "deploy/a.sql"},
+			{Status: "D", Path: "Dockerfile"},
+		}
+		watches := []*PathWatch{
+			{UserID: 1, Pattern: "deploy/"},
+			{UserID: 2, Pattern: "deploy/"},
+			{UserID: 2, Pattern: "/Dockerfile"},
+			{UserID: 3, Pattern: "*.go"},
+		}
+
+		matched := matchPathWatches(watches, changes)
+		So(matched, ShouldHaveLength, 3)
+		So(matched["deploy/"], ShouldResemble, []*ChangedPath{changes[1]})
+		So(matched["/Dockerfile"], ShouldResemble, []*ChangedPath{changes[2]})
+		So(matched["*.go"], ShouldBeEmpty)
+	})
+}
+
+func Test_restrictChangedPath(t *testing.T) {
+	Convey("Show changed files with path restriction", t, func() {
+		restriction := &PathRestriction{Prefixes: []string{"docs"}}
+		So(restrictChangedPath(nil, &ChangedPath{Status: "M", Path: "secret.txt"}), ShouldResemble,
+			&ChangedPath{Status: "M", Path: "secret.txt"})
+		So(restrictChangedPath(restriction, &ChangedPath{Status: "M", Path: "docs/README.md"}), ShouldResemble,
+			&ChangedPath{Status: "M", Path: "docs/README.md"})
+		So(restrictChangedPath(restriction, &ChangedPath{Status: "D", Path: "secret.txt"}), ShouldBeNil)
+
+		// The new path name of a renamed file is not leaked.
+		So(restrictChangedPath(restriction, &ChangedPath{Status: "R", Path: "secret.txt", OldPath: "docs/secret.txt"}), ShouldBeNil)
+		So(restrictChangedPath(restriction, &ChangedPath{Status: "R", Path: "docs/a.txt", OldPath: "secret/a.txt"}), ShouldResemble,
+			&ChangedPath{Status: "A", Path: "docs/a.txt"})
+		So(restrictChangedPath(restriction, &ChangedPath{Status: "R", Path: "docs/b.txt", OldPath: "docs/a.txt"}), ShouldResemble,
+			&ChangedPath{Status: "R", Path: "docs/b.txt", OldPath: "docs/a.txt"})
+	})
+}
+
+func Test_changedPathsOfPush(t *testing.T) {
+	repo := testutil.InitGitRepo(t, "")
+	dir := repo.Dir
+
+	repo.WriteFile("deploy/a.sql", "CREATE TABLE a (id INT);\n")
+	repo.WriteFile("README.md", "# readme\n")
+	first := repo.Commit("initial")
+
+	if err := os.MkdirAll(filepath.Join(dir, "migrations"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	repo.Git("mv", "deploy/a.sql", "migrations/a.sql")
+	repo.WriteFile("README.md", "# README\n")
+	second := repo.Commit("move")
+
+	Convey("List files changed by a push", t, func() {
+		changes, err := changedPathsOfPush(dir, first, second)
+		So(err, ShouldBeNil)
+		So(changes, ShouldResemble, []*ChangedPath{
+			{Status: "M", Path: "README.md"},
+			{Status: "R", Path: "migrations/a.sql", OldPath: "deploy/a.sql"},
+		})
+	})
+
+	Convey("List files of the first push", t, func() {
+		changes, err := changedPathsOfPush(dir, emptyTreeID, first)
+		So(err, ShouldBeNil)
+		So(changes, ShouldResemble, []*ChangedPath{
+			{Status: "A", Path: "README.md"},
+			{Status: "A", Path: "deploy/a.sql"},
+		})
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gogs/git-module"
+	"github.com/json-iterator/go"
+	"gopkg.in/yaml.v2"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/process"
+)
+
+// ProjectRoot is the root directory of a subproject in a repository.
+type ProjectRoot struct {
+	// Path is the directory of the project, empty for the root of the tree.
+	Path      string
+	Name      string
+	Ecosystem string
+}
+
+// PROJECT_MANIFEST_PATH is the path of manifest file that defines projects
+// explicitly, in addition to those detected by markers.
+const PROJECT_MANIFEST_PATH = ".gogs/projects.yml"
+
+// maxProjectRoots is the maximum number of project roots to be detected.
+const maxProjectRoots = 100
+
+// projectMarkers maps names of files that mark project roots to ecosystems.
+var projectMarkers = map[string]string{
+	"go.mod":       "go",
+	"package.json": "npm",
+}
+
+// detectProjectMarker returns the directory and the ecosystem of the project if
+// the file at given path marks a project root. Files of dependencies, e.g.
+// under "node_modules" or "vendor", are ignored.
+func detectProjectMarker(filePath string) (dir, ecosystem string, ok bool) {
+	ecosystem, ok = projectMarkers[path.Base(filePath)]
+	if !ok {
+		return "", "", false
+	}
+
+	dir = path.Dir(filePath)
+	if dir == "." {
+		dir = ""
+	}
+	for _, name := range strings.Split(dir, "/") {
+		if name == "node_modules" || name == "vendor" {
+			return "", "", false
+		}
+	}
+	return dir, ecosystem, true
+}
+
+// projectName returns the name declared by the marker file, or an empty string
+// if none is found.
+func projectName(ecosystem string, data []byte) string {
+	switch ecosystem {
+	case "go":
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) >= 2 && fields[0] == "module" {
+				return strings.Trim(fields[1], `"`)
+			}
+		}
+	case "npm":
+		var pkg struct {
+			Name string `json:"name"`
+		}
+		if jsoniter.Unmarshal(data, &pkg) == nil {
+			return pkg.Name
+		}
+	}
+	return ""
+}
+
+type projectManifest struct {
+	Projects []struct {
+		Path      string `yaml:"path"`
+		Name      string `yaml:"name"`
+		Ecosystem string `yaml:"ecosystem"`
+	} `yaml:"projects"`
+}
+
+// readBlob returns content of the file at given path, up to 64 KiB.
+func readBlob(commit *git.Commit, filePath string) ([]byte, error) {
+	blob, err := commit.GetBlobByPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	r, err := blob.Data()
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(io.LimitReader(r, 64<<10))
+}
+
+// ProjectRoots returns subprojects in the tree of the default branch, which are
+// detected by marker files like "go.mod" and "package.json", or defined by the
+// manifest file ".gogs/projects.yml". Projects defined by the manifest take
+// precedence over detected ones at the same path.
+func (repo *Repository) ProjectRoots() ([]ProjectRoot, error) {
+	gitRepo, err := git.OpenRepository(repo.RepoPath())
+	if err != nil {
+		return nil, fmt.Errorf("open repository: %v", err)
+	}
+	commit, err := gitRepo.GetBranchCommit(repo.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("get branch commit: %v", err)
+	}
+
+	repoPath := repo.RepoPath()
+	stdout, stderr, err := process.ExecDir(-1, repoPath,
+		fmt.Sprintf("ProjectRoots 'git ls-tree': %s", repoPath),
+		"git", "ls-tree", "-r", "--name-only", "-z", commit.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("git ls-tree: %v - %s", err, stderr)
+	}
+
+	roots := make(map[string]*ProjectRoot)
+	hasManifest := false
+	for _, filePath := range strings.Split(stdout, "\x00") {
+		if filePath == PROJECT_MANIFEST_PATH {
+			hasManifest = true
+			continue
+		}
+
+		dir, ecosystem, ok := detectProjectMarker(filePath)
+		if !ok || roots[dir] != nil || len(roots) >= maxProjectRoots {
+			continue
+		}
+		root := &ProjectRoot{
+			Path:      dir,
+			Ecosystem: ecosystem,
+		}
+		if data, err := readBlob(commit, filePath); err != nil {
+			log.Error("Failed to read project marker %q [repo_id: %d]: %v", filePath, repo.ID, err)
+		} else {
+			root.Name = projectName(ecosystem, data)
+		}
+		roots[dir] = root
+	}
+
+	if hasManifest {
+		data, err := readBlob(commit, PROJECT_MANIFEST_PATH)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest: %v", err)
+		}
+		var manifest projectManifest
+		if err = yaml.Unmarshal(data, &manifest); err != nil {
+			log.Trace("Invalid project manifest [repo_id: %d]: %v", repo.ID, err)
+		}
+		for _, p := range manifest.Projects {
+			dir := strings.Trim(path.Clean("/"+p.Path), "/")
+			if roots[dir] == nil && len(roots) >= maxProjectRoots {
+				continue
+			}
+			roots[dir] = &ProjectRoot{
+				Path:      dir,
+				Name:      p.Name,
+				Ecosystem: p.Ecosystem,
+			}
+		}
+	}
+
+	projects := make([]ProjectRoot, 0, len(roots))
+	for _, root := range roots {
+		if root.Name == "" {
+			root.Name = path.Base(root.Path)
+			if root.Path == "" {
+				root.Name = repo.Name
+			}
+		}
+		projects = append(projects, *root)
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Path < projects[j].Path
+	})
+	return projects, nil
+}
+
+// ProjectRootsCacheKey returns key used for cache project roots of the tree of
+// given commit.
+func (repo *Repository) ProjectRootsCacheKey(commitID string) string {
+	return fmt.Sprintf("ProjectRoots_%d_%s", repo.ID, commitID)
+}
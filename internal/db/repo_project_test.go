@@ -0,0 +1,44 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_detectProjectMarker(t *testing.T) {
+	Convey("Detect project roots by marker files", t, func() {
+		tests := []struct {
+			path         string
+			expDir       string
+			expEcosystem string
+			expOK        bool
+		}{
+			{path: "go.mod", expDir: "", expEcosystem: "go", expOK: true},
+			{path: "services/api/go.mod", expDir: "services/api", expEcosystem: "go", expOK: true},
+			{path: "web/package.json", expDir: "web", expEcosystem: "npm", expOK: true},
+			{path: "web/node_modules/react/package.json"},
+			{path: "vendor/github.com/pkg/errors/go.mod"},
+			{path: "web/package-lock.json"},
+		}
+		for _, test := range tests {
+			dir, ecosystem, ok := detectProjectMarker(test.path)
+			So(dir, ShouldEqual, test.expDir)
+			So(ecosystem, ShouldEqual, test.expEcosystem)
+			So(ok, ShouldEqual, test.expOK)
+		}
+	})
+}
+
+func Test_projectName(t *testing.T) {
+	Convey("Get project name from marker files", t, func() {
+		So(projectName("go", []byte("// comment\nmodule gogs.io/gogs\n\ngo 1.12\n")), ShouldEqual, "gogs.io/gogs")
+		So(projectName("npm", []byte(`{"name": "@gogs/web", "version": "1.0.0"}`)), ShouldEqual, "@gogs/web")
+		So(projectName("npm", []byte(`{`)), ShouldBeEmpty)
+		So(projectName("go", []byte("go 1.12\n")), ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"xorm.io/xorm"
+)
+
+// recentPushDuration is how long a pushed branch is suggested to its pusher
+// for composing a pull request.
+const recentPushDuration = 2 * time.Hour
+
+// RecentPush is the branch a user pushed to a repository most recently.
+type RecentPush struct {
+	ID         int64
+	UserID     int64 `xorm:"UNIQUE(s)"`
+	RepoID     int64 `xorm:"UNIQUE(s)"`
+	Branch     string
+	Pushed     time.Time `xorm:"-" json:"-"`
+	PushedUnix int64
+}
+
+func (p *RecentPush) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "pushed_unix":
+		p.Pushed = time.Unix(p.PushedUnix, 0).Local()
+	}
+}
+
+// updateRecentPush records the branch as the one most recently pushed to the
+// repository by the user.
+func updateRecentPush(userID, repoID int64, branch string) error {
+	push := new(RecentPush)
+	has, err := x.Where("user_id = ? AND repo_id = ?", userID, repoID).Get(push)
+	if err != nil {
+		return err
+	}
+
+	push.Branch = branch
+	push.PushedUnix = time.Now().Unix()
+	if has {
+		_, err = x.ID(push.ID).Cols("branch", "pushed_unix").Update(push)
+		return err
+	}
+	push.UserID = userID
+	push.RepoID = repoID
+	_, err = x.Insert(push)
+	return err
+}
+
+// GetRecentPush returns the branch pushed to the repository by the user within
+// recentPushDuration, or nil if there is none.
+func GetRecentPush(userID, repoID int64) (*RecentPush, error) {
+	push := new(RecentPush)
+	has, err := x.Where("user_id = ? AND repo_id = ? AND pushed_unix > ?",
+		userID, repoID, time.Now().Add(-recentPushDuration).Unix()).Get(push)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, nil
+	}
+	return push, nil
+}
+
+// DismissRecentPush stops suggesting the branch recently pushed to the
+// repository by the user.
+func DismissRecentPush(userID, repoID int64) error {
+	_, err := x.Delete(&RecentPush{UserID: userID, RepoID: repoID})
+	return err
+}
+
+// deleteRecentPushesOfBranch removes the deleted branch from recent pushes of
+// all users.
+func deleteRecentPushesOfBranch(repoID int64, branch string) error {
+	_, err := x.Delete(&RecentPush{RepoID: repoID, Branch: branch})
+	return err
+}
+
+// ComposePullRequestURL returns the URL, without leading slash, to compose a
+// pull request from the branch of the repository to the default branch of the
+// base repository, which is the one the repository is forked from if any. It
+// returns an empty string when the base repository does not allow pull
+// requests, the branch is the default branch or has an open pull request.
+func (repo *Repository) ComposePullRequestURL(branch string) (string, error) {
+	if branch == repo.DefaultBranch {
+		return "", nil
+	}
+
+	if err := repo.LoadAttributes(); err != nil {
+		return "", fmt.Errorf("LoadAttributes: %v", err)
+	}
+	baseRepo := repo
+	if repo.IsFork {
+		baseRepo = repo.BaseRepo
+		if err := baseRepo.LoadAttributes(); err != nil {
+			return "", fmt.Errorf("LoadAttributes of base repository: %v", err)
+		}
+	}
+	if !baseRepo.AllowsPulls() {
+		return "", nil
+	}
+
+	prs, err := GetUnmergedPullRequestsByHeadInfo(repo.ID, branch)
+	if err != nil {
+		return "", fmt.Errorf("GetUnmergedPullRequestsByHeadInfo: %v", err)
+	} else if len(prs) > 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%s/compare/%s...%s:%s", baseRepo.FullName(), baseRepo.DefaultBranch, repo.Owner.Name, branch), nil
+}
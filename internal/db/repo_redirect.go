@@ -0,0 +1,112 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// RepoRedirect is a former name of a repository under its owner, which keeps
+// web and clone URLs of the old name working after the repository is renamed.
+type RepoRedirect struct {
+	ID        int64
+	OwnerID   int64  `xorm:"UNIQUE(s)"`
+	LowerName string `xorm:"UNIQUE(s)"`
+	RepoID    int64  `xorm:"INDEX"`
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+}
+
+func (r *RepoRedirect) BeforeInsert() {
+	r.CreatedUnix = time.Now().Unix()
+}
+
+func (r *RepoRedirect) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		r.Created = time.Unix(r.CreatedUnix, 0).Local()
+	}
+}
+
+// releaseRepoRedirect deletes the redirect of the name under the owner, e.g.
+// when a repository takes the name.
+func releaseRepoRedirect(e Engine, ownerID int64, repoName string) error {
+	_, err := e.Delete(&RepoRedirect{
+		OwnerID:   ownerID,
+		LowerName: strings.ToLower(repoName),
+	})
+	return err
+}
+
+// newRepoRedirect redirects the old name under the owner to the repository.
+func newRepoRedirect(e Engine, ownerID int64, oldRepoName string, repoID int64) error {
+	if err := releaseRepoRedirect(e, ownerID, oldRepoName); err != nil {
+		return fmt.Errorf("releaseRepoRedirect: %v", err)
+	}
+	_, err := e.Insert(&RepoRedirect{
+		OwnerID:   ownerID,
+		LowerName: strings.ToLower(oldRepoName),
+		RepoID:    repoID,
+	})
+	return err
+}
+
+// GetRepositoryByOldName returns the repository that was renamed from the name
+// under the owner.
+func GetRepositoryByOldName(ownerID int64, repoName string) (*Repository, error) {
+	redirect := &RepoRedirect{
+		OwnerID:   ownerID,
+		LowerName: strings.ToLower(repoName),
+	}
+	has, err := x.Get(redirect)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, errors.RepoNotExist{UserID: ownerID, Name: repoName}
+	}
+
+	repo, err := GetRepositoryByID(redirect.RepoID)
+	if err != nil {
+		return nil, err
+	} else if redirect.isStale(repo) {
+		return nil, errors.RepoNotExist{UserID: ownerID, Name: repoName}
+	}
+	return repo, nil
+}
+
+// isStale returns true if the redirect no longer leads to the repository,
+// which has been transferred to another owner or renamed back to the old name.
+func (r *RepoRedirect) isStale(repo *Repository) bool {
+	return repo.OwnerID != r.OwnerID || repo.LowerName == r.LowerName
+}
+
+// GetRepoRedirects returns former names of the repository, the latest first.
+func GetRepoRedirects(repoID int64) ([]*RepoRedirect, error) {
+	redirects := make([]*RepoRedirect, 0, 2)
+	return redirects, x.Where("repo_id = ?", repoID).Desc("id").Find(&redirects)
+}
+
+// DeleteRepoRedirect deletes the redirect of the repository, after which the
+// former name no longer leads to the repository.
+func DeleteRepoRedirect(repoID, redirectID int64) error {
+	if redirectID <= 0 {
+		return errors.RepoRedirectNotExist{ID: redirectID, RepoID: repoID}
+	}
+
+	affected, err := x.Where("id = ? AND repo_id = ?", redirectID, repoID).Delete(new(RepoRedirect))
+	if err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.RepoRedirectNotExist{ID: redirectID, RepoID: repoID}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/unknwon/com"
+
+	"gogs.io/gogs/internal/conf"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+func Test_RepoRedirect_isStale(t *testing.T) {
+	redirect := &RepoRedirect{OwnerID: 1, LowerName: "old", RepoID: 10}
+
+	Convey("Redirect leads to the renamed repository", t, func() {
+		So(redirect.isStale(&Repository{ID: 10, OwnerID: 1, LowerName: "new"}), ShouldBeFalse)
+	})
+
+	Convey("Old name reclaimed by the repository itself", t, func() {
+		So(redirect.isStale(&Repository{ID: 10, OwnerID: 1, LowerName: "old"}), ShouldBeTrue)
+	})
+
+	Convey("Repository transferred to another owner", t, func() {
+		So(redirect.isStale(&Repository{ID: 10, OwnerID: 2, LowerName: "new"}), ShouldBeTrue)
+		So(redirect.isStale(&Repository{ID: 10, OwnerID: 2, LowerName: "old"}), ShouldBeTrue)
+	})
+}
+
+func Test_DeleteRepoRedirect(t *testing.T) {
+	setupTestDB(t)
+
+	redirects := []*RepoRedirect{
+		{OwnerID: 1, LowerName: "old1", RepoID: 10},
+		{OwnerID: 1, LowerName: "old2", RepoID: 10},
+		{OwnerID: 1, LowerName: "other", RepoID: 11},
+	}
+	for _, r := range redirects {
+		insertTestBeans(t, r)
+	}
+
+	Convey("Delete a redirect of the repository", t, func() {
+		Convey("Reject missing and foreign redirects", func() {
+			for _, id := range []int64{0, -1, redirects[2].ID, 100} {
+				err := DeleteRepoRedirect(10, id)
+				So(errors.IsRepoRedirectNotExist(err), ShouldBeTrue)
+			}
+
+			count, err := x.Count(new(RepoRedirect))
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 3)
+		})
+
+		So(DeleteRepoRedirect(10, redirects[0].ID), ShouldBeNil)
+		remaining, err := GetRepoRedirects(10)
+		So(err, ShouldBeNil)
+		So(remaining, ShouldHaveLength, 1)
+		So(remaining[0].LowerName, ShouldEqual, "old2")
+	})
+}
+
+func Test_ChangeRepositoryName(t *testing.T) {
+	setupTestDB(t)
+
+	oldRoot := conf.Repository.Root
+	conf.Repository.Root = t.TempDir()
+	defer func() { conf.Repository.Root = oldRoot }()
+
+	owner := &User{Name: "owner", LowerName: "owner"}
+	insertTestBeans(t, owner)
+	repo := &Repository{OwnerID: owner.ID, Name: "repo", LowerName: "repo"}
+	insertTestBeans(t, repo)
+	for _, p := range []string{RepoPath("owner", "repo"), WikiPath("owner", "repo")} {
+		if err := os.MkdirAll(p, os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	Convey("Rename a repository", t, func() {
+		Convey("Keep directories and database unchanged when renaming fails", func() {
+			// A non-empty directory cannot be replaced, so moving the wiki fails
+			// after the repository directory has been moved.
+			blocker := filepath.Join(WikiPath("owner", "blocked"), "HEAD")
+			So(os.MkdirAll(blocker, os.ModePerm), ShouldBeNil)
+
+			So(ChangeRepositoryName(owner, "repo", "blocked"), ShouldNotBeNil)
+			So(com.IsExist(RepoPath("owner", "repo")), ShouldBeTrue)
+			So(com.IsExist(RepoPath("owner", "blocked")), ShouldBeFalse)
+
+			_, err := GetRepositoryByName(owner.ID, "repo")
+			So(err, ShouldBeNil)
+			redirects, err := GetRepoRedirects(repo.ID)
+			So(err, ShouldBeNil)
+			So(redirects, ShouldBeEmpty)
+		})
+
+		So(ChangeRepositoryName(owner, "repo", "renamed"), ShouldBeNil)
+		So(com.IsExist(RepoPath("owner", "repo")), ShouldBeFalse)
+		So(com.IsExist(RepoPath("owner", "renamed")), ShouldBeTrue)
+		So(com.IsExist(WikiPath("owner", "renamed")), ShouldBeTrue)
+
+		redirects, err := GetRepoRedirects(repo.ID)
+		So(err, ShouldBeNil)
+		So(redirects, ShouldHaveLength, 1)
+		So(redirects[0].LowerName, ShouldEqual, "repo")
+	})
+}
+
+func Test_GetRepositoryByOldName(t *testing.T) {
+	setupTestDB(t)
+
+	oldRoot := conf.Repository.Root
+	conf.Repository.Root = t.TempDir()
+	defer func() { conf.Repository.Root = oldRoot }()
+
+	alice := &User{Name: "alice", LowerName: "alice", Email: "alice@example.com"}
+	bob := &User{Name: "bob", LowerName: "bob", Email: "bob@example.com"}
+	insertTestBeans(t, alice, bob)
+	repo := &Repository{OwnerID: alice.ID, Name: "old", LowerName: "old"}
+	insertTestBeans(t, repo)
+	if err := os.MkdirAll(RepoPath("alice", "old"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	assertRepoByName := func(ownerID int64, name string, expRepoID int64) {
+		r, err := GetRepositoryByName(ownerID, name)
+		if expRepoID == 0 {
+			So(errors.IsRepoNotExist(err), ShouldBeTrue)
+			return
+		}
+		So(err, ShouldBeNil)
+		So(r.ID, ShouldEqual, expRepoID)
+	}
+	assertRepoByOldName := func(ownerID int64, name string, expRepoID int64) {
+		r, err := GetRepositoryByOldName(ownerID, name)
+		if expRepoID == 0 {
+			So(errors.IsRepoNotExist(err), ShouldBeTrue)
+			return
+		}
+		So(err, ShouldBeNil)
+		So(r.ID, ShouldEqual, expRepoID)
+	}
+
+	Convey("The old name leads to the renamed repository", t, func() {
+		So(ChangeRepositoryName(alice, "old", "new"), ShouldBeNil)
+
+		assertRepoByName(alice.ID, "old", 0)
+		assertRepoByName(alice.ID, "new", repo.ID)
+		assertRepoByOldName(alice.ID, "OLD", repo.ID)
+		assertRepoByOldName(alice.ID, "new", 0)
+
+		// Former names are only redirected under the owner.
+		assertRepoByOldName(bob.ID, "old", 0)
+	})
+
+	var reclaimer *Repository
+	Convey("A new repository with the old name wins", t, func() {
+		reclaimer = &Repository{OwnerID: alice.ID, Owner: alice, Name: "old", LowerName: "old"}
+		sess := x.NewSession()
+		defer sess.Close()
+		So(sess.Begin(), ShouldBeNil)
+		So(createRepository(sess, alice, alice, reclaimer), ShouldBeNil)
+		So(sess.Commit(), ShouldBeNil)
+
+		assertRepoByName(alice.ID, "old", reclaimer.ID)
+		assertRepoByOldName(alice.ID, "old", 0)
+		redirects, err := GetRepoRedirects(repo.ID)
+		So(err, ShouldBeNil)
+		So(redirects, ShouldBeEmpty)
+	})
+
+	Convey("Redirects do not follow the repository to another owner", t, func() {
+		So(ChangeRepositoryName(alice, "new", "newer"), ShouldBeNil)
+		assertRepoByOldName(alice.ID, "new", repo.ID)
+
+		repo, err := GetRepositoryByID(repo.ID)
+		So(err, ShouldBeNil)
+		So(TransferOwnership(alice, "bob", repo), ShouldBeNil)
+
+		assertRepoByName(bob.ID, "newer", repo.ID)
+		assertRepoByOldName(alice.ID, "new", 0)
+		assertRepoByOldName(alice.ID, "newer", 0)
+		assertRepoByOldName(bob.ID, "new", 0)
+		assertRepoByName(alice.ID, "old", reclaimer.ID)
+	})
+}
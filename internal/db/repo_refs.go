@@ -0,0 +1,151 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gogs.io/gogs/internal/process"
+)
+
+// Kinds of references.
+const (
+	REF_KIND_BRANCH = "branch"
+	REF_KIND_TAG    = "tag"
+)
+
+// Orders of listing references.
+const (
+	REF_SORT_NAME          = "name"
+	REF_SORT_COMMITTERDATE = "committerdate"
+)
+
+// Reference is a branch or a tag without its commit loaded.
+type Reference struct {
+	Name     string
+	CommitID string
+	Updated  time.Time
+}
+
+// refFormat is the format of "git for-each-ref" output parsed by parseRef, one
+// reference per line. The peeled object ID is only present for annotated tags.
+const refFormat = "%(refname)%00%(objectname)%00%(*objectname)%00%(creatordate:unix)"
+
+// parseRef parses a line of "git for-each-ref" output in refFormat and strips
+// the prefix from the reference name. It returns nil if the line is malformed
+// or the reference does not have the prefix.
+func parseRef(line, prefix string) *Reference {
+	fields := strings.Split(line, "\x00")
+	if len(fields) != 4 || !strings.HasPrefix(fields[0], prefix) {
+		return nil
+	}
+
+	ref := &Reference{
+		Name:     strings.TrimPrefix(fields[0], prefix),
+		CommitID: fields[1],
+	}
+	if fields[2] != "" {
+		ref.CommitID = fields[2]
+	}
+	if unix, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+		ref.Updated = time.Unix(unix, 0)
+	}
+	return ref
+}
+
+// refPage is written with output of "git for-each-ref" in refFormat. It only
+// parses and keeps references in the range [start, end), and counts others, so
+// that repositories with many references are not loaded into memory at once.
+type refPage struct {
+	prefix     string
+	start, end int
+
+	refs    []*Reference
+	total   int
+	partial []byte // Incomplete line of the last write
+}
+
+func (p *refPage) Write(b []byte) (int, error) {
+	n := len(b)
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			p.partial = append(p.partial, b...)
+			break
+		}
+
+		if p.total >= p.start && p.total < p.end {
+			if ref := parseRef(string(append(p.partial, b[:i]...)), p.prefix); ref != nil {
+				p.refs = append(p.refs, ref)
+			}
+		}
+		p.partial = p.partial[:0]
+		p.total++
+		b = b[i+1:]
+	}
+	return n, nil
+}
+
+// listRefs returns a page of branches or tags of the repository at the path,
+// along with the total number of them.
+func listRefs(repoPath, kind, sort string, page, perPage int) ([]*Reference, int, error) {
+	var prefix string
+	switch kind {
+	case REF_KIND_BRANCH:
+		prefix = "refs/heads/"
+	case REF_KIND_TAG:
+		prefix = "refs/tags/"
+	default:
+		return nil, 0, fmt.Errorf("unknown reference kind %q", kind)
+	}
+
+	var sortKey string
+	switch sort {
+	case "", REF_SORT_NAME:
+		sortKey = "refname"
+	case REF_SORT_COMMITTERDATE:
+		// Creator date is the committer date for commits, and the tagger date
+		// for annotated tags, which have no committer date. Newest first.
+		sortKey = "-creatordate"
+	default:
+		return nil, 0, fmt.Errorf("unknown reference sort %q", sort)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		return nil, 0, fmt.Errorf("invalid page size %d", perPage)
+	}
+
+	// Only names of references and their objects are read, which is much cheaper
+	// than loading commits of all references. Git still has to go through all
+	// references to sort them, but only the page is kept.
+	start := (page - 1) * perPage
+	p := &refPage{
+		prefix: prefix,
+		start:  start,
+		end:    start + perPage,
+		refs:   make([]*Reference, 0, perPage),
+	}
+	stderr, err := process.ExecDirContext(context.Background(), repoPath,
+		fmt.Sprintf("listRefs 'git for-each-ref': %s", repoPath),
+		p, "git", "for-each-ref", "--sort="+sortKey, "--format="+refFormat, prefix)
+	if err != nil {
+		return nil, 0, fmt.Errorf("git for-each-ref: %v - %s", err, stderr)
+	}
+	return p.refs, p.total, nil
+}
+
+// ListRefs returns a page of branches or tags of the repository sorted by name
+// or committer date, along with the total number of them.
+func (repo *Repository) ListRefs(kind, sort string, page, perPage int) ([]*Reference, int, error) {
+	return listRefs(repo.RepoPath(), kind, sort, page, perPage)
+}
@@ -0,0 +1,186 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"container/list"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gogs/git-module"
+)
+
+// refNamesCacheSize is the maximum number of branch or tag lists kept in
+// memory.
+const refNamesCacheSize = 1000
+
+// refNamesRacyWindow is how recent a modification of references can be for
+// the list of names to still be cached. Modification times of some file
+// systems are only precise to seconds, so a reference updated right after
+// the list is loaded could otherwise go unnoticed.
+const refNamesRacyWindow = 2 * time.Second
+
+type refNamesCacheKey struct {
+	repoID int64
+	kind   string // REF_KIND_BRANCH or REF_KIND_TAG
+}
+
+// refsStamp identifies a state of references of a kind on disk. Git updates
+// loose references by renaming lock files and rewrites "packed-refs" as a
+// whole, both of which change modification times of the files or their
+// parent directories.
+type refsStamp struct {
+	packedModTime int64
+	packedSize    int64
+	looseModTime  int64
+}
+
+func (s refsStamp) latest() time.Time {
+	latest := s.packedModTime
+	if s.looseModTime > latest {
+		latest = s.looseModTime
+	}
+	return time.Unix(0, latest)
+}
+
+// getRefsStamp returns the stamp of references of the kind in the repository
+// at the path.
+func getRefsStamp(repoPath, kind string) (refsStamp, error) {
+	var stamp refsStamp
+	fi, err := os.Stat(filepath.Join(repoPath, "packed-refs"))
+	if err == nil {
+		stamp.packedModTime = fi.ModTime().UnixNano()
+		stamp.packedSize = fi.Size()
+	} else if !os.IsNotExist(err) {
+		return stamp, err
+	}
+
+	dir := "refs/heads"
+	if kind == REF_KIND_TAG {
+		dir = "refs/tags"
+	}
+	err = filepath.Walk(filepath.Join(repoPath, dir), func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if fi.IsDir() && fi.ModTime().UnixNano() > stamp.looseModTime {
+			stamp.looseModTime = fi.ModTime().UnixNano()
+		}
+		return nil
+	})
+	return stamp, err
+}
+
+type refNamesCacheEntry struct {
+	key   refNamesCacheKey
+	stamp refsStamp
+	names []string
+}
+
+// refNamesCache keeps the least recently used lists of branch and tag names of
+// repositories, which are valid as long as the stamp of the references stays
+// the same.
+var refNamesCache = struct {
+	sync.Mutex
+	ll      *list.List
+	entries map[refNamesCacheKey]*list.Element
+}{
+	ll:      list.New(),
+	entries: make(map[refNamesCacheKey]*list.Element),
+}
+
+func getRefNamesCache(key refNamesCacheKey) (*refNamesCacheEntry, bool) {
+	refNamesCache.Lock()
+	defer refNamesCache.Unlock()
+
+	elem, ok := refNamesCache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	refNamesCache.ll.MoveToFront(elem)
+	return elem.Value.(*refNamesCacheEntry), true
+}
+
+func putRefNamesCache(entry *refNamesCacheEntry) {
+	refNamesCache.Lock()
+	defer refNamesCache.Unlock()
+
+	if elem, ok := refNamesCache.entries[entry.key]; ok {
+		elem.Value = entry
+		refNamesCache.ll.MoveToFront(elem)
+		return
+	}
+	refNamesCache.entries[entry.key] = refNamesCache.ll.PushFront(entry)
+	for refNamesCache.ll.Len() > refNamesCacheSize {
+		oldest := refNamesCache.ll.Back()
+		refNamesCache.ll.Remove(oldest)
+		delete(refNamesCache.entries, oldest.Value.(*refNamesCacheEntry).key)
+	}
+}
+
+// ClearRefNamesCache removes branch and tag names of the repository with given
+// ID from the cache.
+func ClearRefNamesCache(repoID int64) {
+	refNamesCache.Lock()
+	defer refNamesCache.Unlock()
+
+	for _, kind := range []string{REF_KIND_BRANCH, REF_KIND_TAG} {
+		key := refNamesCacheKey{repoID: repoID, kind: kind}
+		if elem, ok := refNamesCache.entries[key]; ok {
+			refNamesCache.ll.Remove(elem)
+			delete(refNamesCache.entries, key)
+		}
+	}
+}
+
+// getRefNames returns names of references of the kind, loading them with the
+// function if the cached ones are missing or stale.
+func (repo *Repository) getRefNames(gitRepo *git.Repository, kind string, load func() ([]string, error)) ([]string, error) {
+	stamp, err := getRefsStamp(gitRepo.Path, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	key := refNamesCacheKey{repoID: repo.ID, kind: kind}
+	if entry, ok := getRefNamesCache(key); ok && entry.stamp == stamp {
+		return copyStrings(entry.names), nil
+	}
+
+	names, err := load()
+	if err != nil {
+		return nil, err
+	}
+	if time.Since(stamp.latest()) >= refNamesRacyWindow {
+		putRefNamesCache(&refNamesCacheEntry{
+			key:   key,
+			stamp: stamp,
+			names: copyStrings(names),
+		})
+	}
+	return names, nil
+}
+
+// copyStrings returns a copy of the list, which is never nil, so that callers
+// can modify lists returned from the cache.
+func copyStrings(list []string) []string {
+	return append(make([]string, 0, len(list)), list...)
+}
+
+// GetBranchNames returns names of all branches of the repository, which are
+// cached until any branch is changed.
+func (repo *Repository) GetBranchNames(gitRepo *git.Repository) ([]string, error) {
+	return repo.getRefNames(gitRepo, REF_KIND_BRANCH, gitRepo.GetBranches)
+}
+
+// GetTagNames returns names of all tags of the repository, latest created
+// first, which are cached until any tag is changed.
+func (repo *Repository) GetTagNames(gitRepo *git.Repository) ([]string, error) {
+	return repo.getRefNames(gitRepo, REF_KIND_TAG, gitRepo.GetTags)
+}
@@ -0,0 +1,210 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+// ageRefs moves modification times of references of the repository out of
+// the racy window, as if they were last changed an hour ago.
+func ageRefs(tb testing.TB, repoPath string) {
+	old := time.Now().Add(-time.Hour)
+	paths := []string{filepath.Join(repoPath, "packed-refs")}
+	err := filepath.Walk(filepath.Join(repoPath, "refs"), func(path string, _ os.FileInfo, err error) error {
+		paths = append(paths, path)
+		return err
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	for _, path := range paths {
+		if err = os.Chtimes(path, old, old); err != nil && !os.IsNotExist(err) {
+			tb.Fatal(err)
+		}
+	}
+}
+
+func updateRef(tb testing.TB, repoPath string, args ...string) {
+	testutil.OpenGitRepo(tb, repoPath).Git(append([]string{"update-ref"}, args...)...)
+}
+
+func Test_GetBranchNames(t *testing.T) {
+	repoPath := newRefsRepo(t, 3)
+	gitRepo, err := git.OpenRepository(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitID, err := gitRepo.GetBranchCommitID("branch-00000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &Repository{ID: 1003}
+	defer ClearRefNamesCache(repo.ID)
+	key := refNamesCacheKey{repoID: repo.ID, kind: REF_KIND_BRANCH}
+
+	Convey("Cache branch names until references change", t, func() {
+		ageRefs(t, repoPath)
+		names, err := repo.GetBranchNames(gitRepo)
+		So(err, ShouldBeNil)
+		So(names, ShouldContain, "branch-00000")
+		_, ok := getRefNamesCache(key)
+		So(ok, ShouldBeTrue)
+
+		Convey("Modifying the returned list does not change the cache", func() {
+			names[0] = "modified"
+			names, err = repo.GetBranchNames(gitRepo)
+			So(err, ShouldBeNil)
+			So(names[0], ShouldEqual, "branch-00000")
+		})
+
+		Convey("A pushed branch busts the cache", func() {
+			updateRef(t, repoPath, "refs/heads/feature/new", commitID)
+			names, err = repo.GetBranchNames(gitRepo)
+			So(err, ShouldBeNil)
+			So(names, ShouldContain, "feature/new")
+
+			Convey("So does a branch pushed into an existing directory", func() {
+				ageRefs(t, repoPath)
+				_, err = repo.GetBranchNames(gitRepo)
+				So(err, ShouldBeNil)
+
+				updateRef(t, repoPath, "refs/heads/feature/newer", commitID)
+				names, err = repo.GetBranchNames(gitRepo)
+				So(err, ShouldBeNil)
+				So(names, ShouldContain, "feature/newer")
+			})
+		})
+
+		Convey("A deleted branch busts the cache", func() {
+			updateRef(t, repoPath, "-d", "refs/heads/branch-00002")
+			names, err = repo.GetBranchNames(gitRepo)
+			So(err, ShouldBeNil)
+			So(names, ShouldNotContain, "branch-00002")
+		})
+
+		Convey("Packing references busts the cache", func() {
+			testutil.OpenGitRepo(t, repoPath).Git("pack-refs", "--all")
+			updateRef(t, repoPath, "-d", "refs/heads/branch-00001")
+			ageRefs(t, repoPath)
+
+			names, err = repo.GetBranchNames(gitRepo)
+			So(err, ShouldBeNil)
+			So(names, ShouldNotContain, "branch-00001")
+		})
+	})
+
+	Convey("Recently changed references are not cached", t, func() {
+		ClearRefNamesCache(repo.ID)
+		updateRef(t, repoPath, "refs/heads/recent", commitID)
+		names, err := repo.GetBranchNames(gitRepo)
+		So(err, ShouldBeNil)
+		So(names, ShouldContain, "recent")
+		_, ok := getRefNamesCache(key)
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("Clear cache of a repository", t, func() {
+		ageRefs(t, repoPath)
+		_, err := repo.GetBranchNames(gitRepo)
+		So(err, ShouldBeNil)
+		ClearRefNamesCache(repo.ID)
+		_, ok := getRefNamesCache(key)
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func Test_GetTagNames(t *testing.T) {
+	repoPath := newRefsRepo(t, 1)
+	gitRepo, err := git.OpenRepository(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitID, err := gitRepo.GetBranchCommitID("branch-00000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &Repository{ID: 1004}
+	defer ClearRefNamesCache(repo.ID)
+
+	Convey("Cache tag names until tags change", t, func() {
+		ageRefs(t, repoPath)
+		names, err := repo.GetTagNames(gitRepo)
+		So(err, ShouldBeNil)
+		So(names, ShouldBeEmpty)
+
+		updateRef(t, repoPath, "refs/tags/v1.0", commitID)
+		names, err = repo.GetTagNames(gitRepo)
+		So(err, ShouldBeNil)
+		So(names, ShouldResemble, []string{"v1.0"})
+
+		Convey("Branches are cached separately", func() {
+			ageRefs(t, repoPath)
+			_, err = repo.GetBranchNames(gitRepo)
+			So(err, ShouldBeNil)
+			_, err = repo.GetTagNames(gitRepo)
+			So(err, ShouldBeNil)
+
+			updateRef(t, repoPath, "refs/tags/v1.1", commitID)
+			entry, ok := getRefNamesCache(refNamesCacheKey{repoID: repo.ID, kind: REF_KIND_BRANCH})
+			So(ok, ShouldBeTrue)
+			stamp, err := getRefsStamp(repoPath, REF_KIND_BRANCH)
+			So(err, ShouldBeNil)
+			So(entry.stamp, ShouldResemble, stamp)
+		})
+	})
+}
+
+func Test_GetBranchNames_concurrent(t *testing.T) {
+	repoPath := newRefsRepo(t, 10)
+	ageRefs(t, repoPath)
+	gitRepo, err := git.OpenRepository(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const numRepos = 4
+	defer func() {
+		for i := 0; i < numRepos; i++ {
+			ClearRefNamesCache(int64(-1000 - i))
+		}
+	}()
+
+	Convey("Concurrent requests share the cache", t, func() {
+		var wg sync.WaitGroup
+		errs := make(chan error, 50)
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				repo := &Repository{ID: int64(-1000 - i%numRepos)}
+				if i%10 == 0 {
+					ClearRefNamesCache(repo.ID)
+				}
+				names, err := repo.GetBranchNames(gitRepo)
+				if err != nil {
+					errs <- err
+				} else if len(names) != 10 {
+					errs <- fmt.Errorf("got %d branches", len(names))
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			So(err, ShouldBeNil)
+		}
+	})
+}
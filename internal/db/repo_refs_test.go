@@ -0,0 +1,113 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_parseRef(t *testing.T) {
+	Convey("Parse a line of git for-each-ref output", t, func() {
+		ref := parseRef("refs/tags/v1.0\x00aaaa\x00bbbb\x001577836800", "refs/tags/")
+		So(ref, ShouldNotBeNil)
+		So(ref.Name, ShouldEqual, "v1.0")
+		So(ref.CommitID, ShouldEqual, "bbbb")
+		So(ref.Updated.Unix(), ShouldEqual, 1577836800)
+
+		ref = parseRef("refs/tags/v1.1\x00cccc\x00\x001577923200", "refs/tags/")
+		So(ref, ShouldNotBeNil)
+		So(ref.CommitID, ShouldEqual, "cccc")
+	})
+
+	Convey("Skip malformed lines and references without the prefix", t, func() {
+		So(parseRef("", "refs/heads/"), ShouldBeNil)
+		So(parseRef("refs/tags/v1.0\x00aaaa\x00\x001577836800", "refs/heads/"), ShouldBeNil)
+	})
+}
+
+func Test_refPage(t *testing.T) {
+	Convey("Only keep references of the page from output written in chunks", t, func() {
+		var stdout strings.Builder
+		for i := 0; i < 5; i++ {
+			fmt.Fprintf(&stdout, "refs/heads/branch-%d\x00aaaa\x00\x001577836800\n", i)
+		}
+
+		p := &refPage{prefix: "refs/heads/", start: 2, end: 4}
+		out := stdout.String()
+		for len(out) > 0 {
+			n := 7
+			if n > len(out) {
+				n = len(out)
+			}
+			_, _ = p.Write([]byte(out[:n]))
+			out = out[n:]
+		}
+		So(p.total, ShouldEqual, 5)
+		So(p.refs, ShouldHaveLength, 2)
+		So(p.refs[0].Name, ShouldEqual, "branch-2")
+		So(p.refs[1].Name, ShouldEqual, "branch-3")
+	})
+}
+
+// newRefsRepo creates a repository with given number of branches pointing to
+// the same commit.
+func newRefsRepo(tb testing.TB, n int) string {
+	repo := testutil.InitGitRepo(tb, "", "--bare")
+	tree := repo.GitWithStdin("", "hash-object", "-t", "tree", "-w", "--stdin")
+	commit := repo.GitWithStdin("init", "commit-tree", tree)
+
+	var updates strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&updates, "create refs/heads/branch-%05d %s\n", i, commit)
+	}
+	repo.GitWithStdin(updates.String(), "update-ref", "--stdin")
+	return repo.Dir
+}
+
+func Test_listRefs(t *testing.T) {
+	repoPath := newRefsRepo(t, 25)
+
+	Convey("List branches by page", t, func() {
+		refs, total, err := listRefs(repoPath, REF_KIND_BRANCH, REF_SORT_NAME, 3, 10)
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 25)
+		So(refs, ShouldHaveLength, 5)
+		So(refs[0].Name, ShouldEqual, "branch-00020")
+
+		refs, total, err = listRefs(repoPath, REF_KIND_BRANCH, REF_SORT_COMMITTERDATE, 4, 10)
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 25)
+		So(refs, ShouldBeEmpty)
+
+		refs, total, err = listRefs(repoPath, REF_KIND_TAG, REF_SORT_NAME, 1, 10)
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 0)
+		So(refs, ShouldBeEmpty)
+	})
+
+	Convey("Reject unknown kinds and orders", t, func() {
+		_, _, err := listRefs(repoPath, "note", REF_SORT_NAME, 1, 10)
+		So(err, ShouldNotBeNil)
+		_, _, err = listRefs(repoPath, REF_KIND_BRANCH, "size", 1, 10)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func Benchmark_listRefs(b *testing.B) {
+	repoPath := newRefsRepo(b, 10000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := listRefs(repoPath, REF_KIND_BRANCH, REF_SORT_COMMITTERDATE, 50, 30); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+
+	log "unknwon.dev/clog/v2"
+)
+
+// RepoRelation is a repository that is related to another repository, with
+// the score of how closely they are related. Relations are recomputed
+// periodically by UpdateRepoRelations, and must be filtered by permissions of
+// the viewer when rendered since private repositories are included.
+type RepoRelation struct {
+	ID            int64
+	RepoID        int64 `xorm:"UNIQUE(s)"`
+	RelatedRepoID int64 `xorm:"UNIQUE(s) INDEX"`
+	Score         int64
+}
+
+// Weights of what repositories have in common, which are added to the score
+// of their relation for everything they share.
+const (
+	relationWeightContributor = 3 // A user who pushed to both repositories.
+	relationWeightStargazer   = 2 // A user who starred both repositories.
+	relationWeightDependency  = 1 // A package that both repositories depend on.
+)
+
+const (
+	// maxRelationGroupSize is the maximum number of repositories that share
+	// something for it to be counted. Something shared by more repositories,
+	// e.g. a very popular package, says little about how they are related.
+	maxRelationGroupSize = 100
+	// maxRepoRelations is the number of most related repositories that are
+	// kept for each repository.
+	maxRepoRelations = 10
+)
+
+// relationGroup is repositories that share something, e.g. a stargazer.
+type relationGroup struct {
+	weight  int64
+	repoIDs []int64
+}
+
+// scoreRepoRelations returns the most related repositories of every
+// repository in the groups by overlap counts weighted by groups, the most
+// related first.
+func scoreRepoRelations(groups []*relationGroup, limit int) map[int64][]*RepoRelation {
+	scores := make(map[int64]map[int64]int64)
+	for _, g := range groups {
+		if len(g.repoIDs) < 2 || len(g.repoIDs) > maxRelationGroupSize {
+			continue
+		}
+		for _, a := range g.repoIDs {
+			for _, b := range g.repoIDs {
+				if a == b {
+					continue
+				}
+				if scores[a] == nil {
+					scores[a] = make(map[int64]int64)
+				}
+				scores[a][b] += g.weight
+			}
+		}
+	}
+
+	relations := make(map[int64][]*RepoRelation, len(scores))
+	for repoID, related := range scores {
+		list := make([]*RepoRelation, 0, len(related))
+		for relatedRepoID, score := range related {
+			list = append(list, &RepoRelation{
+				RepoID:        repoID,
+				RelatedRepoID: relatedRepoID,
+				Score:         score,
+			})
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Score != list[j].Score {
+				return list[i].Score > list[j].Score
+			}
+			return list[i].RelatedRepoID < list[j].RelatedRepoID
+		})
+		if len(list) > limit {
+			list = list[:limit]
+		}
+		relations[repoID] = list
+	}
+	return relations
+}
+
+// groupRepoIDs adds the repository to the group of the key.
+func groupRepoIDs(groups map[string]*relationGroup, key string, weight, repoID int64) {
+	g := groups[key]
+	if g == nil {
+		g = &relationGroup{weight: weight}
+		groups[key] = g
+	}
+	g.repoIDs = append(g.repoIDs, repoID)
+}
+
+// repoRelationGroups returns repositories grouped by their contributors,
+// stargazers and dependencies, every repository appears at most once in a
+// group.
+func repoRelationGroups() ([]*relationGroup, error) {
+	groups := make(map[string]*relationGroup)
+
+	var pushes []*struct {
+		ActUserID int64
+		RepoID    int64
+	}
+	if err := x.Table("action").Distinct("act_user_id", "repo_id").
+		Where("op_type = ?", ACTION_COMMIT_REPO).
+		Find(&pushes); err != nil {
+		return nil, fmt.Errorf("find pushes: %v", err)
+	}
+	for _, p := range pushes {
+		groupRepoIDs(groups, fmt.Sprintf("contributor:%d", p.ActUserID), relationWeightContributor, p.RepoID)
+	}
+
+	stars := make([]*Star, 0, 100)
+	if err := x.Find(&stars); err != nil {
+		return nil, fmt.Errorf("find stars: %v", err)
+	}
+	for _, s := range stars {
+		groupRepoIDs(groups, fmt.Sprintf("stargazer:%d", s.UID), relationWeightStargazer, s.RepoID)
+	}
+
+	var deps []*struct {
+		RepoID    int64
+		Ecosystem string
+		LowerName string
+	}
+	if err := x.Table("repo_dependency").Distinct("repo_id", "ecosystem", "lower_name").Find(&deps); err != nil {
+		return nil, fmt.Errorf("find dependencies: %v", err)
+	}
+	for _, d := range deps {
+		groupRepoIDs(groups, "dependency:"+d.Ecosystem+":"+d.LowerName, relationWeightDependency, d.RepoID)
+	}
+
+	list := make([]*relationGroup, 0, len(groups))
+	for _, g := range groups {
+		list = append(list, g)
+	}
+	return list, nil
+}
+
+// UpdateRepoRelations recomputes related repositories of all repositories.
+func UpdateRepoRelations() {
+	if taskStatusTable.IsRunning(_REPO_RELATIONS) {
+		return
+	}
+	taskStatusTable.Start(_REPO_RELATIONS)
+	defer taskStatusTable.Stop(_REPO_RELATIONS)
+
+	log.Trace("Doing: UpdateRepoRelations")
+
+	if err := updateRepoRelations(); err != nil {
+		log.Error("Failed to update repository relations: %v", err)
+	}
+}
+
+func updateRepoRelations() error {
+	groups, err := repoRelationGroups()
+	if err != nil {
+		return err
+	}
+	relations := scoreRepoRelations(groups, maxRepoRelations)
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err = sess.Where("id > 0").Delete(new(RepoRelation)); err != nil {
+		return fmt.Errorf("delete relations: %v", err)
+	}
+	for _, list := range relations {
+		if _, err = sess.Insert(list); err != nil {
+			return fmt.Errorf("insert relations: %v", err)
+		}
+	}
+	return sess.Commit()
+}
+
+// readableRepositories returns repositories with the IDs in the same order
+// that the user can read, which can be nil for anonymous visitors.
+func readableRepositories(u *User, repoIDs []int64) ([]*Repository, error) {
+	if len(repoIDs) == 0 {
+		return nil, nil
+	}
+	found := make([]*Repository, 0, len(repoIDs))
+	if err := x.In("id", repoIDs).Find(&found); err != nil {
+		return nil, fmt.Errorf("find repositories: %v", err)
+	}
+	repoByID := make(map[int64]*Repository, len(found))
+	for _, repo := range found {
+		repoByID[repo.ID] = repo
+	}
+
+	repos := make([]*Repository, 0, len(repoIDs))
+	for _, id := range repoIDs {
+		repo := repoByID[id]
+		if repo == nil {
+			continue
+		}
+		mode, err := EffectiveAccessMode(u, repo)
+		if err != nil {
+			return nil, fmt.Errorf("EffectiveAccessMode [repo_id: %d]: %v", repo.ID, err)
+		} else if mode >= ACCESS_MODE_READ {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, nil
+}
+
+// GetRelatedRepositories returns at most limit repositories that are related
+// to the repository and the user can read, the most related first.
+func (repo *Repository) GetRelatedRepositories(u *User, limit int) ([]*Repository, error) {
+	relations := make([]*RepoRelation, 0, maxRepoRelations)
+	if err := x.Where("repo_id = ?", repo.ID).Desc("score").Asc("related_repo_id").Find(&relations); err != nil {
+		return nil, fmt.Errorf("find relations: %v", err)
+	}
+	repoIDs := make([]int64, len(relations))
+	for i := range relations {
+		repoIDs[i] = relations[i].RelatedRepoID
+	}
+
+	repos, err := readableRepositories(u, repoIDs)
+	if err != nil {
+		return nil, err
+	} else if len(repos) > limit {
+		repos = repos[:limit]
+	}
+	return repos, RepositoryList(repos).LoadAttributes()
+}
+
+// maxRecommendationSeeds is the maximum number of repositories that the user
+// starred or contributed to that recommendations are based on.
+const maxRecommendationSeeds = 200
+
+// RecommendRepositories returns at most limit repositories that the user can
+// read and are related to the repositories the user starred or contributed
+// to, excluding those repositories and the ones the user owns.
+func RecommendRepositories(u *User, limit int) ([]*Repository, error) {
+	seedIDs := make([]int64, 0, 10)
+	if err := x.Table("star").Cols("repo_id").Where("uid = ?", u.ID).
+		Desc("id").Limit(maxRecommendationSeeds).Find(&seedIDs); err != nil {
+		return nil, fmt.Errorf("find starred repositories: %v", err)
+	}
+	contributedIDs := make([]int64, 0, 10)
+	if err := x.Table("action").Distinct("repo_id").
+		Where("act_user_id = ? AND op_type = ?", u.ID, ACTION_COMMIT_REPO).
+		Limit(maxRecommendationSeeds).Find(&contributedIDs); err != nil {
+		return nil, fmt.Errorf("find contributed repositories: %v", err)
+	}
+	seedIDs = append(seedIDs, contributedIDs...)
+	if len(seedIDs) == 0 {
+		return nil, nil
+	}
+
+	var candidates []*struct {
+		RelatedRepoID int64
+		TotalScore    int64
+	}
+	if err := x.Table("repo_relation").Select("related_repo_id, SUM(score) AS total_score").
+		In("repo_id", seedIDs).
+		NotIn("related_repo_id", seedIDs).
+		GroupBy("related_repo_id").
+		OrderBy("total_score DESC, related_repo_id ASC").
+		Limit(limit * 3).
+		Find(&candidates); err != nil {
+		return nil, fmt.Errorf("find candidates: %v", err)
+	}
+	repoIDs := make([]int64, len(candidates))
+	for i := range candidates {
+		repoIDs[i] = candidates[i].RelatedRepoID
+	}
+
+	readable, err := readableRepositories(u, repoIDs)
+	if err != nil {
+		return nil, err
+	}
+	repos := make([]*Repository, 0, limit)
+	for _, repo := range readable {
+		if repo.OwnerID == u.ID {
+			continue
+		}
+		repos = append(repos, repo)
+		if len(repos) == limit {
+			break
+		}
+	}
+	return repos, RepositoryList(repos).LoadAttributes()
+}
@@ -0,0 +1,52 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_scoreRepoRelations(t *testing.T) {
+	Convey("Score relations of repositories by weighted overlaps", t, func() {
+		relatedIDs := func(relations []*RepoRelation) []int64 {
+			ids := make([]int64, len(relations))
+			for i := range relations {
+				ids[i] = relations[i].RelatedRepoID
+			}
+			return ids
+		}
+
+		groups := []*relationGroup{
+			{weight: relationWeightContributor, repoIDs: []int64{1, 2}},
+			{weight: relationWeightStargazer, repoIDs: []int64{1, 3}},
+			{weight: relationWeightStargazer, repoIDs: []int64{1, 3, 4}},
+			{weight: relationWeightDependency, repoIDs: []int64{1, 4}},
+			{weight: relationWeightDependency, repoIDs: []int64{5}},
+		}
+		relations := scoreRepoRelations(groups, 10)
+		So(relatedIDs(relations[1]), ShouldResemble, []int64{3, 2, 4})
+		So(relations[1][0].Score, ShouldEqual, 4)
+		So(relations[1][1].Score, ShouldEqual, 3)
+		So(relations[1][2].Score, ShouldEqual, 3)
+		So(relatedIDs(relations[4]), ShouldResemble, []int64{1, 3})
+		So(relations[5], ShouldBeNil)
+
+		Convey("Keep only the most related repositories", func() {
+			relations := scoreRepoRelations(groups, 1)
+			So(relatedIDs(relations[1]), ShouldResemble, []int64{3})
+		})
+
+		Convey("Skip groups that are too large", func() {
+			repoIDs := make([]int64, maxRelationGroupSize+1)
+			for i := range repoIDs {
+				repoIDs[i] = int64(i + 1)
+			}
+			relations := scoreRepoRelations([]*relationGroup{{weight: 1, repoIDs: repoIDs}}, 10)
+			So(relations, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gogs/git-module"
+	"github.com/unknwon/com"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+// RegenerateRepositoryHooks rewrites delegate hooks of all repositories and
+// their wikis like SyncRepositoryHooks, but does not stop at repositories that
+// fail. It returns the number of repositories and failures of each of them.
+func RegenerateRepositoryHooks() (total int, failures []string, err error) {
+	err = x.Where("id > 0").Iterate(new(Repository),
+		func(idx int, bean interface{}) error {
+			repo := bean.(*Repository)
+			total++
+
+			if err := createDelegateHooks(repo.RepoPath()); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", repo.FullName(), err))
+				return nil
+			}
+			if repo.HasWiki() {
+				if err := createDelegateHooks(repo.WikiPath()); err != nil {
+					failures = append(failures, fmt.Sprintf("%s (wiki): %v", repo.FullName(), err))
+				}
+			}
+			return nil
+		})
+	return total, failures, err
+}
+
+// CountPublicKeys returns the number of public keys, including deploy keys.
+func CountPublicKeys() (int64, error) {
+	return x.Count(new(PublicKey))
+}
+
+// RepoPathsReport is the result of reconciling repository records with their
+// Git directories under "[repository] ROOT".
+type RepoPathsReport struct {
+	// Total is the number of repository records.
+	Total int `json:"total"`
+	// Missing are full names of repositories whose Git directories do not exist.
+	Missing []string `json:"missing"`
+	// Reinitialized are full names of missing repositories that have been
+	// initialized as empty repositories.
+	Reinitialized []string `json:"reinitialized"`
+	// Orphaned are Git directories that no repository record refers to.
+	Orphaned []string `json:"orphaned"`
+}
+
+// CheckRepositoryPaths reconciles repository records with Git directories on
+// disk. Missing repositories are initialized as empty ones when reinit is true,
+// orphaned directories are only reported and never removed.
+func CheckRepositoryPaths(reinit bool) (*RepoPathsReport, error) {
+	report := &RepoPathsReport{
+		Missing:       []string{},
+		Reinitialized: []string{},
+		Orphaned:      []string{},
+	}
+	known := make(map[string]bool)
+	err := x.Where("id > 0").Iterate(new(Repository),
+		func(idx int, bean interface{}) error {
+			repo := bean.(*Repository)
+			report.Total++
+
+			repoPath := repo.RepoPath()
+			known[repoPath] = true
+			known[repo.WikiPath()] = true
+			if com.IsDir(repoPath) {
+				return nil
+			}
+
+			report.Missing = append(report.Missing, repo.FullName())
+			if !reinit {
+				return nil
+			}
+			if err := git.InitRepository(repoPath, true); err != nil {
+				return fmt.Errorf("InitRepository [%s]: %v", repo.FullName(), err)
+			}
+			if err := createDelegateHooks(repoPath); err != nil {
+				return fmt.Errorf("createDelegateHooks [%s]: %v", repo.FullName(), err)
+			}
+			report.Reinitialized = append(report.Reinitialized, repo.FullName())
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("iterate repositories: %v", err)
+	}
+
+	owners, err := ioutil.ReadDir(conf.Repository.Root)
+	if err != nil {
+		return nil, fmt.Errorf("read repository root: %v", err)
+	}
+	for _, owner := range owners {
+		if !owner.IsDir() {
+			continue
+		}
+		ownerPath := filepath.Join(conf.Repository.Root, owner.Name())
+		dirs, err := ioutil.ReadDir(ownerPath)
+		if err != nil {
+			return nil, fmt.Errorf("read directory %q: %v", ownerPath, err)
+		}
+		for _, dir := range dirs {
+			dirPath := filepath.Join(ownerPath, dir.Name())
+			if dir.IsDir() && strings.HasSuffix(dir.Name(), ".git") && !known[dirPath] {
+				report.Orphaned = append(report.Orphaned, dirPath)
+			}
+		}
+	}
+	sort.Strings(report.Orphaned)
+	return report, nil
+}
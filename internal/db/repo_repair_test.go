@@ -0,0 +1,116 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogs/git-module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db/migrations"
+)
+
+// setupRepairTest creates repositories of alice whose Git directories exist
+// for "present" and not for "missing", and an orphaned Git directory.
+func setupRepairTest(t *testing.T) (orphaned string) {
+	setupTestDB(t)
+	// Owners of repositories are loaded while iterating over repositories.
+	x.SetMaxOpenConns(2)
+
+	root := conf.Repository.Root
+	t.Cleanup(func() {
+		conf.Repository.Root = root
+	})
+	conf.Repository.Root = t.TempDir()
+
+	alice := &User{Name: "alice", LowerName: "alice"}
+	insertTestBeans(t, alice)
+	insertTestBeans(t,
+		&Repository{OwnerID: alice.ID, Name: "present", LowerName: "present"},
+		&Repository{OwnerID: alice.ID, Name: "missing", LowerName: "missing"},
+	)
+
+	if err := git.InitRepository(RepoPath("alice", "present"), true); err != nil {
+		t.Fatal(err)
+	}
+	orphaned = RepoPath("alice", "orphaned")
+	if err := git.InitRepository(orphaned, true); err != nil {
+		t.Fatal(err)
+	}
+	// Directories without the ".git" suffix are not Git directories.
+	if err := os.MkdirAll(filepath.Join(conf.Repository.Root, "alice", "tmp"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return orphaned
+}
+
+func Test_CheckRepositoryPaths(t *testing.T) {
+	orphaned := setupRepairTest(t)
+
+	Convey("Report missing and orphaned Git directories", t, func() {
+		report, err := CheckRepositoryPaths(false)
+		So(err, ShouldBeNil)
+		So(report, ShouldResemble, &RepoPathsReport{
+			Total:         2,
+			Missing:       []string{"alice/missing"},
+			Reinitialized: []string{},
+			Orphaned:      []string{orphaned},
+		})
+		_, err = os.Stat(RepoPath("alice", "missing"))
+		So(os.IsNotExist(err), ShouldBeTrue)
+	})
+
+	Convey("Reinitialize missing Git directories", t, func() {
+		report, err := CheckRepositoryPaths(true)
+		So(err, ShouldBeNil)
+		So(report.Missing, ShouldResemble, []string{"alice/missing"})
+		So(report.Reinitialized, ShouldResemble, []string{"alice/missing"})
+		So(report.Orphaned, ShouldResemble, []string{orphaned})
+
+		_, err = os.Stat(filepath.Join(RepoPath("alice", "missing"), "hooks", "pre-receive"))
+		So(err, ShouldBeNil)
+
+		report, err = CheckRepositoryPaths(false)
+		So(err, ShouldBeNil)
+		So(report.Missing, ShouldBeEmpty)
+	})
+}
+
+func Test_RegenerateRepositoryHooks(t *testing.T) {
+	setupRepairTest(t)
+
+	Convey("Regenerate hooks of all repositories and report failures", t, func() {
+		total, failures, err := RegenerateRepositoryHooks()
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 2)
+		So(failures, ShouldHaveLength, 1)
+		So(failures[0], ShouldStartWith, "alice/missing: ")
+
+		_, err = os.Stat(filepath.Join(RepoPath("alice", "present"), "hooks", "update"))
+		So(err, ShouldBeNil)
+	})
+}
+
+func Test_CheckSchemaVersion(t *testing.T) {
+	setupTestDB(t)
+	if err := x.Sync2(new(Version)); err != nil {
+		t.Fatal(err)
+	}
+	insertTestBeans(t, &Version{ID: 1, Version: migrations.ExpectedVersion()})
+
+	Convey("Check the version of the database schema", t, func() {
+		So(CheckSchemaVersion(), ShouldBeNil)
+
+		_, err := x.ID(1).Cols("version").Update(&Version{Version: migrations.ExpectedVersion() - 1})
+		So(err, ShouldBeNil)
+		err = CheckSchemaVersion()
+		So(IsErrSchemaVersionMismatch(err), ShouldBeTrue)
+		So(err, ShouldResemble, ErrSchemaVersionMismatch{Current: migrations.ExpectedVersion() - 1, Expected: migrations.ExpectedVersion()})
+	})
+}
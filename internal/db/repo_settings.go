@@ -0,0 +1,607 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/lazyregexp"
+	"gogs.io/gogs/internal/tool"
+)
+
+// REPO_SETTINGS_VERSION is the version of the repository settings document
+// format.
+const REPO_SETTINGS_VERSION = 1
+
+// RepoSettings is a portable document of repository settings that can be
+// exported from a repository and imported into another one.
+type RepoSettings struct {
+	Version           int                         `yaml:"version"`
+	Merge             *RepoMergeSettings          `yaml:"merge,omitempty"`
+	Labels            []*RepoLabelSetting         `yaml:"labels,omitempty"`
+	Webhooks          []*RepoWebhookSetting       `yaml:"webhooks,omitempty"`
+	ProtectedBranches []*RepoProtectBranchSetting `yaml:"protected_branches,omitempty"`
+	// PushRules are not supported, repositories only inherit push rules from
+	// rule sets of their organizations. Documents that have them are rejected,
+	// rather than imported without them.
+	PushRules interface{} `yaml:"push_rules,omitempty"`
+
+	// warnings are about fields that are unknown to this version.
+	warnings []string
+}
+
+// RepoMergeSettings contains settings of pull requests. Settings that are not
+// present are left unchanged.
+type RepoMergeSettings struct {
+	EnablePulls      *bool `yaml:"enable_pulls,omitempty"`
+	IgnoreWhitespace *bool `yaml:"ignore_whitespace,omitempty"`
+	AllowRebase      *bool `yaml:"allow_rebase,omitempty"`
+}
+
+type RepoLabelSetting struct {
+	Name  string `yaml:"name"`
+	Color string `yaml:"color"`
+}
+
+// RepoWebhookSetting is a webhook identified by its URL. The URL and the secret
+// can be placeholders like "${NAME}" whose values are supplied at import time.
+type RepoWebhookSetting struct {
+	URL         string `yaml:"url"`
+	Type        string `yaml:"type,omitempty"`
+	ContentType string `yaml:"content_type,omitempty"`
+	Secret      string `yaml:"secret,omitempty"`
+	// Events is the list of events to be delivered, "*" for all events.
+	// Default is only the push event.
+	Events []string `yaml:"events,omitempty"`
+	Active *bool    `yaml:"active,omitempty"`
+	Meta   string   `yaml:"meta,omitempty"`
+}
+
+// RepoProtectBranchSetting is a protected branch. Whitelists are only
+// applicable to repositories of organizations.
+type RepoProtectBranchSetting struct {
+	Name               string   `yaml:"name"`
+	RequirePullRequest bool     `yaml:"require_pull_request,omitempty"`
+	WhitelistUsers     []string `yaml:"whitelist_users,omitempty"`
+	WhitelistTeams     []string `yaml:"whitelist_teams,omitempty"`
+}
+
+var placeholderPattern = lazyregexp.New(`^\$\{([A-Za-z0-9_]+)\}$`)
+
+// Placeholders returns names of placeholders used by the document in the order
+// of appearance.
+func (s *RepoSettings) Placeholders() []string {
+	names := make([]string, 0, len(s.Webhooks))
+	seen := make(map[string]bool)
+	for _, w := range s.Webhooks {
+		for _, v := range []string{w.URL, w.Secret} {
+			m := placeholderPattern.FindStringSubmatch(v)
+			if m == nil || seen[m[1]] {
+				continue
+			}
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+// MissingSecrets returns names of placeholders that have no value in secrets.
+func (s *RepoSettings) MissingSecrets(secrets map[string]string) []string {
+	var missing []string
+	for _, name := range s.Placeholders() {
+		if secrets[name] == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// ParseRepoSettings parses the YAML document of repository settings. Fields
+// that are unknown to this version are ignored and reported as warnings by
+// ImportRepoSettings.
+func ParseRepoSettings(data []byte) (*RepoSettings, error) {
+	s := new(RepoSettings)
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return nil, ErrInvalidRepoSettings{Reason: err.Error()}
+	} else if s.Version != REPO_SETTINGS_VERSION {
+		return nil, ErrInvalidRepoSettings{Reason: fmt.Sprintf("unsupported version %d", s.Version)}
+	} else if s.PushRules != nil {
+		return nil, ErrInvalidRepoSettings{Reason: "push rules are not supported, use rule sets of the organization instead"}
+	}
+
+	if err := yaml.UnmarshalStrict(data, new(RepoSettings)); err != nil {
+		if typeErr, ok := err.(*yaml.TypeError); ok {
+			s.warnings = typeErr.Errors
+		}
+	}
+	return s, nil
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+// ExportRepoSettings returns the YAML document of settings of the repository.
+// Secrets of webhooks and URLs of webhooks other than Gogs type, which usually
+// contain tokens, are replaced by placeholders.
+func ExportRepoSettings(repo *Repository) ([]byte, error) {
+	s := &RepoSettings{
+		Version: REPO_SETTINGS_VERSION,
+		Merge: &RepoMergeSettings{
+			EnablePulls:      boolPtr(repo.EnablePulls),
+			IgnoreWhitespace: boolPtr(repo.PullsIgnoreWhitespace),
+			AllowRebase:      boolPtr(repo.PullsAllowRebase),
+		},
+	}
+
+	labels, err := GetLabelsByRepoID(repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetLabelsByRepoID: %v", err)
+	}
+	for _, l := range labels {
+		s.Labels = append(s.Labels, &RepoLabelSetting{
+			Name:  l.Name,
+			Color: l.Color,
+		})
+	}
+
+	webhooks, err := GetWebhooksByRepoID(repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetWebhooksByRepoID: %v", err)
+	}
+	for i, w := range webhooks {
+		hook := &RepoWebhookSetting{
+			URL:         w.URL,
+			Type:        w.HookTaskType.Name(),
+			ContentType: w.ContentType.Name(),
+			Active:      boolPtr(w.IsActive),
+			Meta:        w.Meta,
+		}
+		if w.HookTaskType != GOGS {
+			hook.URL = fmt.Sprintf("${WEBHOOK_%d_URL}", i+1)
+		}
+		if w.Secret != "" {
+			hook.Secret = fmt.Sprintf("${WEBHOOK_%d_SECRET}", i+1)
+		}
+		switch {
+		case w.SendEverything:
+			hook.Events = []string{"*"}
+		case w.ChooseEvents:
+			hook.Events = w.EventsArray()
+		default:
+			hook.Events = []string{string(HOOK_EVENT_PUSH)}
+		}
+		s.Webhooks = append(s.Webhooks, hook)
+	}
+
+	protectBranches, err := GetProtectBranchesByRepoID(repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetProtectBranchesByRepoID: %v", err)
+	}
+	for _, pb := range protectBranches {
+		branch := &RepoProtectBranchSetting{
+			Name:               pb.Name,
+			RequirePullRequest: pb.RequirePullRequest,
+		}
+		if pb.EnableWhitelist {
+			for _, id := range tool.StringsToInt64s(strings.Split(pb.WhitelistUserIDs, ",")) {
+				if u, err := GetUserByID(id); err == nil {
+					branch.WhitelistUsers = append(branch.WhitelistUsers, u.Name)
+				}
+			}
+			for _, id := range tool.StringsToInt64s(strings.Split(pb.WhitelistTeamIDs, ",")) {
+				if t, err := GetTeamByID(id); err == nil {
+					branch.WhitelistTeams = append(branch.WhitelistTeams, t.Name)
+				}
+			}
+		}
+		s.ProtectedBranches = append(s.ProtectedBranches, branch)
+	}
+
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Settings of %s exported by Gogs.\n", repo.FullName())
+	buf.WriteString("# Values like ${NAME} are placeholders to be supplied at import time.\n")
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+type RepoSettingsAction string
+
+const (
+	REPO_SETTINGS_CREATE RepoSettingsAction = "create"
+	REPO_SETTINGS_UPDATE RepoSettingsAction = "update"
+	REPO_SETTINGS_SKIP   RepoSettingsAction = "skip"
+)
+
+// RepoSettingsChange is a change to be made, or skipped with a reason, by
+// importing repository settings.
+type RepoSettingsChange struct {
+	Kind   string
+	Name   string
+	Action RepoSettingsAction
+	Reason string
+
+	apply func(e Engine) error
+}
+
+// RepoSettingsPlan is the result of importing repository settings.
+type RepoSettingsPlan struct {
+	Changes  []*RepoSettingsChange
+	Warnings []string
+	// Placeholders are names of placeholders used by the document.
+	Placeholders []string
+}
+
+func (p *RepoSettingsPlan) add(kind, name string, action RepoSettingsAction, reason string, apply func(e Engine) error) {
+	p.Changes = append(p.Changes, &RepoSettingsChange{
+		Kind:   kind,
+		Name:   name,
+		Action: action,
+		Reason: reason,
+		apply:  apply,
+	})
+}
+
+// NumChanges returns the number of changes that are not skipped.
+func (p *RepoSettingsPlan) NumChanges() int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action != REPO_SETTINGS_SKIP {
+			n++
+		}
+	}
+	return n
+}
+
+// ImportRepoSettings applies the document of settings to the repository and
+// returns what has been created, updated or skipped. Nothing is changed when
+// dryRun is true, and values of placeholders are optional. Otherwise, values
+// of all placeholders must be given in secrets, and all changes are made in
+// one transaction, so nothing is changed if any of them fails.
+//
+// Labels, webhooks and protected branches are matched by name or URL, existing
+// ones that are not in the document are left untouched.
+func ImportRepoSettings(repo *Repository, s *RepoSettings, secrets map[string]string, dryRun bool) (*RepoSettingsPlan, error) {
+	plan := &RepoSettingsPlan{
+		Warnings:     append([]string(nil), s.warnings...),
+		Placeholders: s.Placeholders(),
+	}
+	if !dryRun {
+		if missing := s.MissingSecrets(secrets); len(missing) > 0 {
+			return nil, ErrRepoSettingsMissingSecrets{Names: missing}
+		}
+	}
+
+	planRepoMergeSettings(plan, repo, s.Merge)
+	if err := planRepoLabels(plan, repo, s.Labels); err != nil {
+		return nil, err
+	}
+	if err := planRepoWebhooks(plan, repo, s.Webhooks, secrets); err != nil {
+		return nil, err
+	}
+	if err := planRepoProtectBranches(plan, repo, s.ProtectedBranches); err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return plan, nil
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return nil, err
+	}
+	for _, c := range plan.Changes {
+		if c.apply == nil {
+			continue
+		}
+		if err := c.apply(sess); err != nil {
+			return nil, fmt.Errorf("%s %s %q: %v", c.Action, c.Kind, c.Name, err)
+		}
+	}
+	return plan, sess.Commit()
+}
+
+func planRepoMergeSettings(plan *RepoSettingsPlan, repo *Repository, merge *RepoMergeSettings) {
+	if merge == nil {
+		return
+	}
+
+	changed := false
+	set := func(dst *bool, src *bool) {
+		if src != nil && *dst != *src {
+			*dst = *src
+			changed = true
+		}
+	}
+	// Changes are made on a copy, so nothing is touched in a dry run.
+	updated := *repo
+	set(&updated.EnablePulls, merge.EnablePulls)
+	set(&updated.PullsIgnoreWhitespace, merge.IgnoreWhitespace)
+	set(&updated.PullsAllowRebase, merge.AllowRebase)
+	if !changed {
+		plan.add("merge", "pull requests", REPO_SETTINGS_SKIP, "unchanged", nil)
+		return
+	}
+
+	plan.add("merge", "pull requests", REPO_SETTINGS_UPDATE, "", func(e Engine) error {
+		repo.EnablePulls = updated.EnablePulls
+		repo.PullsIgnoreWhitespace = updated.PullsIgnoreWhitespace
+		repo.PullsAllowRebase = updated.PullsAllowRebase
+		return updateRepository(e, repo, false)
+	})
+}
+
+func planRepoLabels(plan *RepoSettingsPlan, repo *Repository, labels []*RepoLabelSetting) error {
+	for _, l := range labels {
+		name := strings.TrimSpace(l.Name)
+		color := strings.ToLower(l.Color)
+		if name == "" {
+			plan.add("label", l.Name, REPO_SETTINGS_SKIP, "name is empty", nil)
+			continue
+		} else if len(color) != 7 || !labelColorPattern.MatchString(color) {
+			plan.add("label", name, REPO_SETTINGS_SKIP, fmt.Sprintf("invalid color %q", l.Color), nil)
+			continue
+		}
+
+		existing, err := GetLabelOfRepoByName(repo.ID, name)
+		if err != nil {
+			if !IsErrLabelNotExist(err) {
+				return fmt.Errorf("GetLabelOfRepoByName: %v", err)
+			}
+			plan.add("label", name, REPO_SETTINGS_CREATE, "", func(e Engine) error {
+				_, err := e.Insert(&Label{
+					RepoID: repo.ID,
+					Name:   name,
+					Color:  color,
+				})
+				return err
+			})
+			continue
+		}
+
+		if strings.ToLower(existing.Color) == color {
+			plan.add("label", name, REPO_SETTINGS_SKIP, "unchanged", nil)
+			continue
+		}
+		plan.add("label", name, REPO_SETTINGS_UPDATE, "", func(e Engine) error {
+			existing.Color = color
+			return updateLabel(e, existing)
+		})
+	}
+	return nil
+}
+
+// toHookEvent converts the list of event names to *HookEvent.
+func toHookEvent(events []string) (*HookEvent, error) {
+	if len(events) == 0 || (len(events) == 1 && events[0] == string(HOOK_EVENT_PUSH)) {
+		return &HookEvent{PushOnly: true}, nil
+	}
+
+	e := &HookEvent{ChooseEvents: true}
+	for _, name := range events {
+		switch HookEventType(name) {
+		case "*":
+			return &HookEvent{SendEverything: true}, nil
+		case HOOK_EVENT_CREATE:
+			e.Create = true
+		case HOOK_EVENT_DELETE:
+			e.Delete = true
+		case HOOK_EVENT_FORK:
+			e.Fork = true
+		case HOOK_EVENT_PUSH:
+			e.Push = true
+		case HOOK_EVENT_ISSUES:
+			e.Issues = true
+		case HOOK_EVENT_PULL_REQUEST:
+			e.PullRequest = true
+		case HOOK_EVENT_ISSUE_COMMENT:
+			e.IssueComment = true
+		case HOOK_EVENT_RELEASE:
+			e.Release = true
+		case HOOK_EVENT_REPOSITORY:
+			e.Repository = true
+		default:
+			return nil, fmt.Errorf("unknown event %q", name)
+		}
+	}
+	return e, nil
+}
+
+func planRepoWebhooks(plan *RepoSettingsPlan, repo *Repository, hooks []*RepoWebhookSetting, secrets map[string]string) error {
+	existing, err := GetWebhooksByRepoID(repo.ID)
+	if err != nil {
+		return fmt.Errorf("GetWebhooksByRepoID: %v", err)
+	}
+
+	// resolve returns the value of the placeholder, or the value itself if it
+	// is not a placeholder.
+	resolve := func(v string) (string, bool) {
+		m := placeholderPattern.FindStringSubmatch(v)
+		if m == nil {
+			return v, true
+		}
+		value := secrets[m[1]]
+		return value, value != ""
+	}
+
+	for _, h := range hooks {
+		typ := h.Type
+		if typ == "" {
+			typ = GOGS.Name()
+		}
+		contentType := h.ContentType
+		if contentType == "" {
+			contentType = JSON.Name()
+		}
+
+		if !IsValidHookTaskType(typ) {
+			plan.add("webhook", h.URL, REPO_SETTINGS_SKIP, fmt.Sprintf("unknown type %q", h.Type), nil)
+			continue
+		} else if !IsValidHookContentType(contentType) {
+			plan.add("webhook", h.URL, REPO_SETTINGS_SKIP, fmt.Sprintf("unknown content type %q", h.ContentType), nil)
+			continue
+		}
+		event, err := toHookEvent(h.Events)
+		if err != nil {
+			plan.add("webhook", h.URL, REPO_SETTINGS_SKIP, err.Error(), nil)
+			continue
+		}
+
+		rawURL, urlResolved := resolve(h.URL)
+		secret, secretResolved := resolve(h.Secret)
+		if urlResolved {
+			u, err := url.Parse(rawURL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				plan.add("webhook", h.URL, REPO_SETTINGS_SKIP, "invalid URL", nil)
+				continue
+			}
+		}
+
+		w := &Webhook{
+			RepoID:       repo.ID,
+			URL:          rawURL,
+			ContentType:  ToHookContentType(contentType),
+			Secret:       secret,
+			HookEvent:    event,
+			IsActive:     h.Active == nil || *h.Active,
+			HookTaskType: ToHookTaskType(typ),
+			Meta:         h.Meta,
+		}
+
+		var match *Webhook
+		if urlResolved {
+			for _, e := range existing {
+				if e.URL == rawURL {
+					match = e
+					break
+				}
+			}
+		}
+		if match == nil {
+			reason := ""
+			if !urlResolved {
+				reason = "URL is supplied at import time"
+			}
+			plan.add("webhook", h.URL, REPO_SETTINGS_CREATE, reason, func(e Engine) error {
+				if err := w.UpdateEvent(); err != nil {
+					return err
+				}
+				return createWebhook(e, w)
+			})
+			continue
+		}
+
+		if secretResolved && match.Secret == w.Secret &&
+			match.ContentType == w.ContentType &&
+			match.IsActive == w.IsActive &&
+			match.HookTaskType == w.HookTaskType &&
+			match.Meta == w.Meta &&
+			match.HookEvent != nil && *match.HookEvent == *w.HookEvent {
+			plan.add("webhook", h.URL, REPO_SETTINGS_SKIP, "unchanged", nil)
+			continue
+		}
+		plan.add("webhook", h.URL, REPO_SETTINGS_UPDATE, "", func(e Engine) error {
+			w.ID = match.ID
+			w.LastStatus = match.LastStatus
+			w.CreatedUnix = match.CreatedUnix
+			if err := w.UpdateEvent(); err != nil {
+				return err
+			}
+			return updateWebhook(e, w)
+		})
+	}
+	return nil
+}
+
+func planRepoProtectBranches(plan *RepoSettingsPlan, repo *Repository, branches []*RepoProtectBranchSetting) error {
+	if err := repo.GetOwner(); err != nil {
+		return fmt.Errorf("GetOwner: %v", err)
+	}
+
+	for _, b := range branches {
+		if b.Name == "" {
+			plan.add("protected_branch", b.Name, REPO_SETTINGS_SKIP, "name is empty", nil)
+			continue
+		}
+
+		userIDs := make([]int64, 0, len(b.WhitelistUsers))
+		teamIDs := make([]int64, 0, len(b.WhitelistTeams))
+		if !repo.Owner.IsOrganization() {
+			if len(b.WhitelistUsers)+len(b.WhitelistTeams) > 0 {
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf("whitelists of protected branch %q are ignored because repository is not owned by an organization", b.Name))
+			}
+		} else {
+			for _, name := range b.WhitelistUsers {
+				u, err := GetUserByName(name)
+				if err != nil {
+					if !errors.IsUserNotExist(err) {
+						return fmt.Errorf("GetUserByName: %v", err)
+					}
+					plan.Warnings = append(plan.Warnings, fmt.Sprintf("user %q in whitelist of protected branch %q does not exist", name, b.Name))
+					continue
+				}
+				userIDs = append(userIDs, u.ID)
+			}
+			for _, name := range b.WhitelistTeams {
+				t, err := repo.Owner.GetTeam(name)
+				if err != nil {
+					if !errors.IsTeamNotExist(err) {
+						return fmt.Errorf("GetTeam: %v", err)
+					}
+					plan.Warnings = append(plan.Warnings, fmt.Sprintf("team %q in whitelist of protected branch %q does not exist", name, b.Name))
+					continue
+				}
+				teamIDs = append(teamIDs, t.ID)
+			}
+		}
+		whitelistUserIDs := strings.Join(tool.Int64sToStrings(userIDs), ",")
+		whitelistTeamIDs := strings.Join(tool.Int64sToStrings(teamIDs), ",")
+		enableWhitelist := len(userIDs)+len(teamIDs) > 0
+
+		pb, err := GetProtectBranchOfRepoByName(repo.ID, b.Name)
+		action := REPO_SETTINGS_UPDATE
+		if err != nil {
+			if !errors.IsErrBranchNotExist(err) {
+				return fmt.Errorf("GetProtectBranchOfRepoByName: %v", err)
+			}
+			pb = &ProtectBranch{
+				RepoID: repo.ID,
+				Name:   b.Name,
+			}
+			action = REPO_SETTINGS_CREATE
+		} else if pb.Protected &&
+			pb.RequirePullRequest == b.RequirePullRequest &&
+			pb.EnableWhitelist == enableWhitelist &&
+			(!enableWhitelist || (pb.WhitelistUserIDs == whitelistUserIDs && pb.WhitelistTeamIDs == whitelistTeamIDs)) {
+			plan.add("protected_branch", b.Name, REPO_SETTINGS_SKIP, "unchanged", nil)
+			continue
+		}
+
+		plan.add("protected_branch", b.Name, action, "", func(e Engine) error {
+			pb.Protected = true
+			pb.RequirePullRequest = b.RequirePullRequest
+			pb.EnableWhitelist = enableWhitelist
+			if repo.Owner.IsOrganization() {
+				return updateOrgProtectBranch(e, repo, pb, whitelistUserIDs, whitelistTeamIDs)
+			}
+			return updateProtectBranch(e, pb)
+		})
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_ParseRepoSettings(t *testing.T) {
+	Convey("Parse document of repository settings", t, func() {
+		s, err := ParseRepoSettings([]byte(`
+version: 1
+labels:
+  - name: bug
+    color: "#ee0701"
+webhooks:
+  - url: https://ci.example.com/hook
+    secret: ${CI_SECRET}
+  - url: ${CHAT_URL}
+    type: slack
+    secret: ${CI_SECRET}
+merge_queue: true
+`))
+		So(err, ShouldBeNil)
+		So(s.Labels, ShouldHaveLength, 1)
+		So(s.Webhooks, ShouldHaveLength, 2)
+		So(s.warnings, ShouldHaveLength, 1)
+		So(s.warnings[0], ShouldContainSubstring, "merge_queue")
+
+		So(s.Placeholders(), ShouldResemble, []string{"CI_SECRET", "CHAT_URL"})
+		So(s.MissingSecrets(map[string]string{"CI_SECRET": "secret"}), ShouldResemble, []string{"CHAT_URL"})
+		So(s.MissingSecrets(map[string]string{"CI_SECRET": "secret", "CHAT_URL": "https://chat.example.com"}), ShouldBeEmpty)
+
+		Convey("Reject unsupported version", func() {
+			_, err := ParseRepoSettings([]byte("version: 2\n"))
+			So(IsErrInvalidRepoSettings(err), ShouldBeTrue)
+		})
+
+		Convey("Reject push rules", func() {
+			_, err := ParseRepoSettings([]byte("version: 1\npush_rules:\n  - max_file_size: 10\n"))
+			So(IsErrInvalidRepoSettings(err), ShouldBeTrue)
+		})
+
+		Convey("Reject malformed document", func() {
+			_, err := ParseRepoSettings([]byte("version: [1\n"))
+			So(IsErrInvalidRepoSettings(err), ShouldBeTrue)
+		})
+	})
+}
+
+func Test_toHookEvent(t *testing.T) {
+	Convey("Convert event names to hook event", t, func() {
+		e, err := toHookEvent(nil)
+		So(err, ShouldBeNil)
+		So(*e, ShouldResemble, HookEvent{PushOnly: true})
+
+		e, err = toHookEvent([]string{"*"})
+		So(err, ShouldBeNil)
+		So(*e, ShouldResemble, HookEvent{SendEverything: true})
+
+		e, err = toHookEvent([]string{"push", "pull_request"})
+		So(err, ShouldBeNil)
+		So(*e, ShouldResemble, HookEvent{
+			ChooseEvents: true,
+			HookEvents: HookEvents{
+				Push:        true,
+				PullRequest: true,
+			},
+		})
+
+		_, err = toHookEvent([]string{"deployment"})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func Test_ImportRepoSettings(t *testing.T) {
+	setupTestDB(t)
+
+	org := &User{Name: "org", LowerName: "org", Type: USER_TYPE_ORGANIZATION}
+	bob := &User{Name: "bob", LowerName: "bob"}
+	insertTestBeans(t, org, bob)
+	repo := &Repository{OwnerID: org.ID, Name: "proj", LowerName: "proj"}
+	insertTestBeans(t, repo)
+	insertTestBeans(t, &Access{UserID: bob.ID, RepoID: repo.ID, Mode: ACCESS_MODE_WRITE})
+
+	s, err := ParseRepoSettings([]byte(`
+version: 1
+labels:
+  - name: bug
+    color: "#ee0701"
+protected_branches:
+  - name: master
+    whitelist_users: [bob]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Import settings in one transaction", t, func() {
+		Convey("Nothing is changed when a change fails", func() {
+			// Whitelists of the protected branch are the last to be written.
+			So(x.DropTables(new(ProtectBranchWhitelist)), ShouldBeNil)
+			_, err := ImportRepoSettings(repo, s, nil, false)
+			So(err, ShouldNotBeNil)
+
+			_, err = GetLabelOfRepoByName(repo.ID, "bug")
+			So(IsErrLabelNotExist(err), ShouldBeTrue)
+			protectBranches, err := GetProtectBranchesByRepoID(repo.ID)
+			So(err, ShouldBeNil)
+			So(protectBranches, ShouldBeEmpty)
+		})
+
+		Convey("All changes are made", func() {
+			So(x.Sync2(new(ProtectBranchWhitelist)), ShouldBeNil)
+			plan, err := ImportRepoSettings(repo, s, nil, false)
+			So(err, ShouldBeNil)
+			So(plan.NumChanges(), ShouldEqual, 2)
+
+			_, err = GetLabelOfRepoByName(repo.ID, "bug")
+			So(err, ShouldBeNil)
+			So(IsUserInProtectBranchWhitelist(repo.ID, bob.ID, "master"), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"gogs.io/gogs/internal/sync"
+)
+
+// TaskType is the type of background tasks of repositories.
+type TaskType string
+
+const (
+	TaskTypeMirrorSync       TaskType = "mirror_sync"
+	TaskTypeWebhookDelivery  TaskType = "webhook_delivery"
+	TaskTypePullRequestCheck TaskType = "pull_request_check"
+)
+
+// TaskInfo is a background task of a repository in a task queue.
+type TaskInfo struct {
+	Type TaskType
+	// Index is the index of the pull request for mergeability checks.
+	Index int64
+	// Running is true if the task is being processed, a task can be queued
+	// again while running.
+	Running bool
+	// Since is the time when the task was added to the queue, or started
+	// running.
+	Since time.Time
+	// Cancelable is true for queued tasks that can be canceled safely.
+	// Mergeability checks are not, since pull requests would be left in the
+	// checking status.
+	Cancelable bool
+}
+
+// appendTaskInfos appends the running and queued tasks of the identity in the
+// queue.
+func appendTaskInfos(tasks []*TaskInfo, queue *sync.UniqueQueue, id int64, typ TaskType, index int64) []*TaskInfo {
+	queuedAt, runningSince := queue.Status(id)
+	if !runningSince.IsZero() {
+		tasks = append(tasks, &TaskInfo{
+			Type:    typ,
+			Index:   index,
+			Running: true,
+			Since:   runningSince,
+		})
+	}
+	if !queuedAt.IsZero() {
+		tasks = append(tasks, &TaskInfo{
+			Type:       typ,
+			Index:      index,
+			Since:      queuedAt,
+			Cancelable: typ != TaskTypePullRequestCheck,
+		})
+	}
+	return tasks
+}
+
+// ScheduledTasks returns queued and running background tasks of the
+// repository, i.e. mirror syncs, webhook deliveries and mergeability checks of
+// open pull requests, in the order of time.
+func (repo *Repository) ScheduledTasks() ([]*TaskInfo, error) {
+	tasks := make([]*TaskInfo, 0, 2)
+	if repo.IsMirror {
+		tasks = appendTaskInfos(tasks, MirrorQueue, repo.ID, TaskTypeMirrorSync, 0)
+	}
+	tasks = appendTaskInfos(tasks, HookQueue, repo.ID, TaskTypeWebhookDelivery, 0)
+
+	prs := make([]*PullRequest, 0, 10)
+	if err := x.Where("base_repo_id = ? AND has_merged = ? AND issue.is_closed = ?", repo.ID, false, false).
+		Join("INNER", "issue", "issue.id = pull_request.issue_id").Find(&prs); err != nil {
+		return nil, fmt.Errorf("find open pull requests: %v", err)
+	}
+	for _, pr := range prs {
+		tasks = appendTaskInfos(tasks, PullRequestQueue, pr.ID, TaskTypePullRequestCheck, pr.Index)
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].Since.Before(tasks[j].Since)
+	})
+	return tasks, nil
+}
+
+// CancelScheduledTask cancels the queued task of the type, it returns false if
+// there is no such task or it cannot be canceled.
+func (repo *Repository) CancelScheduledTask(typ TaskType) bool {
+	switch typ {
+	case TaskTypeMirrorSync:
+		return repo.IsMirror && MirrorQueue.Cancel(repo.ID)
+	case TaskTypeWebhookDelivery:
+		return HookQueue.Cancel(repo.ID)
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/sync"
+)
+
+func Test_appendTaskInfos(t *testing.T) {
+	Convey("List tasks of an identity in a queue", t, func() {
+		queue := sync.NewUniqueQueue(10)
+		So(appendTaskInfos(nil, queue, 1, TaskTypeMirrorSync, 0), ShouldBeEmpty)
+
+		queue.Add(1)
+		tasks := appendTaskInfos(nil, queue, 1, TaskTypeMirrorSync, 0)
+		So(tasks, ShouldHaveLength, 1)
+		So(tasks[0].Running, ShouldBeFalse)
+		So(tasks[0].Cancelable, ShouldBeTrue)
+		So(tasks[0].Since.IsZero(), ShouldBeFalse)
+
+		Convey("Running tasks are listed with the queued one", func() {
+			queue.Process(<-queue.Queue(), func() {
+				queue.Add(1)
+				tasks := appendTaskInfos(nil, queue, 1, TaskTypeMirrorSync, 0)
+				So(tasks, ShouldHaveLength, 2)
+				So(tasks[0].Running, ShouldBeTrue)
+				So(tasks[0].Cancelable, ShouldBeFalse)
+				So(tasks[1].Running, ShouldBeFalse)
+			})
+		})
+
+		Convey("Mergeability checks cannot be canceled", func() {
+			tasks := appendTaskInfos(nil, queue, 1, TaskTypePullRequestCheck, 3)
+			So(tasks, ShouldHaveLength, 1)
+			So(tasks[0].Index, ShouldEqual, 3)
+			So(tasks[0].Cancelable, ShouldBeFalse)
+		})
+	})
+}
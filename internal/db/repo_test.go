@@ -5,6 +5,7 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/markup"
 )
@@ -61,3 +62,45 @@ func TestRepo(t *testing.T) {
 		})
 	})
 }
+
+func TestRepository_CloneLink(t *testing.T) {
+	oldRunUser, oldDomain, oldPort, oldExternalURL := conf.App.RunUser, conf.SSH.Domain, conf.SSH.Port, conf.Server.ExternalURL
+	defer func() {
+		conf.App.RunUser, conf.SSH.Domain, conf.SSH.Port, conf.Server.ExternalURL = oldRunUser, oldDomain, oldPort, oldExternalURL
+	}()
+	conf.App.RunUser = "git"
+
+	repo := &db.Repository{
+		Name:  "testrepo",
+		Owner: &db.User{Name: "testuser"},
+	}
+
+	Convey("Compose clone links of the repository", t, func() {
+		Convey("Standard SSH port uses the scp-like form", func() {
+			conf.SSH.Domain, conf.SSH.Port = "example.com", 22
+			So(repo.CloneLink().SSH, ShouldEqual, "git@example.com:testuser/testrepo.git")
+			So(repo.WikiCloneLink().SSH, ShouldEqual, "git@example.com:testuser/testrepo.wiki.git")
+		})
+
+		Convey("Custom SSH port uses the ssh:// form", func() {
+			conf.SSH.Domain, conf.SSH.Port = "example.com", 2222
+			So(repo.CloneLink().SSH, ShouldEqual, "ssh://git@example.com:2222/testuser/testrepo.git")
+		})
+
+		Convey("IPv6 hosts are enclosed in brackets", func() {
+			conf.SSH.Domain, conf.SSH.Port = "::1", 22
+			So(repo.CloneLink().SSH, ShouldEqual, "git@[::1]:testuser/testrepo.git")
+
+			conf.SSH.Port = 2222
+			So(repo.CloneLink().SSH, ShouldEqual, "ssh://git@[::1]:2222/testuser/testrepo.git")
+
+			conf.SSH.Domain = "[2001:db8::1]"
+			So(repo.CloneLink().SSH, ShouldEqual, "ssh://git@[2001:db8::1]:2222/testuser/testrepo.git")
+		})
+
+		Convey("HTTP link keeps the port of the external URL", func() {
+			conf.Server.ExternalURL = "http://[::1]:3000/"
+			So(repo.CloneLink().HTTPS, ShouldEqual, "http://[::1]:3000/testuser/testrepo.git")
+		})
+	})
+}
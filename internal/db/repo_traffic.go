@@ -0,0 +1,240 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+// RepoTraffic is the number of clones and fetches of a repository in a day by
+// a visitor. Visitors are identified by hashes that cannot be reversed to user
+// IDs or IP addresses. Rows of visitors older than trafficVisitorDays are
+// rolled up into a single row with an empty visitor, which keeps the number of
+// unique visitors of the day.
+type RepoTraffic struct {
+	ID      int64
+	RepoID  int64  `xorm:"UNIQUE(s)"`
+	DayUnix int64  `xorm:"UNIQUE(s)"`
+	Visitor string `xorm:"UNIQUE(s) NOT NULL DEFAULT ''"`
+	Count   int64  `xorm:"NOT NULL DEFAULT 0"`
+	Uniques int64  `xorm:"NOT NULL DEFAULT 0"`
+}
+
+// trafficVisitorDays is the number of days that rows of visitors are kept
+// before being rolled up.
+const trafficVisitorDays = 14
+
+// trafficDay returns the start of the day of the time in UTC.
+func trafficDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// truncateIP returns the network of the IP address that is wide enough to not
+// identify a single host, i.e. /24 for IPv4 and /48 for IPv6. The address is
+// returned as is when it cannot be parsed.
+func truncateIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return addr
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(48, 128)).String()
+}
+
+// TrafficVisitor returns the hash that identifies the visitor of repository
+// traffic, which is the signed in user or the truncated remote address. The
+// hash is keyed by "[security] SECRET_KEY" so it cannot be reversed by trying
+// all addresses.
+func TrafficVisitor(userID int64, remoteAddr string) string {
+	identity := "ip:" + truncateIP(remoteAddr)
+	if userID > 0 {
+		identity = fmt.Sprintf("user:%d", userID)
+	}
+	return trafficVisitorHash(identity)
+}
+
+// TrafficVisitorByKey returns the hash that identifies the visitor of
+// repository traffic who uses the SSH key.
+func TrafficVisitorByKey(keyID int64) string {
+	return trafficVisitorHash(fmt.Sprintf("key:%d", keyID))
+}
+
+func trafficVisitorHash(identity string) string {
+	mac := hmac.New(sha256.New, []byte(conf.Security.SecretKey))
+	mac.Write([]byte(identity))
+	return hex.EncodeToString(mac.Sum(nil))[:32]
+}
+
+// RecordRepoClone counts a clone or fetch of the repository by the visitor.
+func RecordRepoClone(repoID int64, visitor string) error {
+	dayUnix := trafficDay(time.Now()).Unix()
+	increase := func() (int64, error) {
+		return x.Where("repo_id = ? AND day_unix = ? AND visitor = ?", repoID, dayUnix, visitor).Incr("count").Update(new(RepoTraffic))
+	}
+
+	affected, err := increase()
+	if err != nil {
+		return fmt.Errorf("increase count: %v", err)
+	} else if affected > 0 {
+		return nil
+	}
+
+	_, err = x.Insert(&RepoTraffic{
+		RepoID:  repoID,
+		DayUnix: dayUnix,
+		Visitor: visitor,
+		Count:   1,
+	})
+	if err != nil {
+		// The row may have been inserted by a concurrent request.
+		if affected, _ = increase(); affected > 0 {
+			return nil
+		}
+		return fmt.Errorf("insert: %v", err)
+	}
+	return nil
+}
+
+// DayStat is the total and unique numbers of something in a day.
+type DayStat struct {
+	Day    time.Time
+	Total  int64
+	Unique int64
+}
+
+// sumDayStats returns stats of every day from the start day to the end day,
+// inclusively, in the order of time. Days without traffic have zero numbers.
+func sumDayStats(traffics []*RepoTraffic, start, end time.Time) []DayStat {
+	stats := make([]DayStat, 0, int(end.Sub(start).Hours()/24)+1)
+	index := make(map[int64]int)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		index[day.Unix()] = len(stats)
+		stats = append(stats, DayStat{Day: day})
+	}
+
+	for _, t := range traffics {
+		i, ok := index[t.DayUnix]
+		if !ok {
+			continue
+		}
+		stats[i].Total += t.Count
+		if t.Visitor == "" {
+			stats[i].Unique += t.Uniques
+		} else {
+			stats[i].Unique++
+		}
+	}
+	return stats
+}
+
+// CloneStats returns numbers of clones and fetches of the repository and
+// unique visitors who made them in each of the recent days, including today.
+func (repo *Repository) CloneStats(days int) ([]DayStat, error) {
+	if days < 1 {
+		days = 1
+	}
+	end := trafficDay(time.Now())
+	start := end.AddDate(0, 0, -days+1)
+
+	traffics := make([]*RepoTraffic, 0, days)
+	if err := x.Where("repo_id = ? AND day_unix >= ?", repo.ID, start.Unix()).Find(&traffics); err != nil {
+		return nil, fmt.Errorf("find traffics: %v", err)
+	}
+	return sumDayStats(traffics, start, end), nil
+}
+
+type trafficRollup struct {
+	RepoID  int64
+	DayUnix int64
+	Count   int64
+	Uniques int64
+}
+
+// rollupRepoTraffic rolls up rows of visitors before the time into the rows
+// of days, and deletes all rows before the time of retention.
+func rollupRepoTraffic(before, retention time.Time) error {
+	sess := x.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err := sess.Where("day_unix < ?", retention.Unix()).Delete(new(RepoTraffic)); err != nil {
+		return fmt.Errorf("delete expired: %v", err)
+	}
+
+	rollups := make([]*trafficRollup, 0, 10)
+	if err := sess.Table("repo_traffic").
+		Select("repo_id, day_unix, SUM(count) AS count, COUNT(*) AS uniques").
+		Where("visitor != '' AND day_unix < ?", before.Unix()).
+		GroupBy("repo_id, day_unix").
+		Find(&rollups); err != nil {
+		return fmt.Errorf("sum visitors: %v", err)
+	}
+
+	for _, r := range rollups {
+		day := &RepoTraffic{
+			RepoID:  r.RepoID,
+			DayUnix: r.DayUnix,
+		}
+		has, err := sess.Where("repo_id = ? AND day_unix = ? AND visitor = ''", r.RepoID, r.DayUnix).Get(day)
+		if err != nil {
+			return fmt.Errorf("get day: %v", err)
+		}
+
+		day.Count += r.Count
+		day.Uniques += r.Uniques
+		if has {
+			_, err = sess.ID(day.ID).Cols("count", "uniques").Update(day)
+		} else {
+			_, err = sess.Insert(day)
+		}
+		if err != nil {
+			return fmt.Errorf("save day [repo_id: %d, day_unix: %d]: %v", r.RepoID, r.DayUnix, err)
+		}
+	}
+
+	if _, err := sess.Where("visitor != '' AND day_unix < ?", before.Unix()).Delete(new(RepoTraffic)); err != nil {
+		return fmt.Errorf("delete visitors: %v", err)
+	}
+	return sess.Commit()
+}
+
+// RollupRepoTraffic rolls up clone statistics of visitors that are older than
+// two weeks, and deletes statistics that are older than
+// "[repository.insights] TRAFFIC_RETENTION_DAYS".
+func RollupRepoTraffic() {
+	if taskStatusTable.IsRunning(_REPO_TRAFFIC_ROLLUP) {
+		return
+	}
+	taskStatusTable.Start(_REPO_TRAFFIC_ROLLUP)
+	defer taskStatusTable.Stop(_REPO_TRAFFIC_ROLLUP)
+
+	log.Trace("Doing: RollupRepoTraffic")
+
+	today := trafficDay(time.Now())
+	retentionDays := conf.Repository.Insights.TrafficRetentionDays
+	if retentionDays < trafficVisitorDays {
+		retentionDays = trafficVisitorDays
+	}
+	if err := rollupRepoTraffic(today.AddDate(0, 0, -trafficVisitorDays+1), today.AddDate(0, 0, -retentionDays+1)); err != nil {
+		log.Error("Failed to roll up repository traffic: %v", err)
+	}
+}
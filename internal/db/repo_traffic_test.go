@@ -0,0 +1,52 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_truncateIP(t *testing.T) {
+	Convey("Truncate IP addresses to networks", t, func() {
+		So(truncateIP("192.168.1.23"), ShouldEqual, "192.168.1.0")
+		So(truncateIP("192.168.1.23:52100"), ShouldEqual, "192.168.1.0")
+		So(truncateIP("2001:db8:85a3:8d3:1319:8a2e:370:7348"), ShouldEqual, "2001:db8:85a3::")
+		So(truncateIP("[2001:db8:85a3::1]:22"), ShouldEqual, "2001:db8:85a3::")
+		So(truncateIP("unknown"), ShouldEqual, "unknown")
+	})
+}
+
+func Test_TrafficVisitor(t *testing.T) {
+	Convey("Identify visitors of repository traffic", t, func() {
+		So(TrafficVisitor(0, "10.0.0.1"), ShouldEqual, TrafficVisitor(0, "10.0.0.200"))
+		So(TrafficVisitor(0, "10.0.0.1"), ShouldNotEqual, TrafficVisitor(0, "10.0.1.1"))
+		So(TrafficVisitor(1, "10.0.0.1"), ShouldEqual, TrafficVisitor(1, "10.0.1.1"))
+		So(TrafficVisitor(1, ""), ShouldNotEqual, TrafficVisitorByKey(1))
+		So(TrafficVisitor(0, "10.0.0.1"), ShouldNotContainSubstring, "10.0.0")
+		So(TrafficVisitor(0, "10.0.0.1"), ShouldHaveLength, 32)
+	})
+}
+
+func Test_sumDayStats(t *testing.T) {
+	Convey("Sum traffic of each day", t, func() {
+		start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 0, 2)
+		traffics := []*RepoTraffic{
+			{DayUnix: start.AddDate(0, 0, -1).Unix(), Visitor: "a", Count: 5},
+			{DayUnix: start.Unix(), Visitor: "", Count: 10, Uniques: 4},
+			{DayUnix: start.Unix(), Visitor: "a", Count: 2},
+			{DayUnix: end.Unix(), Visitor: "a", Count: 1},
+			{DayUnix: end.Unix(), Visitor: "b", Count: 3},
+		}
+		So(sumDayStats(traffics, start, end), ShouldResemble, []DayStat{
+			{Day: start, Total: 12, Unique: 5},
+			{Day: start.AddDate(0, 0, 1)},
+			{Day: end, Total: 4, Unique: 2},
+		})
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/email"
+)
+
+// Reasons of a visibility change being forbidden.
+const (
+	VISIBILITY_FORBIDDEN_FORCE_PRIVATE = "force_private"
+	VISIBILITY_FORBIDDEN_FORK          = "fork"
+)
+
+// VisibilitySchedule represents a pending change of repository visibility,
+// which is applied by the cron task once it is due.
+type VisibilitySchedule struct {
+	ID     int64
+	RepoID int64 `xorm:"UNIQUE"`
+	// IsPrivate is the visibility of the repository after the change.
+	IsPrivate bool
+	// DoerID is the user who scheduled the change, it is reported as the sender
+	// of the webhook event.
+	DoerID int64
+
+	Scheduled     time.Time `xorm:"-" json:"-"`
+	ScheduledUnix int64     `xorm:"INDEX"`
+	Created       time.Time `xorm:"-" json:"-"`
+	CreatedUnix   int64
+}
+
+func (s *VisibilitySchedule) BeforeInsert() {
+	s.ScheduledUnix = s.Scheduled.Unix()
+	s.CreatedUnix = time.Now().Unix()
+}
+
+func (s *VisibilitySchedule) BeforeUpdate() {
+	s.ScheduledUnix = s.Scheduled.Unix()
+}
+
+func (s *VisibilitySchedule) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "scheduled_unix":
+		s.Scheduled = time.Unix(s.ScheduledUnix, 0).Local()
+	case "created_unix":
+		s.Created = time.Unix(s.CreatedUnix, 0).Local()
+	}
+}
+
+// GetVisibilitySchedule returns the pending visibility change of the repository.
+func GetVisibilitySchedule(repoID int64) (*VisibilitySchedule, error) {
+	s := &VisibilitySchedule{RepoID: repoID}
+	has, err := x.Get(s)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrVisibilityScheduleNotExist{RepoID: repoID}
+	}
+	return s, nil
+}
+
+// checkVisibilityChange returns an error if the repository is not allowed to
+// change to given visibility by the repository policy of its owner.
+func checkVisibilityChange(repo *Repository, policy *RepoPolicy, isPrivate bool) error {
+	switch {
+	case repo.IsFork:
+		// Visibility of forked repository is forced sync with base repository.
+		return ErrVisibilityChangeForbidden{RepoID: repo.ID, IsPrivate: isPrivate, Reason: VISIBILITY_FORBIDDEN_FORK}
+	case !isPrivate && policy.ForbidVisibilityChange:
+		return ErrVisibilityChangeForbidden{RepoID: repo.ID, IsPrivate: isPrivate, Reason: VISIBILITY_FORBIDDEN_FORCE_PRIVATE}
+	}
+	return nil
+}
+
+// ownerRepoPolicy returns the repository policy of the owner of the repository.
+func (repo *Repository) ownerRepoPolicy() (*RepoPolicy, error) {
+	if err := repo.GetOwner(); err != nil {
+		return nil, fmt.Errorf("GetOwner: %v", err)
+	}
+	return repo.Owner.RepoPolicy()
+}
+
+// ScheduleVisibilityChange schedules the repository to change to the opposite
+// of its current visibility at given time, it replaces any pending change of
+// the repository.
+func ScheduleVisibilityChange(doer *User, repo *Repository, at time.Time) error {
+	if !at.After(time.Now()) {
+		return ErrInvalidVisibilitySchedule{RepoID: repo.ID, ScheduledUnix: at.Unix()}
+	}
+	isPrivate := !repo.IsPrivate
+	policy, err := repo.ownerRepoPolicy()
+	if err != nil {
+		return err
+	} else if err = checkVisibilityChange(repo, policy, isPrivate); err != nil {
+		return err
+	}
+
+	s, err := GetVisibilitySchedule(repo.ID)
+	if err != nil && !IsErrVisibilityScheduleNotExist(err) {
+		return fmt.Errorf("GetVisibilitySchedule: %v", err)
+	} else if err != nil {
+		_, err = x.Insert(&VisibilitySchedule{
+			RepoID:    repo.ID,
+			IsPrivate: isPrivate,
+			DoerID:    doer.ID,
+			Scheduled: at,
+		})
+		return err
+	}
+
+	s.IsPrivate = isPrivate
+	s.DoerID = doer.ID
+	s.Scheduled = at
+	_, err = x.ID(s.ID).AllCols().Update(s)
+	return err
+}
+
+// CancelVisibilitySchedule cancels the pending visibility change of the
+// repository, if any.
+func CancelVisibilitySchedule(repoID int64) error {
+	_, err := x.Where("repo_id = ?", repoID).Delete(new(VisibilitySchedule))
+	return err
+}
+
+// ChangeRepositoryVisibility changes visibility of the repository with all side
+// effects, and sends the repository webhook event with doer as the sender. The
+// pending visibility change of the repository is canceled when the visibility
+// is changed.
+func ChangeRepositoryVisibility(doer *User, repo *Repository, isPrivate bool) error {
+	if repo.IsPrivate == isPrivate {
+		return nil
+	}
+
+	// Visibility of forked repository is forced sync with base repository by
+	// callers, so the policy of the owner does not apply.
+	policy, err := repo.ownerRepoPolicy()
+	if err != nil {
+		return err
+	} else if !repo.IsFork && !isPrivate && policy.ForbidVisibilityChange {
+		return ErrVisibilityChangeForbidden{RepoID: repo.ID, IsPrivate: isPrivate, Reason: VISIBILITY_FORBIDDEN_FORCE_PRIVATE}
+	}
+
+	repo.IsPrivate = isPrivate
+	if err := UpdateRepository(repo, true); err != nil {
+		return fmt.Errorf("UpdateRepository: %v", err)
+	}
+	// Any pending change is outdated once the visibility is changed.
+	if err := CancelVisibilitySchedule(repo.ID); err != nil {
+		return fmt.Errorf("CancelVisibilitySchedule: %v", err)
+	}
+
+	action := REPOSITORY_PUBLICIZED
+	if isPrivate {
+		action = REPOSITORY_PRIVATIZED
+	}
+	if err := PrepareWebhooks(repo, HOOK_EVENT_REPOSITORY, &RepositoryPayload{
+		Action: action,
+		Repo:   repo.APIFormat(nil),
+		Sender: doer.APIFormat(),
+	}); err != nil {
+		return fmt.Errorf("PrepareWebhooks: %v", err)
+	}
+	return nil
+}
+
+// notifyVisibilityChangeFailed sends mail to admins of the repository that the
+// scheduled visibility change is not applied.
+func notifyVisibilityChangeFailed(repo *Repository, s *VisibilitySchedule, reason string) error {
+	if !conf.User.EnableEmailNotification {
+		return nil
+	}
+
+	admins, err := repoAdmins(repo)
+	if err != nil {
+		return fmt.Errorf("repoAdmins: %v", err)
+	}
+
+	now := time.Now()
+	tos := make([]string, 0, len(admins))
+	seen := make(map[int64]bool)
+	for _, u := range admins {
+		if seen[u.ID] || !u.IsMailable() || repo.IsMutedFor(u, now) {
+			continue
+		}
+		seen[u.ID] = true
+		tos = append(tos, u.Email)
+	}
+	email.SendVisibilityChangeFailedMail(NewMailerRepo(repo), tos, s.IsPrivate, reason)
+	return nil
+}
+
+// applyVisibilitySchedule applies the due visibility change. The change is
+// dropped and admins are notified when it is no longer allowed.
+func applyVisibilitySchedule(s *VisibilitySchedule) error {
+	repo, err := GetRepositoryByID(s.RepoID)
+	if err != nil {
+		return fmt.Errorf("GetRepositoryByID: %v", err)
+	}
+
+	// The visibility has been changed to the scheduled one in the meantime.
+	if repo.IsPrivate == s.IsPrivate {
+		return CancelVisibilitySchedule(repo.ID)
+	}
+
+	policy, err := repo.ownerRepoPolicy()
+	if err != nil {
+		return err
+	} else if err = checkVisibilityChange(repo, policy, s.IsPrivate); err != nil {
+		forbidden, ok := err.(ErrVisibilityChangeForbidden)
+		if !ok {
+			return err
+		}
+		log.Trace("Scheduled visibility change of repository [%d] is forbidden: %v", repo.ID, forbidden)
+		if err = CancelVisibilitySchedule(repo.ID); err != nil {
+			return fmt.Errorf("CancelVisibilitySchedule: %v", err)
+		}
+		return notifyVisibilityChangeFailed(repo, s, forbidden.Reason)
+	}
+
+	doer, err := GetUserByID(s.DoerID)
+	if err != nil {
+		if !errors.IsUserNotExist(err) {
+			return fmt.Errorf("GetUserByID [%d]: %v", s.DoerID, err)
+		}
+		// Fall back to the owner when the user who scheduled the change is gone.
+		if err = repo.GetOwner(); err != nil {
+			return fmt.Errorf("GetOwner: %v", err)
+		}
+		doer = repo.Owner
+	}
+
+	if err = ChangeRepositoryVisibility(doer, repo, s.IsPrivate); err != nil {
+		return fmt.Errorf("ChangeRepositoryVisibility: %v", err)
+	}
+	log.Trace("Scheduled visibility change of repository [%d] is applied: is_private=%v", repo.ID, s.IsPrivate)
+	return nil
+}
+
+// ApplyVisibilitySchedules applies all scheduled visibility changes that are
+// due. Failed changes are retried on the next run unless they are forbidden.
+func ApplyVisibilitySchedules() {
+	if taskStatusTable.IsRunning(_VISIBILITY_SCHEDULES) {
+		return
+	}
+	taskStatusTable.Start(_VISIBILITY_SCHEDULES)
+	defer taskStatusTable.Stop(_VISIBILITY_SCHEDULES)
+
+	log.Trace("Doing: ApplyVisibilitySchedules")
+
+	schedules := make([]*VisibilitySchedule, 0, 10)
+	if err := x.Where("scheduled_unix <= ?", time.Now().Unix()).Asc("scheduled_unix").Find(&schedules); err != nil {
+		log.Error("Failed to find due visibility schedules: %v", err)
+		return
+	}
+	for _, s := range schedules {
+		if err := applyVisibilitySchedule(s); err != nil {
+			log.Error("Failed to apply visibility schedule [repo_id: %d]: %v", s.RepoID, err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_checkVisibilityChange(t *testing.T) {
+	reasonOf := func(err error) string {
+		if err == nil {
+			return ""
+		}
+		return err.(ErrVisibilityChangeForbidden).Reason
+	}
+
+	Convey("Allow changes of repositories", t, func() {
+		policy := &RepoPolicy{}
+		So(checkVisibilityChange(&Repository{IsPrivate: true}, policy, false), ShouldBeNil)
+		So(checkVisibilityChange(&Repository{IsPrivate: false}, policy, true), ShouldBeNil)
+
+		policy = &RepoPolicy{ForcePrivate: true}
+		So(checkVisibilityChange(&Repository{IsPrivate: true}, policy, false), ShouldBeNil)
+	})
+
+	Convey("Forbid changes of forks", t, func() {
+		So(reasonOf(checkVisibilityChange(&Repository{IsFork: true, IsPrivate: true}, &RepoPolicy{}, false)), ShouldEqual, VISIBILITY_FORBIDDEN_FORK)
+	})
+
+	Convey("Forbid making repositories public when visibility change is forbidden", t, func() {
+		policy := &RepoPolicy{ForcePrivate: true, ForbidVisibilityChange: true}
+		So(reasonOf(checkVisibilityChange(&Repository{IsPrivate: true}, policy, false)), ShouldEqual, VISIBILITY_FORBIDDEN_FORCE_PRIVATE)
+		So(checkVisibilityChange(&Repository{IsPrivate: false}, policy, true), ShouldBeNil)
+	})
+}
+
+func Test_ChangeRepositoryVisibility(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "owner", LowerName: "owner"}
+	insertTestBeans(t, owner)
+	repo := &Repository{OwnerID: owner.ID, Owner: owner, Name: "repo", LowerName: "repo"}
+	insertTestBeans(t, repo)
+	if err := ScheduleVisibilityChange(owner, repo, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Keep the scheduled visibility change when visibility does not change", t, func() {
+		So(ChangeRepositoryVisibility(owner, repo, false), ShouldBeNil)
+		_, err := GetVisibilitySchedule(repo.ID)
+		So(err, ShouldBeNil)
+	})
+
+	Convey("Drop the scheduled visibility change when visibility is changed in the meantime", t, func() {
+		s, err := GetVisibilitySchedule(repo.ID)
+		So(err, ShouldBeNil)
+		_, err = x.ID(repo.ID).Cols("is_private").Update(&Repository{IsPrivate: true})
+		So(err, ShouldBeNil)
+
+		So(applyVisibilitySchedule(s), ShouldBeNil)
+		_, err = GetVisibilitySchedule(repo.ID)
+		So(IsErrVisibilityScheduleNotExist(err), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,348 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/email"
+)
+
+// ReviewReminder is the setting of a repository, or an organization for all of
+// its repositories, to remind assignees of open pull requests to review. The
+// setting of a repository takes precedence over the one of its organization.
+//
+// Gogs has no review requests, so the assignee of a pull request is considered
+// as the pending reviewer, and a comment by the assignee as review activity.
+type ReviewReminder struct {
+	ID     int64
+	RepoID int64 `xorm:"UNIQUE(s)"`
+	OrgID  int64 `xorm:"UNIQUE(s)"`
+	// RemindAfter is the number of hours without review activity before the
+	// assignee is reminded.
+	RemindAfter int
+	// EscalateAfter is the number of additional hours without review activity
+	// before repository admins are notified, 0 to never escalate.
+	EscalateAfter int
+	// WorkingDays indicates whether to only count time of working days, i.e.
+	// Monday to Friday, and not to send reminders on weekends.
+	WorkingDays bool
+	// PostComment indicates whether to post a reminder in the timeline of the
+	// pull request.
+	PostComment bool
+}
+
+// ValidateReviewReminder returns an error if the setting is invalid.
+func ValidateReviewReminder(r *ReviewReminder) error {
+	if r.RemindAfter < 1 || r.EscalateAfter < 0 {
+		return ErrInvalidReviewReminder{RemindAfter: r.RemindAfter, EscalateAfter: r.EscalateAfter}
+	}
+	return nil
+}
+
+func getReviewReminder(e Engine, repoID, orgID int64) (*ReviewReminder, error) {
+	r := &ReviewReminder{
+		RepoID: repoID,
+		OrgID:  orgID,
+	}
+	has, err := e.Get(r)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrReviewReminderNotExist{RepoID: repoID, OrgID: orgID}
+	}
+	return r, nil
+}
+
+// GetRepoReviewReminder returns the review reminder setting of the repository
+// itself.
+func GetRepoReviewReminder(repoID int64) (*ReviewReminder, error) {
+	return getReviewReminder(x, repoID, 0)
+}
+
+// GetOrgReviewReminder returns the review reminder setting of the
+// organization.
+func GetOrgReviewReminder(orgID int64) (*ReviewReminder, error) {
+	return getReviewReminder(x, 0, orgID)
+}
+
+// UpdateReviewReminder creates or updates the review reminder setting.
+func UpdateReviewReminder(r *ReviewReminder) error {
+	if err := ValidateReviewReminder(r); err != nil {
+		return err
+	}
+
+	existing, err := getReviewReminder(x, r.RepoID, r.OrgID)
+	if err != nil {
+		if !IsErrReviewReminderNotExist(err) {
+			return err
+		}
+		_, err = x.Insert(r)
+		return err
+	}
+
+	r.ID = existing.ID
+	_, err = x.ID(r.ID).AllCols().Update(r)
+	return err
+}
+
+// DeleteReviewReminder deletes the review reminder setting, which disables
+// reminders unless the organization has its own setting.
+func DeleteReviewReminder(repoID, orgID int64) error {
+	_, err := x.Delete(&ReviewReminder{RepoID: repoID, OrgID: orgID})
+	return err
+}
+
+// ReviewReminderState records reminders that have been sent for a pull request,
+// so they are not sent again after restarts.
+type ReviewReminderState struct {
+	ID         int64
+	IssueID    int64 `xorm:"UNIQUE"`
+	AssigneeID int64
+	// SinceUnix is when the assignee started to be waited for review.
+	SinceUnix     int64
+	RemindedUnix  int64
+	EscalatedUnix int64
+}
+
+// isDraftPullRequest returns true if the title marks the pull request as work
+// in progress.
+func isDraftPullRequest(title string) bool {
+	title = strings.ToUpper(strings.TrimSpace(title))
+	return strings.HasPrefix(title, "WIP:") || strings.HasPrefix(title, "[WIP]")
+}
+
+// reviewWaitDuration returns the duration between from and to, excluding
+// weekends when workingDays is true.
+func reviewWaitDuration(from, to time.Time, workingDays bool) time.Duration {
+	if !workingDays {
+		return to.Sub(from)
+	}
+
+	var d time.Duration
+	for from.Before(to) {
+		next := time.Date(from.Year(), from.Month(), from.Day()+1, 0, 0, 0, 0, from.Location())
+		if next.After(to) {
+			next = to
+		}
+		if day := from.Weekday(); day != time.Saturday && day != time.Sunday {
+			d += next.Sub(from)
+		}
+		from = next
+	}
+	return d
+}
+
+// repoAdmins returns users who have admin access to the repository.
+func repoAdmins(repo *Repository) ([]*User, error) {
+	if err := repo.GetOwner(); err != nil {
+		return nil, fmt.Errorf("GetOwner: %v", err)
+	}
+
+	var admins []*User
+	if repo.Owner.IsOrganization() {
+		t, err := repo.Owner.GetOwnerTeam()
+		if err != nil {
+			return nil, fmt.Errorf("GetOwnerTeam: %v", err)
+		}
+		if err = t.GetMembers(); err != nil {
+			return nil, fmt.Errorf("GetMembers: %v", err)
+		}
+		admins = append(admins, t.Members...)
+	} else {
+		admins = append(admins, repo.Owner)
+	}
+
+	collaborators, err := repo.GetCollaborators()
+	if err != nil {
+		return nil, fmt.Errorf("GetCollaborators: %v", err)
+	}
+	for _, c := range collaborators {
+		if c.Collaboration.Mode >= ACCESS_MODE_ADMIN {
+			admins = append(admins, c.User)
+		}
+	}
+	return admins, nil
+}
+
+// remindPendingReview checks the open pull request and sends the reminder or
+// the escalation when it is due.
+func remindPendingReview(repo *Repository, issue *Issue, setting *ReviewReminder, now time.Time) error {
+	state := &ReviewReminderState{IssueID: issue.ID}
+	has, err := x.Get(state)
+	if err != nil {
+		return fmt.Errorf("get state: %v", err)
+	} else if !has {
+		state.AssigneeID = issue.AssigneeID
+		state.SinceUnix = issue.CreatedUnix
+	} else if state.AssigneeID != issue.AssigneeID {
+		// Start over for the new assignee.
+		state.AssigneeID = issue.AssigneeID
+		state.SinceUnix = now.Unix()
+		state.RemindedUnix = 0
+		state.EscalatedUnix = 0
+	}
+	save := func() error {
+		if state.ID == 0 {
+			_, err = x.Insert(state)
+		} else {
+			_, err = x.ID(state.ID).AllCols().Update(state)
+		}
+		return err
+	}
+
+	if issue.AssigneeID == issue.PosterID || isDraftPullRequest(issue.Title) ||
+		(setting.WorkingDays && (now.Weekday() == time.Saturday || now.Weekday() == time.Sunday)) {
+		return save()
+	}
+
+	// Reminders stop once the assignee has commented.
+	reviewed, err := x.Where("issue_id = ? AND poster_id = ? AND type = ? AND created_unix >= ?",
+		issue.ID, issue.AssigneeID, COMMENT_TYPE_COMMENT, state.SinceUnix).Count(new(Comment))
+	if err != nil {
+		return fmt.Errorf("count comments: %v", err)
+	} else if reviewed > 0 {
+		return save()
+	}
+
+	waited := reviewWaitDuration(time.Unix(state.SinceUnix, 0), now, setting.WorkingDays)
+	remindAfter := time.Duration(setting.RemindAfter) * time.Hour
+	escalateAfter := remindAfter + time.Duration(setting.EscalateAfter)*time.Hour
+	issue.Repo = repo
+	switch {
+	case state.RemindedUnix == 0 && waited >= remindAfter:
+		assignee, err := GetUserByID(issue.AssigneeID)
+		if err != nil {
+			return fmt.Errorf("GetUserByID [%d]: %v", issue.AssigneeID, err)
+		}
+		// Postpone the reminder until the muted period is over.
+		if repo.IsMutedFor(assignee, now) {
+			return save()
+		}
+
+		if conf.User.EnableEmailNotification && assignee.IsMailable() {
+			email.SendReviewReminderMail(NewMailerIssue(issue), NewMailerRepo(repo), NewMailerUser(assignee), []string{assignee.Email}, int(waited.Hours()), false)
+		}
+		if setting.PostComment {
+			if err = repo.GetOwner(); err != nil {
+				return fmt.Errorf("GetOwner: %v", err)
+			}
+			if _, err = CreateComment(&CreateCommentOptions{
+				Type:    COMMENT_TYPE_REVIEW_REMINDER,
+				Doer:    assignee,
+				Repo:    repo,
+				Issue:   issue,
+				Content: fmt.Sprintf("%d", int(waited.Hours())),
+			}); err != nil {
+				return fmt.Errorf("CreateComment: %v", err)
+			}
+		}
+		state.RemindedUnix = now.Unix()
+
+	case state.RemindedUnix > 0 && state.EscalatedUnix == 0 && setting.EscalateAfter > 0 && waited >= escalateAfter:
+		assignee, err := GetUserByID(issue.AssigneeID)
+		if err != nil {
+			return fmt.Errorf("GetUserByID [%d]: %v", issue.AssigneeID, err)
+		}
+		admins, err := repoAdmins(repo)
+		if err != nil {
+			return fmt.Errorf("repoAdmins: %v", err)
+		}
+
+		tos := make([]string, 0, len(admins))
+		seen := make(map[int64]bool)
+		for _, u := range admins {
+			if seen[u.ID] || u.ID == assignee.ID || !u.IsMailable() || repo.IsMutedFor(u, now) {
+				continue
+			}
+			seen[u.ID] = true
+			tos = append(tos, u.Email)
+		}
+		if conf.User.EnableEmailNotification {
+			email.SendReviewReminderMail(NewMailerIssue(issue), NewMailerRepo(repo), NewMailerUser(assignee), tos, int(waited.Hours()), true)
+		}
+		state.EscalatedUnix = now.Unix()
+	}
+	return save()
+}
+
+// remindPendingReviewsOfRepo checks all open pull requests that have assignees
+// in the repository.
+func remindPendingReviewsOfRepo(repoID int64, setting *ReviewReminder, now time.Time) error {
+	repo, err := GetRepositoryByID(repoID)
+	if err != nil {
+		return fmt.Errorf("GetRepositoryByID: %v", err)
+	} else if !repo.AllowsPulls() {
+		return nil
+	}
+
+	issues := make([]*Issue, 0, 10)
+	if err = x.Where("repo_id = ? AND is_pull = ? AND is_closed = ? AND assignee_id > 0", repoID, true, false).Find(&issues); err != nil {
+		return fmt.Errorf("find pull requests: %v", err)
+	}
+	for _, issue := range issues {
+		if err = remindPendingReview(repo, issue, setting, now); err != nil {
+			log.Error("Failed to remind pending review [issue_id: %d]: %v", issue.ID, err)
+		}
+	}
+	return nil
+}
+
+// RemindPendingReviews sends reminders for pull requests that have been waiting
+// for review longer than configured by their repositories or organizations.
+func RemindPendingReviews() {
+	if taskStatusTable.IsRunning(_REVIEW_REMINDERS) {
+		return
+	}
+	taskStatusTable.Start(_REVIEW_REMINDERS)
+	defer taskStatusTable.Stop(_REVIEW_REMINDERS)
+
+	log.Trace("Doing: RemindPendingReviews")
+
+	// States of closed pull requests are no longer needed.
+	if _, err := x.Exec("DELETE FROM review_reminder_state WHERE issue_id IN (SELECT id FROM issue WHERE is_closed = ?)", true); err != nil {
+		log.Error("Failed to delete states of closed pull requests: %v", err)
+	}
+
+	settings := make([]*ReviewReminder, 0, 10)
+	if err := x.Find(&settings); err != nil {
+		log.Error("Failed to find review reminders: %v", err)
+		return
+	}
+
+	repoSettings := make(map[int64]*ReviewReminder)
+	for _, s := range settings {
+		if s.RepoID > 0 {
+			repoSettings[s.RepoID] = s
+		}
+	}
+	for _, s := range settings {
+		if s.OrgID == 0 {
+			continue
+		}
+		repoIDs := make([]int64, 0, 10)
+		if err := x.Table("repository").Where("owner_id = ?", s.OrgID).Cols("id").Find(&repoIDs); err != nil {
+			log.Error("Failed to find repositories of organization [%d]: %v", s.OrgID, err)
+			continue
+		}
+		for _, repoID := range repoIDs {
+			if repoSettings[repoID] == nil {
+				repoSettings[repoID] = s
+			}
+		}
+	}
+
+	now := time.Now()
+	for repoID, s := range repoSettings {
+		if err := remindPendingReviewsOfRepo(repoID, s, now); err != nil {
+			log.Error("Failed to remind pending reviews [repo_id: %d]: %v", repoID, err)
+		}
+	}
+}
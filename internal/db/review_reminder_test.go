@@ -0,0 +1,47 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_isDraftPullRequest(t *testing.T) {
+	Convey("Detect draft pull requests by title", t, func() {
+		testCases := []struct {
+			title  string
+			expect bool
+		}{
+			{"WIP: add feature", true},
+			{"wip: add feature", true},
+			{"  [WIP] add feature", true},
+			{"Add feature", false},
+			{"Add WIP: feature", false},
+		}
+		for _, tc := range testCases {
+			So(isDraftPullRequest(tc.title), ShouldEqual, tc.expect)
+		}
+	})
+}
+
+func Test_reviewWaitDuration(t *testing.T) {
+	// 2020-01-10 is a Friday.
+	at := func(day, hour int) time.Time {
+		return time.Date(2020, 1, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	Convey("Count all days", t, func() {
+		So(reviewWaitDuration(at(10, 12), at(13, 12), false), ShouldEqual, 72*time.Hour)
+	})
+
+	Convey("Count working days only", t, func() {
+		So(reviewWaitDuration(at(10, 12), at(13, 12), true), ShouldEqual, 24*time.Hour)
+		So(reviewWaitDuration(at(11, 12), at(12, 12), true), ShouldEqual, time.Duration(0))
+		So(reviewWaitDuration(at(8, 9), at(8, 17), true), ShouldEqual, 8*time.Hour)
+	})
+}
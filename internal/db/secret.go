@@ -0,0 +1,294 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/unknwon/com"
+	log "unknwon.dev/clog/v2"
+	"xorm.io/xorm"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/lazyregexp"
+	"gogs.io/gogs/internal/tool"
+)
+
+// Secret is a named value of a repository or an organization, which can be
+// referenced as "${secrets.NAME}" in configuration of webhooks and is
+// interpolated when used. The value is encrypted by "[security] SECRET_KEY"
+// and never displayed again after creation.
+type Secret struct {
+	ID     int64
+	RepoID int64  `xorm:"UNIQUE(s)"`
+	OrgID  int64  `xorm:"UNIQUE(s)"`
+	Name   string `xorm:"UNIQUE(s) NOT NULL"`
+	Value  string `xorm:"TEXT NOT NULL" json:"-"`
+
+	Created     time.Time `xorm:"-" json:"-"`
+	CreatedUnix int64
+	Updated     time.Time `xorm:"-" json:"-"`
+	UpdatedUnix int64
+}
+
+func (s *Secret) BeforeInsert() {
+	s.CreatedUnix = time.Now().Unix()
+	s.UpdatedUnix = s.CreatedUnix
+}
+
+func (s *Secret) BeforeUpdate() {
+	s.UpdatedUnix = time.Now().Unix()
+}
+
+func (s *Secret) AfterSet(colName string, _ xorm.Cell) {
+	switch colName {
+	case "created_unix":
+		s.Created = time.Unix(s.CreatedUnix, 0).Local()
+	case "updated_unix":
+		s.Updated = time.Unix(s.UpdatedUnix, 0).Local()
+	}
+}
+
+const maxSecretNameLength = 64
+
+var (
+	secretNamePattern      = lazyregexp.New(`^[A-Z_][A-Z0-9_]*$`)
+	secretReferencePattern = lazyregexp.New(`\$\{secrets\.([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// NormalizeSecretName returns the name in upper case, or an error if it is not
+// a valid name of secrets, which consists of letters, digits and underscores
+// and does not start with a digit.
+func NormalizeSecretName(name string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(name))
+	if len(normalized) > maxSecretNameLength || !secretNamePattern.MatchString(normalized) {
+		return "", ErrInvalidSecretName{Name: name}
+	}
+	return normalized, nil
+}
+
+// SecretReferences returns distinct names of secrets referenced in the text,
+// in upper case.
+func SecretReferences(text string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, m := range secretReferencePattern.FindAllStringSubmatch(text, -1) {
+		name := strings.ToUpper(m[1])
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func encryptSecret(value string) (string, error) {
+	encrypted, err := com.AESGCMEncrypt(tool.MD5Bytes(conf.Security.SecretKey), []byte(value))
+	if err != nil {
+		return "", fmt.Errorf("AESGCMEncrypt: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func decryptSecret(value string) (string, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("DecodeString: %v", err)
+	}
+	decrypted, err := com.AESGCMDecrypt(tool.MD5Bytes(conf.Security.SecretKey), encrypted)
+	if err != nil {
+		return "", fmt.Errorf("AESGCMDecrypt: %v", err)
+	}
+	return string(decrypted), nil
+}
+
+func getSecret(e Engine, repoID, orgID int64, name string) (*Secret, error) {
+	s := &Secret{
+		RepoID: repoID,
+		OrgID:  orgID,
+		Name:   name,
+	}
+	has, err := e.Get(s)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrSecretNotExist{RepoID: repoID, OrgID: orgID, Name: name}
+	}
+	return s, nil
+}
+
+// GetSecrets returns secrets of the repository or the organization ordered by
+// name. Values of secrets are still encrypted.
+func GetSecrets(repoID, orgID int64) ([]*Secret, error) {
+	secrets := make([]*Secret, 0, 5)
+	return secrets, x.Where("repo_id = ? AND org_id = ?", repoID, orgID).Asc("name").Find(&secrets)
+}
+
+// SetSecret creates the secret of the repository or the organization, or
+// replaces the value of the existing one with the same name.
+func SetSecret(repoID, orgID int64, name, value string) error {
+	name, err := NormalizeSecretName(name)
+	if err != nil {
+		return err
+	}
+	encrypted, err := encryptSecret(value)
+	if err != nil {
+		return err
+	}
+
+	s, err := getSecret(x, repoID, orgID, name)
+	if err != nil {
+		if !IsErrSecretNotExist(err) {
+			return err
+		}
+		_, err = x.Insert(&Secret{
+			RepoID: repoID,
+			OrgID:  orgID,
+			Name:   name,
+			Value:  encrypted,
+		})
+		return err
+	}
+
+	s.Value = encrypted
+	_, err = x.ID(s.ID).Cols("value", "updated_unix").Update(s)
+	return err
+}
+
+// DeleteSecret deletes the secret of the repository or the organization. It
+// refuses to delete the secret while webhooks still reference it.
+func DeleteSecret(repoID, orgID int64, name string) error {
+	if _, err := getSecret(x, repoID, orgID, name); err != nil {
+		return err
+	}
+
+	refs, err := GetSecretReferences(repoID, orgID, name)
+	if err != nil {
+		return fmt.Errorf("GetSecretReferences: %v", err)
+	} else if len(refs) > 0 {
+		return ErrSecretInUse{RepoID: repoID, OrgID: orgID, Name: name, References: refs}
+	}
+
+	_, err = x.Delete(&Secret{RepoID: repoID, OrgID: orgID, Name: name})
+	return err
+}
+
+// SecretReference is a webhook that references a secret.
+type SecretReference struct {
+	HookID int64
+	URL    string
+	Link   string
+}
+
+func webhookReferences(w *Webhook, name string) bool {
+	for _, ref := range append(SecretReferences(w.URL), SecretReferences(w.Secret)...) {
+		if ref == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSecretReferences returns webhooks that reference the secret of the
+// repository or the organization.
+func GetSecretReferences(repoID, orgID int64, name string) ([]*SecretReference, error) {
+	refs := make([]*SecretReference, 0, 5)
+	if repoID > 0 {
+		repo, err := GetRepositoryByID(repoID)
+		if err != nil {
+			return nil, fmt.Errorf("GetRepositoryByID: %v", err)
+		}
+		ws, err := GetWebhooksByRepoID(repoID)
+		if err != nil {
+			return nil, fmt.Errorf("GetWebhooksByRepoID: %v", err)
+		}
+		for _, w := range ws {
+			if webhookReferences(w, name) {
+				refs = append(refs, &SecretReference{
+					HookID: w.ID,
+					URL:    w.URL,
+					Link:   fmt.Sprintf("%s/settings/hooks/%d", repo.Link(), w.ID),
+				})
+			}
+		}
+		return refs, nil
+	}
+
+	org, err := GetUserByID(orgID)
+	if err != nil {
+		return nil, fmt.Errorf("GetUserByID: %v", err)
+	}
+	ws, err := GetWebhooksByOrgID(orgID)
+	if err != nil {
+		return nil, fmt.Errorf("GetWebhooksByOrgID: %v", err)
+	}
+	for _, w := range ws {
+		if webhookReferences(w, name) {
+			refs = append(refs, &SecretReference{
+				HookID: w.ID,
+				URL:    w.URL,
+				Link:   fmt.Sprintf("%s/org/%s/settings/hooks/%d", conf.Server.Subpath, org.Name, w.ID),
+			})
+		}
+	}
+	return refs, nil
+}
+
+// interpolateSecrets replaces references of secrets in the text with values of
+// secrets of the repository or the organization, exactly one of the IDs is
+// non-zero. Every use is logged by the name of the secret with given usage,
+// but never the value.
+func interpolateSecrets(repoID, orgID int64, text, usage string) (string, error) {
+	names := SecretReferences(text)
+	if len(names) == 0 {
+		return text, nil
+	}
+
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		s, err := getSecret(x, repoID, orgID, name)
+		if err != nil {
+			return "", err
+		}
+
+		values[name], err = decryptSecret(s.Value)
+		if err != nil {
+			return "", fmt.Errorf("decrypt secret %q: %v", name, err)
+		}
+		log.Info("Secret used [repo_id: %d, org_id: %d, name: %s]: %s", s.RepoID, s.OrgID, name, usage)
+	}
+
+	return secretReferencePattern.ReplaceAllStringFunc(text, func(ref string) string {
+		m := secretReferencePattern.FindStringSubmatch(ref)
+		return values[strings.ToUpper(m[1])]
+	}), nil
+}
+
+// interpolateSecrets replaces references of secrets in the text with their
+// values. Webhooks of a repository only use secrets of the repository, and
+// webhooks of an organization only use secrets of the organization, so admins
+// of a single repository can never read secrets of the organization.
+func (w *Webhook) interpolateSecrets(text string) (string, error) {
+	usage := fmt.Sprintf("webhook %d", w.ID)
+	if w.OrgID > 0 {
+		return interpolateSecrets(0, w.OrgID, text, usage)
+	}
+	return interpolateSecrets(w.RepoID, 0, text, usage)
+}
+
+// maskURLError replaces the URL in the error of a request with the one before
+// interpolation, so values of secrets are not recorded.
+func maskURLError(err error, rawURL string) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = rawURL
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_NormalizeSecretName(t *testing.T) {
+	Convey("Normalize names of secrets", t, func() {
+		for name, expected := range map[string]string{
+			"deploy_token": "DEPLOY_TOKEN",
+			" _Env2 ":      "_ENV2",
+		} {
+			normalized, err := NormalizeSecretName(name)
+			So(err, ShouldBeNil)
+			So(normalized, ShouldEqual, expected)
+		}
+
+		for _, name := range []string{"", "2FA", "MY-TOKEN", "SECRET.NAME", "SECRET NAME"} {
+			_, err := NormalizeSecretName(name)
+			So(IsErrInvalidSecretName(err), ShouldBeTrue)
+		}
+	})
+}
+
+func Test_SecretReferences(t *testing.T) {
+	Convey("Find references of secrets", t, func() {
+		So(SecretReferences("https://example.com/hook"), ShouldBeEmpty)
+		So(SecretReferences("https://example.com/${secrets.Env}/hook?token=${secrets.TOKEN}&env=${secrets.env}"),
+			ShouldResemble, []string{"ENV", "TOKEN"})
+		So(SecretReferences("${secrets.2FA} ${secret.TOKEN} $secrets.TOKEN"), ShouldBeEmpty)
+	})
+}
+
+func Test_encryptSecret(t *testing.T) {
+	Convey("Encrypt and decrypt values of secrets", t, func() {
+		encrypted, err := encryptSecret("s3cr3t")
+		So(err, ShouldBeNil)
+		So(encrypted, ShouldNotContainSubstring, "s3cr3t")
+
+		decrypted, err := decryptSecret(encrypted)
+		So(err, ShouldBeNil)
+		So(decrypted, ShouldEqual, "s3cr3t")
+	})
+}
+
+func Test_Webhook_interpolateSecrets(t *testing.T) {
+	setupTestDB(t)
+
+	org := &User{Name: "org", LowerName: "org", Type: USER_TYPE_ORGANIZATION}
+	insertTestBeans(t, org)
+	repo := &Repository{OwnerID: org.ID, Name: "repo", LowerName: "repo"}
+	insertTestBeans(t, repo)
+	if err := SetSecret(0, org.ID, "org_token", "org-value"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetSecret(repo.ID, 0, "repo_token", "repo-value"); err != nil {
+		t.Fatal(err)
+	}
+
+	repoHook := &Webhook{RepoID: repo.ID}
+	orgHook := &Webhook{OrgID: org.ID}
+
+	Convey("Webhooks of a repository only use secrets of the repository", t, func() {
+		text, err := repoHook.interpolateSecrets("https://example.com/${secrets.REPO_TOKEN}")
+		So(err, ShouldBeNil)
+		So(text, ShouldEqual, "https://example.com/repo-value")
+
+		_, err = repoHook.interpolateSecrets("https://example.com/${secrets.ORG_TOKEN}")
+		So(IsErrSecretNotExist(err), ShouldBeTrue)
+	})
+
+	Convey("Webhooks of an organization only use secrets of the organization", t, func() {
+		text, err := orgHook.interpolateSecrets("https://example.com/${secrets.ORG_TOKEN}")
+		So(err, ShouldBeNil)
+		So(text, ShouldEqual, "https://example.com/org-value")
+
+		_, err = orgHook.interpolateSecrets("https://example.com/${secrets.REPO_TOKEN}")
+		So(IsErrSecretNotExist(err), ShouldBeTrue)
+	})
+}
+
+func Test_DeleteSecret(t *testing.T) {
+	setupTestDB(t)
+
+	owner := &User{Name: "alice", LowerName: "alice"}
+	insertTestBeans(t, owner)
+	repo := &Repository{OwnerID: owner.ID, Name: "repo", LowerName: "repo"}
+	insertTestBeans(t, repo)
+	if err := SetSecret(repo.ID, 0, "TOKEN", "value"); err != nil {
+		t.Fatal(err)
+	}
+	hook := &Webhook{RepoID: repo.ID, URL: "https://example.com/${secrets.TOKEN}"}
+	insertTestBeans(t, hook)
+
+	Convey("Refuse to delete secrets referenced by webhooks", t, func() {
+		err := DeleteSecret(repo.ID, 0, "TOKEN")
+		So(IsErrSecretInUse(err), ShouldBeTrue)
+		So(err.(ErrSecretInUse).References, ShouldHaveLength, 1)
+		So(err.(ErrSecretInUse).References[0].HookID, ShouldEqual, hook.ID)
+
+		_, err = getSecret(x, repo.ID, 0, "TOKEN")
+		So(err, ShouldBeNil)
+	})
+
+	Convey("Delete secrets no longer referenced", t, func() {
+		hook.URL = "https://example.com/hook"
+		So(UpdateWebhook(hook), ShouldBeNil)
+
+		So(DeleteSecret(repo.ID, 0, "TOKEN"), ShouldBeNil)
+		_, err := getSecret(x, repo.ID, 0, "TOKEN")
+		So(IsErrSecretNotExist(err), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,14 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+// Session is the table used by the "mysql" and "postgres" session providers
+// to keep sessions in the database, which allows multiple nodes to share them.
+// It is only read and written by the session providers.
+type Session struct {
+	Key    string `xorm:"pk CHAR(16)"`
+	Data   []byte `xorm:"BLOB"`
+	Expiry int64
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SiteContent is content of the site managed by admins, there is at most one
+// row of it.
+type SiteContent struct {
+	ID int64
+	// HomeNotice is a notice in Markdown shown on the landing page and
+	// dashboards.
+	HomeNotice string `xorm:"TEXT"`
+	// HeadSnippet is HTML injected into the head of every page as is, e.g.
+	// scripts of analytics.
+	HeadSnippet string `xorm:"TEXT"`
+	UpdatedUnix int64
+}
+
+// FooterLink is a link shown in the footer of every page, e.g. to the terms
+// of service or the imprint of the site.
+type FooterLink struct {
+	ID       int64
+	Label    string
+	URL      string `xorm:"TEXT"`
+	Position int
+}
+
+// ValidateFooterLink returns an error if the link has no label or its URL is
+// neither an absolute HTTP(S) URL nor a path on the site, so that links cannot
+// run scripts when clicked.
+func ValidateFooterLink(label, rawURL string) error {
+	if strings.TrimSpace(label) == "" {
+		return ErrInvalidFooterLink{label, rawURL}
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ErrInvalidFooterLink{label, rawURL}
+	}
+	isSitePath := u.Scheme == "" && u.Host == "" && strings.HasPrefix(u.Path, "/") && !strings.HasPrefix(rawURL, "//")
+	if !isSitePath && ((u.Scheme != "http" && u.Scheme != "https") || u.Host == "") {
+		return ErrInvalidFooterLink{label, rawURL}
+	}
+	return nil
+}
+
+// siteContentCache keeps the site content and footer links, which are shown
+// on every page, in memory until they are updated.
+var siteContentCache = struct {
+	sync.RWMutex
+	loaded  bool
+	content *SiteContent
+	links   []*FooterLink
+}{}
+
+func loadSiteContent() (*SiteContent, []*FooterLink, error) {
+	// Pages of installation are rendered before the database is available.
+	if x == nil {
+		return new(SiteContent), nil, nil
+	}
+
+	siteContentCache.RLock()
+	if siteContentCache.loaded {
+		defer siteContentCache.RUnlock()
+		return siteContentCache.content, siteContentCache.links, nil
+	}
+	siteContentCache.RUnlock()
+
+	siteContentCache.Lock()
+	defer siteContentCache.Unlock()
+	if siteContentCache.loaded {
+		return siteContentCache.content, siteContentCache.links, nil
+	}
+
+	content := new(SiteContent)
+	if _, err := x.Get(content); err != nil {
+		return nil, nil, fmt.Errorf("get site content: %v", err)
+	}
+	links := make([]*FooterLink, 0, 5)
+	if err := x.Asc("position").Find(&links); err != nil {
+		return nil, nil, fmt.Errorf("find footer links: %v", err)
+	}
+
+	siteContentCache.loaded = true
+	siteContentCache.content = content
+	siteContentCache.links = links
+	return content, links, nil
+}
+
+// GetSiteContent returns the site content, which is empty if it has never
+// been saved. The returned value is shared and must not be modified.
+func GetSiteContent() (*SiteContent, error) {
+	content, _, err := loadSiteContent()
+	return content, err
+}
+
+// GetFooterLinks returns footer links of the site in the order they are
+// shown. The returned links are shared and must not be modified.
+func GetFooterLinks() ([]*FooterLink, error) {
+	_, links, err := loadSiteContent()
+	return links, err
+}
+
+// UpdateSiteContent saves the site content and replaces all footer links with
+// given links in order, which take effect on the next page rendered.
+func UpdateSiteContent(content *SiteContent, links []*FooterLink) (err error) {
+	for _, link := range links {
+		if err = ValidateFooterLink(link.Label, link.URL); err != nil {
+			return err
+		}
+	}
+
+	old := new(SiteContent)
+	if _, err = x.Get(old); err != nil {
+		return fmt.Errorf("get site content: %v", err)
+	}
+
+	sess := x.NewSession()
+	defer sess.Close()
+	if err = sess.Begin(); err != nil {
+		return err
+	}
+
+	content.UpdatedUnix = time.Now().Unix()
+	if old.ID == 0 {
+		content.ID = 0
+		if _, err = sess.Insert(content); err != nil {
+			return fmt.Errorf("insert site content: %v", err)
+		}
+	} else {
+		content.ID = old.ID
+		if _, err = sess.ID(old.ID).AllCols().Update(content); err != nil {
+			return fmt.Errorf("update site content: %v", err)
+		}
+	}
+
+	if _, err = sess.Where("1 = 1").Delete(new(FooterLink)); err != nil {
+		return fmt.Errorf("delete footer links: %v", err)
+	}
+	for i, link := range links {
+		link.ID = 0
+		link.Position = i
+		if _, err = sess.Insert(link); err != nil {
+			return fmt.Errorf("insert footer link: %v", err)
+		}
+	}
+
+	if err = sess.Commit(); err != nil {
+		return err
+	}
+
+	siteContentCache.Lock()
+	siteContentCache.loaded = false
+	siteContentCache.content = nil
+	siteContentCache.links = nil
+	siteContentCache.Unlock()
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_ValidateFooterLink(t *testing.T) {
+	Convey("Validate footer links of the site", t, func() {
+		So(ValidateFooterLink("Terms", "https://example.com/terms"), ShouldBeNil)
+		So(ValidateFooterLink("Imprint", "http://example.com/imprint"), ShouldBeNil)
+		So(ValidateFooterLink("Help", "/explore/repos"), ShouldBeNil)
+
+		So(IsErrInvalidFooterLink(ValidateFooterLink("", "https://example.com")), ShouldBeTrue)
+		So(IsErrInvalidFooterLink(ValidateFooterLink(" ", "/terms")), ShouldBeTrue)
+		So(IsErrInvalidFooterLink(ValidateFooterLink("Script", "javascript:alert(1)")), ShouldBeTrue)
+		So(IsErrInvalidFooterLink(ValidateFooterLink("Data", "data:text/html,<script>")), ShouldBeTrue)
+		So(IsErrInvalidFooterLink(ValidateFooterLink("Protocol relative", "//evil.example.com")), ShouldBeTrue)
+		So(IsErrInvalidFooterLink(ValidateFooterLink("Relative", "terms")), ShouldBeTrue)
+		So(IsErrInvalidFooterLink(ValidateFooterLink("No host", "https:///path")), ShouldBeTrue)
+		So(IsErrInvalidFooterLink(ValidateFooterLink("Empty", "")), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,304 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogs/git-module"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/packet"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/process"
+)
+
+// Statuses of tag signatures.
+const (
+	TAG_SIGNATURE_UNSIGNED   = "unsigned"
+	TAG_SIGNATURE_UNVERIFIED = "unverified"
+	TAG_SIGNATURE_VERIFIED   = "verified"
+)
+
+// TagVerification is the result of verifying the GPG signature of a tag.
+type TagVerification struct {
+	Status string
+	// KeyID is the ID of the key that made the signature in hexadecimal, which
+	// is empty when the tag is unsigned.
+	KeyID string
+	// Signer is the identity of the trusted key that made the signature, e.g.
+	// "Full Name <email>", which is only set when the signature is verified.
+	Signer string
+}
+
+// IsVerified returns true if the tag is signed by a trusted key.
+func (v *TagVerification) IsVerified() bool {
+	return v.Status == TAG_SIGNATURE_VERIFIED
+}
+
+const pgpSignatureBegin = "-----BEGIN PGP SIGNATURE-----"
+
+// loadTrustedKeyring returns keys of "[security] TRUSTED_GPG_KEYRING", which
+// is empty when not set.
+func loadTrustedKeyring() (openpgp.EntityList, error) {
+	if conf.Security.TrustedGPGKeyring == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(conf.Security.TrustedGPGKeyring)
+	if err != nil {
+		return nil, fmt.Errorf("open: %v", err)
+	}
+	defer f.Close()
+
+	keyring, err := openpgp.ReadArmoredKeyRing(f)
+	if err != nil {
+		return nil, fmt.Errorf("read armored keyring: %v", err)
+	}
+	return keyring, nil
+}
+
+// signatureKeyID returns the ID of the key that made the armored signature in
+// hexadecimal, or an empty string if it cannot be parsed.
+func signatureKeyID(signature string) string {
+	block, err := armor.Decode(strings.NewReader(signature))
+	if err != nil {
+		return ""
+	}
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return ""
+	}
+
+	switch sig := p.(type) {
+	case *packet.Signature:
+		if sig.IssuerKeyId != nil {
+			return fmt.Sprintf("%016X", *sig.IssuerKeyId)
+		}
+	case *packet.SignatureV3:
+		return fmt.Sprintf("%016X", sig.IssuerKeyId)
+	}
+	return ""
+}
+
+// verifyTagObject verifies the signature appended to the raw content of an
+// annotated tag object against keys of the keyring.
+func verifyTagObject(keyring openpgp.EntityList, data []byte) *TagVerification {
+	i := bytes.Index(data, []byte(pgpSignatureBegin))
+	if i < 0 {
+		return &TagVerification{Status: TAG_SIGNATURE_UNSIGNED}
+	}
+	payload, signature := data[:i], string(data[i:])
+
+	v := &TagVerification{
+		Status: TAG_SIGNATURE_UNVERIFIED,
+		KeyID:  signatureKeyID(signature),
+	}
+	signer, err := openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(payload), strings.NewReader(signature))
+	if err != nil {
+		return v
+	}
+
+	v.Status = TAG_SIGNATURE_VERIFIED
+	if signer.PrimaryKey != nil {
+		v.KeyID = signer.PrimaryKey.KeyIdString()
+	}
+	for name := range signer.Identities {
+		if v.Signer == "" || name < v.Signer {
+			v.Signer = name
+		}
+	}
+	return v
+}
+
+// maxTagVerifications is the maximum number of verifications of tag objects
+// that are cached.
+const maxTagVerifications = 10000
+
+// tagVerificationCache caches verifications of tag objects by their IDs, which
+// stay the same until the trusted keyring is changed.
+type tagVerificationCache struct {
+	sync.Mutex
+	// stamp identifies the version of the keyring file the cache is for.
+	stamp   string
+	keyring openpgp.EntityList
+	results map[string]*TagVerification
+}
+
+var tagVerifications = &tagVerificationCache{}
+
+// trustedKeyringStamp returns the string that changes whenever the file of
+// "[security] TRUSTED_GPG_KEYRING" is changed.
+func trustedKeyringStamp() (string, error) {
+	if conf.Security.TrustedGPGKeyring == "" {
+		return "", nil
+	}
+
+	fi, err := os.Stat(conf.Security.TrustedGPGKeyring)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%d:%d", conf.Security.TrustedGPGKeyring, fi.Size(), fi.ModTime().UnixNano()), nil
+}
+
+// lookup returns the keyring of the stamp and cached verifications of the tag
+// objects, reloading the keyring and clearing the cache if the stamp changed.
+func (c *tagVerificationCache) lookup(stamp string, objectIDs []string) (openpgp.EntityList, map[string]*TagVerification, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.results == nil || c.stamp != stamp {
+		keyring, err := loadTrustedKeyring()
+		if err != nil {
+			return nil, nil, err
+		}
+		c.stamp = stamp
+		c.keyring = keyring
+		c.results = make(map[string]*TagVerification)
+	}
+
+	hits := make(map[string]*TagVerification, len(objectIDs))
+	for _, id := range objectIDs {
+		if v, ok := c.results[id]; ok {
+			hits[id] = v
+		}
+	}
+	return c.keyring, hits, nil
+}
+
+// add caches verifications of tag objects made with the keyring of the stamp.
+func (c *tagVerificationCache) add(stamp string, results map[string]*TagVerification) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.stamp != stamp {
+		return
+	} else if len(c.results)+len(results) > maxTagVerifications {
+		c.results = make(map[string]*TagVerification)
+	}
+	for id, v := range results {
+		c.results[id] = v
+	}
+}
+
+// parseCatFileBatch parses the output of "git cat-file --batch" into contents
+// of objects by their IDs, skipping missing objects.
+func parseCatFileBatch(output []byte) map[string][]byte {
+	objects := make(map[string][]byte)
+	for len(output) > 0 {
+		i := bytes.IndexByte(output, '\n')
+		if i < 0 {
+			break
+		}
+		fields := strings.Fields(string(output[:i]))
+		output = output[i+1:]
+		if len(fields) != 3 {
+			continue
+		}
+
+		size, err := strconv.Atoi(fields[2])
+		if err != nil || size > len(output) {
+			break
+		}
+		objects[fields[0]] = output[:size]
+		output = output[size:]
+		if len(output) > 0 && output[0] == '\n' {
+			output = output[1:]
+		}
+	}
+	return objects
+}
+
+// TagSignatures verifies GPG signatures of the tags against keys of
+// "[security] TRUSTED_GPG_KEYRING", and returns verifications by tag names.
+// Lightweight tags and annotated tags without signatures are unsigned, and
+// tags that do not exist are left out. Verifications are cached by IDs of tag
+// objects, so only new tags are read and verified.
+func (repo *Repository) TagSignatures(tagNames ...string) (map[string]*TagVerification, error) {
+	results := make(map[string]*TagVerification, len(tagNames))
+	if len(tagNames) == 0 {
+		return results, nil
+	}
+
+	repoPath := repo.RepoPath()
+	args := []string{"for-each-ref", "--format=%(objectname) %(objecttype) %(refname)"}
+	for _, name := range tagNames {
+		args = append(args, git.TAG_PREFIX+name)
+	}
+	stdout, stderr, err := process.ExecDir(-1, repoPath, fmt.Sprintf("TagSignatures (git for-each-ref): %s", repoPath), "git", args...)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %v - %s", err, stderr)
+	}
+
+	requested := make(map[string]bool, len(tagNames))
+	for _, name := range tagNames {
+		requested[name] = true
+	}
+	tagObjects := make(map[string]string) // Tag name -> object ID
+	var objectIDs []string
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		// Patterns of for-each-ref also match tags under the names as directories.
+		name := strings.TrimPrefix(fields[2], git.TAG_PREFIX)
+		if !requested[name] {
+			continue
+		}
+		if fields[1] != "tag" {
+			results[name] = &TagVerification{Status: TAG_SIGNATURE_UNSIGNED}
+			continue
+		}
+		tagObjects[name] = fields[0]
+		objectIDs = append(objectIDs, fields[0])
+	}
+	if len(objectIDs) == 0 {
+		return results, nil
+	}
+
+	stamp, err := trustedKeyringStamp()
+	if err != nil {
+		return nil, fmt.Errorf("stat trusted keyring: %v", err)
+	}
+	keyring, verifications, err := tagVerifications.lookup(stamp, objectIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load trusted keyring: %v", err)
+	}
+
+	var misses []string
+	for _, id := range objectIDs {
+		if verifications[id] == nil {
+			misses = append(misses, id)
+		}
+	}
+	if len(misses) > 0 {
+		stdout, stderr, err = process.ExecDirStdin(-1, repoPath, fmt.Sprintf("TagSignatures (git cat-file): %s", repoPath),
+			strings.NewReader(strings.Join(misses, "\n")+"\n"), "git", "cat-file", "--batch")
+		if err != nil {
+			return nil, fmt.Errorf("read tag objects: %v - %s", err, stderr)
+		}
+
+		verified := make(map[string]*TagVerification, len(misses))
+		for id, data := range parseCatFileBatch([]byte(stdout)) {
+			verified[id] = verifyTagObject(keyring, data)
+			verifications[id] = verified[id]
+		}
+		tagVerifications.add(stamp, verified)
+	}
+
+	for name, id := range tagObjects {
+		if v := verifications[id]; v != nil {
+			results[name] = v
+		}
+	}
+	return results, nil
+}
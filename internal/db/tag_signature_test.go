@@ -0,0 +1,173 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/testutil"
+)
+
+func Test_verifyTagObject(t *testing.T) {
+	payload := []byte(`object 0f2a9d5b3d7c4e1a8b6f9e0d2c4b6a8f1e3d5c7b
+type commit
+tag v1.0.0
+tagger Alice <alice@example.com> 1580000000 +0000
+
+Release v1.0.0
+`)
+
+	signer, err := openpgp.NewEntity("Alice", "", "alice@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var signature bytes.Buffer
+	if err = openpgp.ArmoredDetachSign(&signature, signer, bytes.NewReader(payload), nil); err != nil {
+		t.Fatal(err)
+	}
+	signed := append(append([]byte{}, payload...), signature.Bytes()...)
+
+	Convey("Verify tag objects", t, func() {
+		Convey("Tag without signature is unsigned", func() {
+			So(verifyTagObject(openpgp.EntityList{signer}, payload), ShouldResemble, &TagVerification{Status: TAG_SIGNATURE_UNSIGNED})
+		})
+
+		Convey("Tag signed by a trusted key is verified", func() {
+			So(verifyTagObject(openpgp.EntityList{signer}, signed), ShouldResemble, &TagVerification{
+				Status: TAG_SIGNATURE_VERIFIED,
+				KeyID:  signer.PrimaryKey.KeyIdString(),
+				Signer: "Alice <alice@example.com>",
+			})
+		})
+
+		Convey("Tag signed by an unknown key is unverified", func() {
+			So(verifyTagObject(nil, signed), ShouldResemble, &TagVerification{
+				Status: TAG_SIGNATURE_UNVERIFIED,
+				KeyID:  signer.PrimaryKey.KeyIdString(),
+			})
+		})
+
+		Convey("Tag modified after signing is unverified", func() {
+			tampered := bytes.Replace(signed, []byte("v1.0.0"), []byte("v1.0.1"), 1)
+			So(verifyTagObject(openpgp.EntityList{signer}, tampered).Status, ShouldEqual, TAG_SIGNATURE_UNVERIFIED)
+		})
+	})
+}
+
+// writeArmoredKeyring writes public keys of the entities to the file.
+func writeArmoredKeyring(t *testing.T, path string, entities ...*openpgp.Entity) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entities {
+		if err = e.Serialize(w); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRepository_TagSignatures(t *testing.T) {
+	oldRoot, oldKeyring := conf.Repository.Root, conf.Security.TrustedGPGKeyring
+	conf.Repository.Root = t.TempDir()
+	conf.Security.TrustedGPGKeyring = filepath.Join(t.TempDir(), "keyring.asc")
+	defer func() {
+		conf.Repository.Root, conf.Security.TrustedGPGKeyring = oldRoot, oldKeyring
+		tagVerifications = &tagVerificationCache{}
+	}()
+
+	signer, err := openpgp.NewEntity("Alice", "", "alice@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := openpgp.NewEntity("Bob", "", "bob@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeArmoredKeyring(t, conf.Security.TrustedGPGKeyring, signer)
+
+	repo := &Repository{Name: "repo", Owner: &User{Name: "alice"}}
+	gitRepo := testutil.InitGitRepo(t, repo.RepoPath())
+	commitID := gitRepo.CommitFile("README.md", "readme")
+	gitRepo.Git("tag", "light")
+	gitRepo.Git("tag", "-a", "-m", "Release", "plain")
+	gitRepo.Git("tag", "nested/v1")
+
+	payload := []byte("object " + commitID + "\ntype commit\ntag signed\ntagger Alice <alice@example.com> 1580000000 +0000\n\nRelease\n")
+	var signature bytes.Buffer
+	if err = openpgp.ArmoredDetachSign(&signature, signer, bytes.NewReader(payload), nil); err != nil {
+		t.Fatal(err)
+	}
+	signedID := gitRepo.GitWithStdin(string(payload)+signature.String(), "hash-object", "-t", "tag", "-w", "--stdin")
+	gitRepo.Git("update-ref", "refs/tags/signed", signedID)
+
+	verified := &TagVerification{
+		Status: TAG_SIGNATURE_VERIFIED,
+		KeyID:  signer.PrimaryKey.KeyIdString(),
+		Signer: "Alice <alice@example.com>",
+	}
+
+	Convey("Verify tags in a batch", t, func() {
+		results, err := repo.TagSignatures("light", "plain", "signed", "nested", "missing")
+		So(err, ShouldBeNil)
+		So(results, ShouldResemble, map[string]*TagVerification{
+			"light":  {Status: TAG_SIGNATURE_UNSIGNED},
+			"plain":  {Status: TAG_SIGNATURE_UNSIGNED},
+			"signed": verified,
+		})
+		So(tagVerifications.results[signedID], ShouldResemble, verified)
+	})
+
+	Convey("Use cached verifications of tag objects", t, func() {
+		cached := &TagVerification{Status: TAG_SIGNATURE_UNVERIFIED, KeyID: "cached"}
+		tagVerifications.results[signedID] = cached
+
+		results, err := repo.TagSignatures("signed")
+		So(err, ShouldBeNil)
+		So(results["signed"], ShouldEqual, cached)
+	})
+
+	Convey("Verify again after the keyring is changed", t, func() {
+		writeArmoredKeyring(t, conf.Security.TrustedGPGKeyring, other)
+		later := time.Now().Add(time.Minute)
+		So(os.Chtimes(conf.Security.TrustedGPGKeyring, later, later), ShouldBeNil)
+
+		results, err := repo.TagSignatures("signed")
+		So(err, ShouldBeNil)
+		So(results["signed"], ShouldResemble, &TagVerification{
+			Status: TAG_SIGNATURE_UNVERIFIED,
+			KeyID:  signer.PrimaryKey.KeyIdString(),
+		})
+	})
+}
+
+func Test_parseCatFileBatch(t *testing.T) {
+	Convey("Parse output of cat-file in batch mode", t, func() {
+		output := "1111111111111111111111111111111111111111 tag 5\nhello\n" +
+			"2222222222222222222222222222222222222222 missing\n" +
+			"3333333333333333333333333333333333333333 tag 7\nline\nx\n\n"
+		So(parseCatFileBatch([]byte(output)), ShouldResemble, map[string][]byte{
+			"1111111111111111111111111111111111111111": []byte("hello"),
+			"3333333333333333333333333333333333333333": []byte("line\nx\n"),
+		})
+	})
+}
@@ -100,3 +100,37 @@ func DeleteAccessTokenOfUserByID(userID, id int64) error {
 	})
 	return err
 }
+
+// GetUserByFeedToken returns the user who owns given feed token.
+func GetUserByFeedToken(token string) (*User, error) {
+	if token == "" {
+		return nil, errors.UserNotExist{}
+	}
+	u := new(User)
+	has, err := x.Where("feed_token_hash = ?", tool.SHA256(token)).Get(u)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, errors.UserNotExist{}
+	}
+	return u, nil
+}
+
+// RegenerateFeedToken replaces the feed token of the user with a new one, so
+// feed URLs with the old token stop working. It returns the new token, which
+// cannot be retrieved again since only its hash is stored.
+func (u *User) RegenerateFeedToken() (string, error) {
+	token := tool.SHA1(gouuid.NewV4().String())
+	u.FeedTokenHash = tool.SHA256(token)
+	if _, err := x.ID(u.ID).Cols("feed_token_hash").Update(u); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RevokeFeedToken removes the feed token of the user.
+func (u *User) RevokeFeedToken() error {
+	u.FeedTokenHash = ""
+	_, err := x.ID(u.ID).Cols("feed_token_hash").Update(u)
+	return err
+}
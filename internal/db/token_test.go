@@ -0,0 +1,61 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/db/errors"
+)
+
+func Test_FeedToken(t *testing.T) {
+	setupTestDB(t)
+
+	alice := &User{Name: "alice", LowerName: "alice"}
+	bob := &User{Name: "bob", LowerName: "bob"}
+	insertTestBeans(t, alice, bob)
+
+	Convey("Authenticate with feed tokens", t, func() {
+		aliceToken, err := alice.RegenerateFeedToken()
+		So(err, ShouldBeNil)
+		bobToken, err := bob.RegenerateFeedToken()
+		So(err, ShouldBeNil)
+
+		Convey("Only the hash of the token is stored", func() {
+			u, err := GetUserByID(alice.ID)
+			So(err, ShouldBeNil)
+			So(u.FeedTokenHash, ShouldNotBeEmpty)
+			So(u.FeedTokenHash, ShouldNotEqual, aliceToken)
+
+			_, err = GetUserByFeedToken(u.FeedTokenHash)
+			So(errors.IsUserNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("Tokens belong to their own users", func() {
+			u, err := GetUserByFeedToken(aliceToken)
+			So(err, ShouldBeNil)
+			So(u.ID, ShouldEqual, alice.ID)
+
+			u, err = GetUserByFeedToken(bobToken)
+			So(err, ShouldBeNil)
+			So(u.ID, ShouldEqual, bob.ID)
+		})
+
+		Convey("Regenerated and revoked tokens stop working", func() {
+			newToken, err := alice.RegenerateFeedToken()
+			So(err, ShouldBeNil)
+			_, err = GetUserByFeedToken(aliceToken)
+			So(errors.IsUserNotExist(err), ShouldBeTrue)
+
+			So(alice.RevokeFeedToken(), ShouldBeNil)
+			_, err = GetUserByFeedToken(newToken)
+			So(errors.IsUserNotExist(err), ShouldBeTrue)
+			_, err = GetUserByFeedToken("")
+			So(errors.IsUserNotExist(err), ShouldBeTrue)
+		})
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	git "github.com/gogs/git-module"
+	log "unknwon.dev/clog/v2"
 )
 
 // CommitToPushCommit transforms a git.Commit to PushCommit type.
@@ -89,6 +90,12 @@ func PushUpdate(opts PushUpdateOptions) (err error) {
 		return fmt.Errorf("UpdateSize: %v", err)
 	}
 
+	// Names of branches and tags only change when references are created or
+	// deleted.
+	if isNewRef || isDelRef {
+		ClearRefNamesCache(repo.ID)
+	}
+
 	// Push tags
 	if strings.HasPrefix(opts.RefFullName, git.TAG_PREFIX) {
 		if err := CommitRepoAction(CommitRepoActionOptions{
@@ -105,6 +112,30 @@ func PushUpdate(opts PushUpdateOptions) (err error) {
 		return nil
 	}
 
+	// Parsed .editorconfig of the old HEAD of the default branch is of no use
+	// once the branch moves.
+	if repo.IsDefaultBranchRef(opts.RefFullName) {
+		ClearEditorconfigCache(repo.ID)
+	}
+
+	// Cached commits counts follow the branch, and are removed with it.
+	branch := git.RefEndName(opts.RefFullName)
+	if err = repo.updateCommitsCount(branch, opts.NewCommitID); err != nil {
+		log.Error("Failed to update commits count [repo_id: %d, branch: %s]: %v", repo.ID, branch, err)
+	}
+
+	// Branches other than the default branch are suggested to their pushers
+	// for composing pull requests for a while.
+	if isDelRef {
+		if err = deleteRecentPushesOfBranch(repo.ID, branch); err != nil {
+			log.Error("Failed to delete recent pushes [repo_id: %d, branch: %s]: %v", repo.ID, branch, err)
+		}
+	} else if opts.PusherID > 0 && branch != repo.DefaultBranch {
+		if err = updateRecentPush(opts.PusherID, repo.ID, branch); err != nil {
+			log.Error("Failed to update recent push [repo_id: %d, branch: %s]: %v", repo.ID, branch, err)
+		}
+	}
+
 	var l *list.List
 	// Skip read parent commits when delete branch
 	if !isDelRef {
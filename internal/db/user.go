@@ -5,22 +5,20 @@
 package db
 
 import (
-	"bytes"
 	"container/list"
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
-	"image"
 	_ "image/jpeg"
 	"image/png"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 	"unicode/utf8"
 
-	"github.com/nfnt/resize"
 	"github.com/unknwon/com"
 	"golang.org/x/crypto/pbkdf2"
 	log "unknwon.dev/clog/v2"
@@ -43,6 +41,7 @@ type UserType int
 const (
 	USER_TYPE_INDIVIDUAL UserType = iota // Historic reason to make it starts at 0.
 	USER_TYPE_ORGANIZATION
+	USER_TYPE_BOT
 )
 
 // User represents the object of individual and member of organization.
@@ -70,9 +69,17 @@ type User struct {
 	CreatedUnix int64
 	Updated     time.Time `xorm:"-" json:"-"`
 	UpdatedUnix int64
+	// LastSeenUnix is when the user signed in or made an authenticated request
+	// last time, it is updated at most once an hour.
+	LastSeenUnix int64
 
 	// Remember visibility choice for convenience, true for private
 	LastRepoVisibility bool
+	// Remember protocol choice to clone repositories, either "https" or "ssh"
+	CloneProtocol string `xorm:"VARCHAR(10)"`
+	// Only receive links rather than full content in notification emails of
+	// issues and pull requests
+	MailLinksOnly bool
 	// Maximum repository creation limit, -1 means use gloabl default
 	MaxRepoCreation int `xorm:"NOT NULL DEFAULT -1"`
 
@@ -83,6 +90,11 @@ type User struct {
 	AllowImportLocal bool // Allow migrate repository by local path
 	ProhibitLogin    bool
 
+	// Token to read feeds of private repositories without signing in
+	// FeedTokenHash is the SHA256 hash of the feed token, the token itself is
+	// only shown once when generated.
+	FeedTokenHash string `xorm:"VARCHAR(64) INDEX" json:"-"`
+
 	// Avatar
 	Avatar          string `xorm:"VARCHAR(2048) NOT NULL"`
 	AvatarEmail     string `xorm:"NOT NULL"`
@@ -100,6 +112,11 @@ type User struct {
 	NumMembers  int
 	Teams       []*Team `xorm:"-" json:"-"`
 	Members     []*User `xorm:"-" json:"-"`
+
+	// For bot
+	BotOrgID  int64       `xorm:"INDEX NOT NULL DEFAULT 0"`
+	BotOrg    *User       `xorm:"-" json:"-"`
+	BotGrants []*BotGrant `xorm:"-" json:"-"`
 }
 
 func (u *User) BeforeInsert() {
@@ -150,23 +167,6 @@ func (u *User) HasForkedRepo(repoID int64) bool {
 	return has
 }
 
-func (u *User) RepoCreationNum() int {
-	if u.MaxRepoCreation <= -1 {
-		return conf.Repository.MaxCreationLimit
-	}
-	return u.MaxRepoCreation
-}
-
-func (u *User) CanCreateRepo() bool {
-	if u.MaxRepoCreation <= -1 {
-		if conf.Repository.MaxCreationLimit <= -1 {
-			return true
-		}
-		return u.NumRepos < conf.Repository.MaxCreationLimit
-	}
-	return u.NumRepos < u.MaxRepoCreation
-}
-
 func (u *User) CanCreateOrganization() bool {
 	return !conf.Admin.DisableRegularOrgCreation || u.IsAdmin
 }
@@ -336,23 +336,15 @@ func (u *User) ValidatePassword(passwd string) bool {
 // UploadAvatar saves custom avatar for user.
 // FIXME: split uploads to different subdirs in case we have massive number of users.
 func (u *User) UploadAvatar(data []byte) error {
-	img, _, err := image.Decode(bytes.NewReader(data))
+	data, err := prepareAvatar(data)
 	if err != nil {
-		return fmt.Errorf("decode image: %v", err)
+		return err
 	}
 
 	_ = os.MkdirAll(conf.Picture.AvatarUploadPath, os.ModePerm)
-	fw, err := os.Create(u.CustomAvatarPath())
-	if err != nil {
-		return fmt.Errorf("create custom avatar directory: %v", err)
-	}
-	defer fw.Close()
-
-	m := resize.Resize(avatar.AVATAR_SIZE, avatar.AVATAR_SIZE, img, resize.NearestNeighbor)
-	if err = png.Encode(fw, m); err != nil {
-		return fmt.Errorf("encode image: %v", err)
+	if err = ioutil.WriteFile(u.CustomAvatarPath(), data, 0644); err != nil {
+		return fmt.Errorf("write custom avatar: %v", err)
 	}
-
 	return nil
 }
 
@@ -390,6 +382,11 @@ func (u *User) IsOrganization() bool {
 	return u.Type == USER_TYPE_ORGANIZATION
 }
 
+// IsBot returns true if user is a bot account of an organization.
+func (u *User) IsBot() bool {
+	return u.Type == USER_TYPE_BOT
+}
+
 // IsUserOrgOwner returns true if user is in the owner team of given organization.
 func (u *User) IsUserOrgOwner(orgId int64) bool {
 	return IsOrganizationOwner(orgId, u.ID)
@@ -790,11 +787,17 @@ func deleteUser(e *xorm.Session, u *User) error {
 		&Collaboration{UserID: u.ID},
 		&Access{UserID: u.ID},
 		&Watch{UserID: u.ID},
+		&MuteSchedule{UserID: u.ID},
+		&PathWatch{UserID: u.ID},
 		&Star{UID: u.ID},
 		&Follow{FollowID: u.ID},
 		&Action{UserID: u.ID},
 		&IssueUser{UID: u.ID},
+		&ReviewedFile{UserID: u.ID},
+		&CodeOwnerApproval{UserID: u.ID},
+		&RecentPush{UserID: u.ID},
 		&EmailAddress{UID: u.ID},
+		&LifecycleState{Type: LIFECYCLE_USER, SubjectID: u.ID},
 	); err != nil {
 		return fmt.Errorf("deleteBeans: %v", err)
 	}
@@ -968,9 +971,10 @@ type UserCommit struct {
 }
 
 // ValidateCommitWithEmail chceck if author's e-mail of commit is corresponsind to a user.
+// Only users who have verified the e-mail are returned.
 func ValidateCommitWithEmail(c *git.Commit) *User {
-	u, err := GetUserByEmail(c.Author.Email)
-	if err != nil {
+	u, verified, err := GetUserByCommitEmail(c.Author.Email)
+	if err != nil || !verified {
 		return nil
 	}
 	return u
@@ -988,7 +992,7 @@ func ValidateCommitsWithEmails(oldCommits *list.List) *list.List {
 		c := e.Value.(*git.Commit)
 
 		if v, ok := emails[c.Author.Email]; !ok {
-			u, _ = GetUserByEmail(c.Author.Email)
+			u = ValidateCommitWithEmail(c)
 			emails[c.Author.Email] = u
 		} else {
 			u = v
@@ -1033,6 +1037,36 @@ func GetUserByEmail(email string) (*User, error) {
 	return nil, errors.UserNotExist{Name: email}
 }
 
+// GetUserByCommitEmail returns the user who has the e-mail of a commit, either
+// primary or alternative, and whether the e-mail is verified by the user. A
+// primary e-mail is verified by activating the account. Commits should only be
+// attributed to users who have verified the e-mails, because anyone can author
+// commits with any e-mail.
+func GetUserByCommitEmail(email string) (*User, bool, error) {
+	user, err := GetUserByEmail(email)
+	if err == nil {
+		// Alternative e-mails found by GetUserByEmail are always activated.
+		return user, user.IsActive || !strings.EqualFold(user.Email, email), nil
+	} else if !errors.IsUserNotExist(err) || len(email) == 0 {
+		return nil, false, err
+	}
+
+	// The e-mail may still be an alternative e-mail that is not yet activated.
+	emailAddress := new(EmailAddress)
+	has, err := x.Where("email = ?", strings.ToLower(email)).Get(emailAddress)
+	if err != nil {
+		return nil, false, err
+	} else if !has {
+		return nil, false, errors.UserNotExist{Name: email}
+	}
+
+	user, err = GetUserByID(emailAddress.UID)
+	if err != nil {
+		return nil, false, err
+	}
+	return user, false, nil
+}
+
 type SearchUserOptions struct {
 	Keyword  string
 	Type     UserType
@@ -55,6 +55,19 @@ func GetEmailAddresses(uid int64) ([]*EmailAddress, error) {
 	return emails, nil
 }
 
+// HasVerifiedEmail returns true if the email address is either the activated
+// primary email or an activated alternative email of the user.
+func (u *User) HasVerifiedEmail(email string) (bool, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return false, nil
+	} else if strings.ToLower(u.Email) == email {
+		return u.IsActive, nil
+	}
+
+	return x.Where("uid = ? AND email = ? AND is_activated = ?", u.ID, email, true).Get(new(EmailAddress))
+}
+
 func isEmailUsed(e Engine, email string) (bool, error) {
 	if len(email) == 0 {
 		return true, nil
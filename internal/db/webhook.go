@@ -10,7 +10,9 @@ import (
 	"crypto/tls"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"strings"
 	"time"
 
@@ -23,7 +25,9 @@ import (
 
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/graceful"
 	"gogs.io/gogs/internal/httplib"
+	"gogs.io/gogs/internal/netutil"
 	"gogs.io/gogs/internal/sync"
 )
 
@@ -71,6 +75,7 @@ type HookEvents struct {
 	PullRequest  bool `json:"pull_request"`
 	IssueComment bool `json:"issue_comment"`
 	Release      bool `json:"release"`
+	Repository   bool `json:"repository"`
 }
 
 // HookEvent represents events that will delivery hook.
@@ -105,6 +110,8 @@ type Webhook struct {
 	HookTaskType HookTaskType
 	Meta         string     `xorm:"TEXT"` // store hook-specific attributes
 	LastStatus   HookStatus // Last delivery status
+	// PayloadVersion is the version of shapes of payloads the webhook receives.
+	PayloadVersion HookPayloadVersion
 
 	Created     time.Time `xorm:"-" json:"-"`
 	CreatedUnix int64
@@ -133,6 +140,12 @@ func (w *Webhook) AfterSet(colName string, _ xorm.Cell) {
 		w.Created = time.Unix(w.CreatedUnix, 0).Local()
 	case "updated_unix":
 		w.Updated = time.Unix(w.UpdatedUnix, 0).Local()
+	case "payload_version":
+		// Webhooks created before payloads were versioned keep receiving the shapes
+		// they have been receiving, which are those of the API client package.
+		if w.PayloadVersion == 0 {
+			w.PayloadVersion = HOOK_PAYLOAD_V1
+		}
 	}
 }
 
@@ -204,6 +217,12 @@ func (w *Webhook) HasReleaseEvent() bool {
 		(w.ChooseEvents && w.HookEvents.Release)
 }
 
+// HasRepositoryEvent returns true if hook enabled repository event.
+func (w *Webhook) HasRepositoryEvent() bool {
+	return w.SendEverything ||
+		(w.ChooseEvents && w.HookEvents.Repository)
+}
+
 type eventChecker struct {
 	checker func() bool
 	typ     HookEventType
@@ -220,6 +239,7 @@ func (w *Webhook) EventsArray() []string {
 		{w.HasPullRequestEvent, HOOK_EVENT_PULL_REQUEST},
 		{w.HasIssueCommentEvent, HOOK_EVENT_ISSUE_COMMENT},
 		{w.HasReleaseEvent, HOOK_EVENT_RELEASE},
+		{w.HasRepositoryEvent, HOOK_EVENT_REPOSITORY},
 	}
 	for _, c := range eventCheckers {
 		if c.checker() {
@@ -231,7 +251,14 @@ func (w *Webhook) EventsArray() []string {
 
 // CreateWebhook creates a new web hook.
 func CreateWebhook(w *Webhook) error {
-	_, err := x.Insert(w)
+	return createWebhook(x, w)
+}
+
+func createWebhook(e Engine, w *Webhook) error {
+	if w.PayloadVersion == 0 {
+		w.PayloadVersion = HOOK_PAYLOAD_LATEST
+	}
+	_, err := e.Insert(w)
 	return err
 }
 
@@ -286,7 +313,11 @@ func GetWebhooksByRepoID(repoID int64) ([]*Webhook, error) {
 
 // UpdateWebhook updates information of webhook.
 func UpdateWebhook(w *Webhook) error {
-	_, err := x.Id(w.ID).AllCols().Update(w)
+	return updateWebhook(x, w)
+}
+
+func updateWebhook(e Engine, w *Webhook) error {
+	_, err := e.ID(w.ID).AllCols().Update(w)
 	return err
 }
 
@@ -395,8 +426,270 @@ const (
 	HOOK_EVENT_PULL_REQUEST  HookEventType = "pull_request"
 	HOOK_EVENT_ISSUE_COMMENT HookEventType = "issue_comment"
 	HOOK_EVENT_RELEASE       HookEventType = "release"
+	HOOK_EVENT_REPOSITORY    HookEventType = "repository"
+
+	// HOOK_EVENT_PING is only sent to test a webhook, and cannot be subscribed.
+	HOOK_EVENT_PING HookEventType = "ping"
 )
 
+// PushPayload represents the payload of a push event. In addition to fields of
+// api.PushPayload, it reports the total number of commits and the head commit
+// because the list of commits may be capped by conf.Webhook.MaxPayloadCommits,
+// and whether the push updates the default branch of the repository.
+type PushPayload struct {
+	*api.PushPayload
+	TotalCommits int                `json:"total_commits"`
+	HeadCommit   *api.PayloadCommit `json:"head_commit"`
+	IsDefault    bool               `json:"is_default"`
+}
+
+// NewPushPayload returns a push payload for given base payload whose commits are
+// ordered from newest to oldest. The total number of commits may be greater than
+// the length of commits of the base payload, as the caller is free to convert
+// only the commits that will end up in the payload.
+func NewPushPayload(p *api.PushPayload, totalCommits int) *PushPayload {
+	if totalCommits < len(p.Commits) {
+		totalCommits = len(p.Commits)
+	}
+
+	payload := &PushPayload{
+		PushPayload:  p,
+		TotalCommits: totalCommits,
+	}
+	if len(p.Commits) > 0 {
+		payload.HeadCommit = p.Commits[0]
+	}
+	if max := conf.Webhook.MaxPayloadCommits; max > 0 && len(p.Commits) > max {
+		p.Commits = p.Commits[:max]
+	}
+	return payload
+}
+
+func (p *PushPayload) JSONPayload() ([]byte, error) {
+	return jsoniter.MarshalIndent(p, "", "  ")
+}
+
+// ReleasePayload represents the payload of a release event. In addition to
+// fields of api.ReleasePayload, the release includes its external links.
+type ReleasePayload struct {
+	*api.ReleasePayload
+	Release *PayloadRelease `json:"release"`
+}
+
+// PayloadRelease represents a release in the payload of a release event.
+type PayloadRelease struct {
+	*api.Release
+	Links []*PayloadReleaseLink `json:"links"`
+}
+
+// PayloadReleaseLink represents an external link of a release in the payload
+// of a release event.
+type PayloadReleaseLink struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// NewReleasePayload returns a release payload for given base payload and
+// external links of the release.
+func NewReleasePayload(p *api.ReleasePayload, links []*ReleaseLink) *ReleasePayload {
+	payloadLinks := make([]*PayloadReleaseLink, len(links))
+	for i, link := range links {
+		payloadLinks[i] = &PayloadReleaseLink{
+			ID:    link.ID,
+			Label: link.Label,
+			URL:   link.URL,
+		}
+	}
+	return &ReleasePayload{
+		ReleasePayload: p,
+		Release: &PayloadRelease{
+			Release: p.Release,
+			Links:   payloadLinks,
+		},
+	}
+}
+
+func (p *ReleasePayload) JSONPayload() ([]byte, error) {
+	return jsoniter.MarshalIndent(p, "", "  ")
+}
+
+// IssuesPayload represents the payload of an issues event. In addition to
+// fields of api.IssuesPayload, the issue includes the reason it is closed for.
+type IssuesPayload struct {
+	*api.IssuesPayload
+	Issue *PayloadIssue `json:"issue"`
+}
+
+// PayloadIssue represents an issue in the payload of an issues event.
+type PayloadIssue struct {
+	*api.Issue
+	CloseReason IssueCloseReason `json:"close_reason,omitempty"`
+}
+
+// NewIssuesPayload returns an issues payload for given base payload and the
+// issue of the event.
+func NewIssuesPayload(p *api.IssuesPayload, issue *Issue) *IssuesPayload {
+	return &IssuesPayload{
+		IssuesPayload: p,
+		Issue: &PayloadIssue{
+			Issue:       p.Issue,
+			CloseReason: issue.CloseReason,
+		},
+	}
+}
+
+func (p *IssuesPayload) JSONPayload() ([]byte, error) {
+	return jsoniter.MarshalIndent(p, "", "  ")
+}
+
+// PingPayload represents the payload of a ping event, which is sent to test
+// whether a webhook is set up correctly.
+type PingPayload struct {
+	HookID int64           `json:"hook_id"`
+	Zen    string          `json:"zen"`
+	Repo   *api.Repository `json:"repository"`
+}
+
+func (p *PingPayload) JSONPayload() ([]byte, error) {
+	return jsoniter.MarshalIndent(p, "", "  ")
+}
+
+// RepositoryPayloadAction is the action of a repository event.
+type RepositoryPayloadAction string
+
+const (
+	REPOSITORY_PUBLICIZED RepositoryPayloadAction = "publicized"
+	REPOSITORY_PRIVATIZED RepositoryPayloadAction = "privatized"
+)
+
+// RepositoryPayload represents the payload of a repository event, which is sent
+// when visibility of the repository is changed.
+type RepositoryPayload struct {
+	Action RepositoryPayloadAction `json:"action"`
+	Repo   *api.Repository         `json:"repository"`
+	Sender *api.User               `json:"sender"`
+}
+
+func (p *RepositoryPayload) JSONPayload() ([]byte, error) {
+	return jsoniter.MarshalIndent(p, "", "  ")
+}
+
+// HookPayloadVersion is a version of shapes of payloads sent to Gogs type
+// webhooks. Once a version is released, the shapes of its payloads never change:
+// new fields and restructuring only go into a new version, so receivers are
+// never broken by upgrades until the webhook is upgraded to the new version.
+type HookPayloadVersion int
+
+const (
+	// HOOK_PAYLOAD_V1 is the shapes of payloads of the API client package.
+	HOOK_PAYLOAD_V1 HookPayloadVersion = iota + 1
+	// HOOK_PAYLOAD_V2 adds the total number of commits, the head commit and
+	// whether the default branch is updated to push payloads, and external links
+	// to releases in release payloads.
+	HOOK_PAYLOAD_V2
+	// HOOK_PAYLOAD_V3 adds the close reason to issues in issues payloads.
+	HOOK_PAYLOAD_V3
+
+	HOOK_PAYLOAD_LATEST = HOOK_PAYLOAD_V3
+)
+
+// HookPayloadChange is a change of the shape of payloads of an event made by a
+// version.
+type HookPayloadChange struct {
+	Event HookEventType
+	Field string
+	Kind  string // "added", "removed" or "changed"
+}
+
+// hookPayloadVersion is the serializer and changes of a version.
+type hookPayloadVersion struct {
+	// render converts a payload of the latest version to the shape of this
+	// version. It must keep working as is when later versions are added.
+	render  func(p api.Payloader) api.Payloader
+	changes []HookPayloadChange
+}
+
+var hookPayloadVersions = map[HookPayloadVersion]hookPayloadVersion{
+	HOOK_PAYLOAD_V1: {
+		render: func(p api.Payloader) api.Payloader {
+			switch p := p.(type) {
+			case *PushPayload:
+				return p.PushPayload
+			case *ReleasePayload:
+				return p.ReleasePayload
+			case *IssuesPayload:
+				return p.IssuesPayload
+			}
+			return p
+		},
+	},
+	HOOK_PAYLOAD_V2: {
+		render: func(p api.Payloader) api.Payloader {
+			if p, ok := p.(*IssuesPayload); ok {
+				return p.IssuesPayload
+			}
+			return p
+		},
+		changes: []HookPayloadChange{
+			{Event: HOOK_EVENT_PUSH, Field: "total_commits", Kind: "added"},
+			{Event: HOOK_EVENT_PUSH, Field: "head_commit", Kind: "added"},
+			{Event: HOOK_EVENT_PUSH, Field: "is_default", Kind: "added"},
+			{Event: HOOK_EVENT_RELEASE, Field: "release.links", Kind: "added"},
+		},
+	},
+	HOOK_PAYLOAD_V3: {
+		render: func(p api.Payloader) api.Payloader {
+			return p
+		},
+		changes: []HookPayloadChange{
+			{Event: HOOK_EVENT_ISSUES, Field: "issue.close_reason", Kind: "added"},
+		},
+	},
+}
+
+// IsValidHookPayloadVersion returns true if given version is a released
+// version of payloads.
+func IsValidHookPayloadVersion(v HookPayloadVersion) bool {
+	_, ok := hookPayloadVersions[v]
+	return ok
+}
+
+// HookPayloadVersions returns all released versions of payloads from the oldest
+// to the latest.
+func HookPayloadVersions() []HookPayloadVersion {
+	versions := make([]HookPayloadVersion, 0, len(hookPayloadVersions))
+	for v := HOOK_PAYLOAD_V1; v <= HOOK_PAYLOAD_LATEST; v++ {
+		versions = append(versions, v)
+	}
+	return versions
+}
+
+// IsLatest returns true if the version is the latest version of payloads.
+func (v HookPayloadVersion) IsLatest() bool {
+	return v == HOOK_PAYLOAD_LATEST
+}
+
+// render converts the payload to the shape of the version. Payloads of unknown
+// versions are rendered in the latest version.
+func (v HookPayloadVersion) render(p api.Payloader) api.Payloader {
+	version, ok := hookPayloadVersions[v]
+	if !ok {
+		version = hookPayloadVersions[HOOK_PAYLOAD_LATEST]
+	}
+	return version.render(p)
+}
+
+// HookPayloadChanges returns changes of shapes of payloads made by versions
+// after from up to and including to.
+func HookPayloadChanges(from, to HookPayloadVersion) []HookPayloadChange {
+	var changes []HookPayloadChange
+	for v := from + 1; v <= to; v++ {
+		changes = append(changes, hookPayloadVersions[v].changes...)
+	}
+	return changes
+}
+
 // HookRequest represents hook task request information.
 type HookRequest struct {
 	Headers map[string]string `json:"headers"`
@@ -520,13 +813,48 @@ func UpdateHookTask(t *HookTask) error {
 	return err
 }
 
+// preparePayload converts the payload to the format of the webhook type, and
+// signs it with the secret if there is one.
+func (w *Webhook) preparePayload(event HookEventType, p api.Payloader, secret string) (payloader api.Payloader, signature string, err error) {
+	// Use separate objects so modifcations won't be made on payload on non-Gogs type hooks.
+	switch w.HookTaskType {
+	case SLACK:
+		payloader, err = GetSlackPayload(p, event, w.Meta)
+		if err != nil {
+			return nil, "", fmt.Errorf("GetSlackPayload: %v", err)
+		}
+	case DISCORD:
+		payloader, err = GetDiscordPayload(p, event, w.Meta)
+		if err != nil {
+			return nil, "", fmt.Errorf("GetDiscordPayload: %v", err)
+		}
+	case DINGTALK:
+		payloader, err = GetDingtalkPayload(p, event)
+		if err != nil {
+			return nil, "", fmt.Errorf("GetDingtalkPayload: %v", err)
+		}
+	default:
+		payloader = w.PayloadVersion.render(p)
+	}
+
+	if len(secret) > 0 {
+		data, err := payloader.JSONPayload()
+		if err != nil {
+			log.Error("prepareWebhooks.JSONPayload: %v", err)
+		}
+		sig := hmac.New(sha256.New, []byte(secret))
+		sig.Write(data)
+		signature = hex.EncodeToString(sig.Sum(nil))
+	}
+	return payloader, signature, nil
+}
+
 // prepareHookTasks adds list of webhooks to task queue.
 func prepareHookTasks(e Engine, repo *Repository, event HookEventType, p api.Payloader, webhooks []*Webhook) (err error) {
 	if len(webhooks) == 0 {
 		return nil
 	}
 
-	var payloader api.Payloader
 	for _, w := range webhooks {
 		switch event {
 		case HOOK_EVENT_CREATE:
@@ -561,38 +889,23 @@ func prepareHookTasks(e Engine, repo *Repository, event HookEventType, p api.Pay
 			if !w.HasReleaseEvent() {
 				continue
 			}
+		case HOOK_EVENT_REPOSITORY:
+			if !w.HasRepositoryEvent() {
+				continue
+			}
 		}
 
-		// Use separate objects so modifcations won't be made on payload on non-Gogs type hooks.
-		switch w.HookTaskType {
-		case SLACK:
-			payloader, err = GetSlackPayload(p, event, w.Meta)
-			if err != nil {
-				return fmt.Errorf("GetSlackPayload: %v", err)
-			}
-		case DISCORD:
-			payloader, err = GetDiscordPayload(p, event, w.Meta)
-			if err != nil {
-				return fmt.Errorf("GetDiscordPayload: %v", err)
-			}
-		case DINGTALK:
-			payloader, err = GetDingtalkPayload(p, event)
-			if err != nil {
-				return fmt.Errorf("GetDingtalkPayload: %v", err)
-			}
-		default:
-			payloader = p
+		// The payload cannot be signed without the secret, the webhook is skipped
+		// until referenced secrets are created.
+		secret, err := w.interpolateSecrets(w.Secret)
+		if err != nil {
+			log.Error("Failed to interpolate secrets of webhook [%d]: %v", w.ID, err)
+			continue
 		}
 
-		var signature string
-		if len(w.Secret) > 0 {
-			data, err := payloader.JSONPayload()
-			if err != nil {
-				log.Error("prepareWebhooks.JSONPayload: %v", err)
-			}
-			sig := hmac.New(sha256.New, []byte(w.Secret))
-			sig.Write(data)
-			signature = hex.EncodeToString(sig.Sum(nil))
+		payloader, signature, err := w.preparePayload(event, p, secret)
+		if err != nil {
+			return err
 		}
 
 		if err = createHookTask(e, &HookTask{
@@ -640,33 +953,107 @@ func PrepareWebhooks(repo *Repository, event HookEventType, p api.Payloader) err
 	return prepareWebhooks(x, repo, event, p)
 }
 
-// TestWebhook adds the test webhook matches the ID to task queue.
-func TestWebhook(repo *Repository, event HookEventType, p api.Payloader, webhookID int64) error {
-	webhook, err := GetWebhookOfRepoByID(repo.ID, webhookID)
+// newHookRequest returns a request to deliver the payload of given event. The
+// request refuses to connect to local network addresses unless the host is in
+// conf.Security.LocalNetworkAllowlist.
+func newHookRequest(url, uuid string, event HookEventType, signature string, contentType HookContentType, payload string) *httplib.Request {
+	timeout := time.Duration(conf.Webhook.DeliverTimeout) * time.Second
+	req := httplib.Post(url).SetTimeout(timeout, timeout).
+		Header("X-Github-Delivery", uuid).
+		Header("X-Github-Event", string(event)).
+		Header("X-Gogs-Delivery", uuid).
+		Header("X-Gogs-Signature", signature).
+		Header("X-Gogs-Event", string(event)).
+		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: conf.Webhook.SkipTLSVerify}).
+		SetTransport(&http.Transport{
+			Dial: netutil.Dialer(timeout, timeout, conf.Security.LocalNetworkAllowlist),
+		})
+
+	switch contentType {
+	case JSON:
+		req = req.Header("Content-Type", "application/json").Body(payload)
+	case FORM:
+		req.Param("payload", payload)
+	}
+	return req
+}
+
+// WebhookTestResult represents the result of a test delivery of a webhook.
+type WebhookTestResult struct {
+	Status  int
+	Latency time.Duration
+	Snippet string // The beginning of the response body.
+}
+
+// webhookTestSnippetSize is the maximum number of bytes of the response body
+// to be kept in the result of a test delivery.
+const webhookTestSnippetSize = 512
+
+// TestWebhook sends a signed ping payload to the webhook and waits for the
+// response. The webhook does not have to be saved yet, and nothing is recorded
+// in the delivery history.
+func (repo *Repository) TestWebhook(w *Webhook) (*WebhookTestResult, error) {
+	hookURL, err := w.interpolateSecrets(w.URL)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := w.interpolateSecrets(w.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	payloader, signature, err := w.preparePayload(HOOK_EVENT_PING, &PingPayload{
+		HookID: w.ID,
+		Zen:    "Keep it logically awesome.",
+		Repo:   repo.APIFormat(nil),
+	}, secret)
+	if err != nil {
+		return nil, err
+	}
+	data, err := payloader.JSONPayload()
+	if err != nil {
+		return nil, fmt.Errorf("encode payload: %v", err)
+	}
+
+	req := newHookRequest(hookURL, gouuid.NewV4().String(), HOOK_EVENT_PING, signature, w.ContentType, string(data))
+	start := time.Now()
+	resp, err := req.Response()
+	if err != nil {
+		return nil, maskURLError(err, w.URL)
+	}
+	defer resp.Body.Close()
+
+	snippet, err := ioutil.ReadAll(io.LimitReader(resp.Body, webhookTestSnippetSize))
+	if err != nil {
+		return nil, fmt.Errorf("read body: %v", err)
+	}
+	return &WebhookTestResult{
+		Status:  resp.StatusCode,
+		Latency: time.Since(start),
+		Snippet: string(snippet),
+	}, nil
+}
+
+// interpolateURL returns the URL of the task with references of secrets
+// replaced by their values. The URL of the task itself is kept as is, so values
+// of secrets are never recorded in the delivery history.
+func (t *HookTask) interpolateURL() (string, error) {
+	if len(SecretReferences(t.URL)) == 0 {
+		return t.URL, nil
+	}
+
+	w, err := GetWebhookByID(t.HookID)
 	if err != nil {
-		return fmt.Errorf("GetWebhookOfRepoByID [repo_id: %d, id: %d]: %v", repo.ID, webhookID, err)
+		return "", fmt.Errorf("GetWebhookByID: %v", err)
 	}
-	return prepareHookTasks(x, repo, event, p, []*Webhook{webhook})
+	return w.interpolateSecrets(t.URL)
 }
 
 func (t *HookTask) deliver() {
 	t.IsDelivered = true
 
-	timeout := time.Duration(conf.Webhook.DeliverTimeout) * time.Second
-	req := httplib.Post(t.URL).SetTimeout(timeout, timeout).
-		Header("X-Github-Delivery", t.UUID).
-		Header("X-Github-Event", string(t.EventType)).
-		Header("X-Gogs-Delivery", t.UUID).
-		Header("X-Gogs-Signature", t.Signature).
-		Header("X-Gogs-Event", string(t.EventType)).
-		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: conf.Webhook.SkipTLSVerify})
-
-	switch t.ContentType {
-	case JSON:
-		req = req.Header("Content-Type", "application/json").Body(t.PayloadContent)
-	case FORM:
-		req.Param("payload", t.PayloadContent)
-	}
+	hookURL, interpolateErr := t.interpolateURL()
+	req := newHookRequest(hookURL, t.UUID, t.EventType, t.Signature, t.ContentType, t.PayloadContent)
 
 	// Record delivery information.
 	t.RequestInfo = &HookRequest{
@@ -705,9 +1092,14 @@ func (t *HookTask) deliver() {
 		}
 	}()
 
+	if interpolateErr != nil {
+		t.ResponseInfo.Body = fmt.Sprintf("Interpolate secrets: %v", interpolateErr)
+		return
+	}
+
 	resp, err := req.Response()
 	if err != nil {
-		t.ResponseInfo.Body = fmt.Sprintf("Delivery: %v", err)
+		t.ResponseInfo.Body = fmt.Sprintf("Delivery: %v", maskURLError(err, t.URL))
 		return
 	}
 	defer resp.Body.Close()
@@ -730,9 +1122,16 @@ func (t *HookTask) deliver() {
 // DeliverHooks checks and delivers undelivered hooks.
 // TODO: shoot more hooks at same time.
 func DeliverHooks() {
+	// Tasks that have not been delivered when the process starts to shut down
+	// are kept in database and delivered next time.
+	done := graceful.Add()
 	tasks := make([]*HookTask, 0, 10)
 	x.Where("is_delivered = ?", false).Iterate(new(HookTask),
 		func(idx int, bean interface{}) error {
+			if graceful.IsDraining() {
+				return nil
+			}
+
 			t := bean.(*HookTask)
 			t.deliver()
 			tasks = append(tasks, t)
@@ -745,24 +1144,32 @@ func DeliverHooks() {
 			log.Error("UpdateHookTask [%d]: %v", t.ID, err)
 		}
 	}
+	done()
 
 	// Start listening on new hook requests.
 	for repoID := range HookQueue.Queue() {
 		log.Trace("DeliverHooks [repo_id: %v]", repoID)
-		HookQueue.Remove(repoID)
+		HookQueue.Process(repoID, func() {
+			tasks := make([]*HookTask, 0, 5)
+			if err := x.Where("repo_id = ?", repoID).And("is_delivered = ?", false).Find(&tasks); err != nil {
+				log.Error("Get repository [%s] hook tasks: %v", repoID, err)
+				return
+			}
 
-		tasks = make([]*HookTask, 0, 5)
-		if err := x.Where("repo_id = ?", repoID).And("is_delivered = ?", false).Find(&tasks); err != nil {
-			log.Error("Get repository [%s] hook tasks: %v", repoID, err)
-			continue
-		}
-		for _, t := range tasks {
-			t.deliver()
-			if err := UpdateHookTask(t); err != nil {
-				log.Error("UpdateHookTask [%d]: %v", t.ID, err)
-				continue
+			done := graceful.Add()
+			defer done()
+			for _, t := range tasks {
+				if graceful.IsDraining() {
+					break
+				}
+
+				t.deliver()
+				if err := UpdateHookTask(t); err != nil {
+					log.Error("UpdateHookTask [%d]: %v", t.ID, err)
+					continue
+				}
 			}
-		}
+		})
 	}
 }
 
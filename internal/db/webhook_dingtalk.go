@@ -67,15 +67,19 @@ func GetDingtalkPayload(p api.Payloader, event HookEventType) (payload *Dingtalk
 	case HOOK_EVENT_FORK:
 		payload, err = getDingtalkForkPayload(p.(*api.ForkPayload))
 	case HOOK_EVENT_PUSH:
-		payload, err = getDingtalkPushPayload(p.(*api.PushPayload))
+		payload, err = getDingtalkPushPayload(p.(*PushPayload))
 	case HOOK_EVENT_ISSUES:
-		payload, err = getDingtalkIssuesPayload(p.(*api.IssuesPayload))
+		payload, err = getDingtalkIssuesPayload(p.(*IssuesPayload).IssuesPayload)
 	case HOOK_EVENT_ISSUE_COMMENT:
 		payload, err = getDingtalkIssueCommentPayload(p.(*api.IssueCommentPayload))
 	case HOOK_EVENT_PULL_REQUEST:
 		payload, err = getDingtalkPullRequestPayload(p.(*api.PullRequestPayload))
 	case HOOK_EVENT_RELEASE:
-		payload, err = getDingtalkReleasePayload(p.(*api.ReleasePayload))
+		payload, err = getDingtalkReleasePayload(p.(*ReleasePayload).ReleasePayload)
+	case HOOK_EVENT_REPOSITORY:
+		payload, err = getDingtalkRepositoryPayload(p.(*RepositoryPayload))
+	case HOOK_EVENT_PING:
+		payload, err = getDingtalkPingPayload(p.(*PingPayload))
 	}
 
 	if err != nil {
@@ -121,7 +125,7 @@ func getDingtalkForkPayload(p *api.ForkPayload) (*DingtalkPayload, error) {
 	return &DingtalkPayload{MsgType: "actionCard", ActionCard: actionCard}, nil
 }
 
-func getDingtalkPushPayload(p *api.PushPayload) (*DingtalkPayload, error) {
+func getDingtalkPushPayload(p *PushPayload) (*DingtalkPayload, error) {
 	refName := git.RefEndName(p.Ref)
 
 	pusher := p.Pusher.FullName
@@ -142,7 +146,7 @@ func getDingtalkPushPayload(p *api.PushPayload) (*DingtalkPayload, error) {
 	actionCard.Text += "\n- Repo: **" + MarkdownLinkFormatter(p.Repo.HTMLURL, p.Repo.Name) + "**"
 	actionCard.Text += "\n- Ref: **" + MarkdownLinkFormatter(p.Repo.HTMLURL+"/src/"+refName, refName) + "**"
 	actionCard.Text += "\n- Pusher: **" + pusher + "**"
-	actionCard.Text += "\n## " + fmt.Sprintf("Total %d commits(s)", len(p.Commits))
+	actionCard.Text += "\n## " + fmt.Sprintf("Total %d commits(s)", p.TotalCommits)
 	actionCard.Text += "\n" + detail
 
 	return &DingtalkPayload{MsgType: "actionCard", ActionCard: actionCard}, nil
@@ -255,6 +259,27 @@ func getDingtalkReleasePayload(p *api.ReleasePayload) (*DingtalkPayload, error)
 	return &DingtalkPayload{MsgType: "actionCard", ActionCard: actionCard}, nil
 }
 
+func getDingtalkRepositoryPayload(p *RepositoryPayload) (*DingtalkPayload, error) {
+	actionCard := NewDingtalkActionCard("View Repo", p.Repo.HTMLURL)
+
+	actionCard.Text += "# Repository Event"
+	actionCard.Text += "\n- Repo: **" + MarkdownLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName) + "**"
+	actionCard.Text += "\n- Action: **" + string(p.Action) + "**"
+	actionCard.Text += "\n- Operator: **" + p.Sender.UserName + "**"
+
+	return &DingtalkPayload{MsgType: "actionCard", ActionCard: actionCard}, nil
+}
+
+func getDingtalkPingPayload(p *PingPayload) (*DingtalkPayload, error) {
+	actionCard := NewDingtalkActionCard("View Repo", p.Repo.HTMLURL)
+
+	actionCard.Text += "# Webhook Ping Event"
+	actionCard.Text += "\n- Repo: **" + MarkdownLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName) + "**"
+	actionCard.Text += "\n- Webhook is set up successfully"
+
+	return &DingtalkPayload{MsgType: "actionCard", ActionCard: actionCard}, nil
+}
+
 //Format link addr and title into markdown style
 func MarkdownLinkFormatter(link, text string) string {
 	return "[" + text + "](" + link + ")"
@@ -121,7 +121,7 @@ func getDiscordForkPayload(p *api.ForkPayload) (*DiscordPayload, error) {
 	}, nil
 }
 
-func getDiscordPushPayload(p *api.PushPayload, slack *SlackMeta) (*DiscordPayload, error) {
+func getDiscordPushPayload(p *PushPayload, slack *SlackMeta) (*DiscordPayload, error) {
 	// n new commits
 	var (
 		branchName   = git.RefEndName(p.Ref)
@@ -129,10 +129,10 @@ func getDiscordPushPayload(p *api.PushPayload, slack *SlackMeta) (*DiscordPayloa
 		commitString string
 	)
 
-	if len(p.Commits) == 1 {
+	if p.TotalCommits == 1 {
 		commitDesc = "1 new commit"
 	} else {
-		commitDesc = fmt.Sprintf("%d new commits", len(p.Commits))
+		commitDesc = fmt.Sprintf("%d new commits", p.TotalCommits)
 	}
 
 	if len(p.CompareURL) > 0 {
@@ -370,6 +370,30 @@ func getDiscordReleasePayload(p *api.ReleasePayload) (*DiscordPayload, error) {
 	}, nil
 }
 
+func getDiscordPingPayload(p *PingPayload) (*DiscordPayload, error) {
+	repoLink := DiscordLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	return &DiscordPayload{
+		Embeds: []*DiscordEmbedObject{{
+			Description: fmt.Sprintf("Webhook of %s is set up successfully", repoLink),
+			URL:         p.Repo.HTMLURL,
+		}},
+	}, nil
+}
+
+func getDiscordRepositoryPayload(p *RepositoryPayload) (*DiscordPayload, error) {
+	repoLink := DiscordLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	return &DiscordPayload{
+		Embeds: []*DiscordEmbedObject{{
+			Description: fmt.Sprintf("Repository %s is %s", repoLink, p.Action),
+			URL:         p.Repo.HTMLURL,
+			Author: &DiscordEmbedAuthorObject{
+				Name:    p.Sender.UserName,
+				IconURL: p.Sender.AvatarUrl,
+			},
+		}},
+	}, nil
+}
+
 func GetDiscordPayload(p api.Payloader, event HookEventType, meta string) (payload *DiscordPayload, err error) {
 	slack := &SlackMeta{}
 	if err := jsoniter.Unmarshal([]byte(meta), &slack); err != nil {
@@ -384,15 +408,19 @@ func GetDiscordPayload(p api.Payloader, event HookEventType, meta string) (paylo
 	case HOOK_EVENT_FORK:
 		payload, err = getDiscordForkPayload(p.(*api.ForkPayload))
 	case HOOK_EVENT_PUSH:
-		payload, err = getDiscordPushPayload(p.(*api.PushPayload), slack)
+		payload, err = getDiscordPushPayload(p.(*PushPayload), slack)
 	case HOOK_EVENT_ISSUES:
-		payload, err = getDiscordIssuesPayload(p.(*api.IssuesPayload), slack)
+		payload, err = getDiscordIssuesPayload(p.(*IssuesPayload).IssuesPayload, slack)
 	case HOOK_EVENT_ISSUE_COMMENT:
 		payload, err = getDiscordIssueCommentPayload(p.(*api.IssueCommentPayload), slack)
 	case HOOK_EVENT_PULL_REQUEST:
 		payload, err = getDiscordPullRequestPayload(p.(*api.PullRequestPayload), slack)
 	case HOOK_EVENT_RELEASE:
-		payload, err = getDiscordReleasePayload(p.(*api.ReleasePayload))
+		payload, err = getDiscordReleasePayload(p.(*ReleasePayload).ReleasePayload)
+	case HOOK_EVENT_REPOSITORY:
+		payload, err = getDiscordRepositoryPayload(p.(*RepositoryPayload))
+	case HOOK_EVENT_PING:
+		payload, err = getDiscordPingPayload(p.(*PingPayload))
 	}
 	if err != nil {
 		return nil, fmt.Errorf("event '%s': %v", event, err)
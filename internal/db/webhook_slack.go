@@ -101,7 +101,7 @@ func getSlackForkPayload(p *api.ForkPayload) (*SlackPayload, error) {
 	}, nil
 }
 
-func getSlackPushPayload(p *api.PushPayload, slack *SlackMeta) (*SlackPayload, error) {
+func getSlackPushPayload(p *PushPayload, slack *SlackMeta) (*SlackPayload, error) {
 	// n new commits
 	var (
 		branchName   = git.RefEndName(p.Ref)
@@ -109,10 +109,10 @@ func getSlackPushPayload(p *api.PushPayload, slack *SlackMeta) (*SlackPayload, e
 		commitString string
 	)
 
-	if len(p.Commits) == 1 {
+	if p.TotalCommits == 1 {
 		commitDesc = "1 new commit"
 	} else {
-		commitDesc = fmt.Sprintf("%d new commits", len(p.Commits))
+		commitDesc = fmt.Sprintf("%d new commits", p.TotalCommits)
 	}
 	if len(p.CompareURL) > 0 {
 		commitString = SlackLinkFormatter(p.CompareURL, commitDesc)
@@ -287,6 +287,20 @@ func getSlackReleasePayload(p *api.ReleasePayload) (*SlackPayload, error) {
 	}, nil
 }
 
+func getSlackPingPayload(p *PingPayload) (*SlackPayload, error) {
+	repoLink := SlackLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	return &SlackPayload{
+		Text: fmt.Sprintf("Webhook of %s is set up successfully", repoLink),
+	}, nil
+}
+
+func getSlackRepositoryPayload(p *RepositoryPayload) (*SlackPayload, error) {
+	repoLink := SlackLinkFormatter(p.Repo.HTMLURL, p.Repo.FullName)
+	return &SlackPayload{
+		Text: fmt.Sprintf("[%s] repository %s by %s", repoLink, p.Action, p.Sender.UserName),
+	}, nil
+}
+
 func GetSlackPayload(p api.Payloader, event HookEventType, meta string) (payload *SlackPayload, err error) {
 	slack := &SlackMeta{}
 	if err := jsoniter.Unmarshal([]byte(meta), &slack); err != nil {
@@ -301,15 +315,19 @@ func GetSlackPayload(p api.Payloader, event HookEventType, meta string) (payload
 	case HOOK_EVENT_FORK:
 		payload, err = getSlackForkPayload(p.(*api.ForkPayload))
 	case HOOK_EVENT_PUSH:
-		payload, err = getSlackPushPayload(p.(*api.PushPayload), slack)
+		payload, err = getSlackPushPayload(p.(*PushPayload), slack)
 	case HOOK_EVENT_ISSUES:
-		payload, err = getSlackIssuesPayload(p.(*api.IssuesPayload), slack)
+		payload, err = getSlackIssuesPayload(p.(*IssuesPayload).IssuesPayload, slack)
 	case HOOK_EVENT_ISSUE_COMMENT:
 		payload, err = getSlackIssueCommentPayload(p.(*api.IssueCommentPayload), slack)
 	case HOOK_EVENT_PULL_REQUEST:
 		payload, err = getSlackPullRequestPayload(p.(*api.PullRequestPayload), slack)
 	case HOOK_EVENT_RELEASE:
-		payload, err = getSlackReleasePayload(p.(*api.ReleasePayload))
+		payload, err = getSlackReleasePayload(p.(*ReleasePayload).ReleasePayload)
+	case HOOK_EVENT_REPOSITORY:
+		payload, err = getSlackRepositoryPayload(p.(*RepositoryPayload))
+	case HOOK_EVENT_PING:
+		payload, err = getSlackPingPayload(p.(*PingPayload))
 	}
 	if err != nil {
 		return nil, fmt.Errorf("event '%s': %v", event, err)
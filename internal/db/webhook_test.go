@@ -0,0 +1,253 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	api "github.com/gogs/go-gogs-client"
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+func makePayloadCommits(n int) []*api.PayloadCommit {
+	commits := make([]*api.PayloadCommit, n)
+	for i := range commits {
+		sha := fmt.Sprintf("%040x", n-i)
+		commits[i] = &api.PayloadCommit{
+			ID:      sha,
+			Message: strings.Repeat("x", 200),
+			URL:     "https://gogs.example.com/alice/repo/commit/" + sha,
+			Author: &api.PayloadUser{
+				Name:  "Bob",
+				Email: "bob@example.com",
+			},
+			Committer: &api.PayloadUser{
+				Name:  "Bob",
+				Email: "bob@example.com",
+			},
+			Added: []string{"README.md"},
+		}
+	}
+	return commits
+}
+
+func Test_NewPushPayload(t *testing.T) {
+	defer func(max int) {
+		conf.Webhook.MaxPayloadCommits = max
+	}(conf.Webhook.MaxPayloadCommits)
+	conf.Webhook.MaxPayloadCommits = 20
+
+	pusher := &api.User{ID: 1, UserName: "alice"}
+
+	Convey("Cap commits of a large push", t, func() {
+		commits := makePayloadCommits(3000)
+		p := NewPushPayload(&api.PushPayload{
+			Ref:     "refs/heads/master",
+			Commits: commits,
+			Pusher:  pusher,
+			Sender:  pusher,
+		}, len(commits))
+
+		So(p.Commits, ShouldHaveLength, 20)
+		So(p.TotalCommits, ShouldEqual, 3000)
+		So(p.HeadCommit, ShouldEqual, commits[0])
+		So(p.Pusher.UserName, ShouldEqual, "alice")
+
+		data, err := p.JSONPayload()
+		So(err, ShouldBeNil)
+		So(len(data), ShouldBeLessThan, 32*1024)
+		So(string(data), ShouldContainSubstring, `"total_commits": 3000`)
+		So(string(data), ShouldContainSubstring, `"head_commit": {`)
+	})
+
+	Convey("Report total commits greater than converted commits", t, func() {
+		p := NewPushPayload(&api.PushPayload{Commits: makePayloadCommits(5)}, 3000)
+		So(p.Commits, ShouldHaveLength, 5)
+		So(p.TotalCommits, ShouldEqual, 3000)
+	})
+
+	Convey("Do not cap commits when limit is disabled", t, func() {
+		conf.Webhook.MaxPayloadCommits = 0
+		p := NewPushPayload(&api.PushPayload{Commits: makePayloadCommits(100)}, 0)
+		So(p.Commits, ShouldHaveLength, 100)
+		So(p.TotalCommits, ShouldEqual, 100)
+	})
+
+	Convey("Push without commits", t, func() {
+		p := NewPushPayload(&api.PushPayload{}, 0)
+		So(p.HeadCommit, ShouldBeNil)
+		So(p.TotalCommits, ShouldEqual, 0)
+	})
+
+	Convey("Flag pushes to the default branch", t, func() {
+		p := NewPushPayload(&api.PushPayload{Ref: "refs/heads/master"}, 0)
+		data, err := p.JSONPayload()
+		So(err, ShouldBeNil)
+		So(string(data), ShouldContainSubstring, `"is_default": false`)
+
+		p.IsDefault = true
+		data, err = p.JSONPayload()
+		So(err, ShouldBeNil)
+		So(string(data), ShouldContainSubstring, `"is_default": true`)
+	})
+}
+
+func Test_NewReleasePayload(t *testing.T) {
+	Convey("Include external links in the release", t, func() {
+		p := NewReleasePayload(&api.ReleasePayload{
+			Action:  api.HOOK_RELEASE_PUBLISHED,
+			Release: &api.Release{ID: 1, TagName: "v1.0"},
+		}, []*ReleaseLink{
+			{ID: 2, Label: "Docker image", URL: "https://hub.docker.com/r/gogs/gogs"},
+		})
+
+		data, err := p.JSONPayload()
+		So(err, ShouldBeNil)
+
+		var payload struct {
+			Action  string `json:"action"`
+			Release struct {
+				TagName string `json:"tag_name"`
+				Links   []struct {
+					Label string `json:"label"`
+					URL   string `json:"url"`
+				} `json:"links"`
+			} `json:"release"`
+		}
+		So(jsoniter.Unmarshal(data, &payload), ShouldBeNil)
+		So(payload.Action, ShouldEqual, "published")
+		So(payload.Release.TagName, ShouldEqual, "v1.0")
+		So(payload.Release.Links, ShouldHaveLength, 1)
+		So(payload.Release.Links[0].Label, ShouldEqual, "Docker image")
+		So(payload.Release.Links[0].URL, ShouldEqual, "https://hub.docker.com/r/gogs/gogs")
+	})
+
+	Convey("Release without external links", t, func() {
+		data, err := NewReleasePayload(&api.ReleasePayload{Release: &api.Release{}}, nil).JSONPayload()
+		So(err, ShouldBeNil)
+		So(string(data), ShouldContainSubstring, `"links": []`)
+	})
+}
+
+func Test_NewIssuesPayload(t *testing.T) {
+	Convey("Include the close reason in the issue", t, func() {
+		p := NewIssuesPayload(&api.IssuesPayload{
+			Action: api.HOOK_ISSUE_CLOSED,
+			Index:  1,
+			Issue:  &api.Issue{Index: 1, Title: "Crash on start"},
+		}, &Issue{CloseReason: ISSUE_CLOSE_REASON_DUPLICATE})
+
+		data, err := p.JSONPayload()
+		So(err, ShouldBeNil)
+
+		var payload struct {
+			Action string `json:"action"`
+			Issue  struct {
+				Title       string `json:"title"`
+				CloseReason string `json:"close_reason"`
+			} `json:"issue"`
+		}
+		So(jsoniter.Unmarshal(data, &payload), ShouldBeNil)
+		So(payload.Action, ShouldEqual, "closed")
+		So(payload.Issue.Title, ShouldEqual, "Crash on start")
+		So(payload.Issue.CloseReason, ShouldEqual, "duplicate")
+	})
+
+	Convey("Open issue has no close reason", t, func() {
+		data, err := NewIssuesPayload(&api.IssuesPayload{Issue: &api.Issue{}}, &Issue{}).JSONPayload()
+		So(err, ShouldBeNil)
+		So(string(data), ShouldNotContainSubstring, "close_reason")
+	})
+}
+
+func Test_PushCommits_limit(t *testing.T) {
+	Convey("Limit push commits without touching the original", t, func() {
+		pc := &PushCommits{Len: 3000, Commits: make([]*PushCommit, 3000)}
+
+		limited := pc.limit(20)
+		So(limited.Commits, ShouldHaveLength, 20)
+		So(limited.Len, ShouldEqual, 3000)
+		So(pc.Commits, ShouldHaveLength, 3000)
+
+		So(pc.limit(0).Commits, ShouldHaveLength, 3000)
+	})
+}
+
+func Test_NewMirrorPusher(t *testing.T) {
+	Convey("Attribute mirror synchronization to a synthetic user", t, func() {
+		repo := &Repository{
+			Name:  "upstream",
+			Owner: &User{Name: "alice"},
+		}
+		pusher := NewMirrorPusher(repo)
+		So(pusher.ID, ShouldEqual, -1)
+		So(pusher.UserName, ShouldEqual, "mirror")
+		So(pusher.FullName, ShouldEqual, "Mirror of alice/upstream")
+	})
+}
+
+func Test_HookPayloadVersion(t *testing.T) {
+	Convey("Render payloads in versions", t, func() {
+		base := &api.PushPayload{Ref: "refs/heads/master", Commits: makePayloadCommits(2)}
+		push := NewPushPayload(base, 2)
+		So(HOOK_PAYLOAD_V1.render(push), ShouldEqual, base)
+		So(HOOK_PAYLOAD_V2.render(push), ShouldEqual, push)
+
+		data, err := HOOK_PAYLOAD_V1.render(push).JSONPayload()
+		So(err, ShouldBeNil)
+		So(string(data), ShouldNotContainSubstring, "total_commits")
+
+		release := NewReleasePayload(&api.ReleasePayload{Release: &api.Release{TagName: "v1.0"}}, nil)
+		So(HOOK_PAYLOAD_V1.render(release), ShouldEqual, release.ReleasePayload)
+
+		issues := NewIssuesPayload(&api.IssuesPayload{Issue: &api.Issue{Index: 1}}, &Issue{CloseReason: ISSUE_CLOSE_REASON_NOT_PLANNED})
+		So(HOOK_PAYLOAD_V1.render(issues), ShouldEqual, issues.IssuesPayload)
+		So(HOOK_PAYLOAD_V2.render(issues), ShouldEqual, issues.IssuesPayload)
+		So(HOOK_PAYLOAD_V3.render(issues), ShouldEqual, issues)
+
+		ping := &PingPayload{Zen: "zen"}
+		So(HOOK_PAYLOAD_V1.render(ping), ShouldEqual, ping)
+
+		Convey("Unknown versions are rendered in the latest version", func() {
+			So(HookPayloadVersion(0).render(push), ShouldEqual, push)
+		})
+	})
+
+	Convey("List changes between versions", t, func() {
+		So(HookPayloadVersions(), ShouldResemble, []HookPayloadVersion{HOOK_PAYLOAD_V1, HOOK_PAYLOAD_V2, HOOK_PAYLOAD_V3})
+		So(HookPayloadChanges(HOOK_PAYLOAD_LATEST, HOOK_PAYLOAD_LATEST), ShouldBeEmpty)
+
+		changes := HookPayloadChanges(HOOK_PAYLOAD_V1, HOOK_PAYLOAD_V2)
+		So(changes, ShouldHaveLength, 4)
+		So(changes[0], ShouldResemble, HookPayloadChange{Event: HOOK_EVENT_PUSH, Field: "total_commits", Kind: "added"})
+
+		changes = HookPayloadChanges(HOOK_PAYLOAD_V1, HOOK_PAYLOAD_V3)
+		So(changes, ShouldHaveLength, 5)
+		So(changes[4], ShouldResemble, HookPayloadChange{Event: HOOK_EVENT_ISSUES, Field: "issue.close_reason", Kind: "added"})
+	})
+}
+
+func Test_Webhook_PayloadVersion(t *testing.T) {
+	setupTestDB(t)
+
+	versioned := &Webhook{RepoID: 1, URL: "http://localhost/versioned", PayloadVersion: HOOK_PAYLOAD_V2}
+	legacy := &Webhook{RepoID: 1, URL: "http://localhost/legacy"}
+	insertTestBeans(t, versioned, legacy)
+
+	Convey("Webhooks created before payloads were versioned receive payloads of version 1", t, func() {
+		w, err := GetWebhookByID(legacy.ID)
+		So(err, ShouldBeNil)
+		So(w.PayloadVersion, ShouldEqual, HOOK_PAYLOAD_V1)
+
+		w, err = GetWebhookByID(versioned.ID)
+		So(err, ShouldBeNil)
+		So(w.PayloadVersion, ShouldEqual, HOOK_PAYLOAD_V2)
+	})
+}
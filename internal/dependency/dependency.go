@@ -0,0 +1,286 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package dependency parses dependencies from manifest files of well-known
+// package ecosystems. Parsing is best-effort: unknown syntax is skipped rather
+// than rejected, and only malformed files result in errors.
+package dependency
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Ecosystem is a package ecosystem.
+type Ecosystem string
+
+const (
+	GO       Ecosystem = "go"
+	NPM      Ecosystem = "npm"
+	PYPI     Ecosystem = "pypi"
+	RUBYGEMS Ecosystem = "rubygems"
+	MAVEN    Ecosystem = "maven"
+)
+
+// manifests maps base names of manifest files to their ecosystems.
+var manifests = map[string]Ecosystem{
+	"go.mod":           GO,
+	"package.json":     NPM,
+	"requirements.txt": PYPI,
+	"Gemfile":          RUBYGEMS,
+	"pom.xml":          MAVEN,
+}
+
+// ManifestEcosystem returns the ecosystem of the manifest file at the path,
+// and false if the file is not a manifest.
+func ManifestEcosystem(treePath string) (Ecosystem, bool) {
+	ecosystem, ok := manifests[path.Base(treePath)]
+	return ecosystem, ok
+}
+
+// Dependency is a package that a manifest depends on.
+type Dependency struct {
+	Name string
+	// Version is the version or the constraint of versions, which is empty
+	// when any version is accepted.
+	Version string
+}
+
+// RegistryURL returns the URL of the package on the upstream registry of the
+// ecosystem, or an empty string if the ecosystem is unknown.
+func RegistryURL(ecosystem Ecosystem, name string) string {
+	switch ecosystem {
+	case GO:
+		return "https://pkg.go.dev/" + name
+	case NPM:
+		return "https://www.npmjs.com/package/" + name
+	case PYPI:
+		return "https://pypi.org/project/" + url.PathEscape(name) + "/"
+	case RUBYGEMS:
+		return "https://rubygems.org/gems/" + url.PathEscape(name)
+	case MAVEN:
+		if i := strings.Index(name, ":"); i > 0 {
+			return "https://central.sonatype.com/artifact/" + url.PathEscape(name[:i]) + "/" + url.PathEscape(name[i+1:])
+		}
+		return "https://central.sonatype.com/search?q=" + url.QueryEscape(name)
+	}
+	return ""
+}
+
+// Parse returns dependencies declared in the manifest of the ecosystem, sorted
+// by names.
+func Parse(ecosystem Ecosystem, data []byte) ([]*Dependency, error) {
+	var (
+		deps []*Dependency
+		err  error
+	)
+	switch ecosystem {
+	case GO:
+		deps = parseGoMod(data)
+	case NPM:
+		deps, err = parsePackageJSON(data)
+	case PYPI:
+		deps = parseRequirements(data)
+	case RUBYGEMS:
+		deps = parseGemfile(data)
+	case MAVEN:
+		deps, err = parsePOM(data)
+	default:
+		return nil, fmt.Errorf("unknown ecosystem %q", ecosystem)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(deps, func(i, j int) bool { return deps[i].Name < deps[j].Name })
+	return deps, nil
+}
+
+// lines returns non-empty lines of the data with comments starting with the
+// prefix removed.
+func lines(data []byte, commentPrefix string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 4096), len(data)+1)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, commentPrefix); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseGoMod parses requirements of a go.mod file, both in single lines and
+// in blocks.
+func parseGoMod(data []byte) []*Dependency {
+	var deps []*Dependency
+	inBlock := false
+	for _, line := range lines(data, "//") {
+		switch {
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case line == "require (":
+			inBlock = true
+			continue
+		case strings.HasPrefix(line, "require "):
+			line = strings.TrimSpace(strings.TrimPrefix(line, "require "))
+		case !inBlock:
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		deps = append(deps, &Dependency{
+			Name:    strings.Trim(fields[0], `"`),
+			Version: fields[1],
+		})
+	}
+	return deps
+}
+
+// parsePackageJSON parses dependencies of all kinds of a package.json file.
+func parsePackageJSON(data []byte) ([]*Dependency, error) {
+	var pkg struct {
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		PeerDependencies     map[string]string `json:"peerDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("parse JSON: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	var deps []*Dependency
+	for _, m := range []map[string]string{pkg.Dependencies, pkg.DevDependencies, pkg.PeerDependencies, pkg.OptionalDependencies} {
+		for name, version := range m {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			deps = append(deps, &Dependency{
+				Name:    name,
+				Version: version,
+			})
+		}
+	}
+	return deps, nil
+}
+
+// requirementName matches the name of a requirement of pip, which is followed
+// by optional extras, version specifiers and environment markers.
+var requirementName = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(\[[^\]]*\])?\s*(.*)$`)
+
+// parseRequirements parses a requirements.txt file of pip. Options, e.g.
+// "-r other.txt", and requirements by URLs or paths are skipped.
+func parseRequirements(data []byte) []*Dependency {
+	var deps []*Dependency
+	for _, line := range lines(data, "#") {
+		if strings.HasPrefix(line, "-") || strings.Contains(line, "://") || strings.HasPrefix(line, ".") {
+			continue
+		}
+
+		m := requirementName.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		version := m[3]
+		if i := strings.Index(version, ";"); i >= 0 {
+			version = version[:i]
+		}
+		deps = append(deps, &Dependency{
+			Name:    m[1],
+			Version: strings.Join(strings.Fields(version), ""),
+		})
+	}
+	return deps
+}
+
+var (
+	gemLine   = regexp.MustCompile(`^gem\s*\(?\s*["']([^"']+)["']\s*(.*)$`)
+	gemString = regexp.MustCompile(`^\s*,\s*["']([^"']+)["']`)
+)
+
+// parseGemfile parses gems of a Gemfile. Only versions given as string
+// literals right after the name are recognized, options such as "require:"
+// are ignored.
+func parseGemfile(data []byte) []*Dependency {
+	var deps []*Dependency
+	for _, line := range lines(data, "#") {
+		m := gemLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		var versions []string
+		rest := m[2]
+		for {
+			v := gemString.FindStringSubmatch(rest)
+			if v == nil {
+				break
+			}
+			versions = append(versions, v[1])
+			rest = rest[len(v[0]):]
+		}
+		deps = append(deps, &Dependency{
+			Name:    m[1],
+			Version: strings.Join(versions, ", "),
+		})
+	}
+	return deps
+}
+
+// parsePOM parses dependencies of a pom.xml file of Maven, including ones in
+// the dependency management section. Properties in versions, e.g.
+// "${junit.version}", are left as is.
+func parsePOM(data []byte) ([]*Dependency, error) {
+	type dependency struct {
+		GroupID    string `xml:"groupId"`
+		ArtifactID string `xml:"artifactId"`
+		Version    string `xml:"version"`
+	}
+	var pom struct {
+		Dependencies         []dependency `xml:"dependencies>dependency"`
+		DependencyManagement []dependency `xml:"dependencyManagement>dependencies>dependency"`
+	}
+	if err := xml.Unmarshal(data, &pom); err != nil {
+		return nil, fmt.Errorf("parse XML: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	var deps []*Dependency
+	for _, d := range append(pom.Dependencies, pom.DependencyManagement...) {
+		groupID := strings.TrimSpace(d.GroupID)
+		artifactID := strings.TrimSpace(d.ArtifactID)
+		if artifactID == "" {
+			continue
+		}
+
+		name := groupID + ":" + artifactID
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		deps = append(deps, &Dependency{
+			Name:    name,
+			Version: strings.TrimSpace(d.Version),
+		})
+	}
+	return deps, nil
+}
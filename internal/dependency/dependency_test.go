@@ -0,0 +1,173 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dependency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestEcosystem(t *testing.T) {
+	tests := []struct {
+		path         string
+		expEcosystem Ecosystem
+		expOK        bool
+	}{
+		{path: "go.mod", expEcosystem: GO, expOK: true},
+		{path: "web/package.json", expEcosystem: NPM, expOK: true},
+		{path: "requirements.txt", expEcosystem: PYPI, expOK: true},
+		{path: "Gemfile", expEcosystem: RUBYGEMS, expOK: true},
+		{path: "java/pom.xml", expEcosystem: MAVEN, expOK: true},
+		{path: "go.sum", expOK: false},
+		{path: "Gemfile.lock", expOK: false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			ecosystem, ok := ManifestEcosystem(test.path)
+			assert.Equal(t, test.expEcosystem, ecosystem)
+			assert.Equal(t, test.expOK, ok)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		ecosystem Ecosystem
+		data      string
+		expDeps   []*Dependency
+		expErr    bool
+	}{
+		{
+			name:      "go.mod",
+			ecosystem: GO,
+			data: `module gogs.io/gogs
+
+go 1.14
+
+require github.com/gogs/git-module v1.1.0
+
+require (
+	github.com/unknwon/com v1.0.1 // indirect
+	xorm.io/xorm v0.8.0
+)
+
+replace (
+	github.com/gogs/git-module => ../git-module
+)
+`,
+			expDeps: []*Dependency{
+				{Name: "github.com/gogs/git-module", Version: "v1.1.0"},
+				{Name: "github.com/unknwon/com", Version: "v1.0.1"},
+				{Name: "xorm.io/xorm", Version: "v0.8.0"},
+			},
+		},
+		{
+			name:      "package.json",
+			ecosystem: NPM,
+			data: `{
+  "name": "app",
+  "dependencies": {"react": "^16.13.0"},
+  "devDependencies": {"jest": "~25.1.0", "react": "16.12.0"}
+}`,
+			expDeps: []*Dependency{
+				{Name: "jest", Version: "~25.1.0"},
+				{Name: "react", Version: "^16.13.0"},
+			},
+		},
+		{
+			name:      "malformed package.json",
+			ecosystem: NPM,
+			data:      `{"dependencies": `,
+			expErr:    true,
+		},
+		{
+			name:      "requirements.txt",
+			ecosystem: PYPI,
+			data: `# Web
+Django >= 3.0, < 3.1
+requests[security]==2.23.0 ; python_version >= "3.6"
+-r dev.txt
+git+https://github.com/psf/black.git#egg=black
+six
+`,
+			expDeps: []*Dependency{
+				{Name: "Django", Version: ">=3.0,<3.1"},
+				{Name: "requests", Version: "==2.23.0"},
+				{Name: "six", Version: ""},
+			},
+		},
+		{
+			name:      "Gemfile",
+			ecosystem: RUBYGEMS,
+			data: `source "https://rubygems.org"
+
+gem "rails", "~> 6.0.2", ">= 6.0.2.1"
+gem 'pg', require: false # database
+group :test do
+  gem "rspec"
+end
+`,
+			expDeps: []*Dependency{
+				{Name: "pg", Version: ""},
+				{Name: "rails", Version: "~> 6.0.2, >= 6.0.2.1"},
+				{Name: "rspec", Version: ""},
+			},
+		},
+		{
+			name:      "pom.xml",
+			ecosystem: MAVEN,
+			data: `<project>
+  <dependencyManagement>
+    <dependencies>
+      <dependency><groupId>org.slf4j</groupId><artifactId>slf4j-api</artifactId><version>1.7.30</version></dependency>
+    </dependencies>
+  </dependencyManagement>
+  <dependencies>
+    <dependency>
+      <groupId>junit</groupId>
+      <artifactId>junit</artifactId>
+      <version>${junit.version}</version>
+    </dependency>
+    <dependency>
+      <groupId>org.slf4j</groupId>
+      <artifactId>slf4j-api</artifactId>
+    </dependency>
+  </dependencies>
+</project>`,
+			expDeps: []*Dependency{
+				{Name: "junit:junit", Version: "${junit.version}"},
+				{Name: "org.slf4j:slf4j-api", Version: ""},
+			},
+		},
+		{
+			name:      "malformed pom.xml",
+			ecosystem: MAVEN,
+			data:      `<project><dependencies>`,
+			expErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps, err := Parse(test.ecosystem, []byte(test.data))
+			if test.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expDeps, deps)
+		})
+	}
+}
+
+func TestRegistryURL(t *testing.T) {
+	assert.Equal(t, "https://pkg.go.dev/xorm.io/xorm", RegistryURL(GO, "xorm.io/xorm"))
+	assert.Equal(t, "https://www.npmjs.com/package/@babel/core", RegistryURL(NPM, "@babel/core"))
+	assert.Equal(t, "https://pypi.org/project/Django/", RegistryURL(PYPI, "Django"))
+	assert.Equal(t, "https://rubygems.org/gems/rails", RegistryURL(RUBYGEMS, "rails"))
+	assert.Equal(t, "https://central.sonatype.com/artifact/junit/junit", RegistryURL(MAVEN, "junit:junit"))
+	assert.Equal(t, "", RegistryURL("cargo", "serde"))
+}
@@ -5,10 +5,12 @@
 package email
 
 import (
+	"bytes"
 	"fmt"
 	"html/template"
 	"path/filepath"
 	"sync"
+	texttemplate "text/template"
 	"time"
 
 	"gopkg.in/gomail.v2"
@@ -26,41 +28,59 @@ const (
 	MAIL_AUTH_RESET_PASSWORD  = "auth/reset_passwd"
 	MAIL_AUTH_REGISTER_NOTIFY = "auth/register_notify"
 
-	MAIL_ISSUE_COMMENT = "issue/comment"
-	MAIL_ISSUE_MENTION = "issue/mention"
-
-	MAIL_NOTIFY_COLLABORATOR = "notify/collaborator"
+	MAIL_ISSUE_COMMENT         = "issue/comment"
+	MAIL_ISSUE_MENTION         = "issue/mention"
+	MAIL_ISSUE_REVIEW_REMINDER = "issue/review_reminder"
+	MAIL_ISSUE_MERGE           = "issue/merge"
+
+	MAIL_NOTIFY_COLLABORATOR     = "notify/collaborator"
+	MAIL_NOTIFY_COMMIT_MENTION   = "notify/commit_mention"
+	MAIL_NOTIFY_PATH_WATCH       = "notify/path_watch"
+	MAIL_NOTIFY_REPO_INVITE      = "notify/repo_invite"
+	MAIL_NOTIFY_VISIBILITY       = "notify/visibility_failed"
+	MAIL_NOTIFY_INACTIVE_USER    = "notify/inactive_user"
+	MAIL_NOTIFY_DEACTIVATED_USER = "notify/deactivated_user"
+	MAIL_NOTIFY_INACTIVE_REPO    = "notify/inactive_repo"
 )
 
 var (
 	tplRender     *macaron.TplRender
 	tplRenderOnce sync.Once
+
+	textTemplates     *texttemplate.Template
+	textTemplatesOnce sync.Once
 )
 
+// funcMap returns functions available to both HTML and plain text templates.
+func funcMap() map[string]interface{} {
+	return map[string]interface{}{
+		"AppName": func() string {
+			return conf.App.BrandName
+		},
+		"AppURL": func() string {
+			return conf.Server.ExternalURL
+		},
+		"Year": func() int {
+			return time.Now().Year()
+		},
+	}
+}
+
 // render renders a mail template with given data.
 func render(tpl string, data map[string]interface{}) (string, error) {
 	tplRenderOnce.Do(func() {
+		funcs := funcMap()
+		funcs["Str2HTML"] = func(raw string) template.HTML {
+			return template.HTML(markup.Sanitize(raw))
+		}
 		opt := &macaron.RenderOptions{
 			Directory:         filepath.Join(conf.WorkDir(), "templates", "mail"),
 			AppendDirectories: []string{filepath.Join(conf.CustomDir(), "templates", "mail")},
 			Extensions:        []string{".tmpl", ".html"},
-			Funcs: []template.FuncMap{map[string]interface{}{
-				"AppName": func() string {
-					return conf.App.BrandName
-				},
-				"AppURL": func() string {
-					return conf.Server.ExternalURL
-				},
-				"Year": func() int {
-					return time.Now().Year()
-				},
-				"Str2HTML": func(raw string) template.HTML {
-					return template.HTML(markup.Sanitize(raw))
-				},
-			}},
+			Funcs:             []template.FuncMap{funcs},
 		}
 		if !conf.Server.LoadAssetsFromDisk {
-			opt.TemplateFileSystem = templates.NewTemplateFileSystem("mail", opt.AppendDirectories[0])
+			opt.TemplateFileSystem = templates.NewTemplateFileSystem("mail", opt.AppendDirectories[0], opt.Extensions...)
 		}
 
 		ts := macaron.NewTemplateSet()
@@ -74,6 +94,49 @@ func render(tpl string, data map[string]interface{}) (string, error) {
 	return tplRender.HTMLString(tpl, data)
 }
 
+// renderText renders the plain text template of a mail, which has the same name
+// as the HTML template but the ".txt" extension. Contents are not escaped.
+func renderText(tpl string, data map[string]interface{}) (string, error) {
+	textTemplatesOnce.Do(func() {
+		opt := macaron.RenderOptions{
+			Directory:         filepath.Join(conf.WorkDir(), "templates", "mail"),
+			AppendDirectories: []string{filepath.Join(conf.CustomDir(), "templates", "mail")},
+			Extensions:        []string{".txt"},
+		}
+		var fs macaron.TemplateFileSystem
+		if conf.Server.LoadAssetsFromDisk {
+			fs = macaron.NewTemplateFileSystem(opt, false)
+		} else {
+			fs = templates.NewTemplateFileSystem("mail", opt.AppendDirectories[0], opt.Extensions...)
+		}
+
+		textTemplates = texttemplate.New("").Funcs(funcMap())
+		for _, f := range fs.ListFiles() {
+			texttemplate.Must(textTemplates.New(f.Name()).Parse(string(f.Data())))
+		}
+	})
+
+	var buf bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&buf, tpl, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// renderMultipart renders both the plain text and the HTML templates of a mail
+// with given data.
+func renderMultipart(tpl string, data map[string]interface{}) (plainBody, htmlBody string, err error) {
+	plainBody, err = renderText(tpl, data)
+	if err != nil {
+		return "", "", fmt.Errorf("render plain text: %v", err)
+	}
+	htmlBody, err = render(tpl, data)
+	if err != nil {
+		return "", "", fmt.Errorf("render HTML: %v", err)
+	}
+	return plainBody, htmlBody, nil
+}
+
 func SendTestMail(email string) error {
 	return gomail.Send(&Sender{}, NewMessage([]string{email}, "Gogs Test Email", "Hello 👋, greeting from Gogs!").Message)
 }
@@ -97,6 +160,7 @@ type Repository interface {
 }
 
 type Issue interface {
+	ID() int64
 	MailSubject() string
 	Content() string
 	HTMLURL() string
@@ -187,6 +251,195 @@ func SendCollaboratorMail(u, doer User, repo Repository) {
 	Send(msg)
 }
 
+// SendRepoInviteMail sends mail to the email address that has been invited to
+// collaborate on the repository.
+func SendRepoInviteMail(address string, doer User, repo Repository, link string) {
+	subject := fmt.Sprintf("%s invited you to collaborate on %s", doer.DisplayName(), repo.FullName())
+
+	data := map[string]interface{}{
+		"Subject":  subject,
+		"Inviter":  doer.DisplayName(),
+		"RepoName": repo.FullName(),
+		"Link":     link,
+	}
+	body, err := render(MAIL_NOTIFY_REPO_INVITE, data)
+	if err != nil {
+		log.Error("HTMLString: %v", err)
+		return
+	}
+
+	msg := NewMessage([]string{address}, subject, body)
+	msg.Info = fmt.Sprintf("Email: %s, repository invitation", address)
+
+	Send(msg)
+}
+
+// SendVisibilityChangeFailedMail sends mail to repository admins that the
+// scheduled visibility change of the repository is not applied for the reason.
+func SendVisibilityChangeFailedMail(repo Repository, tos []string, isPrivate bool, reason string) {
+	if len(tos) == 0 {
+		return
+	}
+
+	subject := fmt.Sprintf("Scheduled visibility change of %s failed", repo.FullName())
+	data := map[string]interface{}{
+		"Subject":   subject,
+		"RepoName":  repo.FullName(),
+		"Link":      repo.HTMLURL() + "/settings",
+		"IsPrivate": isPrivate,
+		"Reason":    reason,
+	}
+	body, err := render(MAIL_NOTIFY_VISIBILITY, data)
+	if err != nil {
+		log.Error("HTMLString: %v", err)
+		return
+	}
+
+	msg := NewMessage(tos, subject, body)
+	msg.Info = fmt.Sprintf("Subject: %s, visibility change failed", subject)
+
+	Send(msg)
+}
+
+// SendInactiveUserMail sends mail to the user that the account has been
+// inactive for the months and will be deactivated after the grace days unless
+// the "keep active" link is followed, or never when grace days is zero.
+func SendInactiveUserMail(u User, months, graceDays int, keepLink string) {
+	subject := fmt.Sprintf("Your %s account is inactive", conf.App.BrandName)
+	data := map[string]interface{}{
+		"Subject":    subject,
+		"Username":   u.DisplayName(),
+		"Months":     months,
+		"GraceDays":  graceDays,
+		"KeepLink":   keepLink,
+		"SignInLink": conf.Server.ExternalURL + "user/login",
+	}
+	body, err := render(MAIL_NOTIFY_INACTIVE_USER, data)
+	if err != nil {
+		log.Error("HTMLString: %v", err)
+		return
+	}
+
+	msg := NewMessage([]string{u.Email()}, subject, body)
+	msg.Info = fmt.Sprintf("UID: %d, inactive user", u.ID())
+
+	Send(msg)
+}
+
+// SendDeactivatedUserMail sends mail to the user that the account has been
+// deactivated for being inactive for the months, which can be reactivated by
+// following the "keep active" link.
+func SendDeactivatedUserMail(u User, months int, keepLink string) {
+	subject := fmt.Sprintf("Your %s account has been deactivated", conf.App.BrandName)
+	data := map[string]interface{}{
+		"Subject":  subject,
+		"Username": u.DisplayName(),
+		"Months":   months,
+		"KeepLink": keepLink,
+	}
+	body, err := render(MAIL_NOTIFY_DEACTIVATED_USER, data)
+	if err != nil {
+		log.Error("HTMLString: %v", err)
+		return
+	}
+
+	msg := NewMessage([]string{u.Email()}, subject, body)
+	msg.Info = fmt.Sprintf("UID: %d, deactivated user", u.ID())
+
+	Send(msg)
+}
+
+// SendInactiveRepoMail sends mail to repository admins that the repository
+// has had no activity for the months.
+func SendInactiveRepoMail(repo Repository, tos []string, months int, keepLink string) {
+	if len(tos) == 0 {
+		return
+	}
+
+	subject := fmt.Sprintf("Repository %s is inactive", repo.FullName())
+	data := map[string]interface{}{
+		"Subject":  subject,
+		"RepoName": repo.FullName(),
+		"Link":     repo.HTMLURL(),
+		"Months":   months,
+		"KeepLink": keepLink,
+	}
+	body, err := render(MAIL_NOTIFY_INACTIVE_REPO, data)
+	if err != nil {
+		log.Error("HTMLString: %v", err)
+		return
+	}
+
+	msg := NewMessage(tos, subject, body)
+	msg.Info = fmt.Sprintf("Subject: %s, inactive repository", subject)
+
+	Send(msg)
+}
+
+// MentionedCommit is a commit whose message mentions the receiver of the mail.
+type MentionedCommit struct {
+	Summary string
+	Link    string
+}
+
+// SendCommitMentionMail sends mail to the user mentioned in messages of the
+// commits pushed to the repository.
+func SendCommitMentionMail(repo Repository, doer User, to string, commits []MentionedCommit) {
+	subject := fmt.Sprintf("%s mentioned you in %s", doer.DisplayName(), repo.FullName())
+	data := map[string]interface{}{
+		"Subject":  subject,
+		"Doer":     doer.DisplayName(),
+		"RepoName": repo.FullName(),
+		"Commits":  commits,
+	}
+	body, err := render(MAIL_NOTIFY_COMMIT_MENTION, data)
+	if err != nil {
+		log.Error("HTMLString: %v", err)
+		return
+	}
+
+	msg := NewMessage([]string{to}, subject, body)
+	msg.Info = fmt.Sprintf("Subject: %s, commit mention", subject)
+
+	Send(msg)
+}
+
+// WatchedPath is a changed file whose path is watched by the receiver of the
+// mail.
+type WatchedPath struct {
+	Status  string
+	Path    string
+	OldPath string
+	Link    string
+}
+
+// SendPathWatchMail sends mail to the user who watches paths of the repository
+// changed by a push to the branch. The files may only be the first ones of the
+// total number of matched files.
+func SendPathWatchMail(repo Repository, doer User, to, branch, compareLink string, files []WatchedPath, total int) {
+	subject := fmt.Sprintf("[%s] Watched paths changed on %s", repo.FullName(), branch)
+	data := map[string]interface{}{
+		"Subject":     subject,
+		"Doer":        doer.DisplayName(),
+		"RepoName":    repo.FullName(),
+		"Branch":      branch,
+		"CompareLink": compareLink,
+		"Files":       files,
+		"More":        total - len(files),
+		"Link":        repo.HTMLURL() + "/watch-paths",
+	}
+	body, err := render(MAIL_NOTIFY_PATH_WATCH, data)
+	if err != nil {
+		log.Error("HTMLString: %v", err)
+		return
+	}
+
+	msg := NewMessage([]string{to}, subject, body)
+	msg.Info = fmt.Sprintf("Subject: %s, path watch", subject)
+
+	Send(msg)
+}
+
 func composeTplData(subject, body, link string) map[string]interface{} {
 	data := make(map[string]interface{}, 10)
 	data["Subject"] = subject
@@ -195,34 +448,133 @@ func composeTplData(subject, body, link string) map[string]interface{} {
 	return data
 }
 
-func composeIssueMessage(issue Issue, repo Repository, doer User, tplName string, tos []string, info string) *Message {
-	subject := issue.MailSubject()
-	body := string(markup.Markdown([]byte(issue.Content()), repo.HTMLURL(), repo.ComposeMetas()))
-	data := composeTplData(subject, body, issue.HTMLURL())
+// issueMailData returns data of issue mail templates. Body is the content of
+// the issue or comment rendered from markdown for the HTML part, and Content is
+// the markdown source for the plain text part. Neither is set when the
+// receivers only want links.
+func issueMailData(issue Issue, repo Repository, doer User, linksOnly bool) map[string]interface{} {
+	data := composeTplData(issue.MailSubject(), "", issue.HTMLURL())
 	data["Doer"] = doer
-	content, err := render(tplName, data)
+	data["LinksOnly"] = linksOnly
+	if !linksOnly {
+		data["Body"] = string(markup.Markdown([]byte(issue.Content()), repo.HTMLURL(), repo.ComposeMetas()))
+		data["Content"] = issue.Content()
+	}
+	return data
+}
+
+// composeIssueMessage composes the mail of the issue from the doer. Mails of
+// the same issue are in the same thread, where the mail is of the event of the
+// issue, or starts the thread when the event is empty.
+func composeIssueMessage(issue Issue, doer User, tplName string, data map[string]interface{}, tos []string, event, info string) (*Message, error) {
+	plainBody, htmlBody, err := renderMultipart(tplName, data)
 	if err != nil {
-		log.Error("HTMLString (%s): %v", tplName, err)
+		return nil, err
 	}
+
+	subject := issue.MailSubject()
 	from := gomail.NewMessage().FormatAddress(conf.Email.FromEmail, doer.DisplayName())
-	msg := NewMessageFrom(tos, from, subject, content)
+	msg := NewMultipartMessageFrom(tos, from, subject, plainBody, htmlBody)
+	msg.setThreadHeaders(issue.ID(), event)
 	msg.Info = fmt.Sprintf("Subject: %s, %s", subject, info)
-	return msg
+	return msg, nil
+}
+
+// commentEvent returns the event of the issue for mails of the comment, or the
+// issue creation when the comment ID is zero.
+func commentEvent(commentID int64) string {
+	if commentID == 0 {
+		return ""
+	}
+	return fmt.Sprintf("comments/%d", commentID)
+}
+
+// SendIssueCommentMail composes and sends issue comment emails to target
+// receivers. Content of the issue is left out when the receivers only want
+// links.
+func SendIssueCommentMail(issue Issue, repo Repository, doer User, tos []string, commentID int64, linksOnly bool) {
+	if len(tos) == 0 {
+		return
+	}
+
+	data := issueMailData(issue, repo, doer, linksOnly)
+	msg, err := composeIssueMessage(issue, doer, MAIL_ISSUE_COMMENT, data, tos, commentEvent(commentID), "issue comment")
+	if err != nil {
+		log.Error("Failed to compose issue comment mail: %v", err)
+		return
+	}
+	Send(msg)
 }
 
-// SendIssueCommentMail composes and sends issue comment emails to target receivers.
-func SendIssueCommentMail(issue Issue, repo Repository, doer User, tos []string) {
+// SendIssueMentionMail composes and sends issue mention emails to target
+// receivers. Content of the issue is left out when the receivers only want
+// links.
+func SendIssueMentionMail(issue Issue, repo Repository, doer User, tos []string, commentID int64, linksOnly bool) {
 	if len(tos) == 0 {
 		return
 	}
 
-	Send(composeIssueMessage(issue, repo, doer, MAIL_ISSUE_COMMENT, tos, "issue comment"))
+	data := issueMailData(issue, repo, doer, linksOnly)
+	msg, err := composeIssueMessage(issue, doer, MAIL_ISSUE_MENTION, data, tos, commentEvent(commentID), "issue mention")
+	if err != nil {
+		log.Error("Failed to compose issue mention mail: %v", err)
+		return
+	}
+	Send(msg)
 }
 
-// SendIssueMentionMail composes and sends issue mention emails to target receivers.
-func SendIssueMentionMail(issue Issue, repo Repository, doer User, tos []string) {
+// reviewReminderMailData returns data of the review reminder mail.
+func reviewReminderMailData(issue Issue, reviewer User, hours int, escalated bool) map[string]interface{} {
+	data := composeTplData(issue.MailSubject(), "", issue.HTMLURL())
+	data["Reviewer"] = reviewer.DisplayName()
+	data["Hours"] = hours
+	data["Escalated"] = escalated
+	return data
+}
+
+// SendReviewReminderMail sends mail to remind the reviewer of a pull request
+// that has been waiting for review for given hours. It is sent to repository
+// admins instead when the reminder is escalated.
+func SendReviewReminderMail(issue Issue, repo Repository, reviewer User, tos []string, hours int, escalated bool) {
 	if len(tos) == 0 {
 		return
 	}
-	Send(composeIssueMessage(issue, repo, doer, MAIL_ISSUE_MENTION, tos, "issue mention"))
+
+	subject := issue.MailSubject()
+	plainBody, htmlBody, err := renderMultipart(MAIL_ISSUE_REVIEW_REMINDER, reviewReminderMailData(issue, reviewer, hours, escalated))
+	if err != nil {
+		log.Error("Failed to compose review reminder mail: %v", err)
+		return
+	}
+
+	msg := NewMultipartMessageFrom(tos, conf.Email.From, subject, plainBody, htmlBody)
+	// Reminders are sent repeatedly, each needs its own message ID.
+	msg.setThreadHeaders(issue.ID(), fmt.Sprintf("reminders/%d", time.Now().UnixNano()))
+	msg.Info = fmt.Sprintf("Subject: %s, review reminder", subject)
+
+	Send(msg)
+}
+
+// mergeMailData returns data of the mail of the pull request merged into the
+// base branch.
+func mergeMailData(issue Issue, doer User, baseBranch string) map[string]interface{} {
+	data := composeTplData(issue.MailSubject(), "", issue.HTMLURL())
+	data["Doer"] = doer
+	data["BaseBranch"] = baseBranch
+	return data
+}
+
+// SendPullRequestMergeMail sends mail to participants of the pull request that
+// it has been merged into the base branch by the doer.
+func SendPullRequestMergeMail(issue Issue, doer User, tos []string, baseBranch string) {
+	if len(tos) == 0 {
+		return
+	}
+
+	msg, err := composeIssueMessage(issue, doer, MAIL_ISSUE_MERGE, mergeMailData(issue, doer, baseBranch), tos, "merge", "pull request merge")
+	if err != nil {
+		log.Error("Failed to compose pull request merge mail: %v", err)
+		return
+	}
+	Send(msg)
 }
@@ -0,0 +1,147 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package email
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/testutil"
+)
+
+type mockUser struct {
+	id   int64
+	name string
+}
+
+func (u mockUser) ID() int64                                     { return u.id }
+func (u mockUser) DisplayName() string                           { return u.name }
+func (u mockUser) Email() string                                 { return u.name + "@example.com" }
+func (u mockUser) GenerateActivateCode() string                  { return "" }
+func (u mockUser) GenerateEmailActivateCode(email string) string { return "" }
+
+type mockRepo struct{}
+
+func (mockRepo) FullName() string                { return "alice/example" }
+func (mockRepo) HTMLURL() string                 { return "https://gogs.example.com/alice/example" }
+func (mockRepo) ComposeMetas() map[string]string { return nil }
+
+type mockIssue struct {
+	content string
+}
+
+func (mockIssue) ID() int64           { return 1 }
+func (mockIssue) MailSubject() string { return "[example] Fix <script> in README (#2)" }
+func (i mockIssue) Content() string   { return i.content }
+func (mockIssue) HTMLURL() string     { return "https://gogs.example.com/alice/example/pulls/2" }
+
+func TestIssueMail(t *testing.T) {
+	issue := mockIssue{
+		content: "Looks good to me, but see `README.md`:\n\n- <script>alert(1)</script> & more\n- https://gogs.example.com/alice/example/issues/1",
+	}
+	doer := mockUser{id: 1, name: "alice"}
+	reviewer := mockUser{id: 2, name: "bob"}
+
+	tests := []struct {
+		name string
+		tpl  string
+		data map[string]interface{}
+	}{
+		{
+			name: "comment",
+			tpl:  MAIL_ISSUE_COMMENT,
+			data: issueMailData(issue, mockRepo{}, doer, false),
+		},
+		{
+			name: "comment_links_only",
+			tpl:  MAIL_ISSUE_COMMENT,
+			data: issueMailData(issue, mockRepo{}, doer, true),
+		},
+		{
+			name: "mention",
+			tpl:  MAIL_ISSUE_MENTION,
+			data: issueMailData(issue, mockRepo{}, doer, false),
+		},
+		{
+			name: "mention_links_only",
+			tpl:  MAIL_ISSUE_MENTION,
+			data: issueMailData(issue, mockRepo{}, doer, true),
+		},
+		{
+			name: "review_reminder",
+			tpl:  MAIL_ISSUE_REVIEW_REMINDER,
+			data: reviewReminderMailData(issue, reviewer, 24, false),
+		},
+		{
+			name: "review_reminder_escalated",
+			tpl:  MAIL_ISSUE_REVIEW_REMINDER,
+			data: reviewReminderMailData(issue, reviewer, 48, true),
+		},
+		{
+			name: "merge",
+			tpl:  MAIL_ISSUE_MERGE,
+			data: mergeMailData(issue, doer, "master"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plainBody, htmlBody, err := renderMultipart(test.tpl, test.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			update := testutil.Update("TestIssueMail")
+			golden := filepath.Join("testdata", fmt.Sprintf("TestIssueMail.%s.golden", test.name))
+			testutil.AssertGolden(t, golden+".txt", update, plainBody)
+			testutil.AssertGolden(t, golden+".html", update, htmlBody)
+		})
+	}
+}
+
+func TestMessage_setThreadHeaders(t *testing.T) {
+	before := conf.Server.Domain
+	conf.Server.Domain = "gogs.example.com"
+	defer func() {
+		conf.Server.Domain = before
+	}()
+
+	tests := []struct {
+		name       string
+		event      string
+		expHeaders map[string][]string
+	}{
+		{
+			name:  "issue creation",
+			event: "",
+			expHeaders: map[string][]string{
+				"Message-ID":  {"<issues/1@gogs.example.com>"},
+				"In-Reply-To": nil,
+				"References":  nil,
+			},
+		},
+		{
+			name:  "comment",
+			event: "comments/3",
+			expHeaders: map[string][]string{
+				"Message-ID":  {"<issues/1/comments/3@gogs.example.com>"},
+				"In-Reply-To": {"<issues/1@gogs.example.com>"},
+				"References":  {"<issues/1@gogs.example.com>"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := NewMultipartMessageFrom([]string{"bob@example.com"}, "alice@example.com", "subject", "plain", "<p>html</p>")
+			msg.setThreadHeaders(1, test.event)
+			for field, exp := range test.expHeaders {
+				assert.Equal(t, exp, msg.GetHeader(field), field)
+			}
+		})
+	}
+}
@@ -27,15 +27,20 @@ type Message struct {
 	confirmChan chan struct{}
 }
 
-// NewMessageFrom creates new mail message object with custom From header.
-func NewMessageFrom(to []string, from, subject, htmlBody string) *Message {
-	log.Trace("NewMessageFrom (htmlBody):\n%s", htmlBody)
-
+func newGomailMessage(to []string, from, subject string) *gomail.Message {
 	msg := gomail.NewMessage()
 	msg.SetHeader("From", from)
 	msg.SetHeader("To", to...)
 	msg.SetHeader("Subject", conf.Email.SubjectPrefix+subject)
 	msg.SetDateHeader("Date", time.Now())
+	return msg
+}
+
+// NewMessageFrom creates new mail message object with custom From header.
+func NewMessageFrom(to []string, from, subject, htmlBody string) *Message {
+	log.Trace("NewMessageFrom (htmlBody):\n%s", htmlBody)
+
+	msg := newGomailMessage(to, from, subject)
 
 	contentType := "text/html"
 	body := htmlBody
@@ -63,6 +68,47 @@ func NewMessageFrom(to []string, from, subject, htmlBody string) *Message {
 	}
 }
 
+// NewMultipartMessageFrom creates new mail message object with custom From
+// header, which has both a plain text body and an HTML alternative that mail
+// clients prefer to show. Only the plain text body is sent when
+// "[email] USE_PLAIN_TEXT" is enabled.
+func NewMultipartMessageFrom(to []string, from, subject, plainBody, htmlBody string) *Message {
+	log.Trace("NewMultipartMessageFrom (plainBody):\n%s", plainBody)
+
+	msg := newGomailMessage(to, from, subject)
+	msg.SetBody("text/plain", plainBody)
+	if !conf.Email.UsePlainText {
+		msg.AddAlternative("text/html", htmlBody)
+	}
+	return &Message{
+		Message:     msg,
+		confirmChan: make(chan struct{}),
+	}
+}
+
+// issueMessageID returns the message ID of the mail of the issue creation, or
+// of the mail of an event of the issue, e.g. "comments/1".
+func issueMessageID(issueID int64, event string) string {
+	id := fmt.Sprintf("issues/%d", issueID)
+	if event != "" {
+		id += "/" + event
+	}
+	return fmt.Sprintf("<%s@%s>", id, conf.Server.Domain)
+}
+
+// setThreadHeaders sets headers for mail clients to group mails of the issue
+// as a conversation. The mail of the issue creation, whose event is empty,
+// starts the thread and other mails reply to it. IDs of issues are used rather
+// than names, which change when repositories are renamed or transferred.
+func (msg *Message) setThreadHeaders(issueID int64, event string) {
+	msg.SetHeader("Message-ID", issueMessageID(issueID, event))
+	if event != "" {
+		root := issueMessageID(issueID, "")
+		msg.SetHeader("In-Reply-To", root)
+		msg.SetHeader("References", root)
+	}
+}
+
 // NewMessage creates new mail message object with default From header.
 func NewMessage(to []string, subject, body string) *Message {
 	return NewMessageFrom(to, conf.Email.From, subject, body)
@@ -0,0 +1,102 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+// MaxEntries is the maximum number of entries in a feed.
+const MaxEntries = 30
+
+// Person is the author of an entry.
+type Person struct {
+	Name  string `xml:"name"`
+	Email string `xml:"email,omitempty"`
+	URI   string `xml:"uri,omitempty"`
+}
+
+// Link is a reference from an entry or a feed to a web resource.
+type Link struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+// Text is a human-readable text, whose type is either "text" or "html".
+type Text struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+// Entry is an individual entry of a feed.
+type Entry struct {
+	ID      string  `xml:"id"`
+	Title   string  `xml:"title"`
+	Updated string  `xml:"updated"`
+	Author  *Person `xml:"author,omitempty"`
+	Link    *Link   `xml:"link,omitempty"`
+	Content *Text   `xml:"content,omitempty"`
+}
+
+// Feed is an Atom feed as defined by RFC 4287.
+type Feed struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string   `xml:"id"`
+	Title   string   `xml:"title"`
+	Updated string   `xml:"updated"`
+	Links   []Link   `xml:"link"`
+	Entries []*Entry `xml:"entry"`
+}
+
+// Time formats the time as required by Atom feeds.
+func Time(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// New returns a new feed with the link to the web page it follows, and the
+// link to the feed itself.
+func New(id, title, link, self string) *Feed {
+	return &Feed{
+		ID:    id,
+		Title: title,
+		Links: []Link{
+			{Href: link, Rel: "alternate", Type: "text/html"},
+			{Href: self, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+}
+
+// Add appends an entry to the feed unless it already has MaxEntries entries,
+// and returns false if the entry is not added. The feed is updated when its
+// latest entry is.
+func (f *Feed) Add(e *Entry) bool {
+	if len(f.Entries) >= MaxEntries {
+		return false
+	}
+	f.Entries = append(f.Entries, e)
+	if e.Updated > f.Updated {
+		f.Updated = e.Updated
+	}
+	return true
+}
+
+// Bytes returns the XML document of the feed.
+func (f *Feed) Bytes() ([]byte, error) {
+	if f.Updated == "" {
+		f.Updated = Time(time.Unix(0, 0))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeed_Add(t *testing.T) {
+	f := New("https://example.com/a/b/releases.atom", "Releases", "https://example.com/a/b/releases", "https://example.com/a/b/releases.atom")
+	for i := 0; i < MaxEntries+5; i++ {
+		added := f.Add(&Entry{
+			ID:      fmt.Sprintf("entry-%d", i),
+			Updated: Time(time.Unix(int64(1000-i), 0)),
+		})
+		assert.Equal(t, i < MaxEntries, added)
+	}
+	assert.Len(t, f.Entries, MaxEntries)
+	assert.Equal(t, Time(time.Unix(1000, 0)), f.Updated)
+}
+
+func TestFeed_Bytes(t *testing.T) {
+	f := New("urn:feed", "Issues", "https://example.com/a/b/issues", "https://example.com/a/b/issues.atom")
+	f.Add(&Entry{
+		ID:      "urn:entry",
+		Title:   `<script>"title"`,
+		Updated: "2020-01-02T03:04:05Z",
+		Author:  &Person{Name: "alice"},
+		Content: &Text{Type: "html", Body: "<p>Hello & bye</p>"},
+	})
+
+	data, err := f.Bytes()
+	assert.Nil(t, err)
+	doc := string(data)
+	assert.True(t, strings.HasPrefix(doc, xml.Header))
+	assert.Contains(t, doc, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	assert.Contains(t, doc, `<updated>2020-01-02T03:04:05Z</updated>`)
+	assert.Contains(t, doc, `&lt;script&gt;&#34;title&#34;`)
+	assert.Contains(t, doc, `<content type="html">&lt;p&gt;Hello &amp; bye&lt;/p&gt;</content>`)
+
+	var parsed Feed
+	assert.Nil(t, xml.Unmarshal(data, &parsed))
+	assert.Equal(t, "Issues", parsed.Title)
+	assert.Len(t, parsed.Entries, 1)
+	assert.Equal(t, "<p>Hello & bye</p>", parsed.Entries[0].Content.Body)
+}
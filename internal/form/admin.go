@@ -41,3 +41,37 @@ type AdminEditUser struct {
 func (f *AdminEditUser) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
 	return validate(errs, ctx.Data, f, ctx.Locale)
 }
+
+type AdminLifecyclePolicies struct {
+	UserEnabled        bool
+	UserInactiveMonths int
+	UserGraceDays      int
+	UserExemptions     string
+	RepoEnabled        bool
+	RepoInactiveMonths int
+	RepoExemptions     string
+}
+
+func (f *AdminLifecyclePolicies) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type AdminSiteContent struct {
+	HomeNotice  string   `binding:"MaxSize(4096)"`
+	HeadSnippet string   `binding:"MaxSize(16384)"`
+	LinkLabels  []string `form:"link_label"`
+	LinkURLs    []string `form:"link_url"`
+}
+
+func (f *AdminSiteContent) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type AdminMaintenance struct {
+	Message     string `binding:"MaxSize(512)"`
+	AllowAdmins bool
+}
+
+func (f *AdminMaintenance) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
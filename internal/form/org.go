@@ -30,6 +30,16 @@ func (f *UpdateOrgSetting) Validate(ctx *macaron.Context, errs binding.Errors) b
 	return validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+type OrgRepoPolicy struct {
+	ForcePrivate           bool
+	ForbidVisibilityChange bool
+	MaxCreationLimit       int
+}
+
+func (f *OrgRepoPolicy) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
 type CreateTeam struct {
 	TeamName    string `binding:"Required;AlphaDashDot;MaxSize(30)"`
 	Description string `binding:"MaxSize(255)"`
@@ -39,3 +49,37 @@ type CreateTeam struct {
 func (f *CreateTeam) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
 	return validate(errs, ctx.Data, f, ctx.Locale)
 }
+
+type NewBot struct {
+	BotName  string `binding:"Required;AlphaDashDot;MaxSize(35)" locale:"org.settings.bots.name"`
+	FullName string `binding:"MaxSize(100)"`
+	Email    string `binding:"OmitEmpty;Email;MaxSize(254)"`
+}
+
+func (f *NewBot) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type BotGrant struct {
+	RepoID         int64 `binding:"Required"`
+	CanReadCode    bool
+	CanSetStatus   bool
+	CanCommentPull bool
+}
+
+func (f *BotGrant) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type OrgRuleSet struct {
+	Name                  string `binding:"Required;MaxSize(255)" locale:"org.settings.rule_sets.name"`
+	RepoPatterns          string `binding:"Required" locale:"org.settings.rule_sets.repo_patterns"`
+	BranchPatterns        string `binding:"Required" locale:"org.settings.rule_sets.branch_patterns"`
+	RequirePullRequest    bool
+	CommitMessagePattern  string
+	ForbiddenFilePatterns string
+}
+
+func (f *OrgRuleSet) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
@@ -5,6 +5,7 @@
 package form
 
 import (
+	"mime/multipart"
 	"net/url"
 	"strings"
 
@@ -87,8 +88,16 @@ type RepoSetting struct {
 	Branch        string
 	Interval      int
 	MirrorAddress string
-	Private       bool
-	EnablePrune   bool
+	// MirrorUsername and MirrorPassword are credentials of the mirror address,
+	// empty ones keep the current ones unless MirrorClearCredentials is set.
+	MirrorUsername         string
+	MirrorPassword         string
+	MirrorClearCredentials bool
+	Private                bool
+	EnablePrune            bool
+	// VisibilityAt is the local time to change visibility of the repository,
+	// in the format of "2006-01-02T15:04".
+	VisibilityAt string
 
 	// Advanced settings
 	EnableWiki            bool
@@ -97,19 +106,34 @@ type RepoSetting struct {
 	ExternalWikiURL       string
 	EnableIssues          bool
 	AllowPublicIssues     bool
+	CustomCloseReasons    string
 	EnableExternalTracker bool
 	ExternalTrackerURL    string
 	TrackerURLFormat      string
 	TrackerIssueStyle     string
+	TrackerIssueRegexp    string
 	EnablePulls           bool
 	PullsIgnoreWhitespace bool
 	PullsAllowRebase      bool
+	MergeMessageTemplate  string
+	MergeMessageTrailers  bool
+	AllowPublicReleases   bool
 }
 
 func (f *RepoSetting) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
 	return validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+type ImportRepoSettings struct {
+	Action  string
+	File    *multipart.FileHeader
+	Content string
+}
+
+func (f *ImportRepoSettings) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
 // __________                             .__
 // \______   \____________    ____   ____ |  |__
 //  |    |  _/\_  __ \__  \  /    \_/ ___\|  |  \
@@ -118,17 +142,30 @@ func (f *RepoSetting) Validate(ctx *macaron.Context, errs binding.Errors) bindin
 //         \/             \/     \/     \/     \/
 
 type ProtectBranch struct {
-	Protected          bool
-	RequirePullRequest bool
-	EnableWhitelist    bool
-	WhitelistUsers     string
-	WhitelistTeams     string
+	Protected                 bool
+	RequirePullRequest        bool
+	RequireLinearHistory      bool
+	RequireCodeOwnersApproval bool
+	EnableWhitelist           bool
+	WhitelistUsers            string
+	WhitelistTeams            string
 }
 
 func (f *ProtectBranch) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
 	return validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+type CommitLintRules struct {
+	MaxSubjectLength  int
+	SubjectPattern    string `binding:"MaxSize(255)"`
+	MaxBodyLineLength int
+	EnforceOnPush     bool
+}
+
+func (f *CommitLintRules) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
 //  __      __      ___.   .__    .__            __
 // /  \    /  \ ____\_ |__ |  |__ |  |__   ____ |  | __
 // \   \/\/   // __ \| __ \|  |  \|  |  \ /  _ \|  |/ /
@@ -146,6 +183,7 @@ type Webhook struct {
 	IssueComment bool
 	PullRequest  bool
 	Release      bool
+	Repository   bool
 	Active       bool
 }
 
@@ -220,6 +258,12 @@ type NewIssue struct {
 	AssigneeID  int64
 	Content     string
 	Files       []string
+	// NotDuplicate is checked by the poster to confirm the new issue is not a
+	// duplicate of likely ones.
+	NotDuplicate bool
+	// Template is the file name of the issue form that the issue is created
+	// with, values of its fields are submitted as "form_<field ID>".
+	Template string
 }
 
 func (f *NewIssue) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
@@ -227,9 +271,10 @@ func (f *NewIssue) Validate(ctx *macaron.Context, errs binding.Errors) binding.E
 }
 
 type CreateComment struct {
-	Content string
-	Status  string `binding:"OmitEmpty;In(reopen,close)"`
-	Files   []string
+	Content     string
+	Status      string `binding:"OmitEmpty;In(reopen,close)"`
+	CloseReason string
+	Files       []string
 }
 
 func (f *CreateComment) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
@@ -293,6 +338,8 @@ type NewRelease struct {
 	Draft      string
 	Prerelease bool
 	Files      []string
+	LinkLabels []string `form:"link_label"`
+	LinkURLs   []string `form:"link_url"`
 }
 
 func (f *NewRelease) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
@@ -305,6 +352,8 @@ type EditRelease struct {
 	Draft      string
 	Prerelease bool
 	Files      []string
+	LinkLabels []string `form:"link_label"`
+	LinkURLs   []string `form:"link_url"`
 }
 
 func (f *EditRelease) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
@@ -417,3 +466,91 @@ func (f *DeleteRepoFile) Validate(ctx *macaron.Context, errs binding.Errors) bin
 func (f *DeleteRepoFile) IsNewBrnach() bool {
 	return f.CommitChoice == "commit-to-new-branch"
 }
+
+type MuteSchedule struct {
+	Days     []int  `binding:"Required"`
+	Start    string `binding:"Required;Size(5)"`
+	End      string `binding:"Required;Size(5)"`
+	Timezone string `binding:"Required;MaxSize(50)"`
+}
+
+func (f *MuteSchedule) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type PathWatch struct {
+	Pattern           string `binding:"Required;MaxSize(255)"`
+	DefaultBranchOnly bool
+}
+
+func (f *PathWatch) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type ReviewReminder struct {
+	Enabled       bool
+	RemindAfter   int
+	EscalateAfter int
+	WorkingDays   bool
+	PostComment   bool
+}
+
+func (f *ReviewReminder) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type IssueAutoLock struct {
+	Enabled     bool
+	AfterDays   int
+	ExemptLabel string `binding:"MaxSize(50)"`
+	Comment     string
+}
+
+func (f *IssueAutoLock) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type LockIssue struct {
+	Reason string
+}
+
+func (f *LockIssue) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type MarkDuplicate struct {
+	Index                int64 `binding:"Required"`
+	TransferParticipants bool
+}
+
+func (f *MarkDuplicate) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type Secret struct {
+	Name  string `binding:"Required;MaxSize(64)" locale:"repo.settings.secrets_name"`
+	Value string `binding:"Required" locale:"repo.settings.secrets_value"`
+}
+
+func (f *Secret) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type CollaborationPaths struct {
+	UID         int64 `binding:"Required"`
+	Paths       string
+	AllowIssues bool
+}
+
+func (f *CollaborationPaths) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+type OrgRuleSetExemption struct {
+	RuleSetID int64  `binding:"Required"`
+	Reason    string `binding:"Required;MaxSize(1000)"`
+}
+
+func (f *OrgRuleSetExemption) Validate(ctx *macaron.Context, errs binding.Errors) binding.Errors {
+	return validate(errs, ctx.Data, f, ctx.Locale)
+}
@@ -0,0 +1,182 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package graceful implements graceful shutdown and zero-downtime restart of
+// servers by draining in-flight operations and handing listeners over to a new
+// process.
+package graceful
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	log "unknwon.dev/clog/v2"
+)
+
+type manager struct {
+	lock     sync.Mutex
+	draining bool
+	inflight int
+	hooks    []func(ctx context.Context)
+
+	idle     chan struct{} // Closed when draining and there is no in-flight operation.
+	idleOnce sync.Once
+	done     chan struct{} // Closed when shutdown is finished.
+
+	inheritOnce sync.Once
+	inherited   map[string]*os.File
+	listeners   []*listener
+}
+
+func newManager() *manager {
+	return &manager{
+		idle: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+var defaultManager = newManager()
+
+func (m *manager) isDraining() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.draining
+}
+
+func (m *manager) add() func() {
+	m.lock.Lock()
+	m.inflight++
+	m.lock.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+			m.inflight--
+			if m.draining && m.inflight == 0 {
+				m.idleOnce.Do(func() { close(m.idle) })
+			}
+		})
+	}
+}
+
+func (m *manager) onShutdown(fn func(ctx context.Context)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.hooks = append(m.hooks, fn)
+}
+
+func (m *manager) shutdown(timeout time.Duration) bool {
+	m.lock.Lock()
+	if m.draining {
+		m.lock.Unlock()
+		<-m.done
+		return m.inflightCount() == 0
+	}
+	m.draining = true
+	if m.inflight == 0 {
+		m.idleOnce.Do(func() { close(m.idle) })
+	}
+	hooks := make([]func(ctx context.Context), len(m.hooks))
+	copy(hooks, m.hooks)
+	m.lock.Unlock()
+	defer close(m.done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, fn := range hooks {
+		wg.Add(1)
+		go func(fn func(ctx context.Context)) {
+			defer wg.Done()
+			fn(ctx)
+		}(fn)
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		wg.Wait()
+		<-m.idle
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (m *manager) inflightCount() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.inflight
+}
+
+// IsDraining returns true if the process has started to shut down. No new
+// connections are accepted while draining, and the health check should report
+// so that load balancers stop sending traffic.
+func IsDraining() bool {
+	return defaultManager.isDraining()
+}
+
+// Add records an in-flight operation, e.g. a Git operation over SSH or a webhook
+// delivery, that should be finished before the process exits. The returned
+// function must be called once the operation is done.
+func Add() (done func()) {
+	return defaultManager.add()
+}
+
+// OnShutdown registers a function to be called when the process starts to shut
+// down, e.g. to stop a server from accepting new connections. The context is
+// canceled once the drain timeout is reached.
+func OnShutdown(fn func(ctx context.Context)) {
+	defaultManager.onShutdown(fn)
+}
+
+// Shutdown starts draining and waits for registered shutdown functions and
+// in-flight operations to finish, up to the given timeout. It returns false if
+// the timeout is reached before all of them have finished. Calling it more than
+// once waits for the first call to complete.
+func Shutdown(timeout time.Duration) bool {
+	return defaultManager.shutdown(timeout)
+}
+
+// Done returns a channel that is closed when the shutdown is finished.
+func Done() <-chan struct{} {
+	return defaultManager.done
+}
+
+// HandleSignals blocks until a termination signal is received, then shuts down
+// the process with the given drain timeout. On platforms that support it,
+// SIGUSR2 starts a new process that inherits all listeners before the shutdown,
+// so that no connection is dropped during upgrades.
+func HandleSignals(timeout time.Duration) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, signals...)
+	defer signal.Stop(c)
+
+	for sig := range c {
+		if isRestartSignal(sig) {
+			pid, err := Restart()
+			if err != nil {
+				log.Error("Failed to restart: %v", err)
+				continue
+			}
+			log.Info("Started new process [pid: %d] with inherited listeners", pid)
+		}
+
+		log.Info("Received %v, shutting down with drain timeout %s", sig, timeout)
+		if !Shutdown(timeout) {
+			log.Warn("Drain timeout reached with %d in-flight operation(s)", defaultManager.inflightCount())
+		}
+		return
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package graceful
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleSignals(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Sending signals to the current process is not supported on Windows")
+	}
+
+	defaultManager = newManager()
+	defer func() {
+		defaultManager = newManager()
+	}()
+
+	const chunks = 10
+	started := make(chan struct{})
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for i := 0; i < chunks; i++ {
+				_, _ = w.Write([]byte("chunk\n"))
+				w.(http.Flusher).Flush()
+				if i == 0 {
+					close(started)
+				}
+				time.Sleep(50 * time.Millisecond)
+			}
+		}),
+	}
+	OnShutdown(func(ctx context.Context) {
+		_ = server.Shutdown(ctx)
+	})
+
+	listener, err := Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	signaled := make(chan struct{})
+	go func() {
+		HandleSignals(10 * time.Second)
+		close(signaled)
+	}()
+
+	type result struct {
+		body string
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		results <- result{body: string(body), err: err}
+	}()
+
+	// Signal the process in the middle of the download.
+	<-started
+	// Give the signal handler some time to be registered.
+	time.Sleep(50 * time.Millisecond)
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Signal(syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-signaled:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Shutdown is not finished in time")
+	}
+	assert.True(t, IsDraining())
+
+	// The download should have been completed before shutdown is finished.
+	select {
+	case r := <-results:
+		assert.Nil(t, r.err)
+		assert.Equal(t, strings.Repeat("chunk\n", chunks), r.body)
+	default:
+		t.Fatal("Download is not completed")
+	}
+
+	// New connections should be refused.
+	_, err = http.Get("http://" + listener.Addr().String())
+	assert.NotNil(t, err)
+
+	select {
+	case <-Done():
+	default:
+		t.Fatal("Done channel is not closed")
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	t.Run("wait for in-flight operations", func(t *testing.T) {
+		m := newManager()
+		done := m.add()
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			done()
+		}()
+
+		assert.True(t, m.shutdown(10*time.Second))
+		assert.Equal(t, 0, m.inflightCount())
+	})
+
+	t.Run("drain timeout reached", func(t *testing.T) {
+		m := newManager()
+		done := m.add()
+		defer done()
+
+		assert.False(t, m.shutdown(50*time.Millisecond))
+		assert.Equal(t, 1, m.inflightCount())
+	})
+
+	t.Run("no in-flight operation", func(t *testing.T) {
+		m := newManager()
+		called := false
+		m.onShutdown(func(context.Context) {
+			called = true
+		})
+
+		assert.True(t, m.shutdown(time.Second))
+		assert.True(t, called)
+		assert.True(t, m.isDraining())
+	})
+}
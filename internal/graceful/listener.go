@@ -0,0 +1,169 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package graceful
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// envListeners is the environment variable to pass inherited listeners to the
+// new process. The value is a comma-separated list of "<network>:<address>" in
+// the same order as file descriptors starting from 3.
+const envListeners = "GOGS_INHERITED_LISTENERS"
+
+type listener struct {
+	net.Listener
+	key string
+}
+
+// filer is implemented by listeners that can be handed over to a new process.
+type filer interface {
+	File() (*os.File, error)
+}
+
+func listenerKey(network, addr string) string {
+	return network + ":" + addr
+}
+
+func (m *manager) loadInherited() {
+	m.inheritOnce.Do(func() {
+		m.inherited = make(map[string]*os.File)
+		value := os.Getenv(envListeners)
+		if value == "" {
+			return
+		}
+
+		// Inherited listeners should not be passed down to any subprocess.
+		_ = os.Unsetenv(envListeners)
+		for i, key := range strings.Split(value, ",") {
+			m.inherited[key] = os.NewFile(uintptr(3+i), key)
+		}
+	})
+}
+
+func (m *manager) isInherited(network, addr string) bool {
+	m.loadInherited()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.inherited[listenerKey(network, addr)] != nil
+}
+
+func (m *manager) listen(network, addr string) (net.Listener, error) {
+	m.loadInherited()
+
+	key := listenerKey(network, addr)
+	m.lock.Lock()
+	f := m.inherited[key]
+	delete(m.inherited, key)
+	m.lock.Unlock()
+
+	var (
+		l   net.Listener
+		err error
+	)
+	if f != nil {
+		l, err = net.FileListener(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("inherit listener %q: %v", key, err)
+		}
+	} else {
+		l, err = net.Listen(network, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	gl := &listener{Listener: l, key: key}
+	m.listeners = append(m.listeners, gl)
+	return gl, nil
+}
+
+// Listen announces on the local network address like net.Listen. It reuses the
+// listener inherited from the parent process when there is one for the same
+// network and address, and makes the listener available for handing over to a
+// new process on restart.
+func Listen(network, addr string) (net.Listener, error) {
+	return defaultManager.listen(network, addr)
+}
+
+// IsInherited returns true if there is a listener inherited from the parent
+// process for given network and address that has not yet been used.
+func IsInherited(network, addr string) bool {
+	return defaultManager.isInherited(network, addr)
+}
+
+func (m *manager) restart() (int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	keys := make([]string, 0, len(m.listeners))
+	files := make([]*os.File, 0, len(m.listeners))
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+	for _, l := range m.listeners {
+		fl, ok := l.Listener.(filer)
+		if !ok {
+			return 0, fmt.Errorf("listener %q cannot be handed over", l.key)
+		}
+		f, err := fl.File()
+		if err != nil {
+			return 0, fmt.Errorf("get file of listener %q: %v", l.key, err)
+		}
+		keys = append(keys, l.key)
+		files = append(files, f)
+	}
+
+	// The socket file is now owned by the new process.
+	for _, l := range m.listeners {
+		if ul, ok := l.Listener.(*net.UnixListener); ok {
+			ul.SetUnlinkOnClose(false)
+		}
+	}
+
+	path, err := os.Executable()
+	if err != nil {
+		return 0, fmt.Errorf("get executable: %v", err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return 0, fmt.Errorf("get working directory: %v", err)
+	}
+
+	env := make([]string, 0, len(os.Environ())+1)
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, envListeners+"=") {
+			env = append(env, kv)
+		}
+	}
+	env = append(env, envListeners+"="+strings.Join(keys, ","))
+
+	p, err := os.StartProcess(path, os.Args, &os.ProcAttr{
+		Dir:   wd,
+		Env:   env,
+		Files: append([]*os.File{os.Stdin, os.Stdout, os.Stderr}, files...),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("start process: %v", err)
+	}
+	return p.Pid, nil
+}
+
+// Restart starts a new process with the same executable, arguments and
+// environment, which inherits all listeners created by Listen. It returns the
+// PID of the new process. The caller is responsible for shutting down the
+// current process afterwards.
+func Restart() (pid int, err error) {
+	return defaultManager.restart()
+}
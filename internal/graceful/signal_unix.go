@@ -0,0 +1,18 @@
+// +build !windows
+
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package graceful
+
+import (
+	"os"
+	"syscall"
+)
+
+var signals = []os.Signal{syscall.SIGINT, syscall.SIGTERM, syscall.SIGUSR2}
+
+func isRestartSignal(sig os.Signal) bool {
+	return sig == syscall.SIGUSR2
+}
@@ -0,0 +1,17 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package graceful
+
+import (
+	"os"
+	"syscall"
+)
+
+var signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// Handing over listeners to a new process is not supported on Windows.
+func isRestartSignal(os.Signal) bool {
+	return false
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package imageutil
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+
+	"github.com/nfnt/resize"
+)
+
+type ErrTooManyPixels struct {
+	Width  int
+	Height int
+}
+
+func IsErrTooManyPixels(err error) bool {
+	_, ok := err.(ErrTooManyPixels)
+	return ok
+}
+
+func (err ErrTooManyPixels) Error() string {
+	return fmt.Sprintf("image has too many pixels [width: %d, height: %d]", err.Width, err.Height)
+}
+
+// DecodeConfig returns the dimensions and format of the image without decoding
+// the entire image. It returns ErrTooManyPixels when the number of pixels
+// exceeds maxPixels, which protects against decompression bombs. A maxPixels
+// of zero means no limit.
+func DecodeConfig(data []byte, maxPixels int64) (image.Config, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return cfg, format, err
+	}
+	if maxPixels > 0 && int64(cfg.Width)*int64(cfg.Height) > maxPixels {
+		return cfg, format, ErrTooManyPixels{Width: cfg.Width, Height: cfg.Height}
+	}
+	return cfg, format, nil
+}
+
+// Orient transforms the image to be displayed upright according to the given
+// EXIF orientation.
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	// Orientations 5 to 8 swap width and height.
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // Flip horizontal
+				dx, dy = w-1-x, y
+			case 3: // Rotate 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // Flip vertical
+				dx, dy = x, h-1-y
+			case 5: // Transpose
+				dx, dy = y, x
+			case 6: // Rotate 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // Transverse
+				dx, dy = h-1-y, w-1-x
+			case 8: // Rotate 90 counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+	return dst
+}
+
+// Thumbnail downscales the image to fit in maxWidth and maxHeight while
+// preserving the aspect ratio. The image is returned as-is if it already fits.
+func Thumbnail(maxWidth, maxHeight uint, img image.Image) image.Image {
+	return resize.Thumbnail(maxWidth, maxHeight, img, resize.Bilinear)
+}
+
+// ResizeGIF resizes every frame of the animated GIF to the given width and
+// height. Frames are composited onto a full canvas before resizing because
+// frames of a GIF may only cover part of the canvas.
+func ResizeGIF(g *gif.GIF, width, height uint) *gif.GIF {
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	canvas := image.NewRGBA(bounds)
+	out := &gif.GIF{
+		Image:     make([]*image.Paletted, 0, len(g.Image)),
+		Delay:     g.Delay,
+		LoopCount: g.LoopCount,
+		Config: image.Config{
+			Width:  int(width),
+			Height: int(height),
+		},
+	}
+
+	for i, frame := range g.Image {
+		var previous *image.RGBA
+		disposal := byte(gif.DisposalNone)
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
+		}
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(bounds)
+			draw.Draw(previous, bounds, canvas, image.ZP, draw.Src)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		resized := resize.Resize(width, height, canvas, resize.Bilinear)
+		paletted := image.NewPaletted(image.Rect(0, 0, int(width), int(height)), frame.Palette)
+		draw.FloydSteinberg.Draw(paletted, paletted.Bounds(), resized, image.ZP)
+		out.Image = append(out.Image, paletted)
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.ZP, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	// Every frame covers the whole canvas now, so each one should be drawn on a
+	// cleared canvas.
+	out.Disposal = make([]byte, len(out.Image))
+	for i := range out.Disposal {
+		out.Disposal[i] = gif.DisposalBackground
+	}
+	return out
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package imageutil
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exifSegment returns an APP1 segment with the given orientation in
+// big-endian TIFF structure.
+func exifSegment(orientation byte) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08, // Header
+		0x00, 0x01, // One entry
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, orientation, 0x00, 0x00, // Orientation
+		0x00, 0x00, 0x00, 0x00, // No next IFD
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	length := len(payload) + 2
+	return append([]byte{0xFF, 0xE1, byte(length >> 8), byte(length)}, payload...)
+}
+
+func testJPEG(t *testing.T, width, height int, orientation byte) []byte {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), exifSegment(orientation)...), data[2:]...)
+}
+
+func TestDecodeConfig(t *testing.T) {
+	data := testJPEG(t, 40, 30, 1)
+
+	cfg, format, err := DecodeConfig(data, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, 40, cfg.Width)
+
+	_, _, err = DecodeConfig(data, 1200)
+	assert.Nil(t, err)
+
+	_, _, err = DecodeConfig(data, 1199)
+	assert.Equal(t, ErrTooManyPixels{Width: 40, Height: 30}, err)
+}
+
+func TestOrientation(t *testing.T) {
+	assert.Equal(t, 6, Orientation(testJPEG(t, 4, 4, 6)))
+	assert.Equal(t, 1, Orientation(testJPEG(t, 4, 4, 0)))
+	assert.Equal(t, 1, Orientation([]byte("not an image")))
+}
+
+func TestOrient(t *testing.T) {
+	// A 2x1 image with a white pixel on the left.
+	img := image.NewGray(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.White)
+
+	tests := []struct {
+		orientation int
+		expBounds   image.Rectangle
+		expWhite    image.Point
+	}{
+		{orientation: 1, expBounds: image.Rect(0, 0, 2, 1), expWhite: image.Pt(0, 0)},
+		{orientation: 2, expBounds: image.Rect(0, 0, 2, 1), expWhite: image.Pt(1, 0)},
+		{orientation: 3, expBounds: image.Rect(0, 0, 2, 1), expWhite: image.Pt(1, 0)},
+		{orientation: 6, expBounds: image.Rect(0, 0, 1, 2), expWhite: image.Pt(0, 0)},
+		{orientation: 8, expBounds: image.Rect(0, 0, 1, 2), expWhite: image.Pt(0, 1)},
+	}
+	for _, test := range tests {
+		got := Orient(img, test.orientation)
+		assert.Equal(t, test.expBounds, got.Bounds())
+		r, _, _, _ := got.At(test.expWhite.X, test.expWhite.Y).RGBA()
+		assert.Equal(t, uint32(0xffff), r, "orientation %d", test.orientation)
+	}
+}
+
+func TestStripMetadata(t *testing.T) {
+	t.Run("JPEG", func(t *testing.T) {
+		data := testJPEG(t, 4, 4, 6)
+		stripped, err := StripMetadata(data)
+		assert.Nil(t, err)
+		assert.Equal(t, len(data)-len(exifSegment(6)), len(stripped))
+		assert.Equal(t, 1, Orientation(stripped))
+
+		_, err = jpeg.Decode(bytes.NewReader(stripped))
+		assert.Nil(t, err)
+	})
+
+	t.Run("PNG", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 4, 4))); err != nil {
+			t.Fatal(err)
+		}
+		data := buf.Bytes()
+		// Insert a tEXt chunk right after the IHDR chunk, the CRC is not checked
+		// since it is going to be stripped anyway.
+		ihdrEnd := len(pngHeader) + 12 + 13
+		chunk := append([]byte{0x00, 0x00, 0x00, 0x03, 't', 'E', 'X', 't', 'a', 0x00, 'b'}, 0, 0, 0, 0)
+		withText := append(append(append([]byte{}, data[:ihdrEnd]...), chunk...), data[ihdrEnd:]...)
+
+		stripped, err := StripMetadata(withText)
+		assert.Nil(t, err)
+		assert.Equal(t, data, stripped)
+	})
+
+	t.Run("other formats", func(t *testing.T) {
+		stripped, err := StripMetadata([]byte("GIF89a"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("GIF89a"), stripped)
+	})
+}
+
+func TestResizeGIF(t *testing.T) {
+	g := &gif.GIF{
+		Image: []*image.Paletted{
+			image.NewPaletted(image.Rect(0, 0, 10, 10), palette.Plan9),
+			image.NewPaletted(image.Rect(2, 2, 6, 6), palette.Plan9),
+		},
+		Delay:    []int{10, 20},
+		Disposal: []byte{gif.DisposalNone, gif.DisposalBackground},
+		Config:   image.Config{Width: 10, Height: 10},
+	}
+
+	got := ResizeGIF(g, 4, 4)
+	assert.Len(t, got.Image, 2)
+	assert.Equal(t, []int{10, 20}, got.Delay)
+	for _, frame := range got.Image {
+		assert.Equal(t, image.Rect(0, 0, 4, 4), frame.Bounds())
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, gif.EncodeAll(&buf, got))
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package imageutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+var errMalformedJPEG = errors.New("malformed JPEG")
+
+// jpegSegments calls fn with the marker and the whole bytes of each segment
+// before the start of scan (SOS). It returns the offset of the SOS segment.
+func jpegSegments(data []byte, fn func(marker byte, segment []byte)) (int, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 0, errMalformedJPEG
+	}
+
+	i := 2
+	for {
+		if i+4 > len(data) || data[i] != 0xFF {
+			return 0, errMalformedJPEG
+		}
+		marker := data[i+1]
+		// Fill bytes may precede a marker.
+		if marker == 0xFF {
+			i++
+			continue
+		}
+		if marker == 0xDA {
+			return i, nil
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 0, errMalformedJPEG
+		}
+		fn(marker, data[i:i+2+length])
+		i += 2 + length
+	}
+}
+
+// Orientation returns the EXIF orientation (1 to 8) of the JPEG image. It
+// returns 1 when the image is not a JPEG or has no orientation tag.
+func Orientation(data []byte) int {
+	orientation := 1
+	_, _ = jpegSegments(data, func(marker byte, segment []byte) {
+		// APP1 with the Exif header
+		if marker != 0xE1 || len(segment) < 10 || !bytes.Equal(segment[4:10], []byte("Exif\x00\x00")) {
+			return
+		}
+		if o := exifOrientation(segment[10:]); o >= 1 && o <= 8 {
+			orientation = o
+		}
+	})
+	return orientation
+}
+
+// exifOrientation reads the orientation tag from the first IFD of the TIFF
+// structure.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		// Tag 0x0112 is orientation and its type must be SHORT (3).
+		if order.Uint16(tiff[entry:]) == 0x0112 && order.Uint16(tiff[entry+2:]) == 3 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 0
+}
+
+// StripMetadata removes EXIF, XMP and IPTC metadata from JPEG images, and
+// EXIF and textual chunks from PNG images, without re-encoding. Data of other
+// formats is returned as-is.
+func StripMetadata(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\xFF\xD8")):
+		return stripJPEG(data)
+	case bytes.HasPrefix(data, []byte(pngHeader)):
+		return stripPNG(data)
+	}
+	return data, nil
+}
+
+func stripJPEG(data []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
+	buf.Write(data[:2])
+	sos, err := jpegSegments(data, func(marker byte, segment []byte) {
+		switch marker {
+		case 0xE1, // APP1: EXIF and XMP
+			0xED, // APP13: IPTC
+			0xFE: // COM
+			return
+		}
+		buf.Write(segment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(data[sos:])
+	return buf.Bytes(), nil
+}
+
+const pngHeader = "\x89PNG\r\n\x1a\n"
+
+func stripPNG(data []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
+	buf.WriteString(pngHeader)
+	for i := len(pngHeader); i < len(data); {
+		if i+8 > len(data) {
+			return nil, errors.New("malformed PNG")
+		}
+		// Length, type, data and CRC
+		end := i + 12 + int(binary.BigEndian.Uint32(data[i:]))
+		if end > len(data) || end < i {
+			return nil, errors.New("malformed PNG")
+		}
+		switch string(data[i+4 : i+8]) {
+		case "eXIf", "tEXt", "iTXt", "zTXt":
+		default:
+			buf.Write(data[i:end])
+		}
+		i = end
+	}
+	return buf.Bytes(), nil
+}
@@ -87,6 +87,10 @@ func (r *Regexp) FindAllIndex(b []byte, n int) [][]int {
 	return r.Regexp().FindAllIndex(b, n)
 }
 
+func (r *Regexp) FindAllStringIndex(s string, n int) [][]int {
+	return r.Regexp().FindAllStringIndex(s, n)
+}
+
 func (r *Regexp) Match(b []byte) bool {
 	return r.Regexp().Match(b)
 }
@@ -0,0 +1,156 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package maintenance manages the read-only maintenance mode of the instance.
+// The state is kept in a file under the application data path, so it survives
+// restarts and is shared by the web server, Git hooks and command line.
+package maintenance
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+// DefaultMessage is the message shown to users when no message is specified.
+const DefaultMessage = "The site is in read-only maintenance mode, please try again later."
+
+// State is the state of maintenance mode.
+type State struct {
+	Enabled bool `json:"enabled"`
+	// Message is shown to users whose changes are rejected.
+	Message string `json:"message"`
+	// AllowAdmins indicates whether site admins are allowed to make changes.
+	AllowAdmins bool      `json:"allow_admins"`
+	By          string    `json:"by"`
+	Since       time.Time `json:"since"`
+}
+
+// DisplayMessage returns the message to be shown to users.
+func (s *State) DisplayMessage() string {
+	if s.Message == "" {
+		return DefaultMessage
+	}
+	return s.Message
+}
+
+// Bypass returns true if a user, who is a site admin or not, is allowed to make
+// changes.
+func (s *State) Bypass(isAdmin bool) bool {
+	return !s.Enabled || (s.AllowAdmins && isAdmin)
+}
+
+// Path returns the path of the file that keeps the state.
+func Path() string {
+	return filepath.Join(conf.Server.AppDataPath, "maintenance.json")
+}
+
+var cache struct {
+	sync.Mutex
+	modTime time.Time
+	size    int64
+	state   *State
+}
+
+// load reads the state from the file. It returns a disabled state when the
+// file does not exist.
+func load(fpath string) (*State, error) {
+	data, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &State{}, nil
+		}
+		return nil, err
+	}
+
+	state := new(State)
+	if err = jsoniter.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return state, nil
+}
+
+// Current returns the current state. The file is only read again when it is
+// modified. Writes are blocked with the default message if the file exists but
+// cannot be read, because it is safer than letting them through.
+func Current() *State {
+	fpath := Path()
+	fi, err := os.Stat(fpath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Error("Failed to stat maintenance file %q: %v", fpath, err)
+			return &State{Enabled: true}
+		}
+		return &State{}
+	}
+
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.state != nil && cache.modTime.Equal(fi.ModTime()) && cache.size == fi.Size() {
+		return cache.state
+	}
+
+	state, err := load(fpath)
+	if err != nil {
+		log.Error("Failed to load maintenance file %q: %v", fpath, err)
+		return &State{Enabled: true}
+	}
+	cache.modTime = fi.ModTime()
+	cache.size = fi.Size()
+	cache.state = state
+	return state
+}
+
+// Enable turns on maintenance mode, or updates the message and whether admins
+// are allowed to make changes if it is already on.
+func Enable(by, message string, allowAdmins bool) error {
+	state := &State{
+		Enabled:     true,
+		Message:     message,
+		AllowAdmins: allowAdmins,
+		By:          by,
+		Since:       time.Now(),
+	}
+	if cur := Current(); cur.Enabled && !cur.Since.IsZero() {
+		state.Since = cur.Since
+	}
+
+	data, err := jsoniter.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+
+	fpath := Path()
+	if err = os.MkdirAll(filepath.Dir(fpath), os.ModePerm); err != nil {
+		return errors.Wrap(err, "create directory")
+	}
+	// Write to a temporary file then rename it, so that other processes never
+	// read a partially written file.
+	tmpPath := fpath + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return errors.Wrap(err, "write file")
+	}
+	if err = os.Rename(tmpPath, fpath); err != nil {
+		return errors.Wrap(err, "rename file")
+	}
+	log.Info("Maintenance mode is enabled by %s", by)
+	return nil
+}
+
+// Disable turns off maintenance mode.
+func Disable(by string) error {
+	if err := os.Remove(Path()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	log.Info("Maintenance mode is disabled by %s", by)
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package maintenance
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gogs.io/gogs/internal/conf"
+)
+
+func TestState_Bypass(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   *State
+		isAdmin bool
+		expVal  bool
+	}{
+		{name: "disabled", state: &State{}, expVal: true},
+		{name: "enabled", state: &State{Enabled: true}, isAdmin: true, expVal: false},
+		{name: "allow admins for non-admin", state: &State{Enabled: true, AllowAdmins: true}, expVal: false},
+		{name: "allow admins for admin", state: &State{Enabled: true, AllowAdmins: true}, isAdmin: true, expVal: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expVal, test.state.Bypass(test.isAdmin))
+		})
+	}
+}
+
+func TestEnableDisable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maintenance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	oldPath := conf.Server.AppDataPath
+	conf.Server.AppDataPath = dir
+	defer func() {
+		conf.Server.AppDataPath = oldPath
+	}()
+
+	assert.False(t, Current().Enabled)
+
+	assert.Nil(t, Enable("alice", "", true))
+	state := Current()
+	assert.True(t, state.Enabled)
+	assert.True(t, state.AllowAdmins)
+	assert.Equal(t, "alice", state.By)
+	assert.Equal(t, DefaultMessage, state.DisplayMessage())
+
+	// Updating the message keeps the time it was turned on.
+	assert.Nil(t, Enable("bob", "Upgrading database", false))
+	updated := Current()
+	assert.Equal(t, "Upgrading database", updated.DisplayMessage())
+	assert.False(t, updated.AllowAdmins)
+	assert.True(t, updated.Since.Equal(state.Since))
+
+	assert.Nil(t, Disable("alice"))
+	assert.False(t, Current().Enabled)
+
+	// An unreadable file blocks changes.
+	assert.Nil(t, ioutil.WriteFile(Path(), []byte("{"), 0644))
+	assert.True(t, Current().Enabled)
+}
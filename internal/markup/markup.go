@@ -8,7 +8,10 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net/url"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/unknwon/com"
 	"golang.org/x/net/html"
@@ -31,6 +34,9 @@ func IsIPythonNotebook(name string) bool {
 const (
 	ISSUE_NAME_STYLE_NUMERIC      = "numeric"
 	ISSUE_NAME_STYLE_ALPHANUMERIC = "alphanumeric"
+	// ISSUE_NAME_STYLE_REGEXP matches references by the pattern in metas["regexp"],
+	// whose first capturing group, if any, is the index.
+	ISSUE_NAME_STYLE_REGEXP = "regexp"
 )
 
 var (
@@ -52,6 +58,11 @@ var (
 	// e.g. gogs/gogs#12345
 	CrossReferenceIssueNumericPattern = lazyregexp.New(`( |^)[0-9a-zA-Z-_\.]+/[0-9a-zA-Z-_\.]+#[0-9]+\b`)
 
+	// codeSpanPattern matches code spans in commit messages, e.g. `make test`
+	codeSpanPattern = lazyregexp.New("`[^`\n]+`")
+	// commitURLPattern matches URLs in commit messages without trailing punctuation
+	commitURLPattern = lazyregexp.New(`https?://[^\s<>"'` + "`" + `]*[^\s<>"'.,:;!?)\]` + "`" + `]`)
+
 	// Sha1CurrentPattern matches string that represents a commit SHA, e.g. d8a994ef243349f321568f9e36d5c3f444b99cae
 	// FIXME: this pattern matches pure numbers as well, right now we do a hack to check in RenderSha1CurrentPattern by converting string to a number.
 	Sha1CurrentPattern = lazyregexp.New(`\b[0-9a-f]{7,40}\b`)
@@ -85,10 +96,64 @@ func cutoutVerbosePrefix(prefix string) string {
 	return prefix
 }
 
+// issueRegexps caches compiled patterns of external issue trackers in regexp style.
+var issueRegexps sync.Map
+
+// IssueRegexp compiles the pattern of an external issue tracker in regexp style.
+func IssueRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := issueRegexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	} else if re.NumSubexp() > 1 {
+		return nil, fmt.Errorf("pattern has %d capturing groups, at most one is allowed", re.NumSubexp())
+	}
+	issueRegexps.Store(pattern, re)
+	return re, nil
+}
+
+// renderIssueRegexpPattern renders references matched by the pattern of the
+// external issue tracker to links.
+func renderIssueRegexpPattern(rawBytes []byte, metas map[string]string) []byte {
+	re, err := IssueRegexp(metas["regexp"])
+	if err != nil || metas["regexp"] == "" {
+		return rawBytes
+	}
+
+	var buf bytes.Buffer
+	last := 0
+	for _, loc := range re.FindAllSubmatchIndex(rawBytes, -1) {
+		if loc[0] == loc[1] {
+			continue
+		}
+
+		index := rawBytes[loc[0]:loc[1]]
+		if len(loc) == 4 && loc[2] >= 0 {
+			index = rawBytes[loc[2]:loc[3]]
+		}
+		metas["index"] = url.PathEscape(string(index))
+		buf.Write(rawBytes[last:loc[0]])
+		fmt.Fprintf(&buf, `<a href="%s">%s</a>`, com.Expand(metas["format"], metas), rawBytes[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	if last == 0 {
+		return rawBytes
+	}
+	buf.Write(rawBytes[last:])
+	return buf.Bytes()
+}
+
 // RenderIssueIndexPattern renders issue indexes to corresponding links.
 func RenderIssueIndexPattern(rawBytes []byte, urlPrefix string, metas map[string]string) []byte {
 	urlPrefix = cutoutVerbosePrefix(urlPrefix)
 
+	if metas["style"] == ISSUE_NAME_STYLE_REGEXP {
+		return renderIssueRegexpPattern(rawBytes, metas)
+	}
+
 	pattern := IssueNumericPattern
 	if metas["style"] == ISSUE_NAME_STYLE_ALPHANUMERIC {
 		pattern = IssueAlphanumericPattern
@@ -164,6 +229,40 @@ func RenderSpecialLink(rawBytes []byte, urlPrefix string, metas map[string]strin
 	return rawBytes
 }
 
+// StripCodeSpans returns the text with all code spans removed.
+func StripCodeSpans(text string) string {
+	return codeSpanPattern.ReplaceAllString(text, "")
+}
+
+// writeCommitMessageText writes the text of a commit message with URLs linked.
+func writeCommitMessageText(buf *bytes.Buffer, text string) {
+	last := 0
+	for _, loc := range commitURLPattern.FindAllStringIndex(text, -1) {
+		buf.WriteString(html.EscapeString(text[last:loc[0]]))
+		link := html.EscapeString(text[loc[0]:loc[1]])
+		fmt.Fprintf(buf, `<a href="%s">%s</a>`, link, link)
+		last = loc[1]
+	}
+	buf.WriteString(html.EscapeString(text[last:]))
+}
+
+// RenderCommitMessage renders the commit message to HTML without treating it
+// as Markdown. URLs, mentions and references of issues and commits are linked,
+// except the ones in code spans.
+func RenderCommitMessage(msg, urlPrefix string, metas map[string]string) []byte {
+	var buf bytes.Buffer
+	last := 0
+	for _, loc := range codeSpanPattern.FindAllStringIndex(msg, -1) {
+		writeCommitMessageText(&buf, msg[last:loc[0]])
+		buf.WriteString("<code>")
+		buf.WriteString(html.EscapeString(msg[loc[0]+1 : loc[1]-1]))
+		buf.WriteString("</code>")
+		last = loc[1]
+	}
+	writeCommitMessageText(&buf, msg[last:])
+	return postProcessHTML(buf.Bytes(), urlPrefix, metas)
+}
+
 var (
 	leftAngleBracket  = []byte("</")
 	rightAngleBracket = []byte(">")
@@ -56,6 +56,34 @@ func Test_FindAllMentions(t *testing.T) {
 	})
 }
 
+func Test_RenderIssueIndexPattern_Regexp(t *testing.T) {
+	metas := map[string]string{
+		"format": "https://jira.example.com/browse/{index}",
+		"style":  ISSUE_NAME_STYLE_REGEXP,
+	}
+
+	Convey("Render references matched by the pattern of an external issue tracker", t, func() {
+		metas["regexp"] = `\b(?:PROJ|OPS)-[0-9]+\b`
+		So(string(RenderIssueIndexPattern([]byte("Fix PROJ-12 and OPS-3, not XPROJ-1"), "/prefix", metas)), ShouldEqual,
+			`Fix <a href="https://jira.example.com/browse/PROJ-12">PROJ-12</a> and <a href="https://jira.example.com/browse/OPS-3">OPS-3</a>, not XPROJ-1`)
+		So(string(RenderIssueIndexPattern([]byte("no reference"), "/prefix", metas)), ShouldEqual, "no reference")
+
+		metas["regexp"] = `\bticket:([0-9]+)\b`
+		So(string(RenderIssueIndexPattern([]byte("see ticket:42"), "/prefix", metas)), ShouldEqual,
+			`see <a href="https://jira.example.com/browse/42">ticket:42</a>`)
+	})
+
+	Convey("Reject invalid patterns", t, func() {
+		_, err := IssueRegexp(`(`)
+		So(err, ShouldNotBeNil)
+		_, err = IssueRegexp(`(a)(b)`)
+		So(err, ShouldNotBeNil)
+
+		metas["regexp"] = `(`
+		So(string(RenderIssueIndexPattern([]byte("see #1"), "/prefix", metas)), ShouldEqual, "see #1")
+	})
+}
+
 func Test_RenderIssueIndexPattern(t *testing.T) {
 	Convey("Rendering an issue reference", t, func() {
 		var (
@@ -308,3 +336,31 @@ func Test_RenderIssueIndexPattern(t *testing.T) {
 		})
 	})
 }
+
+func Test_RenderCommitMessage(t *testing.T) {
+	Convey("Rendering a commit message", t, func() {
+		conf.Server.SubpathDepth = 0
+		conf.Server.Subpath = ""
+
+		testCases := []struct {
+			msg    string
+			expect string
+		}{
+			{"Fix #12 for @alice", `Fix <a href="/prefix/issues/12">#12</a> for <a href="/alice">@alice</a>`},
+			{"See https://example.com/a?b=1&c=2.", `See <a href="https://example.com/a?b=1&amp;c=2">https://example.com/a?b=1&amp;c=2</a>.`},
+			{"Run `make #12 @alice` first", `Run <code>make #12 @alice</code> first`},
+			{"# Not a heading\n- not a list", "# Not a heading\n- not a list"},
+			{"<script>alert(1)</script>", "&lt;script&gt;alert(1)&lt;/script&gt;"},
+		}
+		for _, tc := range testCases {
+			So(string(RenderCommitMessage(tc.msg, "/prefix", nil)), ShouldEqual, tc.expect)
+		}
+	})
+}
+
+func Test_StripCodeSpans(t *testing.T) {
+	Convey("Strip code spans", t, func() {
+		So(StripCodeSpans("cc @alice, not `@bob`"), ShouldEqual, "cc @alice, not ")
+		So(StripCodeSpans("unclosed `@bob"), ShouldEqual, "unclosed `@bob")
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package netutil
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+var localCIDRs []*net.IPNet
+
+func init() {
+	// Unspecified, loopback, private, shared address space, link-local and
+	// unique local addresses.
+	for _, cidr := range []string{
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"::/128",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+	} {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic("parse CIDR " + cidr + ": " + err.Error())
+		}
+		localCIDRs = append(localCIDRs, ipnet)
+	}
+}
+
+// IsLocalIP returns true if the IP address belongs to a local network.
+func IsLocalIP(ip net.IP) bool {
+	for _, ipnet := range localCIDRs {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// LocalNetworkError is returned when connecting to a local network address is
+// not allowed.
+type LocalNetworkError struct {
+	Host string
+	IP   net.IP
+}
+
+func (err LocalNetworkError) Error() string {
+	return fmt.Sprintf("%q resolves to local network address %s, which is not in the allowlist", err.Host, err.IP)
+}
+
+// isAllowed returns true if the host is in the allowlist, or the allowlist
+// contains "*".
+func isAllowed(host string, allowlist []string) bool {
+	for _, allowed := range allowlist {
+		if allowed == "*" || strings.EqualFold(allowed, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// Dialer returns a dial function for http.Transport that refuses to connect to
+// local network addresses unless the host is in the allowlist. The address is
+// checked after resolving, so that a hostname cannot be re-resolved to a local
+// network address when connecting.
+func Dialer(connectTimeout, rwTimeout time.Duration, allowlist []string) func(network, addr string) (net.Conn, error) {
+	return func(network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isAllowed(host, allowlist) {
+			ips, err := net.LookupIP(host)
+			if err != nil {
+				return nil, err
+			} else if len(ips) == 0 {
+				return nil, fmt.Errorf("no address found for %q", host)
+			}
+			for _, ip := range ips {
+				if IsLocalIP(ip) {
+					return nil, LocalNetworkError{Host: host, IP: ip}
+				}
+			}
+			addr = net.JoinHostPort(ips[0].String(), port)
+		}
+
+		conn, err := net.DialTimeout(network, addr, connectTimeout)
+		if err != nil {
+			return nil, err
+		}
+		_ = conn.SetDeadline(time.Now().Add(rwTimeout))
+		return conn, nil
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package netutil
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsLocalIP(t *testing.T) {
+	tests := []struct {
+		ip     string
+		expVal bool
+	}{
+		{ip: "127.0.0.1", expVal: true},
+		{ip: "10.1.2.3", expVal: true},
+		{ip: "172.16.0.1", expVal: true},
+		{ip: "192.168.1.1", expVal: true},
+		{ip: "169.254.169.254", expVal: true},
+		{ip: "0.0.0.0", expVal: true},
+		{ip: "::1", expVal: true},
+		{ip: "fd00::1", expVal: true},
+		{ip: "fe80::1", expVal: true},
+
+		{ip: "8.8.8.8", expVal: false},
+		{ip: "172.32.0.1", expVal: false},
+		{ip: "2001:4860:4860::8888", expVal: false},
+	}
+	for _, test := range tests {
+		t.Run(test.ip, func(t *testing.T) {
+			assert.Equal(t, test.expVal, IsLocalIP(net.ParseIP(test.ip)))
+		})
+	}
+}
+
+func TestDialer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	t.Run("blocked", func(t *testing.T) {
+		dial := Dialer(time.Second, time.Second, nil)
+		_, err := dial("tcp", ln.Addr().String())
+		assert.Equal(t, LocalNetworkError{Host: "127.0.0.1", IP: net.ParseIP("127.0.0.1")}, err)
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		for _, allowlist := range [][]string{{"127.0.0.1"}, {"*"}} {
+			dial := Dialer(time.Second, time.Second, allowlist)
+			conn, err := dial("tcp", ln.Addr().String())
+			if assert.Nil(t, err) {
+				_ = conn.Close()
+			}
+		}
+	})
+}
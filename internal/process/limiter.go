@@ -0,0 +1,114 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package process
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrTooManyOperations is returned when an operation cannot be started because
+// too many operations are running and waiting already.
+var ErrTooManyOperations = errors.New("too many operations in progress")
+
+// Limiter limits the number of operations running concurrently, operations over
+// the limit wait in a queue of limited length until others finish.
+type Limiter struct {
+	slots      chan struct{} // Nil means no limit.
+	maxWaiting int
+
+	lock    sync.Mutex
+	waiting int
+	running map[string]int // Operation class -> count
+}
+
+// NewLimiter returns a new limiter that allows given number of operations to run
+// concurrently and given number of operations to wait. Zero or negative
+// maxConcurrent means no limit, but operations are still counted.
+func NewLimiter(maxConcurrent, maxWaiting int) *Limiter {
+	l := &Limiter{
+		maxWaiting: maxWaiting,
+		running:    make(map[string]int),
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// Acquire starts an operation of the class, it waits until the operation is
+// allowed to run or the context is done. It returns ErrTooManyOperations
+// immediately if the queue is full. The returned function must be called when
+// the operation finishes.
+func (l *Limiter) Acquire(ctx context.Context, class string) (release func(), err error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			l.lock.Lock()
+			if l.waiting >= l.maxWaiting {
+				l.lock.Unlock()
+				return nil, ErrTooManyOperations
+			}
+			l.waiting++
+			l.lock.Unlock()
+
+			select {
+			case l.slots <- struct{}{}:
+				err = nil
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			l.lock.Lock()
+			l.waiting--
+			l.lock.Unlock()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	l.lock.Lock()
+	l.running[class]++
+	l.lock.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.lock.Lock()
+			l.running[class]--
+			l.lock.Unlock()
+
+			if l.slots != nil {
+				<-l.slots
+			}
+		})
+	}, nil
+}
+
+// LimiterStats is a snapshot of operations of a limiter.
+type LimiterStats struct {
+	// Running is the number of running operations by class.
+	Running map[string]int
+	// Waiting is the number of operations waiting to run.
+	Waiting int
+}
+
+// Stats returns the current numbers of running and waiting operations.
+func (l *Limiter) Stats() LimiterStats {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	stats := LimiterStats{
+		Running: make(map[string]int, len(l.running)),
+		Waiting: l.waiting,
+	}
+	for class, n := range l.running {
+		stats.Running[class] = n
+	}
+	return stats
+}
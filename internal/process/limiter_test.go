@@ -0,0 +1,60 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package process
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(1, 1)
+
+	release, err := l.Acquire(context.Background(), "diff")
+	assert.Nil(t, err)
+	assert.Equal(t, LimiterStats{Running: map[string]int{"diff": 1}}, l.Stats())
+
+	// The second operation waits until the first one finishes.
+	acquired := make(chan error)
+	go func() {
+		release, err := l.Acquire(context.Background(), "archive")
+		if err == nil {
+			release()
+		}
+		acquired <- err
+	}()
+	for l.Stats().Waiting == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The queue is full.
+	_, err = l.Acquire(context.Background(), "log")
+	assert.Equal(t, ErrTooManyOperations, err)
+
+	release()
+	release() // Releasing more than once has no effect.
+	assert.Nil(t, <-acquired)
+	assert.Equal(t, LimiterStats{Running: map[string]int{"diff": 0, "archive": 0}}, l.Stats())
+
+	// Waiting operations give up when the context is done.
+	release, err = l.Acquire(context.Background(), "diff")
+	assert.Nil(t, err)
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.Acquire(ctx, "diff")
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestExecDirContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := ExecDirContext(ctx, "", "test", nil, "sleep", "10")
+	assert.Equal(t, ErrExecTimeout, err)
+	assert.Empty(t, Processes)
+}
@@ -6,13 +6,18 @@ package process
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
+	"strings"
 	"sync"
 	"time"
 
 	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/trace"
 )
 
 var (
@@ -76,6 +81,11 @@ func Remove(pid int64) bool {
 
 // Exec starts executing a shell command in given path, it tracks corresponding process and timeout.
 func ExecDir(timeout time.Duration, dir, desc, cmdName string, args ...string) (string, string, error) {
+	return ExecDirStdin(timeout, dir, desc, nil, cmdName, args...)
+}
+
+// ExecDirStdin is ExecDir that reads standard input of the command from given reader.
+func ExecDirStdin(timeout time.Duration, dir, desc string, stdin io.Reader, cmdName string, args ...string) (string, string, error) {
 	if timeout == -1 {
 		timeout = DEFAULT_TIMEOUT
 	}
@@ -83,8 +93,16 @@ func ExecDir(timeout time.Duration, dir, desc, cmdName string, args ...string) (
 	bufOut := new(bytes.Buffer)
 	bufErr := new(bytes.Buffer)
 
+	if t := trace.Current(); t != nil && cmdName == "git" {
+		start := time.Now()
+		defer func() {
+			t.AddGit(cmdName+" "+strings.Join(args, " "), time.Since(start))
+		}()
+	}
+
 	cmd := exec.Command(cmdName, args...)
 	cmd.Dir = dir
+	cmd.Stdin = stdin
 	cmd.Stdout = bufOut
 	cmd.Stderr = bufErr
 	if err := cmd.Start(); err != nil {
@@ -112,6 +130,43 @@ func ExecDir(timeout time.Duration, dir, desc, cmdName string, args ...string) (
 	return bufOut.String(), bufErr.String(), err
 }
 
+// ExecDirContext starts executing a shell command in given path and writes its
+// standard output to given writer, it tracks corresponding process and kills it
+// when the context is done. It returns the standard error of the command, and
+// ErrExecTimeout if the deadline of the context is exceeded.
+func ExecDirContext(ctx context.Context, dir, desc string, stdout io.Writer, cmdName string, args ...string) (string, error) {
+	bufErr := new(bytes.Buffer)
+
+	if t := trace.Current(); t != nil && cmdName == "git" {
+		start := time.Now()
+		defer func() {
+			t.AddGit(cmdName+" "+strings.Join(args, " "), time.Since(start))
+		}()
+	}
+
+	cmd := exec.CommandContext(ctx, cmdName, args...)
+	cmd.Dir = dir
+	cmd.Stdout = stdout
+	cmd.Stderr = bufErr
+	if err := cmd.Start(); err != nil {
+		return err.Error(), err
+	}
+
+	pid := Add(desc, cmd)
+	err := cmd.Wait()
+	Remove(pid)
+
+	if err != nil {
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
+			return ErrExecTimeout.Error(), ErrExecTimeout
+		case context.Canceled:
+			return bufErr.String(), context.Canceled
+		}
+	}
+	return bufErr.String(), err
+}
+
 // Exec starts executing a shell command, it tracks corresponding process and timeout.
 func ExecTimeout(timeout time.Duration, desc, cmdName string, args ...string) (string, string, error) {
 	return ExecDir(timeout, "", desc, cmdName, args...)
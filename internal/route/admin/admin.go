@@ -121,6 +121,7 @@ const (
 	SYNC_SSH_AUTHORIZED_KEY
 	SYNC_REPOSITORY_HOOKS
 	REINIT_MISSING_REPOSITORY
+	CHECK_REPO_STATS
 )
 
 func Dashboard(c *context.Context) {
@@ -156,6 +157,9 @@ func Dashboard(c *context.Context) {
 		case REINIT_MISSING_REPOSITORY:
 			success = c.Tr("admin.dashboard.reinit_missing_repos_success")
 			err = db.ReinitMissingRepositories()
+		case CHECK_REPO_STATS:
+			success = c.Tr("admin.dashboard.check_repo_stats_success")
+			db.CheckRepoStats()
 		}
 
 		if err != nil {
@@ -176,6 +180,7 @@ func Dashboard(c *context.Context) {
 	// FIXME: update periodically
 	updateSystemStatus()
 	c.Data["SysStatus"] = sysStatus
+	c.Data["GitOperations"] = db.GitOperationStats()
 	c.Success(DASHBOARD)
 }
 
@@ -207,6 +212,7 @@ func Config(c *context.Context) {
 	c.Data["User"] = conf.User
 	c.Data["Session"] = conf.Session
 	c.Data["Cache"] = conf.Cache
+	c.Data["Cluster"] = conf.Cluster
 	c.Data["Attachment"] = conf.Attachment
 	c.Data["Release"] = conf.Release
 	c.Data["Picture"] = conf.Picture
@@ -0,0 +1,46 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package admin
+
+import (
+	"github.com/unknwon/paginater"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const (
+	BOTS = "admin/bot/list"
+)
+
+func Bots(c *context.Context) {
+	c.Data["Title"] = c.Tr("admin.bots")
+	c.Data["PageIsAdmin"] = true
+	c.Data["PageIsAdminBots"] = true
+
+	total := db.CountBots()
+	page := c.QueryInt("page")
+	if page <= 1 {
+		page = 1
+	}
+	c.Data["Page"] = paginater.New(int(total), conf.UI.Admin.UserPagingNum, page, 5)
+
+	bots, err := db.Bots(page, conf.UI.Admin.UserPagingNum)
+	if err != nil {
+		c.ServerError("Bots", err)
+		return
+	}
+	for _, bot := range bots {
+		if err = bot.LoadBotAttributes(); err != nil {
+			c.ServerError("LoadBotAttributes", err)
+			return
+		}
+	}
+	c.Data["Bots"] = bots
+
+	c.Data["Total"] = total
+	c.Success(BOTS)
+}
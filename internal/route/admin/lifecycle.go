@@ -0,0 +1,95 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package admin
+
+import (
+	"fmt"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+const (
+	LIFECYCLE = "admin/lifecycle"
+)
+
+// maxLifecycleDecisions is the number of latest decisions of lifecycle
+// policies shown in the admin panel.
+const maxLifecycleDecisions = 50
+
+func prepareLifecycle(c *context.Context) bool {
+	c.Title("admin.lifecycle")
+	c.PageIs("Admin")
+	c.PageIs("AdminLifecycle")
+
+	userPolicy, err := db.GetLifecyclePolicy(db.LIFECYCLE_USER)
+	if err != nil {
+		c.ServerError("GetLifecyclePolicy", err)
+		return false
+	}
+	repoPolicy, err := db.GetLifecyclePolicy(db.LIFECYCLE_REPO)
+	if err != nil {
+		c.ServerError("GetLifecyclePolicy", err)
+		return false
+	}
+	c.Data["UserPolicy"] = userPolicy
+	c.Data["RepoPolicy"] = repoPolicy
+
+	decisions, err := db.RecentLifecycleDecisions(maxLifecycleDecisions)
+	if err != nil {
+		c.ServerError("RecentLifecycleDecisions", err)
+		return false
+	}
+	c.Data["Decisions"] = decisions
+	return true
+}
+
+func Lifecycle(c *context.Context) {
+	if !prepareLifecycle(c) {
+		return
+	}
+	c.Success(LIFECYCLE)
+}
+
+func LifecyclePost(c *context.Context, f form.AdminLifecyclePolicies) {
+	if c.HasError() {
+		if prepareLifecycle(c) {
+			c.Success(LIFECYCLE)
+		}
+		return
+	}
+
+	for _, p := range []*db.LifecyclePolicy{
+		{
+			Type:           db.LIFECYCLE_USER,
+			Enabled:        f.UserEnabled,
+			InactiveMonths: f.UserInactiveMonths,
+			GraceDays:      f.UserGraceDays,
+			Exemptions:     f.UserExemptions,
+		},
+		{
+			Type:           db.LIFECYCLE_REPO,
+			Enabled:        f.RepoEnabled,
+			InactiveMonths: f.RepoInactiveMonths,
+			Exemptions:     f.RepoExemptions,
+		},
+	} {
+		if err := db.UpdateLifecyclePolicy(p); err != nil {
+			c.ServerError("UpdateLifecyclePolicy", err)
+			return
+		}
+	}
+
+	desc := fmt.Sprintf("Lifecycle policies are updated by %s [users: %v, repositories: %v]", c.User.Name, f.UserEnabled, f.RepoEnabled)
+	if err := db.CreateLifecycleNotice(desc); err != nil {
+		log.Error("CreateLifecycleNotice: %v", err)
+	}
+
+	c.Flash.Success(c.Tr("admin.lifecycle.update_success"))
+	c.SubURLRedirect("/admin/lifecycle")
+}
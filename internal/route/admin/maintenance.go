@@ -0,0 +1,69 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package admin
+
+import (
+	"fmt"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+	"gogs.io/gogs/internal/maintenance"
+)
+
+const (
+	MAINTENANCE = "admin/maintenance"
+)
+
+func Maintenance(c *context.Context) {
+	c.Title("admin.maintenance")
+	c.PageIs("Admin")
+	c.PageIs("AdminMaintenance")
+
+	c.Data["State"] = maintenance.Current()
+	c.Data["DefaultMessage"] = maintenance.DefaultMessage
+	c.Success(MAINTENANCE)
+}
+
+func MaintenancePost(c *context.Context, f form.AdminMaintenance) {
+	c.Title("admin.maintenance")
+	c.PageIs("Admin")
+	c.PageIs("AdminMaintenance")
+
+	if c.HasError() {
+		c.Data["State"] = maintenance.Current()
+		c.Data["DefaultMessage"] = maintenance.DefaultMessage
+		c.Success(MAINTENANCE)
+		return
+	}
+
+	var desc string
+	switch c.Query("action") {
+	case "enable":
+		if err := maintenance.Enable(c.User.Name, f.Message, f.AllowAdmins); err != nil {
+			c.ServerError("Enable", err)
+			return
+		}
+		desc = fmt.Sprintf("Maintenance mode is enabled by %s [allow_admins: %v]: %s", c.User.Name, f.AllowAdmins, f.Message)
+		c.Flash.Success(c.Tr("admin.maintenance.enable_success"))
+	case "disable":
+		if err := maintenance.Disable(c.User.Name); err != nil {
+			c.ServerError("Disable", err)
+			return
+		}
+		desc = fmt.Sprintf("Maintenance mode is disabled by %s", c.User.Name)
+		c.Flash.Success(c.Tr("admin.maintenance.disable_success"))
+	default:
+		c.NotFound()
+		return
+	}
+
+	if err := db.CreateMaintenanceNotice(desc); err != nil {
+		log.Error("CreateMaintenanceNotice: %v", err)
+	}
+	c.SubURLRedirect("/admin/maintenance")
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+const (
+	SITE_CONTENT = "admin/site_content"
+)
+
+func prepareSiteContent(c *context.Context) {
+	c.Title("admin.site_content")
+	c.PageIs("Admin")
+	c.PageIs("AdminSiteContent")
+}
+
+func SiteContent(c *context.Context) {
+	prepareSiteContent(c)
+
+	content, err := db.GetSiteContent()
+	if err != nil {
+		c.ServerError("GetSiteContent", err)
+		return
+	}
+	links, err := db.GetFooterLinks()
+	if err != nil {
+		c.ServerError("GetFooterLinks", err)
+		return
+	}
+	c.Data["home_notice"] = content.HomeNotice
+	c.Data["head_snippet"] = content.HeadSnippet
+	c.Data["links"] = links
+	c.Success(SITE_CONTENT)
+}
+
+// footerLinks returns footer links from labels and URLs of the form, skipping
+// rows that are left empty.
+func footerLinks(labels, urls []string) []*db.FooterLink {
+	links := make([]*db.FooterLink, 0, len(labels))
+	for i := range labels {
+		label := strings.TrimSpace(labels[i])
+		var url string
+		if i < len(urls) {
+			url = strings.TrimSpace(urls[i])
+		}
+		if label == "" && url == "" {
+			continue
+		}
+		links = append(links, &db.FooterLink{
+			Label: label,
+			URL:   url,
+		})
+	}
+	return links
+}
+
+func SiteContentPost(c *context.Context, f form.AdminSiteContent) {
+	prepareSiteContent(c)
+
+	links := footerLinks(f.LinkLabels, f.LinkURLs)
+	c.Data["links"] = links
+
+	if c.HasError() {
+		c.Success(SITE_CONTENT)
+		return
+	}
+
+	old, err := db.GetSiteContent()
+	if err != nil {
+		c.ServerError("GetSiteContent", err)
+		return
+	}
+	headSnippetChanged := old.HeadSnippet != f.HeadSnippet
+
+	if err = db.UpdateSiteContent(&db.SiteContent{
+		HomeNotice:  f.HomeNotice,
+		HeadSnippet: f.HeadSnippet,
+	}, links); err != nil {
+		if db.IsErrInvalidFooterLink(err) {
+			c.RenderWithErr(c.Tr("admin.site_content.link_invalid"), SITE_CONTENT, &f)
+		} else {
+			c.ServerError("UpdateSiteContent", err)
+		}
+		return
+	}
+
+	// Scripts in the head snippet run on every page, changes of it are always
+	// recorded for audits.
+	if headSnippetChanged {
+		desc := fmt.Sprintf("Custom HTML head snippet is changed by %s", c.User.Name)
+		if err = db.CreateNotice(db.NOTICE_SITE_CONTENT, desc); err != nil {
+			log.Error("CreateNotice: %v", err)
+		}
+	}
+
+	c.Flash.Success(c.Tr("admin.site_content.update_success"))
+	c.SubURLRedirect("/admin/site-content")
+}
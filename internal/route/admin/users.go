@@ -212,6 +212,7 @@ func EditUserPost(c *context.Context, f form.AdminEditUser) {
 	u.IsAdmin = f.Admin
 	u.AllowGitHook = f.AllowGitHook
 	u.AllowImportLocal = f.AllowImportLocal
+	prohibitLoginChanged := u.ProhibitLogin != f.ProhibitLogin
 	u.ProhibitLogin = f.ProhibitLogin
 
 	if err := db.UpdateUser(u); err != nil {
@@ -225,6 +226,12 @@ func EditUserPost(c *context.Context, f form.AdminEditUser) {
 	}
 	log.Trace("Account profile updated by admin (%s): %s", c.User.Name, u.Name)
 
+	if prohibitLoginChanged {
+		if err := db.ResetUserLifecycle(u.ID); err != nil {
+			log.Error("ResetUserLifecycle [%d]: %v", u.ID, err)
+		}
+	}
+
 	c.Flash.Success(c.Tr("admin.users.update_profile_success"))
 	c.Redirect(conf.Server.Subpath + "/admin/users/" + c.Params(":userid"))
 }
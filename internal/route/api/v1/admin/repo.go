@@ -5,14 +5,13 @@
 package admin
 
 import (
-	api "github.com/gogs/go-gogs-client"
 	repo2 "gogs.io/gogs/internal/route/api/v1/repo"
 	user2 "gogs.io/gogs/internal/route/api/v1/user"
 
 	"gogs.io/gogs/internal/context"
 )
 
-func CreateRepo(c *context.APIContext, form api.CreateRepoOption) {
+func CreateRepo(c *context.APIContext, form repo2.CreateRepoOption) {
 	owner := user2.GetUserByParams(c)
 	if c.Written() {
 		return
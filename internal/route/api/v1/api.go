@@ -12,20 +12,32 @@ import (
 	user2 "gogs.io/gogs/internal/route/api/v1/user"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-macaron/binding"
 	"gopkg.in/macaron.v1"
+	log "unknwon.dev/clog/v2"
 
 	api "github.com/gogs/go-gogs-client"
 
+	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/context"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/form"
+	"gogs.io/gogs/internal/sync"
 )
 
-// repoAssignment extracts information from URL parameters to retrieve the repository,
-// and makes sure the context user has at least the read access to the repository.
+// Endpoints that collaborators limited to some paths can request, besides the
+// repository itself. Issue endpoints are only allowed when the collaborator can
+// access issues.
+var (
+	restrictedRepoEndpoints      = []string{"/raw", "/contents", "/archive", "/permissions"}
+	restrictedRepoIssueEndpoints = []string{"/issues", "/labels", "/milestones"}
+)
+
+// repoAssignment extracts information from URL parameters to retrieve the repository.
+// It must be followed by a middleware requiring access to the repository.
 func repoAssignment() macaron.Handler {
 	return func(c *context.APIContext) {
 		username := c.Params(":username")
@@ -55,15 +67,14 @@ func repoAssignment() macaron.Handler {
 			return
 		}
 
-		if c.IsTokenAuth && c.User.IsAdmin {
-			c.Repo.AccessMode = db.ACCESS_MODE_OWNER
-		} else {
-			mode, err := db.UserAccessMode(c.UserID(), r)
-			if err != nil {
-				c.ServerError("UserAccessMode", err)
-				return
-			}
-			c.Repo.AccessMode = mode
+		var u *db.User
+		if c.IsLogged {
+			u = c.User
+		}
+		c.Repo.AccessMode, err = db.EffectiveAccessMode(u, r)
+		if err != nil {
+			c.ServerError("EffectiveAccessMode", err)
+			return
 		}
 
 		if !c.Repo.HasAccess() {
@@ -71,7 +82,35 @@ func repoAssignment() macaron.Handler {
 			return
 		}
 
+		if c.IsLogged && c.Repo.AccessMode == db.ACCESS_MODE_READ {
+			c.Repo.PathRestriction, err = db.GetPathRestriction(r, c.User)
+			if err != nil {
+				c.ServerError("GetPathRestriction", err)
+				return
+			}
+			baseLink := conf.Server.Subpath + "/api/v1/repos/" + username + "/" + reponame
+			if c.Repo.PathRestriction != nil &&
+				!c.Repo.PathRestriction.AllowsPage(baseLink, c.Req.URL.Path, restrictedRepoEndpoints, restrictedRepoIssueEndpoints) {
+				c.NotFound()
+				return
+			}
+		}
+
+		if c.IsLogged && c.User.IsBot() {
+			c.Repo.BotGrant, err = db.GetBotGrant(c.User.ID, r.ID)
+			if err != nil && !db.IsErrBotGrantNotExist(err) {
+				c.ServerError("GetBotGrant", err)
+				return
+			}
+		}
+
 		c.Repo.Repository = r
+
+		if c.IsLogged {
+			if err = db.UpdateCollaboratorAccess(r.ID, c.User.ID); err != nil {
+				log.Error("Failed to update collaborator access [repo_id: %d, user_id: %d]: %v", r.ID, c.User.ID, err)
+			}
+		}
 	}
 }
 
@@ -139,9 +178,17 @@ func reqAdmin() macaron.Handler {
 	}
 }
 
-// reqRepoWriter makes sure the context user has at least write access to the repository.
-func reqRepoWriter() macaron.Handler {
+// reqRepoWriterOrBot makes sure the context user has at least write access to the repository,
+// or is a bot granted with the capability on the repository.
+func reqRepoWriterOrBot(capability db.BotCapability) macaron.Handler {
 	return func(c *context.Context) {
+		if c.User.IsBot() {
+			if c.Repo.BotGrant == nil || !c.Repo.BotGrant.Allows(capability) {
+				c.Error(http.StatusForbidden)
+			}
+			return
+		}
+
 		if !c.Repo.IsWriter() {
 			c.Error(http.StatusForbidden)
 			return
@@ -149,16 +196,45 @@ func reqRepoWriter() macaron.Handler {
 	}
 }
 
-// reqRepoWriter makes sure the context user has at least admin access to the repository.
-func reqRepoAdmin() macaron.Handler {
+// reqBotCapability makes sure the context user is granted with the capability on the
+// repository if it is a bot, other users are not affected.
+func reqBotCapability(capability db.BotCapability) macaron.Handler {
 	return func(c *context.Context) {
-		if !c.Repo.IsAdmin() {
+		if c.User.IsBot() && (c.Repo.BotGrant == nil || !c.Repo.BotGrant.Allows(capability)) {
 			c.Error(http.StatusForbidden)
 			return
 		}
 	}
 }
 
+// reqNotBot makes sure the context user is not a bot, which only works with
+// repositories it is granted.
+func reqNotBot() macaron.Handler {
+	return func(c *context.Context) {
+		if c.IsLogged && c.User.IsBot() {
+			c.Error(http.StatusForbidden)
+			return
+		}
+	}
+}
+
+// limitBots limits the number of requests each bot is allowed to make per minute.
+// Bots are rate limited separately from other users so a misbehaving CI job does
+// not affect anyone else. It does nothing when limit is not positive.
+func limitBots(limit int) macaron.Handler {
+	limiter := sync.NewRateLimiter(limit, time.Minute)
+	return func(c *context.APIContext) {
+		if limit <= 0 || !c.IsLogged || !c.User.IsBot() {
+			return
+		}
+
+		if !limiter.Allow(c.User.Name) {
+			c.Error(http.StatusTooManyRequests, "", "bot has exceeded the rate limit, please try again later")
+			return
+		}
+	}
+}
+
 func mustEnableIssues(c *context.APIContext) {
 	if !c.Repo.Repository.EnableIssues || c.Repo.Repository.EnableExternalTracker {
 		c.NotFound()
@@ -171,6 +247,13 @@ func mustEnableIssues(c *context.APIContext) {
 func RegisterRoutes(m *macaron.Macaron) {
 	bind := binding.Bind
 
+	// Signed in users who are denied access to a repository get 403 rather than
+	// 404, e.g. they may have just been removed from a team of the repository.
+	repoAccess := context.RepoAccessOptions{ForbiddenForAPI: true}
+	reqRepoReader := context.RequireRepoReader(repoAccess)
+	reqRepoWriter := context.RequireRepoWriter(repoAccess)
+	reqRepoAdmin := context.RequireRepoAdmin(repoAccess)
+
 	m.Group("/v1", func() {
 		// Handle preflight OPTIONS request
 		m.Options("/*", func() {})
@@ -178,6 +261,7 @@ func RegisterRoutes(m *macaron.Macaron) {
 		// Miscellaneous
 		m.Post("/markdown", bind(api.MarkdownOption{}), misc2.Markdown)
 		m.Post("/markdown/raw", misc2.MarkdownRaw)
+		m.Get("/site/footer-links", misc2.FooterLinks)
 
 		// Users
 		m.Group("/users", func() {
@@ -232,25 +316,26 @@ func RegisterRoutes(m *macaron.Macaron) {
 			})
 
 			m.Get("/issues", repo2.ListUserIssues)
-		}, reqToken())
+		}, reqToken(), reqNotBot())
 
 		// Repositories
 		m.Get("/users/:username/repos", reqToken(), repo2.ListUserRepositories)
 		m.Get("/orgs/:org/repos", reqToken(), repo2.ListOrgRepositories)
-		m.Combo("/user/repos", reqToken()).
+		m.Combo("/user/repos", reqToken(), reqNotBot()).
 			Get(repo2.ListMyRepos).
-			Post(bind(api.CreateRepoOption{}), repo2.Create)
-		m.Post("/org/:org/repos", reqToken(), bind(api.CreateRepoOption{}), repo2.CreateOrgRepo)
+			Post(bind(repo2.CreateRepoOption{}), repo2.Create)
+		m.Post("/org/:org/repos", reqToken(), reqNotBot(), bind(repo2.CreateRepoOption{}), repo2.CreateOrgRepo)
 
 		m.Group("/repos", func() {
 			m.Get("/search", repo2.Search)
 
-			m.Get("/:username/:reponame", repoAssignment(), repo2.Get)
+			m.Get("/:username/:reponame", repoAssignment(), reqRepoReader, repo2.Get)
 		})
 
 		m.Group("/repos", func() {
-			m.Post("/migrate", bind(form.MigrateRepo{}), repo2.Migrate)
-			m.Delete("/:username/:reponame", repoAssignment(), repo2.Delete)
+			m.Post("/migrate", reqNotBot(), bind(form.MigrateRepo{}), repo2.Migrate)
+			m.Delete("/:username/:reponame", repoAssignment(), reqRepoAdmin, repo2.Delete)
+			m.Patch("/:username/:reponame", repoAssignment(), reqRepoAdmin, bind(repo2.EditRepoOption{}), repo2.Edit)
 
 			m.Group("/:username/:reponame", func() {
 				m.Group("/hooks", func() {
@@ -260,7 +345,7 @@ func RegisterRoutes(m *macaron.Macaron) {
 					m.Combo("/:id").
 						Patch(bind(api.EditHookOption{}), repo2.EditHook).
 						Delete(repo2.DeleteHook)
-				}, reqRepoAdmin())
+				}, reqRepoAdmin)
 
 				m.Group("/collaborators", func() {
 					m.Get("", repo2.ListCollaborators)
@@ -268,7 +353,10 @@ func RegisterRoutes(m *macaron.Macaron) {
 						Get(repo2.IsCollaborator).
 						Put(bind(api.AddCollaboratorOption{}), repo2.AddCollaborator).
 						Delete(repo2.DeleteCollaborator)
-				}, reqRepoAdmin())
+					m.Get("/:collaborator/permission", repo2.GetCollaboratorPermission)
+				}, reqRepoAdmin)
+				m.Get("/permissions", repo2.GetMyPermission)
+				m.Get("/push-log", reqRepoAdmin, repo2.ListPushLogs)
 
 				m.Get("/raw/*", context.RepoRef(), repo2.GetRawFile)
 				m.Get("/contents/*", context.RepoRef(), repo2.GetContents)
@@ -276,15 +364,19 @@ func RegisterRoutes(m *macaron.Macaron) {
 				m.Group("/git/trees", func() {
 					m.Get("/:sha", context.RepoRef(), repo2.GetRepoGitTree)
 				})
+				m.Get("/git/refs/resolve/*", repo2.ResolveRef)
 				m.Get("/forks", repo2.ListForks)
 				m.Group("/branches", func() {
 					m.Get("", repo2.ListBranches)
 					m.Get("/*", repo2.GetBranch)
 				})
+				m.Get("/refs", repo2.ListRefs)
 				m.Group("/commits", func() {
 					m.Get("/:sha", repo2.GetSingleCommit)
+					m.Get("/:sha/status", repo2.GetCombinedStatus)
 					m.Get("/*", repo2.GetReferenceSHA)
 				})
+				m.Post("/statuses/:sha", reqRepoWriterOrBot(db.BOT_CAPABILITY_SET_STATUS), bind(repo2.CreateStatusOption{}), repo2.CreateStatus)
 
 				m.Group("/keys", func() {
 					m.Combo("").
@@ -293,12 +385,12 @@ func RegisterRoutes(m *macaron.Macaron) {
 					m.Combo("/:id").
 						Get(repo2.GetDeployKey).
 						Delete(repo2.DeleteDeploykey)
-				}, reqRepoAdmin())
+				}, reqRepoAdmin)
 
 				m.Group("/issues", func() {
 					m.Combo("").
 						Get(repo2.ListIssues).
-						Post(bind(api.CreateIssueOption{}), repo2.CreateIssue)
+						Post(reqNotBot(), bind(repo2.CreateIssueOption{}), repo2.CreateIssue)
 					m.Group("/comments", func() {
 						m.Get("", repo2.ListRepoIssueComments)
 						m.Patch("/:id", bind(api.EditIssueCommentOption{}), repo2.EditIssueComment)
@@ -306,12 +398,12 @@ func RegisterRoutes(m *macaron.Macaron) {
 					m.Group("/:index", func() {
 						m.Combo("").
 							Get(repo2.GetIssue).
-							Patch(bind(api.EditIssueOption{}), repo2.EditIssue)
+							Patch(reqNotBot(), bind(repo2.EditIssueOption{}), repo2.EditIssue)
 
 						m.Group("/comments", func() {
 							m.Combo("").
 								Get(repo2.ListIssueComments).
-								Post(bind(api.CreateIssueCommentOption{}), repo2.CreateIssueComment)
+								Post(reqBotCapability(db.BOT_CAPABILITY_COMMENT_PULL), bind(api.CreateIssueCommentOption{}), repo2.CreateIssueComment)
 							m.Combo("/:id").
 								Patch(bind(api.EditIssueCommentOption{}), repo2.EditIssueComment).
 								Delete(repo2.DeleteIssueComment)
@@ -324,9 +416,17 @@ func RegisterRoutes(m *macaron.Macaron) {
 								Put(bind(api.IssueLabelsOption{}), repo2.ReplaceIssueLabels).
 								Delete(repo2.ClearIssueLabels)
 							m.Delete("/:id", repo2.DeleteIssueLabel)
-						}, reqRepoWriter())
+						}, reqRepoWriter)
+
+						m.Combo("/lock", reqRepoWriter).
+							Put(bind(repo2.LockIssueOption{}), repo2.LockIssue).
+							Delete(repo2.UnlockIssue)
 					})
 				}, mustEnableIssues)
+				m.Combo("/issue-auto-lock", mustEnableIssues, reqRepoAdmin).
+					Get(repo2.GetIssueAutoLock).
+					Put(bind(repo2.IssueAutoLockOption{}), repo2.EditIssueAutoLock).
+					Delete(repo2.DeleteIssueAutoLock)
 
 				m.Group("/labels", func() {
 					m.Get("", repo2.ListLabels)
@@ -337,7 +437,7 @@ func RegisterRoutes(m *macaron.Macaron) {
 					m.Combo("/:id").
 						Patch(bind(api.EditLabelOption{}), repo2.EditLabel).
 						Delete(repo2.DeleteLabel)
-				}, reqRepoWriter())
+				}, reqRepoWriter)
 
 				m.Group("/milestones", func() {
 					m.Get("", repo2.ListMilestones)
@@ -348,18 +448,33 @@ func RegisterRoutes(m *macaron.Macaron) {
 					m.Combo("/:id").
 						Patch(bind(api.EditMilestoneOption{}), repo2.EditMilestone).
 						Delete(repo2.DeleteMilestone)
-				}, reqRepoWriter())
-
-				m.Patch("/issue-tracker", reqRepoWriter(), bind(api.EditIssueTrackerOption{}), repo2.IssueTracker)
-				m.Post("/mirror-sync", reqRepoWriter(), repo2.MirrorSync)
+				}, reqRepoWriter)
+
+				m.Patch("/issue-tracker", reqRepoWriter, bind(api.EditIssueTrackerOption{}), repo2.IssueTracker)
+				m.Get("/releases/:id/stats", reqRepoWriter, repo2.GetReleaseStats)
+				m.Get("/dependencies", repo2.ListDependencies)
+				m.Group("/stats", func() {
+					m.Get("/code_frequency", repo2.GetCodeFrequency)
+					m.Get("/participation", repo2.GetParticipation)
+					m.Get("/punch_card", repo2.GetPunchCard)
+				})
+				m.Post("/mirror-sync", reqRepoWriter, repo2.MirrorSync)
+				m.Post("/sync-fork", reqRepoWriter, bind(repo2.SyncForkOption{}), repo2.SyncFork)
+				m.Put("/pulls/:index/merge", reqRepoWriter, bind(repo2.MergePullRequestOption{}), repo2.MergePullRequest)
 				m.Get("/editorconfig/:filename", context.RepoRef(), repo2.GetEditorconfig)
-			}, repoAssignment())
+				m.Group("/watch-paths", func() {
+					m.Combo("").
+						Get(repo2.ListPathWatches).
+						Post(reqNotBot(), bind(repo2.CreatePathWatchOption{}), repo2.CreatePathWatch)
+					m.Delete("/:id", repo2.DeletePathWatch)
+				})
+			}, repoAssignment(), reqRepoReader)
 		}, reqToken())
 
 		m.Get("/issues", reqToken(), repo2.ListUserIssues)
 
 		// Organizations
-		m.Combo("/user/orgs", reqToken()).
+		m.Combo("/user/orgs", reqToken(), reqNotBot()).
 			Get(org2.ListMyOrgs).
 			Post(bind(api.CreateOrgOption{}), org2.CreateMyOrg)
 
@@ -381,7 +496,7 @@ func RegisterRoutes(m *macaron.Macaron) {
 						Delete(admin2.DeleteUser)
 					m.Post("/keys", bind(api.CreateKeyOption{}), admin2.CreatePublicKey)
 					m.Post("/orgs", bind(api.CreateOrgOption{}), admin2.CreateOrg)
-					m.Post("/repos", bind(api.CreateRepoOption{}), admin2.CreateRepo)
+					m.Post("/repos", bind(repo2.CreateRepoOption{}), admin2.CreateRepo)
 				})
 			})
 
@@ -406,5 +521,5 @@ func RegisterRoutes(m *macaron.Macaron) {
 		m.Any("/*", func(c *context.Context) {
 			c.NotFound()
 		})
-	}, context.APIContexter())
+	}, context.APIContexter(), limitBots(conf.API.BotRateLimit))
 }
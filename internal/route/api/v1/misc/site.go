@@ -0,0 +1,34 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package misc
+
+import (
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+type footerLink struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// FooterLinks returns footer links of the site in the order they are shown,
+// so that clients can show the same links as web pages.
+func FooterLinks(c *context.APIContext) {
+	links, err := db.GetFooterLinks()
+	if err != nil {
+		c.ServerError("GetFooterLinks", err)
+		return
+	}
+
+	apiLinks := make([]*footerLink, len(links))
+	for i := range links {
+		apiLinks[i] = &footerLink{
+			Label: links[i].Label,
+			URL:   links[i].URL,
+		}
+	}
+	c.JSONSuccess(&apiLinks)
+}
@@ -35,6 +35,18 @@ func AddCollaborator(c *context.APIContext, form api.AddCollaboratorOption) {
 			c.Error(500, "GetUserByName", err)
 		}
 		return
+	} else if collaborator.IsBot() {
+		c.Error(422, "", "bot cannot be added as a collaborator")
+		return
+	}
+
+	if form.Permission != nil {
+		switch *form.Permission {
+		case "read", "write", "admin":
+		default:
+			c.Error(422, "", "permission must be one of read, write and admin")
+			return
+		}
 	}
 
 	if err := c.Repo.Repository.AddCollaborator(collaborator); err != nil {
@@ -88,3 +100,84 @@ func DeleteCollaborator(c *context.APIContext) {
 
 	c.Status(204)
 }
+
+// apiPermission returns API permissions implied by the access mode.
+func apiPermission(mode db.AccessMode) api.Permission {
+	return api.Permission{
+		Admin: mode >= db.ACCESS_MODE_ADMIN,
+		Push:  mode >= db.ACCESS_MODE_WRITE,
+		Pull:  mode >= db.ACCESS_MODE_READ,
+	}
+}
+
+type collaboratorPermission struct {
+	// Permission is one of "none", "read", "write", "admin" and "owner".
+	Permission  string         `json:"permission"`
+	Permissions api.Permission `json:"permissions"`
+	User        *api.User      `json:"user"`
+}
+
+// GetCollaboratorPermission returns the effective access of the user to the
+// repository, which is the same access the web UI enforces and includes
+// access granted by teams and ownership of the organization. The user does
+// not need to be a collaborator.
+func GetCollaboratorPermission(c *context.APIContext) {
+	u, err := db.GetUserByName(c.Params(":collaborator"))
+	if err != nil {
+		c.NotFoundOrServerError("GetUserByName", errors.IsUserNotExist, err)
+		return
+	}
+
+	mode, err := db.EffectiveAccessMode(u, c.Repo.Repository)
+	if err != nil {
+		c.ServerError("EffectiveAccessMode", err)
+		return
+	}
+	c.JSONSuccess(&collaboratorPermission{
+		Permission:  mode.String(),
+		Permissions: apiPermission(mode),
+		User:        u.APIFormat(),
+	})
+}
+
+type repoUnits struct {
+	Code         bool `json:"code"`
+	Issues       bool `json:"issues"`
+	PullRequests bool `json:"pull_requests"`
+	Wiki         bool `json:"wiki"`
+}
+
+type myPermission struct {
+	// Permission is one of "none", "read", "write", "admin" and "owner".
+	Permission  string         `json:"permission"`
+	Permissions api.Permission `json:"permissions"`
+	Units       repoUnits      `json:"units"`
+	// PathPrefixes are only set for collaborators limited to some paths, who
+	// can only read files under these prefixes.
+	PathPrefixes []string `json:"path_prefixes,omitempty"`
+}
+
+// GetMyPermission returns effective access of the authenticated user or token
+// to the repository and to its units.
+func GetMyPermission(c *context.APIContext) {
+	mode := c.Repo.AccessMode
+	grant := c.Repo.BotGrant
+	if grant == nil && c.IsLogged && c.User.IsBot() {
+		grant = new(db.BotGrant)
+	}
+	units := db.RepoUnitAccess(c.Repo.Repository, mode, grant, c.Repo.PathRestriction)
+	perm := &myPermission{
+		Permission:  mode.String(),
+		Permissions: apiPermission(mode),
+		Units: repoUnits{
+			Code:         units.Code,
+			Issues:       units.Issues,
+			PullRequests: units.PullRequests,
+			Wiki:         units.Wiki,
+		},
+	}
+	if r := c.Repo.PathRestriction; r != nil {
+		perm.PathPrefixes = r.Prefixes
+	}
+	c.JSONSuccess(perm)
+}
@@ -43,6 +43,11 @@ func GetContents(c *context.APIContext) {
 		c.NotFoundOrServerError("GetTreeEntryByPath", git.IsErrNotExist, err)
 		return
 	}
+	if r := c.Repo.PathRestriction; r != nil &&
+		((treeEntry.IsDir() && !r.Visible(c.Repo.TreePath)) || (!treeEntry.IsDir() && !r.Allows(c.Repo.TreePath))) {
+		c.NotFound()
+		return
+	}
 	username := c.Params(":username")
 	reponame := c.Params(":reponame")
 
@@ -144,6 +149,10 @@ func GetContents(c *context.APIContext) {
 		return
 	}
 
+	if c.Repo.PathRestriction != nil {
+		entries = c.Repo.PathRestriction.FilterEntries(c.Repo.TreePath, entries)
+	}
+
 	if len(entries) == 0 {
 		c.JSONSuccess([]string{})
 		return
@@ -0,0 +1,77 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"time"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+type dependency struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Ecosystem   string `json:"ecosystem"`
+	RegistryURL string `json:"registry_url"`
+}
+
+type dependencyManifest struct {
+	Path         string        `json:"path"`
+	Ecosystem    string        `json:"ecosystem"`
+	Error        string        `json:"error,omitempty"`
+	Dependencies []*dependency `json:"dependencies"`
+}
+
+type dependencyList struct {
+	// CommitID and Scanned are empty until manifest files of the default
+	// branch have been scanned.
+	CommitID  string                `json:"commit_id"`
+	Scanned   *time.Time            `json:"scanned,omitempty"`
+	Manifests []*dependencyManifest `json:"manifests"`
+}
+
+// ListDependencies returns dependencies parsed from manifest files of the
+// default branch, grouped by manifest files.
+func ListDependencies(c *context.APIContext) {
+	scan, err := db.GetRepoDependencyScan(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetRepoDependencyScan", err)
+		return
+	}
+	manifests, err := db.GetRepoManifests(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetRepoManifests", err)
+		return
+	}
+
+	list := &dependencyList{
+		Manifests: make([]*dependencyManifest, len(manifests)),
+	}
+	if scan == nil {
+		db.AddDependencyScanTask(c.Repo.Repository.ID)
+	} else {
+		list.CommitID = scan.CommitID
+		list.Scanned = &scan.Scanned
+	}
+	for i, m := range manifests {
+		apiManifest := &dependencyManifest{
+			Path:         m.TreePath,
+			Ecosystem:    string(m.Ecosystem),
+			Error:        m.Error,
+			Dependencies: make([]*dependency, len(m.Dependencies)),
+		}
+		for j, d := range m.Dependencies {
+			apiManifest.Dependencies[j] = &dependency{
+				Name:        d.Name,
+				Version:     d.Version,
+				Ecosystem:   string(d.Ecosystem),
+				RegistryURL: d.RegistryURL(),
+			}
+		}
+		list.Manifests[i] = apiManifest
+	}
+	c.JSONSuccess(list)
+}
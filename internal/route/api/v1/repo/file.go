@@ -5,6 +5,8 @@
 package repo
 
 import (
+	"path"
+
 	"github.com/gogs/git-module"
 
 	"gogs.io/gogs/internal/context"
@@ -13,12 +15,12 @@ import (
 )
 
 func GetRawFile(c *context.APIContext) {
-	if !c.Repo.HasAccess() {
+	if c.Repo.Repository.IsBare {
 		c.NotFound()
 		return
 	}
 
-	if c.Repo.Repository.IsBare {
+	if c.Repo.PathRestriction != nil && !c.Repo.PathRestriction.Allows(c.Repo.TreePath) {
 		c.NotFound()
 		return
 	}
@@ -45,15 +47,34 @@ func GetArchive(c *context.APIContext) {
 	repo.Download(c.Context)
 }
 
+// GetEditorconfig returns the .editorconfig definition of the file. Optional
+// queries "ref" and "dir" are the branch, tag or commit and the directory the
+// file is in, which default to the default branch and the root of the tree.
 func GetEditorconfig(c *context.APIContext) {
-	ec, err := c.Repo.GetEditorconfig()
+	if refName := c.Query("ref"); refName != "" {
+		ref, err := context.ResolveRef(c.Repo.GitRepo, refName, c.Repo.Repository.DefaultBranch)
+		if err == nil && ref.TreePath != "" {
+			err = context.ErrRefNotFound
+		}
+		if err != nil {
+			if err == context.ErrRefNotFound || err == context.ErrRefAmbiguous {
+				c.NotFound()
+			} else {
+				c.ServerError("ResolveRef", err)
+			}
+			return
+		}
+		c.Repo.Commit = ref.Commit
+	}
+
+	dir := c.Query("dir")
+	ec, err := c.Repo.GetEditorconfig(dir)
 	if err != nil {
 		c.NotFoundOrServerError("GetEditorconfig", git.IsErrNotExist, err)
 		return
 	}
 
-	fileName := c.Params("filename")
-	def, err := ec.GetDefinitionForFilename(fileName)
+	def, err := ec.GetDefinitionForFilename(path.Join("/", dir, c.Params("filename")))
 	if err != nil {
 		c.ServerError("GetDefinitionForFilename", err)
 		return
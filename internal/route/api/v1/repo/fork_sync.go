@@ -0,0 +1,62 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"net/http"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+type forkSyncStatus struct {
+	Branch         string `json:"branch"`
+	Upstream       string `json:"upstream"`
+	UpstreamBranch string `json:"upstream_branch"`
+	UpstreamSHA    string `json:"upstream_sha"`
+	AheadBy        int    `json:"ahead_by"`
+	BehindBy       int    `json:"behind_by"`
+}
+
+type SyncForkOption struct {
+	Branch string `json:"branch"`
+}
+
+// SyncFork fast-forwards a branch of the fork, which defaults to the default
+// branch, to its upstream branch.
+func SyncFork(c *context.APIContext, form SyncForkOption) {
+	repo := c.Repo.Repository
+	branch := form.Branch
+	if branch == "" {
+		branch = repo.DefaultBranch
+	}
+
+	status, err := db.SyncFork(c.User, repo, branch, c.PushCredential(), c.RemoteAddr())
+	if err != nil {
+		switch {
+		case db.IsErrNotFork(err):
+			c.Error(http.StatusUnprocessableEntity, "", err)
+		case errors.IsErrBranchNotExist(err):
+			c.NotFound()
+		case db.IsErrForkDiverged(err):
+			c.Error(http.StatusConflict, "", err)
+		case db.IsErrBranchPushForbidden(err):
+			c.Error(http.StatusForbidden, "", err)
+		default:
+			c.ServerError("SyncFork", err)
+		}
+		return
+	}
+
+	c.JSONSuccess(&forkSyncStatus{
+		Branch:         status.Branch,
+		Upstream:       repo.BaseRepo.FullName(),
+		UpstreamBranch: status.UpstreamBranch,
+		UpstreamSHA:    status.UpstreamCommitID,
+		AheadBy:        status.Ahead,
+		BehindBy:       status.Behind,
+	})
+}
@@ -67,6 +67,7 @@ func CreateHook(c *context.APIContext, form api.CreateHookOption) {
 				IssueComment: com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_ISSUE_COMMENT)),
 				PullRequest:  com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_PULL_REQUEST)),
 				Release:      com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_RELEASE)),
+				Repository:   com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_REPOSITORY)),
 			},
 		},
 		IsActive:     form.Active,
@@ -158,6 +159,7 @@ func EditHook(c *context.APIContext, form api.EditHookOption) {
 	w.IssueComment = com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_ISSUE_COMMENT))
 	w.PullRequest = com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_PULL_REQUEST))
 	w.Release = com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_RELEASE))
+	w.Repository = com.IsSliceContainsStr(form.Events, string(db.HOOK_EVENT_REPOSITORY))
 	if err = w.UpdateEvent(); err != nil {
 		c.Error(500, "UpdateEvent", err)
 		return
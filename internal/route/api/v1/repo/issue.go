@@ -9,14 +9,28 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/gogs/git-module"
 	api "github.com/gogs/go-gogs-client"
 
+	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/context"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/db/errors"
-	"gogs.io/gogs/internal/conf"
 )
 
+// apiIssue is api.Issue with the reason the issue is closed for.
+type apiIssue struct {
+	*api.Issue
+	CloseReason db.IssueCloseReason `json:"close_reason,omitempty"`
+}
+
+func toAPIIssue(issue *db.Issue) *apiIssue {
+	return &apiIssue{
+		Issue:       issue.APIFormat(),
+		CloseReason: issue.CloseReason,
+	}
+}
+
 func listIssues(c *context.APIContext, opts *db.IssuesOptions) {
 	issues, err := db.Issues(opts)
 	if err != nil {
@@ -31,13 +45,13 @@ func listIssues(c *context.APIContext, opts *db.IssuesOptions) {
 	}
 
 	// FIXME: use IssueList to improve performance.
-	apiIssues := make([]*api.Issue, len(issues))
+	apiIssues := make([]*apiIssue, len(issues))
 	for i := range issues {
 		if err = issues[i].LoadAttributes(); err != nil {
 			c.ServerError("LoadAttributes", err)
 			return
 		}
-		apiIssues[i] = issues[i].APIFormat()
+		apiIssues[i] = toAPIIssue(issues[i])
 	}
 
 	c.SetLinkHeader(int(count), conf.UI.IssuePagingNum)
@@ -56,9 +70,10 @@ func ListUserIssues(c *context.APIContext) {
 
 func ListIssues(c *context.APIContext) {
 	opts := db.IssuesOptions{
-		RepoID:   c.Repo.Repository.ID,
-		Page:     c.QueryInt("page"),
-		IsClosed: api.StateType(c.Query("state")) == api.STATE_CLOSED,
+		RepoID:      c.Repo.Repository.ID,
+		Page:        c.QueryInt("page"),
+		IsClosed:    api.StateType(c.Query("state")) == api.STATE_CLOSED,
+		CloseReason: db.IssueCloseReason(c.Query("close_reason")),
 	}
 
 	listIssues(c, &opts)
@@ -70,10 +85,66 @@ func GetIssue(c *context.APIContext) {
 		c.NotFoundOrServerError("GetIssueByIndex", errors.IsIssueNotExist, err)
 		return
 	}
-	c.JSONSuccess(issue.APIFormat())
+	c.JSONSuccess(toAPIIssue(issue))
+}
+
+// CreateIssueOption is api.CreateIssueOption with an optional issue form, whose
+// field values are rendered into the body.
+type CreateIssueOption struct {
+	Title     string  `json:"title" binding:"Required"`
+	Body      string  `json:"body"`
+	Assignee  string  `json:"assignee"`
+	Milestone int64   `json:"milestone"`
+	Labels    []int64 `json:"labels"`
+	Closed    bool    `json:"closed"`
+	// CloseReason is the reason of closing the issue when it is created closed,
+	// default is completed.
+	CloseReason string `json:"close_reason"`
+	// Template is the file name of the issue form in the default branch.
+	Template string             `json:"template"`
+	Fields   db.IssueFormValues `json:"fields"`
+}
+
+// getIssueForm returns the valid issue form with given file name in the
+// default branch, it responds with 422 and returns nil otherwise.
+func getIssueForm(c *context.APIContext, filename string) *db.IssueForm {
+	if c.Repo.Repository.IsBare {
+		c.Error(http.StatusUnprocessableEntity, "", db.ErrIssueFormNotExist{Filename: filename})
+		return nil
+	}
+
+	gitRepo, err := git.OpenRepository(c.Repo.Repository.RepoPath())
+	if err != nil {
+		c.ServerError("open repository", err)
+		return nil
+	}
+	commit, err := gitRepo.GetBranchCommit(c.Repo.Repository.DefaultBranch)
+	if err != nil {
+		c.ServerError("get default branch commit", err)
+		return nil
+	}
+
+	f, err := db.GetIssueForm(commit, filename)
+	if err != nil {
+		if db.IsErrIssueFormNotExist(err) {
+			c.Error(http.StatusUnprocessableEntity, "", err)
+		} else {
+			c.ServerError("GetIssueForm", err)
+		}
+		return nil
+	} else if !f.IsValid() {
+		c.Error(http.StatusUnprocessableEntity, "", fmt.Sprintf("issue form %q is invalid: %s", filename, strings.Join(f.Errors, "; ")))
+		return nil
+	}
+	return f
 }
 
-func CreateIssue(c *context.APIContext, form api.CreateIssueOption) {
+func CreateIssue(c *context.APIContext, form CreateIssueOption) {
+	if form.Closed && form.CloseReason != "" && !c.Repo.Repository.IsValidIssueCloseReason(db.IssueCloseReason(form.CloseReason)) {
+		c.Error(http.StatusUnprocessableEntity, "", "invalid close reason")
+		return
+	}
+
 	issue := &db.Issue{
 		RepoID:   c.Repo.Repository.ID,
 		Title:    form.Title,
@@ -82,6 +153,18 @@ func CreateIssue(c *context.APIContext, form api.CreateIssueOption) {
 		Content:  form.Body,
 	}
 
+	var issueForm *db.IssueForm
+	if form.Template != "" {
+		issueForm = getIssueForm(c, form.Template)
+		if c.Written() {
+			return
+		}
+		if errs := issueForm.ValidateValues(form.Fields); len(errs) > 0 {
+			c.Error(http.StatusUnprocessableEntity, "", errs[0])
+			return
+		}
+	}
+
 	if c.Repo.IsWriter() {
 		if len(form.Assignee) > 0 {
 			assignee, err := db.GetUserByName(form.Assignee)
@@ -100,13 +183,21 @@ func CreateIssue(c *context.APIContext, form api.CreateIssueOption) {
 		form.Labels = nil
 	}
 
+	if issueForm != nil {
+		var err error
+		if form.Labels, err = issueForm.Apply(c.Repo.Repository, issue, form.Labels, form.Fields); err != nil {
+			c.ServerError("Apply", err)
+			return
+		}
+	}
+
 	if err := db.NewIssue(c.Repo.Repository, issue, form.Labels, nil); err != nil {
 		c.ServerError("NewIssue", err)
 		return
 	}
 
 	if form.Closed {
-		if err := issue.ChangeStatus(c.User, c.Repo.Repository, true); err != nil {
+		if err := issue.ChangeStatus(c.User, c.Repo.Repository, true, db.IssueCloseReason(form.CloseReason)); err != nil {
 			c.ServerError("ChangeStatus", err)
 			return
 		}
@@ -119,10 +210,22 @@ func CreateIssue(c *context.APIContext, form api.CreateIssueOption) {
 		c.ServerError("GetIssueByID", err)
 		return
 	}
-	c.JSON(http.StatusCreated, issue.APIFormat())
+	c.JSON(http.StatusCreated, toAPIIssue(issue))
+}
+
+// EditIssueOption is api.EditIssueOption with the reason of closing the issue.
+type EditIssueOption struct {
+	Title     string  `json:"title"`
+	Body      *string `json:"body"`
+	Assignee  *string `json:"assignee"`
+	Milestone *int64  `json:"milestone"`
+	State     *string `json:"state"`
+	// CloseReason is the reason of closing the issue when the state is changed
+	// to closed, default is completed.
+	CloseReason string `json:"close_reason"`
 }
 
-func EditIssue(c *context.APIContext, form api.EditIssueOption) {
+func EditIssue(c *context.APIContext, form EditIssueOption) {
 	issue, err := db.GetIssueByIndex(c.Repo.Repository.ID, c.ParamsInt64(":index"))
 	if err != nil {
 		c.NotFoundOrServerError("GetIssueByIndex", errors.IsIssueNotExist, err)
@@ -133,6 +236,10 @@ func EditIssue(c *context.APIContext, form api.EditIssueOption) {
 		c.Status(http.StatusForbidden)
 		return
 	}
+	if form.CloseReason != "" && !issue.IsPull && !c.Repo.Repository.IsValidIssueCloseReason(db.IssueCloseReason(form.CloseReason)) {
+		c.Error(http.StatusUnprocessableEntity, "", "invalid close reason")
+		return
+	}
 
 	if len(form.Title) > 0 {
 		issue.Title = form.Title
@@ -178,7 +285,7 @@ func EditIssue(c *context.APIContext, form api.EditIssueOption) {
 		return
 	}
 	if form.State != nil {
-		if err = issue.ChangeStatus(c.User, c.Repo.Repository, api.STATE_CLOSED == api.StateType(*form.State)); err != nil {
+		if err = issue.ChangeStatus(c.User, c.Repo.Repository, api.STATE_CLOSED == api.StateType(*form.State), db.IssueCloseReason(form.CloseReason)); err != nil {
 			c.ServerError("ChangeStatus", err)
 			return
 		}
@@ -190,5 +297,5 @@ func EditIssue(c *context.APIContext, form api.EditIssueOption) {
 		c.ServerError("GetIssueByID", err)
 		return
 	}
-	c.JSON(http.StatusCreated, issue.APIFormat())
+	c.JSON(http.StatusCreated, toAPIIssue(issue))
 }
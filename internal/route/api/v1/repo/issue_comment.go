@@ -75,6 +75,14 @@ func CreateIssueComment(c *context.APIContext, form api.CreateIssueCommentOption
 		return
 	}
 
+	if c.User.IsBot() && !issue.IsPull {
+		c.Error(http.StatusForbidden, "", "bot can only comment on pull requests")
+		return
+	} else if issue.IsLocked && !c.Repo.IsWriter() {
+		c.Error(http.StatusForbidden, "", "issue is locked")
+		return
+	}
+
 	comment, err := db.CreateIssueComment(c.User, c.Repo.Repository, issue, form.Body, nil)
 	if err != nil {
 		c.ServerError("CreateIssueComment", err)
@@ -0,0 +1,107 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"net/http"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+type LockIssueOption struct {
+	LockReason string `json:"lock_reason"`
+}
+
+// https://developer.github.com/v3/issues/#lock-an-issue
+func LockIssue(c *context.APIContext, form LockIssueOption) {
+	issue, err := db.GetIssueByIndex(c.Repo.Repository.ID, c.ParamsInt64(":index"))
+	if err != nil {
+		c.NotFoundOrServerError("GetIssueByIndex", errors.IsIssueNotExist, err)
+		return
+	}
+
+	if !db.IsValidIssueLockReason(form.LockReason) {
+		c.Error(http.StatusUnprocessableEntity, "", "invalid lock reason")
+		return
+	}
+	if err = issue.Lock(form.LockReason); err != nil {
+		c.ServerError("Lock", err)
+		return
+	}
+	c.NoContent()
+}
+
+// https://developer.github.com/v3/issues/#unlock-an-issue
+func UnlockIssue(c *context.APIContext) {
+	issue, err := db.GetIssueByIndex(c.Repo.Repository.ID, c.ParamsInt64(":index"))
+	if err != nil {
+		c.NotFoundOrServerError("GetIssueByIndex", errors.IsIssueNotExist, err)
+		return
+	}
+
+	if err = issue.Unlock(); err != nil {
+		c.ServerError("Unlock", err)
+		return
+	}
+	c.NoContent()
+}
+
+type issueAutoLock struct {
+	AfterDays   int    `json:"after_days"`
+	ExemptLabel string `json:"exempt_label"`
+	Comment     string `json:"comment"`
+}
+
+type IssueAutoLockOption struct {
+	AfterDays   int    `json:"after_days" binding:"Required"`
+	ExemptLabel string `json:"exempt_label" binding:"MaxSize(50)"`
+	Comment     string `json:"comment"`
+}
+
+func GetIssueAutoLock(c *context.APIContext) {
+	l, err := db.GetIssueAutoLock(c.Repo.Repository.ID)
+	if err != nil {
+		c.NotFoundOrServerError("GetIssueAutoLock", db.IsErrIssueAutoLockNotExist, err)
+		return
+	}
+	c.JSONSuccess(&issueAutoLock{
+		AfterDays:   l.AfterDays,
+		ExemptLabel: l.ExemptLabel,
+		Comment:     l.Comment,
+	})
+}
+
+func EditIssueAutoLock(c *context.APIContext, form IssueAutoLockOption) {
+	l := &db.IssueAutoLock{
+		RepoID:      c.Repo.Repository.ID,
+		AfterDays:   form.AfterDays,
+		ExemptLabel: form.ExemptLabel,
+		Comment:     form.Comment,
+		DoerID:      c.User.ID,
+	}
+	if err := db.UpdateIssueAutoLock(l); err != nil {
+		if db.IsErrInvalidIssueAutoLock(err) {
+			c.Error(http.StatusUnprocessableEntity, "", err)
+		} else {
+			c.ServerError("UpdateIssueAutoLock", err)
+		}
+		return
+	}
+	c.JSONSuccess(&issueAutoLock{
+		AfterDays:   l.AfterDays,
+		ExemptLabel: l.ExemptLabel,
+		Comment:     l.Comment,
+	})
+}
+
+func DeleteIssueAutoLock(c *context.APIContext) {
+	if err := db.DeleteIssueAutoLock(c.Repo.Repository.ID); err != nil {
+		c.ServerError("DeleteIssueAutoLock", err)
+		return
+	}
+	c.NoContent()
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"net/http"
+	"time"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+type pathWatch struct {
+	ID                int64     `json:"id"`
+	UserID            int64     `json:"user_id"`
+	Pattern           string    `json:"pattern"`
+	DefaultBranchOnly bool      `json:"default_branch_only"`
+	Created           time.Time `json:"created_at"`
+}
+
+func toPathWatch(w *db.PathWatch) *pathWatch {
+	return &pathWatch{
+		ID:                w.ID,
+		UserID:            w.UserID,
+		Pattern:           w.Pattern,
+		DefaultBranchOnly: w.DefaultBranchOnly,
+		Created:           w.Created,
+	}
+}
+
+type CreatePathWatchOption struct {
+	Pattern           string `json:"pattern" binding:"Required;MaxSize(255)"`
+	DefaultBranchOnly bool   `json:"default_branch_only"`
+	// Username is the user to watch the paths for, only repository admins may
+	// set it to someone other than themselves.
+	Username string `json:"username"`
+}
+
+// ListPathWatches returns path watches of the context user, or of all users
+// if the context user is an admin of the repository.
+func ListPathWatches(c *context.APIContext) {
+	var watches []*db.PathWatch
+	var err error
+	if c.Repo.IsAdmin() {
+		watches, err = db.GetPathWatchesOfRepo(c.Repo.Repository.ID)
+	} else {
+		watches, err = db.GetPathWatches(c.User.ID, c.Repo.Repository.ID)
+	}
+	if err != nil {
+		c.ServerError("GetPathWatches", err)
+		return
+	}
+
+	apiWatches := make([]*pathWatch, len(watches))
+	for i := range watches {
+		apiWatches[i] = toPathWatch(watches[i])
+	}
+	c.JSONSuccess(&apiWatches)
+}
+
+func CreatePathWatch(c *context.APIContext, form CreatePathWatchOption) {
+	u := c.User
+	if form.Username != "" && form.Username != c.User.Name {
+		if !c.Repo.IsAdmin() {
+			c.Error(http.StatusForbidden, "", "only repository admins can watch paths for other users")
+			return
+		}
+
+		var err error
+		u, err = db.GetUserByName(form.Username)
+		if err != nil {
+			if errors.IsUserNotExist(err) {
+				c.Error(http.StatusUnprocessableEntity, "", err)
+			} else {
+				c.ServerError("GetUserByName", err)
+			}
+			return
+		}
+
+		has, err := db.HasAccess(u.ID, c.Repo.Repository, db.ACCESS_MODE_READ)
+		if err != nil {
+			c.ServerError("HasAccess", err)
+			return
+		} else if !has {
+			c.Error(http.StatusUnprocessableEntity, "", "user cannot read the repository")
+			return
+		}
+	}
+
+	w := &db.PathWatch{
+		UserID:            u.ID,
+		RepoID:            c.Repo.Repository.ID,
+		Pattern:           form.Pattern,
+		DefaultBranchOnly: form.DefaultBranchOnly,
+	}
+	if err := db.CreatePathWatch(w); err != nil {
+		if db.IsErrInvalidPathWatchPattern(err) ||
+			db.IsErrPathWatchAlreadyExist(err) ||
+			db.IsErrPathWatchLimitReached(err) {
+			c.Error(http.StatusUnprocessableEntity, "", err)
+		} else {
+			c.ServerError("CreatePathWatch", err)
+		}
+		return
+	}
+
+	w, err := db.GetPathWatchByID(c.Repo.Repository.ID, w.ID)
+	if err != nil {
+		c.ServerError("GetPathWatchByID", err)
+		return
+	}
+	c.JSON(http.StatusCreated, toPathWatch(w))
+}
+
+// DeletePathWatch deletes a path watch of the context user, repository admins
+// can delete path watches of any user.
+func DeletePathWatch(c *context.APIContext) {
+	w, err := db.GetPathWatchByID(c.Repo.Repository.ID, c.ParamsInt64(":id"))
+	if err != nil {
+		c.NotFoundOrServerError("GetPathWatchByID", db.IsErrPathWatchNotExist, err)
+		return
+	} else if w.UserID != c.User.ID && !c.Repo.IsAdmin() {
+		c.NotFound()
+		return
+	}
+
+	if err = db.DeletePathWatch(c.Repo.Repository.ID, w.ID); err != nil {
+		c.ServerError("DeletePathWatch", err)
+		return
+	}
+	c.NoContent()
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"net/http"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+type MergePullRequestOption struct {
+	MergeStyle string `json:"merge_style"`
+	// Message is the message of the merge commit, which is rendered by the
+	// template of the repository if empty. Required trailers are appended in
+	// both cases.
+	Message string `json:"message"`
+}
+
+type mergePullRequestResult struct {
+	Merged         bool   `json:"merged"`
+	MergedCommitID string `json:"merged_commit_id"`
+}
+
+// https://developer.github.com/v3/pulls/#merge-a-pull-request-merge-button
+func MergePullRequest(c *context.APIContext, form MergePullRequestOption) {
+	issue, err := db.GetIssueByIndex(c.Repo.Repository.ID, c.ParamsInt64(":index"))
+	if err != nil {
+		c.NotFoundOrServerError("GetIssueByIndex", errors.IsIssueNotExist, err)
+		return
+	} else if !issue.IsPull || issue.PullRequest == nil {
+		c.NotFound()
+		return
+	}
+
+	pr := issue.PullRequest
+	if issue.IsClosed || pr.HasMerged || pr.HeadRepo == nil || !pr.CanAutoMerge() {
+		c.Error(http.StatusMethodNotAllowed, "", "pull request is not mergeable")
+		return
+	}
+
+	gitRepo, err := git.OpenRepository(c.Repo.Repository.RepoPath())
+	if err != nil {
+		c.ServerError("OpenRepository", err)
+		return
+	}
+
+	mergeStyle := db.MergeStyle(form.MergeStyle)
+	switch mergeStyle {
+	case "":
+		mergeStyle = db.MERGE_STYLE_REGULAR
+	case db.MERGE_STYLE_REGULAR, db.MERGE_STYLE_REBASE:
+	default:
+		c.Error(http.StatusUnprocessableEntity, "", "unknown merge style")
+		return
+	}
+	pr.Issue = issue
+	pr.Issue.Repo = c.Repo.Repository
+	if err = pr.Merge(c.User, gitRepo, mergeStyle, form.Message, c.PushCredential(), c.RemoteAddr()); err != nil {
+		if db.IsErrOrgRuleViolation(err) || db.IsErrMergeCommitNotAllowed(err) || db.IsErrCodeOwnersApprovalRequired(err) {
+			c.Error(http.StatusMethodNotAllowed, "", err)
+			return
+		}
+		c.ServerError("Merge", err)
+		return
+	}
+
+	c.JSONSuccess(&mergePullRequestResult{
+		Merged:         true,
+		MergedCommitID: pr.MergedCommitID,
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/route/api/v1/convert"
+)
+
+type pushLog struct {
+	ID             int64     `json:"id"`
+	PusherID       int64     `json:"pusher_id"`
+	PusherName     string    `json:"pusher_name"`
+	CredentialType string    `json:"credential_type"`
+	CredentialID   int64     `json:"credential_id"`
+	CredentialName string    `json:"credential_name"`
+	RemoteAddr     string    `json:"remote_addr"`
+	IsWiki         bool      `json:"is_wiki"`
+	Ref            string    `json:"ref"`
+	Before         string    `json:"before"`
+	After          string    `json:"after"`
+	Created        time.Time `json:"created_at"`
+}
+
+// ListPushLogs lists a page of refs updated by pushes to the repository,
+// newest first, for exporting them.
+func ListPushLogs(c *context.APIContext) {
+	var since time.Time
+	if c.Query("since") != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, c.Query("since"))
+		if err != nil {
+			c.Error(http.StatusUnprocessableEntity, "", err)
+			return
+		}
+	}
+
+	pageSize := convert.ToCorrectPageSize(c.QueryInt("limit"))
+	logs, total, err := c.Repo.Repository.PushLogs(db.PushLogsOptions{
+		Since:    since,
+		Page:     c.QueryInt("page"),
+		PageSize: pageSize,
+	})
+	if err != nil {
+		c.ServerError("PushLogs", err)
+		return
+	}
+
+	results := make([]*pushLog, len(logs))
+	for i, l := range logs {
+		results[i] = &pushLog{
+			ID:             l.ID,
+			PusherID:       l.PusherID,
+			PusherName:     l.PusherName,
+			CredentialType: string(l.CredentialType),
+			CredentialID:   l.CredentialID,
+			CredentialName: l.CredentialName,
+			RemoteAddr:     l.RemoteAddr,
+			IsWiki:         l.IsWiki,
+			Ref:            l.RefName,
+			Before:         l.OldCommitID,
+			After:          l.NewCommitID,
+			Created:        l.Created,
+		}
+	}
+	c.SetLinkHeader(int(total), pageSize)
+	c.Header().Set("X-Total-Count", fmt.Sprintf("%d", total))
+	c.JSONSuccess(results)
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/route/api/v1/convert"
+)
+
+type reference struct {
+	Name     string    `json:"name"`
+	CommitID string    `json:"commit_id"`
+	Updated  time.Time `json:"updated_at"`
+}
+
+// ListRefs lists a page of branches or tags, without loading their commits.
+func ListRefs(c *context.APIContext) {
+	kind := c.QueryTrim("kind")
+	if kind == "" {
+		kind = db.REF_KIND_BRANCH
+	}
+	sort := c.QueryTrim("sort")
+	if (kind != db.REF_KIND_BRANCH && kind != db.REF_KIND_TAG) ||
+		(sort != "" && sort != db.REF_SORT_NAME && sort != db.REF_SORT_COMMITTERDATE) {
+		c.Error(http.StatusUnprocessableEntity, "", "kind must be branch or tag, and sort must be name or committerdate")
+		return
+	}
+
+	pageSize := convert.ToCorrectPageSize(c.QueryInt("limit"))
+	refs, total, err := c.Repo.Repository.ListRefs(kind, sort, c.QueryInt("page"), pageSize)
+	if err != nil {
+		c.ServerError("ListRefs", err)
+		return
+	}
+
+	results := make([]*reference, len(refs))
+	for i := range refs {
+		results[i] = &reference{
+			Name:     refs[i].Name,
+			CommitID: refs[i].CommitID,
+			Updated:  refs[i].Updated,
+		}
+	}
+	c.SetLinkHeader(total, pageSize)
+	c.Header().Set("X-Total-Count", fmt.Sprintf("%d", total))
+	c.JSONSuccess(results)
+}
+
+type resolvedRef struct {
+	RefType  string `json:"ref_type"`
+	RefName  string `json:"ref_name"`
+	CommitID string `json:"commit_id"`
+	TreePath string `json:"tree_path"`
+}
+
+// ResolveRef splits the path into the branch, tag or commit it starts with and
+// the tree path, the same way as repository pages do.
+func ResolveRef(c *context.APIContext) {
+	if c.Repo.Repository.IsBare {
+		c.NotFound()
+		return
+	}
+
+	gitRepo, err := git.OpenRepository(c.Repo.Repository.RepoPath())
+	if err != nil {
+		c.ServerError("OpenRepository", err)
+		return
+	}
+	ref, err := context.ResolveRef(gitRepo, c.Params("*"), c.Repo.Repository.DefaultBranch)
+	if err != nil {
+		if err == context.ErrRefNotFound || err == context.ErrRefAmbiguous {
+			c.NotFound()
+		} else {
+			c.ServerError("ResolveRef", err)
+		}
+		return
+	}
+
+	c.JSONSuccess(&resolvedRef{
+		RefType:  ref.Kind.String(),
+		RefName:  ref.Name,
+		CommitID: ref.Commit.ID.String(),
+		TreePath: ref.TreePath,
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const (
+	defaultReleaseStatsDays = 30
+	maxReleaseStatsDays     = 365
+)
+
+type releaseDownloadDay struct {
+	Date  string `json:"date"`
+	Count int64  `json:"count"`
+}
+
+type releaseAssetStats struct {
+	ID    int64                 `json:"id"`
+	Name  string                `json:"name"`
+	UUID  string                `json:"uuid"`
+	Total int64                 `json:"total"`
+	Daily []*releaseDownloadDay `json:"daily"`
+}
+
+type releaseStats struct {
+	ReleaseID int64                 `json:"release_id"`
+	TagName   string                `json:"tag_name"`
+	Total     int64                 `json:"total"`
+	Daily     []*releaseDownloadDay `json:"daily"`
+	Assets    []*releaseAssetStats  `json:"assets"`
+}
+
+func toReleaseDownloadDays(stats []db.DayStat) []*releaseDownloadDay {
+	days := make([]*releaseDownloadDay, len(stats))
+	for i := range stats {
+		days[i] = &releaseDownloadDay{
+			Date:  stats[i].Day.Format("2006-01-02"),
+			Count: stats[i].Total,
+		}
+	}
+	return days
+}
+
+// GetReleaseStats returns numbers of downloads of assets of the release in
+// total and in each of the recent days, which is 30 days unless the "days"
+// query is given.
+func GetReleaseStats(c *context.APIContext) {
+	rel, err := db.GetReleaseByID(c.ParamsInt64(":id"))
+	if err != nil {
+		c.NotFoundOrServerError("get release", db.IsErrReleaseNotExist, err)
+		return
+	} else if rel.RepoID != c.Repo.Repository.ID {
+		c.NotFound()
+		return
+	}
+
+	days := c.QueryInt("days")
+	if days <= 0 {
+		days = defaultReleaseStatsDays
+	} else if days > maxReleaseStatsDays {
+		days = maxReleaseStatsDays
+	}
+
+	stats, err := rel.DownloadStats(days)
+	if err != nil {
+		c.ServerError("get download stats", err)
+		return
+	}
+
+	assets := make([]*releaseAssetStats, len(stats.Assets))
+	for i, asset := range stats.Assets {
+		assets[i] = &releaseAssetStats{
+			ID:    asset.Attachment.ID,
+			Name:  asset.Attachment.Name,
+			UUID:  asset.Attachment.UUID,
+			Total: asset.Total,
+			Daily: toReleaseDownloadDays(asset.Days),
+		}
+	}
+	c.JSONSuccess(&releaseStats{
+		ReleaseID: rel.ID,
+		TagName:   rel.TagName,
+		Total:     stats.Total,
+		Daily:     toReleaseDownloadDays(stats.Days),
+		Assets:    assets,
+	})
+}
@@ -9,11 +9,11 @@ import (
 	"net/http"
 	"path"
 
+	"github.com/gogs/git-module"
 	log "unknwon.dev/clog/v2"
 
 	api "github.com/gogs/go-gogs-client"
 
-	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/context"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/db/errors"
@@ -155,7 +155,32 @@ func ListOrgRepositories(c *context.APIContext) {
 	listUserRepositories(c, c.Params(":org"))
 }
 
-func CreateUserRepo(c *context.APIContext, owner *db.User, opt api.CreateRepoOption) {
+// CreateRepoOption is api.CreateRepoOption with a document of repository
+// settings to be applied to the new repository.
+type CreateRepoOption struct {
+	api.CreateRepoOption
+	SettingsYAML    string            `json:"settings_yaml"`
+	SettingsSecrets map[string]string `json:"settings_secrets"`
+}
+
+func CreateUserRepo(c *context.APIContext, owner *db.User, opt CreateRepoOption) {
+	// Check the document before creating the repository, so a repository is
+	// never left half-configured because of an invalid document.
+	var settings *db.RepoSettings
+	if opt.SettingsYAML != "" {
+		var err error
+		settings, err = db.ParseRepoSettings([]byte(opt.SettingsYAML))
+		if err != nil {
+			c.Error(http.StatusUnprocessableEntity, "", err)
+			return
+		}
+
+		if missing := settings.MissingSecrets(opt.SettingsSecrets); len(missing) > 0 {
+			c.Error(http.StatusUnprocessableEntity, "", db.ErrRepoSettingsMissingSecrets{Names: missing})
+			return
+		}
+	}
+
 	repo, err := db.CreateRepository(c.User, owner, db.CreateRepoOptions{
 		Name:        opt.Name,
 		Description: opt.Description,
@@ -166,7 +191,10 @@ func CreateUserRepo(c *context.APIContext, owner *db.User, opt api.CreateRepoOpt
 		AutoInit:    opt.AutoInit,
 	})
 	if err != nil {
-		if db.IsErrRepoAlreadyExist(err) ||
+		if db.IsErrRepoCreationForbidden(err) {
+			c.Error(http.StatusForbidden, "", err)
+		} else if errors.IsReachLimitOfRepo(err) ||
+			db.IsErrRepoAlreadyExist(err) ||
 			db.IsErrNameReserved(err) ||
 			db.IsErrNamePatternNotAllowed(err) {
 			c.Error(http.StatusUnprocessableEntity, "", err)
@@ -181,10 +209,20 @@ func CreateUserRepo(c *context.APIContext, owner *db.User, opt api.CreateRepoOpt
 		return
 	}
 
+	if settings != nil {
+		if _, err = db.ImportRepoSettings(repo, settings, opt.SettingsSecrets, false); err != nil {
+			if err := db.DeleteRepository(c.User.ID, repo.ID); err != nil {
+				log.Error("DeleteRepository: %v", err)
+			}
+			c.ServerError("ImportRepoSettings", err)
+			return
+		}
+	}
+
 	c.JSON(201, repo.APIFormat(&api.Permission{Admin: true, Push: true, Pull: true}))
 }
 
-func Create(c *context.APIContext, opt api.CreateRepoOption) {
+func Create(c *context.APIContext, opt CreateRepoOption) {
 	// Shouldn't reach this condition, but just in case.
 	if c.User.IsOrganization() {
 		c.Error(http.StatusUnprocessableEntity, "", "not allowed creating repository for organization")
@@ -193,7 +231,7 @@ func Create(c *context.APIContext, opt api.CreateRepoOption) {
 	CreateUserRepo(c, c.User, opt)
 }
 
-func CreateOrgRepo(c *context.APIContext, opt api.CreateRepoOption) {
+func CreateOrgRepo(c *context.APIContext, opt CreateRepoOption) {
 	org, err := db.GetOrgByName(c.Params(":org"))
 	if err != nil {
 		c.NotFoundOrServerError("GetOrgByName", errors.IsUserNotExist, err)
@@ -263,7 +301,7 @@ func Migrate(c *context.APIContext, f form.MigrateRepo) {
 	repo, err := db.MigrateRepository(c.User, ctxUser, db.MigrateRepoOptions{
 		Name:        f.RepoName,
 		Description: f.Description,
-		IsPrivate:   f.Private || conf.Repository.ForcePrivate,
+		IsPrivate:   f.Private,
 		IsMirror:    f.Mirror,
 		RemoteAddr:  remoteAddr,
 	})
@@ -274,7 +312,9 @@ func Migrate(c *context.APIContext, f form.MigrateRepo) {
 			}
 		}
 
-		if errors.IsReachLimitOfRepo(err) {
+		if db.IsErrRepoCreationForbidden(err) {
+			c.Error(http.StatusForbidden, "", err)
+		} else if errors.IsReachLimitOfRepo(err) {
 			c.Error(http.StatusUnprocessableEntity, "", err)
 		} else {
 			c.ServerError("MigrateRepository", errors.New(db.HandleMirrorCredentials(err.Error(), true)))
@@ -286,45 +326,71 @@ func Migrate(c *context.APIContext, f form.MigrateRepo) {
 	c.JSON(201, repo.APIFormat(&api.Permission{Admin: true, Push: true, Pull: true}))
 }
 
-// FIXME: inject in the handler chain
-func parseOwnerAndRepo(c *context.APIContext) (*db.User, *db.Repository) {
-	owner, err := db.GetUserByName(c.Params(":username"))
-	if err != nil {
-		if errors.IsUserNotExist(err) {
-			c.Error(http.StatusUnprocessableEntity, "", err)
-		} else {
-			c.ServerError("GetUserByName", err)
-		}
-		return nil, nil
+// repository is a repository with the protocol to clone it resolved for the
+// caller.
+type repository struct {
+	*api.Repository
+	DefaultCloneProtocol string `json:"default_clone_protocol"`
+}
+
+func Get(c *context.APIContext) {
+	repo := c.Repo.Repository
+
+	// The stored default branch could be stale, report the one that exists.
+	if _, err := repo.EffectiveDefaultBranch(); err != nil {
+		log.Error("EffectiveDefaultBranch [repo_id: %d]: %v", repo.ID, err)
 	}
 
-	repo, err := db.GetRepositoryByName(owner.ID, c.Params(":reponame"))
+	cloneProtocols, err := db.ResolveCloneProtocols(c.User)
 	if err != nil {
-		c.NotFoundOrServerError("GetRepositoryByName", errors.IsRepoNotExist, err)
-		return nil, nil
+		c.ServerError("ResolveCloneProtocols", err)
+		return
 	}
 
-	return owner, repo
+	c.JSONSuccess(&repository{
+		Repository: repo.APIFormat(&api.Permission{
+			Admin: c.Repo.IsAdmin(),
+			Push:  c.Repo.IsWriter(),
+			Pull:  true,
+		}),
+		DefaultCloneProtocol: cloneProtocols.Default,
+	})
 }
 
-func Get(c *context.APIContext) {
-	_, repo := parseOwnerAndRepo(c)
-	if c.Written() {
-		return
+// EditRepoOption is the options to edit a repository. Fields that are not set
+// are left unchanged.
+type EditRepoOption struct {
+	DefaultBranch *string `json:"default_branch"`
+}
+
+func Edit(c *context.APIContext, form EditRepoOption) {
+	repo := c.Repo.Repository
+
+	if form.DefaultBranch != nil && *form.DefaultBranch != repo.DefaultBranch {
+		gitRepo, err := git.OpenRepository(repo.RepoPath())
+		if err != nil {
+			c.ServerError("OpenRepository", err)
+			return
+		}
+		if err = repo.UpdateDefaultBranch(gitRepo, *form.DefaultBranch); err != nil {
+			if errors.IsErrBranchNotExist(err) {
+				c.Error(http.StatusUnprocessableEntity, "", err)
+			} else {
+				c.ServerError("UpdateDefaultBranch", err)
+			}
+			return
+		}
 	}
 
 	c.JSONSuccess(repo.APIFormat(&api.Permission{
-		Admin: c.Repo.IsAdmin(),
-		Push:  c.Repo.IsWriter(),
+		Admin: true,
+		Push:  true,
 		Pull:  true,
 	}))
 }
 
 func Delete(c *context.APIContext) {
-	owner, repo := parseOwnerAndRepo(c)
-	if c.Written() {
-		return
-	}
+	owner, repo := c.Repo.Owner, c.Repo.Repository
 
 	if owner.IsOrganization() && !owner.IsOwnedBy(c.User.ID) {
 		c.Error(http.StatusForbidden, "", "given user is not owner of organization")
@@ -364,10 +430,7 @@ func ListForks(c *context.APIContext) {
 }
 
 func IssueTracker(c *context.APIContext, form api.EditIssueTrackerOption) {
-	_, repo := parseOwnerAndRepo(c)
-	if c.Written() {
-		return
-	}
+	repo := c.Repo.Repository
 
 	if form.EnableIssues != nil {
 		repo.EnableIssues = *form.EnableIssues
@@ -394,10 +457,8 @@ func IssueTracker(c *context.APIContext, form api.EditIssueTrackerOption) {
 }
 
 func MirrorSync(c *context.APIContext) {
-	_, repo := parseOwnerAndRepo(c)
-	if c.Written() {
-		return
-	} else if !repo.IsMirror {
+	repo := c.Repo.Repository
+	if !repo.IsMirror {
 		c.NotFound()
 		return
 	}
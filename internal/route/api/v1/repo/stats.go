@@ -0,0 +1,122 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const statsMaxAge = 300 // In seconds
+
+// commitStats returns commit stats of the default branch, and adds the
+// repository to the aggregation queue if they have never been aggregated or are
+// of a previous owner. It responds with 202 and returns nil while the first
+// aggregation is in progress. Stats of empty repositories have no week.
+func commitStats(c *context.APIContext) *db.RepoCommitStats {
+	if c.Repo.Repository.IsBare {
+		return &db.RepoCommitStats{}
+	}
+
+	stats, err := db.GetRepoCommitStats(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetRepoCommitStats", err)
+		return nil
+	}
+	if stats == nil || stats.OwnerID != c.Repo.Repository.OwnerID {
+		db.AddCommitStatsTask(c.Repo.Repository.ID)
+	}
+	if stats == nil {
+		c.JSON(http.StatusAccepted, struct{}{})
+		return nil
+	}
+	return stats
+}
+
+// serveStats writes the stats in JSON. Like badges, the ETag is computed from
+// the document, so dashboards can revalidate cheaply.
+func serveStats(c *context.APIContext, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		c.ServerError("Marshal", err)
+		return
+	}
+
+	cacheControl := "public"
+	if c.Repo.Repository.IsPrivate {
+		cacheControl = "private"
+	}
+	etag := fmt.Sprintf(`"%x"`, sha1.Sum(data))
+	c.Header().Set("Cache-Control", fmt.Sprintf("%s, max-age=%d", cacheControl, statsMaxAge))
+	c.Header().Set("ETag", etag)
+	if c.Req.Header.Get("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Header().Set("Content-Type", "application/json; charset=utf-8")
+	c.Status(http.StatusOK)
+	_, _ = c.Write(data)
+}
+
+// GetCodeFrequency returns weekly additions and deletions of the default
+// branch as [week, additions, -deletions], in the same shape as GitHub.
+func GetCodeFrequency(c *context.APIContext) {
+	stats := commitStats(c)
+	if stats == nil {
+		return
+	}
+
+	weeks := stats.ContinuousWeeks()
+	frequency := make([][3]int64, len(weeks))
+	for i, w := range weeks {
+		frequency[i] = [3]int64{w.Week, w.Additions, -w.Deletions}
+	}
+	serveStats(c, frequency)
+}
+
+type participation struct {
+	All   [52]int64 `json:"all"`
+	Owner [52]int64 `json:"owner"`
+}
+
+// GetParticipation returns weekly commit counts of the default branch of all
+// authors and of the owner in the last 52 weeks, the oldest first.
+func GetParticipation(c *context.APIContext) {
+	stats := commitStats(c)
+	if stats == nil {
+		return
+	}
+
+	var p participation
+	p.All, p.Owner = stats.Participation(time.Now())
+	serveStats(c, &p)
+}
+
+// GetPunchCard returns commit counts of the default branch as
+// [day, hour, commits] by day of week (Sunday first) and hour of day in time
+// zones of authors. It is empty if there is no commit.
+func GetPunchCard(c *context.APIContext) {
+	stats := commitStats(c)
+	if stats == nil {
+		return
+	}
+
+	punchCard := make([][3]int64, 0, 7*24)
+	if len(stats.Weeks) > 0 {
+		for day := range stats.PunchCard {
+			for hour, commits := range stats.PunchCard[day] {
+				punchCard = append(punchCard, [3]int64{int64(day), int64(hour), commits})
+			}
+		}
+	}
+	serveStats(c, punchCard)
+}
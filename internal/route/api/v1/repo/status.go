@@ -0,0 +1,142 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gogs/git-module"
+	api "github.com/gogs/go-gogs-client"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+type commitStatus struct {
+	ID           int64     `json:"id"`
+	State        string    `json:"state"`
+	TargetURL    string    `json:"target_url"`
+	Description  string    `json:"description"`
+	Context      string    `json:"context"`
+	Creator      *api.User `json:"creator"`
+	CreatorIsBot bool      `json:"creator_is_bot"`
+	Created      time.Time `json:"created_at"`
+}
+
+type combinedCommitStatus struct {
+	State      string          `json:"state"`
+	SHA        string          `json:"sha"`
+	TotalCount int             `json:"total_count"`
+	Statuses   []*commitStatus `json:"statuses"`
+}
+
+type CreateStatusOption struct {
+	State       string `json:"state" binding:"Required"`
+	TargetURL   string `json:"target_url" binding:"Url"`
+	Description string `json:"description"`
+	Context     string `json:"context"`
+}
+
+func toCommitStatus(s *db.CommitStatus) (*commitStatus, error) {
+	if err := s.LoadAttributes(); err != nil {
+		return nil, err
+	}
+	return &commitStatus{
+		ID:           s.ID,
+		State:        string(s.State),
+		TargetURL:    s.TargetURL,
+		Description:  s.Description,
+		Context:      s.Context,
+		Creator:      s.Creator.APIFormat(),
+		CreatorIsBot: s.Creator.IsBot(),
+		Created:      s.Created,
+	}, nil
+}
+
+// https://developer.github.com/v3/repos/statuses/#create-a-status
+func CreateStatus(c *context.APIContext, form CreateStatusOption) {
+	gitRepo, err := git.OpenRepository(c.Repo.Repository.RepoPath())
+	if err != nil {
+		c.ServerError("OpenRepository", err)
+		return
+	}
+	commit, err := gitRepo.GetCommit(c.Params(":sha"))
+	if err != nil {
+		c.NotFoundOrServerError("GetCommit", git.IsErrNotExist, err)
+		return
+	}
+
+	status := &db.CommitStatus{
+		State:       db.CommitStatusState(form.State),
+		TargetURL:   form.TargetURL,
+		Description: form.Description,
+		Context:     form.Context,
+	}
+	if err = db.CreateCommitStatus(c.Repo.Repository.ID, c.User.ID, commit.ID.String(), status); err != nil {
+		if db.IsErrInvalidCommitStatusState(err) {
+			c.Error(http.StatusUnprocessableEntity, "", err)
+		} else {
+			c.ServerError("CreateCommitStatus", err)
+		}
+		return
+	}
+
+	apiStatus, err := toCommitStatus(status)
+	if err != nil {
+		c.ServerError("toCommitStatus", err)
+		return
+	}
+	c.JSON(http.StatusCreated, apiStatus)
+}
+
+// https://developer.github.com/v3/repos/statuses/#get-the-combined-status-for-a-specific-ref
+func GetCombinedStatus(c *context.APIContext) {
+	gitRepo, err := git.OpenRepository(c.Repo.Repository.RepoPath())
+	if err != nil {
+		c.ServerError("OpenRepository", err)
+		return
+	}
+
+	// The ref could be a branch, a tag or a commit SHA.
+	ref := c.Params(":sha")
+	var commit *git.Commit
+	if gitRepo.IsBranchExist(ref) {
+		commit, err = gitRepo.GetBranchCommit(ref)
+	} else if gitRepo.IsTagExist(ref) {
+		commit, err = gitRepo.GetTagCommit(ref)
+	} else {
+		commit, err = gitRepo.GetCommit(ref)
+	}
+	if err != nil {
+		c.NotFoundOrServerError("get commit", git.IsErrNotExist, err)
+		return
+	}
+
+	statuses, err := db.GetLatestCommitStatuses(c.Repo.Repository.ID, commit.ID.String())
+	if err != nil {
+		c.ServerError("GetLatestCommitStatuses", err)
+		return
+	}
+
+	combined := &combinedCommitStatus{
+		State:      string(db.CombinedCommitStatusState(statuses)),
+		SHA:        commit.ID.String(),
+		TotalCount: len(statuses),
+		Statuses:   make([]*commitStatus, len(statuses)),
+	}
+	// Stay compatible with GitHub, which reports pending when there is no status.
+	if combined.State == "" {
+		combined.State = string(db.COMMIT_STATUS_PENDING)
+	}
+	for i := range statuses {
+		combined.Statuses[i], err = toCommitStatus(statuses[i])
+		if err != nil {
+			c.ServerError("toCommitStatus", err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, combined)
+}
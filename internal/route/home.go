@@ -6,6 +6,8 @@ package route
 
 import (
 	"github.com/unknwon/paginater"
+	log "unknwon.dev/clog/v2"
+
 	user2 "gogs.io/gogs/internal/route/user"
 
 	"gogs.io/gogs/internal/conf"
@@ -20,6 +22,10 @@ const (
 	EXPLORE_ORGANIZATIONS = "explore/organizations"
 )
 
+// maxRecommendedRepos is the number of repositories recommended to the user on
+// the explore page.
+const maxRecommendedRepos = 5
+
 func Home(c *context.Context) {
 	if c.IsLogged {
 		if !c.User.IsActive && conf.Auth.RequireEmailConfirmation {
@@ -39,6 +45,7 @@ func Home(c *context.Context) {
 	}
 
 	c.Data["PageIsHome"] = true
+	c.SetHomeNotice()
 	c.Success(HOME)
 }
 
@@ -74,6 +81,14 @@ func ExploreRepos(c *context.Context) {
 	}
 	c.Data["Repos"] = repos
 
+	if c.IsLogged && conf.Repository.Recommendations.EnablePersonalized && page == 1 && keyword == "" {
+		recommended, err := db.RecommendRepositories(c.User, maxRecommendedRepos)
+		if err != nil {
+			log.Error("Failed to recommend repositories to user [%d]: %v", c.User.ID, err)
+		}
+		c.Data["RecommendedRepos"] = recommended
+	}
+
 	c.Success(EXPLORE_REPOS)
 }
 
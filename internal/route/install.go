@@ -53,6 +53,8 @@ func GlobalInit(customConf string) error {
 	err := conf.Init(customConf)
 	if err != nil {
 		return errors.Wrap(err, "init configuration")
+	} else if err = conf.CheckCluster(); err != nil {
+		return errors.Wrap(err, "check cluster mode")
 	}
 
 	conf.InitLogging(false)
@@ -83,10 +85,22 @@ func GlobalInit(customConf string) error {
 		db.NewRepoContext()
 
 		// Booting long running goroutines.
-		cron.NewContext()
+		if !conf.Cluster.Enabled || conf.Cluster.RunScheduler {
+			cron.NewContext()
+			db.InitDeliverHooks()
+			db.InitTestPullRequests()
+			if conf.Cluster.Enabled {
+				db.InitPollClusterTasks()
+			}
+		} else {
+			log.Info("Scheduled tasks, hook deliveries and pull request tests are left to other nodes of the cluster")
+			db.InitDiscardQueues()
+		}
 		db.InitSyncMirrors()
-		db.InitDeliverHooks()
-		db.InitTestPullRequests()
+		db.InitFlushReleaseDownloads()
+		db.InitScanDependencies()
+		db.InitAggregateCommitStats()
+		db.InitFetchForkUpstreams()
 	}
 	if db.EnableSQLite3 {
 		log.Info("SQLite3 is supported")
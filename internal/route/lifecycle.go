@@ -0,0 +1,33 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package route
+
+import (
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+// KeepActive resets the inactivity clock of the user or repository that the
+// "keep active" link is sent for.
+func KeepActive(c *context.Context) {
+	d, err := db.KeepActive(c.Params(":token"))
+	if err != nil {
+		c.NotFoundOrServerError("KeepActive", db.IsErrLifecycleKeepTokenNotExist, err)
+		return
+	}
+
+	if !d.IsUser() {
+		c.Flash.Success(c.Tr("home.keep_active_repo_success", d.SubjectName))
+		c.SubURLRedirect("/" + d.SubjectName)
+		return
+	}
+
+	c.Flash.Success(c.Tr("home.keep_active_user_success", d.SubjectName))
+	if c.IsLogged {
+		c.SubURLRedirect("/user/settings")
+	} else {
+		c.SubURLRedirect("/user/login")
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package org
+
+import (
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/form"
+)
+
+const (
+	SETTINGS_BOTS = "org/settings/bots"
+	SETTINGS_BOT  = "org/settings/bot"
+)
+
+func renderBots(c *context.Context) bool {
+	bots, err := db.GetBotsByOrgID(c.Org.Organization.ID)
+	if err != nil {
+		c.ServerError("GetBotsByOrgID", err)
+		return false
+	}
+	for _, bot := range bots {
+		if err = bot.LoadBotAttributes(); err != nil {
+			c.ServerError("LoadBotAttributes", err)
+			return false
+		}
+	}
+	c.Data["Bots"] = bots
+	return true
+}
+
+func Bots(c *context.Context) {
+	c.Title("org.settings")
+	c.PageIs("SettingsBots")
+
+	if !renderBots(c) {
+		return
+	}
+	c.Success(SETTINGS_BOTS)
+}
+
+func BotsPost(c *context.Context, f form.NewBot) {
+	c.Title("org.settings")
+	c.PageIs("SettingsBots")
+
+	if !renderBots(c) {
+		return
+	}
+
+	if c.HasError() {
+		c.Success(SETTINGS_BOTS)
+		return
+	}
+
+	bot := &db.User{
+		Name:     f.BotName,
+		FullName: f.FullName,
+		Email:    f.Email,
+	}
+	if err := db.CreateBot(c.Org.Organization, bot); err != nil {
+		c.Data["Err_BotName"] = true
+		switch {
+		case db.IsErrUserAlreadyExist(err):
+			c.RenderWithErr(c.Tr("form.username_been_taken"), SETTINGS_BOTS, &f)
+		case db.IsErrNameReserved(err):
+			c.RenderWithErr(c.Tr("user.form.name_reserved", err.(db.ErrNameReserved).Name), SETTINGS_BOTS, &f)
+		case db.IsErrNamePatternNotAllowed(err):
+			c.RenderWithErr(c.Tr("user.form.name_pattern_not_allowed", err.(db.ErrNamePatternNotAllowed).Pattern), SETTINGS_BOTS, &f)
+		case db.IsErrEmailAlreadyUsed(err):
+			c.Data["Err_BotName"] = false
+			c.Data["Err_Email"] = true
+			c.RenderWithErr(c.Tr("form.email_been_used"), SETTINGS_BOTS, &f)
+		default:
+			c.ServerError("CreateBot", err)
+		}
+		return
+	}
+	log.Trace("Bot created by %s: %s/%s", c.User.Name, c.Org.Organization.Name, bot.Name)
+
+	c.Flash.Success(c.Tr("org.settings.bots.create_success", bot.Name))
+	c.Redirect(c.Org.OrgLink + "/settings/bots/" + bot.Name)
+}
+
+// parseBot returns the bot account of the organization from URL parameter.
+func parseBot(c *context.Context) *db.User {
+	bot, err := db.GetUserByName(c.Params(":botname"))
+	if err != nil {
+		c.NotFoundOrServerError("GetUserByName", errors.IsUserNotExist, err)
+		return nil
+	} else if !bot.IsBot() || bot.BotOrgID != c.Org.Organization.ID {
+		c.NotFound()
+		return nil
+	}
+	return bot
+}
+
+func renderBot(c *context.Context, bot *db.User) bool {
+	if err := bot.LoadBotAttributes(); err != nil {
+		c.ServerError("LoadBotAttributes", err)
+		return false
+	}
+	c.Data["Bot"] = bot
+
+	tokens, err := db.ListAccessTokens(bot.ID)
+	if err != nil {
+		c.ServerError("ListAccessTokens", err)
+		return false
+	}
+	c.Data["Tokens"] = tokens
+
+	org := c.Org.Organization
+	if err = org.GetRepositories(1, org.NumRepos); err != nil {
+		c.ServerError("GetRepositories", err)
+		return false
+	}
+
+	// List every repository of the organization with its grant, if any.
+	granted := make(map[int64]*db.BotGrant, len(bot.BotGrants))
+	for _, g := range bot.BotGrants {
+		granted[g.RepoID] = g
+	}
+	grants := make([]*db.BotGrant, 0, len(org.Repos))
+	for _, repo := range org.Repos {
+		g, ok := granted[repo.ID]
+		if !ok {
+			g = &db.BotGrant{
+				BotID:  bot.ID,
+				RepoID: repo.ID,
+			}
+		}
+		g.Repo = repo
+		grants = append(grants, g)
+	}
+	c.Data["Grants"] = grants
+	return true
+}
+
+func Bot(c *context.Context) {
+	c.Title("org.settings")
+	c.PageIs("SettingsBots")
+
+	bot := parseBot(c)
+	if c.Written() {
+		return
+	}
+
+	if !renderBot(c, bot) {
+		return
+	}
+	c.Success(SETTINGS_BOT)
+}
+
+func BotGrantPost(c *context.Context, f form.BotGrant) {
+	bot := parseBot(c)
+	if c.Written() {
+		return
+	}
+	botLink := c.Org.OrgLink + "/settings/bots/" + bot.Name
+
+	if c.HasError() {
+		c.Flash.Error(c.Data["ErrorMsg"].(string))
+		c.Redirect(botLink)
+		return
+	}
+
+	repo, err := db.GetRepositoryByID(f.RepoID)
+	if err != nil {
+		c.NotFoundOrServerError("GetRepositoryByID", errors.IsRepoNotExist, err)
+		return
+	} else if repo.OwnerID != c.Org.Organization.ID {
+		c.NotFound()
+		return
+	}
+
+	if err = db.UpdateBotGrant(&db.BotGrant{
+		BotID:          bot.ID,
+		RepoID:         repo.ID,
+		CanReadCode:    f.CanReadCode,
+		CanSetStatus:   f.CanSetStatus,
+		CanCommentPull: f.CanCommentPull,
+	}); err != nil {
+		c.ServerError("UpdateBotGrant", err)
+		return
+	}
+	log.Trace("Bot grant updated by %s: %s -> %s", c.User.Name, bot.Name, repo.Name)
+
+	c.Flash.Success(c.Tr("org.settings.bots.update_grant_success", repo.Name))
+	c.Redirect(botLink)
+}
+
+func BotTokenPost(c *context.Context, f form.NewAccessToken) {
+	bot := parseBot(c)
+	if c.Written() {
+		return
+	}
+	botLink := c.Org.OrgLink + "/settings/bots/" + bot.Name
+
+	if c.HasError() {
+		c.Flash.Error(c.Data["ErrorMsg"].(string))
+		c.Redirect(botLink)
+		return
+	}
+
+	t := &db.AccessToken{
+		UID:  bot.ID,
+		Name: f.Name,
+	}
+	if err := db.NewAccessToken(t); err != nil {
+		if errors.IsAccessTokenNameAlreadyExist(err) {
+			c.Flash.Error(c.Tr("settings.token_name_exists"))
+			c.Redirect(botLink)
+		} else {
+			c.ServerError("NewAccessToken", err)
+		}
+		return
+	}
+
+	c.Flash.Success(c.Tr("settings.generate_token_succees"))
+	c.Flash.Info(t.Sha1)
+	c.Redirect(botLink)
+}
+
+func DeleteBotToken(c *context.Context) {
+	bot := parseBot(c)
+	if c.Written() {
+		return
+	}
+
+	if err := db.DeleteAccessTokenOfUserByID(bot.ID, c.QueryInt64("id")); err != nil {
+		c.Flash.Error("DeleteAccessTokenOfUserByID: " + err.Error())
+	} else {
+		c.Flash.Success(c.Tr("settings.delete_token_success"))
+	}
+
+	c.JSONSuccess(map[string]interface{}{
+		"redirect": c.Org.OrgLink + "/settings/bots/" + bot.Name,
+	})
+}
+
+func DeleteBot(c *context.Context) {
+	bot, err := db.GetUserByID(c.QueryInt64("id"))
+	if err != nil {
+		c.NotFoundOrServerError("GetUserByID", errors.IsUserNotExist, err)
+		return
+	} else if !bot.IsBot() || bot.BotOrgID != c.Org.Organization.ID {
+		c.NotFound()
+		return
+	}
+
+	if err = db.DeleteBot(bot); err != nil {
+		c.Flash.Error("DeleteBot: " + err.Error())
+	} else {
+		log.Trace("Bot deleted by %s: %s/%s", c.User.Name, c.Org.Organization.Name, bot.Name)
+		c.Flash.Success(c.Tr("org.settings.bots.delete_success"))
+	}
+
+	c.JSONSuccess(map[string]interface{}{
+		"redirect": c.Org.OrgLink + "/settings/bots",
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package org
+
+import (
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const (
+	DEPENDENCIES = "org/dependencies"
+)
+
+// maxDependencyResults is the maximum number of dependencies shown for a
+// search of packages.
+const maxDependencyResults = 200
+
+// Dependencies shows repositories of the organization that depend on packages
+// matching the keyword.
+func Dependencies(c *context.Context) {
+	org := c.Org.Organization
+	c.Data["Title"] = org.FullName
+	c.Data["PageIsOrgDependencies"] = true
+
+	keyword := c.Query("q")
+	c.Data["Keyword"] = keyword
+
+	// Dependencies of repositories the user cannot read are not revealed.
+	deps, err := db.SearchOrgDependencies(org.ID, c.UserID(), keyword, maxDependencyResults)
+	if err != nil {
+		c.ServerError("SearchOrgDependencies", err)
+		return
+	}
+	c.Data["Dependencies"] = deps
+	c.Data["IsTruncated"] = len(deps) == maxDependencyResults
+
+	c.Success(DEPENDENCIES)
+}
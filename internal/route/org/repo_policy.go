@@ -0,0 +1,76 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package org
+
+import (
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+const SETTINGS_REPO_POLICY = "org/settings/repo_policy"
+
+// renderRepoPolicy sets the repository policy of the organization and the
+// bounds set by admins.
+func renderRepoPolicy(c *context.Context) bool {
+	org := c.Org.Organization
+	p, err := db.GetOrgRepoPolicy(org.ID)
+	if err != nil {
+		c.ServerError("GetOrgRepoPolicy", err)
+		return false
+	}
+	c.Data["RepoPolicy"] = p
+	c.Data["SiteForcePrivate"] = conf.Repository.ForcePrivate
+	c.Data["SiteForbidVisibilityChange"] = conf.Repository.ForbidVisibilityChange
+	c.Data["CreationLimitBound"] = org.AdminRepoCreationLimit()
+	return true
+}
+
+func RepoPolicy(c *context.Context) {
+	c.Title("org.settings")
+	c.PageIs("SettingsRepoPolicy")
+
+	if !renderRepoPolicy(c) {
+		return
+	}
+	c.Success(SETTINGS_REPO_POLICY)
+}
+
+func RepoPolicyPost(c *context.Context, f form.OrgRepoPolicy) {
+	c.Title("org.settings")
+	c.PageIs("SettingsRepoPolicy")
+
+	if !renderRepoPolicy(c) {
+		return
+	}
+
+	if c.HasError() {
+		c.Success(SETTINGS_REPO_POLICY)
+		return
+	}
+
+	org := c.Org.Organization
+	err := db.UpdateOrgRepoPolicy(org, &db.OrgRepoPolicy{
+		ForcePrivate:           f.ForcePrivate,
+		ForbidVisibilityChange: f.ForbidVisibilityChange,
+		MaxCreationLimit:       f.MaxCreationLimit,
+	})
+	if err != nil {
+		if db.IsErrRepoCreationLimitOutOfBound(err) {
+			c.Data["Err_MaxCreationLimit"] = true
+			c.RenderWithErr(c.Tr("org.settings.repo_policy.limit_out_of_bound", err.(db.ErrRepoCreationLimitOutOfBound).Bound), SETTINGS_REPO_POLICY, &f)
+		} else {
+			c.ServerError("UpdateOrgRepoPolicy", err)
+		}
+		return
+	}
+	log.Trace("Repository policy of organization updated: %s", org.Name)
+
+	c.Flash.Success(c.Tr("org.settings.repo_policy.update_success"))
+	c.Redirect(c.Org.OrgLink + "/settings/repositories")
+}
@@ -0,0 +1,187 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package org
+
+import (
+	"fmt"
+	"strings"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/form"
+)
+
+const (
+	SETTINGS_RULE_SETS     = "org/settings/rule_sets"
+	SETTINGS_RULE_SET_EDIT = "org/settings/rule_set_edit"
+)
+
+// numRuleSetAudits is the number of latest audit entries to show.
+const numRuleSetAudits = 50
+
+func RuleSets(c *context.Context) {
+	c.Title("org.settings")
+	c.PageIs("SettingsRuleSets")
+
+	orgID := c.Org.Organization.ID
+	ruleSets, err := db.GetOrgRuleSets(orgID)
+	if err != nil {
+		c.ServerError("GetOrgRuleSets", err)
+		return
+	}
+	c.Data["RuleSets"] = ruleSets
+
+	exemptions, err := db.GetOrgRuleSetExemptions(orgID)
+	if err != nil {
+		c.ServerError("GetOrgRuleSetExemptions", err)
+		return
+	}
+	c.Data["Exemptions"] = exemptions
+
+	audits, err := db.GetOrgRuleSetAudits(orgID, numRuleSetAudits)
+	if err != nil {
+		c.ServerError("GetOrgRuleSetAudits", err)
+		return
+	}
+	c.Data["Audits"] = audits
+
+	c.Success(SETTINGS_RULE_SETS)
+}
+
+// parseRuleSet returns the rule set of the organization from URL parameter.
+func parseRuleSet(c *context.Context) *db.OrgRuleSet {
+	rs, err := db.GetOrgRuleSetByID(c.Org.Organization.ID, c.ParamsInt64(":id"))
+	if err != nil {
+		c.NotFoundOrServerError("GetOrgRuleSetByID", errors.IsOrgRuleSetNotExist, err)
+		return nil
+	}
+	return rs
+}
+
+func NewRuleSet(c *context.Context) {
+	c.Title("org.settings")
+	c.PageIs("SettingsRuleSets")
+	c.PageIs("RuleSetNew")
+
+	c.Data["RuleSet"] = &db.OrgRuleSet{
+		BranchPatterns: "master",
+	}
+	c.Success(SETTINGS_RULE_SET_EDIT)
+}
+
+func EditRuleSet(c *context.Context) {
+	c.Title("org.settings")
+	c.PageIs("SettingsRuleSets")
+
+	rs := parseRuleSet(c)
+	if c.Written() {
+		return
+	}
+	c.Data["RuleSet"] = rs
+	c.Success(SETTINGS_RULE_SET_EDIT)
+}
+
+// ruleSetFieldErrors are keys of fields with errors by fields of
+// ErrOrgRuleSetInvalid.
+var ruleSetFieldErrors = map[string]string{
+	"name":                    "Err_Name",
+	"repo_patterns":           "Err_RepoPatterns",
+	"branch_patterns":         "Err_BranchPatterns",
+	"commit_message_pattern":  "Err_CommitMessagePattern",
+	"forbidden_file_patterns": "Err_ForbiddenFilePatterns",
+}
+
+// saveRuleSet creates the rule set if its ID is 0, otherwise updates it.
+func saveRuleSet(c *context.Context, f form.OrgRuleSet, rs *db.OrgRuleSet) {
+	rs.Name = strings.TrimSpace(f.Name)
+	rs.RepoPatterns = f.RepoPatterns
+	rs.BranchPatterns = f.BranchPatterns
+	rs.RequirePullRequest = f.RequirePullRequest
+	rs.CommitMessagePattern = f.CommitMessagePattern
+	rs.ForbiddenFilePatterns = f.ForbiddenFilePatterns
+	c.Data["RuleSet"] = rs
+	if c.HasError() {
+		c.Success(SETTINGS_RULE_SET_EDIT)
+		return
+	}
+
+	var err error
+	if rs.ID == 0 {
+		rs.OrgID = c.Org.Organization.ID
+		err = db.CreateOrgRuleSet(c.User, rs)
+	} else {
+		err = db.UpdateOrgRuleSet(c.User, rs)
+	}
+	if err != nil {
+		if db.IsErrOrgRuleSetInvalid(err) {
+			c.Data[ruleSetFieldErrors[err.(db.ErrOrgRuleSetInvalid).Field]] = true
+			c.RenderWithErr(c.Tr("org.settings.rule_sets.invalid", err.(db.ErrOrgRuleSetInvalid).Reason), SETTINGS_RULE_SET_EDIT, &f)
+			return
+		}
+		c.ServerError("save rule set", err)
+		return
+	}
+	log.Trace("Rule set of organization saved by %s: %s/%s", c.User.Name, c.Org.Organization.Name, rs.Name)
+
+	c.Flash.Success(c.Tr("org.settings.rule_sets.save_success", rs.Name))
+	c.Redirect(fmt.Sprintf("%s/settings/rules/%d", c.Org.OrgLink, rs.ID))
+}
+
+func NewRuleSetPost(c *context.Context, f form.OrgRuleSet) {
+	c.Title("org.settings")
+	c.PageIs("SettingsRuleSets")
+	c.PageIs("RuleSetNew")
+
+	saveRuleSet(c, f, &db.OrgRuleSet{})
+}
+
+func EditRuleSetPost(c *context.Context, f form.OrgRuleSet) {
+	c.Title("org.settings")
+	c.PageIs("SettingsRuleSets")
+
+	rs := parseRuleSet(c)
+	if c.Written() {
+		return
+	}
+	saveRuleSet(c, f, rs)
+}
+
+func DeleteRuleSet(c *context.Context) {
+	rs, err := db.GetOrgRuleSetByID(c.Org.Organization.ID, c.QueryInt64("id"))
+	if err != nil {
+		c.NotFoundOrServerError("GetOrgRuleSetByID", errors.IsOrgRuleSetNotExist, err)
+		return
+	}
+
+	if err = db.DeleteOrgRuleSet(c.User, rs); err != nil {
+		c.Flash.Error("DeleteOrgRuleSet: " + err.Error())
+	} else {
+		log.Trace("Rule set of organization deleted by %s: %s/%s", c.User.Name, c.Org.Organization.Name, rs.Name)
+		c.Flash.Success(c.Tr("org.settings.rule_sets.delete_success"))
+	}
+
+	c.JSONSuccess(map[string]interface{}{
+		"redirect": c.Org.OrgLink + "/settings/rules",
+	})
+}
+
+func ReviewRuleSetExemption(c *context.Context) {
+	approve := c.Query("action") == "approve"
+	err := db.ReviewOrgRuleSetExemption(c.User, c.Org.Organization.ID, c.ParamsInt64(":id"), approve)
+	if err != nil {
+		c.NotFoundOrServerError("ReviewOrgRuleSetExemption", errors.IsOrgRuleSetExemptionNotExist, err)
+		return
+	}
+
+	if approve {
+		c.Flash.Success(c.Tr("org.settings.rule_sets.exemption_approved"))
+	} else {
+		c.Flash.Success(c.Tr("org.settings.rule_sets.exemption_rejected"))
+	}
+	c.Redirect(c.Org.OrgLink + "/settings/rules")
+}
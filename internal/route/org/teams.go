@@ -82,6 +82,13 @@ func TeamsAction(c *context.Context) {
 			return
 		}
 
+		// Bots are granted capabilities by the organization instead.
+		if u.IsBot() {
+			c.Flash.Error(c.Tr("org.teams.bot_not_allowed"))
+			c.Redirect(c.Org.OrgLink + "/teams/" + c.Org.Team.LowerName)
+			return
+		}
+
 		err = c.Org.Team.AddMember(u.ID)
 		page = "team"
 	}
@@ -0,0 +1,131 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gogs.io/gogs/internal/badge"
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const badgeMaxAge = 60 // In seconds
+
+// serveBadge renders the badge with label and colors overridden by query
+// parameters. The ETag is computed from what the badge shows, so clients can
+// revalidate cheaply within a short max-age.
+func serveBadge(c *context.Context, b badge.Badge) {
+	if label := strings.TrimSpace(c.Query("label")); label != "" {
+		if runes := []rune(label); len(runes) > 64 {
+			label = string(runes[:64])
+		}
+		b.Label = label
+	}
+	if _, ok := badge.Color(c.Query("color")); ok {
+		b.Color = c.Query("color")
+	}
+	if _, ok := badge.Color(c.Query("label_color")); ok {
+		b.LabelColor = c.Query("label_color")
+	}
+
+	cacheControl := "public"
+	if c.Repo.Repository.IsPrivate {
+		cacheControl = "private"
+	}
+	etag := fmt.Sprintf(`"%x"`, sha1.Sum([]byte(b.Label+"\x00"+b.Message+"\x00"+b.Color+"\x00"+b.LabelColor)))
+	c.Header().Set("Cache-Control", fmt.Sprintf("%s, max-age=%d", cacheControl, badgeMaxAge))
+	c.Header().Set("ETag", etag)
+	if c.Req.Header.Get("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Header().Set("Content-Type", "image/svg+xml; charset=utf-8")
+	c.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+	c.Header().Set("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+	_, _ = c.Write(b.SVG())
+}
+
+// StatusBadge shows the combined commit status of the branch tip.
+func StatusBadge(c *context.Context) {
+	branch := c.Params("*")
+	if !strings.HasSuffix(branch, ".svg") {
+		c.NotFound()
+		return
+	}
+	branch = strings.TrimSuffix(branch, ".svg")
+	if !c.Repo.GitRepo.IsBranchExist(branch) {
+		c.NotFound()
+		return
+	}
+
+	commitID, err := c.Repo.GitRepo.GetBranchCommitID(branch)
+	if err != nil {
+		c.ServerError("GetBranchCommitID", err)
+		return
+	}
+	statuses, err := db.GetLatestCommitStatuses(c.Repo.Repository.ID, commitID)
+	if err != nil {
+		c.ServerError("GetLatestCommitStatuses", err)
+		return
+	}
+
+	b := badge.Badge{
+		Label:   "build",
+		Message: "unknown",
+		Color:   "lightgrey",
+	}
+	switch db.CombinedCommitStatusState(statuses) {
+	case db.COMMIT_STATUS_SUCCESS:
+		b.Message, b.Color = "passing", "brightgreen"
+	case db.COMMIT_STATUS_FAILURE:
+		b.Message, b.Color = "failing", "red"
+	case db.COMMIT_STATUS_PENDING:
+		b.Message, b.Color = "pending", "yellow"
+	}
+	serveBadge(c, b)
+}
+
+// ReleaseBadge shows the tag of the latest release.
+func ReleaseBadge(c *context.Context) {
+	b := badge.Badge{
+		Label:   "release",
+		Message: "none",
+		Color:   "lightgrey",
+	}
+	release, err := db.GetLatestReleaseByRepoID(c.Repo.Repository.ID)
+	if err != nil {
+		if !db.IsErrReleaseNotExist(err) {
+			c.ServerError("GetLatestReleaseByRepoID", err)
+			return
+		}
+	} else {
+		b.Message, b.Color = release.TagName, "blue"
+	}
+	serveBadge(c, b)
+}
+
+// IssuesBadge shows the number of open issues.
+func IssuesBadge(c *context.Context) {
+	if !c.Repo.Repository.EnableIssues || c.Repo.Repository.EnableExternalTracker {
+		c.NotFound()
+		return
+	}
+
+	b := badge.Badge{
+		Label:   "issues",
+		Message: fmt.Sprintf("%d open", c.Repo.Repository.NumOpenIssues),
+		Color:   "blue",
+	}
+	if c.Repo.Repository.NumOpenIssues == 0 {
+		b.Color = "brightgreen"
+	}
+	serveBadge(c, b)
+}
@@ -14,6 +14,7 @@ import (
 
 	"gogs.io/gogs/internal/context"
 	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/tool"
 )
 
@@ -26,6 +27,9 @@ type Branch struct {
 	Name        string
 	Commit      *git.Commit
 	IsProtected bool
+	// IsSameAsDefault indicates whether the tree of the branch is identical to
+	// the default branch, even if their histories have diverged.
+	IsSameAsDefault bool
 }
 
 func loadBranches(c *context.Context) []*Branch {
@@ -50,16 +54,24 @@ func loadBranches(c *context.Context) []*Branch {
 		}
 
 		branches[i] = &Branch{
-			Name:   rawBranches[i].Name,
-			Commit: commit,
+			Name:        rawBranches[i].Name,
+			Commit:      commit,
+			IsProtected: db.MatchProtectBranch(protectBranches, rawBranches[i].Name) != nil,
 		}
+	}
 
-		for j := range protectBranches {
-			if branches[i].Name == protectBranches[j].Name {
-				branches[i].IsProtected = true
-				break
-			}
+	// Comparing trees of loaded commits is cheap, and marks branches that are
+	// safe to delete despite diverged histories.
+	defaultBranch := c.Repo.Repository.DefaultBranch
+	for _, defaultB := range branches {
+		if defaultB.Name != defaultBranch {
+			continue
+		}
+		for i := range branches {
+			branches[i].IsSameAsDefault = branches[i].Name != defaultBranch &&
+				branches[i].Commit.Tree.ID.Equal(defaultB.Commit.Tree.ID)
 		}
+		break
 	}
 
 	c.Data["AllowPullRequest"] = c.Repo.Repository.AllowsPulls()
@@ -122,6 +134,18 @@ func DeleteBranchPost(c *context.Context) {
 	if !c.Repo.GitRepo.IsBranchExist(branchName) {
 		return
 	}
+
+	// Protected branches cannot be deleted by anyone, the same as by pushes.
+	protectBranch, err := db.GetProtectBranchOfRepoByBranch(c.Repo.Repository.ID, branchName)
+	if err != nil && !errors.IsErrBranchNotExist(err) {
+		log.Error("Failed to get protection of branch %q: %v", branchName, err)
+		return
+	}
+	if (protectBranch != nil && protectBranch.Protected) || !c.Repo.CanDirectPush(branchName) {
+		c.Flash.Error(c.Tr("repo.pulls.delete_branch_protected", branchName))
+		return
+	}
+
 	if len(commitID) > 0 {
 		branchCommitID, err := c.Repo.GitRepo.GetBranchCommitID(branchName)
 		if err != nil {
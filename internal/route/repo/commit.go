@@ -6,6 +6,7 @@ package repo
 
 import (
 	"container/list"
+	"net/url"
 	"path"
 
 	"github.com/gogs/git-module"
@@ -82,6 +83,9 @@ func renderCommits(c *context.Context, filename string) {
 	}
 	c.Data["PageSize"] = pageSize
 
+	if !c.SetRefPages() {
+		return
+	}
 	c.Data["Username"] = c.Repo.Owner.Name
 	c.Data["Reponame"] = c.Repo.Repository.Name
 	c.HTML(200, COMMITS)
@@ -109,6 +113,9 @@ func SearchCommits(c *context.Context) {
 	commits = db.ValidateCommitsWithEmails(commits)
 	c.Data["Commits"] = commits
 
+	if !c.SetRefPages() {
+		return
+	}
 	c.Data["Keyword"] = keyword
 	c.Data["Username"] = c.Repo.Owner.Name
 	c.Data["Reponame"] = c.Repo.Repository.Name
@@ -133,12 +140,25 @@ func Diff(c *context.Context) {
 		c.NotFoundOrServerError("get commit by ID", git.IsErrNotExist, err)
 		return
 	}
+	// Abbreviated commit IDs are redirected to the full one, so that links stay
+	// stable.
+	if commitID != commit.ID.String() {
+		c.RawRedirect(c.Repo.MakeURL(url.URL{
+			Path:     "commit/" + commit.ID.String(),
+			RawQuery: c.Req.URL.RawQuery,
+		}))
+		return
+	}
 
-	diff, err := db.GetDiffCommit(db.RepoPath(userName, repoName),
+	diff, err := db.GetDiffCommitContext(c.Req.Request.Context(), db.RepoPath(userName, repoName),
 		commitID, conf.Git.MaxGitDiffLines,
 		conf.Git.MaxGitDiffLineCharacters, conf.Git.MaxGitDiffFiles)
 	if err != nil {
-		c.NotFoundOrServerError("get diff commit", git.IsErrNotExist, err)
+		c.UnavailableOrServerError("get diff commit", err)
+		return
+	}
+	if err = diff.CollapseGeneratedFiles(commit); err != nil {
+		c.ServerError("CollapseGeneratedFiles", err)
 		return
 	}
 
@@ -152,11 +172,17 @@ func Diff(c *context.Context) {
 		}
 	}
 
-	setEditorconfigIfExists(c)
+	setEditorconfigIfExists(c, "")
 	if c.Written() {
 		return
 	}
 
+	closesIssues, err := c.Repo.Repository.ClosesIssues(commit)
+	if err != nil {
+		c.ServerError("ClosesIssues", err)
+		return
+	}
+
 	c.Title(commit.Summary() + " · " + tool.ShortSHA1(commitID))
 	c.Data["CommitID"] = commitID
 	c.Data["IsSplitStyle"] = c.Query("style") == "split"
@@ -164,9 +190,17 @@ func Diff(c *context.Context) {
 	c.Data["Reponame"] = repoName
 	c.Data["IsImageFile"] = commit.IsImageFile
 	c.Data["Commit"] = commit
-	c.Data["Author"] = db.ValidateCommitWithEmail(commit)
+	author, verified, err := c.Repo.ResolveCommitAuthor(commit)
+	if err != nil {
+		c.ServerError("ResolveCommitAuthor", err)
+		return
+	}
+	if verified {
+		c.Data["Author"] = author
+	}
 	c.Data["Diff"] = diff
 	c.Data["Parents"] = parents
+	c.Data["ClosesIssues"] = closesIssues
 	c.Data["DiffNotAvailable"] = diff.NumFiles() == 0
 	c.Data["SourcePath"] = conf.Server.Subpath + "/" + path.Join(userName, repoName, "src", commitID)
 	if commit.ParentCount() > 0 {
@@ -201,11 +235,19 @@ func CompareDiff(c *context.Context) {
 		return
 	}
 
-	diff, err := db.GetDiffRange(db.RepoPath(userName, repoName), beforeCommitID,
+	diff, err := db.GetDiffRangeContext(c.Req.Request.Context(), db.RepoPath(userName, repoName), beforeCommitID,
 		afterCommitID, conf.Git.MaxGitDiffLines,
 		conf.Git.MaxGitDiffLineCharacters, conf.Git.MaxGitDiffFiles)
 	if err != nil {
-		c.Handle(404, "GetDiffRange", err)
+		if db.IsErrGitOperationUnavailable(err) {
+			c.UnavailableOrServerError("GetDiffRange", err)
+		} else {
+			c.Handle(404, "GetDiffRange", err)
+		}
+		return
+	}
+	if err = diff.CollapseGeneratedFiles(commit); err != nil {
+		c.ServerError("CollapseGeneratedFiles", err)
 		return
 	}
 
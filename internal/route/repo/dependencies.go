@@ -0,0 +1,40 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const (
+	DEPENDENCIES = "repo/dependencies"
+)
+
+func Dependencies(c *context.Context) {
+	c.Data["Title"] = c.Tr("repo.dependencies")
+	c.Data["PageIsInsights"] = true
+
+	scan, err := db.GetRepoDependencyScan(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetRepoDependencyScan", err)
+		return
+	}
+	// Repositories that have not been pushed to since dependencies are tracked
+	// are scanned on the first visit.
+	if scan == nil {
+		db.AddDependencyScanTask(c.Repo.Repository.ID)
+	}
+	c.Data["Scan"] = scan
+
+	manifests, err := db.GetRepoManifests(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetRepoManifests", err)
+		return
+	}
+	c.Data["Manifests"] = manifests
+
+	c.Success(DEPENDENCIES)
+}
@@ -56,6 +56,11 @@ func ServeBlob(c *context.Context, blob *git.Blob) error {
 }
 
 func SingleDownload(c *context.Context) {
+	if c.Repo.PathRestriction != nil && !c.Repo.PathRestriction.Allows(c.Repo.TreePath) {
+		c.NotFound()
+		return
+	}
+
 	blob, err := c.Repo.Commit.GetBlobByPath(c.Repo.TreePath)
 	if err != nil {
 		if git.IsErrNotExist(err) {
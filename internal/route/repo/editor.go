@@ -97,6 +97,12 @@ func editFile(c *context.Context, isNewFile bool) {
 		} else {
 			c.Data["FileContent"] = content
 		}
+
+		isLFSTracked, err := c.Repo.IsLFSTracked(c.Repo.TreePath)
+		if err != nil {
+			log.Error("Failed to check if file is tracked by LFS [repo_id: %d, path: %s]: %v", c.Repo.Repository.ID, c.Repo.TreePath, err)
+		}
+		c.Data["IsLFSTracked"] = isLFSTracked
 	} else {
 		treeNames = append(treeNames, "") // Append empty string to allow user name the new file.
 	}
@@ -277,6 +283,7 @@ func editFilePost(c *context.Context, f form.EditRepoFile, isNewFile bool) {
 		Message:      message,
 		Content:      strings.Replace(f.Content, "\r", "", -1),
 		IsNewFile:    isNewFile,
+		RemoteAddr:   c.RemoteAddr(),
 	}); err != nil {
 		log.Error("Failed to update repo file: %v", err)
 		c.FormErr("TreePath")
@@ -384,6 +391,7 @@ func DeleteFilePost(c *context.Context, f form.DeleteRepoFile) {
 		NewBranch:    branchName,
 		TreePath:     c.Repo.TreePath,
 		Message:      message,
+		RemoteAddr:   c.RemoteAddr(),
 	}); err != nil {
 		log.Error("Failed to delete repo file: %v", err)
 		c.RenderWithErr(c.Tr("repo.editor.fail_to_delete_file", c.Repo.TreePath, errors.InternalServerError), DELETE_FILE, &f)
@@ -504,6 +512,7 @@ func UploadFilePost(c *context.Context, f form.UploadRepoFile) {
 		TreePath:     f.TreePath,
 		Message:      message,
 		Files:        f.Files,
+		RemoteAddr:   c.RemoteAddr(),
 	}); err != nil {
 		log.Error("Failed to upload files: %v", err)
 		c.FormErr("TreePath")
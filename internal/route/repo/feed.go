@@ -0,0 +1,185 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/gogs/git-module"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/feed"
+	"gogs.io/gogs/internal/markup"
+)
+
+const feedMaxAge = 300 // In seconds
+
+// serveFeed writes the Atom document of the feed. Like badges, the ETag is
+// computed from the document, so feed readers can revalidate cheaply.
+func serveFeed(c *context.Context, f *feed.Feed) {
+	data, err := f.Bytes()
+	if err != nil {
+		c.ServerError("Bytes", err)
+		return
+	}
+
+	cacheControl := "public"
+	if c.Repo.Repository.IsPrivate {
+		cacheControl = "private"
+	}
+	etag := fmt.Sprintf(`"%x"`, sha1.Sum(data))
+	c.Header().Set("Cache-Control", fmt.Sprintf("%s, max-age=%d", cacheControl, feedMaxAge))
+	c.Header().Set("ETag", etag)
+	if c.Req.Header.Get("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	c.Header().Set("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+	_, _ = c.Write(data)
+}
+
+// newFeed returns an empty feed of the repository. The self link never
+// contains the feed token of the requester.
+func newFeed(c *context.Context, title, link string) *feed.Feed {
+	self := strings.TrimSuffix(conf.Server.ExternalURL, "/") + c.Req.URL.Path
+	return feed.New(self, title+" · "+c.Repo.Repository.FullName(), link, self)
+}
+
+func userFeedPerson(u *db.User) *feed.Person {
+	return &feed.Person{
+		Name: u.DisplayName(),
+		URI:  u.HTMLURL(),
+	}
+}
+
+// CommitsFeed shows the latest commits of the branch. It does nothing unless
+// the path ends with ".atom", so the request falls through to the commit
+// history page.
+func CommitsFeed(c *context.Context) {
+	branch := c.Params("*")
+	if !strings.HasSuffix(branch, ".atom") {
+		return
+	}
+	branch = strings.TrimSuffix(branch, ".atom")
+	if !c.Repo.GitRepo.IsBranchExist(branch) {
+		c.NotFound()
+		return
+	}
+
+	commit, err := c.Repo.GitRepo.GetBranchCommit(branch)
+	if err != nil {
+		c.ServerError("GetBranchCommit", err)
+		return
+	}
+	commits, err := commit.CommitsByRangeSize(1, feed.MaxEntries)
+	if err != nil {
+		c.ServerError("CommitsByRangeSize", err)
+		return
+	}
+
+	repoURL := c.Repo.Repository.HTMLURL()
+	f := newFeed(c, c.Tr("repo.feeds.commits", branch), repoURL+"/commits/"+branch)
+	for e := commits.Front(); e != nil; e = e.Next() {
+		commit := e.Value.(*git.Commit)
+		link := repoURL + "/commit/" + commit.ID.String()
+		f.Add(&feed.Entry{
+			ID:      link,
+			Title:   commit.Summary(),
+			Updated: feed.Time(commit.Committer.When),
+			Author: &feed.Person{
+				Name:  commit.Author.Name,
+				Email: commit.Author.Email,
+			},
+			Link: &feed.Link{Href: link},
+			Content: &feed.Text{
+				Type: "html",
+				Body: "<pre>" + html.EscapeString(commit.Message()) + "</pre>",
+			},
+		})
+	}
+	serveFeed(c, f)
+}
+
+// ReleasesFeed shows the latest published releases.
+func ReleasesFeed(c *context.Context) {
+	releases, err := db.GetPublishedReleasesByRepoID(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetPublishedReleasesByRepoID", err)
+		return
+	}
+
+	repoURL := c.Repo.Repository.HTMLURL()
+	metas := c.Repo.Repository.ComposeMetas()
+	f := newFeed(c, c.Tr("repo.feeds.releases"), repoURL+"/releases")
+	for _, r := range releases {
+		if len(f.Entries) >= feed.MaxEntries {
+			break
+		}
+		if err = r.LoadAttributes(); err != nil {
+			c.ServerError("LoadAttributes", err)
+			return
+		}
+
+		title := r.Title
+		if title == "" {
+			title = r.TagName
+		}
+		link := repoURL + "/src/" + r.TagName
+		f.Add(&feed.Entry{
+			ID:      link,
+			Title:   title,
+			Updated: feed.Time(r.Created),
+			Author:  userFeedPerson(r.Publisher),
+			Link:    &feed.Link{Href: link},
+			Content: &feed.Text{
+				Type: "html",
+				Body: string(markup.Markdown(r.Note, repoURL, metas)),
+			},
+		})
+	}
+	serveFeed(c, f)
+}
+
+// IssuesFeed shows the latest new issues, whether they are open or closed.
+func IssuesFeed(c *context.Context) {
+	if !c.Repo.Repository.EnableIssues || c.Repo.Repository.EnableExternalTracker {
+		c.NotFound()
+		return
+	}
+
+	issues, err := db.GetRecentIssuesByRepoID(c.Repo.Repository.ID, feed.MaxEntries)
+	if err != nil {
+		c.ServerError("GetRecentIssuesByRepoID", err)
+		return
+	}
+
+	repoURL := c.Repo.Repository.HTMLURL()
+	metas := c.Repo.Repository.ComposeMetas()
+	f := newFeed(c, c.Tr("repo.feeds.issues"), repoURL+"/issues")
+	for _, issue := range issues {
+		link := issue.HTMLURL()
+		f.Add(&feed.Entry{
+			ID:      link,
+			Title:   fmt.Sprintf("#%d %s", issue.Index, issue.Title),
+			Updated: feed.Time(issue.Created),
+			Author:  userFeedPerson(issue.Poster),
+			Link:    &feed.Link{Href: link},
+			Content: &feed.Text{
+				Type: "html",
+				Body: string(markup.Markdown(issue.Content, repoURL, metas)),
+			},
+		})
+	}
+	serveFeed(c, f)
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+)
+
+// setForkSyncStatus sets how many commits the current branch of the fork is
+// ahead and behind its upstream branch, to be shown in the repository home page.
+func setForkSyncStatus(c *context.Context) {
+	status, err := c.Repo.Repository.GetForkSyncStatus(c.Repo.BranchName)
+	if err != nil {
+		if !errors.IsErrBranchNotExist(err) && !db.IsErrNotFork(err) && !db.IsErrForkUpstreamNotFetched(err) {
+			log.Error("Failed to get fork sync status [repo_id: %d, branch: %s]: %v", c.Repo.Repository.ID, c.Repo.BranchName, err)
+		}
+		return
+	}
+	c.Data["ForkSyncStatus"] = status
+}
+
+// setHeadOfPullRequests sets open pull requests of other repositories that use
+// the branch as head, and are visible to the current user.
+func setHeadOfPullRequests(c *context.Context) {
+	prs, err := c.Repo.OpenPRsUsingBranchAsHead(c.Repo.BranchName)
+	if err != nil {
+		log.Error("Failed to get pull requests using branch as head [repo_id: %d, branch: %s]: %v", c.Repo.Repository.ID, c.Repo.BranchName, err)
+		return
+	}
+
+	visible := make([]*db.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		has, err := db.HasAccess(c.UserID(), pr.BaseRepo, db.ACCESS_MODE_READ)
+		if err != nil {
+			log.Error("Failed to check access [user_id: %d, repo_id: %d]: %v", c.UserID(), pr.BaseRepoID, err)
+			continue
+		} else if has {
+			visible = append(visible, pr)
+		}
+	}
+	c.Data["HeadOfPullRequests"] = visible
+}
+
+// SyncForkPost fast-forwards the branch of the fork to its upstream branch. It
+// redirects to create a pull request from the upstream branch instead when the
+// branch has diverged.
+func SyncForkPost(c *context.Context) {
+	repo := c.Repo.Repository
+	branch := c.QueryTrim("branch")
+	if branch == "" {
+		branch = repo.DefaultBranch
+	}
+
+	status, err := db.SyncFork(c.User, repo, branch, c.PushCredential(), c.RemoteAddr())
+	if err != nil {
+		switch {
+		case db.IsErrNotFork(err), errors.IsErrBranchNotExist(err):
+			c.NotFound()
+		case db.IsErrForkDiverged(err):
+			if !repo.AllowsPulls() {
+				c.Flash.Error(c.Tr("repo.fork_sync.diverged_pulls_disabled", branch))
+				c.Redirect(repo.Link() + "/src/" + branch)
+				return
+			}
+			c.Flash.Info(c.Tr("repo.fork_sync.diverged", branch))
+			c.Redirect(repo.Link() + "/compare/" + branch + "..." + repo.BaseRepo.MustOwner().Name + ":" + repo.UpstreamBranchOf(branch))
+		case db.IsErrBranchPushForbidden(err):
+			c.Flash.Error(c.Tr("repo.fork_sync.protected", branch))
+			c.Redirect(repo.Link() + "/src/" + branch)
+		default:
+			c.ServerError("SyncFork", err)
+		}
+		return
+	}
+	log.Trace("Fork synced [repo_id: %d, branch: %s]: %s", repo.ID, branch, status.UpstreamCommitID)
+
+	c.Flash.Success(c.Tr("repo.fork_sync.success", branch))
+	c.Redirect(repo.Link() + "/src/" + branch)
+}
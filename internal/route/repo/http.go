@@ -9,6 +9,7 @@ import (
 	"compress/gzip"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path"
@@ -24,7 +25,9 @@ import (
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/lazyregexp"
+	"gogs.io/gogs/internal/maintenance"
 	"gogs.io/gogs/internal/tool"
+	"gogs.io/gogs/internal/trace"
 )
 
 type HTTPContext struct {
@@ -34,6 +37,8 @@ type HTTPContext struct {
 	RepoID    int64
 	RepoName  string
 	AuthUser  *db.User
+	// Credential is the credential that authenticated the user.
+	Credential db.PushCredential
 }
 
 // askCredentials responses HTTP header and status which informs client to provide credentials.
@@ -42,6 +47,23 @@ func askCredentials(c *context.Context, status int, text string) {
 	c.HandleText(status, text)
 }
 
+// rejectPushInMaintenance responds the message in the way that Git clients show
+// it to users. The ref advertisement is replaced by an error packet, which makes
+// the client exit with "remote error: <message>".
+func rejectPushInMaintenance(c *context.Context, message string) {
+	if c.Req.Method != "GET" {
+		c.HandleText(http.StatusServiceUnavailable, message)
+		return
+	}
+
+	c.Resp.Header().Set("Content-Type", "application/x-git-receive-pack-advertisement")
+	c.Resp.Header().Set("Cache-Control", "no-cache, max-age=0, must-revalidate")
+	c.Resp.WriteHeader(http.StatusOK)
+	c.Resp.Write(packetWrite("# service=git-receive-pack\n"))
+	c.Resp.Write([]byte("0000"))
+	c.Resp.Write(packetWrite("ERR " + message + "\n"))
+}
+
 func HTTPContexter() macaron.Handler {
 	return func(c *context.Context) {
 		if len(conf.HTTP.AccessControlAllowOrigin) > 0 {
@@ -64,13 +86,33 @@ func HTTPContexter() macaron.Handler {
 			strings.HasSuffix(c.Req.URL.Path, "git-upload-pack") ||
 			c.Req.Method == "GET"
 
+		// Pushes are rejected in maintenance mode. Credentials are required to
+		// identify site admins when they are allowed to push.
+		isPush := c.Query("service") == "git-receive-pack" ||
+			strings.HasSuffix(c.Req.URL.Path, "git-receive-pack")
+		state := maintenance.Current()
+		if isPush && state.Enabled {
+			if !state.AllowAdmins {
+				rejectPushInMaintenance(c, state.DisplayMessage())
+				return
+			}
+			isPull = false
+		}
+
 		owner, err := db.GetUserByName(ownerName)
 		if err != nil {
 			c.NotFoundOrServerError("GetUserByName", errors.IsUserNotExist, err)
 			return
 		}
 
+		// Clone URLs of renamed repositories keep working, clients are redirected
+		// to the new URL once they are allowed to access the repository.
+		isRenamed := false
 		repo, err := db.GetRepositoryByName(owner.ID, repoName)
+		if errors.IsRepoNotExist(err) {
+			repo, err = db.GetRepositoryByOldName(owner.ID, repoName)
+			isRenamed = err == nil
+		}
 		if err != nil {
 			c.NotFoundOrServerError("GetRepositoryByName", errors.IsRepoNotExist, err)
 			return
@@ -78,8 +120,13 @@ func HTTPContexter() macaron.Handler {
 
 		// Authentication is not required for pulling from public repositories.
 		if isPull && !repo.IsPrivate && !conf.Auth.RequireSigninView {
+			if isRenamed {
+				redirectRenamedRepoGit(c, repo, repoName)
+				return
+			}
 			c.Map(&HTTPContext{
 				Context: c,
+				RepoID:  repo.ID,
 			})
 			return
 		}
@@ -117,6 +164,7 @@ func HTTPContexter() macaron.Handler {
 			c.Handle(http.StatusInternalServerError, "UserLogin", err)
 			return
 		}
+		credential := db.PushCredential{Type: db.PushCredentialPassword}
 
 		// If username and password combination failed, try again using username as a token.
 		if authUser == nil {
@@ -131,6 +179,11 @@ func HTTPContexter() macaron.Handler {
 			}
 			token.Updated = time.Now()
 			// TODO: verify or update token.Updated in database
+			credential = db.PushCredential{
+				Type: db.PushCredentialToken,
+				ID:   token.ID,
+				Name: token.Name,
+			}
 
 			authUser, err = db.GetUserByID(token.UID)
 			if err != nil {
@@ -160,11 +213,37 @@ Please create and use personal access token on user settings page`)
 			return
 		}
 
+		restriction, err := db.GetPathRestriction(repo, authUser)
+		if err != nil {
+			c.Handle(http.StatusInternalServerError, "GetPathRestriction", err)
+			return
+		} else if restriction != nil {
+			c.HandleText(http.StatusForbidden, "Your access to this repository is limited to some paths, which Git cannot enforce. Please use the web interface or the API instead.")
+			return
+		}
+
 		if !isPull && repo.IsMirror {
 			c.HandleText(http.StatusForbidden, "Mirror repository is read-only")
 			return
 		}
 
+		if isPush && !state.Bypass(authUser.IsAdmin) {
+			rejectPushInMaintenance(c, state.DisplayMessage())
+			return
+		}
+
+		if isRenamed {
+			redirectRenamedRepoGit(c, repo, repoName)
+			return
+		}
+
+		if err = db.UpdateCollaboratorAccess(repo.ID, authUser.ID); err != nil {
+			log.Error("Failed to update collaborator access [repo_id: %d, user_id: %d]: %v", repo.ID, authUser.ID, err)
+		}
+		if err = db.UpdateUserLastSeen(authUser.ID); err != nil {
+			log.Error("Failed to update last seen of user [%d]: %v", authUser.ID, err)
+		}
+
 		c.Map(&HTTPContext{
 			Context:   c,
 			OwnerName: ownerName,
@@ -172,21 +251,40 @@ Please create and use personal access token on user settings page`)
 			RepoID:    repo.ID,
 			RepoName:  repoName,
 			AuthUser:  authUser,
+
+			Credential: credential,
 		})
 	}
 }
 
+// redirectRenamedRepoGit permanently redirects the Git request to the new URL
+// of the repository that was renamed from the name. Git follows the redirect
+// of the initial request and warns users about it.
+func redirectRenamedRepoGit(c *context.Context, repo *db.Repository, repoName string) {
+	// Keep suffixes of the old name, e.g. ".wiki.git".
+	suffix := c.Params(":reponame")[len(repoName):]
+	link := url.URL{
+		Path:     repo.Link() + suffix + "/" + c.Params("*"),
+		RawQuery: c.Req.URL.RawQuery,
+	}
+	log.Trace("HTTPGit - Redirect renamed repository: %s -> %s", c.Req.URL.Path, link.Path)
+	c.Context.Redirect(link.String(), http.StatusMovedPermanently)
+}
+
 type serviceHandler struct {
 	w    http.ResponseWriter
 	r    *http.Request
 	dir  string
 	file string
 
-	authUser  *db.User
-	ownerName string
-	ownerSalt string
-	repoID    int64
-	repoName  string
+	authUser   *db.User
+	credential db.PushCredential
+	ownerName  string
+	ownerSalt  string
+	repoID     int64
+	repoName   string
+
+	remoteAddr string
 }
 
 func (h *serviceHandler) setHeaderNoCache() {
@@ -245,18 +343,26 @@ func serviceRPC(h serviceHandler, service string) {
 	cmd := exec.Command("git", service, "--stateless-rpc", h.dir)
 	if service == "receive-pack" {
 		cmd.Env = append(os.Environ(), db.ComposeHookEnvs(db.ComposeHookEnvsOptions{
-			AuthUser:  h.authUser,
-			OwnerName: h.ownerName,
-			OwnerSalt: h.ownerSalt,
-			RepoID:    h.repoID,
-			RepoName:  h.repoName,
-			RepoPath:  h.dir,
+			AuthUser:   h.authUser,
+			Credential: h.credential,
+			RemoteAddr: h.remoteAddr,
+			OwnerName:  h.ownerName,
+			OwnerSalt:  h.ownerSalt,
+			RepoID:     h.repoID,
+			RepoName:   h.repoName,
+			RepoPath:   h.dir,
 		})...)
 	}
 	cmd.Dir = h.dir
 	cmd.Stdout = h.w
 	cmd.Stderr = &stderr
 	cmd.Stdin = reqBody
+	if t := trace.Current(); t != nil {
+		start := time.Now()
+		defer func() {
+			t.AddGit(strings.Join(cmd.Args, " "), time.Since(start))
+		}()
+	}
 	if err = cmd.Run(); err != nil {
 		log.Error("HTTP.serviceRPC: fail to serve RPC '%s': %v - %s", service, err, stderr.String())
 		h.w.WriteHeader(http.StatusInternalServerError)
@@ -284,6 +390,12 @@ func getServiceType(r *http.Request) string {
 func gitCommand(dir string, args ...string) []byte {
 	cmd := exec.Command("git", args...)
 	cmd.Dir = dir
+	if t := trace.Current(); t != nil {
+		start := time.Now()
+		defer func() {
+			t.AddGit(strings.Join(cmd.Args, " "), time.Since(start))
+		}()
+	}
 	out, err := cmd.Output()
 	if err != nil {
 		log.Error(fmt.Sprintf("Git: %v - %s", err, out))
@@ -303,6 +415,22 @@ func packetWrite(str string) []byte {
 	return []byte(s + str)
 }
 
+// recordClone counts the clone or fetch of the repository, which begins with
+// the advertisement of refs. Wikis are not counted.
+func recordClone(h serviceHandler) {
+	if h.repoID == 0 || strings.HasSuffix(h.dir, ".wiki.git") {
+		return
+	}
+
+	var userID int64
+	if h.authUser != nil {
+		userID = h.authUser.ID
+	}
+	if err := db.RecordRepoClone(h.repoID, db.TrafficVisitor(userID, h.remoteAddr)); err != nil {
+		log.Error("Failed to record clone [repo_id: %d]: %v", h.repoID, err)
+	}
+}
+
 func getInfoRefs(h serviceHandler) {
 	h.setHeaderNoCache()
 	service := getServiceType(h.r)
@@ -312,6 +440,10 @@ func getInfoRefs(h serviceHandler) {
 		return
 	}
 
+	if service == "upload-pack" {
+		recordClone(h)
+	}
+
 	refs := gitCommand(h.dir, service, "--stateless-rpc", "--advertise-refs", ".")
 	h.w.Header().Set("Content-Type", fmt.Sprintf("application/x-git-%s-advertisement", service))
 	h.w.WriteHeader(http.StatusOK)
@@ -411,11 +543,14 @@ func HTTP(c *HTTPContext) {
 			dir:  dir,
 			file: file,
 
-			authUser:  c.AuthUser,
-			ownerName: c.OwnerName,
-			ownerSalt: c.OwnerSalt,
-			repoID:    c.RepoID,
-			repoName:  c.RepoName,
+			authUser:   c.AuthUser,
+			credential: c.Credential,
+			ownerName:  c.OwnerName,
+			ownerSalt:  c.OwnerSalt,
+			repoID:     c.RepoID,
+			repoName:   c.RepoName,
+
+			remoteAddr: c.RemoteAddr(),
 		})
 		return
 	}
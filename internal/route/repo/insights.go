@@ -0,0 +1,329 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/json-iterator/go"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const (
+	INSIGHTS = "repo/insights"
+)
+
+// trafficDays is the number of recent days shown in clone statistics.
+const trafficDays = 14
+
+// directorySizes returns directory sizes of the tree of current commit, which
+// are cached by the commit ID since the tree never changes.
+func directorySizes(c *context.Context) ([]db.DirStat, error) {
+	key := c.Repo.Repository.DirectorySizesCacheKey(c.Repo.CommitID)
+	if v, ok := c.Cache.Get(key).(string); ok {
+		var stats []db.DirStat
+		if err := jsoniter.Unmarshal([]byte(v), &stats); err == nil {
+			return stats, nil
+		}
+	}
+
+	stats, err := c.Repo.Repository.DirectorySizes(c.Repo.CommitID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := jsoniter.Marshal(stats)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.Cache.Put(key, string(data), 24*60*60); err != nil {
+		log.Error("Failed to cache directory sizes [key: %s]: %v", key, err)
+	}
+	return stats, nil
+}
+
+// authorStats returns commit counts of authors in the revision range of
+// commit IDs, which are cached since the range never changes.
+func authorStats(c *context.Context, revRange string) ([]db.AuthorStat, error) {
+	key := fmt.Sprintf("AuthorStats_%d_%s", c.Repo.Repository.ID, revRange)
+	if v, ok := c.Cache.Get(key).(string); ok {
+		var stats []db.AuthorStat
+		if err := json.Unmarshal([]byte(v), &stats); err == nil {
+			return stats, nil
+		}
+	}
+
+	stats, err := c.Repo.Repository.AuthorStats(revRange)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.Cache.Put(key, string(data), 7*24*60*60); err != nil {
+		log.Error("Failed to cache author stats [key: %s]: %v", key, err)
+	}
+	return stats, nil
+}
+
+// authorContributions returns author contributions of releases in the order of
+// creation. Statistics are cached by the revision range of every release, so
+// only ranges of new or retagged releases run Git.
+func authorContributions(c *context.Context) ([]*db.Release, map[string][]db.AuthorStat, error) {
+	releases, err := c.Repo.Repository.ReleasesInOrder()
+	if err != nil {
+		return nil, nil, fmt.Errorf("ReleasesInOrder: %v", err)
+	}
+
+	ranges := db.ReleaseRevRanges(releases)
+	contributions := make(map[string][]db.AuthorStat, len(ranges))
+	for tagName, revRange := range ranges {
+		stats, err := authorStats(c, revRange)
+		if err != nil {
+			return nil, nil, fmt.Errorf("authorStats [tag: %s]: %v", tagName, err)
+		}
+		contributions[tagName] = stats
+	}
+	return releases, contributions, nil
+}
+
+type busFactorResult struct {
+	Factor  int
+	Authors []db.AuthorStat
+}
+
+// busFactor returns the bus factor of current commit and authors who count,
+// which are cached by the commit ID and settings for the day since the period
+// of recent commits moves daily.
+func busFactor(c *context.Context) (*busFactorResult, error) {
+	key := fmt.Sprintf("BusFactor_%d_%s_%s_%v_%d", c.Repo.Repository.ID, c.Repo.CommitID, time.Now().Format("2006-01-02"),
+		conf.Repository.Insights.BusFactorMajority, conf.Repository.Insights.BusFactorWindowDays)
+	if v, ok := c.Cache.Get(key).(string); ok {
+		var result busFactorResult
+		if err := jsoniter.Unmarshal([]byte(v), &result); err == nil {
+			return &result, nil
+		}
+	}
+
+	factor, authors, err := c.Repo.Repository.BusFactor(c.Repo.CommitID)
+	if err != nil {
+		return nil, err
+	}
+	result := &busFactorResult{
+		Factor:  factor,
+		Authors: authors,
+	}
+
+	data, err := jsoniter.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.Cache.Put(key, string(data), 24*60*60); err != nil {
+		log.Error("Failed to cache bus factor [key: %s]: %v", key, err)
+	}
+	return result, nil
+}
+
+// codeOwnersConflicts returns conflicts of the CODEOWNERS file of the default
+// branch, which are cached by the commit ID of the branch.
+func codeOwnersConflicts(c *context.Context) ([]db.OwnershipConflict, error) {
+	commitID, err := c.Repo.GitRepo.GetBranchCommitID(c.Repo.Repository.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("GetBranchCommitID: %v", err)
+	}
+
+	key := c.Repo.Repository.CodeOwnersConflictsCacheKey(commitID)
+	if v, ok := c.Cache.Get(key).(string); ok {
+		var conflicts []db.OwnershipConflict
+		if err := jsoniter.Unmarshal([]byte(v), &conflicts); err == nil {
+			return conflicts, nil
+		}
+	}
+
+	conflicts, err := c.Repo.Repository.CodeOwnersConflicts()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := jsoniter.Marshal(conflicts)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.Cache.Put(key, string(data), 24*60*60); err != nil {
+		log.Error("Failed to cache CODEOWNERS conflicts [key: %s]: %v", key, err)
+	}
+	return conflicts, nil
+}
+
+// contributionsChartMaxAuthors is the maximum number of authors shown in the
+// chart of contributions, commits of other authors are shown together.
+const contributionsChartMaxAuthors = 8
+
+var contributionsChartColors = [contributionsChartMaxAuthors]string{
+	"#2185d0", "#21ba45", "#f2711c", "#a333c8", "#fbbd08", "#00b5ad", "#e03997", "#a5673f",
+}
+
+type contributionSegment struct {
+	Name    string
+	Commits int64
+	Percent float64
+	Color   string
+}
+
+type releaseContributions struct {
+	TagName  string
+	Commits  int64
+	Segments []*contributionSegment
+}
+
+// contributionsChart returns stacked bars of releases in the order of creation,
+// where each of the most active authors overall has its own color and others
+// are grouped together. It also returns the legend of colors.
+func contributionsChart(c *context.Context, releases []*db.Release, contributions map[string][]db.AuthorStat) ([]*releaseContributions, []*contributionSegment) {
+	totals := make(map[string]*db.AuthorStat)
+	for _, stats := range contributions {
+		for _, stat := range stats {
+			total := totals[stat.Email]
+			if total == nil {
+				total = &db.AuthorStat{Name: stat.Name, Email: stat.Email}
+				totals[stat.Email] = total
+			}
+			total.Commits += stat.Commits
+		}
+	}
+	tops := make([]*db.AuthorStat, 0, len(totals))
+	for _, total := range totals {
+		tops = append(tops, total)
+	}
+	sort.Slice(tops, func(i, j int) bool {
+		if tops[i].Commits != tops[j].Commits {
+			return tops[i].Commits > tops[j].Commits
+		}
+		return tops[i].Email < tops[j].Email
+	})
+	if len(tops) > contributionsChartMaxAuthors {
+		tops = tops[:contributionsChartMaxAuthors]
+	}
+
+	colors := make(map[string]string, len(tops))
+	legend := make([]*contributionSegment, 0, len(tops)+1)
+	for i, top := range tops {
+		colors[top.Email] = contributionsChartColors[i]
+		legend = append(legend, &contributionSegment{
+			Name:    top.Name,
+			Commits: top.Commits,
+			Color:   contributionsChartColors[i],
+		})
+	}
+	others := c.Tr("repo.insights.contributions_others")
+
+	bars := make([]*releaseContributions, 0, len(releases))
+	hasOthers := false
+	for _, r := range releases {
+		stats, ok := contributions[r.TagName]
+		if !ok {
+			continue
+		}
+
+		bar := &releaseContributions{TagName: r.TagName}
+		var other *contributionSegment
+		for _, stat := range stats {
+			bar.Commits += stat.Commits
+			if color, ok := colors[stat.Email]; ok {
+				bar.Segments = append(bar.Segments, &contributionSegment{
+					Name:    stat.Name,
+					Commits: stat.Commits,
+					Color:   color,
+				})
+				continue
+			}
+
+			if other == nil {
+				other = &contributionSegment{Name: others, Color: "#767676"}
+			}
+			other.Commits += stat.Commits
+		}
+		if other != nil {
+			bar.Segments = append(bar.Segments, other)
+			hasOthers = true
+		}
+		for _, seg := range bar.Segments {
+			seg.Percent = float64(seg.Commits) * 100 / float64(bar.Commits)
+		}
+		bars = append(bars, bar)
+	}
+	if hasOthers {
+		legend = append(legend, &contributionSegment{Name: others, Color: "#767676"})
+	}
+	return bars, legend
+}
+
+func Insights(c *context.Context) {
+	c.Data["Title"] = c.Tr("repo.insights")
+	c.Data["PageIsInsights"] = true
+
+	stats, err := directorySizes(c)
+	if err != nil {
+		c.ServerError("get directory sizes", err)
+		return
+	}
+	c.Data["DirStats"] = stats
+
+	releases, contributions, err := authorContributions(c)
+	if err != nil {
+		c.ServerError("get author contributions", err)
+		return
+	}
+	c.Data["Contributions"], c.Data["ContributionsLegend"] = contributionsChart(c, releases, contributions)
+
+	factor, err := busFactor(c)
+	if err != nil {
+		c.ServerError("get bus factor", err)
+		return
+	}
+	c.Data["BusFactor"] = factor
+	c.Data["BusFactorMajority"] = conf.Repository.Insights.BusFactorMajority
+	c.Data["BusFactorWindowDays"] = conf.Repository.Insights.BusFactorWindowDays
+	c.Data["IsBusFactorRisky"] = factor.Factor > 0 && factor.Factor <= conf.Repository.Insights.BusFactorRiskLevel
+
+	conflicts, err := codeOwnersConflicts(c)
+	if err != nil {
+		c.ServerError("get CODEOWNERS conflicts", err)
+		return
+	}
+	c.Data["CodeOwnersConflicts"] = conflicts
+
+	// Clone statistics are only shown to users who can write to the repository.
+	if c.Repo.IsWriter() {
+		cloneStats, err := c.Repo.Repository.CloneStats(trafficDays)
+		if err != nil {
+			c.ServerError("get clone stats", err)
+			return
+		}
+		var total, unique int64
+		for _, stat := range cloneStats {
+			total += stat.Total
+			unique += stat.Unique
+		}
+		c.Data["CloneStats"] = cloneStats
+		c.Data["CloneTotal"] = total
+		c.Data["CloneUnique"] = unique
+		c.Data["TrafficDays"] = trafficDays
+	}
+
+	if !c.SetRefPages() {
+		return
+	}
+	c.Success(INSIGHTS)
+}
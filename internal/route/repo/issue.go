@@ -97,6 +97,7 @@ func issues(c *context.Context, isPullList bool) {
 		}
 		c.Data["Title"] = c.Tr("repo.pulls")
 		c.Data["PageIsPullList"] = true
+		setRecentPush(c)
 
 	} else {
 		MustEnableIssues(c)
@@ -146,14 +147,21 @@ func issues(c *context.Context, isPullList bool) {
 	selectLabels := c.Query("labels")
 	milestoneID := c.QueryInt64("milestone")
 	isShowClosed := c.Query("state") == "closed"
+	// Only closed issues are filtered by close reasons.
+	var closeReason db.IssueCloseReason
+	if isShowClosed && !isPullList {
+		closeReason = db.IssueCloseReason(c.Query("reason"))
+	}
 	issueStats := db.GetIssueStats(&db.IssueStatsOptions{
 		RepoID:      repo.ID,
+		Repo:        repo,
 		UserID:      uid,
 		Labels:      selectLabels,
 		MilestoneID: milestoneID,
 		AssigneeID:  assigneeID,
 		FilterMode:  filterMode,
 		IsPull:      isPullList,
+		CloseReason: closeReason,
 	})
 
 	page := c.QueryInt("page")
@@ -182,6 +190,7 @@ func issues(c *context.Context, isPullList bool) {
 		IsPull:      isPullList,
 		Labels:      selectLabels,
 		SortType:    sortType,
+		CloseReason: closeReason,
 	})
 	if err != nil {
 		c.Handle(500, "Issues", err)
@@ -242,6 +251,8 @@ func issues(c *context.Context, isPullList bool) {
 	} else {
 		c.Data["State"] = "open"
 	}
+	c.Data["CloseReason"] = closeReason
+	c.Data["CloseReasons"] = append(repo.IssueCloseReasons(), db.ISSUE_CLOSE_REASON_CLOSED)
 
 	c.HTML(200, ISSUES)
 }
@@ -346,7 +357,27 @@ func NewIssue(c *context.Context) {
 	c.Data["RequireSimpleMDE"] = true
 	c.Data["title"] = c.Query("title")
 	c.Data["content"] = c.Query("content")
-	setTemplateIfExists(c, ISSUE_TEMPLATE_KEY, IssueTemplateCandidates)
+
+	forms, err := issueForms(c)
+	if err != nil {
+		c.ServerError("issueForms", err)
+		return
+	}
+	if filename := c.Query("template"); filename != "" {
+		issueForm := setIssueForm(c, forms, filename)
+		if issueForm == nil {
+			c.NotFound()
+			return
+		} else if issueForm.IsValid() && c.Query("title") == "" {
+			c.Data["title"] = issueForm.Title
+		}
+	} else if len(forms) > 0 && !c.QueryBool("blank") {
+		c.Data["IssueForms"] = forms
+		c.Success(ISSUE_CHOOSE)
+		return
+	} else {
+		setTemplateIfExists(c, ISSUE_TEMPLATE_KEY, IssueTemplateCandidates)
+	}
 	renderAttachmentSettings(c)
 
 	RetrieveRepoMetas(c, c.Repo.Repository)
@@ -423,11 +454,53 @@ func NewIssuePost(c *context.Context, f form.NewIssue) {
 		return
 	}
 
+	// Invalid issue forms fall back to the editor, whose content is submitted as is.
+	var issueForm *db.IssueForm
+	var issueFormValues db.IssueFormValues
+	if f.Template != "" {
+		forms, err := issueForms(c)
+		if err != nil {
+			c.ServerError("issueForms", err)
+			return
+		}
+		issueForm = setIssueForm(c, forms, f.Template)
+		if issueForm == nil {
+			c.NotFound()
+			return
+		} else if issueForm.IsValid() {
+			issueFormValues = parseIssueFormValues(c, issueForm)
+			c.Data["IssueFormValues"] = issueFormValues
+		} else {
+			issueForm = nil
+		}
+	}
+
 	if c.HasError() {
 		c.HTML(200, ISSUE_NEW)
 		return
 	}
 
+	if issueForm != nil {
+		if errs := issueForm.ValidateValues(issueFormValues); len(errs) > 0 {
+			c.RenderWithErr(issueFormValueErrorMessage(c, errs), ISSUE_NEW, &f)
+			return
+		}
+	}
+
+	// Ask the poster to confirm before submitting an issue that is likely a duplicate.
+	if !f.NotDuplicate {
+		duplicates, err := likelyDuplicates(c, f.Title)
+		if err != nil {
+			c.ServerError("likelyDuplicates", err)
+			return
+		} else if len(duplicates) > 0 {
+			form.Assign(f, c.Data)
+			c.Data["Duplicates"] = duplicates
+			c.HTML(200, ISSUE_NEW)
+			return
+		}
+	}
+
 	var attachments []string
 	if conf.Attachment.Enabled {
 		attachments = f.Files
@@ -442,6 +515,13 @@ func NewIssuePost(c *context.Context, f form.NewIssue) {
 		AssigneeID:  assigneeID,
 		Content:     f.Content,
 	}
+	if issueForm != nil {
+		var err error
+		if labelIDs, err = issueForm.Apply(c.Repo.Repository, issue, labelIDs, issueFormValues); err != nil {
+			c.ServerError("Apply", err)
+			return
+		}
+	}
 	if err := db.NewIssue(c.Repo.Repository, issue, labelIDs, attachments); err != nil {
 		c.Handle(500, "NewIssue", err)
 		return
@@ -519,6 +599,13 @@ func viewIssue(c *context.Context, isPullList bool) {
 	}
 	c.Data["Title"] = issue.Title
 
+	// Prevent guests accessing pull requests, redirecting them to the pull
+	// request page would send them back to issues.
+	if !c.Repo.HasAccess() && issue.IsPull {
+		c.NotFound()
+		return
+	}
+
 	// Make sure type and URL matches.
 	if !isPullList && issue.IsPull {
 		c.RawRedirect(c.Repo.MakeURL(fmt.Sprintf("pulls/%d", issue.Index)))
@@ -552,8 +639,13 @@ func viewIssue(c *context.Context, isPullList bool) {
 		if issue.PullRequest.HasMerged {
 			c.Data["DisableStatusChange"] = issue.PullRequest.HasMerged
 			PrepareMergedViewPullInfo(c, issue)
-		} else {
-			PrepareViewPullInfo(c, issue)
+		} else if prInfo := PrepareViewPullInfo(c, issue); prInfo != nil && !issue.IsClosed {
+			setCherryPicks(c, issue.PullRequest)
+			if c.Repo.IsWriter() {
+				setMergeMessage(c, issue, prInfo)
+				c.Data["AllowsMergeCommit"] = c.Repo.AllowsMergeCommit(issue.PullRequest.BaseBranch)
+				setCodeOwnersPending(c, issue.PullRequest)
+			}
 		}
 		if c.Written() {
 			return
@@ -645,10 +737,10 @@ func viewIssue(c *context.Context, isPullList bool) {
 	if issue.IsPull && issue.PullRequest.HasMerged {
 		pull := issue.PullRequest
 		branchProtected := false
-		protectBranch, err := db.GetProtectBranchOfRepoByName(pull.BaseRepoID, pull.HeadBranch)
+		protectBranch, err := db.GetProtectBranchOfRepoByBranch(pull.BaseRepoID, pull.HeadBranch)
 		if err != nil {
 			if !errors.IsErrBranchNotExist(err) {
-				c.ServerError("GetProtectBranchOfRepoByName", err)
+				c.ServerError("GetProtectBranchOfRepoByBranch", err)
 				return
 			}
 		} else {
@@ -656,12 +748,12 @@ func viewIssue(c *context.Context, isPullList bool) {
 		}
 
 		c.Data["IsPullBranchDeletable"] = pull.BaseRepoID == pull.HeadRepoID &&
-			c.Repo.IsWriter() && c.Repo.GitRepo.IsBranchExist(pull.HeadBranch) &&
+			c.Repo.CanDirectPush(pull.HeadBranch) && c.Repo.GitRepo.IsBranchExist(pull.HeadBranch) &&
 			!branchProtected
 
-		c.Data["DeleteBranchLink"] = c.Repo.MakeURL(url.URL{
-			Path:     "branches/delete/" + pull.HeadBranch,
-			RawQuery: fmt.Sprintf("commit=%s&redirect_to=%s", pull.MergedCommitID, c.Data["Link"]),
+		c.Data["DeleteBranchLink"] = c.Repo.MakeURLWithQuery("branches/delete/"+pull.HeadBranch, url.Values{
+			"commit":      {pull.MergedCommitID},
+			"redirect_to": {c.Data["Link"].(string)},
 		})
 	}
 
@@ -855,6 +947,19 @@ func NewComment(c *context.Context, f form.CreateComment) {
 		return
 	}
 
+	if issue.IsLocked && !c.Repo.IsWriter() {
+		c.Flash.Error(c.Tr("repo.issues.locked_cannot_comment"))
+		c.RawRedirect(c.Repo.MakeURL(fmt.Sprintf("issues/%d", issue.Index)))
+		return
+	}
+
+	closeReason := db.IssueCloseReason(f.CloseReason)
+	if f.Status == "close" && !issue.IsPull && closeReason != "" && !c.Repo.Repository.IsValidIssueCloseReason(closeReason) {
+		c.Flash.Error(c.Tr("repo.issues.close_reason_invalid"))
+		c.RawRedirect(c.Repo.MakeURL(fmt.Sprintf("issues/%d", issue.Index)))
+		return
+	}
+
 	var err error
 	var comment *db.Comment
 	defer func() {
@@ -890,7 +995,7 @@ func NewComment(c *context.Context, f form.CreateComment) {
 			if pr != nil {
 				c.Flash.Info(c.Tr("repo.pulls.open_unmerged_pull_exists", pr.Index))
 			} else {
-				if err = issue.ChangeStatus(c.User, c.Repo.Repository, f.Status == "close"); err != nil {
+				if err = issue.ChangeStatus(c.User, c.Repo.Repository, f.Status == "close", closeReason); err != nil {
 					log.Error("ChangeStatus: %v", err)
 				} else {
 					log.Trace("Issue [%d] status changed to closed: %v", issue.ID, issue.IsClosed)
@@ -0,0 +1,129 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/db/errors"
+	"gogs.io/gogs/internal/form"
+	"gogs.io/gogs/internal/sync"
+)
+
+// maxDuplicateSuggestions is the maximum number of issues to be suggested as
+// duplicates of a new issue.
+const maxDuplicateSuggestions = 5
+
+// suggestionLimiter limits lookups of duplicate suggestions of each user, which
+// are sent while the user is typing the title of a new issue.
+var suggestionLimiter = sync.NewRateLimiter(60, time.Minute)
+
+type issueSuggestion struct {
+	Index       int64   `json:"number"`
+	Title       string  `json:"title"`
+	State       string  `json:"state"`
+	NumComments int     `json:"comments"`
+	URL         string  `json:"html_url"`
+	Score       float64 `json:"score"`
+	IsLikely    bool    `json:"is_likely"`
+}
+
+// IssueSuggestions returns existing issues that might be duplicates of a new
+// issue with given title.
+func IssueSuggestions(c *context.Context) {
+	if !suggestionLimiter.Allow(fmt.Sprintf("%d", c.User.ID)) {
+		c.Error(http.StatusTooManyRequests)
+		return
+	}
+
+	title := c.QueryTrim("title")
+	if len(title) < 3 {
+		c.JSONSuccess([]*issueSuggestion{})
+		return
+	}
+
+	suggestions, err := db.SuggestDuplicateIssues(c.Repo.Repository.ID, title, maxDuplicateSuggestions)
+	if err != nil {
+		c.ServerError("SuggestDuplicateIssues", err)
+		return
+	}
+
+	results := make([]*issueSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		state := "open"
+		if s.IsClosed {
+			state = "closed"
+		}
+		results[i] = &issueSuggestion{
+			Index:       s.Index,
+			Title:       s.Title,
+			State:       state,
+			NumComments: s.NumComments,
+			URL:         fmt.Sprintf("%s/issues/%d", c.Repo.RepoLink, s.Index),
+			Score:       s.Score,
+			IsLikely:    s.IsLikely(),
+		}
+	}
+	c.JSONSuccess(results)
+}
+
+// likelyDuplicates returns suggestions of the new issue if the most similar
+// one is likely a duplicate.
+func likelyDuplicates(c *context.Context, title string) ([]*db.DuplicateSuggestion, error) {
+	suggestions, err := db.SuggestDuplicateIssues(c.Repo.Repository.ID, title, maxDuplicateSuggestions)
+	if err != nil {
+		return nil, err
+	} else if len(suggestions) == 0 || !suggestions[0].IsLikely() {
+		return nil, nil
+	}
+	return suggestions, nil
+}
+
+func MarkIssueDuplicate(c *context.Context, f form.MarkDuplicate) {
+	issue := getActionIssue(c)
+	if c.Written() {
+		return
+	}
+
+	if !issue.IsPoster(c.User.ID) && !c.Repo.IsWriter() {
+		c.Error(http.StatusForbidden)
+		return
+	}
+
+	issueLink := fmt.Sprintf("%s/issues/%d", c.Repo.RepoLink, issue.Index)
+	if c.HasError() {
+		c.Flash.Error(c.Tr("repo.issues.duplicate_index_required"))
+		c.Redirect(issueLink)
+		return
+	}
+
+	canonical, err := db.GetIssueByIndex(c.Repo.Repository.ID, f.Index)
+	if err != nil {
+		if errors.IsIssueNotExist(err) {
+			c.Flash.Error(c.Tr("repo.issues.duplicate_not_exist", f.Index))
+			c.Redirect(issueLink)
+		} else {
+			c.ServerError("GetIssueByIndex", err)
+		}
+		return
+	}
+
+	if err = issue.MarkAsDuplicate(c.User, c.Repo.Repository, canonical, f.TransferParticipants); err != nil {
+		if db.IsErrInvalidDuplicateIssue(err) {
+			c.Flash.Error(c.Tr("repo.issues.duplicate_invalid", f.Index))
+			c.Redirect(issueLink)
+		} else {
+			c.ServerError("MarkAsDuplicate", err)
+		}
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.issues.duplicate_success", canonical.Index))
+	c.Redirect(issueLink)
+}
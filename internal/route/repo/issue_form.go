@@ -0,0 +1,89 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"strings"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/markup"
+)
+
+const (
+	ISSUE_CHOOSE = "repo/issue/choose"
+
+	// ISSUE_FORM_FIELD_PREFIX is the prefix of names of inputs of issue form fields.
+	ISSUE_FORM_FIELD_PREFIX = "form_"
+)
+
+// issueForms returns issue forms of the default branch.
+func issueForms(c *context.Context) ([]*db.IssueForm, error) {
+	if c.Repo.Repository.IsBare {
+		return nil, nil
+	}
+
+	if c.Repo.Commit == nil {
+		var err error
+		c.Repo.Commit, err = c.Repo.GitRepo.GetBranchCommit(c.Repo.Repository.DefaultBranch)
+		if err != nil {
+			return nil, nil
+		}
+	}
+	return db.IssueForms(c.Repo.Commit)
+}
+
+// setIssueForm sets the issue form with given file name to be rendered, and
+// returns nil if the form does not exist.
+func setIssueForm(c *context.Context, forms []*db.IssueForm, filename string) *db.IssueForm {
+	var form *db.IssueForm
+	for _, f := range forms {
+		if f.Filename == filename {
+			form = f
+			break
+		}
+	}
+	if form == nil {
+		return nil
+	}
+
+	for _, field := range form.Body {
+		if field.Type == db.ISSUE_FORM_FIELD_MARKDOWN {
+			field.RenderedValue = string(markup.Markdown(field.Attributes.Value, c.Repo.RepoLink, c.Repo.Repository.ComposeMetas()))
+		}
+	}
+	c.Data["IssueForm"] = form
+	c.Data["IssueFormValues"] = db.IssueFormValues{}
+	return form
+}
+
+// parseIssueFormValues returns values of fields of the issue form in the request.
+func parseIssueFormValues(c *context.Context, form *db.IssueForm) db.IssueFormValues {
+	values := make(db.IssueFormValues, len(form.Body))
+	for _, field := range form.Body {
+		if !field.IsInput() {
+			continue
+		}
+
+		var selected []string
+		for _, v := range c.Req.Form[ISSUE_FORM_FIELD_PREFIX+field.ID] {
+			if strings.TrimSpace(v) != "" {
+				selected = append(selected, v)
+			}
+		}
+		if len(selected) > 0 {
+			values[field.ID] = selected
+		}
+	}
+	return values
+}
+
+// issueFormValueErrorMessage returns the translated message of the first error.
+func issueFormValueErrorMessage(c *context.Context, errs []db.IssueFormValueError) string {
+	if errs[0].IsMissing {
+		return c.Tr("repo.issues.form.field_required", errs[0].Label)
+	}
+	return c.Tr("repo.issues.form.field_invalid", errs[0].Label)
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"fmt"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+const SETTINGS_ISSUE_AUTO_LOCK = "repo/settings/auto_lock"
+
+func LockIssue(c *context.Context, f form.LockIssue) {
+	issue := getActionIssue(c)
+	if c.Written() {
+		return
+	}
+
+	issueLink := fmt.Sprintf("%s/issues/%d", c.Repo.RepoLink, issue.Index)
+	if !db.IsValidIssueLockReason(f.Reason) {
+		c.Flash.Error(c.Tr("repo.issues.lock_invalid_reason"))
+		c.Redirect(issueLink)
+		return
+	}
+
+	if err := issue.Lock(f.Reason); err != nil {
+		c.ServerError("Lock", err)
+		return
+	}
+	log.Trace("Issue locked by %s: %d/%d", c.User.Name, c.Repo.Repository.ID, issue.ID)
+
+	c.Flash.Success(c.Tr("repo.issues.lock_success"))
+	c.Redirect(issueLink)
+}
+
+func UnlockIssue(c *context.Context) {
+	issue := getActionIssue(c)
+	if c.Written() {
+		return
+	}
+
+	if err := issue.Unlock(); err != nil {
+		c.ServerError("Unlock", err)
+		return
+	}
+	log.Trace("Issue unlocked by %s: %d/%d", c.User.Name, c.Repo.Repository.ID, issue.ID)
+
+	c.Flash.Success(c.Tr("repo.issues.unlock_success"))
+	c.Redirect(fmt.Sprintf("%s/issues/%d", c.Repo.RepoLink, issue.Index))
+}
+
+func IssueAutoLock(c *context.Context) {
+	c.Title("repo.settings.issue_auto_lock")
+	c.PageIs("SettingsIssueAutoLock")
+
+	l, err := db.GetIssueAutoLock(c.Repo.Repository.ID)
+	if err != nil {
+		if !db.IsErrIssueAutoLockNotExist(err) {
+			c.ServerError("GetIssueAutoLock", err)
+			return
+		}
+		l = &db.IssueAutoLock{
+			RepoID:    c.Repo.Repository.ID,
+			AfterDays: 365,
+		}
+	}
+	c.Data["AutoLock"] = l
+	c.Data["IsEnabled"] = err == nil
+	c.Success(SETTINGS_ISSUE_AUTO_LOCK)
+}
+
+func IssueAutoLockPost(c *context.Context, f form.IssueAutoLock) {
+	c.Title("repo.settings.issue_auto_lock")
+	c.PageIs("SettingsIssueAutoLock")
+
+	if !f.Enabled {
+		if err := db.DeleteIssueAutoLock(c.Repo.Repository.ID); err != nil {
+			c.ServerError("DeleteIssueAutoLock", err)
+			return
+		}
+		c.Flash.Success(c.Tr("repo.settings.issue_auto_lock_disabled"))
+		c.Redirect(c.Repo.RepoLink + "/settings/auto-lock")
+		return
+	}
+
+	l := &db.IssueAutoLock{
+		RepoID:      c.Repo.Repository.ID,
+		AfterDays:   f.AfterDays,
+		ExemptLabel: f.ExemptLabel,
+		Comment:     f.Comment,
+		DoerID:      c.User.ID,
+	}
+	c.Data["AutoLock"] = l
+	c.Data["IsEnabled"] = true
+	if c.HasError() {
+		c.Success(SETTINGS_ISSUE_AUTO_LOCK)
+		return
+	}
+
+	if err := db.UpdateIssueAutoLock(l); err != nil {
+		if db.IsErrInvalidIssueAutoLock(err) {
+			c.RenderWithErr(c.Tr("repo.settings.issue_auto_lock_invalid"), SETTINGS_ISSUE_AUTO_LOCK, &f)
+		} else {
+			c.ServerError("UpdateIssueAutoLock", err)
+		}
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.issue_auto_lock_success"))
+	c.Redirect(c.Repo.RepoLink + "/settings/auto-lock")
+}
@@ -0,0 +1,114 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"fmt"
+	"time"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+const (
+	MUTE_SCHEDULE = "repo/mute_schedule"
+)
+
+// formatMinutes formats minutes since midnight as "HH:MM".
+func formatMinutes(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// parseMinutes parses "HH:MM" to minutes since midnight.
+func parseMinutes(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func MuteSchedule(c *context.Context) {
+	c.Data["Title"] = c.Tr("repo.mute_schedule")
+
+	s, err := db.GetMuteSchedule(c.User.ID, c.Repo.Repository.ID)
+	if err != nil {
+		if !db.IsErrMuteScheduleNotExist(err) {
+			c.ServerError("GetMuteSchedule", err)
+			return
+		}
+
+		// Suggest muting off-hours of weekdays.
+		s = &db.MuteSchedule{
+			Days:     0x3e, // Monday to Friday
+			Start:    18 * 60,
+			End:      9 * 60,
+			Timezone: "UTC",
+		}
+	} else {
+		c.Data["HasMuteSchedule"] = true
+	}
+	c.Data["MuteSchedule"] = s
+	c.Data["MuteStart"] = formatMinutes(s.Start)
+	c.Data["MuteEnd"] = formatMinutes(s.End)
+	c.Data["Weekdays"] = []time.Weekday{
+		time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+	}
+
+	c.Success(MUTE_SCHEDULE)
+}
+
+func MuteSchedulePost(c *context.Context, f form.MuteSchedule) {
+	link := c.Repo.RepoLink + "/mute-schedule"
+	if c.HasError() {
+		c.Flash.Error(c.Tr("repo.mute_schedule.invalid"))
+		c.Redirect(link)
+		return
+	}
+
+	s := &db.MuteSchedule{
+		UserID:   c.User.ID,
+		RepoID:   c.Repo.Repository.ID,
+		Timezone: f.Timezone,
+	}
+	for _, day := range f.Days {
+		if day >= 0 && day < 7 {
+			s.Days |= 1 << uint(day)
+		}
+	}
+
+	var err1, err2 error
+	s.Start, err1 = parseMinutes(f.Start)
+	s.End, err2 = parseMinutes(f.End)
+	if err1 != nil || err2 != nil {
+		c.Flash.Error(c.Tr("repo.mute_schedule.invalid"))
+		c.Redirect(link)
+		return
+	}
+
+	if err := db.UpdateMuteSchedule(s); err != nil {
+		if db.IsErrInvalidMuteSchedule(err) {
+			c.Flash.Error(c.Tr("repo.mute_schedule.invalid"))
+			c.Redirect(link)
+		} else {
+			c.ServerError("UpdateMuteSchedule", err)
+		}
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.mute_schedule.update_success"))
+	c.Redirect(link)
+}
+
+func DeleteMuteSchedulePost(c *context.Context) {
+	if err := db.DeleteMuteSchedule(c.User.ID, c.Repo.Repository.ID); err != nil {
+		c.ServerError("DeleteMuteSchedule", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.mute_schedule.delete_success"))
+	c.Redirect(c.Repo.RepoLink + "/mute-schedule")
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+	"gogs.io/gogs/internal/tool"
+)
+
+const (
+	WATCH_PATHS = "repo/watch_paths"
+)
+
+func WatchPaths(c *context.Context) {
+	c.Data["Title"] = c.Tr("repo.watch_paths")
+
+	watches, err := db.GetPathWatches(c.User.ID, c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetPathWatches", err)
+		return
+	}
+	c.Data["PathWatches"] = watches
+
+	c.Success(WATCH_PATHS)
+}
+
+func WatchPathsPost(c *context.Context, f form.PathWatch) {
+	redirectTo := c.Query("redirect_to")
+	if !tool.IsSameSiteURLPath(redirectTo) {
+		redirectTo = c.Repo.RepoLink + "/watch-paths"
+	}
+	if c.HasError() {
+		c.Flash.Error(c.Tr("repo.watch_paths.invalid_pattern", f.Pattern))
+		c.Redirect(redirectTo)
+		return
+	}
+
+	if err := db.CreatePathWatch(&db.PathWatch{
+		UserID:            c.User.ID,
+		RepoID:            c.Repo.Repository.ID,
+		Pattern:           f.Pattern,
+		DefaultBranchOnly: f.DefaultBranchOnly,
+	}); err != nil {
+		switch {
+		case db.IsErrInvalidPathWatchPattern(err):
+			c.Flash.Error(c.Tr("repo.watch_paths.invalid_pattern", f.Pattern))
+		case db.IsErrPathWatchAlreadyExist(err):
+			c.Flash.Info(c.Tr("repo.watch_paths.already_exist", f.Pattern))
+		case db.IsErrPathWatchLimitReached(err):
+			c.Flash.Error(c.Tr("repo.watch_paths.limit_reached", err.(db.ErrPathWatchLimitReached).Limit))
+		default:
+			c.ServerError("CreatePathWatch", err)
+			return
+		}
+		c.Redirect(redirectTo)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.watch_paths.add_success", f.Pattern))
+	c.Redirect(redirectTo)
+}
+
+func DeleteWatchPathPost(c *context.Context) {
+	w, err := db.GetPathWatchByID(c.Repo.Repository.ID, c.QueryInt64("id"))
+	if err != nil {
+		c.NotFoundOrServerError("GetPathWatchByID", db.IsErrPathWatchNotExist, err)
+		return
+	} else if w.UserID != c.User.ID {
+		c.NotFound()
+		return
+	}
+
+	if err = db.DeletePathWatch(c.Repo.Repository.ID, w.ID); err != nil {
+		c.ServerError("DeletePathWatch", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.watch_paths.delete_success", w.Pattern))
+	c.Redirect(c.Repo.RepoLink + "/watch-paths")
+}
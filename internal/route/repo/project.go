@@ -0,0 +1,42 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"github.com/json-iterator/go"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+// setProjectRoots sets subprojects of the default branch to be listed in the
+// repository home page, which are cached by the commit ID. It does nothing
+// when the repository is not a monorepo, i.e. with only a project at the root.
+func setProjectRoots(c *context.Context) {
+	key := c.Repo.Repository.ProjectRootsCacheKey(c.Repo.CommitID)
+	var projects []db.ProjectRoot
+	if v, ok := c.Cache.Get(key).(string); !ok || jsoniter.Unmarshal([]byte(v), &projects) != nil {
+		var err error
+		projects, err = c.Repo.Repository.ProjectRoots()
+		if err != nil {
+			log.Error("Failed to get project roots [repo_id: %d]: %v", c.Repo.Repository.ID, err)
+			return
+		}
+
+		data, err := jsoniter.Marshal(projects)
+		if err == nil {
+			err = c.Cache.Put(key, string(data), 24*60*60)
+		}
+		if err != nil {
+			log.Error("Failed to cache project roots [key: %s]: %v", key, err)
+		}
+	}
+
+	if len(projects) == 0 || (len(projects) == 1 && projects[0].Path == "") {
+		return
+	}
+	c.Data["ProjectRoots"] = projects
+}
@@ -133,8 +133,10 @@ func ForkPost(c *context.Context, f form.CreateRepo) {
 	if err != nil {
 		c.Data["Err_RepoName"] = true
 		switch {
+		case db.IsErrRepoCreationForbidden(err):
+			c.RenderWithErr(c.Tr("repo.form.creation_forbidden_"+err.(db.ErrRepoCreationForbidden).Reason), FORK, &f)
 		case errors.IsReachLimitOfRepo(err):
-			c.RenderWithErr(c.Tr("repo.form.reach_limit_of_creation", c.User.RepoCreationNum()), FORK, &f)
+			c.RenderWithErr(c.Tr("repo.form.reach_limit_of_creation", err.(errors.ReachLimitOfRepo).Limit), FORK, &f)
 		case db.IsErrRepoAlreadyExist(err):
 			c.RenderWithErr(c.Tr("repo.settings.new_owner_has_same_repo"), FORK, &f)
 		case db.IsErrNameReserved(err):
@@ -242,6 +244,18 @@ func PrepareViewPullInfo(c *context.Context, issue *db.Issue) *git.PullRequestIn
 	return prInfo
 }
 
+// setMergeMessage sets the message to pre-fill the merge dialog, failures of
+// rendering the template of the repository are only logged.
+func setMergeMessage(c *context.Context, issue *db.Issue, prInfo *git.PullRequestInfo) {
+	pull := issue.PullRequest
+	pull.Issue = issue
+	msg, err := pull.MergeMessage(c.User, prInfo.Commits)
+	if err != nil {
+		log.Warn("Failed to render merge message [repo_id: %d]: %v", c.Repo.Repository.ID, err)
+	}
+	c.Data["MergeMessage"] = msg
+}
+
 func ViewPullCommits(c *context.Context) {
 	c.Data["PageIsPullList"] = true
 	c.Data["PageIsPullCommits"] = true
@@ -288,6 +302,11 @@ func ViewPullCommits(c *context.Context) {
 			return
 		}
 		commits = prInfo.Commits
+
+		if !issue.IsClosed {
+			setCherryPicks(c, pull)
+			setCommitLintResults(c, commits)
+		}
 	}
 
 	commits = db.ValidateCommitsWithEmails(commits)
@@ -297,6 +316,29 @@ func ViewPullCommits(c *context.Context) {
 	c.Success(PULL_COMMITS)
 }
 
+// setCommitLintResults reports commits of the pull request that violate commit
+// lint rules of the base repository as warnings.
+func setCommitLintResults(c *context.Context, commits *list.List) {
+	rules, err := db.GetCommitLintRules(c.Repo.Repository.ID)
+	if err != nil {
+		log.Error("Failed to get commit lint rules of repository [%d]: %v", c.Repo.Repository.ID, err)
+		return
+	} else if !rules.IsEnabled() {
+		return
+	}
+
+	gitCommits := make([]*git.Commit, 0, commits.Len())
+	for e := commits.Front(); e != nil; e = e.Next() {
+		gitCommits = append(gitCommits, e.Value.(*git.Commit))
+	}
+	results, err := c.Repo.Repository.LintCommitMessages(gitCommits, *rules)
+	if err != nil {
+		log.Error("Failed to lint commit messages of repository [%d]: %v", c.Repo.Repository.ID, err)
+		return
+	}
+	c.Data["CommitLintResults"] = results
+}
+
 func ViewPullFiles(c *context.Context) {
 	c.Data["PageIsPullList"] = true
 	c.Data["PageIsPullFiles"] = true
@@ -353,11 +395,11 @@ func ViewPullFiles(c *context.Context) {
 		gitRepo = headGitRepo
 	}
 
-	diff, err := db.GetDiffRange(diffRepoPath,
+	diff, err := db.GetDiffRangeContext(c.Req.Request.Context(), diffRepoPath,
 		startCommitID, endCommitID, conf.Git.MaxGitDiffLines,
 		conf.Git.MaxGitDiffLineCharacters, conf.Git.MaxGitDiffFiles)
 	if err != nil {
-		c.ServerError("GetDiffRange", err)
+		c.UnavailableOrServerError("GetDiffRange", err)
 		return
 	}
 	c.Data["Diff"] = diff
@@ -368,8 +410,12 @@ func ViewPullFiles(c *context.Context) {
 		c.ServerError("GetCommit", err)
 		return
 	}
+	if err = diff.CollapseGeneratedFiles(commit); err != nil {
+		c.ServerError("CollapseGeneratedFiles", err)
+		return
+	}
 
-	setEditorconfigIfExists(c)
+	setEditorconfigIfExists(c, "")
 	if c.Written() {
 		return
 	}
@@ -388,10 +434,143 @@ func ViewPullFiles(c *context.Context) {
 		c.Data["RawPath"] = conf.Server.Subpath + "/" + path.Join(headTarget, "raw", endCommitID)
 	}
 
+	if c.IsLogged && !pull.HasMerged {
+		state, err := c.Repo.PullReviewState(pull, c.User)
+		if err != nil {
+			c.ServerError("PullReviewState", err)
+			return
+		}
+		viewed, total := state.Progress()
+		c.Data["CanMarkReviewed"] = true
+		c.Data["ReviewedFiles"] = state.ReviewedFiles
+		c.Data["ReviewedCount"] = viewed
+		c.Data["ReviewTotal"] = total
+	}
+	if !pull.HasMerged {
+		setPullCodeOwners(c, pull)
+	}
+
 	c.Data["RequireHighlightJS"] = true
 	c.Success(PULL_FILES)
 }
 
+// setPullCodeOwners sets ownership of changed files of the pull request,
+// failures of resolving owners are only logged.
+func setPullCodeOwners(c *context.Context, pull *db.PullRequest) {
+	owners, err := c.Repo.PullCodeOwners(pull)
+	if err != nil {
+		log.Error("Failed to get code owners of pull request [%d]: %v", pull.ID, err)
+		return
+	} else if len(owners.Groups) == 0 {
+		return
+	}
+
+	c.Data["CodeOwners"] = owners
+	if c.IsLogged {
+		c.Data["IsCodeOwner"] = owners.IsOwner(c.User.ID)
+		c.Data["HasApprovedCodeOwners"] = owners.IsApprovedBy(c.User.ID)
+	}
+}
+
+// setCodeOwnersPending sets groups of changed files that still need approval
+// of their owners when the base branch requires it to merge the pull request,
+// failures of resolving owners are only logged.
+func setCodeOwnersPending(c *context.Context, pull *db.PullRequest) {
+	if !db.IsBranchOfRepoRequireCodeOwnersApproval(c.Repo.Repository.ID, pull.BaseBranch) {
+		return
+	}
+
+	owners, err := c.Repo.PullCodeOwners(pull)
+	if err != nil {
+		log.Error("Failed to get code owners of pull request [%d]: %v", pull.ID, err)
+		return
+	}
+	c.Data["CodeOwnersPending"] = owners.PendingGroups()
+}
+
+// ReviewPullCodeOwners approves files of the pull request that the current
+// user owns at the head commit the user has reviewed, or withdraws the approval.
+func ReviewPullCodeOwners(c *context.Context) {
+	issue := checkPullInfo(c)
+	if c.Written() {
+		return
+	}
+	pull := issue.PullRequest
+	if pull.HasMerged || issue.IsClosed {
+		c.NotFound()
+		return
+	}
+
+	link := c.Repo.RepoLink + "/pulls/" + com.ToStr(issue.Index) + "/files"
+	if c.Query("action") != "approve" {
+		if err := pull.WithdrawCodeOwnersApproval(c.User.ID); err != nil {
+			c.ServerError("WithdrawCodeOwnersApproval", err)
+			return
+		}
+		c.Flash.Success(c.Tr("repo.pulls.code_owners_withdrawn"))
+		c.Redirect(link)
+		return
+	}
+
+	owners, err := c.Repo.PullCodeOwners(pull)
+	if err != nil {
+		c.ServerError("PullCodeOwners", err)
+		return
+	} else if !owners.IsOwner(c.User.ID) {
+		c.Error(403)
+		return
+	}
+
+	// Only approve the commit the owner has reviewed, new commits pushed since
+	// then have to be reviewed again.
+	if c.Query("commit_id") != owners.HeadCommitID {
+		c.Flash.Error(c.Tr("repo.pulls.code_owners_outdated"))
+		c.Redirect(link)
+		return
+	}
+	if err = pull.ApproveCodeOwners(c.User.ID, owners.HeadCommitID); err != nil {
+		c.ServerError("ApproveCodeOwners", err)
+		return
+	}
+	c.Flash.Success(c.Tr("repo.pulls.code_owners_approved"))
+	c.Redirect(link)
+}
+
+type reviewProgress struct {
+	Viewed int `json:"viewed"`
+	Total  int `json:"total"`
+}
+
+// MarkPullFileReviewed marks a file of the pull request as viewed by the
+// current user or removes the mark, and responds with the review progress.
+func MarkPullFileReviewed(c *context.Context) {
+	issue := checkPullInfo(c)
+	if c.Written() {
+		return
+	}
+	pull := issue.PullRequest
+	treePath := c.Query("path")
+	if pull.HasMerged || treePath == "" {
+		c.NotFound()
+		return
+	}
+
+	state, err := c.Repo.PullReviewState(pull, c.User)
+	if err != nil {
+		c.ServerError("PullReviewState", err)
+		return
+	}
+	if err = state.ToggleFile(treePath, c.QueryBool("viewed")); err != nil {
+		c.NotFoundOrServerError("ToggleFile", db.IsErrPullFileNotChanged, err)
+		return
+	}
+	viewed, total := state.Progress()
+	c.JSONSuccess(&reviewProgress{
+		Viewed: viewed,
+		Total:  total,
+	})
+}
+
 func MergePullRequest(c *context.Context) {
 	issue := checkPullInfo(c)
 	if c.Written() {
@@ -415,7 +594,21 @@ func MergePullRequest(c *context.Context) {
 
 	pr.Issue = issue
 	pr.Issue.Repo = c.Repo.Repository
-	if err = pr.Merge(c.User, c.Repo.GitRepo, db.MergeStyle(c.Query("merge_style")), c.Query("commit_description")); err != nil {
+	if err = pr.Merge(c.User, c.Repo.GitRepo, db.MergeStyle(c.Query("merge_style")), c.Query("commit_message"),
+		c.PushCredential(), c.RemoteAddr()); err != nil {
+		if db.IsErrMergeCommitNotAllowed(err) {
+			c.Flash.Error(c.Tr("repo.pulls.merge_commit_not_allowed"))
+			c.Redirect(c.Repo.RepoLink + "/pulls/" + com.ToStr(pr.Index))
+			return
+		} else if db.IsErrOrgRuleViolation(err) {
+			c.Flash.Error(c.Tr("repo.pulls.merge_org_rule_violation", err.Error()))
+			c.Redirect(c.Repo.RepoLink + "/pulls/" + com.ToStr(pr.Index))
+			return
+		} else if db.IsErrCodeOwnersApprovalRequired(err) {
+			c.Flash.Error(c.Tr("repo.pulls.merge_code_owners_required", strings.Join(err.(db.ErrCodeOwnersApprovalRequired).Patterns, ", ")))
+			c.Redirect(c.Repo.RepoLink + "/pulls/" + com.ToStr(pr.Index))
+			return
+		}
 		c.ServerError("Merge", err)
 		return
 	}
@@ -483,18 +676,26 @@ func ParseCompareInfo(c *context.Context) (*db.User, *db.Repository, *git.Reposi
 		headGitRepo *git.Repository
 	)
 
+	// Pull requests from the upstream of a fork bring in changes that cannot be
+	// synced by fast-forward.
+	isFromUpstream := !isSameRepo && baseRepo.IsFork && baseRepo.BaseRepo != nil && headUser.ID == baseRepo.BaseRepo.OwnerID
+
 	// In case user included redundant head user name for comparison in same repository,
 	// no need to check the fork relation.
 	if !isSameRepo {
-		var has bool
-		headRepo, has, err = db.HasForkedRepo(headUser.ID, baseRepo.ID)
-		if err != nil {
-			c.ServerError("HasForkedRepo", err)
-			return nil, nil, nil, nil, "", ""
-		} else if !has {
-			log.Trace("ParseCompareInfo [base_repo_id: %d]: does not have fork or in same repository", baseRepo.ID)
-			c.NotFound()
-			return nil, nil, nil, nil, "", ""
+		if isFromUpstream {
+			headRepo = baseRepo.BaseRepo
+		} else {
+			var has bool
+			headRepo, has, err = db.HasForkedRepo(headUser.ID, baseRepo.ID)
+			if err != nil {
+				c.ServerError("HasForkedRepo", err)
+				return nil, nil, nil, nil, "", ""
+			} else if !has {
+				log.Trace("ParseCompareInfo [base_repo_id: %d]: does not have fork or in same repository", baseRepo.ID)
+				c.NotFound()
+				return nil, nil, nil, nil, "", ""
+			}
 		}
 
 		headGitRepo, err = git.OpenRepository(db.RepoPath(headUser.Name, headRepo.Name))
@@ -507,7 +708,13 @@ func ParseCompareInfo(c *context.Context) (*db.User, *db.Repository, *git.Reposi
 		headGitRepo = c.Repo.GitRepo
 	}
 
-	if !c.User.IsWriterOfRepo(headRepo) && !c.User.IsAdmin {
+	if isFromUpstream {
+		if !headRepo.HasAccess(c.User.ID) && !c.User.IsAdmin {
+			log.Trace("ParseCompareInfo [base_repo_id: %d]: does not have read access to upstream or site admin", baseRepo.ID)
+			c.NotFound()
+			return nil, nil, nil, nil, "", ""
+		}
+	} else if !c.User.IsWriterOfRepo(headRepo) && !c.User.IsAdmin {
 		log.Trace("ParseCompareInfo [base_repo_id: %d]: does not have write access or site admin", baseRepo.ID)
 		c.NotFound()
 		return nil, nil, nil, nil, "", ""
@@ -519,9 +726,12 @@ func ParseCompareInfo(c *context.Context) (*db.User, *db.Repository, *git.Reposi
 		return nil, nil, nil, nil, "", ""
 	}
 
-	headBranches, err := headGitRepo.GetBranches()
+	if !c.SetBranches() {
+		return nil, nil, nil, nil, "", ""
+	}
+	headBranches, err := headRepo.GetBranchNames(headGitRepo)
 	if err != nil {
-		c.ServerError("GetBranches", err)
+		c.ServerError("GetBranchNames", err)
 		return nil, nil, nil, nil, "", ""
 	}
 	c.Data["HeadBranches"] = headBranches
@@ -569,11 +779,11 @@ func PrepareCompareDiff(
 		return true
 	}
 
-	diff, err := db.GetDiffRange(db.RepoPath(headUser.Name, headRepo.Name),
+	diff, err := db.GetDiffRangeContext(c.Req.Request.Context(), db.RepoPath(headUser.Name, headRepo.Name),
 		prInfo.MergeBase, headCommitID, conf.Git.MaxGitDiffLines,
 		conf.Git.MaxGitDiffLineCharacters, conf.Git.MaxGitDiffFiles)
 	if err != nil {
-		c.ServerError("GetDiffRange", err)
+		c.UnavailableOrServerError("GetDiffRange", err)
 		return false
 	}
 	c.Data["Diff"] = diff
@@ -584,6 +794,10 @@ func PrepareCompareDiff(
 		c.ServerError("GetCommit", err)
 		return false
 	}
+	if err = diff.CollapseGeneratedFiles(headCommit); err != nil {
+		c.ServerError("CollapseGeneratedFiles", err)
+		return false
+	}
 
 	prInfo.Commits = db.ValidateCommitsWithEmails(prInfo.Commits)
 	c.Data["Commits"] = prInfo.Commits
@@ -599,6 +813,26 @@ func PrepareCompareDiff(
 	return false
 }
 
+// previewReviewers sets code owners of files changed by the pull request to be
+// opened as suggested reviewers, excluding the author. It is only a preview, so
+// errors are logged without failing the page.
+func previewReviewers(c *context.Context, headRepo *db.Repository, prInfo *git.PullRequestInfo, baseBranch, headBranch string) {
+	users, teams, err := c.Repo.Repository.PreviewReviewersOfCompare(headRepo.RepoPath(), baseBranch, prInfo.MergeBase, headBranch)
+	if err != nil {
+		log.Error("Failed to preview reviewers [repo_id: %d]: %v", c.Repo.Repository.ID, err)
+		return
+	}
+
+	reviewers := make([]*db.User, 0, len(users))
+	for _, u := range users {
+		if u.ID != c.User.ID {
+			reviewers = append(reviewers, u)
+		}
+	}
+	c.Data["ReviewerUsers"] = reviewers
+	c.Data["ReviewerTeams"] = teams
+}
+
 func CompareAndPullRequest(c *context.Context) {
 	c.Data["Title"] = c.Tr("repo.pulls.compare_changes")
 	c.Data["PageIsComparePull"] = true
@@ -636,9 +870,11 @@ func CompareAndPullRequest(c *context.Context) {
 		if c.Written() {
 			return
 		}
+
+		previewReviewers(c, headRepo, prInfo, baseBranch, headBranch)
 	}
 
-	setEditorconfigIfExists(c)
+	setEditorconfigIfExists(c, "")
 	if c.Written() {
 		return
 	}
@@ -737,6 +973,47 @@ func CompareAndPullRequestPost(c *context.Context, f form.NewIssue) {
 	c.Redirect(c.Repo.RepoLink + "/pulls/" + com.ToStr(pullIssue.Index))
 }
 
+// setRecentPush sets the branch recently pushed by the context user, which is
+// suggested for composing a pull request while it still can be composed.
+func setRecentPush(c *context.Context) {
+	if !c.IsLogged || c.Repo.GitRepo == nil {
+		return
+	}
+
+	push, err := db.GetRecentPush(c.User.ID, c.Repo.Repository.ID)
+	if err != nil {
+		log.Error("Failed to get recent push [user_id: %d, repo_id: %d]: %v", c.User.ID, c.Repo.Repository.ID, err)
+		return
+	} else if push == nil || !c.Repo.GitRepo.IsBranchExist(push.Branch) {
+		return
+	}
+
+	link, err := c.Repo.Repository.ComposePullRequestURL(push.Branch)
+	if err != nil {
+		log.Error("Failed to compose pull request URL [repo_id: %d, branch: %s]: %v", c.Repo.Repository.ID, push.Branch, err)
+		return
+	} else if link == "" {
+		return
+	}
+	c.Data["RecentPush"] = push
+	c.Data["RecentPushPullRequestLink"] = conf.Server.Subpath + "/" + link
+}
+
+// DismissRecentPushPost stops suggesting the branch recently pushed by the
+// context user for composing a pull request.
+func DismissRecentPushPost(c *context.Context) {
+	if err := db.DismissRecentPush(c.User.ID, c.Repo.Repository.ID); err != nil {
+		c.ServerError("DismissRecentPush", err)
+		return
+	}
+
+	redirectTo := c.Query("redirect_to")
+	if !tool.IsSameSiteURLPath(redirectTo) {
+		redirectTo = c.Repo.RepoLink
+	}
+	c.Redirect(redirectTo)
+}
+
 func parseOwnerAndRepo(c *context.Context) (*db.User, *db.Repository) {
 	owner, err := db.GetUserByName(c.Params(":username"))
 	if err != nil {
@@ -782,5 +1059,9 @@ func TriggerTask(c *context.Context) {
 
 	go db.HookQueue.Add(repo.ID)
 	go db.AddTestPullRequestTask(pusher, repo.ID, branch, true)
+	if repo.IsDefaultBranchRef(git.BRANCH_PREFIX + branch) {
+		db.AddDependencyScanTask(repo.ID)
+		db.AddCommitStatsTask(repo.ID)
+	}
 	c.Status(202)
 }
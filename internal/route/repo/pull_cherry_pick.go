@@ -0,0 +1,57 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"fmt"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+// setCherryPicks sets commits of the open pull request that are already in
+// the base branch. Failures are only logged, since detection is a hint.
+func setCherryPicks(c *context.Context, pull *db.PullRequest) {
+	picks, err := pull.DetectCherryPicks()
+	if err != nil {
+		log.Error("Failed to detect cherry-picks of pull request [%d]: %v", pull.ID, err)
+		return
+	}
+	c.Data["CherryPicks"] = picks
+}
+
+func ClosePullAsMergedElsewhere(c *context.Context) {
+	issue := checkPullInfo(c)
+	if c.Written() {
+		return
+	}
+	pull := issue.PullRequest
+	if issue.IsClosed || pull.HasMerged {
+		c.NotFound()
+		return
+	}
+
+	picks, err := pull.DetectCherryPicks()
+	if err != nil {
+		c.ServerError("DetectCherryPicks", err)
+		return
+	} else if !picks.IsAll() {
+		c.Flash.Error(c.Tr("repo.pulls.not_all_in_base"))
+		c.Redirect(c.Repo.MakeURL(fmt.Sprintf("pulls/%d", issue.Index)))
+		return
+	}
+
+	pull.Issue = issue
+	content := c.Tr("repo.pulls.merged_elsewhere_comment") + "\n\n" + picks.MarkdownList()
+	if err = pull.CloseAsMergedElsewhere(c.User, content); err != nil {
+		c.ServerError("CloseAsMergedElsewhere", err)
+		return
+	}
+
+	log.Trace("Pull request closed as merged elsewhere: %d", pull.ID)
+	c.Redirect(c.Repo.MakeURL(fmt.Sprintf("pulls/%d", issue.Index)))
+}
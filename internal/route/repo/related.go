@@ -0,0 +1,31 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+// maxRelatedRepos is the number of related repositories shown on the home
+// page of a repository.
+const maxRelatedRepos = 5
+
+// setRelatedRepos sets repositories related to the repository that the user
+// can read.
+func setRelatedRepos(c *context.Context) {
+	var u *db.User
+	if c.IsLogged {
+		u = c.User
+	}
+	repos, err := c.Repo.Repository.GetRelatedRepositories(u, maxRelatedRepos)
+	if err != nil {
+		log.Error("Failed to get related repositories of repository [%d]: %v", c.Repo.Repository.ID, err)
+		return
+	}
+	c.Data["RelatedRepos"] = repos
+}
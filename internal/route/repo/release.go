@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/gogs/git-module"
 	log "unknwon.dev/clog/v2"
 
 	"gogs.io/gogs/internal/context"
@@ -18,20 +19,22 @@ import (
 )
 
 const (
-	RELEASES    = "repo/release/list"
-	RELEASE_NEW = "repo/release/new"
+	RELEASES      = "repo/release/list"
+	RELEASE_NEW   = "repo/release/new"
+	RELEASE_STATS = "repo/release/stats"
 )
 
+// releaseStatsDays is the number of recent days shown in download statistics
+// of a release.
+const releaseStatsDays = 30
+
 // calReleaseNumCommitsBehind calculates given release has how many commits behind release target.
 func calReleaseNumCommitsBehind(repoCtx *context.Repository, release *db.Release, countCache map[string]int64) error {
 	// Get count if not exists
 	if _, ok := countCache[release.Target]; !ok {
 		if repoCtx.GitRepo.IsBranchExist(release.Target) {
-			commit, err := repoCtx.GitRepo.GetBranchCommit(release.Target)
-			if err != nil {
-				return fmt.Errorf("GetBranchCommit: %v", err)
-			}
-			countCache[release.Target], err = commit.CommitsCount()
+			var err error
+			countCache[release.Target], err = repoCtx.Repository.CommitsCount(release.Target)
 			if err != nil {
 				return fmt.Errorf("CommitsCount: %v", err)
 			}
@@ -106,11 +109,29 @@ func Releases(c *context.Context) {
 				c.Handle(500, "CommitsCount", err)
 				return
 			}
-			results[i].NumCommitsBehind = c.Repo.CommitsCount - results[i].NumCommits
+			commitsCount, err := c.Repo.CommitsCount()
+			if err != nil {
+				c.Handle(500, "CommitsCount", err)
+				return
+			}
+			results[i].NumCommitsBehind = commitsCount - results[i].NumCommits
 		}
 	}
 	db.SortReleases(results)
 
+	tagNames := make([]string, len(results))
+	for i := range results {
+		tagNames[i] = results[i].TagName
+	}
+	verifications, err := c.Repo.Repository.TagSignatures(tagNames...)
+	if err != nil {
+		log.Error("TagSignatures [repo_id: %d]: %v", c.Repo.Repository.ID, err)
+	}
+	for _, r := range results {
+		r.IsTagProtected = c.Repo.IsTagProtected(r.TagName)
+		r.Verification = verifications[r.TagName]
+	}
+
 	// Only show drafts if user is viewing the latest page
 	var drafts []*db.Release
 	if tagsResult.HasLatest {
@@ -139,6 +160,13 @@ func Releases(c *context.Context) {
 		}
 	}
 
+	cadence, err := c.Repo.Repository.ReleaseCadence()
+	if err != nil {
+		log.Error("Failed to get release cadence [repo_id: %d]: %v", c.Repo.Repository.ID, err)
+	} else if cadence.NumReleases > 0 {
+		c.Data["Cadence"] = cadence
+	}
+
 	c.Data["Releases"] = results
 	c.Data["HasPrevious"] = !tagsResult.HasLatest
 	c.Data["ReachEnd"] = tagsResult.ReachEnd
@@ -157,11 +185,73 @@ func renderReleaseAttachmentSettings(c *context.Context) {
 	c.Data["AttachmentMaxFiles"] = conf.Release.Attachment.MaxFiles
 }
 
+// prefillReleaseChangelog fills the content of new release with the changelog
+// between "from" and "to" in the query, which default to the latest tag and the
+// default branch. The content is left empty when the changelog is not available.
+func prefillReleaseChangelog(c *context.Context) {
+	from := c.QueryTrim("from")
+	if from == "" {
+		tags, err := c.Repo.Tags()
+		if err != nil {
+			log.Error("Failed to get tags [repo_id: %d]: %v", c.Repo.Repository.ID, err)
+			return
+		} else if len(tags) == 0 {
+			return
+		}
+		from = tags[0]
+	}
+	to := c.QueryTrim("to")
+	if to == "" {
+		to = c.Repo.Repository.DefaultBranch
+	}
+
+	changelog, err := c.Repo.Repository.GenerateChangelog(from, to)
+	if err != nil {
+		if git.IsErrNotExist(err) || db.IsErrUnrelatedHistories(err) {
+			log.Trace("Changelog is not available [repo_id: %d, from: %s, to: %s]: %v", c.Repo.Repository.ID, from, to, err)
+		} else {
+			log.Error("Failed to generate changelog [repo_id: %d, from: %s, to: %s]: %v", c.Repo.Repository.ID, from, to, err)
+		}
+		return
+	} else if len(changelog.Groups) == 0 {
+		return
+	}
+
+	c.Data["content"] = changelog.Markdown(c.Repo.Repository)
+	c.Data["ChangelogFrom"] = from
+	c.Data["ChangelogTo"] = to
+}
+
+// releaseLinks returns external links of the release from labels and URLs of
+// the form, skipping rows that are left empty.
+func releaseLinks(labels, urls []string) []*db.ReleaseLink {
+	links := make([]*db.ReleaseLink, 0, len(labels))
+	for i := range labels {
+		label := strings.TrimSpace(labels[i])
+		var url string
+		if i < len(urls) {
+			url = strings.TrimSpace(urls[i])
+		}
+		if label == "" && url == "" {
+			continue
+		}
+		links = append(links, &db.ReleaseLink{
+			Label: label,
+			URL:   url,
+		})
+	}
+	return links
+}
+
 func NewRelease(c *context.Context) {
 	c.Data["Title"] = c.Tr("repo.release.new_release")
 	c.Data["PageIsReleaseList"] = true
 	c.Data["tag_target"] = c.Repo.Repository.DefaultBranch
 	renderReleaseAttachmentSettings(c)
+	if !c.SetBranches() {
+		return
+	}
+	prefillReleaseChangelog(c)
 	c.HTML(200, RELEASE_NEW)
 }
 
@@ -169,6 +259,12 @@ func NewReleasePost(c *context.Context, f form.NewRelease) {
 	c.Data["Title"] = c.Tr("repo.release.new_release")
 	c.Data["PageIsReleaseList"] = true
 	renderReleaseAttachmentSettings(c)
+	if !c.SetBranches() {
+		return
+	}
+
+	links := releaseLinks(f.LinkLabels, f.LinkURLs)
+	c.Data["links"] = links
 
 	if c.HasError() {
 		c.HTML(200, RELEASE_NEW)
@@ -220,12 +316,15 @@ func NewReleasePost(c *context.Context, f form.NewRelease) {
 		IsPrerelease: f.Prerelease,
 		CreatedUnix:  tagCreatedUnix,
 	}
-	if err = db.NewRelease(c.Repo.GitRepo, rel, attachments); err != nil {
-		c.Data["Err_TagName"] = true
+	if err = db.NewRelease(c.Repo.GitRepo, rel, attachments, links); err != nil {
 		switch {
+		case db.IsErrInvalidReleaseLink(err):
+			c.RenderWithErr(c.Tr("repo.release.link_invalid"), RELEASE_NEW, &f)
 		case db.IsErrReleaseAlreadyExist(err):
+			c.Data["Err_TagName"] = true
 			c.RenderWithErr(c.Tr("repo.release.tag_name_already_exist"), RELEASE_NEW, &f)
 		case db.IsErrInvalidTagName(err):
+			c.Data["Err_TagName"] = true
 			c.RenderWithErr(c.Tr("repo.release.tag_name_invalid"), RELEASE_NEW, &f)
 		default:
 			c.Handle(500, "NewRelease", err)
@@ -259,8 +358,10 @@ func EditRelease(c *context.Context) {
 	c.Data["title"] = rel.Title
 	c.Data["content"] = rel.Note
 	c.Data["attachments"] = rel.Attachments
+	c.Data["links"] = rel.Links
 	c.Data["prerelease"] = rel.IsPrerelease
 	c.Data["IsDraft"] = rel.IsDraft
+	c.Data["IsTagProtected"] = c.Repo.IsTagProtected(rel.TagName)
 
 	c.HTML(200, RELEASE_NEW)
 }
@@ -288,6 +389,10 @@ func EditReleasePost(c *context.Context, f form.EditRelease) {
 	c.Data["attachments"] = rel.Attachments
 	c.Data["prerelease"] = rel.IsPrerelease
 	c.Data["IsDraft"] = rel.IsDraft
+	c.Data["IsTagProtected"] = c.Repo.IsTagProtected(rel.TagName)
+
+	links := releaseLinks(f.LinkLabels, f.LinkURLs)
+	c.Data["links"] = links
 
 	if c.HasError() {
 		c.HTML(200, RELEASE_NEW)
@@ -304,13 +409,38 @@ func EditReleasePost(c *context.Context, f form.EditRelease) {
 	rel.Note = f.Content
 	rel.IsDraft = len(f.Draft) > 0
 	rel.IsPrerelease = f.Prerelease
-	if err = db.UpdateRelease(c.User, c.Repo.GitRepo, rel, isPublish, attachments); err != nil {
-		c.Handle(500, "UpdateRelease", err)
+	if err = db.UpdateRelease(c.User, c.Repo.GitRepo, rel, isPublish, attachments, links); err != nil {
+		if db.IsErrInvalidReleaseLink(err) {
+			c.RenderWithErr(c.Tr("repo.release.link_invalid"), RELEASE_NEW, &f)
+		} else {
+			c.Handle(500, "UpdateRelease", err)
+		}
 		return
 	}
 	c.Redirect(c.Repo.RepoLink + "/releases")
 }
 
+func ReleaseStats(c *context.Context) {
+	rel, err := db.GetRelease(c.Repo.Repository.ID, c.Params("*"))
+	if err != nil {
+		c.NotFoundOrServerError("get release", db.IsErrReleaseNotExist, err)
+		return
+	}
+
+	stats, err := rel.DownloadStats(releaseStatsDays)
+	if err != nil {
+		c.ServerError("get download stats", err)
+		return
+	}
+
+	c.Data["Title"] = c.Tr("repo.release.stats_title", rel.TagName)
+	c.Data["PageIsReleaseList"] = true
+	c.Data["Release"] = rel
+	c.Data["Stats"] = stats
+	c.Data["StatsDays"] = releaseStatsDays
+	c.Success(RELEASE_STATS)
+}
+
 func UploadReleaseAttachment(c *context.Context) {
 	if !conf.Release.Attachment.Enabled {
 		c.NotFound()
@@ -321,7 +451,11 @@ func UploadReleaseAttachment(c *context.Context) {
 
 func DeleteRelease(c *context.Context) {
 	if err := db.DeleteReleaseOfRepoByID(c.Repo.Repository.ID, c.QueryInt64("id")); err != nil {
-		c.Flash.Error("DeleteReleaseByID: " + err.Error())
+		if db.IsErrTagProtected(err) {
+			c.Flash.Error(c.Tr("repo.release.tag_protected", err.(db.ErrTagProtected).TagName))
+		} else {
+			c.Flash.Error("DeleteReleaseByID: " + err.Error())
+		}
 	} else {
 		c.Flash.Success(c.Tr("repo.release.deletion_success"))
 	}
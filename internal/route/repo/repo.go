@@ -6,6 +6,8 @@ package repo
 
 import (
 	"fmt"
+	"mime"
+	"net/http"
 	"os"
 	"path"
 	"strings"
@@ -75,21 +77,34 @@ func Create(c *context.Context) {
 	c.Data["Readmes"] = db.Readmes
 	c.Data["readme"] = "Default"
 	c.Data["private"] = c.User.LastRepoVisibility
-	c.Data["IsForcedPrivate"] = conf.Repository.ForcePrivate
 
 	ctxUser := checkContextUser(c, c.QueryInt64("org"))
 	if c.Written() {
 		return
 	}
 	c.Data["ContextUser"] = ctxUser
+	setForcedPrivate(c, ctxUser)
 
 	c.HTML(200, CREATE)
 }
 
+// setForcedPrivate sets whether new repositories of the owner are forced to be
+// private.
+func setForcedPrivate(c *context.Context, owner *db.User) {
+	policy, err := owner.RepoPolicy()
+	if err != nil {
+		log.Error("Failed to get repository policy of owner [%d]: %v", owner.ID, err)
+		return
+	}
+	c.Data["IsForcedPrivate"] = policy.ForcePrivate
+}
+
 func handleCreateError(c *context.Context, owner *db.User, err error, name, tpl string, form interface{}) {
 	switch {
+	case db.IsErrRepoCreationForbidden(err):
+		c.RenderWithErr(c.Tr("repo.form.creation_forbidden_"+err.(db.ErrRepoCreationForbidden).Reason), tpl, form)
 	case errors.IsReachLimitOfRepo(err):
-		c.RenderWithErr(c.Tr("repo.form.reach_limit_of_creation", owner.RepoCreationNum()), tpl, form)
+		c.RenderWithErr(c.Tr("repo.form.reach_limit_of_creation", err.(errors.ReachLimitOfRepo).Limit), tpl, form)
 	case db.IsErrRepoAlreadyExist(err):
 		c.Data["Err_RepoName"] = true
 		c.RenderWithErr(c.Tr("form.repo_name_been_taken"), tpl, form)
@@ -116,6 +131,7 @@ func CreatePost(c *context.Context, f form.CreateRepo) {
 		return
 	}
 	c.Data["ContextUser"] = ctxUser
+	setForcedPrivate(c, ctxUser)
 
 	if c.HasError() {
 		c.HTML(200, CREATE)
@@ -128,7 +144,7 @@ func CreatePost(c *context.Context, f form.CreateRepo) {
 		Gitignores:  f.Gitignores,
 		License:     f.License,
 		Readme:      f.Readme,
-		IsPrivate:   f.Private || conf.Repository.ForcePrivate,
+		IsPrivate:   f.Private,
 		AutoInit:    f.AutoInit,
 	})
 	if err == nil {
@@ -149,7 +165,6 @@ func CreatePost(c *context.Context, f form.CreateRepo) {
 func Migrate(c *context.Context) {
 	c.Data["Title"] = c.Tr("new_migrate")
 	c.Data["private"] = c.User.LastRepoVisibility
-	c.Data["IsForcedPrivate"] = conf.Repository.ForcePrivate
 	c.Data["mirror"] = c.Query("mirror") == "1"
 
 	ctxUser := checkContextUser(c, c.QueryInt64("org"))
@@ -157,6 +172,7 @@ func Migrate(c *context.Context) {
 		return
 	}
 	c.Data["ContextUser"] = ctxUser
+	setForcedPrivate(c, ctxUser)
 
 	c.HTML(200, MIGRATE)
 }
@@ -169,6 +185,7 @@ func MigratePost(c *context.Context, f form.MigrateRepo) {
 		return
 	}
 	c.Data["ContextUser"] = ctxUser
+	setForcedPrivate(c, ctxUser)
 
 	if c.HasError() {
 		c.HTML(200, MIGRATE)
@@ -199,7 +216,7 @@ func MigratePost(c *context.Context, f form.MigrateRepo) {
 	repo, err := db.MigrateRepository(c.User, ctxUser, db.MigrateRepoOptions{
 		Name:        f.RepoName,
 		Description: f.Description,
-		IsPrivate:   f.Private || conf.Repository.ForcePrivate,
+		IsPrivate:   f.Private,
 		IsMirror:    f.Mirror,
 		RemoteAddr:  remoteAddr,
 	})
@@ -269,74 +286,156 @@ func Action(c *context.Context) {
 	c.Redirect(redirectTo)
 }
 
-func Download(c *context.Context) {
-	var (
-		uri         = c.Params("*")
-		refName     string
-		ext         string
-		archivePath string
-		archiveType git.ArchiveType
-	)
+// archiveFileName returns the file name of the archive of the reference, or of
+// the directory at the tree path of the reference.
+func archiveFileName(repoName string, ref *context.ResolvedRef, ext string) string {
+	// Full commit IDs are too long for file names.
+	name := ref.Name
+	if ref.Kind == context.RefKindCommit {
+		name = tool.ShortSHA1(ref.Commit.ID.String())
+	}
+	name = repoName + "-" + name
+	if ref.TreePath != "" {
+		name += "-" + strings.Replace(strings.Trim(ref.TreePath, "/"), "/", "-", -1)
+	}
+	return name + ext
+}
 
-	switch {
-	case strings.HasSuffix(uri, ".zip"):
-		ext = ".zip"
-		archivePath = path.Join(c.Repo.GitRepo.Path, "archives/zip")
-		archiveType = git.ZIP
-	case strings.HasSuffix(uri, ".tar.gz"):
-		ext = ".tar.gz"
-		archivePath = path.Join(c.Repo.GitRepo.Path, "archives/targz")
-		archiveType = git.TARGZ
-	default:
-		log.Trace("Unknown format: %s", uri)
-		c.Error(404)
+// downloadTree streams the archive of the directory at the tree path of the
+// reference, where files keep their paths in the repository. Unlike archives of
+// whole repositories, archives of directories are not cached on disk.
+func downloadTree(c *context.Context, ref *context.ResolvedRef, format string) {
+	treePath := strings.TrimSuffix(ref.TreePath, "/")
+	entry, err := ref.Commit.GetTreeEntryByPath(treePath)
+	if err != nil {
+		c.NotFoundOrServerError("GetTreeEntryByPath", git.IsErrNotExist, err)
+		return
+	} else if !entry.IsDir() {
+		c.NotFound()
 		return
 	}
-	refName = strings.TrimSuffix(uri, ext)
 
-	if !com.IsDir(archivePath) {
-		if err := os.MkdirAll(archivePath, os.ModePerm); err != nil {
-			c.Handle(500, "Download -> os.MkdirAll(archivePath)", err)
+	// Collaborators limited to some paths can download a parent directory of
+	// allowed paths, which only contains allowed paths.
+	etag := ref.Commit.ID.String() + ":" + treePath
+	paths := []string{treePath}
+	if r := c.Repo.PathRestriction; r != nil && !r.Allows(treePath) {
+		paths = paths[:0]
+		for _, prefix := range r.ExistingPrefixes(ref.Commit) {
+			if strings.HasPrefix(prefix, treePath+"/") {
+				paths = append(paths, prefix)
+			}
+		}
+		if len(paths) == 0 {
+			c.NotFound()
 			return
 		}
+		etag += "-" + r.Key()
 	}
 
-	// Get corresponding commit.
-	var (
-		commit *git.Commit
-		err    error
-	)
-	gitRepo := c.Repo.GitRepo
-	if gitRepo.IsBranchExist(refName) {
-		commit, err = gitRepo.GetBranchCommit(refName)
-		if err != nil {
-			c.Handle(500, "GetBranchCommit", err)
+	etag = `"` + etag + `"`
+	c.Header().Set("ETag", etag)
+	if c.Req.Header.Get("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Header().Set("Content-Type", "application/octet-stream")
+	c.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": archiveFileName(c.Repo.Repository.Name, ref, "."+format),
+	}))
+	err = db.WriteArchive(c.Req.Request.Context(), c.Resp, c.Repo.GitRepo.Path,
+		ref.Commit.ID.String(), c.Repo.Repository.Name+"/", format, paths...)
+	if err != nil {
+		// Nothing can be done once part of the archive has been sent.
+		if c.Resp.Written() {
+			log.Error("Failed to write archive of %q: %v", treePath, err)
 			return
 		}
-	} else if gitRepo.IsTagExist(refName) {
-		commit, err = gitRepo.GetTagCommit(refName)
-		if err != nil {
-			c.Handle(500, "GetTagCommit", err)
-			return
+		c.Header().Del("Content-Disposition")
+		c.UnavailableOrServerError("WriteArchive", err)
+	}
+}
+
+// Download serves the archive of the reference in one of db.ArchiveFormats, or
+// of a directory when the reference is followed by its tree path. Archives of
+// the same commit share the ETag, so repeat downloads of a reference that has
+// not moved are answered with 304.
+func Download(c *context.Context) {
+	uri := c.Params("*")
+	var format string
+	for _, f := range db.ArchiveFormats {
+		if strings.HasSuffix(uri, "."+f) {
+			format = f
+			break
 		}
-	} else if len(refName) >= 7 && len(refName) <= 40 {
-		commit, err = gitRepo.GetCommit(refName)
-		if err != nil {
+	}
+	if format == "" {
+		log.Trace("Unknown format: %s", uri)
+		c.Error(404)
+		return
+	}
+	ext := "." + format
+	refName := strings.TrimSuffix(uri, ext)
+
+	// Get corresponding commit, which is resolved the same way as repository
+	// pages.
+	gitRepo := c.Repo.GitRepo
+	ref, err := context.ResolveRef(gitRepo, refName, c.Repo.Repository.DefaultBranch)
+	if err != nil {
+		if err == context.ErrRefNotFound || err == context.ErrRefAmbiguous {
+			c.NotFound()
+		} else {
+			c.Handle(500, "ResolveRef", err)
+		}
+		return
+	} else if ref.TreePath != "" {
+		downloadTree(c, ref, format)
+		return
+	} else if strings.HasSuffix(refName, "/") {
+		// The root directory is the archive of the whole repository.
+		c.Error(http.StatusBadRequest)
+		return
+	}
+	commit := ref.Commit
+
+	// Archives for collaborators limited to some paths only contain allowed paths.
+	archiveName := tool.ShortSHA1(commit.ID.String())
+	etag := commit.ID.String()
+	var paths []string
+	if r := c.Repo.PathRestriction; r != nil {
+		paths = r.ExistingPrefixes(commit)
+		if len(paths) == 0 {
 			c.NotFound()
 			return
 		}
-	} else {
-		c.NotFound()
+		archiveName += "-" + r.Key()
+		etag += "-" + r.Key()
+	}
+
+	etag = `"` + etag + `"`
+	c.Header().Set("ETag", etag)
+	if c.Req.Header.Get("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
 		return
 	}
 
-	archivePath = path.Join(archivePath, tool.ShortSHA1(commit.ID.String())+ext)
+	archivePath := path.Join(c.Repo.GitRepo.Path, "archives", strings.Replace(format, ".", "", -1))
+	if !com.IsDir(archivePath) {
+		if err := os.MkdirAll(archivePath, os.ModePerm); err != nil {
+			c.Handle(500, "Download -> os.MkdirAll(archivePath)", err)
+			return
+		}
+	}
+
+	archivePath = path.Join(archivePath, archiveName+ext)
 	if !com.IsFile(archivePath) {
-		if err := commit.CreateArchive(archivePath, archiveType); err != nil {
-			c.Handle(500, "Download -> CreateArchive "+archivePath, err)
+		err = db.CreateArchive(c.Req.Request.Context(), gitRepo.Path, commit.ID.String(), archivePath, format, paths...)
+		if err != nil {
+			c.UnavailableOrServerError("Download -> CreateArchive "+archivePath, err)
 			return
 		}
 	}
 
-	c.ServeFile(archivePath, c.Repo.Repository.Name+"-"+refName+ext)
+	c.ServeFile(archivePath, archiveFileName(c.Repo.Repository.Name, ref, ext))
 }
@@ -6,7 +6,9 @@ package repo
 
 import (
 	"fmt"
+	gotemplate "html/template"
 	"io/ioutil"
+	"net/url"
 	"strings"
 	"time"
 
@@ -20,6 +22,8 @@ import (
 	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/email"
 	"gogs.io/gogs/internal/form"
+	"gogs.io/gogs/internal/imageutil"
+	"gogs.io/gogs/internal/markup"
 	"gogs.io/gogs/internal/tool"
 )
 
@@ -32,12 +36,65 @@ const (
 	SETTINGS_GITHOOKS         = "repo/settings/githooks"
 	SETTINGS_GITHOOK_EDIT     = "repo/settings/githook_edit"
 	SETTINGS_DEPLOY_KEYS      = "repo/settings/deploy_keys"
+	SETTINGS_IMPORT           = "repo/settings/import"
+	INVITATION                = "repo/invitation"
 )
 
+// VISIBILITY_TIME_LAYOUT is the layout of values of datetime-local inputs.
+const VISIBILITY_TIME_LAYOUT = "2006-01-02T15:04"
+
+func prepareVisibilitySchedule(c *context.Context) {
+	c.Data["TimeZone"], _ = time.Now().Zone()
+	c.Data["VisibilityMinTime"] = time.Now().Add(time.Minute).Format(VISIBILITY_TIME_LAYOUT)
+
+	policy, err := c.Repo.Owner.RepoPolicy()
+	if err != nil {
+		log.Error("Failed to get repository policy of owner [%d]: %v", c.Repo.Owner.ID, err)
+		return
+	}
+	c.Data["IsVisibilityLocked"] = c.Repo.Repository.IsPrivate && policy.ForbidVisibilityChange
+}
+
+// prepareProtectionSummary sets the summary of protected branches, failures
+// are only logged so the settings page is still usable.
+func prepareProtectionSummary(c *context.Context) {
+	summaries, err := c.Repo.Repository.ProtectionSummary()
+	if err != nil {
+		log.Error("Failed to get protection summary of repository [%d]: %v", c.Repo.Repository.ID, err)
+		return
+	}
+	c.Data["ProtectionSummaries"] = summaries
+}
+
+// prepareRepoRedirects sets former names of the repository that are still
+// redirected, failures are only logged so the settings page is still usable.
+func prepareRepoRedirects(c *context.Context) {
+	redirects, err := db.GetRepoRedirects(c.Repo.Repository.ID)
+	if err != nil {
+		log.Error("Failed to get redirects of repository [%d]: %v", c.Repo.Repository.ID, err)
+		return
+	}
+	c.Data["RepoRedirects"] = redirects
+}
+
+// prepareMergeMessageTemplate sets variables of merge message templates and
+// the error of the current template, which is only a warning since merges fall
+// back to the default message.
+func prepareMergeMessageTemplate(c *context.Context) {
+	c.Data["MergeMessageVariables"] = db.MergeMessageVariables
+	if err := db.ValidateMergeMessageTemplate(c.Repo.Repository.MergeMessageTemplate); err != nil {
+		c.Data["MergeMessageTemplateError"] = err.Error()
+	}
+}
+
 func Settings(c *context.Context) {
 	c.Title("repo.settings")
 	c.PageIs("SettingsOptions")
 	c.RequireAutosize()
+	prepareVisibilitySchedule(c)
+	prepareProtectionSummary(c)
+	prepareRepoRedirects(c)
+	prepareMergeMessageTemplate(c)
 	c.Success(SETTINGS_OPTIONS)
 }
 
@@ -45,6 +102,10 @@ func SettingsPost(c *context.Context, f form.RepoSetting) {
 	c.Title("repo.settings")
 	c.PageIs("SettingsOptions")
 	c.RequireAutosize()
+	prepareVisibilitySchedule(c)
+	prepareProtectionSummary(c)
+	prepareRepoRedirects(c)
+	prepareMergeMessageTemplate(c)
 
 	repo := c.Repo.Repository
 
@@ -55,12 +116,12 @@ func SettingsPost(c *context.Context, f form.RepoSetting) {
 			return
 		}
 
-		isNameChanged := false
 		oldRepoName := repo.Name
 		newRepoName := f.RepoName
-		// Check if repository name has been changed.
-		if repo.LowerName != strings.ToLower(newRepoName) {
-			isNameChanged = true
+		// Changes of only the case are not announced since the old name does not
+		// need to be redirected.
+		isNameChanged := repo.LowerName != strings.ToLower(newRepoName)
+		if repo.Name != newRepoName {
 			if err := db.ChangeRepositoryName(c.Repo.Owner, repo.Name, newRepoName); err != nil {
 				c.FormErr("RepoName")
 				switch {
@@ -76,9 +137,8 @@ func SettingsPost(c *context.Context, f form.RepoSetting) {
 				return
 			}
 
-			log.Trace("Repository name changed: %s/%s -> %s", c.Repo.Owner.Name, repo.Name, newRepoName)
+			log.Trace("Repository name changed: %s/%s -> %s", c.Repo.Owner.Name, oldRepoName, newRepoName)
 		}
-		// In case it's just a case change.
 		repo.Name = newRepoName
 		repo.LowerName = strings.ToLower(newRepoName)
 
@@ -90,12 +150,19 @@ func SettingsPost(c *context.Context, f form.RepoSetting) {
 			f.Private = repo.BaseRepo.IsPrivate
 		}
 
-		visibilityChanged := repo.IsPrivate != f.Private
-		repo.IsPrivate = f.Private
-		if err := db.UpdateRepository(repo, visibilityChanged); err != nil {
+		if err := db.UpdateRepository(repo, false); err != nil {
 			c.ServerError("UpdateRepository", err)
 			return
 		}
+		if err := db.ChangeRepositoryVisibility(c.User, repo, f.Private); err != nil {
+			if db.IsErrVisibilityChangeForbidden(err) {
+				c.Flash.Error(c.Tr("repo.settings.visibility_forbidden_" + err.(db.ErrVisibilityChangeForbidden).Reason))
+				c.Redirect(repo.Link() + "/settings")
+			} else {
+				c.ServerError("ChangeRepositoryVisibility", err)
+			}
+			return
+		}
 		log.Trace("Repository basic settings updated: %s/%s", c.Repo.Owner.Name, repo.Name)
 
 		if isNameChanged {
@@ -107,6 +174,52 @@ func SettingsPost(c *context.Context, f form.RepoSetting) {
 		c.Flash.Success(c.Tr("repo.settings.update_settings_success"))
 		c.Redirect(repo.Link() + "/settings")
 
+	case "schedule-visibility":
+		at, err := time.ParseInLocation(VISIBILITY_TIME_LAYOUT, f.VisibilityAt, time.Local)
+		if err != nil {
+			c.Flash.Error(c.Tr("repo.settings.visibility_schedule_invalid"))
+			c.Redirect(repo.Link() + "/settings")
+			return
+		}
+
+		if err = db.ScheduleVisibilityChange(c.User, repo, at); err != nil {
+			switch {
+			case db.IsErrInvalidVisibilitySchedule(err):
+				c.Flash.Error(c.Tr("repo.settings.visibility_schedule_invalid"))
+			case db.IsErrVisibilityChangeForbidden(err):
+				c.Flash.Error(c.Tr("repo.settings.visibility_forbidden_" + err.(db.ErrVisibilityChangeForbidden).Reason))
+			default:
+				c.ServerError("ScheduleVisibilityChange", err)
+				return
+			}
+			c.Redirect(repo.Link() + "/settings")
+			return
+		}
+		log.Trace("Repository visibility change scheduled: %s/%s at %s", c.Repo.Owner.Name, repo.Name, at)
+
+		c.Flash.Success(c.Tr("repo.settings.visibility_schedule_success"))
+		c.Redirect(repo.Link() + "/settings")
+
+	case "cancel-visibility":
+		if err := db.CancelVisibilitySchedule(repo.ID); err != nil {
+			c.ServerError("CancelVisibilitySchedule", err)
+			return
+		}
+		log.Trace("Repository visibility change canceled: %s/%s", c.Repo.Owner.Name, repo.Name)
+
+		c.Flash.Success(c.Tr("repo.settings.visibility_schedule_canceled"))
+		c.Redirect(repo.Link() + "/settings")
+
+	case "delete-redirect":
+		if err := db.DeleteRepoRedirect(repo.ID, c.QueryInt64("id")); err != nil {
+			c.NotFoundOrServerError("DeleteRepoRedirect", errors.IsRepoRedirectNotExist, err)
+			return
+		}
+		log.Trace("Repository redirect deleted: %s/%s", c.Repo.Owner.Name, repo.Name)
+
+		c.Flash.Success(c.Tr("repo.settings.redirect_deleted"))
+		c.Redirect(repo.Link() + "/settings")
+
 	case "mirror":
 		if !repo.IsMirror {
 			c.NotFound()
@@ -122,8 +235,8 @@ func SettingsPost(c *context.Context, f form.RepoSetting) {
 				return
 			}
 		}
-		if err := c.Repo.Mirror.SaveAddress(f.MirrorAddress); err != nil {
-			c.ServerError("SaveAddress", err)
+		if err := c.Repo.Mirror.UpdateAddress(f.MirrorAddress, f.MirrorUsername, f.MirrorPassword, f.MirrorClearCredentials); err != nil {
+			c.ServerError("UpdateAddress", err)
 			return
 		}
 
@@ -141,19 +254,37 @@ func SettingsPost(c *context.Context, f form.RepoSetting) {
 		c.Redirect(repo.Link() + "/settings")
 
 	case "advanced":
+		if f.EnableExternalTracker && f.TrackerIssueStyle == markup.ISSUE_NAME_STYLE_REGEXP {
+			if _, err := markup.IssueRegexp(f.TrackerIssueRegexp); err != nil || f.TrackerIssueRegexp == "" {
+				c.Flash.Error(c.Tr("repo.settings.tracker_issue_style.regexp_invalid"))
+				c.Redirect(c.Repo.RepoLink + "/settings")
+				return
+			}
+		}
+		if _, err := db.ParseCustomIssueCloseReasons(f.CustomCloseReasons); err != nil {
+			c.Flash.Error(c.Tr("repo.settings.custom_close_reasons_invalid", err.(db.ErrInvalidIssueCloseReason).Reason))
+			c.Redirect(c.Repo.RepoLink + "/settings")
+			return
+		}
+
 		repo.EnableWiki = f.EnableWiki
 		repo.AllowPublicWiki = f.AllowPublicWiki
 		repo.EnableExternalWiki = f.EnableExternalWiki
 		repo.ExternalWikiURL = f.ExternalWikiURL
 		repo.EnableIssues = f.EnableIssues
 		repo.AllowPublicIssues = f.AllowPublicIssues
+		repo.CustomCloseReasons = strings.TrimSpace(f.CustomCloseReasons)
 		repo.EnableExternalTracker = f.EnableExternalTracker
 		repo.ExternalTrackerURL = f.ExternalTrackerURL
 		repo.ExternalTrackerFormat = f.TrackerURLFormat
 		repo.ExternalTrackerStyle = f.TrackerIssueStyle
+		repo.ExternalTrackerRegexp = f.TrackerIssueRegexp
 		repo.EnablePulls = f.EnablePulls
 		repo.PullsIgnoreWhitespace = f.PullsIgnoreWhitespace
 		repo.PullsAllowRebase = f.PullsAllowRebase
+		repo.MergeMessageTemplate = strings.TrimSpace(f.MergeMessageTemplate)
+		repo.MergeMessageTrailers = f.MergeMessageTrailers
+		repo.AllowPublicReleases = f.AllowPublicReleases
 
 		if err := db.UpdateRepository(repo, false); err != nil {
 			c.ServerError("UpdateRepository", err)
@@ -338,6 +469,9 @@ func UpdateAvatarSetting(c *context.Context, f form.Avatar, ctxRepo *db.Reposito
 			return errors.New(c.Tr("settings.uploaded_avatar_not_a_image"))
 		}
 		if err = ctxRepo.UploadAvatar(data); err != nil {
+			if imageutil.IsErrTooManyPixels(err) {
+				return errors.New(c.Tr("settings.uploaded_avatar_too_large"))
+			}
 			return fmt.Errorf("upload avatar: %v", err)
 		}
 	} else {
@@ -364,10 +498,48 @@ func SettingsCollaboration(c *context.Context) {
 		return
 	}
 	c.Data["Collaborators"] = users
+	prepareInactiveCollaborators(c)
+
+	invites, err := c.Repo.Repository.PendingInvites()
+	if err != nil {
+		c.Handle(500, "PendingInvites", err)
+		return
+	}
+	c.Data["PendingInvites"] = invites
 
 	c.HTML(200, SETTINGS_COLLABORATION)
 }
 
+// Periods of inactivity in days that collaborators can be reviewed for.
+var (
+	inactiveDaysOptions = []int{30, 90, 180, 365}
+	defaultInactiveDays = 90
+)
+
+// prepareInactiveCollaborators flags collaborators who have not accessed the
+// repository for the period selected by the "inactive_days" query.
+func prepareInactiveCollaborators(c *context.Context) {
+	days := defaultInactiveDays
+	for _, d := range inactiveDaysOptions {
+		if c.QueryInt("inactive_days") == d {
+			days = d
+		}
+	}
+	c.Data["InactiveDays"] = days
+	c.Data["InactiveDaysOptions"] = inactiveDaysOptions
+
+	collaborations, err := c.Repo.Repository.InactiveCollaborators(time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		log.Error("Failed to get inactive collaborators of repository [%d]: %v", c.Repo.Repository.ID, err)
+		return
+	}
+	inactive := make(map[int64]*db.Collaboration, len(collaborations))
+	for _, collaboration := range collaborations {
+		inactive[collaboration.UserID] = collaboration
+	}
+	c.Data["InactiveCollaborators"] = inactive
+}
+
 func SettingsCollaborationPost(c *context.Context) {
 	name := strings.ToLower(c.Query("collaborator"))
 	if len(name) == 0 || c.Repo.Owner.LowerName == name {
@@ -375,9 +547,25 @@ func SettingsCollaborationPost(c *context.Context) {
 		return
 	}
 
-	u, err := db.GetUserByName(name)
+	// Users can also be found by their email addresses, and email addresses that
+	// have not been registered are invited to collaborate.
+	isEmail := strings.Contains(name, "@")
+	var (
+		u   *db.User
+		err error
+	)
+	if isEmail {
+		u, err = db.GetUserByEmail(name)
+	} else {
+		u, err = db.GetUserByName(name)
+	}
 	if err != nil {
 		if errors.IsUserNotExist(err) {
+			if isEmail && conf.Email.Enabled {
+				inviteCollaborator(c, name)
+				return
+			}
+
 			c.Flash.Error(c.Tr("form.user_not_exist"))
 			c.Redirect(conf.Server.Subpath + c.Req.URL.Path)
 		} else {
@@ -393,6 +581,13 @@ func SettingsCollaborationPost(c *context.Context) {
 		return
 	}
 
+	// Bots are granted capabilities by the organization instead.
+	if u.IsBot() {
+		c.Flash.Error(c.Tr("repo.settings.bot_not_allowed_to_be_collaborator"))
+		c.Redirect(conf.Server.Subpath + c.Req.URL.Path)
+		return
+	}
+
 	if err = c.Repo.Repository.AddCollaborator(u); err != nil {
 		c.Handle(500, "AddCollaborator", err)
 		return
@@ -406,6 +601,124 @@ func SettingsCollaborationPost(c *context.Context) {
 	c.Redirect(conf.Server.Subpath + c.Req.URL.Path)
 }
 
+func sendRepoInviteMail(c *context.Context, inv *db.RepoInvite) error {
+	email.SendRepoInviteMail(inv.Email, db.NewMailerUser(c.User), db.NewMailerRepo(c.Repo.Repository),
+		conf.Server.ExternalURL+"repo/invitations/"+inv.Token)
+	return db.MarkInviteSent(inv)
+}
+
+func inviteCollaborator(c *context.Context, address string) {
+	inv, err := c.Repo.Repository.InviteCollaborator(c.User, address, db.ACCESS_MODE_WRITE)
+	if err != nil {
+		c.Handle(500, "InviteCollaborator", err)
+		return
+	}
+
+	if err = sendRepoInviteMail(c, inv); err != nil {
+		c.Handle(500, "sendRepoInviteMail", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.invite_collaborator_success", inv.Email))
+	c.Redirect(conf.Server.Subpath + c.Req.URL.Path)
+}
+
+func ChangeInviteAccessMode(c *context.Context) {
+	if err := c.Repo.Repository.ChangeInviteAccessMode(
+		c.QueryInt64("id"),
+		db.AccessMode(c.QueryInt("mode"))); err != nil {
+		log.Error("ChangeInviteAccessMode: %v", err)
+		return
+	}
+
+	c.Status(204)
+}
+
+func ResendInvite(c *context.Context) {
+	inv, err := c.Repo.Repository.GetInvite(c.QueryInt64("id"))
+	if err != nil {
+		c.NotFoundOrServerError("GetInvite", errors.IsRepoInviteNotExist, err)
+		return
+	}
+
+	if err = sendRepoInviteMail(c, inv); err != nil {
+		c.Handle(500, "sendRepoInviteMail", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.resend_invite_success", inv.Email))
+	c.Redirect(c.Repo.RepoLink + "/settings/collaboration")
+}
+
+func RevokeInvite(c *context.Context) {
+	if err := c.Repo.Repository.RevokeInvite(c.QueryInt64("id")); err != nil {
+		c.Handle(500, "RevokeInvite", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.revoke_invite_success"))
+	c.Redirect(c.Repo.RepoLink + "/settings/collaboration")
+}
+
+func parseRepoInvite(c *context.Context) (*db.RepoInvite, *db.Repository) {
+	inv, err := db.GetRepoInviteByToken(c.Params(":token"))
+	if err != nil {
+		c.NotFoundOrServerError("GetRepoInviteByToken", errors.IsRepoInviteNotExist, err)
+		return nil, nil
+	}
+
+	repo, err := db.GetRepositoryByID(inv.RepoID)
+	if err != nil {
+		c.NotFoundOrServerError("GetRepositoryByID", errors.IsRepoNotExist, err)
+		return nil, nil
+	}
+
+	c.Data["Title"] = c.Tr("repo.invitation.title")
+	c.Data["Invite"] = inv
+	c.Data["InviteRepo"] = repo
+	return inv, repo
+}
+
+// AcceptInvite shows the invitation to collaborate on a repository to the
+// signed in user.
+func AcceptInvite(c *context.Context) {
+	inv, _ := parseRepoInvite(c)
+	if c.Written() {
+		return
+	}
+
+	verified, err := c.User.HasVerifiedEmail(inv.Email)
+	if err != nil {
+		c.Handle(500, "HasVerifiedEmail", err)
+		return
+	}
+	c.Data["IsEmailVerified"] = verified
+
+	c.Success(INVITATION)
+}
+
+// AcceptInvitePost converts the invitation to a collaboration of the signed in
+// user.
+func AcceptInvitePost(c *context.Context) {
+	inv, repo := parseRepoInvite(c)
+	if c.Written() {
+		return
+	}
+
+	if err := db.AcceptRepoInvite(inv, c.User); err != nil {
+		if errors.IsRepoInviteEmailMismatch(err) {
+			c.Flash.Error(c.Tr("repo.invitation.email_not_verified", inv.Email, conf.Server.Subpath))
+			c.Redirect(conf.Server.Subpath + c.Req.URL.Path)
+		} else {
+			c.Handle(500, "AcceptRepoInvite", err)
+		}
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.accept_invite_success", repo.FullName()))
+	c.Redirect(repo.Link())
+}
+
 func ChangeCollaborationAccessMode(c *context.Context) {
 	if err := c.Repo.Repository.ChangeCollaborationAccessMode(
 		c.QueryInt64("uid"),
@@ -417,6 +730,37 @@ func ChangeCollaborationAccessMode(c *context.Context) {
 	c.Status(204)
 }
 
+func ChangeCollaborationPaths(c *context.Context, f form.CollaborationPaths) {
+	if c.HasError() {
+		c.Flash.Error(c.Data["ErrorMsg"].(string))
+		c.Redirect(c.Repo.RepoLink + "/settings/collaboration")
+		return
+	}
+
+	prefixes, err := db.ParsePathPrefixes(f.Paths)
+	if err != nil {
+		if db.IsErrInvalidPathPrefix(err) {
+			c.Flash.Error(c.Tr("repo.settings.collaboration_paths_invalid", gotemplate.HTMLEscapeString(err.(db.ErrInvalidPathPrefix).Prefix)))
+			c.Redirect(c.Repo.RepoLink + "/settings/collaboration")
+		} else {
+			c.ServerError("ParsePathPrefixes", err)
+		}
+		return
+	}
+
+	if err = c.Repo.Repository.SetCollaborationPaths(f.UID, prefixes, f.AllowIssues); err != nil {
+		c.ServerError("SetCollaborationPaths", err)
+		return
+	}
+
+	if len(prefixes) > 0 {
+		c.Flash.Success(c.Tr("repo.settings.collaboration_paths_success"))
+	} else {
+		c.Flash.Success(c.Tr("repo.settings.collaboration_paths_removed"))
+	}
+	c.Redirect(c.Repo.RepoLink + "/settings/collaboration")
+}
+
 func DeleteCollaboration(c *context.Context) {
 	if err := c.Repo.Repository.DeleteCollaboration(c.QueryInt64("id")); err != nil {
 		c.Flash.Error("DeleteCollaboration: " + err.Error())
@@ -433,38 +777,140 @@ func SettingsBranches(c *context.Context) {
 	c.Data["Title"] = c.Tr("repo.settings.branches")
 	c.Data["PageIsSettingsBranches"] = true
 
+	protectTags, err := db.GetProtectTags(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetProtectTags", err)
+		return
+	}
+	c.Data["ProtectTags"] = protectTags
+
+	lintRules, err := db.GetCommitLintRules(c.Repo.Repository.ID)
+	if err != nil {
+		c.ServerError("GetCommitLintRules", err)
+		return
+	}
+	c.Data["CommitLintRules"] = lintRules
+
 	if c.Repo.Repository.IsBare {
 		c.Flash.Info(c.Tr("repo.settings.branches_bare"), true)
 		c.HTML(200, SETTINGS_BRANCHES)
 		return
 	}
 
+	if !c.SetBranches() {
+		return
+	}
+
 	protectBranches, err := db.GetProtectBranchesByRepoID(c.Repo.Repository.ID)
 	if err != nil {
 		c.Handle(500, "GetProtectBranchesByRepoID", err)
 		return
 	}
 
-	// Filter out deleted branches
-	branches := make([]string, 0, len(protectBranches))
+	// Filter out deleted branches, patterns are kept even if nothing matches
+	branches := make([]*db.ProtectBranch, 0, len(protectBranches))
 	for i := range protectBranches {
-		if c.Repo.GitRepo.IsBranchExist(protectBranches[i].Name) {
-			branches = append(branches, protectBranches[i].Name)
+		if protectBranches[i].IsPattern() || c.Repo.GitRepo.IsBranchExist(protectBranches[i].Name) {
+			branches = append(branches, protectBranches[i])
 		}
 	}
 	c.Data["ProtectBranches"] = branches
 
+	if c.Repo.Owner.IsOrganization() {
+		ruleSets, err := c.Repo.Repository.InheritedRuleSets()
+		if err != nil {
+			c.ServerError("InheritedRuleSets", err)
+			return
+		}
+		c.Data["InheritedRuleSets"] = ruleSets
+	}
+
 	c.HTML(200, SETTINGS_BRANCHES)
 }
 
+// RequestRuleSetExemption requests an exemption of the repository from a rule
+// set of the organization, which can only be granted by owners of the
+// organization.
+func RequestRuleSetExemption(c *context.Context, f form.OrgRuleSetExemption) {
+	if c.HasError() {
+		c.Flash.Error(c.Data["ErrorMsg"].(string))
+		c.Redirect(c.Repo.RepoLink + "/settings/branches")
+		return
+	}
+
+	if err := db.RequestOrgRuleSetExemption(c.User, c.Repo.Repository, f.RuleSetID, f.Reason); err != nil {
+		c.NotFoundOrServerError("RequestOrgRuleSetExemption", errors.IsOrgRuleSetNotExist, err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.rule_sets.exemption_requested"))
+	c.Redirect(c.Repo.RepoLink + "/settings/branches")
+}
+
+// AddProtectedTag protects tags of the repository that match the pattern.
+func AddProtectedTag(c *context.Context) {
+	pattern := c.Query("pattern")
+	if err := db.AddProtectTag(c.Repo.Repository.ID, pattern); err != nil {
+		if db.IsErrInvalidTagPattern(err) {
+			c.Flash.Error(c.Tr("repo.settings.protected_tags_invalid", pattern))
+			c.Redirect(c.Repo.RepoLink + "/settings/branches")
+			return
+		}
+		c.ServerError("AddProtectTag", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.protected_tags_added", pattern))
+	c.Redirect(c.Repo.RepoLink + "/settings/branches")
+}
+
+func DeleteProtectedTag(c *context.Context) {
+	if err := db.DeleteProtectTag(c.Repo.Repository.ID, c.QueryInt64("id")); err != nil {
+		c.NotFoundOrServerError("DeleteProtectTag", errors.IsProtectTagNotExist, err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.protected_tags_deleted"))
+	c.Redirect(c.Repo.RepoLink + "/settings/branches")
+}
+
+// UpdateCommitLintRules saves commit lint rules of the repository.
+func UpdateCommitLintRules(c *context.Context, f form.CommitLintRules) {
+	if c.HasError() {
+		c.Flash.Error(c.Data["ErrorMsg"].(string))
+		c.Redirect(c.Repo.RepoLink + "/settings/branches")
+		return
+	}
+
+	if err := db.UpdateCommitLintRules(&db.CommitLintRules{
+		RepoID:            c.Repo.Repository.ID,
+		MaxSubjectLength:  f.MaxSubjectLength,
+		SubjectPattern:    f.SubjectPattern,
+		MaxBodyLineLength: f.MaxBodyLineLength,
+		EnforceOnPush:     f.EnforceOnPush,
+	}); err != nil {
+		if db.IsErrInvalidCommitLintPattern(err) {
+			c.Flash.Error(c.Tr("repo.settings.commit_lint.invalid_pattern", f.SubjectPattern))
+			c.Redirect(c.Repo.RepoLink + "/settings/branches")
+			return
+		}
+		c.ServerError("UpdateCommitLintRules", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.commit_lint.updated"))
+	c.Redirect(c.Repo.RepoLink + "/settings/branches")
+}
+
 func UpdateDefaultBranch(c *context.Context) {
 	branch := c.Query("branch")
-	if c.Repo.GitRepo.IsBranchExist(branch) &&
-		c.Repo.Repository.DefaultBranch != branch {
-		c.Repo.Repository.DefaultBranch = branch
-		if err := c.Repo.GitRepo.SetDefaultBranch(branch); err != nil {
-			if !git.IsErrUnsupportedVersion(err) {
-				c.Handle(500, "SetDefaultBranch", err)
+	if c.Repo.Repository.DefaultBranch != branch {
+		if err := c.Repo.Repository.UpdateDefaultBranch(c.Repo.GitRepo, branch); err != nil {
+			if errors.IsErrBranchNotExist(err) {
+				c.NotFound()
+				return
+			} else if !git.IsErrUnsupportedVersion(err) {
+				c.ServerError("UpdateDefaultBranch", err)
 				return
 			}
 
@@ -474,19 +920,34 @@ func UpdateDefaultBranch(c *context.Context) {
 		}
 	}
 
-	if err := db.UpdateRepository(c.Repo.Repository, false); err != nil {
-		c.Handle(500, "UpdateRepository", err)
-		return
-	}
-
 	c.Flash.Success(c.Tr("repo.settings.update_default_branch_success"))
 	c.Redirect(c.Repo.RepoLink + "/settings/branches")
 }
 
+// protectedBranchName returns the branch whose protection is edited, or the
+// pattern of branches given in the query since patterns cannot be put in paths
+// that are also parsed as references.
+func protectedBranchName(c *context.Context) (name string, isPattern, ok bool) {
+	if branch := c.Params("*"); branch != "" {
+		if !c.Repo.GitRepo.IsBranchExist(branch) {
+			c.NotFound()
+			return "", false, false
+		}
+		return branch, false, true
+	}
+
+	pattern := strings.TrimSpace(c.Query("name"))
+	if !db.IsProtectBranchPattern(pattern) {
+		c.Flash.Error(c.Tr("repo.settings.protected_branch_pattern_invalid", pattern))
+		c.Redirect(c.Repo.RepoLink + "/settings/branches")
+		return "", false, false
+	}
+	return pattern, true, true
+}
+
 func SettingsProtectedBranch(c *context.Context) {
-	branch := c.Params("*")
-	if !c.Repo.GitRepo.IsBranchExist(branch) {
-		c.NotFound()
+	branch, isPattern, ok := protectedBranchName(c)
+	if !ok {
 		return
 	}
 
@@ -506,6 +967,33 @@ func SettingsProtectedBranch(c *context.Context) {
 		}
 	}
 
+	if isPattern {
+		branches, err := c.Repo.Branches()
+		if err != nil {
+			c.ServerError("Branches", err)
+			return
+		}
+		matching := make([]string, 0, 5)
+		for _, name := range branches {
+			if protectBranch.Match(name) {
+				matching = append(matching, name)
+			}
+		}
+		c.Data["MatchingBranches"] = matching
+	} else if !protectBranch.Protected {
+		// Let users know the branch is already protected by a pattern, which is
+		// overridden once the branch is protected on its own.
+		matched, err := db.GetProtectBranchOfRepoByBranch(c.Repo.Repository.ID, branch)
+		if err != nil {
+			if !errors.IsErrBranchNotExist(err) {
+				c.ServerError("GetProtectBranchOfRepoByBranch", err)
+				return
+			}
+		} else {
+			c.Data["MatchedPattern"] = matched.Name
+		}
+	}
+
 	if c.Repo.Owner.IsOrganization() {
 		users, err := c.Repo.Repository.GetWriters()
 		if err != nil {
@@ -529,9 +1017,8 @@ func SettingsProtectedBranch(c *context.Context) {
 }
 
 func SettingsProtectedBranchPost(c *context.Context, f form.ProtectBranch) {
-	branch := c.Params("*")
-	if !c.Repo.GitRepo.IsBranchExist(branch) {
-		c.NotFound()
+	branch, isPattern, ok := protectedBranchName(c)
+	if !ok {
 		return
 	}
 
@@ -551,6 +1038,8 @@ func SettingsProtectedBranchPost(c *context.Context, f form.ProtectBranch) {
 
 	protectBranch.Protected = f.Protected
 	protectBranch.RequirePullRequest = f.RequirePullRequest
+	protectBranch.RequireLinearHistory = f.RequireLinearHistory
+	protectBranch.RequireCodeOwnersApproval = f.RequireCodeOwnersApproval
 	protectBranch.EnableWhitelist = f.EnableWhitelist
 	if c.Repo.Owner.IsOrganization() {
 		err = db.UpdateOrgProtectBranch(c.Repo.Repository, protectBranch, f.WhitelistUsers, f.WhitelistTeams)
@@ -563,6 +1052,10 @@ func SettingsProtectedBranchPost(c *context.Context, f form.ProtectBranch) {
 	}
 
 	c.Flash.Success(c.Tr("repo.settings.update_protect_branch_success"))
+	if isPattern {
+		c.RawRedirect(c.Repo.RepoLink + "/settings/branches/pattern?name=" + url.QueryEscape(branch))
+		return
+	}
 	c.Redirect(fmt.Sprintf("%s/settings/branches/%s", c.Repo.RepoLink, branch))
 }
 
@@ -577,9 +1070,33 @@ func SettingsGitHooks(c *context.Context) {
 	}
 	c.Data["Hooks"] = hooks
 
+	drift, err := c.Repo.Repository.HooksDrift()
+	if err != nil {
+		c.ServerError("HooksDrift", err)
+		return
+	}
+	driftedHooks := make([]string, 0, len(drift))
+	for _, name := range git.HookNames {
+		if drift[name] {
+			driftedHooks = append(driftedHooks, name)
+		}
+	}
+	c.Data["DriftedHooks"] = driftedHooks
+
 	c.HTML(200, SETTINGS_GITHOOKS)
 }
 
+func SettingsGitHooksRestorePost(c *context.Context) {
+	if err := c.Repo.Repository.RestoreHooks(); err != nil {
+		c.ServerError("RestoreHooks", err)
+		return
+	}
+
+	log.Trace("Managed hooks restored: %s", c.Repo.Repository.FullName())
+	c.Flash.Success(c.Tr("repo.settings.githooks_restore_success"))
+	c.Redirect(c.Repo.RepoLink + "/settings/hooks/git")
+}
+
 func SettingsGitHooksEdit(c *context.Context) {
 	c.Data["Title"] = c.Tr("repo.settings.githooks")
 	c.Data["PageIsSettingsGitHooks"] = true
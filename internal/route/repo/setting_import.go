@@ -0,0 +1,120 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"fmt"
+	gotemplate "html/template"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+// maxRepoSettingsSize is the maximum size in bytes of an uploaded document of
+// repository settings.
+const maxRepoSettingsSize = 1 << 20
+
+func SettingsExport(c *context.Context) {
+	data, err := db.ExportRepoSettings(c.Repo.Repository)
+	if err != nil {
+		c.ServerError("ExportRepoSettings", err)
+		return
+	}
+
+	c.Header().Set("Content-Type", "application/x-yaml; charset=utf-8")
+	c.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-settings.yml"`, c.Repo.Repository.Name))
+	c.Status(http.StatusOK)
+	_, _ = c.Write(data)
+}
+
+func SettingsImport(c *context.Context) {
+	c.Title("repo.settings.import_export")
+	c.PageIs("SettingsImport")
+	c.Success(SETTINGS_IMPORT)
+}
+
+// readRepoSettings returns content of the uploaded file, or the content of
+// the document posted along with values of placeholders.
+func readRepoSettings(f form.ImportRepoSettings) (string, error) {
+	if f.File == nil {
+		return f.Content, nil
+	}
+
+	r, err := f.File.Open()
+	if err != nil {
+		return "", fmt.Errorf("open file: %v", err)
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxRepoSettingsSize+1))
+	if err != nil {
+		return "", fmt.Errorf("read file: %v", err)
+	} else if len(data) > maxRepoSettingsSize {
+		return "", db.ErrInvalidRepoSettings{Reason: "file is too large"}
+	}
+	return string(data), nil
+}
+
+func SettingsImportPost(c *context.Context, f form.ImportRepoSettings) {
+	c.Title("repo.settings.import_export")
+	c.PageIs("SettingsImport")
+
+	content, err := readRepoSettings(f)
+	if err != nil {
+		if db.IsErrInvalidRepoSettings(err) {
+			c.RenderWithErr(c.Tr("repo.settings.import_invalid", gotemplate.HTMLEscapeString(err.(db.ErrInvalidRepoSettings).Reason)), SETTINGS_IMPORT, &f)
+		} else {
+			c.ServerError("readRepoSettings", err)
+		}
+		return
+	} else if strings.TrimSpace(content) == "" {
+		c.RenderWithErr(c.Tr("repo.settings.import_empty"), SETTINGS_IMPORT, &f)
+		return
+	}
+
+	s, err := db.ParseRepoSettings([]byte(content))
+	if err != nil {
+		if db.IsErrInvalidRepoSettings(err) {
+			c.RenderWithErr(c.Tr("repo.settings.import_invalid", gotemplate.HTMLEscapeString(err.(db.ErrInvalidRepoSettings).Reason)), SETTINGS_IMPORT, &f)
+		} else {
+			c.ServerError("ParseRepoSettings", err)
+		}
+		return
+	}
+
+	secrets := make(map[string]string)
+	for _, name := range s.Placeholders() {
+		secrets[name] = c.Query("secret_" + name)
+	}
+
+	if f.Action == "apply" {
+		plan, err := db.ImportRepoSettings(c.Repo.Repository, s, secrets, false)
+		if err == nil {
+			c.Flash.Success(c.Tr("repo.settings.import_success", plan.NumChanges()))
+			c.Redirect(c.Repo.RepoLink + "/settings/import")
+			return
+		} else if !db.IsErrRepoSettingsMissingSecrets(err) {
+			c.ServerError("ImportRepoSettings", err)
+			return
+		}
+
+		c.Flash.ErrorMsg = c.Tr("repo.settings.import_missing_secrets", strings.Join(err.(db.ErrRepoSettingsMissingSecrets).Names, ", "))
+		c.Data["Flash"] = c.Flash
+	}
+
+	plan, err := db.ImportRepoSettings(c.Repo.Repository, s, secrets, true)
+	if err != nil {
+		c.ServerError("ImportRepoSettings", err)
+		return
+	}
+	c.Data["Plan"] = plan
+	c.Data["Content"] = content
+	c.Success(SETTINGS_IMPORT)
+}
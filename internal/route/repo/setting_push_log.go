@@ -0,0 +1,39 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"github.com/unknwon/paginater"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const (
+	SETTINGS_PUSH_LOG = "repo/settings/push_log"
+)
+
+func SettingsPushLog(c *context.Context) {
+	c.Title("repo.settings.push_log")
+	c.PageIs("SettingsPushLog")
+
+	page := c.QueryInt("page")
+	if page <= 1 {
+		page = 1
+	}
+	logs, total, err := c.Repo.Repository.PushLogs(db.PushLogsOptions{
+		Page:     page,
+		PageSize: db.ItemsPerPage,
+	})
+	if err != nil {
+		c.ServerError("PushLogs", err)
+		return
+	}
+	c.Data["PushLogs"] = logs
+	c.Data["Page"] = paginater.New(int(total), db.ItemsPerPage, page, 5)
+	c.Data["RetentionDays"] = conf.Repository.PushLog.RetentionDays
+	c.Success(SETTINGS_PUSH_LOG)
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+const (
+	SETTINGS_REVIEW_REMINDERS     = "repo/settings/reminders"
+	ORG_SETTINGS_REVIEW_REMINDERS = "org/settings/reminders"
+)
+
+// getReviewReminder returns the review reminder setting of the repository or
+// the organization in context, and whether it exists.
+func getReviewReminder(c *context.Context, orCtx *OrgRepoCtx) (*db.ReviewReminder, bool, error) {
+	var r *db.ReviewReminder
+	var err error
+	if orCtx.RepoID > 0 {
+		r, err = db.GetRepoReviewReminder(orCtx.RepoID)
+	} else {
+		r, err = db.GetOrgReviewReminder(orCtx.OrgID)
+	}
+	if err == nil {
+		return r, true, nil
+	} else if !db.IsErrReviewReminderNotExist(err) {
+		return nil, false, err
+	}
+
+	// Show settings of the organization as defaults, if any.
+	if orCtx.RepoID > 0 && c.Repo.Owner.IsOrganization() {
+		r, err = db.GetOrgReviewReminder(c.Repo.Owner.ID)
+		if err == nil {
+			c.Data["IsInheritedFromOrg"] = true
+			r.ID = 0
+			r.RepoID = orCtx.RepoID
+			r.OrgID = 0
+			return r, false, nil
+		} else if !db.IsErrReviewReminderNotExist(err) {
+			return nil, false, err
+		}
+	}
+
+	return &db.ReviewReminder{
+		RepoID:      orCtx.RepoID,
+		OrgID:       orCtx.OrgID,
+		RemindAfter: 24,
+		WorkingDays: true,
+	}, false, nil
+}
+
+func reviewRemindersTemplate(orCtx *OrgRepoCtx) string {
+	if orCtx.RepoID > 0 {
+		return SETTINGS_REVIEW_REMINDERS
+	}
+	return ORG_SETTINGS_REVIEW_REMINDERS
+}
+
+func ReviewReminders(c *context.Context) {
+	c.Title("repo.settings.review_reminders")
+	c.PageIs("SettingsReviewReminders")
+
+	orCtx, err := getOrgRepoCtx(c)
+	if err != nil {
+		c.ServerError("getOrgRepoCtx", err)
+		return
+	}
+
+	r, exists, err := getReviewReminder(c, orCtx)
+	if err != nil {
+		c.ServerError("getReviewReminder", err)
+		return
+	}
+	c.Data["BaseLink"] = orCtx.Link
+	c.Data["Reminder"] = r
+	c.Data["IsEnabled"] = exists
+	c.Success(reviewRemindersTemplate(orCtx))
+}
+
+func ReviewRemindersPost(c *context.Context, f form.ReviewReminder) {
+	c.Title("repo.settings.review_reminders")
+	c.PageIs("SettingsReviewReminders")
+
+	orCtx, err := getOrgRepoCtx(c)
+	if err != nil {
+		c.ServerError("getOrgRepoCtx", err)
+		return
+	}
+	c.Data["BaseLink"] = orCtx.Link
+
+	if !f.Enabled {
+		if err = db.DeleteReviewReminder(orCtx.RepoID, orCtx.OrgID); err != nil {
+			c.ServerError("DeleteReviewReminder", err)
+			return
+		}
+		c.Flash.Success(c.Tr("repo.settings.review_reminders_disabled"))
+		c.Redirect(orCtx.Link + "/settings/reminders")
+		return
+	}
+
+	r := &db.ReviewReminder{
+		RepoID:        orCtx.RepoID,
+		OrgID:         orCtx.OrgID,
+		RemindAfter:   f.RemindAfter,
+		EscalateAfter: f.EscalateAfter,
+		WorkingDays:   f.WorkingDays,
+		PostComment:   f.PostComment,
+	}
+	if err = db.UpdateReviewReminder(r); err != nil {
+		if db.IsErrInvalidReviewReminder(err) {
+			c.Data["Reminder"] = r
+			c.Data["IsEnabled"] = true
+			c.RenderWithErr(c.Tr("repo.settings.review_reminders_invalid"), reviewRemindersTemplate(orCtx), &f)
+		} else {
+			c.ServerError("UpdateReviewReminder", err)
+		}
+		return
+	}
+
+	c.Flash.Success(c.Tr("repo.settings.review_reminders_success"))
+	c.Redirect(orCtx.Link + "/settings/reminders")
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	gotemplate "html/template"
+	"strings"
+
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/form"
+)
+
+const (
+	SETTINGS_SECRETS     = "repo/settings/secrets"
+	ORG_SETTINGS_SECRETS = "org/settings/secrets"
+)
+
+func secretsTemplate(orCtx *OrgRepoCtx) string {
+	if orCtx.RepoID > 0 {
+		return SETTINGS_SECRETS
+	}
+	return ORG_SETTINGS_SECRETS
+}
+
+// loadSecrets sets secrets of the repository or the organization in context,
+// along with webhooks that reference each of them.
+func loadSecrets(c *context.Context, orCtx *OrgRepoCtx) error {
+	secrets, err := db.GetSecrets(orCtx.RepoID, orCtx.OrgID)
+	if err != nil {
+		return err
+	}
+
+	refs := make(map[int64][]*db.SecretReference, len(secrets))
+	for _, s := range secrets {
+		refs[s.ID], err = db.GetSecretReferences(orCtx.RepoID, orCtx.OrgID, s.Name)
+		if err != nil {
+			return err
+		}
+	}
+	c.Data["BaseLink"] = orCtx.Link
+	c.Data["Secrets"] = secrets
+	c.Data["SecretReferences"] = refs
+	return nil
+}
+
+func SettingsSecrets(c *context.Context) {
+	c.Title("repo.settings.secrets")
+	c.PageIs("SettingsSecrets")
+
+	orCtx, err := getOrgRepoCtx(c)
+	if err != nil {
+		c.ServerError("getOrgRepoCtx", err)
+		return
+	}
+
+	if err = loadSecrets(c, orCtx); err != nil {
+		c.ServerError("loadSecrets", err)
+		return
+	}
+	c.Success(secretsTemplate(orCtx))
+}
+
+func SettingsSecretsPost(c *context.Context, f form.Secret) {
+	c.Title("repo.settings.secrets")
+	c.PageIs("SettingsSecrets")
+
+	orCtx, err := getOrgRepoCtx(c)
+	if err != nil {
+		c.ServerError("getOrgRepoCtx", err)
+		return
+	}
+
+	if err = loadSecrets(c, orCtx); err != nil {
+		c.ServerError("loadSecrets", err)
+		return
+	}
+
+	if c.HasError() {
+		c.Success(secretsTemplate(orCtx))
+		return
+	}
+
+	name, err := db.NormalizeSecretName(f.Name)
+	if err != nil {
+		c.Data["Err_Name"] = true
+		c.RenderWithErr(c.Tr("repo.settings.secrets_invalid_name"), secretsTemplate(orCtx), &f)
+		return
+	}
+	if err = db.SetSecret(orCtx.RepoID, orCtx.OrgID, name, f.Value); err != nil {
+		c.ServerError("SetSecret", err)
+		return
+	}
+
+	log.Info("Secret %q set [repo_id: %d, org_id: %d] by %s", name, orCtx.RepoID, orCtx.OrgID, c.User.Name)
+	c.Flash.Success(c.Tr("repo.settings.secrets_set_success", name))
+	c.Redirect(orCtx.Link + "/settings/secrets")
+}
+
+func DeleteSecret(c *context.Context) {
+	orCtx, err := getOrgRepoCtx(c)
+	if err != nil {
+		c.ServerError("getOrgRepoCtx", err)
+		return
+	}
+
+	secrets, err := db.GetSecrets(orCtx.RepoID, orCtx.OrgID)
+	if err != nil {
+		c.ServerError("GetSecrets", err)
+		return
+	}
+	var secret *db.Secret
+	for _, s := range secrets {
+		if s.ID == c.QueryInt64("id") {
+			secret = s
+			break
+		}
+	}
+	if secret == nil {
+		c.NotFound()
+		return
+	}
+
+	if err = db.DeleteSecret(orCtx.RepoID, orCtx.OrgID, secret.Name); err != nil {
+		if !db.IsErrSecretInUse(err) {
+			c.ServerError("DeleteSecret", err)
+			return
+		}
+
+		refs := err.(db.ErrSecretInUse).References
+		urls := make([]string, len(refs))
+		for i := range refs {
+			urls[i] = gotemplate.HTMLEscapeString(refs[i].URL)
+		}
+		c.Flash.Error(c.Tr("repo.settings.secrets_deletion_in_use", secret.Name, strings.Join(urls, "<br>")))
+	} else {
+		log.Info("Secret %q deleted [repo_id: %d, org_id: %d] by %s", secret.Name, orCtx.RepoID, orCtx.OrgID, c.User.Name)
+		c.Flash.Success(c.Tr("repo.settings.secrets_deletion_success", secret.Name))
+	}
+	c.JSON(200, map[string]interface{}{
+		"redirect": orCtx.Link + "/settings/secrets",
+	})
+}
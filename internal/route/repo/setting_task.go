@@ -0,0 +1,40 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+)
+
+const (
+	SETTINGS_SCHEDULED_TASKS = "repo/settings/tasks"
+)
+
+func SettingsScheduledTasks(c *context.Context) {
+	c.Title("repo.settings.scheduled_tasks")
+	c.PageIs("SettingsScheduledTasks")
+
+	tasks, err := c.Repo.Repository.ScheduledTasks()
+	if err != nil {
+		c.ServerError("ScheduledTasks", err)
+		return
+	}
+	c.Data["Tasks"] = tasks
+	c.Success(SETTINGS_SCHEDULED_TASKS)
+}
+
+func CancelScheduledTask(c *context.Context) {
+	typ := db.TaskType(c.Query("type"))
+	if c.Repo.Repository.CancelScheduledTask(typ) {
+		log.Info("Scheduled task %q canceled [repo_id: %d] by %s", typ, c.Repo.Repository.ID, c.User.Name)
+		c.Flash.Success(c.Tr("repo.settings.scheduled_tasks_cancel_success"))
+	} else {
+		c.Flash.Warning(c.Tr("repo.settings.scheduled_tasks_cancel_failed"))
+	}
+	c.Redirect(c.Repo.RepoLink + "/settings/tasks")
+}
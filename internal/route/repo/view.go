@@ -34,22 +34,14 @@ const (
 )
 
 func renderDirectory(c *context.Context, treeLink string) {
-	tree, err := c.Repo.Commit.SubTree(c.Repo.TreePath)
+	entries, pageEntries, pager, err := listDirectory(c, c.QueryInt("page"))
 	if err != nil {
-		c.NotFoundOrServerError("Repo.Commit.SubTree", git.IsErrNotExist, err)
+		c.NotFoundOrServerError("listDirectory", git.IsErrNotExist, err)
 		return
 	}
 
-	entries, err := tree.ListEntries()
-	if err != nil {
-		c.ServerError("ListEntries", err)
-		return
-	}
-	entries.Sort()
-
-	c.Data["Files"], err = entries.GetCommitsInfoWithCustomConcurrency(c.Repo.Commit, c.Repo.TreePath, conf.Repository.CommitsFetchConcurrency)
-	if err != nil {
-		c.ServerError("GetCommitsInfoWithCustomConcurrency", err)
+	if err = renderTreeEntries(c, pageEntries, pager); err != nil {
+		c.ServerError("renderTreeEntries", err)
 		return
 	}
 
@@ -58,6 +50,10 @@ func renderDirectory(c *context.Context, treeLink string) {
 		if entry.IsDir() || !markup.IsReadmeFile(entry.Name()) {
 			continue
 		}
+		// Parent directories of allowed paths are visible, but not their files.
+		if c.Repo.PathRestriction != nil && !c.Repo.PathRestriction.Allows(path.Join(c.Repo.TreePath, entry.Name())) {
+			continue
+		}
 
 		// TODO: collect all possible README files and show with priority.
 		readmeFile = entry.Blob()
@@ -114,7 +110,14 @@ func renderDirectory(c *context.Context, treeLink string) {
 		}
 	}
 	c.Data["LatestCommit"] = latestCommit
-	c.Data["LatestCommitUser"] = db.ValidateCommitWithEmail(latestCommit)
+	author, verified, err := c.Repo.ResolveCommitAuthor(latestCommit)
+	if err != nil {
+		c.ServerError("ResolveCommitAuthor", err)
+		return
+	}
+	if verified {
+		c.Data["LatestCommitUser"] = author
+	}
 
 	if c.Repo.CanEnableEditor() {
 		c.Data["CanAddFile"] = true
@@ -137,6 +140,13 @@ func renderFile(c *context.Context, entry *git.TreeEntry, treeLink, rawLink stri
 	c.Data["HighlightClass"] = highlight.FileNameToHighlightClass(blob.Name())
 	c.Data["RawFileLink"] = rawLink + "/" + c.Repo.TreePath
 
+	ownerUsers, ownerTeams, err := c.Repo.OwnerOfPath(c.Repo.TreePath)
+	if err != nil {
+		log.Error("Failed to get code owners of %q [repo_id: %d]: %v", c.Repo.TreePath, c.Repo.Repository.ID, err)
+	}
+	c.Data["CodeOwnerUsers"] = ownerUsers
+	c.Data["CodeOwnerTeams"] = ownerTeams
+
 	buf := make([]byte, 1024)
 	n, _ := dataRc.Read(buf)
 	buf = buf[:n]
@@ -228,8 +238,10 @@ func renderFile(c *context.Context, entry *git.TreeEntry, treeLink, rawLink stri
 	}
 }
 
-func setEditorconfigIfExists(c *context.Context) {
-	ec, err := c.Repo.GetEditorconfig()
+// setEditorconfigIfExists sets .editorconfig definitions that apply to files in
+// the directory of the tree being viewed, which is the root for diffs.
+func setEditorconfigIfExists(c *context.Context, dir string) {
+	ec, err := c.Repo.GetEditorconfig(dir)
 	if err != nil && !git.IsErrNotExist(err) {
 		log.Trace("setEditorconfigIfExists.GetEditorconfig [%d]: %v", c.Repo.Repository.ID, err)
 		return
@@ -244,6 +256,9 @@ func Home(c *context.Context) {
 		c.HTML(200, BARE)
 		return
 	}
+	if !c.SetRefPages() {
+		return
+	}
 
 	title := c.Repo.Repository.Owner.Name + "/" + c.Repo.Repository.Name
 	if len(c.Repo.Repository.Description) > 0 {
@@ -264,17 +279,16 @@ func Home(c *context.Context) {
 		treeLink += "/" + c.Repo.TreePath
 	} else {
 		isRootDir = true
-
-		// Only show Git stats panel when view root directory
-		var err error
-		c.Repo.CommitsCount, err = c.Repo.Commit.CommitsCount()
-		if err != nil {
-			c.Handle(500, "CommitsCount", err)
-			return
-		}
-		c.Data["CommitsCount"] = c.Repo.CommitsCount
 	}
 	c.Data["PageIsRepoHome"] = isRootDir
+	if _, err := c.Repo.Size(); err != nil {
+		log.Error("Failed to get size of repository [%d]: %v", c.Repo.Repository.ID, err)
+	}
+	c.Data["RepoSize"] = c.Repo.RepoSize
+	c.Data["NumLooseObjects"] = c.Repo.NumLooseObjects
+	c.Data["NumPackedObjects"] = c.Repo.NumPackedObjects
+	// Commits are only counted for the Git stats panel of the root directory.
+	c.Data["CommitsCount"] = c.Repo.CommitsCount
 
 	// Get current entry user currently looking at.
 	entry, err := c.Repo.Commit.GetTreeEntryByPath(c.Repo.TreePath)
@@ -282,21 +296,45 @@ func Home(c *context.Context) {
 		c.NotFoundOrServerError("Repo.Commit.GetTreeEntryByPath", git.IsErrNotExist, err)
 		return
 	}
+	if r := c.Repo.PathRestriction; r != nil &&
+		((entry.IsDir() && !r.Visible(c.Repo.TreePath)) || (!entry.IsDir() && !r.Allows(c.Repo.TreePath))) {
+		c.NotFound()
+		return
+	}
 
+	ecDir := c.Repo.TreePath
 	if entry.IsDir() {
 		renderDirectory(c, treeLink)
 	} else {
 		renderFile(c, entry, treeLink, rawLink)
+		ecDir = path.Dir(ecDir)
 	}
 	if c.Written() {
 		return
 	}
 
-	setEditorconfigIfExists(c)
+	setEditorconfigIfExists(c, ecDir)
 	if c.Written() {
 		return
 	}
 
+	if isRootDir && c.Repo.IsViewBranch && c.Repo.BranchName == c.Repo.Repository.DefaultBranch && c.Repo.PathRestriction == nil {
+		setProjectRoots(c)
+	}
+	if isRootDir && c.Repo.PathRestriction == nil {
+		setRelatedRepos(c)
+	}
+	if c.Repo.PullRequest.Allowed {
+		setCanComparePullRequest(c)
+	}
+	if isRootDir {
+		setRecentPush(c)
+	}
+	if isRootDir && c.Repo.IsViewBranch && c.Repo.Repository.IsFork {
+		setForkSyncStatus(c)
+		setHeadOfPullRequests(c)
+	}
+
 	var treeNames []string
 	paths := make([]string, 0, 5)
 	if len(c.Repo.TreePath) > 0 {
@@ -318,6 +356,18 @@ func Home(c *context.Context) {
 	c.HTML(200, HOME)
 }
 
+// setCanComparePullRequest sets whether the branch being viewed can be compared
+// with the default branch of the base repository, so the button to compose a
+// pull request is hidden for tags, commits and identical branches.
+func setCanComparePullRequest(c *context.Context) {
+	baseBranch := c.Repo.PullRequest.BaseRepo.DefaultBranch
+	canCompare, err := c.Repo.CanComparePullRequest(baseBranch, c.Repo.BranchName)
+	if err != nil {
+		log.Error("Failed to check if pull request can be compared [repo_id: %d, base: %s, head: %s]: %v", c.Repo.Repository.ID, baseBranch, c.Repo.BranchName, err)
+	}
+	c.Data["CanComparePullRequest"] = canCompare
+}
+
 func RenderUserCards(c *context.Context, total int, getter func(page int) ([]*db.User, error), tpl string) {
 	page := c.QueryInt("page")
 	if page <= 0 {
@@ -0,0 +1,201 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package repo
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/gogs/git-module"
+	"github.com/unknwon/paginater"
+	log "unknwon.dev/clog/v2"
+
+	"gogs.io/gogs/internal/conf"
+	"gogs.io/gogs/internal/context"
+	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/template"
+	"gogs.io/gogs/internal/tool"
+)
+
+// treeEntry is an entry of a directory with the last commit that changed it,
+// which is nil if not found (yet).
+type treeEntry struct {
+	*git.TreeEntry
+	Commit    *db.LastCommit
+	SubModule *git.SubModuleFile
+}
+
+// listDirectory returns sorted entries of the directory at the tree path of
+// current commit that are visible to the viewer, and those in the page.
+func listDirectory(c *context.Context, page int) (git.Entries, git.Entries, *paginater.Paginater, error) {
+	tree, err := c.Repo.Commit.SubTree(c.Repo.TreePath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	entries, err := tree.ListEntries()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	entries.Sort()
+	if c.Repo.PathRestriction != nil {
+		entries = c.Repo.PathRestriction.FilterEntries(c.Repo.TreePath, entries)
+	}
+
+	pager := paginater.New(len(entries), conf.Repository.TreePagingNum, page, 5)
+	start := (pager.Current() - 1) * pager.PagingNum()
+	end := start + pager.PagingNum()
+	if end > len(entries) {
+		end = len(entries)
+	}
+	return entries, entries[start:end], pager, nil
+}
+
+// lastCommitsOfEntries returns last commits of the entries of the directory at
+// the tree path of current commit, which are cached by the commit ID and the
+// tree path since they never change. Entries whose last commits are not found
+// before the context is done are missing from the result.
+func lastCommitsOfEntries(c *context.Context, ctx gocontext.Context, entries git.Entries) (map[string]*db.LastCommit, error) {
+	key := db.LastCommitsCacheKey(c.Repo.CommitID, c.Repo.TreePath)
+	commits := make(map[string]*db.LastCommit)
+	if v, ok := c.Cache.Get(key).(string); ok {
+		if err := json.Unmarshal([]byte(v), &commits); err != nil {
+			commits = make(map[string]*db.LastCommit)
+		}
+	}
+
+	var missing []string
+	for _, entry := range entries {
+		if _, ok := commits[entry.Name()]; !ok {
+			missing = append(missing, entry.Name())
+		}
+	}
+	if len(missing) == 0 {
+		return commits, nil
+	}
+
+	found, err := db.LastCommitsOfEntries(ctx, c.Repo.GitRepo.Path, c.Repo.CommitID, c.Repo.TreePath, missing)
+	if err != nil {
+		return nil, err
+	} else if len(found) == 0 {
+		return commits, nil
+	}
+	for name, commit := range found {
+		commits[name] = commit
+	}
+
+	data, err := json.Marshal(commits)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.Cache.Put(key, string(data), 24*60*60); err != nil {
+		log.Error("Failed to cache last commits [key: %s]: %v", key, err)
+	}
+	return commits, nil
+}
+
+// renderTreeEntries sets entries of the page with their last commits that are
+// found within "[repository] LAST_COMMITS_TIME_BUDGET" for rendering, and a
+// link to load the rest if any.
+func renderTreeEntries(c *context.Context, entries git.Entries, pager *paginater.Paginater) error {
+	ctx, cancel := gocontext.WithTimeout(c.Req.Request.Context(), conf.Repository.LastCommitsTimeBudget)
+	defer cancel()
+	commits, err := lastCommitsOfEntries(c, ctx, entries)
+	if err != nil && !db.IsErrGitOperationUnavailable(err) {
+		return err
+	}
+
+	files := make([]*treeEntry, len(entries))
+	complete := true
+	for i, entry := range entries {
+		commit, ok := commits[entry.Name()]
+		complete = complete && ok
+		files[i] = &treeEntry{
+			TreeEntry: entry,
+			Commit:    commit,
+		}
+		if !entry.IsSubModule() {
+			continue
+		}
+
+		sm, err := c.Repo.Commit.GetSubModule(path.Join(c.Repo.TreePath, entry.Name()))
+		if err != nil && !git.IsErrNotExist(err) {
+			return err
+		}
+		smURL := ""
+		if sm != nil {
+			smURL = sm.URL
+		}
+		files[i].SubModule = git.NewSubModuleFile(nil, smURL, entry.ID.String())
+	}
+	c.Data["Files"] = files
+	c.Data["Page"] = pager
+
+	if !complete {
+		c.Data["LastCommitsLink"] = c.Repo.MakeURLWithQuery(
+			path.Join("last-commits", c.Repo.CommitID, c.Repo.TreePath),
+			url.Values{"page": {strconv.Itoa(pager.Current())}},
+		)
+	}
+	return nil
+}
+
+type lastCommitInfo struct {
+	SHA     string `json:"sha"`
+	URL     string `json:"html_url"`
+	Message string `json:"message"`
+	Age     string `json:"age"`
+}
+
+// LastCommits returns last commits of entries in the page of the directory,
+// which are loaded by the browser when not all of them are found before the
+// page is shown. Messages and ages of commits are rendered as HTML.
+func LastCommits(c *context.Context) {
+	if c.Repo.TreePath != "" {
+		entry, err := c.Repo.Commit.GetTreeEntryByPath(c.Repo.TreePath)
+		if err != nil {
+			c.NotFoundOrServerError("Repo.Commit.GetTreeEntryByPath", git.IsErrNotExist, err)
+			return
+		} else if !entry.IsDir() {
+			c.NotFound()
+			return
+		}
+	}
+	if r := c.Repo.PathRestriction; r != nil && !r.Visible(c.Repo.TreePath) {
+		c.NotFound()
+		return
+	}
+
+	_, entries, _, err := listDirectory(c, c.QueryInt("page"))
+	if err != nil {
+		c.NotFoundOrServerError("listDirectory", git.IsErrNotExist, err)
+		return
+	}
+
+	commits, err := lastCommitsOfEntries(c, c.Req.Request.Context(), entries)
+	if err != nil {
+		c.ServerError("lastCommitsOfEntries", err)
+		return
+	}
+
+	results := make(map[string]*lastCommitInfo, len(entries))
+	metas := c.Repo.Repository.ComposeMetas()
+	for _, entry := range entries {
+		commit := commits[entry.Name()]
+		if commit == nil {
+			continue
+		}
+		results[entry.Name()] = &lastCommitInfo{
+			SHA:     commit.ID,
+			URL:     c.Repo.MakeURL("commit/" + commit.ID),
+			Message: template.RenderCommitMessage(false, commit.Summary, c.Repo.RepoLink, metas),
+			Age:     string(tool.TimeSince(commit.Committed, c.Locale.Language())),
+		}
+	}
+	c.JSONSuccess(results)
+}
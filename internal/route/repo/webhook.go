@@ -6,14 +6,13 @@ package repo
 
 import (
 	"fmt"
+	gotemplate "html/template"
 	"strings"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/unknwon/com"
 
-	git "github.com/gogs/git-module"
-	api "github.com/gogs/go-gogs-client"
-
 	"gogs.io/gogs/internal/context"
 	"gogs.io/gogs/internal/db"
 	"gogs.io/gogs/internal/db/errors"
@@ -118,6 +117,7 @@ func ParseHookEvent(f form.Webhook) *db.HookEvent {
 			IssueComment: f.IssueComment,
 			PullRequest:  f.PullRequest,
 			Release:      f.Release,
+			Repository:   f.Repository,
 		},
 	}
 }
@@ -147,14 +147,19 @@ func WebHooksNewPost(c *context.Context, f form.NewWebhook) {
 	}
 
 	w := &db.Webhook{
-		RepoID:       orCtx.RepoID,
-		URL:          f.PayloadURL,
-		ContentType:  contentType,
-		Secret:       f.Secret,
-		HookEvent:    ParseHookEvent(f.Webhook),
-		IsActive:     f.Active,
-		HookTaskType: db.GOGS,
-		OrgID:        orCtx.OrgID,
+		RepoID:         orCtx.RepoID,
+		URL:            f.PayloadURL,
+		ContentType:    contentType,
+		Secret:         f.Secret,
+		HookEvent:      ParseHookEvent(f.Webhook),
+		IsActive:       f.Active,
+		HookTaskType:   db.GOGS,
+		PayloadVersion: db.HOOK_PAYLOAD_LATEST,
+		OrgID:          orCtx.OrgID,
+	}
+	if c.QueryBool("test") {
+		testNewWebhook(c, orCtx, w)
+		return
 	}
 	if err := w.UpdateEvent(); err != nil {
 		c.Handle(500, "UpdateEvent", err)
@@ -206,6 +211,10 @@ func SlackHooksNewPost(c *context.Context, f form.NewSlackHook) {
 		Meta:         string(meta),
 		OrgID:        orCtx.OrgID,
 	}
+	if c.QueryBool("test") {
+		testNewWebhook(c, orCtx, w)
+		return
+	}
 	if err := w.UpdateEvent(); err != nil {
 		c.Handle(500, "UpdateEvent", err)
 		return
@@ -256,6 +265,10 @@ func DiscordHooksNewPost(c *context.Context, f form.NewDiscordHook) {
 		Meta:         string(meta),
 		OrgID:        orCtx.OrgID,
 	}
+	if c.QueryBool("test") {
+		testNewWebhook(c, orCtx, w)
+		return
+	}
 	if err := w.UpdateEvent(); err != nil {
 		c.Handle(500, "UpdateEvent", err)
 		return
@@ -294,6 +307,10 @@ func DingtalkHooksNewPost(c *context.Context, f form.NewDingtalkHook) {
 		HookTaskType: db.DINGTALK,
 		OrgID:        orCtx.OrgID,
 	}
+	if c.QueryBool("test") {
+		testNewWebhook(c, orCtx, w)
+		return
+	}
 	if err := w.UpdateEvent(); err != nil {
 		c.Handle(500, "UpdateEvent", err)
 		return
@@ -306,6 +323,35 @@ func DingtalkHooksNewPost(c *context.Context, f form.NewDingtalkHook) {
 	c.Redirect(orCtx.Link + "/settings/hooks")
 }
 
+// testNewWebhook sends a ping to the webhook that has not been saved yet, and
+// renders the form again with the result and the values filled in.
+func testNewWebhook(c *context.Context, orCtx *OrgRepoCtx, w *db.Webhook) {
+	if orCtx.RepoID == 0 {
+		c.NotFound()
+		return
+	}
+
+	c.Data["BaseLink"] = orCtx.Link
+	c.Data["HookType"] = w.HookTaskType.Name()
+	c.Data["Webhook"] = w
+	if w.HookTaskType == db.SLACK || w.HookTaskType == db.DISCORD {
+		c.Data["SlackHook"] = w.GetSlackHook()
+	}
+
+	result, err := c.Repo.Repository.TestWebhook(w)
+	switch {
+	case err != nil:
+		c.Flash.ErrorMsg = c.Tr("repo.settings.webhook.test_delivery_failed", gotemplate.HTMLEscapeString(err.Error()))
+	case result.Status/100 == 2:
+		c.Flash.SuccessMsg = c.Tr("repo.settings.webhook.test_delivery_success", result.Status, result.Latency.Round(time.Millisecond).String())
+	default:
+		c.Flash.WarningMsg = c.Tr("repo.settings.webhook.test_delivery_unexpected_status", result.Status,
+			result.Latency.Round(time.Millisecond).String(), gotemplate.HTMLEscapeString(result.Snippet))
+	}
+	c.Data["Flash"] = c.Flash
+	c.HTML(200, orCtx.NewTemplate)
+}
+
 func checkWebhook(c *context.Context) (*OrgRepoCtx, *db.Webhook) {
 	c.Data["RequireHighlightJS"] = true
 
@@ -338,6 +384,15 @@ func checkWebhook(c *context.Context) (*OrgRepoCtx, *db.Webhook) {
 		c.Data["HookType"] = "dingtalk"
 	default:
 		c.Data["HookType"] = "gogs"
+		c.Data["LatestPayloadVersion"] = db.HOOK_PAYLOAD_LATEST
+		c.Data["PayloadUpgradeChanges"] = db.HookPayloadChanges(w.PayloadVersion, db.HOOK_PAYLOAD_LATEST)
+		var older []db.HookPayloadVersion
+		for _, v := range db.HookPayloadVersions() {
+			if v < w.PayloadVersion {
+				older = append(older, v)
+			}
+		}
+		c.Data["OlderPayloadVersions"] = older
 	}
 
 	c.Data["History"], err = w.History(1)
@@ -516,81 +571,52 @@ func DingtalkHooksEditPost(c *context.Context, f form.NewDingtalkHook) {
 	c.Redirect(fmt.Sprintf("%s/settings/hooks/%d", orCtx.Link, w.ID))
 }
 
-func TestWebhook(c *context.Context) {
-	var authorUsername, committerUsername string
-
-	// Grab latest commit or fake one if it's empty repository.
-	commit := c.Repo.Commit
-	if commit == nil {
-		ghost := db.NewGhostUser()
-		commit = &git.Commit{
-			ID:            git.MustIDFromString(git.EMPTY_SHA),
-			Author:        ghost.NewGitSig(),
-			Committer:     ghost.NewGitSig(),
-			CommitMessage: "This is a fake commit",
-		}
-		authorUsername = ghost.Name
-		committerUsername = ghost.Name
-	} else {
-		// Try to match email with a real user.
-		author, err := db.GetUserByEmail(commit.Author.Email)
-		if err == nil {
-			authorUsername = author.Name
-		} else if !errors.IsUserNotExist(err) {
-			c.Handle(500, "GetUserByEmail.(author)", err)
-			return
-		}
+// WebhookPayloadVersionPost switches the webhook to given version of payloads,
+// either to upgrade it or to fall back to an older version for receivers that
+// are written against the old shapes.
+func WebhookPayloadVersionPost(c *context.Context) {
+	orCtx, w := checkWebhook(c)
+	if c.Written() {
+		return
+	}
 
-		committer, err := db.GetUserByEmail(commit.Committer.Email)
-		if err == nil {
-			committerUsername = committer.Name
-		} else if !errors.IsUserNotExist(err) {
-			c.Handle(500, "GetUserByEmail.(committer)", err)
-			return
-		}
+	version := db.HookPayloadVersion(c.QueryInt("version"))
+	if w.HookTaskType != db.GOGS || !db.IsValidHookPayloadVersion(version) {
+		c.NotFound()
+		return
+	}
+
+	w.PayloadVersion = version
+	if err := db.UpdateWebhook(w); err != nil {
+		c.Handle(500, "UpdateWebhook", err)
+		return
 	}
 
-	fileStatus, err := commit.FileStatus()
+	c.Flash.Success(c.Tr("repo.settings.webhook.payload_version_success", version))
+	c.Redirect(fmt.Sprintf("%s/settings/hooks/%d", orCtx.Link, w.ID))
+}
+
+func TestWebhook(c *context.Context) {
+	webhook, err := db.GetWebhookOfRepoByID(c.Repo.Repository.ID, c.ParamsInt64(":id"))
 	if err != nil {
-		c.Handle(500, "FileStatus", err)
-		return
-	}
-
-	apiUser := c.User.APIFormat()
-	p := &api.PushPayload{
-		Ref:    git.BRANCH_PREFIX + c.Repo.Repository.DefaultBranch,
-		Before: commit.ID.String(),
-		After:  commit.ID.String(),
-		Commits: []*api.PayloadCommit{
-			{
-				ID:      commit.ID.String(),
-				Message: commit.Message(),
-				URL:     c.Repo.Repository.HTMLURL() + "/commit/" + commit.ID.String(),
-				Author: &api.PayloadUser{
-					Name:     commit.Author.Name,
-					Email:    commit.Author.Email,
-					UserName: authorUsername,
-				},
-				Committer: &api.PayloadUser{
-					Name:     commit.Committer.Name,
-					Email:    commit.Committer.Email,
-					UserName: committerUsername,
-				},
-				Added:    fileStatus.Added,
-				Removed:  fileStatus.Removed,
-				Modified: fileStatus.Modified,
-			},
-		},
-		Repo:   c.Repo.Repository.APIFormat(nil),
-		Pusher: apiUser,
-		Sender: apiUser,
+		c.NotFoundOrServerError("GetWebhookOfRepoByID", errors.IsWebhookNotExist, err)
+		return
 	}
-	if err := db.TestWebhook(c.Repo.Repository, db.HOOK_EVENT_PUSH, p, c.ParamsInt64("id")); err != nil {
-		c.Handle(500, "TestWebhook", err)
-	} else {
-		c.Flash.Info(c.Tr("repo.settings.webhook.test_delivery_success"))
+
+	result, err := c.Repo.Repository.TestWebhook(webhook)
+	if err != nil {
+		c.Flash.Error(c.Tr("repo.settings.webhook.test_delivery_failed", gotemplate.HTMLEscapeString(err.Error())))
 		c.Status(200)
+		return
+	}
+
+	latency := result.Latency.Round(time.Millisecond).String()
+	if result.Status/100 == 2 {
+		c.Flash.Success(c.Tr("repo.settings.webhook.test_delivery_success", result.Status, latency))
+	} else {
+		c.Flash.Warning(c.Tr("repo.settings.webhook.test_delivery_unexpected_status", result.Status, latency, gotemplate.HTMLEscapeString(result.Snippet)))
 	}
+	c.Status(200)
 }
 
 func RedeliveryWebhook(c *context.Context) {
@@ -62,6 +62,7 @@ func retrieveFeeds(c *context.Context, ctxUser *db.User, userID int64, isProfile
 	// Check access of private repositories.
 	feeds := make([]*db.Action, 0, len(actions))
 	unameAvatars := make(map[string]string)
+	repoMetas := make(map[int64]map[string]string)
 	for _, act := range actions {
 		// Cache results to reduce queries.
 		_, ok := unameAvatars[act.ActUserName]
@@ -78,6 +79,24 @@ func retrieveFeeds(c *context.Context, ctxUser *db.User, userID int64, isProfile
 		}
 
 		act.ActAvatar = unameAvatars[act.ActUserName]
+
+		// Metas are only used to render commit messages of pushes.
+		if act.OpType == db.ACTION_COMMIT_REPO || act.OpType == db.ACTION_MIRROR_SYNC_PUSH {
+			metas, ok := repoMetas[act.RepoID]
+			if !ok {
+				repo, err := db.GetRepositoryByID(act.RepoID)
+				if err != nil {
+					if !errors.IsRepoNotExist(err) {
+						c.Handle(500, "GetRepositoryByID", err)
+						return
+					}
+				} else {
+					metas = repo.ComposeMetas()
+				}
+				repoMetas[act.RepoID] = metas
+			}
+			act.Metas = metas
+		}
 		feeds = append(feeds, act)
 	}
 	c.Data["Feeds"] = feeds
@@ -107,6 +126,7 @@ func Dashboard(c *context.Context) {
 	c.Data["Title"] = ctxUser.DisplayName() + " - " + c.Tr("dashboard")
 	c.Data["PageIsDashboard"] = true
 	c.Data["PageIsNews"] = true
+	c.SetHomeNotice()
 
 	// Only user can have collaborative repositories.
 	if !ctxUser.IsOrganization() {
@@ -291,6 +311,8 @@ func Issues(c *context.Context) {
 		return
 	}
 
+	// Repositories whose issues are listed, numbers of which are summed up.
+	var listedRepos []*db.Repository
 	if repoID > 0 {
 		repo, err := db.GetRepositoryByID(repoID)
 		if err != nil {
@@ -308,6 +330,17 @@ func Issues(c *context.Context) {
 			c.Handle(404, "Issues", fmt.Errorf("#%d", repoID))
 			return
 		}
+		listedRepos = []*db.Repository{repo}
+	} else {
+		listedRepoIDs := make(map[int64]bool, len(userRepoIDs))
+		for _, id := range userRepoIDs {
+			listedRepoIDs[id] = true
+		}
+		for _, repo := range repos {
+			if listedRepoIDs[repo.ID] {
+				listedRepos = append(listedRepos, repo)
+			}
+		}
 	}
 
 	for _, issue := range issues {
@@ -317,7 +350,7 @@ func Issues(c *context.Context) {
 		}
 	}
 
-	issueStats := db.GetUserIssueStats(repoID, ctxUser.ID, userRepoIDs, filterMode, isPullList)
+	issueStats := db.GetUserIssueStats(repoID, ctxUser.ID, listedRepos, filterMode, isPullList)
 
 	var total int
 	if !isShowClosed {
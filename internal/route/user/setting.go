@@ -24,6 +24,7 @@ import (
 	"gogs.io/gogs/internal/db/errors"
 	"gogs.io/gogs/internal/email"
 	"gogs.io/gogs/internal/form"
+	"gogs.io/gogs/internal/imageutil"
 	"gogs.io/gogs/internal/tool"
 )
 
@@ -137,6 +138,9 @@ func UpdateAvatarSetting(c *context.Context, f form.Avatar, ctxUser *db.User) er
 			return errors.New(c.Tr("settings.uploaded_avatar_not_a_image"))
 		}
 		if err = ctxUser.UploadAvatar(data); err != nil {
+			if imageutil.IsErrTooManyPixels(err) {
+				return errors.New(c.Tr("settings.uploaded_avatar_too_large"))
+			}
 			return fmt.Errorf("upload avatar: %v", err)
 		}
 	} else {
@@ -303,6 +307,18 @@ func DeleteEmail(c *context.Context) {
 	})
 }
 
+// SettingsEmailNotificationPost updates whether the user only receives links
+// rather than full content in notification emails.
+func SettingsEmailNotificationPost(c *context.Context) {
+	if err := c.User.UpdateMailLinksOnly(c.QueryBool("links_only")); err != nil {
+		c.ServerError("UpdateMailLinksOnly", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("settings.email_notification_success"))
+	c.SubURLRedirect("/user/settings/email")
+}
+
 func SettingsSSHKeys(c *context.Context) {
 	c.Title("settings.ssh_keys")
 	c.PageIs("SettingsSSHKeys")
@@ -375,6 +391,22 @@ func DeleteSSHKey(c *context.Context) {
 	})
 }
 
+// SettingsCloneProtocolPost remembers the protocol last chosen by the user to
+// clone repositories.
+func SettingsCloneProtocolPost(c *context.Context) {
+	protocol := c.Query("protocol")
+	if !db.IsValidCloneProtocol(protocol) {
+		c.Error(400)
+		return
+	}
+
+	if err := c.User.UpdateCloneProtocol(protocol); err != nil {
+		c.ServerError("UpdateCloneProtocol", err)
+		return
+	}
+	c.Status(204)
+}
+
 func SettingsSecurity(c *context.Context) {
 	c.Title("settings.security")
 	c.PageIs("SettingsSecurity")
@@ -636,6 +668,28 @@ func SettingsDeleteApplication(c *context.Context) {
 	})
 }
 
+func SettingsRegenerateFeedToken(c *context.Context) {
+	token, err := c.User.RegenerateFeedToken()
+	if err != nil {
+		c.ServerError("RegenerateFeedToken", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("settings.feed_token_regenerate_success"))
+	c.Flash.Info(token)
+	c.SubURLRedirect("/user/settings/applications")
+}
+
+func SettingsRevokeFeedToken(c *context.Context) {
+	if err := c.User.RevokeFeedToken(); err != nil {
+		c.ServerError("RevokeFeedToken", err)
+		return
+	}
+
+	c.Flash.Success(c.Tr("settings.feed_token_revoke_success"))
+	c.SubURLRedirect("/user/settings/applications")
+}
+
 func SettingsDelete(c *context.Context) {
 	c.Title("settings.delete")
 	c.PageIs("SettingsDelete")
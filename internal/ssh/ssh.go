@@ -5,6 +5,7 @@
 package ssh
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -20,6 +21,7 @@ import (
 
 	"gogs.io/gogs/internal/conf"
 	"gogs.io/gogs/internal/db"
+	"gogs.io/gogs/internal/graceful"
 )
 
 func cleanCommand(cmd string) string {
@@ -30,7 +32,22 @@ func cleanCommand(cmd string) string {
 	return cmd[i:]
 }
 
-func handleServerConn(keyID string, chans <-chan ssh.NewChannel) {
+// sshConnection returns the value of the SSH_CONNECTION environment variable
+// for the connection, which is "<client IP> <client port> <server IP> <server
+// port>" as set by OpenSSH.
+func sshConnection(conn ssh.ConnMetadata) string {
+	fields := make([]string, 0, 4)
+	for _, addr := range []net.Addr{conn.RemoteAddr(), conn.LocalAddr()} {
+		host, port, err := net.SplitHostPort(addr.String())
+		if err != nil {
+			host, port = addr.String(), "0"
+		}
+		fields = append(fields, host, port)
+	}
+	return strings.Join(fields, " ")
+}
+
+func handleServerConn(keyID, connection string, chans <-chan ssh.NewChannel) {
 	for newChan := range chans {
 		if newChan.ChannelType() != "session" {
 			newChan.Reject(ssh.UnknownChannelType, "unknown channel type")
@@ -61,13 +78,17 @@ func handleServerConn(keyID string, chans <-chan ssh.NewChannel) {
 						return
 					}
 				case "exec":
+					// Make sure the Git operation is finished before the process exits.
+					done := graceful.Add()
+					defer done()
+
 					cmdName := strings.TrimLeft(payload, "'()")
 					log.Trace("SSH: Payload: %v", cmdName)
 
 					args := []string{"serv", "key-" + keyID, "--config=" + conf.CustomConf}
 					log.Trace("SSH: Arguments: %v", args)
 					cmd := exec.Command(conf.AppPath(), args...)
-					cmd.Env = append(os.Environ(), "SSH_ORIGINAL_COMMAND="+cmdName)
+					cmd.Env = append(os.Environ(), "SSH_ORIGINAL_COMMAND="+cmdName, "SSH_CONNECTION="+connection)
 
 					stdout, err := cmd.StdoutPipe()
 					if err != nil {
@@ -111,14 +132,23 @@ func handleServerConn(keyID string, chans <-chan ssh.NewChannel) {
 }
 
 func listen(config *ssh.ServerConfig, host string, port int) {
-	listener, err := net.Listen("tcp", host+":"+com.ToStr(port))
+	listener, err := graceful.Listen("tcp", host+":"+com.ToStr(port))
 	if err != nil {
 		log.Fatal("Failed to start SSH server: %v", err)
 	}
+	graceful.OnShutdown(func(context.Context) {
+		if err := listener.Close(); err != nil {
+			log.Error("SSH: Failed to close listener: %v", err)
+		}
+	})
+
 	for {
 		// Once a ServerConfig has been configured, connections can be accepted.
 		conn, err := listener.Accept()
 		if err != nil {
+			if graceful.IsDraining() {
+				return
+			}
 			log.Error("SSH: Error accepting incoming connection: %v", err)
 			continue
 		}
@@ -142,7 +172,7 @@ func listen(config *ssh.ServerConfig, host string, port int) {
 			log.Trace("SSH: Connection from %s (%s)", sConn.RemoteAddr(), sConn.ClientVersion())
 			// The incoming Request channel must be serviced.
 			go ssh.DiscardRequests(reqs)
-			go handleServerConn(sConn.Permissions.Extensions["key-id"], chans)
+			go handleServerConn(sConn.Permissions.Extensions["key-id"], sshConnection(sConn), chans)
 		}()
 	}
 }
@@ -0,0 +1,54 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the number of times each identity is allowed to perform an
+// operation in a fixed time window. Counts of all identities are reset together
+// when a new window starts, so memory is only used by identities seen in the
+// current window.
+type RateLimiter struct {
+	lock   sync.Mutex
+	limit  int
+	window time.Duration
+	start  time.Time
+	counts map[string]int
+}
+
+// NewRateLimiter initializes and returns a new RateLimiter object that allows
+// limit times per window for each identity.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:  limit,
+		window: window,
+		counts: make(map[string]int),
+	}
+}
+
+// Allow returns true and counts the operation if the identity has not reached
+// the limit in the current window.
+func (l *RateLimiter) Allow(identity string) bool {
+	return l.allow(identity, time.Now())
+}
+
+func (l *RateLimiter) allow(identity string, now time.Time) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if now.Sub(l.start) >= l.window {
+		l.start = now
+		l.counts = make(map[string]int)
+	}
+
+	if l.counts[identity] >= l.limit {
+		return false
+	}
+	l.counts[identity]++
+	return true
+}
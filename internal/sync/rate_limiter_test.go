@@ -0,0 +1,25 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(2, time.Minute)
+	now := time.Now()
+
+	assert.True(t, l.allow("alice", now))
+	assert.True(t, l.allow("alice", now.Add(time.Second)))
+	assert.False(t, l.allow("alice", now.Add(2*time.Second)))
+	assert.True(t, l.allow("bob", now.Add(2*time.Second)))
+
+	// A new window resets counts.
+	assert.True(t, l.allow("alice", now.Add(time.Minute)))
+}
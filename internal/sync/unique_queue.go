@@ -5,6 +5,8 @@
 package sync
 
 import (
+	"time"
+
 	"github.com/unknwon/com"
 )
 
@@ -17,6 +19,13 @@ import (
 type UniqueQueue struct {
 	table *StatusTable
 	queue chan string
+
+	// Following fields are protected by the lock of the table.
+	queuedAt map[string]time.Time
+	running  map[string]time.Time
+	// canceled is the number of canceled instances of each identity that are
+	// still in the channel, which are skipped when processing.
+	canceled map[string]int
 }
 
 // NewUniqueQueue initializes and returns a new UniqueQueue object.
@@ -26,8 +35,11 @@ func NewUniqueQueue(queueLength int) *UniqueQueue {
 	}
 
 	return &UniqueQueue{
-		table: NewStatusTable(),
-		queue: make(chan string, queueLength),
+		table:    NewStatusTable(),
+		queue:    make(chan string, queueLength),
+		queuedAt: make(map[string]time.Time),
+		running:  make(map[string]time.Time),
+		canceled: make(map[string]int),
 	}
 }
 
@@ -52,6 +64,7 @@ func (q *UniqueQueue) AddFunc(id interface{}, fn func()) {
 	idStr := com.ToStr(id)
 	q.table.Lock()
 	q.table.pool[idStr] = true
+	q.queuedAt[idStr] = time.Now()
 	if fn != nil {
 		fn()
 	}
@@ -66,5 +79,62 @@ func (q *UniqueQueue) Add(id interface{}) {
 
 // Remove removes instance from the queue.
 func (q *UniqueQueue) Remove(id interface{}) {
-	q.table.Stop(com.ToStr(id))
+	idStr := com.ToStr(id)
+	q.table.Lock()
+	delete(q.queuedAt, idStr)
+	q.table.Unlock()
+	q.table.Stop(idStr)
+}
+
+// Process removes the instance retrieved from the queue and calls the
+// function, during which the instance is reported as running. The function is
+// not called if the instance has been canceled.
+func (q *UniqueQueue) Process(id string, fn func()) {
+	q.table.Lock()
+	if q.canceled[id] > 0 {
+		q.canceled[id]--
+		if q.canceled[id] == 0 {
+			delete(q.canceled, id)
+		}
+		q.table.Unlock()
+		return
+	}
+	q.table.pool[id] = false
+	delete(q.queuedAt, id)
+	q.running[id] = time.Now()
+	q.table.Unlock()
+
+	defer func() {
+		q.table.Lock()
+		delete(q.running, id)
+		q.table.Unlock()
+	}()
+	fn()
+}
+
+// Cancel removes the instance from the line so it will not be processed, it
+// returns false if the instance is not in the queue. Running instances cannot
+// be canceled.
+func (q *UniqueQueue) Cancel(id interface{}) bool {
+	idStr := com.ToStr(id)
+	q.table.Lock()
+	defer q.table.Unlock()
+
+	if !q.table.pool[idStr] {
+		return false
+	}
+	q.table.pool[idStr] = false
+	delete(q.queuedAt, idStr)
+	q.canceled[idStr]++
+	return true
+}
+
+// Status returns the time when the instance was added to the queue and the
+// time when it started running, which are zero if it is not queued or not
+// running. An instance can be added to the queue again while running.
+func (q *UniqueQueue) Status(id interface{}) (queuedAt, runningSince time.Time) {
+	idStr := com.ToStr(id)
+	q.table.RLock()
+	defer q.table.RUnlock()
+	return q.queuedAt[idStr], q.running[idStr]
 }
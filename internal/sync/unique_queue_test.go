@@ -0,0 +1,51 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueQueue(t *testing.T) {
+	q := NewUniqueQueue(10)
+
+	q.Add(1)
+	q.Add(1)
+	assert.True(t, q.Exist(1))
+	queuedAt, runningSince := q.Status(1)
+	assert.False(t, queuedAt.IsZero())
+	assert.True(t, runningSince.IsZero())
+
+	// The instance is running, and can be added to the queue again.
+	id := <-q.Queue()
+	q.Process(id, func() {
+		assert.False(t, q.Exist(1))
+		queuedAt, runningSince := q.Status(1)
+		assert.True(t, queuedAt.IsZero())
+		assert.False(t, runningSince.IsZero())
+
+		q.Add(1)
+		assert.True(t, q.Exist(1))
+	})
+	_, runningSince = q.Status(1)
+	assert.True(t, runningSince.IsZero())
+
+	// The canceled instance is skipped, but not the one added afterwards.
+	assert.True(t, q.Cancel(1))
+	assert.False(t, q.Cancel(1))
+	assert.False(t, q.Exist(1))
+	q.Add(1)
+
+	processed := 0
+	for i := 0; i < 2; i++ {
+		q.Process(<-q.Queue(), func() {
+			processed++
+		})
+	}
+	assert.Equal(t, 1, processed)
+	assert.False(t, q.Exist(1))
+}
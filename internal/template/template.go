@@ -74,6 +74,7 @@ func FuncMap() []template.FuncMap {
 			"NewLine2br":       NewLine2br,
 			"TimeSince":        tool.TimeSince,
 			"RawTimeSince":     tool.RawTimeSince,
+			"HumanDuration":    tool.HumanDuration,
 			"FileSize":         tool.FileSize,
 			"Subtract":         tool.Subtract,
 			"Add": func(a, b int) int {
@@ -186,8 +187,7 @@ func ToUTF8WithErr(content []byte) (error, string) {
 
 // RenderCommitMessage renders commit message with special links.
 func RenderCommitMessage(full bool, msg, urlPrefix string, metas map[string]string) string {
-	cleanMsg := template.HTMLEscapeString(msg)
-	fullMessage := string(markup.RenderIssueIndexPattern([]byte(cleanMsg), urlPrefix, metas))
+	fullMessage := string(markup.RenderCommitMessage(msg, urlPrefix, metas))
 	msgLines := strings.Split(strings.TrimSpace(fullMessage), "\n")
 	numLines := len(msgLines)
 	if numLines == 0 {
@@ -0,0 +1,129 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package testutil
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// GitRepo is a Git repository created for a test.
+type GitRepo struct {
+	tb testing.TB
+	// Dir is the directory of the repository, which is also the work tree unless
+	// the repository is bare.
+	Dir string
+	env []string
+}
+
+// InitGitRepo initializes a Git repository with "master" as the default branch
+// in the directory, which is created if it does not exist. When dir is empty,
+// a temporary directory is used and removed after the test. Extra arguments are
+// passed to "git init", e.g. "--bare". The test is skipped if git is not
+// available.
+//
+// Commits are authored and committed by "gogs <gogs@localhost>" unless changed
+// by SetAuthor.
+func InitGitRepo(tb testing.TB, dir string, args ...string) *GitRepo {
+	tb.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		tb.Skip("git is not available")
+	}
+
+	if dir == "" {
+		var err error
+		dir, err = ioutil.TempDir("", "gogs-test-repo")
+		if err != nil {
+			tb.Fatal(err)
+		}
+		tb.Cleanup(func() { _ = os.RemoveAll(dir) })
+	} else if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		tb.Fatal(err)
+	}
+
+	r := OpenGitRepo(tb, dir)
+	r.Git(append([]string{"init", "--quiet"}, args...)...)
+	r.Git("symbolic-ref", "HEAD", "refs/heads/master")
+	return r
+}
+
+// OpenGitRepo returns the existing Git repository in the directory.
+func OpenGitRepo(tb testing.TB, dir string) *GitRepo {
+	r := &GitRepo{
+		tb:  tb,
+		Dir: dir,
+	}
+	r.SetAuthor("gogs", "gogs@localhost")
+	return r
+}
+
+// SetAuthor sets the author and committer of following commits.
+func (r *GitRepo) SetAuthor(name, email string) {
+	r.env = []string{
+		"GIT_AUTHOR_NAME=" + name, "GIT_AUTHOR_EMAIL=" + email,
+		"GIT_COMMITTER_NAME=" + name, "GIT_COMMITTER_EMAIL=" + email,
+	}
+}
+
+// GitWithStdin runs git with the arguments and the input in the repository,
+// and returns the output with surrounding spaces trimmed. The test fails when
+// git fails.
+func (r *GitRepo) GitWithStdin(stdin string, args ...string) string {
+	r.tb.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = r.Dir
+	cmd.Env = append(os.Environ(), r.env...)
+	cmd.Stdin = strings.NewReader(stdin)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		r.tb.Fatalf("git %v: %v - %s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// Git runs git with the arguments in the repository, and returns the output
+// with surrounding spaces trimmed. The test fails when git fails.
+func (r *GitRepo) Git(args ...string) string {
+	r.tb.Helper()
+	return r.GitWithStdin("", args...)
+}
+
+// WriteFile writes the content to the file of the path relative to the work
+// tree, parent directories are created as needed.
+func (r *GitRepo) WriteFile(name, content string) {
+	r.tb.Helper()
+
+	path := filepath.Join(r.Dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		r.tb.Fatal(err)
+	} else if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		r.tb.Fatal(err)
+	}
+}
+
+// Commit stages all changes of the work tree and commits them with the
+// message, and returns the ID of the new commit.
+func (r *GitRepo) Commit(message string) string {
+	r.tb.Helper()
+
+	r.Git("add", "--all")
+	r.Git("commit", "--quiet", "--allow-empty", "-m", message)
+	return r.Git("rev-parse", "HEAD")
+}
+
+// CommitFile writes the content to the file and commits it with the message
+// "change <name>", and returns the ID of the new commit.
+func (r *GitRepo) CommitFile(name, content string) string {
+	r.tb.Helper()
+
+	r.WriteFile(name, content)
+	return r.Commit("change " + name)
+}
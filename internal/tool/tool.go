@@ -8,6 +8,7 @@ import (
 	"crypto/md5"
 	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -46,6 +47,12 @@ func SHA1(str string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// SHA256 encodes string to SHA256 hex value.
+func SHA256(str string) string {
+	h := sha256.Sum256([]byte(str))
+	return hex.EncodeToString(h[:])
+}
+
 // ShortSHA1 truncates SHA1 string length to at most 10.
 func ShortSHA1(sha1 string) string {
 	if len(sha1) > 10 {
@@ -313,7 +320,12 @@ func timeSince(then time.Time, lang string) string {
 		lbl = i18n.Tr(lang, "tool.from_now")
 		diff = then.Unix() - now.Unix()
 	}
+	return humanDiff(diff, lbl, lang)
+}
 
+// humanDiff returns the user-friendly string of the number of seconds followed
+// by the label.
+func humanDiff(diff int64, lbl, lang string) string {
 	switch {
 	case diff <= 0:
 		return i18n.Tr(lang, "tool.now")
@@ -354,6 +366,12 @@ func timeSince(then time.Time, lang string) string {
 	}
 }
 
+// HumanDuration returns the user-friendly string of the duration, e.g. "3
+// weeks".
+func HumanDuration(d time.Duration, lang string) string {
+	return strings.TrimSpace(humanDiff(int64(d/time.Second), "", lang))
+}
+
 func RawTimeSince(t time.Time, lang string) string {
 	return timeSince(t, lang)
 }
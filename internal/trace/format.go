@@ -0,0 +1,98 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	FormatLogfmt = "logfmt"
+	FormatJSON   = "json"
+)
+
+// Field is a key-value pair of a structured log line.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Milliseconds returns the duration in milliseconds, which is the unit of all
+// durations in structured log lines.
+func Milliseconds(d time.Duration) float64 {
+	return float64(d/time.Microsecond) / 1000
+}
+
+// Fields returns fields of counters, slow operations are only included when
+// detailed is true.
+func (c Counters) Fields(detailed bool) []Field {
+	fields := []Field{
+		{"git_count", c.GitCount},
+		{"git_ms", Milliseconds(c.GitDuration)},
+		{"sql_count", c.SQLCount},
+		{"sql_ms", Milliseconds(c.SQLDuration)},
+	}
+	if !detailed {
+		return fields
+	}
+
+	for i, op := range c.SlowOps {
+		fields = append(fields,
+			Field{fmt.Sprintf("slow_%d_%s", i, op.Kind), op.Desc},
+			Field{fmt.Sprintf("slow_%d_ms", i), Milliseconds(op.Duration)},
+		)
+	}
+	return fields
+}
+
+// Encode encodes fields in given format, it falls back to logfmt for unknown
+// formats.
+func Encode(format string, fields []Field) string {
+	var buf bytes.Buffer
+	if format == FormatJSON {
+		buf.WriteByte('{')
+		for i, f := range fields {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.Quote(f.Key))
+			buf.WriteByte(':')
+			v, err := jsoniter.Marshal(f.Value)
+			if err != nil {
+				v = []byte(strconv.Quote(fmt.Sprint(f.Value)))
+			}
+			buf.Write(v)
+		}
+		buf.WriteByte('}')
+		return buf.String()
+	}
+
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(f.Key)
+		buf.WriteByte('=')
+
+		var v string
+		switch val := f.Value.(type) {
+		case float64:
+			v = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			v = fmt.Sprint(val)
+		}
+		if v == "" || strings.ContainsAny(v, " =\"\t\r\n") {
+			v = strconv.Quote(v)
+		}
+		buf.WriteString(v)
+	}
+	return buf.String()
+}
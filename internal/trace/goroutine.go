@@ -0,0 +1,61 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	bound  int32    // The number of traces currently bound, accessed atomically.
+	traces sync.Map // Goroutine ID -> *Trace
+)
+
+// goid returns the ID of the current goroutine, which is parsed from the first
+// line of its stack trace, i.e. "goroutine 123 [running]:".
+func goid() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i > 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
+
+// Bind binds the trace to the current goroutine until the returned function is
+// called.
+func Bind(t *Trace) (unbind func()) {
+	id := goid()
+	traces.Store(id, t)
+	atomic.AddInt32(&bound, 1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			traces.Delete(id)
+			atomic.AddInt32(&bound, -1)
+		})
+	}
+}
+
+// Current returns the trace bound to the current goroutine, or nil if there is
+// none. It is cheap to call when no trace is bound at all.
+func Current() *Trace {
+	if atomic.LoadInt32(&bound) == 0 {
+		return nil
+	}
+
+	t, ok := traces.Load(goid())
+	if !ok {
+		return nil
+	}
+	return t.(*Trace)
+}
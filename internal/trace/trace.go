@@ -0,0 +1,98 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package trace collects counters of Git subprocesses and SQL queries made while
+// serving a request, which helps to find out why a request is slow.
+//
+// Most code paths do not carry a context, and every request is served within a
+// single goroutine, so a trace is bound to the goroutine that serves the request.
+// Work done in other goroutines is not counted.
+package trace
+
+import (
+	"sync"
+	"time"
+)
+
+// Op is a single Git command or SQL query that took longer than the slow
+// threshold.
+type Op struct {
+	Kind     string // Either "git" or "sql".
+	Desc     string
+	Duration time.Duration
+}
+
+// Counters is a snapshot of counters collected by a trace.
+type Counters struct {
+	GitCount    int
+	GitDuration time.Duration
+	SQLCount    int
+	SQLDuration time.Duration
+	SlowOps     []Op
+}
+
+// Trace collects counters for a single request. All methods are safe to be
+// called on a nil trace, which does nothing.
+type Trace struct {
+	threshold time.Duration
+
+	lock     sync.Mutex
+	counters Counters
+}
+
+// New returns a new trace. Operations take longer than the threshold are
+// recorded in detail, zero threshold means never.
+func New(threshold time.Duration) *Trace {
+	return &Trace{threshold: threshold}
+}
+
+func (t *Trace) add(kind, desc string, d time.Duration) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	switch kind {
+	case "git":
+		t.counters.GitCount++
+		t.counters.GitDuration += d
+	case "sql":
+		t.counters.SQLCount++
+		t.counters.SQLDuration += d
+	}
+	if t.threshold > 0 && d >= t.threshold {
+		t.counters.SlowOps = append(t.counters.SlowOps, Op{
+			Kind:     kind,
+			Desc:     desc,
+			Duration: d,
+		})
+	}
+}
+
+// AddGit records a Git subprocess with its command line and duration.
+func (t *Trace) AddGit(cmd string, d time.Duration) {
+	t.add("git", cmd, d)
+}
+
+// AddSQL records a SQL query with its statement and duration.
+func (t *Trace) AddSQL(query string, d time.Duration) {
+	t.add("sql", query, d)
+}
+
+// Counters returns a snapshot of counters collected so far.
+func (t *Trace) Counters() Counters {
+	if t == nil {
+		return Counters{}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	c := t.counters
+	c.SlowOps = make([]Op, len(t.counters.SlowOps))
+	copy(c.SlowOps, t.counters.SlowOps)
+	return c
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 The Gogs Authors. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBind(t *testing.T) {
+	assert.Nil(t, Current())
+
+	tr := New(time.Second)
+	unbind := Bind(tr)
+	assert.Equal(t, tr, Current())
+
+	// Traces are not shared with other goroutines.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Nil(t, Current())
+	}()
+	wg.Wait()
+
+	unbind()
+	unbind()
+	assert.Nil(t, Current())
+}
+
+func TestTrace_Counters(t *testing.T) {
+	tr := New(time.Second)
+	tr.AddGit("git log", 100*time.Millisecond)
+	tr.AddGit("git gc", 2*time.Second)
+	tr.AddSQL("SELECT 1", 5*time.Millisecond)
+
+	assert.Equal(t, Counters{
+		GitCount:    2,
+		GitDuration: 2100 * time.Millisecond,
+		SQLCount:    1,
+		SQLDuration: 5 * time.Millisecond,
+		SlowOps: []Op{
+			{Kind: "git", Desc: "git gc", Duration: 2 * time.Second},
+		},
+	}, tr.Counters())
+
+	// Nil trace should just work.
+	var nilTrace *Trace
+	nilTrace.AddGit("git log", time.Second)
+	assert.Equal(t, Counters{}, nilTrace.Counters())
+}
+
+func TestEncode(t *testing.T) {
+	fields := []Field{
+		{"method", "GET"},
+		{"route", "/:username/:reponame"},
+		{"status", 200},
+		{"duration_ms", Milliseconds(1500 * time.Microsecond)},
+		{"slow_0_git", "git log -1"},
+		{"user", ""},
+	}
+
+	tests := []struct {
+		format string
+		expVal string
+	}{
+		{
+			format: FormatLogfmt,
+			expVal: `method=GET route=/:username/:reponame status=200 duration_ms=1.5 slow_0_git="git log -1" user=""`,
+		},
+		{
+			format: FormatJSON,
+			expVal: `{"method":"GET","route":"/:username/:reponame","status":200,"duration_ms":1.5,"slow_0_git":"git log -1","user":""}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			assert.Equal(t, test.expVal, Encode(test.format, fields))
+		})
+	}
+}
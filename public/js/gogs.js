@@ -236,6 +236,28 @@ function initRepository() {
             $(this).find('.text').addClass('black');
             return false;
         });
+
+        // Only the first page of branches and tags is rendered, load the rest on demand
+        $('.choose.reference .load-more-refs').click(function () {
+            var $this = $(this);
+            var $menu = $this.closest('.dropdown > .menu');
+            var page = $this.data('page');
+            $.getJSON($this.data('url') + '&page=' + page, function (refs, status, xhr) {
+                refs.forEach(function (ref) {
+                    var name = ref.name.replace(/%/g, '%25').replace(/#/g, '%23').replace(/ /g, '%20').replace(/\?/g, '%3F');
+                    $('<div class="item"></div>').text(ref.name)
+                        .attr('data-url', $menu.data('link-prefix') + name + $menu.data('link-suffix'))
+                        .insertBefore($this);
+                });
+                if ($this.siblings('.item').length >= parseInt(xhr.getResponseHeader('X-Total-Count'))) {
+                    $this.remove();
+                } else {
+                    $this.data('page', page + 1);
+                }
+                $('.choose.reference .dropdown').dropdown('refresh');
+            });
+            return false;
+        });
     }
 
     // Wiki
@@ -473,22 +495,30 @@ function initRepository() {
                 $(m[0]).siblings('.lines-code').addClass('active');
             }
         }).trigger('hashchange');
+
+        var $reviewProgress = $('.review-progress');
+        $('.reviewed-file input').change(function () {
+            var $input = $(this);
+            $input.prop('disabled', true);
+            $.post($reviewProgress.data('url'), {
+                "_csrf": csrf,
+                "path": $input.attr('data-path'),
+                "viewed": $input.is(':checked')
+            }).done(function (data) {
+                $reviewProgress.find('progress').attr('value', data.viewed).attr('max', data.total);
+                $reviewProgress.find('strong').eq(0).text(data.viewed);
+                $reviewProgress.find('strong').eq(1).text(data.total);
+            }).fail(function () {
+                $input.prop('checked', !$input.is(':checked'));
+            }).always(function () {
+                $input.prop('disabled', false);
+            });
+        });
     }
 
     // Quick start and repository home
-    $('#repo-clone-ssh').click(function () {
-        $('.clone-url').text($(this).data('link'));
-        $('#repo-clone-url').val($(this).data('link'));
-        $(this).addClass('blue');
-        $('#repo-clone-https').removeClass('blue');
-        localStorage.setItem('repo-clone-protocol', 'ssh');
-    });
-    $('#repo-clone-https').click(function () {
-        $('.clone-url').text($(this).data('link'));
-        $('#repo-clone-url').val($(this).data('link'));
-        $(this).addClass('blue');
-        $('#repo-clone-ssh').removeClass('blue');
-        localStorage.setItem('repo-clone-protocol', 'https');
+    $('#repo-clone-ssh, #repo-clone-https').click(function () {
+        selectCloneProtocol($(this), true);
     });
     $('#repo-clone-url').click(function () {
         $(this).select();
@@ -509,6 +539,105 @@ function initRepository() {
     }
 }
 
+function selectCloneProtocol($button, remember) {
+    var protocol = $button.data('protocol');
+    var link = $button.data('link');
+    $('.clone-url').text(link);
+    $('#repo-clone-url').val(link);
+    $('.clone-base-url').each(function () {
+        $(this).text($(this).data(protocol));
+    });
+    $('.clone-ide-link').each(function () {
+        $(this).attr('href', $(this).data('prefix') + encodeURIComponent(link));
+    });
+    $('#repo-clone-ssh, #repo-clone-https').removeClass('blue');
+    $button.addClass('blue');
+    localStorage.setItem('repo-clone-protocol', protocol);
+
+    var rememberURL = $('#clone-panel').data('remember-url');
+    if (remember && rememberURL) {
+        $.post(rememberURL, {
+            "_csrf": csrf,
+            "protocol": protocol
+        });
+    }
+}
+
+function initIssueSuggestions() {
+    var $title = $('input[data-suggestions-url]');
+    var $suggestions = $('#issue-suggestions');
+    if ($title.length === 0 || $suggestions.length === 0) {
+        return;
+    }
+
+    var $list = $suggestions.find('.list');
+    var timer = null;
+    var lastTitle = '';
+    var lookup = function () {
+        var title = $.trim($title.val());
+        if (title === lastTitle) {
+            return;
+        }
+        lastTitle = title;
+        if (title.length < 3) {
+            $suggestions.addClass('hide');
+            return;
+        }
+
+        $.getJSON($title.data('suggestions-url'), {title: title}, function (data) {
+            // Ignore stale responses of previous titles.
+            if (title !== lastTitle) {
+                return;
+            }
+
+            $list.empty();
+            $.each(data, function (i, item) {
+                var $item = $('<div class="item">');
+                $item.append($('<span>').addClass('octicon ' + (item.state === 'closed' ? 'octicon-issue-closed' : 'octicon-issue-opened')));
+                $item.append(' ');
+                $item.append($('<a target="_blank" rel="noopener">').attr('href', item.html_url).text('#' + item.number + ' ' + item.title));
+                $item.append(' ');
+                var state = item.state === 'closed' ? $suggestions.data('closed') : $suggestions.data('open');
+                var comments = $suggestions.data('num-comments').replace('%d', item.comments);
+                $item.append($('<span class="text grey">').text(state + ' · ' + comments));
+                if (item.is_likely) {
+                    $item.find('a').addClass('text bold');
+                }
+                $list.append($item);
+            });
+            $suggestions.toggleClass('hide', data.length === 0);
+        });
+    };
+
+    $title.on('input', function () {
+        clearTimeout(timer);
+        timer = setTimeout(lookup, 300);
+    });
+    lookup();
+}
+
+function initLastCommits() {
+    var $table = $('#repo-files-table[data-last-commits-url]');
+    if ($table.length === 0) {
+        return;
+    }
+
+    $.getJSON($table.data('last-commits-url'), function (data) {
+        $table.find('tbody tr[data-entry]').each(function () {
+            var $row = $(this);
+            var commit = data[$row.attr('data-entry')];
+            if (!commit || $row.find('.message .sha').length > 0) {
+                return;
+            }
+
+            var $message = $row.find('.message').empty();
+            $message.append($('<a rel="nofollow" class="ui sha label">').attr('href', commit.html_url).text(commit.sha.substr(0, 10)));
+            $message.append(' ').append(commit.message);
+            $row.find('.age').html(commit.age);
+        });
+    });
+}
+
 function initWikiForm() {
     var $editArea = $('.repository.wiki textarea#edit_area');
     if ($editArea.length > 0) {
@@ -774,7 +903,14 @@ function initEditor() {
         value = value.split('/');
         value = value[value.length - 1];
 
-        $.getJSON($editFilename.data('ec-url-prefix') + value, function (editorconfig) {
+        // .editorconfig files are looked up from the folder of the file on the branch being edited
+        var treePath = $('#tree_path').val();
+        var query = $.param({
+            ref: $editFilename.data('ec-ref'),
+            dir: treePath.substring(0, treePath.lastIndexOf('/') + 1)
+        });
+
+        $.getJSON($editFilename.data('ec-url-prefix') + encodeURIComponent(value) + '?' + query, function (editorconfig) {
             if (editorconfig.indent_style === 'tab') {
                 codeMirrorEditor.setOption("indentWithTabs", true);
                 codeMirrorEditor.setOption('extraKeys', {});
@@ -1112,6 +1248,7 @@ function initRepositoryCollaboration() {
         $.post($menu.data('url'), {
             "_csrf": csrf,
             "uid": $menu.data('uid'),
+            "id": $menu.data('id'),
             "mode": $(this).data('value')
         })
     });
@@ -1139,7 +1276,17 @@ function initWebhookSettings() {
     })
 
     // Trigger delivery
-    $('.delivery.button, .redelivery.button').click(function () {
+    // Test delivery is synchronous, reload to show its result once it is done.
+    $('.delivery.button').click(function () {
+        var $this = $(this);
+        $this.addClass('loading disabled');
+        $.post($this.data('link'), {
+            "_csrf": csrf
+        }).always(function () {
+            window.location.href = $this.data('redirect');
+        });
+    });
+    $('.redelivery.button').click(function () {
         var $this = $(this);
         $this.addClass('loading disabled');
         $.post($this.data('link'), {
@@ -1375,6 +1522,8 @@ $(document).ready(function () {
 
     initCommentForm();
     initRepository();
+    initIssueSuggestions();
+    initLastCommits();
     initWikiForm();
     initEditForm();
     initEditor();
@@ -1384,15 +1533,17 @@ $(document).ready(function () {
 
     // Repo clone url.
     if ($('#repo-clone-url').length > 0) {
-        switch (localStorage.getItem('repo-clone-protocol')) {
-            case 'ssh':
-                if ($('#repo-clone-ssh').click().length === 0) {
-                    $('#repo-clone-https').click();
-                }
-                break;
-            default:
-                $('#repo-clone-https').click();
-                break;
+        // Signed-in users have their last chosen protocol remembered by the server.
+        var protocol = $('#clone-panel').data('remember-url') ? $('#clone-panel').data('default') : localStorage.getItem('repo-clone-protocol');
+        var $button = $('#repo-clone-' + protocol);
+        if ($button.length === 0) {
+            $button = $('#repo-clone-https');
+        }
+        if ($button.length === 0) {
+            $button = $('#repo-clone-ssh');
+        }
+        if ($button.length > 0) {
+            selectCloneProtocol($button, false);
         }
     }
 